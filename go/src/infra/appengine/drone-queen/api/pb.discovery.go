@@ -12,428 +12,504 @@ func init() {
 			"drone_queen.Drone", "drone_queen.InventoryProvider", "drone_queen.Inspect",
 		},
 		[]byte{31, 139,
-			8, 0, 0, 0, 0, 0, 0, 255, 164, 122, 75, 108, 27, 73,
-			122, 48, 187, 171, 37, 145, 37, 191, 84, 246, 120, 52, 180, 199,
-			254, 76, 143, 118, 164, 89, 170, 41, 201, 143, 249, 101, 239, 252,
-			179, 148, 72, 89, 244, 74, 164, 126, 62, 172, 181, 189, 187, 118,
-			139, 93, 36, 123, 166, 217, 77, 119, 23, 37, 203, 30, 207, 236,
-			191, 185, 100, 129, 32, 1, 114, 8, 2, 4, 11, 36, 57, 36,
-			192, 230, 28, 228, 144, 83, 238, 185, 5, 8, 144, 123, 246, 190,
-			192, 94, 114, 12, 190, 170, 238, 38, 245, 240, 120, 103, 87, 24,
-			99, 250, 171, 199, 247, 174, 239, 81, 69, 250, 31, 5, 122, 189,
-			235, 251, 93, 151, 23, 6, 129, 47, 252, 189, 97, 167, 32, 156,
-			62, 15, 133, 213, 31, 152, 114, 136, 157, 87, 11, 204, 120, 65,
-			238, 62, 205, 52, 227, 53, 108, 150, 78, 133, 188, 237, 123, 118,
-			56, 171, 129, 54, 79, 234, 49, 200, 46, 209, 9, 207, 242, 252,
-			112, 86, 7, 109, 126, 162, 174, 128, 181, 175, 233, 197, 182, 223,
-			55, 143, 225, 92, 59, 151, 96, 220, 193, 161, 29, 237, 201, 247,
-			187, 142, 232, 13, 247, 204, 182, 223, 47, 116, 125, 215, 242, 186,
-			35, 22, 7, 226, 112, 192, 195, 17, 167, 255, 163, 105, 127, 175,
-			147, 7, 59, 107, 191, 214, 175, 61, 80, 152, 119, 162, 181, 230,
-			46, 119, 221, 31, 121, 254, 129, 215, 196, 61, 15, 255, 107, 145,
-			78, 177, 137, 107, 169, 95, 106, 26, 253, 247, 51, 84, 59, 195,
-			200, 181, 20, 91, 249, 183, 51, 32, 119, 180, 125, 23, 214, 134,
-			157, 14, 15, 66, 88, 4, 133, 235, 227, 16, 108, 75, 88, 224,
-			120, 130, 7, 237, 158, 229, 117, 57, 116, 252, 160, 111, 9, 10,
-			235, 254, 224, 48, 112, 186, 61, 1, 43, 75, 75, 255, 39, 218,
-			0, 21, 175, 109, 2, 20, 93, 23, 228, 92, 8, 1, 15, 121,
-			176, 207, 109, 147, 66, 79, 136, 65, 120, 175, 80, 176, 249, 62,
-			119, 253, 1, 15, 194, 88, 25, 40, 233, 32, 98, 98, 113, 79,
-			49, 81, 160, 20, 234, 220, 118, 66, 17, 56, 123, 67, 225, 248,
-			30, 88, 158, 13, 195, 144, 131, 227, 65, 232, 15, 131, 54, 151,
-			35, 123, 142, 103, 5, 135, 146, 175, 48, 15, 7, 142, 232, 129,
-			31, 200, 255, 251, 67, 65, 161, 239, 219, 78, 199, 105, 91, 136,
-			33, 15, 86, 192, 97, 192, 131, 190, 35, 4, 183, 97, 16, 248,
-			251, 142, 205, 109, 16, 61, 75, 128, 232, 161, 116, 174, 235, 31,
-			56, 94, 23, 208, 148, 14, 110, 10, 113, 19, 133, 62, 23, 247,
-			40, 5, 252, 251, 228, 24, 99, 33, 248, 157, 152, 163, 182, 111,
-			115, 232, 15, 67, 1, 1, 23, 150, 227, 73, 172, 214, 158, 191,
-			143, 83, 145, 198, 40, 120, 190, 112, 218, 60, 15, 162, 231, 132,
-			224, 58, 161, 64, 12, 227, 20, 61, 251, 24, 59, 182, 19, 182,
-			93, 203, 233, 243, 192, 124, 27, 19, 142, 55, 174, 139, 152, 137,
-			65, 224, 219, 195, 54, 31, 241, 65, 71, 140, 252, 81, 124, 80,
-			136, 164, 179, 253, 246, 176, 207, 61, 97, 197, 70, 42, 248, 1,
-			248, 162, 199, 3, 232, 91, 130, 7, 142, 229, 134, 35, 85, 75,
-			3, 137, 30, 167, 48, 206, 125, 34, 84, 149, 59, 114, 39, 34,
-			246, 172, 62, 71, 134, 198, 125, 203, 243, 71, 115, 82, 239, 142,
-			8, 81, 34, 79, 161, 242, 131, 16, 250, 214, 33, 236, 113, 244,
-			20, 27, 132, 15, 220, 179, 253, 32, 228, 232, 20, 131, 192, 239,
-			251, 130, 131, 210, 137, 8, 193, 230, 129, 179, 207, 109, 232, 4,
-			126, 159, 42, 45, 132, 126, 71, 28, 160, 155, 68, 30, 4, 225,
-			128, 183, 209, 131, 96, 16, 56, 232, 88, 1, 250, 142, 167, 188,
-			40, 12, 37, 239, 20, 154, 155, 149, 6, 52, 106, 27, 205, 221,
-			98, 189, 12, 149, 6, 236, 212, 107, 143, 42, 165, 114, 9, 214,
-			30, 67, 115, 179, 12, 235, 181, 157, 199, 245, 202, 131, 205, 38,
-			108, 214, 182, 74, 229, 122, 3, 138, 213, 18, 172, 215, 170, 205,
-			122, 101, 173, 213, 172, 213, 27, 20, 114, 197, 6, 84, 26, 57,
-			57, 83, 172, 62, 134, 242, 143, 119, 234, 229, 70, 3, 106, 117,
-			168, 108, 239, 108, 85, 202, 37, 216, 45, 214, 235, 197, 106, 179,
-			82, 110, 228, 161, 82, 93, 223, 106, 149, 42, 213, 7, 121, 88,
-			107, 53, 161, 90, 107, 82, 216, 170, 108, 87, 154, 229, 18, 52,
-			107, 121, 73, 246, 228, 62, 168, 109, 192, 118, 185, 190, 190, 89,
-			172, 54, 139, 107, 149, 173, 74, 243, 177, 36, 184, 81, 105, 86,
-			145, 216, 70, 173, 78, 161, 8, 59, 197, 122, 179, 178, 222, 218,
-			42, 214, 97, 167, 85, 223, 169, 53, 202, 128, 146, 149, 42, 141,
-			245, 173, 98, 101, 187, 92, 50, 161, 82, 133, 106, 13, 202, 143,
-			202, 213, 38, 52, 54, 139, 91, 91, 71, 5, 165, 80, 219, 173,
-			150, 235, 200, 253, 184, 152, 176, 86, 134, 173, 74, 113, 109, 171,
-			140, 164, 164, 156, 165, 74, 189, 188, 222, 68, 129, 70, 95, 235,
-			149, 82, 185, 218, 44, 110, 229, 41, 52, 118, 202, 235, 149, 226,
-			86, 30, 202, 63, 46, 111, 239, 108, 21, 235, 143, 243, 17, 210,
-			70, 249, 255, 181, 202, 213, 102, 165, 184, 5, 165, 226, 118, 241,
-			65, 185, 1, 243, 239, 210, 202, 78, 189, 182, 222, 170, 151, 183,
-			145, 235, 218, 6, 52, 90, 107, 141, 102, 165, 217, 106, 150, 225,
-			65, 173, 86, 146, 202, 110, 148, 235, 143, 42, 235, 229, 198, 125,
-			216, 170, 53, 164, 194, 90, 141, 114, 158, 66, 169, 216, 44, 74,
-			210, 59, 245, 218, 70, 165, 217, 184, 143, 223, 107, 173, 70, 69,
-			42, 174, 82, 109, 150, 235, 245, 214, 78, 179, 82, 171, 46, 192,
-			102, 109, 183, 252, 168, 92, 135, 245, 98, 171, 81, 46, 73, 13,
-			215, 170, 40, 45, 250, 74, 185, 86, 127, 140, 104, 81, 15, 210,
-			2, 121, 216, 221, 44, 55, 55, 203, 117, 84, 170, 212, 86, 17,
-			213, 208, 104, 214, 43, 235, 205, 241, 101, 181, 58, 52, 107, 245,
-			38, 29, 147, 19, 170, 229, 7, 91, 149, 7, 229, 234, 122, 25,
-			167, 107, 136, 102, 183, 210, 40, 47, 64, 177, 94, 105, 224, 130,
-			138, 36, 12, 187, 197, 199, 80, 107, 73, 169, 209, 80, 173, 70,
-			153, 170, 239, 49, 215, 205, 75, 123, 66, 101, 3, 138, 165, 71,
-			21, 228, 60, 90, 189, 83, 107, 52, 42, 145, 187, 72, 181, 173,
-			111, 70, 58, 55, 41, 77, 83, 77, 103, 4, 82, 179, 248, 149,
-			102, 36, 151, 186, 79, 51, 84, 79, 207, 169, 79, 53, 120, 51,
-			117, 93, 14, 94, 87, 159, 106, 240, 163, 212, 154, 28, 156, 86,
-			159, 106, 112, 46, 149, 151, 131, 154, 250, 84, 131, 223, 75, 21,
-			228, 96, 244, 169, 6, 63, 78, 229, 228, 32, 85, 159, 106, 112,
-			62, 117, 67, 14, 126, 164, 62, 127, 119, 133, 234, 70, 138, 77,
-			124, 141, 153, 47, 251, 155, 43, 80, 132, 36, 229, 202, 248, 200,
-			67, 238, 137, 16, 44, 24, 248, 142, 39, 100, 84, 115, 250, 152,
-			101, 108, 62, 224, 158, 205, 61, 25, 21, 45, 239, 80, 141, 191,
-			242, 61, 25, 76, 92, 191, 109, 185, 20, 218, 150, 203, 61, 219,
-			10, 242, 192, 61, 12, 254, 54, 88, 136, 171, 237, 15, 213, 190,
-			168, 40, 144, 161, 180, 19, 88, 237, 81, 194, 136, 39, 48, 31,
-			96, 133, 32, 97, 76, 152, 190, 171, 98, 34, 52, 123, 60, 66,
-			228, 96, 38, 117, 45, 225, 236, 115, 140, 105, 150, 7, 124, 224,
-			183, 123, 96, 9, 104, 53, 215, 161, 239, 216, 158, 12, 232, 190,
-			71, 225, 161, 229, 13, 49, 11, 44, 231, 97, 121, 245, 211, 165,
-			124, 28, 167, 7, 129, 239, 242, 129, 112, 218, 240, 32, 224, 93,
-			63, 112, 44, 47, 225, 30, 14, 122, 78, 187, 7, 252, 165, 224,
-			200, 147, 140, 207, 167, 172, 218, 179, 218, 95, 30, 88, 1, 174,
-			240, 225, 144, 91, 1, 248, 30, 199, 248, 135, 25, 191, 239, 120,
-			67, 193, 101, 186, 132, 187, 75, 137, 124, 174, 239, 117, 77, 216,
-			226, 214, 96, 36, 114, 192, 33, 23, 246, 185, 21, 112, 59, 7,
-			161, 175, 242, 175, 231, 131, 203, 173, 1, 141, 150, 129, 176, 246,
-			92, 142, 146, 123, 156, 163, 94, 59, 126, 160, 42, 145, 1, 166,
-			86, 149, 207, 135, 33, 38, 37, 11, 158, 174, 220, 94, 236, 249,
-			195, 0, 92, 199, 227, 86, 64, 65, 98, 255, 233, 252, 183, 215,
-			28, 104, 207, 130, 92, 185, 32, 131, 120, 143, 67, 32, 139, 28,
-			39, 148, 41, 1, 150, 150, 150, 150, 23, 229, 127, 205, 165, 165,
-			123, 242, 191, 39, 40, 250, 234, 234, 234, 234, 226, 242, 202, 226,
-			173, 229, 230, 202, 173, 123, 119, 86, 239, 221, 89, 53, 87, 227,
-			191, 39, 38, 172, 29, 82, 52, 164, 8, 156, 182, 64, 6, 69,
-			36, 162, 196, 158, 135, 3, 14, 220, 11, 135, 1, 87, 163, 7,
-			28, 218, 168, 101, 223, 219, 231, 129, 80, 246, 85, 57, 9, 158,
-			214, 55, 214, 41, 220, 186, 117, 107, 117, 36, 203, 193, 193, 129,
-			233, 112, 209, 49, 253, 160, 91, 8, 58, 109, 252, 135, 43, 76,
-			241, 82, 44, 96, 193, 198, 1, 41, 123, 221, 16, 133, 186, 9,
-			229, 151, 86, 127, 224, 242, 144, 210, 248, 19, 150, 239, 193, 186,
-			223, 31, 12, 5, 31, 59, 11, 146, 224, 78, 173, 81, 249, 49,
-			60, 71, 205, 204, 47, 60, 55, 163, 138, 103, 180, 40, 169, 60,
-			239, 171, 153, 81, 205, 28, 114, 241, 44, 50, 240, 188, 220, 94,
-			109, 109, 109, 45, 44, 156, 186, 78, 250, 251, 252, 210, 194, 253,
-			49, 158, 86, 222, 197, 83, 151, 11, 196, 226, 119, 108, 235, 112,
-			140, 183, 80, 4, 195, 182, 144, 4, 246, 45, 23, 196, 126, 68,
-			241, 200, 242, 239, 137, 253, 60, 72, 134, 238, 255, 161, 34, 237,
-			155, 98, 31, 161, 111, 147, 72, 45, 26, 134, 188, 13, 159, 192,
-			242, 210, 210, 81, 9, 111, 189, 85, 194, 93, 199, 187, 181, 2,
-			207, 31, 112, 209, 56, 12, 5, 239, 227, 116, 49, 220, 112, 92,
-			222, 60, 106, 136, 141, 202, 86, 185, 89, 217, 46, 67, 71, 68,
-			108, 188, 109, 207, 247, 58, 34, 230, 180, 85, 169, 54, 239, 222,
-			6, 225, 180, 191, 12, 225, 51, 152, 159, 159, 87, 35, 11, 29,
-			97, 218, 7, 155, 78, 183, 87, 178, 132, 220, 181, 0, 63, 248,
-			1, 220, 90, 89, 128, 175, 64, 206, 109, 249, 7, 241, 84, 172,
-			183, 66, 1, 138, 200, 175, 237, 31, 132, 18, 37, 30, 150, 229,
-			165, 165, 177, 24, 22, 154, 201, 2, 21, 165, 150, 239, 158, 60,
-			70, 9, 54, 220, 190, 124, 247, 246, 237, 219, 159, 222, 186, 187,
-			52, 10, 27, 123, 188, 227, 7, 28, 90, 158, 243, 50, 198, 178,
-			250, 233, 210, 113, 44, 230, 31, 102, 204, 121, 37, 63, 204, 207,
-			43, 165, 20, 164, 177, 240, 111, 1, 22, 199, 217, 121, 135, 7,
-			35, 30, 84, 87, 140, 103, 110, 12, 143, 116, 128, 133, 35, 14,
-			112, 251, 173, 14, 240, 208, 218, 183, 224, 185, 50, 164, 217, 30,
-			6, 1, 247, 4, 46, 217, 118, 92, 215, 9, 199, 28, 0, 163,
-			41, 244, 229, 40, 124, 6, 111, 223, 240, 45, 110, 14, 159, 141,
-			70, 77, 143, 31, 172, 13, 29, 215, 230, 193, 252, 2, 10, 214,
-			136, 52, 20, 145, 80, 138, 89, 80, 184, 240, 15, 215, 84, 149,
-			236, 142, 39, 80, 242, 104, 165, 18, 61, 18, 91, 106, 96, 193,
-			220, 67, 204, 146, 151, 145, 14, 238, 188, 85, 7, 145, 20, 113,
-			246, 133, 157, 67, 209, 83, 213, 245, 17, 245, 143, 179, 63, 191,
-			112, 220, 54, 15, 184, 88, 31, 105, 99, 126, 65, 70, 192, 135,
-			141, 90, 21, 182, 173, 193, 192, 241, 186, 148, 66, 197, 83, 35,
-			170, 149, 205, 203, 228, 56, 166, 167, 195, 129, 76, 0, 71, 210,
-			185, 10, 168, 81, 38, 165, 50, 44, 127, 167, 168, 172, 72, 97,
-			70, 183, 48, 153, 231, 21, 26, 53, 138, 196, 114, 175, 49, 155,
-			190, 89, 124, 221, 247, 61, 209, 123, 179, 248, 218, 182, 14, 223,
-			52, 95, 99, 74, 123, 115, 239, 117, 223, 241, 222, 220, 123, 29,
-			242, 246, 155, 167, 230, 107, 44, 34, 208, 145, 223, 252, 244, 73,
-			142, 194, 65, 143, 7, 28, 212, 110, 68, 100, 185, 7, 214, 97,
-			8, 252, 37, 214, 53, 216, 1, 169, 12, 217, 193, 220, 104, 59,
-			93, 71, 132, 152, 234, 93, 14, 17, 165, 60, 72, 82, 121, 10,
-			138, 88, 30, 36, 181, 188, 76, 65, 146, 164, 204, 214, 175, 120,
-			224, 47, 14, 44, 219, 86, 61, 149, 56, 240, 99, 108, 220, 106,
-			247, 84, 165, 18, 87, 55, 88, 21, 69, 7, 45, 31, 213, 21,
-			152, 222, 186, 62, 12, 7, 50, 121, 198, 91, 231, 29, 147, 155,
-			209, 224, 242, 233, 53, 208, 66, 158, 74, 250, 254, 64, 97, 86,
-			148, 114, 79, 114, 16, 14, 59, 29, 231, 37, 86, 105, 216, 220,
-			115, 89, 179, 72, 63, 144, 245, 217, 124, 174, 213, 92, 207, 45,
-			220, 63, 50, 74, 85, 25, 245, 98, 232, 4, 220, 54, 161, 8,
-			242, 206, 225, 150, 114, 134, 80, 54, 170, 206, 43, 30, 64, 216,
-			243, 135, 174, 29, 171, 114, 24, 114, 89, 99, 205, 91, 97, 66,
-			205, 134, 189, 67, 138, 108, 44, 160, 1, 60, 108, 13, 61, 149,
-			232, 79, 186, 18, 42, 210, 58, 66, 106, 96, 5, 225, 136, 204,
-			30, 167, 32, 43, 29, 204, 251, 237, 54, 31, 8, 216, 243, 69,
-			79, 210, 196, 189, 170, 147, 142, 101, 8, 79, 240, 129, 197, 160,
-			223, 233, 132, 92, 200, 34, 102, 195, 15, 128, 171, 179, 150, 135,
-			220, 202, 210, 242, 167, 24, 51, 151, 239, 52, 151, 150, 239, 221,
-			90, 186, 183, 124, 199, 92, 90, 126, 146, 139, 188, 59, 4, 9,
-			39, 65, 119, 96, 133, 130, 130, 92, 41, 233, 251, 222, 168, 154,
-			188, 147, 7, 196, 102, 70, 7, 200, 218, 183, 26, 237, 192, 25,
-			136, 60, 214, 128, 71, 10, 24, 11, 48, 105, 128, 191, 247, 5,
-			111, 11, 85, 251, 96, 65, 165, 156, 93, 249, 163, 116, 255, 80,
-			88, 88, 85, 218, 20, 158, 10, 191, 210, 168, 53, 228, 33, 155,
-			95, 56, 165, 108, 51, 251, 254, 43, 199, 117, 45, 121, 186, 184,
-			183, 216, 106, 20, 108, 191, 29, 22, 118, 249, 94, 97, 196, 74,
-			161, 206, 59, 60, 224, 94, 155, 23, 30, 184, 254, 158, 229, 62,
-			171, 73, 30, 194, 2, 50, 84, 24, 35, 178, 32, 47, 116, 122,
-			190, 109, 162, 48, 42, 210, 228, 229, 57, 87, 44, 193, 115, 172,
-			163, 80, 233, 102, 252, 241, 60, 22, 8, 69, 221, 227, 177, 180,
-			220, 166, 167, 138, 72, 225, 233, 243, 80, 4, 29, 185, 117, 76,
-			34, 191, 29, 154, 3, 21, 217, 80, 150, 149, 130, 235, 236, 5,
-			86, 112, 40, 139, 81, 179, 39, 250, 238, 77, 249, 21, 239, 93,
-			144, 23, 17, 52, 113, 228, 152, 72, 56, 224, 109, 248, 120, 238,
-			241, 226, 92, 127, 113, 206, 110, 206, 109, 222, 155, 219, 190, 55,
-			215, 48, 231, 58, 79, 62, 54, 97, 203, 249, 146, 31, 56, 33,
-			151, 197, 63, 42, 104, 100, 165, 97, 200, 21, 182, 135, 190, 109,
-			73, 103, 253, 56, 132, 167, 207, 43, 141, 90, 156, 234, 55, 84,
-			176, 178, 35, 112, 126, 225, 249, 79, 231, 213, 245, 93, 20, 231,
-			190, 240, 109, 101, 9, 252, 88, 148, 85, 180, 53, 112, 164, 65,
-			226, 81, 85, 91, 43, 94, 11, 39, 113, 75, 57, 99, 2, 115,
-			43, 165, 185, 149, 18, 133, 5, 84, 164, 191, 39, 175, 205, 172,
-			72, 78, 193, 3, 104, 91, 3, 121, 64, 252, 14, 116, 185, 199,
-			3, 75, 29, 181, 248, 152, 133, 42, 44, 39, 250, 55, 169, 252,
-			35, 70, 74, 99, 228, 235, 244, 12, 253, 149, 70, 13, 35, 165,
-			167, 152, 241, 11, 77, 191, 148, 253, 115, 13, 234, 163, 182, 47,
-			118, 125, 191, 35, 61, 94, 170, 56, 116, 188, 246, 120, 233, 65,
-			79, 175, 61, 96, 123, 24, 10, 116, 133, 111, 235, 21, 232, 105,
-			205, 194, 19, 112, 188, 182, 59, 12, 157, 125, 236, 158, 206, 210,
-			9, 100, 111, 66, 242, 55, 21, 131, 26, 130, 233, 243, 49, 72,
-			16, 100, 23, 233, 111, 148, 48, 26, 51, 254, 84, 211, 89, 246,
-			63, 53, 168, 250, 222, 162, 199, 187, 170, 57, 60, 210, 98, 90,
-			113, 43, 133, 221, 213, 233, 45, 102, 53, 218, 152, 116, 93, 251,
-			150, 59, 228, 161, 186, 166, 27, 33, 147, 151, 137, 161, 112, 92,
-			23, 122, 214, 62, 7, 111, 156, 166, 68, 29, 109, 164, 170, 165,
-			81, 93, 107, 199, 15, 176, 91, 140, 91, 234, 227, 10, 139, 58,
-			169, 124, 244, 143, 158, 162, 20, 109, 66, 202, 25, 43, 69, 147,
-			98, 167, 207, 198, 32, 65, 240, 194, 204, 222, 164, 10, 175, 244,
-			191, 111, 211, 69, 199, 235, 4, 86, 193, 26, 12, 184, 215, 117,
-			60, 94, 176, 3, 223, 227, 139, 47, 134, 156, 123, 232, 165, 133,
-			144, 7, 251, 78, 59, 186, 129, 103, 211, 114, 250, 153, 156, 206,
-			190, 235, 69, 32, 247, 11, 157, 178, 58, 31, 248, 129, 40, 225,
-			182, 58, 127, 49, 228, 161, 96, 31, 82, 170, 208, 12, 135, 142,
-			45, 95, 3, 50, 245, 140, 28, 105, 13, 29, 155, 237, 210, 243,
-			174, 111, 217, 207, 162, 168, 237, 7, 234, 101, 96, 122, 197, 52,
-			199, 168, 155, 39, 17, 155, 91, 190, 101, 87, 146, 93, 245, 115,
-			238, 17, 152, 125, 159, 206, 40, 4, 54, 15, 101, 0, 116, 124,
-			111, 150, 72, 242, 23, 228, 68, 105, 52, 206, 24, 53, 122, 206,
-			62, 159, 53, 228, 188, 252, 206, 222, 162, 231, 142, 146, 96, 55,
-			232, 25, 123, 40, 158, 225, 145, 107, 59, 226, 80, 10, 115, 182,
-			62, 109, 15, 197, 122, 52, 148, 251, 87, 157, 94, 60, 194, 107,
-			56, 240, 189, 144, 179, 207, 233, 100, 40, 44, 49, 84, 239, 33,
-			231, 86, 62, 126, 187, 116, 106, 135, 217, 144, 203, 235, 209, 182,
-			99, 106, 212, 143, 171, 113, 157, 158, 231, 47, 7, 78, 32, 91,
-			255, 103, 104, 26, 41, 235, 244, 74, 246, 248, 163, 138, 153, 164,
-			224, 250, 185, 209, 22, 28, 100, 55, 233, 89, 43, 12, 157, 174,
-			199, 237, 103, 246, 80, 132, 179, 6, 144, 249, 76, 253, 76, 60,
-			88, 26, 138, 16, 23, 217, 129, 229, 120, 142, 215, 85, 139, 38,
-			212, 162, 120, 16, 23, 229, 238, 208, 73, 197, 63, 155, 161, 103,
-			91, 213, 31, 85, 107, 187, 213, 103, 229, 122, 189, 86, 191, 144,
-			98, 147, 84, 175, 253, 232, 130, 198, 46, 208, 51, 241, 84, 171,
-			85, 41, 93, 208, 115, 15, 208, 131, 92, 110, 133, 28, 177, 252,
-			158, 30, 196, 168, 33, 249, 208, 37, 31, 242, 59, 247, 30, 90,
-			97, 12, 145, 210, 105, 238, 111, 52, 202, 74, 188, 237, 90, 193,
-			17, 2, 15, 233, 57, 107, 223, 114, 92, 12, 164, 207, 18, 92,
-			211, 43, 55, 143, 24, 233, 228, 70, 179, 52, 20, 245, 179, 201,
-			86, 156, 201, 46, 82, 82, 26, 10, 100, 202, 179, 250, 60, 226,
-			86, 126, 39, 78, 166, 143, 156, 236, 161, 145, 214, 46, 232, 35,
-			166, 143, 208, 136, 152, 190, 72, 103, 182, 156, 80, 121, 71, 76,
-			57, 247, 59, 141, 178, 241, 209, 200, 205, 62, 163, 147, 146, 101,
-			116, 51, 148, 96, 238, 136, 4, 39, 55, 152, 202, 231, 162, 77,
-			217, 95, 105, 116, 66, 142, 176, 115, 84, 79, 116, 173, 159, 238,
-			95, 250, 119, 246, 175, 63, 246, 72, 230, 102, 232, 121, 41, 195,
-			200, 4, 185, 127, 209, 232, 133, 209, 88, 164, 134, 59, 145, 75,
-			40, 37, 220, 56, 169, 132, 177, 197, 210, 136, 114, 121, 214, 85,
-			182, 59, 46, 251, 28, 61, 55, 58, 22, 136, 41, 178, 96, 114,
-			88, 148, 202, 178, 52, 29, 159, 1, 41, 84, 186, 158, 192, 167,
-			9, 179, 242, 79, 137, 178, 119, 232, 244, 88, 4, 96, 215, 223,
-			17, 249, 178, 240, 174, 224, 161, 48, 38, 254, 127, 2, 227, 241,
-			35, 118, 2, 227, 137, 163, 179, 194, 233, 76, 197, 219, 231, 158,
-			240, 131, 195, 29, 245, 94, 21, 32, 153, 49, 143, 61, 70, 230,
-			228, 121, 57, 70, 230, 20, 103, 95, 249, 59, 141, 78, 85, 60,
-			172, 223, 4, 219, 166, 116, 228, 177, 236, 218, 91, 93, 89, 225,
-			190, 254, 14, 87, 103, 15, 104, 58, 182, 61, 187, 250, 22, 151,
-			80, 168, 62, 252, 86, 135, 89, 187, 241, 228, 250, 59, 242, 232,
-			195, 223, 206, 209, 41, 54, 97, 164, 126, 174, 105, 244, 159, 53,
-			249, 158, 108, 164, 216, 202, 175, 181, 35, 79, 195, 203, 171, 178,
-			99, 219, 106, 173, 87, 160, 56, 20, 61, 63, 8, 205, 183, 188,
-			15, 183, 66, 89, 226, 69, 175, 112, 163, 215, 84, 39, 132, 174,
-			191, 207, 3, 15, 187, 89, 207, 142, 30, 7, 139, 3, 171, 141,
-			136, 157, 54, 247, 176, 206, 125, 196, 131, 208, 241, 61, 88, 49,
-			151, 226, 26, 68, 213, 233, 29, 127, 232, 217, 241, 29, 248, 86,
-			101, 189, 92, 109, 148, 161, 227, 184, 88, 100, 100, 168, 78, 82,
-			140, 76, 166, 22, 162, 55, 140, 116, 234, 82, 244, 138, 64, 83,
-			119, 227, 151, 9, 252, 164, 84, 159, 76, 49, 227, 76, 234, 178,
-			134, 181, 229, 36, 214, 150, 103, 210, 103, 233, 63, 104, 212, 152,
-			196, 218, 146, 48, 189, 148, 253, 107, 89, 90, 198, 174, 138, 156,
-			183, 45, 215, 85, 109, 154, 138, 63, 88, 243, 4, 114, 9, 184,
-			206, 62, 247, 120, 168, 158, 6, 186, 92, 64, 169, 213, 164, 160,
-			14, 92, 31, 107, 83, 108, 181, 26, 92, 61, 221, 214, 203, 197,
-			210, 118, 89, 222, 129, 219, 92, 88, 142, 27, 98, 115, 38, 228,
-			3, 129, 39, 176, 78, 27, 61, 98, 75, 74, 178, 100, 163, 209,
-			203, 173, 73, 233, 25, 58, 49, 41, 171, 74, 194, 38, 103, 98,
-			72, 103, 132, 177, 143, 98, 136, 48, 194, 10, 107, 116, 75, 74,
-			164, 49, 242, 158, 94, 202, 126, 14, 99, 39, 229, 237, 2, 201,
-			37, 224, 31, 120, 60, 8, 123, 206, 0, 237, 88, 106, 53, 195,
-			132, 174, 134, 232, 18, 186, 168, 233, 247, 18, 186, 26, 97, 228,
-			189, 194, 154, 84, 177, 198, 140, 217, 212, 85, 165, 98, 220, 51,
-			155, 254, 128, 238, 81, 99, 82, 67, 13, 95, 209, 75, 217, 22,
-			140, 29, 41, 16, 220, 117, 85, 231, 31, 85, 117, 96, 237, 249,
-			67, 1, 150, 235, 42, 87, 226, 146, 13, 72, 242, 151, 44, 200,
-			149, 138, 145, 113, 37, 66, 196, 165, 38, 181, 115, 37, 226, 82,
-			147, 218, 185, 18, 113, 169, 73, 237, 92, 41, 172, 209, 191, 210,
-			168, 62, 169, 51, 3, 82, 55, 181, 236, 47, 53, 136, 78, 114,
-			194, 64, 244, 208, 29, 66, 125, 103, 61, 28, 189, 89, 96, 33,
-			189, 207, 193, 81, 171, 29, 223, 43, 216, 124, 111, 216, 237, 58,
-			94, 215, 148, 47, 15, 33, 87, 59, 162, 242, 58, 121, 106, 129,
-			182, 223, 31, 88, 194, 217, 115, 92, 71, 28, 130, 31, 96, 143,
-			26, 1, 221, 161, 21, 88, 158, 224, 82, 4, 84, 25, 90, 13,
-			210, 231, 233, 52, 53, 38, 117, 84, 217, 13, 189, 40, 249, 215,
-			165, 108, 55, 38, 47, 196, 144, 206, 200, 141, 153, 92, 12, 17,
-			70, 110, 44, 126, 30, 109, 211, 24, 201, 233, 247, 163, 41, 52,
-			66, 110, 242, 92, 12, 233, 140, 228, 206, 95, 139, 33, 194, 72,
-			110, 97, 21, 13, 103, 164, 152, 49, 151, 186, 163, 37, 125, 215,
-			92, 58, 75, 255, 44, 238, 187, 200, 188, 62, 155, 253, 6, 70,
-			21, 14, 58, 18, 26, 7, 107, 34, 136, 83, 140, 106, 163, 35,
-			247, 53, 1, 170, 252, 32, 246, 49, 117, 85, 66, 193, 229, 168,
-			29, 25, 33, 120, 127, 32, 14, 239, 131, 5, 30, 63, 80, 120,
-			14, 176, 59, 217, 227, 111, 193, 39, 109, 172, 218, 44, 50, 175,
-			167, 99, 72, 99, 100, 62, 115, 49, 134, 8, 35, 243, 151, 223,
-			167, 247, 163, 22, 139, 124, 162, 207, 101, 77, 56, 86, 188, 203,
-			11, 41, 249, 235, 130, 142, 124, 6, 180, 108, 216, 179, 92, 203,
-			107, 75, 91, 70, 168, 180, 73, 220, 125, 33, 134, 16, 215, 12,
-			196, 16, 97, 228, 147, 155, 31, 209, 71, 146, 140, 206, 72, 94,
-			191, 158, 173, 192, 137, 186, 65, 222, 231, 65, 111, 216, 183, 60,
-			232, 4, 14, 247, 108, 247, 16, 198, 231, 35, 23, 143, 47, 78,
-			143, 10, 170, 79, 32, 226, 88, 80, 148, 38, 159, 201, 198, 16,
-			97, 36, 255, 33, 218, 209, 48, 82, 36, 197, 140, 69, 125, 153,
-			168, 57, 130, 42, 89, 164, 179, 52, 164, 147, 8, 161, 249, 150,
-			140, 171, 89, 27, 198, 251, 2, 197, 90, 232, 200, 43, 93, 169,
-			130, 68, 63, 42, 14, 141, 238, 229, 122, 254, 1, 244, 45, 239,
-			144, 130, 240, 133, 229, 170, 3, 57, 10, 83, 24, 165, 195, 225,
-			0, 35, 162, 73, 233, 57, 58, 165, 136, 78, 32, 213, 49, 88,
-			99, 100, 105, 250, 253, 17, 76, 24, 89, 202, 94, 161, 127, 161,
-			92, 140, 48, 114, 91, 103, 217, 255, 175, 1, 150, 29, 170, 21,
-			149, 214, 25, 209, 177, 186, 220, 147, 23, 176, 142, 12, 99, 137,
-			253, 74, 173, 102, 33, 90, 209, 233, 56, 158, 35, 14, 77, 170,
-			120, 148, 45, 112, 104, 245, 249, 56, 210, 211, 157, 204, 9, 143,
-			41, 159, 76, 32, 71, 177, 242, 137, 198, 200, 237, 204, 217, 24,
-			66, 110, 47, 204, 200, 99, 163, 49, 227, 110, 170, 170, 142, 13,
-			58, 201, 221, 244, 21, 106, 81, 195, 144, 241, 110, 85, 191, 148,
-			109, 130, 106, 142, 162, 164, 17, 5, 59, 53, 20, 155, 223, 114,
-			93, 19, 160, 34, 47, 146, 157, 62, 46, 179, 60, 121, 239, 214,
-			238, 241, 246, 151, 52, 185, 27, 1, 30, 4, 152, 127, 21, 147,
-			154, 158, 154, 68, 26, 233, 24, 210, 24, 89, 205, 156, 143, 33,
-			194, 200, 42, 187, 40, 61, 68, 195, 211, 125, 79, 95, 83, 30,
-			162, 201, 243, 125, 111, 234, 44, 253, 185, 78, 39, 17, 68, 94,
-			63, 55, 46, 103, 127, 171, 193, 145, 62, 40, 190, 225, 244, 124,
-			145, 252, 32, 199, 243, 131, 190, 229, 186, 135, 9, 195, 210, 66,
-			188, 99, 13, 93, 65, 35, 29, 59, 157, 113, 41, 157, 16, 228,
-			15, 109, 188, 46, 6, 191, 161, 247, 165, 231, 31, 120, 38, 28,
-			189, 232, 84, 91, 104, 18, 133, 135, 33, 15, 163, 216, 192, 189,
-			97, 63, 66, 156, 100, 200, 182, 235, 200, 3, 227, 243, 80, 114,
-			135, 56, 105, 148, 59, 14, 121, 244, 36, 16, 45, 146, 22, 31,
-			134, 124, 156, 83, 133, 47, 242, 87, 45, 138, 35, 159, 27, 51,
-			35, 88, 103, 228, 243, 75, 239, 209, 179, 145, 134, 52, 70, 126,
-			104, 76, 39, 211, 154, 132, 39, 71, 176, 206, 200, 15, 51, 52,
-			89, 174, 51, 82, 52, 222, 75, 166, 113, 123, 209, 184, 48, 130,
-			113, 254, 226, 37, 250, 183, 154, 116, 21, 141, 145, 13, 125, 54,
-			251, 151, 218, 119, 141, 176, 149, 206, 248, 142, 3, 43, 68, 5,
-			138, 184, 86, 10, 84, 169, 24, 253, 58, 172, 227, 112, 215, 86,
-			202, 136, 126, 162, 213, 83, 177, 152, 171, 51, 162, 52, 236, 7,
-			20, 77, 237, 171, 31, 216, 37, 158, 166, 77, 32, 139, 177, 167,
-			161, 244, 27, 81, 208, 213, 100, 52, 220, 184, 252, 62, 221, 144,
-			178, 232, 140, 108, 234, 75, 217, 85, 56, 214, 138, 161, 60, 242,
-			170, 125, 60, 224, 141, 106, 37, 181, 156, 143, 124, 91, 159, 68,
-			68, 87, 98, 72, 99, 100, 243, 234, 247, 99, 136, 48, 178, 105,
-			22, 232, 15, 37, 69, 194, 200, 67, 253, 163, 236, 45, 56, 114,
-			47, 32, 131, 252, 168, 126, 248, 150, 148, 162, 233, 196, 64, 20,
-			9, 52, 193, 200, 195, 233, 153, 24, 210, 24, 121, 200, 174, 199,
-			16, 18, 203, 221, 164, 129, 164, 108, 48, 178, 173, 127, 148, 69,
-			116, 99, 151, 13, 71, 41, 31, 43, 234, 162, 19, 37, 55, 152,
-			16, 71, 51, 26, 191, 85, 88, 16, 14, 247, 208, 132, 126, 231,
-			168, 56, 9, 175, 134, 36, 154, 64, 19, 140, 108, 39, 188, 26,
-			26, 35, 219, 9, 175, 6, 97, 100, 59, 119, 83, 134, 41, 157,
-			25, 59, 169, 71, 42, 76, 161, 46, 119, 210, 89, 250, 3, 106,
-			24, 178, 198, 168, 235, 179, 217, 194, 119, 115, 61, 69, 95, 151,
-			97, 190, 30, 249, 133, 42, 81, 234, 145, 95, 168, 162, 164, 126,
-			249, 125, 250, 84, 210, 209, 24, 105, 233, 87, 178, 85, 144, 42,
-			26, 213, 156, 73, 28, 193, 99, 108, 121, 42, 196, 97, 56, 176,
-			80, 127, 201, 196, 136, 11, 122, 10, 27, 154, 129, 216, 19, 104,
-			130, 145, 86, 164, 20, 85, 0, 181, 216, 229, 24, 34, 140, 180,
-			62, 200, 98, 103, 128, 250, 217, 77, 93, 147, 58, 65, 43, 239,
-			166, 175, 72, 93, 25, 204, 120, 156, 114, 148, 174, 80, 163, 143,
-			211, 89, 250, 127, 241, 59, 195, 200, 83, 253, 108, 118, 69, 137,
-			128, 41, 131, 15, 2, 46, 159, 113, 76, 144, 221, 207, 209, 27,
-			26, 44, 22, 5, 183, 240, 20, 77, 83, 195, 48, 50, 41, 70,
-			158, 78, 159, 145, 156, 24, 25, 84, 214, 24, 164, 43, 72, 18,
-			165, 140, 252, 68, 103, 106, 19, 77, 49, 242, 19, 41, 140, 97,
-			24, 152, 233, 127, 166, 219, 42, 142, 27, 50, 211, 255, 140, 158,
-			165, 55, 232, 36, 66, 104, 203, 231, 198, 165, 44, 75, 126, 120,
-			25, 121, 97, 20, 231, 140, 40, 47, 63, 55, 198, 96, 141, 145,
-			231, 211, 231, 71, 48, 97, 228, 57, 187, 72, 255, 68, 139, 112,
-			106, 140, 180, 141, 75, 89, 49, 158, 67, 199, 48, 195, 239, 153,
-			144, 155, 106, 189, 44, 59, 198, 60, 202, 138, 142, 197, 105, 169,
-			122, 140, 107, 180, 104, 123, 140, 107, 180, 105, 123, 140, 107, 180,
-			106, 155, 93, 196, 54, 214, 48, 12, 212, 67, 79, 207, 101, 255,
-			81, 59, 97, 16, 60, 97, 241, 207, 100, 229, 241, 236, 91, 54,
-			63, 210, 93, 196, 45, 133, 116, 75, 108, 205, 44, 199, 11, 199,
-			187, 58, 112, 60, 245, 76, 129, 5, 28, 202, 107, 69, 138, 144,
-			248, 162, 192, 169, 46, 200, 71, 191, 202, 141, 170, 14, 170, 14,
-			62, 183, 101, 203, 104, 115, 151, 143, 130, 172, 161, 167, 12, 228,
-			59, 129, 38, 25, 233, 77, 159, 139, 33, 141, 145, 222, 249, 15,
-			99, 136, 48, 210, 3, 249, 43, 57, 140, 0, 95, 68, 94, 60,
-			161, 49, 242, 69, 250, 138, 28, 158, 100, 196, 77, 93, 149, 195,
-			147, 26, 35, 110, 250, 3, 233, 220, 83, 204, 232, 167, 134, 202,
-			185, 167, 52, 70, 250, 233, 172, 116, 173, 41, 116, 45, 79, 15,
-			149, 107, 77, 73, 215, 242, 232, 121, 153, 208, 166, 148, 107, 249,
-			6, 147, 10, 159, 138, 220, 200, 143, 12, 50, 21, 185, 145, 63,
-			125, 118, 4, 19, 70, 252, 11, 51, 201, 118, 141, 145, 129, 177,
-			146, 76, 99, 113, 61, 48, 62, 28, 193, 56, 127, 109, 113, 4,
-			19, 70, 6, 75, 203, 201, 118, 157, 145, 23, 198, 141, 100, 26,
-			43, 227, 23, 99, 212, 17, 253, 139, 233, 171, 35, 152, 48, 242,
-			226, 58, 36, 219, 9, 35, 129, 113, 41, 153, 198, 0, 31, 140,
-			109, 199, 195, 31, 68, 222, 36, 97, 92, 207, 46, 202, 243, 55,
-			133, 146, 11, 253, 170, 82, 139, 52, 145, 136, 76, 52, 37, 77,
-			36, 166, 47, 196, 144, 198, 136, 152, 121, 63, 134, 8, 35, 34,
-			171, 108, 145, 102, 228, 32, 149, 149, 58, 79, 107, 140, 28, 164,
-			223, 167, 211, 84, 55, 50, 108, 226, 165, 188, 125, 193, 137, 140,
-			198, 200, 203, 244, 172, 52, 70, 6, 141, 113, 168, 127, 173, 140,
-			145, 145, 198, 56, 164, 103, 165, 60, 25, 101, 140, 87, 145, 49,
-			50, 145, 49, 94, 69, 242, 100, 34, 99, 188, 138, 140, 145, 137,
-			140, 241, 42, 50, 70, 70, 25, 227, 181, 113, 45, 153, 198, 195,
-			245, 122, 108, 59, 26, 227, 245, 244, 7, 35, 152, 48, 242, 250,
-			234, 135, 201, 118, 157, 145, 175, 140, 203, 201, 52, 26, 227, 43,
-			35, 61, 130, 53, 70, 190, 202, 204, 140, 96, 194, 200, 87, 81,
-			37, 149, 81, 198, 120, 19, 25, 35, 19, 25, 227, 205, 24, 117,
-			52, 198, 155, 200, 24, 153, 200, 24, 111, 34, 99, 100, 80, 242,
-			111, 244, 89, 165, 22, 105, 140, 111, 34, 99, 100, 164, 49, 190,
-			137, 206, 75, 70, 234, 224, 155, 243, 23, 99, 136, 48, 242, 205,
-			229, 247, 227, 103, 166, 255, 13, 0, 0, 255, 255, 195, 197, 224,
-			80, 61, 50, 0, 0},
+			8, 0, 0, 0, 0, 0, 0, 255, 172, 123, 219, 147, 27, 199,
+			185, 31, 102, 122, 0, 98, 123, 121, 217, 109, 82, 20, 5, 222,
+			62, 130, 90, 115, 87, 198, 14, 118, 151, 162, 28, 146, 86, 116,
+			176, 11, 144, 4, 189, 4, 86, 184, 136, 34, 85, 54, 57, 139,
+			105, 0, 115, 52, 152, 129, 103, 6, 187, 92, 201, 146, 227, 36,
+			229, 228, 84, 78, 114, 142, 157, 196, 57, 78, 226, 99, 91, 81,
+			146, 170, 147, 231, 92, 42, 111, 201, 67, 242, 146, 170, 164, 42,
+			85, 169, 202, 31, 224, 202, 107, 170, 242, 146, 199, 212, 215, 221,
+			51, 3, 236, 69, 58, 58, 39, 52, 85, 198, 247, 77, 247, 119,
+			235, 95, 127, 253, 245, 133, 244, 191, 151, 233, 245, 129, 239, 15,
+			92, 94, 30, 7, 126, 228, 239, 78, 250, 229, 200, 25, 241, 48,
+			178, 70, 99, 83, 176, 216, 57, 217, 192, 140, 27, 20, 239, 211,
+			185, 78, 220, 134, 93, 162, 167, 66, 222, 243, 61, 59, 188, 164,
+			129, 182, 76, 90, 49, 201, 46, 208, 172, 103, 121, 126, 120, 73,
+			7, 109, 57, 219, 146, 196, 230, 231, 244, 124, 207, 31, 153, 135,
+			100, 110, 158, 77, 36, 238, 32, 107, 71, 123, 254, 237, 129, 19,
+			13, 39, 187, 102, 207, 31, 149, 7, 190, 107, 121, 131, 212, 196,
+			113, 116, 48, 230, 97, 106, 233, 255, 213, 180, 47, 116, 242, 112,
+			103, 243, 207, 244, 107, 15, 165, 228, 29, 37, 217, 124, 202, 93,
+			247, 123, 158, 191, 239, 117, 176, 207, 227, 255, 185, 74, 79, 177,
+			236, 181, 204, 31, 104, 26, 253, 47, 167, 169, 118, 154, 145, 107,
+			25, 182, 241, 31, 78, 131, 232, 209, 243, 93, 216, 156, 244, 251,
+			60, 8, 97, 21, 164, 172, 91, 33, 216, 86, 100, 129, 227, 69,
+			60, 232, 13, 45, 111, 192, 161, 239, 7, 35, 43, 162, 176, 229,
+			143, 15, 2, 103, 48, 140, 96, 99, 109, 237, 175, 168, 14, 80,
+			247, 122, 38, 64, 197, 117, 65, 124, 11, 33, 224, 33, 15, 246,
+			184, 109, 82, 24, 70, 209, 56, 188, 87, 46, 219, 124, 143, 187,
+			254, 152, 7, 97, 28, 12, 244, 116, 172, 140, 88, 221, 149, 70,
+			148, 41, 133, 22, 183, 157, 48, 10, 156, 221, 73, 228, 248, 30,
+			88, 158, 13, 147, 144, 131, 227, 65, 232, 79, 130, 30, 23, 156,
+			93, 199, 179, 130, 3, 97, 87, 88, 130, 125, 39, 26, 130, 31,
+			136, 255, 247, 39, 17, 133, 145, 111, 59, 125, 167, 103, 161, 132,
+			18, 88, 1, 135, 49, 15, 70, 78, 20, 113, 27, 198, 129, 191,
+			231, 216, 220, 134, 104, 104, 69, 16, 13, 209, 59, 215, 245, 247,
+			29, 111, 0, 56, 178, 14, 118, 10, 177, 19, 133, 17, 143, 238,
+			81, 10, 248, 231, 173, 67, 134, 133, 224, 247, 99, 139, 122, 190,
+			205, 97, 52, 9, 35, 8, 120, 100, 57, 158, 144, 106, 237, 250,
+			123, 248, 73, 69, 140, 130, 231, 71, 78, 143, 151, 32, 26, 58,
+			33, 184, 78, 24, 161, 132, 105, 141, 158, 125, 200, 28, 219, 9,
+			123, 174, 229, 140, 120, 96, 158, 100, 132, 227, 77, 199, 34, 54,
+			98, 28, 248, 246, 164, 199, 83, 59, 104, 106, 200, 95, 202, 14,
+			10, 202, 59, 219, 239, 77, 70, 220, 139, 172, 120, 144, 202, 126,
+			0, 126, 52, 228, 1, 140, 172, 136, 7, 142, 229, 134, 105, 168,
+			113, 96, 80, 38, 133, 105, 235, 19, 167, 26, 220, 17, 61, 81,
+			176, 103, 141, 56, 26, 52, 141, 45, 207, 79, 191, 137, 184, 59,
+			81, 136, 30, 121, 82, 148, 31, 132, 48, 178, 14, 96, 151, 35,
+			82, 108, 136, 124, 224, 158, 237, 7, 33, 71, 80, 140, 3, 127,
+			228, 71, 28, 141, 177, 39, 189, 40, 4, 155, 7, 206, 30, 183,
+			161, 31, 248, 35, 42, 163, 16, 250, 253, 104, 31, 97, 162, 16,
+			4, 225, 152, 247, 16, 65, 48, 14, 28, 4, 86, 128, 216, 241,
+			36, 138, 194, 80, 216, 78, 161, 243, 168, 222, 134, 118, 243, 65,
+			231, 105, 165, 85, 131, 122, 27, 118, 90, 205, 15, 234, 213, 90,
+			21, 54, 159, 65, 231, 81, 13, 182, 154, 59, 207, 90, 245, 135,
+			143, 58, 240, 168, 185, 93, 173, 181, 218, 80, 105, 84, 97, 171,
+			217, 232, 180, 234, 155, 221, 78, 179, 213, 166, 80, 172, 180, 161,
+			222, 46, 138, 47, 149, 198, 51, 168, 125, 184, 211, 170, 181, 219,
+			208, 108, 65, 253, 201, 206, 118, 189, 86, 133, 167, 149, 86, 171,
+			210, 232, 212, 107, 237, 18, 212, 27, 91, 219, 221, 106, 189, 241,
+			176, 4, 155, 221, 14, 52, 154, 29, 10, 219, 245, 39, 245, 78,
+			173, 10, 157, 102, 73, 168, 61, 218, 15, 154, 15, 224, 73, 173,
+			181, 245, 168, 210, 232, 84, 54, 235, 219, 245, 206, 51, 161, 240,
+			65, 189, 211, 64, 101, 15, 154, 45, 10, 21, 216, 169, 180, 58,
+			245, 173, 238, 118, 165, 5, 59, 221, 214, 78, 179, 93, 3, 244,
+			172, 90, 111, 111, 109, 87, 234, 79, 106, 85, 19, 234, 13, 104,
+			52, 161, 246, 65, 173, 209, 129, 246, 163, 202, 246, 246, 172, 163,
+			20, 154, 79, 27, 181, 22, 90, 63, 237, 38, 108, 214, 96, 187,
+			94, 217, 220, 174, 193, 131, 102, 75, 248, 89, 173, 183, 106, 91,
+			29, 116, 40, 253, 181, 85, 175, 214, 26, 157, 202, 118, 137, 66,
+			123, 167, 182, 85, 175, 108, 151, 160, 246, 97, 237, 201, 206, 118,
+			165, 245, 172, 164, 132, 182, 107, 239, 119, 107, 141, 78, 189, 178,
+			13, 213, 202, 147, 202, 195, 90, 27, 150, 191, 46, 42, 59, 173,
+			230, 86, 183, 85, 123, 130, 86, 55, 31, 64, 187, 187, 217, 238,
+			212, 59, 221, 78, 13, 30, 54, 155, 85, 17, 236, 118, 173, 245,
+			65, 125, 171, 214, 190, 15, 219, 77, 12, 255, 3, 232, 182, 107,
+			37, 10, 213, 74, 167, 34, 84, 239, 180, 154, 15, 234, 157, 246,
+			125, 252, 189, 217, 109, 215, 69, 224, 234, 141, 78, 173, 213, 234,
+			238, 116, 234, 205, 198, 10, 60, 106, 62, 173, 125, 80, 107, 193,
+			86, 165, 219, 174, 85, 69, 132, 155, 13, 244, 22, 177, 82, 107,
+			182, 158, 161, 88, 140, 131, 24, 129, 18, 60, 125, 84, 235, 60,
+			170, 181, 48, 168, 34, 90, 21, 12, 67, 187, 211, 170, 111, 117,
+			166, 155, 53, 91, 208, 105, 182, 58, 116, 202, 79, 104, 212, 30,
+			110, 215, 31, 214, 26, 91, 53, 180, 167, 137, 98, 158, 214, 219,
+			181, 21, 168, 180, 234, 109, 108, 80, 23, 138, 225, 105, 229, 25,
+			52, 187, 194, 107, 28, 168, 110, 187, 70, 229, 239, 41, 232, 150,
+			196, 120, 66, 253, 1, 84, 170, 31, 212, 209, 114, 213, 122, 167,
+			217, 110, 215, 21, 92, 68, 216, 182, 30, 169, 152, 155, 148, 230,
+			169, 166, 51, 2, 153, 75, 248, 43, 207, 72, 49, 115, 159, 206,
+			81, 61, 191, 36, 127, 74, 230, 205, 204, 117, 193, 188, 46, 127,
+			74, 230, 155, 153, 77, 193, 156, 151, 63, 37, 115, 41, 83, 18,
+			76, 77, 254, 148, 204, 111, 101, 202, 130, 169, 126, 74, 230, 173,
+			76, 81, 48, 169, 252, 41, 153, 203, 153, 27, 130, 249, 166, 252,
+			249, 127, 46, 83, 221, 200, 176, 236, 231, 184, 242, 21, 126, 119,
+			25, 42, 144, 44, 185, 34, 63, 242, 144, 123, 81, 8, 22, 140,
+			125, 199, 139, 68, 86, 115, 70, 184, 202, 216, 124, 204, 61, 155,
+			123, 34, 59, 91, 222, 1, 224, 178, 11, 159, 248, 158, 72, 38,
+			174, 223, 179, 92, 10, 61, 203, 229, 158, 109, 5, 37, 224, 30,
+			38, 127, 27, 44, 148, 213, 243, 39, 178, 159, 42, 10, 68, 42,
+			237, 7, 86, 47, 93, 48, 226, 15, 17, 5, 81, 33, 8, 26,
+			23, 76, 223, 149, 57, 17, 58, 67, 174, 4, 57, 184, 146, 186,
+			86, 228, 236, 113, 204, 105, 150, 7, 124, 236, 247, 134, 96, 69,
+			208, 237, 108, 193, 200, 177, 61, 145, 208, 125, 143, 194, 99, 203,
+			155, 224, 138, 184, 94, 130, 245, 187, 223, 89, 43, 197, 121, 122,
+			28, 248, 46, 31, 71, 78, 15, 30, 6, 124, 224, 7, 142, 229,
+			37, 214, 195, 254, 208, 233, 13, 129, 191, 138, 56, 26, 43, 242,
+			243, 49, 173, 118, 173, 222, 199, 251, 86, 128, 45, 124, 56, 224,
+			86, 0, 190, 199, 77, 74, 197, 138, 63, 114, 188, 73, 196, 197,
+			114, 9, 239, 172, 37, 254, 185, 190, 55, 48, 97, 155, 91, 227,
+			212, 229, 128, 67, 49, 28, 113, 43, 224, 118, 17, 66, 95, 174,
+			191, 158, 15, 46, 183, 198, 84, 53, 131, 200, 218, 117, 57, 56,
+			33, 120, 156, 99, 92, 251, 126, 32, 43, 145, 49, 46, 173, 24,
+			161, 18, 76, 66, 92, 28, 45, 248, 104, 227, 237, 213, 161, 63,
+			9, 192, 117, 60, 110, 5, 20, 132, 244, 239, 47, 127, 117, 205,
+			129, 227, 89, 22, 45, 87, 208, 9, 12, 119, 32, 138, 28, 39,
+			20, 75, 2, 172, 173, 173, 173, 175, 138, 191, 157, 181, 181, 123,
+			226, 239, 115, 116, 253, 238, 221, 187, 119, 87, 215, 55, 86, 111,
+			175, 119, 54, 110, 223, 187, 115, 247, 222, 157, 187, 230, 221, 248,
+			207, 115, 19, 54, 15, 40, 14, 100, 20, 56, 189, 8, 13, 140,
+			148, 139, 66, 122, 9, 246, 57, 112, 47, 156, 4, 184, 42, 91,
+			17, 146, 61, 28, 11, 223, 219, 227, 65, 132, 242, 37, 88, 252,
+			17, 124, 212, 122, 176, 69, 225, 246, 237, 219, 119, 83, 95, 246,
+			247, 247, 77, 135, 71, 125, 211, 15, 6, 229, 160, 223, 195, 255,
+			176, 133, 25, 189, 138, 86, 176, 96, 227, 128, 101, 129, 55, 8,
+			209, 169, 155, 80, 123, 101, 141, 198, 46, 15, 41, 141, 127, 194,
+			250, 61, 216, 242, 71, 227, 73, 196, 167, 230, 130, 80, 184, 211,
+			108, 215, 63, 132, 151, 24, 153, 229, 149, 151, 166, 170, 120, 210,
+			70, 73, 229, 121, 95, 126, 73, 104, 51, 228, 209, 11, 53, 192,
+			203, 200, 93, 110, 116, 183, 183, 87, 86, 142, 109, 39, 240, 190,
+			188, 182, 114, 127, 202, 166, 141, 175, 179, 105, 192, 35, 148, 235,
+			247, 109, 235, 96, 202, 182, 48, 10, 38, 189, 72, 40, 216, 179,
+			92, 136, 246, 148, 198, 153, 230, 223, 138, 246, 74, 32, 12, 186,
+			255, 23, 117, 105, 207, 140, 246, 208, 193, 175, 242, 72, 54, 154,
+			132, 188, 7, 111, 193, 250, 218, 218, 172, 135, 183, 79, 244, 240,
+			169, 227, 221, 222, 128, 151, 15, 121, 212, 62, 8, 35, 62, 194,
+			207, 149, 240, 129, 227, 242, 206, 236, 64, 60, 168, 111, 215, 58,
+			245, 39, 53, 232, 71, 202, 140, 147, 250, 124, 171, 31, 197, 150,
+			118, 235, 141, 206, 59, 111, 67, 228, 244, 62, 14, 225, 93, 88,
+			94, 94, 150, 156, 149, 126, 100, 218, 251, 143, 156, 193, 176, 106,
+			69, 162, 215, 10, 124, 247, 187, 112, 123, 99, 5, 126, 4, 226,
+			219, 182, 191, 31, 127, 138, 227, 86, 46, 67, 5, 158, 58, 158,
+			237, 239, 135, 66, 36, 206, 208, 245, 181, 181, 169, 28, 22, 154,
+			73, 3, 153, 165, 214, 223, 57, 58, 141, 18, 105, 216, 125, 253,
+			157, 183, 223, 126, 251, 59, 183, 223, 89, 75, 211, 198, 46, 239,
+			251, 1, 135, 174, 231, 188, 82, 185, 14, 147, 217, 97, 41, 230,
+			95, 108, 48, 151, 165, 255, 176, 188, 140, 30, 132, 80, 22, 131,
+			133, 127, 87, 96, 117, 218, 156, 175, 65, 48, 202, 185, 189, 145,
+			202, 89, 154, 146, 35, 0, 176, 50, 3, 128, 183, 79, 4, 192,
+			99, 107, 207, 130, 151, 114, 240, 205, 222, 36, 8, 184, 23, 97,
+			147, 39, 142, 235, 58, 225, 20, 0, 48, 155, 194, 72, 112, 225,
+			93, 56, 185, 195, 87, 192, 28, 222, 77, 185, 166, 199, 247, 55,
+			39, 142, 107, 243, 96, 121, 5, 29, 107, 171, 8, 41, 21, 50,
+			48, 43, 82, 22, 254, 15, 219, 52, 4, 214, 151, 29, 47, 66,
+			207, 85, 75, 233, 186, 114, 27, 67, 176, 178, 98, 238, 162, 100,
+			97, 75, 26, 131, 59, 39, 198, 64, 121, 17, 175, 190, 176, 115,
+			16, 13, 101, 117, 61, 19, 254, 105, 243, 151, 87, 14, 125, 52,
+			31, 242, 104, 43, 141, 198, 242, 138, 200, 128, 143, 219, 205, 6,
+			60, 177, 198, 99, 199, 27, 80, 10, 117, 79, 114, 112, 203, 104,
+			69, 184, 11, 155, 182, 5, 119, 216, 136, 233, 153, 229, 92, 38,
+			84, 181, 146, 82, 145, 150, 191, 81, 86, 150, 170, 76, 232, 224,
+			66, 231, 132, 66, 39, 85, 123, 105, 84, 86, 252, 20, 87, 211,
+			207, 86, 63, 29, 249, 94, 52, 252, 108, 245, 83, 219, 58, 248,
+			172, 243, 41, 46, 105, 159, 221, 251, 116, 228, 120, 159, 221, 251,
+			52, 228, 189, 207, 62, 50, 63, 197, 34, 2, 129, 252, 217, 247,
+			159, 23, 41, 236, 15, 121, 192, 65, 246, 70, 65, 150, 187, 111,
+			29, 132, 192, 95, 97, 93, 131, 59, 32, 185, 66, 246, 113, 109,
+			180, 157, 129, 19, 133, 184, 212, 187, 28, 148, 166, 18, 8, 85,
+			37, 10, 82, 89, 9, 132, 182, 146, 168, 87, 132, 74, 177, 90,
+			127, 194, 3, 127, 117, 108, 217, 24, 16, 92, 204, 246, 253, 88,
+			26, 183, 122, 67, 244, 139, 39, 213, 13, 86, 69, 106, 162, 149,
+			84, 93, 209, 179, 60, 24, 248, 48, 25, 227, 226, 118, 55, 238,
+			186, 236, 152, 220, 84, 204, 245, 227, 107, 160, 149, 18, 21, 250,
+			253, 49, 82, 150, 43, 53, 21, 159, 23, 33, 156, 244, 251, 206,
+			43, 172, 210, 156, 158, 133, 101, 7, 142, 34, 130, 68, 212, 103,
+			203, 197, 110, 103, 171, 184, 114, 127, 134, 75, 49, 64, 1, 255,
+			225, 196, 9, 184, 109, 66, 5, 247, 129, 145, 127, 91, 130, 33,
+			20, 27, 85, 231, 19, 30, 64, 56, 244, 39, 174, 29, 135, 18,
+			79, 28, 186, 157, 45, 88, 182, 194, 68, 155, 13, 187, 7, 20,
+			138, 207, 139, 43, 56, 0, 30, 110, 13, 61, 185, 208, 31, 133,
+			18, 6, 210, 154, 81, 53, 182, 130, 48, 85, 179, 203, 41, 136,
+			74, 7, 215, 253, 94, 143, 143, 35, 216, 245, 163, 161, 168, 235,
+			176, 175, 220, 73, 199, 62, 132, 71, 236, 0, 203, 3, 191, 223,
+			15, 121, 36, 138, 152, 7, 126, 0, 92, 46, 169, 37, 40, 110,
+			172, 173, 127, 103, 117, 109, 125, 117, 253, 78, 103, 109, 253, 222,
+			237, 181, 123, 235, 119, 204, 181, 245, 231, 69, 133, 238, 16, 4,
+			157, 36, 221, 177, 21, 70, 20, 68, 75, 161, 223, 247, 210, 106,
+			242, 78, 9, 80, 154, 169, 38, 144, 181, 103, 181, 123, 129, 51,
+			142, 74, 88, 3, 206, 20, 48, 22, 224, 162, 1, 254, 238, 239,
+			115, 92, 152, 177, 246, 193, 130, 74, 130, 93, 226, 81, 192, 63,
+			140, 44, 172, 42, 109, 10, 31, 69, 126, 189, 221, 108, 139, 73,
+			182, 188, 114, 76, 217, 102, 142, 252, 79, 28, 215, 181, 68, 205,
+			195, 189, 213, 110, 187, 108, 251, 189, 176, 252, 148, 239, 150, 83,
+			83, 202, 45, 222, 231, 1, 247, 122, 188, 252, 208, 245, 119, 45,
+			247, 69, 83, 216, 16, 150, 209, 160, 242, 148, 146, 21, 113, 160,
+			51, 244, 109, 19, 179, 129, 204, 52, 37, 176, 18, 147, 224, 37,
+			214, 81, 24, 116, 51, 254, 241, 50, 118, 8, 93, 221, 229, 177,
+			183, 220, 166, 199, 186, 72, 225, 163, 151, 97, 20, 244, 69, 215,
+			41, 143, 252, 94, 104, 142, 133, 62, 225, 203, 70, 217, 117, 118,
+			3, 43, 56, 16, 103, 122, 230, 48, 26, 185, 55, 197, 175, 184,
+			239, 138, 56, 202, 162, 9, 144, 99, 37, 120, 44, 1, 183, 150,
+			158, 173, 46, 141, 86, 151, 236, 206, 210, 163, 123, 75, 79, 238,
+			45, 181, 205, 165, 254, 243, 91, 38, 108, 59, 31, 243, 125, 39,
+			228, 162, 248, 199, 0, 165, 163, 52, 9, 185, 148, 246, 216, 183,
+			45, 1, 214, 91, 33, 124, 244, 178, 222, 110, 198, 75, 253, 3,
+			161, 65, 56, 174, 202, 143, 239, 47, 203, 227, 59, 149, 231, 126,
+			223, 183, 229, 72, 224, 143, 85, 180, 178, 108, 141, 29, 49, 32,
+			49, 87, 184, 83, 150, 182, 150, 143, 202, 22, 126, 198, 10, 150,
+			54, 170, 75, 27, 85, 10, 43, 24, 72, 127, 87, 28, 155, 89,
+			202, 207, 136, 7, 208, 179, 198, 98, 130, 248, 125, 24, 112, 143,
+			7, 150, 156, 106, 241, 52, 195, 105, 57, 29, 127, 147, 138, 63,
+			196, 200, 104, 140, 124, 158, 95, 164, 191, 210, 168, 97, 100, 244,
+			12, 51, 254, 186, 166, 95, 40, 252, 61, 13, 90, 233, 182, 47,
+			134, 190, 223, 23, 136, 71, 179, 33, 116, 188, 222, 116, 233, 65,
+			143, 175, 61, 224, 9, 30, 177, 237, 242, 175, 220, 43, 208, 227,
+			54, 11, 207, 193, 241, 122, 238, 36, 116, 246, 112, 247, 116, 134,
+			102, 209, 188, 172, 176, 239, 84, 76, 106, 72, 230, 207, 197, 36,
+			65, 146, 157, 167, 191, 147, 206, 104, 204, 248, 219, 154, 206, 10,
+			255, 67, 131, 134, 239, 173, 122, 124, 32, 55, 135, 113, 18, 22,
+			14, 89, 202, 59, 220, 38, 30, 155, 94, 77, 104, 168, 142, 201,
+			174, 107, 207, 114, 39, 60, 20, 160, 155, 18, 38, 14, 19, 195,
+			200, 113, 93, 24, 90, 123, 28, 188, 105, 157, 66, 180, 234, 136,
+			208, 178, 34, 181, 107, 237, 251, 1, 238, 22, 227, 45, 245, 225,
+			128, 169, 157, 84, 73, 253, 71, 143, 9, 138, 150, 21, 126, 198,
+			65, 209, 132, 219, 249, 51, 49, 73, 144, 92, 88, 220, 205, 201,
+			244, 74, 255, 235, 35, 186, 234, 120, 253, 192, 42, 91, 227, 49,
+			247, 6, 142, 199, 203, 118, 224, 123, 124, 245, 135, 19, 206, 61,
+			68, 105, 25, 207, 163, 157, 158, 58, 129, 103, 243, 226, 243, 11,
+			241, 185, 240, 117, 55, 2, 197, 127, 111, 80, 214, 226, 99, 63,
+			136, 170, 216, 173, 197, 127, 56, 225, 97, 196, 174, 82, 42, 197,
+			76, 38, 142, 45, 110, 3, 230, 90, 115, 130, 211, 157, 56, 54,
+			123, 74, 207, 185, 190, 101, 191, 80, 89, 219, 15, 228, 205, 192,
+			252, 134, 105, 78, 105, 55, 143, 10, 54, 183, 125, 203, 174, 39,
+			189, 90, 103, 221, 25, 154, 125, 155, 46, 74, 1, 54, 15, 69,
+			46, 118, 124, 239, 18, 17, 234, 23, 196, 135, 106, 202, 103, 140,
+			26, 67, 103, 143, 95, 50, 196, 119, 241, 155, 125, 159, 94, 28,
+			7, 124, 207, 241, 39, 161, 123, 240, 98, 232, 135, 17, 183, 95,
+			216, 147, 40, 188, 148, 5, 178, 60, 191, 113, 235, 235, 12, 172,
+			78, 162, 71, 142, 23, 181, 46, 164, 98, 30, 9, 41, 213, 73,
+			20, 178, 135, 116, 113, 215, 143, 94, 96, 29, 245, 98, 143, 7,
+			120, 88, 26, 94, 202, 9, 201, 151, 103, 36, 111, 250, 209, 150,
+			111, 243, 15, 100, 155, 214, 185, 221, 25, 58, 100, 43, 116, 97,
+			226, 89, 123, 150, 227, 98, 34, 144, 22, 158, 2, 178, 60, 215,
+			58, 55, 197, 71, 157, 133, 219, 244, 236, 108, 212, 216, 13, 122,
+			218, 158, 68, 47, 48, 139, 244, 156, 232, 64, 140, 207, 153, 214,
+			188, 61, 137, 182, 20, 171, 208, 163, 167, 148, 39, 24, 38, 60,
+			91, 86, 163, 40, 126, 179, 42, 93, 112, 173, 48, 138, 3, 132,
+			88, 86, 35, 88, 136, 143, 19, 98, 200, 152, 201, 234, 223, 58,
+			139, 125, 100, 52, 144, 89, 252, 46, 61, 59, 235, 39, 91, 160,
+			196, 158, 68, 74, 21, 254, 196, 75, 37, 21, 40, 161, 96, 174,
+			21, 147, 197, 223, 233, 244, 252, 204, 0, 132, 99, 223, 11, 57,
+			123, 143, 230, 194, 200, 138, 38, 242, 22, 234, 236, 87, 13, 153,
+			236, 97, 182, 69, 243, 150, 234, 118, 8, 188, 250, 97, 240, 110,
+			209, 115, 252, 213, 216, 193, 164, 235, 123, 210, 117, 242, 245, 174,
+			167, 93, 144, 201, 110, 210, 51, 86, 24, 58, 3, 47, 134, 151,
+			33, 6, 239, 116, 204, 196, 145, 195, 70, 118, 96, 57, 158, 227,
+			13, 82, 12, 206, 181, 78, 199, 76, 209, 104, 153, 46, 28, 134,
+			212, 165, 156, 176, 249, 236, 44, 104, 138, 119, 104, 78, 122, 202,
+			22, 233, 153, 110, 227, 123, 141, 230, 211, 198, 139, 90, 171, 213,
+			108, 45, 100, 88, 142, 234, 205, 239, 45, 104, 108, 129, 158, 142,
+			63, 117, 187, 245, 234, 130, 94, 124, 136, 51, 220, 229, 86, 200,
+			81, 223, 159, 115, 134, 51, 106, 8, 139, 117, 225, 150, 248, 93,
+			124, 141, 158, 159, 17, 36, 163, 95, 252, 39, 26, 101, 85, 222,
+			115, 173, 96, 70, 193, 99, 122, 246, 16, 190, 81, 214, 252, 198,
+			205, 153, 225, 60, 218, 209, 172, 78, 162, 214, 153, 217, 41, 176,
+			74, 73, 117, 114, 60, 146, 227, 36, 160, 167, 73, 224, 177, 145,
+			215, 22, 244, 212, 232, 25, 29, 202, 232, 243, 116, 113, 219, 9,
+			37, 142, 98, 205, 197, 255, 165, 83, 54, 205, 85, 128, 124, 151,
+			230, 132, 201, 8, 72, 244, 96, 105, 198, 131, 163, 29, 76, 65,
+			182, 84, 167, 194, 207, 116, 154, 21, 28, 118, 150, 234, 73, 172,
+			245, 227, 145, 168, 127, 99, 36, 254, 165, 83, 230, 177, 57, 45,
+			251, 255, 41, 167, 229, 142, 205, 105, 197, 69, 122, 78, 196, 45,
+			29, 246, 226, 191, 213, 232, 66, 202, 83, 161, 191, 163, 96, 40,
+			3, 127, 227, 104, 224, 167, 26, 11, 224, 136, 230, 5, 87, 226,
+			229, 112, 188, 151, 232, 217, 116, 210, 162, 36, 133, 154, 100, 42,
+			203, 97, 42, 208, 124, 60, 67, 69, 32, 243, 173, 132, 62, 46,
+			128, 27, 255, 74, 139, 7, 120, 135, 206, 79, 229, 39, 118, 253,
+			228, 204, 37, 38, 97, 1, 78, 110, 160, 2, 32, 36, 38, 115,
+			238, 136, 196, 169, 217, 120, 188, 196, 169, 6, 82, 226, 6, 167,
+			139, 117, 111, 143, 123, 145, 31, 28, 236, 200, 59, 204, 128, 237,
+			208, 249, 169, 89, 114, 72, 205, 204, 252, 57, 78, 205, 76, 3,
+			165, 230, 183, 26, 61, 85, 247, 176, 166, 143, 216, 19, 74, 211,
+			89, 194, 174, 157, 56, 125, 164, 236, 235, 39, 126, 87, 49, 121,
+			72, 243, 241, 216, 179, 43, 71, 27, 79, 153, 121, 245, 132, 175,
+			82, 208, 230, 141, 231, 215, 191, 166, 182, 122, 252, 159, 239, 224,
+			27, 3, 35, 243, 207, 53, 141, 254, 107, 77, 188, 49, 48, 50,
+			108, 227, 207, 180, 153, 231, 2, 235, 119, 197, 46, 126, 187, 187,
+			85, 135, 202, 36, 26, 250, 65, 104, 158, 240, 102, 160, 139, 23,
+			183, 253, 248, 102, 54, 189, 97, 119, 66, 24, 248, 123, 60, 240,
+			240, 132, 195, 179, 213, 133, 113, 101, 108, 245, 80, 176, 211, 227,
+			30, 238, 125, 212, 92, 131, 13, 115, 45, 174, 75, 229, 222, 173,
+			239, 79, 60, 59, 190, 23, 217, 174, 111, 213, 26, 237, 26, 244,
+			29, 23, 11, 207, 57, 170, 147, 12, 35, 185, 204, 138, 186, 215,
+			202, 103, 46, 168, 155, 37, 154, 121, 39, 190, 173, 194, 159, 148,
+			234, 185, 12, 51, 78, 103, 46, 106, 184, 223, 200, 225, 126, 227,
+			116, 254, 12, 253, 23, 26, 53, 114, 184, 223, 32, 76, 175, 22,
+			254, 68, 131, 41, 168, 226, 17, 68, 207, 114, 93, 185, 117, 23,
+			241, 19, 151, 41, 129, 64, 51, 184, 206, 30, 247, 120, 24, 138,
+			227, 151, 1, 143, 160, 218, 237, 80, 144, 19, 14, 239, 216, 67,
+			220, 126, 183, 57, 94, 28, 112, 104, 213, 42, 213, 39, 53, 220,
+			40, 129, 141, 143, 13, 220, 16, 124, 233, 146, 184, 15, 183, 122,
+			81, 250, 176, 65, 104, 18, 111, 2, 168, 186, 205, 55, 41, 61,
+			77, 179, 104, 167, 198, 8, 203, 45, 198, 148, 206, 8, 99, 111,
+			198, 20, 97, 132, 149, 55, 233, 182, 240, 72, 99, 228, 53, 189,
+			90, 120, 15, 166, 102, 202, 201, 14, 137, 38, 224, 239, 123, 60,
+			8, 135, 206, 24, 199, 177, 218, 237, 132, 137, 94, 13, 197, 37,
+			122, 241, 6, 241, 181, 68, 175, 70, 24, 121, 173, 188, 41, 66,
+			172, 49, 227, 82, 230, 138, 12, 49, 246, 185, 148, 127, 131, 238,
+			82, 35, 167, 97, 132, 47, 235, 213, 66, 23, 166, 166, 20, 68,
+			220, 117, 229, 105, 144, 170, 244, 241, 217, 195, 36, 2, 203, 117,
+			209, 4, 252, 128, 102, 64, 146, 98, 197, 38, 77, 134, 24, 13,
+			151, 46, 40, 43, 53, 17, 157, 203, 202, 74, 77, 68, 231, 178,
+			178, 82, 19, 209, 185, 92, 222, 164, 191, 208, 168, 158, 211, 153,
+			1, 153, 155, 90, 225, 15, 52, 80, 51, 57, 49, 64, 61, 126,
+			8, 161, 181, 179, 21, 166, 247, 88, 184, 83, 219, 195, 163, 78,
+			209, 218, 241, 189, 178, 205, 119, 39, 131, 129, 227, 13, 76, 113,
+			27, 21, 114, 217, 67, 109, 185, 146, 235, 55, 232, 249, 163, 177,
+			21, 57, 187, 142, 235, 68, 7, 120, 25, 25, 70, 150, 34, 6,
+			19, 43, 176, 188, 136, 11, 23, 48, 100, 186, 198, 8, 228, 207,
+			209, 121, 106, 228, 116, 12, 217, 13, 189, 34, 236, 215, 133, 111,
+			55, 114, 11, 49, 165, 51, 114, 99, 177, 24, 83, 132, 145, 27,
+			171, 239, 169, 110, 26, 35, 69, 253, 190, 250, 132, 131, 80, 204,
+			157, 141, 41, 157, 145, 226, 185, 107, 49, 69, 24, 41, 174, 220,
+			197, 129, 51, 50, 204, 88, 202, 124, 79, 75, 246, 226, 75, 249,
+			2, 253, 195, 120, 47, 78, 150, 245, 75, 133, 31, 67, 90, 85,
+			33, 144, 112, 112, 176, 14, 83, 195, 161, 14, 27, 99, 248, 154,
+			0, 13, 190, 31, 99, 76, 158, 210, 81, 188, 51, 196, 75, 81,
+			204, 16, 124, 52, 142, 14, 238, 131, 5, 30, 223, 151, 114, 246,
+			113, 199, 186, 203, 79, 144, 39, 198, 24, 55, 215, 89, 70, 150,
+			245, 124, 76, 105, 140, 44, 207, 157, 143, 41, 194, 200, 242, 197,
+			215, 233, 125, 181, 237, 38, 111, 233, 75, 5, 19, 14, 109, 232,
+			196, 33, 165, 120, 113, 130, 163, 139, 31, 97, 215, 114, 45, 175,
+			39, 198, 82, 137, 210, 114, 140, 188, 165, 47, 196, 148, 198, 200,
+			91, 139, 16, 83, 132, 145, 183, 110, 190, 73, 63, 16, 106, 116,
+			70, 74, 250, 245, 66, 29, 142, 212, 42, 24, 37, 11, 134, 147,
+			145, 229, 65, 63, 112, 184, 103, 187, 7, 48, 253, 93, 65, 60,
+			62, 76, 159, 117, 84, 207, 162, 224, 216, 81, 244, 166, 52, 87,
+			136, 41, 194, 72, 233, 42, 142, 163, 97, 100, 72, 134, 25, 171,
+			250, 58, 145, 223, 8, 142, 222, 42, 189, 68, 67, 154, 67, 10,
+			81, 180, 102, 92, 41, 216, 48, 189, 177, 146, 166, 133, 14, 30,
+			61, 138, 248, 196, 199, 148, 226, 201, 142, 21, 37, 167, 150, 33,
+			12, 253, 125, 24, 89, 222, 1, 30, 157, 69, 150, 139, 89, 46,
+			76, 199, 69, 100, 233, 112, 50, 198, 140, 104, 82, 122, 150, 158,
+			146, 74, 179, 168, 117, 138, 214, 24, 89, 155, 127, 61, 165, 9,
+			35, 107, 133, 203, 244, 143, 36, 196, 8, 35, 111, 235, 172, 240,
+			19, 13, 176, 236, 144, 199, 19, 98, 238, 165, 122, 172, 1, 247,
+			34, 60, 82, 118, 66, 52, 62, 25, 191, 106, 183, 83, 86, 45,
+			250, 125, 199, 115, 162, 3, 147, 74, 27, 197, 177, 72, 136, 239,
+			146, 166, 132, 30, 15, 50, 39, 60, 20, 124, 146, 69, 139, 226,
+			224, 19, 141, 145, 183, 231, 206, 196, 20, 90, 187, 176, 72, 191,
+			212, 133, 237, 6, 35, 247, 116, 179, 240, 11, 29, 142, 223, 158,
+			11, 184, 169, 160, 205, 36, 120, 220, 161, 66, 192, 123, 220, 139,
+			220, 3, 8, 44, 143, 226, 97, 178, 200, 57, 37, 224, 230, 192,
+			44, 197, 23, 107, 135, 162, 128, 135, 66, 145, 21, 68, 120, 48,
+			142, 169, 7, 196, 162, 78, 81, 63, 190, 198, 67, 151, 226, 20,
+			57, 228, 161, 82, 142, 249, 104, 102, 70, 129, 35, 209, 55, 116,
+			240, 104, 77, 188, 155, 147, 198, 36, 47, 201, 148, 153, 22, 46,
+			26, 18, 54, 37, 148, 96, 237, 249, 142, 13, 189, 225, 36, 192,
+			58, 18, 109, 134, 30, 174, 229, 33, 141, 151, 180, 212, 191, 36,
+			160, 134, 8, 83, 66, 229, 24, 185, 55, 207, 98, 74, 99, 228,
+			222, 249, 149, 152, 34, 140, 220, 43, 173, 42, 108, 107, 204, 184,
+			175, 255, 94, 140, 109, 204, 102, 247, 233, 34, 189, 33, 176, 45,
+			22, 149, 119, 141, 11, 5, 150, 60, 63, 83, 43, 70, 130, 68,
+			77, 32, 241, 221, 4, 137, 114, 141, 120, 119, 254, 92, 74, 19,
+			70, 222, 101, 231, 105, 67, 137, 212, 24, 121, 207, 184, 93, 120,
+			15, 14, 159, 34, 32, 234, 196, 5, 66, 234, 33, 54, 129, 161,
+			101, 199, 11, 85, 130, 170, 41, 253, 152, 72, 222, 51, 174, 166,
+			52, 42, 184, 102, 166, 52, 97, 228, 189, 245, 13, 250, 165, 156,
+			9, 89, 70, 170, 250, 90, 225, 23, 26, 28, 217, 185, 8, 32,
+			161, 166, 246, 190, 21, 140, 146, 208, 251, 54, 135, 164, 73, 12,
+			49, 42, 49, 118, 43, 68, 49, 98, 116, 33, 152, 120, 56, 94,
+			38, 204, 206, 161, 200, 199, 222, 78, 255, 32, 126, 119, 50, 192,
+			203, 40, 240, 251, 52, 21, 31, 96, 17, 50, 137, 212, 66, 107,
+			100, 244, 172, 129, 102, 38, 84, 142, 145, 234, 252, 27, 49, 165,
+			49, 82, 45, 124, 59, 166, 8, 35, 85, 179, 76, 255, 155, 156,
+			44, 57, 70, 30, 235, 183, 10, 255, 81, 135, 169, 125, 211, 161,
+			105, 34, 175, 161, 196, 58, 186, 203, 185, 7, 98, 135, 194, 237,
+			25, 112, 81, 129, 46, 57, 77, 112, 194, 192, 200, 194, 101, 218,
+			179, 188, 30, 87, 147, 66, 180, 3, 219, 231, 33, 62, 157, 68,
+			247, 147, 233, 69, 167, 38, 70, 9, 118, 39, 17, 124, 204, 249,
+			88, 100, 181, 81, 50, 134, 106, 126, 28, 136, 224, 37, 204, 200,
+			7, 39, 42, 65, 232, 211, 244, 35, 138, 159, 110, 96, 121, 242,
+			30, 40, 94, 8, 83, 107, 2, 30, 78, 70, 60, 140, 199, 34,
+			157, 240, 40, 108, 4, 62, 30, 101, 79, 137, 21, 55, 200, 60,
+			56, 28, 129, 153, 137, 149, 51, 48, 164, 9, 149, 101, 228, 241,
+			252, 98, 76, 105, 140, 60, 102, 197, 152, 34, 140, 60, 94, 250,
+			22, 181, 40, 206, 56, 163, 153, 233, 104, 133, 46, 204, 110, 125,
+			227, 133, 253, 68, 136, 165, 73, 12, 209, 129, 145, 183, 68, 186,
+			5, 39, 241, 74, 214, 50, 6, 206, 213, 102, 254, 34, 125, 139,
+			26, 134, 152, 169, 239, 235, 139, 133, 171, 184, 10, 197, 74, 142,
+			78, 90, 52, 84, 78, 217, 247, 85, 242, 149, 19, 246, 253, 185,
+			211, 49, 69, 24, 121, 255, 220, 2, 125, 40, 164, 106, 140, 180,
+			245, 215, 10, 247, 96, 111, 214, 252, 195, 86, 151, 240, 161, 151,
+			44, 219, 101, 237, 171, 26, 37, 42, 181, 44, 74, 138, 85, 162,
+			233, 237, 185, 133, 152, 34, 140, 180, 207, 95, 16, 101, 146, 206,
+			140, 110, 230, 135, 178, 76, 194, 37, 185, 155, 191, 76, 45, 106,
+			24, 162, 88, 251, 80, 191, 80, 232, 224, 181, 84, 52, 137, 181,
+			169, 226, 86, 178, 148, 171, 88, 129, 155, 0, 245, 8, 173, 117,
+			70, 216, 204, 242, 196, 221, 91, 111, 200, 123, 31, 171, 183, 173,
+			24, 90, 30, 4, 184, 223, 146, 70, 234, 122, 38, 199, 200, 135,
+			202, 72, 89, 16, 126, 56, 119, 46, 166, 8, 35, 31, 50, 44,
+			132, 12, 3, 159, 208, 25, 207, 116, 75, 102, 77, 93, 220, 173,
+			60, 59, 117, 134, 254, 53, 157, 230, 240, 35, 218, 250, 3, 227,
+			98, 225, 127, 107, 48, 115, 214, 166, 202, 52, 49, 93, 226, 71,
+			185, 30, 222, 236, 184, 238, 65, 98, 48, 250, 99, 243, 190, 53,
+			113, 35, 170, 214, 84, 181, 144, 40, 199, 157, 16, 196, 99, 91,
+			111, 128, 197, 238, 196, 251, 216, 243, 247, 61, 19, 102, 47, 59,
+			101, 23, 154, 84, 221, 147, 16, 31, 163, 137, 90, 144, 123, 147,
+			145, 18, 156, 96, 173, 231, 58, 184, 238, 37, 147, 25, 101, 82,
+			181, 87, 56, 224, 81, 105, 186, 145, 88, 225, 241, 34, 120, 202,
+			82, 41, 79, 101, 101, 93, 213, 141, 63, 48, 22, 83, 90, 103,
+			228, 7, 23, 94, 163, 103, 84, 132, 52, 70, 94, 24, 243, 201,
+			103, 4, 196, 11, 35, 151, 210, 58, 35, 47, 230, 104, 210, 92,
+			103, 228, 165, 241, 90, 242, 25, 145, 241, 210, 88, 72, 105, 252,
+			126, 254, 2, 253, 13, 230, 120, 241, 149, 235, 151, 10, 63, 215,
+			190, 105, 69, 93, 239, 79, 247, 216, 183, 66, 12, 32, 214, 106,
+			162, 43, 222, 150, 115, 76, 138, 18, 63, 14, 119, 241, 5, 183,
+			235, 130, 122, 166, 45, 50, 42, 54, 20, 53, 145, 136, 8, 248,
+			1, 197, 106, 200, 151, 143, 236, 19, 164, 225, 116, 224, 9, 210,
+			208, 123, 174, 138, 108, 93, 44, 88, 252, 226, 235, 244, 129, 240,
+			69, 103, 100, 160, 175, 21, 238, 194, 161, 227, 190, 153, 213, 50,
+			46, 112, 211, 189, 177, 108, 30, 111, 221, 80, 78, 14, 5, 93,
+			142, 41, 141, 145, 193, 149, 111, 199, 20, 97, 100, 96, 150, 233,
+			239, 9, 141, 132, 17, 71, 127, 179, 112, 59, 137, 82, 186, 124,
+			168, 68, 18, 158, 16, 192, 88, 23, 49, 80, 68, 66, 101, 25,
+			113, 84, 202, 212, 69, 169, 231, 176, 235, 49, 133, 202, 138, 55,
+			105, 32, 52, 27, 140, 184, 250, 155, 5, 14, 51, 71, 223, 179,
+			154, 19, 200, 138, 129, 138, 103, 148, 232, 144, 174, 188, 52, 126,
+			175, 96, 65, 56, 217, 197, 33, 244, 251, 137, 205, 66, 104, 18,
+			23, 172, 155, 220, 196, 86, 35, 203, 136, 155, 216, 106, 104, 140,
+			184, 137, 173, 6, 97, 196, 45, 222, 164, 255, 73, 130, 44, 203,
+			200, 88, 191, 86, 248, 119, 71, 11, 137, 111, 144, 227, 213, 90,
+			171, 188, 192, 73, 37, 198, 84, 84, 159, 170, 103, 136, 153, 161,
+			47, 183, 115, 165, 163, 158, 171, 137, 72, 19, 12, 28, 81, 230,
+			247, 103, 173, 193, 148, 128, 255, 114, 66, 185, 149, 21, 142, 196,
+			80, 204, 106, 140, 140, 231, 222, 80, 80, 204, 18, 70, 198, 87,
+			174, 138, 204, 76, 152, 17, 102, 14, 100, 102, 198, 65, 12, 243,
+			5, 250, 93, 106, 24, 4, 179, 221, 68, 191, 84, 40, 127, 179,
+			217, 38, 67, 78, 196, 98, 52, 81, 83, 129, 136, 164, 59, 81,
+			83, 129, 136, 164, 59, 185, 248, 58, 253, 72, 232, 209, 24, 121,
+			165, 95, 46, 52, 112, 137, 155, 62, 86, 73, 82, 39, 230, 85,
+			124, 13, 140, 89, 29, 171, 11, 43, 94, 56, 15, 21, 16, 244,
+			24, 51, 52, 3, 165, 39, 84, 150, 145, 87, 10, 7, 68, 148,
+			148, 175, 216, 197, 152, 34, 140, 188, 122, 163, 128, 135, 95, 8,
+			158, 79, 50, 215, 68, 76, 16, 44, 159, 228, 47, 211, 121, 170,
+			27, 89, 150, 253, 81, 230, 15, 53, 25, 44, 12, 233, 143, 242,
+			5, 250, 87, 41, 49, 178, 115, 140, 124, 174, 159, 41, 108, 72,
+			31, 112, 91, 132, 183, 239, 226, 25, 141, 9, 226, 132, 239, 80,
+			213, 230, 120, 97, 196, 45, 219, 164, 120, 248, 96, 100, 231, 50,
+			140, 124, 62, 47, 23, 235, 236, 92, 70, 155, 161, 116, 73, 9,
+			165, 148, 145, 31, 235, 76, 118, 162, 25, 70, 126, 60, 143, 201,
+			216, 48, 178, 36, 195, 114, 63, 209, 244, 191, 165, 17, 113, 113,
+			156, 197, 253, 172, 241, 19, 141, 158, 161, 69, 154, 195, 207, 248,
+			54, 224, 111, 104, 39, 84, 253, 231, 232, 41, 217, 38, 43, 26,
+			77, 49, 52, 100, 204, 159, 75, 25, 4, 25, 236, 60, 253, 155,
+			154, 146, 171, 49, 227, 167, 40, 55, 154, 222, 47, 78, 73, 135,
+			63, 231, 230, 179, 163, 54, 3, 206, 108, 30, 178, 212, 196, 56,
+			110, 91, 58, 101, 57, 222, 162, 255, 116, 218, 114, 188, 71, 255,
+			233, 180, 229, 26, 65, 6, 59, 79, 255, 13, 206, 244, 44, 198,
+			227, 239, 104, 122, 177, 240, 47, 181, 35, 131, 131, 25, 38, 254,
+			167, 66, 34, 49, 142, 44, 123, 106, 8, 167, 142, 208, 4, 70,
+			241, 40, 210, 114, 188, 112, 250, 20, 19, 28, 79, 62, 213, 192,
+			196, 128, 69, 137, 165, 130, 33, 228, 169, 133, 67, 190, 170, 72,
+			255, 101, 146, 218, 101, 211, 164, 114, 197, 45, 165, 205, 93, 142,
+			56, 194, 101, 51, 107, 100, 245, 140, 33, 12, 79, 200, 28, 146,
+			243, 103, 99, 82, 67, 242, 220, 213, 152, 36, 72, 194, 13, 49,
+			215, 115, 204, 248, 187, 90, 230, 26, 157, 167, 196, 200, 105, 72,
+			228, 47, 139, 15, 167, 152, 241, 71, 90, 230, 138, 248, 112, 74,
+			67, 34, 143, 185, 66, 55, 242, 44, 247, 199, 90, 230, 31, 107,
+			154, 248, 148, 215, 152, 241, 199, 90, 190, 32, 16, 151, 71, 196,
+			253, 76, 211, 255, 68, 33, 46, 47, 16, 247, 51, 141, 226, 30,
+			49, 103, 228, 17, 43, 204, 248, 185, 102, 48, 49, 70, 121, 133,
+			174, 159, 199, 99, 148, 87, 232, 250, 185, 54, 127, 38, 101, 16,
+			100, 44, 44, 38, 34, 52, 102, 252, 125, 205, 216, 72, 26, 104,
+			57, 193, 184, 154, 50, 68, 139, 107, 171, 41, 131, 32, 99, 109,
+			61, 17, 161, 51, 227, 31, 104, 198, 141, 164, 129, 158, 21, 140,
+			212, 10, 93, 67, 198, 252, 149, 148, 65, 144, 113, 29, 18, 17,
+			132, 25, 255, 80, 51, 46, 36, 13, 72, 86, 48, 82, 17, 68,
+			67, 134, 2, 155, 96, 136, 46, 236, 124, 34, 194, 96, 198, 47,
+			166, 29, 49, 36, 227, 116, 202, 200, 33, 227, 204, 229, 148, 161,
+			33, 227, 74, 234, 153, 65, 144, 49, 229, 89, 150, 25, 255, 72,
+			51, 86, 146, 6, 89, 67, 48, 82, 153, 89, 209, 226, 204, 249,
+			148, 161, 33, 227, 194, 155, 41, 131, 32, 227, 214, 178, 40, 128,
+			243, 56, 98, 191, 212, 244, 43, 114, 64, 5, 210, 126, 25, 35,
+			45, 143, 117, 180, 241, 75, 109, 126, 33, 38, 53, 108, 188, 248,
+			122, 76, 18, 36, 11, 18, 80, 115, 204, 248, 167, 90, 166, 32,
+			80, 51, 167, 33, 145, 127, 93, 0, 138, 178, 220, 175, 52, 113,
+			119, 130, 159, 168, 198, 140, 95, 105, 249, 75, 2, 80, 20, 1,
+			245, 167, 154, 254, 207, 20, 160, 168, 0, 212, 159, 98, 10, 195,
+			32, 82, 132, 7, 51, 126, 29, 3, 138, 42, 64, 253, 58, 30,
+			7, 170, 0, 245, 235, 24, 80, 84, 1, 234, 215, 49, 160, 168,
+			4, 212, 111, 52, 227, 90, 210, 0, 243, 198, 111, 166, 69, 96,
+			222, 248, 141, 54, 255, 70, 202, 32, 200, 184, 114, 53, 17, 161,
+			51, 227, 183, 154, 113, 49, 105, 128, 128, 250, 173, 102, 228, 83,
+			134, 134, 140, 185, 197, 148, 65, 144, 113, 225, 181, 68, 4, 97,
+			198, 23, 49, 160, 168, 2, 212, 23, 211, 86, 32, 160, 190, 136,
+			1, 69, 21, 160, 190, 208, 212, 78, 133, 98, 36, 190, 212, 244,
+			75, 50, 80, 98, 160, 190, 140, 7, 138, 138, 129, 250, 50, 78,
+			9, 84, 12, 212, 151, 218, 185, 243, 49, 73, 176, 239, 197, 215,
+			119, 115, 227, 192, 143, 252, 219, 255, 111, 0, 60, 158, 197, 218,
+			40, 60, 0, 0},
 	)
 }
 
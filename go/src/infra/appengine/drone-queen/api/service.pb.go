@@ -81,7 +81,7 @@ func (x ReportDroneResponse_Status) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use ReportDroneResponse_Status.Descriptor instead.
 func (ReportDroneResponse_Status) EnumDescriptor() ([]byte, []int) {
-	return file_infra_appengine_drone_queen_api_service_proto_rawDescGZIP(), []int{1, 0}
+	return file_infra_appengine_drone_queen_api_service_proto_rawDescGZIP(), []int{2, 0}
 }
 
 type ReportDroneRequest struct {
@@ -99,6 +99,22 @@ type ReportDroneRequest struct {
 	// hive value for the drone agent. This is used for DUT/drone affinity.
 	// DUTs with same hive value will be assigned to this drone.
 	Hive string `protobuf:"bytes,4,opt,name=hive,proto3" json:"hive,omitempty"`
+	// previously_hosted_duts are DUTs that the drone host recently ran
+	// bots for, e.g., before the drone agent restarted.  The queen
+	// prefers to assign these DUTs back to the drone if the hints are
+	// recent and the drone has capacity, to avoid churning bot caches
+	// on the drone host.
+	PreviouslyHostedDuts []*ReportDroneRequest_DutHint `protobuf:"bytes,5,rep,name=previously_hosted_duts,json=previouslyHostedDuts,proto3" json:"previously_hosted_duts,omitempty"`
+	// bot_code_versions are the Swarming bot code versions that the
+	// drone's bots are running.  This is used to verify the progress of
+	// bot code rollouts.
+	BotCodeVersions []*BotCodeVersion `protobuf:"bytes,6,rep,name=bot_code_versions,json=botCodeVersions,proto3" json:"bot_code_versions,omitempty"`
+	// unavailable_duts are DUTs which have been drained on the drone
+	// host, e.g. for maintenance.  The drone does not run bots for
+	// these DUTs, but keeps them assigned if they are assigned to it, so
+	// they are not assigned to another drone.  The drone resumes running
+	// bots for them once they are no longer drained on the host.
+	UnavailableDuts []string `protobuf:"bytes,7,rep,name=unavailable_duts,json=unavailableDuts,proto3" json:"unavailable_duts,omitempty"`
 }
 
 func (x *ReportDroneRequest) Reset() {
@@ -161,6 +177,86 @@ func (x *ReportDroneRequest) GetHive() string {
 	return ""
 }
 
+func (x *ReportDroneRequest) GetPreviouslyHostedDuts() []*ReportDroneRequest_DutHint {
+	if x != nil {
+		return x.PreviouslyHostedDuts
+	}
+	return nil
+}
+
+func (x *ReportDroneRequest) GetBotCodeVersions() []*BotCodeVersion {
+	if x != nil {
+		return x.BotCodeVersions
+	}
+	return nil
+}
+
+func (x *ReportDroneRequest) GetUnavailableDuts() []string {
+	if x != nil {
+		return x.UnavailableDuts
+	}
+	return nil
+}
+
+// BotCodeVersion is the Swarming bot code version that the bot for a
+// DUT is running.
+type BotCodeVersion struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// dut is the name of the DUT.
+	Dut string `protobuf:"bytes,1,opt,name=dut,proto3" json:"dut,omitempty"`
+	// version is the bot code version, as reported by the bot.
+	Version string `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (x *BotCodeVersion) Reset() {
+	*x = BotCodeVersion{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_infra_appengine_drone_queen_api_service_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BotCodeVersion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BotCodeVersion) ProtoMessage() {}
+
+func (x *BotCodeVersion) ProtoReflect() protoreflect.Message {
+	mi := &file_infra_appengine_drone_queen_api_service_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BotCodeVersion.ProtoReflect.Descriptor instead.
+func (*BotCodeVersion) Descriptor() ([]byte, []int) {
+	return file_infra_appengine_drone_queen_api_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *BotCodeVersion) GetDut() string {
+	if x != nil {
+		return x.Dut
+	}
+	return ""
+}
+
+func (x *BotCodeVersion) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
 type ReportDroneResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -180,12 +276,16 @@ type ReportDroneResponse struct {
 	// draining_duts are the DUTs that the drone should drain.  This is
 	// always a subset of assigned_duts.
 	DrainingDuts []string `protobuf:"bytes,5,rep,name=draining_duts,json=drainingDuts,proto3" json:"draining_duts,omitempty"`
+	// bot_code_version is the Swarming bot code version that the drone
+	// should use when starting bots.  If empty, the drone should use the
+	// current bot code version of the Swarming server.
+	BotCodeVersion string `protobuf:"bytes,6,opt,name=bot_code_version,json=botCodeVersion,proto3" json:"bot_code_version,omitempty"`
 }
 
 func (x *ReportDroneResponse) Reset() {
 	*x = ReportDroneResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_infra_appengine_drone_queen_api_service_proto_msgTypes[1]
+		mi := &file_infra_appengine_drone_queen_api_service_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -198,7 +298,7 @@ func (x *ReportDroneResponse) String() string {
 func (*ReportDroneResponse) ProtoMessage() {}
 
 func (x *ReportDroneResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_infra_appengine_drone_queen_api_service_proto_msgTypes[1]
+	mi := &file_infra_appengine_drone_queen_api_service_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -211,7 +311,7 @@ func (x *ReportDroneResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReportDroneResponse.ProtoReflect.Descriptor instead.
 func (*ReportDroneResponse) Descriptor() ([]byte, []int) {
-	return file_infra_appengine_drone_queen_api_service_proto_rawDescGZIP(), []int{1}
+	return file_infra_appengine_drone_queen_api_service_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *ReportDroneResponse) GetStatus() ReportDroneResponse_Status {
@@ -249,6 +349,13 @@ func (x *ReportDroneResponse) GetDrainingDuts() []string {
 	return nil
 }
 
+func (x *ReportDroneResponse) GetBotCodeVersion() string {
+	if x != nil {
+		return x.BotCodeVersion
+	}
+	return ""
+}
+
 type ReleaseDutsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -264,7 +371,7 @@ type ReleaseDutsRequest struct {
 func (x *ReleaseDutsRequest) Reset() {
 	*x = ReleaseDutsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_infra_appengine_drone_queen_api_service_proto_msgTypes[2]
+		mi := &file_infra_appengine_drone_queen_api_service_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -277,7 +384,7 @@ func (x *ReleaseDutsRequest) String() string {
 func (*ReleaseDutsRequest) ProtoMessage() {}
 
 func (x *ReleaseDutsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_infra_appengine_drone_queen_api_service_proto_msgTypes[2]
+	mi := &file_infra_appengine_drone_queen_api_service_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -290,7 +397,7 @@ func (x *ReleaseDutsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReleaseDutsRequest.ProtoReflect.Descriptor instead.
 func (*ReleaseDutsRequest) Descriptor() ([]byte, []int) {
-	return file_infra_appengine_drone_queen_api_service_proto_rawDescGZIP(), []int{2}
+	return file_infra_appengine_drone_queen_api_service_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *ReleaseDutsRequest) GetDroneUuid() string {
@@ -316,7 +423,7 @@ type ReleaseDutsResponse struct {
 func (x *ReleaseDutsResponse) Reset() {
 	*x = ReleaseDutsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_infra_appengine_drone_queen_api_service_proto_msgTypes[3]
+		mi := &file_infra_appengine_drone_queen_api_service_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -329,7 +436,7 @@ func (x *ReleaseDutsResponse) String() string {
 func (*ReleaseDutsResponse) ProtoMessage() {}
 
 func (x *ReleaseDutsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_infra_appengine_drone_queen_api_service_proto_msgTypes[3]
+	mi := &file_infra_appengine_drone_queen_api_service_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -342,7 +449,7 @@ func (x *ReleaseDutsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReleaseDutsResponse.ProtoReflect.Descriptor instead.
 func (*ReleaseDutsResponse) Descriptor() ([]byte, []int) {
-	return file_infra_appengine_drone_queen_api_service_proto_rawDescGZIP(), []int{3}
+	return file_infra_appengine_drone_queen_api_service_proto_rawDescGZIP(), []int{4}
 }
 
 type DeclareDutsRequest struct {
@@ -359,7 +466,7 @@ type DeclareDutsRequest struct {
 func (x *DeclareDutsRequest) Reset() {
 	*x = DeclareDutsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_infra_appengine_drone_queen_api_service_proto_msgTypes[4]
+		mi := &file_infra_appengine_drone_queen_api_service_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -372,7 +479,7 @@ func (x *DeclareDutsRequest) String() string {
 func (*DeclareDutsRequest) ProtoMessage() {}
 
 func (x *DeclareDutsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_infra_appengine_drone_queen_api_service_proto_msgTypes[4]
+	mi := &file_infra_appengine_drone_queen_api_service_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -385,7 +492,7 @@ func (x *DeclareDutsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeclareDutsRequest.ProtoReflect.Descriptor instead.
 func (*DeclareDutsRequest) Descriptor() ([]byte, []int) {
-	return file_infra_appengine_drone_queen_api_service_proto_rawDescGZIP(), []int{4}
+	return file_infra_appengine_drone_queen_api_service_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *DeclareDutsRequest) GetAvailableDuts() []*DeclareDutsRequest_Dut {
@@ -404,7 +511,7 @@ type DeclareDutsResponse struct {
 func (x *DeclareDutsResponse) Reset() {
 	*x = DeclareDutsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_infra_appengine_drone_queen_api_service_proto_msgTypes[5]
+		mi := &file_infra_appengine_drone_queen_api_service_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -417,7 +524,7 @@ func (x *DeclareDutsResponse) String() string {
 func (*DeclareDutsResponse) ProtoMessage() {}
 
 func (x *DeclareDutsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_infra_appengine_drone_queen_api_service_proto_msgTypes[5]
+	mi := &file_infra_appengine_drone_queen_api_service_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -430,7 +537,7 @@ func (x *DeclareDutsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeclareDutsResponse.ProtoReflect.Descriptor instead.
 func (*DeclareDutsResponse) Descriptor() ([]byte, []int) {
-	return file_infra_appengine_drone_queen_api_service_proto_rawDescGZIP(), []int{5}
+	return file_infra_appengine_drone_queen_api_service_proto_rawDescGZIP(), []int{6}
 }
 
 type ListDronesRequest struct {
@@ -442,7 +549,7 @@ type ListDronesRequest struct {
 func (x *ListDronesRequest) Reset() {
 	*x = ListDronesRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_infra_appengine_drone_queen_api_service_proto_msgTypes[6]
+		mi := &file_infra_appengine_drone_queen_api_service_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -455,7 +562,7 @@ func (x *ListDronesRequest) String() string {
 func (*ListDronesRequest) ProtoMessage() {}
 
 func (x *ListDronesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_infra_appengine_drone_queen_api_service_proto_msgTypes[6]
+	mi := &file_infra_appengine_drone_queen_api_service_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -468,7 +575,7 @@ func (x *ListDronesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListDronesRequest.ProtoReflect.Descriptor instead.
 func (*ListDronesRequest) Descriptor() ([]byte, []int) {
-	return file_infra_appengine_drone_queen_api_service_proto_rawDescGZIP(), []int{6}
+	return file_infra_appengine_drone_queen_api_service_proto_rawDescGZIP(), []int{7}
 }
 
 type ListDronesResponse struct {
@@ -482,7 +589,7 @@ type ListDronesResponse struct {
 func (x *ListDronesResponse) Reset() {
 	*x = ListDronesResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_infra_appengine_drone_queen_api_service_proto_msgTypes[7]
+		mi := &file_infra_appengine_drone_queen_api_service_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -495,7 +602,7 @@ func (x *ListDronesResponse) String() string {
 func (*ListDronesResponse) ProtoMessage() {}
 
 func (x *ListDronesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_infra_appengine_drone_queen_api_service_proto_msgTypes[7]
+	mi := &file_infra_appengine_drone_queen_api_service_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -508,7 +615,7 @@ func (x *ListDronesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListDronesResponse.ProtoReflect.Descriptor instead.
 func (*ListDronesResponse) Descriptor() ([]byte, []int) {
-	return file_infra_appengine_drone_queen_api_service_proto_rawDescGZIP(), []int{7}
+	return file_infra_appengine_drone_queen_api_service_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *ListDronesResponse) GetDrones() []*ListDronesResponse_Drone {
@@ -527,7 +634,7 @@ type ListDutsRequest struct {
 func (x *ListDutsRequest) Reset() {
 	*x = ListDutsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_infra_appengine_drone_queen_api_service_proto_msgTypes[8]
+		mi := &file_infra_appengine_drone_queen_api_service_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -540,7 +647,7 @@ func (x *ListDutsRequest) String() string {
 func (*ListDutsRequest) ProtoMessage() {}
 
 func (x *ListDutsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_infra_appengine_drone_queen_api_service_proto_msgTypes[8]
+	mi := &file_infra_appengine_drone_queen_api_service_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -553,7 +660,7 @@ func (x *ListDutsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListDutsRequest.ProtoReflect.Descriptor instead.
 func (*ListDutsRequest) Descriptor() ([]byte, []int) {
-	return file_infra_appengine_drone_queen_api_service_proto_rawDescGZIP(), []int{8}
+	return file_infra_appengine_drone_queen_api_service_proto_rawDescGZIP(), []int{9}
 }
 
 type ListDutsResponse struct {
@@ -567,7 +674,7 @@ type ListDutsResponse struct {
 func (x *ListDutsResponse) Reset() {
 	*x = ListDutsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_infra_appengine_drone_queen_api_service_proto_msgTypes[9]
+		mi := &file_infra_appengine_drone_queen_api_service_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -580,7 +687,7 @@ func (x *ListDutsResponse) String() string {
 func (*ListDutsResponse) ProtoMessage() {}
 
 func (x *ListDutsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_infra_appengine_drone_queen_api_service_proto_msgTypes[9]
+	mi := &file_infra_appengine_drone_queen_api_service_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -593,7 +700,7 @@ func (x *ListDutsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListDutsResponse.ProtoReflect.Descriptor instead.
 func (*ListDutsResponse) Descriptor() ([]byte, []int) {
-	return file_infra_appengine_drone_queen_api_service_proto_rawDescGZIP(), []int{9}
+	return file_infra_appengine_drone_queen_api_service_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *ListDutsResponse) GetDuts() []*ListDutsResponse_Dut {
@@ -616,7 +723,7 @@ type ReportDroneRequest_LoadIndicators struct {
 func (x *ReportDroneRequest_LoadIndicators) Reset() {
 	*x = ReportDroneRequest_LoadIndicators{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_infra_appengine_drone_queen_api_service_proto_msgTypes[10]
+		mi := &file_infra_appengine_drone_queen_api_service_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -629,7 +736,7 @@ func (x *ReportDroneRequest_LoadIndicators) String() string {
 func (*ReportDroneRequest_LoadIndicators) ProtoMessage() {}
 
 func (x *ReportDroneRequest_LoadIndicators) ProtoReflect() protoreflect.Message {
-	mi := &file_infra_appengine_drone_queen_api_service_proto_msgTypes[10]
+	mi := &file_infra_appengine_drone_queen_api_service_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -652,6 +759,63 @@ func (x *ReportDroneRequest_LoadIndicators) GetDutCapacity() uint32 {
 	return 0
 }
 
+type ReportDroneRequest_DutHint struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// name of the DUT.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// last_hosted_time is when the drone last had the DUT assigned.
+	LastHostedTime *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=last_hosted_time,json=lastHostedTime,proto3" json:"last_hosted_time,omitempty"`
+}
+
+func (x *ReportDroneRequest_DutHint) Reset() {
+	*x = ReportDroneRequest_DutHint{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_infra_appengine_drone_queen_api_service_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReportDroneRequest_DutHint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportDroneRequest_DutHint) ProtoMessage() {}
+
+func (x *ReportDroneRequest_DutHint) ProtoReflect() protoreflect.Message {
+	mi := &file_infra_appengine_drone_queen_api_service_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportDroneRequest_DutHint.ProtoReflect.Descriptor instead.
+func (*ReportDroneRequest_DutHint) Descriptor() ([]byte, []int) {
+	return file_infra_appengine_drone_queen_api_service_proto_rawDescGZIP(), []int{0, 1}
+}
+
+func (x *ReportDroneRequest_DutHint) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ReportDroneRequest_DutHint) GetLastHostedTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastHostedTime
+	}
+	return nil
+}
+
 type DeclareDutsRequest_Dut struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -667,7 +831,7 @@ type DeclareDutsRequest_Dut struct {
 func (x *DeclareDutsRequest_Dut) Reset() {
 	*x = DeclareDutsRequest_Dut{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_infra_appengine_drone_queen_api_service_proto_msgTypes[11]
+		mi := &file_infra_appengine_drone_queen_api_service_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -680,7 +844,7 @@ func (x *DeclareDutsRequest_Dut) String() string {
 func (*DeclareDutsRequest_Dut) ProtoMessage() {}
 
 func (x *DeclareDutsRequest_Dut) ProtoReflect() protoreflect.Message {
-	mi := &file_infra_appengine_drone_queen_api_service_proto_msgTypes[11]
+	mi := &file_infra_appengine_drone_queen_api_service_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -693,7 +857,7 @@ func (x *DeclareDutsRequest_Dut) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeclareDutsRequest_Dut.ProtoReflect.Descriptor instead.
 func (*DeclareDutsRequest_Dut) Descriptor() ([]byte, []int) {
-	return file_infra_appengine_drone_queen_api_service_proto_rawDescGZIP(), []int{4, 0}
+	return file_infra_appengine_drone_queen_api_service_proto_rawDescGZIP(), []int{5, 0}
 }
 
 func (x *DeclareDutsRequest_Dut) GetName() string {
@@ -719,12 +883,14 @@ type ListDronesResponse_Drone struct {
 	ExpirationTime   *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=expiration_time,json=expirationTime,proto3" json:"expiration_time,omitempty"`
 	DroneDescription string                 `protobuf:"bytes,3,opt,name=drone_description,json=droneDescription,proto3" json:"drone_description,omitempty"`
 	Hive             string                 `protobuf:"bytes,4,opt,name=hive,proto3" json:"hive,omitempty"`
+	BotCodeVersions  []*BotCodeVersion      `protobuf:"bytes,5,rep,name=bot_code_versions,json=botCodeVersions,proto3" json:"bot_code_versions,omitempty"`
+	UnavailableDuts  []string               `protobuf:"bytes,6,rep,name=unavailable_duts,json=unavailableDuts,proto3" json:"unavailable_duts,omitempty"`
 }
 
 func (x *ListDronesResponse_Drone) Reset() {
 	*x = ListDronesResponse_Drone{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_infra_appengine_drone_queen_api_service_proto_msgTypes[12]
+		mi := &file_infra_appengine_drone_queen_api_service_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -737,7 +903,7 @@ func (x *ListDronesResponse_Drone) String() string {
 func (*ListDronesResponse_Drone) ProtoMessage() {}
 
 func (x *ListDronesResponse_Drone) ProtoReflect() protoreflect.Message {
-	mi := &file_infra_appengine_drone_queen_api_service_proto_msgTypes[12]
+	mi := &file_infra_appengine_drone_queen_api_service_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -750,7 +916,7 @@ func (x *ListDronesResponse_Drone) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListDronesResponse_Drone.ProtoReflect.Descriptor instead.
 func (*ListDronesResponse_Drone) Descriptor() ([]byte, []int) {
-	return file_infra_appengine_drone_queen_api_service_proto_rawDescGZIP(), []int{7, 0}
+	return file_infra_appengine_drone_queen_api_service_proto_rawDescGZIP(), []int{8, 0}
 }
 
 func (x *ListDronesResponse_Drone) GetId() string {
@@ -781,6 +947,20 @@ func (x *ListDronesResponse_Drone) GetHive() string {
 	return ""
 }
 
+func (x *ListDronesResponse_Drone) GetBotCodeVersions() []*BotCodeVersion {
+	if x != nil {
+		return x.BotCodeVersions
+	}
+	return nil
+}
+
+func (x *ListDronesResponse_Drone) GetUnavailableDuts() []string {
+	if x != nil {
+		return x.UnavailableDuts
+	}
+	return nil
+}
+
 type ListDutsResponse_Dut struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -795,7 +975,7 @@ type ListDutsResponse_Dut struct {
 func (x *ListDutsResponse_Dut) Reset() {
 	*x = ListDutsResponse_Dut{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_infra_appengine_drone_queen_api_service_proto_msgTypes[13]
+		mi := &file_infra_appengine_drone_queen_api_service_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -808,7 +988,7 @@ func (x *ListDutsResponse_Dut) String() string {
 func (*ListDutsResponse_Dut) ProtoMessage() {}
 
 func (x *ListDutsResponse_Dut) ProtoReflect() protoreflect.Message {
-	mi := &file_infra_appengine_drone_queen_api_service_proto_msgTypes[13]
+	mi := &file_infra_appengine_drone_queen_api_service_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -821,7 +1001,7 @@ func (x *ListDutsResponse_Dut) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListDutsResponse_Dut.ProtoReflect.Descriptor instead.
 func (*ListDutsResponse_Dut) Descriptor() ([]byte, []int) {
-	return file_infra_appengine_drone_queen_api_service_proto_rawDescGZIP(), []int{9, 0}
+	return file_infra_appengine_drone_queen_api_service_proto_rawDescGZIP(), []int{10, 0}
 }
 
 func (x *ListDutsResponse_Dut) GetId() string {
@@ -860,7 +1040,7 @@ var file_infra_appengine_drone_queen_api_service_proto_rawDesc = []byte{
 	0x69, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
 	0x0b, 0x64, 0x72, 0x6f, 0x6e, 0x65, 0x5f, 0x71, 0x75, 0x65, 0x65, 0x6e, 0x1a, 0x1f, 0x67, 0x6f,
 	0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69,
-	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x82, 0x02,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xba, 0x04,
 	0x0a, 0x12, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x44, 0x72, 0x6f, 0x6e, 0x65, 0x52, 0x65, 0x71,
 	0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x64, 0x72, 0x6f, 0x6e, 0x65, 0x5f, 0x75, 0x75,
 	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x64, 0x72, 0x6f, 0x6e, 0x65, 0x55,
@@ -873,27 +1053,53 @@ var file_infra_appengine_drone_queen_api_service_proto_rawDesc = []byte{
 	0x64, 0x72, 0x6f, 0x6e, 0x65, 0x5f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
 	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x64, 0x72, 0x6f, 0x6e, 0x65, 0x44, 0x65,
 	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x69, 0x76,
-	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x69, 0x76, 0x65, 0x1a, 0x33, 0x0a,
-	0x0e, 0x4c, 0x6f, 0x61, 0x64, 0x49, 0x6e, 0x64, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x12,
-	0x21, 0x0a, 0x0c, 0x64, 0x75, 0x74, 0x5f, 0x63, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x64, 0x75, 0x74, 0x43, 0x61, 0x70, 0x61, 0x63, 0x69,
-	0x74, 0x79, 0x22, 0xbb, 0x02, 0x0a, 0x13, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x44, 0x72, 0x6f,
-	0x6e, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f, 0x0a, 0x06, 0x73, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x27, 0x2e, 0x64, 0x72, 0x6f,
-	0x6e, 0x65, 0x5f, 0x71, 0x75, 0x65, 0x65, 0x6e, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x44,
-	0x72, 0x6f, 0x6e, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x53, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x64,
-	0x72, 0x6f, 0x6e, 0x65, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x09, 0x64, 0x72, 0x6f, 0x6e, 0x65, 0x55, 0x75, 0x69, 0x64, 0x12, 0x43, 0x0a, 0x0f, 0x65, 0x78,
-	0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
-	0x0e, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x12,
-	0x23, 0x0a, 0x0d, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x5f, 0x64, 0x75, 0x74, 0x73,
-	0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64,
-	0x44, 0x75, 0x74, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x64, 0x72, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67,
-	0x5f, 0x64, 0x75, 0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x64, 0x72, 0x61,
-	0x69, 0x6e, 0x69, 0x6e, 0x67, 0x44, 0x75, 0x74, 0x73, 0x22, 0x35, 0x0a, 0x06, 0x53, 0x74, 0x61,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x69, 0x76, 0x65, 0x12, 0x5d, 0x0a,
+	0x16, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x6c, 0x79, 0x5f, 0x68, 0x6f, 0x73, 0x74,
+	0x65, 0x64, 0x5f, 0x64, 0x75, 0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e,
+	0x64, 0x72, 0x6f, 0x6e, 0x65, 0x5f, 0x71, 0x75, 0x65, 0x65, 0x6e, 0x2e, 0x52, 0x65, 0x70, 0x6f,
+	0x72, 0x74, 0x44, 0x72, 0x6f, 0x6e, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x44,
+	0x75, 0x74, 0x48, 0x69, 0x6e, 0x74, 0x52, 0x14, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73,
+	0x6c, 0x79, 0x48, 0x6f, 0x73, 0x74, 0x65, 0x64, 0x44, 0x75, 0x74, 0x73, 0x12, 0x47, 0x0a, 0x11,
+	0x62, 0x6f, 0x74, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x64, 0x72, 0x6f, 0x6e, 0x65, 0x5f,
+	0x71, 0x75, 0x65, 0x65, 0x6e, 0x2e, 0x42, 0x6f, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x56, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0f, 0x62, 0x6f, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x56, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x75, 0x6e, 0x61, 0x76, 0x61, 0x69, 0x6c,
+	0x61, 0x62, 0x6c, 0x65, 0x5f, 0x64, 0x75, 0x74, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x0f, 0x75, 0x6e, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x44, 0x75, 0x74, 0x73,
+	0x1a, 0x33, 0x0a, 0x0e, 0x4c, 0x6f, 0x61, 0x64, 0x49, 0x6e, 0x64, 0x69, 0x63, 0x61, 0x74, 0x6f,
+	0x72, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x75, 0x74, 0x5f, 0x63, 0x61, 0x70, 0x61, 0x63, 0x69,
+	0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x64, 0x75, 0x74, 0x43, 0x61, 0x70,
+	0x61, 0x63, 0x69, 0x74, 0x79, 0x1a, 0x63, 0x0a, 0x07, 0x44, 0x75, 0x74, 0x48, 0x69, 0x6e, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x44, 0x0a, 0x10, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x68, 0x6f, 0x73,
+	0x74, 0x65, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0e, 0x6c, 0x61, 0x73, 0x74,
+	0x48, 0x6f, 0x73, 0x74, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x3c, 0x0a, 0x0e, 0x42, 0x6f,
+	0x74, 0x43, 0x6f, 0x64, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x10, 0x0a, 0x03,
+	0x64, 0x75, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x64, 0x75, 0x74, 0x12, 0x18,
+	0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0xe5, 0x02, 0x0a, 0x13, 0x52, 0x65, 0x70,
+	0x6f, 0x72, 0x74, 0x44, 0x72, 0x6f, 0x6e, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x3f, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x27, 0x2e, 0x64, 0x72, 0x6f, 0x6e, 0x65, 0x5f, 0x71, 0x75, 0x65, 0x65, 0x6e, 0x2e, 0x52,
+	0x65, 0x70, 0x6f, 0x72, 0x74, 0x44, 0x72, 0x6f, 0x6e, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x64, 0x72, 0x6f, 0x6e, 0x65, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x64, 0x72, 0x6f, 0x6e, 0x65, 0x55, 0x75, 0x69, 0x64,
+	0x12, 0x43, 0x0a, 0x0f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0e, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65,
+	0x64, 0x5f, 0x64, 0x75, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x61, 0x73,
+	0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x44, 0x75, 0x74, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x64, 0x72,
+	0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x64, 0x75, 0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x0c, 0x64, 0x72, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x44, 0x75, 0x74, 0x73, 0x12,
+	0x28, 0x0a, 0x10, 0x62, 0x6f, 0x74, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x62, 0x6f, 0x74, 0x43, 0x6f,
+	0x64, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x35, 0x0a, 0x06, 0x53, 0x74, 0x61,
 	0x74, 0x75, 0x73, 0x12, 0x11, 0x0a, 0x0d, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x5f, 0x45,
 	0x52, 0x52, 0x4f, 0x52, 0x10, 0x00, 0x12, 0x06, 0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x01, 0x12, 0x10,
 	0x0a, 0x0c, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x5f, 0x55, 0x55, 0x49, 0x44, 0x10, 0x02,
@@ -915,13 +1121,13 @@ var file_infra_appengine_drone_queen_api_service_proto_rawDesc = []byte{
 	0x76, 0x65, 0x4a, 0x04, 0x08, 0x01, 0x10, 0x02, 0x52, 0x04, 0x64, 0x75, 0x74, 0x73, 0x22, 0x15,
 	0x0a, 0x13, 0x44, 0x65, 0x63, 0x6c, 0x61, 0x72, 0x65, 0x44, 0x75, 0x74, 0x73, 0x52, 0x65, 0x73,
 	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x13, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x72, 0x6f,
-	0x6e, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xf3, 0x01, 0x0a, 0x12, 0x4c,
+	0x6e, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xe7, 0x02, 0x0a, 0x12, 0x4c,
 	0x69, 0x73, 0x74, 0x44, 0x72, 0x6f, 0x6e, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
 	0x65, 0x12, 0x3d, 0x0a, 0x06, 0x64, 0x72, 0x6f, 0x6e, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
 	0x0b, 0x32, 0x25, 0x2e, 0x64, 0x72, 0x6f, 0x6e, 0x65, 0x5f, 0x71, 0x75, 0x65, 0x65, 0x6e, 0x2e,
 	0x4c, 0x69, 0x73, 0x74, 0x44, 0x72, 0x6f, 0x6e, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
 	0x73, 0x65, 0x2e, 0x44, 0x72, 0x6f, 0x6e, 0x65, 0x52, 0x06, 0x64, 0x72, 0x6f, 0x6e, 0x65, 0x73,
-	0x1a, 0x9d, 0x01, 0x0a, 0x05, 0x44, 0x72, 0x6f, 0x6e, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x1a, 0x91, 0x02, 0x0a, 0x05, 0x44, 0x72, 0x6f, 0x6e, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
 	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x43, 0x0a, 0x0f, 0x65, 0x78,
 	0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20,
 	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
@@ -931,50 +1137,57 @@ var file_infra_appengine_drone_queen_api_service_proto_rawDesc = []byte{
 	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x64, 0x72, 0x6f, 0x6e,
 	0x65, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04,
 	0x68, 0x69, 0x76, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x69, 0x76, 0x65,
-	0x22, 0x11, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x75, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x22, 0xb7, 0x01, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x75, 0x74, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x04, 0x64, 0x75, 0x74, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x64, 0x72, 0x6f, 0x6e, 0x65, 0x5f, 0x71,
-	0x75, 0x65, 0x65, 0x6e, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x75, 0x74, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x44, 0x75, 0x74, 0x52, 0x04, 0x64, 0x75, 0x74, 0x73, 0x1a,
-	0x6c, 0x0a, 0x03, 0x44, 0x75, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e,
-	0x65, 0x64, 0x5f, 0x64, 0x72, 0x6f, 0x6e, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
-	0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x44, 0x72, 0x6f, 0x6e, 0x65, 0x12, 0x1a, 0x0a,
-	0x08, 0x64, 0x72, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x08, 0x64, 0x72, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x69, 0x76,
-	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x69, 0x76, 0x65, 0x32, 0xab, 0x01,
-	0x0a, 0x05, 0x44, 0x72, 0x6f, 0x6e, 0x65, 0x12, 0x50, 0x0a, 0x0b, 0x52, 0x65, 0x70, 0x6f, 0x72,
-	0x74, 0x44, 0x72, 0x6f, 0x6e, 0x65, 0x12, 0x1f, 0x2e, 0x64, 0x72, 0x6f, 0x6e, 0x65, 0x5f, 0x71,
-	0x75, 0x65, 0x65, 0x6e, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x44, 0x72, 0x6f, 0x6e, 0x65,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x64, 0x72, 0x6f, 0x6e, 0x65, 0x5f,
-	0x71, 0x75, 0x65, 0x65, 0x6e, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x44, 0x72, 0x6f, 0x6e,
-	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x50, 0x0a, 0x0b, 0x52, 0x65, 0x6c,
-	0x65, 0x61, 0x73, 0x65, 0x44, 0x75, 0x74, 0x73, 0x12, 0x1f, 0x2e, 0x64, 0x72, 0x6f, 0x6e, 0x65,
-	0x5f, 0x71, 0x75, 0x65, 0x65, 0x6e, 0x2e, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x44, 0x75,
-	0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x64, 0x72, 0x6f, 0x6e,
-	0x65, 0x5f, 0x71, 0x75, 0x65, 0x65, 0x6e, 0x2e, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x44,
-	0x75, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0x65, 0x0a, 0x11, 0x49,
-	0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
-	0x12, 0x50, 0x0a, 0x0b, 0x44, 0x65, 0x63, 0x6c, 0x61, 0x72, 0x65, 0x44, 0x75, 0x74, 0x73, 0x12,
-	0x1f, 0x2e, 0x64, 0x72, 0x6f, 0x6e, 0x65, 0x5f, 0x71, 0x75, 0x65, 0x65, 0x6e, 0x2e, 0x44, 0x65,
-	0x63, 0x6c, 0x61, 0x72, 0x65, 0x44, 0x75, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x20, 0x2e, 0x64, 0x72, 0x6f, 0x6e, 0x65, 0x5f, 0x71, 0x75, 0x65, 0x65, 0x6e, 0x2e, 0x44,
-	0x65, 0x63, 0x6c, 0x61, 0x72, 0x65, 0x44, 0x75, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x32, 0xa1, 0x01, 0x0a, 0x07, 0x49, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x74, 0x12, 0x4d,
-	0x0a, 0x0a, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x72, 0x6f, 0x6e, 0x65, 0x73, 0x12, 0x1e, 0x2e, 0x64,
-	0x72, 0x6f, 0x6e, 0x65, 0x5f, 0x71, 0x75, 0x65, 0x65, 0x6e, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44,
-	0x72, 0x6f, 0x6e, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x64,
-	0x72, 0x6f, 0x6e, 0x65, 0x5f, 0x71, 0x75, 0x65, 0x65, 0x6e, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44,
-	0x72, 0x6f, 0x6e, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a,
-	0x08, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x75, 0x74, 0x73, 0x12, 0x1c, 0x2e, 0x64, 0x72, 0x6f, 0x6e,
-	0x65, 0x5f, 0x71, 0x75, 0x65, 0x65, 0x6e, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x75, 0x74, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x64, 0x72, 0x6f, 0x6e, 0x65, 0x5f,
-	0x71, 0x75, 0x65, 0x65, 0x6e, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x75, 0x74, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x21, 0x5a, 0x1f, 0x69, 0x6e, 0x66, 0x72, 0x61, 0x2f,
-	0x61, 0x70, 0x70, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x2f, 0x64, 0x72, 0x6f, 0x6e, 0x65, 0x2d,
-	0x71, 0x75, 0x65, 0x65, 0x6e, 0x2f, 0x61, 0x70, 0x69, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x33,
+	0x12, 0x47, 0x0a, 0x11, 0x62, 0x6f, 0x74, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x5f, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x64, 0x72,
+	0x6f, 0x6e, 0x65, 0x5f, 0x71, 0x75, 0x65, 0x65, 0x6e, 0x2e, 0x42, 0x6f, 0x74, 0x43, 0x6f, 0x64,
+	0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0f, 0x62, 0x6f, 0x74, 0x43, 0x6f, 0x64,
+	0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x75, 0x6e, 0x61,
+	0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x64, 0x75, 0x74, 0x73, 0x18, 0x06, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x0f, 0x75, 0x6e, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65,
+	0x44, 0x75, 0x74, 0x73, 0x22, 0x11, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x75, 0x74, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xb7, 0x01, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74,
+	0x44, 0x75, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x04,
+	0x64, 0x75, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x64, 0x72, 0x6f,
+	0x6e, 0x65, 0x5f, 0x71, 0x75, 0x65, 0x65, 0x6e, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x75, 0x74,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x44, 0x75, 0x74, 0x52, 0x04, 0x64,
+	0x75, 0x74, 0x73, 0x1a, 0x6c, 0x0a, 0x03, 0x44, 0x75, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x61, 0x73,
+	0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x5f, 0x64, 0x72, 0x6f, 0x6e, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0d, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x44, 0x72, 0x6f, 0x6e,
+	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x72, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x08, 0x64, 0x72, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x12, 0x12, 0x0a,
+	0x04, 0x68, 0x69, 0x76, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x69, 0x76,
+	0x65, 0x32, 0xab, 0x01, 0x0a, 0x05, 0x44, 0x72, 0x6f, 0x6e, 0x65, 0x12, 0x50, 0x0a, 0x0b, 0x52,
+	0x65, 0x70, 0x6f, 0x72, 0x74, 0x44, 0x72, 0x6f, 0x6e, 0x65, 0x12, 0x1f, 0x2e, 0x64, 0x72, 0x6f,
+	0x6e, 0x65, 0x5f, 0x71, 0x75, 0x65, 0x65, 0x6e, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x44,
+	0x72, 0x6f, 0x6e, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x64, 0x72,
+	0x6f, 0x6e, 0x65, 0x5f, 0x71, 0x75, 0x65, 0x65, 0x6e, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74,
+	0x44, 0x72, 0x6f, 0x6e, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x50, 0x0a,
+	0x0b, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x44, 0x75, 0x74, 0x73, 0x12, 0x1f, 0x2e, 0x64,
+	0x72, 0x6f, 0x6e, 0x65, 0x5f, 0x71, 0x75, 0x65, 0x65, 0x6e, 0x2e, 0x52, 0x65, 0x6c, 0x65, 0x61,
+	0x73, 0x65, 0x44, 0x75, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e,
+	0x64, 0x72, 0x6f, 0x6e, 0x65, 0x5f, 0x71, 0x75, 0x65, 0x65, 0x6e, 0x2e, 0x52, 0x65, 0x6c, 0x65,
+	0x61, 0x73, 0x65, 0x44, 0x75, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32,
+	0x65, 0x0a, 0x11, 0x49, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79, 0x50, 0x72, 0x6f, 0x76,
+	0x69, 0x64, 0x65, 0x72, 0x12, 0x50, 0x0a, 0x0b, 0x44, 0x65, 0x63, 0x6c, 0x61, 0x72, 0x65, 0x44,
+	0x75, 0x74, 0x73, 0x12, 0x1f, 0x2e, 0x64, 0x72, 0x6f, 0x6e, 0x65, 0x5f, 0x71, 0x75, 0x65, 0x65,
+	0x6e, 0x2e, 0x44, 0x65, 0x63, 0x6c, 0x61, 0x72, 0x65, 0x44, 0x75, 0x74, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x64, 0x72, 0x6f, 0x6e, 0x65, 0x5f, 0x71, 0x75, 0x65,
+	0x65, 0x6e, 0x2e, 0x44, 0x65, 0x63, 0x6c, 0x61, 0x72, 0x65, 0x44, 0x75, 0x74, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0xa1, 0x01, 0x0a, 0x07, 0x49, 0x6e, 0x73, 0x70, 0x65,
+	0x63, 0x74, 0x12, 0x4d, 0x0a, 0x0a, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x72, 0x6f, 0x6e, 0x65, 0x73,
+	0x12, 0x1e, 0x2e, 0x64, 0x72, 0x6f, 0x6e, 0x65, 0x5f, 0x71, 0x75, 0x65, 0x65, 0x6e, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x44, 0x72, 0x6f, 0x6e, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1f, 0x2e, 0x64, 0x72, 0x6f, 0x6e, 0x65, 0x5f, 0x71, 0x75, 0x65, 0x65, 0x6e, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x44, 0x72, 0x6f, 0x6e, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x47, 0x0a, 0x08, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x75, 0x74, 0x73, 0x12, 0x1c, 0x2e,
+	0x64, 0x72, 0x6f, 0x6e, 0x65, 0x5f, 0x71, 0x75, 0x65, 0x65, 0x6e, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x44, 0x75, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x64, 0x72,
+	0x6f, 0x6e, 0x65, 0x5f, 0x71, 0x75, 0x65, 0x65, 0x6e, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x75,
+	0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x21, 0x5a, 0x1f, 0x69, 0x6e,
+	0x66, 0x72, 0x61, 0x2f, 0x61, 0x70, 0x70, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x2f, 0x64, 0x72,
+	0x6f, 0x6e, 0x65, 0x2d, 0x71, 0x75, 0x65, 0x65, 0x6e, 0x2f, 0x61, 0x70, 0x69, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -990,48 +1203,54 @@ func file_infra_appengine_drone_queen_api_service_proto_rawDescGZIP() []byte {
 }
 
 var file_infra_appengine_drone_queen_api_service_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_infra_appengine_drone_queen_api_service_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
+var file_infra_appengine_drone_queen_api_service_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
 var file_infra_appengine_drone_queen_api_service_proto_goTypes = []interface{}{
 	(ReportDroneResponse_Status)(0),           // 0: drone_queen.ReportDroneResponse.Status
 	(*ReportDroneRequest)(nil),                // 1: drone_queen.ReportDroneRequest
-	(*ReportDroneResponse)(nil),               // 2: drone_queen.ReportDroneResponse
-	(*ReleaseDutsRequest)(nil),                // 3: drone_queen.ReleaseDutsRequest
-	(*ReleaseDutsResponse)(nil),               // 4: drone_queen.ReleaseDutsResponse
-	(*DeclareDutsRequest)(nil),                // 5: drone_queen.DeclareDutsRequest
-	(*DeclareDutsResponse)(nil),               // 6: drone_queen.DeclareDutsResponse
-	(*ListDronesRequest)(nil),                 // 7: drone_queen.ListDronesRequest
-	(*ListDronesResponse)(nil),                // 8: drone_queen.ListDronesResponse
-	(*ListDutsRequest)(nil),                   // 9: drone_queen.ListDutsRequest
-	(*ListDutsResponse)(nil),                  // 10: drone_queen.ListDutsResponse
-	(*ReportDroneRequest_LoadIndicators)(nil), // 11: drone_queen.ReportDroneRequest.LoadIndicators
-	(*DeclareDutsRequest_Dut)(nil),            // 12: drone_queen.DeclareDutsRequest.Dut
-	(*ListDronesResponse_Drone)(nil),          // 13: drone_queen.ListDronesResponse.Drone
-	(*ListDutsResponse_Dut)(nil),              // 14: drone_queen.ListDutsResponse.Dut
-	(*timestamppb.Timestamp)(nil),             // 15: google.protobuf.Timestamp
+	(*BotCodeVersion)(nil),                    // 2: drone_queen.BotCodeVersion
+	(*ReportDroneResponse)(nil),               // 3: drone_queen.ReportDroneResponse
+	(*ReleaseDutsRequest)(nil),                // 4: drone_queen.ReleaseDutsRequest
+	(*ReleaseDutsResponse)(nil),               // 5: drone_queen.ReleaseDutsResponse
+	(*DeclareDutsRequest)(nil),                // 6: drone_queen.DeclareDutsRequest
+	(*DeclareDutsResponse)(nil),               // 7: drone_queen.DeclareDutsResponse
+	(*ListDronesRequest)(nil),                 // 8: drone_queen.ListDronesRequest
+	(*ListDronesResponse)(nil),                // 9: drone_queen.ListDronesResponse
+	(*ListDutsRequest)(nil),                   // 10: drone_queen.ListDutsRequest
+	(*ListDutsResponse)(nil),                  // 11: drone_queen.ListDutsResponse
+	(*ReportDroneRequest_LoadIndicators)(nil), // 12: drone_queen.ReportDroneRequest.LoadIndicators
+	(*ReportDroneRequest_DutHint)(nil),        // 13: drone_queen.ReportDroneRequest.DutHint
+	(*DeclareDutsRequest_Dut)(nil),            // 14: drone_queen.DeclareDutsRequest.Dut
+	(*ListDronesResponse_Drone)(nil),          // 15: drone_queen.ListDronesResponse.Drone
+	(*ListDutsResponse_Dut)(nil),              // 16: drone_queen.ListDutsResponse.Dut
+	(*timestamppb.Timestamp)(nil),             // 17: google.protobuf.Timestamp
 }
 var file_infra_appengine_drone_queen_api_service_proto_depIdxs = []int32{
-	11, // 0: drone_queen.ReportDroneRequest.load_indicators:type_name -> drone_queen.ReportDroneRequest.LoadIndicators
-	0,  // 1: drone_queen.ReportDroneResponse.status:type_name -> drone_queen.ReportDroneResponse.Status
-	15, // 2: drone_queen.ReportDroneResponse.expiration_time:type_name -> google.protobuf.Timestamp
-	12, // 3: drone_queen.DeclareDutsRequest.available_duts:type_name -> drone_queen.DeclareDutsRequest.Dut
-	13, // 4: drone_queen.ListDronesResponse.drones:type_name -> drone_queen.ListDronesResponse.Drone
-	14, // 5: drone_queen.ListDutsResponse.duts:type_name -> drone_queen.ListDutsResponse.Dut
-	15, // 6: drone_queen.ListDronesResponse.Drone.expiration_time:type_name -> google.protobuf.Timestamp
-	1,  // 7: drone_queen.Drone.ReportDrone:input_type -> drone_queen.ReportDroneRequest
-	3,  // 8: drone_queen.Drone.ReleaseDuts:input_type -> drone_queen.ReleaseDutsRequest
-	5,  // 9: drone_queen.InventoryProvider.DeclareDuts:input_type -> drone_queen.DeclareDutsRequest
-	7,  // 10: drone_queen.Inspect.ListDrones:input_type -> drone_queen.ListDronesRequest
-	9,  // 11: drone_queen.Inspect.ListDuts:input_type -> drone_queen.ListDutsRequest
-	2,  // 12: drone_queen.Drone.ReportDrone:output_type -> drone_queen.ReportDroneResponse
-	4,  // 13: drone_queen.Drone.ReleaseDuts:output_type -> drone_queen.ReleaseDutsResponse
-	6,  // 14: drone_queen.InventoryProvider.DeclareDuts:output_type -> drone_queen.DeclareDutsResponse
-	8,  // 15: drone_queen.Inspect.ListDrones:output_type -> drone_queen.ListDronesResponse
-	10, // 16: drone_queen.Inspect.ListDuts:output_type -> drone_queen.ListDutsResponse
-	12, // [12:17] is the sub-list for method output_type
-	7,  // [7:12] is the sub-list for method input_type
-	7,  // [7:7] is the sub-list for extension type_name
-	7,  // [7:7] is the sub-list for extension extendee
-	0,  // [0:7] is the sub-list for field type_name
+	12, // 0: drone_queen.ReportDroneRequest.load_indicators:type_name -> drone_queen.ReportDroneRequest.LoadIndicators
+	13, // 1: drone_queen.ReportDroneRequest.previously_hosted_duts:type_name -> drone_queen.ReportDroneRequest.DutHint
+	2,  // 2: drone_queen.ReportDroneRequest.bot_code_versions:type_name -> drone_queen.BotCodeVersion
+	0,  // 3: drone_queen.ReportDroneResponse.status:type_name -> drone_queen.ReportDroneResponse.Status
+	17, // 4: drone_queen.ReportDroneResponse.expiration_time:type_name -> google.protobuf.Timestamp
+	14, // 5: drone_queen.DeclareDutsRequest.available_duts:type_name -> drone_queen.DeclareDutsRequest.Dut
+	15, // 6: drone_queen.ListDronesResponse.drones:type_name -> drone_queen.ListDronesResponse.Drone
+	16, // 7: drone_queen.ListDutsResponse.duts:type_name -> drone_queen.ListDutsResponse.Dut
+	17, // 8: drone_queen.ReportDroneRequest.DutHint.last_hosted_time:type_name -> google.protobuf.Timestamp
+	17, // 9: drone_queen.ListDronesResponse.Drone.expiration_time:type_name -> google.protobuf.Timestamp
+	2,  // 10: drone_queen.ListDronesResponse.Drone.bot_code_versions:type_name -> drone_queen.BotCodeVersion
+	1,  // 11: drone_queen.Drone.ReportDrone:input_type -> drone_queen.ReportDroneRequest
+	4,  // 12: drone_queen.Drone.ReleaseDuts:input_type -> drone_queen.ReleaseDutsRequest
+	6,  // 13: drone_queen.InventoryProvider.DeclareDuts:input_type -> drone_queen.DeclareDutsRequest
+	8,  // 14: drone_queen.Inspect.ListDrones:input_type -> drone_queen.ListDronesRequest
+	10, // 15: drone_queen.Inspect.ListDuts:input_type -> drone_queen.ListDutsRequest
+	3,  // 16: drone_queen.Drone.ReportDrone:output_type -> drone_queen.ReportDroneResponse
+	5,  // 17: drone_queen.Drone.ReleaseDuts:output_type -> drone_queen.ReleaseDutsResponse
+	7,  // 18: drone_queen.InventoryProvider.DeclareDuts:output_type -> drone_queen.DeclareDutsResponse
+	9,  // 19: drone_queen.Inspect.ListDrones:output_type -> drone_queen.ListDronesResponse
+	11, // 20: drone_queen.Inspect.ListDuts:output_type -> drone_queen.ListDutsResponse
+	16, // [16:21] is the sub-list for method output_type
+	11, // [11:16] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
 }
 
 func init() { file_infra_appengine_drone_queen_api_service_proto_init() }
@@ -1053,7 +1272,7 @@ func file_infra_appengine_drone_queen_api_service_proto_init() {
 			}
 		}
 		file_infra_appengine_drone_queen_api_service_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReportDroneResponse); i {
+			switch v := v.(*BotCodeVersion); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1065,7 +1284,7 @@ func file_infra_appengine_drone_queen_api_service_proto_init() {
 			}
 		}
 		file_infra_appengine_drone_queen_api_service_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReleaseDutsRequest); i {
+			switch v := v.(*ReportDroneResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1077,7 +1296,7 @@ func file_infra_appengine_drone_queen_api_service_proto_init() {
 			}
 		}
 		file_infra_appengine_drone_queen_api_service_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReleaseDutsResponse); i {
+			switch v := v.(*ReleaseDutsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1089,7 +1308,7 @@ func file_infra_appengine_drone_queen_api_service_proto_init() {
 			}
 		}
 		file_infra_appengine_drone_queen_api_service_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeclareDutsRequest); i {
+			switch v := v.(*ReleaseDutsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1101,7 +1320,7 @@ func file_infra_appengine_drone_queen_api_service_proto_init() {
 			}
 		}
 		file_infra_appengine_drone_queen_api_service_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeclareDutsResponse); i {
+			switch v := v.(*DeclareDutsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1113,7 +1332,7 @@ func file_infra_appengine_drone_queen_api_service_proto_init() {
 			}
 		}
 		file_infra_appengine_drone_queen_api_service_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListDronesRequest); i {
+			switch v := v.(*DeclareDutsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1125,7 +1344,7 @@ func file_infra_appengine_drone_queen_api_service_proto_init() {
 			}
 		}
 		file_infra_appengine_drone_queen_api_service_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListDronesResponse); i {
+			switch v := v.(*ListDronesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1137,7 +1356,7 @@ func file_infra_appengine_drone_queen_api_service_proto_init() {
 			}
 		}
 		file_infra_appengine_drone_queen_api_service_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListDutsRequest); i {
+			switch v := v.(*ListDronesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1149,7 +1368,7 @@ func file_infra_appengine_drone_queen_api_service_proto_init() {
 			}
 		}
 		file_infra_appengine_drone_queen_api_service_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListDutsResponse); i {
+			switch v := v.(*ListDutsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1161,7 +1380,7 @@ func file_infra_appengine_drone_queen_api_service_proto_init() {
 			}
 		}
 		file_infra_appengine_drone_queen_api_service_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReportDroneRequest_LoadIndicators); i {
+			switch v := v.(*ListDutsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1173,7 +1392,7 @@ func file_infra_appengine_drone_queen_api_service_proto_init() {
 			}
 		}
 		file_infra_appengine_drone_queen_api_service_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeclareDutsRequest_Dut); i {
+			switch v := v.(*ReportDroneRequest_LoadIndicators); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1185,7 +1404,7 @@ func file_infra_appengine_drone_queen_api_service_proto_init() {
 			}
 		}
 		file_infra_appengine_drone_queen_api_service_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListDronesResponse_Drone); i {
+			switch v := v.(*ReportDroneRequest_DutHint); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1197,6 +1416,30 @@ func file_infra_appengine_drone_queen_api_service_proto_init() {
 			}
 		}
 		file_infra_appengine_drone_queen_api_service_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeclareDutsRequest_Dut); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_infra_appengine_drone_queen_api_service_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListDronesResponse_Drone); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_infra_appengine_drone_queen_api_service_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ListDutsResponse_Dut); i {
 			case 0:
 				return &v.state
@@ -1215,7 +1458,7 @@ func file_infra_appengine_drone_queen_api_service_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_infra_appengine_drone_queen_api_service_proto_rawDesc,
 			NumEnums:      1,
-			NumMessages:   14,
+			NumMessages:   16,
 			NumExtensions: 0,
 			NumServices:   3,
 		},
@@ -17,6 +17,7 @@ package config
 
 import (
 	"context"
+	"hash/fnv"
 	"net/http"
 	"time"
 
@@ -100,3 +101,41 @@ func AssignmentDuration(ctx context.Context) time.Duration {
 	}
 	return gd
 }
+
+// AffinityHintWindow returns the configured validity window for DUT
+// affinity hints reported by drones.  A zero duration means hints
+// are disabled.
+func AffinityHintWindow(ctx context.Context) time.Duration {
+	pd := Get(ctx).GetAffinityHintWindow()
+	if pd == nil {
+		const defaultWindow = 30 * time.Minute
+		return defaultWindow
+	}
+	gd, err := ptypes.Duration(pd)
+	if err != nil {
+		panic(err)
+	}
+	return gd
+}
+
+// BotCodeVersion returns the Swarming bot code version that a drone
+// should use, according to the configured bot code rollout.  The drone
+// is placed in the canary group by a hash of key, which should be
+// stable for the drone, e.g. its description.  An empty version means
+// the drone should use the current bot code version of the Swarming
+// server.
+func BotCodeVersion(ctx context.Context, key string) string {
+	r := Get(ctx).GetBotCodeRollout()
+	if inCanary(key, r.GetCanaryPercent()) {
+		return r.GetCanaryVersion()
+	}
+	return r.GetStableVersion()
+}
+
+// inCanary returns true if key falls in the canary group covering the
+// given percentage of keys.
+func inCanary(key string, percent uint32) bool {
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(key))
+	return h.Sum32()%100 < percent
+}
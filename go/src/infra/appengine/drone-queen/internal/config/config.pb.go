@@ -39,6 +39,15 @@ type Config struct {
 	// instance identifies which instance of the service this is.  For
 	// example, this could be prod for the prod instance.
 	Instance string `protobuf:"bytes,3,opt,name=instance,proto3" json:"instance,omitempty"`
+	// affinity_hint_window is how long DUT affinity hints reported by
+	// drones remain valid.  Hints older than this are ignored and the
+	// DUTs are assigned normally.  If unset, a default window is used.
+	// A zero duration disables affinity hints.
+	AffinityHintWindow *durationpb.Duration `protobuf:"bytes,4,opt,name=affinity_hint_window,json=affinityHintWindow,proto3" json:"affinity_hint_window,omitempty"`
+	// bot_code_rollout controls the Swarming bot code version used by
+	// drones.  If unset, drones use the current bot code version of the
+	// Swarming server.
+	BotCodeRollout *BotCodeRollout `protobuf:"bytes,5,opt,name=bot_code_rollout,json=botCodeRollout,proto3" json:"bot_code_rollout,omitempty"`
 }
 
 func (x *Config) Reset() {
@@ -94,6 +103,95 @@ func (x *Config) GetInstance() string {
 	return ""
 }
 
+func (x *Config) GetAffinityHintWindow() *durationpb.Duration {
+	if x != nil {
+		return x.AffinityHintWindow
+	}
+	return nil
+}
+
+func (x *Config) GetBotCodeRollout() *BotCodeRollout {
+	if x != nil {
+		return x.BotCodeRollout
+	}
+	return nil
+}
+
+// BotCodeRollout controls a staged rollout of Swarming bot code to
+// drones.  Drones are split into a canary and a stable group by a hash
+// of their description, so a drone stays in the same group across
+// restarts.
+type BotCodeRollout struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// stable_version is the bot code version pinned on drones outside
+	// the canary group.  If empty, these drones use the current bot code
+	// version of the Swarming server.
+	StableVersion string `protobuf:"bytes,1,opt,name=stable_version,json=stableVersion,proto3" json:"stable_version,omitempty"`
+	// canary_version is the bot code version pinned on drones in the
+	// canary group.  If empty, these drones use the current bot code
+	// version of the Swarming server.
+	CanaryVersion string `protobuf:"bytes,2,opt,name=canary_version,json=canaryVersion,proto3" json:"canary_version,omitempty"`
+	// canary_percent is the percentage of drones, from 0 to 100, in the
+	// canary group.
+	CanaryPercent uint32 `protobuf:"varint,3,opt,name=canary_percent,json=canaryPercent,proto3" json:"canary_percent,omitempty"`
+}
+
+func (x *BotCodeRollout) Reset() {
+	*x = BotCodeRollout{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_infra_appengine_drone_queen_internal_config_config_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BotCodeRollout) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BotCodeRollout) ProtoMessage() {}
+
+func (x *BotCodeRollout) ProtoReflect() protoreflect.Message {
+	mi := &file_infra_appengine_drone_queen_internal_config_config_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BotCodeRollout.ProtoReflect.Descriptor instead.
+func (*BotCodeRollout) Descriptor() ([]byte, []int) {
+	return file_infra_appengine_drone_queen_internal_config_config_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *BotCodeRollout) GetStableVersion() string {
+	if x != nil {
+		return x.StableVersion
+	}
+	return ""
+}
+
+func (x *BotCodeRollout) GetCanaryVersion() string {
+	if x != nil {
+		return x.CanaryVersion
+	}
+	return ""
+}
+
+func (x *BotCodeRollout) GetCanaryPercent() uint32 {
+	if x != nil {
+		return x.CanaryPercent
+	}
+	return 0
+}
+
 // AccessGroups holds access group configuration
 type AccessGroups struct {
 	state         protoimpl.MessageState
@@ -111,7 +209,7 @@ type AccessGroups struct {
 func (x *AccessGroups) Reset() {
 	*x = AccessGroups{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_infra_appengine_drone_queen_internal_config_config_proto_msgTypes[1]
+		mi := &file_infra_appengine_drone_queen_internal_config_config_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -124,7 +222,7 @@ func (x *AccessGroups) String() string {
 func (*AccessGroups) ProtoMessage() {}
 
 func (x *AccessGroups) ProtoReflect() protoreflect.Message {
-	mi := &file_infra_appengine_drone_queen_internal_config_config_proto_msgTypes[1]
+	mi := &file_infra_appengine_drone_queen_internal_config_config_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -137,7 +235,7 @@ func (x *AccessGroups) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AccessGroups.ProtoReflect.Descriptor instead.
 func (*AccessGroups) Descriptor() ([]byte, []int) {
-	return file_infra_appengine_drone_queen_internal_config_config_proto_rawDescGZIP(), []int{1}
+	return file_infra_appengine_drone_queen_internal_config_config_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *AccessGroups) GetDrones() string {
@@ -170,8 +268,8 @@ var file_infra_appengine_drone_queen_internal_config_config_proto_rawDesc = []by
 	0x6e, 0x66, 0x69, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x12, 0x64, 0x72, 0x6f, 0x6e,
 	0x65, 0x5f, 0x71, 0x75, 0x65, 0x65, 0x6e, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x1a, 0x1e,
 	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f,
-	0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xb7,
-	0x01, 0x0a, 0x06, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x45, 0x0a, 0x0d, 0x61, 0x63, 0x63,
+	0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xd2,
+	0x02, 0x0a, 0x06, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x45, 0x0a, 0x0d, 0x61, 0x63, 0x63,
 	0x65, 0x73, 0x73, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
 	0x32, 0x20, 0x2e, 0x64, 0x72, 0x6f, 0x6e, 0x65, 0x5f, 0x71, 0x75, 0x65, 0x65, 0x6e, 0x2e, 0x63,
 	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x47, 0x72, 0x6f, 0x75,
@@ -182,18 +280,36 @@ var file_infra_appengine_drone_queen_internal_config_config_proto_rawDesc = []by
 	0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x12, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e,
 	0x6d, 0x65, 0x6e, 0x74, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08,
 	0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
-	0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x22, 0x77, 0x0a, 0x0c, 0x41, 0x63, 0x63, 0x65,
-	0x73, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x72, 0x6f, 0x6e,
-	0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x72, 0x6f, 0x6e, 0x65, 0x73,
-	0x12, 0x2f, 0x0a, 0x13, 0x69, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79, 0x5f, 0x70, 0x72,
-	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x69,
-	0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
-	0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x74, 0x6f, 0x72,
-	0x73, 0x42, 0x2d, 0x5a, 0x2b, 0x69, 0x6e, 0x66, 0x72, 0x61, 0x2f, 0x61, 0x70, 0x70, 0x65, 0x6e,
-	0x67, 0x69, 0x6e, 0x65, 0x2f, 0x64, 0x72, 0x6f, 0x6e, 0x65, 0x2d, 0x71, 0x75, 0x65, 0x65, 0x6e,
-	0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x4b, 0x0a, 0x14, 0x61, 0x66, 0x66, 0x69,
+	0x6e, 0x69, 0x74, 0x79, 0x5f, 0x68, 0x69, 0x6e, 0x74, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x12, 0x61, 0x66, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x79, 0x48, 0x69, 0x6e, 0x74, 0x57,
+	0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x4c, 0x0a, 0x10, 0x62, 0x6f, 0x74, 0x5f, 0x63, 0x6f, 0x64,
+	0x65, 0x5f, 0x72, 0x6f, 0x6c, 0x6c, 0x6f, 0x75, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x22, 0x2e, 0x64, 0x72, 0x6f, 0x6e, 0x65, 0x5f, 0x71, 0x75, 0x65, 0x65, 0x6e, 0x2e, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x2e, 0x42, 0x6f, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x6f, 0x6c, 0x6c,
+	0x6f, 0x75, 0x74, 0x52, 0x0e, 0x62, 0x6f, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x6f, 0x6c, 0x6c,
+	0x6f, 0x75, 0x74, 0x22, 0x85, 0x01, 0x0a, 0x0e, 0x42, 0x6f, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x52,
+	0x6f, 0x6c, 0x6c, 0x6f, 0x75, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x74, 0x61, 0x62, 0x6c, 0x65,
+	0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
+	0x73, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x25, 0x0a,
+	0x0e, 0x63, 0x61, 0x6e, 0x61, 0x72, 0x79, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x61, 0x6e, 0x61, 0x72, 0x79, 0x56, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x61, 0x6e, 0x61, 0x72, 0x79, 0x5f, 0x70,
+	0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x63, 0x61,
+	0x6e, 0x61, 0x72, 0x79, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x22, 0x77, 0x0a, 0x0c, 0x41,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x64,
+	0x72, 0x6f, 0x6e, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x72, 0x6f,
+	0x6e, 0x65, 0x73, 0x12, 0x2f, 0x0a, 0x13, 0x69, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79,
+	0x5f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x12, 0x69, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79, 0x50, 0x72, 0x6f, 0x76, 0x69,
+	0x64, 0x65, 0x72, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x73, 0x70, 0x65, 0x63,
+	0x74, 0x6f, 0x72, 0x73, 0x42, 0x2d, 0x5a, 0x2b, 0x69, 0x6e, 0x66, 0x72, 0x61, 0x2f, 0x61, 0x70,
+	0x70, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x2f, 0x64, 0x72, 0x6f, 0x6e, 0x65, 0x2d, 0x71, 0x75,
+	0x65, 0x65, 0x6e, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -208,20 +324,23 @@ func file_infra_appengine_drone_queen_internal_config_config_proto_rawDescGZIP()
 	return file_infra_appengine_drone_queen_internal_config_config_proto_rawDescData
 }
 
-var file_infra_appengine_drone_queen_internal_config_config_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_infra_appengine_drone_queen_internal_config_config_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
 var file_infra_appengine_drone_queen_internal_config_config_proto_goTypes = []interface{}{
 	(*Config)(nil),              // 0: drone_queen.config.Config
-	(*AccessGroups)(nil),        // 1: drone_queen.config.AccessGroups
-	(*durationpb.Duration)(nil), // 2: google.protobuf.Duration
+	(*BotCodeRollout)(nil),      // 1: drone_queen.config.BotCodeRollout
+	(*AccessGroups)(nil),        // 2: drone_queen.config.AccessGroups
+	(*durationpb.Duration)(nil), // 3: google.protobuf.Duration
 }
 var file_infra_appengine_drone_queen_internal_config_config_proto_depIdxs = []int32{
-	1, // 0: drone_queen.config.Config.access_groups:type_name -> drone_queen.config.AccessGroups
-	2, // 1: drone_queen.config.Config.assignment_duration:type_name -> google.protobuf.Duration
-	2, // [2:2] is the sub-list for method output_type
-	2, // [2:2] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	2, // 0: drone_queen.config.Config.access_groups:type_name -> drone_queen.config.AccessGroups
+	3, // 1: drone_queen.config.Config.assignment_duration:type_name -> google.protobuf.Duration
+	3, // 2: drone_queen.config.Config.affinity_hint_window:type_name -> google.protobuf.Duration
+	1, // 3: drone_queen.config.Config.bot_code_rollout:type_name -> drone_queen.config.BotCodeRollout
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
 }
 
 func init() { file_infra_appengine_drone_queen_internal_config_config_proto_init() }
@@ -243,6 +362,18 @@ func file_infra_appengine_drone_queen_internal_config_config_proto_init() {
 			}
 		}
 		file_infra_appengine_drone_queen_internal_config_config_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BotCodeRollout); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_infra_appengine_drone_queen_internal_config_config_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*AccessGroups); i {
 			case 0:
 				return &v.state
@@ -261,7 +392,7 @@ func file_infra_appengine_drone_queen_internal_config_config_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_infra_appengine_drone_queen_internal_config_config_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   2,
+			NumMessages:   3,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -0,0 +1,76 @@
+// Copyright 2021 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"context"
+	"fmt"
+	"testing"
+)
+
+func TestBotCodeVersion(t *testing.T) {
+	t.Parallel()
+	t.Run("no config", func(t *testing.T) {
+		t.Parallel()
+		if got := BotCodeVersion(context.Background(), "drone1"); got != "" {
+			t.Errorf("Got version %q; want empty", got)
+		}
+	})
+	withRollout := func(percent uint32) context.Context {
+		return Use(context.Background(), &Config{
+			BotCodeRollout: &BotCodeRollout{
+				StableVersion: "stable",
+				CanaryVersion: "canary",
+				CanaryPercent: percent,
+			},
+		})
+	}
+	t.Run("no canary", func(t *testing.T) {
+		t.Parallel()
+		ctx := withRollout(0)
+		for i := 0; i < 100; i++ {
+			if got := BotCodeVersion(ctx, fmt.Sprintf("drone%d", i)); got != "stable" {
+				t.Fatalf("Got version %q for drone%d; want stable", got, i)
+			}
+		}
+	})
+	t.Run("all canary", func(t *testing.T) {
+		t.Parallel()
+		ctx := withRollout(100)
+		for i := 0; i < 100; i++ {
+			if got := BotCodeVersion(ctx, fmt.Sprintf("drone%d", i)); got != "canary" {
+				t.Fatalf("Got version %q for drone%d; want canary", got, i)
+			}
+		}
+	})
+	t.Run("partial canary", func(t *testing.T) {
+		t.Parallel()
+		ctx := withRollout(10)
+		canaries := 0
+		for i := 0; i < 1000; i++ {
+			key := fmt.Sprintf("drone%d.example.com", i)
+			got := BotCodeVersion(ctx, key)
+			if got == "canary" {
+				canaries++
+			}
+			if again := BotCodeVersion(ctx, key); again != got {
+				t.Fatalf("Got version %q then %q for %s; want stable group", got, again, key)
+			}
+		}
+		if canaries < 50 || canaries > 150 {
+			t.Errorf("Got %d canaries out of 1000; want about 100", canaries)
+		}
+	})
+}
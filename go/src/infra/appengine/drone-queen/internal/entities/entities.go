@@ -72,9 +72,42 @@ type Drone struct {
 	Expiration  time.Time
 	Description string
 	Hive        string
+	// BotCodeVersions are the Swarming bot code versions last
+	// reported by the drone for its bots.
+	BotCodeVersions []BotCodeVersion `gae:",noindex"`
+	// UnavailableDUTs are the DUTs last reported by the drone as
+	// drained on the drone host.  Assigned DUTs among these stay
+	// assigned to the drone, but have no bot running.
+	UnavailableDUTs []DUTID `gae:",noindex"`
 }
 
 // Equal implements equality.
 func (d Drone) Equal(v Drone) bool {
-	return d == v
+	if d.ID != v.ID || !d.Expiration.Equal(v.Expiration) || d.Description != v.Description || d.Hive != v.Hive {
+		return false
+	}
+	if len(d.BotCodeVersions) != len(v.BotCodeVersions) {
+		return false
+	}
+	for i := range d.BotCodeVersions {
+		if d.BotCodeVersions[i] != v.BotCodeVersions[i] {
+			return false
+		}
+	}
+	if len(d.UnavailableDUTs) != len(v.UnavailableDUTs) {
+		return false
+	}
+	for i := range d.UnavailableDUTs {
+		if d.UnavailableDUTs[i] != v.UnavailableDUTs[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// BotCodeVersion is the Swarming bot code version that a drone's bot
+// for a DUT is running.
+type BotCodeVersion struct {
+	DUT     DUTID
+	Version string
 }
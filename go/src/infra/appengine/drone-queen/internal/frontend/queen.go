@@ -16,6 +16,7 @@ package frontend
 
 import (
 	"context"
+	"sort"
 	"time"
 
 	"github.com/golang/protobuf/ptypes"
@@ -67,6 +68,8 @@ func (q *DroneQueenImpl) ReportDrone(ctx context.Context, req *api.ReportDroneRe
 		d.Expiration = q.now().Add(config.AssignmentDuration(ctx)).UTC()
 		d.Description = req.GetDroneDescription()
 		d.Hive = req.GetHive()
+		d.BotCodeVersions = botCodeVersionsFromAPI(req.GetBotCodeVersions())
+		d.UnavailableDUTs = dutIDs(req.GetUnavailableDuts())
 		if err = datastore.Put(ctx, &d); err != nil {
 			return errors.Annotate(err, "refresh drone expiration").Err()
 		}
@@ -82,9 +85,10 @@ func (q *DroneQueenImpl) ReportDrone(ctx context.Context, req *api.ReportDroneRe
 		return nil, err
 	}
 	// Assign new DUTs.
+	preferred := recentDUTHints(req.GetPreviouslyHostedDuts(), q.now(), config.AffinityHintWindow(ctx))
 	var duts []*entities.DUT
 	f = func(ctx context.Context) error {
-		duts, err = queries.AssignNewDUTs(ctx, id, req.GetLoadIndicators(), req.GetHive())
+		duts, err = queries.AssignNewDUTs(ctx, id, req.GetLoadIndicators(), req.GetHive(), preferred)
 		return err
 	}
 	if err = datastore.RunInTransaction(ctx, f, nil); err != nil {
@@ -96,6 +100,7 @@ func (q *DroneQueenImpl) ReportDrone(ctx context.Context, req *api.ReportDroneRe
 		// Input time should always be valid.
 		panic(err)
 	}
+	res.BotCodeVersion = config.BotCodeVersion(ctx, botCodeRolloutKey(req, id))
 	for _, d := range duts {
 		if d.AssignedDrone != id {
 			panic(d)
@@ -234,6 +239,8 @@ func (q *DroneQueenImpl) ListDrones(ctx context.Context, req *api.ListDronesRequ
 			ExpirationTime:   t,
 			DroneDescription: d.Description,
 			Hive:             d.Hive,
+			BotCodeVersions:  botCodeVersionsToAPI(d.BotCodeVersions),
+			UnavailableDuts:  dutNames(d.UnavailableDUTs),
 		})
 	}
 	return res, nil
@@ -260,6 +267,106 @@ func (q *DroneQueenImpl) ListDuts(ctx context.Context, req *api.ListDutsRequest)
 	return res, nil
 }
 
+// recentDUTHints returns the DUTs from the affinity hints which were
+// hosted within the given window before now, most recently hosted
+// first.  A non-positive window disables hints.
+func recentDUTHints(hints []*api.ReportDroneRequest_DutHint, now time.Time, window time.Duration) []entities.DUTID {
+	if window <= 0 {
+		return nil
+	}
+	lastHosted := make(map[entities.DUTID]time.Time)
+	for _, h := range hints {
+		if h.GetName() == "" {
+			continue
+		}
+		t, err := ptypes.Timestamp(h.GetLastHostedTime())
+		if err != nil {
+			continue
+		}
+		if now.Sub(t) > window {
+			continue
+		}
+		id := entities.DUTID(h.GetName())
+		if t.After(lastHosted[id]) {
+			lastHosted[id] = t
+		}
+	}
+	ids := make([]entities.DUTID, 0, len(lastHosted))
+	for id := range lastHosted {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool {
+		ti, tj := lastHosted[ids[i]], lastHosted[ids[j]]
+		if !ti.Equal(tj) {
+			return ti.After(tj)
+		}
+		return ids[i] < ids[j]
+	})
+	return ids
+}
+
+// botCodeRolloutKey returns the key used to place a drone in a bot
+// code rollout group.  The drone description is preferred as it is
+// stable across drone restarts, unlike the drone UUID.
+func botCodeRolloutKey(req *api.ReportDroneRequest, id entities.DroneID) string {
+	if d := req.GetDroneDescription(); d != "" {
+		return d
+	}
+	return string(id)
+}
+
+// botCodeVersionsFromAPI converts the bot code versions reported by a
+// drone for storing in the drone entity.
+func botCodeVersionsFromAPI(v []*api.BotCodeVersion) []entities.BotCodeVersion {
+	var r []entities.BotCodeVersion
+	for _, v := range v {
+		if v.GetDut() == "" {
+			continue
+		}
+		r = append(r, entities.BotCodeVersion{
+			DUT:     entities.DUTID(v.GetDut()),
+			Version: v.GetVersion(),
+		})
+	}
+	return r
+}
+
+// botCodeVersionsToAPI converts the bot code versions stored in a drone
+// entity for API responses.
+func botCodeVersionsToAPI(v []entities.BotCodeVersion) []*api.BotCodeVersion {
+	var r []*api.BotCodeVersion
+	for _, v := range v {
+		r = append(r, &api.BotCodeVersion{
+			Dut:     string(v.DUT),
+			Version: v.Version,
+		})
+	}
+	return r
+}
+
+// dutIDs converts DUT names reported by a drone for storing in the
+// drone entity.  Empty names are ignored.
+func dutIDs(names []string) []entities.DUTID {
+	var r []entities.DUTID
+	for _, n := range names {
+		if n == "" {
+			continue
+		}
+		r = append(r, entities.DUTID(n))
+	}
+	return r
+}
+
+// dutNames converts DUT IDs stored in a drone entity for API
+// responses.
+func dutNames(ids []entities.DUTID) []string {
+	var r []string
+	for _, id := range ids {
+		r = append(r, string(id))
+	}
+	return r
+}
+
 func (q *DroneQueenImpl) now() time.Time {
 	if q.nowFunc != nil {
 		return q.nowFunc()
@@ -21,6 +21,7 @@ import (
 	"time"
 
 	"infra/appengine/drone-queen/api"
+	"infra/appengine/drone-queen/internal/config"
 	"infra/appengine/drone-queen/internal/entities"
 
 	"github.com/golang/protobuf/ptypes"
@@ -385,9 +386,46 @@ func TestDroneQueenImpl_ReportDrone(t *testing.T) {
 	})
 }
 
+func TestRecentDUTHints(t *testing.T) {
+	t.Parallel()
+	now := time.Date(2000, 1, 2, 3, 4, 5, 6, time.UTC)
+	hint := func(name string, ago time.Duration) *api.ReportDroneRequest_DutHint {
+		ts, err := ptypes.TimestampProto(now.Add(-ago))
+		if err != nil {
+			t.Fatal(err)
+		}
+		return &api.ReportDroneRequest_DutHint{Name: name, LastHostedTime: ts}
+	}
+	hints := []*api.ReportDroneRequest_DutHint{
+		hint("ion", 10*time.Minute),
+		hint("casty", time.Minute),
+		hint("nelo", 2*time.Hour),
+		hint("ion", 5*time.Minute),
+		hint("", time.Minute),
+		{Name: "lyra"},
+	}
+	t.Run("recent hints most recent first", func(t *testing.T) {
+		t.Parallel()
+		got := recentDUTHints(hints, now, 30*time.Minute)
+		want := []entities.DUTID{"casty", "ion"}
+		if diff := cmp.Diff(want, got); diff != "" {
+			t.Errorf("Unexpected hints (-want +got):\n%s", diff)
+		}
+	})
+	t.Run("zero window disables hints", func(t *testing.T) {
+		t.Parallel()
+		if got := recentDUTHints(hints, now, 0); len(got) != 0 {
+			t.Errorf("Got hints %v; want none", got)
+		}
+	})
+}
+
 func TestDroneQueenImpl_workflows(t *testing.T) {
 	t.Parallel()
 	t.Run("happy path", testHappyPath)
+	t.Run("restarted drone gets DUTs back", testRestartedDroneAffinity)
+	t.Run("bot code rollout", testBotCodeRollout)
+	t.Run("unavailable DUTs stay assigned", testUnavailableDUTs)
 }
 
 func testHappyPath(t *testing.T) {
@@ -443,6 +481,175 @@ func testHappyPath(t *testing.T) {
 	}
 }
 
+func testRestartedDroneAffinity(t *testing.T) {
+	t.Parallel()
+	ctx := gaetesting.TestingContextWithAppID("go-test")
+	datastore.GetTestable(ctx).Consistent(true)
+	now := time.Date(2000, 1, 2, 3, 4, 5, 6, time.UTC)
+	d := DroneQueenImpl{
+		nowFunc: staticTime(now),
+	}
+	availableDuts := []*api.DeclareDutsRequest_Dut{
+		{Name: "casty"},
+		{Name: "ion"},
+		{Name: "nelo"},
+	}
+	if _, err := d.DeclareDuts(ctx, &api.DeclareDutsRequest{AvailableDuts: availableDuts}); err != nil {
+		t.Fatal(err)
+	}
+	ts, err := ptypes.TimestampProto(now.Add(-time.Minute))
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The restarted drone reports with hints and gets its DUT back.
+	res, err := d.ReportDrone(ctx, &api.ReportDroneRequest{
+		LoadIndicators: &api.ReportDroneRequest_LoadIndicators{
+			DutCapacity: 1,
+		},
+		PreviouslyHostedDuts: []*api.ReportDroneRequest_DutHint{
+			{Name: "nelo", LastHostedTime: ts},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertSameStrings(t, []string{"nelo"}, res.AssignedDuts)
+	// A competing drone gets the remaining DUTs.
+	res, err = d.ReportDrone(ctx, &api.ReportDroneRequest{
+		LoadIndicators: &api.ReportDroneRequest_LoadIndicators{
+			DutCapacity: 3,
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertSameStrings(t, []string{"casty", "ion"}, res.AssignedDuts)
+}
+
+func testBotCodeRollout(t *testing.T) {
+	t.Parallel()
+	ctx := gaetesting.TestingContextWithAppID("go-test")
+	datastore.GetTestable(ctx).Consistent(true)
+	now := time.Date(2000, 1, 2, 3, 4, 5, 6, time.UTC)
+	d := DroneQueenImpl{
+		nowFunc: staticTime(now),
+	}
+	ctx = config.Use(ctx, &config.Config{
+		BotCodeRollout: &config.BotCodeRollout{
+			StableVersion: "stable",
+			CanaryVersion: "canary",
+			CanaryPercent: 100,
+		},
+	})
+	// The drone registers and is pinned to the canary version.
+	res, err := d.ReportDrone(ctx, &api.ReportDroneRequest{
+		DroneDescription: "drone1.example.com",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := res.GetBotCodeVersion(); got != "canary" {
+		t.Errorf("Got bot code version %q; want canary", got)
+	}
+	// The drone reports the versions its bots run.
+	_, err = d.ReportDrone(ctx, &api.ReportDroneRequest{
+		DroneUuid:        res.GetDroneUuid(),
+		DroneDescription: "drone1.example.com",
+		BotCodeVersions: []*api.BotCodeVersion{
+			{Dut: "casty", Version: "canary"},
+			{Dut: "ion", Version: "stable"},
+			{Version: "ignored"},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	lres, err := d.ListDrones(ctx, &api.ListDronesRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n := len(lres.GetDrones()); n != 1 {
+		t.Fatalf("Got %d drones; want 1", n)
+	}
+	var got []string
+	for _, v := range lres.GetDrones()[0].GetBotCodeVersions() {
+		got = append(got, v.GetDut()+"="+v.GetVersion())
+	}
+	want := []string{"casty=canary", "ion=stable"}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("Unexpected bot code versions (-want +got):\n%s", diff)
+	}
+}
+
+func testUnavailableDUTs(t *testing.T) {
+	t.Parallel()
+	ctx := gaetesting.TestingContextWithAppID("go-test")
+	datastore.GetTestable(ctx).Consistent(true)
+	now := time.Date(2000, 1, 2, 3, 4, 5, 6, time.UTC)
+	d := DroneQueenImpl{
+		nowFunc: staticTime(now),
+	}
+	availableDuts := []*api.DeclareDutsRequest_Dut{
+		{Name: "casty"},
+		{Name: "ion"},
+	}
+	if _, err := d.DeclareDuts(ctx, &api.DeclareDutsRequest{AvailableDuts: availableDuts}); err != nil {
+		t.Fatal(err)
+	}
+	res, err := d.ReportDrone(ctx, &api.ReportDroneRequest{
+		LoadIndicators: &api.ReportDroneRequest_LoadIndicators{
+			DutCapacity: 1,
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n := len(res.GetAssignedDuts()); n != 1 {
+		t.Fatalf("Got %d assigned DUTs; want 1", n)
+	}
+	dut := res.GetAssignedDuts()[0]
+	// The drone reports the DUT as drained on the drone host.
+	uuid := res.GetDroneUuid()
+	res, err = d.ReportDrone(ctx, &api.ReportDroneRequest{
+		DroneUuid: uuid,
+		LoadIndicators: &api.ReportDroneRequest_LoadIndicators{
+			DutCapacity: 1,
+		},
+		UnavailableDuts: []string{dut},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertSameStrings(t, []string{dut}, res.GetAssignedDuts())
+	// Another drone does not get the unavailable DUT.
+	res, err = d.ReportDrone(ctx, &api.ReportDroneRequest{
+		LoadIndicators: &api.ReportDroneRequest_LoadIndicators{
+			DutCapacity: 2,
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, a := range res.GetAssignedDuts() {
+		if a == dut {
+			t.Errorf("Unavailable DUT %v assigned to another drone", dut)
+		}
+	}
+	lres, err := d.ListDrones(ctx, &api.ListDronesRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []string
+	for _, dr := range lres.GetDrones() {
+		if dr.GetId() == uuid {
+			got = dr.GetUnavailableDuts()
+		}
+	}
+	if diff := cmp.Diff([]string{dut}, got); diff != "" {
+		t.Errorf("Unexpected unavailable DUTs (-want +got):\n%s", diff)
+	}
+}
+
 // goTime converts a protobuf timestamp to a Go Time.
 func goTime(t *timestamp.Timestamp) time.Time {
 	gt, err := ptypes.Timestamp(t)
@@ -101,23 +101,98 @@ func getUnassignedDUTs(ctx context.Context, n int32, hive string) ([]*entities.D
 	return duts, nil
 }
 
+// getPreferredDUTs gets at most the specified number of DUTs from
+// the preferred list that can be assigned, i.e., that exist, are
+// unassigned, are not draining, and are in the given hive.  The
+// order of the preferred list is kept.  This does not have to be run
+// in a transaction, but caveat emptor.
+func getPreferredDUTs(ctx context.Context, n int, hive string, preferred []entities.DUTID) ([]*entities.DUT, error) {
+	if n <= 0 || len(preferred) == 0 {
+		return nil, nil
+	}
+	duts := make([]*entities.DUT, len(preferred))
+	for i, id := range preferred {
+		duts[i] = &entities.DUT{ID: id, Group: entities.DUTGroupKey(ctx)}
+	}
+	var exists []bool
+	if err := datastore.Get(ctx, duts); err != nil {
+		me, ok := err.(errors.MultiError)
+		if !ok {
+			return nil, errors.Annotate(err, "get preferred DUTs").Err()
+		}
+		exists = make([]bool, len(me))
+		for i, err := range me {
+			switch {
+			case err == nil:
+				exists[i] = true
+			case !datastore.IsErrNoSuchEntity(err):
+				return nil, errors.Annotate(err, "get preferred DUT %v", preferred[i]).Err()
+			}
+		}
+	}
+	var got []*entities.DUT
+	for i, d := range duts {
+		if len(got) == n {
+			break
+		}
+		if exists != nil && !exists[i] {
+			continue
+		}
+		if d.AssignedDrone != "" || d.Draining || d.Hive != hive {
+			continue
+		}
+		got = append(got, d)
+	}
+	return got, nil
+}
+
 // AssignNewDUTs assigns new DUTs to the drone according to its load
 // indicators and current DUTs.  Returns the list of all DUTs assigned
 // to the drone. hive is the zone/hive the DUT/Drone belongs to.
 //
+// preferred is a list of DUTs that should be assigned to the drone
+// ahead of other unassigned DUTs if possible, e.g., DUTs the drone
+// hosted before it restarted.  Preferred DUTs that are not available
+// are skipped and the remaining capacity is filled normally.
+//
 // This function needs to be run within a datastore transaction.
-func AssignNewDUTs(ctx context.Context, d entities.DroneID, li *api.ReportDroneRequest_LoadIndicators, hive string) ([]*entities.DUT, error) {
+func AssignNewDUTs(ctx context.Context, d entities.DroneID, li *api.ReportDroneRequest_LoadIndicators, hive string, preferred []entities.DUTID) ([]*entities.DUT, error) {
 	currentDUTs, err := getDroneDUTs(ctx, d)
 	if err != nil {
 		return nil, errors.Annotate(err, "assign new DUTs to %v", d).Err()
 	}
 	dutsNeeded := uint32ToInt(li.GetDutCapacity()) - len(currentDUTs)
 
-	newDUTs, err := getUnassignedDUTs(ctx, int32(dutsNeeded), hive)
+	newDUTs, err := getPreferredDUTs(ctx, dutsNeeded, hive, preferred)
 	if err != nil {
 		return nil, errors.Annotate(err, "assign new DUTs to %v", d).Err()
 	}
-	logging.Infof(ctx, "Got unassigned DUTs to assign: %v", entities.FormatDUTs(newDUTs))
+	if len(newDUTs) > 0 {
+		logging.Infof(ctx, "Got preferred DUTs to assign: %v", entities.FormatDUTs(newDUTs))
+	}
+	if n := dutsNeeded - len(newDUTs); n > 0 {
+		// Query for extra DUTs since the query may return
+		// preferred DUTs that were already picked.
+		unassigned, err := getUnassignedDUTs(ctx, int32(n+len(newDUTs)), hive)
+		if err != nil {
+			return nil, errors.Annotate(err, "assign new DUTs to %v", d).Err()
+		}
+		picked := make(map[entities.DUTID]bool, len(newDUTs))
+		for _, dut := range newDUTs {
+			picked[dut.ID] = true
+		}
+		var others []*entities.DUT
+		for _, dut := range unassigned {
+			if len(others) == n {
+				break
+			}
+			if !picked[dut.ID] {
+				others = append(others, dut)
+			}
+		}
+		logging.Infof(ctx, "Got unassigned DUTs to assign: %v", entities.FormatDUTs(others))
+		newDUTs = append(newDUTs, others...)
+	}
 	for _, dut := range newDUTs {
 		dut.AssignedDrone = d
 	}
@@ -342,7 +342,7 @@ func TestAssignNewDUTs(t *testing.T) {
 			var got []*entities.DUT
 			f := func(ctx context.Context) error {
 				var err error
-				got, err = AssignNewDUTs(ctx, "earthes", c.li, "")
+				got, err = AssignNewDUTs(ctx, "earthes", c.li, "", nil)
 				return err
 			}
 			if err := datastore.RunInTransaction(ctx, f, nil); err != nil {
@@ -364,6 +364,168 @@ func TestAssignNewDUTs(t *testing.T) {
 	}
 }
 
+func TestAssignNewDUTs_preferred(t *testing.T) {
+	t.Parallel()
+	cases := []struct {
+		desc      string
+		initial   []*entities.DUT
+		li        *api.ReportDroneRequest_LoadIndicators
+		preferred []entities.DUTID
+		want      []*entities.DUT
+	}{
+		{
+			desc: "assign preferred DUTs first",
+			initial: []*entities.DUT{
+				{ID: "ionasal"},
+				{ID: "nayaflask"},
+				{ID: "nei"},
+			},
+			li:        &api.ReportDroneRequest_LoadIndicators{DutCapacity: 1},
+			preferred: []entities.DUTID{"nei"},
+			want: []*entities.DUT{
+				{ID: "nei", AssignedDrone: "earthes"},
+			},
+		},
+		{
+			desc: "fill remaining capacity normally",
+			initial: []*entities.DUT{
+				{ID: "ionasal"},
+				{ID: "nayaflask"},
+				{ID: "nei"},
+			},
+			li:        &api.ReportDroneRequest_LoadIndicators{DutCapacity: 2},
+			preferred: []entities.DUTID{"nei"},
+			want: []*entities.DUT{
+				{ID: "ionasal", AssignedDrone: "earthes"},
+				{ID: "nei", AssignedDrone: "earthes"},
+			},
+		},
+		{
+			desc: "don't double count preferred DUTs returned by the query",
+			initial: []*entities.DUT{
+				{ID: "ionasal"},
+				{ID: "nayaflask"},
+				{ID: "nei"},
+			},
+			li:        &api.ReportDroneRequest_LoadIndicators{DutCapacity: 2},
+			preferred: []entities.DUTID{"ionasal"},
+			want: []*entities.DUT{
+				{ID: "ionasal", AssignedDrone: "earthes"},
+				{ID: "nayaflask", AssignedDrone: "earthes"},
+			},
+		},
+		{
+			desc: "don't take preferred DUTs from competing drone",
+			initial: []*entities.DUT{
+				{ID: "ionasal"},
+				{ID: "nei", AssignedDrone: "delta"},
+			},
+			li:        &api.ReportDroneRequest_LoadIndicators{DutCapacity: 1},
+			preferred: []entities.DUTID{"nei"},
+			want: []*entities.DUT{
+				{ID: "ionasal", AssignedDrone: "earthes"},
+			},
+		},
+		{
+			desc: "skip draining, missing, and other hive preferred DUTs",
+			initial: []*entities.DUT{
+				{ID: "ionasal"},
+				{ID: "nei", Draining: true},
+				{ID: "casty", Hive: "other"},
+			},
+			li:        &api.ReportDroneRequest_LoadIndicators{DutCapacity: 1},
+			preferred: []entities.DUTID{"nei", "shurelia", "casty"},
+			want: []*entities.DUT{
+				{ID: "ionasal", AssignedDrone: "earthes"},
+			},
+		},
+		{
+			desc: "don't assign preferred DUTs to full drone",
+			initial: []*entities.DUT{
+				{ID: "ionasal", AssignedDrone: "earthes"},
+				{ID: "nei"},
+			},
+			li:        &api.ReportDroneRequest_LoadIndicators{DutCapacity: 1},
+			preferred: []entities.DUTID{"nei"},
+			want: []*entities.DUT{
+				{ID: "ionasal", AssignedDrone: "earthes"},
+			},
+		},
+	}
+	for _, c := range cases {
+		c := c
+		t.Run(c.desc, func(t *testing.T) {
+			t.Parallel()
+			ctx := gaetesting.TestingContextWithAppID("go-test")
+			datastore.GetTestable(ctx).Consistent(true)
+			ctx = testlogger.Use(ctx, t)
+			applyGroup(ctx, c.initial)
+			if err := datastore.Put(ctx, c.initial); err != nil {
+				t.Fatal(err)
+			}
+			var got []*entities.DUT
+			f := func(ctx context.Context) error {
+				var err error
+				got, err = AssignNewDUTs(ctx, "earthes", c.li, "", c.preferred)
+				return err
+			}
+			if err := datastore.RunInTransaction(ctx, f, nil); err != nil {
+				t.Fatal(err)
+			}
+			applyGroup(ctx, c.want)
+			assertSameDUTs(t, c.want, got)
+		})
+	}
+}
+
+func TestAssignNewDUTs_competing_drones(t *testing.T) {
+	t.Parallel()
+	ctx := gaetesting.TestingContextWithAppID("go-test")
+	datastore.GetTestable(ctx).Consistent(true)
+	ctx = testlogger.Use(ctx, t)
+	initial := []*entities.DUT{
+		{ID: "casty"},
+		{ID: "ionasal"},
+		{ID: "nayaflask"},
+		{ID: "nei"},
+	}
+	applyGroup(ctx, initial)
+	if err := datastore.Put(ctx, initial); err != nil {
+		t.Fatal(err)
+	}
+	li := &api.ReportDroneRequest_LoadIndicators{DutCapacity: 2}
+	assign := func(d entities.DroneID, preferred []entities.DUTID) []*entities.DUT {
+		t.Helper()
+		var got []*entities.DUT
+		f := func(ctx context.Context) error {
+			var err error
+			got, err = AssignNewDUTs(ctx, d, li, "", preferred)
+			return err
+		}
+		if err := datastore.RunInTransaction(ctx, f, nil); err != nil {
+			t.Fatal(err)
+		}
+		return got
+	}
+	// The restarted drone reports first and gets its DUTs back,
+	// even though other DUTs would be picked first normally.
+	got := assign("earthes", []entities.DUTID{"nei", "nayaflask"})
+	want := []*entities.DUT{
+		{ID: "nayaflask", AssignedDrone: "earthes"},
+		{ID: "nei", AssignedDrone: "earthes"},
+	}
+	applyGroup(ctx, want)
+	assertSameDUTs(t, want, got)
+	// A competing drone claiming the same DUTs gets the rest.
+	got = assign("delta", []entities.DUTID{"nei"})
+	want = []*entities.DUT{
+		{ID: "casty", AssignedDrone: "delta"},
+		{ID: "ionasal", AssignedDrone: "delta"},
+	}
+	applyGroup(ctx, want)
+	assertSameDUTs(t, want, got)
+}
+
 func TestFreeInvalidDUTs(t *testing.T) {
 	t.Parallel()
 	now := time.Date(2000, 1, 2, 3, 4, 5, 0, time.UTC)
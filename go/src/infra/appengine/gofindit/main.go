@@ -7,13 +7,24 @@
 package main
 
 import (
+	"flag"
+
 	"go.chromium.org/luci/gae/service/datastore"
 	"go.chromium.org/luci/server"
+	"go.chromium.org/luci/server/cron"
 	"go.chromium.org/luci/server/gaeemulation"
 	"go.chromium.org/luci/server/module"
 	"go.chromium.org/luci/server/router"
+
+	gofinditpb "infra/appengine/gofindit/proto"
+	"infra/appengine/gofindit/pubsub"
+	"infra/appengine/gofindit/reporting"
+	gofinditserver "infra/appengine/gofindit/server"
 )
 
+var ingestionConfigPath = flag.String("ingestion-config", "ingestion.json",
+	"Path to the JSON file with the rules selecting the failed builds to ingest.")
+
 func init() {
 	// TODO (crbug.com/1242998): Remove when this becomes the default (~Jan 2022).
 	datastore.EnableSafeGet()
@@ -21,6 +32,7 @@ func init() {
 
 func main() {
 	modules := []module.Module{
+		cron.NewModuleFromFlags(),
 		gaeemulation.NewModuleFromFlags(),
 	}
 
@@ -29,6 +41,22 @@ func main() {
 			c.Writer.Write([]byte("Placeholder for GoFindit UI"))
 		})
 
+		ingestionConfig, err := pubsub.LoadIngestionConfig(*ingestionConfigPath)
+		if err != nil {
+			return err
+		}
+		bbHandler := &pubsub.BuildbucketHandler{
+			Config:          ingestionConfig,
+			NewBuildsClient: pubsub.NewBuildsClient,
+		}
+		// Pub/Sub subscription endpoints.
+		srv.Routes.POST("/_ah/push-handlers/buildbucket", nil, bbHandler.ServeHTTP)
+
+		// GAE crons.
+		cron.RegisterHandler("report-analysis-outcomes", reporting.CronHandler(srv.Options.CloudProject))
+
+		gofinditpb.RegisterGoFinditServer(srv.PRPC, &gofinditserver.GoFinditServer{})
+
 		return nil
 	})
 }
@@ -31,6 +31,36 @@ const (
 	AnalysisStatus_Skipped   AnalysisStatus = "Skipped"
 )
 
+// SuspectVerificationStatus is the result of verifying a suspect with rerun
+// builds.
+type SuspectVerificationStatus string
+
+const (
+	SuspectVerificationStatus_Unverified SuspectVerificationStatus = "Unverified"
+	// The suspect was confirmed to be the culprit.
+	SuspectVerificationStatus_ConfirmedCulprit SuspectVerificationStatus = "ConfirmedCulprit"
+	// The suspect was found not to cause the failure.
+	SuspectVerificationStatus_Vindicated SuspectVerificationStatus = "Vindicated"
+)
+
+// GerritAction is an action taken on the CL of a culprit in Gerrit.
+type GerritAction string
+
+const (
+	GerritAction_Comment GerritAction = "Comment"
+	GerritAction_Revert  GerritAction = "Revert"
+)
+
+// CulpritFeedback is the feedback of a sheriff on a culprit.
+type CulpritFeedback string
+
+const (
+	// The culprit caused the failure.
+	CulpritFeedback_Correct CulpritFeedback = "Correct"
+	// The culprit did not cause the failure.
+	CulpritFeedback_Incorrect CulpritFeedback = "Incorrect"
+)
+
 type GitilesCommit struct {
 	GitilesProject        string `gae:"gitiles_project"`
 	GitilesHost           string `gae:"gitiles_host"`
@@ -107,6 +137,13 @@ type CompileFailureAnalysis struct {
 	FirstFailedBuildId int64 `gae:"first_failed_build_id"`
 	// Id of the latest build in which the failures did not happen.
 	LastPassedBuildId int64 `gae:"last_passed_build_id"`
+	// The commit that the regression range starts after, exclusively.
+	// Unset if the range reaches the beginning of the history.
+	LastPassedCommit GitilesCommit `gae:"last_passed_commit"`
+	// Whether no build in which the failures did not happen was found, and the
+	// regression range was bounded by a number of commits instead.
+	// If set, LastPassedBuildId is unset.
+	UnboundedRange bool `gae:"unbounded_range"`
 }
 
 // CompileFailureInRerunBuild is a compile failure in a rerun build.
@@ -132,9 +169,20 @@ type CompileRerunBuild struct {
 
 // Culprit is the culprit of rerun analysis.
 type Culprit struct {
+	Id int64 `gae:"$id"`
 	// Key to the CompileFailureAnalysis that results in this culprit.
 	ParentAnalysis *datastore.Key `gae:"parent"`
 	GitilesCommit
+	// Time when the culprit was found.
+	CreateTime time.Time `gae:"create_time"`
+	// Actions taken on the CL of the culprit in Gerrit.
+	GerritActions []GerritAction `gae:"gerrit_actions,noindex"`
+	// Feedback of a sheriff on the culprit. Empty if no feedback was given.
+	Feedback CulpritFeedback `gae:"feedback"`
+	// Email of the sheriff who gave the feedback.
+	FeedbackUser string `gae:"feedback_user,noindex"`
+	// Time when the feedback was given.
+	FeedbackTime time.Time `gae:"feedback_time,noindex"`
 }
 
 // SuspectHint describes the reason why a CL is a suspect.
@@ -155,4 +203,6 @@ type Suspect struct {
 	// SuspectHint describes the reason why a CL is a suspect.
 	Hint SuspectHint `gae:"hint"`
 	GitilesCommit
+	// Result of verifying the suspect with rerun builds.
+	VerificationStatus SuspectVerificationStatus `gae:"verification_status"`
 }
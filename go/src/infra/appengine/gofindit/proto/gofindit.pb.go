@@ -0,0 +1,299 @@
+// Copyright 2021 The Chromium Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.26.0
+// 	protoc        v3.12.1
+// source: infra/appengine/gofindit/proto/gofindit.proto
+
+package gofinditpb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// CulpritFeedback is the feedback of a sheriff on a culprit.
+type CulpritFeedback int32
+
+const (
+	CulpritFeedback_CULPRIT_FEEDBACK_UNSPECIFIED CulpritFeedback = 0
+	// The culprit caused the failure.
+	CulpritFeedback_CORRECT CulpritFeedback = 1
+	// The culprit did not cause the failure.
+	CulpritFeedback_INCORRECT CulpritFeedback = 2
+)
+
+// Enum value maps for CulpritFeedback.
+var (
+	CulpritFeedback_name = map[int32]string{
+		0: "CULPRIT_FEEDBACK_UNSPECIFIED",
+		1: "CORRECT",
+		2: "INCORRECT",
+	}
+	CulpritFeedback_value = map[string]int32{
+		"CULPRIT_FEEDBACK_UNSPECIFIED": 0,
+		"CORRECT":                      1,
+		"INCORRECT":                    2,
+	}
+)
+
+func (x CulpritFeedback) Enum() *CulpritFeedback {
+	p := new(CulpritFeedback)
+	*p = x
+	return p
+}
+
+func (x CulpritFeedback) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CulpritFeedback) Descriptor() protoreflect.EnumDescriptor {
+	return file_infra_appengine_gofindit_proto_gofindit_proto_enumTypes[0].Descriptor()
+}
+
+func (CulpritFeedback) Type() protoreflect.EnumType {
+	return &file_infra_appengine_gofindit_proto_gofindit_proto_enumTypes[0]
+}
+
+func (x CulpritFeedback) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CulpritFeedback.Descriptor instead.
+func (CulpritFeedback) EnumDescriptor() ([]byte, []int) {
+	return file_infra_appengine_gofindit_proto_gofindit_proto_rawDescGZIP(), []int{0}
+}
+
+type MarkCulpritFeedbackRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// ID of the compile failure analysis which found the culprit.
+	AnalysisId int64 `protobuf:"varint,1,opt,name=analysis_id,json=analysisId,proto3" json:"analysis_id,omitempty"`
+	// Gitiles commit ID of the culprit.
+	CommitId string `protobuf:"bytes,2,opt,name=commit_id,json=commitId,proto3" json:"commit_id,omitempty"`
+	// The feedback on the culprit.
+	Feedback CulpritFeedback `protobuf:"varint,3,opt,name=feedback,proto3,enum=gofindit.CulpritFeedback" json:"feedback,omitempty"`
+}
+
+func (x *MarkCulpritFeedbackRequest) Reset() {
+	*x = MarkCulpritFeedbackRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_infra_appengine_gofindit_proto_gofindit_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MarkCulpritFeedbackRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkCulpritFeedbackRequest) ProtoMessage() {}
+
+func (x *MarkCulpritFeedbackRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_infra_appengine_gofindit_proto_gofindit_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkCulpritFeedbackRequest.ProtoReflect.Descriptor instead.
+func (*MarkCulpritFeedbackRequest) Descriptor() ([]byte, []int) {
+	return file_infra_appengine_gofindit_proto_gofindit_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *MarkCulpritFeedbackRequest) GetAnalysisId() int64 {
+	if x != nil {
+		return x.AnalysisId
+	}
+	return 0
+}
+
+func (x *MarkCulpritFeedbackRequest) GetCommitId() string {
+	if x != nil {
+		return x.CommitId
+	}
+	return ""
+}
+
+func (x *MarkCulpritFeedbackRequest) GetFeedback() CulpritFeedback {
+	if x != nil {
+		return x.Feedback
+	}
+	return CulpritFeedback_CULPRIT_FEEDBACK_UNSPECIFIED
+}
+
+type MarkCulpritFeedbackResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *MarkCulpritFeedbackResponse) Reset() {
+	*x = MarkCulpritFeedbackResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_infra_appengine_gofindit_proto_gofindit_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MarkCulpritFeedbackResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkCulpritFeedbackResponse) ProtoMessage() {}
+
+func (x *MarkCulpritFeedbackResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_infra_appengine_gofindit_proto_gofindit_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkCulpritFeedbackResponse.ProtoReflect.Descriptor instead.
+func (*MarkCulpritFeedbackResponse) Descriptor() ([]byte, []int) {
+	return file_infra_appengine_gofindit_proto_gofindit_proto_rawDescGZIP(), []int{1}
+}
+
+var File_infra_appengine_gofindit_proto_gofindit_proto protoreflect.FileDescriptor
+
+var file_infra_appengine_gofindit_proto_gofindit_proto_rawDesc = []byte{
+	0x0a, 0x2d, 0x69, 0x6e, 0x66, 0x72, 0x61, 0x2f, 0x61, 0x70, 0x70, 0x65, 0x6e, 0x67, 0x69, 0x6e,
+	0x65, 0x2f, 0x67, 0x6f, 0x66, 0x69, 0x6e, 0x64, 0x69, 0x74, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2f, 0x67, 0x6f, 0x66, 0x69, 0x6e, 0x64, 0x69, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
+	0x08, 0x67, 0x6f, 0x66, 0x69, 0x6e, 0x64, 0x69, 0x74, 0x22, 0x91, 0x01, 0x0a, 0x1a, 0x4d, 0x61,
+	0x72, 0x6b, 0x43, 0x75, 0x6c, 0x70, 0x72, 0x69, 0x74, 0x46, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63,
+	0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x6e, 0x61, 0x6c,
+	0x79, 0x73, 0x69, 0x73, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x61,
+	0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6f, 0x6d,
+	0x6d, 0x69, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6f,
+	0x6d, 0x6d, 0x69, 0x74, 0x49, 0x64, 0x12, 0x35, 0x0a, 0x08, 0x66, 0x65, 0x65, 0x64, 0x62, 0x61,
+	0x63, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x66, 0x69, 0x6e,
+	0x64, 0x69, 0x74, 0x2e, 0x43, 0x75, 0x6c, 0x70, 0x72, 0x69, 0x74, 0x46, 0x65, 0x65, 0x64, 0x62,
+	0x61, 0x63, 0x6b, 0x52, 0x08, 0x66, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x22, 0x1d, 0x0a,
+	0x1b, 0x4d, 0x61, 0x72, 0x6b, 0x43, 0x75, 0x6c, 0x70, 0x72, 0x69, 0x74, 0x46, 0x65, 0x65, 0x64,
+	0x62, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2a, 0x4f, 0x0a, 0x0f,
+	0x43, 0x75, 0x6c, 0x70, 0x72, 0x69, 0x74, 0x46, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x12,
+	0x20, 0x0a, 0x1c, 0x43, 0x55, 0x4c, 0x50, 0x52, 0x49, 0x54, 0x5f, 0x46, 0x45, 0x45, 0x44, 0x42,
+	0x41, 0x43, 0x4b, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
+	0x00, 0x12, 0x0b, 0x0a, 0x07, 0x43, 0x4f, 0x52, 0x52, 0x45, 0x43, 0x54, 0x10, 0x01, 0x12, 0x0d,
+	0x0a, 0x09, 0x49, 0x4e, 0x43, 0x4f, 0x52, 0x52, 0x45, 0x43, 0x54, 0x10, 0x02, 0x32, 0x70, 0x0a,
+	0x08, 0x47, 0x6f, 0x46, 0x69, 0x6e, 0x64, 0x69, 0x74, 0x12, 0x64, 0x0a, 0x13, 0x4d, 0x61, 0x72,
+	0x6b, 0x43, 0x75, 0x6c, 0x70, 0x72, 0x69, 0x74, 0x46, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b,
+	0x12, 0x24, 0x2e, 0x67, 0x6f, 0x66, 0x69, 0x6e, 0x64, 0x69, 0x74, 0x2e, 0x4d, 0x61, 0x72, 0x6b,
+	0x43, 0x75, 0x6c, 0x70, 0x72, 0x69, 0x74, 0x46, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x67, 0x6f, 0x66, 0x69, 0x6e, 0x64, 0x69,
+	0x74, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x43, 0x75, 0x6c, 0x70, 0x72, 0x69, 0x74, 0x46, 0x65, 0x65,
+	0x64, 0x62, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42,
+	0x2b, 0x5a, 0x29, 0x69, 0x6e, 0x66, 0x72, 0x61, 0x2f, 0x61, 0x70, 0x70, 0x65, 0x6e, 0x67, 0x69,
+	0x6e, 0x65, 0x2f, 0x67, 0x6f, 0x66, 0x69, 0x6e, 0x64, 0x69, 0x74, 0x2f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x3b, 0x67, 0x6f, 0x66, 0x69, 0x6e, 0x64, 0x69, 0x74, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_infra_appengine_gofindit_proto_gofindit_proto_rawDescOnce sync.Once
+	file_infra_appengine_gofindit_proto_gofindit_proto_rawDescData = file_infra_appengine_gofindit_proto_gofindit_proto_rawDesc
+)
+
+func file_infra_appengine_gofindit_proto_gofindit_proto_rawDescGZIP() []byte {
+	file_infra_appengine_gofindit_proto_gofindit_proto_rawDescOnce.Do(func() {
+		file_infra_appengine_gofindit_proto_gofindit_proto_rawDescData = protoimpl.X.CompressGZIP(file_infra_appengine_gofindit_proto_gofindit_proto_rawDescData)
+	})
+	return file_infra_appengine_gofindit_proto_gofindit_proto_rawDescData
+}
+
+var file_infra_appengine_gofindit_proto_gofindit_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_infra_appengine_gofindit_proto_gofindit_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_infra_appengine_gofindit_proto_gofindit_proto_goTypes = []interface{}{
+	(CulpritFeedback)(0),                // 0: gofindit.CulpritFeedback
+	(*MarkCulpritFeedbackRequest)(nil),  // 1: gofindit.MarkCulpritFeedbackRequest
+	(*MarkCulpritFeedbackResponse)(nil), // 2: gofindit.MarkCulpritFeedbackResponse
+}
+var file_infra_appengine_gofindit_proto_gofindit_proto_depIdxs = []int32{
+	0, // 0: gofindit.MarkCulpritFeedbackRequest.feedback:type_name -> gofindit.CulpritFeedback
+	1, // 1: gofindit.GoFindit.MarkCulpritFeedback:input_type -> gofindit.MarkCulpritFeedbackRequest
+	2, // 2: gofindit.GoFindit.MarkCulpritFeedback:output_type -> gofindit.MarkCulpritFeedbackResponse
+	2, // [2:3] is the sub-list for method output_type
+	1, // [1:2] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_infra_appengine_gofindit_proto_gofindit_proto_init() }
+func file_infra_appengine_gofindit_proto_gofindit_proto_init() {
+	if File_infra_appengine_gofindit_proto_gofindit_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_infra_appengine_gofindit_proto_gofindit_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MarkCulpritFeedbackRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_infra_appengine_gofindit_proto_gofindit_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MarkCulpritFeedbackResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_infra_appengine_gofindit_proto_gofindit_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_infra_appengine_gofindit_proto_gofindit_proto_goTypes,
+		DependencyIndexes: file_infra_appengine_gofindit_proto_gofindit_proto_depIdxs,
+		EnumInfos:         file_infra_appengine_gofindit_proto_gofindit_proto_enumTypes,
+		MessageInfos:      file_infra_appengine_gofindit_proto_gofindit_proto_msgTypes,
+	}.Build()
+	File_infra_appengine_gofindit_proto_gofindit_proto = out.File
+	file_infra_appengine_gofindit_proto_gofindit_proto_rawDesc = nil
+	file_infra_appengine_gofindit_proto_gofindit_proto_goTypes = nil
+	file_infra_appengine_gofindit_proto_gofindit_proto_depIdxs = nil
+}
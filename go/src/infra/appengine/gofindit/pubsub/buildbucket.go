@@ -0,0 +1,233 @@
+// Copyright 2021 The Chromium Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+// Package pubsub handles the Pub/Sub messages GoFindit subscribes to.
+package pubsub
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	buildbucketpb "go.chromium.org/luci/buildbucket/proto"
+	bbv1 "go.chromium.org/luci/common/api/buildbucket/buildbucket/v1"
+	"go.chromium.org/luci/common/data/strpair"
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/common/logging"
+	"go.chromium.org/luci/common/retry/transient"
+	"go.chromium.org/luci/common/tsmon/field"
+	"go.chromium.org/luci/common/tsmon/metric"
+	"go.chromium.org/luci/gae/service/datastore"
+	"go.chromium.org/luci/grpc/prpc"
+	"go.chromium.org/luci/server/auth"
+	"go.chromium.org/luci/server/router"
+
+	"infra/appengine/gofindit/model"
+)
+
+var (
+	buildCounter = metric.NewCounter(
+		"gofindit/buildbucket_pubsub/builds",
+		"The number of buildbucket builds received by GoFindit from PubSub",
+		nil,
+		// "ingested", "filtered", "transient-failure" or "permanent-failure".
+		field.String("status"))
+
+	filteredCounter = metric.NewCounter(
+		"gofindit/buildbucket_pubsub/filtered",
+		"The number of buildbucket builds filtered out by the ingestion rules",
+		nil,
+		// "project", "status", "experimental", "builder" or "failure_step".
+		field.String("reason"))
+)
+
+// buildFailureReason is the failure reason of builds which failed, as
+// opposed to infra failures.
+const buildFailureReason = "BUILD_FAILURE"
+
+// buildFields are the fields of builds fetched to evaluate the ingestion
+// rules and record the failed build.
+var buildFields = &fieldmaskpb.FieldMask{
+	Paths: []string{
+		"id", "builder", "number", "status",
+		"create_time", "start_time", "end_time",
+		"input.properties", "input.gitiles_commit", "steps",
+	},
+}
+
+// BuildsClient is the subset of buildbucketpb.BuildsClient used to fetch
+// builds.
+type BuildsClient interface {
+	GetBuild(ctx context.Context, in *buildbucketpb.GetBuildRequest, opts ...grpc.CallOption) (*buildbucketpb.Build, error)
+}
+
+// NewBuildsClient returns a Buildbucket client for the host, authenticated
+// as the service.
+func NewBuildsClient(ctx context.Context, host string) (BuildsClient, error) {
+	t, err := auth.GetRPCTransport(ctx, auth.AsSelf)
+	if err != nil {
+		return nil, err
+	}
+	return buildbucketpb.NewBuildsPRPCClient(&prpc.Client{
+		C:    &http.Client{Transport: t},
+		Host: host,
+	}), nil
+}
+
+// Sent by pubsub.
+// This struct is just convenient for unwrapping the json message.
+type pubsubMessage struct {
+	Message struct {
+		Data []byte
+	}
+}
+
+// buildMessage is the data of a message of the buildbucket Pub/Sub topic.
+type buildMessage struct {
+	Build    bbv1.LegacyApiCommonBuildMessage
+	Hostname string
+}
+
+// BuildbucketHandler handles buildbucket Pub/Sub messages, ingesting the
+// failed builds which match the ingestion rules.
+type BuildbucketHandler struct {
+	// Config holds the ingestion rules.
+	Config *IngestionConfig
+	// NewBuildsClient returns a Buildbucket client for a host.
+	NewBuildsClient func(ctx context.Context, host string) (BuildsClient, error)
+}
+
+// ServeHTTP accepts and processes a buildbucket Pub/Sub message.
+func (h *BuildbucketHandler) ServeHTTP(ctx *router.Context) {
+	status := "unknown"
+	defer func() {
+		// Closure for late binding.
+		buildCounter.Add(ctx.Context, 1, status)
+	}()
+
+	ingested, err := h.handle(ctx.Context, ctx.Request)
+	switch {
+	case err != nil:
+		errors.Log(ctx.Context, errors.Annotate(err, "handling buildbucket pubsub event").Err())
+		if transient.Tag.In(err) {
+			// Transient errors are 500 so that PubSub retries them.
+			status = "transient-failure"
+			ctx.Writer.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		// Permanent failures are 200s so that PubSub does not retry them.
+		status = "permanent-failure"
+	case ingested:
+		status = "ingested"
+	default:
+		status = "filtered"
+	}
+	ctx.Writer.WriteHeader(http.StatusOK)
+}
+
+func (h *BuildbucketHandler) handle(ctx context.Context, r *http.Request) (ingested bool, err error) {
+	var msg pubsubMessage
+	if err := json.NewDecoder(r.Body).Decode(&msg); err != nil {
+		return false, errors.Annotate(err, "could not decode buildbucket pubsub message").Err()
+	}
+	var bm buildMessage
+	if err := json.Unmarshal(msg.Message.Data, &bm); err != nil {
+		return false, errors.Annotate(err, "could not parse buildbucket pubsub message data").Err()
+	}
+
+	// Evaluate the rules which only need the message first, to avoid
+	// fetching builds which are not ingested anyway.
+	rules, reason := h.checkMessage(&bm.Build)
+	if rules == nil {
+		filtered(ctx, bm.Build.Id, reason)
+		return false, nil
+	}
+
+	client, err := h.NewBuildsClient(ctx, bm.Hostname)
+	if err != nil {
+		return false, errors.Annotate(err, "creating buildbucket client").Err()
+	}
+	build, err := client.GetBuild(ctx, &buildbucketpb.GetBuildRequest{
+		Id:     bm.Build.Id,
+		Fields: buildFields,
+	})
+	if err != nil {
+		return false, transient.Tag.Apply(errors.Annotate(err, "fetching build %d", bm.Build.Id).Err())
+	}
+	if reason, ok := rules.checkBuild(build); !ok {
+		filtered(ctx, bm.Build.Id, reason)
+		return false, nil
+	}
+
+	if err := datastore.Put(ctx, failedBuild(build)); err != nil {
+		return false, transient.Tag.Apply(errors.Annotate(err, "saving failed build %d", build.Id).Err())
+	}
+	logging.Infof(ctx, "Ingested failed build %d", build.Id)
+	return true, nil
+}
+
+// checkMessage checks the ingestion rules which only need the Pub/Sub
+// message. Returns the rules of the project of the build, or nil and the
+// reason for filtering it out.
+func (h *BuildbucketHandler) checkMessage(b *bbv1.LegacyApiCommonBuildMessage) (rules *ProjectRules, reason string) {
+	rules = h.Config.rules(b.Project)
+	switch {
+	case rules == nil:
+		return nil, filterReasonProject
+	case b.Status != bbv1.StatusCompleted || b.Result != bbv1.ResultFailure || b.FailureReason != buildFailureReason:
+		// Only completed builds which failed (and not because of an infra
+		// failure) are analyzed.
+		return nil, filterReasonStatus
+	case b.Experimental && !rules.IncludeExperimental:
+		return nil, filterReasonExperimental
+	case !rules.checkBuilder(builderFromTags(b.Tags)):
+		return nil, filterReasonBuilder
+	}
+	return rules, ""
+}
+
+func filtered(ctx context.Context, buildID int64, reason string) {
+	logging.Debugf(ctx, "Build %d filtered out: %s", buildID, reason)
+	filteredCounter.Add(ctx, 1, reason)
+}
+
+// builderFromTags returns the builder of a build from its tags.
+func builderFromTags(tags []string) string {
+	for _, t := range tags {
+		if k, v := strpair.Parse(t); k == bbv1.TagBuilder {
+			return v
+		}
+	}
+	return ""
+}
+
+// failedBuild returns the entity recording a failed build.
+func failedBuild(b *buildbucketpb.Build) *model.LuciFailedBuild {
+	c := b.GetInput().GetGitilesCommit()
+	return &model.LuciFailedBuild{
+		Id: b.Id,
+		LuciBuild: model.LuciBuild{
+			BuildId:     b.Id,
+			Project:     b.GetBuilder().GetProject(),
+			Bucket:      b.GetBuilder().GetBucket(),
+			Builder:     b.GetBuilder().GetBuilder(),
+			BuildNumber: int(b.Number),
+			GitilesCommit: model.GitilesCommit{
+				GitilesHost:           c.GetHost(),
+				GitilesProject:        c.GetProject(),
+				GitilesRef:            c.GetRef(),
+				GitilesCommitID:       c.GetId(),
+				GitilesCommitPosition: int(c.GetPosition()),
+			},
+			CreateTime: b.GetCreateTime().AsTime(),
+			StartTime:  b.GetStartTime().AsTime(),
+			EndTime:    b.GetEndTime().AsTime(),
+			Status:     b.Status,
+		},
+		FailureType: model.BuildFailureType_Compile,
+	}
+}
@@ -0,0 +1,232 @@
+// Copyright 2021 The Chromium Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package pubsub
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	. "github.com/smartystreets/goconvey/convey"
+	"go.chromium.org/luci/appengine/gaetesting"
+	buildbucketpb "go.chromium.org/luci/buildbucket/proto"
+	bbv1 "go.chromium.org/luci/common/api/buildbucket/buildbucket/v1"
+	"go.chromium.org/luci/common/tsmon"
+	"go.chromium.org/luci/gae/service/datastore"
+	"go.chromium.org/luci/server/router"
+
+	"infra/appengine/gofindit/model"
+)
+
+const testConfig = `{
+  "projects": {
+    "chromium": {
+      "builder_groups": ["chromium.linux"],
+      "builders": ["linux-builder"],
+      "failure_step_patterns": ["^compile", "^generate_build_files"]
+    },
+    "chromeos": {
+      "include_experimental": true
+    }
+  }
+}`
+
+// fakeBuildsClient serves builds by ID.
+type fakeBuildsClient struct {
+	builds map[int64]*buildbucketpb.Build
+	err    error
+	calls  int
+}
+
+func (c *fakeBuildsClient) GetBuild(ctx context.Context, in *buildbucketpb.GetBuildRequest, opts ...grpc.CallOption) (*buildbucketpb.Build, error) {
+	c.calls++
+	if c.err != nil {
+		return nil, c.err
+	}
+	return c.builds[in.Id], nil
+}
+
+func makeMessage(b bbv1.LegacyApiCommonBuildMessage) []byte {
+	data, _ := json.Marshal(&buildMessage{Build: b, Hostname: "buildbucket.example.com"})
+	msg := pubsubMessage{}
+	msg.Message.Data = data
+	blob, _ := json.Marshal(&msg)
+	return blob
+}
+
+func failedBuildMessage(id int64, project, builder string) bbv1.LegacyApiCommonBuildMessage {
+	return bbv1.LegacyApiCommonBuildMessage{
+		Id:            id,
+		Project:       project,
+		Bucket:        "luci." + project + ".ci",
+		Status:        bbv1.StatusCompleted,
+		Result:        bbv1.ResultFailure,
+		FailureReason: buildFailureReason,
+		Tags:          []string{"builder:" + builder, "user_agent:recipe"},
+	}
+}
+
+func makeBuild(id int64, project, builder, group string, failedSteps ...string) *buildbucketpb.Build {
+	props, _ := structpb.NewStruct(map[string]interface{}{builderGroupProperty: group})
+	b := &buildbucketpb.Build{
+		Id:      id,
+		Builder: &buildbucketpb.BuilderID{Project: project, Bucket: "ci", Builder: builder},
+		Number:  123,
+		Status:  buildbucketpb.Status_FAILURE,
+		Input:   &buildbucketpb.Build_Input{Properties: props},
+		Steps: []*buildbucketpb.Step{
+			{Name: "bot_update", Status: buildbucketpb.Status_SUCCESS},
+		},
+	}
+	for _, s := range failedSteps {
+		b.Steps = append(b.Steps, &buildbucketpb.Step{Name: s, Status: buildbucketpb.Status_FAILURE})
+	}
+	return b
+}
+
+func TestBuildbucketHandler(t *testing.T) {
+	t.Parallel()
+
+	Convey("BuildbucketHandler", t, func() {
+		c := gaetesting.TestingContext()
+		c, _ = tsmon.WithDummyInMemory(c)
+
+		cfg, err := ParseIngestionConfig([]byte(testConfig))
+		So(err, ShouldBeNil)
+		client := &fakeBuildsClient{builds: map[int64]*buildbucketpb.Build{}}
+		h := &BuildbucketHandler{
+			Config: cfg,
+			NewBuildsClient: func(ctx context.Context, host string) (BuildsClient, error) {
+				So(host, ShouldEqual, "buildbucket.example.com")
+				return client, nil
+			},
+		}
+
+		serve := func(b bbv1.LegacyApiCommonBuildMessage) int {
+			rec := httptest.NewRecorder()
+			h.ServeHTTP(&router.Context{
+				Context: c,
+				Writer:  rec,
+				Request: httptest.NewRequest(http.MethodPost, "/_ah/push-handlers/buildbucket", bytes.NewReader(makeMessage(b))),
+			})
+			return rec.Code
+		}
+		ingested := func(id int64) bool {
+			err := datastore.Get(c, &model.LuciFailedBuild{Id: id})
+			So(err == nil || err == datastore.ErrNoSuchEntity, ShouldBeTrue)
+			return err == nil
+		}
+		expectFiltered := func(b bbv1.LegacyApiCommonBuildMessage, reason string, fetched bool) {
+			So(serve(b), ShouldEqual, http.StatusOK)
+			So(ingested(b.Id), ShouldBeFalse)
+			So(filteredCounter.Get(c, reason), ShouldEqual, 1)
+			So(buildCounter.Get(c, "filtered"), ShouldEqual, 1)
+			So(client.calls > 0, ShouldEqual, fetched)
+		}
+
+		Convey("Ingests builds of listed builders", func() {
+			client.builds[1] = makeBuild(1, "chromium", "linux-builder", "chromium.other", "compile")
+			So(serve(failedBuildMessage(1, "chromium", "linux-builder")), ShouldEqual, http.StatusOK)
+			So(ingested(1), ShouldBeTrue)
+			So(buildCounter.Get(c, "ingested"), ShouldEqual, 1)
+
+			fb := &model.LuciFailedBuild{Id: 1}
+			So(datastore.Get(c, fb), ShouldBeNil)
+			So(fb.Builder, ShouldEqual, "linux-builder")
+			So(fb.BuildNumber, ShouldEqual, 123)
+			So(fb.FailureType, ShouldEqual, model.BuildFailureType_Compile)
+		})
+		Convey("Ingests builds of listed builder groups", func() {
+			client.builds[2] = makeBuild(2, "chromium", "linux-tests", "chromium.linux", "generate_build_files")
+			So(serve(failedBuildMessage(2, "chromium", "linux-tests")), ShouldEqual, http.StatusOK)
+			So(ingested(2), ShouldBeTrue)
+		})
+		Convey("Ingests builds of all builders without builder rules", func() {
+			client.builds[3] = makeBuild(3, "chromeos", "any-builder", "", "build_packages")
+			b := failedBuildMessage(3, "chromeos", "any-builder")
+			b.Experimental = true
+			So(serve(b), ShouldEqual, http.StatusOK)
+			So(ingested(3), ShouldBeTrue)
+		})
+		Convey("Filters out builds of other projects", func() {
+			expectFiltered(failedBuildMessage(4, "v8", "linux-builder"), filterReasonProject, false)
+		})
+		Convey("Filters out builds which did not fail", func() {
+			b := failedBuildMessage(5, "chromium", "linux-builder")
+			b.Result = bbv1.ResultSuccess
+			b.FailureReason = ""
+			expectFiltered(b, filterReasonStatus, false)
+		})
+		Convey("Filters out infra failures", func() {
+			b := failedBuildMessage(6, "chromium", "linux-builder")
+			b.FailureReason = "INFRA_FAILURE"
+			expectFiltered(b, filterReasonStatus, false)
+		})
+		Convey("Filters out experimental builds", func() {
+			b := failedBuildMessage(7, "chromium", "linux-builder")
+			b.Experimental = true
+			expectFiltered(b, filterReasonExperimental, false)
+		})
+		Convey("Filters out builds of other builder groups", func() {
+			client.builds[8] = makeBuild(8, "chromium", "linux-tests", "chromium.mac", "compile")
+			expectFiltered(failedBuildMessage(8, "chromium", "linux-tests"), filterReasonBuilder, true)
+		})
+		Convey("Filters out builds without a failed compile step", func() {
+			client.builds[9] = makeBuild(9, "chromium", "linux-builder", "chromium.linux", "browser_tests")
+			expectFiltered(failedBuildMessage(9, "chromium", "linux-builder"), filterReasonFailureStep, true)
+		})
+		Convey("Filters out builds of other builders with builder rules only", func() {
+			cfg, err := ParseIngestionConfig([]byte(`{"projects": {"chromium": {"builders": ["linux-builder"]}}}`))
+			So(err, ShouldBeNil)
+			h.Config = cfg
+			expectFiltered(failedBuildMessage(10, "chromium", "linux-tests"), filterReasonBuilder, false)
+		})
+		Convey("Retries build fetch failures", func() {
+			client.err = errors.New("unavailable")
+			So(serve(failedBuildMessage(11, "chromium", "linux-builder")), ShouldEqual, http.StatusInternalServerError)
+			So(ingested(11), ShouldBeFalse)
+			So(buildCounter.Get(c, "transient-failure"), ShouldEqual, 1)
+		})
+		Convey("Ignores malformed messages", func() {
+			rec := httptest.NewRecorder()
+			h.ServeHTTP(&router.Context{
+				Context: c,
+				Writer:  rec,
+				Request: httptest.NewRequest(http.MethodPost, "/_ah/push-handlers/buildbucket", bytes.NewReader([]byte("{"))),
+			})
+			So(rec.Code, ShouldEqual, http.StatusOK)
+			So(buildCounter.Get(c, "permanent-failure"), ShouldEqual, 1)
+		})
+	})
+}
+
+func TestParseIngestionConfig(t *testing.T) {
+	t.Parallel()
+
+	Convey("ParseIngestionConfig", t, func() {
+		Convey("Valid", func() {
+			cfg, err := ParseIngestionConfig([]byte(testConfig))
+			So(err, ShouldBeNil)
+			So(cfg.rules("chromium").Builders, ShouldResemble, []string{"linux-builder"})
+			So(cfg.rules("v8"), ShouldBeNil)
+		})
+		Convey("Invalid pattern", func() {
+			_, err := ParseIngestionConfig([]byte(`{"projects": {"chromium": {"failure_step_patterns": ["("]}}}`))
+			So(err, ShouldNotBeNil)
+			So(err.Error(), ShouldContainSubstring, `project "chromium"`)
+		})
+		Convey("Missing rules", func() {
+			_, err := ParseIngestionConfig([]byte(`{"projects": {"chromium": null}}`))
+			So(err, ShouldNotBeNil)
+		})
+	})
+}
@@ -0,0 +1,159 @@
+// Copyright 2021 The Chromium Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package pubsub
+
+import (
+	"encoding/json"
+	"os"
+	"regexp"
+
+	buildbucketpb "go.chromium.org/luci/buildbucket/proto"
+	"go.chromium.org/luci/common/data/stringset"
+	"go.chromium.org/luci/common/errors"
+)
+
+// Reasons for filtering out a build, reported by the filtered builds
+// metric.
+const (
+	filterReasonProject      = "project"
+	filterReasonStatus       = "status"
+	filterReasonExperimental = "experimental"
+	filterReasonBuilder      = "builder"
+	filterReasonFailureStep  = "failure_step"
+)
+
+// builderGroupProperty is the input property holding the builder group of
+// a build.
+const builderGroupProperty = "builder_group"
+
+// IngestionConfig configures which failed builds are ingested.
+//
+// An ingestion config file is a JSON file like:
+//
+//	{
+//	  "projects": {
+//	    "chromium": {
+//	      "builder_groups": ["chromium.linux"],
+//	      "builders": ["linux-rel"],
+//	      "failure_step_patterns": ["^compile", "^generate_build_files"]
+//	    }
+//	  }
+//	}
+type IngestionConfig struct {
+	// Projects are the ingestion rules of each LUCI project. Builds of other
+	// projects are not ingested.
+	Projects map[string]*ProjectRules `json:"projects"`
+}
+
+// ProjectRules are the ingestion rules of the builds of a LUCI project.
+type ProjectRules struct {
+	// BuilderGroups are the builder groups whose builds are ingested.
+	BuilderGroups []string `json:"builder_groups"`
+	// Builders are the builders whose builds are ingested, in addition to
+	// those of BuilderGroups. If both are empty, builds of all builders are
+	// ingested.
+	Builders []string `json:"builders"`
+	// FailureStepPatterns are regular expressions, one of which the name of
+	// a failed step must match for the build to be ingested. If empty,
+	// builds are ingested regardless of their failed steps.
+	FailureStepPatterns []string `json:"failure_step_patterns"`
+	// IncludeExperimental controls whether experimental builds are
+	// ingested.
+	IncludeExperimental bool `json:"include_experimental"`
+
+	builderGroups       stringset.Set
+	builders            stringset.Set
+	failureStepPatterns []*regexp.Regexp
+}
+
+// LoadIngestionConfig loads an ingestion config from a JSON file.
+func LoadIngestionConfig(path string) (*IngestionConfig, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errors.Annotate(err, "load ingestion config").Err()
+	}
+	cfg, err := ParseIngestionConfig(content)
+	if err != nil {
+		return nil, errors.Annotate(err, "load ingestion config %q", path).Err()
+	}
+	return cfg, nil
+}
+
+// ParseIngestionConfig parses and validates an ingestion config.
+func ParseIngestionConfig(content []byte) (*IngestionConfig, error) {
+	cfg := &IngestionConfig{}
+	if err := json.Unmarshal(content, cfg); err != nil {
+		return nil, errors.Annotate(err, "parse ingestion config").Err()
+	}
+	for project, rules := range cfg.Projects {
+		if rules == nil {
+			return nil, errors.Reason("project %q: no rules", project).Err()
+		}
+		if err := rules.init(); err != nil {
+			return nil, errors.Annotate(err, "project %q", project).Err()
+		}
+	}
+	return cfg, nil
+}
+
+func (r *ProjectRules) init() error {
+	r.builderGroups = stringset.NewFromSlice(r.BuilderGroups...)
+	r.builders = stringset.NewFromSlice(r.Builders...)
+	r.failureStepPatterns = make([]*regexp.Regexp, 0, len(r.FailureStepPatterns))
+	for _, p := range r.FailureStepPatterns {
+		re, err := regexp.Compile(p)
+		if err != nil {
+			return errors.Annotate(err, "failure step pattern %q", p).Err()
+		}
+		r.failureStepPatterns = append(r.failureStepPatterns, re)
+	}
+	return nil
+}
+
+// rules returns the ingestion rules of a project, or nil if builds of the
+// project are not ingested.
+func (c *IngestionConfig) rules(project string) *ProjectRules {
+	if c == nil {
+		return nil
+	}
+	return c.Projects[project]
+}
+
+// needsBuilderGroup returns whether the builder group of a build of the
+// builder must be checked.
+func (r *ProjectRules) needsBuilderGroup(builder string) bool {
+	return r.builderGroups.Len() > 0 && !r.builders.Has(builder)
+}
+
+// checkBuilder returns whether builds of the builder may be ingested,
+// before checking its builder group.
+func (r *ProjectRules) checkBuilder(builder string) bool {
+	return r.builders.Has(builder) || r.builderGroups.Len() > 0 || r.builders.Len() == 0
+}
+
+// checkBuild checks the rules which need the input properties and steps of
+// the build, returning the reason for filtering it out, if any.
+func (r *ProjectRules) checkBuild(b *buildbucketpb.Build) (reason string, ok bool) {
+	if r.needsBuilderGroup(b.GetBuilder().GetBuilder()) {
+		group := b.GetInput().GetProperties().GetFields()[builderGroupProperty].GetStringValue()
+		if !r.builderGroups.Has(group) {
+			return filterReasonBuilder, false
+		}
+	}
+	if len(r.failureStepPatterns) == 0 {
+		return "", true
+	}
+	for _, s := range b.GetSteps() {
+		if s.GetStatus() != buildbucketpb.Status_FAILURE {
+			continue
+		}
+		for _, re := range r.failureStepPatterns {
+			if re.MatchString(s.GetName()) {
+				return "", true
+			}
+		}
+	}
+	return filterReasonFailureStep, false
+}
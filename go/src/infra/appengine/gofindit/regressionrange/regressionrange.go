@@ -0,0 +1,262 @@
+// Copyright 2021 The Chromium Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+// Package regressionrange resolves the range of commits in which a compile
+// failure was introduced, for analyses that were not given the last passed
+// build.
+package regressionrange
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	buildbucketpb "go.chromium.org/luci/buildbucket/proto"
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/common/logging"
+	gitilespb "go.chromium.org/luci/common/proto/gitiles"
+
+	"infra/appengine/gofindit/model"
+)
+
+const (
+	// DefaultMaxBuilds is the default number of builds preceding the failed
+	// build to search for a build with a passing compile step.
+	DefaultMaxBuilds = 100
+	// DefaultMaxCommits is the default number of commits in the range when no
+	// build with a passing compile step is found.
+	DefaultMaxCommits = 200
+	// DefaultCompileStepName is the default name of the compile step.
+	DefaultCompileStepName = "compile"
+
+	searchPageSize = 100
+)
+
+// BuildsClient is the subset of buildbucketpb.BuildsClient used to search
+// build history.
+type BuildsClient interface {
+	SearchBuilds(ctx context.Context, in *buildbucketpb.SearchBuildsRequest, opts ...grpc.CallOption) (*buildbucketpb.SearchBuildsResponse, error)
+}
+
+// GitilesClient is the subset of gitilespb.GitilesClient used to read the
+// commit log.
+type GitilesClient interface {
+	Log(ctx context.Context, in *gitilespb.LogRequest, opts ...grpc.CallOption) (*gitilespb.LogResponse, error)
+}
+
+// RegressionRange is the range of commits in which a compile failure was
+// introduced: after LastPassedCommit, up to and including FirstFailedCommit.
+type RegressionRange struct {
+	// FirstFailedBuild is the first build in the sequence of consecutive
+	// builds with a failing compile step that ends with the failed build.
+	FirstFailedBuild *buildbucketpb.Build
+	// FirstFailedCommit is the input commit of FirstFailedBuild.
+	FirstFailedCommit *buildbucketpb.GitilesCommit
+
+	// LastPassedBuild is the latest build before FirstFailedBuild with a
+	// passing compile step. Nil if Unbounded.
+	LastPassedBuild *buildbucketpb.Build
+	// LastPassedCommit is the commit the range starts after, exclusively.
+	// It is the input commit of LastPassedBuild, or, if Unbounded, the commit
+	// MaxCommits before FirstFailedCommit. Nil if the range reaches the
+	// beginning of the history.
+	LastPassedCommit *buildbucketpb.GitilesCommit
+
+	// Unbounded is set if no build with a passing compile step was found
+	// within the lookback, and the range was bounded by a number of commits
+	// instead.
+	Unbounded bool
+}
+
+// Populate sets the regression range of a compile failure analysis.
+func (r *RegressionRange) Populate(a *model.CompileFailureAnalysis) {
+	a.FirstFailedBuildId = r.FirstFailedBuild.GetId()
+	a.LastPassedBuildId = r.LastPassedBuild.GetId()
+	a.UnboundedRange = r.Unbounded
+	a.LastPassedCommit = model.GitilesCommit{}
+	if c := r.LastPassedCommit; c != nil {
+		a.LastPassedCommit = model.GitilesCommit{
+			GitilesHost:           c.Host,
+			GitilesProject:        c.Project,
+			GitilesRef:            c.Ref,
+			GitilesCommitID:       c.Id,
+			GitilesCommitPosition: int(c.Position),
+		}
+	}
+}
+
+// Resolver resolves regression ranges from the build history of a builder.
+type Resolver struct {
+	// Builds is used to search the build history.
+	Builds BuildsClient
+	// NewGitilesClient returns a client for a Gitiles host. Used when no
+	// build with a passing compile step is found.
+	NewGitilesClient func(ctx context.Context, host string) (GitilesClient, error)
+
+	// MaxBuilds is the number of builds preceding the failed build to search
+	// for a build with a passing compile step. Defaults to DefaultMaxBuilds.
+	MaxBuilds int
+	// MaxCommits is the number of commits in the range when no build with a
+	// passing compile step is found. Defaults to DefaultMaxCommits.
+	MaxCommits int
+	// CompileStepName is the name of the compile step.
+	// Defaults to DefaultCompileStepName.
+	CompileStepName string
+}
+
+// Resolve returns the regression range of the compile failure in the failed
+// build.
+//
+// It walks the history of the builder, from the failed build backwards, for
+// the most recent build with a passing compile step. Builds without a
+// compile step result, e.g. builds which were canceled, infra-failed before
+// compiling, or had their steps purged, are skipped.
+//
+// If there is no such build within the lookback, the range is bounded by
+// MaxCommits commits instead, and marked Unbounded.
+func (r *Resolver) Resolve(ctx context.Context, failed *buildbucketpb.Build) (*RegressionRange, error) {
+	if failed.GetInput().GetGitilesCommit() == nil {
+		return nil, errors.Reason("build %d has no input gitiles commit", failed.GetId()).Err()
+	}
+	rr := &RegressionRange{
+		FirstFailedBuild:  failed,
+		FirstFailedCommit: failed.Input.GitilesCommit,
+	}
+
+	req := &buildbucketpb.SearchBuildsRequest{
+		Predicate: &buildbucketpb.BuildPredicate{
+			Builder: failed.Builder,
+			// Builds IDs decrease over time, so these are the failed build
+			// and the builds before it.
+			Build: &buildbucketpb.BuildRange{EndBuildId: failed.Id},
+		},
+		Fields: &fieldmaskpb.FieldMask{
+			Paths: []string{
+				"builds.*.id",
+				"builds.*.builder",
+				"builds.*.number",
+				"builds.*.status",
+				"builds.*.input.gitiles_commit",
+				"builds.*.steps.*.name",
+				"builds.*.steps.*.status",
+			},
+		},
+		PageSize: searchPageSize,
+	}
+	seen := 0
+	for seen < r.maxBuilds() {
+		res, err := r.Builds.SearchBuilds(ctx, req)
+		if err != nil {
+			return nil, errors.Annotate(err, "search builds of %s", failed.Builder).Err()
+		}
+		for _, b := range res.Builds {
+			if b.Id == failed.Id {
+				continue
+			}
+			if seen++; seen > r.maxBuilds() {
+				break
+			}
+			if b.GetInput().GetGitilesCommit() == nil {
+				logging.Debugf(ctx, "Skipping build %d without an input gitiles commit", b.Id)
+				continue
+			}
+			switch r.compileStatus(b) {
+			case buildbucketpb.Status_SUCCESS:
+				rr.LastPassedBuild = b
+				rr.LastPassedCommit = b.Input.GitilesCommit
+				return rr, nil
+			case buildbucketpb.Status_FAILURE:
+				rr.FirstFailedBuild = b
+				rr.FirstFailedCommit = b.Input.GitilesCommit
+			default:
+				logging.Debugf(ctx, "Skipping build %d without a compile step result", b.Id)
+			}
+		}
+		if res.NextPageToken == "" {
+			break
+		}
+		req.PageToken = res.NextPageToken
+	}
+
+	logging.Infof(ctx, "No build with a passing compile step found before build %d, bounding the range by %d commits", rr.FirstFailedBuild.Id, r.maxCommits())
+	if err := r.boundByCommits(ctx, rr); err != nil {
+		return nil, err
+	}
+	return rr, nil
+}
+
+// boundByCommits sets the start of the range to the commit MaxCommits before
+// the first failed commit, and marks the range unbounded.
+func (r *Resolver) boundByCommits(ctx context.Context, rr *RegressionRange) error {
+	c := rr.FirstFailedCommit
+	client, err := r.NewGitilesClient(ctx, c.Host)
+	if err != nil {
+		return errors.Annotate(err, "create gitiles client for %s", c.Host).Err()
+	}
+
+	// The log starts with the first failed commit, so the commit MaxCommits
+	// before it is at index MaxCommits.
+	req := &gitilespb.LogRequest{
+		Project:    c.Project,
+		Committish: c.Id,
+		PageSize:   int32(r.maxCommits() + 1),
+	}
+	var log []string
+	for len(log) <= r.maxCommits() {
+		res, err := client.Log(ctx, req)
+		if err != nil {
+			return errors.Annotate(err, "read log of %s/%s from %s", c.Host, c.Project, c.Id).Err()
+		}
+		for _, commit := range res.Log {
+			log = append(log, commit.Id)
+		}
+		if res.NextPageToken == "" {
+			break
+		}
+		req.PageToken = res.NextPageToken
+	}
+
+	rr.Unbounded = true
+	rr.LastPassedBuild = nil
+	rr.LastPassedCommit = nil
+	if len(log) > r.maxCommits() {
+		rr.LastPassedCommit = &buildbucketpb.GitilesCommit{
+			Host:    c.Host,
+			Project: c.Project,
+			Ref:     c.Ref,
+			Id:      log[r.maxCommits()],
+		}
+	}
+	return nil
+}
+
+// compileStatus returns the status of the compile step of the build, or
+// STATUS_UNSPECIFIED if the build has no compile step.
+func (r *Resolver) compileStatus(b *buildbucketpb.Build) buildbucketpb.Status {
+	name := r.CompileStepName
+	if name == "" {
+		name = DefaultCompileStepName
+	}
+	for _, s := range b.Steps {
+		if s.Name == name {
+			return s.Status
+		}
+	}
+	return buildbucketpb.Status_STATUS_UNSPECIFIED
+}
+
+func (r *Resolver) maxBuilds() int {
+	if r.MaxBuilds > 0 {
+		return r.MaxBuilds
+	}
+	return DefaultMaxBuilds
+}
+
+func (r *Resolver) maxCommits() int {
+	if r.MaxCommits > 0 {
+		return r.MaxCommits
+	}
+	return DefaultMaxCommits
+}
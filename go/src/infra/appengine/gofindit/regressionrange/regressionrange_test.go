@@ -0,0 +1,347 @@
+// Copyright 2021 The Chromium Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package regressionrange
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+
+	buildbucketpb "go.chromium.org/luci/buildbucket/proto"
+	"go.chromium.org/luci/common/proto/git"
+	gitilespb "go.chromium.org/luci/common/proto/gitiles"
+
+	"infra/appengine/gofindit/model"
+
+	. "github.com/smartystreets/goconvey/convey"
+	. "go.chromium.org/luci/common/testing/assertions"
+)
+
+var builder = &buildbucketpb.BuilderID{
+	Project: "chromium",
+	Bucket:  "ci",
+	Builder: "Linux Builder",
+}
+
+// commitID returns the ID of the commit at the given index of the history,
+// newest first.
+func commitID(i int) string {
+	return fmt.Sprintf("c%03d", i)
+}
+
+// makeBuild returns a build of the commit at the given index of the
+// history, with a compile step of the given status. STATUS_UNSPECIFIED means
+// the build has no compile step.
+func makeBuild(id int64, commit int, compile buildbucketpb.Status) *buildbucketpb.Build {
+	b := &buildbucketpb.Build{
+		Id:      id,
+		Builder: builder,
+		Status:  buildbucketpb.Status_SUCCESS,
+		Input: &buildbucketpb.Build_Input{
+			GitilesCommit: &buildbucketpb.GitilesCommit{
+				Host:    "chromium.googlesource.com",
+				Project: "chromium/src",
+				Ref:     "refs/heads/main",
+				Id:      commitID(commit),
+			},
+		},
+		Steps: []*buildbucketpb.Step{
+			{Name: "bot_update", Status: buildbucketpb.Status_SUCCESS},
+		},
+	}
+	if compile != buildbucketpb.Status_STATUS_UNSPECIFIED {
+		b.Steps = append(b.Steps, &buildbucketpb.Step{Name: "compile", Status: compile})
+		if compile != buildbucketpb.Status_SUCCESS {
+			b.Status = compile
+		}
+	}
+	return b
+}
+
+// fakeBuilds is a fake BuildsClient serving a build history.
+type fakeBuilds struct {
+	// builds in the history, newest first, i.e. in increasing order of IDs.
+	builds []*buildbucketpb.Build
+	// pageSize, if set, limits the page size.
+	pageSize int
+	requests int
+}
+
+func (f *fakeBuilds) SearchBuilds(ctx context.Context, in *buildbucketpb.SearchBuildsRequest, opts ...grpc.CallOption) (*buildbucketpb.SearchBuildsResponse, error) {
+	f.requests++
+	pageSize := int(in.PageSize)
+	if f.pageSize > 0 && f.pageSize < pageSize {
+		pageSize = f.pageSize
+	}
+	start := 0
+	if in.PageToken != "" {
+		var err error
+		if start, err = strconv.Atoi(in.PageToken); err != nil {
+			return nil, err
+		}
+	}
+
+	res := &buildbucketpb.SearchBuildsResponse{}
+	var matching []*buildbucketpb.Build
+	for _, b := range f.builds {
+		if proto.Equal(b.Builder, in.Predicate.Builder) && b.Id >= in.Predicate.Build.EndBuildId {
+			matching = append(matching, b)
+		}
+	}
+	for i := start; i < len(matching); i++ {
+		if len(res.Builds) == pageSize {
+			res.NextPageToken = strconv.Itoa(i)
+			break
+		}
+		res.Builds = append(res.Builds, matching[i])
+	}
+	return res, nil
+}
+
+// fakeGitiles is a fake GitilesClient serving a linear history of
+// historyLen commits.
+type fakeGitiles struct {
+	historyLen int
+}
+
+func (f *fakeGitiles) Log(ctx context.Context, in *gitilespb.LogRequest, opts ...grpc.CallOption) (*gitilespb.LogResponse, error) {
+	start := -1
+	if in.PageToken != "" {
+		start, _ = strconv.Atoi(in.PageToken)
+	} else {
+		for i := 0; i < f.historyLen; i++ {
+			if commitID(i) == in.Committish {
+				start = i
+			}
+		}
+		if start == -1 {
+			return nil, fmt.Errorf("commit %s not found", in.Committish)
+		}
+	}
+
+	res := &gitilespb.LogResponse{}
+	// Serve at most 10 commits per page to exercise pagination.
+	for i := start; i < f.historyLen; i++ {
+		if len(res.Log) == int(in.PageSize) || len(res.Log) == 10 {
+			res.NextPageToken = strconv.Itoa(i)
+			break
+		}
+		res.Log = append(res.Log, &git.Commit{Id: commitID(i)})
+	}
+	return res, nil
+}
+
+func TestResolve(t *testing.T) {
+	t.Parallel()
+
+	Convey("Resolve", t, func() {
+		ctx := context.Background()
+
+		failed := makeBuild(1000, 10, buildbucketpb.Status_FAILURE)
+		builds := &fakeBuilds{}
+		gitiles := &fakeGitiles{historyLen: 300}
+		r := &Resolver{
+			Builds: builds,
+			NewGitilesClient: func(ctx context.Context, host string) (GitilesClient, error) {
+				So(host, ShouldEqual, "chromium.googlesource.com")
+				return gitiles, nil
+			},
+		}
+		resolve := func() *RegressionRange {
+			rr, err := r.Resolve(ctx, failed)
+			So(err, ShouldBeNil)
+			return rr
+		}
+
+		Convey("Previous build passed", func() {
+			passed := makeBuild(1001, 12, buildbucketpb.Status_SUCCESS)
+			builds.builds = []*buildbucketpb.Build{failed, passed}
+
+			rr := resolve()
+			So(rr.Unbounded, ShouldBeFalse)
+			So(rr.FirstFailedBuild, ShouldResembleProto, failed)
+			So(rr.FirstFailedCommit.Id, ShouldEqual, commitID(10))
+			So(rr.LastPassedBuild, ShouldResembleProto, passed)
+			So(rr.LastPassedCommit.Id, ShouldEqual, commitID(12))
+		})
+
+		Convey("Consecutive failures extend the range", func() {
+			failed2 := makeBuild(1001, 12, buildbucketpb.Status_FAILURE)
+			failed3 := makeBuild(1002, 15, buildbucketpb.Status_FAILURE)
+			passed := makeBuild(1003, 20, buildbucketpb.Status_SUCCESS)
+			builds.builds = []*buildbucketpb.Build{failed, failed2, failed3, passed}
+
+			rr := resolve()
+			So(rr.Unbounded, ShouldBeFalse)
+			So(rr.FirstFailedBuild, ShouldResembleProto, failed3)
+			So(rr.FirstFailedCommit.Id, ShouldEqual, commitID(15))
+			So(rr.LastPassedBuild, ShouldResembleProto, passed)
+		})
+
+		Convey("Ignores builds of other builders and newer builds", func() {
+			other := makeBuild(1001, 12, buildbucketpb.Status_SUCCESS)
+			other.Builder = &buildbucketpb.BuilderID{Project: "chromium", Bucket: "ci", Builder: "Mac Builder"}
+			newer := makeBuild(999, 8, buildbucketpb.Status_SUCCESS)
+			passed := makeBuild(1002, 15, buildbucketpb.Status_SUCCESS)
+			builds.builds = []*buildbucketpb.Build{newer, failed, other, passed}
+
+			rr := resolve()
+			So(rr.LastPassedBuild, ShouldResembleProto, passed)
+		})
+
+		Convey("Skips gaps in build history", func() {
+			// Infra-failed before compiling.
+			infraFailed := makeBuild(1001, 12, buildbucketpb.Status_STATUS_UNSPECIFIED)
+			infraFailed.Status = buildbucketpb.Status_INFRA_FAILURE
+			// Canceled while compiling. There is no build 1003.
+			canceled := makeBuild(1004, 14, buildbucketpb.Status_CANCELED)
+			// Still running.
+			running := makeBuild(1005, 15, buildbucketpb.Status_STARTED)
+			failed2 := makeBuild(1006, 16, buildbucketpb.Status_FAILURE)
+			passed := makeBuild(1008, 20, buildbucketpb.Status_SUCCESS)
+			builds.builds = []*buildbucketpb.Build{failed, infraFailed, canceled, running, failed2, passed}
+
+			rr := resolve()
+			So(rr.Unbounded, ShouldBeFalse)
+			So(rr.FirstFailedBuild, ShouldResembleProto, failed2)
+			So(rr.LastPassedBuild, ShouldResembleProto, passed)
+		})
+
+		Convey("Skips purged builds", func() {
+			purged := &buildbucketpb.Build{Id: 1001, Builder: builder, Status: buildbucketpb.Status_SUCCESS}
+			purgedSteps := makeBuild(1002, 13, buildbucketpb.Status_SUCCESS)
+			purgedSteps.Steps = nil
+			passed := makeBuild(1003, 14, buildbucketpb.Status_SUCCESS)
+			builds.builds = []*buildbucketpb.Build{failed, purged, purgedSteps, passed}
+
+			rr := resolve()
+			So(rr.Unbounded, ShouldBeFalse)
+			So(rr.FirstFailedBuild, ShouldResembleProto, failed)
+			So(rr.LastPassedBuild, ShouldResembleProto, passed)
+		})
+
+		Convey("Pages through build history", func() {
+			builds.pageSize = 2
+			builds.builds = []*buildbucketpb.Build{failed}
+			for i := 1; i <= 6; i++ {
+				builds.builds = append(builds.builds, makeBuild(int64(1000+i), 10+i, buildbucketpb.Status_FAILURE))
+			}
+			passed := makeBuild(1007, 20, buildbucketpb.Status_SUCCESS)
+			builds.builds = append(builds.builds, passed)
+
+			rr := resolve()
+			So(rr.FirstFailedBuild.Id, ShouldEqual, 1006)
+			So(rr.LastPassedBuild, ShouldResembleProto, passed)
+			So(builds.requests, ShouldEqual, 4)
+		})
+
+		Convey("Falls back to a range of commits", func() {
+			r.MaxBuilds = 3
+			r.MaxCommits = 25
+			builds.builds = []*buildbucketpb.Build{failed}
+			for i := 1; i <= 5; i++ {
+				builds.builds = append(builds.builds, makeBuild(int64(1000+i), 10+i, buildbucketpb.Status_FAILURE))
+			}
+			builds.builds = append(builds.builds, makeBuild(1006, 20, buildbucketpb.Status_SUCCESS))
+
+			Convey("Beyond the lookback", func() {
+				rr := resolve()
+				So(rr.Unbounded, ShouldBeTrue)
+				So(rr.FirstFailedBuild.Id, ShouldEqual, 1003)
+				So(rr.FirstFailedCommit.Id, ShouldEqual, commitID(13))
+				So(rr.LastPassedBuild, ShouldBeNil)
+				So(rr.LastPassedCommit, ShouldResembleProto, &buildbucketpb.GitilesCommit{
+					Host:    "chromium.googlesource.com",
+					Project: "chromium/src",
+					Ref:     "refs/heads/main",
+					Id:      commitID(13 + 25),
+				})
+			})
+
+			Convey("History purged", func() {
+				builds.builds = []*buildbucketpb.Build{failed}
+
+				rr := resolve()
+				So(rr.Unbounded, ShouldBeTrue)
+				So(rr.FirstFailedBuild, ShouldResembleProto, failed)
+				So(rr.LastPassedCommit.Id, ShouldEqual, commitID(10+25))
+			})
+
+			Convey("Range reaches the beginning of the history", func() {
+				gitiles.historyLen = 30
+
+				rr := resolve()
+				So(rr.Unbounded, ShouldBeTrue)
+				So(rr.LastPassedCommit, ShouldBeNil)
+			})
+
+			Convey("Defaults", func() {
+				r.MaxBuilds = 0
+				r.MaxCommits = 0
+				builds.builds = []*buildbucketpb.Build{failed}
+
+				rr := resolve()
+				So(rr.LastPassedCommit.Id, ShouldEqual, commitID(10+DefaultMaxCommits))
+			})
+		})
+
+		Convey("Failed build without input commit", func() {
+			failed.Input = nil
+			_, err := r.Resolve(ctx, failed)
+			So(err, ShouldErrLike, "build 1000 has no input gitiles commit")
+		})
+	})
+}
+
+func TestPopulate(t *testing.T) {
+	t.Parallel()
+
+	Convey("Populate", t, func() {
+		a := &model.CompileFailureAnalysis{}
+
+		Convey("Bounded", func() {
+			rr := &RegressionRange{
+				FirstFailedBuild: makeBuild(1002, 10, buildbucketpb.Status_FAILURE),
+				LastPassedBuild:  makeBuild(1003, 12, buildbucketpb.Status_SUCCESS),
+				LastPassedCommit: &buildbucketpb.GitilesCommit{
+					Host:     "chromium.googlesource.com",
+					Project:  "chromium/src",
+					Ref:      "refs/heads/main",
+					Id:       commitID(12),
+					Position: 123,
+				},
+			}
+			rr.Populate(a)
+			So(a, ShouldResemble, &model.CompileFailureAnalysis{
+				FirstFailedBuildId: 1002,
+				LastPassedBuildId:  1003,
+				LastPassedCommit: model.GitilesCommit{
+					GitilesHost:           "chromium.googlesource.com",
+					GitilesProject:        "chromium/src",
+					GitilesRef:            "refs/heads/main",
+					GitilesCommitID:       commitID(12),
+					GitilesCommitPosition: 123,
+				},
+			})
+		})
+
+		Convey("Unbounded", func() {
+			a.LastPassedBuildId = 1
+			rr := &RegressionRange{
+				FirstFailedBuild: makeBuild(1002, 10, buildbucketpb.Status_FAILURE),
+				Unbounded:        true,
+			}
+			rr.Populate(a)
+			So(a, ShouldResemble, &model.CompileFailureAnalysis{
+				FirstFailedBuildId: 1002,
+				UnboundedRange:     true,
+			})
+		})
+	})
+}
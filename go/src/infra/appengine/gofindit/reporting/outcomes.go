@@ -0,0 +1,153 @@
+// Copyright 2021 The Chromium Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+// Package reporting reports the outcomes of GoFindit analyses as metrics and
+// BigQuery rows, to track the quality of GoFindit over time.
+package reporting
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/gae/service/datastore"
+
+	"infra/appengine/gofindit/model"
+)
+
+// analysisOutcome is the outcome of a compile failure analysis.
+type analysisOutcome struct {
+	Analysis *model.CompileFailureAnalysis
+	// The first failed build of the analysis, or nil if it was not found.
+	Build    *model.LuciFailedBuild
+	Suspects []*model.Suspect
+	Culprits []*model.Culprit
+}
+
+// timeToCulprit returns the time from the creation of the analysis to
+// finding its first culprit, and false if no culprit was found.
+func (o *analysisOutcome) timeToCulprit() (time.Duration, bool) {
+	var first time.Time
+	for _, c := range o.Culprits {
+		if !c.CreateTime.IsZero() && (first.IsZero() || c.CreateTime.Before(first)) {
+			first = c.CreateTime
+		}
+	}
+	if first.IsZero() {
+		return 0, false
+	}
+	return first.Sub(o.Analysis.CreateTime), true
+}
+
+// loadOutcomes loads the outcomes of the analyses created since the time.
+func loadOutcomes(ctx context.Context, since time.Time) ([]*analysisOutcome, error) {
+	q := datastore.NewQuery("CompileFailureAnalysis").Gte("create_time", since)
+	var analyses []*model.CompileFailureAnalysis
+	if err := datastore.GetAll(ctx, q, &analyses); err != nil {
+		return nil, errors.Annotate(err, "failed to query analyses").Err()
+	}
+
+	outcomes := make([]*analysisOutcome, len(analyses))
+	for i, a := range analyses {
+		o := &analysisOutcome{Analysis: a}
+		if a.FirstFailedBuildId != 0 {
+			build := &model.LuciFailedBuild{Id: a.FirstFailedBuildId}
+			switch err := datastore.Get(ctx, build); {
+			case err == datastore.ErrNoSuchEntity:
+			case err != nil:
+				return nil, errors.Annotate(err, "failed to get build %d of analysis %d", a.FirstFailedBuildId, a.Id).Err()
+			default:
+				o.Build = build
+			}
+		}
+
+		key := datastore.KeyForObj(ctx, a)
+		if err := datastore.GetAll(ctx, datastore.NewQuery("Suspect").Eq("parent", key), &o.Suspects); err != nil {
+			return nil, errors.Annotate(err, "failed to query suspects of analysis %d", a.Id).Err()
+		}
+		if err := datastore.GetAll(ctx, datastore.NewQuery("Culprit").Eq("parent", key), &o.Culprits); err != nil {
+			return nil, errors.Annotate(err, "failed to query culprits of analysis %d", a.Id).Err()
+		}
+		outcomes[i] = o
+	}
+	return outcomes, nil
+}
+
+// builderOutcomes are the aggregated outcomes of the analyses of the
+// failures of a builder.
+type builderOutcomes struct {
+	Project string
+	Builder string
+
+	AnalysesStarted  int64
+	SuspectsProduced int64
+	// CulpritsVerified is the number of culprits confirmed by rerun builds.
+	CulpritsVerified int64
+	// VerificationsRefuted is the number of suspects found not to cause the
+	// failure by rerun builds.
+	VerificationsRefuted int64
+	GerritActions        int64
+
+	// totalTimeToCulprit is the sum of the times to culprit of the
+	// culpritAnalyses analyses which found a culprit.
+	totalTimeToCulprit time.Duration
+	culpritAnalyses    int64
+}
+
+// MeanTimeToCulprit returns the mean time from the creation of an analysis
+// to finding its culprit, or 0 if no analysis found a culprit.
+func (b *builderOutcomes) MeanTimeToCulprit() time.Duration {
+	if b.culpritAnalyses == 0 {
+		return 0
+	}
+	return b.totalTimeToCulprit / time.Duration(b.culpritAnalyses)
+}
+
+// aggregate aggregates the outcomes of the analyses per builder, sorted by
+// project and builder. Analyses without a known build are aggregated under
+// empty names.
+func aggregate(outcomes []*analysisOutcome) []*builderOutcomes {
+	type builderKey struct{ project, builder string }
+	byBuilder := map[builderKey]*builderOutcomes{}
+	for _, o := range outcomes {
+		var k builderKey
+		if o.Build != nil {
+			k = builderKey{o.Build.Project, o.Build.Builder}
+		}
+		b, ok := byBuilder[k]
+		if !ok {
+			b = &builderOutcomes{Project: k.project, Builder: k.builder}
+			byBuilder[k] = b
+		}
+
+		b.AnalysesStarted++
+		b.SuspectsProduced += int64(len(o.Suspects))
+		for _, s := range o.Suspects {
+			if s.VerificationStatus == model.SuspectVerificationStatus_Vindicated {
+				b.VerificationsRefuted++
+			}
+		}
+		b.CulpritsVerified += int64(len(o.Culprits))
+		for _, c := range o.Culprits {
+			b.GerritActions += int64(len(c.GerritActions))
+		}
+		if d, ok := o.timeToCulprit(); ok {
+			b.totalTimeToCulprit += d
+			b.culpritAnalyses++
+		}
+	}
+
+	result := make([]*builderOutcomes, 0, len(byBuilder))
+	for _, b := range byBuilder {
+		result = append(result, b)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Project != result[j].Project {
+			return result[i].Project < result[j].Project
+		}
+		return result[i].Builder < result[j].Builder
+	})
+	return result
+}
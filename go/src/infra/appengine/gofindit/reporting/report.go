@@ -0,0 +1,239 @@
+// Copyright 2021 The Chromium Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package reporting
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"cloud.google.com/go/bigquery"
+	"google.golang.org/api/option"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"go.chromium.org/luci/common/bq"
+	"go.chromium.org/luci/common/clock"
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/common/logging"
+	"go.chromium.org/luci/common/tsmon"
+	"go.chromium.org/luci/common/tsmon/field"
+	"go.chromium.org/luci/common/tsmon/metric"
+	"go.chromium.org/luci/common/tsmon/types"
+	"go.chromium.org/luci/server/auth"
+
+	"infra/appengine/gofindit/model"
+	bqpb "infra/appengine/gofindit/proto/bq"
+)
+
+const (
+	// metricsWindow is the period before the report aggregated as metrics.
+	metricsWindow = 24 * time.Hour
+	// exportWindow is the period before the report in which analyses are
+	// exported to BigQuery. Analyses are exported repeatedly in that period
+	// to capture feedback given after the analysis ended.
+	exportWindow = 7 * 24 * time.Hour
+
+	bqDataset = "gofindit"
+	bqTable   = "analyses"
+	// bqBatchSize is the maximum number of rows inserted at once.
+	bqBatchSize = 500
+)
+
+var (
+	analysesGauge = metric.NewInt(
+		"gofindit/analysis_outcomes/analyses",
+		"The number of compile failure analyses started in the last day",
+		nil,
+		field.String("project"),
+		field.String("builder"))
+
+	suspectsGauge = metric.NewInt(
+		"gofindit/analysis_outcomes/suspects",
+		"The number of suspects produced by the analyses started in the last day",
+		nil,
+		field.String("project"),
+		field.String("builder"))
+
+	culpritsGauge = metric.NewInt(
+		"gofindit/analysis_outcomes/culprits",
+		"The number of culprits verified by the analyses started in the last day",
+		nil,
+		field.String("project"),
+		field.String("builder"))
+
+	vindicatedGauge = metric.NewInt(
+		"gofindit/analysis_outcomes/vindicated_suspects",
+		"The number of suspects refuted by the analyses started in the last day",
+		nil,
+		field.String("project"),
+		field.String("builder"))
+
+	gerritActionsGauge = metric.NewInt(
+		"gofindit/analysis_outcomes/gerrit_actions",
+		"The number of Gerrit actions taken on culprits of the analyses started in the last day",
+		nil,
+		field.String("project"),
+		field.String("builder"))
+
+	timeToCulpritGauge = metric.NewFloat(
+		"gofindit/analysis_outcomes/mean_time_to_culprit",
+		"The mean time to find the culprit of the analyses started in the last day",
+		&types.MetricMetadata{Units: types.Seconds},
+		field.String("project"),
+		field.String("builder"))
+
+	outcomeMetrics = []types.Metric{
+		analysesGauge, suspectsGauge, culpritsGauge,
+		vindicatedGauge, gerritActionsGauge, timeToCulpritGauge,
+	}
+)
+
+// rowInserter is the subset of bigquery.Inserter used to export rows.
+type rowInserter interface {
+	Put(ctx context.Context, src interface{}) error
+}
+
+// CronHandler returns a cron handler which reports the outcomes of recent
+// analyses as metrics and exports them to BigQuery in the GCP project.
+func CronHandler(gcpProject string) func(ctx context.Context) error {
+	return func(ctx context.Context) error {
+		client, err := bqClient(ctx, gcpProject)
+		if err != nil {
+			return errors.Annotate(err, "failed to create the BigQuery client").Err()
+		}
+		defer client.Close()
+		return report(ctx, client.Dataset(bqDataset).Table(bqTable).Inserter())
+	}
+}
+
+// bqClient returns a BigQuery client for the GCP project, authenticated as
+// GoFindit.
+func bqClient(ctx context.Context, gcpProject string) (*bigquery.Client, error) {
+	tr, err := auth.GetRPCTransport(ctx, auth.AsSelf, auth.WithScopes(bigquery.Scope))
+	if err != nil {
+		return nil, err
+	}
+	return bigquery.NewClient(ctx, gcpProject, option.WithHTTPClient(&http.Client{
+		Transport: tr,
+	}))
+}
+
+// report reports the outcomes of the analyses started in the last day as
+// metrics, and exports the analyses started in the last week to BigQuery.
+func report(ctx context.Context, ins rowInserter) error {
+	now := clock.Now(ctx).UTC()
+	outcomes, err := loadOutcomes(ctx, now.Add(-exportWindow))
+	if err != nil {
+		return err
+	}
+
+	var recent []*analysisOutcome
+	for _, o := range outcomes {
+		if !o.Analysis.CreateTime.Before(now.Add(-metricsWindow)) {
+			recent = append(recent, o)
+		}
+	}
+	reportMetrics(ctx, aggregate(recent))
+
+	rows := make([]*bq.Row, len(outcomes))
+	for i, o := range outcomes {
+		rows[i] = &bq.Row{
+			Message: analysisRow(o, now),
+			// Deduplicates retries of the same daily export.
+			InsertID: fmt.Sprintf("%d:%s", o.Analysis.Id, now.Format("2006-01-02")),
+		}
+	}
+	for start := 0; start < len(rows); start += bqBatchSize {
+		end := start + bqBatchSize
+		if end > len(rows) {
+			end = len(rows)
+		}
+		if err := ins.Put(ctx, rows[start:end]); err != nil {
+			return errors.Annotate(err, "failed to export analyses").Err()
+		}
+	}
+	logging.Infof(ctx, "Reported %d analyses as metrics and exported %d analyses", len(recent), len(rows))
+	return nil
+}
+
+// reportMetrics sets the outcome metrics, dropping the values of builders
+// without recent analyses.
+func reportMetrics(ctx context.Context, outcomes []*builderOutcomes) {
+	store := tsmon.GetState(ctx).Store()
+	for _, m := range outcomeMetrics {
+		store.Reset(ctx, m)
+	}
+	for _, b := range outcomes {
+		analysesGauge.Set(ctx, b.AnalysesStarted, b.Project, b.Builder)
+		suspectsGauge.Set(ctx, b.SuspectsProduced, b.Project, b.Builder)
+		culpritsGauge.Set(ctx, b.CulpritsVerified, b.Project, b.Builder)
+		vindicatedGauge.Set(ctx, b.VerificationsRefuted, b.Project, b.Builder)
+		gerritActionsGauge.Set(ctx, b.GerritActions, b.Project, b.Builder)
+		timeToCulpritGauge.Set(ctx, b.MeanTimeToCulprit().Seconds(), b.Project, b.Builder)
+	}
+}
+
+// analysisRow returns the BigQuery row of the outcome of an analysis.
+func analysisRow(o *analysisOutcome, exportTime time.Time) *bqpb.AnalysisRow {
+	a := o.Analysis
+	row := &bqpb.AnalysisRow{
+		AnalysisId:         a.Id,
+		FirstFailedBuildId: a.FirstFailedBuildId,
+		Status:             string(a.Status),
+		CreateTime:         timestampOrNil(a.CreateTime),
+		EndTime:            timestampOrNil(a.EndTime),
+		ExportTime:         timestamppb.New(exportTime),
+	}
+	if o.Build != nil {
+		row.Project = o.Build.Project
+		row.Bucket = o.Build.Bucket
+		row.Builder = o.Build.Builder
+	}
+	for _, s := range o.Suspects {
+		row.Suspects = append(row.Suspects, &bqpb.Suspect{
+			Commit:             commit(s.GitilesCommit),
+			Score:              int64(s.Hint.Score),
+			VerificationStatus: string(s.VerificationStatus),
+		})
+	}
+	for _, c := range o.Culprits {
+		culprit := &bqpb.Culprit{
+			Commit:       commit(c.GitilesCommit),
+			CreateTime:   timestampOrNil(c.CreateTime),
+			Feedback:     feedbackNames[c.Feedback],
+			FeedbackTime: timestampOrNil(c.FeedbackTime),
+		}
+		for _, action := range c.GerritActions {
+			culprit.GerritActions = append(culprit.GerritActions, string(action))
+		}
+		row.Culprits = append(row.Culprits, culprit)
+	}
+	return row
+}
+
+// feedbackNames maps the culprit feedback to the names of the corresponding
+// gofindit.CulpritFeedback values.
+var feedbackNames = map[model.CulpritFeedback]string{
+	model.CulpritFeedback_Correct:   "CORRECT",
+	model.CulpritFeedback_Incorrect: "INCORRECT",
+}
+
+func commit(c model.GitilesCommit) *bqpb.Commit {
+	return &bqpb.Commit{
+		Host:     c.GitilesHost,
+		Project:  c.GitilesProject,
+		Ref:      c.GitilesRef,
+		Id:       c.GitilesCommitID,
+		Position: int64(c.GitilesCommitPosition),
+	}
+}
+
+func timestampOrNil(t time.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+	return timestamppb.New(t)
+}
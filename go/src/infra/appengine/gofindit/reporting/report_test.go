@@ -0,0 +1,177 @@
+// Copyright 2021 The Chromium Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package reporting
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+	"go.chromium.org/luci/appengine/gaetesting"
+	"go.chromium.org/luci/common/bq"
+	"go.chromium.org/luci/common/clock"
+	"go.chromium.org/luci/common/clock/testclock"
+	. "go.chromium.org/luci/common/testing/assertions"
+	"go.chromium.org/luci/common/tsmon"
+	"go.chromium.org/luci/gae/service/datastore"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"infra/appengine/gofindit/model"
+	bqpb "infra/appengine/gofindit/proto/bq"
+)
+
+// fakeInserter records the rows put.
+type fakeInserter struct {
+	rows []*bq.Row
+}
+
+func (f *fakeInserter) Put(ctx context.Context, src interface{}) error {
+	f.rows = append(f.rows, src.([]*bq.Row)...)
+	return nil
+}
+
+func TestReport(t *testing.T) {
+	t.Parallel()
+
+	Convey("report", t, func() {
+		c := gaetesting.TestingContext()
+		cl := testclock.New(testclock.TestTimeUTC)
+		c = clock.Set(c, cl)
+		c, _ = tsmon.WithDummyInMemory(c)
+		datastore.GetTestable(c).Consistent(true)
+		now := cl.Now()
+
+		// Seed two analyses of linux builds in the last day, one of a mac
+		// build two days ago and one of a week ago.
+		putBuild := func(id int64, builder string) {
+			So(datastore.Put(c, &model.LuciFailedBuild{
+				Id:        id,
+				LuciBuild: model.LuciBuild{BuildId: id, Project: "chromium", Bucket: "ci", Builder: builder},
+			}), ShouldBeNil)
+		}
+		putAnalysis := func(id, buildID int64, age time.Duration) *datastore.Key {
+			a := &model.CompileFailureAnalysis{
+				Id:                 id,
+				FirstFailedBuildId: buildID,
+				CreateTime:         now.Add(-age),
+				EndTime:            now.Add(-age + time.Hour),
+				Status:             model.AnalysisStatus_Completed,
+			}
+			So(datastore.Put(c, a), ShouldBeNil)
+			return datastore.KeyForObj(c, a)
+		}
+		putBuild(1, "linux")
+		putBuild(2, "linux")
+		putBuild(3, "mac")
+		linux1 := putAnalysis(11, 1, 2*time.Hour)
+		linux2 := putAnalysis(12, 2, 4*time.Hour)
+		mac := putAnalysis(13, 3, 48*time.Hour)
+		putAnalysis(14, 3, 8*24*time.Hour)
+
+		commit := model.GitilesCommit{GitilesHost: "host", GitilesProject: "chromium/src", GitilesRef: "refs/heads/main", GitilesCommitID: "abc", GitilesCommitPosition: 100}
+		So(datastore.Put(c, []*model.Suspect{
+			{ParentAnalysis: linux1, GitilesCommit: commit, Hint: model.SuspectHint{Score: 80}, VerificationStatus: model.SuspectVerificationStatus_ConfirmedCulprit},
+			{ParentAnalysis: linux1, Hint: model.SuspectHint{Score: 20}, VerificationStatus: model.SuspectVerificationStatus_Vindicated},
+			{ParentAnalysis: linux2, VerificationStatus: model.SuspectVerificationStatus_Unverified},
+			{ParentAnalysis: mac, VerificationStatus: model.SuspectVerificationStatus_Unverified},
+		}), ShouldBeNil)
+		So(datastore.Put(c, []*model.Culprit{
+			{
+				ParentAnalysis: linux1,
+				GitilesCommit:  commit,
+				CreateTime:     now.Add(-90 * time.Minute),
+				GerritActions:  []model.GerritAction{model.GerritAction_Comment, model.GerritAction_Revert},
+				Feedback:       model.CulpritFeedback_Correct,
+				FeedbackTime:   now.Add(-time.Minute),
+			},
+			{
+				ParentAnalysis: linux2,
+				CreateTime:     now.Add(-3 * time.Hour),
+			},
+		}), ShouldBeNil)
+
+		ins := &fakeInserter{}
+		So(report(c, ins), ShouldBeNil)
+
+		Convey("aggregates the last day as metrics", func() {
+			So(analysesGauge.Get(c, "chromium", "linux"), ShouldEqual, 2)
+			So(suspectsGauge.Get(c, "chromium", "linux"), ShouldEqual, 3)
+			So(culpritsGauge.Get(c, "chromium", "linux"), ShouldEqual, 2)
+			So(vindicatedGauge.Get(c, "chromium", "linux"), ShouldEqual, 1)
+			So(gerritActionsGauge.Get(c, "chromium", "linux"), ShouldEqual, 2)
+			// The culprits were found after 30 and 60 minutes.
+			So(timeToCulpritGauge.Get(c, "chromium", "linux"), ShouldEqual, 45*60)
+
+			So(analysesGauge.Get(c, "chromium", "mac"), ShouldEqual, 0)
+		})
+
+		Convey("exports the last week to BigQuery", func() {
+			So(ins.rows, ShouldHaveLength, 3)
+			ids := map[int64]*bqpb.AnalysisRow{}
+			for _, r := range ins.rows {
+				row := r.Message.(*bqpb.AnalysisRow)
+				ids[row.AnalysisId] = row
+			}
+			So(ids, ShouldContainKey, int64(11))
+			So(ids, ShouldContainKey, int64(12))
+			So(ids, ShouldContainKey, int64(13))
+		})
+	})
+}
+
+func TestAnalysisRow(t *testing.T) {
+	t.Parallel()
+
+	Convey("analysisRow", t, func() {
+		now := testclock.TestTimeUTC
+		outcome := &analysisOutcome{
+			Analysis: &model.CompileFailureAnalysis{
+				Id:                 11,
+				FirstFailedBuildId: 1,
+				CreateTime:         now.Add(-time.Hour),
+				Status:             model.AnalysisStatus_Running,
+			},
+			Build: &model.LuciFailedBuild{
+				Id:        1,
+				LuciBuild: model.LuciBuild{Project: "chromium", Bucket: "ci", Builder: "linux"},
+			},
+			Suspects: []*model.Suspect{{
+				GitilesCommit:      model.GitilesCommit{GitilesHost: "host", GitilesCommitID: "abc", GitilesCommitPosition: 100},
+				Hint:               model.SuspectHint{Score: 80},
+				VerificationStatus: model.SuspectVerificationStatus_ConfirmedCulprit,
+			}},
+			Culprits: []*model.Culprit{{
+				GitilesCommit: model.GitilesCommit{GitilesHost: "host", GitilesCommitID: "abc", GitilesCommitPosition: 100},
+				CreateTime:    now.Add(-time.Minute),
+				GerritActions: []model.GerritAction{model.GerritAction_Revert},
+				Feedback:      model.CulpritFeedback_Incorrect,
+				FeedbackTime:  now,
+			}},
+		}
+		So(analysisRow(outcome, now), ShouldResembleProto, &bqpb.AnalysisRow{
+			AnalysisId:         11,
+			Project:            "chromium",
+			Bucket:             "ci",
+			Builder:            "linux",
+			FirstFailedBuildId: 1,
+			Status:             "Running",
+			CreateTime:         timestamppb.New(now.Add(-time.Hour)),
+			Suspects: []*bqpb.Suspect{{
+				Commit:             &bqpb.Commit{Host: "host", Id: "abc", Position: 100},
+				Score:              80,
+				VerificationStatus: "ConfirmedCulprit",
+			}},
+			Culprits: []*bqpb.Culprit{{
+				Commit:        &bqpb.Commit{Host: "host", Id: "abc", Position: 100},
+				CreateTime:    timestamppb.New(now.Add(-time.Minute)),
+				GerritActions: []string{"Revert"},
+				Feedback:      "INCORRECT",
+				FeedbackTime:  timestamppb.New(now),
+			}},
+			ExportTime: timestamppb.New(now),
+		})
+	})
+}
@@ -0,0 +1,81 @@
+// Copyright 2021 The Chromium Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+// Package server implements the GoFindit pRPC API.
+package server
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"go.chromium.org/luci/common/clock"
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/common/logging"
+	"go.chromium.org/luci/gae/service/datastore"
+	"go.chromium.org/luci/server/auth"
+
+	"infra/appengine/gofindit/model"
+	gofinditpb "infra/appengine/gofindit/proto"
+)
+
+// feedbackGroup is a Chrome Infra Auth group, members of which are allowed
+// to give feedback on culprits.
+const feedbackGroup = "googlers"
+
+// GoFinditServer implements gofinditpb.GoFinditServer.
+type GoFinditServer struct {
+	gofinditpb.UnimplementedGoFinditServer
+}
+
+// MarkCulpritFeedback records the feedback of a sheriff on a culprit.
+func (s *GoFinditServer) MarkCulpritFeedback(ctx context.Context, req *gofinditpb.MarkCulpritFeedbackRequest) (*gofinditpb.MarkCulpritFeedbackResponse, error) {
+	switch yes, err := auth.IsMember(ctx, feedbackGroup); {
+	case err != nil:
+		return nil, errors.Annotate(err, "failed to check ACL").Err()
+	case !yes:
+		return nil, status.Errorf(codes.PermissionDenied, "not a member of %s", feedbackGroup)
+	}
+
+	var feedback model.CulpritFeedback
+	switch req.Feedback {
+	case gofinditpb.CulpritFeedback_CORRECT:
+		feedback = model.CulpritFeedback_Correct
+	case gofinditpb.CulpritFeedback_INCORRECT:
+		feedback = model.CulpritFeedback_Incorrect
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "feedback must be CORRECT or INCORRECT")
+	}
+	switch {
+	case req.AnalysisId == 0:
+		return nil, status.Errorf(codes.InvalidArgument, "analysis_id is not specified")
+	case req.CommitId == "":
+		return nil, status.Errorf(codes.InvalidArgument, "commit_id is not specified")
+	}
+
+	analysisKey := datastore.NewKey(ctx, "CompileFailureAnalysis", "", req.AnalysisId, nil)
+	q := datastore.NewQuery("Culprit").
+		Eq("parent", analysisKey).
+		Eq("gitiles_commit_id", req.CommitId)
+	var culprits []*model.Culprit
+	if err := datastore.GetAll(ctx, q, &culprits); err != nil {
+		return nil, errors.Annotate(err, "failed to query culprits").Err()
+	}
+	if len(culprits) == 0 {
+		return nil, status.Errorf(codes.NotFound, "analysis %d has no culprit %s", req.AnalysisId, req.CommitId)
+	}
+
+	now := clock.Now(ctx)
+	for _, c := range culprits {
+		c.Feedback = feedback
+		c.FeedbackUser = auth.CurrentUser(ctx).Email
+		c.FeedbackTime = now
+	}
+	if err := datastore.Put(ctx, culprits); err != nil {
+		return nil, errors.Annotate(err, "failed to save the feedback").Err()
+	}
+	logging.Infof(ctx, "%s marked culprit %s of analysis %d as %s", auth.CurrentIdentity(ctx), req.CommitId, req.AnalysisId, feedback)
+	return &gofinditpb.MarkCulpritFeedbackResponse{}, nil
+}
@@ -0,0 +1,98 @@
+// Copyright 2021 The Chromium Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package server
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+	"go.chromium.org/luci/appengine/gaetesting"
+	"go.chromium.org/luci/common/clock"
+	"go.chromium.org/luci/common/clock/testclock"
+	"go.chromium.org/luci/gae/service/datastore"
+	"go.chromium.org/luci/server/auth"
+	"go.chromium.org/luci/server/auth/authtest"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"infra/appengine/gofindit/model"
+	gofinditpb "infra/appengine/gofindit/proto"
+)
+
+func TestMarkCulpritFeedback(t *testing.T) {
+	t.Parallel()
+
+	Convey("MarkCulpritFeedback", t, func() {
+		c := gaetesting.TestingContext()
+		cl := testclock.New(testclock.TestTimeUTC)
+		c = clock.Set(c, cl)
+		datastore.GetTestable(c).AutoIndex(true)
+		datastore.GetTestable(c).Consistent(true)
+		server := &GoFinditServer{}
+
+		analysis := &model.CompileFailureAnalysis{Id: 123}
+		So(datastore.Put(c, analysis), ShouldBeNil)
+		culprit := &model.Culprit{
+			ParentAnalysis: datastore.KeyForObj(c, analysis),
+			GitilesCommit:  model.GitilesCommit{GitilesCommitID: "abc"},
+		}
+		So(datastore.Put(c, culprit), ShouldBeNil)
+
+		sheriff := auth.WithState(c, &authtest.FakeState{
+			Identity:       "user:sheriff@example.com",
+			IdentityGroups: []string{feedbackGroup},
+		})
+		req := &gofinditpb.MarkCulpritFeedbackRequest{
+			AnalysisId: 123,
+			CommitId:   "abc",
+			Feedback:   gofinditpb.CulpritFeedback_INCORRECT,
+		}
+
+		Convey("records the feedback", func() {
+			_, err := server.MarkCulpritFeedback(sheriff, req)
+			So(err, ShouldBeNil)
+
+			got := &model.Culprit{Id: culprit.Id}
+			So(datastore.Get(c, got), ShouldBeNil)
+			So(got.Feedback, ShouldEqual, model.CulpritFeedback_Incorrect)
+			So(got.FeedbackUser, ShouldEqual, "sheriff@example.com")
+			So(got.FeedbackTime, ShouldEqual, cl.Now())
+
+			Convey("and overwrites it", func() {
+				req.Feedback = gofinditpb.CulpritFeedback_CORRECT
+				_, err := server.MarkCulpritFeedback(sheriff, req)
+				So(err, ShouldBeNil)
+				So(datastore.Get(c, got), ShouldBeNil)
+				So(got.Feedback, ShouldEqual, model.CulpritFeedback_Correct)
+			})
+		})
+
+		Convey("requires membership", func() {
+			other := auth.WithState(c, &authtest.FakeState{
+				Identity: "user:other@example.com",
+			})
+			_, err := server.MarkCulpritFeedback(other, req)
+			So(status.Code(err), ShouldEqual, codes.PermissionDenied)
+		})
+
+		Convey("validates the request", func() {
+			req.Feedback = gofinditpb.CulpritFeedback_CULPRIT_FEEDBACK_UNSPECIFIED
+			_, err := server.MarkCulpritFeedback(sheriff, req)
+			So(status.Code(err), ShouldEqual, codes.InvalidArgument)
+
+			req.Feedback = gofinditpb.CulpritFeedback_CORRECT
+			req.CommitId = ""
+			_, err = server.MarkCulpritFeedback(sheriff, req)
+			So(status.Code(err), ShouldEqual, codes.InvalidArgument)
+		})
+
+		Convey("fails for unknown culprits", func() {
+			req.CommitId = "def"
+			_, err := server.MarkCulpritFeedback(sheriff, req)
+			So(status.Code(err), ShouldEqual, codes.NotFound)
+		})
+	})
+}
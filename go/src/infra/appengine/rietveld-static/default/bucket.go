@@ -0,0 +1,99 @@
+// Copyright 2021 The Chromium Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"strconv"
+
+	"cloud.google.com/go/storage"
+	"google.golang.org/api/iterator"
+)
+
+// bucket gives access to the objects of the rietveld bucket, which are named
+// after the paths of the archived pages.
+type bucket interface {
+	// Attrs returns the attributes of the object at path, or
+	// storage.ErrObjectNotExist if there is none.
+	Attrs(ctx context.Context, path string) (*Attrs, error)
+	// NewReader returns a reader of the contents of the object at path.
+	NewReader(ctx context.Context, path string) (io.ReadCloser, error)
+	// NewRangeReader returns a reader of length bytes of the contents of the
+	// object at path, from offset.
+	NewRangeReader(ctx context.Context, path string, offset, length int64) (io.ReadCloser, error)
+	// Walk calls f with the path and attributes of each object whose path
+	// starts with prefix, until f returns an error.
+	Walk(ctx context.Context, prefix string, f func(path string, attrs *Attrs) error) error
+}
+
+// gcsBucket is a bucket stored in GCS.
+type gcsBucket struct {
+	handle *storage.BucketHandle
+}
+
+func (b *gcsBucket) Attrs(ctx context.Context, path string) (*Attrs, error) {
+	attrs, err := b.handle.Object(path).Attrs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return parseAttrs(attrs)
+}
+
+func (b *gcsBucket) NewReader(ctx context.Context, path string) (io.ReadCloser, error) {
+	return b.handle.Object(path).NewReader(ctx)
+}
+
+func (b *gcsBucket) NewRangeReader(ctx context.Context, path string, offset, length int64) (io.ReadCloser, error) {
+	return b.handle.Object(path).NewRangeReader(ctx, offset, length)
+}
+
+func (b *gcsBucket) Walk(ctx context.Context, prefix string, f func(path string, attrs *Attrs) error) error {
+	it := b.handle.Objects(ctx, &storage.Query{Prefix: prefix})
+	for {
+		attrs, err := it.Next()
+		if err == iterator.Done {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		parsed, err := parseAttrs(attrs)
+		if err != nil {
+			log.Printf("skipping %s: %v", attrs.Name, err)
+			continue
+		}
+		if err := f(attrs.Name, parsed); err != nil {
+			return err
+		}
+	}
+}
+
+// parseAttrs parses the attributes of a rietveld page from the metadata of
+// its GCS object.
+func parseAttrs(attrs *storage.ObjectAttrs) (*Attrs, error) {
+	private, ok := attrs.Metadata["Rietveld-Private"]
+	if !ok {
+		return nil, errors.New("expected object metadata to contain Rietveld-Private attribute")
+	}
+
+	statusCodeStr, ok := attrs.Metadata["Status-Code"]
+	if !ok {
+		return nil, errors.New("expected object metadata to contain Status-Code attribute")
+	}
+	statusCode, err := strconv.Atoi(statusCodeStr)
+	if err != nil {
+		return nil, fmt.Errorf("expected object Status-Code attribute to be an integer: %v", statusCode)
+	}
+
+	return &Attrs{
+		Private:     private == "True",
+		StatusCode:  statusCode,
+		ContentType: attrs.ContentType,
+		Size:        attrs.Size,
+	}, nil
+}
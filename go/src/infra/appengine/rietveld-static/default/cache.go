@@ -0,0 +1,144 @@
+// Copyright 2021 The Chromium Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+package main
+
+import (
+	"context"
+	"errors"
+	"log"
+	"sync"
+	"time"
+
+	"cloud.google.com/go/storage"
+)
+
+const (
+	// attrsCacheSize is the maximum number of objects in the attrs cache.
+	attrsCacheSize = 200000
+	// attrsCacheTTL is the time after which cached attributes are fetched
+	// again.
+	attrsCacheTTL = time.Hour
+)
+
+// errCacheFull stops walking the bucket once the cache is full.
+var errCacheFull = errors.New("attrs cache is full")
+
+// attrsCache is an in-memory cache of the attributes of objects, which also
+// remembers objects that do not exist.
+type attrsCache struct {
+	maxEntries int
+	ttl        time.Duration
+
+	mu      sync.Mutex
+	entries map[string]attrsCacheEntry
+}
+
+type attrsCacheEntry struct {
+	// attrs is nil if the object does not exist.
+	attrs   *Attrs
+	expires time.Time
+}
+
+func newAttrsCache(maxEntries int, ttl time.Duration) *attrsCache {
+	return &attrsCache{
+		maxEntries: maxEntries,
+		ttl:        ttl,
+		entries:    make(map[string]attrsCacheEntry),
+	}
+}
+
+// get returns the cached attributes of the object at path, which are nil if
+// the object does not exist, and whether they were cached.
+func (c *attrsCache) get(path string) (*Attrs, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	e, ok := c.entries[path]
+	if !ok || time.Now().After(e.expires) {
+		return nil, false
+	}
+	return e.attrs, true
+}
+
+// put caches the attributes of the object at path, or nil if it does not
+// exist. An arbitrary entry is evicted if the cache is full.
+func (c *attrsCache) put(path string, attrs *Attrs) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if _, ok := c.entries[path]; !ok && len(c.entries) >= c.maxEntries {
+		for evicted := range c.entries {
+			delete(c.entries, evicted)
+			break
+		}
+	}
+	c.entries[path] = attrsCacheEntry{attrs: attrs, expires: time.Now().Add(c.ttl)}
+}
+
+// len returns the number of cached objects.
+func (c *attrsCache) len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(c.entries)
+}
+
+// attrs returns the attributes of the object at path, from the cache if
+// possible, or storage.ErrObjectNotExist if there is none.
+func (s *server) attrs(ctx context.Context, path string) (*Attrs, error) {
+	if attrs, ok := s.cache.get(path); ok {
+		if attrs == nil {
+			return nil, storage.ErrObjectNotExist
+		}
+		return attrs, nil
+	}
+
+	attrs, err := s.bucket.Attrs(ctx, path)
+	switch {
+	case err == storage.ErrObjectNotExist:
+		s.cache.put(path, nil)
+	case err != nil:
+		return nil, err
+	default:
+		s.cache.put(path, attrs)
+	}
+	return attrs, err
+}
+
+// warmCache populates the attrs cache with the objects whose paths start with
+// the given prefixes, and does so again every interval until ctx is done.
+//
+// The listing of the bucket stands for a sitemap: prefixes are walked in
+// order, so the hottest ones should come first, until the cache is full.
+func (s *server) warmCache(ctx context.Context, prefixes []string, interval time.Duration) {
+	for {
+		s.warmCacheOnce(ctx, prefixes)
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(interval):
+		}
+	}
+}
+
+// warmCacheOnce walks the given prefixes once, and returns the number of
+// objects cached.
+func (s *server) warmCacheOnce(ctx context.Context, prefixes []string) int {
+	warmed := 0
+	for _, prefix := range prefixes {
+		err := s.bucket.Walk(ctx, prefix, func(path string, attrs *Attrs) error {
+			if warmed >= s.cache.maxEntries {
+				return errCacheFull
+			}
+			s.cache.put(path, attrs)
+			warmed++
+			return nil
+		})
+		if err == errCacheFull {
+			break
+		}
+		if err != nil {
+			log.Printf("failed to warm the attrs cache with %s: %v", prefix, err)
+		}
+	}
+	log.Printf("warmed the attrs cache with %d objects", warmed)
+	return warmed
+}
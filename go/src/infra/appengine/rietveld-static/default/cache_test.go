@@ -0,0 +1,83 @@
+// Copyright 2021 The Chromium Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+package main
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestAttrsCache(t *testing.T) {
+	t.Parallel()
+
+	c := newAttrsCache(2, time.Hour)
+	c.put("/1", &Attrs{StatusCode: 200})
+	c.put("/2", nil)
+	if attrs, ok := c.get("/1"); !ok || attrs.StatusCode != 200 {
+		t.Errorf("got %v, %v for /1, want cached attributes", attrs, ok)
+	}
+	if attrs, ok := c.get("/2"); !ok || attrs != nil {
+		t.Errorf("got %v, %v for /2, want a cached missing object", attrs, ok)
+	}
+	if _, ok := c.get("/3"); ok {
+		t.Errorf("got /3 cached, want it not cached")
+	}
+
+	c.put("/3", &Attrs{StatusCode: 200})
+	if c.len() != 2 {
+		t.Errorf("got %d cached objects, want 2", c.len())
+	}
+	if _, ok := c.get("/3"); !ok {
+		t.Errorf("got /3 not cached, want it cached")
+	}
+
+	expired := newAttrsCache(2, -time.Second)
+	expired.put("/1", &Attrs{StatusCode: 200})
+	if _, ok := expired.get("/1"); ok {
+		t.Errorf("got expired /1 cached, want it not cached")
+	}
+}
+
+func TestWarmCache(t *testing.T) {
+	t.Parallel()
+
+	b := &fakeBucket{
+		objects: map[string]*Attrs{
+			"/1001":         {StatusCode: 200},
+			"/1001/patch/1": {StatusCode: 200},
+			"/1002":         {Private: true, StatusCode: 200},
+			"/2001":         {StatusCode: 200},
+			"/3001":         {StatusCode: 200},
+		},
+	}
+
+	t.Run("caches the objects under the prefixes", func(t *testing.T) {
+		t.Parallel()
+		s := newTestServer(b)
+		if n := s.warmCacheOnce(context.Background(), []string{"/100", "/2"}); n != 4 {
+			t.Errorf("got %d objects warmed, want 4", n)
+		}
+		for _, path := range []string{"/1001", "/1001/patch/1", "/1002", "/2001"} {
+			if attrs, ok := s.cache.get(path); !ok || attrs != b.objects[path] {
+				t.Errorf("got %v, %v for %s, want cached attributes", attrs, ok, path)
+			}
+		}
+		if _, ok := s.cache.get("/3001"); ok {
+			t.Errorf("got /3001 cached, want it not cached")
+		}
+	})
+
+	t.Run("stops once the cache is full", func(t *testing.T) {
+		t.Parallel()
+		s := newTestServer(b)
+		s.cache = newAttrsCache(2, time.Hour)
+		if n := s.warmCacheOnce(context.Background(), []string{"/1", "/2"}); n != 2 {
+			t.Errorf("got %d objects warmed, want 2", n)
+		}
+		if _, ok := s.cache.get("/2001"); ok {
+			t.Errorf("got /2001 cached, want the hotter prefix only")
+		}
+	})
+}
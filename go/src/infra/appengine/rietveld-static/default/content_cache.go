@@ -0,0 +1,110 @@
+// Copyright 2021 The Chromium Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+package main
+
+import (
+	"container/list"
+	"sync"
+)
+
+const (
+	// defaultContentCacheBytes is the default maximum total size of the
+	// objects in the content cache.
+	defaultContentCacheBytes = 256 << 20
+	// defaultContentCacheMaxObjectBytes is the default maximum size of an
+	// object in the content cache. Larger objects are streamed from GCS.
+	defaultContentCacheMaxObjectBytes = 1 << 20
+)
+
+// contentCache is an in-memory LRU cache of the attributes and contents of
+// small public objects, bounded by the total size of the cached contents.
+//
+// The archived pages never change, so entries don't expire. Private objects
+// are never cached, so that a cache hit can't skip the authorization of the
+// user.
+type contentCache struct {
+	maxBytes       int64
+	maxObjectBytes int64
+
+	mu    sync.Mutex
+	bytes int64
+	// lru holds the *contentCacheEntry, the most recently used first.
+	lru     *list.List
+	entries map[string]*list.Element
+}
+
+type contentCacheEntry struct {
+	path  string
+	attrs *Attrs
+	body  []byte
+}
+
+// size is the number of bytes the entry accounts for in the cache.
+func (e *contentCacheEntry) size() int64 {
+	return int64(len(e.path) + len(e.body))
+}
+
+func newContentCache(maxBytes, maxObjectBytes int64) *contentCache {
+	return &contentCache{
+		maxBytes:       maxBytes,
+		maxObjectBytes: maxObjectBytes,
+		lru:            list.New(),
+		entries:        make(map[string]*list.Element),
+	}
+}
+
+// cacheable returns whether an object with the given attributes may be
+// cached, so that its contents are worth reading in full.
+func (c *contentCache) cacheable(attrs *Attrs) bool {
+	return !attrs.Private && attrs.Size <= c.maxObjectBytes
+}
+
+// get returns the cached attributes and contents of the object at path, and
+// whether they were cached.
+func (c *contentCache) get(path string) (*Attrs, []byte, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	el, ok := c.entries[path]
+	if !ok {
+		return nil, nil, false
+	}
+	c.lru.MoveToFront(el)
+	e := el.Value.(*contentCacheEntry)
+	return e.attrs, e.body, true
+}
+
+// put caches the attributes and contents of the object at path, unless it is
+// private or too large. The least recently used objects are evicted to make
+// room for it.
+func (c *contentCache) put(path string, attrs *Attrs, body []byte) {
+	e := &contentCacheEntry{path: path, attrs: attrs, body: body}
+	if attrs.Private || int64(len(body)) > c.maxObjectBytes || e.size() > c.maxBytes {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if el, ok := c.entries[path]; ok {
+		c.remove(el)
+	}
+	for c.bytes+e.size() > c.maxBytes {
+		c.remove(c.lru.Back())
+	}
+	c.entries[path] = c.lru.PushFront(e)
+	c.bytes += e.size()
+}
+
+// remove removes an entry from the cache. c.mu must be held.
+func (c *contentCache) remove(el *list.Element) {
+	e := c.lru.Remove(el).(*contentCacheEntry)
+	delete(c.entries, e.path)
+	c.bytes -= e.size()
+}
+
+// len returns the number of cached objects and their total size.
+func (c *contentCache) len() (int, int64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(c.entries), c.bytes
+}
@@ -0,0 +1,148 @@
+// Copyright 2021 The Chromium Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestContentCache(t *testing.T) {
+	t.Parallel()
+
+	public := &Attrs{StatusCode: 200, ContentType: "text/html"}
+
+	t.Run("evicts the least recently used objects", func(t *testing.T) {
+		t.Parallel()
+		// Room for three 8 byte objects with 2 byte paths.
+		c := newContentCache(30, 10)
+		c.put("/1", public, []byte("11111111"))
+		c.put("/2", public, []byte("22222222"))
+		c.put("/3", public, []byte("33333333"))
+		if _, body, ok := c.get("/1"); !ok || string(body) != "11111111" {
+			t.Errorf("got %q, %v for /1, want cached contents", body, ok)
+		}
+		c.put("/4", public, []byte("44444444"))
+		if _, _, ok := c.get("/2"); ok {
+			t.Errorf("got /2 cached, want it evicted")
+		}
+		for _, path := range []string{"/1", "/3", "/4"} {
+			if _, _, ok := c.get(path); !ok {
+				t.Errorf("got %s not cached, want it cached", path)
+			}
+		}
+		if n, size := c.len(); n != 3 || size != 30 {
+			t.Errorf("got %d cached objects of %d bytes, want 3 of 30 bytes", n, size)
+		}
+
+		// Replacing an object accounts for its new size.
+		c.put("/4", public, []byte("4"))
+		if n, size := c.len(); n != 3 || size != 23 {
+			t.Errorf("got %d cached objects of %d bytes, want 3 of 23 bytes", n, size)
+		}
+	})
+
+	t.Run("skips private and large objects", func(t *testing.T) {
+		t.Parallel()
+		c := newContentCache(100, 10)
+		c.put("/private", &Attrs{Private: true, StatusCode: 200}, []byte("private"))
+		c.put("/large", public, []byte("larger than 10 bytes"))
+		if n, _ := c.len(); n != 0 {
+			t.Errorf("got %d cached objects, want none", n)
+		}
+		if c.cacheable(&Attrs{Private: true}) {
+			t.Errorf("got private objects cacheable, want them not cacheable")
+		}
+		if c.cacheable(&Attrs{Size: 11}) {
+			t.Errorf("got large objects cacheable, want them not cacheable")
+		}
+	})
+}
+
+func TestPathHandler(t *testing.T) {
+	t.Parallel()
+
+	newBucket := func() *fakeBucket {
+		return &fakeBucket{
+			objects: map[string]*Attrs{
+				"/1001":         {StatusCode: 200, ContentType: "text/html", Size: 18},
+				"/1002":         {Private: true, StatusCode: 200, ContentType: "text/html", Size: 18},
+				"/1003/patch/1": {StatusCode: 404, ContentType: "text/plain", Size: 26},
+				"/1004":         {StatusCode: 200, ContentType: "text/html", Size: 2 << 10},
+			},
+		}
+	}
+	get := func(s *server, path string) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		s.pathHandler(rec, httptest.NewRequest(http.MethodGet, path, nil))
+		return rec
+	}
+	check := func(t *testing.T, rec *httptest.ResponseRecorder, code int, contentType, cacheControl, body string) {
+		t.Helper()
+		if rec.Code != code {
+			t.Errorf("got status %d, want %d", rec.Code, code)
+		}
+		if got := rec.Header().Get("Content-Type"); got != contentType {
+			t.Errorf("got Content-Type %q, want %q", got, contentType)
+		}
+		if got := rec.Header().Get("Cache-Control"); got != cacheControl {
+			t.Errorf("got Cache-Control %q, want %q", got, cacheControl)
+		}
+		if got := rec.Body.String(); got != body {
+			t.Errorf("got body %q, want %q", got, body)
+		}
+	}
+
+	t.Run("serves small public objects from the cache", func(t *testing.T) {
+		t.Parallel()
+		b := newBucket()
+		s := newTestServer(b)
+		for i := 0; i < 3; i++ {
+			check(t, get(s, "/1001/"), 200, "text/html", publicCacheControl, "contents of /1001")
+			check(t, get(s, "/1003/patch/1"), 404, "text/plain", publicCacheControl, "contents of /1003/patch/1")
+		}
+		if b.reads != 2 || b.lookups != 2 {
+			t.Errorf("got %d reads and %d lookups, want 2 of each", b.reads, b.lookups)
+		}
+	})
+
+	t.Run("streams large objects", func(t *testing.T) {
+		t.Parallel()
+		b := newBucket()
+		s := newTestServer(b)
+		for i := 0; i < 2; i++ {
+			check(t, get(s, "/1004"), 200, "text/html", publicCacheControl, "contents of /1004")
+		}
+		if b.reads != 2 {
+			t.Errorf("got %d reads, want 2", b.reads)
+		}
+		if n, _ := s.content.len(); n != 0 {
+			t.Errorf("got %d cached objects, want none", n)
+		}
+	})
+
+	t.Run("does not cache private objects", func(t *testing.T) {
+		t.Parallel()
+		b := newBucket()
+		s := newTestServer(b)
+		rec := get(s, "/1002")
+		// Outside of the private project, private issues are redirected.
+		if rec.Code != http.StatusMovedPermanently || !strings.HasPrefix(rec.Header().Get("Location"), privateProjectURL) {
+			t.Errorf("got status %d to %q, want a redirect to the private project", rec.Code, rec.Header().Get("Location"))
+		}
+		if _, _, ok := s.content.get("/1002"); ok {
+			t.Errorf("got /1002 cached, want it not cached")
+		}
+	})
+
+	t.Run("missing objects", func(t *testing.T) {
+		t.Parallel()
+		rec := get(newTestServer(newBucket()), "/9999")
+		if rec.Code != http.StatusNotFound {
+			t.Errorf("got status %d, want %d", rec.Code, http.StatusNotFound)
+		}
+	})
+}
@@ -0,0 +1,119 @@
+// Copyright 2021 The Chromium Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+	"sync"
+
+	"cloud.google.com/go/storage"
+)
+
+const (
+	// maxExistsPaths is the maximum number of paths of an /api/exists request.
+	maxExistsPaths = 1000
+	// maxExistsBodyBytes is the maximum size of the body of an /api/exists
+	// request.
+	maxExistsBodyBytes = 1 << 20
+	// maxConcurrentLookups is the default maximum number of GCS lookups in
+	// flight for an /api/exists request.
+	maxConcurrentLookups = 50
+)
+
+// existsRequest is the body of an /api/exists request.
+type existsRequest struct {
+	Paths []string `json:"paths"`
+}
+
+// existsResult tells whether a path of an /api/exists request resolves.
+type existsResult struct {
+	Path       string `json:"path"`
+	Exists     bool   `json:"exists"`
+	Private    bool   `json:"private"`
+	StatusCode int    `json:"status_code,omitempty"`
+	// Error is set if the lookup failed, in which case Exists is unknown.
+	Error string `json:"error,omitempty"`
+}
+
+// existsResponse is the body of an /api/exists response. Results are in the
+// order of the paths of the request.
+type existsResponse struct {
+	Results []*existsResult `json:"results"`
+}
+
+// existsHandler handles POST /api/exists, which checks whether the given
+// paths resolve, without fetching the pages. Only users allowed to view
+// private issues can use it, so it must be called on the IAP-protected
+// project.
+func (s *server) existsHandler(w http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+	if req.Method != http.MethodPost {
+		http.Error(w, "only POST is allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	if err := s.authorize(ctx, req); err != nil {
+		log.Printf("not authorized: %v", err)
+		http.Error(w, "not authorized", http.StatusUnauthorized)
+		return
+	}
+
+	var body existsRequest
+	if err := json.NewDecoder(http.MaxBytesReader(w, req.Body, maxExistsBodyBytes)).Decode(&body); err != nil {
+		http.Error(w, fmt.Sprintf("failed to parse the request: %v", err), http.StatusBadRequest)
+		return
+	}
+	if len(body.Paths) > maxExistsPaths {
+		http.Error(w, fmt.Sprintf("at most %d paths are allowed, got %d", maxExistsPaths, len(body.Paths)), http.StatusBadRequest)
+		return
+	}
+
+	res := &existsResponse{Results: s.existAll(ctx, body.Paths)}
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(res); err != nil {
+		log.Printf("failed to write the response: %v", err)
+	}
+}
+
+// existAll looks up the given paths in parallel, with at most
+// s.maxConcurrentLookups lookups in flight.
+func (s *server) existAll(ctx context.Context, paths []string) []*existsResult {
+	results := make([]*existsResult, len(paths))
+	sem := make(chan struct{}, s.maxConcurrentLookups)
+	var wg sync.WaitGroup
+	for i, path := range paths {
+		sem <- struct{}{}
+		wg.Add(1)
+		go func(i int, path string) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			results[i] = s.exists(ctx, path)
+		}(i, path)
+	}
+	wg.Wait()
+	return results
+}
+
+// exists looks up a single path, like pathHandler does.
+func (s *server) exists(ctx context.Context, path string) *existsResult {
+	res := &existsResult{Path: path}
+	attrs, err := s.attrs(ctx, strings.TrimSuffix(path, "/"))
+	switch {
+	case err == storage.ErrObjectNotExist:
+	case err != nil:
+		log.Printf("failed to fetch attributes for %s: %v", path, err)
+		res.Error = err.Error()
+	default:
+		res.Exists = true
+		res.Private = attrs.Private
+		res.StatusCode = attrs.StatusCode
+	}
+	return res
+}
@@ -0,0 +1,239 @@
+// Copyright 2021 The Chromium Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"cloud.google.com/go/storage"
+	"github.com/google/go-cmp/cmp"
+)
+
+// fakeBucket is a bucket with the objects in memory.
+type fakeBucket struct {
+	objects map[string]*Attrs
+	// bodies are the contents of objects, "contents of <path>" by default.
+	bodies map[string]string
+	// errs are the errors returned when fetching the attributes of objects.
+	errs map[string]error
+	// delay is the duration of each attributes lookup.
+	delay time.Duration
+
+	mu          sync.Mutex
+	lookups     int
+	inFlight    int
+	maxInFlight int
+	reads       int
+	rangeReads  int
+}
+
+func (b *fakeBucket) Attrs(ctx context.Context, path string) (*Attrs, error) {
+	b.mu.Lock()
+	b.lookups++
+	b.inFlight++
+	if b.inFlight > b.maxInFlight {
+		b.maxInFlight = b.inFlight
+	}
+	b.mu.Unlock()
+	defer func() {
+		b.mu.Lock()
+		b.inFlight--
+		b.mu.Unlock()
+	}()
+
+	time.Sleep(b.delay)
+	if err, ok := b.errs[path]; ok {
+		return nil, err
+	}
+	attrs, ok := b.objects[path]
+	if !ok {
+		return nil, storage.ErrObjectNotExist
+	}
+	return attrs, nil
+}
+
+func (b *fakeBucket) NewReader(ctx context.Context, path string) (io.ReadCloser, error) {
+	b.mu.Lock()
+	b.reads++
+	b.mu.Unlock()
+	if _, ok := b.objects[path]; !ok {
+		return nil, storage.ErrObjectNotExist
+	}
+	return ioutil.NopCloser(strings.NewReader(b.body(path))), nil
+}
+
+func (b *fakeBucket) NewRangeReader(ctx context.Context, path string, offset, length int64) (io.ReadCloser, error) {
+	b.mu.Lock()
+	b.rangeReads++
+	b.mu.Unlock()
+	if _, ok := b.objects[path]; !ok {
+		return nil, storage.ErrObjectNotExist
+	}
+	return ioutil.NopCloser(strings.NewReader(b.body(path)[offset : offset+length])), nil
+}
+
+func (b *fakeBucket) body(path string) string {
+	if body, ok := b.bodies[path]; ok {
+		return body
+	}
+	return "contents of " + path
+}
+
+func (b *fakeBucket) Walk(ctx context.Context, prefix string, f func(path string, attrs *Attrs) error) error {
+	var paths []string
+	for path := range b.objects {
+		if strings.HasPrefix(path, prefix) {
+			paths = append(paths, path)
+		}
+	}
+	sort.Strings(paths)
+	for _, path := range paths {
+		if err := f(path, b.objects[path]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func newTestServer(b *fakeBucket) *server {
+	return &server{
+		bucket:  b,
+		cache:   newAttrsCache(100, time.Hour),
+		content: newContentCache(1<<20, 1<<10),
+		authorize: func(ctx context.Context, req *http.Request) error {
+			if req.Header.Get("X-Test-User") != "someone@chromium.org" {
+				return errors.New("not allowed")
+			}
+			return nil
+		},
+		maxConcurrentLookups: 10,
+	}
+}
+
+// postExists sends an /api/exists request to s, and returns the recorded
+// response.
+func postExists(s *server, paths []string, user string) *httptest.ResponseRecorder {
+	body, err := json.Marshal(&existsRequest{Paths: paths})
+	if err != nil {
+		panic(err)
+	}
+	req := httptest.NewRequest(http.MethodPost, "/api/exists", strings.NewReader(string(body)))
+	req.Header.Set("X-Test-User", user)
+	rec := httptest.NewRecorder()
+	s.existsHandler(rec, req)
+	return rec
+}
+
+func TestExistsHandler(t *testing.T) {
+	t.Parallel()
+
+	newBucket := func() *fakeBucket {
+		return &fakeBucket{
+			objects: map[string]*Attrs{
+				"/1001":         {StatusCode: 200, ContentType: "text/html"},
+				"/1002":         {Private: true, StatusCode: 200, ContentType: "text/html"},
+				"/1003/patch/1": {StatusCode: 404, ContentType: "text/plain"},
+			},
+			errs: map[string]error{
+				"/1004": errors.New("expected object metadata to contain Status-Code attribute"),
+			},
+		}
+	}
+
+	t.Run("mixed batch", func(t *testing.T) {
+		t.Parallel()
+		b := newBucket()
+		s := newTestServer(b)
+		paths := []string{"/1001", "/1002/", "/1003/patch/1", "/1004", "/9999"}
+
+		rec := postExists(s, paths, "someone@chromium.org")
+		if rec.Code != http.StatusOK {
+			t.Fatalf("got status %d: %s", rec.Code, rec.Body)
+		}
+		var res existsResponse
+		if err := json.NewDecoder(rec.Body).Decode(&res); err != nil {
+			t.Fatal(err)
+		}
+		want := existsResponse{Results: []*existsResult{
+			{Path: "/1001", Exists: true, StatusCode: 200},
+			{Path: "/1002/", Exists: true, Private: true, StatusCode: 200},
+			{Path: "/1003/patch/1", Exists: true, StatusCode: 404},
+			{Path: "/1004", Error: "expected object metadata to contain Status-Code attribute"},
+			{Path: "/9999"},
+		}}
+		if diff := cmp.Diff(want, res); diff != "" {
+			t.Errorf("unexpected response (-want +got):\n%s", diff)
+		}
+
+		// Found and missing objects are cached, failed lookups are not.
+		postExists(s, paths, "someone@chromium.org")
+		if b.lookups != 6 {
+			t.Errorf("got %d lookups, want 6", b.lookups)
+		}
+	})
+
+	t.Run("bounds concurrent lookups", func(t *testing.T) {
+		t.Parallel()
+		b := newBucket()
+		b.delay = 10 * time.Millisecond
+		s := newTestServer(b)
+		s.maxConcurrentLookups = 3
+		paths := make([]string, 30)
+		for i := range paths {
+			paths[i] = fmt.Sprintf("/%d", 2000+i)
+		}
+
+		rec := postExists(s, paths, "someone@chromium.org")
+		if rec.Code != http.StatusOK {
+			t.Fatalf("got status %d: %s", rec.Code, rec.Body)
+		}
+		if b.lookups != len(paths) {
+			t.Errorf("got %d lookups, want %d", b.lookups, len(paths))
+		}
+		if b.maxInFlight > 3 {
+			t.Errorf("got %d lookups in flight, want at most 3", b.maxInFlight)
+		}
+	})
+
+	t.Run("requires authorization", func(t *testing.T) {
+		t.Parallel()
+		b := newBucket()
+		rec := postExists(newTestServer(b), []string{"/1001"}, "someone@example.com")
+		if rec.Code != http.StatusUnauthorized {
+			t.Errorf("got status %d, want %d", rec.Code, http.StatusUnauthorized)
+		}
+		if b.lookups != 0 {
+			t.Errorf("got %d lookups, want 0", b.lookups)
+		}
+	})
+
+	t.Run("rejects too many paths", func(t *testing.T) {
+		t.Parallel()
+		rec := postExists(newTestServer(newBucket()), make([]string, maxExistsPaths+1), "someone@chromium.org")
+		if rec.Code != http.StatusBadRequest {
+			t.Errorf("got status %d, want %d", rec.Code, http.StatusBadRequest)
+		}
+	})
+
+	t.Run("rejects other methods", func(t *testing.T) {
+		t.Parallel()
+		rec := httptest.NewRecorder()
+		newTestServer(newBucket()).existsHandler(rec, httptest.NewRequest(http.MethodGet, "/api/exists", nil))
+		if rec.Code != http.StatusMethodNotAllowed {
+			t.Errorf("got status %d, want %d", rec.Code, http.StatusMethodNotAllowed)
+		}
+	})
+}
@@ -8,6 +8,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"io/ioutil"
 	"log"
 	"net/http"
 	"os"
@@ -23,15 +24,71 @@ const rietveldBucket = "chromiumcodereview"
 const privateProjectID = "chromiumcodereview-private"
 const privateProjectURL = "https://" + privateProjectID + ".appspot.com"
 
+// Cache-Control headers of the archived pages. Public pages never change, so
+// browsers and proxies may cache them. Private pages must not be cached by
+// proxies, which serve other users.
+const (
+	publicCacheControl  = "public, max-age=86400"
+	privateCacheControl = "private, max-age=0"
+)
+
 // Attrs contains information for a GS object
 type Attrs struct {
 	Private     bool
 	StatusCode  int
 	ContentType string
+	// Size is the size of the object in bytes.
+	Size int64
+}
+
+// server serves the pages archived in the rietveld bucket.
+type server struct {
+	bucket bucket
+	cache  *attrsCache
+	// content caches the contents of small public objects.
+	content *contentCache
+	// authorize checks that the user is allowed to view private issues.
+	authorize func(ctx context.Context, req *http.Request) error
+	// maxConcurrentLookups is the maximum number of GCS lookups in flight
+	// for an /api/exists request.
+	maxConcurrentLookups int
 }
 
 func main() {
-	http.Handle("/", http.HandlerFunc(pathHandler))
+	ctx := context.Background()
+	client, err := storage.NewClient(ctx)
+	if err != nil {
+		log.Fatalf("failed to create storage client: %v", err)
+	}
+	defer client.Close()
+
+	// CONTENT_CACHE_BYTES and CONTENT_CACHE_MAX_OBJECT_BYTES bound the total
+	// size of the content cache and the size of the objects it caches.
+	contentCacheBytes, err := envInt64("CONTENT_CACHE_BYTES", defaultContentCacheBytes)
+	if err != nil {
+		log.Fatal(err)
+	}
+	contentCacheMaxObjectBytes, err := envInt64("CONTENT_CACHE_MAX_OBJECT_BYTES", defaultContentCacheMaxObjectBytes)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	s := &server{
+		bucket:               &gcsBucket{handle: client.Bucket(rietveldBucket)},
+		cache:                newAttrsCache(attrsCacheSize, attrsCacheTTL),
+		content:              newContentCache(contentCacheBytes, contentCacheMaxObjectBytes),
+		authorize:            authorize,
+		maxConcurrentLookups: maxConcurrentLookups,
+	}
+
+	// WARM_CACHE_PREFIXES is a comma-separated list of the hottest path
+	// prefixes, whose attributes are kept in the cache.
+	if prefixes := os.Getenv("WARM_CACHE_PREFIXES"); prefixes != "" {
+		go s.warmCache(ctx, strings.Split(prefixes, ","), attrsCacheTTL/2)
+	}
+
+	http.Handle("/api/exists", http.HandlerFunc(s.existsHandler))
+	http.Handle("/", http.HandlerFunc(s.pathHandler))
 
 	port := os.Getenv("PORT")
 	if port == "" {
@@ -46,53 +103,72 @@ func main() {
 }
 
 // pathHandler handles /<path> to access gs://chromiumcodereview/<path>.
-func pathHandler(w http.ResponseWriter, req *http.Request) {
-	ctx := context.Background()
+//
+// Single range requests for the pages archived with the 200 status code are
+// supported, so that interrupted downloads of large patches can be resumed.
+func (s *server) pathHandler(w http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
 	// Remove trailing slashes, so that '/<issue>/' works as well as '/<issue>'.
 	path := strings.TrimSuffix(req.URL.Path, "/")
 
-	client, err := storage.NewClient(ctx)
-	if err != nil {
-		log.Printf("failed to create storage client: %v", err)
-		http.Error(w, err.Error(), http.StatusInternalServerError)
-		return
-	}
-	defer client.Close()
-
-	obj := client.Bucket(rietveldBucket).Object(path)
-
-	attrs, err := fetchAttrs(ctx, obj)
-	if err != nil {
-		log.Printf("failed to fetch attributes for %s: %v", path, err)
-		errString := err.Error()
-		if err == storage.ErrObjectNotExist {
-			http.Error(w, errString, http.StatusNotFound)
-		} else {
-			http.Error(w, errString, http.StatusInternalServerError)
+	attrs, body, cached := s.content.get(path)
+	if !cached {
+		var err error
+		if attrs, err = s.attrs(ctx, path); err != nil {
+			log.Printf("failed to fetch attributes for %s: %v", path, err)
+			errString := err.Error()
+			if err == storage.ErrObjectNotExist {
+				http.Error(w, errString, http.StatusNotFound)
+			} else {
+				http.Error(w, errString, http.StatusInternalServerError)
+			}
+			return
 		}
-		return
-	}
-
-	if attrs.Private {
-		// Private issues should only be accessed by a project protected with an
-		// IAP. Redirect to a protected project if necessary.
-		projectID := os.Getenv("GOOGLE_CLOUD_PROJECT")
-		if projectID != privateProjectID {
-			log.Printf("redirecting to %s", privateProjectURL+path)
-			http.Redirect(w, req, privateProjectURL+path, http.StatusMovedPermanently)
+		if !s.checkPrivate(w, req, path, attrs) {
 			return
 		}
-		// Validate that the IAP JWT is valid and the user is authorized when trying
-		// to access a private issue.
-		err = authorize(ctx, req)
-		if err != nil {
-			log.Printf("not authorized: %v", err)
-			http.Error(w, "not authorized", http.StatusUnauthorized)
+		if s.content.cacheable(attrs) && req.Method != http.MethodHead {
+			if body, err = s.readAll(ctx, path); err != nil {
+				log.Printf("failed to read %s: %v", path, err)
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+				return
+			}
+			s.content.put(path, attrs, body)
+			cached = true
+		}
+	}
+
+	size := attrs.Size
+	if cached {
+		size = int64(len(body))
+	}
+	var rng *byteRange
+	if attrs.StatusCode == http.StatusOK {
+		var err error
+		rng, err = parseRange(req.Header.Get("Range"), size)
+		if err == errUnsatisfiableRange {
+			w.Header().Set("Content-Range", fmt.Sprintf("bytes */%d", size))
+			http.Error(w, err.Error(), http.StatusRequestedRangeNotSatisfiable)
 			return
 		}
 	}
 
-	reader, err := obj.NewReader(ctx)
+	switch {
+	case req.Method == http.MethodHead:
+		writeHeader(w, attrs, rng, size)
+		return
+	case cached:
+		writeObject(w, attrs, rng, body)
+		return
+	}
+
+	var reader io.ReadCloser
+	var err error
+	if rng != nil {
+		reader, err = s.bucket.NewRangeReader(ctx, path, rng.start, rng.length)
+	} else {
+		reader, err = s.bucket.NewReader(ctx, path)
+	}
 	if err != nil {
 		log.Printf("failed to fetch %s: %v", path, err)
 		http.Error(w, err.Error(), http.StatusInternalServerError)
@@ -100,9 +176,13 @@ func pathHandler(w http.ResponseWriter, req *http.Request) {
 	}
 	defer reader.Close()
 
-	w.WriteHeader(attrs.StatusCode)
-	w.Header().Set("Content-Type", attrs.ContentType)
-
+	if rng != nil {
+		writeHeader(w, attrs, rng, size)
+	} else {
+		// The size in the attributes may not be the size of the contents
+		// served by GCS, e.g. if they are transcoded, so it's not sent.
+		writeHeader(w, attrs, nil, -1)
+	}
 	_, err = io.Copy(w, reader)
 	if err != nil {
 		log.Printf("failed to copy %s: %v", path, err)
@@ -110,31 +190,91 @@ func pathHandler(w http.ResponseWriter, req *http.Request) {
 	}
 }
 
-func fetchAttrs(ctx context.Context, obj *storage.ObjectHandle) (*Attrs, error) {
-	attrs, err := obj.Attrs(ctx)
+// readAll reads the contents of the object at path.
+func (s *server) readAll(ctx context.Context, path string) ([]byte, error) {
+	reader, err := s.bucket.NewReader(ctx, path)
 	if err != nil {
 		return nil, err
 	}
+	defer reader.Close()
+	return ioutil.ReadAll(reader)
+}
 
-	private, ok := attrs.Metadata["Rietveld-Private"]
-	if !ok {
-		return nil, errors.New("expected object metadata to contain Rietveld-Private attribute")
+// checkPrivate checks that the user may view the object at path, if it is
+// private. Otherwise, it writes the response redirecting the user to the
+// private project or rejecting the request, and returns false.
+func (s *server) checkPrivate(w http.ResponseWriter, req *http.Request, path string, attrs *Attrs) bool {
+	if !attrs.Private {
+		return true
+	}
+	// Private issues should only be accessed by a project protected with an
+	// IAP. Redirect to a protected project if necessary.
+	projectID := os.Getenv("GOOGLE_CLOUD_PROJECT")
+	if projectID != privateProjectID {
+		log.Printf("redirecting to %s", privateProjectURL+path)
+		http.Redirect(w, req, privateProjectURL+path, http.StatusMovedPermanently)
+		return false
+	}
+	// Validate that the IAP JWT is valid and the user is authorized when trying
+	// to access a private issue.
+	if err := s.authorize(req.Context(), req); err != nil {
+		log.Printf("not authorized: %v", err)
+		http.Error(w, "not authorized", http.StatusUnauthorized)
+		return false
 	}
+	return true
+}
 
-	statusCodeStr, ok := attrs.Metadata["Status-Code"]
-	if !ok {
-		return nil, errors.New("expected object metadata to contain Status-Code attribute")
+// writeHeader writes the header of the response serving the given range of
+// an object with the given attributes and size, or the whole object if rng
+// is nil. The Content-Length header is not sent if size is negative.
+func writeHeader(w http.ResponseWriter, attrs *Attrs, rng *byteRange, size int64) {
+	h := w.Header()
+	h.Set("Content-Type", attrs.ContentType)
+	if attrs.Private {
+		h.Set("Cache-Control", privateCacheControl)
+	} else {
+		h.Set("Cache-Control", publicCacheControl)
 	}
-	statusCode, err := strconv.Atoi(statusCodeStr)
-	if err != nil {
-		return nil, fmt.Errorf("expected object Status-Code attribute to be an integer: %v", statusCode)
+	if attrs.StatusCode == http.StatusOK {
+		h.Set("Accept-Ranges", "bytes")
+	}
+	if rng != nil {
+		h.Set("Content-Range", rng.contentRange(size))
+		h.Set("Content-Length", strconv.FormatInt(rng.length, 10))
+		w.WriteHeader(http.StatusPartialContent)
+		return
+	}
+	if size >= 0 {
+		h.Set("Content-Length", strconv.FormatInt(size, 10))
 	}
+	w.WriteHeader(attrs.StatusCode)
+}
+
+// writeObject writes the response serving the given range of an object with
+// the given attributes and contents, or the whole object if rng is nil.
+func writeObject(w http.ResponseWriter, attrs *Attrs, rng *byteRange, body []byte) {
+	writeHeader(w, attrs, rng, int64(len(body)))
+	if rng != nil {
+		body = body[rng.start : rng.start+rng.length]
+	}
+	if _, err := w.Write(body); err != nil {
+		log.Printf("failed to write response: %v", err)
+	}
+}
 
-	return &Attrs{
-		Private:     private == "True",
-		StatusCode:  statusCode,
-		ContentType: attrs.ContentType,
-	}, nil
+// envInt64 returns the value of the environment variable name as an integer,
+// or def if it's not set.
+func envInt64(name string, def int64) (int64, error) {
+	v := os.Getenv(name)
+	if v == "" {
+		return def, nil
+	}
+	i, err := strconv.ParseInt(v, 10, 64)
+	if err != nil || i < 0 {
+		return 0, fmt.Errorf("%s must be a non-negative integer, got %q", name, v)
+	}
+	return i, nil
 }
 
 // authorize validates the JWT token present in the request and ensures that the
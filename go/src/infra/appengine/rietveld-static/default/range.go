@@ -0,0 +1,78 @@
+// Copyright 2021 The Chromium Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+package main
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// errUnsatisfiableRange is returned by parseRange if the requested range
+// starts past the end of the object.
+var errUnsatisfiableRange = errors.New("requested range not satisfiable")
+
+// byteRange is a range of bytes of an object.
+type byteRange struct {
+	start  int64
+	length int64
+}
+
+// contentRange returns the value of the Content-Range header of a response
+// serving the range of an object of the given size.
+func (r *byteRange) contentRange(size int64) string {
+	return fmt.Sprintf("bytes %d-%d/%d", r.start, r.start+r.length-1, size)
+}
+
+// parseRange parses the Range header of a request for an object of the given
+// size. Only single ranges are supported: parseRange returns nil, so that the
+// whole object is served, if there is no header, or if it has multiple ranges
+// or is malformed. The range is truncated to the end of the object, and
+// errUnsatisfiableRange is returned if it starts past the end.
+func parseRange(header string, size int64) (*byteRange, error) {
+	spec := strings.TrimPrefix(header, "bytes=")
+	if header == "" || spec == header || strings.Contains(spec, ",") {
+		return nil, nil
+	}
+	i := strings.Index(spec, "-")
+	if i < 0 {
+		return nil, nil
+	}
+	first, last := strings.TrimSpace(spec[:i]), strings.TrimSpace(spec[i+1:])
+
+	if first == "" {
+		// A suffix range, e.g. "bytes=-500" for the last 500 bytes.
+		n, err := strconv.ParseInt(last, 10, 64)
+		if err != nil || n < 0 {
+			return nil, nil
+		}
+		if n == 0 || size == 0 {
+			return nil, errUnsatisfiableRange
+		}
+		if n > size {
+			n = size
+		}
+		return &byteRange{start: size - n, length: n}, nil
+	}
+
+	start, err := strconv.ParseInt(first, 10, 64)
+	if err != nil || start < 0 {
+		return nil, nil
+	}
+	end := size - 1
+	if last != "" {
+		// A closed range, e.g. "bytes=0-499" for the first 500 bytes.
+		if end, err = strconv.ParseInt(last, 10, 64); err != nil || end < start {
+			return nil, nil
+		}
+		if end >= size {
+			end = size - 1
+		}
+	}
+	if start >= size {
+		return nil, errUnsatisfiableRange
+	}
+	return &byteRange{start: start, length: end - start + 1}, nil
+}
@@ -0,0 +1,145 @@
+// Copyright 2021 The Chromium Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestParseRange(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		header  string
+		want    *byteRange
+		wantErr error
+	}{
+		{"", nil, nil},
+		{"bytes=0-99", &byteRange{0, 100}, nil},
+		{"bytes=100-", &byteRange{100, 900}, nil},
+		{"bytes=-100", &byteRange{900, 100}, nil},
+		{"bytes=-2000", &byteRange{0, 1000}, nil},
+		{"bytes=900-1999", &byteRange{900, 100}, nil},
+		{"bytes=1000-", nil, errUnsatisfiableRange},
+		{"bytes=1000-1999", nil, errUnsatisfiableRange},
+		{"bytes=-0", nil, errUnsatisfiableRange},
+		// Multiple ranges and malformed headers are ignored.
+		{"bytes=0-9,20-29", nil, nil},
+		{"bytes=10-0", nil, nil},
+		{"bytes=a-b", nil, nil},
+		{"bytes=10", nil, nil},
+		{"items=0-9", nil, nil},
+	}
+	for _, c := range cases {
+		got, err := parseRange(c.header, 1000)
+		if err != c.wantErr {
+			t.Errorf("parseRange(%q) failed: got %v, want %v", c.header, err, c.wantErr)
+		}
+		if diff := cmp.Diff(c.want, got, cmp.AllowUnexported(byteRange{})); diff != "" {
+			t.Errorf("parseRange(%q) differs (-want +got):\n%s", c.header, diff)
+		}
+	}
+}
+
+func TestPathHandlerRanges(t *testing.T) {
+	t.Parallel()
+
+	// A patch larger than the objects in the content cache of the test server,
+	// and a small page, which is cached.
+	patch := strings.Repeat("0123456789", 205)
+	newBucket := func() *fakeBucket {
+		return &fakeBucket{
+			objects: map[string]*Attrs{
+				"/1001/patch/1/2": {StatusCode: 200, ContentType: "text/plain", Size: int64(len(patch))},
+				"/1001":           {StatusCode: 200, ContentType: "text/html", Size: 17},
+				"/1003/patch/1":   {StatusCode: 404, ContentType: "text/plain", Size: 26},
+			},
+			bodies: map[string]string{"/1001/patch/1/2": patch},
+		}
+	}
+	request := func(s *server, method, path, rng string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, path, nil)
+		if rng != "" {
+			req.Header.Set("Range", rng)
+		}
+		rec := httptest.NewRecorder()
+		s.pathHandler(rec, req)
+		return rec
+	}
+
+	cases := []struct {
+		name         string
+		path         string
+		rng          string
+		code         int
+		contentRange string
+		body         string
+	}{
+		{"open-ended range", "/1001/patch/1/2", "bytes=2000-", 206, "bytes 2000-2049/2050", patch[2000:]},
+		{"closed range", "/1001/patch/1/2", "bytes=10-19", 206, "bytes 10-19/2050", patch[10:20]},
+		{"suffix range", "/1001/patch/1/2", "bytes=-5", 206, "bytes 2045-2049/2050", patch[2045:]},
+		{"range past EOF", "/1001/patch/1/2", "bytes=3000-", 416, "bytes */2050", "requested range not satisfiable\n"},
+		{"range ending past EOF", "/1001/patch/1/2", "bytes=2040-9999", 206, "bytes 2040-2049/2050", patch[2040:]},
+		{"multiple ranges", "/1001/patch/1/2", "bytes=0-1,5-6", 200, "", patch},
+		{"malformed range", "/1001/patch/1/2", "bytes=x-", 200, "", patch},
+		{"cached object", "/1001", "bytes=0-7", 206, "bytes 0-7/17", "contents"},
+		{"object archived with an error", "/1003/patch/1", "bytes=0-7", 404, "", "contents of /1003/patch/1"},
+	}
+	for _, c := range cases {
+		c := c
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+			b := newBucket()
+			rec := request(newTestServer(b), http.MethodGet, c.path, c.rng)
+			if rec.Code != c.code {
+				t.Errorf("got status %d, want %d", rec.Code, c.code)
+			}
+			if got := rec.Header().Get("Content-Range"); got != c.contentRange {
+				t.Errorf("got Content-Range %q, want %q", got, c.contentRange)
+			}
+			if got := rec.Body.String(); got != c.body {
+				t.Errorf("got body %q, want %q", got, c.body)
+			}
+			if c.code == 206 && c.path == "/1001/patch/1/2" && (b.rangeReads != 1 || b.reads != 0) {
+				t.Errorf("got %d range reads and %d reads, want the range only", b.rangeReads, b.reads)
+			}
+		})
+	}
+
+	t.Run("advertises range support", func(t *testing.T) {
+		t.Parallel()
+		s := newTestServer(newBucket())
+		if got := request(s, http.MethodGet, "/1001/patch/1/2", "").Header().Get("Accept-Ranges"); got != "bytes" {
+			t.Errorf("got Accept-Ranges %q, want %q", got, "bytes")
+		}
+		if got := request(s, http.MethodGet, "/1003/patch/1", "").Header().Get("Accept-Ranges"); got != "" {
+			t.Errorf("got Accept-Ranges %q for an error page, want none", got)
+		}
+	})
+
+	t.Run("HEAD requests", func(t *testing.T) {
+		t.Parallel()
+		b := newBucket()
+		s := newTestServer(b)
+		rec := request(s, http.MethodHead, "/1001/patch/1/2", "")
+		if rec.Code != 200 || rec.Header().Get("Content-Length") != "2050" || rec.Body.Len() != 0 {
+			t.Errorf("got status %d, Content-Length %q and %d bytes, want the length of the object only",
+				rec.Code, rec.Header().Get("Content-Length"), rec.Body.Len())
+		}
+		rec = request(s, http.MethodHead, "/1001/patch/1/2", "bytes=-50")
+		if rec.Code != 206 || rec.Header().Get("Content-Length") != "50" || rec.Body.Len() != 0 {
+			t.Errorf("got status %d, Content-Length %q and %d bytes, want the length of the range only",
+				rec.Code, rec.Header().Get("Content-Length"), rec.Body.Len())
+		}
+		request(s, http.MethodHead, "/1001", "")
+		if b.reads != 0 || b.rangeReads != 0 {
+			t.Errorf("got %d reads and %d range reads, want none", b.reads, b.rangeReads)
+		}
+	})
+}
@@ -5,12 +5,16 @@
 package app
 
 import (
+	"bytes"
+	"compress/zlib"
 	"context"
 	"encoding/json"
+	"io/ioutil"
 	"net/http"
 	"regexp"
 	"time"
 
+	bbpb "go.chromium.org/luci/buildbucket/proto"
 	bbv1 "go.chromium.org/luci/common/api/buildbucket/buildbucket/v1"
 	"go.chromium.org/luci/common/errors"
 	"go.chromium.org/luci/common/retry/transient"
@@ -20,8 +24,10 @@ import (
 	cvv1 "go.chromium.org/luci/cv/api/v1"
 	"go.chromium.org/luci/server/router"
 	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
+	"infra/appengine/weetbix/internal/buildbucket/pubsubpb"
 	"infra/appengine/weetbix/internal/cv"
 	"infra/appengine/weetbix/internal/services/resultingester"
 	"infra/appengine/weetbix/internal/tasks/taskspb"
@@ -35,6 +41,9 @@ const (
 	// chromiumCIBucket is the bucket for chromium ci builds in bb v1 format.
 	chromiumCIBucket = "luci.chromium.ci"
 
+	// chromiumCIBucketV2 is the bucket for chromium ci builds in bb v2 format.
+	chromiumCIBucketV2 = "ci"
+
 	chromiumProject = "chromium"
 )
 
@@ -46,6 +55,13 @@ var (
 		// "success", "ignored", "transient-failure" or "permanent-failure".
 		field.String("status"))
 
+	buildFormatCounter = metric.NewCounter(
+		"weetbix/buildbucket_pubsub/formats",
+		"The number of buildbucket Pub/Sub messages received by Weetbix, by message format",
+		nil,
+		// "v1" or "v2".
+		field.String("format"))
+
 	cvRunCounter = metric.NewCounter(
 		"weetbix/cv_pubsub/runs",
 		"The number of CV runs received by Weetbix from PubSub",
@@ -61,7 +77,8 @@ var (
 // See https://source.chromium.org/chromium/infra/infra/+/main:luci/appengine/components/components/pubsub.py;l=178;drc=78ce3aa55a2e5f77dc05517ef3ec377b3f36dc6e.
 type pubsubMessage struct {
 	Message struct {
-		Data []byte
+		Data       []byte
+		Attributes map[string]string
 	}
 	Attributes map[string]interface{}
 }
@@ -82,6 +99,9 @@ func processErr(ctx *router.Context, err error) string {
 // As of Aug 2021, Weetbix subscribes to this Pub/Sub topic to get completed
 // Chromium CI builds.
 // For CQ builds, Weetbix uses CV Pub/Sub as the entrypoint.
+//
+// Both the legacy and the builds_v2 message formats are accepted while
+// Buildbucket migrates to the builds_v2 topic.
 func BuildbucketPubSubHandler(ctx *router.Context) {
 	status := "unknown"
 	defer func() {
@@ -100,7 +120,7 @@ func BuildbucketPubSubHandler(ctx *router.Context) {
 }
 
 func bbPubSubHandlerImpl(ctx context.Context, request *http.Request) error {
-	build, createTime, err := extractBuildAndCreateTime(request)
+	build, createTime, err := extractBuildAndCreateTime(ctx, request)
 
 	switch {
 	case err != nil:
@@ -120,17 +140,30 @@ func bbPubSubHandlerImpl(ctx context.Context, request *http.Request) error {
 	}
 }
 
-func extractBuildAndCreateTime(r *http.Request) (*taskspb.Build, time.Time, error) {
+func extractBuildAndCreateTime(ctx context.Context, r *http.Request) (*taskspb.Build, time.Time, error) {
 	var msg pubsubMessage
 	if err := json.NewDecoder(r.Body).Decode(&msg); err != nil {
 		return nil, time.Time{}, errors.Annotate(err, "could not decode buildbucket pubsub message").Err()
 	}
 
+	// Buildbucket sets the "version" attribute only on messages published to
+	// the builds_v2 topic.
+	if msg.Message.Attributes["version"] == "v2" {
+		buildFormatCounter.Add(ctx, 1, "v2")
+		return extractBuildAndCreateTimeV2(msg.Message.Data)
+	}
+	buildFormatCounter.Add(ctx, 1, "v1")
+	return extractBuildAndCreateTimeV1(msg.Message.Data)
+}
+
+// extractBuildAndCreateTimeV1 extracts build from a message published to
+// the legacy buildbucket Pub/Sub topic.
+func extractBuildAndCreateTimeV1(data []byte) (*taskspb.Build, time.Time, error) {
 	var message struct {
 		Build    bbv1.LegacyApiCommonBuildMessage
 		Hostname string
 	}
-	switch err := json.Unmarshal(msg.Message.Data, &message); {
+	switch err := json.Unmarshal(data, &message); {
 	case err != nil:
 		return nil, time.Time{}, errors.Annotate(err, "could not parse buildbucket pubsub message data").Err()
 	case message.Build.Bucket != chromiumCIBucket:
@@ -151,6 +184,65 @@ func extractBuildAndCreateTime(r *http.Request) (*taskspb.Build, time.Time, erro
 	return build, createTime, nil
 }
 
+// extractBuildAndCreateTimeV2 extracts build from a message published to
+// the builds_v2 buildbucket Pub/Sub topic.
+func extractBuildAndCreateTimeV2(data []byte) (*taskspb.Build, time.Time, error) {
+	message := &pubsubpb.BuildsV2PubSub{}
+	if err := protojson.Unmarshal(data, message); err != nil {
+		return nil, time.Time{}, errors.Annotate(err, "could not parse buildbucket pubsub message data").Err()
+	}
+	b, err := mergeLargeBuildFields(message)
+	switch {
+	case err != nil:
+		return nil, time.Time{}, err
+	case b.GetBuilder().GetProject() != chromiumProject || b.GetBuilder().GetBucket() != chromiumCIBucketV2:
+		// Received a non-chromium-ci build, ignore it.
+		return nil, time.Time{}, nil
+	case b.GetStatus()&bbpb.Status_ENDED_MASK != bbpb.Status_ENDED_MASK:
+		// Received build that hasn't completed yet, ignore it.
+		return nil, time.Time{}, nil
+	case b.GetCreateTime() == nil:
+		return nil, time.Time{}, errors.New("build did not have created timestamp specified")
+	}
+
+	build := &taskspb.Build{
+		Id:   b.Id,
+		Host: b.GetInfra().GetBuildbucket().GetHostname(),
+	}
+	return build, b.CreateTime.AsTime(), nil
+}
+
+// mergeLargeBuildFields returns the build from the builds_v2 message with
+// large fields (input/output properties and steps) decompressed and merged
+// back into it.
+func mergeLargeBuildFields(message *pubsubpb.BuildsV2PubSub) (*bbpb.Build, error) {
+	build := message.GetBuild()
+	if build == nil {
+		return nil, errors.New("buildbucket pubsub message did not contain a build")
+	}
+	if len(message.BuildLargeFields) == 0 {
+		return build, nil
+	}
+	if message.Compression != pubsubpb.Compression_ZLIB {
+		return nil, errors.Reason("unsupported compression of large build fields: %s", message.Compression).Err()
+	}
+	r, err := zlib.NewReader(bytes.NewReader(message.BuildLargeFields))
+	if err != nil {
+		return nil, errors.Annotate(err, "could not decompress large build fields").Err()
+	}
+	defer r.Close()
+	blob, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, errors.Annotate(err, "could not decompress large build fields").Err()
+	}
+	largeFields := &bbpb.Build{}
+	if err := proto.Unmarshal(blob, largeFields); err != nil {
+		return nil, errors.Annotate(err, "could not parse large build fields").Err()
+	}
+	proto.Merge(build, largeFields)
+	return build, nil
+}
+
 // CVRunPubSubHandler accepts and process VC Pub/Sub messages.
 func CVRunPubSubHandler(ctx *router.Context) {
 	status := "unknown"
@@ -6,6 +6,7 @@ package app
 
 import (
 	"bytes"
+	"compress/zlib"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -15,14 +16,18 @@ import (
 	"testing"
 	"time"
 
+	bbpb "go.chromium.org/luci/buildbucket/proto"
 	bbv1 "go.chromium.org/luci/common/api/buildbucket/buildbucket/v1"
 	"go.chromium.org/luci/common/clock"
 	cvv0 "go.chromium.org/luci/cv/api/v0"
 	cvv1 "go.chromium.org/luci/cv/api/v1"
 	"go.chromium.org/luci/server/tq"
 	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
+	"infra/appengine/weetbix/internal/buildbucket/pubsubpb"
 	"infra/appengine/weetbix/internal/cv"
 	"infra/appengine/weetbix/internal/tasks/taskspb"
 	"infra/appengine/weetbix/internal/testutil"
@@ -35,12 +40,19 @@ import (
 )
 
 func makeReq(blob []byte) io.ReadCloser {
+	return makeReqWithAttributes(blob, nil)
+}
+
+func makeReqWithAttributes(blob []byte, attributes map[string]string) io.ReadCloser {
 	msg := struct {
 		Message struct {
-			Data []byte
+			Data       []byte
+			Attributes map[string]string
 		}
 		Attributes map[string]interface{}
-	}{struct{ Data []byte }{Data: blob}, nil}
+	}{}
+	msg.Message.Data = blob
+	msg.Message.Attributes = attributes
 	jmsg, _ := json.Marshal(msg)
 	return ioutil.NopCloser(bytes.NewReader(jmsg))
 }
@@ -54,6 +66,41 @@ func makeBBReq(build bbv1.LegacyApiCommonBuildMessage) io.ReadCloser {
 	return makeReq(bm)
 }
 
+func makeBBV2Req(build *bbpb.Build, largeFields *bbpb.Build) io.ReadCloser {
+	msg := &pubsubpb.BuildsV2PubSub{
+		Build:       build,
+		Compression: pubsubpb.Compression_ZLIB,
+	}
+	if largeFields != nil {
+		blob, _ := proto.Marshal(largeFields)
+		var buf bytes.Buffer
+		zw := zlib.NewWriter(&buf)
+		zw.Write(blob)
+		zw.Close()
+		msg.BuildLargeFields = buf.Bytes()
+	}
+	bm, _ := protojson.Marshal(msg)
+	return makeReqWithAttributes(bm, map[string]string{"version": "v2"})
+}
+
+func makeBBV2Build(project, bucket string, status bbpb.Status, createTime time.Time) *bbpb.Build {
+	return &bbpb.Build{
+		Id: 87654321,
+		Builder: &bbpb.BuilderID{
+			Project: project,
+			Bucket:  bucket,
+			Builder: "Linux Tests",
+		},
+		Status:     status,
+		CreateTime: timestamppb.New(createTime),
+		Infra: &bbpb.BuildInfra{
+			Buildbucket: &bbpb.BuildInfra_Buildbucket{
+				Hostname: "hostname",
+			},
+		},
+	}
+}
+
 func TestHandleBuild(t *testing.T) {
 	t.Parallel()
 	ctx := testutil.TestingContext()
@@ -86,6 +133,95 @@ func TestHandleBuild(t *testing.T) {
 			So(err, ShouldBeNil)
 		})
 	})
+
+	Convey(`Test extractBuildAndCreateTime`, t, func() {
+		createTime := time.Date(2021, time.December, 1, 10, 0, 0, 0, time.UTC)
+		expected := &taskspb.Build{
+			Id:   87654321,
+			Host: "hostname",
+		}
+
+		Convey(`v1 format`, func() {
+			buildExp := bbv1.LegacyApiCommonBuildMessage{
+				Project:   "chromium",
+				Bucket:    chromiumCIBucket,
+				Id:        87654321,
+				Status:    bbv1.StatusCompleted,
+				CreatedTs: bbv1.FormatTimestamp(createTime),
+			}
+			r := &http.Request{Body: makeBBReq(buildExp)}
+			build, ct, err := extractBuildAndCreateTime(ctx, r)
+			So(err, ShouldBeNil)
+			So(build, ShouldResembleProto, expected)
+			So(ct, ShouldEqual, createTime)
+		})
+
+		Convey(`v2 format`, func() {
+			b := makeBBV2Build("chromium", chromiumCIBucketV2, bbpb.Status_FAILURE, createTime)
+			r := &http.Request{Body: makeBBV2Req(b, nil)}
+			build, ct, err := extractBuildAndCreateTime(ctx, r)
+			So(err, ShouldBeNil)
+			So(build, ShouldResembleProto, expected)
+			So(ct, ShouldEqual, createTime)
+		})
+
+		Convey(`v2 format with large fields`, func() {
+			b := makeBBV2Build("chromium", chromiumCIBucketV2, bbpb.Status_SUCCESS, createTime)
+			props, _ := structpb.NewStruct(map[string]interface{}{"key": "value"})
+			largeFields := &bbpb.Build{
+				Input: &bbpb.Build_Input{
+					Properties: props,
+				},
+				Steps: []*bbpb.Step{{Name: "compile"}},
+			}
+			r := &http.Request{Body: makeBBV2Req(b, largeFields)}
+			build, ct, err := extractBuildAndCreateTime(ctx, r)
+			So(err, ShouldBeNil)
+			So(build, ShouldResembleProto, expected)
+			So(ct, ShouldEqual, createTime)
+		})
+
+		Convey(`v2 large fields are merged into build`, func() {
+			b := makeBBV2Build("chromium", chromiumCIBucketV2, bbpb.Status_SUCCESS, createTime)
+			var buf bytes.Buffer
+			blob, _ := proto.Marshal(&bbpb.Build{Steps: []*bbpb.Step{{Name: "compile"}}})
+			zw := zlib.NewWriter(&buf)
+			zw.Write(blob)
+			zw.Close()
+			merged, err := mergeLargeBuildFields(&pubsubpb.BuildsV2PubSub{
+				Build:            b,
+				BuildLargeFields: buf.Bytes(),
+			})
+			So(err, ShouldBeNil)
+			So(merged.Steps, ShouldHaveLength, 1)
+			So(merged.Steps[0].Name, ShouldEqual, "compile")
+			So(merged.Id, ShouldEqual, 87654321)
+		})
+
+		Convey(`v2 non chromium build is ignored`, func() {
+			b := makeBBV2Build("fake", "ci", bbpb.Status_SUCCESS, createTime)
+			r := &http.Request{Body: makeBBV2Req(b, nil)}
+			build, _, err := extractBuildAndCreateTime(ctx, r)
+			So(err, ShouldBeNil)
+			So(build, ShouldBeNil)
+		})
+
+		Convey(`v2 incomplete build is ignored`, func() {
+			b := makeBBV2Build("chromium", chromiumCIBucketV2, bbpb.Status_STARTED, createTime)
+			r := &http.Request{Body: makeBBV2Req(b, nil)}
+			build, _, err := extractBuildAndCreateTime(ctx, r)
+			So(err, ShouldBeNil)
+			So(build, ShouldBeNil)
+		})
+
+		Convey(`v2 build without create time fails`, func() {
+			b := makeBBV2Build("chromium", chromiumCIBucketV2, bbpb.Status_SUCCESS, createTime)
+			b.CreateTime = nil
+			r := &http.Request{Body: makeBBV2Req(b, nil)}
+			_, _, err := extractBuildAndCreateTime(ctx, r)
+			So(err, ShouldErrLike, "build did not have created timestamp specified")
+		})
+	})
 }
 
 func makeCVRunReq(psRun *cvv1.PubSubRun) io.ReadCloser {
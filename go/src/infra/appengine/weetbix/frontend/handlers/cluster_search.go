@@ -0,0 +1,257 @@
+// Copyright 2021 The Chromium Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/common/logging"
+	"go.chromium.org/luci/server/router"
+
+	"infra/appengine/weetbix/internal/acl"
+	"infra/appengine/weetbix/internal/analysis"
+	"infra/appengine/weetbix/internal/clustering/runs"
+	"infra/appengine/weetbix/internal/config"
+)
+
+const (
+	// maxSearchProjects is the maximum number of projects which can be
+	// searched at once.
+	maxSearchProjects = 20
+	// defaultSearchLimit is the number of clusters returned by a search if
+	// no limit is given.
+	defaultSearchLimit = 100
+	// maxSearchLimit is the maximum number of clusters returned by a search.
+	maxSearchLimit = 1000
+	// defaultSearchTimeout is the deadline shared by the queries of all
+	// projects searched.
+	defaultSearchTimeout = time.Minute
+	// defaultSearchOrder is the metric clusters are ordered by if no order
+	// is given.
+	defaultSearchOrder = "presubmitRejects1d"
+)
+
+// searchOrders are the metrics clusters can be ordered by, keyed by the
+// JSON name of the metric in ClusterSummary. Clusters are ordered by the
+// residual value of the metric, from the highest.
+var searchOrders = map[string]func(*analysis.ClusterSummary) int64{
+	"presubmitRejects1d": func(c *analysis.ClusterSummary) int64 { return c.PresubmitRejects1d.Residual },
+	"presubmitRejects3d": func(c *analysis.ClusterSummary) int64 { return c.PresubmitRejects3d.Residual },
+	"presubmitRejects7d": func(c *analysis.ClusterSummary) int64 { return c.PresubmitRejects7d.Residual },
+	"testRunFailures1d":  func(c *analysis.ClusterSummary) int64 { return c.TestRunFails1d.Residual },
+	"testRunFailures3d":  func(c *analysis.ClusterSummary) int64 { return c.TestRunFails3d.Residual },
+	"testRunFailures7d":  func(c *analysis.ClusterSummary) int64 { return c.TestRunFails7d.Residual },
+	"failures1d":         func(c *analysis.ClusterSummary) int64 { return c.Failures1d.Residual },
+	"failures3d":         func(c *analysis.ClusterSummary) int64 { return c.Failures3d.Residual },
+	"failures7d":         func(c *analysis.ClusterSummary) int64 { return c.Failures7d.Residual },
+}
+
+// projectClusterSummary is a cluster summary of a cross-project search.
+type projectClusterSummary struct {
+	Project string `json:"project"`
+	*analysis.ClusterSummary
+}
+
+// searchWarning describes a project whose clusters could not be read.
+type searchWarning struct {
+	Project string `json:"project"`
+	Message string `json:"message"`
+}
+
+// searchClustersResponse is the response to SearchClusters.
+type searchClustersResponse struct {
+	Clusters []*projectClusterSummary `json:"clusters"`
+	// InaccessibleProjects are the requested projects which do not exist in
+	// Weetbix or which the user may not list the clusters of. They are not
+	// searched.
+	InaccessibleProjects []string `json:"inaccessibleProjects,omitempty"`
+	// Warnings describe the projects whose clusters could not be read. The
+	// clusters of the other projects are still returned.
+	Warnings []*searchWarning `json:"warnings,omitempty"`
+	// Truncated is true if clusters were omitted because of the limit.
+	Truncated bool `json:"truncated"`
+}
+
+// SearchClusters serves a GET request for /api/clusters, which searches the
+// clusters of several projects at once.
+// The projects are given by the repeated project query parameter. Projects
+// which do not exist or which the user may not list the clusters of are
+// not searched, and are listed in the response.
+// The clusters of all projects are merged and ordered by the orderBy query
+// parameter, which names a cluster metric, and at most limit clusters are
+// returned. The startTime, endTime and consistent query parameters have the
+// same meaning as for ListClusters.
+// If the clusters of a project cannot be read, a warning is returned
+// instead of failing the request.
+func (h *Handlers) SearchClusters(ctx *router.Context) {
+	query := ctx.Request.URL.Query()
+	projects := dedupe(query["project"])
+	if len(projects) == 0 {
+		http.Error(ctx.Writer, "Please supply at least one project.", http.StatusBadRequest)
+		return
+	}
+	if len(projects) > maxSearchProjects {
+		http.Error(ctx.Writer, "Please supply at most "+strconv.Itoa(maxSearchProjects)+" projects.", http.StatusBadRequest)
+		return
+	}
+	orderBy := query.Get("orderBy")
+	if orderBy == "" {
+		orderBy = defaultSearchOrder
+	}
+	metric, ok := searchOrders[orderBy]
+	if !ok {
+		http.Error(ctx.Writer, "Please supply a valid orderBy, e.g. "+defaultSearchOrder+".", http.StatusBadRequest)
+		return
+	}
+	limit, ok := obtainSearchLimitOrError(ctx)
+	if !ok {
+		return
+	}
+	tr, ok := obtainTimeRangeOrError(ctx)
+	if !ok {
+		return
+	}
+	consistent, ok := obtainConsistentOrError(ctx)
+	if !ok {
+		return
+	}
+
+	projectCfgs, err := config.Projects(ctx.Context)
+	if err != nil {
+		logging.Errorf(ctx.Context, "Obtain project config: %v", err)
+		http.Error(ctx.Writer, "Internal server error.", http.StatusInternalServerError)
+		return
+	}
+	// Unknown projects are reported like inaccessible ones, so that the
+	// response does not reveal which projects exist.
+	var known, inaccessible []string
+	for _, p := range projects {
+		if _, ok := projectCfgs[p]; ok {
+			known = append(known, p)
+		} else {
+			inaccessible = append(inaccessible, p)
+		}
+	}
+	allowed, denied, err := acl.FilterProjects(ctx.Context, acl.PermListClusters, known)
+	if err != nil {
+		logging.Errorf(ctx.Context, "Checking project permissions: %s", err)
+		http.Error(ctx.Writer, "Internal server error.", http.StatusInternalServerError)
+		return
+	}
+	inaccessible = append(inaccessible, denied...)
+	sort.Strings(inaccessible)
+
+	qs := make([]*clusterQuery, 0, len(allowed))
+	for _, p := range allowed {
+		qs = append(qs, &clusterQuery{
+			project:    p,
+			projectCfg: projectCfgs[p],
+			timeRange:  tr,
+			consistent: consistent,
+		})
+	}
+	resp := h.searchClusters(ctx.Context, qs, metric, limit)
+	resp.InaccessibleProjects = inaccessible
+	respondWithJSON(ctx, resp)
+}
+
+// obtainSearchLimitOrError reads the optional limit query parameter.
+func obtainSearchLimitOrError(ctx *router.Context) (limit int, ok bool) {
+	value := ctx.Request.URL.Query().Get("limit")
+	if value == "" {
+		return defaultSearchLimit, true
+	}
+	limit, err := strconv.Atoi(value)
+	if err != nil || limit <= 0 || limit > maxSearchLimit {
+		http.Error(ctx.Writer, "Please supply a valid limit, between 1 and "+strconv.Itoa(maxSearchLimit)+".", http.StatusBadRequest)
+		return 0, false
+	}
+	return limit, true
+}
+
+// searchClusters runs the cluster queries concurrently, and merges their
+// results ordered by the metric, keeping at most limit clusters.
+func (h *Handlers) searchClusters(ctx context.Context, qs []*clusterQuery, metric func(*analysis.ClusterSummary) int64, limit int) *searchClustersResponse {
+	ctx, cancel := context.WithTimeout(ctx, h.searchTimeout)
+	defer cancel()
+
+	results := make([][]*analysis.ClusterSummary, len(qs))
+	errs := make([]error, len(qs))
+	var wg sync.WaitGroup
+	for i, q := range qs {
+		wg.Add(1)
+		go func(i int, q *clusterQuery) {
+			defer wg.Done()
+			results[i], errs[i] = h.queryProjectClusters(ctx, q)
+		}(i, q)
+	}
+	wg.Wait()
+
+	resp := &searchClustersResponse{Clusters: []*projectClusterSummary{}}
+	for i, q := range qs {
+		if err := errs[i]; err != nil {
+			logging.Warningf(ctx, "Searching clusters of project %s: %s", q.project, err)
+			msg := "Failed to read clusters."
+			if ctx.Err() == context.DeadlineExceeded {
+				msg = "Timed out reading clusters."
+			}
+			resp.Warnings = append(resp.Warnings, &searchWarning{Project: q.project, Message: msg})
+			continue
+		}
+		for _, c := range results[i] {
+			resp.Clusters = append(resp.Clusters, &projectClusterSummary{Project: q.project, ClusterSummary: c})
+		}
+	}
+	sort.SliceStable(resp.Clusters, func(i, j int) bool {
+		a, b := resp.Clusters[i], resp.Clusters[j]
+		if ma, mb := metric(a.ClusterSummary), metric(b.ClusterSummary); ma != mb {
+			return ma > mb
+		}
+		if a.Project != b.Project {
+			return a.Project < b.Project
+		}
+		if a.ClusterID.Algorithm != b.ClusterID.Algorithm {
+			return a.ClusterID.Algorithm < b.ClusterID.Algorithm
+		}
+		return a.ClusterID.ID < b.ClusterID.ID
+	})
+	if len(resp.Clusters) > limit {
+		resp.Clusters = resp.Clusters[:limit]
+		resp.Truncated = true
+	}
+	return resp
+}
+
+// readProjectClusters reads the clusters matching the query, reading the
+// re-clustering progress of the project first if the query is consistent.
+func (h *Handlers) readProjectClusters(ctx context.Context, q *clusterQuery) ([]*analysis.ClusterSummary, error) {
+	if q.consistent && q.progress == nil {
+		progress, err := runs.ReadReclusteringProgressCached(ctx, q.project)
+		if err != nil {
+			return nil, errors.Annotate(err, "reading re-clustering progress").Err()
+		}
+		q.progress = progress
+	}
+	return h.readClusters(ctx, q)
+}
+
+// dedupe returns the distinct non-empty values, in their original order.
+func dedupe(values []string) []string {
+	seen := make(map[string]bool, len(values))
+	result := make([]string, 0, len(values))
+	for _, v := range values {
+		if v != "" && !seen[v] {
+			seen[v] = true
+			result = append(result, v)
+		}
+	}
+	return result
+}
@@ -0,0 +1,196 @@
+// Copyright 2021 The Chromium Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"go.chromium.org/luci/auth/identity"
+	"go.chromium.org/luci/gae/impl/memory"
+	"go.chromium.org/luci/server/auth"
+	"go.chromium.org/luci/server/auth/authtest"
+	"go.chromium.org/luci/server/router"
+
+	"infra/appengine/weetbix/internal/acl"
+	"infra/appengine/weetbix/internal/analysis"
+	"infra/appengine/weetbix/internal/clustering"
+	"infra/appengine/weetbix/internal/config"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestSearchClusters(t *testing.T) {
+	Convey(`SearchClusters`, t, func() {
+		ctx := memory.Use(context.Background())
+		So(config.SetTestProjectConfig(ctx, map[string]*config.ProjectConfig{
+			"chromium": {},
+			"chromeos": {},
+			"fuchsia":  {},
+			"secret":   {},
+		}), ShouldBeNil)
+		user := identity.Identity("user:someone@example.com")
+		ctx = auth.WithState(ctx, &authtest.FakeState{
+			Identity: user,
+			FakeDB: authtest.NewFakeDB(
+				authtest.MockPermission(user, "chromium:@root", acl.PermListClusters),
+				authtest.MockPermission(user, "chromeos:@root", acl.PermListClusters),
+				authtest.MockPermission(user, "fuchsia:@root", acl.PermListClusters),
+			),
+		})
+
+		cluster := func(id string, presubmitRejects, failures int64) *analysis.ClusterSummary {
+			return &analysis.ClusterSummary{
+				ClusterID:          clustering.ClusterID{Algorithm: "rules-v1", ID: id},
+				PresubmitRejects1d: analysis.Counts{Residual: presubmitRejects},
+				Failures1d:         analysis.Counts{Residual: failures},
+			}
+		}
+		// Fake per-project backends.
+		backends := map[string]func(ctx context.Context) ([]*analysis.ClusterSummary, error){
+			"chromium": func(ctx context.Context) ([]*analysis.ClusterSummary, error) {
+				return []*analysis.ClusterSummary{cluster("aa", 10, 1), cluster("bb", 3, 30)}, nil
+			},
+			"chromeos": func(ctx context.Context) ([]*analysis.ClusterSummary, error) {
+				return []*analysis.ClusterSummary{cluster("cc", 7, 7), cluster("aa", 3, 2)}, nil
+			},
+			"fuchsia": func(ctx context.Context) ([]*analysis.ClusterSummary, error) {
+				return []*analysis.ClusterSummary{cluster("dd", 1, 100)}, nil
+			},
+		}
+		var mu sync.Mutex
+		var queried []string
+		h := NewHandlers("cloud-project")
+		h.queryProjectClusters = func(ctx context.Context, q *clusterQuery) ([]*analysis.ClusterSummary, error) {
+			mu.Lock()
+			queried = append(queried, q.project)
+			mu.Unlock()
+			return backends[q.project](ctx)
+		}
+
+		// searchResult is the subset of searchClustersResponse read by the
+		// tests.
+		type searchResult struct {
+			Clusters []struct {
+				Project            string               `json:"project"`
+				ClusterID          clustering.ClusterID `json:"clusterId"`
+				PresubmitRejects1d analysis.Counts      `json:"presubmitRejects1d"`
+			} `json:"clusters"`
+			InaccessibleProjects []string         `json:"inaccessibleProjects"`
+			Warnings             []*searchWarning `json:"warnings"`
+			Truncated            bool             `json:"truncated"`
+		}
+		search := func(url string) (*httptest.ResponseRecorder, *searchResult) {
+			rec := httptest.NewRecorder()
+			h.SearchClusters(&router.Context{
+				Context: ctx,
+				Writer:  rec,
+				Request: httptest.NewRequest(http.MethodGet, url, nil),
+			})
+			if rec.Code != http.StatusOK {
+				return rec, nil
+			}
+			resp := &searchResult{}
+			So(json.Unmarshal(rec.Body.Bytes(), resp), ShouldBeNil)
+			return rec, resp
+		}
+		// summarize returns the project and cluster ID of the clusters in order.
+		summarize := func(resp *searchResult) []string {
+			var result []string
+			for _, c := range resp.Clusters {
+				result = append(result, c.Project+"/"+c.ClusterID.ID)
+			}
+			return result
+		}
+
+		Convey(`Merges clusters of all projects ordered by impact`, func() {
+			_, resp := search("/api/clusters?project=chromium&project=chromeos&project=fuchsia")
+			So(summarize(resp), ShouldResemble, []string{
+				"chromium/aa", "chromeos/cc", "chromeos/aa", "chromium/bb", "fuchsia/dd",
+			})
+			So(resp.Clusters[0].PresubmitRejects1d.Residual, ShouldEqual, 10)
+			So(resp.InaccessibleProjects, ShouldBeEmpty)
+			So(resp.Warnings, ShouldBeEmpty)
+			So(resp.Truncated, ShouldBeFalse)
+		})
+		Convey(`Orders by the requested metric`, func() {
+			_, resp := search("/api/clusters?project=chromium&project=chromeos&project=fuchsia&orderBy=failures1d")
+			So(summarize(resp), ShouldResemble, []string{
+				"fuchsia/dd", "chromium/bb", "chromeos/cc", "chromeos/aa", "chromium/aa",
+			})
+		})
+		Convey(`Applies the limit to the merged clusters`, func() {
+			_, resp := search("/api/clusters?project=chromium&project=chromeos&project=fuchsia&limit=2")
+			So(summarize(resp), ShouldResemble, []string{"chromium/aa", "chromeos/cc"})
+			So(resp.Truncated, ShouldBeTrue)
+		})
+		Convey(`Drops inaccessible and unknown projects`, func() {
+			_, resp := search("/api/clusters?project=secret&project=chromium&project=unknown&project=chromium")
+			So(summarize(resp), ShouldResemble, []string{"chromium/aa", "chromium/bb"})
+			So(resp.InaccessibleProjects, ShouldResemble, []string{"secret", "unknown"})
+			So(queried, ShouldResemble, []string{"chromium"})
+		})
+		Convey(`Returns warnings for failed projects`, func() {
+			backends["chromeos"] = func(ctx context.Context) ([]*analysis.ClusterSummary, error) {
+				return nil, errors.New("bigquery is down")
+			}
+			_, resp := search("/api/clusters?project=chromium&project=chromeos")
+			So(summarize(resp), ShouldResemble, []string{"chromium/aa", "chromium/bb"})
+			So(resp.Warnings, ShouldResemble, []*searchWarning{
+				{Project: "chromeos", Message: "Failed to read clusters."},
+			})
+		})
+		Convey(`Returns warnings for projects exceeding the deadline`, func() {
+			h.searchTimeout = 10 * time.Millisecond
+			backends["fuchsia"] = func(ctx context.Context) ([]*analysis.ClusterSummary, error) {
+				<-ctx.Done()
+				return nil, ctx.Err()
+			}
+			_, resp := search("/api/clusters?project=fuchsia&project=chromium")
+			So(summarize(resp), ShouldResemble, []string{"chromium/aa", "chromium/bb"})
+			So(resp.Warnings, ShouldResemble, []*searchWarning{
+				{Project: "fuchsia", Message: "Timed out reading clusters."},
+			})
+		})
+		Convey(`Queries projects concurrently`, func() {
+			// Each backend waits for all the others to be called.
+			var started sync.WaitGroup
+			started.Add(3)
+			for _, p := range []string{"chromium", "chromeos", "fuchsia"} {
+				f := backends[p]
+				backends[p] = func(ctx context.Context) ([]*analysis.ClusterSummary, error) {
+					started.Done()
+					started.Wait()
+					return f(ctx)
+				}
+			}
+			_, resp := search("/api/clusters?project=chromium&project=chromeos&project=fuchsia")
+			So(resp.Clusters, ShouldHaveLength, 5)
+			sort.Strings(queried)
+			So(queried, ShouldResemble, []string{"chromeos", "chromium", "fuchsia"})
+		})
+		Convey(`Invalid requests`, func() {
+			for _, url := range []string{
+				"/api/clusters",
+				"/api/clusters?project=chromium&orderBy=unknown",
+				"/api/clusters?project=chromium&limit=0",
+				"/api/clusters?project=chromium&limit=1001",
+				"/api/clusters?project=chromium&limit=many",
+				"/api/clusters?project=chromium&consistent=maybe",
+			} {
+				rec, _ := search(url)
+				So(rec.Code, ShouldEqual, http.StatusBadRequest)
+			}
+			So(queried, ShouldBeEmpty)
+		})
+	})
+}
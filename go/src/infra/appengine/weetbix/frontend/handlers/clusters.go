@@ -5,39 +5,125 @@
 package handlers
 
 import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
 	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
 
+	"cloud.google.com/go/bigquery"
+
+	"go.chromium.org/luci/common/errors"
 	"go.chromium.org/luci/common/logging"
 	"go.chromium.org/luci/server/router"
 
 	"infra/appengine/weetbix/internal/analysis"
 	"infra/appengine/weetbix/internal/clustering"
+	"infra/appengine/weetbix/internal/clustering/rules/prepare"
+	"infra/appengine/weetbix/internal/clustering/runs"
+	"infra/appengine/weetbix/internal/config"
+	"infra/appengine/weetbix/internal/config/compiledcfg"
+	"infra/appengine/weetbix/internal/events"
+	pb "infra/appengine/weetbix/proto/v1"
+)
+
+const (
+	// reclusteringHeader is the response header set to "true" if the
+	// cluster metrics served mix the outputs of different algorithms or
+	// rules versions because a re-clustering run is in progress, and to
+	// "false" otherwise.
+	reclusteringHeader = "X-Weetbix-Reclustering"
+	// reclusteringProgressHeader is the response header set to the progress
+	// of the re-clustering run in progress, in thousandths of the chunks
+	// re-clustered.
+	reclusteringProgressHeader = "X-Weetbix-Reclustering-Progress-Per-Mille"
+	// algorithmsVersionsHeader is the response header set to the
+	// comma-separated algorithms versions whose output is in the cluster
+	// metrics served.
+	algorithmsVersionsHeader = "X-Weetbix-Algorithms-Versions"
+)
+
+const (
+	// clusterQueryWorkers is the number of asynchronous cluster queries run
+	// concurrently.
+	clusterQueryWorkers = 4
+	// maxPendingClusterQueries is the maximum number of asynchronous cluster
+	// queries waiting to be run.
+	maxPendingClusterQueries = 20
+	// clusterQueryTimeout is the time after which asynchronous cluster
+	// queries are abandoned.
+	clusterQueryTimeout = 5 * time.Minute
 )
 
 // ListClusters serves a GET request for /api/projects/:project/clusters.
+// If the optional startTime and endTime query parameters are set, the
+// impact of each cluster over that time range is also returned.
+// If the optional consistent query parameter is true, clusters being
+// changed by a re-clustering run are omitted.
+// If the optional kind query parameter is set, only clusters of that kind
+// of failures are returned: "test" for clusters of test failures, and
+// "buildStep" for clusters of build step failures.
+// If the optional async query parameter is true, the clusters are read in
+// the background, and a request token is returned instead. The clusters
+// are then delivered by a clusterQuery event on the
+// clusterQueries/{requestToken} topic of StreamEvents.
 func (h *Handlers) ListClusters(ctx *router.Context) {
-	ac, err := analysis.NewClient(ctx.Context, h.cloudProject)
-	if err != nil {
-		logging.Errorf(ctx.Context, "Creating new analysis client: %v", err)
-		http.Error(ctx.Writer, "Internal server error.", http.StatusInternalServerError)
+	tr, ok := obtainTimeRangeOrError(ctx)
+	if !ok {
+		return
+	}
+	consistent, ok := obtainConsistentOrError(ctx)
+	if !ok {
+		return
+	}
+	kind, ok := obtainFailureKindOrError(ctx)
+	if !ok {
+		return
+	}
+	async, ok := obtainAsyncOrError(ctx)
+	if !ok {
 		return
 	}
-	defer func() {
-		if err := ac.Close(); err != nil {
-			logging.Warningf(ctx.Context, "Closing analysis client: %v", err)
-		}
-	}()
 	projectID, projectCfg, ok := obtainProjectConfigOrError(ctx)
 	if !ok {
 		return
 	}
-	opts := analysis.ImpactfulClusterReadOptions{
-		Project:    projectID,
-		Thresholds: projectCfg.BugFilingThreshold,
+	progress, ok := obtainReclusteringProgressOrError(ctx, projectID)
+	if !ok {
+		return
+	}
+	q := &clusterQuery{
+		project:    projectID,
+		projectCfg: projectCfg,
+		timeRange:  tr,
+		consistent: consistent,
+		kind:       kind,
+		progress:   progress,
 	}
-	clusters, err := ac.ReadImpactfulClusters(ctx.Context, opts)
+	if async {
+		token, err := newRequestToken()
+		if err != nil {
+			logging.Errorf(ctx.Context, "Generating request token: %s", err)
+			http.Error(ctx.Writer, "Internal server error.", http.StatusInternalServerError)
+			return
+		}
+		q.requestToken = token
+		select {
+		case h.clusterQueries <- q:
+		default:
+			http.Error(ctx.Writer, "Too many cluster queries in progress. Please try again later.", http.StatusServiceUnavailable)
+			return
+		}
+		respondWithJSON(ctx, &clusterQueryResponse{RequestToken: token})
+		return
+	}
+	clusters, err := h.readClusters(ctx.Context, q)
 	if err != nil {
-		logging.Errorf(ctx.Context, "Reading Clusters from BigQuery: %s", err)
+		logging.Errorf(ctx.Context, "Reading clusters: %s", err)
 		http.Error(ctx.Writer, "Internal server error.", http.StatusInternalServerError)
 		return
 	}
@@ -46,11 +132,24 @@ func (h *Handlers) ListClusters(ctx *router.Context) {
 
 // GetCluster serves a GET request for
 // api/projects/:project/clusters/:algorithm/:id.
+// If the optional startTime and endTime query parameters are set, the
+// impact of the cluster over that time range is also returned.
+// If the optional consistent query parameter is true and the cluster is
+// being changed by a re-clustering run, the request fails with
+// 503 Service Unavailable.
 func (h *Handlers) GetCluster(ctx *router.Context) {
 	projectID, ok := obtainProjectOrError(ctx)
 	if !ok {
 		return
 	}
+	tr, ok := obtainTimeRangeOrError(ctx)
+	if !ok {
+		return
+	}
+	consistent, ok := obtainConsistentOrError(ctx)
+	if !ok {
+		return
+	}
 	clusterID := clustering.ClusterID{
 		Algorithm: ctx.Params.ByName("algorithm"),
 		ID:        ctx.Params.ByName("id"),
@@ -59,6 +158,14 @@ func (h *Handlers) GetCluster(ctx *router.Context) {
 		http.Error(ctx.Writer, "Please supply a valid cluster ID.", http.StatusBadRequest)
 		return
 	}
+	progress, ok := obtainReclusteringProgressOrError(ctx, projectID)
+	if !ok {
+		return
+	}
+	if consistent && !progress.IsClusterStable(clusterID) {
+		http.Error(ctx.Writer, "The cluster is being re-clustered. Please try again later, or without consistent=true.", http.StatusServiceUnavailable)
+		return
+	}
 	ac, err := analysis.NewClient(ctx.Context, h.cloudProject)
 	if err != nil {
 		logging.Errorf(ctx.Context, "Creating new analysis client: %v", err)
@@ -71,18 +178,271 @@ func (h *Handlers) GetCluster(ctx *router.Context) {
 		}
 	}()
 
-	clusters, err := ac.ReadCluster(ctx.Context, projectID, clusterID)
+	cluster, err := ac.ReadCluster(ctx.Context, projectID, clusterID)
 	if err != nil {
 		logging.Errorf(ctx.Context, "Reading Cluster from BigQuery: %s", err)
 		http.Error(ctx.Writer, "Internal server error.", http.StatusInternalServerError)
 		return
 	}
+	if tr != nil {
+		clusters := []*analysis.ClusterSummary{cluster}
+		if err := ac.PopulateTimeRangeImpact(ctx.Context, projectID, *tr, clusters); err != nil {
+			logging.Errorf(ctx.Context, "Reading Cluster impact over time range from BigQuery: %s", err)
+			http.Error(ctx.Writer, "Internal server error.", http.StatusInternalServerError)
+			return
+		}
+	}
 
-	respondWithJSON(ctx, clusters)
+	respondWithJSON(ctx, cluster)
+}
+
+// obtainTimeRangeOrError reads the time range from the startTime and
+// endTime query parameters, in RFC 3339 format. Returns a nil time range
+// if neither parameter is set.
+func obtainTimeRangeOrError(ctx *router.Context) (tr *analysis.TimeRange, ok bool) {
+	query := ctx.Request.URL.Query()
+	startTime, endTime := query.Get("startTime"), query.Get("endTime")
+	if startTime == "" && endTime == "" {
+		return nil, true
+	}
+	if startTime == "" || endTime == "" {
+		http.Error(ctx.Writer, "Please supply both startTime and endTime, or neither.", http.StatusBadRequest)
+		return nil, false
+	}
+	start, err := time.Parse(time.RFC3339, startTime)
+	if err != nil {
+		http.Error(ctx.Writer, "Please supply a valid startTime, in RFC 3339 format.", http.StatusBadRequest)
+		return nil, false
+	}
+	end, err := time.Parse(time.RFC3339, endTime)
+	if err != nil {
+		http.Error(ctx.Writer, "Please supply a valid endTime, in RFC 3339 format.", http.StatusBadRequest)
+		return nil, false
+	}
+
+	cfg, err := config.Get(ctx.Context)
+	if err != nil {
+		logging.Errorf(ctx.Context, "Obtain config: %v", err)
+		http.Error(ctx.Writer, "Internal server error.", http.StatusInternalServerError)
+		return nil, false
+	}
+	maxDays := cfg.MaxClusterImpactRangeDays
+	if maxDays == 0 {
+		maxDays = analysis.DefaultMaxTimeRangeDays
+	}
+	tr = &analysis.TimeRange{Start: start, End: end}
+	if err := tr.Validate(maxDays); err != nil {
+		http.Error(ctx.Writer, "Please supply a valid time range: "+err.Error()+".", http.StatusBadRequest)
+		return nil, false
+	}
+	return tr, true
+}
+
+// obtainConsistentOrError reads the optional consistent query parameter,
+// which requests only cluster metrics not being changed by a re-clustering
+// run, favouring consistency over freshness.
+func obtainConsistentOrError(ctx *router.Context) (consistent bool, ok bool) {
+	value := ctx.Request.URL.Query().Get("consistent")
+	if value == "" {
+		return false, true
+	}
+	consistent, err := strconv.ParseBool(value)
+	if err != nil {
+		http.Error(ctx.Writer, "Please supply a valid consistent value, either true or false.", http.StatusBadRequest)
+		return false, false
+	}
+	return consistent, true
+}
+
+// obtainFailureKindOrError reads the optional kind query parameter, which
+// restricts the clusters read to those of one kind of failures.
+func obtainFailureKindOrError(ctx *router.Context) (kind pb.FailureKind, ok bool) {
+	switch ctx.Request.URL.Query().Get("kind") {
+	case "":
+		return pb.FailureKind_FAILURE_KIND_UNSPECIFIED, true
+	case "test":
+		return pb.FailureKind_TEST_FAILURE, true
+	case "buildStep":
+		return pb.FailureKind_BUILD_STEP_FAILURE, true
+	default:
+		http.Error(ctx.Writer, "Please supply a valid kind, either test or buildStep.", http.StatusBadRequest)
+		return pb.FailureKind_FAILURE_KIND_UNSPECIFIED, false
+	}
+}
+
+// obtainReclusteringProgressOrError reads the re-clustering progress of
+// the project, and describes in the response headers whether the cluster
+// metrics served mix the outputs of different algorithms or rules
+// versions.
+func obtainReclusteringProgressOrError(ctx *router.Context, projectID string) (*runs.ReclusteringProgress, bool) {
+	progress, err := runs.ReadReclusteringProgressCached(ctx.Context, projectID)
+	if err != nil {
+		logging.Errorf(ctx.Context, "Reading re-clustering progress: %s", err)
+		http.Error(ctx.Writer, "Internal server error.", http.StatusInternalServerError)
+		return nil, false
+	}
+	setReclusteringHeaders(ctx.Writer.Header(), progress)
+	return progress, true
+}
+
+// setReclusteringHeaders sets the response headers describing the given
+// re-clustering progress.
+func setReclusteringHeaders(h http.Header, progress *runs.ReclusteringProgress) {
+	var versions []string
+	for _, v := range progress.AlgorithmsVersionsInEffect() {
+		versions = append(versions, strconv.FormatInt(v, 10))
+	}
+	h.Set(algorithmsVersionsHeader, strings.Join(versions, ","))
+	h.Set(reclusteringHeader, strconv.FormatBool(progress.IsReclustering()))
+	if progress.IsReclustering() {
+		h.Set(reclusteringProgressHeader, strconv.Itoa(progress.ProgressPerMille))
+	}
+}
+
+// filterStableClusters returns the clusters not being changed by the
+// re-clustering run in progress, if any.
+func filterStableClusters(progress *runs.ReclusteringProgress, clusters []*analysis.ClusterSummary) []*analysis.ClusterSummary {
+	result := make([]*analysis.ClusterSummary, 0, len(clusters))
+	for _, c := range clusters {
+		if progress.IsClusterStable(c.ClusterID) {
+			result = append(result, c)
+		}
+	}
+	return result
+}
+
+// clusterQuery is a query for the clusters of a project.
+type clusterQuery struct {
+	// requestToken identifies asynchronous queries.
+	requestToken string
+	project      string
+	projectCfg   *config.ProjectConfig
+	// timeRange is the time range to read the impact of clusters over, if
+	// any.
+	timeRange  *analysis.TimeRange
+	consistent bool
+	// kind is the kind of failures of the clusters to read, or
+	// FAILURE_KIND_UNSPECIFIED to read clusters of all kinds.
+	kind     pb.FailureKind
+	progress *runs.ReclusteringProgress
+}
+
+// clusterQueryResponse is the response to an asynchronous cluster query.
+type clusterQueryResponse struct {
+	RequestToken string `json:"requestToken"`
+}
+
+// clusterQueryEventData is the data of a clusterQuery event.
+type clusterQueryEventData struct {
+	RequestToken string                     `json:"requestToken"`
+	Clusters     []*analysis.ClusterSummary `json:"clusters,omitempty"`
+	// Error is set if the query failed.
+	Error string `json:"error,omitempty"`
+}
+
+// readClusters reads the clusters matching the query from BigQuery.
+func (h *Handlers) readClusters(ctx context.Context, q *clusterQuery) ([]*analysis.ClusterSummary, error) {
+	ac, err := analysis.NewClient(ctx, h.cloudProject)
+	if err != nil {
+		return nil, errors.Annotate(err, "creating new analysis client").Err()
+	}
+	defer func() {
+		if err := ac.Close(); err != nil {
+			logging.Warningf(ctx, "Closing analysis client: %v", err)
+		}
+	}()
+	opts := analysis.ImpactfulClusterReadOptions{
+		Project:             q.project,
+		Thresholds:          q.projectCfg.BugFilingThreshold,
+		BuildStepThresholds: q.projectCfg.BuildFailures.GetBugFilingThreshold(),
+		FailureKind:         q.kind,
+	}
+	clusters, err := ac.ReadImpactfulClusters(ctx, opts)
+	if err != nil {
+		return nil, errors.Annotate(err, "reading clusters from BigQuery").Err()
+	}
+	if q.consistent {
+		clusters = filterStableClusters(q.progress, clusters)
+	}
+	if q.timeRange != nil {
+		if err := ac.PopulateTimeRangeImpact(ctx, q.project, *q.timeRange, clusters); err != nil {
+			return nil, errors.Annotate(err, "reading cluster impact over time range from BigQuery").Err()
+		}
+	}
+	return clusters, nil
+}
+
+// RunClusterQueries runs the asynchronous cluster queries submitted to
+// ListClusters, and publishes their results. It runs until the context is
+// cancelled.
+func (h *Handlers) RunClusterQueries(ctx context.Context) {
+	var wg sync.WaitGroup
+	defer wg.Wait()
+	for i := 0; i < clusterQueryWorkers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case q := <-h.clusterQueries:
+					h.runClusterQuery(ctx, q)
+				}
+			}
+		}()
+	}
+}
+
+// runClusterQuery runs an asynchronous cluster query, and publishes its
+// result.
+func (h *Handlers) runClusterQuery(ctx context.Context, q *clusterQuery) {
+	ctx, cancel := context.WithTimeout(ctx, clusterQueryTimeout)
+	defer cancel()
+	data := &clusterQueryEventData{RequestToken: q.requestToken}
+	clusters, err := h.readClusters(ctx, q)
+	if err != nil {
+		logging.Errorf(ctx, "Reading clusters for request %s: %s", q.requestToken, err)
+		data.Error = "Internal server error."
+	} else {
+		data.Clusters = clusters
+	}
+	h.events.PublishFinal(ctx, events.Event{
+		Topic: clusterQueryTopic(q.requestToken),
+		Type:  clusterQueryEvent,
+		Data:  data,
+	})
+}
+
+// newRequestToken returns a new random request token.
+func newRequestToken() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// obtainAsyncOrError reads the optional async query parameter.
+func obtainAsyncOrError(ctx *router.Context) (async bool, ok bool) {
+	value := ctx.Request.URL.Query().Get("async")
+	if value == "" {
+		return false, true
+	}
+	async, err := strconv.ParseBool(value)
+	if err != nil {
+		http.Error(ctx.Writer, "Please supply a valid async value, either true or false.", http.StatusBadRequest)
+		return false, false
+	}
+	return async, true
 }
 
 // GetClusterFailures handles a GET request for
 // /api/projects/:project/clusters/:algorithm/:id/failures.
+// The optional filter query parameter is an AIP-160 filter, and the
+// optional orderBy query parameter an AIP-132 ordering, over the fields of
+// analysis.ClusterFailuresTable. If the optional format query parameter is
+// csv, the failures are returned as CSV instead of JSON, for export.
 func (h *Handlers) GetClusterFailures(ctx *router.Context) {
 	projectID, ok := obtainProjectOrError(ctx)
 	if !ok {
@@ -96,6 +456,18 @@ func (h *Handlers) GetClusterFailures(ctx *router.Context) {
 		http.Error(ctx.Writer, "Please supply a valid cluster ID.", http.StatusBadRequest)
 		return
 	}
+	filter, ok := obtainFilterOrError(ctx, analysis.ClusterFailuresTable)
+	if !ok {
+		return
+	}
+	order, ok := obtainOrderByOrError(ctx, analysis.ClusterFailuresTable)
+	if !ok {
+		return
+	}
+	csv, ok := obtainCSVFormatOrError(ctx)
+	if !ok {
+		return
+	}
 	ac, err := analysis.NewClient(ctx.Context, h.cloudProject)
 	if err != nil {
 		logging.Errorf(ctx.Context, "Creating new analysis client: %v", err)
@@ -108,12 +480,148 @@ func (h *Handlers) GetClusterFailures(ctx *router.Context) {
 		}
 	}()
 
-	failures, err := ac.ReadClusterFailures(ctx.Context, projectID, clusterID)
+	failures, err := ac.ReadClusterFailures(ctx.Context, analysis.ClusterFailuresReadOptions{
+		Project:   projectID,
+		ClusterID: clusterID,
+		Filter:    filter,
+		OrderBy:   order,
+	})
 	if err != nil {
 		logging.Errorf(ctx.Context, "Reading Cluster from BigQuery: %s", err)
 		http.Error(ctx.Writer, "Internal server error.", http.StatusInternalServerError)
 		return
 	}
 
+	if csv {
+		filename := fmt.Sprintf("%s-%s-%s-failures.csv", projectID, clusterID.Algorithm, clusterID.ID)
+		respondWithCSV(ctx, filename, clusterFailuresCSV(failures))
+		return
+	}
 	respondWithJSON(ctx, failures)
 }
+
+// obtainCSVFormatOrError reads the optional format query parameter, and
+// returns whether the response should be CSV rather than JSON.
+func obtainCSVFormatOrError(ctx *router.Context) (csv bool, ok bool) {
+	switch ctx.Request.URL.Query().Get("format") {
+	case "", "json":
+		return false, true
+	case "csv":
+		return true, true
+	default:
+		http.Error(ctx.Writer, "Please supply a valid format, either json or csv.", http.StatusBadRequest)
+		return false, false
+	}
+}
+
+// clusterFailuresCSVHeader is the header of the CSV export of cluster
+// failures.
+var clusterFailuresCSVHeader = []string{
+	"partition_time",
+	"realm",
+	"ingested_invocation_id",
+	"test_id",
+	"variant",
+	"presubmit_run_id",
+	"is_included",
+	"is_included_with_high_priority",
+	"is_exonerated",
+	"exoneration_reason",
+	"exoneration_explanation",
+}
+
+// clusterFailuresCSV returns the CSV records, including the header, of the
+// given cluster failures. Null values are empty.
+func clusterFailuresCSV(failures []*analysis.ClusterFailure) [][]string {
+	nullString := func(s bigquery.NullString) string {
+		if !s.Valid {
+			return ""
+		}
+		return s.StringVal
+	}
+	nullBool := func(b bigquery.NullBool) string {
+		if !b.Valid {
+			return ""
+		}
+		return strconv.FormatBool(b.Bool)
+	}
+	records := [][]string{clusterFailuresCSVHeader}
+	for _, f := range failures {
+		partitionTime := ""
+		if f.PartitionTime.Valid {
+			partitionTime = f.PartitionTime.Timestamp.UTC().Format(time.RFC3339)
+		}
+		var variant []string
+		for _, v := range f.Variant {
+			variant = append(variant, nullString(v.Key)+":"+nullString(v.Value))
+		}
+		presubmitRunID := ""
+		if f.PresubmitRunID != nil && f.PresubmitRunID.ID.Valid {
+			presubmitRunID = nullString(f.PresubmitRunID.System) + "/" + f.PresubmitRunID.ID.StringVal
+		}
+		records = append(records, []string{
+			partitionTime,
+			nullString(f.Realm),
+			nullString(f.IngestedInvocationID),
+			nullString(f.TestID),
+			strings.Join(variant, ","),
+			presubmitRunID,
+			nullBool(f.IsIncluded),
+			nullBool(f.IsIncludedWithHighPriority),
+			nullBool(f.IsExonerated),
+			nullString(f.ExonerationReason),
+			nullString(f.ExonerationExplanation),
+		})
+	}
+	return records
+}
+
+// PrepareRuleFromCluster handles a GET request for
+// /api/projects/:project/clusters/:algorithm/:id/prepareRule.
+// It returns a failure association rule capturing the suggested cluster,
+// and a preview of the failures it would match, for the user to review
+// before creating the rule.
+func (h *Handlers) PrepareRuleFromCluster(ctx *router.Context) {
+	projectID, ok := obtainProjectOrError(ctx)
+	if !ok {
+		return
+	}
+	clusterID := clustering.ClusterID{
+		Algorithm: ctx.Params.ByName("algorithm"),
+		ID:        ctx.Params.ByName("id"),
+	}
+	if err := clusterID.Validate(); err != nil {
+		http.Error(ctx.Writer, "Please supply a valid cluster ID.", http.StatusBadRequest)
+		return
+	}
+	cfg, err := compiledcfg.Project(ctx.Context, projectID, time.Time{})
+	if err != nil {
+		logging.Errorf(ctx.Context, "Obtaining project config: %s", err)
+		http.Error(ctx.Writer, "Internal server error.", http.StatusInternalServerError)
+		return
+	}
+	ac, err := analysis.NewClient(ctx.Context, h.cloudProject)
+	if err != nil {
+		logging.Errorf(ctx.Context, "Creating new analysis client: %v", err)
+		http.Error(ctx.Writer, "Internal server error.", http.StatusInternalServerError)
+		return
+	}
+	defer func() {
+		if err := ac.Close(); err != nil {
+			logging.Warningf(ctx.Context, "Closing analysis client: %v", err)
+		}
+	}()
+
+	rule, err := prepare.FromCluster(ctx.Context, ac, projectID, cfg, clusterID)
+	if err == prepare.ErrNotSuggestedCluster {
+		http.Error(ctx.Writer, "Please supply a suggested cluster ID.", http.StatusBadRequest)
+		return
+	}
+	if err != nil {
+		logging.Errorf(ctx.Context, "Preparing rule from cluster: %s", err)
+		http.Error(ctx.Writer, "Internal server error.", http.StatusInternalServerError)
+		return
+	}
+
+	respondWithJSON(ctx, rule)
+}
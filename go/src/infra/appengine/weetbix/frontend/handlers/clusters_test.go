@@ -0,0 +1,203 @@
+// Copyright 2021 The Chromium Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package handlers
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"cloud.google.com/go/bigquery"
+
+	"go.chromium.org/luci/server/router"
+
+	"infra/appengine/weetbix/internal/analysis"
+	"infra/appengine/weetbix/internal/clustering"
+	"infra/appengine/weetbix/internal/clustering/runs"
+	pb "infra/appengine/weetbix/proto/v1"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestReclusteringStatus(t *testing.T) {
+	t.Parallel()
+	Convey(`Reclustering status`, t, func() {
+		rulesVersion := time.Date(2021, time.January, 1, 1, 0, 0, 0, time.UTC)
+		configVersion := time.Date(2021, time.February, 1, 1, 0, 0, 0, time.UTC)
+		bugCluster := &analysis.ClusterSummary{
+			ClusterID: clustering.ClusterID{Algorithm: "rules-v1", ID: "00112233445566778899aabbccddeeff"},
+		}
+		suggestedCluster := &analysis.ClusterSummary{
+			ClusterID: clustering.ClusterID{Algorithm: "testname-v1", ID: "00112233445566778899aabbccddeeff"},
+		}
+		clusters := []*analysis.ClusterSummary{bugCluster, suggestedCluster}
+		h := http.Header{}
+
+		Convey(`Not reclustering`, func() {
+			progress := &runs.ReclusteringProgress{
+				ProgressPerMille:        1000,
+				LatestAlgorithmsVersion: 2,
+				Next:                    runs.ReclusteringTarget{RulesVersion: rulesVersion, AlgorithmsVersion: 2},
+				Last:                    runs.ReclusteringTarget{RulesVersion: rulesVersion, AlgorithmsVersion: 2},
+			}
+			setReclusteringHeaders(h, progress)
+			So(h.Get(reclusteringHeader), ShouldEqual, "false")
+			So(h.Get(algorithmsVersionsHeader), ShouldEqual, "2")
+			So(h.Get(reclusteringProgressHeader), ShouldEqual, "")
+			So(filterStableClusters(progress, clusters), ShouldResemble, clusters)
+		})
+		Convey(`Partially reclustered`, func() {
+			Convey(`Algorithms`, func() {
+				progress := &runs.ReclusteringProgress{
+					ProgressPerMille:        250,
+					LatestAlgorithmsVersion: 3,
+					Next:                    runs.ReclusteringTarget{RulesVersion: rulesVersion, AlgorithmsVersion: 3},
+					Last:                    runs.ReclusteringTarget{RulesVersion: rulesVersion, AlgorithmsVersion: 2},
+				}
+				setReclusteringHeaders(h, progress)
+				So(h.Get(reclusteringHeader), ShouldEqual, "true")
+				So(h.Get(algorithmsVersionsHeader), ShouldEqual, "2,3")
+				So(h.Get(reclusteringProgressHeader), ShouldEqual, "250")
+				So(filterStableClusters(progress, clusters), ShouldBeEmpty)
+			})
+			Convey(`Rules`, func() {
+				progress := &runs.ReclusteringProgress{
+					ProgressPerMille:        500,
+					LatestAlgorithmsVersion: 2,
+					Next:                    runs.ReclusteringTarget{RulesVersion: rulesVersion, AlgorithmsVersion: 2},
+					Last:                    runs.ReclusteringTarget{RulesVersion: rulesVersion.Add(-time.Hour), AlgorithmsVersion: 2},
+				}
+				setReclusteringHeaders(h, progress)
+				So(h.Get(reclusteringHeader), ShouldEqual, "true")
+				So(h.Get(algorithmsVersionsHeader), ShouldEqual, "2")
+				So(h.Get(reclusteringProgressHeader), ShouldEqual, "500")
+				So(filterStableClusters(progress, clusters), ShouldResemble, []*analysis.ClusterSummary{suggestedCluster})
+			})
+			Convey(`Config`, func() {
+				progress := &runs.ReclusteringProgress{
+					ProgressPerMille:        500,
+					LatestAlgorithmsVersion: 2,
+					Next:                    runs.ReclusteringTarget{RulesVersion: rulesVersion, AlgorithmsVersion: 2, ConfigVersion: configVersion},
+					Last:                    runs.ReclusteringTarget{RulesVersion: rulesVersion, AlgorithmsVersion: 2, ConfigVersion: configVersion.Add(-time.Hour)},
+				}
+				setReclusteringHeaders(h, progress)
+				So(h.Get(reclusteringHeader), ShouldEqual, "true")
+				So(h.Get(algorithmsVersionsHeader), ShouldEqual, "2")
+				So(h.Get(reclusteringProgressHeader), ShouldEqual, "500")
+				So(filterStableClusters(progress, clusters), ShouldResemble, []*analysis.ClusterSummary{bugCluster})
+			})
+		})
+		Convey(`Reclustering complete`, func() {
+			progress := &runs.ReclusteringProgress{
+				ProgressPerMille:        1000,
+				LatestAlgorithmsVersion: 3,
+				Next:                    runs.ReclusteringTarget{RulesVersion: rulesVersion, AlgorithmsVersion: 3},
+				Last:                    runs.ReclusteringTarget{RulesVersion: rulesVersion, AlgorithmsVersion: 3},
+			}
+			setReclusteringHeaders(h, progress)
+			So(h.Get(reclusteringHeader), ShouldEqual, "false")
+			So(h.Get(algorithmsVersionsHeader), ShouldEqual, "3")
+			So(filterStableClusters(progress, clusters), ShouldResemble, clusters)
+		})
+	})
+}
+
+func TestFailureKind(t *testing.T) {
+	t.Parallel()
+	Convey(`Failure kind`, t, func() {
+		obtain := func(query string) (pb.FailureKind, bool, int) {
+			w := httptest.NewRecorder()
+			ctx := &router.Context{
+				Writer:  w,
+				Request: httptest.NewRequest(http.MethodGet, "/api/projects/chromium/clusters"+query, nil),
+			}
+			kind, ok := obtainFailureKindOrError(ctx)
+			return kind, ok, w.Code
+		}
+		Convey(`Unset`, func() {
+			kind, ok, _ := obtain("")
+			So(ok, ShouldBeTrue)
+			So(kind, ShouldEqual, pb.FailureKind_FAILURE_KIND_UNSPECIFIED)
+		})
+		Convey(`Test failures`, func() {
+			kind, ok, _ := obtain("?kind=test")
+			So(ok, ShouldBeTrue)
+			So(kind, ShouldEqual, pb.FailureKind_TEST_FAILURE)
+		})
+		Convey(`Build step failures`, func() {
+			kind, ok, _ := obtain("?kind=buildStep")
+			So(ok, ShouldBeTrue)
+			So(kind, ShouldEqual, pb.FailureKind_BUILD_STEP_FAILURE)
+		})
+		Convey(`Invalid`, func() {
+			_, ok, code := obtain("?kind=compile")
+			So(ok, ShouldBeFalse)
+			So(code, ShouldEqual, http.StatusBadRequest)
+		})
+	})
+}
+
+func TestClusterFailuresCSV(t *testing.T) {
+	t.Parallel()
+	Convey(`Format`, t, func() {
+		obtain := func(query string) (bool, bool, int) {
+			w := httptest.NewRecorder()
+			ctx := &router.Context{
+				Writer:  w,
+				Request: httptest.NewRequest(http.MethodGet, "/api/projects/chromium/clusters/rules-v2/abcdef/failures"+query, nil),
+			}
+			csv, ok := obtainCSVFormatOrError(ctx)
+			return csv, ok, w.Code
+		}
+		Convey(`Unset`, func() {
+			csv, ok, _ := obtain("")
+			So(ok, ShouldBeTrue)
+			So(csv, ShouldBeFalse)
+		})
+		Convey(`CSV`, func() {
+			csv, ok, _ := obtain("?format=csv")
+			So(ok, ShouldBeTrue)
+			So(csv, ShouldBeTrue)
+		})
+		Convey(`Invalid`, func() {
+			_, ok, code := obtain("?format=xml")
+			So(ok, ShouldBeFalse)
+			So(code, ShouldEqual, http.StatusBadRequest)
+		})
+	})
+	Convey(`Records`, t, func() {
+		str := func(s string) bigquery.NullString {
+			return bigquery.NullString{StringVal: s, Valid: true}
+		}
+		boolean := func(b bool) bigquery.NullBool {
+			return bigquery.NullBool{Bool: b, Valid: true}
+		}
+		failures := []*analysis.ClusterFailure{
+			{
+				Realm:                      str("chromium:try"),
+				IngestedInvocationID:       str("build-1234"),
+				TestID:                     str("ninja://test"),
+				Variant:                    []*analysis.Variant{{Key: str("builder"), Value: str("linux-rel")}, {Key: str("os"), Value: str("Ubuntu")}},
+				PresubmitRunID:             &analysis.PresubmitRunID{System: str("luci-cv"), ID: str("chromium/1234")},
+				PartitionTime:              bigquery.NullTimestamp{Timestamp: time.Date(2022, time.March, 1, 2, 3, 4, 0, time.UTC), Valid: true},
+				IsIncluded:                 boolean(true),
+				IsIncludedWithHighPriority: boolean(false),
+				IsExonerated:               boolean(true),
+				ExonerationReason:          str("OCCURS_ON_MAINLINE"),
+				ExonerationExplanation:     str("Weetbix reported the test is failing on mainline, \"flaky\"."),
+			},
+			{
+				TestID:       str("ninja://other"),
+				IsExonerated: boolean(false),
+			},
+		}
+		So(clusterFailuresCSV(failures), ShouldResemble, [][]string{
+			clusterFailuresCSVHeader,
+			{"2022-03-01T02:03:04Z", "chromium:try", "build-1234", "ninja://test", "builder:linux-rel,os:Ubuntu", "luci-cv/chromium/1234", "true", "false", "true", "OCCURS_ON_MAINLINE", "Weetbix reported the test is failing on mainline, \"flaky\"."},
+			{"", "", "", "ninja://other", "", "", "", "", "false", "", ""},
+		})
+	})
+}
@@ -0,0 +1,261 @@
+// Copyright 2021 The Chromium Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"regexp"
+	"strings"
+	"time"
+
+	"go.chromium.org/luci/common/clock"
+	"go.chromium.org/luci/common/logging"
+	"go.chromium.org/luci/server/router"
+
+	"infra/appengine/weetbix/internal/clustering/runs"
+	"infra/appengine/weetbix/internal/config"
+	"infra/appengine/weetbix/internal/events"
+)
+
+const (
+	// defaultEventsIdleTimeout is the time after which event streams with
+	// no events are closed. Browsers reconnect closed event streams
+	// automatically.
+	defaultEventsIdleTimeout = 5 * time.Minute
+	// eventsRetryMillis is the reconnection delay suggested to clients.
+	eventsRetryMillis = 5000
+	// maxEventTopics is the maximum number of topics a client may subscribe
+	// to in one request.
+	maxEventTopics = 10
+	// reclusteringProgressPollInterval is the interval at which the
+	// re-clustering progress of projects with subscribers is read.
+	reclusteringProgressPollInterval = 5 * time.Second
+
+	// reclusteringProgressEvent is the type of the events describing the
+	// re-clustering progress of a project.
+	reclusteringProgressEvent = "reclusteringProgress"
+	// clusterQueryEvent is the type of the events notifying the completion
+	// of an asynchronous cluster query.
+	clusterQueryEvent = "clusterQuery"
+)
+
+const (
+	reclusteringProgressTopicPrefix = "projects/"
+	reclusteringProgressTopicSuffix = "/reclusteringProgress"
+	clusterQueryTopicPrefix         = "clusterQueries/"
+)
+
+// requestTokenRe matches valid request tokens.
+var requestTokenRe = regexp.MustCompile(`^[0-9a-f]{32}$`)
+
+// reclusteringProgressTopic returns the topic of the re-clustering
+// progress events of a project.
+func reclusteringProgressTopic(project string) string {
+	return reclusteringProgressTopicPrefix + project + reclusteringProgressTopicSuffix
+}
+
+// parseReclusteringProgressTopic returns the project of a re-clustering
+// progress topic.
+func parseReclusteringProgressTopic(topic string) (project string, ok bool) {
+	if !strings.HasPrefix(topic, reclusteringProgressTopicPrefix) || !strings.HasSuffix(topic, reclusteringProgressTopicSuffix) {
+		return "", false
+	}
+	project = strings.TrimSuffix(strings.TrimPrefix(topic, reclusteringProgressTopicPrefix), reclusteringProgressTopicSuffix)
+	return project, project != ""
+}
+
+// clusterQueryTopic returns the topic of the completion event of the
+// asynchronous cluster query with the given request token.
+func clusterQueryTopic(token string) string {
+	return clusterQueryTopicPrefix + token
+}
+
+// reclusteringProgressEventData is the data of a reclusteringProgress
+// event.
+type reclusteringProgressEventData struct {
+	Project  string                     `json:"project"`
+	Progress *runs.ReclusteringProgress `json:"progress"`
+}
+
+// StreamEvents serves a GET request for /api/events.
+//
+// The events published on the topics given by the topic query parameters
+// are streamed as server-sent events, until the client disconnects or no
+// event is published for a while. The supported topics are:
+//   - projects/{project}/reclusteringProgress, for the re-clustering
+//     progress of a project. The current progress is sent on subscription,
+//     and then each time it changes.
+//   - clusterQueries/{requestToken}, for the completion of the
+//     asynchronous cluster query with the given request token. See
+//     ListClusters.
+//
+// Events are only delivered by the instance which publishes them. As the
+// stream may be served by a different instance, clients must fall back to
+// polling if no event is received in time.
+func (h *Handlers) StreamEvents(ctx *router.Context) {
+	topics, ok := obtainTopicsOrError(ctx)
+	if !ok {
+		return
+	}
+	flusher, ok := ctx.Writer.(http.Flusher)
+	if !ok {
+		logging.Errorf(ctx.Context, "Response writer does not support streaming")
+		http.Error(ctx.Writer, "Internal server error.", http.StatusInternalServerError)
+		return
+	}
+	sub := h.events.Subscribe(ctx.Context, topics...)
+	defer sub.Close()
+
+	header := ctx.Writer.Header()
+	header.Set("Content-Type", "text/event-stream")
+	header.Set("Cache-Control", "no-cache")
+	// Disable buffering by proxies.
+	header.Set("X-Accel-Buffering", "no")
+	ctx.Writer.WriteHeader(http.StatusOK)
+	if _, err := fmt.Fprintf(ctx.Writer, "retry: %d\n\n", eventsRetryMillis); err != nil {
+		logging.Warningf(ctx.Context, "Writing event stream: %s", err)
+		return
+	}
+	for _, topic := range topics {
+		project, ok := parseReclusteringProgressTopic(topic)
+		if !ok {
+			continue
+		}
+		progress, err := runs.ReadReclusteringProgress(ctx.Context, project)
+		if err != nil {
+			logging.Errorf(ctx.Context, "Reading re-clustering progress: %s", err)
+			return
+		}
+		if err := writeEvent(ctx.Writer, newReclusteringProgressEvent(project, progress)); err != nil {
+			logging.Warningf(ctx.Context, "Writing event stream: %s", err)
+			return
+		}
+	}
+	flusher.Flush()
+
+	idle := time.NewTimer(h.eventsIdleTimeout)
+	defer idle.Stop()
+	for {
+		select {
+		case <-ctx.Context.Done():
+			// The client disconnected.
+			return
+		case <-sub.Dropped():
+			// The client is not keeping up. It will reconnect.
+			logging.Warningf(ctx.Context, "Event stream dropped: client too slow")
+			return
+		case <-idle.C:
+			return
+		case e := <-sub.Events():
+			if err := writeEvent(ctx.Writer, e); err != nil {
+				logging.Warningf(ctx.Context, "Writing event stream: %s", err)
+				return
+			}
+			flusher.Flush()
+			if !idle.Stop() {
+				<-idle.C
+			}
+			idle.Reset(h.eventsIdleTimeout)
+		}
+	}
+}
+
+// obtainTopicsOrError reads and validates the topic query parameters.
+func obtainTopicsOrError(ctx *router.Context) (topics []string, ok bool) {
+	topics = ctx.Request.URL.Query()["topic"]
+	if len(topics) == 0 {
+		http.Error(ctx.Writer, "Please supply at least one topic.", http.StatusBadRequest)
+		return nil, false
+	}
+	if len(topics) > maxEventTopics {
+		http.Error(ctx.Writer, fmt.Sprintf("Please supply at most %d topics.", maxEventTopics), http.StatusBadRequest)
+		return nil, false
+	}
+	projectCfgs, err := config.Projects(ctx.Context)
+	if err != nil {
+		logging.Errorf(ctx.Context, "Obtain project config: %v", err)
+		http.Error(ctx.Writer, "Internal server error.", http.StatusInternalServerError)
+		return nil, false
+	}
+	for _, topic := range topics {
+		if project, ok := parseReclusteringProgressTopic(topic); ok {
+			if _, ok := projectCfgs[project]; !ok {
+				http.Error(ctx.Writer, fmt.Sprintf("Project of topic %q does not exist in Weetbix.", topic), http.StatusBadRequest)
+				return nil, false
+			}
+			continue
+		}
+		if strings.HasPrefix(topic, clusterQueryTopicPrefix) && requestTokenRe.MatchString(strings.TrimPrefix(topic, clusterQueryTopicPrefix)) {
+			continue
+		}
+		http.Error(ctx.Writer, fmt.Sprintf("Please supply a valid topic, %q is not.", topic), http.StatusBadRequest)
+		return nil, false
+	}
+	return topics, true
+}
+
+// writeEvent writes the event in the server-sent events format.
+func writeEvent(w io.Writer, e events.Event) error {
+	data, err := json.Marshal(e.Data)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(w, "event: %s\ndata: %s\n\n", e.Type, data)
+	return err
+}
+
+func newReclusteringProgressEvent(project string, progress *runs.ReclusteringProgress) events.Event {
+	return events.Event{
+		Topic: reclusteringProgressTopic(project),
+		Type:  reclusteringProgressEvent,
+		Data:  &reclusteringProgressEventData{Project: project, Progress: progress},
+	}
+}
+
+// PublishReclusteringProgress periodically publishes the re-clustering
+// progress of the projects which have subscribers, when it changes.
+// It runs until the context is cancelled.
+func (h *Handlers) PublishReclusteringProgress(ctx context.Context) {
+	last := make(map[string]*runs.ReclusteringProgress)
+	for {
+		if r := <-clock.After(ctx, reclusteringProgressPollInterval); r.Err != nil {
+			return
+		}
+		h.publishReclusteringProgress(ctx, last)
+	}
+}
+
+// publishReclusteringProgress publishes the re-clustering progress of the
+// projects which have subscribers, if it changed since last published.
+func (h *Handlers) publishReclusteringProgress(ctx context.Context, last map[string]*runs.ReclusteringProgress) {
+	subscribed := make(map[string]bool)
+	for _, topic := range h.events.Topics() {
+		project, ok := parseReclusteringProgressTopic(topic)
+		if !ok {
+			continue
+		}
+		subscribed[project] = true
+		progress, err := runs.ReadReclusteringProgress(ctx, project)
+		if err != nil {
+			logging.Warningf(ctx, "Reading re-clustering progress of %s: %s", project, err)
+			continue
+		}
+		if reflect.DeepEqual(last[project], progress) {
+			continue
+		}
+		last[project] = progress
+		h.events.Publish(ctx, newReclusteringProgressEvent(project, progress))
+	}
+	for project := range last {
+		if !subscribed[project] {
+			delete(last, project)
+		}
+	}
+}
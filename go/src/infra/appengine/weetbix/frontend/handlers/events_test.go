@@ -0,0 +1,209 @@
+// Copyright 2021 The Chromium Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"go.chromium.org/luci/gae/impl/memory"
+	"go.chromium.org/luci/server/router"
+
+	"infra/appengine/weetbix/internal/analysis"
+	"infra/appengine/weetbix/internal/config"
+	"infra/appengine/weetbix/internal/events"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+// streamRecorder records a streamed response. Unlike
+// httptest.ResponseRecorder, it can be read while the response is written.
+type streamRecorder struct {
+	header  http.Header
+	flushes chan struct{}
+
+	mu     sync.Mutex
+	status int
+	body   bytes.Buffer
+}
+
+func newStreamRecorder() *streamRecorder {
+	return &streamRecorder{
+		header:  http.Header{},
+		flushes: make(chan struct{}, 100),
+	}
+}
+
+func (r *streamRecorder) Header() http.Header {
+	return r.header
+}
+
+func (r *streamRecorder) WriteHeader(status int) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.status = status
+}
+
+func (r *streamRecorder) Write(b []byte) (int, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.body.Write(b)
+}
+
+func (r *streamRecorder) Flush() {
+	r.flushes <- struct{}{}
+}
+
+func (r *streamRecorder) Body() string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.body.String()
+}
+
+// waitFlush waits for the next flush of the response.
+func (r *streamRecorder) waitFlush() {
+	select {
+	case <-r.flushes:
+	case <-time.After(10 * time.Second):
+		panic("timed out waiting for the response to be flushed")
+	}
+}
+
+func TestStreamEvents(t *testing.T) {
+	Convey(`StreamEvents`, t, func() {
+		ctx := memory.Use(context.Background())
+		So(config.SetTestProjectConfig(ctx, map[string]*config.ProjectConfig{
+			"chromium": {},
+		}), ShouldBeNil)
+
+		h := NewHandlers("cloud-project")
+		const token = "00112233445566778899aabbccddeeff"
+		topic := clusterQueryTopic(token)
+
+		stream := func(ctx context.Context, url string) (*streamRecorder, chan struct{}) {
+			rec := newStreamRecorder()
+			done := make(chan struct{})
+			go func() {
+				defer close(done)
+				h.StreamEvents(&router.Context{
+					Context: ctx,
+					Writer:  rec,
+					Request: httptest.NewRequest(http.MethodGet, url, nil).WithContext(ctx),
+				})
+			}()
+			return rec, done
+		}
+
+		Convey(`Streams events until the client disconnects`, func() {
+			ctx, cancel := context.WithCancel(ctx)
+			defer cancel()
+			rec, done := stream(ctx, "/api/events?topic="+topic)
+			rec.waitFlush()
+			So(rec.status, ShouldEqual, http.StatusOK)
+			So(rec.Header().Get("Content-Type"), ShouldEqual, "text/event-stream")
+
+			h.events.Publish(ctx, events.Event{Topic: topic, Type: "progress", Data: map[string]int{"perMille": 500}})
+			rec.waitFlush()
+			// Events on other topics are not streamed.
+			h.events.Publish(ctx, events.Event{Topic: clusterQueryTopic("ffeeddccbbaa99887766554433221100"), Type: "progress", Data: 1})
+			h.events.PublishFinal(ctx, events.Event{
+				Topic: topic,
+				Type:  clusterQueryEvent,
+				Data: &clusterQueryEventData{
+					RequestToken: token,
+					Clusters:     []*analysis.ClusterSummary{},
+				},
+			})
+			rec.waitFlush()
+
+			// The client disconnects mid-stream.
+			cancel()
+			<-done
+			So(h.events.Topics(), ShouldBeEmpty)
+			h.events.Publish(ctx, events.Event{Topic: topic, Type: "progress", Data: 1})
+
+			So(rec.Body(), ShouldEqual, "retry: 5000\n\n"+
+				"event: progress\ndata: {\"perMille\":500}\n\n"+
+				"event: clusterQuery\ndata: {\"requestToken\":\"00112233445566778899aabbccddeeff\"}\n\n")
+		})
+		Convey(`Delivers final events published before subscription`, func() {
+			ctx, cancel := context.WithCancel(ctx)
+			defer cancel()
+			h.events.PublishFinal(ctx, events.Event{
+				Topic: topic,
+				Type:  clusterQueryEvent,
+				Data:  &clusterQueryEventData{RequestToken: token, Error: "Internal server error."},
+			})
+			rec, done := stream(ctx, "/api/events?topic="+topic)
+			rec.waitFlush()
+			rec.waitFlush()
+			cancel()
+			<-done
+			So(rec.Body(), ShouldEqual, "retry: 5000\n\n"+
+				"event: clusterQuery\ndata: {\"requestToken\":\"00112233445566778899aabbccddeeff\",\"error\":\"Internal server error.\"}\n\n")
+		})
+		Convey(`Closes idle streams`, func() {
+			h.eventsIdleTimeout = time.Millisecond
+			rec, done := stream(ctx, "/api/events?topic="+topic)
+			<-done
+			So(rec.Body(), ShouldEqual, "retry: 5000\n\n")
+			So(h.events.Topics(), ShouldBeEmpty)
+		})
+		Convey(`Drops slow clients`, func() {
+			h.events.BufferSize = 1
+			ctx, cancel := context.WithCancel(ctx)
+			defer cancel()
+			rec, done := stream(ctx, "/api/events?topic="+topic)
+			rec.waitFlush()
+			// Block the client, so that events are not consumed.
+			rec.mu.Lock()
+			for i := 0; i < 3; i++ {
+				h.events.Publish(ctx, events.Event{Topic: topic, Type: "progress", Data: i})
+			}
+			rec.mu.Unlock()
+			<-done
+			So(h.events.Topics(), ShouldBeEmpty)
+		})
+		Convey(`Invalid topics`, func() {
+			get := func(url string) *httptest.ResponseRecorder {
+				rec := httptest.NewRecorder()
+				h.StreamEvents(&router.Context{
+					Context: ctx,
+					Writer:  rec,
+					Request: httptest.NewRequest(http.MethodGet, url, nil),
+				})
+				return rec
+			}
+			So(get("/api/events").Code, ShouldEqual, http.StatusBadRequest)
+			So(get("/api/events?topic=unknown").Code, ShouldEqual, http.StatusBadRequest)
+			So(get("/api/events?topic=clusterQueries/not-a-token").Code, ShouldEqual, http.StatusBadRequest)
+			So(get("/api/events?topic=projects/unknown/reclusteringProgress").Code, ShouldEqual, http.StatusBadRequest)
+			So(h.events.Topics(), ShouldBeEmpty)
+		})
+	})
+}
+
+func TestEventTopics(t *testing.T) {
+	t.Parallel()
+	Convey(`Event topics`, t, func() {
+		project, ok := parseReclusteringProgressTopic(reclusteringProgressTopic("chromium"))
+		So(ok, ShouldBeTrue)
+		So(project, ShouldEqual, "chromium")
+
+		_, ok = parseReclusteringProgressTopic("projects//reclusteringProgress")
+		So(ok, ShouldBeFalse)
+		_, ok = parseReclusteringProgressTopic(clusterQueryTopic("00112233445566778899aabbccddeeff"))
+		So(ok, ShouldBeFalse)
+
+		token, err := newRequestToken()
+		So(err, ShouldBeNil)
+		So(requestTokenRe.MatchString(token), ShouldBeTrue)
+	})
+}
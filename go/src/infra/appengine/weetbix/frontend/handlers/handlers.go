@@ -5,25 +5,71 @@
 package handlers
 
 import (
+	"bytes"
+	"context"
+	"encoding/csv"
 	"encoding/json"
+	"mime"
 	"net/http"
+	"time"
+
+	"golang.org/x/sync/semaphore"
 
 	"go.chromium.org/luci/common/logging"
 	"go.chromium.org/luci/server/router"
 
+	"infra/appengine/weetbix/internal/aip"
+	"infra/appengine/weetbix/internal/analysis"
 	"infra/appengine/weetbix/internal/config"
+	"infra/appengine/weetbix/internal/events"
+	"infra/appengine/weetbix/internal/maintenance"
 )
 
+// configRevisionHeader is the response header set to the LUCI Config
+// revision of the project config used to serve a request.
+const configRevisionHeader = "X-Weetbix-Config-Revision"
+
 // Handlers provides methods servicing Weetbix HTTP routes.
 type Handlers struct {
 	cloudProject string
-	// prod is set when running in production (not a dev workstation).
-	prod bool
+	// events delivers events streamed to clients by StreamEvents.
+	events *events.Broker
+	// clusterQueries holds the asynchronous cluster queries waiting to be
+	// run by RunClusterQueries.
+	clusterQueries chan *clusterQuery
+	// queryProjectClusters reads the clusters of a project searched by
+	// SearchClusters. Replaced in tests to avoid calling BigQuery.
+	queryProjectClusters func(ctx context.Context, q *clusterQuery) ([]*analysis.ClusterSummary, error)
+	// searchTimeout is the deadline shared by the queries of all projects
+	// searched by SearchClusters.
+	searchTimeout time.Duration
+	// eventsIdleTimeout is the time after which event streams with no
+	// events are closed.
+	eventsIdleTimeout time.Duration
+	// summarySections are the sections of the project summaries served by
+	// GetProjectSummary. Replaced in tests to avoid calling the backends.
+	summarySections []summarySection
+	// summaryTimeout is the deadline of each section of a project summary.
+	summaryTimeout time.Duration
+	// summarySem limits the number of sections of project summaries read
+	// concurrently.
+	summarySem *semaphore.Weighted
 }
 
 // NewHandlers initialises a new Handlers instance.
-func NewHandlers(cloudProject string, prod bool) *Handlers {
-	return &Handlers{cloudProject: cloudProject, prod: prod}
+func NewHandlers(cloudProject string) *Handlers {
+	h := &Handlers{
+		cloudProject:      cloudProject,
+		events:            events.NewBroker(),
+		clusterQueries:    make(chan *clusterQuery, maxPendingClusterQueries),
+		eventsIdleTimeout: defaultEventsIdleTimeout,
+		searchTimeout:     defaultSearchTimeout,
+		summaryTimeout:    defaultSummaryTimeout,
+		summarySem:        semaphore.NewWeighted(maxConcurrentSummaryReads),
+	}
+	h.queryProjectClusters = h.readProjectClusters
+	h.summarySections = h.defaultSummarySections()
+	return h
 }
 
 func obtainProjectConfigOrError(ctx *router.Context) (project string, cfg *config.ProjectConfig, ok bool) {
@@ -39,6 +85,15 @@ func obtainProjectConfigOrError(ctx *router.Context) (project string, cfg *confi
 		http.Error(ctx.Writer, "Project does not exist in Weetbix.", http.StatusBadRequest)
 		return "", nil, false
 	}
+	versions, err := config.ProjectVersions(ctx.Context)
+	if err != nil {
+		logging.Errorf(ctx.Context, "Obtain project config versions: %v", err)
+		http.Error(ctx.Writer, "Internal server error.", http.StatusInternalServerError)
+		return "", nil, false
+	}
+	// Identify the config the response is based on, to help debug
+	// differences in behaviour between instances.
+	ctx.Writer.Header().Set(configRevisionHeader, versions[projectID].Revision)
 	return projectID, projectCfg, true
 }
 
@@ -47,6 +102,45 @@ func obtainProjectOrError(ctx *router.Context) (project string, ok bool) {
 	return project, ok
 }
 
+// checkNotInMaintenance checks that the project is not in read-only
+// maintenance mode, responding with the maintenance message if it is.
+func checkNotInMaintenance(ctx *router.Context, project string) (ok bool) {
+	m, err := maintenance.Get(ctx.Context, project)
+	if err != nil {
+		// Do not refuse changes because the maintenance mode cannot be
+		// read.
+		logging.Errorf(ctx.Context, "Reading maintenance mode: %v", err)
+		return true
+	}
+	if m.ReadOnly {
+		http.Error(ctx.Writer, "Weetbix is read-only for maintenance: "+m.Message, http.StatusServiceUnavailable)
+		return false
+	}
+	return true
+}
+
+// obtainFilterOrError reads the optional filter query parameter, an
+// AIP-160 filter over the fields of the table.
+func obtainFilterOrError(ctx *router.Context, t *aip.Table) (*aip.Filter, bool) {
+	f, err := t.ParseFilter(ctx.Request.URL.Query().Get("filter"))
+	if err != nil {
+		http.Error(ctx.Writer, "Please supply a valid filter: "+err.Error()+".", http.StatusBadRequest)
+		return nil, false
+	}
+	return f, true
+}
+
+// obtainOrderByOrError reads the optional orderBy query parameter, an
+// AIP-132 ordering by the fields of the table.
+func obtainOrderByOrError(ctx *router.Context, t *aip.Table) ([]aip.OrderBy, bool) {
+	order, err := t.ParseOrderBy(ctx.Request.URL.Query().Get("orderBy"))
+	if err != nil {
+		http.Error(ctx.Writer, "Please supply a valid orderBy: "+err.Error()+".", http.StatusBadRequest)
+		return nil, false
+	}
+	return order, true
+}
+
 func respondWithJSON(ctx *router.Context, data interface{}) {
 	bytes, err := json.Marshal(data)
 	if err != nil {
@@ -58,3 +152,19 @@ func respondWithJSON(ctx *router.Context, data interface{}) {
 		logging.Errorf(ctx.Context, "Writing JSON response: %s", err)
 	}
 }
+
+// respondWithCSV writes the records as a CSV attachment with the given
+// file name.
+func respondWithCSV(ctx *router.Context, filename string, records [][]string) {
+	var b bytes.Buffer
+	if err := csv.NewWriter(&b).WriteAll(records); err != nil {
+		logging.Errorf(ctx.Context, "Writing CSV for response: %s", err)
+		http.Error(ctx.Writer, "Internal server error.", http.StatusInternalServerError)
+		return
+	}
+	ctx.Writer.Header().Add("Content-Type", "text/csv")
+	ctx.Writer.Header().Add("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": filename}))
+	if _, err := ctx.Writer.Write(b.Bytes()); err != nil {
+		logging.Errorf(ctx.Context, "Writing CSV response: %s", err)
+	}
+}
@@ -25,6 +25,9 @@ import (
 
 // ListRules serves a GET request for
 // /api/projects/:project/rules.
+// The optional filter query parameter is an AIP-160 filter, and the
+// optional orderBy query parameter an AIP-132 ordering, over the fields of
+// rules.ListTable. Rules are ordered by bug after the given order.
 func (h *Handlers) ListRules(ctx *router.Context) {
 	transctx, cancel := span.ReadOnlyTransaction(ctx.Context)
 	defer cancel()
@@ -33,7 +36,15 @@ func (h *Handlers) ListRules(ctx *router.Context) {
 	if !ok {
 		return
 	}
-	rs, err := rules.ReadActive(transctx, projectID)
+	filter, ok := obtainFilterOrError(ctx, rules.ListTable)
+	if !ok {
+		return
+	}
+	order, ok := obtainOrderByOrError(ctx, rules.ListTable)
+	if !ok {
+		return
+	}
+	rs, err := rules.ReadActiveMatching(transctx, projectID, filter, order)
 	if err != nil {
 		logging.Errorf(ctx.Context, "Reading rules: %s", err)
 		http.Error(ctx.Writer, "Internal server error.", http.StatusInternalServerError)
@@ -89,6 +100,9 @@ func (h *Handlers) PatchRule(ctx *router.Context) {
 	if !ok {
 		return
 	}
+	if !checkNotInMaintenance(ctx, projectID) {
+		return
+	}
 
 	ruleID := ctx.Params.ByName("id")
 	if !rules.RuleIDRe.MatchString(ruleID) {
@@ -132,6 +146,13 @@ func (h *Handlers) PatchRule(ctx *router.Context) {
 			case "ruleDefinition":
 				rule.RuleDefinition = u.Rule.RuleDefinition
 			case "bug":
+				if rule.Bug != u.Rule.Bug {
+					// The status of the previous bug no longer applies.
+					rule.BugFixedTime = time.Time{}
+					rule.ImpactFreeSince = time.Time{}
+					rule.IsRecurred = false
+					rules.UpdateBugSync(ctx, rule.Project, rule.RuleID, time.Time{}, time.Time{}, false)
+				}
 				rule.Bug = u.Rule.Bug
 			case "isActive":
 				rule.IsActive = u.Rule.IsActive
@@ -0,0 +1,263 @@
+// Copyright 2021 The Chromium Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	"golang.org/x/sync/semaphore"
+
+	"go.chromium.org/luci/common/clock"
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/common/logging"
+	"go.chromium.org/luci/server/caching"
+	"go.chromium.org/luci/server/router"
+	"go.chromium.org/luci/server/span"
+
+	"infra/appengine/weetbix/internal/analysis"
+	"infra/appengine/weetbix/internal/bugs"
+	"infra/appengine/weetbix/internal/bugs/monorail"
+	"infra/appengine/weetbix/internal/clustering/rules"
+	"infra/appengine/weetbix/internal/clustering/runs"
+	"infra/appengine/weetbix/internal/config"
+	"infra/appengine/weetbix/internal/services/resultingester"
+)
+
+const (
+	// summaryTopClusters is the number of clusters with the most presubmit
+	// impact in a project summary.
+	summaryTopClusters = 5
+	// defaultSummaryTimeout is the deadline of each section of a project
+	// summary. Sections not read by then are omitted from the summary.
+	defaultSummaryTimeout = 20 * time.Second
+	// maxConcurrentSummaryReads is the maximum number of sections of project
+	// summaries read concurrently, across all requests.
+	maxConcurrentSummaryReads = 8
+	// summaryCacheTTL is how long complete project summaries are cached for.
+	summaryCacheTTL = time.Minute
+	// partialSummaryCacheTTL is how long project summaries with missing
+	// sections are cached for, so that failing backends are retried sooner.
+	partialSummaryCacheTTL = 10 * time.Second
+	// noPriority is the priority of open bugs without priority.
+	noPriority = "none"
+)
+
+// summaryCache caches the summary of each LUCI project.
+var summaryCache = caching.RegisterLRUCache(0)
+
+// projectSummary summarizes the current state of a LUCI project in
+// Weetbix.
+type projectSummary struct {
+	Project string `json:"project"`
+	// TopClusters are the clusters with the most presubmit impact over the
+	// last day, from the highest.
+	TopClusters []*analysis.ClusterSummary `json:"topClusters,omitempty"`
+	// OpenBugsByPriority is the number of open bugs associated with active
+	// rules, by bug priority. Bugs without priority are counted as
+	// noPriority.
+	OpenBugsByPriority map[string]int `json:"openBugsByPriority,omitempty"`
+	// LastIngestionTime is the time test results were last ingested into
+	// the project. Unset if test results were never ingested.
+	LastIngestionTime *time.Time `json:"lastIngestionTime,omitempty"`
+	// ReclusteringProgress is the progress of re-clustering the test
+	// results of the project.
+	ReclusteringProgress *runs.ReclusteringProgress `json:"reclusteringProgress,omitempty"`
+	// IsReclustering is whether a re-clustering run is in progress.
+	IsReclustering bool `json:"isReclustering"`
+	// Warnings describe the sections which could not be read, and are
+	// omitted from the summary.
+	Warnings []*summaryWarning `json:"warnings,omitempty"`
+	// ComputedTime is the time the summary was computed. Summaries are
+	// cached for up to summaryCacheTTL.
+	ComputedTime time.Time `json:"computedTime"`
+}
+
+// summaryWarning describes a section of a project summary which could not
+// be read.
+type summaryWarning struct {
+	Section string `json:"section"`
+	Message string `json:"message"`
+}
+
+// summarySection reads a section of a project summary into s. Sections are
+// read concurrently, and each only sets its own fields of s.
+type summarySection struct {
+	name string
+	read func(ctx context.Context, project string, cfg *config.ProjectConfig, s *projectSummary) error
+}
+
+// GetProjectSummary serves a GET request for
+// /api/projects/:project/summary, which summarizes the current state of the
+// project: its clusters with the most presubmit impact, its open bugs by
+// priority, when test results were last ingested and the re-clustering
+// progress.
+// Sections which cannot be read in time are omitted, and described by
+// warnings, instead of failing the request.
+func (h *Handlers) GetProjectSummary(ctx *router.Context) {
+	projectID, projectCfg, ok := obtainProjectConfigOrError(ctx)
+	if !ok {
+		return
+	}
+	summary, err := h.projectSummaryCached(ctx.Context, projectID, projectCfg)
+	if err != nil {
+		logging.Errorf(ctx.Context, "Summarizing project: %s", err)
+		http.Error(ctx.Writer, "Internal server error.", http.StatusInternalServerError)
+		return
+	}
+	respondWithJSON(ctx, summary)
+}
+
+// projectSummaryCached returns the summary of the project from an in-process
+// cache, computing it if it is not cached.
+func (h *Handlers) projectSummaryCached(ctx context.Context, project string, cfg *config.ProjectConfig) (*projectSummary, error) {
+	cache := summaryCache.LRU(ctx)
+	if cache == nil {
+		// A fallback useful in unit tests that may not have the process cache
+		// available.
+		return h.summarizeProject(ctx, project, cfg), nil
+	}
+	value, err := cache.GetOrCreate(ctx, project, func() (interface{}, time.Duration, error) {
+		summary := h.summarizeProject(ctx, project, cfg)
+		if len(summary.Warnings) > 0 {
+			return summary, partialSummaryCacheTTL, nil
+		}
+		return summary, summaryCacheTTL, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return value.(*projectSummary), nil
+}
+
+// summarizeProject reads the sections of the summary of the project
+// concurrently.
+func (h *Handlers) summarizeProject(ctx context.Context, project string, cfg *config.ProjectConfig) *projectSummary {
+	ctx, cancel := context.WithTimeout(ctx, h.summaryTimeout)
+	defer cancel()
+
+	s := &projectSummary{Project: project}
+	errs := make([]error, len(h.summarySections))
+	var wg sync.WaitGroup
+	for i, section := range h.summarySections {
+		wg.Add(1)
+		go func(i int, section summarySection) {
+			defer wg.Done()
+			if err := h.summarySem.Acquire(ctx, 1); err != nil {
+				errs[i] = err
+				return
+			}
+			defer h.summarySem.Release(1)
+			errs[i] = section.read(ctx, project, cfg, s)
+		}(i, section)
+	}
+	wg.Wait()
+
+	for i, section := range h.summarySections {
+		if err := errs[i]; err != nil {
+			logging.Warningf(ctx, "Summarizing %s of project %s: %s", section.name, project, err)
+			msg := "Failed to read " + section.name + "."
+			if ctx.Err() == context.DeadlineExceeded {
+				msg = "Timed out reading " + section.name + "."
+			}
+			s.Warnings = append(s.Warnings, &summaryWarning{Section: section.name, Message: msg})
+		}
+	}
+	s.ComputedTime = clock.Now(ctx)
+	return s
+}
+
+// defaultSummarySections returns the sections of project summaries.
+func (h *Handlers) defaultSummarySections() []summarySection {
+	return []summarySection{
+		{name: "topClusters", read: h.readSummaryTopClusters},
+		{name: "openBugs", read: readSummaryOpenBugs},
+		{name: "ingestion", read: readSummaryIngestion},
+		{name: "reclustering", read: readSummaryReclustering},
+	}
+}
+
+// readSummaryTopClusters reads the clusters with the most presubmit impact.
+func (h *Handlers) readSummaryTopClusters(ctx context.Context, project string, cfg *config.ProjectConfig, s *projectSummary) error {
+	clusters, err := h.queryProjectClusters(ctx, &clusterQuery{project: project, projectCfg: cfg})
+	if err != nil {
+		return err
+	}
+	metric := searchOrders[defaultSearchOrder]
+	sort.SliceStable(clusters, func(i, j int) bool {
+		return metric(clusters[i]) > metric(clusters[j])
+	})
+	if len(clusters) > summaryTopClusters {
+		clusters = clusters[:summaryTopClusters]
+	}
+	s.TopClusters = clusters
+	return nil
+}
+
+// readSummaryOpenBugs counts the open bugs of the active rules by priority.
+func readSummaryOpenBugs(ctx context.Context, project string, cfg *config.ProjectConfig, s *projectSummary) error {
+	rs, err := rules.ReadActive(span.Single(ctx), project)
+	if err != nil {
+		return errors.Annotate(err, "read active rules").Err()
+	}
+	var ids []string
+	seen := make(map[string]bool)
+	for _, r := range rs {
+		if r.Bug.System == bugs.MonorailSystem && !seen[r.Bug.ID] {
+			seen[r.Bug.ID] = true
+			ids = append(ids, r.Bug.ID)
+		}
+	}
+
+	counts := make(map[string]int)
+	if len(ids) > 0 {
+		serviceCfg, err := config.Get(ctx)
+		if err != nil {
+			return errors.Annotate(err, "get config").Err()
+		}
+		mc, err := monorail.NewClient(ctx, serviceCfg.MonorailHostname)
+		if err != nil {
+			return err
+		}
+		priorities, err := monorail.NewBugManager(mc, cfg.Monorail).ReadPriorities(ctx, ids)
+		if err != nil {
+			return errors.Annotate(err, "read bug priorities").Err()
+		}
+		for _, priority := range priorities {
+			if priority == "" {
+				priority = noPriority
+			}
+			counts[priority]++
+		}
+	}
+	s.OpenBugsByPriority = counts
+	return nil
+}
+
+// readSummaryIngestion reads when test results were last ingested.
+func readSummaryIngestion(ctx context.Context, project string, cfg *config.ProjectConfig, s *projectSummary) error {
+	t, err := resultingester.ReadLastIngestionTime(span.Single(ctx), project)
+	if err != nil {
+		return err
+	}
+	if !t.IsZero() {
+		s.LastIngestionTime = &t
+	}
+	return nil
+}
+
+// readSummaryReclustering reads the re-clustering progress.
+func readSummaryReclustering(ctx context.Context, project string, cfg *config.ProjectConfig, s *projectSummary) error {
+	progress, err := runs.ReadReclusteringProgressCached(ctx, project)
+	if err != nil {
+		return errors.Annotate(err, "read re-clustering progress").Err()
+	}
+	s.ReclusteringProgress = progress
+	s.IsReclustering = progress.IsReclustering()
+	return nil
+}
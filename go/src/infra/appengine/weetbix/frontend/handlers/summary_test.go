@@ -0,0 +1,242 @@
+// Copyright 2021 The Chromium Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"go.chromium.org/luci/common/clock/testclock"
+	"go.chromium.org/luci/gae/impl/memory"
+	"go.chromium.org/luci/server/caching"
+	"go.chromium.org/luci/server/router"
+
+	"infra/appengine/weetbix/internal/analysis"
+	"infra/appengine/weetbix/internal/clustering"
+	"infra/appengine/weetbix/internal/clustering/runs"
+	"infra/appengine/weetbix/internal/config"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestGetProjectSummary(t *testing.T) {
+	Convey(`GetProjectSummary`, t, func() {
+		ctx := memory.Use(context.Background())
+		ctx, tc := testclock.UseTime(ctx, testclock.TestRecentTimeUTC)
+		So(config.SetTestProjectConfig(ctx, map[string]*config.ProjectConfig{
+			"chromium": {},
+		}), ShouldBeNil)
+
+		cluster := func(id string, presubmitRejects int64) *analysis.ClusterSummary {
+			return &analysis.ClusterSummary{
+				ClusterID:          clustering.ClusterID{Algorithm: "rules-v1", ID: id},
+				PresubmitRejects1d: analysis.Counts{Residual: presubmitRejects},
+			}
+		}
+		lastIngestion := testclock.TestRecentTimeUTC.Add(-time.Minute)
+		progress := &runs.ReclusteringProgress{
+			ProgressPerMille: 500,
+			Next:             runs.ReclusteringTarget{AlgorithmsVersion: 2},
+			Last:             runs.ReclusteringTarget{AlgorithmsVersion: 1},
+		}
+
+		// Fake backends, each counting its calls.
+		var mu sync.Mutex
+		calls := make(map[string]int)
+		count := func(section string) {
+			mu.Lock()
+			defer mu.Unlock()
+			calls[section]++
+		}
+		backends := map[string]func(ctx context.Context, s *projectSummary) error{
+			"topClusters": func(ctx context.Context, s *projectSummary) error {
+				return nil
+			},
+			"openBugs": func(ctx context.Context, s *projectSummary) error {
+				s.OpenBugsByPriority = map[string]int{"0": 1, "2": 3, noPriority: 1}
+				return nil
+			},
+			"ingestion": func(ctx context.Context, s *projectSummary) error {
+				s.LastIngestionTime = &lastIngestion
+				return nil
+			},
+			"reclustering": func(ctx context.Context, s *projectSummary) error {
+				s.ReclusteringProgress = progress
+				s.IsReclustering = progress.IsReclustering()
+				return nil
+			},
+		}
+		clusters := []*analysis.ClusterSummary{
+			cluster("aa", 1), cluster("bb", 7), cluster("cc", 3), cluster("dd", 9),
+			cluster("ee", 0), cluster("ff", 5),
+		}
+
+		h := NewHandlers("cloud-project")
+		h.queryProjectClusters = func(ctx context.Context, q *clusterQuery) ([]*analysis.ClusterSummary, error) {
+			if err := backends["topClusters"](ctx, nil); err != nil {
+				return nil, err
+			}
+			return append([]*analysis.ClusterSummary{}, clusters...), nil
+		}
+		for i, section := range h.summarySections {
+			section := section
+			h.summarySections[i].read = func(ctx context.Context, project string, cfg *config.ProjectConfig, s *projectSummary) error {
+				count(section.name)
+				if section.name == "topClusters" {
+					return section.read(ctx, project, cfg, s)
+				}
+				return backends[section.name](ctx, s)
+			}
+		}
+
+		// summaryResult is the subset of projectSummary read by the tests.
+		type summaryResult struct {
+			Project     string `json:"project"`
+			TopClusters []struct {
+				ClusterID clustering.ClusterID `json:"clusterId"`
+			} `json:"topClusters"`
+			OpenBugsByPriority   map[string]int             `json:"openBugsByPriority"`
+			LastIngestionTime    *time.Time                 `json:"lastIngestionTime"`
+			ReclusteringProgress *runs.ReclusteringProgress `json:"reclusteringProgress"`
+			IsReclustering       bool                       `json:"isReclustering"`
+			Warnings             []*summaryWarning          `json:"warnings"`
+			ComputedTime         time.Time                  `json:"computedTime"`
+		}
+		get := func(project string) (*httptest.ResponseRecorder, *summaryResult) {
+			rec := httptest.NewRecorder()
+			h.GetProjectSummary(&router.Context{
+				Context: ctx,
+				Writer:  rec,
+				Request: httptest.NewRequest(http.MethodGet, "/api/projects/"+project+"/summary", nil),
+				Params:  router.Params{{Key: "project", Value: project}},
+			})
+			if rec.Code != http.StatusOK {
+				return rec, nil
+			}
+			resp := &summaryResult{}
+			So(json.Unmarshal(rec.Body.Bytes(), resp), ShouldBeNil)
+			return rec, resp
+		}
+		topClusterIDs := func(resp *summaryResult) []string {
+			var result []string
+			for _, c := range resp.TopClusters {
+				result = append(result, c.ClusterID.ID)
+			}
+			return result
+		}
+
+		Convey(`Assembles all sections`, func() {
+			_, resp := get("chromium")
+			So(resp.Project, ShouldEqual, "chromium")
+			So(topClusterIDs(resp), ShouldResemble, []string{"dd", "bb", "ff", "cc", "aa"})
+			So(resp.OpenBugsByPriority, ShouldResemble, map[string]int{"0": 1, "2": 3, "none": 1})
+			So(resp.LastIngestionTime, ShouldNotBeNil)
+			So(resp.LastIngestionTime.Equal(lastIngestion), ShouldBeTrue)
+			So(resp.ReclusteringProgress.ProgressPerMille, ShouldEqual, 500)
+			So(resp.IsReclustering, ShouldBeTrue)
+			So(resp.Warnings, ShouldBeEmpty)
+			So(resp.ComputedTime.Equal(testclock.TestRecentTimeUTC), ShouldBeTrue)
+		})
+		Convey(`Returns a partial summary if sections fail`, func() {
+			backends["openBugs"] = func(ctx context.Context, s *projectSummary) error {
+				return errors.New("monorail is down")
+			}
+			backends["topClusters"] = func(ctx context.Context, s *projectSummary) error {
+				return errors.New("bigquery is down")
+			}
+			_, resp := get("chromium")
+			So(resp.TopClusters, ShouldBeEmpty)
+			So(resp.OpenBugsByPriority, ShouldBeNil)
+			So(resp.LastIngestionTime, ShouldNotBeNil)
+			So(resp.ReclusteringProgress, ShouldNotBeNil)
+			So(resp.Warnings, ShouldResemble, []*summaryWarning{
+				{Section: "topClusters", Message: "Failed to read topClusters."},
+				{Section: "openBugs", Message: "Failed to read openBugs."},
+			})
+		})
+		Convey(`Returns a partial summary if sections exceed the deadline`, func() {
+			h.summaryTimeout = 10 * time.Millisecond
+			backends["ingestion"] = func(ctx context.Context, s *projectSummary) error {
+				<-ctx.Done()
+				return ctx.Err()
+			}
+			_, resp := get("chromium")
+			So(resp.LastIngestionTime, ShouldBeNil)
+			So(topClusterIDs(resp), ShouldHaveLength, 5)
+			So(resp.Warnings, ShouldResemble, []*summaryWarning{
+				{Section: "ingestion", Message: "Timed out reading ingestion."},
+			})
+		})
+		Convey(`Reads sections concurrently`, func() {
+			// Each section waits for all the others to be called.
+			var started sync.WaitGroup
+			started.Add(len(backends))
+			for name, f := range backends {
+				f := f
+				backends[name] = func(ctx context.Context, s *projectSummary) error {
+					started.Done()
+					started.Wait()
+					return f(ctx, s)
+				}
+			}
+			_, resp := get("chromium")
+			So(resp.Warnings, ShouldBeEmpty)
+		})
+		Convey(`Bounds the sections read concurrently`, func() {
+			h.summaryTimeout = 10 * time.Millisecond
+			So(h.summarySem.Acquire(ctx, maxConcurrentSummaryReads), ShouldBeNil)
+			defer h.summarySem.Release(maxConcurrentSummaryReads)
+
+			_, resp := get("chromium")
+			So(resp.Warnings, ShouldHaveLength, len(backends))
+			So(calls, ShouldBeEmpty)
+		})
+		Convey(`Caches summaries`, func() {
+			ctx = caching.WithEmptyProcessCache(ctx)
+
+			_, first := get("chromium")
+			So(calls["ingestion"], ShouldEqual, 1)
+
+			tc.Add(summaryCacheTTL - time.Second)
+			_, second := get("chromium")
+			So(calls["ingestion"], ShouldEqual, 1)
+			So(second.ComputedTime, ShouldResemble, first.ComputedTime)
+
+			tc.Add(2 * time.Second)
+			_, third := get("chromium")
+			So(calls["ingestion"], ShouldEqual, 2)
+			So(third.ComputedTime.After(first.ComputedTime), ShouldBeTrue)
+
+			Convey(`Partial summaries for less time`, func() {
+				backends["openBugs"] = func(ctx context.Context, s *projectSummary) error {
+					return errors.New("monorail is down")
+				}
+				tc.Add(summaryCacheTTL + time.Second)
+				_, resp := get("chromium")
+				So(resp.Warnings, ShouldHaveLength, 1)
+				So(calls["ingestion"], ShouldEqual, 3)
+
+				tc.Add(partialSummaryCacheTTL - time.Second)
+				get("chromium")
+				So(calls["ingestion"], ShouldEqual, 3)
+
+				tc.Add(2 * time.Second)
+				get("chromium")
+				So(calls["ingestion"], ShouldEqual, 4)
+			})
+		})
+		Convey(`Unknown project`, func() {
+			rec, _ := get("unknown")
+			So(rec.Code, ShouldEqual, http.StatusBadRequest)
+			So(calls, ShouldBeEmpty)
+		})
+	})
+}
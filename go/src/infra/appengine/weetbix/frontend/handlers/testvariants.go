@@ -0,0 +1,65 @@
+// Copyright 2021 The Chromium Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"go.chromium.org/luci/common/logging"
+	"go.chromium.org/luci/server/router"
+	"go.chromium.org/luci/server/span"
+
+	"infra/appengine/weetbix/internal/analyzedtestvariants"
+)
+
+const (
+	defaultTestVariantsPageSize = 100
+	maxTestVariantsPageSize     = 1000
+)
+
+// ListFlakyTestVariants serves a GET request for
+// /api/projects/:project/testVariants.
+//
+// Test variants are ordered by flakiness score, as specified by the orderBy
+// query parameter: "flakinessScore desc" (the default) lists the flakiest
+// test variants first, "flakinessScore asc" the least flaky first. At most
+// pageSize test variants are returned.
+func (h *Handlers) ListFlakyTestVariants(ctx *router.Context) {
+	projectID, ok := obtainProjectOrError(ctx)
+	if !ok {
+		return
+	}
+	ascending := false
+	switch ctx.Request.URL.Query().Get("orderBy") {
+	case "", "flakinessScore desc":
+	case "flakinessScore asc":
+		ascending = true
+	default:
+		http.Error(ctx.Writer, "Please supply a valid orderBy: \"flakinessScore desc\" or \"flakinessScore asc\".", http.StatusBadRequest)
+		return
+	}
+	pageSize := defaultTestVariantsPageSize
+	if s := ctx.Request.URL.Query().Get("pageSize"); s != "" {
+		var err error
+		pageSize, err = strconv.Atoi(s)
+		if err != nil || pageSize <= 0 {
+			http.Error(ctx.Writer, "Please supply a valid pageSize.", http.StatusBadRequest)
+			return
+		}
+		if pageSize > maxTestVariantsPageSize {
+			pageSize = maxTestVariantsPageSize
+		}
+	}
+
+	tvs, err := analyzedtestvariants.QueryFlakyTestVariants(span.Single(ctx.Context), projectID, ascending, pageSize)
+	if err != nil {
+		logging.Errorf(ctx.Context, "Reading test variants: %s", err)
+		http.Error(ctx.Writer, "Internal server error.", http.StatusInternalServerError)
+		return
+	}
+
+	respondWithJSON(ctx, tvs)
+}
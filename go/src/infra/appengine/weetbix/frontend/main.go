@@ -30,21 +30,33 @@ import (
 
 	"infra/appengine/weetbix/app"
 	"infra/appengine/weetbix/frontend/handlers"
+	"infra/appengine/weetbix/internal/acl"
 	"infra/appengine/weetbix/internal/admin"
 	adminpb "infra/appengine/weetbix/internal/admin/proto"
+	"infra/appengine/weetbix/internal/analysis/clusteredfailures"
+	"infra/appengine/weetbix/internal/analysis/outbox"
 	"infra/appengine/weetbix/internal/analyzedtestvariants"
 	"infra/appengine/weetbix/internal/clustering/reclustering/orchestrator"
 	"infra/appengine/weetbix/internal/config"
+	"infra/appengine/weetbix/internal/maintenance"
+	"infra/appengine/weetbix/internal/services/backfill"
+	"infra/appengine/weetbix/internal/services/bugupdater"
+	"infra/appengine/weetbix/internal/services/chunkexport"
+	"infra/appengine/weetbix/internal/services/projectpurger"
 	"infra/appengine/weetbix/internal/services/reclustering"
 	"infra/appengine/weetbix/internal/services/resultcollector"
 	"infra/appengine/weetbix/internal/services/resultingester"
+	"infra/appengine/weetbix/internal/services/retention"
+	"infra/appengine/weetbix/internal/services/rulehygiene"
 	"infra/appengine/weetbix/internal/services/testvariantbqexporter"
 	"infra/appengine/weetbix/internal/services/testvariantupdator"
+	"infra/appengine/weetbix/internal/testvariants"
+	pb "infra/appengine/weetbix/proto/v1"
 )
 
 // authGroup is the name of the LUCI Auth group that controls whether the user
 // should have access to Weetbix.
-const authGroup = "weetbix-access"
+const authGroup = acl.AccessGroup
 
 func init() {
 	// TODO (crbug.com/1242998): Remove when this becomes the default (~Jan 2022).
@@ -63,11 +75,18 @@ func prepareTemplates(opts *server.Options) *templates.Bundle {
 				return nil, err
 			}
 
+			// Do not fail pages because the maintenance mode cannot be read.
+			maintenanceMessage, err := maintenance.Banner(ctx)
+			if err != nil {
+				logging.Errorf(ctx, "Reading maintenance mode: %s", err)
+			}
+
 			return templates.Args{
-				"AuthGroup":       authGroup,
-				"AuthServiceHost": opts.AuthServiceHost,
-				"User":            auth.CurrentUser(ctx).Email,
-				"LogoutURL":       logoutURL,
+				"AuthGroup":          authGroup,
+				"AuthServiceHost":    opts.AuthServiceHost,
+				"User":               auth.CurrentUser(ctx).Email,
+				"LogoutURL":          logoutURL,
+				"MaintenanceMessage": maintenanceMessage,
 			}, nil
 		},
 	}
@@ -126,24 +145,37 @@ func main() {
 	server.Main(nil, modules, func(srv *server.Server) error {
 		mw := pageBase(srv)
 
-		handlers := handlers.NewHandlers(srv.Options.CloudProject, srv.Options.Prod)
+		handlers := handlers.NewHandlers(srv.Options.CloudProject)
+		srv.Routes.GET("/api/events", mw, handlers.StreamEvents)
+		srv.Routes.GET("/api/clusters", mw, handlers.SearchClusters)
+		srv.Routes.GET("/api/projects/:project/clusters/:algorithm/:id/prepareRule", mw, handlers.PrepareRuleFromCluster)
 		srv.Routes.GET("/api/projects/:project/clusters/:algorithm/:id/failures", mw, handlers.GetClusterFailures)
 		srv.Routes.GET("/api/projects/:project/clusters/:algorithm/:id", mw, handlers.GetCluster)
 		srv.Routes.GET("/api/projects/:project/clusters", mw, handlers.ListClusters)
 		srv.Routes.GET("/api/projects/:project/reclusteringProgress", mw, handlers.GetReclusteringProgress)
+		srv.Routes.GET("/api/projects/:project/summary", mw, handlers.GetProjectSummary)
 		srv.Routes.GET("/api/projects/:project/rules", mw, handlers.ListRules)
 		srv.Routes.GET("/api/projects/:project/rules/:id", mw, handlers.GetRule)
+		srv.Routes.GET("/api/projects/:project/testVariants", mw, handlers.ListFlakyTestVariants)
 		srv.Routes.PATCH("/api/projects/:project/rules/:id", mw, handlers.PatchRule)
 		srv.Routes.Static("/static/", mw, http.Dir("./ui/dist"))
 		// Anything that is not found, serve app html and let the client side router handle it.
 		srv.Routes.NotFound(mw, handlers.IndexPage)
 
+		srv.RunInBackground("weetbix.cluster-queries", handlers.RunClusterQueries)
+		srv.RunInBackground("weetbix.reclustering-progress-events", handlers.PublishReclusteringProgress)
+
 		// GAE crons.
 		cron.RegisterHandler("read-config", config.Update)
-		cron.RegisterHandler("update-analysis-and-bugs", handlers.UpdateAnalysisAndBugs)
+		cron.RegisterHandler("update-analysis-and-bugs", bugupdater.CronHandler)
 		cron.RegisterHandler("export-test-variants", testvariantbqexporter.ScheduleTasks)
 		cron.RegisterHandler("purge-test-variants", analyzedtestvariants.Purge)
+		cron.RegisterHandler("update-flakiness-scores", analyzedtestvariants.UpdateFlakinessScores)
 		cron.RegisterHandler("reclustering", orchestrator.CronHandler)
+		outboxDispatcher := outbox.NewDispatcher(clusteredfailures.NewClient(srv.Options.CloudProject))
+		cron.RegisterHandler("dispatch-clustered-failures", outboxDispatcher.CronHandler)
+		cron.RegisterHandler("rule-hygiene", rulehygiene.CronHandler(srv.Options.CloudProject))
+		cron.RegisterHandler("enforce-retention", retention.CronHandler(srv.Options.CloudProject))
 
 		// Pub/Sub subscription endpoints.
 		srv.Routes.POST("/_ah/push-handlers/buildbucket", nil, app.BuildbucketPubSubHandler)
@@ -156,12 +188,25 @@ func main() {
 		if err := resultingester.RegisterTaskHandler(srv); err != nil {
 			return errors.Annotate(err, "register result ingester").Err()
 		}
+		bugupdater.RegisterTaskHandler(srv)
+		if err := projectpurger.RegisterTaskHandler(srv); err != nil {
+			return errors.Annotate(err, "register project purger").Err()
+		}
+		backfill.RegisterTaskHandler(srv)
+		if err := chunkexport.RegisterTaskHandler(srv); err != nil {
+			return errors.Annotate(err, "register chunk exporter").Err()
+		}
 		resultcollector.RegisterTaskClass()
 		testvariantbqexporter.RegisterTaskClass()
 		testvariantupdator.RegisterTaskClass()
 
 		// Register pRPC servers.
+		// Service accounts are only allowed to call read-only methods.
+		srv.RegisterUnaryServerInterceptor(acl.UnaryServerInterceptor)
+		// Mutating methods are refused in maintenance mode.
+		srv.RegisterUnaryServerInterceptor(maintenance.UnaryServerInterceptor)
 		adminpb.RegisterAdminServer(srv.PRPC, admin.CreateServer())
+		pb.RegisterTestVariantsServer(srv.PRPC, testvariants.CreateServer())
 
 		return nil
 	})
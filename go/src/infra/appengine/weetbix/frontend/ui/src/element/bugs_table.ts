@@ -31,6 +31,8 @@ export class BugsTable extends LitElement {
                     <th>Rule Definition</th>
                     <th>Rule ID</th>
                     <th>Source Cluster ID</th>
+                    <th>Stale</th>
+                    <th>Recurred</th>
                 </tr>
             </thead>
             <tbody>
@@ -40,6 +42,8 @@ export class BugsTable extends LitElement {
                     <td>${c.ruleDefinition}</td>
                     <td>${c.ruleId}</td>
                     <td>${c.sourceCluster.algorithm}/${c.sourceCluster.id}</td>
+                    <td>${c.isStale ? "Yes" : "No"}</td>
+                    <td>${c.isRecurred ? "Yes" : "No"}</td>
                 </tr>`)}
             </tbody>
         </table>
@@ -54,6 +58,8 @@ interface FailureAssociationRule {
     ruleDefinition: string;
     bug: BugId;
     sourceCluster: ClusterId;
+    isStale: boolean;
+    isRecurred: boolean;
 }
 
 interface BugId {
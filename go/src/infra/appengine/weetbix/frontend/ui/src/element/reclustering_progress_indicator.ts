@@ -209,11 +209,12 @@ export class ReclusteringProgressIndicator extends LitElement {
 export interface RefreshAnalysisEvent {
 }
 
-// ReclusteringTarget captures the rules and algorithms a re-clustering run
-// is re-clustering to.
+// ReclusteringTarget captures the rules, algorithms and config a
+// re-clustering run is re-clustering to.
 interface ReclusteringTarget {
     rulesVersion: string; // RFC 3339 encoded date/time.
     algorithmsVersion: number;
+    configVersion: string; // RFC 3339 encoded date/time.
 }
 
 // ReclusteringProgress captures the progress re-clustering a
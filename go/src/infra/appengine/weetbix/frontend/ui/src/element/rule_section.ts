@@ -73,6 +73,8 @@ export class RuleSection extends LitElement {
         const formatUser = (user : string) : TemplateResult => {
             if (user == 'weetbix') {
                 return html`Weetbix`;
+            } else if (user == 'weetbix-hygiene') {
+                return html`Weetbix rule hygiene`;
             } else if (user.endsWith("@google.com")) {
                 var ldap = user.substr(0, user.length - "@google.com".length)
                 return html`<a href="http://who/${ldap}">${ldap}</a>`;
@@ -96,7 +98,14 @@ export class RuleSection extends LitElement {
                     </tr>
                     <tr>
                         <th>Associated Bug</th>
-                        <td>${r.bug.system}/${r.bug.id}</td>
+                        <td data-cy="rule-bug">
+                            ${r.bug.system}/${r.bug.id}
+                            ${r.bugFixedTime.startsWith("0001-") ?
+                                html`` :
+                                html`<span title="${formatTooltipTime(r.bugFixedTime)}">(fixed ${formatTime(r.bugFixedTime)})</span>`}
+                            ${r.isRecurred ? html`<span class="recurred" data-cy="rule-recurred">(recurred)</span>` : html``}
+                            <mwc-icon class="inline-icon" title="Rules whose bug is fixed are disabled automatically once failures stop. If failures recur after the fix, the rule is flagged as recurred and Weetbix re-opens or comments on the bug.">help_outline</mwc-icon>
+                        </td>
                     </tr>
                     <tr>
                         <th>Enabled</th>
@@ -108,6 +117,16 @@ export class RuleSection extends LitElement {
                             </div>
                         </td>
                     </tr>
+                    <tr>
+                        <th>Last Matched</th>
+                        <td data-cy="rule-last-matched">
+                            ${r.lastMatched.startsWith("0001-") ?
+                                html`Never` :
+                                html`<span title="${formatTooltipTime(r.lastMatched)}">${formatTime(r.lastMatched)}</span>`}
+                            ${r.isStale ? html`<span class="stale">(stale)</span>` : html``}
+                            <mwc-icon class="inline-icon" title="The time of the most recent failure matched by the rule, as last checked by Weetbix. Rules which have not matched failures for a while are flagged as stale and, if their bug is closed, may be disabled automatically.">help_outline</mwc-icon>
+                        </td>
+                    </tr>
                     <tr>
                         <th>Source Cluster</th>
                         <td>
@@ -309,6 +328,12 @@ export class RuleSection extends LitElement {
         mwc-textarea, mwc-textfield {
             margin: 5px 0px;
         }
+        .stale {
+            color: var(--mdc-theme-error, #b00020);
+        }
+        .recurred {
+            color: var(--mdc-theme-error, #b00020);
+        }
         .audit {
             font-size: var(--font-size-small);
             color: var(--greyed-out-text-color);
@@ -350,6 +375,10 @@ interface Rule {
     bug: BugId;
     isActive: boolean;
     sourceCluster: ClusterId;
+    lastMatched: string; // RFC 3339 encoded date/time.
+    isStale: boolean;
+    bugFixedTime: string; // RFC 3339 encoded date/time.
+    isRecurred: boolean;
 }
 
 interface BugId {
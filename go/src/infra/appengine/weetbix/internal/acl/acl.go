@@ -0,0 +1,145 @@
+// Copyright 2021 The Chromium Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+// Package acl implements authorization of callers of the Weetbix pRPC API.
+//
+// Human users must be members of AccessGroup and may call any method.
+// Service accounts must be members of RobotReaderGroup and may only call
+// read-only methods, regardless of their membership in other groups.
+//
+// A method is read-only if its definition sets the idempotency_level option
+// to NO_SIDE_EFFECTS. All other methods are mutating.
+package acl
+
+import (
+	"context"
+	"strings"
+
+	"go.chromium.org/luci/auth/identity"
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/server/auth"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+const (
+	// AccessGroup is the name of the LUCI Auth group that controls whether
+	// the user should have access to Weetbix.
+	AccessGroup = "weetbix-access"
+
+	// RobotReaderGroup is the name of the LUCI Auth group that controls
+	// whether the service account should have read-only access to Weetbix.
+	RobotReaderGroup = "weetbix-robot-readers"
+)
+
+// publicServices are services which can be called by anyone.
+var publicServices = map[string]bool{
+	// Used by RPC Explorer to list services.
+	"discovery.Discovery": true,
+}
+
+// MethodClass classifies pRPC methods by their side effects.
+type MethodClass int
+
+const (
+	// Mutating methods may change the state of Weetbix.
+	Mutating MethodClass = iota
+	// ReadOnly methods do not change the state of Weetbix.
+	ReadOnly
+)
+
+func (c MethodClass) String() string {
+	if c == ReadOnly {
+		return "read-only"
+	}
+	return "mutating"
+}
+
+// ClassifyMethod returns the class of the method identified by its full gRPC
+// name, e.g. "/weetbix.internal.admin.Admin/ExportTestVariants".
+func ClassifyMethod(fullMethod string) MethodClass {
+	return classifyMethod(protoregistry.GlobalFiles, fullMethod)
+}
+
+func classifyMethod(files *protoregistry.Files, fullMethod string) MethodClass {
+	name := protoreflect.FullName(strings.Replace(strings.TrimPrefix(fullMethod, "/"), "/", ".", 1))
+	d, err := files.FindDescriptorByName(name)
+	if err != nil {
+		// Unknown methods are conservatively treated as mutating.
+		return Mutating
+	}
+	md, ok := d.(protoreflect.MethodDescriptor)
+	if !ok {
+		return Mutating
+	}
+	opts, ok := md.Options().(*descriptorpb.MethodOptions)
+	if ok && opts.GetIdempotencyLevel() == descriptorpb.MethodOptions_NO_SIDE_EFFECTS {
+		return ReadOnly
+	}
+	return Mutating
+}
+
+// UnaryServerInterceptor is a grpc.UnaryServerInterceptor that checks the
+// caller is allowed to call the method.
+func UnaryServerInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if !publicServices[serviceName(info.FullMethod)] {
+		if err := CheckAccess(ctx, info.FullMethod, ClassifyMethod(info.FullMethod)); err != nil {
+			return nil, err
+		}
+	}
+	return handler(ctx, req)
+}
+
+// CheckAccess checks whether the current caller is allowed to call the method
+// of the given class.
+//
+// Returns a PermissionDenied gRPC error naming the required group if the
+// caller is not allowed.
+func CheckAccess(ctx context.Context, method string, class MethodClass) error {
+	id := auth.CurrentIdentity(ctx)
+	if !IsServiceAccount(id) {
+		return checkMember(ctx, id, AccessGroup)
+	}
+	if class != ReadOnly {
+		return status.Errorf(codes.PermissionDenied, "service account %s may only call read-only methods, %s is %s", id, method, class)
+	}
+	return checkMember(ctx, id, RobotReaderGroup)
+}
+
+// IsServiceAccount tells whether the identity is a service account (robot)
+// rather than a human user.
+func IsServiceAccount(id identity.Identity) bool {
+	switch id.Kind() {
+	case identity.Bot, identity.Service:
+		return true
+	case identity.User:
+		return strings.HasSuffix(id.Email(), ".gserviceaccount.com")
+	default:
+		return false
+	}
+}
+
+func checkMember(ctx context.Context, id identity.Identity, group string) error {
+	switch yes, err := auth.IsMember(ctx, group); {
+	case err != nil:
+		return errors.Annotate(err, "failed to check ACL").Err()
+	case !yes:
+		return status.Errorf(codes.PermissionDenied, "%s is not a member of %s", id, group)
+	default:
+		return nil
+	}
+}
+
+// serviceName returns the service name of the full gRPC method name.
+func serviceName(fullMethod string) string {
+	s := strings.TrimPrefix(fullMethod, "/")
+	if i := strings.Index(s, "/"); i >= 0 {
+		return s[:i]
+	}
+	return s
+}
@@ -0,0 +1,221 @@
+// Copyright 2021 The Chromium Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package acl
+
+import (
+	"context"
+	"testing"
+
+	"go.chromium.org/luci/auth/identity"
+	"go.chromium.org/luci/server/auth"
+	"go.chromium.org/luci/server/auth/authtest"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+
+	. "github.com/smartystreets/goconvey/convey"
+	. "go.chromium.org/luci/common/testing/assertions"
+
+	// Register the admin service descriptors.
+	_ "infra/appengine/weetbix/internal/admin/proto"
+)
+
+const (
+	human = identity.Identity("user:someone@example.com")
+	robot = identity.Identity("user:dashboard@project.iam.gserviceaccount.com")
+)
+
+func TestCheckAccess(t *testing.T) {
+	t.Parallel()
+
+	Convey(`CheckAccess`, t, func() {
+		ctx := context.Background()
+		withCaller := func(id identity.Identity, groups ...string) context.Context {
+			return auth.WithState(ctx, &authtest.FakeState{
+				Identity:       id,
+				IdentityGroups: groups,
+			})
+		}
+		const method = "/weetbix.v1.Test/Method"
+
+		Convey(`Human user`, func() {
+			Convey(`in access group can call any method`, func() {
+				ctx := withCaller(human, AccessGroup)
+				So(CheckAccess(ctx, method, ReadOnly), ShouldBeNil)
+				So(CheckAccess(ctx, method, Mutating), ShouldBeNil)
+			})
+			Convey(`not in access group is denied`, func() {
+				ctx := withCaller(human, RobotReaderGroup)
+				for _, class := range []MethodClass{ReadOnly, Mutating} {
+					err := CheckAccess(ctx, method, class)
+					So(err, ShouldHaveGRPCStatus, codes.PermissionDenied)
+					So(err, ShouldErrLike, "not a member of weetbix-access")
+				}
+			})
+		})
+		Convey(`Anonymous is denied`, func() {
+			ctx := withCaller(identity.AnonymousIdentity)
+			for _, class := range []MethodClass{ReadOnly, Mutating} {
+				err := CheckAccess(ctx, method, class)
+				So(err, ShouldHaveGRPCStatus, codes.PermissionDenied)
+				So(err, ShouldErrLike, "not a member of weetbix-access")
+			}
+		})
+		Convey(`Service account`, func() {
+			Convey(`in robot group can call read-only methods`, func() {
+				ctx := withCaller(robot, RobotReaderGroup)
+				So(CheckAccess(ctx, method, ReadOnly), ShouldBeNil)
+			})
+			Convey(`in robot group cannot call mutating methods`, func() {
+				ctx := withCaller(robot, RobotReaderGroup)
+				err := CheckAccess(ctx, method, Mutating)
+				So(err, ShouldHaveGRPCStatus, codes.PermissionDenied)
+				So(err, ShouldErrLike, "may only call read-only methods")
+			})
+			Convey(`in access group cannot call mutating methods`, func() {
+				ctx := withCaller(robot, AccessGroup, RobotReaderGroup)
+				err := CheckAccess(ctx, method, Mutating)
+				So(err, ShouldHaveGRPCStatus, codes.PermissionDenied)
+				So(err, ShouldErrLike, "may only call read-only methods")
+			})
+			Convey(`in access group only is denied`, func() {
+				ctx := withCaller(robot, AccessGroup)
+				err := CheckAccess(ctx, method, ReadOnly)
+				So(err, ShouldHaveGRPCStatus, codes.PermissionDenied)
+				So(err, ShouldErrLike, "not a member of weetbix-robot-readers")
+			})
+			Convey(`in no group is denied`, func() {
+				ctx := withCaller(robot)
+				err := CheckAccess(ctx, method, ReadOnly)
+				So(err, ShouldHaveGRPCStatus, codes.PermissionDenied)
+				So(err, ShouldErrLike, "not a member of weetbix-robot-readers")
+			})
+		})
+	})
+}
+
+func TestFilterProjects(t *testing.T) {
+	t.Parallel()
+
+	Convey(`FilterProjects`, t, func() {
+		ctx := auth.WithState(context.Background(), &authtest.FakeState{
+			Identity: human,
+			FakeDB: authtest.NewFakeDB(
+				authtest.MockPermission(human, "chromium:@root", PermListClusters),
+				authtest.MockPermission(human, "fuchsia:@root", PermListClusters),
+			),
+		})
+		allowed, denied, err := FilterProjects(ctx, PermListClusters, []string{"fuchsia", "secret", "chromium", "other"})
+		So(err, ShouldBeNil)
+		So(allowed, ShouldResemble, []string{"fuchsia", "chromium"})
+		So(denied, ShouldResemble, []string{"secret", "other"})
+	})
+}
+
+func TestIsServiceAccount(t *testing.T) {
+	t.Parallel()
+
+	Convey(`IsServiceAccount`, t, func() {
+		So(IsServiceAccount(human), ShouldBeFalse)
+		So(IsServiceAccount(identity.AnonymousIdentity), ShouldBeFalse)
+		So(IsServiceAccount(robot), ShouldBeTrue)
+		So(IsServiceAccount(identity.Identity("user:app@appspot.gserviceaccount.com")), ShouldBeTrue)
+		So(IsServiceAccount(identity.Identity("bot:whitelisted-ip")), ShouldBeTrue)
+		So(IsServiceAccount(identity.Identity("service:app-id")), ShouldBeTrue)
+	})
+}
+
+func TestClassifyMethod(t *testing.T) {
+	t.Parallel()
+
+	Convey(`ClassifyMethod`, t, func() {
+		Convey(`admin methods are mutating`, func() {
+			So(ClassifyMethod("/weetbix.internal.admin.Admin/ExportTestVariants"), ShouldEqual, Mutating)
+		})
+		Convey(`unknown methods are mutating`, func() {
+			So(ClassifyMethod("/weetbix.v1.Unknown/Method"), ShouldEqual, Mutating)
+			So(ClassifyMethod("malformed"), ShouldEqual, Mutating)
+		})
+		Convey(`annotated methods are read-only`, func() {
+			files := testFiles()
+			So(classifyMethod(files, "/weetbix.test.Test/Get"), ShouldEqual, ReadOnly)
+			So(classifyMethod(files, "/weetbix.test.Test/Update"), ShouldEqual, Mutating)
+		})
+	})
+}
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	t.Parallel()
+
+	Convey(`UnaryServerInterceptor`, t, func() {
+		called := false
+		handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+			called = true
+			return nil, nil
+		}
+		ctx := auth.WithState(context.Background(), &authtest.FakeState{
+			Identity:       robot,
+			IdentityGroups: []string{RobotReaderGroup},
+		})
+
+		Convey(`denies robot calling mutating method`, func() {
+			info := &grpc.UnaryServerInfo{FullMethod: "/weetbix.internal.admin.Admin/ExportTestVariants"}
+			_, err := UnaryServerInterceptor(ctx, nil, info, handler)
+			So(err, ShouldHaveGRPCStatus, codes.PermissionDenied)
+			So(called, ShouldBeFalse)
+		})
+		Convey(`allows discovery`, func() {
+			info := &grpc.UnaryServerInfo{FullMethod: "/discovery.Discovery/Describe"}
+			_, err := UnaryServerInterceptor(ctx, nil, info, handler)
+			So(err, ShouldBeNil)
+			So(called, ShouldBeTrue)
+		})
+	})
+}
+
+// testFiles returns a registry with a service having one read-only and one
+// mutating method.
+func testFiles() *protoregistry.Files {
+	fdp := &descriptorpb.FileDescriptorProto{
+		Name:    proto.String("test.proto"),
+		Package: proto.String("weetbix.test"),
+		Syntax:  proto.String("proto3"),
+		MessageType: []*descriptorpb.DescriptorProto{
+			{Name: proto.String("Msg")},
+		},
+		Service: []*descriptorpb.ServiceDescriptorProto{
+			{
+				Name: proto.String("Test"),
+				Method: []*descriptorpb.MethodDescriptorProto{
+					{
+						Name:       proto.String("Get"),
+						InputType:  proto.String(".weetbix.test.Msg"),
+						OutputType: proto.String(".weetbix.test.Msg"),
+						Options: &descriptorpb.MethodOptions{
+							IdempotencyLevel: descriptorpb.MethodOptions_NO_SIDE_EFFECTS.Enum(),
+						},
+					},
+					{
+						Name:       proto.String("Update"),
+						InputType:  proto.String(".weetbix.test.Msg"),
+						OutputType: proto.String(".weetbix.test.Msg"),
+					},
+				},
+			},
+		},
+	}
+	fd, err := protodesc.NewFile(fdp, nil)
+	if err != nil {
+		panic(err)
+	}
+	files := &protoregistry.Files{}
+	if err := files.RegisterFile(fd); err != nil {
+		panic(err)
+	}
+	return files
+}
@@ -0,0 +1,39 @@
+// Copyright 2021 The Chromium Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package acl
+
+import (
+	"context"
+
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/server/auth"
+	"go.chromium.org/luci/server/auth/realms"
+)
+
+// PermListClusters allows listing the clusters of a LUCI project.
+// It is checked in the root realm of the project.
+var PermListClusters = realms.RegisterPermission("weetbix.clusters.list")
+
+// ProjectRealm returns the root realm of the LUCI project, in which
+// project-wide permissions are checked.
+func ProjectRealm(project string) string {
+	return realms.Join(project, realms.RootRealm)
+}
+
+// FilterProjects splits the projects into those in which the caller has
+// the permission and those in which they do not, preserving their order.
+func FilterProjects(ctx context.Context, perm realms.Permission, projects []string) (allowed, denied []string, err error) {
+	for _, project := range projects {
+		switch ok, err := auth.HasPermission(ctx, perm, ProjectRealm(project)); {
+		case err != nil:
+			return nil, nil, errors.Annotate(err, "checking %s in project %s", perm, project).Err()
+		case ok:
+			allowed = append(allowed, project)
+		default:
+			denied = append(denied, project)
+		}
+	}
+	return allowed, denied, nil
+}
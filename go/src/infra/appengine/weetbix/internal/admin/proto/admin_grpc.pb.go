@@ -28,6 +28,52 @@ type AdminClient interface {
 	// result ingestion started. If such request arises, we need to add another
 	// Admin API for it.
 	ExportTestVariants(ctx context.Context, in *ExportTestVariantsRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// ListProjectUpdateStatuses lists the status of the most recent updates of
+	// cluster analysis and bugs for each LUCI project. Used to alert on
+	// projects falling behind.
+	ListProjectUpdateStatuses(ctx context.Context, in *ListProjectUpdateStatusesRequest, opts ...grpc.CallOption) (*ListProjectUpdateStatusesResponse, error)
+	// ListProjectConfigVersions lists the versions of the project configs
+	// served by the instance handling the request.
+	ListProjectConfigVersions(ctx context.Context, in *ListProjectConfigVersionsRequest, opts ...grpc.CallOption) (*ListProjectConfigVersionsResponse, error)
+	// PurgeProject deletes the data of a LUCI project offboarded from
+	// Weetbix. The project must no longer have a project config.
+	//
+	// Purging takes two calls. Called without a confirm_token, PurgeProject
+	// is a dry run: it reports the rows to be deleted and issues a token.
+	// Called again with the token within an hour, it starts the purge, which
+	// continues in the background. Calling it again with the same token
+	// reports the progress of the purge.
+	PurgeProject(ctx context.Context, in *PurgeProjectRequest, opts ...grpc.CallOption) (*PurgeProjectResponse, error)
+	// SetMaintenanceMode puts all of Weetbix, or a LUCI project, in read-only
+	// maintenance mode, or takes it out of maintenance mode, for a limited
+	// time. Until it expires, the setting takes precedence over the
+	// maintenance mode in the service config.
+	//
+	// SetMaintenanceMode may be called in maintenance mode.
+	SetMaintenanceMode(ctx context.Context, in *SetMaintenanceModeRequest, opts ...grpc.CallOption) (*MaintenanceMode, error)
+	// BackfillProject re-ingests the test results of the completed builds of
+	// a LUCI project created in a time range. Builds already ingested are
+	// skipped unless force is set.
+	//
+	// The backfill continues in the background, enqueuing ingestion tasks at
+	// the requested rate. At most two backfills of a project may be in
+	// progress at once. Called with dry_run, BackfillProject only counts the
+	// builds to be ingested.
+	BackfillProject(ctx context.Context, in *BackfillProjectRequest, opts ...grpc.CallOption) (*BackfillStatus, error)
+	// GetBackfillStatus reports the progress of a backfill.
+	GetBackfillStatus(ctx context.Context, in *GetBackfillStatusRequest, opts ...grpc.CallOption) (*BackfillStatus, error)
+	// ExportChunks exports a sample of the clustering chunks of a LUCI project
+	// with partition times in a time range to the chunk export GCS bucket of
+	// the service config, for offline experimentation with clustering
+	// algorithms. The project must opt in to exports in its project config.
+	//
+	// Failures are redacted before export. The export continues in the
+	// background, writing a JSONL object per exported chunk, then a manifest
+	// of the objects with their checksums, and finally a completion marker.
+	// At most one export of a project may be in progress at once.
+	ExportChunks(ctx context.Context, in *ExportChunksRequest, opts ...grpc.CallOption) (*ChunkExportStatus, error)
+	// GetChunkExportStatus reports the progress of a chunk export.
+	GetChunkExportStatus(ctx context.Context, in *GetChunkExportStatusRequest, opts ...grpc.CallOption) (*ChunkExportStatus, error)
 }
 
 type adminClient struct {
@@ -47,6 +93,78 @@ func (c *adminClient) ExportTestVariants(ctx context.Context, in *ExportTestVari
 	return out, nil
 }
 
+func (c *adminClient) ListProjectUpdateStatuses(ctx context.Context, in *ListProjectUpdateStatusesRequest, opts ...grpc.CallOption) (*ListProjectUpdateStatusesResponse, error) {
+	out := new(ListProjectUpdateStatusesResponse)
+	err := c.cc.Invoke(ctx, "/weetbix.internal.admin.Admin/ListProjectUpdateStatuses", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) ListProjectConfigVersions(ctx context.Context, in *ListProjectConfigVersionsRequest, opts ...grpc.CallOption) (*ListProjectConfigVersionsResponse, error) {
+	out := new(ListProjectConfigVersionsResponse)
+	err := c.cc.Invoke(ctx, "/weetbix.internal.admin.Admin/ListProjectConfigVersions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) PurgeProject(ctx context.Context, in *PurgeProjectRequest, opts ...grpc.CallOption) (*PurgeProjectResponse, error) {
+	out := new(PurgeProjectResponse)
+	err := c.cc.Invoke(ctx, "/weetbix.internal.admin.Admin/PurgeProject", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) SetMaintenanceMode(ctx context.Context, in *SetMaintenanceModeRequest, opts ...grpc.CallOption) (*MaintenanceMode, error) {
+	out := new(MaintenanceMode)
+	err := c.cc.Invoke(ctx, "/weetbix.internal.admin.Admin/SetMaintenanceMode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) BackfillProject(ctx context.Context, in *BackfillProjectRequest, opts ...grpc.CallOption) (*BackfillStatus, error) {
+	out := new(BackfillStatus)
+	err := c.cc.Invoke(ctx, "/weetbix.internal.admin.Admin/BackfillProject", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) GetBackfillStatus(ctx context.Context, in *GetBackfillStatusRequest, opts ...grpc.CallOption) (*BackfillStatus, error) {
+	out := new(BackfillStatus)
+	err := c.cc.Invoke(ctx, "/weetbix.internal.admin.Admin/GetBackfillStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) ExportChunks(ctx context.Context, in *ExportChunksRequest, opts ...grpc.CallOption) (*ChunkExportStatus, error) {
+	out := new(ChunkExportStatus)
+	err := c.cc.Invoke(ctx, "/weetbix.internal.admin.Admin/ExportChunks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) GetChunkExportStatus(ctx context.Context, in *GetChunkExportStatusRequest, opts ...grpc.CallOption) (*ChunkExportStatus, error) {
+	out := new(ChunkExportStatus)
+	err := c.cc.Invoke(ctx, "/weetbix.internal.admin.Admin/GetChunkExportStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AdminServer is the server API for Admin service.
 // All implementations must embed UnimplementedAdminServer
 // for forward compatibility
@@ -60,6 +178,52 @@ type AdminServer interface {
 	// result ingestion started. If such request arises, we need to add another
 	// Admin API for it.
 	ExportTestVariants(context.Context, *ExportTestVariantsRequest) (*emptypb.Empty, error)
+	// ListProjectUpdateStatuses lists the status of the most recent updates of
+	// cluster analysis and bugs for each LUCI project. Used to alert on
+	// projects falling behind.
+	ListProjectUpdateStatuses(context.Context, *ListProjectUpdateStatusesRequest) (*ListProjectUpdateStatusesResponse, error)
+	// ListProjectConfigVersions lists the versions of the project configs
+	// served by the instance handling the request.
+	ListProjectConfigVersions(context.Context, *ListProjectConfigVersionsRequest) (*ListProjectConfigVersionsResponse, error)
+	// PurgeProject deletes the data of a LUCI project offboarded from
+	// Weetbix. The project must no longer have a project config.
+	//
+	// Purging takes two calls. Called without a confirm_token, PurgeProject
+	// is a dry run: it reports the rows to be deleted and issues a token.
+	// Called again with the token within an hour, it starts the purge, which
+	// continues in the background. Calling it again with the same token
+	// reports the progress of the purge.
+	PurgeProject(context.Context, *PurgeProjectRequest) (*PurgeProjectResponse, error)
+	// SetMaintenanceMode puts all of Weetbix, or a LUCI project, in read-only
+	// maintenance mode, or takes it out of maintenance mode, for a limited
+	// time. Until it expires, the setting takes precedence over the
+	// maintenance mode in the service config.
+	//
+	// SetMaintenanceMode may be called in maintenance mode.
+	SetMaintenanceMode(context.Context, *SetMaintenanceModeRequest) (*MaintenanceMode, error)
+	// BackfillProject re-ingests the test results of the completed builds of
+	// a LUCI project created in a time range. Builds already ingested are
+	// skipped unless force is set.
+	//
+	// The backfill continues in the background, enqueuing ingestion tasks at
+	// the requested rate. At most two backfills of a project may be in
+	// progress at once. Called with dry_run, BackfillProject only counts the
+	// builds to be ingested.
+	BackfillProject(context.Context, *BackfillProjectRequest) (*BackfillStatus, error)
+	// GetBackfillStatus reports the progress of a backfill.
+	GetBackfillStatus(context.Context, *GetBackfillStatusRequest) (*BackfillStatus, error)
+	// ExportChunks exports a sample of the clustering chunks of a LUCI project
+	// with partition times in a time range to the chunk export GCS bucket of
+	// the service config, for offline experimentation with clustering
+	// algorithms. The project must opt in to exports in its project config.
+	//
+	// Failures are redacted before export. The export continues in the
+	// background, writing a JSONL object per exported chunk, then a manifest
+	// of the objects with their checksums, and finally a completion marker.
+	// At most one export of a project may be in progress at once.
+	ExportChunks(context.Context, *ExportChunksRequest) (*ChunkExportStatus, error)
+	// GetChunkExportStatus reports the progress of a chunk export.
+	GetChunkExportStatus(context.Context, *GetChunkExportStatusRequest) (*ChunkExportStatus, error)
 	mustEmbedUnimplementedAdminServer()
 }
 
@@ -70,6 +234,30 @@ type UnimplementedAdminServer struct {
 func (UnimplementedAdminServer) ExportTestVariants(context.Context, *ExportTestVariantsRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ExportTestVariants not implemented")
 }
+func (UnimplementedAdminServer) ListProjectUpdateStatuses(context.Context, *ListProjectUpdateStatusesRequest) (*ListProjectUpdateStatusesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListProjectUpdateStatuses not implemented")
+}
+func (UnimplementedAdminServer) ListProjectConfigVersions(context.Context, *ListProjectConfigVersionsRequest) (*ListProjectConfigVersionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListProjectConfigVersions not implemented")
+}
+func (UnimplementedAdminServer) PurgeProject(context.Context, *PurgeProjectRequest) (*PurgeProjectResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurgeProject not implemented")
+}
+func (UnimplementedAdminServer) SetMaintenanceMode(context.Context, *SetMaintenanceModeRequest) (*MaintenanceMode, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetMaintenanceMode not implemented")
+}
+func (UnimplementedAdminServer) BackfillProject(context.Context, *BackfillProjectRequest) (*BackfillStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BackfillProject not implemented")
+}
+func (UnimplementedAdminServer) GetBackfillStatus(context.Context, *GetBackfillStatusRequest) (*BackfillStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBackfillStatus not implemented")
+}
+func (UnimplementedAdminServer) ExportChunks(context.Context, *ExportChunksRequest) (*ChunkExportStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportChunks not implemented")
+}
+func (UnimplementedAdminServer) GetChunkExportStatus(context.Context, *GetChunkExportStatusRequest) (*ChunkExportStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetChunkExportStatus not implemented")
+}
 func (UnimplementedAdminServer) mustEmbedUnimplementedAdminServer() {}
 
 // UnsafeAdminServer may be embedded to opt out of forward compatibility for this service.
@@ -101,6 +289,150 @@ func _Admin_ExportTestVariants_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Admin_ListProjectUpdateStatuses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListProjectUpdateStatusesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).ListProjectUpdateStatuses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/weetbix.internal.admin.Admin/ListProjectUpdateStatuses",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).ListProjectUpdateStatuses(ctx, req.(*ListProjectUpdateStatusesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_ListProjectConfigVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListProjectConfigVersionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).ListProjectConfigVersions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/weetbix.internal.admin.Admin/ListProjectConfigVersions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).ListProjectConfigVersions(ctx, req.(*ListProjectConfigVersionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_PurgeProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurgeProjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).PurgeProject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/weetbix.internal.admin.Admin/PurgeProject",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).PurgeProject(ctx, req.(*PurgeProjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_SetMaintenanceMode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetMaintenanceModeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).SetMaintenanceMode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/weetbix.internal.admin.Admin/SetMaintenanceMode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).SetMaintenanceMode(ctx, req.(*SetMaintenanceModeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_BackfillProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BackfillProjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).BackfillProject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/weetbix.internal.admin.Admin/BackfillProject",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).BackfillProject(ctx, req.(*BackfillProjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_GetBackfillStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBackfillStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).GetBackfillStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/weetbix.internal.admin.Admin/GetBackfillStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).GetBackfillStatus(ctx, req.(*GetBackfillStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_ExportChunks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportChunksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).ExportChunks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/weetbix.internal.admin.Admin/ExportChunks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).ExportChunks(ctx, req.(*ExportChunksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_GetChunkExportStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetChunkExportStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).GetChunkExportStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/weetbix.internal.admin.Admin/GetChunkExportStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).GetChunkExportStatus(ctx, req.(*GetChunkExportStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Admin_ServiceDesc is the grpc.ServiceDesc for Admin service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -112,6 +444,38 @@ var Admin_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ExportTestVariants",
 			Handler:    _Admin_ExportTestVariants_Handler,
 		},
+		{
+			MethodName: "ListProjectUpdateStatuses",
+			Handler:    _Admin_ListProjectUpdateStatuses_Handler,
+		},
+		{
+			MethodName: "ListProjectConfigVersions",
+			Handler:    _Admin_ListProjectConfigVersions_Handler,
+		},
+		{
+			MethodName: "PurgeProject",
+			Handler:    _Admin_PurgeProject_Handler,
+		},
+		{
+			MethodName: "SetMaintenanceMode",
+			Handler:    _Admin_SetMaintenanceMode_Handler,
+		},
+		{
+			MethodName: "BackfillProject",
+			Handler:    _Admin_BackfillProject_Handler,
+		},
+		{
+			MethodName: "GetBackfillStatus",
+			Handler:    _Admin_GetBackfillStatus_Handler,
+		},
+		{
+			MethodName: "ExportChunks",
+			Handler:    _Admin_ExportChunks_Handler,
+		},
+		{
+			MethodName: "GetChunkExportStatus",
+			Handler:    _Admin_GetChunkExportStatus_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "infra/appengine/weetbix/internal/admin/proto/admin.proto",
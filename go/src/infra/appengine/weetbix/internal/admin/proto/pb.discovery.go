@@ -12,466 +12,946 @@ func init() {
 			"weetbix.internal.admin.Admin",
 		},
 		[]byte{31, 139,
-			8, 0, 0, 0, 0, 0, 0, 255, 236, 123, 77, 140, 27, 73,
-			150, 30, 147, 201, 170, 46, 69, 75, 45, 41, 165, 233, 213, 176,
-			213, 210, 107, 142, 74, 34, 101, 86, 178, 126, 244, 91, 26, 13,
-			134, 197, 202, 146, 82, 83, 34, 107, 72, 86, 105, 164, 70, 183,
-			148, 204, 12, 146, 49, 74, 102, 176, 51, 130, 69, 177, 101, 1,
-			134, 1, 99, 129, 93, 96, 97, 27, 48, 96, 31, 108, 3, 246,
-			201, 139, 5, 124, 48, 96, 248, 176, 192, 194, 128, 13, 95, 22,
-			240, 205, 7, 31, 236, 147, 111, 134, 125, 53, 96, 195, 48, 226,
-			69, 38, 201, 146, 74, 82, 207, 216, 48, 176, 64, 23, 84, 221,
-			25, 127, 47, 222, 123, 241, 226, 189, 239, 69, 68, 145, 191, 176,
-			200, 23, 61, 206, 123, 33, 173, 12, 99, 46, 121, 103, 212, 173,
-			208, 193, 80, 78, 108, 44, 90, 167, 117, 163, 157, 54, 22, 62,
-			33, 11, 142, 106, 223, 122, 67, 206, 249, 124, 96, 191, 213, 190,
-			69, 176, 117, 79, 21, 247, 140, 103, 105, 115, 143, 135, 94, 212,
-			179, 121, 220, 155, 77, 35, 39, 67, 42, 42, 47, 35, 62, 142,
-			244, 148, 195, 206, 255, 48, 140, 127, 146, 53, 31, 236, 109, 253,
-			105, 246, 210, 3, 61, 114, 47, 233, 110, 63, 161, 97, 248, 43,
-			213, 185, 173, 198, 61, 250, 223, 103, 200, 162, 149, 187, 148, 217,
-			56, 67, 254, 242, 36, 49, 78, 90, 230, 165, 140, 181, 254, 175,
-			79, 2, 14, 240, 121, 8, 91, 163, 110, 151, 198, 2, 86, 64,
-			147, 186, 38, 32, 240, 164, 7, 44, 146, 52, 246, 251, 94, 212,
-			163, 208, 229, 241, 192, 147, 4, 106, 124, 56, 137, 89, 175, 47,
-			97, 125, 117, 245, 78, 50, 0, 220, 200, 183, 1, 170, 97, 8,
-			216, 38, 32, 166, 130, 198, 135, 52, 176, 9, 244, 165, 28, 138,
-			205, 74, 37, 160, 135, 52, 228, 67, 26, 139, 84, 86, 159, 15,
-			180, 144, 62, 15, 87, 58, 154, 137, 10, 33, 208, 164, 1, 19,
-			50, 102, 157, 145, 100, 60, 2, 47, 10, 96, 36, 40, 176, 8,
-			4, 31, 197, 62, 197, 154, 14, 139, 188, 120, 130, 124, 137, 50,
-			140, 153, 236, 3, 143, 241, 255, 124, 36, 9, 12, 120, 192, 186,
-			204, 247, 20, 133, 50, 120, 49, 133, 33, 141, 7, 76, 74, 26,
-			192, 48, 230, 135, 44, 160, 1, 200, 190, 39, 65, 246, 149, 116,
-			97, 200, 199, 44, 234, 129, 207, 163, 128, 169, 65, 66, 13, 34,
-			48, 160, 114, 147, 16, 80, 63, 215, 223, 98, 76, 0, 239, 166,
-			28, 249, 60, 160, 48, 24, 9, 9, 49, 149, 30, 139, 144, 170,
-			215, 225, 135, 170, 41, 209, 24, 129, 136, 75, 230, 211, 50, 200,
-			62, 19, 16, 50, 33, 21, 133, 249, 25, 163, 224, 45, 118, 2,
-			38, 252, 208, 99, 3, 26, 219, 239, 99, 130, 69, 243, 186, 72,
-			153, 24, 198, 60, 24, 249, 116, 198, 7, 153, 49, 242, 127, 197,
-			7, 129, 68, 186, 128, 251, 163, 1, 141, 164, 151, 46, 82, 133,
-			199, 192, 101, 159, 198, 48, 240, 36, 141, 153, 23, 138, 153, 170,
-			113, 129, 100, 159, 18, 152, 231, 126, 42, 84, 157, 50, 28, 169,
-			8, 71, 222, 128, 42, 134, 230, 109, 43, 226, 179, 54, 212, 59,
-			147, 66, 73, 20, 105, 82, 60, 22, 48, 240, 38, 208, 161, 202,
-			82, 2, 144, 28, 104, 20, 240, 88, 80, 101, 20, 195, 152, 15,
-			184, 164, 160, 117, 34, 5, 4, 52, 102, 135, 52, 128, 110, 204,
-			7, 68, 107, 65, 240, 174, 28, 43, 51, 73, 44, 8, 196, 144,
-			250, 202, 130, 96, 24, 51, 101, 88, 177, 178, 157, 72, 91, 145,
-			16, 200, 59, 129, 246, 67, 183, 5, 173, 198, 78, 251, 73, 181,
-			233, 128, 219, 130, 189, 102, 227, 192, 221, 118, 182, 97, 235, 41,
-			180, 31, 58, 80, 107, 236, 61, 109, 186, 15, 30, 182, 225, 97,
-			99, 119, 219, 105, 182, 160, 90, 223, 134, 90, 163, 222, 110, 186,
-			91, 251, 237, 70, 179, 69, 160, 80, 109, 129, 219, 42, 96, 75,
-			181, 254, 20, 156, 223, 236, 53, 157, 86, 11, 26, 77, 112, 31,
-			239, 237, 186, 206, 54, 60, 169, 54, 155, 213, 122, 219, 117, 90,
-			101, 112, 235, 181, 221, 253, 109, 183, 254, 160, 12, 91, 251, 109,
-			168, 55, 218, 4, 118, 221, 199, 110, 219, 217, 134, 118, 163, 140,
-			211, 190, 59, 14, 26, 59, 240, 216, 105, 214, 30, 86, 235, 237,
-			234, 150, 187, 235, 182, 159, 226, 132, 59, 110, 187, 174, 38, 219,
-			105, 52, 9, 84, 97, 175, 218, 108, 187, 181, 253, 221, 106, 19,
-			246, 246, 155, 123, 141, 150, 3, 74, 178, 109, 183, 85, 219, 173,
-			186, 143, 157, 109, 27, 220, 58, 212, 27, 224, 28, 56, 245, 54,
-			180, 30, 86, 119, 119, 143, 10, 74, 160, 241, 164, 238, 52, 21,
-			247, 243, 98, 194, 150, 3, 187, 110, 117, 107, 215, 81, 83, 161,
-			156, 219, 110, 211, 169, 181, 149, 64, 179, 175, 154, 187, 237, 212,
-			219, 213, 221, 50, 129, 214, 158, 83, 115, 171, 187, 101, 112, 126,
-			227, 60, 222, 219, 173, 54, 159, 150, 19, 162, 45, 231, 215, 251,
-			78, 189, 237, 86, 119, 97, 187, 250, 184, 250, 192, 105, 65, 241,
-			99, 90, 217, 107, 54, 106, 251, 77, 231, 177, 226, 186, 177, 3,
-			173, 253, 173, 86, 219, 109, 239, 183, 29, 120, 208, 104, 108, 163,
-			178, 91, 78, 243, 192, 173, 57, 173, 123, 176, 219, 104, 161, 194,
-			246, 91, 78, 153, 192, 118, 181, 93, 197, 169, 247, 154, 141, 29,
-			183, 221, 186, 167, 190, 183, 246, 91, 46, 42, 206, 173, 183, 157,
-			102, 115, 127, 175, 237, 54, 234, 37, 120, 216, 120, 226, 28, 56,
-			77, 168, 85, 247, 91, 206, 54, 106, 184, 81, 87, 210, 42, 91,
-			113, 26, 205, 167, 138, 172, 210, 3, 174, 64, 25, 158, 60, 116,
-			218, 15, 157, 166, 82, 42, 106, 171, 170, 212, 208, 106, 55, 221,
-			90, 123, 190, 91, 163, 9, 237, 70, 179, 77, 230, 228, 132, 186,
-			243, 96, 215, 125, 224, 212, 107, 142, 106, 110, 40, 50, 79, 220,
-			150, 83, 130, 106, 211, 109, 169, 14, 46, 78, 12, 79, 170, 79,
-			161, 177, 143, 82, 171, 133, 218, 111, 57, 68, 127, 207, 153, 110,
-			25, 215, 19, 220, 29, 168, 110, 31, 184, 138, 243, 164, 247, 94,
-			163, 213, 114, 19, 115, 65, 181, 213, 30, 38, 58, 183, 9, 89,
-			34, 70, 214, 50, 33, 115, 65, 125, 45, 89, 102, 33, 115, 143,
-			156, 32, 217, 165, 101, 253, 169, 43, 127, 150, 113, 176, 242, 83,
-			253, 169, 43, 175, 100, 202, 88, 105, 232, 79, 93, 185, 156, 249,
-			107, 88, 153, 124, 234, 202, 171, 153, 2, 86, 18, 253, 169, 43,
-			175, 101, 190, 194, 202, 43, 250, 83, 87, 22, 51, 151, 177, 242,
-			178, 254, 252, 159, 89, 146, 205, 101, 44, 115, 35, 115, 38, 255,
-			223, 179, 80, 133, 30, 141, 104, 204, 124, 192, 8, 10, 3, 42,
-			132, 215, 163, 58, 4, 76, 248, 8, 124, 47, 130, 152, 174, 168,
-			64, 35, 57, 120, 135, 156, 5, 16, 208, 46, 139, 208, 253, 141,
-			134, 161, 10, 38, 52, 32, 71, 199, 163, 251, 157, 240, 81, 12,
-			213, 61, 87, 216, 80, 5, 57, 25, 50, 223, 11, 129, 190, 242,
-			6, 195, 144, 2, 19, 138, 30, 198, 47, 9, 158, 64, 47, 22,
-			211, 239, 70, 84, 72, 2, 137, 87, 139, 169, 24, 242, 72, 205,
-			60, 25, 162, 235, 243, 34, 69, 79, 5, 159, 62, 15, 108, 216,
-			225, 49, 176, 72, 72, 47, 242, 105, 26, 141, 84, 124, 101, 62,
-			133, 29, 206, 225, 181, 174, 2, 136, 135, 62, 108, 121, 113, 241,
-			45, 172, 97, 35, 212, 40, 169, 216, 52, 138, 35, 1, 239, 105,
-			191, 167, 201, 188, 81, 142, 173, 79, 225, 81, 171, 81, 199, 72,
-			66, 197, 212, 205, 119, 121, 12, 47, 176, 247, 11, 37, 153, 214,
-			5, 118, 228, 157, 223, 82, 95, 194, 139, 215, 111, 94, 216, 132,
-			16, 98, 230, 50, 134, 101, 110, 44, 157, 234, 44, 226, 52, 27,
-			228, 223, 173, 145, 203, 111, 35, 40, 201, 6, 84, 72, 111, 48,
-			124, 31, 138, 186, 71, 78, 180, 211, 62, 214, 5, 242, 137, 160,
-			42, 78, 137, 11, 6, 24, 69, 179, 153, 22, 173, 243, 100, 33,
-			242, 34, 46, 46, 100, 193, 40, 46, 52, 117, 97, 235, 111, 25,
-			199, 67, 175, 207, 166, 36, 83, 248, 181, 254, 3, 225, 215, 148,
-			223, 223, 9, 130, 253, 219, 10, 249, 196, 90, 184, 148, 249, 187,
-			134, 241, 35, 6, 251, 17, 131, 253, 136, 193, 126, 196, 96, 63,
-			98, 176, 31, 49, 216, 255, 71, 12, 54, 133, 70, 234, 51, 197,
-			96, 110, 10, 204, 212, 103, 138, 193, 166, 192, 108, 121, 10, 204,
-			174, 102, 42, 41, 48, 83, 159, 41, 6, 155, 2, 179, 107, 83,
-			96, 86, 156, 1, 51, 245, 249, 159, 190, 68, 12, 182, 248, 135,
-			134, 10, 125, 249, 127, 255, 37, 84, 97, 26, 122, 103, 208, 66,
-			128, 7, 67, 206, 34, 137, 110, 141, 13, 84, 152, 9, 232, 144,
-			70, 1, 141, 164, 134, 67, 19, 93, 255, 61, 143, 208, 155, 132,
-			220, 247, 66, 2, 190, 23, 210, 40, 240, 226, 50, 208, 72, 121,
-			255, 64, 1, 44, 15, 124, 62, 210, 227, 18, 116, 128, 190, 180,
-			27, 123, 254, 44, 98, 164, 13, 42, 32, 40, 168, 128, 101, 21,
-			49, 121, 168, 157, 34, 34, 32, 77, 136, 169, 80, 26, 122, 146,
-			29, 106, 104, 24, 1, 29, 114, 191, 15, 158, 132, 253, 118, 13,
-			6, 44, 136, 208, 163, 243, 136, 192, 35, 47, 26, 169, 48, 176,
-			86, 134, 181, 187, 183, 87, 203, 169, 163, 30, 198, 60, 164, 67,
-			201, 124, 120, 16, 211, 30, 143, 153, 23, 77, 185, 135, 113, 159,
-			249, 125, 160, 175, 36, 85, 60, 161, 131, 62, 166, 87, 199, 243,
-			95, 142, 189, 56, 64, 60, 57, 161, 94, 12, 60, 162, 202, 1,
-			170, 144, 63, 96, 209, 72, 82, 140, 151, 112, 107, 117, 42, 95,
-			200, 163, 158, 13, 187, 212, 27, 206, 68, 142, 41, 20, 196, 128,
-			122, 49, 13, 10, 32, 184, 14, 192, 17, 135, 144, 122, 67, 146,
-			116, 3, 233, 117, 52, 118, 141, 40, 85, 122, 237, 34, 2, 149,
-			52, 30, 170, 216, 170, 3, 250, 72, 168, 168, 228, 193, 215, 235,
-			55, 86, 250, 10, 2, 135, 44, 162, 94, 76, 0, 169, 127, 83,
-			252, 48, 232, 80, 235, 89, 193, 158, 37, 59, 1, 156, 49, 162,
-			28, 38, 48, 38, 192, 234, 234, 234, 218, 10, 254, 107, 175, 174,
-			110, 226, 191, 103, 74, 244, 187, 119, 239, 222, 93, 89, 91, 95,
-			217, 88, 107, 175, 111, 108, 222, 188, 187, 121, 243, 174, 125, 55,
-			253, 121, 102, 195, 214, 132, 168, 133, 148, 49, 243, 165, 98, 80,
-			38, 34, 34, 245, 50, 140, 41, 208, 72, 140, 226, 4, 250, 143,
-			41, 34, 127, 159, 71, 135, 52, 150, 122, 125, 117, 80, 130, 175,
-			155, 59, 53, 2, 27, 27, 27, 119, 103, 178, 140, 199, 99, 155,
-			81, 217, 69, 132, 24, 119, 125, 245, 171, 122, 216, 242, 149, 44,
-			41, 196, 70, 65, 205, 28, 245, 132, 18, 234, 103, 224, 232, 44,
-			64, 16, 146, 126, 194, 218, 38, 212, 248, 96, 56, 146, 116, 110,
-			47, 224, 132, 123, 141, 150, 251, 27, 120, 161, 52, 83, 44, 41,
-			20, 141, 129, 121, 214, 105, 10, 62, 19, 160, 62, 3, 207, 130,
-			202, 231, 201, 2, 23, 113, 120, 125, 127, 119, 183, 84, 58, 182,
-			31, 218, 123, 113, 181, 116, 111, 142, 167, 245, 143, 241, 212, 163,
-			82, 81, 225, 221, 192, 155, 204, 241, 38, 100, 60, 242, 37, 78,
-			112, 232, 133, 32, 15, 147, 25, 143, 116, 191, 42, 15, 203, 128,
-			12, 221, 251, 125, 69, 58, 180, 229, 161, 42, 125, 72, 34, 221,
-			105, 36, 168, 15, 215, 97, 109, 117, 245, 168, 132, 27, 239, 149,
-			240, 9, 139, 54, 214, 225, 197, 3, 42, 91, 19, 33, 233, 64,
-			53, 87, 197, 14, 11, 105, 251, 232, 66, 236, 184, 187, 78, 219,
-			125, 236, 64, 87, 38, 108, 188, 111, 204, 213, 174, 76, 57, 221,
-			119, 235, 237, 91, 55, 64, 50, 255, 165, 128, 251, 80, 44, 22,
-			117, 77, 169, 43, 237, 96, 252, 144, 245, 250, 219, 158, 196, 81,
-			37, 248, 249, 207, 97, 99, 189, 4, 127, 29, 176, 109, 151, 143,
-			211, 166, 84, 111, 149, 10, 84, 21, 191, 1, 31, 11, 36, 169,
-			54, 203, 218, 234, 234, 156, 15, 19, 246, 180, 131, 246, 82, 107,
-			183, 222, 221, 70, 83, 106, 106, 248, 218, 173, 27, 55, 110, 220,
-			222, 184, 181, 58, 115, 27, 29, 218, 229, 49, 133, 253, 136, 189,
-			74, 169, 220, 189, 189, 250, 54, 21, 251, 247, 91, 204, 162, 150,
-			31, 138, 69, 173, 148, 10, 46, 150, 250, 41, 193, 202, 60, 59,
-			31, 177, 96, 69, 71, 169, 43, 165, 179, 60, 71, 7, 13, 160,
-			116, 196, 0, 110, 188, 215, 0, 30, 121, 135, 30, 188, 208, 11,
-			105, 251, 163, 56, 166, 145, 84, 93, 30, 179, 48, 100, 98, 206,
-			0, 148, 55, 133, 1, 214, 194, 125, 120, 255, 128, 15, 152, 57,
-			220, 159, 213, 218, 17, 29, 111, 141, 88, 24, 208, 184, 88, 82,
-			130, 181, 18, 13, 37, 83, 104, 197, 148, 210, 220, 30, 64, 245,
-			169, 107, 217, 89, 36, 149, 228, 73, 79, 45, 122, 34, 54, 106,
-			160, 100, 119, 20, 101, 228, 101, 166, 131, 155, 31, 209, 129, 139,
-			103, 12, 210, 142, 248, 120, 78, 236, 164, 22, 34, 62, 134, 251,
-			112, 164, 207, 7, 37, 157, 49, 254, 113, 145, 35, 62, 182, 123,
-			84, 58, 202, 216, 116, 93, 177, 52, 39, 249, 81, 233, 147, 206,
-			170, 80, 124, 143, 164, 183, 222, 43, 105, 178, 94, 41, 206, 128,
-			189, 137, 236, 235, 68, 226, 136, 161, 205, 47, 84, 177, 244, 182,
-			21, 62, 160, 178, 54, 91, 247, 98, 9, 125, 61, 30, 131, 60,
-			246, 134, 67, 22, 245, 8, 1, 55, 210, 53, 58, 107, 47, 35,
-			12, 152, 211, 211, 100, 136, 161, 238, 8, 112, 209, 161, 35, 193,
-			12, 4, 3, 208, 239, 20, 127, 244, 84, 10, 187, 120, 10, 182,
-			148, 53, 25, 93, 171, 38, 43, 188, 86, 184, 225, 205, 202, 235,
-			1, 143, 100, 255, 205, 202, 235, 192, 155, 188, 105, 191, 86, 193,
-			251, 205, 230, 235, 1, 139, 222, 108, 190, 22, 212, 127, 243, 181,
-			253, 90, 193, 37, 181, 101, 223, 124, 243, 172, 64, 96, 220, 167,
-			49, 5, 61, 90, 17, 242, 194, 177, 55, 17, 64, 95, 41, 4,
-			167, 146, 61, 141, 5, 186, 10, 5, 4, 172, 199, 164, 80, 160,
-			38, 164, 144, 204, 84, 6, 156, 170, 76, 64, 79, 86, 6, 156,
-			173, 140, 193, 22, 167, 68, 92, 242, 61, 141, 249, 202, 208, 11,
-			2, 157, 62, 202, 49, 79, 169, 81, 207, 239, 107, 76, 150, 226,
-			56, 133, 255, 18, 151, 82, 78, 16, 148, 10, 228, 61, 14, 163,
-			33, 194, 132, 116, 104, 145, 217, 212, 78, 42, 215, 142, 71, 123,
-			165, 50, 193, 249, 249, 80, 83, 214, 51, 21, 158, 21, 64, 140,
-			186, 93, 246, 74, 225, 81, 60, 254, 211, 199, 119, 202, 14, 16,
-			137, 22, 11, 251, 237, 90, 161, 116, 239, 72, 45, 209, 128, 241,
-			187, 17, 139, 105, 96, 67, 21, 244, 241, 151, 54, 6, 129, 57,
-			57, 251, 158, 198, 32, 250, 124, 20, 6, 169, 42, 71, 130, 34,
-			154, 44, 122, 98, 58, 91, 0, 157, 9, 81, 108, 148, 212, 2,
-			68, 42, 11, 142, 52, 164, 121, 215, 148, 148, 34, 189, 35, 83,
-			13, 189, 88, 204, 166, 233, 80, 2, 136, 233, 20, 194, 241, 125,
-			58, 148, 208, 225, 178, 143, 115, 170, 177, 250, 208, 32, 149, 65,
-			188, 195, 135, 130, 189, 188, 219, 21, 84, 34, 92, 219, 225, 113,
-			122, 194, 89, 134, 194, 250, 234, 218, 109, 21, 29, 214, 110, 182,
-			87, 215, 54, 55, 86, 55, 215, 110, 218, 171, 107, 207, 10, 137,
-			117, 11, 192, 242, 52, 188, 12, 61, 33, 9, 96, 79, 156, 159,
-			71, 51, 220, 124, 179, 12, 138, 154, 157, 108, 32, 239, 208, 107,
-			249, 49, 27, 202, 178, 66, 187, 71, 160, 154, 7, 42, 60, 166,
-			231, 142, 136, 242, 20, 116, 212, 198, 174, 237, 17, 205, 95, 185,
-			171, 192, 139, 3, 2, 95, 75, 238, 182, 26, 45, 220, 100, 197,
-			210, 49, 0, 213, 30, 240, 239, 89, 24, 122, 184, 187, 104, 180,
-			178, 223, 170, 4, 220, 23, 149, 39, 180, 83, 153, 177, 82, 105,
-			210, 46, 141, 105, 228, 211, 202, 131, 144, 119, 188, 240, 121, 3,
-			121, 16, 21, 197, 80, 101, 110, 146, 18, 153, 30, 225, 186, 169,
-			167, 41, 227, 62, 215, 44, 193, 11, 133, 24, 149, 210, 237, 244,
-			227, 69, 42, 144, 18, 181, 67, 83, 105, 105, 64, 142, 21, 145,
-			192, 215, 47, 132, 140, 187, 56, 116, 78, 34, 238, 11, 123, 168,
-			61, 155, 146, 101, 189, 18, 178, 78, 236, 197, 19, 132, 221, 118,
-			95, 14, 194, 159, 225, 87, 58, 182, 132, 103, 46, 100, 106, 200,
-			233, 36, 98, 72, 125, 184, 182, 252, 116, 101, 121, 176, 178, 28,
-			180, 151, 31, 110, 46, 63, 222, 92, 110, 217, 203, 221, 103, 215,
-			108, 216, 101, 47, 233, 152, 9, 138, 105, 142, 82, 208, 108, 149,
-			70, 130, 106, 106, 143, 120, 224, 161, 177, 94, 19, 240, 245, 11,
-			183, 213, 72, 65, 205, 142, 118, 86, 65, 82, 44, 150, 94, 124,
-			83, 212, 39, 149, 137, 159, 251, 45, 15, 244, 74, 168, 143, 21,
-			204, 23, 188, 33, 195, 5, 73, 107, 117, 22, 161, 121, 173, 188,
-			75, 27, 229, 76, 39, 88, 94, 223, 94, 94, 223, 38, 80, 82,
-			138, 228, 29, 60, 33, 244, 18, 57, 37, 141, 193, 247, 134, 184,
-			65, 120, 87, 95, 21, 120, 122, 171, 165, 219, 76, 104, 183, 60,
-			213, 63, 30, 114, 127, 170, 143, 185, 115, 127, 104, 44, 157, 37,
-			255, 208, 32, 185, 92, 38, 155, 177, 114, 127, 108, 100, 207, 231,
-			255, 196, 128, 230, 44, 195, 77, 109, 159, 119, 209, 228, 81, 199,
-			130, 69, 254, 60, 202, 34, 199, 195, 44, 120, 60, 18, 82, 217,
-			194, 135, 210, 34, 114, 92, 94, 244, 12, 88, 228, 135, 35, 193,
-			14, 85, 162, 120, 138, 44, 40, 246, 22, 144, 191, 79, 210, 162,
-			161, 138, 75, 167, 211, 162, 169, 138, 214, 57, 242, 95, 180, 48,
-			134, 149, 251, 59, 70, 214, 202, 255, 7, 3, 234, 60, 90, 137,
-			104, 79, 231, 193, 71, 178, 105, 47, 205, 26, 85, 34, 121, 124,
-			54, 93, 79, 6, 78, 19, 204, 67, 47, 28, 81, 161, 143, 36,
-			103, 196, 240, 224, 84, 72, 22, 134, 208, 247, 14, 41, 68, 243,
-			115, 34, 233, 100, 32, 209, 217, 155, 78, 208, 187, 60, 86, 137,
-			113, 122, 122, 240, 182, 194, 146, 164, 177, 156, 252, 146, 99, 148,
-			98, 44, 160, 156, 169, 82, 12, 20, 123, 233, 84, 90, 52, 85,
-			241, 204, 217, 233, 77, 198, 191, 248, 138, 172, 176, 168, 27, 123,
-			21, 111, 56, 164, 81, 143, 69, 180, 50, 166, 84, 118, 216, 43,
-			125, 152, 94, 57, 92, 171, 248, 124, 48, 224, 81, 114, 175, 65,
-			146, 102, 251, 112, 45, 255, 177, 75, 144, 194, 88, 223, 121, 52,
-			85, 194, 106, 221, 34, 75, 212, 139, 67, 70, 133, 196, 75, 143,
-			79, 215, 243, 111, 223, 103, 216, 211, 88, 208, 156, 246, 181, 214,
-			201, 98, 168, 34, 150, 196, 43, 145, 15, 143, 74, 122, 22, 110,
-			145, 147, 109, 42, 100, 147, 138, 81, 40, 221, 192, 250, 156, 44,
-			10, 68, 185, 56, 243, 137, 102, 82, 178, 62, 35, 89, 22, 32,
-			221, 19, 205, 44, 11, 10, 223, 145, 79, 14, 188, 152, 121, 145,
-			180, 108, 98, 6, 180, 123, 193, 0, 179, 248, 233, 250, 69, 123,
-			38, 182, 157, 244, 176, 183, 105, 215, 137, 100, 60, 105, 170, 142,
-			249, 91, 100, 41, 173, 176, 206, 16, 243, 37, 157, 36, 115, 169,
-			79, 235, 60, 89, 192, 245, 78, 230, 210, 133, 205, 236, 29, 163,
-			112, 131, 16, 237, 99, 247, 60, 22, 255, 208, 145, 133, 93, 114,
-			126, 107, 212, 107, 199, 158, 255, 146, 69, 61, 133, 16, 121, 68,
-			35, 249, 94, 65, 47, 146, 19, 126, 218, 41, 161, 52, 171, 40,
-			220, 33, 159, 237, 197, 84, 140, 58, 3, 38, 155, 163, 232, 135,
-			43, 236, 250, 11, 114, 234, 128, 198, 1, 243, 101, 75, 122, 114,
-			36, 172, 75, 36, 127, 224, 52, 183, 221, 90, 251, 121, 171, 93,
-			109, 239, 183, 158, 239, 215, 241, 240, 117, 199, 117, 182, 207, 100,
-			172, 207, 8, 217, 175, 59, 191, 217, 115, 106, 109, 103, 251, 12,
-			177, 206, 146, 83, 105, 255, 157, 221, 234, 175, 158, 158, 185, 100,
-			157, 36, 75, 211, 14, 235, 91, 229, 103, 215, 63, 102, 161, 247,
-			146, 138, 97, 231, 209, 127, 252, 130, 44, 90, 185, 92, 134, 26,
-			228, 79, 13, 188, 159, 202, 101, 172, 245, 127, 108, 28, 185, 106,
-			90, 95, 67, 88, 84, 235, 199, 124, 192, 70, 3, 168, 142, 100,
-			159, 199, 194, 126, 207, 157, 211, 190, 64, 95, 154, 156, 236, 207,
-			110, 104, 152, 128, 30, 63, 164, 113, 148, 224, 10, 216, 106, 109,
-			175, 8, 57, 9, 41, 132, 204, 167, 120, 13, 138, 123, 27, 3,
-			160, 130, 175, 163, 40, 72, 207, 209, 118, 221, 154, 83, 111, 57,
-			208, 101, 33, 157, 158, 126, 46, 102, 206, 145, 19, 36, 107, 102,
-			44, 115, 41, 83, 74, 142, 34, 73, 166, 154, 30, 111, 170, 207,
-			43, 120, 18, 153, 59, 149, 57, 103, 228, 47, 64, 53, 57, 107,
-			82, 252, 77, 29, 252, 220, 181, 229, 169, 165, 179, 228, 231, 137,
-			55, 55, 79, 103, 75, 249, 10, 138, 206, 195, 128, 10, 57, 151,
-			36, 72, 174, 157, 73, 64, 83, 6, 145, 174, 77, 200, 73, 237,
-			78, 23, 213, 240, 47, 210, 146, 97, 153, 167, 47, 94, 73, 75,
-			166, 101, 158, 190, 86, 36, 110, 226, 104, 77, 43, 123, 45, 255,
-			115, 112, 19, 122, 60, 10, 39, 243, 209, 7, 117, 162, 64, 170,
-			62, 217, 10, 39, 200, 77, 172, 234, 117, 92, 154, 78, 106, 44,
-			42, 90, 233, 164, 134, 162, 124, 177, 144, 150, 76, 203, 180, 150,
-			175, 146, 63, 55, 72, 118, 33, 99, 229, 46, 100, 174, 26, 249,
-			127, 110, 128, 54, 67, 237, 204, 19, 203, 180, 9, 184, 152, 53,
-			4, 84, 210, 120, 192, 210, 245, 10, 67, 141, 18, 40, 222, 113,
-			41, 79, 33, 244, 58, 83, 56, 212, 35, 53, 172, 167, 175, 184,
-			142, 162, 211, 123, 60, 214, 139, 120, 76, 3, 13, 200, 187, 30,
-			11, 71, 177, 190, 31, 143, 41, 162, 76, 204, 129, 146, 250, 50,
-			208, 67, 26, 1, 235, 2, 67, 38, 82, 106, 52, 40, 233, 117,
-			90, 80, 218, 188, 176, 96, 145, 231, 36, 183, 128, 235, 244, 69,
-			246, 171, 124, 19, 170, 41, 23, 58, 152, 68, 92, 234, 80, 162,
-			237, 16, 197, 180, 9, 180, 85, 137, 9, 173, 101, 188, 174, 66,
-			132, 221, 101, 161, 164, 152, 131, 37, 68, 18, 173, 46, 232, 197,
-			251, 34, 123, 49, 45, 101, 45, 243, 139, 203, 64, 238, 226, 228,
-			134, 101, 94, 202, 90, 249, 178, 222, 9, 199, 234, 4, 151, 110,
-			20, 209, 87, 67, 234, 75, 181, 63, 18, 66, 6, 142, 61, 153,
-			150, 178, 150, 121, 233, 244, 89, 242, 55, 12, 164, 155, 181, 204,
-			66, 246, 39, 121, 129, 198, 151, 18, 234, 123, 66, 67, 247, 148,
-			150, 190, 156, 157, 146, 78, 25, 80, 82, 114, 21, 5, 3, 214,
-			69, 188, 42, 25, 106, 25, 67, 110, 53, 242, 194, 201, 247, 52,
-			80, 238, 62, 113, 204, 218, 4, 108, 116, 39, 83, 246, 148, 104,
-			133, 236, 233, 180, 164, 24, 178, 206, 147, 219, 200, 157, 105, 153,
-			203, 217, 51, 249, 235, 31, 147, 250, 29, 153, 77, 67, 141, 156,
-			150, 178, 150, 185, 124, 234, 52, 41, 146, 108, 206, 176, 114, 165,
-			204, 13, 35, 127, 17, 220, 64, 49, 44, 39, 218, 36, 231, 140,
-			45, 217, 165, 74, 111, 165, 165, 243, 228, 9, 201, 229, 12, 181,
-			250, 229, 236, 249, 252, 35, 84, 212, 17, 203, 212, 14, 216, 38,
-			144, 228, 235, 225, 68, 103, 226, 184, 240, 135, 94, 200, 18, 40,
-			130, 233, 177, 30, 20, 116, 10, 201, 94, 50, 20, 90, 50, 203,
-			217, 165, 180, 100, 88, 102, 249, 196, 233, 180, 100, 90, 102, 217,
-			58, 71, 254, 126, 22, 121, 48, 44, 115, 35, 123, 38, 255, 71,
-			89, 112, 183, 241, 188, 252, 173, 93, 146, 122, 136, 227, 217, 83,
-			9, 213, 145, 22, 22, 129, 142, 195, 219, 91, 229, 228, 34, 56,
-			73, 227, 55, 9, 20, 88, 116, 200, 245, 197, 186, 168, 188, 118,
-			235, 7, 141, 90, 181, 237, 54, 234, 207, 221, 237, 55, 21, 69,
-			70, 84, 94, 239, 55, 119, 159, 59, 173, 90, 117, 207, 217, 126,
-			222, 118, 90, 109, 108, 75, 168, 87, 94, 55, 157, 214, 254, 46,
-			214, 21, 8, 60, 193, 244, 254, 8, 153, 50, 28, 51, 30, 45,
-			109, 58, 18, 23, 55, 193, 113, 248, 82, 70, 37, 41, 115, 108,
-			79, 149, 104, 44, 40, 213, 164, 74, 84, 43, 183, 113, 226, 211,
-			180, 100, 90, 230, 198, 103, 167, 201, 95, 26, 36, 155, 203, 90,
-			185, 205, 204, 47, 140, 252, 95, 24, 144, 24, 229, 209, 75, 162,
-			177, 135, 246, 16, 143, 162, 72, 95, 61, 160, 198, 124, 79, 208,
-			244, 10, 65, 120, 3, 58, 171, 77, 147, 40, 250, 138, 250, 35,
-			101, 251, 44, 154, 237, 6, 69, 77, 148, 113, 165, 210, 183, 58,
-			60, 34, 115, 237, 141, 86, 25, 30, 236, 237, 167, 47, 27, 102,
-			13, 10, 1, 176, 48, 61, 46, 16, 192, 99, 197, 146, 78, 155,
-			66, 175, 151, 6, 18, 101, 17, 155, 75, 167, 201, 223, 86, 80,
-			58, 171, 108, 244, 126, 246, 82, 254, 111, 26, 200, 168, 126, 90,
-			132, 215, 246, 233, 150, 73, 240, 17, 56, 158, 223, 135, 151, 116,
-			178, 162, 13, 115, 232, 177, 248, 136, 26, 136, 74, 237, 189, 129,
-			242, 202, 16, 80, 225, 199, 172, 163, 180, 209, 231, 227, 153, 125,
-			141, 61, 161, 120, 130, 34, 181, 123, 118, 42, 73, 25, 168, 244,
-			237, 82, 178, 46, 89, 140, 78, 247, 179, 63, 73, 75, 134, 101,
-			222, 255, 252, 167, 105, 201, 180, 204, 251, 23, 191, 36, 132, 100,
-			115, 166, 149, 251, 101, 230, 129, 129, 66, 169, 189, 251, 203, 37,
-			139, 252, 138, 228, 114, 166, 146, 169, 150, 61, 155, 255, 5, 52,
-			105, 143, 190, 218, 132, 111, 191, 246, 86, 190, 255, 70, 253, 103,
-			117, 229, 238, 243, 111, 174, 23, 43, 111, 85, 148, 174, 95, 33,
-			240, 216, 123, 5, 33, 141, 122, 178, 191, 9, 183, 110, 36, 236,
-			152, 184, 215, 106, 137, 153, 152, 200, 78, 237, 196, 201, 180, 100,
-			90, 102, 237, 244, 25, 114, 25, 167, 53, 44, 115, 39, 123, 46,
-			111, 29, 161, 180, 126, 243, 214, 148, 148, 178, 184, 157, 41, 41,
-			101, 113, 59, 39, 62, 75, 75, 166, 101, 238, 156, 181, 200, 46,
-			201, 230, 114, 86, 238, 81, 230, 137, 145, 255, 229, 91, 254, 166,
-			51, 234, 129, 76, 80, 34, 76, 1, 31, 96, 198, 120, 164, 45,
-			221, 191, 168, 155, 156, 97, 153, 143, 150, 46, 146, 127, 170, 22,
-			60, 167, 148, 83, 207, 158, 207, 255, 61, 189, 224, 199, 12, 3,
-			159, 199, 250, 233, 87, 48, 189, 168, 82, 225, 48, 53, 223, 178,
-			138, 136, 12, 25, 235, 50, 181, 185, 58, 147, 15, 120, 144, 31,
-			226, 224, 6, 60, 226, 177, 199, 194, 212, 193, 229, 80, 233, 245,
-			68, 83, 57, 84, 122, 61, 113, 112, 57, 84, 122, 221, 58, 71,
-			254, 87, 22, 229, 49, 44, 243, 32, 251, 7, 249, 255, 150, 125,
-			87, 158, 153, 138, 254, 159, 138, 228, 234, 157, 113, 156, 234, 152,
-			128, 84, 152, 228, 13, 13, 211, 135, 115, 51, 86, 188, 228, 46,
-			117, 36, 104, 12, 99, 60, 5, 19, 148, 2, 147, 101, 192, 93,
-			81, 112, 21, 64, 254, 133, 10, 129, 191, 216, 9, 189, 151, 44,
-			162, 66, 20, 244, 107, 187, 121, 218, 200, 0, 153, 113, 48, 140,
-			57, 158, 208, 232, 189, 85, 240, 19, 60, 92, 40, 225, 125, 41,
-			151, 233, 153, 110, 25, 58, 35, 197, 133, 24, 13, 244, 121, 166,
-			66, 179, 201, 147, 22, 26, 204, 221, 12, 43, 106, 215, 4, 60,
-			209, 112, 28, 124, 30, 117, 89, 111, 164, 161, 211, 116, 161, 148,
-			73, 31, 76, 23, 74, 153, 244, 193, 9, 43, 45, 153, 150, 121,
-			240, 147, 207, 201, 175, 73, 54, 183, 96, 229, 158, 101, 168, 145,
-			119, 222, 50, 233, 97, 154, 169, 104, 191, 224, 133, 130, 3, 190,
-			105, 211, 176, 171, 80, 251, 53, 52, 71, 81, 65, 57, 179, 66,
-			237, 0, 191, 19, 164, 149, 91, 48, 44, 243, 217, 210, 231, 228,
-			31, 40, 187, 94, 80, 118, 253, 109, 246, 124, 254, 143, 181, 93,
-			39, 235, 161, 47, 83, 61, 49, 125, 251, 51, 140, 185, 79, 133,
-			72, 100, 156, 155, 251, 7, 154, 106, 56, 242, 217, 138, 127, 88,
-			64, 7, 189, 187, 95, 115, 161, 198, 7, 138, 196, 1, 141, 149,
-			2, 99, 2, 69, 93, 125, 144, 122, 180, 5, 180, 230, 111, 19,
-			37, 45, 160, 53, 127, 155, 88, 243, 2, 90, 243, 183, 214, 57,
-			242, 111, 180, 20, 134, 101, 6, 217, 51, 249, 127, 105, 28, 209,
-			211, 113, 220, 186, 111, 87, 207, 76, 48, 97, 224, 72, 128, 78,
-			115, 158, 84, 148, 77, 2, 0, 133, 215, 170, 235, 243, 189, 102,
-			227, 145, 83, 107, 191, 169, 232, 98, 237, 0, 3, 176, 182, 71,
-			236, 166, 115, 182, 59, 119, 239, 220, 185, 179, 118, 247, 198, 173,
-			141, 59, 55, 111, 172, 172, 173, 116, 239, 222, 184, 189, 177, 222,
-			165, 235, 171, 171, 55, 111, 117, 131, 181, 194, 84, 96, 101, 21,
-			193, 84, 96, 101, 21, 65, 18, 90, 23, 208, 42, 130, 207, 78,
-			79, 79, 45, 254, 213, 121, 114, 231, 125, 57, 33, 222, 237, 71,
-			94, 88, 241, 130, 1, 139, 146, 20, 17, 191, 147, 3, 140, 207,
-			211, 76, 62, 237, 105, 99, 107, 254, 67, 127, 19, 147, 255, 221,
-			14, 73, 10, 127, 110, 144, 159, 58, 175, 134, 60, 150, 115, 176,
-			84, 52, 245, 99, 89, 149, 209, 199, 212, 11, 211, 212, 90, 23,
-			172, 159, 145, 83, 126, 200, 71, 193, 243, 100, 31, 37, 73, 246,
-			73, 172, 220, 211, 117, 214, 5, 242, 73, 224, 73, 79, 80, 121,
-			193, 196, 230, 180, 168, 136, 226, 83, 135, 11, 57, 77, 20, 11,
-			214, 13, 66, 84, 52, 127, 142, 201, 220, 133, 69, 60, 63, 249,
-			201, 252, 89, 198, 244, 120, 166, 121, 66, 166, 159, 235, 191, 37,
-			11, 85, 165, 19, 203, 35, 214, 187, 98, 88, 107, 246, 241, 42,
-			180, 223, 43, 114, 254, 243, 119, 206, 108, 240, 233, 109, 33, 179,
-			117, 235, 217, 141, 223, 101, 41, 239, 225, 247, 176, 243, 232, 207,
-			206, 232, 68, 255, 214, 95, 209, 68, 255, 242, 44, 209, 95, 198,
-			79, 195, 50, 79, 100, 110, 39, 57, 255, 167, 153, 95, 165, 57,
-			191, 250, 252, 175, 6, 201, 46, 102, 172, 220, 217, 204, 23, 70,
-			254, 63, 27, 128, 171, 3, 124, 136, 71, 184, 83, 119, 59, 240,
-			88, 36, 61, 22, 209, 88, 167, 131, 54, 129, 167, 201, 123, 112,
-			63, 77, 118, 171, 123, 174, 72, 238, 20, 154, 123, 53, 112, 94,
-			13, 67, 30, 211, 120, 147, 192, 245, 233, 219, 90, 191, 207, 135,
-			98, 37, 89, 133, 149, 128, 30, 218, 222, 112, 40, 134, 92, 226,
-			115, 151, 120, 232, 211, 100, 84, 37, 121, 186, 45, 42, 200, 71,
-			64, 15, 223, 75, 230, 7, 146, 24, 198, 60, 64, 87, 189, 168,
-			92, 222, 217, 165, 83, 228, 207, 76, 146, 91, 196, 172, 56, 159,
-			61, 200, 255, 35, 19, 222, 53, 50, 144, 49, 235, 245, 148, 212,
-			199, 181, 121, 226, 37, 62, 52, 162, 216, 134, 241, 153, 164, 16,
-			85, 232, 184, 78, 231, 130, 25, 238, 155, 228, 210, 70, 239, 105,
-			12, 247, 162, 12, 157, 239, 82, 26, 211, 155, 40, 8, 120, 68,
-			193, 27, 73, 62, 240, 36, 83, 74, 158, 40, 3, 241, 99, 30,
-			193, 111, 121, 39, 77, 207, 149, 166, 143, 164, 232, 42, 132, 122,
-			254, 75, 101, 19, 161, 126, 29, 173, 15, 69, 194, 152, 122, 193,
-			68, 89, 78, 186, 166, 173, 161, 23, 69, 52, 198, 115, 241, 45,
-			214, 251, 245, 136, 198, 19, 27, 92, 9, 1, 167, 34, 186, 38,
-			97, 204, 227, 151, 192, 186, 243, 175, 241, 1, 69, 198, 21, 81,
-			164, 147, 55, 16, 9, 69, 50, 75, 226, 122, 84, 32, 108, 23,
-			210, 139, 85, 86, 171, 226, 131, 24, 249, 253, 25, 157, 152, 161,
-			228, 99, 138, 175, 167, 240, 198, 45, 80, 89, 58, 222, 177, 145,
-			196, 12, 171, 123, 174, 126, 85, 37, 181, 51, 95, 212, 71, 12,
-			249, 197, 11, 105, 41, 107, 153, 249, 159, 174, 167, 37, 211, 50,
-			243, 247, 155, 136, 199, 51, 86, 238, 75, 181, 131, 211, 211, 170,
-			47, 151, 190, 34, 219, 233, 105, 213, 229, 236, 185, 252, 109, 29,
-			152, 154, 202, 67, 218, 160, 22, 118, 182, 116, 233, 45, 6, 186,
-			207, 52, 63, 231, 241, 52, 63, 215, 87, 4, 230, 229, 36, 168,
-			104, 174, 46, 39, 232, 89, 243, 113, 249, 172, 69, 14, 211, 83,
-			171, 43, 217, 47, 242, 108, 170, 228, 228, 225, 216, 81, 195, 153,
-			183, 27, 157, 168, 41, 172, 134, 29, 31, 239, 183, 218, 128, 88,
-			164, 67, 241, 21, 244, 12, 24, 105, 6, 143, 3, 67, 120, 94,
-			111, 94, 153, 114, 168, 194, 222, 149, 19, 159, 207, 29, 113, 93,
-			249, 105, 158, 124, 138, 28, 102, 45, 115, 57, 73, 113, 50, 217,
-			236, 130, 42, 165, 195, 20, 247, 203, 39, 206, 164, 37, 211, 50,
-			151, 207, 157, 79, 134, 153, 150, 121, 53, 123, 46, 105, 50, 23,
-			84, 41, 29, 166, 92, 206, 213, 169, 62, 76, 213, 243, 172, 69,
-			254, 217, 2, 142, 203, 89, 230, 205, 236, 213, 252, 159, 228, 240,
-			202, 107, 238, 148, 81, 165, 124, 104, 177, 92, 39, 164, 83, 149,
-			131, 147, 156, 216, 99, 114, 189, 139, 7, 241, 115, 91, 165, 59,
-			10, 67, 232, 243, 145, 114, 191, 174, 77, 109, 69, 105, 50, 215,
-			190, 186, 185, 186, 90, 134, 181, 205, 213, 85, 176, 109, 155, 64,
-			67, 217, 216, 152, 161, 111, 165, 19, 24, 171, 173, 210, 161, 32,
-			227, 81, 164, 175, 114, 147, 173, 59, 71, 151, 16, 168, 115, 153,
-			56, 99, 170, 146, 207, 152, 143, 241, 152, 201, 3, 65, 85, 174,
-			41, 147, 75, 193, 244, 9, 29, 222, 182, 11, 246, 61, 250, 120,
-			124, 0, 206, 195, 48, 185, 174, 86, 252, 39, 235, 221, 249, 46,
-			145, 51, 182, 161, 138, 71, 67, 117, 126, 136, 241, 165, 60, 155,
-			71, 13, 247, 88, 36, 96, 13, 217, 81, 59, 83, 246, 85, 95,
-			165, 174, 25, 250, 154, 205, 15, 98, 232, 69, 250, 105, 99, 122,
-			252, 169, 135, 106, 20, 166, 188, 6, 74, 45, 250, 94, 28, 36,
-			182, 174, 198, 17, 96, 145, 218, 138, 211, 199, 246, 98, 224, 133,
-			97, 114, 233, 173, 67, 189, 126, 103, 144, 76, 144, 240, 163, 86,
-			69, 248, 125, 26, 140, 66, 74, 222, 239, 42, 241, 24, 65, 13,
-			78, 22, 59, 37, 206, 35, 42, 108, 178, 254, 71, 198, 156, 142,
-			19, 52, 169, 47, 215, 161, 203, 104, 24, 160, 163, 75, 254, 52,
-			98, 182, 67, 209, 159, 216, 176, 69, 125, 15, 255, 46, 169, 207,
-			4, 153, 9, 168, 171, 142, 144, 138, 249, 224, 184, 125, 3, 244,
-			85, 114, 227, 165, 194, 91, 98, 185, 185, 69, 101, 171, 233, 174,
-			81, 185, 235, 205, 63, 248, 42, 45, 153, 150, 121, 243, 202, 114,
-			10, 29, 255, 79, 0, 0, 0, 255, 255, 108, 175, 136, 55, 253,
-			60, 0, 0},
+			8, 0, 0, 0, 0, 0, 0, 255, 236, 125, 11, 144, 28, 199,
+			117, 216, 206, 231, 22, 123, 13, 28, 238, 208, 0, 73, 96, 1,
+			129, 141, 37, 1, 220, 1, 123, 187, 247, 1, 64, 2, 32, 24,
+			221, 103, 15, 88, 234, 112, 119, 218, 221, 3, 72, 48, 212, 97,
+			118, 167, 247, 110, 136, 221, 153, 213, 204, 236, 29, 142, 48, 149,
+			72, 178, 171, 44, 187, 228, 146, 171, 162, 200, 86, 89, 178, 165,
+			72, 81, 36, 167, 172, 216, 86, 57, 114, 217, 178, 75, 76, 252,
+			11, 93, 114, 202, 182, 98, 69, 159, 146, 171, 108, 203, 81, 57,
+			76, 88, 37, 91, 142, 202, 145, 146, 122, 175, 187, 103, 118, 239,
+			246, 240, 145, 92, 137, 93, 69, 22, 69, 109, 207, 116, 191, 247,
+			250, 245, 235, 247, 94, 191, 247, 122, 142, 252, 231, 227, 228, 232,
+			170, 231, 173, 54, 120, 190, 229, 123, 161, 87, 109, 215, 243, 118,
+			219, 183, 66, 199, 115, 115, 248, 132, 14, 138, 247, 57, 245, 62,
+			115, 129, 164, 102, 101, 23, 122, 144, 236, 10, 120, 205, 115, 237,
+			224, 160, 198, 180, 97, 163, 164, 154, 244, 0, 233, 115, 45, 215,
+			11, 14, 234, 76, 27, 238, 43, 137, 198, 244, 15, 145, 253, 53,
+			175, 153, 219, 2, 114, 122, 64, 1, 92, 130, 39, 75, 218, 141,
+			83, 171, 78, 184, 214, 174, 230, 106, 94, 51, 191, 234, 53, 44,
+			119, 53, 166, 175, 21, 110, 182, 120, 16, 145, 249, 191, 52, 237,
+			163, 186, 113, 121, 105, 250, 83, 250, 209, 203, 2, 238, 146, 132,
+			155, 187, 206, 27, 141, 183, 184, 222, 134, 91, 129, 33, 207, 124,
+			249, 49, 146, 164, 230, 209, 68, 160, 145, 223, 223, 67, 180, 61,
+			212, 56, 154, 160, 19, 159, 223, 195, 112, 64, 205, 107, 176, 233,
+			118, 189, 206, 253, 128, 141, 50, 1, 234, 100, 192, 108, 43, 180,
+			152, 227, 134, 220, 175, 173, 89, 238, 42, 103, 117, 207, 111, 90,
+			33, 97, 51, 94, 107, 211, 119, 86, 215, 66, 54, 49, 54, 246,
+			164, 28, 192, 138, 110, 45, 199, 216, 84, 163, 193, 240, 93, 192,
+			124, 30, 112, 127, 157, 219, 57, 194, 214, 194, 176, 21, 92, 200,
+			231, 109, 190, 206, 27, 94, 139, 251, 129, 226, 4, 204, 179, 37,
+			137, 24, 173, 10, 34, 242, 132, 176, 18, 183, 157, 32, 244, 157,
+			106, 27, 184, 195, 44, 215, 102, 237, 128, 51, 199, 101, 129, 215,
+			246, 107, 28, 159, 84, 29, 215, 242, 55, 145, 174, 32, 203, 54,
+			156, 112, 141, 121, 62, 254, 191, 215, 14, 9, 107, 122, 182, 83,
+			119, 106, 200, 172, 44, 179, 124, 206, 90, 220, 111, 58, 97, 200,
+			109, 214, 242, 189, 117, 199, 230, 54, 11, 215, 172, 144, 133, 107,
+			48, 187, 70, 195, 219, 112, 220, 85, 6, 203, 234, 192, 160, 0,
+			6, 17, 214, 228, 225, 5, 66, 24, 252, 115, 106, 11, 97, 1,
+			243, 234, 138, 162, 154, 103, 115, 214, 108, 7, 33, 243, 121, 104,
+			57, 46, 66, 181, 170, 222, 58, 188, 146, 28, 35, 204, 245, 66,
+			167, 198, 179, 44, 92, 115, 2, 214, 112, 130, 16, 32, 116, 98,
+			116, 237, 45, 228, 216, 78, 80, 107, 88, 78, 147, 251, 185, 157,
+			136, 112, 220, 78, 94, 40, 34, 90, 190, 103, 183, 107, 60, 166,
+			131, 196, 132, 252, 64, 116, 16, 38, 103, 103, 123, 181, 118, 147,
+			187, 161, 165, 22, 41, 239, 249, 204, 11, 215, 184, 207, 154, 86,
+			200, 125, 199, 106, 4, 49, 171, 97, 97, 0, 38, 97, 157, 212,
+			71, 147, 90, 224, 14, 142, 4, 192, 174, 213, 228, 64, 80, 167,
+			108, 185, 94, 252, 14, 249, 238, 132, 1, 204, 200, 21, 160, 60,
+			63, 96, 77, 107, 147, 85, 57, 72, 138, 205, 66, 143, 113, 215,
+			246, 252, 128, 131, 80, 180, 124, 175, 233, 133, 28, 136, 177, 219,
+			181, 48, 96, 54, 247, 157, 117, 110, 179, 186, 239, 53, 137, 224,
+			66, 224, 213, 195, 13, 16, 19, 41, 65, 44, 104, 241, 26, 72,
+			16, 107, 249, 14, 8, 150, 15, 178, 227, 10, 41, 10, 2, 164,
+			157, 176, 202, 149, 98, 153, 149, 23, 231, 42, 215, 167, 74, 5,
+			86, 44, 179, 165, 210, 226, 181, 226, 108, 97, 150, 77, 63, 199,
+			42, 87, 10, 108, 102, 113, 233, 185, 82, 241, 242, 149, 10, 187,
+			178, 56, 63, 91, 40, 149, 217, 212, 194, 44, 155, 89, 92, 168,
+			148, 138, 211, 203, 149, 197, 82, 153, 176, 204, 84, 153, 21, 203,
+			25, 124, 51, 181, 240, 28, 43, 60, 187, 84, 42, 148, 203, 108,
+			177, 196, 138, 87, 151, 230, 139, 133, 89, 118, 125, 170, 84, 154,
+			90, 168, 20, 11, 229, 44, 43, 46, 204, 204, 47, 207, 22, 23,
+			46, 103, 217, 244, 114, 133, 45, 44, 86, 8, 155, 47, 94, 45,
+			86, 10, 179, 172, 178, 152, 69, 180, 219, 199, 177, 197, 57, 118,
+			181, 80, 154, 185, 50, 181, 80, 153, 154, 46, 206, 23, 43, 207,
+			33, 194, 185, 98, 101, 1, 144, 205, 45, 150, 8, 155, 98, 75,
+			83, 165, 74, 113, 102, 121, 126, 170, 196, 150, 150, 75, 75, 139,
+			229, 2, 131, 153, 205, 22, 203, 51, 243, 83, 197, 171, 133, 217,
+			28, 43, 46, 176, 133, 69, 86, 184, 86, 88, 168, 176, 242, 149,
+			169, 249, 249, 238, 137, 18, 182, 120, 125, 161, 80, 2, 234, 59,
+			167, 201, 166, 11, 108, 190, 56, 53, 61, 95, 96, 115, 139, 37,
+			156, 231, 108, 177, 84, 152, 169, 192, 132, 226, 95, 51, 197, 217,
+			194, 66, 101, 106, 62, 75, 88, 121, 169, 48, 83, 156, 154, 207,
+			178, 194, 179, 133, 171, 75, 243, 83, 165, 231, 178, 18, 104, 185,
+			240, 214, 229, 194, 66, 165, 56, 53, 207, 102, 167, 174, 78, 93,
+			46, 148, 217, 240, 189, 184, 178, 84, 90, 156, 89, 46, 21, 174,
+			2, 213, 139, 115, 172, 188, 60, 93, 174, 20, 43, 203, 149, 2,
+			187, 188, 184, 56, 139, 204, 46, 23, 74, 215, 138, 51, 133, 242,
+			69, 54, 191, 8, 236, 159, 99, 203, 229, 66, 150, 176, 217, 169,
+			202, 20, 162, 94, 42, 45, 206, 21, 43, 229, 139, 240, 123, 122,
+			185, 92, 68, 198, 21, 23, 42, 133, 82, 105, 121, 169, 82, 92,
+			92, 24, 97, 87, 22, 175, 23, 174, 21, 74, 108, 102, 106, 185,
+			92, 152, 69, 14, 47, 46, 192, 108, 65, 86, 10, 139, 165, 231,
+			0, 44, 240, 1, 87, 32, 203, 174, 95, 41, 84, 174, 20, 74,
+			192, 84, 228, 214, 20, 176, 161, 92, 41, 21, 103, 42, 157, 221,
+			22, 75, 172, 178, 88, 170, 144, 142, 121, 178, 133, 194, 229, 249,
+			226, 229, 194, 194, 76, 1, 232, 89, 4, 48, 215, 139, 229, 194,
+			8, 155, 42, 21, 203, 208, 161, 136, 136, 217, 245, 169, 231, 216,
+			226, 50, 206, 26, 22, 106, 185, 92, 32, 226, 119, 135, 232, 102,
+			113, 61, 89, 113, 142, 77, 205, 94, 43, 2, 229, 178, 247, 210,
+			98, 185, 92, 148, 226, 130, 108, 155, 185, 34, 121, 158, 35, 36,
+			69, 52, 157, 26, 44, 113, 16, 126, 165, 168, 145, 73, 92, 36,
+			253, 68, 79, 29, 23, 63, 197, 195, 199, 18, 143, 226, 195, 71,
+			197, 79, 241, 240, 241, 196, 20, 62, 220, 45, 126, 138, 135, 199,
+			19, 89, 124, 168, 137, 159, 226, 225, 137, 68, 14, 31, 202, 159,
+			226, 225, 201, 68, 6, 31, 18, 241, 83, 60, 28, 78, 28, 195,
+			135, 143, 139, 159, 31, 30, 34, 186, 153, 160, 102, 61, 17, 104,
+			233, 159, 28, 98, 83, 76, 153, 91, 212, 142, 60, 224, 110, 24,
+			48, 139, 5, 206, 170, 203, 237, 44, 171, 59, 183, 185, 61, 218,
+			224, 238, 106, 184, 198, 130, 150, 229, 130, 150, 9, 157, 38, 143,
+			187, 115, 155, 48, 11, 198, 212, 188, 182, 139, 186, 91, 218, 125,
+			84, 152, 117, 223, 170, 197, 102, 65, 189, 8, 25, 250, 0, 216,
+			36, 96, 22, 189, 134, 208, 124, 172, 24, 50, 7, 180, 183, 205,
+			91, 220, 181, 185, 0, 104, 185, 155, 172, 102, 53, 184, 107, 91,
+			62, 66, 173, 121, 110, 141, 183, 66, 80, 211, 183, 56, 203, 216,
+			214, 102, 134, 128, 78, 203, 52, 61, 55, 92, 203, 40, 48, 62,
+			111, 88, 96, 218, 66, 143, 85, 156, 38, 15, 66, 171, 217, 18,
+			138, 90, 90, 56, 219, 1, 243, 202, 221, 26, 103, 85, 30, 110,
+			112, 238, 18, 22, 110, 116, 246, 94, 183, 26, 109, 30, 0, 48,
+			43, 102, 21, 144, 224, 132, 172, 102, 185, 172, 202, 153, 101, 131,
+			42, 247, 124, 22, 180, 171, 33, 76, 23, 56, 2, 74, 148, 89,
+			49, 160, 28, 43, 161, 199, 0, 128, 90, 45, 223, 187, 237, 128,
+			57, 104, 108, 178, 211, 163, 227, 99, 217, 177, 177, 49, 182, 201,
+			45, 63, 200, 17, 194, 30, 99, 133, 219, 86, 179, 213, 224, 1,
+			33, 234, 39, 27, 191, 192, 102, 188, 102, 171, 29, 242, 152, 12,
+			196, 209, 69, 46, 112, 142, 181, 2, 222, 182, 61, 52, 190, 57,
+			105, 164, 163, 14, 44, 8, 45, 63, 100, 151, 88, 46, 151, 187,
+			184, 245, 29, 119, 237, 174, 55, 17, 34, 229, 95, 169, 183, 98,
+			160, 122, 154, 83, 203, 122, 9, 204, 75, 212, 26, 21, 184, 84,
+			251, 226, 150, 65, 40, 0, 114, 136, 248, 173, 6, 96, 75, 33,
+			113, 234, 108, 120, 27, 162, 167, 216, 24, 59, 113, 98, 43, 172,
+			167, 217, 216, 8, 187, 35, 134, 245, 160, 238, 244, 37, 54, 126,
+			113, 219, 91, 137, 250, 18, 27, 31, 83, 255, 200, 78, 47, 51,
+			222, 8, 120, 111, 2, 158, 238, 73, 192, 83, 119, 39, 96, 244,
+			46, 4, 156, 238, 69, 64, 199, 242, 79, 196, 203, 31, 175, 23,
+			174, 127, 220, 60, 29, 75, 198, 131, 75, 193, 142, 107, 189, 179,
+			140, 136, 129, 157, 75, 126, 169, 123, 201, 217, 233, 120, 154, 242,
+			145, 132, 23, 47, 186, 26, 34, 217, 16, 15, 216, 38, 5, 241,
+			152, 110, 62, 119, 201, 92, 39, 139, 227, 1, 167, 239, 190, 188,
+			113, 199, 167, 59, 59, 238, 128, 227, 116, 111, 28, 163, 247, 88,
+			193, 201, 157, 54, 176, 109, 133, 28, 52, 106, 14, 254, 99, 243,
+			6, 30, 49, 216, 210, 102, 184, 38, 188, 41, 0, 20, 194, 198,
+			220, 222, 113, 216, 182, 54, 131, 75, 147, 89, 214, 116, 220, 118,
+			200, 131, 75, 227, 99, 35, 221, 219, 140, 93, 138, 176, 13, 111,
+			121, 149, 155, 243, 189, 102, 37, 2, 21, 218, 35, 168, 123, 158,
+			41, 47, 46, 176, 171, 86, 171, 229, 184, 171, 132, 176, 162, 43,
+			158, 192, 137, 194, 10, 193, 73, 239, 160, 31, 78, 95, 160, 26,
+			185, 11, 98, 102, 11, 51, 0, 94, 184, 187, 202, 124, 75, 186,
+			174, 22, 232, 75, 194, 188, 234, 139, 188, 22, 102, 217, 198, 26,
+			247, 133, 3, 46, 59, 114, 88, 56, 233, 61, 7, 237, 122, 221,
+			185, 205, 50, 65, 134, 13, 59, 174, 141, 39, 21, 119, 85, 217,
+			141, 17, 208, 253, 4, 16, 182, 124, 94, 227, 128, 177, 186, 137,
+			227, 220, 118, 179, 202, 253, 14, 19, 35, 207, 62, 177, 149, 9,
+			24, 191, 13, 246, 13, 252, 96, 43, 0, 253, 44, 236, 146, 213,
+			80, 67, 114, 108, 206, 243, 25, 23, 27, 46, 203, 38, 213, 115,
+			1, 105, 172, 195, 98, 5, 44, 88, 243, 218, 13, 155, 85, 57,
+			137, 230, 238, 116, 49, 10, 88, 145, 153, 12, 50, 48, 95, 167,
+			193, 59, 160, 129, 237, 24, 239, 0, 38, 97, 17, 176, 36, 49,
+			137, 189, 160, 229, 148, 116, 141, 3, 92, 128, 179, 5, 42, 97,
+			77, 167, 230, 119, 195, 189, 95, 176, 227, 65, 38, 71, 240, 31,
+			195, 76, 104, 212, 168, 167, 134, 200, 87, 53, 98, 154, 9, 61,
+			65, 141, 23, 245, 3, 233, 47, 104, 172, 140, 94, 65, 132, 20,
+			92, 129, 53, 222, 229, 22, 228, 216, 85, 56, 105, 85, 185, 144,
+			237, 209, 201, 241, 179, 217, 179, 79, 156, 3, 3, 7, 255, 35,
+			112, 6, 57, 189, 229, 33, 115, 220, 90, 163, 29, 56, 235, 60,
+			199, 22, 188, 144, 95, 0, 168, 1, 103, 85, 175, 141, 83, 243,
+			225, 180, 136, 59, 71, 156, 77, 46, 16, 118, 110, 12, 136, 200,
+			55, 29, 151, 157, 130, 70, 211, 113, 243, 107, 62, 59, 197, 38,
+			206, 176, 53, 63, 111, 91, 155, 236, 20, 155, 60, 119, 54, 55,
+			113, 150, 193, 30, 201, 131, 113, 101, 167, 196, 14, 21, 150, 150,
+			144, 61, 164, 15, 102, 215, 7, 211, 219, 165, 90, 26, 53, 94,
+			76, 13, 170, 150, 65, 141, 23, 233, 126, 242, 110, 3, 25, 161,
+			81, 195, 215, 105, 250, 111, 116, 197, 136, 46, 231, 198, 146, 124,
+			233, 246, 110, 58, 156, 155, 78, 126, 145, 152, 97, 106, 55, 5,
+			172, 193, 131, 64, 108, 24, 207, 229, 17, 52, 191, 203, 215, 18,
+			210, 104, 177, 49, 194, 110, 202, 117, 184, 201, 234, 14, 111, 216,
+			176, 57, 152, 197, 90, 94, 224, 132, 206, 58, 30, 241, 92, 190,
+			106, 225, 239, 155, 72, 144, 236, 40, 4, 93, 169, 129, 0, 73,
+			233, 64, 232, 249, 172, 233, 249, 60, 203, 44, 230, 122, 238, 232,
+			75, 220, 247, 132, 23, 4, 162, 13, 75, 211, 13, 77, 28, 173,
+			171, 156, 68, 211, 131, 131, 42, 248, 143, 32, 94, 216, 189, 155,
+			206, 173, 34, 114, 254, 252, 249, 172, 252, 159, 16, 143, 142, 7,
+			29, 162, 161, 214, 75, 235, 131, 85, 80, 235, 165, 193, 154, 164,
+			6, 84, 203, 160, 134, 63, 180, 175, 154, 196, 248, 201, 36, 249,
+			77, 74, 14, 111, 13, 105, 241, 102, 43, 220, 220, 41, 158, 181,
+			139, 244, 21, 224, 253, 244, 203, 189, 131, 83, 4, 223, 170, 200,
+			148, 122, 45, 162, 82, 57, 207, 239, 136, 76, 129, 106, 12, 242,
+			183, 92, 111, 195, 21, 40, 91, 213, 7, 136, 78, 125, 111, 72,
+			68, 167, 38, 135, 222, 136, 78, 189, 17, 157, 122, 35, 58, 245,
+			70, 116, 234, 141, 232, 212, 27, 209, 169, 255, 135, 209, 169, 130,
+			10, 68, 61, 150, 40, 200, 135, 143, 199, 129, 168, 199, 163, 64,
+			212, 241, 196, 105, 21, 136, 130, 159, 42, 58, 21, 5, 162, 78,
+			68, 129, 168, 147, 113, 32, 10, 126, 170, 232, 84, 20, 6, 131,
+			159, 127, 167, 99, 116, 202, 152, 76, 12, 165, 255, 167, 206, 166,
+			216, 42, 119, 185, 239, 212, 24, 90, 80, 214, 228, 65, 96, 173,
+			130, 126, 180, 66, 182, 233, 181, 49, 0, 227, 243, 81, 72, 131,
+			132, 30, 179, 214, 61, 199, 102, 54, 175, 59, 46, 88, 5, 187,
+			221, 106, 56, 53, 11, 163, 49, 93, 227, 81, 253, 110, 122, 109,
+			159, 77, 45, 21, 131, 28, 155, 98, 225, 102, 203, 169, 89, 13,
+			229, 252, 195, 9, 35, 244, 64, 43, 49, 39, 84, 94, 140, 207,
+			223, 222, 230, 65, 136, 97, 38, 209, 14, 90, 158, 11, 152, 225,
+			16, 4, 254, 159, 11, 240, 32, 53, 178, 230, 73, 31, 203, 113,
+			131, 208, 114, 107, 92, 89, 35, 200, 254, 56, 53, 206, 230, 60,
+			47, 62, 91, 250, 173, 26, 155, 182, 252, 225, 45, 190, 70, 14,
+			93, 141, 17, 230, 243, 176, 237, 187, 1, 219, 225, 125, 199, 73,
+			179, 178, 198, 197, 17, 36, 114, 23, 229, 41, 211, 243, 217, 77,
+			132, 118, 19, 102, 38, 120, 129, 29, 197, 153, 140, 221, 188, 243,
+			242, 205, 92, 236, 250, 79, 166, 6, 34, 15, 234, 143, 242, 228,
+			209, 173, 30, 84, 168, 130, 1, 59, 121, 81, 23, 73, 127, 20,
+			48, 120, 224, 180, 224, 59, 122, 123, 94, 123, 35, 136, 202, 251,
+			58, 125, 239, 188, 96, 68, 233, 3, 184, 94, 255, 117, 148, 236,
+			162, 125, 71, 19, 239, 209, 222, 200, 12, 190, 145, 25, 124, 35,
+			51, 248, 70, 102, 240, 141, 204, 224, 27, 153, 193, 255, 239, 153,
+			193, 233, 56, 51, 56, 45, 31, 238, 144, 25, 204, 199, 153, 193,
+			252, 3, 100, 6, 191, 117, 24, 125, 175, 190, 119, 128, 229, 75,
+			255, 197, 97, 54, 213, 17, 245, 143, 60, 10, 8, 240, 182, 60,
+			199, 13, 65, 141, 130, 121, 237, 149, 170, 195, 231, 47, 65, 72,
+			201, 243, 89, 195, 171, 89, 13, 18, 165, 239, 178, 221, 193, 226,
+			7, 201, 25, 146, 222, 97, 181, 28, 58, 62, 2, 144, 202, 249,
+			65, 204, 11, 60, 66, 151, 241, 150, 87, 91, 131, 144, 220, 114,
+			101, 134, 53, 29, 219, 5, 203, 194, 60, 151, 176, 103, 44, 183,
+			13, 39, 240, 241, 44, 27, 63, 255, 196, 88, 86, 233, 233, 150,
+			239, 53, 120, 43, 116, 106, 236, 178, 207, 87, 61, 223, 177, 220,
+			56, 249, 184, 177, 230, 212, 214, 24, 191, 29, 98, 212, 26, 245,
+			115, 143, 94, 85, 171, 118, 107, 195, 242, 161, 135, 135, 209, 70,
+			230, 185, 28, 206, 158, 16, 109, 145, 177, 122, 180, 177, 34, 142,
+			137, 19, 111, 120, 238, 106, 142, 205, 115, 171, 21, 79, 217, 231,
+			44, 19, 52, 185, 229, 115, 59, 195, 2, 79, 56, 190, 174, 199,
+			26, 220, 106, 17, 217, 141, 133, 86, 85, 184, 172, 46, 199, 144,
+			56, 68, 233, 48, 10, 212, 2, 211, 10, 28, 202, 178, 118, 0,
+			70, 201, 98, 207, 79, 156, 25, 93, 3, 207, 183, 225, 184, 220,
+			242, 9, 67, 232, 47, 12, 223, 221, 231, 128, 245, 204, 99, 207,
+			145, 156, 244, 51, 125, 149, 204, 4, 147, 192, 198, 198, 198, 198,
+			71, 241, 223, 202, 216, 216, 5, 252, 247, 6, 76, 253, 252, 249,
+			243, 231, 71, 199, 39, 70, 39, 199, 43, 19, 147, 23, 206, 158,
+			191, 112, 246, 124, 238, 188, 250, 231, 70, 142, 77, 111, 98, 242,
+			55, 244, 157, 90, 8, 4, 134, 114, 138, 8, 61, 203, 54, 56,
+			227, 110, 208, 246, 165, 199, 191, 193, 209, 225, 175, 121, 238, 58,
+			247, 67, 128, 15, 118, 23, 9, 120, 190, 52, 55, 67, 216, 228,
+			228, 228, 249, 120, 46, 27, 27, 27, 57, 135, 135, 117, 140, 203,
+			249, 245, 90, 222, 175, 215, 160, 71, 46, 188, 29, 142, 64, 176,
+			76, 101, 32, 238, 43, 233, 26, 239, 5, 68, 184, 180, 88, 46,
+			62, 203, 110, 2, 103, 134, 71, 110, 110, 79, 176, 69, 158, 167,
+			244, 207, 163, 118, 46, 224, 225, 138, 92, 224, 97, 120, 58, 188,
+			176, 60, 63, 63, 50, 210, 179, 31, 202, 251, 240, 216, 200, 197,
+			14, 154, 38, 238, 69, 211, 42, 15, 1, 174, 87, 183, 173, 205,
+			14, 218, 130, 208, 111, 215, 66, 68, 176, 110, 53, 88, 184, 46,
+			49, 118, 117, 63, 17, 174, 103, 25, 18, 116, 241, 251, 157, 210,
+			122, 46, 92, 135, 9, 222, 109, 70, 162, 83, 59, 224, 53, 25,
+			147, 31, 185, 216, 59, 83, 182, 101, 134, 215, 29, 119, 114, 130,
+			221, 188, 204, 195, 242, 102, 16, 114, 204, 94, 77, 5, 115, 78,
+			131, 87, 186, 23, 98, 174, 56, 95, 168, 20, 175, 22, 88, 61,
+			148, 100, 236, 52, 230, 68, 61, 84, 148, 46, 23, 23, 42, 231,
+			206, 176, 208, 169, 221, 130, 188, 228, 240, 240, 176, 120, 50, 82,
+			15, 115, 246, 198, 21, 103, 117, 109, 214, 10, 113, 212, 8, 123,
+			234, 41, 54, 57, 49, 194, 126, 136, 225, 187, 121, 111, 67, 189,
+			82, 124, 203, 231, 217, 20, 187, 238, 184, 182, 183, 17, 32, 72,
+			216, 44, 227, 99, 93, 105, 164, 92, 212, 65, 104, 169, 241, 115,
+			219, 183, 81, 4, 13, 134, 143, 159, 59, 115, 230, 204, 19, 147,
+			231, 198, 98, 181, 81, 229, 117, 207, 231, 108, 217, 117, 110, 75,
+			93, 7, 202, 108, 43, 148, 220, 247, 183, 152, 195, 98, 254, 108,
+			120, 24, 102, 16, 176, 124, 148, 226, 28, 97, 163, 157, 228, 220,
+			67, 130, 1, 206, 228, 68, 12, 231, 120, 7, 28, 20, 128, 145,
+			46, 1, 56, 179, 163, 0, 60, 99, 173, 91, 236, 166, 88, 252,
+			92, 173, 237, 251, 220, 13, 161, 203, 85, 167, 209, 112, 130, 14,
+			1, 0, 109, 202, 154, 248, 148, 93, 98, 59, 15, 184, 139, 152,
+			179, 75, 241, 211, 156, 203, 55, 166, 219, 78, 195, 230, 254, 240,
+			8, 76, 172, 44, 57, 36, 81, 8, 198, 200, 4, 43, 252, 11,
+			125, 22, 80, 214, 135, 29, 55, 132, 153, 203, 158, 98, 234, 114,
+			218, 192, 130, 145, 145, 92, 21, 32, 35, 45, 49, 15, 206, 238,
+			200, 3, 57, 11, 101, 125, 183, 102, 138, 123, 145, 63, 60, 178,
+			229, 101, 238, 50, 15, 103, 98, 110, 12, 223, 119, 234, 55, 166,
+			229, 110, 185, 95, 97, 73, 9, 170, 229, 7, 210, 202, 16, 13,
+			183, 66, 176, 232, 22, 24, 115, 56, 113, 113, 34, 9, 0, 100,
+			153, 59, 96, 77, 95, 30, 189, 131, 117, 62, 47, 143, 222, 177,
+			173, 205, 151, 43, 119, 192, 164, 189, 124, 225, 78, 211, 113, 95,
+			190, 112, 39, 224, 181, 151, 159, 207, 221, 129, 220, 28, 8, 242,
+			203, 47, 220, 200, 16, 153, 117, 22, 163, 1, 144, 213, 216, 176,
+			54, 59, 115, 194, 194, 66, 214, 193, 54, 218, 206, 170, 19, 6,
+			50, 113, 43, 49, 101, 25, 162, 202, 18, 38, 144, 101, 25, 98,
+			19, 105, 88, 68, 137, 214, 26, 146, 101, 163, 45, 81, 16, 4,
+			198, 108, 195, 83, 208, 184, 85, 91, 131, 121, 241, 200, 187, 1,
+			175, 72, 110, 180, 172, 244, 43, 106, 150, 203, 86, 61, 214, 110,
+			129, 113, 59, 175, 134, 14, 59, 57, 158, 147, 15, 199, 123, 251,
+			64, 35, 89, 130, 248, 189, 150, 128, 44, 48, 101, 110, 100, 84,
+			70, 93, 38, 211, 185, 136, 101, 129, 28, 160, 127, 54, 156, 89,
+			174, 204, 100, 70, 46, 118, 61, 197, 12, 59, 132, 187, 28, 159,
+			219, 16, 30, 195, 176, 202, 164, 136, 45, 5, 120, 80, 117, 94,
+			226, 190, 74, 48, 75, 86, 66, 196, 97, 185, 50, 195, 134, 45,
+			136, 175, 9, 108, 144, 159, 39, 44, 115, 35, 51, 2, 11, 224,
+			194, 209, 208, 21, 134, 126, 187, 40, 201, 236, 101, 7, 170, 150,
+			229, 7, 49, 26, 200, 48, 162, 167, 3, 118, 191, 6, 213, 95,
+			172, 234, 133, 107, 136, 19, 198, 138, 147, 180, 154, 67, 176, 141,
+			14, 112, 6, 189, 122, 61, 224, 33, 58, 49, 93, 185, 254, 204,
+			196, 216, 248, 19, 163, 99, 227, 163, 227, 103, 43, 99, 227, 23,
+			38, 199, 46, 140, 159, 205, 141, 141, 223, 200, 72, 233, 14, 24,
+			182, 35, 165, 219, 178, 32, 16, 136, 61, 17, 191, 231, 198, 222,
+			228, 217, 44, 3, 104, 57, 185, 129, 172, 117, 171, 92, 243, 157,
+			86, 152, 5, 31, 176, 203, 129, 177, 24, 24, 13, 89, 24, 1,
+			43, 142, 7, 107, 41, 236, 66, 30, 81, 252, 33, 134, 104, 91,
+			190, 77, 216, 243, 161, 87, 44, 47, 150, 209, 107, 25, 30, 233,
+			225, 182, 229, 154, 222, 75, 78, 163, 97, 161, 207, 195, 221, 209,
+			229, 114, 222, 246, 106, 65, 254, 58, 175, 230, 99, 82, 242, 37,
+			46, 171, 222, 242, 151, 27, 94, 213, 106, 172, 44, 34, 13, 65,
+			30, 8, 202, 119, 32, 25, 33, 81, 60, 179, 168, 52, 13, 36,
+			134, 21, 73, 236, 102, 84, 148, 162, 126, 220, 84, 19, 146, 213,
+			113, 114, 182, 16, 133, 237, 53, 69, 194, 158, 191, 25, 132, 126,
+			29, 135, 118, 204, 200, 171, 5, 185, 150, 208, 108, 48, 151, 137,
+			124, 195, 169, 250, 150, 191, 153, 135, 142, 185, 181, 176, 217, 120,
+			12, 127, 169, 177, 35, 24, 49, 33, 145, 32, 43, 36, 16, 150,
+			96, 39, 143, 63, 55, 122, 188, 57, 122, 220, 174, 28, 191, 114,
+			225, 248, 213, 11, 199, 203, 185, 227, 245, 27, 39, 115, 108, 222,
+			185, 197, 55, 156, 128, 163, 243, 15, 12, 138, 87, 169, 29, 112,
+			1, 237, 25, 207, 22, 117, 124, 39, 3, 246, 252, 205, 98, 121,
+			81, 153, 250, 57, 196, 144, 179, 101, 115, 120, 228, 230, 11, 195,
+			34, 117, 42, 245, 220, 139, 158, 45, 86, 2, 126, 140, 2, 149,
+			121, 171, 229, 224, 130, 168, 167, 56, 157, 188, 160, 53, 191, 29,
+			54, 206, 83, 33, 56, 62, 49, 123, 124, 98, 150, 176, 17, 144,
+			21, 175, 138, 97, 51, 75, 206, 51, 228, 62, 171, 89, 45, 220,
+			32, 94, 93, 196, 205, 69, 237, 76, 164, 243, 101, 145, 77, 196,
+			255, 174, 114, 143, 119, 164, 246, 145, 15, 169, 114, 15, 243, 93,
+			154, 126, 32, 253, 19, 26, 43, 197, 199, 62, 37, 250, 94, 29,
+			37, 30, 160, 178, 192, 113, 107, 157, 174, 7, 233, 237, 123, 116,
+			231, 251, 119, 56, 43, 144, 94, 135, 133, 27, 93, 249, 255, 1,
+			85, 175, 1, 244, 237, 82, 77, 141, 154, 239, 210, 82, 131, 170,
+			105, 64, 147, 238, 39, 127, 161, 201, 146, 13, 243, 71, 53, 157,
+			166, 255, 139, 198, 22, 60, 119, 52, 42, 136, 120, 176, 202, 141,
+			28, 91, 144, 3, 163, 83, 151, 172, 11, 5, 161, 235, 56, 175,
+			98, 48, 49, 8, 157, 70, 131, 173, 89, 235, 156, 185, 157, 56,
+			81, 115, 203, 130, 82, 16, 45, 43, 148, 167, 214, 186, 231, 195,
+			105, 81, 29, 169, 183, 50, 76, 158, 164, 226, 34, 137, 237, 76,
+			209, 250, 168, 249, 163, 49, 83, 52, 156, 118, 106, 64, 53, 13,
+			104, 118, 212, 69, 252, 242, 49, 50, 234, 184, 117, 223, 202, 91,
+			173, 22, 119, 87, 29, 151, 231, 55, 56, 15, 171, 206, 237, 60,
+			118, 201, 175, 143, 231, 107, 94, 179, 25, 221, 252, 33, 242, 117,
+			110, 125, 60, 125, 175, 132, 64, 102, 67, 196, 255, 177, 224, 149,
+			158, 35, 41, 110, 249, 13, 135, 7, 33, 222, 11, 218, 61, 145,
+			86, 103, 75, 5, 32, 23, 153, 130, 82, 212, 151, 78, 144, 36,
+			20, 239, 6, 225, 65, 253, 158, 163, 100, 207, 204, 57, 178, 167,
+			194, 131, 176, 196, 131, 118, 35, 44, 218, 244, 97, 146, 12, 208,
+			245, 67, 204, 253, 37, 217, 162, 123, 137, 238, 216, 8, 183, 191,
+			164, 59, 118, 230, 237, 100, 215, 53, 11, 14, 250, 33, 205, 17,
+			195, 230, 245, 131, 26, 51, 134, 119, 79, 28, 201, 197, 211, 206,
+			201, 30, 185, 89, 94, 47, 184, 161, 191, 89, 130, 142, 233, 115,
+			36, 165, 30, 208, 33, 98, 220, 226, 155, 18, 23, 252, 132, 20,
+			7, 174, 183, 196, 37, 26, 23, 244, 39, 181, 204, 25, 66, 132,
+			30, 95, 178, 28, 255, 126, 71, 102, 230, 201, 129, 233, 246, 106,
+			197, 183, 106, 183, 28, 119, 21, 28, 68, 207, 229, 110, 184, 227,
+			68, 143, 144, 254, 154, 234, 36, 33, 197, 15, 50, 79, 146, 189,
+			75, 62, 15, 218, 213, 166, 19, 150, 218, 238, 253, 51, 236, 212,
+			77, 50, 112, 141, 251, 182, 83, 11, 203, 161, 21, 182, 3, 122,
+			148, 164, 175, 21, 74, 179, 197, 153, 202, 74, 185, 50, 85, 89,
+			46, 175, 44, 47, 96, 50, 120, 174, 88, 152, 29, 74, 208, 189,
+			132, 44, 47, 20, 158, 93, 42, 204, 84, 10, 179, 67, 132, 238,
+			35, 3, 170, 255, 220, 252, 212, 91, 158, 27, 58, 74, 247, 144,
+			84, 212, 97, 98, 58, 123, 227, 212, 189, 36, 244, 162, 124, 208,
+			170, 62, 243, 229, 195, 80, 47, 99, 38, 184, 70, 62, 165, 97,
+			206, 198, 76, 208, 137, 159, 213, 186, 210, 47, 19, 227, 232, 21,
+			205, 172, 249, 94, 211, 105, 55, 217, 84, 59, 92, 243, 252, 32,
+			183, 67, 30, 102, 25, 10, 17, 234, 42, 218, 29, 103, 45, 156,
+			128, 173, 122, 235, 220, 119, 165, 91, 193, 166, 203, 179, 163, 65,
+			184, 217, 224, 172, 225, 212, 56, 166, 4, 33, 92, 1, 70, 4,
+			156, 150, 58, 148, 177, 169, 224, 210, 124, 113, 166, 176, 80, 46,
+			176, 186, 211, 224, 81, 68, 48, 153, 216, 15, 225, 57, 35, 65,
+			141, 84, 98, 68, 134, 231, 72, 124, 25, 0, 126, 62, 142, 209,
+			57, 115, 32, 177, 95, 75, 31, 100, 83, 50, 0, 35, 139, 200,
+			112, 239, 4, 29, 41, 188, 129, 212, 62, 242, 148, 42, 222, 27,
+			212, 71, 210, 121, 156, 186, 215, 176, 121, 16, 198, 67, 64, 179,
+			160, 50, 177, 185, 34, 16, 225, 230, 162, 226, 184, 36, 12, 63,
+			172, 90, 26, 53, 6, 143, 60, 174, 90, 6, 53, 6, 79, 14,
+			147, 162, 170, 141, 163, 250, 201, 244, 83, 144, 126, 104, 180, 109,
+			206, 60, 183, 177, 217, 65, 156, 208, 119, 224, 163, 66, 208, 165,
+			22, 54, 54, 145, 26, 89, 32, 10, 76, 142, 144, 106, 73, 128,
+			165, 144, 66, 133, 23, 61, 146, 81, 45, 131, 26, 244, 248, 9,
+			242, 235, 26, 209, 251, 18, 212, 60, 152, 56, 161, 165, 63, 173,
+			49, 33, 134, 176, 94, 22, 147, 146, 153, 35, 242, 22, 128, 205,
+			67, 72, 66, 168, 245, 106, 52, 112, 162, 160, 90, 192, 131, 110,
+			55, 194, 168, 146, 113, 93, 140, 20, 94, 61, 191, 237, 185, 92,
+			149, 230, 97, 110, 203, 89, 117, 61, 159, 219, 194, 31, 175, 91,
+			78, 3, 66, 83, 144, 43, 246, 57, 58, 153, 120, 4, 146, 207,
+			179, 140, 175, 115, 23, 202, 139, 29, 36, 66, 65, 227, 54, 184,
+			159, 132, 24, 125, 176, 78, 7, 251, 40, 89, 33, 102, 31, 88,
+			93, 227, 176, 126, 44, 93, 98, 83, 138, 10, 145, 30, 115, 189,
+			80, 152, 18, 96, 17, 212, 74, 135, 237, 32, 7, 49, 56, 184,
+			37, 17, 8, 46, 99, 10, 7, 29, 236, 186, 211, 128, 76, 146,
+			187, 170, 128, 72, 174, 2, 2, 141, 26, 135, 245, 35, 170, 165,
+			83, 227, 240, 163, 140, 156, 71, 228, 26, 53, 142, 234, 52, 157,
+			21, 59, 161, 39, 79, 240, 120, 209, 118, 249, 237, 22, 135, 11,
+			14, 17, 88, 88, 158, 163, 250, 30, 213, 210, 169, 113, 116, 112,
+			31, 249, 231, 26, 194, 213, 169, 145, 209, 31, 74, 7, 172, 210,
+			1, 104, 205, 10, 132, 231, 174, 96, 33, 183, 99, 208, 138, 0,
+			152, 165, 199, 170, 241, 37, 141, 208, 177, 162, 2, 200, 41, 215,
+			106, 108, 190, 196, 109, 80, 247, 82, 49, 11, 17, 200, 161, 58,
+			137, 200, 3, 185, 204, 232, 131, 170, 5, 4, 209, 3, 228, 9,
+			164, 206, 160, 198, 113, 125, 40, 125, 234, 94, 179, 222, 54, 103,
+			3, 34, 238, 122, 212, 210, 169, 113, 124, 96, 144, 12, 19, 221,
+			212, 168, 57, 146, 56, 163, 165, 143, 176, 34, 4, 196, 157, 112,
+			19, 0, 90, 157, 194, 38, 119, 41, 240, 109, 36, 117, 128, 92,
+			39, 166, 169, 193, 234, 103, 245, 3, 233, 103, 88, 101, 171, 100,
+			10, 5, 156, 35, 76, 30, 215, 27, 155, 120, 40, 22, 11, 191,
+			110, 53, 28, 233, 138, 128, 48, 100, 196, 32, 187, 154, 145, 123,
+			73, 3, 111, 201, 200, 234, 41, 213, 210, 168, 145, 237, 31, 84,
+			45, 131, 26, 89, 186, 159, 252, 148, 142, 52, 64, 230, 95, 31,
+			74, 255, 152, 206, 138, 179, 81, 169, 103, 7, 45, 74, 67, 244,
+			38, 15, 206, 83, 93, 111, 28, 151, 9, 59, 60, 59, 157, 149,
+			201, 81, 121, 138, 191, 64, 88, 198, 113, 215, 61, 81, 232, 23,
+			228, 239, 20, 23, 174, 45, 206, 76, 65, 66, 104, 165, 56, 251,
+			114, 30, 192, 4, 249, 59, 203, 165, 249, 149, 66, 121, 102, 106,
+			169, 48, 187, 82, 41, 148, 43, 248, 78, 66, 207, 223, 41, 21,
+			202, 203, 243, 248, 44, 67, 216, 117, 60, 221, 119, 129, 201, 178,
+			30, 227, 81, 210, 162, 145, 40, 210, 210, 143, 195, 170, 17, 56,
+			163, 116, 144, 29, 49, 81, 235, 3, 214, 40, 38, 194, 202, 77,
+			246, 239, 86, 45, 131, 26, 147, 123, 7, 201, 239, 107, 68, 55,
+			117, 106, 94, 72, 60, 173, 165, 127, 83, 99, 82, 40, 227, 210,
+			93, 208, 13, 27, 22, 202, 131, 223, 118, 177, 66, 69, 202, 69,
+			205, 10, 184, 138, 171, 7, 80, 71, 23, 61, 85, 103, 40, 126,
+			155, 215, 176, 252, 217, 113, 227, 221, 192, 224, 216, 157, 101, 245,
+			248, 32, 139, 105, 141, 248, 253, 98, 57, 203, 46, 47, 45, 171,
+			108, 127, 252, 2, 60, 0, 40, 74, 247, 90, 210, 5, 246, 153,
+			223, 118, 65, 87, 179, 122, 195, 90, 85, 134, 4, 246, 206, 133,
+			212, 32, 121, 31, 184, 210, 58, 200, 232, 37, 253, 104, 250, 93,
+			26, 18, 138, 12, 115, 58, 203, 154, 215, 165, 127, 196, 10, 86,
+			109, 141, 221, 226, 155, 163, 200, 91, 214, 178, 28, 191, 139, 13,
+			132, 181, 44, 223, 106, 130, 86, 102, 54, 15, 106, 190, 83, 5,
+			110, 172, 121, 27, 177, 124, 109, 88, 1, 208, 196, 134, 121, 110,
+			53, 167, 102, 146, 101, 60, 172, 229, 70, 228, 186, 232, 122, 34,
+			9, 36, 61, 164, 90, 26, 53, 46, 61, 124, 72, 181, 12, 106,
+			92, 58, 242, 38, 66, 136, 110, 26, 212, 124, 115, 226, 178, 134,
+			251, 14, 246, 238, 155, 83, 148, 188, 133, 152, 166, 1, 115, 154,
+			209, 247, 165, 159, 102, 37, 190, 202, 111, 95, 96, 111, 123, 222,
+			26, 125, 233, 5, 248, 207, 216, 232, 249, 149, 23, 78, 13, 231,
+			183, 60, 24, 57, 245, 56, 97, 87, 173, 219, 76, 92, 137, 187,
+			192, 206, 157, 145, 228, 24, 184, 215, 102, 164, 152, 24, 72, 206,
+			76, 255, 30, 213, 50, 168, 49, 51, 56, 68, 30, 69, 180, 26,
+			53, 230, 244, 253, 105, 218, 5, 105, 226, 236, 185, 8, 20, 72,
+			220, 92, 4, 10, 36, 110, 174, 127, 175, 106, 25, 212, 152, 219,
+			71, 201, 60, 209, 77, 147, 154, 207, 36, 174, 107, 233, 55, 111,
+			209, 55, 213, 246, 42, 11, 165, 151, 200, 34, 135, 15, 118, 240,
+			150, 119, 106, 255, 34, 111, 76, 141, 26, 207, 164, 142, 144, 127,
+			5, 11, 110, 2, 115, 22, 244, 3, 233, 247, 139, 5, 239, 49,
+			140, 213, 60, 95, 148, 65, 217, 81, 246, 198, 9, 98, 241, 205,
+			66, 142, 207, 65, 194, 234, 14, 108, 174, 234, 230, 93, 52, 200,
+			253, 40, 184, 166, 231, 122, 190, 229, 52, 148, 130, 51, 145, 233,
+			11, 146, 83, 38, 50, 125, 65, 42, 56, 19, 101, 96, 129, 238,
+			39, 255, 27, 20, 28, 138, 243, 53, 253, 145, 244, 255, 208, 183,
+			207, 39, 102, 209, 223, 235, 148, 138, 194, 152, 244, 98, 157, 19,
+			48, 53, 25, 89, 87, 2, 156, 91, 227, 29, 164, 88, 50, 193,
+			216, 134, 40, 216, 6, 94, 230, 8, 56, 103, 78, 152, 101, 184,
+			43, 50, 69, 112, 144, 159, 6, 19, 248, 244, 92, 195, 186, 229,
+			184, 60, 8, 50, 162, 242, 172, 19, 54, 18, 64, 98, 10, 90,
+			190, 7, 209, 30, 185, 183, 50, 53, 233, 15, 103, 70, 192, 134,
+			128, 191, 33, 67, 186, 89, 86, 109, 67, 249, 91, 208, 110, 138,
+			18, 17, 240, 102, 101, 153, 7, 143, 60, 90, 9, 237, 100, 192,
+			174, 11, 119, 28, 226, 91, 117, 103, 85, 94, 47, 136, 22, 10,
+			68, 250, 90, 180, 80, 32, 210, 215, 250, 169, 106, 25, 212, 184,
+			246, 208, 195, 228, 173, 68, 55, 251, 168, 121, 35, 193, 181, 116,
+			97, 139, 72, 183, 212, 73, 69, 232, 5, 171, 17, 120, 12, 203,
+			235, 97, 69, 44, 150, 153, 121, 43, 43, 181, 221, 12, 40, 179,
+			204, 204, 53, 252, 45, 61, 45, 179, 79, 163, 198, 141, 212, 195,
+			228, 167, 65, 174, 251, 64, 174, 223, 166, 31, 72, 255, 184, 144,
+			107, 185, 30, 232, 158, 130, 214, 81, 245, 48, 45, 223, 171, 137,
+			251, 24, 188, 27, 247, 125, 138, 106, 163, 93, 115, 70, 107, 235,
+			25, 84, 208, 243, 203, 51, 69, 54, 227, 53, 1, 196, 53, 238,
+			3, 3, 125, 194, 134, 197, 227, 107, 74, 163, 245, 161, 52, 191,
+			77, 50, 169, 15, 165, 249, 109, 82, 154, 251, 80, 154, 223, 70,
+			247, 147, 255, 40, 102, 161, 81, 195, 214, 135, 210, 191, 162, 117,
+			241, 169, 23, 181, 197, 173, 143, 99, 17, 148, 4, 116, 25, 104,
+			117, 230, 81, 83, 185, 0, 185, 131, 204, 29, 232, 186, 178, 84,
+			90, 124, 166, 48, 83, 121, 57, 47, 154, 51, 215, 208, 0, 11,
+			121, 196, 110, 226, 204, 246, 228, 249, 39, 159, 124, 114, 252, 252,
+			153, 115, 147, 79, 158, 61, 51, 58, 62, 90, 63, 127, 230, 137,
+			201, 137, 58, 159, 24, 27, 59, 123, 174, 110, 143, 171, 237, 219,
+			135, 82, 97, 71, 19, 6, 169, 176, 165, 105, 237, 67, 169, 176,
+			247, 14, 70, 81, 139, 175, 255, 164, 70, 158, 220, 233, 80, 136,
+			25, 111, 215, 106, 228, 45, 187, 233, 184, 242, 140, 136, 191, 101,
+			4, 227, 97, 217, 51, 167, 122, 230, 240, 109, 250, 30, 223, 60,
+			73, 223, 237, 2, 201, 61, 67, 33, 233, 7, 11, 179, 100, 126,
+			93, 35, 135, 10, 183, 91, 158, 31, 118, 56, 182, 65, 73, 148,
+			158, 66, 76, 192, 231, 86, 67, 29, 206, 69, 131, 62, 70, 6,
+			106, 13, 175, 109, 175, 200, 157, 40, 143, 233, 123, 240, 225, 146,
+			120, 6, 85, 152, 80, 162, 24, 240, 240, 160, 129, 175, 85, 19,
+			128, 98, 5, 193, 65, 19, 159, 139, 6, 61, 67, 8, 76, 101,
+			5, 143, 131, 7, 147, 24, 129, 121, 168, 51, 26, 18, 5, 120,
+			74, 253, 161, 250, 153, 201, 16, 54, 239, 4, 161, 68, 186, 220,
+			178, 173, 144, 11, 183, 156, 171, 73, 100, 26, 228, 216, 93, 250,
+			128, 49, 9, 56, 189, 76, 82, 129, 124, 38, 67, 49, 167, 115,
+			189, 215, 47, 215, 3, 80, 41, 26, 156, 249, 55, 58, 217, 223,
+			163, 7, 240, 67, 177, 75, 48, 83, 53, 233, 28, 217, 215, 176,
+			130, 112, 37, 104, 215, 96, 255, 175, 192, 236, 238, 35, 4, 53,
+			8, 131, 202, 98, 12, 240, 134, 78, 19, 124, 180, 194, 125, 223,
+			243, 5, 20, 227, 158, 80, 6, 26, 86, 16, 22, 96, 4, 60,
+			163, 111, 34, 36, 134, 33, 23, 168, 63, 234, 66, 231, 201, 94,
+			161, 107, 87, 214, 185, 15, 215, 27, 14, 246, 33, 134, 227, 59,
+			241, 106, 6, 123, 95, 19, 157, 75, 3, 181, 206, 230, 150, 197,
+			235, 234, 26, 45, 94, 147, 28, 187, 75, 31, 185, 120, 87, 72,
+			74, 210, 163, 22, 47, 123, 143, 197, 235, 2, 84, 138, 70, 103,
+			222, 65, 14, 244, 234, 113, 151, 213, 219, 206, 18, 253, 7, 96,
+			73, 157, 12, 116, 35, 78, 147, 148, 207, 215, 29, 232, 43, 49,
+			71, 109, 122, 158, 144, 58, 15, 107, 107, 247, 43, 49, 253, 216,
+			27, 218, 153, 10, 217, 191, 212, 246, 87, 185, 156, 172, 228, 246,
+			93, 166, 9, 123, 30, 8, 243, 155, 43, 161, 119, 139, 187, 209,
+			158, 23, 15, 43, 240, 44, 243, 119, 58, 57, 208, 13, 86, 46,
+			208, 44, 33, 190, 183, 177, 130, 193, 103, 181, 68, 59, 50, 168,
+			2, 250, 160, 228, 109, 204, 64, 239, 82, 191, 47, 127, 5, 247,
+			69, 3, 93, 38, 135, 186, 58, 173, 240, 219, 45, 199, 231, 247,
+			187, 31, 30, 238, 4, 86, 192, 161, 240, 18, 120, 141, 215, 198,
+			5, 28, 243, 158, 112, 250, 177, 55, 180, 233, 12, 25, 172, 121,
+			112, 90, 130, 179, 139, 24, 223, 119, 207, 241, 123, 227, 33, 240,
+			144, 30, 35, 123, 124, 111, 35, 88, 177, 121, 131, 135, 220, 70,
+			5, 105, 148, 118, 195, 179, 89, 241, 40, 115, 158, 12, 116, 177,
+			46, 86, 180, 82, 123, 99, 131, 82, 98, 194, 40, 92, 64, 163,
+			132, 191, 51, 63, 173, 145, 67, 101, 30, 94, 181, 96, 41, 92,
+			184, 64, 112, 213, 179, 249, 189, 165, 226, 48, 233, 247, 185, 101,
+			175, 128, 63, 130, 0, 83, 165, 20, 60, 88, 116, 27, 155, 32,
+			76, 242, 254, 131, 178, 0, 178, 73, 79, 19, 35, 12, 27, 146,
+			139, 135, 182, 113, 65, 221, 79, 45, 65, 175, 204, 143, 104, 100,
+			112, 11, 97, 221, 120, 181, 157, 241, 234, 221, 120, 47, 146, 221,
+			15, 38, 13, 132, 71, 18, 144, 249, 63, 26, 121, 120, 218, 170,
+			221, 170, 59, 141, 198, 125, 111, 155, 110, 177, 209, 31, 68, 108,
+			206, 146, 20, 119, 237, 251, 165, 116, 23, 119, 109, 104, 209, 71,
+			200, 46, 219, 223, 92, 241, 219, 46, 242, 55, 85, 74, 218, 254,
+			102, 169, 237, 130, 52, 212, 61, 191, 38, 132, 47, 85, 18, 13,
+			122, 138, 236, 195, 98, 144, 96, 165, 197, 253, 21, 81, 41, 136,
+			194, 213, 87, 26, 20, 47, 150, 184, 127, 21, 31, 103, 222, 66,
+			14, 94, 230, 161, 226, 129, 180, 124, 247, 100, 193, 67, 36, 249,
+			162, 87, 93, 137, 162, 249, 125, 47, 122, 213, 162, 157, 121, 143,
+			73, 246, 118, 131, 122, 96, 24, 91, 54, 165, 241, 253, 114, 215,
+			188, 127, 238, 254, 192, 76, 4, 11, 91, 19, 209, 219, 149, 234,
+			230, 193, 93, 50, 67, 34, 158, 76, 111, 210, 139, 100, 183, 120,
+			45, 22, 62, 117, 79, 210, 36, 180, 157, 52, 77, 255, 247, 163,
+			105, 228, 100, 48, 143, 112, 144, 160, 158, 216, 45, 158, 205, 193,
+			35, 122, 156, 236, 149, 93, 130, 91, 78, 171, 197, 237, 131, 187,
+			177, 211, 128, 120, 90, 22, 15, 233, 73, 34, 103, 191, 194, 221,
+			183, 183, 121, 155, 219, 7, 247, 96, 63, 57, 186, 32, 159, 102,
+			254, 147, 70, 246, 11, 39, 116, 102, 173, 237, 222, 10, 254, 33,
+			238, 171, 147, 100, 48, 192, 226, 141, 21, 149, 124, 197, 253, 165,
+			149, 246, 138, 199, 115, 242, 105, 102, 129, 28, 134, 90, 39, 152,
+			136, 152, 211, 15, 184, 81, 126, 214, 36, 251, 182, 65, 251, 199,
+			176, 87, 122, 112, 172, 175, 23, 199, 40, 35, 187, 33, 75, 228,
+			184, 120, 10, 194, 141, 211, 95, 234, 124, 244, 15, 127, 211, 28,
+			39, 123, 107, 176, 70, 193, 74, 80, 179, 92, 151, 171, 109, 51,
+			32, 158, 150, 197, 67, 216, 17, 178, 27, 199, 197, 140, 118, 142,
+			28, 93, 144, 79, 233, 105, 178, 79, 166, 117, 58, 186, 138, 205,
+			51, 164, 94, 168, 206, 19, 255, 33, 69, 250, 166, 192, 117, 162,
+			22, 161, 219, 15, 115, 116, 124, 39, 79, 107, 199, 131, 95, 250,
+			225, 109, 115, 199, 235, 124, 153, 4, 125, 191, 70, 14, 117, 120,
+			228, 157, 103, 28, 30, 208, 39, 119, 66, 181, 227, 16, 133, 241,
+			252, 247, 49, 82, 120, 151, 25, 227, 125, 186, 182, 149, 174, 46,
+			47, 250, 254, 232, 234, 30, 242, 32, 116, 109, 29, 217, 73, 215,
+			45, 178, 167, 211, 37, 166, 59, 31, 43, 187, 28, 103, 129, 60,
+			123, 127, 157, 37, 190, 4, 245, 9, 221, 238, 198, 237, 188, 254,
+			59, 186, 124, 233, 147, 59, 13, 217, 210, 63, 147, 160, 183, 200,
+			160, 178, 227, 146, 32, 154, 219, 105, 244, 150, 142, 10, 219, 137,
+			123, 245, 23, 242, 133, 19, 220, 183, 205, 5, 161, 99, 59, 13,
+			223, 201, 91, 185, 111, 132, 40, 89, 107, 100, 79, 167, 113, 218,
+			121, 5, 123, 152, 176, 244, 200, 78, 157, 183, 169, 244, 76, 130,
+			222, 33, 7, 122, 153, 14, 58, 185, 19, 144, 187, 24, 154, 7,
+			193, 12, 211, 156, 62, 119, 227, 204, 131, 132, 186, 46, 34, 176,
+			86, 245, 153, 95, 190, 5, 215, 87, 205, 196, 223, 234, 255, 72,
+			75, 33, 30, 141, 75, 33, 134, 241, 167, 70, 141, 254, 196, 113,
+			252, 169, 67, 45, 196, 8, 254, 52, 168, 177, 59, 241, 132, 172,
+			149, 24, 72, 188, 69, 213, 74, 192, 207, 255, 174, 17, 61, 153,
+			160, 230, 254, 196, 243, 90, 250, 235, 26, 67, 109, 204, 188, 22,
+			86, 190, 69, 97, 234, 38, 236, 29, 203, 113, 225, 43, 42, 112,
+			98, 202, 17, 246, 156, 188, 83, 94, 83, 69, 2, 112, 65, 92,
+			212, 41, 178, 210, 210, 12, 43, 220, 110, 53, 60, 159, 251, 23,
+			8, 59, 21, 221, 211, 173, 173, 121, 173, 96, 84, 46, 206, 168,
+			205, 215, 115, 86, 171, 21, 180, 188, 48, 87, 243, 154, 121, 191,
+			85, 227, 114, 84, 94, 94, 255, 14, 242, 72, 135, 205, 215, 119,
+			4, 115, 159, 32, 224, 227, 24, 24, 226, 78, 66, 168, 120, 127,
+			106, 128, 252, 91, 131, 152, 73, 172, 38, 56, 162, 95, 75, 127,
+			216, 96, 219, 141, 10, 11, 125, 103, 117, 21, 102, 221, 235, 157,
+			21, 220, 194, 91, 75, 194, 204, 97, 94, 131, 168, 212, 30, 190,
+			0, 182, 196, 73, 0, 60, 196, 202, 90, 87, 17, 201, 196, 204,
+			79, 144, 101, 213, 183, 43, 24, 81, 1, 47, 179, 161, 184, 210,
+			106, 135, 94, 211, 10, 225, 194, 125, 99, 19, 196, 166, 230, 123,
+			46, 123, 209, 171, 170, 178, 6, 224, 116, 87, 105, 67, 232, 225,
+			141, 42, 40, 154, 105, 192, 197, 29, 75, 22, 147, 52, 224, 212,
+			185, 9, 242, 164, 214, 180, 220, 2, 203, 238, 99, 61, 225, 180,
+			179, 250, 214, 54, 247, 55, 241, 99, 144, 182, 199, 3, 247, 100,
+			200, 54, 60, 255, 22, 20, 101, 116, 220, 232, 103, 56, 101, 92,
+			17, 0, 45, 47, 84, 72, 136, 68, 38, 117, 152, 227, 174, 130,
+			7, 228, 185, 80, 131, 1, 118, 62, 199, 138, 117, 22, 180, 107,
+			107, 49, 28, 223, 193, 153, 111, 112, 188, 138, 5, 204, 178, 108,
+			248, 44, 19, 150, 38, 19, 41, 134, 240, 141, 0, 64, 230, 132,
+			34, 8, 14, 171, 165, 81, 227, 72, 242, 160, 106, 233, 212, 56,
+			114, 104, 66, 181, 12, 106, 28, 185, 84, 34, 191, 162, 225, 194,
+			106, 212, 60, 166, 63, 102, 164, 63, 161, 237, 28, 105, 197, 27,
+			202, 129, 252, 196, 154, 42, 139, 129, 86, 211, 195, 252, 124, 13,
+			242, 92, 109, 52, 222, 80, 249, 66, 24, 84, 19, 66, 50, 214,
+			130, 162, 138, 0, 170, 227, 93, 27, 114, 70, 98, 191, 64, 229,
+			58, 131, 112, 191, 74, 245, 228, 64, 31, 96, 66, 200, 106, 192,
+			69, 44, 200, 58, 203, 87, 1, 171, 91, 141, 6, 36, 153, 170,
+			124, 205, 113, 101, 201, 4, 208, 173, 81, 227, 88, 242, 81, 213,
+			210, 169, 113, 140, 189, 89, 181, 12, 106, 28, 123, 75, 67, 181,
+			76, 106, 100, 204, 60, 25, 32, 73, 108, 101, 68, 243, 157, 98,
+			254, 58, 53, 79, 234, 35, 70, 58, 216, 57, 86, 217, 49, 125,
+			21, 72, 140, 51, 35, 72, 165, 204, 80, 5, 80, 204, 9, 26,
+			78, 37, 241, 212, 231, 26, 216, 154, 229, 218, 13, 85, 167, 46,
+			151, 55, 154, 10, 228, 17, 79, 70, 83, 209, 117, 106, 156, 140,
+			166, 162, 27, 212, 56, 25, 77, 69, 55, 169, 49, 28, 77, 69,
+			55, 51, 162, 249, 59, 98, 143, 66, 225, 128, 94, 76, 255, 170,
+			193, 58, 221, 7, 38, 66, 74, 98, 249, 80, 214, 49, 251, 213,
+			201, 127, 230, 213, 235, 85, 207, 242, 237, 232, 70, 182, 20, 86,
+			81, 245, 175, 122, 201, 242, 33, 214, 240, 220, 85, 238, 139, 34,
+			34, 43, 122, 43, 88, 0, 59, 23, 176, 227, 92, 173, 91, 60,
+			192, 43, 11, 176, 51, 131, 28, 155, 177, 26, 13, 89, 111, 3,
+			23, 188, 45, 214, 21, 201, 203, 118, 145, 77, 192, 22, 88, 204,
+			246, 55, 33, 61, 119, 1, 190, 155, 225, 115, 208, 32, 98, 34,
+			62, 222, 130, 130, 12, 162, 156, 31, 108, 11, 155, 57, 65, 0,
+			31, 87, 181, 24, 66, 132, 196, 154, 64, 105, 173, 66, 109, 179,
+			186, 2, 47, 222, 34, 29, 144, 192, 118, 25, 220, 192, 200, 2,
+			10, 220, 138, 50, 69, 7, 196, 200, 123, 20, 226, 162, 187, 227,
+			2, 108, 105, 108, 64, 127, 172, 250, 96, 126, 196, 188, 96, 194,
+			78, 184, 21, 145, 168, 190, 0, 108, 164, 139, 252, 150, 239, 173,
+			194, 183, 3, 35, 57, 2, 100, 145, 64, 64, 73, 193, 100, 146,
+			170, 150, 78, 141, 201, 253, 39, 84, 11, 86, 121, 188, 64, 62,
+			167, 227, 154, 155, 212, 184, 168, 47, 165, 63, 173, 179, 237, 206,
+			30, 107, 181, 67, 184, 152, 210, 0, 44, 114, 69, 179, 144, 206,
+			236, 94, 125, 44, 85, 7, 197, 55, 10, 70, 139, 176, 102, 12,
+			5, 62, 226, 192, 113, 136, 88, 75, 39, 100, 176, 112, 94, 189,
+			71, 39, 216, 217, 22, 107, 56, 77, 7, 63, 144, 2, 39, 181,
+			28, 91, 118, 67, 167, 1, 140, 21, 129, 182, 64, 228, 5, 3,
+			184, 33, 24, 9, 136, 252, 244, 34, 160, 131, 42, 72, 232, 177,
+			157, 8, 197, 118, 105, 174, 58, 164, 173, 199, 188, 229, 71, 160,
+			64, 234, 68, 90, 121, 43, 180, 136, 211, 80, 160, 112, 49, 82,
+			148, 166, 78, 141, 139, 145, 162, 52, 13, 106, 92, 188, 52, 79,
+			62, 38, 118, 87, 31, 53, 10, 122, 49, 253, 47, 13, 166, 156,
+			72, 181, 193, 124, 62, 42, 148, 121, 176, 61, 115, 47, 215, 87,
+			30, 39, 65, 51, 96, 104, 4, 181, 228, 150, 77, 40, 207, 187,
+			64, 175, 37, 191, 136, 12, 185, 178, 28, 155, 22, 67, 98, 235,
+			4, 184, 160, 202, 13, 74, 8, 101, 132, 134, 181, 93, 252, 216,
+			31, 6, 172, 96, 235, 4, 60, 84, 37, 64, 85, 73, 239, 221,
+			132, 24, 110, 81, 67, 180, 6, 197, 56, 178, 76, 33, 90, 50,
+			184, 40, 221, 161, 183, 160, 160, 206, 10, 121, 142, 77, 133, 194,
+			0, 192, 14, 87, 56, 100, 197, 164, 154, 147, 92, 9, 71, 40,
+			116, 33, 242, 144, 17, 118, 107, 188, 75, 29, 48, 25, 211, 204,
+			110, 99, 46, 200, 164, 168, 106, 151, 197, 11, 146, 129, 96, 194,
+			185, 36, 85, 85, 210, 37, 19, 58, 100, 230, 11, 201, 135, 84,
+			75, 167, 70, 225, 225, 172, 106, 25, 212, 40, 60, 81, 32, 5,
+			92, 207, 36, 53, 175, 232, 207, 24, 233, 39, 216, 182, 99, 196,
+			142, 91, 213, 138, 230, 25, 33, 76, 106, 212, 184, 146, 124, 68,
+			181, 116, 106, 92, 57, 56, 166, 90, 6, 53, 174, 92, 44, 170,
+			150, 73, 141, 98, 164, 188, 147, 160, 188, 161, 249, 239, 77, 36,
+			103, 23, 53, 150, 245, 185, 244, 39, 77, 233, 68, 161, 23, 31,
+			72, 143, 7, 52, 154, 8, 67, 41, 133, 33, 45, 44, 44, 151,
+			8, 45, 108, 215, 234, 68, 112, 182, 101, 249, 161, 40, 171, 2,
+			153, 10, 182, 138, 151, 242, 192, 16, 138, 114, 176, 46, 207, 148,
+			89, 181, 93, 187, 197, 97, 175, 147, 30, 27, 47, 139, 134, 220,
+			171, 215, 225, 218, 55, 140, 226, 190, 19, 127, 138, 4, 241, 198,
+			20, 18, 102, 53, 224, 218, 123, 184, 214, 12, 122, 88, 20, 175,
+			37, 238, 254, 43, 23, 17, 73, 116, 194, 160, 135, 101, 153, 147,
+			161, 17, 249, 13, 75, 219, 130, 42, 74, 229, 96, 137, 209, 2,
+			129, 156, 199, 86, 129, 39, 93, 18, 15, 95, 115, 3, 6, 90,
+			120, 17, 109, 94, 93, 51, 106, 113, 95, 142, 231, 182, 96, 11,
+			106, 45, 216, 149, 77, 203, 117, 234, 232, 192, 202, 101, 16, 67,
+			228, 173, 141, 112, 141, 59, 62, 171, 173, 241, 218, 173, 160, 13,
+			223, 196, 1, 139, 84, 119, 92, 116, 75, 45, 22, 135, 148, 88,
+			211, 242, 111, 225, 7, 101, 212, 22, 242, 220, 136, 230, 222, 251,
+			103, 251, 246, 81, 2, 184, 75, 163, 198, 114, 100, 44, 118, 233,
+			212, 88, 142, 140, 197, 46, 131, 26, 203, 227, 211, 100, 30, 69,
+			44, 69, 205, 103, 245, 27, 70, 250, 105, 214, 235, 92, 121, 23,
+			161, 239, 148, 141, 8, 47, 124, 182, 235, 217, 100, 90, 181, 116,
+			106, 60, 123, 248, 156, 106, 25, 212, 120, 118, 170, 164, 90, 38,
+			53, 158, 139, 4, 63, 5, 130, 15, 77, 40, 164, 75, 80, 243,
+			133, 196, 186, 22, 149, 153, 191, 144, 58, 70, 102, 85, 153, 249,
+			138, 190, 63, 253, 132, 208, 145, 37, 40, 76, 200, 49, 56, 89,
+			196, 103, 7, 117, 251, 8, 171, 22, 84, 97, 45, 70, 221, 4,
+			145, 0, 165, 15, 192, 164, 84, 75, 163, 198, 138, 44, 123, 19,
+			142, 240, 202, 62, 74, 214, 85, 185, 185, 173, 31, 78, 59, 145,
+			151, 47, 63, 131, 208, 125, 114, 233, 60, 184, 128, 64, 98, 213,
+			180, 232, 120, 117, 185, 92, 97, 88, 68, 84, 133, 195, 106, 32,
+			85, 57, 236, 29, 65, 96, 175, 42, 166, 68, 87, 189, 74, 66,
+			214, 171, 60, 172, 90, 80, 175, 114, 40, 77, 118, 35, 133, 58,
+			53, 184, 172, 77, 76, 232, 122, 31, 180, 212, 48, 160, 158, 247,
+			15, 169, 150, 65, 13, 190, 255, 128, 28, 102, 80, 163, 174, 239,
+			151, 175, 140, 62, 104, 169, 97, 224, 103, 212, 35, 126, 24, 208,
+			115, 31, 37, 159, 236, 195, 113, 38, 53, 218, 250, 137, 244, 79,
+			152, 172, 18, 43, 11, 41, 246, 232, 70, 10, 13, 220, 193, 114,
+			86, 144, 87, 109, 208, 21, 155, 135, 211, 64, 231, 89, 173, 222,
+			134, 75, 76, 94, 27, 46, 72, 20, 161, 224, 61, 92, 227, 155,
+			29, 239, 225, 238, 86, 150, 141, 95, 24, 27, 131, 175, 129, 19,
+			182, 8, 135, 156, 13, 7, 111, 63, 240, 77, 182, 1, 103, 181,
+			42, 103, 161, 223, 118, 107, 234, 35, 252, 225, 90, 23, 92, 66,
+			216, 2, 124, 227, 17, 79, 115, 120, 204, 240, 189, 13, 172, 15,
+			135, 111, 233, 66, 145, 104, 40, 47, 243, 169, 15, 66, 224, 215,
+			55, 2, 231, 37, 168, 75, 66, 251, 232, 123, 104, 148, 170, 155,
+			4, 143, 170, 114, 189, 171, 111, 151, 243, 244, 115, 108, 10, 119,
+			197, 130, 183, 142, 55, 64, 178, 49, 30, 24, 110, 57, 110, 192,
+			198, 145, 28, 56, 26, 194, 55, 177, 234, 120, 194, 140, 203, 166,
+			58, 116, 111, 208, 178, 224, 120, 33, 252, 233, 112, 205, 114, 229,
+			80, 84, 56, 24, 32, 192, 89, 7, 107, 112, 153, 43, 4, 89,
+			67, 186, 161, 168, 24, 14, 75, 209, 151, 163, 130, 38, 184, 58,
+			226, 178, 170, 168, 176, 17, 247, 131, 241, 75, 202, 17, 61, 176,
+			42, 65, 109, 141, 219, 237, 6, 39, 59, 159, 213, 163, 35, 154,
+			92, 108, 5, 220, 115, 121, 144, 35, 19, 63, 166, 117, 240, 88,
+			150, 129, 137, 203, 185, 242, 243, 189, 112, 210, 150, 223, 249, 138,
+			119, 40, 122, 14, 57, 54, 205, 107, 22, 220, 235, 133, 185, 144,
+			120, 130, 226, 81, 23, 40, 184, 217, 215, 99, 223, 48, 126, 91,
+			94, 85, 131, 248, 138, 148, 92, 51, 9, 178, 170, 118, 13, 248,
+			116, 237, 71, 142, 169, 150, 65, 141, 246, 227, 199, 177, 108, 87,
+			163, 230, 237, 196, 166, 22, 149, 203, 223, 78, 13, 227, 115, 157,
+			154, 119, 18, 255, 76, 139, 106, 148, 239, 164, 70, 200, 154, 42,
+			81, 126, 135, 158, 77, 63, 207, 42, 93, 103, 223, 109, 39, 88,
+			193, 13, 16, 180, 42, 231, 174, 60, 11, 219, 240, 149, 152, 6,
+			183, 192, 198, 185, 53, 158, 37, 204, 243, 109, 238, 163, 116, 169,
+			129, 114, 14, 186, 158, 48, 1, 85, 103, 33, 242, 59, 118, 31,
+			85, 45, 184, 79, 249, 232, 73, 213, 50, 168, 241, 142, 83, 167,
+			137, 139, 133, 200, 201, 119, 107, 137, 247, 107, 90, 250, 38, 235,
+			113, 116, 103, 206, 214, 83, 123, 124, 74, 223, 249, 144, 78, 208,
+			184, 119, 59, 19, 57, 66, 118, 139, 98, 103, 243, 221, 90, 234,
+			48, 57, 38, 171, 157, 205, 31, 209, 244, 135, 210, 251, 145, 63,
+			91, 250, 15, 168, 26, 102, 232, 147, 82, 77, 141, 154, 63, 162,
+			245, 15, 169, 166, 1, 205, 253, 7, 136, 37, 203, 152, 205, 247,
+			104, 250, 68, 186, 140, 240, 64, 154, 81, 2, 27, 192, 66, 89,
+			62, 85, 111, 55, 228, 36, 148, 65, 5, 221, 195, 150, 221, 128,
+			135, 16, 135, 113, 61, 245, 26, 86, 3, 7, 193, 71, 108, 34,
+			122, 180, 36, 226, 56, 172, 154, 26, 52, 143, 140, 170, 166, 1,
+			205, 177, 113, 114, 3, 233, 209, 169, 249, 94, 77, 31, 75, 207,
+			247, 160, 7, 210, 52, 220, 126, 0, 90, 196, 128, 136, 16, 61,
+			137, 192, 21, 33, 48, 247, 247, 106, 71, 78, 171, 166, 1, 205,
+			92, 158, 92, 66, 66, 12, 106, 190, 79, 211, 15, 202, 91, 87,
+			88, 1, 166, 2, 161, 34, 160, 213, 147, 170, 8, 151, 209, 135,
+			227, 213, 34, 24, 26, 52, 251, 247, 171, 38, 66, 127, 248, 17,
+			242, 110, 40, 4, 53, 116, 147, 154, 255, 66, 211, 31, 75, 183,
+			213, 45, 155, 160, 163, 40, 95, 174, 175, 220, 149, 247, 138, 119,
+			116, 29, 28, 114, 49, 103, 58, 33, 193, 58, 185, 158, 4, 24,
+			145, 108, 38, 145, 138, 65, 213, 212, 160, 57, 116, 84, 53, 13,
+			104, 30, 131, 75, 92, 80, 179, 158, 252, 128, 150, 248, 41, 77,
+			67, 17, 133, 43, 51, 31, 208, 82, 195, 248, 170, 143, 38, 63,
+			168, 37, 62, 36, 95, 245, 105, 212, 252, 160, 150, 26, 1, 127,
+			200, 236, 3, 233, 253, 25, 77, 31, 77, 63, 45, 191, 247, 4,
+			248, 59, 167, 187, 109, 151, 103, 123, 111, 96, 32, 169, 15, 54,
+			176, 249, 51, 154, 30, 53, 147, 0, 125, 247, 163, 170, 169, 65,
+			147, 13, 171, 166, 1, 205, 211, 89, 82, 33, 186, 153, 164, 201,
+			159, 211, 18, 255, 90, 211, 210, 115, 172, 87, 0, 74, 237, 226,
+			45, 43, 33, 9, 246, 234, 189, 183, 106, 82, 163, 230, 207, 105,
+			169, 35, 184, 85, 147, 48, 217, 143, 222, 117, 171, 38, 193, 89,
+			50, 63, 170, 164, 36, 137, 36, 127, 84, 109, 213, 36, 146, 252,
+			81, 109, 255, 1, 50, 129, 240, 52, 106, 126, 28, 132, 228, 241,
+			123, 11, 73, 132, 0, 246, 222, 199, 213, 154, 38, 113, 239, 125,
+			92, 173, 105, 18, 247, 222, 199, 181, 99, 25, 242, 79, 136, 110,
+			238, 162, 201, 79, 106, 137, 127, 167, 105, 233, 113, 182, 133, 25,
+			170, 86, 30, 140, 122, 7, 94, 25, 77, 90, 149, 211, 223, 165,
+			81, 243, 147, 90, 234, 33, 184, 154, 101, 238, 130, 233, 255, 188,
+			166, 63, 156, 30, 137, 167, 47, 192, 50, 85, 110, 215, 205, 86,
+			73, 243, 46, 100, 202, 207, 43, 166, 236, 66, 166, 252, 188, 214,
+			191, 79, 53, 13, 128, 123, 224, 33, 114, 13, 177, 104, 212, 252,
+			180, 166, 159, 78, 95, 233, 214, 23, 17, 10, 40, 7, 199, 34,
+			61, 25, 104, 235, 36, 164, 83, 113, 180, 93, 44, 68, 143, 136,
+			0, 198, 125, 90, 233, 138, 93, 200, 184, 79, 107, 71, 78, 168,
+			166, 1, 111, 71, 78, 161, 196, 167, 104, 242, 151, 180, 196, 175,
+			74, 137, 79, 105, 212, 252, 37, 208, 215, 192, 133, 20, 112, 225,
+			51, 32, 4, 35, 219, 132, 128, 109, 172, 121, 129, 116, 236, 240,
+			134, 163, 84, 102, 136, 34, 133, 92, 248, 140, 226, 66, 10, 185,
+			240, 25, 37, 26, 41, 228, 194, 103, 64, 52, 28, 196, 162, 81,
+			243, 179, 154, 126, 88, 90, 77, 17, 107, 195, 192, 156, 204, 233,
+			200, 152, 94, 22, 142, 124, 50, 2, 216, 25, 4, 3, 63, 169,
+			13, 156, 200, 118, 42, 143, 174, 112, 96, 68, 23, 92, 83, 255,
+			108, 76, 23, 48, 230, 179, 90, 255, 195, 170, 105, 64, 243, 16,
+			156, 81, 116, 179, 159, 38, 127, 77, 75, 252, 129, 100, 76, 191,
+			70, 205, 95, 131, 221, 241, 67, 196, 52, 251, 129, 49, 191, 161,
+			233, 195, 105, 151, 85, 186, 254, 248, 7, 134, 25, 29, 87, 56,
+			83, 81, 46, 0, 253, 125, 12, 12, 178, 42, 135, 104, 168, 114,
+			69, 37, 51, 49, 239, 192, 3, 142, 103, 146, 40, 90, 185, 201,
+			195, 238, 136, 165, 156, 69, 63, 170, 142, 223, 80, 170, 163, 31,
+			110, 33, 153, 191, 161, 237, 62, 168, 154, 26, 16, 119, 232, 49,
+			213, 52, 160, 121, 226, 36, 121, 23, 104, 235, 126, 176, 27, 175,
+			0, 183, 195, 14, 110, 227, 109, 3, 252, 188, 94, 111, 30, 67,
+			176, 16, 214, 66, 178, 51, 200, 170, 240, 145, 69, 196, 42, 108,
+			221, 200, 78, 103, 248, 41, 58, 29, 70, 19, 128, 101, 120, 69,
+			45, 67, 63, 202, 231, 43, 106, 25, 250, 113, 25, 94, 209, 14,
+			165, 241, 138, 84, 191, 174, 83, 243, 183, 52, 253, 66, 250, 82,
+			247, 38, 145, 84, 138, 242, 83, 21, 47, 20, 148, 138, 120, 66,
+			231, 251, 8, 51, 88, 209, 223, 82, 59, 163, 95, 7, 110, 252,
+			150, 118, 228, 172, 106, 26, 208, 124, 242, 60, 89, 68, 204, 6,
+			53, 127, 23, 182, 231, 84, 55, 102, 49, 227, 40, 11, 211, 109,
+			164, 240, 157, 48, 81, 50, 60, 220, 177, 112, 70, 18, 33, 42,
+			236, 96, 87, 127, 87, 237, 203, 126, 180, 171, 191, 171, 141, 156,
+			34, 101, 196, 110, 82, 243, 85, 112, 38, 10, 189, 176, 199, 254,
+			195, 221, 240, 119, 120, 25, 18, 7, 152, 201, 87, 99, 10, 192,
+			248, 189, 170, 188, 136, 126, 52, 147, 175, 106, 185, 60, 185, 130,
+			20, 244, 81, 243, 11, 154, 126, 40, 125, 97, 139, 148, 43, 193,
+			70, 49, 149, 194, 169, 76, 186, 32, 32, 244, 88, 135, 67, 209,
+			175, 247, 9, 80, 187, 84, 83, 131, 102, 234, 128, 106, 26, 208,
+			124, 228, 32, 106, 114, 66, 147, 127, 168, 37, 190, 132, 154, 188,
+			171, 126, 85, 153, 181, 237, 187, 205, 138, 178, 128, 160, 201, 137,
+			70, 205, 63, 4, 77, 126, 130, 152, 38, 129, 173, 250, 199, 154,
+			190, 63, 125, 144, 85, 58, 62, 85, 11, 128, 212, 32, 32, 130,
+			160, 202, 250, 99, 37, 147, 4, 119, 209, 31, 107, 253, 123, 85,
+			211, 0, 48, 251, 40, 121, 12, 129, 106, 212, 252, 19, 77, 223,
+			151, 126, 168, 199, 254, 143, 32, 130, 148, 255, 137, 154, 52, 65,
+			41, 255, 19, 45, 181, 71, 53, 13, 120, 59, 8, 135, 112, 221,
+			220, 77, 147, 95, 214, 18, 255, 77, 42, 155, 221, 26, 53, 191,
+			172, 165, 142, 145, 151, 136, 105, 238, 134, 25, 124, 13, 180, 112,
+			99, 187, 22, 14, 61, 6, 194, 7, 179, 217, 22, 1, 247, 234,
+			219, 116, 99, 143, 62, 91, 226, 253, 113, 40, 24, 168, 220, 141,
+			92, 249, 154, 226, 202, 110, 228, 202, 215, 148, 34, 223, 141, 92,
+			249, 26, 40, 242, 39, 145, 80, 141, 154, 127, 10, 132, 158, 130,
+			251, 176, 112, 26, 103, 205, 118, 40, 62, 2, 227, 181, 58, 111,
+			178, 51, 159, 215, 193, 15, 141, 208, 0, 171, 254, 84, 211, 147,
+			170, 137, 144, 118, 41, 52, 160, 16, 254, 84, 121, 253, 187, 65,
+			33, 252, 57, 160, 17, 94, 191, 44, 230, 133, 175, 20, 183, 26,
+			214, 38, 56, 183, 248, 229, 116, 63, 128, 63, 52, 5, 184, 59,
+			102, 157, 131, 191, 19, 34, 190, 43, 5, 59, 38, 42, 26, 222,
+			58, 109, 189, 15, 113, 168, 105, 131, 154, 248, 243, 120, 218, 186,
+			1, 205, 253, 7, 200, 18, 78, 219, 160, 230, 95, 130, 107, 51,
+			205, 174, 120, 27, 120, 58, 151, 17, 80, 145, 181, 0, 239, 63,
+			136, 63, 169, 18, 253, 121, 28, 56, 62, 89, 50, 158, 247, 4,
+			254, 145, 160, 8, 61, 232, 137, 191, 212, 244, 180, 106, 106, 208,
+			60, 124, 84, 53, 17, 225, 177, 12, 121, 142, 232, 230, 30, 154,
+			252, 43, 45, 241, 55, 154, 150, 126, 11, 219, 154, 215, 112, 130,
+			29, 87, 189, 51, 189, 179, 147, 71, 184, 71, 163, 230, 95, 105,
+			169, 71, 112, 117, 247, 128, 24, 190, 246, 253, 172, 238, 30, 20,
+			162, 215, 212, 234, 238, 65, 33, 122, 77, 173, 238, 30, 20, 162,
+			215, 96, 117, 167, 17, 141, 70, 205, 215, 1, 205, 153, 239, 103,
+			117, 37, 72, 16, 167, 215, 213, 242, 237, 65, 113, 122, 93, 45,
+			223, 30, 220, 121, 175, 3, 194, 16, 17, 234, 212, 252, 107, 77,
+			207, 166, 235, 221, 122, 86, 45, 95, 148, 137, 178, 234, 144, 162,
+			22, 102, 188, 39, 91, 85, 204, 184, 59, 244, 13, 127, 109, 17,
+			162, 92, 34, 213, 23, 145, 8, 134, 232, 175, 149, 34, 222, 131,
+			134, 232, 175, 181, 35, 39, 85, 211, 128, 183, 167, 78, 163, 114,
+			24, 160, 201, 191, 213, 18, 239, 209, 133, 114, 24, 208, 168, 249,
+			183, 90, 234, 40, 158, 244, 6, 96, 85, 190, 3, 236, 202, 239,
+			228, 162, 201, 252, 7, 172, 139, 74, 70, 68, 75, 51, 128, 75,
+			243, 29, 197, 169, 1, 92, 154, 239, 40, 78, 13, 224, 210, 124,
+			7, 56, 5, 138, 104, 0, 104, 252, 46, 216, 195, 70, 199, 23,
+			88, 189, 186, 72, 81, 137, 116, 84, 156, 54, 136, 113, 101, 217,
+			243, 113, 165, 41, 228, 141, 108, 172, 170, 28, 201, 145, 232, 183,
+			74, 21, 135, 50, 120, 13, 236, 173, 183, 195, 182, 175, 150, 116,
+			0, 93, 218, 239, 42, 126, 13, 224, 146, 126, 87, 153, 206, 1,
+			92, 210, 239, 130, 233, 220, 131, 132, 234, 212, 252, 158, 166, 143,
+			200, 151, 192, 234, 239, 197, 67, 97, 26, 223, 211, 142, 60, 174,
+			154, 6, 52, 79, 14, 227, 33, 111, 0, 182, 246, 59, 117, 125,
+			127, 250, 105, 80, 157, 168, 56, 59, 24, 24, 39, 151, 96, 90,
+			176, 129, 221, 56, 149, 133, 58, 164, 203, 224, 15, 224, 65, 250,
+			157, 186, 148, 252, 1, 220, 200, 239, 212, 119, 237, 85, 77, 68,
+			182, 143, 146, 25, 68, 109, 82, 243, 135, 117, 125, 95, 250, 236,
+			54, 212, 61, 50, 121, 29, 141, 14, 185, 26, 192, 235, 171, 63,
+			28, 99, 4, 3, 255, 195, 250, 174, 61, 170, 105, 64, 115, 112,
+			8, 69, 127, 0, 180, 220, 143, 234, 250, 81, 41, 250, 77, 235,
+			182, 211, 108, 55, 59, 204, 89, 92, 115, 34, 50, 123, 170, 110,
+			27, 62, 170, 46, 63, 35, 156, 35, 108, 150, 215, 45, 76, 94,
+			134, 30, 59, 55, 38, 248, 34, 19, 17, 74, 191, 141, 79, 140,
+			141, 69, 36, 246, 9, 180, 187, 84, 19, 190, 21, 165, 167, 14,
+			169, 38, 124, 43, 74, 63, 242, 38, 20, 253, 189, 52, 249, 227,
+			122, 226, 39, 165, 232, 239, 213, 168, 249, 227, 122, 138, 145, 113,
+			98, 154, 123, 65, 244, 223, 171, 235, 15, 165, 31, 219, 46, 250,
+			210, 194, 171, 165, 145, 168, 247, 162, 184, 191, 87, 151, 226, 190,
+			23, 197, 253, 189, 186, 20, 247, 189, 40, 238, 239, 213, 247, 31,
+			64, 247, 111, 47, 200, 201, 251, 116, 253, 128, 116, 255, 162, 59,
+			215, 254, 86, 232, 120, 203, 92, 252, 149, 12, 17, 171, 83, 217,
+			65, 121, 56, 143, 176, 131, 90, 122, 95, 140, 29, 100, 248, 125,
+			122, 255, 160, 106, 66, 88, 69, 167, 251, 201, 63, 37, 186, 57,
+			72, 147, 239, 215, 19, 191, 170, 107, 233, 133, 8, 220, 78, 49,
+			186, 56, 203, 24, 81, 166, 146, 198, 82, 177, 147, 45, 154, 125,
+			80, 163, 230, 251, 245, 212, 195, 120, 214, 31, 4, 70, 126, 64,
+			191, 219, 89, 127, 16, 25, 247, 1, 69, 250, 32, 50, 238, 3,
+			138, 113, 131, 200, 184, 15, 0, 227, 64, 144, 7, 129, 113, 31,
+			4, 198, 157, 189, 7, 227, 148, 239, 10, 225, 67, 117, 180, 136,
+			48, 2, 179, 62, 24, 99, 4, 102, 125, 80, 49, 107, 16, 55,
+			252, 7, 129, 89, 160, 5, 7, 193, 37, 248, 144, 174, 159, 78,
+			231, 31, 72, 51, 69, 184, 64, 67, 124, 72, 151, 26, 98, 16,
+			149, 241, 135, 116, 169, 92, 6, 81, 67, 124, 72, 151, 202, 101,
+			16, 90, 31, 214, 165, 114, 25, 68, 83, 253, 225, 120, 40, 236,
+			240, 15, 235, 82, 185, 12, 162, 169, 254, 176, 126, 114, 88, 50,
+			198, 164, 230, 71, 196, 14, 87, 38, 244, 129, 119, 248, 32, 238,
+			240, 143, 168, 29, 62, 136, 59, 252, 35, 106, 135, 15, 162, 11,
+			255, 17, 125, 112, 136, 60, 131, 24, 251, 168, 249, 49, 216, 225,
+			79, 253, 32, 59, 92, 130, 134, 125, 251, 49, 181, 111, 7, 33,
+			117, 110, 126, 76, 237, 219, 65, 220, 183, 31, 131, 125, 187, 128,
+			136, 147, 212, 252, 132, 174, 31, 76, 191, 185, 67, 6, 194, 77,
+			105, 62, 229, 25, 157, 219, 247, 47, 14, 201, 62, 4, 168, 196,
+			1, 162, 85, 159, 208, 101, 72, 114, 16, 50, 231, 230, 39, 244,
+			135, 31, 193, 131, 203, 160, 190, 139, 154, 159, 210, 245, 108, 250,
+			66, 183, 73, 87, 136, 48, 174, 18, 209, 112, 87, 180, 187, 146,
+			8, 74, 45, 47, 68, 137, 62, 165, 75, 51, 61, 168, 239, 50,
+			160, 121, 234, 52, 105, 33, 218, 20, 53, 127, 65, 215, 199, 210,
+			213, 24, 45, 120, 215, 114, 141, 55, 224, 19, 49, 220, 109, 55,
+			193, 19, 142, 209, 138, 175, 188, 118, 145, 231, 4, 93, 21, 16,
+			50, 49, 220, 139, 188, 84, 18, 81, 42, 242, 32, 124, 243, 11,
+			186, 60, 206, 13, 66, 90, 213, 252, 5, 61, 151, 71, 7, 110,
+			80, 239, 167, 230, 47, 234, 122, 58, 125, 106, 203, 161, 69, 210,
+			23, 147, 182, 229, 248, 54, 168, 247, 247, 225, 80, 181, 242, 16,
+			12, 249, 69, 93, 30, 223, 6, 245, 126, 3, 154, 143, 28, 66,
+			119, 120, 80, 39, 212, 252, 140, 174, 31, 73, 79, 247, 70, 163,
+			10, 80, 170, 81, 106, 7, 146, 117, 192, 155, 173, 155, 32, 66,
+			79, 32, 154, 20, 163, 135, 3, 222, 103, 64, 123, 201, 38, 68,
+			147, 244, 67, 135, 201, 38, 162, 223, 77, 205, 207, 234, 250, 155,
+			210, 183, 118, 154, 165, 20, 112, 88, 238, 72, 250, 229, 95, 164,
+			148, 236, 205, 118, 156, 51, 73, 199, 88, 33, 188, 27, 42, 1,
+			169, 64, 69, 116, 238, 134, 232, 82, 76, 39, 28, 227, 62, 171,
+			167, 30, 81, 77, 136, 46, 233, 233, 35, 24, 93, 26, 162, 201,
+			95, 211, 19, 191, 35, 13, 219, 16, 68, 151, 244, 212, 97, 114,
+			145, 152, 230, 16, 232, 227, 207, 129, 62, 30, 221, 110, 216, 132,
+			79, 39, 75, 56, 182, 164, 176, 1, 205, 16, 106, 234, 207, 169,
+			141, 50, 132, 154, 250, 115, 74, 83, 15, 161, 166, 254, 28, 104,
+			106, 96, 214, 16, 40, 186, 207, 131, 222, 188, 213, 173, 55, 17,
+			254, 182, 74, 16, 133, 234, 239, 195, 161, 27, 66, 135, 238, 243,
+			74, 116, 135, 208, 161, 251, 188, 210, 185, 67, 168, 223, 63, 175,
+			116, 238, 16, 232, 247, 87, 148, 206, 29, 66, 117, 253, 74, 60,
+			20, 102, 241, 138, 210, 185, 67, 168, 174, 95, 1, 157, 187, 129,
+			83, 52, 168, 249, 219, 32, 15, 78, 215, 183, 143, 149, 41, 146,
+			156, 140, 167, 230, 184, 108, 120, 44, 203, 198, 95, 200, 117, 249,
+			53, 178, 56, 175, 5, 153, 208, 8, 134, 156, 152, 90, 155, 174,
+			72, 240, 16, 250, 126, 191, 29, 47, 4, 88, 134, 223, 214, 251,
+			15, 170, 38, 210, 117, 88, 72, 195, 62, 154, 252, 61, 61, 241,
+			7, 82, 26, 246, 105, 212, 252, 61, 61, 245, 24, 201, 19, 211,
+			220, 7, 210, 240, 42, 72, 195, 177, 237, 210, 32, 39, 17, 85,
+			90, 0, 232, 125, 40, 1, 175, 42, 196, 251, 80, 2, 94, 85,
+			18, 176, 15, 37, 224, 85, 144, 0, 48, 16, 251, 128, 119, 95,
+			0, 91, 253, 212, 14, 182, 90, 192, 222, 230, 226, 116, 22, 29,
+			69, 136, 193, 100, 127, 33, 70, 12, 75, 250, 5, 101, 178, 247,
+			225, 146, 126, 1, 76, 182, 67, 116, 147, 210, 228, 31, 233, 112,
+			71, 32, 253, 60, 219, 94, 90, 178, 221, 197, 113, 37, 33, 138,
+			172, 94, 245, 76, 164, 247, 49, 150, 106, 212, 252, 35, 176, 83,
+			224, 236, 80, 96, 231, 23, 239, 234, 236, 80, 100, 224, 23, 213,
+			60, 40, 50, 240, 139, 138, 129, 20, 25, 248, 69, 96, 224, 40,
+			194, 211, 168, 249, 37, 96, 224, 163, 119, 101, 96, 4, 27, 120,
+			244, 165, 24, 54, 240, 232, 75, 138, 71, 20, 121, 244, 37, 224,
+			17, 124, 59, 145, 130, 216, 127, 5, 182, 103, 238, 65, 182, 103,
+			132, 10, 182, 201, 87, 212, 54, 161, 232, 213, 124, 69, 237, 48,
+			138, 219, 228, 43, 106, 135, 81, 104, 125, 85, 237, 48, 138, 94,
+			205, 87, 227, 161, 32, 187, 95, 85, 59, 140, 162, 87, 243, 85,
+			216, 97, 103, 144, 74, 147, 154, 95, 135, 29, 118, 226, 126, 118,
+			88, 68, 29, 184, 49, 95, 143, 25, 1, 110, 204, 215, 213, 246,
+			160, 232, 198, 124, 93, 63, 44, 226, 237, 20, 14, 42, 127, 166,
+			235, 135, 100, 188, 29, 202, 205, 26, 242, 235, 109, 157, 56, 58,
+			245, 33, 11, 61, 245, 85, 152, 204, 42, 92, 111, 16, 245, 105,
+			121, 245, 177, 161, 252, 216, 248, 196, 228, 153, 179, 231, 158, 120,
+			242, 188, 85, 173, 217, 188, 190, 181, 157, 207, 68, 164, 130, 227,
+			243, 103, 49, 169, 224, 248, 252, 153, 222, 127, 64, 53, 13, 104,
+			62, 114, 16, 173, 44, 5, 166, 127, 3, 28, 159, 83, 247, 118,
+			124, 182, 136, 6, 184, 56, 223, 136, 209, 128, 139, 243, 13, 229,
+			226, 80, 116, 113, 190, 1, 46, 14, 228, 211, 40, 184, 56, 223,
+			4, 23, 231, 241, 110, 23, 71, 238, 146, 110, 7, 71, 33, 0,
+			103, 230, 155, 241, 170, 130, 51, 243, 77, 229, 204, 80, 116, 102,
+			190, 169, 159, 58, 77, 218, 136, 32, 69, 205, 215, 192, 153, 89,
+			237, 70, 160, 74, 218, 162, 63, 57, 182, 173, 76, 77, 24, 115,
+			249, 231, 183, 164, 151, 67, 58, 220, 28, 73, 162, 19, 244, 72,
+			13, 80, 244, 104, 94, 139, 105, 4, 143, 230, 53, 229, 209, 80,
+			244, 104, 94, 3, 143, 102, 25, 105, 236, 167, 230, 235, 224, 106,
+			92, 222, 98, 235, 165, 60, 56, 238, 214, 2, 154, 154, 231, 6,
+			142, 72, 201, 130, 253, 151, 164, 116, 187, 59, 20, 221, 157, 215,
+			149, 29, 167, 232, 238, 188, 174, 252, 13, 138, 238, 206, 235, 224,
+			111, 156, 67, 26, 8, 53, 191, 5, 210, 63, 220, 155, 134, 14,
+			121, 236, 70, 2, 78, 205, 183, 98, 36, 224, 212, 124, 75, 57,
+			11, 84, 39, 6, 52, 211, 71, 164, 80, 237, 166, 230, 183, 117,
+			253, 209, 109, 174, 155, 186, 251, 185, 51, 26, 240, 73, 190, 29,
+			163, 1, 159, 228, 219, 202, 105, 167, 232, 147, 124, 91, 63, 114,
+			180, 154, 108, 249, 94, 232, 77, 254, 223, 1, 0, 116, 21, 232,
+			40, 132, 141, 0, 0},
 	)
 }
 
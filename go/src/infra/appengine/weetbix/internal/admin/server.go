@@ -7,6 +7,7 @@ package admin
 import (
 	"context"
 	"fmt"
+	"sort"
 	"time"
 
 	"google.golang.org/grpc/codes"
@@ -18,9 +19,15 @@ import (
 	"go.chromium.org/luci/common/logging"
 	"go.chromium.org/luci/grpc/appstatus"
 	"go.chromium.org/luci/server/auth"
+	"go.chromium.org/luci/server/span"
 
 	adminpb "infra/appengine/weetbix/internal/admin/proto"
+	"infra/appengine/weetbix/internal/bugs/updater"
 	"infra/appengine/weetbix/internal/config"
+	"infra/appengine/weetbix/internal/maintenance"
+	"infra/appengine/weetbix/internal/services/backfill"
+	"infra/appengine/weetbix/internal/services/chunkexport"
+	"infra/appengine/weetbix/internal/services/projectpurger"
 	"infra/appengine/weetbix/internal/services/testvariantbqexporter"
 	"infra/appengine/weetbix/pbutil"
 	pb "infra/appengine/weetbix/proto/v1"
@@ -179,7 +186,7 @@ func (a *adminServer) ExportTestVariants(ctx context.Context, req *adminpb.Expor
 		err := testvariantbqexporter.Schedule(ctx, req.Realm, req.CloudProject, req.Dataset, req.Table, bqExport.GetPredicate(), &pb.TimeRange{
 			Earliest: timestamppb.New(r.start),
 			Latest:   timestamppb.New(r.end),
-		})
+		}, false)
 		if err != nil {
 			return nil, err
 		}
@@ -188,6 +195,412 @@ func (a *adminServer) ExportTestVariants(ctx context.Context, req *adminpb.Expor
 	return &emptypb.Empty{}, nil
 }
 
+// ListProjectUpdateStatuses implements AdminServer.
+func (a *adminServer) ListProjectUpdateStatuses(ctx context.Context, req *adminpb.ListProjectUpdateStatusesRequest) (*adminpb.ListProjectUpdateStatusesResponse, error) {
+	if err := checkAllowed(ctx, "ListProjectUpdateStatuses"); err != nil {
+		return nil, err
+	}
+
+	statuses, err := updater.ReadStatuses(span.Single(ctx))
+	if err != nil {
+		return nil, errors.Annotate(err, "read project update statuses").Err()
+	}
+	versions, err := config.ProjectVersions(ctx)
+	if err != nil {
+		return nil, errors.Annotate(err, "read project config versions").Err()
+	}
+	res := &adminpb.ListProjectUpdateStatusesResponse{
+		Statuses: make([]*adminpb.ProjectUpdateStatus, 0, len(statuses)),
+	}
+	for _, s := range statuses {
+		status := &adminpb.ProjectUpdateStatus{
+			Project:   s.Project,
+			LastError: s.LastError,
+		}
+		if !s.LastSuccessTime.IsZero() {
+			status.LastSuccessTime = timestamppb.New(s.LastSuccessTime)
+		}
+		if !s.LastErrorTime.IsZero() {
+			status.LastErrorTime = timestamppb.New(s.LastErrorTime)
+		}
+		if v, ok := versions[s.Project]; ok {
+			status.ConfigVersion = configVersionToProto(v)
+		}
+		res.Statuses = append(res.Statuses, status)
+	}
+	return res, nil
+}
+
+// ListProjectConfigVersions implements AdminServer.
+func (a *adminServer) ListProjectConfigVersions(ctx context.Context, req *adminpb.ListProjectConfigVersionsRequest) (*adminpb.ListProjectConfigVersionsResponse, error) {
+	if err := checkAllowed(ctx, "ListProjectConfigVersions"); err != nil {
+		return nil, err
+	}
+
+	versions, err := config.ProjectVersions(ctx)
+	if err != nil {
+		return nil, errors.Annotate(err, "read project config versions").Err()
+	}
+	projects := make([]string, 0, len(versions))
+	for project := range versions {
+		projects = append(projects, project)
+	}
+	sort.Strings(projects)
+
+	res := &adminpb.ListProjectConfigVersionsResponse{
+		Versions: make([]*adminpb.ProjectConfigVersion, 0, len(projects)),
+	}
+	for _, project := range projects {
+		res.Versions = append(res.Versions, &adminpb.ProjectConfigVersion{
+			Project:       project,
+			ConfigVersion: configVersionToProto(versions[project]),
+		})
+	}
+	return res, nil
+}
+
+// PurgeProject implements AdminServer.
+func (a *adminServer) PurgeProject(ctx context.Context, req *adminpb.PurgeProjectRequest) (*adminpb.PurgeProjectResponse, error) {
+	if err := checkAllowed(ctx, "PurgeProject"); err != nil {
+		return nil, err
+	}
+
+	switch {
+	case req.Project == "":
+		return nil, appstatus.BadRequest(unspecified("project"))
+	case !config.ProjectRe.MatchString(req.Project):
+		return nil, appstatus.BadRequest(fmt.Errorf("project %q is not a valid LUCI project name", req.Project))
+	}
+
+	// Guard against purging the data of a project still using Weetbix.
+	projects, err := config.Projects(ctx)
+	if err != nil {
+		return nil, errors.Annotate(err, "read project configs").Err()
+	}
+	if _, ok := projects[req.Project]; ok {
+		return nil, appstatus.Errorf(codes.FailedPrecondition, "project %s still has a config; remove the project config before purging its data", req.Project)
+	}
+
+	var purge *projectpurger.Purge
+	if req.ConfirmToken == "" {
+		purge, err = projectpurger.IssueToken(ctx, req.Project)
+	} else {
+		purge, err = projectpurger.Confirm(ctx, req.Project, req.ConfirmToken)
+	}
+	switch {
+	case err == projectpurger.NotFound:
+		return nil, appstatus.Errorf(codes.FailedPrecondition, "no confirmation token was issued for project %s; call PurgeProject without a confirm_token first", req.Project)
+	case err == projectpurger.ErrTokenMismatch:
+		return nil, appstatus.BadRequest(err)
+	case err == projectpurger.ErrTokenExpired:
+		return nil, appstatus.Errorf(codes.FailedPrecondition, "%s; call PurgeProject without a confirm_token for a new one", err)
+	case err != nil:
+		return nil, errors.Annotate(err, "purge project").Err()
+	}
+
+	counts, err := projectpurger.CountRows(ctx, req.Project)
+	if err != nil {
+		return nil, errors.Annotate(err, "count rows").Err()
+	}
+	res := &adminpb.PurgeProjectResponse{
+		RowCounts:   make([]*adminpb.TableRowCount, 0, len(counts)),
+		RowsDeleted: purge.RowsDeleted,
+	}
+	for _, c := range counts {
+		res.RowCounts = append(res.RowCounts, &adminpb.TableRowCount{
+			Table: c.Table,
+			Rows:  c.Rows,
+		})
+	}
+	if !purge.Started() {
+		res.ConfirmToken = purge.ConfirmToken
+		res.ConfirmTokenExpireTime = timestamppb.New(purge.TokenIssueTime.Add(projectpurger.TokenValidity))
+	} else {
+		res.StartTime = timestamppb.New(purge.StartTime)
+	}
+	if purge.Completed() {
+		res.CompletionTime = timestamppb.New(purge.CompletionTime)
+	}
+	return res, nil
+}
+
+// maxMaintenanceTTL is the maximum duration of a maintenance mode override.
+const maxMaintenanceTTL = 7 * 24 * time.Hour
+
+// SetMaintenanceMode implements AdminServer.
+func (a *adminServer) SetMaintenanceMode(ctx context.Context, req *adminpb.SetMaintenanceModeRequest) (*adminpb.MaintenanceMode, error) {
+	if err := checkAllowed(ctx, "SetMaintenanceMode"); err != nil {
+		return nil, err
+	}
+
+	switch {
+	case req.Project != "" && !config.ProjectRe.MatchString(req.Project):
+		return nil, appstatus.BadRequest(fmt.Errorf("project %q is not a valid LUCI project name", req.Project))
+	case req.ReadOnly && req.Message == "":
+		return nil, appstatus.BadRequest(unspecified("message"))
+	case req.Ttl == nil:
+		return nil, appstatus.BadRequest(unspecified("ttl"))
+	}
+	if err := req.Ttl.CheckValid(); err != nil {
+		return nil, appstatus.BadRequest(errors.Annotate(err, "ttl").Err())
+	}
+	ttl := req.Ttl.AsDuration()
+	if ttl <= 0 || ttl > maxMaintenanceTTL {
+		return nil, appstatus.BadRequest(fmt.Errorf("ttl must be positive and at most %s", maxMaintenanceTTL))
+	}
+
+	m := maintenance.Mode{
+		ReadOnly:   req.ReadOnly,
+		Message:    req.Message,
+		ExpireTime: clock.Now(ctx).Add(ttl),
+	}
+	if err := maintenance.SetOverride(ctx, req.Project, m, string(auth.CurrentIdentity(ctx))); err != nil {
+		return nil, err
+	}
+	return &adminpb.MaintenanceMode{
+		ReadOnly:   m.ReadOnly,
+		Message:    m.Message,
+		ExpireTime: timestamppb.New(m.ExpireTime),
+	}, nil
+}
+
+func validateBackfillProjectRequest(ctx context.Context, req *adminpb.BackfillProjectRequest) error {
+	switch {
+	case req.Project == "":
+		return unspecified("project")
+	case !config.ProjectRe.MatchString(req.Project):
+		return fmt.Errorf("project %q is not a valid LUCI project name", req.Project)
+	case req.StartTime == nil:
+		return unspecified("start_time")
+	case req.EndTime == nil:
+		return unspecified("end_time")
+	case req.BuildsPerMinute < 0 || req.BuildsPerMinute > backfill.MaxBuildsPerMinute:
+		return fmt.Errorf("builds_per_minute must be between 0 and %d", backfill.MaxBuildsPerMinute)
+	}
+	return validateStartEndTime(ctx, req.StartTime, req.EndTime)
+}
+
+// validateStartEndTime validates the time range [start, end) of a request,
+// which must not end in the future.
+func validateStartEndTime(ctx context.Context, start, end *timestamppb.Timestamp) error {
+	if err := start.CheckValid(); err != nil {
+		return errors.Annotate(err, "start_time").Err()
+	}
+	if err := end.CheckValid(); err != nil {
+		return errors.Annotate(err, "end_time").Err()
+	}
+	if !start.AsTime().Before(end.AsTime()) {
+		return fmt.Errorf("start_time must be before end_time")
+	}
+	if end.AsTime().After(clock.Now(ctx)) {
+		return fmt.Errorf("end_time must not be in the future")
+	}
+	return nil
+}
+
+// BackfillProject implements AdminServer.
+func (a *adminServer) BackfillProject(ctx context.Context, req *adminpb.BackfillProjectRequest) (*adminpb.BackfillStatus, error) {
+	if err := checkAllowed(ctx, "BackfillProject"); err != nil {
+		return nil, err
+	}
+
+	if err := validateBackfillProjectRequest(ctx, req); err != nil {
+		return nil, appstatus.BadRequest(err)
+	}
+	projects, err := config.Projects(ctx)
+	if err != nil {
+		return nil, errors.Annotate(err, "read project configs").Err()
+	}
+	if _, ok := projects[req.Project]; !ok {
+		return nil, appstatus.Errorf(codes.FailedPrecondition, "project %s does not have a config", req.Project)
+	}
+
+	opts := backfill.Options{
+		Project:         req.Project,
+		StartTime:       req.StartTime.AsTime(),
+		EndTime:         req.EndTime.AsTime(),
+		Force:           req.Force,
+		BuildsPerMinute: int64(req.BuildsPerMinute),
+	}
+	if opts.BuildsPerMinute == 0 {
+		opts.BuildsPerMinute = backfill.DefaultBuildsPerMinute
+	}
+	var job *backfill.Job
+	if req.DryRun {
+		job, err = backfill.DryRun(ctx, opts)
+	} else {
+		job, err = backfill.Start(ctx, opts, string(auth.CurrentIdentity(ctx)))
+	}
+	switch {
+	case err == backfill.ErrTooManyBuilds:
+		return nil, appstatus.Errorf(codes.FailedPrecondition, "%s; use a shorter time range for dry runs", err)
+	case err == backfill.ErrTooManyJobs:
+		return nil, appstatus.Errorf(codes.ResourceExhausted, "at most %d backfills of project %s may be in progress", backfill.MaxRunningJobs, req.Project)
+	case err != nil:
+		return nil, errors.Annotate(err, "backfill project").Err()
+	}
+	return backfillStatusToProto(job), nil
+}
+
+// GetBackfillStatus implements AdminServer.
+func (a *adminServer) GetBackfillStatus(ctx context.Context, req *adminpb.GetBackfillStatusRequest) (*adminpb.BackfillStatus, error) {
+	if err := checkAllowed(ctx, "GetBackfillStatus"); err != nil {
+		return nil, err
+	}
+
+	switch {
+	case req.Project == "":
+		return nil, appstatus.BadRequest(unspecified("project"))
+	case req.JobId == "":
+		return nil, appstatus.BadRequest(unspecified("job_id"))
+	}
+	job, err := backfill.Read(span.Single(ctx), req.Project, req.JobId)
+	switch {
+	case err == backfill.NotFound:
+		return nil, appstatus.Errorf(codes.NotFound, "backfill %s of project %s not found", req.JobId, req.Project)
+	case err != nil:
+		return nil, err
+	}
+	return backfillStatusToProto(job), nil
+}
+
+func backfillStatusToProto(j *backfill.Job) *adminpb.BackfillStatus {
+	result := &adminpb.BackfillStatus{
+		Project:         j.Project,
+		JobId:           j.JobID,
+		StartTime:       timestamppb.New(j.StartTime),
+		EndTime:         timestamppb.New(j.EndTime),
+		Force:           j.Force,
+		BuildsPerMinute: int32(j.BuildsPerMinute),
+		CreatedBy:       j.CreatedBy,
+		BuildsFound:     j.BuildsFound,
+		BuildsSkipped:   j.BuildsSkipped,
+		BuildsEnqueued:  j.BuildsEnqueued,
+	}
+	if !j.CreationTime.IsZero() {
+		result.CreateTime = timestamppb.New(j.CreationTime)
+	}
+	if j.Completed() {
+		result.CompletionTime = timestamppb.New(j.CompletionTime)
+	}
+	return result
+}
+
+func validateExportChunksRequest(ctx context.Context, req *adminpb.ExportChunksRequest) error {
+	switch {
+	case req.Project == "":
+		return unspecified("project")
+	case !config.ProjectRe.MatchString(req.Project):
+		return fmt.Errorf("project %q is not a valid LUCI project name", req.Project)
+	case req.StartTime == nil:
+		return unspecified("start_time")
+	case req.EndTime == nil:
+		return unspecified("end_time")
+	case req.SampleFraction < 0 || req.SampleFraction > 1:
+		return fmt.Errorf("sample_fraction must be in (0, 1]")
+	}
+	return validateStartEndTime(ctx, req.StartTime, req.EndTime)
+}
+
+// ExportChunks implements AdminServer.
+func (a *adminServer) ExportChunks(ctx context.Context, req *adminpb.ExportChunksRequest) (*adminpb.ChunkExportStatus, error) {
+	if err := checkAllowed(ctx, "ExportChunks"); err != nil {
+		return nil, err
+	}
+
+	if err := validateExportChunksRequest(ctx, req); err != nil {
+		return nil, appstatus.BadRequest(err)
+	}
+	projects, err := config.Projects(ctx)
+	if err != nil {
+		return nil, errors.Annotate(err, "read project configs").Err()
+	}
+	projectCfg, ok := projects[req.Project]
+	if !ok {
+		return nil, appstatus.Errorf(codes.FailedPrecondition, "project %s does not have a config", req.Project)
+	}
+	if !projectCfg.GetChunkExport().GetEnabled() {
+		return nil, appstatus.Errorf(codes.FailedPrecondition, "project %s has not opted in to chunk exports", req.Project)
+	}
+	cfg, err := config.Get(ctx)
+	if err != nil {
+		return nil, errors.Annotate(err, "get config").Err()
+	}
+	if cfg.ChunkExportGcsBucket == "" {
+		return nil, appstatus.Errorf(codes.FailedPrecondition, "no chunk export bucket is configured")
+	}
+
+	opts := chunkexport.Options{
+		Project:        req.Project,
+		StartTime:      req.StartTime.AsTime(),
+		EndTime:        req.EndTime.AsTime(),
+		SampleFraction: req.SampleFraction,
+	}
+	if opts.SampleFraction == 0 {
+		opts.SampleFraction = config.ChunkExportSampleFraction(projectCfg)
+	}
+	job, err := chunkexport.Start(ctx, opts, cfg.ChunkExportGcsBucket, string(auth.CurrentIdentity(ctx)))
+	switch {
+	case err == chunkexport.ErrTooManyJobs:
+		return nil, appstatus.Errorf(codes.ResourceExhausted, "at most %d chunk exports of project %s may be in progress", chunkexport.MaxRunningJobs, req.Project)
+	case err != nil:
+		return nil, errors.Annotate(err, "export chunks").Err()
+	}
+	return chunkExportStatusToProto(job), nil
+}
+
+// GetChunkExportStatus implements AdminServer.
+func (a *adminServer) GetChunkExportStatus(ctx context.Context, req *adminpb.GetChunkExportStatusRequest) (*adminpb.ChunkExportStatus, error) {
+	if err := checkAllowed(ctx, "GetChunkExportStatus"); err != nil {
+		return nil, err
+	}
+
+	switch {
+	case req.Project == "":
+		return nil, appstatus.BadRequest(unspecified("project"))
+	case req.JobId == "":
+		return nil, appstatus.BadRequest(unspecified("job_id"))
+	}
+	job, err := chunkexport.Read(span.Single(ctx), req.Project, req.JobId)
+	switch {
+	case err == chunkexport.NotFound:
+		return nil, appstatus.Errorf(codes.NotFound, "chunk export %s of project %s not found", req.JobId, req.Project)
+	case err != nil:
+		return nil, err
+	}
+	return chunkExportStatusToProto(job), nil
+}
+
+func chunkExportStatusToProto(j *chunkexport.Job) *adminpb.ChunkExportStatus {
+	result := &adminpb.ChunkExportStatus{
+		Project:          j.Project,
+		JobId:            j.JobID,
+		StartTime:        timestamppb.New(j.StartTime),
+		EndTime:          timestamppb.New(j.EndTime),
+		SampleFraction:   j.SampleFraction,
+		Destination:      fmt.Sprintf("gs://%s/%s", j.Bucket, j.Prefix()),
+		CreatedBy:        j.CreatedBy,
+		CreateTime:       timestamppb.New(j.CreationTime),
+		ChunksScanned:    j.ChunksScanned,
+		ChunksExported:   j.ChunksExported,
+		FailuresExported: j.FailuresExported,
+	}
+	if j.Completed() {
+		result.CompletionTime = timestamppb.New(j.CompletionTime)
+	}
+	return result
+}
+
+func configVersionToProto(v config.ConfigVersion) *adminpb.ConfigVersion {
+	result := &adminpb.ConfigVersion{
+		Revision: v.Revision,
+	}
+	if !v.FetchTime.IsZero() {
+		result.FetchTime = timestamppb.New(v.FetchTime)
+	}
+	return result
+}
+
 func checkAllowed(ctx context.Context, name string) error {
 	switch yes, err := auth.IsMember(ctx, allowGroup); {
 	case err != nil:
@@ -10,21 +10,29 @@ import (
 	"testing"
 	"time"
 
+	"github.com/golang/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
+	bbpb "go.chromium.org/luci/buildbucket/proto"
 	"go.chromium.org/luci/gae/impl/memory"
 	"go.chromium.org/luci/server/auth"
 	"go.chromium.org/luci/server/auth/authtest"
 	"go.chromium.org/luci/server/tq"
 
 	adminpb "infra/appengine/weetbix/internal/admin/proto"
+	"infra/appengine/weetbix/internal/buildbucket"
 	"infra/appengine/weetbix/internal/config"
+	"infra/appengine/weetbix/internal/maintenance"
+	"infra/appengine/weetbix/internal/services/backfill"
 	"infra/appengine/weetbix/internal/services/testvariantbqexporter"
 	"infra/appengine/weetbix/internal/tasks/taskspb"
 	pb "infra/appengine/weetbix/proto/v1"
 
 	. "github.com/smartystreets/goconvey/convey"
 	"go.chromium.org/luci/common/clock"
+	"go.chromium.org/luci/common/clock/testclock"
 	. "go.chromium.org/luci/common/testing/assertions"
 )
 
@@ -318,3 +326,226 @@ func TestExportTestVariants(t *testing.T) {
 		So(payloads, ShouldResembleProto, tasks)
 	})
 }
+
+func TestListProjectConfigVersions(t *testing.T) {
+	t.Parallel()
+	Convey("ListProjectConfigVersions", t, func() {
+		ctx := memory.Use(context.Background())
+		now := time.Date(2021, time.December, 1, 12, 0, 0, 0, time.UTC)
+		ctx, _ = testclock.UseTime(ctx, now)
+		ctx = auth.WithState(ctx, &authtest.FakeState{
+			Identity:       "user:admin@example.com",
+			IdentityGroups: []string{allowGroup},
+		})
+		So(config.SetTestProjectConfig(ctx, map[string]*config.ProjectConfig{
+			"project-b": {},
+			"project-a": {},
+		}), ShouldBeNil)
+
+		server := CreateServer()
+		res, err := server.ListProjectConfigVersions(ctx, &adminpb.ListProjectConfigVersionsRequest{})
+		So(err, ShouldBeNil)
+		So(res, ShouldResembleProto, &adminpb.ListProjectConfigVersionsResponse{
+			Versions: []*adminpb.ProjectConfigVersion{
+				{
+					Project:       "project-a",
+					ConfigVersion: &adminpb.ConfigVersion{FetchTime: timestamppb.New(now)},
+				},
+				{
+					Project:       "project-b",
+					ConfigVersion: &adminpb.ConfigVersion{FetchTime: timestamppb.New(now)},
+				},
+			},
+		})
+	})
+}
+
+func TestSetMaintenanceMode(t *testing.T) {
+	t.Parallel()
+	Convey("SetMaintenanceMode", t, func() {
+		ctx := memory.Use(context.Background())
+		now := time.Date(2021, time.December, 1, 12, 0, 0, 0, time.UTC)
+		ctx, _ = testclock.UseTime(ctx, now)
+		ctx = auth.WithState(ctx, &authtest.FakeState{
+			Identity:       "user:admin@example.com",
+			IdentityGroups: []string{allowGroup},
+		})
+		So(config.SetTestConfig(ctx, &config.Config{}), ShouldBeNil)
+
+		server := CreateServer()
+		req := &adminpb.SetMaintenanceModeRequest{
+			Project:  "chromium",
+			ReadOnly: true,
+			Message:  "Database maintenance.",
+			Ttl:      durationpb.New(time.Hour),
+		}
+
+		Convey("Sets the maintenance mode", func() {
+			res, err := server.SetMaintenanceMode(ctx, req)
+			So(err, ShouldBeNil)
+			expected := maintenance.Mode{
+				ReadOnly:   true,
+				Message:    "Database maintenance.",
+				ExpireTime: now.Add(time.Hour),
+			}
+			So(res, ShouldResembleProto, &adminpb.MaintenanceMode{
+				ReadOnly:   true,
+				Message:    "Database maintenance.",
+				ExpireTime: timestamppb.New(now.Add(time.Hour)),
+			})
+
+			m, err := maintenance.Get(ctx, "chromium")
+			So(err, ShouldBeNil)
+			So(m, ShouldResemble, expected)
+
+			m, err = maintenance.Get(ctx, "chromeos")
+			So(err, ShouldBeNil)
+			So(m, ShouldResemble, maintenance.Mode{})
+		})
+		Convey("Invalid project", func() {
+			req.Project = "Chromium"
+			_, err := server.SetMaintenanceMode(ctx, req)
+			So(err, ShouldHaveAppStatus, codes.InvalidArgument, "not a valid LUCI project name")
+		})
+		Convey("Message required", func() {
+			req.Message = ""
+			_, err := server.SetMaintenanceMode(ctx, req)
+			So(err, ShouldHaveAppStatus, codes.InvalidArgument, "message is not specified")
+		})
+		Convey("TTL too long", func() {
+			req.Ttl = durationpb.New(8 * 24 * time.Hour)
+			_, err := server.SetMaintenanceMode(ctx, req)
+			So(err, ShouldHaveAppStatus, codes.InvalidArgument, "ttl must be positive")
+		})
+	})
+}
+
+func TestBackfillProject(t *testing.T) {
+	t.Parallel()
+	Convey("BackfillProject", t, func() {
+		ctx := memory.Use(context.Background())
+		now := time.Date(2021, time.December, 1, 12, 0, 0, 0, time.UTC)
+		ctx, _ = testclock.UseTime(ctx, now)
+		ctx = auth.WithState(ctx, &authtest.FakeState{
+			Identity:       "user:admin@example.com",
+			IdentityGroups: []string{allowGroup},
+		})
+		So(config.SetTestProjectConfig(ctx, createProjectsConfig()), ShouldBeNil)
+
+		server := CreateServer()
+		req := &adminpb.BackfillProjectRequest{
+			Project:   "chromium",
+			StartTime: timestamppb.New(now.Add(-48 * time.Hour)),
+			EndTime:   timestamppb.New(now.Add(-24 * time.Hour)),
+			DryRun:    true,
+			Force:     true,
+		}
+
+		Convey("Dry run", func() {
+			ctl := gomock.NewController(t)
+			defer ctl.Finish()
+			mbc := buildbucket.NewMockedClient(ctx, ctl)
+			mbc.Client.EXPECT().SearchBuilds(gomock.Any(), gomock.Any(), gomock.Any()).Return(&bbpb.SearchBuildsResponse{
+				Builds: []*bbpb.Build{{Id: 1}, {Id: 2}},
+			}, nil)
+
+			res, err := server.BackfillProject(mbc.Ctx, req)
+			So(err, ShouldBeNil)
+			So(res, ShouldResembleProto, &adminpb.BackfillStatus{
+				Project:         "chromium",
+				StartTime:       req.StartTime,
+				EndTime:         req.EndTime,
+				Force:           true,
+				BuildsPerMinute: backfill.DefaultBuildsPerMinute,
+				BuildsFound:     2,
+				BuildsEnqueued:  2,
+			})
+		})
+		Convey("Invalid project", func() {
+			req.Project = "Chromium"
+			_, err := server.BackfillProject(ctx, req)
+			So(err, ShouldHaveAppStatus, codes.InvalidArgument, "not a valid LUCI project name")
+		})
+		Convey("Project without config", func() {
+			req.Project = "chromeos"
+			_, err := server.BackfillProject(ctx, req)
+			So(err, ShouldHaveAppStatus, codes.FailedPrecondition, "does not have a config")
+		})
+		Convey("Start time after end time", func() {
+			req.StartTime = timestamppb.New(now.Add(-time.Hour))
+			_, err := server.BackfillProject(ctx, req)
+			So(err, ShouldHaveAppStatus, codes.InvalidArgument, "start_time must be before end_time")
+		})
+		Convey("End time in the future", func() {
+			req.EndTime = timestamppb.New(now.Add(time.Hour))
+			_, err := server.BackfillProject(ctx, req)
+			So(err, ShouldHaveAppStatus, codes.InvalidArgument, "end_time must not be in the future")
+		})
+		Convey("Rate too high", func() {
+			req.BuildsPerMinute = backfill.MaxBuildsPerMinute + 1
+			_, err := server.BackfillProject(ctx, req)
+			So(err, ShouldHaveAppStatus, codes.InvalidArgument, "builds_per_minute must be between")
+		})
+	})
+}
+
+func TestExportChunks(t *testing.T) {
+	t.Parallel()
+	Convey("ExportChunks", t, func() {
+		ctx := memory.Use(context.Background())
+		now := time.Date(2021, time.December, 1, 12, 0, 0, 0, time.UTC)
+		ctx, _ = testclock.UseTime(ctx, now)
+		ctx = auth.WithState(ctx, &authtest.FakeState{
+			Identity:       "user:admin@example.com",
+			IdentityGroups: []string{allowGroup},
+		})
+		projectCfg := createProjectsConfig()
+		projectCfg["chromium"].ChunkExport = &config.ChunkExport{Enabled: true}
+		projectCfg["chromeos"] = &config.ProjectConfig{}
+		So(config.SetTestProjectConfig(ctx, projectCfg), ShouldBeNil)
+		So(config.SetTestConfig(ctx, &config.Config{ChunkExportGcsBucket: "exports-bucket"}), ShouldBeNil)
+
+		server := CreateServer()
+		req := &adminpb.ExportChunksRequest{
+			Project:   "chromium",
+			StartTime: timestamppb.New(now.Add(-48 * time.Hour)),
+			EndTime:   timestamppb.New(now.Add(-24 * time.Hour)),
+		}
+
+		Convey("Invalid project", func() {
+			req.Project = "Chromium"
+			_, err := server.ExportChunks(ctx, req)
+			So(err, ShouldHaveAppStatus, codes.InvalidArgument, "not a valid LUCI project name")
+		})
+		Convey("Project without config", func() {
+			req.Project = "fuchsia"
+			_, err := server.ExportChunks(ctx, req)
+			So(err, ShouldHaveAppStatus, codes.FailedPrecondition, "does not have a config")
+		})
+		Convey("Project not opted in", func() {
+			req.Project = "chromeos"
+			_, err := server.ExportChunks(ctx, req)
+			So(err, ShouldHaveAppStatus, codes.FailedPrecondition, "has not opted in")
+		})
+		Convey("No bucket configured", func() {
+			So(config.SetTestConfig(ctx, &config.Config{}), ShouldBeNil)
+			_, err := server.ExportChunks(ctx, req)
+			So(err, ShouldHaveAppStatus, codes.FailedPrecondition, "no chunk export bucket")
+		})
+		Convey("Start time after end time", func() {
+			req.StartTime = timestamppb.New(now.Add(-time.Hour))
+			_, err := server.ExportChunks(ctx, req)
+			So(err, ShouldHaveAppStatus, codes.InvalidArgument, "start_time must be before end_time")
+		})
+		Convey("End time in the future", func() {
+			req.EndTime = timestamppb.New(now.Add(time.Hour))
+			_, err := server.ExportChunks(ctx, req)
+			So(err, ShouldHaveAppStatus, codes.InvalidArgument, "end_time must not be in the future")
+		})
+		Convey("Invalid sample fraction", func() {
+			req.SampleFraction = 1.5
+			_, err := server.ExportChunks(ctx, req)
+			So(err, ShouldHaveAppStatus, codes.InvalidArgument, "sample_fraction must be in (0, 1]")
+		})
+	})
+}
@@ -0,0 +1,405 @@
+// Copyright 2021 The Chromium Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+// Package aip implements the filtering (https://google.aip.dev/160) and
+// ordering (https://google.aip.dev/132#ordering) of list requests shared by
+// all Weetbix list APIs.
+//
+// Filters and orderings are checked against a Table, the whitelist of
+// the fields of a list API, and translated to parameterized SQL over the
+// Spanner or BigQuery columns backing those fields. Values supplied by
+// the user are only ever passed as query parameters.
+//
+// The supported filter syntax is the subset of AIP-160 of restrictions
+// comparing a field with a value, combined with AND, OR, NOT (or "-") and
+// parentheses, e.g.
+//
+//	bug.system = "monorail" AND (isStale = true OR -ruleDefinition:"flaky")
+//
+// Unsupported constructs, such as functions and bare values, are rejected
+// with an InvalidArgument error giving the position of the offending
+// token.
+package aip
+
+import (
+	"fmt"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+
+	"go.chromium.org/luci/grpc/appstatus"
+)
+
+// Expr is a node of the syntax tree of a filter.
+type Expr interface {
+	isExpr()
+}
+
+// And is the conjunction of expressions.
+type And struct {
+	Args []Expr
+}
+
+// Or is the disjunction of expressions.
+type Or struct {
+	Args []Expr
+}
+
+// Not is the negation of an expression.
+type Not struct {
+	Arg Expr
+}
+
+// Restriction compares a field with a value, e.g. `bug.id = "123"`.
+// Positions are the 1-based positions in the filter text.
+type Restriction struct {
+	Field         string
+	FieldPos      int
+	Comparator    string
+	ComparatorPos int
+	// Value is the unquoted value.
+	Value    string
+	ValuePos int
+	// Quoted is true if the value was given as a quoted string.
+	Quoted bool
+}
+
+func (*And) isExpr()         {}
+func (*Or) isExpr()          {}
+func (*Not) isExpr()         {}
+func (*Restriction) isExpr() {}
+
+// comparators are the supported comparators, longest first.
+var comparators = []string{"!=", "<=", ">=", "=", "<", ">", ":"}
+
+// Keywords of the filter syntax.
+const (
+	keywordAnd = "AND"
+	keywordOr  = "OR"
+	keywordNot = "NOT"
+)
+
+// ParseFilter parses an AIP-160 filter into its syntax tree. It returns a
+// nil Expr for an empty filter.
+func ParseFilter(text string) (Expr, error) {
+	toks, err := lex(text)
+	if err != nil {
+		return nil, err
+	}
+	p := &parser{toks: toks}
+	if p.peek().kind == tokenEOF {
+		return nil, nil
+	}
+	e, err := p.parseExpression()
+	if err != nil {
+		return nil, err
+	}
+	if t := p.peek(); t.kind != tokenEOF {
+		return nil, t.errorf("unexpected %s", t)
+	}
+	return e, nil
+}
+
+// positionErrorf returns an InvalidArgument error for the text at the
+// 1-based position.
+func positionErrorf(pos int, format string, args ...interface{}) error {
+	return appstatus.Errorf(codes.InvalidArgument, "%s at position %d", fmt.Sprintf(format, args...), pos)
+}
+
+type tokenKind int
+
+const (
+	tokenEOF tokenKind = iota
+	// tokenText is unquoted text, e.g. a field name, a keyword or a value.
+	tokenText
+	// tokenString is a quoted string.
+	tokenString
+	tokenLParen
+	tokenRParen
+	tokenComparator
+)
+
+type token struct {
+	kind tokenKind
+	// value is the text of the token, unquoted for strings.
+	value string
+	// pos is the 1-based position of the token in the filter.
+	pos int
+}
+
+func (t token) String() string {
+	switch t.kind {
+	case tokenEOF:
+		return "end of filter"
+	case tokenString:
+		return fmt.Sprintf("string %q", t.value)
+	default:
+		return fmt.Sprintf("%q", t.value)
+	}
+}
+
+func (t token) errorf(format string, args ...interface{}) error {
+	return positionErrorf(t.pos, format, args...)
+}
+
+func (t token) isKeyword(k string) bool {
+	return t.kind == tokenText && t.value == k
+}
+
+// isTextDelimiter returns true if the character ends unquoted text.
+func isTextDelimiter(c byte) bool {
+	return strings.IndexByte(" \t\r\n()=!<>:\"',", c) >= 0
+}
+
+// lex splits the filter into tokens, terminated by a tokenEOF.
+func lex(text string) ([]token, error) {
+	var toks []token
+	for i := 0; i < len(text); {
+		c := text[i]
+		pos := i + 1
+		switch {
+		case c == ' ' || c == '\t' || c == '\r' || c == '\n':
+			i++
+		case c == '(':
+			toks = append(toks, token{kind: tokenLParen, value: "(", pos: pos})
+			i++
+		case c == ')':
+			toks = append(toks, token{kind: tokenRParen, value: ")", pos: pos})
+			i++
+		case c == '"' || c == '\'':
+			value, n, err := lexString(text[i:], pos)
+			if err != nil {
+				return nil, err
+			}
+			toks = append(toks, token{kind: tokenString, value: value, pos: pos})
+			i += n
+		case strings.IndexByte("=!<>:", c) >= 0:
+			var comparator string
+			for _, cmp := range comparators {
+				if strings.HasPrefix(text[i:], cmp) {
+					comparator = cmp
+					break
+				}
+			}
+			if comparator == "" {
+				return nil, positionErrorf(pos, "unexpected %q", string(c))
+			}
+			toks = append(toks, token{kind: tokenComparator, value: comparator, pos: pos})
+			i += len(comparator)
+		case c == ',':
+			return nil, positionErrorf(pos, "unexpected \",\"")
+		default:
+			j := i
+			for j < len(text) && !isTextDelimiter(text[j]) {
+				j++
+			}
+			toks = append(toks, token{kind: tokenText, value: text[i:j], pos: pos})
+			i = j
+		}
+	}
+	return append(toks, token{kind: tokenEOF, pos: len(text) + 1}), nil
+}
+
+// lexString reads the quoted string at the start of text. It returns the
+// unquoted string and the length of the quoted string.
+// A backslash escapes the following character.
+func lexString(text string, pos int) (string, int, error) {
+	quote := text[0]
+	var b strings.Builder
+	for i := 1; i < len(text); i++ {
+		switch c := text[i]; {
+		case c == quote:
+			return b.String(), i + 1, nil
+		case c == '\\':
+			if i+1 == len(text) {
+				return "", 0, positionErrorf(pos, "unterminated string")
+			}
+			i++
+			b.WriteByte(text[i])
+		default:
+			b.WriteByte(c)
+		}
+	}
+	return "", 0, positionErrorf(pos, "unterminated string")
+}
+
+// parser is a recursive descent parser of the AIP-160 grammar, in which OR
+// binds more tightly than AND.
+type parser struct {
+	toks []token
+	i    int
+}
+
+func (p *parser) peek() token {
+	return p.toks[p.i]
+}
+
+func (p *parser) next() token {
+	t := p.toks[p.i]
+	if t.kind != tokenEOF {
+		p.i++
+	}
+	return t
+}
+
+// parseExpression parses sequences joined by AND.
+func (p *parser) parseExpression() (Expr, error) {
+	var args []Expr
+	for {
+		e, err := p.parseSequence()
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, e)
+		if !p.peek().isKeyword(keywordAnd) {
+			break
+		}
+		p.next()
+	}
+	return newAnd(args), nil
+}
+
+// parseSequence parses factors separated by whitespace only, which are
+// implicitly joined by AND.
+func (p *parser) parseSequence() (Expr, error) {
+	var args []Expr
+	for {
+		e, err := p.parseFactor()
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, e)
+		if !p.startsTerm() {
+			break
+		}
+	}
+	return newAnd(args), nil
+}
+
+// startsTerm returns true if the next token starts a term.
+func (p *parser) startsTerm() bool {
+	t := p.peek()
+	switch t.kind {
+	case tokenLParen, tokenString:
+		return true
+	case tokenText:
+		return !t.isKeyword(keywordAnd) && !t.isKeyword(keywordOr)
+	default:
+		return false
+	}
+}
+
+// parseFactor parses terms joined by OR.
+func (p *parser) parseFactor() (Expr, error) {
+	var args []Expr
+	for {
+		e, err := p.parseTerm()
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, e)
+		if !p.peek().isKeyword(keywordOr) {
+			break
+		}
+		p.next()
+	}
+	if len(args) == 1 {
+		return args[0], nil
+	}
+	return &Or{Args: args}, nil
+}
+
+// parseTerm parses a simple expression, optionally negated by NOT or "-".
+func (p *parser) parseTerm() (Expr, error) {
+	t := p.peek()
+	switch {
+	case t.isKeyword(keywordNot):
+		p.next()
+		e, err := p.parseSimple()
+		if err != nil {
+			return nil, err
+		}
+		return &Not{Arg: e}, nil
+	case t.kind == tokenText && strings.HasPrefix(t.value, "-"):
+		if t.value == "-" {
+			p.next()
+		} else {
+			// The negation is part of the text of the field name.
+			p.toks[p.i] = token{kind: tokenText, value: t.value[1:], pos: t.pos + 1}
+		}
+		e, err := p.parseSimple()
+		if err != nil {
+			return nil, err
+		}
+		return &Not{Arg: e}, nil
+	default:
+		return p.parseSimple()
+	}
+}
+
+// parseSimple parses a parenthesized expression or a restriction.
+func (p *parser) parseSimple() (Expr, error) {
+	if p.peek().kind != tokenLParen {
+		return p.parseRestriction()
+	}
+	p.next()
+	e, err := p.parseExpression()
+	if err != nil {
+		return nil, err
+	}
+	if t := p.next(); t.kind != tokenRParen {
+		return nil, t.errorf("expected \")\", got %s", t)
+	}
+	return e, nil
+}
+
+// parseRestriction parses a restriction comparing a field with a value.
+func (p *parser) parseRestriction() (Expr, error) {
+	field := p.next()
+	switch {
+	case field.kind == tokenString:
+		return nil, field.errorf("expected a field name, got %s; bare values are not supported", field)
+	case field.kind != tokenText || isKeyword(field.value):
+		return nil, field.errorf("expected a field name, got %s", field)
+	}
+	cmp := p.next()
+	switch cmp.kind {
+	case tokenComparator:
+	case tokenLParen:
+		return nil, field.errorf("function %q is not supported", field.value)
+	default:
+		return nil, field.errorf("expected a comparator after field %q; bare values are not supported", field.value)
+	}
+	value := p.next()
+	switch {
+	case value.kind == tokenString:
+	case value.kind != tokenText || isKeyword(value.value):
+		return nil, value.errorf("expected a value, got %s", value)
+	}
+	if t := p.peek(); t.kind == tokenLParen {
+		return nil, value.errorf("function %q is not supported", value.value)
+	}
+	return &Restriction{
+		Field:         field.value,
+		FieldPos:      field.pos,
+		Comparator:    cmp.value,
+		ComparatorPos: cmp.pos,
+		Value:         value.value,
+		ValuePos:      value.pos,
+		Quoted:        value.kind == tokenString,
+	}, nil
+}
+
+func isKeyword(s string) bool {
+	return s == keywordAnd || s == keywordOr || s == keywordNot
+}
+
+// newAnd returns the conjunction of the expressions, or the expression
+// itself if there is only one.
+func newAnd(args []Expr) Expr {
+	if len(args) == 1 {
+		return args[0]
+	}
+	return &And{Args: args}
+}
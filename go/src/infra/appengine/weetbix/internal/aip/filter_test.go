@@ -0,0 +1,186 @@
+// Copyright 2021 The Chromium Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package aip
+
+import (
+	"testing"
+
+	"google.golang.org/grpc/codes"
+
+	. "github.com/smartystreets/goconvey/convey"
+	. "go.chromium.org/luci/common/testing/assertions"
+)
+
+// restriction returns a restriction of the field with an unquoted value,
+// at the positions of the filter `{field}{comparator}{value}`.
+func restriction(field, comparator, value string) *Restriction {
+	return &Restriction{
+		Field:         field,
+		FieldPos:      1,
+		Comparator:    comparator,
+		ComparatorPos: len(field) + 1,
+		Value:         value,
+		ValuePos:      len(field) + len(comparator) + 1,
+	}
+}
+
+// shape returns the structure of an expression without positions, e.g.
+// `AND(a = 1, NOT(b:"x"))`.
+func shape(e Expr) string {
+	switch e := e.(type) {
+	case nil:
+		return "<nil>"
+	case *And:
+		return "AND(" + shapes(e.Args) + ")"
+	case *Or:
+		return "OR(" + shapes(e.Args) + ")"
+	case *Not:
+		return "NOT(" + shape(e.Arg) + ")"
+	case *Restriction:
+		value := e.Value
+		if e.Quoted {
+			value = `"` + value + `"`
+		}
+		return e.Field + " " + e.Comparator + " " + value
+	default:
+		panic("unknown expression")
+	}
+}
+
+func shapes(es []Expr) string {
+	s := ""
+	for i, e := range es {
+		if i > 0 {
+			s += ", "
+		}
+		s += shape(e)
+	}
+	return s
+}
+
+func TestParseFilter(t *testing.T) {
+	t.Parallel()
+
+	Convey(`ParseFilter`, t, func() {
+		Convey(`Empty`, func() {
+			for _, text := range []string{"", "  ", "\t\n"} {
+				e, err := ParseFilter(text)
+				So(err, ShouldBeNil)
+				So(e, ShouldBeNil)
+			}
+		})
+		Convey(`Restrictions`, func() {
+			for _, c := range []string{"=", "!=", "<", "<=", ">", ">=", ":"} {
+				e, err := ParseFilter("field" + c + "value")
+				So(err, ShouldBeNil)
+				So(e, ShouldResemble, restriction("field", c, "value"))
+			}
+		})
+		Convey(`Positions`, func() {
+			e, err := ParseFilter(`  bug.id  !=  "a b"`)
+			So(err, ShouldBeNil)
+			So(e, ShouldResemble, &Restriction{
+				Field:         "bug.id",
+				FieldPos:      3,
+				Comparator:    "!=",
+				ComparatorPos: 11,
+				Value:         "a b",
+				ValuePos:      15,
+				Quoted:        true,
+			})
+		})
+		Convey(`Values`, func() {
+			cases := map[string]string{
+				`a = b`:                  `a = b`,
+				`a = -5`:                 `a = -5`,
+				`a = 1.5`:                `a = 1.5`,
+				`a = "x y"`:              `a = "x y"`,
+				`a = 'x y'`:              `a = "x y"`,
+				`a = "say \"hi\""`:       `a = "say "hi""`,
+				`a = 'it\'s'`:            `a = "it's"`,
+				`a = "back\\slash"`:      `a = "back\slash"`,
+				`a = "(AND)"`:            `a = "(AND)"`,
+				`a = "2021-01-02T03:04Z"`: `a = "2021-01-02T03:04Z"`,
+				`a = ""`:                 `a = ""`,
+				`a.b.c = *`:              `a.b.c = *`,
+			}
+			for text, want := range cases {
+				e, err := ParseFilter(text)
+				So(err, ShouldBeNil)
+				So(shape(e), ShouldEqual, want)
+			}
+		})
+		Convey(`Operators`, func() {
+			cases := map[string]string{
+				`a=1 AND b=2`:          `AND(a = 1, b = 2)`,
+				`a=1 b=2 c=3`:          `AND(a = 1, b = 2, c = 3)`,
+				`a=1 OR b=2`:           `OR(a = 1, b = 2)`,
+				`a=1 OR b=2 OR c=3`:    `OR(a = 1, b = 2, c = 3)`,
+				`NOT a=1`:              `NOT(a = 1)`,
+				`-a=1`:                 `NOT(a = 1)`,
+				`- a=1`:                `NOT(a = 1)`,
+				`-(a=1 OR b=2)`:        `NOT(OR(a = 1, b = 2))`,
+				`NOT (a=1 AND b=2)`:    `NOT(AND(a = 1, b = 2))`,
+				`(a=1)`:                `a = 1`,
+				`((a=1))`:              `a = 1`,
+				`a=1 AND (b=2 OR c=3)`: `AND(a = 1, OR(b = 2, c = 3))`,
+				`(a=1 AND b=2) OR c=3`:  `OR(AND(a = 1, b = 2), c = 3)`,
+				// OR binds more tightly than AND.
+				`a=1 AND b=2 OR c=3`: `AND(a = 1, OR(b = 2, c = 3))`,
+				`a=1 OR b=2 AND c=3`: `AND(OR(a = 1, b = 2), c = 3)`,
+				// Sequences bind more tightly than AND.
+				`a=1 b=2 AND c=3`: `AND(AND(a = 1, b = 2), c = 3)`,
+				// Keywords are case-sensitive, and only keywords in
+				// operator position.
+				`and=1 or=2`:     `AND(and = 1, or = 2)`,
+				`a="AND" b="OR"`: `AND(a = "AND", b = "OR")`,
+			}
+			for text, want := range cases {
+				e, err := ParseFilter(text)
+				So(err, ShouldBeNil)
+				So(shape(e), ShouldEqual, want)
+			}
+		})
+		Convey(`Negated field positions`, func() {
+			e, err := ParseFilter(`-a=1`)
+			So(err, ShouldBeNil)
+			So(e.(*Not).Arg.(*Restriction).FieldPos, ShouldEqual, 2)
+		})
+		Convey(`Invalid filters`, func() {
+			cases := []struct {
+				text string
+				err  string
+			}{
+				{`a`, `expected a comparator after field "a"; bare values are not supported at position 1`},
+				{`"a"`, `expected a field name, got string "a"; bare values are not supported at position 1`},
+				{`a=1 b`, `expected a comparator after field "b"; bare values are not supported at position 5`},
+				{`a=`, `expected a value, got end of filter at position 3`},
+				{`a==1`, `expected a value, got "=" at position 3`},
+				{`=1`, `expected a field name, got "=" at position 1`},
+				{`a=1 AND`, `expected a field name, got end of filter at position 8`},
+				{`a=1 OR OR b=2`, `expected a field name, got "OR" at position 8`},
+				{`AND a=1`, `expected a field name, got "AND" at position 1`},
+				{`NOT`, `expected a field name, got end of filter at position 4`},
+				{`NOT NOT a=1`, `expected a field name, got "NOT" at position 5`},
+				{`a=NOT`, `expected a value, got "NOT" at position 3`},
+				{`(a=1`, `expected ")", got end of filter at position 5`},
+				{`a=1)`, `unexpected ")" at position 4`},
+				{`()`, `expected a field name, got ")" at position 2`},
+				{`f(a)`, `function "f" is not supported at position 1`},
+				{`a=f(b)`, `function "f" is not supported at position 3`},
+				{`a.f(b)=1`, `function "a.f" is not supported at position 1`},
+				{`f(a, b)`, `unexpected "," at position 4`},
+				{`a!1`, `unexpected "!" at position 2`},
+				{`a="b`, `unterminated string at position 3`},
+				{`a='b\'`, `unterminated string at position 3`},
+				{`a="b\`, `unterminated string at position 3`},
+			}
+			for _, c := range cases {
+				_, err := ParseFilter(c.text)
+				So(err, ShouldHaveAppStatus, codes.InvalidArgument, c.err)
+			}
+		})
+	})
+}
@@ -0,0 +1,91 @@
+// Copyright 2021 The Chromium Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package aip
+
+import (
+	"strings"
+)
+
+// OrderBy is a field to order by.
+type OrderBy struct {
+	Column     *Column
+	Descending bool
+}
+
+// ParseOrderBy parses an AIP-132 ordering, the comma-separated list of the
+// fields to order by, each optionally followed by " desc" for descending
+// order, e.g. "lastUpdated desc, bug.id". The fields must be sortable
+// fields of the table.
+// An empty ordering returns no fields.
+func (t *Table) ParseOrderBy(text string) ([]OrderBy, error) {
+	if strings.TrimSpace(text) == "" {
+		return nil, nil
+	}
+	var result []OrderBy
+	seen := make(map[string]bool)
+	offset := 0
+	for _, part := range strings.Split(text, ",") {
+		ws := words(part, offset)
+		if len(ws) == 0 {
+			return nil, positionErrorf(offset+1, "expected a field name")
+		}
+		offset += len(part) + 1
+
+		name := ws[0]
+		c, ok := t.columns[name.value]
+		if !ok || !c.Sortable {
+			return nil, name.errorf("field %q cannot be ordered by", name.value)
+		}
+		if seen[name.value] {
+			return nil, name.errorf("field %q is ordered by more than once", name.value)
+		}
+		seen[name.value] = true
+
+		o := OrderBy{Column: c}
+		rest := ws[1:]
+		if len(rest) > 0 && rest[0].value == "desc" {
+			o.Descending = true
+			rest = rest[1:]
+		}
+		if len(rest) > 0 {
+			return nil, rest[0].errorf("unexpected %s, expected \"desc\" or \",\"", rest[0])
+		}
+		result = append(result, o)
+	}
+	return result, nil
+}
+
+// words splits the text into whitespace-separated words, as text tokens.
+// offset is the position of the text in the ordering.
+func words(text string, offset int) []token {
+	var result []token
+	for i := 0; i < len(text); {
+		if strings.IndexByte(" \t\r\n", text[i]) >= 0 {
+			i++
+			continue
+		}
+		j := i
+		for j < len(text) && strings.IndexByte(" \t\r\n", text[j]) < 0 {
+			j++
+		}
+		result = append(result, token{kind: tokenText, value: text[i:j], pos: offset + i + 1})
+		i = j
+	}
+	return result
+}
+
+// OrderByClause returns the SQL of the ordering, for use in an ORDER BY
+// clause, e.g. "LastUpdated DESC, BugId". It returns "" for no ordering.
+func OrderByClause(order []OrderBy) string {
+	parts := make([]string, 0, len(order))
+	for _, o := range order {
+		if o.Descending {
+			parts = append(parts, o.Column.SQL+" DESC")
+		} else {
+			parts = append(parts, o.Column.SQL)
+		}
+	}
+	return strings.Join(parts, ", ")
+}
@@ -0,0 +1,66 @@
+// Copyright 2021 The Chromium Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package aip
+
+import (
+	"testing"
+
+	"google.golang.org/grpc/codes"
+
+	. "github.com/smartystreets/goconvey/convey"
+	. "go.chromium.org/luci/common/testing/assertions"
+)
+
+func TestOrderBy(t *testing.T) {
+	t.Parallel()
+
+	Convey(`ParseOrderBy`, t, func() {
+		Convey(`Empty`, func() {
+			for _, text := range []string{"", "  "} {
+				order, err := testTable.ParseOrderBy(text)
+				So(err, ShouldBeNil)
+				So(order, ShouldBeEmpty)
+				So(OrderByClause(order), ShouldEqual, "")
+			}
+		})
+		Convey(`Valid`, func() {
+			cases := map[string]string{
+				`name`:                        `Name`,
+				`name desc`:                   `Name DESC`,
+				`  name   desc  `:             `Name DESC`,
+				`count desc,name`:             `Count DESC, Name`,
+				`created, count desc , name`:  `CreationTime, Count DESC, Name`,
+				"created\tdesc,\ncount  desc": `CreationTime DESC, Count DESC`,
+			}
+			for text, want := range cases {
+				order, err := testTable.ParseOrderBy(text)
+				So(err, ShouldBeNil)
+				So(OrderByClause(order), ShouldEqual, want)
+			}
+		})
+		Convey(`Invalid`, func() {
+			cases := []struct {
+				text string
+				err  string
+			}{
+				{`unknown`, `field "unknown" cannot be ordered by at position 1`},
+				{`name, bug.id`, `field "bug.id" cannot be ordered by at position 7`},
+				{`name, secret desc`, `field "secret" cannot be ordered by at position 7`},
+				{`name, count, name desc`, `field "name" is ordered by more than once at position 14`},
+				{`name asc`, `unexpected "asc", expected "desc" or "," at position 6`},
+				{`name DESC`, `unexpected "DESC", expected "desc" or "," at position 6`},
+				{`name desc desc`, `unexpected "desc", expected "desc" or "," at position 11`},
+				{`count name`, `unexpected "name", expected "desc" or "," at position 7`},
+				{`name,`, `expected a field name at position 6`},
+				{`name,, count`, `expected a field name at position 6`},
+				{`,name`, `expected a field name at position 1`},
+			}
+			for _, c := range cases {
+				_, err := testTable.ParseOrderBy(c.text)
+				So(err, ShouldHaveAppStatus, codes.InvalidArgument, c.err)
+			}
+		})
+	})
+}
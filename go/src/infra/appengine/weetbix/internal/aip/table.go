@@ -0,0 +1,248 @@
+// Copyright 2021 The Chromium Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package aip
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// FieldType is the type of the values of a field.
+type FieldType int
+
+const (
+	// String fields support all comparators. The ":" (has) comparator
+	// matches values containing the given substring.
+	String FieldType = iota
+	// Bool fields support the "=" and "!=" comparators with the values
+	// true and false.
+	Bool
+	// Int64 fields support all comparators but ":".
+	Int64
+	// Timestamp fields support all comparators but ":", with RFC 3339
+	// values. The values must be quoted, as they contain ":".
+	Timestamp
+)
+
+func (t FieldType) String() string {
+	switch t {
+	case String:
+		return "string"
+	case Bool:
+		return "bool"
+	case Int64:
+		return "int64"
+	case Timestamp:
+		return "timestamp"
+	default:
+		return fmt.Sprintf("FieldType(%d)", int(t))
+	}
+}
+
+// Column is a field of a list API, and the database column backing it.
+type Column struct {
+	// Name is the name of the field in filters and orderings, e.g.
+	// "bug.id".
+	Name string
+	// SQL is the SQL expression of the column, e.g. "BugId". It is
+	// trusted, and inserted in queries as is.
+	SQL string
+	// Type is the type of the values of the field.
+	Type FieldType
+	// Filterable is true if the field can be used in filters.
+	Filterable bool
+	// Sortable is true if the field can be used in orderings.
+	Sortable bool
+}
+
+// Table is the whitelist of the fields of a list API.
+type Table struct {
+	columns map[string]*Column
+}
+
+// NewTable creates a Table of the columns. It panics if two columns have
+// the same name.
+func NewTable(columns ...*Column) *Table {
+	t := &Table{columns: make(map[string]*Column, len(columns))}
+	for _, c := range columns {
+		if _, ok := t.columns[c.Name]; ok {
+			panic(fmt.Sprintf("aip: duplicate column %q", c.Name))
+		}
+		t.columns[c.Name] = c
+	}
+	return t
+}
+
+// QueryParameter is a parameter of a generated SQL clause. It has the same
+// meaning for Spanner and BigQuery queries.
+type QueryParameter struct {
+	Name  string
+	Value interface{}
+}
+
+// Filter is a filter checked against the fields of a table.
+type Filter struct {
+	table *Table
+	expr  Expr
+}
+
+// ParseFilter parses the AIP-160 filter, and checks it only restricts
+// filterable fields of the table, with values of their types.
+// An empty filter matches everything.
+func (t *Table) ParseFilter(text string) (*Filter, error) {
+	e, err := ParseFilter(text)
+	if err != nil {
+		return nil, err
+	}
+	f := &Filter{table: t, expr: e}
+	if _, err := f.whereClause("p"); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// WhereClause returns the SQL boolean expression of the filter, for use in
+// a WHERE clause, and the parameters it uses. The names of the parameters
+// start with paramPrefix.
+// A nil filter matches everything.
+func (f *Filter) WhereClause(paramPrefix string) (string, []QueryParameter) {
+	if f == nil {
+		return "TRUE", nil
+	}
+	g, err := f.whereClause(paramPrefix)
+	if err != nil {
+		// Filters are checked when they are parsed.
+		panic(fmt.Sprintf("aip: unchecked filter: %s", err))
+	}
+	return g.sql.String(), g.params
+}
+
+func (f *Filter) whereClause(paramPrefix string) (*sqlGenerator, error) {
+	g := &sqlGenerator{table: f.table, paramPrefix: paramPrefix}
+	if f.expr == nil {
+		g.sql.WriteString("TRUE")
+		return g, nil
+	}
+	if err := g.writeExpr(f.expr); err != nil {
+		return nil, err
+	}
+	return g, nil
+}
+
+// sqlGenerator generates the SQL of a filter.
+type sqlGenerator struct {
+	table       *Table
+	paramPrefix string
+	sql         strings.Builder
+	params      []QueryParameter
+}
+
+func (g *sqlGenerator) writeExpr(e Expr) error {
+	switch e := e.(type) {
+	case *And:
+		return g.writeJoined(" AND ", e.Args)
+	case *Or:
+		return g.writeJoined(" OR ", e.Args)
+	case *Not:
+		g.sql.WriteString("(NOT ")
+		if err := g.writeExpr(e.Arg); err != nil {
+			return err
+		}
+		g.sql.WriteString(")")
+		return nil
+	case *Restriction:
+		return g.writeRestriction(e)
+	default:
+		panic(fmt.Sprintf("aip: unknown expression %T", e))
+	}
+}
+
+func (g *sqlGenerator) writeJoined(sep string, args []Expr) error {
+	g.sql.WriteString("(")
+	for i, a := range args {
+		if i > 0 {
+			g.sql.WriteString(sep)
+		}
+		if err := g.writeExpr(a); err != nil {
+			return err
+		}
+	}
+	g.sql.WriteString(")")
+	return nil
+}
+
+func (g *sqlGenerator) writeRestriction(r *Restriction) error {
+	c, ok := g.table.columns[r.Field]
+	if !ok || !c.Filterable {
+		return positionErrorf(r.FieldPos, "field %q cannot be filtered on", r.Field)
+	}
+	value, err := parseValue(c, r)
+	if err != nil {
+		return err
+	}
+	switch r.Comparator {
+	case ":":
+		if c.Type != String {
+			return positionErrorf(r.ComparatorPos, "comparator \":\" is not supported for %s field %q", c.Type, c.Name)
+		}
+		// Match the value literally in the LIKE pattern.
+		value = likeEscaper.Replace(value.(string))
+		fmt.Fprintf(&g.sql, "(%s LIKE CONCAT('%%', @%s, '%%'))", c.SQL, g.addParam(value))
+	case "=", "!=":
+		fmt.Fprintf(&g.sql, "(%s %s @%s)", c.SQL, r.Comparator, g.addParam(value))
+	default:
+		if c.Type == Bool {
+			return positionErrorf(r.ComparatorPos, "comparator %q is not supported for %s field %q", r.Comparator, c.Type, c.Name)
+		}
+		fmt.Fprintf(&g.sql, "(%s %s @%s)", c.SQL, r.Comparator, g.addParam(value))
+	}
+	return nil
+}
+
+// likeEscaper escapes the wildcards of LIKE patterns, which use backslash
+// as the escape character in both Spanner and BigQuery.
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// addParam adds a query parameter with the value, and returns its name.
+func (g *sqlGenerator) addParam(value interface{}) string {
+	name := g.paramPrefix + strconv.Itoa(len(g.params))
+	g.params = append(g.params, QueryParameter{Name: name, Value: value})
+	return name
+}
+
+// parseValue parses the value of the restriction as a value of the type of
+// the column.
+func parseValue(c *Column, r *Restriction) (interface{}, error) {
+	switch c.Type {
+	case String:
+		return r.Value, nil
+	case Bool:
+		if !r.Quoted {
+			switch r.Value {
+			case "true":
+				return true, nil
+			case "false":
+				return false, nil
+			}
+		}
+		return nil, positionErrorf(r.ValuePos, "invalid value for bool field %q, expected true or false", c.Name)
+	case Int64:
+		v, err := strconv.ParseInt(r.Value, 10, 64)
+		if err != nil {
+			return nil, positionErrorf(r.ValuePos, "invalid value for int64 field %q", c.Name)
+		}
+		return v, nil
+	case Timestamp:
+		v, err := time.Parse(time.RFC3339Nano, r.Value)
+		if err != nil {
+			return nil, positionErrorf(r.ValuePos, "invalid value for timestamp field %q, expected a quoted RFC 3339 timestamp", c.Name)
+		}
+		return v.UTC(), nil
+	default:
+		panic(fmt.Sprintf("aip: unknown field type %s", c.Type))
+	}
+}
@@ -0,0 +1,139 @@
+// Copyright 2021 The Chromium Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package aip
+
+import (
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+
+	. "github.com/smartystreets/goconvey/convey"
+	. "go.chromium.org/luci/common/testing/assertions"
+)
+
+var updateGolden = flag.Bool("test.update-golden", false, "Instead of testing, regenerate the golden files.")
+
+var testTable = NewTable(
+	&Column{Name: "name", SQL: "Name", Type: String, Filterable: true, Sortable: true},
+	&Column{Name: "bug.id", SQL: "BugId", Type: String, Filterable: true},
+	&Column{Name: "active", SQL: "IFNULL(IsActive, FALSE)", Type: Bool, Filterable: true},
+	&Column{Name: "count", SQL: "Count", Type: Int64, Filterable: true, Sortable: true},
+	&Column{Name: "created", SQL: "CreationTime", Type: Timestamp, Filterable: true, Sortable: true},
+	&Column{Name: "secret", SQL: "Secret", Type: String},
+)
+
+// goldenFilters are the filters whose SQL is in testdata/where.golden.
+var goldenFilters = []string{
+	``,
+	`name = "a"`,
+	`name != a`,
+	`name < "m" AND name >= "c"`,
+	`name:"50%_off\\"`,
+	`bug.id = "123" OR bug.id = "456" OR name = x`,
+	`active = true`,
+	`active != false`,
+	`count > -3 count <= 10`,
+	`created < "2021-01-02T03:04:05.000006Z"`,
+	`created >= "2021-01-02T04:04:05+01:00"`,
+	`NOT active = true`,
+	`-(name = a AND count = 1) OR active = false`,
+	`name = "'; DROP TABLE Rules; --"`,
+}
+
+// formatWhereClause formats the SQL and parameters of the filter for the
+// golden file.
+func formatWhereClause(text string) string {
+	f, err := testTable.ParseFilter(text)
+	if err != nil {
+		return fmt.Sprintf("# filter\n%s\n# error\n%s\n", text, err)
+	}
+	sql, params := f.WhereClause("p")
+	var b strings.Builder
+	fmt.Fprintf(&b, "# filter\n%s\n# sql\n%s\n# params\n", text, sql)
+	for _, p := range params {
+		fmt.Fprintf(&b, "%s = %T(%#v)\n", p.Name, p.Value, p.Value)
+	}
+	return b.String()
+}
+
+func TestWhereClauseGolden(t *testing.T) {
+	t.Parallel()
+
+	var parts []string
+	for _, text := range goldenFilters {
+		parts = append(parts, formatWhereClause(text))
+	}
+	got := strings.Join(parts, "\n")
+
+	path := filepath.Join("testdata", "where.golden")
+	if *updateGolden {
+		if err := ioutil.WriteFile(path, []byte(got), 0644); err != nil {
+			t.Fatalf("Failed to update golden file: %s", err)
+		}
+		return
+	}
+	want, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatalf("Failed to read golden file: %s", err)
+	}
+	if got != string(want) {
+		t.Errorf("WhereClause() differs from %s; run the test with -test.update-golden to update it. Got:\n%s", path, got)
+	}
+}
+
+func TestTable(t *testing.T) {
+	t.Parallel()
+
+	Convey(`Table`, t, func() {
+		Convey(`Nil filter matches everything`, func() {
+			var f *Filter
+			sql, params := f.WhereClause("p")
+			So(sql, ShouldEqual, "TRUE")
+			So(params, ShouldBeEmpty)
+		})
+		Convey(`Parameters are prefixed`, func() {
+			f, err := testTable.ParseFilter(`name = a OR name = b`)
+			So(err, ShouldBeNil)
+			sql, params := f.WhereClause("filter_")
+			So(sql, ShouldEqual, "((Name = @filter_0) OR (Name = @filter_1))")
+			So(params, ShouldResemble, []QueryParameter{
+				{Name: "filter_0", Value: "a"},
+				{Name: "filter_1", Value: "b"},
+			})
+		})
+		Convey(`Invalid filters`, func() {
+			cases := []struct {
+				text string
+				err  string
+			}{
+				{`unknown = 1`, `field "unknown" cannot be filtered on at position 1`},
+				{`name = a AND secret = b`, `field "secret" cannot be filtered on at position 14`},
+				{`active = yes`, `invalid value for bool field "active", expected true or false at position 10`},
+				{`active = "true"`, `invalid value for bool field "active", expected true or false at position 10`},
+				{`active > false`, `comparator ">" is not supported for bool field "active" at position 8`},
+				{`active : true`, `comparator ":" is not supported for bool field "active" at position 8`},
+				{`count = 1.5`, `invalid value for int64 field "count" at position 9`},
+				{`count : 1`, `comparator ":" is not supported for int64 field "count" at position 7`},
+				{`created = "yesterday"`, `invalid value for timestamp field "created", expected a quoted RFC 3339 timestamp at position 11`},
+				{`created : "2021-01-02T03:04:05Z"`, `comparator ":" is not supported for timestamp field "created" at position 9`},
+				{`name = a b`, `expected a comparator after field "b"; bare values are not supported at position 10`},
+			}
+			for _, c := range cases {
+				_, err := testTable.ParseFilter(c.text)
+				So(err, ShouldHaveAppStatus, codes.InvalidArgument, c.err)
+			}
+		})
+		Convey(`Duplicate columns`, func() {
+			So(func() {
+				NewTable(&Column{Name: "a", SQL: "A"}, &Column{Name: "a", SQL: "B"})
+			}, ShouldPanic)
+		})
+	})
+}
@@ -15,9 +15,11 @@ import (
 
 	"go.chromium.org/luci/common/errors"
 
+	"infra/appengine/weetbix/internal/aip"
 	"infra/appengine/weetbix/internal/bqutil"
 	"infra/appengine/weetbix/internal/clustering"
 	"infra/appengine/weetbix/internal/config"
+	pb "infra/appengine/weetbix/proto/v1"
 )
 
 // ImpactfulClusterReadOptions specifies options for ReadImpactfulClusters().
@@ -27,9 +29,18 @@ type ImpactfulClusterReadOptions struct {
 	// Thresholds is the set of thresholds, which if any are met
 	// or exceeded, should result in the cluster being returned.
 	// Thresholds are applied based on cluster residual impact.
+	// They apply to clusters of test failures.
 	Thresholds *config.ImpactThreshold
+	// BuildStepThresholds is the set of thresholds applied to clusters of
+	// build step failures instead of Thresholds. If nil, such clusters are
+	// only returned if they are always included.
+	BuildStepThresholds *config.ImpactThreshold
 	// AlwaysInclude is the set of clusters to always include.
 	AlwaysInclude []clustering.ClusterID
+	// FailureKind, if specified, is the kind of failures of the clusters
+	// to return. Clusters of other kinds are not returned, even if they
+	// are always included.
+	FailureKind pb.FailureKind
 }
 
 // ClusterSummary represents a statistical summary of a cluster's failures,
@@ -50,6 +61,19 @@ type ClusterSummary struct {
 	AffectedTests7d      []SubCluster         `json:"affectedTests7d"`
 	ExampleFailureReason bigquery.NullString  `json:"exampleFailureReason"`
 	ExampleTestID        string               `json:"exampleTestId"`
+	// FailureKind is the kind of the failures in the cluster, i.e.
+	// "BUILD_STEP_FAILURE" if all of its failures are build step failures,
+	// and "TEST_FAILURE" otherwise.
+	FailureKind string `json:"failureKind"`
+	// ExonerationReasons7d is the number of exonerated failures in the
+	// cluster in the last 7 days, by exoneration reason (e.g.
+	// "OCCURS_ON_MAINLINE"), from the most common reason. Not read by
+	// ReadImpactfulClusters.
+	ExonerationReasons7d []SubCluster `json:"exonerationReasons7d"`
+	// TimeRangeImpact is the impact of the cluster over the time range
+	// requested by the user, if any. Not read by ReadImpactfulClusters or
+	// ReadCluster; see PopulateTimeRangeImpact.
+	TimeRangeImpact *TimeRangeImpact `json:"timeRangeImpact,omitempty" bigquery:"-"`
 }
 
 // SubCluster represents the name of a test and the number of times
@@ -85,12 +109,17 @@ func NewClient(ctx context.Context, gcpProject string) (*Client, error) {
 	if err != nil {
 		return nil, err
 	}
-	return &Client{client: client}, nil
+	c := &Client{client: client}
+	c.runQuery = c.readQuery
+	return c, nil
 }
 
 // Client may be used to read Weetbix clusters.
 type Client struct {
 	client *bigquery.Client
+	// runQuery runs a query and returns an iterator over its results.
+	// Replaced in tests to avoid calling BigQuery.
+	runQuery func(ctx context.Context, q *query) (rowIterator, error)
 }
 
 // Close releases any resources held by the client.
@@ -144,11 +173,40 @@ func (c *Client) ReadImpactfulClusters(ctx context.Context, opts ImpactfulCluste
 		return nil, errors.Annotate(err, "getting dataset").Err()
 	}
 
-	whereFailures, failuresParams := whereThresholdsExceeded("failures", opts.Thresholds.TestResultsFailed)
-	whereTestRuns, testRunsParams := whereThresholdsExceeded("test_run_fails", opts.Thresholds.TestRunsFailed)
-	wherePresubmits, presubmitParams := whereThresholdsExceeded("presubmit_rejects", opts.Thresholds.PresubmitRunsFailed)
+	it, err := c.runQuery(ctx, impactfulClustersQuery(dataset, opts))
+	if err != nil {
+		return nil, errors.Annotate(err, "querying cluster summaries").Err()
+	}
+	clusters := []*ClusterSummary{}
+	for {
+		row := &ClusterSummary{}
+		err := it.Next(row)
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, errors.Annotate(err, "obtain next cluster summary row").Err()
+		}
+		clusters = append(clusters, row)
+	}
+	return clusters, nil
+}
 
-	q := c.client.Query(`
+// impactfulClustersQuery returns the query for the clusters exceeding the
+// impact thresholds of the options, or otherwise nominated to be read.
+func impactfulClustersQuery(dataset string, opts ImpactfulClusterReadOptions) *query {
+	whereTests, testsParams := whereImpactThresholdsExceeded("", opts.Thresholds)
+	whereBuildSteps := "FALSE"
+	var buildStepsParams []bigquery.QueryParameter
+	if opts.BuildStepThresholds != nil {
+		whereBuildSteps, buildStepsParams = whereImpactThresholdsExceeded("build_step_", opts.BuildStepThresholds)
+	}
+	failureKind := ""
+	if opts.FailureKind != pb.FailureKind_FAILURE_KIND_UNSPECIFIED {
+		failureKind = opts.FailureKind.String()
+	}
+
+	sql := `
 		SELECT
 			STRUCT(cluster_algorithm AS Algorithm, cluster_id as ID) as ClusterID,` +
 		selectCounts("presubmit_rejects", "PresubmitRejects", "1d") +
@@ -161,48 +219,32 @@ func (c *Client) ReadImpactfulClusters(ctx context.Context, opts ImpactfulCluste
 		selectCounts("failures", "Failures", "3d") +
 		selectCounts("failures", "Failures", "7d") + `
 			example_failure_reason.primary_error_message as ExampleFailureReason,
-			example_test_id as ExampleTestID
+			example_test_id as ExampleTestID,
+			failure_kind as FailureKind
 		FROM ` + dataset + `.cluster_summaries
-		WHERE (` + whereFailures + `) OR (` + whereTestRuns + `) OR (` + wherePresubmits + `)
-			OR STRUCT(cluster_algorithm AS Algorithm, cluster_id as ID) IN UNNEST(@alwaysInclude)
+		WHERE ((failure_kind = 'TEST_FAILURE' AND (` + whereTests + `))
+			OR (failure_kind = 'BUILD_STEP_FAILURE' AND (` + whereBuildSteps + `))
+			OR STRUCT(cluster_algorithm AS Algorithm, cluster_id as ID) IN UNNEST(@alwaysInclude))
+		  AND (@failureKind = '' OR failure_kind = @failureKind)
 		ORDER BY
 			presubmit_rejects_residual_1d DESC,
 			test_run_fails_residual_1d DESC,
 			failures_residual_1d DESC
-	`)
+	`
 
 	params := []bigquery.QueryParameter{
 		{
 			Name:  "alwaysInclude",
 			Value: opts.AlwaysInclude,
 		},
+		{
+			Name:  "failureKind",
+			Value: failureKind,
+		},
 	}
-	params = append(params, failuresParams...)
-	params = append(params, testRunsParams...)
-	params = append(params, presubmitParams...)
-	q.Parameters = params
-
-	job, err := q.Run(ctx)
-	if err != nil {
-		return nil, errors.Annotate(err, "querying cluster summaries").Err()
-	}
-	it, err := job.Read(ctx)
-	if err != nil {
-		return nil, errors.Annotate(err, "obtain result iterator").Err()
-	}
-	clusters := []*ClusterSummary{}
-	for {
-		row := &ClusterSummary{}
-		err := it.Next(row)
-		if err == iterator.Done {
-			break
-		}
-		if err != nil {
-			return nil, errors.Annotate(err, "obtain next cluster summary row").Err()
-		}
-		clusters = append(clusters, row)
-	}
-	return clusters, nil
+	params = append(params, testsParams...)
+	params = append(params, buildStepsParams...)
+	return &query{SQL: sql, Parameters: params}
 }
 
 func valueOrDefault(value *int64, defaultValue int64) int64 {
@@ -222,26 +264,44 @@ func selectCounts(sqlPrefix, fieldPrefix, suffix string) string {
 		`) AS ` + fieldPrefix + suffix + `,`
 }
 
+// whereImpactThresholdsExceeded generates a SQL Where clause to query
+// where any metric exceeds its threshold in the given impact thresholds.
+// The names of the query parameters are prefixed with paramPrefix, so that
+// clauses for different thresholds can be used in the same query.
+func whereImpactThresholdsExceeded(paramPrefix string, thresholds *config.ImpactThreshold) (string, []bigquery.QueryParameter) {
+	whereFailures, failuresParams := whereThresholdsExceeded(paramPrefix, "failures", thresholds.TestResultsFailed)
+	whereTestRuns, testRunsParams := whereThresholdsExceeded(paramPrefix, "test_run_fails", thresholds.TestRunsFailed)
+	wherePresubmits, presubmitParams := whereThresholdsExceeded(paramPrefix, "presubmit_rejects", thresholds.PresubmitRunsFailed)
+
+	sql := "(" + whereFailures + ") OR (" + whereTestRuns + ") OR (" + wherePresubmits + ")"
+	var params []bigquery.QueryParameter
+	params = append(params, failuresParams...)
+	params = append(params, testRunsParams...)
+	params = append(params, presubmitParams...)
+	return sql, params
+}
+
 // whereThresholdsExceeded generates a SQL Where clause to query
 // where a particular metric exceeds a given threshold.
-func whereThresholdsExceeded(sqlPrefix string, threshold *config.MetricThreshold) (string, []bigquery.QueryParameter) {
+func whereThresholdsExceeded(paramPrefix, sqlPrefix string, threshold *config.MetricThreshold) (string, []bigquery.QueryParameter) {
 	if threshold == nil {
 		threshold = &config.MetricThreshold{}
 	}
-	sql := sqlPrefix + "_residual_1d > @" + sqlPrefix + "_1d OR " +
-		sqlPrefix + "_residual_3d > @" + sqlPrefix + "_3d OR " +
-		sqlPrefix + "_residual_7d > @" + sqlPrefix + "_7d"
+	param := paramPrefix + sqlPrefix
+	sql := sqlPrefix + "_residual_1d > @" + param + "_1d OR " +
+		sqlPrefix + "_residual_3d > @" + param + "_3d OR " +
+		sqlPrefix + "_residual_7d > @" + param + "_7d"
 	parameters := []bigquery.QueryParameter{
 		{
-			Name:  sqlPrefix + "_1d",
+			Name:  param + "_1d",
 			Value: valueOrDefault(threshold.OneDay, math.MaxInt64),
 		},
 		{
-			Name:  sqlPrefix + "_3d",
+			Name:  param + "_3d",
 			Value: valueOrDefault(threshold.ThreeDay, math.MaxInt64),
 		},
 		{
-			Name:  sqlPrefix + "_7d",
+			Name:  param + "_7d",
 			Value: valueOrDefault(threshold.SevenDay, math.MaxInt64),
 		},
 	}
@@ -270,8 +330,10 @@ func (c *Client) ReadCluster(ctx context.Context, luciProject string, clusterID
 			affected_tests_1d as AffectedTests1d,
 			affected_tests_3d as AffectedTests3d,
 			affected_tests_7d as AffectedTests7d,
+			exoneration_reasons_7d as ExonerationReasons7d,
 			example_failure_reason.primary_error_message as ExampleFailureReason,
-			example_test_id as ExampleTestID
+			example_test_id as ExampleTestID,
+			failure_kind as FailureKind
 		FROM ` + dataset + `.cluster_summaries
 		WHERE cluster_algorithm = @clusterAlgorithm
 		  AND cluster_id = @clusterID
@@ -316,6 +378,13 @@ type ClusterFailure struct {
 	IsIncluded                 bigquery.NullBool      `json:"isIncluded"`
 	IsIncludedWithHighPriority bigquery.NullBool      `json:"isIncludedWithHighPriority"`
 	IsExonerated               bigquery.NullBool      `json:"isExonerated"`
+	// ExonerationReason is the reason the failure was exonerated, e.g.
+	// "OCCURS_ON_MAINLINE", if it was exonerated.
+	ExonerationReason bigquery.NullString `json:"exonerationReason"`
+	// ExonerationExplanation is the explanation of the exoneration of the
+	// failure, if it was exonerated.
+	ExonerationExplanation bigquery.NullString `json:"exonerationExplanation"`
+	Artifacts              []*ArtifactLink     `json:"artifacts"`
 }
 
 type Variant struct {
@@ -323,21 +392,65 @@ type Variant struct {
 	Value bigquery.NullString `json:"value"`
 }
 
+// ArtifactLink identifies an artifact of a failed test result, such as its
+// log snippet, so that the UI can link to it.
+type ArtifactLink struct {
+	System      bigquery.NullString `json:"system"`
+	Name        bigquery.NullString `json:"name"`
+	ArtifactID  bigquery.NullString `bigquery:"artifact_id" json:"artifactID"`
+	ContentType bigquery.NullString `bigquery:"content_type" json:"contentType"`
+	SizeBytes   bigquery.NullInt64  `bigquery:"size_bytes" json:"sizeBytes"`
+}
+
 type PresubmitRunID struct {
 	System bigquery.NullString `json:"system"`
 	ID     bigquery.NullString `json:"id"`
 }
 
-// ReadClusterFailures reads the latest 2000 failures for a single cluster for the last 7 days.
-func (c *Client) ReadClusterFailures(ctx context.Context, luciProject string, clusterID clustering.ClusterID) ([]*ClusterFailure, error) {
-	dataset, err := bqutil.DatasetForProject(luciProject)
-	if err != nil {
-		return nil, errors.Annotate(err, "getting dataset").Err()
+// ClusterFailuresTable is the table of the fields by which cluster
+// failures can be filtered and ordered. The field names are those of the
+// JSON representation of cluster failures.
+var ClusterFailuresTable = aip.NewTable(
+	&aip.Column{Name: "realm", SQL: "realm", Type: aip.String, Filterable: true, Sortable: true},
+	&aip.Column{Name: "ingestedInvocationID", SQL: "ingested_invocation_id", Type: aip.String, Filterable: true, Sortable: true},
+	&aip.Column{Name: "testID", SQL: "test_id", Type: aip.String, Filterable: true, Sortable: true},
+	&aip.Column{Name: "presubmitRunID.system", SQL: "presubmit_run_id.system", Type: aip.String, Filterable: true},
+	&aip.Column{Name: "presubmitRunID.id", SQL: "presubmit_run_id.id", Type: aip.String, Filterable: true},
+	&aip.Column{Name: "partitionTime", SQL: "partition_time", Type: aip.Timestamp, Filterable: true, Sortable: true},
+	&aip.Column{Name: "isIncluded", SQL: "is_included", Type: aip.Bool, Filterable: true},
+	&aip.Column{Name: "isIncludedWithHighPriority", SQL: "is_included_with_high_priority", Type: aip.Bool, Filterable: true},
+	&aip.Column{Name: "isExonerated", SQL: "is_exonerated", Type: aip.Bool, Filterable: true},
+	&aip.Column{Name: "exonerationReason", SQL: "exoneration_reason", Type: aip.String, Filterable: true},
+)
+
+// ClusterFailuresReadOptions specifies the cluster failures to read.
+type ClusterFailuresReadOptions struct {
+	// Project is the LUCI project of the cluster.
+	Project   string
+	ClusterID clustering.ClusterID
+	// Filter is the filter the failures must match, parsed with
+	// ClusterFailuresTable. A nil filter matches all failures.
+	Filter *aip.Filter
+	// OrderBy is the order of the failures, parsed with
+	// ClusterFailuresTable. Failures are ordered by partition time, from
+	// the latest, after the given order.
+	OrderBy []aip.OrderBy
+}
+
+// clusterFailuresQuery returns the query for the failures of a cluster
+// matching the options.
+func clusterFailuresQuery(dataset string, opts ClusterFailuresReadOptions) *query {
+	whereFilter, filterParams := opts.Filter.WhereClause("filter_")
+	orderBy := aip.OrderByClause(opts.OrderBy)
+	if orderBy != "" {
+		orderBy += ", "
 	}
+	orderBy += "partition_time DESC"
+
 	// TODO(mwarton): change this to read from materialized
 	// table instead of directly from the view for better performance
 	// (from 30 seconds -> 2 seconds).
-	q := c.client.Query(`
+	sql := `
 		SELECT
 			realm as Realm,
 			ingested_invocation_id as IngestedInvocationID,
@@ -347,25 +460,38 @@ func (c *Client) ReadClusterFailures(ctx context.Context, luciProject string, cl
 			partition_time as PartitionTime,
 			is_included as IsIncluded,
 			is_included_with_high_priority as IsIncludedWithHighPriority,
-			is_exonerated as IsExonerated
+			is_exonerated as IsExonerated,
+			exoneration_reason as ExonerationReason,
+			exoneration_explanation as ExonerationExplanation,
+			artifacts as Artifacts
 		FROM
 			` + dataset + `.clustered_failures_latest_7d
 		WHERE cluster_algorithm = @clusterAlgorithm
 		  AND cluster_id = @clusterID
-		ORDER BY partition_time DESC
+		  AND (` + whereFilter + `)
+		ORDER BY ` + orderBy + `
 		LIMIT 2000
-	`)
-	q.Parameters = []bigquery.QueryParameter{
-		{Name: "clusterAlgorithm", Value: clusterID.Algorithm},
-		{Name: "clusterID", Value: clusterID.ID},
+	`
+	params := []bigquery.QueryParameter{
+		{Name: "clusterAlgorithm", Value: opts.ClusterID.Algorithm},
+		{Name: "clusterID", Value: opts.ClusterID.ID},
 	}
-	job, err := q.Run(ctx)
+	for _, p := range filterParams {
+		params = append(params, bigquery.QueryParameter{Name: p.Name, Value: p.Value})
+	}
+	return &query{SQL: sql, Parameters: params}
+}
+
+// ReadClusterFailures reads the latest 2000 failures for a single cluster
+// for the last 7 days which match the filter of the options.
+func (c *Client) ReadClusterFailures(ctx context.Context, opts ClusterFailuresReadOptions) ([]*ClusterFailure, error) {
+	dataset, err := bqutil.DatasetForProject(opts.Project)
 	if err != nil {
-		return nil, errors.Annotate(err, "querying cluster failures").Err()
+		return nil, errors.Annotate(err, "getting dataset").Err()
 	}
-	it, err := job.Read(ctx)
+	it, err := c.runQuery(ctx, clusterFailuresQuery(dataset, opts))
 	if err != nil {
-		return nil, errors.Annotate(err, "obtain result iterator").Err()
+		return nil, errors.Annotate(err, "querying cluster failures").Err()
 	}
 	failures := []*ClusterFailure{}
 	for {
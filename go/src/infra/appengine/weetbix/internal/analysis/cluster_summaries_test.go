@@ -0,0 +1,229 @@
+// Copyright 2021 The Chromium Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package analysis
+
+import (
+	"context"
+	"math"
+	"testing"
+	"time"
+
+	"cloud.google.com/go/bigquery"
+	"google.golang.org/api/iterator"
+	"google.golang.org/protobuf/proto"
+
+	"infra/appengine/weetbix/internal/clustering"
+	"infra/appengine/weetbix/internal/config"
+	pb "infra/appengine/weetbix/proto/v1"
+
+	. "github.com/smartystreets/goconvey/convey"
+	. "go.chromium.org/luci/common/testing/assertions"
+)
+
+// fakeClusterFailureIterator iterates over rows of the cluster failures
+// query.
+type fakeClusterFailureIterator struct {
+	rows []*ClusterFailure
+}
+
+func (it *fakeClusterFailureIterator) Next(dst interface{}) error {
+	if len(it.rows) == 0 {
+		return iterator.Done
+	}
+	*dst.(*ClusterFailure) = *it.rows[0]
+	it.rows = it.rows[1:]
+	return nil
+}
+
+// fakeClusterSummaryIterator iterates over rows of the cluster summaries
+// query.
+type fakeClusterSummaryIterator struct {
+	rows []*ClusterSummary
+}
+
+func (it *fakeClusterSummaryIterator) Next(dst interface{}) error {
+	if len(it.rows) == 0 {
+		return iterator.Done
+	}
+	*dst.(*ClusterSummary) = *it.rows[0]
+	it.rows = it.rows[1:]
+	return nil
+}
+
+func TestReadImpactfulClusters(t *testing.T) {
+	Convey(`ReadImpactfulClusters`, t, func() {
+		ctx := context.Background()
+
+		var queries []*query
+		rows := []*ClusterSummary{
+			{
+				ClusterID:   clustering.ClusterID{Algorithm: "reason-v3", ID: "00112233445566778899aabbccddeeff"},
+				FailureKind: "BUILD_STEP_FAILURE",
+			},
+		}
+		c := &Client{
+			runQuery: func(ctx context.Context, q *query) (rowIterator, error) {
+				queries = append(queries, q)
+				return &fakeClusterSummaryIterator{rows: rows}, nil
+			},
+		}
+		opts := ImpactfulClusterReadOptions{
+			Project: "testproject",
+			Thresholds: &config.ImpactThreshold{
+				TestResultsFailed: &config.MetricThreshold{OneDay: proto.Int64(100)},
+			},
+		}
+		paramValue := func(q *query, name string) interface{} {
+			for _, p := range q.Parameters {
+				if p.Name == name {
+					return p.Value
+				}
+			}
+			return nil
+		}
+
+		Convey(`Without build step thresholds or kind`, func() {
+			clusters, err := c.ReadImpactfulClusters(ctx, opts)
+			So(err, ShouldBeNil)
+			So(clusters, ShouldResemble, rows)
+
+			So(queries, ShouldHaveLength, 1)
+			q := queries[0]
+			So(q.SQL, ShouldContainSubstring, `testproject.cluster_summaries`)
+			So(q.SQL, ShouldContainSubstring, `failure_kind = 'BUILD_STEP_FAILURE' AND (FALSE)`)
+			So(paramValue(q, "failureKind"), ShouldEqual, "")
+			So(paramValue(q, "failures_1d"), ShouldEqual, int64(100))
+			So(paramValue(q, "build_step_failures_1d"), ShouldBeNil)
+		})
+		Convey(`With build step thresholds`, func() {
+			opts.BuildStepThresholds = &config.ImpactThreshold{
+				TestResultsFailed: &config.MetricThreshold{OneDay: proto.Int64(10)},
+			}
+			_, err := c.ReadImpactfulClusters(ctx, opts)
+			So(err, ShouldBeNil)
+
+			So(queries, ShouldHaveLength, 1)
+			q := queries[0]
+			So(q.SQL, ShouldContainSubstring, `failures_residual_1d > @build_step_failures_1d`)
+			So(paramValue(q, "failures_1d"), ShouldEqual, int64(100))
+			So(paramValue(q, "build_step_failures_1d"), ShouldEqual, int64(10))
+			So(paramValue(q, "build_step_failures_3d"), ShouldEqual, int64(math.MaxInt64))
+		})
+		Convey(`With kind`, func() {
+			opts.FailureKind = pb.FailureKind_BUILD_STEP_FAILURE
+			_, err := c.ReadImpactfulClusters(ctx, opts)
+			So(err, ShouldBeNil)
+
+			So(queries, ShouldHaveLength, 1)
+			q := queries[0]
+			So(q.SQL, ShouldContainSubstring, `AND (@failureKind = '' OR failure_kind = @failureKind)`)
+			So(paramValue(q, "failureKind"), ShouldEqual, "BUILD_STEP_FAILURE")
+		})
+		Convey(`Without thresholds`, func() {
+			opts.Thresholds = nil
+			_, err := c.ReadImpactfulClusters(ctx, opts)
+			So(err, ShouldErrLike, "thresholds must be specified")
+			So(queries, ShouldBeEmpty)
+		})
+	})
+}
+
+func TestReadClusterFailures(t *testing.T) {
+	Convey(`ReadClusterFailures`, t, func() {
+		ctx := context.Background()
+
+		var queries []*query
+		rows := []*ClusterFailure{
+			{TestID: bigquery.NullString{StringVal: "test1", Valid: true}},
+			{TestID: bigquery.NullString{StringVal: "test2", Valid: true}},
+		}
+		c := &Client{
+			runQuery: func(ctx context.Context, q *query) (rowIterator, error) {
+				queries = append(queries, q)
+				return &fakeClusterFailureIterator{rows: rows}, nil
+			},
+		}
+		opts := ClusterFailuresReadOptions{
+			Project:   "testproject",
+			ClusterID: clustering.ClusterID{Algorithm: "rules-v1", ID: "0123456789abcdef0123456789abcdef"},
+		}
+
+		Convey(`Without filter`, func() {
+			failures, err := c.ReadClusterFailures(ctx, opts)
+			So(err, ShouldBeNil)
+			So(failures, ShouldResemble, rows)
+
+			So(queries, ShouldHaveLength, 1)
+			q := queries[0]
+			So(q.SQL, ShouldContainSubstring, `testproject.clustered_failures_latest_7d`)
+			So(q.SQL, ShouldContainSubstring, `AND (TRUE)`)
+			So(q.SQL, ShouldContainSubstring, `ORDER BY partition_time DESC`)
+			So(q.Parameters, ShouldResemble, []bigquery.QueryParameter{
+				{Name: "clusterAlgorithm", Value: "rules-v1"},
+				{Name: "clusterID", Value: "0123456789abcdef0123456789abcdef"},
+			})
+		})
+		Convey(`With filter and order`, func() {
+			var err error
+			opts.Filter, err = ClusterFailuresTable.ParseFilter(`isExonerated = false AND (testID:"Browser" OR partitionTime >= "2021-12-01T00:00:00Z")`)
+			So(err, ShouldBeNil)
+			opts.OrderBy, err = ClusterFailuresTable.ParseOrderBy(`testID, realm desc`)
+			So(err, ShouldBeNil)
+
+			_, err = c.ReadClusterFailures(ctx, opts)
+			So(err, ShouldBeNil)
+			So(queries, ShouldHaveLength, 1)
+			q := queries[0]
+			So(q.SQL, ShouldContainSubstring, `AND (((is_exonerated = @filter_0) AND ((test_id LIKE CONCAT('%', @filter_1, '%')) OR (partition_time >= @filter_2))))`)
+			So(q.SQL, ShouldContainSubstring, `ORDER BY test_id, realm DESC, partition_time DESC`)
+			So(q.Parameters, ShouldResemble, []bigquery.QueryParameter{
+				{Name: "clusterAlgorithm", Value: "rules-v1"},
+				{Name: "clusterID", Value: "0123456789abcdef0123456789abcdef"},
+				{Name: "filter_0", Value: false},
+				{Name: "filter_1", Value: "Browser"},
+				{Name: "filter_2", Value: time.Date(2021, time.December, 1, 0, 0, 0, 0, time.UTC)},
+			})
+		})
+		Convey(`With exoneration reason`, func() {
+			exonerated := func(reason, explanation string) *ClusterFailure {
+				return &ClusterFailure{
+					TestID:                 bigquery.NullString{StringVal: "test1", Valid: true},
+					IsExonerated:           bigquery.NullBool{Bool: true, Valid: true},
+					ExonerationReason:      bigquery.NullString{StringVal: reason, Valid: true},
+					ExonerationExplanation: bigquery.NullString{StringVal: explanation, Valid: explanation != ""},
+				}
+			}
+			rows = []*ClusterFailure{
+				exonerated("OCCURS_ON_MAINLINE", "Weetbix reported the test is failing on mainline."),
+				exonerated("OCCURS_ON_OTHER_CLS", "Weetbix reported the test failing in presubmit runs of other CLs."),
+				exonerated("NOT_CRITICAL", "Builder is experimental."),
+				exonerated("EXONERATION_REASON_UNSPECIFIED", ""),
+			}
+			var err error
+			opts.Filter, err = ClusterFailuresTable.ParseFilter(`exonerationReason = "OCCURS_ON_MAINLINE"`)
+			So(err, ShouldBeNil)
+
+			failures, err := c.ReadClusterFailures(ctx, opts)
+			So(err, ShouldBeNil)
+			So(failures, ShouldResemble, rows)
+			So(queries, ShouldHaveLength, 1)
+			q := queries[0]
+			So(q.SQL, ShouldContainSubstring, `exoneration_reason as ExonerationReason`)
+			So(q.SQL, ShouldContainSubstring, `exoneration_explanation as ExonerationExplanation`)
+			So(q.SQL, ShouldContainSubstring, `AND ((exoneration_reason = @filter_0))`)
+			So(q.Parameters, ShouldResemble, []bigquery.QueryParameter{
+				{Name: "clusterAlgorithm", Value: "rules-v1"},
+				{Name: "clusterID", Value: "0123456789abcdef0123456789abcdef"},
+				{Name: "filter_0", Value: "OCCURS_ON_MAINLINE"},
+			})
+		})
+		Convey(`Invalid project`, func() {
+			opts.Project = "!invalid"
+			_, err := c.ReadClusterFailures(ctx, opts)
+			So(err, ShouldNotBeNil)
+			So(queries, ShouldBeEmpty)
+		})
+	})
+}
@@ -6,11 +6,13 @@ package clusteredfailures
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"time"
 
-	"cloud.google.com/go/bigquery"
 	"go.chromium.org/luci/common/bq"
 	"go.chromium.org/luci/common/errors"
-	"go.chromium.org/luci/server/caching"
 
 	"infra/appengine/weetbix/internal/bqutil"
 	bqpb "infra/appengine/weetbix/proto/bq"
@@ -24,9 +26,6 @@ const batchSize = 1000
 // tableName is the name of the exported BigQuery table.
 const tableName = "clustered_failures"
 
-// schemaApplyer ensures BQ schema matches the row proto definitions.
-var schemaApplyer = bq.NewSchemaApplyer(caching.RegisterLRUCache(50))
-
 // NewClient creates a new client for exporting clustered failures.
 func NewClient(projectID string) *Client {
 	return &Client{
@@ -55,7 +54,7 @@ func (c *Client) Insert(ctx context.Context, luciProject string, rows []*bqpb.Cl
 
 	// Dataset for the project may have to be manually created.
 	table := client.Dataset(dataset).Table(tableName)
-	if err := schemaApplyer.EnsureTable(ctx, table, tableMetadata); err != nil {
+	if err := ensureTable(ctx, table); err != nil {
 		return errors.Annotate(err, "ensuring clustered failures table in dataset %q", dataset).Err()
 	}
 
@@ -64,7 +63,7 @@ func (c *Client) Insert(ctx context.Context, luciProject string, rows []*bqpb.Cl
 		// bq.Row implements ValueSaver for arbitrary protos.
 		bqRow := &bq.Row{
 			Message:  r,
-			InsertID: bigquery.NoDedupeID,
+			InsertID: insertID(r),
 		}
 		bqRows = append(bqRows, bqRow)
 	}
@@ -75,3 +74,36 @@ func (c *Client) Insert(ctx context.Context, luciProject string, rows []*bqpb.Cl
 	}
 	return nil
 }
+
+// SetPartitionExpiration sets the time after which partitions of the
+// clustered failures table of the given LUCI project expire, by their
+// partition time. Tables which do not exist yet are left unchanged.
+// Returns whether the table was updated.
+func (c *Client) SetPartitionExpiration(ctx context.Context, luciProject string, expiration time.Duration) (updated bool, err error) {
+	client, err := bqutil.Client(ctx, c.projectID)
+	if err != nil {
+		return false, errors.Annotate(err, "creating BQ client").Err()
+	}
+	defer client.Close()
+
+	dataset, err := bqutil.DatasetForProject(luciProject)
+	if err != nil {
+		return false, errors.Annotate(err, "getting dataset").Err()
+	}
+	table := client.Dataset(dataset).Table(tableName)
+	updated, err = setPartitionExpiration(ctx, table, expiration)
+	if err != nil {
+		return false, errors.Annotate(err, "setting partition expiration of clustered failures table in dataset %q", dataset).Err()
+	}
+	return updated, nil
+}
+
+// insertID returns the BigQuery insert ID of the row. Rows are identified
+// by the test result, the cluster and the time they were last updated,
+// so that a row exported more than once (e.g. because the export was
+// retried) is deduplicated by BigQuery on a best-effort basis.
+func insertID(r *bqpb.ClusteredFailureRow) string {
+	key := fmt.Sprintf("%q/%v/%q/%q/%v", r.ChunkId, r.ChunkIndex, r.ClusterAlgorithm, r.ClusterId, r.LastUpdated.AsTime().UnixNano())
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,238 @@
+// Copyright 2021 The Chromium Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package clusteredfailures
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"cloud.google.com/go/bigquery"
+	"google.golang.org/api/googleapi"
+
+	"go.chromium.org/luci/common/bq"
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/common/logging"
+	"go.chromium.org/luci/common/retry"
+	"go.chromium.org/luci/common/retry/transient"
+	"go.chromium.org/luci/server/caching"
+
+	"infra/appengine/weetbix/internal/bqutil"
+	"infra/appengine/weetbix/internal/config"
+)
+
+// schemaCache caches the outcome of table schema migrations, to avoid
+// making duplicate BigQuery calls.
+var schemaCache = caching.RegisterLRUCache(50)
+
+// ErrIncompatibleSchema is returned if the schema of an existing table
+// cannot be migrated to the schema of the row proto by adding columns.
+var ErrIncompatibleSchema = errors.New("incompatible table schema")
+
+// ensureTable creates the table if it does not exist, or else adds the
+// columns of the row proto it is missing. Existing columns are never
+// modified or deleted; if the type or mode of an existing column does not
+// match the row proto, ErrIncompatibleSchema is returned and the table is
+// left untouched.
+func ensureTable(ctx context.Context, t bq.Table) error {
+	// Migrating the table inside GetOrCreate ensures that different
+	// goroutines do not attempt to migrate the same table concurrently.
+	cachedErr, err := schemaCache.LRU(ctx).GetOrCreate(ctx, t.FullyQualifiedName(), func() (interface{}, time.Duration, error) {
+		err := migrateTable(ctx, t, tableMetadata)
+		switch {
+		case err == nil:
+			// Remember the table is up to date for 5 minutes.
+			return nil, 5 * time.Minute, nil
+		case transient.Tag.In(err):
+			return nil, 0, err
+		default:
+			// Cache the fatal error for one minute.
+			return err, time.Minute, nil
+		}
+	})
+	if err != nil {
+		return err
+	}
+	if cachedErr != nil {
+		return cachedErr.(error)
+	}
+	return nil
+}
+
+// migrateTable creates the table with the given metadata if it does not
+// exist, or else adds the columns of the given schema it is missing.
+func migrateTable(ctx context.Context, t bq.Table, spec *bigquery.TableMetadata) error {
+	return retry.Retry(ctx, transient.Only(retry.Default), func() error {
+		// Retrieve the metadata in the retry loop because of the ETag check
+		// below.
+		md, err := t.Metadata(ctx)
+		apiErr, ok := err.(*googleapi.Error)
+		switch {
+		case ok && apiErr.Code == http.StatusNotFound:
+			return createTable(ctx, t, spec)
+		case ok && apiErr.Code == http.StatusForbidden:
+			return err
+		case err != nil:
+			return transient.Tag.Apply(err)
+		}
+
+		schema, mutated, err := mergeSchema(md.Schema, spec.Schema)
+		if err != nil {
+			return errors.Annotate(err, "table %s", t.FullyQualifiedName()).Err()
+		}
+		if !mutated {
+			return nil
+		}
+		_, err = t.Update(ctx, bigquery.TableMetadataToUpdate{Schema: schema}, md.ETag)
+		apiErr, ok = err.(*googleapi.Error)
+		switch {
+		case ok && apiErr.Code == http.StatusConflict:
+			// The ETag became stale since we read it. Try again.
+			return transient.Tag.Apply(err)
+		case ok && apiErr.Code == http.StatusForbidden:
+			return err
+		case err != nil:
+			return transient.Tag.Apply(err)
+		}
+		logging.Infof(ctx, "Added columns to BigQuery table %s", t.FullyQualifiedName())
+		return nil
+	}, nil)
+}
+
+func createTable(ctx context.Context, t bq.Table, spec *bigquery.TableMetadata) error {
+	err := t.Create(ctx, spec)
+	apiErr, ok := err.(*googleapi.Error)
+	switch {
+	case ok && apiErr.Code == http.StatusConflict:
+		// The table was just created concurrently. Migrate it instead.
+		return transient.Tag.Apply(err)
+	case ok && apiErr.Code == http.StatusForbidden:
+		return err
+	case err != nil:
+		return transient.Tag.Apply(err)
+	}
+	logging.Infof(ctx, "Created BigQuery table %s", t.FullyQualifiedName())
+	return nil
+}
+
+// setPartitionExpiration sets the time after which partitions of the table
+// expire, if the table exists and its partitions expire at a different
+// time. BigQuery deletes expired partitions automatically. Returns whether
+// the table was updated.
+func setPartitionExpiration(ctx context.Context, t bq.Table, expiration time.Duration) (updated bool, err error) {
+	err = retry.Retry(ctx, transient.Only(retry.Default), func() error {
+		// Retrieve the metadata in the retry loop because of the ETag check
+		// below.
+		md, err := t.Metadata(ctx)
+		apiErr, ok := err.(*googleapi.Error)
+		switch {
+		case ok && apiErr.Code == http.StatusNotFound:
+			// The table is created with rows. Its expiration is updated
+			// by a later call.
+			return nil
+		case ok && apiErr.Code == http.StatusForbidden:
+			return err
+		case err != nil:
+			return transient.Tag.Apply(err)
+		}
+
+		switch {
+		case md.TimePartitioning == nil:
+			return errors.Reason("table %s is not partitioned", t.FullyQualifiedName()).Err()
+		case md.TimePartitioning.Expiration == expiration:
+			return nil
+		}
+		_, err = t.Update(ctx, bigquery.TableMetadataToUpdate{
+			TimePartitioning: &bigquery.TimePartitioning{Expiration: expiration},
+		}, md.ETag)
+		apiErr, ok = err.(*googleapi.Error)
+		switch {
+		case ok && apiErr.Code == http.StatusConflict:
+			// The ETag became stale since we read it. Try again.
+			return transient.Tag.Apply(err)
+		case ok && apiErr.Code == http.StatusForbidden:
+			return err
+		case err != nil:
+			return transient.Tag.Apply(err)
+		}
+		logging.Infof(ctx, "Set partition expiration of BigQuery table %s to %s (was %s)", t.FullyQualifiedName(), expiration, md.TimePartitioning.Expiration)
+		updated = true
+		return nil
+	}, nil)
+	return updated, err
+}
+
+// mergeSchema returns the existing schema with the fields of the desired
+// schema it is missing appended, and whether any were. Returns
+// ErrIncompatibleSchema if a field exists in both with a different type or
+// mode.
+func mergeSchema(existing, desired bigquery.Schema) (merged bigquery.Schema, mutated bool, err error) {
+	indexed := make(map[string]*bigquery.FieldSchema, len(existing))
+	for _, f := range existing {
+		indexed[f.Name] = f
+	}
+	merged = make(bigquery.Schema, 0, len(existing)+len(desired))
+	for _, f := range existing {
+		copied := *f
+		merged = append(merged, &copied)
+		indexed[f.Name] = &copied
+	}
+	for _, f := range desired {
+		e, ok := indexed[f.Name]
+		if !ok {
+			// New columns must not be required.
+			added := *f
+			added.Required = false
+			merged = append(merged, &added)
+			mutated = true
+			continue
+		}
+		if e.Type != f.Type || e.Repeated != f.Repeated {
+			return nil, false, errors.Annotate(ErrIncompatibleSchema, "column %q is %s (repeated: %v), want %s (repeated: %v)",
+				f.Name, e.Type, e.Repeated, f.Type, f.Repeated).Err()
+		}
+		if f.Type != bigquery.RecordFieldType {
+			continue
+		}
+		nested, nestedMutated, err := mergeSchema(e.Schema, f.Schema)
+		if err != nil {
+			return nil, false, errors.Annotate(err, "column %q", f.Name).Err()
+		}
+		e.Schema = nested
+		mutated = mutated || nestedMutated
+	}
+	return merged, mutated, nil
+}
+
+// EnsureTables migrates the clustered failures tables of all LUCI projects
+// configured in Weetbix to the schema of the row proto.
+//
+// It is run at startup, so that new columns are available before rows are
+// exported. Tables are also migrated before rows are first inserted into
+// them, in case this fails.
+func (c *Client) EnsureTables(ctx context.Context) {
+	projects, err := config.Projects(ctx)
+	if err != nil {
+		logging.Errorf(ctx, "Migrating clustered failures tables: obtaining projects: %s", err)
+		return
+	}
+	client, err := bqutil.Client(ctx, c.projectID)
+	if err != nil {
+		logging.Errorf(ctx, "Migrating clustered failures tables: creating BQ client: %s", err)
+		return
+	}
+	defer client.Close()
+
+	for project := range projects {
+		dataset, err := bqutil.DatasetForProject(project)
+		if err != nil {
+			logging.Errorf(ctx, "Migrating clustered failures table of %s: getting dataset: %s", project, err)
+			continue
+		}
+		if err := ensureTable(ctx, client.Dataset(dataset).Table(tableName)); err != nil {
+			logging.Errorf(ctx, "Migrating clustered failures table in dataset %q: %s", dataset, err)
+		}
+	}
+}
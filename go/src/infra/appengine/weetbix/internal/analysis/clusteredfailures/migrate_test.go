@@ -0,0 +1,219 @@
+// Copyright 2021 The Chromium Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package clusteredfailures
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"cloud.google.com/go/bigquery"
+	"google.golang.org/api/googleapi"
+
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/server/caching"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+// fakeTable is an in-memory bq.Table.
+type fakeTable struct {
+	md      *bigquery.TableMetadata
+	creates int
+	updates int
+}
+
+func (t *fakeTable) FullyQualifiedName() string {
+	return "project.dataset.clustered_failures"
+}
+
+func (t *fakeTable) Metadata(ctx context.Context) (*bigquery.TableMetadata, error) {
+	if t.md == nil {
+		return nil, &googleapi.Error{Code: http.StatusNotFound}
+	}
+	md := *t.md
+	md.ETag = "etag"
+	return &md, nil
+}
+
+func (t *fakeTable) Create(ctx context.Context, md *bigquery.TableMetadata) error {
+	t.creates++
+	t.md = md
+	return nil
+}
+
+func (t *fakeTable) Update(ctx context.Context, md bigquery.TableMetadataToUpdate, etag string) (*bigquery.TableMetadata, error) {
+	t.updates++
+	if etag != "etag" {
+		return nil, &googleapi.Error{Code: http.StatusPreconditionFailed}
+	}
+	updated := *t.md
+	if md.Schema != nil {
+		updated.Schema = md.Schema
+	}
+	if md.TimePartitioning != nil {
+		tp := *t.md.TimePartitioning
+		tp.Expiration = md.TimePartitioning.Expiration
+		updated.TimePartitioning = &tp
+	}
+	t.md = &updated
+	return t.md, nil
+}
+
+func fieldNames(s bigquery.Schema) []string {
+	var names []string
+	for _, f := range s {
+		names = append(names, f.Name)
+	}
+	return names
+}
+
+func TestMigrateTable(t *testing.T) {
+	t.Parallel()
+	Convey(`migrateTable`, t, func() {
+		ctx := context.Background()
+		desired := &bigquery.TableMetadata{
+			Schema: bigquery.Schema{
+				{Name: "project", Type: bigquery.StringFieldType, Required: true},
+				{Name: "failure_reason", Type: bigquery.RecordFieldType, Schema: bigquery.Schema{
+					{Name: "primary_error_message", Type: bigquery.StringFieldType},
+				}},
+				{Name: "structured_failure_reason", Type: bigquery.RecordFieldType, Schema: bigquery.Schema{
+					{Name: "primary_error_message", Type: bigquery.StringFieldType},
+					{Name: "error_type_tags", Type: bigquery.StringFieldType, Repeated: true},
+				}},
+			},
+		}
+
+		Convey(`Creates missing table`, func() {
+			table := &fakeTable{}
+			So(migrateTable(ctx, table, desired), ShouldBeNil)
+			So(table.creates, ShouldEqual, 1)
+			So(table.md, ShouldEqual, desired)
+		})
+		Convey(`Adds missing columns`, func() {
+			table := &fakeTable{md: &bigquery.TableMetadata{
+				Schema: bigquery.Schema{
+					{Name: "legacy", Type: bigquery.IntegerFieldType},
+					{Name: "project", Type: bigquery.StringFieldType, Required: true},
+					{Name: "failure_reason", Type: bigquery.RecordFieldType, Schema: bigquery.Schema{}},
+				},
+			}}
+			So(migrateTable(ctx, table, desired), ShouldBeNil)
+			So(table.updates, ShouldEqual, 1)
+
+			s := table.md.Schema
+			// Existing columns are kept, and new columns appended.
+			So(fieldNames(s), ShouldResemble, []string{"legacy", "project", "failure_reason", "structured_failure_reason"})
+			So(s[1].Required, ShouldBeTrue)
+			So(fieldNames(s[2].Schema), ShouldResemble, []string{"primary_error_message"})
+			So(fieldNames(s[3].Schema), ShouldResemble, []string{"primary_error_message", "error_type_tags"})
+			So(s[3].Schema[1].Repeated, ShouldBeTrue)
+		})
+		Convey(`Does not update up to date table`, func() {
+			table := &fakeTable{md: &bigquery.TableMetadata{Schema: desired.Schema}}
+			So(migrateTable(ctx, table, desired), ShouldBeNil)
+			So(table.updates, ShouldEqual, 0)
+		})
+		Convey(`Rejects incompatible columns`, func() {
+			table := &fakeTable{md: &bigquery.TableMetadata{
+				Schema: bigquery.Schema{
+					{Name: "project", Type: bigquery.StringFieldType, Required: true},
+					{Name: "structured_failure_reason", Type: bigquery.RecordFieldType, Schema: bigquery.Schema{
+						{Name: "error_type_tags", Type: bigquery.StringFieldType},
+					}},
+				},
+			}}
+			err := migrateTable(ctx, table, desired)
+			So(errors.Unwrap(err), ShouldEqual, ErrIncompatibleSchema)
+			So(err.Error(), ShouldContainSubstring, `column "error_type_tags"`)
+			So(table.updates, ShouldEqual, 0)
+		})
+	})
+}
+
+func TestEnsureTable(t *testing.T) {
+	t.Parallel()
+	Convey(`ensureTable`, t, func() {
+		ctx := caching.WithEmptyProcessCache(context.Background())
+
+		Convey(`Caches successful migrations`, func() {
+			table := &fakeTable{}
+			So(ensureTable(ctx, table), ShouldBeNil)
+			So(ensureTable(ctx, table), ShouldBeNil)
+			So(table.creates, ShouldEqual, 1)
+		})
+		Convey(`Caches incompatible schemas`, func() {
+			table := &fakeTable{md: &bigquery.TableMetadata{
+				Schema: bigquery.Schema{
+					{Name: "project", Type: bigquery.IntegerFieldType},
+				},
+			}}
+			err := ensureTable(ctx, table)
+			So(errors.Unwrap(err), ShouldEqual, ErrIncompatibleSchema)
+
+			// The second call is served from the cache.
+			table.md = nil
+			err = ensureTable(ctx, table)
+			So(errors.Unwrap(err), ShouldEqual, ErrIncompatibleSchema)
+			So(table.creates, ShouldEqual, 0)
+		})
+	})
+}
+
+func TestSetPartitionExpiration(t *testing.T) {
+	t.Parallel()
+	Convey(`setPartitionExpiration`, t, func() {
+		ctx := context.Background()
+		schema := bigquery.Schema{
+			{Name: "partition_time", Type: bigquery.TimestampFieldType},
+		}
+		partitioning := func(expiration time.Duration) *bigquery.TimePartitioning {
+			return &bigquery.TimePartitioning{
+				Type:       bigquery.DayPartitioningType,
+				Expiration: expiration,
+				Field:      "partition_time",
+			}
+		}
+
+		Convey(`Updates existing table`, func() {
+			table := &fakeTable{md: &bigquery.TableMetadata{
+				Schema:           schema,
+				TimePartitioning: partitioning(540 * 24 * time.Hour),
+			}}
+			updated, err := setPartitionExpiration(ctx, table, 90*24*time.Hour)
+			So(err, ShouldBeNil)
+			So(updated, ShouldBeTrue)
+			So(table.updates, ShouldEqual, 1)
+			So(table.md.TimePartitioning, ShouldResemble, partitioning(90*24*time.Hour))
+			So(table.md.Schema, ShouldResemble, schema)
+		})
+		Convey(`Does not update up to date table`, func() {
+			table := &fakeTable{md: &bigquery.TableMetadata{
+				Schema:           schema,
+				TimePartitioning: partitioning(90 * 24 * time.Hour),
+			}}
+			updated, err := setPartitionExpiration(ctx, table, 90*24*time.Hour)
+			So(err, ShouldBeNil)
+			So(updated, ShouldBeFalse)
+			So(table.updates, ShouldEqual, 0)
+		})
+		Convey(`Ignores missing table`, func() {
+			table := &fakeTable{}
+			updated, err := setPartitionExpiration(ctx, table, 90*24*time.Hour)
+			So(err, ShouldBeNil)
+			So(updated, ShouldBeFalse)
+			So(table.creates, ShouldEqual, 0)
+		})
+		Convey(`Rejects unpartitioned table`, func() {
+			table := &fakeTable{md: &bigquery.TableMetadata{Schema: schema}}
+			_, err := setPartitionExpiration(ctx, table, 90*24*time.Hour)
+			So(err, ShouldNotBeNil)
+			So(err.Error(), ShouldContainSubstring, "is not partitioned")
+			So(table.updates, ShouldEqual, 0)
+		})
+	})
+}
@@ -17,6 +17,9 @@ import (
 	pb "infra/appengine/weetbix/proto/v1"
 )
 
+// partitionExpirationTime is the partition expiration tables are created
+// with. The enforce-retention cron job then sets it to the retention period
+// of the LUCI project, see SetPartitionExpiration.
 const partitionExpirationTime = 540 * 24 * time.Hour
 
 const rowMessage = "weetbix.bq.ClusteredFailureRow"
@@ -6,21 +6,23 @@ package analysis
 
 import (
 	"context"
-	"time"
+	"unicode/utf8"
 
+	"infra/appengine/weetbix/internal/analysis/outbox"
 	"infra/appengine/weetbix/internal/clustering"
+	"infra/appengine/weetbix/internal/clustering/algorithms/failurereason"
 	cpb "infra/appengine/weetbix/internal/clustering/proto"
+	"infra/appengine/weetbix/pbutil"
 	bqpb "infra/appengine/weetbix/proto/bq"
 	pb "infra/appengine/weetbix/proto/v1"
 
 	"google.golang.org/protobuf/proto"
-	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 // ClusteringHandler handles test result (re-)clustering events, to
 // ensure analysis remains up-to-date.
 type ClusteringHandler struct {
-	cfClient ClusteredFailuresClient
+	dispatcher *outbox.Dispatcher
 }
 
 // ClusteredFailuresClient exports clustered failures to BigQuery for
@@ -32,28 +34,34 @@ type ClusteredFailuresClient interface {
 
 func NewClusteringHandler(cf ClusteredFailuresClient) *ClusteringHandler {
 	return &ClusteringHandler{
-		cfClient: cf,
+		dispatcher: outbox.NewDispatcher(cf),
 	}
 }
 
 // HandleUpdatedClusters handles (re-)clustered test results. It is called
-// after the spanner transaction effecting the (re-)clustering has committed.
-// commitTime is the Spanner time the transaction committed.
-//
-// If this method fails, it will not be retried and data loss or inconsistency
-// (in this method's BigQuery export) may occur. This could be improved in
-// future with a two-stage apply process (journalling the BigQuery updates
-// to be applied as part of the original transaction and retrying them at
-// a later point if they do not succeed).
-func (r *ClusteringHandler) HandleUpdatedClusters(ctx context.Context, updates *clustering.Update, commitTime time.Time) error {
-	rowUpdates := prepareInserts(updates, commitTime)
-	return r.cfClient.Insert(ctx, updates.Project, rowUpdates)
+// in the Spanner read/write transaction effecting the (re-)clustering,
+// and writes the updates to the clustered failures table to the outbox,
+// so that they are exported if and only if the transaction commits.
+func (r *ClusteringHandler) HandleUpdatedClusters(ctx context.Context, updates *clustering.Update) error {
+	rowUpdates := prepareInserts(updates)
+	if len(rowUpdates) == 0 {
+		return nil
+	}
+	return outbox.Create(ctx, updates.Project, updates.ChunkID, rowUpdates)
+}
+
+// ExportUpdatedClusters exports the updates written to the outbox for the
+// given chunks, once the transaction which wrote them has committed.
+// Updates which fail to export remain in the outbox, and are exported
+// later by the dispatch-clustered-failures cron job.
+func (r *ClusteringHandler) ExportUpdatedClusters(ctx context.Context, project string, chunkIDs []string) error {
+	return r.dispatcher.DispatchChunks(ctx, project, chunkIDs)
 }
 
 // prepareInserts prepares entries into the BigQuery clustered failures table
 // in response to a (re-)clustering. For efficiency, only the updated rows are
-// returned.
-func prepareInserts(updates *clustering.Update, commitTime time.Time) []*bqpb.ClusteredFailureRow {
+// returned. The LastUpdated time of the rows is set when they are exported.
+func prepareInserts(updates *clustering.Update) []*bqpb.ClusteredFailureRow {
 	var result []*bqpb.ClusteredFailureRow
 	for _, u := range updates.Updates {
 		deleted := make(map[string]*clustering.ClusterID)
@@ -84,7 +92,7 @@ func prepareInserts(updates *clustering.Update, commitTime time.Time) []*bqpb.Cl
 		for _, dc := range deleted {
 			isIncluded := false
 			isIncludedWithHighPriority := false
-			row := entryFromUpdate(updates.Project, updates.ChunkID, dc, u.TestResult, isIncluded, isIncludedWithHighPriority, commitTime)
+			row := entryFromUpdate(updates.Project, updates.ChunkID, dc, u.TestResult, isIncluded, isIncludedWithHighPriority)
 			result = append(result, row)
 		}
 		// Create rows for retained clusters for which inclusion was modified.
@@ -101,7 +109,7 @@ func prepareInserts(updates *clustering.Update, commitTime time.Time) []*bqpb.Cl
 				// For efficiency, do not stream an update.
 				continue
 			}
-			row := entryFromUpdate(updates.Project, updates.ChunkID, rc, u.TestResult, isIncluded, newIncludedWithHighPriority, commitTime)
+			row := entryFromUpdate(updates.Project, updates.ChunkID, rc, u.TestResult, isIncluded, newIncludedWithHighPriority)
 			result = append(result, row)
 		}
 		// Create rows for new clusters.
@@ -112,14 +120,14 @@ func prepareInserts(updates *clustering.Update, commitTime time.Time) []*bqpb.Cl
 			// appear with high priority in any suggested clusters it appears
 			// in.
 			isIncludedWithHighPriority := nc.IsBugCluster() || !newInBugCluster
-			row := entryFromUpdate(updates.Project, updates.ChunkID, nc, u.TestResult, isIncluded, isIncludedWithHighPriority, commitTime)
+			row := entryFromUpdate(updates.Project, updates.ChunkID, nc, u.TestResult, isIncluded, isIncludedWithHighPriority)
 			result = append(result, row)
 		}
 	}
 	return result
 }
 
-func entryFromUpdate(project, chunkID string, cluster *clustering.ClusterID, failure *cpb.Failure, included, includedWithHighPriority bool, commitTime time.Time) *bqpb.ClusteredFailureRow {
+func entryFromUpdate(project, chunkID string, cluster *clustering.ClusterID, failure *cpb.Failure, included, includedWithHighPriority bool) *bqpb.ClusteredFailureRow {
 	// Copy the failure, to ensure the returned ClusteredFailure does not
 	// alias any of the original failure's nested message protos.
 	failure = proto.Clone(failure).(*cpb.Failure)
@@ -128,7 +136,6 @@ func entryFromUpdate(project, chunkID string, cluster *clustering.ClusterID, fai
 		ClusterId:        cluster.ID,
 		TestResultSystem: failure.TestResultId.System,
 		TestResultId:     failure.TestResultId.Id,
-		LastUpdated:      timestamppb.New(commitTime),
 
 		PartitionTime: failure.PartitionTime,
 
@@ -148,6 +155,9 @@ func entryFromUpdate(project, chunkID string, cluster *clustering.ClusterID, fai
 		Duration:             failure.Duration,
 		IsExonerated:         failure.IsExonerated,
 
+		ExonerationReason:      failure.ExonerationReason,
+		ExonerationExplanation: failure.ExonerationExplanation,
+
 		PresubmitRunId:                failure.PresubmitRunId,
 		IngestedInvocationId:          failure.IngestedInvocationId,
 		IngestedInvocationResultIndex: failure.IngestedInvocationResultIndex,
@@ -157,17 +167,48 @@ func entryFromUpdate(project, chunkID string, cluster *clustering.ClusterID, fai
 		TestRunResultIndex:            failure.TestRunResultIndex,
 		TestRunResultCount:            failure.TestRunResultCount,
 		IsTestRunBlocked:              failure.IsTestRunBlocked,
+		IsDuplicate:                   failure.IsDuplicate,
+
+		StructuredFailureReason: structuredFailureReason(failure),
+		Artifacts:               failure.Artifacts,
+		FailureKind:             failure.FailureKind,
 	}
 	return entry
 }
 
-func variantPairs(v *pb.Variant) []*pb.StringPair {
-	var result []*pb.StringPair
-	for k, v := range v.Def {
-		result = append(result, &pb.StringPair{
-			Key:   k,
-			Value: v,
-		})
+// maxPrimaryErrorMessageBytes is the maximum size of the primary error
+// message of a structured failure reason.
+const maxPrimaryErrorMessageBytes = 1024
+
+// structuredFailureReason returns the structured failure reason of a
+// failure, or nil if the failure has no failure reason or error types.
+func structuredFailureReason(failure *cpb.Failure) *bqpb.StructuredFailureReason {
+	if failure.FailureReason == nil && len(failure.ErrorTypeTags) == 0 {
+		return nil
 	}
-	return result
+	message, truncated := truncateUTF8(failure.FailureReason.GetPrimaryErrorMessage(), maxPrimaryErrorMessageBytes)
+	return &bqpb.StructuredFailureReason{
+		PrimaryErrorMessage:           message,
+		ErrorTypeTags:                 failure.ErrorTypeTags,
+		Truncated:                     truncated,
+		NormalizationAlgorithmVersion: failurereason.AlgorithmVersion,
+	}
+}
+
+// truncateUTF8 truncates s to at most n bytes, without splitting a UTF-8
+// encoded rune.
+func truncateUTF8(s string, n int) (result string, truncated bool) {
+	if len(s) <= n {
+		return s, false
+	}
+	for n > 0 && !utf8.RuneStart(s[n]) {
+		n--
+	}
+	return s[:n], true
+}
+
+// variantPairs returns the key-value pairs of the variant, sorted by key,
+// so that rows are deterministic.
+func variantPairs(v *pb.Variant) []*pb.StringPair {
+	return pbutil.VariantToStringPairs(v)
 }
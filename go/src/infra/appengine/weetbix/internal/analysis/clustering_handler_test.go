@@ -0,0 +1,77 @@
+// Copyright 2021 The Chromium Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package analysis
+
+import (
+	"strings"
+	"testing"
+
+	"infra/appengine/weetbix/internal/clustering"
+	"infra/appengine/weetbix/internal/clustering/algorithms/failurereason"
+	cpb "infra/appengine/weetbix/internal/clustering/proto"
+	bqpb "infra/appengine/weetbix/proto/bq"
+	pb "infra/appengine/weetbix/proto/v1"
+
+	. "github.com/smartystreets/goconvey/convey"
+	. "go.chromium.org/luci/common/testing/assertions"
+)
+
+func TestStructuredFailureReason(t *testing.T) {
+	t.Parallel()
+	Convey(`Structured failure reason`, t, func() {
+		cluster := &clustering.ClusterID{Algorithm: failurereason.AlgorithmName, ID: "00112233445566778899aabbccddeeff"}
+		failure := &cpb.Failure{
+			TestResultId:  &pb.TestResultId{System: "resultdb", Id: "invocations/inv/tests/test/results/result"},
+			TestId:        "test",
+			FailureReason: &pb.FailureReason{PrimaryErrorMessage: "Check failed: x == y."},
+			ErrorTypeTags: []string{"check_failure"},
+		}
+		row := func() *bqpb.ClusteredFailureRow {
+			return entryFromUpdate("chromium", "chunk", cluster, failure, true, true)
+		}
+
+		Convey(`With failure reason and error types`, func() {
+			r := row()
+			So(r.StructuredFailureReason, ShouldResembleProto, &bqpb.StructuredFailureReason{
+				PrimaryErrorMessage:           "Check failed: x == y.",
+				ErrorTypeTags:                 []string{"check_failure"},
+				NormalizationAlgorithmVersion: failurereason.AlgorithmVersion,
+			})
+			// The existing column is unchanged.
+			So(r.FailureReason, ShouldResembleProto, &pb.FailureReason{PrimaryErrorMessage: "Check failed: x == y."})
+		})
+		Convey(`Without error types`, func() {
+			failure.ErrorTypeTags = nil
+			So(row().StructuredFailureReason, ShouldResembleProto, &bqpb.StructuredFailureReason{
+				PrimaryErrorMessage:           "Check failed: x == y.",
+				NormalizationAlgorithmVersion: failurereason.AlgorithmVersion,
+			})
+		})
+		Convey(`Without failure reason`, func() {
+			failure.FailureReason = nil
+			So(row().StructuredFailureReason, ShouldResembleProto, &bqpb.StructuredFailureReason{
+				ErrorTypeTags:                 []string{"check_failure"},
+				NormalizationAlgorithmVersion: failurereason.AlgorithmVersion,
+			})
+
+			failure.ErrorTypeTags = nil
+			So(row().StructuredFailureReason, ShouldBeNil)
+		})
+		Convey(`Truncated`, func() {
+			// "é" is encoded as two bytes, so the limit falls within a rune.
+			failure.FailureReason.PrimaryErrorMessage = "a" + strings.Repeat("é", maxPrimaryErrorMessageBytes)
+			r := row()
+			So(r.StructuredFailureReason.Truncated, ShouldBeTrue)
+			So(r.StructuredFailureReason.PrimaryErrorMessage, ShouldEqual, "a"+strings.Repeat("é", maxPrimaryErrorMessageBytes/2-1))
+			// The existing column is not truncated.
+			So(r.FailureReason.PrimaryErrorMessage, ShouldEqual, failure.FailureReason.PrimaryErrorMessage)
+		})
+		Convey(`Does not alias the failure`, func() {
+			r := row()
+			r.StructuredFailureReason.ErrorTypeTags[0] = "modified"
+			So(failure.ErrorTypeTags, ShouldResemble, []string{"check_failure"})
+		})
+	})
+}
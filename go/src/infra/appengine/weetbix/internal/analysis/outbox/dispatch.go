@@ -0,0 +1,265 @@
+// Copyright 2021 The Chromium Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+// Package outbox implements the transactional outbox of rows to export
+// to the clustered failures BigQuery table.
+//
+// Rows are written to the outbox in the same Spanner transaction as the
+// (re-)clustering which produced them, and exported to BigQuery once the
+// transaction commits. Entries are only deleted from the outbox once
+// their rows are exported, so rows are not lost if the process stops
+// between the commit and the export; the dispatch-clustered-failures cron
+// job exports any entries left behind.
+package outbox
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"go.chromium.org/luci/common/clock"
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/common/logging"
+	"go.chromium.org/luci/common/tsmon/field"
+	"go.chromium.org/luci/common/tsmon/metric"
+	"go.chromium.org/luci/common/tsmon/types"
+	"go.chromium.org/luci/server/span"
+
+	"infra/appengine/weetbix/internal/config"
+	bqpb "infra/appengine/weetbix/proto/bq"
+)
+
+const (
+	// maxAttempts is the number of failed exports after which an entry
+	// is quarantined. With the backoff below, entries are retried for
+	// about ten hours before they are quarantined.
+	maxAttempts = 15
+	// minBackoff is the time to wait before retrying an entry after its
+	// first failed export. The time doubles with each failed export, up
+	// to maxBackoff.
+	minBackoff = time.Minute
+	maxBackoff = time.Hour
+	// dueChunksBatchSize is the number of chunks to read at a time when
+	// dispatching due entries.
+	dueChunksBatchSize = 100
+	// cronDispatchDuration is the time the dispatch-clustered-failures
+	// cron job dispatches entries for. The cron job runs every minute.
+	cronDispatchDuration = 50 * time.Second
+)
+
+var (
+	dispatchCounter = metric.NewCounter(
+		"weetbix/clustered_failures_outbox/dispatches",
+		"The number of attempts to export entries of the clustered failures outbox to BigQuery",
+		nil,
+		// status can be "success", "failure" or "quarantined".
+		field.String("project"), field.String("status"))
+
+	depthGauge = metric.NewInt(
+		"weetbix/clustered_failures_outbox/depth",
+		"The number of entries in the clustered failures outbox",
+		nil,
+		// state can be "pending" or "quarantined".
+		field.String("project"), field.String("state"))
+
+	ageGauge = metric.NewFloat(
+		"weetbix/clustered_failures_outbox/age",
+		"The age of the oldest entry waiting to be exported from the clustered failures outbox",
+		&types.MetricMetadata{Units: types.Seconds},
+		field.String("project"))
+)
+
+// Exporter exports rows to the clustered failures BigQuery table.
+type Exporter interface {
+	// Insert inserts the given rows into BigQuery. Rows must be inserted
+	// with insert IDs derived from their contents, so that rows exported
+	// more than once are deduplicated.
+	Insert(ctx context.Context, luciProject string, rows []*bqpb.ClusteredFailureRow) error
+}
+
+// Dispatcher exports the rows in the clustered failures outbox to
+// BigQuery.
+//
+// The entries of each chunk are exported in the order they were written.
+// If an entry fails to export, later entries of the same chunk are held
+// back until it is exported or quarantined, so that BigQuery never
+// receives a newer version of a row before an older one.
+type Dispatcher struct {
+	exporter Exporter
+}
+
+// NewDispatcher initialises a new Dispatcher.
+func NewDispatcher(exporter Exporter) *Dispatcher {
+	return &Dispatcher{
+		exporter: exporter,
+	}
+}
+
+// DispatchChunks exports the outbox entries of the given chunks of a LUCI
+// project. It is called after the transaction writing the entries commits.
+// Entries which fail to export remain in the outbox to be retried by
+// Dispatch.
+func (d *Dispatcher) DispatchChunks(ctx context.Context, project string, chunkIDs []string) error {
+	entries, err := ReadChunks(span.Single(ctx), project, chunkIDs)
+	if err != nil {
+		return errors.Annotate(err, "read outbox entries").Err()
+	}
+	return d.dispatch(ctx, entries)
+}
+
+// Dispatch exports outbox entries which are due for export, until no
+// entries are due or the deadline is reached.
+func (d *Dispatcher) Dispatch(ctx context.Context, deadline time.Time) error {
+	for clock.Now(ctx).Before(deadline) {
+		chunks, err := ReadDueChunks(span.Single(ctx), clock.Now(ctx), dueChunksBatchSize)
+		if err != nil {
+			return errors.Annotate(err, "read due chunks").Err()
+		}
+		if len(chunks) == 0 {
+			return nil
+		}
+		// Read the entries of the chunks of each project together.
+		var projects []string
+		chunkIDsByProject := make(map[string][]string)
+		for _, c := range chunks {
+			if _, ok := chunkIDsByProject[c.Project]; !ok {
+				projects = append(projects, c.Project)
+			}
+			chunkIDsByProject[c.Project] = append(chunkIDsByProject[c.Project], c.ChunkID)
+		}
+		for _, project := range projects {
+			if err := d.DispatchChunks(ctx, project, chunkIDsByProject[project]); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// CronHandler handles the dispatch-clustered-failures cron job. It exports
+// the outbox entries which are due for export, then reports metrics on
+// the outbox.
+func (d *Dispatcher) CronHandler(ctx context.Context) error {
+	err := d.Dispatch(ctx, clock.Now(ctx).Add(cronDispatchDuration))
+	if err != nil {
+		logging.Errorf(ctx, "Dispatching the clustered failures outbox: %s", err)
+	}
+	if err := reportMetrics(ctx); err != nil {
+		return errors.Annotate(err, "report metrics").Err()
+	}
+	return err
+}
+
+// dispatch exports the given entries, which must be ordered by project,
+// chunk, commit time and sequence number.
+func (d *Dispatcher) dispatch(ctx context.Context, entries []*Entry) error {
+	now := clock.Now(ctx)
+	var blocked *Entry
+	for _, e := range entries {
+		if blocked != nil && blocked.Project == e.Project && blocked.ChunkID == e.ChunkID {
+			// An earlier entry of the chunk is waiting to be retried.
+			continue
+		}
+		if e.NextAttemptTime.After(now) {
+			blocked = e
+			continue
+		}
+		exported, err := d.export(ctx, e)
+		if err != nil {
+			return err
+		}
+		if !exported {
+			blocked = e
+		}
+	}
+	return nil
+}
+
+// export exports the rows of the given entry and deletes it. It returns
+// whether the entry is no longer pending, i.e. it was exported or
+// quarantined. Failed exports are recorded on the entry and are not
+// returned as errors.
+func (d *Dispatcher) export(ctx context.Context, e *Entry) (done bool, err error) {
+	lastUpdated := timestamppb.New(e.CommitTime)
+	for _, r := range e.Rows {
+		r.LastUpdated = lastUpdated
+	}
+	exportErr := d.exporter.Insert(ctx, e.Project, e.Rows)
+	if exportErr == nil {
+		if err := Delete(ctx, e.EntryKey); err != nil {
+			// The entry will be exported again, and deduplicated by
+			// insert ID.
+			return false, err
+		}
+		dispatchCounter.Add(ctx, 1, e.Project, "success")
+		return true, nil
+	}
+
+	e.Attempts++
+	e.LastError = exportErr.Error()
+	if e.Attempts >= maxAttempts {
+		logging.Errorf(ctx, "Quarantining outbox entry %s/%s (committed %s) after %v failed exports: %s",
+			e.Project, e.ChunkID, e.CommitTime, e.Attempts, exportErr)
+		e.Quarantined = true
+		dispatchCounter.Add(ctx, 1, e.Project, "quarantined")
+	} else {
+		logging.Warningf(ctx, "Failed to export outbox entry %s/%s (committed %s), attempt %v: %s",
+			e.Project, e.ChunkID, e.CommitTime, e.Attempts, exportErr)
+		e.NextAttemptTime = clock.Now(ctx).Add(backoff(e.Attempts))
+		dispatchCounter.Add(ctx, 1, e.Project, "failure")
+	}
+	if err := recordFailure(ctx, e); err != nil {
+		return false, err
+	}
+	// Quarantined entries do not hold back later entries of the chunk.
+	return e.Quarantined, nil
+}
+
+// backoff returns the time to wait before retrying an entry which failed
+// to export the given number of times.
+func backoff(attempts int64) time.Duration {
+	result := minBackoff
+	for i := int64(1); i < attempts && result < maxBackoff; i++ {
+		result *= 2
+	}
+	if result > maxBackoff {
+		result = maxBackoff
+	}
+	return result
+}
+
+// reportMetrics reports the depth and age of the outbox of each LUCI
+// project.
+func reportMetrics(ctx context.Context) error {
+	stats, err := ReadStats(span.Single(ctx))
+	if err != nil {
+		return errors.Annotate(err, "read outbox stats").Err()
+	}
+	projectCfg, err := config.Projects(ctx)
+	if err != nil {
+		return errors.Annotate(err, "get project configs").Err()
+	}
+	// Report projects with empty outboxes, so their metrics are reset
+	// once their outboxes drain.
+	statsByProject := make(map[string]*ProjectStats)
+	for project := range projectCfg {
+		statsByProject[project] = &ProjectStats{Project: project}
+	}
+	for _, s := range stats {
+		statsByProject[s.Project] = s
+	}
+
+	now := clock.Now(ctx)
+	for project, s := range statsByProject {
+		depthGauge.Set(ctx, s.Pending, project, "pending")
+		depthGauge.Set(ctx, s.Quarantined, project, "quarantined")
+		age := 0.0
+		if !s.OldestPendingCommitTime.IsZero() {
+			age = now.Sub(s.OldestPendingCommitTime).Seconds()
+		}
+		ageGauge.Set(ctx, age, project)
+	}
+	return nil
+}
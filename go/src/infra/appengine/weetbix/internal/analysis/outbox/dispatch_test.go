@@ -0,0 +1,237 @@
+// Copyright 2021 The Chromium Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package outbox
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"go.chromium.org/luci/common/clock/testclock"
+	"go.chromium.org/luci/server/span"
+
+	"infra/appengine/weetbix/internal/testutil"
+	bqpb "infra/appengine/weetbix/proto/bq"
+
+	. "github.com/smartystreets/goconvey/convey"
+	. "go.chromium.org/luci/common/testing/assertions"
+)
+
+// fakeExporter is a fake Exporter which fails to export rows of test IDs
+// in failingTests.
+type fakeExporter struct {
+	inserted     []*bqpb.ClusteredFailureRow
+	failingTests map[string]bool
+}
+
+func (f *fakeExporter) Insert(ctx context.Context, luciProject string, rows []*bqpb.ClusteredFailureRow) error {
+	for _, r := range rows {
+		if f.failingTests[r.TestId] {
+			return errors.New("bigquery is unavailable")
+		}
+	}
+	for _, r := range rows {
+		f.inserted = append(f.inserted, proto.Clone(r).(*bqpb.ClusteredFailureRow))
+	}
+	return nil
+}
+
+func TestDispatch(t *testing.T) {
+	Convey(`With Spanner Test Database`, t, func() {
+		ctx := testutil.SpannerTestContext(t)
+		// Use a time representable in Spanner, which stores timestamps
+		// with microsecond precision.
+		now := time.Date(2021, time.December, 1, 12, 0, 0, 0, time.UTC)
+		ctx, tc := testclock.UseTime(ctx, now)
+
+		exporter := &fakeExporter{failingTests: make(map[string]bool)}
+		d := NewDispatcher(exporter)
+
+		chunkA := strings.Repeat("aa", 16)
+		chunkB := strings.Repeat("bb", 16)
+
+		newRows := func(chunkID, testID string, n int) []*bqpb.ClusteredFailureRow {
+			var rows []*bqpb.ClusteredFailureRow
+			for i := 0; i < n; i++ {
+				rows = append(rows, &bqpb.ClusteredFailureRow{
+					ClusterAlgorithm: "rules-v2",
+					ClusterId:        "00112233445566778899aabbccddeeff",
+					ChunkId:          chunkID,
+					ChunkIndex:       int64(i),
+					TestId:           testID,
+				})
+			}
+			return rows
+		}
+		// create writes the rows of each chunk to the outbox in a
+		// transaction, and returns the rows as they should be exported.
+		create := func(rowsByChunk map[string][]*bqpb.ClusteredFailureRow) []*bqpb.ClusteredFailureRow {
+			commitTime, err := span.ReadWriteTransaction(ctx, func(ctx context.Context) error {
+				for chunkID, rows := range rowsByChunk {
+					if err := Create(ctx, "chromium", chunkID, rows); err != nil {
+						return err
+					}
+				}
+				return nil
+			})
+			So(err, ShouldBeNil)
+			var expected []*bqpb.ClusteredFailureRow
+			for _, chunkID := range []string{chunkA, chunkB} {
+				for _, r := range rowsByChunk[chunkID] {
+					r = proto.Clone(r).(*bqpb.ClusteredFailureRow)
+					r.LastUpdated = timestamppb.New(commitTime)
+					expected = append(expected, r)
+				}
+			}
+			return expected
+		}
+		dispatch := func() {
+			So(d.Dispatch(ctx, tc.Now().Add(time.Minute)), ShouldBeNil)
+		}
+		readAll := func() []*Entry {
+			entries, err := ReadAllForTesting(ctx)
+			So(err, ShouldBeNil)
+			return entries
+		}
+
+		Convey(`Entries are only written if the transaction commits`, func() {
+			_, err := span.ReadWriteTransaction(ctx, func(ctx context.Context) error {
+				if err := Create(ctx, "chromium", chunkA, newRows(chunkA, "test", 1)); err != nil {
+					return err
+				}
+				return errors.New("transaction failed")
+			})
+			So(err, ShouldErrLike, "transaction failed")
+			So(readAll(), ShouldBeEmpty)
+		})
+		Convey(`Rows are exported by DispatchChunks`, func() {
+			expected := create(map[string][]*bqpb.ClusteredFailureRow{
+				chunkA: newRows(chunkA, "test", 2),
+			})
+			So(d.DispatchChunks(ctx, "chromium", []string{chunkA}), ShouldBeNil)
+			So(exporter.inserted, ShouldResembleProto, expected)
+			So(readAll(), ShouldBeEmpty)
+		})
+		Convey(`Rows are exported by Dispatch after a crash following the commit`, func() {
+			// The process stops between the commit and the export, so
+			// DispatchChunks is never called.
+			expected := create(map[string][]*bqpb.ClusteredFailureRow{
+				chunkA: newRows(chunkA, "test", 2),
+				chunkB: newRows(chunkB, "test", 1),
+			})
+			So(readAll(), ShouldHaveLength, 2)
+
+			dispatch()
+			So(exporter.inserted, ShouldResembleProto, expected)
+			So(readAll(), ShouldBeEmpty)
+		})
+		Convey(`Large updates are split across entries`, func() {
+			expected := create(map[string][]*bqpb.ClusteredFailureRow{
+				chunkA: newRows(chunkA, "test", maxRowsPerEntry+1),
+			})
+			entries := readAll()
+			So(entries, ShouldHaveLength, 2)
+			So(entries[0].Rows, ShouldHaveLength, maxRowsPerEntry)
+			So(entries[1].Rows, ShouldHaveLength, 1)
+
+			dispatch()
+			So(exporter.inserted, ShouldResembleProto, expected)
+		})
+		Convey(`Failed exports are retried with backoff, in order`, func() {
+			exporter.failingTests["test"] = true
+			first := create(map[string][]*bqpb.ClusteredFailureRow{
+				chunkA: newRows(chunkA, "test", 1),
+			})
+			second := create(map[string][]*bqpb.ClusteredFailureRow{
+				chunkA: newRows(chunkA, "other-test", 1),
+				chunkB: newRows(chunkB, "other-test", 1),
+			})
+
+			So(d.DispatchChunks(ctx, "chromium", []string{chunkA, chunkB}), ShouldBeNil)
+			// The later entry of chunk A is held back behind the failed
+			// entry, while chunk B is unaffected.
+			So(exporter.inserted, ShouldResembleProto, second[1:])
+			entries := readAll()
+			So(entries, ShouldHaveLength, 2)
+			So(entries[0].Attempts, ShouldEqual, 1)
+			So(entries[0].NextAttemptTime, ShouldEqual, tc.Now().Add(minBackoff))
+			So(entries[0].LastError, ShouldEqual, "bigquery is unavailable")
+			So(entries[1].Attempts, ShouldEqual, 0)
+
+			// Not retried before the backoff elapses.
+			exporter.failingTests["test"] = false
+			dispatch()
+			So(exporter.inserted, ShouldHaveLength, 1)
+
+			tc.Add(minBackoff)
+			dispatch()
+			So(exporter.inserted, ShouldResembleProto, append(second[1:], first[0], second[0]))
+			So(readAll(), ShouldBeEmpty)
+		})
+		Convey(`Poison entries are quarantined`, func() {
+			exporter.failingTests["poison"] = true
+			create(map[string][]*bqpb.ClusteredFailureRow{
+				chunkA: newRows(chunkA, "poison", 1),
+			})
+			expected := create(map[string][]*bqpb.ClusteredFailureRow{
+				chunkA: newRows(chunkA, "test", 1),
+			})
+
+			for i := 0; i < maxAttempts; i++ {
+				So(exporter.inserted, ShouldBeEmpty)
+				dispatch()
+				tc.Add(maxBackoff)
+			}
+			// Quarantined entries do not hold back later entries.
+			So(exporter.inserted, ShouldResembleProto, expected)
+
+			entries := readAll()
+			So(entries, ShouldHaveLength, 1)
+			So(entries[0].Quarantined, ShouldBeTrue)
+			So(entries[0].Attempts, ShouldEqual, maxAttempts)
+
+			// Quarantined entries are not exported again.
+			exporter.failingTests["poison"] = false
+			dispatch()
+			So(exporter.inserted, ShouldHaveLength, 1)
+
+			stats, err := ReadStats(span.Single(ctx))
+			So(err, ShouldBeNil)
+			So(stats, ShouldResemble, []*ProjectStats{{Project: "chromium", Quarantined: 1}})
+		})
+		Convey(`ReadStats`, func() {
+			var commitTimes []time.Time
+			for i := 0; i < 2; i++ {
+				rows := create(map[string][]*bqpb.ClusteredFailureRow{
+					chunkA: newRows(chunkA, fmt.Sprintf("test-%v", i), 1),
+				})
+				commitTimes = append(commitTimes, rows[0].LastUpdated.AsTime())
+			}
+			stats, err := ReadStats(span.Single(ctx))
+			So(err, ShouldBeNil)
+			So(stats, ShouldResemble, []*ProjectStats{{
+				Project:                 "chromium",
+				Pending:                 2,
+				OldestPendingCommitTime: commitTimes[0],
+			}})
+		})
+	})
+}
+
+func TestBackoff(t *testing.T) {
+	t.Parallel()
+	Convey(`Backoff`, t, func() {
+		So(backoff(1), ShouldEqual, minBackoff)
+		So(backoff(2), ShouldEqual, 2*minBackoff)
+		So(backoff(3), ShouldEqual, 4*minBackoff)
+		So(backoff(maxAttempts), ShouldEqual, maxBackoff)
+	})
+}
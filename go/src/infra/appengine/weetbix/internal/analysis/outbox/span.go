@@ -0,0 +1,268 @@
+// Copyright 2021 The Chromium Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package outbox
+
+import (
+	"context"
+	"time"
+
+	"cloud.google.com/go/spanner"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/proto"
+
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/server/span"
+
+	spanutil "infra/appengine/weetbix/internal/span"
+	bqpb "infra/appengine/weetbix/proto/bq"
+)
+
+// maxRowsPerEntry is the maximum number of rows stored in each outbox
+// entry. With each row not exceeding ~2KB, this keeps entries well clear
+// of the 10MB Spanner cell size limit, and each export within a single
+// BigQuery insert request.
+const maxRowsPerEntry = 1000
+
+// EntryKey identifies an outbox entry.
+type EntryKey struct {
+	// Project is the LUCI Project the rows belong to.
+	Project string
+	// ChunkID is the identity of the (re-)clustered chunk.
+	ChunkID string
+	// CommitTime is the commit time of the (re-)clustering.
+	CommitTime time.Time
+	// Sequence is the sequence number of the entry amongst those
+	// written for the chunk in the same transaction.
+	Sequence int64
+}
+
+// Entry is an entry in the outbox of rows to be exported to the
+// clustered failures BigQuery table.
+type Entry struct {
+	EntryKey
+	// Rows are the rows to export. LastUpdated is not set on the rows;
+	// they are exported with the CommitTime of the entry instead.
+	Rows []*bqpb.ClusteredFailureRow
+	// Attempts is the number of failed attempts to export the rows.
+	Attempts int64
+	// NextAttemptTime is the earliest time the export may be attempted
+	// again. Zero if no attempt has failed.
+	NextAttemptTime time.Time
+	// LastError is the error of the last failed attempt.
+	LastError string
+	// Quarantined is whether the entry failed to export too many times,
+	// and will not be exported again.
+	Quarantined bool
+}
+
+// Create buffers the creation of outbox entries holding the given rows,
+// in the current Spanner read/write transaction. The rows are exported
+// with the commit time of the transaction as their last updated time.
+// At most one call to Create may be made for each chunk in a
+// transaction.
+func Create(ctx context.Context, project, chunkID string, rows []*bqpb.ClusteredFailureRow) error {
+	for i := 0; i*maxRowsPerEntry < len(rows); i++ {
+		end := (i + 1) * maxRowsPerEntry
+		if end > len(rows) {
+			end = len(rows)
+		}
+		encoded := make([][]byte, 0, end-i*maxRowsPerEntry)
+		for _, r := range rows[i*maxRowsPerEntry : end] {
+			b, err := proto.Marshal(r)
+			if err != nil {
+				return errors.Annotate(err, "marshal row").Err()
+			}
+			encoded = append(encoded, b)
+		}
+		span.BufferWrite(ctx, spanutil.InsertMap("ClusteredFailureExports", map[string]interface{}{
+			"Project":    project,
+			"ChunkId":    chunkID,
+			"CommitTime": spanner.CommitTimestamp,
+			"Sequence":   int64(i),
+			"Rows":       encoded,
+			"Attempts":   int64(0),
+		}))
+	}
+	return nil
+}
+
+// ReadChunks reads the outbox entries of the given chunks of a LUCI
+// project which are not quarantined, ordered by chunk, commit time and
+// sequence number.
+func ReadChunks(ctx context.Context, project string, chunkIDs []string) ([]*Entry, error) {
+	whereClause := `Project = @project AND ChunkId IN UNNEST(@chunkIDs) AND Quarantined IS NULL`
+	params := map[string]interface{}{
+		"project":  project,
+		"chunkIDs": chunkIDs,
+	}
+	return readWhere(ctx, whereClause, params)
+}
+
+// ReadDueChunks reads up to limit chunks which have outbox entries due
+// for export. A chunk is due if none of its entries which are not
+// quarantined are waiting to be retried after a failed export. Chunks
+// are returned in order of their oldest entry.
+func ReadDueChunks(ctx context.Context, now time.Time, limit int) ([]EntryKey, error) {
+	stmt := spanner.NewStatement(`
+		SELECT Project, ChunkId
+		FROM ClusteredFailureExports
+		WHERE Quarantined IS NULL
+		GROUP BY Project, ChunkId
+		HAVING IFNULL(MAX(NextAttemptTime), @now) <= @now
+		ORDER BY MIN(CommitTime)
+		LIMIT @limit
+	`)
+	stmt.Params["now"] = now
+	stmt.Params["limit"] = limit
+
+	var results []EntryKey
+	err := span.Query(ctx, stmt).Do(func(r *spanner.Row) error {
+		var key EntryKey
+		if err := r.Columns(&key.Project, &key.ChunkID); err != nil {
+			return errors.Annotate(err, "read chunk row").Err()
+		}
+		results = append(results, key)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+func readWhere(ctx context.Context, whereClause string, params map[string]interface{}) ([]*Entry, error) {
+	stmt := spanner.NewStatement(`
+		SELECT
+		  Project, ChunkId, CommitTime, Sequence, Rows,
+		  Attempts, NextAttemptTime, LastError, Quarantined
+		FROM ClusteredFailureExports
+		WHERE ` + whereClause + `
+		ORDER BY Project, ChunkId, CommitTime, Sequence
+	`)
+	for k, v := range params {
+		stmt.Params[k] = v
+	}
+
+	var results []*Entry
+	err := span.Query(ctx, stmt).Do(func(r *spanner.Row) error {
+		e := &Entry{}
+		var rows [][]byte
+		var nextAttemptTime spanner.NullTime
+		var lastError spanner.NullString
+		var quarantined spanner.NullBool
+		err := r.Columns(
+			&e.Project, &e.ChunkID, &e.CommitTime, &e.Sequence, &rows,
+			&e.Attempts, &nextAttemptTime, &lastError, &quarantined)
+		if err != nil {
+			return errors.Annotate(err, "read outbox row").Err()
+		}
+		e.Rows = make([]*bqpb.ClusteredFailureRow, 0, len(rows))
+		for _, b := range rows {
+			row := &bqpb.ClusteredFailureRow{}
+			if err := proto.Unmarshal(b, row); err != nil {
+				return errors.Annotate(err, "unmarshal row").Err()
+			}
+			e.Rows = append(e.Rows, row)
+		}
+		e.NextAttemptTime = nextAttemptTime.Time
+		e.LastError = lastError.StringVal
+		e.Quarantined = quarantined.Valid && quarantined.Bool
+		results = append(results, e)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+// Delete deletes the given outbox entry. Deleting an entry which does not
+// exist is not an error.
+func Delete(ctx context.Context, key EntryKey) error {
+	m := spanner.Delete("ClusteredFailureExports", key.spannerKey())
+	if _, err := span.Apply(ctx, []*spanner.Mutation{m}); err != nil {
+		return errors.Annotate(err, "delete outbox entry").Err()
+	}
+	return nil
+}
+
+// recordFailure records a failed attempt to export the given entry,
+// whose fields have been updated to reflect the attempt.
+func recordFailure(ctx context.Context, e *Entry) error {
+	m := spanutil.UpdateMap("ClusteredFailureExports", map[string]interface{}{
+		"Project":         e.Project,
+		"ChunkId":         e.ChunkID,
+		"CommitTime":      e.CommitTime,
+		"Sequence":        e.Sequence,
+		"Attempts":        e.Attempts,
+		"NextAttemptTime": spanner.NullTime{Time: e.NextAttemptTime, Valid: !e.NextAttemptTime.IsZero()},
+		"LastError":       e.LastError,
+		// Quarantined uses the value 'NULL' to indicate false, and true to
+		// indicate true.
+		"Quarantined": spanner.NullBool{Bool: e.Quarantined, Valid: e.Quarantined},
+	})
+	_, err := span.Apply(ctx, []*spanner.Mutation{m})
+	if spanner.ErrCode(err) == codes.NotFound {
+		// The entry was exported and deleted concurrently.
+		return nil
+	}
+	if err != nil {
+		return errors.Annotate(err, "record failed export").Err()
+	}
+	return nil
+}
+
+func (k EntryKey) spannerKey() spanner.Key {
+	return spanner.Key{k.Project, k.ChunkID, k.CommitTime, k.Sequence}
+}
+
+// ProjectStats are statistics about the outbox entries of a LUCI project.
+type ProjectStats struct {
+	// Project is the LUCI Project.
+	Project string
+	// Pending is the number of entries waiting to be exported.
+	Pending int64
+	// Quarantined is the number of quarantined entries.
+	Quarantined int64
+	// OldestPendingCommitTime is the commit time of the oldest entry
+	// waiting to be exported. Zero if there are no such entries.
+	OldestPendingCommitTime time.Time
+}
+
+// ReadStats reads statistics about the outbox entries of each LUCI
+// project with entries, ordered by project.
+func ReadStats(ctx context.Context) ([]*ProjectStats, error) {
+	stmt := spanner.NewStatement(`
+		SELECT
+		  Project,
+		  COUNTIF(Quarantined IS NULL),
+		  COUNTIF(Quarantined IS NOT NULL),
+		  MIN(IF(Quarantined IS NULL, CommitTime, NULL))
+		FROM ClusteredFailureExports
+		GROUP BY Project
+		ORDER BY Project
+	`)
+	var results []*ProjectStats
+	err := span.Query(ctx, stmt).Do(func(r *spanner.Row) error {
+		s := &ProjectStats{}
+		var oldest spanner.NullTime
+		if err := r.Columns(&s.Project, &s.Pending, &s.Quarantined, &oldest); err != nil {
+			return errors.Annotate(err, "read outbox stats row").Err()
+		}
+		s.OldestPendingCommitTime = oldest.Time
+		results = append(results, s)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+// ReadAllForTesting reads all outbox entries, including quarantined
+// entries, for testing.
+func ReadAllForTesting(ctx context.Context) ([]*Entry, error) {
+	return readWhere(span.Single(ctx), "TRUE", nil)
+}
@@ -35,9 +35,14 @@ CREATE TEMP FUNCTION sub_cluster(VALUES ARRAY<STRING>) AS (
 	  r.is_included,
 	  r.is_included_with_high_priority,
 	  r.is_exonerated,
+	  -- Failures ingested before exoneration reasons were recorded have
+	  -- no reason.
+	  IF(r.is_exonerated, IFNULL(r.exoneration_reason, 'EXONERATION_REASON_UNSPECIFIED'), NULL) AS exoneration_reason,
+	  IFNULL(r.is_duplicate, FALSE) AS is_duplicate,
 	  r.test_id,
 	  r.failure_reason,
 	  r.test_run_id,
+	  IFNULL(r.failure_kind, 'TEST_FAILURE') = 'BUILD_STEP_FAILURE' AS is_build_step_failure,
 	  r.partition_time >= TIMESTAMP_SUB(CURRENT_TIMESTAMP(), INTERVAL 1 DAY) as is_1d,
 	  r.partition_time >= TIMESTAMP_SUB(CURRENT_TIMESTAMP(), INTERVAL 3 DAY) as is_3d,
 	  r.partition_time >= TIMESTAMP_SUB(CURRENT_TIMESTAMP(), INTERVAL 7 DAY) as is_7d,
@@ -96,9 +101,14 @@ CREATE TEMP FUNCTION sub_cluster(VALUES ARRAY<STRING>) AS (
 	  COUNTIF(is_7d AND is_included_with_high_priority AND NOT is_exonerated) as failures_residual_7d,
 	  COUNTIF(is_7d AND is_included_with_high_priority) as failures_residual_pre_exon_7d,
 	  sub_cluster(ARRAY_AGG(IF(is_7d, test_id, NULL) IGNORE NULLS)) AS affected_tests_7d,
+	  sub_cluster(ARRAY_AGG(IF(is_7d, exoneration_reason, NULL) IGNORE NULLS)) AS exoneration_reasons_7d,
 
 	  ANY_VALUE(failure_reason) as example_failure_reason,
 	  MIN(test_id) as example_test_id,
+	  -- Clusters of build step failures only are build step failure
+	  -- clusters. Suggested clusters never mix failure kinds, but rule
+	  -- clusters may.
+	  IF(LOGICAL_AND(is_build_step_failure), 'BUILD_STEP_FAILURE', 'TEST_FAILURE') as failure_kind,
   FROM clustered_failures_extended
-  WHERE is_included
+  WHERE is_included AND NOT is_duplicate
   GROUP BY cluster_algorithm, cluster_id`
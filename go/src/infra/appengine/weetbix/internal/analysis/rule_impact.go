@@ -0,0 +1,86 @@
+// Copyright 2021 The Chromium Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package analysis
+
+import (
+	"context"
+
+	"google.golang.org/api/iterator"
+
+	"go.chromium.org/luci/common/errors"
+
+	"infra/appengine/weetbix/internal/bqutil"
+	"infra/appengine/weetbix/internal/clustering/rules/lang"
+)
+
+// FailureCounts captures the number of failures matched by a failure
+// association rule.
+type FailureCounts struct {
+	// The number of failures, excluding exonerated failures.
+	Nominal int64 `json:"nominal"`
+	// The number of failures, including exonerated failures.
+	PreExoneration int64 `json:"preExoneration"`
+}
+
+// ReadRuleFailures7d counts the failures in the last 7 days that would
+// be matched by the given failure association rule, without the rule
+// having to exist.
+//
+// The rule is validated before use. As the rule language is a subset of
+// BigQuery Standard SQL with the same semantics, the rule is then evaluated
+// by BigQuery directly.
+func (c *Client) ReadRuleFailures7d(ctx context.Context, luciProject, rule string) (*FailureCounts, error) {
+	// Only allow the variables defined by the query below.
+	if _, err := lang.Parse(rule, "test", "reason"); err != nil {
+		return nil, errors.Annotate(err, "parsing rule").Err()
+	}
+	dataset, err := bqutil.DatasetForProject(luciProject)
+	if err != nil {
+		return nil, errors.Annotate(err, "getting dataset").Err()
+	}
+
+	// Each failure appears once for each cluster it is in. Every failure
+	// remains in its test name cluster, so de-duplicating the rows of
+	// included failures yields each failure exactly once.
+	q := c.client.Query(`
+		WITH failures AS (
+			SELECT
+				ARRAY_AGG(cf ORDER BY last_updated DESC LIMIT 1)[OFFSET(0)] as r
+			FROM ` + dataset + `.clustered_failures cf
+			WHERE partition_time >= TIMESTAMP_SUB(CURRENT_TIMESTAMP(), INTERVAL 7 DAY)
+			  AND is_included AND NOT IFNULL(is_duplicate, FALSE)
+			GROUP BY test_result_system, test_result_id
+		),
+		failures_with_rule_vars AS (
+			SELECT
+				r.is_exonerated,
+				r.test_id AS test,
+				IFNULL(r.failure_reason.primary_error_message, "") AS reason
+			FROM failures
+		)
+		SELECT
+			COUNTIF(NOT is_exonerated) AS Nominal,
+			COUNT(*) AS PreExoneration
+		FROM failures_with_rule_vars
+		WHERE ` + rule + `
+	`)
+	job, err := q.Run(ctx)
+	if err != nil {
+		return nil, errors.Annotate(err, "querying rule failures").Err()
+	}
+	it, err := job.Read(ctx)
+	if err != nil {
+		return nil, errors.Annotate(err, "obtain result iterator").Err()
+	}
+	counts := &FailureCounts{}
+	err = it.Next(counts)
+	if err == iterator.Done {
+		return nil, errors.New("no result row")
+	}
+	if err != nil {
+		return nil, errors.Annotate(err, "obtain rule failures row").Err()
+	}
+	return counts, nil
+}
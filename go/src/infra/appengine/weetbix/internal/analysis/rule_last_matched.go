@@ -0,0 +1,91 @@
+// Copyright 2021 The Chromium Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package analysis
+
+import (
+	"context"
+	"time"
+
+	"cloud.google.com/go/bigquery"
+	"google.golang.org/api/iterator"
+
+	"go.chromium.org/luci/common/errors"
+
+	"infra/appengine/weetbix/internal/bqutil"
+	"infra/appengine/weetbix/internal/clustering"
+)
+
+// ruleLastMatchedRow is a row read by the rule last matched query.
+type ruleLastMatchedRow struct {
+	RuleID      string
+	LastMatched time.Time
+}
+
+// ruleLastMatchedQuery returns the query for the partition time of the
+// most recent failure matched by each failure association rule, amongst
+// the failures with a partition time of at least since.
+//
+// The query filters clustered_failures on partition_time, so that only
+// the partitions since the given time are scanned. A failure is matched
+// by a rule if it is included in the rule's cluster, as of the latest
+// clustering of the failure. All versions of the rules-based clustering
+// algorithm are considered.
+func ruleLastMatchedQuery(dataset string, since time.Time) *query {
+	sql := `
+		WITH rule_failures_latest AS (
+			SELECT
+				cluster_id,
+				partition_time,
+				ARRAY_AGG(is_included ORDER BY last_updated DESC LIMIT 1)[OFFSET(0)] AS is_included
+			FROM ` + dataset + `.clustered_failures
+			WHERE partition_time >= @since
+			  AND STARTS_WITH(cluster_algorithm, @rulesAlgorithmPrefix)
+			GROUP BY cluster_algorithm, cluster_id, test_result_system, test_result_id, partition_time
+		)
+		SELECT
+			cluster_id AS RuleID,
+			MAX(partition_time) AS LastMatched
+		FROM rule_failures_latest
+		WHERE is_included
+		GROUP BY cluster_id
+	`
+	return &query{
+		SQL: sql,
+		Parameters: []bigquery.QueryParameter{
+			{Name: "since", Value: since},
+			{Name: "rulesAlgorithmPrefix", Value: clustering.RulesAlgorithmPrefix},
+		},
+	}
+}
+
+// ReadRulesLastMatched reads the partition time of the most recent failure
+// matched by each failure association rule in the given LUCI project.
+// Only failures with a partition time of at least since are considered;
+// rules which have not matched any of these failures are omitted from
+// the result. The result is keyed by rule ID.
+func (c *Client) ReadRulesLastMatched(ctx context.Context, luciProject string, since time.Time) (map[string]time.Time, error) {
+	dataset, err := bqutil.DatasetForProject(luciProject)
+	if err != nil {
+		return nil, errors.Annotate(err, "getting dataset").Err()
+	}
+
+	it, err := c.runQuery(ctx, ruleLastMatchedQuery(dataset, since))
+	if err != nil {
+		return nil, errors.Annotate(err, "querying rules last matched").Err()
+	}
+	result := make(map[string]time.Time)
+	for {
+		row := &ruleLastMatchedRow{}
+		err := it.Next(row)
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, errors.Annotate(err, "obtain next rule last matched row").Err()
+		}
+		result[row.RuleID] = row.LastMatched
+	}
+	return result, nil
+}
@@ -0,0 +1,107 @@
+// Copyright 2021 The Chromium Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package analysis
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"google.golang.org/api/iterator"
+
+	. "github.com/smartystreets/goconvey/convey"
+	. "go.chromium.org/luci/common/testing/assertions"
+)
+
+// fakeLastMatchedIterator iterates over rows of the rule last matched
+// query.
+type fakeLastMatchedIterator struct {
+	rows []*ruleLastMatchedRow
+}
+
+func (it *fakeLastMatchedIterator) Next(dst interface{}) error {
+	if len(it.rows) == 0 {
+		return iterator.Done
+	}
+	*dst.(*ruleLastMatchedRow) = *it.rows[0]
+	it.rows = it.rows[1:]
+	return nil
+}
+
+func TestReadRulesLastMatched(t *testing.T) {
+	Convey(`ReadRulesLastMatched`, t, func() {
+		ctx := context.Background()
+
+		var queries []*query
+		var rows []*ruleLastMatchedRow
+		var queryErr error
+		c := &Client{
+			runQuery: func(ctx context.Context, q *query) (rowIterator, error) {
+				queries = append(queries, q)
+				if queryErr != nil {
+					return nil, queryErr
+				}
+				return &fakeLastMatchedIterator{rows: rows}, nil
+			},
+		}
+
+		since := time.Date(2021, time.December, 1, 12, 0, 0, 0, time.UTC)
+
+		Convey(`Query`, func() {
+			_, err := c.ReadRulesLastMatched(ctx, "testproject", since)
+			So(err, ShouldBeNil)
+			So(queries, ShouldHaveLength, 1)
+			q := queries[0]
+
+			Convey(`Reads only partitions since the given time`, func() {
+				So(q.SQL, ShouldContainSubstring, `FROM testproject.clustered_failures`)
+				So(q.SQL, ShouldContainSubstring, `WHERE partition_time >= @since`)
+				So(q.Parameters[0].Name, ShouldEqual, "since")
+				So(q.Parameters[0].Value, ShouldResemble, since)
+			})
+			Convey(`Reads all versions of the rules algorithm`, func() {
+				So(q.SQL, ShouldContainSubstring, `STARTS_WITH(cluster_algorithm, @rulesAlgorithmPrefix)`)
+				So(q.Parameters[1].Name, ShouldEqual, "rulesAlgorithmPrefix")
+				So(q.Parameters[1].Value, ShouldEqual, "rules-")
+			})
+			Convey(`Considers only failures still in the rule cluster`, func() {
+				So(q.SQL, ShouldContainSubstring, `ARRAY_AGG(is_included ORDER BY last_updated DESC LIMIT 1)[OFFSET(0)] AS is_included`)
+				So(q.SQL, ShouldContainSubstring, `WHERE is_included`)
+			})
+			Convey(`Reads the most recent match of each rule`, func() {
+				So(q.SQL, ShouldContainSubstring, `MAX(partition_time) AS LastMatched`)
+				So(q.SQL, ShouldContainSubstring, `GROUP BY cluster_id`)
+			})
+		})
+		Convey(`Invalid project`, func() {
+			_, err := c.ReadRulesLastMatched(ctx, "!invalid", since)
+			So(err, ShouldErrLike, `getting dataset`)
+			So(queries, ShouldBeEmpty)
+		})
+		Convey(`Query error`, func() {
+			queryErr = errors.New("quota exceeded")
+			_, err := c.ReadRulesLastMatched(ctx, "testproject", since)
+			So(err, ShouldErrLike, `querying rules last matched: quota exceeded`)
+		})
+		Convey(`Response assembly`, func() {
+			rows = []*ruleLastMatchedRow{
+				{RuleID: "aa", LastMatched: since.Add(time.Hour)},
+				{RuleID: "bb", LastMatched: since.Add(48 * time.Hour)},
+			}
+			lastMatched, err := c.ReadRulesLastMatched(ctx, "testproject", since)
+			So(err, ShouldBeNil)
+			So(lastMatched, ShouldResemble, map[string]time.Time{
+				"aa": since.Add(time.Hour),
+				"bb": since.Add(48 * time.Hour),
+			})
+		})
+		Convey(`No matches`, func() {
+			lastMatched, err := c.ReadRulesLastMatched(ctx, "testproject", since)
+			So(err, ShouldBeNil)
+			So(lastMatched, ShouldBeEmpty)
+		})
+	})
+}
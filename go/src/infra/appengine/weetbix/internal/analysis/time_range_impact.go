@@ -0,0 +1,312 @@
+// Copyright 2021 The Chromium Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package analysis
+
+import (
+	"context"
+	"time"
+
+	"cloud.google.com/go/bigquery"
+	"cloud.google.com/go/civil"
+	"google.golang.org/api/iterator"
+
+	"go.chromium.org/luci/common/errors"
+
+	"infra/appengine/weetbix/internal/bqutil"
+	"infra/appengine/weetbix/internal/clustering"
+)
+
+// DefaultMaxTimeRangeDays is the maximum number of days in the time range
+// cluster impact may be read over, if the service configuration does not
+// specify one.
+const DefaultMaxTimeRangeDays = 30
+
+// maxTimeRangeImpactBytesBilled is the maximum number of bytes a query
+// for the impact of clusters over a time range may bill. The query fails
+// if it would scan more. As the query only scans the partitions of
+// clustered_failures in the time range, this should only be reached if
+// the time range is very long.
+const maxTimeRangeImpactBytesBilled = 100 * 1000 * 1000 * 1000 // 100 GB
+
+// TimeRange is a range of time, from Start (inclusive) to End (exclusive).
+type TimeRange struct {
+	Start time.Time
+	End   time.Time
+}
+
+// Validate validates the time range is well-formed, and spans no more
+// than the given number of days.
+func (tr TimeRange) Validate(maxDays int64) error {
+	if tr.Start.IsZero() {
+		return errors.New("start time must be specified")
+	}
+	if tr.End.IsZero() {
+		return errors.New("end time must be specified")
+	}
+	if !tr.End.After(tr.Start) {
+		return errors.New("end time must be after start time")
+	}
+	if tr.End.Sub(tr.Start) > time.Duration(maxDays)*24*time.Hour {
+		return errors.Reason("time range must not exceed %v days", maxDays).Err()
+	}
+	return nil
+}
+
+// days returns the UTC dates overlapped by the time range, in order.
+func (tr TimeRange) days() []civil.Date {
+	var days []civil.Date
+	last := civil.DateOf(tr.End.Add(-time.Nanosecond).UTC())
+	for d := civil.DateOf(tr.Start.UTC()); !d.After(last); d = d.AddDays(1) {
+		days = append(days, d)
+	}
+	return days
+}
+
+// TimeRangeImpact is the impact of a cluster over a time range.
+type TimeRangeImpact struct {
+	StartTime        time.Time `json:"startTime"`
+	EndTime          time.Time `json:"endTime"`
+	PresubmitRejects Counts    `json:"presubmitRejects"`
+	TestRunFails     Counts    `json:"testRunFailures"`
+	Failures         Counts    `json:"failures"`
+	// Daily is the impact of the cluster on each UTC day overlapped by
+	// the time range, in order of date. Days without failures in the
+	// cluster are included, with zero impact.
+	//
+	// As a presubmit run or test run may fail on more than one day,
+	// the daily presubmit rejects and test run failures may not sum to
+	// the totals above.
+	Daily []*DailyImpact `json:"daily"`
+}
+
+// DailyImpact is the impact of a cluster on one UTC day.
+type DailyImpact struct {
+	Date             civil.Date `json:"date"`
+	PresubmitRejects Counts     `json:"presubmitRejects"`
+	TestRunFails     Counts     `json:"testRunFailures"`
+	Failures         Counts     `json:"failures"`
+}
+
+// timeRangeImpactRow is a row read by the time range impact query.
+type timeRangeImpactRow struct {
+	ClusterID        clustering.ClusterID
+	PresubmitRejects Counts
+	TestRunFails     Counts
+	Failures         Counts
+	Daily            []DailyImpact
+}
+
+// query is a parameterised BigQuery query.
+type query struct {
+	SQL        string
+	Parameters []bigquery.QueryParameter
+	// MaxBytesBilled is the maximum number of bytes the query may bill.
+	// If zero, the project default applies.
+	MaxBytesBilled int64
+}
+
+// rowIterator iterates over the rows of a query result.
+type rowIterator interface {
+	Next(dst interface{}) error
+}
+
+// readQuery runs a query on BigQuery and returns an iterator over its
+// results.
+func (c *Client) readQuery(ctx context.Context, q *query) (rowIterator, error) {
+	bq := c.client.Query(q.SQL)
+	bq.Parameters = q.Parameters
+	bq.MaxBytesBilled = q.MaxBytesBilled
+	job, err := bq.Run(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return job.Read(ctx)
+}
+
+// timeRangeImpactQuery returns the query for the impact of the given
+// clusters over a time range, in total and by day.
+//
+// The query filters clustered_failures on partition_time, so that only
+// the partitions overlapping the time range are scanned.
+func timeRangeImpactQuery(dataset string, tr TimeRange, clusterIDs []clustering.ClusterID) *query {
+	sql := `
+		WITH clustered_failures_latest AS (
+			SELECT
+				cluster_algorithm,
+				cluster_id,
+				ARRAY_AGG(cf ORDER BY last_updated DESC LIMIT 1)[OFFSET(0)] as r
+			FROM ` + dataset + `.clustered_failures cf
+			WHERE partition_time >= @startTime AND partition_time < @endTime
+			  AND STRUCT(cluster_algorithm AS Algorithm, cluster_id AS ID) IN UNNEST(@clusterIDs)
+			GROUP BY cluster_algorithm, cluster_id, test_result_system, test_result_id
+		),
+		clustered_failures_extended AS (
+			SELECT
+				cluster_algorithm,
+				cluster_id,
+				DATE(r.partition_time) as date,
+				r.is_included_with_high_priority,
+				r.is_exonerated,
+				r.test_run_id,
+				CONCAT(r.presubmit_run_id.system, ":", r.presubmit_run_id.id) AS presubmit_run_uniqifier,
+				(r.presubmit_run_id IS NOT NULL AND r.is_ingested_invocation_blocked AND
+				 r.ingested_invocation_result_index + 1 = r.ingested_invocation_result_count) as is_presubmit_reject,
+				(r.test_run_result_index + 1 = r.test_run_result_count) AND r.is_test_run_blocked as is_test_run_fail,
+			FROM clustered_failures_latest
+			WHERE r.is_included AND NOT IFNULL(r.is_duplicate, FALSE)
+		),
+		totals AS (
+			SELECT
+				cluster_algorithm,
+				cluster_id,` +
+		selectImpactCounts() + `
+			FROM clustered_failures_extended
+			GROUP BY cluster_algorithm, cluster_id
+		),
+		daily AS (
+			SELECT
+				cluster_algorithm,
+				cluster_id,
+				date,` +
+		selectImpactCounts() + `
+			FROM clustered_failures_extended
+			GROUP BY cluster_algorithm, cluster_id, date
+		)
+		SELECT
+			STRUCT(t.cluster_algorithm AS Algorithm, t.cluster_id AS ID) as ClusterID,
+			t.PresubmitRejects,
+			t.TestRunFails,
+			t.Failures,
+			ARRAY(
+				SELECT AS STRUCT
+					d.date AS Date,
+					d.PresubmitRejects,
+					d.TestRunFails,
+					d.Failures
+				FROM daily d
+				WHERE d.cluster_algorithm = t.cluster_algorithm
+				  AND d.cluster_id = t.cluster_id
+				ORDER BY d.date
+			) AS Daily
+		FROM totals t
+	`
+	return &query{
+		SQL: sql,
+		Parameters: []bigquery.QueryParameter{
+			{Name: "startTime", Value: tr.Start},
+			{Name: "endTime", Value: tr.End},
+			{Name: "clusterIDs", Value: clusterIDs},
+		},
+		MaxBytesBilled: maxTimeRangeImpactBytesBilled,
+	}
+}
+
+// selectImpactCounts generates SQL to select the presubmit rejects,
+// test run failures and failures of the rows of
+// clustered_failures_extended being aggregated.
+func selectImpactCounts() string {
+	return `
+				STRUCT(
+					COUNT(DISTINCT IF(is_presubmit_reject AND NOT is_exonerated, presubmit_run_uniqifier, NULL)) AS Nominal,
+					COUNT(DISTINCT IF(is_presubmit_reject, presubmit_run_uniqifier, NULL)) AS PreExoneration,
+					COUNT(DISTINCT IF(is_presubmit_reject AND is_included_with_high_priority AND NOT is_exonerated, presubmit_run_uniqifier, NULL)) AS Residual,
+					COUNT(DISTINCT IF(is_presubmit_reject AND is_included_with_high_priority, presubmit_run_uniqifier, NULL)) AS ResidualPreExoneration
+				) AS PresubmitRejects,
+				STRUCT(
+					COUNT(DISTINCT IF(is_test_run_fail AND NOT is_exonerated, test_run_id, NULL)) AS Nominal,
+					COUNT(DISTINCT IF(is_test_run_fail, test_run_id, NULL)) AS PreExoneration,
+					COUNT(DISTINCT IF(is_test_run_fail AND is_included_with_high_priority AND NOT is_exonerated, test_run_id, NULL)) AS Residual,
+					COUNT(DISTINCT IF(is_test_run_fail AND is_included_with_high_priority, test_run_id, NULL)) AS ResidualPreExoneration
+				) AS TestRunFails,
+				STRUCT(
+					COUNTIF(NOT is_exonerated) AS Nominal,
+					COUNT(*) AS PreExoneration,
+					COUNTIF(is_included_with_high_priority AND NOT is_exonerated) AS Residual,
+					COUNTIF(is_included_with_high_priority) AS ResidualPreExoneration
+				) AS Failures`
+}
+
+// ReadTimeRangeImpact reads the impact of the given clusters over a time
+// range, in total and by day. The result is keyed by ClusterID.Key(), and
+// has an entry for each of the given clusters, even those without failures
+// in the time range.
+//
+// The time range should be validated by the caller.
+func (c *Client) ReadTimeRangeImpact(ctx context.Context, luciProject string, tr TimeRange, clusterIDs []clustering.ClusterID) (map[string]*TimeRangeImpact, error) {
+	result := make(map[string]*TimeRangeImpact)
+	if len(clusterIDs) == 0 {
+		return result, nil
+	}
+	dataset, err := bqutil.DatasetForProject(luciProject)
+	if err != nil {
+		return nil, errors.Annotate(err, "getting dataset").Err()
+	}
+
+	it, err := c.runQuery(ctx, timeRangeImpactQuery(dataset, tr, clusterIDs))
+	if err != nil {
+		return nil, errors.Annotate(err, "querying time range impact").Err()
+	}
+	rows := make(map[string]*timeRangeImpactRow)
+	for {
+		row := &timeRangeImpactRow{}
+		err := it.Next(row)
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, errors.Annotate(err, "obtain next time range impact row").Err()
+		}
+		rows[row.ClusterID.Key()] = row
+	}
+
+	for _, clusterID := range clusterIDs {
+		result[clusterID.Key()] = newTimeRangeImpact(tr, rows[clusterID.Key()])
+	}
+	return result, nil
+}
+
+// newTimeRangeImpact assembles the impact of a cluster over a time range
+// from its query result row. row may be nil, if the cluster had no
+// failures in the time range.
+func newTimeRangeImpact(tr TimeRange, row *timeRangeImpactRow) *TimeRangeImpact {
+	impact := &TimeRangeImpact{
+		StartTime: tr.Start,
+		EndTime:   tr.End,
+	}
+	daily := make(map[civil.Date]DailyImpact)
+	if row != nil {
+		impact.PresubmitRejects = row.PresubmitRejects
+		impact.TestRunFails = row.TestRunFails
+		impact.Failures = row.Failures
+		for _, d := range row.Daily {
+			daily[d.Date] = d
+		}
+	}
+	for _, date := range tr.days() {
+		d, ok := daily[date]
+		if !ok {
+			d = DailyImpact{Date: date}
+		}
+		impact.Daily = append(impact.Daily, &d)
+	}
+	return impact
+}
+
+// PopulateTimeRangeImpact sets the impact of each of the given clusters
+// over the time range.
+func (c *Client) PopulateTimeRangeImpact(ctx context.Context, luciProject string, tr TimeRange, clusters []*ClusterSummary) error {
+	clusterIDs := make([]clustering.ClusterID, 0, len(clusters))
+	for _, cs := range clusters {
+		clusterIDs = append(clusterIDs, cs.ClusterID)
+	}
+	impact, err := c.ReadTimeRangeImpact(ctx, luciProject, tr, clusterIDs)
+	if err != nil {
+		return err
+	}
+	for _, cs := range clusters {
+		cs.TimeRangeImpact = impact[cs.ClusterID.Key()]
+	}
+	return nil
+}
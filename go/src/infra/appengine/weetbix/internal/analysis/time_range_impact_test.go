@@ -0,0 +1,228 @@
+// Copyright 2021 The Chromium Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package analysis
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"cloud.google.com/go/civil"
+	"google.golang.org/api/iterator"
+
+	"infra/appengine/weetbix/internal/clustering"
+
+	. "github.com/smartystreets/goconvey/convey"
+	. "go.chromium.org/luci/common/testing/assertions"
+)
+
+// fakeRowIterator iterates over rows of the time range impact query.
+type fakeRowIterator struct {
+	rows []*timeRangeImpactRow
+}
+
+func (it *fakeRowIterator) Next(dst interface{}) error {
+	if len(it.rows) == 0 {
+		return iterator.Done
+	}
+	*dst.(*timeRangeImpactRow) = *it.rows[0]
+	it.rows = it.rows[1:]
+	return nil
+}
+
+// fakeQueryRunner records the queries run, and returns the configured rows.
+type fakeQueryRunner struct {
+	queries []*query
+	rows    []*timeRangeImpactRow
+	err     error
+}
+
+func (f *fakeQueryRunner) runQuery(ctx context.Context, q *query) (rowIterator, error) {
+	f.queries = append(f.queries, q)
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &fakeRowIterator{rows: f.rows}, nil
+}
+
+func TestTimeRange(t *testing.T) {
+	Convey(`Validate`, t, func() {
+		start := time.Date(2021, time.December, 1, 0, 0, 0, 0, time.UTC)
+		tr := TimeRange{Start: start, End: start.Add(7 * 24 * time.Hour)}
+
+		Convey(`Valid`, func() {
+			So(tr.Validate(7), ShouldBeNil)
+		})
+		Convey(`Start unset`, func() {
+			tr.Start = time.Time{}
+			So(tr.Validate(7), ShouldErrLike, `start time must be specified`)
+		})
+		Convey(`End unset`, func() {
+			tr.End = time.Time{}
+			So(tr.Validate(7), ShouldErrLike, `end time must be specified`)
+		})
+		Convey(`End before start`, func() {
+			tr.End = start.Add(-time.Hour)
+			So(tr.Validate(7), ShouldErrLike, `end time must be after start time`)
+		})
+		Convey(`Empty`, func() {
+			tr.End = start
+			So(tr.Validate(7), ShouldErrLike, `end time must be after start time`)
+		})
+		Convey(`Too long`, func() {
+			tr.End = tr.End.Add(time.Second)
+			So(tr.Validate(7), ShouldErrLike, `time range must not exceed 7 days`)
+		})
+	})
+	Convey(`days`, t, func() {
+		Convey(`Whole days`, func() {
+			tr := TimeRange{
+				Start: time.Date(2021, time.December, 1, 0, 0, 0, 0, time.UTC),
+				End:   time.Date(2021, time.December, 3, 0, 0, 0, 0, time.UTC),
+			}
+			So(tr.days(), ShouldResemble, []civil.Date{
+				{Year: 2021, Month: time.December, Day: 1},
+				{Year: 2021, Month: time.December, Day: 2},
+			})
+		})
+		Convey(`Partial days`, func() {
+			tr := TimeRange{
+				Start: time.Date(2021, time.December, 31, 23, 0, 0, 0, time.UTC),
+				End:   time.Date(2022, time.January, 1, 1, 0, 0, 0, time.UTC),
+			}
+			So(tr.days(), ShouldResemble, []civil.Date{
+				{Year: 2021, Month: time.December, Day: 31},
+				{Year: 2022, Month: time.January, Day: 1},
+			})
+		})
+		Convey(`Non-UTC time zone`, func() {
+			loc := time.FixedZone("UTC-8", -8*60*60)
+			tr := TimeRange{
+				Start: time.Date(2021, time.December, 1, 20, 0, 0, 0, loc),
+				End:   time.Date(2021, time.December, 1, 22, 0, 0, 0, loc),
+			}
+			So(tr.days(), ShouldResemble, []civil.Date{
+				{Year: 2021, Month: time.December, Day: 2},
+			})
+		})
+	})
+}
+
+func TestReadTimeRangeImpact(t *testing.T) {
+	Convey(`ReadTimeRangeImpact`, t, func() {
+		ctx := context.Background()
+		fake := &fakeQueryRunner{}
+		c := &Client{runQuery: fake.runQuery}
+
+		start := time.Date(2021, time.December, 1, 12, 0, 0, 0, time.UTC)
+		tr := TimeRange{Start: start, End: start.Add(3 * 24 * time.Hour)}
+		clusterA := clustering.ClusterID{Algorithm: "rules-v1", ID: "aa"}
+		clusterB := clustering.ClusterID{Algorithm: "testname-v1", ID: "bb"}
+		clusterIDs := []clustering.ClusterID{clusterA, clusterB}
+
+		day := func(d int) civil.Date {
+			return civil.Date{Year: 2021, Month: time.December, Day: d}
+		}
+		counts := func(n int64) Counts {
+			return Counts{Nominal: n, PreExoneration: n + 1, Residual: n, ResidualPreExoneration: n + 1}
+		}
+
+		Convey(`Query`, func() {
+			_, err := c.ReadTimeRangeImpact(ctx, "testproject", tr, clusterIDs)
+			So(err, ShouldBeNil)
+			So(fake.queries, ShouldHaveLength, 1)
+			q := fake.queries[0]
+
+			Convey(`Reads only partitions in the time range`, func() {
+				So(q.SQL, ShouldContainSubstring, `FROM testproject.clustered_failures cf`)
+				So(q.SQL, ShouldContainSubstring, `WHERE partition_time >= @startTime AND partition_time < @endTime`)
+				So(q.Parameters, ShouldHaveLength, 3)
+				So(q.Parameters[0].Name, ShouldEqual, "startTime")
+				So(q.Parameters[0].Value, ShouldResemble, tr.Start)
+				So(q.Parameters[1].Name, ShouldEqual, "endTime")
+				So(q.Parameters[1].Value, ShouldResemble, tr.End)
+			})
+			Convey(`Reads only the requested clusters`, func() {
+				So(q.SQL, ShouldContainSubstring, `IN UNNEST(@clusterIDs)`)
+				So(q.Parameters[2].Name, ShouldEqual, "clusterIDs")
+				So(q.Parameters[2].Value, ShouldResemble, clusterIDs)
+			})
+			Convey(`Groups by day`, func() {
+				So(q.SQL, ShouldContainSubstring, `GROUP BY cluster_algorithm, cluster_id, date`)
+			})
+			Convey(`Limits bytes billed`, func() {
+				So(q.MaxBytesBilled, ShouldEqual, maxTimeRangeImpactBytesBilled)
+			})
+		})
+		Convey(`Without clusters`, func() {
+			impact, err := c.ReadTimeRangeImpact(ctx, "testproject", tr, nil)
+			So(err, ShouldBeNil)
+			So(impact, ShouldBeEmpty)
+			So(fake.queries, ShouldBeEmpty)
+		})
+		Convey(`Invalid project`, func() {
+			_, err := c.ReadTimeRangeImpact(ctx, "!invalid", tr, clusterIDs)
+			So(err, ShouldErrLike, `getting dataset`)
+			So(fake.queries, ShouldBeEmpty)
+		})
+		Convey(`Query error`, func() {
+			fake.err = errors.New("quota exceeded")
+			_, err := c.ReadTimeRangeImpact(ctx, "testproject", tr, clusterIDs)
+			So(err, ShouldErrLike, `querying time range impact: quota exceeded`)
+		})
+		Convey(`Response assembly`, func() {
+			fake.rows = []*timeRangeImpactRow{
+				{
+					ClusterID:        clusterA,
+					PresubmitRejects: counts(2),
+					TestRunFails:     counts(3),
+					Failures:         counts(10),
+					Daily: []DailyImpact{
+						{Date: day(1), PresubmitRejects: counts(1), TestRunFails: counts(1), Failures: counts(4)},
+						{Date: day(3), PresubmitRejects: counts(1), TestRunFails: counts(2), Failures: counts(6)},
+					},
+				},
+			}
+			impact, err := c.ReadTimeRangeImpact(ctx, "testproject", tr, clusterIDs)
+			So(err, ShouldBeNil)
+			So(impact, ShouldResemble, map[string]*TimeRangeImpact{
+				clusterA.Key(): {
+					StartTime:        tr.Start,
+					EndTime:          tr.End,
+					PresubmitRejects: counts(2),
+					TestRunFails:     counts(3),
+					Failures:         counts(10),
+					Daily: []*DailyImpact{
+						{Date: day(1), PresubmitRejects: counts(1), TestRunFails: counts(1), Failures: counts(4)},
+						{Date: day(2)},
+						{Date: day(3), PresubmitRejects: counts(1), TestRunFails: counts(2), Failures: counts(6)},
+						{Date: day(4)},
+					},
+				},
+				clusterB.Key(): {
+					StartTime: tr.Start,
+					EndTime:   tr.End,
+					Daily: []*DailyImpact{
+						{Date: day(1)},
+						{Date: day(2)},
+						{Date: day(3)},
+						{Date: day(4)},
+					},
+				},
+			})
+
+			Convey(`PopulateTimeRangeImpact`, func() {
+				clusters := []*ClusterSummary{
+					{ClusterID: clusterA},
+					{ClusterID: clusterB},
+				}
+				So(c.PopulateTimeRangeImpact(ctx, "testproject", tr, clusters), ShouldBeNil)
+				So(clusters[0].TimeRangeImpact, ShouldResemble, impact[clusterA.Key()])
+				So(clusters[1].TimeRangeImpact, ShouldResemble, impact[clusterB.Key()])
+			})
+		})
+	})
+}
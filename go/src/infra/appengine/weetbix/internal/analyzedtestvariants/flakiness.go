@@ -0,0 +1,256 @@
+// Copyright 2021 The Chromium Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package analyzedtestvariants
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"cloud.google.com/go/spanner"
+
+	"go.chromium.org/luci/common/clock"
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/common/logging"
+	"go.chromium.org/luci/server/span"
+
+	spanutil "infra/appengine/weetbix/internal/span"
+	pb "infra/appengine/weetbix/proto/v1"
+)
+
+const (
+	// FlakinessWindow is the time range of the verdicts used to compute
+	// flakiness scores.
+	FlakinessWindow = 7 * 24 * time.Hour
+
+	// FlakinessHalfLife is the age at which a verdict's weight in the
+	// flakiness score is halved.
+	FlakinessHalfLife = 2 * 24 * time.Hour
+
+	// PreSubmitVerdictWeight is the weight of a verdict from a try build,
+	// relative to a verdict from a CI build. Try builds test CLs that are not
+	// yet submitted, so their unexpected results are less likely to be caused
+	// by flakiness.
+	PreSubmitVerdictWeight = 0.5
+
+	// FlakinessScoreRefreshInterval is how often flakiness scores above zero are
+	// recomputed without new verdicts, so that they decay as their verdicts
+	// age.
+	FlakinessScoreRefreshInterval = 24 * time.Hour
+)
+
+// FlakinessVerdict is the part of a verdict used to compute flakiness scores.
+type FlakinessVerdict struct {
+	Status                       pb.VerdictStatus
+	IsPreSubmit                  bool
+	HasContributedToClSubmission bool
+	IngestionTime                time.Time
+}
+
+// FlakinessScore computes the flakiness score of a test variant from its
+// verdicts. The score is the weighted rate of verdicts with unexpected
+// results, between 0 and 1:
+//
+//	score = sum(w_i * u_i) / sum(w_i)
+//
+// where, for each verdict i ingested within FlakinessWindow before now:
+//   - u_i is 1 if the verdict has unexpected results (VERDICT_FLAKY or
+//     UNEXPECTED), and 0 otherwise.
+//   - w_i = s_i * 2^(-age_i / FlakinessHalfLife), so that recent verdicts
+//     weigh more.
+//   - s_i is 1 for verdicts from CI builds, PreSubmitVerdictWeight for
+//     verdicts from try builds that contributed to a CL's submission, and 0
+//     for other try build verdicts, which could be noise.
+//
+// The score is 0 if there are no such verdicts.
+func FlakinessScore(vs []*FlakinessVerdict, now time.Time) float64 {
+	var weighted, total float64
+	for _, v := range vs {
+		age := now.Sub(v.IngestionTime)
+		if age > FlakinessWindow {
+			continue
+		}
+		if age < 0 {
+			age = 0
+		}
+		w := 1.0
+		if v.IsPreSubmit {
+			if !v.HasContributedToClSubmission {
+				continue
+			}
+			w = PreSubmitVerdictWeight
+		}
+		w *= math.Exp2(-float64(age) / float64(FlakinessHalfLife))
+
+		total += w
+		if v.Status == pb.VerdictStatus_VERDICT_FLAKY || v.Status == pb.VerdictStatus_UNEXPECTED {
+			weighted += w
+		}
+	}
+	if total == 0 {
+		return 0
+	}
+	return weighted / total
+}
+
+// readFlakinessVerdicts reads the verdicts of a test variant ingested since
+// the given time.
+func readFlakinessVerdicts(ctx context.Context, k spanner.Key, since time.Time) ([]*FlakinessVerdict, error) {
+	st := spanner.NewStatement(`
+		SELECT Status, IsPreSubmit, HasContributedToClSubmission, IngestionTime
+		FROM Verdicts@{FORCE_INDEX=VerdictsByKeyAndIngestionTime, spanner_emulator.disable_query_null_filtered_index_check=true}
+		WHERE Realm = @realm
+		AND TestId = @testID
+		AND VariantHash = @variantHash
+		AND IngestionTime >= @since
+	`)
+	st.Params = map[string]interface{}{
+		"realm":       k[0],
+		"testID":      k[1],
+		"variantHash": k[2],
+		"since":       since,
+	}
+
+	var vs []*FlakinessVerdict
+	var b spanutil.Buffer
+	err := span.Query(ctx, st).Do(
+		func(row *spanner.Row) error {
+			v := &FlakinessVerdict{}
+			var isPreSubmit, contributed spanner.NullBool
+			if err := b.FromSpanner(row, &v.Status, &isPreSubmit, &contributed, &v.IngestionTime); err != nil {
+				return err
+			}
+			v.IsPreSubmit = isPreSubmit.Bool
+			v.HasContributedToClSubmission = contributed.Bool
+			vs = append(vs, v)
+			return nil
+		},
+	)
+	return vs, err
+}
+
+// queryFlakinessScoreUpdates queries the keys of the test variants whose
+// flakiness scores need to be recomputed, which are:
+//   - test variants with new verdicts since their score was last computed,
+//   - test variants with a score above zero which has not been computed since
+//     refreshBefore.
+func queryFlakinessScoreUpdates(ctx context.Context, refreshBefore time.Time) ([]spanner.Key, error) {
+	st := spanner.NewStatement(`
+		SELECT Realm, TestId, VariantHash
+		FROM AnalyzedTestVariants@{FORCE_INDEX=AnalyzedTestVariantsByFlakinessScoreStale, spanner_emulator.disable_query_null_filtered_index_check=true}
+		WHERE FlakinessScoreStale
+		UNION DISTINCT
+		SELECT Realm, TestId, VariantHash
+		FROM AnalyzedTestVariants@{FORCE_INDEX=AnalyzedTestVariantsByFlakinessScore, spanner_emulator.disable_query_null_filtered_index_check=true}
+		WHERE FlakinessScore > 0
+		AND FlakinessScoreUpdateTime < @refreshBefore
+	`)
+	st.Params = map[string]interface{}{
+		"refreshBefore": refreshBefore,
+	}
+
+	var keys []spanner.Key
+	var b spanutil.Buffer
+	err := span.Query(ctx, st).Do(
+		func(row *spanner.Row) error {
+			var realm, testID, variantHash string
+			if err := b.FromSpanner(row, &realm, &testID, &variantHash); err != nil {
+				return err
+			}
+			keys = append(keys, spanner.Key{realm, testID, variantHash})
+			return nil
+		},
+	)
+	return keys, err
+}
+
+// updateFlakinessScore recomputes and saves the flakiness score of a test
+// variant.
+func updateFlakinessScore(ctx context.Context, k spanner.Key) error {
+	_, err := span.ReadWriteTransaction(ctx, func(ctx context.Context) error {
+		// Reading the verdicts in the same transaction that clears
+		// FlakinessScoreStale ensures a verdict ingested concurrently is
+		// either included in the score, or marks the score stale again.
+		now := clock.Now(ctx)
+		vs, err := readFlakinessVerdicts(ctx, k, now.Add(-FlakinessWindow))
+		if err != nil {
+			return err
+		}
+		span.BufferWrite(ctx, spanutil.UpdateMap("AnalyzedTestVariants", map[string]interface{}{
+			"Realm":                    k[0],
+			"TestId":                   k[1],
+			"VariantHash":              k[2],
+			"FlakinessScore":           FlakinessScore(vs, now),
+			"FlakinessScoreUpdateTime": now,
+			"FlakinessScoreStale":      spanner.NullBool{},
+		}))
+		return nil
+	})
+	return err
+}
+
+// UpdateFlakinessScores recomputes the flakiness scores of test variants.
+//
+// The update is incremental: only the scores of test variants with new
+// verdicts, and the scores above zero which have not been recomputed for
+// FlakinessScoreRefreshInterval (to let them decay), are recomputed.
+func UpdateFlakinessScores(ctx context.Context) error {
+	keys, err := queryFlakinessScoreUpdates(span.Single(ctx), clock.Now(ctx).Add(-FlakinessScoreRefreshInterval))
+	if err != nil {
+		return errors.Annotate(err, "query test variants to update").Err()
+	}
+	for _, k := range keys {
+		if err := updateFlakinessScore(ctx, k); err != nil {
+			return errors.Annotate(err, "update flakiness score of %s", k).Err()
+		}
+	}
+	logging.Infof(ctx, "Updated flakiness scores of %d test variants", len(keys))
+	return nil
+}
+
+// FlakyTestVariant is a test variant with its flakiness score.
+type FlakyTestVariant struct {
+	Realm                    string    `json:"realm"`
+	TestID                   string    `json:"testId"`
+	VariantHash              string    `json:"variantHash"`
+	FlakinessScore           float64   `json:"flakinessScore"`
+	FlakinessScoreUpdateTime time.Time `json:"flakinessScoreUpdateTime"`
+}
+
+// QueryFlakyTestVariants queries the test variants of a LUCI project with a
+// flakiness score, ordered by flakiness score. At most limit test variants
+// are returned.
+func QueryFlakyTestVariants(ctx context.Context, project string, ascending bool, limit int) ([]*FlakyTestVariant, error) {
+	order := "DESC"
+	if ascending {
+		order = "ASC"
+	}
+	st := spanner.NewStatement(`
+		SELECT Realm, TestId, VariantHash, FlakinessScore, FlakinessScoreUpdateTime
+		FROM AnalyzedTestVariants@{FORCE_INDEX=AnalyzedTestVariantsByFlakinessScore, spanner_emulator.disable_query_null_filtered_index_check=true}
+		WHERE STARTS_WITH(Realm, @realmPrefix)
+		AND FlakinessScore IS NOT NULL
+		ORDER BY FlakinessScore ` + order + `, Realm, TestId, VariantHash
+		LIMIT @limit
+	`)
+	st.Params = map[string]interface{}{
+		"realmPrefix": project + ":",
+		"limit":       limit,
+	}
+
+	var tvs []*FlakyTestVariant
+	var b spanutil.Buffer
+	err := span.Query(ctx, st).Do(
+		func(row *spanner.Row) error {
+			tv := &FlakyTestVariant{}
+			if err := b.FromSpanner(row, &tv.Realm, &tv.TestID, &tv.VariantHash, &tv.FlakinessScore, &tv.FlakinessScoreUpdateTime); err != nil {
+				return err
+			}
+			tvs = append(tvs, tv)
+			return nil
+		},
+	)
+	return tvs, err
+}
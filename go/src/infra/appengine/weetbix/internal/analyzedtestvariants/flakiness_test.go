@@ -0,0 +1,192 @@
+// Copyright 2021 The Chromium Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package analyzedtestvariants
+
+import (
+	"testing"
+	"time"
+
+	"cloud.google.com/go/spanner"
+	"go.chromium.org/luci/common/clock"
+	"go.chromium.org/luci/server/span"
+
+	"infra/appengine/weetbix/internal/testutil"
+	"infra/appengine/weetbix/internal/testutil/insert"
+	pb "infra/appengine/weetbix/proto/v1"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestFlakinessScore(t *testing.T) {
+	Convey(`FlakinessScore`, t, func() {
+		now := time.Date(2021, 12, 1, 0, 0, 0, 0, time.UTC)
+		verdict := func(status pb.VerdictStatus, age time.Duration) *FlakinessVerdict {
+			return &FlakinessVerdict{
+				Status:        status,
+				IngestionTime: now.Add(-age),
+			}
+		}
+
+		Convey(`No verdicts`, func() {
+			So(FlakinessScore(nil, now), ShouldEqual, 0)
+		})
+		Convey(`Only expected verdicts`, func() {
+			vs := []*FlakinessVerdict{
+				verdict(pb.VerdictStatus_EXPECTED, time.Hour),
+				verdict(pb.VerdictStatus_EXPECTED, 2*time.Hour),
+			}
+			So(FlakinessScore(vs, now), ShouldEqual, 0)
+		})
+		Convey(`Only unexpected verdicts`, func() {
+			vs := []*FlakinessVerdict{
+				verdict(pb.VerdictStatus_VERDICT_FLAKY, time.Hour),
+				verdict(pb.VerdictStatus_UNEXPECTED, 3*24*time.Hour),
+			}
+			So(FlakinessScore(vs, now), ShouldEqual, 1)
+		})
+		Convey(`Recent verdicts weigh more`, func() {
+			vs := []*FlakinessVerdict{
+				verdict(pb.VerdictStatus_VERDICT_FLAKY, 0),
+				verdict(pb.VerdictStatus_EXPECTED, FlakinessHalfLife),
+			}
+			So(FlakinessScore(vs, now), ShouldAlmostEqual, 1/1.5)
+
+			vs = []*FlakinessVerdict{
+				verdict(pb.VerdictStatus_EXPECTED, 0),
+				verdict(pb.VerdictStatus_VERDICT_FLAKY, FlakinessHalfLife),
+			}
+			So(FlakinessScore(vs, now), ShouldAlmostEqual, 0.5/1.5)
+		})
+		Convey(`Verdicts outside the window are ignored`, func() {
+			vs := []*FlakinessVerdict{
+				verdict(pb.VerdictStatus_EXPECTED, time.Hour),
+				verdict(pb.VerdictStatus_VERDICT_FLAKY, FlakinessWindow+time.Hour),
+			}
+			So(FlakinessScore(vs, now), ShouldEqual, 0)
+
+			vs = vs[1:]
+			So(FlakinessScore(vs, now), ShouldEqual, 0)
+		})
+		Convey(`Try build verdicts`, func() {
+			flaky := verdict(pb.VerdictStatus_VERDICT_FLAKY, 0)
+			flaky.IsPreSubmit = true
+			vs := []*FlakinessVerdict{
+				verdict(pb.VerdictStatus_EXPECTED, 0),
+				flaky,
+			}
+			Convey(`that contributed to CL submission are weighted`, func() {
+				flaky.HasContributedToClSubmission = true
+				So(FlakinessScore(vs, now), ShouldAlmostEqual, PreSubmitVerdictWeight/(1+PreSubmitVerdictWeight))
+			})
+			Convey(`that did not contribute to CL submission are ignored`, func() {
+				So(FlakinessScore(vs, now), ShouldEqual, 0)
+			})
+		})
+	})
+}
+
+func TestUpdateFlakinessScores(t *testing.T) {
+	Convey(`UpdateFlakinessScores`, t, func() {
+		ctx := testutil.SpannerTestContext(t)
+		realm := "chromium:ci"
+		vh := "varianthash"
+		now := clock.Now(ctx).UTC()
+
+		ms := []*spanner.Mutation{
+			// New verdicts were ingested, the score must be computed.
+			insert.AnalyzedTestVariant(realm, "ninja://stale", vh, pb.AnalyzedTestVariantStatus_FLAKY, map[string]interface{}{
+				"FlakinessScoreStale": true,
+			}),
+			insert.Verdict(realm, "ninja://stale", vh, "build-0", pb.VerdictStatus_VERDICT_FLAKY, now.Add(-time.Hour), nil),
+			insert.Verdict(realm, "ninja://stale", vh, "build-1", pb.VerdictStatus_EXPECTED, now.Add(-time.Hour), nil),
+			// Recently computed score, without new verdicts.
+			insert.AnalyzedTestVariant(realm, "ninja://fresh", vh, pb.AnalyzedTestVariantStatus_FLAKY, map[string]interface{}{
+				"FlakinessScore":           0.3,
+				"FlakinessScoreUpdateTime": now.Add(-time.Hour),
+			}),
+			insert.Verdict(realm, "ninja://fresh", vh, "build-0", pb.VerdictStatus_EXPECTED, now.Add(-2*time.Hour), nil),
+			// Score computed long ago, which must decay.
+			insert.AnalyzedTestVariant(realm, "ninja://decaying", vh, pb.AnalyzedTestVariantStatus_FLAKY, map[string]interface{}{
+				"FlakinessScore":           0.3,
+				"FlakinessScoreUpdateTime": now.Add(-FlakinessScoreRefreshInterval - time.Hour),
+			}),
+			insert.Verdict(realm, "ninja://decaying", vh, "build-0", pb.VerdictStatus_VERDICT_FLAKY, now.Add(-FlakinessWindow-2*time.Hour), nil),
+			// No verdicts at all.
+			insert.AnalyzedTestVariant(realm, "ninja://unscored", vh, pb.AnalyzedTestVariantStatus_FLAKY, nil),
+			// Test variant in another project.
+			insert.AnalyzedTestVariant("other:ci", "ninja://stale", vh, pb.AnalyzedTestVariantStatus_FLAKY, map[string]interface{}{
+				"FlakinessScoreStale": true,
+			}),
+			insert.Verdict("other:ci", "ninja://stale", vh, "build-0", pb.VerdictStatus_VERDICT_FLAKY, now.Add(-time.Hour), nil),
+		}
+		testutil.MustApply(ctx, ms...)
+
+		type score struct {
+			score      spanner.NullFloat64
+			updateTime spanner.NullTime
+			stale      spanner.NullBool
+		}
+		readScore := func(realm, testID string) score {
+			row, err := span.ReadRow(span.Single(ctx), "AnalyzedTestVariants", spanner.Key{realm, testID, vh}, []string{"FlakinessScore", "FlakinessScoreUpdateTime", "FlakinessScoreStale"})
+			So(err, ShouldBeNil)
+			var s score
+			So(row.Columns(&s.score, &s.updateTime, &s.stale), ShouldBeNil)
+			return s
+		}
+
+		So(UpdateFlakinessScores(ctx), ShouldBeNil)
+
+		stale := readScore(realm, "ninja://stale")
+		So(stale.score, ShouldResemble, spanner.NullFloat64{Float64: 0.5, Valid: true})
+		So(stale.updateTime.Valid, ShouldBeTrue)
+		So(stale.stale.Valid, ShouldBeFalse)
+
+		fresh := readScore(realm, "ninja://fresh")
+		So(fresh.score, ShouldResemble, spanner.NullFloat64{Float64: 0.3, Valid: true})
+		So(fresh.updateTime.Time, ShouldHappenBefore, now.Add(-30*time.Minute))
+
+		decaying := readScore(realm, "ninja://decaying")
+		So(decaying.score, ShouldResemble, spanner.NullFloat64{Float64: 0, Valid: true})
+		So(decaying.updateTime.Time, ShouldHappenAfter, now.Add(-time.Hour))
+
+		unscored := readScore(realm, "ninja://unscored")
+		So(unscored.score.Valid, ShouldBeFalse)
+		So(unscored.updateTime.Valid, ShouldBeFalse)
+
+		Convey(`Only updates scores incrementally`, func() {
+			keys, err := queryFlakinessScoreUpdates(span.Single(ctx), clock.Now(ctx).Add(-FlakinessScoreRefreshInterval))
+			So(err, ShouldBeNil)
+			So(keys, ShouldBeEmpty)
+
+			// A new verdict marks the score stale again.
+			testutil.MustApply(ctx,
+				insert.Verdict(realm, "ninja://fresh", vh, "build-1", pb.VerdictStatus_VERDICT_FLAKY, now.Add(-2*time.Hour), nil),
+				spanner.Update("AnalyzedTestVariants", []string{"Realm", "TestId", "VariantHash", "FlakinessScoreStale"}, []interface{}{realm, "ninja://fresh", vh, true}),
+			)
+			keys, err = queryFlakinessScoreUpdates(span.Single(ctx), clock.Now(ctx).Add(-FlakinessScoreRefreshInterval))
+			So(err, ShouldBeNil)
+			So(keys, ShouldResemble, []spanner.Key{{realm, "ninja://fresh", vh}})
+
+			So(UpdateFlakinessScores(ctx), ShouldBeNil)
+			fresh := readScore(realm, "ninja://fresh")
+			So(fresh.score, ShouldResemble, spanner.NullFloat64{Float64: 0.5, Valid: true})
+		})
+
+		Convey(`QueryFlakyTestVariants`, func() {
+			tvs, err := QueryFlakyTestVariants(span.Single(ctx), "chromium", false, 10)
+			So(err, ShouldBeNil)
+			So(tvs, ShouldHaveLength, 3)
+			So(tvs[0].TestID, ShouldEqual, "ninja://stale")
+			So(tvs[0].FlakinessScore, ShouldEqual, 0.5)
+			So(tvs[1].TestID, ShouldEqual, "ninja://fresh")
+			So(tvs[2].TestID, ShouldEqual, "ninja://decaying")
+
+			tvs, err = QueryFlakyTestVariants(span.Single(ctx), "chromium", true, 1)
+			So(err, ShouldBeNil)
+			So(tvs, ShouldHaveLength, 1)
+			So(tvs[0].TestID, ShouldEqual, "ninja://decaying")
+		})
+	})
+}
@@ -6,6 +6,7 @@ package bugs
 
 import (
 	"errors"
+	"fmt"
 	"infra/appengine/weetbix/internal/analysis"
 	"infra/appengine/weetbix/internal/clustering"
 )
@@ -18,6 +19,36 @@ type BugToUpdate struct {
 
 var ErrCreateSimulated = errors.New("CreateNew did not create a bug as the bug manager is in simulation mode")
 
+// DeferReason is the reason a change to a bug-tracking system was
+// deferred to a later run.
+type DeferReason string
+
+const (
+	// DeferredRunQuota means the maximum number of changes per run was
+	// reached.
+	DeferredRunQuota DeferReason = "run_quota"
+	// DeferredMinuteQuota means the maximum number of changes per minute
+	// was reached.
+	DeferredMinuteQuota DeferReason = "minute_quota"
+)
+
+// DeferredError is returned by bug managers if a bug was not created
+// because changes to the bug-tracking system are throttled.
+type DeferredError struct {
+	Reason DeferReason
+}
+
+func (e *DeferredError) Error() string {
+	return fmt.Sprintf("change to bug-tracking system deferred: %s", e.Reason)
+}
+
+// DeferredUpdate is an update to a bug which was not made because changes
+// to the bug-tracking system are throttled.
+type DeferredUpdate struct {
+	BugName string
+	Reason  DeferReason
+}
+
 // CreateRequest captures key details of a cluster and its impact,
 // as needed for filing new bugs.
 type CreateRequest struct {
@@ -12,6 +12,7 @@ import (
 	"infra/appengine/weetbix/internal/bugs"
 	"infra/appengine/weetbix/internal/clustering"
 	"infra/appengine/weetbix/internal/config"
+	"infra/appengine/weetbix/internal/sanitize"
 	mpb "infra/monorailv2/api/v3/api_proto"
 
 	"google.golang.org/genproto/protobuf/field_mask"
@@ -29,9 +30,14 @@ const (
 	managedLabel        = "Weetbix-Managed"
 )
 
-// whitespaceRE matches blocks of whitespace, including new lines tabs and
-// spaces.
-var whitespaceRE = regexp.MustCompile(`[ \t\n]+`)
+const (
+	// maxTitleRunes is the maximum length of the cluster-specific part of
+	// a bug title.
+	maxTitleRunes = 150
+	// maxDescriptionRunes is the maximum length of the cluster-specific
+	// part of a bug description.
+	maxDescriptionRunes = 4096
+)
 
 // priorityRE matches chromium monorail priority values.
 var priorityRE = regexp.MustCompile(`^Pri-([0123])$`)
@@ -50,6 +56,25 @@ const AssignedStatus = "Assigned"
 // UntriagedStatus is the status of bugs that have just been opened.
 const UntriagedStatus = "Untriaged"
 
+// closedStatuses are the statuses of bugs that are closed. These are the
+// statuses monorail projects use by default to mean closed.
+var closedStatuses = map[string]bool{
+	"Fixed":     true,
+	"Verified":  true,
+	"Duplicate": true,
+	"WontFix":   true,
+	"Invalid":   true,
+	"Done":      true,
+	"Archived":  true,
+}
+
+// fixedStatuses are the statuses of bugs that are closed because they were
+// fixed.
+var fixedStatuses = map[string]bool{
+	"Fixed":    true,
+	"Verified": true,
+}
+
 // Generator provides access to a methods to generate a new bug and/or bug
 // updates for a cluster.
 type Generator struct {
@@ -74,7 +99,7 @@ func NewGenerator(impact *bugs.ClusterImpact, monorailCfg *config.MonorailProjec
 // are the cluster-specific bug title and description.
 func (g *Generator) PrepareNew(description *clustering.ClusterDescription) *mpb.MakeIssueRequest {
 	issue := &mpb.Issue{
-		Summary: fmt.Sprintf("Tests are failing: %v", sanitiseTitle(description.Title, 150)),
+		Summary: fmt.Sprintf("Tests are failing: %v", sanitize.Line(description.Title, maxTitleRunes)),
 		State:   mpb.IssueContentState_ACTIVE,
 		Status:  &mpb.Issue_StatusValue{Status: UntriagedStatus},
 		FieldValues: []*mpb.FieldValue{
@@ -99,13 +124,19 @@ func (g *Generator) PrepareNew(description *clustering.ClusterDescription) *mpb.
 	return &mpb.MakeIssueRequest{
 		Parent:      fmt.Sprintf("projects/%s", g.monorailCfg.Project),
 		Issue:       issue,
-		Description: fmt.Sprintf(DescriptionTemplate, description.Description),
+		Description: fmt.Sprintf(DescriptionTemplate, sanitize.Text(description.Description, maxDescriptionRunes)),
 		NotifyType:  mpb.NotifyType_EMAIL,
 	}
 }
 
 func (g *Generator) priorityFieldName() string {
-	return fmt.Sprintf("projects/%s/fieldDefs/%v", g.monorailCfg.Project, g.monorailCfg.PriorityFieldId)
+	return priorityFieldName(g.monorailCfg)
+}
+
+// priorityFieldName returns the name of the priority field of the monorail
+// project.
+func priorityFieldName(monorailCfg *config.MonorailProject) string {
+	return fmt.Sprintf("projects/%s/fieldDefs/%v", monorailCfg.Project, monorailCfg.PriorityFieldId)
 }
 
 // NeedsUpdate determines if the bug for the given cluster needs to be updated.
@@ -205,6 +236,37 @@ func (g *Generator) prepareBugVerifiedUpdate(issue *mpb.Issue, update *mpb.Issue
 	return comment
 }
 
+// prepareRecurrenceUpdate prepares an update noting that failures recurred
+// after the given fixed issue was fixed. If reopen is set, the issue is
+// re-opened, otherwise it is only commented on.
+func prepareRecurrenceUpdate(issue *mpb.Issue, reopen bool) *mpb.ModifyIssuesRequest {
+	delta := &mpb.IssueDelta{
+		Issue: &mpb.Issue{
+			Name: issue.Name,
+		},
+		UpdateMask: &field_mask.FieldMask{
+			Paths: []string{},
+		},
+	}
+	comment := "Weetbix has identified new occurrences of the failure cluster since the bug was fixed. If the fix was incomplete, please re-open the bug."
+	if reopen {
+		status := UntriagedStatus
+		if issue.GetOwner().GetUser() != "" {
+			status = AssignedStatus
+		}
+		delta.Issue.Status = &mpb.Issue_StatusValue{Status: status}
+		delta.UpdateMask.Paths = append(delta.UpdateMask.Paths, "status")
+		comment = "Weetbix has identified new occurrences of the failure cluster since the bug was fixed. The bug has been re-opened."
+	}
+	return &mpb.ModifyIssuesRequest{
+		Deltas: []*mpb.IssueDelta{
+			delta,
+		},
+		NotifyType:     mpb.NotifyType_EMAIL,
+		CommentContent: comment,
+	}
+}
+
 func prepareManualPriorityUpdate(issue *mpb.Issue, update *mpb.IssueDelta) string {
 	update.Issue.Labels = []*mpb.Issue_LabelValue{{
 		Label: manualPriorityLabel,
@@ -308,6 +370,14 @@ func issueVerified(issue *mpb.Issue) bool {
 	return issue.Status.Status == VerifiedStatus
 }
 
+func issueClosed(issue *mpb.Issue) bool {
+	return closedStatuses[issue.Status.GetStatus()]
+}
+
+func issueFixed(issue *mpb.Issue) bool {
+	return fixedStatuses[issue.Status.GetStatus()]
+}
+
 // isHigherPriority returns whether priority p1 is higher than priority p2.
 // The passed strings are the priority field values as used in monorail. These
 // must be matched against monorail project configuration in order to
@@ -403,18 +473,3 @@ func (g *Generator) clusterResolved() bool {
 	lowestPriority := g.monorailCfg.Priorities[len(g.monorailCfg.Priorities)-1]
 	return !g.impact.MeetsThreshold(lowestPriority.Threshold)
 }
-
-// sanitiseTitle removes tabs and line breaks from input, replacing them with
-// spaces, and truncates the output to the given number of runes.
-func sanitiseTitle(input string, maxLength int) string {
-	// Replace blocks of whitespace, including new lines and tabs, with just a
-	// single space.
-	strippedInput := whitespaceRE.ReplaceAllString(input, " ")
-
-	// Truncate to desired length.
-	runes := []rune(strippedInput)
-	if len(runes) > maxLength {
-		return string(runes[0:maxLength-3]) + "..."
-	}
-	return strippedInput
-}
@@ -8,6 +8,7 @@ import (
 	"context"
 	"fmt"
 	"regexp"
+	"sort"
 
 	"infra/appengine/weetbix/internal/bugs"
 	"infra/appengine/weetbix/internal/config"
@@ -42,6 +43,9 @@ type BugManager struct {
 	// appear as that user, which breaks the detection of user-made
 	// priority changes vs system-made priority changes.
 	Simulate bool
+	// Throttle, if set, limits the changes made to monorail. Changes
+	// in excess of its quota are deferred.
+	Throttle *Throttle
 }
 
 // NewBugManager initialises a new bug manager, using the specified
@@ -66,6 +70,9 @@ func (m *BugManager) Create(ctx context.Context, request *bugs.CreateRequest) (s
 		logging.Debugf(ctx, "Would create Monorail issue: %s", textPBMultiline.Format(req))
 		return "", bugs.ErrCreateSimulated
 	}
+	if reason, ok := m.Throttle.acquire(ctx); !ok {
+		return "", &bugs.DeferredError{Reason: reason}
+	}
 	// Save the issue in Monorail.
 	issue, err := m.client.MakeIssue(ctx, req)
 	if err != nil {
@@ -79,38 +86,102 @@ func (m *BugManager) Create(ctx context.Context, request *bugs.CreateRequest) (s
 }
 
 type clusterIssue struct {
-	impact *bugs.ClusterImpact
-	issue  *mpb.Issue
+	bugName string
+	impact  *bugs.ClusterImpact
+	issue   *mpb.Issue
+}
+
+// Classes of issue updates, in the order updates are made when changes
+// to monorail are throttled.
+const (
+	// highPriorityUpdate sets an issue to one of the two highest
+	// priorities (e.g. P0 or P1).
+	highPriorityUpdate = iota
+	// statusUpdate verifies or re-opens an issue, or otherwise changes
+	// its priority.
+	statusUpdate
+	// commentUpdate only comments on an issue, e.g. to note its priority
+	// has been set manually.
+	commentUpdate
+)
+
+// issueUpdate is an update to be made to an issue.
+type issueUpdate struct {
+	bugName string
+	req     *mpb.ModifyIssuesRequest
+	class   int
 }
 
-// Update updates the specified list of bugs.
-func (m *BugManager) Update(ctx context.Context, bugsToUpdate []*bugs.BugToUpdate) error {
+// Update updates the specified list of bugs. If changes to monorail are
+// throttled, the most important updates are made first, and the updates
+// which were not made are returned.
+func (m *BugManager) Update(ctx context.Context, bugsToUpdate []*bugs.BugToUpdate) ([]*bugs.DeferredUpdate, error) {
 	// Fetch issues for bugs to update.
 	cis, err := m.fetchIssues(ctx, bugsToUpdate)
 	if err != nil {
-		return err
+		return nil, err
 	}
+	var updates []*issueUpdate
 	for _, ci := range cis {
 		g, err := NewGenerator(ci.impact, m.monorailCfg)
 		if err != nil {
-			return errors.Annotate(err, "create issue generator").Err()
+			return nil, errors.Annotate(err, "create issue generator").Err()
 		}
-		if g.NeedsUpdate(ci.issue) {
-			comments, err := m.client.ListComments(ctx, ci.issue.Name)
-			if err != nil {
-				return err
+		if !g.NeedsUpdate(ci.issue) {
+			continue
+		}
+		comments, err := m.client.ListComments(ctx, ci.issue.Name)
+		if err != nil {
+			return nil, err
+		}
+		req := g.MakeUpdate(ci.issue, comments)
+		updates = append(updates, &issueUpdate{
+			bugName: ci.bugName,
+			req:     req,
+			class:   g.updateClass(req),
+		})
+	}
+
+	// Make the most important updates first. Within each class, keep the
+	// order of the bugs passed, as callers list the bugs whose updates
+	// were previously deferred first.
+	sort.SliceStable(updates, func(i, j int) bool {
+		return updates[i].class < updates[j].class
+	})
+	var deferred []*bugs.DeferredUpdate
+	for _, u := range updates {
+		if m.Simulate {
+			logging.Debugf(ctx, "Would update Monorail issue: %s", textPBMultiline.Format(u.req))
+			continue
+		}
+		if reason, ok := m.Throttle.acquire(ctx); !ok {
+			deferred = append(deferred, &bugs.DeferredUpdate{BugName: u.bugName, Reason: reason})
+			continue
+		}
+		if err := m.client.ModifyIssues(ctx, u.req); err != nil {
+			return nil, errors.Annotate(err, "failed to update to issue %s", u.req.Deltas[0].Issue.Name).Err()
+		}
+	}
+	return deferred, nil
+}
+
+// updateClass returns the class of the given update, which must have been
+// prepared by MakeUpdate.
+func (g *Generator) updateClass(req *mpb.ModifyIssuesRequest) int {
+	class := commentUpdate
+	for _, delta := range req.Deltas {
+		for _, fv := range delta.Issue.GetFieldValues() {
+			if fv.Field == g.priorityFieldName() && g.indexOfPriority(fv.Value) < 2 {
+				return highPriorityUpdate
 			}
-			req := g.MakeUpdate(ci.issue, comments)
-			if m.Simulate {
-				logging.Debugf(ctx, "Would update Monorail issue: %s", textPBMultiline.Format(req))
-			} else {
-				if err := m.client.ModifyIssues(ctx, req); err != nil {
-					return errors.Annotate(err, "failed to update to issue %s", ci.issue.Name).Err()
-				}
+		}
+		for _, p := range delta.UpdateMask.GetPaths() {
+			if p == "status" || p == "field_values" {
+				class = statusUpdate
 			}
 		}
 	}
-	return nil
+	return class
 }
 
 func (m *BugManager) fetchIssues(ctx context.Context, updates []*bugs.BugToUpdate) ([]*clusterIssue, error) {
@@ -121,7 +192,7 @@ func (m *BugManager) fetchIssues(ctx context.Context, updates []*bugs.BugToUpdat
 	var clusterIssues []*clusterIssue
 	for i := 0; i < pages; i++ {
 		// Divide bug clusters into pages of monorailPageSize.
-		pageEnd := i*monorailPageSize + (monorailPageSize - 1)
+		pageEnd := (i + 1) * monorailPageSize
 		if pageEnd > len(updates) {
 			pageEnd = len(updates)
 		}
@@ -142,14 +213,127 @@ func (m *BugManager) fetchIssues(ctx context.Context, updates []*bugs.BugToUpdat
 		}
 		for i, upd := range updatesPage {
 			clusterIssues = append(clusterIssues, &clusterIssue{
-				impact: upd.Impact,
-				issue:  issues[i],
+				bugName: upd.BugName,
+				impact:  upd.Impact,
+				issue:   issues[i],
 			})
 		}
 	}
 	return clusterIssues, nil
 }
 
+// ReadClosed reads which of the given bugs are closed, e.g. because they
+// were fixed or marked as duplicates. Bugs are identified by their internal
+// bug name, e.g. "{monorail_project}/{numeric_id}". The result is keyed by
+// bug name.
+func (m *BugManager) ReadClosed(ctx context.Context, bugNames []string) (map[string]bool, error) {
+	result := make(map[string]bool)
+	err := m.readIssues(ctx, bugNames, func(bug string, issue *mpb.Issue) {
+		result[bug] = issueClosed(issue)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// ReadFixed reads which of the given bugs are fixed, i.e. closed as Fixed or
+// Verified. Bugs closed for other reasons, e.g. as duplicates, are not
+// fixed. Bugs are identified by their internal bug name, e.g.
+// "{monorail_project}/{numeric_id}". The result is keyed by bug name.
+func (m *BugManager) ReadFixed(ctx context.Context, bugNames []string) (map[string]bool, error) {
+	result := make(map[string]bool)
+	err := m.readIssues(ctx, bugNames, func(bug string, issue *mpb.Issue) {
+		result[bug] = issueFixed(issue)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// NotifyRecurrence notes on the given fixed bug that failures recurred
+// after it was fixed. If reopen is set, the bug is also re-opened. The bug
+// is identified by its internal bug name, e.g.
+// "{monorail_project}/{numeric_id}". If changes to monorail are throttled,
+// a *bugs.DeferredError is returned.
+func (m *BugManager) NotifyRecurrence(ctx context.Context, bugName string, reopen bool) error {
+	name, err := toMonorailIssueName(bugName)
+	if err != nil {
+		return err
+	}
+	issue, err := m.client.GetIssue(ctx, name)
+	if err != nil {
+		return err
+	}
+	req := prepareRecurrenceUpdate(issue, reopen)
+	if m.Simulate {
+		logging.Debugf(ctx, "Would update Monorail issue: %s", textPBMultiline.Format(req))
+		return nil
+	}
+	if reason, ok := m.Throttle.acquire(ctx); !ok {
+		return &bugs.DeferredError{Reason: reason}
+	}
+	if err := m.client.ModifyIssues(ctx, req); err != nil {
+		return errors.Annotate(err, "failed to update to issue %s", name).Err()
+	}
+	return nil
+}
+
+// ReadPriorities reads the priorities of those of the given bugs which are
+// open. Bugs are identified by their internal bug name, e.g.
+// "{monorail_project}/{numeric_id}". The result is keyed by bug name, and
+// the priority of open bugs without priority is "".
+func (m *BugManager) ReadPriorities(ctx context.Context, bugNames []string) (map[string]string, error) {
+	fieldName := priorityFieldName(m.monorailCfg)
+	result := make(map[string]string)
+	err := m.readIssues(ctx, bugNames, func(bug string, issue *mpb.Issue) {
+		if issueClosed(issue) {
+			return
+		}
+		result[bug] = ""
+		for _, fv := range issue.FieldValues {
+			if fv.Field == fieldName {
+				result[bug] = fv.Value
+			}
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// readIssues reads the issues of the given bugs, in pages, and calls f with
+// each bug and its issue.
+func (m *BugManager) readIssues(ctx context.Context, bugNames []string, f func(bug string, issue *mpb.Issue)) error {
+	for start := 0; start < len(bugNames); start += monorailPageSize {
+		end := start + monorailPageSize
+		if end > len(bugNames) {
+			end = len(bugNames)
+		}
+		page := bugNames[start:end]
+
+		var names []string
+		for _, bug := range page {
+			name, err := toMonorailIssueName(bug)
+			if err != nil {
+				return err
+			}
+			names = append(names, name)
+		}
+		// Guarantees result array in 1:1 correspondence to requested names.
+		issues, err := m.client.BatchGetIssues(ctx, names)
+		if err != nil {
+			return err
+		}
+		for i, bug := range page {
+			f(bug, issues[i])
+		}
+	}
+	return nil
+}
+
 // toMonorailIssueName converts an internal bug name like
 // "{monorail_project}/{numeric_id}" to a monorail issue name like
 // "projects/{project}/issues/{numeric_id}".
@@ -8,8 +8,11 @@ import (
 	"context"
 	"infra/appengine/weetbix/internal/bugs"
 	"infra/appengine/weetbix/internal/clustering"
+	"infra/appengine/weetbix/internal/config"
 	mpb "infra/monorailv2/api/v3/api_proto"
+	"strings"
 	"testing"
+	"unicode/utf8"
 
 	. "github.com/smartystreets/goconvey/convey"
 	. "go.chromium.org/luci/common/testing/assertions"
@@ -83,10 +86,27 @@ func TestManager(t *testing.T) {
 					}},
 				})
 				So(len(issue.Comments), ShouldEqual, 1)
-				So(issue.Comments[0].Content, ShouldContainSubstring, reason)
+				// Indentation is collapsed.
+				So(issue.Comments[0].Content, ShouldContainSubstring, "A cluster of failures has been found with reason: Expected equality of these values:\n\"Expected_Value\"\nmy_expr.evaluate(123)\nWhich is: \"Unexpected_Value\"")
 				So(issue.Comments[0].Content, ShouldNotContainSubstring, "ClusterIDShouldNotAppearInOutput")
 				So(issue.NotifyCount, ShouldEqual, 1)
 			})
+			Convey("With unsanitized failure cluster", func() {
+				reason := "\x1b[31mCheck failed\x1b[0m: \xff\n" + strings.Repeat("QUJDREVGR0hJSktMTU5PUA==", 1000) + "\nat main.cc:10"
+				c.Description.Title = reason
+				c.Description.Description = "A cluster of failures has been found with reason: " + reason
+
+				_, err := bm.Create(ctx, c)
+				So(err, ShouldBeNil)
+				So(len(f.Issues), ShouldEqual, 1)
+				issue := f.Issues[0]
+
+				So(issue.Issue.Summary, ShouldStartWith, "Tests are failing: Check failed: \ufffd QUJD")
+				So(issue.Issue.Summary, ShouldEndWith, "...")
+				So(utf8.RuneCountInString(issue.Issue.Summary), ShouldEqual, len("Tests are failing: ")+150)
+				So(len(issue.Comments), ShouldEqual, 1)
+				So(issue.Comments[0].Content, ShouldStartWith, "A cluster of failures has been found with reason: Check failed: \ufffd\n...\nat main.cc:10")
+			})
 			Convey("With test name failure cluster", func() {
 				c.Description.Title = "ninja://:blink_web_tests/media/my-suite/my-test.html"
 				c.Description.Description = "A test is failing " + c.Description.Title
@@ -131,6 +151,16 @@ func TestManager(t *testing.T) {
 				So(err, ShouldEqual, bugs.ErrCreateSimulated)
 				So(len(f.Issues), ShouldEqual, 0)
 			})
+			Convey("Defers creation if throttled", func() {
+				bm.Throttle = newThrottle(&config.MonorailQuota{MaxChangesPerRun: 1}, &tokenBucket{})
+				_, err := bm.Create(ctx, c)
+				So(err, ShouldBeNil)
+				So(len(f.Issues), ShouldEqual, 1)
+
+				_, err = bm.Create(ctx, c)
+				So(err, ShouldResemble, &bugs.DeferredError{Reason: bugs.DeferredRunQuota})
+				So(len(f.Issues), ShouldEqual, 1)
+			})
 		})
 		Convey("Update", func() {
 			c := NewCreateRequest()
@@ -148,7 +178,7 @@ func TestManager(t *testing.T) {
 			bugsToUpdate := []*bugs.BugToUpdate{bugToUpdate}
 			updateDoesNothing := func() {
 				originalIssues := CopyIssuesStore(f)
-				err := bm.Update(ctx, bugsToUpdate)
+				_, err := bm.Update(ctx, bugsToUpdate)
 				So(err, ShouldBeNil)
 				So(f, ShouldResembleIssuesStore, originalIssues)
 			}
@@ -172,7 +202,7 @@ func TestManager(t *testing.T) {
 				Convey("Reduces priority in response to reduced impact", func() {
 					bugToUpdate.Impact = ChromiumP2Impact()
 					originalNotifyCount := f.Issues[0].NotifyCount
-					err := bm.Update(ctx, bugsToUpdate)
+					_, err := bm.Update(ctx, bugsToUpdate)
 					So(err, ShouldBeNil)
 					So(ChromiumTestIssuePriority(f.Issues[0].Issue), ShouldEqual, "2")
 
@@ -191,7 +221,7 @@ func TestManager(t *testing.T) {
 					bugToUpdate.Impact = ChromiumP0Impact()
 
 					originalNotifyCount := f.Issues[0].NotifyCount
-					err := bm.Update(ctx, bugsToUpdate)
+					_, err := bm.Update(ctx, bugsToUpdate)
 					So(err, ShouldBeNil)
 					So(ChromiumTestIssuePriority(f.Issues[0].Issue), ShouldEqual, "0")
 
@@ -215,7 +245,7 @@ func TestManager(t *testing.T) {
 					SortLabels(expectedIssue.Labels)
 
 					So(f.Issues[0].NotifyCount, ShouldEqual, 1)
-					err = bm.Update(ctx, bugsToUpdate)
+					_, err = bm.Update(ctx, bugsToUpdate)
 					So(err, ShouldBeNil)
 					So(f.Issues[0].Issue, ShouldResembleProto, expectedIssue)
 
@@ -231,7 +261,7 @@ func TestManager(t *testing.T) {
 						So(err, ShouldBeNil)
 						So(hasLabel(f.Issues[0].Issue, manualPriorityLabel), ShouldBeFalse)
 
-						err := bm.Update(ctx, bugsToUpdate)
+						_, err := bm.Update(ctx, bugsToUpdate)
 						So(err, ShouldBeNil)
 						So(ChromiumTestIssuePriority(f.Issues[0].Issue), ShouldEqual, "3")
 
@@ -261,12 +291,12 @@ func TestManager(t *testing.T) {
 					// Update may reduce the priority from P1 to P3, but the
 					// issue should be left open. This is because hysteresis on
 					// priority and issue verified state is applied separately.
-					err := bm.Update(ctx, bugsToUpdate)
+					_, err := bm.Update(ctx, bugsToUpdate)
 					So(err, ShouldBeNil)
 					So(f.Issues[0].Issue.Status.Status, ShouldEqual, UntriagedStatus)
 				})
 				Convey("Update closes bug", func() {
-					err := bm.Update(ctx, bugsToUpdate)
+					_, err := bm.Update(ctx, bugsToUpdate)
 					So(err, ShouldBeNil)
 					So(f.Issues[0].Issue.Status.Status, ShouldEqual, VerifiedStatus)
 
@@ -289,7 +319,7 @@ func TestManager(t *testing.T) {
 							So(f.Issues[0].Issue.Owner.GetUser(), ShouldEqual, "users/100")
 
 							// Issue should return to "Assigned" status.
-							err := bm.Update(ctx, bugsToUpdate)
+							_, err := bm.Update(ctx, bugsToUpdate)
 							So(err, ShouldBeNil)
 							So(f.Issues[0].Issue.Status.Status, ShouldEqual, AssignedStatus)
 							So(ChromiumTestIssuePriority(f.Issues[0].Issue), ShouldEqual, "3")
@@ -299,7 +329,7 @@ func TestManager(t *testing.T) {
 						})
 						Convey("Issue has no owner", func() {
 							// Issue should return to "Untriaged" status.
-							err := bm.Update(ctx, bugsToUpdate)
+							_, err := bm.Update(ctx, bugsToUpdate)
 							So(err, ShouldBeNil)
 							So(f.Issues[0].Issue.Status.Status, ShouldEqual, UntriagedStatus)
 							So(ChromiumTestIssuePriority(f.Issues[0].Issue), ShouldEqual, "3")
@@ -311,6 +341,208 @@ func TestManager(t *testing.T) {
 				})
 			})
 		})
+		Convey("Throttled update", func() {
+			// Create three bugs, at P1.
+			var bugsToUpdate []*bugs.BugToUpdate
+			for i := 0; i < 3; i++ {
+				c := NewCreateRequest()
+				c.Impact = ChromiumP1Impact()
+				bug, err := bm.Create(ctx, c)
+				So(err, ShouldBeNil)
+				bugsToUpdate = append(bugsToUpdate, &bugs.BugToUpdate{BugName: bug})
+			}
+			So(len(f.Issues), ShouldEqual, 3)
+
+			// The priority of chromium/100 was set manually, so only a
+			// comment is added.
+			usercl, err := NewClient(UseFakeIssuesClient(ctx, f, "users/100"), "myhost")
+			So(err, ShouldBeNil)
+			err = usercl.ModifyIssues(ctx, updateBugPriorityRequest(f.Issues[0].Issue.Name, "2"))
+			So(err, ShouldBeNil)
+			bugsToUpdate[0].Impact = ChromiumP3Impact()
+			// chromium/101 is lowered to P3.
+			bugsToUpdate[1].Impact = ChromiumP3Impact()
+			// chromium/102 is raised to P0.
+			bugsToUpdate[2].Impact = ChromiumP0Impact()
+
+			Convey("Makes high priority updates first", func() {
+				bm.Throttle = newThrottle(&config.MonorailQuota{MaxChangesPerRun: 2}, &tokenBucket{})
+				deferred, err := bm.Update(ctx, bugsToUpdate)
+				So(err, ShouldBeNil)
+				So(deferred, ShouldResemble, []*bugs.DeferredUpdate{
+					{BugName: "chromium/100", Reason: bugs.DeferredRunQuota},
+				})
+				So(ChromiumTestIssuePriority(f.Issues[2].Issue), ShouldEqual, "0")
+				So(ChromiumTestIssuePriority(f.Issues[1].Issue), ShouldEqual, "3")
+				So(hasLabel(f.Issues[0].Issue, manualPriorityLabel), ShouldBeFalse)
+
+				// The deferred update is made in the next run.
+				bm.Throttle = newThrottle(&config.MonorailQuota{MaxChangesPerRun: 2}, &tokenBucket{})
+				deferred, err = bm.Update(ctx, bugsToUpdate)
+				So(err, ShouldBeNil)
+				So(deferred, ShouldBeEmpty)
+				So(hasLabel(f.Issues[0].Issue, manualPriorityLabel), ShouldBeTrue)
+			})
+			Convey("Limits changes per minute", func() {
+				bm.Throttle = newThrottle(&config.MonorailQuota{MaxChangesPerMinute: 1}, &tokenBucket{})
+				deferred, err := bm.Update(ctx, bugsToUpdate)
+				So(err, ShouldBeNil)
+				So(deferred, ShouldResemble, []*bugs.DeferredUpdate{
+					{BugName: "chromium/101", Reason: bugs.DeferredMinuteQuota},
+					{BugName: "chromium/100", Reason: bugs.DeferredMinuteQuota},
+				})
+				So(ChromiumTestIssuePriority(f.Issues[2].Issue), ShouldEqual, "0")
+				So(ChromiumTestIssuePriority(f.Issues[1].Issue), ShouldEqual, "1")
+			})
+			Convey("Does not defer updates in simulation mode", func() {
+				bm.Simulate = true
+				bm.Throttle = newThrottle(&config.MonorailQuota{MaxChangesPerRun: 1}, &tokenBucket{})
+				originalIssues := CopyIssuesStore(f)
+				deferred, err := bm.Update(ctx, bugsToUpdate)
+				So(err, ShouldBeNil)
+				So(deferred, ShouldBeEmpty)
+				So(f, ShouldResembleIssuesStore, originalIssues)
+			})
+		})
+		Convey("ReadClosed", func() {
+			var bugNames []string
+			for i := 0; i < 3; i++ {
+				c := NewCreateRequest()
+				c.Impact = ChromiumP1Impact()
+				bug, err := bm.Create(ctx, c)
+				So(err, ShouldBeNil)
+				bugNames = append(bugNames, bug)
+			}
+			f.Issues[1].Issue.Status.Status = "Fixed"
+			f.Issues[2].Issue.Status.Status = VerifiedStatus
+
+			closed, err := bm.ReadClosed(ctx, bugNames)
+			So(err, ShouldBeNil)
+			So(closed, ShouldResemble, map[string]bool{
+				"chromium/100": false,
+				"chromium/101": true,
+				"chromium/102": true,
+			})
+
+			Convey("Without bugs", func() {
+				closed, err := bm.ReadClosed(ctx, nil)
+				So(err, ShouldBeNil)
+				So(closed, ShouldBeEmpty)
+			})
+			Convey("With invalid bug", func() {
+				_, err := bm.ReadClosed(ctx, []string{"invalid"})
+				So(err, ShouldErrLike, `invalid bug "invalid"`)
+			})
+		})
+		Convey("ReadFixed", func() {
+			var bugNames []string
+			for i := 0; i < 4; i++ {
+				c := NewCreateRequest()
+				c.Impact = ChromiumP1Impact()
+				bug, err := bm.Create(ctx, c)
+				So(err, ShouldBeNil)
+				bugNames = append(bugNames, bug)
+			}
+			f.Issues[1].Issue.Status.Status = "Fixed"
+			f.Issues[2].Issue.Status.Status = VerifiedStatus
+			f.Issues[3].Issue.Status.Status = "Duplicate"
+
+			fixed, err := bm.ReadFixed(ctx, bugNames)
+			So(err, ShouldBeNil)
+			So(fixed, ShouldResemble, map[string]bool{
+				"chromium/100": false,
+				"chromium/101": true,
+				"chromium/102": true,
+				"chromium/103": false,
+			})
+
+			Convey("With invalid bug", func() {
+				_, err := bm.ReadFixed(ctx, []string{"invalid"})
+				So(err, ShouldErrLike, `invalid bug "invalid"`)
+			})
+		})
+		Convey("NotifyRecurrence", func() {
+			c := NewCreateRequest()
+			c.Impact = ChromiumP1Impact()
+			bug, err := bm.Create(ctx, c)
+			So(err, ShouldBeNil)
+			f.Issues[0].Issue.Status.Status = "Fixed"
+			originalNotifyCount := f.Issues[0].NotifyCount
+
+			Convey("Reopen", func() {
+				Convey("Without owner", func() {
+					err := bm.NotifyRecurrence(ctx, bug, true)
+					So(err, ShouldBeNil)
+					So(f.Issues[0].Issue.Status.Status, ShouldEqual, UntriagedStatus)
+				})
+				Convey("With owner", func() {
+					f.Issues[0].Issue.Owner = &mpb.Issue_UserValue{User: "users/100"}
+					err := bm.NotifyRecurrence(ctx, bug, true)
+					So(err, ShouldBeNil)
+					So(f.Issues[0].Issue.Status.Status, ShouldEqual, AssignedStatus)
+				})
+				comments := f.Issues[0].Comments
+				So(comments[len(comments)-1].Content, ShouldContainSubstring, "The bug has been re-opened.")
+				So(f.Issues[0].NotifyCount, ShouldEqual, originalNotifyCount+1)
+			})
+			Convey("Comment", func() {
+				err := bm.NotifyRecurrence(ctx, bug, false)
+				So(err, ShouldBeNil)
+				So(f.Issues[0].Issue.Status.Status, ShouldEqual, "Fixed")
+				comments := f.Issues[0].Comments
+				So(comments[len(comments)-1].Content, ShouldContainSubstring, "If the fix was incomplete, please re-open the bug.")
+				So(f.Issues[0].NotifyCount, ShouldEqual, originalNotifyCount+1)
+			})
+			Convey("Throttled", func() {
+				bm.Throttle = newThrottle(&config.MonorailQuota{MaxChangesPerRun: 1}, &tokenBucket{})
+				So(bm.NotifyRecurrence(ctx, bug, true), ShouldBeNil)
+
+				f.Issues[0].Issue.Status.Status = "Fixed"
+				err := bm.NotifyRecurrence(ctx, bug, true)
+				So(err, ShouldResemble, &bugs.DeferredError{Reason: bugs.DeferredRunQuota})
+				So(f.Issues[0].Issue.Status.Status, ShouldEqual, "Fixed")
+			})
+			Convey("Simulated", func() {
+				bm.Simulate = true
+				originalIssues := CopyIssuesStore(f)
+				err := bm.NotifyRecurrence(ctx, bug, true)
+				So(err, ShouldBeNil)
+				So(f, ShouldResembleIssuesStore, originalIssues)
+			})
+			Convey("With invalid bug", func() {
+				err := bm.NotifyRecurrence(ctx, "invalid", true)
+				So(err, ShouldErrLike, `invalid bug "invalid"`)
+			})
+		})
+		Convey("ReadPriorities", func() {
+			var bugNames []string
+			for _, impact := range []*bugs.ClusterImpact{ChromiumP0Impact(), ChromiumP1Impact(), ChromiumP2Impact()} {
+				c := NewCreateRequest()
+				c.Impact = impact
+				bug, err := bm.Create(ctx, c)
+				So(err, ShouldBeNil)
+				bugNames = append(bugNames, bug)
+			}
+			f.Issues[1].Issue.Status.Status = "Fixed"
+
+			priorities, err := bm.ReadPriorities(ctx, bugNames)
+			So(err, ShouldBeNil)
+			So(priorities, ShouldResemble, map[string]string{
+				"chromium/100": "0",
+				"chromium/102": "2",
+			})
+
+			Convey("Without priority", func() {
+				f.Issues[0].Issue.FieldValues = nil
+				priorities, err := bm.ReadPriorities(ctx, bugNames[:1])
+				So(err, ShouldBeNil)
+				So(priorities, ShouldResemble, map[string]string{"chromium/100": ""})
+			})
+			Convey("With invalid bug", func() {
+				_, err := bm.ReadPriorities(ctx, []string{"invalid"})
+				So(err, ShouldErrLike, `invalid bug "invalid"`)
+			})
+		})
 	})
 }
 
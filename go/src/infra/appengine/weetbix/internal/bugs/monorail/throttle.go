@@ -0,0 +1,105 @@
+// Copyright 2021 The Chromium Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package monorail
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"infra/appengine/weetbix/internal/bugs"
+	"infra/appengine/weetbix/internal/config"
+
+	"go.chromium.org/luci/common/clock"
+)
+
+// minuteBucket limits the rate of changes made to monorail by this
+// process. It is shared by the runs for all LUCI projects, as these
+// share the monorail API quota of the service.
+var minuteBucket = &tokenBucket{}
+
+// Throttle limits the changes (issue creations and modifications) made
+// to monorail during one run of the bug updater, to stay within the
+// monorail API quota.
+type Throttle struct {
+	// remaining is the number of changes that may still be made in this
+	// run. Negative if unlimited.
+	remaining int64
+	// perMinute is the maximum number of changes per minute. Zero if
+	// unlimited.
+	perMinute int64
+	bucket    *tokenBucket
+}
+
+// NewThrottle returns a Throttle for a new run of the bug updater, which
+// applies the given quota. If the quota is nil, changes are not
+// throttled.
+func NewThrottle(quota *config.MonorailQuota) *Throttle {
+	return newThrottle(quota, minuteBucket)
+}
+
+func newThrottle(quota *config.MonorailQuota, bucket *tokenBucket) *Throttle {
+	t := &Throttle{
+		remaining: quota.GetMaxChangesPerRun(),
+		perMinute: quota.GetMaxChangesPerMinute(),
+		bucket:    bucket,
+	}
+	if t.remaining == 0 {
+		t.remaining = -1
+	}
+	return t
+}
+
+// acquire reserves quota for one change. If the change may not be made,
+// it returns false and the reason.
+func (t *Throttle) acquire(ctx context.Context) (bugs.DeferReason, bool) {
+	if t == nil {
+		return "", true
+	}
+	if t.remaining == 0 {
+		return bugs.DeferredRunQuota, false
+	}
+	if t.perMinute > 0 && !t.bucket.take(clock.Now(ctx), t.perMinute) {
+		return bugs.DeferredMinuteQuota, false
+	}
+	if t.remaining > 0 {
+		t.remaining--
+	}
+	return "", true
+}
+
+// tokenBucket implements a token bucket, refilled at a rate of a given
+// number of tokens per minute, and holding at most that number of tokens.
+type tokenBucket struct {
+	mu     sync.Mutex
+	tokens float64
+	// last is the time the bucket was last refilled. Zero if the bucket
+	// has not been used yet.
+	last time.Time
+}
+
+// take takes a token from the bucket, if there is one.
+func (b *tokenBucket) take(now time.Time, perMinute int64) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	capacity := float64(perMinute)
+	if b.last.IsZero() {
+		b.tokens = capacity
+	} else if now.After(b.last) {
+		b.tokens += now.Sub(b.last).Minutes() * capacity
+	}
+	if b.tokens > capacity {
+		// The capacity may have been reduced since the last call.
+		b.tokens = capacity
+	}
+	if now.After(b.last) {
+		b.last = now
+	}
+	if b.tokens < 1 {
+		return false
+	}
+	b.tokens--
+	return true
+}
@@ -0,0 +1,68 @@
+// Copyright 2021 The Chromium Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package monorail
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"infra/appengine/weetbix/internal/bugs"
+	"infra/appengine/weetbix/internal/config"
+
+	. "github.com/smartystreets/goconvey/convey"
+	"go.chromium.org/luci/common/clock/testclock"
+)
+
+func TestThrottle(t *testing.T) {
+	t.Parallel()
+
+	Convey("With Throttle", t, func() {
+		now := time.Date(2021, time.December, 1, 12, 0, 0, 0, time.UTC)
+		ctx, tc := testclock.UseTime(context.Background(), now)
+		bucket := &tokenBucket{}
+
+		acquire := func(th *Throttle, n int) []bugs.DeferReason {
+			var reasons []bugs.DeferReason
+			for i := 0; i < n; i++ {
+				reason, _ := th.acquire(ctx)
+				reasons = append(reasons, reason)
+			}
+			return reasons
+		}
+
+		Convey("Unlimited", func() {
+			So(acquire(nil, 3), ShouldResemble, []bugs.DeferReason{"", "", ""})
+			So(acquire(NewThrottle(nil), 3), ShouldResemble, []bugs.DeferReason{"", "", ""})
+		})
+		Convey("Limits changes per run", func() {
+			th := newThrottle(&config.MonorailQuota{MaxChangesPerRun: 2}, bucket)
+			So(acquire(th, 3), ShouldResemble, []bugs.DeferReason{"", "", bugs.DeferredRunQuota})
+
+			// A new run gets a new budget.
+			th = newThrottle(&config.MonorailQuota{MaxChangesPerRun: 2}, bucket)
+			So(acquire(th, 1), ShouldResemble, []bugs.DeferReason{""})
+		})
+		Convey("Limits changes per minute across runs", func() {
+			quota := &config.MonorailQuota{MaxChangesPerMinute: 2}
+			So(acquire(newThrottle(quota, bucket), 3), ShouldResemble, []bugs.DeferReason{"", "", bugs.DeferredMinuteQuota})
+			So(acquire(newThrottle(quota, bucket), 1), ShouldResemble, []bugs.DeferReason{bugs.DeferredMinuteQuota})
+
+			// One token is refilled after 30 seconds.
+			tc.Add(30 * time.Second)
+			So(acquire(newThrottle(quota, bucket), 2), ShouldResemble, []bugs.DeferReason{"", bugs.DeferredMinuteQuota})
+
+			// The bucket holds at most one minute of tokens.
+			tc.Add(time.Hour)
+			So(acquire(newThrottle(quota, bucket), 3), ShouldResemble, []bugs.DeferReason{"", "", bugs.DeferredMinuteQuota})
+		})
+		Convey("Changes deferred per minute do not use the run budget", func() {
+			th := newThrottle(&config.MonorailQuota{MaxChangesPerRun: 2, MaxChangesPerMinute: 1}, bucket)
+			So(acquire(th, 2), ShouldResemble, []bugs.DeferReason{"", bugs.DeferredMinuteQuota})
+			tc.Add(time.Minute)
+			So(acquire(th, 2), ShouldResemble, []bugs.DeferReason{"", bugs.DeferredRunQuota})
+		})
+	})
+}
@@ -6,12 +6,14 @@ package updater
 
 import (
 	"context"
+	"time"
 
 	"infra/appengine/weetbix/internal/analysis"
 	"infra/appengine/weetbix/internal/bugs"
 	"infra/appengine/weetbix/internal/bugs/monorail"
 	"infra/appengine/weetbix/internal/clustering/runs"
 	"infra/appengine/weetbix/internal/config"
+	"infra/appengine/weetbix/internal/config/compiledcfg"
 
 	"go.chromium.org/luci/common/errors"
 	"go.chromium.org/luci/common/logging"
@@ -28,60 +30,82 @@ type AnalysisClient interface {
 }
 
 // UpdateAnalysisAndBugs updates BigQuery analysis, and then updates bugs
-// to reflect this analysis.
+// to reflect this analysis, for the given LUCI project. The outcome is
+// recorded in the project's update status, see ReadStatuses.
+// Quota, if set, limits the changes made to monorail by the update.
+// Deadline, if set, is the time by which the update must complete.
 // Simulate, if true, avoids any changes being applied to monorail and logs
 // the changes which would be made instead. This must be set when running
 // on developer computers as Weetbix-initiated monorail changes will appear
 // on monorail as the developer themselves rather than the Weetbix service.
 // This leads to bugs errounously being detected as having manual priority
 // changes.
-func UpdateAnalysisAndBugs(ctx context.Context, monorailHost, projectID string, simulate bool) error {
-	mc, err := monorail.NewClient(ctx, monorailHost)
+func UpdateAnalysisAndBugs(ctx context.Context, monorailHost string, quota *config.MonorailQuota, gcpProject, project string, simulate bool, deadline time.Time) error {
+	projectCfg, err := config.Projects(ctx)
 	if err != nil {
 		return err
 	}
-	ac, err := analysis.NewClient(ctx, projectID)
+	if _, ok := projectCfg[project]; !ok {
+		// The project was removed since the update was scheduled.
+		logging.Warningf(ctx, "Project %s has no config, skipping update of analysis and bugs.", project)
+		return nil
+	}
+	cfg, err := compiledcfg.Project(ctx, project, time.Time{})
 	if err != nil {
-		return err
+		return errors.Annotate(err, "read project config").Err()
 	}
-	projectCfg, err := config.Projects(ctx)
+	mc, err := monorail.NewClient(ctx, monorailHost)
 	if err != nil {
 		return err
 	}
-	var errs []error
-	// In future, the 10 minute GAE request limit may mean we need to
-	// parallelise these tasks or fan them out as separate tasks.
-	for project, cfg := range projectCfg {
-		opts := updateOptions{
-			project:            project,
-			analysisClient:     ac,
-			monorailClient:     mc,
-			projectConfig:      cfg,
-			simulateBugUpdates: simulate,
-			maxBugsFiledPerRun: 1,
-		}
-		// Isolate other projects from bug update errors
-		// in one project.
-		err := updateAnalysisAndBugsForProject(ctx, opts)
-		if err != nil {
-			err = errors.Annotate(err, "in project %v", project).Err()
-			logging.Errorf(ctx, "Updating analysis and bugs: %s", err)
-			errs = append(errs, err)
-		}
+	ac, err := analysis.NewClient(ctx, gcpProject)
+	if err != nil {
+		return err
 	}
-	if len(errs) > 0 {
-		return errors.NewMultiError(errs...)
+	opts := updateOptions{
+		project:            project,
+		analysisClient:     ac,
+		monorailClient:     mc,
+		monorailQuota:      quota,
+		projectConfig:      cfg,
+		simulateBugUpdates: simulate,
+		maxBugsFiledPerRun: 1,
+		deadline:           deadline,
 	}
-	return nil
+	return updateAndRecordStatus(ctx, opts)
 }
 
 type updateOptions struct {
 	project            string
 	analysisClient     AnalysisClient
 	monorailClient     *monorail.Client
-	projectConfig      *config.ProjectConfig
+	monorailQuota      *config.MonorailQuota
+	projectConfig      *compiledcfg.ProjectConfig
 	simulateBugUpdates bool
 	maxBugsFiledPerRun int
+	deadline           time.Time
+}
+
+// updateAndRecordStatus updates analysis and bugs for a LUCI project,
+// and records the outcome in the project's update status.
+func updateAndRecordStatus(ctx context.Context, opts updateOptions) error {
+	// Apply the deadline to the update only, so that the outcome can still
+	// be recorded if the deadline is exceeded.
+	updateCtx := ctx
+	if !opts.deadline.IsZero() {
+		var cancel context.CancelFunc
+		updateCtx, cancel = context.WithDeadline(ctx, opts.deadline)
+		defer cancel()
+	}
+	if err := updateAnalysisAndBugsForProject(updateCtx, opts); err != nil {
+		err = errors.Annotate(err, "in project %v", opts.project).Err()
+		logging.Errorf(ctx, "Updating analysis and bugs: %s", err)
+		if rerr := recordFailure(ctx, opts.project, err); rerr != nil {
+			logging.Errorf(ctx, "Recording update failure: %s", rerr)
+		}
+		return err
+	}
+	return recordSuccess(ctx, opts.project)
 }
 
 // updateAnalysisAndBugsForProject updates BigQuery analysis, and
@@ -98,16 +122,17 @@ func updateAnalysisAndBugsForProject(ctx context.Context, opts updateOptions) er
 		return errors.Annotate(err, "update cluster summaries").Err()
 	}
 
-	monorailCfg := opts.projectConfig.Monorail
-	thresholds := opts.projectConfig.BugFilingThreshold
+	monorailCfg := opts.projectConfig.Config.Monorail
 	mgrs := make(map[string]BugManager)
 
 	mbm := monorail.NewBugManager(opts.monorailClient, monorailCfg)
 	mbm.Simulate = opts.simulateBugUpdates
+	mbm.Throttle = monorail.NewThrottle(opts.monorailQuota)
 	mgrs[bugs.MonorailSystem] = mbm
 
-	bu := NewBugUpdater(opts.project, mgrs, opts.analysisClient, thresholds)
+	bu := NewBugUpdater(opts.project, mgrs, opts.analysisClient, opts.projectConfig)
 	bu.MaxBugsFiledPerRun = opts.maxBugsFiledPerRun
+	bu.BuildStepBugFilingThreshold = opts.projectConfig.Config.BuildFailures.GetBugFilingThreshold()
 	if err := bu.Run(ctx, progress); err != nil {
 		return errors.Annotate(err, "update bugs").Err()
 	}
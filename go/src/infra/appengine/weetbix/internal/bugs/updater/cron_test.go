@@ -0,0 +1,131 @@
+// Copyright 2021 The Chromium Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package updater
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"infra/appengine/weetbix/internal/bugs/monorail"
+	"infra/appengine/weetbix/internal/clustering/algorithms"
+	"infra/appengine/weetbix/internal/clustering/rules"
+	"infra/appengine/weetbix/internal/clustering/runs"
+	"infra/appengine/weetbix/internal/config"
+	"infra/appengine/weetbix/internal/config/compiledcfg"
+	"infra/appengine/weetbix/internal/testutil"
+
+	. "github.com/smartystreets/goconvey/convey"
+	"go.chromium.org/luci/common/clock/testclock"
+	. "go.chromium.org/luci/common/testing/assertions"
+	"go.chromium.org/luci/server/span"
+)
+
+func TestUpdateAndRecordStatus(t *testing.T) {
+	Convey("With Spanner Test Database", t, func() {
+		ctx := testutil.SpannerTestContext(t)
+		now := time.Date(2021, time.December, 1, 12, 0, 0, 0, time.UTC)
+		ctx, tc := testclock.UseTime(ctx, now)
+
+		f := &monorail.FakeIssuesStore{
+			NextID:            100,
+			PriorityFieldName: "projects/chromium/fieldDefs/11",
+		}
+		user := monorail.AutomationUsers[0]
+		mc, err := monorail.NewClient(monorail.UseFakeIssuesClient(ctx, f, user), "myhost")
+		So(err, ShouldBeNil)
+
+		configVersion := time.Date(2021, time.February, 1, 1, 0, 0, 0, time.UTC)
+		projectCfg, err := compiledcfg.NewConfig(&config.ProjectConfig{
+			Monorail:           monorail.ChromiumTestConfig(),
+			BugFilingThreshold: &config.ImpactThreshold{},
+		}, configVersion)
+		So(err, ShouldBeNil)
+		optsFor := func(project string, ac AnalysisClient) updateOptions {
+			return updateOptions{
+				project:            project,
+				analysisClient:     ac,
+				monorailClient:     mc,
+				projectConfig:      projectCfg,
+				maxBugsFiledPerRun: 1,
+			}
+		}
+
+		err = runs.SetRunsForTesting(ctx, []*runs.ReclusteringRun{
+			runs.NewRun(0).
+				WithProject("project-a").
+				WithAlgorithmsVersion(algorithms.AlgorithmsVersion).
+				WithRulesVersion(rules.StartingEpoch).
+				WithConfigVersion(configVersion).
+				WithCompletedProgress().Build(),
+			runs.NewRun(1).
+				WithProject("project-b").
+				WithAlgorithmsVersion(algorithms.AlgorithmsVersion).
+				WithRulesVersion(rules.StartingEpoch).
+				WithConfigVersion(configVersion).
+				WithCompletedProgress().Build(),
+		})
+		So(err, ShouldBeNil)
+
+		readStatuses := func() []*ProjectStatus {
+			statuses, err := ReadStatuses(span.Single(ctx))
+			So(err, ShouldBeNil)
+			return statuses
+		}
+
+		Convey("Without updates", func() {
+			So(readStatuses(), ShouldResemble, []*ProjectStatus{})
+		})
+		Convey("Failing project does not affect other projects", func() {
+			failing := &fakeAnalysisClient{rebuildErr: errors.New("bigquery unavailable")}
+			healthy := &fakeAnalysisClient{}
+
+			err := updateAndRecordStatus(ctx, optsFor("project-a", failing))
+			So(err, ShouldErrLike, "bigquery unavailable")
+			err = updateAndRecordStatus(ctx, optsFor("project-b", healthy))
+			So(err, ShouldBeNil)
+
+			So(healthy.analysisBuilt, ShouldBeTrue)
+			So(readStatuses(), ShouldResemble, []*ProjectStatus{
+				{
+					Project:       "project-a",
+					LastErrorTime: now,
+					LastError:     "in project project-a: update cluster summaries: bigquery unavailable",
+				},
+				{
+					Project:         "project-b",
+					LastSuccessTime: now,
+				},
+			})
+
+			Convey("Success retains the last error", func() {
+				tc.Add(15 * time.Minute)
+				err := updateAndRecordStatus(ctx, optsFor("project-a", healthy))
+				So(err, ShouldBeNil)
+
+				statuses := readStatuses()
+				So(statuses[0], ShouldResemble, &ProjectStatus{
+					Project:         "project-a",
+					LastSuccessTime: now.Add(15 * time.Minute),
+					LastErrorTime:   now,
+					LastError:       "in project project-a: update cluster summaries: bigquery unavailable",
+				})
+			})
+		})
+		Convey("Exceeded deadline is recorded", func() {
+			opts := optsFor("project-a", &fakeAnalysisClient{})
+			opts.deadline = now.Add(-time.Minute)
+
+			err := updateAndRecordStatus(ctx, opts)
+			So(err, ShouldNotBeNil)
+
+			statuses := readStatuses()
+			So(statuses, ShouldHaveLength, 1)
+			So(statuses[0].LastSuccessTime, ShouldBeZeroValue)
+			So(statuses[0].LastErrorTime, ShouldEqual, now)
+			So(statuses[0].LastError, ShouldContainSubstring, "in project project-a")
+		})
+	})
+}
@@ -0,0 +1,162 @@
+// Copyright 2021 The Chromium Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package updater
+
+import (
+	"context"
+	"time"
+
+	"cloud.google.com/go/spanner"
+
+	"go.chromium.org/luci/common/clock"
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/common/tsmon/field"
+	"go.chromium.org/luci/common/tsmon/metric"
+	"go.chromium.org/luci/server/span"
+
+	"infra/appengine/weetbix/internal/bugs"
+	spanutil "infra/appengine/weetbix/internal/span"
+)
+
+// Kinds of deferred actions.
+const (
+	// createAction files a bug for a suggested cluster. The subject
+	// is the cluster key, "{algorithm}:{id}".
+	createAction = "create"
+	// updateAction updates a bug. The subject is the bug, as
+	// "{system}:{id}".
+	updateAction = "update"
+)
+
+var deferredActionsCounter = metric.NewCounter(
+	"weetbix/bug_updater/deferred_actions",
+	"The number of changes to bug-tracking systems deferred to a later run of the bug updater, by LUCI Project, action and reason.",
+	nil,
+	// The LUCI Project.
+	field.String("project"),
+	// The action, "create" or "update".
+	field.String("action"),
+	// The reason the action was deferred, e.g. "run_quota".
+	field.String("reason"))
+
+// deferredAction is a change to a bug-tracking system which was deferred
+// because changes were throttled. Only the intent to act is recorded;
+// the content of the change is recomputed from the latest analysis when
+// it is attempted again.
+type deferredAction struct {
+	Action  string
+	Subject string
+	Reason  bugs.DeferReason
+	// The time the action was first deferred.
+	FirstDeferredTime time.Time
+}
+
+// readDeferredActions reads the actions deferred by the last run of the
+// bug updater for the given project, ordered by the time they were first
+// deferred.
+func readDeferredActions(ctx context.Context, project string) ([]*deferredAction, error) {
+	stmt := spanner.NewStatement(`
+		SELECT Action, Subject, Reason, FirstDeferredTime
+		FROM DeferredBugActions
+		WHERE Project = @project
+		ORDER BY FirstDeferredTime, Action, Subject
+	`)
+	stmt.Params["project"] = project
+	it := span.Query(span.Single(ctx), stmt)
+	actions := []*deferredAction{}
+	err := it.Do(func(r *spanner.Row) error {
+		var action, subject, reason string
+		var firstDeferredTime time.Time
+		if err := r.Columns(&action, &subject, &reason, &firstDeferredTime); err != nil {
+			return errors.Annotate(err, "read deferred action row").Err()
+		}
+		actions = append(actions, &deferredAction{
+			Action:            action,
+			Subject:           subject,
+			Reason:            bugs.DeferReason(reason),
+			FirstDeferredTime: firstDeferredTime,
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return actions, nil
+}
+
+// writeDeferredActions replaces the deferred actions of the given project.
+func writeDeferredActions(ctx context.Context, project string, actions []*deferredAction) error {
+	ms := []*spanner.Mutation{
+		spanner.Delete("DeferredBugActions", spanner.Key{project}.AsPrefix()),
+	}
+	for _, a := range actions {
+		ms = append(ms, spanutil.InsertMap("DeferredBugActions", map[string]interface{}{
+			"Project":           project,
+			"Action":            a.Action,
+			"Subject":           a.Subject,
+			"Reason":            string(a.Reason),
+			"FirstDeferredTime": a.FirstDeferredTime,
+		}))
+	}
+	if _, err := span.Apply(ctx, ms); err != nil {
+		return errors.Annotate(err, "write deferred bug actions").Err()
+	}
+	return nil
+}
+
+// deferredQueue tracks the actions deferred in a run of the bug updater.
+type deferredQueue struct {
+	project string
+	// previous are the actions deferred by the last run, by key.
+	previous map[string]*deferredAction
+	// next are the actions to carry over to the next run.
+	next []*deferredAction
+}
+
+// newDeferredQueue returns a queue of the actions deferred in a new run
+// of the bug updater, given the actions deferred by the last run.
+func newDeferredQueue(project string, previous []*deferredAction) *deferredQueue {
+	q := &deferredQueue{
+		project:  project,
+		previous: make(map[string]*deferredAction),
+	}
+	for _, a := range previous {
+		q.previous[actionKey(a.Action, a.Subject)] = a
+	}
+	return q
+}
+
+// previousAction returns the given action if it was deferred by the last
+// run, or nil otherwise.
+func (q *deferredQueue) previousAction(action, subject string) *deferredAction {
+	return q.previous[actionKey(action, subject)]
+}
+
+// deferAction records that the given action was deferred in this run.
+func (q *deferredQueue) deferAction(ctx context.Context, action, subject string, reason bugs.DeferReason) {
+	firstDeferredTime := clock.Now(ctx)
+	if a := q.previousAction(action, subject); a != nil {
+		firstDeferredTime = a.FirstDeferredTime
+	}
+	q.next = append(q.next, &deferredAction{
+		Action:            action,
+		Subject:           subject,
+		Reason:            reason,
+		FirstDeferredTime: firstDeferredTime,
+	})
+	deferredActionsCounter.Add(ctx, 1, q.project, action, string(reason))
+}
+
+// carryOver keeps the given action, deferred by the last run, in the
+// queue without attempting it in this run.
+func (q *deferredQueue) carryOver(action, subject string) {
+	if a := q.previousAction(action, subject); a != nil {
+		q.next = append(q.next, a)
+	}
+}
+
+func actionKey(action, subject string) string {
+	return action + "/" + subject
+}
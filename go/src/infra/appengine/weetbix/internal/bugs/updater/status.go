@@ -0,0 +1,101 @@
+// Copyright 2021 The Chromium Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package updater
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"cloud.google.com/go/spanner"
+
+	"go.chromium.org/luci/common/clock"
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/server/span"
+
+	spanutil "infra/appengine/weetbix/internal/span"
+)
+
+// maxErrorLength is the maximum length of an error message recorded in
+// the status of a project, in bytes.
+const maxErrorLength = 10000
+
+// ProjectStatus is the status of analysis and bug updates for a LUCI
+// project.
+type ProjectStatus struct {
+	// The LUCI Project.
+	Project string
+	// The time the last successful update completed. Zero if no update
+	// has succeeded.
+	LastSuccessTime time.Time
+	// The time the last failed update completed. Zero if no update has
+	// failed.
+	LastErrorTime time.Time
+	// The error that caused the last failed update.
+	LastError string
+}
+
+// ReadStatuses reads the update status of all LUCI projects that have
+// been updated at least once, ordered by project.
+func ReadStatuses(ctx context.Context) ([]*ProjectStatus, error) {
+	stmt := spanner.NewStatement(`
+		SELECT Project, LastSuccessTime, LastErrorTime, LastError
+		FROM ProjectUpdateStatus
+		ORDER BY Project
+	`)
+	it := span.Query(ctx, stmt)
+	statuses := []*ProjectStatus{}
+	err := it.Do(func(r *spanner.Row) error {
+		var project string
+		var lastSuccessTime, lastErrorTime spanner.NullTime
+		var lastError spanner.NullString
+		if err := r.Columns(&project, &lastSuccessTime, &lastErrorTime, &lastError); err != nil {
+			return errors.Annotate(err, "read status row").Err()
+		}
+		statuses = append(statuses, &ProjectStatus{
+			Project:         project,
+			LastSuccessTime: lastSuccessTime.Time,
+			LastErrorTime:   lastErrorTime.Time,
+			LastError:       lastError.StringVal,
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return statuses, nil
+}
+
+// recordSuccess records a successful update of the given project.
+func recordSuccess(ctx context.Context, project string) error {
+	return applyStatus(ctx, map[string]interface{}{
+		"Project":         project,
+		"LastSuccessTime": clock.Now(ctx),
+	})
+}
+
+// recordFailure records a failed update of the given project.
+func recordFailure(ctx context.Context, project string, updateErr error) error {
+	msg := updateErr.Error()
+	if len(msg) > maxErrorLength {
+		// Avoid leaving a partial UTF-8 sequence at the end.
+		msg = strings.ToValidUTF8(msg[:maxErrorLength], "")
+	}
+	return applyStatus(ctx, map[string]interface{}{
+		"Project":       project,
+		"LastErrorTime": clock.Now(ctx),
+		"LastError":     msg,
+	})
+}
+
+// applyStatus writes the given columns of a project's status. Columns not
+// specified retain their existing values.
+func applyStatus(ctx context.Context, values map[string]interface{}) error {
+	ms := spanutil.InsertOrUpdateMap("ProjectUpdateStatus", values)
+	if _, err := span.Apply(ctx, []*spanner.Mutation{ms}); err != nil {
+		return errors.Annotate(err, "write project update status").Err()
+	}
+	return nil
+}
@@ -0,0 +1,262 @@
+// Copyright 2021 The Chromium Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package updater
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"go.chromium.org/luci/common/clock"
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/common/logging"
+	"go.chromium.org/luci/server/span"
+
+	"infra/appengine/weetbix/internal/bugs"
+	"infra/appengine/weetbix/internal/clustering/rules"
+	"infra/appengine/weetbix/internal/clustering/runs"
+	"infra/appengine/weetbix/internal/config"
+)
+
+// recurAction notes on a fixed bug that failures recurred. The subject is
+// the bug, as "{system}:{id}".
+const recurAction = "recur"
+
+// recurrenceGracePeriod is the time after a bug is first observed to be
+// fixed during which failures are not considered recurrences. Impact is
+// measured over the last day, so it includes failures from before the fix
+// until a day has passed.
+const recurrenceGracePeriod = 24 * time.Hour
+
+// bugSyncState is the state of the synchronisation of a rule with the
+// status of its bug.
+type bugSyncState struct {
+	// The time the bug was first observed to be fixed. Zero if the bug
+	// is not fixed.
+	FixedTime time.Time
+	// The time since which the cluster has had no impact, while the bug
+	// is fixed. Zero if the bug is not fixed or the cluster has impact.
+	ImpactFreeSince time.Time
+	// Whether failures recurred after the bug was fixed.
+	Recurred bool
+}
+
+// bugSyncDecision is the outcome of a step of the synchronisation of a rule
+// with the status of its bug.
+type bugSyncDecision struct {
+	// The next state of the synchronisation.
+	Next bugSyncState
+	// The actions to record in the history of the rule, in order.
+	Actions []string
+	// Whether to notify the bug that failures recurred.
+	Notify bool
+	// Whether to reopen the bug when notifying it.
+	Reopen bool
+	// Whether to archive the rule.
+	Archive bool
+}
+
+// syncState returns the state of the synchronisation of the given rule
+// with the status of its bug.
+func syncState(r *rules.FailureAssociationRule) bugSyncState {
+	return bugSyncState{
+		FixedTime:       r.BugFixedTime,
+		ImpactFreeSince: r.ImpactFreeSince,
+		Recurred:        r.IsRecurred,
+	}
+}
+
+// decideBugSync decides how to synchronise a rule in the given state with
+// the status of its bug, given whether the bug is fixed and the impact of
+// the rule's cluster at time now.
+//
+// To avoid flapping, failures are only considered to recur once the grace
+// period after the fix has passed and their impact meets the recurrence
+// threshold, and the bug is notified at most once each time it is fixed.
+// Impact below the threshold does not recur, but delays archival.
+func decideBugSync(cfg *config.BugStatusSync, now time.Time, s bugSyncState, fixed bool, impact *bugs.ClusterImpact) *bugSyncDecision {
+	d := &bugSyncDecision{Next: s}
+	if !fixed {
+		if !s.FixedTime.IsZero() {
+			d.Next.FixedTime = time.Time{}
+			d.Next.ImpactFreeSince = time.Time{}
+			d.Actions = append(d.Actions, rules.ActionBugReopened)
+		}
+		return d
+	}
+	if s.FixedTime.IsZero() {
+		d.Next = bugSyncState{FixedTime: now}
+		d.Actions = append(d.Actions, rules.ActionBugFixed)
+	}
+	if d.Next.Recurred {
+		// The bug was already notified of the recurrence, and remains
+		// fixed. Leave it to its owner.
+		return d
+	}
+	if now.Sub(d.Next.FixedTime) >= recurrenceGracePeriod && impact.MeetsThreshold(cfg.RecurrenceThreshold) {
+		d.Next.Recurred = true
+		d.Next.ImpactFreeSince = time.Time{}
+		d.Notify = true
+		d.Reopen = cfg.RecurrenceAction != config.BugStatusSync_COMMENT
+		if d.Reopen {
+			d.Next.FixedTime = time.Time{}
+		}
+		d.Actions = append(d.Actions, rules.ActionRecurred)
+		return d
+	}
+	if !impactFree(impact) {
+		d.Next.ImpactFreeSince = time.Time{}
+		return d
+	}
+	if d.Next.ImpactFreeSince.IsZero() {
+		d.Next.ImpactFreeSince = now
+	}
+	confirmation := time.Duration(cfg.ConfirmationDays) * 24 * time.Hour
+	if now.Sub(d.Next.ImpactFreeSince) >= confirmation {
+		d.Archive = true
+		d.Actions = append(d.Actions, rules.ActionArchived)
+	}
+	return d
+}
+
+// impactFree returns whether the given impact is zero over the last day.
+func impactFree(impact *bugs.ClusterImpact) bool {
+	return impact.TestResultsFailed.OneDay == 0 &&
+		impact.TestRunsFailed.OneDay == 0 &&
+		impact.PresubmitRunsFailed.OneDay == 0
+}
+
+// historyDetails returns a human-readable description of the given action.
+func historyDetails(action string, d *bugSyncDecision, cfg *config.BugStatusSync) string {
+	switch action {
+	case rules.ActionBugFixed:
+		return "The bug was marked fixed."
+	case rules.ActionBugReopened:
+		return "The bug is no longer marked fixed."
+	case rules.ActionRecurred:
+		if d.Reopen {
+			return "Failures recurred after the bug was fixed. The bug was re-opened."
+		}
+		return "Failures recurred after the bug was fixed. The bug was commented on."
+	case rules.ActionArchived:
+		return fmt.Sprintf("No failures were observed for %v days after the bug was fixed. The rule was archived.", cfg.ConfirmationDays)
+	default:
+		return ""
+	}
+}
+
+// syncBugStatuses synchronises the given active rules with the status of
+// their bugs, per the project's bug status sync configuration. Only rules
+// whose latest version has been incorporated into the analysis are
+// synchronised. Recurrence notifications which are throttled are deferred
+// to the next run, and the rule is left unchanged until then.
+//
+// It returns the IDs of the rules whose bugs are fixed and remain active.
+// The bugs of these rules are managed by synchronisation and must not
+// otherwise be updated.
+func (b *BugUpdater) syncBugStatuses(ctx context.Context, cfg *config.BugStatusSync, ruleByID map[string]*rules.FailureAssociationRule, impactByRuleID map[string]*bugs.ClusterImpact, progress *runs.ReclusteringProgress, queue *deferredQueue) (map[string]struct{}, error) {
+	rulesBySystem := make(map[string][]*rules.FailureAssociationRule)
+	for _, r := range ruleByID {
+		if !progress.IncorporatesRulesVersion(r.LastUpdated) {
+			queue.carryOver(recurAction, bugSubject(r.Bug.System, r.Bug.ID))
+			continue
+		}
+		rulesBySystem[r.Bug.System] = append(rulesBySystem[r.Bug.System], r)
+	}
+
+	now := clock.Now(ctx)
+	fixedRuleIDs := make(map[string]struct{})
+	for system, rs := range rulesBySystem {
+		manager, ok := b.managers[system]
+		if !ok {
+			logging.Warningf(ctx, "Encountered bug(s) with an unrecognised manager: %q", system)
+			continue
+		}
+		// Notify bugs whose notification was deferred by the last run first.
+		// Order the remaining rules by bug, so the order is stable.
+		sort.Slice(rs, func(i, j int) bool {
+			return rs[i].Bug.ID < rs[j].Bug.ID
+		})
+		sort.SliceStable(rs, func(i, j int) bool {
+			return deferredBefore(
+				queue.previousAction(recurAction, bugSubject(system, rs[i].Bug.ID)),
+				queue.previousAction(recurAction, bugSubject(system, rs[j].Bug.ID)))
+		})
+		var bugNames []string
+		for _, r := range rs {
+			bugNames = append(bugNames, r.Bug.ID)
+		}
+		fixedByBug, err := manager.ReadFixed(ctx, bugNames)
+		if err != nil {
+			return nil, errors.Annotate(err, "read fixed bugs").Err()
+		}
+		for _, r := range rs {
+			fixed := fixedByBug[r.Bug.ID]
+			impact, ok := impactByRuleID[r.RuleID]
+			if !ok {
+				impact = &bugs.ClusterImpact{}
+			}
+			d := decideBugSync(cfg, now, syncState(r), fixed, impact)
+			if d.Notify {
+				err := manager.NotifyRecurrence(ctx, r.Bug.ID, d.Reopen)
+				if derr, ok := err.(*bugs.DeferredError); ok {
+					queue.deferAction(ctx, recurAction, bugSubject(system, r.Bug.ID), derr.Reason)
+					fixedRuleIDs[r.RuleID] = struct{}{}
+					continue
+				}
+				if err != nil {
+					return nil, errors.Annotate(err, "notify recurrence on bug %s", r.Bug.ID).Err()
+				}
+			}
+			if err := applyBugSync(ctx, cfg, r, d); err != nil {
+				return nil, errors.Annotate(err, "sync rule %s", r.RuleID).Err()
+			}
+			if !d.Next.FixedTime.IsZero() && !d.Archive {
+				fixedRuleIDs[r.RuleID] = struct{}{}
+			}
+		}
+	}
+	return fixedRuleIDs, nil
+}
+
+// applyBugSync records the decision to synchronise the given rule with the
+// status of its bug. The rule is left unchanged if it was modified or
+// archived since it was read.
+func applyBugSync(ctx context.Context, cfg *config.BugStatusSync, rule *rules.FailureAssociationRule, d *bugSyncDecision) error {
+	if d.Next == syncState(rule) && len(d.Actions) == 0 {
+		return nil
+	}
+	_, err := span.ReadWriteTransaction(ctx, func(ctx context.Context) error {
+		r, err := rules.Read(ctx, rule.Project, rule.RuleID)
+		if err != nil {
+			return err
+		}
+		if !r.LastUpdated.Equal(rule.LastUpdated) || !r.IsActive {
+			return nil
+		}
+		if d.Archive {
+			r.IsActive = false
+			if err := rules.Update(ctx, r, rules.WeetbixSystem); err != nil {
+				return err
+			}
+		}
+		rules.UpdateBugSync(ctx, r.Project, r.RuleID, d.Next.FixedTime, d.Next.ImpactFreeSince, d.Next.Recurred)
+		for _, action := range d.Actions {
+			err := rules.RecordHistory(ctx, &rules.HistoryEntry{
+				Project: r.Project,
+				RuleID:  r.RuleID,
+				Action:  action,
+				User:    rules.WeetbixSystem,
+				Details: historyDetails(action, d, cfg),
+			})
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	return err
+}
@@ -0,0 +1,149 @@
+// Copyright 2021 The Chromium Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package updater
+
+import (
+	"testing"
+	"time"
+
+	"infra/appengine/weetbix/internal/bugs"
+	"infra/appengine/weetbix/internal/clustering/rules"
+	"infra/appengine/weetbix/internal/config"
+
+	. "github.com/smartystreets/goconvey/convey"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestDecideBugSync(t *testing.T) {
+	t.Parallel()
+
+	Convey("decideBugSync", t, func() {
+		cfg := &config.BugStatusSync{
+			ConfirmationDays: 7,
+			RecurrenceThreshold: &config.ImpactThreshold{
+				TestResultsFailed: &config.MetricThreshold{OneDay: proto.Int64(10)},
+			},
+			RecurrenceAction: config.BugStatusSync_REOPEN,
+		}
+		fixedTime := time.Date(2021, time.February, 1, 0, 0, 0, 0, time.UTC)
+		impact := func(failures int64) *bugs.ClusterImpact {
+			return &bugs.ClusterImpact{
+				TestResultsFailed: bugs.MetricImpact{OneDay: failures},
+			}
+		}
+
+		Convey("Open bug", func() {
+			d := decideBugSync(cfg, fixedTime, bugSyncState{}, false, impact(100))
+			So(d, ShouldResemble, &bugSyncDecision{})
+		})
+		Convey("Open bug remains recurred", func() {
+			s := bugSyncState{Recurred: true}
+			d := decideBugSync(cfg, fixedTime, s, false, impact(0))
+			So(d, ShouldResemble, &bugSyncDecision{Next: s})
+		})
+		Convey("Bug fixed", func() {
+			Convey("With impact", func() {
+				d := decideBugSync(cfg, fixedTime, bugSyncState{}, true, impact(100))
+				So(d, ShouldResemble, &bugSyncDecision{
+					Next:    bugSyncState{FixedTime: fixedTime},
+					Actions: []string{rules.ActionBugFixed},
+				})
+			})
+			Convey("Without impact", func() {
+				d := decideBugSync(cfg, fixedTime, bugSyncState{}, true, impact(0))
+				So(d, ShouldResemble, &bugSyncDecision{
+					Next:    bugSyncState{FixedTime: fixedTime, ImpactFreeSince: fixedTime},
+					Actions: []string{rules.ActionBugFixed},
+				})
+			})
+			Convey("After recurrence", func() {
+				d := decideBugSync(cfg, fixedTime, bugSyncState{Recurred: true}, true, impact(0))
+				So(d.Next, ShouldResemble, bugSyncState{FixedTime: fixedTime, ImpactFreeSince: fixedTime})
+				So(d.Actions, ShouldResemble, []string{rules.ActionBugFixed})
+			})
+		})
+		Convey("Bug re-opened", func() {
+			s := bugSyncState{FixedTime: fixedTime, ImpactFreeSince: fixedTime}
+			d := decideBugSync(cfg, fixedTime.Add(time.Hour), s, false, impact(0))
+			So(d, ShouldResemble, &bugSyncDecision{
+				Actions: []string{rules.ActionBugReopened},
+			})
+		})
+		Convey("Recurrence", func() {
+			s := bugSyncState{FixedTime: fixedTime}
+			now := fixedTime.Add(recurrenceGracePeriod)
+
+			Convey("Re-opens bug", func() {
+				d := decideBugSync(cfg, now, s, true, impact(10))
+				So(d, ShouldResemble, &bugSyncDecision{
+					Next:    bugSyncState{Recurred: true},
+					Actions: []string{rules.ActionRecurred},
+					Notify:  true,
+					Reopen:  true,
+				})
+			})
+			Convey("Re-opens bug by default", func() {
+				cfg.RecurrenceAction = config.BugStatusSync_RECURRENCE_ACTION_UNSPECIFIED
+				d := decideBugSync(cfg, now, s, true, impact(10))
+				So(d.Reopen, ShouldBeTrue)
+			})
+			Convey("Comments on bug", func() {
+				cfg.RecurrenceAction = config.BugStatusSync_COMMENT
+				d := decideBugSync(cfg, now, s, true, impact(10))
+				So(d, ShouldResemble, &bugSyncDecision{
+					Next:    bugSyncState{FixedTime: fixedTime, Recurred: true},
+					Actions: []string{rules.ActionRecurred},
+					Notify:  true,
+				})
+
+				Convey("Only once", func() {
+					d := decideBugSync(cfg, now.Add(time.Hour), d.Next, true, impact(100))
+					So(d, ShouldResemble, &bugSyncDecision{Next: bugSyncState{FixedTime: fixedTime, Recurred: true}})
+				})
+				Convey("Rule not archived", func() {
+					d := decideBugSync(cfg, now.Add(30*24*time.Hour), d.Next, true, impact(0))
+					So(d.Archive, ShouldBeFalse)
+				})
+			})
+			Convey("Within grace period", func() {
+				d := decideBugSync(cfg, now.Add(-time.Minute), s, true, impact(100))
+				So(d, ShouldResemble, &bugSyncDecision{Next: s})
+			})
+			Convey("Below threshold", func() {
+				d := decideBugSync(cfg, now, s, true, impact(9))
+				So(d, ShouldResemble, &bugSyncDecision{Next: s})
+			})
+		})
+		Convey("Archival", func() {
+			s := bugSyncState{FixedTime: fixedTime, ImpactFreeSince: fixedTime}
+
+			Convey("Within confirmation window", func() {
+				d := decideBugSync(cfg, fixedTime.Add(7*24*time.Hour-time.Minute), s, true, impact(0))
+				So(d, ShouldResemble, &bugSyncDecision{Next: s})
+			})
+			Convey("After confirmation window", func() {
+				d := decideBugSync(cfg, fixedTime.Add(7*24*time.Hour), s, true, impact(0))
+				So(d, ShouldResemble, &bugSyncDecision{
+					Next:    s,
+					Actions: []string{rules.ActionArchived},
+					Archive: true,
+				})
+			})
+			Convey("Any impact restarts confirmation window", func() {
+				now := fixedTime.Add(3 * 24 * time.Hour)
+				d := decideBugSync(cfg, now, s, true, &bugs.ClusterImpact{
+					PresubmitRunsFailed: bugs.MetricImpact{OneDay: 1},
+				})
+				So(d, ShouldResemble, &bugSyncDecision{Next: bugSyncState{FixedTime: fixedTime}})
+
+				d = decideBugSync(cfg, now.Add(time.Hour), d.Next, true, impact(0))
+				So(d.Next.ImpactFreeSince, ShouldEqual, now.Add(time.Hour))
+
+				d = decideBugSync(cfg, fixedTime.Add(7*24*time.Hour), d.Next, true, impact(0))
+				So(d.Archive, ShouldBeFalse)
+			})
+		})
+	})
+}
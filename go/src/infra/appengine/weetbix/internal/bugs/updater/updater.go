@@ -8,6 +8,7 @@ import (
 	"context"
 	"crypto/rand"
 	"encoding/hex"
+	"sort"
 
 	"infra/appengine/weetbix/internal/analysis"
 	"infra/appengine/weetbix/internal/bugs"
@@ -15,9 +16,9 @@ import (
 	"infra/appengine/weetbix/internal/clustering/algorithms"
 	"infra/appengine/weetbix/internal/clustering/algorithms/rulesalgorithm"
 	"infra/appengine/weetbix/internal/clustering/rules"
-	"infra/appengine/weetbix/internal/clustering/rules/lang"
 	"infra/appengine/weetbix/internal/clustering/runs"
 	"infra/appengine/weetbix/internal/config"
+	"infra/appengine/weetbix/internal/config/compiledcfg"
 	pb "infra/appengine/weetbix/proto/v1"
 
 	"go.chromium.org/luci/common/errors"
@@ -30,10 +31,21 @@ import (
 // cluster.
 type BugManager interface {
 	// Create creates a new bug for the given request, returning its name,
-	// or any encountered error.
+	// or any encountered error. If changes to the bug-tracking system
+	// are throttled, it returns a *bugs.DeferredError.
 	Create(ctx context.Context, cluster *bugs.CreateRequest) (string, error)
-	// Update updates the specified list of bugs.
-	Update(ctx context.Context, bugs []*bugs.BugToUpdate) error
+	// Update updates the specified list of bugs, in order of importance,
+	// and returns the updates which were deferred because changes to the
+	// bug-tracking system are throttled. Where updates are of equal
+	// importance, bugs listed earlier are updated first.
+	Update(ctx context.Context, bugs []*bugs.BugToUpdate) ([]*bugs.DeferredUpdate, error)
+	// ReadFixed reads which of the given bugs are fixed, keyed by bug name.
+	ReadFixed(ctx context.Context, bugNames []string) (map[string]bool, error)
+	// NotifyRecurrence notes on the given fixed bug that failures recurred
+	// after it was fixed, and reopens it if reopen is set. If changes to
+	// the bug-tracking system are throttled, it returns a
+	// *bugs.DeferredError.
+	NotifyRecurrence(ctx context.Context, bugName string, reopen bool) error
 }
 
 // BugUpdater performs updates to Monorail bugs and BugClusters to keep them
@@ -46,21 +58,30 @@ type BugUpdater struct {
 	// managers stores the manager responsible for updating bugs for each
 	// bug tracking system (monorail, buganizer, etc.).
 	managers map[string]BugManager
+	// projectCfg is the compiled configuration of the project. Suggested
+	// clusters are identified using it.
+	projectCfg *compiledcfg.ProjectConfig
 	// bugFilingThreshold is the threshold at which bugs should be filed.
 	bugFilingThreshold *config.ImpactThreshold
+	// BuildStepBugFilingThreshold is the threshold at which bugs should be
+	// filed for clusters of build step failures. If nil, no bugs are filed
+	// for such clusters.
+	BuildStepBugFilingThreshold *config.ImpactThreshold
 	// MaxBugsFiledPerRun is the maximum number of bugs to file each time
 	// BugUpdater runs. This throttles the rate of changes to monorail.
 	MaxBugsFiledPerRun int
 }
 
-// NewBugUpdater initialises a new BugUpdater. The specified impact thresholds are used
-// when determining whether to a file a bug.
-func NewBugUpdater(project string, mgrs map[string]BugManager, ac AnalysisClient, bugFilingThreshold *config.ImpactThreshold) *BugUpdater {
+// NewBugUpdater initialises a new BugUpdater. The impact thresholds of the
+// specified project configuration are used when determining whether to a
+// file a bug.
+func NewBugUpdater(project string, mgrs map[string]BugManager, ac AnalysisClient, projectCfg *compiledcfg.ProjectConfig) *BugUpdater {
 	return &BugUpdater{
 		project:            project,
 		managers:           mgrs,
 		analysisClient:     ac,
-		bugFilingThreshold: bugFilingThreshold,
+		projectCfg:         projectCfg,
+		bugFilingThreshold: projectCfg.Config.BugFilingThreshold,
 		MaxBugsFiledPerRun: 1, // Default value.
 	}
 }
@@ -68,6 +89,8 @@ func NewBugUpdater(project string, mgrs map[string]BugManager, ac AnalysisClient
 // Run updates files/updates bugs to match high-impact clusters as
 // identified by analysis. Each bug has a corresponding failure association
 // rule.
+// Changes deferred because the bug-tracking system is throttled are
+// recorded, and attempted before other changes in the next run.
 // The passed progress should reflect the progress of re-clustering as captured
 // in the latest analysis.
 func (b *BugUpdater) Run(ctx context.Context, progress *runs.ReclusteringProgress) error {
@@ -81,6 +104,12 @@ func (b *BugUpdater) Run(ctx context.Context, progress *runs.ReclusteringProgres
 		return nil
 	}
 
+	previous, err := readDeferredActions(ctx, b.project)
+	if err != nil {
+		return errors.Annotate(err, "read deferred bug actions").Err()
+	}
+	queue := newDeferredQueue(b.project, previous)
+
 	ruleByID, err := b.readActiveFailureAssociationRules(ctx)
 	if err != nil {
 		return errors.Annotate(err, "read active failure association rules").Err()
@@ -114,9 +143,10 @@ func (b *BugUpdater) Run(ctx context.Context, progress *runs.ReclusteringProgres
 	//    reached the threshold to file a new bug for, we want to read
 	//    them, so we can file a bug.
 	clusterSummaries, err := b.analysisClient.ReadImpactfulClusters(ctx, analysis.ImpactfulClusterReadOptions{
-		Project:       b.project,
-		Thresholds:    b.bugFilingThreshold,
-		AlwaysInclude: bugClusterIDs,
+		Project:             b.project,
+		Thresholds:          b.bugFilingThreshold,
+		BuildStepThresholds: b.BuildStepBugFilingThreshold,
+		AlwaysInclude:       bugClusterIDs,
 	})
 	if err != nil {
 		return errors.Annotate(err, "read impactful clusters").Err()
@@ -145,22 +175,51 @@ func (b *BugUpdater) Run(ctx context.Context, progress *runs.ReclusteringProgres
 			continue
 		}
 
-		// Only file a bug if the residual impact exceeds the threshold.
+		// Only file a bug if the residual impact exceeds the threshold
+		// for the kind of failures in the cluster.
+		threshold := b.bugFilingThreshold
+		if clusterSummary.FailureKind == pb.FailureKind_BUILD_STEP_FAILURE.String() {
+			threshold = b.BuildStepBugFilingThreshold
+			if threshold == nil {
+				continue
+			}
+		}
 		impact := bugs.ExtractResidualImpact(clusterSummary)
-		if !impact.MeetsThreshold(b.bugFilingThreshold) {
+		if !impact.MeetsThreshold(threshold) {
 			continue
 		}
 
 		toCreateBugsFor = append(toCreateBugsFor, clusterSummary)
 	}
 
+	// File bugs for clusters whose bug filing was deferred by the last
+	// run first.
+	sort.SliceStable(toCreateBugsFor, func(i, j int) bool {
+		return deferredBefore(
+			queue.previousAction(createAction, toCreateBugsFor[i].ClusterID.Key()),
+			queue.previousAction(createAction, toCreateBugsFor[j].ClusterID.Key()))
+	})
+
+	// Suggested clusters are only comparable to those identified using
+	// the project configuration once re-clustering has incorporated it.
+	fileBugs := progress.IncorporatesConfigVersion(b.projectCfg.LastUpdated)
+	if !fileBugs && len(toCreateBugsFor) > 0 {
+		logging.Warningf(ctx, "Auto-bug filing paused for project %s as re-clustering to new project configuration is in progress.", b.project)
+	}
+
 	bugsFiled := 0
 	for _, clusterSummary := range toCreateBugsFor {
 		// Throttle how many bugs may be filed each time.
-		if bugsFiled >= b.MaxBugsFiledPerRun {
-			break
+		if !fileBugs || bugsFiled >= b.MaxBugsFiledPerRun {
+			// Bug filing deferred by the last run remains deferred.
+			queue.carryOver(createAction, clusterSummary.ClusterID.Key())
+			continue
 		}
 		created, err := b.createBug(ctx, clusterSummary)
+		if derr, ok := err.(*bugs.DeferredError); ok {
+			queue.deferAction(ctx, createAction, clusterSummary.ClusterID.Key(), derr.Reason)
+			continue
+		}
 		if err != nil {
 			return err
 		}
@@ -169,9 +228,22 @@ func (b *BugUpdater) Run(ctx context.Context, progress *runs.ReclusteringProgres
 		}
 	}
 
+	// Synchronise rules with the status of their bugs, if configured.
+	// The bugs of rules whose bugs are fixed are left to synchronisation.
+	var fixedRuleIDs map[string]struct{}
+	if cfg := b.projectCfg.Config.GetBugStatusSync(); cfg != nil {
+		fixedRuleIDs, err = b.syncBugStatuses(ctx, cfg, ruleByID, impactByRuleID, progress, queue)
+		if err != nil {
+			return errors.Annotate(err, "sync bug statuses").Err()
+		}
+	}
+
 	// Iterate over all active bug clusters (except those we just created).
 	bugUpdatesBySystem := make(map[string][]*bugs.BugToUpdate)
 	for id, r := range ruleByID {
+		if _, ok := fixedRuleIDs[id]; ok {
+			continue
+		}
 		impact, ok := impactByRuleID[id]
 		if !ok {
 			// If there is no analysis, this usually means the cluster is
@@ -183,6 +255,8 @@ func (b *BugUpdater) Run(ctx context.Context, progress *runs.ReclusteringProgres
 		// version of this failure association rule. This avoids bugs getting
 		// erroneous priority changes while impact information is incomplete.
 		if !progress.IncorporatesRulesVersion(r.LastUpdated) {
+			// Any update deferred by the last run remains deferred.
+			queue.carryOver(updateAction, bugSubject(r.Bug.System, r.Bug.ID))
 			continue
 		}
 
@@ -200,11 +274,41 @@ func (b *BugUpdater) Run(ctx context.Context, progress *runs.ReclusteringProgres
 			logging.Warningf(ctx, "Encountered bug(s) with an unrecognised manager: %q", manager)
 			continue
 		}
-		if err := manager.Update(ctx, bugsToUpdate); err != nil {
+		// Update bugs whose update was deferred by the last run first.
+		// Order the remaining bugs by name, so the order is stable.
+		sort.Slice(bugsToUpdate, func(i, j int) bool {
+			return bugsToUpdate[i].BugName < bugsToUpdate[j].BugName
+		})
+		sort.SliceStable(bugsToUpdate, func(i, j int) bool {
+			return deferredBefore(
+				queue.previousAction(updateAction, bugSubject(system, bugsToUpdate[i].BugName)),
+				queue.previousAction(updateAction, bugSubject(system, bugsToUpdate[j].BugName)))
+		})
+		deferred, err := manager.Update(ctx, bugsToUpdate)
+		if err != nil {
 			return err
 		}
+		for _, d := range deferred {
+			queue.deferAction(ctx, updateAction, bugSubject(system, d.BugName), d.Reason)
+		}
 	}
-	return nil
+	return writeDeferredActions(ctx, b.project, queue.next)
+}
+
+// deferredBefore returns whether an item whose action was previously
+// deferred as a should be acted on before an item whose action was
+// previously deferred as b. Either may be nil if the action was not
+// deferred. Items whose action was deferred earlier come first.
+func deferredBefore(a, b *deferredAction) bool {
+	if a == nil || b == nil {
+		return a != nil && b == nil
+	}
+	return a.FirstDeferredTime.Before(b.FirstDeferredTime)
+}
+
+// bugSubject returns the subject of a deferred action on the given bug.
+func bugSubject(system, id string) string {
+	return system + ":" + id
 }
 
 // createBug files a new bug for the given suggested cluster,
@@ -218,6 +322,7 @@ func (b *BugUpdater) createBug(ctx context.Context, cs *analysis.ClusterSummary)
 
 	failure := &clustering.Failure{
 		TestID: cs.ExampleTestID,
+		Kind:   pb.FailureKind(pb.FailureKind_value[cs.FailureKind]),
 	}
 	if cs.ExampleFailureReason.Valid {
 		failure.Reason = &pb.FailureReason{PrimaryErrorMessage: cs.ExampleFailureReason.StringVal}
@@ -237,15 +342,15 @@ func (b *BugUpdater) createBug(ctx context.Context, cs *analysis.ClusterSummary)
 	// could result in indefinite creation of new bugs, as the system
 	// will repeatedly create new failure association rules for the
 	// same suggested cluster.
-	if hex.EncodeToString(alg.Cluster(failure)) != cs.ClusterID.ID {
+	if hex.EncodeToString(alg.Cluster(b.projectCfg, failure)) != cs.ClusterID.ID {
 		return false, errors.New("example failure did not match cluster ID")
 	}
-	rule, err := generateFailureAssociationRule(alg, failure)
+	rule, err := algorithms.FailureAssociationRule(b.projectCfg, alg, failure)
 	if err != nil {
 		return false, errors.Annotate(err, "obtain failure association rule").Err()
 	}
 	request := &bugs.CreateRequest{
-		Description: alg.ClusterDescription(failure),
+		Description: alg.ClusterDescription(b.projectCfg, failure),
 		Impact:      bugs.ExtractResidualImpact(cs),
 	}
 
@@ -258,6 +363,11 @@ func (b *BugUpdater) createBug(ctx context.Context, cs *analysis.ClusterSummary)
 		// This is expected.
 		return false, nil
 	}
+	if _, ok := err.(*bugs.DeferredError); ok {
+		// Changes to the bug-tracking system are throttled. Return the
+		// error as-is so the caller can defer the bug filing.
+		return false, err
+	}
 	if err != nil {
 		return false, errors.Annotate(err, "create issue in %v", mgr).Err()
 	}
@@ -281,26 +391,6 @@ func (b *BugUpdater) createBug(ctx context.Context, cs *analysis.ClusterSummary)
 	return true, nil
 }
 
-func generateFailureAssociationRule(alg algorithms.Algorithm, failure *clustering.Failure) (string, error) {
-	rule := alg.FailureAssociationRule(failure)
-
-	// Check the generated rule is valid and matches the failure.
-	// An improperly generated failure association rule could result
-	// in uncontrolled creation of new bugs.
-	expr, err := lang.Parse(rule, "test", "reason")
-	if err != nil {
-		return "", errors.Annotate(err, "rule generated by %s did not parse", alg.Name()).Err()
-	}
-	match := expr.Evaluate(map[string]string{
-		"test":   failure.TestID,
-		"reason": failure.Reason.GetPrimaryErrorMessage(),
-	})
-	if !match {
-		return "", errors.New("rule generated by %s did not match example failure")
-	}
-	return rule, nil
-}
-
 // generateRuleID returns a random 128-bit rule ID, encoded as
 // 32 lowercase hexadecimal characters.
 func generateRuleID() (string, error) {
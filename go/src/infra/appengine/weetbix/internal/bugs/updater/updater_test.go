@@ -23,11 +23,13 @@ import (
 	"infra/appengine/weetbix/internal/clustering/rules"
 	"infra/appengine/weetbix/internal/clustering/runs"
 	"infra/appengine/weetbix/internal/config"
+	"infra/appengine/weetbix/internal/config/compiledcfg"
 	"infra/appengine/weetbix/internal/testutil"
 	pb "infra/appengine/weetbix/proto/v1"
 
 	"cloud.google.com/go/bigquery"
 	. "github.com/smartystreets/goconvey/convey"
+	"go.chromium.org/luci/common/clock/testclock"
 	"go.chromium.org/luci/config/validation"
 	"go.chromium.org/luci/server/span"
 	"google.golang.org/protobuf/proto"
@@ -70,22 +72,26 @@ func TestRun(t *testing.T) {
 			Monorail:           monorailCfg,
 			BugFilingThreshold: thres,
 		}
+		configVersion := time.Date(2021, time.February, 1, 1, 0, 0, 0, time.UTC)
+		compiledCfg, err := compiledcfg.NewConfig(projectCfg, configVersion)
+		So(err, ShouldBeNil)
 
 		opts := updateOptions{
 			project:            project,
 			analysisClient:     ac,
 			monorailClient:     mc,
-			projectConfig:      projectCfg,
+			projectConfig:      compiledCfg,
 			maxBugsFiledPerRun: 1,
 		}
 
 		// Unless otherwise specified, assume re-clustering has caught up to
-		// the latest version of algorithms.
+		// the latest version of algorithms and project configuration.
 		err = runs.SetRunsForTesting(ctx, []*runs.ReclusteringRun{
 			runs.NewRun(0).
 				WithProject(project).
 				WithAlgorithmsVersion(algorithms.AlgorithmsVersion).
 				WithRulesVersion(rules.StartingEpoch).
+				WithConfigVersion(configVersion).
 				WithCompletedProgress().Build(),
 		})
 		So(err, ShouldBeNil)
@@ -214,6 +220,7 @@ func TestRun(t *testing.T) {
 						WithProject(project).
 						WithAlgorithmsVersion(algorithms.AlgorithmsVersion).
 						WithRulesVersion(createTime).
+						WithConfigVersion(configVersion).
 						WithCompletedProgress().Build(),
 				})
 				So(err, ShouldBeNil)
@@ -221,12 +228,33 @@ func TestRun(t *testing.T) {
 				expectCreate = true
 				test()
 			})
+			Convey("Without re-clustering caught up to latest config", func() {
+				err = runs.SetRunsForTesting(ctx, []*runs.ReclusteringRun{
+					runs.NewRun(1).
+						WithProject(project).
+						WithAlgorithmsVersion(algorithms.AlgorithmsVersion).
+						WithRulesVersion(rules.StartingEpoch).
+						WithConfigVersion(configVersion).
+						WithReportedProgress(500).Build(),
+					runs.NewRun(0).
+						WithProject(project).
+						WithAlgorithmsVersion(algorithms.AlgorithmsVersion).
+						WithRulesVersion(rules.StartingEpoch).
+						WithConfigVersion(configVersion.Add(-1 * time.Hour)).
+						WithCompletedProgress().Build(),
+				})
+				So(err, ShouldBeNil)
+
+				expectCreate = false
+				test()
+			})
 			Convey("Without re-clustering caught up to latest algorithms", func() {
 				err = runs.SetRunsForTesting(ctx, []*runs.ReclusteringRun{
 					runs.NewRun(0).
 						WithProject(project).
 						WithAlgorithmsVersion(algorithms.AlgorithmsVersion - 1).
 						WithRulesVersion(rules.StartingEpoch).
+						WithConfigVersion(configVersion).
 						WithCompletedProgress().Build(),
 				})
 				So(err, ShouldBeNil)
@@ -235,6 +263,102 @@ func TestRun(t *testing.T) {
 				test()
 			})
 		})
+		Convey("With a build step cluster above impact threshold", func() {
+			stepFailure := &clustering.Failure{
+				TestID: "compile",
+				Reason: &pb.FailureReason{PrimaryErrorMessage: "Exit code 1"},
+				Kind:   pb.FailureKind_BUILD_STEP_FAILURE,
+			}
+			reasonAlg, err := algorithms.SuggestingAlgorithm(failurereason.AlgorithmName)
+			So(err, ShouldBeNil)
+			sourceClusterID := clustering.ClusterID{
+				Algorithm: failurereason.AlgorithmName,
+				ID:        hex.EncodeToString(reasonAlg.Cluster(compiledCfg, stepFailure)),
+			}
+			suggestedClusters[1].ClusterID = sourceClusterID
+			suggestedClusters[1].ExampleTestID = "compile"
+			suggestedClusters[1].ExampleFailureReason = bigquery.NullString{StringVal: "Exit code 1", Valid: true}
+			suggestedClusters[1].FailureKind = pb.FailureKind_BUILD_STEP_FAILURE.String()
+			suggestedClusters[1].Failures1d.Residual = 100
+
+			Convey("Without build step bug filing threshold", func() {
+				err = updateAnalysisAndBugsForProject(ctx, opts)
+				So(err, ShouldBeNil)
+
+				rs, err := rules.ReadActive(span.Single(ctx), project)
+				So(err, ShouldBeNil)
+				So(rs, ShouldResemble, []*rules.FailureAssociationRule{})
+				So(f.Issues, ShouldBeNil)
+			})
+			Convey("With build step bug filing threshold", func() {
+				projectCfg.BuildFailures = &config.BuildFailures{
+					BugFilingThreshold: &config.ImpactThreshold{
+						TestResultsFailed: &config.MetricThreshold{
+							OneDay: proto.Int64(50),
+						},
+					},
+				}
+				err = updateAnalysisAndBugsForProject(ctx, opts)
+				So(err, ShouldBeNil)
+
+				rs, err := rules.ReadActive(span.Single(ctx), project)
+				So(err, ShouldBeNil)
+				So(rs, ShouldHaveLength, 1)
+				So(rs[0].RuleDefinition, ShouldEqual, `test = "compile" AND reason LIKE "Exit code %"`)
+				So(rs[0].SourceCluster, ShouldResemble, sourceClusterID)
+				So(len(f.Issues), ShouldEqual, 1)
+				So(f.Issues[0].Issue.Summary, ShouldContainSubstring, "compile: Exit code 1")
+			})
+		})
+		Convey("With bug filing throttled", func() {
+			suggestedClusters[1].Failures1d.Residual = 200
+			suggestedClusters[2].Failures1d.Residual = 200
+			opts.maxBugsFiledPerRun = 2
+			opts.monorailQuota = &config.MonorailQuota{MaxChangesPerRun: 1}
+
+			err = updateAnalysisAndBugsForProject(ctx, opts)
+			So(err, ShouldBeNil)
+			So(len(f.Issues), ShouldEqual, 1)
+			So(readDeferred(ctx, project), ShouldResemble, []*deferredAction{
+				{
+					Action:  createAction,
+					Subject: testIDClusterID("testname-2").Key(),
+					Reason:  bugs.DeferredRunQuota,
+				},
+			})
+
+			Convey("Deferred bug filing is carried over", func() {
+				// Another cluster now precedes the deferred cluster.
+				suggestedClusters[3].Failures1d.Residual = 200
+				ac.clusters = []*analysis.ClusterSummary{
+					suggestedClusters[0],
+					suggestedClusters[1],
+					suggestedClusters[3],
+					suggestedClusters[2],
+				}
+
+				err = updateAnalysisAndBugsForProject(ctx, opts)
+				So(err, ShouldBeNil)
+				So(len(f.Issues), ShouldEqual, 2)
+
+				rs, err := rules.ReadActive(span.Single(ctx), project)
+				So(err, ShouldBeNil)
+				var sources []clustering.ClusterID
+				for _, r := range rs {
+					sources = append(sources, r.SourceCluster)
+				}
+				So(sources, ShouldHaveLength, 2)
+				So(sources, ShouldContain, testIDClusterID("testname-1"))
+				So(sources, ShouldContain, testIDClusterID("testname-2"))
+				So(readDeferred(ctx, project), ShouldResemble, []*deferredAction{
+					{
+						Action:  createAction,
+						Subject: testIDClusterID("testname-3").Key(),
+						Reason:  bugs.DeferredRunQuota,
+					},
+				})
+			})
+		})
 		Convey("With multiple suggested clusters above impact thresold", func() {
 			expectBugClusters := func(count int) {
 				bugClusters, err := rules.ReadActive(span.Single(ctx), project)
@@ -366,6 +490,7 @@ func TestRun(t *testing.T) {
 						WithProject(project).
 						WithAlgorithmsVersion(algorithms.AlgorithmsVersion).
 						WithRulesVersion(rs[2].LastUpdated).
+						WithConfigVersion(configVersion).
 						WithCompletedProgress().Build(),
 				})
 				So(err, ShouldBeNil)
@@ -386,6 +511,33 @@ func TestRun(t *testing.T) {
 
 					expectFinalBugClusters()
 				})
+				Convey("Throttled updates are prioritised and carried over", func() {
+					opts.monorailQuota = &config.MonorailQuota{MaxChangesPerRun: 1}
+					bugs.SetResidualImpact(bugClusters[0], monorail.ChromiumP3Impact())
+					bugs.SetResidualImpact(bugClusters[2], monorail.ChromiumP0Impact())
+
+					err = updateAnalysisAndBugsForProject(ctx, opts)
+					So(err, ShouldBeNil)
+
+					// The change to P0 is made before the change to P3.
+					So(monorail.ChromiumTestIssuePriority(f.Issues[2].Issue), ShouldEqual, "0")
+					So(monorail.ChromiumTestIssuePriority(f.Issues[0].Issue), ShouldNotEqual, "3")
+					So(readDeferred(ctx, project), ShouldResemble, []*deferredAction{
+						{
+							Action:  updateAction,
+							Subject: "monorail:chromium/100",
+							Reason:  bugs.DeferredRunQuota,
+						},
+					})
+
+					err = updateAnalysisAndBugsForProject(ctx, opts)
+					So(err, ShouldBeNil)
+
+					So(monorail.ChromiumTestIssuePriority(f.Issues[0].Issue), ShouldEqual, "3")
+					So(readDeferred(ctx, project), ShouldResemble, []*deferredAction{})
+
+					expectFinalBugClusters()
+				})
 				Convey("Decreasing cluster impact decreases issue priority", func() {
 					issue := f.Issues[0].Issue
 					So(issue.Name, ShouldEqual, "projects/chromium/issues/100")
@@ -419,11 +571,206 @@ func TestRun(t *testing.T) {
 					So(issue.Name, ShouldEqual, "projects/chromium/issues/100")
 					So(issue.Status.Status, ShouldEqual, monorail.VerifiedStatus)
 				})
+				Convey("With bug status sync", func() {
+					now := time.Date(2021, time.February, 10, 0, 0, 0, 0, time.UTC)
+					ctx, tc := testclock.UseTime(ctx, now)
+					projectCfg.BugStatusSync = &config.BugStatusSync{
+						ConfirmationDays: 7,
+						RecurrenceThreshold: &config.ImpactThreshold{
+							TestResultsFailed: &config.MetricThreshold{OneDay: proto.Int64(10)},
+						},
+						RecurrenceAction: config.BugStatusSync_REOPEN,
+					}
+					ruleID := rs[0].RuleID
+					issue := f.Issues[0]
+					So(issue.Issue.Name, ShouldEqual, "projects/chromium/issues/100")
+
+					readRule := func() *rules.FailureAssociationRule {
+						r, err := rules.Read(span.Single(ctx), project, ruleID)
+						So(err, ShouldBeNil)
+						return r
+					}
+					readHistory := func() []string {
+						es, err := rules.ReadHistory(span.Single(ctx), project, ruleID)
+						So(err, ShouldBeNil)
+						var actions []string
+						for _, e := range es {
+							So(e.User, ShouldEqual, rules.WeetbixSystem)
+							actions = append(actions, e.Action)
+						}
+						return actions
+					}
+					run := func() {
+						err := updateAnalysisAndBugsForProject(ctx, opts)
+						So(err, ShouldBeNil)
+					}
+
+					Convey("Open bug is not synced", func() {
+						run()
+						r := readRule()
+						So(r.BugFixedTime, ShouldBeZeroValue)
+						So(r.IsRecurred, ShouldBeFalse)
+						So(readHistory(), ShouldBeEmpty)
+					})
+
+					// Fix the bug.
+					issue.Issue.Status.Status = "Fixed"
+					run()
+					So(readRule().BugFixedTime, ShouldEqual, now)
+					So(readHistory(), ShouldResemble, []string{rules.ActionBugFixed})
+
+					// Failures within a day of the fix are not recurrences.
+					originalIssues := monorail.CopyIssuesStore(f)
+					tc.Add(time.Hour)
+					run()
+					So(f, monorail.ShouldResembleIssuesStore, originalIssues)
+
+					Convey("Recurrence re-opens bug", func() {
+						tc.Add(24 * time.Hour)
+						notifyCount := issue.NotifyCount
+						run()
+						So(issue.Issue.Status.Status, ShouldEqual, monorail.UntriagedStatus)
+						So(issue.Comments[len(issue.Comments)-1].Content, ShouldContainSubstring, "The bug has been re-opened.")
+						So(issue.NotifyCount, ShouldEqual, notifyCount+1)
+						r := readRule()
+						So(r.IsRecurred, ShouldBeTrue)
+						So(r.BugFixedTime, ShouldBeZeroValue)
+						So(r.IsActive, ShouldBeTrue)
+						So(readHistory(), ShouldResemble, []string{rules.ActionRecurred, rules.ActionBugFixed})
+
+						// The bug is not notified again.
+						originalIssues := monorail.CopyIssuesStore(f)
+						run()
+						So(f, monorail.ShouldResembleIssuesStore, originalIssues)
+						So(readHistory(), ShouldHaveLength, 2)
+
+						Convey("Fixing the bug again clears the recurrence", func() {
+							issue.Issue.Status.Status = monorail.VerifiedStatus
+							run()
+							r := readRule()
+							So(r.IsRecurred, ShouldBeFalse)
+							So(r.BugFixedTime, ShouldEqual, tc.Now())
+						})
+					})
+					Convey("Recurrence comments on bug", func() {
+						projectCfg.BugStatusSync.RecurrenceAction = config.BugStatusSync_COMMENT
+						tc.Add(24 * time.Hour)
+						run()
+						So(issue.Issue.Status.Status, ShouldEqual, "Fixed")
+						So(issue.Comments[len(issue.Comments)-1].Content, ShouldContainSubstring, "If the fix was incomplete, please re-open the bug.")
+						r := readRule()
+						So(r.IsRecurred, ShouldBeTrue)
+						So(r.BugFixedTime, ShouldEqual, now)
+
+						// The bug is commented on once, and the rule is
+						// not archived once failures stop.
+						originalIssues := monorail.CopyIssuesStore(f)
+						bugs.SetResidualImpact(bugClusters[0], &bugs.ClusterImpact{})
+						run()
+						tc.Add(8 * 24 * time.Hour)
+						run()
+						So(f, monorail.ShouldResembleIssuesStore, originalIssues)
+						So(readRule().IsActive, ShouldBeTrue)
+						So(readHistory(), ShouldResemble, []string{rules.ActionRecurred, rules.ActionBugFixed})
+					})
+					Convey("Throttled recurrence is deferred", func() {
+						f.Issues[1].Issue.Status.Status = "Fixed"
+						run()
+
+						opts.monorailQuota = &config.MonorailQuota{MaxChangesPerRun: 1}
+						bugs.SetResidualImpact(bugClusters[1], &bugs.ClusterImpact{
+							TestResultsFailed: bugs.MetricImpact{OneDay: 100},
+						})
+						tc.Add(24 * time.Hour)
+						run()
+						So(issue.Issue.Status.Status, ShouldEqual, monorail.UntriagedStatus)
+						So(f.Issues[1].Issue.Status.Status, ShouldEqual, "Fixed")
+						So(readDeferred(ctx, project), ShouldResemble, []*deferredAction{
+							{
+								Action:  recurAction,
+								Subject: "monorail:chromium/101",
+								Reason:  bugs.DeferredRunQuota,
+							},
+						})
+						r, err := rules.Read(span.Single(ctx), project, rs[1].RuleID)
+						So(err, ShouldBeNil)
+						So(r.IsRecurred, ShouldBeFalse)
+
+						run()
+						So(f.Issues[1].Issue.Status.Status, ShouldEqual, monorail.UntriagedStatus)
+						So(readDeferred(ctx, project), ShouldResemble, []*deferredAction{})
+					})
+					Convey("Impact below the threshold does not recur", func() {
+						tc.Add(24 * time.Hour)
+						bugs.SetResidualImpact(bugClusters[0], &bugs.ClusterImpact{
+							TestResultsFailed: bugs.MetricImpact{OneDay: 5},
+						})
+						run()
+						So(f, monorail.ShouldResembleIssuesStore, originalIssues)
+						r := readRule()
+						So(r.IsRecurred, ShouldBeFalse)
+						So(r.ImpactFreeSince, ShouldBeZeroValue)
+					})
+					Convey("No impact archives rule", func() {
+						bugs.SetResidualImpact(bugClusters[0], &bugs.ClusterImpact{})
+						run()
+						impactFreeSince := tc.Now()
+						So(readRule().ImpactFreeSince, ShouldEqual, impactFreeSince)
+
+						tc.Add(6 * 24 * time.Hour)
+						run()
+						So(readRule().IsActive, ShouldBeTrue)
+
+						Convey("Flapping impact restarts confirmation", func() {
+							bugs.SetResidualImpact(bugClusters[0], &bugs.ClusterImpact{
+								TestResultsFailed: bugs.MetricImpact{OneDay: 1},
+							})
+							run()
+							So(readRule().ImpactFreeSince, ShouldBeZeroValue)
+
+							bugs.SetResidualImpact(bugClusters[0], &bugs.ClusterImpact{})
+							tc.Add(24 * time.Hour)
+							run()
+							So(readRule().IsActive, ShouldBeTrue)
+							So(readRule().ImpactFreeSince, ShouldEqual, tc.Now())
+						})
+						Convey("Confirmation window elapses", func() {
+							tc.Add(24 * time.Hour)
+							run()
+							r := readRule()
+							So(r.IsActive, ShouldBeFalse)
+							So(r.LastUpdatedUser, ShouldEqual, rules.WeetbixSystem)
+							So(readHistory(), ShouldResemble, []string{rules.ActionArchived, rules.ActionBugFixed})
+							So(f, monorail.ShouldResembleIssuesStore, originalIssues)
+						})
+					})
+					Convey("Re-opening bug clears fix", func() {
+						issue.Issue.Status.Status = monorail.AssignedStatus
+						tc.Add(time.Hour)
+						run()
+						r := readRule()
+						So(r.BugFixedTime, ShouldBeZeroValue)
+						So(r.IsRecurred, ShouldBeFalse)
+						So(readHistory(), ShouldResemble, []string{rules.ActionBugReopened, rules.ActionBugFixed})
+					})
+				})
 			})
 		})
 	})
 }
 
+// readDeferred reads the deferred actions of the given project, clearing
+// the time they were first deferred.
+func readDeferred(ctx context.Context, project string) []*deferredAction {
+	actions, err := readDeferredActions(ctx, project)
+	So(err, ShouldBeNil)
+	for _, a := range actions {
+		So(a.FirstDeferredTime, ShouldNotBeZeroValue)
+		a.FirstDeferredTime = time.Time{}
+	}
+	return actions
+}
+
 func makeSuggestedCluster(uniqifier int) *analysis.ClusterSummary {
 	testID := fmt.Sprintf("testname-%v", uniqifier)
 	return &analysis.ClusterSummary{
@@ -451,7 +798,7 @@ func testIDClusterID(testID string) clustering.ClusterID {
 
 	return clustering.ClusterID{
 		Algorithm: testname.AlgorithmName,
-		ID: hex.EncodeToString(testAlg.Cluster(&clustering.Failure{
+		ID: hex.EncodeToString(testAlg.Cluster(&compiledcfg.ProjectConfig{}, &clustering.Failure{
 			TestID: testID,
 		})),
 	}
@@ -463,7 +810,7 @@ func reasonClusterID(reason string) clustering.ClusterID {
 
 	return clustering.ClusterID{
 		Algorithm: failurereason.AlgorithmName,
-		ID: hex.EncodeToString(reasonAlg.Cluster(&clustering.Failure{
+		ID: hex.EncodeToString(reasonAlg.Cluster(&compiledcfg.ProjectConfig{}, &clustering.Failure{
 			Reason: &pb.FailureReason{PrimaryErrorMessage: reason},
 		})),
 	}
@@ -479,9 +826,14 @@ func bugClusterID(ruleID string) clustering.ClusterID {
 type fakeAnalysisClient struct {
 	analysisBuilt bool
 	clusters      []*analysis.ClusterSummary
+	// rebuildErr, if set, is returned by RebuildAnalysis.
+	rebuildErr error
 }
 
 func (f *fakeAnalysisClient) RebuildAnalysis(ctx context.Context, project string) error {
+	if f.rebuildErr != nil {
+		return f.rebuildErr
+	}
 	f.analysisBuilt = true
 	return nil
 }
@@ -492,24 +844,31 @@ func (f *fakeAnalysisClient) ReadImpactfulClusters(ctx context.Context, opts ana
 	}
 	var results []*analysis.ClusterSummary
 	for _, c := range f.clusters {
+		thresholds := opts.Thresholds
+		if c.FailureKind == pb.FailureKind_BUILD_STEP_FAILURE.String() {
+			thresholds = opts.BuildStepThresholds
+		}
 		include := containsValue(opts.AlwaysInclude, c.ClusterID)
-		if opts.Thresholds.TestResultsFailed != nil {
+		if thresholds.GetTestResultsFailed() != nil {
 			include = include ||
-				exceedsThreshold(c.Failures1d.Residual, opts.Thresholds.TestResultsFailed.OneDay) ||
-				exceedsThreshold(c.Failures3d.Residual, opts.Thresholds.TestResultsFailed.ThreeDay) ||
-				exceedsThreshold(c.Failures7d.Residual, opts.Thresholds.TestResultsFailed.SevenDay)
+				exceedsThreshold(c.Failures1d.Residual, thresholds.TestResultsFailed.OneDay) ||
+				exceedsThreshold(c.Failures3d.Residual, thresholds.TestResultsFailed.ThreeDay) ||
+				exceedsThreshold(c.Failures7d.Residual, thresholds.TestResultsFailed.SevenDay)
 		}
-		if opts.Thresholds.TestRunsFailed != nil {
+		if thresholds.GetTestRunsFailed() != nil {
 			include = include ||
-				exceedsThreshold(c.TestRunFails1d.Residual, opts.Thresholds.TestRunsFailed.OneDay) ||
-				exceedsThreshold(c.TestRunFails3d.Residual, opts.Thresholds.TestRunsFailed.ThreeDay) ||
-				exceedsThreshold(c.TestRunFails7d.Residual, opts.Thresholds.TestRunsFailed.SevenDay)
+				exceedsThreshold(c.TestRunFails1d.Residual, thresholds.TestRunsFailed.OneDay) ||
+				exceedsThreshold(c.TestRunFails3d.Residual, thresholds.TestRunsFailed.ThreeDay) ||
+				exceedsThreshold(c.TestRunFails7d.Residual, thresholds.TestRunsFailed.SevenDay)
 		}
-		if opts.Thresholds.PresubmitRunsFailed != nil {
+		if thresholds.GetPresubmitRunsFailed() != nil {
 			include = include ||
-				exceedsThreshold(c.PresubmitRejects1d.Residual, opts.Thresholds.PresubmitRunsFailed.OneDay) ||
-				exceedsThreshold(c.PresubmitRejects3d.Residual, opts.Thresholds.PresubmitRunsFailed.ThreeDay) ||
-				exceedsThreshold(c.PresubmitRejects7d.Residual, opts.Thresholds.PresubmitRunsFailed.SevenDay)
+				exceedsThreshold(c.PresubmitRejects1d.Residual, thresholds.PresubmitRunsFailed.OneDay) ||
+				exceedsThreshold(c.PresubmitRejects3d.Residual, thresholds.PresubmitRunsFailed.ThreeDay) ||
+				exceedsThreshold(c.PresubmitRejects7d.Residual, thresholds.PresubmitRunsFailed.SevenDay)
+		}
+		if opts.FailureKind != pb.FailureKind_FAILURE_KIND_UNSPECIFIED && c.FailureKind != opts.FailureKind.String() {
+			include = false
 		}
 		if include {
 			results = append(results, c)
@@ -71,3 +71,21 @@ func (c *Client) GetBuildWithBuilderAndRDBInfo(ctx context.Context, id int64) (*
 		},
 	})
 }
+
+// GetBuildWithStatusAndSteps is a shortcut for GetBuild which returns the
+// bbpb.Build that contains the status and steps of the build.
+func (c *Client) GetBuildWithStatusAndSteps(ctx context.Context, id int64) (*bbpb.Build, error) {
+	return c.GetBuild(ctx, &bbpb.GetBuildRequest{
+		Id: id,
+		Mask: &bbpb.BuildMask{
+			Fields: &field_mask.FieldMask{
+				Paths: []string{"status", "steps"},
+			},
+		},
+	})
+}
+
+// SearchBuilds returns a page of builds matching the request.
+func (c *Client) SearchBuilds(ctx context.Context, req *bbpb.SearchBuildsRequest) (*bbpb.SearchBuildsResponse, error) {
+	return c.client.SearchBuilds(ctx, req)
+}
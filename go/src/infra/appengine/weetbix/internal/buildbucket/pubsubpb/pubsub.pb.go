@@ -0,0 +1,248 @@
+// Copyright 2021 The Chromium Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.1
+// 	protoc        v3.17.3
+// source: infra/appengine/weetbix/internal/buildbucket/pubsubpb/pubsub.proto
+
+package pubsubpb
+
+import (
+	proto "go.chromium.org/luci/buildbucket/proto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Compression method used to compress data.
+//
+// See also: Compression in buildbucket/proto/common.proto.
+type Compression int32
+
+const (
+	Compression_ZLIB Compression = 0
+	Compression_ZSTD Compression = 1
+)
+
+// Enum value maps for Compression.
+var (
+	Compression_name = map[int32]string{
+		0: "ZLIB",
+		1: "ZSTD",
+	}
+	Compression_value = map[string]int32{
+		"ZLIB": 0,
+		"ZSTD": 1,
+	}
+)
+
+func (x Compression) Enum() *Compression {
+	p := new(Compression)
+	*p = x
+	return p
+}
+
+func (x Compression) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Compression) Descriptor() protoreflect.EnumDescriptor {
+	return file_infra_appengine_weetbix_internal_buildbucket_pubsubpb_pubsub_proto_enumTypes[0].Descriptor()
+}
+
+func (Compression) Type() protoreflect.EnumType {
+	return &file_infra_appengine_weetbix_internal_buildbucket_pubsubpb_pubsub_proto_enumTypes[0]
+}
+
+func (x Compression) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Compression.Descriptor instead.
+func (Compression) EnumDescriptor() ([]byte, []int) {
+	return file_infra_appengine_weetbix_internal_buildbucket_pubsubpb_pubsub_proto_rawDescGZIP(), []int{0}
+}
+
+// BuildsV2PubSub is the message published to the builds_v2 Pub/Sub topic.
+//
+// Mirrors BuildsV2PubSub in buildbucket/proto/notification.proto, which is
+// not yet available in the pinned version of luci-go.
+type BuildsV2PubSub struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The build with large fields (input.properties, output.properties and
+	// steps) stripped.
+	Build *proto.Build `protobuf:"bytes,1,opt,name=build,proto3" json:"build,omitempty"`
+	// A Build message in binary format, compressed with the method specified
+	// in the compression field. Contains only the fields stripped from build.
+	BuildLargeFields []byte `protobuf:"bytes,2,opt,name=build_large_fields,json=buildLargeFields,proto3" json:"build_large_fields,omitempty"`
+	// The compression method build_large_fields uses.
+	Compression Compression `protobuf:"varint,3,opt,name=compression,proto3,enum=weetbix.internal.pubsub.Compression" json:"compression,omitempty"`
+}
+
+func (x *BuildsV2PubSub) Reset() {
+	*x = BuildsV2PubSub{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_infra_appengine_weetbix_internal_buildbucket_pubsubpb_pubsub_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BuildsV2PubSub) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BuildsV2PubSub) ProtoMessage() {}
+
+func (x *BuildsV2PubSub) ProtoReflect() protoreflect.Message {
+	mi := &file_infra_appengine_weetbix_internal_buildbucket_pubsubpb_pubsub_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BuildsV2PubSub.ProtoReflect.Descriptor instead.
+func (*BuildsV2PubSub) Descriptor() ([]byte, []int) {
+	return file_infra_appengine_weetbix_internal_buildbucket_pubsubpb_pubsub_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *BuildsV2PubSub) GetBuild() *proto.Build {
+	if x != nil {
+		return x.Build
+	}
+	return nil
+}
+
+func (x *BuildsV2PubSub) GetBuildLargeFields() []byte {
+	if x != nil {
+		return x.BuildLargeFields
+	}
+	return nil
+}
+
+func (x *BuildsV2PubSub) GetCompression() Compression {
+	if x != nil {
+		return x.Compression
+	}
+	return Compression_ZLIB
+}
+
+var File_infra_appengine_weetbix_internal_buildbucket_pubsubpb_pubsub_proto protoreflect.FileDescriptor
+
+var file_infra_appengine_weetbix_internal_buildbucket_pubsubpb_pubsub_proto_rawDesc = []byte{
+	0x0a, 0x42, 0x69, 0x6e, 0x66, 0x72, 0x61, 0x2f, 0x61, 0x70, 0x70, 0x65, 0x6e, 0x67, 0x69, 0x6e,
+	0x65, 0x2f, 0x77, 0x65, 0x65, 0x74, 0x62, 0x69, 0x78, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e,
+	0x61, 0x6c, 0x2f, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x2f, 0x70,
+	0x75, 0x62, 0x73, 0x75, 0x62, 0x70, 0x62, 0x2f, 0x70, 0x75, 0x62, 0x73, 0x75, 0x62, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x17, 0x77, 0x65, 0x65, 0x74, 0x62, 0x69, 0x78, 0x2e, 0x69, 0x6e,
+	0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x70, 0x75, 0x62, 0x73, 0x75, 0x62, 0x1a, 0x32, 0x67,
+	0x6f, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x69, 0x75, 0x6d, 0x2e, 0x6f, 0x72, 0x67, 0x2f, 0x6c,
+	0x75, 0x63, 0x69, 0x2f, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x2f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x22, 0xb3, 0x01, 0x0a, 0x0e, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x73, 0x56, 0x32, 0x50, 0x75,
+	0x62, 0x53, 0x75, 0x62, 0x12, 0x2b, 0x0a, 0x05, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x62, 0x75, 0x63, 0x6b, 0x65,
+	0x74, 0x2e, 0x76, 0x32, 0x2e, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x52, 0x05, 0x62, 0x75, 0x69, 0x6c,
+	0x64, 0x12, 0x2c, 0x0a, 0x12, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x5f, 0x6c, 0x61, 0x72, 0x67, 0x65,
+	0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x10, 0x62,
+	0x75, 0x69, 0x6c, 0x64, 0x4c, 0x61, 0x72, 0x67, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12,
+	0x46, 0x0a, 0x0b, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x24, 0x2e, 0x77, 0x65, 0x65, 0x74, 0x62, 0x69, 0x78, 0x2e, 0x69,
+	0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x70, 0x75, 0x62, 0x73, 0x75, 0x62, 0x2e, 0x43,
+	0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x63, 0x6f, 0x6d, 0x70,
+	0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2a, 0x21, 0x0a, 0x0b, 0x43, 0x6f, 0x6d, 0x70, 0x72,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x08, 0x0a, 0x04, 0x5a, 0x4c, 0x49, 0x42, 0x10, 0x00,
+	0x12, 0x08, 0x0a, 0x04, 0x5a, 0x53, 0x54, 0x44, 0x10, 0x01, 0x42, 0x37, 0x5a, 0x35, 0x69, 0x6e,
+	0x66, 0x72, 0x61, 0x2f, 0x61, 0x70, 0x70, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x2f, 0x77, 0x65,
+	0x65, 0x74, 0x62, 0x69, 0x78, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x62,
+	0x75, 0x69, 0x6c, 0x64, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x2f, 0x70, 0x75, 0x62, 0x73, 0x75,
+	0x62, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_infra_appengine_weetbix_internal_buildbucket_pubsubpb_pubsub_proto_rawDescOnce sync.Once
+	file_infra_appengine_weetbix_internal_buildbucket_pubsubpb_pubsub_proto_rawDescData = file_infra_appengine_weetbix_internal_buildbucket_pubsubpb_pubsub_proto_rawDesc
+)
+
+func file_infra_appengine_weetbix_internal_buildbucket_pubsubpb_pubsub_proto_rawDescGZIP() []byte {
+	file_infra_appengine_weetbix_internal_buildbucket_pubsubpb_pubsub_proto_rawDescOnce.Do(func() {
+		file_infra_appengine_weetbix_internal_buildbucket_pubsubpb_pubsub_proto_rawDescData = protoimpl.X.CompressGZIP(file_infra_appengine_weetbix_internal_buildbucket_pubsubpb_pubsub_proto_rawDescData)
+	})
+	return file_infra_appengine_weetbix_internal_buildbucket_pubsubpb_pubsub_proto_rawDescData
+}
+
+var file_infra_appengine_weetbix_internal_buildbucket_pubsubpb_pubsub_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_infra_appengine_weetbix_internal_buildbucket_pubsubpb_pubsub_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_infra_appengine_weetbix_internal_buildbucket_pubsubpb_pubsub_proto_goTypes = []interface{}{
+	(Compression)(0),       // 0: weetbix.internal.pubsub.Compression
+	(*BuildsV2PubSub)(nil), // 1: weetbix.internal.pubsub.BuildsV2PubSub
+	(*proto.Build)(nil),    // 2: buildbucket.v2.Build
+}
+var file_infra_appengine_weetbix_internal_buildbucket_pubsubpb_pubsub_proto_depIdxs = []int32{
+	2, // 0: weetbix.internal.pubsub.BuildsV2PubSub.build:type_name -> buildbucket.v2.Build
+	0, // 1: weetbix.internal.pubsub.BuildsV2PubSub.compression:type_name -> weetbix.internal.pubsub.Compression
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_infra_appengine_weetbix_internal_buildbucket_pubsubpb_pubsub_proto_init() }
+func file_infra_appengine_weetbix_internal_buildbucket_pubsubpb_pubsub_proto_init() {
+	if File_infra_appengine_weetbix_internal_buildbucket_pubsubpb_pubsub_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_infra_appengine_weetbix_internal_buildbucket_pubsubpb_pubsub_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BuildsV2PubSub); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_infra_appengine_weetbix_internal_buildbucket_pubsubpb_pubsub_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_infra_appengine_weetbix_internal_buildbucket_pubsubpb_pubsub_proto_goTypes,
+		DependencyIndexes: file_infra_appengine_weetbix_internal_buildbucket_pubsubpb_pubsub_proto_depIdxs,
+		EnumInfos:         file_infra_appengine_weetbix_internal_buildbucket_pubsubpb_pubsub_proto_enumTypes,
+		MessageInfos:      file_infra_appengine_weetbix_internal_buildbucket_pubsubpb_pubsub_proto_msgTypes,
+	}.Build()
+	File_infra_appengine_weetbix_internal_buildbucket_pubsubpb_pubsub_proto = out.File
+	file_infra_appengine_weetbix_internal_buildbucket_pubsubpb_pubsub_proto_rawDesc = nil
+	file_infra_appengine_weetbix_internal_buildbucket_pubsubpb_pubsub_proto_goTypes = nil
+	file_infra_appengine_weetbix_internal_buildbucket_pubsubpb_pubsub_proto_depIdxs = nil
+}
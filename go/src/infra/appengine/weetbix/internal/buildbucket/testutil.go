@@ -46,3 +46,20 @@ func (mc *MockedClient) GetBuildWithBuilderAndRDBInfo(bID int64, res *bbpb.Build
 	}
 	mc.GetBuild(req, res)
 }
+
+func (mc *MockedClient) GetBuildWithStatusAndSteps(bID int64, res *bbpb.Build) {
+	req := &bbpb.GetBuildRequest{
+		Id: bID,
+		Mask: &bbpb.BuildMask{
+			Fields: &field_mask.FieldMask{
+				Paths: []string{"status", "steps"},
+			},
+		},
+	}
+	mc.GetBuild(req, res)
+}
+
+// SearchBuilds Mocks the SearchBuilds RPC.
+func (mc *MockedClient) SearchBuilds(req *bbpb.SearchBuildsRequest, res *bbpb.SearchBuildsResponse) {
+	mc.Client.EXPECT().SearchBuilds(gomock.Any(), proto.MatcherEqual(req), gomock.Any()).Return(res, nil)
+}
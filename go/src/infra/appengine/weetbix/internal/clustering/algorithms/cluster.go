@@ -7,6 +7,7 @@ package algorithms
 import (
 	"encoding/hex"
 	"errors"
+	"fmt"
 
 	"infra/appengine/weetbix/internal/clustering"
 	"infra/appengine/weetbix/internal/clustering/algorithms/failurereason"
@@ -14,23 +15,30 @@ import (
 	"infra/appengine/weetbix/internal/clustering/algorithms/testname"
 	"infra/appengine/weetbix/internal/clustering/rules"
 	"infra/appengine/weetbix/internal/clustering/rules/cache"
+	"infra/appengine/weetbix/internal/clustering/rules/lang"
+	"infra/appengine/weetbix/internal/config/compiledcfg"
 )
 
 // Algorithm represents the interface that each clustering algorithm
 // generating suggested clusters must implement.
+//
+// Algorithms are passed the compiled configuration of the LUCI project
+// the failures belong to, so that their clustering may be configured by
+// the project. Failures are re-clustered by all algorithms when the
+// configuration changes.
 type Algorithm interface {
 	// Name returns the identifier of the clustering algorithm.
 	Name() string
 	// Cluster clusters the given test failure and returns its cluster ID (if
 	// it can be clustered) or nil otherwise. THe returned cluster ID must be
 	// at most 16 bytes.
-	Cluster(failure *clustering.Failure) []byte
+	Cluster(config *compiledcfg.ProjectConfig, failure *clustering.Failure) []byte
 	// FailureAssociationRule returns a failure association rule that
 	// captures the definition of the cluster containing the given example.
-	FailureAssociationRule(example *clustering.Failure) string
+	FailureAssociationRule(config *compiledcfg.ProjectConfig, example *clustering.Failure) string
 	// ClusterDescription returns a description of the cluster, for use when
 	// filing bugs, with the help of the given example failure.
-	ClusterDescription(example *clustering.Failure) *clustering.ClusterDescription
+	ClusterDescription(config *compiledcfg.ProjectConfig, example *clustering.Failure) *clustering.ClusterDescription
 }
 
 // AlgorithmsVersion is the version of the set of algorithms used.
@@ -73,8 +81,9 @@ func init() {
 }
 
 // Cluster performs (incremental re-)clustering of the given test
-// failures using all registered clustering algorithms and the
-// specified set of failure association rules.
+// failures using all registered clustering algorithms, the given
+// project configuration and the specified set of failure association
+// rules.
 //
 // If the test results have not been previously clustered, pass
 // an existing ClusterResults of NewEmptyClusterResults(...)
@@ -82,7 +91,7 @@ func init() {
 //
 // If the test results have been previously clustered, pass the
 // ClusterResults returned by the last call to Cluster.
-func Cluster(ruleset *cache.Ruleset, existing clustering.ClusterResults, failures []*clustering.Failure) clustering.ClusterResults {
+func Cluster(config *compiledcfg.ProjectConfig, ruleset *cache.Ruleset, existing clustering.ClusterResults, failures []*clustering.Failure) clustering.ClusterResults {
 	if existing.AlgorithmsVersion > AlgorithmsVersion {
 		// We are running out-of-date clustering algorithms. Do not
 		// try to improve on the existing clustering. This can
@@ -90,13 +99,19 @@ func Cluster(ruleset *cache.Ruleset, existing clustering.ClusterResults, failure
 		return existing
 	}
 
+	// Suggesting algorithms may be configured by the project. If the test
+	// results were clustered with another version of the configuration
+	// (older, or newer if our cached configuration is out of date), none
+	// of the previous results can be retained.
+	reuseSuggestedAlgorithmResults := existing.ConfigVersion.Equal(config.LastUpdated)
+
 	// For each suggesting algorithm, figure out whether it has already been
 	// run previously and we can retain its results (for efficiency), or
 	// if we need to run it again.
 	var suggestedAlgorithmsToRun []Algorithm
 	suggestedAlgorithmsToRetain := make(map[string]struct{})
 	for _, alg := range suggestingAlgorithms {
-		if _, ok := existing.Algorithms[alg.Name()]; ok {
+		if _, ok := existing.Algorithms[alg.Name()]; ok && reuseSuggestedAlgorithmResults {
 			// The algorithm was run previously. Retain its results.
 			suggestedAlgorithmsToRetain[alg.Name()] = struct{}{}
 		} else {
@@ -138,7 +153,7 @@ func Cluster(ruleset *cache.Ruleset, existing clustering.ClusterResults, failure
 
 		// Run the suggested clustering algorithms.
 		for _, a := range suggestedAlgorithmsToRun {
-			id := a.Cluster(f)
+			id := a.Cluster(config, f)
 			if id == nil {
 				continue
 			}
@@ -187,6 +202,7 @@ func Cluster(ruleset *cache.Ruleset, existing clustering.ClusterResults, failure
 	return clustering.ClusterResults{
 		AlgorithmsVersion: AlgorithmsVersion,
 		RulesVersion:      newRulesVersion,
+		ConfigVersion:     config.LastUpdated,
 		Algorithms:        algorithmNames,
 		Clusters:          result,
 	}
@@ -211,6 +227,30 @@ func SuggestingAlgorithm(algorithm string) (Algorithm, error) {
 	return nil, ErrAlgorithmNotExist
 }
 
+// FailureAssociationRule returns a failure association rule, generated by
+// the given algorithm with the given project configuration, that captures
+// the definition of the cluster containing the given example.
+//
+// The rule is checked to be valid and to match the example failure, as an
+// improperly generated rule could result in the uncontrolled creation of
+// new bugs.
+func FailureAssociationRule(config *compiledcfg.ProjectConfig, alg Algorithm, example *clustering.Failure) (string, error) {
+	rule := alg.FailureAssociationRule(config, example)
+
+	expr, err := lang.Parse(rule, rules.Identifiers...)
+	if err != nil {
+		return "", fmt.Errorf("rule generated by %s did not parse: %w", alg.Name(), err)
+	}
+	match := expr.Evaluate(map[string]string{
+		"test":   example.TestID,
+		"reason": example.Reason.GetPrimaryErrorMessage(),
+	})
+	if !match {
+		return "", fmt.Errorf("rule generated by %s did not match example failure", alg.Name())
+	}
+	return rule, nil
+}
+
 // NewEmptyClusterResults returns a new ClusterResults for a list of
 // test results of length count. The ClusterResults will indicate the
 // test results have not been clustered.
@@ -220,7 +260,10 @@ func NewEmptyClusterResults(count int) clustering.ClusterResults {
 		AlgorithmsVersion: 0,
 		// The RulesVersion StartingEpoch refers to the empty set of rules.
 		RulesVersion: rules.StartingEpoch,
-		Algorithms:   make(map[string]struct{}),
-		Clusters:     make([][]*clustering.ClusterID, count),
+		// The ConfigVersion StartingEpoch refers to the earliest
+		// configuration.
+		ConfigVersion: compiledcfg.StartingEpoch,
+		Algorithms:    make(map[string]struct{}),
+		Clusters:      make([][]*clustering.ClusterID, count),
 	}
 }
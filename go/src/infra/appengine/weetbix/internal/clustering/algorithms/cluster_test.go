@@ -7,6 +7,7 @@ package algorithms
 import (
 	"encoding/hex"
 	"fmt"
+	"regexp"
 	"strings"
 	"testing"
 	"time"
@@ -17,6 +18,7 @@ import (
 	"infra/appengine/weetbix/internal/clustering/algorithms/testname"
 	"infra/appengine/weetbix/internal/clustering/rules"
 	"infra/appengine/weetbix/internal/clustering/rules/cache"
+	"infra/appengine/weetbix/internal/config/compiledcfg"
 	pb "infra/appengine/weetbix/proto/v1"
 
 	. "github.com/smartystreets/goconvey/convey"
@@ -41,9 +43,17 @@ func TestCluster(t *testing.T) {
 		rules := []*cache.CachedRule{rule1, rule2}
 		ruleset := cache.NewRuleset("myproject", rules, rulesVersion, lastUpdated)
 
+		configVersion := time.Date(2020, time.February, 1, 1, 0, 0, 0, time.UTC)
+		cfg := &compiledcfg.ProjectConfig{
+			TestNameMaskingRules: []*compiledcfg.TestNameMaskingRule{
+				{Name: "parameter", Pattern: regexp.MustCompile(`^ninja://test_name_one/(\d+)$`)},
+			},
+			LastUpdated: configVersion,
+		}
+
 		failures := []*clustering.Failure{
 			{
-				TestID: "ninja://test_name_one/",
+				TestID: "ninja://test_name_one/1",
 			},
 			{
 				TestID: "ninja://test_name_two/",
@@ -60,11 +70,11 @@ func TestCluster(t *testing.T) {
 		}
 		expectedClusters := [][]*clustering.ClusterID{
 			{
-				testNameClusterID(failures[0]),
+				testNameClusterID(cfg, failures[0]),
 			},
 			{
 				failureReasonClusterID(failures[1]),
-				testNameClusterID(failures[1]),
+				testNameClusterID(cfg, failures[1]),
 				ruleClusterID(rule1.RuleID),
 				ruleClusterID(rule2.RuleID),
 			},
@@ -73,10 +83,11 @@ func TestCluster(t *testing.T) {
 		Convey(`From scratch`, func() {
 			existing := NewEmptyClusterResults(len(failures))
 
-			results := Cluster(ruleset, existing, failures)
+			results := Cluster(cfg, ruleset, existing, failures)
 
 			So(results.AlgorithmsVersion, ShouldEqual, AlgorithmsVersion)
 			So(results.RulesVersion, ShouldEqual, rulesVersion)
+			So(results.ConfigVersion, ShouldEqual, configVersion)
 			So(results.Algorithms, ShouldResemble, expectedAlgorithms)
 			So(diffClusters(results.Clusters, expectedClusters), ShouldBeBlank)
 		})
@@ -85,6 +96,7 @@ func TestCluster(t *testing.T) {
 			existing := clustering.ClusterResults{
 				AlgorithmsVersion: AlgorithmsVersion,
 				RulesVersion:      rulesVersion,
+				ConfigVersion:     configVersion,
 				Algorithms: map[string]struct{}{
 					failurereason.AlgorithmName:  {},
 					rulesalgorithm.AlgorithmName: {},
@@ -92,11 +104,11 @@ func TestCluster(t *testing.T) {
 				},
 				Clusters: [][]*clustering.ClusterID{
 					{
-						testNameClusterID(failures[0]),
+						testNameClusterID(cfg, failures[0]),
 					},
 					{
 						failureReasonClusterID(failures[1]),
-						testNameClusterID(failures[1]),
+						testNameClusterID(cfg, failures[1]),
 						ruleClusterID(rule1.RuleID),
 						ruleClusterID(rule2.RuleID),
 					},
@@ -104,7 +116,7 @@ func TestCluster(t *testing.T) {
 			}
 
 			Convey(`From already up-to-date clustering`, func() {
-				results := Cluster(ruleset, existing, failures)
+				results := Cluster(cfg, ruleset, existing, failures)
 
 				// Should not change the clustering.
 				So(results.AlgorithmsVersion, ShouldEqual, AlgorithmsVersion)
@@ -122,7 +134,7 @@ func TestCluster(t *testing.T) {
 					ID:        "old-failure-reason-cluster-id",
 				}
 
-				results := Cluster(ruleset, existing, failures)
+				results := Cluster(cfg, ruleset, existing, failures)
 
 				// Should produce the same clustering as clustering
 				// from scratch.
@@ -137,12 +149,12 @@ func TestCluster(t *testing.T) {
 				existing.Algorithms["rules-v0"] = struct{}{}
 				existing.Clusters[1] = []*clustering.ClusterID{
 					failureReasonClusterID(failures[1]),
-					testNameClusterID(failures[1]),
+					testNameClusterID(cfg, failures[1]),
 					{Algorithm: "rules-v0", ID: rule1.RuleID},
 					{Algorithm: "rules-v0", ID: "rule-no-longer-matched-with-v1"},
 				}
 
-				results := Cluster(ruleset, existing, failures)
+				results := Cluster(cfg, ruleset, existing, failures)
 
 				// Should produce the same clustering as clustering
 				// from scratch.
@@ -165,7 +177,7 @@ func TestCluster(t *testing.T) {
 					},
 				}
 
-				results := Cluster(ruleset, existing, failures)
+				results := Cluster(cfg, ruleset, existing, failures)
 
 				// As the algorithms version is later, the clustering
 				// should be left completely untouched.
@@ -175,12 +187,12 @@ func TestCluster(t *testing.T) {
 				existing.RulesVersion = rulesVersion.Add(-1 * time.Hour)
 				existing.Clusters[1] = []*clustering.ClusterID{
 					failureReasonClusterID(failures[1]),
-					testNameClusterID(failures[1]),
+					testNameClusterID(cfg, failures[1]),
 					ruleClusterID(rule1.RuleID),
 					ruleClusterID("now-deleted-rule-id"),
 				}
 
-				results := Cluster(ruleset, existing, failures)
+				results := Cluster(cfg, ruleset, existing, failures)
 
 				// Should produce the same clustering as clustering
 				// from scratch.
@@ -189,16 +201,51 @@ func TestCluster(t *testing.T) {
 				So(results.Algorithms, ShouldResemble, expectedAlgorithms)
 				So(diffClusters(results.Clusters, expectedClusters), ShouldBeBlank)
 			})
+			Convey(`Incrementally from older config version`, func() {
+				// The test results were clustered before the masking rule
+				// was added.
+				existing.ConfigVersion = configVersion.Add(-1 * time.Hour)
+				existing.Clusters[0] = []*clustering.ClusterID{
+					testNameClusterID(&compiledcfg.ProjectConfig{}, failures[0]),
+				}
+
+				results := Cluster(cfg, ruleset, existing, failures)
+
+				// Should produce the same clustering as clustering
+				// from scratch.
+				So(results.AlgorithmsVersion, ShouldEqual, AlgorithmsVersion)
+				So(results.RulesVersion, ShouldEqual, rulesVersion)
+				So(results.ConfigVersion, ShouldEqual, configVersion)
+				So(results.Algorithms, ShouldResemble, expectedAlgorithms)
+				So(diffClusters(results.Clusters, expectedClusters), ShouldBeBlank)
+			})
+			Convey(`Incrementally from newer config version`, func() {
+				// Our cached config is out of date. The test results are
+				// re-clustered with it, so that the clustering matches the
+				// config version recorded.
+				existing.ConfigVersion = configVersion.Add(1 * time.Hour)
+				existing.Clusters[0] = []*clustering.ClusterID{
+					{Algorithm: testname.AlgorithmName, ID: "00112233445566778899aabbccddeeff"},
+				}
+
+				results := Cluster(cfg, ruleset, existing, failures)
+
+				So(results.AlgorithmsVersion, ShouldEqual, AlgorithmsVersion)
+				So(results.RulesVersion, ShouldEqual, rulesVersion)
+				So(results.ConfigVersion, ShouldEqual, configVersion)
+				So(results.Algorithms, ShouldResemble, expectedAlgorithms)
+				So(diffClusters(results.Clusters, expectedClusters), ShouldBeBlank)
+			})
 			Convey(`Incrementally from newer rules version`, func() {
 				existing.RulesVersion = rulesVersion.Add(1 * time.Hour)
 				existing.Clusters[1] = []*clustering.ClusterID{
 					failureReasonClusterID(failures[1]),
-					testNameClusterID(failures[1]),
+					testNameClusterID(cfg, failures[1]),
 					ruleClusterID(rule1.RuleID),
 					ruleClusterID("later-added-rule-id"),
 				}
 
-				results := Cluster(ruleset, existing, failures)
+				results := Cluster(cfg, ruleset, existing, failures)
 
 				// Should keep existing rule clusters, as they are newer.
 				expectedClusters = existing.Clusters
@@ -211,11 +258,11 @@ func TestCluster(t *testing.T) {
 	})
 }
 
-func testNameClusterID(failure *clustering.Failure) *clustering.ClusterID {
+func testNameClusterID(config *compiledcfg.ProjectConfig, failure *clustering.Failure) *clustering.ClusterID {
 	alg := &testname.Algorithm{}
 	return &clustering.ClusterID{
 		Algorithm: testname.AlgorithmName,
-		ID:        hex.EncodeToString(alg.Cluster(failure)),
+		ID:        hex.EncodeToString(alg.Cluster(config, failure)),
 	}
 }
 
@@ -223,7 +270,7 @@ func failureReasonClusterID(failure *clustering.Failure) *clustering.ClusterID {
 	alg := &failurereason.Algorithm{}
 	return &clustering.ClusterID{
 		Algorithm: failurereason.AlgorithmName,
-		ID:        hex.EncodeToString(alg.Cluster(failure)),
+		ID:        hex.EncodeToString(alg.Cluster(&compiledcfg.ProjectConfig{}, failure)),
 	}
 }
 
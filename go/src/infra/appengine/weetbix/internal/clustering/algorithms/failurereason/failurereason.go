@@ -7,6 +7,9 @@
 //
 // This algorithm removes ips, temp file names, numbers and other such tokens
 // to cluster similar reasons together.
+//
+// Build step failures are clustered by their step name and summary, and
+// never in the same cluster as test failures.
 package failurereason
 
 import (
@@ -17,13 +20,15 @@ import (
 	"strings"
 
 	"infra/appengine/weetbix/internal/clustering"
+	"infra/appengine/weetbix/internal/config/compiledcfg"
+	"infra/appengine/weetbix/internal/sanitize"
 )
 
 // AlgorithmVersion is the version of the clustering algorithm. The algorithm
 // version should be incremented whenever existing test results may be
 // clustered differently (i.e. Cluster(f) returns a different value for some
 // f that may have been already ingested).
-const AlgorithmVersion = 2
+const AlgorithmVersion = 3
 
 // AlgorithmName is the identifier for the clustering algorithm.
 // Weetbix requires all clustering algorithms to have a unique identifier.
@@ -36,6 +41,16 @@ var AlgorithmName = fmt.Sprintf("failurereason-v%v", AlgorithmVersion)
 const bugDescriptionTemplate = `This bug is for all test failures where the primary error message is similar to the following (ignoring numbers and hexadecimal values):
 %s`
 
+const buildStepBugDescriptionTemplate = `This bug is for all failures of the build step %s where the step summary is similar to the following (ignoring numbers and hexadecimal values):
+%s`
+
+// maxTitleRunes and maxDescriptionRunes are the maximum lengths of the
+// primary error message in the cluster title and description.
+const (
+	maxTitleRunes       = 150
+	maxDescriptionRunes = 1024
+)
+
 // To match any 1 or more digit numbers, or hex values (often appear in temp
 // file names or prints of pointers), which will be replaced.
 var clusterExp = regexp.MustCompile(`[/+0-9a-zA-Z]{10,}=+|[\-0-9a-fA-F\s]{16,}|[0-9a-fA-Fx]{8,}|[0-9]+`)
@@ -51,12 +66,18 @@ func (a *Algorithm) Name() string {
 
 // Cluster clusters the given test failure and returns its cluster ID (if it
 // can be clustered) or nil otherwise.
-func (a *Algorithm) Cluster(failure *clustering.Failure) []byte {
+func (a *Algorithm) Cluster(config *compiledcfg.ProjectConfig, failure *clustering.Failure) []byte {
 	if failure.Reason == nil || failure.Reason.PrimaryErrorMessage == "" {
 		return nil
 	}
 	// Replace numbers and hex values.
 	id := clusterExp.ReplaceAllString(failure.Reason.PrimaryErrorMessage, "0")
+	if failure.IsBuildStepFailure() {
+		// Cluster build step failures by step, and separately from test
+		// failures. The step name is quoted, so the result can never equal
+		// the reason of a test failure with the same prefix.
+		id = "step:" + strconv.Quote(failure.TestID) + ":" + id
+	}
 	// sha256 hash the resulting string.
 	h := sha256.Sum256([]byte(id))
 	// Take first 16 bytes as the ID. (Risk of collision is
@@ -66,23 +87,35 @@ func (a *Algorithm) Cluster(failure *clustering.Failure) []byte {
 
 // ClusterDescription returns a description of the cluster, for use when
 // filing bugs, with the help of the given example failure.
-func (a *Algorithm) ClusterDescription(example *clustering.Failure) *clustering.ClusterDescription {
+func (a *Algorithm) ClusterDescription(config *compiledcfg.ProjectConfig, example *clustering.Failure) *clustering.ClusterDescription {
 	if example.Reason == nil || example.Reason.PrimaryErrorMessage == "" {
 		return nil
 	}
-	// Quote and escape.
-	primaryError := strconv.QuoteToGraphic(example.Reason.PrimaryErrorMessage)
-	// Unquote, so we are left with the escaped error message only.
-	primaryError = primaryError[1 : len(primaryError)-1]
+	reason := example.Reason.PrimaryErrorMessage
+	if example.IsBuildStepFailure() {
+		step := quote(sanitize.Line(example.TestID, maxTitleRunes))
+		return &clustering.ClusterDescription{
+			Title:       quote(sanitize.Line(example.TestID+": "+reason, maxTitleRunes)),
+			Description: fmt.Sprintf(buildStepBugDescriptionTemplate, step, quote(sanitize.Text(reason, maxDescriptionRunes))),
+		}
+	}
 	return &clustering.ClusterDescription{
-		Title:       primaryError,
-		Description: fmt.Sprintf(bugDescriptionTemplate, primaryError),
+		Title:       quote(sanitize.Line(reason, maxTitleRunes)),
+		Description: fmt.Sprintf(bugDescriptionTemplate, quote(sanitize.Text(reason, maxDescriptionRunes))),
 	}
 }
 
+// quote escapes s, so that it does not break the formatting of bugs.
+func quote(s string) string {
+	// Quote and escape.
+	s = strconv.QuoteToGraphic(s)
+	// Unquote, so we are left with the escaped string only.
+	return s[1 : len(s)-1]
+}
+
 // FailureAssociationRule returns a failure association rule that
 // captures the definition of cluster containing the given example.
-func (a *Algorithm) FailureAssociationRule(example *clustering.Failure) string {
+func (a *Algorithm) FailureAssociationRule(config *compiledcfg.ProjectConfig, example *clustering.Failure) string {
 	if example.Reason == nil || example.Reason.PrimaryErrorMessage == "" {
 		return ""
 	}
@@ -98,5 +131,9 @@ func (a *Algorithm) FailureAssociationRule(example *clustering.Failure) string {
 
 	// Escape the pattern as a string literal.
 	stringLiteral := strconv.QuoteToGraphic(likePattern)
+	if example.IsBuildStepFailure() {
+		// Build step failures are clustered by step as well.
+		return fmt.Sprintf("test = %s AND reason LIKE %s", strconv.QuoteToGraphic(example.TestID), stringLiteral)
+	}
 	return fmt.Sprintf("reason LIKE %s", stringLiteral)
 }
@@ -5,10 +5,13 @@
 package failurereason
 
 import (
+	"strings"
 	"testing"
+	"unicode/utf8"
 
 	"infra/appengine/weetbix/internal/clustering"
 	"infra/appengine/weetbix/internal/clustering/rules/lang"
+	"infra/appengine/weetbix/internal/config/compiledcfg"
 	pb "infra/appengine/weetbix/proto/v1"
 
 	. "github.com/smartystreets/goconvey/convey"
@@ -17,12 +20,13 @@ import (
 func TestAlgorithm(t *testing.T) {
 	Convey(`Cluster`, t, func() {
 		a := &Algorithm{}
+		cfg := &compiledcfg.ProjectConfig{}
 		Convey(`Does not cluster test result without failure reason`, func() {
-			id := a.Cluster(&clustering.Failure{})
+			id := a.Cluster(cfg, &clustering.Failure{})
 			So(id, ShouldBeNil)
 		})
 		Convey(`ID of appropriate length`, func() {
-			id := a.Cluster(&clustering.Failure{
+			id := a.Cluster(cfg, &clustering.Failure{
 				Reason: &pb.FailureReason{PrimaryErrorMessage: "abcd this is a test failure message"},
 			})
 			// IDs may be 16 bytes at most.
@@ -30,28 +34,56 @@ func TestAlgorithm(t *testing.T) {
 			So(len(id), ShouldBeLessThanOrEqualTo, clustering.MaxClusterIDBytes)
 		})
 		Convey(`Same ID for same cluster with different numbers`, func() {
-			id1 := a.Cluster(&clustering.Failure{
+			id1 := a.Cluster(cfg, &clustering.Failure{
 				Reason: &pb.FailureReason{PrimaryErrorMessage: "Null pointer exception at ip 0x45637271"},
 			})
-			id2 := a.Cluster(&clustering.Failure{
+			id2 := a.Cluster(cfg, &clustering.Failure{
 				Reason: &pb.FailureReason{PrimaryErrorMessage: "Null pointer exception at ip 0x12345678"},
 			})
 			So(id2, ShouldResemble, id1)
 		})
 		Convey(`Different ID for different clusters`, func() {
-			id1 := a.Cluster(&clustering.Failure{
+			id1 := a.Cluster(cfg, &clustering.Failure{
 				Reason: &pb.FailureReason{PrimaryErrorMessage: "Exception in TestMethod"},
 			})
-			id2 := a.Cluster(&clustering.Failure{
+			id2 := a.Cluster(cfg, &clustering.Failure{
 				Reason: &pb.FailureReason{PrimaryErrorMessage: "Exception in MethodUnderTest"},
 			})
 			So(id2, ShouldNotResemble, id1)
 		})
+		Convey(`Build step failures`, func() {
+			reason := &pb.FailureReason{PrimaryErrorMessage: "Exit code 1"}
+			testFailure := a.Cluster(cfg, &clustering.Failure{
+				TestID: "compile",
+				Reason: reason,
+				Kind:   pb.FailureKind_TEST_FAILURE,
+			})
+			compile1 := a.Cluster(cfg, &clustering.Failure{
+				TestID: "compile",
+				Reason: reason,
+				Kind:   pb.FailureKind_BUILD_STEP_FAILURE,
+			})
+			compile2 := a.Cluster(cfg, &clustering.Failure{
+				TestID: "compile",
+				Reason: &pb.FailureReason{PrimaryErrorMessage: "Exit code 2"},
+				Kind:   pb.FailureKind_BUILD_STEP_FAILURE,
+			})
+			botUpdate := a.Cluster(cfg, &clustering.Failure{
+				TestID: "bot_update",
+				Reason: reason,
+				Kind:   pb.FailureKind_BUILD_STEP_FAILURE,
+			})
+			So(len(compile1), ShouldBeLessThanOrEqualTo, clustering.MaxClusterIDBytes)
+			So(compile2, ShouldResemble, compile1)
+			So(compile1, ShouldNotResemble, testFailure)
+			So(botUpdate, ShouldNotResemble, compile1)
+		})
 	})
 	Convey(`Failure Association Rule`, t, func() {
 		a := &Algorithm{}
+		cfg := &compiledcfg.ProjectConfig{}
 		test := func(failure *clustering.Failure, expectedRule string) {
-			rule := a.FailureAssociationRule(failure)
+			rule := a.FailureAssociationRule(cfg, failure)
 			So(rule, ShouldEqual, expectedRule)
 
 			// Test the rule is valid syntax and matches at least the example failure.
@@ -85,14 +117,35 @@ func TestAlgorithm(t *testing.T) {
 			}
 			test(failure, `reason LIKE "\\_\\%\"'+[]|\x00\r\n\v\u202e\u2066 %"`)
 		})
+		Convey(`Build step failure`, func() {
+			failure := &clustering.Failure{
+				TestID: `test_pre_run|compile "all"`,
+				Reason: &pb.FailureReason{PrimaryErrorMessage: "Exit code 1"},
+				Kind:   pb.FailureKind_BUILD_STEP_FAILURE,
+			}
+			rule := a.FailureAssociationRule(cfg, failure)
+			So(rule, ShouldEqual, `test = "test_pre_run|compile \"all\"" AND reason LIKE "Exit code %"`)
+
+			expr, err := lang.Parse(rule, "test", "reason")
+			So(err, ShouldBeNil)
+			So(expr.Evaluate(map[string]string{
+				"test":   failure.TestID,
+				"reason": failure.Reason.PrimaryErrorMessage,
+			}), ShouldBeTrue)
+			So(expr.Evaluate(map[string]string{
+				"test":   "bot_update",
+				"reason": failure.Reason.PrimaryErrorMessage,
+			}), ShouldBeFalse)
+		})
 	})
 	Convey(`Cluster Description`, t, func() {
 		a := &Algorithm{}
+		cfg := &compiledcfg.ProjectConfig{}
 		Convey(`Hexadecimal`, func() {
 			failure := &clustering.Failure{
 				Reason: &pb.FailureReason{PrimaryErrorMessage: "Null pointer exception at ip 0x45637271"},
 			}
-			description := a.ClusterDescription(failure)
+			description := a.ClusterDescription(cfg, failure)
 			So(description.Title, ShouldEqual, `Null pointer exception at ip 0x45637271`)
 			So(description.Description, ShouldContainSubstring, `Null pointer exception at ip 0x45637271`)
 		})
@@ -100,9 +153,30 @@ func TestAlgorithm(t *testing.T) {
 			failure := &clustering.Failure{
 				Reason: &pb.FailureReason{PrimaryErrorMessage: `_%"'+[]|` + "\u0000\r\n\v\u202E\u2066 AdafdxAAD17917+/="},
 			}
-			description := a.ClusterDescription(failure)
-			So(description.Title, ShouldEqual, `_%\"'+[]|\x00\r\n\v\u202e\u2066 AdafdxAAD17917+/=`)
-			So(description.Description, ShouldContainSubstring, `_%\"'+[]|\x00\r\n\v\u202e\u2066 AdafdxAAD17917+/=`)
+			description := a.ClusterDescription(cfg, failure)
+			So(description.Title, ShouldEqual, `_%\"'+[]| AdafdxAAD17917+/=`)
+			So(description.Description, ShouldContainSubstring, `_%\"'+[]|\n\nAdafdxAAD17917+/=`)
+		})
+		Convey(`Sanitization`, func() {
+			failure := &clustering.Failure{
+				Reason: &pb.FailureReason{PrimaryErrorMessage: "\x1b[31mCheck failed:\x1b[0m \xff\n" + strings.Repeat("QUJDREVGR0hJSktMTU5PUA==", 1000) + "\nat main.cc:10"},
+			}
+			description := a.ClusterDescription(cfg, failure)
+			So(description.Title, ShouldStartWith, "Check failed: \ufffd QUJD")
+			So(description.Title, ShouldEndWith, "...")
+			So(utf8.RuneCountInString(description.Title), ShouldEqual, 150)
+			So(description.Description, ShouldContainSubstring, "Check failed: \ufffd\\n...\\nat main.cc:10")
+		})
+		Convey(`Build step failure`, func() {
+			failure := &clustering.Failure{
+				TestID: "compile",
+				Reason: &pb.FailureReason{PrimaryErrorMessage: "Exit code 1"},
+				Kind:   pb.FailureKind_BUILD_STEP_FAILURE,
+			}
+			description := a.ClusterDescription(cfg, failure)
+			So(description.Title, ShouldEqual, `compile: Exit code 1`)
+			So(description.Description, ShouldContainSubstring, `build step compile`)
+			So(description.Description, ShouldContainSubstring, `Exit code 1`)
 		})
 	})
 }
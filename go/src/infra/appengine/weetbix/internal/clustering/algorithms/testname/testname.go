@@ -3,20 +3,31 @@
 // found in the LICENSE file.
 
 // Package testname contains the test name-based clustering algorithm for Weetbix.
+//
+// The parameterised parts of test IDs, such as the parameters of gtest
+// value- and type-parameterised tests, or the virtual suites of web tests,
+// may be masked by the test name masking rules of the project, so that
+// the failures of all parameterisations of a test are clustered together.
+//
+// Build step failures are not clustered by this algorithm, as a step name
+// alone does not say why the step failed.
 package testname
 
 import (
 	"crypto/sha256"
 	"fmt"
-	"infra/appengine/weetbix/internal/clustering"
 	"strconv"
+	"strings"
+
+	"infra/appengine/weetbix/internal/clustering"
+	"infra/appengine/weetbix/internal/config/compiledcfg"
 )
 
 // AlgorithmVersion is the version of the clustering algorithm. The algorithm
 // version should be incremented whenever existing test results may be
 // clustered differently (i.e. Cluster(f) returns a different value for some
 // f that may have been already ingested).
-const AlgorithmVersion = 1
+const AlgorithmVersion = 3
 
 // AlgorithmName is the identifier for the clustering algorithm.
 // Weetbix requires all clustering algorithms to have a unique identifier.
@@ -37,10 +48,15 @@ func (a *Algorithm) Name() string {
 
 // Cluster clusters the given test failure and returns its cluster ID (if it
 // can be clustered) or nil otherwise.
-func (a *Algorithm) Cluster(failure *clustering.Failure) []byte {
-	id := failure.TestID
-	// Hash test ID to generate a unique fingerprint.
-	h := sha256.Sum256([]byte(id))
+func (a *Algorithm) Cluster(config *compiledcfg.ProjectConfig, failure *clustering.Failure) []byte {
+	if failure.IsBuildStepFailure() {
+		return nil
+	}
+	// Hash the LIKE pattern matching the test ID, in which masked parts
+	// are wildcards, to generate a unique fingerprint. All test IDs masked
+	// to the same pattern are in the same cluster.
+	pattern := likePattern(config, failure.TestID)
+	h := sha256.Sum256([]byte(pattern))
 	// Take first 16 bytes as the ID. (Risk of collision is
 	// so low as to not warrant full 32 bytes.)
 	return h[0:16]
@@ -48,18 +64,86 @@ func (a *Algorithm) Cluster(failure *clustering.Failure) []byte {
 
 const bugDescriptionTemplate = `This bug is for all test failures with the test name: %s`
 
+const maskedBugDescriptionTemplate = `This bug is for all test failures with a test name like: %s
+(where %% matches any parameterisation of the test, as masked by the test name masking rule %q).`
+
 // ClusterDescription returns a description of the cluster, for use when
 // filing bugs, with the help of the given example failure.
-func (a *Algorithm) ClusterDescription(example *clustering.Failure) *clustering.ClusterDescription {
+func (a *Algorithm) ClusterDescription(config *compiledcfg.ProjectConfig, example *clustering.Failure) *clustering.ClusterDescription {
+	literals, rule := mask(config, example.TestID)
+	if rule == nil {
+		return &clustering.ClusterDescription{
+			Title:       example.TestID,
+			Description: fmt.Sprintf(bugDescriptionTemplate, example.TestID),
+		}
+	}
+	masked := strings.Join(literals, "%")
 	return &clustering.ClusterDescription{
-		Title:       example.TestID,
-		Description: fmt.Sprintf(bugDescriptionTemplate, example.TestID),
+		Title:       masked,
+		Description: fmt.Sprintf(maskedBugDescriptionTemplate, masked, rule.Name),
 	}
 }
 
 // FailureAssociationRule returns a failure association rule that
 // captures the definition of cluster containing the given example.
-func (a *Algorithm) FailureAssociationRule(example *clustering.Failure) string {
-	stringLiteral := strconv.QuoteToGraphic(example.TestID)
-	return fmt.Sprintf("test = %s", stringLiteral)
+func (a *Algorithm) FailureAssociationRule(config *compiledcfg.ProjectConfig, example *clustering.Failure) string {
+	if _, rule := mask(config, example.TestID); rule == nil {
+		return fmt.Sprintf("test = %s", strconv.QuoteToGraphic(example.TestID))
+	}
+	return fmt.Sprintf("test LIKE %s", strconv.QuoteToGraphic(likePattern(config, example.TestID)))
+}
+
+// likeEscaper escapes \, % and _ so that they are not interpreted by LIKE
+// pattern matching.
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// likePattern returns the LIKE pattern matching the given test ID, and all
+// other test IDs masked like it. Masked parts of the test ID are replaced
+// with wildcards.
+func likePattern(config *compiledcfg.ProjectConfig, testID string) string {
+	literals, _ := mask(config, testID)
+	for i, l := range literals {
+		literals[i] = likeEscaper.Replace(l)
+	}
+	return strings.Join(literals, "%")
+}
+
+// mask masks the given test ID with the first test name masking rule of
+// the project which masks part of it, and returns the rule, along with the
+// unmasked parts of the test ID between (and around) the masked ones. The
+// parts masked are those matched by the capture groups of the rule.
+// If no rule masks part of the test ID, the returned rule is nil and the
+// only unmasked part is the test ID.
+func mask(config *compiledcfg.ProjectConfig, testID string) ([]string, *compiledcfg.TestNameMaskingRule) {
+	for _, rule := range config.TestNameMaskingRules {
+		m := rule.Pattern.FindStringSubmatchIndex(testID)
+		if m == nil {
+			continue
+		}
+		var literals []string
+		// The end of the part of the test ID consumed so far.
+		end := 0
+		masked := false
+		// m[0] and m[1] bound the whole match; each pair after them bounds
+		// a capture group, in order of their opening parenthesis. Groups
+		// which did not participate in the match have negative bounds.
+		// Nested and adjacent groups are masked together.
+		for i := 2; i+1 < len(m); i += 2 {
+			start, stop := m[i], m[i+1]
+			if start < 0 || start == stop || stop <= end {
+				continue
+			}
+			if start > end || !masked {
+				literals = append(literals, testID[end:start])
+			}
+			end = stop
+			masked = true
+		}
+		if !masked {
+			// The capture groups matched nothing.
+			continue
+		}
+		return append(literals, testID[end:]), rule
+	}
+	return []string{testID}, nil
 }
@@ -5,20 +5,38 @@
 package testname
 
 import (
+	"regexp"
 	"testing"
 
 	"infra/appengine/weetbix/internal/clustering"
 	"infra/appengine/weetbix/internal/clustering/rules/lang"
+	"infra/appengine/weetbix/internal/config/compiledcfg"
 	pb "infra/appengine/weetbix/proto/v1"
 
 	. "github.com/smartystreets/goconvey/convey"
 )
 
 func TestAlgorithm(t *testing.T) {
+	// Masks the virtual suites of web tests, and the parameters of gtest
+	// value-parameterised tests.
+	maskingCfg := &compiledcfg.ProjectConfig{
+		TestNameMaskingRules: []*compiledcfg.TestNameMaskingRule{
+			{
+				Name:    "webtest-virtual-suite",
+				Pattern: regexp.MustCompile(`^ninja://:blink_web_tests/(virtual/[^/]+/)`),
+			},
+			{
+				Name:    "gtest-value-parameterised",
+				Pattern: regexp.MustCompile(`^ninja://.*:\w+/(\w+/)?\w+\.\w+/(\w+)$`),
+			},
+		},
+	}
+
 	Convey(`Cluster`, t, func() {
 		a := &Algorithm{}
+		cfg := &compiledcfg.ProjectConfig{}
 		Convey(`ID of appropriate length`, func() {
-			id := a.Cluster(&clustering.Failure{
+			id := a.Cluster(cfg, &clustering.Failure{
 				TestID: "ninja://test_name",
 			})
 			// IDs may be 16 bytes at most.
@@ -26,30 +44,84 @@ func TestAlgorithm(t *testing.T) {
 			So(len(id), ShouldBeLessThanOrEqualTo, clustering.MaxClusterIDBytes)
 		})
 		Convey(`Same ID for same test name`, func() {
-			id1 := a.Cluster(&clustering.Failure{
+			id1 := a.Cluster(cfg, &clustering.Failure{
 				TestID: "ninja://test_name_one/",
 				Reason: &pb.FailureReason{PrimaryErrorMessage: "A"},
 			})
-			id2 := a.Cluster(&clustering.Failure{
+			id2 := a.Cluster(cfg, &clustering.Failure{
 				TestID: "ninja://test_name_one/",
 				Reason: &pb.FailureReason{PrimaryErrorMessage: "B"},
 			})
 			So(id2, ShouldResemble, id1)
 		})
 		Convey(`Different ID for different clusters`, func() {
-			id1 := a.Cluster(&clustering.Failure{
+			id1 := a.Cluster(cfg, &clustering.Failure{
 				TestID: "ninja://test_name_one/",
 			})
-			id2 := a.Cluster(&clustering.Failure{
+			id2 := a.Cluster(cfg, &clustering.Failure{
 				TestID: "ninja://test_name_two/",
 			})
 			So(id2, ShouldNotResemble, id1)
 		})
+		Convey(`Does not cluster build step failures`, func() {
+			id := a.Cluster(cfg, &clustering.Failure{
+				TestID: "compile",
+				Kind:   pb.FailureKind_BUILD_STEP_FAILURE,
+			})
+			So(id, ShouldBeNil)
+		})
+		Convey(`With masking rules`, func() {
+			cfg = maskingCfg
+			Convey(`Same ID for masked parameterisations`, func() {
+				id1 := a.Cluster(cfg, &clustering.Failure{
+					TestID: "ninja://:blink_web_tests/virtual/gpu/fast/canvas/fill.html",
+				})
+				id2 := a.Cluster(cfg, &clustering.Failure{
+					TestID: "ninja://:blink_web_tests/virtual/dark-mode/fast/canvas/fill.html",
+				})
+				So(id2, ShouldResemble, id1)
+
+				id1 = a.Cluster(cfg, &clustering.Failure{
+					TestID: "ninja://chrome/test:browser_tests/All/MyTest.Load/0",
+				})
+				id2 = a.Cluster(cfg, &clustering.Failure{
+					TestID: "ninja://chrome/test:browser_tests/Incognito/MyTest.Load/1",
+				})
+				So(id2, ShouldResemble, id1)
+			})
+			Convey(`Different ID for unmasked parts`, func() {
+				id1 := a.Cluster(cfg, &clustering.Failure{
+					TestID: "ninja://:blink_web_tests/virtual/gpu/fast/canvas/fill.html",
+				})
+				id2 := a.Cluster(cfg, &clustering.Failure{
+					TestID: "ninja://:blink_web_tests/virtual/gpu/fast/canvas/stroke.html",
+				})
+				So(id2, ShouldNotResemble, id1)
+			})
+			Convey(`Different ID for masked and unmasked test`, func() {
+				// The unmasked test is not in the cluster of its virtual
+				// suites, as the masking rule does not match it.
+				id1 := a.Cluster(cfg, &clustering.Failure{
+					TestID: "ninja://:blink_web_tests/virtual/gpu/fast/canvas/fill.html",
+				})
+				id2 := a.Cluster(cfg, &clustering.Failure{
+					TestID: "ninja://:blink_web_tests/fast/canvas/fill.html",
+				})
+				So(id2, ShouldNotResemble, id1)
+			})
+			Convey(`Same ID for unmasked test as without masking rules`, func() {
+				failure := &clustering.Failure{
+					TestID: "ninja://:blink_web_tests/fast/canvas/fill.html",
+				}
+				So(a.Cluster(cfg, failure), ShouldResemble, a.Cluster(&compiledcfg.ProjectConfig{}, failure))
+			})
+		})
 	})
 	Convey(`Failure Association Rule`, t, func() {
 		a := &Algorithm{}
+		cfg := &compiledcfg.ProjectConfig{}
 		test := func(failure *clustering.Failure, expectedRule string) {
-			rule := a.FailureAssociationRule(failure)
+			rule := a.FailureAssociationRule(cfg, failure)
 			So(rule, ShouldEqual, expectedRule)
 
 			// Test the rule is valid syntax and matches at least the example failure.
@@ -65,15 +137,44 @@ func TestAlgorithm(t *testing.T) {
 			}
 			test(failure, `test = "ninja://:blink_web_tests/virtual/dark-color-scheme/fast/forms/color-scheme/select/select-multiple-hover-unselected.html"`)
 		})
+		Convey(`With masking rules`, func() {
+			cfg = maskingCfg
+			Convey(`Masked Test ID`, func() {
+				failure := &clustering.Failure{
+					TestID: "ninja://:blink_web_tests/virtual/dark-color-scheme/fast/forms/color-scheme/select/select-multiple-hover-unselected.html",
+				}
+				test(failure, `test LIKE "ninja://:blink\\_web\\_tests/%fast/forms/color-scheme/select/select-multiple-hover-unselected.html"`)
+			})
+			Convey(`Multiple masked parts`, func() {
+				failure := &clustering.Failure{
+					TestID: "ninja://chrome/test:browser_tests/All/MyTest.Load/0",
+				}
+				test(failure, `test LIKE "ninja://chrome/test:browser\\_tests/%MyTest.Load/%"`)
+			})
+			Convey(`Unmasked Test ID`, func() {
+				failure := &clustering.Failure{
+					TestID: "ninja://:blink_web_tests/fast/forms/100%_width.html",
+				}
+				test(failure, `test = "ninja://:blink_web_tests/fast/forms/100%_width.html"`)
+			})
+		})
 	})
 	Convey(`Cluster Description`, t, func() {
 		a := &Algorithm{}
+		cfg := &compiledcfg.ProjectConfig{}
 
 		failure := &clustering.Failure{
 			TestID: "ninja://:blink_web_tests/virtual/dark-color-scheme/fast/forms/color-scheme/select/select-multiple-hover-unselected.html",
 		}
-		description := a.ClusterDescription(failure)
+		description := a.ClusterDescription(cfg, failure)
 		So(description.Title, ShouldEqual, "ninja://:blink_web_tests/virtual/dark-color-scheme/fast/forms/color-scheme/select/select-multiple-hover-unselected.html")
 		So(description.Description, ShouldContainSubstring, "ninja://:blink_web_tests/virtual/dark-color-scheme/fast/forms/color-scheme/select/select-multiple-hover-unselected.html")
+
+		Convey(`With masking rules`, func() {
+			description := a.ClusterDescription(maskingCfg, failure)
+			So(description.Title, ShouldEqual, "ninja://:blink_web_tests/%fast/forms/color-scheme/select/select-multiple-hover-unselected.html")
+			So(description.Description, ShouldContainSubstring, "ninja://:blink_web_tests/%fast/forms/color-scheme/select/select-multiple-hover-unselected.html")
+			So(description.Description, ShouldContainSubstring, `"webtest-virtual-suite"`)
+		})
 	})
 }
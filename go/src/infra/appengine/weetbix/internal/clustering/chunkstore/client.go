@@ -136,6 +136,23 @@ func (c *Client) Get(ctx context.Context, project, objectID string) (*cpb.Chunk,
 	return content, nil
 }
 
+// Delete deletes the chunk with the specified object ID. Deleting a chunk
+// which does not exist is not an error.
+func (c *Client) Delete(ctx context.Context, project, objectID string) error {
+	if err := validateProject(project); err != nil {
+		return err
+	}
+	if err := validateObjectID(objectID); err != nil {
+		return err
+	}
+	name := FileName(project, objectID)
+	err := c.client.Bucket(c.bucket).Object(name).Delete(ctx)
+	if err != nil && err != storage.ErrObjectNotExist {
+		return errors.Annotate(err, "deleting object %q", name).Err()
+	}
+	return nil
+}
+
 func validateProject(project string) error {
 	if !config.ProjectRe.MatchString(project) {
 		return fmt.Errorf("project %q is not a valid", project)
@@ -76,3 +76,16 @@ func (fc *FakeClient) Get(ctx context.Context, project, objectID string) (*cpb.C
 	}
 	return proto.Clone(content).(*cpb.Chunk), nil
 }
+
+// Delete deletes the chunk with the specified object ID. Deleting a chunk
+// which does not exist is not an error.
+func (fc *FakeClient) Delete(ctx context.Context, project, objectID string) error {
+	if err := validateProject(project); err != nil {
+		return err
+	}
+	if err := validateObjectID(objectID); err != nil {
+		return err
+	}
+	delete(fc.Contents, FileName(project, objectID))
+	return nil
+}
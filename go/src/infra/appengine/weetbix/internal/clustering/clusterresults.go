@@ -23,6 +23,11 @@ type ClusterResults struct {
 	// the snapshot of failure association rules used to cluster
 	// the test results.
 	RulesVersion time.Time
+	// ConfigVersion is the version of the LUCI project configuration
+	// used to cluster test results. This is the time the configuration
+	// was fetched from LUCI Config. Clustering algorithms, such as the
+	// test name algorithm, may be configured by the project.
+	ConfigVersion time.Time
 	// Algorithms is the set of algorithms that were used to cluster
 	// the test results. Each entry is an algorithm name.
 	// When stored alongside the clustered test results, this allows only
@@ -18,6 +18,16 @@ type Failure struct {
 	TestID string
 	// The failure reason explaining the reason why the test failed.
 	Reason *pb.FailureReason
+	// The kind of the failure. For build step failures, TestID is the name
+	// of the failed step and Reason is its summary.
+	// FAILURE_KIND_UNSPECIFIED is treated as TEST_FAILURE.
+	Kind pb.FailureKind
+}
+
+// IsBuildStepFailure returns whether the failure is the failure of a build
+// step, rather than of a test.
+func (f *Failure) IsBuildStepFailure() bool {
+	return f.Kind == pb.FailureKind_BUILD_STEP_FAILURE
 }
 
 // FailureFromProto extracts failure information relevant for clustering from
@@ -25,6 +35,7 @@ type Failure struct {
 func FailureFromProto(f *cpb.Failure) *Failure {
 	result := &Failure{
 		TestID: f.TestId,
+		Kind:   f.FailureKind,
 	}
 	if f.FailureReason != nil {
 		result.Reason = proto.Clone(f.FailureReason).(*pb.FailureReason)
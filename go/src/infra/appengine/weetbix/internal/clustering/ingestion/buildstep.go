@@ -0,0 +1,124 @@
+// Copyright 2021 The Chromium Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package ingestion
+
+import (
+	"fmt"
+	"strings"
+
+	cpb "infra/appengine/weetbix/internal/clustering/proto"
+	"infra/appengine/weetbix/internal/sanitize"
+	"infra/appengine/weetbix/pbutil"
+	pb "infra/appengine/weetbix/proto/v1"
+
+	bbpb "go.chromium.org/luci/buildbucket/proto"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// buildStepResultSystem is the test result system of build step failures.
+const buildStepResultSystem = "buildbucket"
+
+// stepSeparator separates the names of nested steps in a step name.
+const stepSeparator = "|"
+
+// failuresFromBuild returns the failures of the failed leaf steps of a
+// failed build, except for test steps, in the order of the steps in the
+// build.
+func failuresFromBuild(opts Options, b *bbpb.Build) []*cpb.Failure {
+	if b.Status != bbpb.Status_FAILURE && b.Status != bbpb.Status_INFRA_FAILURE {
+		// Only failed builds are ingested. Steps of builds which are
+		// still running may not have completed, and failed steps of
+		// successful builds did not block the build.
+		return nil
+	}
+	var failures []*cpb.Failure
+	for _, step := range b.Steps {
+		if step.Status != bbpb.Status_FAILURE && step.Status != bbpb.Status_INFRA_FAILURE {
+			continue
+		}
+		if !isLeafStep(b.Steps, step.Name) {
+			// The failure of the parent step is described by the
+			// failures of its children.
+			continue
+		}
+		if opts.IsTestStep != nil && opts.IsTestStep(step.Name) {
+			// The failures of the tests run by the step are ingested
+			// from ResultDB instead.
+			continue
+		}
+		failures = append(failures, failureFromStep(b, step, opts))
+	}
+	return failures
+}
+
+// isLeafStep returns whether the step with the given name has no child
+// steps.
+func isLeafStep(steps []*bbpb.Step, name string) bool {
+	prefix := name + stepSeparator
+	for _, s := range steps {
+		if strings.HasPrefix(s.Name, prefix) {
+			return false
+		}
+	}
+	return true
+}
+
+func failureFromStep(b *bbpb.Build, step *bbpb.Step, opts Options) *cpb.Failure {
+	var presubmitRunID *pb.PresubmitRunId
+	if opts.PresubmitRunID != nil {
+		// Copy the proto to avoid aliasing the original.
+		presubmitRunID = proto.Clone(opts.PresubmitRunID).(*pb.PresubmitRunId)
+	}
+	variant := pbutil.Variant(
+		"project", b.Builder.GetProject(),
+		"bucket", b.Builder.GetBucket(),
+		"builder", b.Builder.GetBuilder(),
+	)
+	var duration *durationpb.Duration
+	if step.StartTime != nil && step.EndTime != nil {
+		duration = durationpb.New(step.EndTime.AsTime().Sub(step.StartTime.AsTime()))
+	}
+	return &cpb.Failure{
+		TestResultId: &pb.TestResultId{
+			System: buildStepResultSystem,
+			Id:     fmt.Sprintf("%s/steps/%s", opts.InvocationID, step.Name),
+		},
+		PartitionTime:                 timestamppb.New(opts.PartitionTime),
+		ChunkIndex:                    -1, // To be populated by chunking.
+		Realm:                         opts.Realm,
+		TestId:                        step.Name,
+		Variant:                       variant,
+		VariantHash:                   pbutil.VariantHash(variant),
+		FailureReason:                 failureReasonFromStep(step),
+		StartTime:                     step.StartTime,
+		Duration:                      duration,
+		IngestedInvocationId:          opts.InvocationID,
+		IngestedInvocationResultIndex: 0,
+		IngestedInvocationResultCount: 1,
+		// The build failed, and the step is one of the reasons why.
+		IsIngestedInvocationBlocked: true,
+		// Steps are run once per build, so the build is their test run.
+		TestRunId:          opts.InvocationID,
+		TestRunResultIndex: 0,
+		TestRunResultCount: 1,
+		IsTestRunBlocked:   true,
+		PresubmitRunId:     presubmitRunID,
+		FailureKind:        pb.FailureKind_BUILD_STEP_FAILURE,
+	}
+}
+
+// failureReasonFromStep returns the failure reason of a failed step, which
+// is its summary, cleaned up and truncated for display. Steps without a
+// summary are described by their status, e.g. "INFRA_FAILURE", so that
+// they are still clustered.
+func failureReasonFromStep(step *bbpb.Step) *pb.FailureReason {
+	summary := sanitize.Text(step.SummaryMarkdown, maxPrimaryErrorMessageRunes)
+	if strings.TrimSpace(summary) == "" {
+		summary = step.Status.String()
+	}
+	return &pb.FailureReason{PrimaryErrorMessage: summary}
+}
@@ -15,8 +15,10 @@ import (
 	"infra/appengine/weetbix/internal/clustering/reclustering"
 	"infra/appengine/weetbix/internal/clustering/rules"
 	"infra/appengine/weetbix/internal/clustering/state"
+	"infra/appengine/weetbix/internal/config/compiledcfg"
 	pb "infra/appengine/weetbix/proto/v1"
 
+	bbpb "go.chromium.org/luci/buildbucket/proto"
 	"go.chromium.org/luci/common/errors"
 	rdbpb "go.chromium.org/luci/resultdb/proto/v1"
 	"go.chromium.org/luci/server/span"
@@ -35,6 +37,22 @@ type Options struct {
 	InvocationID string
 	// PresubmitRunID is the identity of the presubmit run (if any).
 	PresubmitRunID *pb.PresubmitRunId
+	// IsDuplicate reports whether the verdict of the test variant duplicates
+	// one already ingested from another invocation, e.g. a retried build of
+	// the same patchsets. Failures of such test variants are ingested, but
+	// marked as duplicate so they are excluded from impact.
+	// If unset, no test variants are considered duplicates.
+	IsDuplicate func(tv *rdbpb.TestVariant) bool
+	// Artifacts are the links to artifacts of the test results being
+	// ingested, keyed by ResultDB test result name. They are stored with
+	// the failures of the respective test results.
+	// If unset, failures are stored without artifact links.
+	Artifacts map[string][]*pb.ArtifactLink
+	// IsTestStep reports whether the build step with the given name runs
+	// tests. Failures of such steps are not ingested by PutBuild, as the
+	// failures of their tests are ingested by Put instead.
+	// If unset, no steps are considered test steps.
+	IsTestStep func(stepName string) bool
 }
 
 // ChunkStore is the interface for the blob store archiving chunks of test
@@ -90,6 +108,22 @@ func (i *Ingester) Open(opts Options) *Ingestion {
 // ensure all chunks are written out.
 func (i *Ingestion) Put(ctx context.Context, tvs []*rdbpb.TestVariant) error {
 	failures := failuresFromTestVariants(i.opts, tvs)
+	return i.put(ctx, failures)
+}
+
+// PutBuild buffers the failures of the failed steps of a build which are not
+// test steps, e.g. compile steps, for clustering. The build must have its
+// builder, status and steps populated. Failures of steps are clustered
+// separately from test failures.
+//
+// To keep chunks deterministic, PutBuild must be called before or after
+// all calls to Put, in the same order each time the build is ingested.
+func (i *Ingestion) PutBuild(ctx context.Context, b *bbpb.Build) error {
+	failures := failuresFromBuild(i.opts, b)
+	return i.put(ctx, failures)
+}
+
+func (i *Ingestion) put(ctx context.Context, failures []*cpb.Failure) error {
 	i.buffer = append(i.buffer, failures...)
 
 	for len(i.buffer) > ChunkSize {
@@ -187,7 +221,12 @@ func (i *Ingestion) writeChunk(ctx context.Context, chunk *cpb.Chunk) error {
 		return errors.Annotate(err, "obtain ruleset").Err()
 	}
 
-	update, err := reclustering.PrepareUpdate(ctx, ruleset, chunk, clusterState)
+	cfg, err := compiledcfg.Project(ctx, i.opts.Project, compiledcfg.StartingEpoch)
+	if err != nil {
+		return errors.Annotate(err, "obtain config").Err()
+	}
+
+	update, err := reclustering.PrepareUpdate(ctx, cfg, ruleset, chunk, clusterState)
 	if err != nil {
 		return err
 	}
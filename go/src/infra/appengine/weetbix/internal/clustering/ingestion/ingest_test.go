@@ -8,6 +8,7 @@ import (
 	"encoding/hex"
 	"fmt"
 	"sort"
+	"strings"
 	"testing"
 	"time"
 
@@ -19,10 +20,15 @@ import (
 	"infra/appengine/weetbix/internal/clustering/algorithms/testname"
 	"infra/appengine/weetbix/internal/clustering/chunkstore"
 	"infra/appengine/weetbix/internal/clustering/rules"
+	"infra/appengine/weetbix/internal/config"
+	"infra/appengine/weetbix/internal/config/compiledcfg"
 	"infra/appengine/weetbix/internal/testutil"
+	"infra/appengine/weetbix/pbutil"
 	bqpb "infra/appengine/weetbix/proto/bq"
 	pb "infra/appengine/weetbix/proto/v1"
 
+	bbpb "go.chromium.org/luci/buildbucket/proto"
+	"go.chromium.org/luci/gae/impl/memory"
 	rdbpb "go.chromium.org/luci/resultdb/proto/v1"
 	"go.chromium.org/luci/server/caching"
 	"google.golang.org/protobuf/proto"
@@ -36,7 +42,12 @@ import (
 func TestIngest(t *testing.T) {
 	Convey(`With Ingestor`, t, func() {
 		ctx := testutil.SpannerTestContext(t)
-		ctx = caching.WithEmptyProcessCache(ctx) // For rules cache.
+		ctx = caching.WithEmptyProcessCache(ctx) // For rules and config cache.
+		ctx = memory.Use(ctx)
+		err := config.SetTestProjectConfig(ctx, map[string]*config.ProjectConfig{
+			"chromium": {},
+		})
+		So(err, ShouldBeNil)
 
 		chunkStore := chunkstore.NewFakeClient()
 		clusteredFailures := clusteredfailures.NewFakeClient()
@@ -50,13 +61,7 @@ func TestIngest(t *testing.T) {
 			InvocationID:   "build-123456790123456",
 			PresubmitRunID: &pb.PresubmitRunId{System: "luci-cv", Id: "cq-run-123"},
 		}
-		testIngestion := func(input []*rdbpb.TestVariant, expectedCFs []*bqpb.ClusteredFailureRow) {
-			ingestion := ingestor.Open(opts)
-			err := ingestion.Put(ctx, input)
-			So(err, ShouldBeNil)
-			err = ingestion.Flush(ctx)
-			So(err, ShouldBeNil)
-
+		verifyInsertions := func(expectedCFs []*bqpb.ClusteredFailureRow) {
 			insertions := clusteredFailures.InsertionsByProject["chromium"]
 			So(len(insertions), ShouldEqual, len(expectedCFs))
 
@@ -81,6 +86,24 @@ func TestIngest(t *testing.T) {
 				So(actual, ShouldResembleProto, copyExp)
 			}
 		}
+		testIngestion := func(input []*rdbpb.TestVariant, expectedCFs []*bqpb.ClusteredFailureRow) {
+			ingestion := ingestor.Open(opts)
+			err := ingestion.Put(ctx, input)
+			So(err, ShouldBeNil)
+			err = ingestion.Flush(ctx)
+			So(err, ShouldBeNil)
+
+			verifyInsertions(expectedCFs)
+		}
+		testBuildIngestion := func(b *bbpb.Build, expectedCFs []*bqpb.ClusteredFailureRow) {
+			ingestion := ingestor.Open(opts)
+			err := ingestion.PutBuild(ctx, b)
+			So(err, ShouldBeNil)
+			err = ingestion.Flush(ctx)
+			So(err, ShouldBeNil)
+
+			verifyInsertions(expectedCFs)
+		}
 
 		// This rule should match failures used in this test.
 		rule := rules.NewRule(100).WithProject(opts.Project).WithRuleDefinition(`reason LIKE "Failure reason%"`).Build()
@@ -113,6 +136,40 @@ func TestIngest(t *testing.T) {
 				testIngestion(tvs, expectedCFs)
 				So(len(chunkStore.Contents), ShouldEqual, 1)
 			})
+			Convey(`Duplicate verdict`, func() {
+				tv.Results[0].Result.Status = rdbpb.TestStatus_FAIL
+				tv.Results[0].Result.Expected = false
+				opts.IsDuplicate = func(dup *rdbpb.TestVariant) bool {
+					return dup.TestId == tv.TestId
+				}
+				for _, cf := range expectedCFs {
+					cf.IsDuplicate = true
+				}
+
+				testIngestion(tvs, expectedCFs)
+				So(len(chunkStore.Contents), ShouldEqual, 1)
+			})
+			Convey(`Failure with artifacts`, func() {
+				link := &pb.ArtifactLink{
+					System:      "resultdb",
+					Name:        tv.Results[0].Result.Name + "/artifacts/snippet",
+					ArtifactId:  "snippet",
+					ContentType: "text/plain",
+					SizeBytes:   100,
+				}
+				opts.Artifacts = map[string][]*pb.ArtifactLink{
+					tv.Results[0].Result.Name: {link},
+					"invocations/other/tests/other/results/other": {
+						{System: "resultdb", Name: "invocations/other/tests/other/results/other/artifacts/snippet"},
+					},
+				}
+				for _, cf := range expectedCFs {
+					cf.Artifacts = []*pb.ArtifactLink{link}
+				}
+
+				testIngestion(tvs, expectedCFs)
+				So(len(chunkStore.Contents), ShouldEqual, 1)
+			})
 			Convey(`Expected failure`, func() {
 				tv.Results[0].Result.Status = rdbpb.TestStatus_FAIL
 				tv.Results[0].Result.Expected = true
@@ -181,22 +238,81 @@ func TestIngest(t *testing.T) {
 				So(len(chunkStore.Contents), ShouldEqual, 1)
 			})
 			Convey(`Failure with exoneration`, func() {
-				tv.Exonerations = []*rdbpb.TestExoneration{
-					{
-						Name:            fmt.Sprintf("invocations/testrun-mytestrun/tests/test-name-%v/exonerations/exon-1", uniqifier),
+				exoneration := func(id, explanation string, reason rdbpb.ExonerationReason) *rdbpb.TestExoneration {
+					return &rdbpb.TestExoneration{
+						Name:            fmt.Sprintf("invocations/testrun-mytestrun/tests/test-name-%v/exonerations/%s", uniqifier, id),
 						TestId:          tv.TestId,
 						Variant:         proto.Clone(tv.Variant).(*rdbpb.Variant),
 						VariantHash:     "hash",
-						ExonerationId:   "exon-1",
-						ExplanationHtml: "<p>Known flake affecting CQ</p>",
-					},
+						ExonerationId:   id,
+						ExplanationHtml: explanation,
+						Reason:          reason,
+					}
+				}
+				expectExoneration := func(reason pb.ExonerationReason, explanation string) {
+					for _, cf := range expectedCFs {
+						cf.IsExonerated = true
+						cf.ExonerationReason = reason
+						cf.ExonerationExplanation = explanation
+					}
 				}
-				testnameCF.IsExonerated = true
-				regexpCF.IsExonerated = true
-				ruleCF.IsExonerated = true
 
-				testIngestion(tvs, expectedCFs)
-				So(len(chunkStore.Contents), ShouldEqual, 1)
+				Convey(`Without reason`, func() {
+					tv.Exonerations = []*rdbpb.TestExoneration{
+						exoneration("exon-1", "<p>Known flake affecting CQ</p>", rdbpb.ExonerationReason_EXONERATION_REASON_UNSPECIFIED),
+					}
+					expectExoneration(pb.ExonerationReason_EXONERATION_REASON_UNSPECIFIED, "<p>Known flake affecting CQ</p>")
+
+					testIngestion(tvs, expectedCFs)
+					So(len(chunkStore.Contents), ShouldEqual, 1)
+				})
+				Convey(`Occurs on mainline`, func() {
+					tv.Exonerations = []*rdbpb.TestExoneration{
+						exoneration("exon-1", "Weetbix reported the test is failing on mainline.", rdbpb.ExonerationReason_OCCURS_ON_MAINLINE),
+					}
+					expectExoneration(pb.ExonerationReason_OCCURS_ON_MAINLINE, "Weetbix reported the test is failing on mainline.")
+
+					testIngestion(tvs, expectedCFs)
+					So(len(chunkStore.Contents), ShouldEqual, 1)
+				})
+				Convey(`Occurs on other CLs`, func() {
+					tv.Exonerations = []*rdbpb.TestExoneration{
+						exoneration("exon-1", "Weetbix reported the test failing in presubmit runs of other CLs.", rdbpb.ExonerationReason_OCCURS_ON_OTHER_CLS),
+					}
+					expectExoneration(pb.ExonerationReason_OCCURS_ON_OTHER_CLS, "Weetbix reported the test failing in presubmit runs of other CLs.")
+
+					testIngestion(tvs, expectedCFs)
+					So(len(chunkStore.Contents), ShouldEqual, 1)
+				})
+				Convey(`Not critical`, func() {
+					tv.Exonerations = []*rdbpb.TestExoneration{
+						exoneration("exon-1", "Builder is experimental.", rdbpb.ExonerationReason_NOT_CRITICAL),
+					}
+					expectExoneration(pb.ExonerationReason_NOT_CRITICAL, "Builder is experimental.")
+
+					testIngestion(tvs, expectedCFs)
+					So(len(chunkStore.Contents), ShouldEqual, 1)
+				})
+				Convey(`Multiple exonerations`, func() {
+					// The first exoneration is recorded.
+					tv.Exonerations = []*rdbpb.TestExoneration{
+						exoneration("exon-1", "Builder is experimental.", rdbpb.ExonerationReason_NOT_CRITICAL),
+						exoneration("exon-2", "Weetbix reported the test is failing on mainline.", rdbpb.ExonerationReason_OCCURS_ON_MAINLINE),
+					}
+					expectExoneration(pb.ExonerationReason_NOT_CRITICAL, "Builder is experimental.")
+
+					testIngestion(tvs, expectedCFs)
+					So(len(chunkStore.Contents), ShouldEqual, 1)
+				})
+				Convey(`Long explanation`, func() {
+					tv.Exonerations = []*rdbpb.TestExoneration{
+						exoneration("exon-1", strings.Repeat("a", 300), rdbpb.ExonerationReason_OCCURS_ON_MAINLINE),
+					}
+					expectExoneration(pb.ExonerationReason_OCCURS_ON_MAINLINE, strings.Repeat("a", 253)+"...")
+
+					testIngestion(tvs, expectedCFs)
+					So(len(chunkStore.Contents), ShouldEqual, 1)
+				})
 			})
 			Convey(`Failure with only suggested clusters`, func() {
 				reason := &pb.FailureReason{
@@ -219,6 +335,23 @@ func TestIngest(t *testing.T) {
 				regexpCF.IsIncludedWithHighPriority = true
 				expectedCFs = []*bqpb.ClusteredFailureRow{testnameCF, regexpCF}
 
+				testIngestion(tvs, expectedCFs)
+				So(len(chunkStore.Contents), ShouldEqual, 1)
+			})
+			Convey(`Failure with unsanitized failure reason`, func() {
+				reason := &pb.FailureReason{
+					PrimaryErrorMessage: "Should not match rule",
+				}
+				tv.Results[0].Result.FailureReason = &rdbpb.FailureReason{
+					PrimaryErrorMessage: "\x1b[31mShould \t not\x00 match rule\x1b[0m\r\n",
+				}
+				testnameCF.FailureReason = reason
+				regexpCF.FailureReason = reason
+				setRegexpClustered(regexpCF)
+				testnameCF.IsIncludedWithHighPriority = true
+				regexpCF.IsIncludedWithHighPriority = true
+				expectedCFs = []*bqpb.ClusteredFailureRow{testnameCF, regexpCF}
+
 				testIngestion(tvs, expectedCFs)
 				So(len(chunkStore.Contents), ShouldEqual, 1)
 			})
@@ -275,6 +408,66 @@ func TestIngest(t *testing.T) {
 				So(len(chunkStore.Contents), ShouldEqual, 1)
 			})
 		})
+		Convey(`Ingest build step failures`, func() {
+			stepStart := time.Date(2022, time.February, 12, 0, 0, 0, 0, time.UTC)
+			b := &bbpb.Build{
+				Builder: &bbpb.BuilderID{Project: "chromium", Bucket: "try", Builder: "linux-rel"},
+				Status:  bbpb.Status_FAILURE,
+				Steps: []*bbpb.Step{
+					{Name: "bot_update", Status: bbpb.Status_SUCCESS},
+					{
+						Name:            "compile (with patch)",
+						Status:          bbpb.Status_FAILURE,
+						SummaryMarkdown: "\x1b[31mFailed to compile 3 targets\x1b[0m",
+						StartTime:       timestamppb.New(stepStart),
+						EndTime:         timestamppb.New(stepStart.Add(time.Minute)),
+					},
+					{Name: "test_pre_run", Status: bbpb.Status_INFRA_FAILURE},
+					{Name: "test_pre_run|install packages", Status: bbpb.Status_INFRA_FAILURE},
+					{Name: "browser_tests (with patch)", Status: bbpb.Status_FAILURE, SummaryMarkdown: "3 tests failed"},
+				},
+			}
+			opts.IsTestStep = func(stepName string) bool {
+				return strings.HasPrefix(stepName, "browser_tests")
+			}
+
+			compileCF := expectedBuildStepClusteredFailure("compile (with patch)", "Failed to compile 3 targets")
+			compileCF.StartTime = timestamppb.New(stepStart)
+			compileCF.Duration = durationpb.New(time.Minute)
+			setRegexpClustered(compileCF)
+			installCF := expectedBuildStepClusteredFailure("test_pre_run|install packages", "INFRA_FAILURE")
+			setRegexpClustered(installCF)
+
+			Convey(`Failed build`, func() {
+				// Build step failures are only clustered by failure
+				// reason, and do not match the rule for test failures.
+				testBuildIngestion(b, []*bqpb.ClusteredFailureRow{compileCF, installCF})
+				So(len(chunkStore.Contents), ShouldEqual, 1)
+			})
+			Convey(`Clustered separately from test failures`, func() {
+				b.Steps[1].SummaryMarkdown = "Failure reason."
+				compileCF.FailureReason.PrimaryErrorMessage = "Failure reason."
+				compileCF.StructuredFailureReason.PrimaryErrorMessage = "Failure reason."
+				setRegexpClustered(compileCF)
+
+				testCF := expectedClusteredFailure(1, 1, 0, 1, 0)
+				setRegexpClustered(testCF)
+				So(compileCF.ClusterId, ShouldNotEqual, testCF.ClusterId)
+
+				// The failure matches the rule, which is not specific to
+				// test failures.
+				compileRuleCF := proto.Clone(compileCF).(*bqpb.ClusteredFailureRow)
+				setRuleClustered(compileRuleCF, rule)
+				compileCF.IsIncludedWithHighPriority = false
+
+				testBuildIngestion(b, []*bqpb.ClusteredFailureRow{compileCF, compileRuleCF, installCF})
+			})
+			Convey(`Successful build`, func() {
+				b.Status = bbpb.Status_SUCCESS
+				testBuildIngestion(b, nil)
+				So(chunkStore.Contents, ShouldBeEmpty)
+			})
+		})
 		Convey(`Ingest many failures`, func() {
 			var tvs []*rdbpb.TestVariant
 			var expectedCFs []*bqpb.ClusteredFailureRow
@@ -306,15 +499,17 @@ func TestIngest(t *testing.T) {
 
 func setTestNameClustered(e *bqpb.ClusteredFailureRow) {
 	e.ClusterAlgorithm = testname.AlgorithmName
-	e.ClusterId = hex.EncodeToString((&testname.Algorithm{}).Cluster(&clustering.Failure{
+	e.ClusterId = hex.EncodeToString((&testname.Algorithm{}).Cluster(&compiledcfg.ProjectConfig{}, &clustering.Failure{
 		TestID: e.TestId,
 	}))
 }
 
 func setRegexpClustered(e *bqpb.ClusteredFailureRow) {
 	e.ClusterAlgorithm = failurereason.AlgorithmName
-	e.ClusterId = hex.EncodeToString((&failurereason.Algorithm{}).Cluster(&clustering.Failure{
+	e.ClusterId = hex.EncodeToString((&failurereason.Algorithm{}).Cluster(&compiledcfg.ProjectConfig{}, &clustering.Failure{
+		TestID: e.TestId,
 		Reason: &pb.FailureReason{PrimaryErrorMessage: e.FailureReason.PrimaryErrorMessage},
+		Kind:   e.FailureKind,
 	}))
 }
 
@@ -378,6 +573,18 @@ func newTestResult(uniqifier, testRunNum, resultNum int) *rdbpb.TestResult {
 				Key:   "monorail_component",
 				Value: "Component>MyComponent",
 			},
+			{
+				Key:   "error_type",
+				Value: "timeout",
+			},
+			{
+				Key:   "error_type",
+				Value: "check_failure",
+			},
+			{
+				Key:   "error_type",
+				Value: "timeout",
+			},
 		},
 		TestMetadata: &rdbpb.TestMetadata{},
 		FailureReason: &rdbpb.FailureReason{
@@ -425,5 +632,53 @@ func expectedClusteredFailure(uniqifier, testRunCount, testRunNum, resultsPerTes
 		TestRunResultIndex:            int64(resultNum),
 		TestRunResultCount:            int64(resultsPerTestRun),
 		IsTestRunBlocked:              true,
+		StructuredFailureReason: &bqpb.StructuredFailureReason{
+			PrimaryErrorMessage:           "Failure reason.",
+			ErrorTypeTags:                 []string{"check_failure", "timeout"},
+			NormalizationAlgorithmVersion: failurereason.AlgorithmVersion,
+		},
+		FailureKind: pb.FailureKind_TEST_FAILURE,
+	}
+}
+
+func expectedBuildStepClusteredFailure(stepName, reason string) *bqpb.ClusteredFailureRow {
+	return &bqpb.ClusteredFailureRow{
+		ClusterAlgorithm: "", // Determined by clustering algorithm.
+		ClusterId:        "", // Determined by clustering algorithm.
+		TestResultSystem: "buildbucket",
+		TestResultId:     "build-123456790123456/steps/" + stepName,
+		LastUpdated:      nil, // Only known at runtime, Spanner commit timestamp.
+
+		PartitionTime: timestamppb.New(time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC)),
+		IsIncluded:    true,
+		// The failure is in no bug cluster.
+		IsIncludedWithHighPriority: true,
+
+		ChunkId:    "",
+		ChunkIndex: 0, // To be set by caller as needed.
+
+		Realm:  "chromium:ci",
+		TestId: stepName,
+		Variant: []*pb.StringPair{
+			{Key: "bucket", Value: "try"},
+			{Key: "builder", Value: "linux-rel"},
+			{Key: "project", Value: "chromium"},
+		},
+		VariantHash:                   pbutil.VariantHash(pbutil.Variant("project", "chromium", "bucket", "try", "builder", "linux-rel")),
+		FailureReason:                 &pb.FailureReason{PrimaryErrorMessage: reason},
+		PresubmitRunId:                &pb.PresubmitRunId{System: "luci-cv", Id: "cq-run-123"},
+		IngestedInvocationId:          "build-123456790123456",
+		IngestedInvocationResultIndex: 0,
+		IngestedInvocationResultCount: 1,
+		IsIngestedInvocationBlocked:   true,
+		TestRunId:                     "build-123456790123456",
+		TestRunResultIndex:            0,
+		TestRunResultCount:            1,
+		IsTestRunBlocked:              true,
+		StructuredFailureReason: &bqpb.StructuredFailureReason{
+			PrimaryErrorMessage:           reason,
+			NormalizationAlgorithmVersion: failurereason.AlgorithmVersion,
+		},
+		FailureKind: pb.FailureKind_BUILD_STEP_FAILURE,
 	}
 }
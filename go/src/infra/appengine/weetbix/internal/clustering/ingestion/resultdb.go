@@ -9,9 +9,11 @@ import (
 	"sort"
 
 	cpb "infra/appengine/weetbix/internal/clustering/proto"
+	"infra/appengine/weetbix/internal/sanitize"
 	"infra/appengine/weetbix/pbutil"
 	pb "infra/appengine/weetbix/proto/v1"
 
+	"go.chromium.org/luci/common/data/stringset"
 	rdbpb "go.chromium.org/luci/resultdb/proto/v1"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/timestamppb"
@@ -46,6 +48,8 @@ func failuresFromTestVariants(opts Options, tvs []*rdbpb.TestVariant) []*cpb.Fai
 			}
 		}
 
+		isDuplicate := opts.IsDuplicate != nil && opts.IsDuplicate(tv)
+
 		seqByTestRun := make(map[string]int64)
 		for i, tr := range tv.Results {
 			testRun := testRuns[i]
@@ -55,14 +59,14 @@ func failuresFromTestVariants(opts Options, tvs []*rdbpb.TestVariant) []*cpb.Fai
 				continue
 			}
 
-			exonerated := len(tv.Exonerations) > 0
-			failure := failureFromResult(tv, tr.Result, opts, exonerated, testRun)
+			failure := failureFromResult(tv, tr.Result, opts, testRun)
 			failure.IngestedInvocationResultIndex = int64(i)
 			failure.IngestedInvocationResultCount = int64(len(tv.Results))
 			failure.IsIngestedInvocationBlocked = !hasPass
 			failure.TestRunResultIndex = seqByTestRun[testRun]
 			failure.TestRunResultCount = countByTestRun[testRun]
 			failure.IsTestRunBlocked = !testRunHasPass[testRun]
+			failure.IsDuplicate = isDuplicate
 			failures = append(failures, failure)
 
 			seqByTestRun[testRun] += 1
@@ -112,13 +116,13 @@ func sortResultsByStartTime(results []*rdbpb.TestResultBundle) []*rdbpb.TestResu
 	return sortedResults
 }
 
-func failureFromResult(tv *rdbpb.TestVariant, tr *rdbpb.TestResult, opts Options, exonerated bool, testRunID string) *cpb.Failure {
+func failureFromResult(tv *rdbpb.TestVariant, tr *rdbpb.TestResult, opts Options, testRunID string) *cpb.Failure {
 	var presubmitRunID *pb.PresubmitRunId
 	if opts.PresubmitRunID != nil {
 		// Copy the proto to avoid aliasing the original.
 		presubmitRunID = proto.Clone(opts.PresubmitRunID).(*pb.PresubmitRunId)
 	}
-	return &cpb.Failure{
+	failure := &cpb.Failure{
 		TestResultId:                  pbutil.TestResultIDFromResultDB(tr.Name),
 		PartitionTime:                 timestamppb.New(opts.PartitionTime),
 		ChunkIndex:                    -1, // To be populated by chunking.
@@ -126,11 +130,12 @@ func failureFromResult(tv *rdbpb.TestVariant, tr *rdbpb.TestResult, opts Options
 		TestId:                        tv.TestId,                              // Get from variant, as it is not populated on each result.
 		Variant:                       pbutil.VariantFromResultDB(tv.Variant), // Get from variant, as it is not populated on each result.
 		VariantHash:                   tv.VariantHash,                         // Get from variant, as it is not populated on each result.
-		FailureReason:                 pbutil.FailureReasonFromResultDB(tr.FailureReason),
+		FailureReason:                 failureReasonFromResult(tr),
 		BugTrackingComponent:          extractBugTrackingComponent(tr.Tags),
+		ErrorTypeTags:                 extractErrorTypeTags(tr.Tags),
 		StartTime:                     tr.StartTime,
 		Duration:                      tr.Duration,
-		IsExonerated:                  exonerated,
+		IsExonerated:                  len(tv.Exonerations) > 0,
 		IngestedInvocationId:          opts.InvocationID,
 		IngestedInvocationResultIndex: -1,    // To be populated by caller.
 		IngestedInvocationResultCount: -1,    // To be populated by caller.
@@ -140,7 +145,63 @@ func failureFromResult(tv *rdbpb.TestVariant, tr *rdbpb.TestResult, opts Options
 		TestRunResultCount:            -1,    // To be populated by caller.
 		IsTestRunBlocked:              false, // To be populated by caller.
 		PresubmitRunId:                presubmitRunID,
+		Artifacts:                     artifactLinks(opts.Artifacts[tr.Name]),
+		FailureKind:                   pb.FailureKind_TEST_FAILURE,
+	}
+	if len(tv.Exonerations) > 0 {
+		// A test variant is rarely exonerated more than once. If it is,
+		// the first exoneration is representative enough for analysis.
+		e := tv.Exonerations[0]
+		failure.ExonerationReason = pbutil.ExonerationReasonFromResultDB(e.Reason)
+		failure.ExonerationExplanation = sanitize.Text(e.ExplanationHtml, maxExonerationExplanationRunes)
+	}
+	return failure
+}
+
+// artifactLinks returns a copy of the given artifact links, to avoid
+// aliasing the links in the ingestion options.
+func artifactLinks(links []*pb.ArtifactLink) []*pb.ArtifactLink {
+	if len(links) == 0 {
+		return nil
+	}
+	result := make([]*pb.ArtifactLink, 0, len(links))
+	for _, l := range links {
+		result = append(result, proto.Clone(l).(*pb.ArtifactLink))
+	}
+	return result
+}
+
+// maxExonerationExplanationRunes is the maximum length of the exoneration
+// explanation of an ingested failure.
+const maxExonerationExplanationRunes = 256
+
+// maxPrimaryErrorMessageRunes is the maximum length of the primary error
+// message of an ingested failure.
+const maxPrimaryErrorMessageRunes = 1024
+
+// failureReasonFromResult returns the failure reason of a test result, with
+// its primary error message cleaned up and truncated for display.
+func failureReasonFromResult(tr *rdbpb.TestResult) *pb.FailureReason {
+	fr := pbutil.FailureReasonFromResultDB(tr.FailureReason)
+	if fr != nil {
+		fr.PrimaryErrorMessage = sanitize.Text(fr.PrimaryErrorMessage, maxPrimaryErrorMessageRunes)
+	}
+	return fr
+}
+
+// extractErrorTypeTags returns the sorted, distinct values of the
+// error_type tags of a test result.
+func extractErrorTypeTags(tags []*rdbpb.StringPair) []string {
+	values := stringset.New(0)
+	for _, tag := range tags {
+		if tag.Key == "error_type" && tag.Value != "" {
+			values.Add(tag.Value)
+		}
+	}
+	if values.Len() == 0 {
+		return nil
 	}
+	return values.ToSortedSlice()
 }
 
 func extractBugTrackingComponent(tags []*rdbpb.StringPair) *pb.BugTrackingComponent {
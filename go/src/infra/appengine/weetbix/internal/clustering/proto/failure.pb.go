@@ -187,6 +187,29 @@ type Failure struct {
 	// to see if the impact of this test run being blocked was
 	// mitigated by exoneration.
 	IsTestRunBlocked bool `protobuf:"varint,21,opt,name=is_test_run_blocked,json=isTestRunBlocked,proto3" json:"is_test_run_blocked,omitempty"`
+	// Does the verdict of this test variant duplicate one already ingested
+	// from another build of the same patchsets, e.g. because CQ retried the
+	// build? Duplicate failures are stored, but excluded from impact, so that
+	// retries do not inflate it.
+	IsDuplicate bool `protobuf:"varint,22,opt,name=is_duplicate,json=isDuplicate,proto3" json:"is_duplicate,omitempty"`
+	// The types of the error which caused the test to fail, e.g.
+	// "check_failure" or "timeout", as tagged by the test results system.
+	// For ResultDB, these are the values of the "error_type" tags of the
+	// test result, sorted and deduplicated.
+	ErrorTypeTags []string `protobuf:"bytes,23,rep,name=error_type_tags,json=errorTypeTags,proto3" json:"error_type_tags,omitempty"`
+	// Links to selected artifacts of the test result, e.g. its log snippet
+	// or stack trace, as configured by the project. At most a handful of
+	// artifacts are linked to per test result.
+	Artifacts []*v1.ArtifactLink `protobuf:"bytes,24,rep,name=artifacts,proto3" json:"artifacts,omitempty"`
+	// The kind of the failure. Failures of build steps are clustered
+	// separately from test failures.
+	FailureKind v1.FailureKind `protobuf:"varint,25,opt,name=failure_kind,json=failureKind,proto3,enum=weetbix.v1.FailureKind" json:"failure_kind,omitempty"`
+	// The reason the failure was exonerated, as recorded by the test result
+	// system. Unset if the failure was not exonerated.
+	ExonerationReason v1.ExonerationReason `protobuf:"varint,26,opt,name=exoneration_reason,json=exonerationReason,proto3,enum=weetbix.v1.ExonerationReason" json:"exoneration_reason,omitempty"`
+	// The explanation of why the failure was exonerated, cleaned up and
+	// truncated to 256 characters. Unset if the failure was not exonerated.
+	ExonerationExplanation string `protobuf:"bytes,27,opt,name=exoneration_explanation,json=exonerationExplanation,proto3" json:"exoneration_explanation,omitempty"`
 }
 
 func (x *Failure) Reset() {
@@ -368,6 +391,48 @@ func (x *Failure) GetIsTestRunBlocked() bool {
 	return false
 }
 
+func (x *Failure) GetIsDuplicate() bool {
+	if x != nil {
+		return x.IsDuplicate
+	}
+	return false
+}
+
+func (x *Failure) GetErrorTypeTags() []string {
+	if x != nil {
+		return x.ErrorTypeTags
+	}
+	return nil
+}
+
+func (x *Failure) GetArtifacts() []*v1.ArtifactLink {
+	if x != nil {
+		return x.Artifacts
+	}
+	return nil
+}
+
+func (x *Failure) GetFailureKind() v1.FailureKind {
+	if x != nil {
+		return x.FailureKind
+	}
+	return v1.FailureKind(0)
+}
+
+func (x *Failure) GetExonerationReason() v1.ExonerationReason {
+	if x != nil {
+		return x.ExonerationReason
+	}
+	return v1.ExonerationReason(0)
+}
+
+func (x *Failure) GetExonerationExplanation() string {
+	if x != nil {
+		return x.ExonerationExplanation
+	}
+	return ""
+}
+
 var File_infra_appengine_weetbix_internal_clustering_proto_failure_proto protoreflect.FileDescriptor
 
 var file_infra_appengine_weetbix_internal_clustering_proto_failure_proto_rawDesc = []byte{
@@ -392,7 +457,7 @@ var file_infra_appengine_weetbix_internal_clustering_proto_failure_proto_rawDesc
 	0x32, 0x24, 0x2e, 0x77, 0x65, 0x65, 0x74, 0x62, 0x69, 0x78, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72,
 	0x6e, 0x61, 0x6c, 0x2e, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x46,
 	0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x52, 0x08, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x73,
-	0x22, 0xe7, 0x08, 0x0a, 0x07, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x12, 0x3e, 0x0a, 0x0e,
+	0x22, 0xad, 0x0b, 0x0a, 0x07, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x12, 0x3e, 0x0a, 0x0e,
 	0x74, 0x65, 0x73, 0x74, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01,
 	0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x77, 0x65, 0x65, 0x74, 0x62, 0x69, 0x78, 0x2e, 0x76,
 	0x31, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x49, 0x64, 0x52, 0x0c,
@@ -462,12 +527,32 @@ var file_infra_appengine_weetbix_internal_clustering_proto_failure_proto_rawDesc
 	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2d, 0x0a, 0x13, 0x69,
 	0x73, 0x5f, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x72, 0x75, 0x6e, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
 	0x65, 0x64, 0x18, 0x15, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x69, 0x73, 0x54, 0x65, 0x73, 0x74,
-	0x52, 0x75, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x42, 0x40, 0x5a, 0x3e, 0x69, 0x6e,
-	0x66, 0x72, 0x61, 0x2f, 0x61, 0x70, 0x70, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x2f, 0x77, 0x65,
-	0x65, 0x74, 0x62, 0x69, 0x78, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x63,
-	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x3b,
-	0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x33,
+	0x52, 0x75, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x73,
+	0x5f, 0x64, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x18, 0x16, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0b, 0x69, 0x73, 0x44, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x12, 0x26, 0x0a,
+	0x0f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x74, 0x61, 0x67, 0x73,
+	0x18, 0x17, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x54, 0x79, 0x70,
+	0x65, 0x54, 0x61, 0x67, 0x73, 0x12, 0x36, 0x0a, 0x09, 0x61, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63,
+	0x74, 0x73, 0x18, 0x18, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x77, 0x65, 0x65, 0x74, 0x62,
+	0x69, 0x78, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x4c, 0x69,
+	0x6e, 0x6b, 0x52, 0x09, 0x61, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x73, 0x12, 0x3a, 0x0a,
+	0x0c, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x5f, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x19, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x77, 0x65, 0x65, 0x74, 0x62, 0x69, 0x78, 0x2e, 0x76, 0x31,
+	0x2e, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x0b, 0x66, 0x61,
+	0x69, 0x6c, 0x75, 0x72, 0x65, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x4c, 0x0a, 0x12, 0x65, 0x78, 0x6f,
+	0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18,
+	0x1a, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x77, 0x65, 0x65, 0x74, 0x62, 0x69, 0x78, 0x2e,
+	0x76, 0x31, 0x2e, 0x45, 0x78, 0x6f, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x52, 0x11, 0x65, 0x78, 0x6f, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x37, 0x0a, 0x17, 0x65, 0x78, 0x6f, 0x6e, 0x65,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x65, 0x78, 0x70, 0x6c, 0x61, 0x6e, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x1b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x16, 0x65, 0x78, 0x6f, 0x6e, 0x65, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x78, 0x70, 0x6c, 0x61, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x42, 0x40, 0x5a, 0x3e, 0x69, 0x6e, 0x66, 0x72, 0x61, 0x2f, 0x61, 0x70, 0x70, 0x65, 0x6e, 0x67,
+	0x69, 0x6e, 0x65, 0x2f, 0x77, 0x65, 0x65, 0x74, 0x62, 0x69, 0x78, 0x2f, 0x69, 0x6e, 0x74, 0x65,
+	0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x2f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x3b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x69, 0x6e, 0x67,
+	0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -493,22 +578,28 @@ var file_infra_appengine_weetbix_internal_clustering_proto_failure_proto_goTypes
 	(*v1.BugTrackingComponent)(nil), // 6: weetbix.v1.BugTrackingComponent
 	(*durationpb.Duration)(nil),     // 7: google.protobuf.Duration
 	(*v1.PresubmitRunId)(nil),       // 8: weetbix.v1.PresubmitRunId
+	(*v1.ArtifactLink)(nil),         // 9: weetbix.v1.ArtifactLink
+	(v1.FailureKind)(0),             // 10: weetbix.v1.FailureKind
+	(v1.ExonerationReason)(0),       // 11: weetbix.v1.ExonerationReason
 }
 var file_infra_appengine_weetbix_internal_clustering_proto_failure_proto_depIdxs = []int32{
-	1, // 0: weetbix.internal.clustering.Chunk.failures:type_name -> weetbix.internal.clustering.Failure
-	2, // 1: weetbix.internal.clustering.Failure.test_result_id:type_name -> weetbix.v1.TestResultId
-	3, // 2: weetbix.internal.clustering.Failure.partition_time:type_name -> google.protobuf.Timestamp
-	4, // 3: weetbix.internal.clustering.Failure.variant:type_name -> weetbix.v1.Variant
-	5, // 4: weetbix.internal.clustering.Failure.failure_reason:type_name -> weetbix.v1.FailureReason
-	6, // 5: weetbix.internal.clustering.Failure.bug_tracking_component:type_name -> weetbix.v1.BugTrackingComponent
-	3, // 6: weetbix.internal.clustering.Failure.start_time:type_name -> google.protobuf.Timestamp
-	7, // 7: weetbix.internal.clustering.Failure.duration:type_name -> google.protobuf.Duration
-	8, // 8: weetbix.internal.clustering.Failure.presubmit_run_id:type_name -> weetbix.v1.PresubmitRunId
-	9, // [9:9] is the sub-list for method output_type
-	9, // [9:9] is the sub-list for method input_type
-	9, // [9:9] is the sub-list for extension type_name
-	9, // [9:9] is the sub-list for extension extendee
-	0, // [0:9] is the sub-list for field type_name
+	1,  // 0: weetbix.internal.clustering.Chunk.failures:type_name -> weetbix.internal.clustering.Failure
+	2,  // 1: weetbix.internal.clustering.Failure.test_result_id:type_name -> weetbix.v1.TestResultId
+	3,  // 2: weetbix.internal.clustering.Failure.partition_time:type_name -> google.protobuf.Timestamp
+	4,  // 3: weetbix.internal.clustering.Failure.variant:type_name -> weetbix.v1.Variant
+	5,  // 4: weetbix.internal.clustering.Failure.failure_reason:type_name -> weetbix.v1.FailureReason
+	6,  // 5: weetbix.internal.clustering.Failure.bug_tracking_component:type_name -> weetbix.v1.BugTrackingComponent
+	3,  // 6: weetbix.internal.clustering.Failure.start_time:type_name -> google.protobuf.Timestamp
+	7,  // 7: weetbix.internal.clustering.Failure.duration:type_name -> google.protobuf.Duration
+	8,  // 8: weetbix.internal.clustering.Failure.presubmit_run_id:type_name -> weetbix.v1.PresubmitRunId
+	9,  // 9: weetbix.internal.clustering.Failure.artifacts:type_name -> weetbix.v1.ArtifactLink
+	10, // 10: weetbix.internal.clustering.Failure.failure_kind:type_name -> weetbix.v1.FailureKind
+	11, // 11: weetbix.internal.clustering.Failure.exoneration_reason:type_name -> weetbix.v1.ExonerationReason
+	12, // [12:12] is the sub-list for method output_type
+	12, // [12:12] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
 }
 
 func init() { file_infra_appengine_weetbix_internal_clustering_proto_failure_proto_init() }
@@ -26,6 +26,7 @@ import (
 	"infra/appengine/weetbix/internal/clustering/runs"
 	"infra/appengine/weetbix/internal/clustering/state"
 	"infra/appengine/weetbix/internal/config"
+	"infra/appengine/weetbix/internal/config/compiledcfg"
 	"infra/appengine/weetbix/internal/services/reclustering"
 	"infra/appengine/weetbix/internal/tasks/taskspb"
 )
@@ -235,6 +236,10 @@ func createProjectRun(ctx context.Context, project string, attemptStart, attempt
 		if err != nil {
 			return progress, err
 		}
+		cfg, err := compiledcfg.Project(ctx, project, time.Time{})
+		if err != nil {
+			return progress, errors.Annotate(err, "read project config").Err()
+		}
 		newRun.RulesVersion = rulesVersion
 		newRun.AlgorithmsVersion = algorithms.AlgorithmsVersion
 		if lastRun.AlgorithmsVersion > newRun.AlgorithmsVersion {
@@ -242,16 +247,23 @@ func createProjectRun(ctx context.Context, project string, attemptStart, attempt
 			// this orchestrator is running old code.
 			newRun.AlgorithmsVersion = lastRun.AlgorithmsVersion
 		}
+		newRun.ConfigVersion = cfg.LastUpdated
+		if lastRun.ConfigVersion.After(newRun.ConfigVersion) {
+			// Never roll back to an earlier config version. Assume
+			// this orchestrator's cached config is out of date.
+			newRun.ConfigVersion = lastRun.ConfigVersion
+		}
 	} else {
 		// It is foreseeable that re-clustering rules could have changed
-		// every time the orchestrator runs. If we update the rules and
-		// algorithms version for each new run, we may be continuously
+		// every time the orchestrator runs. If we update the rules,
+		// algorithms and config version for each new run, we may be continuously
 		// re-clustering chunks early on in the keyspace without ever
 		// getting around to later chunks. To ensure progress, and ensure
 		// that every chunk gets a fair slice of re-clustering resources,
 		// keep the same re-clustering goals until the last run has completed.
 		newRun.RulesVersion = lastRun.RulesVersion
 		newRun.AlgorithmsVersion = lastRun.AlgorithmsVersion
+		newRun.ConfigVersion = lastRun.ConfigVersion
 	}
 	err = runs.Create(ctx, newRun)
 	if err != nil {
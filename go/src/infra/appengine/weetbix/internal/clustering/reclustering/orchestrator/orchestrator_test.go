@@ -70,12 +70,16 @@ func TestOrchestrator(t *testing.T) {
 			testOrchestratorDoesNothing()
 		})
 		Convey("With Projects", func() {
-			// Orchestrator only looks at the projects that have config, not the config itself.
+			// Orchestrator only looks at the projects that have config,
+			// and the version of their config, not the config itself.
 			projectCfg := make(map[string]*config.ProjectConfig)
 			projectCfg["project-a"] = &config.ProjectConfig{}
 			projectCfg["project-b"] = &config.ProjectConfig{}
 			config.SetTestProjectConfig(ctx, projectCfg)
 
+			// The version of the config is the time it was fetched.
+			configVersion := tc.Now()
+
 			// Create chunks in project-b. After this, the row estimates
 			// for the projects should be:
 			// project-a: ~100
@@ -141,6 +145,7 @@ func TestOrchestrator(t *testing.T) {
 				AttemptTimestamp:  expectedAttemptTime,
 				AlgorithmsVersion: algorithms.AlgorithmsVersion,
 				RulesVersion:      rules.StartingEpoch,
+				ConfigVersion:     configVersion,
 				ShardCount:        1,
 				ShardsReported:    0,
 				Progress:          0,
@@ -150,6 +155,7 @@ func TestOrchestrator(t *testing.T) {
 				AttemptTimestamp:  expectedAttemptTime,
 				AlgorithmsVersion: algorithms.AlgorithmsVersion,
 				RulesVersion:      rulesVersionB,
+				ConfigVersion:     configVersion,
 				ShardCount:        3,
 				ShardsReported:    0,
 				Progress:          0,
@@ -189,6 +195,7 @@ func TestOrchestrator(t *testing.T) {
 					AttemptTimestamp:  expectedAttemptTime.Add(-5 * time.Minute),
 					AlgorithmsVersion: 1,
 					RulesVersion:      rulesVersionB.Add(-1 * time.Hour),
+					ConfigVersion:     configVersion.Add(-1 * time.Hour),
 					ShardCount:        10,
 					ShardsReported:    10,
 					// Complete.
@@ -227,8 +234,48 @@ func TestOrchestrator(t *testing.T) {
 					// reclustering only the beginning of the workers' keyspaces).
 					expectedRunB.AlgorithmsVersion = runB.AlgorithmsVersion
 					expectedRunB.RulesVersion = runB.RulesVersion
+					expectedRunB.ConfigVersion = runB.ConfigVersion
 					test()
 				})
+				Convey("test name masking enabled", func() {
+					// Enable test name masking in project-b, after
+					// re-clustering to its previous config started.
+					projectCfg["project-b"] = &config.ProjectConfig{
+						Clustering: &config.Clustering{
+							TestNameMaskingRules: []*config.TestNameMaskingRule{
+								{
+									Name:    "gtest-value-parameterised",
+									Pattern: `^ninja://.*:\w+/(\w+/)?\w+\.\w+/(\w+)$`,
+								},
+							},
+						},
+					}
+					So(config.SetTestProjectConfig(ctx, projectCfg), ShouldBeNil)
+
+					Convey("during incomplete run", func() {
+						runB.Progress = 500
+
+						err := runs.SetRunsForTesting(ctx, []*runs.ReclusteringRun{runB})
+						So(err, ShouldBeNil)
+
+						// Re-clustering to the new config waits for the
+						// previous run to complete.
+						expectedRunB.AlgorithmsVersion = runB.AlgorithmsVersion
+						expectedRunB.RulesVersion = runB.RulesVersion
+						expectedRunB.ConfigVersion = runB.ConfigVersion
+						test()
+					})
+					Convey("after complete run", func() {
+						err := runs.SetRunsForTesting(ctx, []*runs.ReclusteringRun{runB})
+						So(err, ShouldBeNil)
+
+						// The new run re-clusters to the new config, so that
+						// chunks clustered without test name masking are
+						// re-clustered with it.
+						So(expectedRunB.ConfigVersion, ShouldHappenAfter, runB.ConfigVersion)
+						test()
+					})
+				})
 				Convey("existing complete run with later algorithms version", func() {
 					runB.AlgorithmsVersion = algorithms.AlgorithmsVersion + 5
 
@@ -245,6 +292,18 @@ func TestOrchestrator(t *testing.T) {
 					expectedRunB.AlgorithmsVersion = runB.AlgorithmsVersion
 					test()
 				})
+				Convey("existing complete run with later config version", func() {
+					runB.ConfigVersion = configVersion.Add(1 * time.Hour)
+
+					err := runs.SetRunsForTesting(ctx, []*runs.ReclusteringRun{runB})
+					So(err, ShouldBeNil)
+
+					// The config cached by the instance running the
+					// orchestrator may be out of date. The config version
+					// of subsequent runs must also be non-decreasing.
+					expectedRunB.ConfigVersion = runB.ConfigVersion
+					test()
+				})
 			})
 			Convey("Does not schedule with an overlapping run", func() {
 				// This can occur if the reclustering interval changes.
@@ -254,6 +313,7 @@ func TestOrchestrator(t *testing.T) {
 					AttemptTimestamp:  expectedAttemptTime.Add(-1 * time.Minute),
 					AlgorithmsVersion: 1,
 					RulesVersion:      rulesVersionB.Add(-1 * time.Hour),
+					ConfigVersion:     configVersion.Add(-1 * time.Hour),
 					ShardCount:        1,
 					ShardsReported:    1,
 					Progress:          500,
@@ -14,6 +14,7 @@ import (
 	cpb "infra/appengine/weetbix/internal/clustering/proto"
 	"infra/appengine/weetbix/internal/clustering/rules/cache"
 	"infra/appengine/weetbix/internal/clustering/state"
+	"infra/appengine/weetbix/internal/config/compiledcfg"
 
 	"go.chromium.org/luci/common/trace"
 	"go.chromium.org/luci/server/caching"
@@ -37,9 +38,13 @@ func Ruleset(ctx context.Context, project string, minimumVersion time.Time) (*ca
 // Analysis is the interface for cluster analysis.
 type Analysis interface {
 	// HandleUpdatedClusters handles (re-)clustered test results. It is called
-	// after the spanner transaction effecting the (re-)clustering has
-	// committed. commitTime is the Spanner time the transaction committed.
-	HandleUpdatedClusters(ctx context.Context, updates *clustering.Update, commitTime time.Time) error
+	// in the Spanner read/write transaction effecting the (re-)clustering.
+	HandleUpdatedClusters(ctx context.Context, updates *clustering.Update) error
+	// ExportUpdatedClusters exports the updates handled for the given
+	// chunks of a LUCI project. It is called after the Spanner transaction
+	// effecting the (re-)clustering has committed. Updates which fail to
+	// export must be retained and exported later.
+	ExportUpdatedClusters(ctx context.Context, project string, chunkIDs []string) error
 }
 
 // PendingUpdate is a (re-)clustering of a chunk of test results
@@ -67,7 +72,10 @@ type PendingUpdate struct {
 // If the chunk does exist in Spanner, pass the state.Entry read
 // from Spanner, along with the test results. The chunk will be
 // re-clustered and updated.
-func PrepareUpdate(ctx context.Context, ruleset *cache.Ruleset, chunk *cpb.Chunk, existingState *state.Entry) (upd *PendingUpdate, err error) {
+//
+// Test results are clustered using the given compiled configuration of
+// the project, and the given ruleset.
+func PrepareUpdate(ctx context.Context, cfg *compiledcfg.ProjectConfig, ruleset *cache.Ruleset, chunk *cpb.Chunk, existingState *state.Entry) (upd *PendingUpdate, err error) {
 	_, s := trace.StartSpan(ctx, "infra/appengine/weetbix/internal/clustering/reclustering.PrepareUpdate")
 	s.Attribute("project", existingState.Project)
 	s.Attribute("chunkID", existingState.ChunkID)
@@ -84,7 +92,7 @@ func PrepareUpdate(ctx context.Context, ruleset *cache.Ruleset, chunk *cpb.Chunk
 		existingClustering = existingState.Clustering
 	}
 
-	newClustering := algorithms.Cluster(ruleset, existingClustering, clustering.FailuresFromProtos(chunk.Failures))
+	newClustering := algorithms.Cluster(cfg, ruleset, existingClustering, clustering.FailuresFromProtos(chunk.Failures))
 
 	updates := prepareClusterUpdates(chunk, existingClustering, newClustering)
 
@@ -126,17 +134,17 @@ func (p *PendingUpdate) ApplyToSpanner(ctx context.Context) error {
 	return nil
 }
 
-// ApplyToAnalysis exports changed failures for re-analysis. The
-// Spanner commit time must be provided so that analysis has the
-// correct update chronology.
-func (p *PendingUpdate) ApplyToAnalysis(ctx context.Context, analysis Analysis, commitTime time.Time) error {
+// ApplyToAnalysis exports changed failures for re-analysis. Must be
+// called in the Spanner transaction applying the update, so that
+// analysis is updated if and only if the transaction commits.
+func (p *PendingUpdate) ApplyToAnalysis(ctx context.Context, analysis Analysis) error {
 	if len(p.updates) > 0 {
 		update := &clustering.Update{
 			Project: p.existingState.Project,
 			ChunkID: p.existingState.ChunkID,
 			Updates: p.updates,
 		}
-		if err := analysis.HandleUpdatedClusters(ctx, update, commitTime); err != nil {
+		if err := analysis.HandleUpdatedClusters(ctx, update); err != nil {
 			return err
 		}
 	}
@@ -157,7 +165,7 @@ func (p *PendingUpdate) EstimatedTransactionSize() int {
 		return 200 + numClusters*10
 	}
 	// The clustering state has not changed, only
-	// AlgorithmsVersion and RulesVersion will be updated.
+	// AlgorithmsVersion, RulesVersion and ConfigVersion will be updated.
 	return 200
 }
 
@@ -12,6 +12,7 @@ import (
 
 	"go.chromium.org/luci/common/clock"
 	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/common/logging"
 	"go.chromium.org/luci/common/trace"
 	"go.chromium.org/luci/server/span"
 )
@@ -100,17 +101,35 @@ func (p *PendingUpdates) Apply(ctx context.Context, analysis Analysis) (err erro
 			if err := pu.ApplyToSpanner(ctx); err != nil {
 				return errors.Annotate(err, "apply to spanner").Err()
 			}
+			if err := pu.ApplyToAnalysis(ctx, analysis); err != nil {
+				return errors.Annotate(err, "apply to analysis").Err()
+			}
 			appliedUpdates = append(appliedUpdates, pu)
 		}
 		return nil
 	}
-	commitTime, err := span.ReadWriteTransaction(ctx, f)
-	if err != nil {
+	if _, err := span.ReadWriteTransaction(ctx, f); err != nil {
 		return err
 	}
+
+	// Export the analysis updates of each project.
+	var projects []string
+	chunkIDsByProject := make(map[string][]string)
 	for _, pu := range appliedUpdates {
-		if err := pu.ApplyToAnalysis(ctx, analysis, commitTime); err != nil {
-			return errors.Annotate(err, "export analysis").Err()
+		if len(pu.updates) == 0 {
+			continue
+		}
+		project := pu.Chunk.Project
+		if _, ok := chunkIDsByProject[project]; !ok {
+			projects = append(projects, project)
+		}
+		chunkIDsByProject[project] = append(chunkIDsByProject[project], pu.Chunk.ChunkID)
+	}
+	for _, project := range projects {
+		if err := analysis.ExportUpdatedClusters(ctx, project, chunkIDsByProject[project]); err != nil {
+			// The updates were retained by analysis, and will be exported
+			// later.
+			logging.Warningf(ctx, "Failed to export analysis updates of project %s: %s", project, err)
 		}
 	}
 	if len(appliedUpdates) != len(p.updates) {
@@ -27,6 +27,8 @@ import (
 	"infra/appengine/weetbix/internal/clustering/rules/cache"
 	"infra/appengine/weetbix/internal/clustering/runs"
 	"infra/appengine/weetbix/internal/clustering/state"
+	"infra/appengine/weetbix/internal/config"
+	"infra/appengine/weetbix/internal/config/compiledcfg"
 	spanutil "infra/appengine/weetbix/internal/span"
 	"infra/appengine/weetbix/internal/tasks/taskspb"
 	"infra/appengine/weetbix/internal/testutil"
@@ -36,6 +38,7 @@ import (
 
 	"cloud.google.com/go/spanner"
 
+	"go.chromium.org/luci/gae/impl/memory"
 	"go.chromium.org/luci/server/caching"
 	"go.chromium.org/luci/server/span"
 	"google.golang.org/protobuf/proto"
@@ -62,6 +65,10 @@ type scenario struct {
 	rulesVersion time.Time
 	// rules are the failure association rules.
 	rules []*rules.FailureAssociationRule
+	// configVersion is the version of the project configuration.
+	configVersion time.Time
+	// projectCfg is the project configuration.
+	projectCfg *config.ProjectConfig
 	// testResults are the actual test failures ingested by Weetbix,
 	// organised in chunks by object ID.
 	testResultsByObjectID map[string]*cpb.Chunk
@@ -71,7 +78,8 @@ func TestReclustering(t *testing.T) {
 	Convey(`With Worker`, t, func() {
 		ctx := testutil.SpannerTestContext(t)
 		ctx, tc := testclock.UseTime(ctx, testclock.TestRecentTimeUTC)
-		ctx = caching.WithEmptyProcessCache(ctx) // For rules cache.
+		ctx = caching.WithEmptyProcessCache(ctx) // For rules and config cache.
+		ctx = memory.Use(ctx)
 
 		chunkStore := chunkstore.NewFakeClient()
 		clusteredFailures := clusteredfailures.NewFakeClient()
@@ -85,6 +93,7 @@ func TestReclustering(t *testing.T) {
 			AttemptTimestamp:  attemptTime,
 			AlgorithmsVersion: algorithms.AlgorithmsVersion,
 			RulesVersion:      time.Time{}, // To be set by the test.
+			ConfigVersion:     time.Time{}, // To be set by the test.
 			ShardCount:        1,
 			ShardsReported:    0,
 			Progress:          0,
@@ -105,11 +114,19 @@ func TestReclustering(t *testing.T) {
 		setupScenario := func(s *scenario) {
 			// Create the run entry corresponding to the task.
 			run.RulesVersion = s.rulesVersion
+			run.ConfigVersion = s.configVersion
 			So(runs.SetRunsForTesting(ctx, []*runs.ReclusteringRun{run}), ShouldBeNil)
 
 			// Set stored failure association rules.
 			So(rules.SetRulesForTesting(ctx, s.rules), ShouldBeNil)
 
+			// Set stored project configuration. Its version is the time
+			// it was fetched.
+			cfgCtx, _ := testclock.UseTime(ctx, s.configVersion)
+			So(config.SetTestProjectConfig(cfgCtx, map[string]*config.ProjectConfig{
+				testProject: s.projectCfg,
+			}), ShouldBeNil)
+
 			// Set stored test result chunks.
 			for objectID, chunk := range s.testResultsByObjectID {
 				chunkStore.Contents[chunkstore.FileName(testProject, objectID)] = chunk
@@ -153,6 +170,7 @@ func TestReclustering(t *testing.T) {
 			s := newScenario().withOldClustering(true).build()
 			s.rules = expected.rules
 			s.rulesVersion = expected.rulesVersion
+			s.configVersion = expected.configVersion
 			setupScenario(s)
 
 			// Run the task.
@@ -225,6 +243,53 @@ func TestReclustering(t *testing.T) {
 			So(err, ShouldBeNil)
 			So(actualRun.Progress, ShouldEqual, 1000)
 		})
+		Convey(`Re-clustering to new config`, func() {
+			// Enable a test name masking rule which masks the part of the
+			// test names which distinguishes them.
+			projectCfg := &config.ProjectConfig{
+				Clustering: &config.Clustering{
+					TestNameMaskingRules: []*config.TestNameMaskingRule{
+						{
+							Name:    "test-suffix",
+							Pattern: `^test_(\w+)$`,
+						},
+					},
+				},
+			}
+			configVersion := time.Date(2020, time.September, 1, 1, 0, 0, 0, time.UTC)
+			expected := newScenario().withProjectConfig(projectCfg, configVersion).build()
+
+			// Start with clustering using the previous config.
+			s := newScenario().build()
+			s.projectCfg = expected.projectCfg
+			s.configVersion = expected.configVersion
+			setupScenario(s)
+
+			// Run the task.
+			continuation, err := worker.Do(ctx, task, TargetTaskDuration)
+			So(err, ShouldBeNil)
+			So(continuation, ShouldBeNil)
+
+			// Final clustering state should be equal expected state.
+			actualState, err := state.ReadAllForTesting(ctx, testProject)
+			So(err, ShouldBeNil)
+			for _, as := range actualState {
+				as.LastUpdated = time.Time{}
+			}
+			So(actualState, ShouldResemble, expected.clusteringState)
+
+			// BigQuery exports should correctly reflect the new
+			// test name clusters.
+			exports := clusteredFailures.InsertionsByProject[testProject]
+			sortBQExport(exports)
+			netExports := flattenBigQueryExports(append(s.netBQExports, exports...))
+			So(netExports, ShouldResembleProto, expected.netBQExports)
+
+			// Run is reported as complete.
+			actualRun, err := runs.Read(span.Single(ctx), testProject, run.AttemptTimestamp)
+			So(err, ShouldBeNil)
+			So(actualRun.Progress, ShouldEqual, 1000)
+		})
 		Convey(`Worker respects end time`, func() {
 			expected := newScenario().withOldClustering(false).build()
 
@@ -232,6 +297,7 @@ func TestReclustering(t *testing.T) {
 			s := newScenario().withOldClustering(true).build()
 			s.rules = expected.rules
 			s.rulesVersion = expected.rulesVersion
+			s.configVersion = expected.configVersion
 			setupScenario(s)
 
 			// Start the worker after the attempt time.
@@ -263,6 +329,7 @@ func TestReclustering(t *testing.T) {
 			s := newScenario().withOldClustering(true).build()
 			s.rules = finalState.rules
 			s.rulesVersion = finalState.rulesVersion
+			s.configVersion = finalState.configVersion
 			setupScenario(s)
 
 			// Make reading a chunk's test results trigger updating
@@ -329,6 +396,7 @@ func TestReclustering(t *testing.T) {
 		Convey(`Worker running out of date algorithms`, func() {
 			run.AlgorithmsVersion = algorithms.AlgorithmsVersion + 1
 			run.RulesVersion = rules.StartingEpoch
+			run.ConfigVersion = compiledcfg.StartingEpoch
 			So(runs.SetRunsForTesting(ctx, []*runs.ReclusteringRun{run}), ShouldBeNil)
 
 			continuation, err := worker.Do(ctx, task, TargetTaskDuration)
@@ -337,6 +405,7 @@ func TestReclustering(t *testing.T) {
 		})
 		Convey(`Continuation correctly scheduled`, func() {
 			run.RulesVersion = rules.StartingEpoch
+			run.ConfigVersion = compiledcfg.StartingEpoch
 			So(runs.SetRunsForTesting(ctx, []*runs.ReclusteringRun{run}), ShouldBeNil)
 
 			// Leave no time for the task to run.
@@ -478,6 +547,7 @@ func (b *testResultBuilder) buildFailure() *cpb.Failure {
 		Variant:       &pb.Variant{Def: map[string]string{"variantkey": "value"}},
 		VariantHash:   hex.EncodeToString(keyHash[:]),
 		FailureReason: b.failureReason,
+		ErrorTypeTags: []string{"timeout"},
 		BugTrackingComponent: &pb.BugTrackingComponent{
 			System:    "monorail",
 			Component: "Component>MyComponent",
@@ -557,6 +627,12 @@ func (b *testResultBuilder) buildBQExport(clusterIDs []*clustering.ClusterID) []
 			TestRunResultIndex: int64((int64(b.uniqifier) / 2) + 1),
 			TestRunResultCount: int64(b.uniqifier + 1),
 			IsTestRunBlocked:   b.uniqifier%2 == 0,
+
+			StructuredFailureReason: &bqpb.StructuredFailureReason{
+				PrimaryErrorMessage:           b.failureReason.GetPrimaryErrorMessage(),
+				ErrorTypeTags:                 []string{"timeout"},
+				NormalizationAlgorithmVersion: failurereason.AlgorithmVersion,
+			},
 		}
 		results = append(results, result)
 	}
@@ -565,7 +641,7 @@ func (b *testResultBuilder) buildBQExport(clusterIDs []*clustering.ClusterID) []
 
 // buildClusters returns the clusters that would be expected for this test
 // result, if current clustering algorithms were used.
-func (b *testResultBuilder) buildClusters(rules *cache.Ruleset) []*clustering.ClusterID {
+func (b *testResultBuilder) buildClusters(rules *cache.Ruleset, config *compiledcfg.ProjectConfig) []*clustering.ClusterID {
 	var clusters []*clustering.ClusterID
 	failure := &clustering.Failure{
 		TestID: b.testName,
@@ -574,13 +650,13 @@ func (b *testResultBuilder) buildClusters(rules *cache.Ruleset) []*clustering.Cl
 	testNameAlg := &testname.Algorithm{}
 	clusters = append(clusters, &clustering.ClusterID{
 		Algorithm: testNameAlg.Name(),
-		ID:        hex.EncodeToString(testNameAlg.Cluster(failure)),
+		ID:        hex.EncodeToString(testNameAlg.Cluster(config, failure)),
 	})
 	if b.failureReason != nil && b.failureReason.PrimaryErrorMessage != "" {
 		failureReasonAlg := &failurereason.Algorithm{}
 		clusters = append(clusters, &clustering.ClusterID{
 			Algorithm: failureReasonAlg.Name(),
-			ID:        hex.EncodeToString(failureReasonAlg.Cluster(failure)),
+			ID:        hex.EncodeToString(failureReasonAlg.Cluster(config, failure)),
 		})
 	}
 	vals := map[string]string{
@@ -607,6 +683,7 @@ type chunkBuilder struct {
 	objectID      string
 	testResults   []*testResultBuilder
 	ruleset       *cache.Ruleset
+	config        *compiledcfg.ProjectConfig
 	oldClustering bool
 }
 
@@ -620,6 +697,7 @@ func newChunk(uniqifier int) *chunkBuilder {
 		chunkID:       hex.EncodeToString(chunkID[:16]),
 		objectID:      hex.EncodeToString(objectID[:16]),
 		ruleset:       cache.NewRuleset("", nil, rules.StartingEpoch, time.Time{}),
+		config:        &compiledcfg.ProjectConfig{LastUpdated: compiledcfg.StartingEpoch},
 		oldClustering: false,
 	}
 }
@@ -648,6 +726,13 @@ func (b *chunkBuilder) withRuleset(ruleset *cache.Ruleset) *chunkBuilder {
 	return b
 }
 
+// withConfig sets the compiled project configuration to use to determine
+// current clustering (only used if out-of-date clustering is not set).
+func (b *chunkBuilder) withConfig(config *compiledcfg.ProjectConfig) *chunkBuilder {
+	b.config = config
+	return b
+}
+
 func (b *chunkBuilder) buildTestResults() (chunk *cpb.Chunk) {
 	var failures []*cpb.Failure
 	for i, tr := range b.testResults {
@@ -683,6 +768,7 @@ func (b *chunkBuilder) buildState() *state.Entry {
 		crs = clustering.ClusterResults{
 			AlgorithmsVersion: 1,
 			RulesVersion:      b.ruleset.RulesVersion,
+			ConfigVersion:     b.config.LastUpdated,
 			Algorithms:        algs,
 			Clusters:          clusters,
 		}
@@ -693,11 +779,12 @@ func (b *chunkBuilder) buildState() *state.Entry {
 		algs[rulesalgorithm.AlgorithmName] = struct{}{}
 		var clusters [][]*clustering.ClusterID
 		for _, tr := range b.testResults {
-			clusters = append(clusters, tr.buildClusters(b.ruleset))
+			clusters = append(clusters, tr.buildClusters(b.ruleset, b.config))
 		}
 		crs = clustering.ClusterResults{
 			AlgorithmsVersion: algorithms.AlgorithmsVersion,
 			RulesVersion:      b.ruleset.RulesVersion,
+			ConfigVersion:     b.config.LastUpdated,
 			Algorithms:        algs,
 			Clusters:          clusters,
 		}
@@ -736,15 +823,26 @@ type scenarioBuilder struct {
 	project       string
 	chunkCount    int
 	oldClustering bool
+	projectCfg    *config.ProjectConfig
+	configVersion time.Time
 }
 
 func newScenario() *scenarioBuilder {
 	return &scenarioBuilder{
-		project:    testProject,
-		chunkCount: 2,
+		project:       testProject,
+		chunkCount:    2,
+		projectCfg:    &config.ProjectConfig{},
+		configVersion: time.Date(2020, time.August, 1, 1, 0, 0, 0, time.UTC),
 	}
 }
 
+// withProjectConfig sets the project configuration, and its version.
+func (b *scenarioBuilder) withProjectConfig(cfg *config.ProjectConfig, version time.Time) *scenarioBuilder {
+	b.projectCfg = cfg
+	b.configVersion = version
+	return b
+}
+
 func (b *scenarioBuilder) withOldClustering(value bool) *scenarioBuilder {
 	b.oldClustering = value
 	return b
@@ -769,6 +867,13 @@ func (b *scenarioBuilder) build() *scenario {
 
 	ruleset := cache.NewRuleset(b.project, activeRules, rulesVersion, time.Time{})
 
+	configVersion := compiledcfg.StartingEpoch
+	if !b.oldClustering {
+		configVersion = b.configVersion
+	}
+	compiledCfg, err := compiledcfg.NewConfig(b.projectCfg, configVersion)
+	So(err, ShouldBeNil)
+
 	var state []*state.Entry
 	testResultsByObjectID := make(map[string]*cpb.Chunk)
 	var bqExports []*bqpb.ClusteredFailureRow
@@ -783,6 +888,7 @@ func (b *scenarioBuilder) build() *scenario {
 		cb := newChunk(i).withProject(b.project).
 			withOldClustering(b.oldClustering).
 			withRuleset(ruleset).
+			withConfig(compiledCfg).
 			withTestResults(trOne, trTwo)
 
 		s := cb.buildState()
@@ -795,6 +901,8 @@ func (b *scenarioBuilder) build() *scenario {
 	return &scenario{
 		rulesVersion:          rulesVersion,
 		rules:                 rs,
+		configVersion:         b.configVersion,
+		projectCfg:            b.projectCfg,
 		testResultsByObjectID: testResultsByObjectID,
 		clusteringState:       state,
 		netBQExports:          bqExports,
@@ -0,0 +1,101 @@
+// Copyright 2021 The Chromium Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package rules
+
+import (
+	"context"
+	"time"
+
+	"cloud.google.com/go/spanner"
+
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/server/span"
+
+	spanutil "infra/appengine/weetbix/internal/span"
+)
+
+// The actions recorded in the history of rules.
+const (
+	// ActionBugFixed is recorded when the bug of a rule is first observed
+	// to be fixed.
+	ActionBugFixed = "bug_fixed"
+	// ActionBugReopened is recorded when the bug of a rule is observed to
+	// no longer be fixed.
+	ActionBugReopened = "bug_reopened"
+	// ActionRecurred is recorded when failures recur after the bug of a
+	// rule was fixed.
+	ActionRecurred = "recurred"
+	// ActionArchived is recorded when a rule is archived because its
+	// cluster had no impact for long enough after its bug was fixed.
+	ActionArchived = "archived"
+)
+
+// HistoryEntry is a change made to a failure association rule, as recorded
+// in its history.
+type HistoryEntry struct {
+	// The LUCI Project of the rule.
+	Project string `json:"project"`
+	// The identifier of the rule.
+	RuleID string `json:"ruleId"`
+	// The time of the change. Output only.
+	ChangeTime time.Time `json:"changeTime"`
+	// The kind of change, one of the Action* constants.
+	Action string `json:"action"`
+	// The user which made the change.
+	User string `json:"user"`
+	// A human-readable description of the change.
+	Details string `json:"details"`
+}
+
+// RecordHistory records a change to a failure association rule in its
+// history. The change time is the commit time of the transaction.
+func RecordHistory(ctx context.Context, e *HistoryEntry) error {
+	if err := validateUser(e.User); err != nil {
+		return err
+	}
+	if e.Action == "" {
+		return errors.New("action must be specified")
+	}
+	ms := spanutil.InsertMap("FailureAssociationRuleHistory", map[string]interface{}{
+		"Project":    e.Project,
+		"RuleId":     e.RuleID,
+		"ChangeTime": spanner.CommitTimestamp,
+		"Action":     e.Action,
+		"User":       e.User,
+		"Details":    e.Details,
+	})
+	span.BufferWrite(ctx, ms)
+	return nil
+}
+
+// ReadHistory reads the history of the failure association rule with the
+// given rule ID, most recent change first.
+func ReadHistory(ctx context.Context, project, ruleID string) ([]*HistoryEntry, error) {
+	stmt := spanner.NewStatement(`
+		SELECT ChangeTime, Action, User, Details
+		FROM FailureAssociationRuleHistory
+		WHERE Project = @projectID AND RuleId = @ruleId
+		ORDER BY ChangeTime DESC, Action
+	`)
+	stmt.Params = map[string]interface{}{
+		"projectID": project,
+		"ruleId":    ruleID,
+	}
+
+	it := span.Query(ctx, stmt)
+	es := []*HistoryEntry{}
+	err := it.Do(func(r *spanner.Row) error {
+		e := &HistoryEntry{Project: project, RuleID: ruleID}
+		if err := r.Columns(&e.ChangeTime, &e.Action, &e.User, &e.Details); err != nil {
+			return errors.Annotate(err, "read history row").Err()
+		}
+		es = append(es, e)
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Annotate(err, "query rule history").Err()
+	}
+	return es, nil
+}
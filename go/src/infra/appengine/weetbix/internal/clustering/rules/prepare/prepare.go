@@ -0,0 +1,139 @@
+// Copyright 2021 The Chromium Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+// Package prepare prepares failure association rules from suggested
+// clusters, for users to review before creating a rule.
+package prepare
+
+import (
+	"context"
+	"encoding/hex"
+
+	"go.chromium.org/luci/common/errors"
+
+	"infra/appengine/weetbix/internal/analysis"
+	"infra/appengine/weetbix/internal/clustering"
+	"infra/appengine/weetbix/internal/clustering/algorithms"
+	"infra/appengine/weetbix/internal/config/compiledcfg"
+	pb "infra/appengine/weetbix/proto/v1"
+)
+
+// ErrNotSuggestedCluster is returned if a rule is prepared for a cluster
+// that is not a suggested cluster of a known clustering algorithm.
+var ErrNotSuggestedCluster = errors.New("cluster is not a suggested cluster of a known algorithm")
+
+// Tolerance is the relative difference between the failures matched by a
+// rule and the failures of its source cluster, beyond which the rule is
+// considered to over- or under-match the cluster.
+const Tolerance = 0.1
+
+// Match describes how closely the failures matched by a rule follow the
+// failures of its source cluster.
+type Match string
+
+const (
+	// Matches means the rule matches about as many failures as the cluster.
+	Matches Match = "MATCHES"
+	// OverMatches means the rule matches more failures than the cluster.
+	OverMatches Match = "OVER_MATCHES"
+	// UnderMatches means the rule matches fewer failures than the cluster.
+	UnderMatches Match = "UNDER_MATCHES"
+)
+
+// AnalysisClient reads the analysis needed to prepare a rule.
+type AnalysisClient interface {
+	// ReadCluster reads information about a single cluster.
+	ReadCluster(ctx context.Context, luciProject string, clusterID clustering.ClusterID) (*analysis.ClusterSummary, error)
+	// ReadRuleFailures7d counts the failures in the last 7 days that would
+	// be matched by the given rule.
+	ReadRuleFailures7d(ctx context.Context, luciProject, rule string) (*analysis.FailureCounts, error)
+}
+
+// Preview compares the failures a rule would match in the last 7 days with
+// the failures of its source cluster.
+type Preview struct {
+	RuleFailures7d    analysis.FailureCounts `json:"ruleFailures7d"`
+	ClusterFailures7d analysis.FailureCounts `json:"clusterFailures7d"`
+	// Ratio is the number of failures matched by the rule over the number
+	// of failures of the cluster, including exonerated failures. It is zero
+	// if the cluster has no failures.
+	Ratio float64 `json:"ratio"`
+	Match Match   `json:"match"`
+}
+
+// PreparedRule is a failure association rule prepared from a suggested
+// cluster.
+type PreparedRule struct {
+	RuleDefinition string               `json:"ruleDefinition"`
+	SourceCluster  clustering.ClusterID `json:"sourceCluster"`
+	Preview        *Preview             `json:"preview"`
+}
+
+// FromCluster prepares a failure association rule capturing the given
+// suggested cluster, and previews its impact. The cluster is identified
+// using the given compiled configuration of the LUCI project.
+func FromCluster(ctx context.Context, ac AnalysisClient, luciProject string, cfg *compiledcfg.ProjectConfig, clusterID clustering.ClusterID) (*PreparedRule, error) {
+	alg, err := algorithms.SuggestingAlgorithm(clusterID.Algorithm)
+	if err == algorithms.ErrAlgorithmNotExist {
+		return nil, ErrNotSuggestedCluster
+	}
+	if err != nil {
+		return nil, err
+	}
+	cs, err := ac.ReadCluster(ctx, luciProject, clusterID)
+	if err != nil {
+		return nil, errors.Annotate(err, "reading cluster").Err()
+	}
+
+	failure := &clustering.Failure{
+		TestID: cs.ExampleTestID,
+		Kind:   pb.FailureKind(pb.FailureKind_value[cs.FailureKind]),
+	}
+	if cs.ExampleFailureReason.Valid {
+		failure.Reason = &pb.FailureReason{PrimaryErrorMessage: cs.ExampleFailureReason.StringVal}
+	}
+	if hex.EncodeToString(alg.Cluster(cfg, failure)) != clusterID.ID {
+		return nil, errors.New("example failure did not match cluster ID")
+	}
+	rule, err := algorithms.FailureAssociationRule(cfg, alg, failure)
+	if err != nil {
+		return nil, errors.Annotate(err, "obtain failure association rule").Err()
+	}
+
+	ruleFailures, err := ac.ReadRuleFailures7d(ctx, luciProject, rule)
+	if err != nil {
+		return nil, errors.Annotate(err, "reading rule failures").Err()
+	}
+	clusterFailures := analysis.FailureCounts{
+		Nominal:        cs.Failures7d.Nominal,
+		PreExoneration: cs.Failures7d.PreExoneration,
+	}
+	return &PreparedRule{
+		RuleDefinition: rule,
+		SourceCluster:  clusterID,
+		Preview:        preview(*ruleFailures, clusterFailures),
+	}, nil
+}
+
+func preview(ruleFailures, clusterFailures analysis.FailureCounts) *Preview {
+	p := &Preview{
+		RuleFailures7d:    ruleFailures,
+		ClusterFailures7d: clusterFailures,
+		Match:             Matches,
+	}
+	if clusterFailures.PreExoneration == 0 {
+		if ruleFailures.PreExoneration > 0 {
+			p.Match = OverMatches
+		}
+		return p
+	}
+	p.Ratio = float64(ruleFailures.PreExoneration) / float64(clusterFailures.PreExoneration)
+	switch {
+	case p.Ratio > 1+Tolerance:
+		p.Match = OverMatches
+	case p.Ratio < 1-Tolerance:
+		p.Match = UnderMatches
+	}
+	return p
+}
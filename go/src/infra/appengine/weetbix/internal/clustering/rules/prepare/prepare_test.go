@@ -0,0 +1,253 @@
+// Copyright 2021 The Chromium Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package prepare
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"testing"
+	"time"
+
+	"cloud.google.com/go/bigquery"
+
+	"infra/appengine/weetbix/internal/analysis"
+	"infra/appengine/weetbix/internal/clustering"
+	"infra/appengine/weetbix/internal/clustering/algorithms/failurereason"
+	"infra/appengine/weetbix/internal/clustering/algorithms/rulesalgorithm"
+	"infra/appengine/weetbix/internal/clustering/algorithms/testname"
+	"infra/appengine/weetbix/internal/clustering/rules/lang"
+	"infra/appengine/weetbix/internal/config"
+	"infra/appengine/weetbix/internal/config/compiledcfg"
+	pb "infra/appengine/weetbix/proto/v1"
+
+	. "github.com/smartystreets/goconvey/convey"
+	. "go.chromium.org/luci/common/testing/assertions"
+)
+
+// fakeFailure is a failure in the fake analysis backend.
+type fakeFailure struct {
+	test         string
+	reason       string
+	isExonerated bool
+}
+
+// fakeAnalysisClient is a fake analysis backend, which evaluates rules
+// against a fixed set of failures.
+type fakeAnalysisClient struct {
+	cluster  *analysis.ClusterSummary
+	failures []fakeFailure
+}
+
+func (f *fakeAnalysisClient) ReadCluster(ctx context.Context, luciProject string, clusterID clustering.ClusterID) (*analysis.ClusterSummary, error) {
+	if f.cluster == nil || f.cluster.ClusterID != clusterID {
+		return nil, errors.New("cluster not found")
+	}
+	return f.cluster, nil
+}
+
+func (f *fakeAnalysisClient) ReadRuleFailures7d(ctx context.Context, luciProject, rule string) (*analysis.FailureCounts, error) {
+	expr, err := lang.Parse(rule, "test", "reason")
+	if err != nil {
+		return nil, err
+	}
+	counts := &analysis.FailureCounts{}
+	for _, failure := range f.failures {
+		if !expr.Evaluate(map[string]string{"test": failure.test, "reason": failure.reason}) {
+			continue
+		}
+		counts.PreExoneration++
+		if !failure.isExonerated {
+			counts.Nominal++
+		}
+	}
+	return counts, nil
+}
+
+func reasonClusterID(cfg *compiledcfg.ProjectConfig, reason string) clustering.ClusterID {
+	alg := &failurereason.Algorithm{}
+	id := alg.Cluster(cfg, &clustering.Failure{Reason: &pb.FailureReason{PrimaryErrorMessage: reason}})
+	return clustering.ClusterID{Algorithm: alg.Name(), ID: hex.EncodeToString(id)}
+}
+
+func testNameClusterID(cfg *compiledcfg.ProjectConfig, testID string) clustering.ClusterID {
+	alg := &testname.Algorithm{}
+	id := alg.Cluster(cfg, &clustering.Failure{TestID: testID})
+	return clustering.ClusterID{Algorithm: alg.Name(), ID: hex.EncodeToString(id)}
+}
+
+func TestFromCluster(t *testing.T) {
+	Convey(`FromCluster`, t, func() {
+		ctx := context.Background()
+		ac := &fakeAnalysisClient{}
+		cfg, err := compiledcfg.NewConfig(&config.ProjectConfig{
+			Clustering: &config.Clustering{
+				TestNameMaskingRules: []*config.TestNameMaskingRule{
+					{
+						Name:    "webtest-virtual-suite",
+						Pattern: `^ninja://:blink_web_tests/(virtual/[^/]+/)`,
+					},
+				},
+			},
+		}, time.Date(2021, time.February, 1, 1, 0, 0, 0, time.UTC))
+		So(err, ShouldBeNil)
+		setCluster := func(clusterID clustering.ClusterID, testID, reason string, failures7d int64) {
+			ac.cluster = &analysis.ClusterSummary{
+				ClusterID:            clusterID,
+				Failures7d:           analysis.Counts{Nominal: failures7d, PreExoneration: failures7d},
+				ExampleTestID:        testID,
+				ExampleFailureReason: bigquery.NullString{StringVal: reason, Valid: reason != ""},
+			}
+		}
+
+		Convey(`Reason with regexp metacharacters`, func() {
+			reason := `Expected (a.b) [c*d]+ {e?} ^f$ | g\h at 0x1234abcd`
+			clusterID := reasonClusterID(cfg, reason)
+			setCluster(clusterID, "ninja://test", reason, 2)
+			ac.failures = []fakeFailure{
+				{test: "ninja://test", reason: reason},
+				{test: "ninja://other_test", reason: `Expected (a.b) [c*d]+ {e?} ^f$ | g\h at 0x5678ef01`},
+				// Metacharacters must only match themselves.
+				{test: "ninja://test", reason: `Expected (axb) [c*d]+ {e?} ^f$ | g\h at 0x1234abcd`},
+				{test: "ninja://test", reason: `Expected (a.b) [cccd]+ {e?} ^f$ | g\h at 0x1234abcd`},
+				{test: "ninja://test", reason: `Expected (a.b) [c*d] {e?} ^f$ | g\h at 0x1234abcd`},
+				{test: "ninja://test", reason: `Expected (a.b) [c*d]+ {e?} ^f$ | gh at 0x1234abcd`},
+			}
+
+			r, err := FromCluster(ctx, ac, "chromium", cfg, clusterID)
+			So(err, ShouldBeNil)
+			So(r.RuleDefinition, ShouldEqual, `reason LIKE "Expected (a.b) [c*d]+ {e?} ^f$ | g\\\\h at %"`)
+			So(r.SourceCluster, ShouldResemble, clusterID)
+			So(r.Preview, ShouldResemble, &Preview{
+				RuleFailures7d:    analysis.FailureCounts{Nominal: 2, PreExoneration: 2},
+				ClusterFailures7d: analysis.FailureCounts{Nominal: 2, PreExoneration: 2},
+				Ratio:             1,
+				Match:             Matches,
+			})
+		})
+		Convey(`Reason with LIKE wildcards`, func() {
+			reason := `100% of _tests_ failed`
+			clusterID := reasonClusterID(cfg, reason)
+			setCluster(clusterID, "ninja://test", reason, 1)
+			ac.failures = []fakeFailure{
+				{test: "ninja://test", reason: reason},
+				{test: "ninja://test", reason: `100x of atestsb failed`},
+			}
+
+			r, err := FromCluster(ctx, ac, "chromium", cfg, clusterID)
+			So(err, ShouldBeNil)
+			So(r.RuleDefinition, ShouldEqual, `reason LIKE "%\\% of \\_tests\\_ failed"`)
+			So(r.Preview.RuleFailures7d.PreExoneration, ShouldEqual, 1)
+			So(r.Preview.Match, ShouldEqual, Matches)
+		})
+		Convey(`Reason with quotes and control characters`, func() {
+			reason := "\"quoted\" 'single'\n\ttab"
+			clusterID := reasonClusterID(cfg, reason)
+			setCluster(clusterID, "ninja://test", reason, 1)
+			ac.failures = []fakeFailure{
+				{test: "ninja://test", reason: reason},
+				{test: "ninja://test", reason: "\"quoted\" 'single' \ttab"},
+			}
+
+			r, err := FromCluster(ctx, ac, "chromium", cfg, clusterID)
+			So(err, ShouldBeNil)
+			So(r.RuleDefinition, ShouldEqual, `reason LIKE "\"quoted\" 'single'\n\ttab"`)
+			So(r.Preview.RuleFailures7d.PreExoneration, ShouldEqual, 1)
+		})
+		Convey(`Test name`, func() {
+			testID := `ninja://test/"quoted"\path.*`
+			clusterID := testNameClusterID(cfg, testID)
+			setCluster(clusterID, testID, "", 1)
+			ac.failures = []fakeFailure{
+				{test: testID},
+				{test: `ninja://test/"quoted"\path.x`},
+			}
+
+			r, err := FromCluster(ctx, ac, "chromium", cfg, clusterID)
+			So(err, ShouldBeNil)
+			So(r.RuleDefinition, ShouldEqual, `test = "ninja://test/\"quoted\"\\path.*"`)
+			So(r.Preview.RuleFailures7d.PreExoneration, ShouldEqual, 1)
+		})
+		Convey(`Masked test name`, func() {
+			testID := "ninja://:blink_web_tests/virtual/gpu/fast/canvas/fill_rect.html"
+			clusterID := testNameClusterID(cfg, testID)
+			setCluster(clusterID, testID, "", 2)
+			ac.failures = []fakeFailure{
+				{test: testID},
+				{test: "ninja://:blink_web_tests/virtual/threaded/fast/canvas/fill_rect.html"},
+				{test: "ninja://:blink_web_tests/virtual/gpu/fast/canvas/fill_rect_html"},
+			}
+
+			r, err := FromCluster(ctx, ac, "chromium", cfg, clusterID)
+			So(err, ShouldBeNil)
+			So(r.RuleDefinition, ShouldEqual, `test LIKE "ninja://:blink\\_web\\_tests/%fast/canvas/fill\\_rect.html"`)
+			So(r.Preview.RuleFailures7d.PreExoneration, ShouldEqual, 2)
+			So(r.Preview.Match, ShouldEqual, Matches)
+
+			// The rule also matches the test outside of virtual suites,
+			// which is clustered separately.
+			ac.failures = append(ac.failures, fakeFailure{test: "ninja://:blink_web_tests/fast/canvas/fill_rect.html"})
+			r, err = FromCluster(ctx, ac, "chromium", cfg, clusterID)
+			So(err, ShouldBeNil)
+			So(r.Preview.Match, ShouldEqual, OverMatches)
+		})
+		Convey(`Preview`, func() {
+			reason := "Failure at line 12"
+			clusterID := reasonClusterID(cfg, reason)
+			ac.failures = []fakeFailure{
+				{reason: "Failure at line 12"},
+				{reason: "Failure at line 13"},
+				{reason: "Failure at line 14", isExonerated: true},
+				{reason: "Failure at line 15", isExonerated: true},
+				{reason: "Unrelated failure"},
+			}
+			Convey(`Matches within tolerance`, func() {
+				setCluster(clusterID, "", reason, 4)
+				r, err := FromCluster(ctx, ac, "chromium", cfg, clusterID)
+				So(err, ShouldBeNil)
+				So(r.Preview.RuleFailures7d, ShouldResemble, analysis.FailureCounts{Nominal: 2, PreExoneration: 4})
+				So(r.Preview.Ratio, ShouldEqual, 1)
+				So(r.Preview.Match, ShouldEqual, Matches)
+			})
+			Convey(`Over-matches`, func() {
+				setCluster(clusterID, "", reason, 2)
+				r, err := FromCluster(ctx, ac, "chromium", cfg, clusterID)
+				So(err, ShouldBeNil)
+				So(r.Preview.Ratio, ShouldEqual, 2)
+				So(r.Preview.Match, ShouldEqual, OverMatches)
+			})
+			Convey(`Under-matches`, func() {
+				setCluster(clusterID, "", reason, 5)
+				r, err := FromCluster(ctx, ac, "chromium", cfg, clusterID)
+				So(err, ShouldBeNil)
+				So(r.Preview.Ratio, ShouldEqual, 0.8)
+				So(r.Preview.Match, ShouldEqual, UnderMatches)
+			})
+			Convey(`Cluster without failures`, func() {
+				setCluster(clusterID, "", reason, 0)
+				r, err := FromCluster(ctx, ac, "chromium", cfg, clusterID)
+				So(err, ShouldBeNil)
+				So(r.Preview.Ratio, ShouldEqual, 0)
+				So(r.Preview.Match, ShouldEqual, OverMatches)
+
+				ac.failures = nil
+				r, err = FromCluster(ctx, ac, "chromium", cfg, clusterID)
+				So(err, ShouldBeNil)
+				So(r.Preview.Match, ShouldEqual, Matches)
+			})
+		})
+		Convey(`Rule cluster`, func() {
+			clusterID := clustering.ClusterID{Algorithm: rulesalgorithm.AlgorithmName, ID: "00112233445566778899aabbccddeeff"}
+			_, err := FromCluster(ctx, ac, "chromium", cfg, clusterID)
+			So(err, ShouldEqual, ErrNotSuggestedCluster)
+		})
+		Convey(`Example not in cluster`, func() {
+			clusterID := reasonClusterID(cfg, "Failure A")
+			setCluster(clusterID, "", "Failure B", 1)
+			_, err := FromCluster(ctx, ac, "chromium", cfg, clusterID)
+			So(err, ShouldErrLike, "example failure did not match cluster ID")
+		})
+	})
+}
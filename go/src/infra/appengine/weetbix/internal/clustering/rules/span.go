@@ -14,6 +14,7 @@ import (
 	"go.chromium.org/luci/common/errors"
 	"go.chromium.org/luci/server/span"
 
+	"infra/appengine/weetbix/internal/aip"
 	"infra/appengine/weetbix/internal/bugs"
 	"infra/appengine/weetbix/internal/clustering"
 	"infra/appengine/weetbix/internal/clustering/rules/lang"
@@ -29,13 +30,18 @@ var Identifiers = []string{"test", "reason"}
 var RuleIDRe = regexp.MustCompile(`^[0-9a-f]{32}$`)
 
 // UserRe matches valid users. These are email addresses or the special
-// value "weetbix".
-var UserRe = regexp.MustCompile(`^weetbix|([a-zA-Z0-9_.+-]+@[a-zA-Z0-9-]+\.[a-zA-Z0-9-.]+)$`)
+// values "weetbix" and "weetbix-hygiene".
+var UserRe = regexp.MustCompile(`^(weetbix|weetbix-hygiene|([a-zA-Z0-9_.+-]+@[a-zA-Z0-9-]+\.[a-zA-Z0-9-.]+))$`)
 
 // WeetbixSystem is the special user that identifies changes made by the
 // Weetbix system itself in audit fields.
 const WeetbixSystem = "weetbix"
 
+// WeetbixHygiene is the special user that identifies changes made by the
+// Weetbix rule hygiene job in audit fields, e.g. the archival of rules
+// which no longer match any failures.
+const WeetbixHygiene = "weetbix-hygiene"
+
 // StartingEpoch is the rule version used for projects that have no rules
 // (even inactive rules). It is deliberately different from the timestamp
 // zero value to be discernible from "timestamp not populated" programming
@@ -73,6 +79,22 @@ type FailureAssociationRule struct {
 	// of the source cluster, this cluster ID tells bug filing to ignore
 	// the source cluster when determining whether new bugs need to be filed.
 	SourceCluster clustering.ClusterID `json:"sourceCluster"`
+	// The partition time of the most recent failure matched by the rule,
+	// as last observed by the rule hygiene job. Zero if the rule has not
+	// been observed to match any failure. Output only.
+	LastMatched time.Time `json:"lastMatched"`
+	// Whether the rule has not matched any failure for the period
+	// configured in the project's rule hygiene configuration. Output only.
+	IsStale bool `json:"isStale"`
+	// The time the bug updater first observed the rule's bug to be fixed.
+	// Zero if the bug is not fixed. Output only.
+	BugFixedTime time.Time `json:"bugFixedTime"`
+	// The time since which the rule's cluster has had no impact, while its
+	// bug is fixed. Zero if the bug is not fixed or the cluster has impact.
+	// Output only.
+	ImpactFreeSince time.Time `json:"impactFreeSince"`
+	// Whether failures recurred after the rule's bug was fixed. Output only.
+	IsRecurred bool `json:"isRecurred"`
 }
 
 // Read reads the failure association rule with the given rule ID.
@@ -93,8 +115,47 @@ func Read(ctx context.Context, project string, id string) (*FailureAssociationRu
 
 // ReadActive reads all active Weetbix failure association rules in the given LUCI project.
 func ReadActive(ctx context.Context, projectID string) ([]*FailureAssociationRule, error) {
-	whereClause := `IsActive`
-	rs, err := readWhere(ctx, projectID, whereClause, nil)
+	return ReadActiveMatching(ctx, projectID, nil, nil)
+}
+
+// ListTable is the table of the fields by which rules can be filtered and
+// ordered when they are listed. The field names are those of the JSON
+// representation of rules.
+var ListTable = aip.NewTable(
+	&aip.Column{Name: "ruleId", SQL: "RuleId", Type: aip.String, Filterable: true, Sortable: true},
+	&aip.Column{Name: "ruleDefinition", SQL: "RuleDefinition", Type: aip.String, Filterable: true},
+	&aip.Column{Name: "bug.system", SQL: "BugSystem", Type: aip.String, Filterable: true, Sortable: true},
+	&aip.Column{Name: "bug.id", SQL: "BugId", Type: aip.String, Filterable: true, Sortable: true},
+	&aip.Column{Name: "creationTime", SQL: "CreationTime", Type: aip.Timestamp, Filterable: true, Sortable: true},
+	&aip.Column{Name: "creationUser", SQL: "CreationUser", Type: aip.String, Filterable: true, Sortable: true},
+	&aip.Column{Name: "lastUpdated", SQL: "LastUpdated", Type: aip.Timestamp, Filterable: true, Sortable: true},
+	&aip.Column{Name: "lastUpdatedUser", SQL: "LastUpdatedUser", Type: aip.String, Filterable: true, Sortable: true},
+	&aip.Column{Name: "sourceCluster.algorithm", SQL: "SourceClusterAlgorithm", Type: aip.String, Filterable: true},
+	&aip.Column{Name: "sourceCluster.id", SQL: "SourceClusterId", Type: aip.String, Filterable: true},
+	// Rules which have not been observed to match any failure have a zero
+	// lastMatched, like in their JSON representation.
+	&aip.Column{Name: "lastMatched", SQL: "IFNULL(LastMatched, TIMESTAMP '0001-01-01 00:00:00+00')", Type: aip.Timestamp, Filterable: true, Sortable: true},
+	&aip.Column{Name: "isStale", SQL: "IFNULL(IsStale, FALSE)", Type: aip.Bool, Filterable: true},
+	&aip.Column{Name: "isRecurred", SQL: "IFNULL(IsRecurred, FALSE)", Type: aip.Bool, Filterable: true},
+)
+
+// ReadActiveMatching reads the active failure association rules in the
+// given LUCI project which match the filter, in the given order, and then
+// by bug. A nil filter matches all rules. The filter and order must be
+// parsed with ListTable.
+func ReadActiveMatching(ctx context.Context, projectID string, filter *aip.Filter, order []aip.OrderBy) ([]*FailureAssociationRule, error) {
+	filterClause, params := filter.WhereClause("filter_")
+	whereClause := `IsActive AND ` + filterClause
+	paramsMap := make(map[string]interface{}, len(params))
+	for _, p := range params {
+		paramsMap[p.Name] = p.Value
+	}
+	orderBy := aip.OrderByClause(order)
+	if orderBy != "" {
+		orderBy += ", "
+	}
+	orderBy += "BugSystem, BugId"
+	rs, err := readWhereOrdered(ctx, projectID, whereClause, paramsMap, orderBy)
 	if err != nil {
 		return nil, errors.Annotate(err, "query active rules").Err()
 	}
@@ -121,15 +182,23 @@ func ReadDelta(ctx context.Context, projectID string, sinceTime time.Time) ([]*F
 // readWhere failure association rules matching the given where clause,
 // substituting params for any SQL parameters used in that calsue.
 func readWhere(ctx context.Context, projectID string, whereClause string, params map[string]interface{}) ([]*FailureAssociationRule, error) {
+	return readWhereOrdered(ctx, projectID, whereClause, params, "BugSystem, BugId")
+}
+
+// readWhereOrdered reads failure association rules matching the given
+// where clause, like readWhere, in the order of the given ORDER BY clause.
+func readWhereOrdered(ctx context.Context, projectID string, whereClause string, params map[string]interface{}, orderBy string) ([]*FailureAssociationRule, error) {
 	stmt := spanner.NewStatement(`
 		SELECT RuleId, RuleDefinition, BugSystem, BugId,
 		  CreationTime, LastUpdated,
 		  CreationUser, LastUpdatedUser,
 		  IsActive,
-		  SourceClusterAlgorithm, SourceClusterId
+		  SourceClusterAlgorithm, SourceClusterId,
+		  LastMatched, IsStale,
+		  BugFixedTime, ImpactFreeSince, IsRecurred
 		FROM FailureAssociationRules
 		WHERE Project = @projectID AND (` + whereClause + `)
-		ORDER BY BugSystem, BugId
+		ORDER BY ` + orderBy + `
 	`)
 	stmt.Params = make(map[string]interface{})
 	for k, v := range params {
@@ -145,12 +214,18 @@ func readWhere(ctx context.Context, projectID string, whereClause string, params
 		var creationUser, lastUpdatedUser string
 		var isActive spanner.NullBool
 		var sourceClusterAlgorithm, sourceClusterID string
+		var lastMatched spanner.NullTime
+		var isStale spanner.NullBool
+		var bugFixedTime, impactFreeSince spanner.NullTime
+		var isRecurred spanner.NullBool
 		err := r.Columns(
 			&ruleID, &ruleDefinition, &bugSystem, &bugID,
 			&creationTime, &lastUpdated,
 			&creationUser, &lastUpdatedUser,
 			&isActive,
 			&sourceClusterAlgorithm, &sourceClusterID,
+			&lastMatched, &isStale,
+			&bugFixedTime, &impactFreeSince, &isRecurred,
 		)
 		if err != nil {
 			return errors.Annotate(err, "read rule row").Err()
@@ -170,6 +245,11 @@ func readWhere(ctx context.Context, projectID string, whereClause string, params
 				Algorithm: sourceClusterAlgorithm,
 				ID:        sourceClusterID,
 			},
+			LastMatched:     lastMatched.Time,
+			IsStale:         isStale.Valid && isStale.Bool,
+			BugFixedTime:    bugFixedTime.Time,
+			ImpactFreeSince: impactFreeSince.Time,
+			IsRecurred:      isRecurred.Valid && isRecurred.Bool,
 		}
 		rs = append(rs, rule)
 		return nil
@@ -269,6 +349,44 @@ func Update(ctx context.Context, r *FailureAssociationRule, user string) error {
 	return nil
 }
 
+// UpdateHygiene records the partition time of the most recent failure
+// matched by a rule, and whether the rule is stale, as observed by the rule
+// hygiene job. lastMatched may be zero if the rule has not been observed to
+// match any failure.
+//
+// As this does not change how failures are matched, it does not update
+// LastUpdated. This avoids triggering re-clustering.
+func UpdateHygiene(ctx context.Context, project, ruleID string, lastMatched time.Time, isStale bool) {
+	ms := spanutil.UpdateMap("FailureAssociationRules", map[string]interface{}{
+		"Project":     project,
+		"RuleId":      ruleID,
+		"LastMatched": spanner.NullTime{Time: lastMatched, Valid: !lastMatched.IsZero()},
+		// IsStale uses the value 'NULL' to indicate false, and true to indicate true.
+		"IsStale": spanner.NullBool{Bool: isStale, Valid: isStale},
+	})
+	span.BufferWrite(ctx, ms)
+}
+
+// UpdateBugSync records the state of the synchronisation of a rule with the
+// status of its bug, as maintained by the bug updater: the time the bug was
+// first observed to be fixed, the time since which the rule's cluster has
+// had no impact and whether failures recurred after the fix. The times may
+// be zero if they are not set.
+//
+// As this does not change how failures are matched, it does not update
+// LastUpdated. This avoids triggering re-clustering.
+func UpdateBugSync(ctx context.Context, project, ruleID string, bugFixedTime, impactFreeSince time.Time, isRecurred bool) {
+	ms := spanutil.UpdateMap("FailureAssociationRules", map[string]interface{}{
+		"Project":         project,
+		"RuleId":          ruleID,
+		"BugFixedTime":    spanner.NullTime{Time: bugFixedTime, Valid: !bugFixedTime.IsZero()},
+		"ImpactFreeSince": spanner.NullTime{Time: impactFreeSince, Valid: !impactFreeSince.IsZero()},
+		// IsRecurred uses the value 'NULL' to indicate false, and true to indicate true.
+		"IsRecurred": spanner.NullBool{Bool: isRecurred, Valid: isRecurred},
+	})
+	span.BufferWrite(ctx, ms)
+}
+
 func validateRule(r *FailureAssociationRule) error {
 	switch {
 	case !config.ProjectRe.MatchString(r.Project):
@@ -56,6 +56,68 @@ func TestSpan(t *testing.T) {
 				})
 			})
 		})
+		Convey(`ReadActiveMatching`, func() {
+			reference := time.Date(2020, 1, 2, 3, 4, 5, 6000, time.UTC)
+			rulesToCreate := []*FailureAssociationRule{
+				NewRule(0).WithBugID("chromium/100").WithLastUpdated(reference).Build(),
+				NewRule(1).WithBugID("chromium/200").WithLastUpdated(reference.Add(time.Hour)).WithStale(true).Build(),
+				NewRule(2).WithBugID("chromium/300").WithLastUpdated(reference.Add(2 * time.Hour)).WithRuleDefinition(`test = "flaky_test"`).Build(),
+				NewRule(3).WithBugID("chromium/400").WithActive(false).Build(),
+				NewRule(4).WithProject("otherproject").Build(),
+			}
+			err := SetRulesForTesting(ctx, rulesToCreate)
+			So(err, ShouldBeNil)
+
+			read := func(filter, orderBy string) []*FailureAssociationRule {
+				f, err := ListTable.ParseFilter(filter)
+				So(err, ShouldBeNil)
+				o, err := ListTable.ParseOrderBy(orderBy)
+				So(err, ShouldBeNil)
+				rules, err := ReadActiveMatching(span.Single(ctx), testProject, f, o)
+				So(err, ShouldBeNil)
+				return rules
+			}
+
+			Convey(`No filter`, func() {
+				So(read("", ""), ShouldResemble, []*FailureAssociationRule{
+					rulesToCreate[0],
+					rulesToCreate[1],
+					rulesToCreate[2],
+				})
+			})
+			Convey(`Filter`, func() {
+				So(read(`bug.id = "chromium/200"`, ""), ShouldResemble, []*FailureAssociationRule{
+					rulesToCreate[1],
+				})
+				So(read(`isStale = false`, ""), ShouldResemble, []*FailureAssociationRule{
+					rulesToCreate[0],
+					rulesToCreate[2],
+				})
+				So(read(`ruleDefinition:flaky_test OR lastUpdated < "2020-01-02T03:30:00Z"`, ""), ShouldResemble, []*FailureAssociationRule{
+					rulesToCreate[0],
+					rulesToCreate[2],
+				})
+				So(read(`-bug.id:"/1" lastUpdated >= "2020-01-02T03:04:05.000006Z"`, ""), ShouldResemble, []*FailureAssociationRule{
+					rulesToCreate[1],
+					rulesToCreate[2],
+				})
+				// Like wildcards in values are matched literally.
+				So(read(`ruleDefinition:"flaky%"`, ""), ShouldResemble, []*FailureAssociationRule{})
+				// Inactive rules are never matched.
+				So(read(`bug.id = "chromium/400"`, ""), ShouldResemble, []*FailureAssociationRule{})
+			})
+			Convey(`Order`, func() {
+				So(read("", "lastUpdated desc"), ShouldResemble, []*FailureAssociationRule{
+					rulesToCreate[2],
+					rulesToCreate[1],
+					rulesToCreate[0],
+				})
+				So(read(`isStale = false`, "lastUpdated desc"), ShouldResemble, []*FailureAssociationRule{
+					rulesToCreate[2],
+					rulesToCreate[0],
+				})
+			})
+		})
 		Convey(`ReadDelta`, func() {
 			Convey(`Invalid since time`, func() {
 				_, err := ReadDelta(span.Single(ctx), testProject, time.Time{})
@@ -262,5 +324,132 @@ func TestSpan(t *testing.T) {
 				})
 			})
 		})
+		Convey(`UpdateHygiene`, func() {
+			r := NewRule(100).Build()
+			err := SetRulesForTesting(ctx, []*FailureAssociationRule{r})
+			So(err, ShouldBeNil)
+
+			testUpdateHygiene := func(lastMatched time.Time, isStale bool) {
+				_, err := span.ReadWriteTransaction(ctx, func(ctx context.Context) error {
+					UpdateHygiene(ctx, r.Project, r.RuleID, lastMatched, isStale)
+					return nil
+				})
+				So(err, ShouldBeNil)
+			}
+			readRule := func() *FailureAssociationRule {
+				txn, cancel := span.ReadOnlyTransaction(ctx)
+				defer cancel()
+				rule, err := Read(txn, r.Project, r.RuleID)
+				So(err, ShouldBeNil)
+				return rule
+			}
+
+			lastMatched := time.Date(2021, time.December, 1, 12, 0, 0, 0, time.UTC)
+			testUpdateHygiene(lastMatched, true)
+
+			// LastUpdated is unchanged, so that re-clustering is not triggered.
+			expectedRule := *r
+			expectedRule.LastMatched = lastMatched
+			expectedRule.IsStale = true
+			So(readRule(), ShouldResemble, &expectedRule)
+
+			Convey(`Clear`, func() {
+				testUpdateHygiene(time.Time{}, false)
+				So(readRule(), ShouldResemble, r)
+			})
+		})
+		Convey(`UpdateBugSync`, func() {
+			r := NewRule(100).Build()
+			err := SetRulesForTesting(ctx, []*FailureAssociationRule{r})
+			So(err, ShouldBeNil)
+
+			testUpdateBugSync := func(bugFixedTime, impactFreeSince time.Time, isRecurred bool) {
+				_, err := span.ReadWriteTransaction(ctx, func(ctx context.Context) error {
+					UpdateBugSync(ctx, r.Project, r.RuleID, bugFixedTime, impactFreeSince, isRecurred)
+					return nil
+				})
+				So(err, ShouldBeNil)
+			}
+			readRule := func() *FailureAssociationRule {
+				txn, cancel := span.ReadOnlyTransaction(ctx)
+				defer cancel()
+				rule, err := Read(txn, r.Project, r.RuleID)
+				So(err, ShouldBeNil)
+				return rule
+			}
+
+			bugFixedTime := time.Date(2021, time.December, 1, 12, 0, 0, 0, time.UTC)
+			impactFreeSince := time.Date(2021, time.December, 2, 12, 0, 0, 0, time.UTC)
+			testUpdateBugSync(bugFixedTime, impactFreeSince, true)
+
+			// LastUpdated is unchanged, so that re-clustering is not triggered.
+			expectedRule := *r
+			expectedRule.BugFixedTime = bugFixedTime
+			expectedRule.ImpactFreeSince = impactFreeSince
+			expectedRule.IsRecurred = true
+			So(readRule(), ShouldResemble, &expectedRule)
+
+			Convey(`Clear`, func() {
+				testUpdateBugSync(time.Time{}, time.Time{}, false)
+				So(readRule(), ShouldResemble, r)
+			})
+		})
+		Convey(`History`, func() {
+			r := NewRule(100).Build()
+			err := SetRulesForTesting(ctx, []*FailureAssociationRule{r})
+			So(err, ShouldBeNil)
+
+			record := func(e *HistoryEntry) (time.Time, error) {
+				commitTime, err := span.ReadWriteTransaction(ctx, func(ctx context.Context) error {
+					return RecordHistory(ctx, e)
+				})
+				return commitTime.In(time.UTC), err
+			}
+
+			Convey(`Empty`, func() {
+				es, err := ReadHistory(span.Single(ctx), r.Project, r.RuleID)
+				So(err, ShouldBeNil)
+				So(es, ShouldResemble, []*HistoryEntry{})
+			})
+			Convey(`Valid`, func() {
+				fixed := &HistoryEntry{
+					Project: r.Project,
+					RuleID:  r.RuleID,
+					Action:  ActionBugFixed,
+					User:    WeetbixSystem,
+					Details: "The bug was marked fixed.",
+				}
+				archived := &HistoryEntry{
+					Project: r.Project,
+					RuleID:  r.RuleID,
+					Action:  ActionArchived,
+					User:    WeetbixSystem,
+					Details: "The rule was archived.",
+				}
+				fixed.ChangeTime, err = record(fixed)
+				So(err, ShouldBeNil)
+				archived.ChangeTime, err = record(archived)
+				So(err, ShouldBeNil)
+
+				es, err := ReadHistory(span.Single(ctx), r.Project, r.RuleID)
+				So(err, ShouldBeNil)
+				So(es, ShouldResemble, []*HistoryEntry{archived, fixed})
+
+				Convey(`Deleted with rule`, func() {
+					So(SetRulesForTesting(ctx, nil), ShouldBeNil)
+					es, err := ReadHistory(span.Single(ctx), r.Project, r.RuleID)
+					So(err, ShouldBeNil)
+					So(es, ShouldBeEmpty)
+				})
+			})
+			Convey(`With invalid User`, func() {
+				_, err := record(&HistoryEntry{Project: r.Project, RuleID: r.RuleID, Action: ActionBugFixed})
+				So(err, ShouldErrLike, "user must be valid")
+			})
+			Convey(`Without Action`, func() {
+				_, err := record(&HistoryEntry{Project: r.Project, RuleID: r.RuleID, User: WeetbixSystem})
+				So(err, ShouldErrLike, "action must be specified")
+			})
+		})
 	})
 }
@@ -32,6 +32,11 @@ type RuleBuilder struct {
 	creationTime  time.Time
 	lastUpdated   time.Time
 	sourceCluster clustering.ClusterID
+	bugID         string
+	lastMatched   time.Time
+	stale         bool
+	bugFixedTime  time.Time
+	recurred      bool
 }
 
 // NewRule starts building a new Rule.
@@ -43,6 +48,7 @@ func NewRule(uniqifier int) *RuleBuilder {
 		definition:   "reason LIKE \"%exit code 5%\" AND test LIKE \"tast.arc.%\"",
 		creationTime: time.Date(1900, 1, 2, 3, 4, 5, uniqifier, time.UTC),
 		lastUpdated:  time.Date(1900, 1, 2, 3, 4, 5, uniqifier, time.UTC),
+		bugID:        fmt.Sprintf("chromium/%v", uniqifier),
 		sourceCluster: clustering.ClusterID{
 			Algorithm: fmt.Sprintf("clusteralg%v", uniqifier),
 			ID:        hex.EncodeToString([]byte(fmt.Sprintf("id%v", uniqifier))),
@@ -87,19 +93,56 @@ func (b *RuleBuilder) WithSourceCluster(value clustering.ClusterID) *RuleBuilder
 	return b
 }
 
+// WithBugID specifies the identifier of the monorail bug associated with
+// the rule, e.g. "chromium/123".
+func (b *RuleBuilder) WithBugID(id string) *RuleBuilder {
+	b.bugID = id
+	return b
+}
+
+// WithLastMatched specifies the partition time of the last failure matched
+// by the rule.
+func (b *RuleBuilder) WithLastMatched(value time.Time) *RuleBuilder {
+	b.lastMatched = value
+	return b
+}
+
+// WithStale specifies whether the rule will be flagged as stale.
+func (b *RuleBuilder) WithStale(stale bool) *RuleBuilder {
+	b.stale = stale
+	return b
+}
+
+// WithBugFixedTime specifies the time the bug of the rule was first
+// observed to be fixed.
+func (b *RuleBuilder) WithBugFixedTime(value time.Time) *RuleBuilder {
+	b.bugFixedTime = value
+	return b
+}
+
+// WithRecurred specifies whether the rule will be flagged as recurred.
+func (b *RuleBuilder) WithRecurred(recurred bool) *RuleBuilder {
+	b.recurred = recurred
+	return b
+}
+
 func (b *RuleBuilder) Build() *FailureAssociationRule {
 	ruleIDBytes := sha256.Sum256([]byte(fmt.Sprintf("rule-id%v", b.uniqifier)))
 	return &FailureAssociationRule{
 		Project:         b.project,
 		RuleID:          hex.EncodeToString(ruleIDBytes[0:16]),
 		RuleDefinition:  b.definition,
-		Bug:             bugs.BugID{System: "monorail", ID: fmt.Sprintf("chromium/%v", b.uniqifier)},
+		Bug:             bugs.BugID{System: "monorail", ID: b.bugID},
 		IsActive:        b.active,
 		CreationTime:    b.creationTime,
 		CreationUser:    WeetbixSystem,
 		LastUpdated:     b.lastUpdated,
 		LastUpdatedUser: "user@google.com",
 		SourceCluster:   b.sourceCluster,
+		LastMatched:     b.lastMatched,
+		IsStale:         b.stale,
+		BugFixedTime:    b.bugFixedTime,
+		IsRecurred:      b.recurred,
 	}
 }
 
@@ -124,6 +167,11 @@ func SetRulesForTesting(ctx context.Context, rs []*FailureAssociationRule) error
 				"IsActive":               spanner.NullBool{Bool: r.IsActive, Valid: r.IsActive},
 				"SourceClusterAlgorithm": r.SourceCluster.Algorithm,
 				"SourceClusterId":        r.SourceCluster.ID,
+				"LastMatched":            spanner.NullTime{Time: r.LastMatched, Valid: !r.LastMatched.IsZero()},
+				"IsStale":                spanner.NullBool{Bool: r.IsStale, Valid: r.IsStale},
+				"BugFixedTime":           spanner.NullTime{Time: r.BugFixedTime, Valid: !r.BugFixedTime.IsZero()},
+				"ImpactFreeSince":        spanner.NullTime{Time: r.ImpactFreeSince, Valid: !r.ImpactFreeSince.IsZero()},
+				"IsRecurred":             spanner.NullBool{Bool: r.IsRecurred, Valid: r.IsRecurred},
 			})
 			span.BufferWrite(ctx, ms)
 		}
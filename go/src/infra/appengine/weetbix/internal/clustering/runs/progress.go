@@ -6,14 +6,23 @@ package runs
 
 import (
 	"context"
+	"infra/appengine/weetbix/internal/clustering"
 	"infra/appengine/weetbix/internal/clustering/algorithms"
 	"time"
 
+	"go.chromium.org/luci/server/caching"
 	"go.chromium.org/luci/server/span"
 )
 
-// ReclusteringTarget captures the rules and algorithms a re-clustering run
-// is re-clustering to.
+// progressCacheTTL is how long re-clustering progress is cached for
+// by ReadReclusteringProgressCached.
+const progressCacheTTL = 15 * time.Second
+
+// progressCache caches the re-clustering progress of each LUCI project.
+var progressCache = caching.RegisterLRUCache(0)
+
+// ReclusteringTarget captures the rules, algorithms and config a
+// re-clustering run is re-clustering to.
 type ReclusteringTarget struct {
 	// RulesVersion is the rules version the re-clustering run is attempting
 	// to achieve.
@@ -21,11 +30,14 @@ type ReclusteringTarget struct {
 	// AlgorithmsVersion is the algorithms version the re-clustering run is
 	// attempting to achieve.
 	AlgorithmsVersion int64 `json:"algorithmsVersion"`
+	// ConfigVersion is the project config version the re-clustering run is
+	// attempting to achieve.
+	ConfigVersion time.Time `json:"configVersion"`
 }
 
 // ReclusteringProgress captures the progress re-clustering a
 // given LUCI project's test results using specific rules
-// versions or algorithms versions.
+// versions, algorithms versions or config versions.
 type ReclusteringProgress struct {
 	// ProgressPerMille is the progress of the current re-clustering run,
 	// measured in thousandths (per mille).
@@ -81,14 +93,79 @@ func ReadReclusteringProgress(ctx context.Context, project string) (*Reclusterin
 		Next: ReclusteringTarget{
 			RulesVersion:      lastWithProgress.RulesVersion,
 			AlgorithmsVersion: lastWithProgress.AlgorithmsVersion,
+			ConfigVersion:     lastWithProgress.ConfigVersion,
 		},
 		Last: ReclusteringTarget{
 			RulesVersion:      lastCompleted.RulesVersion,
 			AlgorithmsVersion: lastCompleted.AlgorithmsVersion,
+			ConfigVersion:     lastCompleted.ConfigVersion,
 		},
 	}, nil
 }
 
+// ReadReclusteringProgressCached reads the re-clustering progress for
+// the given LUCI project from an in-process cache, reading it from
+// Spanner if it is not cached. The progress returned may be up to
+// progressCacheTTL old, making it suitable for use when serving
+// cluster metrics.
+func ReadReclusteringProgressCached(ctx context.Context, project string) (*ReclusteringProgress, error) {
+	cache := progressCache.LRU(ctx)
+	if cache == nil {
+		// A fallback useful in unit tests that may not have the process cache
+		// available.
+		return ReadReclusteringProgress(ctx, project)
+	}
+	value, err := cache.GetOrCreate(ctx, project, func() (interface{}, time.Duration, error) {
+		progress, err := ReadReclusteringProgress(ctx, project)
+		if err != nil {
+			return nil, 0, err
+		}
+		return progress, progressCacheTTL, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return value.(*ReclusteringProgress), nil
+}
+
+// IsReclustering returns whether a re-clustering run is part-way
+// through changing Weetbix's clustering output, so that the output
+// mixes that of the last and next rules, algorithms or config versions.
+func (p *ReclusteringProgress) IsReclustering() bool {
+	return p.Next.AlgorithmsVersion != p.Last.AlgorithmsVersion ||
+		!p.Next.RulesVersion.Equal(p.Last.RulesVersion) ||
+		!p.Next.ConfigVersion.Equal(p.Last.ConfigVersion)
+}
+
+// AlgorithmsVersionsInEffect returns the versions of algorithms whose
+// output is in Weetbix's clustering output, in ascending order.
+func (p *ReclusteringProgress) AlgorithmsVersionsInEffect() []int64 {
+	if p.Next.AlgorithmsVersion == p.Last.AlgorithmsVersion {
+		return []int64{p.Last.AlgorithmsVersion}
+	}
+	if p.Next.AlgorithmsVersion < p.Last.AlgorithmsVersion {
+		return []int64{p.Next.AlgorithmsVersion, p.Last.AlgorithmsVersion}
+	}
+	return []int64{p.Last.AlgorithmsVersion, p.Next.AlgorithmsVersion}
+}
+
+// IsClusterStable returns whether the membership of the given cluster
+// is not being changed by the re-clustering run in progress, I.E. whether
+// the cluster contains only fully re-clustered data.
+func (p *ReclusteringProgress) IsClusterStable(clusterID clustering.ClusterID) bool {
+	if p.Next.AlgorithmsVersion != p.Last.AlgorithmsVersion {
+		// The algorithms version does not identify which algorithms
+		// changed, so all clusters may be changing.
+		return false
+	}
+	if clusterID.IsBugCluster() {
+		return p.Next.RulesVersion.Equal(p.Last.RulesVersion)
+	}
+	// Suggested clusters may be configured by the project, e.g. by its
+	// test name masking rules.
+	return p.Next.ConfigVersion.Equal(p.Last.ConfigVersion)
+}
+
 // IncorporatesLatestAlgorithms returns whether only the latest
 // algorithms are in Weetbix's clustering output.
 func (p *ReclusteringProgress) IncorporatesLatestAlgorithms() bool {
@@ -101,3 +178,9 @@ func (p *ReclusteringProgress) IncorporatesLatestAlgorithms() bool {
 func (p *ReclusteringProgress) IncorporatesRulesVersion(rulesVersion time.Time) bool {
 	return !rulesVersion.After(p.Last.RulesVersion)
 }
+
+// IncorporatesConfigVersion returns whether Weetbix's clustering output
+// reflects the given version of project configuration, and no other.
+func (p *ReclusteringProgress) IncorporatesConfigVersion(configVersion time.Time) bool {
+	return configVersion.Equal(p.Last.ConfigVersion) && configVersion.Equal(p.Next.ConfigVersion)
+}
@@ -15,6 +15,7 @@ import (
 
 	"infra/appengine/weetbix/internal/clustering/rules"
 	"infra/appengine/weetbix/internal/config"
+	"infra/appengine/weetbix/internal/config/compiledcfg"
 	spanutil "infra/appengine/weetbix/internal/span"
 )
 
@@ -33,6 +34,10 @@ type ReclusteringRun struct {
 	// Chunks with a RulesVersion less than this value are eligible to be
 	// re-clustered.
 	RulesVersion time.Time
+	// The minimum config version the reclustering run is trying to achieve.
+	// Chunks with a ConfigVersion less than this value are eligible to be
+	// re-clustered.
+	ConfigVersion time.Time
 	// The number of shards created for this run (for this LUCI project).
 	ShardCount int64
 	// The number of shards that have reported progress (at least once).
@@ -70,6 +75,7 @@ func Read(ctx context.Context, projectID string, attemptTimestamp time.Time) (*R
 // - AttemptTimestamp of 1900-01-01 00:00:00 UTC.
 // - AlgorithmsVersion of 1.
 // - RulesVersion of rules.StartingEpoch.
+// - ConfigVersion of compiledcfg.StartingEpoch.
 // - ShardCount and ShardsReported of 1.
 // - Progress of 1000.
 func ReadLast(ctx context.Context, projectID string) (*ReclusteringRun, error) {
@@ -118,6 +124,7 @@ func fakeLastRow(projectID string) *ReclusteringRun {
 		AttemptTimestamp:  time.Date(1900, 1, 1, 0, 0, 0, 0, time.UTC),
 		AlgorithmsVersion: 1,
 		RulesVersion:      rules.StartingEpoch,
+		ConfigVersion:     compiledcfg.StartingEpoch,
 		ShardCount:        1,
 		ShardsReported:    1,
 		Progress:          1000,
@@ -129,7 +136,7 @@ func fakeLastRow(projectID string) *ReclusteringRun {
 func readLastWhere(ctx context.Context, projectID string, whereClause string, params map[string]interface{}) (*ReclusteringRun, error) {
 	stmt := spanner.NewStatement(`
 		SELECT
-		  AttemptTimestamp, RulesVersion,
+		  AttemptTimestamp, RulesVersion, ConfigVersion,
 		  AlgorithmsVersion, ShardCount, ShardsReported, Progress
 		FROM ReclusteringRuns
 		WHERE Project = @projectID AND (` + whereClause + `)
@@ -144,10 +151,10 @@ func readLastWhere(ctx context.Context, projectID string, whereClause string, pa
 	it := span.Query(ctx, stmt)
 	rs := []*ReclusteringRun{}
 	err := it.Do(func(r *spanner.Row) error {
-		var attemptTimestamp, rulesVersion time.Time
+		var attemptTimestamp, rulesVersion, configVersion time.Time
 		var algorithmsVersion, shardCount, shardsReported, progress int64
 		err := r.Columns(
-			&attemptTimestamp, &rulesVersion,
+			&attemptTimestamp, &rulesVersion, &configVersion,
 			&algorithmsVersion, &shardCount, &shardsReported, &progress,
 		)
 		if err != nil {
@@ -159,6 +166,7 @@ func readLastWhere(ctx context.Context, projectID string, whereClause string, pa
 			AttemptTimestamp:  attemptTimestamp,
 			AlgorithmsVersion: algorithmsVersion,
 			RulesVersion:      rulesVersion,
+			ConfigVersion:     configVersion,
 			ShardCount:        shardCount,
 			ShardsReported:    shardsReported,
 			Progress:          progress,
@@ -182,6 +190,7 @@ func Create(ctx context.Context, r *ReclusteringRun) error {
 		"AttemptTimestamp":  r.AttemptTimestamp,
 		"AlgorithmsVersion": r.AlgorithmsVersion,
 		"RulesVersion":      r.RulesVersion,
+		"ConfigVersion":     r.ConfigVersion,
 		"ShardCount":        r.ShardCount,
 		"ShardsReported":    r.ShardsReported,
 		"Progress":          r.Progress,
@@ -200,6 +209,8 @@ func validateRun(r *ReclusteringRun) error {
 		return errors.New("algorithms version must be valid")
 	case r.RulesVersion.Before(rules.StartingEpoch):
 		return errors.New("rules version must be valid")
+	case r.ConfigVersion.Before(compiledcfg.StartingEpoch):
+		return errors.New("config version must be valid")
 	case r.ShardCount <= 0:
 		return errors.New("shard count must be valid")
 	case r.ShardsReported < 0 || r.ShardsReported > r.ShardCount:
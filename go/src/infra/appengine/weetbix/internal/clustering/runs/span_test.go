@@ -9,10 +9,13 @@ import (
 	"testing"
 	"time"
 
+	"go.chromium.org/luci/server/caching"
 	"go.chromium.org/luci/server/span"
 
+	"infra/appengine/weetbix/internal/clustering"
 	"infra/appengine/weetbix/internal/clustering/algorithms"
 	"infra/appengine/weetbix/internal/clustering/rules"
+	"infra/appengine/weetbix/internal/config/compiledcfg"
 	"infra/appengine/weetbix/internal/testutil"
 
 	. "github.com/smartystreets/goconvey/convey"
@@ -44,6 +47,7 @@ func TestSpan(t *testing.T) {
 				AttemptTimestamp:  time.Date(1900, 1, 1, 0, 0, 0, 0, time.UTC),
 				AlgorithmsVersion: 1,
 				RulesVersion:      rules.StartingEpoch,
+				ConfigVersion:     compiledcfg.StartingEpoch,
 				ShardCount:        1,
 				ShardsReported:    1,
 				Progress:          1000,
@@ -168,6 +172,125 @@ func TestSpan(t *testing.T) {
 				So(progress.LatestAlgorithmsVersion, ShouldEqual, algorithms.AlgorithmsVersion)
 				So(progress.IncorporatesLatestAlgorithms(), ShouldBeTrue)
 			})
+			Convey(`Reclustering State`, func() {
+				reference := time.Date(2020, time.January, 1, 1, 0, 0, 0, time.UTC)
+				rulesVersion := time.Date(2021, time.January, 1, 1, 0, 0, 0, time.UTC)
+				bugCluster := clustering.ClusterID{Algorithm: "rules-v1", ID: "00112233445566778899aabbccddeeff"}
+				suggestedCluster := clustering.ClusterID{Algorithm: "testname-v1", ID: "00112233445566778899aabbccddeeff"}
+
+				Convey(`Not Reclustering`, func() {
+					runs := []*ReclusteringRun{
+						NewRun(0).WithAttemptTimestamp(reference.Add(-5 * time.Minute)).WithAlgorithmsVersion(algorithms.AlgorithmsVersion).WithRulesVersion(rulesVersion).WithNoReportedProgress().Build(),
+						NewRun(1).WithAttemptTimestamp(reference.Add(-10 * time.Minute)).WithAlgorithmsVersion(algorithms.AlgorithmsVersion).WithRulesVersion(rulesVersion).WithCompletedProgress().Build(),
+					}
+					err := SetRunsForTesting(ctx, runs)
+					So(err, ShouldBeNil)
+
+					progress, err := ReadReclusteringProgress(ctx, testProject)
+					So(err, ShouldBeNil)
+
+					So(progress.IsReclustering(), ShouldBeFalse)
+					So(progress.AlgorithmsVersionsInEffect(), ShouldResemble, []int64{algorithms.AlgorithmsVersion})
+					So(progress.IsClusterStable(bugCluster), ShouldBeTrue)
+					So(progress.IsClusterStable(suggestedCluster), ShouldBeTrue)
+				})
+				Convey(`Partially Reclustered`, func() {
+					Convey(`Algorithms`, func() {
+						runs := []*ReclusteringRun{
+							NewRun(0).WithAttemptTimestamp(reference.Add(-5 * time.Minute)).WithAlgorithmsVersion(algorithms.AlgorithmsVersion + 1).WithRulesVersion(rulesVersion).WithReportedProgress(250).Build(),
+							NewRun(1).WithAttemptTimestamp(reference.Add(-10 * time.Minute)).WithAlgorithmsVersion(algorithms.AlgorithmsVersion).WithRulesVersion(rulesVersion).WithCompletedProgress().Build(),
+						}
+						err := SetRunsForTesting(ctx, runs)
+						So(err, ShouldBeNil)
+
+						progress, err := ReadReclusteringProgress(ctx, testProject)
+						So(err, ShouldBeNil)
+
+						So(progress.IsReclustering(), ShouldBeTrue)
+						So(progress.ProgressPerMille, ShouldEqual, 250)
+						So(progress.AlgorithmsVersionsInEffect(), ShouldResemble, []int64{algorithms.AlgorithmsVersion, algorithms.AlgorithmsVersion + 1})
+						So(progress.IsClusterStable(bugCluster), ShouldBeFalse)
+						So(progress.IsClusterStable(suggestedCluster), ShouldBeFalse)
+					})
+					Convey(`Rules`, func() {
+						runs := []*ReclusteringRun{
+							NewRun(0).WithAttemptTimestamp(reference.Add(-5 * time.Minute)).WithAlgorithmsVersion(algorithms.AlgorithmsVersion).WithRulesVersion(rulesVersion).WithReportedProgress(500).Build(),
+							NewRun(1).WithAttemptTimestamp(reference.Add(-10 * time.Minute)).WithAlgorithmsVersion(algorithms.AlgorithmsVersion).WithRulesVersion(rulesVersion.Add(-1 * time.Hour)).WithCompletedProgress().Build(),
+						}
+						err := SetRunsForTesting(ctx, runs)
+						So(err, ShouldBeNil)
+
+						progress, err := ReadReclusteringProgress(ctx, testProject)
+						So(err, ShouldBeNil)
+
+						So(progress.IsReclustering(), ShouldBeTrue)
+						So(progress.ProgressPerMille, ShouldEqual, 500)
+						So(progress.AlgorithmsVersionsInEffect(), ShouldResemble, []int64{algorithms.AlgorithmsVersion})
+						So(progress.IsClusterStable(bugCluster), ShouldBeFalse)
+						So(progress.IsClusterStable(suggestedCluster), ShouldBeTrue)
+					})
+					Convey(`Config`, func() {
+						configVersion := time.Date(2021, time.February, 1, 1, 0, 0, 0, time.UTC)
+						runs := []*ReclusteringRun{
+							NewRun(0).WithAttemptTimestamp(reference.Add(-5 * time.Minute)).WithAlgorithmsVersion(algorithms.AlgorithmsVersion).WithRulesVersion(rulesVersion).WithConfigVersion(configVersion).WithReportedProgress(500).Build(),
+							NewRun(1).WithAttemptTimestamp(reference.Add(-10 * time.Minute)).WithAlgorithmsVersion(algorithms.AlgorithmsVersion).WithRulesVersion(rulesVersion).WithConfigVersion(configVersion.Add(-1 * time.Hour)).WithCompletedProgress().Build(),
+						}
+						err := SetRunsForTesting(ctx, runs)
+						So(err, ShouldBeNil)
+
+						progress, err := ReadReclusteringProgress(ctx, testProject)
+						So(err, ShouldBeNil)
+
+						So(progress.IsReclustering(), ShouldBeTrue)
+						So(progress.ProgressPerMille, ShouldEqual, 500)
+						So(progress.Next.ConfigVersion, ShouldEqual, configVersion)
+						So(progress.Last.ConfigVersion, ShouldEqual, configVersion.Add(-1*time.Hour))
+						So(progress.IncorporatesConfigVersion(configVersion), ShouldBeFalse)
+						So(progress.IncorporatesConfigVersion(configVersion.Add(-1*time.Hour)), ShouldBeFalse)
+						So(progress.IsClusterStable(bugCluster), ShouldBeTrue)
+						So(progress.IsClusterStable(suggestedCluster), ShouldBeFalse)
+					})
+				})
+				Convey(`Reclustering Complete`, func() {
+					runs := []*ReclusteringRun{
+						NewRun(0).WithAttemptTimestamp(reference.Add(-5 * time.Minute)).WithAlgorithmsVersion(algorithms.AlgorithmsVersion + 1).WithRulesVersion(rulesVersion).WithCompletedProgress().Build(),
+						NewRun(1).WithAttemptTimestamp(reference.Add(-10 * time.Minute)).WithAlgorithmsVersion(algorithms.AlgorithmsVersion).WithRulesVersion(rulesVersion).WithCompletedProgress().Build(),
+					}
+					err := SetRunsForTesting(ctx, runs)
+					So(err, ShouldBeNil)
+
+					progress, err := ReadReclusteringProgress(ctx, testProject)
+					So(err, ShouldBeNil)
+
+					So(progress.IsReclustering(), ShouldBeFalse)
+					So(progress.ProgressPerMille, ShouldEqual, 1000)
+					So(progress.AlgorithmsVersionsInEffect(), ShouldResemble, []int64{algorithms.AlgorithmsVersion + 1})
+					So(progress.IsClusterStable(bugCluster), ShouldBeTrue)
+					So(progress.IsClusterStable(suggestedCluster), ShouldBeTrue)
+				})
+				Convey(`Cached`, func() {
+					cachedCtx := caching.WithEmptyProcessCache(ctx)
+					runs := []*ReclusteringRun{
+						NewRun(0).WithAttemptTimestamp(reference.Add(-5 * time.Minute)).WithAlgorithmsVersion(algorithms.AlgorithmsVersion).WithRulesVersion(rulesVersion).WithReportedProgress(500).Build(),
+						NewRun(1).WithAttemptTimestamp(reference.Add(-10 * time.Minute)).WithAlgorithmsVersion(algorithms.AlgorithmsVersion).WithRulesVersion(rulesVersion.Add(-1 * time.Hour)).WithCompletedProgress().Build(),
+					}
+					err := SetRunsForTesting(ctx, runs)
+					So(err, ShouldBeNil)
+
+					progress, err := ReadReclusteringProgressCached(cachedCtx, testProject)
+					So(err, ShouldBeNil)
+					So(progress.ProgressPerMille, ShouldEqual, 500)
+
+					// Progress is served from the cache until it expires.
+					runs[0].Progress = runs[0].ShardCount * 750
+					err = SetRunsForTesting(ctx, runs)
+					So(err, ShouldBeNil)
+
+					progress, err = ReadReclusteringProgressCached(cachedCtx, testProject)
+					So(err, ShouldBeNil)
+					So(progress.ProgressPerMille, ShouldEqual, 500)
+				})
+			})
 		})
 		Convey(`Reporting Progress`, func() {
 			reference := time.Date(2020, time.January, 1, 1, 0, 0, 0, time.UTC)
@@ -281,6 +404,11 @@ func TestSpan(t *testing.T) {
 				err := testCreate(r)
 				So(err, ShouldErrLike, "rules version must be valid")
 			})
+			Convey(`With invalid Config Version`, func() {
+				r.ConfigVersion = time.Time{}
+				err := testCreate(r)
+				So(err, ShouldErrLike, "config version must be valid")
+			})
 			Convey(`With invalid Shard Count`, func() {
 				r.ShardCount = 0
 				err := testCreate(r)
@@ -31,6 +31,7 @@ func NewRun(uniqifier int) *RunBuilder {
 		AttemptTimestamp:  time.Date(2010, time.January, 1, 1, 0, 0, uniqifier, time.UTC),
 		AlgorithmsVersion: int64(uniqifier + 1),
 		RulesVersion:      time.Date(2012, time.January, 1, 1, 0, 0, uniqifier, time.UTC),
+		ConfigVersion:     time.Date(2011, time.January, 1, 1, 0, 0, 0, time.UTC),
 		ShardCount:        int64(uniqifier + 1),
 		ShardsReported:    int64(uniqifier / 2),
 		Progress:          int64(uniqifier) * 500,
@@ -58,6 +59,12 @@ func (b *RunBuilder) WithRulesVersion(value time.Time) *RunBuilder {
 	return b
 }
 
+// WithConfigVersion specifies the config version to use on the run.
+func (b *RunBuilder) WithConfigVersion(value time.Time) *RunBuilder {
+	b.run.ConfigVersion = value
+	return b
+}
+
 // WithAlgorithmsVersion specifies the algorithms version to use on the run.
 func (b *RunBuilder) WithAlgorithmsVersion(value int64) *RunBuilder {
 	b.run.AlgorithmsVersion = value
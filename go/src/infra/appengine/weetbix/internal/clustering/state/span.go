@@ -69,6 +69,7 @@ func Create(ctx context.Context, e *Entry) error {
 		"ObjectID":          e.ObjectID,
 		"AlgorithmsVersion": e.Clustering.AlgorithmsVersion,
 		"RulesVersion":      e.Clustering.RulesVersion,
+		"ConfigVersion":     e.Clustering.ConfigVersion,
 		"Clusters":          clusters,
 		"LastUpdated":       spanner.CommitTimestamp,
 	})
@@ -146,6 +147,7 @@ func UpdateClustering(ctx context.Context, previous *Entry, update *clustering.C
 	upd["LastUpdated"] = spanner.CommitTimestamp
 	upd["AlgorithmsVersion"] = update.AlgorithmsVersion
 	upd["RulesVersion"] = update.RulesVersion
+	upd["ConfigVersion"] = update.ConfigVersion
 
 	if !clustering.AlgorithmsAndClustersEqual(&previous.Clustering, update) {
 		// Clusters is a field that may be many kilobytes in size.
@@ -197,6 +199,10 @@ type ReadNextOptions struct {
 	// If a row has an RulesVersion less than this value, it will
 	// be eligble to be read.
 	RulesVersion time.Time
+	// The minimum ConfigVersion that re-clustering wants to achieve.
+	// If a row has an ConfigVersion less than this value, it will
+	// be eligble to be read.
+	ConfigVersion time.Time
 }
 
 // ReadNextN reads the n consecutively next clustering state entries
@@ -205,13 +211,33 @@ func ReadNextN(ctx context.Context, project string, opts ReadNextOptions, n int)
 	params := make(map[string]interface{})
 	whereClause := `
 		ChunkId > @startChunkID AND ChunkId <= @endChunkID
-		AND (AlgorithmsVersion < @algorithmsVersion OR RulesVersion < @rulesVersion)
+		AND (AlgorithmsVersion < @algorithmsVersion
+			OR RulesVersion < @rulesVersion
+			OR ConfigVersion < @configVersion)
 	`
 	params["startChunkID"] = opts.StartChunkID
 	params["endChunkID"] = opts.EndChunkID
 	params["algorithmsVersion"] = opts.AlgorithmsVersion
 	params["rulesVersion"] = opts.RulesVersion
+	params["configVersion"] = opts.ConfigVersion
+
+	return readWhere(ctx, project, whereClause, params, n)
+}
 
+// ReadNextInPartitionTimeRange reads the n consecutively next clustering
+// state entries after the chunk with ID startChunkID (exclusive) whose
+// partition time is in the range [start, end). To read from the start of
+// the table, leave startChunkID blank ("").
+func ReadNextInPartitionTimeRange(ctx context.Context, project, startChunkID string, start, end time.Time, n int) ([]*Entry, error) {
+	whereClause := `
+		ChunkId > @startChunkID
+		AND PartitionTime >= @start AND PartitionTime < @end
+	`
+	params := map[string]interface{}{
+		"startChunkID": startChunkID,
+		"start":        start,
+		"end":          end,
+	}
 	return readWhere(ctx, project, whereClause, params, n)
 }
 
@@ -219,7 +245,7 @@ func readWhere(ctx context.Context, project, whereClause string, params map[stri
 	stmt := spanner.NewStatement(`
 		SELECT
 		  ChunkId, PartitionTime, ObjectId,
-		  AlgorithmsVersion, RulesVersion,
+		  AlgorithmsVersion, RulesVersion, ConfigVersion,
 		  LastUpdated, Clusters
 		FROM ClusteringState
 		WHERE Project = @project AND (` + whereClause + `)
@@ -242,7 +268,7 @@ func readWhere(ctx context.Context, project, whereClause string, params map[stri
 		err := b.FromSpanner(r,
 			&result.ChunkID, &result.PartitionTime, &result.ObjectID,
 			&result.Clustering.AlgorithmsVersion, &result.Clustering.RulesVersion,
-			&result.LastUpdated, clusters)
+			&result.Clustering.ConfigVersion, &result.LastUpdated, clusters)
 		if err != nil {
 			return errors.Annotate(err, "read clustering state row").Err()
 		}
@@ -363,6 +389,8 @@ func validateClusterResults(c *clustering.ClusterResults) error {
 		return errors.New("algorithms version must be specified")
 	case c.RulesVersion.IsZero():
 		return errors.New("rules version must be specified")
+	case c.ConfigVersion.IsZero():
+		return errors.New("config version must be specified")
 	default:
 		if err := validateAlgorithms(c.Algorithms); err != nil {
 			return errors.Annotate(err, "algorithms").Err()
@@ -69,6 +69,12 @@ func TestSpanner(t *testing.T) {
 					_, err := testCreate(e)
 					So(err, ShouldErrLike, "rules version must be specified")
 				})
+				Convey(`Config Version missing`, func() {
+					var t time.Time
+					e.Clustering.ConfigVersion = t
+					_, err := testCreate(e)
+					So(err, ShouldErrLike, "config version must be specified")
+				})
 				Convey(`Algorithms Version missing`, func() {
 					e.Clustering.AlgorithmsVersion = 0
 					_, err := testCreate(e)
@@ -156,6 +162,7 @@ func TestSpanner(t *testing.T) {
 					newClustering = &NewEntry(0).Build().Clustering
 					newClustering.AlgorithmsVersion = 10
 					newClustering.RulesVersion = time.Date(2024, time.June, 5, 4, 3, 2, 1000, time.UTC)
+					newClustering.ConfigVersion = time.Date(2024, time.July, 5, 4, 3, 2, 1000, time.UTC)
 					expected.Clustering = *newClustering
 					So(clustering.AlgorithmsAndClustersEqual(&entries[0].Clustering, newClustering), ShouldBeTrue)
 					test()
@@ -209,6 +216,7 @@ func TestSpanner(t *testing.T) {
 		})
 		Convey(`ReadNextN`, func() {
 			targetRulesVersion := time.Date(2024, 1, 1, 1, 1, 1, 0, time.UTC)
+			targetConfigVersion := time.Date(2024, 2, 1, 1, 1, 1, 0, time.UTC)
 			targetAlgorithmsVersion := 10
 			entries := []*Entry{
 				// Should not be read.
@@ -228,6 +236,9 @@ func TestSpanner(t *testing.T) {
 				// Check handling of EndChunkID as an inclusive upper-bound.
 				NewEntry(6).WithChunkIDPrefix("11" + strings.Repeat("ff", 15)).WithAlgorithmsVersion(2).Build(), // Should be read.
 				NewEntry(7).WithChunkIDPrefix("12" + strings.Repeat("00", 15)).WithAlgorithmsVersion(2).Build(), // Should not be read.
+
+				// Should be read (configVersion < targetConfigVersion).
+				NewEntry(8).WithChunkIDPrefix("11").WithAlgorithmsVersion(10).WithRulesVersion(targetRulesVersion).WithConfigVersion(targetConfigVersion.Add(-1 * time.Hour)).Build(),
 			}
 
 			commitTime, err := CreateEntriesForTesting(ctx, entries)
@@ -242,6 +253,7 @@ func TestSpanner(t *testing.T) {
 				entries[3],
 				entries[4],
 				entries[6],
+				entries[8],
 			}
 			sort.Slice(expectedEntries, func(i, j int) bool {
 				return expectedEntries[i].ChunkID < expectedEntries[j].ChunkID
@@ -252,6 +264,7 @@ func TestSpanner(t *testing.T) {
 				EndChunkID:        "11" + strings.Repeat("ff", 15),
 				AlgorithmsVersion: int64(targetAlgorithmsVersion),
 				RulesVersion:      targetRulesVersion,
+				ConfigVersion:     targetConfigVersion,
 			}
 			// Reads first page.
 			rows, err := ReadNextN(span.Single(ctx), testProject, readOpts, 3)
@@ -265,11 +278,47 @@ func TestSpanner(t *testing.T) {
 			So(rows, ShouldResemble, expectedEntries[3:])
 
 			// Read empty last page.
-			readOpts.StartChunkID = rows[1].ChunkID
+			readOpts.StartChunkID = rows[2].ChunkID
 			rows, err = ReadNextN(span.Single(ctx), testProject, readOpts, 3)
 			So(err, ShouldBeNil)
 			So(rows, ShouldBeEmpty)
 		})
+		Convey(`ReadNextInPartitionTimeRange`, func() {
+			start := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+			end := start.Add(24 * time.Hour)
+			entries := []*Entry{
+				// Should be read.
+				NewEntry(0).WithPartitionTime(start).Build(),
+				NewEntry(1).WithPartitionTime(end.Add(-time.Microsecond)).Build(),
+				NewEntry(2).WithPartitionTime(start.Add(time.Hour)).Build(),
+
+				// Should not be read (outside the time range).
+				NewEntry(3).WithPartitionTime(start.Add(-time.Microsecond)).Build(),
+				NewEntry(4).WithPartitionTime(end).Build(),
+
+				// Should not be read (other project).
+				NewEntry(5).WithPartitionTime(start).WithProject("other").Build(),
+			}
+			commitTime, err := CreateEntriesForTesting(ctx, entries)
+			So(err, ShouldBeNil)
+			for _, e := range entries {
+				e.LastUpdated = commitTime.In(time.UTC)
+			}
+			expectedEntries := []*Entry{entries[0], entries[1], entries[2]}
+			sort.Slice(expectedEntries, func(i, j int) bool {
+				return expectedEntries[i].ChunkID < expectedEntries[j].ChunkID
+			})
+
+			// Read first page.
+			rows, err := ReadNextInPartitionTimeRange(span.Single(ctx), testProject, "", start, end, 2)
+			So(err, ShouldBeNil)
+			So(rows, ShouldResemble, expectedEntries[0:2])
+
+			// Read last page.
+			rows, err = ReadNextInPartitionTimeRange(span.Single(ctx), testProject, rows[1].ChunkID, start, end, 2)
+			So(err, ShouldBeNil)
+			So(rows, ShouldResemble, expectedEntries[2:])
+		})
 		Convey(`EstimateChunks`, func() {
 			Convey(`Less than 100 chunks`, func() {
 				est, err := EstimateChunks(span.Single(ctx), testProject)
@@ -25,8 +25,8 @@ type EntryBuilder struct {
 }
 
 // NewEntry creates a new entry builder with the given uniqifier.
-// The uniqifier affects the ChunkID, AlgorithmVersion, RulesVersion
-// and Algorithms.
+// The uniqifier affects the ChunkID, AlgorithmVersion, RulesVersion,
+// ConfigVersion and Algorithms.
 func NewEntry(uniqifier int) *EntryBuilder {
 	// Generate a 128-bit chunkID from the uniqifier.
 	// Using a hash function ensures they will be approximately uniformly
@@ -43,6 +43,7 @@ func NewEntry(uniqifier int) *EntryBuilder {
 		Clustering: clustering.ClusterResults{
 			AlgorithmsVersion: int64(uniqifier + 1),
 			RulesVersion:      time.Date(2025, 1, 1, 1, 1, 1, uniqifier, time.UTC),
+			ConfigVersion:     time.Date(2025, 2, 1, 1, 1, 1, uniqifier, time.UTC),
 			Algorithms: map[string]struct{}{
 				fmt.Sprintf("alg-%v", uniqifier): {},
 				"alg-extra":                      {},
@@ -83,6 +84,12 @@ func (b *EntryBuilder) WithProject(project string) *EntryBuilder {
 	return b
 }
 
+// WithPartitionTime specifies the partition time for the entry.
+func (b *EntryBuilder) WithPartitionTime(t time.Time) *EntryBuilder {
+	b.entry.PartitionTime = t
+	return b
+}
+
 // WithAlgorithmsVersion specifies the algorithms version for the entry.
 func (b *EntryBuilder) WithAlgorithmsVersion(version int64) *EntryBuilder {
 	b.entry.Clustering.AlgorithmsVersion = version
@@ -95,6 +102,12 @@ func (b *EntryBuilder) WithRulesVersion(version time.Time) *EntryBuilder {
 	return b
 }
 
+// WithConfigVersion specifies the config version for the entry.
+func (b *EntryBuilder) WithConfigVersion(version time.Time) *EntryBuilder {
+	b.entry.Clustering.ConfigVersion = version
+	return b
+}
+
 // Build returns the built entry.
 func (b *EntryBuilder) Build() *Entry {
 	return b.entry
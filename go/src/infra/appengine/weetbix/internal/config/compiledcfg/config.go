@@ -0,0 +1,118 @@
+// Copyright 2021 The Chromium Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+// Package compiledcfg contains the compiled configuration of LUCI projects
+// used to cluster test failures.
+package compiledcfg
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"go.chromium.org/luci/common/data/caching/lru"
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/server/caching"
+
+	"infra/appengine/weetbix/internal/config"
+)
+
+// StartingEpoch is the config version of projects whose configuration was
+// stored before the time it was fetched was recorded. It is also the
+// config version chunks clustered before config versions were recorded
+// are treated as having.
+var StartingEpoch = time.Date(1900, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+// TODO(crbug.com/1243174). Instrument the size of this cache so that we
+// can monitor it.
+var configCache = caching.RegisterLRUCache(0)
+
+// ProjectConfig is the compiled configuration of a LUCI project.
+type ProjectConfig struct {
+	// Config is the project configuration.
+	Config *config.ProjectConfig
+	// TestNameMaskingRules are the test name masking rules of the project,
+	// in order of application.
+	TestNameMaskingRules []*TestNameMaskingRule
+	// LastUpdated is the time the project configuration was fetched from
+	// LUCI Config. It is the version of the configuration used to cluster
+	// test failures.
+	LastUpdated time.Time
+}
+
+// TestNameMaskingRule is a compiled test name masking rule.
+type TestNameMaskingRule struct {
+	// Name is the name of the rule.
+	Name string
+	// Pattern is the regular expression matching test IDs. The parts of
+	// test IDs matched by its capture groups are masked.
+	Pattern *regexp.Regexp
+}
+
+// NewConfig compiles the given project configuration, of the given
+// version.
+func NewConfig(cfg *config.ProjectConfig, lastUpdated time.Time) (*ProjectConfig, error) {
+	result := &ProjectConfig{
+		Config:      cfg,
+		LastUpdated: lastUpdated,
+	}
+	for _, r := range cfg.GetClustering().GetTestNameMaskingRules() {
+		re, err := regexp.Compile(r.Pattern)
+		if err != nil {
+			return nil, errors.Annotate(err, "compiling test name masking rule %q", r.Name).Err()
+		}
+		result.TestNameMaskingRules = append(result.TestNameMaskingRules, &TestNameMaskingRule{
+			Name:    r.Name,
+			Pattern: re,
+		})
+	}
+	return result, nil
+}
+
+// Project returns the compiled configuration of the given project, from
+// the in-process cache. If a minimum config version is required, pass it as
+// minimumVersion. (Or otherwise, use time.Time{}.)
+func Project(ctx context.Context, project string, minimumVersion time.Time) (*ProjectConfig, error) {
+	cfg, version, err := config.ProjectWithVersion(ctx, project)
+	if err != nil {
+		return nil, errors.Annotate(err, "get project config").Err()
+	}
+	lastUpdated := version.FetchTime
+	if lastUpdated.Before(StartingEpoch) {
+		// Stored before fetch times were recorded.
+		lastUpdated = StartingEpoch
+	}
+	if lastUpdated.Before(minimumVersion) {
+		// The config served by this instance is out of date. It will
+		// be refreshed shortly.
+		return nil, fmt.Errorf("could not obtain config of requested minimum version (%v)", minimumVersion)
+	}
+
+	cache := configCache.LRU(ctx)
+	if cache == nil {
+		// A fallback useful in unit tests that may not have the process cache
+		// available.
+		return NewConfig(cfg, lastUpdated)
+	}
+	value, _ := cache.Mutate(ctx, project, func(it *lru.Item) *lru.Item {
+		if it != nil && it.Value.(*ProjectConfig).LastUpdated.Equal(lastUpdated) {
+			// The compiled config is up-to-date.
+			return it
+		}
+		var compiled *ProjectConfig
+		if compiled, err = NewConfig(cfg, lastUpdated); err != nil {
+			// Keep the cached value (if any) for now.
+			return it
+		}
+		return &lru.Item{
+			Value: compiled,
+			Exp:   0, // Never.
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+	return value.(*ProjectConfig), nil
+}
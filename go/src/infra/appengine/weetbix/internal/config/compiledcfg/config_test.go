@@ -0,0 +1,105 @@
+// Copyright 2021 The Chromium Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package compiledcfg
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"go.chromium.org/luci/gae/impl/memory"
+	"go.chromium.org/luci/server/caching"
+
+	"infra/appengine/weetbix/internal/config"
+
+	. "github.com/smartystreets/goconvey/convey"
+	"go.chromium.org/luci/common/clock/testclock"
+	. "go.chromium.org/luci/common/testing/assertions"
+)
+
+func TestCompiledConfig(t *testing.T) {
+	Convey(`With In-Process Cache`, t, func() {
+		ctx := memory.Use(context.Background())
+		ctx = caching.WithEmptyProcessCache(ctx)
+		ctx, tc := testclock.UseTime(ctx, time.Date(2021, time.February, 1, 1, 0, 0, 0, time.UTC))
+
+		projectCfg := &config.ProjectConfig{
+			Clustering: &config.Clustering{
+				TestNameMaskingRules: []*config.TestNameMaskingRule{
+					{
+						Name:    "webtest-virtual-suite",
+						Pattern: `^ninja://:blink_web_tests/(virtual/[^/]+/)`,
+					},
+				},
+			},
+		}
+		setProjectConfig := func(cfg *config.ProjectConfig) time.Time {
+			So(config.SetTestProjectConfig(ctx, map[string]*config.ProjectConfig{
+				"chromium": cfg,
+			}), ShouldBeNil)
+			// The version of the config is the time it was fetched.
+			return tc.Now()
+		}
+
+		Convey(`Compiles test name masking rules`, func() {
+			version := setProjectConfig(projectCfg)
+
+			cfg, err := Project(ctx, "chromium", time.Time{})
+			So(err, ShouldBeNil)
+			So(cfg.Config, ShouldResembleProto, projectCfg)
+			So(cfg.LastUpdated, ShouldEqual, version)
+			So(cfg.TestNameMaskingRules, ShouldHaveLength, 1)
+			So(cfg.TestNameMaskingRules[0].Name, ShouldEqual, "webtest-virtual-suite")
+			So(cfg.TestNameMaskingRules[0].Pattern.String(), ShouldEqual, `^ninja://:blink_web_tests/(virtual/[^/]+/)`)
+		})
+		Convey(`Reflects project config updates`, func() {
+			oldVersion := setProjectConfig(&config.ProjectConfig{})
+
+			cfg, err := Project(ctx, "chromium", time.Time{})
+			So(err, ShouldBeNil)
+			So(cfg.LastUpdated, ShouldEqual, oldVersion)
+			So(cfg.TestNameMaskingRules, ShouldBeEmpty)
+
+			// Enable test name masking. Wait for the project config
+			// cache to expire.
+			tc.Add(2 * time.Minute)
+			newVersion := setProjectConfig(projectCfg)
+
+			cfg, err = Project(ctx, "chromium", newVersion)
+			So(err, ShouldBeNil)
+			So(cfg.LastUpdated, ShouldEqual, newVersion)
+			So(cfg.TestNameMaskingRules, ShouldHaveLength, 1)
+		})
+		Convey(`Minimum version not available`, func() {
+			version := setProjectConfig(projectCfg)
+
+			_, err := Project(ctx, "chromium", version.Add(time.Minute))
+			So(err, ShouldErrLike, "could not obtain config of requested minimum version")
+		})
+		Convey(`Project without config`, func() {
+			setProjectConfig(projectCfg)
+
+			_, err := Project(ctx, "other", time.Time{})
+			So(err, ShouldErrLike, "no config found for project other")
+		})
+	})
+}
+
+func TestNewConfig(t *testing.T) {
+	Convey(`Invalid masking rule pattern`, t, func() {
+		projectCfg := &config.ProjectConfig{
+			Clustering: &config.Clustering{
+				TestNameMaskingRules: []*config.TestNameMaskingRule{
+					{
+						Name:    "invalid",
+						Pattern: `(`,
+					},
+				},
+			},
+		}
+		_, err := NewConfig(projectCfg, StartingEpoch)
+		So(err, ShouldErrLike, `compiling test name masking rule "invalid"`)
+	})
+}
@@ -47,6 +47,24 @@ type Config struct {
 	//
 	// If this is unset or zero, re-clustering is disabled.
 	ReclusteringIntervalMinutes int64 `protobuf:"varint,4,opt,name=reclustering_interval_minutes,json=reclusteringIntervalMinutes,proto3" json:"reclustering_interval_minutes,omitempty"`
+	// The maximum number of days in the time range cluster impact may be
+	// queried over. Bounds the cost of cluster queries with a time range.
+	// Must be less than 540, the partition expiry of the clustered_failures
+	// table.
+	//
+	// If this is unset or zero, defaults to 30 days.
+	MaxClusterImpactRangeDays int64 `protobuf:"varint,5,opt,name=max_cluster_impact_range_days,json=maxClusterImpactRangeDays,proto3" json:"max_cluster_impact_range_days,omitempty"`
+	// Throttles the changes Weetbix makes to Monorail, to stay within its API
+	// quota. If unset, changes are not throttled.
+	MonorailQuota *MonorailQuota `protobuf:"bytes,6,opt,name=monorail_quota,json=monorailQuota,proto3" json:"monorail_quota,omitempty"`
+	// Puts Weetbix, or some LUCI projects, in read-only maintenance mode.
+	// Maintenance mode can also be set through the Admin service, which
+	// takes precedence over this setting until it expires.
+	MaintenanceMode *MaintenanceMode `protobuf:"bytes,7,opt,name=maintenance_mode,json=maintenanceMode,proto3" json:"maintenance_mode,omitempty"`
+	// The GCS bucket sampled clustering chunks are exported to, for offline
+	// experimentation with clustering algorithms. See the ExportChunks admin
+	// RPC. If unset, chunks cannot be exported.
+	ChunkExportGcsBucket string `protobuf:"bytes,8,opt,name=chunk_export_gcs_bucket,json=chunkExportGcsBucket,proto3" json:"chunk_export_gcs_bucket,omitempty"`
 }
 
 func (x *Config) Reset() {
@@ -109,6 +127,169 @@ func (x *Config) GetReclusteringIntervalMinutes() int64 {
 	return 0
 }
 
+func (x *Config) GetMaxClusterImpactRangeDays() int64 {
+	if x != nil {
+		return x.MaxClusterImpactRangeDays
+	}
+	return 0
+}
+
+func (x *Config) GetMonorailQuota() *MonorailQuota {
+	if x != nil {
+		return x.MonorailQuota
+	}
+	return nil
+}
+
+func (x *Config) GetMaintenanceMode() *MaintenanceMode {
+	if x != nil {
+		return x.MaintenanceMode
+	}
+	return nil
+}
+
+func (x *Config) GetChunkExportGcsBucket() string {
+	if x != nil {
+		return x.ChunkExportGcsBucket
+	}
+	return ""
+}
+
+// MonorailQuota limits the rate of changes (bug filings and bug updates)
+// Weetbix makes to Monorail. Changes in excess of the limits are deferred
+// to later runs of the bug updater. Bug filings and raising bugs to the
+// highest priorities take precedence over other changes.
+type MonorailQuota struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The maximum number of changes made in each run of the bug updater for
+	// a LUCI project. If this is unset or zero, runs are not limited.
+	MaxChangesPerRun int64 `protobuf:"varint,1,opt,name=max_changes_per_run,json=maxChangesPerRun,proto3" json:"max_changes_per_run,omitempty"`
+	// The maximum number of changes made per minute, across all LUCI projects
+	// updated by the same instance. If this is unset or zero, the rate is not
+	// limited.
+	MaxChangesPerMinute int64 `protobuf:"varint,2,opt,name=max_changes_per_minute,json=maxChangesPerMinute,proto3" json:"max_changes_per_minute,omitempty"`
+}
+
+func (x *MonorailQuota) Reset() {
+	*x = MonorailQuota{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_infra_appengine_weetbix_internal_config_config_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MonorailQuota) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MonorailQuota) ProtoMessage() {}
+
+func (x *MonorailQuota) ProtoReflect() protoreflect.Message {
+	mi := &file_infra_appengine_weetbix_internal_config_config_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MonorailQuota.ProtoReflect.Descriptor instead.
+func (*MonorailQuota) Descriptor() ([]byte, []int) {
+	return file_infra_appengine_weetbix_internal_config_config_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *MonorailQuota) GetMaxChangesPerRun() int64 {
+	if x != nil {
+		return x.MaxChangesPerRun
+	}
+	return 0
+}
+
+func (x *MonorailQuota) GetMaxChangesPerMinute() int64 {
+	if x != nil {
+		return x.MaxChangesPerMinute
+	}
+	return 0
+}
+
+// MaintenanceMode makes Weetbix read-only, e.g. during Spanner maintenance.
+// In maintenance mode, mutating RPCs are refused with a message explaining
+// the maintenance, and task queue tasks (e.g. result ingestion) are deferred
+// until maintenance mode ends.
+type MaintenanceMode struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Whether all of Weetbix is in maintenance mode.
+	ReadOnly bool `protobuf:"varint,1,opt,name=read_only,json=readOnly,proto3" json:"read_only,omitempty"`
+	// The LUCI projects in maintenance mode. Ignored if read_only is set.
+	ReadOnlyProjects []string `protobuf:"bytes,2,rep,name=read_only_projects,json=readOnlyProjects,proto3" json:"read_only_projects,omitempty"`
+	// The message displayed to users during maintenance, e.g.
+	// "Database maintenance until 14:00 UTC, see go/weetbix-status".
+	Message string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *MaintenanceMode) Reset() {
+	*x = MaintenanceMode{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_infra_appengine_weetbix_internal_config_config_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MaintenanceMode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MaintenanceMode) ProtoMessage() {}
+
+func (x *MaintenanceMode) ProtoReflect() protoreflect.Message {
+	mi := &file_infra_appengine_weetbix_internal_config_config_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MaintenanceMode.ProtoReflect.Descriptor instead.
+func (*MaintenanceMode) Descriptor() ([]byte, []int) {
+	return file_infra_appengine_weetbix_internal_config_config_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *MaintenanceMode) GetReadOnly() bool {
+	if x != nil {
+		return x.ReadOnly
+	}
+	return false
+}
+
+func (x *MaintenanceMode) GetReadOnlyProjects() []string {
+	if x != nil {
+		return x.ReadOnlyProjects
+	}
+	return nil
+}
+
+func (x *MaintenanceMode) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
 var File_infra_appengine_weetbix_internal_config_config_proto protoreflect.FileDescriptor
 
 var file_infra_appengine_weetbix_internal_config_config_proto_rawDesc = []byte{
@@ -116,7 +297,7 @@ var file_infra_appengine_weetbix_internal_config_config_proto_rawDesc = []byte{
 	0x65, 0x2f, 0x77, 0x65, 0x65, 0x74, 0x62, 0x69, 0x78, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e,
 	0x61, 0x6c, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
 	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0a, 0x77, 0x65, 0x65, 0x74, 0x62, 0x69, 0x78, 0x2e,
-	0x76, 0x31, 0x22, 0xd6, 0x01, 0x0a, 0x06, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x2b, 0x0a,
+	0x76, 0x31, 0x22, 0xd9, 0x03, 0x0a, 0x06, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x2b, 0x0a,
 	0x11, 0x6d, 0x6f, 0x6e, 0x6f, 0x72, 0x61, 0x69, 0x6c, 0x5f, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61,
 	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x6d, 0x6f, 0x6e, 0x6f, 0x72, 0x61,
 	0x69, 0x6c, 0x48, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x28, 0x0a, 0x10, 0x63, 0x68,
@@ -129,7 +310,38 @@ var file_infra_appengine_weetbix_internal_config_config_proto_rawDesc = []byte{
 	0x73, 0x74, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c,
 	0x5f, 0x6d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x1b,
 	0x72, 0x65, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x74, 0x65,
-	0x72, 0x76, 0x61, 0x6c, 0x4d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x42, 0x30, 0x5a, 0x2e, 0x69,
+	0x72, 0x76, 0x61, 0x6c, 0x4d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x12, 0x40, 0x0a, 0x1d, 0x6d,
+	0x61, 0x78, 0x5f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x6d, 0x70, 0x61, 0x63,
+	0x74, 0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x64, 0x61, 0x79, 0x73, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x19, 0x6d, 0x61, 0x78, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x6d,
+	0x70, 0x61, 0x63, 0x74, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x44, 0x61, 0x79, 0x73, 0x12, 0x40, 0x0a,
+	0x0e, 0x6d, 0x6f, 0x6e, 0x6f, 0x72, 0x61, 0x69, 0x6c, 0x5f, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x77, 0x65, 0x65, 0x74, 0x62, 0x69, 0x78, 0x2e,
+	0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x6e, 0x6f, 0x72, 0x61, 0x69, 0x6c, 0x51, 0x75, 0x6f, 0x74, 0x61,
+	0x52, 0x0d, 0x6d, 0x6f, 0x6e, 0x6f, 0x72, 0x61, 0x69, 0x6c, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x12,
+	0x46, 0x0a, 0x10, 0x6d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x6d,
+	0x6f, 0x64, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x77, 0x65, 0x65, 0x74,
+	0x62, 0x69, 0x78, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e,
+	0x63, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x0f, 0x6d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61,
+	0x6e, 0x63, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x35, 0x0a, 0x17, 0x63, 0x68, 0x75, 0x6e, 0x6b,
+	0x5f, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x67, 0x63, 0x73, 0x5f, 0x62, 0x75, 0x63, 0x6b,
+	0x65, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x45,
+	0x78, 0x70, 0x6f, 0x72, 0x74, 0x47, 0x63, 0x73, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x22, 0x73,
+	0x0a, 0x0d, 0x4d, 0x6f, 0x6e, 0x6f, 0x72, 0x61, 0x69, 0x6c, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x12,
+	0x2d, 0x0a, 0x13, 0x6d, 0x61, 0x78, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x5f, 0x70,
+	0x65, 0x72, 0x5f, 0x72, 0x75, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x6d, 0x61,
+	0x78, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x50, 0x65, 0x72, 0x52, 0x75, 0x6e, 0x12, 0x33,
+	0x0a, 0x16, 0x6d, 0x61, 0x78, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x5f, 0x70, 0x65,
+	0x72, 0x5f, 0x6d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x13,
+	0x6d, 0x61, 0x78, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x50, 0x65, 0x72, 0x4d, 0x69, 0x6e,
+	0x75, 0x74, 0x65, 0x22, 0x76, 0x0a, 0x0f, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e,
+	0x63, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x61, 0x64, 0x5f, 0x6f,
+	0x6e, 0x6c, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x72, 0x65, 0x61, 0x64, 0x4f,
+	0x6e, 0x6c, 0x79, 0x12, 0x2c, 0x0a, 0x12, 0x72, 0x65, 0x61, 0x64, 0x5f, 0x6f, 0x6e, 0x6c, 0x79,
+	0x5f, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x10, 0x72, 0x65, 0x61, 0x64, 0x4f, 0x6e, 0x6c, 0x79, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74,
+	0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x42, 0x30, 0x5a, 0x2e, 0x69,
 	0x6e, 0x66, 0x72, 0x61, 0x2f, 0x61, 0x70, 0x70, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x2f, 0x77,
 	0x65, 0x65, 0x74, 0x62, 0x69, 0x78, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f,
 	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x3b, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x62, 0x06, 0x70,
@@ -148,16 +360,20 @@ func file_infra_appengine_weetbix_internal_config_config_proto_rawDescGZIP() []b
 	return file_infra_appengine_weetbix_internal_config_config_proto_rawDescData
 }
 
-var file_infra_appengine_weetbix_internal_config_config_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_infra_appengine_weetbix_internal_config_config_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
 var file_infra_appengine_weetbix_internal_config_config_proto_goTypes = []interface{}{
-	(*Config)(nil), // 0: weetbix.v1.Config
+	(*Config)(nil),          // 0: weetbix.v1.Config
+	(*MonorailQuota)(nil),   // 1: weetbix.v1.MonorailQuota
+	(*MaintenanceMode)(nil), // 2: weetbix.v1.MaintenanceMode
 }
 var file_infra_appengine_weetbix_internal_config_config_proto_depIdxs = []int32{
-	0, // [0:0] is the sub-list for method output_type
-	0, // [0:0] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	1, // 0: weetbix.v1.Config.monorail_quota:type_name -> weetbix.v1.MonorailQuota
+	2, // 1: weetbix.v1.Config.maintenance_mode:type_name -> weetbix.v1.MaintenanceMode
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
 }
 
 func init() { file_infra_appengine_weetbix_internal_config_config_proto_init() }
@@ -178,6 +394,30 @@ func file_infra_appengine_weetbix_internal_config_config_proto_init() {
 				return nil
 			}
 		}
+		file_infra_appengine_weetbix_internal_config_config_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MonorailQuota); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_infra_appengine_weetbix_internal_config_config_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MaintenanceMode); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -185,7 +425,7 @@ func file_infra_appengine_weetbix_internal_config_config_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_infra_appengine_weetbix_internal_config_config_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   1,
+			NumMessages:   3,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -7,14 +7,18 @@ package config
 import (
 	"context"
 	"fmt"
+	"sort"
+	"strings"
 	"time"
 
 	"google.golang.org/protobuf/proto"
 
+	"go.chromium.org/luci/common/clock"
 	"go.chromium.org/luci/common/errors"
 	"go.chromium.org/luci/common/logging"
 	"go.chromium.org/luci/common/tsmon/field"
 	"go.chromium.org/luci/common/tsmon/metric"
+	"go.chromium.org/luci/common/tsmon/types"
 	"go.chromium.org/luci/config"
 	"go.chromium.org/luci/config/cfgclient"
 	"go.chromium.org/luci/config/validation"
@@ -35,6 +39,12 @@ var (
 		nil,
 		// status can be "success" or "failure".
 		field.String("project"), field.String("status"))
+
+	configAgeGauge = metric.NewFloat(
+		"weetbix/project_config/age",
+		"The time since the project config served by this instance was fetched from LUCI Config",
+		&types.MetricMetadata{Units: types.Seconds},
+		field.String("project"))
 )
 
 type cachedProjectConfig struct {
@@ -44,6 +54,23 @@ type cachedProjectConfig struct {
 	ID     string      `gae:"$id"` // The name of the project for which the config is.
 	Config []byte      `gae:",noindex"`
 	Meta   config.Meta `gae:",noindex"`
+	// FetchTime is the time the config revision was fetched from
+	// LUCI Config and stored.
+	FetchTime time.Time `gae:",noindex"`
+}
+
+// ConfigVersion identifies the version of a project's configuration.
+type ConfigVersion struct {
+	// Revision is the LUCI Config revision of the configuration.
+	Revision string
+	// FetchTime is the time the revision was fetched from LUCI Config.
+	FetchTime time.Time
+}
+
+// projectConfigs is the value of the in-memory project config cache.
+type projectConfigs struct {
+	configs  map[string]*ProjectConfig
+	versions map[string]ConfigVersion
 }
 
 func init() {
@@ -129,8 +156,10 @@ func updateStoredConfig(ctx context.Context, fetchedConfigs map[string]*fetchedP
 		return err
 	}
 
+	now := clock.Now(ctx)
 	var errs []error
 	var toPut []*cachedProjectConfig
+	diff := &configDiff{}
 	for project, fetch := range fetchedConfigs {
 		if fetch.Config == nil {
 			// Config did not pass validation.
@@ -155,13 +184,20 @@ func updateStoredConfig(ctx context.Context, fetchedConfigs map[string]*fetchedP
 		}
 		logging.Infof(ctx, "Updating cached config %s: %q -> %q", cur.ID, cur.Meta.Revision, fetch.Meta.Revision)
 		toPut = append(toPut, &cachedProjectConfig{
-			ID:     cur.ID,
-			Config: blob,
-			Meta:   fetch.Meta,
+			ID:        cur.ID,
+			Config:    blob,
+			Meta:      fetch.Meta,
+			FetchTime: now,
 		})
+		if ok {
+			diff.Updated = append(diff.Updated, revisionChange{Project: project, From: cur.Meta.Revision, To: fetch.Meta.Revision})
+		} else {
+			diff.Added = append(diff.Added, revisionChange{Project: project, To: fetch.Meta.Revision})
+		}
 	}
 	if err := datastore.Put(ctx, toPut); err != nil {
 		errs = append(errs, errors.Annotate(err, "updating project configs").Err())
+		diff.Added, diff.Updated = nil, nil
 	}
 
 	var toDelete []*datastore.Key
@@ -170,10 +206,21 @@ func updateStoredConfig(ctx context.Context, fetchedConfigs map[string]*fetchedP
 			continue
 		}
 		toDelete = append(toDelete, datastore.KeyForObj(ctx, cur))
+		diff.Removed = append(diff.Removed, revisionChange{Project: project, From: cur.Meta.Revision})
 	}
 
 	if err := datastore.Delete(ctx, toDelete); err != nil {
 		errs = append(errs, errors.Annotate(err, "deleting stale project configs").Err())
+		diff.Removed = nil
+	}
+
+	if !diff.empty() {
+		diff.sort()
+		logging.Fields{
+			"added":   diff.Added,
+			"updated": diff.Updated,
+			"removed": diff.Removed,
+		}.Infof(ctx, "Activated new project config revisions: %s", diff)
 	}
 
 	if len(errs) > 0 {
@@ -215,11 +262,33 @@ func fetchProjectConfigEntities(ctx context.Context) (map[string]*cachedProjectC
 // Projects returns all project configurations, in a map by project name.
 // Uses in-memory cache to avoid hitting datastore all the time.
 func Projects(ctx context.Context) (map[string]*ProjectConfig, error) {
+	pc, err := cachedProjects(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return pc.configs, nil
+}
+
+// ProjectVersions returns the versions of all project configurations, in
+// a map by project name. The versions are those of the configurations
+// returned by Projects.
+func ProjectVersions(ctx context.Context) (map[string]ConfigVersion, error) {
+	pc, err := cachedProjects(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return pc.versions, nil
+}
+
+// cachedProjects returns all project configurations and their versions,
+// from the in-memory cache.
+func cachedProjects(ctx context.Context) (*projectConfigs, error) {
 	val, err := projectCacheSlot.Fetch(ctx, func(interface{}) (val interface{}, exp time.Duration, err error) {
-		var pc map[string]*ProjectConfig
+		var pc *projectConfigs
 		if pc, err = fetchProjects(ctx); err != nil {
 			return nil, 0, err
 		}
+		reportConfigAge(ctx, pc.versions)
 		return pc, time.Minute, nil
 	})
 	switch {
@@ -231,30 +300,115 @@ func Projects(ctx context.Context) (map[string]*ProjectConfig, error) {
 	case err != nil:
 		return nil, err
 	default:
-		pc := val.(map[string]*ProjectConfig)
-		return pc, nil
+		return val.(*projectConfigs), nil
+	}
+}
+
+// reportConfigAge reports the age of the project configurations being
+// served to tsmon.
+func reportConfigAge(ctx context.Context, versions map[string]ConfigVersion) {
+	now := clock.Now(ctx)
+	for project, v := range versions {
+		if v.FetchTime.IsZero() {
+			// Stored before fetch times were recorded.
+			continue
+		}
+		configAgeGauge.Set(ctx, now.Sub(v.FetchTime).Seconds(), project)
 	}
 }
 
 // fetchProjects retrieves all project configurations from datastore.
-func fetchProjects(ctx context.Context) (map[string]*ProjectConfig, error) {
+func fetchProjects(ctx context.Context) (*projectConfigs, error) {
 	ctx = cleanContext(ctx)
 
 	cachedCfgs, err := fetchProjectConfigEntities(ctx)
 	if err != nil {
 		return nil, errors.Annotate(err, "fetching cached config").Err()
 	}
-	result := make(map[string]*ProjectConfig)
+	result := &projectConfigs{
+		configs:  make(map[string]*ProjectConfig),
+		versions: make(map[string]ConfigVersion),
+	}
 	for project, cached := range cachedCfgs {
 		cfg := &ProjectConfig{}
 		if err := proto.Unmarshal(cached.Config, cfg); err != nil {
 			return nil, errors.Annotate(err, "unmarshalling cached config").Err()
 		}
-		result[project] = cfg
+		result.configs[project] = cfg
+		result.versions[project] = ConfigVersion{
+			Revision:  cached.Meta.Revision,
+			FetchTime: cached.FetchTime,
+		}
 	}
 	return result, nil
 }
 
+// revisionChange is a change to the config revision of a project.
+type revisionChange struct {
+	Project string
+	// From is the previous revision, empty if the project was added.
+	From string
+	// To is the new revision, empty if the project was removed.
+	To string
+}
+
+func (c revisionChange) String() string {
+	switch {
+	case c.From == "":
+		return fmt.Sprintf("%s@%s", c.Project, c.To)
+	case c.To == "":
+		return fmt.Sprintf("%s@%s", c.Project, c.From)
+	default:
+		return fmt.Sprintf("%s@%s->%s", c.Project, c.From, c.To)
+	}
+}
+
+// configDiff summarises the changes to the stored project configs made
+// by an update.
+type configDiff struct {
+	Added   []revisionChange
+	Updated []revisionChange
+	Removed []revisionChange
+}
+
+func (d *configDiff) empty() bool {
+	return len(d.Added) == 0 && len(d.Updated) == 0 && len(d.Removed) == 0
+}
+
+// sort sorts the changes by project.
+func (d *configDiff) sort() {
+	for _, cs := range [][]revisionChange{d.Added, d.Updated, d.Removed} {
+		sort.Slice(cs, func(i, j int) bool { return cs[i].Project < cs[j].Project })
+	}
+}
+
+// String returns a one-line summary of the diff, e.g.
+// "added: c@rev3; updated: b@rev1->rev2; removed: a@rev1".
+func (d *configDiff) String() string {
+	var parts []string
+	for _, s := range []struct {
+		name    string
+		changes []revisionChange
+	}{
+		{"added", d.Added},
+		{"updated", d.Updated},
+		{"removed", d.Removed},
+	} {
+		if len(s.changes) == 0 {
+			continue
+		}
+		strs := make([]string, len(s.changes))
+		for i, c := range s.changes {
+			strs[i] = c.String()
+		}
+		parts = append(parts, s.name+": "+strings.Join(strs, ", "))
+	}
+	if len(parts) == 0 {
+		return "no changes"
+	}
+	return strings.Join(parts, "; ")
+}
+
 // cleanContext returns a context with datastore using the default namespace
 // and not using transactions.
 func cleanContext(ctx context.Context) context.Context {
@@ -287,12 +441,48 @@ func SetTestProjectConfig(ctx context.Context, cfg map[string]*ProjectConfig) er
 
 // Project returns the configurations of the requested project.
 func Project(ctx context.Context, project string) (*ProjectConfig, error) {
-	configs, err := Projects(ctx)
+	cfg, _, err := ProjectWithVersion(ctx, project)
+	return cfg, err
+}
+
+// ProjectWithVersion returns the configuration of the requested project,
+// and its version.
+func ProjectWithVersion(ctx context.Context, project string) (*ProjectConfig, ConfigVersion, error) {
+	pc, err := cachedProjects(ctx)
 	if err != nil {
-		return nil, err
+		return nil, ConfigVersion{}, err
+	}
+	if c, ok := pc.configs[project]; ok {
+		return c, pc.versions[project], nil
 	}
-	if c, ok := configs[project]; ok {
-		return c, nil
+	return nil, ConfigVersion{}, fmt.Errorf("no config found for project %s", project)
+}
+
+// DefaultFailureRetentionDays is the number of days for which failure data
+// is retained in projects which do not configure a retention period.
+const DefaultFailureRetentionDays = 90
+
+// FailureRetention returns the period for which the clustered failures and
+// test verdicts of a project with the given configuration are retained.
+func FailureRetention(cfg *ProjectConfig) time.Duration {
+	days := cfg.GetRetention().GetFailureRetentionDays()
+	if days == 0 {
+		days = DefaultFailureRetentionDays
+	}
+	return time.Duration(days) * 24 * time.Hour
+}
+
+// DefaultChunkExportSampleFraction is the fraction of the chunks of a
+// project exported by chunk exports, if neither the export nor the project
+// config specifies one.
+const DefaultChunkExportSampleFraction = 0.01
+
+// ChunkExportSampleFraction returns the fraction of the chunks of a project
+// with the given configuration which are exported by default.
+func ChunkExportSampleFraction(cfg *ProjectConfig) float64 {
+	f := cfg.GetChunkExport().GetSampleFraction()
+	if f == 0 {
+		f = DefaultChunkExportSampleFraction
 	}
-	return nil, fmt.Errorf("no config found for project %s", project)
+	return f
 }
@@ -24,6 +24,60 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// RecurrenceAction is the action taken on the bug of a rule whose failures
+// recur after the bug was fixed.
+type BugStatusSync_RecurrenceAction int32
+
+const (
+	// Defaults to REOPEN.
+	BugStatusSync_RECURRENCE_ACTION_UNSPECIFIED BugStatusSync_RecurrenceAction = 0
+	// Reopen the bug, with a comment explaining why.
+	BugStatusSync_REOPEN BugStatusSync_RecurrenceAction = 1
+	// Only comment on the bug, leaving it closed.
+	BugStatusSync_COMMENT BugStatusSync_RecurrenceAction = 2
+)
+
+// Enum value maps for BugStatusSync_RecurrenceAction.
+var (
+	BugStatusSync_RecurrenceAction_name = map[int32]string{
+		0: "RECURRENCE_ACTION_UNSPECIFIED",
+		1: "REOPEN",
+		2: "COMMENT",
+	}
+	BugStatusSync_RecurrenceAction_value = map[string]int32{
+		"RECURRENCE_ACTION_UNSPECIFIED": 0,
+		"REOPEN":                        1,
+		"COMMENT":                       2,
+	}
+)
+
+func (x BugStatusSync_RecurrenceAction) Enum() *BugStatusSync_RecurrenceAction {
+	p := new(BugStatusSync_RecurrenceAction)
+	*p = x
+	return p
+}
+
+func (x BugStatusSync_RecurrenceAction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BugStatusSync_RecurrenceAction) Descriptor() protoreflect.EnumDescriptor {
+	return file_infra_appengine_weetbix_internal_config_project_config_proto_enumTypes[0].Descriptor()
+}
+
+func (BugStatusSync_RecurrenceAction) Type() protoreflect.EnumType {
+	return &file_infra_appengine_weetbix_internal_config_project_config_proto_enumTypes[0]
+}
+
+func (x BugStatusSync_RecurrenceAction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BugStatusSync_RecurrenceAction.Descriptor instead.
+func (BugStatusSync_RecurrenceAction) EnumDescriptor() ([]byte, []int) {
+	return file_infra_appengine_weetbix_internal_config_project_config_proto_rawDescGZIP(), []int{8, 0}
+}
+
 // ProjectConfig is the project-specific configuration data for Weetbix.
 type ProjectConfig struct {
 	state         protoimpl.MessageState
@@ -37,6 +91,29 @@ type ProjectConfig struct {
 	BugFilingThreshold *ImpactThreshold `protobuf:"bytes,2,opt,name=bug_filing_threshold,json=bugFilingThreshold,proto3" json:"bug_filing_threshold,omitempty"`
 	// Per realm configurations.
 	Realms []*RealmConfig `protobuf:"bytes,3,rep,name=realms,proto3" json:"realms,omitempty"`
+	// The configuration of the clean up of failure association rules which
+	// no longer match any failures. If unset, rules are not cleaned up.
+	RuleHygiene *RuleHygiene `protobuf:"bytes,4,opt,name=rule_hygiene,json=ruleHygiene,proto3" json:"rule_hygiene,omitempty"`
+	// The configuration of the artifacts of failed test results which are
+	// linked to from clustered failures. If unset, no artifacts are linked.
+	ArtifactLinks *ArtifactLinks `protobuf:"bytes,5,opt,name=artifact_links,json=artifactLinks,proto3" json:"artifact_links,omitempty"`
+	// The configuration of the ingestion of failed build steps which are not
+	// test steps, e.g. compile steps, and of bug filing for their clusters.
+	// If unset, build step failures are not ingested.
+	BuildFailures *BuildFailures `protobuf:"bytes,6,opt,name=build_failures,json=buildFailures,proto3" json:"build_failures,omitempty"`
+	// The configuration of the retention of failure data. If unset, failure
+	// data is retained for the default retention period.
+	Retention *Retention `protobuf:"bytes,7,opt,name=retention,proto3" json:"retention,omitempty"`
+	// Configuration for exports of samples of the project's clustering chunks,
+	// used for offline experimentation with clustering algorithms. If unset,
+	// the project's chunks are not exported.
+	ChunkExport *ChunkExport `protobuf:"bytes,8,opt,name=chunk_export,json=chunkExport,proto3" json:"chunk_export,omitempty"`
+	// The configuration of the clustering of the project's test failures.
+	Clustering *Clustering `protobuf:"bytes,9,opt,name=clustering,proto3" json:"clustering,omitempty"`
+	// The configuration of the synchronisation of failure association rules
+	// with the status of their bugs. If unset, rules are not synchronised
+	// with the status of their bugs.
+	BugStatusSync *BugStatusSync `protobuf:"bytes,10,opt,name=bug_status_sync,json=bugStatusSync,proto3" json:"bug_status_sync,omitempty"`
 }
 
 func (x *ProjectConfig) Reset() {
@@ -92,6 +169,55 @@ func (x *ProjectConfig) GetRealms() []*RealmConfig {
 	return nil
 }
 
+func (x *ProjectConfig) GetRuleHygiene() *RuleHygiene {
+	if x != nil {
+		return x.RuleHygiene
+	}
+	return nil
+}
+
+func (x *ProjectConfig) GetArtifactLinks() *ArtifactLinks {
+	if x != nil {
+		return x.ArtifactLinks
+	}
+	return nil
+}
+
+func (x *ProjectConfig) GetBuildFailures() *BuildFailures {
+	if x != nil {
+		return x.BuildFailures
+	}
+	return nil
+}
+
+func (x *ProjectConfig) GetRetention() *Retention {
+	if x != nil {
+		return x.Retention
+	}
+	return nil
+}
+
+func (x *ProjectConfig) GetChunkExport() *ChunkExport {
+	if x != nil {
+		return x.ChunkExport
+	}
+	return nil
+}
+
+func (x *ProjectConfig) GetClustering() *Clustering {
+	if x != nil {
+		return x.Clustering
+	}
+	return nil
+}
+
+func (x *ProjectConfig) GetBugStatusSync() *BugStatusSync {
+	if x != nil {
+		return x.BugStatusSync
+	}
+	return nil
+}
+
 // MonorailProject describes the configuration to use when filing bugs
 // into a given monorail project.
 type MonorailProject struct {
@@ -576,6 +702,533 @@ func (x *RealmConfig) GetTestVariantAnalysis() *TestVariantAnalysisConfig {
 	return nil
 }
 
+// RuleHygiene configures the clean up of failure association rules which
+// no longer match any failures, e.g. because the failing tests were deleted
+// or the bugs were fixed.
+type RuleHygiene struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The number of days after which an active rule which has not matched any
+	// failure is flagged as stale. Stale rules are highlighted to users, but
+	// continue to be evaluated.
+	//
+	// Must be at least 1 and at most 540 (the retention period of clustered
+	// failures).
+	StaleAfterDays int64 `protobuf:"varint,1,opt,name=stale_after_days,json=staleAfterDays,proto3" json:"stale_after_days,omitempty"`
+	// Whether to archive (deactivate) rules which have not matched any failure
+	// for archive_after_days, and whose bug is closed. Archived rules are no
+	// longer evaluated, and their bugs are no longer updated by Weetbix.
+	AutoArchive bool `protobuf:"varint,2,opt,name=auto_archive,json=autoArchive,proto3" json:"auto_archive,omitempty"`
+	// The number of days after which an active rule which has not matched any
+	// failure is archived, if its bug is closed and auto_archive is set.
+	//
+	// Must be set if auto_archive is set, and then must be greater than
+	// stale_after_days and at most 540.
+	ArchiveAfterDays int64 `protobuf:"varint,3,opt,name=archive_after_days,json=archiveAfterDays,proto3" json:"archive_after_days,omitempty"`
+}
+
+func (x *RuleHygiene) Reset() {
+	*x = RuleHygiene{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_infra_appengine_weetbix_internal_config_project_config_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RuleHygiene) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RuleHygiene) ProtoMessage() {}
+
+func (x *RuleHygiene) ProtoReflect() protoreflect.Message {
+	mi := &file_infra_appengine_weetbix_internal_config_project_config_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RuleHygiene.ProtoReflect.Descriptor instead.
+func (*RuleHygiene) Descriptor() ([]byte, []int) {
+	return file_infra_appengine_weetbix_internal_config_project_config_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *RuleHygiene) GetStaleAfterDays() int64 {
+	if x != nil {
+		return x.StaleAfterDays
+	}
+	return 0
+}
+
+func (x *RuleHygiene) GetAutoArchive() bool {
+	if x != nil {
+		return x.AutoArchive
+	}
+	return false
+}
+
+func (x *RuleHygiene) GetArchiveAfterDays() int64 {
+	if x != nil {
+		return x.ArchiveAfterDays
+	}
+	return 0
+}
+
+// BugStatusSync configures how the bug updater keeps failure association
+// rules in sync with the status of their bugs once the bugs are fixed, i.e.
+// marked Fixed or Verified.
+//
+// Rules whose bug is fixed are archived once their cluster has had no impact
+// for confirmation_days. If failures recur after the fix, the bug is reopened
+// (or commented on, per recurrence_action) and the rule is flagged as
+// recurred.
+type BugStatusSync struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The number of days for which the cluster of a rule whose bug is fixed
+	// must have no impact before the rule is archived. Archived rules are no
+	// longer evaluated, and their bugs are no longer updated by Weetbix.
+	//
+	// Must be at least 1 and at most 30.
+	ConfirmationDays int64 `protobuf:"varint,1,opt,name=confirmation_days,json=confirmationDays,proto3" json:"confirmation_days,omitempty"`
+	// The impact at which failures of a rule whose bug is fixed are considered
+	// to have recurred. Only failures from a day after the bug was fixed are
+	// considered, so that failures from before the fix are not mistaken for
+	// recurrences.
+	//
+	// Must be specified, and only one_day thresholds may be set.
+	RecurrenceThreshold *ImpactThreshold `protobuf:"bytes,2,opt,name=recurrence_threshold,json=recurrenceThreshold,proto3" json:"recurrence_threshold,omitempty"`
+	// The action taken on the bug when failures recur. Weetbix acts at most
+	// once each time a bug is fixed.
+	RecurrenceAction BugStatusSync_RecurrenceAction `protobuf:"varint,3,opt,name=recurrence_action,json=recurrenceAction,proto3,enum=weetbix.v1.BugStatusSync_RecurrenceAction" json:"recurrence_action,omitempty"`
+}
+
+func (x *BugStatusSync) Reset() {
+	*x = BugStatusSync{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_infra_appengine_weetbix_internal_config_project_config_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BugStatusSync) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BugStatusSync) ProtoMessage() {}
+
+func (x *BugStatusSync) ProtoReflect() protoreflect.Message {
+	mi := &file_infra_appengine_weetbix_internal_config_project_config_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BugStatusSync.ProtoReflect.Descriptor instead.
+func (*BugStatusSync) Descriptor() ([]byte, []int) {
+	return file_infra_appengine_weetbix_internal_config_project_config_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *BugStatusSync) GetConfirmationDays() int64 {
+	if x != nil {
+		return x.ConfirmationDays
+	}
+	return 0
+}
+
+func (x *BugStatusSync) GetRecurrenceThreshold() *ImpactThreshold {
+	if x != nil {
+		return x.RecurrenceThreshold
+	}
+	return nil
+}
+
+func (x *BugStatusSync) GetRecurrenceAction() BugStatusSync_RecurrenceAction {
+	if x != nil {
+		return x.RecurrenceAction
+	}
+	return BugStatusSync_RECURRENCE_ACTION_UNSPECIFIED
+}
+
+// ArtifactLinks configures which artifacts of failed test results are
+// captured during ingestion, so that cluster example failures can link to
+// them. Only links to the artifacts are stored, not their contents.
+type ArtifactLinks struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The IDs of the test result artifacts to link to, e.g. "snippet" or
+	// "stack_trace". Artifacts are linked to in the order of their IDs here.
+	//
+	// At most 10 IDs may be specified, and each must be a valid ResultDB
+	// artifact ID.
+	ArtifactIds []string `protobuf:"bytes,1,rep,name=artifact_ids,json=artifactIds,proto3" json:"artifact_ids,omitempty"`
+}
+
+func (x *ArtifactLinks) Reset() {
+	*x = ArtifactLinks{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_infra_appengine_weetbix_internal_config_project_config_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ArtifactLinks) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArtifactLinks) ProtoMessage() {}
+
+func (x *ArtifactLinks) ProtoReflect() protoreflect.Message {
+	mi := &file_infra_appengine_weetbix_internal_config_project_config_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArtifactLinks.ProtoReflect.Descriptor instead.
+func (*ArtifactLinks) Descriptor() ([]byte, []int) {
+	return file_infra_appengine_weetbix_internal_config_project_config_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ArtifactLinks) GetArtifactIds() []string {
+	if x != nil {
+		return x.ArtifactIds
+	}
+	return nil
+}
+
+// BuildFailures configures the ingestion and clustering of failed build
+// steps which are not test steps, e.g. compile or infra steps. Such failures
+// are clustered by their step name and summary, separately from test
+// failures.
+type BuildFailures struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Regular expressions matching the names of steps which run tests.
+	// Failures of these steps are not ingested as build step failures, as
+	// the failures of their tests are ingested instead. The expressions are
+	// matched against the whole step name, including the names of any parent
+	// steps, e.g. "test_pre_run|browser_tests (with patch)".
+	//
+	// Only leaf steps are ingested, so the parent steps of failed steps need
+	// not be matched.
+	TestStepPatterns []string `protobuf:"bytes,1,rep,name=test_step_patterns,json=testStepPatterns,proto3" json:"test_step_patterns,omitempty"`
+	// The threshold at which to file bugs for clusters of build step
+	// failures. If a cluster's impact exceeds the given threshold, a bug
+	// will be filed for it. If unset, no bugs are filed for such clusters.
+	BugFilingThreshold *ImpactThreshold `protobuf:"bytes,2,opt,name=bug_filing_threshold,json=bugFilingThreshold,proto3" json:"bug_filing_threshold,omitempty"`
+}
+
+func (x *BuildFailures) Reset() {
+	*x = BuildFailures{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_infra_appengine_weetbix_internal_config_project_config_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BuildFailures) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BuildFailures) ProtoMessage() {}
+
+func (x *BuildFailures) ProtoReflect() protoreflect.Message {
+	mi := &file_infra_appengine_weetbix_internal_config_project_config_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BuildFailures.ProtoReflect.Descriptor instead.
+func (*BuildFailures) Descriptor() ([]byte, []int) {
+	return file_infra_appengine_weetbix_internal_config_project_config_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *BuildFailures) GetTestStepPatterns() []string {
+	if x != nil {
+		return x.TestStepPatterns
+	}
+	return nil
+}
+
+func (x *BuildFailures) GetBugFilingThreshold() *ImpactThreshold {
+	if x != nil {
+		return x.BugFilingThreshold
+	}
+	return nil
+}
+
+// Retention configures how long the failure data of a LUCI project is kept.
+type Retention struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The number of days for which clustered failures and test verdicts are
+	// retained, by their partition time. Older data is deleted from Spanner
+	// by the enforce-retention cron job, and expires from the clustered
+	// failures BigQuery table.
+	//
+	// If unset, defaults to 90. Otherwise, must be at least 30 (to cover the
+	// impact periods bugs are filed for) and at most 540.
+	FailureRetentionDays int64 `protobuf:"varint,1,opt,name=failure_retention_days,json=failureRetentionDays,proto3" json:"failure_retention_days,omitempty"`
+}
+
+func (x *Retention) Reset() {
+	*x = Retention{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_infra_appengine_weetbix_internal_config_project_config_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Retention) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Retention) ProtoMessage() {}
+
+func (x *Retention) ProtoReflect() protoreflect.Message {
+	mi := &file_infra_appengine_weetbix_internal_config_project_config_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Retention.ProtoReflect.Descriptor instead.
+func (*Retention) Descriptor() ([]byte, []int) {
+	return file_infra_appengine_weetbix_internal_config_project_config_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *Retention) GetFailureRetentionDays() int64 {
+	if x != nil {
+		return x.FailureRetentionDays
+	}
+	return 0
+}
+
+// ChunkExport configures exports of samples of the clustering chunks of a
+// LUCI project to the chunk_export_gcs_bucket of the service config. Exported
+// failures are redacted, see the chunkexport package.
+type ChunkExport struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Whether the project opts in to exports of its chunks.
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// The fraction of the project's chunks exported, unless an export
+	// requests another fraction. If unset, defaults to 0.01. Otherwise, must
+	// be in (0, 1].
+	SampleFraction float64 `protobuf:"fixed64,2,opt,name=sample_fraction,json=sampleFraction,proto3" json:"sample_fraction,omitempty"`
+}
+
+func (x *ChunkExport) Reset() {
+	*x = ChunkExport{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_infra_appengine_weetbix_internal_config_project_config_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChunkExport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChunkExport) ProtoMessage() {}
+
+func (x *ChunkExport) ProtoReflect() protoreflect.Message {
+	mi := &file_infra_appengine_weetbix_internal_config_project_config_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChunkExport.ProtoReflect.Descriptor instead.
+func (*ChunkExport) Descriptor() ([]byte, []int) {
+	return file_infra_appengine_weetbix_internal_config_project_config_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ChunkExport) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *ChunkExport) GetSampleFraction() float64 {
+	if x != nil {
+		return x.SampleFraction
+	}
+	return 0
+}
+
+// Clustering configures the clustering of the test failures of a LUCI
+// project.
+type Clustering struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The rules masking the parameterised parts of test IDs, applied by the
+	// test name clustering algorithm, so that the failures of all
+	// parameterisations of a test are clustered together. The first rule
+	// matching a test ID is applied. If no rule matches, failures are
+	// clustered by their exact test ID.
+	//
+	// Changing the rules re-clusters the project's failures.
+	TestNameMaskingRules []*TestNameMaskingRule `protobuf:"bytes,1,rep,name=test_name_masking_rules,json=testNameMaskingRules,proto3" json:"test_name_masking_rules,omitempty"`
+}
+
+func (x *Clustering) Reset() {
+	*x = Clustering{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_infra_appengine_weetbix_internal_config_project_config_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Clustering) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Clustering) ProtoMessage() {}
+
+func (x *Clustering) ProtoReflect() protoreflect.Message {
+	mi := &file_infra_appengine_weetbix_internal_config_project_config_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Clustering.ProtoReflect.Descriptor instead.
+func (*Clustering) Descriptor() ([]byte, []int) {
+	return file_infra_appengine_weetbix_internal_config_project_config_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *Clustering) GetTestNameMaskingRules() []*TestNameMaskingRule {
+	if x != nil {
+		return x.TestNameMaskingRules
+	}
+	return nil
+}
+
+// TestNameMaskingRule masks the parameterised parts of matching test IDs.
+type TestNameMaskingRule struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// A human-readable name of the rule, unique within the project, e.g.
+	// "gtest-value-parameterised". Must match ^[a-z0-9-]{1,64}$.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// An RE2 regular expression, matched against the whole test ID. The parts
+	// of the test ID matched by the capture groups of the expression are
+	// masked. Must have at least one capture group.
+	//
+	// For example, "^ninja://:blink_web_tests/(virtual/[^/]+/)" masks the
+	// virtual test suite of web tests, and "^ninja://.*/(\d+)$" the parameter
+	// index of gtest value-parameterised tests.
+	Pattern string `protobuf:"bytes,2,opt,name=pattern,proto3" json:"pattern,omitempty"`
+}
+
+func (x *TestNameMaskingRule) Reset() {
+	*x = TestNameMaskingRule{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_infra_appengine_weetbix_internal_config_project_config_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TestNameMaskingRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TestNameMaskingRule) ProtoMessage() {}
+
+func (x *TestNameMaskingRule) ProtoReflect() protoreflect.Message {
+	mi := &file_infra_appengine_weetbix_internal_config_project_config_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TestNameMaskingRule.ProtoReflect.Descriptor instead.
+func (*TestNameMaskingRule) Descriptor() ([]byte, []int) {
+	return file_infra_appengine_weetbix_internal_config_project_config_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *TestNameMaskingRule) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TestNameMaskingRule) GetPattern() string {
+	if x != nil {
+		return x.Pattern
+	}
+	return ""
+}
+
 var File_infra_appengine_weetbix_internal_config_project_config_proto protoreflect.FileDescriptor
 
 var file_infra_appengine_weetbix_internal_config_project_config_proto_rawDesc = []byte{
@@ -588,7 +1241,7 @@ var file_infra_appengine_weetbix_internal_config_project_config_proto_rawDesc =
 	0x62, 0x69, 0x78, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x63, 0x6f, 0x6e,
 	0x66, 0x69, 0x67, 0x2f, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74,
 	0x5f, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xc8, 0x01, 0x0a, 0x0d, 0x50, 0x72, 0x6f, 0x6a, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xf4, 0x04, 0x0a, 0x0d, 0x50, 0x72, 0x6f, 0x6a, 0x65,
 	0x63, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x37, 0x0a, 0x08, 0x6d, 0x6f, 0x6e, 0x6f,
 	0x72, 0x61, 0x69, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x77, 0x65, 0x65,
 	0x74, 0x62, 0x69, 0x78, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x6e, 0x6f, 0x72, 0x61, 0x69, 0x6c,
@@ -601,89 +1254,176 @@ var file_infra_appengine_weetbix_internal_config_project_config_proto_rawDesc =
 	0x12, 0x2f, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b,
 	0x32, 0x17, 0x2e, 0x77, 0x65, 0x65, 0x74, 0x62, 0x69, 0x78, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65,
 	0x61, 0x6c, 0x6d, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x06, 0x72, 0x65, 0x61, 0x6c, 0x6d,
-	0x73, 0x22, 0xa7, 0x02, 0x0a, 0x0f, 0x4d, 0x6f, 0x6e, 0x6f, 0x72, 0x61, 0x69, 0x6c, 0x50, 0x72,
-	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x12,
-	0x50, 0x0a, 0x14, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64,
-	0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e,
+	0x73, 0x12, 0x3a, 0x0a, 0x0c, 0x72, 0x75, 0x6c, 0x65, 0x5f, 0x68, 0x79, 0x67, 0x69, 0x65, 0x6e,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x77, 0x65, 0x65, 0x74, 0x62, 0x69,
+	0x78, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x48, 0x79, 0x67, 0x69, 0x65, 0x6e, 0x65,
+	0x52, 0x0b, 0x72, 0x75, 0x6c, 0x65, 0x48, 0x79, 0x67, 0x69, 0x65, 0x6e, 0x65, 0x12, 0x40, 0x0a,
+	0x0e, 0x61, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x5f, 0x6c, 0x69, 0x6e, 0x6b, 0x73, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x77, 0x65, 0x65, 0x74, 0x62, 0x69, 0x78, 0x2e,
+	0x76, 0x31, 0x2e, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x4c, 0x69, 0x6e, 0x6b, 0x73,
+	0x52, 0x0d, 0x61, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x4c, 0x69, 0x6e, 0x6b, 0x73, 0x12,
+	0x40, 0x0a, 0x0e, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65,
+	0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x77, 0x65, 0x65, 0x74, 0x62, 0x69,
+	0x78, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72,
+	0x65, 0x73, 0x52, 0x0d, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65,
+	0x73, 0x12, 0x33, 0x0a, 0x09, 0x72, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x77, 0x65, 0x65, 0x74, 0x62, 0x69, 0x78, 0x2e, 0x76,
+	0x31, 0x2e, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x72, 0x65, 0x74,
+	0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3a, 0x0a, 0x0c, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x5f,
+	0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x77,
+	0x65, 0x65, 0x74, 0x62, 0x69, 0x78, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x45,
+	0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x0b, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x45, 0x78, 0x70, 0x6f,
+	0x72, 0x74, 0x12, 0x36, 0x0a, 0x0a, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x69, 0x6e, 0x67,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x77, 0x65, 0x65, 0x74, 0x62, 0x69, 0x78,
+	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0a,
+	0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x41, 0x0a, 0x0f, 0x62, 0x75,
+	0x67, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x73, 0x79, 0x6e, 0x63, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x77, 0x65, 0x65, 0x74, 0x62, 0x69, 0x78, 0x2e, 0x76, 0x31,
+	0x2e, 0x42, 0x75, 0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x53, 0x79, 0x6e, 0x63, 0x52, 0x0d,
+	0x62, 0x75, 0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x53, 0x79, 0x6e, 0x63, 0x22, 0xa7, 0x02,
+	0x0a, 0x0f, 0x4d, 0x6f, 0x6e, 0x6f, 0x72, 0x61, 0x69, 0x6c, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63,
+	0x74, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x50, 0x0a, 0x14, 0x64,
+	0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x77, 0x65, 0x65, 0x74,
+	0x62, 0x69, 0x78, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x6e, 0x6f, 0x72, 0x61, 0x69, 0x6c, 0x46,
+	0x69, 0x65, 0x6c, 0x64, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x12, 0x64, 0x65, 0x66, 0x61, 0x75,
+	0x6c, 0x74, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x2a, 0x0a,
+	0x11, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f,
+	0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69,
+	0x74, 0x79, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x49, 0x64, 0x12, 0x3c, 0x0a, 0x0a, 0x70, 0x72, 0x69,
+	0x6f, 0x72, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
 	0x77, 0x65, 0x65, 0x74, 0x62, 0x69, 0x78, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x6e, 0x6f, 0x72,
-	0x61, 0x69, 0x6c, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x12, 0x64,
-	0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x56, 0x61, 0x6c, 0x75, 0x65,
-	0x73, 0x12, 0x2a, 0x0a, 0x11, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x66, 0x69,
-	0x65, 0x6c, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x70, 0x72,
-	0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x49, 0x64, 0x12, 0x3c, 0x0a,
-	0x0a, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x1c, 0x2e, 0x77, 0x65, 0x65, 0x74, 0x62, 0x69, 0x78, 0x2e, 0x76, 0x31, 0x2e, 0x4d,
-	0x6f, 0x6e, 0x6f, 0x72, 0x61, 0x69, 0x6c, 0x50, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x52,
-	0x0a, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x3e, 0x0a, 0x1b, 0x70,
-	0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x68, 0x79, 0x73, 0x74, 0x65, 0x72, 0x65, 0x73,
-	0x69, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x19, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x48, 0x79, 0x73, 0x74, 0x65, 0x72,
-	0x65, 0x73, 0x69, 0x73, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x22, 0x45, 0x0a, 0x12, 0x4d,
-	0x6f, 0x6e, 0x6f, 0x72, 0x61, 0x69, 0x6c, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x56, 0x61, 0x6c, 0x75,
-	0x65, 0x12, 0x19, 0x0a, 0x08, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x07, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x22, 0x69, 0x0a, 0x10, 0x4d, 0x6f, 0x6e, 0x6f, 0x72, 0x61, 0x69, 0x6c, 0x50, 0x72,
-	0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69,
-	0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69,
-	0x74, 0x79, 0x12, 0x39, 0x0a, 0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x77, 0x65, 0x65, 0x74, 0x62, 0x69, 0x78, 0x2e,
-	0x76, 0x31, 0x2e, 0x49, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f,
-	0x6c, 0x64, 0x52, 0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x22, 0xf8, 0x03,
-	0x0a, 0x0f, 0x49, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c,
-	0x64, 0x12, 0x4b, 0x0a, 0x13, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74,
-	0x73, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b,
-	0x2e, 0x77, 0x65, 0x65, 0x74, 0x62, 0x69, 0x78, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x74, 0x72,
-	0x69, 0x63, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x52, 0x11, 0x74, 0x65, 0x73,
-	0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x12, 0x45,
-	0x0a, 0x10, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x72, 0x75, 0x6e, 0x73, 0x5f, 0x66, 0x61, 0x69, 0x6c,
-	0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x77, 0x65, 0x65, 0x74, 0x62,
-	0x69, 0x78, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x54, 0x68, 0x72, 0x65,
-	0x73, 0x68, 0x6f, 0x6c, 0x64, 0x52, 0x0e, 0x74, 0x65, 0x73, 0x74, 0x52, 0x75, 0x6e, 0x73, 0x46,
-	0x61, 0x69, 0x6c, 0x65, 0x64, 0x12, 0x4f, 0x0a, 0x15, 0x70, 0x72, 0x65, 0x73, 0x75, 0x62, 0x6d,
-	0x69, 0x74, 0x5f, 0x72, 0x75, 0x6e, 0x73, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x18, 0x06,
+	0x61, 0x69, 0x6c, 0x50, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x52, 0x0a, 0x70, 0x72, 0x69,
+	0x6f, 0x72, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x3e, 0x0a, 0x1b, 0x70, 0x72, 0x69, 0x6f, 0x72,
+	0x69, 0x74, 0x79, 0x5f, 0x68, 0x79, 0x73, 0x74, 0x65, 0x72, 0x65, 0x73, 0x69, 0x73, 0x5f, 0x70,
+	0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x19, 0x70, 0x72,
+	0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x48, 0x79, 0x73, 0x74, 0x65, 0x72, 0x65, 0x73, 0x69, 0x73,
+	0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x22, 0x45, 0x0a, 0x12, 0x4d, 0x6f, 0x6e, 0x6f, 0x72,
+	0x61, 0x69, 0x6c, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x19, 0x0a,
+	0x08, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x07, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x69,
+	0x0a, 0x10, 0x4d, 0x6f, 0x6e, 0x6f, 0x72, 0x61, 0x69, 0x6c, 0x50, 0x72, 0x69, 0x6f, 0x72, 0x69,
+	0x74, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x39,
+	0x0a, 0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1b, 0x2e, 0x77, 0x65, 0x65, 0x74, 0x62, 0x69, 0x78, 0x2e, 0x76, 0x31, 0x2e, 0x49,
+	0x6d, 0x70, 0x61, 0x63, 0x74, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x52, 0x09,
+	0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x22, 0xf8, 0x03, 0x0a, 0x0f, 0x49, 0x6d,
+	0x70, 0x61, 0x63, 0x74, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x4b, 0x0a,
+	0x13, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x5f, 0x66, 0x61,
+	0x69, 0x6c, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x77, 0x65, 0x65,
+	0x74, 0x62, 0x69, 0x78, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x54, 0x68,
+	0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x52, 0x11, 0x74, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x73, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x12, 0x45, 0x0a, 0x10, 0x74, 0x65,
+	0x73, 0x74, 0x5f, 0x72, 0x75, 0x6e, 0x73, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x18, 0x05,
 	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x77, 0x65, 0x65, 0x74, 0x62, 0x69, 0x78, 0x2e, 0x76,
 	0x31, 0x2e, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c,
-	0x64, 0x52, 0x13, 0x70, 0x72, 0x65, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x52, 0x75, 0x6e, 0x73,
-	0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x12, 0x39, 0x0a, 0x16, 0x75, 0x6e, 0x65, 0x78, 0x70, 0x65,
-	0x63, 0x74, 0x65, 0x64, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x73, 0x5f, 0x31, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x14, 0x75, 0x6e, 0x65, 0x78, 0x70, 0x65,
-	0x63, 0x74, 0x65, 0x64, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x73, 0x31, 0x64, 0x88, 0x01,
-	0x01, 0x12, 0x39, 0x0a, 0x16, 0x75, 0x6e, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f,
-	0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x73, 0x5f, 0x33, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x03, 0x48, 0x01, 0x52, 0x14, 0x75, 0x6e, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x46,
-	0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x73, 0x33, 0x64, 0x88, 0x01, 0x01, 0x12, 0x39, 0x0a, 0x16,
-	0x75, 0x6e, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x75,
-	0x72, 0x65, 0x73, 0x5f, 0x37, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x48, 0x02, 0x52, 0x14,
-	0x75, 0x6e, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72,
-	0x65, 0x73, 0x37, 0x64, 0x88, 0x01, 0x01, 0x42, 0x19, 0x0a, 0x17, 0x5f, 0x75, 0x6e, 0x65, 0x78,
+	0x64, 0x52, 0x0e, 0x74, 0x65, 0x73, 0x74, 0x52, 0x75, 0x6e, 0x73, 0x46, 0x61, 0x69, 0x6c, 0x65,
+	0x64, 0x12, 0x4f, 0x0a, 0x15, 0x70, 0x72, 0x65, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x5f, 0x72,
+	0x75, 0x6e, 0x73, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1b, 0x2e, 0x77, 0x65, 0x65, 0x74, 0x62, 0x69, 0x78, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65,
+	0x74, 0x72, 0x69, 0x63, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x52, 0x13, 0x70,
+	0x72, 0x65, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x52, 0x75, 0x6e, 0x73, 0x46, 0x61, 0x69, 0x6c,
+	0x65, 0x64, 0x12, 0x39, 0x0a, 0x16, 0x75, 0x6e, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64,
+	0x5f, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x73, 0x5f, 0x31, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x48, 0x00, 0x52, 0x14, 0x75, 0x6e, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64,
+	0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x73, 0x31, 0x64, 0x88, 0x01, 0x01, 0x12, 0x39, 0x0a,
+	0x16, 0x75, 0x6e, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x66, 0x61, 0x69, 0x6c,
+	0x75, 0x72, 0x65, 0x73, 0x5f, 0x33, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x48, 0x01, 0x52,
+	0x14, 0x75, 0x6e, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x46, 0x61, 0x69, 0x6c, 0x75,
+	0x72, 0x65, 0x73, 0x33, 0x64, 0x88, 0x01, 0x01, 0x12, 0x39, 0x0a, 0x16, 0x75, 0x6e, 0x65, 0x78,
 	0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x73, 0x5f,
-	0x31, 0x64, 0x42, 0x19, 0x0a, 0x17, 0x5f, 0x75, 0x6e, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65,
-	0x64, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x73, 0x5f, 0x33, 0x64, 0x42, 0x19, 0x0a,
-	0x17, 0x5f, 0x75, 0x6e, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x66, 0x61, 0x69,
-	0x6c, 0x75, 0x72, 0x65, 0x73, 0x5f, 0x37, 0x64, 0x22, 0x9b, 0x01, 0x0a, 0x0f, 0x4d, 0x65, 0x74,
-	0x72, 0x69, 0x63, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x1c, 0x0a, 0x07,
-	0x6f, 0x6e, 0x65, 0x5f, 0x64, 0x61, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52,
-	0x06, 0x6f, 0x6e, 0x65, 0x44, 0x61, 0x79, 0x88, 0x01, 0x01, 0x12, 0x20, 0x0a, 0x09, 0x74, 0x68,
-	0x72, 0x65, 0x65, 0x5f, 0x64, 0x61, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x48, 0x01, 0x52,
-	0x08, 0x74, 0x68, 0x72, 0x65, 0x65, 0x44, 0x61, 0x79, 0x88, 0x01, 0x01, 0x12, 0x20, 0x0a, 0x09,
-	0x73, 0x65, 0x76, 0x65, 0x6e, 0x5f, 0x64, 0x61, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x48,
-	0x02, 0x52, 0x08, 0x73, 0x65, 0x76, 0x65, 0x6e, 0x44, 0x61, 0x79, 0x88, 0x01, 0x01, 0x42, 0x0a,
-	0x0a, 0x08, 0x5f, 0x6f, 0x6e, 0x65, 0x5f, 0x64, 0x61, 0x79, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x74,
-	0x68, 0x72, 0x65, 0x65, 0x5f, 0x64, 0x61, 0x79, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x73, 0x65, 0x76,
-	0x65, 0x6e, 0x5f, 0x64, 0x61, 0x79, 0x22, 0x7c, 0x0a, 0x0b, 0x52, 0x65, 0x61, 0x6c, 0x6d, 0x43,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x59, 0x0a, 0x15, 0x74, 0x65, 0x73,
-	0x74, 0x5f, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x5f, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x73,
-	0x69, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x77, 0x65, 0x65, 0x74, 0x62,
-	0x69, 0x78, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x56, 0x61, 0x72, 0x69, 0x61, 0x6e,
-	0x74, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52,
-	0x13, 0x74, 0x65, 0x73, 0x74, 0x56, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x41, 0x6e, 0x61, 0x6c,
-	0x79, 0x73, 0x69, 0x73, 0x42, 0x30, 0x5a, 0x2e, 0x69, 0x6e, 0x66, 0x72, 0x61, 0x2f, 0x61, 0x70,
-	0x70, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x2f, 0x77, 0x65, 0x65, 0x74, 0x62, 0x69, 0x78, 0x2f,
-	0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x3b,
-	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x37, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x48, 0x02, 0x52, 0x14, 0x75, 0x6e, 0x65, 0x78,
+	0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x73, 0x37, 0x64,
+	0x88, 0x01, 0x01, 0x42, 0x19, 0x0a, 0x17, 0x5f, 0x75, 0x6e, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74,
+	0x65, 0x64, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x73, 0x5f, 0x31, 0x64, 0x42, 0x19,
+	0x0a, 0x17, 0x5f, 0x75, 0x6e, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x66, 0x61,
+	0x69, 0x6c, 0x75, 0x72, 0x65, 0x73, 0x5f, 0x33, 0x64, 0x42, 0x19, 0x0a, 0x17, 0x5f, 0x75, 0x6e,
+	0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65,
+	0x73, 0x5f, 0x37, 0x64, 0x22, 0x9b, 0x01, 0x0a, 0x0f, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x54,
+	0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x1c, 0x0a, 0x07, 0x6f, 0x6e, 0x65, 0x5f,
+	0x64, 0x61, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x06, 0x6f, 0x6e, 0x65,
+	0x44, 0x61, 0x79, 0x88, 0x01, 0x01, 0x12, 0x20, 0x0a, 0x09, 0x74, 0x68, 0x72, 0x65, 0x65, 0x5f,
+	0x64, 0x61, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x48, 0x01, 0x52, 0x08, 0x74, 0x68, 0x72,
+	0x65, 0x65, 0x44, 0x61, 0x79, 0x88, 0x01, 0x01, 0x12, 0x20, 0x0a, 0x09, 0x73, 0x65, 0x76, 0x65,
+	0x6e, 0x5f, 0x64, 0x61, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x48, 0x02, 0x52, 0x08, 0x73,
+	0x65, 0x76, 0x65, 0x6e, 0x44, 0x61, 0x79, 0x88, 0x01, 0x01, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x6f,
+	0x6e, 0x65, 0x5f, 0x64, 0x61, 0x79, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x65,
+	0x5f, 0x64, 0x61, 0x79, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x73, 0x65, 0x76, 0x65, 0x6e, 0x5f, 0x64,
+	0x61, 0x79, 0x22, 0x7c, 0x0a, 0x0b, 0x52, 0x65, 0x61, 0x6c, 0x6d, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x59, 0x0a, 0x15, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x76, 0x61,
+	0x72, 0x69, 0x61, 0x6e, 0x74, 0x5f, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x77, 0x65, 0x65, 0x74, 0x62, 0x69, 0x78, 0x2e, 0x76,
+	0x31, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x56, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x41, 0x6e, 0x61,
+	0x6c, 0x79, 0x73, 0x69, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x13, 0x74, 0x65, 0x73,
+	0x74, 0x56, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73,
+	0x22, 0x88, 0x01, 0x0a, 0x0b, 0x52, 0x75, 0x6c, 0x65, 0x48, 0x79, 0x67, 0x69, 0x65, 0x6e, 0x65,
+	0x12, 0x28, 0x0a, 0x10, 0x73, 0x74, 0x61, 0x6c, 0x65, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x5f,
+	0x64, 0x61, 0x79, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x73, 0x74, 0x61, 0x6c,
+	0x65, 0x41, 0x66, 0x74, 0x65, 0x72, 0x44, 0x61, 0x79, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x75,
+	0x74, 0x6f, 0x5f, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0b, 0x61, 0x75, 0x74, 0x6f, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x12, 0x2c, 0x0a,
+	0x12, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x5f, 0x64,
+	0x61, 0x79, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x61, 0x72, 0x63, 0x68, 0x69,
+	0x76, 0x65, 0x41, 0x66, 0x74, 0x65, 0x72, 0x44, 0x61, 0x79, 0x73, 0x22, 0xb5, 0x02, 0x0a, 0x0d,
+	0x42, 0x75, 0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x53, 0x79, 0x6e, 0x63, 0x12, 0x2b, 0x0a,
+	0x11, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x61,
+	0x79, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72,
+	0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x79, 0x73, 0x12, 0x4e, 0x0a, 0x14, 0x72, 0x65,
+	0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f,
+	0x6c, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x77, 0x65, 0x65, 0x74, 0x62,
+	0x69, 0x78, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x54, 0x68, 0x72, 0x65,
+	0x73, 0x68, 0x6f, 0x6c, 0x64, 0x52, 0x13, 0x72, 0x65, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63,
+	0x65, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x57, 0x0a, 0x11, 0x72, 0x65,
+	0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2a, 0x2e, 0x77, 0x65, 0x65, 0x74, 0x62, 0x69, 0x78, 0x2e,
+	0x76, 0x31, 0x2e, 0x42, 0x75, 0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x53, 0x79, 0x6e, 0x63,
+	0x2e, 0x52, 0x65, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x10, 0x72, 0x65, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x41, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x22, 0x4e, 0x0a, 0x10, 0x52, 0x65, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63,
+	0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x1d, 0x52, 0x45, 0x43, 0x55, 0x52,
+	0x52, 0x45, 0x4e, 0x43, 0x45, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53,
+	0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x52, 0x45,
+	0x4f, 0x50, 0x45, 0x4e, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x43, 0x4f, 0x4d, 0x4d, 0x45, 0x4e,
+	0x54, 0x10, 0x02, 0x22, 0x32, 0x0a, 0x0d, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x4c,
+	0x69, 0x6e, 0x6b, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74,
+	0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x72, 0x74, 0x69,
+	0x66, 0x61, 0x63, 0x74, 0x49, 0x64, 0x73, 0x22, 0x8c, 0x01, 0x0a, 0x0d, 0x42, 0x75, 0x69, 0x6c,
+	0x64, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x74, 0x65, 0x73,
+	0x74, 0x5f, 0x73, 0x74, 0x65, 0x70, 0x5f, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x10, 0x74, 0x65, 0x73, 0x74, 0x53, 0x74, 0x65, 0x70, 0x50,
+	0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x73, 0x12, 0x4d, 0x0a, 0x14, 0x62, 0x75, 0x67, 0x5f, 0x66,
+	0x69, 0x6c, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x77, 0x65, 0x65, 0x74, 0x62, 0x69, 0x78, 0x2e,
+	0x76, 0x31, 0x2e, 0x49, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f,
+	0x6c, 0x64, 0x52, 0x12, 0x62, 0x75, 0x67, 0x46, 0x69, 0x6c, 0x69, 0x6e, 0x67, 0x54, 0x68, 0x72,
+	0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x22, 0x41, 0x0a, 0x09, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x34, 0x0a, 0x16, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x5f, 0x72,
+	0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x61, 0x79, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x14, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x52, 0x65, 0x74, 0x65,
+	0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x79, 0x73, 0x22, 0x50, 0x0a, 0x0b, 0x43, 0x68, 0x75,
+	0x6e, 0x6b, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62,
+	0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c,
+	0x65, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x5f, 0x66, 0x72, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0e, 0x73, 0x61, 0x6d,
+	0x70, 0x6c, 0x65, 0x46, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x64, 0x0a, 0x0a, 0x43,
+	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x56, 0x0a, 0x17, 0x74, 0x65, 0x73,
+	0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x72,
+	0x75, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x77, 0x65, 0x65,
+	0x74, 0x62, 0x69, 0x78, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65,
+	0x4d, 0x61, 0x73, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x14, 0x74, 0x65, 0x73,
+	0x74, 0x4e, 0x61, 0x6d, 0x65, 0x4d, 0x61, 0x73, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x75, 0x6c, 0x65,
+	0x73, 0x22, 0x43, 0x0a, 0x13, 0x54, 0x65, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x4d, 0x61, 0x73,
+	0x6b, 0x69, 0x6e, 0x67, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70,
+	0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x42, 0x30, 0x5a, 0x2e, 0x69, 0x6e, 0x66, 0x72, 0x61, 0x2f,
+	0x61, 0x70, 0x70, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x2f, 0x77, 0x65, 0x65, 0x74, 0x62, 0x69,
+	0x78, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x3b, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -698,33 +1438,54 @@ func file_infra_appengine_weetbix_internal_config_project_config_proto_rawDescGZ
 	return file_infra_appengine_weetbix_internal_config_project_config_proto_rawDescData
 }
 
-var file_infra_appengine_weetbix_internal_config_project_config_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_infra_appengine_weetbix_internal_config_project_config_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_infra_appengine_weetbix_internal_config_project_config_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
 var file_infra_appengine_weetbix_internal_config_project_config_proto_goTypes = []interface{}{
-	(*ProjectConfig)(nil),             // 0: weetbix.v1.ProjectConfig
-	(*MonorailProject)(nil),           // 1: weetbix.v1.MonorailProject
-	(*MonorailFieldValue)(nil),        // 2: weetbix.v1.MonorailFieldValue
-	(*MonorailPriority)(nil),          // 3: weetbix.v1.MonorailPriority
-	(*ImpactThreshold)(nil),           // 4: weetbix.v1.ImpactThreshold
-	(*MetricThreshold)(nil),           // 5: weetbix.v1.MetricThreshold
-	(*RealmConfig)(nil),               // 6: weetbix.v1.RealmConfig
-	(*TestVariantAnalysisConfig)(nil), // 7: weetbix.v1.TestVariantAnalysisConfig
+	(BugStatusSync_RecurrenceAction)(0), // 0: weetbix.v1.BugStatusSync.RecurrenceAction
+	(*ProjectConfig)(nil),               // 1: weetbix.v1.ProjectConfig
+	(*MonorailProject)(nil),             // 2: weetbix.v1.MonorailProject
+	(*MonorailFieldValue)(nil),          // 3: weetbix.v1.MonorailFieldValue
+	(*MonorailPriority)(nil),            // 4: weetbix.v1.MonorailPriority
+	(*ImpactThreshold)(nil),             // 5: weetbix.v1.ImpactThreshold
+	(*MetricThreshold)(nil),             // 6: weetbix.v1.MetricThreshold
+	(*RealmConfig)(nil),                 // 7: weetbix.v1.RealmConfig
+	(*RuleHygiene)(nil),                 // 8: weetbix.v1.RuleHygiene
+	(*BugStatusSync)(nil),               // 9: weetbix.v1.BugStatusSync
+	(*ArtifactLinks)(nil),               // 10: weetbix.v1.ArtifactLinks
+	(*BuildFailures)(nil),               // 11: weetbix.v1.BuildFailures
+	(*Retention)(nil),                   // 12: weetbix.v1.Retention
+	(*ChunkExport)(nil),                 // 13: weetbix.v1.ChunkExport
+	(*Clustering)(nil),                  // 14: weetbix.v1.Clustering
+	(*TestNameMaskingRule)(nil),         // 15: weetbix.v1.TestNameMaskingRule
+	(*TestVariantAnalysisConfig)(nil),   // 16: weetbix.v1.TestVariantAnalysisConfig
 }
 var file_infra_appengine_weetbix_internal_config_project_config_proto_depIdxs = []int32{
-	1,  // 0: weetbix.v1.ProjectConfig.monorail:type_name -> weetbix.v1.MonorailProject
-	4,  // 1: weetbix.v1.ProjectConfig.bug_filing_threshold:type_name -> weetbix.v1.ImpactThreshold
-	6,  // 2: weetbix.v1.ProjectConfig.realms:type_name -> weetbix.v1.RealmConfig
-	2,  // 3: weetbix.v1.MonorailProject.default_field_values:type_name -> weetbix.v1.MonorailFieldValue
-	3,  // 4: weetbix.v1.MonorailProject.priorities:type_name -> weetbix.v1.MonorailPriority
-	4,  // 5: weetbix.v1.MonorailPriority.threshold:type_name -> weetbix.v1.ImpactThreshold
-	5,  // 6: weetbix.v1.ImpactThreshold.test_results_failed:type_name -> weetbix.v1.MetricThreshold
-	5,  // 7: weetbix.v1.ImpactThreshold.test_runs_failed:type_name -> weetbix.v1.MetricThreshold
-	5,  // 8: weetbix.v1.ImpactThreshold.presubmit_runs_failed:type_name -> weetbix.v1.MetricThreshold
-	7,  // 9: weetbix.v1.RealmConfig.test_variant_analysis:type_name -> weetbix.v1.TestVariantAnalysisConfig
-	10, // [10:10] is the sub-list for method output_type
-	10, // [10:10] is the sub-list for method input_type
-	10, // [10:10] is the sub-list for extension type_name
-	10, // [10:10] is the sub-list for extension extendee
-	0,  // [0:10] is the sub-list for field type_name
+	2,  // 0: weetbix.v1.ProjectConfig.monorail:type_name -> weetbix.v1.MonorailProject
+	5,  // 1: weetbix.v1.ProjectConfig.bug_filing_threshold:type_name -> weetbix.v1.ImpactThreshold
+	7,  // 2: weetbix.v1.ProjectConfig.realms:type_name -> weetbix.v1.RealmConfig
+	8,  // 3: weetbix.v1.ProjectConfig.rule_hygiene:type_name -> weetbix.v1.RuleHygiene
+	10, // 4: weetbix.v1.ProjectConfig.artifact_links:type_name -> weetbix.v1.ArtifactLinks
+	11, // 5: weetbix.v1.ProjectConfig.build_failures:type_name -> weetbix.v1.BuildFailures
+	12, // 6: weetbix.v1.ProjectConfig.retention:type_name -> weetbix.v1.Retention
+	13, // 7: weetbix.v1.ProjectConfig.chunk_export:type_name -> weetbix.v1.ChunkExport
+	14, // 8: weetbix.v1.ProjectConfig.clustering:type_name -> weetbix.v1.Clustering
+	9,  // 9: weetbix.v1.ProjectConfig.bug_status_sync:type_name -> weetbix.v1.BugStatusSync
+	3,  // 10: weetbix.v1.MonorailProject.default_field_values:type_name -> weetbix.v1.MonorailFieldValue
+	4,  // 11: weetbix.v1.MonorailProject.priorities:type_name -> weetbix.v1.MonorailPriority
+	5,  // 12: weetbix.v1.MonorailPriority.threshold:type_name -> weetbix.v1.ImpactThreshold
+	6,  // 13: weetbix.v1.ImpactThreshold.test_results_failed:type_name -> weetbix.v1.MetricThreshold
+	6,  // 14: weetbix.v1.ImpactThreshold.test_runs_failed:type_name -> weetbix.v1.MetricThreshold
+	6,  // 15: weetbix.v1.ImpactThreshold.presubmit_runs_failed:type_name -> weetbix.v1.MetricThreshold
+	16, // 16: weetbix.v1.RealmConfig.test_variant_analysis:type_name -> weetbix.v1.TestVariantAnalysisConfig
+	5,  // 17: weetbix.v1.BugStatusSync.recurrence_threshold:type_name -> weetbix.v1.ImpactThreshold
+	0,  // 18: weetbix.v1.BugStatusSync.recurrence_action:type_name -> weetbix.v1.BugStatusSync.RecurrenceAction
+	5,  // 19: weetbix.v1.BuildFailures.bug_filing_threshold:type_name -> weetbix.v1.ImpactThreshold
+	15, // 20: weetbix.v1.Clustering.test_name_masking_rules:type_name -> weetbix.v1.TestNameMaskingRule
+	21, // [21:21] is the sub-list for method output_type
+	21, // [21:21] is the sub-list for method input_type
+	21, // [21:21] is the sub-list for extension type_name
+	21, // [21:21] is the sub-list for extension extendee
+	0,  // [0:21] is the sub-list for field type_name
 }
 
 func init() { file_infra_appengine_weetbix_internal_config_project_config_proto_init() }
@@ -818,6 +1579,102 @@ func file_infra_appengine_weetbix_internal_config_project_config_proto_init() {
 				return nil
 			}
 		}
+		file_infra_appengine_weetbix_internal_config_project_config_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RuleHygiene); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_infra_appengine_weetbix_internal_config_project_config_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BugStatusSync); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_infra_appengine_weetbix_internal_config_project_config_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ArtifactLinks); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_infra_appengine_weetbix_internal_config_project_config_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BuildFailures); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_infra_appengine_weetbix_internal_config_project_config_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Retention); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_infra_appengine_weetbix_internal_config_project_config_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChunkExport); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_infra_appengine_weetbix_internal_config_project_config_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Clustering); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_infra_appengine_weetbix_internal_config_project_config_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TestNameMaskingRule); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	file_infra_appengine_weetbix_internal_config_project_config_proto_msgTypes[4].OneofWrappers = []interface{}{}
 	file_infra_appengine_weetbix_internal_config_project_config_proto_msgTypes[5].OneofWrappers = []interface{}{}
@@ -826,13 +1683,14 @@ func file_infra_appengine_weetbix_internal_config_project_config_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_infra_appengine_weetbix_internal_config_project_config_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   7,
+			NumEnums:      1,
+			NumMessages:   15,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_infra_appengine_weetbix_internal_config_project_config_proto_goTypes,
 		DependencyIndexes: file_infra_appengine_weetbix_internal_config_project_config_proto_depIdxs,
+		EnumInfos:         file_infra_appengine_weetbix_internal_config_project_config_proto_enumTypes,
 		MessageInfos:      file_infra_appengine_weetbix_internal_config_project_config_proto_msgTypes,
 	}.Build()
 	File_infra_appengine_weetbix_internal_config_project_config_proto = out.File
@@ -9,6 +9,8 @@ import (
 	"testing"
 	"time"
 
+	"go.chromium.org/luci/common/clock"
+	"go.chromium.org/luci/common/tsmon"
 	"go.chromium.org/luci/config"
 	"go.chromium.org/luci/config/cfgclient"
 	cfgmem "go.chromium.org/luci/config/impl/memory"
@@ -80,6 +82,20 @@ func createProjectConfig() *ProjectConfig {
 				},
 			},
 		},
+		RuleHygiene: &RuleHygiene{
+			StaleAfterDays:   30,
+			AutoArchive:      true,
+			ArchiveAfterDays: 90,
+		},
+		BugStatusSync: &BugStatusSync{
+			ConfirmationDays: 7,
+			RecurrenceThreshold: &ImpactThreshold{
+				TestResultsFailed: &MetricThreshold{
+					OneDay: proto.Int64(10),
+				},
+			},
+			RecurrenceAction: BugStatusSync_REOPEN,
+		},
 	}
 }
 
@@ -148,8 +164,9 @@ func TestProjectConfig(t *testing.T) {
 			datastore.GetTestable(ctx).CatchupIndexes()
 
 			// Fetch returns the new value right away.
-			projects, err = fetchProjects(ctx)
+			pc, err := fetchProjects(ctx)
 			So(err, ShouldBeNil)
+			projects = pc.configs
 			So(len(projects), ShouldEqual, 2)
 			So(projects["b"], ShouldResembleProto, newProjectB)
 			So(projects["c"], ShouldResembleProto, projectC)
@@ -172,6 +189,47 @@ func TestProjectConfig(t *testing.T) {
 			So(projects["c"], ShouldResembleProto, projectC)
 		})
 
+		Convey("Versions are tracked", func() {
+			ctx, _ := tsmon.WithDummyInMemory(ctx)
+			// Datastore stores times with microsecond precision.
+			tc.Set(testclock.TestRecentTimeUTC.Truncate(time.Second))
+			fetchTime := clock.Now(ctx)
+
+			err := updateProjects(ctx)
+			So(err, ShouldBeNil)
+			datastore.GetTestable(ctx).CatchupIndexes()
+
+			versions, err := ProjectVersions(ctx)
+			So(err, ShouldBeNil)
+			So(len(versions), ShouldEqual, 2)
+			So(versions["a"].Revision, ShouldNotBeEmpty)
+			So(versions["a"].FetchTime, ShouldEqual, fetchTime)
+			So(versions["b"].FetchTime, ShouldEqual, fetchTime)
+			So(configAgeGauge.Get(ctx, "a"), ShouldEqual, 0)
+
+			// Update project b only.
+			tc.Add(time.Hour)
+			newProjectB := createProjectConfig()
+			newProjectB.Monorail.PriorityFieldId = 2
+			configs["projects/b"]["${appid}.cfg"] = textPBMultiline.Format(newProjectB)
+			err = updateProjects(ctx)
+			So(err, ShouldBeNil)
+			datastore.GetTestable(ctx).CatchupIndexes()
+
+			// Time passes, in-memory cached copy expires.
+			tc.Add(2 * time.Minute)
+
+			newVersions, err := ProjectVersions(ctx)
+			So(err, ShouldBeNil)
+			So(newVersions["a"], ShouldResemble, versions["a"])
+			So(newVersions["b"].Revision, ShouldNotEqual, versions["b"].Revision)
+			So(newVersions["b"].FetchTime, ShouldEqual, fetchTime.Add(time.Hour))
+
+			// The age of the served config is reported.
+			So(configAgeGauge.Get(ctx, "a"), ShouldEqual, (time.Hour + 2*time.Minute).Seconds())
+			So(configAgeGauge.Get(ctx, "b"), ShouldEqual, (2 * time.Minute).Seconds())
+		})
+
 		Convey("Validation works", func() {
 			configs["projects/b"]["${appid}.cfg"] = `bad data`
 			err := updateProjects(ctx)
@@ -226,6 +284,43 @@ func TestProjectConfig(t *testing.T) {
 	})
 }
 
+func TestConfigDiff(t *testing.T) {
+	t.Parallel()
+
+	Convey("configDiff", t, func() {
+		Convey("empty", func() {
+			d := &configDiff{}
+			So(d.empty(), ShouldBeTrue)
+			So(d.String(), ShouldEqual, "no changes")
+		})
+		Convey("summary", func() {
+			d := &configDiff{
+				Added: []revisionChange{
+					{Project: "d", To: "rev4"},
+					{Project: "c", To: "rev3"},
+				},
+				Updated: []revisionChange{
+					{Project: "b", From: "rev1", To: "rev2"},
+				},
+				Removed: []revisionChange{
+					{Project: "a", From: "rev1"},
+				},
+			}
+			d.sort()
+			So(d.empty(), ShouldBeFalse)
+			So(d.String(), ShouldEqual, "added: c@rev3, d@rev4; updated: b@rev1->rev2; removed: a@rev1")
+		})
+		Convey("only updates", func() {
+			d := &configDiff{
+				Updated: []revisionChange{
+					{Project: "b", From: "rev1", To: "rev2"},
+				},
+			}
+			So(d.String(), ShouldEqual, "updated: b@rev1->rev2")
+		})
+	})
+}
+
 func TestProject(t *testing.T) {
 	t.Parallel()
 
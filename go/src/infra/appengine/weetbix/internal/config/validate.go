@@ -19,6 +19,35 @@ import (
 
 const maxHysteresisPercent = 1000
 
+// maxRuleHygieneDays is the maximum number of days a rule may go unmatched
+// before it is flagged or archived. This is the maximum retention period of
+// the clustered_failures table, beyond which matches cannot be observed.
+const maxRuleHygieneDays = 540
+
+// Bounds of the configurable retention period of failure data, in days.
+// Failures must be retained for at least the longest period over which
+// cluster impact is computed for bug filing, and at most for the retention
+// period of the clustered_failures table.
+const (
+	minFailureRetentionDays = 30
+	maxFailureRetentionDays = 540
+)
+
+// maxBugStatusSyncConfirmationDays is the maximum number of days the
+// cluster of a rule whose bug is fixed must have no impact before the rule
+// is archived.
+const maxBugStatusSyncConfirmationDays = 30
+
+// maxArtifactLinkIDs is the maximum number of artifact IDs whose artifacts
+// are linked to from clustered failures. Each linked artifact is stored
+// with every failure, so this bounds storage growth.
+const maxArtifactLinkIDs = 10
+
+// maxTestNameMaskingRules is the maximum number of test name masking rules
+// of a project. Every rule may be matched against the test ID of every
+// ingested failure.
+const maxTestNameMaskingRules = 20
+
 var (
 	// https://cloud.google.com/storage/docs/naming-buckets
 	bucketRE = regexp.MustCompile(`^[a-z0-9][a-z0-9\-_.]{1,220}[a-z0-9]$`)
@@ -29,6 +58,9 @@ var (
 	// https://source.chromium.org/chromium/infra/infra/+/main:luci/appengine/auth_service/proto/realms_config.proto;l=85;drc=04e290f764a293d642d287b0118e9880df4afb35
 	realmRE = regexp.MustCompile(`^[a-z0-9_\.\-/]{1,400}$`)
 
+	// https://source.chromium.org/chromium/infra/luci/luci-go/+/main:resultdb/pbutil/artifact.go
+	artifactIDRE = regexp.MustCompile(`^[[:word:]]([\p{L}\p{M}\p{N}\p{P}\p{S}\p{Zs}]{0,254})$`)
+
 	// Patterns for BigQuery table.
 	// https://cloud.google.com/resource-manager/docs/creating-managing-projects
 	cloudProjectRE = regexp.MustCompile(`^[a-z][a-z0-9\-]{4,28}[a-z0-9]$`)
@@ -36,6 +68,8 @@ var (
 	datasetRE = regexp.MustCompile(`^[a-zA-Z0-9_]*$`)
 	// https://cloud.google.com/bigquery/docs/tables#table_naming
 	tableRE = regexp.MustCompile(`^[\p{L}\p{M}\p{N}\p{Pc}\p{Pd}\p{Zs}]*$`)
+
+	maskingRuleNameRE = regexp.MustCompile(`^[a-z0-9-]{1,64}$`)
 )
 
 func validateConfig(ctx *validation.Context, cfg *Config) {
@@ -47,6 +81,45 @@ func validateConfig(ctx *validation.Context, cfg *Config) {
 	// Limit within GAE autoscaling request timeout of 10 minutes.
 	// https://cloud.google.com/appengine/docs/standard/python/how-instances-are-managed
 	validateIntegerConfig(ctx, "reclustering_interval_minutes", cfg.ReclusteringIntervalMinutes, 9)
+	// Limit to the partition expiry of the clustered_failures table.
+	validateIntegerConfig(ctx, "max_cluster_impact_range_days", cfg.MaxClusterImpactRangeDays, 540)
+	validateMonorailQuota(ctx, cfg.MonorailQuota)
+	validateMaintenanceMode(ctx, cfg.MaintenanceMode)
+	if cfg.ChunkExportGcsBucket != "" {
+		validateStringConfig(ctx, "chunk_export_gcs_bucket", cfg.ChunkExportGcsBucket, bucketRE)
+	}
+}
+
+func validateMaintenanceMode(ctx *validation.Context, m *MaintenanceMode) {
+	if m == nil {
+		// Weetbix is not in maintenance mode.
+		return
+	}
+	ctx.Enter("maintenance_mode")
+	defer ctx.Exit()
+	for i, p := range m.ReadOnlyProjects {
+		ctx.Enter("read_only_projects[%v]", i)
+		if !ProjectRe.MatchString(p) {
+			ctx.Errorf("invalid LUCI project: %q", p)
+		}
+		ctx.Exit()
+	}
+	if (m.ReadOnly || len(m.ReadOnlyProjects) > 0) && m.Message == "" {
+		ctx.Enter("message")
+		ctx.Errorf("a message is required in maintenance mode")
+		ctx.Exit()
+	}
+}
+
+func validateMonorailQuota(ctx *validation.Context, q *MonorailQuota) {
+	if q == nil {
+		// Changes to monorail are not throttled.
+		return
+	}
+	ctx.Enter("monorail_quota")
+	defer ctx.Exit()
+	validateIntegerConfig(ctx, "max_changes_per_run", q.MaxChangesPerRun, 10000)
+	validateIntegerConfig(ctx, "max_changes_per_minute", q.MaxChangesPerMinute, 10000)
 }
 
 func validateMonorailHostname(ctx *validation.Context, hostname string) {
@@ -171,6 +244,193 @@ func ValidateProjectConfig(ctx *validation.Context, cfg *ProjectConfig) {
 	for _, rCfg := range cfg.Realms {
 		validateRealmConfig(ctx, rCfg)
 	}
+	validateRuleHygiene(ctx, cfg.RuleHygiene)
+	validateBugStatusSync(ctx, cfg.BugStatusSync)
+	validateArtifactLinks(ctx, cfg.ArtifactLinks)
+	validateBuildFailures(ctx, cfg.BuildFailures)
+	validateRetention(ctx, cfg.Retention)
+	validateChunkExport(ctx, cfg.ChunkExport)
+	validateClustering(ctx, cfg.Clustering)
+}
+
+func validateClustering(ctx *validation.Context, cfg *Clustering) {
+	if cfg == nil {
+		// Test names are not masked.
+		return
+	}
+	ctx.Enter("clustering")
+	defer ctx.Exit()
+
+	if len(cfg.TestNameMaskingRules) > maxTestNameMaskingRules {
+		ctx.Enter("test_name_masking_rules")
+		ctx.Errorf("must not have more than %v entries", maxTestNameMaskingRules)
+		ctx.Exit()
+	}
+	seen := make(map[string]bool)
+	for i, r := range cfg.TestNameMaskingRules {
+		ctx.Enter("test_name_masking_rules[%v]", i)
+		switch {
+		case !maskingRuleNameRE.MatchString(r.Name):
+			ctx.Errorf("invalid name %q", r.Name)
+		case seen[r.Name]:
+			ctx.Errorf("duplicate name %q", r.Name)
+		}
+		seen[r.Name] = true
+		if re, err := regexp.Compile(r.Pattern); err != nil {
+			ctx.Errorf("invalid regular expression %q: %s", r.Pattern, err)
+		} else if re.NumSubexp() == 0 {
+			ctx.Errorf("regular expression %q has no capture groups to mask", r.Pattern)
+		}
+		ctx.Exit()
+	}
+}
+
+func validateChunkExport(ctx *validation.Context, cfg *ChunkExport) {
+	if cfg == nil {
+		// Chunks of the project are not exported.
+		return
+	}
+	ctx.Enter("chunk_export")
+	defer ctx.Exit()
+	ctx.Enter("sample_fraction")
+	defer ctx.Exit()
+
+	// Zero means the default fraction.
+	if cfg.SampleFraction < 0 || cfg.SampleFraction > 1 {
+		ctx.Errorf("value must be in (0, 1]")
+	}
+}
+
+func validateRetention(ctx *validation.Context, cfg *Retention) {
+	if cfg == nil || cfg.FailureRetentionDays == 0 {
+		// The default retention period applies.
+		return
+	}
+	ctx.Enter("retention")
+	defer ctx.Exit()
+	ctx.Enter("failure_retention_days")
+	defer ctx.Exit()
+
+	if cfg.FailureRetentionDays < minFailureRetentionDays {
+		ctx.Errorf("value must be at least %v", minFailureRetentionDays)
+	}
+	if cfg.FailureRetentionDays > maxFailureRetentionDays {
+		ctx.Errorf("value must not exceed %v", maxFailureRetentionDays)
+	}
+}
+
+func validateBuildFailures(ctx *validation.Context, cfg *BuildFailures) {
+	if cfg == nil {
+		// Build step failures are not ingested.
+		return
+	}
+	ctx.Enter("build_failures")
+	defer ctx.Exit()
+
+	for i, p := range cfg.TestStepPatterns {
+		ctx.Enter("test_step_patterns[%v]", i)
+		if _, err := regexp.Compile(p); err != nil {
+			ctx.Errorf("invalid regular expression %q: %s", p, err)
+		}
+		ctx.Exit()
+	}
+	if cfg.BugFilingThreshold != nil {
+		validateImpactThreshold(ctx, cfg.BugFilingThreshold, "bug_filing_threshold")
+	}
+}
+
+func validateRuleHygiene(ctx *validation.Context, cfg *RuleHygiene) {
+	if cfg == nil {
+		// Rules are not cleaned up.
+		return
+	}
+	ctx.Enter("rule_hygiene")
+	defer ctx.Exit()
+
+	validateRuleHygieneDays(ctx, "stale_after_days", cfg.StaleAfterDays, 1)
+	if cfg.AutoArchive {
+		validateRuleHygieneDays(ctx, "archive_after_days", cfg.ArchiveAfterDays, cfg.StaleAfterDays+1)
+	} else if cfg.ArchiveAfterDays != 0 {
+		ctx.Enter("archive_after_days")
+		ctx.Errorf("must not be set unless auto_archive is set")
+		ctx.Exit()
+	}
+}
+
+func validateBugStatusSync(ctx *validation.Context, cfg *BugStatusSync) {
+	if cfg == nil {
+		// Rules are not synchronised with the status of their bugs.
+		return
+	}
+	ctx.Enter("bug_status_sync")
+	defer ctx.Exit()
+
+	ctx.Enter("confirmation_days")
+	if cfg.ConfirmationDays < 1 {
+		ctx.Errorf("value must be at least 1")
+	}
+	if cfg.ConfirmationDays > maxBugStatusSyncConfirmationDays {
+		ctx.Errorf("value must not exceed %v", maxBugStatusSyncConfirmationDays)
+	}
+	ctx.Exit()
+
+	validateImpactThreshold(ctx, cfg.RecurrenceThreshold, "recurrence_threshold")
+	if t := cfg.RecurrenceThreshold; t != nil {
+		// Three and seven day impact includes failures from before the fix.
+		ctx.Enter("recurrence_threshold")
+		for _, m := range []*MetricThreshold{t.TestResultsFailed, t.TestRunsFailed, t.PresubmitRunsFailed} {
+			if m.GetThreeDay() != 0 || m.GetSevenDay() != 0 {
+				ctx.Errorf("only one_day thresholds may be set")
+				break
+			}
+		}
+		ctx.Exit()
+	}
+
+	ctx.Enter("recurrence_action")
+	if _, ok := BugStatusSync_RecurrenceAction_name[int32(cfg.RecurrenceAction)]; !ok {
+		ctx.Errorf("unknown recurrence action %v", cfg.RecurrenceAction)
+	}
+	ctx.Exit()
+}
+
+func validateArtifactLinks(ctx *validation.Context, cfg *ArtifactLinks) {
+	if cfg == nil {
+		// No artifacts are linked.
+		return
+	}
+	ctx.Enter("artifact_links")
+	defer ctx.Exit()
+
+	if len(cfg.ArtifactIds) > maxArtifactLinkIDs {
+		ctx.Enter("artifact_ids")
+		ctx.Errorf("must not have more than %v entries", maxArtifactLinkIDs)
+		ctx.Exit()
+	}
+	seen := make(map[string]bool)
+	for i, id := range cfg.ArtifactIds {
+		ctx.Enter("artifact_ids[%v]", i)
+		switch {
+		case !artifactIDRE.MatchString(id):
+			ctx.Errorf("invalid artifact ID %q", id)
+		case seen[id]:
+			ctx.Errorf("duplicate artifact ID %q", id)
+		}
+		seen[id] = true
+		ctx.Exit()
+	}
+}
+
+func validateRuleHygieneDays(ctx *validation.Context, fieldName string, value, min int64) {
+	ctx.Enter(fieldName)
+	defer ctx.Exit()
+
+	if value < min {
+		ctx.Errorf("value must be at least %v", min)
+	}
+	if value > maxRuleHygieneDays {
+		ctx.Errorf("value must not exceed %v", maxRuleHygieneDays)
+	}
 }
 
 func validateMonorail(ctx *validation.Context, cfg *MonorailProject, bugFilingThres *ImpactThreshold) {
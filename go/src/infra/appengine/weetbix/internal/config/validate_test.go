@@ -6,6 +6,7 @@ package config
 
 import (
 	"context"
+	"fmt"
 	"io/ioutil"
 	"testing"
 	"time"
@@ -24,6 +25,11 @@ var sampleConfigStr = `
 	chunk_gcs_bucket: "my-chunk-bucket"
 	reclustering_workers: 50
 	reclustering_interval_minutes: 5
+	max_cluster_impact_range_days: 90
+	monorail_quota {
+		max_changes_per_run: 50
+		max_changes_per_minute: 20
+	}
 `
 
 // createConfig returns a new valid Config for testing.
@@ -80,6 +86,17 @@ func TestServiceConfigValidator(t *testing.T) {
 			So(validate(cfg), ShouldErrLike, `invalid chunk_gcs_bucket: "my bucket"`)
 		})
 	})
+	Convey("chunk export GCS bucket", t, func() {
+		cfg := createConfig()
+		Convey("may be unset", func() {
+			cfg.ChunkExportGcsBucket = ""
+			So(validate(cfg), ShouldBeNil)
+		})
+		Convey("must be correctly formed", func() {
+			cfg.ChunkExportGcsBucket = "my bucket"
+			So(validate(cfg), ShouldErrLike, `invalid chunk_export_gcs_bucket: "my bucket"`)
+		})
+	})
 	Convey("reclustering workers", t, func() {
 		cfg := createConfig()
 		Convey("less than zero", func() {
@@ -102,6 +119,66 @@ func TestServiceConfigValidator(t *testing.T) {
 			So(validate(cfg), ShouldErrLike, `value is greater than 9`)
 		})
 	})
+	Convey("max cluster impact range", t, func() {
+		cfg := createConfig()
+		Convey("unset", func() {
+			cfg.MaxClusterImpactRangeDays = 0
+			So(validate(cfg), ShouldBeNil)
+		})
+		Convey("less than zero", func() {
+			cfg.MaxClusterImpactRangeDays = -1
+			So(validate(cfg), ShouldErrLike, `value is less than zero`)
+		})
+		Convey("too large", func() {
+			cfg.MaxClusterImpactRangeDays = 541
+			So(validate(cfg), ShouldErrLike, `value is greater than 540`)
+		})
+	})
+	Convey("monorail quota", t, func() {
+		cfg := createConfig()
+		Convey("unset", func() {
+			cfg.MonorailQuota = nil
+			So(validate(cfg), ShouldBeNil)
+		})
+		Convey("unlimited", func() {
+			cfg.MonorailQuota = &MonorailQuota{}
+			So(validate(cfg), ShouldBeNil)
+		})
+		Convey("changes per run less than zero", func() {
+			cfg.MonorailQuota.MaxChangesPerRun = -1
+			So(validate(cfg), ShouldErrLike, `(monorail_quota / max_changes_per_run): value is less than zero`)
+		})
+		Convey("changes per minute too large", func() {
+			cfg.MonorailQuota.MaxChangesPerMinute = 10001
+			So(validate(cfg), ShouldErrLike, `(monorail_quota / max_changes_per_minute): value is greater than 10000`)
+		})
+	})
+	Convey("maintenance mode", t, func() {
+		cfg := createConfig()
+		cfg.MaintenanceMode = &MaintenanceMode{
+			ReadOnlyProjects: []string{"chromium"},
+			Message:          "Database maintenance.",
+		}
+		Convey("valid", func() {
+			So(validate(cfg), ShouldBeNil)
+		})
+		Convey("unset", func() {
+			cfg.MaintenanceMode = nil
+			So(validate(cfg), ShouldBeNil)
+		})
+		Convey("invalid project", func() {
+			cfg.MaintenanceMode.ReadOnlyProjects = append(cfg.MaintenanceMode.ReadOnlyProjects, "Chromium")
+			So(validate(cfg), ShouldErrLike, `(maintenance_mode / read_only_projects[1]): invalid LUCI project: "Chromium"`)
+		})
+		Convey("message required", func() {
+			cfg.MaintenanceMode.Message = ""
+			So(validate(cfg), ShouldErrLike, `(maintenance_mode / message): a message is required in maintenance mode`)
+		})
+		Convey("message optional when not in maintenance", func() {
+			cfg.MaintenanceMode = &MaintenanceMode{}
+			So(validate(cfg), ShouldBeNil)
+		})
+	})
 }
 
 func TestProjectConfigValidator(t *testing.T) {
@@ -402,4 +479,245 @@ func TestProjectConfigValidator(t *testing.T) {
 			})
 		})
 	})
+
+	Convey("rule hygiene", t, func() {
+		cfg := createProjectConfig()
+		hygiene := cfg.RuleHygiene
+
+		Convey("may be unset", func() {
+			cfg.RuleHygiene = nil
+			So(validate(cfg), ShouldBeNil)
+		})
+		Convey("stale after days", func() {
+			Convey("must be specified", func() {
+				hygiene.StaleAfterDays = 0
+				So(validate(cfg), ShouldErrLike, "(rule_hygiene / stale_after_days): value must be at least 1")
+			})
+			Convey("must not exceed retention", func() {
+				hygiene.StaleAfterDays = 541
+				hygiene.AutoArchive = false
+				hygiene.ArchiveAfterDays = 0
+				So(validate(cfg), ShouldErrLike, "(rule_hygiene / stale_after_days): value must not exceed 540")
+			})
+		})
+		Convey("archive after days", func() {
+			Convey("must be specified with auto archive", func() {
+				hygiene.ArchiveAfterDays = 0
+				So(validate(cfg), ShouldErrLike, "(rule_hygiene / archive_after_days): value must be at least 31")
+			})
+			Convey("must be greater than stale after days", func() {
+				hygiene.ArchiveAfterDays = 30
+				So(validate(cfg), ShouldErrLike, "(rule_hygiene / archive_after_days): value must be at least 31")
+			})
+			Convey("must not exceed retention", func() {
+				hygiene.ArchiveAfterDays = 541
+				So(validate(cfg), ShouldErrLike, "(rule_hygiene / archive_after_days): value must not exceed 540")
+			})
+			Convey("must not be set without auto archive", func() {
+				hygiene.AutoArchive = false
+				So(validate(cfg), ShouldErrLike, "(rule_hygiene / archive_after_days): must not be set unless auto_archive is set")
+			})
+			Convey("may be unset without auto archive", func() {
+				hygiene.AutoArchive = false
+				hygiene.ArchiveAfterDays = 0
+				So(validate(cfg), ShouldBeNil)
+			})
+		})
+	})
+
+	Convey("bug status sync", t, func() {
+		cfg := createProjectConfig()
+		sync := cfg.BugStatusSync
+
+		Convey("may be unset", func() {
+			cfg.BugStatusSync = nil
+			So(validate(cfg), ShouldBeNil)
+		})
+		Convey("valid", func() {
+			So(validate(cfg), ShouldBeNil)
+		})
+		Convey("confirmation days", func() {
+			Convey("must be specified", func() {
+				sync.ConfirmationDays = 0
+				So(validate(cfg), ShouldErrLike, "(bug_status_sync / confirmation_days): value must be at least 1")
+			})
+			Convey("must not exceed maximum", func() {
+				sync.ConfirmationDays = 31
+				So(validate(cfg), ShouldErrLike, "(bug_status_sync / confirmation_days): value must not exceed 30")
+			})
+		})
+		Convey("recurrence threshold", func() {
+			Convey("must be specified", func() {
+				sync.RecurrenceThreshold = nil
+				So(validate(cfg), ShouldErrLike, "(bug_status_sync / recurrence_threshold): impact thresolds must be specified")
+			})
+			Convey("must be non-negative", func() {
+				sync.RecurrenceThreshold.TestResultsFailed.OneDay = proto.Int64(-1)
+				So(validate(cfg), ShouldErrLike, "(bug_status_sync / recurrence_threshold / test_results_failed / one_day): value must be non-negative")
+			})
+			Convey("must only have one day thresholds", func() {
+				sync.RecurrenceThreshold.PresubmitRunsFailed = &MetricThreshold{SevenDay: proto.Int64(1)}
+				So(validate(cfg), ShouldErrLike, "(bug_status_sync / recurrence_threshold): only one_day thresholds may be set")
+			})
+		})
+		Convey("recurrence action", func() {
+			Convey("may be unspecified", func() {
+				sync.RecurrenceAction = BugStatusSync_RECURRENCE_ACTION_UNSPECIFIED
+				So(validate(cfg), ShouldBeNil)
+			})
+			Convey("must be known", func() {
+				sync.RecurrenceAction = 100
+				So(validate(cfg), ShouldErrLike, "(bug_status_sync / recurrence_action): unknown recurrence action 100")
+			})
+		})
+	})
+
+	Convey("artifact links", t, func() {
+		cfg := createProjectConfig()
+		cfg.ArtifactLinks = &ArtifactLinks{
+			ArtifactIds: []string{"snippet", "stack_trace"},
+		}
+		Convey("may be unset", func() {
+			cfg.ArtifactLinks = nil
+			So(validate(cfg), ShouldBeNil)
+		})
+		Convey("valid", func() {
+			So(validate(cfg), ShouldBeNil)
+		})
+		Convey("too many artifact IDs", func() {
+			cfg.ArtifactLinks.ArtifactIds = nil
+			for i := 0; i < 11; i++ {
+				cfg.ArtifactLinks.ArtifactIds = append(cfg.ArtifactLinks.ArtifactIds, fmt.Sprintf("artifact%v", i))
+			}
+			So(validate(cfg), ShouldErrLike, "(artifact_links / artifact_ids): must not have more than 10 entries")
+		})
+		Convey("invalid artifact ID", func() {
+			cfg.ArtifactLinks.ArtifactIds[1] = ""
+			So(validate(cfg), ShouldErrLike, `(artifact_links / artifact_ids[1]): invalid artifact ID ""`)
+		})
+		Convey("duplicate artifact ID", func() {
+			cfg.ArtifactLinks.ArtifactIds[1] = "snippet"
+			So(validate(cfg), ShouldErrLike, `(artifact_links / artifact_ids[1]): duplicate artifact ID "snippet"`)
+		})
+	})
+
+	Convey("build failures", t, func() {
+		cfg := createProjectConfig()
+		cfg.BuildFailures = &BuildFailures{
+			TestStepPatterns: []string{`.*_tests \(with patch\)`},
+			BugFilingThreshold: &ImpactThreshold{
+				TestRunsFailed: &MetricThreshold{OneDay: proto.Int64(10)},
+			},
+		}
+		Convey("may be unset", func() {
+			cfg.BuildFailures = nil
+			So(validate(cfg), ShouldBeNil)
+		})
+		Convey("valid", func() {
+			So(validate(cfg), ShouldBeNil)
+		})
+		Convey("bug filing threshold may be unset", func() {
+			cfg.BuildFailures.BugFilingThreshold = nil
+			So(validate(cfg), ShouldBeNil)
+		})
+		Convey("invalid test step pattern", func() {
+			cfg.BuildFailures.TestStepPatterns = append(cfg.BuildFailures.TestStepPatterns, "(")
+			So(validate(cfg), ShouldErrLike, `(build_failures / test_step_patterns[1]): invalid regular expression "("`)
+		})
+		Convey("invalid bug filing threshold", func() {
+			cfg.BuildFailures.BugFilingThreshold.TestRunsFailed.OneDay = proto.Int64(-1)
+			So(validate(cfg), ShouldErrLike, "(build_failures / bug_filing_threshold / test_runs_failed / one_day): value must be non-negative")
+		})
+	})
+
+	Convey("retention", t, func() {
+		cfg := createProjectConfig()
+		cfg.Retention = &Retention{FailureRetentionDays: 180}
+		Convey("may be unset", func() {
+			cfg.Retention = nil
+			So(validate(cfg), ShouldBeNil)
+		})
+		Convey("valid", func() {
+			So(validate(cfg), ShouldBeNil)
+		})
+		Convey("failure retention days may be unset", func() {
+			cfg.Retention.FailureRetentionDays = 0
+			So(validate(cfg), ShouldBeNil)
+		})
+		Convey("failure retention days too short", func() {
+			cfg.Retention.FailureRetentionDays = 29
+			So(validate(cfg), ShouldErrLike, "(retention / failure_retention_days): value must be at least 30")
+		})
+		Convey("failure retention days too long", func() {
+			cfg.Retention.FailureRetentionDays = 541
+			So(validate(cfg), ShouldErrLike, "(retention / failure_retention_days): value must not exceed 540")
+		})
+	})
+
+	Convey("chunk export", t, func() {
+		cfg := createProjectConfig()
+		cfg.ChunkExport = &ChunkExport{Enabled: true, SampleFraction: 0.05}
+		Convey("may be unset", func() {
+			cfg.ChunkExport = nil
+			So(validate(cfg), ShouldBeNil)
+		})
+		Convey("valid", func() {
+			So(validate(cfg), ShouldBeNil)
+		})
+		Convey("sample fraction may be unset", func() {
+			cfg.ChunkExport.SampleFraction = 0
+			So(validate(cfg), ShouldBeNil)
+		})
+		Convey("sample fraction too large", func() {
+			cfg.ChunkExport.SampleFraction = 1.5
+			So(validate(cfg), ShouldErrLike, "(chunk_export / sample_fraction): value must be in (0, 1]")
+		})
+		Convey("negative sample fraction", func() {
+			cfg.ChunkExport.SampleFraction = -0.1
+			So(validate(cfg), ShouldErrLike, "(chunk_export / sample_fraction): value must be in (0, 1]")
+		})
+	})
+
+	Convey("clustering", t, func() {
+		cfg := createProjectConfig()
+		cfg.Clustering = &Clustering{
+			TestNameMaskingRules: []*TestNameMaskingRule{
+				{Name: "webtest-virtual-suite", Pattern: `^ninja://:blink_web_tests/(virtual/[^/]+/)`},
+				{Name: "gtest-parameter-index", Pattern: `^ninja://.*/(\d+)$`},
+			},
+		}
+		Convey("may be unset", func() {
+			cfg.Clustering = nil
+			So(validate(cfg), ShouldBeNil)
+		})
+		Convey("valid", func() {
+			So(validate(cfg), ShouldBeNil)
+		})
+		Convey("too many masking rules", func() {
+			cfg.Clustering.TestNameMaskingRules = nil
+			for i := 0; i < 21; i++ {
+				cfg.Clustering.TestNameMaskingRules = append(cfg.Clustering.TestNameMaskingRules, &TestNameMaskingRule{
+					Name:    fmt.Sprintf("rule-%v", i),
+					Pattern: `^(.*)$`,
+				})
+			}
+			So(validate(cfg), ShouldErrLike, "(clustering / test_name_masking_rules): must not have more than 20 entries")
+		})
+		Convey("invalid masking rule name", func() {
+			cfg.Clustering.TestNameMaskingRules[1].Name = "Index"
+			So(validate(cfg), ShouldErrLike, `(clustering / test_name_masking_rules[1]): invalid name "Index"`)
+		})
+		Convey("duplicate masking rule name", func() {
+			cfg.Clustering.TestNameMaskingRules[1].Name = "webtest-virtual-suite"
+			So(validate(cfg), ShouldErrLike, `(clustering / test_name_masking_rules[1]): duplicate name "webtest-virtual-suite"`)
+		})
+		Convey("invalid masking rule pattern", func() {
+			cfg.Clustering.TestNameMaskingRules[1].Pattern = "(("
+			So(validate(cfg), ShouldErrLike, `(clustering / test_name_masking_rules[1]): invalid regular expression "(("`)
+		})
+		Convey("masking rule pattern without capture groups", func() {
+			cfg.Clustering.TestNameMaskingRules[1].Pattern = `^ninja://.*/\d+$`
+			So(validate(cfg), ShouldErrLike, `(clustering / test_name_masking_rules[1]): regular expression "^ninja://.*/\\d+$" has no capture groups to mask`)
+		})
+	})
 }
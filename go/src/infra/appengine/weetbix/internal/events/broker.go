@@ -0,0 +1,202 @@
+// Copyright 2021 The Chromium Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+// Package events implements a lightweight in-process publish/subscribe
+// broker, used to push progress events to Weetbix UI clients.
+//
+// Events are only delivered to subscribers in the same process as the
+// publisher. Delivery across instances of the service is not supported;
+// clients must be prepared not to receive events published by other
+// instances and fall back to polling.
+package events
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.chromium.org/luci/common/clock"
+)
+
+// DefaultBufferSize is the default number of events buffered for each
+// subscription.
+const DefaultBufferSize = 16
+
+// DefaultRetention is the default time the final event of a topic is kept
+// for late subscribers.
+const DefaultRetention = 5 * time.Minute
+
+// Event is an event published on a topic.
+type Event struct {
+	// Topic is the topic the event was published on.
+	Topic string
+	// Type is the type of the event, e.g. "reclusteringProgress".
+	Type string
+	// Data is the payload of the event. It must be serialisable to JSON.
+	Data interface{}
+}
+
+// finalEvent is the final event published on a topic.
+type finalEvent struct {
+	event     Event
+	expiresAt time.Time
+}
+
+// Broker delivers events published on topics to the subscribers of those
+// topics. A Broker is safe for concurrent use.
+type Broker struct {
+	// BufferSize is the number of events buffered for each subscription.
+	// Subscriptions that fall further behind are dropped.
+	BufferSize int
+	// Retention is the time the final event of a topic is kept, to be
+	// delivered to subscribers who subscribe after it was published.
+	Retention time.Duration
+
+	mu     sync.Mutex
+	subs   map[string]map[*Subscription]struct{}
+	finals map[string]finalEvent
+}
+
+// NewBroker creates a new broker with the default buffer size and
+// retention.
+func NewBroker() *Broker {
+	return &Broker{
+		BufferSize: DefaultBufferSize,
+		Retention:  DefaultRetention,
+	}
+}
+
+// Subscription receives the events published on a set of topics.
+type Subscription struct {
+	broker  *Broker
+	topics  []string
+	events  chan Event
+	dropped chan struct{}
+	once    sync.Once
+}
+
+// Events returns the channel on which events are delivered.
+func (s *Subscription) Events() <-chan Event {
+	return s.events
+}
+
+// Dropped returns a channel which is closed if the subscription was
+// dropped because its buffer overflowed. No further events are delivered
+// to a dropped subscription.
+func (s *Subscription) Dropped() <-chan struct{} {
+	return s.dropped
+}
+
+// Close unsubscribes from all topics. It is safe to call Close more than
+// once.
+func (s *Subscription) Close() {
+	s.broker.mu.Lock()
+	defer s.broker.mu.Unlock()
+	s.broker.removeLocked(s)
+}
+
+// Subscribe subscribes to the given topics. Final events still retained for
+// the topics are delivered immediately. The caller must Close the
+// subscription when done.
+func (b *Broker) Subscribe(ctx context.Context, topics ...string) *Subscription {
+	size := b.BufferSize
+	if size <= 0 {
+		size = DefaultBufferSize
+	}
+	s := &Subscription{
+		broker:  b,
+		topics:  topics,
+		events:  make(chan Event, size),
+		dropped: make(chan struct{}),
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.expireLocked(ctx)
+	if b.subs == nil {
+		b.subs = make(map[string]map[*Subscription]struct{})
+	}
+	for _, t := range topics {
+		if b.subs[t] == nil {
+			b.subs[t] = make(map[*Subscription]struct{})
+		}
+		b.subs[t][s] = struct{}{}
+		if f, ok := b.finals[t]; ok {
+			b.sendLocked(s, f.event)
+		}
+	}
+	return s
+}
+
+// Publish publishes an event on its topic.
+func (b *Broker) Publish(ctx context.Context, e Event) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.expireLocked(ctx)
+	for s := range b.subs[e.Topic] {
+		b.sendLocked(s, e)
+	}
+}
+
+// PublishFinal publishes the last event of a topic, e.g. the completion of
+// a query. The event is retained, so that it is also delivered to
+// subscribers who subscribe shortly after it was published.
+func (b *Broker) PublishFinal(ctx context.Context, e Event) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.expireLocked(ctx)
+	retention := b.Retention
+	if retention <= 0 {
+		retention = DefaultRetention
+	}
+	if b.finals == nil {
+		b.finals = make(map[string]finalEvent)
+	}
+	b.finals[e.Topic] = finalEvent{event: e, expiresAt: clock.Now(ctx).Add(retention)}
+	for s := range b.subs[e.Topic] {
+		b.sendLocked(s, e)
+	}
+}
+
+// Topics returns the topics which currently have subscribers.
+func (b *Broker) Topics() []string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	topics := make([]string, 0, len(b.subs))
+	for t := range b.subs {
+		topics = append(topics, t)
+	}
+	return topics
+}
+
+// sendLocked sends the event to the subscription without blocking,
+// dropping the subscription if its buffer is full.
+func (b *Broker) sendLocked(s *Subscription, e Event) {
+	select {
+	case s.events <- e:
+	default:
+		b.removeLocked(s)
+		s.once.Do(func() { close(s.dropped) })
+	}
+}
+
+// removeLocked removes the subscription from all its topics.
+func (b *Broker) removeLocked(s *Subscription) {
+	for _, t := range s.topics {
+		delete(b.subs[t], s)
+		if len(b.subs[t]) == 0 {
+			delete(b.subs, t)
+		}
+	}
+}
+
+// expireLocked deletes the final events past their retention.
+func (b *Broker) expireLocked(ctx context.Context) {
+	now := clock.Now(ctx)
+	for t, f := range b.finals {
+		if now.After(f.expiresAt) {
+			delete(b.finals, t)
+		}
+	}
+}
@@ -0,0 +1,112 @@
+// Copyright 2021 The Chromium Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package events
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"go.chromium.org/luci/common/clock/testclock"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestBroker(t *testing.T) {
+	Convey(`Broker`, t, func() {
+		ctx, tc := testclock.UseTime(context.Background(), testclock.TestRecentTimeUTC)
+		b := NewBroker()
+		b.BufferSize = 2
+
+		received := func(s *Subscription) []Event {
+			var result []Event
+			for {
+				select {
+				case e := <-s.Events():
+					result = append(result, e)
+				default:
+					return result
+				}
+			}
+		}
+
+		Convey(`Delivers events by topic`, func() {
+			s1 := b.Subscribe(ctx, "a", "b")
+			defer s1.Close()
+			s2 := b.Subscribe(ctx, "b")
+			defer s2.Close()
+			So(b.Topics(), ShouldHaveLength, 2)
+
+			b.Publish(ctx, Event{Topic: "a", Type: "t", Data: 1})
+			b.Publish(ctx, Event{Topic: "b", Type: "t", Data: 2})
+			b.Publish(ctx, Event{Topic: "c", Type: "t", Data: 3})
+
+			So(received(s1), ShouldResemble, []Event{
+				{Topic: "a", Type: "t", Data: 1},
+				{Topic: "b", Type: "t", Data: 2},
+			})
+			So(received(s2), ShouldResemble, []Event{
+				{Topic: "b", Type: "t", Data: 2},
+			})
+		})
+		Convey(`Close unsubscribes`, func() {
+			s := b.Subscribe(ctx, "a")
+			s.Close()
+			s.Close()
+			So(b.Topics(), ShouldBeEmpty)
+
+			b.Publish(ctx, Event{Topic: "a", Data: 1})
+			So(received(s), ShouldBeEmpty)
+		})
+		Convey(`Drops slow subscriptions`, func() {
+			slow := b.Subscribe(ctx, "a")
+			defer slow.Close()
+			for i := 0; i < 3; i++ {
+				b.Publish(ctx, Event{Topic: "a", Data: i})
+			}
+			So(slow.Dropped(), shouldBeClosed)
+			So(received(slow), ShouldHaveLength, 2)
+			So(b.Topics(), ShouldBeEmpty)
+
+			// Other subscriptions are not affected.
+			s := b.Subscribe(ctx, "a")
+			defer s.Close()
+			b.Publish(ctx, Event{Topic: "a", Data: 3})
+			So(received(s), ShouldResemble, []Event{{Topic: "a", Data: 3}})
+			So(s.Dropped(), shouldNotBeClosed)
+		})
+		Convey(`Retains final events`, func() {
+			final := Event{Topic: "a", Type: "done", Data: "result"}
+			b.PublishFinal(ctx, final)
+
+			s := b.Subscribe(ctx, "a")
+			defer s.Close()
+			So(received(s), ShouldResemble, []Event{final})
+
+			tc.Add(DefaultRetention + time.Second)
+			late := b.Subscribe(ctx, "a")
+			defer late.Close()
+			So(received(late), ShouldBeEmpty)
+		})
+	})
+}
+
+// shouldBeClosed asserts that a channel is closed.
+func shouldBeClosed(actual interface{}, expected ...interface{}) string {
+	select {
+	case <-actual.(<-chan struct{}):
+		return ""
+	default:
+		return "expected the channel to be closed"
+	}
+}
+
+// shouldNotBeClosed asserts that a channel is not closed.
+func shouldNotBeClosed(actual interface{}, expected ...interface{}) string {
+	if shouldBeClosed(actual) == "" {
+		return "expected the channel not to be closed"
+	}
+	return ""
+}
@@ -0,0 +1,75 @@
+// Copyright 2021 The Chromium Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package maintenance
+
+import (
+	"context"
+
+	"go.chromium.org/luci/common/logging"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"infra/appengine/weetbix/internal/acl"
+)
+
+// exemptMethods are mutating methods which may be called in maintenance
+// mode.
+var exemptMethods = map[string]bool{
+	// Used to end maintenance mode.
+	"/weetbix.internal.admin.Admin/SetMaintenanceMode": true,
+}
+
+// UnaryServerInterceptor is a grpc.UnaryServerInterceptor that refuses
+// mutating methods in maintenance mode.
+//
+// Methods are classified as read-only or mutating by acl.ClassifyMethod.
+// Mutating methods of requests with a project field are refused if the
+// project is in maintenance mode, other mutating methods if all of Weetbix
+// is in maintenance mode.
+func UnaryServerInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if err := CheckMethod(ctx, info.FullMethod, req); err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+// CheckMethod checks whether the method may be called with the request in
+// the current maintenance mode.
+//
+// Returns an Unavailable gRPC error with the maintenance message if not.
+func CheckMethod(ctx context.Context, fullMethod string, req interface{}) error {
+	if exemptMethods[fullMethod] || acl.ClassifyMethod(fullMethod) == acl.ReadOnly {
+		return nil
+	}
+	m, err := Get(ctx, requestProject(req))
+	if err != nil {
+		// Do not make Weetbix unavailable because the maintenance mode
+		// cannot be read.
+		logging.Errorf(ctx, "Reading maintenance mode: %s", err)
+		return nil
+	}
+	if m.ReadOnly {
+		return status.Errorf(codes.Unavailable, "Weetbix is read-only for maintenance: %s", m.Message)
+	}
+	return nil
+}
+
+// requestProject returns the value of the project field of the request, or
+// "" if the request has no such field.
+func requestProject(req interface{}) string {
+	msg, ok := req.(proto.Message)
+	if !ok {
+		return ""
+	}
+	r := msg.ProtoReflect()
+	fd := r.Descriptor().Fields().ByName("project")
+	if fd == nil || fd.Kind() != protoreflect.StringKind || fd.IsList() {
+		return ""
+	}
+	return r.Get(fd).String()
+}
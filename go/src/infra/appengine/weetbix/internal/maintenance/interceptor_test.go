@@ -0,0 +1,92 @@
+// Copyright 2021 The Chromium Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package maintenance
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"go.chromium.org/luci/common/clock/testclock"
+	"go.chromium.org/luci/gae/impl/memory"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+
+	adminpb "infra/appengine/weetbix/internal/admin/proto"
+	"infra/appengine/weetbix/internal/config"
+
+	. "github.com/smartystreets/goconvey/convey"
+	. "go.chromium.org/luci/common/testing/assertions"
+)
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	t.Parallel()
+
+	Convey(`UnaryServerInterceptor`, t, func() {
+		ctx := memory.Use(context.Background())
+		now := time.Date(2021, time.December, 1, 12, 0, 0, 0, time.UTC)
+		ctx, _ = testclock.UseTime(ctx, now)
+		cfg := &config.Config{
+			MaintenanceMode: &config.MaintenanceMode{
+				ReadOnlyProjects: []string{"chromium"},
+				Message:          "Database maintenance.",
+			},
+		}
+		So(config.SetTestConfig(ctx, cfg), ShouldBeNil)
+
+		call := func(method string, req interface{}) error {
+			handled := false
+			info := &grpc.UnaryServerInfo{FullMethod: "/weetbix.internal.admin.Admin/" + method}
+			_, err := UnaryServerInterceptor(ctx, req, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+				handled = true
+				return nil, nil
+			})
+			So(handled, ShouldEqual, err == nil)
+			return err
+		}
+
+		Convey(`Read-only methods are allowed`, func() {
+			cfg.MaintenanceMode.ReadOnly = true
+			So(config.SetTestConfig(ctx, cfg), ShouldBeNil)
+			So(call("ListProjectConfigVersions", &adminpb.ListProjectConfigVersionsRequest{}), ShouldBeNil)
+		})
+		Convey(`Mutating methods of projects in maintenance are refused`, func() {
+			err := call("PurgeProject", &adminpb.PurgeProjectRequest{Project: "chromium"})
+			So(err, ShouldHaveGRPCStatus, codes.Unavailable)
+			So(err, ShouldErrLike, "Weetbix is read-only for maintenance: Database maintenance.")
+		})
+		Convey(`Mutating methods of other projects are allowed`, func() {
+			So(call("PurgeProject", &adminpb.PurgeProjectRequest{Project: "chromeos"}), ShouldBeNil)
+		})
+		Convey(`Mutating methods without a project are refused in global maintenance`, func() {
+			req := &adminpb.ExportTestVariantsRequest{Realm: "chromeos:ci"}
+			So(call("ExportTestVariants", req), ShouldBeNil)
+
+			cfg.MaintenanceMode.ReadOnly = true
+			So(config.SetTestConfig(ctx, cfg), ShouldBeNil)
+			So(call("ExportTestVariants", req), ShouldHaveGRPCStatus, codes.Unavailable)
+		})
+		Convey(`Unknown methods are treated as mutating`, func() {
+			cfg.MaintenanceMode.ReadOnly = true
+			So(config.SetTestConfig(ctx, cfg), ShouldBeNil)
+			So(call("Unknown", nil), ShouldHaveGRPCStatus, codes.Unavailable)
+		})
+		Convey(`Maintenance mode can be ended in maintenance`, func() {
+			cfg.MaintenanceMode.ReadOnly = true
+			So(config.SetTestConfig(ctx, cfg), ShouldBeNil)
+			So(call("SetMaintenanceMode", &adminpb.SetMaintenanceModeRequest{}), ShouldBeNil)
+		})
+	})
+}
+
+func TestRequestProject(t *testing.T) {
+	t.Parallel()
+
+	Convey(`requestProject`, t, func() {
+		So(requestProject(&adminpb.PurgeProjectRequest{Project: "chromium"}), ShouldEqual, "chromium")
+		So(requestProject(&adminpb.ExportTestVariantsRequest{Realm: "chromium:ci"}), ShouldEqual, "")
+		So(requestProject(nil), ShouldEqual, "")
+	})
+}
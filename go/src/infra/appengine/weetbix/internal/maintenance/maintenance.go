@@ -0,0 +1,221 @@
+// Copyright 2021 The Chromium Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+// Package maintenance implements the read-only maintenance mode of Weetbix,
+// used e.g. during Spanner maintenance or to contain a bad deployment.
+//
+// Maintenance mode applies to all of Weetbix or to individual LUCI
+// projects. It is set in the service config, and can be overridden for a
+// limited time through the Admin service. In maintenance mode, mutating
+// RPCs are refused with the maintenance message, and task queue tasks are
+// deferred until maintenance mode ends.
+package maintenance
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"go.chromium.org/luci/common/clock"
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/gae/service/datastore"
+	"go.chromium.org/luci/gae/service/info"
+	"go.chromium.org/luci/server/caching"
+
+	"infra/appengine/weetbix/internal/config"
+)
+
+// overrideKind is the datastore kind of maintenance mode overrides.
+const overrideKind = "weetbix.MaintenanceOverride"
+
+// globalID is the ID of the override which applies to all of Weetbix.
+const globalID = "*"
+
+// cacheDuration is how long maintenance mode overrides are cached for.
+// It bounds the time for an override to take effect on all instances.
+const cacheDuration = 10 * time.Second
+
+var overridesCacheSlot = caching.RegisterCacheSlot()
+
+// Mode is the maintenance mode of Weetbix, or of a LUCI project.
+type Mode struct {
+	// ReadOnly is whether mutating operations are refused.
+	ReadOnly bool
+	// Message explains the maintenance to users.
+	Message string
+	// ExpireTime is the time the override setting the mode expires.
+	// Zero if the mode is set by the service config.
+	ExpireTime time.Time
+}
+
+// override is a maintenance mode set through the Admin service, which takes
+// precedence over the service config until it expires.
+type override struct {
+	_extra datastore.PropertyMap `gae:"-,extra"`
+	_kind  string                `gae:"$kind,weetbix.MaintenanceOverride"`
+
+	// ID is the LUCI project the override applies to, or globalID.
+	ID         string    `gae:"$id"`
+	ReadOnly   bool      `gae:",noindex"`
+	Message    string    `gae:",noindex"`
+	ExpireTime time.Time `gae:",noindex"`
+	// SetBy is the identity of the admin who set the override.
+	SetBy string `gae:",noindex"`
+}
+
+func (o *override) mode() Mode {
+	return Mode{
+		ReadOnly:   o.ReadOnly,
+		Message:    o.Message,
+		ExpireTime: o.ExpireTime,
+	}
+}
+
+// Get returns the maintenance mode of the LUCI project, which is read-only
+// if either all of Weetbix or the project is in maintenance mode.
+// If project is empty, Get returns the maintenance mode of all of Weetbix.
+func Get(ctx context.Context, project string) (Mode, error) {
+	cfg, err := config.Get(ctx)
+	if err != nil {
+		return Mode{}, errors.Annotate(err, "get config").Err()
+	}
+	overrides, err := cachedOverrides(ctx)
+	if err != nil {
+		return Mode{}, err
+	}
+	return resolve(cfg.MaintenanceMode, overrides, clock.Now(ctx), project), nil
+}
+
+// resolve returns the maintenance mode of the LUCI project at time now.
+func resolve(cfg *config.MaintenanceMode, overrides map[string]*override, now time.Time, project string) Mode {
+	global := Mode{ReadOnly: cfg.GetReadOnly(), Message: cfg.GetMessage()}
+	if o, ok := overrides[globalID]; ok && now.Before(o.ExpireTime) {
+		global = o.mode()
+	}
+	if global.ReadOnly || project == "" {
+		return global
+	}
+
+	var m Mode
+	for _, p := range cfg.GetReadOnlyProjects() {
+		if p == project {
+			m = Mode{ReadOnly: true, Message: cfg.GetMessage()}
+		}
+	}
+	if o, ok := overrides[project]; ok && now.Before(o.ExpireTime) {
+		m = o.mode()
+	}
+	return m
+}
+
+// Banner returns the maintenance notice to display in the UI, or "" if
+// no part of Weetbix is in maintenance mode.
+func Banner(ctx context.Context) (string, error) {
+	cfg, err := config.Get(ctx)
+	if err != nil {
+		return "", errors.Annotate(err, "get config").Err()
+	}
+	overrides, err := cachedOverrides(ctx)
+	if err != nil {
+		return "", err
+	}
+	return banner(cfg.MaintenanceMode, overrides, clock.Now(ctx)), nil
+}
+
+func banner(cfg *config.MaintenanceMode, overrides map[string]*override, now time.Time) string {
+	if m := resolve(cfg, overrides, now, ""); m.ReadOnly {
+		return fmt.Sprintf("Weetbix is read-only for maintenance: %s", m.Message)
+	}
+
+	// Collect the projects in maintenance mode, by message.
+	projects := map[string][]string{}
+	candidates := append([]string(nil), cfg.GetReadOnlyProjects()...)
+	for id := range overrides {
+		if id != globalID {
+			candidates = append(candidates, id)
+		}
+	}
+	seen := map[string]bool{}
+	for _, p := range candidates {
+		if seen[p] {
+			continue
+		}
+		seen[p] = true
+		if m := resolve(cfg, overrides, now, p); m.ReadOnly {
+			projects[m.Message] = append(projects[m.Message], p)
+		}
+	}
+
+	var notices []string
+	for msg, ps := range projects {
+		sort.Strings(ps)
+		notices = append(notices, fmt.Sprintf("Weetbix is read-only for maintenance in %s: %s", strings.Join(ps, ", "), msg))
+	}
+	sort.Strings(notices)
+	return strings.Join(notices, " ")
+}
+
+// SetOverride sets the maintenance mode of the LUCI project, or of all of
+// Weetbix if project is empty, until the given time. setBy is the identity
+// of the admin setting the override.
+func SetOverride(ctx context.Context, project string, m Mode, setBy string) error {
+	id := project
+	if id == "" {
+		id = globalID
+	}
+	o := &override{
+		ID:         id,
+		ReadOnly:   m.ReadOnly,
+		Message:    m.Message,
+		ExpireTime: m.ExpireTime,
+		SetBy:      setBy,
+	}
+	if err := datastore.Put(cleanContext(ctx), o); err != nil {
+		return errors.Annotate(err, "put maintenance override").Err()
+	}
+	return nil
+}
+
+// cachedOverrides returns the maintenance mode overrides, by ID, from the
+// in-memory cache.
+func cachedOverrides(ctx context.Context) (map[string]*override, error) {
+	val, err := overridesCacheSlot.Fetch(ctx, func(interface{}) (val interface{}, exp time.Duration, err error) {
+		var overrides map[string]*override
+		if overrides, err = fetchOverrides(ctx); err != nil {
+			return nil, 0, err
+		}
+		return overrides, cacheDuration, nil
+	})
+	switch {
+	case err == caching.ErrNoProcessCache:
+		// A fallback useful in unit tests that may not have the process cache
+		// available.
+		return fetchOverrides(ctx)
+	case err != nil:
+		return nil, err
+	default:
+		return val.(map[string]*override), nil
+	}
+}
+
+// fetchOverrides reads the maintenance mode overrides from datastore.
+func fetchOverrides(ctx context.Context) (map[string]*override, error) {
+	var overrides []*override
+	if err := datastore.GetAll(cleanContext(ctx), datastore.NewQuery(overrideKind), &overrides); err != nil {
+		return nil, errors.Annotate(err, "fetch maintenance overrides").Err()
+	}
+	result := make(map[string]*override, len(overrides))
+	for _, o := range overrides {
+		result[o.ID] = o
+	}
+	return result, nil
+}
+
+// cleanContext returns a context with datastore using the default namespace
+// and not using transactions.
+func cleanContext(ctx context.Context) context.Context {
+	return datastore.WithoutTransaction(info.MustNamespace(ctx, ""))
+}
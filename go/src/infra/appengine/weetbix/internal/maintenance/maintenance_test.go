@@ -0,0 +1,118 @@
+// Copyright 2021 The Chromium Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package maintenance
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"go.chromium.org/luci/common/clock/testclock"
+	"go.chromium.org/luci/gae/impl/memory"
+
+	"infra/appengine/weetbix/internal/config"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestGet(t *testing.T) {
+	t.Parallel()
+
+	Convey(`Get`, t, func() {
+		ctx := memory.Use(context.Background())
+		now := time.Date(2021, time.December, 1, 12, 0, 0, 0, time.UTC)
+		ctx, tc := testclock.UseTime(ctx, now)
+		cfg := &config.Config{
+			MaintenanceMode: &config.MaintenanceMode{
+				ReadOnlyProjects: []string{"chromium"},
+				Message:          "Database maintenance.",
+			},
+		}
+		So(config.SetTestConfig(ctx, cfg), ShouldBeNil)
+		get := func(project string) Mode {
+			m, err := Get(ctx, project)
+			So(err, ShouldBeNil)
+			return m
+		}
+		dbMaintenance := Mode{ReadOnly: true, Message: "Database maintenance."}
+
+		Convey(`Service config`, func() {
+			So(get("chromium"), ShouldResemble, dbMaintenance)
+			So(get("chromeos"), ShouldResemble, Mode{})
+			So(get(""), ShouldResemble, Mode{})
+
+			cfg.MaintenanceMode.ReadOnly = true
+			So(config.SetTestConfig(ctx, cfg), ShouldBeNil)
+			So(get("chromeos"), ShouldResemble, dbMaintenance)
+			So(get(""), ShouldResemble, dbMaintenance)
+		})
+		Convey(`Overrides`, func() {
+			bad := Mode{
+				ReadOnly:   true,
+				Message:    "Bad deployment.",
+				ExpireTime: now.Add(time.Hour),
+			}
+			Convey(`Project`, func() {
+				So(SetOverride(ctx, "chromeos", bad, "user:admin@example.com"), ShouldBeNil)
+				So(get("chromeos"), ShouldResemble, bad)
+				So(get("chromium"), ShouldResemble, dbMaintenance)
+				So(get(""), ShouldResemble, Mode{})
+			})
+			Convey(`Project out of maintenance`, func() {
+				ok := Mode{ExpireTime: now.Add(time.Hour)}
+				So(SetOverride(ctx, "chromium", ok, "user:admin@example.com"), ShouldBeNil)
+				So(get("chromium"), ShouldResemble, ok)
+			})
+			Convey(`Global`, func() {
+				So(SetOverride(ctx, "", bad, "user:admin@example.com"), ShouldBeNil)
+				So(get("chromeos"), ShouldResemble, bad)
+				So(get("chromium"), ShouldResemble, bad)
+				So(get(""), ShouldResemble, bad)
+			})
+			Convey(`Expired`, func() {
+				So(SetOverride(ctx, "", bad, "user:admin@example.com"), ShouldBeNil)
+				tc.Add(time.Hour)
+				So(get("chromeos"), ShouldResemble, Mode{})
+				So(get("chromium"), ShouldResemble, dbMaintenance)
+			})
+		})
+	})
+}
+
+func TestBanner(t *testing.T) {
+	t.Parallel()
+
+	Convey(`banner`, t, func() {
+		now := time.Date(2021, time.December, 1, 12, 0, 0, 0, time.UTC)
+		cfg := &config.MaintenanceMode{}
+		overrides := map[string]*override{}
+
+		Convey(`Not in maintenance`, func() {
+			So(banner(cfg, overrides, now), ShouldEqual, "")
+		})
+		Convey(`Global`, func() {
+			cfg.ReadOnly = true
+			cfg.Message = "Database maintenance."
+			So(banner(cfg, overrides, now), ShouldEqual, "Weetbix is read-only for maintenance: Database maintenance.")
+		})
+		Convey(`Projects`, func() {
+			cfg.ReadOnlyProjects = []string{"chromium", "chromeos"}
+			cfg.Message = "Database maintenance."
+			overrides["fuchsia"] = &override{
+				ID:         "fuchsia",
+				ReadOnly:   true,
+				Message:    "Bad deployment.",
+				ExpireTime: now.Add(time.Hour),
+			}
+			overrides["chromeos"] = &override{
+				ID:         "chromeos",
+				ExpireTime: now.Add(time.Hour),
+			}
+			So(banner(cfg, overrides, now), ShouldEqual,
+				"Weetbix is read-only for maintenance in chromium: Database maintenance. "+
+					"Weetbix is read-only for maintenance in fuchsia: Bad deployment.")
+		})
+	})
+}
@@ -0,0 +1,82 @@
+// Copyright 2021 The Chromium Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package maintenance
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/common/logging"
+	"go.chromium.org/luci/common/tsmon/field"
+	"go.chromium.org/luci/common/tsmon/metric"
+	"go.chromium.org/luci/server/tq"
+	"google.golang.org/protobuf/proto"
+)
+
+// DeferDelay is how long tasks are deferred for in maintenance mode.
+const DeferDelay = 5 * time.Minute
+
+var deferredTasksCounter = metric.NewCounter(
+	"weetbix/maintenance/deferred_tasks",
+	"The number of task queue tasks deferred or skipped because of maintenance mode, by LUCI project, task class and action.",
+	nil,
+	// The LUCI Project, or "" if all of Weetbix is in maintenance mode
+	// and the project is not known.
+	field.String("project"),
+	field.String("task_class"),
+	// action can be "deferred" or "skipped".
+	field.String("action"))
+
+// DeferTask re-enqueues the task with a delay of DeferDelay if the LUCI
+// project, or all of Weetbix if project is empty, is in maintenance mode.
+// Returns whether the task was deferred, in which case the caller should
+// not process it.
+//
+// taskClass identifies the kind of task in metrics.
+func DeferTask(ctx context.Context, project, taskClass string, payload proto.Message) (deferred bool, err error) {
+	m, err := Get(ctx, project)
+	if err != nil {
+		// Process the task rather than letting it pile up.
+		logging.Errorf(ctx, "Reading maintenance mode: %s", err)
+		return false, nil
+	}
+	if !m.ReadOnly {
+		return false, nil
+	}
+
+	err = tq.AddTask(ctx, &tq.Task{
+		Title: fmt.Sprintf("deferred-%s", taskClass),
+		// Copy the task to avoid aliasing the payload of the running task.
+		Payload: proto.Clone(payload),
+		Delay:   DeferDelay,
+	})
+	if err != nil {
+		return false, errors.Annotate(err, "defer %s task", taskClass).Err()
+	}
+	deferredTasksCounter.Add(ctx, 1, project, taskClass, "deferred")
+	logging.Infof(ctx, "Deferred %s task for %s as Weetbix is read-only for maintenance: %s", taskClass, DeferDelay, m.Message)
+	return true, nil
+}
+
+// SkipTask returns whether the LUCI project is in maintenance mode, in
+// which case the caller should not process the task. It is used instead of
+// DeferTask for periodic tasks, whose work is picked up by the next run.
+//
+// taskClass identifies the kind of task in metrics.
+func SkipTask(ctx context.Context, project, taskClass string) bool {
+	m, err := Get(ctx, project)
+	if err != nil {
+		logging.Errorf(ctx, "Reading maintenance mode: %s", err)
+		return false
+	}
+	if !m.ReadOnly {
+		return false
+	}
+	deferredTasksCounter.Add(ctx, 1, project, taskClass, "skipped")
+	logging.Infof(ctx, "Skipped %s task as Weetbix is read-only for maintenance: %s", taskClass, m.Message)
+	return true
+}
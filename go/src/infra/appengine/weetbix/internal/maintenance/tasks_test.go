@@ -0,0 +1,90 @@
+// Copyright 2021 The Chromium Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package maintenance
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"go.chromium.org/luci/common/clock/testclock"
+	"go.chromium.org/luci/common/tsmon"
+	"go.chromium.org/luci/gae/impl/memory"
+	"go.chromium.org/luci/server/tq"
+
+	"infra/appengine/weetbix/internal/config"
+	"infra/appengine/weetbix/internal/tasks/taskspb"
+
+	. "github.com/smartystreets/goconvey/convey"
+	. "go.chromium.org/luci/common/testing/assertions"
+)
+
+func init() {
+	tq.RegisterTaskClass(tq.TaskClass{
+		ID:        "test-task",
+		Prototype: &taskspb.PurgeProject{},
+		Queue:     "test-queue",
+		Kind:      tq.NonTransactional,
+	})
+}
+
+func TestDeferTask(t *testing.T) {
+	t.Parallel()
+
+	Convey(`With maintenance mode`, t, func() {
+		ctx := memory.Use(context.Background())
+		now := time.Date(2021, time.December, 1, 12, 0, 0, 0, time.UTC)
+		ctx, _ = testclock.UseTime(ctx, now)
+		ctx, _ = tsmon.WithDummyInMemory(ctx)
+		ctx, skdr := tq.TestingContext(ctx, nil)
+		cfg := &config.Config{
+			MaintenanceMode: &config.MaintenanceMode{
+				ReadOnlyProjects: []string{"chromium"},
+				Message:          "Database maintenance.",
+			},
+		}
+		So(config.SetTestConfig(ctx, cfg), ShouldBeNil)
+
+		Convey(`DeferTask`, func() {
+			Convey(`Defers tasks of projects in maintenance`, func() {
+				task := &taskspb.PurgeProject{Project: "chromium"}
+				deferred, err := DeferTask(ctx, "chromium", "test-task", task)
+				So(err, ShouldBeNil)
+				So(deferred, ShouldBeTrue)
+
+				tasks := skdr.Tasks()
+				So(tasks, ShouldHaveLength, 1)
+				So(tasks[0].Payload, ShouldResembleProto, task)
+				So(tasks[0].ETA.Equal(now.Add(DeferDelay)), ShouldBeTrue)
+				So(deferredTasksCounter.Get(ctx, "chromium", "test-task", "deferred"), ShouldEqual, int64(1))
+			})
+			Convey(`Does not defer tasks of other projects`, func() {
+				deferred, err := DeferTask(ctx, "chromeos", "test-task", &taskspb.PurgeProject{Project: "chromeos"})
+				So(err, ShouldBeNil)
+				So(deferred, ShouldBeFalse)
+				So(skdr.Tasks(), ShouldBeEmpty)
+			})
+			Convey(`Defers tasks of unknown projects in global maintenance`, func() {
+				deferred, err := DeferTask(ctx, "", "test-task", &taskspb.PurgeProject{})
+				So(err, ShouldBeNil)
+				So(deferred, ShouldBeFalse)
+
+				cfg.MaintenanceMode.ReadOnly = true
+				So(config.SetTestConfig(ctx, cfg), ShouldBeNil)
+				deferred, err = DeferTask(ctx, "", "test-task", &taskspb.PurgeProject{})
+				So(err, ShouldBeNil)
+				So(deferred, ShouldBeTrue)
+				So(skdr.Tasks(), ShouldHaveLength, 1)
+				So(deferredTasksCounter.Get(ctx, "", "test-task", "deferred"), ShouldEqual, int64(1))
+			})
+		})
+		Convey(`SkipTask`, func() {
+			So(SkipTask(ctx, "chromium", "test-task"), ShouldBeTrue)
+			So(SkipTask(ctx, "chromeos", "test-task"), ShouldBeFalse)
+			So(skdr.Tasks(), ShouldBeEmpty)
+			So(deferredTasksCounter.Get(ctx, "chromium", "test-task", "skipped"), ShouldEqual, int64(1))
+		})
+	})
+}
@@ -85,6 +85,38 @@ func (c *Client) QueryTestVariants(ctx context.Context, invName string, f func([
 	return nil
 }
 
+// QueryArtifacts queries the artifacts of test results in the invocation
+// (and the invocations it includes) which match the predicate.
+//
+// f is called once per page of artifacts.
+func (c *Client) QueryArtifacts(ctx context.Context, invName string, predicate *rdbpb.ArtifactPredicate, f func([]*rdbpb.Artifact) error, maxPages int) error {
+	pageToken := ""
+
+	for page := 0; page < maxPages; page++ {
+		rsp, err := c.client.QueryArtifacts(ctx, &rdbpb.QueryArtifactsRequest{
+			Invocations: []string{invName},
+			Predicate:   predicate,
+			PageSize:    1000, // Maximum page size.
+			PageToken:   pageToken,
+		})
+		if err != nil {
+			return err
+		}
+
+		if err = f(rsp.Artifacts); err != nil {
+			return err
+		}
+
+		pageToken = rsp.GetNextPageToken()
+		if pageToken == "" {
+			// No more artifacts.
+			break
+		}
+	}
+
+	return nil
+}
+
 // GetInvocation retrieves the invocation.
 func (c *Client) GetInvocation(ctx context.Context, invName string) (*rdbpb.Invocation, error) {
 	inv, err := c.client.GetInvocation(ctx, &rdbpb.GetInvocationRequest{
@@ -61,6 +61,37 @@ func TestResultDB(t *testing.T) {
 			So(len(tvs), ShouldEqual, 2)
 		})
 
+		Convey(`QueryArtifacts`, func() {
+			predicate := &rdbpb.ArtifactPredicate{
+				FollowEdges: &rdbpb.ArtifactPredicate_EdgeTypeSet{
+					TestResults: true,
+				},
+			}
+			req := &rdbpb.QueryArtifactsRequest{
+				Invocations: []string{inv},
+				Predicate:   predicate,
+				PageSize:    1000,
+			}
+			res := &rdbpb.QueryArtifactsResponse{
+				Artifacts: []*rdbpb.Artifact{
+					{
+						Name:       "invocations/inv/tests/ninja:%2F%2Ftest1/results/one/artifacts/snippet",
+						ArtifactId: "snippet",
+					},
+				},
+			}
+			mc.QueryArtifacts(req, res)
+
+			maxPages := 1
+			var artifacts []*rdbpb.Artifact
+			err := rc.QueryArtifacts(mc.Ctx, inv, predicate, func(res []*rdbpb.Artifact) error {
+				artifacts = append(artifacts, res...)
+				return nil
+			}, maxPages)
+			So(err, ShouldBeNil)
+			So(artifacts, ShouldResembleProto, res.Artifacts)
+		})
+
 		Convey(`GetInvocation`, func() {
 			realm := "realm"
 			req := &rdbpb.GetInvocationRequest{
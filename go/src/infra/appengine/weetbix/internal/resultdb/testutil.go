@@ -35,6 +35,12 @@ func (mc *MockedClient) QueryTestVariants(req *rdbpb.QueryTestVariantsRequest, r
 		gomock.Any()).Return(res, nil)
 }
 
+// QueryArtifacts mocks the QueryArtifacts RPC.
+func (mc *MockedClient) QueryArtifacts(req *rdbpb.QueryArtifactsRequest, res *rdbpb.QueryArtifactsResponse) {
+	mc.Client.EXPECT().QueryArtifacts(gomock.Any(), proto.MatcherEqual(req),
+		gomock.Any()).Return(res, nil)
+}
+
 // GetInvocation mocks the GetInvocation RPC.
 func (mc *MockedClient) GetInvocation(req *rdbpb.GetInvocationRequest, res *rdbpb.Invocation) {
 	mc.Client.EXPECT().GetInvocation(gomock.Any(), proto.MatcherEqual(req),
@@ -0,0 +1,168 @@
+// Copyright 2021 The Chromium Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+// Package sanitize cleans up and truncates free-form text, such as failure
+// reasons, for display in the Weetbix UI and in bugs.
+package sanitize
+
+import (
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// TruncationMarker marks where text was truncated.
+const TruncationMarker = "..."
+
+// ansiRE matches ANSI escape sequences: CSI sequences (e.g. colours and
+// cursor movement), terminated OSC sequences (e.g. window titles and
+// hyperlinks), character set designations and two-character escape
+// sequences.
+var ansiRE = regexp.MustCompile(`\x1b(?:\[[0-?]*[ -/]*[@-~]|\][^\x07\x1b]*(?:\x07|\x1b\\)|[ -/]+[0-~]|[0-Z\\-~])`)
+
+// Text cleans up s with Clean, then truncates it to at most maxRunes runes
+// with Truncate.
+func Text(s string, maxRunes int) string {
+	return Truncate(Clean(s), maxRunes)
+}
+
+// Line is like Text, but also joins the lines of s with spaces, for use
+// in titles.
+func Line(s string, maxRunes int) string {
+	s = Clean(s)
+	s = strings.ReplaceAll(s, "\n\n", "\n")
+	s = strings.ReplaceAll(s, "\n", " ")
+	return Truncate(s, maxRunes)
+}
+
+// Clean makes s safe to display:
+//   - ANSI escape sequences are removed.
+//   - Invalid UTF-8 is replaced with U+FFFD.
+//   - Control characters other than whitespace, and bidirectional text
+//     controls, are removed.
+//   - Runs of whitespace within a line are collapsed to a single space.
+//     Runs of whitespace spanning lines are collapsed to a single line
+//     break, or to an empty line if they span more than one line break.
+//   - Leading and trailing whitespace is removed.
+func Clean(s string) string {
+	s = strings.ToValidUTF8(s, string(utf8.RuneError))
+	s = ansiRE.ReplaceAllString(s, "")
+
+	var b strings.Builder
+	b.Grow(len(s))
+	// The number of line breaks, and whether there was any other
+	// whitespace, since the last rune written.
+	lineBreaks := 0
+	space := false
+	for _, r := range s {
+		switch {
+		case isLineBreak(r):
+			lineBreaks++
+			continue
+		case unicode.IsSpace(r):
+			space = true
+			continue
+		case unicode.IsControl(r) || unicode.Is(unicode.Bidi_Control, r):
+			continue
+		}
+		if b.Len() > 0 {
+			switch {
+			case lineBreaks > 1:
+				b.WriteString("\n\n")
+			case lineBreaks == 1:
+				b.WriteByte('\n')
+			case space:
+				b.WriteByte(' ')
+			}
+		}
+		lineBreaks = 0
+		space = false
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// Truncate truncates s to at most maxRunes runes, replacing the removed
+// text with TruncationMarker. A rune is never separated from the
+// combining marks which follow it, nor from runes joined to it, as in
+// emoji sequences.
+//
+// If s has several lines, and its first and last lines fit, the middle of
+// s is removed instead of its end: as many whole lines as fit are kept from
+// the start, followed by a line with the marker and the last line.
+func Truncate(s string, maxRunes int) string {
+	if utf8.RuneCountInString(s) <= maxRunes {
+		return s
+	}
+	runes := []rune(s)
+	marker := []rune(TruncationMarker)
+	if maxRunes <= len(marker) {
+		return string(runes[:cut(runes, maxRunes)])
+	}
+
+	firstEnd := indexRune(runes, '\n')
+	if firstEnd >= 0 {
+		lastStart := lastIndexRune(runes, '\n')
+		tail := runes[lastStart:]
+		// The length of the head, including the line break ending it.
+		budget := maxRunes - len(marker) - len(tail)
+		if firstEnd+1 <= budget {
+			headEnd := lastIndexRune(runes[:budget], '\n')
+			var b strings.Builder
+			b.WriteString(string(runes[:headEnd+1]))
+			b.WriteString(TruncationMarker)
+			b.WriteString(string(tail))
+			return b.String()
+		}
+	}
+
+	head := string(runes[:cut(runes, maxRunes-len(marker))])
+	return strings.TrimRightFunc(head, unicode.IsSpace) + TruncationMarker
+}
+
+// cut returns the largest index no greater than n at which runes can be
+// split without separating a rune from its combining marks, or runes
+// joined with a zero width joiner.
+func cut(runes []rune, n int) int {
+	for n > 0 && n < len(runes) && (isMark(runes[n]) || runes[n-1] == zeroWidthJoiner) {
+		n--
+	}
+	return n
+}
+
+const zeroWidthJoiner = '\u200d'
+
+// isMark returns whether r combines with the rune before it.
+func isMark(r rune) bool {
+	return unicode.In(r, unicode.Mn, unicode.Mc, unicode.Me) || r == zeroWidthJoiner
+}
+
+// isLineBreak returns whether r breaks a line.
+func isLineBreak(r rune) bool {
+	switch r {
+	case '\n', '\v', '\f', '\u0085', '\u2028', '\u2029':
+		return true
+	default:
+		return false
+	}
+}
+
+func indexRune(runes []rune, r rune) int {
+	for i, x := range runes {
+		if x == r {
+			return i
+		}
+	}
+	return -1
+}
+
+func lastIndexRune(runes []rune, r rune) int {
+	for i := len(runes) - 1; i >= 0; i-- {
+		if runes[i] == r {
+			return i
+		}
+	}
+	return -1
+}
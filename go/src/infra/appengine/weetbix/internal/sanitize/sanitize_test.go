@@ -0,0 +1,118 @@
+// Copyright 2021 The Chromium Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package sanitize
+
+import (
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestSanitize(t *testing.T) {
+	Convey(`Clean`, t, func() {
+		Convey(`Leaves plain text unchanged`, func() {
+			So(Clean("Expected 1, got 2."), ShouldEqual, "Expected 1, got 2.")
+		})
+		Convey(`Strips ANSI escapes`, func() {
+			So(Clean("\x1b[31;1mFAILED\x1b[0m: test"), ShouldEqual, "FAILED: test")
+			So(Clean("\x1b[2K\x1b[1Aprogress"), ShouldEqual, "progress")
+			So(Clean("\x1b]8;;https://example.com\x07link\x1b]8;;\x1b\\"), ShouldEqual, "link")
+			So(Clean("\x1b=keypad\x1b>"), ShouldEqual, "keypad")
+			So(Clean("\x1b(Bcharset\x1bc"), ShouldEqual, "charset")
+			So(Clean("lone escape\x1b"), ShouldEqual, "lone escape")
+		})
+		Convey(`Replaces invalid UTF-8`, func() {
+			So(Clean("bad \xff\xfe bytes"), ShouldEqual, "bad � bytes")
+			// A truncated multi-byte encoding.
+			So(Clean("cut \xe6\x97"), ShouldEqual, "cut �")
+			So(utf8.ValidString(Clean("\xc0\x80\xed\xa0\x80")), ShouldBeTrue)
+		})
+		Convey(`Removes control characters`, func() {
+			So(Clean("nul\x00 bell\x07 del\x7f c1\u009b"), ShouldEqual, "nul bell del c1")
+			So(Clean("backspace\b\b\b"), ShouldEqual, "backspace")
+		})
+		Convey(`Removes bidirectional text controls`, func() {
+			So(Clean("abc\u202edef\u2066ghi\u2069\u200f"), ShouldEqual, "abcdefghi")
+		})
+		Convey(`Collapses whitespace`, func() {
+			So(Clean("  a \t\t b\u00a0\u3000c  "), ShouldEqual, "a b c")
+			So(Clean("a \r\n  b"), ShouldEqual, "a\nb")
+			So(Clean("a\r\n\r\n\r\n\r\nb"), ShouldEqual, "a\n\nb")
+			So(Clean("a\v\fb\u2028c"), ShouldEqual, "a\n\nb\nc")
+			So(Clean("\n\n  \t\n"), ShouldEqual, "")
+		})
+		Convey(`Preserves combining characters`, func() {
+			So(Clean("cafe\u0301 क\u094dष"), ShouldEqual, "cafe\u0301 क\u094dष")
+		})
+		Convey(`Preserves CJK and emoji`, func() {
+			So(Clean("测试失败：超时 \U0001F469\u200d\U0001F4BB"), ShouldEqual, "测试失败：超时 \U0001F469\u200d\U0001F4BB")
+		})
+	})
+	Convey(`Truncate`, t, func() {
+		Convey(`Leaves short text unchanged`, func() {
+			So(Truncate("", 10), ShouldEqual, "")
+			So(Truncate("0123456789", 10), ShouldEqual, "0123456789")
+			So(Truncate("测试失败", 4), ShouldEqual, "测试失败")
+		})
+		Convey(`Truncates single lines`, func() {
+			So(Truncate("0123456789", 8), ShouldEqual, "01234...")
+			So(Truncate("01234 6789", 9), ShouldEqual, "01234...")
+		})
+		Convey(`Counts runes, not bytes`, func() {
+			result := Truncate("测试失败：超时了", 7)
+			So(result, ShouldEqual, "测试失败...")
+			So(utf8.ValidString(result), ShouldBeTrue)
+		})
+		Convey(`Does not separate combining marks`, func() {
+			// "e" followed by a combining acute accent, ten times.
+			s := strings.Repeat("e\u0301", 10)
+			So(Truncate(s, 8), ShouldEqual, "e\u0301e\u0301...")
+			// Devanagari vowel signs.
+			So(Truncate(strings.Repeat("क\u093f", 4), 6), ShouldEqual, "क\u093f...")
+			// Emoji joined with zero width joiners.
+			So(Truncate("ab\U0001F469\u200d\U0001F4BBcdef", 7), ShouldEqual, "ab...")
+		})
+		Convey(`Very short limits`, func() {
+			So(Truncate("0123456789", 3), ShouldEqual, "012")
+			So(Truncate("0123456789", 0), ShouldEqual, "")
+			So(Truncate("e\u0301e\u0301", 3), ShouldEqual, "e\u0301")
+		})
+		Convey(`Preserves first and last lines`, func() {
+			s := "first line\nsecond line\nthird line\nlast line"
+			So(Truncate(s, 30), ShouldEqual, "first line\n...\nlast line")
+			So(Truncate(s, 42), ShouldEqual, "first line\nsecond line\n...\nlast line")
+			So(utf8.RuneCountInString(Truncate(s, 42)), ShouldBeLessThanOrEqualTo, 42)
+		})
+		Convey(`Truncates the end if first and last lines do not fit`, func() {
+			s := "a very long first line\nmiddle\nlast line"
+			So(Truncate(s, 20), ShouldEqual, "a very long first...")
+		})
+		Convey(`Huge base64 blobs`, func() {
+			s := "Unexpected response:\n" + strings.Repeat("QUJDREVGR0hJSktMTU5PUA==", 10000) + "\nat main.go:10"
+			result := Truncate(s, 100)
+			So(result, ShouldEqual, "Unexpected response:\n...\nat main.go:10")
+
+			s = "Unexpected response: " + strings.Repeat("QUJDREVGR0hJSktMTU5PUA==", 10000)
+			result = Truncate(s, 100)
+			So(utf8.RuneCountInString(result), ShouldEqual, 100)
+			So(result, ShouldStartWith, "Unexpected response: QUJD")
+			So(result, ShouldEndWith, TruncationMarker)
+		})
+	})
+	Convey(`Text`, t, func() {
+		s := "\x1b[31mError:\x1b[0m\tbad \xff input\r\n\r\n\r\n  at foo()\n  at bar()\n  at baz()\r\n"
+		So(Text(s, 100), ShouldEqual, "Error: bad � input\n\nat foo()\nat bar()\nat baz()")
+		So(Text(s, 31), ShouldEqual, "Error: bad � input\n...\nat baz()")
+		So(Text(s, 35), ShouldEqual, "Error: bad � input\n\n...\nat baz()")
+	})
+	Convey(`Line`, t, func() {
+		s := "Expected equality of these values:\n  \"Expected_Value\"\n\n  my_expr.evaluate(123)"
+		So(Line(s, 200), ShouldEqual, `Expected equality of these values: "Expected_Value" my_expr.evaluate(123)`)
+		So(Line(s, 20), ShouldEqual, "Expected equality...")
+		So(Line("\u0000\r\n\v\u202e\u2066", 10), ShouldEqual, "")
+	})
+}
@@ -0,0 +1,272 @@
+// Copyright 2021 The Chromium Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+// Package backfill re-ingests the test results of historical builds of a
+// LUCI project.
+//
+// A backfill is carried out by a chain of backfill-project tasks. Each
+// task enumerates a page of the completed builds of the project created in
+// the backfilled time range, skips builds already ingested, and enqueues
+// ingestion tasks for the rest, spaced out to keep to the rate of the
+// backfill. It then schedules the next task once the ingestion tasks it
+// enqueued are due, so that backfills do not swamp the ingestion pipeline.
+package backfill
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"google.golang.org/genproto/protobuf/field_mask"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	bbpb "go.chromium.org/luci/buildbucket/proto"
+	"go.chromium.org/luci/common/clock"
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/common/logging"
+	"go.chromium.org/luci/server"
+	"go.chromium.org/luci/server/span"
+	"go.chromium.org/luci/server/tq"
+	_ "go.chromium.org/luci/server/tq/txn/spanner"
+
+	"infra/appengine/weetbix/internal/buildbucket"
+	"infra/appengine/weetbix/internal/maintenance"
+	"infra/appengine/weetbix/internal/services/resultingester"
+	"infra/appengine/weetbix/internal/tasks/taskspb"
+)
+
+const (
+	taskClass = "backfill-project"
+	queue     = "backfill-project"
+
+	// bbHost is the Buildbucket host builds are backfilled from.
+	bbHost = "cr-buildbucket.appspot.com"
+
+	// pageSize is the number of builds enumerated by each task.
+	pageSize = 500
+
+	// maxDryRunPages is the maximum number of pages of builds enumerated
+	// by a dry run.
+	maxDryRunPages = 20
+
+	// DefaultBuildsPerMinute is the rate of backfills which do not
+	// specify one.
+	DefaultBuildsPerMinute = 60
+	// MaxBuildsPerMinute is the maximum rate of a backfill.
+	MaxBuildsPerMinute = 1200
+
+	// MaxRunningJobs is the maximum number of backfills of a LUCI project
+	// in progress at once.
+	MaxRunningJobs = 2
+)
+
+// ErrTooManyBuilds is returned by DryRun if the time range contains more
+// builds than a dry run enumerates.
+var ErrTooManyBuilds = errors.Reason("the time range contains more than %d builds", maxDryRunPages*pageSize).Err()
+
+var tc = tq.RegisterTaskClass(tq.TaskClass{
+	ID:        taskClass,
+	Prototype: &taskspb.BackfillProject{},
+	Queue:     queue,
+	Kind:      tq.FollowsContext,
+})
+
+// Options configures a backfill.
+type Options struct {
+	// The LUCI Project whose builds are backfilled.
+	Project string
+	// The range of build creation times backfilled, [StartTime, EndTime).
+	StartTime time.Time
+	EndTime   time.Time
+	// Whether builds already ingested are ingested again.
+	Force bool
+	// The maximum rate at which ingestion tasks are enqueued.
+	BuildsPerMinute int64
+}
+
+// RegisterTaskHandler registers the handler for backfill-project tasks.
+func RegisterTaskHandler(srv *server.Server) {
+	handler := func(ctx context.Context, payload proto.Message) error {
+		task := payload.(*taskspb.BackfillProject)
+		if deferred, err := maintenance.DeferTask(ctx, task.Project, taskClass, task); err != nil || deferred {
+			return err
+		}
+		return runJob(ctx, task.Project, task.JobId)
+	}
+	tc.AttachHandler(handler)
+}
+
+// Schedule enqueues a task to continue a backfill after the given delay.
+// If called in a Spanner read/write transaction, the task is enqueued only
+// if the transaction commits.
+func Schedule(ctx context.Context, project, jobID string, delay time.Duration) error {
+	return tq.AddTask(ctx, &tq.Task{
+		Title:   fmt.Sprintf("%s-%s-%s", project, jobID, clock.Now(ctx).Format("20060102-150405")),
+		Payload: &taskspb.BackfillProject{Project: project, JobId: jobID},
+		Delay:   delay,
+	})
+}
+
+// DryRun enumerates the builds of a backfill without enqueuing any
+// ingestion tasks. It returns a job with the number of builds found,
+// skipped, and which would be enqueued.
+func DryRun(ctx context.Context, opts Options) (*Job, error) {
+	bc, err := buildbucket.NewClient(ctx, bbHost)
+	if err != nil {
+		return nil, err
+	}
+	j := &Job{
+		Project:         opts.Project,
+		StartTime:       opts.StartTime,
+		EndTime:         opts.EndTime,
+		Force:           opts.Force,
+		BuildsPerMinute: opts.BuildsPerMinute,
+	}
+	for i := 0; i < maxDryRunPages; i++ {
+		rsp, err := searchBuilds(ctx, bc, j)
+		if err != nil {
+			return nil, err
+		}
+		toIngest, err := buildsToIngest(ctx, rsp.Builds, j.Force)
+		if err != nil {
+			return nil, err
+		}
+		j.BuildsFound += int64(len(rsp.Builds))
+		j.BuildsSkipped += int64(len(rsp.Builds) - len(toIngest))
+		j.BuildsEnqueued += int64(len(toIngest))
+		j.PageToken = rsp.NextPageToken
+		if j.PageToken == "" {
+			return j, nil
+		}
+	}
+	return nil, ErrTooManyBuilds
+}
+
+// runJob enumerates the next page of builds of a backfill and enqueues
+// their ingestion, then schedules a task for the following page.
+func runJob(ctx context.Context, project, jobID string) error {
+	j, err := Read(span.Single(ctx), project, jobID)
+	if err == NotFound {
+		// The data of the project may have been purged.
+		logging.Warningf(ctx, "Backfill %s of project %s not found.", jobID, project)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if j.Completed() {
+		return nil
+	}
+
+	bc, err := buildbucket.NewClient(ctx, bbHost)
+	if err != nil {
+		return err
+	}
+	rsp, err := searchBuilds(ctx, bc, j)
+	if err != nil {
+		return err
+	}
+	toIngest, err := buildsToIngest(ctx, rsp.Builds, j.Force)
+	if err != nil {
+		return err
+	}
+
+	// Space out ingestion tasks to keep to the rate of the backfill. If
+	// the task is retried, the builds of the page are enqueued again;
+	// ingestion of a build is idempotent.
+	interval := time.Minute / time.Duration(j.BuildsPerMinute)
+	for i, b := range toIngest {
+		task := &taskspb.IngestTestResults{
+			Build:         &taskspb.Build{Host: bbHost, Id: b.Id},
+			PartitionTime: b.CreateTime,
+		}
+		if err := resultingester.ScheduleWithDelay(ctx, task, time.Duration(i)*interval); err != nil {
+			return errors.Annotate(err, "schedule ingestion of build %d", b.Id).Err()
+		}
+	}
+
+	pageToken := j.PageToken
+	_, err = span.ReadWriteTransaction(ctx, func(ctx context.Context) error {
+		j, err := Read(ctx, project, jobID)
+		if err != nil {
+			return err
+		}
+		if j.Completed() || j.PageToken != pageToken {
+			// Another attempt of the task already recorded the page.
+			return nil
+		}
+		j.BuildsFound += int64(len(rsp.Builds))
+		j.BuildsSkipped += int64(len(rsp.Builds) - len(toIngest))
+		j.BuildsEnqueued += int64(len(toIngest))
+		j.PageToken = rsp.NextPageToken
+		if j.PageToken == "" {
+			j.CompletionTime = clock.Now(ctx)
+		}
+		recordPage(ctx, j)
+		if j.Completed() {
+			return nil
+		}
+		// Enumerate the next page once the ingestion tasks enqueued for
+		// this page are due.
+		return Schedule(ctx, project, jobID, time.Duration(len(toIngest))*interval)
+	})
+	if err != nil {
+		return errors.Annotate(err, "record backfill progress").Err()
+	}
+	return nil
+}
+
+// searchBuilds returns the page of completed builds of the backfill at its
+// page token.
+func searchBuilds(ctx context.Context, bc *buildbucket.Client, j *Job) (*bbpb.SearchBuildsResponse, error) {
+	rsp, err := bc.SearchBuilds(ctx, searchBuildsRequest(j))
+	if err != nil {
+		return nil, errors.Annotate(err, "search builds").Err()
+	}
+	return rsp, nil
+}
+
+func searchBuildsRequest(j *Job) *bbpb.SearchBuildsRequest {
+	return &bbpb.SearchBuildsRequest{
+		Predicate: &bbpb.BuildPredicate{
+			Builder: &bbpb.BuilderID{Project: j.Project},
+			Status:  bbpb.Status_ENDED_MASK,
+			CreateTime: &bbpb.TimeRange{
+				StartTime: timestamppb.New(j.StartTime),
+				EndTime:   timestamppb.New(j.EndTime),
+			},
+		},
+		Mask: &bbpb.BuildMask{
+			Fields: &field_mask.FieldMask{
+				Paths: []string{"id", "create_time"},
+			},
+		},
+		PageSize:  pageSize,
+		PageToken: j.PageToken,
+	}
+}
+
+// buildsToIngest returns the builds to enqueue for ingestion. Unless force
+// is set, builds whose ingestion was recorded are skipped.
+func buildsToIngest(ctx context.Context, builds []*bbpb.Build, force bool) ([]*bbpb.Build, error) {
+	if force || len(builds) == 0 {
+		return builds, nil
+	}
+	ids := make([]int64, 0, len(builds))
+	for _, b := range builds {
+		ids = append(ids, b.Id)
+	}
+	ingested, err := resultingester.ReadIngested(span.Single(ctx), bbHost, ids)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*bbpb.Build, 0, len(builds))
+	for _, b := range builds {
+		if !ingested[b.Id] {
+			result = append(result, b)
+		}
+	}
+	return result, nil
+}
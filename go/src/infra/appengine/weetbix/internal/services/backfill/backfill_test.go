@@ -0,0 +1,182 @@
+// Copyright 2021 The Chromium Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package backfill
+
+import (
+	"testing"
+	"time"
+
+	"cloud.google.com/go/spanner"
+	"github.com/golang/mock/gomock"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	bbpb "go.chromium.org/luci/buildbucket/proto"
+	"go.chromium.org/luci/common/clock/testclock"
+	"go.chromium.org/luci/server/span"
+	"go.chromium.org/luci/server/tq"
+	"go.chromium.org/luci/server/tq/tqtesting"
+
+	"infra/appengine/weetbix/internal/buildbucket"
+	spanutil "infra/appengine/weetbix/internal/span"
+	"infra/appengine/weetbix/internal/tasks/taskspb"
+	"infra/appengine/weetbix/internal/testutil"
+
+	. "github.com/smartystreets/goconvey/convey"
+	. "go.chromium.org/luci/common/testing/assertions"
+)
+
+func TestBackfill(t *testing.T) {
+	Convey(`With Spanner Test Database`, t, func() {
+		ctx := testutil.SpannerTestContext(t)
+		// Use a time representable in Spanner, which stores timestamps
+		// with microsecond precision.
+		now := time.Date(2021, time.December, 1, 12, 0, 0, 0, time.UTC)
+		ctx, tc := testclock.UseTime(ctx, now)
+		ctx, skdr := tq.TestingContext(ctx, nil)
+
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		mbc := buildbucket.NewMockedClient(ctx, ctl)
+		ctx = mbc.Ctx
+
+		opts := Options{
+			Project:         "chromium",
+			StartTime:       now.Add(-48 * time.Hour),
+			EndTime:         now.Add(-24 * time.Hour),
+			BuildsPerMinute: 60,
+		}
+		build := func(id int64) *bbpb.Build {
+			return &bbpb.Build{Id: id, CreateTime: timestamppb.New(opts.StartTime.Add(time.Duration(id) * time.Minute))}
+		}
+		expectPage := func(pageToken string, rsp *bbpb.SearchBuildsResponse) {
+			j := &Job{Project: opts.Project, StartTime: opts.StartTime, EndTime: opts.EndTime, PageToken: pageToken}
+			mbc.SearchBuilds(searchBuildsRequest(j), rsp)
+		}
+		expectPages := func() {
+			expectPage("", &bbpb.SearchBuildsResponse{
+				Builds:        []*bbpb.Build{build(1), build(2), build(3)},
+				NextPageToken: "page2",
+			})
+			expectPage("page2", &bbpb.SearchBuildsResponse{
+				Builds: []*bbpb.Build{build(4)},
+			})
+		}
+		// Build 2 was already ingested.
+		testutil.MustApply(ctx, spanutil.InsertMap("IngestionControl", map[string]interface{}{
+			"BuildId":       bbHost + "/2",
+			"Project":       "chromium",
+			"IngestionTime": spanner.CommitTimestamp,
+		}))
+
+		// ingestionETAs returns the ETAs of the ingestion tasks enqueued,
+		// by build ID.
+		ingestionETAs := func() map[int64]time.Time {
+			result := make(map[int64]time.Time)
+			for _, task := range skdr.Tasks() {
+				if p, ok := task.Payload.(*taskspb.IngestTestResults); ok {
+					So(p.Build.Host, ShouldEqual, bbHost)
+					So(p.PartitionTime, ShouldResembleProto, build(p.Build.Id).CreateTime)
+					result[p.Build.Id] = task.ETA
+				}
+			}
+			return result
+		}
+		// backfillTasks returns the backfill-project tasks enqueued, by ETA.
+		backfillTasks := func() tqtesting.TaskList {
+			return skdr.Tasks().Filter(func(t *tqtesting.Task) bool {
+				_, ok := t.Payload.(*taskspb.BackfillProject)
+				return ok
+			}).SortByETA()
+		}
+
+		Convey(`DryRun`, func() {
+			expectPages()
+			job, err := DryRun(ctx, opts)
+			So(err, ShouldBeNil)
+			So(job.BuildsFound, ShouldEqual, 4)
+			So(job.BuildsSkipped, ShouldEqual, 1)
+			So(job.BuildsEnqueued, ShouldEqual, 3)
+			So(skdr.Tasks(), ShouldBeEmpty)
+
+			Convey(`With force`, func() {
+				expectPages()
+				opts.Force = true
+				job, err := DryRun(ctx, opts)
+				So(err, ShouldBeNil)
+				So(job.BuildsSkipped, ShouldEqual, 0)
+				So(job.BuildsEnqueued, ShouldEqual, 4)
+			})
+		})
+		Convey(`Start`, func() {
+			job, err := Start(ctx, opts, "user:admin@example.com")
+			So(err, ShouldBeNil)
+			So(job.JobID, ShouldHaveLength, 32)
+			So(job.CreationTime, ShouldEqual, now)
+			So(job.Completed(), ShouldBeFalse)
+			So(skdr.Tasks().Payloads(), ShouldResembleProto, []*taskspb.BackfillProject{
+				{Project: "chromium", JobId: job.JobID},
+			})
+
+			read, err := Read(span.Single(ctx), "chromium", job.JobID)
+			So(err, ShouldBeNil)
+			So(read, ShouldResemble, job)
+
+			Convey(`Limits concurrent backfills`, func() {
+				_, err := Start(ctx, opts, "user:admin@example.com")
+				So(err, ShouldBeNil)
+				_, err = Start(ctx, opts, "user:admin@example.com")
+				So(err, ShouldEqual, ErrTooManyJobs)
+
+				opts.Project = "chromeos"
+				_, err = Start(ctx, opts, "user:admin@example.com")
+				So(err, ShouldBeNil)
+			})
+			Convey(`Run`, func() {
+				expectPages()
+
+				// First page.
+				So(runJob(ctx, "chromium", job.JobID), ShouldBeNil)
+				So(ingestionETAs(), ShouldResemble, map[int64]time.Time{
+					1: now,
+					3: now.Add(time.Second),
+				})
+				next := backfillTasks()
+				So(next, ShouldHaveLength, 2)
+				So(next[1].ETA, ShouldEqual, now.Add(2*time.Second))
+
+				read, err := Read(span.Single(ctx), "chromium", job.JobID)
+				So(err, ShouldBeNil)
+				So(read.PageToken, ShouldEqual, "page2")
+				So(read.BuildsFound, ShouldEqual, 3)
+				So(read.BuildsSkipped, ShouldEqual, 1)
+				So(read.BuildsEnqueued, ShouldEqual, 2)
+				So(read.Completed(), ShouldBeFalse)
+
+				// Second page.
+				tc.Add(2 * time.Second)
+				So(runJob(ctx, "chromium", job.JobID), ShouldBeNil)
+				So(ingestionETAs(), ShouldContainKey, int64(4))
+				So(backfillTasks(), ShouldHaveLength, 2)
+
+				read, err = Read(span.Single(ctx), "chromium", job.JobID)
+				So(err, ShouldBeNil)
+				So(read.BuildsFound, ShouldEqual, 4)
+				So(read.BuildsSkipped, ShouldEqual, 1)
+				So(read.BuildsEnqueued, ShouldEqual, 3)
+				So(read.CompletionTime, ShouldEqual, tc.Now())
+
+				// Completed backfills no longer count towards the limit.
+				_, err = Start(ctx, opts, "user:admin@example.com")
+				So(err, ShouldBeNil)
+				_, err = Start(ctx, opts, "user:admin@example.com")
+				So(err, ShouldBeNil)
+			})
+		})
+		Convey(`Read`, func() {
+			_, err := Read(span.Single(ctx), "chromium", "0123456789abcdef0123456789abcdef")
+			So(err, ShouldEqual, NotFound)
+		})
+	})
+}
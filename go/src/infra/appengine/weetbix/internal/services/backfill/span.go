@@ -0,0 +1,185 @@
+// Copyright 2021 The Chromium Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package backfill
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"time"
+
+	"cloud.google.com/go/spanner"
+	"google.golang.org/grpc/codes"
+
+	"go.chromium.org/luci/common/clock"
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/server/span"
+
+	spanutil "infra/appengine/weetbix/internal/span"
+)
+
+var (
+	// NotFound is the error returned by Read if the backfill does not
+	// exist.
+	NotFound = errors.New("backfill not found")
+	// ErrTooManyJobs is returned by Start if MaxRunningJobs backfills of
+	// the project are already in progress.
+	ErrTooManyJobs = errors.New("too many backfills of the project in progress")
+)
+
+// Job is the record of a backfill of the builds of a LUCI project.
+type Job struct {
+	// The LUCI Project.
+	Project string
+	// Identifier of the backfill, unique within the project.
+	JobID string
+	// The range of build creation times backfilled, [StartTime, EndTime).
+	StartTime time.Time
+	EndTime   time.Time
+	// Whether builds already ingested are ingested again.
+	Force bool
+	// The maximum rate at which ingestion tasks are enqueued.
+	BuildsPerMinute int64
+	// The identity which requested the backfill.
+	CreatedBy string
+	// The time the backfill was requested.
+	CreationTime time.Time
+	// The Buildbucket page token of the next page of builds to enumerate.
+	PageToken string
+	// The numbers of builds enumerated, skipped because they were already
+	// ingested, and enqueued for ingestion to date.
+	BuildsFound    int64
+	BuildsSkipped  int64
+	BuildsEnqueued int64
+	// The time all builds were enumerated. Zero if the backfill is in
+	// progress.
+	CompletionTime time.Time
+}
+
+// Completed returns whether all builds of the backfill were enumerated.
+func (j *Job) Completed() bool {
+	return !j.CompletionTime.IsZero()
+}
+
+var jobColumns = []string{
+	"Project", "JobId", "StartTime", "EndTime", "Force", "BuildsPerMinute",
+	"CreatedBy", "CreationTime", "PageToken", "BuildsFound", "BuildsSkipped",
+	"BuildsEnqueued", "CompletionTime",
+}
+
+// Read reads the given backfill of a LUCI project. If the backfill does
+// not exist, the error NotFound is returned.
+func Read(ctx context.Context, project, jobID string) (*Job, error) {
+	row, err := span.ReadRow(ctx, "BackfillJobs", spanner.Key{project, jobID}, jobColumns)
+	if spanner.ErrCode(err) == codes.NotFound {
+		return nil, NotFound
+	}
+	if err != nil {
+		return nil, errors.Annotate(err, "read backfill").Err()
+	}
+	j := &Job{}
+	var completionTime spanner.NullTime
+	err = row.Columns(&j.Project, &j.JobID, &j.StartTime, &j.EndTime, &j.Force, &j.BuildsPerMinute,
+		&j.CreatedBy, &j.CreationTime, &j.PageToken, &j.BuildsFound, &j.BuildsSkipped,
+		&j.BuildsEnqueued, &completionTime)
+	if err != nil {
+		return nil, errors.Annotate(err, "read backfill row").Err()
+	}
+	j.CompletionTime = completionTime.Time
+	return j, nil
+}
+
+// Start records a new backfill of the builds of a LUCI project and
+// schedules its first task. If MaxRunningJobs backfills of the project
+// are in progress, the error ErrTooManyJobs is returned.
+func Start(ctx context.Context, opts Options, createdBy string) (*Job, error) {
+	jobID, err := generateJobID()
+	if err != nil {
+		return nil, err
+	}
+	var result *Job
+	_, err = span.ReadWriteTransaction(ctx, func(ctx context.Context) error {
+		running, err := countRunning(ctx, opts.Project)
+		if err != nil {
+			return err
+		}
+		if running >= MaxRunningJobs {
+			return ErrTooManyJobs
+		}
+		result = &Job{
+			Project:         opts.Project,
+			JobID:           jobID,
+			StartTime:       opts.StartTime,
+			EndTime:         opts.EndTime,
+			Force:           opts.Force,
+			BuildsPerMinute: opts.BuildsPerMinute,
+			CreatedBy:       createdBy,
+			CreationTime:    clock.Now(ctx),
+		}
+		span.BufferWrite(ctx, spanutil.InsertMap("BackfillJobs", map[string]interface{}{
+			"Project":         result.Project,
+			"JobId":           result.JobID,
+			"StartTime":       result.StartTime,
+			"EndTime":         result.EndTime,
+			"Force":           result.Force,
+			"BuildsPerMinute": result.BuildsPerMinute,
+			"CreatedBy":       result.CreatedBy,
+			"CreationTime":    result.CreationTime,
+			"PageToken":       "",
+			"BuildsFound":     int64(0),
+			"BuildsSkipped":   int64(0),
+			"BuildsEnqueued":  int64(0),
+			"CompletionTime":  spanner.NullTime{},
+		}))
+		return Schedule(ctx, result.Project, result.JobID, 0)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// countRunning returns the number of backfills of the project in progress.
+func countRunning(ctx context.Context, project string) (int64, error) {
+	stmt := spanner.NewStatement(`
+		SELECT COUNT(*) FROM BackfillJobs
+		WHERE Project = @project AND CompletionTime IS NULL`)
+	stmt.Params["project"] = project
+	var count int64
+	err := span.Query(ctx, stmt).Do(func(r *spanner.Row) error {
+		return r.Columns(&count)
+	})
+	if err != nil {
+		return 0, errors.Annotate(err, "count running backfills").Err()
+	}
+	return count, nil
+}
+
+// recordPage records the progress of a backfill after a page of builds
+// was enumerated. Must be called in a read/write transaction.
+func recordPage(ctx context.Context, j *Job) {
+	values := map[string]interface{}{
+		"Project":        j.Project,
+		"JobId":          j.JobID,
+		"PageToken":      j.PageToken,
+		"BuildsFound":    j.BuildsFound,
+		"BuildsSkipped":  j.BuildsSkipped,
+		"BuildsEnqueued": j.BuildsEnqueued,
+	}
+	if j.Completed() {
+		values["CompletionTime"] = j.CompletionTime
+	}
+	span.BufferWrite(ctx, spanutil.UpdateMap("BackfillJobs", values))
+}
+
+// generateJobID returns a random 128-bit backfill identifier, encoded as
+// 32 lowercase hexadecimal characters.
+func generateJobID() (string, error) {
+	randomBytes := make([]byte, 16)
+	if _, err := rand.Read(randomBytes); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(randomBytes), nil
+}
@@ -0,0 +1,123 @@
+// Copyright 2021 The Chromium Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+// Package bugupdater schedules and runs the per-project tasks which update
+// cluster analysis and Weetbix-managed bugs.
+package bugupdater
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"go.chromium.org/luci/common/clock"
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/server"
+	"go.chromium.org/luci/server/tq"
+
+	"infra/appengine/weetbix/internal/bugs/updater"
+	"infra/appengine/weetbix/internal/config"
+	"infra/appengine/weetbix/internal/maintenance"
+	"infra/appengine/weetbix/internal/tasks/taskspb"
+)
+
+const (
+	taskClass = "update-analysis-and-bugs"
+	queue     = "update-analysis-and-bugs"
+
+	// cronInterval is the interval at which the update-analysis-and-bugs
+	// cron job runs. Must match frontend/cron.yaml.
+	cronInterval = 15 * time.Minute
+
+	// taskDeadline is the time a task has to complete, measured from
+	// the cron run that scheduled it. This leaves time for the task to
+	// be retried before the next cron run schedules a new task.
+	taskDeadline = 14 * time.Minute
+)
+
+var tc = tq.RegisterTaskClass(tq.TaskClass{
+	ID:        taskClass,
+	Prototype: &taskspb.UpdateAnalysisAndBugs{},
+	Queue:     queue,
+	Kind:      tq.NonTransactional,
+})
+
+// RegisterTaskHandler registers the handler for update-analysis-and-bugs
+// tasks.
+func RegisterTaskHandler(srv *server.Server) {
+	// Avoid changes being applied to monorail from developer computers.
+	// See updater.UpdateAnalysisAndBugs.
+	simulate := !srv.Options.Prod
+	handler := func(ctx context.Context, payload proto.Message) error {
+		task := payload.(*taskspb.UpdateAnalysisAndBugs)
+		// Do not defer bug updates, as deferred updates would race with
+		// the updates scheduled by the next cron run.
+		if maintenance.SkipTask(ctx, task.Project, taskClass) {
+			return nil
+		}
+		return updateAnalysisAndBugs(ctx, srv.Options.CloudProject, simulate, task)
+	}
+	tc.AttachHandler(handler)
+}
+
+// Schedule enqueues a task to update analysis and bugs for a LUCI project.
+// Tasks for the same project and attempt time are deduplicated.
+func Schedule(ctx context.Context, task *taskspb.UpdateAnalysisAndBugs) error {
+	title := fmt.Sprintf("%s-%s", task.Project, task.AttemptTime.AsTime().Format("20060102-150405"))
+	return tq.AddTask(ctx, &tq.Task{
+		Title: title,
+		// Copy the task to avoid the caller retaining an alias to
+		// the task proto passed to tq.AddTask.
+		Payload:          proto.Clone(task).(*taskspb.UpdateAnalysisAndBugs),
+		DeduplicationKey: title,
+	})
+}
+
+// CronHandler handles the update-analysis-and-bugs cron job. It schedules
+// a task to update analysis and bugs for each LUCI project, so that
+// projects are updated in isolation from each other.
+func CronHandler(ctx context.Context) error {
+	projectCfg, err := config.Projects(ctx)
+	if err != nil {
+		return errors.Annotate(err, "get project configs").Err()
+	}
+
+	// In case this is a retry, round the time back to that of the
+	// original cron run, so that tasks are deduplicated.
+	attemptTime := clock.Now(ctx).UTC().Truncate(cronInterval)
+
+	var errs []error
+	for project := range projectCfg {
+		task := &taskspb.UpdateAnalysisAndBugs{
+			Project:     project,
+			AttemptTime: timestamppb.New(attemptTime),
+			Deadline:    timestamppb.New(attemptTime.Add(taskDeadline)),
+		}
+		if err := Schedule(ctx, task); err != nil {
+			errs = append(errs, errors.Annotate(err, "schedule update for project %v", project).Err())
+		}
+	}
+	if len(errs) > 0 {
+		return errors.NewMultiError(errs...)
+	}
+	return nil
+}
+
+func updateAnalysisAndBugs(ctx context.Context, gcpProject string, simulate bool, task *taskspb.UpdateAnalysisAndBugs) error {
+	cfg, err := config.Get(ctx)
+	if err != nil {
+		return errors.Annotate(err, "get config").Err()
+	}
+	deadline := task.Deadline.AsTime()
+	err = updater.UpdateAnalysisAndBugs(ctx, cfg.MonorailHostname, cfg.MonorailQuota, gcpProject, task.Project, simulate, deadline)
+	if err != nil && !clock.Now(ctx).Before(deadline) {
+		// Do not retry past the deadline. The next cron run will
+		// schedule a new task for the project.
+		return tq.Fatal.Apply(err)
+	}
+	return err
+}
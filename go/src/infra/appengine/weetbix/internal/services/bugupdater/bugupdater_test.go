@@ -0,0 +1,82 @@
+// Copyright 2021 The Chromium Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package bugupdater
+
+import (
+	"sort"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"go.chromium.org/luci/gae/impl/memory"
+	"go.chromium.org/luci/server/tq"
+	"go.chromium.org/luci/server/tq/tqtesting"
+
+	"infra/appengine/weetbix/internal/config"
+	"infra/appengine/weetbix/internal/tasks/taskspb"
+	"infra/appengine/weetbix/internal/testutil"
+
+	. "github.com/smartystreets/goconvey/convey"
+	"go.chromium.org/luci/common/clock/testclock"
+	. "go.chromium.org/luci/common/testing/assertions"
+)
+
+func TestCronHandler(t *testing.T) {
+	Convey(`CronHandler`, t, func() {
+		// Simulate the cron job running a few seconds late.
+		cronTime := time.Date(2025, time.January, 1, 12, 15, 0, 0, time.UTC)
+		ctx, tc := testclock.UseTime(testutil.TestingContext(), cronTime.Add(5*time.Second))
+		ctx = memory.Use(ctx) // For config cache.
+		ctx, skdr := tq.TestingContext(ctx, nil)
+
+		Convey(`Without projects`, func() {
+			config.SetTestProjectConfig(ctx, map[string]*config.ProjectConfig{})
+
+			So(CronHandler(ctx), ShouldBeNil)
+			So(skdr.Tasks(), ShouldBeEmpty)
+		})
+		Convey(`With projects`, func() {
+			config.SetTestProjectConfig(ctx, map[string]*config.ProjectConfig{
+				"project-a": {},
+				"project-b": {},
+			})
+			expected := []*taskspb.UpdateAnalysisAndBugs{
+				{
+					Project:     "project-a",
+					AttemptTime: timestamppb.New(cronTime),
+					Deadline:    timestamppb.New(cronTime.Add(taskDeadline)),
+				},
+				{
+					Project:     "project-b",
+					AttemptTime: timestamppb.New(cronTime),
+					Deadline:    timestamppb.New(cronTime.Add(taskDeadline)),
+				},
+			}
+
+			Convey(`Schedules a task per project`, func() {
+				So(CronHandler(ctx), ShouldBeNil)
+				So(tasks(skdr), ShouldResembleProto, expected)
+			})
+			Convey(`Retried cron run does not schedule tasks again`, func() {
+				So(CronHandler(ctx), ShouldBeNil)
+				tc.Add(time.Minute)
+				So(CronHandler(ctx), ShouldBeNil)
+				So(tasks(skdr), ShouldResembleProto, expected)
+			})
+		})
+	})
+}
+
+func tasks(s *tqtesting.Scheduler) []*taskspb.UpdateAnalysisAndBugs {
+	var tasks []*taskspb.UpdateAnalysisAndBugs
+	for _, pl := range s.Tasks().Payloads() {
+		tasks = append(tasks, pl.(*taskspb.UpdateAnalysisAndBugs))
+	}
+	sort.Slice(tasks, func(i, j int) bool {
+		return tasks[i].Project < tasks[j].Project
+	})
+	return tasks
+}
@@ -0,0 +1,308 @@
+// Copyright 2021 The Chromium Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+// Package chunkexport exports samples of the clustering chunks of a LUCI
+// project to GCS, for offline experimentation with clustering algorithms.
+//
+// An export is carried out by a chain of export-chunks tasks. Each task
+// reads a page of the clustering state of the chunks in the exported range
+// of partition times, and exports the sampled chunks: their failures are
+// redacted (see Redact) and written to an object per chunk. Once all chunks
+// were considered, a last task writes the manifest of the export and its
+// completion marker. Tasks record their progress in the ChunkExportJobs
+// table, so an export resumes where it left off if a task fails.
+//
+// The objects of an export are written to the chunk export bucket of the
+// service config, under Prefix(project, jobID):
+//
+//	chunks/<chunk ID>.jsonl  One per exported chunk, see EncodeFailures.
+//	manifest.jsonl           The exported objects, see EncodeManifest.
+//	_COMPLETE                A JSON CompletionMarker, written last.
+//
+// Chunks are sampled by their ID, so exports of the same chunks with the
+// same fraction export the same sample.
+package chunkexport
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"math"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	"go.chromium.org/luci/common/clock"
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/common/logging"
+	"go.chromium.org/luci/server"
+	"go.chromium.org/luci/server/span"
+	"go.chromium.org/luci/server/tq"
+	_ "go.chromium.org/luci/server/tq/txn/spanner"
+
+	"infra/appengine/weetbix/internal/clustering/chunkstore"
+	cpb "infra/appengine/weetbix/internal/clustering/proto"
+	"infra/appengine/weetbix/internal/clustering/state"
+	"infra/appengine/weetbix/internal/config"
+	"infra/appengine/weetbix/internal/maintenance"
+	"infra/appengine/weetbix/internal/tasks/taskspb"
+)
+
+const (
+	taskClass = "export-chunks"
+	queue     = "export-chunks"
+
+	// pageSize is the number of chunks considered for export by each task.
+	pageSize = 100
+
+	// MaxRunningJobs is the maximum number of exports of a LUCI project in
+	// progress at once.
+	MaxRunningJobs = 1
+)
+
+var tc = tq.RegisterTaskClass(tq.TaskClass{
+	ID:        taskClass,
+	Prototype: &taskspb.ExportChunks{},
+	Queue:     queue,
+	Kind:      tq.FollowsContext,
+})
+
+// Options configures an export.
+type Options struct {
+	// The LUCI Project whose chunks are exported.
+	Project string
+	// The range of chunk partition times exported, [StartTime, EndTime).
+	StartTime time.Time
+	EndTime   time.Time
+	// The fraction of the chunks exported, in (0, 1].
+	SampleFraction float64
+}
+
+// ChunkStore is the interface for the blob store archiving chunks of test
+// results.
+type ChunkStore interface {
+	// Get retrieves the chunk with the specified object ID and returns it.
+	Get(ctx context.Context, project, objectID string) (*cpb.Chunk, error)
+}
+
+// exporter carries out the tasks of exports.
+type exporter struct {
+	chunks   ChunkStore
+	bucket   Bucket
+	pageSize int
+}
+
+// RegisterTaskHandler registers the handler for export-chunks tasks.
+func RegisterTaskHandler(srv *server.Server) error {
+	ctx := srv.Context
+	cfg, err := config.Get(ctx)
+	if err != nil {
+		return err
+	}
+	chunkStore, err := chunkstore.NewClient(ctx, cfg.ChunkGcsBucket)
+	if err != nil {
+		return err
+	}
+	srv.RegisterCleanup(func(ctx context.Context) {
+		chunkStore.Close()
+	})
+	bucket, err := newGCSBucket(ctx)
+	if err != nil {
+		return err
+	}
+	srv.RegisterCleanup(func(ctx context.Context) {
+		bucket.Close()
+	})
+	e := &exporter{
+		chunks:   chunkStore,
+		bucket:   bucket,
+		pageSize: pageSize,
+	}
+	handler := func(ctx context.Context, payload proto.Message) error {
+		task := payload.(*taskspb.ExportChunks)
+		if deferred, err := maintenance.DeferTask(ctx, task.Project, taskClass, task); err != nil || deferred {
+			return err
+		}
+		return e.run(ctx, task.Project, task.JobId)
+	}
+	tc.AttachHandler(handler)
+	return nil
+}
+
+// Schedule enqueues a task to continue an export. If called in a Spanner
+// read/write transaction, the task is enqueued only if the transaction
+// commits.
+func Schedule(ctx context.Context, project, jobID string) error {
+	return tq.AddTask(ctx, &tq.Task{
+		Title:   fmt.Sprintf("%s-%s-%s", project, jobID, clock.Now(ctx).Format("20060102-150405")),
+		Payload: &taskspb.ExportChunks{Project: project, JobId: jobID},
+	})
+}
+
+// run exports the next page of chunks of an export, or completes the export
+// if all chunks were considered.
+func (e *exporter) run(ctx context.Context, project, jobID string) error {
+	j, err := Read(span.Single(ctx), project, jobID)
+	if err == NotFound {
+		// The data of the project may have been purged.
+		logging.Warningf(ctx, "Chunk export %s of project %s not found.", jobID, project)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	switch {
+	case j.Completed():
+		return nil
+	case j.scanned():
+		return e.complete(ctx, j)
+	default:
+		return e.exportPage(ctx, j)
+	}
+}
+
+// exportPage exports the sampled chunks of the next page of chunks of an
+// export, then schedules a task for the following page.
+func (e *exporter) exportPage(ctx context.Context, j *Job) error {
+	entries, err := state.ReadNextInPartitionTimeRange(span.Single(ctx), j.Project, j.LastChunkID, j.StartTime, j.EndTime, e.pageSize)
+	if err != nil {
+		return errors.Annotate(err, "read clustering state").Err()
+	}
+	// Objects are named after their chunk, so if the task is retried, the
+	// objects of the page are replaced.
+	var objects []*Object
+	var failures int64
+	for _, entry := range entries {
+		if !sampled(entry.ChunkID, j.SampleFraction) {
+			continue
+		}
+		o, err := e.exportChunk(ctx, j, entry)
+		if err != nil {
+			return errors.Annotate(err, "export chunk %s", entry.ChunkID).Err()
+		}
+		objects = append(objects, o)
+		failures += o.Failures
+	}
+
+	lastChunkID := j.LastChunkID
+	_, err = span.ReadWriteTransaction(ctx, func(ctx context.Context) error {
+		j, err := Read(ctx, j.Project, j.JobID)
+		if err != nil {
+			return err
+		}
+		if j.Completed() || j.LastChunkID != lastChunkID {
+			// Another attempt of the task already recorded the page.
+			return nil
+		}
+		j.ChunksScanned += int64(len(entries))
+		j.ChunksExported += int64(len(objects))
+		j.FailuresExported += failures
+		if len(entries) < e.pageSize {
+			j.LastChunkID = state.EndOfTable
+		} else {
+			j.LastChunkID = entries[len(entries)-1].ChunkID
+		}
+		recordPage(ctx, j, objects)
+		return Schedule(ctx, j.Project, j.JobID)
+	})
+	if err != nil {
+		return errors.Annotate(err, "record chunk export progress").Err()
+	}
+	return nil
+}
+
+// exportChunk writes the redacted failures of the chunk to its object.
+func (e *exporter) exportChunk(ctx context.Context, j *Job, entry *state.Entry) (*Object, error) {
+	chunk, err := e.chunks.Get(ctx, j.Project, entry.ObjectID)
+	if err != nil {
+		return nil, err
+	}
+	failures := make([]*cpb.Failure, 0, len(chunk.Failures))
+	for _, f := range chunk.Failures {
+		failures = append(failures, Redact(f, j.RedactionKey))
+	}
+	data, err := EncodeFailures(failures)
+	if err != nil {
+		return nil, err
+	}
+	o := &Object{
+		ChunkID:   entry.ChunkID,
+		Name:      chunkObjectName(j.Prefix(), entry.ChunkID),
+		SHA256:    checksum(data),
+		SizeBytes: int64(len(data)),
+		Failures:  int64(len(failures)),
+	}
+	if err := e.bucket.Write(ctx, j.Bucket, o.Name, "application/x-ndjson", data); err != nil {
+		return nil, err
+	}
+	return o, nil
+}
+
+// complete writes the manifest and then the completion marker of an export
+// whose chunks were all considered.
+func (e *exporter) complete(ctx context.Context, j *Job) error {
+	objects, err := ReadObjects(span.Single(ctx), j.Project, j.JobID)
+	if err != nil {
+		return err
+	}
+	manifest, err := EncodeManifest(objects)
+	if err != nil {
+		return err
+	}
+	manifestName := j.Prefix() + ManifestName
+	if err := e.bucket.Write(ctx, j.Bucket, manifestName, "application/x-ndjson", manifest); err != nil {
+		return errors.Annotate(err, "write manifest").Err()
+	}
+
+	completionTime := clock.Now(ctx)
+	marker, err := json.Marshal(&CompletionMarker{
+		Project:          j.Project,
+		JobID:            j.JobID,
+		StartTime:        j.StartTime,
+		EndTime:          j.EndTime,
+		SampleFraction:   j.SampleFraction,
+		ChunksScanned:    j.ChunksScanned,
+		ChunksExported:   j.ChunksExported,
+		FailuresExported: j.FailuresExported,
+		Manifest:         manifestName,
+		ManifestSHA256:   checksum(manifest),
+		CompletionTime:   completionTime,
+	})
+	if err != nil {
+		return errors.Annotate(err, "encode completion marker").Err()
+	}
+	if err := e.bucket.Write(ctx, j.Bucket, j.Prefix()+CompletionMarkerName, "application/json", marker); err != nil {
+		return errors.Annotate(err, "write completion marker").Err()
+	}
+
+	_, err = span.ReadWriteTransaction(ctx, func(ctx context.Context) error {
+		j, err := Read(ctx, j.Project, j.JobID)
+		if err != nil {
+			return err
+		}
+		if j.Completed() {
+			return nil
+		}
+		j.CompletionTime = completionTime
+		recordCompletion(ctx, j)
+		return nil
+	})
+	if err != nil {
+		return errors.Annotate(err, "record chunk export completion").Err()
+	}
+	return nil
+}
+
+// sampled returns whether the chunk with the given ID is in the sample of
+// the given fraction of chunks. Chunks in the sample of a fraction are also
+// in the samples of larger fractions.
+func sampled(chunkID string, fraction float64) bool {
+	if fraction >= 1 {
+		return true
+	}
+	h := sha256.Sum256([]byte(chunkID))
+	return float64(binary.BigEndian.Uint64(h[:8])) < fraction*math.Exp2(64)
+}
@@ -0,0 +1,228 @@
+// Copyright 2021 The Chromium Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package chunkexport
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"go.chromium.org/luci/common/clock/testclock"
+	"go.chromium.org/luci/server/span"
+	"go.chromium.org/luci/server/tq"
+
+	"infra/appengine/weetbix/internal/clustering/chunkstore"
+	cpb "infra/appengine/weetbix/internal/clustering/proto"
+	"infra/appengine/weetbix/internal/clustering/state"
+	"infra/appengine/weetbix/internal/tasks/taskspb"
+	"infra/appengine/weetbix/internal/testutil"
+
+	. "github.com/smartystreets/goconvey/convey"
+	. "go.chromium.org/luci/common/testing/assertions"
+)
+
+// fakeBucket stores objects in memory.
+type fakeBucket struct {
+	// objects are the objects written, by bucket and name.
+	objects map[string][]byte
+}
+
+func (b *fakeBucket) Write(ctx context.Context, bucket, name, contentType string, data []byte) error {
+	b.objects[bucket+"/"+name] = append([]byte(nil), data...)
+	return nil
+}
+
+func TestExport(t *testing.T) {
+	Convey(`With Spanner Test Database`, t, func() {
+		ctx := testutil.SpannerTestContext(t)
+		// Use a time representable in Spanner, which stores timestamps
+		// with microsecond precision.
+		now := time.Date(2021, time.December, 1, 12, 0, 0, 0, time.UTC)
+		ctx, tc := testclock.UseTime(ctx, now)
+		ctx, skdr := tq.TestingContext(ctx, nil)
+
+		opts := Options{
+			Project:        "chromium",
+			StartTime:      now.Add(-48 * time.Hour),
+			EndTime:        now.Add(-24 * time.Hour),
+			SampleFraction: 1,
+		}
+		chunks := chunkstore.NewFakeClient()
+		bucket := &fakeBucket{objects: make(map[string][]byte)}
+		e := &exporter{chunks: chunks, bucket: bucket, pageSize: 2}
+
+		// Three chunks in the time range, and one outside it.
+		var entries []*state.Entry
+		for i := 0; i < 4; i++ {
+			partitionTime := opts.StartTime.Add(time.Duration(i) * time.Hour)
+			if i == 3 {
+				partitionTime = opts.EndTime
+			}
+			entry := state.NewEntry(i).WithProject("chromium").WithPartitionTime(partitionTime).Build()
+			objectID, err := chunks.Put(ctx, "chromium", &cpb.Chunk{
+				Failures: []*cpb.Failure{testFailure(2 * i), testFailure(2*i + 1)},
+			})
+			So(err, ShouldBeNil)
+			entry.ObjectID = objectID
+			entries = append(entries, entry)
+		}
+		_, err := state.CreateEntriesForTesting(ctx, entries)
+		So(err, ShouldBeNil)
+
+		exportTasks := func() int {
+			count := 0
+			for _, t := range skdr.Tasks() {
+				if _, ok := t.Payload.(*taskspb.ExportChunks); ok {
+					count++
+				}
+			}
+			return count
+		}
+
+		Convey(`Start`, func() {
+			job, err := Start(ctx, opts, "exports-bucket", "user:admin@example.com")
+			So(err, ShouldBeNil)
+			So(job.JobID, ShouldHaveLength, 32)
+			So(job.RedactionKey, ShouldHaveLength, 32)
+			So(job.CreationTime, ShouldEqual, now)
+			So(skdr.Tasks().Payloads(), ShouldResembleProto, []*taskspb.ExportChunks{
+				{Project: "chromium", JobId: job.JobID},
+			})
+
+			read, err := Read(span.Single(ctx), "chromium", job.JobID)
+			So(err, ShouldBeNil)
+			So(read, ShouldResemble, job)
+
+			Convey(`Limits concurrent exports`, func() {
+				_, err = Start(ctx, opts, "exports-bucket", "user:admin@example.com")
+				So(err, ShouldEqual, ErrTooManyJobs)
+
+				opts.Project = "chromeos"
+				_, err = Start(ctx, opts, "exports-bucket", "user:admin@example.com")
+				So(err, ShouldBeNil)
+			})
+			Convey(`Run`, func() {
+				object := func(name string) []byte {
+					return bucket.objects["exports-bucket/"+job.Prefix()+name]
+				}
+
+				// First page.
+				So(e.run(ctx, "chromium", job.JobID), ShouldBeNil)
+				So(exportTasks(), ShouldEqual, 2)
+				read, err := Read(span.Single(ctx), "chromium", job.JobID)
+				So(err, ShouldBeNil)
+				So(read.ChunksScanned, ShouldEqual, 2)
+				So(read.ChunksExported, ShouldEqual, 2)
+				So(read.FailuresExported, ShouldEqual, 4)
+				So(bucket.objects, ShouldHaveLength, 2)
+
+				// A retry of the task does not record the page again.
+				read.LastChunkID = ""
+				So(e.exportPage(ctx, read), ShouldBeNil)
+				So(exportTasks(), ShouldEqual, 2)
+
+				// Last page.
+				So(e.run(ctx, "chromium", job.JobID), ShouldBeNil)
+				read, err = Read(span.Single(ctx), "chromium", job.JobID)
+				So(err, ShouldBeNil)
+				So(read.LastChunkID, ShouldEqual, state.EndOfTable)
+				So(read.ChunksScanned, ShouldEqual, 3)
+				So(read.ChunksExported, ShouldEqual, 3)
+				So(read.FailuresExported, ShouldEqual, 6)
+				So(read.Completed(), ShouldBeFalse)
+				So(object(ManifestName), ShouldBeNil)
+
+				// Completion.
+				tc.Add(time.Minute)
+				So(e.run(ctx, "chromium", job.JobID), ShouldBeNil)
+				read, err = Read(span.Single(ctx), "chromium", job.JobID)
+				So(err, ShouldBeNil)
+				So(read.CompletionTime, ShouldEqual, tc.Now())
+				So(exportTasks(), ShouldEqual, 3)
+
+				manifest, err := DecodeManifest(bytes.NewReader(object(ManifestName)))
+				So(err, ShouldBeNil)
+				So(manifest, ShouldHaveLength, 3)
+				// The chunks in the time range, by chunk ID.
+				index := map[string]int{
+					entries[0].ChunkID: 0,
+					entries[1].ChunkID: 1,
+					entries[2].ChunkID: 2,
+				}
+				for j, o := range manifest {
+					if j > 0 {
+						So(o.ChunkID, ShouldBeGreaterThan, manifest[j-1].ChunkID)
+					}
+					So(index, ShouldContainKey, o.ChunkID)
+					i := index[o.ChunkID]
+					data := bucket.objects["exports-bucket/"+o.Name]
+					So(o.SHA256, ShouldEqual, checksum(data))
+					So(o.SizeBytes, ShouldEqual, int64(len(data)))
+
+					failures, err := DecodeFailures(bytes.NewReader(data))
+					So(err, ShouldBeNil)
+					So(failures, ShouldHaveLength, int(o.Failures))
+					So(failures, ShouldResembleProto, []*cpb.Failure{
+						Redact(testFailure(2*i), job.RedactionKey),
+						Redact(testFailure(2*i+1), job.RedactionKey),
+					})
+				}
+
+				marker := &CompletionMarker{}
+				So(json.Unmarshal(object(CompletionMarkerName), marker), ShouldBeNil)
+				So(marker, ShouldResemble, &CompletionMarker{
+					Project:          "chromium",
+					JobID:            job.JobID,
+					StartTime:        opts.StartTime,
+					EndTime:          opts.EndTime,
+					SampleFraction:   1,
+					ChunksScanned:    3,
+					ChunksExported:   3,
+					FailuresExported: 6,
+					Manifest:         job.Prefix() + ManifestName,
+					ManifestSHA256:   checksum(object(ManifestName)),
+					CompletionTime:   tc.Now(),
+				})
+
+				// Completed exports no longer count towards the limit.
+				_, err = Start(ctx, opts, "exports-bucket", "user:admin@example.com")
+				So(err, ShouldBeNil)
+			})
+		})
+		Convey(`Read`, func() {
+			_, err := Read(span.Single(ctx), "chromium", "0123456789abcdef0123456789abcdef")
+			So(err, ShouldEqual, NotFound)
+		})
+	})
+}
+
+func TestSampled(t *testing.T) {
+	Convey(`Sampled`, t, func() {
+		count := func(fraction float64) int {
+			result := 0
+			for i := 0; i < 10000; i++ {
+				if sampled(fmt.Sprintf("%032x", i), fraction) {
+					result++
+				}
+			}
+			return result
+		}
+		So(count(0), ShouldEqual, 0)
+		So(count(1), ShouldEqual, 10000)
+		So(count(0.1), ShouldBeBetween, 900, 1100)
+
+		Convey(`Samples of larger fractions include smaller ones`, func() {
+			for i := 0; i < 1000; i++ {
+				id := fmt.Sprintf("%032x", i)
+				if sampled(id, 0.1) {
+					So(sampled(id, 0.2), ShouldBeTrue)
+				}
+			}
+		})
+	})
+}
@@ -0,0 +1,172 @@
+// Copyright 2021 The Chromium Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package chunkexport
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"path"
+	"time"
+
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"go.chromium.org/luci/common/errors"
+
+	cpb "infra/appengine/weetbix/internal/clustering/proto"
+)
+
+// Names of the objects of an export, relative to its prefix.
+const (
+	// chunksDir holds an object per exported chunk.
+	chunksDir = "chunks"
+	// ManifestName is the name of the manifest of an export.
+	ManifestName = "manifest.jsonl"
+	// CompletionMarkerName is the name of the completion marker of an
+	// export. It is written last.
+	CompletionMarkerName = "_COMPLETE"
+)
+
+// maxLineBytes is the maximum length of a line of an exported object.
+const maxLineBytes = 1 << 20
+
+// Object is an object written by an export, listed in its manifest.
+type Object struct {
+	// The ID of the exported chunk.
+	ChunkID string `json:"chunk_id"`
+	// The name of the object in the bucket of the export.
+	Name string `json:"object"`
+	// The SHA-256 hash of the contents of the object, hex-encoded.
+	SHA256 string `json:"sha256"`
+	// The size of the object, in bytes.
+	SizeBytes int64 `json:"size_bytes"`
+	// The number of failures in the object.
+	Failures int64 `json:"failures"`
+}
+
+// CompletionMarker is the contents of the completion marker of an export.
+type CompletionMarker struct {
+	Project string `json:"project"`
+	JobID   string `json:"job_id"`
+	// The range of chunk partition times exported, [StartTime, EndTime).
+	StartTime time.Time `json:"start_time"`
+	EndTime   time.Time `json:"end_time"`
+	// The fraction of the chunks exported.
+	SampleFraction float64 `json:"sample_fraction"`
+	// The numbers of chunks considered for export, and of chunks and
+	// failures exported.
+	ChunksScanned    int64 `json:"chunks_scanned"`
+	ChunksExported   int64 `json:"chunks_exported"`
+	FailuresExported int64 `json:"failures_exported"`
+	// The name of the manifest, and the SHA-256 hash of its contents,
+	// hex-encoded.
+	Manifest       string `json:"manifest"`
+	ManifestSHA256 string `json:"manifest_sha256"`
+	// The time the export completed.
+	CompletionTime time.Time `json:"completion_time"`
+}
+
+// Prefix returns the prefix of the names of the objects of an export.
+func Prefix(project, jobID string) string {
+	return project + "/" + jobID + "/"
+}
+
+// chunkObjectName returns the name of the object the chunk with the given
+// ID is exported to.
+func chunkObjectName(prefix, chunkID string) string {
+	return path.Join(prefix, chunksDir, chunkID+".jsonl")
+}
+
+// EncodeFailures encodes failures as an exported chunk object: one line per
+// failure, holding the JSON encoding of the weetbix.internal.clustering.Failure
+// proto, with proto field names. The encoding is deterministic.
+func EncodeFailures(failures []*cpb.Failure) ([]byte, error) {
+	opts := protojson.MarshalOptions{UseProtoNames: true}
+	var buf bytes.Buffer
+	for _, f := range failures {
+		b, err := opts.Marshal(f)
+		if err != nil {
+			return nil, errors.Annotate(err, "marshal failure").Err()
+		}
+		// protojson does not guarantee stable whitespace.
+		if err := json.Compact(&buf, b); err != nil {
+			return nil, errors.Annotate(err, "compact failure").Err()
+		}
+		buf.WriteByte('\n')
+	}
+	return buf.Bytes(), nil
+}
+
+// DecodeFailures decodes an exported chunk object.
+func DecodeFailures(r io.Reader) ([]*cpb.Failure, error) {
+	var failures []*cpb.Failure
+	err := scanLines(r, func(line []byte) error {
+		f := &cpb.Failure{}
+		if err := protojson.Unmarshal(line, f); err != nil {
+			return err
+		}
+		failures = append(failures, f)
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Annotate(err, "decode failures").Err()
+	}
+	return failures, nil
+}
+
+// EncodeManifest encodes the manifest of an export: one line per exported
+// object, holding the JSON encoding of an Object.
+func EncodeManifest(objects []*Object) ([]byte, error) {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	for _, o := range objects {
+		if err := enc.Encode(o); err != nil {
+			return nil, errors.Annotate(err, "encode manifest").Err()
+		}
+	}
+	return buf.Bytes(), nil
+}
+
+// DecodeManifest decodes the manifest of an export.
+func DecodeManifest(r io.Reader) ([]*Object, error) {
+	var objects []*Object
+	err := scanLines(r, func(line []byte) error {
+		o := &Object{}
+		if err := json.Unmarshal(line, o); err != nil {
+			return err
+		}
+		objects = append(objects, o)
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Annotate(err, "decode manifest").Err()
+	}
+	return objects, nil
+}
+
+// scanLines calls f with each non-empty line read from r.
+func scanLines(r io.Reader, f func(line []byte) error) error {
+	s := bufio.NewScanner(r)
+	s.Buffer(nil, maxLineBytes)
+	for i := 1; s.Scan(); i++ {
+		if len(s.Bytes()) == 0 {
+			continue
+		}
+		if err := f(s.Bytes()); err != nil {
+			return fmt.Errorf("line %d: %s", i, err)
+		}
+	}
+	return s.Err()
+}
+
+// checksum returns the hex-encoded SHA-256 hash of data.
+func checksum(data []byte) string {
+	h := sha256.Sum256(data)
+	return hex.EncodeToString(h[:])
+}
@@ -0,0 +1,97 @@
+// Copyright 2021 The Chromium Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package chunkexport
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	cpb "infra/appengine/weetbix/internal/clustering/proto"
+	pb "infra/appengine/weetbix/proto/v1"
+
+	. "github.com/smartystreets/goconvey/convey"
+	. "go.chromium.org/luci/common/testing/assertions"
+)
+
+// testFailure returns a failure with all fields set.
+func testFailure(i int) *cpb.Failure {
+	return &cpb.Failure{
+		TestResultId:  &pb.TestResultId{System: "resultdb", Id: fmt.Sprintf("invocations/task-%d/tests/test/results/%d", i, i)},
+		PartitionTime: timestamppb.New(time.Date(2021, time.December, 1, 0, 0, 0, 0, time.UTC)),
+		ChunkIndex:    int64(i),
+		Realm:         "chromium:ci",
+		TestId:        fmt.Sprintf("ninja://test_%d", i),
+		Variant:       &pb.Variant{Def: map[string]string{"builder": "linux-rel", "os": "Ubuntu-18.04"}},
+		VariantHash:   "0123456789abcdef",
+		FailureReason: &pb.FailureReason{
+			PrimaryErrorMessage: fmt.Sprintf("Expected 1, got %d; owner user%d@example.com", i, i),
+		},
+		BugTrackingComponent: &pb.BugTrackingComponent{System: "monorail", Component: "Blink>Layout"},
+		StartTime:            timestamppb.New(time.Date(2021, time.December, 1, 0, 0, i, 0, time.UTC)),
+		Duration:             durationpb.New(time.Duration(i) * time.Second),
+		IsExonerated:         i%2 == 0,
+		PresubmitRunId:       &pb.PresubmitRunId{System: "luci-cv", Id: fmt.Sprintf("chromium/%d", i)},
+		IngestedInvocationId: fmt.Sprintf("build-%d", i),
+		TestRunId:            fmt.Sprintf("task-%d", i),
+		ErrorTypeTags:        []string{"timeout"},
+		Artifacts: []*pb.ArtifactLink{{
+			System: "resultdb",
+			Name:   fmt.Sprintf("invocations/task-%d/tests/test/results/%d/artifacts/snippet", i, i),
+		}},
+		FailureKind: pb.FailureKind_TEST_FAILURE,
+	}
+}
+
+func TestFormat(t *testing.T) {
+	Convey(`Failures`, t, func() {
+		failures := []*cpb.Failure{testFailure(1), testFailure(2), {}}
+
+		data, err := EncodeFailures(failures)
+		So(err, ShouldBeNil)
+		So(strings.Count(string(data), "\n"), ShouldEqual, 3)
+
+		Convey(`Round-trip`, func() {
+			decoded, err := DecodeFailures(bytes.NewReader(data))
+			So(err, ShouldBeNil)
+			So(decoded, ShouldResembleProto, failures)
+		})
+		Convey(`Deterministic`, func() {
+			again, err := EncodeFailures(failures)
+			So(err, ShouldBeNil)
+			So(again, ShouldResemble, data)
+			So(checksum(again), ShouldEqual, checksum(data))
+		})
+		Convey(`Uses proto field names`, func() {
+			So(string(data), ShouldContainSubstring, `"ingested_invocation_id":"build-1"`)
+		})
+		Convey(`Invalid line`, func() {
+			_, err := DecodeFailures(strings.NewReader(string(data) + "{\"test_id\":\n"))
+			So(err, ShouldErrLike, "line 4")
+		})
+	})
+	Convey(`Manifest`, t, func() {
+		objects := []*Object{
+			{ChunkID: "aa", Name: "chromium/job/chunks/aa.jsonl", SHA256: checksum([]byte("a")), SizeBytes: 1, Failures: 1},
+			{ChunkID: "bb", Name: "chromium/job/chunks/bb.jsonl", SHA256: checksum([]byte("bb")), SizeBytes: 2, Failures: 3},
+		}
+		data, err := EncodeManifest(objects)
+		So(err, ShouldBeNil)
+		So(strings.Split(string(data), "\n")[0], ShouldEqual,
+			`{"chunk_id":"aa","object":"chromium/job/chunks/aa.jsonl","sha256":"ca978112ca1bbdcafac231b39a23dc4da786eff8147c4e72b9807785afee48bb","size_bytes":1,"failures":1}`)
+
+		decoded, err := DecodeManifest(bytes.NewReader(data))
+		So(err, ShouldBeNil)
+		So(decoded, ShouldResemble, objects)
+	})
+	Convey(`Object names`, t, func() {
+		So(chunkObjectName(Prefix("chromium", "job"), "aa"), ShouldEqual, "chromium/job/chunks/aa.jsonl")
+	})
+}
@@ -0,0 +1,70 @@
+// Copyright 2021 The Chromium Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package chunkexport
+
+import (
+	"context"
+
+	"cloud.google.com/go/storage"
+	"google.golang.org/api/option"
+	"google.golang.org/grpc"
+
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/grpc/grpcmon"
+	"go.chromium.org/luci/server/auth"
+)
+
+// Bucket is the interface for the GCS buckets chunks are exported to.
+type Bucket interface {
+	// Write writes an object to the bucket, replacing any object with the
+	// same name.
+	Write(ctx context.Context, bucket, name, contentType string, data []byte) error
+}
+
+// gcsBucket writes objects to GCS.
+type gcsBucket struct {
+	client *storage.Client
+}
+
+// newGCSBucket initialises a new gcsBucket.
+func newGCSBucket(ctx context.Context) (*gcsBucket, error) {
+	// Credentials with Cloud scope.
+	creds, err := auth.GetPerRPCCredentials(ctx, auth.AsSelf, auth.WithScopes(auth.CloudOAuthScopes...))
+	if err != nil {
+		return nil, errors.Annotate(err, "failed to get PerRPCCredentials").Err()
+	}
+	cl, err := storage.NewClient(ctx,
+		option.WithGRPCDialOption(grpc.WithPerRPCCredentials(creds)),
+		option.WithGRPCDialOption(grpcmon.WithClientRPCStatsMonitor()),
+		option.WithScopes(storage.ScopeReadWrite),
+	)
+	if err != nil {
+		return nil, errors.Annotate(err, "failed to instantiate Cloud Storage client").Err()
+	}
+	return &gcsBucket{client: cl}, nil
+}
+
+// Close releases resources associated with the client.
+func (b *gcsBucket) Close() {
+	b.client.Close()
+}
+
+// Write implements Bucket.
+func (b *gcsBucket) Write(ctx context.Context, bucket, name, contentType string, data []byte) error {
+	w := b.client.Bucket(bucket).Object(name).NewWriter(ctx)
+	// Objects are small, write them in a single request.
+	if len(data) < 8*1024*1024 {
+		w.ChunkSize = len(data)
+	}
+	w.ContentType = contentType
+	if _, err := w.Write(data); err != nil {
+		w.Close()
+		return errors.Annotate(err, "writing object %q", name).Err()
+	}
+	if err := w.Close(); err != nil {
+		return errors.Annotate(err, "closing object %q", name).Err()
+	}
+	return nil
+}
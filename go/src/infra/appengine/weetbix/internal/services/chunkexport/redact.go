@@ -0,0 +1,62 @@
+// Copyright 2021 The Chromium Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package chunkexport
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"regexp"
+
+	"google.golang.org/protobuf/proto"
+
+	cpb "infra/appengine/weetbix/internal/clustering/proto"
+)
+
+// redactedEmail replaces email addresses in exported failure reasons.
+const redactedEmail = "<redacted-email>"
+
+// emailRe matches email addresses.
+var emailRe = regexp.MustCompile(`[a-zA-Z0-9._%+\-]+@[a-zA-Z0-9.\-]+\.[a-zA-Z]{2,}`)
+
+// Redact returns a copy of the failure with the fields which may identify
+// people or their changes redacted, keeping the fields clustering algorithms
+// use:
+//   - The IDs of the test result, ingested invocation, test run and
+//     presubmit run are pseudonymised: they are replaced by hashes keyed
+//     with the key of the export, so failures of the same invocation, test
+//     run or presubmit run can still be told apart, but the invocations and
+//     changes cannot be looked up.
+//   - Links to artifacts are removed.
+//   - Email addresses in the failure reason are replaced.
+func Redact(f *cpb.Failure, key []byte) *cpb.Failure {
+	result := proto.Clone(f).(*cpb.Failure)
+	if result.TestResultId != nil {
+		result.TestResultId.Id = pseudonym(key, "test-result", result.TestResultId.Id)
+	}
+	if result.PresubmitRunId != nil {
+		result.PresubmitRunId.Id = pseudonym(key, "presubmit-run", result.PresubmitRunId.Id)
+	}
+	result.IngestedInvocationId = pseudonym(key, "invocation", result.IngestedInvocationId)
+	result.TestRunId = pseudonym(key, "test-run", result.TestRunId)
+	result.Artifacts = nil
+	if result.FailureReason != nil {
+		result.FailureReason.PrimaryErrorMessage = emailRe.ReplaceAllString(result.FailureReason.PrimaryErrorMessage, redactedEmail)
+	}
+	return result
+}
+
+// pseudonym returns the pseudonym of the identifier of the given kind,
+// 32 lowercase hexadecimal characters. Empty identifiers stay empty.
+func pseudonym(key []byte, kind, id string) string {
+	if id == "" {
+		return ""
+	}
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(kind))
+	mac.Write([]byte{0})
+	mac.Write([]byte(id))
+	return hex.EncodeToString(mac.Sum(nil)[:16])
+}
@@ -0,0 +1,79 @@
+// Copyright 2021 The Chromium Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package chunkexport
+
+import (
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+
+	cpb "infra/appengine/weetbix/internal/clustering/proto"
+
+	. "github.com/smartystreets/goconvey/convey"
+	. "go.chromium.org/luci/common/testing/assertions"
+)
+
+func TestRedact(t *testing.T) {
+	Convey(`Redact`, t, func() {
+		key := []byte("key")
+		f := testFailure(1)
+		original := proto.Clone(f).(*cpb.Failure)
+		redacted := Redact(f, key)
+
+		Convey(`Does not modify the failure`, func() {
+			So(f, ShouldResembleProto, original)
+		})
+		Convey(`Pseudonymises identifiers`, func() {
+			for _, id := range []string{
+				redacted.TestResultId.Id,
+				redacted.PresubmitRunId.Id,
+				redacted.IngestedInvocationId,
+				redacted.TestRunId,
+			} {
+				So(id, ShouldHaveLength, 32)
+			}
+			So(redacted.TestResultId.System, ShouldEqual, "resultdb")
+			So(redacted.PresubmitRunId.System, ShouldEqual, "luci-cv")
+
+			Convey(`Consistently within an export`, func() {
+				other := testFailure(2)
+				other.IngestedInvocationId = f.IngestedInvocationId
+				otherRedacted := Redact(other, key)
+				So(otherRedacted.IngestedInvocationId, ShouldEqual, redacted.IngestedInvocationId)
+				So(otherRedacted.TestRunId, ShouldNotEqual, redacted.TestRunId)
+			})
+			Convey(`Differently across exports`, func() {
+				So(Redact(f, []byte("other key")).IngestedInvocationId, ShouldNotEqual, redacted.IngestedInvocationId)
+			})
+			Convey(`Differently by kind`, func() {
+				f.TestRunId = f.IngestedInvocationId
+				So(Redact(f, key).TestRunId, ShouldNotEqual, redacted.IngestedInvocationId)
+			})
+			Convey(`Except empty identifiers`, func() {
+				f.TestRunId = ""
+				f.PresubmitRunId = nil
+				redacted := Redact(f, key)
+				So(redacted.TestRunId, ShouldEqual, "")
+				So(redacted.PresubmitRunId, ShouldBeNil)
+			})
+		})
+		Convey(`Removes artifact links`, func() {
+			So(redacted.Artifacts, ShouldBeNil)
+		})
+		Convey(`Replaces email addresses`, func() {
+			So(redacted.FailureReason.PrimaryErrorMessage, ShouldEqual, "Expected 1, got 1; owner <redacted-email>")
+		})
+		Convey(`Keeps the fields used for clustering`, func() {
+			expected := proto.Clone(original).(*cpb.Failure)
+			expected.TestResultId.Id = redacted.TestResultId.Id
+			expected.PresubmitRunId.Id = redacted.PresubmitRunId.Id
+			expected.IngestedInvocationId = redacted.IngestedInvocationId
+			expected.TestRunId = redacted.TestRunId
+			expected.Artifacts = nil
+			expected.FailureReason.PrimaryErrorMessage = redacted.FailureReason.PrimaryErrorMessage
+			So(redacted, ShouldResembleProto, expected)
+		})
+	})
+}
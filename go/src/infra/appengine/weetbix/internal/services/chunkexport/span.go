@@ -0,0 +1,248 @@
+// Copyright 2021 The Chromium Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package chunkexport
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"time"
+
+	"cloud.google.com/go/spanner"
+	"google.golang.org/grpc/codes"
+
+	"go.chromium.org/luci/common/clock"
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/server/span"
+
+	"infra/appengine/weetbix/internal/clustering/state"
+	spanutil "infra/appengine/weetbix/internal/span"
+)
+
+var (
+	// NotFound is the error returned by Read if the export does not exist.
+	NotFound = errors.New("chunk export not found")
+	// ErrTooManyJobs is returned by Start if MaxRunningJobs exports of the
+	// project are already in progress.
+	ErrTooManyJobs = errors.New("too many chunk exports of the project in progress")
+)
+
+// Job is the record of an export of the clustering chunks of a LUCI project.
+type Job struct {
+	// The LUCI Project.
+	Project string
+	// Identifier of the export, unique within the project.
+	JobID string
+	// The range of chunk partition times exported, [StartTime, EndTime).
+	StartTime time.Time
+	EndTime   time.Time
+	// The fraction of the chunks exported.
+	SampleFraction float64
+	// The GCS bucket the chunks are exported to.
+	Bucket string
+	// The key identifiers in exported failures are pseudonymised with.
+	RedactionKey []byte
+	// The identity which requested the export.
+	CreatedBy string
+	// The time the export was requested.
+	CreationTime time.Time
+	// The ID of the last chunk considered for export. Empty before the
+	// first page of chunks is read, and state.EndOfTable once all chunks
+	// were considered.
+	LastChunkID string
+	// The numbers of chunks considered for export, and of chunks and
+	// failures exported to date.
+	ChunksScanned    int64
+	ChunksExported   int64
+	FailuresExported int64
+	// The time the manifest and completion marker were written. Zero if the
+	// export is in progress.
+	CompletionTime time.Time
+}
+
+// Completed returns whether the export completed.
+func (j *Job) Completed() bool {
+	return !j.CompletionTime.IsZero()
+}
+
+// scanned returns whether all chunks of the export were considered.
+func (j *Job) scanned() bool {
+	return j.LastChunkID == state.EndOfTable
+}
+
+// Prefix returns the prefix of the names of the objects of the export.
+func (j *Job) Prefix() string {
+	return Prefix(j.Project, j.JobID)
+}
+
+var jobColumns = []string{
+	"Project", "JobId", "StartTime", "EndTime", "SampleFraction", "Bucket",
+	"RedactionKey", "CreatedBy", "CreationTime", "LastChunkId", "ChunksScanned",
+	"ChunksExported", "FailuresExported", "CompletionTime",
+}
+
+// Read reads the given export of a LUCI project. If the export does not
+// exist, the error NotFound is returned.
+func Read(ctx context.Context, project, jobID string) (*Job, error) {
+	row, err := span.ReadRow(ctx, "ChunkExportJobs", spanner.Key{project, jobID}, jobColumns)
+	if spanner.ErrCode(err) == codes.NotFound {
+		return nil, NotFound
+	}
+	if err != nil {
+		return nil, errors.Annotate(err, "read chunk export").Err()
+	}
+	j := &Job{}
+	var completionTime spanner.NullTime
+	err = row.Columns(&j.Project, &j.JobID, &j.StartTime, &j.EndTime, &j.SampleFraction, &j.Bucket,
+		&j.RedactionKey, &j.CreatedBy, &j.CreationTime, &j.LastChunkID, &j.ChunksScanned,
+		&j.ChunksExported, &j.FailuresExported, &completionTime)
+	if err != nil {
+		return nil, errors.Annotate(err, "read chunk export row").Err()
+	}
+	j.CompletionTime = completionTime.Time
+	return j, nil
+}
+
+// Start records a new export of the chunks of a LUCI project to the given
+// GCS bucket and schedules its first task. If MaxRunningJobs exports of the
+// project are in progress, the error ErrTooManyJobs is returned.
+func Start(ctx context.Context, opts Options, bucket, createdBy string) (*Job, error) {
+	jobID, err := randomHex(16)
+	if err != nil {
+		return nil, err
+	}
+	key := make([]byte, 32)
+	if _, err := rand.Read(key); err != nil {
+		return nil, err
+	}
+	var result *Job
+	_, err = span.ReadWriteTransaction(ctx, func(ctx context.Context) error {
+		running, err := countRunning(ctx, opts.Project)
+		if err != nil {
+			return err
+		}
+		if running >= MaxRunningJobs {
+			return ErrTooManyJobs
+		}
+		result = &Job{
+			Project:        opts.Project,
+			JobID:          jobID,
+			StartTime:      opts.StartTime,
+			EndTime:        opts.EndTime,
+			SampleFraction: opts.SampleFraction,
+			Bucket:         bucket,
+			RedactionKey:   key,
+			CreatedBy:      createdBy,
+			CreationTime:   clock.Now(ctx),
+		}
+		span.BufferWrite(ctx, spanutil.InsertMap("ChunkExportJobs", map[string]interface{}{
+			"Project":          result.Project,
+			"JobId":            result.JobID,
+			"StartTime":        result.StartTime,
+			"EndTime":          result.EndTime,
+			"SampleFraction":   result.SampleFraction,
+			"Bucket":           result.Bucket,
+			"RedactionKey":     result.RedactionKey,
+			"CreatedBy":        result.CreatedBy,
+			"CreationTime":     result.CreationTime,
+			"LastChunkId":      "",
+			"ChunksScanned":    int64(0),
+			"ChunksExported":   int64(0),
+			"FailuresExported": int64(0),
+			"CompletionTime":   spanner.NullTime{},
+		}))
+		return Schedule(ctx, result.Project, result.JobID)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// countRunning returns the number of exports of the project in progress.
+func countRunning(ctx context.Context, project string) (int64, error) {
+	stmt := spanner.NewStatement(`
+		SELECT COUNT(*) FROM ChunkExportJobs
+		WHERE Project = @project AND CompletionTime IS NULL`)
+	stmt.Params["project"] = project
+	var count int64
+	err := span.Query(ctx, stmt).Do(func(r *spanner.Row) error {
+		return r.Columns(&count)
+	})
+	if err != nil {
+		return 0, errors.Annotate(err, "count running chunk exports").Err()
+	}
+	return count, nil
+}
+
+// recordPage records the progress of an export after a page of chunks was
+// considered, and the objects written for the page. Must be called in a
+// read/write transaction.
+func recordPage(ctx context.Context, j *Job, objects []*Object) {
+	span.BufferWrite(ctx, spanutil.UpdateMap("ChunkExportJobs", map[string]interface{}{
+		"Project":          j.Project,
+		"JobId":            j.JobID,
+		"LastChunkId":      j.LastChunkID,
+		"ChunksScanned":    j.ChunksScanned,
+		"ChunksExported":   j.ChunksExported,
+		"FailuresExported": j.FailuresExported,
+	}))
+	for _, o := range objects {
+		span.BufferWrite(ctx, spanutil.InsertOrUpdateMap("ChunkExportObjects", map[string]interface{}{
+			"Project":    j.Project,
+			"JobId":      j.JobID,
+			"ChunkId":    o.ChunkID,
+			"ObjectName": o.Name,
+			"Sha256":     o.SHA256,
+			"SizeBytes":  o.SizeBytes,
+			"Failures":   o.Failures,
+		}))
+	}
+}
+
+// recordCompletion records the completion of an export. Must be called in
+// a read/write transaction.
+func recordCompletion(ctx context.Context, j *Job) {
+	span.BufferWrite(ctx, spanutil.UpdateMap("ChunkExportJobs", map[string]interface{}{
+		"Project":        j.Project,
+		"JobId":          j.JobID,
+		"CompletionTime": j.CompletionTime,
+	}))
+}
+
+// ReadObjects reads the objects written by an export to date, ordered by
+// chunk ID.
+func ReadObjects(ctx context.Context, project, jobID string) ([]*Object, error) {
+	stmt := spanner.NewStatement(`
+		SELECT ChunkId, ObjectName, Sha256, SizeBytes, Failures
+		FROM ChunkExportObjects
+		WHERE Project = @project AND JobId = @jobID
+		ORDER BY ChunkId`)
+	stmt.Params["project"] = project
+	stmt.Params["jobID"] = jobID
+	var objects []*Object
+	err := span.Query(ctx, stmt).Do(func(r *spanner.Row) error {
+		o := &Object{}
+		if err := r.Columns(&o.ChunkID, &o.Name, &o.SHA256, &o.SizeBytes, &o.Failures); err != nil {
+			return err
+		}
+		objects = append(objects, o)
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Annotate(err, "read chunk export objects").Err()
+	}
+	return objects, nil
+}
+
+// randomHex returns n random bytes, encoded as 2n lowercase hexadecimal
+// characters.
+func randomHex(n int) (string, error) {
+	randomBytes := make([]byte, n)
+	if _, err := rand.Read(randomBytes); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(randomBytes), nil
+}
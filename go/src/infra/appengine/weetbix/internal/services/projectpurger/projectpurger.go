@@ -0,0 +1,102 @@
+// Copyright 2021 The Chromium Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+// Package projectpurger deletes the data of LUCI projects offboarded from
+// Weetbix.
+//
+// A purge is requested in two steps. A dry run counts the rows to be
+// deleted and issues a confirmation token (IssueToken). Presenting the
+// token confirms the purge (Confirm), which is then carried out by a chain
+// of purge-project tasks, each deleting rows in batches for a short time
+// before scheduling the next.
+package projectpurger
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	"go.chromium.org/luci/common/clock"
+	"go.chromium.org/luci/server"
+	"go.chromium.org/luci/server/tq"
+	_ "go.chromium.org/luci/server/tq/txn/spanner"
+
+	"infra/appengine/weetbix/internal/clustering/chunkstore"
+	"infra/appengine/weetbix/internal/config"
+	"infra/appengine/weetbix/internal/maintenance"
+	"infra/appengine/weetbix/internal/tasks/taskspb"
+)
+
+const (
+	taskClass = "purge-project"
+	queue     = "purge-project"
+
+	// batchSize is the maximum number of rows deleted in each transaction.
+	batchSize = 500
+
+	// taskDuration is the time each task purges for, before it leaves the
+	// rest of the purge to a continuation task.
+	taskDuration = 5 * time.Minute
+)
+
+var tc = tq.RegisterTaskClass(tq.TaskClass{
+	ID:        taskClass,
+	Prototype: &taskspb.PurgeProject{},
+	Queue:     queue,
+	Kind:      tq.FollowsContext,
+})
+
+// RegisterTaskHandler registers the handler for purge-project tasks.
+func RegisterTaskHandler(srv *server.Server) error {
+	ctx := srv.Context
+	cfg, err := config.Get(ctx)
+	if err != nil {
+		return err
+	}
+	chunkStore, err := chunkstore.NewClient(ctx, cfg.ChunkGcsBucket)
+	if err != nil {
+		return err
+	}
+	srv.RegisterCleanup(func(ctx context.Context) {
+		chunkStore.Close()
+	})
+	p := &purger{
+		chunks:       chunkStore,
+		bq:           &bqTables{gcpProject: srv.Options.CloudProject},
+		batchSize:    batchSize,
+		taskDuration: taskDuration,
+	}
+	handler := func(ctx context.Context, payload proto.Message) error {
+		task := payload.(*taskspb.PurgeProject)
+		if deferred, err := maintenance.DeferTask(ctx, task.Project, taskClass, task); err != nil || deferred {
+			return err
+		}
+		return purgeProject(ctx, p, task.Project)
+	}
+	tc.AttachHandler(handler)
+	return nil
+}
+
+// Schedule enqueues a task to continue the purge of a LUCI project. If
+// called in a Spanner read/write transaction, the task is enqueued only if
+// the transaction commits.
+func Schedule(ctx context.Context, project string) error {
+	return tq.AddTask(ctx, &tq.Task{
+		Title:   fmt.Sprintf("%s-%s", project, clock.Now(ctx).Format("20060102-150405")),
+		Payload: &taskspb.PurgeProject{Project: project},
+	})
+}
+
+func purgeProject(ctx context.Context, p *purger, project string) error {
+	done, err := p.run(ctx, project)
+	if err != nil {
+		return err
+	}
+	if !done {
+		return Schedule(ctx, project)
+	}
+	return nil
+}
@@ -0,0 +1,268 @@
+// Copyright 2021 The Chromium Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package projectpurger
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"cloud.google.com/go/spanner"
+	"google.golang.org/api/googleapi"
+
+	"go.chromium.org/luci/common/clock"
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/common/logging"
+	"go.chromium.org/luci/server/span"
+
+	"infra/appengine/weetbix/internal/bqutil"
+	spanutil "infra/appengine/weetbix/internal/span"
+)
+
+// purgeTable is a Spanner table which contains data of LUCI projects.
+type purgeTable struct {
+	// The name of the table.
+	name string
+	// The primary key columns of the table.
+	keyColumns []string
+	// Whether rows belong to a project by their realm, rather than by
+	// their Project column.
+	byRealm bool
+	// Whether rows reference chunks of test results in GCS, by their
+	// ObjectId column.
+	hasChunks bool
+}
+
+// purgeTables are the tables which contain data of LUCI projects, in the
+// order they are purged. Interleaved tables are purged before their
+// parents, to keep the number of rows deleted in each transaction small.
+var purgeTables = []purgeTable{
+	{name: "Verdicts", keyColumns: []string{"Realm", "TestId", "VariantHash", "InvocationId"}, byRealm: true},
+	{name: "AnalyzedTestVariants", keyColumns: []string{"Realm", "TestId", "VariantHash"}, byRealm: true},
+	{name: "PatchsetVerdicts", keyColumns: []string{"Project", "Patchsets", "TestId", "VariantHash", "InvocationId"}},
+	{name: "RecentVerdicts", keyColumns: []string{"Project", "TestId", "VariantHash", "PartitionTime", "IngestedInvocationId"}},
+	{name: "ClusteredFailureExports", keyColumns: []string{"Project", "ChunkId", "CommitTime", "Sequence"}},
+	{name: "ClusteringState", keyColumns: []string{"Project", "ChunkId"}, hasChunks: true},
+	{name: "FailureAssociationRuleHistory", keyColumns: []string{"Project", "RuleId", "ChangeTime", "Action"}},
+	{name: "FailureAssociationRules", keyColumns: []string{"Project", "RuleId"}},
+	{name: "ReclusteringRuns", keyColumns: []string{"Project", "AttemptTimestamp"}},
+	{name: "DeferredBugActions", keyColumns: []string{"Project", "Action", "Subject"}},
+	{name: "ProjectUpdateStatus", keyColumns: []string{"Project"}},
+	{name: "IngestionControl", keyColumns: []string{"BuildId"}},
+	{name: "BackfillJobs", keyColumns: []string{"Project", "JobId"}},
+	{name: "ChunkExportObjects", keyColumns: []string{"Project", "JobId", "ChunkId"}},
+	{name: "ChunkExportJobs", keyColumns: []string{"Project", "JobId"}},
+	{name: "TestVariantBqExportCheckpoints", keyColumns: []string{"Realm", "CloudProject", "Dataset", "TableName"}, byRealm: true},
+}
+
+// projectTables are the tables in the BigQuery dataset of each LUCI
+// project.
+var projectTables = []string{"clustered_failures", "cluster_summaries"}
+
+// whereClause returns the condition selecting rows of the table which
+// belong to a project, given the parameters returned by queryParams.
+func (t purgeTable) whereClause() string {
+	if t.byRealm {
+		return "STARTS_WITH(Realm, @realmPrefix)"
+	}
+	return "Project = @project"
+}
+
+func queryParams(project string) map[string]interface{} {
+	return map[string]interface{}{
+		"project": project,
+		// Realms are of the form "{project}:{realm}".
+		"realmPrefix": project + ":",
+	}
+}
+
+// TableCount is the number of rows of a table which belong to a project.
+type TableCount struct {
+	Table string
+	Rows  int64
+}
+
+// CountRows counts the rows of each Spanner table which belong to the
+// given LUCI project, i.e. the rows which a purge would delete.
+func CountRows(ctx context.Context, project string) ([]*TableCount, error) {
+	ctx, cancel := span.ReadOnlyTransaction(ctx)
+	defer cancel()
+
+	counts := make([]*TableCount, 0, len(purgeTables))
+	for _, t := range purgeTables {
+		stmt := spanner.NewStatement(fmt.Sprintf(`SELECT COUNT(*) FROM %s WHERE %s`, t.name, t.whereClause()))
+		stmt.Params = queryParams(project)
+		var rows int64
+		err := span.Query(ctx, stmt).Do(func(r *spanner.Row) error {
+			return r.Columns(&rows)
+		})
+		if err != nil {
+			return nil, errors.Annotate(err, "count rows of %s", t.name).Err()
+		}
+		counts = append(counts, &TableCount{Table: t.name, Rows: rows})
+	}
+	return counts, nil
+}
+
+// ChunkDeleter deletes chunks of test results from storage.
+type ChunkDeleter interface {
+	// Delete deletes the chunk with the specified object ID. Deleting a
+	// chunk which does not exist is not an error.
+	Delete(ctx context.Context, project, objectID string) error
+}
+
+// BigQueryDeleter deletes the BigQuery data of LUCI projects.
+type BigQueryDeleter interface {
+	// DeleteProjectTables deletes the tables in the dataset of the given
+	// LUCI project. Deleting tables which do not exist is not an error.
+	DeleteProjectTables(ctx context.Context, project string) error
+}
+
+// purger deletes the data of LUCI projects.
+type purger struct {
+	chunks ChunkDeleter
+	bq     BigQueryDeleter
+	// batchSize is the maximum number of rows deleted in each
+	// transaction.
+	batchSize int
+	// taskDuration is the time a task purges for, before it leaves the
+	// rest of the purge to a continuation task.
+	taskDuration time.Duration
+}
+
+// run continues the purge of the given LUCI project. Purges are resumable:
+// each run deletes the rows which remain, so a run may stop at any time
+// and a later run picks up from there. run returns whether the purge
+// completed; if not, another run is required.
+func (p *purger) run(ctx context.Context, project string) (done bool, err error) {
+	purge, err := Read(span.Single(ctx), project)
+	if err == NotFound {
+		logging.Warningf(ctx, "No purge was requested for project %s.", project)
+		return true, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	if !purge.Started() || purge.Completed() {
+		logging.Warningf(ctx, "Purge of project %s is not in progress.", project)
+		return true, nil
+	}
+
+	deadline := clock.Now(ctx).Add(p.taskDuration)
+	for _, t := range purgeTables {
+		for {
+			if !clock.Now(ctx).Before(deadline) {
+				return false, nil
+			}
+			deleted, err := p.deleteBatch(ctx, project, t)
+			if err != nil {
+				return false, errors.Annotate(err, "purge %s", t.name).Err()
+			}
+			if deleted == 0 {
+				break
+			}
+		}
+	}
+	if err := p.bq.DeleteProjectTables(ctx, project); err != nil {
+		return false, errors.Annotate(err, "delete BigQuery tables").Err()
+	}
+	if err := markCompleted(ctx, project); err != nil {
+		return false, err
+	}
+	logging.Infof(ctx, "Purge of project %s completed.", project)
+	return true, nil
+}
+
+// deleteBatch deletes up to batchSize rows of the given table which belong
+// to the given project, and returns the number of rows deleted.
+func (p *purger) deleteBatch(ctx context.Context, project string, t purgeTable) (int, error) {
+	columns := t.keyColumns
+	if t.hasChunks {
+		columns = append(append([]string{}, columns...), "ObjectId")
+	}
+	stmt := spanner.NewStatement(fmt.Sprintf(`SELECT %s FROM %s WHERE %s LIMIT @limit`,
+		strings.Join(columns, ", "), t.name, t.whereClause()))
+	stmt.Params = queryParams(project)
+	stmt.Params["limit"] = p.batchSize
+
+	var keys []spanner.Key
+	var objectIDs []string
+	err := span.Query(span.Single(ctx), stmt).Do(func(r *spanner.Row) error {
+		key, err := spanutil.ReadKey(r, len(t.keyColumns))
+		if err != nil {
+			return err
+		}
+		keys = append(keys, key)
+		if t.hasChunks {
+			var objectID string
+			if err := r.Column(len(t.keyColumns), &objectID); err != nil {
+				return err
+			}
+			objectIDs = append(objectIDs, objectID)
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, errors.Annotate(err, "read keys").Err()
+	}
+	if len(keys) == 0 {
+		return 0, nil
+	}
+
+	// Delete the chunks before the rows referencing them, so that no chunk
+	// is left behind if the purge stops part way.
+	for _, objectID := range objectIDs {
+		if err := p.chunks.Delete(ctx, project, objectID); err != nil {
+			return 0, errors.Annotate(err, "delete chunk").Err()
+		}
+	}
+
+	_, err = span.ReadWriteTransaction(ctx, func(ctx context.Context) error {
+		for _, key := range keys {
+			span.BufferWrite(ctx, spanner.Delete(t.name, key))
+		}
+		return addRowsDeleted(ctx, project, int64(len(keys)))
+	})
+	if err != nil {
+		return 0, errors.Annotate(err, "delete rows").Err()
+	}
+	return len(keys), nil
+}
+
+// bqTables deletes the BigQuery tables of LUCI projects in the Weetbix
+// GCP project.
+type bqTables struct {
+	// gcpProject is the name of the GCP project that contains Weetbix
+	// datasets.
+	gcpProject string
+}
+
+// DeleteProjectTables implements BigQueryDeleter. As each LUCI project
+// has its own dataset, the project's tables are deleted outright rather
+// than by partition.
+func (b *bqTables) DeleteProjectTables(ctx context.Context, project string) error {
+	client, err := bqutil.Client(ctx, b.gcpProject)
+	if err != nil {
+		return errors.Annotate(err, "creating BQ client").Err()
+	}
+	defer client.Close()
+
+	dataset, err := bqutil.DatasetForProject(project)
+	if err != nil {
+		return errors.Annotate(err, "getting dataset").Err()
+	}
+	for _, name := range projectTables {
+		err := client.Dataset(dataset).Table(name).Delete(ctx)
+		if apiErr, ok := err.(*googleapi.Error); ok && apiErr.Code == http.StatusNotFound {
+			continue
+		}
+		if err != nil {
+			return errors.Annotate(err, "delete table %s.%s", dataset, name).Err()
+		}
+	}
+	return nil
+}
@@ -0,0 +1,227 @@
+// Copyright 2021 The Chromium Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package projectpurger
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"go.chromium.org/luci/common/clock/testclock"
+	"go.chromium.org/luci/server/span"
+	"go.chromium.org/luci/server/tq"
+
+	"infra/appengine/weetbix/internal/clustering/chunkstore"
+	cpb "infra/appengine/weetbix/internal/clustering/proto"
+	"infra/appengine/weetbix/internal/clustering/rules"
+	"infra/appengine/weetbix/internal/clustering/state"
+	"infra/appengine/weetbix/internal/tasks/taskspb"
+	"infra/appengine/weetbix/internal/testutil"
+	"infra/appengine/weetbix/internal/testutil/insert"
+	pb "infra/appengine/weetbix/proto/v1"
+
+	. "github.com/smartystreets/goconvey/convey"
+	. "go.chromium.org/luci/common/testing/assertions"
+)
+
+type fakeBigQuery struct {
+	deletedProjects []string
+}
+
+func (f *fakeBigQuery) DeleteProjectTables(ctx context.Context, project string) error {
+	f.deletedProjects = append(f.deletedProjects, project)
+	return nil
+}
+
+func TestPurge(t *testing.T) {
+	Convey(`With Spanner Test Database`, t, func() {
+		ctx := testutil.SpannerTestContext(t)
+		// Use a time representable in Spanner, which stores timestamps
+		// with microsecond precision.
+		now := time.Date(2021, time.December, 1, 12, 0, 0, 0, time.UTC)
+		ctx, tc := testclock.UseTime(ctx, now)
+		ctx, skdr := tq.TestingContext(ctx, nil)
+
+		chunks := chunkstore.NewFakeClient()
+		bq := &fakeBigQuery{}
+		p := &purger{
+			chunks:       chunks,
+			bq:           bq,
+			batchSize:    1,
+			taskDuration: time.Minute,
+		}
+
+		// Set up data for the purged project and another project.
+		var entries []*state.Entry
+		var objectIDs []string
+		for i, project := range []string{"purged", "other"} {
+			objectID, err := chunks.Put(ctx, project, &cpb.Chunk{})
+			So(err, ShouldBeNil)
+			objectIDs = append(objectIDs, objectID)
+			entry := state.NewEntry(i).WithProject(project).Build()
+			entry.ObjectID = objectID
+			entries = append(entries, entry)
+		}
+		_, err := state.CreateEntriesForTesting(ctx, entries)
+		So(err, ShouldBeNil)
+		So(rules.SetRulesForTesting(ctx, []*rules.FailureAssociationRule{
+			rules.NewRule(0).WithProject("purged").Build(),
+			rules.NewRule(1).WithProject("purged").Build(),
+			rules.NewRule(2).WithProject("other").Build(),
+		}), ShouldBeNil)
+		testutil.MustApply(ctx,
+			insert.AnalyzedTestVariant("purged:ci", "ninja://test", "varianthash", pb.AnalyzedTestVariantStatus_FLAKY, nil),
+			insert.Verdict("purged:ci", "ninja://test", "varianthash", "inv-1", pb.VerdictStatus_EXPECTED, tc.Now(), nil),
+			insert.AnalyzedTestVariant("other:ci", "ninja://test", "varianthash", pb.AnalyzedTestVariantStatus_FLAKY, nil),
+			// A realm of a project whose name starts with that of the
+			// purged project.
+			insert.AnalyzedTestVariant("purged-too:ci", "ninja://test", "varianthash", pb.AnalyzedTestVariantStatus_FLAKY, nil),
+		)
+
+		rowCounts := func(project string) map[string]int64 {
+			counts, err := CountRows(ctx, project)
+			So(err, ShouldBeNil)
+			result := make(map[string]int64)
+			for _, c := range counts {
+				if c.Rows > 0 {
+					result[c.Table] = c.Rows
+				}
+			}
+			return result
+		}
+
+		Convey(`CountRows`, func() {
+			So(rowCounts("purged"), ShouldResemble, map[string]int64{
+				"Verdicts":                1,
+				"AnalyzedTestVariants":    1,
+				"ClusteringState":         1,
+				"FailureAssociationRules": 2,
+			})
+			So(rowCounts("other"), ShouldResemble, map[string]int64{
+				"AnalyzedTestVariants":    1,
+				"ClusteringState":         1,
+				"FailureAssociationRules": 1,
+			})
+		})
+		Convey(`IssueToken`, func() {
+			purge, err := IssueToken(ctx, "purged")
+			So(err, ShouldBeNil)
+			So(purge.ConfirmToken, ShouldHaveLength, 32)
+			So(purge.TokenIssueTime, ShouldEqual, tc.Now())
+			So(purge.Started(), ShouldBeFalse)
+
+			read, err := Read(span.Single(ctx), "purged")
+			So(err, ShouldBeNil)
+			So(read, ShouldResemble, purge)
+
+			Convey(`Replaces unconfirmed tokens`, func() {
+				newPurge, err := IssueToken(ctx, "purged")
+				So(err, ShouldBeNil)
+				So(newPurge.ConfirmToken, ShouldNotEqual, purge.ConfirmToken)
+
+				_, err = Confirm(ctx, "purged", purge.ConfirmToken)
+				So(err, ShouldEqual, ErrTokenMismatch)
+			})
+		})
+		Convey(`Confirm`, func() {
+			purge, err := IssueToken(ctx, "purged")
+			So(err, ShouldBeNil)
+
+			Convey(`Without token issued`, func() {
+				_, err := Confirm(ctx, "other", purge.ConfirmToken)
+				So(err, ShouldEqual, NotFound)
+			})
+			Convey(`With mismatched token`, func() {
+				_, err := Confirm(ctx, "purged", "00000000000000000000000000000000")
+				So(err, ShouldEqual, ErrTokenMismatch)
+				So(skdr.Tasks(), ShouldBeEmpty)
+			})
+			Convey(`With expired token`, func() {
+				tc.Add(TokenValidity + time.Second)
+				_, err := Confirm(ctx, "purged", purge.ConfirmToken)
+				So(err, ShouldEqual, ErrTokenExpired)
+				So(skdr.Tasks(), ShouldBeEmpty)
+			})
+			Convey(`With valid token`, func() {
+				confirmed, err := Confirm(ctx, "purged", purge.ConfirmToken)
+				So(err, ShouldBeNil)
+				So(confirmed.StartTime, ShouldEqual, tc.Now())
+				So(skdr.Tasks().Payloads(), ShouldResembleProto, []*taskspb.PurgeProject{{Project: "purged"}})
+
+				Convey(`Is idempotent`, func() {
+					tc.Add(TokenValidity + time.Second)
+					again, err := Confirm(ctx, "purged", purge.ConfirmToken)
+					So(err, ShouldBeNil)
+					So(again, ShouldResemble, confirmed)
+					So(skdr.Tasks(), ShouldHaveLength, 1)
+				})
+				Convey(`Tokens are not issued while in progress`, func() {
+					inProgress, err := IssueToken(ctx, "purged")
+					So(err, ShouldBeNil)
+					So(inProgress, ShouldResemble, confirmed)
+				})
+			})
+		})
+		Convey(`Run`, func() {
+			Convey(`Without confirmed purge`, func() {
+				_, err := IssueToken(ctx, "purged")
+				So(err, ShouldBeNil)
+
+				done, err := p.run(ctx, "purged")
+				So(err, ShouldBeNil)
+				So(done, ShouldBeTrue)
+				So(rowCounts("purged"), ShouldHaveLength, 4)
+			})
+			Convey(`With confirmed purge`, func() {
+				purge, err := IssueToken(ctx, "purged")
+				So(err, ShouldBeNil)
+				_, err = Confirm(ctx, "purged", purge.ConfirmToken)
+				So(err, ShouldBeNil)
+
+				expectPurged := func() {
+					So(rowCounts("purged"), ShouldBeEmpty)
+					So(rowCounts("other"), ShouldResemble, map[string]int64{
+						"AnalyzedTestVariants":    1,
+						"ClusteringState":         1,
+						"FailureAssociationRules": 1,
+					})
+					So(rowCounts("purged-too"), ShouldResemble, map[string]int64{
+						"AnalyzedTestVariants": 1,
+					})
+					So(chunks.Contents, ShouldNotContainKey, chunkstore.FileName("purged", objectIDs[0]))
+					So(chunks.Contents, ShouldContainKey, chunkstore.FileName("other", objectIDs[1]))
+					So(bq.deletedProjects, ShouldResemble, []string{"purged"})
+
+					read, err := Read(span.Single(ctx), "purged")
+					So(err, ShouldBeNil)
+					So(read.RowsDeleted, ShouldEqual, 5)
+					So(read.CompletionTime, ShouldEqual, tc.Now())
+				}
+
+				Convey(`In one run`, func() {
+					done, err := p.run(ctx, "purged")
+					So(err, ShouldBeNil)
+					So(done, ShouldBeTrue)
+					expectPurged()
+				})
+				Convey(`Resumed after deadline`, func() {
+					// A run whose deadline has passed leaves the purge to
+					// the next run.
+					p.taskDuration = 0
+					done, err := p.run(ctx, "purged")
+					So(err, ShouldBeNil)
+					So(done, ShouldBeFalse)
+					So(rowCounts("purged"), ShouldHaveLength, 4)
+
+					p.taskDuration = time.Minute
+					done, err = p.run(ctx, "purged")
+					So(err, ShouldBeNil)
+					So(done, ShouldBeTrue)
+					expectPurged()
+				})
+			})
+		})
+	})
+}
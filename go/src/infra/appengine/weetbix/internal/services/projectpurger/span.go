@@ -0,0 +1,200 @@
+// Copyright 2021 The Chromium Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package projectpurger
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"time"
+
+	"cloud.google.com/go/spanner"
+	"google.golang.org/grpc/codes"
+
+	"go.chromium.org/luci/common/clock"
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/server/span"
+
+	spanutil "infra/appengine/weetbix/internal/span"
+)
+
+// TokenValidity is the time a confirmation token may be used to confirm
+// a purge after it was issued.
+const TokenValidity = time.Hour
+
+var (
+	// NotFound is the error returned by Read if no purge was requested
+	// for the project.
+	NotFound = errors.New("project purge not found")
+	// ErrTokenMismatch is returned by Confirm if the confirmation token
+	// is not the last token issued for the project.
+	ErrTokenMismatch = errors.New("confirmation token does not match the last token issued for the project")
+	// ErrTokenExpired is returned by Confirm if the confirmation token
+	// has expired.
+	ErrTokenExpired = errors.New("confirmation token has expired")
+)
+
+// Purge is the record of a request to delete the data of a LUCI
+// project.
+type Purge struct {
+	// The LUCI Project.
+	Project string
+	// The token which must be presented to confirm the purge.
+	ConfirmToken string
+	// The time the confirmation token was issued.
+	TokenIssueTime time.Time
+	// The time the purge was confirmed and started. Zero if the purge has
+	// not been confirmed.
+	StartTime time.Time
+	// The time the purge completed. Zero if the purge has not completed.
+	CompletionTime time.Time
+	// The number of Spanner rows deleted to date.
+	RowsDeleted int64
+}
+
+// Started returns whether the purge was confirmed.
+func (p *Purge) Started() bool {
+	return !p.StartTime.IsZero()
+}
+
+// Completed returns whether the purge has completed.
+func (p *Purge) Completed() bool {
+	return !p.CompletionTime.IsZero()
+}
+
+var purgeColumns = []string{
+	"Project", "ConfirmToken", "TokenIssueTime", "StartTime", "CompletionTime", "RowsDeleted",
+}
+
+// Read reads the purge of the given LUCI project. If no purge was
+// requested for the project, the error NotFound is returned.
+func Read(ctx context.Context, project string) (*Purge, error) {
+	row, err := span.ReadRow(ctx, "ProjectPurges", spanner.Key{project}, purgeColumns)
+	if spanner.ErrCode(err) == codes.NotFound {
+		return nil, NotFound
+	}
+	if err != nil {
+		return nil, errors.Annotate(err, "read project purge").Err()
+	}
+	p := &Purge{}
+	var startTime, completionTime spanner.NullTime
+	err = row.Columns(&p.Project, &p.ConfirmToken, &p.TokenIssueTime, &startTime, &completionTime, &p.RowsDeleted)
+	if err != nil {
+		return nil, errors.Annotate(err, "read project purge row").Err()
+	}
+	p.StartTime = startTime.Time
+	p.CompletionTime = completionTime.Time
+	return p, nil
+}
+
+// IssueToken issues a new confirmation token for the purge of the given
+// LUCI project. If a purge of the project is in progress, no token is
+// issued and the purge in progress is returned.
+func IssueToken(ctx context.Context, project string) (*Purge, error) {
+	token, err := generateToken()
+	if err != nil {
+		return nil, err
+	}
+	var result *Purge
+	_, err = span.ReadWriteTransaction(ctx, func(ctx context.Context) error {
+		p, err := Read(ctx, project)
+		if err != nil && err != NotFound {
+			return err
+		}
+		if err == nil && p.Started() && !p.Completed() {
+			result = p
+			return nil
+		}
+		// Replace any purge which completed, so the data of the project
+		// may be purged again.
+		result = &Purge{
+			Project:        project,
+			ConfirmToken:   token,
+			TokenIssueTime: clock.Now(ctx),
+		}
+		span.BufferWrite(ctx, spanutil.InsertOrUpdateMap("ProjectPurges", map[string]interface{}{
+			"Project":        result.Project,
+			"ConfirmToken":   result.ConfirmToken,
+			"TokenIssueTime": result.TokenIssueTime,
+			"StartTime":      spanner.NullTime{},
+			"CompletionTime": spanner.NullTime{},
+			"RowsDeleted":    int64(0),
+		}))
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// Confirm confirms the purge of the given LUCI project with the given
+// confirmation token, and schedules the purge. Confirming a purge which
+// was already confirmed with the same token has no effect.
+func Confirm(ctx context.Context, project, token string) (*Purge, error) {
+	var result *Purge
+	_, err := span.ReadWriteTransaction(ctx, func(ctx context.Context) error {
+		p, err := Read(ctx, project)
+		if err != nil {
+			return err
+		}
+		if p.ConfirmToken != token {
+			return ErrTokenMismatch
+		}
+		result = p
+		if p.Started() {
+			return nil
+		}
+		if clock.Now(ctx).After(p.TokenIssueTime.Add(TokenValidity)) {
+			return ErrTokenExpired
+		}
+		p.StartTime = clock.Now(ctx)
+		span.BufferWrite(ctx, spanutil.UpdateMap("ProjectPurges", map[string]interface{}{
+			"Project":   p.Project,
+			"StartTime": p.StartTime,
+		}))
+		return Schedule(ctx, project)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// addRowsDeleted adds to the number of rows deleted by the purge of the
+// given project. Must be called in a read/write transaction.
+func addRowsDeleted(ctx context.Context, project string, rows int64) error {
+	p, err := Read(ctx, project)
+	if err != nil {
+		return err
+	}
+	span.BufferWrite(ctx, spanutil.UpdateMap("ProjectPurges", map[string]interface{}{
+		"Project":     project,
+		"RowsDeleted": p.RowsDeleted + rows,
+	}))
+	return nil
+}
+
+// markCompleted records the completion of the purge of the given project.
+func markCompleted(ctx context.Context, project string) error {
+	ms := spanutil.UpdateMap("ProjectPurges", map[string]interface{}{
+		"Project":        project,
+		"CompletionTime": clock.Now(ctx),
+	})
+	if _, err := span.Apply(ctx, []*spanner.Mutation{ms}); err != nil {
+		return errors.Annotate(err, "record project purge completion").Err()
+	}
+	return nil
+}
+
+// generateToken returns a random 128-bit confirmation token, encoded as
+// 32 lowercase hexadecimal characters.
+func generateToken() (string, error) {
+	randomBytes := make([]byte, 16)
+	if _, err := rand.Read(randomBytes); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(randomBytes), nil
+}
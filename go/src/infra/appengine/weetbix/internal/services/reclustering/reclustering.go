@@ -19,6 +19,7 @@ import (
 	"infra/appengine/weetbix/internal/clustering/chunkstore"
 	"infra/appengine/weetbix/internal/clustering/reclustering"
 	"infra/appengine/weetbix/internal/config"
+	"infra/appengine/weetbix/internal/maintenance"
 	"infra/appengine/weetbix/internal/tasks/taskspb"
 )
 
@@ -49,11 +50,17 @@ func RegisterTaskHandler(srv *server.Server) error {
 		chunkStore.Close()
 	})
 	cf := clusteredfailures.NewClient(srv.Options.CloudProject)
+	// Add new columns to the exported tables before rows using them are
+	// exported.
+	srv.RunInBackground("weetbix.clustered-failures-schema", cf.EnsureTables)
 	analysis := analysis.NewClusteringHandler(cf)
 	worker := reclustering.NewWorker(chunkStore, analysis)
 
 	handler := func(ctx context.Context, payload proto.Message) error {
 		task := payload.(*taskspb.ReclusterChunks)
+		if deferred, err := maintenance.DeferTask(ctx, task.Project, taskClass, task); err != nil || deferred {
+			return err
+		}
 		return reclusterTestResults(ctx, worker, task)
 	}
 	tc.AttachHandler(handler)
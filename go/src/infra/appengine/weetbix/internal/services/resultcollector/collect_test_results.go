@@ -7,6 +7,7 @@ package resultcollector
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"golang.org/x/sync/errgroup"
 	"golang.org/x/sync/semaphore"
@@ -17,6 +18,7 @@ import (
 	"go.chromium.org/luci/server/tq"
 
 	"infra/appengine/weetbix/internal/analyzedtestvariants"
+	"infra/appengine/weetbix/internal/maintenance"
 	"infra/appengine/weetbix/internal/resultdb"
 	"infra/appengine/weetbix/internal/tasks/taskspb"
 	pb "infra/appengine/weetbix/proto/v1"
@@ -38,6 +40,10 @@ func RegisterTaskClass() {
 		Kind:      tq.NonTransactional,
 		Handler: func(ctx context.Context, payload proto.Message) error {
 			task := payload.(*taskspb.CollectTestResults)
+			project := strings.SplitN(task.Resultdb.GetInvocation().GetRealm(), ":", 2)[0]
+			if deferred, err := maintenance.DeferTask(ctx, project, taskClass, task); err != nil || deferred {
+				return err
+			}
 			return collectTestResults(ctx, task)
 		},
 	})
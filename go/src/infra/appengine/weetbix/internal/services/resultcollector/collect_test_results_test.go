@@ -189,5 +189,25 @@ func TestSaveVerdicts(t *testing.T) {
 		So(err, ShouldBeNil)
 		So(total, ShouldEqual, 3)
 
+		// The flakiness scores of the test variants are marked stale.
+		stale := 0
+		ks = spanner.KeySets(
+			spanner.Key{realm, "ninja://test_known_flake", vh},
+			spanner.Key{realm, "ninja://test_consistent_failure", vh},
+			spanner.Key{realm, "ninja://test_has_unexpected", vh},
+			spanner.Key{realm, "ninja://test_no_new_results", vh},
+		)
+		err = span.Read(ctx, "AnalyzedTestVariants", ks, []string{"FlakinessScoreStale"}).Do(
+			func(row *spanner.Row) error {
+				var isStale spanner.NullBool
+				So(row.Column(0, &isStale), ShouldBeNil)
+				if isStale.Valid && isStale.Bool {
+					stale++
+				}
+				return nil
+			},
+		)
+		So(err, ShouldBeNil)
+		So(stale, ShouldEqual, 3)
 	})
 }
@@ -21,7 +21,7 @@ import (
 )
 
 func createVerdicts(ctx context.Context, task *taskspb.CollectTestResults, tvs []*rdbpb.TestVariant) error {
-	ms := make([]*spanner.Mutation, 0, len(tvs))
+	ms := make([]*spanner.Mutation, 0, 2*len(tvs))
 	// Each batch of verdicts use the same ingestion time.
 	now := clock.Now(ctx)
 	for _, tv := range tvs {
@@ -32,7 +32,7 @@ func createVerdicts(ctx context.Context, task *taskspb.CollectTestResults, tvs [
 		if m == nil {
 			continue
 		}
-		ms = append(ms, m)
+		ms = append(ms, m, markFlakinessScoreStale(task.Resultdb.Invocation.Realm, tv))
 	}
 	_, err := span.ReadWriteTransaction(ctx, func(ctx context.Context) error {
 		span.BufferWrite(ctx, ms...)
@@ -70,6 +70,20 @@ func insertVerdict(task *taskspb.CollectTestResults, tv *rdbpb.TestVariant, inge
 	return spanner.InsertOrUpdateMap("Verdicts", spanutil.ToSpannerMap(row))
 }
 
+// markFlakinessScoreStale returns a mutation to mark the flakiness score of
+// the test variant for recomputation, because it has a new verdict. The test
+// variant is also marked as modified, so that the next incremental BigQuery
+// export exports the new verdict.
+func markFlakinessScoreStale(realm string, tv *rdbpb.TestVariant) *spanner.Mutation {
+	return spanutil.UpdateMap("AnalyzedTestVariants", map[string]interface{}{
+		"Realm":               realm,
+		"TestId":              tv.TestId,
+		"VariantHash":         tv.VariantHash,
+		"FlakinessScoreStale": true,
+		"LastModified":        spanner.CommitTimestamp,
+	})
+}
+
 func deriveVerdictStatus(tv *rdbpb.TestVariant) pb.VerdictStatus {
 	switch tv.Status {
 	case rdbpb.TestVariantStatus_FLAKY:
@@ -121,10 +121,11 @@ func createOrUpdateAnalyzedTestVariants(ctx context.Context, realm, builder stri
 
 				if ns != atv.Status {
 					vals := map[string]interface{}{
-						"Realm":       atv.Realm,
-						"TestId":      atv.TestId,
-						"VariantHash": atv.VariantHash,
-						"Status":      int64(ns),
+						"Realm":        atv.Realm,
+						"TestId":       atv.TestId,
+						"VariantHash":  atv.VariantHash,
+						"Status":       int64(ns),
+						"LastModified": spanner.CommitTimestamp,
 					}
 					if atv.Status == pb.AnalyzedTestVariantStatus_CONSISTENTLY_EXPECTED || atv.Status == pb.AnalyzedTestVariantStatus_NO_NEW_RESULTS {
 						// The test variant starts to have unexpected failures again, need
@@ -191,6 +192,7 @@ func insertRow(ctx context.Context, realm, builder string, tv *rdbpb.TestVariant
 		"Builder":                   builder,
 		"Tags":                      extractLocationTags(tv),
 		"NextUpdateTaskEnqueueTime": now,
+		"LastModified":              spanner.CommitTimestamp,
 	}
 	if tv.TestMetadata != nil {
 		tmd, err := proto.Marshal(pbutil.TestMetadataFromResultDB(tv.TestMetadata))
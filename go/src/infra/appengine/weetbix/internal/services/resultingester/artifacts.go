@@ -0,0 +1,114 @@
+// Copyright 2021 The Chromium Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package resultingester
+
+import (
+	"context"
+	"regexp"
+	"sort"
+
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/common/tsmon/field"
+	"go.chromium.org/luci/common/tsmon/metric"
+	rdbpb "go.chromium.org/luci/resultdb/proto/v1"
+
+	"infra/appengine/weetbix/internal/config"
+	"infra/appengine/weetbix/internal/resultdb"
+	pb "infra/appengine/weetbix/proto/v1"
+)
+
+// maxArtifactsPerResult is the maximum number of artifacts linked to from
+// each failed test result. The links are stored with every clustered
+// failure, so this bounds the growth of storage.
+const maxArtifactsPerResult = 5
+
+// maxArtifactPages is the maximum number of pages of artifacts to read from
+// ResultDB, per build. The page size is 1000 artifacts.
+const maxArtifactPages = 10
+
+var truncatedArtifactLinksCounter = metric.NewCounter(
+	"weetbix/ingestion/truncated_artifact_links",
+	"The number of test results which had more artifacts to link to than are stored, by LUCI Project.",
+	nil,
+	// The LUCI Project.
+	field.String("project"))
+
+// artifactResultRe extracts the name of the test result from the name of
+// a test result artifact. It does not match invocation-level artifacts.
+var artifactResultRe = regexp.MustCompile(`^(invocations/[^/]+/tests/[^/]+/results/[^/]+)/artifacts/.+$`)
+
+// artifactIDsToLink returns the IDs of the test result artifacts the LUCI
+// project has configured to link to from clustered failures.
+func artifactIDsToLink(ctx context.Context, project string) ([]string, error) {
+	cfgs, err := config.Projects(ctx)
+	if err != nil {
+		return nil, errors.Annotate(err, "read project configs").Err()
+	}
+	return cfgs[project].GetArtifactLinks().GetArtifactIds(), nil
+}
+
+// queryArtifactLinks returns links to the artifacts with the given IDs of
+// the test results in the invocation, keyed by test result name. Only the
+// artifacts of test variants with unexpected results are read.
+//
+// Artifacts of each test result are ordered by the position of their ID in
+// artifactIDs, and at most maxArtifactsPerResult are returned.
+func queryArtifactLinks(ctx context.Context, rc *resultdb.Client, project, invName string, artifactIDs []string) (map[string][]*pb.ArtifactLink, error) {
+	if len(artifactIDs) == 0 {
+		return nil, nil
+	}
+	order := make(map[string]int)
+	for i, id := range artifactIDs {
+		order[id] = i
+	}
+
+	links := make(map[string][]*pb.ArtifactLink)
+	predicate := &rdbpb.ArtifactPredicate{
+		FollowEdges: &rdbpb.ArtifactPredicate_EdgeTypeSet{
+			TestResults: true,
+		},
+		TestResultPredicate: &rdbpb.TestResultPredicate{
+			Expectancy: rdbpb.TestResultPredicate_VARIANTS_WITH_UNEXPECTED_RESULTS,
+		},
+	}
+	f := func(artifacts []*rdbpb.Artifact) error {
+		for _, a := range artifacts {
+			if _, ok := order[a.ArtifactId]; !ok {
+				continue
+			}
+			match := artifactResultRe.FindStringSubmatch(a.Name)
+			if match == nil {
+				continue
+			}
+			links[match[1]] = append(links[match[1]], &pb.ArtifactLink{
+				System:      "resultdb",
+				Name:        a.Name,
+				ArtifactId:  a.ArtifactId,
+				ContentType: a.ContentType,
+				SizeBytes:   a.SizeBytes,
+			})
+		}
+		return nil
+	}
+	if err := rc.QueryArtifacts(ctx, invName, predicate, f, maxArtifactPages); err != nil {
+		return nil, errors.Annotate(err, "query artifacts").Err()
+	}
+
+	for name, ls := range links {
+		// Order artifacts deterministically, so that the chunks written by
+		// a retried ingestion are the same.
+		sort.Slice(ls, func(i, j int) bool {
+			if order[ls[i].ArtifactId] != order[ls[j].ArtifactId] {
+				return order[ls[i].ArtifactId] < order[ls[j].ArtifactId]
+			}
+			return ls[i].Name < ls[j].Name
+		})
+		if len(ls) > maxArtifactsPerResult {
+			truncatedArtifactLinksCounter.Add(ctx, 1, project)
+			links[name] = ls[:maxArtifactsPerResult]
+		}
+	}
+	return links, nil
+}
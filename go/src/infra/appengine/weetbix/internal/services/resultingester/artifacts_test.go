@@ -0,0 +1,113 @@
+// Copyright 2021 The Chromium Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package resultingester
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+
+	"go.chromium.org/luci/common/tsmon"
+	rdbpb "go.chromium.org/luci/resultdb/proto/v1"
+
+	"infra/appengine/weetbix/internal/resultdb"
+	pb "infra/appengine/weetbix/proto/v1"
+
+	. "github.com/smartystreets/goconvey/convey"
+	. "go.chromium.org/luci/common/testing/assertions"
+)
+
+func TestQueryArtifactLinks(t *testing.T) {
+	t.Parallel()
+	Convey(`queryArtifactLinks`, t, func() {
+		ctx, _ := tsmon.WithDummyInMemory(context.Background())
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		mrc := resultdb.NewMockedClient(ctx, ctl)
+		ctx = mrc.Ctx
+		rc, err := resultdb.NewClient(ctx, "results.api.cr.dev")
+		So(err, ShouldBeNil)
+
+		const inv = "invocations/build-87654321"
+		const result = "invocations/task-1/tests/ninja:%2F%2Ftest/results/one"
+		artifact := func(resultName, artifactID string) *rdbpb.Artifact {
+			return &rdbpb.Artifact{
+				Name:        resultName + "/artifacts/" + artifactID,
+				ArtifactId:  artifactID,
+				ContentType: "text/plain",
+				SizeBytes:   10,
+			}
+		}
+		link := func(resultName, artifactID string) *pb.ArtifactLink {
+			return &pb.ArtifactLink{
+				System:      "resultdb",
+				Name:        resultName + "/artifacts/" + artifactID,
+				ArtifactId:  artifactID,
+				ContentType: "text/plain",
+				SizeBytes:   10,
+			}
+		}
+		expectQuery := func(artifacts ...*rdbpb.Artifact) {
+			mrc.QueryArtifacts(&rdbpb.QueryArtifactsRequest{
+				Invocations: []string{inv},
+				Predicate: &rdbpb.ArtifactPredicate{
+					FollowEdges: &rdbpb.ArtifactPredicate_EdgeTypeSet{
+						TestResults: true,
+					},
+					TestResultPredicate: &rdbpb.TestResultPredicate{
+						Expectancy: rdbpb.TestResultPredicate_VARIANTS_WITH_UNEXPECTED_RESULTS,
+					},
+				},
+				PageSize: 1000,
+			}, &rdbpb.QueryArtifactsResponse{Artifacts: artifacts})
+		}
+
+		Convey(`Without artifact IDs`, func() {
+			links, err := queryArtifactLinks(ctx, rc, "chromium", inv, nil)
+			So(err, ShouldBeNil)
+			So(links, ShouldBeNil)
+		})
+		Convey(`Links configured artifacts`, func() {
+			const otherResult = "invocations/task-2/tests/ninja:%2F%2Ftest/results/two"
+			expectQuery(
+				artifact(result, "stack_trace"),
+				artifact(result, "screenshot"),
+				artifact(result, "snippet"),
+				artifact(otherResult, "snippet"),
+				&rdbpb.Artifact{Name: "invocations/task-1/artifacts/snippet", ArtifactId: "snippet"},
+			)
+
+			links, err := queryArtifactLinks(ctx, rc, "chromium", inv, []string{"snippet", "stack_trace"})
+			So(err, ShouldBeNil)
+			So(links, ShouldHaveLength, 2)
+			So(links[result], ShouldResembleProto, []*pb.ArtifactLink{
+				link(result, "snippet"),
+				link(result, "stack_trace"),
+			})
+			So(links[otherResult], ShouldResembleProto, []*pb.ArtifactLink{
+				link(otherResult, "snippet"),
+			})
+			So(truncatedArtifactLinksCounter.Get(ctx, "chromium"), ShouldEqual, 0)
+		})
+		Convey(`Truncates artifacts`, func() {
+			var artifacts []*rdbpb.Artifact
+			var artifactIDs []string
+			for i := maxArtifactsPerResult; i >= 0; i-- {
+				id := fmt.Sprintf("artifact-%v", i)
+				artifacts = append(artifacts, artifact(result, id))
+				artifactIDs = append([]string{id}, artifactIDs...)
+			}
+			expectQuery(artifacts...)
+
+			links, err := queryArtifactLinks(ctx, rc, "chromium", inv, artifactIDs)
+			So(err, ShouldBeNil)
+			So(links[result], ShouldHaveLength, maxArtifactsPerResult)
+			So(links[result][0], ShouldResembleProto, link(result, "artifact-0"))
+			So(truncatedArtifactLinksCounter.Get(ctx, "chromium"), ShouldEqual, 1)
+		})
+	})
+}
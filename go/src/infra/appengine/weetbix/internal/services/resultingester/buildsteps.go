@@ -0,0 +1,56 @@
+// Copyright 2021 The Chromium Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package resultingester
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	bbpb "go.chromium.org/luci/buildbucket/proto"
+	"go.chromium.org/luci/common/errors"
+
+	"infra/appengine/weetbix/internal/buildbucket"
+	"infra/appengine/weetbix/internal/config"
+)
+
+// buildFailuresConfig returns the configuration of build step failure
+// ingestion of the LUCI project, or nil if the project does not ingest
+// build step failures.
+func buildFailuresConfig(ctx context.Context, project string) (*config.BuildFailures, error) {
+	cfgs, err := config.Projects(ctx)
+	if err != nil {
+		return nil, errors.Annotate(err, "read project configs").Err()
+	}
+	return cfgs[project].GetBuildFailures(), nil
+}
+
+// testStepMatcher returns a function which returns whether a step name
+// matches any of the given patterns. Patterns must match the whole step
+// name.
+func testStepMatcher(patterns []string) (func(stepName string) bool, error) {
+	if len(patterns) == 0 {
+		return func(string) bool { return false }, nil
+	}
+	anchored := make([]string, 0, len(patterns))
+	for _, p := range patterns {
+		anchored = append(anchored, fmt.Sprintf("(?:%s)", p))
+	}
+	re, err := regexp.Compile(fmt.Sprintf("^(?:%s)$", strings.Join(anchored, "|")))
+	if err != nil {
+		return nil, errors.Annotate(err, "compile test step patterns").Err()
+	}
+	return re.MatchString, nil
+}
+
+// buildStatusAndSteps reads the status and the steps of the build.
+func buildStatusAndSteps(ctx context.Context, bbHost string, bID int64) (*bbpb.Build, error) {
+	bc, err := buildbucket.NewClient(ctx, bbHost)
+	if err != nil {
+		return nil, err
+	}
+	return bc.GetBuildWithStatusAndSteps(ctx, bID)
+}
@@ -0,0 +1,90 @@
+// Copyright 2021 The Chromium Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package resultingester
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"cloud.google.com/go/spanner"
+
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/server/span"
+
+	spanutil "infra/appengine/weetbix/internal/span"
+)
+
+// controlBuildID returns the key of a build in the IngestionControl table.
+func controlBuildID(host string, id int64) string {
+	return fmt.Sprintf("%s/%d", host, id)
+}
+
+// recordIngested records in the IngestionControl table that the test
+// results of the build were ingested into the given LUCI project.
+func recordIngested(ctx context.Context, host string, id int64, project string) error {
+	m := spanutil.InsertOrUpdateMap("IngestionControl", map[string]interface{}{
+		"BuildId":       controlBuildID(host, id),
+		"Project":       project,
+		"IngestionTime": spanner.CommitTimestamp,
+	})
+	if _, err := span.Apply(ctx, []*spanner.Mutation{m}); err != nil {
+		return errors.Annotate(err, "record ingestion of build %s-%d", host, id).Err()
+	}
+	return nil
+}
+
+// ReadIngested returns the IDs of the builds of the given Buildbucket host
+// whose test results were ingested, out of the given builds.
+func ReadIngested(ctx context.Context, host string, ids []int64) (map[int64]bool, error) {
+	keys := make([]spanner.KeySet, 0, len(ids))
+	byKey := make(map[string]int64, len(ids))
+	for _, id := range ids {
+		k := controlBuildID(host, id)
+		keys = append(keys, spanner.Key{k})
+		byKey[k] = id
+	}
+
+	result := make(map[int64]bool)
+	err := span.Read(ctx, "IngestionControl", spanner.KeySets(keys...), []string{"BuildId"}).Do(
+		func(row *spanner.Row) error {
+			var k string
+			if err := row.Columns(&k); err != nil {
+				return err
+			}
+			result[byKey[k]] = true
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, errors.Annotate(err, "read ingestion control").Err()
+	}
+	return result, nil
+}
+
+// ReadLastIngestionTime returns the time the test results of a build were
+// last ingested into the given LUCI project, or the zero time if no test
+// results were ever ingested into it.
+func ReadLastIngestionTime(ctx context.Context, project string) (time.Time, error) {
+	stmt := spanner.NewStatement(`
+		SELECT MAX(IngestionTime)
+		FROM IngestionControl@{FORCE_INDEX=IngestionControlByProjectAndIngestionTime}
+		WHERE Project = @project
+	`)
+	stmt.Params = map[string]interface{}{
+		"project": project,
+	}
+	var lastIngestion spanner.NullTime
+	err := span.Query(ctx, stmt).Do(func(row *spanner.Row) error {
+		return row.Columns(&lastIngestion)
+	})
+	if err != nil {
+		return time.Time{}, errors.Annotate(err, "query last ingestion time").Err()
+	}
+	if !lastIngestion.Valid {
+		return time.Time{}, nil
+	}
+	return lastIngestion.Time, nil
+}
@@ -0,0 +1,190 @@
+// Copyright 2021 The Chromium Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package resultingester
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"cloud.google.com/go/spanner"
+
+	"go.chromium.org/luci/common/clock"
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/common/tsmon/field"
+	"go.chromium.org/luci/common/tsmon/metric"
+	cvv0 "go.chromium.org/luci/cv/api/v0"
+	rdbpb "go.chromium.org/luci/resultdb/proto/v1"
+	"go.chromium.org/luci/server/span"
+
+	spanutil "infra/appengine/weetbix/internal/span"
+)
+
+// duplicateWindow is the period after a verdict is ingested during which
+// an identical verdict from another build of the same patchsets is
+// considered a duplicate of it. CQ retries builds within minutes to hours.
+const duplicateWindow = 24 * time.Hour
+
+var patchsetVerdictsCounter = metric.NewCounter(
+	"weetbix/ingestion/patchset_verdicts",
+	"The number of verdicts ingested from presubmit builds, by LUCI Project and whether they duplicate a verdict from an earlier build of the same patchsets.",
+	nil,
+	// The LUCI Project.
+	field.String("project"),
+	field.Bool("is_duplicate"))
+
+// patchsetVerdict is a verdict recorded in the PatchsetVerdicts table.
+type patchsetVerdict struct {
+	InvocationID          string
+	Status                rdbpb.TestVariantStatus
+	UnexpectedResultCount int64
+	TotalResultCount      int64
+	IngestionTime         time.Time
+}
+
+// sameVerdict returns whether the two verdicts have the same outcome.
+// Verdicts with different outcomes across retries, e.g. a failure which
+// became flaky, are never duplicates.
+func (v *patchsetVerdict) sameVerdict(o *patchsetVerdict) bool {
+	return v.Status == o.Status &&
+		v.UnexpectedResultCount == o.UnexpectedResultCount &&
+		v.TotalResultCount == o.TotalResultCount
+}
+
+// patchsetsKey returns the key of the patchsets tested by a CV run, or
+// "" if the run has no CLs.
+func patchsetsKey(run *cvv0.Run) string {
+	cls := make([]string, 0, len(run.GetCls()))
+	for _, cl := range run.GetCls() {
+		cls = append(cls, fmt.Sprintf("%s/%d/%d", cl.Host, cl.Change, cl.Patchset))
+	}
+	sort.Strings(cls)
+	return strings.Join(cls, ",")
+}
+
+// verdictFromTestVariant returns the verdict of a test variant ingested
+// from the given invocation.
+func verdictFromTestVariant(invocationID string, tv *rdbpb.TestVariant) *patchsetVerdict {
+	v := &patchsetVerdict{
+		InvocationID: invocationID,
+		Status:       tv.Status,
+	}
+	for _, trb := range tv.Results {
+		tr := trb.Result
+		if tr.Status == rdbpb.TestStatus_SKIP {
+			continue
+		}
+		v.TotalResultCount++
+		if !tr.Expected {
+			v.UnexpectedResultCount++
+		}
+	}
+	return v
+}
+
+// identifyDuplicateVerdicts records the verdicts of the test variants
+// ingested from an invocation testing the given patchsets, and returns
+// the test variants whose verdict duplicates one ingested from another
+// invocation of the same patchsets within duplicateWindow.
+//
+// A verdict is only compared to verdicts ingested before it, so that if
+// ingestion of an invocation is retried, its verdicts are identified the
+// same way.
+func identifyDuplicateVerdicts(ctx context.Context, project, patchsets, invocationID string, tvs []*rdbpb.TestVariant) (map[testVariantKey]bool, error) {
+	var duplicates map[testVariantKey]bool
+	_, err := span.ReadWriteTransaction(ctx, func(ctx context.Context) error {
+		duplicates = make(map[testVariantKey]bool)
+		existing, err := readPatchsetVerdicts(ctx, project, patchsets, tvs)
+		if err != nil {
+			return err
+		}
+
+		now := clock.Now(ctx)
+		ms := make([]*spanner.Mutation, 0, len(tvs))
+		for _, tv := range tvs {
+			k := testVariantKey{tv.TestId, tv.VariantHash}
+			v := verdictFromTestVariant(invocationID, tv)
+
+			// If the verdict was not ingested before, it will be ingested
+			// at the commit timestamp, which is after that of all verdicts
+			// read.
+			ingestionTime := now
+			own, ingested := existing[k][invocationID]
+			if ingested {
+				ingestionTime = own.IngestionTime
+			} else {
+				ms = append(ms, spanutil.InsertMap("PatchsetVerdicts", map[string]interface{}{
+					"Project":               project,
+					"Patchsets":             patchsets,
+					"TestId":                tv.TestId,
+					"VariantHash":           tv.VariantHash,
+					"InvocationId":          invocationID,
+					"Status":                int64(v.Status),
+					"UnexpectedResultCount": v.UnexpectedResultCount,
+					"TotalResultCount":      v.TotalResultCount,
+					"IngestionTime":         spanner.CommitTimestamp,
+				}))
+			}
+
+			for _, prior := range existing[k] {
+				if prior.InvocationID == invocationID ||
+					(ingested && !prior.IngestionTime.Before(ingestionTime)) ||
+					ingestionTime.Sub(prior.IngestionTime) > duplicateWindow {
+					continue
+				}
+				if prior.sameVerdict(v) {
+					duplicates[k] = true
+					break
+				}
+			}
+		}
+		span.BufferWrite(ctx, ms...)
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Annotate(err, "identify duplicate verdicts").Err()
+	}
+
+	for _, tv := range tvs {
+		isDuplicate := duplicates[testVariantKey{tv.TestId, tv.VariantHash}]
+		patchsetVerdictsCounter.Add(ctx, 1, project, isDuplicate)
+	}
+	return duplicates, nil
+}
+
+// readPatchsetVerdicts reads the verdicts recorded for the given test
+// variants and patchsets, keyed by test variant and invocation ID.
+func readPatchsetVerdicts(ctx context.Context, project, patchsets string, tvs []*rdbpb.TestVariant) (map[testVariantKey]map[string]*patchsetVerdict, error) {
+	ks := spanner.KeySets()
+	for _, tv := range tvs {
+		ks = spanner.KeySets(spanner.Key{project, patchsets, tv.TestId, tv.VariantHash}.AsPrefix(), ks)
+	}
+	fields := []string{"TestId", "VariantHash", "InvocationId", "Status", "UnexpectedResultCount", "TotalResultCount", "IngestionTime"}
+
+	result := make(map[testVariantKey]map[string]*patchsetVerdict)
+	var b spanutil.Buffer
+	err := span.Read(ctx, "PatchsetVerdicts", ks, fields).Do(
+		func(row *spanner.Row) error {
+			var k testVariantKey
+			v := &patchsetVerdict{}
+			var status int64
+			if err := b.FromSpanner(row, &k.TestId, &k.VariantHash, &v.InvocationID, &status, &v.UnexpectedResultCount, &v.TotalResultCount, &v.IngestionTime); err != nil {
+				return err
+			}
+			v.Status = rdbpb.TestVariantStatus(status)
+			if result[k] == nil {
+				result[k] = make(map[string]*patchsetVerdict)
+			}
+			result[k][v.InvocationID] = v
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, errors.Annotate(err, "read patchset verdicts").Err()
+	}
+	return result, nil
+}
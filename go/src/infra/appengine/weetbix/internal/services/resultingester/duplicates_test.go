@@ -0,0 +1,131 @@
+// Copyright 2021 The Chromium Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package resultingester
+
+import (
+	"testing"
+
+	cvv0 "go.chromium.org/luci/cv/api/v0"
+	rdbpb "go.chromium.org/luci/resultdb/proto/v1"
+
+	"infra/appengine/weetbix/internal/testutil"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestPatchsetsKey(t *testing.T) {
+	t.Parallel()
+	Convey(`patchsetsKey`, t, func() {
+		Convey(`Without CV run`, func() {
+			So(patchsetsKey(nil), ShouldEqual, "")
+		})
+		Convey(`Sorts CLs`, func() {
+			run := &cvv0.Run{
+				Cls: []*cvv0.GerritChange{
+					{Host: "chromium-review.googlesource.com", Change: 2, Patchset: 5},
+					{Host: "chromium-review.googlesource.com", Change: 1, Patchset: 3},
+				},
+			}
+			So(patchsetsKey(run), ShouldEqual, "chromium-review.googlesource.com/1/3,chromium-review.googlesource.com/2/5")
+		})
+	})
+}
+
+func TestIdentifyDuplicateVerdicts(t *testing.T) {
+	Convey(`identifyDuplicateVerdicts`, t, func() {
+		ctx := testutil.SpannerTestContext(t)
+
+		const project = "chromium"
+		const patchsets = "chromium-review.googlesource.com/1/3"
+
+		result := func(status rdbpb.TestStatus, expected bool) *rdbpb.TestResultBundle {
+			return &rdbpb.TestResultBundle{
+				Result: &rdbpb.TestResult{Status: status, Expected: expected},
+			}
+		}
+		failure := &rdbpb.TestVariant{
+			TestId:      "ninja://test_failure",
+			VariantHash: "hash",
+			Status:      rdbpb.TestVariantStatus_UNEXPECTED,
+			Results: []*rdbpb.TestResultBundle{
+				result(rdbpb.TestStatus_FAIL, false),
+				result(rdbpb.TestStatus_FAIL, false),
+			},
+		}
+		flake := &rdbpb.TestVariant{
+			TestId:      "ninja://test_failure",
+			VariantHash: "hash",
+			Status:      rdbpb.TestVariantStatus_FLAKY,
+			Results: []*rdbpb.TestResultBundle{
+				result(rdbpb.TestStatus_FAIL, false),
+				result(rdbpb.TestStatus_PASS, true),
+			},
+		}
+		other := &rdbpb.TestVariant{
+			TestId:      "ninja://test_other",
+			VariantHash: "hash",
+			Status:      rdbpb.TestVariantStatus_UNEXPECTED,
+			Results: []*rdbpb.TestResultBundle{
+				result(rdbpb.TestStatus_CRASH, false),
+			},
+		}
+		failureKey := testVariantKey{failure.TestId, failure.VariantHash}
+		otherKey := testVariantKey{other.TestId, other.VariantHash}
+
+		// The first build of the patchsets.
+		dups, err := identifyDuplicateVerdicts(ctx, project, patchsets, "build-1", []*rdbpb.TestVariant{failure, other})
+		So(err, ShouldBeNil)
+		So(dups, ShouldBeEmpty)
+
+		Convey(`Retry with same results`, func() {
+			dups, err := identifyDuplicateVerdicts(ctx, project, patchsets, "build-2", []*rdbpb.TestVariant{failure, other})
+			So(err, ShouldBeNil)
+			So(dups, ShouldResemble, map[testVariantKey]bool{failureKey: true, otherKey: true})
+
+			Convey(`Retried ingestion is stable`, func() {
+				// Retrying ingestion of either build identifies the same
+				// duplicates, as verdicts are only compared to verdicts
+				// ingested earlier.
+				dups, err := identifyDuplicateVerdicts(ctx, project, patchsets, "build-1", []*rdbpb.TestVariant{failure, other})
+				So(err, ShouldBeNil)
+				So(dups, ShouldBeEmpty)
+
+				dups, err = identifyDuplicateVerdicts(ctx, project, patchsets, "build-2", []*rdbpb.TestVariant{failure, other})
+				So(err, ShouldBeNil)
+				So(dups, ShouldResemble, map[testVariantKey]bool{failureKey: true, otherKey: true})
+			})
+		})
+		Convey(`Retry with changed results`, func() {
+			// The failure became flaky on retry, which must not be
+			// collapsed into the earlier verdict.
+			dups, err := identifyDuplicateVerdicts(ctx, project, patchsets, "build-2", []*rdbpb.TestVariant{flake, other})
+			So(err, ShouldBeNil)
+			So(dups, ShouldResemble, map[testVariantKey]bool{otherKey: true})
+		})
+		Convey(`Retry with different result counts`, func() {
+			failedOnce := &rdbpb.TestVariant{
+				TestId:      failure.TestId,
+				VariantHash: failure.VariantHash,
+				Status:      rdbpb.TestVariantStatus_UNEXPECTED,
+				Results: []*rdbpb.TestResultBundle{
+					result(rdbpb.TestStatus_FAIL, false),
+				},
+			}
+			dups, err := identifyDuplicateVerdicts(ctx, project, patchsets, "build-2", []*rdbpb.TestVariant{failedOnce})
+			So(err, ShouldBeNil)
+			So(dups, ShouldBeEmpty)
+		})
+		Convey(`Build of other patchsets`, func() {
+			dups, err := identifyDuplicateVerdicts(ctx, project, "chromium-review.googlesource.com/1/4", "build-2", []*rdbpb.TestVariant{failure, other})
+			So(err, ShouldBeNil)
+			So(dups, ShouldBeEmpty)
+		})
+		Convey(`Other project`, func() {
+			dups, err := identifyDuplicateVerdicts(ctx, "chromeos", patchsets, "build-2", []*rdbpb.TestVariant{failure, other})
+			So(err, ShouldBeNil)
+			So(dups, ShouldBeEmpty)
+		})
+	})
+}
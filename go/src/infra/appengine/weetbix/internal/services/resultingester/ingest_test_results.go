@@ -8,6 +8,7 @@ import (
 	"context"
 	"fmt"
 	"regexp"
+	"time"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -27,6 +28,7 @@ import (
 	"infra/appengine/weetbix/internal/clustering/chunkstore"
 	"infra/appengine/weetbix/internal/clustering/ingestion"
 	"infra/appengine/weetbix/internal/config"
+	"infra/appengine/weetbix/internal/maintenance"
 	"infra/appengine/weetbix/internal/resultdb"
 	"infra/appengine/weetbix/internal/services/resultcollector"
 	"infra/appengine/weetbix/internal/tasks/taskspb"
@@ -89,6 +91,12 @@ func RegisterTaskHandler(srv *server.Server) error {
 
 // Schedule enqueues a task to ingest test results from a build.
 func Schedule(ctx context.Context, task *taskspb.IngestTestResults) error {
+	return ScheduleWithDelay(ctx, task, 0)
+}
+
+// ScheduleWithDelay enqueues a task to ingest test results from a build,
+// to run after the given delay.
+func ScheduleWithDelay(ctx context.Context, task *taskspb.IngestTestResults, delay time.Duration) error {
 	// Note that currently we don't need to deduplicate tasks, because for
 	// Chromium use case Weetbix only ingest test results of the try builds that
 	// contribute to CL submission, so each build should be processed only once.
@@ -97,6 +105,7 @@ func Schedule(ctx context.Context, task *taskspb.IngestTestResults) error {
 	return tq.AddTask(ctx, &tq.Task{
 		Title:   fmt.Sprintf("%s-%d", task.Build.Host, task.Build.Id),
 		Payload: task,
+		Delay:   delay,
 	})
 }
 
@@ -104,6 +113,11 @@ func (i *resultIngester) ingestTestResults(ctx context.Context, payload *taskspb
 	if err := validateRequest(payload); err != nil {
 		return err
 	}
+	// The project is not known until the invocation is read, so check
+	// whether all of Weetbix is in maintenance mode first.
+	if deferred, err := maintenance.DeferTask(ctx, "", resultIngestionTaskClass, payload); err != nil || deferred {
+		return err
+	}
 
 	b, err := builderAndResultDBInfo(ctx, payload)
 	code := status.Code(err)
@@ -131,6 +145,20 @@ func (i *resultIngester) ingestTestResults(ctx context.Context, payload *taskspb
 	if project == "" {
 		return fmt.Errorf("invocation has invalid realm: %q", inv.Realm)
 	}
+	if deferred, err := maintenance.DeferTask(ctx, project, resultIngestionTaskClass, payload); err != nil || deferred {
+		return err
+	}
+
+	// Read links to the artifacts of failed test results, so they can be
+	// stored with the clustered failures.
+	artifactIDs, err := artifactIDsToLink(ctx, project)
+	if err != nil {
+		return err
+	}
+	artifacts, err := queryArtifactLinks(ctx, rc, project, invName, artifactIDs)
+	if err != nil {
+		return errors.Annotate(err, "ingesting artifact links").Err()
+	}
 
 	// Setup clustering ingestion.
 	invID, err := rdbbutil.ParseInvocationName(invName)
@@ -139,12 +167,49 @@ func (i *resultIngester) ingestTestResults(ctx context.Context, payload *taskspb
 		InvocationID:  invID,
 		PartitionTime: payload.PartitionTime.AsTime(),
 		Realm:         inv.Realm,
+		Artifacts:     artifacts,
 	}
 	if payload.CvRun != nil {
 		opts.PresubmitRunID = &pb.PresubmitRunId{System: "luci-cv", Id: payload.CvRun.Id}
 	}
+	// CQ may retry a build on the same patchsets. Verdicts duplicated by
+	// the retry are marked, so they do not inflate cluster impact.
+	patchsets := patchsetsKey(payload.CvRun)
+	duplicates := make(map[testVariantKey]bool)
+	if patchsets != "" {
+		opts.IsDuplicate = func(tv *rdbpb.TestVariant) bool {
+			return duplicates[testVariantKey{tv.TestId, tv.VariantHash}]
+		}
+	}
+	// Verdicts with unexpected runs are recorded with the CLs they tested,
+	// to analyze the recent failure rate of test variants.
+	cls := changelistsOfRun(payload.CvRun)
+	buildFailures, err := buildFailuresConfig(ctx, project)
+	if err != nil {
+		return err
+	}
+	if buildFailures != nil {
+		opts.IsTestStep, err = testStepMatcher(buildFailures.TestStepPatterns)
+		if err != nil {
+			return err
+		}
+	}
 	clusterIngestion := i.clustering.Open(opts)
 
+	if buildFailures != nil {
+		// Failures of build steps which do not run tests are clustered
+		// with the test failures of the build, so that the failures of
+		// e.g. compile or infra steps are also surfaced.
+		bs, err := buildStatusAndSteps(ctx, payload.Build.Host, payload.Build.Id)
+		if err != nil {
+			return errors.Annotate(err, "ingesting build step failures for clustering").Err()
+		}
+		bs.Builder = b.Builder
+		if err := clusterIngestion.PutBuild(ctx, bs); err != nil {
+			return errors.Annotate(err, "ingesting build step failures for clustering").Err()
+		}
+	}
+
 	// Query test variants from ResultDB and save/update the corresponding
 	// AnalyzedTestVariant rows.
 	// We read test variants from ResultDB in pages, and the func will be called
@@ -155,6 +220,18 @@ func (i *resultIngester) ingestTestResults(ctx context.Context, payload *taskspb
 				return errors.Annotate(err, "ingesting for test variant analysis").Err()
 			}
 		}
+		if patchsets != "" {
+			dups, err := identifyDuplicateVerdicts(ctx, project, patchsets, invID, tvs)
+			if err != nil {
+				return errors.Annotate(err, "ingesting for deduplication").Err()
+			}
+			for k := range dups {
+				duplicates[k] = true
+			}
+		}
+		if err := recordRecentVerdicts(ctx, project, invID, opts.PartitionTime, cls, tvs); err != nil {
+			return errors.Annotate(err, "ingesting for failure rate analysis").Err()
+		}
 		// Clustering ingestion is designed to behave gracefully in case of
 		// a task retry. Given the same options and same test variants (in
 		// the same order), the IDs and content of the chunks it writes is
@@ -178,7 +255,7 @@ func (i *resultIngester) ingestTestResults(ctx context.Context, payload *taskspb
 		return err
 	}
 
-	return nil
+	return recordIngested(ctx, payload.Build.Host, payload.Build.Id, project)
 }
 
 func validateRequest(payload *taskspb.IngestTestResults) error {
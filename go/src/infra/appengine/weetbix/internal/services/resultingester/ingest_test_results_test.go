@@ -15,6 +15,7 @@ import (
 	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
+	bbpb "go.chromium.org/luci/buildbucket/proto"
 	cvv0 "go.chromium.org/luci/cv/api/v0"
 	"go.chromium.org/luci/gae/impl/memory"
 	rdbpb "go.chromium.org/luci/resultdb/proto/v1"
@@ -37,6 +38,7 @@ import (
 	"infra/appengine/weetbix/internal/testutil"
 	"infra/appengine/weetbix/internal/testutil/insert"
 	"infra/appengine/weetbix/pbutil"
+	bqpb "infra/appengine/weetbix/proto/bq"
 	pb "infra/appengine/weetbix/proto/v1"
 
 	. "github.com/smartystreets/goconvey/convey"
@@ -278,9 +280,45 @@ func TestIngestTestResults(t *testing.T) {
 			So(len(actTestIDsWithTasks), ShouldEqual, 3)
 			So(actTestIDsWithTasks, ShouldResemble, testIDsWithNextTask)
 
+			// Confirm the verdicts with unexpected runs are recorded for
+			// failure rate analysis.
+			recentVerdicts := make(map[string][]int64)
+			err = span.Read(ctx, "RecentVerdicts", spanner.AllKeys(), []string{"TestId", "IngestedInvocationId", "FlakyRunCount", "UnexpectedRunCount"}).Do(
+				func(row *spanner.Row) error {
+					var testID, invID string
+					var flaky, unexpected int64
+					err := b.FromSpanner(row, &testID, &invID, &flaky, &unexpected)
+					So(err, ShouldBeNil)
+					So(invID, ShouldEqual, "build-87654321")
+					recentVerdicts[testID] = []int64{flaky, unexpected}
+					return nil
+				},
+			)
+			So(err, ShouldBeNil)
+			So(recentVerdicts, ShouldResemble, map[string][]int64{
+				"ninja://test_new_failure":        {0, 1},
+				"ninja://test_known_flake":        {0, 1},
+				"ninja://test_consistent_failure": {0, 1},
+				"ninja://test_no_new_results":     {0, 1},
+				"ninja://test_new_flake":          {1, 0},
+				"ninja://test_has_unexpected":     {1, 0},
+				"ninja://test_unexpected_pass":    {0, 1},
+			})
+
 			// Confirm chunks have been written to GCS.
 			So(len(chunkStore.Contents), ShouldEqual, 1)
 
+			// Confirm the ingestion has been recorded.
+			ingested, err := ReadIngested(ctx, "host", []int64{bID, bID + 1})
+			So(err, ShouldBeNil)
+			So(ingested, ShouldResemble, map[int64]bool{bID: true})
+			lastIngestion, err := ReadLastIngestionTime(span.Single(ctx), "chromium")
+			So(err, ShouldBeNil)
+			So(lastIngestion, ShouldHappenAfter, time.Time{})
+			lastIngestion, err = ReadLastIngestionTime(span.Single(ctx), "other")
+			So(err, ShouldBeNil)
+			So(lastIngestion.IsZero(), ShouldBeTrue)
+
 			// Confirm clustering has occurred, with each test result in at
 			// least one cluster.
 			actualClusteredFailures := make(map[string]int)
@@ -300,5 +338,116 @@ func TestIngestTestResults(t *testing.T) {
 			}
 			So(actualClusteredFailures, ShouldResemble, expectedClusteredFailures)
 		})
+		Convey(`with build step failures`, func() {
+			cfg := createProjectsConfig()
+			cfg["chromium"].BuildFailures = &config.BuildFailures{
+				TestStepPatterns: []string{`.*_tests \(with patch\)`},
+			}
+			config.SetTestProjectConfig(ctx, cfg)
+
+			mbc.GetBuildWithStatusAndSteps(bID, &bbpb.Build{
+				Status: bbpb.Status_FAILURE,
+				Steps: []*bbpb.Step{
+					{Name: "compile", Status: bbpb.Status_SUCCESS},
+					{Name: "browser_tests (with patch)", Status: bbpb.Status_FAILURE},
+					{Name: "bot_update", Status: bbpb.Status_INFRA_FAILURE, SummaryMarkdown: "checkout failed"},
+				},
+			})
+
+			payload := &taskspb.IngestTestResults{
+				Build: &taskspb.Build{
+					Host: "host",
+					Id:   bID,
+				},
+				PartitionTime: timestamppb.New(time.Date(2025, time.January, 1, 12, 0, 0, 0, time.UTC)),
+			}
+			err := ri.ingestTestResults(ctx, payload)
+			So(err, ShouldBeNil)
+
+			// Confirm the failed step which does not run tests is
+			// clustered, along with the test failures of the build.
+			var stepFailures []*bqpb.ClusteredFailureRow
+			testFailures := make(map[string]bool)
+			for _, f := range clusteredFailures.InsertionsByProject["chromium"] {
+				switch f.FailureKind {
+				case pb.FailureKind_BUILD_STEP_FAILURE:
+					stepFailures = append(stepFailures, f)
+				case pb.FailureKind_TEST_FAILURE:
+					testFailures[f.TestId] = true
+				}
+			}
+			So(len(testFailures), ShouldEqual, 6)
+			So(stepFailures, ShouldNotBeEmpty)
+			for _, f := range stepFailures {
+				So(f.TestId, ShouldEqual, "bot_update")
+				So(f.FailureReason.PrimaryErrorMessage, ShouldEqual, "checkout failed")
+			}
+		})
+		Convey(`with artifact links`, func() {
+			cfg := createProjectsConfig()
+			cfg["chromium"].ArtifactLinks = &config.ArtifactLinks{
+				ArtifactIds: []string{"snippet"},
+			}
+			config.SetTestProjectConfig(ctx, cfg)
+
+			artifactsReq := &rdbpb.QueryArtifactsRequest{
+				Invocations: []string{inv},
+				Predicate: &rdbpb.ArtifactPredicate{
+					FollowEdges: &rdbpb.ArtifactPredicate_EdgeTypeSet{
+						TestResults: true,
+					},
+					TestResultPredicate: &rdbpb.TestResultPredicate{
+						Expectancy: rdbpb.TestResultPredicate_VARIANTS_WITH_UNEXPECTED_RESULTS,
+					},
+				},
+				PageSize: 1000,
+			}
+			artifactsRes := &rdbpb.QueryArtifactsResponse{
+				Artifacts: []*rdbpb.Artifact{
+					{
+						Name:        sampleResultName + "/artifacts/snippet",
+						ArtifactId:  "snippet",
+						ContentType: "text/plain",
+						SizeBytes:   100,
+					},
+					{
+						Name:       sampleResultName + "/artifacts/screenshot",
+						ArtifactId: "screenshot",
+					},
+				},
+			}
+			mrc.QueryArtifacts(artifactsReq, artifactsRes)
+
+			payload := &taskspb.IngestTestResults{
+				Build: &taskspb.Build{
+					Host: "host",
+					Id:   bID,
+				},
+				PartitionTime: timestamppb.New(time.Date(2025, time.January, 1, 12, 0, 0, 0, time.UTC)),
+			}
+			err := ri.ingestTestResults(ctx, payload)
+			So(err, ShouldBeNil)
+
+			// Confirm the artifact links are stored with the clustered
+			// failures of the test result, and only its failures.
+			expectedLinks := []*pb.ArtifactLink{
+				{
+					System:      "resultdb",
+					Name:        sampleResultName + "/artifacts/snippet",
+					ArtifactId:  "snippet",
+					ContentType: "text/plain",
+					SizeBytes:   100,
+				},
+			}
+			insertions := clusteredFailures.InsertionsByProject["chromium"]
+			So(insertions, ShouldNotBeEmpty)
+			for _, f := range insertions {
+				if f.TestId == "ninja://test_new_failure" {
+					So(f.Artifacts, ShouldResembleProto, expectedLinks)
+				} else {
+					So(f.Artifacts, ShouldBeEmpty)
+				}
+			}
+		})
 	})
 }
@@ -0,0 +1,115 @@
+// Copyright 2021 The Chromium Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package resultingester
+
+import (
+	"context"
+	"regexp"
+	"time"
+
+	"cloud.google.com/go/spanner"
+
+	"go.chromium.org/luci/common/errors"
+	cvv0 "go.chromium.org/luci/cv/api/v0"
+	rdbpb "go.chromium.org/luci/resultdb/proto/v1"
+	"go.chromium.org/luci/server/span"
+
+	"infra/appengine/weetbix/internal/testvariants"
+	pb "infra/appengine/weetbix/proto/v1"
+)
+
+// testRunRe extracts the test run from the ResultDB test result name, i.e.
+// the invocation which directly contains the test result.
+var testRunRe = regexp.MustCompile(`^invocations/([^/]+)/tests/[^/]+/results/[^/]+$`)
+
+// changelistsOfRun returns the changelists tested by a CV run, or nil if
+// the results are not from a CV run.
+func changelistsOfRun(run *cvv0.Run) []*pb.Changelist {
+	var cls []*pb.Changelist
+	for _, cl := range run.GetCls() {
+		cls = append(cls, &pb.Changelist{
+			Host:     cl.Host,
+			Change:   cl.Change,
+			Patchset: cl.Patchset,
+		})
+	}
+	return cls
+}
+
+// recentVerdictFromTestVariant returns the verdict of a test variant
+// ingested from the given invocation, or nil if none of its runs had
+// unexpected results.
+func recentVerdictFromTestVariant(project, invocationID string, partitionTime time.Time, cls []*pb.Changelist, tv *rdbpb.TestVariant) *testvariants.RecentVerdict {
+	type runCounts struct {
+		expected, unexpected int
+	}
+	runs := make(map[string]*runCounts)
+	for _, trb := range tv.Results {
+		tr := trb.Result
+		if tr.Status == rdbpb.TestStatus_SKIP {
+			continue
+		}
+		var run string
+		if m := testRunRe.FindStringSubmatch(tr.Name); m != nil {
+			run = m[1]
+		}
+		c, ok := runs[run]
+		if !ok {
+			c = &runCounts{}
+			runs[run] = c
+		}
+		if tr.Expected {
+			c.expected++
+		} else {
+			c.unexpected++
+		}
+	}
+
+	v := &testvariants.RecentVerdict{
+		Project:              project,
+		TestID:               tv.TestId,
+		VariantHash:          tv.VariantHash,
+		PartitionTime:        partitionTime,
+		IngestedInvocationID: invocationID,
+		Changelists:          cls,
+	}
+	for _, c := range runs {
+		switch {
+		case c.unexpected == 0:
+			// Runs with only expected results are not recorded.
+		case c.expected > 0:
+			v.FlakyRunCount++
+		default:
+			v.UnexpectedRunCount++
+		}
+	}
+	if v.FlakyRunCount == 0 && v.UnexpectedRunCount == 0 {
+		return nil
+	}
+	return v
+}
+
+// recordRecentVerdicts records the verdicts with unexpected runs of the
+// test variants ingested from an invocation, for the analysis of the
+// recent failure rate of test variants.
+func recordRecentVerdicts(ctx context.Context, project, invocationID string, partitionTime time.Time, cls []*pb.Changelist, tvs []*rdbpb.TestVariant) error {
+	ms := make([]*spanner.Mutation, 0, len(tvs))
+	for _, tv := range tvs {
+		if v := recentVerdictFromTestVariant(project, invocationID, partitionTime, cls, tv); v != nil {
+			ms = append(ms, testvariants.SaveRecentVerdict(v))
+		}
+	}
+	if len(ms) == 0 {
+		return nil
+	}
+	_, err := span.ReadWriteTransaction(ctx, func(ctx context.Context) error {
+		span.BufferWrite(ctx, ms...)
+		return nil
+	})
+	if err != nil {
+		return errors.Annotate(err, "record recent verdicts").Err()
+	}
+	return nil
+}
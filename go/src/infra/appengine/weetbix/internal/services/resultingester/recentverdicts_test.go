@@ -0,0 +1,83 @@
+// Copyright 2021 The Chromium Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package resultingester
+
+import (
+	"testing"
+	"time"
+
+	cvv0 "go.chromium.org/luci/cv/api/v0"
+	rdbpb "go.chromium.org/luci/resultdb/proto/v1"
+
+	"infra/appengine/weetbix/internal/testvariants"
+	pb "infra/appengine/weetbix/proto/v1"
+
+	. "github.com/smartystreets/goconvey/convey"
+	. "go.chromium.org/luci/common/testing/assertions"
+)
+
+func TestRecentVerdictFromTestVariant(t *testing.T) {
+	t.Parallel()
+	Convey(`recentVerdictFromTestVariant`, t, func() {
+		partitionTime := time.Date(2021, time.December, 1, 12, 0, 0, 0, time.UTC)
+		cls := changelistsOfRun(&cvv0.Run{
+			Cls: []*cvv0.GerritChange{
+				{Host: "chromium-review.googlesource.com", Change: 1, Patchset: 3},
+			},
+		})
+		So(cls, ShouldResembleProto, []*pb.Changelist{
+			{Host: "chromium-review.googlesource.com", Change: 1, Patchset: 3},
+		})
+
+		result := func(run string, status rdbpb.TestStatus, expected bool) *rdbpb.TestResultBundle {
+			return &rdbpb.TestResultBundle{
+				Result: &rdbpb.TestResult{
+					Name:     "invocations/" + run + "/tests/ninja:%2F%2Ftest/results/" + status.String(),
+					Status:   status,
+					Expected: expected,
+				},
+			}
+		}
+		verdict := func(results ...*rdbpb.TestResultBundle) *testvariants.RecentVerdict {
+			tv := &rdbpb.TestVariant{
+				TestId:      "ninja://test",
+				VariantHash: "hash",
+				Results:     results,
+			}
+			return recentVerdictFromTestVariant("chromium", "build-1", partitionTime, cls, tv)
+		}
+
+		Convey(`Counts runs`, func() {
+			v := verdict(
+				// Flaky run.
+				result("task-1", rdbpb.TestStatus_FAIL, false),
+				result("task-1", rdbpb.TestStatus_PASS, true),
+				// Unexpected runs.
+				result("task-2", rdbpb.TestStatus_FAIL, false),
+				result("task-3", rdbpb.TestStatus_CRASH, false),
+				result("task-3", rdbpb.TestStatus_SKIP, true),
+				// Expected run.
+				result("task-4", rdbpb.TestStatus_PASS, true),
+			)
+			So(v, ShouldResemble, &testvariants.RecentVerdict{
+				Project:              "chromium",
+				TestID:               "ninja://test",
+				VariantHash:          "hash",
+				PartitionTime:        partitionTime,
+				IngestedInvocationID: "build-1",
+				Changelists:          cls,
+				FlakyRunCount:        1,
+				UnexpectedRunCount:   2,
+			})
+		})
+		Convey(`Without unexpected runs`, func() {
+			v := verdict(
+				result("task-1", rdbpb.TestStatus_PASS, true),
+				result("task-2", rdbpb.TestStatus_SKIP, false),
+			)
+			So(v, ShouldBeNil)
+		})
+	})
+}
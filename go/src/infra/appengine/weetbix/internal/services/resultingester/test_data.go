@@ -14,6 +14,7 @@ var sampleVar = pbutil.Variant("k1", "v1")
 var sampleTmd = &rdbpb.TestMetadata{
 	Name: "test_new_failure",
 }
+var sampleResultName = "invocations/task-1/tests/ninja:%2F%2Ftest_new_failure/results/one"
 
 func mockedGetBuildRsp(inv string) *bbpb.Build {
 	return &bbpb.Build{
@@ -43,6 +44,7 @@ func mockedQueryTestVariantsRsp() *rdbpb.QueryTestVariantsResponse {
 				Results: []*rdbpb.TestResultBundle{
 					{
 						Result: &rdbpb.TestResult{
+							Name:   sampleResultName,
 							Status: rdbpb.TestStatus_FAIL,
 							Tags:   pbutil.StringPairs("random_tag", "random_tag_value", "monorail_component", "Monorail>Component"),
 						},
@@ -110,7 +112,8 @@ func mockedQueryTestVariantsRsp() *rdbpb.QueryTestVariantsResponse {
 					},
 					{
 						Result: &rdbpb.TestResult{
-							Status: rdbpb.TestStatus_PASS,
+							Status:   rdbpb.TestStatus_PASS,
+							Expected: true,
 						},
 					},
 				},
@@ -127,7 +130,8 @@ func mockedQueryTestVariantsRsp() *rdbpb.QueryTestVariantsResponse {
 					},
 					{
 						Result: &rdbpb.TestResult{
-							Status: rdbpb.TestStatus_PASS,
+							Status:   rdbpb.TestStatus_PASS,
+							Expected: true,
 						},
 					},
 				},
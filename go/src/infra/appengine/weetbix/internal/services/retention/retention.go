@@ -0,0 +1,194 @@
+// Copyright 2021 The Chromium Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+// Package retention enforces the retention period of the failure data of
+// LUCI projects.
+//
+// The enforce-retention cron job deletes the chunks of clustered test
+// results and the test verdicts of each LUCI project whose partition time is
+// older than the project's retention period (see config.FailureRetention).
+// Rows are deleted from Spanner in small batches at a limited rate, to leave
+// capacity for ingestion and re-clustering; rows which remain when a run
+// ends are deleted by the next run. The cron job also sets the partition
+// expiration of the project's clustered failures BigQuery table to the
+// retention period, so that BigQuery deletes older partitions.
+package retention
+
+import (
+	"context"
+	"time"
+
+	"golang.org/x/time/rate"
+
+	"go.chromium.org/luci/common/clock"
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/common/logging"
+	"go.chromium.org/luci/common/tsmon/field"
+	"go.chromium.org/luci/common/tsmon/metric"
+	"go.chromium.org/luci/common/tsmon/types"
+
+	"infra/appengine/weetbix/internal/analysis/clusteredfailures"
+	"infra/appengine/weetbix/internal/clustering/chunkstore"
+	"infra/appengine/weetbix/internal/config"
+)
+
+const (
+	// batchSize is the maximum number of rows deleted in each transaction.
+	batchSize = 500
+
+	// batchesPerSecond is the maximum rate of delete transactions.
+	batchesPerSecond = 5
+
+	// runDuration is the time each run of the cron job deletes rows for,
+	// within the 10 minute request timeout of cron jobs.
+	runDuration = 8 * time.Minute
+
+	// SafetyMargin is the time since the last update of a chunk of test
+	// results within which the chunk is not deleted, even if it is older
+	// than the retention period. This avoids deleting chunks while they are
+	// being re-clustered; such chunks are deleted by a later run.
+	SafetyMargin = time.Hour
+)
+
+var (
+	rowsDeletedCounter = metric.NewCounter(
+		"weetbix/retention/rows_deleted",
+		"The number of Spanner rows deleted because they were older than the retention period of their LUCI project",
+		nil,
+		field.String("project"), field.String("table"))
+
+	oldestPartitionGauge = metric.NewFloat(
+		"weetbix/retention/oldest_partition_age",
+		"The age of the partition time of the oldest chunk of clustered test results remaining in Spanner",
+		&types.MetricMetadata{Units: types.Seconds},
+		field.String("project"))
+)
+
+// ChunkDeleter deletes chunks of test results from storage.
+type ChunkDeleter interface {
+	// Delete deletes the chunk with the specified object ID. Deleting a
+	// chunk which does not exist is not an error.
+	Delete(ctx context.Context, project, objectID string) error
+}
+
+// PartitionExpirer sets the partition expiration of the clustered
+// failures BigQuery tables of LUCI projects.
+type PartitionExpirer interface {
+	// SetPartitionExpiration sets the time after which partitions of the
+	// clustered failures table of the given LUCI project expire. Returns
+	// whether the table was updated.
+	SetPartitionExpiration(ctx context.Context, project string, expiration time.Duration) (bool, error)
+}
+
+// CronHandler returns the handler of the enforce-retention cron job, which
+// deletes the failure data of each LUCI project which is older than the
+// project's retention period. Partition expirations are set on the
+// clustered failures tables in the given GCP project.
+func CronHandler(gcpProject string) func(ctx context.Context) error {
+	return func(ctx context.Context) error {
+		cfg, err := config.Get(ctx)
+		if err != nil {
+			return errors.Annotate(err, "get config").Err()
+		}
+		projectCfg, err := config.Projects(ctx)
+		if err != nil {
+			return errors.Annotate(err, "get project configs").Err()
+		}
+		chunkStore, err := chunkstore.NewClient(ctx, cfg.ChunkGcsBucket)
+		if err != nil {
+			return err
+		}
+		defer chunkStore.Close()
+
+		e := &enforcer{
+			chunks:       chunkStore,
+			bq:           clusteredfailures.NewClient(gcpProject),
+			batchSize:    batchSize,
+			limiter:      rate.NewLimiter(batchesPerSecond, 1),
+			safetyMargin: SafetyMargin,
+		}
+		deadline := clock.Now(ctx).Add(runDuration)
+
+		var errs []error
+		for project, pc := range projectCfg {
+			if err := e.run(ctx, project, config.FailureRetention(pc), deadline); err != nil {
+				err = errors.Annotate(err, "in project %v", project).Err()
+				logging.Errorf(ctx, "Enforcing retention: %s", err)
+				errs = append(errs, err)
+			}
+		}
+		if len(errs) > 0 {
+			return errors.NewMultiError(errs...)
+		}
+		return nil
+	}
+}
+
+// enforcer deletes failure data older than the retention period.
+type enforcer struct {
+	chunks ChunkDeleter
+	bq     PartitionExpirer
+	// batchSize is the maximum number of rows deleted in each
+	// transaction.
+	batchSize int
+	// limiter limits the rate of delete transactions.
+	limiter *rate.Limiter
+	// safetyMargin is the time since the last update of a chunk within
+	// which it is not deleted. See SafetyMargin.
+	safetyMargin time.Duration
+}
+
+// run deletes the failure data of the given LUCI project older than the
+// retention period, until the deadline, and sets the partition expiration
+// of the project's clustered failures table to the retention period.
+func (e *enforcer) run(ctx context.Context, project string, retention time.Duration, deadline time.Time) error {
+	if _, err := e.bq.SetPartitionExpiration(ctx, project, retention); err != nil {
+		return errors.Annotate(err, "set partition expiration").Err()
+	}
+
+	now := clock.Now(ctx)
+	cutoff := now.Add(-retention)
+	safeBefore := now.Add(-e.safetyMargin)
+	done := true
+tables:
+	for _, t := range retentionTables {
+		for {
+			if !clock.Now(ctx).Before(deadline) {
+				logging.Warningf(ctx, "Enforcing retention of project %s: deadline exceeded, leaving the rest to the next run.", project)
+				done = false
+				break tables
+			}
+			if err := e.limiter.Wait(ctx); err != nil {
+				return err
+			}
+			read, deleted, err := e.deleteBatch(ctx, project, t, cutoff, safeBefore)
+			if err != nil {
+				return errors.Annotate(err, "delete from %s", t.name).Err()
+			}
+			rowsDeletedCounter.Add(ctx, int64(deleted), project, t.name)
+			if read < e.batchSize {
+				break
+			}
+		}
+	}
+	if done {
+		logging.Infof(ctx, "Enforced retention of %s for project %s.", retention, project)
+	}
+	return e.reportOldestPartition(ctx, project)
+}
+
+// reportOldestPartition reports the age of the oldest chunk of the project
+// remaining in Spanner.
+func (e *enforcer) reportOldestPartition(ctx context.Context, project string) error {
+	oldest, err := readOldestPartitionTime(ctx, project)
+	if err != nil {
+		return errors.Annotate(err, "read oldest partition time").Err()
+	}
+	var age float64
+	if !oldest.IsZero() {
+		age = clock.Now(ctx).Sub(oldest).Seconds()
+	}
+	oldestPartitionGauge.Set(ctx, age, project)
+	return nil
+}
@@ -0,0 +1,219 @@
+// Copyright 2021 The Chromium Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package retention
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"testing"
+	"time"
+
+	"cloud.google.com/go/spanner"
+	"golang.org/x/time/rate"
+
+	"go.chromium.org/luci/common/clock/testclock"
+	"go.chromium.org/luci/common/tsmon"
+	"go.chromium.org/luci/server/span"
+
+	"infra/appengine/weetbix/internal/clustering/chunkstore"
+	cpb "infra/appengine/weetbix/internal/clustering/proto"
+	"infra/appengine/weetbix/internal/clustering/state"
+	spanutil "infra/appengine/weetbix/internal/span"
+	"infra/appengine/weetbix/internal/testutil"
+	"infra/appengine/weetbix/internal/testutil/insert"
+	"infra/appengine/weetbix/internal/testvariants"
+	pb "infra/appengine/weetbix/proto/v1"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+type fakeBigQuery struct {
+	expirations map[string]time.Duration
+}
+
+func (f *fakeBigQuery) SetPartitionExpiration(ctx context.Context, project string, expiration time.Duration) (bool, error) {
+	updated := f.expirations[project] != expiration
+	f.expirations[project] = expiration
+	return updated, nil
+}
+
+func TestEnforceRetention(t *testing.T) {
+	Convey(`With Spanner Test Database`, t, func() {
+		ctx := testutil.SpannerTestContext(t)
+		ctx, _ = tsmon.WithDummyInMemory(ctx)
+		// The LastUpdated time of chunks is their Spanner commit time, so
+		// use the current time. Spanner stores timestamps with microsecond
+		// precision.
+		now := time.Now().UTC().Truncate(time.Microsecond)
+		ctx, tc := testclock.UseTime(ctx, now)
+		days := func(n int) time.Duration {
+			return time.Duration(n) * 24 * time.Hour
+		}
+		const retention = 90 * 24 * time.Hour
+
+		chunks := chunkstore.NewFakeClient()
+		bq := &fakeBigQuery{expirations: make(map[string]time.Duration)}
+		e := &enforcer{
+			chunks:       chunks,
+			bq:           bq,
+			batchSize:    1,
+			limiter:      rate.NewLimiter(rate.Inf, 1),
+			safetyMargin: SafetyMargin,
+		}
+
+		// Set up chunks older and younger than the retention period.
+		var entries []*state.Entry
+		objectIDs := make(map[string]string)
+		for i, age := range []int{120, 100, 10} {
+			name := fmt.Sprintf("%vd", age)
+			objectID, err := chunks.Put(ctx, "chromium", &cpb.Chunk{})
+			So(err, ShouldBeNil)
+			objectIDs[name] = objectID
+			entry := state.NewEntry(i).WithProject("chromium").Build()
+			entry.PartitionTime = now.Add(-days(age))
+			entry.ObjectID = objectID
+			entries = append(entries, entry)
+		}
+		// A chunk of another project.
+		otherEntry := state.NewEntry(3).WithProject("other").Build()
+		otherEntry.PartitionTime = now.Add(-days(120))
+		entries = append(entries, otherEntry)
+		_, err := state.CreateEntriesForTesting(ctx, entries)
+		So(err, ShouldBeNil)
+
+		recentVerdict := func(invocationID string, partitionTime time.Time) *spanner.Mutation {
+			return testvariants.SaveRecentVerdict(&testvariants.RecentVerdict{
+				Project:              "chromium",
+				TestID:               "ninja://test",
+				VariantHash:          "varianthash",
+				PartitionTime:        partitionTime,
+				IngestedInvocationID: invocationID,
+				UnexpectedRunCount:   1,
+			})
+		}
+		patchsetVerdict := func(invocationID string, ingestionTime time.Time) *spanner.Mutation {
+			return spanutil.InsertMap("PatchsetVerdicts", map[string]interface{}{
+				"Project":               "chromium",
+				"Patchsets":             "chromium-review.googlesource.com/1/1",
+				"TestId":                "ninja://test",
+				"VariantHash":           "varianthash",
+				"InvocationId":          invocationID,
+				"Status":                int64(pb.VerdictStatus_UNEXPECTED),
+				"UnexpectedResultCount": int64(1),
+				"TotalResultCount":      int64(1),
+				"IngestionTime":         ingestionTime,
+			})
+		}
+		testutil.MustApply(ctx,
+			insert.AnalyzedTestVariant("chromium:ci", "ninja://test", "varianthash", pb.AnalyzedTestVariantStatus_FLAKY, nil),
+			insert.Verdict("chromium:ci", "ninja://test", "varianthash", "inv-old", pb.VerdictStatus_EXPECTED, now.Add(-days(100)), nil),
+			insert.Verdict("chromium:ci", "ninja://test", "varianthash", "inv-new", pb.VerdictStatus_EXPECTED, now.Add(-days(1)), nil),
+			// A realm of a project whose name starts with that of the
+			// project.
+			insert.AnalyzedTestVariant("chromium-too:ci", "ninja://test", "varianthash", pb.AnalyzedTestVariantStatus_FLAKY, nil),
+			insert.Verdict("chromium-too:ci", "ninja://test", "varianthash", "inv-old", pb.VerdictStatus_EXPECTED, now.Add(-days(100)), nil),
+			recentVerdict("inv-old", now.Add(-days(100))),
+			recentVerdict("inv-new", now.Add(-days(1))),
+			patchsetVerdict("inv-old", now.Add(-days(100))),
+			patchsetVerdict("inv-new", now.Add(-days(1))),
+		)
+
+		chunkIDs := func(project string) []string {
+			entries, err := state.ReadAllForTesting(ctx, project)
+			So(err, ShouldBeNil)
+			ids := make([]string, 0, len(entries))
+			for _, e := range entries {
+				ids = append(ids, e.ChunkID)
+			}
+			return ids
+		}
+		// invocationIDs reads the invocation IDs of the rows of a table
+		// matching the condition.
+		invocationIDs := func(table, column, where string) []string {
+			stmt := spanner.NewStatement(fmt.Sprintf(`SELECT %s FROM %s WHERE %s ORDER BY %s`, column, table, where, column))
+			var ids []string
+			err := span.Query(span.Single(ctx), stmt).Do(func(r *spanner.Row) error {
+				var id string
+				if err := r.Columns(&id); err != nil {
+					return err
+				}
+				ids = append(ids, id)
+				return nil
+			})
+			So(err, ShouldBeNil)
+			return ids
+		}
+		chunkID := func(i int) string {
+			return entries[i].ChunkID
+		}
+		// sorted returns the chunk IDs in the order they are read.
+		sorted := func(ids ...string) []string {
+			sort.Strings(ids)
+			return ids
+		}
+
+		Convey(`Chunks within the safety margin are kept`, func() {
+			// The chunks were just created, as if they were being
+			// re-clustered.
+			So(e.run(ctx, "chromium", retention, tc.Now().Add(time.Minute)), ShouldBeNil)
+			So(chunkIDs("chromium"), ShouldHaveLength, 3)
+			So(chunks.Contents, ShouldHaveLength, 3)
+			So(rowsDeletedCounter.Get(ctx, "chromium", "ClusteringState"), ShouldEqual, 0)
+
+			// Other data older than the retention period is deleted
+			// regardless of the safety margin.
+			So(invocationIDs("Verdicts", "InvocationId", `Realm = "chromium:ci"`), ShouldResemble, []string{"inv-new"})
+			So(rowsDeletedCounter.Get(ctx, "chromium", "Verdicts"), ShouldEqual, 1)
+		})
+		Convey(`Data older than the retention period is deleted`, func() {
+			tc.Add(SafetyMargin + time.Minute)
+			So(e.run(ctx, "chromium", retention, tc.Now().Add(time.Minute)), ShouldBeNil)
+
+			So(chunkIDs("chromium"), ShouldResemble, []string{chunkID(2)})
+			So(chunks.Contents, ShouldNotContainKey, chunkstore.FileName("chromium", objectIDs["120d"]))
+			So(chunks.Contents, ShouldNotContainKey, chunkstore.FileName("chromium", objectIDs["100d"]))
+			So(chunks.Contents, ShouldContainKey, chunkstore.FileName("chromium", objectIDs["10d"]))
+			So(invocationIDs("Verdicts", "InvocationId", `Realm = "chromium:ci"`), ShouldResemble, []string{"inv-new"})
+			So(invocationIDs("RecentVerdicts", "IngestedInvocationId", `Project = "chromium"`), ShouldResemble, []string{"inv-new"})
+			So(invocationIDs("PatchsetVerdicts", "InvocationId", `Project = "chromium"`), ShouldResemble, []string{"inv-new"})
+
+			// The data of other projects is kept.
+			So(chunkIDs("other"), ShouldResemble, []string{chunkID(3)})
+			So(invocationIDs("Verdicts", "InvocationId", `Realm = "chromium-too:ci"`), ShouldResemble, []string{"inv-old"})
+
+			So(bq.expirations, ShouldResemble, map[string]time.Duration{"chromium": retention})
+			So(rowsDeletedCounter.Get(ctx, "chromium", "ClusteringState"), ShouldEqual, 2)
+			So(rowsDeletedCounter.Get(ctx, "chromium", "Verdicts"), ShouldEqual, 1)
+			So(rowsDeletedCounter.Get(ctx, "chromium", "RecentVerdicts"), ShouldEqual, 1)
+			So(rowsDeletedCounter.Get(ctx, "chromium", "PatchsetVerdicts"), ShouldEqual, 1)
+			So(oldestPartitionGauge.Get(ctx, "chromium"), ShouldEqual, tc.Now().Sub(entries[2].PartitionTime).Seconds())
+
+			Convey(`Idempotent`, func() {
+				So(e.run(ctx, "chromium", retention, tc.Now().Add(time.Minute)), ShouldBeNil)
+				So(chunkIDs("chromium"), ShouldResemble, []string{chunkID(2)})
+				So(rowsDeletedCounter.Get(ctx, "chromium", "ClusteringState"), ShouldEqual, 2)
+			})
+		})
+		Convey(`Longer retention periods are respected`, func() {
+			tc.Add(SafetyMargin + time.Minute)
+			So(e.run(ctx, "chromium", days(110), tc.Now().Add(time.Minute)), ShouldBeNil)
+			So(chunkIDs("chromium"), ShouldResemble, sorted(chunkID(1), chunkID(2)))
+			So(invocationIDs("Verdicts", "InvocationId", `Realm = "chromium:ci"`), ShouldResemble, []string{"inv-new", "inv-old"})
+			So(bq.expirations, ShouldResemble, map[string]time.Duration{"chromium": days(110)})
+		})
+		Convey(`Stops at the deadline`, func() {
+			tc.Add(SafetyMargin + time.Minute)
+			So(e.run(ctx, "chromium", retention, tc.Now()), ShouldBeNil)
+			So(chunkIDs("chromium"), ShouldHaveLength, 3)
+			So(invocationIDs("Verdicts", "InvocationId", `Realm = "chromium:ci"`), ShouldResemble, []string{"inv-new", "inv-old"})
+			So(oldestPartitionGauge.Get(ctx, "chromium"), ShouldEqual, tc.Now().Sub(entries[0].PartitionTime).Seconds())
+
+			// The next run picks up from there.
+			So(e.run(ctx, "chromium", retention, tc.Now().Add(time.Minute)), ShouldBeNil)
+			So(chunkIDs("chromium"), ShouldResemble, []string{chunkID(2)})
+		})
+	})
+}
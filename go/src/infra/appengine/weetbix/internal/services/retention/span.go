@@ -0,0 +1,177 @@
+// Copyright 2021 The Chromium Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package retention
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"cloud.google.com/go/spanner"
+
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/server/span"
+
+	"infra/appengine/weetbix/internal/clustering/state"
+	spanutil "infra/appengine/weetbix/internal/span"
+)
+
+// retentionTable is a Spanner table whose rows are deleted once they are
+// older than the retention period of their LUCI project.
+type retentionTable struct {
+	// The name of the table.
+	name string
+	// The primary key columns of the table.
+	keyColumns []string
+	// The TIMESTAMP column compared against the retention period.
+	timeColumn string
+	// Whether rows belong to a project by their realm, rather than by
+	// their Project column.
+	byRealm bool
+	// Whether the table is ClusteringState, whose rows reference chunks of
+	// test results in GCS by their ObjectId column, and are not deleted
+	// within the safety margin of their LastUpdated time.
+	isClusteringState bool
+}
+
+// retentionTables are the tables whose rows are deleted once they are older
+// than the retention period, in the order they are processed.
+var retentionTables = []retentionTable{
+	{name: "ClusteringState", keyColumns: []string{"Project", "ChunkId"}, timeColumn: "PartitionTime", isClusteringState: true},
+	{name: "Verdicts", keyColumns: []string{"Realm", "TestId", "VariantHash", "InvocationId"}, timeColumn: "InvocationCreationTime", byRealm: true},
+	{name: "RecentVerdicts", keyColumns: []string{"Project", "TestId", "VariantHash", "PartitionTime", "IngestedInvocationId"}, timeColumn: "PartitionTime"},
+	{name: "PatchsetVerdicts", keyColumns: []string{"Project", "Patchsets", "TestId", "VariantHash", "InvocationId"}, timeColumn: "IngestionTime"},
+}
+
+// whereClause returns the condition selecting the rows of the table which
+// belong to the project and are older than the retention period.
+func (t retentionTable) whereClause() string {
+	clause := "Project = @project"
+	if t.byRealm {
+		// Realms are of the form "{project}:{realm}".
+		clause = "STARTS_WITH(Realm, @realmPrefix)"
+	}
+	clause += fmt.Sprintf(" AND %s < @cutoff", t.timeColumn)
+	if t.isClusteringState {
+		clause += " AND LastUpdated < @safeBefore"
+	}
+	return clause
+}
+
+// deleteBatch deletes up to batchSize rows of the given table which belong
+// to the given project and are older than cutoff. Chunks updated at or
+// after safeBefore are not deleted. Returns the number of rows read, which
+// is less than batchSize if no more rows are due for deletion, and the
+// number of rows deleted.
+func (e *enforcer) deleteBatch(ctx context.Context, project string, t retentionTable, cutoff, safeBefore time.Time) (read, deleted int, err error) {
+	columns := t.keyColumns
+	if t.isClusteringState {
+		columns = append(append([]string{}, columns...), "ObjectId")
+	}
+	stmt := spanner.NewStatement(fmt.Sprintf(`SELECT %s FROM %s WHERE %s LIMIT @limit`,
+		strings.Join(columns, ", "), t.name, t.whereClause()))
+	stmt.Params = map[string]interface{}{
+		"project":     project,
+		"realmPrefix": project + ":",
+		"cutoff":      cutoff,
+		"safeBefore":  safeBefore,
+		"limit":       e.batchSize,
+	}
+
+	var keys []spanner.Key
+	var objectIDs []string
+	err = span.Query(span.Single(ctx), stmt).Do(func(r *spanner.Row) error {
+		key, err := spanutil.ReadKey(r, len(t.keyColumns))
+		if err != nil {
+			return err
+		}
+		keys = append(keys, key)
+		if t.isClusteringState {
+			var objectID string
+			if err := r.Column(len(t.keyColumns), &objectID); err != nil {
+				return err
+			}
+			objectIDs = append(objectIDs, objectID)
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, 0, errors.Annotate(err, "read keys").Err()
+	}
+	if len(keys) == 0 {
+		return 0, 0, nil
+	}
+
+	// The chunks deleted, by index into keys.
+	var deletedChunks []int
+	_, err = span.ReadWriteTransaction(ctx, func(ctx context.Context) error {
+		deleted = 0
+		deletedChunks = nil
+		if !t.isClusteringState {
+			for _, key := range keys {
+				span.BufferWrite(ctx, spanner.Delete(t.name, key))
+			}
+			deleted = len(keys)
+			return nil
+		}
+
+		// Chunks may have been (re-)clustered since they were read. Keep
+		// those, so that the re-clustering is not lost.
+		chunkKeys := make([]state.ChunkKey, len(keys))
+		for i, key := range keys {
+			chunkKeys[i] = state.ChunkKey{Project: key[0].(string), ChunkID: key[1].(string)}
+		}
+		lastUpdated, err := state.ReadLastUpdated(ctx, chunkKeys)
+		if err != nil {
+			return errors.Annotate(err, "read last updated").Err()
+		}
+		for i, key := range keys {
+			if lastUpdated[i].IsZero() || !lastUpdated[i].Before(safeBefore) {
+				// Deleted or updated in the meantime.
+				continue
+			}
+			span.BufferWrite(ctx, spanner.Delete(t.name, key))
+			deletedChunks = append(deletedChunks, i)
+		}
+		deleted = len(deletedChunks)
+		return nil
+	})
+	if err != nil {
+		return 0, 0, errors.Annotate(err, "delete rows").Err()
+	}
+
+	// Delete the chunks only once the rows referencing them are deleted,
+	// so that no chunk which is still referenced is deleted.
+	for _, i := range deletedChunks {
+		if err := e.chunks.Delete(ctx, project, objectIDs[i]); err != nil {
+			return 0, 0, errors.Annotate(err, "delete chunk").Err()
+		}
+	}
+	return len(keys), deleted, nil
+}
+
+// readOldestPartitionTime reads the partition time of the oldest chunk of
+// the project. Returns the zero time if the project has no chunks.
+func readOldestPartitionTime(ctx context.Context, project string) (time.Time, error) {
+	stmt := spanner.NewStatement(`
+		SELECT MIN(PartitionTime)
+		FROM ClusteringState
+		WHERE Project = @project
+	`)
+	stmt.Params["project"] = project
+
+	var oldest spanner.NullTime
+	err := span.Query(span.Single(ctx), stmt).Do(func(r *spanner.Row) error {
+		return r.Columns(&oldest)
+	})
+	if err != nil {
+		return time.Time{}, err
+	}
+	if !oldest.Valid {
+		return time.Time{}, nil
+	}
+	return oldest.Time, nil
+}
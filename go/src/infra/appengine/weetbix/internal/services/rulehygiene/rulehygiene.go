@@ -0,0 +1,268 @@
+// Copyright 2021 The Chromium Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+// Package rulehygiene cleans up failure association rules which no longer
+// match any failures, e.g. because the failing tests were deleted or the
+// bugs were fixed.
+//
+// For each LUCI project with a rule hygiene configuration, the rule-hygiene
+// cron job records when each active rule last matched a failure, flags
+// rules which have not matched a failure for stale_after_days as stale and,
+// if auto_archive is set, archives rules which have not matched a failure
+// for archive_after_days and whose bugs are closed.
+package rulehygiene
+
+import (
+	"context"
+	"time"
+
+	"go.chromium.org/luci/common/clock"
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/common/logging"
+	"go.chromium.org/luci/server/span"
+
+	"infra/appengine/weetbix/internal/analysis"
+	"infra/appengine/weetbix/internal/bugs"
+	"infra/appengine/weetbix/internal/bugs/monorail"
+	"infra/appengine/weetbix/internal/clustering/rules"
+	"infra/appengine/weetbix/internal/config"
+)
+
+// batchSize is the maximum number of rules updated in each transaction.
+const batchSize = 500
+
+// AnalysisClient reads when failure association rules last matched
+// failures.
+type AnalysisClient interface {
+	// ReadRulesLastMatched reads the partition time of the most recent
+	// failure matched by each rule in the project, amongst the failures
+	// with a partition time of at least since. The result is keyed by
+	// rule ID.
+	ReadRulesLastMatched(ctx context.Context, project string, since time.Time) (map[string]time.Time, error)
+}
+
+// BugClient reads the state of bugs in a bug tracking system.
+type BugClient interface {
+	// ReadClosed reads which of the given bugs are closed. The result is
+	// keyed by bug ID.
+	ReadClosed(ctx context.Context, bugIDs []string) (map[string]bool, error)
+}
+
+// CronHandler returns the handler of the rule-hygiene cron job, which
+// cleans up the rules of each LUCI project with a rule hygiene
+// configuration. Rules are read from the clustered failures in the given
+// GCP project.
+func CronHandler(gcpProject string) func(ctx context.Context) error {
+	return func(ctx context.Context) error {
+		cfg, err := config.Get(ctx)
+		if err != nil {
+			return errors.Annotate(err, "get config").Err()
+		}
+		projectCfg, err := config.Projects(ctx)
+		if err != nil {
+			return errors.Annotate(err, "get project configs").Err()
+		}
+		ac, err := analysis.NewClient(ctx, gcpProject)
+		if err != nil {
+			return err
+		}
+		defer ac.Close()
+		mc, err := monorail.NewClient(ctx, cfg.MonorailHostname)
+		if err != nil {
+			return err
+		}
+
+		var errs []error
+		for project, pc := range projectCfg {
+			if pc.RuleHygiene == nil {
+				continue
+			}
+			h := &hygiene{
+				project:        project,
+				cfg:            pc.RuleHygiene,
+				analysisClient: ac,
+				bugClients: map[string]BugClient{
+					bugs.MonorailSystem: monorail.NewBugManager(mc, pc.Monorail),
+				},
+			}
+			if err := h.run(ctx); err != nil {
+				err = errors.Annotate(err, "in project %v", project).Err()
+				logging.Errorf(ctx, "Cleaning up rules: %s", err)
+				errs = append(errs, err)
+			}
+		}
+		if len(errs) > 0 {
+			return errors.NewMultiError(errs...)
+		}
+		return nil
+	}
+}
+
+// decision is the outcome of the rule hygiene checks for an active rule.
+type decision struct {
+	// Stale is whether the rule should be flagged as stale.
+	Stale bool
+	// Archive is whether the rule should be archived.
+	Archive bool
+}
+
+// decide decides whether an active rule which last matched a failure (or
+// was created, if later) at lastActive should be flagged as stale or be
+// archived at time now. bugClosed is whether the rule's bug is closed.
+func decide(cfg *config.RuleHygiene, now, lastActive time.Time, bugClosed bool) decision {
+	return decision{
+		Stale:   !now.Before(lastActive.Add(days(cfg.StaleAfterDays))),
+		Archive: mayArchive(cfg, now, lastActive) && bugClosed,
+	}
+}
+
+// mayArchive returns whether an active rule which last matched a failure
+// (or was created, if later) at lastActive may be archived at time now,
+// if its bug is closed.
+func mayArchive(cfg *config.RuleHygiene, now, lastActive time.Time) bool {
+	return cfg.AutoArchive && !now.Before(lastActive.Add(days(cfg.ArchiveAfterDays)))
+}
+
+// lookback returns how far back to look for failures matched by rules.
+// Rules which have not matched any failure in this period are flagged or
+// archived regardless of when they last matched a failure before it.
+func lookback(cfg *config.RuleHygiene) time.Duration {
+	if cfg.AutoArchive && cfg.ArchiveAfterDays > cfg.StaleAfterDays {
+		return days(cfg.ArchiveAfterDays)
+	}
+	return days(cfg.StaleAfterDays)
+}
+
+func days(n int64) time.Duration {
+	return time.Duration(n) * 24 * time.Hour
+}
+
+// hygiene cleans up the rules of a LUCI project.
+type hygiene struct {
+	project        string
+	cfg            *config.RuleHygiene
+	analysisClient AnalysisClient
+	// bugClients are the clients used to read the state of bugs, keyed
+	// by bug system. Rules with bugs in other systems are never archived.
+	bugClients map[string]BugClient
+}
+
+// ruleUpdate is the change to make to a rule.
+type ruleUpdate struct {
+	rule *rules.FailureAssociationRule
+	// lastMatched is the partition time of the last failure matched by
+	// the rule. Zero if the rule has not matched any failure.
+	lastMatched time.Time
+	// lastActive is the later of lastMatched and the rule creation time.
+	lastActive time.Time
+	stale      bool
+	archive    bool
+}
+
+// run flags the stale rules of the project, and archives the rules which
+// may be archived.
+func (h *hygiene) run(ctx context.Context) error {
+	now := clock.Now(ctx)
+	rs, err := rules.ReadActive(span.Single(ctx), h.project)
+	if err != nil {
+		return errors.Annotate(err, "read active rules").Err()
+	}
+	lastMatched, err := h.analysisClient.ReadRulesLastMatched(ctx, h.project, now.Add(-lookback(h.cfg)))
+	if err != nil {
+		return errors.Annotate(err, "read rules last matched").Err()
+	}
+
+	var updates []*ruleUpdate
+	// The bugs of rules which may be archived, by bug system.
+	archivable := make(map[string][]string)
+	for _, r := range rs {
+		lm := r.LastMatched
+		if t, ok := lastMatched[r.RuleID]; ok && t.After(lm) {
+			lm = t
+		}
+		lastActive := r.CreationTime
+		if lm.After(lastActive) {
+			lastActive = lm
+		}
+		updates = append(updates, &ruleUpdate{
+			rule:        r,
+			lastMatched: lm,
+			lastActive:  lastActive,
+		})
+		if _, ok := h.bugClients[r.Bug.System]; ok && mayArchive(h.cfg, now, lastActive) {
+			archivable[r.Bug.System] = append(archivable[r.Bug.System], r.Bug.ID)
+		}
+	}
+
+	closed := make(map[bugs.BugID]bool)
+	for system, ids := range archivable {
+		c, err := h.bugClients[system].ReadClosed(ctx, ids)
+		if err != nil {
+			return errors.Annotate(err, "read closed %s bugs", system).Err()
+		}
+		for id, isClosed := range c {
+			closed[bugs.BugID{System: system, ID: id}] = isClosed
+		}
+	}
+
+	var changed []*ruleUpdate
+	for _, u := range updates {
+		d := decide(h.cfg, now, u.lastActive, closed[u.rule.Bug])
+		u.stale = d.Stale
+		u.archive = d.Archive
+		if u.archive || u.stale != u.rule.IsStale || !u.lastMatched.Equal(u.rule.LastMatched) {
+			changed = append(changed, u)
+		}
+	}
+
+	archived := 0
+	for len(changed) > 0 {
+		batch := changed
+		if len(batch) > batchSize {
+			batch = batch[:batchSize]
+		}
+		changed = changed[len(batch):]
+		n, err := applyUpdates(ctx, h.project, batch)
+		if err != nil {
+			return errors.Annotate(err, "update rules").Err()
+		}
+		archived += n
+	}
+	if archived > 0 {
+		logging.Infof(ctx, "Archived %v rules which no longer match failures.", archived)
+	}
+	return nil
+}
+
+// applyUpdates applies the given rule updates in a transaction, returning
+// the number of rules archived. Rules modified since they were read are
+// skipped; they are checked again by the next run.
+func applyUpdates(ctx context.Context, project string, updates []*ruleUpdate) (int, error) {
+	archived := 0
+	_, err := span.ReadWriteTransaction(ctx, func(ctx context.Context) error {
+		archived = 0
+		for _, u := range updates {
+			r, err := rules.Read(ctx, project, u.rule.RuleID)
+			if err != nil {
+				return err
+			}
+			if !r.LastUpdated.Equal(u.rule.LastUpdated) || !r.IsActive {
+				continue
+			}
+			if u.archive {
+				r.IsActive = false
+				if err := rules.Update(ctx, r, rules.WeetbixHygiene); err != nil {
+					return err
+				}
+				archived++
+			}
+			rules.UpdateHygiene(ctx, project, r.RuleID, u.lastMatched, u.stale)
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return archived, nil
+}
@@ -0,0 +1,217 @@
+// Copyright 2021 The Chromium Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package rulehygiene
+
+import (
+	"context"
+	"sort"
+	"testing"
+	"time"
+
+	"go.chromium.org/luci/common/clock/testclock"
+	"go.chromium.org/luci/server/span"
+
+	"infra/appengine/weetbix/internal/bugs"
+	"infra/appengine/weetbix/internal/clustering/rules"
+	"infra/appengine/weetbix/internal/config"
+	"infra/appengine/weetbix/internal/testutil"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+type fakeAnalysis struct {
+	since       time.Time
+	lastMatched map[string]time.Time
+}
+
+func (f *fakeAnalysis) ReadRulesLastMatched(ctx context.Context, project string, since time.Time) (map[string]time.Time, error) {
+	f.since = since
+	return f.lastMatched, nil
+}
+
+type fakeBugs struct {
+	closed    map[string]bool
+	requested []string
+}
+
+func (f *fakeBugs) ReadClosed(ctx context.Context, bugIDs []string) (map[string]bool, error) {
+	f.requested = append(f.requested, bugIDs...)
+	result := make(map[string]bool)
+	for _, id := range bugIDs {
+		result[id] = f.closed[id]
+	}
+	return result, nil
+}
+
+func TestDecide(t *testing.T) {
+	Convey(`Decide`, t, func() {
+		now := time.Date(2021, time.December, 1, 12, 0, 0, 0, time.UTC)
+		cfg := &config.RuleHygiene{
+			StaleAfterDays:   30,
+			AutoArchive:      true,
+			ArchiveAfterDays: 90,
+		}
+		daysAgo := func(n int) time.Time {
+			return now.Add(-time.Duration(n) * 24 * time.Hour)
+		}
+
+		testCases := []struct {
+			name        string
+			lastActive  time.Time
+			autoArchive bool
+			bugClosed   bool
+			expected    decision
+		}{
+			{
+				name:        "Recently matched",
+				lastActive:  daysAgo(29),
+				autoArchive: true,
+				bugClosed:   true,
+				expected:    decision{},
+			},
+			{
+				name:        "Unmatched for stale period",
+				lastActive:  daysAgo(30),
+				autoArchive: true,
+				bugClosed:   false,
+				expected:    decision{Stale: true},
+			},
+			{
+				name:        "Unmatched for less than archive period, bug closed",
+				lastActive:  daysAgo(89),
+				autoArchive: true,
+				bugClosed:   true,
+				expected:    decision{Stale: true},
+			},
+			{
+				name:        "Unmatched for archive period, bug open",
+				lastActive:  daysAgo(90),
+				autoArchive: true,
+				bugClosed:   false,
+				expected:    decision{Stale: true},
+			},
+			{
+				name:        "Unmatched for archive period, bug closed",
+				lastActive:  daysAgo(90),
+				autoArchive: true,
+				bugClosed:   true,
+				expected:    decision{Stale: true, Archive: true},
+			},
+			{
+				name:        "Unmatched for archive period, bug closed, auto archive off",
+				lastActive:  daysAgo(90),
+				autoArchive: false,
+				bugClosed:   true,
+				expected:    decision{Stale: true},
+			},
+		}
+		for _, tc := range testCases {
+			Convey(tc.name, func() {
+				cfg.AutoArchive = tc.autoArchive
+				So(decide(cfg, now, tc.lastActive, tc.bugClosed), ShouldResemble, tc.expected)
+			})
+		}
+	})
+}
+
+func TestRun(t *testing.T) {
+	Convey(`With Spanner Test Database`, t, func() {
+		ctx := testutil.SpannerTestContext(t)
+		// Use a time representable in Spanner, which stores timestamps
+		// with microsecond precision.
+		now := time.Date(2021, time.December, 1, 12, 0, 0, 0, time.UTC)
+		ctx, _ = testclock.UseTime(ctx, now)
+		daysAgo := func(n int) time.Time {
+			return now.Add(-time.Duration(n) * 24 * time.Hour)
+		}
+
+		// Rules are created in 1900 unless otherwise specified.
+		matched := rules.NewRule(1).Build()
+		stale := rules.NewRule(2).Build()
+		archived := rules.NewRule(3).Build()
+		recent := rules.NewRule(4).WithCreationTime(daysAgo(10)).Build()
+		unflagged := rules.NewRule(5).WithStale(true).WithLastMatched(daysAgo(100)).Build()
+		notArchived := rules.NewRule(6).WithLastMatched(daysAgo(40)).Build()
+		inactive := rules.NewRule(7).WithActive(false).Build()
+		rs := []*rules.FailureAssociationRule{matched, stale, archived, recent, unflagged, notArchived, inactive}
+		So(rules.SetRulesForTesting(ctx, rs), ShouldBeNil)
+
+		ac := &fakeAnalysis{
+			lastMatched: map[string]time.Time{
+				matched.RuleID:   daysAgo(1),
+				unflagged.RuleID: daysAgo(2),
+			},
+		}
+		bc := &fakeBugs{
+			closed: map[string]bool{
+				archived.Bug.ID:    true,
+				notArchived.Bug.ID: true,
+				inactive.Bug.ID:    true,
+			},
+		}
+		h := &hygiene{
+			project: "myproject",
+			cfg: &config.RuleHygiene{
+				StaleAfterDays:   30,
+				AutoArchive:      true,
+				ArchiveAfterDays: 90,
+			},
+			analysisClient: ac,
+			bugClients: map[string]BugClient{
+				bugs.MonorailSystem: bc,
+			},
+		}
+		readRule := func(r *rules.FailureAssociationRule) *rules.FailureAssociationRule {
+			txn, cancel := span.ReadOnlyTransaction(ctx)
+			defer cancel()
+			rule, err := rules.Read(txn, r.Project, r.RuleID)
+			So(err, ShouldBeNil)
+			return rule
+		}
+
+		So(h.run(ctx), ShouldBeNil)
+
+		Convey(`Reads matches over the archive period`, func() {
+			So(ac.since, ShouldResemble, daysAgo(90))
+		})
+		Convey(`Reads only the bugs of rules which may be archived`, func() {
+			sort.Strings(bc.requested)
+			So(bc.requested, ShouldResemble, []string{stale.Bug.ID, archived.Bug.ID})
+		})
+		Convey(`Records last matched time`, func() {
+			expected := *matched
+			expected.LastMatched = daysAgo(1)
+			So(readRule(matched), ShouldResemble, &expected)
+		})
+		Convey(`Flags stale rules`, func() {
+			expected := *stale
+			expected.IsStale = true
+			So(readRule(stale), ShouldResemble, &expected)
+
+			expected = *notArchived
+			expected.IsStale = true
+			So(readRule(notArchived), ShouldResemble, &expected)
+		})
+		Convey(`Unflags rules which match again`, func() {
+			expected := *unflagged
+			expected.LastMatched = daysAgo(2)
+			expected.IsStale = false
+			So(readRule(unflagged), ShouldResemble, &expected)
+		})
+		Convey(`Does not flag recently created rules`, func() {
+			So(readRule(recent), ShouldResemble, recent)
+		})
+		Convey(`Archives rules with closed bugs`, func() {
+			rule := readRule(archived)
+			So(rule.IsActive, ShouldBeFalse)
+			So(rule.IsStale, ShouldBeTrue)
+			So(rule.LastUpdatedUser, ShouldEqual, rules.WeetbixHygiene)
+			So(rule.LastUpdated, ShouldHappenAfter, archived.LastUpdated)
+		})
+		Convey(`Does not change inactive rules`, func() {
+			So(readRule(inactive), ShouldResemble, inactive)
+		})
+	})
+}
@@ -0,0 +1,93 @@
+// Copyright 2021 The Chromium Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package testvariantbqexporter
+
+import (
+	"context"
+	"time"
+
+	"cloud.google.com/go/spanner"
+	"google.golang.org/grpc/codes"
+
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/server/span"
+
+	spanutil "infra/appengine/weetbix/internal/span"
+)
+
+const (
+	// fullExportInterval is the interval between two full exports of a table.
+	// Incremental exports in between only export the test variants modified
+	// since the previous export.
+	fullExportInterval = 7 * 24 * time.Hour
+	// maxCatchUp is how far back an incremental export goes at most if
+	// previous exports failed, so that a table which has not been exported
+	// for long does not cause an unbounded export.
+	maxCatchUp = 7 * 24 * time.Hour
+)
+
+// checkpoint records how far the test variants of a realm were exported to a
+// BigQuery table.
+type checkpoint struct {
+	// The end of the time range of the last successful export.
+	ExportedUntil time.Time
+	// The end of the time range of the last successful full export.
+	LastFullExportTime time.Time
+}
+
+var checkpointColumns = []string{"ExportedUntil", "LastFullExportTime"}
+
+// readCheckpoint reads the checkpoint of the export of the test variants of
+// a realm to a BigQuery table. It returns nil if the table was never
+// exported incrementally.
+func readCheckpoint(ctx context.Context, realm, cloudProject, dataset, table string) (*checkpoint, error) {
+	row, err := span.ReadRow(ctx, "TestVariantBqExportCheckpoints", spanner.Key{realm, cloudProject, dataset, table}, checkpointColumns)
+	if spanner.ErrCode(err) == codes.NotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.Annotate(err, "read checkpoint").Err()
+	}
+	cp := &checkpoint{}
+	if err := row.Columns(&cp.ExportedUntil, &cp.LastFullExportTime); err != nil {
+		return nil, errors.Annotate(err, "read checkpoint row").Err()
+	}
+	return cp, nil
+}
+
+// advanceCheckpoint records that the test variants of a realm were exported
+// to a BigQuery table until exportedUntil, fully if full is true.
+//
+// Checkpoints only move forward: if a concurrent or later export already
+// advanced the checkpoint past exportedUntil, it is left as is.
+func advanceCheckpoint(ctx context.Context, realm, cloudProject, dataset, table string, exportedUntil time.Time, full bool) error {
+	_, err := span.ReadWriteTransaction(ctx, func(ctx context.Context) error {
+		cp, err := readCheckpoint(ctx, realm, cloudProject, dataset, table)
+		if err != nil {
+			return err
+		}
+		lastFull := time.Time{}
+		if cp != nil {
+			if !exportedUntil.After(cp.ExportedUntil) {
+				return nil
+			}
+			lastFull = cp.LastFullExportTime
+		}
+		if full && exportedUntil.After(lastFull) {
+			lastFull = exportedUntil
+		}
+		span.BufferWrite(ctx, spanutil.InsertOrUpdateMap("TestVariantBqExportCheckpoints", map[string]interface{}{
+			"Realm":              realm,
+			"CloudProject":       cloudProject,
+			"Dataset":            dataset,
+			"TableName":          table,
+			"ExportedUntil":      exportedUntil,
+			"LastFullExportTime": lastFull,
+			"LastUpdated":        spanner.CommitTimestamp,
+		}))
+		return nil
+	})
+	return err
+}
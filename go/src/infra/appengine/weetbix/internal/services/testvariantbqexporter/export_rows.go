@@ -7,6 +7,7 @@ package testvariantbqexporter
 import (
 	"context"
 	"net/http"
+	"time"
 
 	"cloud.google.com/go/bigquery"
 	"golang.org/x/sync/semaphore"
@@ -41,6 +42,10 @@ type Options struct {
 	Table        string
 	Predicate    *pb.AnalyzedTestVariantPredicate
 	TimeRange    *pb.TimeRange
+	// Incremental exports only export the test variants modified since the
+	// previous export, and extend TimeRange back to it. See
+	// exportIncrementally.
+	Incremental bool
 }
 
 // BQExporter exports test variant rows to the dedicated table.
@@ -54,6 +59,13 @@ type BQExporter struct {
 
 	// batchSem limits the number of batches we hold in memory at a time.
 	batchSem *semaphore.Weighted
+
+	// modifiedSince, if set, restricts the export to the test variants
+	// modified since.
+	modifiedSince time.Time
+
+	// exportTime is the time the rows are exported.
+	exportTime time.Time
 }
 
 func CreateBQExporter(options *Options) *BQExporter {
@@ -93,6 +105,9 @@ func (b *BQExporter) ExportRows(ctx context.Context) error {
 	}
 
 	inserter := bqutil.NewInserter(table, maxBatchRowCount)
+	if b.options.Incremental {
+		return b.exportIncrementally(ctx, inserter)
+	}
 	if err = b.exportTestVariantRows(ctx, inserter); err != nil {
 		return errors.Annotate(err, "export test variant rows").Err()
 	}
@@ -0,0 +1,70 @@
+// Copyright 2021 The Chromium Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package testvariantbqexporter
+
+import (
+	"context"
+
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/common/logging"
+	"go.chromium.org/luci/server/span"
+
+	"infra/appengine/weetbix/pbutil"
+	pb "infra/appengine/weetbix/proto/v1"
+)
+
+// exportIncrementally exports the test variants modified since the previous
+// export of the table, then advances the checkpoint of the table.
+//
+// The time range is extended back to the end of the previous successful
+// export, by at most maxCatchUp, so that exports which failed are caught up
+// on. Every fullExportInterval, and if the table has no checkpoint yet, all
+// test variants are exported regardless of when they were modified.
+//
+// Exports up to a time the checkpoint is already past are skipped, so that
+// retried tasks do not export the same rows again.
+func (b *BQExporter) exportIncrementally(ctx context.Context, ins inserter) error {
+	o := b.options
+	latest, err := pbutil.AsTime(o.TimeRange.GetLatest())
+	if err != nil {
+		return err
+	}
+	earliest, err := pbutil.AsTime(o.TimeRange.GetEarliest())
+	if err != nil {
+		return err
+	}
+
+	cp, err := readCheckpoint(span.Single(ctx), o.Realm, o.CloudProject, o.Dataset, o.Table)
+	if err != nil {
+		return err
+	}
+	if cp != nil && !cp.ExportedUntil.Before(latest) {
+		logging.Infof(ctx, "test variants of %s already exported to %s.%s.%s until %s", o.Realm, o.CloudProject, o.Dataset, o.Table, cp.ExportedUntil)
+		return nil
+	}
+
+	full := cp == nil || latest.Sub(cp.LastFullExportTime) >= fullExportInterval
+	if cp != nil && cp.ExportedUntil.Before(earliest) {
+		earliest = cp.ExportedUntil
+	}
+	if latest.Sub(earliest) > maxCatchUp {
+		earliest = latest.Add(-maxCatchUp)
+	}
+	o.TimeRange = &pb.TimeRange{
+		Earliest: pbutil.MustTimestampProto(earliest),
+		Latest:   o.TimeRange.Latest,
+	}
+	if !full {
+		b.modifiedSince = earliest
+	}
+
+	if err := b.exportTestVariantRows(ctx, ins); err != nil {
+		return errors.Annotate(err, "export test variant rows").Err()
+	}
+	if err := advanceCheckpoint(ctx, o.Realm, o.CloudProject, o.Dataset, o.Table, latest, full); err != nil {
+		return errors.Annotate(err, "advance checkpoint").Err()
+	}
+	return nil
+}
@@ -0,0 +1,165 @@
+// Copyright 2021 The Chromium Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package testvariantbqexporter
+
+import (
+	"sort"
+	"testing"
+	"time"
+
+	"cloud.google.com/go/spanner"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"go.chromium.org/luci/common/clock"
+	"go.chromium.org/luci/server/span"
+
+	spanutil "infra/appengine/weetbix/internal/span"
+	"infra/appengine/weetbix/internal/testutil"
+	"infra/appengine/weetbix/internal/testutil/insert"
+	bqpb "infra/appengine/weetbix/proto/bq"
+	pb "infra/appengine/weetbix/proto/v1"
+
+	. "github.com/smartystreets/goconvey/convey"
+	. "go.chromium.org/luci/common/testing/assertions"
+)
+
+func TestExportIncrementally(t *testing.T) {
+	Convey(`exportIncrementally`, t, func() {
+		ctx := testutil.SpannerTestContext(t)
+		realm := "chromium:ci"
+		// Unmodified is a test variant not modified in the last day, modified
+		// is one modified just now.
+		unmodified := "ninja://unmodified"
+		modified := "ninja://modified"
+		vh := "varianthash"
+		now := clock.Now(ctx).Round(time.Microsecond)
+		latest := now.Truncate(time.Hour).UTC()
+		halfHAgo := latest.Add(-30 * time.Minute)
+		testutil.MustApply(ctx,
+			insert.AnalyzedTestVariant(realm, unmodified, vh, pb.AnalyzedTestVariantStatus_FLAKY, map[string]interface{}{
+				"StatusUpdateTime": latest.Add(-48 * time.Hour),
+				"LastModified":     latest.Add(-24 * time.Hour),
+			}),
+			insert.AnalyzedTestVariant(realm, modified, vh, pb.AnalyzedTestVariantStatus_FLAKY, map[string]interface{}{
+				"StatusUpdateTime": latest.Add(-48 * time.Hour),
+			}),
+			insert.Verdict(realm, unmodified, vh, "build-1", pb.VerdictStatus_VERDICT_FLAKY, halfHAgo, map[string]interface{}{
+				"IngestionTime": halfHAgo,
+			}),
+			insert.Verdict(realm, modified, vh, "build-1", pb.VerdictStatus_VERDICT_FLAKY, halfHAgo, map[string]interface{}{
+				"IngestionTime": halfHAgo,
+			}),
+		)
+
+		insertCheckpoint := func(exportedUntil, lastFullExportTime time.Time) {
+			testutil.MustApply(ctx, spanutil.InsertMap("TestVariantBqExportCheckpoints", map[string]interface{}{
+				"Realm":              realm,
+				"CloudProject":       "cloud_project",
+				"Dataset":            "dataset",
+				"TableName":          "table",
+				"ExportedUntil":      exportedUntil,
+				"LastFullExportTime": lastFullExportTime,
+				"LastUpdated":        spanner.CommitTimestamp,
+			}))
+		}
+		readCP := func() *checkpoint {
+			cp, err := readCheckpoint(span.Single(ctx), realm, "cloud_project", "dataset", "table")
+			So(err, ShouldBeNil)
+			return cp
+		}
+
+		br := CreateBQExporter(&Options{
+			Realm:        realm,
+			CloudProject: "cloud_project",
+			Dataset:      "dataset",
+			Table:        "table",
+			TimeRange: &pb.TimeRange{
+				Earliest: timestamppb.New(latest.Add(-time.Hour)),
+				Latest:   timestamppb.New(latest),
+			},
+			Incremental: true,
+		})
+
+		export := func() []*bqpb.TestVariantRow {
+			ins := &mockPassInserter{}
+			So(br.exportIncrementally(ctx, ins), ShouldBeNil)
+			rows := make([]*bqpb.TestVariantRow, len(ins.insertedMessages))
+			for i, m := range ins.insertedMessages {
+				rows[i] = m.Message.(*bqpb.TestVariantRow)
+			}
+			sort.Slice(rows, func(i, j int) bool { return rows[i].TestId < rows[j].TestId })
+			return rows
+		}
+		testIDs := func(rows []*bqpb.TestVariantRow) []string {
+			ids := make([]string, len(rows))
+			for i, r := range rows {
+				ids[i] = r.TestId
+			}
+			return ids
+		}
+
+		Convey(`without checkpoint`, func() {
+			rows := export()
+			So(testIDs(rows), ShouldResemble, []string{modified, unmodified})
+			So(rows[0].TimeRange.Earliest, ShouldResembleProto, timestamppb.New(latest.Add(-time.Hour)))
+			So(rows[0].ExportTime, ShouldNotBeNil)
+
+			cp := readCP()
+			So(cp.ExportedUntil, ShouldEqual, latest)
+			So(cp.LastFullExportTime, ShouldEqual, latest)
+
+			Convey(`retry is skipped`, func() {
+				So(export(), ShouldBeEmpty)
+				So(readCP(), ShouldResemble, cp)
+			})
+		})
+
+		Convey(`incremental`, func() {
+			lastFull := latest.Add(-24 * time.Hour)
+			insertCheckpoint(latest.Add(-time.Hour), lastFull)
+			rows := export()
+			So(testIDs(rows), ShouldResemble, []string{modified})
+
+			cp := readCP()
+			So(cp.ExportedUntil, ShouldEqual, latest)
+			So(cp.LastFullExportTime, ShouldEqual, lastFull)
+		})
+
+		Convey(`catches up on failed exports`, func() {
+			insertCheckpoint(latest.Add(-3*time.Hour), latest.Add(-24*time.Hour))
+			rows := export()
+			So(testIDs(rows), ShouldResemble, []string{modified})
+			So(rows[0].TimeRange.Earliest, ShouldResembleProto, timestamppb.New(latest.Add(-3*time.Hour)))
+			So(readCP().ExportedUntil, ShouldEqual, latest)
+		})
+
+		Convey(`full export is due`, func() {
+			insertCheckpoint(latest.Add(-30*24*time.Hour), latest.Add(-30*24*time.Hour))
+			rows := export()
+			So(testIDs(rows), ShouldResemble, []string{modified, unmodified})
+			// Catching up is capped.
+			So(rows[0].TimeRange.Earliest, ShouldResembleProto, timestamppb.New(latest.Add(-maxCatchUp)))
+
+			cp := readCP()
+			So(cp.ExportedUntil, ShouldEqual, latest)
+			So(cp.LastFullExportTime, ShouldEqual, latest)
+		})
+
+		Convey(`checkpoint is not advanced on failure`, func() {
+			insertCheckpoint(latest.Add(-time.Hour), latest.Add(-24*time.Hour))
+			err := br.exportIncrementally(ctx, &mockFailInserter{})
+			So(err, ShouldErrLike, "some error")
+			So(readCP().ExportedUntil, ShouldEqual, latest.Add(-time.Hour))
+		})
+
+		Convey(`checkpoint does not move backwards`, func() {
+			insertCheckpoint(latest.Add(time.Hour), latest.Add(time.Hour))
+			So(advanceCheckpoint(ctx, realm, "cloud_project", "dataset", "table", latest, true), ShouldBeNil)
+			cp := readCP()
+			So(cp.ExportedUntil, ShouldEqual, latest.Add(time.Hour))
+			So(cp.LastFullExportTime, ShouldEqual, latest.Add(time.Hour))
+		})
+	})
+}
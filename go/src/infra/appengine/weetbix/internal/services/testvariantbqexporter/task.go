@@ -48,6 +48,7 @@ func RegisterTaskClass() {
 				Table:        task.Table,
 				Predicate:    task.Predicate,
 				TimeRange:    task.TimeRange,
+				Incremental:  task.Incremental,
 			})
 			return br.ExportRows(ctx)
 		},
@@ -55,12 +56,17 @@ func RegisterTaskClass() {
 }
 
 // Schedule enqueues a task to export AnalyzedTestVariant rows to BigQuery.
-func Schedule(ctx context.Context, realm, cloudProject, dataset, table string, predicate *pb.AnalyzedTestVariantPredicate, timeRange *pb.TimeRange) error {
+// If incremental is true, the task only exports the test variants modified
+// since the previous incremental export of the table.
+func Schedule(ctx context.Context, realm, cloudProject, dataset, table string, predicate *pb.AnalyzedTestVariantPredicate, timeRange *pb.TimeRange, incremental bool) error {
 	earliest, err := pbutil.AsTime(timeRange.Earliest)
 	if err != nil {
 		return err
 	}
 	key := fmt.Sprintf("%s-%s-%s-%s-%d", realm, cloudProject, dataset, url.PathEscape(table), earliest.Unix())
+	if incremental {
+		key += "-incremental"
+	}
 	return tq.AddTask(ctx, &tq.Task{
 		Title: key,
 		Payload: &taskspb.ExportTestVariants{
@@ -70,6 +76,7 @@ func Schedule(ctx context.Context, realm, cloudProject, dataset, table string, p
 			Table:        table,
 			Predicate:    predicate,
 			TimeRange:    timeRange,
+			Incremental:  incremental,
 		},
 		DeduplicationKey: key,
 	})
@@ -105,7 +112,7 @@ func ScheduleTasks(ctx context.Context) error {
 				if table == nil {
 					continue
 				}
-				err := Schedule(ctx, fullRealm, table.CloudProject, table.Dataset, table.Table, bqc.GetPredicate(), timeRange)
+				err := Schedule(ctx, fullRealm, table.CloudProject, table.Dataset, table.Table, bqc.GetPredicate(), timeRange, true)
 				if err != nil {
 					errs = append(errs, err)
 				}
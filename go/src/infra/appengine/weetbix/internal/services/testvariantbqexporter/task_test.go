@@ -52,8 +52,21 @@ func TestSchedule(t *testing.T) {
 			Predicate:    predicate,
 			TimeRange:    timeRange,
 		}
-		So(Schedule(ctx, realm, cloudProject, dataset, table, predicate, timeRange), ShouldBeNil)
+		So(Schedule(ctx, realm, cloudProject, dataset, table, predicate, timeRange, false), ShouldBeNil)
 		So(skdr.Tasks().Payloads()[0], ShouldResembleProto, task)
+
+		Convey(`incremental`, func() {
+			So(Schedule(ctx, realm, cloudProject, dataset, table, predicate, timeRange, true), ShouldBeNil)
+			// The incremental task is not deduplicated with the other one.
+			incremental := 0
+			for _, p := range skdr.Tasks().Payloads() {
+				if p.(*taskspb.ExportTestVariants).Incremental {
+					incremental++
+				}
+			}
+			So(skdr.Tasks().Payloads(), ShouldHaveLength, 2)
+			So(incremental, ShouldEqual, 1)
+		})
 	})
 }
 
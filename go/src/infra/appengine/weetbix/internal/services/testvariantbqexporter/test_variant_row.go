@@ -22,6 +22,7 @@ import (
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"go.chromium.org/luci/common/bq"
+	"go.chromium.org/luci/common/clock"
 	"go.chromium.org/luci/common/errors"
 	"go.chromium.org/luci/common/logging"
 	"go.chromium.org/luci/server/span"
@@ -50,8 +51,9 @@ func generateStatement(tmpl *template.Template, input interface{}) (spanner.Stat
 
 func (b *BQExporter) populateQueryParameters() (inputs, params map[string]interface{}, err error) {
 	inputs = map[string]interface{}{
-		"TestIdFilter": b.options.Predicate.GetTestIdRegexp() != "",
-		"StatusFilter": b.options.Predicate.GetStatus() != pb.AnalyzedTestVariantStatus_STATUS_UNSPECIFIED,
+		"TestIdFilter":        b.options.Predicate.GetTestIdRegexp() != "",
+		"StatusFilter":        b.options.Predicate.GetStatus() != pb.AnalyzedTestVariantStatus_STATUS_UNSPECIFIED,
+		"ModifiedSinceFilter": !b.modifiedSince.IsZero(),
 	}
 
 	params = map[string]interface{}{
@@ -79,6 +81,10 @@ func (b *BQExporter) populateQueryParameters() (inputs, params map[string]interf
 		params["status"] = int(status)
 	}
 
+	if !b.modifiedSince.IsZero() {
+		params["modifiedSince"] = b.modifiedSince
+	}
+
 	switch p := b.options.Predicate.GetVariant().GetPredicate().(type) {
 	case *pb.VariantPredicate_Equals:
 		inputs["VariantHashEquals"] = true
@@ -384,6 +390,7 @@ func (b *BQExporter) generateTestVariantRows(ctx context.Context, row *spanner.R
 		newTV.TimeRange = str.tr
 		newTV.PartitionTime = str.tr.Latest
 		newTV.Status = str.status.String()
+		newTV.ExportTime = timestamppb.New(b.exportTime)
 		b.populateFlakeStatistics(newTV, vs[0], verdicts, str.tr)
 		b.populateVerdictsInRange(newTV, verdicts, str.tr)
 		tvs = append(tvs, newTV)
@@ -504,6 +511,7 @@ func (b *BQExporter) insertRows(ctx context.Context, ins inserter, rowProtos []*
 }
 
 func (b *BQExporter) exportTestVariantRows(ctx context.Context, ins inserter) error {
+	b.exportTime = clock.Now(ctx)
 	batchC := make(chan []*bqpb.TestVariantRow)
 	eg, ctx := errgroup.WithContext(ctx)
 
@@ -548,6 +556,10 @@ var testVariantRowsTmpl = template.Must(template.New("testVariantRowsTmpl").Pars
 				OR StatusUpdateTime > @startTime
 			)
     {{end}}
+		{{/* Filter by modification time, for incremental exports */}}
+		{{if .ModifiedSinceFilter}}
+			AND LastModified >= @modifiedSince
+		{{end}}
 	)
 
 	SELECT
@@ -177,6 +177,7 @@ func updateTestVariantStatus(ctx context.Context, task *taskspb.UpdateTestVarian
 			}
 
 			vals["StatusUpdateTime"] = spanner.CommitTimestamp
+			vals["LastModified"] = spanner.CommitTimestamp
 			if newStatus != pb.AnalyzedTestVariantStatus_CONSISTENTLY_EXPECTED && newStatus != pb.AnalyzedTestVariantStatus_NO_NEW_RESULTS {
 				// Only schedule the next UpdateTestVariant task if the test variant
 				// still has unexpected failures.
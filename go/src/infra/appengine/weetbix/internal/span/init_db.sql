@@ -68,6 +68,26 @@ CREATE TABLE AnalyzedTestVariants (
   FlakeStatistics BYTES(MAX),
   -- Timestamp when the most recent flake statistics were computed.
   FlakeStatisticUpdateTime TIMESTAMP,
+
+  -- Flakiness score of the test variant, between 0 and 1. It is the weighted
+  -- rate of recent verdicts with unexpected results.
+  -- See analyzedtestvariants.FlakinessScore for the formula.
+  FlakinessScore FLOAT64,
+  -- Timestamp when the flakiness score was last computed.
+  FlakinessScoreUpdateTime TIMESTAMP,
+  -- Whether new verdicts were ingested since the flakiness score was last
+  -- computed. The only allowed values are true or NULL (to indicate false),
+  -- so that AnalyzedTestVariantsByFlakinessScoreStale only indexes the test
+  -- variants whose score needs recomputing.
+  FlakinessScoreStale BOOL,
+
+  -- Timestamp when the row was last modified in a way that changes its
+  -- exported BigQuery rows, i.e. when a verdict of the test variant was
+  -- ingested or its status changed. Recomputing the flakiness score does not
+  -- count. Incremental BigQuery exports only export the test variants
+  -- modified since the previous export.
+  -- NULL if the row was not modified since the column was added.
+  LastModified TIMESTAMP OPTIONS (allow_commit_timestamp=true),
 ) PRIMARY KEY (Realm, TestId, VariantHash);
 
 -- Used by finding test variants with FLAKY status on a builder in
@@ -75,6 +95,20 @@ CREATE TABLE AnalyzedTestVariants (
 CREATE NULL_FILTERED INDEX AnalyzedTestVariantsByBuilderAndStatus
 ON AnalyzedTestVariants (Realm, Builder, Status);
 
+-- Used by finding test variants whose flakiness score needs recomputing.
+CREATE NULL_FILTERED INDEX AnalyzedTestVariantsByFlakinessScoreStale
+ON AnalyzedTestVariants (FlakinessScoreStale);
+
+-- Used by finding the flakiest test variants, and flakiness scores that need
+-- to decay.
+CREATE NULL_FILTERED INDEX AnalyzedTestVariantsByFlakinessScore
+ON AnalyzedTestVariants (FlakinessScore DESC) STORING (FlakinessScoreUpdateTime);
+
+-- Used by finding the test variants modified since the previous incremental
+-- BigQuery export.
+CREATE NULL_FILTERED INDEX AnalyzedTestVariantsByLastModified
+ON AnalyzedTestVariants (Realm, LastModified);
+
 -- Stores results of a test variant in one invocation.
 CREATE TABLE Verdicts (
   -- Primary Key of the parent AnalyzedTestVariants.
@@ -194,8 +228,54 @@ CREATE TABLE FailureAssociationRules (
   -- This is the algorithm-specific ID component of the suggested cluster
   -- this rule was created from.
   SourceClusterId STRING(32) NOT NULL,
+  -- The partition time of the most recent failure matched by the rule, as
+  -- last observed by the rule hygiene cron job. NULL if the rule has not
+  -- been observed to match any failure.
+  -- This is not an update to the rule, so does not change LastUpdated.
+  LastMatched TIMESTAMP,
+  -- Whether the rule has not matched any failure for the period configured
+  -- in the project's rule hygiene configuration. The only allowed values
+  -- are true or NULL (to indicate false).
+  -- This is not an update to the rule, so does not change LastUpdated.
+  IsStale BOOL,
+  -- The time the bug updater first observed the rule's bug to be fixed
+  -- (i.e. marked Fixed or Verified). NULL if the bug is not fixed, or the
+  -- project does not synchronise rules with the status of their bugs.
+  -- This is not an update to the rule, so does not change LastUpdated.
+  BugFixedTime TIMESTAMP,
+  -- The time since which the cluster of the rule has been observed to have
+  -- no impact, while its bug is fixed. NULL if the bug is not fixed or the
+  -- cluster has impact.
+  -- This is not an update to the rule, so does not change LastUpdated.
+  ImpactFreeSince TIMESTAMP,
+  -- Whether failures recurred after the rule's bug was fixed. Cleared when
+  -- the bug is fixed again. The only allowed values are true or NULL (to
+  -- indicate false).
+  -- This is not an update to the rule, so does not change LastUpdated.
+  IsRecurred BOOL,
 ) PRIMARY KEY (Project, RuleId);
 
+-- FailureAssociationRuleHistory records the changes made to failure
+-- association rules by the Weetbix system in response to changes in the
+-- status of their bugs, e.g. the bug being fixed or failures recurring.
+CREATE TABLE FailureAssociationRuleHistory (
+  -- The LUCI Project of the rule.
+  Project STRING(40) NOT NULL,
+  -- The identifier of the rule.
+  RuleId STRING(32) NOT NULL,
+  -- The time of the change.
+  ChangeTime TIMESTAMP NOT NULL OPTIONS (allow_commit_timestamp=true),
+  -- The kind of change, e.g. "bug_fixed", "bug_reopened", "recurred" or
+  -- "archived".
+  Action STRING(32) NOT NULL,
+  -- The user which made the change. This is 'weetbix' for changes made by
+  -- Weetbix itself.
+  User STRING(320) NOT NULL,
+  -- A human-readable description of the change.
+  Details STRING(MAX) NOT NULL,
+) PRIMARY KEY (Project, RuleId, ChangeTime DESC, Action),
+INTERLEAVE IN PARENT FailureAssociationRules ON DELETE CASCADE;
+
 -- The failure association rule associated with a bug. This also enforces
 -- the constraint that each rule must have a unique bug, even if the rules
 -- are in different LUCI Projects.
@@ -227,6 +307,10 @@ CREATE TABLE ClusteringState (
   -- recently updated failure association rule in the snapshot of failure
   -- association rules used to match the test results.
   RulesVersion TIMESTAMP NOT NULL,
+  -- The version of project configuration used to cluster test results in
+  -- this chunk. This is the time the configuration was fetched from LUCI
+  -- Config.
+  ConfigVersion TIMESTAMP NOT NULL,
   -- Serialized ChunkClusters proto containing which test result is in which
   -- cluster.
   Clusters BYTES(MAX) NOT NULL,
@@ -234,6 +318,37 @@ CREATE TABLE ClusteringState (
   LastUpdated TIMESTAMP NOT NULL OPTIONS (allow_commit_timestamp=true),
 ) PRIMARY KEY (Project, ChunkId);
 
+-- ClusteredFailureExports is the outbox of rows to be exported to the
+-- clustered_failures BigQuery table. Entries are written in the same
+-- transaction as the (re-)clustering which produced them, and deleted once
+-- their rows are exported, so that no rows are lost if the export fails.
+CREATE TABLE ClusteredFailureExports (
+  -- The LUCI Project the rows belong to.
+  Project STRING(40) NOT NULL,
+  -- The identity of the (re-)clustered chunk of test results.
+  ChunkId STRING(32) NOT NULL,
+  -- The Spanner commit timestamp of the (re-)clustering. Rows are exported
+  -- with this as their last updated time.
+  CommitTime TIMESTAMP NOT NULL OPTIONS (allow_commit_timestamp=true),
+  -- The sequence number of the entry amongst those written for the chunk
+  -- in the same transaction.
+  Sequence INT64 NOT NULL,
+  -- The rows to export. Each is a serialized weetbix.bq.ClusteredFailureRow
+  -- proto, without its last updated time.
+  Rows ARRAY<BYTES(MAX)> NOT NULL,
+  -- The number of failed attempts to export the rows.
+  Attempts INT64 NOT NULL,
+  -- The earliest time the export of the rows may be attempted again.
+  -- NULL if no attempt has failed.
+  NextAttemptTime TIMESTAMP,
+  -- The error of the last failed attempt. NULL if no attempt has failed.
+  LastError STRING(MAX),
+  -- Whether the entry was quarantined after failing to export too many
+  -- times. Quarantined entries are not exported again. Uses the value
+  -- NULL to indicate false, and TRUE to indicate true.
+  Quarantined BOOL,
+) PRIMARY KEY (Project, ChunkId, CommitTime, Sequence);
+
 -- ReclusteringRuns contains details of runs used to re-cluster test results.
 CREATE TABLE ReclusteringRuns (
   -- The LUCI Project.
@@ -248,6 +363,10 @@ CREATE TABLE ReclusteringRuns (
   -- Chunks with a RulesVersion less than this value are eligible to be
   -- re-clustered.
   RulesVersion TIMESTAMP NOT NULL,
+  -- The minimum project configuration version the reclustering run is
+  -- trying to achieve. Chunks with a ConfigVersion less than this value are
+  -- eligible to be re-clustered.
+  ConfigVersion TIMESTAMP NOT NULL,
   -- The number of shards created for this run (for this LUCI project).
   ShardCount INT64 NOT NULL,
   -- The number of shards that have reported progress (at least once).
@@ -259,6 +378,239 @@ CREATE TABLE ReclusteringRuns (
   Progress INT64 NOT NULL,
 ) PRIMARY KEY (Project, AttemptTimestamp DESC);
 
+-- ProjectUpdateStatus records the outcome of the most recent updates of
+-- cluster analysis and bugs for each LUCI project. Used to detect projects
+-- falling behind.
+CREATE TABLE ProjectUpdateStatus (
+  -- The LUCI Project.
+  Project STRING(40) NOT NULL,
+  -- The time the last update to succeed completed.
+  -- NULL if no update has succeeded.
+  LastSuccessTime TIMESTAMP,
+  -- The time the last update to fail completed.
+  -- NULL if no update has failed.
+  LastErrorTime TIMESTAMP,
+  -- The error that caused the last failed update.
+  -- NULL if no update has failed.
+  LastError STRING(MAX),
+) PRIMARY KEY (Project);
+
+-- DeferredBugActions records the changes to bug-tracking systems which the
+-- bug updater of each LUCI project deferred because changes were throttled.
+-- Deferred changes are attempted before other changes in the next run.
+CREATE TABLE DeferredBugActions (
+  -- The LUCI Project.
+  Project STRING(40) NOT NULL,
+  -- The kind of change: "create" to file a bug for a suggested cluster, or
+  -- "update" to update a bug.
+  Action STRING(16) NOT NULL,
+  -- The subject of the change. For "create", the suggested cluster, as
+  -- "{algorithm}:{id}". For "update", the bug, as "{system}:{id}".
+  Subject STRING(MAX) NOT NULL,
+  -- The reason the change was last deferred, e.g. "run_quota".
+  Reason STRING(32) NOT NULL,
+  -- The time the change was first deferred.
+  FirstDeferredTime TIMESTAMP NOT NULL,
+) PRIMARY KEY (Project, Action, Subject);
+
+-- ProjectPurges records requests to delete the data of LUCI projects
+-- offboarded from Weetbix, and the progress of each deletion.
+CREATE TABLE ProjectPurges (
+  -- The LUCI Project.
+  Project STRING(40) NOT NULL,
+  -- The token which must be presented to confirm the purge. Issued by a
+  -- dry run of the purge.
+  ConfirmToken STRING(32) NOT NULL,
+  -- The time the confirmation token was issued.
+  TokenIssueTime TIMESTAMP NOT NULL,
+  -- The time the purge was confirmed and started.
+  -- NULL if the purge has not been confirmed.
+  StartTime TIMESTAMP,
+  -- The time the purge completed.
+  -- NULL if the purge has not completed.
+  CompletionTime TIMESTAMP,
+  -- The number of Spanner rows deleted to date.
+  RowsDeleted INT64 NOT NULL,
+) PRIMARY KEY (Project);
+
+-- PatchsetVerdicts records the verdicts of test variants with unexpected
+-- results ingested from presubmit builds, by the patchsets tested. Used to
+-- detect verdicts duplicated by CQ retrying a build on the same patchsets.
+CREATE TABLE PatchsetVerdicts (
+  -- The LUCI Project.
+  Project STRING(40) NOT NULL,
+  -- The patchsets tested by the build, as a comma-separated, sorted list of
+  -- "{gerrit host}/{change}/{patchset}".
+  Patchsets STRING(MAX) NOT NULL,
+  -- Unique identifier of the test,
+  -- see also luci.resultdb.v1.TestResult.test_id.
+  TestId STRING(MAX) NOT NULL,
+  -- A hex-encoded sha256 of concatenated "<key>:<value>\n" variant pairs.
+  VariantHash STRING(64) NOT NULL,
+  -- Id of the build invocation the verdict was ingested from.
+  InvocationId STRING(MAX) NOT NULL,
+  -- Status of the verdict, see luci.resultdb.v1.TestVariantStatus.
+  Status INT64 NOT NULL,
+  -- Result counts in the verdict.
+  -- Note that SKIP results are ignored in either of the counts.
+  UnexpectedResultCount INT64 NOT NULL,
+  TotalResultCount INT64 NOT NULL,
+  -- The time the verdict was first ingested. A verdict is only a duplicate
+  -- of verdicts ingested before it.
+  IngestionTime TIMESTAMP NOT NULL OPTIONS (allow_commit_timestamp=true),
+) PRIMARY KEY (Project, Patchsets, TestId, VariantHash, InvocationId);
+
+-- RecentVerdicts summarises the runs of the verdicts of test variants
+-- with unexpected results ingested from presubmit and postsubmit builds, by
+-- test variant and partition time. Used to compute the recent failure rates
+-- of test variants with a single range read per test variant. Verdicts with
+-- only expected results are not recorded, to keep the reads small for test
+-- variants run by many builds.
+CREATE TABLE RecentVerdicts (
+  -- The LUCI Project.
+  Project STRING(40) NOT NULL,
+  -- Unique identifier of the test,
+  -- see also luci.resultdb.v1.TestResult.test_id.
+  TestId STRING(MAX) NOT NULL,
+  -- A hex-encoded sha256 of concatenated "<key>:<value>\n" variant pairs.
+  VariantHash STRING(64) NOT NULL,
+  -- The partition time of the ingested invocation, i.e. the creation time
+  -- of the presubmit run or, for postsubmit, of the invocation.
+  PartitionTime TIMESTAMP NOT NULL,
+  -- Id of the build invocation the verdict was ingested from.
+  IngestedInvocationId STRING(MAX) NOT NULL,
+  -- The changelists tested by the build, as a sorted list of
+  -- "{gerrit host}/{change}/{patchset}". Empty for postsubmit.
+  Changelists ARRAY<STRING(MAX)> NOT NULL,
+  -- The numbers of test runs of the verdict with both expected and
+  -- unexpected results, and with only unexpected results.
+  -- Skipped results are ignored.
+  FlakyRunCount INT64 NOT NULL,
+  UnexpectedRunCount INT64 NOT NULL,
+  -- The time the verdict was last ingested.
+  IngestionTime TIMESTAMP NOT NULL OPTIONS (allow_commit_timestamp=true),
+) PRIMARY KEY (Project, TestId, VariantHash, PartitionTime, IngestedInvocationId);
+
+-- IngestionControl records the builds whose test results were ingested.
+-- Used to skip builds already ingested when backfilling.
+CREATE TABLE IngestionControl (
+  -- The build, as "{buildbucket host}/{build id}".
+  BuildId STRING(MAX) NOT NULL,
+  -- The LUCI Project the test results were ingested into.
+  Project STRING(40) NOT NULL,
+  -- The time ingestion of the build last completed.
+  IngestionTime TIMESTAMP NOT NULL OPTIONS (allow_commit_timestamp=true),
+) PRIMARY KEY (BuildId);
+
+-- Used by finding when test results were last ingested into a LUCI project.
+CREATE INDEX IngestionControlByProjectAndIngestionTime
+ON IngestionControl (Project, IngestionTime DESC);
+
+-- BackfillJobs records requests to re-ingest the builds of a LUCI project
+-- in a time range, and the progress of each backfill.
+CREATE TABLE BackfillJobs (
+  -- The LUCI Project.
+  Project STRING(40) NOT NULL,
+  -- Identifier of the backfill, unique within the project.
+  JobId STRING(32) NOT NULL,
+  -- The range of build creation times backfilled, [StartTime, EndTime).
+  StartTime TIMESTAMP NOT NULL,
+  EndTime TIMESTAMP NOT NULL,
+  -- Whether builds already ingested are ingested again.
+  Force BOOL NOT NULL,
+  -- The maximum rate at which ingestion tasks are enqueued.
+  BuildsPerMinute INT64 NOT NULL,
+  -- The identity which requested the backfill.
+  CreatedBy STRING(MAX) NOT NULL,
+  -- The time the backfill was requested.
+  CreationTime TIMESTAMP NOT NULL,
+  -- The Buildbucket page token of the next page of builds to enumerate.
+  -- Empty before the first page is enumerated.
+  PageToken STRING(MAX) NOT NULL,
+  -- The numbers of builds enumerated, skipped because they were already
+  -- ingested, and enqueued for ingestion to date.
+  BuildsFound INT64 NOT NULL,
+  BuildsSkipped INT64 NOT NULL,
+  BuildsEnqueued INT64 NOT NULL,
+  -- The time all builds were enumerated.
+  -- NULL if the backfill is in progress.
+  CompletionTime TIMESTAMP,
+) PRIMARY KEY (Project, JobId);
+
+-- ChunkExportJobs records requests to export samples of the clustering chunks
+-- of a LUCI project to GCS, and the progress of each export.
+CREATE TABLE ChunkExportJobs (
+  -- The LUCI Project.
+  Project STRING(40) NOT NULL,
+  -- Identifier of the export, unique within the project.
+  JobId STRING(32) NOT NULL,
+  -- The range of chunk partition times exported, [StartTime, EndTime).
+  StartTime TIMESTAMP NOT NULL,
+  EndTime TIMESTAMP NOT NULL,
+  -- The fraction of the chunks in the time range exported.
+  SampleFraction FLOAT64 NOT NULL,
+  -- The GCS bucket the chunks are exported to.
+  Bucket STRING(MAX) NOT NULL,
+  -- The secret key identifiers in exported failures are pseudonymised with.
+  -- Never exported.
+  RedactionKey BYTES(32) NOT NULL,
+  -- The identity which requested the export.
+  CreatedBy STRING(MAX) NOT NULL,
+  -- The time the export was requested.
+  CreationTime TIMESTAMP NOT NULL,
+  -- The ID of the last chunk considered for export. Empty before the first
+  -- page of chunks is read, and "ff..." once all chunks were considered.
+  LastChunkId STRING(32) NOT NULL,
+  -- The numbers of chunks considered for export, and of chunks and failures
+  -- exported to date.
+  ChunksScanned INT64 NOT NULL,
+  ChunksExported INT64 NOT NULL,
+  FailuresExported INT64 NOT NULL,
+  -- The time the manifest and completion marker were written.
+  -- NULL if the export is in progress.
+  CompletionTime TIMESTAMP,
+) PRIMARY KEY (Project, JobId);
+
+-- ChunkExportObjects records the objects written by a chunk export, which
+-- are listed in the manifest of the export once all chunks are exported.
+CREATE TABLE ChunkExportObjects (
+  -- The LUCI Project.
+  Project STRING(40) NOT NULL,
+  -- Identifier of the export.
+  JobId STRING(32) NOT NULL,
+  -- The ID of the exported chunk.
+  ChunkId STRING(32) NOT NULL,
+  -- The name of the object in the bucket of the export.
+  ObjectName STRING(MAX) NOT NULL,
+  -- The SHA-256 hash of the contents of the object, hex-encoded.
+  Sha256 STRING(64) NOT NULL,
+  -- The size of the object, in bytes.
+  SizeBytes INT64 NOT NULL,
+  -- The number of failures in the object.
+  Failures INT64 NOT NULL,
+) PRIMARY KEY (Project, JobId, ChunkId),
+INTERLEAVE IN PARENT ChunkExportJobs ON DELETE CASCADE;
+
+-- Stores the progress of the incremental exports of the test variants of a
+-- realm to a BigQuery table.
+CREATE TABLE TestVariantBqExportCheckpoints (
+  -- Realm of the exported test variants.
+  Realm STRING(64) NOT NULL,
+  -- BigQuery table the test variants are exported to.
+  CloudProject STRING(MAX) NOT NULL,
+  Dataset STRING(MAX) NOT NULL,
+  TableName STRING(MAX) NOT NULL,
+  -- The end of the time range of the last successful export. The next
+  -- incremental export starts from there, and only exports the test variants
+  -- modified since.
+  ExportedUntil TIMESTAMP NOT NULL,
+  -- The end of the time range of the last successful full export, which
+  -- exported all test variants regardless of when they were modified.
+  LastFullExportTime TIMESTAMP NOT NULL,
+  -- The time the checkpoint was last updated.
+  LastUpdated TIMESTAMP NOT NULL OPTIONS (allow_commit_timestamp=true),
+) PRIMARY KEY (Realm, CloudProject, Dataset, TableName);
+
 -- Stores transactional tasks reminders.
 -- See https://go.chromium.org/luci/server/tq. Scanned by tq-sweeper-spanner.
 CREATE TABLE TQReminders (
@@ -0,0 +1,49 @@
+// Copyright 2021 The Chromium Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package span
+
+import (
+	"time"
+
+	"cloud.google.com/go/spanner"
+	sppb "google.golang.org/genproto/googleapis/spanner/v1"
+
+	"go.chromium.org/luci/common/errors"
+)
+
+// ReadKey reads the first n columns of the row, which must be of types
+// STRING, INT64 or TIMESTAMP, as a key.
+func ReadKey(r *spanner.Row, n int) (spanner.Key, error) {
+	key := make(spanner.Key, n)
+	for i := 0; i < n; i++ {
+		var v spanner.GenericColumnValue
+		if err := r.Column(i, &v); err != nil {
+			return nil, err
+		}
+		switch v.Type.Code {
+		case sppb.TypeCode_STRING:
+			var s string
+			if err := v.Decode(&s); err != nil {
+				return nil, err
+			}
+			key[i] = s
+		case sppb.TypeCode_INT64:
+			var n int64
+			if err := v.Decode(&n); err != nil {
+				return nil, err
+			}
+			key[i] = n
+		case sppb.TypeCode_TIMESTAMP:
+			var t time.Time
+			if err := v.Decode(&t); err != nil {
+				return nil, err
+			}
+			key[i] = t
+		default:
+			return nil, errors.Reason("unsupported key column type %s", v.Type.Code).Err()
+		}
+	}
+	return key, nil
+}
@@ -29,6 +29,7 @@ func AnalyzedTestVariant(realm, tId, vHash string, status pb.AnalyzedTestVariant
 		"Status":           status,
 		"CreateTime":       spanner.CommitTimestamp,
 		"StatusUpdateTime": spanner.CommitTimestamp,
+		"LastModified":     spanner.CommitTimestamp,
 	}
 	updateDict(values, extraValues)
 	return span.InsertMap("AnalyzedTestVariants", values)
@@ -25,9 +25,19 @@ func cleanupDatabase(ctx context.Context, client *spanner.Client) error {
 	_, err := client.Apply(ctx, []*spanner.Mutation{
 		// No need to explicitly delete interleaved tables.
 		spanner.Delete("AnalyzedTestVariants", spanner.AllKeys()),
+		spanner.Delete("BackfillJobs", spanner.AllKeys()),
+		spanner.Delete("ChunkExportJobs", spanner.AllKeys()),
+		spanner.Delete("ClusteredFailureExports", spanner.AllKeys()),
 		spanner.Delete("ClusteringState", spanner.AllKeys()),
+		spanner.Delete("DeferredBugActions", spanner.AllKeys()),
 		spanner.Delete("FailureAssociationRules", spanner.AllKeys()),
+		spanner.Delete("IngestionControl", spanner.AllKeys()),
+		spanner.Delete("PatchsetVerdicts", spanner.AllKeys()),
+		spanner.Delete("ProjectPurges", spanner.AllKeys()),
+		spanner.Delete("ProjectUpdateStatus", spanner.AllKeys()),
+		spanner.Delete("RecentVerdicts", spanner.AllKeys()),
 		spanner.Delete("ReclusteringRuns", spanner.AllKeys()),
+		spanner.Delete("TestVariantBqExportCheckpoints", spanner.AllKeys()),
 	})
 	return err
 }
@@ -0,0 +1,155 @@
+// Copyright 2021 The Chromium Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package testvariants
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "infra/appengine/weetbix/proto/v1"
+)
+
+const (
+	// IntervalCount is the number of intervals the failure rate of a test
+	// variant is analyzed over.
+	IntervalCount = 5
+
+	// IntervalDuration is the length of each interval.
+	IntervalDuration = 24 * time.Hour
+
+	// MaxExamples is the maximum number of examples of each kind of verdict
+	// returned per test variant.
+	MaxExamples = 10
+)
+
+// QueryFailureRate analyzes the recent failure rate of the given test
+// variants of a project, as of the given time. Unexpected verdicts which
+// tested any of the excluded changes, regardless of the patchset, are not
+// returned as examples.
+//
+// Must be called in a Spanner transactional context.
+func QueryFailureRate(ctx context.Context, project string, tvs []*pb.TestVariantIdentifier, excluded []*pb.Changelist, asOf time.Time) ([]*pb.TestVariantFailureRateAnalysis, error) {
+	verdicts, err := readRecentVerdicts(ctx, project, tvs, asOf.Add(-IntervalCount*IntervalDuration))
+	if err != nil {
+		return nil, err
+	}
+	excludedChanges := make(map[string]bool, len(excluded))
+	for _, cl := range excluded {
+		excludedChanges[changeKey(cl)] = true
+	}
+
+	result := make([]*pb.TestVariantFailureRateAnalysis, 0, len(tvs))
+	for _, tv := range tvs {
+		vs := verdicts[testVariantKey{tv.TestId, tv.VariantHash}]
+		a := analyzeFailureRate(vs, excludedChanges, asOf)
+		a.TestId = tv.TestId
+		a.VariantHash = tv.VariantHash
+		result = append(result, a)
+	}
+	return result, nil
+}
+
+// analyzeFailureRate analyzes the verdicts of a test variant, sorted by
+// partition time, the oldest first.
+func analyzeFailureRate(vs []*RecentVerdict, excludedChanges map[string]bool, asOf time.Time) *pb.TestVariantFailureRateAnalysis {
+	a := &pb.TestVariantFailureRateAnalysis{
+		Intervals: make([]*pb.IntervalStats, IntervalCount),
+	}
+	for i := range a.Intervals {
+		a.Intervals[i] = &pb.IntervalStats{
+			IntervalAge: int32(i + 1),
+			TimeRange: &pb.TimeRange{
+				Earliest: timestamppb.New(asOf.Add(-time.Duration(i+1) * IntervalDuration)),
+				Latest:   timestamppb.New(asOf.Add(-time.Duration(i) * IntervalDuration)),
+			},
+		}
+	}
+
+	seenChanges := make(map[string]bool)
+	// Iterate the most recent verdicts first, so the most recent
+	// examples are kept.
+	for i := len(vs) - 1; i >= 0; i-- {
+		v := vs[i]
+		age := intervalIndex(v.PartitionTime, asOf)
+		if age >= IntervalCount {
+			continue
+		}
+		stats := a.Intervals[age]
+		switch {
+		case v.IsRunFlaky():
+			stats.TotalRunFlakyVerdicts++
+			if len(a.RunFlakyVerdictExamples) < MaxExamples {
+				a.RunFlakyVerdictExamples = append(a.RunFlakyVerdictExamples, verdictExample(v))
+			}
+		case v.IsRunUnexpected():
+			stats.TotalRunUnexpectedVerdicts++
+			if len(v.Changelists) == 0 || len(a.UnexpectedVerdictExamplesOnOtherChangelists) >= MaxExamples {
+				continue
+			}
+			if testsAnyChange(v.Changelists, excludedChanges) {
+				continue
+			}
+			// Retries of the same changes count as one example.
+			k := changesKey(v.Changelists)
+			if seenChanges[k] {
+				continue
+			}
+			seenChanges[k] = true
+			a.UnexpectedVerdictExamplesOnOtherChangelists = append(a.UnexpectedVerdictExamplesOnOtherChangelists, verdictExample(v))
+		}
+	}
+	return a
+}
+
+// intervalIndex returns the 0-based index of the interval the partition
+// time falls in. Partition times after asOf are in the first interval.
+func intervalIndex(partitionTime, asOf time.Time) int {
+	d := asOf.Sub(partitionTime)
+	if d <= 0 {
+		return 0
+	}
+	return int((d - 1) / IntervalDuration)
+}
+
+func verdictExample(v *RecentVerdict) *pb.VerdictExample {
+	return &pb.VerdictExample{
+		PartitionTime:        timestamppb.New(v.PartitionTime),
+		IngestedInvocationId: v.IngestedInvocationID,
+		Changelists:          v.Changelists,
+	}
+}
+
+// changeKey returns the key of the change of a changelist, ignoring the
+// patchset.
+func changeKey(cl *pb.Changelist) string {
+	return fmt.Sprintf("%s/%d", cl.Host, cl.Change)
+}
+
+// changesKey returns the key of the set of changes of the changelists,
+// ignoring the patchsets.
+func changesKey(cls []*pb.Changelist) string {
+	keys := make([]string, 0, len(cls))
+	for _, cl := range cls {
+		keys = append(keys, changeKey(cl))
+	}
+	sort.Strings(keys)
+	return strings.Join(keys, ",")
+}
+
+// testsAnyChange returns whether any of the changelists is of one of the
+// given changes.
+func testsAnyChange(cls []*pb.Changelist, changes map[string]bool) bool {
+	for _, cl := range cls {
+		if changes[changeKey(cl)] {
+			return true
+		}
+	}
+	return false
+}
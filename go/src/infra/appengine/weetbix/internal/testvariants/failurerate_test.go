@@ -0,0 +1,228 @@
+// Copyright 2021 The Chromium Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package testvariants
+
+import (
+	"fmt"
+	"sort"
+	"testing"
+	"time"
+
+	"cloud.google.com/go/spanner"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"go.chromium.org/luci/server/span"
+
+	"infra/appengine/weetbix/internal/testutil"
+	pb "infra/appengine/weetbix/proto/v1"
+
+	. "github.com/smartystreets/goconvey/convey"
+	. "go.chromium.org/luci/common/testing/assertions"
+)
+
+func TestQueryFailureRate(t *testing.T) {
+	Convey(`QueryFailureRate`, t, func() {
+		ctx := testutil.SpannerTestContext(t)
+
+		asOf := time.Date(2021, time.December, 1, 12, 0, 0, 0, time.UTC)
+		day := IntervalDuration
+		cl := func(change int64, patchset int32) *pb.Changelist {
+			return &pb.Changelist{Host: "chromium-review.googlesource.com", Change: change, Patchset: patchset}
+		}
+		verdict := func(testID, invID string, partitionTime time.Time, flaky, unexpected int64, cls ...*pb.Changelist) *RecentVerdict {
+			return &RecentVerdict{
+				Project:              "chromium",
+				TestID:               testID,
+				VariantHash:          "vh",
+				PartitionTime:        partitionTime,
+				IngestedInvocationID: invID,
+				Changelists:          cls,
+				FlakyRunCount:        flaky,
+				UnexpectedRunCount:   unexpected,
+			}
+		}
+		example := func(v *RecentVerdict) *pb.VerdictExample {
+			return &pb.VerdictExample{
+				PartitionTime:        timestamppb.New(v.PartitionTime),
+				IngestedInvocationId: v.IngestedInvocationID,
+				Changelists:          v.Changelists,
+			}
+		}
+		intervals := func(flaky, unexpected [IntervalCount]int32) []*pb.IntervalStats {
+			result := make([]*pb.IntervalStats, IntervalCount)
+			for i := range result {
+				result[i] = &pb.IntervalStats{
+					IntervalAge: int32(i + 1),
+					TimeRange: &pb.TimeRange{
+						Earliest: timestamppb.New(asOf.Add(-time.Duration(i+1) * day)),
+						Latest:   timestamppb.New(asOf.Add(-time.Duration(i) * day)),
+					},
+					TotalRunFlakyVerdicts:      flaky[i],
+					TotalRunUnexpectedVerdicts: unexpected[i],
+				}
+			}
+			return result
+		}
+		save := func(vs ...*RecentVerdict) {
+			ms := make([]*spanner.Mutation, 0, len(vs))
+			for _, v := range vs {
+				ms = append(ms, SaveRecentVerdict(v))
+			}
+			testutil.MustApply(ctx, ms...)
+		}
+		query := func(tvs []*pb.TestVariantIdentifier, excluded ...*pb.Changelist) []*pb.TestVariantFailureRateAnalysis {
+			result, err := QueryFailureRate(span.Single(ctx), "chromium", tvs, excluded, asOf)
+			So(err, ShouldBeNil)
+			return result
+		}
+
+		Convey(`Seeded history`, func() {
+			const testID = "ninja://test"
+			futureFlaky := verdict(testID, "build-1", asOf.Add(time.Hour), 1, 1, cl(1, 1))
+			recentUnexpected := verdict(testID, "build-2", asOf.Add(-time.Hour), 0, 2, cl(2, 2))
+			// A retry of an earlier patchset of the same change.
+			retryUnexpected := verdict(testID, "build-3", asOf.Add(-2*time.Hour), 0, 1, cl(2, 1))
+			excludedUnexpected := verdict(testID, "build-4", asOf.Add(-day-time.Hour), 0, 1, cl(3, 1))
+			postsubmitUnexpected := verdict(testID, "build-5", asOf.Add(-2*day-time.Hour), 0, 1)
+			// On the boundaries of the intervals.
+			boundaryFlaky := verdict(testID, "build-6", asOf.Add(-4*day), 2, 0, cl(4, 1))
+			oldestUnexpected := verdict(testID, "build-7", asOf.Add(-5*day), 0, 1, cl(5, 1), cl(6, 1))
+			tooOld := verdict(testID, "build-8", asOf.Add(-5*day-time.Second), 1, 0, cl(7, 1))
+
+			otherVariant := verdict(testID, "build-9", asOf.Add(-time.Hour), 1, 0)
+			otherVariant.VariantHash = "othervh"
+			otherProject := verdict(testID, "build-10", asOf.Add(-time.Hour), 1, 0)
+			otherProject.Project = "chromeos"
+
+			save(futureFlaky, recentUnexpected, retryUnexpected, excludedUnexpected, postsubmitUnexpected,
+				boundaryFlaky, oldestUnexpected, tooOld, otherVariant, otherProject)
+
+			result := query([]*pb.TestVariantIdentifier{
+				{TestId: testID, VariantHash: "vh"},
+				{TestId: "ninja://no_verdicts", VariantHash: "vh"},
+			}, cl(3, 5))
+			So(result, ShouldResembleProto, []*pb.TestVariantFailureRateAnalysis{
+				{
+					TestId:      testID,
+					VariantHash: "vh",
+					Intervals: intervals(
+						[IntervalCount]int32{1, 0, 0, 1, 0},
+						[IntervalCount]int32{2, 1, 1, 0, 1},
+					),
+					RunFlakyVerdictExamples: []*pb.VerdictExample{
+						example(futureFlaky),
+						example(boundaryFlaky),
+					},
+					UnexpectedVerdictExamplesOnOtherChangelists: []*pb.VerdictExample{
+						example(recentUnexpected),
+						example(oldestUnexpected),
+					},
+				},
+				{
+					TestId:      "ninja://no_verdicts",
+					VariantHash: "vh",
+					Intervals: intervals(
+						[IntervalCount]int32{},
+						[IntervalCount]int32{},
+					),
+				},
+			})
+		})
+		Convey(`Examples are limited`, func() {
+			const testID = "ninja://flaky_and_failing"
+			var flaky, unexpected []*RecentVerdict
+			for i := 0; i < MaxExamples+2; i++ {
+				pt := asOf.Add(-time.Duration(i+1) * time.Hour)
+				flaky = append(flaky, verdict(testID, fmt.Sprintf("build-flaky-%d", i), pt, 1, 0))
+				unexpected = append(unexpected, verdict(testID, fmt.Sprintf("build-unexpected-%d", i), pt, 0, 1, cl(int64(100+i), 1)))
+			}
+			save(append(flaky, unexpected...)...)
+
+			result := query([]*pb.TestVariantIdentifier{{TestId: testID, VariantHash: "vh"}})
+			So(result, ShouldHaveLength, 1)
+			a := result[0]
+			So(a.Intervals[0].TotalRunFlakyVerdicts, ShouldEqual, MaxExamples+2)
+			So(a.Intervals[0].TotalRunUnexpectedVerdicts, ShouldEqual, MaxExamples+2)
+			So(a.RunFlakyVerdictExamples, ShouldHaveLength, MaxExamples)
+			So(a.UnexpectedVerdictExamplesOnOtherChangelists, ShouldHaveLength, MaxExamples)
+			for i := 0; i < MaxExamples; i++ {
+				So(a.RunFlakyVerdictExamples[i], ShouldResembleProto, example(flaky[i]))
+				So(a.UnexpectedVerdictExamplesOnOtherChangelists[i], ShouldResembleProto, example(unexpected[i]))
+			}
+		})
+	})
+}
+
+func TestIntervalIndex(t *testing.T) {
+	t.Parallel()
+
+	Convey(`intervalIndex`, t, func() {
+		asOf := time.Date(2021, time.December, 1, 12, 0, 0, 0, time.UTC)
+		So(intervalIndex(asOf.Add(time.Hour), asOf), ShouldEqual, 0)
+		So(intervalIndex(asOf, asOf), ShouldEqual, 0)
+		So(intervalIndex(asOf.Add(-IntervalDuration+time.Nanosecond), asOf), ShouldEqual, 0)
+		So(intervalIndex(asOf.Add(-IntervalDuration), asOf), ShouldEqual, 0)
+		So(intervalIndex(asOf.Add(-IntervalDuration-time.Nanosecond), asOf), ShouldEqual, 1)
+		So(intervalIndex(asOf.Add(-IntervalCount*IntervalDuration), asOf), ShouldEqual, IntervalCount-1)
+	})
+}
+
+// BenchmarkQueryFailureRate measures the latency of analyzing the maximum
+// number of test variants per request, some of which fail often, and
+// reports the 95th percentile.
+func BenchmarkQueryFailureRate(b *testing.B) {
+	ctx := testutil.SpannerTestContext(b)
+
+	asOf := time.Date(2021, time.December, 1, 12, 0, 0, 0, time.UTC)
+	tvs := make([]*pb.TestVariantIdentifier, 0, maxTestVariants)
+	var ms []*spanner.Mutation
+	for i := 0; i < maxTestVariants; i++ {
+		tv := &pb.TestVariantIdentifier{TestId: fmt.Sprintf("ninja://test_%d", i), VariantHash: "vh"}
+		tvs = append(tvs, tv)
+		// Every tenth test variant fails in a verdict every half hour.
+		verdicts := 5
+		if i%10 == 0 {
+			verdicts = 2 * 24 * IntervalCount
+		}
+		for j := 0; j < verdicts; j++ {
+			ms = append(ms, SaveRecentVerdict(&RecentVerdict{
+				Project:              "chromium",
+				TestID:               tv.TestId,
+				VariantHash:          tv.VariantHash,
+				PartitionTime:        asOf.Add(-time.Duration(j) * 30 * time.Minute),
+				IngestedInvocationID: fmt.Sprintf("build-%d", j),
+				Changelists:          []*pb.Changelist{{Host: "chromium-review.googlesource.com", Change: int64(j), Patchset: 1}},
+				FlakyRunCount:        int64(j % 2),
+				UnexpectedRunCount:   1,
+			}))
+		}
+	}
+	// Stay within the limit of mutations per commit.
+	for len(ms) > 0 {
+		n := len(ms)
+		if n > 1000 {
+			n = 1000
+		}
+		if _, err := span.Apply(ctx, ms[:n]); err != nil {
+			b.Fatal(err)
+		}
+		ms = ms[n:]
+	}
+
+	latencies := make([]time.Duration, 0, b.N)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		start := time.Now()
+		if _, err := QueryFailureRate(span.Single(ctx), "chromium", tvs, nil, asOf); err != nil {
+			b.Fatal(err)
+		}
+		latencies = append(latencies, time.Since(start))
+	}
+	b.StopTimer()
+
+	sort.Slice(latencies, func(i, j int) bool { return latencies[i] < latencies[j] })
+	p95 := latencies[(len(latencies)*95+99)/100-1]
+	b.ReportMetric(float64(p95)/float64(time.Millisecond), "p95-ms")
+}
@@ -0,0 +1,91 @@
+// Copyright 2021 The Chromium Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package testvariants
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"go.chromium.org/luci/common/clock"
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/grpc/appstatus"
+	"go.chromium.org/luci/server/span"
+
+	"infra/appengine/weetbix/internal/config"
+	pb "infra/appengine/weetbix/proto/v1"
+)
+
+const (
+	// maxTestVariants is the maximum number of test variants which may be
+	// analyzed per request.
+	maxTestVariants = 100
+
+	// maxChangelists is the maximum number of changelists which may be
+	// specified per request.
+	maxChangelists = 100
+)
+
+// testVariantsServer implements pb.TestVariantsServer.
+type testVariantsServer struct {
+	pb.UnimplementedTestVariantsServer
+}
+
+// CreateServer creates a testVariantsServer.
+func CreateServer() *testVariantsServer {
+	return &testVariantsServer{}
+}
+
+// QueryTestVariantFailureRateAnalysis implements pb.TestVariantsServer.
+func (*testVariantsServer) QueryTestVariantFailureRateAnalysis(ctx context.Context, req *pb.QueryTestVariantFailureRateAnalysisRequest) (*pb.QueryTestVariantFailureRateAnalysisResponse, error) {
+	if err := validateQueryTestVariantFailureRateAnalysisRequest(req); err != nil {
+		return nil, appstatus.BadRequest(err)
+	}
+
+	asOf := clock.Now(ctx)
+	// The verdicts are read with a single read, so a single-use
+	// transaction suffices.
+	tvs, err := QueryFailureRate(span.Single(ctx), req.Project, req.TestVariants, req.Changelists, asOf)
+	if err != nil {
+		return nil, errors.Annotate(err, "query failure rate").Err()
+	}
+	return &pb.QueryTestVariantFailureRateAnalysisResponse{
+		AsOf:         timestamppb.New(asOf),
+		TestVariants: tvs,
+	}, nil
+}
+
+func validateQueryTestVariantFailureRateAnalysisRequest(req *pb.QueryTestVariantFailureRateAnalysisRequest) error {
+	switch {
+	case req.Project == "":
+		return fmt.Errorf("project is not specified")
+	case !config.ProjectRe.MatchString(req.Project):
+		return fmt.Errorf("project %q is not a valid LUCI project", req.Project)
+	case len(req.TestVariants) == 0:
+		return fmt.Errorf("test_variants is not specified")
+	case len(req.TestVariants) > maxTestVariants:
+		return fmt.Errorf("no more than %d test_variants may be specified", maxTestVariants)
+	case len(req.Changelists) > maxChangelists:
+		return fmt.Errorf("no more than %d changelists may be specified", maxChangelists)
+	}
+	for i, tv := range req.TestVariants {
+		switch {
+		case tv.GetTestId() == "":
+			return fmt.Errorf("test_variants[%d]: test_id is not specified", i)
+		case tv.GetVariantHash() == "":
+			return fmt.Errorf("test_variants[%d]: variant_hash is not specified", i)
+		}
+	}
+	for i, cl := range req.Changelists {
+		switch {
+		case cl.GetHost() == "":
+			return fmt.Errorf("changelists[%d]: host is not specified", i)
+		case cl.GetChange() <= 0:
+			return fmt.Errorf("changelists[%d]: change must be positive", i)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,98 @@
+// Copyright 2021 The Chromium Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package testvariants
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"go.chromium.org/luci/common/clock/testclock"
+
+	"infra/appengine/weetbix/internal/testutil"
+	pb "infra/appengine/weetbix/proto/v1"
+
+	. "github.com/smartystreets/goconvey/convey"
+	. "go.chromium.org/luci/common/testing/assertions"
+)
+
+func TestQueryTestVariantFailureRateAnalysis(t *testing.T) {
+	Convey(`QueryTestVariantFailureRateAnalysis`, t, func() {
+		ctx := testutil.SpannerTestContext(t)
+		now := time.Date(2021, time.December, 1, 12, 0, 0, 0, time.UTC)
+		ctx, _ = testclock.UseTime(ctx, now)
+		srv := CreateServer()
+
+		testutil.MustApply(ctx, SaveRecentVerdict(&RecentVerdict{
+			Project:              "chromium",
+			TestID:               "ninja://test",
+			VariantHash:          "vh",
+			PartitionTime:        now.Add(-time.Hour),
+			IngestedInvocationID: "build-1",
+			Changelists:          []*pb.Changelist{{Host: "chromium-review.googlesource.com", Change: 1, Patchset: 2}},
+			FlakyRunCount:        1,
+		}))
+
+		req := &pb.QueryTestVariantFailureRateAnalysisRequest{
+			Project: "chromium",
+			TestVariants: []*pb.TestVariantIdentifier{
+				{TestId: "ninja://test", VariantHash: "vh"},
+			},
+		}
+
+		Convey(`Valid`, func() {
+			rsp, err := srv.QueryTestVariantFailureRateAnalysis(ctx, req)
+			So(err, ShouldBeNil)
+			So(rsp.AsOf, ShouldResembleProto, timestamppb.New(now))
+			So(rsp.TestVariants, ShouldHaveLength, 1)
+			tv := rsp.TestVariants[0]
+			So(tv.TestId, ShouldEqual, "ninja://test")
+			So(tv.Intervals, ShouldHaveLength, IntervalCount)
+			So(tv.Intervals[0].TotalRunFlakyVerdicts, ShouldEqual, 1)
+			So(tv.RunFlakyVerdictExamples, ShouldHaveLength, 1)
+			So(tv.RunFlakyVerdictExamples[0].IngestedInvocationId, ShouldEqual, "build-1")
+		})
+		Convey(`Invalid`, func() {
+			test := func(errMsg string) {
+				_, err := srv.QueryTestVariantFailureRateAnalysis(ctx, req)
+				So(err, ShouldHaveAppStatus, codes.InvalidArgument, errMsg)
+			}
+			Convey(`No project`, func() {
+				req.Project = ""
+				test("project is not specified")
+			})
+			Convey(`Invalid project`, func() {
+				req.Project = "Chromium"
+				test("not a valid LUCI project")
+			})
+			Convey(`No test variants`, func() {
+				req.TestVariants = nil
+				test("test_variants is not specified")
+			})
+			Convey(`Too many test variants`, func() {
+				req.TestVariants = nil
+				for i := 0; i <= maxTestVariants; i++ {
+					req.TestVariants = append(req.TestVariants, &pb.TestVariantIdentifier{TestId: fmt.Sprintf("ninja://test_%d", i), VariantHash: "vh"})
+				}
+				test("no more than 100 test_variants")
+			})
+			Convey(`No test ID`, func() {
+				req.TestVariants[0].TestId = ""
+				test("test_variants[0]: test_id is not specified")
+			})
+			Convey(`No variant hash`, func() {
+				req.TestVariants[0].VariantHash = ""
+				test("test_variants[0]: variant_hash is not specified")
+			})
+			Convey(`Invalid changelist`, func() {
+				req.Changelists = []*pb.Changelist{{Host: "chromium-review.googlesource.com"}}
+				test("changelists[0]: change must be positive")
+			})
+		})
+	})
+}
@@ -0,0 +1,159 @@
+// Copyright 2021 The Chromium Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+// Package testvariants implements the TestVariants pRPC service, which
+// serves statistics about test variants computed from the recent verdicts
+// recorded at ingestion time.
+package testvariants
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"cloud.google.com/go/spanner"
+
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/server/span"
+
+	spanutil "infra/appengine/weetbix/internal/span"
+	pb "infra/appengine/weetbix/proto/v1"
+)
+
+// RecentVerdict is a verdict with unexpected runs, as recorded in the
+// RecentVerdicts table.
+type RecentVerdict struct {
+	Project              string
+	TestID               string
+	VariantHash          string
+	PartitionTime        time.Time
+	IngestedInvocationID string
+	// Changelists are the changelists tested by the ingested invocation.
+	// Empty for postsubmit.
+	Changelists []*pb.Changelist
+	// FlakyRunCount is the number of runs with both expected and
+	// unexpected results.
+	FlakyRunCount int64
+	// UnexpectedRunCount is the number of runs with only unexpected
+	// results.
+	UnexpectedRunCount int64
+}
+
+// IsRunFlaky returns whether the verdict has a flaky run.
+func (v *RecentVerdict) IsRunFlaky() bool {
+	return v.FlakyRunCount > 0
+}
+
+// IsRunUnexpected returns whether the verdict has an unexpected run and
+// no flaky run.
+func (v *RecentVerdict) IsRunUnexpected() bool {
+	return v.FlakyRunCount == 0 && v.UnexpectedRunCount > 0
+}
+
+// SaveRecentVerdict returns a mutation to record the verdict in the
+// RecentVerdicts table. Re-ingesting the same verdict overwrites it.
+func SaveRecentVerdict(v *RecentVerdict) *spanner.Mutation {
+	return spanutil.InsertOrUpdateMap("RecentVerdicts", map[string]interface{}{
+		"Project":              v.Project,
+		"TestId":               v.TestID,
+		"VariantHash":          v.VariantHash,
+		"PartitionTime":        v.PartitionTime,
+		"IngestedInvocationId": v.IngestedInvocationID,
+		"Changelists":          changelistsToSpanner(v.Changelists),
+		"FlakyRunCount":        v.FlakyRunCount,
+		"UnexpectedRunCount":   v.UnexpectedRunCount,
+		"IngestionTime":        spanner.CommitTimestamp,
+	})
+}
+
+// testVariantKey identifies a test variant in a project.
+type testVariantKey struct {
+	TestID      string
+	VariantHash string
+}
+
+// readRecentVerdicts reads the verdicts of the given test variants with a
+// partition time at or after since, keyed by test variant. The verdicts of
+// each test variant are sorted by partition time, the oldest first.
+//
+// Reads one key range per test variant, so that the cost of the read is
+// proportional to the number of verdicts returned.
+func readRecentVerdicts(ctx context.Context, project string, tvs []*pb.TestVariantIdentifier, since time.Time) (map[testVariantKey][]*RecentVerdict, error) {
+	ks := spanner.KeySets()
+	for _, tv := range tvs {
+		ks = spanner.KeySets(spanner.KeyRange{
+			Start: spanner.Key{project, tv.TestId, tv.VariantHash, since},
+			End:   spanner.Key{project, tv.TestId, tv.VariantHash},
+			Kind:  spanner.ClosedClosed,
+		}, ks)
+	}
+	fields := []string{"TestId", "VariantHash", "PartitionTime", "IngestedInvocationId", "Changelists", "FlakyRunCount", "UnexpectedRunCount"}
+
+	result := make(map[testVariantKey][]*RecentVerdict)
+	var b spanutil.Buffer
+	err := span.Read(ctx, "RecentVerdicts", ks, fields).Do(
+		func(row *spanner.Row) error {
+			v := &RecentVerdict{Project: project}
+			var cls []string
+			if err := b.FromSpanner(row, &v.TestID, &v.VariantHash, &v.PartitionTime, &v.IngestedInvocationID, &cls, &v.FlakyRunCount, &v.UnexpectedRunCount); err != nil {
+				return err
+			}
+			var err error
+			if v.Changelists, err = changelistsFromSpanner(cls); err != nil {
+				return err
+			}
+			k := testVariantKey{v.TestID, v.VariantHash}
+			result[k] = append(result[k], v)
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, errors.Annotate(err, "read recent verdicts").Err()
+	}
+	for _, vs := range result {
+		sort.SliceStable(vs, func(i, j int) bool {
+			return vs[i].PartitionTime.Before(vs[j].PartitionTime)
+		})
+	}
+	return result, nil
+}
+
+// changelistsToSpanner returns the changelists as a sorted list of
+// "{host}/{change}/{patchset}".
+func changelistsToSpanner(cls []*pb.Changelist) []string {
+	result := make([]string, 0, len(cls))
+	for _, cl := range cls {
+		result = append(result, fmt.Sprintf("%s/%d/%d", cl.Host, cl.Change, cl.Patchset))
+	}
+	sort.Strings(result)
+	return result
+}
+
+// changelistsFromSpanner is the inverse of changelistsToSpanner.
+func changelistsFromSpanner(cls []string) ([]*pb.Changelist, error) {
+	result := make([]*pb.Changelist, 0, len(cls))
+	for _, s := range cls {
+		parts := strings.Split(s, "/")
+		if len(parts) != 3 {
+			return nil, errors.Reason("invalid changelist %q", s).Err()
+		}
+		change, err := strconv.ParseInt(parts[1], 10, 64)
+		if err != nil {
+			return nil, errors.Annotate(err, "invalid changelist %q", s).Err()
+		}
+		patchset, err := strconv.ParseInt(parts[2], 10, 32)
+		if err != nil {
+			return nil, errors.Annotate(err, "invalid changelist %q", s).Err()
+		}
+		result = append(result, &pb.Changelist{
+			Host:     parts[0],
+			Change:   change,
+			Patchset: int32(patchset),
+		})
+	}
+	return result, nil
+}
@@ -55,6 +55,23 @@ func FailureReasonFromResultDB(fr *rdbpb.FailureReason) *pb.FailureReason {
 	}
 }
 
+// ExonerationReasonFromResultDB converts a ResultDB ExonerationReason to a
+// Weetbix ExonerationReason.
+func ExonerationReasonFromResultDB(r rdbpb.ExonerationReason) pb.ExonerationReason {
+	switch r {
+	case rdbpb.ExonerationReason_OCCURS_ON_MAINLINE:
+		return pb.ExonerationReason_OCCURS_ON_MAINLINE
+	case rdbpb.ExonerationReason_OCCURS_ON_OTHER_CLS:
+		return pb.ExonerationReason_OCCURS_ON_OTHER_CLS
+	case rdbpb.ExonerationReason_NOT_CRITICAL:
+		return pb.ExonerationReason_NOT_CRITICAL
+	case rdbpb.ExonerationReason_UNEXPECTED_PASS:
+		return pb.ExonerationReason_UNEXPECTED_PASS
+	default:
+		return pb.ExonerationReason_EXONERATION_REASON_UNSPECIFIED
+	}
+}
+
 // TestMetadataFromResultDB converts a ResultDB TestMetadata to a Weetbix
 // TestMetadata.
 func TestMetadataFromResultDB(rdbTmd *rdbpb.TestMetadata) *pb.TestMetadata {
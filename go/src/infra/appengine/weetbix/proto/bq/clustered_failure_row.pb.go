@@ -218,6 +218,41 @@ type ClusteredFailureRow struct {
 	// to see if the impact of this test run being blocked was
 	// mitigated by exoneration.
 	IsTestRunBlocked bool `protobuf:"varint,28,opt,name=is_test_run_blocked,json=isTestRunBlocked,proto3" json:"is_test_run_blocked,omitempty"`
+	// Does the verdict of this test variant duplicate one already ingested
+	// from another build of the same patchsets, e.g. because CQ retried the
+	// build? Duplicate failures are stored, but excluded from impact, so that
+	// retries do not inflate it.
+	IsDuplicate bool `protobuf:"varint,29,opt,name=is_duplicate,json=isDuplicate,proto3" json:"is_duplicate,omitempty"`
+	// Structured information about why the test failed. Unlike
+	// failure_reason, this also captures the type of the error and how the
+	// failure reason was processed by Weetbix.
+	//
+	// Unset if the test result system provided no failure reason or error
+	// types.
+	StructuredFailureReason *StructuredFailureReason `protobuf:"bytes,30,opt,name=structured_failure_reason,json=structuredFailureReason,proto3" json:"structured_failure_reason,omitempty"`
+	// Links to selected artifacts of the test result, e.g. its log snippet
+	// or stack trace, so that the failure can be deep-linked from the UI.
+	// Which artifacts are linked is configured by the LUCI project. Only
+	// links are stored, not the artifact contents.
+	Artifacts []*v1.ArtifactLink `protobuf:"bytes,31,rep,name=artifacts,proto3" json:"artifacts,omitempty"`
+	// The kind of the failure, e.g. TEST_FAILURE or BUILD_STEP_FAILURE.
+	// For build step failures, test_id is the name of the failed step,
+	// the variant describes the builder the build ran on, and
+	// failure_reason is the summary of the step.
+	// Unset for failures ingested before the kind was recorded, which are
+	// test failures.
+	FailureKind v1.FailureKind `protobuf:"varint,32,opt,name=failure_kind,json=failureKind,proto3,enum=weetbix.v1.FailureKind" json:"failure_kind,omitempty"`
+	// The reason the failure was exonerated, e.g. OCCURS_ON_MAINLINE or
+	// NOT_CRITICAL. The OCCURS_ON_* reasons are exonerations advised by
+	// Weetbix.
+	// Unset if the failure was not exonerated, or was ingested before
+	// exoneration reasons were recorded.
+	ExonerationReason v1.ExonerationReason `protobuf:"varint,33,opt,name=exoneration_reason,json=exonerationReason,proto3,enum=weetbix.v1.ExonerationReason" json:"exoneration_reason,omitempty"`
+	// The explanation of why the failure was exonerated, as provided by
+	// the test result system, cleaned up and truncated to 256 characters.
+	// HTML markup is not removed.
+	// Unset if the failure was not exonerated.
+	ExonerationExplanation string `protobuf:"bytes,34,opt,name=exoneration_explanation,json=exonerationExplanation,proto3" json:"exoneration_explanation,omitempty"`
 }
 
 func (x *ClusteredFailureRow) Reset() {
@@ -448,6 +483,128 @@ func (x *ClusteredFailureRow) GetIsTestRunBlocked() bool {
 	return false
 }
 
+func (x *ClusteredFailureRow) GetIsDuplicate() bool {
+	if x != nil {
+		return x.IsDuplicate
+	}
+	return false
+}
+
+func (x *ClusteredFailureRow) GetStructuredFailureReason() *StructuredFailureReason {
+	if x != nil {
+		return x.StructuredFailureReason
+	}
+	return nil
+}
+
+func (x *ClusteredFailureRow) GetArtifacts() []*v1.ArtifactLink {
+	if x != nil {
+		return x.Artifacts
+	}
+	return nil
+}
+
+func (x *ClusteredFailureRow) GetFailureKind() v1.FailureKind {
+	if x != nil {
+		return x.FailureKind
+	}
+	return v1.FailureKind(0)
+}
+
+func (x *ClusteredFailureRow) GetExonerationReason() v1.ExonerationReason {
+	if x != nil {
+		return x.ExonerationReason
+	}
+	return v1.ExonerationReason(0)
+}
+
+func (x *ClusteredFailureRow) GetExonerationExplanation() string {
+	if x != nil {
+		return x.ExonerationExplanation
+	}
+	return ""
+}
+
+// StructuredFailureReason is structured information about why a test
+// failed.
+type StructuredFailureReason struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The error message that ultimately caused the test to fail, truncated
+	// to 1024 bytes.
+	PrimaryErrorMessage string `protobuf:"bytes,1,opt,name=primary_error_message,json=primaryErrorMessage,proto3" json:"primary_error_message,omitempty"`
+	// The types of the error which caused the test to fail, e.g.
+	// "check_failure" or "timeout", as tagged by the test results system.
+	ErrorTypeTags []string `protobuf:"bytes,2,rep,name=error_type_tags,json=errorTypeTags,proto3" json:"error_type_tags,omitempty"`
+	// Whether primary_error_message was truncated.
+	Truncated bool `protobuf:"varint,3,opt,name=truncated,proto3" json:"truncated,omitempty"`
+	// The version of the failure reason normalization algorithm (the
+	// failure reason clustering algorithm) that clustered the failure.
+	NormalizationAlgorithmVersion int64 `protobuf:"varint,4,opt,name=normalization_algorithm_version,json=normalizationAlgorithmVersion,proto3" json:"normalization_algorithm_version,omitempty"`
+}
+
+func (x *StructuredFailureReason) Reset() {
+	*x = StructuredFailureReason{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_infra_appengine_weetbix_proto_bq_clustered_failure_row_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StructuredFailureReason) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StructuredFailureReason) ProtoMessage() {}
+
+func (x *StructuredFailureReason) ProtoReflect() protoreflect.Message {
+	mi := &file_infra_appengine_weetbix_proto_bq_clustered_failure_row_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StructuredFailureReason.ProtoReflect.Descriptor instead.
+func (*StructuredFailureReason) Descriptor() ([]byte, []int) {
+	return file_infra_appengine_weetbix_proto_bq_clustered_failure_row_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *StructuredFailureReason) GetPrimaryErrorMessage() string {
+	if x != nil {
+		return x.PrimaryErrorMessage
+	}
+	return ""
+}
+
+func (x *StructuredFailureReason) GetErrorTypeTags() []string {
+	if x != nil {
+		return x.ErrorTypeTags
+	}
+	return nil
+}
+
+func (x *StructuredFailureReason) GetTruncated() bool {
+	if x != nil {
+		return x.Truncated
+	}
+	return false
+}
+
+func (x *StructuredFailureReason) GetNormalizationAlgorithmVersion() int64 {
+	if x != nil {
+		return x.NormalizationAlgorithmVersion
+	}
+	return 0
+}
+
 var File_infra_appengine_weetbix_proto_bq_clustered_failure_row_proto protoreflect.FileDescriptor
 
 var file_infra_appengine_weetbix_proto_bq_clustered_failure_row_proto_rawDesc = []byte{
@@ -466,7 +623,7 @@ var file_infra_appengine_weetbix_proto_bq_clustered_failure_row_proto_rawDesc =
 	0x61, 0x2f, 0x61, 0x70, 0x70, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x2f, 0x77, 0x65, 0x65, 0x74,
 	0x62, 0x69, 0x78, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x76, 0x31, 0x2f, 0x66, 0x61, 0x69,
 	0x6c, 0x75, 0x72, 0x65, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x22, 0x95, 0x0b, 0x0a, 0x13, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x65, 0x64, 0x46,
+	0x6f, 0x22, 0x94, 0x0e, 0x0a, 0x13, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x65, 0x64, 0x46,
 	0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x52, 0x6f, 0x77, 0x12, 0x2b, 0x0a, 0x11, 0x63, 0x6c, 0x75,
 	0x73, 0x74, 0x65, 0x72, 0x5f, 0x61, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x18, 0x01,
 	0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x41, 0x6c, 0x67,
@@ -555,10 +712,48 @@ var file_infra_appengine_weetbix_proto_bq_clustered_failure_row_proto_rawDesc =
 	0x65, 0x73, 0x75, 0x6c, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2d, 0x0a, 0x13, 0x69, 0x73,
 	0x5f, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x72, 0x75, 0x6e, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x65,
 	0x64, 0x18, 0x1c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x69, 0x73, 0x54, 0x65, 0x73, 0x74, 0x52,
-	0x75, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x42, 0x2c, 0x5a, 0x2a, 0x69, 0x6e, 0x66,
-	0x72, 0x61, 0x2f, 0x61, 0x70, 0x70, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x2f, 0x77, 0x65, 0x65,
-	0x74, 0x62, 0x69, 0x78, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x62, 0x71, 0x3b, 0x77, 0x65,
-	0x65, 0x74, 0x62, 0x69, 0x78, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x75, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x73, 0x5f,
+	0x64, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x18, 0x1d, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0b, 0x69, 0x73, 0x44, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x12, 0x5f, 0x0a, 0x19,
+	0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x75, 0x72, 0x65, 0x64, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x75,
+	0x72, 0x65, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x23, 0x2e, 0x77, 0x65, 0x65, 0x74, 0x62, 0x69, 0x78, 0x2e, 0x62, 0x71, 0x2e, 0x53, 0x74, 0x72,
+	0x75, 0x63, 0x74, 0x75, 0x72, 0x65, 0x64, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x52, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x52, 0x17, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x75, 0x72, 0x65, 0x64,
+	0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x36, 0x0a,
+	0x09, 0x61, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x73, 0x18, 0x1f, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x18, 0x2e, 0x77, 0x65, 0x65, 0x74, 0x62, 0x69, 0x78, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x72,
+	0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x09, 0x61, 0x72, 0x74, 0x69,
+	0x66, 0x61, 0x63, 0x74, 0x73, 0x12, 0x3a, 0x0a, 0x0c, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65,
+	0x5f, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x20, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x77, 0x65,
+	0x65, 0x74, 0x62, 0x69, 0x78, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65,
+	0x4b, 0x69, 0x6e, 0x64, 0x52, 0x0b, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x4b, 0x69, 0x6e,
+	0x64, 0x12, 0x4c, 0x0a, 0x12, 0x65, 0x78, 0x6f, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x21, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e,
+	0x77, 0x65, 0x65, 0x74, 0x62, 0x69, 0x78, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x6f, 0x6e, 0x65,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x11, 0x65, 0x78,
+	0x6f, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12,
+	0x37, 0x0a, 0x17, 0x65, 0x78, 0x6f, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x65,
+	0x78, 0x70, 0x6c, 0x61, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x22, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x16, 0x65, 0x78, 0x6f, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x78, 0x70,
+	0x6c, 0x61, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xdb, 0x01, 0x0a, 0x17, 0x53, 0x74, 0x72,
+	0x75, 0x63, 0x74, 0x75, 0x72, 0x65, 0x64, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x52, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x12, 0x32, 0x0a, 0x15, 0x70, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x5f,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x13, 0x70, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x45, 0x72, 0x72, 0x6f,
+	0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x26, 0x0a, 0x0f, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x74, 0x61, 0x67, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x0d, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x54, 0x79, 0x70, 0x65, 0x54, 0x61, 0x67, 0x73,
+	0x12, 0x1c, 0x0a, 0x09, 0x74, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x09, 0x74, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x64, 0x12, 0x46,
+	0x0a, 0x1f, 0x6e, 0x6f, 0x72, 0x6d, 0x61, 0x6c, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x61, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x1d, 0x6e, 0x6f, 0x72, 0x6d, 0x61, 0x6c, 0x69,
+	0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x56,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x42, 0x2c, 0x5a, 0x2a, 0x69, 0x6e, 0x66, 0x72, 0x61, 0x2f,
+	0x61, 0x70, 0x70, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x2f, 0x77, 0x65, 0x65, 0x74, 0x62, 0x69,
+	0x78, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x62, 0x71, 0x3b, 0x77, 0x65, 0x65, 0x74, 0x62,
+	0x69, 0x78, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -573,30 +768,38 @@ func file_infra_appengine_weetbix_proto_bq_clustered_failure_row_proto_rawDescGZ
 	return file_infra_appengine_weetbix_proto_bq_clustered_failure_row_proto_rawDescData
 }
 
-var file_infra_appengine_weetbix_proto_bq_clustered_failure_row_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_infra_appengine_weetbix_proto_bq_clustered_failure_row_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
 var file_infra_appengine_weetbix_proto_bq_clustered_failure_row_proto_goTypes = []interface{}{
 	(*ClusteredFailureRow)(nil),     // 0: weetbix.bq.ClusteredFailureRow
-	(*timestamppb.Timestamp)(nil),   // 1: google.protobuf.Timestamp
-	(*v1.StringPair)(nil),           // 2: weetbix.v1.StringPair
-	(*v1.FailureReason)(nil),        // 3: weetbix.v1.FailureReason
-	(*v1.BugTrackingComponent)(nil), // 4: weetbix.v1.BugTrackingComponent
-	(*durationpb.Duration)(nil),     // 5: google.protobuf.Duration
-	(*v1.PresubmitRunId)(nil),       // 6: weetbix.v1.PresubmitRunId
+	(*StructuredFailureReason)(nil), // 1: weetbix.bq.StructuredFailureReason
+	(*timestamppb.Timestamp)(nil),   // 2: google.protobuf.Timestamp
+	(*v1.StringPair)(nil),           // 3: weetbix.v1.StringPair
+	(*v1.FailureReason)(nil),        // 4: weetbix.v1.FailureReason
+	(*v1.BugTrackingComponent)(nil), // 5: weetbix.v1.BugTrackingComponent
+	(*durationpb.Duration)(nil),     // 6: google.protobuf.Duration
+	(*v1.PresubmitRunId)(nil),       // 7: weetbix.v1.PresubmitRunId
+	(*v1.ArtifactLink)(nil),         // 8: weetbix.v1.ArtifactLink
+	(v1.FailureKind)(0),             // 9: weetbix.v1.FailureKind
+	(v1.ExonerationReason)(0),       // 10: weetbix.v1.ExonerationReason
 }
 var file_infra_appengine_weetbix_proto_bq_clustered_failure_row_proto_depIdxs = []int32{
-	1, // 0: weetbix.bq.ClusteredFailureRow.last_updated:type_name -> google.protobuf.Timestamp
-	1, // 1: weetbix.bq.ClusteredFailureRow.partition_time:type_name -> google.protobuf.Timestamp
-	2, // 2: weetbix.bq.ClusteredFailureRow.variant:type_name -> weetbix.v1.StringPair
-	3, // 3: weetbix.bq.ClusteredFailureRow.failure_reason:type_name -> weetbix.v1.FailureReason
-	4, // 4: weetbix.bq.ClusteredFailureRow.bug_tracking_component:type_name -> weetbix.v1.BugTrackingComponent
-	1, // 5: weetbix.bq.ClusteredFailureRow.start_time:type_name -> google.protobuf.Timestamp
-	5, // 6: weetbix.bq.ClusteredFailureRow.duration:type_name -> google.protobuf.Duration
-	6, // 7: weetbix.bq.ClusteredFailureRow.presubmit_run_id:type_name -> weetbix.v1.PresubmitRunId
-	8, // [8:8] is the sub-list for method output_type
-	8, // [8:8] is the sub-list for method input_type
-	8, // [8:8] is the sub-list for extension type_name
-	8, // [8:8] is the sub-list for extension extendee
-	0, // [0:8] is the sub-list for field type_name
+	2,  // 0: weetbix.bq.ClusteredFailureRow.last_updated:type_name -> google.protobuf.Timestamp
+	2,  // 1: weetbix.bq.ClusteredFailureRow.partition_time:type_name -> google.protobuf.Timestamp
+	3,  // 2: weetbix.bq.ClusteredFailureRow.variant:type_name -> weetbix.v1.StringPair
+	4,  // 3: weetbix.bq.ClusteredFailureRow.failure_reason:type_name -> weetbix.v1.FailureReason
+	5,  // 4: weetbix.bq.ClusteredFailureRow.bug_tracking_component:type_name -> weetbix.v1.BugTrackingComponent
+	2,  // 5: weetbix.bq.ClusteredFailureRow.start_time:type_name -> google.protobuf.Timestamp
+	6,  // 6: weetbix.bq.ClusteredFailureRow.duration:type_name -> google.protobuf.Duration
+	7,  // 7: weetbix.bq.ClusteredFailureRow.presubmit_run_id:type_name -> weetbix.v1.PresubmitRunId
+	1,  // 8: weetbix.bq.ClusteredFailureRow.structured_failure_reason:type_name -> weetbix.bq.StructuredFailureReason
+	8,  // 9: weetbix.bq.ClusteredFailureRow.artifacts:type_name -> weetbix.v1.ArtifactLink
+	9,  // 10: weetbix.bq.ClusteredFailureRow.failure_kind:type_name -> weetbix.v1.FailureKind
+	10, // 11: weetbix.bq.ClusteredFailureRow.exoneration_reason:type_name -> weetbix.v1.ExonerationReason
+	12, // [12:12] is the sub-list for method output_type
+	12, // [12:12] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
 }
 
 func init() { file_infra_appengine_weetbix_proto_bq_clustered_failure_row_proto_init() }
@@ -617,6 +820,18 @@ func file_infra_appengine_weetbix_proto_bq_clustered_failure_row_proto_init() {
 				return nil
 			}
 		}
+		file_infra_appengine_weetbix_proto_bq_clustered_failure_row_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StructuredFailureReason); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -624,7 +839,7 @@ func file_infra_appengine_weetbix_proto_bq_clustered_failure_row_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_infra_appengine_weetbix_proto_bq_clustered_failure_row_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   1,
+			NumMessages:   2,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
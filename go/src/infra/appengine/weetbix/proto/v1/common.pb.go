@@ -86,6 +86,129 @@ func (VerdictStatus) EnumDescriptor() ([]byte, []int) {
 	return file_infra_appengine_weetbix_proto_v1_common_proto_rawDescGZIP(), []int{0}
 }
 
+// FailureKind is the kind of an unexpected failure ingested by Weetbix.
+// Failures of different kinds are clustered separately.
+type FailureKind int32
+
+const (
+	// The failure kind is unspecified. Failures ingested before their kind
+	// was recorded are test failures.
+	FailureKind_FAILURE_KIND_UNSPECIFIED FailureKind = 0
+	// The failure of a test result.
+	FailureKind_TEST_FAILURE FailureKind = 1
+	// The failure of a build step which is not a test step, e.g. a compile
+	// or an infra step. The test ID of such a failure is the name of the
+	// step, and its failure reason is the summary of the step.
+	FailureKind_BUILD_STEP_FAILURE FailureKind = 2
+)
+
+// Enum value maps for FailureKind.
+var (
+	FailureKind_name = map[int32]string{
+		0: "FAILURE_KIND_UNSPECIFIED",
+		1: "TEST_FAILURE",
+		2: "BUILD_STEP_FAILURE",
+	}
+	FailureKind_value = map[string]int32{
+		"FAILURE_KIND_UNSPECIFIED": 0,
+		"TEST_FAILURE":             1,
+		"BUILD_STEP_FAILURE":       2,
+	}
+)
+
+func (x FailureKind) Enum() *FailureKind {
+	p := new(FailureKind)
+	*p = x
+	return p
+}
+
+func (x FailureKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FailureKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_infra_appengine_weetbix_proto_v1_common_proto_enumTypes[1].Descriptor()
+}
+
+func (FailureKind) Type() protoreflect.EnumType {
+	return &file_infra_appengine_weetbix_proto_v1_common_proto_enumTypes[1]
+}
+
+func (x FailureKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use FailureKind.Descriptor instead.
+func (FailureKind) EnumDescriptor() ([]byte, []int) {
+	return file_infra_appengine_weetbix_proto_v1_common_proto_rawDescGZIP(), []int{1}
+}
+
+// ExonerationReason is the reason a test failure was exonerated.
+// The values mirror those of ResultDB's ExonerationReason.
+type ExonerationReason int32
+
+const (
+	// The failure was not exonerated, or was exonerated for a reason
+	// that was not recorded (e.g. before reasons were ingested).
+	ExonerationReason_EXONERATION_REASON_UNSPECIFIED ExonerationReason = 0
+	// The test was exonerated because it had unexpected results on
+	// mainline (CI) builds, as advised by Weetbix.
+	ExonerationReason_OCCURS_ON_MAINLINE ExonerationReason = 1
+	// The test was exonerated because it failed in presubmit runs of
+	// other CLs, as advised by Weetbix.
+	ExonerationReason_OCCURS_ON_OTHER_CLS ExonerationReason = 2
+	// The test variant was not critical to the build or presubmit run,
+	// so its failure did not block it.
+	ExonerationReason_NOT_CRITICAL ExonerationReason = 3
+	// The test was exonerated because its unexpected result was a pass.
+	ExonerationReason_UNEXPECTED_PASS ExonerationReason = 4
+)
+
+// Enum value maps for ExonerationReason.
+var (
+	ExonerationReason_name = map[int32]string{
+		0: "EXONERATION_REASON_UNSPECIFIED",
+		1: "OCCURS_ON_MAINLINE",
+		2: "OCCURS_ON_OTHER_CLS",
+		3: "NOT_CRITICAL",
+		4: "UNEXPECTED_PASS",
+	}
+	ExonerationReason_value = map[string]int32{
+		"EXONERATION_REASON_UNSPECIFIED": 0,
+		"OCCURS_ON_MAINLINE":             1,
+		"OCCURS_ON_OTHER_CLS":            2,
+		"NOT_CRITICAL":                   3,
+		"UNEXPECTED_PASS":                4,
+	}
+)
+
+func (x ExonerationReason) Enum() *ExonerationReason {
+	p := new(ExonerationReason)
+	*p = x
+	return p
+}
+
+func (x ExonerationReason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ExonerationReason) Descriptor() protoreflect.EnumDescriptor {
+	return file_infra_appengine_weetbix_proto_v1_common_proto_enumTypes[2].Descriptor()
+}
+
+func (ExonerationReason) Type() protoreflect.EnumType {
+	return &file_infra_appengine_weetbix_proto_v1_common_proto_enumTypes[2]
+}
+
+func (x ExonerationReason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ExonerationReason.Descriptor instead.
+func (ExonerationReason) EnumDescriptor() ([]byte, []int) {
+	return file_infra_appengine_weetbix_proto_v1_common_proto_rawDescGZIP(), []int{2}
+}
+
 // A range of timestamps.
 type TimeRange struct {
 	state         protoimpl.MessageState
@@ -446,6 +569,99 @@ func (x *PresubmitRunId) GetId() string {
 	return ""
 }
 
+// Link to an artifact of a test result, such as a log snippet or stack
+// trace. Only identifies the artifact; its contents are held by the test
+// results system.
+type ArtifactLink struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The test results system which holds the artifact.
+	// Currently, the only valid value is "resultdb".
+	System string `protobuf:"bytes,1,opt,name=system,proto3" json:"system,omitempty"`
+	// The identity of the artifact in the test results system.
+	//
+	// For artifacts in ResultDB, this is the artifact's resource name, of the
+	// format:
+	// "invocations/{INVOCATION_ID}/tests/{URL_ESCAPED_TEST_ID}/results/{RESULT_ID}/artifacts/{ARTIFACT_ID}".
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// The ID of the artifact within the test result, e.g. "snippet".
+	ArtifactId string `protobuf:"bytes,3,opt,name=artifact_id,json=artifactId,proto3" json:"artifact_id,omitempty"`
+	// The media type of the artifact contents, e.g. "text/plain".
+	// Empty if unknown.
+	ContentType string `protobuf:"bytes,4,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	// The size of the artifact contents, in bytes.
+	SizeBytes int64 `protobuf:"varint,5,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+}
+
+func (x *ArtifactLink) Reset() {
+	*x = ArtifactLink{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_infra_appengine_weetbix_proto_v1_common_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ArtifactLink) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArtifactLink) ProtoMessage() {}
+
+func (x *ArtifactLink) ProtoReflect() protoreflect.Message {
+	mi := &file_infra_appengine_weetbix_proto_v1_common_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArtifactLink.ProtoReflect.Descriptor instead.
+func (*ArtifactLink) Descriptor() ([]byte, []int) {
+	return file_infra_appengine_weetbix_proto_v1_common_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ArtifactLink) GetSystem() string {
+	if x != nil {
+		return x.System
+	}
+	return ""
+}
+
+func (x *ArtifactLink) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ArtifactLink) GetArtifactId() string {
+	if x != nil {
+		return x.ArtifactId
+	}
+	return ""
+}
+
+func (x *ArtifactLink) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *ArtifactLink) GetSizeBytes() int64 {
+	if x != nil {
+		return x.SizeBytes
+	}
+	return 0
+}
+
 var File_infra_appengine_weetbix_proto_v1_common_proto protoreflect.FileDescriptor
 
 var file_infra_appengine_weetbix_proto_v1_common_proto_rawDesc = []byte{
@@ -484,17 +700,41 @@ var file_infra_appengine_weetbix_proto_v1_common_proto_rawDesc = []byte{
 	0x6e, 0x65, 0x6e, 0x74, 0x22, 0x38, 0x0a, 0x0e, 0x50, 0x72, 0x65, 0x73, 0x75, 0x62, 0x6d, 0x69,
 	0x74, 0x52, 0x75, 0x6e, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d,
 	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x12, 0x0e,
-	0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x2a, 0x60,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x9d,
+	0x01, 0x0a, 0x0c, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x4c, 0x69, 0x6e, 0x6b, 0x12,
+	0x16, 0x0a, 0x06, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x61,
+	0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x61, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c,
+	0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x1d, 0x0a, 0x0a, 0x73, 0x69, 0x7a, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x69, 0x7a, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x2a, 0x60,
 	0x0a, 0x0d, 0x56, 0x65, 0x72, 0x64, 0x69, 0x63, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
 	0x1e, 0x0a, 0x1a, 0x56, 0x45, 0x52, 0x44, 0x49, 0x43, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55,
 	0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12,
 	0x0e, 0x0a, 0x0a, 0x55, 0x4e, 0x45, 0x58, 0x50, 0x45, 0x43, 0x54, 0x45, 0x44, 0x10, 0x0a, 0x12,
 	0x11, 0x0a, 0x0d, 0x56, 0x45, 0x52, 0x44, 0x49, 0x43, 0x54, 0x5f, 0x46, 0x4c, 0x41, 0x4b, 0x59,
 	0x10, 0x1e, 0x12, 0x0c, 0x0a, 0x08, 0x45, 0x58, 0x50, 0x45, 0x43, 0x54, 0x45, 0x44, 0x10, 0x32,
-	0x42, 0x2c, 0x5a, 0x2a, 0x69, 0x6e, 0x66, 0x72, 0x61, 0x2f, 0x61, 0x70, 0x70, 0x65, 0x6e, 0x67,
-	0x69, 0x6e, 0x65, 0x2f, 0x77, 0x65, 0x65, 0x74, 0x62, 0x69, 0x78, 0x2f, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x2f, 0x76, 0x31, 0x3b, 0x77, 0x65, 0x65, 0x74, 0x62, 0x69, 0x78, 0x70, 0x62, 0x62, 0x06,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x2a, 0x55, 0x0a, 0x0b, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x4b, 0x69, 0x6e, 0x64, 0x12,
+	0x1c, 0x0a, 0x18, 0x46, 0x41, 0x49, 0x4c, 0x55, 0x52, 0x45, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f,
+	0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x10, 0x0a,
+	0x0c, 0x54, 0x45, 0x53, 0x54, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x55, 0x52, 0x45, 0x10, 0x01, 0x12,
+	0x16, 0x0a, 0x12, 0x42, 0x55, 0x49, 0x4c, 0x44, 0x5f, 0x53, 0x54, 0x45, 0x50, 0x5f, 0x46, 0x41,
+	0x49, 0x4c, 0x55, 0x52, 0x45, 0x10, 0x02, 0x2a, 0x8f, 0x01, 0x0a, 0x11, 0x45, 0x78, 0x6f, 0x6e,
+	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x22, 0x0a,
+	0x1e, 0x45, 0x58, 0x4f, 0x4e, 0x45, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x41,
+	0x53, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
+	0x00, 0x12, 0x16, 0x0a, 0x12, 0x4f, 0x43, 0x43, 0x55, 0x52, 0x53, 0x5f, 0x4f, 0x4e, 0x5f, 0x4d,
+	0x41, 0x49, 0x4e, 0x4c, 0x49, 0x4e, 0x45, 0x10, 0x01, 0x12, 0x17, 0x0a, 0x13, 0x4f, 0x43, 0x43,
+	0x55, 0x52, 0x53, 0x5f, 0x4f, 0x4e, 0x5f, 0x4f, 0x54, 0x48, 0x45, 0x52, 0x5f, 0x43, 0x4c, 0x53,
+	0x10, 0x02, 0x12, 0x10, 0x0a, 0x0c, 0x4e, 0x4f, 0x54, 0x5f, 0x43, 0x52, 0x49, 0x54, 0x49, 0x43,
+	0x41, 0x4c, 0x10, 0x03, 0x12, 0x13, 0x0a, 0x0f, 0x55, 0x4e, 0x45, 0x58, 0x50, 0x45, 0x43, 0x54,
+	0x45, 0x44, 0x5f, 0x50, 0x41, 0x53, 0x53, 0x10, 0x04, 0x42, 0x2c, 0x5a, 0x2a, 0x69, 0x6e, 0x66,
+	0x72, 0x61, 0x2f, 0x61, 0x70, 0x70, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x2f, 0x77, 0x65, 0x65,
+	0x74, 0x62, 0x69, 0x78, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x76, 0x31, 0x3b, 0x77, 0x65,
+	0x65, 0x74, 0x62, 0x69, 0x78, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -509,28 +749,31 @@ func file_infra_appengine_weetbix_proto_v1_common_proto_rawDescGZIP() []byte {
 	return file_infra_appengine_weetbix_proto_v1_common_proto_rawDescData
 }
 
-var file_infra_appengine_weetbix_proto_v1_common_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_infra_appengine_weetbix_proto_v1_common_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_infra_appengine_weetbix_proto_v1_common_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_infra_appengine_weetbix_proto_v1_common_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
 var file_infra_appengine_weetbix_proto_v1_common_proto_goTypes = []interface{}{
 	(VerdictStatus)(0),            // 0: weetbix.v1.VerdictStatus
-	(*TimeRange)(nil),             // 1: weetbix.v1.TimeRange
-	(*TestResultId)(nil),          // 2: weetbix.v1.TestResultId
-	(*Variant)(nil),               // 3: weetbix.v1.Variant
-	(*StringPair)(nil),            // 4: weetbix.v1.StringPair
-	(*BugTrackingComponent)(nil),  // 5: weetbix.v1.BugTrackingComponent
-	(*PresubmitRunId)(nil),        // 6: weetbix.v1.PresubmitRunId
-	nil,                           // 7: weetbix.v1.Variant.DefEntry
-	(*timestamppb.Timestamp)(nil), // 8: google.protobuf.Timestamp
+	(FailureKind)(0),              // 1: weetbix.v1.FailureKind
+	(ExonerationReason)(0),        // 2: weetbix.v1.ExonerationReason
+	(*TimeRange)(nil),             // 3: weetbix.v1.TimeRange
+	(*TestResultId)(nil),          // 4: weetbix.v1.TestResultId
+	(*Variant)(nil),               // 5: weetbix.v1.Variant
+	(*StringPair)(nil),            // 6: weetbix.v1.StringPair
+	(*BugTrackingComponent)(nil),  // 7: weetbix.v1.BugTrackingComponent
+	(*PresubmitRunId)(nil),        // 8: weetbix.v1.PresubmitRunId
+	(*ArtifactLink)(nil),          // 9: weetbix.v1.ArtifactLink
+	nil,                           // 10: weetbix.v1.Variant.DefEntry
+	(*timestamppb.Timestamp)(nil), // 11: google.protobuf.Timestamp
 }
 var file_infra_appengine_weetbix_proto_v1_common_proto_depIdxs = []int32{
-	8, // 0: weetbix.v1.TimeRange.earliest:type_name -> google.protobuf.Timestamp
-	8, // 1: weetbix.v1.TimeRange.latest:type_name -> google.protobuf.Timestamp
-	7, // 2: weetbix.v1.Variant.def:type_name -> weetbix.v1.Variant.DefEntry
-	3, // [3:3] is the sub-list for method output_type
-	3, // [3:3] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	11, // 0: weetbix.v1.TimeRange.earliest:type_name -> google.protobuf.Timestamp
+	11, // 1: weetbix.v1.TimeRange.latest:type_name -> google.protobuf.Timestamp
+	10, // 2: weetbix.v1.Variant.def:type_name -> weetbix.v1.Variant.DefEntry
+	3,  // [3:3] is the sub-list for method output_type
+	3,  // [3:3] is the sub-list for method input_type
+	3,  // [3:3] is the sub-list for extension type_name
+	3,  // [3:3] is the sub-list for extension extendee
+	0,  // [0:3] is the sub-list for field type_name
 }
 
 func init() { file_infra_appengine_weetbix_proto_v1_common_proto_init() }
@@ -611,14 +854,26 @@ func file_infra_appengine_weetbix_proto_v1_common_proto_init() {
 				return nil
 			}
 		}
+		file_infra_appengine_weetbix_proto_v1_common_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ArtifactLink); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_infra_appengine_weetbix_proto_v1_common_proto_rawDesc,
-			NumEnums:      1,
-			NumMessages:   7,
+			NumEnums:      3,
+			NumMessages:   8,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -4,4 +4,4 @@
 
 package weetbixpb
 
-//go:generate cproto
+//go:generate cproto -use-grpc-plugin
@@ -57,6 +57,9 @@ func NewClient(ctx context.Context) (*Client, error) {
 // See go/buildbucket-proto for the list of all fields.
 var getBuildFields = []string{
 	"id",
+	// The builder name prefixes the names of mirrored logs reserved by the
+	// orchestrator.
+	"builder",
 	// Build details are parsed from the build's properties.
 	"output.properties",
 	// Build status is used to determine whether the build is complete.
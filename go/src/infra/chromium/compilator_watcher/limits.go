@@ -0,0 +1,204 @@
+// Copyright 2021 The Chromium Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	buildbucket_pb "go.chromium.org/luci/buildbucket/proto"
+	"go.chromium.org/luci/buildbucket/protoutil"
+	"go.chromium.org/luci/common/logging"
+	"google.golang.org/protobuf/proto"
+)
+
+// truncatedSuffix is appended to summary markdowns truncated to keep the
+// mirrored build within its size budget.
+const truncatedSuffix = "\n\n...(truncated)"
+
+// minTruncatedSummaryBytes is the size below which summary markdowns are not
+// truncated any further.
+const minTruncatedSummaryBytes = 256
+
+// stepLimits bounds the size of the mirrored build, so that compilator
+// builds with a huge number of steps or huge step summaries don't make
+// Buildbucket reject the sub-build updates.
+type stepLimits struct {
+	// maxSteps is the maximum number of mirrored steps. Failed steps and
+	// their parents are always mirrored, on top of this limit. Zero means
+	// no limit.
+	maxSteps int
+	// maxBuildSizeBytes is the maximum size of the serialized mirrored
+	// build. Zero means no limit.
+	maxBuildSizeBytes int
+}
+
+// applyStepLimits truncates the steps of luciBuild and their summaries to
+// fit within limits.
+func applyStepLimits(ctx context.Context, luciBuild *buildbucket_pb.Build, limits stepLimits) {
+	luciBuild.Steps = limitSteps(luciBuild.Steps, limits.maxSteps)
+	if !limitBuildSize(luciBuild, limits.maxBuildSizeBytes) {
+		logging.Warningf(ctx, "Mirrored build is still %d bytes after truncating summaries, over the budget of %d bytes",
+			proto.Size(luciBuild), limits.maxBuildSizeBytes)
+	}
+}
+
+// isFailedStep returns whether the step ended without succeeding.
+func isFailedStep(step *buildbucket_pb.Step) bool {
+	return protoutil.IsEnded(step.GetStatus()) && step.GetStatus() != buildbucket_pb.Status_SUCCESS
+}
+
+// parentStepName returns the name of the parent of a nested step, or "" for
+// a top-level step.
+func parentStepName(name string) string {
+	if i := strings.LastIndex(name, "|"); i >= 0 {
+		return name[:i]
+	}
+	return ""
+}
+
+// limitSteps returns at most maxSteps steps: the first and last steps, with a
+// synthetic step standing for the omitted steps in between. Failed steps and
+// the parents of the returned nested steps are always returned, so more than
+// maxSteps steps are returned if there are too many of them.
+//
+// steps itself is returned if it's within the limit.
+func limitSteps(steps []*buildbucket_pb.Step, maxSteps int) []*buildbucket_pb.Step {
+	if maxSteps <= 0 || len(steps) <= maxSteps {
+		return steps
+	}
+	// Reserve a step for the omitted steps.
+	head := (maxSteps - 1) / 2
+	tail := maxSteps - 1 - head
+
+	keep := make([]bool, len(steps))
+	indexes := make(map[string]int, len(steps))
+	for i, step := range steps {
+		indexes[step.GetName()] = i
+		keep[i] = i < head || i >= len(steps)-tail || isFailedStep(step)
+	}
+	for i, step := range steps {
+		if !keep[i] {
+			continue
+		}
+		for parent := parentStepName(step.GetName()); parent != ""; parent = parentStepName(parent) {
+			if j, ok := indexes[parent]; ok {
+				keep[j] = true
+			}
+		}
+	}
+
+	limited := make([]*buildbucket_pb.Step, 0, maxSteps+1)
+	var omitted []*buildbucket_pb.Step
+	for i, step := range steps {
+		if !keep[i] {
+			omitted = append(omitted, step)
+			continue
+		}
+		if len(omitted) > 0 {
+			limited = append(limited, omittedStepsStep(omitted, indexes))
+			omitted = nil
+		}
+		limited = append(limited, step)
+	}
+	if len(omitted) > 0 {
+		limited = append(limited, omittedStepsStep(omitted, indexes))
+	}
+	return limited
+}
+
+// omittedStepsStep returns the synthetic step standing for omitted steps.
+// Its name is made unique among the names in indexes, which it's added to.
+func omittedStepsStep(omitted []*buildbucket_pb.Step, indexes map[string]int) *buildbucket_pb.Step {
+	name := fmt.Sprintf("… %d steps omitted …", len(omitted))
+	for i := 2; ; i++ {
+		if _, ok := indexes[name]; !ok {
+			break
+		}
+		name = fmt.Sprintf("… %d steps omitted (%d) …", len(omitted), i)
+	}
+	indexes[name] = -1
+
+	step := &buildbucket_pb.Step{
+		Name:   name,
+		Status: buildbucket_pb.Status_SUCCESS,
+		SummaryMarkdown: fmt.Sprintf(
+			"%d steps were omitted to keep this build within its size limits. See the compilator build for all of its steps.",
+			len(omitted)),
+	}
+	for _, s := range omitted {
+		if step.StartTime == nil {
+			step.StartTime = s.GetStartTime()
+		}
+		if !protoutil.IsEnded(s.GetStatus()) {
+			step.Status = buildbucket_pb.Status_STARTED
+		}
+	}
+	if step.StartTime == nil {
+		step.Status = buildbucket_pb.Status_SCHEDULED
+	}
+	if step.Status == buildbucket_pb.Status_SUCCESS {
+		step.EndTime = omitted[len(omitted)-1].GetEndTime()
+	}
+	return step
+}
+
+// limitBuildSize truncates the longest summary markdowns of the build and its
+// steps until the serialized build is at most maxBytes. Steps and the steps
+// slice are copied before being truncated, since they may be shared with the
+// compilator build.
+//
+// Returns false if the build is still too large once all summaries are
+// truncated.
+func limitBuildSize(build *buildbucket_pb.Build, maxBytes int) bool {
+	if maxBytes <= 0 {
+		return true
+	}
+	copied := false
+	for {
+		excess := proto.Size(build) - maxBytes
+		if excess <= 0 {
+			return true
+		}
+
+		// Find the longest summary markdown, -1 being the build's own.
+		longest, longestLen := -1, len(build.GetSummaryMarkdown())
+		for i, step := range build.GetSteps() {
+			if l := len(step.GetSummaryMarkdown()); l > longestLen {
+				longest, longestLen = i, l
+			}
+		}
+		if longestLen <= minTruncatedSummaryBytes+len(truncatedSuffix) {
+			return false
+		}
+
+		newLen := longestLen - excess - len(truncatedSuffix)
+		if newLen < minTruncatedSummaryBytes {
+			newLen = minTruncatedSummaryBytes
+		}
+		if longest < 0 {
+			build.SummaryMarkdown = truncateMarkdown(build.SummaryMarkdown, newLen)
+		} else {
+			if !copied {
+				build.Steps = append([]*buildbucket_pb.Step(nil), build.Steps...)
+				copied = true
+			}
+			step := proto.Clone(build.Steps[longest]).(*buildbucket_pb.Step)
+			step.SummaryMarkdown = truncateMarkdown(step.SummaryMarkdown, newLen)
+			build.Steps[longest] = step
+		}
+	}
+}
+
+// truncateMarkdown truncates md to at most n bytes, without splitting UTF-8
+// characters, and marks it as truncated.
+func truncateMarkdown(md string, n int) string {
+	for n > 0 && !utf8.RuneStart(md[n]) {
+		n--
+	}
+	return md[:n] + truncatedSuffix
+}
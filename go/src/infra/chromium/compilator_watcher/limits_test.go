@@ -0,0 +1,170 @@
+// Copyright 2021 The Chromium Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package main
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+	buildbucket_pb "go.chromium.org/luci/buildbucket/proto"
+	. "go.chromium.org/luci/common/testing/assertions"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func getManySteps(n int) []*buildbucket_pb.Step {
+	start := time.Date(2021, 01, 01, 00, 00, 00, 00, time.UTC)
+	steps := make([]*buildbucket_pb.Step, n)
+	for i := range steps {
+		steps[i] = &buildbucket_pb.Step{
+			Name:      fmt.Sprintf("step %d", i),
+			Status:    buildbucket_pb.Status_SUCCESS,
+			StartTime: timestamppb.New(start.Add(time.Duration(i) * time.Minute)),
+			EndTime:   timestamppb.New(start.Add(time.Duration(i+1) * time.Minute)),
+		}
+	}
+	return steps
+}
+
+func getStepNames(steps []*buildbucket_pb.Step) []string {
+	names := make([]string, len(steps))
+	for i, step := range steps {
+		names[i] = step.GetName()
+	}
+	return names
+}
+
+func TestLimitSteps(t *testing.T) {
+	t.Parallel()
+
+	Convey("limitSteps", t, func() {
+		steps := getManySteps(1000)
+
+		Convey("keeps steps within the limit", func() {
+			So(limitSteps(steps[:10], 10), ShouldResemble, steps[:10])
+			So(limitSteps(steps, 0), ShouldResemble, steps)
+		})
+
+		Convey("keeps the first and last steps", func() {
+			limited := limitSteps(steps, 10)
+			So(limited, ShouldHaveLength, 10)
+			So(getStepNames(limited), ShouldResemble, []string{
+				"step 0", "step 1", "step 2", "step 3",
+				"… 991 steps omitted …",
+				"step 995", "step 996", "step 997", "step 998", "step 999",
+			})
+
+			omitted := limited[4]
+			So(omitted.Status, ShouldEqual, buildbucket_pb.Status_SUCCESS)
+			So(omitted.StartTime, ShouldResembleProto, steps[4].StartTime)
+			So(omitted.EndTime, ShouldResembleProto, steps[994].EndTime)
+			So(omitted.SummaryMarkdown, ShouldContainSubstring, "991 steps were omitted")
+		})
+
+		Convey("keeps failed steps", func() {
+			steps[500].Status = buildbucket_pb.Status_FAILURE
+			steps[600].Status = buildbucket_pb.Status_INFRA_FAILURE
+			limited := limitSteps(steps, 10)
+			So(getStepNames(limited), ShouldResemble, []string{
+				"step 0", "step 1", "step 2", "step 3",
+				"… 496 steps omitted …",
+				"step 500",
+				"… 99 steps omitted …",
+				"step 600",
+				"… 394 steps omitted …",
+				"step 995", "step 996", "step 997", "step 998", "step 999",
+			})
+		})
+
+		Convey("keeps the parents of nested steps", func() {
+			steps[499].Name = "compile"
+			steps[500].Name = "compile|postprocess"
+			steps[501].Name = "compile|postprocess|check"
+			steps[501].Status = buildbucket_pb.Status_FAILURE
+			limited := limitSteps(steps, 10)
+			So(getStepNames(limited[4:8]), ShouldResemble, []string{
+				"… 495 steps omitted …",
+				"compile",
+				"compile|postprocess",
+				"compile|postprocess|check",
+			})
+		})
+
+		Convey("marks the omitted steps as running", func() {
+			steps[500].Status = buildbucket_pb.Status_STARTED
+			steps[500].EndTime = nil
+			omitted := limitSteps(steps, 10)[4]
+			So(omitted.Status, ShouldEqual, buildbucket_pb.Status_STARTED)
+			So(omitted.EndTime, ShouldBeNil)
+		})
+
+		Convey("gives omitted steps unique names", func() {
+			steps = getManySteps(13)
+			steps[0].Name = "… 4 steps omitted …"
+			steps[6].Status = buildbucket_pb.Status_FAILURE
+			limited := limitSteps(steps, 5)
+			So(getStepNames(limited), ShouldResemble, []string{
+				"… 4 steps omitted …",
+				"step 1",
+				"… 4 steps omitted (2) …",
+				"step 6",
+				"… 4 steps omitted (3) …",
+				"step 11", "step 12",
+			})
+		})
+	})
+}
+
+func TestLimitBuildSize(t *testing.T) {
+	t.Parallel()
+
+	Convey("limitBuildSize", t, func() {
+		steps := getManySteps(10)
+		for _, step := range steps {
+			step.SummaryMarkdown = strings.Repeat("a", 10*1024)
+		}
+		steps[5].SummaryMarkdown = strings.Repeat("é", 50*1024)
+		compBuild := &buildbucket_pb.Build{Steps: steps}
+		build := &buildbucket_pb.Build{
+			SummaryMarkdown: strings.Repeat("b", 20*1024),
+			Steps:           compBuild.Steps,
+		}
+
+		Convey("truncates the longest summaries", func() {
+			So(limitBuildSize(build, 100*1024), ShouldBeTrue)
+			So(proto.Size(build), ShouldBeLessThanOrEqualTo, 100*1024)
+
+			So(build.Steps[5].SummaryMarkdown, ShouldEndWith, truncatedSuffix)
+			So(strings.TrimSuffix(build.Steps[5].SummaryMarkdown, truncatedSuffix), ShouldStartWith, "éé")
+			So(strings.Trim(strings.TrimSuffix(build.Steps[5].SummaryMarkdown, truncatedSuffix), "é"), ShouldBeEmpty)
+			So(build.Steps[0].SummaryMarkdown, ShouldNotEndWith, truncatedSuffix)
+
+			// The compilator build is left untouched.
+			So(compBuild.Steps[5].SummaryMarkdown, ShouldHaveLength, 100*1024)
+		})
+
+		Convey("stops truncating summaries at a minimum size", func() {
+			So(limitBuildSize(build, 1024), ShouldBeFalse)
+			So(build.SummaryMarkdown, ShouldHaveLength, minTruncatedSummaryBytes+len(truncatedSuffix))
+			for _, step := range build.Steps {
+				So(step.SummaryMarkdown, ShouldEndWith, truncatedSuffix)
+			}
+			for _, step := range compBuild.Steps {
+				So(step.SummaryMarkdown, ShouldNotEndWith, truncatedSuffix)
+			}
+		})
+
+		Convey("does nothing within the budget", func() {
+			size := proto.Size(build)
+			So(limitBuildSize(build, size), ShouldBeTrue)
+			So(proto.Size(build), ShouldEqual, size)
+			So(limitBuildSize(build, 0), ShouldBeTrue)
+			So(proto.Size(build), ShouldEqual, size)
+		})
+	})
+}
@@ -15,6 +15,7 @@ import (
 	"time"
 
 	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/proto"
 
 	buildbucket_pb "go.chromium.org/luci/buildbucket/proto"
 	"go.chromium.org/luci/buildbucket/protoutil"
@@ -35,6 +36,16 @@ const swarmingOutputPropKey = "swarming_trigger_properties"
 const swarmingPhase = "getSwarmingTriggerProps"
 const localTestPhase = "getLocalTests"
 
+// compilatorBuildLogName is the name of the log linking to the compilator
+// build, which is added to the first copied step.
+const compilatorBuildLogName = "compilator build"
+
+// reservedLogNames are the names of logs the orchestrator attaches to its
+// own steps. Compilator logs with these names are prefixed with the
+// compilator builder name, so that they're not mistaken for the
+// orchestrator's.
+var reservedLogNames = stringset.NewFromSlice("stdout", "stderr", "$debug", compilatorBuildLogName)
+
 func main() {
 	exe.Run(luciEXEMain, exe.WithZlibCompression(zlib.BestCompression))
 }
@@ -70,7 +81,9 @@ type cmdArgs struct {
 	phase                          string
 	compPollingTimeoutSec          time.Duration
 	compPollingIntervalSec         time.Duration
+	maxCompPollingIntervalSec      time.Duration
 	maxConsecutiveGetBuildTimeouts int64
+	limits                         stepLimits
 }
 
 func parseArgs(args []string) (cmdArgs, error) {
@@ -87,13 +100,30 @@ func parseArgs(args []string) (cmdArgs, error) {
 	compPollingIntervalSec := fs.Int64(
 		"compilator-polling-interval-sec",
 		10,
-		"Number of seconds to wait between compilator polls")
+		"Number of seconds to wait between compilator polls. The interval backs off "+
+			"exponentially while the compilator build makes no progress")
+
+	maxCompPollingIntervalSec := fs.Int64(
+		"max-polling-interval-sec",
+		60,
+		"The maximum number of seconds to wait between compilator polls")
 
 	maxGetBuildTimeouts := fs.Int64(
 		"max-consecutive-get-build-timeouts",
 		3,
 		"The maximum amount of consecutive timeouts allowed when running GetBuild for the compilator build")
 
+	maxMirroredSteps := fs.Int(
+		"max-mirrored-steps",
+		500,
+		"The maximum number of compilator steps to display, on top of failed steps. "+
+			"The first and last steps are displayed, the ones in between are omitted. 0 means no limit")
+
+	maxBuildSizeBytes := fs.Int(
+		"max-build-size-bytes",
+		800*1024,
+		"The maximum size of the serialized sub-build. Step summaries are truncated to fit. 0 means no limit")
+
 	if err := fs.Parse(args); err != nil {
 		return cmdArgs{}, err
 	}
@@ -106,6 +136,18 @@ func parseArgs(args []string) (cmdArgs, error) {
 		errs = append(errs, errors.Reason(
 			"Exactly one of -get-swarming-trigger-props or -get-local-tests is required").Err())
 	}
+	// The first and last steps are always displayed, along with the step
+	// standing for the omitted ones.
+	if *maxMirroredSteps != 0 && *maxMirroredSteps < 3 {
+		errs = append(errs, errors.Reason("max-mirrored-steps must be 0 or at least 3").Err())
+	}
+	if *maxCompPollingIntervalSec < *compPollingIntervalSec {
+		errs = append(errs, errors.Reason(
+			"max-polling-interval-sec must be at least compilator-polling-interval-sec").Err())
+	}
+	if *maxBuildSizeBytes < 0 {
+		errs = append(errs, errors.Reason("max-build-size-bytes must not be negative").Err())
+	}
 	if errs.First() != nil {
 		return cmdArgs{}, errs
 	}
@@ -124,7 +166,12 @@ func parseArgs(args []string) (cmdArgs, error) {
 		phase:                          phase,
 		compPollingTimeoutSec:          time.Duration(*compPollingTimeoutSec) * time.Second,
 		compPollingIntervalSec:         time.Duration(*compPollingIntervalSec) * time.Second,
+		maxCompPollingIntervalSec:      time.Duration(*maxCompPollingIntervalSec) * time.Second,
 		maxConsecutiveGetBuildTimeouts: *maxGetBuildTimeouts,
+		limits: stepLimits{
+			maxSteps:          *maxMirroredSteps,
+			maxBuildSizeBytes: *maxBuildSizeBytes,
+		},
 	}, nil
 }
 
@@ -150,11 +197,73 @@ func updateFilteredSteps(
 	luciBuild *buildbucket_pb.Build,
 	compBuild *buildbucket_pb.Build,
 	phase string) {
+	var steps []*buildbucket_pb.Step
 	if phase == swarmingPhase {
-		luciBuild.Steps = getStepsUntilSwarmingTriggerProps(compBuild)
+		steps = getStepsUntilSwarmingTriggerProps(compBuild)
 	} else {
-		luciBuild.Steps = getStepsAfterSwarmingTriggerProps(compBuild)
+		steps = getStepsAfterSwarmingTriggerProps(compBuild)
+	}
+	// steps may share its backing array with compBuild, so copy it.
+	luciBuild.Steps = make([]*buildbucket_pb.Step, len(steps))
+	for i, step := range steps {
+		luciBuild.Steps[i] = mirrorStep(step, compBuild)
+	}
+	addCompilatorBuildLink(luciBuild, compBuild)
+}
+
+// mirrorStep returns the step of compBuild to copy, with the logs whose
+// names are reserved by the orchestrator prefixed with the compilator
+// builder name. The step is copied before its logs are renamed, since it's
+// shared with compBuild.
+func mirrorStep(step *buildbucket_pb.Step, compBuild *buildbucket_pb.Build) *buildbucket_pb.Step {
+	reserved := false
+	for _, log := range step.GetLogs() {
+		if reservedLogNames.Has(log.GetName()) {
+			reserved = true
+			break
+		}
+	}
+	if !reserved {
+		return step
+	}
+
+	prefix := compBuild.GetBuilder().GetBuilder()
+	if prefix == "" {
+		prefix = "compilator"
+	}
+	mirrored := proto.Clone(step).(*buildbucket_pb.Step)
+	for _, log := range mirrored.Logs {
+		if reservedLogNames.Has(log.Name) {
+			log.Name = prefix + " " + log.Name
+		}
+	}
+	return mirrored
+}
+
+// compilatorBuildURL returns the URL of the page of the compilator build.
+func compilatorBuildURL(compBuild *buildbucket_pb.Build) string {
+	return fmt.Sprintf("https://ci.chromium.org/b/%d", compBuild.GetId())
+}
+
+// addCompilatorBuildLink adds a log linking to the compilator build to the
+// first step of luciBuild, unless it already has one.
+func addCompilatorBuildLink(luciBuild *buildbucket_pb.Build, compBuild *buildbucket_pb.Build) {
+	if len(luciBuild.GetSteps()) == 0 {
+		return
 	}
+	// Compilator logs with the same name were renamed by mirrorStep, so a
+	// log with this name is the link.
+	for _, log := range luciBuild.Steps[0].GetLogs() {
+		if log.GetName() == compilatorBuildLogName {
+			return
+		}
+	}
+	first := proto.Clone(luciBuild.Steps[0]).(*buildbucket_pb.Step)
+	first.Logs = append(first.Logs, &buildbucket_pb.Log{
+		Name:    compilatorBuildLogName,
+		ViewUrl: compilatorBuildURL(compBuild),
+	})
+	luciBuild.Steps[0] = first
 }
 
 func getStepsUntilSwarmingTriggerProps(
@@ -181,9 +290,9 @@ func getStepsUntilSwarmingTriggerProps(
 func updateLastStep(luciBuild *buildbucket_pb.Build, compBuild *buildbucket_pb.Build) {
 	// This function is called when the latest compBuild step name has not
 	// changed but the copied step in luciBuild should still be updated in
-	// case the step's status has changed.
+	// case the step's status or logs have changed.
 	compBuildSteps := compBuild.GetSteps()
-	latestCompStep := compBuildSteps[len(compBuildSteps)-1]
+	latestCompStep := mirrorStep(compBuildSteps[len(compBuildSteps)-1], compBuild)
 
 	luciBuildSteps := luciBuild.GetSteps()
 
@@ -199,6 +308,7 @@ func updateLastStep(luciBuild *buildbucket_pb.Build, compBuild *buildbucket_pb.B
 			}
 		}
 	}
+	addCompilatorBuildLink(luciBuild, compBuild)
 }
 
 func getStepsAfterSwarmingTriggerProps(
@@ -247,6 +357,7 @@ func copySteps(ctx context.Context, luciBuild *buildbucket_pb.Build, parsedArgs
 
 	var latestCompBuildStepName = ""
 
+	interval := newPollingInterval(parsedArgs.compPollingIntervalSec, parsedArgs.maxCompPollingIntervalSec)
 	var timeoutCounts int64 = 0
 	for {
 		compBuild, err := bClient.GetBuild(cctx, parsedArgs.compilatorID)
@@ -257,6 +368,11 @@ func copySteps(ctx context.Context, luciBuild *buildbucket_pb.Build, parsedArgs
 			if grpcutil.Code(err) == codes.DeadlineExceeded {
 				if timeoutCounts < parsedArgs.maxConsecutiveGetBuildTimeouts {
 					timeoutCounts += 1
+					// Give Buildbucket some slack before retrying.
+					interval.backOff()
+					if tr := clock.Sleep(cctx, interval.next(ctx)); tr.Err != nil {
+						return tr.Err
+					}
 					continue
 				}
 			}
@@ -269,10 +385,19 @@ func copySteps(ctx context.Context, luciBuild *buildbucket_pb.Build, parsedArgs
 		case maybeLatestCompStepName != latestCompBuildStepName:
 			latestCompBuildStepName = maybeLatestCompStepName
 			updateFilteredSteps(luciBuild, compBuild, parsedArgs.phase)
+			applyStepLimits(ctx, luciBuild, parsedArgs.limits)
 			send()
+			interval.reset()
 		case maybeLatestCompStepName != "":
 			updateLastStep(luciBuild, compBuild)
+			applyStepLimits(ctx, luciBuild, parsedArgs.limits)
 			send()
+			interval.backOff()
+		default:
+			interval.backOff()
+		}
+		if nearSwarmingTriggerProps(compBuild, parsedArgs.phase) {
+			interval.reset()
 		}
 
 		if parsedArgs.phase == swarmingPhase {
@@ -296,10 +421,11 @@ func copySteps(ctx context.Context, luciBuild *buildbucket_pb.Build, parsedArgs
 			luciBuild.Status = compBuild.GetStatus()
 			luciBuild.SummaryMarkdown = compBuild.GetSummaryMarkdown()
 			luciBuild.EndTime = timestamppb.New(clock.Now(ctx))
+			applyStepLimits(ctx, luciBuild, parsedArgs.limits)
 			send()
 			return nil
 		}
-		if tr := clock.Sleep(cctx, parsedArgs.compPollingIntervalSec); tr.Err != nil {
+		if tr := clock.Sleep(cctx, interval.next(ctx)); tr.Err != nil {
 			return tr.Err
 		}
 	}
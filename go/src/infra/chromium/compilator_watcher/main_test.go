@@ -6,6 +6,7 @@ package main
 
 import (
 	"context"
+	"strings"
 	"testing"
 	"time"
 
@@ -17,6 +18,7 @@ import (
 	"go.chromium.org/luci/luciexe/exe"
 	"google.golang.org/grpc/codes"
 	grpcStatus "google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/structpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 	bb "infra/chromium/compilator_watcher/internal/bb"
@@ -36,6 +38,16 @@ func getSteps(stepPairs []stepNameStatusPair) []*buildbucket_pb.Step {
 	return steps
 }
 
+// withCompilatorBuildLink adds the log linking to the compilator build, which
+// is added to the first copied step, to the expected steps.
+func withCompilatorBuildLink(steps []*buildbucket_pb.Step) []*buildbucket_pb.Step {
+	steps[0].Logs = append(steps[0].Logs, &buildbucket_pb.Log{
+		Name:    compilatorBuildLogName,
+		ViewUrl: "https://ci.chromium.org/b/12345",
+	})
+	return steps
+}
+
 func getBuildsWithSteps(
 	stepPairs []stepNameStatusPair,
 	outputFields map[string]*structpb.Value,
@@ -140,6 +152,62 @@ func TestLuciEXEMain(t *testing.T) {
 				ShouldResemble,
 				"Error while running compilator_watcher: "+expectedErrText)
 		})
+		Convey("fails if max mirrored steps is too low", func() {
+			userArgs := []string{
+				"-compilator-id", "12345", "-get-local-tests", "-max-mirrored-steps", "2"}
+			err := luciEXEMain(ctx, input, userArgs, sender)
+
+			So(err, ShouldErrLike, "max-mirrored-steps must be 0 or at least 3")
+		})
+		Convey("fails if max polling interval is below the polling interval", func() {
+			userArgs := []string{
+				"-compilator-id", "12345", "-get-local-tests",
+				"-compilator-polling-interval-sec", "30", "-max-polling-interval-sec", "20"}
+			err := luciEXEMain(ctx, input, userArgs, sender)
+
+			So(err, ShouldErrLike, "max-polling-interval-sec must be at least compilator-polling-interval-sec")
+		})
+		Convey("limits the steps and size of oversized compilator builds", func() {
+			compBuild := &buildbucket_pb.Build{
+				Status:          buildbucket_pb.Status_FAILURE,
+				Id:              12345,
+				SummaryMarkdown: strings.Repeat("summary ", 16*1024),
+				Steps:           getManySteps(2000),
+				Output: &buildbucket_pb.Build_Output{
+					Properties: &structpb.Struct{},
+				},
+			}
+			compBuild.Steps[0].Name = swarmingTriggerPropsStepName
+			compBuild.Steps[1000].Status = buildbucket_pb.Status_FAILURE
+			for _, step := range compBuild.Steps {
+				step.SummaryMarkdown = strings.Repeat("log line\n", 1024)
+			}
+			ctx = context.WithValue(
+				ctx,
+				bb.FakeBuildsContextKey,
+				[]bb.FakeGetBuildResponse{{Build: compBuild}})
+
+			userArgs := []string{
+				"-compilator-id", "12345", "-get-local-tests",
+				"-max-mirrored-steps", "100", "-max-build-size-bytes", "200000"}
+			err := luciEXEMain(ctx, input, userArgs, sender)
+			So(err, ShouldBeNil)
+			So(input.Status, ShouldEqual, buildbucket_pb.Status_FAILURE)
+
+			// 49 first steps, the failed step, 50 last steps and 2 steps
+			// standing for the omitted ones.
+			So(input.GetSteps(), ShouldHaveLength, 102)
+			So(input.GetSteps()[0].GetName(), ShouldEqual, "step 1")
+			So(input.GetSteps()[49].GetName(), ShouldEqual, "… 950 steps omitted …")
+			So(input.GetSteps()[50].GetName(), ShouldEqual, "step 1000")
+			So(input.GetSteps()[51].GetName(), ShouldEqual, "… 949 steps omitted …")
+			So(input.GetSteps()[101].GetName(), ShouldEqual, "step 1999")
+			So(proto.Size(input), ShouldBeLessThanOrEqualTo, 200000)
+			So(input.SummaryMarkdown, ShouldEndWith, truncatedSuffix)
+
+			// The compilator build is left untouched.
+			So(compBuild.Steps[1999].SummaryMarkdown, ShouldNotEndWith, truncatedSuffix)
+		})
 		Convey("copies compilator build failure status and summary", func() {
 			compBuild := &buildbucket_pb.Build{
 				Status:          buildbucket_pb.Status_FAILURE,
@@ -222,6 +290,250 @@ func TestLuciEXEMain(t *testing.T) {
 			})
 		})
 
+		Convey("mirrors step logs", func() {
+			withLogs := func(step *buildbucket_pb.Step, names ...string) *buildbucket_pb.Step {
+				for _, name := range names {
+					step.Logs = append(step.Logs, &buildbucket_pb.Log{
+						Name:    name,
+						ViewUrl: "https://logs.example.com/" + step.Name + "/" + name,
+					})
+				}
+				return step
+			}
+
+			Convey("prefixing reserved log names with the builder name", func() {
+				compBuilds := []bb.FakeGetBuildResponse{
+					{Build: getBuildsWithSteps([]stepNameStatusPair{
+						{
+							stepName: "lookup GN args",
+							status:   buildbucket_pb.Status_SUCCESS,
+						},
+						{
+							stepName: "compile (with patch)",
+							status:   buildbucket_pb.Status_STARTED,
+						},
+					}, map[string]*structpb.Value{}, buildbucket_pb.Status_STARTED)},
+					{Build: getBuildsWithSteps([]stepNameStatusPair{
+						{
+							stepName: "lookup GN args",
+							status:   buildbucket_pb.Status_SUCCESS,
+						},
+						{
+							stepName: "compile (with patch)",
+							status:   buildbucket_pb.Status_SUCCESS,
+						},
+					}, map[string]*structpb.Value{}, buildbucket_pb.Status_SUCCESS)},
+				}
+				for _, resp := range compBuilds {
+					resp.Build.Builder = &buildbucket_pb.BuilderID{Builder: "linux-rel-compilator"}
+					withLogs(resp.Build.Steps[0], "stdout", "gn_args")
+				}
+				withLogs(compBuilds[0].Build.Steps[1], "stdout")
+				// The ninja log is only available once the compile step ends.
+				withLogs(compBuilds[1].Build.Steps[1], "stdout", "raw_io.output_text[ninja log]")
+				ctx = context.WithValue(
+					ctx,
+					bb.FakeBuildsContextKey,
+					compBuilds)
+
+				err := luciEXEMain(ctx, input, userArgs, sender)
+				So(err, ShouldBeNil)
+
+				lookupGNArgs := &buildbucket_pb.Step{
+					Name:   "lookup GN args",
+					Status: buildbucket_pb.Status_SUCCESS,
+					Logs: []*buildbucket_pb.Log{
+						{Name: "linux-rel-compilator stdout", ViewUrl: "https://logs.example.com/lookup GN args/stdout"},
+						{Name: "gn_args", ViewUrl: "https://logs.example.com/lookup GN args/gn_args"},
+					},
+				}
+				compile := &buildbucket_pb.Step{
+					Name:   "compile (with patch)",
+					Status: buildbucket_pb.Status_SUCCESS,
+					Logs: []*buildbucket_pb.Log{
+						{Name: "linux-rel-compilator stdout", ViewUrl: "https://logs.example.com/compile (with patch)/stdout"},
+						{Name: "raw_io.output_text[ninja log]", ViewUrl: "https://logs.example.com/compile (with patch)/raw_io.output_text[ninja log]"},
+					},
+				}
+				So(input.GetSteps(), ShouldResembleProto, withCompilatorBuildLink([]*buildbucket_pb.Step{lookupGNArgs, compile}))
+
+				// The compilator build is left untouched.
+				So(compBuilds[1].Build.Steps[0].Logs, ShouldHaveLength, 2)
+				So(compBuilds[1].Build.Steps[0].Logs[0].Name, ShouldEqual, "stdout")
+				So(compBuilds[1].Build.Steps[1].Logs[0].Name, ShouldEqual, "stdout")
+			})
+			Convey("prefixing reserved log names without a builder name", func() {
+				compBuild := getBuildsWithSteps([]stepNameStatusPair{
+					{
+						stepName: "compile (with patch)",
+						status:   buildbucket_pb.Status_FAILURE,
+					},
+				}, map[string]*structpb.Value{}, buildbucket_pb.Status_FAILURE)
+				withLogs(compBuild.Steps[0], "stdout", compilatorBuildLogName)
+				ctx = context.WithValue(
+					ctx,
+					bb.FakeBuildsContextKey,
+					[]bb.FakeGetBuildResponse{{Build: compBuild}})
+
+				err := luciEXEMain(ctx, input, userArgs, sender)
+				So(err, ShouldBeNil)
+
+				So(input.GetSteps(), ShouldResembleProto, withCompilatorBuildLink([]*buildbucket_pb.Step{
+					{
+						Name:   "compile (with patch)",
+						Status: buildbucket_pb.Status_FAILURE,
+						Logs: []*buildbucket_pb.Log{
+							{Name: "compilator stdout", ViewUrl: "https://logs.example.com/compile (with patch)/stdout"},
+							{Name: "compilator compilator build", ViewUrl: "https://logs.example.com/compile (with patch)/compilator build"},
+						},
+					},
+				}))
+			})
+		})
+
+		Convey("polls adaptively", func() {
+			var sleeps []time.Duration
+			clk.SetTimerCallback(func(amt time.Duration, timer clock.Timer) {
+				for _, tag := range testclock.GetTags(timer) {
+					if tag == clock.ContextDeadlineTag {
+						return
+					}
+				}
+				sleeps = append(sleeps, amt)
+				clk.Add(amt)
+			})
+			userArgs := []string{
+				"-compilator-id", "12345", "-get-swarming-trigger-props",
+				"-compilator-polling-interval-sec", "10", "-max-polling-interval-sec", "30"}
+			// shouldBeAbout asserts that the sleeps are the given intervals,
+			// up to the jitter.
+			shouldBeAbout := func(actual interface{}, expected ...interface{}) string {
+				actualSleeps := actual.([]time.Duration)
+				if msg := ShouldHaveLength(actualSleeps, len(expected)); msg != "" {
+					return msg
+				}
+				for i, e := range expected {
+					want := e.(time.Duration)
+					if msg := ShouldBeBetweenOrEqual(actualSleeps[i], want*8/10, want*12/10); msg != "" {
+						return msg
+					}
+				}
+				return ""
+			}
+
+			Convey("backing off while the step doesn't change", func() {
+				compBuilds := []bb.FakeGetBuildResponse{
+					{Build: getBuildsWithSteps([]stepNameStatusPair{
+						{
+							stepName: "lookup GN args",
+							status:   buildbucket_pb.Status_STARTED,
+						},
+					}, map[string]*structpb.Value{}, buildbucket_pb.Status_STARTED)},
+					{Build: getBuildsWithSteps([]stepNameStatusPair{
+						{
+							stepName: "lookup GN args",
+							status:   buildbucket_pb.Status_STARTED,
+						},
+					}, map[string]*structpb.Value{}, buildbucket_pb.Status_STARTED)},
+					{Build: getBuildsWithSteps([]stepNameStatusPair{
+						{
+							stepName: "lookup GN args",
+							status:   buildbucket_pb.Status_STARTED,
+						},
+					}, map[string]*structpb.Value{}, buildbucket_pb.Status_STARTED)},
+					{Build: getBuildsWithSteps([]stepNameStatusPair{
+						{
+							stepName: "lookup GN args",
+							status:   buildbucket_pb.Status_SUCCESS,
+						},
+						{
+							stepName: "analyze",
+							status:   buildbucket_pb.Status_STARTED,
+						},
+					}, map[string]*structpb.Value{}, buildbucket_pb.Status_STARTED)},
+					{Build: getBuildsWithSteps([]stepNameStatusPair{
+						{
+							stepName: "lookup GN args",
+							status:   buildbucket_pb.Status_SUCCESS,
+						},
+						{
+							stepName: "analyze",
+							status:   buildbucket_pb.Status_FAILURE,
+						},
+					}, map[string]*structpb.Value{}, buildbucket_pb.Status_FAILURE)},
+				}
+				ctx = context.WithValue(
+					ctx,
+					bb.FakeBuildsContextKey,
+					compBuilds)
+				err := luciEXEMain(ctx, input, userArgs, sender)
+				So(err, ShouldBeNil)
+				// The interval is capped at 30s and reset when the "analyze"
+				// step appears.
+				So(sleeps, shouldBeAbout, 10*time.Second, 20*time.Second, 30*time.Second, 10*time.Second)
+			})
+			Convey("resetting once compiled", func() {
+				compBuilds := []bb.FakeGetBuildResponse{
+					{Build: getBuildsWithSteps([]stepNameStatusPair{
+						{
+							stepName: "compile (with patch)",
+							status:   buildbucket_pb.Status_STARTED,
+						},
+					}, map[string]*structpb.Value{}, buildbucket_pb.Status_STARTED)},
+					{Build: getBuildsWithSteps([]stepNameStatusPair{
+						{
+							stepName: "compile (with patch)",
+							status:   buildbucket_pb.Status_STARTED,
+						},
+					}, map[string]*structpb.Value{}, buildbucket_pb.Status_STARTED)},
+					{Build: getBuildsWithSteps([]stepNameStatusPair{
+						{
+							stepName: "compile (with patch)",
+							status:   buildbucket_pb.Status_SUCCESS,
+						},
+					}, map[string]*structpb.Value{}, buildbucket_pb.Status_STARTED)},
+					{Build: getBuildsWithSteps([]stepNameStatusPair{
+						{
+							stepName: "compile (with patch)",
+							status:   buildbucket_pb.Status_SUCCESS,
+						},
+					}, map[string]*structpb.Value{}, buildbucket_pb.Status_SUCCESS)},
+				}
+				ctx = context.WithValue(
+					ctx,
+					bb.FakeBuildsContextKey,
+					compBuilds)
+				err := luciEXEMain(ctx, input, userArgs, sender)
+				So(err, ShouldBeNil)
+				So(sleeps, shouldBeAbout, 10*time.Second, 20*time.Second, 10*time.Second)
+			})
+			Convey("backing off on GetBuild timeouts", func() {
+				compBuilds := []bb.FakeGetBuildResponse{
+					{Build: getBuildsWithSteps([]stepNameStatusPair{
+						{
+							stepName: "lookup GN args",
+							status:   buildbucket_pb.Status_STARTED,
+						},
+					}, map[string]*structpb.Value{}, buildbucket_pb.Status_STARTED)},
+					{Err: grpcStatus.Error(codes.DeadlineExceeded, "Gateway Timeout")},
+					{Err: grpcStatus.Error(codes.DeadlineExceeded, "Gateway Timeout")},
+					{Build: getBuildsWithSteps([]stepNameStatusPair{
+						{
+							stepName: "lookup GN args",
+							status:   buildbucket_pb.Status_FAILURE,
+						},
+					}, map[string]*structpb.Value{}, buildbucket_pb.Status_FAILURE)},
+				}
+				ctx = context.WithValue(
+					ctx,
+					bb.FakeBuildsContextKey,
+					compBuilds)
+				err := luciEXEMain(ctx, input, userArgs, sender)
+				So(err, ShouldBeNil)
+				So(sleeps, shouldBeAbout, 10*time.Second, 20*time.Second, 30*time.Second)
+			})
+		})
+
 		Convey("updates last step even if step name is the same", func() {
 			compBuilds := []bb.FakeGetBuildResponse{
 				{Build: getBuildsWithSteps([]stepNameStatusPair{
@@ -262,7 +574,7 @@ func TestLuciEXEMain(t *testing.T) {
 					status:   buildbucket_pb.Status_SUCCESS,
 				},
 			})
-			So(input.GetSteps(), ShouldResembleProto, expectedSteps)
+			So(input.GetSteps(), ShouldResembleProto, withCompilatorBuildLink(expectedSteps))
 		})
 
 		Convey("updates last step even if step name is the same but is hidden failing step", func() {
@@ -294,7 +606,7 @@ func TestLuciEXEMain(t *testing.T) {
 						status:   buildbucket_pb.Status_FAILURE,
 					},
 				})
-				So(input.GetSteps(), ShouldResembleProto, expectedSteps)
+				So(input.GetSteps(), ShouldResembleProto, withCompilatorBuildLink(expectedSteps))
 			})
 			Convey("and previous copied steps exist", func() {
 				compBuilds := []bb.FakeGetBuildResponse{
@@ -342,7 +654,7 @@ func TestLuciEXEMain(t *testing.T) {
 						status:   buildbucket_pb.Status_FAILURE,
 					},
 				})
-				So(input.GetSteps(), ShouldResembleProto, expectedSteps)
+				So(input.GetSteps(), ShouldResembleProto, withCompilatorBuildLink(expectedSteps))
 			})
 		})
 
@@ -480,7 +792,7 @@ func TestLuciEXEMain(t *testing.T) {
 						status:   buildbucket_pb.Status_SUCCESS,
 					},
 				})
-				So(input.GetSteps(), ShouldResembleProto, expectedSteps)
+				So(input.GetSteps(), ShouldResembleProto, withCompilatorBuildLink(expectedSteps))
 			})
 			Convey("during localTestPhase", func() {
 				userArgs := []string{"-compilator-id", "12345", "-get-local-tests"}
@@ -509,7 +821,7 @@ func TestLuciEXEMain(t *testing.T) {
 						status:   buildbucket_pb.Status_SUCCESS,
 					},
 				})
-				So(input.GetSteps(), ShouldResembleProto, expectedSteps)
+				So(input.GetSteps(), ShouldResembleProto, withCompilatorBuildLink(expectedSteps))
 			})
 			Convey("and displays failed hidden steps", func() {
 				compBuilds := []bb.FakeGetBuildResponse{
@@ -551,7 +863,7 @@ func TestLuciEXEMain(t *testing.T) {
 						status:   buildbucket_pb.Status_FAILURE,
 					},
 				})
-				So(input.GetSteps(), ShouldResembleProto, expectedSteps)
+				So(input.GetSteps(), ShouldResembleProto, withCompilatorBuildLink(expectedSteps))
 			})
 
 			Convey("sets InfraFailure with summary for timeout", func() {
@@ -620,7 +932,7 @@ func TestLuciEXEMain(t *testing.T) {
 							status:   buildbucket_pb.Status_FAILURE,
 						},
 					})
-					So(input.GetSteps(), ShouldResembleProto, expectedSteps)
+					So(input.GetSteps(), ShouldResembleProto, withCompilatorBuildLink(expectedSteps))
 
 				})
 				Convey("and raising err if the num of consecutive errs exceeds max number", func() {
@@ -675,7 +987,7 @@ func TestLuciEXEMain(t *testing.T) {
 							status:   buildbucket_pb.Status_FAILURE,
 						},
 					})
-					So(input.GetSteps(), ShouldResembleProto, expectedSteps)
+					So(input.GetSteps(), ShouldResembleProto, withCompilatorBuildLink(expectedSteps))
 				})
 			})
 		})
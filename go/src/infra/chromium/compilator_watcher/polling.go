@@ -0,0 +1,68 @@
+// Copyright 2021 The Chromium Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package main
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	buildbucket_pb "go.chromium.org/luci/buildbucket/proto"
+	"go.chromium.org/luci/common/data/rand/mathrand"
+)
+
+// pollingJitter is the maximum fraction by which polling intervals are
+// randomly shortened or lengthened, so that orchestrators started at the same
+// time don't poll Buildbucket in sync.
+const pollingJitter = 0.2
+
+// pollingInterval is the interval between polls of the compilator build. It
+// backs off exponentially from base up to max while the compilator build
+// makes no progress.
+type pollingInterval struct {
+	base    time.Duration
+	max     time.Duration
+	current time.Duration
+}
+
+func newPollingInterval(base, max time.Duration) *pollingInterval {
+	return &pollingInterval{base: base, max: max, current: base}
+}
+
+// reset sets the interval back to the base interval.
+func (p *pollingInterval) reset() {
+	p.current = p.base
+}
+
+// backOff doubles the interval, up to the max interval.
+func (p *pollingInterval) backOff() {
+	p.current *= 2
+	if p.current > p.max {
+		p.current = p.max
+	}
+}
+
+// next returns the interval to wait for before the next poll, which is the
+// current interval with up to ±pollingJitter of random jitter.
+func (p *pollingInterval) next(ctx context.Context) time.Duration {
+	jitter := (2*mathrand.Float64(ctx) - 1) * pollingJitter
+	return time.Duration(float64(p.current) * (1 + jitter))
+}
+
+// nearSwarmingTriggerProps returns whether the compilator build is about to
+// output the swarming trigger properties, which is once it's done compiling.
+// The compilator build is then polled at the base interval, so that the
+// tests are triggered as soon as possible.
+func nearSwarmingTriggerProps(compBuild *buildbucket_pb.Build, phase string) bool {
+	if phase != swarmingPhase {
+		return false
+	}
+	for _, step := range compBuild.GetSteps() {
+		if strings.HasPrefix(step.GetName(), "compile (") && step.GetStatus() == buildbucket_pb.Status_SUCCESS {
+			return true
+		}
+	}
+	return false
+}
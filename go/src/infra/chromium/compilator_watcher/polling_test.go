@@ -0,0 +1,80 @@
+// Copyright 2021 The Chromium Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package main
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+	buildbucket_pb "go.chromium.org/luci/buildbucket/proto"
+)
+
+func TestPollingInterval(t *testing.T) {
+	t.Parallel()
+
+	Convey("pollingInterval", t, func() {
+		ctx := context.Background()
+		p := newPollingInterval(10*time.Second, 60*time.Second)
+
+		Convey("backs off exponentially up to the max interval", func() {
+			So(p.current, ShouldEqual, 10*time.Second)
+			p.backOff()
+			So(p.current, ShouldEqual, 20*time.Second)
+			p.backOff()
+			So(p.current, ShouldEqual, 40*time.Second)
+			p.backOff()
+			So(p.current, ShouldEqual, 60*time.Second)
+			p.backOff()
+			So(p.current, ShouldEqual, 60*time.Second)
+
+			Convey("and resets to the base interval", func() {
+				p.reset()
+				So(p.current, ShouldEqual, 10*time.Second)
+			})
+		})
+
+		Convey("jitters the interval", func() {
+			p.backOff()
+			distinct := map[time.Duration]bool{}
+			for i := 0; i < 100; i++ {
+				next := p.next(ctx)
+				So(next, ShouldBeBetweenOrEqual, 16*time.Second, 24*time.Second)
+				distinct[next] = true
+			}
+			So(len(distinct), ShouldBeGreaterThan, 1)
+		})
+	})
+}
+
+func TestNearSwarmingTriggerProps(t *testing.T) {
+	t.Parallel()
+
+	Convey("nearSwarmingTriggerProps", t, func() {
+		compBuild := getBuildsWithSteps([]stepNameStatusPair{
+			{
+				stepName: "lookup GN args",
+				status:   buildbucket_pb.Status_SUCCESS,
+			},
+			{
+				stepName: "compile (with patch)",
+				status:   buildbucket_pb.Status_STARTED,
+			},
+		}, nil, buildbucket_pb.Status_STARTED)
+
+		Convey("is false while compiling", func() {
+			So(nearSwarmingTriggerProps(compBuild, swarmingPhase), ShouldBeFalse)
+		})
+		Convey("is true once compiled", func() {
+			compBuild.Steps[1].Status = buildbucket_pb.Status_SUCCESS
+			So(nearSwarmingTriggerProps(compBuild, swarmingPhase), ShouldBeTrue)
+		})
+		Convey("is false during localTestPhase", func() {
+			compBuild.Steps[1].Status = buildbucket_pb.Status_SUCCESS
+			So(nearSwarmingTriggerProps(compBuild, localTestPhase), ShouldBeFalse)
+		})
+	})
+}
@@ -0,0 +1,152 @@
+// Copyright 2021 The Chromium OS Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+// +build !windows
+
+package main
+
+import (
+	"bufio"
+	"log"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.chromium.org/luci/common/errors"
+
+	"infra/cmd/drone-agent/internal/agent"
+	"infra/cmd/drone-agent/internal/diskmon"
+)
+
+// loadConfig loads the reloadable agent configuration.
+//
+// The configuration is read from the environment variables below.  If
+// path is not empty, it is a file of KEY=VALUE lines which override
+// the environment variables of the same name.  Empty lines and lines
+// starting with # are ignored.
+//
+// DRONE_AGENT_SWARMING_URL is the URL of the Swarming instance.
+// Should be a full URL without the path, e.g. https://host.example.com
+//
+// DRONE_AGENT_WORKING_DIR is the directory for Swarming bot working
+// dirs.  Defaults to $HOME/skylab_bots.
+//
+// DRONE_AGENT_DUT_CAPACITY is the number of DUTs the drone can host.
+//
+// DRONE_AGENT_REPORTING_INTERVAL_MINS is the interval between
+// reports to the queen.
+func loadConfig(path string) (agent.Config, error) {
+	e := env{}
+	if path != "" {
+		var err error
+		e, err = readEnvFile(path)
+		if err != nil {
+			return agent.Config{}, errors.Annotate(err, "load config").Err()
+		}
+	}
+	workingDir, ok := e.lookup("DRONE_AGENT_WORKING_DIR")
+	if !ok {
+		workingDir = filepath.Join(os.Getenv("HOME"), "skylab_bots")
+	}
+	return agent.Config{
+		SwarmingURL:       e.get("DRONE_AGENT_SWARMING_URL"),
+		WorkingDir:        workingDir,
+		DUTCapacity:       e.getInt("DRONE_AGENT_DUT_CAPACITY", 10),
+		ReportingInterval: time.Duration(e.getInt("DRONE_AGENT_REPORTING_INTERVAL_MINS", 1)) * time.Minute,
+	}, nil
+}
+
+// diskThresholds returns the disk usage thresholds of the agent.
+//
+// The thresholds are read from the environment variables below, which
+// are percentages of free disk space or inodes of the working dir
+// filesystem.  They are not reloaded.  A threshold of 0 disables the
+// action.
+//
+// DRONE_AGENT_DISK_THROTTLE_PERCENT is the threshold below which the
+// agent stops accepting new DUTs.  Defaults to 20.
+//
+// DRONE_AGENT_DISK_CLEANUP_PERCENT is the threshold below which the
+// agent removes the working dirs of exited bots.  Defaults to 10.
+//
+// DRONE_AGENT_DISK_CRITICAL_PERCENT is the threshold below which the
+// agent drains.  Defaults to 5.
+func diskThresholds() diskmon.Thresholds {
+	e := env{}
+	return diskmon.Thresholds{
+		Throttle: e.getInt("DRONE_AGENT_DISK_THROTTLE_PERCENT", 20),
+		Cleanup:  e.getInt("DRONE_AGENT_DISK_CLEANUP_PERCENT", 10),
+		Critical: e.getInt("DRONE_AGENT_DISK_CRITICAL_PERCENT", 5),
+	}
+}
+
+// tokenFailureThreshold returns the number of consecutive access token
+// renewal failures after which the agent stops accepting new DUTs.
+// It is read from DRONE_AGENT_TOKEN_FAILURE_THRESHOLD and defaults to
+// 3.  It is not reloaded.
+func tokenFailureThreshold() int {
+	return env{}.getInt("DRONE_AGENT_TOKEN_FAILURE_THRESHOLD", 3)
+}
+
+// env holds the values read from a config file.  Keys not in the map
+// are looked up in the environment.
+type env map[string]string
+
+// readEnvFile reads a file of KEY=VALUE lines.
+func readEnvFile(path string) (env, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, errors.Annotate(err, "read env file").Err()
+	}
+	defer f.Close()
+	e := env{}
+	sc := bufio.NewScanner(f)
+	for n := 1; sc.Scan(); n++ {
+		line := strings.TrimSpace(sc.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		parts := strings.SplitN(line, "=", 2)
+		if len(parts) != 2 {
+			return nil, errors.Reason("read env file %s: line %d: expected KEY=VALUE", path, n).Err()
+		}
+		e[strings.TrimSpace(parts[0])] = strings.TrimSpace(parts[1])
+	}
+	if err := sc.Err(); err != nil {
+		return nil, errors.Annotate(err, "read env file").Err()
+	}
+	return e, nil
+}
+
+// lookup looks up a value, from the config file if set there, and
+// otherwise from the environment.
+func (e env) lookup(key string) (string, bool) {
+	if v, ok := e[key]; ok {
+		return v, true
+	}
+	return os.LookupEnv(key)
+}
+
+// get gets a string value, or the empty string if it is not set.
+func (e env) get(key string) string {
+	v, _ := e.lookup(key)
+	return v
+}
+
+// getInt gets an int value.  If the value is not valid or is not set,
+// use the default value.
+func (e env) getInt(key string, defaultValue int) int {
+	v, ok := e.lookup(key)
+	if !ok {
+		return defaultValue
+	}
+	n, err := strconv.Atoi(v)
+	if err != nil {
+		log.Printf("Invalid %s, using default value (error: %v)", key, err)
+		return defaultValue
+	}
+	return n
+}
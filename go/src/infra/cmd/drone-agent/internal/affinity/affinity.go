@@ -0,0 +1,129 @@
+// Copyright 2021 The Chromium OS Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+// Package affinity implements a persisted record of the DUTs recently
+// hosted by the drone.  The record survives agent restarts and is
+// reported to the queen as DUT affinity hints, so that DUTs return to
+// the same drone and bot caches on the drone host stay warm.
+package affinity
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"go.chromium.org/luci/common/errors"
+
+	"infra/appengine/drone-queen/api"
+)
+
+// MaxAge is how long a DUT is kept in the history after it was last
+// hosted.  The queen has its own validity window for hints; this only
+// keeps the file from growing without bound.
+const MaxAge = 24 * time.Hour
+
+// History records when DUTs were last hosted by the drone.
+// History is safe to use concurrently.
+type History struct {
+	path string
+
+	// The following fields are covered by the mutex.
+	m    sync.Mutex
+	duts map[string]time.Time
+}
+
+// Load loads the history persisted at the given path.  A missing file
+// is treated as an empty history.
+//
+// Load always returns a usable History, even if an error is returned
+// because the file could not be read.  In that case the history is
+// empty and will overwrite the file when recorded.
+func Load(path string) (*History, error) {
+	h := &History{
+		path: path,
+		duts: make(map[string]time.Time),
+	}
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return h, nil
+		}
+		return h, errors.Annotate(err, "load affinity history").Err()
+	}
+	var data fileData
+	if err := json.Unmarshal(b, &data); err != nil {
+		return h, errors.Annotate(err, "load affinity history").Err()
+	}
+	for d, t := range data.DUTs {
+		h.duts[d] = t
+	}
+	return h, nil
+}
+
+// Record marks the DUTs as hosted at the given time, drops DUTs that
+// were last hosted more than MaxAge ago, and persists the history.
+func (h *History) Record(duts []string, t time.Time) error {
+	h.m.Lock()
+	defer h.m.Unlock()
+	for _, d := range duts {
+		h.duts[d] = t
+	}
+	for d, last := range h.duts {
+		if t.Sub(last) > MaxAge {
+			delete(h.duts, d)
+		}
+	}
+	if err := h.write(); err != nil {
+		return errors.Annotate(err, "record affinity history").Err()
+	}
+	return nil
+}
+
+// Hints returns the history as DUT affinity hints for reporting to
+// the queen, sorted by DUT name.
+func (h *History) Hints() []*api.ReportDroneRequest_DutHint {
+	h.m.Lock()
+	defer h.m.Unlock()
+	hints := make([]*api.ReportDroneRequest_DutHint, 0, len(h.duts))
+	for d, t := range h.duts {
+		ts, err := ptypes.TimestampProto(t)
+		if err != nil {
+			continue
+		}
+		hints = append(hints, &api.ReportDroneRequest_DutHint{
+			Name:           d,
+			LastHostedTime: ts,
+		})
+	}
+	sort.Slice(hints, func(i, j int) bool { return hints[i].GetName() < hints[j].GetName() })
+	return hints
+}
+
+// write atomically writes the history to its file.
+// The caller must hold the mutex.
+func (h *History) write() error {
+	tmp := h.path + ".tmp"
+	f, err := os.Create(tmp)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	e := json.NewEncoder(f)
+	if err := e.Encode(fileData{DUTs: h.duts}); err != nil {
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp, h.path)
+}
+
+// fileData is the JSON format of the history file.
+type fileData struct {
+	DUTs map[string]time.Time `json:"duts"`
+}
@@ -0,0 +1,107 @@
+// Copyright 2021 The Chromium OS Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package affinity
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestHistory(t *testing.T) {
+	t.Parallel()
+	dir, err := ioutil.TempDir("", "test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "affinity.json")
+
+	h, err := Load(path)
+	if err != nil {
+		t.Fatalf("Load with missing file returned error: %s", err)
+	}
+	if got := h.Hints(); len(got) != 0 {
+		t.Errorf("Got hints %v for missing file; want none", got)
+	}
+
+	t1 := time.Date(2000, 1, 2, 3, 4, 5, 0, time.UTC)
+	t2 := t1.Add(time.Hour)
+	t3 := t1.Add(MaxAge + time.Minute)
+	if err := h.Record([]string{"ryza", "claudia"}, t1); err != nil {
+		t.Fatal(err)
+	}
+	if err := h.Record([]string{"ryza", "klaudia"}, t2); err != nil {
+		t.Fatal(err)
+	}
+	t.Run("persisted across loads", func(t *testing.T) {
+		h, err := Load(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		want := map[string]time.Time{
+			"claudia": t1,
+			"klaudia": t2,
+			"ryza":    t2,
+		}
+		if diff := cmp.Diff(want, hintTimes(t, h)); diff != "" {
+			t.Errorf("hints mismatch (-want +got):\n%s", diff)
+		}
+	})
+	if err := h.Record(nil, t3); err != nil {
+		t.Fatal(err)
+	}
+	t.Run("old DUTs are dropped", func(t *testing.T) {
+		want := map[string]time.Time{
+			"klaudia": t2,
+			"ryza":    t2,
+		}
+		if diff := cmp.Diff(want, hintTimes(t, h)); diff != "" {
+			t.Errorf("hints mismatch (-want +got):\n%s", diff)
+		}
+	})
+}
+
+func TestLoad_corrupt_file(t *testing.T) {
+	t.Parallel()
+	dir, err := ioutil.TempDir("", "test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "affinity.json")
+	if err := ioutil.WriteFile(path, []byte("not json"), 0666); err != nil {
+		t.Fatal(err)
+	}
+	h, err := Load(path)
+	if err == nil {
+		t.Errorf("Load with corrupt file returned no error")
+	}
+	if got := h.Hints(); len(got) != 0 {
+		t.Errorf("Got hints %v for corrupt file; want none", got)
+	}
+	if err := h.Record([]string{"ryza"}, time.Now()); err != nil {
+		t.Errorf("Record after corrupt file returned error: %s", err)
+	}
+}
+
+// hintTimes returns the history hints as a map of DUT to time.
+func hintTimes(t *testing.T, h *History) map[string]time.Time {
+	t.Helper()
+	m := make(map[string]time.Time)
+	for _, hint := range h.Hints() {
+		ts, err := ptypes.Timestamp(hint.GetLastHostedTime())
+		if err != nil {
+			t.Fatal(err)
+		}
+		m[hint.GetName()] = ts
+	}
+	return m
+}
@@ -13,6 +13,9 @@ import (
 	"log"
 	"math"
 	"os"
+	"path/filepath"
+	"sort"
+	"strings"
 	"sync"
 	"time"
 
@@ -20,6 +23,7 @@ import (
 	"go.chromium.org/luci/common/errors"
 
 	"infra/appengine/drone-queen/api"
+	"infra/cmd/drone-agent/internal/affinity"
 	"infra/cmd/drone-agent/internal/agent/state"
 	"infra/cmd/drone-agent/internal/bot"
 	"infra/cmd/drone-agent/internal/draining"
@@ -35,9 +39,13 @@ type Agent struct {
 	SwarmingURL string
 	// WorkingDir is used for Swarming bot working dirs.  It is
 	// the caller's responsibility to create this.
-	WorkingDir        string
+	WorkingDir string
+	// ReportingInterval and DUTCapacity may be changed with
+	// Reload while the agent is running.  configMu guards them
+	// once the agent is running.
 	ReportingInterval time.Duration
 	DUTCapacity       int
+	configMu          sync.RWMutex
 	// StartBotFunc is used to start Swarming bots.
 	// This must be set.
 	StartBotFunc func(bot.Config) (bot.Bot, error)
@@ -48,9 +56,70 @@ type Agent struct {
 	// used for instrumenting the state for testing.  If nil, this
 	// is a no-op.
 	wrapStateFunc func(*state.State) stateInterface
+	// clock is used for the reporting interval and for timestamps.
+	// This is used to control reporting cycles in tests.  If nil,
+	// use the system clock.
+	clock clock
 	// hive value of the drone agent.  This is used for DUT/drone affinity.
 	// A drone is assigned DUTs with same hive value.
 	Hive string
+	// History records the DUTs hosted by the drone across agent
+	// restarts.  It is reported to the queen as DUT affinity
+	// hints.  If nil, no hints are recorded or reported.
+	History *affinity.History
+	// DrainDir is checked for per-DUT drain marker files (see
+	// draining.DUTs) when reporting to the queen.  The bots for
+	// marked DUTs are drained without releasing the DUTs, which are
+	// reported to the queen as unavailable, and bots are started for
+	// them again once the marker files are removed.  If empty, DUTs
+	// are not drained individually.
+	DrainDir string
+	// ThrottleFunc, if set, is called each time the agent reports
+	// to the queen.  While it returns true, the agent stops
+	// accepting new DUTs, like when draining, but keeps its current
+	// DUTs.  This is used when the drone is low on disk space.
+	ThrottleFunc func() bool
+
+	// botsMu guards botCodeVersion, bots and botDirs.
+	botsMu sync.Mutex
+	// botCodeVersion is the Swarming bot code version pinned by the
+	// queen for newly started bots.  If empty, bots are started with
+	// the current bot code version of the Swarming server.
+	botCodeVersion string
+	// bots are the configs of the running bots by DUT.  The bots
+	// are checked for their running bot code version, which is
+	// reported to the queen.
+	bots map[string]bot.Config
+	// botDirs are the working dirs of the bots which are starting
+	// or running.  Other bot working dirs are removed by
+	// CleanupBotDirs.
+	botDirs map[string]bool
+
+	// statusMu guards session and lastReport.
+	statusMu sync.Mutex
+	// session is the state of the current drone UUID assignment.
+	// It is nil while the agent is not registered with the queen.
+	session stateInterface
+	// lastReport is when the agent last reported to the queen
+	// successfully, including registering.
+	lastReport time.Time
+}
+
+// Status is a snapshot of the status of the agent.
+type Status struct {
+	// UUID is the drone UUID assigned by the queen.  It is empty
+	// while the agent is not registered with the queen.
+	UUID string
+	Hive string
+	// Bots are the states of the bots by DUT, for the DUTs which
+	// are assigned to the drone or have bots which have not exited
+	// yet.
+	Bots map[string]state.BotState
+	// LastReport is when the agent last reported to the queen
+	// successfully, including registering.  It is zero if the agent
+	// has not reported yet.
+	LastReport        time.Time
+	ReportingInterval time.Duration
 }
 
 // logger defines the logging interface used by Agent.
@@ -58,6 +127,18 @@ type logger interface {
 	Printf(string, ...interface{})
 }
 
+// clock defines the time interface used by Agent.
+type clock interface {
+	Now() time.Time
+	After(time.Duration) <-chan time.Time
+}
+
+// systemClock implements clock using the time package.
+type systemClock struct{}
+
+func (systemClock) Now() time.Time                         { return time.Now() }
+func (systemClock) After(d time.Duration) <-chan time.Time { return time.After(d) }
+
 // stateInterface is the state interface used by the agent.  The usual
 // implementation of the interface is in the state package.
 type stateInterface interface {
@@ -66,12 +147,14 @@ type stateInterface interface {
 	SetExpiration(t time.Time)
 	AddDUT(dutID string)
 	DrainDUT(dutID string)
+	HoldDUT(dutID string)
 	TerminateDUT(dutID string)
 	DrainAll()
 	TerminateAll()
 	Wait()
 	BlockDUTs()
 	ActiveDUTs() []string
+	BotStates() map[string]state.BotState
 }
 
 // Run runs the agent until it is canceled via the context.
@@ -100,7 +183,8 @@ func (a *Agent) Run(ctx context.Context) {
 // function returns an error.
 func (a *Agent) runOnce(ctx context.Context) error {
 	a.log("Registering with queen")
-	res, err := a.Client.ReportDrone(ctx, a.reportRequest(ctx, ""))
+	req := a.reportRequest(ctx, "")
+	res, err := a.Client.ReportDrone(ctx, req)
 	if err != nil {
 		return errors.Annotate(err, "register with queen").Err()
 	}
@@ -114,6 +198,9 @@ func (a *Agent) runOnce(ctx context.Context) error {
 		return errors.Reason("register with queen: got empty UUID").Err()
 	}
 	s := a.wrapState(state.New(uuid, hook{a: a, uuid: uuid}))
+	a.setSession(s)
+	defer a.setSession(nil)
+	a.recordReport()
 
 	// Set up expiration context.
 	t, err := ptypes.Timestamp(res.GetExpirationTime())
@@ -123,9 +210,11 @@ func (a *Agent) runOnce(ctx context.Context) error {
 	ctx = s.WithExpire(ctx, t)
 
 	// Do normal report update.
-	if err := applyUpdateToState(res, s); err != nil {
+	a.setBotCodeVersion(res.GetBotCodeVersion())
+	if err := applyUpdateToState(res, req.GetUnavailableDuts(), s); err != nil {
 		return errors.Annotate(err, "register with queen").Err()
 	}
+	a.recordHostedDUTs(res)
 
 	return a.reportLoop(ctx, s)
 }
@@ -175,7 +264,7 @@ func (a *Agent) reportLoop(ctx context.Context, s stateInterface) error {
 
 	for {
 		select {
-		case <-time.After(a.ReportingInterval):
+		case <-a.getClock().After(a.Config().ReportingInterval):
 		case <-readyToExit:
 			return nil
 		}
@@ -194,7 +283,8 @@ func (a *Agent) reportLoop(ctx context.Context, s stateInterface) error {
 // reportDrone does one cycle of calling the ReportDrone queen RPC and
 // handling the response.
 func (a *Agent) reportDrone(ctx context.Context, s stateInterface) error {
-	res, err := a.Client.ReportDrone(ctx, a.reportRequest(ctx, s.UUID()))
+	req := a.reportRequest(ctx, s.UUID())
+	res, err := a.Client.ReportDrone(ctx, req)
 	if err != nil {
 		return errors.Annotate(err, "report to queen").Err()
 	}
@@ -206,14 +296,19 @@ func (a *Agent) reportDrone(ctx context.Context, s stateInterface) error {
 	default:
 		return errors.Reason("report to queen: got unexpected status %v", rs).Err()
 	}
-	if err := applyUpdateToState(res, s); err != nil {
+	a.recordReport()
+	a.setBotCodeVersion(res.GetBotCodeVersion())
+	if err := applyUpdateToState(res, req.GetUnavailableDuts(), s); err != nil {
 		return errors.Annotate(err, "report to queen").Err()
 	}
+	a.recordHostedDUTs(res)
 	return nil
 }
 
 // applyUpdateToState applies the response from a ReportDrone call to the agent state.
-func applyUpdateToState(res *api.ReportDroneResponse, s stateInterface) error {
+// Assigned DUTs which were reported as unavailable are held rather
+// than added.
+func applyUpdateToState(res *api.ReportDroneResponse, unavailable []string, s stateInterface) error {
 	t, err := ptypes.Timestamp(res.GetExpirationTime())
 	if err != nil {
 		return errors.Annotate(err, "apply update to state").Err()
@@ -224,10 +319,18 @@ func applyUpdateToState(res *api.ReportDroneResponse, s stateInterface) error {
 		s.DrainDUT(d)
 		draining[d] = true
 	}
+	held := make(map[string]bool)
+	for _, d := range unavailable {
+		held[d] = true
+	}
 	assigned := make(map[string]bool)
 	for _, d := range res.GetAssignedDuts() {
 		assigned[d] = true
-		if !draining[d] {
+		switch {
+		case draining[d]:
+		case held[d]:
+			s.HoldDUT(d)
+		default:
 			s.AddDUT(d)
 		}
 	}
@@ -239,6 +342,27 @@ func applyUpdateToState(res *api.ReportDroneResponse, s stateInterface) error {
 	return nil
 }
 
+// recordHostedDUTs records the DUTs assigned to the drone, excluding
+// draining DUTs, in the agent's affinity history.
+func (a *Agent) recordHostedDUTs(res *api.ReportDroneResponse) {
+	if a.History == nil {
+		return
+	}
+	draining := make(map[string]bool)
+	for _, d := range res.GetDrainingDuts() {
+		draining[d] = true
+	}
+	var duts []string
+	for _, d := range res.GetAssignedDuts() {
+		if !draining[d] {
+			duts = append(duts, d)
+		}
+	}
+	if err := a.History.Record(duts, a.getClock().Now()); err != nil {
+		a.log("Error recording hosted DUTs: %s", err)
+	}
+}
+
 // reportRequest returns the api.ReportDroneRequest to use when
 // reporting to the drone queen.
 func (a *Agent) reportRequest(ctx context.Context, uuid string) *api.ReportDroneRequest {
@@ -250,17 +374,204 @@ func (a *Agent) reportRequest(ctx context.Context, uuid string) *api.ReportDrone
 	req := api.ReportDroneRequest{
 		DroneUuid: uuid,
 		LoadIndicators: &api.ReportDroneRequest_LoadIndicators{
-			DutCapacity: intToUint32(a.DUTCapacity),
+			DutCapacity: intToUint32(a.Config().DUTCapacity),
 		},
 		DroneDescription: hostname,
 		Hive:             a.Hive,
 	}
-	if shouldRefuseNewDUTs(ctx) {
+	if a.History != nil {
+		req.PreviouslyHostedDuts = a.History.Hints()
+	}
+	req.BotCodeVersions = a.runningBotCodeVersions()
+	req.UnavailableDuts = a.drainedDUTs()
+	if shouldRefuseNewDUTs(ctx) || a.throttled() {
 		req.LoadIndicators.DutCapacity = 0
 	}
 	return &req
 }
 
+// throttled returns true if the agent should stop accepting new DUTs
+// according to ThrottleFunc.
+func (a *Agent) throttled() bool {
+	return a.ThrottleFunc != nil && a.ThrottleFunc()
+}
+
+// drainedDUTs returns the DUTs marked as drained by marker files in
+// the drain dir.
+func (a *Agent) drainedDUTs() []string {
+	if a.DrainDir == "" {
+		return nil
+	}
+	duts, err := draining.DUTs(a.DrainDir)
+	if err != nil {
+		a.log("Error checking drained DUTs: %s", err)
+		return nil
+	}
+	return duts
+}
+
+// setBotCodeVersion sets the bot code version pinned by the queen.
+// Bots which are already running are not affected.
+func (a *Agent) setBotCodeVersion(v string) {
+	a.botsMu.Lock()
+	defer a.botsMu.Unlock()
+	if v != a.botCodeVersion {
+		a.log("Bot code version changed from %q to %q", a.botCodeVersion, v)
+		a.botCodeVersion = v
+	}
+}
+
+func (a *Agent) getBotCodeVersion() string {
+	a.botsMu.Lock()
+	defer a.botsMu.Unlock()
+	return a.botCodeVersion
+}
+
+// trackBot records a started bot, so that its running bot code
+// version is reported.
+func (a *Agent) trackBot(dutID string, c bot.Config) {
+	a.botsMu.Lock()
+	defer a.botsMu.Unlock()
+	if a.bots == nil {
+		a.bots = make(map[string]bot.Config)
+	}
+	a.bots[dutID] = c
+}
+
+// untrackBot removes a bot recorded by trackBot, unless a newer bot
+// has been recorded for the DUT since.
+func (a *Agent) untrackBot(dutID string, c bot.Config) {
+	a.botsMu.Lock()
+	defer a.botsMu.Unlock()
+	if a.bots[dutID] == c {
+		delete(a.bots, dutID)
+	}
+}
+
+// newBotDir creates a working dir for a new bot for the DUT, which
+// is kept by CleanupBotDirs until it is released with releaseBotDir.
+func (a *Agent) newBotDir(dutID string) (string, error) {
+	a.botsMu.Lock()
+	defer a.botsMu.Unlock()
+	dir, err := ioutil.TempDir(a.WorkingDir, dutID+".")
+	if err != nil {
+		return "", err
+	}
+	if a.botDirs == nil {
+		a.botDirs = make(map[string]bool)
+	}
+	a.botDirs[dir] = true
+	return dir, nil
+}
+
+// releaseBotDir releases a bot working dir created by newBotDir once
+// the bot has exited.
+func (a *Agent) releaseBotDir(dir string) {
+	a.botsMu.Lock()
+	defer a.botsMu.Unlock()
+	delete(a.botDirs, dir)
+}
+
+// CleanupBotDirs removes the working dirs of the bots which have
+// exited, along with their drain and log files, to free disk space.
+// The files of the agent and of running bots are kept.  This is safe
+// to call while the agent is running.
+func (a *Agent) CleanupBotDirs() error {
+	a.botsMu.Lock()
+	fis, err := ioutil.ReadDir(a.WorkingDir)
+	var stale []string
+	for _, fi := range fis {
+		dir := filepath.Join(a.WorkingDir, fi.Name())
+		// Bot working dirs are named after the DUT with a
+		// random suffix.  See newBotDir.
+		if !fi.IsDir() || !strings.Contains(fi.Name(), ".") || a.botDirs[dir] {
+			continue
+		}
+		stale = append(stale, dir)
+	}
+	a.botsMu.Unlock()
+	if err != nil {
+		return errors.Annotate(err, "cleanup bot dirs").Err()
+	}
+
+	// Removing the files may be slow, so do it without holding
+	// the lock.  New bots do not reuse the names of old dirs.
+	var merr errors.MultiError
+	for _, dir := range stale {
+		// The drain and log files of bots are next to their
+		// working dirs.  See bot.Config.
+		for _, p := range []string{dir, dir + ".drain", dir + ".log"} {
+			if err := os.RemoveAll(p); err != nil {
+				merr = append(merr, err)
+			}
+		}
+	}
+	if len(stale) > 0 {
+		a.log("Cleaned up %d bot dirs", len(stale))
+	}
+	if len(merr) > 0 {
+		return errors.Annotate(merr, "cleanup bot dirs").Err()
+	}
+	return nil
+}
+
+// runningBotCodeVersions returns the bot code versions of the running
+// bots, sorted by DUT.  Bots which have not reported their version yet
+// are omitted.
+func (a *Agent) runningBotCodeVersions() []*api.BotCodeVersion {
+	a.botsMu.Lock()
+	configs := make(map[string]bot.Config, len(a.bots))
+	for d, c := range a.bots {
+		configs[d] = c
+	}
+	a.botsMu.Unlock()
+
+	var versions []*api.BotCodeVersion
+	for d, c := range configs {
+		v, err := bot.RunningVersion(c)
+		if err != nil {
+			continue
+		}
+		versions = append(versions, &api.BotCodeVersion{Dut: d, Version: v})
+	}
+	sort.Slice(versions, func(i, j int) bool {
+		return versions[i].GetDut() < versions[j].GetDut()
+	})
+	return versions
+}
+
+// Status returns a snapshot of the status of the agent.  This is safe
+// to call concurrently.
+func (a *Agent) Status() Status {
+	st := Status{
+		Hive:              a.Hive,
+		ReportingInterval: a.Config().ReportingInterval,
+	}
+	a.statusMu.Lock()
+	s := a.session
+	st.LastReport = a.lastReport
+	a.statusMu.Unlock()
+	if s != nil {
+		st.UUID = s.UUID()
+		st.Bots = s.BotStates()
+	}
+	return st
+}
+
+// setSession sets the state of the current drone UUID assignment.
+func (a *Agent) setSession(s stateInterface) {
+	a.statusMu.Lock()
+	defer a.statusMu.Unlock()
+	a.session = s
+}
+
+// recordReport records a successful report to the queen.
+func (a *Agent) recordReport() {
+	a.statusMu.Lock()
+	defer a.statusMu.Unlock()
+	a.lastReport = a.getClock().Now()
+}
+
 // shouldRefuseNewDUTs returns true if we should refuse new DUTs.
 func shouldRefuseNewDUTs(ctx context.Context) bool {
 	return draining.IsDraining(ctx) || ctx.Err() != nil
@@ -274,6 +585,13 @@ func (a *Agent) log(format string, args ...interface{}) {
 	}
 }
 
+func (a *Agent) getClock() clock {
+	if a.clock == nil {
+		return systemClock{}
+	}
+	return a.clock
+}
+
 func (a *Agent) wrapState(s *state.State) stateInterface {
 	if a.wrapStateFunc == nil {
 		return s
@@ -289,16 +607,25 @@ type hook struct {
 
 // StartBot implements state.ControllerHook.
 func (h hook) StartBot(dutID string) (bot.Bot, error) {
-	dir, err := ioutil.TempDir(h.a.WorkingDir, dutID+".")
+	dir, err := h.a.newBotDir(dutID)
 	if err != nil {
 		return nil, errors.Annotate(err, "start bot %v", dutID).Err()
 	}
-	b, err := h.a.StartBotFunc(h.botConfig(dutID, dir))
+	c := h.botConfig(dutID, dir)
+	b, err := h.a.StartBotFunc(c)
 	if err != nil {
 		_ = os.RemoveAll(dir)
+		h.a.releaseBotDir(dir)
 		return nil, errors.Annotate(err, "start bot %v", dutID).Err()
 	}
-	return b, nil
+	h.a.trackBot(dutID, c)
+	return trackedBot{
+		Bot: b,
+		untrack: func() {
+			h.a.untrackBot(dutID, c)
+			h.a.releaseBotDir(dir)
+		},
+	}, nil
 }
 
 // botConfig returns a bot config for starting a Swarming bot.
@@ -306,12 +633,27 @@ func (h hook) botConfig(dutID string, workDir string) bot.Config {
 	const botIDPrefix = "crossk-"
 	botID := botIDPrefix + dutID
 	return bot.Config{
-		SwarmingURL:   h.a.SwarmingURL,
-		BotID:         botID,
-		WorkDirectory: workDir,
+		SwarmingURL:    h.a.SwarmingURL,
+		BotID:          botID,
+		WorkDirectory:  workDir,
+		BotCodeVersion: h.a.getBotCodeVersion(),
 	}
 }
 
+// trackedBot wraps a bot recorded with Agent.trackBot, removing
+// the record when the bot exits.
+type trackedBot struct {
+	bot.Bot
+	untrack func()
+}
+
+// Wait implements bot.Bot.
+func (b trackedBot) Wait() error {
+	err := b.Bot.Wait()
+	b.untrack()
+	return err
+}
+
 // ReleaseDUT implements state.ControllerHook.
 func (h hook) ReleaseDUT(dutID string) {
 	const releaseDUTsTimeout = time.Minute
@@ -8,7 +8,9 @@ import (
 	"context"
 	"io/ioutil"
 	"os"
+	"path/filepath"
 	"sort"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -17,6 +19,8 @@ import (
 	"github.com/google/go-cmp/cmp"
 
 	"infra/appengine/drone-queen/api"
+	"infra/cmd/drone-agent/internal/affinity"
+	"infra/cmd/drone-agent/internal/agent/state"
 	"infra/cmd/drone-agent/internal/bot"
 	"infra/cmd/drone-agent/internal/draining"
 )
@@ -301,6 +305,121 @@ func TestAgent_draining_reports_lame_duck_mode(t *testing.T) {
 	testAgentExits(t, done)
 }
 
+func TestAgent_reports_affinity_hints(t *testing.T) {
+	t.Parallel()
+	a, cleanup := newTestAgent(t)
+	defer cleanup()
+
+	// Set up a history as left by a previous agent run.
+	h, err := affinity.Load(filepath.Join(a.WorkingDir, "affinity.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := h.Record([]string{"claudia"}, time.Now()); err != nil {
+		t.Fatal(err)
+	}
+	a.History = h
+
+	// Set up agent.
+	c := injectSpyClient(a)
+	c.res.AssignedDuts = []string{"ryza"}
+
+	// Start running.
+	ctx := context.Background()
+	ctx, drain := draining.WithDraining(ctx)
+	done := runWithDoneChannel(ctx, a)
+
+	t.Run("registration includes previous DUTs", func(t *testing.T) {
+		select {
+		case req := <-c.reports:
+			got := hintNames(req)
+			want := []string{"claudia"}
+			if diff := cmp.Diff(want, got); diff != "" {
+				t.Errorf("hints mismatch (-want +got):\n%s", diff)
+			}
+		case <-time.After(time.Second):
+			t.Fatalf("agent did not call ReportDrone")
+		}
+	})
+	t.Run("reports include assigned DUTs", func(t *testing.T) {
+		select {
+		case req := <-c.reports:
+			got := hintNames(req)
+			want := []string{"claudia", "ryza"}
+			if diff := cmp.Diff(want, got); diff != "" {
+				t.Errorf("hints mismatch (-want +got):\n%s", diff)
+			}
+		case <-time.After(time.Second):
+			t.Fatalf("agent did not call ReportDrone")
+		}
+	})
+	drain()
+	testAgentExits(t, done)
+}
+
+func TestAgent_pins_bot_code_version(t *testing.T) {
+	t.Parallel()
+	a, cleanup := newTestAgent(t)
+	defer cleanup()
+
+	// Set up agent.
+	c := injectSpyClient(a)
+	c.res.AssignedDuts = []string{"ryza"}
+	c.res.BotCodeVersion = "abc123"
+	b := newPersistentBot()
+	configs := make(chan bot.Config, 1)
+	a.StartBotFunc = func(cfg bot.Config) (bot.Bot, error) {
+		// Write the state file as the bot would.
+		state := []byte(`{"version": "abc123"}`)
+		if err := ioutil.WriteFile(filepath.Join(cfg.WorkDirectory, "state.json"), state, 0666); err != nil {
+			return nil, err
+		}
+		select {
+		case configs <- cfg:
+		default:
+		}
+		return b, nil
+	}
+
+	// Start running.
+	ctx := context.Background()
+	ctx, drain := draining.WithDraining(ctx)
+	done := runWithDoneChannel(ctx, a)
+
+	t.Run("bot started with pinned version", func(t *testing.T) {
+		select {
+		case cfg := <-configs:
+			if got := cfg.BotCodeVersion; got != "abc123" {
+				t.Errorf("Got bot code version %q; want %q", got, "abc123")
+			}
+		case <-time.After(time.Second):
+			t.Fatalf("agent did not start assigned bot")
+		}
+	})
+	t.Run("agent reports running version", func(t *testing.T) {
+		deadline := time.After(time.Second)
+		for {
+			select {
+			case req := <-c.reports:
+				got := botCodeVersions(req)
+				if len(got) == 0 {
+					continue
+				}
+				want := []string{"ryza=abc123"}
+				if diff := cmp.Diff(want, got); diff != "" {
+					t.Errorf("bot code versions mismatch (-want +got):\n%s", diff)
+				}
+				return
+			case <-deadline:
+				t.Fatalf("agent did not report bot code versions")
+			}
+		}
+	})
+	drain()
+	b.Stop()
+	testAgentExits(t, done)
+}
+
 func TestAgent_keep_reporting_while_draining(t *testing.T) {
 	t.Parallel()
 	a, cleanup := newTestAgent(t)
@@ -468,6 +587,375 @@ func TestAgent_block_new_duts_when_draining(t *testing.T) {
 	testAgentExits(t, done)
 }
 
+func TestAgent_drain_dut_with_marker_file(t *testing.T) {
+	t.Parallel()
+	a, cleanup := newTestAgent(t)
+	defer cleanup()
+	a.DrainDir = a.WorkingDir
+
+	// Set up agent.
+	c := injectSpyClient(a)
+	c.res.AssignedDuts = []string{"ryza", "claudia"}
+	f := injectStateSpyFactory(a)
+	started := make(chan string, 8)
+	a.StartBotFunc = func(cfg bot.Config) (bot.Bot, error) {
+		select {
+		case started <- cfg.BotID:
+		default:
+		}
+		return bot.NewFakeBot(), nil
+	}
+
+	// Start running.
+	ctx := context.Background()
+	ctx, drain := draining.WithDraining(ctx)
+	done := runWithDoneChannel(ctx, a)
+
+	s := <-f.states
+	waitForBot := func(t *testing.T, botID string) {
+		t.Helper()
+		deadline := time.After(time.Second)
+		for {
+			select {
+			case got := <-started:
+				if got == botID {
+					return
+				}
+			case <-deadline:
+				t.Fatalf("agent did not start bot %v", botID)
+			}
+		}
+	}
+	waitForBot(t, "crossk-ryza")
+	marker := filepath.Join(a.DrainDir, draining.DUTFilePrefix+"ryza")
+	if err := ioutil.WriteFile(marker, nil, 0666); err != nil {
+		t.Fatal(err)
+	}
+	t.Run("marked DUT is held", func(t *testing.T) {
+		select {
+		case d := <-s.heldDUTs:
+			if d != "ryza" {
+				t.Errorf("Got held DUT %v; want ryza", d)
+			}
+		case <-time.After(time.Second):
+			t.Fatalf("DUT not held")
+		}
+	})
+	t.Run("marked DUT is reported unavailable", func(t *testing.T) {
+		deadline := time.After(time.Second)
+		for {
+			select {
+			case req := <-c.reports:
+				got := req.GetUnavailableDuts()
+				if len(got) == 0 {
+					continue
+				}
+				want := []string{"ryza"}
+				if diff := cmp.Diff(want, got); diff != "" {
+					t.Errorf("unavailable DUTs mismatch (-want +got):\n%s", diff)
+				}
+				return
+			case <-deadline:
+				t.Fatalf("agent did not report unavailable DUTs")
+			}
+		}
+	})
+	if err := os.Remove(marker); err != nil {
+		t.Fatal(err)
+	}
+	t.Run("bot restarted after removing marker", func(t *testing.T) {
+		waitForBot(t, "crossk-ryza")
+	})
+	drain()
+	testAgentExits(t, done)
+}
+
+func TestAgent_throttle_reports_no_capacity(t *testing.T) {
+	t.Parallel()
+	a, cleanup := newTestAgent(t)
+	defer cleanup()
+
+	// Set up agent.
+	c := injectSpyClient(a)
+	var throttled int32
+	a.ThrottleFunc = func() bool { return atomic.LoadInt32(&throttled) != 0 }
+
+	// Start running.
+	ctx := context.Background()
+	ctx, drain := draining.WithDraining(ctx)
+	done := runWithDoneChannel(ctx, a)
+
+	waitForCapacity := func(t *testing.T, want uint32) {
+		t.Helper()
+		deadline := time.After(time.Second)
+		for {
+			select {
+			case req := <-c.reports:
+				if req.GetLoadIndicators().GetDutCapacity() == want {
+					return
+				}
+			case <-deadline:
+				t.Fatalf("agent did not report DUT capacity %d", want)
+			}
+		}
+	}
+	t.Run("agent reports capacity", func(t *testing.T) {
+		waitForCapacity(t, 99999)
+	})
+	atomic.StoreInt32(&throttled, 1)
+	t.Run("agent reports no capacity while throttled", func(t *testing.T) {
+		waitForCapacity(t, 0)
+	})
+	atomic.StoreInt32(&throttled, 0)
+	t.Run("agent reports capacity again", func(t *testing.T) {
+		waitForCapacity(t, 99999)
+	})
+	drain()
+	testAgentExits(t, done)
+}
+
+func TestAgent_status(t *testing.T) {
+	t.Parallel()
+	a, cleanup := newTestAgent(t)
+	defer cleanup()
+	a.Hive = "some-hive"
+
+	// Set up agent.
+	c := injectStubClient(a)
+	c.res.DroneUuid = "ryza-uuid"
+	c.res.AssignedDuts = []string{"ryza"}
+
+	t.Run("not registered before running", func(t *testing.T) {
+		got := a.Status()
+		if got.UUID != "" || !got.LastReport.IsZero() {
+			t.Errorf("Got UUID %q, last report %v; want none", got.UUID, got.LastReport)
+		}
+	})
+
+	// Start running.
+	start := time.Now()
+	ctx := context.Background()
+	ctx, drain := draining.WithDraining(ctx)
+	done := runWithDoneChannel(ctx, a)
+
+	t.Run("status while running", func(t *testing.T) {
+		deadline := time.After(time.Second)
+		for {
+			got := a.Status()
+			if got.Bots["ryza"] == state.BotRunning {
+				want := Status{
+					UUID:              "ryza-uuid",
+					Hive:              "some-hive",
+					Bots:              map[string]state.BotState{"ryza": state.BotRunning},
+					LastReport:        got.LastReport,
+					ReportingInterval: time.Nanosecond,
+				}
+				if diff := cmp.Diff(want, got); diff != "" {
+					t.Errorf("status mismatch (-want +got):\n%s", diff)
+				}
+				if got.LastReport.Before(start) {
+					t.Errorf("Got last report %v; want after %v", got.LastReport, start)
+				}
+				return
+			}
+			select {
+			case <-deadline:
+				t.Fatalf("agent status did not show running bot, got %#v", got)
+			case <-time.After(10 * time.Millisecond):
+			}
+		}
+	})
+	drain()
+	testAgentExits(t, done)
+	t.Run("not registered after exiting", func(t *testing.T) {
+		if got := a.Status(); got.UUID != "" || len(got.Bots) != 0 {
+			t.Errorf("Got UUID %q, bots %v; want none", got.UUID, got.Bots)
+		}
+	})
+}
+
+func TestAgent_cleanup_bot_dirs(t *testing.T) {
+	t.Parallel()
+	a, cleanup := newTestAgent(t)
+	defer cleanup()
+
+	// Set up files of exited bots and of the agent.
+	stale := filepath.Join(a.WorkingDir, "claudia.123")
+	keep := []string{
+		filepath.Join(a.WorkingDir, "drone-agent.drain"),
+		filepath.Join(a.WorkingDir, "drone-agent.affinity.json"),
+		filepath.Join(a.WorkingDir, "cache"),
+	}
+	for _, d := range []string{stale, keep[2]} {
+		if err := os.Mkdir(d, 0777); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for _, f := range []string{stale + ".drain", stale + ".log", filepath.Join(stale, "swarming_bot.zip"), keep[0], keep[1]} {
+		if err := ioutil.WriteFile(f, nil, 0666); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// Set up agent.
+	c := injectSpyClient(a)
+	c.res.AssignedDuts = []string{"ryza"}
+	b := newPersistentBot()
+	dirs := make(chan string, 1)
+	a.StartBotFunc = func(cfg bot.Config) (bot.Bot, error) {
+		select {
+		case dirs <- cfg.WorkDirectory:
+		default:
+		}
+		return b, nil
+	}
+
+	// Start running.
+	ctx := context.Background()
+	ctx, drain := draining.WithDraining(ctx)
+	done := runWithDoneChannel(ctx, a)
+
+	var running string
+	select {
+	case running = <-dirs:
+	case <-time.After(time.Second):
+		t.Fatalf("agent did not start assigned bot")
+	}
+	if err := a.CleanupBotDirs(); err != nil {
+		t.Fatalf("CleanupBotDirs returned error: %s", err)
+	}
+	t.Run("files of exited bots removed", func(t *testing.T) {
+		for _, p := range []string{stale, stale + ".drain", stale + ".log"} {
+			if _, err := os.Stat(p); !os.IsNotExist(err) {
+				t.Errorf("Got %s not removed (stat error %v)", p, err)
+			}
+		}
+	})
+	t.Run("files of running bots and agent kept", func(t *testing.T) {
+		for _, p := range append(keep, running) {
+			if _, err := os.Stat(p); err != nil {
+				t.Errorf("Got %s removed: %s", p, err)
+			}
+		}
+	})
+	drain()
+	b.Stop()
+	testAgentExits(t, done)
+
+	t.Run("dir of exited bot removed", func(t *testing.T) {
+		if err := a.CleanupBotDirs(); err != nil {
+			t.Fatalf("CleanupBotDirs returned error: %s", err)
+		}
+		if _, err := os.Stat(running); !os.IsNotExist(err) {
+			t.Errorf("Got %s not removed (stat error %v)", running, err)
+		}
+	})
+}
+
+func TestAgent_reload_reporting_interval(t *testing.T) {
+	t.Parallel()
+	a, cleanup := newTestAgent(t)
+	defer cleanup()
+
+	// Set up agent.
+	c := injectSpyClient(a)
+
+	// Start running.
+	ctx := context.Background()
+	ctx, drain := draining.WithDraining(ctx)
+	done := runWithDoneChannel(ctx, a)
+
+	select {
+	case <-c.reports:
+	case <-time.After(time.Second):
+		t.Fatalf("agent did not call ReportDrone")
+	}
+	cfg := a.Config()
+	cfg.ReportingInterval = time.Hour
+	if got := a.Reload(cfg); len(got) != 0 {
+		t.Errorf("Reload() = %v; want no fields needing restart", got)
+	}
+	t.Run("agent stops reporting at the old interval", func(t *testing.T) {
+		// Let the cycle in progress during the reload finish.
+		time.Sleep(10 * time.Millisecond)
+	drainChannel:
+		for {
+			select {
+			case <-c.reports:
+			default:
+				break drainChannel
+			}
+		}
+		select {
+		case <-c.reports:
+			t.Errorf("agent called ReportDrone; want no call within the new interval")
+		case <-time.After(100 * time.Millisecond):
+		}
+	})
+	drain()
+	testAgentExits(t, done)
+}
+
+func TestAgent_reload_dut_capacity(t *testing.T) {
+	t.Parallel()
+	a, cleanup := newTestAgent(t)
+	defer cleanup()
+
+	// Set up agent.
+	c := injectSpyClient(a)
+
+	// Start running.
+	ctx := context.Background()
+	ctx, drain := draining.WithDraining(ctx)
+	done := runWithDoneChannel(ctx, a)
+
+	select {
+	case <-c.reports:
+	case <-time.After(time.Second):
+		t.Fatalf("agent did not call ReportDrone")
+	}
+	cfg := a.Config()
+	cfg.DUTCapacity = 3
+	a.Reload(cfg)
+	t.Run("agent reports new DUT capacity", func(t *testing.T) {
+		deadline := time.After(time.Second)
+		for {
+			select {
+			case req := <-c.reports:
+				if req.GetLoadIndicators().GetDutCapacity() == 3 {
+					return
+				}
+			case <-deadline:
+				t.Fatalf("agent did not report new DUT capacity")
+			}
+		}
+	})
+	drain()
+	testAgentExits(t, done)
+}
+
+func TestAgent_reload_requires_restart(t *testing.T) {
+	t.Parallel()
+	a, cleanup := newTestAgent(t)
+	defer cleanup()
+
+	old := a.Config()
+	cfg := old
+	cfg.SwarmingURL = "https://other-swarming.example.com"
+	cfg.WorkingDir = "/other/dir"
+	cfg.ReportingInterval = time.Minute
+	got := a.Reload(cfg)
+	want := []string{"SwarmingURL", "WorkingDir"}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("fields needing restart mismatch (-want +got):\n%s", diff)
+	}
+	want2 := old
+	want2.ReportingInterval = time.Minute
+	if diff := cmp.Diff(want2, a.Config()); diff != "" {
+		t.Errorf("config mismatch (-want +got):\n%s", diff)
+	}
+}
+
 // newTestAgent makes a new agent for tests with common values.  Tests
 // MUST NOT depend on the exact values here.  If something is
 // important to a test, the test should explicitly set the value.
@@ -489,6 +977,25 @@ func newTestAgent(t *testing.T) (a *Agent, cleanup func()) {
 	return a, cleanup
 }
 
+// hintNames returns the DUT names of the affinity hints in the request.
+func hintNames(req *api.ReportDroneRequest) []string {
+	var names []string
+	for _, h := range req.GetPreviouslyHostedDuts() {
+		names = append(names, h.GetName())
+	}
+	return names
+}
+
+// botCodeVersions returns the bot code versions in the request, as
+// DUT=version strings.
+func botCodeVersions(req *api.ReportDroneRequest) []string {
+	var v []string
+	for _, b := range req.GetBotCodeVersions() {
+		v = append(v, b.GetDut()+"="+b.GetVersion())
+	}
+	return v
+}
+
 // runWithDoneChannel runs the agent and returns a channel that is
 // closed when the agent exits.
 func runWithDoneChannel(ctx context.Context, a *Agent) <-chan struct{} {
@@ -0,0 +1,62 @@
+// Copyright 2021 The Chromium OS Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package agent
+
+import (
+	"time"
+)
+
+// Config is the part of the agent configuration that can be reloaded
+// while the agent is running.
+type Config struct {
+	SwarmingURL       string
+	WorkingDir        string
+	ReportingInterval time.Duration
+	DUTCapacity       int
+}
+
+// Config returns a snapshot of the agent configuration.  This is safe
+// to call concurrently with Reload.
+func (a *Agent) Config() Config {
+	a.configMu.RLock()
+	defer a.configMu.RUnlock()
+	return Config{
+		SwarmingURL:       a.SwarmingURL,
+		WorkingDir:        a.WorkingDir,
+		ReportingInterval: a.ReportingInterval,
+		DUTCapacity:       a.DUTCapacity,
+	}
+}
+
+// Reload applies a new configuration to the agent, which may be
+// running.
+//
+// Changes to the reporting interval and DUT capacity are applied
+// immediately, and take effect from the next report to the queen.
+// Changes to the Swarming URL and working dir require restarting the
+// agent, as running bots depend on them.  These are logged and not
+// applied.  Reload returns the names of the changed fields that were
+// not applied.
+func (a *Agent) Reload(c Config) (needRestart []string) {
+	a.configMu.Lock()
+	defer a.configMu.Unlock()
+	if c.ReportingInterval != a.ReportingInterval {
+		a.log("Reload: changing reporting interval from %s to %s", a.ReportingInterval, c.ReportingInterval)
+		a.ReportingInterval = c.ReportingInterval
+	}
+	if c.DUTCapacity != a.DUTCapacity {
+		a.log("Reload: changing DUT capacity from %d to %d", a.DUTCapacity, c.DUTCapacity)
+		a.DUTCapacity = c.DUTCapacity
+	}
+	if c.SwarmingURL != a.SwarmingURL {
+		a.log("Reload: changing Swarming URL from %q to %q requires a restart, not applied", a.SwarmingURL, c.SwarmingURL)
+		needRestart = append(needRestart, "SwarmingURL")
+	}
+	if c.WorkingDir != a.WorkingDir {
+		a.log("Reload: changing working dir from %q to %q requires a restart, not applied", a.WorkingDir, c.WorkingDir)
+		needRestart = append(needRestart, "WorkingDir")
+	}
+	return needRestart
+}
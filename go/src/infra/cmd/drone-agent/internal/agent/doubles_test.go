@@ -58,6 +58,7 @@ func (f stateSpyFactory) wrapState(s *state.State) stateInterface {
 		addedDUTs:      make(chan string, 8),
 		terminatedDUTs: make(chan string, 8),
 		drainedDUTs:    make(chan string, 8),
+		heldDUTs:       make(chan string, 8),
 		terminatedAll:  make(chan struct{}, 1),
 		drainedAll:     make(chan struct{}, 1),
 		blocked:        make(chan struct{}, 1),
@@ -75,6 +76,7 @@ type stateSpy struct {
 	addedDUTs      chan string
 	terminatedDUTs chan string
 	drainedDUTs    chan string
+	heldDUTs       chan string
 	terminatedAll  chan struct{}
 	drainedAll     chan struct{}
 	blocked        chan struct{}
@@ -104,6 +106,14 @@ func (s *stateSpy) DrainDUT(dutID string) {
 	}
 }
 
+func (s *stateSpy) HoldDUT(dutID string) {
+	s.State.HoldDUT(dutID)
+	select {
+	case s.heldDUTs <- dutID:
+	default:
+	}
+}
+
 func (s *stateSpy) TerminateAll() {
 	s.State.TerminateAll()
 	select {
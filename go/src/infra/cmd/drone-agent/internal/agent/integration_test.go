@@ -0,0 +1,396 @@
+// Copyright 2021 The Chromium OS Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package agent
+
+import (
+	"context"
+	"io/ioutil"
+	"path/filepath"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"infra/appengine/drone-queen/api"
+	"infra/cmd/drone-agent/internal/bot"
+	"infra/cmd/drone-agent/internal/draining"
+	"infra/cmd/drone-agent/internal/fakequeen"
+)
+
+// These tests run the agent against a fake queen served over gRPC.
+// Reporting cycles are driven by the test using a fake clock.
+
+// integrationTimeout is how long the tests wait for the agent to act.
+const integrationTimeout = 5 * time.Second
+
+func TestIntegration_initial_assignment(t *testing.T) {
+	t.Parallel()
+	e := newIntegrationEnv(t)
+	e.queen.Script(fakequeen.Response{AssignedDUTs: []string{"ryza", "claudia", "lila"}})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := runWithDoneChannel(ctx, e.agent)
+
+	t.Run("started bots for assigned DUTs", func(t *testing.T) {
+		got := e.bots.waitStarted(t, 3)
+		want := []string{"crossk-claudia", "crossk-lila", "crossk-ryza"}
+		if diff := cmp.Diff(want, got); diff != "" {
+			t.Errorf("started bots mismatch (-want +got):\n%s", diff)
+		}
+	})
+	t.Run("registered with queen", func(t *testing.T) {
+		reqs := e.queen.Reports()
+		if len(reqs) == 0 {
+			t.Fatalf("agent did not report to queen")
+		}
+		if got := reqs[0].GetDroneUuid(); got != "" {
+			t.Errorf("registration UUID = %q; want empty", got)
+		}
+		if got := reqs[0].GetLoadIndicators().GetDutCapacity(); got != 99999 {
+			t.Errorf("registration DUT capacity = %d; want 99999", got)
+		}
+	})
+	t.Run("reports with assigned UUID", func(t *testing.T) {
+		e.tick(t)
+		reqs := e.waitReports(t, 2)
+		if got := reqs[1].GetDroneUuid(); got != fakequeen.DefaultUUID {
+			t.Errorf("report UUID = %q; want %q", got, fakequeen.DefaultUUID)
+		}
+	})
+	cancel()
+	testAgentExits(t, done)
+}
+
+func TestIntegration_assignment_revoked_mid_task(t *testing.T) {
+	t.Parallel()
+	e := newIntegrationEnv(t)
+	e.queen.Script(
+		fakequeen.Response{AssignedDUTs: []string{"ryza", "claudia"}},
+		fakequeen.Response{AssignedDUTs: []string{"ryza"}},
+	)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := runWithDoneChannel(ctx, e.agent)
+
+	e.bots.waitStarted(t, 2)
+	e.tick(t)
+	t.Run("revoked DUT is released", func(t *testing.T) {
+		if !e.waitReleased(t, "claudia") {
+			t.Errorf("agent did not release DUT claudia")
+		}
+	})
+	t.Run("revoked DUT bot is terminated", func(t *testing.T) {
+		if !e.bots.get("crossk-claudia").terminated() {
+			t.Errorf("bot for claudia was not terminated")
+		}
+	})
+	t.Run("assigned DUT bot keeps running", func(t *testing.T) {
+		e.tick(t)
+		e.waitReports(t, 3)
+		if b := e.bots.get("crossk-ryza"); b.terminated() || b.drained() {
+			t.Errorf("bot for ryza was stopped")
+		}
+		if got := e.queen.Released(); len(got) != 1 {
+			t.Errorf("released DUTs = %v; want only claudia", got)
+		}
+	})
+	cancel()
+	testAgentExits(t, done)
+}
+
+func TestIntegration_queen_unavailable(t *testing.T) {
+	t.Parallel()
+	e := newIntegrationEnv(t)
+	assigned := fakequeen.Response{AssignedDUTs: []string{"ryza", "claudia"}}
+	unavailable := fakequeen.Response{Unavailable: true}
+	e.queen.Script(assigned, unavailable, unavailable, unavailable, assigned)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := runWithDoneChannel(ctx, e.agent)
+
+	e.bots.waitStarted(t, 2)
+	for i := 0; i < 4; i++ {
+		e.tick(t)
+		e.waitReports(t, i+2)
+	}
+	t.Run("bots keep running", func(t *testing.T) {
+		for _, id := range []string{"crossk-ryza", "crossk-claudia"} {
+			if b := e.bots.get(id); b.terminated() || b.drained() {
+				t.Errorf("bot %v was stopped", id)
+			}
+		}
+		if got := e.bots.startedCount(); got != 2 {
+			t.Errorf("started %d bots; want 2", got)
+		}
+		if got := e.queen.Released(); len(got) != 0 {
+			t.Errorf("released DUTs = %v; want none", got)
+		}
+	})
+	t.Run("agent keeps its UUID", func(t *testing.T) {
+		for i, r := range e.queen.Reports()[1:] {
+			if got := r.GetDroneUuid(); got != fakequeen.DefaultUUID {
+				t.Errorf("report %d UUID = %q; want %q", i+1, got, fakequeen.DefaultUUID)
+			}
+		}
+	})
+	cancel()
+	testAgentExits(t, done)
+}
+
+func TestIntegration_drain_file(t *testing.T) {
+	t.Parallel()
+	e := newIntegrationEnv(t)
+	e.bots.persistent = true
+	e.queen.Script(fakequeen.Response{AssignedDUTs: []string{"ryza", "claudia"}})
+
+	path := filepath.Join(e.agent.WorkingDir, "drone-agent.drain")
+	ctx := draining.WithFile(context.Background(), path, time.Millisecond)
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	done := runWithDoneChannel(ctx, e.agent)
+
+	e.bots.waitStarted(t, 2)
+	if err := ioutil.WriteFile(path, nil, 0666); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case <-draining.C(ctx):
+	case <-time.After(integrationTimeout):
+		t.Fatalf("agent context was not drained after creating drain file")
+	}
+	t.Run("bots are drained", func(t *testing.T) {
+		for _, id := range []string{"crossk-ryza", "crossk-claudia"} {
+			if !e.bots.get(id).waitDrained() {
+				t.Errorf("bot %v was not drained", id)
+			}
+		}
+	})
+	t.Run("reports lame duck mode", func(t *testing.T) {
+		e.tick(t)
+		reqs := e.waitReports(t, 2)
+		if got := reqs[len(reqs)-1].GetLoadIndicators().GetDutCapacity(); got != 0 {
+			t.Errorf("DUT capacity = %d; want 0", got)
+		}
+	})
+	t.Run("no new bots are started", func(t *testing.T) {
+		if got := e.bots.startedCount(); got != 2 {
+			t.Errorf("started %d bots; want 2", got)
+		}
+	})
+	e.bots.stopAll()
+	testAgentExits(t, done)
+	t.Run("drained DUTs are released", func(t *testing.T) {
+		got := e.queen.Released()
+		sort.Strings(got)
+		want := []string{"claudia", "ryza"}
+		if diff := cmp.Diff(want, got); diff != "" {
+			t.Errorf("released DUTs mismatch (-want +got):\n%s", diff)
+		}
+	})
+}
+
+// integrationEnv is an agent connected to a fake queen.
+type integrationEnv struct {
+	agent *Agent
+	queen *fakequeen.Server
+	bots  *fakeBotStarter
+	ticks chan time.Time
+}
+
+// newIntegrationEnv returns an integrationEnv.  Resources are cleaned
+// up when the test finishes.
+func newIntegrationEnv(t *testing.T) *integrationEnv {
+	t.Helper()
+	a, cleanup := newTestAgent(t)
+	t.Cleanup(cleanup)
+
+	q := fakequeen.NewServer()
+	c, stop, err := q.Serve()
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(stop)
+
+	e := &integrationEnv{
+		agent: a,
+		queen: q,
+		bots:  newFakeBotStarter(),
+		ticks: make(chan time.Time),
+	}
+	a.Client = c
+	a.StartBotFunc = e.bots.start
+	a.clock = tickClock{e.ticks}
+	return e
+}
+
+// tick runs one reporting cycle of the agent.
+func (e *integrationEnv) tick(t *testing.T) {
+	t.Helper()
+	select {
+	case e.ticks <- time.Now():
+	case <-time.After(integrationTimeout):
+		t.Fatalf("agent is not waiting to report")
+	}
+}
+
+// waitReports waits until the queen received at least n reports.
+func (e *integrationEnv) waitReports(t *testing.T, n int) []*api.ReportDroneRequest {
+	t.Helper()
+	ctx, cancel := context.WithTimeout(context.Background(), integrationTimeout)
+	defer cancel()
+	reqs := e.queen.WaitReports(ctx, n)
+	if reqs == nil {
+		t.Fatalf("queen did not receive %d reports", n)
+	}
+	return reqs
+}
+
+// waitReleased waits until the queen received a release for the DUT.
+func (e *integrationEnv) waitReleased(t *testing.T, dutID string) bool {
+	t.Helper()
+	ctx, cancel := context.WithTimeout(context.Background(), integrationTimeout)
+	defer cancel()
+	return e.queen.WaitReleased(ctx, dutID)
+}
+
+// tickClock implements clock, with reporting intervals elapsing when
+// the test sends to the channel.
+type tickClock struct {
+	ticks chan time.Time
+}
+
+func (tickClock) Now() time.Time                         { return time.Now() }
+func (c tickClock) After(time.Duration) <-chan time.Time { return c.ticks }
+
+// fakeBotStarter starts fake bots and records them by bot ID.
+type fakeBotStarter struct {
+	m sync.Mutex
+	// persistent bots do not exit when drained.
+	persistent bool
+	bots       map[string]*recordingBot
+	starts     int
+	started    chan string
+}
+
+func newFakeBotStarter() *fakeBotStarter {
+	return &fakeBotStarter{
+		bots: make(map[string]*recordingBot),
+		// This channel needs a big enough buffer to capture
+		// events needed by tests.  Events that overfill the
+		// channel buffer are discarded.
+		started: make(chan string, 8),
+	}
+}
+
+// start implements Agent.StartBotFunc.
+func (s *fakeBotStarter) start(c bot.Config) (bot.Bot, error) {
+	s.m.Lock()
+	defer s.m.Unlock()
+	b := newRecordingBot(s.persistent)
+	s.bots[c.BotID] = b
+	s.starts++
+	select {
+	case s.started <- c.BotID:
+	default:
+	}
+	return b, nil
+}
+
+// waitStarted waits until n bots are started and returns their
+// sorted IDs.
+func (s *fakeBotStarter) waitStarted(t *testing.T, n int) []string {
+	t.Helper()
+	ids := make([]string, n)
+	for i := range ids {
+		select {
+		case ids[i] = <-s.started:
+		case <-time.After(integrationTimeout):
+			t.Fatalf("agent started %d bots; want %d", i, n)
+		}
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+func (s *fakeBotStarter) get(botID string) *recordingBot {
+	s.m.Lock()
+	defer s.m.Unlock()
+	return s.bots[botID]
+}
+
+func (s *fakeBotStarter) startedCount() int {
+	s.m.Lock()
+	defer s.m.Unlock()
+	return s.starts
+}
+
+func (s *fakeBotStarter) stopAll() {
+	s.m.Lock()
+	defer s.m.Unlock()
+	for _, b := range s.bots {
+		b.Stop()
+	}
+}
+
+// recordingBot is a FakeBot that records whether it was drained or
+// terminated.
+type recordingBot struct {
+	*bot.FakeBot
+	drainC     chan struct{}
+	terminateC chan struct{}
+	drainOnce  sync.Once
+	termOnce   sync.Once
+}
+
+func newRecordingBot(persistent bool) *recordingBot {
+	b := &recordingBot{
+		FakeBot:    bot.NewFakeBot(),
+		drainC:     make(chan struct{}),
+		terminateC: make(chan struct{}),
+	}
+	b.DrainFunc = func(fb *bot.FakeBot) error {
+		b.drainOnce.Do(func() { close(b.drainC) })
+		if !persistent {
+			fb.Stop()
+		}
+		return nil
+	}
+	b.TerminateFunc = func(fb *bot.FakeBot) error {
+		b.termOnce.Do(func() { close(b.terminateC) })
+		fb.Stop()
+		return nil
+	}
+	return b
+}
+
+func (b *recordingBot) drained() bool {
+	select {
+	case <-b.drainC:
+		return true
+	default:
+		return false
+	}
+}
+
+func (b *recordingBot) terminated() bool {
+	select {
+	case <-b.terminateC:
+		return true
+	default:
+		return false
+	}
+}
+
+// waitDrained waits until the bot is drained.
+func (b *recordingBot) waitDrained() bool {
+	select {
+	case <-b.drainC:
+		return true
+	case <-time.After(integrationTimeout):
+		return false
+	}
+}
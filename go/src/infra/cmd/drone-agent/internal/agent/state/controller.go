@@ -5,6 +5,7 @@
 package state
 
 import (
+	"fmt"
 	"log"
 	"sync"
 
@@ -22,6 +23,33 @@ type ControllerHook interface {
 	ReleaseDUT(dutID string)
 }
 
+// BotState is the state of the bot for a DUT.
+type BotState int
+
+// Bot states.
+const (
+	// BotStarting means the bot is being started.
+	BotStarting BotState = iota
+	// BotRunning means the bot is running.
+	BotRunning
+	// BotTerminating means the bot has been drained or terminated
+	// but has not exited yet.
+	BotTerminating
+)
+
+func (s BotState) String() string {
+	switch s {
+	case BotStarting:
+		return "starting"
+	case BotRunning:
+		return "running"
+	case BotTerminating:
+		return "terminating"
+	default:
+		return fmt.Sprintf("BotState(%d)", int(s))
+	}
+}
+
 // Controller provides running bots for DUTs.  Callers tell Controller
 // what DUTs to add, drain, or terminate, and Controller makes sure
 // there are bots running or not running for those DUTs.
@@ -33,13 +61,16 @@ type Controller struct {
 	m       sync.Mutex
 	blocked bool
 	duts    map[string]dutSignals
+	// botStates are the states of the bots for the DUTs in duts.
+	botStates map[string]BotState
 }
 
 // NewController creates a new Controller.
 func NewController(h ControllerHook) *Controller {
 	c := &Controller{
-		hook: h,
-		duts: make(map[string]dutSignals),
+		hook:      h,
+		duts:      make(map[string]dutSignals),
+		botStates: make(map[string]BotState),
 	}
 	return c
 }
@@ -61,34 +92,57 @@ func (c *Controller) AddDUT(dutID string) {
 	log.Printf("Starting new bot for DUT %v", dutID)
 	s := newDUTSignals()
 	c.duts[dutID] = s
+	c.botStates[dutID] = BotStarting
 	c.wg.Add(1)
 	go func() {
 		defer c.wg.Done()
-		runBotForDUT(c.hook, dutID, s)
+		runBotForDUT(c.hook, dutID, s, func(b BotState) { c.setBotState(dutID, b) })
 		c.m.Lock()
 		delete(c.duts, dutID)
+		delete(c.botStates, dutID)
 		c.m.Unlock()
 	}()
 }
 
+// setBotState sets the state of the bot for the DUT.
+func (c *Controller) setBotState(dutID string, b BotState) {
+	c.m.Lock()
+	defer c.m.Unlock()
+	if _, ok := c.duts[dutID]; ok {
+		c.botStates[dutID] = b
+	}
+}
+
 // runBotForDUT keeps a Swarming bot running for the DUT.
-// Signals to drain or terminate should be sent using dutSignals.
+// Signals to drain, terminate or hold should be sent using dutSignals.
+// The state of the bot is passed to setState as it changes.
 // This function otherwise runs forever.
-func runBotForDUT(h ControllerHook, dutID string, s dutSignals) {
-	defer h.ReleaseDUT(dutID)
+func runBotForDUT(h ControllerHook, dutID string, s dutSignals, setState func(BotState)) {
+	// Holding keeps the DUT instead of releasing it, unless the
+	// DUT is also drained or terminated.
+	var held bool
+	defer func() {
+		if !held || s.stopRequested() {
+			h.ReleaseDUT(dutID)
+		}
+	}()
 	for {
-		select {
-		case <-s.drain:
+		if s.stopRequested() {
 			return
-		case <-s.terminate:
+		}
+		select {
+		case <-s.hold:
+			held = true
 			return
 		default:
 		}
+		setState(BotStarting)
 		b, err := h.StartBot(dutID)
 		if err != nil {
 			log.Printf("Fail to start bot %s %s", dutID, err)
 			continue
 		}
+		setState(BotRunning)
 		wait := make(chan struct{})
 		go func() {
 			_ = b.Wait()
@@ -102,10 +156,22 @@ func runBotForDUT(h ControllerHook, dutID string, s dutSignals) {
 				// TODO(ayatane): Log error?
 				_ = b.Drain()
 				stop = true
+				held = false
+				setState(BotTerminating)
 			case <-s.terminate:
 				// TODO(ayatane): Log error?
 				_ = b.Terminate()
 				stop = true
+				held = false
+				setState(BotTerminating)
+			case <-s.hold:
+				if !stop {
+					// TODO(ayatane): Log error?
+					_ = b.Drain()
+					stop = true
+					held = true
+					setState(BotTerminating)
+				}
 			case <-wait:
 				break listenForSignals
 			}
@@ -152,6 +218,22 @@ func (c *Controller) TerminateDUT(dutID string) {
 	}
 }
 
+// HoldDUT removes a DUT to no longer have bots running for it and
+// drains its current bot, like DrainDUT, but keeps the DUT instead of
+// releasing it.  This is used for DUTs drained on the drone host; call
+// AddDUT once the bot has exited to run a bot for the DUT again.
+// If the controller does not have the DUT, do nothing.
+// This method is concurrency safe.
+func (c *Controller) HoldDUT(dutID string) {
+	c.m.Lock()
+	s, ok := c.duts[dutID]
+	c.m.Unlock()
+	if ok {
+		log.Printf("Holding DUT %v", dutID)
+		s.sendHold()
+	}
+}
+
 // DrainAll drains all DUTs.
 // You almost certainly want to call Block first to make sure DUTs
 // don't get added right after calling this.
@@ -195,6 +277,19 @@ func (c *Controller) ActiveDUTs() []string {
 	return ds
 }
 
+// BotStates returns the states of the bots for the DUTs the
+// controller is keeping alive, like ActiveDUTs.
+// This method is safe to call concurrently.
+func (c *Controller) BotStates() map[string]BotState {
+	c.m.Lock()
+	defer c.m.Unlock()
+	states := make(map[string]BotState, len(c.botStates))
+	for d, b := range c.botStates {
+		states[d] = b
+	}
+	return states
+}
+
 // Wait for all Swarming bots to finish.  It is the caller's
 // responsibility to make sure all bots are terminated or drained,
 // else this call will hang.
@@ -205,12 +300,14 @@ func (c *Controller) Wait() {
 type dutSignals struct {
 	drain     chan struct{}
 	terminate chan struct{}
+	hold      chan struct{}
 }
 
 func newDUTSignals() dutSignals {
 	return dutSignals{
 		drain:     make(chan struct{}, 1),
 		terminate: make(chan struct{}, 1),
+		hold:      make(chan struct{}, 1),
 	}
 }
 
@@ -227,3 +324,23 @@ func (s dutSignals) sendTerminate() {
 	default:
 	}
 }
+
+func (s dutSignals) sendHold() {
+	select {
+	case s.hold <- struct{}{}:
+	default:
+	}
+}
+
+// stopRequested returns true if a drain or terminate signal is
+// pending, consuming it.
+func (s dutSignals) stopRequested() bool {
+	select {
+	case <-s.drain:
+		return true
+	case <-s.terminate:
+		return true
+	default:
+		return false
+	}
+}
@@ -214,6 +214,95 @@ func TestController(t *testing.T) {
 			t.Errorf("Did not release DUT")
 		}
 	})
+	t.Run("hold DUT drains bot without releasing", func(t *testing.T) {
+		t.Parallel()
+		started := make(chan string, 2)
+		released := make(chan string, 1)
+		h := stubHook{
+			start: func(dutID string) (bot.Bot, error) {
+				started <- dutID
+				return bot.NewFakeBot(), nil
+			},
+			release: func(dutID string) { released <- dutID },
+		}
+		c := NewController(h)
+
+		const d = "some-dut"
+		c.AddDUT(d)
+		<-started
+		c.HoldDUT(d)
+		c.Wait()
+		select {
+		case got := <-released:
+			t.Errorf("Got released DUT %v; want none", got)
+		default:
+		}
+		if got := c.ActiveDUTs(); len(got) != 0 {
+			t.Errorf("ActiveDUTs() = %v; want empty", got)
+		}
+		t.Run("add held DUT again", func(t *testing.T) {
+			c.AddDUT(d)
+			select {
+			case <-started:
+			case <-time.After(time.Second):
+				t.Fatalf("bot not started after adding held DUT")
+			}
+			c.DrainDUT(d)
+			c.Wait()
+		})
+	})
+	t.Run("hold does not cancel drain", func(t *testing.T) {
+		t.Parallel()
+		b := bot.NewFakeBot()
+		b.DrainFunc = func(*bot.FakeBot) error { return nil }
+		started := make(chan struct{}, 1)
+		released := make(chan string, 1)
+		h := stubHook{
+			start: func(dutID string) (bot.Bot, error) {
+				started <- struct{}{}
+				return b, nil
+			},
+			release: func(dutID string) { released <- dutID },
+		}
+		c := NewController(h)
+
+		const d = "some-dut"
+		c.AddDUT(d)
+		<-started
+		c.DrainDUT(d)
+		c.HoldDUT(d)
+		b.Stop()
+		c.Wait()
+		select {
+		case <-released:
+		default:
+			t.Errorf("DUT not released after draining")
+		}
+	})
+	t.Run("bot states", func(t *testing.T) {
+		t.Parallel()
+		b := bot.NewFakeBot()
+		b.DrainFunc = func(*bot.FakeBot) error { return nil }
+		unblock := make(chan struct{})
+		h := stubHook{
+			start: func(dutID string) (bot.Bot, error) {
+				<-unblock
+				return b, nil
+			},
+		}
+		c := NewController(h)
+
+		const d = "some-dut"
+		c.AddDUT(d)
+		assertBotStates(t, c, map[string]BotState{d: BotStarting})
+		close(unblock)
+		assertBotStates(t, c, map[string]BotState{d: BotRunning})
+		c.DrainDUT(d)
+		assertBotStates(t, c, map[string]BotState{d: BotTerminating})
+		b.Stop()
+		c.Wait()
+		assertBotStates(t, c, map[string]BotState{})
+	})
 	t.Run("stopped DUTs are removed", func(t *testing.T) {
 		t.Parallel()
 		c := NewController(stubHook{})
@@ -268,6 +357,24 @@ func TestController(t *testing.T) {
 	})
 }
 
+// assertBotStates asserts that the bot states of the controller
+// become the wanted states, as bots change state asynchronously.
+func assertBotStates(t *testing.T, c *Controller, want map[string]BotState) {
+	t.Helper()
+	deadline := time.Now().Add(time.Second)
+	for {
+		got := c.BotStates()
+		diff := cmp.Diff(want, got)
+		if diff == "" {
+			return
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("BotStates() mismatch (-want +got):\n%s", diff)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
 func assertDontHang(t *testing.T, f func(), msg string) {
 	t.Helper()
 	done := make(chan struct{})
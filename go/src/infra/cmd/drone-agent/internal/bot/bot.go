@@ -6,9 +6,12 @@
 package bot
 
 import (
+	"encoding/json"
 	"fmt"
 	"io"
+	"io/ioutil"
 	"net/http"
+	"net/url"
 	"os"
 	"os/exec"
 	"path/filepath"
@@ -134,6 +137,10 @@ type Config struct {
 	// The parent directory should be writable to allow creation
 	// of the drain file.
 	WorkDirectory string
+	// BotCodeVersion is the Swarming bot code version to start the
+	// bot with.  If empty, the current bot code version of the
+	// Swarming server is used.
+	BotCodeVersion string
 }
 
 func (c Config) drainFilePath() string {
@@ -148,7 +155,15 @@ func (c Config) botZipPath() string {
 	return filepath.Join(c.WorkDirectory, "swarming_bot.zip")
 }
 
+func (c Config) stateFilePath() string {
+	return filepath.Join(c.WorkDirectory, "state.json")
+}
+
 func (c Config) botCodeURL() string {
+	if c.BotCodeVersion != "" {
+		return fmt.Sprintf("%s/swarming/api/v1/bot/bot_code/%s?bot_id=%s",
+			c.SwarmingURL, url.PathEscape(c.BotCodeVersion), url.QueryEscape(c.BotID))
+	}
 	return fmt.Sprintf("%s/bot_code?bot_id=%s", c.SwarmingURL, c.BotID)
 }
 
@@ -157,3 +172,24 @@ func (c Config) env() []string {
 		"SWARMING_BOT_ID=" + c.BotID,
 	}
 }
+
+// RunningVersion returns the bot code version that the bot started
+// with the config is running.  The version is read from the state
+// file which the bot writes to its work directory.  An error is
+// returned if the bot has not written its state yet.
+func RunningVersion(c Config) (string, error) {
+	b, err := ioutil.ReadFile(c.stateFilePath())
+	if err != nil {
+		return "", errors.Annotate(err, "running version of bot %s", c.BotID).Err()
+	}
+	var state struct {
+		Version string `json:"version"`
+	}
+	if err := json.Unmarshal(b, &state); err != nil {
+		return "", errors.Annotate(err, "running version of bot %s", c.BotID).Err()
+	}
+	if state.Version == "" {
+		return "", errors.Reason("running version of bot %s: no version in state", c.BotID).Err()
+	}
+	return state.Version, nil
+}
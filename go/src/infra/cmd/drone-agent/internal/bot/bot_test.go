@@ -0,0 +1,84 @@
+// Copyright 2021 The Chromium OS Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package bot
+
+import (
+	"io/ioutil"
+	"testing"
+)
+
+func TestConfig_botCodeURL(t *testing.T) {
+	t.Parallel()
+	cases := []struct {
+		name    string
+		version string
+		want    string
+	}{
+		{"current", "", "https://swarming.example.com/bot_code?bot_id=crossk-dut1"},
+		{"pinned", "abc123", "https://swarming.example.com/swarming/api/v1/bot/bot_code/abc123?bot_id=crossk-dut1"},
+	}
+	for _, c := range cases {
+		c := c
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+			cfg := Config{
+				SwarmingURL:    "https://swarming.example.com",
+				BotID:          "crossk-dut1",
+				BotCodeVersion: c.version,
+			}
+			if got := cfg.botCodeURL(); got != c.want {
+				t.Errorf("botCodeURL() = %q; want %q", got, c.want)
+			}
+		})
+	}
+}
+
+func TestRunningVersion(t *testing.T) {
+	t.Parallel()
+	setup := func(t *testing.T, state string) Config {
+		c := Config{
+			BotID:         "crossk-dut1",
+			WorkDirectory: t.TempDir(),
+		}
+		if state != "" {
+			if err := ioutil.WriteFile(c.stateFilePath(), []byte(state), 0666); err != nil {
+				t.Fatal(err)
+			}
+		}
+		return c
+	}
+	t.Run("version in state", func(t *testing.T) {
+		t.Parallel()
+		c := setup(t, `{"version": "abc123", "started_ts": 1234}`)
+		got, err := RunningVersion(c)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != "abc123" {
+			t.Errorf("Got version %q; want %q", got, "abc123")
+		}
+	})
+	t.Run("no state file", func(t *testing.T) {
+		t.Parallel()
+		c := setup(t, "")
+		if _, err := RunningVersion(c); err == nil {
+			t.Errorf("Got no error; want error for missing state file")
+		}
+	})
+	t.Run("no version", func(t *testing.T) {
+		t.Parallel()
+		c := setup(t, `{}`)
+		if _, err := RunningVersion(c); err == nil {
+			t.Errorf("Got no error; want error for missing version")
+		}
+	})
+	t.Run("bad state", func(t *testing.T) {
+		t.Parallel()
+		c := setup(t, `{"version":`)
+		if _, err := RunningVersion(c); err == nil {
+			t.Errorf("Got no error; want error for bad state")
+		}
+	})
+}
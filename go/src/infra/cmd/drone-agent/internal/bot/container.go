@@ -0,0 +1,198 @@
+// Copyright 2021 The Chromium OS Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package bot
+
+import (
+	"io"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+
+	"go.chromium.org/luci/common/errors"
+)
+
+// ContainerConfig is the configuration for running Swarming bots in
+// containers.
+type ContainerConfig struct {
+	// Runtime is the container runtime CLI, e.g. docker or podman.
+	Runtime string
+	// Image is the container image to run bots in.  The image
+	// must provide python3.8.
+	Image string
+	// TokenFile is the OAuth token file for bots.  It is
+	// mounted read-only at the same path in each container.
+	TokenFile string
+	// Devices are the host devices made available to each
+	// container, e.g. /dev/net/tun.
+	Devices []string
+}
+
+// Commander runs commands.  It is used to drive the container runtime
+// CLI and is replaced in tests.
+type Commander interface {
+	// Output runs a command to completion and returns its combined
+	// output.
+	Output(name string, args ...string) ([]byte, error)
+	// Start starts a command writing its stdout and stderr to out.
+	Start(out io.Writer, name string, args ...string) (Process, error)
+}
+
+// Process is a command started by a Commander.
+type Process interface {
+	// Wait waits for the command to exit.
+	Wait() error
+}
+
+// ExecCommander implements Commander with os/exec.
+type ExecCommander struct{}
+
+// Output implements Commander.
+func (ExecCommander) Output(name string, args ...string) ([]byte, error) {
+	return exec.Command(name, args...).CombinedOutput()
+}
+
+// Start implements Commander.
+func (ExecCommander) Start(out io.Writer, name string, args ...string) (Process, error) {
+	cmd := exec.Command(name, args...)
+	cmd.Stdout = out
+	cmd.Stderr = out
+	if err := cmd.Start(); err != nil {
+		return nil, err
+	}
+	return cmd, nil
+}
+
+// ContainerStarter has a Start method for starting Swarming bots, each
+// in its own container.  A container can only access its bot's work
+// directory and the bot token file, so that a task cannot read the
+// files of the other bots on the drone.
+type ContainerStarter struct {
+	Starter
+	config ContainerConfig
+	cmd    Commander
+}
+
+// NewContainerStarter returns a new ContainerStarter.  An error is
+// returned if the container runtime is not available.
+func NewContainerStarter(c *http.Client, cc ContainerConfig, cmd Commander) (ContainerStarter, error) {
+	if cc.Runtime == "" {
+		return ContainerStarter{}, errors.Reason("new container starter: no container runtime").Err()
+	}
+	if cc.Image == "" {
+		return ContainerStarter{}, errors.Reason("new container starter: no container image").Err()
+	}
+	if out, err := cmd.Output(cc.Runtime, "version"); err != nil {
+		return ContainerStarter{}, errors.Annotate(err, "new container starter: %s unavailable: %s", cc.Runtime, out).Err()
+	}
+	return ContainerStarter{
+		Starter: NewStarter(c),
+		config:  cc,
+		cmd:     cmd,
+	}, nil
+}
+
+// Start starts a Swarming bot in a new container.  The returned Bot
+// object can be used to interact with the bot.  The output of the
+// container is written to the same log file as bots started by
+// Starter.
+func (s ContainerStarter) Start(c Config) (b Bot, err error) {
+	if err := s.downloadBotCode(c); err != nil {
+		return nil, errors.Annotate(err, "start bot with %+v", c).Err()
+	}
+	f, err := os.Create(c.logFilePath())
+	if err != nil {
+		return nil, errors.Annotate(err, "start bot with %+v", c).Err()
+	}
+	defer func() {
+		if err != nil {
+			_ = f.Close()
+		}
+	}()
+	// Remove any container left behind by a previous agent with
+	// the same name.  This fails if there is none.
+	_, _ = s.cmd.Output(s.config.Runtime, "rm", "--force", c.containerName())
+	p, err := s.cmd.Start(f, s.config.Runtime, s.runArgs(c)...)
+	if err != nil {
+		return nil, errors.Annotate(err, "start bot with %+v", c).Err()
+	}
+	return containerBot{
+		config:  c,
+		runtime: s.config.Runtime,
+		cmd:     s.cmd,
+		run:     p,
+		logFile: f,
+	}, nil
+}
+
+// runArgs returns the container runtime arguments to run a Swarming
+// bot.  The bot work directory is mounted at the same path in the
+// container, so that the bot finds its files where it would outside
+// of a container.
+func (s ContainerStarter) runArgs(c Config) []string {
+	args := []string{
+		"run",
+		"--rm",
+		// Forward signals to the bot, and reap its children.
+		"--init",
+		"--name", c.containerName(),
+		// Bots need to reach DUTs and lab services.
+		"--network", "host",
+		"--volume", c.WorkDirectory + ":" + c.WorkDirectory,
+		"--workdir", c.WorkDirectory,
+	}
+	if s.config.TokenFile != "" {
+		args = append(args, "--volume", s.config.TokenFile+":"+s.config.TokenFile+":ro")
+	}
+	for _, d := range s.config.Devices {
+		args = append(args, "--device", d)
+	}
+	for _, e := range c.env() {
+		args = append(args, "--env", e)
+	}
+	return append(args, s.config.Image, "python3.8", c.botZipPath(), "start_bot")
+}
+
+type containerBot struct {
+	config  Config
+	runtime string
+	cmd     Commander
+	// run is the container runtime process running the container.
+	// It exits when the container exits.
+	run     Process
+	logFile *os.File
+}
+
+// Wait implements Bot.
+func (b containerBot) Wait() error {
+	err := b.run.Wait()
+	_ = b.logFile.Close()
+	return err
+}
+
+// Drain implements Bot.
+//
+// The drain file is created inside the container, as the directory
+// containing it is not mounted in the container.
+func (b containerBot) Drain() error {
+	if out, err := b.cmd.Output(b.runtime, "exec", b.config.containerName(), "touch", b.config.drainFilePath()); err != nil {
+		return errors.Annotate(err, "drain bot %s: %s", b.config.BotID, out).Err()
+	}
+	return nil
+}
+
+// Terminate implements Bot.
+func (b containerBot) Terminate() error {
+	if out, err := b.cmd.Output(b.runtime, "kill", "--signal", "SIGTERM", b.config.containerName()); err != nil {
+		return errors.Annotate(err, "terminate bot %s: %s", b.config.BotID, out).Err()
+	}
+	return nil
+}
+
+// containerName returns the name of the container running the bot.
+// Work directories are unique, so the name is unique too.
+func (c Config) containerName() string {
+	return "swarming-bot-" + filepath.Base(c.WorkDirectory)
+}
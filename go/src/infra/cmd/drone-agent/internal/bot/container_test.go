@@ -0,0 +1,239 @@
+// Copyright 2021 The Chromium OS Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package bot
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+// fakeCommander records the commands run, and fails the commands
+// listed in fail.
+type fakeCommander struct {
+	commands [][]string
+	fail     map[string]bool
+	// output is written by started commands.
+	output string
+}
+
+func (c *fakeCommander) record(name string, args []string) error {
+	cmd := append([]string{name}, args...)
+	c.commands = append(c.commands, cmd)
+	if c.fail[args[0]] {
+		return fmt.Errorf("%s failed", args[0])
+	}
+	return nil
+}
+
+// Output implements Commander.
+func (c *fakeCommander) Output(name string, args ...string) ([]byte, error) {
+	if err := c.record(name, args); err != nil {
+		return []byte("some output"), err
+	}
+	return nil, nil
+}
+
+// Start implements Commander.
+func (c *fakeCommander) Start(out io.Writer, name string, args ...string) (Process, error) {
+	if err := c.record(name, args); err != nil {
+		return nil, err
+	}
+	if _, err := io.WriteString(out, c.output); err != nil {
+		return nil, err
+	}
+	return fakeProcess{}, nil
+}
+
+type fakeProcess struct{}
+
+// Wait implements Process.
+func (fakeProcess) Wait() error {
+	return nil
+}
+
+func TestNewContainerStarter(t *testing.T) {
+	t.Parallel()
+	cc := ContainerConfig{Runtime: "docker", Image: "bot-image"}
+	t.Run("available", func(t *testing.T) {
+		t.Parallel()
+		cmd := &fakeCommander{}
+		if _, err := NewContainerStarter(http.DefaultClient, cc, cmd); err != nil {
+			t.Fatal(err)
+		}
+		want := [][]string{{"docker", "version"}}
+		if diff := cmp.Diff(want, cmd.commands); diff != "" {
+			t.Errorf("commands mismatch (-want +got):\n%s", diff)
+		}
+	})
+	t.Run("unavailable", func(t *testing.T) {
+		t.Parallel()
+		cmd := &fakeCommander{fail: map[string]bool{"version": true}}
+		_, err := NewContainerStarter(http.DefaultClient, cc, cmd)
+		if err == nil || !strings.Contains(err.Error(), "docker unavailable: some output") {
+			t.Errorf("Got error %v; want docker unavailable", err)
+		}
+	})
+	t.Run("no image", func(t *testing.T) {
+		t.Parallel()
+		cmd := &fakeCommander{}
+		cc := cc
+		cc.Image = ""
+		if _, err := NewContainerStarter(http.DefaultClient, cc, cmd); err == nil {
+			t.Errorf("Got no error; want error for missing image")
+		}
+		if len(cmd.commands) > 0 {
+			t.Errorf("Got commands %v; want none", cmd.commands)
+		}
+	})
+}
+
+func TestContainerStarter(t *testing.T) {
+	t.Parallel()
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if v := strings.TrimPrefix(r.URL.Path, "/swarming/api/v1/bot/bot_code/"); v != r.URL.Path {
+			fmt.Fprintf(w, "bot code %s", v)
+			return
+		}
+		fmt.Fprint(w, "bot code")
+	}))
+	defer ts.Close()
+
+	setup := func(t *testing.T, cmd *fakeCommander) (ContainerStarter, Config) {
+		s, err := NewContainerStarter(ts.Client(), ContainerConfig{
+			Runtime:   "podman",
+			Image:     "bot-image",
+			TokenFile: "/var/lib/swarming/token.json",
+			Devices:   []string{"/dev/net/tun", "/dev/kvm"},
+		}, cmd)
+		if err != nil {
+			t.Fatal(err)
+		}
+		cmd.commands = nil
+		c := Config{
+			SwarmingURL:   ts.URL,
+			BotID:         "crossk-dut1",
+			WorkDirectory: filepath.Join(t.TempDir(), "dut1.123"),
+		}
+		if err := os.Mkdir(c.WorkDirectory, 0777); err != nil {
+			t.Fatal(err)
+		}
+		return s, c
+	}
+
+	t.Run("lifecycle", func(t *testing.T) {
+		cmd := &fakeCommander{output: "bot output"}
+		s, c := setup(t, cmd)
+		b, err := s.Start(c)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := b.Drain(); err != nil {
+			t.Fatal(err)
+		}
+		if err := b.Terminate(); err != nil {
+			t.Fatal(err)
+		}
+		if err := b.Wait(); err != nil {
+			t.Fatal(err)
+		}
+
+		wd := c.WorkDirectory
+		want := [][]string{
+			{"podman", "rm", "--force", "swarming-bot-dut1.123"},
+			{
+				"podman", "run", "--rm", "--init",
+				"--name", "swarming-bot-dut1.123",
+				"--network", "host",
+				"--volume", wd + ":" + wd,
+				"--workdir", wd,
+				"--volume", "/var/lib/swarming/token.json:/var/lib/swarming/token.json:ro",
+				"--device", "/dev/net/tun",
+				"--device", "/dev/kvm",
+				"--env", "SWARMING_BOT_ID=crossk-dut1",
+				"bot-image", "python3.8", filepath.Join(wd, "swarming_bot.zip"), "start_bot",
+			},
+			{"podman", "exec", "swarming-bot-dut1.123", "touch", wd + ".drain"},
+			{"podman", "kill", "--signal", "SIGTERM", "swarming-bot-dut1.123"},
+		}
+		if diff := cmp.Diff(want, cmd.commands); diff != "" {
+			t.Errorf("commands mismatch (-want +got):\n%s", diff)
+		}
+		got, err := ioutil.ReadFile(c.logFilePath())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(got) != "bot output" {
+			t.Errorf("Got log %q; want %q", got, "bot output")
+		}
+		got, err = ioutil.ReadFile(c.botZipPath())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(got) != "bot code" {
+			t.Errorf("Got bot code %q; want %q", got, "bot code")
+		}
+	})
+	t.Run("pinned bot code version", func(t *testing.T) {
+		cmd := &fakeCommander{}
+		s, c := setup(t, cmd)
+		c.BotCodeVersion = "abc123"
+		b, err := s.Start(c)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := b.Wait(); err != nil {
+			t.Fatal(err)
+		}
+		got, err := ioutil.ReadFile(c.botZipPath())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := "bot code abc123"; string(got) != want {
+			t.Errorf("Got bot code %q; want %q", got, want)
+		}
+	})
+	t.Run("stale container is ignored", func(t *testing.T) {
+		cmd := &fakeCommander{fail: map[string]bool{"rm": true}}
+		s, c := setup(t, cmd)
+		b, err := s.Start(c)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := b.Wait(); err != nil {
+			t.Fatal(err)
+		}
+	})
+	t.Run("run fails", func(t *testing.T) {
+		cmd := &fakeCommander{fail: map[string]bool{"run": true}}
+		s, c := setup(t, cmd)
+		if _, err := s.Start(c); err == nil {
+			t.Errorf("Got no error; want error from run")
+		}
+	})
+	t.Run("drain and terminate fail", func(t *testing.T) {
+		cmd := &fakeCommander{fail: map[string]bool{"exec": true, "kill": true}}
+		s, c := setup(t, cmd)
+		b, err := s.Start(c)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer b.Wait()
+		if err := b.Drain(); err == nil {
+			t.Errorf("Got no error; want error from drain")
+		}
+		if err := b.Terminate(); err == nil {
+			t.Errorf("Got no error; want error from terminate")
+		}
+	})
+}
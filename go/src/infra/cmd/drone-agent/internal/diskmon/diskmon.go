@@ -0,0 +1,230 @@
+// Copyright 2021 The Chromium OS Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+// Package diskmon implements monitoring of the free disk space and
+// inodes of the drone's working directory filesystem.  As the
+// filesystem fills up, the monitor escalates through levels which the
+// agent acts on: stop accepting new DUTs, clean up the files of
+// exited bots, and drain.
+package diskmon
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+)
+
+// Usage is the usage of a filesystem.
+type Usage struct {
+	// FreeBytes is the space available to unprivileged users.
+	FreeBytes  uint64
+	TotalBytes uint64
+	// FreeInodes and TotalInodes are zero for filesystems which do
+	// not report inodes.
+	FreeInodes  uint64
+	TotalInodes uint64
+}
+
+// FreePercent returns the lower of the percentages of free space and
+// free inodes.
+func (u Usage) FreePercent() float64 {
+	p := percent(u.FreeBytes, u.TotalBytes)
+	if u.TotalInodes > 0 {
+		if pi := percent(u.FreeInodes, u.TotalInodes); pi < p {
+			p = pi
+		}
+	}
+	return p
+}
+
+func (u Usage) String() string {
+	return fmt.Sprintf("%d/%d bytes free (%.1f%%), %d/%d inodes free (%.1f%%)",
+		u.FreeBytes, u.TotalBytes, percent(u.FreeBytes, u.TotalBytes),
+		u.FreeInodes, u.TotalInodes, percent(u.FreeInodes, u.TotalInodes))
+}
+
+func percent(free, total uint64) float64 {
+	if total == 0 {
+		return 100
+	}
+	return float64(free) * 100 / float64(total)
+}
+
+// Level is how full a filesystem is.  Higher levels are more severe.
+type Level int
+
+// Levels in order of severity.  Each level includes the actions of the
+// levels below it.
+const (
+	// OK means there is enough free space.
+	OK Level = iota
+	// Throttle means the drone should stop accepting new DUTs.
+	Throttle
+	// Cleanup means the files of exited bots should be removed.
+	Cleanup
+	// Critical means the agent should drain.
+	Critical
+)
+
+func (l Level) String() string {
+	switch l {
+	case OK:
+		return "OK"
+	case Throttle:
+		return "Throttle"
+	case Cleanup:
+		return "Cleanup"
+	case Critical:
+		return "Critical"
+	default:
+		return fmt.Sprintf("Level(%d)", int(l))
+	}
+}
+
+// Thresholds are the percentages of free space or inodes below which
+// each level is reached.  A zero threshold is never reached.
+type Thresholds struct {
+	Throttle int
+	Cleanup  int
+	Critical int
+}
+
+// Level returns the level of the usage.
+func (t Thresholds) Level(u Usage) Level {
+	p := u.FreePercent()
+	switch {
+	case p < float64(t.Critical):
+		return Critical
+	case p < float64(t.Cleanup):
+		return Cleanup
+	case p < float64(t.Throttle):
+		return Throttle
+	default:
+		return OK
+	}
+}
+
+// Status is the last sample taken by a Monitor.
+type Status struct {
+	Usage Usage
+	Level Level
+	// Time is when the sample was taken.  It is zero if no sample
+	// has been taken.
+	Time time.Time
+}
+
+// Monitor samples the usage of a filesystem and acts on the level.
+// The exported fields must not be changed after the monitor is
+// started.
+type Monitor struct {
+	// Path is a path on the monitored filesystem.
+	Path       string
+	Thresholds Thresholds
+	// Stat returns the usage of the filesystem with the path.  If
+	// nil, Statfs is used.
+	Stat func(path string) (Usage, error)
+	// CleanupFunc, if set, is called to free space each time the
+	// level is Cleanup or above.  The filesystem is sampled again
+	// after cleaning up.
+	CleanupFunc func()
+	// DrainFunc, if set, is called each time the level is Critical.
+	DrainFunc func()
+
+	// logger is used for logging.  If nil, use the log package.
+	logger logger
+
+	// mu guards status.
+	mu     sync.Mutex
+	status Status
+}
+
+// logger defines the logging interface used by Monitor.
+type logger interface {
+	Printf(string, ...interface{})
+}
+
+// Run samples the filesystem immediately and then at the interval
+// returned by the function, until the context is done.
+func (m *Monitor) Run(ctx context.Context, interval func() time.Duration) {
+	for {
+		m.Check()
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(interval()):
+		}
+	}
+}
+
+// Check samples the filesystem once and acts on the level.  If the
+// filesystem cannot be sampled, the previous level is kept.
+func (m *Monitor) Check() Level {
+	u, err := m.stat()
+	if err != nil {
+		m.log("Error checking disk usage of %s: %s", m.Path, err)
+		return m.Status().Level
+	}
+	l := m.Thresholds.Level(u)
+	if l >= Throttle {
+		// Stop accepting new DUTs before taking the other
+		// actions.
+		m.setStatus(u, l)
+	}
+	if l >= Cleanup && m.CleanupFunc != nil {
+		m.CleanupFunc()
+		if u2, err := m.stat(); err != nil {
+			m.log("Error checking disk usage of %s after cleanup: %s", m.Path, err)
+		} else {
+			m.log("Disk cleanup freed %d bytes, %d inodes", int64(u2.FreeBytes-u.FreeBytes), int64(u2.FreeInodes-u.FreeInodes))
+			u = u2
+			l = m.Thresholds.Level(u)
+		}
+	}
+	m.setStatus(u, l)
+	if l >= Critical && m.DrainFunc != nil {
+		m.log("Draining, disk usage is critical")
+		m.DrainFunc()
+	}
+	return l
+}
+
+// Status returns the last sample.  This is safe to call concurrently.
+func (m *Monitor) Status() Status {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.status
+}
+
+// Throttled returns true if the drone should not accept new DUTs.  This
+// is safe to call concurrently.
+func (m *Monitor) Throttled() bool {
+	return m.Status().Level >= Throttle
+}
+
+func (m *Monitor) setStatus(u Usage, l Level) {
+	m.mu.Lock()
+	prev := m.status
+	m.status = Status{Usage: u, Level: l, Time: time.Now()}
+	m.mu.Unlock()
+	if l != prev.Level {
+		m.log("Disk usage level of %s changed from %s to %s: %s", m.Path, prev.Level, l, u)
+	}
+}
+
+func (m *Monitor) stat() (Usage, error) {
+	if m.Stat == nil {
+		return Statfs(m.Path)
+	}
+	return m.Stat(m.Path)
+}
+
+func (m *Monitor) log(format string, args ...interface{}) {
+	if v := m.logger; v != nil {
+		v.Printf(format, args...)
+	} else {
+		log.Printf(format, args...)
+	}
+}
@@ -0,0 +1,239 @@
+// Copyright 2021 The Chromium OS Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package diskmon
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+var testThresholds = Thresholds{Throttle: 20, Cleanup: 10, Critical: 5}
+
+// fakeFS implements Monitor.Stat with a scripted sequence of usages.
+// The last usage is repeated once the sequence is exhausted.
+type fakeFS struct {
+	usages []Usage
+	err    error
+}
+
+func (f *fakeFS) stat(string) (Usage, error) {
+	if f.err != nil {
+		return Usage{}, f.err
+	}
+	u := f.usages[0]
+	if len(f.usages) > 1 {
+		f.usages = f.usages[1:]
+	}
+	return u, nil
+}
+
+// usage returns a usage with the given percentages of free space and
+// inodes.
+func usage(freeBytes, freeInodes uint64) Usage {
+	return Usage{
+		FreeBytes:   freeBytes,
+		TotalBytes:  100,
+		FreeInodes:  freeInodes,
+		TotalInodes: 100,
+	}
+}
+
+// discardLogger discards logs.
+type discardLogger struct{}
+
+func (discardLogger) Printf(string, ...interface{}) {}
+
+func TestMonitor_escalation(t *testing.T) {
+	t.Parallel()
+	fs := &fakeFS{}
+	var events []string
+	m := &Monitor{
+		Path:       "/work",
+		Thresholds: testThresholds,
+		Stat:       fs.stat,
+		logger:     discardLogger{},
+	}
+	throttled := false
+	m.CleanupFunc = func() {
+		if m.Throttled() && !throttled {
+			// Throttling takes effect before cleaning up.
+			events = append(events, "throttle")
+			throttled = true
+		}
+		// Nothing is cleaned up, so the next sample is the same.
+		events = append(events, "cleanup")
+	}
+	m.DrainFunc = func() { events = append(events, "drain") }
+
+	// Fill up the disk, one sample per reporting interval.
+	for i, u := range []Usage{
+		usage(50, 80),
+		usage(30, 25),
+		usage(15, 40), // Throttle
+		usage(12, 12), // Still throttled
+		usage(9, 30),  // Cleanup
+		usage(8, 7),   // Cleanup
+		usage(20, 4),  // Critical, by inodes
+	} {
+		fs.usages = []Usage{u}
+		l := m.Check()
+		if m.Throttled() && !throttled {
+			events = append(events, "throttle")
+			throttled = true
+		}
+		events = append(events, fmt.Sprintf("%d:%s", i, l))
+	}
+
+	want := []string{
+		"0:OK",
+		"1:OK",
+		"throttle",
+		"2:Throttle",
+		"3:Throttle",
+		"cleanup",
+		"4:Cleanup",
+		"cleanup",
+		"5:Cleanup",
+		"cleanup",
+		"drain",
+		"6:Critical",
+	}
+	if diff := cmp.Diff(want, events); diff != "" {
+		t.Errorf("events mismatch (-want +got):\n%s", diff)
+	}
+	if got, want := m.Status().Usage, usage(20, 4); got != want {
+		t.Errorf("Status().Usage = %v; want %v", got, want)
+	}
+}
+
+func TestMonitor_sudden_fill(t *testing.T) {
+	t.Parallel()
+	fs := &fakeFS{}
+	var events []string
+	m := &Monitor{
+		Path:       "/work",
+		Thresholds: testThresholds,
+		Stat:       fs.stat,
+		logger:     discardLogger{},
+	}
+	m.CleanupFunc = func() {
+		events = append(events, fmt.Sprintf("cleanup, throttled %t", m.Throttled()))
+	}
+	m.DrainFunc = func() {
+		events = append(events, fmt.Sprintf("drain, throttled %t", m.Throttled()))
+	}
+	fs.usages = []Usage{usage(50, 50)}
+	m.Check()
+	fs.usages = []Usage{usage(1, 50)}
+	m.Check()
+
+	want := []string{
+		"cleanup, throttled true",
+		"drain, throttled true",
+	}
+	if diff := cmp.Diff(want, events); diff != "" {
+		t.Errorf("events mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestMonitor_cleanup_frees_space(t *testing.T) {
+	t.Parallel()
+	fs := &fakeFS{}
+	cleanups, drains := 0, 0
+	m := &Monitor{
+		Path:       "/work",
+		Thresholds: testThresholds,
+		Stat:       fs.stat,
+		logger:     discardLogger{},
+		CleanupFunc: func() {
+			cleanups++
+			// Cleaning up frees most of the disk.
+			fs.usages = []Usage{usage(60, 60)}
+		},
+		DrainFunc: func() { drains++ },
+	}
+	fs.usages = []Usage{usage(3, 50)}
+	if got := m.Check(); got != OK {
+		t.Errorf("Check() = %s; want %s", got, OK)
+	}
+	if cleanups != 1 {
+		t.Errorf("Got %d cleanups; want 1", cleanups)
+	}
+	if drains != 0 {
+		t.Errorf("Got %d drains; want 0", drains)
+	}
+	if m.Throttled() {
+		t.Errorf("Throttled() = true after cleanup; want false")
+	}
+}
+
+func TestMonitor_recovers(t *testing.T) {
+	t.Parallel()
+	fs := &fakeFS{}
+	m := &Monitor{
+		Path:       "/work",
+		Thresholds: testThresholds,
+		Stat:       fs.stat,
+		logger:     discardLogger{},
+	}
+	fs.usages = []Usage{usage(15, 50)}
+	m.Check()
+	if !m.Throttled() {
+		t.Fatalf("Throttled() = false; want true")
+	}
+	// Space was freed by something else.
+	fs.usages = []Usage{usage(50, 50)}
+	m.Check()
+	if m.Throttled() {
+		t.Errorf("Throttled() = true; want false")
+	}
+}
+
+func TestMonitor_stat_error_keeps_level(t *testing.T) {
+	t.Parallel()
+	fs := &fakeFS{}
+	m := &Monitor{
+		Path:       "/work",
+		Thresholds: testThresholds,
+		Stat:       fs.stat,
+		logger:     discardLogger{},
+	}
+	fs.usages = []Usage{usage(15, 50)}
+	m.Check()
+	fs.err = errors.New("some error")
+	if got := m.Check(); got != Throttle {
+		t.Errorf("Check() = %s; want %s", got, Throttle)
+	}
+	if !m.Throttled() {
+		t.Errorf("Throttled() = false; want true")
+	}
+}
+
+func TestThresholds_Level(t *testing.T) {
+	t.Parallel()
+	cases := []struct {
+		t    Thresholds
+		u    Usage
+		want Level
+	}{
+		{testThresholds, usage(20, 20), OK},
+		{testThresholds, usage(19, 50), Throttle},
+		{testThresholds, usage(50, 9), Cleanup},
+		{testThresholds, usage(4, 50), Critical},
+		// Filesystems without inodes.
+		{testThresholds, Usage{FreeBytes: 50, TotalBytes: 100}, OK},
+		// Zero thresholds are never reached.
+		{Thresholds{}, usage(0, 0), OK},
+		{Thresholds{Critical: 5}, usage(6, 6), OK},
+	}
+	for _, c := range cases {
+		if got := c.t.Level(c.u); got != c.want {
+			t.Errorf("%#v.Level(%v) = %s; want %s", c.t, c.u, got, c.want)
+		}
+	}
+}
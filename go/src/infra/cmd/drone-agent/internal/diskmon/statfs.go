@@ -0,0 +1,27 @@
+// Copyright 2021 The Chromium OS Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+// +build !windows
+
+package diskmon
+
+import (
+	"syscall"
+
+	"go.chromium.org/luci/common/errors"
+)
+
+// Statfs returns the usage of the filesystem with the path.
+func Statfs(path string) (Usage, error) {
+	var s syscall.Statfs_t
+	if err := syscall.Statfs(path, &s); err != nil {
+		return Usage{}, errors.Annotate(err, "statfs %s", path).Err()
+	}
+	return Usage{
+		FreeBytes:   uint64(s.Bavail) * uint64(s.Bsize),
+		TotalBytes:  uint64(s.Blocks) * uint64(s.Bsize),
+		FreeInodes:  uint64(s.Ffree),
+		TotalInodes: uint64(s.Files),
+	}, nil
+}
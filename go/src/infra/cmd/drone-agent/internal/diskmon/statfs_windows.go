@@ -0,0 +1,12 @@
+// Copyright 2021 The Chromium OS Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+// +build windows
+
+package diskmon
+
+// Statfs returns the usage of the filesystem with the path.
+func Statfs(path string) (Usage, error) {
+	panic("windows not supported")
+}
@@ -7,6 +7,11 @@ package draining
 
 import (
 	"context"
+	"io/ioutil"
+	"os"
+	"strings"
+	"sync"
+	"time"
 )
 
 // key is a context value key.
@@ -14,18 +19,32 @@ type key struct{}
 
 // value is a context value to store draining status.
 type value struct {
-	c chan struct{}
+	c    chan struct{}
+	once sync.Once
+}
+
+// drain marks the value as draining.  It is safe to call more than
+// once.
+func (dv *value) drain() {
+	dv.once.Do(func() { close(dv.c) })
 }
 
 // WithDraining returns a context that can be marked as draining by
 // calling the returned function.
 func WithDraining(ctx context.Context) (context.Context, func()) {
-	dv := value{
+	dv := &value{
 		c: make(chan struct{}),
 	}
-	ctx = context.WithValue(ctx, key{}, &dv)
-	return ctx, func() {
-		close(dv.c)
+	ctx = context.WithValue(ctx, key{}, dv)
+	return ctx, dv.drain
+}
+
+// Drain marks the context as draining, like the function returned by
+// WithDraining.  If the context is not set up for draining, this does
+// nothing.
+func Drain(ctx context.Context) {
+	if dv, ok := ctx.Value(key{}).(*value); ok {
+		dv.drain()
 	}
 }
 
@@ -51,3 +70,56 @@ func C(ctx context.Context) <-chan struct{} {
 	}
 	return dv.c
 }
+
+// WithFile returns a context that is marked as draining when a file
+// exists at the given path.  The file is checked immediately and then
+// at the given interval, until the context is done.
+func WithFile(ctx context.Context, path string, interval time.Duration) context.Context {
+	ctx, drain := WithDraining(ctx)
+	if _, err := os.Stat(path); err == nil {
+		drain()
+		return ctx
+	}
+	go func() {
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(interval):
+			}
+			if _, err := os.Stat(path); err == nil {
+				drain()
+				return
+			}
+		}
+	}()
+	return ctx
+}
+
+// DUTFilePrefix is the name prefix of per-DUT drain marker files.  A
+// file named DUTFilePrefix followed by the DUT name in a drain
+// directory marks the DUT as drained.
+const DUTFilePrefix = "drain."
+
+// DUTs returns the names of the DUTs marked as drained by marker
+// files in the directory, sorted by name.  If the directory does not
+// exist, no DUTs are drained.
+func DUTs(dir string) ([]string, error) {
+	fis, err := ioutil.ReadDir(dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	var duts []string
+	for _, fi := range fis {
+		if fi.IsDir() || !strings.HasPrefix(fi.Name(), DUTFilePrefix) {
+			continue
+		}
+		if d := strings.TrimPrefix(fi.Name(), DUTFilePrefix); d != "" {
+			duts = append(duts, d)
+		}
+	}
+	return duts, nil
+}
@@ -6,7 +6,12 @@ package draining
 
 import (
 	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
 	"testing"
+	"time"
 )
 
 func TestIsDraining(t *testing.T) {
@@ -38,3 +43,89 @@ func TestC(t *testing.T) {
 		t.Fatalf("didn't receive from channel after calling drain")
 	}
 }
+
+func TestDrain(t *testing.T) {
+	t.Parallel()
+	t.Run("draining context", func(t *testing.T) {
+		t.Parallel()
+		ctx, drain := WithDraining(context.Background())
+		Drain(ctx)
+		if v := IsDraining(ctx); !v {
+			t.Fatalf("after calling Drain, IsDraining = %v; want true", v)
+		}
+		// Draining again is a no-op.
+		Drain(ctx)
+		drain()
+	})
+	t.Run("context without draining", func(t *testing.T) {
+		t.Parallel()
+		ctx := context.Background()
+		Drain(ctx)
+		if v := IsDraining(ctx); v {
+			t.Fatalf("after calling Drain, IsDraining = %v; want false", v)
+		}
+	})
+}
+
+func TestWithFile(t *testing.T) {
+	t.Parallel()
+	path := filepath.Join(t.TempDir(), "drain")
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	ctx = WithFile(ctx, path, time.Millisecond)
+	if IsDraining(ctx) {
+		t.Fatalf("before creating file, IsDraining = true; want false")
+	}
+	if err := ioutil.WriteFile(path, nil, 0666); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case <-C(ctx):
+	case <-time.After(time.Second):
+		t.Fatalf("didn't receive from channel after creating file")
+	}
+}
+
+func TestWithFile_existing(t *testing.T) {
+	t.Parallel()
+	path := filepath.Join(t.TempDir(), "drain")
+	if err := ioutil.WriteFile(path, nil, 0666); err != nil {
+		t.Fatal(err)
+	}
+	ctx := WithFile(context.Background(), path, time.Hour)
+	if v := IsDraining(ctx); !v {
+		t.Fatalf("IsDraining = %v; want true", v)
+	}
+}
+
+func TestDUTs(t *testing.T) {
+	t.Parallel()
+	dir := t.TempDir()
+	for _, n := range []string{"drain.ryza", "drain.claudia", "drain.", "drone-agent.drain", "ryza.1234"} {
+		if err := ioutil.WriteFile(filepath.Join(dir, n), nil, 0666); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := os.Mkdir(filepath.Join(dir, "drain.lila"), 0777); err != nil {
+		t.Fatal(err)
+	}
+	got, err := DUTs(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []string{"claudia", "ryza"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("DUTs() = %v; want %v", got, want)
+	}
+}
+
+func TestDUTs_missing_dir(t *testing.T) {
+	t.Parallel()
+	got, err := DUTs(filepath.Join(t.TempDir(), "missing"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 0 {
+		t.Errorf("DUTs() = %v; want no DUTs", got)
+	}
+}
@@ -0,0 +1,217 @@
+// Copyright 2021 The Chromium OS Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+// Package fakequeen implements a fake drone queen gRPC server for
+// testing drone agents.
+package fakequeen
+
+import (
+	"context"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"infra/appengine/drone-queen/api"
+)
+
+// DefaultUUID is the drone UUID assigned by a Server, unless changed
+// with SetUUID.
+const DefaultUUID = "3679687c-b341-4422-ad6d-a935887ed6a7"
+
+// endOfTime is the default expiration time of drone assignments.
+var endOfTime = time.Date(9999, 1, 2, 3, 4, 5, 6, time.UTC)
+
+// Response is a scripted response to a ReportDrone call.
+type Response struct {
+	// AssignedDUTs are the DUTs assigned to the drone.
+	AssignedDUTs []string
+	// DrainingDUTs are the assigned DUTs which are draining.
+	DrainingDUTs []string
+	// Status is the response status.  The zero value is
+	// api.ReportDroneResponse_UNKNOWN, so the default is replaced
+	// with api.ReportDroneResponse_OK.
+	Status api.ReportDroneResponse_Status
+	// Expiration is the expiration time of the drone assignment.
+	// If zero, the assignment never expires.
+	Expiration time.Time
+	// Unavailable makes the call fail with codes.Unavailable, as
+	// if the queen was down.
+	Unavailable bool
+}
+
+// Server is a fake drone queen server.  It implements api.DroneServer.
+//
+// ReportDrone calls are answered with the scripted responses, one per
+// call, in order.  Once the script runs out, the last response is
+// repeated.  All requests are captured for inspection.
+type Server struct {
+	m        sync.Mutex
+	uuid     string
+	script   []Response
+	last     Response
+	reports  []*api.ReportDroneRequest
+	released []string
+	// changed is closed and replaced when a request is captured.
+	changed chan struct{}
+}
+
+// NewServer returns a new Server which assigns no DUTs until
+// responses are scripted.
+func NewServer() *Server {
+	return &Server{
+		uuid:    DefaultUUID,
+		changed: make(chan struct{}),
+	}
+}
+
+// SetUUID sets the UUID assigned to drones that register.
+func (s *Server) SetUUID(uuid string) {
+	s.m.Lock()
+	defer s.m.Unlock()
+	s.uuid = uuid
+}
+
+// Script appends responses to the script.
+func (s *Server) Script(rs ...Response) {
+	s.m.Lock()
+	defer s.m.Unlock()
+	s.script = append(s.script, rs...)
+}
+
+// Reports returns copies of the captured ReportDrone requests.
+func (s *Server) Reports() []*api.ReportDroneRequest {
+	s.m.Lock()
+	defer s.m.Unlock()
+	reports := make([]*api.ReportDroneRequest, len(s.reports))
+	for i, r := range s.reports {
+		reports[i] = proto.Clone(r).(*api.ReportDroneRequest)
+	}
+	return reports
+}
+
+// Released returns the DUTs released with ReleaseDuts, in order.
+func (s *Server) Released() []string {
+	s.m.Lock()
+	defer s.m.Unlock()
+	return append([]string(nil), s.released...)
+}
+
+// WaitReports waits until at least n ReportDrone requests have been
+// captured and returns them, or returns nil if the context is done
+// first.
+func (s *Server) WaitReports(ctx context.Context, n int) []*api.ReportDroneRequest {
+	if !s.wait(ctx, func() bool { return len(s.reports) >= n }) {
+		return nil
+	}
+	return s.Reports()
+}
+
+// WaitReleased waits until the DUT has been released and returns
+// true, or returns false if the context is done first.
+func (s *Server) WaitReleased(ctx context.Context, dutID string) bool {
+	return s.wait(ctx, func() bool {
+		for _, d := range s.released {
+			if d == dutID {
+				return true
+			}
+		}
+		return false
+	})
+}
+
+// wait waits until cond, which is called with the lock held, is true
+// and returns true, or returns false if the context is done first.
+func (s *Server) wait(ctx context.Context, cond func() bool) bool {
+	for {
+		s.m.Lock()
+		ok, changed := cond(), s.changed
+		s.m.Unlock()
+		if ok {
+			return true
+		}
+		select {
+		case <-changed:
+		case <-ctx.Done():
+			return false
+		}
+	}
+}
+
+// notifyLocked wakes up waiters.  The lock must be held.
+func (s *Server) notifyLocked() {
+	close(s.changed)
+	s.changed = make(chan struct{})
+}
+
+// ReportDrone implements api.DroneServer.
+func (s *Server) ReportDrone(ctx context.Context, req *api.ReportDroneRequest) (*api.ReportDroneResponse, error) {
+	s.m.Lock()
+	defer s.m.Unlock()
+	s.reports = append(s.reports, proto.Clone(req).(*api.ReportDroneRequest))
+	defer s.notifyLocked()
+
+	r := s.last
+	if len(s.script) > 0 {
+		r, s.script = s.script[0], s.script[1:]
+		s.last = r
+	}
+	if r.Unavailable {
+		return nil, status.Errorf(codes.Unavailable, "fake queen unavailable")
+	}
+	res := &api.ReportDroneResponse{
+		Status:         r.Status,
+		DroneUuid:      req.GetDroneUuid(),
+		ExpirationTime: timestamppb.New(endOfTime),
+		AssignedDuts:   r.AssignedDUTs,
+		DrainingDuts:   r.DrainingDUTs,
+	}
+	if res.Status == api.ReportDroneResponse_UNKNOWN {
+		res.Status = api.ReportDroneResponse_OK
+	}
+	if res.DroneUuid == "" {
+		res.DroneUuid = s.uuid
+	}
+	if !r.Expiration.IsZero() {
+		res.ExpirationTime = timestamppb.New(r.Expiration)
+	}
+	return res, nil
+}
+
+// ReleaseDuts implements api.DroneServer.
+func (s *Server) ReleaseDuts(ctx context.Context, req *api.ReleaseDutsRequest) (*api.ReleaseDutsResponse, error) {
+	s.m.Lock()
+	defer s.m.Unlock()
+	s.released = append(s.released, req.GetDuts()...)
+	s.notifyLocked()
+	return &api.ReleaseDutsResponse{}, nil
+}
+
+// Serve serves the Server over gRPC on a local port and returns a
+// client connected to it.  The returned function stops the server and
+// closes the client connection.
+func (s *Server) Serve() (c api.DroneClient, stop func(), err error) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return nil, nil, err
+	}
+	gs := grpc.NewServer()
+	api.RegisterDroneServer(gs, s)
+	go gs.Serve(l)
+
+	conn, err := grpc.Dial(l.Addr().String(), grpc.WithInsecure())
+	if err != nil {
+		gs.Stop()
+		return nil, nil, err
+	}
+	return api.NewDroneClient(conn), func() {
+		conn.Close()
+		gs.Stop()
+	}, nil
+}
@@ -0,0 +1,173 @@
+// Copyright 2021 The Chromium OS Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+// Package status implements the local HTTP endpoint serving the status
+// and health of the agent, so that operators can see what the agent
+// is doing without reading its logs.
+package status
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"sort"
+	"time"
+
+	"go.chromium.org/luci/common/errors"
+
+	"infra/cmd/drone-agent/internal/agent"
+	"infra/cmd/drone-agent/internal/tokman"
+)
+
+// Status is the status document served by the agent.
+type Status struct {
+	// UUID is the drone UUID.  It is empty while the agent is not
+	// registered with the queen.
+	UUID string `json:"uuid"`
+	Hive string `json:"hive"`
+	// Bots are the bots for the DUTs assigned to the drone, sorted
+	// by DUT.
+	Bots []Bot `json:"bots"`
+	// LastReport is when the agent last reported to the queen
+	// successfully.  It is omitted if the agent has not reported
+	// yet.
+	LastReport *time.Time `json:"last_report,omitempty"`
+	Draining   bool       `json:"draining"`
+	Token      Token      `json:"token"`
+
+	// ReportingInterval is used for checking the health of the
+	// agent.
+	ReportingInterval time.Duration `json:"-"`
+}
+
+// Bot is the status of the bot for a DUT.
+type Bot struct {
+	DUT string `json:"dut"`
+	// State is one of starting, running or terminating.
+	State string `json:"state"`
+}
+
+// Token is the status of the access token file used by the bots.
+type Token struct {
+	Expiry              time.Time `json:"expiry"`
+	LastRenewal         time.Time `json:"last_renewal"`
+	LastError           string    `json:"last_error,omitempty"`
+	ConsecutiveFailures int       `json:"consecutive_failures"`
+}
+
+// New returns the status document for the status of the agent, whether
+// it is draining and the status of its access token file.
+func New(a agent.Status, draining bool, t tokman.Status) Status {
+	s := Status{
+		UUID:              a.UUID,
+		Hive:              a.Hive,
+		Bots:              []Bot{},
+		Draining:          draining,
+		ReportingInterval: a.ReportingInterval,
+		Token: Token{
+			Expiry:              t.Expiry,
+			LastRenewal:         t.LastRenewal,
+			LastError:           t.LastError,
+			ConsecutiveFailures: t.ConsecutiveFailures,
+		},
+	}
+	if !a.LastReport.IsZero() {
+		lr := a.LastReport
+		s.LastReport = &lr
+	}
+	for d, b := range a.Bots {
+		s.Bots = append(s.Bots, Bot{DUT: d, State: b.String()})
+	}
+	sort.Slice(s.Bots, func(i, j int) bool { return s.Bots[i].DUT < s.Bots[j].DUT })
+	return s
+}
+
+// staleReportIntervals is the number of reporting intervals without a
+// successful report to the queen after which the agent is unhealthy.
+const staleReportIntervals = 3
+
+// Handler serves the status document at /status and the health of the
+// agent at /healthz.  The agent is unhealthy if it has not reported to
+// the queen successfully for 3 reporting intervals, counting from when
+// the handler is made if the agent has not reported yet.
+type Handler struct {
+	mux    *http.ServeMux
+	status func() Status
+	start  time.Time
+	// now is used to check the time of the last report.  This is
+	// used in tests.
+	now func() time.Time
+}
+
+// NewHandler returns a Handler serving the status returned by the
+// function.
+func NewHandler(f func() Status) *Handler {
+	h := &Handler{
+		mux:    http.NewServeMux(),
+		status: f,
+		start:  time.Now(),
+		now:    time.Now,
+	}
+	h.mux.HandleFunc("/status", h.serveStatus)
+	h.mux.HandleFunc("/healthz", h.serveHealth)
+	return h
+}
+
+// ServeHTTP implements http.Handler.
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	h.mux.ServeHTTP(w, r)
+}
+
+func (h *Handler) serveStatus(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	e := json.NewEncoder(w)
+	e.SetIndent("", "  ")
+	if err := e.Encode(h.status()); err != nil {
+		log.Printf("Error writing status: %s", err)
+	}
+}
+
+func (h *Handler) serveHealth(w http.ResponseWriter, r *http.Request) {
+	if err := h.checkHealth(h.status()); err != nil {
+		http.Error(w, err.Error(), http.StatusServiceUnavailable)
+		return
+	}
+	fmt.Fprintln(w, "ok")
+}
+
+// checkHealth returns an error if the agent is unhealthy.
+func (h *Handler) checkHealth(s Status) error {
+	last := h.start
+	if s.LastReport != nil && s.LastReport.After(last) {
+		last = *s.LastReport
+	}
+	if d := h.now().Sub(last); d > staleReportIntervals*s.ReportingInterval {
+		if s.LastReport == nil {
+			return fmt.Errorf("no report to queen in %s", d)
+		}
+		return fmt.Errorf("last report to queen was %s ago", d)
+	}
+	return nil
+}
+
+// ListenAndServe serves the handler on the TCP address until the
+// context is canceled.
+func ListenAndServe(ctx context.Context, addr string, h http.Handler) error {
+	srv := &http.Server{Addr: addr, Handler: h}
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		select {
+		case <-ctx.Done():
+			srv.Close()
+		case <-done:
+		}
+	}()
+	if err := srv.ListenAndServe(); err != http.ErrServerClosed {
+		return errors.Annotate(err, "serve status").Err()
+	}
+	return nil
+}
@@ -0,0 +1,142 @@
+// Copyright 2021 The Chromium OS Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package status
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"infra/cmd/drone-agent/internal/agent"
+	"infra/cmd/drone-agent/internal/agent/state"
+	"infra/cmd/drone-agent/internal/tokman"
+)
+
+var testTime = time.Date(2001, 2, 3, 4, 5, 6, 0, time.UTC)
+
+func TestNew(t *testing.T) {
+	t.Parallel()
+	a := agent.Status{
+		UUID: "some-uuid",
+		Hive: "some-hive",
+		Bots: map[string]state.BotState{
+			"ryza":    state.BotRunning,
+			"claudia": state.BotStarting,
+			"lila":    state.BotTerminating,
+		},
+		LastReport:        testTime,
+		ReportingInterval: time.Minute,
+	}
+	tok := tokman.Status{
+		Expiry:              testTime.Add(30 * time.Minute),
+		LastRenewal:         testTime,
+		LastError:           "some error",
+		ConsecutiveFailures: 2,
+	}
+	got := New(a, true, tok)
+	want := Status{
+		UUID: "some-uuid",
+		Hive: "some-hive",
+		Bots: []Bot{
+			{DUT: "claudia", State: "starting"},
+			{DUT: "lila", State: "terminating"},
+			{DUT: "ryza", State: "running"},
+		},
+		LastReport: &testTime,
+		Draining:   true,
+		Token: Token{
+			Expiry:              testTime.Add(30 * time.Minute),
+			LastRenewal:         testTime,
+			LastError:           "some error",
+			ConsecutiveFailures: 2,
+		},
+		ReportingInterval: time.Minute,
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("status mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestHandler_status(t *testing.T) {
+	t.Parallel()
+	h := NewHandler(func() Status {
+		return Status{
+			UUID:       "some-uuid",
+			Hive:       "some-hive",
+			Bots:       []Bot{{DUT: "ryza", State: "running"}},
+			LastReport: &testTime,
+			Token: Token{
+				Expiry:      testTime.Add(30 * time.Minute),
+				LastRenewal: testTime,
+			},
+			ReportingInterval: time.Minute,
+		}
+	})
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest("GET", "/status", nil))
+	if rec.Code != http.StatusOK {
+		t.Errorf("Got status code %d; want %d", rec.Code, http.StatusOK)
+	}
+	want := `{
+  "uuid": "some-uuid",
+  "hive": "some-hive",
+  "bots": [
+    {
+      "dut": "ryza",
+      "state": "running"
+    }
+  ],
+  "last_report": "2001-02-03T04:05:06Z",
+  "draining": false,
+  "token": {
+    "expiry": "2001-02-03T04:35:06Z",
+    "last_renewal": "2001-02-03T04:05:06Z",
+    "consecutive_failures": 0
+  }
+}
+`
+	if diff := cmp.Diff(want, rec.Body.String()); diff != "" {
+		t.Errorf("body mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestHandler_healthz(t *testing.T) {
+	t.Parallel()
+	start := testTime
+	recent := start.Add(5 * time.Minute)
+	cases := []struct {
+		desc       string
+		now        time.Time
+		lastReport *time.Time
+		want       int
+	}{
+		{"just started", start.Add(time.Minute), nil, http.StatusOK},
+		{"no report since starting", start.Add(4 * time.Minute), nil, http.StatusServiceUnavailable},
+		{"recent report", recent.Add(2 * time.Minute), &recent, http.StatusOK},
+		{"stale report", recent.Add(4 * time.Minute), &recent, http.StatusServiceUnavailable},
+	}
+	for _, c := range cases {
+		c := c
+		t.Run(c.desc, func(t *testing.T) {
+			t.Parallel()
+			h := NewHandler(func() Status {
+				return Status{
+					LastReport:        c.lastReport,
+					ReportingInterval: time.Minute,
+				}
+			})
+			h.start = start
+			h.now = func() time.Time { return c.now }
+			rec := httptest.NewRecorder()
+			h.ServeHTTP(rec, httptest.NewRequest("GET", "/healthz", nil))
+			if rec.Code != c.want {
+				t.Errorf("Got status code %d; want %d (body %q)", rec.Code, c.want, rec.Body.String())
+			}
+		})
+	}
+}
@@ -11,80 +11,278 @@ import (
 	"log"
 	"math/rand"
 	"os"
+	"sort"
+	"sync"
 	"time"
 
-	"go.chromium.org/luci/auth"
 	"go.chromium.org/luci/common/errors"
 	"golang.org/x/oauth2"
 )
 
 const (
 	defaultLifetime = 30 * time.Minute
+	// minMargin is the least validity left on the token in the
+	// token file when it is renewed.  Swarming bots read the token
+	// file on demand, so it must always hold a token that is still
+	// good for running a task step.
+	minMargin = 10 * time.Minute
+	// maxMargin bounds the margin so that renewals are not done
+	// back to back when renewals are very slow.
+	maxMargin = 20 * time.Minute
+	// latencyFactor is how many times the observed p99 renewal
+	// latency is added to minMargin, so that a renewal which is as
+	// slow as the slowest recent ones still finishes well before
+	// the token drops below minMargin.
+	latencyFactor = 4
+	// latencyWindow is the number of recent renewal latencies used
+	// to calculate the margin.
+	latencyWindow = 50
+	// defaultFailureThreshold is the number of consecutive renewal
+	// failures after which the FailureHook is called.
+	defaultFailureThreshold = 3
 )
 
+// TokenSource gets OAuth access tokens.  This is implemented by
+// *auth.Authenticator.
+type TokenSource interface {
+	// GetAccessToken returns a token that is valid for at least
+	// the given lifetime.
+	GetAccessToken(lifetime time.Duration) (*oauth2.Token, error)
+}
+
+// FailureHook is notified when the access token cannot be renewed
+// repeatedly, so that the agent can stop relying on the token.
+type FailureHook interface {
+	// RenewFailed is called after each failed renewal once there
+	// have been at least the threshold number of consecutive
+	// failures.
+	RenewFailed(failures int, err error)
+	// RenewRecovered is called after a successful renewal
+	// following a call to RenewFailed.
+	RenewRecovered()
+}
+
+// Status is the state of the access token file.
+type Status struct {
+	// Expiry is the expiry of the token in the token file.  It is
+	// zero if no token has been written.
+	Expiry time.Time
+	// LastRenewal is when the token was last renewed, or when
+	// renewal was last attempted if it failed.
+	LastRenewal time.Time
+	// LastError is the error of the last renewal.  It is empty if
+	// the last renewal succeeded.
+	LastError string
+	// ConsecutiveFailures is the number of renewals that failed in
+	// a row up to the last renewal.
+	ConsecutiveFailures int
+	// LatencyP50 and LatencyP99 are the percentiles of recent
+	// renewal latencies.
+	LatencyP50 time.Duration
+	LatencyP99 time.Duration
+	// Margin is the validity left on the token at which it is
+	// renewed.
+	Margin time.Duration
+}
+
 // Renewer is used to renew an access token file.
 type Renewer struct {
-	a    *auth.Authenticator
+	// Hook, if set, is called on repeated renewal failures.  This
+	// must not be changed while KeepNew is running.
+	Hook FailureHook
+	// FailureThreshold is the number of consecutive renewal
+	// failures after which Hook is called.  If zero, a default is
+	// used.  This must not be changed while KeepNew is running.
+	FailureThreshold int
+
+	a    TokenSource
 	path string
+	// clock is used for sleeping and for measuring renewal
+	// latencies.  This is used to script renewals in tests.
+	clock clock
+	// failing is set once Hook is notified of failures, until a
+	// renewal succeeds.  This is only used by KeepNew.
+	failing bool
+
+	// mu guards status and latencies.
+	mu     sync.Mutex
+	status Status
+	// latencies are the recent renewal latencies, used as a ring
+	// buffer of size latencyWindow.
+	latencies []time.Duration
+	next      int
 }
 
+// clock defines the time interface used by Renewer.
+type clock interface {
+	Now() time.Time
+	After(time.Duration) <-chan time.Time
+}
+
+// systemClock implements clock using the time package.
+type systemClock struct{}
+
+func (systemClock) Now() time.Time                         { return time.Now() }
+func (systemClock) After(d time.Duration) <-chan time.Time { return time.After(d) }
+
 // Make makes an access token file and returns a Renewer for renewing
 // the token.
-func Make(a *auth.Authenticator, path string, lifetime time.Duration) (Renewer, error) {
-	r := Renewer{
-		a:    a,
-		path: path,
-	}
+func Make(a TokenSource, path string, lifetime time.Duration) (*Renewer, error) {
+	r := newRenewer(a, path, systemClock{})
 	if _, err := r.RenewOnce(lifetime); err != nil {
-		return Renewer{}, errors.Annotate(err, "make access token file").Err()
+		return nil, errors.Annotate(err, "make access token file").Err()
 	}
 	return r, nil
 }
 
+func newRenewer(a TokenSource, path string, c clock) *Renewer {
+	return &Renewer{
+		a:     a,
+		path:  path,
+		clock: c,
+	}
+}
+
 // KeepNew keeps the access token valid until the context is canceled.
+// The token is renewed once its remaining validity drops below a
+// margin, which grows with the observed renewal latencies.
 // This function blocks until canceled.
-func (r Renewer) KeepNew(ctx context.Context) {
+func (r *Renewer) KeepNew(ctx context.Context) {
 	d := newDelayer()
 	for ctx.Err() == nil {
-		tok, err := r.RenewOnce(defaultLifetime)
-		if err != nil {
-			log.Printf("Error: %s", err)
-			sleep(ctx, d.Next())
-			continue
-		}
-		d.Reset()
-		sleep(ctx, sleepForToken(time.Now(), tok))
+		r.sleep(ctx, r.renewNext(d))
 	}
 	log.Printf("Tokman exited.")
 }
 
+// renewNext renews the access token once and returns how long to sleep
+// before the next renewal.  Hook is notified of repeated failures and
+// of the recovery from them.
+func (r *Renewer) renewNext(d *errDelayer) time.Duration {
+	tok, err := r.RenewOnce(defaultLifetime)
+	if err != nil {
+		log.Printf("Error: %s", err)
+		if n := r.Status().ConsecutiveFailures; n >= r.failureThreshold() {
+			r.failing = true
+			if r.Hook != nil {
+				r.Hook.RenewFailed(n, err)
+			}
+		}
+		return d.Next()
+	}
+	if r.failing {
+		r.failing = false
+		if r.Hook != nil {
+			r.Hook.RenewRecovered()
+		}
+	}
+	d.Reset()
+	return sleepForToken(r.clock.Now(), tok, r.Status().Margin)
+}
+
+func (r *Renewer) failureThreshold() int {
+	if r.FailureThreshold > 0 {
+		return r.FailureThreshold
+	}
+	return defaultFailureThreshold
+}
+
 // sleepForToken returns how much time to sleep for the given token
-// before renewing.
-func sleepForToken(now time.Time, tok *oauth2.Token) time.Duration {
-	return tok.Expiry.Sub(now) - 15*time.Minute
+// before renewing, so that it is renewed when its remaining validity
+// drops to the margin.
+func sleepForToken(now time.Time, tok *oauth2.Token, margin time.Duration) time.Duration {
+	d := tok.Expiry.Sub(now) - margin
+	if d < 0 {
+		return 0
+	}
+	return d
 }
 
 // sleep provides cancelable sleep.
-func sleep(ctx context.Context, d time.Duration) {
+func (r *Renewer) sleep(ctx context.Context, d time.Duration) {
 	select {
 	case <-ctx.Done():
-	case <-time.After(d):
+	case <-r.clock.After(d):
 	}
 }
 
 // RenewOnce renews the access token file once.
-func (r Renewer) RenewOnce(lifetime time.Duration) (*oauth2.Token, error) {
+func (r *Renewer) RenewOnce(lifetime time.Duration) (*oauth2.Token, error) {
+	start := r.clock.Now()
 	tok, err := r.a.GetAccessToken(lifetime)
-	if err != nil {
-		return nil, errors.Annotate(err, "renew access token file").Err()
+	end := r.clock.Now()
+	if err == nil {
+		err = writeToken(tok, r.path)
 	}
-	if err := writeToken(tok, r.path); err != nil {
-		return nil, errors.Annotate(err, "renew access token file").Err()
+	if err != nil {
+		err = errors.Annotate(err, "renew access token file").Err()
+		r.record(start, end, nil, err)
+		return nil, err
 	}
+	r.record(start, end, tok, nil)
 	log.Printf("Access token renewed.")
 	return tok, nil
 }
 
+// Status returns the status of the access token file.  This is safe
+// to call concurrently.
+func (r *Renewer) Status() Status {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.status
+}
+
+// record records the outcome of a renewal which started and ended at
+// the given times.
+func (r *Renewer) record(start, end time.Time, tok *oauth2.Token, err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if len(r.latencies) < latencyWindow {
+		r.latencies = append(r.latencies, end.Sub(start))
+	} else {
+		r.latencies[r.next] = end.Sub(start)
+		r.next = (r.next + 1) % latencyWindow
+	}
+	s := &r.status
+	s.LastRenewal = end
+	if err != nil {
+		s.LastError = err.Error()
+		s.ConsecutiveFailures++
+	} else {
+		s.Expiry = tok.Expiry
+		s.LastError = ""
+		s.ConsecutiveFailures = 0
+	}
+	s.LatencyP50 = percentile(r.latencies, 50)
+	s.LatencyP99 = percentile(r.latencies, 99)
+	s.Margin = margin(s.LatencyP99)
+}
+
+// margin returns the validity left on the token at which it is
+// renewed, for the given p99 renewal latency.
+func margin(p99 time.Duration) time.Duration {
+	m := minMargin + latencyFactor*p99
+	if m > maxMargin {
+		return maxMargin
+	}
+	return m
+}
+
+// percentile returns the pth percentile of the durations using the
+// nearest-rank method.  It returns zero if there are no durations.
+func percentile(ds []time.Duration, p int) time.Duration {
+	if len(ds) == 0 {
+		return 0
+	}
+	sorted := append([]time.Duration(nil), ds...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+	rank := (p*len(sorted) + 99) / 100
+	if rank < 1 {
+		rank = 1
+	}
+	return sorted[rank-1]
+}
+
 // writeToken atomically writes the OAuth2 token to the JSON file used
 // by Swarming bots.
 func writeToken(t *oauth2.Token, path string) error {
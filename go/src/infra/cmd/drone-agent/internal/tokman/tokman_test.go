@@ -5,6 +5,8 @@
 package tokman
 
 import (
+	"errors"
+	"fmt"
 	"io/ioutil"
 	"os"
 	"path/filepath"
@@ -49,11 +51,217 @@ func TestSleepForToken(t *testing.T) {
 	tok := &oauth2.Token{
 		Expiry: time.Date(2000, 1, 2, 3, 30, 5, 6, time.UTC),
 	}
-	got := sleepForToken(time.Date(2000, 1, 2, 3, 4, 5, 6, time.UTC), tok)
-	want := 11 * time.Minute
-	if got != want {
-		t.Errorf("sleepForToken(...) = %v; want %v", got, want)
+	cases := []struct {
+		desc   string
+		now    time.Time
+		margin time.Duration
+		want   time.Duration
+	}{
+		{"before margin", time.Date(2000, 1, 2, 3, 4, 5, 6, time.UTC), 15 * time.Minute, 11 * time.Minute},
+		{"within margin", time.Date(2000, 1, 2, 3, 20, 5, 6, time.UTC), 15 * time.Minute, 0},
+	}
+	for _, c := range cases {
+		c := c
+		t.Run(c.desc, func(t *testing.T) {
+			t.Parallel()
+			got := sleepForToken(c.now, tok, c.margin)
+			if got != c.want {
+				t.Errorf("sleepForToken(%v, ..., %v) = %v; want %v", c.now, c.margin, got, c.want)
+			}
+		})
+	}
+}
+
+func TestMargin(t *testing.T) {
+	t.Parallel()
+	cases := []struct {
+		p99  time.Duration
+		want time.Duration
+	}{
+		{0, minMargin},
+		{30 * time.Second, minMargin + 2*time.Minute},
+		{time.Hour, maxMargin},
+	}
+	for _, c := range cases {
+		if got := margin(c.p99); got != c.want {
+			t.Errorf("margin(%v) = %v; want %v", c.p99, got, c.want)
+		}
+	}
+}
+
+func TestPercentile(t *testing.T) {
+	t.Parallel()
+	var ds []time.Duration
+	for i := 100; i > 0; i-- {
+		ds = append(ds, time.Duration(i)*time.Second)
+	}
+	cases := []struct {
+		ds   []time.Duration
+		p    int
+		want time.Duration
+	}{
+		{nil, 50, 0},
+		{ds, 50, 50 * time.Second},
+		{ds, 99, 99 * time.Second},
+		{ds[:10], 99, 100 * time.Second},
+		{ds[:1], 50, 100 * time.Second},
+	}
+	for _, c := range cases {
+		if got := percentile(c.ds, c.p); got != c.want {
+			t.Errorf("percentile(%d durations, %d) = %v; want %v", len(c.ds), c.p, got, c.want)
+		}
+	}
+}
+
+func TestRenewer_latencySpike(t *testing.T) {
+	t.Parallel()
+	c := &fakeClock{now: time.Date(2000, 1, 2, 3, 4, 5, 0, time.UTC)}
+	ts := &scriptedTokenSource{clock: c}
+	for i := 0; i < 9; i++ {
+		ts.add(time.Second, nil)
+	}
+	ts.add(3*time.Minute, nil)
+	r := newRenewer(ts, tempTokenFile(t), c)
+	d := newDelayer()
+
+	for i := 0; i < 9; i++ {
+		got := r.renewNext(d)
+		want := defaultLifetime - (minMargin + latencyFactor*time.Second)
+		if got != want {
+			t.Fatalf("Renewal %d: got sleep %v; want %v", i, got, want)
+		}
+	}
+	// The slow renewal pushes up the p99 latency, so the next
+	// renewal is done earlier.
+	got := r.renewNext(d)
+	if want := defaultLifetime - maxMargin; got != want {
+		t.Errorf("After latency spike: got sleep %v; want %v", got, want)
+	}
+	s := r.Status()
+	want := Status{
+		Expiry:      c.now.Add(defaultLifetime),
+		LastRenewal: c.now,
+		LatencyP50:  time.Second,
+		LatencyP99:  3 * time.Minute,
+		Margin:      maxMargin,
+	}
+	if diff := cmp.Diff(want, s); diff != "" {
+		t.Errorf("status mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestRenewer_repeatedFailures(t *testing.T) {
+	t.Parallel()
+	c := &fakeClock{now: time.Date(2000, 1, 2, 3, 4, 5, 0, time.UTC)}
+	ts := &scriptedTokenSource{clock: c}
+	ts.add(time.Second, nil)
+	for i := 0; i < 4; i++ {
+		ts.add(time.Second, errors.New("some error"))
+	}
+	ts.add(time.Second, nil)
+	r := newRenewer(ts, tempTokenFile(t), c)
+	h := &spyHook{}
+	r.Hook = h
+	r.FailureThreshold = 3
+	d := newDelayer()
+	d.rander = stubRander{.5}
+
+	r.renewNext(d)
+	expiry := r.Status().Expiry
+	for i := 0; i < 4; i++ {
+		r.renewNext(d)
+	}
+	s := r.Status()
+	if s.ConsecutiveFailures != 4 {
+		t.Errorf("Got %d consecutive failures; want 4", s.ConsecutiveFailures)
+	}
+	if s.LastError == "" {
+		t.Errorf("Got no last error; want error")
+	}
+	if !s.Expiry.Equal(expiry) {
+		t.Errorf("Got expiry %v; want %v kept from the last good token", s.Expiry, expiry)
+	}
+
+	r.renewNext(d)
+	want := []string{"failed 3", "failed 4", "recovered"}
+	if diff := cmp.Diff(want, h.events); diff != "" {
+		t.Errorf("hook events mismatch (-want +got):\n%s", diff)
+	}
+	s = r.Status()
+	if s.ConsecutiveFailures != 0 || s.LastError != "" {
+		t.Errorf("Got %d consecutive failures, last error %q after recovery; want none", s.ConsecutiveFailures, s.LastError)
+	}
+}
+
+// tempTokenFile returns the path of a token file in a temporary
+// directory which is removed after the test.
+func tempTokenFile(t *testing.T) string {
+	t.Helper()
+	d, err := ioutil.TempDir("", "test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { os.RemoveAll(d) })
+	return filepath.Join(d, "token.json")
+}
+
+// fakeClock implements clock.  Time only passes when advanced.
+type fakeClock struct {
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time { return c.now }
+
+func (c *fakeClock) After(d time.Duration) <-chan time.Time {
+	c.now = c.now.Add(d)
+	ch := make(chan time.Time, 1)
+	ch <- c.now
+	return ch
+}
+
+// scriptedTokenSource implements TokenSource with a scripted sequence
+// of renewals.  Each renewal takes its latency on the fake clock.
+type scriptedTokenSource struct {
+	clock   *fakeClock
+	results []scriptedResult
+}
+
+type scriptedResult struct {
+	latency time.Duration
+	err     error
+}
+
+func (s *scriptedTokenSource) add(latency time.Duration, err error) {
+	s.results = append(s.results, scriptedResult{latency: latency, err: err})
+}
+
+func (s *scriptedTokenSource) GetAccessToken(lifetime time.Duration) (*oauth2.Token, error) {
+	if len(s.results) == 0 {
+		return nil, errors.New("no more scripted renewals")
+	}
+	res := s.results[0]
+	s.results = s.results[1:]
+	s.clock.now = s.clock.now.Add(res.latency)
+	if res.err != nil {
+		return nil, res.err
 	}
+	return &oauth2.Token{
+		AccessToken: "ya29.Gl1uB45Ecullaaaaaaaaa",
+		Expiry:      s.clock.now.Add(lifetime),
+	}, nil
+}
+
+// spyHook implements FailureHook by recording the calls.
+type spyHook struct {
+	events []string
+}
+
+func (h *spyHook) RenewFailed(failures int, err error) {
+	h.events = append(h.events, fmt.Sprintf("failed %d", failures))
+}
+
+func (h *spyHook) RenewRecovered() {
+	h.events = append(h.events, "recovered")
 }
 
 func TestRandRange(t *testing.T) {
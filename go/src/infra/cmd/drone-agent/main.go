@@ -12,44 +12,60 @@ package main
 import (
 	"context"
 	"log"
+	"net/http"
 	"os"
 	"path/filepath"
-	"strconv"
+	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"go.chromium.org/luci/auth"
 	"go.chromium.org/luci/grpc/prpc"
 
 	"infra/appengine/drone-queen/api"
+	"infra/cmd/drone-agent/internal/affinity"
 	"infra/cmd/drone-agent/internal/agent"
 	"infra/cmd/drone-agent/internal/bot"
+	"infra/cmd/drone-agent/internal/diskmon"
 	"infra/cmd/drone-agent/internal/draining"
+	"infra/cmd/drone-agent/internal/status"
 	"infra/cmd/drone-agent/internal/tokman"
 )
 
 const (
 	drainingFile   = "drone-agent.drain"
+	affinityFile   = "drone-agent.affinity.json"
 	oauthTokenPath = "/var/lib/swarming/oauth_bot_token.json"
 )
 
 var (
 	queenService = os.Getenv("DRONE_AGENT_QUEEN_SERVICE")
-	// DRONE_AGENT_SWARMING_URL is the URL of the Swarming
-	// instance.  Should be a full URL without the path,
-	// e.g. https://host.example.com
-	swarmingURL       = os.Getenv("DRONE_AGENT_SWARMING_URL")
-	dutCapacity       = getIntEnv("DRONE_AGENT_DUT_CAPACITY", 10)
-	reportingInterval = time.Duration(getIntEnv("DRONE_AGENT_REPORTING_INTERVAL_MINS", 1)) * time.Minute
+	// DRONE_AGENT_CONFIG_FILE is the path of an optional file
+	// overriding the reloadable configuration in the
+	// environment.  See loadConfig.
+	configFile = os.Getenv("DRONE_AGENT_CONFIG_FILE")
 
 	authOptions = auth.Options{
 		Method:                 auth.ServiceAccountMethod,
 		ServiceAccountJSONPath: os.Getenv("GOOGLE_APPLICATION_CREDENTIALS"),
 	}
-	workingDirPath = filepath.Join(os.Getenv("HOME"), "skylab_bots")
 	// hive value of the drone agent.  This is used for DUT/drone affinity.
 	// A drone is assigned DUTs with same hive value.
 	hive = os.Getenv("DRONE_AGENT_HIVE")
+
+	// DRONE_AGENT_BOT_CONTAINER_RUNTIME, if set, is the container
+	// runtime CLI (docker or podman) used to run each Swarming bot
+	// in its own container.  See startBotFunc.
+	botContainerRuntime = os.Getenv("DRONE_AGENT_BOT_CONTAINER_RUNTIME")
+	// DRONE_AGENT_BOT_CONTAINER_IMAGE is the image to run bots in.
+	botContainerImage = os.Getenv("DRONE_AGENT_BOT_CONTAINER_IMAGE")
+	// DRONE_AGENT_BOT_CONTAINER_DEVICES is a comma separated list
+	// of host devices made available to bot containers.
+	botContainerDevices = os.Getenv("DRONE_AGENT_BOT_CONTAINER_DEVICES")
+	// DRONE_AGENT_STATUS_ADDR is the TCP address to serve the agent
+	// status and health on.  See statusAddr.
+	statusAddrEnv = os.Getenv("DRONE_AGENT_STATUS_ADDR")
 )
 
 func main() {
@@ -60,9 +76,13 @@ func main() {
 
 func innerMain() error {
 	// TODO(ayatane): Add environment validation.
+	cfg, err := loadConfig(configFile)
+	if err != nil {
+		return err
+	}
 	ctx, cancel := context.WithCancel(context.Background())
 	ctx = notifySIGTERM(ctx)
-	ctx = notifyDraining(ctx, filepath.Join(workingDirPath, drainingFile))
+	ctx = draining.WithFile(ctx, filepath.Join(cfg.WorkingDir, drainingFile), checkDrainingInterval)
 
 	var wg sync.WaitGroup
 	defer wg.Wait()
@@ -74,6 +94,9 @@ func innerMain() error {
 	if err != nil {
 		return err
 	}
+	th := &tokenHook{}
+	r.Hook = th
+	r.FailureThreshold = tokenFailureThreshold()
 	wg.Add(1)
 	go func() {
 		r.KeepNew(ctx)
@@ -84,61 +107,133 @@ func innerMain() error {
 	if err != nil {
 		return err
 	}
-	if err := os.MkdirAll(workingDirPath, 0777); err != nil {
+	if err := os.MkdirAll(cfg.WorkingDir, 0777); err != nil {
 		return err
 	}
 
+	history, err := affinity.Load(filepath.Join(cfg.WorkingDir, affinityFile))
+	if err != nil {
+		log.Printf("Ignoring DUT affinity history: %s", err)
+	}
+
 	a := agent.Agent{
 		Client: api.NewDronePRPCClient(&prpc.Client{
 			C:    h,
 			Host: queenService,
 		}),
-		SwarmingURL:       swarmingURL,
-		WorkingDir:        workingDirPath,
-		ReportingInterval: reportingInterval,
-		DUTCapacity:       dutCapacity,
-		StartBotFunc:      bot.NewStarter(h).Start,
+		SwarmingURL:       cfg.SwarmingURL,
+		WorkingDir:        cfg.WorkingDir,
+		ReportingInterval: cfg.ReportingInterval,
+		DUTCapacity:       cfg.DUTCapacity,
+		StartBotFunc:      startBotFunc(h),
 		Hive:              hive,
+		History:           history,
+		DrainDir:          cfg.WorkingDir,
 	}
-	a.Run(ctx)
-	return nil
-}
 
-const checkDrainingInterval = time.Minute
-
-// notifyDraining returns a context that is marked as draining when a
-// file exists at the given path.
-func notifyDraining(ctx context.Context, path string) context.Context {
-	ctx, drain := draining.WithDraining(ctx)
-	_, err := os.Stat(path)
-	if err == nil {
-		drain()
-		return ctx
+	m := &diskmon.Monitor{
+		Path:       cfg.WorkingDir,
+		Thresholds: diskThresholds(),
+		CleanupFunc: func() {
+			if err := a.CleanupBotDirs(); err != nil {
+				log.Printf("Error cleaning up bot dirs: %s", err)
+			}
+		},
+		DrainFunc: func() { draining.Drain(ctx) },
 	}
+	a.ThrottleFunc = func() bool { return m.Throttled() || th.Failing() }
+	wg.Add(1)
 	go func() {
-		for {
-			time.Sleep(checkDrainingInterval)
-			_, err := os.Stat(path)
-			if err == nil {
-				drain()
-				return
-			}
+		m.Run(ctx, func() time.Duration { return a.Config().ReportingInterval })
+		wg.Done()
+	}()
+	sh := status.NewHandler(func() status.Status {
+		return status.New(a.Status(), draining.IsDraining(ctx), r.Status())
+	})
+	wg.Add(1)
+	go func() {
+		if err := status.ListenAndServe(ctx, statusAddr(), sh); err != nil {
+			log.Printf("Error serving status: %s", err)
 		}
+		wg.Done()
 	}()
-	return ctx
+	notifySIGHUP(ctx, func() {
+		log.Printf("Reloading configuration")
+		cfg, err := loadConfig(configFile)
+		if err != nil {
+			log.Printf("Error reloading configuration, keeping current configuration: %s", err)
+			return
+		}
+		if f := a.Reload(cfg); len(f) > 0 {
+			log.Printf("Restart the agent to apply changes to %v", f)
+		}
+	})
+	a.Run(ctx)
+	return nil
 }
 
-// getIntEnv gets an int value from an environment variable.  If the
-// environment variable is not valid or is not set, use the default value.
-func getIntEnv(key string, defaultValue int) int {
-	v, ok := os.LookupEnv(key)
-	if !ok {
-		return defaultValue
+// startBotFunc returns the function used to start Swarming bots.
+// If DRONE_AGENT_BOT_CONTAINER_RUNTIME is set, bots are run in
+// containers.  If the container runtime is unavailable, bots are run
+// as processes instead.
+func startBotFunc(h *http.Client) func(bot.Config) (bot.Bot, error) {
+	if botContainerRuntime == "" {
+		return bot.NewStarter(h).Start
+	}
+	cc := bot.ContainerConfig{
+		Runtime:   botContainerRuntime,
+		Image:     botContainerImage,
+		TokenFile: oauthTokenPath,
+	}
+	for _, d := range strings.Split(botContainerDevices, ",") {
+		if d = strings.TrimSpace(d); d != "" {
+			cc.Devices = append(cc.Devices, d)
+		}
 	}
-	n, err := strconv.Atoi(v)
+	s, err := bot.NewContainerStarter(h, cc, bot.ExecCommander{})
 	if err != nil {
-		log.Printf("Invalid %s, using default value (error: %v)", key, err)
-		return defaultValue
+		log.Printf("WARNING: Running bots as processes, as bots cannot be run in containers: %s", err)
+		return bot.NewStarter(h).Start
+	}
+	return s.Start
+}
+
+// statusAddr returns the TCP address to serve the agent status and
+// health on, which defaults to a local only port.
+func statusAddr() string {
+	if statusAddrEnv != "" {
+		return statusAddrEnv
+	}
+	return "localhost:8087"
+}
+
+// tokenHook implements tokman.FailureHook.  While the access token
+// cannot be renewed, the agent stops accepting new DUTs, which tells
+// the queen that the drone cannot take on more work, so that no new
+// bots are started with a token which is about to expire.
+type tokenHook struct {
+	failing int32
+}
+
+// Failing returns true if the access token is failing to be renewed.
+// This is safe to call concurrently.
+func (h *tokenHook) Failing() bool {
+	return atomic.LoadInt32(&h.failing) != 0
+}
+
+// RenewFailed implements tokman.FailureHook.
+func (h *tokenHook) RenewFailed(failures int, err error) {
+	if atomic.SwapInt32(&h.failing, 1) == 0 {
+		log.Printf("Not accepting new DUTs after %d access token renewal failures: %s", failures, err)
 	}
-	return n
 }
+
+// RenewRecovered implements tokman.FailureHook.
+func (h *tokenHook) RenewRecovered() {
+	atomic.StoreInt32(&h.failing, 0)
+	log.Printf("Access token renewal recovered, accepting new DUTs")
+}
+
+// checkDrainingInterval is the interval for checking for the draining
+// file.
+const checkDrainingInterval = time.Minute
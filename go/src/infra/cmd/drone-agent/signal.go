@@ -28,3 +28,21 @@ func notifySIGTERM(ctx context.Context) context.Context {
 	}()
 	return ctx
 }
+
+// notifySIGHUP calls f each time SIGHUP is received, until the context
+// is canceled.
+func notifySIGHUP(ctx context.Context, f func()) {
+	ch := make(chan os.Signal, 1)
+	signal.Notify(ch, unix.SIGHUP)
+	go func() {
+		defer signal.Stop(ch)
+		for {
+			select {
+			case <-ch:
+				f()
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+}
@@ -13,3 +13,7 @@ import (
 func notifySIGTERM(ctx context.Context) context.Context {
 	panic("windows not supported")
 }
+
+func notifySIGHUP(ctx context.Context, f func()) {
+	panic("windows not supported")
+}
@@ -21,6 +21,8 @@ type MockSession struct {
 	Calls        []*MockCmd // Records command invocations
 	ReturnError  []error    // Errors to return by commands (default: nil)
 	ReturnOutput []string   // Stdout to return by commands (default: "")
+	// Contents of the -json-output files written by commands (default: none).
+	ReturnJSONOutput []string
 }
 
 // MockCmd mocks a single command invocation.
@@ -33,6 +35,8 @@ type MockCmd struct {
 	ReturnError   error  // Error to be returned by the invocation
 	ReturnOutput  string // Stdout to be return by the invocation
 	ConsumedStdin string // Result of reading Stdin (for reading in tests)
+	// Contents of the -json-output file written by the invocation, if set.
+	ReturnJSONOutput string
 }
 
 var _ Cmd = &MockCmd{}
@@ -62,6 +66,9 @@ func (s *MockSession) CommandContext(_ context.Context, executable string, args
 	if len(s.ReturnOutput) > len(s.Calls) {
 		c.ReturnOutput = s.ReturnOutput[len(s.Calls)]
 	}
+	if len(s.ReturnJSONOutput) > len(s.Calls) {
+		c.ReturnJSONOutput = s.ReturnJSONOutput[len(s.Calls)]
+	}
 	s.Calls = append(s.Calls, c)
 	return c
 }
@@ -74,6 +81,15 @@ func (c *MockCmd) Run() error {
 		}
 		c.ConsumedStdin = string(data)
 	}
+	if c.ReturnJSONOutput != "" {
+		for i, arg := range c.Args {
+			if arg == "-json-output" && i+1 < len(c.Args) {
+				if err := ioutil.WriteFile(c.Args[i+1], []byte(c.ReturnJSONOutput), 0600); err != nil {
+					return err
+				}
+			}
+		}
+	}
 	return c.ReturnError
 }
 
@@ -9,11 +9,14 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"strconv"
 	"strings"
+	"time"
 
 	"go.chromium.org/luci/common/errors"
-	"go.chromium.org/luci/common/logging"
 	"go.chromium.org/luci/common/system/filesystem"
+
+	"infra/cmd/mac_toolchain/installlock"
 )
 
 // InstallPackagesArgs are the parameters for installPackages() to keep them manageable.
@@ -25,6 +28,21 @@ type InstallPackagesArgs struct {
 	serviceAccountJSON string
 }
 
+// packageNamesForKind returns the names of the CIPD packages, relative to the
+// package prefix, that make up an installation of |kind|.
+func packageNamesForKind(kind KindType) ([]string, error) {
+	switch kind {
+	case macKind:
+		return []string{MacPackageName}, nil
+	case iosKind:
+		return []string{MacPackageName, IosPackageName}, nil
+	case iosRuntimeKind:
+		return []string{IosRuntimePackageName}, nil
+	default:
+		return nil, errors.Reason("unknown package kind: %s", kind).Tag(codeInvalidArgs).Err()
+	}
+}
+
 // Installs the cpid package to |rootPath| of specified |kind|, find package
 // as input |cipdPackagePrefix| & |ref|. These args are passed within
 // |InstallPackagesArgs| struct.
@@ -39,17 +57,25 @@ func installPackages(ctx context.Context, args InstallPackagesArgs) error {
 	cipdCheckArgs := append([]string{"puppet-check-updates"}, cipdArgs...)
 	cipdEnsureArgs := append([]string{"ensure"}, cipdArgs...)
 
+	packageNames, err := packageNamesForKind(args.kind)
+	if err != nil {
+		return err
+	}
+	packages := make([]*packageResult, len(packageNames))
 	ensureSpec := ""
-	switch args.kind {
-	case macKind:
-		ensureSpec += fmt.Sprintf("%s/%s %s\n", args.cipdPackagePrefix, MacPackageName, args.ref)
-	case iosKind:
-		ensureSpec += fmt.Sprintf("%s/%s %s\n%s/%s %s\n", args.cipdPackagePrefix, MacPackageName, args.ref, args.cipdPackagePrefix, IosPackageName, args.ref)
-	case iosRuntimeKind:
-		ensureSpec += fmt.Sprintf("%s/%s %s\n", args.cipdPackagePrefix, IosRuntimePackageName, args.ref)
-	default:
-		return errors.Reason("unknown package kind: %s", args.kind).Err()
+	for i, name := range packageNames {
+		packages[i] = &packageResult{
+			Package: args.cipdPackagePrefix + "/" + name,
+			Version: args.ref,
+			Path:    args.rootPath,
+		}
+		ensureSpec += fmt.Sprintf("%s %s\n", packages[i].Package, args.ref)
 	}
+	defer func() {
+		for _, p := range packages {
+			recordPackage(ctx, p)
+		}
+	}()
 
 	// Check if `cipd ensure` will do something. Note: `cipd puppet-check-updates`
 	// returns code 0 when `cipd ensure` has work to do, and "fails" otherwise.
@@ -69,19 +95,32 @@ func installPackages(ctx context.Context, args InstallPackagesArgs) error {
 		if _, statErr := os.Stat(binDirPath); !os.IsNotExist(statErr) {
 			return nil
 		}
-		logging.Warningf(ctx, "Contents/Developer/usr/bin doesn't exist in cached Xcode. Reinstalling Xcode.")
+		warningf(ctx, "Contents/Developer/usr/bin doesn't exist in cached Xcode. Reinstalling Xcode.")
 		// Remove and create an empty Xcode dir so `cipd ensure` will work to
 		// download a new one.
 		if removeErr := filesystem.RemoveAll(xcodeAppPath); removeErr != nil {
-			return errors.Annotate(removeErr, "failed to remove corrupted Xcode package.").Err()
+			return errors.Annotate(removeErr, "failed to remove corrupted Xcode package.").Tag(codeFilesystem).Err()
 		}
 		if err := os.MkdirAll(xcodeAppPath, 0700); err != nil {
-			return errors.Annotate(err, "failed to create a folder %s", xcodeAppPath).Err()
+			return errors.Annotate(err, "failed to create a folder %s", xcodeAppPath).Tag(codeFilesystem).Err()
 		}
 	}
 
-	if err := RunWithStdin(ctx, ensureSpec, "cipd", cipdEnsureArgs...); err != nil {
-		return errors.Annotate(err, "failed to install CIPD packages: %s", ensureSpec).Err()
+	// The installed instances are reported by subdir, which is always the
+	// root here.
+	var pins map[string][]cipdPin
+	err = runCipdWithJSONOutput(ctx, &pins, func(extraArgs []string) error {
+		return RunWithStdin(ctx, ensureSpec, "cipd", append(cipdEnsureArgs, extraArgs...)...)
+	})
+	if err != nil {
+		return errors.Annotate(err, "failed to install CIPD packages: %s", ensureSpec).Tag(codeCipdInstall).Err()
+	}
+	for _, pin := range pins[""] {
+		for _, p := range packages {
+			if p.Package == pin.Package {
+				p.InstanceID = pin.InstanceID
+			}
+		}
 	}
 	// Xcode really wants its files to be user-writable (hangs mysteriously
 	// otherwise). CIPD by default installs everything read-only. Update
@@ -90,7 +129,7 @@ func installPackages(ctx context.Context, args InstallPackagesArgs) error {
 	// TODO(sergeyberezin): remove this once crbug.com/803158 is resolved and all
 	// currently used Xcode versions are re-uploaded.
 	if err := RunCommand(ctx, "chmod", "-R", "u+w", args.rootPath); err != nil {
-		return errors.Annotate(err, "failed to update package permissions in %s for %s", args.rootPath, args.kind).Err()
+		return errors.Annotate(err, "failed to update package permissions in %s for %s", args.rootPath, args.kind).Tag(codeCipdInstall).Err()
 	}
 	return nil
 }
@@ -129,6 +168,21 @@ func getXcodePath(ctx context.Context) string {
 	return strings.Trim(path, " \n")
 }
 
+// getMacOSMajorVersion returns the major version of the host's macOS, e.g. 13
+// for macOS 13.2.1.
+func getMacOSMajorVersion(ctx context.Context) (int, error) {
+	out, err := RunOutput(ctx, "/usr/bin/sw_vers", "-productVersion")
+	if err != nil {
+		return 0, errors.Annotate(err, "failed to run /usr/bin/sw_vers -productVersion").Err()
+	}
+	version := strings.TrimSpace(out)
+	major, err := strconv.Atoi(strings.SplitN(version, ".", 2)[0])
+	if err != nil {
+		return 0, errors.Annotate(err, "unexpected macOS version %q", version).Err()
+	}
+	return major, nil
+}
+
 func setXcodePath(ctx context.Context, xcodeAppPath string) error {
 	err := RunCommand(ctx, "sudo", "/usr/bin/xcode-select", "-s", xcodeAppPath)
 	if err != nil {
@@ -158,35 +212,38 @@ func acceptLicense(ctx context.Context, xcodeAppPath string) error {
 		return RunCommand(ctx, "sudo", "/usr/bin/xcodebuild", "-license", "accept")
 	})
 	if err != nil {
-		return errors.Annotate(err, "failed to accept new license").Err()
+		return errors.Annotate(err, "failed to accept new license").Tag(codeLicense).Err()
 	}
 	return nil
 }
 
-func finalizeInstall(ctx context.Context, xcodeAppPath, xcodeVersion, packageInstallerOnBots string) error {
-	return RunWithXcodeSelect(ctx, xcodeAppPath, func() error {
-		err := RunCommand(ctx, "sudo", "/usr/bin/xcodebuild", "-runFirstLaunch")
-		if err != nil {
-			return errors.Annotate(err, "failed when invoking xcodebuild -runFirstLaunch").Err()
+func finalizeInstall(ctx context.Context, args InstallArgs) error {
+	err := RunWithXcodeSelect(ctx, args.xcodeAppPath, func() error {
+		if err := runPostInstallSteps(ctx, args); err != nil {
+			return err
 		}
 		// This command is needed to avoid a potential compile time issue.
-		_, err = RunOutput(ctx, "xcrun", "simctl", "list")
+		_, err := RunOutput(ctx, "xcrun", "simctl", "list")
 		if err != nil {
 			return errors.Annotate(err, "failed when invoking `xcrun simctl list`").Err()
 		}
 		return nil
 	})
+	if err != nil {
+		return errors.Annotate(err, "failed to finalize the installation").Tag(codePostInstall).Err()
+	}
+	return nil
 }
 
 func enableDeveloperMode(ctx context.Context) error {
 	out, err := RunOutput(ctx, "/usr/sbin/DevToolsSecurity", "-status")
 	if err != nil {
-		return errors.Annotate(err, "failed to run /usr/sbin/DevToolsSecurity -status").Err()
+		return errors.Annotate(err, "failed to run /usr/sbin/DevToolsSecurity -status").Tag(codeDeveloperMode).Err()
 	}
 	if !strings.Contains(out, "Developer mode is currently enabled.") {
 		err = RunCommand(ctx, "sudo", "/usr/sbin/DevToolsSecurity", "-enable")
 		if err != nil {
-			return errors.Annotate(err, "failed to run sudo /usr/sbin/DevToolsSecurity -enable").Err()
+			return errors.Annotate(err, "failed to run sudo /usr/sbin/DevToolsSecurity -enable").Tag(codeDeveloperMode).Err()
 		}
 	}
 	return nil
@@ -202,13 +259,45 @@ type InstallArgs struct {
 	serviceAccountJSON     string
 	packageInstallerOnBots string
 	withRuntime            bool
+	// Post-install steps. Completed steps are recorded in |installMarkerFile|,
+	// if set, and skipped by later installs of the same Xcode version.
+	runFirstLaunch     bool
+	installComponents  []string
+	installMarkerFile  string
+	postInstallTimeout time.Duration
+	postInstallRetries int
+	// How long to wait for another installation into |xcodeAppPath| to finish.
+	lockTimeout time.Duration
+}
+
+// lockInstallDir waits for other installations into |dir| to finish, and
+// prevents new ones from starting until the returned function is called.
+func lockInstallDir(ctx context.Context, dir string, timeout time.Duration) (unlock func(), err error) {
+	release, err := installlock.Acquire(ctx, dir, timeout)
+	switch {
+	case installlock.InProgress.In(err):
+		return nil, errors.Annotate(err, "failed to lock %s", dir).Tag(codeInstallInProgress).Err()
+	case err != nil:
+		return nil, errors.Annotate(err, "failed to lock %s", dir).Tag(codeFilesystem).Err()
+	}
+	return func() {
+		if err := release(); err != nil {
+			warningf(ctx, "Failed to unlock %s: %s", dir, err)
+		}
+	}, nil
 }
 
 // Installs Xcode. The default runtime of the Xcode version will be installed
 // unless |args.withRuntime| is False.
 func installXcode(ctx context.Context, args InstallArgs) error {
+	unlock, err := lockInstallDir(ctx, args.xcodeAppPath, args.lockTimeout)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
 	if err := os.MkdirAll(args.xcodeAppPath, 0700); err != nil {
-		return errors.Annotate(err, "failed to create a folder %s", args.xcodeAppPath).Err()
+		return errors.Annotate(err, "failed to create a folder %s", args.xcodeAppPath).Tag(codeFilesystem).Err()
 	}
 	installPackagesArgs := InstallPackagesArgs{
 		ref:                args.xcodeVersion,
@@ -217,7 +306,10 @@ func installXcode(ctx context.Context, args InstallArgs) error {
 		kind:               args.kind,
 		serviceAccountJSON: args.serviceAccountJSON,
 	}
-	if err := installPackages(ctx, installPackagesArgs); err != nil {
+	err = recordPhase(ctx, "install_xcode", func() error {
+		return installPackages(ctx, installPackagesArgs)
+	})
+	if err != nil {
 		return err
 	}
 	simulatorDirPath := filepath.Join(args.xcodeAppPath, XcodeIOSSimulatorRuntimeRelPath)
@@ -233,19 +325,28 @@ func installXcode(ctx context.Context, args InstallArgs) error {
 			cipdPackagePrefix:  args.cipdPackagePrefix,
 			serviceAccountJSON: args.serviceAccountJSON,
 		}
-		if err := installRuntime(ctx, runtimeInstallArgs); err != nil {
+		// The runtime folder is inside |xcodeAppPath|, which is already locked.
+		if err := installRuntimeLocked(ctx, runtimeInstallArgs); err != nil {
 			return err
 		}
 	}
 	if needToAcceptLicense(ctx, args.xcodeAppPath, args.acceptedLicensesFile) {
-		if err := acceptLicense(ctx, args.xcodeAppPath); err != nil {
+		err := recordPhase(ctx, "accept_license", func() error {
+			return acceptLicense(ctx, args.xcodeAppPath)
+		})
+		if err != nil {
 			return err
 		}
 	}
-	if err := finalizeInstall(ctx, args.xcodeAppPath, args.xcodeVersion, args.packageInstallerOnBots); err != nil {
+	err = recordPhase(ctx, "post_install", func() error {
+		return finalizeInstall(ctx, args)
+	})
+	if err != nil {
 		return err
 	}
-	return enableDeveloperMode(ctx)
+	return recordPhase(ctx, "enable_developer_mode", func() error {
+		return enableDeveloperMode(ctx)
+	})
 }
 
 // Tests whether the input |ref| exists as a ref in CIPD |packagePath|.
@@ -304,7 +405,7 @@ func resolveRuntimeRef(ctx context.Context, args ResolveRuntimeRefArgs) (string,
 		if err := resolveRef(ctx, args.packagePath, searchRef, args.serviceAccountJSON); err == nil { // if NO error
 			return searchRef, nil
 		} else {
-			logging.Warningf(ctx, "Failed to resolve ref: %s. Error: %s", searchRef, err.Error())
+			warningf(ctx, "Failed to resolve ref: %s. Error: %s", searchRef, err.Error())
 		}
 	}
 	err := errors.Reason("Failed to resolve runtime ref given runtime version: %s, xcode version: %s.", args.runtimeVersion, args.xcodeVersion).Err()
@@ -318,12 +419,25 @@ type RuntimeInstallArgs struct {
 	installPath        string
 	cipdPackagePrefix  string
 	serviceAccountJSON string
+	// How long to wait for another installation into |installPath| to finish.
+	lockTimeout time.Duration
 }
 
 // Resolves and installs the suitable runtime.
 func installRuntime(ctx context.Context, args RuntimeInstallArgs) error {
+	unlock, err := lockInstallDir(ctx, args.installPath, args.lockTimeout)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+	return installRuntimeLocked(ctx, args)
+}
+
+// installRuntimeLocked is installRuntime with |args.installPath| locked by
+// the caller.
+func installRuntimeLocked(ctx context.Context, args RuntimeInstallArgs) error {
 	if err := os.MkdirAll(args.installPath, 0700); err != nil {
-		return errors.Annotate(err, "failed to create a folder %s", args.installPath).Err()
+		return errors.Annotate(err, "failed to create a folder %s", args.installPath).Tag(codeFilesystem).Err()
 	}
 
 	packagePath := args.cipdPackagePrefix + "/" + IosRuntimePackageName
@@ -333,9 +447,13 @@ func installRuntime(ctx context.Context, args RuntimeInstallArgs) error {
 		packagePath:        packagePath,
 		serviceAccountJSON: args.serviceAccountJSON,
 	}
-	ref, err := resolveRuntimeRef(ctx, resolveRuntimeRefArgs)
+	var ref string
+	err := recordPhase(ctx, "resolve_runtime", func() (err error) {
+		ref, err = resolveRuntimeRef(ctx, resolveRuntimeRefArgs)
+		return
+	})
 	if err != nil {
-		return errors.Annotate(err, "failed to resolve runtime cipd ref. Xcode version: %s, runtime version: %s", args.xcodeVersion, args.runtimeVersion).Err()
+		return errors.Annotate(err, "failed to resolve runtime cipd ref. Xcode version: %s, runtime version: %s", args.xcodeVersion, args.runtimeVersion).Tag(codeCipdResolve).Err()
 	}
 	installPackagesArgs := InstallPackagesArgs{
 		ref:                ref,
@@ -344,8 +462,7 @@ func installRuntime(ctx context.Context, args RuntimeInstallArgs) error {
 		kind:               iosRuntimeKind,
 		serviceAccountJSON: args.serviceAccountJSON,
 	}
-	if err := installPackages(ctx, installPackagesArgs); err != nil {
-		return err
-	}
-	return nil
+	return recordPhase(ctx, "install_runtime", func() error {
+		return installPackages(ctx, installPackagesArgs)
+	})
 }
@@ -6,13 +6,17 @@ package main
 
 import (
 	"context"
+	"io/ioutil"
 	"os"
 	"path/filepath"
 	"testing"
 
 	"go.chromium.org/luci/common/errors"
 
+	"infra/cmd/mac_toolchain/installlock"
+
 	. "github.com/smartystreets/goconvey/convey"
+	. "go.chromium.org/luci/common/testing/assertions"
 )
 
 func TestInstallXcode(t *testing.T) {
@@ -30,6 +34,7 @@ func TestInstallXcode(t *testing.T) {
 			serviceAccountJSON:     "",
 			packageInstallerOnBots: "testdata/dummy_installer",
 			withRuntime:            false,
+			runFirstLaunch:         true,
 		}
 
 		Convey("for accepted license, mac", func() {
@@ -283,6 +288,7 @@ func TestInstallXcode(t *testing.T) {
 			serviceAccountJSON:     "",
 			packageInstallerOnBots: "testdata/dummy_installer",
 			withRuntime:            true,
+			runFirstLaunch:         true,
 		}
 
 		Convey("install with runtime", func() {
@@ -666,4 +672,39 @@ func TestInstallXcode(t *testing.T) {
 		})
 	})
 
+	Convey("installations into a locked folder fail", t, func() {
+		var s MockSession
+		ctx := useMockCmd(context.Background(), &s)
+		tmpDir, err := ioutil.TempDir("", "mac_toolchain")
+		So(err, ShouldBeNil)
+		defer os.RemoveAll(tmpDir)
+		installPath := filepath.Join(tmpDir, "Xcode.app")
+
+		release, err := installlock.Acquire(ctx, installPath, 0)
+		So(err, ShouldBeNil)
+		defer release()
+
+		Convey("for installXcode", func() {
+			err := installXcode(ctx, InstallArgs{
+				xcodeVersion:      "testVersion",
+				xcodeAppPath:      installPath,
+				cipdPackagePrefix: "test/prefix",
+				kind:              macKind,
+			})
+			So(err, ShouldErrLike, "is in progress")
+			So(errorCodeOf(err), ShouldEqual, codeInstallInProgress)
+			So(s.Calls, ShouldHaveLength, 0)
+		})
+
+		Convey("for installRuntime", func() {
+			err := installRuntime(ctx, RuntimeInstallArgs{
+				xcodeVersion:      "testVersion",
+				installPath:       installPath,
+				cipdPackagePrefix: "test/prefix",
+			})
+			So(err, ShouldErrLike, "is in progress")
+			So(errorCodeOf(err), ShouldEqual, codeInstallInProgress)
+			So(s.Calls, ShouldHaveLength, 0)
+		})
+	})
 }
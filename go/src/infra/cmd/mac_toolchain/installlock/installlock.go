@@ -0,0 +1,174 @@
+// Copyright 2021 The Chromium Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+// Package installlock implements advisory locks which serialize concurrent
+// installations into the same directory, e.g. by several tasks sharing a
+// bot's cache directory.
+//
+// The lock is a file next to the installation directory holding the pid of
+// the process which owns it. A lock left behind by a process which no longer
+// exists is broken automatically.
+package installlock
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.chromium.org/luci/common/clock"
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/common/logging"
+)
+
+// InProgress is attached to the error returned by Acquire when the lock is
+// still held by another live process after the timeout.
+var InProgress = errors.BoolTag{Key: errors.NewTagKey("another installation is in progress")}
+
+// PollInterval is how often Acquire checks whether a held lock was released.
+var PollInterval = time.Second
+
+// Path returns the path of the lock file guarding installations into dir.
+func Path(dir string) string {
+	dir = filepath.Clean(dir)
+	return filepath.Join(filepath.Dir(dir), "."+filepath.Base(dir)+".install.lock")
+}
+
+// Acquire grabs the lock guarding installations into dir and returns a
+// function which releases it.
+//
+// If another live process holds the lock, Acquire waits until it is
+// released, at most for timeout. Zero timeout means only one attempt is made.
+// Errors returned because the lock is still held are tagged with InProgress.
+func Acquire(ctx context.Context, dir string, timeout time.Duration) (release func() error, err error) {
+	path := Path(dir)
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return nil, errors.Annotate(err, "failed to create a folder for %s", path).Err()
+	}
+
+	deadline := clock.Now(ctx).Add(timeout)
+	for attempt := 1; ; attempt++ {
+		switch ok, err := tryLock(path); {
+		case err != nil:
+			return nil, err
+		case ok:
+			return func() error { return unlock(path) }, nil
+		}
+
+		pid, err := readPid(path)
+		switch {
+		case os.IsNotExist(err):
+			// Released in the meantime.
+			continue
+		case err != nil:
+			return nil, err
+		case !processExists(pid):
+			logging.Warningf(ctx, "Breaking the stale lock %s of process %d which no longer exists", path, pid)
+			if err := breakStale(path, pid); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		now := clock.Now(ctx)
+		if !now.Before(deadline) {
+			return nil, errors.Reason("another installation into %s is in progress (process %d holds %s); gave up after %s", dir, pid, path, timeout).Tag(InProgress).Err()
+		}
+		if attempt == 1 {
+			logging.Infof(ctx, "Another installation into %s is in progress (process %d), waiting up to %s...", dir, pid, timeout)
+		}
+		delay := PollInterval
+		if left := deadline.Sub(now); left < delay {
+			delay = left
+		}
+		if tr := clock.Sleep(ctx, delay); tr.Err != nil {
+			return nil, errors.Annotate(tr.Err, "waiting for %s", path).Err()
+		}
+	}
+}
+
+// tryLock creates the lock file holding the pid of the current process,
+// unless it already exists.
+//
+// The pid is written to a temporary file first, which is then linked into
+// place, so that other processes never observe a partially written lock.
+func tryLock(path string) (ok bool, err error) {
+	tmp := fmt.Sprintf("%s.%d.tmp", path, os.Getpid())
+	if err := ioutil.WriteFile(tmp, []byte(strconv.Itoa(os.Getpid())+"\n"), 0600); err != nil {
+		return false, errors.Annotate(err, "failed to write %s", tmp).Err()
+	}
+	defer os.Remove(tmp)
+
+	switch err := os.Link(tmp, path); {
+	case err == nil:
+		return true, nil
+	case os.IsExist(err):
+		return false, nil
+	default:
+		return false, errors.Annotate(err, "failed to create %s", path).Err()
+	}
+}
+
+// unlock removes the lock file, if it's still owned by the current process.
+func unlock(path string) error {
+	switch pid, err := readPid(path); {
+	case err != nil:
+		return err
+	case pid != os.Getpid():
+		return errors.Reason("%s is owned by process %d, not by the current process", path, pid).Err()
+	}
+	if err := os.Remove(path); err != nil {
+		return errors.Annotate(err, "failed to remove %s", path).Err()
+	}
+	return nil
+}
+
+// breakStale removes the lock file owned by the process |pid|.
+//
+// Several processes may find the same stale lock at once, and one of them may
+// grab the lock before another one breaks it. So the lock file is moved away
+// first, and put back if it turns out it's no longer the stale one.
+func breakStale(path string, pid int) error {
+	moved := fmt.Sprintf("%s.%d.stale", path, os.Getpid())
+	if err := os.Rename(path, moved); err != nil {
+		if os.IsNotExist(err) {
+			// Broken or released by another process.
+			return nil
+		}
+		return errors.Annotate(err, "failed to break %s", path).Err()
+	}
+	defer os.Remove(moved)
+
+	switch owner, err := readPid(moved); {
+	case err != nil:
+		return err
+	case owner != pid:
+		// Grabbed by another process in the meantime. If yet another process
+		// grabbed it after the file was moved, keep that one.
+		if err := os.Link(moved, path); err != nil && !os.IsExist(err) {
+			return errors.Annotate(err, "failed to restore %s", path).Err()
+		}
+	}
+	return nil
+}
+
+// readPid returns the pid of the process owning the lock file.
+func readPid(path string) (int, error) {
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return 0, err
+		}
+		return 0, errors.Annotate(err, "failed to read %s", path).Err()
+	}
+	pid, err := strconv.Atoi(strings.TrimSpace(string(b)))
+	if err != nil {
+		return 0, errors.Annotate(err, "malformed lock file %s", path).Err()
+	}
+	return pid, nil
+}
@@ -0,0 +1,168 @@
+// Copyright 2021 The Chromium Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package installlock
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+	. "go.chromium.org/luci/common/testing/assertions"
+)
+
+func init() {
+	PollInterval = 10 * time.Millisecond
+}
+
+// deadPid returns the pid of a process which has exited.
+func deadPid(t *testing.T) int {
+	cmd := exec.Command("true")
+	if err := cmd.Run(); err != nil {
+		t.Fatal(err)
+	}
+	return cmd.Process.Pid
+}
+
+func TestAcquire(t *testing.T) {
+	t.Parallel()
+
+	Convey("Acquire", t, func() {
+		ctx := context.Background()
+		tmpDir, err := ioutil.TempDir("", "installlock")
+		So(err, ShouldBeNil)
+		defer os.RemoveAll(tmpDir)
+		dir := filepath.Join(tmpDir, "cache", "Xcode.app")
+		lockPath := filepath.Join(tmpDir, "cache", ".Xcode.app.install.lock")
+
+		So(Path(dir), ShouldEqual, lockPath)
+		So(Path(dir+"/"), ShouldEqual, lockPath)
+
+		Convey("locks and releases", func() {
+			release, err := Acquire(ctx, dir, 0)
+			So(err, ShouldBeNil)
+			b, err := ioutil.ReadFile(lockPath)
+			So(err, ShouldBeNil)
+			So(string(b), ShouldEqual, strconv.Itoa(os.Getpid())+"\n")
+
+			So(release(), ShouldBeNil)
+			_, err = os.Stat(lockPath)
+			So(os.IsNotExist(err), ShouldBeTrue)
+
+			// The lock can be grabbed again.
+			release, err = Acquire(ctx, dir, 0)
+			So(err, ShouldBeNil)
+			So(release(), ShouldBeNil)
+		})
+
+		Convey("locks different directories independently", func() {
+			release1, err := Acquire(ctx, dir, 0)
+			So(err, ShouldBeNil)
+			defer release1()
+			release2, err := Acquire(ctx, filepath.Join(tmpDir, "cache", "Xcode2.app"), 0)
+			So(err, ShouldBeNil)
+			So(release2(), ShouldBeNil)
+		})
+
+		Convey("waits for the lock to be released", func() {
+			release, err := Acquire(ctx, dir, 0)
+			So(err, ShouldBeNil)
+
+			done := make(chan error)
+			go func() {
+				release, err := Acquire(ctx, dir, time.Minute)
+				if err == nil {
+					err = release()
+				}
+				done <- err
+			}()
+
+			time.Sleep(5 * PollInterval)
+			select {
+			case err := <-done:
+				t.Fatalf("grabbed the lock while it's held: %v", err)
+			default:
+			}
+			So(release(), ShouldBeNil)
+			So(<-done, ShouldBeNil)
+		})
+
+		Convey("times out", func() {
+			release, err := Acquire(ctx, dir, 0)
+			So(err, ShouldBeNil)
+			defer release()
+
+			Convey("immediately with zero timeout", func() {
+				_, err := Acquire(ctx, dir, 0)
+				So(err, ShouldErrLike, "is in progress")
+				So(InProgress.In(err), ShouldBeTrue)
+			})
+
+			Convey("after the timeout", func() {
+				start := time.Now()
+				_, err := Acquire(ctx, dir, 100*time.Millisecond)
+				So(err, ShouldErrLike, "gave up after 100ms")
+				So(InProgress.In(err), ShouldBeTrue)
+				So(time.Since(start), ShouldBeGreaterThanOrEqualTo, 100*time.Millisecond)
+			})
+
+			Convey("when the context is canceled", func() {
+				ctx, cancel := context.WithCancel(ctx)
+				cancel()
+				_, err := Acquire(ctx, dir, time.Minute)
+				So(err, ShouldErrLike, context.Canceled)
+				So(InProgress.In(err), ShouldBeFalse)
+			})
+		})
+
+		Convey("breaks a stale lock", func() {
+			So(os.MkdirAll(filepath.Dir(lockPath), 0700), ShouldBeNil)
+			So(ioutil.WriteFile(lockPath, []byte(strconv.Itoa(deadPid(t))+"\n"), 0600), ShouldBeNil)
+
+			release, err := Acquire(ctx, dir, 0)
+			So(err, ShouldBeNil)
+			b, err := ioutil.ReadFile(lockPath)
+			So(err, ShouldBeNil)
+			So(string(b), ShouldEqual, strconv.Itoa(os.Getpid())+"\n")
+			So(release(), ShouldBeNil)
+
+			// No leftovers.
+			files, err := ioutil.ReadDir(filepath.Dir(lockPath))
+			So(err, ShouldBeNil)
+			So(files, ShouldBeEmpty)
+		})
+
+		Convey("doesn't break a lock grabbed in the meantime", func() {
+			So(os.MkdirAll(filepath.Dir(lockPath), 0700), ShouldBeNil)
+			So(ioutil.WriteFile(lockPath, []byte(strconv.Itoa(os.Getpid())+"\n"), 0600), ShouldBeNil)
+
+			// Another process found a stale lock of a dead process, but the lock
+			// was released and grabbed again before the lock was broken.
+			So(breakStale(lockPath, deadPid(t)), ShouldBeNil)
+			b, err := ioutil.ReadFile(lockPath)
+			So(err, ShouldBeNil)
+			So(string(b), ShouldEqual, strconv.Itoa(os.Getpid())+"\n")
+		})
+
+		Convey("doesn't release a lock of another process", func() {
+			release, err := Acquire(ctx, dir, 0)
+			So(err, ShouldBeNil)
+			So(ioutil.WriteFile(lockPath, []byte("1\n"), 0600), ShouldBeNil)
+			So(release(), ShouldErrLike, "owned by process 1")
+		})
+
+		Convey("fails on a malformed lock", func() {
+			So(os.MkdirAll(filepath.Dir(lockPath), 0700), ShouldBeNil)
+			So(ioutil.WriteFile(lockPath, []byte("garbage"), 0600), ShouldBeNil)
+			_, err := Acquire(ctx, dir, 0)
+			So(err, ShouldErrLike, "malformed lock file")
+		})
+	})
+}
@@ -0,0 +1,19 @@
+// Copyright 2021 The Chromium Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+// +build !windows
+
+package installlock
+
+import (
+	"syscall"
+)
+
+// processExists returns whether the process |pid| exists.
+func processExists(pid int) bool {
+	// Signal 0 only checks whether the process could be signaled. EPERM means
+	// it exists, but belongs to another user.
+	err := syscall.Kill(pid, 0)
+	return err == nil || err == syscall.EPERM
+}
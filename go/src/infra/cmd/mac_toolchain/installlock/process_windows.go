@@ -0,0 +1,13 @@
+// Copyright 2021 The Chromium Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package installlock
+
+// processExists returns whether the process |pid| exists.
+//
+// Stale locks are not detected on Windows, where mac_toolchain doesn't
+// install anything.
+func processExists(pid int) bool {
+	return true
+}
@@ -6,13 +6,17 @@ package main
 
 import (
 	"context"
+	"encoding/json"
 	"flag"
 	"os"
+	"path/filepath"
 	"strings"
+	"time"
 
 	"github.com/maruel/subcommands"
 	"go.chromium.org/luci/common/cli"
 	"go.chromium.org/luci/common/errors"
+	luciflag "go.chromium.org/luci/common/flag"
 	"go.chromium.org/luci/common/flag/flagenum"
 	"go.chromium.org/luci/common/logging"
 	"go.chromium.org/luci/common/logging/gologger"
@@ -34,6 +38,10 @@ const XcodeIOSSimulatorRuntimeRelPath = "Contents/Developer/Platforms/iPhoneOS.p
 // Filename of default simulator runtime in Xcode package.
 const XcodeIOSSimulatorRuntimeFilename = "iOS.simruntime"
 
+// DefaultLockTimeout is how long an installation waits by default for another
+// installation into the same folder to finish.
+const DefaultLockTimeout = 30 * time.Minute
+
 // Package name of iOS runtime in CIPD.
 const IosRuntimePackageName = "ios_runtime"
 
@@ -77,6 +85,7 @@ type commonFlags struct {
 	subcommands.CommandRunBase
 	verbose           bool
 	cipdPackagePrefix string
+	jsonOutput        string
 }
 
 type installRun struct {
@@ -86,6 +95,21 @@ type installRun struct {
 	kind               KindType
 	serviceAccountJSON string
 	withRuntime        bool
+	runFirstLaunch     bool
+	installComponents  []string
+	postInstallTimeout time.Duration
+	postInstallRetries int
+	lockTimeout        time.Duration
+}
+
+type verifyRun struct {
+	commonFlags
+	xcodeVersion       string
+	xcodePath          string
+	kind               KindType
+	serviceAccountJSON string
+	withRuntime        bool
+	json               bool
 }
 
 type uploadRun struct {
@@ -119,6 +143,30 @@ type installRuntimeRun struct {
 	xcodeVersion       string
 	outputDir          string
 	serviceAccountJSON string
+	lockTimeout        time.Duration
+}
+
+// isFlagSet returns whether the flag |name| was set on the command line.
+func isFlagSet(flags *flag.FlagSet, name string) bool {
+	set := false
+	flags.Visit(func(f *flag.Flag) {
+		if f.Name == name {
+			set = true
+		}
+	})
+	return set
+}
+
+// defaultRunFirstLaunch returns whether `xcodebuild -runFirstLaunch` runs after
+// installing Xcode when -run-first-launch isn't specified. Newer Xcodes require
+// it on macOS 13 and later.
+func defaultRunFirstLaunch(ctx context.Context) bool {
+	major, err := getMacOSMajorVersion(ctx)
+	if err != nil {
+		warningf(ctx, "Failed to get the macOS version, running first launch anyway: %s", err)
+		return true
+	}
+	return major >= 13
 }
 
 func stripLastTrailingSlash(prefix string) string {
@@ -141,13 +189,20 @@ func (c *commonFlags) ModifyContext(ctx context.Context) context.Context {
 // "-with-runtime=False" is passed in explicitly.
 func (c *installRun) Run(a subcommands.Application, args []string, env subcommands.Env) int {
 	ctx := cli.GetContext(a, c, env)
+	return c.runOperation(ctx, "install", c.execute)
+}
+
+func (c *installRun) execute(ctx context.Context) error {
+	updateResult(ctx, func(r *result) {
+		r.XcodeVersion = c.xcodeVersion
+		r.Kind = string(c.kind)
+		r.InstallPath = c.outputDir
+	})
 	if c.xcodeVersion == "" {
-		errors.Log(ctx, errors.Reason("no Xcode version specified (-xcode-version)").Err())
-		return 1
+		return errors.Reason("no Xcode version specified (-xcode-version)").Tag(codeInvalidArgs).Err()
 	}
 	if c.outputDir == "" {
-		errors.Log(ctx, errors.Reason("no output folder specified (-output-dir)").Err())
-		return 1
+		return errors.Reason("no output folder specified (-output-dir)").Tag(codeInvalidArgs).Err()
 	}
 	logging.Infof(ctx, "About to install Xcode %s in %s for %s", c.xcodeVersion, c.outputDir, c.kind.String())
 
@@ -161,21 +216,92 @@ func (c *installRun) Run(a subcommands.Application, args []string, env subcomman
 		serviceAccountJSON:     c.serviceAccountJSON,
 		packageInstallerOnBots: PackageInstallerOnBots,
 		withRuntime:            c.withRuntime && c.kind == iosKind,
+		runFirstLaunch:         c.runFirstLaunch,
+		installComponents:      c.installComponents,
+		installMarkerFile:      filepath.Join(c.outputDir, InstallMarkerFilename),
+		postInstallTimeout:     c.postInstallTimeout,
+		postInstallRetries:     c.postInstallRetries,
+		lockTimeout:            c.lockTimeout,
 	}
-	if err := installXcode(ctx, installArgs); err != nil {
-		errors.Log(ctx, err)
-		return 1
+	if !isFlagSet(&c.Flags, "run-first-launch") {
+		installArgs.runFirstLaunch = defaultRunFirstLaunch(ctx)
 	}
-	return 0
+	return installXcode(ctx, installArgs)
+}
+
+// Entrance function to verify an installed Xcode for "verify" cmd line switch.
+// Prints the differences between the installed files and the CIPD packages,
+// and fails if there are any.
+func (c *verifyRun) Run(a subcommands.Application, args []string, env subcommands.Env) int {
+	ctx := cli.GetContext(a, c, env)
+	return c.runOperation(ctx, "verify", c.execute)
+}
+
+func (c *verifyRun) execute(ctx context.Context) error {
+	updateResult(ctx, func(r *result) {
+		r.XcodeVersion = c.xcodeVersion
+		r.Kind = string(c.kind)
+		r.InstallPath = c.xcodePath
+	})
+	if c.xcodeVersion == "" {
+		return errors.Reason("no Xcode version specified (-xcode-version)").Tag(codeInvalidArgs).Err()
+	}
+	if c.xcodePath == "" {
+		return errors.Reason("path to Xcode.app is not specified (-xcode-path)").Tag(codeInvalidArgs).Err()
+	}
+
+	c.cipdPackagePrefix = stripLastTrailingSlash(c.cipdPackagePrefix)
+	verifyArgs := VerifyArgs{
+		xcodeVersion:       c.xcodeVersion,
+		xcodeAppPath:       c.xcodePath,
+		cipdPackagePrefix:  c.cipdPackagePrefix,
+		kind:               c.kind,
+		serviceAccountJSON: c.serviceAccountJSON,
+		withRuntime:        c.withRuntime && c.kind == iosKind,
+	}
+	var report *verifyReport
+	err := recordPhase(ctx, "verify_xcode", func() (err error) {
+		report, err = verifyXcode(ctx, verifyArgs)
+		return
+	})
+	if err != nil {
+		return err
+	}
+	updateResult(ctx, func(r *result) {
+		r.Packages = report.Packages
+		r.Verification = report
+	})
+
+	if c.json {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		err = enc.Encode(report)
+	} else {
+		err = printVerifyReport(os.Stdout, report)
+	}
+	if err != nil {
+		return errors.Annotate(err, "failed to print the verification report").Err()
+	}
+	if !report.OK {
+		return errors.Reason("Xcode in %s doesn't match the CIPD packages: %d missing, %d modified, %d extra files",
+			c.xcodePath, len(report.Missing), len(report.Modified), len(report.Extra)).Tag(codeVerifyMismatch).Err()
+	}
+	return nil
 }
 
 // Entrance function to upload an Xcode for "upload" cmd line switch. Also uploads
 // the iOS runtime package within the Xcode.
 func (c *uploadRun) Run(a subcommands.Application, args []string, env subcommands.Env) int {
 	ctx := cli.GetContext(a, c, env)
+	return c.runOperation(ctx, "upload", c.execute)
+}
+
+func (c *uploadRun) execute(ctx context.Context) error {
+	updateResult(ctx, func(r *result) {
+		r.SourcePath = c.xcodePath
+	})
 	if c.xcodePath == "" {
-		errors.Log(ctx, errors.Reason("path to Xcode.app is not specified (-xcode-path)").Err())
-		return 1
+		return errors.Reason("path to Xcode.app is not specified (-xcode-path)").Tag(codeInvalidArgs).Err()
 	}
 	c.cipdPackagePrefix = stripLastTrailingSlash(c.cipdPackagePrefix)
 	packageRuntimeAndXcodeArgs := PackageRuntimeAndXcodeArgs{
@@ -185,24 +311,26 @@ func (c *uploadRun) Run(a subcommands.Application, args []string, env subcommand
 		outputDir:          "",
 		skipRefTag:         c.skipRefTag,
 	}
-	if err := packageRuntimeAndXcode(ctx, packageRuntimeAndXcodeArgs); err != nil {
-		errors.Log(ctx, err)
-		return 1
-	}
-	return 0
+	return packageRuntimeAndXcode(ctx, packageRuntimeAndXcodeArgs)
 }
 
 // Entrance function to locally package an Xcode for "package" cmd line switch.
 // Also packages the iOS runtime package within the Xcode.
 func (c *packageRun) Run(a subcommands.Application, args []string, env subcommands.Env) int {
 	ctx := cli.GetContext(a, c, env)
+	return c.runOperation(ctx, "package", c.execute)
+}
+
+func (c *packageRun) execute(ctx context.Context) error {
+	updateResult(ctx, func(r *result) {
+		r.SourcePath = c.xcodePath
+		r.OutputDir = c.outputDir
+	})
 	if c.xcodePath == "" {
-		errors.Log(ctx, errors.Reason("path to Xcode.app is not specified (-xcode-path)").Err())
-		return 1
+		return errors.Reason("path to Xcode.app is not specified (-xcode-path)").Tag(codeInvalidArgs).Err()
 	}
 	if c.outputDir == "" {
-		errors.Log(ctx, errors.Reason("output directory is not specified (-output-dir)").Err())
-		return 1
+		return errors.Reason("output directory is not specified (-output-dir)").Tag(codeInvalidArgs).Err()
 	}
 	c.cipdPackagePrefix = stripLastTrailingSlash(c.cipdPackagePrefix)
 	packageRuntimeAndXcodeArgs := PackageRuntimeAndXcodeArgs{
@@ -212,19 +340,21 @@ func (c *packageRun) Run(a subcommands.Application, args []string, env subcomman
 		outputDir:          c.outputDir,
 		skipRefTag:         false,
 	}
-	if err := packageRuntimeAndXcode(ctx, packageRuntimeAndXcodeArgs); err != nil {
-		errors.Log(ctx, err)
-		return 1
-	}
-	return 0
+	return packageRuntimeAndXcode(ctx, packageRuntimeAndXcodeArgs)
 }
 
 // Entrance function to upload a runtime for upload-runtime cmd line switch.
 func (c *uploadRuntimeRun) Run(a subcommands.Application, args []string, env subcommands.Env) int {
 	ctx := cli.GetContext(a, c, env)
+	return c.runOperation(ctx, "upload-runtime", c.execute)
+}
+
+func (c *uploadRuntimeRun) execute(ctx context.Context) error {
+	updateResult(ctx, func(r *result) {
+		r.SourcePath = c.runtimePath
+	})
 	if c.runtimePath == "" {
-		errors.Log(ctx, errors.Reason("path to iOS runtime is not specified (-runtime-path)").Err())
-		return 1
+		return errors.Reason("path to iOS runtime is not specified (-runtime-path)").Tag(codeInvalidArgs).Err()
 	}
 
 	packageRuntimeArgs := PackageRuntimeArgs{
@@ -234,24 +364,26 @@ func (c *uploadRuntimeRun) Run(a subcommands.Application, args []string, env sub
 		serviceAccountJSON: c.serviceAccountJSON,
 		outputDir:          "",
 	}
-	if err := packageRuntime(ctx, packageRuntimeArgs); err != nil {
-		errors.Log(ctx, err)
-		return 1
-	}
-	return 0
+	return packageRuntime(ctx, packageRuntimeArgs)
 }
 
 // Entrance function to package a runtime locally for package-runtime cmd line
 // switch.
 func (c *packageRuntimeRun) Run(a subcommands.Application, args []string, env subcommands.Env) int {
 	ctx := cli.GetContext(a, c, env)
+	return c.runOperation(ctx, "package-runtime", c.execute)
+}
+
+func (c *packageRuntimeRun) execute(ctx context.Context) error {
+	updateResult(ctx, func(r *result) {
+		r.SourcePath = c.runtimePath
+		r.OutputDir = c.outputDir
+	})
 	if c.runtimePath == "" {
-		errors.Log(ctx, errors.Reason("path to iOS runtime is not specified (-runtime-path)").Err())
-		return 1
+		return errors.Reason("path to iOS runtime is not specified (-runtime-path)").Tag(codeInvalidArgs).Err()
 	}
 	if c.outputDir == "" {
-		errors.Log(ctx, errors.Reason("output directory is not specified (-output-dir)").Err())
-		return 1
+		return errors.Reason("output directory is not specified (-output-dir)").Tag(codeInvalidArgs).Err()
 	}
 
 	packageRuntimeArgs := PackageRuntimeArgs{
@@ -261,23 +393,26 @@ func (c *packageRuntimeRun) Run(a subcommands.Application, args []string, env su
 		serviceAccountJSON: "",
 		outputDir:          c.outputDir,
 	}
-	if err := packageRuntime(ctx, packageRuntimeArgs); err != nil {
-		errors.Log(ctx, err)
-		return 1
-	}
-	return 0
+	return packageRuntime(ctx, packageRuntimeArgs)
 }
 
 // Entrance function to install a runtime for install-runtime cmd line switch.
 func (c *installRuntimeRun) Run(a subcommands.Application, args []string, env subcommands.Env) int {
 	ctx := cli.GetContext(a, c, env)
+	return c.runOperation(ctx, "install-runtime", c.execute)
+}
+
+func (c *installRuntimeRun) execute(ctx context.Context) error {
+	updateResult(ctx, func(r *result) {
+		r.RuntimeVersion = c.runtimeVersion
+		r.XcodeVersion = c.xcodeVersion
+		r.InstallPath = c.outputDir
+	})
 	if c.runtimeVersion == "" && c.xcodeVersion == "" {
-		errors.Log(ctx, errors.Reason("no runtime or xcode version specified").Err())
-		return 1
+		return errors.Reason("no runtime or xcode version specified").Tag(codeInvalidArgs).Err()
 	}
 	if c.outputDir == "" {
-		errors.Log(ctx, errors.Reason("no output folder specified (-output-dir)").Err())
-		return 1
+		return errors.Reason("no output folder specified (-output-dir)").Tag(codeInvalidArgs).Err()
 	}
 	logging.Infof(ctx, "About to install runtime %s %s to %s", c.runtimeVersion, c.xcodeVersion, c.outputDir)
 
@@ -289,17 +424,15 @@ func (c *installRuntimeRun) Run(a subcommands.Application, args []string, env su
 		installPath:        c.outputDir,
 		cipdPackagePrefix:  c.cipdPackagePrefix,
 		serviceAccountJSON: c.serviceAccountJSON,
+		lockTimeout:        c.lockTimeout,
 	}
-	if err := installRuntime(ctx, runtimeInstallArgs); err != nil {
-		errors.Log(ctx, err)
-		return 1
-	}
-	return 0
+	return installRuntime(ctx, runtimeInstallArgs)
 }
 
 func commonFlagVars(c *commonFlags) {
 	c.Flags.BoolVar(&c.verbose, "verbose", false, "Log more.")
 	c.Flags.StringVar(&c.cipdPackagePrefix, "cipd-package-prefix", DefaultCipdPackagePrefix, "CIPD package prefix.")
+	c.Flags.StringVar(&c.jsonOutput, "json-output", "", "Path to write a JSON document describing the result of the command to.")
 }
 
 func installFlagVars(c *installRun) {
@@ -309,6 +442,22 @@ func installFlagVars(c *installRun) {
 	c.Flags.StringVar(&c.serviceAccountJSON, "service-account-json", "", "Service account to use for authentication.")
 	c.Flags.Var(&c.kind, "kind", "Installation kind: "+KindTypeEnum.Choices()+". (default: \""+string(DefaultKind)+"\")")
 	c.Flags.BoolVar(&c.withRuntime, "with-runtime", true, "Whether to install the default iOS runtime to Xcode. Only works in ios kind.")
+	c.Flags.BoolVar(&c.runFirstLaunch, "run-first-launch", false, "Whether to run `xcodebuild -runFirstLaunch` after installing Xcode. (default: true on macOS 13 and later)")
+	c.Flags.Var(luciflag.CommaList(&c.installComponents), "install-components", "Comma-separated list of additional system components to install from the packages in Xcode.app/"+XcodeComponentPackagesRelPath+", e.g. \"MobileDevice,CoreTypes\".")
+	c.Flags.DurationVar(&c.postInstallTimeout, "post-install-timeout", 10*time.Minute, "Timeout of each attempt of a post-install step. 0 means no timeout.")
+	c.Flags.IntVar(&c.postInstallRetries, "post-install-retries", 2, "Number of times to retry a failed post-install step.")
+	c.Flags.DurationVar(&c.lockTimeout, "lock-timeout", DefaultLockTimeout, "How long to wait for another installation into -output-dir to finish.")
+	c.kind = DefaultKind
+}
+
+func verifyFlagVars(c *verifyRun) {
+	commonFlagVars(&c.commonFlags)
+	c.Flags.StringVar(&c.xcodeVersion, "xcode-version", "", "Xcode version code. (required)")
+	c.Flags.StringVar(&c.xcodePath, "xcode-path", "", "Path to the installed Xcode.app to verify. (required)")
+	c.Flags.StringVar(&c.serviceAccountJSON, "service-account-json", "", "Service account to use for authentication.")
+	c.Flags.Var(&c.kind, "kind", "Installation kind: "+KindTypeEnum.Choices()+". (default: \""+string(DefaultKind)+"\")")
+	c.Flags.BoolVar(&c.withRuntime, "with-runtime", true, "Whether to verify the default iOS runtime in Xcode. Only works in ios kind. If false, files in the runtime folder are ignored.")
+	c.Flags.BoolVar(&c.json, "json", false, "Print the differences as a JSON document instead of text.")
 	c.kind = DefaultKind
 }
 
@@ -343,6 +492,7 @@ func installRuntimeFlagVars(c *installRuntimeRun) {
 	c.Flags.StringVar(&c.xcodeVersion, "xcode-version", "", "Xcode version code.")
 	c.Flags.StringVar(&c.outputDir, "output-dir", "", "Path where to install the runtime (required).")
 	c.Flags.StringVar(&c.serviceAccountJSON, "service-account-json", "", "Service account to use for authentication.")
+	c.Flags.DurationVar(&c.lockTimeout, "lock-timeout", DefaultLockTimeout, "How long to wait for another installation into -output-dir to finish.")
 }
 
 var (
@@ -365,6 +515,24 @@ requested is uploaded with it's runtime separated from Xcode package.`,
 		},
 	}
 
+	cmdVerify = &subcommands.Command{
+		UsageLine: "verify <options>",
+		ShortDesc: "Verifies an installed Xcode.",
+		LongDesc: `Verifies that an installed Xcode matches its CIPD packages.
+
+Fetches the packages that "install" would install for the same -xcode-version
+and -kind, and compares them with the files in -xcode-path by their contents.
+Prints the missing, modified and extra files, and fails if there are any.
+
+-with-runtime=false ignores the simulator runtime folder, for Xcode installed
+without a runtime.`,
+		CommandRun: func() subcommands.CommandRun {
+			c := &verifyRun{}
+			verifyFlagVars(c)
+			return c
+		},
+	}
+
 	cmdUpload = &subcommands.Command{
 		UsageLine: "upload <options>",
 		ShortDesc: "Uploads Xcode CIPD packages.",
@@ -446,6 +614,7 @@ func main() {
 		Commands: []*subcommands.Command{
 			subcommands.CmdHelp,
 			cmdInstall,
+			cmdVerify,
 			cmdUpload,
 			cmdPackage,
 			cmdUploadRuntime,
@@ -166,7 +166,7 @@ func makeXcodePackages(xcodeAppPath string, cipdPackagePrefix string) (p Package
 func buildCipdPackages(packages Packages, buildFn func(PackageSpec) error) error {
 	tmpDir, err := ioutil.TempDir("", "mac_toolchain_")
 	if err != nil {
-		return errors.Annotate(err, "cannot create a temporary folder for CIPD package configuration files in %s", os.TempDir()).Err()
+		return errors.Annotate(err, "cannot create a temporary folder for CIPD package configuration files in %s", os.TempDir()).Tag(codeFilesystem).Err()
 	}
 	defer os.RemoveAll(tmpDir)
 
@@ -181,11 +181,11 @@ func buildCipdPackages(packages Packages, buildFn func(PackageSpec) error) error
 		p := packages[name]
 		yamlBytes, err := yaml.Marshal(p)
 		if err != nil {
-			return errors.Annotate(err, "failed to serialize %s.yaml", name).Err()
+			return errors.Annotate(err, "failed to serialize %s.yaml", name).Tag(codePackageBuild).Err()
 		}
 		yamlPath := filepath.Join(tmpDir, name+".yaml")
 		if err = ioutil.WriteFile(yamlPath, yamlBytes, 0600); err != nil {
-			return errors.Annotate(err, "failed to write package definition file %s", yamlPath).Err()
+			return errors.Annotate(err, "failed to write package definition file %s", yamlPath).Tag(codeFilesystem).Err()
 		}
 		if err = buildFn(PackageSpec{Name: p.Package, YamlPath: yamlPath}); err != nil {
 			return err
@@ -197,15 +197,17 @@ func buildCipdPackages(packages Packages, buildFn func(PackageSpec) error) error
 func createBuilder(ctx context.Context, tags []string, refs []string, serviceAccountJSON, outputDir string) func(PackageSpec) error {
 	builder := func(p PackageSpec) error {
 		args := []string{}
+		pkg := &packageResult{Package: p.Name}
 		if outputDir != "" {
 			pkgParts := strings.Split(p.Name, "/")
 			fileName := pkgParts[len(pkgParts)-1] + ".cipd"
+			pkg.Path = filepath.Join(outputDir, fileName)
 			args = append(args, "pkg-build",
-				"-out", filepath.Join(outputDir, fileName),
+				"-out", pkg.Path,
 			)
 			// Ensure outputDir exists. MkdirAll returns nil if path already exists.
 			if err := os.MkdirAll(outputDir, 0777); err != nil {
-				return errors.Annotate(err, "failed to create output directory %s", outputDir).Err()
+				return errors.Annotate(err, "failed to create output directory %s", outputDir).Tag(codeFilesystem).Err()
 			}
 		} else {
 			args = append(args,
@@ -213,9 +215,11 @@ func createBuilder(ctx context.Context, tags []string, refs []string, serviceAcc
 			)
 			for _, tag := range tags {
 				args = append(args, "-tag", tag)
+				pkg.Tags = append(pkg.Tags, tag)
 			}
 			for _, ref := range refs {
 				args = append(args, "-ref", strings.ToLower(ref))
+				pkg.Refs = append(pkg.Refs, strings.ToLower(ref))
 			}
 		}
 		args = append(args, "-pkg-def", p.YamlPath)
@@ -225,9 +229,15 @@ func createBuilder(ctx context.Context, tags []string, refs []string, serviceAcc
 
 		logging.Infof(ctx, "Creating a CIPD package %s", p.Name)
 		logging.Debugf(ctx, "Running cipd %s", strings.Join(args, " "))
-		if err := RunCommand(ctx, "cipd", args...); err != nil {
-			return errors.Annotate(err, "creating a CIPD package failed.").Err()
+		var pin cipdPin
+		err := runCipdWithJSONOutput(ctx, &pin, func(extraArgs []string) error {
+			return RunCommand(ctx, "cipd", append(args, extraArgs...)...)
+		})
+		if err != nil {
+			return errors.Annotate(err, "creating a CIPD package failed.").Tag(codePackageBuild).Err()
 		}
+		pkg.InstanceID = pin.InstanceID
+		recordPackage(ctx, pkg)
 		return nil
 	}
 	return builder
@@ -246,12 +256,15 @@ type PackageXcodeArgs struct {
 func packageXcode(ctx context.Context, args PackageXcodeArgs) error {
 	xcodeVersion, buildVersion, err := getXcodeVersion(filepath.Join(args.xcodeAppPath, "Contents", "version.plist"))
 	if err != nil {
-		return errors.Annotate(err, "this doesn't look like a valid Xcode.app folder: %s", args.xcodeAppPath).Err()
+		return errors.Annotate(err, "this doesn't look like a valid Xcode.app folder: %s", args.xcodeAppPath).Tag(codeInvalidBundle).Err()
 	}
+	updateResult(ctx, func(r *result) {
+		r.XcodeVersion = xcodeVersion
+	})
 
 	packages, err := makeXcodePackages(args.xcodeAppPath, args.cipdPackagePrefix)
 	if err != nil {
-		return err
+		return errors.Annotate(err, "failed to make Xcode package definitions").Tag(codeInvalidBundle).Err()
 	}
 	tags := []string{
 		"xcode_version:" + xcodeVersion,
@@ -269,7 +282,10 @@ func packageXcode(ctx context.Context, args PackageXcodeArgs) error {
 
 	buildFn := createBuilder(ctx, tags, refs, args.serviceAccountJSON, args.outputDir)
 
-	if err = buildCipdPackages(packages, buildFn); err != nil {
+	err = recordPhase(ctx, "package_xcode", func() error {
+		return buildCipdPackages(packages, buildFn)
+	})
+	if err != nil {
 		return err
 	}
 
@@ -341,7 +357,7 @@ func packageRuntime(ctx context.Context, args PackageRuntimeArgs) error {
 		var err error
 		_, xcodeBuildVersion, err = getXcodeVersion(filepath.Join(args.xcodeAppPath, "Contents", "version.plist"))
 		if err != nil {
-			return errors.Annotate(err, "this doesn't look like a valid Xcode.app folder: %s", args.xcodeAppPath).Err()
+			return errors.Annotate(err, "this doesn't look like a valid Xcode.app folder: %s", args.xcodeAppPath).Tag(codeInvalidBundle).Err()
 		}
 	}
 
@@ -354,13 +370,16 @@ func packageRuntime(ctx context.Context, args PackageRuntimeArgs) error {
 	}
 	pkg, err := makePackage(runtimeMakePackageArgs)
 	if err != nil {
-		return errors.Annotate(err, "failed to create cipd package definition for %s/%s", runtimeDir, runtimeFileName).Err()
+		return errors.Annotate(err, "failed to create cipd package definition for %s/%s", runtimeDir, runtimeFileName).Tag(codeInvalidBundle).Err()
 	}
 
 	runtimeName, runtimeID, err := getSimulatorVersion(filepath.Join(runtimeDir, runtimeFileName, "Contents", "Info.plist"))
 	if err != nil {
-		return errors.Annotate(err, "failed to get simulator info from %s/%s/Contents/Info.plist", runtimeDir, runtimeFileName).Err()
+		return errors.Annotate(err, "failed to get simulator info from %s/%s/Contents/Info.plist", runtimeDir, runtimeFileName).Tag(codeInvalidBundle).Err()
 	}
+	updateResult(ctx, func(r *result) {
+		r.RuntimeVersion = runtimeID
+	})
 
 	tags := []string{
 		"ios_runtime_version:" + runtimeName,
@@ -397,7 +416,10 @@ func packageRuntime(ctx context.Context, args PackageRuntimeArgs) error {
 
 	buildFn := createBuilder(ctx, tags, refs, args.serviceAccountJSON, args.outputDir)
 
-	if err = buildCipdPackages(Packages{runtimeID: pkg}, buildFn); err != nil {
+	err = recordPhase(ctx, "package_runtime", func() error {
+		return buildCipdPackages(Packages{runtimeID: pkg}, buildFn)
+	})
+	if err != nil {
 		return err
 	}
 
@@ -0,0 +1,213 @@
+// Copyright 2021 The Chromium Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	"go.chromium.org/luci/common/clock"
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/common/logging"
+)
+
+// InstallMarkerFilename is the name of the file in the Xcode installation
+// folder which records the post-install steps completed for the installed
+// Xcode version.
+const InstallMarkerFilename = ".mac_toolchain_install.json"
+
+// Relative path from Xcode.app where the packages of additional system
+// components are stored.
+const XcodeComponentPackagesRelPath = "Contents/Resources/Packages"
+
+// Names of the post-install steps, as recorded in the install marker.
+const (
+	firstLaunchStep            = "run-first-launch"
+	installComponentStepPrefix = "install-component:"
+)
+
+// postInstallRetryDelay is the time to wait before retrying a failed
+// post-install step.
+const postInstallRetryDelay = 10 * time.Second
+
+// installMarker records the post-install steps completed for an Xcode
+// installation, so re-runs of install can skip them.
+type installMarker struct {
+	XcodeVersion   string   `json:"xcode_version"`
+	CompletedSteps []string `json:"completed_post_install_steps"`
+}
+
+// completed returns whether the post-install |step| has completed.
+func (m *installMarker) completed(step string) bool {
+	for _, s := range m.CompletedSteps {
+		if s == step {
+			return true
+		}
+	}
+	return false
+}
+
+// readInstallMarker reads the install marker at |path|. A marker for another
+// Xcode version, or one which cannot be read, is ignored and an empty marker
+// for |xcodeVersion| is returned instead.
+func readInstallMarker(ctx context.Context, path, xcodeVersion string) *installMarker {
+	empty := &installMarker{XcodeVersion: xcodeVersion}
+	if path == "" {
+		return empty
+	}
+	data, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return empty
+	}
+	if err != nil {
+		warningf(ctx, "Failed to read install marker %s, ignoring it: %s", path, err)
+		return empty
+	}
+	m := &installMarker{}
+	if err := json.Unmarshal(data, m); err != nil {
+		warningf(ctx, "Failed to parse install marker %s, ignoring it: %s", path, err)
+		return empty
+	}
+	if m.XcodeVersion != xcodeVersion {
+		logging.Infof(ctx, "Install marker %s is for Xcode %s, not %s. Ignoring it.", path, m.XcodeVersion, xcodeVersion)
+		return empty
+	}
+	return m
+}
+
+// writeInstallMarker writes the install marker |m| to |path|. Nothing is
+// written if |path| is empty.
+func writeInstallMarker(path string, m *installMarker) error {
+	if path == "" {
+		return nil
+	}
+	data, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return errors.Annotate(err, "failed to encode install marker").Err()
+	}
+	if err := ioutil.WriteFile(path, data, 0600); err != nil {
+		return errors.Annotate(err, "failed to write install marker %s", path).Err()
+	}
+	return nil
+}
+
+// postInstallStep is a step run after Xcode packages are installed.
+type postInstallStep struct {
+	name string
+	// usableOnFailure is whether Xcode is still usable if the step fails.
+	usableOnFailure bool
+	run             func(ctx context.Context) error
+}
+
+// postInstallSteps returns the post-install steps requested in |args|, in the
+// order they should run.
+func postInstallSteps(args InstallArgs) ([]postInstallStep, error) {
+	var steps []postInstallStep
+	if args.runFirstLaunch {
+		steps = append(steps, postInstallStep{
+			name: firstLaunchStep,
+			run:  runFirstLaunch,
+		})
+	}
+	for _, component := range args.installComponents {
+		packagePath := filepath.Join(args.xcodeAppPath, XcodeComponentPackagesRelPath, component+".pkg")
+		if _, err := os.Stat(packagePath); err != nil {
+			return nil, errors.Annotate(err, "component %s is not in Xcode %s", component, args.xcodeVersion).Err()
+		}
+		steps = append(steps, postInstallStep{
+			name:            installComponentStepPrefix + component,
+			usableOnFailure: true,
+			run: func(ctx context.Context) error {
+				return installComponent(ctx, packagePath, args.packageInstallerOnBots)
+			},
+		})
+	}
+	return steps, nil
+}
+
+func runFirstLaunch(ctx context.Context) error {
+	if err := RunCommand(ctx, "sudo", "/usr/bin/xcodebuild", "-runFirstLaunch"); err != nil {
+		return errors.Annotate(err, "failed when invoking xcodebuild -runFirstLaunch").Err()
+	}
+	return nil
+}
+
+// installComponent installs the component package at |packagePath|. On bots,
+// packages must be installed through |packageInstallerOnBots|.
+func installComponent(ctx context.Context, packagePath, packageInstallerOnBots string) error {
+	var err error
+	if _, statErr := os.Stat(packageInstallerOnBots); statErr == nil {
+		err = RunCommand(ctx, "sudo", packageInstallerOnBots, "--package-path", packagePath)
+	} else {
+		err = RunCommand(ctx, "sudo", "/usr/sbin/installer", "-pkg", packagePath, "-target", "/")
+	}
+	if err != nil {
+		return errors.Annotate(err, "failed to install package %s", packagePath).Err()
+	}
+	return nil
+}
+
+// runPostInstallStep runs |step|, retrying it up to |retries| times. Each
+// attempt is limited to |timeout|, unless it's zero.
+func runPostInstallStep(ctx context.Context, step postInstallStep, timeout time.Duration, retries int) error {
+	var err error
+	for attempt := 0; attempt <= retries; attempt++ {
+		if attempt > 0 {
+			warningf(ctx, "Post-install step %s failed (attempt %d of %d): %s. Retrying in %s.", step.name, attempt, retries+1, err, postInstallRetryDelay)
+			if r := <-clock.After(ctx, postInstallRetryDelay); r.Err != nil {
+				return errors.Annotate(r.Err, "cancelled while waiting to retry").Err()
+			}
+		}
+		if err = runWithTimeout(ctx, timeout, step.run); err == nil {
+			return nil
+		}
+	}
+	return err
+}
+
+func runWithTimeout(ctx context.Context, timeout time.Duration, f func(ctx context.Context) error) error {
+	if timeout <= 0 {
+		return f(ctx)
+	}
+	ctx, cancel := clock.WithTimeout(ctx, timeout)
+	defer cancel()
+	err := f(ctx)
+	if err != nil && ctx.Err() == context.DeadlineExceeded {
+		return errors.Annotate(err, "timed out after %s", timeout).Err()
+	}
+	return err
+}
+
+// runPostInstallSteps runs the post-install steps requested in |args| which
+// haven't completed yet according to the install marker, and records the
+// completed ones.
+func runPostInstallSteps(ctx context.Context, args InstallArgs) error {
+	steps, err := postInstallSteps(args)
+	if err != nil {
+		return errors.Annotate(err, "invalid post-install steps; Xcode is installed and usable, but no post-install step was run").Err()
+	}
+	marker := readInstallMarker(ctx, args.installMarkerFile, args.xcodeVersion)
+	for _, step := range steps {
+		if marker.completed(step.name) {
+			logging.Infof(ctx, "Post-install step %s already completed for Xcode %s, skipping.", step.name, args.xcodeVersion)
+			continue
+		}
+		if err := runPostInstallStep(ctx, step, args.postInstallTimeout, args.postInstallRetries); err != nil {
+			if step.usableOnFailure {
+				return errors.Annotate(err, "post-install step %s failed; Xcode is installed and usable, but without this step", step.name).Err()
+			}
+			return errors.Annotate(err, "post-install step %s failed; Xcode is installed but may not be usable until this step succeeds", step.name).Err()
+		}
+		marker.CompletedSteps = append(marker.CompletedSteps, step.name)
+		if err := writeInstallMarker(args.installMarkerFile, marker); err != nil {
+			return err
+		}
+	}
+	return nil
+}
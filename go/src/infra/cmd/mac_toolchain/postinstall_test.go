@@ -0,0 +1,226 @@
+// Copyright 2021 The Chromium Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package main
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"go.chromium.org/luci/common/clock"
+	"go.chromium.org/luci/common/clock/testclock"
+	"go.chromium.org/luci/common/errors"
+
+	. "github.com/smartystreets/goconvey/convey"
+	. "go.chromium.org/luci/common/testing/assertions"
+)
+
+func TestPostInstall(t *testing.T) {
+	t.Parallel()
+
+	Convey("post-install steps work", t, func() {
+		var s MockSession
+		ctx := useMockCmd(context.Background(), &s)
+		ctx, tc := testclock.UseTime(ctx, testclock.TestRecentTimeUTC)
+		tc.SetTimerCallback(func(d time.Duration, t clock.Timer) {
+			tc.Add(d)
+		})
+
+		tmpDir, err := ioutil.TempDir("", "mac_toolchain")
+		So(err, ShouldBeNil)
+		defer os.RemoveAll(tmpDir)
+		xcodeAppPath := filepath.Join(tmpDir, "Xcode.app")
+		packagesPath := filepath.Join(xcodeAppPath, XcodeComponentPackagesRelPath)
+		So(os.MkdirAll(packagesPath, 0700), ShouldBeNil)
+		for _, pkg := range []string{"MobileDevice.pkg", "CoreTypes.pkg"} {
+			So(ioutil.WriteFile(filepath.Join(packagesPath, pkg), nil, 0600), ShouldBeNil)
+		}
+		markerFile := filepath.Join(xcodeAppPath, InstallMarkerFilename)
+
+		installArgs := InstallArgs{
+			xcodeVersion:           "testVersion",
+			xcodeAppPath:           xcodeAppPath,
+			packageInstallerOnBots: "testdata/dummy_installer",
+			runFirstLaunch:         true,
+			installComponents:      []string{"MobileDevice", "CoreTypes"},
+			installMarkerFile:      markerFile,
+		}
+		s.ReturnOutput = []string{"old/xcode/path"}
+
+		Convey("runs all steps and records them in the marker", func() {
+			err := finalizeInstall(ctx, installArgs)
+			So(err, ShouldBeNil)
+			So(s.Calls, ShouldHaveLength, 7)
+
+			So(s.Calls[0].Executable, ShouldEqual, "/usr/bin/xcode-select")
+			So(s.Calls[0].Args, ShouldResemble, []string{"-p"})
+
+			So(s.Calls[1].Executable, ShouldEqual, "sudo")
+			So(s.Calls[1].Args, ShouldResemble, []string{"/usr/bin/xcode-select", "-s", xcodeAppPath})
+
+			So(s.Calls[2].Executable, ShouldEqual, "sudo")
+			So(s.Calls[2].Args, ShouldResemble, []string{"/usr/bin/xcodebuild", "-runFirstLaunch"})
+
+			So(s.Calls[3].Executable, ShouldEqual, "sudo")
+			So(s.Calls[3].Args, ShouldResemble, []string{
+				"testdata/dummy_installer", "--package-path", filepath.Join(packagesPath, "MobileDevice.pkg"),
+			})
+
+			So(s.Calls[4].Executable, ShouldEqual, "sudo")
+			So(s.Calls[4].Args, ShouldResemble, []string{
+				"testdata/dummy_installer", "--package-path", filepath.Join(packagesPath, "CoreTypes.pkg"),
+			})
+
+			So(s.Calls[5].Executable, ShouldEqual, "xcrun")
+			So(s.Calls[5].Args, ShouldResemble, []string{"simctl", "list"})
+
+			So(s.Calls[6].Executable, ShouldEqual, "sudo")
+			So(s.Calls[6].Args, ShouldResemble, []string{"/usr/bin/xcode-select", "-s", "old/xcode/path"})
+
+			m := readInstallMarker(ctx, markerFile, "testVersion")
+			So(m.CompletedSteps, ShouldResemble, []string{
+				"run-first-launch",
+				"install-component:MobileDevice",
+				"install-component:CoreTypes",
+			})
+
+			Convey("and skips them when run again", func() {
+				s.Calls = nil
+				err := finalizeInstall(ctx, installArgs)
+				So(err, ShouldBeNil)
+				So(s.Calls, ShouldHaveLength, 4)
+				So(s.Calls[2].Executable, ShouldEqual, "xcrun")
+				So(s.Calls[2].Args, ShouldResemble, []string{"simctl", "list"})
+			})
+
+			Convey("but not for another Xcode version", func() {
+				s.Calls = nil
+				installArgs.xcodeVersion = "otherVersion"
+				err := finalizeInstall(ctx, installArgs)
+				So(err, ShouldBeNil)
+				So(s.Calls, ShouldHaveLength, 7)
+				So(s.Calls[2].Args, ShouldResemble, []string{"/usr/bin/xcodebuild", "-runFirstLaunch"})
+			})
+		})
+
+		Convey("installs components without the installer on non-bots", func() {
+			installArgs.runFirstLaunch = false
+			installArgs.installComponents = []string{"MobileDevice"}
+			installArgs.packageInstallerOnBots = "testdata/nonexistent_installer"
+			err := finalizeInstall(ctx, installArgs)
+			So(err, ShouldBeNil)
+			So(s.Calls, ShouldHaveLength, 5)
+			So(s.Calls[2].Executable, ShouldEqual, "sudo")
+			So(s.Calls[2].Args, ShouldResemble, []string{
+				"/usr/sbin/installer", "-pkg", filepath.Join(packagesPath, "MobileDevice.pkg"), "-target", "/",
+			})
+		})
+
+		Convey("skips first launch when disabled", func() {
+			installArgs.runFirstLaunch = false
+			installArgs.installComponents = nil
+			err := finalizeInstall(ctx, installArgs)
+			So(err, ShouldBeNil)
+			So(s.Calls, ShouldHaveLength, 4)
+			So(s.Calls[2].Executable, ShouldEqual, "xcrun")
+		})
+
+		Convey("retries failed steps", func() {
+			installArgs.postInstallRetries = 2
+			s.ReturnError = []error{nil, nil, errors.Reason("first launch failed").Err()}
+			err := finalizeInstall(ctx, installArgs)
+			So(err, ShouldBeNil)
+			So(s.Calls, ShouldHaveLength, 8)
+			So(s.Calls[2].Args, ShouldResemble, []string{"/usr/bin/xcodebuild", "-runFirstLaunch"})
+			So(s.Calls[3].Args, ShouldResemble, []string{"/usr/bin/xcodebuild", "-runFirstLaunch"})
+		})
+
+		Convey("reports a failed first launch", func() {
+			installArgs.postInstallRetries = 1
+			s.ReturnError = []error{
+				nil,
+				nil,
+				errors.Reason("first launch failed").Err(),
+				errors.Reason("first launch failed").Err(),
+			}
+			err := finalizeInstall(ctx, installArgs)
+			So(err, ShouldErrLike, "post-install step run-first-launch failed; Xcode is installed but may not be usable")
+			So(err, ShouldErrLike, "first launch failed")
+			// The first launch is attempted twice, then Xcode is unselected.
+			So(s.Calls, ShouldHaveLength, 5)
+
+			m := readInstallMarker(ctx, markerFile, "testVersion")
+			So(m.CompletedSteps, ShouldBeEmpty)
+		})
+
+		Convey("reports a failed component installation", func() {
+			s.ReturnError = []error{nil, nil, nil, nil, errors.Reason("installer failed").Err()}
+			err := finalizeInstall(ctx, installArgs)
+			So(err, ShouldErrLike, "post-install step install-component:CoreTypes failed; Xcode is installed and usable")
+
+			m := readInstallMarker(ctx, markerFile, "testVersion")
+			So(m.CompletedSteps, ShouldResemble, []string{
+				"run-first-launch",
+				"install-component:MobileDevice",
+			})
+		})
+
+		Convey("fails for an unknown component", func() {
+			installArgs.installComponents = []string{"Unknown"}
+			err := finalizeInstall(ctx, installArgs)
+			So(err, ShouldErrLike, "component Unknown is not in Xcode testVersion")
+			// No step is run.
+			So(s.Calls, ShouldHaveLength, 3)
+		})
+
+		Convey("ignores a corrupted marker", func() {
+			So(ioutil.WriteFile(markerFile, []byte("not json"), 0600), ShouldBeNil)
+			m := readInstallMarker(ctx, markerFile, "testVersion")
+			So(m, ShouldResemble, &installMarker{XcodeVersion: "testVersion"})
+		})
+	})
+
+	Convey("runWithTimeout works", t, func() {
+		ctx, tc := testclock.UseTime(context.Background(), testclock.TestRecentTimeUTC)
+		tc.SetTimerCallback(func(d time.Duration, t clock.Timer) {
+			tc.Add(d)
+		})
+		err := runWithTimeout(ctx, time.Minute, func(ctx context.Context) error {
+			<-ctx.Done()
+			return errors.Reason("killed").Err()
+		})
+		So(err, ShouldErrLike, "timed out after 1m0s")
+	})
+
+	Convey("getMacOSMajorVersion works", t, func() {
+		var s MockSession
+		ctx := useMockCmd(context.Background(), &s)
+
+		Convey("for a full version", func() {
+			s.ReturnOutput = []string{"13.2.1\n"}
+			major, err := getMacOSMajorVersion(ctx)
+			So(err, ShouldBeNil)
+			So(major, ShouldEqual, 13)
+			So(s.Calls[0].Executable, ShouldEqual, "/usr/bin/sw_vers")
+			So(s.Calls[0].Args, ShouldResemble, []string{"-productVersion"})
+		})
+
+		Convey("for a major version only", func() {
+			s.ReturnOutput = []string{"14\n"}
+			major, err := getMacOSMajorVersion(ctx)
+			So(err, ShouldBeNil)
+			So(major, ShouldEqual, 14)
+		})
+
+		Convey("for an unexpected version", func() {
+			s.ReturnOutput = []string{"unknown\n"}
+			_, err := getMacOSMajorVersion(ctx)
+			So(err, ShouldErrLike, "unexpected macOS version")
+		})
+	})
+}
@@ -0,0 +1,269 @@
+// Copyright 2021 The Chromium Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+
+	"go.chromium.org/luci/common/clock"
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/common/logging"
+)
+
+// errorCode is the type of the error a subcommand failed with, as reported in
+// the -json-output document. Attach it to errors with
+// errors.Reason(...).Tag(code) or errors.Annotate(...).Tag(code).
+type errorCode string
+
+const (
+	// Invalid command line flags.
+	codeInvalidArgs = errorCode("INVALID_ARGS")
+	// Failure to create, remove or write local files and folders.
+	codeFilesystem = errorCode("FILESYSTEM_ERROR")
+	// Another installation into the same folder didn't finish in time.
+	codeInstallInProgress = errorCode("INSTALL_IN_PROGRESS")
+	// No CIPD ref matching the requested version.
+	codeCipdResolve = errorCode("CIPD_RESOLVE_FAILED")
+	// `cipd ensure` failed, or the installed packages couldn't be set up.
+	codeCipdInstall = errorCode("CIPD_INSTALL_FAILED")
+	// `cipd pkg-fetch` failed, or the fetched package couldn't be read.
+	codeCipdFetch = errorCode("CIPD_FETCH_FAILED")
+	// The installed Xcode doesn't match its CIPD packages.
+	codeVerifyMismatch = errorCode("VERIFY_MISMATCH")
+	// The Xcode.app or runtime to package is missing or malformed.
+	codeInvalidBundle = errorCode("INVALID_BUNDLE")
+	// `cipd create` or `cipd pkg-build` failed.
+	codePackageBuild = errorCode("PACKAGE_BUILD_FAILED")
+	// Accepting the Xcode license failed.
+	codeLicense = errorCode("LICENSE_ACCEPT_FAILED")
+	// A post-install step failed.
+	codePostInstall = errorCode("POST_INSTALL_FAILED")
+	// Enabling the Developer mode failed.
+	codeDeveloperMode = errorCode("DEVELOPER_MODE_FAILED")
+	// Any other error.
+	codeUnknown = errorCode("UNKNOWN")
+)
+
+var errorCodeKey = errors.NewTagKey("mac_toolchain error code")
+
+// GenerateErrorTagValue implements errors.TagValueGenerator.
+func (c errorCode) GenerateErrorTagValue() errors.TagValue {
+	return errors.TagValue{Key: errorCodeKey, Value: c}
+}
+
+// errorCodeOf returns the code attached to |err|, or codeUnknown if there's
+// none.
+func errorCodeOf(err error) errorCode {
+	if v, ok := errors.TagValueIn(errorCodeKey, err); ok {
+		return v.(errorCode)
+	}
+	return codeUnknown
+}
+
+// result is the document written to the -json-output file.
+type result struct {
+	// Operation is the subcommand, e.g. "install".
+	Operation string `json:"operation"`
+	// Success is whether the subcommand succeeded.
+	Success bool `json:"success"`
+	// XcodeVersion and RuntimeVersion are the versions requested by install
+	// commands, or read from the packaged Xcode.app and runtime by upload and
+	// package commands.
+	XcodeVersion   string `json:"xcode_version,omitempty"`
+	RuntimeVersion string `json:"runtime_version,omitempty"`
+	// Kind is the installation kind of the install command.
+	Kind string `json:"kind,omitempty"`
+	// InstallPath is the folder packages are installed to.
+	InstallPath string `json:"install_path,omitempty"`
+	// SourcePath is the Xcode.app or runtime being packaged.
+	SourcePath string `json:"source_path,omitempty"`
+	// OutputDir is the folder local packages are written to.
+	OutputDir string `json:"output_dir,omitempty"`
+	// Packages are the CIPD packages installed, uploaded or built.
+	Packages []*packageResult `json:"packages,omitempty"`
+	// Phases are the phases of the operation, in the order they ran.
+	Phases []*phaseResult `json:"phases,omitempty"`
+	// Verification is the outcome of the verify command.
+	Verification *verifyReport `json:"verification,omitempty"`
+	// Warnings are the non-fatal problems encountered.
+	Warnings []string `json:"warnings,omitempty"`
+	// Error is set if the subcommand failed.
+	Error *resultError `json:"error,omitempty"`
+}
+
+// packageResult is a CIPD package installed, uploaded or built.
+type packageResult struct {
+	Package string `json:"package"`
+	// Version is the ref an installed package was resolved from.
+	Version string `json:"version,omitempty"`
+	// InstanceID is the CIPD instance ID of the package. It's not set for
+	// installed packages which were already up to date.
+	InstanceID string `json:"instance_id,omitempty"`
+	// Refs and Tags are attached to uploaded packages.
+	Refs []string `json:"refs,omitempty"`
+	Tags []string `json:"tags,omitempty"`
+	// Path is the folder a package is installed to, or the file a local
+	// package is written to.
+	Path string `json:"path,omitempty"`
+}
+
+// phaseResult is a completed (or failed) phase of an operation.
+type phaseResult struct {
+	Name        string  `json:"name"`
+	DurationSec float64 `json:"duration_sec"`
+}
+
+// resultError is the error a subcommand failed with.
+type resultError struct {
+	Code    errorCode `json:"code"`
+	Message string    `json:"message"`
+}
+
+// resultBuilder collects the result of a subcommand as it runs.
+type resultBuilder struct {
+	result result
+}
+
+type resultKeyType string
+
+const resultKey resultKeyType = "resultKey"
+
+// withResult returns a context which collects results into |r|.
+func withResult(ctx context.Context, r *resultBuilder) context.Context {
+	return context.WithValue(ctx, resultKey, r)
+}
+
+// resultFromContext returns the builder installed with withResult, or nil if
+// results aren't being collected.
+func resultFromContext(ctx context.Context) *resultBuilder {
+	r, _ := ctx.Value(resultKey).(*resultBuilder)
+	return r
+}
+
+// updateResult calls |f| to update the result being collected, if any.
+func updateResult(ctx context.Context, f func(r *result)) {
+	if b := resultFromContext(ctx); b != nil {
+		f(&b.result)
+	}
+}
+
+// recordPackage adds |p| to the packages of the result being collected.
+func recordPackage(ctx context.Context, p *packageResult) {
+	updateResult(ctx, func(r *result) {
+		r.Packages = append(r.Packages, p)
+	})
+}
+
+// recordPhase runs |f| as the phase |name|, recording its duration.
+func recordPhase(ctx context.Context, name string, f func() error) error {
+	start := clock.Now(ctx)
+	err := f()
+	updateResult(ctx, func(r *result) {
+		r.Phases = append(r.Phases, &phaseResult{
+			Name:        name,
+			DurationSec: clock.Now(ctx).Sub(start).Seconds(),
+		})
+	})
+	return err
+}
+
+// warningf logs a warning, and records it in the result being collected.
+func warningf(ctx context.Context, format string, args ...interface{}) {
+	logging.Warningf(ctx, format, args...)
+	updateResult(ctx, func(r *result) {
+		r.Warnings = append(r.Warnings, fmt.Sprintf(format, args...))
+	})
+}
+
+// finish records the outcome |err| of the subcommand.
+func (b *resultBuilder) finish(err error) {
+	b.result.Success = err == nil
+	if err != nil {
+		b.result.Error = &resultError{
+			Code:    errorCodeOf(err),
+			Message: err.Error(),
+		}
+	}
+}
+
+// write writes the result document to |path|.
+func (b *resultBuilder) write(path string) error {
+	data, err := json.MarshalIndent(&b.result, "", "  ")
+	if err != nil {
+		return errors.Annotate(err, "failed to encode the JSON output").Err()
+	}
+	if err := ioutil.WriteFile(path, data, 0644); err != nil {
+		return errors.Annotate(err, "failed to write the JSON output to %s", path).Err()
+	}
+	return nil
+}
+
+// runOperation runs |f|, which implements the |operation| subcommand, and
+// reports its outcome: the error, if any, is logged, and the result is
+// written to the -json-output file if requested. Returns the exit code.
+func (c *commonFlags) runOperation(ctx context.Context, operation string, f func(ctx context.Context) error) int {
+	b := &resultBuilder{result: result{Operation: operation}}
+	fctx := ctx
+	if c.jsonOutput != "" {
+		fctx = withResult(ctx, b)
+	}
+	err := f(fctx)
+	b.finish(err)
+	if err != nil {
+		errors.Log(ctx, err)
+	}
+	if c.jsonOutput != "" {
+		if werr := b.write(c.jsonOutput); werr != nil {
+			errors.Log(ctx, werr)
+			return 1
+		}
+	}
+	if err != nil {
+		return 1
+	}
+	return 0
+}
+
+// runCipdWithJSONOutput calls |run| to run a cipd command, passing it the
+// extra arguments for cipd to write its JSON output, and decodes the "result"
+// of the output into |out|. The output is only requested when a result is
+// being collected; otherwise |run| gets no extra arguments and |out| is left
+// untouched. Failures to read the output are warnings, not errors.
+func runCipdWithJSONOutput(ctx context.Context, out interface{}, run func(extraArgs []string) error) error {
+	if resultFromContext(ctx) == nil {
+		return run(nil)
+	}
+	f, err := ioutil.TempFile("", "mac_toolchain_cipd_*.json")
+	if err != nil {
+		warningf(ctx, "Failed to create a file for the CIPD JSON output: %s", err)
+		return run(nil)
+	}
+	f.Close()
+	defer os.Remove(f.Name())
+
+	if err := run([]string{"-json-output", f.Name()}); err != nil {
+		return err
+	}
+	data, err := ioutil.ReadFile(f.Name())
+	if err == nil {
+		err = json.Unmarshal(data, &struct {
+			Result interface{} `json:"result"`
+		}{out})
+	}
+	if err != nil {
+		warningf(ctx, "Failed to read the CIPD JSON output: %s", err)
+	}
+	return nil
+}
+
+// cipdPin is a package instance in the CIPD JSON output.
+type cipdPin struct {
+	Package    string `json:"package"`
+	InstanceID string `json:"instance_id"`
+}
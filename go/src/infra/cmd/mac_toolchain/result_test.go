@@ -0,0 +1,369 @@
+// Copyright 2021 The Chromium Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/maruel/subcommands"
+	"go.chromium.org/luci/common/cli"
+	"go.chromium.org/luci/common/clock/testclock"
+	"go.chromium.org/luci/common/errors"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+// runCommand runs the subcommand |cmd| with |args| against the exec fake |s|,
+// and returns its exit code.
+func runCommand(s *MockSession, cmd *subcommands.Command, args ...string) int {
+	app := &cli.Application{
+		Name: "mac_toolchain",
+		Context: func(ctx context.Context) context.Context {
+			ctx, _ = testclock.UseTime(ctx, testclock.TestRecentTimeUTC)
+			return useMockCmd(ctx, s)
+		},
+		Commands: []*subcommands.Command{cmd},
+	}
+	c := cmd.CommandRun()
+	So(c.GetFlags().Parse(args), ShouldBeNil)
+	return c.Run(app, nil, nil)
+}
+
+// runWithJSONOutput runs the subcommand |cmd| with |args| and -json-output
+// against the exec fake |s|, and returns its exit code and JSON output.
+func runWithJSONOutput(s *MockSession, cmd *subcommands.Command, args ...string) (int, *result) {
+	tmpDir, err := ioutil.TempDir("", "mac_toolchain")
+	So(err, ShouldBeNil)
+	defer os.RemoveAll(tmpDir)
+	jsonOutput := filepath.Join(tmpDir, "result.json")
+
+	code := runCommand(s, cmd, append([]string{"-json-output", jsonOutput}, args...)...)
+	data, err := ioutil.ReadFile(jsonOutput)
+	So(err, ShouldBeNil)
+	r := &result{}
+	So(json.Unmarshal(data, r), ShouldBeNil)
+	return code, r
+}
+
+func TestJSONOutput(t *testing.T) {
+	t.Parallel()
+
+	Convey("-json-output works", t, func() {
+		var s MockSession
+		testErr := errors.Reason("test error").Err()
+
+		Convey("for install", func() {
+			tmpDir, err := ioutil.TempDir("", "mac_toolchain")
+			So(err, ShouldBeNil)
+			defer os.RemoveAll(tmpDir)
+			xcodeAppPath := filepath.Join(tmpDir, "Xcode.app")
+			args := []string{
+				"-xcode-version", "testVersion",
+				"-output-dir", xcodeAppPath,
+				"-cipd-package-prefix", "test/prefix",
+				"-run-first-launch=false",
+			}
+
+			Convey("on success", func() {
+				s.ReturnJSONOutput = []string{
+					"",
+					`{"result": {"": [{"package": "test/prefix/mac", "instance_id": "mac-instance"}]}}`,
+				}
+				code, r := runWithJSONOutput(&s, cmdInstall, args...)
+				So(code, ShouldEqual, 0)
+				So(s.Calls, ShouldHaveLength, 11)
+				So(s.Calls[1].Args[0], ShouldEqual, "ensure")
+				So(s.Calls[1].Args, ShouldContain, "-json-output")
+				So(r, ShouldResemble, &result{
+					Operation:    "install",
+					Success:      true,
+					XcodeVersion: "testVersion",
+					Kind:         "mac",
+					InstallPath:  xcodeAppPath,
+					Packages: []*packageResult{
+						{
+							Package:    "test/prefix/mac",
+							Version:    "testVersion",
+							InstanceID: "mac-instance",
+							Path:       xcodeAppPath,
+						},
+					},
+					Phases: []*phaseResult{
+						{Name: "install_xcode"},
+						{Name: "accept_license"},
+						{Name: "post_install"},
+						{Name: "enable_developer_mode"},
+					},
+				})
+			})
+
+			Convey("for invalid arguments", func() {
+				code, r := runWithJSONOutput(&s, cmdInstall, "-output-dir", xcodeAppPath)
+				So(code, ShouldEqual, 1)
+				So(s.Calls, ShouldHaveLength, 0)
+				So(r.Success, ShouldBeFalse)
+				So(r.InstallPath, ShouldEqual, xcodeAppPath)
+				So(r.Error, ShouldResemble, &resultError{
+					Code:    codeInvalidArgs,
+					Message: "no Xcode version specified (-xcode-version)",
+				})
+			})
+
+			Convey("when cipd ensure fails", func() {
+				s.ReturnError = []error{nil, testErr}
+				code, r := runWithJSONOutput(&s, cmdInstall, args...)
+				So(code, ShouldEqual, 1)
+				So(s.Calls, ShouldHaveLength, 2)
+				So(r.Success, ShouldBeFalse)
+				So(r.Error.Code, ShouldEqual, codeCipdInstall)
+				So(r.Error.Message, ShouldContainSubstring, "failed to install CIPD packages")
+				So(r.Packages, ShouldResemble, []*packageResult{
+					{Package: "test/prefix/mac", Version: "testVersion", Path: xcodeAppPath},
+				})
+				So(r.Phases, ShouldResemble, []*phaseResult{{Name: "install_xcode"}})
+			})
+
+			Convey("when the Developer mode can't be enabled", func() {
+				s.ReturnError = make([]error, 11)
+				s.ReturnError[10] = testErr
+				code, r := runWithJSONOutput(&s, cmdInstall, args...)
+				So(code, ShouldEqual, 1)
+				So(r.Error.Code, ShouldEqual, codeDeveloperMode)
+				So(r.Phases, ShouldHaveLength, 4)
+			})
+		})
+
+		Convey("for install-runtime", func() {
+			tmpDir, err := ioutil.TempDir("", "mac_toolchain")
+			So(err, ShouldBeNil)
+			defer os.RemoveAll(tmpDir)
+
+			Convey("on success", func() {
+				s.ReturnJSONOutput = []string{
+					"",
+					"",
+					`{"result": {"": [{"package": "test/prefix/ios_runtime", "instance_id": "runtime-instance"}]}}`,
+				}
+				code, r := runWithJSONOutput(&s, cmdInstallRuntime,
+					"-runtime-version", "ios-14-4",
+					"-output-dir", tmpDir,
+					"-cipd-package-prefix", "test/prefix")
+				So(code, ShouldEqual, 0)
+				So(s.Calls, ShouldHaveLength, 4)
+				So(r, ShouldResemble, &result{
+					Operation:      "install-runtime",
+					Success:        true,
+					RuntimeVersion: "ios-14-4",
+					InstallPath:    tmpDir,
+					Packages: []*packageResult{
+						{
+							Package:    "test/prefix/ios_runtime",
+							Version:    "ios-14-4",
+							InstanceID: "runtime-instance",
+							Path:       tmpDir,
+						},
+					},
+					Phases: []*phaseResult{
+						{Name: "resolve_runtime"},
+						{Name: "install_runtime"},
+					},
+				})
+			})
+
+			Convey("when no runtime matches", func() {
+				s.ReturnError = []error{testErr, testErr, testErr}
+				code, r := runWithJSONOutput(&s, cmdInstallRuntime,
+					"-runtime-version", "ios-14-4",
+					"-xcode-version", "testVersion",
+					"-output-dir", tmpDir,
+					"-cipd-package-prefix", "test/prefix")
+				So(code, ShouldEqual, 1)
+				So(s.Calls, ShouldHaveLength, 3)
+				So(r.XcodeVersion, ShouldEqual, "testVersion")
+				So(r.Error.Code, ShouldEqual, codeCipdResolve)
+				So(r.Warnings, ShouldHaveLength, 3)
+				So(r.Warnings[0], ShouldContainSubstring, "Failed to resolve ref: ios-14-4_testVersion")
+				So(r.Packages, ShouldBeEmpty)
+				So(r.Phases, ShouldResemble, []*phaseResult{{Name: "resolve_runtime"}})
+			})
+
+			Convey("for invalid arguments", func() {
+				code, r := runWithJSONOutput(&s, cmdInstallRuntime, "-output-dir", tmpDir)
+				So(code, ShouldEqual, 1)
+				So(r.Error.Code, ShouldEqual, codeInvalidArgs)
+			})
+		})
+
+		Convey("for upload", func() {
+			Convey("on success", func() {
+				s.ReturnJSONOutput = []string{
+					`{"result": {"package": "test/prefix/ios_runtime", "instance_id": "runtime-instance"}}`,
+					`{"result": {"package": "test/prefix/ios", "instance_id": "ios-instance"}}`,
+					`{"result": {"package": "test/prefix/mac", "instance_id": "mac-instance"}}`,
+				}
+				code, r := runWithJSONOutput(&s, cmdUpload,
+					"-xcode-path", "testdata/Xcode-new.app",
+					"-cipd-package-prefix", "test/prefix")
+				So(code, ShouldEqual, 0)
+				So(s.Calls, ShouldHaveLength, 3)
+				xcodeTags := []string{"xcode_version:TESTXCODEVERSION", "build_version:TESTBUILDVERSION"}
+				xcodeRefs := []string{"testbuildversion", "latest"}
+				So(r, ShouldResemble, &result{
+					Operation:      "upload",
+					Success:        true,
+					XcodeVersion:   "TESTXCODEVERSION",
+					RuntimeVersion: "ios-14-4",
+					SourcePath:     "testdata/Xcode-new.app",
+					Packages: []*packageResult{
+						{
+							Package:    "test/prefix/ios_runtime",
+							InstanceID: "runtime-instance",
+							Refs:       []string{"ios-14-4_latest", "testbuildversion", "ios-14-4_testbuildversion"},
+							Tags:       []string{"ios_runtime_version:iOS 14.4", "xcode_build_version:testbuildversion", "type:xcode_default"},
+						},
+						{Package: "test/prefix/ios", InstanceID: "ios-instance", Refs: xcodeRefs, Tags: xcodeTags},
+						{Package: "test/prefix/mac", InstanceID: "mac-instance", Refs: xcodeRefs, Tags: xcodeTags},
+					},
+					Phases: []*phaseResult{
+						{Name: "package_runtime"},
+						{Name: "package_xcode"},
+					},
+				})
+			})
+
+			Convey("for an invalid Xcode.app", func() {
+				code, r := runWithJSONOutput(&s, cmdUpload, "-xcode-path", "testdata/nonexistent.app")
+				So(code, ShouldEqual, 1)
+				So(s.Calls, ShouldHaveLength, 0)
+				So(r.Error.Code, ShouldEqual, codeInvalidBundle)
+				So(r.Error.Message, ShouldContainSubstring, "this doesn't look like a valid Xcode.app folder")
+			})
+
+			Convey("when cipd create fails", func() {
+				s.ReturnError = []error{nil, testErr}
+				code, r := runWithJSONOutput(&s, cmdUpload, "-xcode-path", "testdata/Xcode-new.app")
+				So(code, ShouldEqual, 1)
+				So(s.Calls, ShouldHaveLength, 2)
+				So(r.Error.Code, ShouldEqual, codePackageBuild)
+				So(r.Packages, ShouldHaveLength, 1)
+				// The CIPD JSON output of the runtime package is missing.
+				So(r.Warnings, ShouldHaveLength, 1)
+				So(r.Warnings[0], ShouldContainSubstring, "Failed to read the CIPD JSON output")
+			})
+		})
+
+		Convey("for package", func() {
+			Convey("on success", func() {
+				// Make sure `outputDir` actually exists in testdata; otherwise the test
+				// will needlessly create a directory and leave it behind.
+				s.ReturnJSONOutput = []string{
+					`{"result": {"package": "test/prefix/ios_runtime", "instance_id": "runtime-instance"}}`,
+					`{"result": {"package": "test/prefix/ios", "instance_id": "ios-instance"}}`,
+					`{"result": {"package": "test/prefix/mac", "instance_id": "mac-instance"}}`,
+				}
+				code, r := runWithJSONOutput(&s, cmdPackage,
+					"-xcode-path", "testdata/Xcode-new.app",
+					"-output-dir", "testdata/outdir",
+					"-cipd-package-prefix", "test/prefix")
+				So(code, ShouldEqual, 0)
+				So(r.OutputDir, ShouldEqual, "testdata/outdir")
+				So(r.Packages, ShouldResemble, []*packageResult{
+					{Package: "test/prefix/ios_runtime", InstanceID: "runtime-instance", Path: filepath.Join("testdata/outdir", "ios_runtime.cipd")},
+					{Package: "test/prefix/ios", InstanceID: "ios-instance", Path: filepath.Join("testdata/outdir", "ios.cipd")},
+					{Package: "test/prefix/mac", InstanceID: "mac-instance", Path: filepath.Join("testdata/outdir", "mac.cipd")},
+				})
+			})
+
+			Convey("for invalid arguments", func() {
+				code, r := runWithJSONOutput(&s, cmdPackage, "-xcode-path", "testdata/Xcode-new.app")
+				So(code, ShouldEqual, 1)
+				So(r.Error, ShouldResemble, &resultError{
+					Code:    codeInvalidArgs,
+					Message: "output directory is not specified (-output-dir)",
+				})
+			})
+		})
+
+		Convey("for upload-runtime", func() {
+			Convey("on success", func() {
+				s.ReturnJSONOutput = []string{
+					`{"result": {"package": "test/prefix/ios_runtime", "instance_id": "runtime-instance"}}`,
+				}
+				code, r := runWithJSONOutput(&s, cmdUploadRuntime,
+					"-runtime-path", "testdata/runtimes/iOS 12.4.simruntime",
+					"-cipd-package-prefix", "test/prefix")
+				So(code, ShouldEqual, 0)
+				So(r, ShouldResemble, &result{
+					Operation:      "upload-runtime",
+					Success:        true,
+					RuntimeVersion: "ios-12-4",
+					SourcePath:     "testdata/runtimes/iOS 12.4.simruntime",
+					Packages: []*packageResult{
+						{
+							Package:    "test/prefix/ios_runtime",
+							InstanceID: "runtime-instance",
+							Refs:       []string{"ios-12-4_latest", "ios-12-4"},
+							Tags:       []string{"ios_runtime_version:iOS 12.4", "type:manually_uploaded"},
+						},
+					},
+					Phases: []*phaseResult{{Name: "package_runtime"}},
+				})
+			})
+
+			Convey("for invalid arguments", func() {
+				code, r := runWithJSONOutput(&s, cmdUploadRuntime)
+				So(code, ShouldEqual, 1)
+				So(r.Error.Code, ShouldEqual, codeInvalidArgs)
+			})
+		})
+
+		Convey("for package-runtime", func() {
+			Convey("on success", func() {
+				s.ReturnJSONOutput = []string{
+					`{"result": {"package": "test/prefix/ios_runtime", "instance_id": "runtime-instance"}}`,
+				}
+				code, r := runWithJSONOutput(&s, cmdPackageRuntime,
+					"-runtime-path", "testdata/runtimes/iOS 12.4.simruntime",
+					"-output-dir", "testdata/outdir",
+					"-cipd-package-prefix", "test/prefix")
+				So(code, ShouldEqual, 0)
+				So(r.Packages, ShouldResemble, []*packageResult{
+					{Package: "test/prefix/ios_runtime", InstanceID: "runtime-instance", Path: filepath.Join("testdata/outdir", "ios_runtime.cipd")},
+				})
+			})
+
+			Convey("for a nonexistent runtime", func() {
+				code, r := runWithJSONOutput(&s, cmdPackageRuntime,
+					"-runtime-path", "testdata/runtimes/nonexistent.simruntime",
+					"-output-dir", "testdata/outdir")
+				So(code, ShouldEqual, 1)
+				So(s.Calls, ShouldHaveLength, 0)
+				So(r.Error.Code, ShouldEqual, codeInvalidBundle)
+			})
+		})
+
+		Convey("but isn't required", func() {
+			code := runCommand(&s, cmdUploadRuntime, "-runtime-path", "testdata/runtimes/iOS 12.4.simruntime")
+			So(code, ShouldEqual, 0)
+			So(s.Calls, ShouldHaveLength, 1)
+			So(s.Calls[0].Args, ShouldNotContain, "-json-output")
+
+			failing := MockSession{ReturnError: []error{testErr}}
+			So(runCommand(&failing, cmdUploadRuntime, "-runtime-path", "testdata/runtimes/iOS 12.4.simruntime"), ShouldEqual, 1)
+		})
+	})
+
+	Convey("errorCodeOf works", t, func() {
+		So(errorCodeOf(errors.Reason("untagged").Err()), ShouldEqual, codeUnknown)
+		err := errors.Reason("tagged").Tag(codeLicense).Err()
+		So(errorCodeOf(err), ShouldEqual, codeLicense)
+		So(errorCodeOf(errors.Annotate(err, "annotated").Err()), ShouldEqual, codeLicense)
+	})
+}
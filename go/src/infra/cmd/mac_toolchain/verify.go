@@ -0,0 +1,320 @@
+// Copyright 2021 The Chromium Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package main
+
+import (
+	"archive/zip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"go.chromium.org/luci/common/errors"
+)
+
+// cipdManifestDir is the folder in CIPD package files with the package
+// metadata. It isn't installed.
+const cipdManifestDir = ".cipdpkg"
+
+// cipdInstallDir is the folder where CIPD keeps its state in an installation
+// root.
+const cipdInstallDir = ".cipd"
+
+// VerifyArgs are the parameters for verifyXcode() to keep them manageable.
+type VerifyArgs struct {
+	xcodeVersion       string
+	xcodeAppPath       string
+	cipdPackagePrefix  string
+	kind               KindType
+	serviceAccountJSON string
+	// Whether to verify the simulator runtime in
+	// |XcodeIOSSimulatorRuntimeRelPath|. If false, the files there are ignored.
+	withRuntime bool
+}
+
+// verifyReport is the outcome of verifying an installed Xcode.
+type verifyReport struct {
+	// OK is whether the installed files match the packages.
+	OK bool `json:"ok"`
+	// Packages are the packages the installation was compared against.
+	Packages []*packageResult `json:"packages,omitempty"`
+	// Missing are the files in the packages which are not installed.
+	Missing []string `json:"missing,omitempty"`
+	// Modified are the installed files which differ from the packages.
+	Modified []string `json:"modified,omitempty"`
+	// Extra are the installed files which are in none of the packages.
+	Extra []string `json:"extra,omitempty"`
+}
+
+// packageFile is a file in a CIPD package.
+type packageFile struct {
+	// SHA256 is the hex digest of the file contents. Empty for symlinks.
+	SHA256 string
+	// Symlink is the target of a symlink.
+	Symlink string
+}
+
+// Verifies that the files in |args.xcodeAppPath| match the CIPD packages that
+// installXcode would install for the same arguments. The packages are fetched
+// from CIPD and compared with the files on disk by their contents.
+func verifyXcode(ctx context.Context, args VerifyArgs) (*verifyReport, error) {
+	packageNames, err := packageNamesForKind(args.kind)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &verifyReport{}
+	expected := map[string]packageFile{}
+	for _, name := range packageNames {
+		pkg := &packageResult{
+			Package: args.cipdPackagePrefix + "/" + name,
+			Version: args.xcodeVersion,
+			Path:    args.xcodeAppPath,
+		}
+		report.Packages = append(report.Packages, pkg)
+		if err := fetchPackageFiles(ctx, pkg, args.serviceAccountJSON, "", expected); err != nil {
+			return nil, err
+		}
+	}
+
+	// Like installXcode, expect the default runtime package only if the Xcode
+	// packages don't have the runtime folder.
+	runtimePrefix := XcodeIOSSimulatorRuntimeRelPath + "/"
+	hasRuntime := false
+	for name := range expected {
+		if strings.HasPrefix(name, runtimePrefix) {
+			hasRuntime = true
+			break
+		}
+	}
+	if args.withRuntime && !hasRuntime {
+		pkg := &packageResult{
+			Package: args.cipdPackagePrefix + "/" + IosRuntimePackageName,
+			Path:    filepath.Join(args.xcodeAppPath, XcodeIOSSimulatorRuntimeRelPath),
+		}
+		pkg.Version, err = resolveRuntimeRef(ctx, ResolveRuntimeRefArgs{
+			xcodeVersion:       args.xcodeVersion,
+			packagePath:        pkg.Package,
+			serviceAccountJSON: args.serviceAccountJSON,
+		})
+		if err != nil {
+			return nil, errors.Annotate(err, "failed to resolve runtime cipd ref. Xcode version: %s", args.xcodeVersion).Tag(codeCipdResolve).Err()
+		}
+		report.Packages = append(report.Packages, pkg)
+		if err := fetchPackageFiles(ctx, pkg, args.serviceAccountJSON, runtimePrefix, expected); err != nil {
+			return nil, err
+		}
+	}
+	if !args.withRuntime {
+		for name := range expected {
+			if strings.HasPrefix(name, runtimePrefix) {
+				delete(expected, name)
+			}
+		}
+	}
+
+	ignore := func(name string) bool {
+		return name == InstallMarkerFilename || (!args.withRuntime && strings.HasPrefix(name+"/", runtimePrefix))
+	}
+	if err := compareFiles(args.xcodeAppPath, expected, ignore, report); err != nil {
+		return nil, err
+	}
+	return report, nil
+}
+
+// fetchPackageFiles fetches the package |pkg| from CIPD and adds its files to
+// |files|, keyed by their slash-separated path relative to the Xcode.app,
+// which is the package path prefixed with |prefix|.
+func fetchPackageFiles(ctx context.Context, pkg *packageResult, serviceAccountJSON, prefix string, files map[string]packageFile) error {
+	f, err := ioutil.TempFile("", "mac_toolchain_verify_*.cipd")
+	if err != nil {
+		return errors.Annotate(err, "failed to create a temporary file").Tag(codeFilesystem).Err()
+	}
+	f.Close()
+	defer os.Remove(f.Name())
+
+	fetchArgs := []string{"pkg-fetch", pkg.Package, "-version", pkg.Version, "-out", f.Name()}
+	if serviceAccountJSON != "" {
+		fetchArgs = append(fetchArgs, "-service-account-json", serviceAccountJSON)
+	}
+	var pin cipdPin
+	err = runCipdWithJSONOutput(ctx, &pin, func(extraArgs []string) error {
+		return RunCommand(ctx, "cipd", append(fetchArgs, extraArgs...)...)
+	})
+	if err != nil {
+		return errors.Annotate(err, "failed to fetch CIPD package %s@%s", pkg.Package, pkg.Version).Tag(codeCipdFetch).Err()
+	}
+	pkg.InstanceID = pin.InstanceID
+
+	if err := readPackageFiles(f.Name(), prefix, files); err != nil {
+		return errors.Annotate(err, "failed to read CIPD package %s@%s", pkg.Package, pkg.Version).Tag(codeCipdFetch).Err()
+	}
+	return nil
+}
+
+// readPackageFiles adds the files in the CIPD package file |packagePath| to
+// |files|, with their paths prefixed with |prefix|.
+func readPackageFiles(packagePath, prefix string, files map[string]packageFile) error {
+	r, err := zip.OpenReader(packagePath)
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+
+	for _, zf := range r.File {
+		if strings.HasSuffix(zf.Name, "/") || strings.HasPrefix(zf.Name, cipdManifestDir+"/") {
+			continue
+		}
+		rc, err := zf.Open()
+		if err != nil {
+			return errors.Annotate(err, "failed to open %s", zf.Name).Err()
+		}
+		var pf packageFile
+		if zf.Mode()&os.ModeSymlink != 0 {
+			var target []byte
+			if target, err = ioutil.ReadAll(rc); err == nil {
+				pf.Symlink = string(target)
+			}
+		} else {
+			pf.SHA256, err = hashReader(rc)
+		}
+		rc.Close()
+		if err != nil {
+			return errors.Annotate(err, "failed to read %s", zf.Name).Err()
+		}
+		files[prefix+zf.Name] = pf
+	}
+	return nil
+}
+
+// compareFiles compares the files in |root| with |expected| and records the
+// differences in |report|. Installed files for which |ignore| returns true
+// are not reported as extra.
+func compareFiles(root string, expected map[string]packageFile, ignore func(name string) bool, report *verifyReport) error {
+	for name, pf := range expected {
+		p := filepath.Join(root, filepath.FromSlash(name))
+		fi, err := os.Lstat(p)
+		switch {
+		case os.IsNotExist(err):
+			report.Missing = append(report.Missing, name)
+			continue
+		case err != nil:
+			return errors.Annotate(err, "failed to stat %s", p).Tag(codeFilesystem).Err()
+		}
+
+		if pf.Symlink != "" {
+			if fi.Mode()&os.ModeSymlink == 0 {
+				report.Modified = append(report.Modified, name)
+			} else if target, err := os.Readlink(p); err != nil {
+				return errors.Annotate(err, "failed to read the symlink %s", p).Tag(codeFilesystem).Err()
+			} else if target != pf.Symlink {
+				report.Modified = append(report.Modified, name)
+			}
+			continue
+		}
+
+		if !fi.Mode().IsRegular() {
+			report.Modified = append(report.Modified, name)
+			continue
+		}
+		hash, err := hashFile(p)
+		if err != nil {
+			return errors.Annotate(err, "failed to read %s", p).Tag(codeFilesystem).Err()
+		}
+		if hash != pf.SHA256 {
+			report.Modified = append(report.Modified, name)
+		}
+	}
+
+	err := filepath.Walk(root, func(p string, fi os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(root, p)
+		if err != nil {
+			return err
+		}
+		name := filepath.ToSlash(rel)
+		switch {
+		case fi.IsDir() && fi.Name() == cipdInstallDir:
+			return filepath.SkipDir
+		case name == "." || ignore(name):
+			if fi.IsDir() && name != "." {
+				return filepath.SkipDir
+			}
+			return nil
+		case fi.IsDir():
+			return nil
+		}
+		if _, ok := expected[name]; !ok {
+			report.Extra = append(report.Extra, name)
+		}
+		return nil
+	})
+	if err != nil {
+		return errors.Annotate(err, "failed to list files in %s", root).Tag(codeFilesystem).Err()
+	}
+
+	sort.Strings(report.Missing)
+	sort.Strings(report.Modified)
+	sort.Strings(report.Extra)
+	report.OK = len(report.Missing) == 0 && len(report.Modified) == 0 && len(report.Extra) == 0
+	return nil
+}
+
+// printVerifyReport prints the differences in |report| to |w|, one file per
+// line.
+func printVerifyReport(w io.Writer, report *verifyReport) error {
+	for _, pkg := range report.Packages {
+		if _, err := fmt.Fprintf(w, "package %s %s %s\n", pkg.Package, pkg.Version, pkg.InstanceID); err != nil {
+			return err
+		}
+	}
+	printNames := func(kind string, names []string) error {
+		for _, name := range names {
+			if _, err := fmt.Fprintf(w, "%s %s\n", kind, name); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	if err := printNames("missing", report.Missing); err != nil {
+		return err
+	}
+	if err := printNames("modified", report.Modified); err != nil {
+		return err
+	}
+	if err := printNames("extra", report.Extra); err != nil {
+		return err
+	}
+	if report.OK {
+		_, err := fmt.Fprintf(w, "OK\n")
+		return err
+	}
+	return nil
+}
+
+func hashFile(p string) (string, error) {
+	f, err := os.Open(p)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+	return hashReader(f)
+}
+
+func hashReader(r io.Reader) (string, error) {
+	h := sha256.New()
+	if _, err := io.Copy(h, r); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
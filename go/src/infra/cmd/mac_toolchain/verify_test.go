@@ -0,0 +1,97 @@
+// Copyright 2021 The Chromium Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package main
+
+import (
+	"archive/zip"
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestVerify(t *testing.T) {
+	t.Parallel()
+
+	Convey("verify works", t, func() {
+		tmpDir, err := ioutil.TempDir("", "mac_toolchain")
+		So(err, ShouldBeNil)
+		defer os.RemoveAll(tmpDir)
+
+		// Build a CIPD package file.
+		packagePath := filepath.Join(tmpDir, "mac.cipd")
+		f, err := os.Create(packagePath)
+		So(err, ShouldBeNil)
+		zw := zip.NewWriter(f)
+		addFile := func(name, contents string, mode os.FileMode) {
+			h := &zip.FileHeader{Name: name, Method: zip.Deflate}
+			h.SetMode(mode)
+			w, err := zw.CreateHeader(h)
+			So(err, ShouldBeNil)
+			_, err = w.Write([]byte(contents))
+			So(err, ShouldBeNil)
+		}
+		addFile("Contents/Info.plist", "info", 0644)
+		addFile("Contents/Developer/usr/bin/xcodebuild", "xcodebuild", 0755)
+		addFile("Contents/Developer/usr/bin/link", "xcodebuild", os.ModeSymlink|0777)
+		addFile(".cipdpkg/manifest.json", "{}", 0644)
+		So(zw.Close(), ShouldBeNil)
+		So(f.Close(), ShouldBeNil)
+
+		Convey("readPackageFiles", func() {
+			files := map[string]packageFile{}
+			So(readPackageFiles(packagePath, "prefix/", files), ShouldBeNil)
+			So(files, ShouldHaveLength, 3)
+			So(files["prefix/Contents/Info.plist"].SHA256, ShouldEqual, "06271baf49532c879aa3c58b48671884bcc858f09197412d682750496c33e1e1")
+			So(files["prefix/Contents/Developer/usr/bin/link"], ShouldResemble, packageFile{Symlink: "xcodebuild"})
+		})
+
+		Convey("compareFiles", func() {
+			expected := map[string]packageFile{}
+			So(readPackageFiles(packagePath, "", expected), ShouldBeNil)
+
+			// Install the package.
+			xcodeAppPath := filepath.Join(tmpDir, "Xcode.app")
+			binPath := filepath.Join(xcodeAppPath, "Contents", "Developer", "usr", "bin")
+			So(os.MkdirAll(binPath, 0700), ShouldBeNil)
+			So(ioutil.WriteFile(filepath.Join(xcodeAppPath, "Contents", "Info.plist"), []byte("info"), 0600), ShouldBeNil)
+			So(ioutil.WriteFile(filepath.Join(binPath, "xcodebuild"), []byte("xcodebuild"), 0700), ShouldBeNil)
+			So(os.Symlink("xcodebuild", filepath.Join(binPath, "link")), ShouldBeNil)
+			So(os.MkdirAll(filepath.Join(xcodeAppPath, ".cipd", "pkgs"), 0700), ShouldBeNil)
+			So(ioutil.WriteFile(filepath.Join(xcodeAppPath, ".cipd", "pkgs", "state"), nil, 0600), ShouldBeNil)
+			So(ioutil.WriteFile(filepath.Join(xcodeAppPath, InstallMarkerFilename), nil, 0600), ShouldBeNil)
+			ignore := func(name string) bool { return name == InstallMarkerFilename }
+
+			Convey("for an intact installation", func() {
+				report := &verifyReport{}
+				So(compareFiles(xcodeAppPath, expected, ignore, report), ShouldBeNil)
+				So(report, ShouldResemble, &verifyReport{OK: true})
+			})
+
+			Convey("for a corrupted installation", func() {
+				So(os.Remove(filepath.Join(binPath, "xcodebuild")), ShouldBeNil)
+				So(ioutil.WriteFile(filepath.Join(xcodeAppPath, "Contents", "Info.plist"), []byte("changed"), 0600), ShouldBeNil)
+				So(ioutil.WriteFile(filepath.Join(xcodeAppPath, "Contents", "extra"), nil, 0600), ShouldBeNil)
+
+				report := &verifyReport{}
+				So(compareFiles(xcodeAppPath, expected, ignore, report), ShouldBeNil)
+				So(report, ShouldResemble, &verifyReport{
+					Missing:  []string{"Contents/Developer/usr/bin/xcodebuild"},
+					Modified: []string{"Contents/Info.plist"},
+					Extra:    []string{"Contents/extra"},
+				})
+
+				buf := &bytes.Buffer{}
+				So(printVerifyReport(buf, report), ShouldBeNil)
+				So(buf.String(), ShouldEqual, "missing Contents/Developer/usr/bin/xcodebuild\n"+
+					"modified Contents/Info.plist\n"+
+					"extra Contents/extra\n")
+			})
+		})
+	})
+}
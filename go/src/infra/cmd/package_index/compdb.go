@@ -85,7 +85,7 @@ func (clangTargets *ClangTargets) populateChannel() {
 
 // ProcessClangTargets processes clang targets from a given compdb file in clangTargets' filePath.
 func (clangTargets *ClangTargets) ProcessClangTargets(ctx context.Context, rootPath, outDir, corpus, buildConfig string,
-	hashMaps *FileHashMap, targetDataOut chan<- string, targetUnitOut chan<- *kpb.CompilationUnit) error {
+	hashMaps *FileHashMap, targetDataOut chan<- dataFile, targetUnitOut chan<- *kpb.CompilationUnit) error {
 	// Parse compdb once.
 	clangTargets.once.Do(clangTargets.populateChannel)
 
@@ -102,7 +102,7 @@ func (clangTargets *ClangTargets) ProcessClangTargets(ctx context.Context, rootP
 			continue
 		}
 		for _, f := range files {
-			targetDataOut <- f
+			targetDataOut <- dataFile{path: f, language: languageCxx}
 		}
 	}
 	close(clangTargetChan)
@@ -298,9 +298,17 @@ func getClangUnit(ctx context.Context, clangInfo *clangUnitInfo, rootPath, outDi
 	unitProto.SourceFile = append(unitProto.SourceFile, clangInfo.unit.File)
 	unitProto.WorkingDirectory = convertPathToForwardSlashes(clangInfo.unit.Directory)
 	unitProto.OutputKey = outputFile
+	// The corpus of the unit is that of its source file, whose path is
+	// normalized like the paths of required inputs.
+	sourcePath := clangInfo.unit.File
+	if filepath.IsAbs(sourcePath) {
+		if sourcePath, err = filepath.Rel(clangInfo.unit.Directory, sourcePath); err != nil {
+			return nil, err
+		}
+	}
 	unitProto.VName = &kpb.VName{
-		Corpus:   corpusForFile(ctx, clangInfo.unit.File, corpus),
-		Language: "c++",
+		Corpus:   corpusForFile(ctx, convertPathToForwardSlashes(normalizePath(outDir, sourcePath)), corpus),
+		Language: languageCxx,
 	}
 
 	// Add the build config if specified.
@@ -26,6 +26,7 @@ type processor func(context.Context, string, string, string, string,
 type GnTargetInterface interface {
 	getUnit() (*kpb.CompilationUnit, error)
 	getFiles() ([]string, error)
+	getLanguage() string
 }
 
 // Maps all GN target names to their respective JSON information.
@@ -100,7 +101,7 @@ func (gnTargets *GnTargets) populateChannel() {
 // If files is true, then process and send target data files. Otherwise, process
 // and send the compilation unit.
 func (gnTargets *GnTargets) ProcessGnTargets(ctx context.Context, rootPath, outDir, corpus, buildConfig string,
-	hashMaps *FileHashMap, targetDataOut chan<- string, targetUnitOut chan<- *kpb.CompilationUnit) error {
+	hashMaps *FileHashMap, targetDataOut chan<- dataFile, targetUnitOut chan<- *kpb.CompilationUnit) error {
 	// Parse GN targets once.
 	gnTargets.once.Do(gnTargets.populateChannel)
 
@@ -123,7 +124,7 @@ func (gnTargets *GnTargets) ProcessGnTargets(ctx context.Context, rootPath, outD
 				continue
 			}
 			for _, f := range files {
-				targetDataOut <- f
+				targetDataOut <- dataFile{path: f, language: gnInterface.getLanguage()}
 			}
 
 			// Send gnInterface to channel for deferred unit processing.
@@ -0,0 +1,251 @@
+// Copyright 2021 The Chromium Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package main
+
+import (
+	"archive/zip"
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"go.chromium.org/luci/common/logging"
+	"google.golang.org/protobuf/proto"
+
+	kpb "infra/cmd/package_index/kythe/proto"
+)
+
+// startTimeComment prefixes the start time of the generation of a kzip in
+// the kzip comment.
+const startTimeComment = "package_index start_time="
+
+// previousKzip is an index pack generated by a previous run, whose entries
+// are copied into the new index pack when they are still valid, instead of
+// being generated again.
+//
+// The digest of a data file which was not modified since the previous run
+// started is taken from the previous units, instead of reading and hashing
+// the file again. The digests of the units then only change if their
+// required inputs, or the units themselves, changed.
+//
+// A nil *previousKzip is valid and has no entries.
+type previousKzip struct {
+	r *zip.ReadCloser
+
+	// The time the generation of the previous kzip started. Files modified
+	// since may have changed.
+	startTime time.Time
+
+	// Maps from the path of each entry of the previous kzip to its file.
+	entries map[string]*zip.File
+
+	// Maps from the absolute path of each required input of the previous
+	// units to its digest.
+	digests map[string]string
+
+	// Maps from the path of each entry to copy into the new kzip to the
+	// language of the first unit which needs it.
+	needed   map[string]string
+	neededMu sync.Mutex
+}
+
+// openPreviousKzip reads the units of the kzip at path.
+func openPreviousKzip(ctx context.Context, path string) (*previousKzip, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	r, err := zip.OpenReader(path)
+	if err != nil {
+		return nil, err
+	}
+	p := &previousKzip{
+		r:       r,
+		entries: make(map[string]*zip.File, len(r.File)),
+		digests: make(map[string]string),
+		needed:  make(map[string]string),
+	}
+
+	// Kzips written before the start time was recorded are assumed to have
+	// been generated just before they were written.
+	p.startTime = info.ModTime()
+	if strings.HasPrefix(r.Comment, startTimeComment) {
+		if t, err := time.Parse(time.RFC3339Nano, strings.TrimPrefix(r.Comment, startTimeComment)); err == nil {
+			p.startTime = t
+		}
+	}
+
+	for _, f := range r.File {
+		if strings.HasSuffix(f.Name, "/") {
+			continue
+		}
+		p.entries[f.Name] = f
+	}
+
+	for name, f := range p.entries {
+		if !strings.HasPrefix(name, unitsDir) {
+			continue
+		}
+		content, err := readZipFile(f)
+		if err != nil {
+			r.Close()
+			return nil, err
+		}
+		indexedCompilation := &kpb.IndexedCompilation{}
+		if err := proto.Unmarshal(content, indexedCompilation); err != nil {
+			r.Close()
+			return nil, err
+		}
+		p.addUnitDigests(indexedCompilation.GetUnit())
+	}
+	logging.Infof(ctx, "Read %d entries and %d file digests from previous kzip %s",
+		len(p.entries), len(p.digests), path)
+	return p, nil
+}
+
+// addUnitDigests records the digests of the required inputs of unit whose
+// data files are in the previous kzip.
+//
+// Units without a working directory, e.g. those of GN targets, have required
+// inputs relative to an unknown directory, so their inputs are always read
+// again.
+func (p *previousKzip) addUnitDigests(unit *kpb.CompilationUnit) {
+	for _, input := range unit.GetRequiredInput() {
+		digest := input.GetInfo().GetDigest()
+		if _, ok := p.entries[filesDir+digest]; !ok || digest == "" {
+			continue
+		}
+		fname := filepath.FromSlash(input.GetInfo().GetPath())
+		if !filepath.IsAbs(fname) {
+			if unit.GetWorkingDirectory() == "" {
+				continue
+			}
+			fname = filepath.Join(filepath.FromSlash(unit.GetWorkingDirectory()), fname)
+		}
+		fname, err := filepath.Abs(fname)
+		if err != nil {
+			continue
+		}
+		p.digests[fname] = digest
+	}
+}
+
+// usePreviousKzip opens the kzip at path generated by a previous run, and
+// seeds the set of entries sent with its entries, so that they are copied
+// rather than generated again.
+func (ip *indexPack) usePreviousKzip(ctx context.Context, path string) error {
+	p, err := openPreviousKzip(ctx, path)
+	if err != nil {
+		return err
+	}
+	for name := range p.entries {
+		ip.entrySet.Add(name)
+	}
+	ip.previous = p
+	return nil
+}
+
+// unchangedDigest returns the digest of the data file fname if it is in the
+// previous kzip and was not modified since.
+func (p *previousKzip) unchangedDigest(fname string) (string, bool) {
+	if p == nil {
+		return "", false
+	}
+	digest, ok := p.digests[fname]
+	if !ok {
+		return "", false
+	}
+	info, err := os.Stat(fname)
+	if err != nil || info.ModTime().After(p.startTime) {
+		return "", false
+	}
+	return digest, true
+}
+
+// markNeeded records that the entry at path of the previous kzip, if any,
+// must be copied into the new kzip for a unit of language.
+func (p *previousKzip) markNeeded(path, language string) {
+	if p == nil {
+		return
+	}
+	if _, ok := p.entries[path]; !ok {
+		return
+	}
+	p.neededMu.Lock()
+	defer p.neededMu.Unlock()
+	if _, ok := p.needed[path]; !ok {
+		p.needed[path] = language
+	}
+}
+
+// Close closes the previous kzip.
+func (p *previousKzip) Close() error {
+	if p == nil {
+		return nil
+	}
+	return p.r.Close()
+}
+
+// sendEntry sends entry to be written to the kzip, unless an entry with the
+// same path was already sent. Entries of the previous kzip are not sent, but
+// copied by copyPreviousEntries instead.
+func (ip *indexPack) sendEntry(kzipEntryChannel chan<- kzipEntry, entry kzipEntry) bool {
+	if !ip.entrySet.Add(entry.path) {
+		ip.previous.markNeeded(entry.path, entry.language)
+		return false
+	}
+	kzipEntryChannel <- entry
+	return true
+}
+
+// copyPreviousEntries sends the entries of the previous kzip used by the new
+// kzip to be written to it. It must be called once all units were sent.
+func (ip *indexPack) copyPreviousEntries(ctx context.Context, kzipEntryChannel chan<- kzipEntry) error {
+	if ip.previous == nil {
+		return nil
+	}
+	ip.previous.neededMu.Lock()
+	languages := make(map[string]string, len(ip.previous.needed))
+	paths := make([]string, 0, len(ip.previous.needed))
+	for path, language := range ip.previous.needed {
+		languages[path] = language
+		paths = append(paths, path)
+	}
+	ip.previous.neededMu.Unlock()
+	sort.Strings(paths)
+
+	for _, path := range paths {
+		content, err := readZipFile(ip.previous.entries[path])
+		if err != nil {
+			return err
+		}
+		kzipEntryChannel <- kzipEntry{
+			path:     path,
+			content:  content,
+			language: languages[path],
+			copied:   true,
+		}
+	}
+	logging.Infof(ctx, "Copied %d of %d entries from the previous kzip", len(paths), len(ip.previous.entries))
+	return nil
+}
+
+// readZipFile returns the uncompressed content of f.
+func readZipFile(f *zip.File) ([]byte, error) {
+	rc, err := f.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer rc.Close()
+	content := make([]byte, f.UncompressedSize64)
+	if _, err := io.ReadFull(rc, content); err != nil {
+		return nil, err
+	}
+	return content, nil
+}
@@ -0,0 +1,256 @@
+// Copyright 2021 The Chromium Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package main
+
+import (
+	"archive/zip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+	"google.golang.org/protobuf/proto"
+
+	kpb "infra/cmd/package_index/kythe/proto"
+)
+
+// writeIncrementalFixture writes a checkout with two clang targets, a.cc and
+// b.cc, which both include common.h, and returns the path to its compdb.
+func writeIncrementalFixture(t *testing.T, rootDir string) string {
+	outDir := filepath.Join(rootDir, "src", "out", "Debug")
+	files := map[string]string{
+		"src/a.cc":           "#include \"common.h\"\n#include \"a.h\"\nint a() { return A; }\n",
+		"src/a.h":            "#define A 1\n",
+		"src/b.cc":           "#include \"common.h\"\nint b() { return 2; }\n",
+		"src/common.h":       "#pragma once\n",
+		"src/a.cc.filepaths": "../../a.cc\n../../common.h\n../../a.h\n",
+		"src/b.cc.filepaths": "../../b.cc\n../../common.h\n",
+	}
+	for name, content := range files {
+		path := filepath.Join(rootDir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	var compDb []clangUnit
+	for _, name := range []string{"a", "b"} {
+		compDb = append(compDb, clangUnit{
+			Directory: outDir,
+			Command:   "clang++ -c ../../" + name + ".cc -o " + name + ".o",
+			File:      "../../" + name + ".cc",
+		})
+	}
+	content, err := json.Marshal(compDb)
+	if err != nil {
+		t.Fatal(err)
+	}
+	compDbPath := filepath.Join(outDir, "compile_commands.json")
+	if err := os.MkdirAll(outDir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(compDbPath, content, 0644); err != nil {
+		t.Fatal(err)
+	}
+	return compDbPath
+}
+
+// generateClangKzip runs the clang target part of the pipeline of main and
+// returns the paths of the entries written to the kzip, and whether they were
+// copied from the previous kzip.
+func generateClangKzip(ctx context.Context, ip *indexPack) (map[string]bool, error) {
+	numRoutines := 2
+	kzipEntryChannel := make(chan kzipEntry, 10)
+	unitProtoChannel := make(chan *kpb.CompilationUnit, 10)
+	dataFileChannel := make(chan dataFile, 10)
+
+	clangTargets := NewClangTargets(ip.compDbPath)
+	clangTargets.DataWg.Add(numRoutines)
+	clangTargets.KzipDataWg.Add(numRoutines)
+	clangTargets.UnitWg.Add(numRoutines)
+	errs := make(chan error, numRoutines)
+	for i := 0; i < numRoutines; i++ {
+		go func() {
+			errs <- clangTargets.ProcessClangTargets(ip.ctx, ip.rootPath, ip.outDir, ip.corpus,
+				ip.buildConfig, ip.hashMaps, dataFileChannel, unitProtoChannel)
+		}()
+	}
+
+	var kzipEntryWg sync.WaitGroup
+	kzipEntryWg.Add(2 * numRoutines)
+	for i := 0; i < numRoutines; i++ {
+		go func() {
+			ip.dataFileToKzipEntry(ctx, dataFileChannel, kzipEntryChannel)
+			kzipEntryWg.Done()
+			clangTargets.KzipDataWg.Done()
+		}()
+		go func() {
+			ip.unitFileToKzipEntry(ctx, unitProtoChannel, kzipEntryChannel)
+			kzipEntryWg.Done()
+		}()
+	}
+	go func() {
+		clangTargets.DataWg.Wait()
+		close(dataFileChannel)
+	}()
+	go func() {
+		clangTargets.UnitWg.Wait()
+		close(unitProtoChannel)
+	}()
+	copyErr := make(chan error, 1)
+	go func() {
+		kzipEntryWg.Wait()
+		copyErr <- ip.copyPreviousEntries(ctx, kzipEntryChannel)
+		close(kzipEntryChannel)
+	}()
+
+	// Record the entries on their way to the kzip.
+	written := make(map[string]bool)
+	recorded := make(chan kzipEntry)
+	go func() {
+		for entry := range kzipEntryChannel {
+			written[entry.path] = entry.copied
+			recorded <- entry
+		}
+		close(recorded)
+	}()
+	if err := ip.writeToKzip(recorded); err != nil {
+		return nil, err
+	}
+	for i := 0; i < numRoutines; i++ {
+		if err := <-errs; err != nil {
+			return nil, err
+		}
+	}
+	if err := <-copyErr; err != nil {
+		return nil, err
+	}
+	return written, ip.previous.Close()
+}
+
+// readKzip returns the content of each entry of the kzip at path.
+func readKzip(t *testing.T, path string) map[string]string {
+	r, err := zip.OpenReader(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer r.Close()
+	entries := make(map[string]string)
+	for _, f := range r.File {
+		content, err := readZipFile(f)
+		if err != nil {
+			t.Fatal(err)
+		}
+		entries[f.Name] = string(content)
+	}
+	return entries
+}
+
+func TestIncrementalKzip(t *testing.T) {
+	t.Parallel()
+
+	Convey("Incremental kzip generation", t, func() {
+		tmpdir, err := ioutil.TempDir("", "")
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer os.RemoveAll(tmpdir)
+
+		ctx := context.Background()
+		rootDir := filepath.Join(tmpdir, "checkout")
+		compDbPath := writeIncrementalFixture(t, rootDir)
+		var stats *kzipStats
+		generate := func(output, previous string) map[string]bool {
+			ip := newIndexPack(ctx, filepath.Join(tmpdir, output), rootDir, "src/out/Debug", compDbPath,
+				"", "", "chromium-test", "")
+			if previous != "" {
+				So(ip.usePreviousKzip(ctx, filepath.Join(tmpdir, previous)), ShouldBeNil)
+			}
+			written, err := generateClangKzip(ctx, ip)
+			So(err, ShouldBeNil)
+			stats = ip.stats
+			return written
+		}
+		unitOf := func(entries map[string]string, sourceFile string) string {
+			for path, content := range entries {
+				if !strings.HasPrefix(path, unitsDir) || path == unitsDir {
+					continue
+				}
+				indexedCompilation := &kpb.IndexedCompilation{}
+				So(proto.Unmarshal([]byte(content), indexedCompilation), ShouldBeNil)
+				if indexedCompilation.GetUnit().GetSourceFile()[0] == sourceFile {
+					return path
+				}
+			}
+			return ""
+		}
+
+		first := generate("first.kzip", "")
+		So(first, ShouldHaveLength, 6) // 4 data files and 2 units.
+		for _, copied := range first {
+			So(copied, ShouldBeFalse)
+		}
+		firstEntries := readKzip(t, filepath.Join(tmpdir, "first.kzip"))
+
+		Convey("Only the changed unit is regenerated", func() {
+			// Change a.h, which is only included by a.cc.
+			aHeader := filepath.Join(rootDir, "src", "a.h")
+			newContent := "#define A 2\n"
+			So(ioutil.WriteFile(aHeader, []byte(newContent), 0644), ShouldBeNil)
+			later := time.Now().Add(time.Hour)
+			So(os.Chtimes(aHeader, later, later), ShouldBeNil)
+
+			second := generate("second.kzip", "first.kzip")
+			secondEntries := readKzip(t, filepath.Join(tmpdir, "second.kzip"))
+
+			var regenerated []string
+			for path, copied := range second {
+				if !copied {
+					regenerated = append(regenerated, path)
+				}
+			}
+			hash := sha256.Sum256([]byte(newContent))
+			So(regenerated, ShouldHaveLength, 2)
+			So(regenerated, ShouldContain, filesDir+hex.EncodeToString(hash[:]))
+			So(regenerated, ShouldContain, unitOf(secondEntries, "../../a.cc"))
+
+			// The unit of b.cc is copied, and the previous unit of a.cc is
+			// dropped.
+			So(second[unitOf(firstEntries, "../../b.cc")], ShouldBeTrue)
+			So(second, ShouldNotContainKey, unitOf(firstEntries, "../../a.cc"))
+			So(second, ShouldHaveLength, 6)
+
+			// Copied entries keep the language of their units.
+			So(stats.Languages, ShouldResemble, map[string]*languageStats{
+				languageCxx: {Units: 2, DataFiles: 4, Copied: 4},
+			})
+
+			Convey("The kzip is the same as a full generation", func() {
+				generate("full.kzip", "")
+				So(secondEntries, ShouldResemble, readKzip(t, filepath.Join(tmpdir, "full.kzip")))
+			})
+		})
+
+		Convey("Everything is copied if nothing changed", func() {
+			second := generate("second.kzip", "first.kzip")
+			So(second, ShouldHaveLength, 6)
+			for _, copied := range second {
+				So(copied, ShouldBeTrue)
+			}
+			So(readKzip(t, filepath.Join(tmpdir, "second.kzip")), ShouldResemble, firstEntries)
+		})
+	})
+}
@@ -2,6 +2,7 @@ package main
 
 import (
 	"context"
+	"time"
 )
 
 // indexPack contains the information necessary to assemble the kzip.
@@ -29,6 +30,24 @@ type indexPack struct {
 	// Mapping to and from a filename and its content hash.
 	hashMaps *FileHashMap
 
+	// Paths of the entries sent to be written to the kzip, including the
+	// entries of the previous kzip, used to avoid writing an entry twice.
+	entrySet *ConcurrentSet
+
+	// The kzip generated by a previous run, whose entries are reused
+	// (optional). nil unless a previous kzip was given.
+	previous *previousKzip
+
+	// The time the generation of the index pack started.
+	startTime time.Time
+
+	// Counts of the entries written to the kzip.
+	stats *kzipStats
+
+	// Mapping from source files to the units which include them (optional).
+	// nil unless the reverse map was requested.
+	reverseMap *reverseMap
+
 	// Used for logging.
 	ctx context.Context
 }
@@ -49,5 +68,8 @@ func newIndexPack(ctx context.Context, outputFile, rootPath, outDir, compDbPath,
 		ctx:                   ctx,
 	}
 	ip.hashMaps = NewFileHashMap()
+	ip.entrySet = NewConcurrentSet(0)
+	ip.startTime = time.Now()
+	ip.stats = newKzipStats()
 	return ip
 }
@@ -16,6 +16,7 @@ import (
 	"path/filepath"
 	"sort"
 	"strings"
+	"time"
 
 	"go.chromium.org/luci/common/logging"
 	"google.golang.org/protobuf/encoding/protojson"
@@ -28,10 +29,31 @@ import (
 var filesDir = "kzip/files/"
 var unitsDir = "kzip/pbunits/"
 
+// Languages of the compilation units, as set in their VName.
+const (
+	languageCxx    = "c++"
+	languageJava   = "java"
+	languageMojom  = "mojom"
+	languageProto  = "protobuf"
+	languageTorque = "torque"
+)
+
 // kzipEntry stores the path to a file to be written in the kzip and its contents.
 type kzipEntry struct {
 	path    string
 	content []byte
+	// The language of the unit, or of the first unit the data file is an
+	// input of.
+	language string
+	// Whether the entry was copied from the previous kzip.
+	copied bool
+}
+
+// dataFile is a data file of a target, to be written to the kzip.
+type dataFile struct {
+	path string
+	// The language of the target.
+	language string
 }
 
 // mergeExistingKzips iterates through files inside existingJavaKzipsPath
@@ -178,7 +200,9 @@ func (ip *indexPack) processExistingKzip(ctx context.Context, kzip string, kzipE
 	if err != nil {
 		return err
 	}
-	kzipEntryChannel <- entry
+	entry.language = languageJava
+	ip.reverseMap.AddUnit(strings.TrimPrefix(entry.path, unitsDir), protoUnit)
+	ip.sendEntry(kzipEntryChannel, entry)
 	logging.Debugf(ctx, "Added %s from kzip", entry.path)
 
 	for _, file := range files {
@@ -198,7 +222,11 @@ func (ip *indexPack) processExistingKzip(ctx context.Context, kzip string, kzipE
 			continue
 		}
 
-		kzipEntryChannel <- kzipEntry{filesDir + filepath.Base(file.Name), content}
+		ip.sendEntry(kzipEntryChannel, kzipEntry{
+			path:     filesDir + filepath.Base(file.Name),
+			content:  content,
+			language: languageJava,
+		})
 		logging.Debugf(ctx, "Added %s from kzip", file.Name)
 
 		rc.Close()
@@ -240,8 +268,19 @@ func (ip *indexPack) unitFileToKzipEntry(ctx context.Context,
 		if err != nil {
 			return err
 		}
-		kzipEntryChannel <- entry
-		logging.Debugf(ctx, "Writing compilation unit file %s", entry.path)
+		entry.language = unitProto.GetVName().GetLanguage()
+		ip.reverseMap.AddUnit(strings.TrimPrefix(entry.path, unitsDir), unitProto)
+		if ip.sendEntry(kzipEntryChannel, entry) {
+			logging.Debugf(ctx, "Writing compilation unit file %s", entry.path)
+		} else {
+			logging.Debugf(ctx, "Reusing compilation unit file %s", entry.path)
+		}
+
+		// Data files reused from the previous kzip were not sent, so they must
+		// be copied.
+		for _, requiredInput := range unitProto.GetRequiredInput() {
+			ip.previous.markNeeded(filesDir+requiredInput.GetInfo().GetDigest(), entry.language)
+		}
 	}
 
 	return nil
@@ -250,18 +289,32 @@ func (ip *indexPack) unitFileToKzipEntry(ctx context.Context,
 // dataFileToKzipEntry takes files from dataFileChannel and
 // creates a kzipEntry to be written to the kzip archive.
 func (ip *indexPack) dataFileToKzipEntry(ctx context.Context,
-	dataFileChannel <-chan string, kzipEntryChannel chan<- kzipEntry) error {
-	for fname := range dataFileChannel {
-		fname, err := filepath.Abs(fname)
+	dataFileChannel <-chan dataFile, kzipEntryChannel chan<- kzipEntry) error {
+	for df := range dataFileChannel {
+		fname, err := filepath.Abs(df.path)
 		if err != nil {
 			return err
 		}
 
 		if _, ok := ip.hashMaps.Filehash(fname); !ok {
+			// Files not modified since the previous kzip was generated are
+			// not read again.
+			if digest, ok := ip.previous.unchangedDigest(fname); ok {
+				if relName, err := filepath.Rel(ip.rootPath, fname); err == nil {
+					ip.reverseMap.AddFile(relName)
+				}
+				ip.hashMaps.Add(fname, digest)
+				logging.Debugf(ctx, "Reusing source file %s as %s%s", fname, filesDir, digest)
+				continue
+			}
+
 			if _, err := os.Stat(fname); os.IsNotExist(err) {
 				logging.Warningf(ctx, "File %s does not exist: %s", fname, err)
 				continue
 			}
+			if relName, err := filepath.Rel(ip.rootPath, fname); err == nil {
+				ip.reverseMap.AddFile(relName)
+			}
 
 			content, err := ioutil.ReadFile(fname)
 			if err != nil {
@@ -289,7 +342,7 @@ func (ip *indexPack) dataFileToKzipEntry(ctx context.Context,
 			hashFname := filesDir + hash
 			logging.Debugf(ctx, "Including source file %s as %s for compilation", fname, hashFname)
 
-			kzipEntryChannel <- kzipEntry{hashFname, content}
+			ip.sendEntry(kzipEntryChannel, kzipEntry{path: hashFname, content: content, language: df.language})
 		}
 	}
 
@@ -306,11 +359,11 @@ func indexedCompilationToKzipEntry(indexedCompilationProto *kpb.IndexedCompilati
 	}
 	hash := sha256.Sum256(content)
 	path := unitsDir + hex.EncodeToString(hash[:])
-	return kzipEntry{path, content}, nil
+	return kzipEntry{path: path, content: content}, nil
 }
 
 // writeToKzip first creates the kzip file with the appropriate directory structure
-// and writes kzipEntries to the kzip archive.
+// and writes kzipEntries to the kzip archive, counting them in ip.stats.
 func (ip *indexPack) writeToKzip(kzipEntryChannel <-chan kzipEntry) error {
 	kzip, err := os.Create(ip.outputFile)
 	if err != nil {
@@ -320,6 +373,11 @@ func (ip *indexPack) writeToKzip(kzipEntryChannel <-chan kzipEntry) error {
 
 	// Create the directories inside kzip.
 	w := zip.NewWriter(kzip)
+	// Record when the generation started, so that a later run using this
+	// kzip as its previous kzip knows which files may have changed since.
+	if err := w.SetComment(startTimeComment + ip.startTime.UTC().Format(time.RFC3339Nano)); err != nil {
+		return err
+	}
 	_, err = w.Create("kzip/")
 	if err != nil {
 		return err
@@ -344,6 +402,7 @@ func (ip *indexPack) writeToKzip(kzipEntryChannel <-chan kzipEntry) error {
 		if err != nil {
 			return err
 		}
+		ip.stats.add(entry)
 	}
 
 	return nil
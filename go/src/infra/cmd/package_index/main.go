@@ -35,6 +35,10 @@ var (
 	outDirFlag        = flag.String("out_dir", "src/out/Debug", "Output directory from which compilation is run.")
 	filepathsFlag     = flag.Bool("keep_filepaths_files", false, "Keep the .filepaths files used for index pack generation.")
 	verboseFlag       = flag.Bool("verbose", false, "Print the details of every file being written to the index pack.")
+	reverseMapFlag    = flag.String("reverse_map_output", "", "Path to write a JSON lines mapping from each source file to the compilation units which include it (optional).")
+	previousKzipFlag  = flag.String("previous_kzip", "", "Path to the index pack generated by a previous run, whose entries are reused for unchanged files and units (optional).")
+	minUnitsFlag      = flag.String("min_units", "", "Comma-separated minimum numbers of units per language, e.g. 'c++=1000,mojom=10', below which the index pack generation fails (optional).")
+	vnamesConfigFlag  = flag.String("vnames-config", "", "Path to a Kythe vnames.json config whose rules rewrite the corpus, root and path of the VNames of files by path; files matching no rule are in the corpus given by -corpus (optional).")
 )
 
 // validateFlags checks that the required flags are present.
@@ -69,12 +73,27 @@ func validateFlags(ctx context.Context) {
 	if missing {
 		panic("missing flags")
 	}
+
+	if *previousKzipFlag != "" && *previousKzipFlag == *outputFlag {
+		panic("previous_kzip must differ from path_to_archive_output")
+	}
 }
 
 func main() {
 	ctx := gologger.StdConfig.Use(context.Background())
 	flag.Parse()
 	validateFlags(ctx)
+	minUnits, err := parseMinUnits(*minUnitsFlag)
+	if err != nil {
+		panic(err)
+	}
+	if *vnamesConfigFlag != "" {
+		rules, err := loadVNameRules(*vnamesConfigFlag)
+		if err != nil {
+			panic(err)
+		}
+		ctx = withVNameRules(ctx, rules)
+	}
 
 	// Remove the old zip archive (if it exists). This avoids the new index
 	// pack being added to the old zip archive.
@@ -96,6 +115,14 @@ func main() {
 	}
 	ip := newIndexPack(ctx, *outputFlag, rootPath, *outDirFlag, *compDbFlag,
 		*gnFlag, *existingKzipsFlag, *corpusFlag, *buildFlag)
+	if *reverseMapFlag != "" {
+		ip.reverseMap = newReverseMap()
+	}
+	if *previousKzipFlag != "" {
+		if err := ip.usePreviousKzip(ctx, *previousKzipFlag); err != nil {
+			panic(err)
+		}
+	}
 
 	// Process existing kzips.
 	existingKzipChannel := make(chan string, chanSize)
@@ -124,7 +151,7 @@ func main() {
 
 	// Process targets.
 	unitProtoChannel := make(chan *kpb.CompilationUnit, chanSize)
-	dataFileChannel := make(chan string, chanSize)
+	dataFileChannel := make(chan dataFile, chanSize)
 
 	// Process clang targets.
 	clangTargets := NewClangTargets(ip.compDbPath)
@@ -190,9 +217,13 @@ func main() {
 		close(unitProtoChannel)
 	}()
 
-	// Close kzipEntryChannel after all kzip entries have been sent.
+	// Copy the entries of the previous kzip which are still used, then close
+	// kzipEntryChannel after all kzip entries have been sent.
 	go func() {
 		kzipEntryWg.Wait()
+		if err := ip.copyPreviousEntries(ctx, kzipEntryChannel); err != nil {
+			panic(err)
+		}
 		close(kzipEntryChannel)
 	}()
 
@@ -201,12 +232,34 @@ func main() {
 	if err != nil {
 		panic(err)
 	}
+	if err = ip.previous.Close(); err != nil {
+		panic(err)
+	}
+	ip.stats.Log(ctx)
+	if err = ip.stats.Write(statsPath(*outputFlag)); err != nil {
+		panic(err)
+	}
+	if ip.reverseMap != nil {
+		err = ip.reverseMap.Write(*reverseMapFlag)
+		if err != nil {
+			panic(err)
+		}
+	}
 
 	// Clean up.
 	if !*filepathsFlag {
 		// Remove all *.filepaths files.
 		removeFilepathsFiles(ip.ctx, filepath.Join(rootPath, "src"))
 	}
+
+	// Fail on missing units, e.g. when the GN targets changed shape and no
+	// longer match the target processors.
+	if below := ip.stats.belowMinUnits(minUnits); len(below) > 0 {
+		for _, b := range below {
+			logging.Errorf(ctx, "Too few units of %s", b)
+		}
+		os.Exit(1)
+	}
 	logging.Infof(ctx, "%s: Done.", time.Now().Format("15:04:05"))
 	os.Exit(0)
 }
@@ -141,7 +141,7 @@ func (m *mojomTarget) getUnit() (*kpb.CompilationUnit, error) {
 		}
 	}
 	unitProto.Argument = append(unitProto.Argument, sourceFiles...)
-	unitProto.VName = &kpb.VName{Corpus: m.corpus, Language: "mojom"}
+	unitProto.VName = &kpb.VName{Corpus: unitCorpus(m.ctx, m.outDir, sourceFiles, m.corpus), Language: m.getLanguage()}
 	if m.buildConfig != "" {
 		injectUnitBuildDetails(m.ctx, unitProto, m.buildConfig)
 	}
@@ -172,11 +172,10 @@ func (m *mojomTarget) getUnit() (*kpb.CompilationUnit, error) {
 			continue
 		}
 
+		vname := &kpb.VName{}
+		setVnameForFile(m.ctx, vname, convertPathToForwardSlashes(normalizePath(m.outDir, requiredFile)), m.corpus)
 		requiredInput := &kpb.CompilationUnit_FileInput{
-			VName: &kpb.VName{
-				Corpus: m.corpus,
-				Path:   convertPathToForwardSlashes(normalizePath(m.outDir, requiredFile)),
-			},
+			VName: vname,
 			Info: &kpb.FileInfo{
 				Digest: h,
 				Path:   convertPathToForwardSlashes(requiredFile),
@@ -187,6 +186,11 @@ func (m *mojomTarget) getUnit() (*kpb.CompilationUnit, error) {
 	return unitProto, nil
 }
 
+// getLanguage returns the language of the target.
+func (m *mojomTarget) getLanguage() string {
+	return languageMojom
+}
+
 // getFiles retrieves a list of all files that are required for compilation of a target.
 // Returns a list of all included files, in their absolute paths.
 func (m *mojomTarget) getFiles() ([]string, error) {
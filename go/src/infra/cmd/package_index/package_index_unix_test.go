@@ -10,10 +10,12 @@ package main
 import (
 	"archive/zip"
 	"context"
+	"encoding/json"
 	"io"
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"sort"
 	"strings"
 	"sync"
 	"testing"
@@ -64,6 +66,7 @@ func TestPackageIndexUnix(t *testing.T) {
 		}
 		ip := newIndexPack(ctx, outputPath, rootDir, "src/out/Debug", compDbPath, gnPath, kzipPath,
 			"chromium-test", "linux")
+		ip.reverseMap = newReverseMap()
 
 		// Read expected units and place into a map.
 		unitMap := make(map[unitKey]string)
@@ -121,7 +124,7 @@ func TestPackageIndexUnix(t *testing.T) {
 
 			// Parse and process targets.
 			unitProtoChannel := make(chan *kpb.CompilationUnit, chanSize)
-			dataFileChannel := make(chan string, chanSize)
+			dataFileChannel := make(chan dataFile, chanSize)
 
 			// Parse compdb.
 			clangTargets := NewClangTargets(compDbPath)
@@ -284,6 +287,63 @@ func TestPackageIndexUnix(t *testing.T) {
 						unitMap[unitKey{unitOut.GetVName().GetCorpus(), unitOut.GetSourceFile()[0]}])
 				}
 			})
+
+			Convey("Reverse map maps files to the units which include them", func() {
+				r, err := zip.OpenReader(outputPath)
+				if err != nil {
+					t.Fatal(err)
+				}
+				defer r.Close()
+				unitDigests := make(map[string]bool)
+				for _, zipInfo := range r.File {
+					if strings.HasPrefix(zipInfo.Name, unitsDir) && zipInfo.Name != unitsDir {
+						unitDigests[strings.TrimPrefix(zipInfo.Name, unitsDir)] = true
+					}
+				}
+
+				reverseMapPath := filepath.Join(tmpdir, "reverse_map.jsonl")
+				So(ip.reverseMap.Write(reverseMapPath), ShouldBeNil)
+				content, err := ioutil.ReadFile(reverseMapPath)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				files := make(map[string][]reverseMapUnit)
+				withoutUnits := make(map[string]int)
+				summaries := make(map[string]int)
+				for _, line := range strings.Split(strings.TrimSpace(string(content)), "\n") {
+					var record reverseMapRecord
+					So(json.Unmarshal([]byte(line), &record), ShouldBeNil)
+					if record.File != nil {
+						files[record.File.Path] = record.File.Units
+						if len(record.File.Units) == 0 {
+							withoutUnits[topLevelDir(record.File.Path)]++
+						}
+					} else {
+						summaries[record.Summary.Dir] = record.Summary.FilesWithoutUnits
+					}
+				}
+				So(summaries, ShouldResemble, withoutUnits)
+
+				// Every referenced unit is in the kzip.
+				for _, units := range files {
+					for _, u := range units {
+						So(unitDigests, ShouldContainKey, u.Digest)
+					}
+				}
+
+				// A header is included by the clang unit.
+				So(files["src/test.h"], ShouldHaveLength, 1)
+				So(files["src/test.h"][0].Target, ShouldEqual, "test.o")
+
+				// An imported mojom is included by both mojom units.
+				var targets []string
+				for _, u := range files["src/test2.mojom"] {
+					targets = append(targets, u.Target)
+				}
+				sort.Strings(targets)
+				So(targets, ShouldResemble, []string{"../../test.mojom", "../../test2.mojom"})
+			})
 		})
 	})
 }
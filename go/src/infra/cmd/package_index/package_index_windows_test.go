@@ -141,7 +141,7 @@ func TestPackageIndexWindows(t *testing.T) {
 
 			// Parse and process targets.
 			unitProtoChannel := make(chan *kpb.CompilationUnit, chanSize)
-			dataFileChannel := make(chan string, chanSize)
+			dataFileChannel := make(chan dataFile, chanSize)
 
 			// Parse compdb.
 			clangTargets := NewClangTargets(modCompDbPath)
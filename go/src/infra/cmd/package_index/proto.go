@@ -119,7 +119,7 @@ func (p protoTarget) getUnit() (*kpb.CompilationUnit, error) {
 		unitProto.Argument = append(unitProto.Argument, source)
 	}
 
-	unitProto.VName = &kpb.VName{Corpus: p.corpus, Language: "protobuf"}
+	unitProto.VName = &kpb.VName{Corpus: unitCorpus(p.ctx, p.outDir, sourceFiles, p.corpus), Language: p.getLanguage()}
 	if p.buildConfig != "" {
 		injectUnitBuildDetails(p.ctx, unitProto, p.buildConfig)
 	}
@@ -156,11 +156,10 @@ func (p protoTarget) getUnit() (*kpb.CompilationUnit, error) {
 			logging.Warningf(p.ctx, "File %s was not found.", f)
 		}
 
+		vname := &kpb.VName{}
+		setVnameForFile(p.ctx, vname, convertPathToForwardSlashes(vnamePath), p.corpus)
 		requiredInput := &kpb.CompilationUnit_FileInput{
-			VName: &kpb.VName{
-				Corpus: p.corpus,
-				Path:   convertPathToForwardSlashes(vnamePath),
-			},
+			VName: vname,
 			Info: &kpb.FileInfo{
 				Digest: h,
 				Path:   convertPathToForwardSlashes(infoPath),
@@ -171,6 +170,11 @@ func (p protoTarget) getUnit() (*kpb.CompilationUnit, error) {
 	return unitProto, nil
 }
 
+// getLanguage returns the language of the target.
+func (p protoTarget) getLanguage() string {
+	return languageProto
+}
+
 // getFiles retrieves a list of all files that are required for compilation of a target.
 // Returns a list of all included files, in their absolute paths.
 func (p protoTarget) getFiles() ([]string, error) {
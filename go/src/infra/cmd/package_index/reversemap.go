@@ -0,0 +1,188 @@
+// Copyright 2021 The Chromium Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+
+	kpb "infra/cmd/package_index/kythe/proto"
+)
+
+// reverseMap maps source files to the compilation units which include
+// them. It is used to debug missing cross-references, by answering
+// whether a file was part of any compilation unit.
+//
+// A nil *reverseMap is valid and discards everything added to it.
+type reverseMap struct {
+	sync.Mutex
+
+	// Maps from file path, relative to the root of the checkout, to the
+	// units which include the file as a required input.
+	units map[string][]reverseMapUnit
+
+	// Paths of data files written to the kzip, relative to the root of the
+	// checkout.
+	files map[string]bool
+}
+
+// reverseMapUnit identifies a compilation unit in the reverse map.
+type reverseMapUnit struct {
+	// Digest of the unit, as in its kzip file name.
+	Digest string `json:"digest"`
+	// The target of the unit: its output key, or its first source file if
+	// it has no output key.
+	Target string `json:"target"`
+}
+
+// reverseMapFile is the reverse map record for a single file.
+type reverseMapFile struct {
+	Path  string           `json:"path"`
+	Units []reverseMapUnit `json:"units"`
+}
+
+// reverseMapSummary counts the files included in no unit within a
+// top-level directory of the checkout.
+type reverseMapSummary struct {
+	Dir               string `json:"dir"`
+	FilesWithoutUnits int    `json:"files_without_units"`
+}
+
+// reverseMapRecord is a line of the reverse map output. Exactly one field
+// is set.
+type reverseMapRecord struct {
+	File    *reverseMapFile    `json:"file,omitempty"`
+	Summary *reverseMapSummary `json:"summary,omitempty"`
+}
+
+// newReverseMap initializes a new reverseMap.
+func newReverseMap() *reverseMap {
+	return &reverseMap{
+		units: make(map[string][]reverseMapUnit),
+		files: make(map[string]bool),
+	}
+}
+
+// AddUnit records that the unit with the given digest includes each of
+// its required inputs.
+func (m *reverseMap) AddUnit(digest string, unit *kpb.CompilationUnit) {
+	if m == nil {
+		return
+	}
+	ref := reverseMapUnit{Digest: digest, Target: unit.GetOutputKey()}
+	if ref.Target == "" && len(unit.GetSourceFile()) > 0 {
+		ref.Target = unit.GetSourceFile()[0]
+	}
+
+	m.Lock()
+	defer m.Unlock()
+	for _, input := range unit.GetRequiredInput() {
+		path := input.GetVName().GetPath()
+		if root := input.GetVName().GetRoot(); root != "" {
+			// Undo the move of files in external corpora, see
+			// setVnameForFile.
+			path = root + "/" + path
+		}
+		m.units[path] = append(m.units[path], ref)
+	}
+}
+
+// AddFile records that the data file with the given path, relative to the
+// root of the checkout, was written to the kzip.
+func (m *reverseMap) AddFile(path string) {
+	if m == nil {
+		return
+	}
+	m.Lock()
+	defer m.Unlock()
+	m.files[convertPathToForwardSlashes(path)] = true
+}
+
+// records returns the records of the reverse map: one per file, ordered by
+// path, followed by the summaries of files without units, ordered by
+// directory.
+func (m *reverseMap) records() []reverseMapRecord {
+	m.Lock()
+	defer m.Unlock()
+
+	var paths []string
+	for path := range m.units {
+		paths = append(paths, path)
+	}
+	for path := range m.files {
+		if _, ok := m.units[path]; !ok {
+			paths = append(paths, path)
+		}
+	}
+	sort.Strings(paths)
+
+	var records []reverseMapRecord
+	withoutUnits := make(map[string]int)
+	for _, path := range paths {
+		units := append([]reverseMapUnit{}, m.units[path]...)
+		sort.Slice(units, func(i, j int) bool {
+			return units[i].Digest < units[j].Digest
+		})
+		records = append(records, reverseMapRecord{
+			File: &reverseMapFile{Path: path, Units: units},
+		})
+		if len(units) == 0 {
+			withoutUnits[topLevelDir(path)]++
+		}
+	}
+
+	var dirs []string
+	for dir := range withoutUnits {
+		dirs = append(dirs, dir)
+	}
+	sort.Strings(dirs)
+	for _, dir := range dirs {
+		records = append(records, reverseMapRecord{
+			Summary: &reverseMapSummary{Dir: dir, FilesWithoutUnits: withoutUnits[dir]},
+		})
+	}
+	return records
+}
+
+// Write writes the reverse map to outputFile as JSON lines.
+func (m *reverseMap) Write(outputFile string) error {
+	f, err := os.Create(outputFile)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	w := bufio.NewWriter(f)
+	enc := json.NewEncoder(w)
+	for _, r := range m.records() {
+		if err := enc.Encode(r); err != nil {
+			return err
+		}
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+	return f.Close()
+}
+
+// topLevelDir returns the top-level directory of the checkout containing
+// path, e.g. "src/third_party" for "src/third_party/foo/bar.h". Files
+// directly inside the source directory belong to it, e.g. "src" for
+// "src/foo.cc".
+func topLevelDir(path string) string {
+	parts := strings.SplitN(path, "/", 3)
+	switch len(parts) {
+	case 1:
+		return "."
+	case 2:
+		return parts[0]
+	default:
+		return parts[0] + "/" + parts[1]
+	}
+}
@@ -0,0 +1,98 @@
+// Copyright 2021 The Chromium Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package main
+
+import (
+	"sync"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	kpb "infra/cmd/package_index/kythe/proto"
+)
+
+func TestReverseMap(t *testing.T) {
+	t.Parallel()
+
+	unitWithInputs := func(outputKey, sourceFile string, paths ...string) *kpb.CompilationUnit {
+		unit := &kpb.CompilationUnit{
+			OutputKey:  outputKey,
+			SourceFile: []string{sourceFile},
+		}
+		for _, p := range paths {
+			unit.RequiredInput = append(unit.RequiredInput, &kpb.CompilationUnit_FileInput{
+				VName: &kpb.VName{Path: p},
+			})
+		}
+		return unit
+	}
+
+	Convey("Reverse map", t, func() {
+		m := newReverseMap()
+
+		Convey("Maps files to units", func() {
+			m.AddUnit("bbb", unitWithInputs("foo.o", "../../foo.cc", "src/foo.cc", "src/foo.h"))
+			m.AddUnit("aaa", unitWithInputs("", "../../bar.cc", "src/bar.cc", "src/foo.h"))
+
+			So(m.records(), ShouldResemble, []reverseMapRecord{
+				{File: &reverseMapFile{Path: "src/bar.cc", Units: []reverseMapUnit{{"aaa", "../../bar.cc"}}}},
+				{File: &reverseMapFile{Path: "src/foo.cc", Units: []reverseMapUnit{{"bbb", "foo.o"}}}},
+				{File: &reverseMapFile{Path: "src/foo.h", Units: []reverseMapUnit{{"aaa", "../../bar.cc"}, {"bbb", "foo.o"}}}},
+			})
+		})
+
+		Convey("Restores the root of files in external corpora", func() {
+			unit := unitWithInputs("foo.o", "../../foo.cc")
+			unit.RequiredInput = append(unit.RequiredInput, &kpb.CompilationUnit_FileInput{
+				VName: &kpb.VName{Root: "src/third_party/sdk", Path: "sdk.h"},
+			})
+			m.AddUnit("aaa", unit)
+
+			So(m.records(), ShouldResemble, []reverseMapRecord{
+				{File: &reverseMapFile{Path: "src/third_party/sdk/sdk.h", Units: []reverseMapUnit{{"aaa", "foo.o"}}}},
+			})
+		})
+
+		Convey("Counts files without units by top-level directory", func() {
+			m.AddUnit("aaa", unitWithInputs("foo.o", "../../foo.cc", "src/foo.cc"))
+			m.AddFile("src/foo.cc")
+			m.AddFile("src/unused.cc")
+			m.AddFile("src/third_party/a/unused.h")
+			m.AddFile("src/third_party/b/unused.h")
+
+			So(m.records(), ShouldResemble, []reverseMapRecord{
+				{File: &reverseMapFile{Path: "src/foo.cc", Units: []reverseMapUnit{{"aaa", "foo.o"}}}},
+				{File: &reverseMapFile{Path: "src/third_party/a/unused.h", Units: []reverseMapUnit{}}},
+				{File: &reverseMapFile{Path: "src/third_party/b/unused.h", Units: []reverseMapUnit{}}},
+				{File: &reverseMapFile{Path: "src/unused.cc", Units: []reverseMapUnit{}}},
+				{Summary: &reverseMapSummary{Dir: "src", FilesWithoutUnits: 1}},
+				{Summary: &reverseMapSummary{Dir: "src/third_party", FilesWithoutUnits: 2}},
+			})
+		})
+
+		Convey("Is safe for concurrent use", func() {
+			var wg sync.WaitGroup
+			for i := 0; i < 10; i++ {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					m.AddUnit("aaa", unitWithInputs("foo.o", "../../foo.cc", "src/foo.h"))
+					m.AddFile("src/foo.h")
+				}()
+			}
+			wg.Wait()
+
+			records := m.records()
+			So(records, ShouldHaveLength, 1)
+			So(records[0].File.Units, ShouldHaveLength, 10)
+		})
+
+		Convey("Nil map discards everything", func() {
+			var nilMap *reverseMap
+			nilMap.AddUnit("aaa", unitWithInputs("foo.o", "../../foo.cc", "src/foo.cc"))
+			nilMap.AddFile("src/foo.cc")
+		})
+	})
+}
@@ -0,0 +1,147 @@
+// Copyright 2021 The Chromium Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"go.chromium.org/luci/common/logging"
+)
+
+// unknownLanguage is the language of the entries whose language is not known.
+const unknownLanguage = "unknown"
+
+// languageStats counts the entries of a language written to the kzip.
+type languageStats struct {
+	Units     int `json:"units"`
+	DataFiles int `json:"data_files"`
+	// Entries copied from the previous kzip, included in the counts above.
+	Copied int `json:"copied"`
+}
+
+// kzipStats counts the entries written to the kzip per language.
+//
+// Data files are shared by units of several languages, e.g. a header included
+// by both C++ and Mojom units, but are only counted for the language of the
+// first unit they were written for.
+type kzipStats struct {
+	Units     int                       `json:"units"`
+	DataFiles int                       `json:"data_files"`
+	Languages map[string]*languageStats `json:"languages"`
+}
+
+// newKzipStats initializes a new kzipStats.
+func newKzipStats() *kzipStats {
+	return &kzipStats{Languages: make(map[string]*languageStats)}
+}
+
+// add counts entry. Not safe for concurrent use.
+func (s *kzipStats) add(entry kzipEntry) {
+	language := entry.language
+	if language == "" {
+		language = unknownLanguage
+	}
+	ls, ok := s.Languages[language]
+	if !ok {
+		ls = &languageStats{}
+		s.Languages[language] = ls
+	}
+	if strings.HasPrefix(entry.path, unitsDir) {
+		s.Units++
+		ls.Units++
+	} else {
+		s.DataFiles++
+		ls.DataFiles++
+	}
+	if entry.copied {
+		ls.Copied++
+	}
+}
+
+// languages returns the languages with entries, ordered by name.
+func (s *kzipStats) languages() []string {
+	var languages []string
+	for language := range s.Languages {
+		languages = append(languages, language)
+	}
+	sort.Strings(languages)
+	return languages
+}
+
+// Log logs the summary of the stats.
+func (s *kzipStats) Log(ctx context.Context) {
+	logging.Infof(ctx, "Wrote %d units and %d data files", s.Units, s.DataFiles)
+	for _, language := range s.languages() {
+		ls := s.Languages[language]
+		logging.Infof(ctx, "  %s: %d units, %d data files (%d copied from the previous kzip)",
+			language, ls.Units, ls.DataFiles, ls.Copied)
+	}
+}
+
+// Write writes the stats to outputFile as JSON.
+func (s *kzipStats) Write(outputFile string) error {
+	content, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(outputFile, append(content, '\n'), 0644)
+}
+
+// statsPath returns the path of the stats of the kzip at kzipPath, next to
+// it, e.g. "out/index.stats.json" for "out/index.kzip".
+func statsPath(kzipPath string) string {
+	return strings.TrimSuffix(kzipPath, filepath.Ext(kzipPath)) + ".stats.json"
+}
+
+// parseMinUnits parses a comma-separated list of language=count pairs, e.g.
+// "c++=1000,mojom=10", into the minimum number of units of each language.
+func parseMinUnits(s string) (map[string]int, error) {
+	minUnits := make(map[string]int)
+	if s == "" {
+		return minUnits, nil
+	}
+	for _, pair := range strings.Split(s, ",") {
+		parts := strings.SplitN(pair, "=", 2)
+		if len(parts) != 2 || parts[0] == "" {
+			return nil, fmt.Errorf("invalid minimum %q, want language=count", pair)
+		}
+		count, err := strconv.Atoi(parts[1])
+		if err != nil || count < 0 {
+			return nil, fmt.Errorf("invalid count of minimum %q", pair)
+		}
+		minUnits[parts[0]] = count
+	}
+	return minUnits, nil
+}
+
+// belowMinUnits returns a description of each language with fewer units than
+// its minimum in minUnits, ordered by language.
+func (s *kzipStats) belowMinUnits(minUnits map[string]int) []string {
+	var languages []string
+	for language := range minUnits {
+		languages = append(languages, language)
+	}
+	sort.Strings(languages)
+
+	var below []string
+	for _, language := range languages {
+		units := 0
+		if ls, ok := s.Languages[language]; ok {
+			units = ls.Units
+		}
+		if units < minUnits[language] {
+			below = append(below, fmt.Sprintf("%s: %d units, want at least %d",
+				language, units, minUnits[language]))
+		}
+	}
+	return below
+}
@@ -0,0 +1,115 @@
+// Copyright 2021 The Chromium Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestKzipStats(t *testing.T) {
+	t.Parallel()
+
+	Convey("Kzip stats", t, func() {
+		tmpdir, err := ioutil.TempDir("", "")
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer os.RemoveAll(tmpdir)
+
+		ctx := context.Background()
+		ip := newIndexPack(ctx, filepath.Join(tmpdir, "index.kzip"), tmpdir, "src/out/Debug", "",
+			"", "", "chromium-test", "")
+
+		Convey("Counts the entries written per language", func() {
+			entries := []kzipEntry{
+				{path: unitsDir + "1", language: languageCxx},
+				{path: unitsDir + "2", language: languageCxx, copied: true},
+				{path: filesDir + "a", language: languageCxx},
+				{path: unitsDir + "3", language: languageMojom},
+				{path: filesDir + "b", language: languageMojom, copied: true},
+				{path: filesDir + "c"},
+			}
+			ch := make(chan kzipEntry, len(entries))
+			for _, entry := range entries {
+				ch <- entry
+			}
+			close(ch)
+			So(ip.writeToKzip(ch), ShouldBeNil)
+
+			So(ip.stats, ShouldResemble, &kzipStats{
+				Units:     3,
+				DataFiles: 3,
+				Languages: map[string]*languageStats{
+					languageCxx:     {Units: 2, DataFiles: 1, Copied: 1},
+					languageMojom:   {Units: 1, DataFiles: 1, Copied: 1},
+					unknownLanguage: {DataFiles: 1},
+				},
+			})
+
+			Convey("Writes the stats as JSON", func() {
+				path := statsPath(ip.outputFile)
+				So(path, ShouldEqual, filepath.Join(tmpdir, "index.stats.json"))
+				So(ip.stats.Write(path), ShouldBeNil)
+
+				content, err := ioutil.ReadFile(path)
+				So(err, ShouldBeNil)
+				got := newKzipStats()
+				So(json.Unmarshal(content, got), ShouldBeNil)
+				So(got, ShouldResemble, ip.stats)
+			})
+
+			Convey("Reports languages below their minimum", func() {
+				So(ip.stats.belowMinUnits(map[string]int{
+					languageCxx:   2,
+					languageMojom: 1,
+				}), ShouldBeEmpty)
+				So(ip.stats.belowMinUnits(map[string]int{
+					languageCxx:   3,
+					languageMojom: 1,
+					languageProto: 1,
+				}), ShouldResemble, []string{
+					"c++: 2 units, want at least 3",
+					"protobuf: 0 units, want at least 1",
+				})
+			})
+		})
+	})
+}
+
+func TestParseMinUnits(t *testing.T) {
+	t.Parallel()
+
+	Convey("Parse minimum units", t, func() {
+		Convey("Empty", func() {
+			minUnits, err := parseMinUnits("")
+			So(err, ShouldBeNil)
+			So(minUnits, ShouldBeEmpty)
+		})
+
+		Convey("Per language", func() {
+			minUnits, err := parseMinUnits("c++=1000,mojom=10,protobuf=0")
+			So(err, ShouldBeNil)
+			So(minUnits, ShouldResemble, map[string]int{
+				languageCxx:   1000,
+				languageMojom: 10,
+				languageProto: 0,
+			})
+		})
+
+		Convey("Invalid", func() {
+			for _, s := range []string{"1000", "=10", "c++=", "c++=-1", "c++=10,"} {
+				_, err := parseMinUnits(s)
+				So(err, ShouldNotBeNil)
+			}
+		})
+	})
+}
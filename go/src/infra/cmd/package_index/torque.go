@@ -72,7 +72,7 @@ func (m *torqueTarget) getUnit() (*kpb.CompilationUnit, error) {
 
 	// TODO(nicohartmann@, v8:12261): Might have to capture some arguments here
 	// when supporting generated files.
-	unitProto.VName = &kpb.VName{Corpus: m.corpus, Language: "torque"}
+	unitProto.VName = &kpb.VName{Corpus: unitCorpus(m.ctx, m.outDir, sourceFiles, m.corpus), Language: m.getLanguage()}
 	// TODO(nicohartmann@, v8:12261): Might have to capture some build details
 	// here.
 
@@ -87,12 +87,10 @@ func (m *torqueTarget) getUnit() (*kpb.CompilationUnit, error) {
 
 		h, _ := m.hashMap.Filehash(p)
 
+		vname := &kpb.VName{}
+		setVnameForFile(m.ctx, vname, convertPathToForwardSlashes(normalizePath(m.outDir, requiredFile)), m.corpus)
 		requiredInput := &kpb.CompilationUnit_FileInput{
-			VName: &kpb.VName{
-				Corpus: m.corpus,
-				Path: convertPathToForwardSlashes(
-					normalizePath(m.outDir, requiredFile)),
-			},
+			VName: vname,
 			Info: &kpb.FileInfo{
 				Digest: h,
 				Path:   convertPathToForwardSlashes(requiredFile),
@@ -105,6 +103,10 @@ func (m *torqueTarget) getUnit() (*kpb.CompilationUnit, error) {
 	return unitProto, nil
 }
 
+func (m *torqueTarget) getLanguage() string {
+	return languageTorque
+}
+
 func (m *torqueTarget) getFiles() ([]string, error) {
 	var dataFiles []string
 	for _, src := range m.target.Sources {
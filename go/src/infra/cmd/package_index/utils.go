@@ -180,14 +180,21 @@ func findImports(ctx context.Context, regex *regexp.Regexp, fpath string, import
 
 // setVnameForFile returns the appropriate VName for filepath.
 //
-// Specifically, this checks if the file should be put in a special corpus
-// (e.g. the one for the Windows SDK), and if so overrides defaultCorpus
-// and moves the windows path to root.
+// Specifically, this checks if the file is rewritten by the VName rules of
+// ctx, or should be put in a special corpus (e.g. the one for the Windows
+// SDK), and if so overrides defaultCorpus and moves the windows path to
+// root.
 func setVnameForFile(ctx context.Context, vnameProto *kpb.VName, filepath, defaultCorpus string) {
 	if strings.Contains(filepath, "\\") {
 		panic("Filepath contains \\")
 	}
 
+	if corpus, root, vnamePath, ok := vnameRulesFromContext(ctx).apply(filepath, defaultCorpus); ok {
+		vnameProto.Corpus = corpus
+		vnameProto.Root = root
+		vnameProto.Path = vnamePath
+		return
+	}
 	vnameProto.Corpus = defaultCorpus
 	vnameProto.Path = filepath
 	for prefix, corpus := range externalCorpora {
@@ -202,13 +209,17 @@ func setVnameForFile(ctx context.Context, vnameProto *kpb.VName, filepath, defau
 
 // corpusForFile returns the appropriate corpus name for filepath.
 //
-// Specifically, this checks if the file should be put in a special corpus
-// (e.g. the one for the Windows SDK). If not, returns defaultCorpus.
+// Specifically, this checks if the file is rewritten by the VName rules of
+// ctx, or should be put in a special corpus (e.g. the one for the Windows
+// SDK). If not, returns defaultCorpus.
 func corpusForFile(ctx context.Context, filepath, defaultCorpus string) string {
 	if strings.Contains(filepath, "\\") {
 		panic("Filepath contains \\")
 	}
 
+	if corpus, _, _, ok := vnameRulesFromContext(ctx).apply(filepath, defaultCorpus); ok {
+		return corpus
+	}
 	for prefix, corpus := range externalCorpora {
 		if strings.HasPrefix(filepath, prefix) {
 			return corpus
@@ -217,6 +228,15 @@ func corpusForFile(ctx context.Context, filepath, defaultCorpus string) string {
 	return defaultCorpus
 }
 
+// unitCorpus returns the corpus of a unit generated from a GN target, which
+// is that of its first source file. sourceFiles are relative to outDir.
+func unitCorpus(ctx context.Context, outDir string, sourceFiles []string, defaultCorpus string) string {
+	if len(sourceFiles) == 0 {
+		return defaultCorpus
+	}
+	return corpusForFile(ctx, convertPathToForwardSlashes(normalizePath(outDir, sourceFiles[0])), defaultCorpus)
+}
+
 // isUnwantedWinArg checks if a given arg should be removed for
 // compatibility with the Windows indexer.
 func isUnwantedWinArg(arg string) bool {
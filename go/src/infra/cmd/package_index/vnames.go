@@ -0,0 +1,180 @@
+// Copyright 2021 The Chromium Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// vnameRule is a rule of a Kythe VName configuration, rewriting the paths
+// it matches into the corpus, root and path of their VNames.
+type vnameRule struct {
+	pattern *regexp.Regexp
+	// The literal prefix of all the paths matching pattern, checked first
+	// as most paths match few rules.
+	prefix string
+	corpus vnameTemplate
+	root   vnameTemplate
+	path   vnameTemplate
+}
+
+// vnameTemplate is a VName field of a rule, which may refer to the
+// capture groups of the pattern of the rule.
+type vnameTemplate struct {
+	// The template, in the syntax of regexp.Expand.
+	template string
+	// Whether the template refers to capture groups. If not, the template
+	// is the value of the field.
+	hasGroups bool
+}
+
+// vnameRules are the rules of a Kythe VName configuration, in order of
+// precedence.
+type vnameRules []*vnameRule
+
+// vnameRuleJSON is a rule in the vnames.json format of Kythe, e.g.
+//
+//	{
+//	  "pattern": "src/third_party/([^/]+)/(.*)",
+//	  "vname": {"corpus": "@1@", "path": "@2@"}
+//	}
+//
+// The pattern must match whole paths. @n@ in the VName fields is replaced
+// with the n-th capture group of the pattern.
+type vnameRuleJSON struct {
+	Pattern string `json:"pattern"`
+	VName   struct {
+		Corpus string `json:"corpus"`
+		Root   string `json:"root"`
+		Path   string `json:"path"`
+	} `json:"vname"`
+}
+
+// vnameGroupRe matches the capture group references in the VName fields of
+// the vnames.json format.
+var vnameGroupRe = regexp.MustCompile(`@([0-9]+)@`)
+
+// loadVNameRules reads the Kythe VName configuration in the vnames.json
+// format at path.
+func loadVNameRules(path string) (vnameRules, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	rules, err := parseVNameRules(data)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", path, err)
+	}
+	return rules, nil
+}
+
+// parseVNameRules parses a Kythe VName configuration in the vnames.json
+// format, compiling its patterns.
+func parseVNameRules(data []byte) (vnameRules, error) {
+	var parsed []vnameRuleJSON
+	if err := json.Unmarshal(data, &parsed); err != nil {
+		return nil, err
+	}
+	rules := make(vnameRules, 0, len(parsed))
+	for i, p := range parsed {
+		pattern, err := regexp.Compile("^(?:" + p.Pattern + ")$")
+		if err != nil {
+			return nil, fmt.Errorf("rule %d: %v", i, err)
+		}
+		r := &vnameRule{pattern: pattern}
+		r.prefix, _ = pattern.LiteralPrefix()
+		for _, f := range []struct {
+			name string
+			s    string
+			t    *vnameTemplate
+		}{
+			{"corpus", p.VName.Corpus, &r.corpus},
+			{"root", p.VName.Root, &r.root},
+			{"path", p.VName.Path, &r.path},
+		} {
+			if *f.t, err = parseVNameTemplate(f.s, pattern.NumSubexp()); err != nil {
+				return nil, fmt.Errorf("rule %d: %s: %v", i, f.name, err)
+			}
+		}
+		rules = append(rules, r)
+	}
+	return rules, nil
+}
+
+// parseVNameTemplate converts a VName field of the vnames.json format to a
+// template referring to at most numGroups capture groups.
+func parseVNameTemplate(s string, numGroups int) (vnameTemplate, error) {
+	if !vnameGroupRe.MatchString(s) {
+		return vnameTemplate{template: s}, nil
+	}
+	var err error
+	// Escape $, which is special in regexp.Expand templates.
+	template := vnameGroupRe.ReplaceAllStringFunc(strings.ReplaceAll(s, "$", "$$"), func(ref string) string {
+		n, _ := strconv.Atoi(strings.Trim(ref, "@"))
+		if n > numGroups && err == nil {
+			err = fmt.Errorf("%s refers to a missing capture group", ref)
+		}
+		return "${" + strconv.Itoa(n) + "}"
+	})
+	if err != nil {
+		return vnameTemplate{}, err
+	}
+	return vnameTemplate{template: template, hasGroups: true}, nil
+}
+
+// apply returns the corpus, root and path of the VName of the file at
+// path p, relative to the root of the checkout, as rewritten by the first
+// rule matching it. A rule leaving the corpus or the path empty keeps
+// defaultCorpus or p. ok is false if no rule matches p.
+func (rs vnameRules) apply(p, defaultCorpus string) (corpus, root, path string, ok bool) {
+	for _, r := range rs {
+		if !strings.HasPrefix(p, r.prefix) {
+			continue
+		}
+		m := r.pattern.FindStringSubmatchIndex(p)
+		if m == nil {
+			continue
+		}
+		corpus = r.corpus.expand(r.pattern, p, m)
+		if corpus == "" {
+			corpus = defaultCorpus
+		}
+		path = r.path.expand(r.pattern, p, m)
+		if path == "" {
+			path = p
+		}
+		return corpus, r.root.expand(r.pattern, p, m), path, true
+	}
+	return "", "", "", false
+}
+
+// expand returns the value of the field for the match m of pattern in p.
+func (t vnameTemplate) expand(pattern *regexp.Regexp, p string, m []int) string {
+	if !t.hasGroups {
+		return t.template
+	}
+	return string(pattern.ExpandString(nil, t.template, p, m))
+}
+
+var vnameRulesKey = "package_index vname rules"
+
+// withVNameRules returns a context in which the VNames of files are
+// rewritten by rules.
+func withVNameRules(ctx context.Context, rules vnameRules) context.Context {
+	return context.WithValue(ctx, &vnameRulesKey, rules)
+}
+
+// vnameRulesFromContext returns the VName rules installed in ctx by
+// withVNameRules, if any.
+func vnameRulesFromContext(ctx context.Context) vnameRules {
+	rules, _ := ctx.Value(&vnameRulesKey).(vnameRules)
+	return rules
+}
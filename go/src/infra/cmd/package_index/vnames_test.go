@@ -0,0 +1,141 @@
+// Copyright 2021 The Chromium Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	kpb "infra/cmd/package_index/kythe/proto"
+)
+
+const testVNamesConfig = `[
+  {
+    "pattern": "src/third_party/depot_tools/(.*)",
+    "vname": {"corpus": "chromium.googlesource.com/chromium/tools/depot_tools", "path": "@1@"}
+  },
+  {
+    "pattern": "src/third_party/([^/]+)/src/(.*)",
+    "vname": {"corpus": "third_party/@1@", "root": "src", "path": "@2@"}
+  },
+  {
+    "pattern": "src/third_party/.*",
+    "vname": {"root": "third_party"}
+  }
+]`
+
+func TestVNameRules(t *testing.T) {
+	t.Parallel()
+	Convey("VName rules", t, func() {
+		rules, err := parseVNameRules([]byte(testVNamesConfig))
+		So(err, ShouldBeNil)
+		So(rules, ShouldHaveLength, 3)
+
+		apply := func(p string) []string {
+			corpus, root, path, ok := rules.apply(p, "chromium")
+			if !ok {
+				return nil
+			}
+			return []string{corpus, root, path}
+		}
+
+		Convey("First matching rule takes precedence", func() {
+			So(apply("src/third_party/depot_tools/src/gclient.py"), ShouldResemble,
+				[]string{"chromium.googlesource.com/chromium/tools/depot_tools", "", "src/gclient.py"})
+		})
+		Convey("Capture groups are substituted", func() {
+			So(apply("src/third_party/skia/src/core/SkCanvas.cpp"), ShouldResemble,
+				[]string{"third_party/skia", "src", "core/SkCanvas.cpp"})
+		})
+		Convey("Empty corpus and path are kept", func() {
+			So(apply("src/third_party/zlib/zlib.h"), ShouldResemble,
+				[]string{"chromium", "third_party", "src/third_party/zlib/zlib.h"})
+		})
+		Convey("Patterns match whole paths", func() {
+			So(apply("gen/src/third_party/depot_tools/gclient.py"), ShouldBeNil)
+		})
+		Convey("No rule matches", func() {
+			So(apply("src/base/logging.cc"), ShouldBeNil)
+		})
+		Convey("Dollar signs are literal", func() {
+			rules, err := parseVNameRules([]byte(`[{"pattern": "(.*)", "vname": {"path": "$1/@1@"}}]`))
+			So(err, ShouldBeNil)
+			_, _, path, ok := rules.apply("a.cc", "chromium")
+			So(ok, ShouldBeTrue)
+			So(path, ShouldEqual, "$1/a.cc")
+		})
+		Convey("Invalid pattern", func() {
+			_, err := parseVNameRules([]byte(`[{"pattern": "(", "vname": {"corpus": "c"}}]`))
+			So(err.Error(), ShouldStartWith, "rule 0: ")
+		})
+		Convey("Missing capture group", func() {
+			_, err := parseVNameRules([]byte(`[{"pattern": "(.*)", "vname": {"path": "@2@"}}]`))
+			So(err.Error(), ShouldEqual, "rule 0: path: @2@ refers to a missing capture group")
+		})
+	})
+	Convey("VNames of files", t, func() {
+		rules, err := parseVNameRules([]byte(testVNamesConfig))
+		So(err, ShouldBeNil)
+		ctx := withVNameRules(context.Background(), rules)
+
+		Convey("Rules take precedence over external corpora", func() {
+			rules, err := parseVNameRules([]byte(`[{"pattern": "src/third_party/depot_tools/win_toolchain/(.*)", "vname": {"corpus": "winsdk-next", "path": "@1@"}}]`))
+			So(err, ShouldBeNil)
+			ctx := withVNameRules(context.Background(), rules)
+			vname := &kpb.VName{}
+			setVnameForFile(ctx, vname, "src/third_party/depot_tools/win_toolchain/include/windows.h", "chromium")
+			So(vname.Corpus, ShouldEqual, "winsdk-next")
+			So(vname.Root, ShouldEqual, "")
+			So(vname.Path, ShouldEqual, "include/windows.h")
+		})
+		Convey("Rewritten file", func() {
+			vname := &kpb.VName{}
+			setVnameForFile(ctx, vname, "src/third_party/skia/src/core/SkCanvas.cpp", "chromium")
+			So(vname.Corpus, ShouldEqual, "third_party/skia")
+			So(vname.Root, ShouldEqual, "src")
+			So(vname.Path, ShouldEqual, "core/SkCanvas.cpp")
+			So(corpusForFile(ctx, "src/third_party/skia/src/core/SkCanvas.cpp", "chromium"), ShouldEqual, "third_party/skia")
+		})
+		Convey("Fallback to the global corpus", func() {
+			vname := &kpb.VName{}
+			setVnameForFile(ctx, vname, "src/base/logging.cc", "chromium")
+			So(vname.Corpus, ShouldEqual, "chromium")
+			So(vname.Path, ShouldEqual, "src/base/logging.cc")
+			So(corpusForFile(ctx, "src/base/logging.cc", "chromium"), ShouldEqual, "chromium")
+		})
+		Convey("Units of GN targets", func() {
+			So(unitCorpus(ctx, "src/out/Debug", []string{"../../third_party/skia/src/foo.mojom"}, "chromium"), ShouldEqual, "third_party/skia")
+			So(unitCorpus(ctx, "src/out/Debug", nil, "chromium"), ShouldEqual, "chromium")
+		})
+	})
+}
+
+func BenchmarkVNameRules(b *testing.B) {
+	// A config like that of a Chromium checkout, with a rule per
+	// third_party checkout.
+	config := "["
+	for i := 0; i < 100; i++ {
+		config += fmt.Sprintf(`{"pattern": "src/third_party/lib%d/(.*)", "vname": {"corpus": "lib%d", "path": "@1@"}},`, i, i)
+	}
+	config += `{"pattern": "src/(.*)", "vname": {"path": "@1@"}}]`
+	rules, err := parseVNameRules([]byte(config))
+	if err != nil {
+		b.Fatal(err)
+	}
+	paths := []string{
+		"src/third_party/lib0/include/lib.h",
+		"src/third_party/lib99/include/lib.h",
+		"src/base/logging.cc",
+		"src/out/Debug/gen/base/base_jni_headers.h",
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		rules.apply(paths[i%len(paths)], "chromium")
+	}
+}
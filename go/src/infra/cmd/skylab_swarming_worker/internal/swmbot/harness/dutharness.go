@@ -7,6 +7,7 @@ package harness
 import (
 	"context"
 	"log"
+	"path/filepath"
 
 	"go.chromium.org/luci/common/errors"
 
@@ -30,6 +31,8 @@ type DUTHarness struct {
 	ResultsDir   string
 	LocalState   *swmbot.LocalDUTState
 	labelUpdater *labelupdater.LabelUpdater
+	// readOnly is set by the ReadOnly option.
+	readOnly bool
 	// err tracks errors during setup to simplify error handling logic.
 	err     error
 	closers []closer
@@ -73,7 +76,11 @@ func (dh *DUTHarness) loadLocalDUTInfo(ctx context.Context) {
 		dh.err = err
 		return
 	}
-	dh.closers = append(dh.closers, ldi)
+	// The local DUT state is written back to the drone and UFS on
+	// Close, which a read-only harness must not do.
+	if !dh.readOnly {
+		dh.closers = append(dh.closers, ldi)
+	}
 	dh.LocalState = &ldi.LocalDUTState
 }
 
@@ -82,10 +89,14 @@ func (dh *DUTHarness) loadUFSDUTInfo(ctx context.Context) (*inventory.DeviceUnde
 		return nil, nil
 	}
 	var s *ufsdutinfo.Store
+	var uf ufsdutinfo.UpdateFunc
+	if !dh.readOnly {
+		uf = dh.labelUpdater.Update
+	}
 	if dh.DUTID != "" {
-		s, dh.err = ufsdutinfo.LoadByID(ctx, dh.BotInfo, dh.DUTID, dh.labelUpdater.Update)
+		s, dh.err = ufsdutinfo.LoadByID(ctx, dh.BotInfo, dh.DUTID, uf)
 	} else if dh.DUTHostname != "" {
-		s, dh.err = ufsdutinfo.LoadByHostname(ctx, dh.BotInfo, dh.DUTHostname, dh.labelUpdater.Update)
+		s, dh.err = ufsdutinfo.LoadByHostname(ctx, dh.BotInfo, dh.DUTHostname, uf)
 	} else {
 		dh.err = errors.Reason("Both DUTID and DUTHostname field is empty.").Err()
 	}
@@ -118,11 +129,16 @@ func (dh *DUTHarness) addLocalStateToHostInfo(hi *hostinfo.HostInfo) {
 	dh.closers = append(dh.closers, hib)
 }
 
-func (dh *DUTHarness) makeDUTResultsDir(d *resultsdir.Dir) {
+func (dh *DUTHarness) makeDUTResultsDir(d *resultsdir.Dir, l swmbot.ResultsLayout) {
 	if dh.err != nil {
 		return
 	}
-	path, err := d.OpenSubDir(dh.DUTHostname)
+	rel, err := filepath.Rel(d.Path, l.DUTResultsDir(d.Path, dh.DUTHostname))
+	if err != nil {
+		dh.err = err
+		return
+	}
+	path, err := d.OpenSubDir(rel)
 	if err != nil {
 		dh.err = err
 		return
@@ -11,10 +11,12 @@ import (
 	"context"
 	"log"
 
+	"go.chromium.org/chromiumos/infra/proto/go/test_platform/side_effects"
 	"go.chromium.org/luci/common/errors"
 
 	"infra/cmd/skylab_swarming_worker/internal/swmbot"
 	"infra/cmd/skylab_swarming_worker/internal/swmbot/harness/resultsdir"
+	"infra/libs/skylab/sideeffects"
 	ufspb "infra/unifiedfleet/api/v1/models"
 	ufsAPI "infra/unifiedfleet/api/v1/rpc"
 	ufsUtil "infra/unifiedfleet/app/util"
@@ -29,9 +31,16 @@ type closer interface {
 type Info struct {
 	*swmbot.Info
 
+	// Layout is the layout of the results directories, set by the
+	// ResultsLayout option. It defaults to swmbot.LuciferLayout.
+	Layout         swmbot.ResultsLayout
 	TaskResultsDir *resultsdir.Dir
 	DUTs           []*DUTHarness
 	closers        []closer
+	// readOnly is set by the ReadOnly option.
+	readOnly bool
+	// sideEffectsConfig is set by the SideEffectsConfig option.
+	sideEffectsConfig *side_effects.Config
 }
 
 // Close closes and flushes out the harness resources.  This is safe
@@ -59,18 +68,27 @@ func (i *Info) Close(ctx context.Context) error {
 // be closed.
 func Open(ctx context.Context, b *swmbot.Info, o ...Option) (i *Info, err error) {
 	i = &Info{
-		Info: b,
+		Info:   b,
+		Layout: swmbot.LuciferLayout{},
 	}
 	defer func(i *Info) {
 		if err != nil {
 			_ = i.Close(ctx)
 		}
 	}(i)
+	for _, o := range o {
+		if o, ok := o.(infoOption); ok {
+			o.configureInfo(i)
+		}
+	}
 	// Make result dir for swarming bot, which will be uploaded to GS once the
 	// task completes.
 	if err := i.makeTaskResultsDir(); err != nil {
 		return nil, errors.Annotate(err, "create task result directory").Err()
 	}
+	if err := i.writeSideEffectsConfig(); err != nil {
+		return nil, errors.Annotate(err, "write side effects config").Err()
+	}
 	if err := i.loadDUTHarnesses(ctx); err != nil {
 		return nil, errors.Annotate(err, "load DUTHarness").Err()
 	}
@@ -90,7 +108,7 @@ func Open(ctx context.Context, b *swmbot.Info, o ...Option) (i *Info, err error)
 		hi := dh.makeHostInfo(d, sv)
 		dh.addLocalStateToHostInfo(hi)
 		// Make a sub-dir for each DUT, which will be consumed by lucifer later.
-		dh.makeDUTResultsDir(i.TaskResultsDir)
+		dh.makeDUTResultsDir(i.TaskResultsDir, i.Layout)
 		// Copying host_info_store file into DUT's result dir.
 		dh.exposeHostInfo(hi)
 		if dh.err != nil {
@@ -101,17 +119,35 @@ func Open(ctx context.Context, b *swmbot.Info, o ...Option) (i *Info, err error)
 }
 
 func (i *Info) makeTaskResultsDir() error {
-	path := i.Info.ResultsDir()
+	path := i.Layout.TaskResultsDir(i.Info)
 	rd, err := resultsdir.Open(path)
 	if err != nil {
 		return err
 	}
 	log.Printf("Created task results directory %s", path)
-	i.closers = append(i.closers, rd)
+	if i.readOnly {
+		// Leave the directory unsealed so that it is not offloaded.
+		log.Printf("Read-only harness: task results directory will not be sealed")
+	} else {
+		i.closers = append(i.closers, rd)
+	}
 	i.TaskResultsDir = rd
 	return nil
 }
 
+// writeSideEffectsConfig writes the side_effects.Config, if any, into the
+// task results directory.
+func (i *Info) writeSideEffectsConfig() error {
+	if i.sideEffectsConfig == nil {
+		return nil
+	}
+	if err := sideeffects.WriteConfigToDisk(i.TaskResultsDir.Path, i.sideEffectsConfig); err != nil {
+		return err
+	}
+	log.Printf("Wrote side_effects.Config to %s", i.TaskResultsDir.Path)
+	return nil
+}
+
 func (i *Info) loadDUTHarnesses(ctx context.Context) error {
 	if !i.Info.IsSchedulingUnit {
 		d := makeDUTHarness(i.Info)
@@ -4,6 +4,12 @@
 
 package harness
 
+import (
+	"go.chromium.org/chromiumos/infra/proto/go/test_platform/side_effects"
+
+	"infra/cmd/skylab_swarming_worker/internal/swmbot"
+)
+
 // Option is passed to Open to configure the harness.
 // There can be two level of options, one directly applies to
 // Info while another one will applies to each DUTHarness.
@@ -11,6 +17,11 @@ type Option interface {
 	option()
 }
 
+type infoOption interface {
+	Option
+	configureInfo(*Info)
+}
+
 type dutHarnessOption interface {
 	Option
 	configureDutHarness(*DUTHarness)
@@ -36,3 +47,69 @@ func (o updateInventoryOpt) configureDutHarness(dh *DUTHarness) {
 func UpdateInventory(name string) Option {
 	return updateInventoryOpt{name: name}
 }
+
+// Assert that readOnlyOpt matches both infoOption and dutHarnessOption.
+var (
+	_ infoOption       = readOnlyOpt{}
+	_ dutHarnessOption = readOnlyOpt{}
+)
+
+type readOnlyOpt struct{}
+
+func (readOnlyOpt) option() {}
+
+func (readOnlyOpt) configureInfo(i *Info) {
+	i.readOnly = true
+}
+
+func (readOnlyOpt) configureDutHarness(dh *DUTHarness) {
+	dh.readOnly = true
+}
+
+// ReadOnly returns a readOnlyOpt that opens the harness without
+// mutating any state outside of the results directory: the inventory
+// and the local DUT state are not updated on Close, and the task
+// results directory is not sealed for upload.  It takes precedence
+// over UpdateInventory.
+func ReadOnly() Option {
+	return readOnlyOpt{}
+}
+
+// Assert that sideEffectsConfigOpt matches infoOption.
+var _ infoOption = sideEffectsConfigOpt{}
+
+type sideEffectsConfigOpt struct {
+	c *side_effects.Config
+}
+
+func (sideEffectsConfigOpt) option() {}
+
+func (o sideEffectsConfigOpt) configureInfo(i *Info) {
+	i.sideEffectsConfig = o.c
+}
+
+// SideEffectsConfig returns a sideEffectsConfigOpt that writes the
+// side_effects.Config into the task results directory, for the uploaders
+// run after the task.
+func SideEffectsConfig(c *side_effects.Config) Option {
+	return sideEffectsConfigOpt{c: c}
+}
+
+// Assert that resultsLayoutOpt matches infoOption.
+var _ infoOption = resultsLayoutOpt{}
+
+type resultsLayoutOpt struct {
+	l swmbot.ResultsLayout
+}
+
+func (resultsLayoutOpt) option() {}
+
+func (o resultsLayoutOpt) configureInfo(i *Info) {
+	i.Layout = o.l
+}
+
+// ResultsLayout returns a resultsLayoutOpt that creates the task and DUT
+// results directories in the layout l, instead of swmbot.LuciferLayout.
+func ResultsLayout(l swmbot.ResultsLayout) Option {
+	return resultsLayoutOpt{l: l}
+}
@@ -10,7 +10,6 @@ package swmbot
 import (
 	"fmt"
 	"os"
-	"path/filepath"
 	"strings"
 
 	"infra/cmd/skylab_swarming_worker/internal/lucifer"
@@ -85,13 +84,6 @@ func (b *Info) LuciferConfig() lucifer.Config {
 	}
 }
 
-// ResultsDir returns the path to the results directory used by the bot task.
-func (b *Info) ResultsDir() string {
-	// TODO(pprabhu): Reflect the requesting swarming server URL in the resultdir.
-	// This will truly disambiguate results between different swarming servers.
-	return filepath.Join(b.AutotestPath, "results", resultsSubdir(b.Task.RunID))
-}
-
 // TaskRunURL returns the URL for the current Swarming task execution.
 func (b *Info) TaskRunURL() string {
 	// TODO(ayatane): Remove this fallback once SWARMING_SERVICE is passed down here.
@@ -102,19 +94,15 @@ func (b *Info) TaskRunURL() string {
 }
 
 // StainlessURL returns the URL to the stainless logs browser for logs offloaded
-// from this task.
-func (t *Task) StainlessURL() string {
+// from this task, whose results are in the layout l.
+func (t *Task) StainlessURL(l ResultsLayout) string {
 	return fmt.Sprintf(
 		"https://stainless.corp.google.com/browse/chromeos-autotest-results/%s/",
-		resultsSubdir(t.RunID))
+		l.OffloadSubdir(t))
 }
 
 // GsURL returns the URL for the Google Storage location of the logs offloaded
-// from this task.
-func (t *Task) GsURL(gsBucket string) string {
-	return fmt.Sprintf("gs://%s/%s/", gsBucket, resultsSubdir(t.RunID))
-}
-
-func resultsSubdir(runID string) string {
-	return filepath.Join(fmt.Sprintf("swarming-%s0", runID[:len(runID)-1]), runID[len(runID)-1:])
+// from this task, whose results are in the layout l.
+func (t *Task) GsURL(gsBucket string, l ResultsLayout) string {
+	return fmt.Sprintf("gs://%s/%s/", gsBucket, l.OffloadSubdir(t))
 }
@@ -38,7 +38,7 @@ func TestTask_StainlessURL(t *testing.T) {
 	}
 	// Stainless browser expects directory paths to contain a trailing /
 	suffix := filepath.Join("swarming-3e4391423c3a4310", "a") + "/"
-	got := task.StainlessURL()
+	got := task.StainlessURL(LuciferLayout{})
 	if !strings.HasSuffix(got, suffix) {
 		t.Errorf("Stainless URL does not have suffix %s: %s", suffix, got)
 	}
@@ -0,0 +1,131 @@
+// Copyright 2021 The Chromium OS Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package swmbot
+
+import (
+	"fmt"
+	"path"
+	"path/filepath"
+)
+
+// ResultsLayout describes where a task and its DUTs write their results,
+// which is where the results are parsed and offloaded from.
+type ResultsLayout interface {
+	// Name returns the name of the layout, used to select it.
+	Name() string
+	// TaskResultsDir returns the path to the results directory of the task
+	// run by the bot.
+	TaskResultsDir(b *Info) string
+	// DUTResultsDir returns the path to the results directory of the DUT,
+	// given the task results directory.
+	DUTResultsDir(taskDir, hostname string) string
+	// AnnotationFIFOPath returns the path to the FIFO that LogDog
+	// annotations are written to, given the task results directory.
+	AnnotationFIFOPath(taskDir string) string
+	// StatusLogPath returns the path to the Autotest status log of the DUT,
+	// given the DUT results directory.
+	StatusLogPath(dutDir string) string
+	// OffloadSubdir returns the path of the results of the task in the
+	// results bucket, relative to the bucket.
+	OffloadSubdir(t *Task) string
+}
+
+// Results layout names.
+const (
+	LuciferLayoutName = "lucifer"
+	TRv2LayoutName    = "trv2"
+)
+
+// ResultsLayoutByName returns the results layout with the given name.
+func ResultsLayoutByName(name string) (ResultsLayout, error) {
+	switch name {
+	case LuciferLayoutName:
+		return LuciferLayout{}, nil
+	case TRv2LayoutName:
+		return TRv2Layout{}, nil
+	default:
+		return nil, fmt.Errorf("unknown results layout %q", name)
+	}
+}
+
+// LuciferLayout is the results layout of lucifer tasks, and the default.
+//
+//   <autotest>/results/swarming-<run ID but last digit>0/<last digit>/
+//     logdog.fifo
+//     <hostname>/
+//       status.log
+type LuciferLayout struct{}
+
+// Name implements ResultsLayout.
+func (LuciferLayout) Name() string {
+	return LuciferLayoutName
+}
+
+// TaskResultsDir implements ResultsLayout.
+func (l LuciferLayout) TaskResultsDir(b *Info) string {
+	// TODO(pprabhu): Reflect the requesting swarming server URL in the resultdir.
+	// This will truly disambiguate results between different swarming servers.
+	return filepath.Join(b.AutotestPath, "results", filepath.FromSlash(l.OffloadSubdir(&b.Task)))
+}
+
+// DUTResultsDir implements ResultsLayout.
+func (LuciferLayout) DUTResultsDir(taskDir, hostname string) string {
+	return filepath.Join(taskDir, hostname)
+}
+
+// AnnotationFIFOPath implements ResultsLayout.
+func (LuciferLayout) AnnotationFIFOPath(taskDir string) string {
+	return filepath.Join(taskDir, "logdog.fifo")
+}
+
+// StatusLogPath implements ResultsLayout.
+func (LuciferLayout) StatusLogPath(dutDir string) string {
+	return filepath.Join(dutDir, "status.log")
+}
+
+// OffloadSubdir implements ResultsLayout.
+func (LuciferLayout) OffloadSubdir(t *Task) string {
+	runID := t.RunID
+	return path.Join(fmt.Sprintf("swarming-%s0", runID[:len(runID)-1]), runID[len(runID)-1:])
+}
+
+// TRv2Layout is the results layout of the tasks run by the test runner v2
+// flows, which keep the results of each DUT under autoserv_test.
+//
+//   <autotest>/results/trv2/swarming-<run ID>/
+//     annotations.fifo
+//     autoserv_test/<hostname>/
+//       results/status.log
+type TRv2Layout struct{}
+
+// Name implements ResultsLayout.
+func (TRv2Layout) Name() string {
+	return TRv2LayoutName
+}
+
+// TaskResultsDir implements ResultsLayout.
+func (l TRv2Layout) TaskResultsDir(b *Info) string {
+	return filepath.Join(b.AutotestPath, "results", filepath.FromSlash(l.OffloadSubdir(&b.Task)))
+}
+
+// DUTResultsDir implements ResultsLayout.
+func (TRv2Layout) DUTResultsDir(taskDir, hostname string) string {
+	return filepath.Join(taskDir, "autoserv_test", hostname)
+}
+
+// AnnotationFIFOPath implements ResultsLayout.
+func (TRv2Layout) AnnotationFIFOPath(taskDir string) string {
+	return filepath.Join(taskDir, "annotations.fifo")
+}
+
+// StatusLogPath implements ResultsLayout.
+func (TRv2Layout) StatusLogPath(dutDir string) string {
+	return filepath.Join(dutDir, "results", "status.log")
+}
+
+// OffloadSubdir implements ResultsLayout.
+func (TRv2Layout) OffloadSubdir(t *Task) string {
+	return path.Join("trv2", "swarming-"+t.RunID)
+}
@@ -0,0 +1,89 @@
+// Copyright 2021 The Chromium OS Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package swmbot
+
+import (
+	"testing"
+)
+
+func TestResultsLayouts(t *testing.T) {
+	t.Parallel()
+	b := &Info{
+		AutotestPath: "/usr/local/autotest",
+		Task:         Task{RunID: "3e4391423c3a431a"},
+	}
+	testCases := []struct {
+		layout       ResultsLayout
+		taskDir      string
+		dutDir       string
+		fifo         string
+		statusLog    string
+		stainlessURL string
+		gsURL        string
+	}{
+		{
+			layout:       LuciferLayout{},
+			taskDir:      "/usr/local/autotest/results/swarming-3e4391423c3a4310/a",
+			dutDir:       "/usr/local/autotest/results/swarming-3e4391423c3a4310/a/host1",
+			fifo:         "/usr/local/autotest/results/swarming-3e4391423c3a4310/a/logdog.fifo",
+			statusLog:    "/usr/local/autotest/results/swarming-3e4391423c3a4310/a/host1/status.log",
+			stainlessURL: "https://stainless.corp.google.com/browse/chromeos-autotest-results/swarming-3e4391423c3a4310/a/",
+			gsURL:        "gs://bucket/swarming-3e4391423c3a4310/a/",
+		},
+		{
+			layout:       TRv2Layout{},
+			taskDir:      "/usr/local/autotest/results/trv2/swarming-3e4391423c3a431a",
+			dutDir:       "/usr/local/autotest/results/trv2/swarming-3e4391423c3a431a/autoserv_test/host1",
+			fifo:         "/usr/local/autotest/results/trv2/swarming-3e4391423c3a431a/annotations.fifo",
+			statusLog:    "/usr/local/autotest/results/trv2/swarming-3e4391423c3a431a/autoserv_test/host1/results/status.log",
+			stainlessURL: "https://stainless.corp.google.com/browse/chromeos-autotest-results/trv2/swarming-3e4391423c3a431a/",
+			gsURL:        "gs://bucket/trv2/swarming-3e4391423c3a431a/",
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.layout.Name(), func(t *testing.T) {
+			t.Parallel()
+			l := tc.layout
+			taskDir := l.TaskResultsDir(b)
+			if taskDir != tc.taskDir {
+				t.Errorf("TaskResultsDir() = %s, want %s", taskDir, tc.taskDir)
+			}
+			dutDir := l.DUTResultsDir(taskDir, "host1")
+			if dutDir != tc.dutDir {
+				t.Errorf("DUTResultsDir() = %s, want %s", dutDir, tc.dutDir)
+			}
+			if got := l.AnnotationFIFOPath(taskDir); got != tc.fifo {
+				t.Errorf("AnnotationFIFOPath() = %s, want %s", got, tc.fifo)
+			}
+			if got := l.StatusLogPath(dutDir); got != tc.statusLog {
+				t.Errorf("StatusLogPath() = %s, want %s", got, tc.statusLog)
+			}
+			if got := b.Task.StainlessURL(l); got != tc.stainlessURL {
+				t.Errorf("StainlessURL() = %s, want %s", got, tc.stainlessURL)
+			}
+			if got := b.Task.GsURL("bucket", l); got != tc.gsURL {
+				t.Errorf("GsURL() = %s, want %s", got, tc.gsURL)
+			}
+		})
+	}
+}
+
+func TestResultsLayoutByName(t *testing.T) {
+	t.Parallel()
+	for _, name := range []string{LuciferLayoutName, TRv2LayoutName} {
+		l, err := ResultsLayoutByName(name)
+		if err != nil {
+			t.Errorf("ResultsLayoutByName(%q) returned error: %s", name, err)
+			continue
+		}
+		if l.Name() != name {
+			t.Errorf("ResultsLayoutByName(%q) returned layout %q", name, l.Name())
+		}
+	}
+	if _, err := ResultsLayoutByName("bogus"); err == nil {
+		t.Errorf("ResultsLayoutByName(%q) returned no error", "bogus")
+	}
+}
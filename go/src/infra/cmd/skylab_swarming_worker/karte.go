@@ -0,0 +1,82 @@
+// Copyright 2021 The Chromium OS Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package main
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"go.chromium.org/luci/auth"
+
+	"infra/cmd/skylab_swarming_worker/internal/swmbot/harness"
+	kclient "infra/cros/karte/client"
+	"infra/cros/recovery/karte"
+	"infra/cros/recovery/logger/metrics"
+)
+
+// Karte observation kinds recorded for lucifer tasks.
+const (
+	dutHostnameObservation = "dut_hostname"
+	dutStateObservation    = "dut_state"
+	durationObservation    = "duration_seconds"
+)
+
+// newKarteMetrics returns a client for recording actions in Karte, or
+// nil if Karte reporting is disabled.
+//
+// Karte reporting is best-effort, so failing to create the client is
+// logged and Karte reporting is disabled.
+func newKarteMetrics(ctx context.Context, a *args) metrics.Metrics {
+	if !a.recordKarte {
+		return nil
+	}
+	m, err := karte.NewMetrics(ctx, kclient.DevConfig(auth.Options{}))
+	if err != nil {
+		log.Printf("Failed to create Karte client, not recording Karte actions: %s", err)
+		return nil
+	}
+	return m
+}
+
+// luciferActionKind returns the Karte action kind for the lucifer task,
+// e.g. "lucifer_repair" for an admin repair task.
+func luciferActionKind(a *args) string {
+	if task, ok := getAdminTask(a.taskName); ok {
+		return "lucifer_" + task
+	}
+	return "lucifer_" + a.taskName
+}
+
+// recordLuciferAction records the outcome of the lucifer task for a DUT
+// as a Karte action.  If m is nil, nothing is recorded.
+//
+// Errors are logged and otherwise ignored, so that Karte never fails
+// the task.
+func recordLuciferAction(ctx context.Context, m metrics.Metrics, a *args, dh *harness.DUTHarness, start, stop time.Time, luciferErr error) {
+	if m == nil {
+		return
+	}
+	action := &metrics.Action{
+		ActionKind:     luciferActionKind(a),
+		SwarmingTaskID: dh.BotInfo.Task.RunID,
+		AssetTag:       dh.DUTID,
+		StartTime:      start,
+		StopTime:       stop,
+		Status:         metrics.ActionStatusSuccess,
+		Observations: []*metrics.Observation{
+			metrics.NewStringObservation(dutHostnameObservation, dh.DUTHostname),
+			metrics.NewStringObservation(dutStateObservation, string(dh.LocalState.HostState)),
+			metrics.NewFloat64Observation(durationObservation, stop.Sub(start).Seconds()),
+		},
+	}
+	if luciferErr != nil {
+		action.Status = metrics.ActionStatusFail
+		action.FailReason = luciferErr.Error()
+	}
+	if _, err := m.Create(ctx, action); err != nil {
+		log.Printf("Failed to record Karte action for %s: %s", dh.DUTHostname, err)
+	}
+}
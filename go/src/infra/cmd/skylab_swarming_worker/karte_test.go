@@ -0,0 +1,127 @@
+// Copyright 2021 The Chromium OS Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package main
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"infra/cmd/skylab_swarming_worker/internal/swmbot"
+	"infra/cmd/skylab_swarming_worker/internal/swmbot/harness"
+	"infra/cros/dutstate"
+	"infra/cros/recovery/logger/metrics"
+)
+
+// fakeMetrics records the actions created.
+type fakeMetrics struct {
+	actions []*metrics.Action
+	err     error
+}
+
+func (m *fakeMetrics) Create(ctx context.Context, action *metrics.Action) (*metrics.Action, error) {
+	m.actions = append(m.actions, action)
+	return action, m.err
+}
+
+func (m *fakeMetrics) Update(ctx context.Context, action *metrics.Action) (*metrics.Action, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (m *fakeMetrics) Search(ctx context.Context, q *metrics.Query) (*metrics.QueryResult, error) {
+	return nil, errors.New("not implemented")
+}
+
+func newFakeDUTHarness(state dutstate.State) *harness.DUTHarness {
+	return &harness.DUTHarness{
+		BotInfo: &swmbot.Info{
+			Task: swmbot.Task{RunID: "task1"},
+		},
+		DUTID:       "dut1",
+		DUTHostname: "host1",
+		LocalState:  &swmbot.LocalDUTState{HostState: state},
+	}
+}
+
+func TestRecordLuciferAction(t *testing.T) {
+	t.Parallel()
+
+	start := time.Date(2021, time.December, 1, 12, 0, 0, 0, time.UTC)
+	stop := start.Add(90 * time.Second)
+
+	testCases := []struct {
+		task       string
+		state      dutstate.State
+		luciferErr error
+		kind       string
+		status     metrics.ActionStatus
+		failReason string
+	}{
+		{adminRepair, dutstate.Ready, nil, "lucifer_repair", metrics.ActionStatusSuccess, ""},
+		{adminRepair, dutstate.RepairFailed, errors.New("repair failed"), "lucifer_repair", metrics.ActionStatusFail, "repair failed"},
+		{deploy, dutstate.Ready, nil, "lucifer_deploy", metrics.ActionStatusSuccess, ""},
+		{adminAudit, dutstate.NeedsRepair, nil, "lucifer_audit", metrics.ActionStatusSuccess, ""},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.kind+"_"+string(tc.status), func(t *testing.T) {
+			t.Parallel()
+			m := &fakeMetrics{}
+			a := &args{taskName: tc.task}
+			recordLuciferAction(context.Background(), m, a, newFakeDUTHarness(tc.state), start, stop, tc.luciferErr)
+			if len(m.actions) != 1 {
+				t.Fatalf("Input task was %s - got %d actions, expected 1", tc.task, len(m.actions))
+			}
+			got := m.actions[0]
+			if got.ActionKind != tc.kind {
+				t.Errorf("Input task was %s - action kind was incorrect, got: %s, expected: %s", tc.task, got.ActionKind, tc.kind)
+			}
+			if got.Status != tc.status {
+				t.Errorf("Input task was %s - status was incorrect, got: %s, expected: %s", tc.task, got.Status, tc.status)
+			}
+			if got.FailReason != tc.failReason {
+				t.Errorf("Input task was %s - fail reason was incorrect, got: %q, expected: %q", tc.task, got.FailReason, tc.failReason)
+			}
+			if got.SwarmingTaskID != "task1" || got.AssetTag != "dut1" {
+				t.Errorf("Input task was %s - got task ID %q and asset tag %q, expected task1 and dut1", tc.task, got.SwarmingTaskID, got.AssetTag)
+			}
+			if !got.StartTime.Equal(start) || !got.StopTime.Equal(stop) {
+				t.Errorf("Input task was %s - got times %s to %s, expected %s to %s", tc.task, got.StartTime, got.StopTime, start, stop)
+			}
+			observations := make(map[string]string)
+			for _, o := range got.Observations {
+				observations[o.MetricKind] = o.Value
+			}
+			expected := map[string]string{
+				dutHostnameObservation: "host1",
+				dutStateObservation:    string(tc.state),
+				durationObservation:    metrics.NewFloat64Observation(durationObservation, 90).Value,
+			}
+			for k, v := range expected {
+				if observations[k] != v {
+					t.Errorf("Input task was %s - observation %s was incorrect, got: %q, expected: %q", tc.task, k, observations[k], v)
+				}
+			}
+		})
+	}
+}
+
+func TestRecordLuciferActionDisabled(t *testing.T) {
+	t.Parallel()
+	// A nil Metrics disables recording, and must not panic.
+	recordLuciferAction(context.Background(), nil, &args{taskName: adminRepair}, newFakeDUTHarness(dutstate.Ready), time.Now(), time.Now(), nil)
+}
+
+func TestRecordLuciferActionCreateError(t *testing.T) {
+	t.Parallel()
+	// Failing to record an action is logged and otherwise ignored.
+	m := &fakeMetrics{err: errors.New("karte unavailable")}
+	recordLuciferAction(context.Background(), m, &args{taskName: adminRepair}, newFakeDUTHarness(dutstate.Ready), time.Now(), time.Now(), nil)
+	if len(m.actions) != 1 {
+		t.Errorf("got %d actions, expected 1", len(m.actions))
+	}
+}
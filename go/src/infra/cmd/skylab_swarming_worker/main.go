@@ -20,10 +20,22 @@
 //   LUCIFER_TOOLS_DIR: Path to the lucifer installation.
 //   PARSER_PATH: Path to the autotest_status_parser installation.
 //   SKYLAB_DUT_ID: skylab_inventory id of the DUT that belongs to this bot.
+//   SKYLAB_WORKER_RECORD_KARTE: If set to "1", record lucifer task outcomes
+//     in Karte.  Overridden by the -record-karte flag.
 //
 // Per-task variables:
 //
 //   SWARMING_TASK_ID: task id of the swarming task being serviced.
+//
+// With -dry-run, skylab_swarming_worker opens a read-only harness and
+// prints the lucifer command and results directory of each DUT instead
+// of running the task.  Neither the inventory nor the local DUT state
+// is updated, so this can be used to check a task's args and the
+// variables above on a dev workstation.
+//
+// The results directories are laid out for lucifer by default.  Tasks
+// run by the test runner v2 flows select their layout with
+// -results-layout=trv2, or with the results_layout job keyval.
 
 package main
 
@@ -31,8 +43,10 @@ import (
 	"context"
 	"flag"
 	"fmt"
+	"io"
 	"log"
 	"os"
+	"os/exec"
 	"path/filepath"
 	"strings"
 	"time"
@@ -47,6 +61,7 @@ import (
 	"infra/cmd/skylab_swarming_worker/internal/swmbot"
 	"infra/cmd/skylab_swarming_worker/internal/swmbot/harness"
 	"infra/cros/dutstate"
+	"infra/cros/recovery/logger/metrics"
 )
 
 // Task names.
@@ -76,11 +91,15 @@ func main() {
 type args struct {
 	adminService        string
 	deadline            time.Time
+	dryRun              bool
 	actions             string
 	isolatedOutdir      string
 	logdogAnnotationURL string
+	recordKarte         bool
+	resultsLayout       string
 	sideEffectsConfig   string
 	taskName            string
+	validateSideEffects bool
 	xClientTest         bool
 	xKeyvals            map[string]string
 	xProvisionLabels    []string
@@ -109,9 +128,18 @@ func parseArgs() *args {
 	flag.StringVar(&a.isolatedOutdir, "isolated-outdir", "",
 		"Directory to place isolated output into. Generate no isolated output if not set.")
 	flag.StringVar(&a.sideEffectsConfig, "side-effect-config", "",
-		"JSONpb string of side_effects.Config to be dropped into the results directory. No file is created if empty.")
+		"JSONpb string of side_effects.Config to be validated and dropped into the results directory. No file is created if empty.")
+	flag.BoolVar(&a.validateSideEffects, "validate-side-effects-only", false,
+		"Only validate -side-effect-config, without running the task.")
 	flag.Var(lflag.Time(&a.deadline), "deadline",
 		"Soft deadline for completion, formatted as stiptime. Wrap-up actions may outlive this deadline.")
+	flag.BoolVar(&a.recordKarte, "record-karte", os.Getenv("SKYLAB_WORKER_RECORD_KARTE") == "1",
+		"Record lucifer task outcomes in Karte. Failures to record are logged and ignored.")
+	flag.StringVar(&a.resultsLayout, "results-layout", "",
+		fmt.Sprintf("Layout of the results directories, %q or %q. If empty, the %q keyval selects it, defaulting to %q.",
+			swmbot.LuciferLayoutName, swmbot.TRv2LayoutName, resultsLayoutKeyval, swmbot.LuciferLayoutName))
+	flag.BoolVar(&a.dryRun, "dry-run", false,
+		"Print the lucifer command and results directory of each DUT without running the task or updating any state.")
 	flag.Parse()
 
 	return a
@@ -121,14 +149,32 @@ func mainInner(a *args) error {
 	ctx := context.Background()
 	// Set up Go logger for LUCI libraries.
 	ctx = gologger.StdConfig.Use(ctx)
+	if a.validateSideEffects {
+		return validateSideEffectsConfig(a.sideEffectsConfig)
+	}
 	b := swmbot.GetInfo()
 	log.Printf("Swarming bot config: %#v", b)
+	if a.dryRun {
+		return dryRun(ctx, a, b, os.Stdout)
+	}
 	annotWriter, err := openLogDogWriter(ctx, a.logdogAnnotationURL)
 	if err != nil {
 		return err
 	}
 	defer annotWriter.Close()
-	i, err := harness.Open(ctx, b, harnessOptions(a)...)
+	ho, err := harnessOptions(a)
+	if err != nil {
+		return err
+	}
+	if a.sideEffectsConfig != "" {
+		// Fail early, rather than when the results are uploaded.
+		sec, err := parseSideEffectsConfig(a.sideEffectsConfig, annotWriter)
+		if err != nil {
+			return err
+		}
+		ho = append(ho, harness.SideEffectsConfig(sec))
+	}
+	i, err := harness.Open(ctx, b, ho...)
 	log.Printf("mainInner: harness info object (%#v)", i)
 	if err != nil {
 		return err
@@ -137,19 +183,12 @@ func mainInner(a *args) error {
 
 	var luciferErr error
 
+	state, isSetState := setStateTasks[a.taskName]
 	switch {
-	case a.taskName == setStateNeedsRepairTaskName:
-		setStateForDUTs(i, dutstate.NeedsRepair)
-	case a.taskName == setStateReservedTaskName:
-		setStateForDUTs(i, dutstate.Reserved)
-	case a.taskName == setStateManualRepairTaskName:
-		setStateForDUTs(i, dutstate.ManualRepair)
-	case a.taskName == setStateNeedsReplacementTaskName:
-		setStateForDUTs(i, dutstate.NeedsReplacement)
-	case a.taskName == setStateNeedsManualRepairTaskName:
-		setStateForDUTs(i, dutstate.NeedsManualRepair)
+	case isSetState:
+		setStateForDUTs(i, state)
 	case isSupportedLuciferTask(a):
-		luciferErr = luciferFlow(ctx, a, i, annotWriter)
+		luciferErr = luciferFlow(ctx, a, i, annotWriter, newKarteMetrics(ctx, a))
 	default:
 		luciferErr = errors.Reason("skylab_swarming_worker failed to recognize task type").Err()
 	}
@@ -166,7 +205,9 @@ func setStateForDUTs(i *harness.Info, state dutstate.State) {
 	}
 }
 
-func luciferFlow(ctx context.Context, a *args, i *harness.Info, annotWriter writeCloser) error {
+// luciferFlow runs the lucifer task for each DUT.  If m is not nil, the
+// outcome of each DUT's task is recorded as a Karte action.
+func luciferFlow(ctx context.Context, a *args, i *harness.Info, annotWriter writeCloser, m metrics.Metrics) error {
 	var fifoPath string
 	if a.logdogAnnotationURL != "" {
 		// Set up FIFO, pipe, and goroutines like so:
@@ -176,7 +217,7 @@ func luciferFlow(ctx context.Context, a *args, i *harness.Info, annotWriter writ
 		// lucifer -> FIFO -go-/
 		//
 		// Both the worker and Lucifer need to write to LogDog.
-		fifoPath = filepath.Join(i.TaskResultsDir.Path, "logdog.fifo")
+		fifoPath = logDogFIFOPath(i)
 		fc, err := fifo.NewCopier(annotWriter, fifoPath)
 		if err != nil {
 			return err
@@ -193,16 +234,10 @@ func luciferFlow(ctx context.Context, a *args, i *harness.Info, annotWriter writ
 	//			performance limitation.
 	var errs []error
 	for _, dh := range i.DUTs {
-		ta := lucifer.TaskArgs{
-			// Swarming task number 5670d0e630f66c10 failed due to a path length that was too long (108 chars).
-			// Let's kick the can down the road and use a shorter suffix for the abort socket.
-			// TODO(gregorynisbet): Come up with a permanent solution for short paths.
-			AbortSock:  filepath.Join(dh.ResultsDir, "sk"),
-			GCPProject: gcpProject,
-			ResultsDir: dh.ResultsDir,
-			LogDogFile: fifoPath,
-		}
+		ta := luciferTaskArgs(dh, fifoPath)
+		start := time.Now()
 		luciferErr := runLuciferTask(ctx, dh, a, ta)
+		recordLuciferAction(ctx, m, a, dh, start, time.Now(), luciferErr)
 		if luciferErr != nil {
 			// Attempt to parse results regardless of lucifer errors.
 			luciferErr = errors.Annotate(luciferErr, "run lucifer task").Err()
@@ -211,7 +246,7 @@ func luciferFlow(ctx context.Context, a *args, i *harness.Info, annotWriter writ
 		}
 	}
 	annotations.BuildStep(annotWriter, "Epilog")
-	annotations.StepLink(annotWriter, "Task results (Stainless)", i.Info.Task.StainlessURL())
+	annotations.StepLink(annotWriter, "Task results (Stainless)", i.Info.Task.StainlessURL(i.Layout))
 	annotations.StepClosed(annotWriter)
 	if len(errs) > 0 {
 		return errors.Annotate(errors.MultiError(errs), "lucifer flow").Err()
@@ -219,12 +254,122 @@ func luciferFlow(ctx context.Context, a *args, i *harness.Info, annotWriter writ
 	return nil
 }
 
-func harnessOptions(a *args) []harness.Option {
-	var ho []harness.Option
+// logDogFIFOPath returns the path of the FIFO lucifer writes LogDog
+// annotations to.
+func logDogFIFOPath(i *harness.Info) string {
+	return i.Layout.AnnotationFIFOPath(i.TaskResultsDir.Path)
+}
+
+// luciferTaskArgs returns the common lucifer task args for the DUT.
+func luciferTaskArgs(dh *harness.DUTHarness, logDogFile string) lucifer.TaskArgs {
+	return lucifer.TaskArgs{
+		// Swarming task number 5670d0e630f66c10 failed due to a path length that was too long (108 chars).
+		// Let's kick the can down the road and use a shorter suffix for the abort socket.
+		// TODO(gregorynisbet): Come up with a permanent solution for short paths.
+		AbortSock:  filepath.Join(dh.ResultsDir, "sk"),
+		GCPProject: gcpProject,
+		ResultsDir: dh.ResultsDir,
+		LogDogFile: logDogFile,
+	}
+}
+
+// dryRun opens a read-only harness and writes what the task would do
+// for each DUT to w.
+func dryRun(ctx context.Context, a *args, b *swmbot.Info, w io.Writer) error {
+	ho, err := harnessOptions(a)
+	if err != nil {
+		return err
+	}
+	i, err := harness.Open(ctx, b, ho...)
+	if err != nil {
+		return err
+	}
+	defer i.Close(ctx)
+	if err := printDryRun(w, a, i); err != nil {
+		return err
+	}
+	return i.Close(ctx)
+}
+
+// setStateTasks maps the names of the tasks which only set the DUT
+// state to the state they set.
+var setStateTasks = map[string]dutstate.State{
+	setStateNeedsRepairTaskName:       dutstate.NeedsRepair,
+	setStateReservedTaskName:          dutstate.Reserved,
+	setStateManualRepairTaskName:      dutstate.ManualRepair,
+	setStateNeedsReplacementTaskName:  dutstate.NeedsReplacement,
+	setStateNeedsManualRepairTaskName: dutstate.NeedsManualRepair,
+}
+
+// printDryRun writes the parsed task args and, for each DUT, the results
+// directory and the lucifer command the task would run to w.
+func printDryRun(w io.Writer, a *args, i *harness.Info) error {
+	state, isSetState := setStateTasks[a.taskName]
+	if !isSetState && !isSupportedLuciferTask(a) {
+		return errors.Reason("skylab_swarming_worker failed to recognize task type").Err()
+	}
+	fmt.Fprintf(w, "Task: %s\n", a.taskName)
+	fmt.Fprintf(w, "Results layout: %s\n", i.Layout.Name())
+	fmt.Fprintf(w, "Task results dir: %s\n", i.TaskResultsDir.Path)
+	if len(a.xKeyvals) > 0 {
+		fmt.Fprintf(w, "Keyvals: %v\n", a.xKeyvals)
+	}
+	if len(a.xProvisionLabels) > 0 {
+		fmt.Fprintf(w, "Provision labels: %s\n", strings.Join(a.xProvisionLabels, ","))
+	}
+	var fifoPath string
+	if a.logdogAnnotationURL != "" {
+		fifoPath = logDogFIFOPath(i)
+	}
+	for _, dh := range i.DUTs {
+		fmt.Fprintf(w, "DUT %s (%s)\n", dh.DUTHostname, dh.DUTID)
+		fmt.Fprintf(w, "  Results dir: %s\n", dh.ResultsDir)
+		fmt.Fprintf(w, "  Status log: %s\n", i.Layout.StatusLogPath(dh.ResultsDir))
+		if isSetState {
+			fmt.Fprintf(w, "  Set state: %s\n", state)
+			continue
+		}
+		cmd, _ := luciferTaskCommand(dh, a, luciferTaskArgs(dh, fifoPath))
+		fmt.Fprintf(w, "  Command: %s\n", strings.Join(cmd.Args, " "))
+	}
+	return nil
+}
+
+func harnessOptions(a *args) ([]harness.Option, error) {
+	l, err := resultsLayout(a)
+	if err != nil {
+		return nil, err
+	}
+	ho := []harness.Option{harness.ResultsLayout(l)}
+	if a.dryRun {
+		ho = append(ho, harness.ReadOnly())
+	}
 	if updatesInventory(a) {
 		ho = append(ho, harness.UpdateInventory(getTaskName(a)))
 	}
-	return ho
+	return ho, nil
+}
+
+// resultsLayoutKeyval is the job keyval selecting the layout of the results
+// directories, if the -results-layout flag is not set.
+const resultsLayoutKeyval = "results_layout"
+
+// resultsLayout returns the layout of the results directories selected by
+// the -results-layout flag, or else by the results_layout keyval, defaulting
+// to the lucifer layout.
+func resultsLayout(a *args) (swmbot.ResultsLayout, error) {
+	name := a.resultsLayout
+	if name == "" {
+		name = a.xKeyvals[resultsLayoutKeyval]
+	}
+	if name == "" {
+		return swmbot.LuciferLayout{}, nil
+	}
+	l, err := swmbot.ResultsLayoutByName(name)
+	if err != nil {
+		return nil, errors.Annotate(err, "results layout").Err()
+	}
+	return l, nil
 }
 
 func isSupportedLuciferTask(a *args) bool {
@@ -260,14 +405,24 @@ func runLuciferTask(ctx context.Context, dh *harness.DUTHarness, a *args, ta luc
 		ctx, c = context.WithDeadline(ctx, a.deadline)
 		defer c()
 	}
+	cmd, kind := luciferTaskCommand(dh, a, ta)
+	if _, err := runLuciferCommand(ctx, cmd, dh, ta.AbortSock); err != nil {
+		return errors.Annotate(err, "run %s task", kind).Err()
+	}
+	return nil
+}
+
+// luciferTaskCommand returns the lucifer command running the task for
+// the DUT, and the kind of the task (admin/deploy/audit).
+func luciferTaskCommand(dh *harness.DUTHarness, a *args, ta lucifer.TaskArgs) (cmd *exec.Cmd, kind string) {
 	switch {
 	case isAuditTask(a):
-		return runAuditTask(ctx, dh, a.actions, ta)
+		return auditTaskCommand(dh, a.actions, ta), "audit"
 	case isAdminTask(a):
 		n, _ := getAdminTask(a.taskName)
-		return runAdminTask(ctx, dh, n, ta)
+		return adminTaskCommand(dh, n, ta), "admin"
 	case isDeployTask(a):
-		return runDeployTask(ctx, dh, a.actions, ta)
+		return deployTaskCommand(dh, a.actions, ta), "deploy"
 	default:
 		panic("Unsupported task type")
 	}
@@ -306,51 +461,37 @@ func isRepairTask(a *args) bool {
 	return task == repairTaskName
 }
 
-// runAdminTask runs an admin task.  name is the name of the task.
-func runAdminTask(ctx context.Context, dh *harness.DUTHarness, name string, ta lucifer.TaskArgs) (err error) {
+// adminTaskCommand returns the lucifer command for an admin task.
+// name is the name of the task.
+func adminTaskCommand(dh *harness.DUTHarness, name string, ta lucifer.TaskArgs) *exec.Cmd {
 	r := lucifer.AdminTaskArgs{
 		TaskArgs: ta,
 		Host:     dh.DUTHostname,
 		Task:     name,
 	}
-
-	cmd := lucifer.AdminTaskCommand(dh.BotInfo.LuciferConfig(), r)
-	if _, err := runLuciferCommand(ctx, cmd, dh, r.AbortSock); err != nil {
-		return errors.Annotate(err, "run admin task").Err()
-	}
-	return nil
+	return lucifer.AdminTaskCommand(dh.BotInfo.LuciferConfig(), r)
 }
 
-// runDeployTask runs a deploy task using lucifer.
+// deployTaskCommand returns the lucifer command for a deploy task.
 //
 // actions is a possibly empty comma separated list of deploy actions to run
-func runDeployTask(ctx context.Context, dh *harness.DUTHarness, actions string, ta lucifer.TaskArgs) error {
+func deployTaskCommand(dh *harness.DUTHarness, actions string, ta lucifer.TaskArgs) *exec.Cmd {
 	r := lucifer.DeployTaskArgs{
 		TaskArgs: ta,
 		Host:     dh.DUTHostname,
 		Actions:  actions,
 	}
-
-	cmd := lucifer.DeployTaskCommand(dh.BotInfo.LuciferConfig(), r)
-	if _, err := runLuciferCommand(ctx, cmd, dh, r.AbortSock); err != nil {
-		return errors.Annotate(err, "run deploy task").Err()
-	}
-	return nil
+	return lucifer.DeployTaskCommand(dh.BotInfo.LuciferConfig(), r)
 }
 
-// runAuditTask runs an audit task using lucifer.
+// auditTaskCommand returns the lucifer command for an audit task.
 //
 // actions is a possibly empty comma separated list of deploy actions to run
-func runAuditTask(ctx context.Context, dh *harness.DUTHarness, actions string, ta lucifer.TaskArgs) error {
+func auditTaskCommand(dh *harness.DUTHarness, actions string, ta lucifer.TaskArgs) *exec.Cmd {
 	r := lucifer.AuditTaskArgs{
 		TaskArgs: ta,
 		Host:     dh.DUTHostname,
 		Actions:  actions,
 	}
-
-	cmd := lucifer.AuditTaskCommand(dh.BotInfo.LuciferConfig(), r)
-	if _, err := runLuciferCommand(ctx, cmd, dh, r.AbortSock); err != nil {
-		return errors.Annotate(err, "run audit task").Err()
-	}
-	return nil
+	return lucifer.AuditTaskCommand(dh.BotInfo.LuciferConfig(), r)
 }
@@ -5,7 +5,12 @@
 package main
 
 import (
+	"bytes"
 	"testing"
+
+	"infra/cmd/skylab_swarming_worker/internal/swmbot"
+	"infra/cmd/skylab_swarming_worker/internal/swmbot/harness"
+	"infra/cmd/skylab_swarming_worker/internal/swmbot/harness/resultsdir"
 )
 
 const deploy = "deploy"
@@ -153,3 +158,173 @@ func TestIsAuditTask(t *testing.T) {
 		})
 	}
 }
+
+func TestPrintDryRun(t *testing.T) {
+	t.Parallel()
+
+	b := &swmbot.Info{
+		AutotestPath:  "/autotest",
+		LabpackDir:    "/labpack",
+		LuciferBinDir: "/opt/lucifer",
+	}
+	i := &harness.Info{
+		Info:           b,
+		Layout:         swmbot.LuciferLayout{},
+		TaskResultsDir: &resultsdir.Dir{Path: "/results/swarming-1"},
+		DUTs: []*harness.DUTHarness{
+			{BotInfo: b, DUTID: "id1", DUTHostname: "host1", ResultsDir: "/results/swarming-1/host1"},
+		},
+	}
+
+	testCases := []struct {
+		name     string
+		args     args
+		expected string
+	}{
+		{
+			"admin task",
+			args{taskName: adminRepair},
+			`Task: admin_repair
+Results layout: lucifer
+Task results dir: /results/swarming-1
+DUT host1 (id1)
+  Results dir: /results/swarming-1/host1
+  Status log: /results/swarming-1/host1/status.log
+  Command: /opt/lucifer/lucifer admintask -autotestdir /autotest -labpackdir /labpack -abortsock /results/swarming-1/host1/sk -gcp-project chromeos-skylab -resultsdir /results/swarming-1/host1 -host host1 -task repair
+`,
+		},
+		{
+			"deploy task with logdog",
+			args{taskName: deploy, actions: "stage-usb", logdogAnnotationURL: "logdog://host/project/prefix/+/annotations"},
+			`Task: deploy
+Results layout: lucifer
+Task results dir: /results/swarming-1
+DUT host1 (id1)
+  Results dir: /results/swarming-1/host1
+  Status log: /results/swarming-1/host1/status.log
+  Command: /opt/lucifer/lucifer deploytask -autotestdir /autotest -labpackdir /labpack -abortsock /results/swarming-1/host1/sk -gcp-project chromeos-skylab -resultsdir /results/swarming-1/host1 -logdog-file /results/swarming-1/logdog.fifo -host host1 -actions stage-usb
+`,
+		},
+		{
+			"audit task with keyvals and provision labels",
+			args{
+				taskName:         adminAudit,
+				actions:          "verify-servo-usb-drive",
+				xKeyvals:         map[string]string{"k": "v"},
+				xProvisionLabels: []string{"cros-version:foo", "fwro-version:bar"},
+			},
+			`Task: admin_audit
+Results layout: lucifer
+Task results dir: /results/swarming-1
+Keyvals: map[k:v]
+Provision labels: cros-version:foo,fwro-version:bar
+DUT host1 (id1)
+  Results dir: /results/swarming-1/host1
+  Status log: /results/swarming-1/host1/status.log
+  Command: /opt/lucifer/lucifer audittask -autotestdir /autotest -labpackdir /labpack -abortsock /results/swarming-1/host1/sk -gcp-project chromeos-skylab -resultsdir /results/swarming-1/host1 -host host1 -actions verify-servo-usb-drive
+`,
+		},
+		{
+			"set state task",
+			args{taskName: adminSetStateNeedsRepair},
+			`Task: set_needs_repair
+Results layout: lucifer
+Task results dir: /results/swarming-1
+DUT host1 (id1)
+  Results dir: /results/swarming-1/host1
+  Status log: /results/swarming-1/host1/status.log
+  Set state: needs_repair
+`,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			var buf bytes.Buffer
+			if err := printDryRun(&buf, &tc.args, i); err != nil {
+				t.Fatalf("printDryRun returned error: %s", err)
+			}
+			if got := buf.String(); got != tc.expected {
+				t.Errorf("Unexpected output for %s, got:\n%s\nexpected:\n%s", tc.name, got, tc.expected)
+			}
+		})
+	}
+
+	t.Run("trv2 layout", func(t *testing.T) {
+		t.Parallel()
+		i := &harness.Info{
+			Info:           b,
+			Layout:         swmbot.TRv2Layout{},
+			TaskResultsDir: &resultsdir.Dir{Path: "/results/trv2/swarming-1"},
+			DUTs: []*harness.DUTHarness{
+				{BotInfo: b, DUTID: "id1", DUTHostname: "host1", ResultsDir: "/results/trv2/swarming-1/autoserv_test/host1"},
+			},
+		}
+		a := &args{taskName: adminRepair, logdogAnnotationURL: "logdog://host/project/prefix/+/annotations"}
+		expected := `Task: admin_repair
+Results layout: trv2
+Task results dir: /results/trv2/swarming-1
+DUT host1 (id1)
+  Results dir: /results/trv2/swarming-1/autoserv_test/host1
+  Status log: /results/trv2/swarming-1/autoserv_test/host1/results/status.log
+  Command: /opt/lucifer/lucifer admintask -autotestdir /autotest -labpackdir /labpack -abortsock /results/trv2/swarming-1/autoserv_test/host1/sk -gcp-project chromeos-skylab -resultsdir /results/trv2/swarming-1/autoserv_test/host1 -logdog-file /results/trv2/swarming-1/annotations.fifo -host host1 -task repair
+`
+		var buf bytes.Buffer
+		if err := printDryRun(&buf, a, i); err != nil {
+			t.Fatalf("printDryRun returned error: %s", err)
+		}
+		if got := buf.String(); got != expected {
+			t.Errorf("Unexpected output, got:\n%s\nexpected:\n%s", got, expected)
+		}
+	})
+
+	t.Run("unknown task", func(t *testing.T) {
+		t.Parallel()
+		var buf bytes.Buffer
+		if err := printDryRun(&buf, &args{taskName: "bogus"}, i); err == nil {
+			t.Errorf("printDryRun returned no error for an unknown task")
+		}
+	})
+}
+
+func TestResultsLayout(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		args     args
+		expected string
+	}{
+		{"default", args{}, swmbot.LuciferLayoutName},
+		{"flag", args{resultsLayout: "trv2"}, swmbot.TRv2LayoutName},
+		{"keyval", args{xKeyvals: map[string]string{"results_layout": "trv2"}}, swmbot.TRv2LayoutName},
+		{
+			"flag over keyval",
+			args{resultsLayout: "lucifer", xKeyvals: map[string]string{"results_layout": "trv2"}},
+			swmbot.LuciferLayoutName,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			l, err := resultsLayout(&tc.args)
+			if err != nil {
+				t.Fatalf("resultsLayout returned error: %s", err)
+			}
+			if got := l.Name(); got != tc.expected {
+				t.Errorf("Unexpected layout, got: %s, expected: %s", got, tc.expected)
+			}
+		})
+	}
+
+	t.Run("unknown layout", func(t *testing.T) {
+		t.Parallel()
+		if _, err := resultsLayout(&args{resultsLayout: "bogus"}); err == nil {
+			t.Errorf("resultsLayout returned no error for an unknown layout")
+		}
+	})
+}
@@ -7,6 +7,8 @@ package main
 import (
 	"fmt"
 	"io"
+	"io/ioutil"
+	"log"
 	"strings"
 
 	"github.com/golang/protobuf/jsonpb"
@@ -46,24 +48,15 @@ func parseSideEffectsConfig(content string, logdogOutput io.Writer) (sec *side_e
 	return &c, nil
 }
 
-// dropSideEffectsConfig write a side_effects.Config to
-// <dir>/side_effects_config.json as a JSONpb.
-func dropSideEffectsConfig(sec *side_effects.Config, dir string, logdogOutput io.Writer) (err error) {
-	annotations.SeedStep(logdogOutput, "Write side_effects_config.json")
-	annotations.StepCursor(logdogOutput, "Write side_effects_config.json")
-	annotations.StepStarted(logdogOutput)
-	defer annotations.StepClosed(logdogOutput)
-
-	defer func() {
-		if err != nil {
-			annotations.StepException(logdogOutput)
-			fmt.Fprint(logdogOutput, err.Error())
-		}
-	}()
-
-	fmt.Fprintf(logdogOutput, "Writing side_effects.Config to %s", dir)
-	if err := sideeffects.WriteConfigToDisk(dir, sec); err != nil {
-		return errors.Annotate(err, "drop side_effects.Config").Err()
+// validateSideEffectsConfig parses and validates a side_effects.Config
+// JSONpb string, for -validate-side-effects-only.
+func validateSideEffectsConfig(content string) error {
+	if content == "" {
+		return errors.Reason("validate side_effects.Config: -side-effect-config is empty").Err()
+	}
+	if _, err := parseSideEffectsConfig(content, ioutil.Discard); err != nil {
+		return err
 	}
+	log.Printf("side_effects.Config is valid")
 	return nil
 }
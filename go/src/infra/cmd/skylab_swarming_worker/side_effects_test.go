@@ -0,0 +1,59 @@
+// Copyright 2021 The Chromium OS Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestValidateSideEffectsConfig(t *testing.T) {
+	t.Parallel()
+
+	dir, err := ioutil.TempDir("", "side_effects")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	socket := filepath.Join(dir, "proxy.sock")
+	if err := ioutil.WriteFile(socket, nil, 0644); err != nil {
+		t.Fatal(err)
+	}
+	config := func(bucket string) string {
+		return fmt.Sprintf(`{
+			"tko": {"proxySocket": %q, "mysqlUser": "user", "encryptedMysqlPassword": "password"},
+			"googleStorage": {"bucket": %q}
+		}`, socket, bucket)
+	}
+
+	testCases := []struct {
+		name    string
+		content string
+		valid   bool
+	}{
+		{"valid", config("chromeos-autotest-results"), true},
+		{"empty", "", false},
+		{"malformed", "{", false},
+		{"missing bucket", config(""), false},
+		{"bucket with scheme", config("gs://chromeos-autotest-results"), false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			err := validateSideEffectsConfig(tc.content)
+			if tc.valid && err != nil {
+				t.Errorf("validateSideEffectsConfig(%q) returned error: %s", tc.content, err)
+			}
+			if !tc.valid && err == nil {
+				t.Errorf("validateSideEffectsConfig(%q) succeeded, expected error", tc.content)
+			}
+		})
+	}
+}
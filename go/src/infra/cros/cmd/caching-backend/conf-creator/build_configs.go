@@ -6,9 +6,16 @@ package main
 
 import (
 	"bytes"
+	"encoding/json"
 	"fmt"
+	"hash/fnv"
 	"html/template"
+	"io/ioutil"
 	"net"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
 	"strings"
 
 	models "infra/unifiedfleet/api/v1/models"
@@ -25,14 +32,63 @@ const (
 // nginxConfData contains information about the node which is necessary to
 // create the nginx.conf file.
 type nginxConfData struct {
-	UpstreamHost   string
-	VirtualIP      string
 	WorkerCount    uint
 	CacheSizeInGB  int
 	GSAServerCount int
 	GSAInitialPort int
+	// HealthCheckTimeout is the timeout in seconds of the health check
+	// endpoints.
+	HealthCheckTimeout uint
+	// Services are the caching services the node serves, each with its own
+	// nginx server block.
+	Services []nginxServiceData
 }
 
+// nginxServiceData contains information about a caching service which is
+// necessary to create its server block in the nginx.conf file.
+type nginxServiceData struct {
+	Name string
+	// Port is the port the server block listens on. It's the port of the
+	// caching service in UFS.
+	Port int
+	// LegacyPort is whether the server block also listens on legacyPort.
+	LegacyPort bool
+	// UpstreamHost is the host:port of the peer node, if the node is the
+	// primary of the caching service.
+	UpstreamHost string
+	VirtualIPs   hostAddrs
+}
+
+// ListenAddrs returns the addresses the server block listens on for port,
+// one per address family of the virtual IPs of the caching service.
+func (s nginxServiceData) ListenAddrs(port int) []string {
+	var addrs []string
+	if s.VirtualIPs.IPv4 != "" {
+		addrs = append(addrs, net.JoinHostPort("*", strconv.Itoa(port)))
+	}
+	if s.VirtualIPs.IPv6 != "" {
+		addrs = append(addrs, net.JoinHostPort("::", strconv.Itoa(port)))
+	}
+	return addrs
+}
+
+// ForwardedHost returns the value of the X-Forwarded-Host header of requests
+// to the upstream, i.e. the main virtual IP of the caching service and the
+// port of the request.
+func (s nginxServiceData) ForwardedHost() string {
+	return net.JoinHostPort(s.VirtualIPs.main(), "$server_port")
+}
+
+// LoopbackHost returns the host:port the server block listens on at the
+// loopback address, which the health check endpoints are reached at.
+func (s nginxServiceData) LoopbackHost() string {
+	return net.JoinHostPort(loopback(s.VirtualIPs.main()), strconv.Itoa(s.Port))
+}
+
+// legacyPort is the port that devserver clients still use. Only the first
+// caching service of a node listens on it.
+const legacyPort = 8888
+
 // Ports returns a slice of ports for the gs_archive_server upstream or backup
 // list.
 func (n nginxConfData) Ports() []int {
@@ -46,11 +102,196 @@ func (n nginxConfData) Ports() []int {
 // keepalivedConfData contains information about the node which is necessary to
 // create the keepalived.conf file.
 type keepalivedConfData struct {
-	Interface   string
+	Interface string
+	// The health check of the local nginx. The interval and timeout are in
+	// seconds. Fall and Rise are the number of consecutive failed and
+	// successful checks to change the health state.
+	HealthCheckInterval uint
+	HealthCheckTimeout  uint
+	HealthCheckFall     uint
+	HealthCheckRise     uint
+	// Instances are the VRRP instances of the caching services the node
+	// serves.
+	Instances []keepalivedInstanceData
+}
+
+// keepalivedInstanceData contains information about a caching service which
+// is necessary to create its vrrp_instance block in the keepalived.conf file.
+// A VRRP instance only has addresses of a single family, so a dual-stack
+// caching service has an instance per address family.
+type keepalivedInstanceData struct {
+	// UnicastPeer is the address of the peer node, of the same family as
+	// VirtualIP.
 	UnicastPeer string
 	VirtualIP   string
 	State       string
 	Priority    int32
+	// VirtualRouterID identifies the VRRP instance. See virtualRouterID.
+	VirtualRouterID int
+	// Port is the port of the nginx server block of the service, whose
+	// health is checked.
+	Port int
+}
+
+// IPv6 returns whether the VRRP instance is of an IPv6 virtual IP.
+func (k keepalivedInstanceData) IPv6() bool {
+	return isIPv6(k.VirtualIP)
+}
+
+// Name returns the suffix of the names of the vrrp_instance and vrrp_script
+// blocks of the VRRP instance. IPv4 and IPv6 instances have separate virtual
+// router IDs, so the names of IPv6 instances are prefixed with "v6_".
+func (k keepalivedInstanceData) Name() string {
+	if k.IPv6() {
+		return fmt.Sprintf("v6_%d", k.VirtualRouterID)
+	}
+	return strconv.Itoa(k.VirtualRouterID)
+}
+
+// HealthCheckURL returns the URL of the nginx health check endpoint of the
+// service, at the loopback address of the family of the VRRP instance.
+func (k keepalivedInstanceData) HealthCheckURL() string {
+	return fmt.Sprintf("http://%s/health", net.JoinHostPort(loopback(k.VirtualIP), strconv.Itoa(k.Port)))
+}
+
+// virtualRouterID derives the VRRP virtual router ID of a caching service
+// from its virtual IP, so that both nodes of the service agree on it. The ID
+// is in [1, 255].
+func virtualRouterID(vip string) int {
+	h := fnv.New32a()
+	h.Write([]byte(vip))
+	return int(h.Sum32()%255) + 1
+}
+
+// validateServices checks that the caching services of a node can be served
+// together, i.e. that their nginx server blocks don't listen on the same
+// port, or on a port of the gs_archive_server instances, and that their VRRP
+// instances of the same address family have different virtual router IDs.
+func validateServices(n nginxConfData, k keepalivedConfData) error {
+	ports := make(map[int]string)
+	for _, p := range n.Ports() {
+		ports[p] = "gs_archive_server"
+	}
+	for _, s := range n.Services {
+		if s.LegacyPort {
+			if other, ok := ports[legacyPort]; ok {
+				return fmt.Errorf("validate services: legacy port %d of %q is used by %q", legacyPort, s.Name, other)
+			}
+			ports[legacyPort] = s.Name
+		}
+		if other, ok := ports[s.Port]; ok {
+			return fmt.Errorf("validate services: port %d of %q is used by %q", s.Port, s.Name, other)
+		}
+		ports[s.Port] = s.Name
+	}
+	// IPv4 and IPv6 VRRP instances don't interfere with each other.
+	type routerID struct {
+		ipv6 bool
+		id   int
+	}
+	ids := make(map[routerID]string)
+	for _, i := range k.Instances {
+		id := routerID{i.IPv6(), i.VirtualRouterID}
+		if other, ok := ids[id]; ok {
+			return fmt.Errorf("validate services: virtual router ID %d of %q is used by %q", i.VirtualRouterID, i.VirtualIP, other)
+		}
+		ids[id] = i.VirtualIP
+	}
+	return nil
+}
+
+// stubStatusURL returns the URL of the nginx stub_status endpoint of the
+// server block listening on port of the caching service with virtual IP vip,
+// which the metrics exporter on the node scrapes. It's served by
+// nginxTemplate.
+func stubStatusURL(vip string, port int) string {
+	return fmt.Sprintf("http://%s/nginx_status", net.JoinHostPort(loopback(vip), strconv.Itoa(port)))
+}
+
+// exporterTargetsData contains information about a caching service of the
+// node which is necessary to create the metrics exporter targets file.
+type exporterTargetsData struct {
+	// Role is the role of the node in the caching service, i.e. "primary" or
+	// "secondary".
+	Role string
+	// VirtualIP is the main virtual IP of the service, see hostAddrs.main.
+	VirtualIP   string
+	ServiceName string
+	// Port is the port of the nginx server block of the service.
+	Port int
+}
+
+// exporterTarget is an entry of the exporter targets file. The format is
+// the same as a Prometheus file based service discovery file.
+type exporterTarget struct {
+	Targets []string          `json:"targets"`
+	Labels  map[string]string `json:"labels,omitempty"`
+}
+
+// buildExporterTargets generates the exporter targets file, with a target per
+// caching service of the node. If data is empty, i.e. the node isn't in any
+// caching service, the file has no targets.
+func buildExporterTargets(data []exporterTargetsData) (string, error) {
+	targets := []exporterTarget{}
+	for _, d := range data {
+		targets = append(targets, exporterTarget{
+			Targets: []string{stubStatusURL(d.VirtualIP, d.Port)},
+			Labels: map[string]string{
+				"role":    d.Role,
+				"vip":     d.VirtualIP,
+				"service": d.ServiceName,
+			},
+		})
+	}
+	b, err := json.MarshalIndent(targets, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("error while encoding exporter targets: %s", err)
+	}
+	return string(b) + "\n", nil
+}
+
+// writeFileAtomic writes data to the file at path. The data is written to a
+// temporary file in the same directory first and then renamed, so readers
+// never see a partially written file.
+func writeFileAtomic(path string, data []byte) (err error) {
+	f, err := ioutil.TempFile(filepath.Dir(path), "."+filepath.Base(path)+".tmp")
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			os.Remove(f.Name())
+		}
+	}()
+	if _, err := f.Write(data); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Chmod(0644); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	return os.Rename(f.Name(), path)
+}
+
+// writeFileIfChanged atomically writes data to the file at path, unless the
+// file already has the same contents. It returns whether the file was
+// written.
+func writeFileIfChanged(path string, data []byte) (bool, error) {
+	old, err := ioutil.ReadFile(path)
+	switch {
+	case err == nil && bytes.Equal(old, data):
+		return false, nil
+	case err != nil && !os.IsNotExist(err):
+		return false, err
+	}
+	if err := writeFileAtomic(path, data); err != nil {
+		return false, err
+	}
+	return true, nil
 }
 
 // buildConfig generates the final template data.
@@ -63,42 +304,144 @@ func buildConfig(configTmpl string, configData interface{}) (string, error) {
 	return buf.String(), nil
 }
 
-// findService finds the correct caching service for the current
-// node from a list of caching services.
-func findService(services []*models.CachingService, nodeIP, nodeName string) (_ *models.CachingService, ok bool) {
+// findServices finds the caching services of the current node from a list of
+// caching services, ordered by name.
+func findServices(services []*models.CachingService, nodeIP, nodeName string) []*models.CachingService {
+	var found []*models.CachingService
 	for _, service := range services {
-		if nodeIP == service.GetPrimaryNode() || nodeName == service.GetPrimaryNode() {
-			return service, true
-		}
-		if nodeIP == service.GetSecondaryNode() || nodeName == service.GetSecondaryNode() {
-			return service, true
+		if isNode(service.GetPrimaryNode(), nodeIP, nodeName) || isNode(service.GetSecondaryNode(), nodeIP, nodeName) {
+			found = append(found, service)
 		}
 	}
-	return nil, false
+	sort.Slice(found, func(i, j int) bool {
+		return found[i].GetName() < found[j].GetName()
+	})
+	return found
+}
+
+// isNode returns whether node, the primary or secondary node of a caching
+// service in UFS, is the node with the given IP and name. IPv6 addresses may
+// be written differently in UFS and in nodeIP, so IP addresses are compared
+// parsed.
+func isNode(node, nodeIP, nodeName string) bool {
+	if node == nodeName {
+		return true
+	}
+	if ip := net.ParseIP(node); ip != nil {
+		return ip.Equal(net.ParseIP(nodeIP))
+	}
+	return node == nodeIP
 }
 
-// nodeVirtualIP gets the virtual IP of the current node.
-func nodeVirtualIP(service *models.CachingService) (string, error) {
+// serviceHostname gets the hostname of the caching service.
+func serviceHostname(service *models.CachingService) string {
 	// The service name is in the format cachingservice/<hostname>. So do the
 	// required string manipulation to obtain the name.
 	splitName := strings.Split(service.GetName(), "/")
-	name := splitName[len(splitName)-1]
-	vip, err := lookupHost(name)
+	return splitName[len(splitName)-1]
+}
+
+// nodeVirtualIPs gets the virtual IPs of the current node in a caching
+// service. A dual-stack caching service has both an IPv4 and an IPv6 virtual
+// IP.
+func nodeVirtualIPs(service *models.CachingService) (hostAddrs, error) {
+	name := serviceHostname(service)
+	vips, err := lookupAddrs(name)
 	if err != nil {
-		return "", fmt.Errorf("get node virtual IP of %q: %s", name, err)
+		return vips, fmt.Errorf("get node virtual IPs of %q: %s", name, err)
 	}
-	return vip, nil
+	return vips, nil
 }
 
-// lookupHost looks up the IP address of the provided host by using the local
-// resolver.
-func lookupHost(hostname string) (string, error) {
-	addrs, err := net.LookupHost(hostname)
-	if err != nil {
-		return "", fmt.Errorf("lookup IP of %q: %s", hostname, err)
+// hostAddrs are the IP addresses of a host, by address family. At least one
+// of them is set.
+type hostAddrs struct {
+	IPv4 string
+	IPv6 string
+}
+
+// list returns the addresses, IPv4 first.
+func (a hostAddrs) list() []string {
+	var l []string
+	for _, addr := range []string{a.IPv4, a.IPv6} {
+		if addr != "" {
+			l = append(l, addr)
+		}
+	}
+	return l
+}
+
+// main returns the address used where a single address of the host is
+// needed, i.e. the IPv4 address if any.
+func (a hostAddrs) main() string {
+	if a.IPv4 != "" {
+		return a.IPv4
+	}
+	return a.IPv6
+}
+
+// sameFamily returns the address of the same family as ip, or "" if there's
+// none.
+func (a hostAddrs) sameFamily(ip string) string {
+	if isIPv6(ip) {
+		return a.IPv6
+	}
+	return a.IPv4
+}
+
+// isIPv6 returns whether ip is an IPv6 address.
+func isIPv6(ip string) bool {
+	parsed := net.ParseIP(ip)
+	return parsed != nil && parsed.To4() == nil
+}
+
+// loopback returns the loopback address of the family of ip.
+func loopback(ip string) string {
+	if isIPv6(ip) {
+		return "::1"
+	}
+	return "127.0.0.1"
+}
+
+// familyName returns the name of the address family of ip, for errors.
+func familyName(ip string) string {
+	if isIPv6(ip) {
+		return "IPv6"
+	}
+	return "IPv4"
+}
+
+// lookupAddrs looks up the IP addresses of the provided host by using the
+// local resolver. The first address of each family is used. host may also be
+// an IP address, e.g. in the name of a caching service in UFS, in which case
+// it's the only address.
+func lookupAddrs(host string) (hostAddrs, error) {
+	var a hostAddrs
+	addrs := []string{host}
+	if net.ParseIP(host) == nil {
+		var err error
+		if addrs, err = net.LookupHost(host); err != nil {
+			return a, fmt.Errorf("lookup IP of %q: %s", host, err)
+		}
+	}
+	for _, addr := range addrs {
+		// Link-local addresses with a zone, e.g. "fe80::1%eth0", aren't
+		// parsed, and aren't usable as virtual IPs or peers anyway.
+		ip := net.ParseIP(addr)
+		switch {
+		case ip == nil:
+		case ip.To4() != nil:
+			if a.IPv4 == "" {
+				a.IPv4 = ip.String()
+			}
+		default:
+			if a.IPv6 == "" {
+				a.IPv6 = ip.String()
+			}
+		}
 	}
-	if len(addrs) == 0 {
-		return "", fmt.Errorf("lookup IP of %q: No addresses found", hostname)
+	if a.IPv4 == "" && a.IPv6 == "" {
+		return a, fmt.Errorf("lookup IP of %q: No addresses found", host)
 	}
-	return addrs[0], nil
+	return a, nil
 }
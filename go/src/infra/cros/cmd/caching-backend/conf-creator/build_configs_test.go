@@ -0,0 +1,458 @@
+// Copyright 2021 The Chromium OS Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	models "infra/unifiedfleet/api/v1/models"
+)
+
+var updateGolden = flag.Bool("update-golden", false, "Update the golden files in testdata")
+
+func checkGolden(t *testing.T, name, got string) {
+	t.Helper()
+	path := filepath.Join("testdata", name)
+	if *updateGolden {
+		if err := ioutil.WriteFile(path, []byte(got), 0644); err != nil {
+			t.Fatalf("update golden file %q: %s", path, err)
+		}
+	}
+	want, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatalf("read golden file %q: %s", path, err)
+	}
+	if diff := cmp.Diff(string(want), got); diff != "" {
+		t.Errorf("config differs from %q (-want +got):\n%s", path, diff)
+	}
+}
+
+// The caching services in the tests. The node is the primary of the first
+// and the secondary of the second, which are IPv4 only. The node is also the
+// primary of IPv6 only and dual-stack services.
+var (
+	primaryService = nginxServiceData{
+		Name:         "cachingservice-1.example.com",
+		Port:         8082,
+		LegacyPort:   true,
+		UpstreamHost: "192.168.0.2:8082",
+		VirtualIPs:   hostAddrs{IPv4: "192.168.0.10"},
+	}
+	secondaryService = nginxServiceData{
+		Name:       "cachingservice-2.example.com",
+		Port:       8083,
+		VirtualIPs: hostAddrs{IPv4: "192.168.1.10"},
+	}
+	ipv6Service = nginxServiceData{
+		Name:         "cachingservice-3.example.com",
+		Port:         8082,
+		LegacyPort:   true,
+		UpstreamHost: "[fd00::2]:8082",
+		VirtualIPs:   hostAddrs{IPv6: "fd00::10"},
+	}
+	dualStackService = nginxServiceData{
+		Name:         "cachingservice-4.example.com",
+		Port:         8082,
+		LegacyPort:   true,
+		UpstreamHost: "192.168.0.2:8082",
+		VirtualIPs:   hostAddrs{IPv4: "192.168.0.10", IPv6: "fd00::10"},
+	}
+)
+
+func TestBuildNginxConfig(t *testing.T) {
+	t.Parallel()
+	secondaryOnly := secondaryService
+	secondaryOnly.LegacyPort = true
+	data := map[string]nginxConfData{
+		"nginx_primary.conf.golden": {
+			CacheSizeInGB:      750,
+			GSAServerCount:     2,
+			GSAInitialPort:     18000,
+			HealthCheckTimeout: 2,
+			Services:           []nginxServiceData{primaryService},
+		},
+		"nginx_secondary.conf.golden": {
+			WorkerCount:        8,
+			CacheSizeInGB:      750,
+			GSAServerCount:     2,
+			GSAInitialPort:     18000,
+			HealthCheckTimeout: 2,
+			Services:           []nginxServiceData{secondaryOnly},
+		},
+		"nginx_dual.conf.golden": {
+			CacheSizeInGB:      750,
+			GSAServerCount:     2,
+			GSAInitialPort:     18000,
+			HealthCheckTimeout: 2,
+			Services:           []nginxServiceData{primaryService, secondaryService},
+		},
+		"nginx_ipv6.conf.golden": {
+			CacheSizeInGB:      750,
+			GSAServerCount:     2,
+			GSAInitialPort:     18000,
+			HealthCheckTimeout: 2,
+			Services:           []nginxServiceData{ipv6Service},
+		},
+		"nginx_dualstack.conf.golden": {
+			CacheSizeInGB:      750,
+			GSAServerCount:     2,
+			GSAInitialPort:     18000,
+			HealthCheckTimeout: 2,
+			Services:           []nginxServiceData{dualStackService},
+		},
+	}
+	for name, d := range data {
+		got, err := buildConfig(nginxTemplate, d)
+		if err != nil {
+			t.Fatalf("buildConfig(%q) failed: %s", name, err)
+		}
+		checkGolden(t, name, got)
+	}
+}
+
+func TestBuildKeepalivedConfig(t *testing.T) {
+	t.Parallel()
+	primary := keepalivedInstanceData{
+		UnicastPeer:     "192.168.0.2",
+		VirtualIP:       "192.168.0.10",
+		State:           "MASTER",
+		Priority:        150,
+		VirtualRouterID: virtualRouterID("192.168.0.10"),
+		Port:            8082,
+	}
+	backup := keepalivedInstanceData{
+		UnicastPeer:     "192.168.1.1",
+		VirtualIP:       "192.168.1.10",
+		State:           "BACKUP",
+		Priority:        100,
+		VirtualRouterID: virtualRouterID("192.168.1.10"),
+		Port:            8083,
+	}
+	primaryIPv6 := keepalivedInstanceData{
+		UnicastPeer:     "fd00::2",
+		VirtualIP:       "fd00::10",
+		State:           "MASTER",
+		Priority:        150,
+		VirtualRouterID: virtualRouterID("fd00::10"),
+		Port:            8082,
+	}
+	data := map[string]keepalivedConfData{
+		"keepalived_primary.conf.golden": {
+			Interface:           "bond0",
+			HealthCheckInterval: 3,
+			HealthCheckTimeout:  2,
+			HealthCheckFall:     2,
+			HealthCheckRise:     2,
+			Instances:           []keepalivedInstanceData{primary},
+		},
+		"keepalived_backup.conf.golden": {
+			Interface:           "bond0",
+			HealthCheckInterval: 5,
+			HealthCheckTimeout:  4,
+			HealthCheckFall:     3,
+			HealthCheckRise:     1,
+			Instances:           []keepalivedInstanceData{backup},
+		},
+		"keepalived_dual.conf.golden": {
+			Interface:           "bond0",
+			HealthCheckInterval: 3,
+			HealthCheckTimeout:  2,
+			HealthCheckFall:     2,
+			HealthCheckRise:     2,
+			Instances:           []keepalivedInstanceData{primary, backup},
+		},
+		"keepalived_ipv6.conf.golden": {
+			Interface:           "bond0",
+			HealthCheckInterval: 3,
+			HealthCheckTimeout:  2,
+			HealthCheckFall:     2,
+			HealthCheckRise:     2,
+			Instances:           []keepalivedInstanceData{primaryIPv6},
+		},
+		// A dual-stack service has a VRRP instance per address family.
+		"keepalived_dualstack.conf.golden": {
+			Interface:           "bond0",
+			HealthCheckInterval: 3,
+			HealthCheckTimeout:  2,
+			HealthCheckFall:     2,
+			HealthCheckRise:     2,
+			Instances:           []keepalivedInstanceData{primary, primaryIPv6},
+		},
+	}
+	for name, d := range data {
+		got, err := buildConfig(keepalivedTemplate, d)
+		if err != nil {
+			t.Fatalf("buildConfig(%q) failed: %s", name, err)
+		}
+		checkGolden(t, name, got)
+	}
+}
+
+func TestBuildExporterTargets(t *testing.T) {
+	t.Parallel()
+	primary := exporterTargetsData{
+		Role:        "primary",
+		VirtualIP:   "192.168.0.10",
+		ServiceName: "cachingservice-1.example.com",
+		Port:        8082,
+	}
+	secondary := exporterTargetsData{
+		Role:        "secondary",
+		VirtualIP:   "192.168.1.10",
+		ServiceName: "cachingservice-2.example.com",
+		Port:        8083,
+	}
+	ipv6 := exporterTargetsData{
+		Role:        "primary",
+		VirtualIP:   "fd00::10",
+		ServiceName: "cachingservice-3.example.com",
+		Port:        8082,
+	}
+	data := map[string][]exporterTargetsData{
+		"exporter_targets_primary.json.golden":   {primary},
+		"exporter_targets_secondary.json.golden": {secondary},
+		"exporter_targets_dual.json.golden":      {primary, secondary},
+		"exporter_targets_ipv6.json.golden":      {ipv6},
+		// The node isn't in any caching service.
+		"exporter_targets_noop.json.golden": nil,
+	}
+	for name, d := range data {
+		got, err := buildExporterTargets(d)
+		if err != nil {
+			t.Fatalf("buildExporterTargets(%q) failed: %s", name, err)
+		}
+		checkGolden(t, name, got)
+	}
+}
+
+func TestFindServices(t *testing.T) {
+	t.Parallel()
+	services := []*models.CachingService{
+		{Name: "cachingservices/c", PrimaryNode: "192.168.2.1", SecondaryNode: "192.168.2.2"},
+		{Name: "cachingservices/b", PrimaryNode: "192.168.1.1", SecondaryNode: "node-1"},
+		{Name: "cachingservices/a", PrimaryNode: "192.168.0.1", SecondaryNode: "192.168.0.2"},
+		{Name: "cachingservices/d", PrimaryNode: "fd00::1", SecondaryNode: "fd00::2"},
+	}
+	cases := []struct {
+		nodeIP, nodeName string
+		want             []string
+	}{
+		{"192.168.0.1", "node-1", []string{"cachingservices/a", "cachingservices/b"}},
+		{"192.168.2.2", "node-2", []string{"cachingservices/c"}},
+		{"192.168.3.1", "node-3", nil},
+		// IPv6 addresses are compared parsed.
+		{"fd00:0:0:0:0:0:0:1", "node-4", []string{"cachingservices/d"}},
+	}
+	for _, c := range cases {
+		var got []string
+		for _, s := range findServices(services, c.nodeIP, c.nodeName) {
+			got = append(got, s.GetName())
+		}
+		if diff := cmp.Diff(c.want, got); diff != "" {
+			t.Errorf("findServices(%q, %q) differs (-want +got):\n%s", c.nodeIP, c.nodeName, diff)
+		}
+	}
+}
+
+func TestVirtualRouterID(t *testing.T) {
+	t.Parallel()
+	seen := make(map[int]string)
+	for _, vip := range []string{"192.168.0.10", "192.168.1.10", "10.0.0.1"} {
+		id := virtualRouterID(vip)
+		if id < 1 || id > 255 {
+			t.Errorf("virtualRouterID(%q) = %d, want in [1, 255]", vip, id)
+		}
+		if other, ok := seen[id]; ok {
+			t.Errorf("virtualRouterID(%q) = %d, same as %q", vip, id, other)
+		}
+		seen[id] = vip
+		if again := virtualRouterID(vip); again != id {
+			t.Errorf("virtualRouterID(%q) = %d, then %d", vip, id, again)
+		}
+	}
+}
+
+func TestValidateServices(t *testing.T) {
+	t.Parallel()
+	newData := func(ports ...int) (nginxConfData, keepalivedConfData) {
+		n := nginxConfData{GSAServerCount: 2, GSAInitialPort: 18000}
+		var k keepalivedConfData
+		for i, p := range ports {
+			vip := fmt.Sprintf("192.168.%d.10", i)
+			n.Services = append(n.Services, nginxServiceData{
+				Name:       fmt.Sprintf("cachingservice-%d.example.com", i),
+				Port:       p,
+				LegacyPort: i == 0,
+				VirtualIPs: hostAddrs{IPv4: vip},
+			})
+			k.Instances = append(k.Instances, keepalivedInstanceData{
+				VirtualIP:       vip,
+				VirtualRouterID: virtualRouterID(vip),
+				Port:            p,
+			})
+		}
+		return n, k
+	}
+	cases := []struct {
+		name    string
+		ports   []int
+		wantErr string
+	}{
+		{"single service", []int{8082}, ""},
+		{"dual services", []int{8082, 8083}, ""},
+		{"same port", []int{8082, 8082}, "port 8082"},
+		{"legacy port", []int{8082, 8888}, "port 8888"},
+		{"gs_archive_server port", []int{8082, 18001}, `used by "gs_archive_server"`},
+	}
+	for _, c := range cases {
+		n, k := newData(c.ports...)
+		err := validateServices(n, k)
+		switch {
+		case c.wantErr == "" && err != nil:
+			t.Errorf("%s: validateServices() failed: %s", c.name, err)
+		case c.wantErr != "" && (err == nil || !strings.Contains(err.Error(), c.wantErr)):
+			t.Errorf("%s: validateServices() = %v, want error containing %q", c.name, err, c.wantErr)
+		}
+	}
+
+	// Services with the same virtual router ID.
+	n, k := newData(8082, 8083)
+	k.Instances[1].VirtualRouterID = k.Instances[0].VirtualRouterID
+	if err := validateServices(n, k); err == nil || !strings.Contains(err.Error(), "virtual router ID") {
+		t.Errorf("validateServices() = %v, want error about the virtual router ID", err)
+	}
+
+	// IPv4 and IPv6 instances may have the same virtual router ID.
+	n, k = newData(8082, 8083)
+	k.Instances[1].VirtualIP = "fd00::10"
+	k.Instances[1].VirtualRouterID = k.Instances[0].VirtualRouterID
+	if err := validateServices(n, k); err != nil {
+		t.Errorf("validateServices() failed: %s", err)
+	}
+}
+
+func TestLookupAddrs(t *testing.T) {
+	t.Parallel()
+	// IP addresses aren't looked up.
+	cases := []struct {
+		host string
+		want hostAddrs
+	}{
+		{"192.168.0.10", hostAddrs{IPv4: "192.168.0.10"}},
+		{"FD00:0::10", hostAddrs{IPv6: "fd00::10"}},
+	}
+	for _, c := range cases {
+		got, err := lookupAddrs(c.host)
+		if err != nil {
+			t.Fatalf("lookupAddrs(%q) failed: %s", c.host, err)
+		}
+		if got != c.want {
+			t.Errorf("lookupAddrs(%q) = %+v, want %+v", c.host, got, c.want)
+		}
+	}
+}
+
+func TestHostAddrs(t *testing.T) {
+	t.Parallel()
+	dual := hostAddrs{IPv4: "192.168.0.2", IPv6: "fd00::2"}
+	if diff := cmp.Diff([]string{"192.168.0.2", "fd00::2"}, dual.list()); diff != "" {
+		t.Errorf("list() differs (-want +got):\n%s", diff)
+	}
+	if got := dual.main(); got != "192.168.0.2" {
+		t.Errorf("main() = %q, want the IPv4 address", got)
+	}
+	if got := dual.sameFamily("fd00::10"); got != "fd00::2" {
+		t.Errorf("sameFamily(%q) = %q, want the IPv6 address", "fd00::10", got)
+	}
+	ipv4 := hostAddrs{IPv4: "192.168.0.2"}
+	if got := ipv4.sameFamily("fd00::10"); got != "" {
+		t.Errorf("sameFamily(%q) = %q, want none", "fd00::10", got)
+	}
+	ipv6 := hostAddrs{IPv6: "fd00::2"}
+	if got := ipv6.main(); got != "fd00::2" {
+		t.Errorf("main() = %q, want the IPv6 address", got)
+	}
+}
+
+func TestWriteFileAtomic(t *testing.T) {
+	t.Parallel()
+	dir, err := ioutil.TempDir("", "conf-creator")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "nginx.conf")
+	for _, content := range []string{"old", "new"} {
+		if err := writeFileAtomic(path, []byte(content)); err != nil {
+			t.Fatalf("writeFileAtomic(%q) failed: %s", content, err)
+		}
+		got, err := ioutil.ReadFile(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(got) != content {
+			t.Errorf("writeFileAtomic(%q) wrote %q", content, got)
+		}
+	}
+	// No temporary files are left behind.
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) != 1 {
+		t.Errorf("got %d files in %q, want 1", len(files), dir)
+	}
+	if fi := files[0]; fi.Mode().Perm() != 0644 {
+		t.Errorf("got file mode %v, want 0644", fi.Mode().Perm())
+	}
+}
+
+func TestNoOpConfig(t *testing.T) {
+	t.Parallel()
+	checkGolden(t, "nginx_noop.conf.golden", noOpNginxTemplate)
+	checkGolden(t, "keepalived_noop.conf.golden", noOpKeepalivedTemplate)
+}
+
+func TestHealthCheckWiring(t *testing.T) {
+	t.Parallel()
+	if !strings.Contains(keepalivedTemplate, "{{ .HealthCheckURL }}") {
+		t.Errorf("keepalived config does not check the health endpoint")
+	}
+	// The health endpoint is checked at the loopback address of the family
+	// of the VRRP instance, which nginx listens on.
+	for vip, want := range map[string]string{
+		"192.168.0.10": "http://127.0.0.1:8082/health",
+		"fd00::10":     "http://[::1]:8082/health",
+	} {
+		k := keepalivedInstanceData{VirtualIP: vip, Port: 8082}
+		if got := k.HealthCheckURL(); got != want {
+			t.Errorf("HealthCheckURL() of %q = %q, want %q", vip, got, want)
+		}
+	}
+	// Both the operational and the non-operational nginx config serve the
+	// health endpoint, so that keepalived never checks a missing endpoint.
+	for name, templ := range map[string]string{"nginx": nginxTemplate, "no-op nginx": noOpNginxTemplate} {
+		if !strings.Contains(templ, "location = /health {") {
+			t.Errorf("%s config does not serve the health endpoint", name)
+		}
+	}
+	// The exporter scrapes the stub_status endpoint of the operational nginx
+	// config.
+	url := stubStatusURL("192.168.0.10", 8082)
+	if !strings.HasSuffix(url, "/nginx_status") || !strings.Contains(nginxTemplate, "location = /nginx_status {\n      stub_status;") {
+		t.Errorf("nginx config does not serve stub_status at %q", url)
+	}
+	if strings.Contains(noOpKeepalivedTemplate, "track_script") {
+		t.Errorf("no-op keepalived config must not track the health of nginx")
+	}
+}
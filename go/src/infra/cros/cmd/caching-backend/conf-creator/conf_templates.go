@@ -5,33 +5,44 @@
 package main
 
 const keepalivedTemplate = `# This file is generated. DO NOT EDIT.
+{{- range .Instances }}
 
-vrrp_script chk_caching_backend_health {
-  script "curl http://localhost:8082/check_health"
-  interval 3  # In second.
+vrrp_script chk_caching_backend_health_{{ .Name }} {
+  script "curl --fail --silent --output /dev/null --max-time {{ $.HealthCheckTimeout }} {{ .HealthCheckURL }}"
+  interval {{ $.HealthCheckInterval }}  # In second.
+  timeout {{ $.HealthCheckTimeout }}  # In second.
+  fall {{ $.HealthCheckFall }}
+  rise {{ $.HealthCheckRise }}
   weight 60
 }
 
-vrrp_instance CacheServer {
+vrrp_instance CacheServer_{{ .Name }} {
   state {{ .State }}
-  interface {{ .Interface }}
-  virtual_router_id 51
+  interface {{ $.Interface }}
+  virtual_router_id {{ .VirtualRouterID }}
   priority {{ .Priority }}
   advert_int 1
+  {{- if .IPv6 }}
+  # IPv6 instances use VRRP version 3, which has no authentication.
+  native_ipv6
+  {{- end }}
   unicast_peer {
     {{ .UnicastPeer }}
   }
+  {{- if not .IPv6 }}
   authentication {
         auth_type PASS
         auth_pass PASSWORD
   }
+  {{- end }}
   track_script {
-    chk_caching_backend_health
+    chk_caching_backend_health_{{ .Name }}
   }
   virtual_ipaddress {
     {{ .VirtualIP }}
   }
 }
+{{- end }}
 `
 
 const nginxTemplate = `# This file is generated. DO NOT EDIT.
@@ -58,23 +69,30 @@ http {
                   '"$http_user_agent" "$http_x_forwarded_for" $upstream_cache_status';
   proxy_cache_path  /var/cache/nginx levels=1:2 keys_zone=google-storage:80m
                     max_size={{ .CacheSizeInGB }}g inactive=720h;
-  # gs_cache upstream definition.
-  upstream gs_archive_servers {
+  {{- range .Services }}
+  # gs_cache upstream definition of {{ .Name }}.
+  upstream gs_archive_servers_{{ .Port }} {
     {{ if .UpstreamHost }}
     server {{ .UpstreamHost }} fail_timeout=10s;
-    {{ range .Ports -}}
+    {{ range $.Ports -}}
     server 127.0.0.1:{{.}} backup;
     {{ end -}}
     {{ else }}
-    {{ range .Ports -}}
+    {{ range $.Ports -}}
     server 127.0.0.1:{{.}} fail_timeout=10s;
     {{ end -}}
     {{ end }}
   }
   server {
-    listen *:8082;
+    {{- range .ListenAddrs .Port }}
+    listen {{ . }};
+    {{- end }}
+    {{- if .LegacyPort }}
     # TODO(guocb) Remove this after removing provision branch using gs_cache.
-    listen *:8888;
+    {{- range .ListenAddrs 8888 }}
+    listen {{ . }};
+    {{- end }}
+    {{- end }}
     server_name           gs-cache;
     add_header            'Cache-Control' 'public, max-age=3153600';
     add_header            '{{ if .UpstreamHost }}X-Cache-Primary{{ else }}X-Cache-Secondary{{ end }}' '$upstream_cache_status';
@@ -87,13 +105,13 @@ http {
       proxy_cache_lock_timeout 900s;
       proxy_cache_bypass $http_x_no_cache;
       expires max;
-      proxy_pass            http://gs_archive_servers$uri$is_args$args;
+      proxy_pass            http://gs_archive_servers_{{ .Port }}$uri$is_args$args;
       proxy_read_timeout    900;
       proxy_connect_timeout 90;
       proxy_redirect        off;
       proxy_http_version    1.1;
       proxy_set_header      Connection "";
-      proxy_set_header      X-Forwarded-Host {{ .VirtualIP }}:$server_port;
+      proxy_set_header      X-Forwarded-Host {{ .ForwardedHost }};
       proxy_set_header      X-Forwarded-For $proxy_add_x_forwarded_for;
       proxy_cache           google-storage;
       proxy_cache_valid     200 720h;
@@ -109,13 +127,13 @@ http {
       proxy_cache_lock_timeout 900s;
       proxy_cache_bypass $http_x_no_cache;
       expires max;
-      proxy_pass            http://gs_archive_servers$uri$is_args$args;
+      proxy_pass            http://gs_archive_servers_{{ .Port }}$uri$is_args$args;
       proxy_read_timeout    900;
       proxy_connect_timeout 90;
       proxy_redirect        off;
       proxy_http_version    1.1;
       proxy_set_header      Connection "";
-      proxy_set_header      X-Forwarded-Host {{ .VirtualIP }}:$server_port;
+      proxy_set_header      X-Forwarded-Host {{ .ForwardedHost }};
       proxy_set_header      X-Forwarded-For $proxy_add_x_forwarded_for;
       proxy_cache           google-storage;
       proxy_cache_valid     200 48h;
@@ -135,6 +153,26 @@ http {
       rewrite "^/static/([^/]+/[^/]+/recovery_image)\.bin$" "/extract/chromeos-image-archive/$1.tar.xz?file=recovery_image.bin?" last;
       rewrite "^/static/(.+)$" "/download/chromeos-image-archive/$1?" last;
     }
+    # Health check endpoints for keepalived, only reachable from the node
+    # itself. /nginx_status reports the state of nginx connections, and is
+    # also scraped by the metrics exporter. /health fetches it through this
+    # server, so it fails if nginx is alive but not serving requests in time.
+    location = /nginx_status {
+      stub_status;
+      allow 127.0.0.1;
+      allow ::1;
+      deny all;
+    }
+    location = /health {
+      allow 127.0.0.1;
+      allow ::1;
+      deny all;
+      proxy_pass            http://{{ .LoopbackHost }}/nginx_status;
+      proxy_connect_timeout {{ $.HealthCheckTimeout }}s;
+      proxy_read_timeout    {{ $.HealthCheckTimeout }}s;
+      proxy_http_version    1.1;
+      proxy_set_header      Connection "";
+    }
     # Some legacy RPCs in order to be backward compatible with devserver.
     location /check_health {
       default_type application/json;
@@ -161,6 +199,7 @@ http {
       return 200 'The /list_image_dir RPC is not supported by GS Cache. Usage is discouraged.';
     }
   }
+  {{- end }}
 }
 `
 
@@ -173,4 +212,16 @@ const noOpKeepalivedTemplate = `# This file is generated. DO NOT EDIT.
 const noOpNginxTemplate = `# This file is generated. DO NOT EDIT.
 
 events {}
+
+http {
+  # The caching backend is not operational on this node, so its health check
+  # always fails.
+  server {
+    listen 127.0.0.1:8082;
+    listen [::1]:8082;
+    location = /health {
+      return 503;
+    }
+  }
+}
 `
@@ -10,7 +10,6 @@ import (
 	"context"
 	"flag"
 	"fmt"
-	"io/ioutil"
 	"log"
 	"net"
 	"os"
@@ -27,6 +26,7 @@ import (
 var (
 	keepalivedConfigFilePath = flag.String("keepalived-conf", "/mnt/conf/keepalived/keepalived.conf", "Path to where keepalived.conf should be created.")
 	nginxConfigFilePath      = flag.String("nginx-conf", "/mnt/conf/nginx/nginx.conf", "Path to where nginx.conf should be created.")
+	exporterTargetsFilePath  = flag.String("exporter-targets", "", "Path to where the metrics exporter targets file should be created. If empty, the file isn't created.")
 	serviceAccountJSONPath   = flag.String("service-account", "/creds/service_accounts/artifacts-downloader-service-account.json", "Path to the service account JSON key file.")
 	ufsService               = flag.String("ufs-host", "ufs.api.cr.dev", "Host of the UFS service.")
 	cacheSizeInGB            = flag.Uint("nginx-cache-size", 750, "The size of nginx cache in GB.")
@@ -34,6 +34,14 @@ var (
 	gsaServerCount           = flag.Uint("gsa-server-count", 1, "The number of upstream gs_archive_server instances to be added in nginx-conf.")
 	gsaInitialPort           = flag.Uint("gsa-initial-port", 18000, "The port number for the first instance of the gs_archive_server in nginx.conf. Port number will increase by 1 for all subsequent entries.")
 	keepalivedInterface      = flag.String("keepalived-interface", "bond0", "The interface keepalived listens on.")
+	healthCheckInterval      = flag.Uint("health-check-interval", 3, "The interval in seconds between keepalived health checks of nginx.")
+	healthCheckTimeout       = flag.Uint("health-check-timeout", 2, "The timeout in seconds of a keepalived health check of nginx. Must be less than the interval.")
+	healthCheckFall          = flag.Uint("health-check-fall", 2, "The number of consecutive failed health checks for nginx to be considered unhealthy.")
+	healthCheckRise          = flag.Uint("health-check-rise", 2, "The number of consecutive successful health checks for nginx to be considered healthy again.")
+	watchInterval            = flag.Duration("watch-interval", 0, "The interval between re-generating the configs from UFS. nginx and keepalived are reloaded when their configs change. If 0, the configs are generated once.")
+	once                     = flag.Bool("once", false, "Generate the configs once and exit, e.g. in an initContainer, regardless of -watch-interval.")
+	nginxPIDFilePath         = flag.String("nginx-pid-file", "", "Path to the pid file of nginx, which is sent SIGHUP to reload. If empty, 'nginx -s reload' is run instead.")
+	keepalivedPIDFilePath    = flag.String("keepalived-pid-file", "", "Path to the pid file of keepalived, which is sent SIGHUP to reload. If empty, keepalived isn't reloaded.")
 )
 
 var (
@@ -56,88 +64,234 @@ func innerMain() error {
 	if nodeName == "" {
 		return fmt.Errorf("environment variable NODE_NAME missing,")
 	}
+	if *healthCheckTimeout == 0 || *healthCheckTimeout >= *healthCheckInterval {
+		return fmt.Errorf("health check timeout must be positive and less than the interval")
+	}
+	ctx := context.Background()
+	if *once || *watchInterval == 0 {
+		return runOnce(ctx)
+	}
+	return watch(cancelOnSignals(ctx), *watchInterval)
+}
+
+// runOnce creates the configs from the caching services in UFS. It's used
+// by the initContainer, before nginx and keepalived run, so they aren't
+// reloaded.
+func runOnce(ctx context.Context) error {
 	log.Println("Getting caching service information from UFS...")
-	services, err := getCachingServices()
+	services, err := getCachingServices(ctx)
 	if err != nil {
 		return err
 	}
-	service, ok := findService(services, nodeIP, nodeName)
-	if !ok {
-		log.Println("Could not find caching service for this node in UFS")
-		log.Println("Creating non-operational nginx.conf...")
-		if err := ioutil.WriteFile(*nginxConfigFilePath, []byte(noOpNginxTemplate), 0644); err != nil {
-			return err
-		}
-		log.Println("Creating non-operational keepalived.conf...")
-		if err := ioutil.WriteFile(*keepalivedConfigFilePath, []byte(noOpKeepalivedTemplate), 0644); err != nil {
-			return err
-		}
-		return nil
-	}
-	vip, err := nodeVirtualIP(service)
+	c, err := generateConfigs(services)
 	if err != nil {
 		return err
 	}
+	_, err = writeConfigs(c)
+	return err
+}
+
+// configs are the contents of the config files generated for the node.
+type configs struct {
+	nginx      string
+	keepalived string
+	// exporterTargets is the metrics exporter targets file. It's only
+	// written if the -exporter-targets flag is set.
+	exporterTargets string
+}
+
+// generateConfigs generates the configs of the caching services of the
+// node.
+func generateConfigs(services []*models.CachingService) (*configs, error) {
+	nodeServices := findServices(services, nodeIP, nodeName)
+	if len(nodeServices) == 0 {
+		log.Println("Could not find caching service for this node in UFS")
+		log.Println("Creating non-operational configs...")
+		e, err := buildExporterTargets(nil)
+		if err != nil {
+			return nil, err
+		}
+		return &configs{
+			nginx:           noOpNginxTemplate,
+			keepalived:      noOpKeepalivedTemplate,
+			exporterTargets: e,
+		}, nil
+	}
 	n := nginxConfData{
 		WorkerCount: *nginxWorkerCount,
 		// TODO(sanikak): Define types to make the unit clearer.
 		// E.g.  type gigabyte int.
-		CacheSizeInGB:  int(*cacheSizeInGB),
-		GSAServerCount: int(*gsaServerCount),
-		GSAInitialPort: int(*gsaInitialPort),
-		VirtualIP:      vip,
+		CacheSizeInGB:      int(*cacheSizeInGB),
+		GSAServerCount:     int(*gsaServerCount),
+		GSAInitialPort:     int(*gsaInitialPort),
+		HealthCheckTimeout: *healthCheckTimeout,
 	}
 	k := keepalivedConfData{
-		VirtualIP: vip,
-		Interface: *keepalivedInterface,
+		Interface:           *keepalivedInterface,
+		HealthCheckInterval: *healthCheckInterval,
+		HealthCheckTimeout:  *healthCheckTimeout,
+		HealthCheckFall:     *healthCheckFall,
+		HealthCheckRise:     *healthCheckRise,
 	}
-	switch {
-	case nodeIP == service.GetPrimaryNode() || nodeName == service.GetPrimaryNode():
-		peerIP, err := lookupHost(service.GetSecondaryNode())
+	var e []exporterTargetsData
+	// The service of each VRRP instance in k.
+	var instanceServices []*models.CachingService
+	for i, service := range nodeServices {
+		s, insts, t, err := serviceConfData(service)
 		if err != nil {
-			return err
+			return nil, err
 		}
-		n.UpstreamHost = net.JoinHostPort(peerIP, strconv.Itoa(int(service.GetPort())))
-		k.UnicastPeer = peerIP
-		// Keepalived configuration uses the following non-inclusive language.
-		k.State = "MASTER"
-		k.Priority = 150
-	case nodeIP == service.GetSecondaryNode() || nodeName == service.GetSecondaryNode():
-		peerIP, err := lookupHost(service.GetPrimaryNode())
-		if err != nil {
-			return err
+		s.LegacyPort = i == 0
+		n.Services = append(n.Services, s)
+		k.Instances = append(k.Instances, insts...)
+		e = append(e, t)
+		for range insts {
+			instanceServices = append(instanceServices, service)
+		}
+	}
+	if err := validateServices(n, k); err != nil {
+		return nil, err
+	}
+	c := &configs{}
+	var err error
+	if c.nginx, err = buildConfig(nginxTemplate, n); err != nil {
+		return nil, fmt.Errorf("generate configs: nginx: %s", err)
+	}
+	// The exporter scrapes the nginx configured above, so generate its
+	// targets together to keep them consistent.
+	if c.exporterTargets, err = buildExporterTargets(e); err != nil {
+		return nil, fmt.Errorf("generate configs: exporter targets: %s", err)
+	}
+	instances := k.Instances[:0]
+	for i, inst := range k.Instances {
+		if service := instanceServices[i]; service.GetState() != models.State_STATE_SERVING {
+			log.Printf("Didn't config keepalived for %q (%s) since the service state in UFS isn't STATE_SERVING (%s instead)", serviceHostname(service), inst.VirtualIP, service.GetState())
+			continue
 		}
-		k.UnicastPeer = peerIP
-		k.State = "BACKUP"
-		k.Priority = 100
+		instances = append(instances, inst)
+	}
+	k.Instances = instances
+	if len(k.Instances) == 0 {
+		c.keepalived = noOpKeepalivedTemplate
+		return c, nil
+	}
+	if c.keepalived, err = buildConfig(keepalivedTemplate, k); err != nil {
+		return nil, fmt.Errorf("generate configs: keepalived: %s", err)
+	}
+	return c, nil
+}
+
+// serviceConfData returns the information about a caching service of the
+// node which is necessary to create the nginx, keepalived and exporter
+// configs. The service has a VRRP instance per address family of its virtual
+// IPs.
+func serviceConfData(service *models.CachingService) (nginxServiceData, []keepalivedInstanceData, exporterTargetsData, error) {
+	var (
+		s nginxServiceData
+		k []keepalivedInstanceData
+		e exporterTargetsData
+	)
+	vips, err := nodeVirtualIPs(service)
+	if err != nil {
+		return s, k, e, err
+	}
+	port := int(service.GetPort())
+	s = nginxServiceData{
+		Name:       serviceHostname(service),
+		Port:       port,
+		VirtualIPs: vips,
+	}
+	e = exporterTargetsData{
+		VirtualIP:   vips.main(),
+		ServiceName: s.Name,
+		Port:        port,
+	}
+	var (
+		peer     string
+		state    string
+		priority int32
+	)
+	switch {
+	case isNode(service.GetPrimaryNode(), nodeIP, nodeName):
+		peer = service.GetSecondaryNode()
+		// Keepalived configuration uses the following non-inclusive language.
+		state = "MASTER"
+		priority = 150
+		e.Role = "primary"
+	case isNode(service.GetSecondaryNode(), nodeIP, nodeName):
+		peer = service.GetPrimaryNode()
+		state = "BACKUP"
+		priority = 100
+		e.Role = "secondary"
 	default:
-		return fmt.Errorf("node is neither the primary nor the secondary")
+		return s, k, e, fmt.Errorf("node is neither the primary nor the secondary of %q", s.Name)
 	}
-	if err := buildAndWriteConfig("nginx", nginxTemplate, n, *nginxConfigFilePath); err != nil {
-		return err
+	peerIPs, err := lookupAddrs(peer)
+	if err != nil {
+		return s, k, e, err
+	}
+	for _, vip := range vips.list() {
+		peerIP := peerIPs.sameFamily(vip)
+		if peerIP == "" {
+			return s, k, e, fmt.Errorf("peer %q of %q has no %s address for virtual IP %q", peer, s.Name, familyName(vip), vip)
+		}
+		k = append(k, keepalivedInstanceData{
+			UnicastPeer:     peerIP,
+			VirtualIP:       vip,
+			State:           state,
+			Priority:        priority,
+			VirtualRouterID: virtualRouterID(vip),
+			Port:            port,
+		})
+	}
+	if e.Role == "primary" {
+		s.UpstreamHost = net.JoinHostPort(peerIPs.sameFamily(vips.main()), strconv.Itoa(port))
+	}
+	return s, k, e, nil
+}
+
+// changedConfigs reports which configs writeConfigs rewrote.
+type changedConfigs struct {
+	nginx      bool
+	keepalived bool
+}
+
+// writeConfigs writes the configs to the paths given by the flags. Only the
+// files whose contents differ from c are rewritten.
+func writeConfigs(c *configs) (changedConfigs, error) {
+	var changed changedConfigs
+	var err error
+	if changed.nginx, err = writeConfig("nginx", *nginxConfigFilePath, c.nginx); err != nil {
+		return changed, err
 	}
-	if s := service.GetState(); s != models.State_STATE_SERVING {
-		log.Printf("Didn't config keepalived since the service state in UFS isn't STATE_SERVING (%s instead)", s)
-		return ioutil.WriteFile(*keepalivedConfigFilePath, []byte(noOpKeepalivedTemplate), 0644)
+	if *exporterTargetsFilePath != "" {
+		if _, err := writeConfig("exporter targets", *exporterTargetsFilePath, c.exporterTargets); err != nil {
+			return changed, err
+		}
+	}
+	if changed.keepalived, err = writeConfig("keepalived", *keepalivedConfigFilePath, c.keepalived); err != nil {
+		return changed, err
 	}
-	return buildAndWriteConfig("keepalived", keepalivedTemplate, k, *keepalivedConfigFilePath)
+	return changed, nil
 }
 
-func buildAndWriteConfig(name string, templ string, data interface{}, path string) error {
-	log.Printf("Configuring %q and writing to %q ...", name, path)
-	d, err := buildConfig(templ, data)
+// writeConfig writes the config named name to path, if its contents differ
+// from data. It returns whether the file was written.
+func writeConfig(name, path, data string) (bool, error) {
+	changed, err := writeFileIfChanged(path, []byte(data))
 	if err != nil {
-		return fmt.Errorf("build and write config of %q: %s", name, err)
+		return false, fmt.Errorf("write config of %q: %s", name, err)
 	}
-	if err := ioutil.WriteFile(path, []byte(d), 0644); err != nil {
-		return fmt.Errorf("build and write config of %q: %s", name, err)
+	if changed {
+		log.Printf("Wrote %q config to %q", name, path)
+	} else {
+		log.Printf("The %q config in %q is up to date", name, path)
 	}
-	return nil
+	return changed, nil
 }
 
 // getCachingServiceFromUFS gets all caching services listed in the UFS.
-func getCachingServices() ([]*models.CachingService, error) {
-	ctx := context.Background()
+func getCachingServices(ctx context.Context) ([]*models.CachingService, error) {
 	md := metadata.Pairs("namespace", "os")
 	ctx = metadata.NewOutgoingContext(ctx, md)
 	o := auth.Options{
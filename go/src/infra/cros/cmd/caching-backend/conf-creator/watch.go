@@ -0,0 +1,145 @@
+// Copyright 2021 The Chromium OS Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os/exec"
+	"strconv"
+	"strings"
+	"time"
+
+	models "infra/unifiedfleet/api/v1/models"
+)
+
+// initialRetryDelay is the delay before retrying a failed query to UFS for
+// the first time. The delay doubles on each retry.
+const initialRetryDelay = time.Second
+
+// watch re-generates the configs from UFS every interval until ctx is done,
+// and reloads nginx and keepalived when their configs change. Errors are
+// logged and the existing configs are kept until the next interval.
+func watch(ctx context.Context, interval time.Duration) error {
+	log.Printf("Re-generating configs every %s", interval)
+	for {
+		if err := refresh(ctx, interval); err != nil {
+			log.Printf("Failed to refresh configs, keeping the existing ones: %s", err)
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(interval):
+		}
+	}
+}
+
+// refresh re-generates the configs from UFS, and reloads nginx and
+// keepalived if their configs changed. Failed queries to UFS are retried
+// with a delay of up to maxRetryDelay.
+func refresh(ctx context.Context, maxRetryDelay time.Duration) error {
+	var services []*models.CachingService
+	err := retryWithBackoff(ctx, initialRetryDelay, maxRetryDelay, func() error {
+		var err error
+		services, err = getCachingServices(ctx)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	c, err := generateConfigs(services)
+	if err != nil {
+		return err
+	}
+	changed, err := writeConfigs(c)
+	if err != nil {
+		return err
+	}
+	if changed.nginx {
+		if err := reloadNginx(); err != nil {
+			return err
+		}
+	}
+	if changed.keepalived {
+		if err := reloadKeepalived(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// retryWithBackoff calls f until it succeeds or ctx is done. The delay
+// between calls starts at initialDelay and doubles, up to maxDelay.
+func retryWithBackoff(ctx context.Context, initialDelay, maxDelay time.Duration, f func() error) error {
+	delay := initialDelay
+	for {
+		err := f()
+		if err == nil {
+			return nil
+		}
+		log.Printf("Retrying in %s: %s", delay, err)
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("retry: %s (last error: %s)", ctx.Err(), err)
+		case <-time.After(delay):
+		}
+		if delay *= 2; delay > maxDelay {
+			delay = maxDelay
+		}
+	}
+}
+
+// reloadNginx makes nginx reload its config, by sending SIGHUP to the
+// process in the nginx pid file if any, or else by running
+// 'nginx -s reload'.
+func reloadNginx() error {
+	if *nginxPIDFilePath != "" {
+		return reloadProcess("nginx", *nginxPIDFilePath)
+	}
+	log.Println("Reloading nginx with 'nginx -s reload'")
+	if out, err := exec.Command("nginx", "-s", "reload").CombinedOutput(); err != nil {
+		return fmt.Errorf("reload nginx: %s: %s", err, out)
+	}
+	return nil
+}
+
+// reloadKeepalived makes keepalived reload its config, by sending SIGHUP to
+// the process in the keepalived pid file, if any.
+func reloadKeepalived() error {
+	if *keepalivedPIDFilePath == "" {
+		log.Println("Not reloading keepalived since -keepalived-pid-file isn't set")
+		return nil
+	}
+	return reloadProcess("keepalived", *keepalivedPIDFilePath)
+}
+
+// reloadProcess sends SIGHUP to the process named name whose pid is in
+// pidFile.
+func reloadProcess(name, pidFile string) error {
+	pid, err := readPIDFile(pidFile)
+	if err != nil {
+		return fmt.Errorf("reload %s: %s", name, err)
+	}
+	log.Printf("Reloading %s by sending SIGHUP to pid %d", name, pid)
+	if err := sendSIGHUP(pid); err != nil {
+		return fmt.Errorf("reload %s: %s", name, err)
+	}
+	return nil
+}
+
+// readPIDFile reads the pid in the pid file at path.
+func readPIDFile(path string) (int, error) {
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return 0, fmt.Errorf("read pid file: %s", err)
+	}
+	pid, err := strconv.Atoi(strings.TrimSpace(string(b)))
+	if err != nil || pid <= 0 {
+		return 0, fmt.Errorf("read pid file %q: invalid pid %q", path, b)
+	}
+	return pid, nil
+}
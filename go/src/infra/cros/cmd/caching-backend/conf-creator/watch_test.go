@@ -0,0 +1,123 @@
+// Copyright 2021 The Chromium OS Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package main
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestWriteFileIfChanged(t *testing.T) {
+	t.Parallel()
+	dir, err := ioutil.TempDir("", "conf-creator")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "nginx.conf")
+	cases := []struct {
+		content string
+		want    bool
+	}{
+		{"old", true},
+		{"old", false},
+		{"new", true},
+	}
+	for _, c := range cases {
+		changed, err := writeFileIfChanged(path, []byte(c.content))
+		if err != nil {
+			t.Fatalf("writeFileIfChanged(%q) failed: %s", c.content, err)
+		}
+		if changed != c.want {
+			t.Errorf("writeFileIfChanged(%q) = %t, want %t", c.content, changed, c.want)
+		}
+		got, err := ioutil.ReadFile(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(got) != c.content {
+			t.Errorf("writeFileIfChanged(%q) wrote %q", c.content, got)
+		}
+	}
+}
+
+func TestRetryWithBackoff(t *testing.T) {
+	t.Parallel()
+	t.Run("succeeds after failures", func(t *testing.T) {
+		t.Parallel()
+		calls := 0
+		err := retryWithBackoff(context.Background(), time.Millisecond, 2*time.Millisecond, func() error {
+			calls++
+			if calls < 4 {
+				return errors.New("UFS is down")
+			}
+			return nil
+		})
+		if err != nil {
+			t.Errorf("retryWithBackoff() failed: %s", err)
+		}
+		if calls != 4 {
+			t.Errorf("retryWithBackoff() called f %d times, want 4", calls)
+		}
+	})
+	t.Run("stops when the context is done", func(t *testing.T) {
+		t.Parallel()
+		ctx, cancel := context.WithCancel(context.Background())
+		calls := 0
+		err := retryWithBackoff(ctx, time.Millisecond, time.Millisecond, func() error {
+			calls++
+			if calls == 3 {
+				cancel()
+			}
+			return errors.New("UFS is down")
+		})
+		if err == nil {
+			t.Errorf("retryWithBackoff() succeeded, want error")
+		}
+		if calls != 3 {
+			t.Errorf("retryWithBackoff() called f %d times, want 3", calls)
+		}
+	})
+}
+
+func TestReadPIDFile(t *testing.T) {
+	t.Parallel()
+	dir, err := ioutil.TempDir("", "conf-creator")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	cases := []struct {
+		content string
+		want    int
+		wantErr bool
+	}{
+		{"1234\n", 1234, false},
+		{"", 0, true},
+		{"nginx", 0, true},
+		{"-1", 0, true},
+	}
+	path := filepath.Join(dir, "nginx.pid")
+	for _, c := range cases {
+		if err := ioutil.WriteFile(path, []byte(c.content), 0644); err != nil {
+			t.Fatal(err)
+		}
+		got, err := readPIDFile(path)
+		if (err != nil) != c.wantErr {
+			t.Errorf("readPIDFile(%q) returned error %v, want error: %t", c.content, err, c.wantErr)
+		}
+		if got != c.want {
+			t.Errorf("readPIDFile(%q) = %d, want %d", c.content, got, c.want)
+		}
+	}
+	if _, err := readPIDFile(filepath.Join(dir, "missing.pid")); err == nil {
+		t.Errorf("readPIDFile() of a missing file succeeded")
+	}
+}
@@ -0,0 +1,258 @@
+// Copyright 2021 The Chromium OS Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"math"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"cloud.google.com/go/bigquery"
+)
+
+// flushDelay is the delay of flushing a window after its end, to let the
+// records of the last seconds of the window be logged and read.
+const flushDelay = 30 * time.Second
+
+// aggregateKey is the key of records aggregated in a window.
+type aggregateKey struct {
+	pathPrefix  string
+	httpMethod  string
+	status      int
+	cacheStatus string
+}
+
+// aggregateStats is the stats of records with the same key in a window.
+type aggregateStats struct {
+	count         int
+	bodyBytesSent int
+	// requestTimes are the request times of all records, used to compute the
+	// percentiles.
+	requestTimes []float64
+}
+
+// aggregatedRecord is a row of the aggregated table.
+type aggregatedRecord struct {
+	windowStart    time.Time
+	windowEnd      time.Time
+	hostname       string
+	pathPrefix     string
+	httpMethod     string
+	status         int
+	cacheStatus    string
+	count          int
+	bodyBytesSent  int
+	requestTimeP50 float64
+	requestTimeP95 float64
+	requestTimeP99 float64
+}
+
+// aggregator buckets records into windows of fixed length, aligned to the
+// Unix epoch, by their timestamps.
+type aggregator struct {
+	window   time.Duration
+	hostname string
+	// pathSegments is the number of leading segments of a path kept in its
+	// prefix.
+	pathSegments int
+
+	mu sync.Mutex // mu protects 'windows'.
+	// windows maps the start time of a window to the stats of its keys.
+	windows map[time.Time]map[aggregateKey]*aggregateStats
+}
+
+func newAggregator(window time.Duration, hostname string, pathSegments int) *aggregator {
+	return &aggregator{
+		window:       window,
+		hostname:     hostname,
+		pathSegments: pathSegments,
+		windows:      make(map[time.Time]map[aggregateKey]*aggregateStats),
+	}
+}
+
+// add adds a record to the window of its timestamp.
+func (a *aggregator) add(r *record) {
+	start := r.timestamp.Truncate(a.window)
+	k := aggregateKey{
+		pathPrefix:  pathPrefix(r.path, a.pathSegments),
+		httpMethod:  r.httpMethod,
+		status:      r.status,
+		cacheStatus: r.cacheStatus,
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	w, ok := a.windows[start]
+	if !ok {
+		w = make(map[aggregateKey]*aggregateStats)
+		a.windows[start] = w
+	}
+	s, ok := w[k]
+	if !ok {
+		s = &aggregateStats{}
+		w[k] = s
+	}
+	s.count++
+	s.bodyBytesSent += r.bodyBytesSent
+	s.requestTimes = append(s.requestTimes, r.requestTime)
+}
+
+// flush removes the windows ending at or before t, and returns their
+// aggregated records, in the order of window start and key.
+func (a *aggregator) flush(t time.Time) []*aggregatedRecord {
+	a.mu.Lock()
+	var starts []time.Time
+	for start := range a.windows {
+		if !start.Add(a.window).After(t) {
+			starts = append(starts, start)
+		}
+	}
+	flushed := make(map[time.Time]map[aggregateKey]*aggregateStats, len(starts))
+	for _, start := range starts {
+		flushed[start] = a.windows[start]
+		delete(a.windows, start)
+	}
+	a.mu.Unlock()
+
+	sort.Slice(starts, func(i, j int) bool { return starts[i].Before(starts[j]) })
+	var records []*aggregatedRecord
+	for _, start := range starts {
+		var w []*aggregatedRecord
+		for k, s := range flushed[start] {
+			w = append(w, a.aggregatedRecord(start, k, s))
+		}
+		sort.Slice(w, func(i, j int) bool { return w[i].less(w[j]) })
+		records = append(records, w...)
+	}
+	return records
+}
+
+// flushAll removes all windows, complete or not, and returns their
+// aggregated records.
+func (a *aggregator) flushAll() []*aggregatedRecord {
+	var latest time.Time
+	a.mu.Lock()
+	for start := range a.windows {
+		if start.After(latest) {
+			latest = start
+		}
+	}
+	a.mu.Unlock()
+	return a.flush(latest.Add(a.window))
+}
+
+// run flushes the complete windows on every window boundary, delayed by
+// flushDelay, and all windows when the context is done, and queues the
+// aggregated records.
+// Records read after their window is flushed are aggregated in another row
+// of the same window.
+func (a *aggregator) run(ctx context.Context, queue func(interface{})) {
+	queueAll := func(records []*aggregatedRecord) {
+		for _, r := range records {
+			queue(r)
+		}
+		if len(records) > 0 {
+			log.Printf("Aggregated %d record(s)", len(records))
+		}
+	}
+	for {
+		end := time.Now().Add(-flushDelay).Truncate(a.window).Add(a.window)
+		t := time.NewTimer(time.Until(end.Add(flushDelay)))
+		select {
+		case <-t.C:
+			queueAll(a.flush(end))
+		case <-ctx.Done():
+			t.Stop()
+			queueAll(a.flushAll())
+			return
+		}
+	}
+}
+
+func (a *aggregator) aggregatedRecord(start time.Time, k aggregateKey, s *aggregateStats) *aggregatedRecord {
+	sort.Float64s(s.requestTimes)
+	return &aggregatedRecord{
+		windowStart:    start,
+		windowEnd:      start.Add(a.window),
+		hostname:       a.hostname,
+		pathPrefix:     k.pathPrefix,
+		httpMethod:     k.httpMethod,
+		status:         k.status,
+		cacheStatus:    k.cacheStatus,
+		count:          s.count,
+		bodyBytesSent:  s.bodyBytesSent,
+		requestTimeP50: percentile(s.requestTimes, 50),
+		requestTimeP95: percentile(s.requestTimes, 95),
+		requestTimeP99: percentile(s.requestTimes, 99),
+	}
+}
+
+// less orders aggregated records of the same window by their keys.
+func (r *aggregatedRecord) less(o *aggregatedRecord) bool {
+	switch {
+	case r.pathPrefix != o.pathPrefix:
+		return r.pathPrefix < o.pathPrefix
+	case r.httpMethod != o.httpMethod:
+		return r.httpMethod < o.httpMethod
+	case r.status != o.status:
+		return r.status < o.status
+	default:
+		return r.cacheStatus < o.cacheStatus
+	}
+}
+
+// percentile returns the p-th percentile of the sorted values, using the
+// nearest-rank method.
+func percentile(sorted []float64, p float64) float64 {
+	if len(sorted) == 0 {
+		return 0
+	}
+	rank := int(math.Ceil(p / 100 * float64(len(sorted))))
+	if rank < 1 {
+		rank = 1
+	}
+	return sorted[rank-1]
+}
+
+// pathPrefix returns the first n segments of the path without the query,
+// e.g. "/download/chromeos-image-archive/eve-release" for
+// "/download/chromeos-image-archive/eve-release/R90-13816.0.0/image.zip" and
+// n = 3.
+func pathPrefix(path string, n int) string {
+	if i := strings.IndexByte(path, '?'); i >= 0 {
+		path = path[:i]
+	}
+	segments := strings.SplitN(strings.TrimPrefix(path, "/"), "/", n+1)
+	if len(segments) > n {
+		segments = segments[:n]
+	}
+	return "/" + strings.Join(segments, "/")
+}
+
+func (r *aggregatedRecord) Save() (row map[string]bigquery.Value, insertID string, err error) {
+	row = map[string]bigquery.Value{
+		"window_start":     r.windowStart,
+		"window_end":       r.windowEnd,
+		"hostname":         r.hostname,
+		"path_prefix":      r.pathPrefix,
+		"http_method":      r.httpMethod,
+		"status":           r.status,
+		"cache":            r.cacheStatus,
+		"count":            r.count,
+		"body_bytes_sent":  r.bodyBytesSent,
+		"request_time_p50": r.requestTimeP50,
+		"request_time_p95": r.requestTimeP95,
+		"request_time_p99": r.requestTimeP99,
+	}
+	// A unique insert ID can prevent duplicated uploading when the BigQuery client retrys.
+	insertID = fmt.Sprintf("%v", row)
+
+	return row, insertID, nil
+}
@@ -0,0 +1,131 @@
+// Copyright 2021 The Chromium OS Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package main
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestAggregatorWindows(t *testing.T) {
+	t.Parallel()
+	base := time.Date(2021, 6, 9, 20, 0, 0, 0, time.UTC)
+	a := newAggregator(5*time.Minute, "host", 2)
+	for _, r := range []*record{
+		{timestamp: base.Add(10 * time.Second), httpMethod: "GET", path: "/download/a/1", status: 200, cacheStatus: "HIT", bodyBytesSent: 10, requestTime: 0.1},
+		{timestamp: base.Add(4*time.Minute + 59*time.Second), httpMethod: "GET", path: "/download/a/2", status: 200, cacheStatus: "HIT", bodyBytesSent: 20, requestTime: 0.3},
+		{timestamp: base.Add(time.Minute), httpMethod: "GET", path: "/download/b", status: 200, cacheStatus: "MISS", bodyBytesSent: 30, requestTime: 2},
+		// The next window.
+		{timestamp: base.Add(5 * time.Minute), httpMethod: "GET", path: "/download/a/1", status: 200, cacheStatus: "HIT", bodyBytesSent: 40, requestTime: 0.2},
+	} {
+		a.add(r)
+	}
+
+	if got := a.flush(base.Add(5*time.Minute - time.Second)); len(got) > 0 {
+		t.Errorf("flush() before the end of the first window = %v, want nothing", got)
+	}
+
+	want := []*aggregatedRecord{
+		{
+			windowStart:    base,
+			windowEnd:      base.Add(5 * time.Minute),
+			hostname:       "host",
+			pathPrefix:     "/download/a",
+			httpMethod:     "GET",
+			status:         200,
+			cacheStatus:    "HIT",
+			count:          2,
+			bodyBytesSent:  30,
+			requestTimeP50: 0.1,
+			requestTimeP95: 0.3,
+			requestTimeP99: 0.3,
+		},
+		{
+			windowStart:    base,
+			windowEnd:      base.Add(5 * time.Minute),
+			hostname:       "host",
+			pathPrefix:     "/download/b",
+			httpMethod:     "GET",
+			status:         200,
+			cacheStatus:    "MISS",
+			count:          1,
+			bodyBytesSent:  30,
+			requestTimeP50: 2,
+			requestTimeP95: 2,
+			requestTimeP99: 2,
+		},
+	}
+	got := a.flush(base.Add(5 * time.Minute))
+	if diff := cmp.Diff(want, got, cmp.AllowUnexported(aggregatedRecord{})); diff != "" {
+		t.Errorf("flush() at the end of the first window returned unexpected diff (-want +got):\n%s", diff)
+	}
+	if got := a.flush(base.Add(5 * time.Minute)); len(got) > 0 {
+		t.Errorf("flush() again = %v, want nothing", got)
+	}
+
+	// The incomplete window is flushed by flushAll.
+	want = []*aggregatedRecord{
+		{
+			windowStart:    base.Add(5 * time.Minute),
+			windowEnd:      base.Add(10 * time.Minute),
+			hostname:       "host",
+			pathPrefix:     "/download/a",
+			httpMethod:     "GET",
+			status:         200,
+			cacheStatus:    "HIT",
+			count:          1,
+			bodyBytesSent:  40,
+			requestTimeP50: 0.2,
+			requestTimeP95: 0.2,
+			requestTimeP99: 0.2,
+		},
+	}
+	got = a.flushAll()
+	if diff := cmp.Diff(want, got, cmp.AllowUnexported(aggregatedRecord{})); diff != "" {
+		t.Errorf("flushAll() returned unexpected diff (-want +got):\n%s", diff)
+	}
+	if got := a.flushAll(); len(got) > 0 {
+		t.Errorf("flushAll() again = %v, want nothing", got)
+	}
+}
+
+func TestAggregatorPercentiles(t *testing.T) {
+	t.Parallel()
+	base := time.Date(2021, 6, 9, 20, 0, 0, 0, time.UTC)
+	a := newAggregator(time.Minute, "host", 1)
+	// Add request times 100, 99, ..., 1 in one window.
+	for i := 100; i > 0; i-- {
+		a.add(&record{timestamp: base, path: "/download", requestTime: float64(i)})
+	}
+	got := a.flushAll()
+	if len(got) != 1 {
+		t.Fatalf("flushAll() = %v, want one record", got)
+	}
+	if r := got[0]; r.count != 100 || r.requestTimeP50 != 50 || r.requestTimeP95 != 95 || r.requestTimeP99 != 99 {
+		t.Errorf("flushAll() = %+v, want count 100 and p50/p95/p99 50/95/99", r)
+	}
+}
+
+func TestPathPrefix(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		path string
+		n    int
+		want string
+	}{
+		{"/download/chromeos-image-archive/eve-release/R90-13816.0.0/image.zip", 3, "/download/chromeos-image-archive/eve-release"},
+		{"/download/chromeos-image-archive/eve-release/R90-13816.0.0/image.zip", 1, "/download"},
+		{"/download/abc", 3, "/download/abc"},
+		{"/extract/bucket/file.tar?file=a/b", 2, "/extract/bucket"},
+		{"/", 3, "/"},
+	}
+	for _, tc := range tests {
+		if got := pathPrefix(tc.path, tc.n); got != tc.want {
+			t.Errorf("pathPrefix(%q, %d) = %q, want %q", tc.path, tc.n, got, tc.want)
+		}
+	}
+}
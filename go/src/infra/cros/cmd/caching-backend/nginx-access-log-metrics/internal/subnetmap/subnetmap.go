@@ -0,0 +1,156 @@
+// Copyright 2021 The Chromium OS Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+// Package subnetmap maps client IPs to the labs and zones of their subnets.
+//
+// A subnet map file is either a JSON file (with the ".json" extension) like:
+//
+//	[{"cidr": "10.1.0.0/16", "lab": "lab1", "zone": "zone1", "role": "dut"}]
+//
+// or a CSV file of "cidr,lab,zone,role" lines. Lines starting with '#' in a
+// CSV file are ignored.
+package subnetmap
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// Subnet describes where the IPs of a subnet are.
+type Subnet struct {
+	CIDR string `json:"cidr"`
+	Lab  string `json:"lab"`
+	Zone string `json:"zone"`
+	Role string `json:"role"`
+}
+
+// node is a node of a binary trie keyed by IP address bits.
+type node struct {
+	children [2]*node
+	// subnet is the subnet whose prefix ends at the node, if any.
+	subnet *Subnet
+}
+
+// Map maps IPs to subnets by longest-prefix match.
+// A Map is safe for concurrent lookups, but not for concurrent inserts.
+type Map struct {
+	v4 node
+	v6 node
+}
+
+// Insert inserts a subnet into the map.
+func (m *Map) Insert(s *Subnet) error {
+	addr, ipNet, err := net.ParseCIDR(s.CIDR)
+	if err != nil {
+		return fmt.Errorf("insert subnet: %s", err)
+	}
+	if !addr.Equal(ipNet.IP) {
+		return fmt.Errorf("insert subnet %q: host bits are set", s.CIDR)
+	}
+	ip, root := m.root(ipNet.IP)
+	ones, _ := ipNet.Mask.Size()
+	n := root
+	for i := 0; i < ones; i++ {
+		b := bit(ip, i)
+		if n.children[b] == nil {
+			n.children[b] = &node{}
+		}
+		n = n.children[b]
+	}
+	if n.subnet != nil {
+		return fmt.Errorf("insert subnet %q: duplicates %q", s.CIDR, n.subnet.CIDR)
+	}
+	n.subnet = s
+	return nil
+}
+
+// Lookup returns the most specific subnet containing the IP, or nil if
+// there is none.
+func (m *Map) Lookup(ip net.IP) *Subnet {
+	if ip == nil {
+		return nil
+	}
+	ip, n := m.root(ip)
+	found := n.subnet
+	for i := 0; i < len(ip)*8; i++ {
+		if n = n.children[bit(ip, i)]; n == nil {
+			break
+		}
+		if n.subnet != nil {
+			found = n.subnet
+		}
+	}
+	return found
+}
+
+// root returns the IP in its canonical length, and the root of the trie
+// for its address family.
+func (m *Map) root(ip net.IP) (net.IP, *node) {
+	if ip4 := ip.To4(); ip4 != nil {
+		return ip4, &m.v4
+	}
+	return ip.To16(), &m.v6
+}
+
+// bit returns the i-th most significant bit of the IP.
+func bit(ip net.IP, i int) int {
+	return int(ip[i/8]>>(7-uint(i%8))) & 1
+}
+
+// Load loads a subnet map from a JSON or CSV file.
+func Load(path string) (*Map, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("load subnet map: %s", err)
+	}
+	var subnets []*Subnet
+	if strings.EqualFold(filepath.Ext(path), ".json") {
+		subnets, err = parseJSON(content)
+	} else {
+		subnets, err = parseCSV(content)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("load subnet map %q: %s", path, err)
+	}
+	m := &Map{}
+	for _, s := range subnets {
+		if err := m.Insert(s); err != nil {
+			return nil, fmt.Errorf("load subnet map %q: %s", path, err)
+		}
+	}
+	return m, nil
+}
+
+func parseJSON(content []byte) ([]*Subnet, error) {
+	var subnets []*Subnet
+	if err := json.Unmarshal(content, &subnets); err != nil {
+		return nil, fmt.Errorf("parse JSON: %s", err)
+	}
+	return subnets, nil
+}
+
+func parseCSV(content []byte) ([]*Subnet, error) {
+	r := csv.NewReader(bytes.NewReader(content))
+	r.Comment = '#'
+	r.FieldsPerRecord = 4
+	r.TrimLeadingSpace = true
+	var subnets []*Subnet
+	for {
+		f, err := r.Read()
+		if err == io.EOF {
+			return subnets, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("parse CSV: %s", err)
+		}
+		subnets = append(subnets, &Subnet{CIDR: f[0], Lab: f[1], Zone: f[2], Role: f[3]})
+	}
+}
@@ -0,0 +1,194 @@
+// Copyright 2021 The Chromium OS Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package subnetmap
+
+import (
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestLookup(t *testing.T) {
+	t.Parallel()
+	subnets := []*Subnet{
+		{CIDR: "10.0.0.0/8", Lab: "lab", Zone: "any", Role: "server"},
+		{CIDR: "10.1.0.0/16", Lab: "lab1", Zone: "zone1", Role: "dut"},
+		{CIDR: "10.1.2.0/24", Lab: "lab1", Zone: "zone2", Role: "dut"},
+		{CIDR: "10.1.2.128/32", Lab: "lab1", Zone: "zone3", Role: "server"},
+		{CIDR: "2001:db8::/32", Lab: "lab2", Zone: "zone1", Role: "dut"},
+		{CIDR: "2001:db8:1::/48", Lab: "lab2", Zone: "zone2", Role: "dut"},
+	}
+	m := &Map{}
+	for _, s := range subnets {
+		if err := m.Insert(s); err != nil {
+			t.Fatalf("Insert(%q) failed: %s", s.CIDR, err)
+		}
+	}
+	tests := []struct {
+		ip   string
+		want *Subnet
+	}{
+		{"10.2.3.4", subnets[0]},
+		{"10.1.3.4", subnets[1]},
+		{"10.1.2.3", subnets[2]},
+		{"10.1.2.128", subnets[3]},
+		{"10.1.2.129", subnets[2]},
+		{"::ffff:10.1.2.3", subnets[2]},
+		{"11.0.0.1", nil},
+		{"2001:db8:2::1", subnets[4]},
+		{"2001:db8:1::1", subnets[5]},
+		{"2001:db9::1", nil},
+		// An IPv6 address is not matched by IPv4 subnets.
+		{"a01:203::", nil},
+		{"not-an-ip", nil},
+	}
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.ip, func(t *testing.T) {
+			t.Parallel()
+			if got := m.Lookup(net.ParseIP(tc.ip)); got != tc.want {
+				t.Errorf("Lookup(%q) = %v, want %v", tc.ip, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestLookupDefaultRoute(t *testing.T) {
+	t.Parallel()
+	m := &Map{}
+	s := &Subnet{CIDR: "0.0.0.0/0", Lab: "default"}
+	if err := m.Insert(s); err != nil {
+		t.Fatalf("Insert(%q) failed: %s", s.CIDR, err)
+	}
+	if got := m.Lookup(net.ParseIP("192.168.0.1")); got != s {
+		t.Errorf("Lookup(%q) = %v, want %v", "192.168.0.1", got, s)
+	}
+	if got := m.Lookup(net.ParseIP("2001:db8::1")); got != nil {
+		t.Errorf("Lookup(%q) = %v, want nil", "2001:db8::1", got)
+	}
+}
+
+func TestInsertErrors(t *testing.T) {
+	t.Parallel()
+	m := &Map{}
+	if err := m.Insert(&Subnet{CIDR: "10.1.0.0/16"}); err != nil {
+		t.Fatalf("Insert() failed: %s", err)
+	}
+	for _, cidr := range []string{"10.1.2.3/16", "10.1.0.0", "10.1.0.0/33"} {
+		if err := m.Insert(&Subnet{CIDR: cidr}); err == nil {
+			t.Errorf("Insert(%q) succeeded, want error", cidr)
+		}
+	}
+}
+
+func TestLoad(t *testing.T) {
+	t.Parallel()
+	want := []*Subnet{
+		{CIDR: "10.1.0.0/16", Lab: "lab1", Zone: "zone1", Role: "dut"},
+		{CIDR: "2001:db8::/32", Lab: "lab2", Zone: "zone2", Role: "server"},
+	}
+	tests := []struct {
+		name    string
+		content string
+	}{
+		{
+			name:    "map.csv",
+			content: "# cidr,lab,zone,role\n10.1.0.0/16,lab1,zone1,dut\n2001:db8::/32, lab2, zone2, server\n",
+		},
+		{
+			name: "map.json",
+			content: `[{"cidr": "10.1.0.0/16", "lab": "lab1", "zone": "zone1", "role": "dut"},
+			{"cidr": "2001:db8::/32", "lab": "lab2", "zone": "zone2", "role": "server"}]`,
+		},
+	}
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			path := filepath.Join(t.TempDir(), tc.name)
+			if err := os.WriteFile(path, []byte(tc.content), 0644); err != nil {
+				t.Fatal(err)
+			}
+			m, err := Load(path)
+			if err != nil {
+				t.Fatalf("Load(%q) failed: %s", path, err)
+			}
+			got := []*Subnet{m.Lookup(net.ParseIP("10.1.0.1")), m.Lookup(net.ParseIP("2001:db8::1"))}
+			if diff := cmp.Diff(want, got); diff != "" {
+				t.Errorf("Load(%q) mismatch (-want +got):\n%s", path, diff)
+			}
+		})
+	}
+}
+
+func TestLoadErrors(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		content string
+		wantErr string
+	}{
+		{"bad.csv", "10.1.0.0/16,lab1,zone1\n", "parse CSV"},
+		{"bad.json", `{"cidr": "10.1.0.0/16"}`, "parse JSON"},
+		{"duplicated.csv", "10.1.0.0/16,lab1,zone1,dut\n10.1.0.0/16,lab2,zone2,dut\n", "duplicates"},
+	}
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			path := filepath.Join(t.TempDir(), tc.name)
+			if err := os.WriteFile(path, []byte(tc.content), 0644); err != nil {
+				t.Fatal(err)
+			}
+			_, err := Load(path)
+			if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+				t.Errorf("Load(%q) = %v, want error containing %q", path, err, tc.wantErr)
+			}
+		})
+	}
+}
+
+func TestWatcherReload(t *testing.T) {
+	t.Parallel()
+	path := filepath.Join(t.TempDir(), "map.csv")
+	if err := os.WriteFile(path, []byte("10.1.0.0/16,lab1,zone1,dut\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	w, err := NewWatcher(path, time.Hour)
+	if err != nil {
+		t.Fatalf("NewWatcher() failed: %s", err)
+	}
+	defer w.Close()
+	ip := net.ParseIP("10.1.0.1")
+	if got := w.Lookup(ip); got == nil || got.Lab != "lab1" {
+		t.Fatalf("Lookup(%s) = %v, want lab1", ip, got)
+	}
+
+	if err := os.WriteFile(path, []byte("10.1.0.0/16,lab22,zone1,dut\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.reloadIfChanged(); err != nil {
+		t.Fatalf("reloadIfChanged() failed: %s", err)
+	}
+	if got := w.Lookup(ip); got == nil || got.Lab != "lab22" {
+		t.Errorf("Lookup(%s) after reload = %v, want lab22", ip, got)
+	}
+
+	// A broken file keeps the last good map.
+	if err := os.WriteFile(path, []byte("broken"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.reloadIfChanged(); err == nil {
+		t.Errorf("reloadIfChanged() succeeded with a broken file, want error")
+	}
+	if got := w.Lookup(ip); got == nil || got.Lab != "lab22" {
+		t.Errorf("Lookup(%s) after failed reload = %v, want lab22", ip, got)
+	}
+}
@@ -0,0 +1,92 @@
+// Copyright 2021 The Chromium OS Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package subnetmap
+
+import (
+	"log"
+	"net"
+	"os"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// Watcher serves lookups from a subnet map file, and reloads the file when
+// it changes.
+type Watcher struct {
+	path    string
+	m       atomic.Value // m holds the current *Map.
+	modTime time.Time
+	size    int64
+	closing chan struct{}
+	wg      sync.WaitGroup
+}
+
+// NewWatcher loads the subnet map file and checks it for changes at the
+// given interval.
+func NewWatcher(path string, interval time.Duration) (*Watcher, error) {
+	w := &Watcher{path: path, closing: make(chan struct{})}
+	if err := w.reload(); err != nil {
+		return nil, err
+	}
+	t := time.NewTicker(interval)
+	w.wg.Add(1)
+	go func() {
+		defer w.wg.Done()
+		defer t.Stop()
+		for {
+			select {
+			case <-t.C:
+				if err := w.reloadIfChanged(); err != nil {
+					// Keep serving the last good map.
+					log.Printf("subnetmap: %s", err)
+				}
+			case <-w.closing:
+				return
+			}
+		}
+	}()
+	return w, nil
+}
+
+// Lookup returns the most specific subnet containing the IP, or nil if
+// there is none.
+func (w *Watcher) Lookup(ip net.IP) *Subnet {
+	return w.m.Load().(*Map).Lookup(ip)
+}
+
+// Close stops watching the file.
+func (w *Watcher) Close() {
+	close(w.closing)
+	w.wg.Wait()
+}
+
+// reloadIfChanged reloads the file if its modification time or size
+// changed since it was last loaded.
+func (w *Watcher) reloadIfChanged() error {
+	fi, err := os.Stat(w.path)
+	if err != nil {
+		return err
+	}
+	if fi.ModTime().Equal(w.modTime) && fi.Size() == w.size {
+		return nil
+	}
+	return w.reload()
+}
+
+func (w *Watcher) reload() error {
+	fi, err := os.Stat(w.path)
+	if err != nil {
+		return err
+	}
+	m, err := Load(w.path)
+	if err != nil {
+		return err
+	}
+	w.m.Store(m)
+	w.modTime, w.size = fi.ModTime(), fi.Size()
+	log.Printf("subnetmap: loaded %q", w.path)
+	return nil
+}
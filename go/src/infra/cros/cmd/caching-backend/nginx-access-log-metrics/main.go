@@ -9,10 +9,12 @@ import (
 	"flag"
 	"fmt"
 	"log"
+	"net"
 	"os"
 	"regexp"
 	"strconv"
 	"strings"
+	"sync/atomic"
 	"time"
 
 	"cloud.google.com/go/bigquery"
@@ -20,6 +22,7 @@ import (
 
 	"infra/cros/cmd/caching-backend/nginx-access-log-metrics/internal/bquploader"
 	"infra/cros/cmd/caching-backend/nginx-access-log-metrics/internal/filetailer"
+	"infra/cros/cmd/caching-backend/nginx-access-log-metrics/internal/subnetmap"
 )
 
 type record struct {
@@ -33,14 +36,31 @@ type record struct {
 	expectedSize  int
 	requestTime   float64
 	cacheStatus   string
+	// lab and zone are of the subnet of the client IP, or null if the
+	// client IP is not in any known subnet.
+	lab  bigquery.NullString
+	zone bigquery.NullString
 }
 
 var (
 	svcAcctJSONPath = flag.String("service-account-json", "", "Path to JSON file with service account credentials to use")
 	projectID       = flag.String("project-id", "cros-lab-servers", "ID of the cloud projecdt to upload metrics data to")
 	dataset         = flag.String("dataset", "caching_backend", "Dataset name of the BigQuery tables")
-	tableName       = flag.String("table", "access_log", "BigQuery table name")
+	tableName       = flag.String("table", "access_log", "BigQuery table name of raw records, one per log line")
+	uploadRawRows   = flag.Bool("upload-raw-rows", false, "Upload raw records, one per log line, to the table given by -table")
+	aggTableName    = flag.String("aggregated-table", "access_log_aggregated", "BigQuery table name of aggregated records")
+	aggWindow       = flag.Duration("aggregation-window", 5*time.Minute, "Length of the windows to aggregate records in by path prefix, http method, status and cache status; 0 disables the aggregation")
+	pathSegments    = flag.Int("path-prefix-segments", 3, "Number of leading path segments kept in the path prefix of aggregated records")
 	inputLogFile    = flag.String("input-log-file", "/var/log/nginx/gs-cache.access.log", "Nginx access log for gs_cache")
+	subnetMapFile   = flag.String("subnet-map", "", "Path to a CSV or JSON file mapping subnets to labs and zones, used to enrich records with the lab and zone of client IPs")
+)
+
+const (
+	// uploadInterval is the interval of uploading records to BigQuery.
+	uploadInterval = 10 * time.Minute
+	// subnetMapCheckInterval is the interval of checking the subnet map file
+	// for changes.
+	subnetMapCheckInterval = time.Minute
 )
 
 func main() {
@@ -52,6 +72,14 @@ func main() {
 
 func innerMain() error {
 	flag.Parse()
+	switch {
+	case *aggWindow < 0:
+		return fmt.Errorf("-aggregation-window must not be negative")
+	case *aggWindow == 0 && !*uploadRawRows:
+		return fmt.Errorf("nothing to upload: -aggregation-window is 0 and -upload-raw-rows is not set")
+	case *pathSegments < 1:
+		return fmt.Errorf("-path-prefix-segments must be positive")
+	}
 
 	hostname, err := os.Hostname()
 	if err != nil {
@@ -60,16 +88,45 @@ func innerMain() error {
 
 	ctx := cancelOnSignals(context.Background())
 
-	t := bquploader.TargetTable{
-		ProjectID: *projectID,
-		Dataset:   *dataset,
-		TableName: *tableName,
+	var uploader *bquploader.Uploader
+	if *uploadRawRows {
+		uploader, err = newUploader(*tableName)
+		if err != nil {
+			return err
+		}
+		defer uploader.Close()
 	}
-	uploader, err := bquploader.NewUploader(t, 10*time.Minute, option.WithCredentialsFile(*svcAcctJSONPath))
-	if err != nil {
-		return err
+
+	var agg *aggregator
+	// aggDone is closed when the aggregator has queued all its records.
+	aggDone := make(chan struct{})
+	if *aggWindow > 0 {
+		aggUploader, err := newUploader(*aggTableName)
+		if err != nil {
+			return err
+		}
+		defer aggUploader.Close()
+		agg = newAggregator(*aggWindow, hostname, *pathSegments)
+		go func() {
+			defer close(aggDone)
+			agg.run(ctx, aggUploader.QueueRecord)
+		}()
+	} else {
+		close(aggDone)
+	}
+
+	var subnets *subnetmap.Watcher
+	// unmatched is the number of records whose client IP is not in any
+	// subnet of the map, in the current interval.
+	var unmatched int64
+	if *subnetMapFile != "" {
+		subnets, err = subnetmap.NewWatcher(*subnetMapFile, subnetMapCheckInterval)
+		if err != nil {
+			return err
+		}
+		defer subnets.Close()
+		go reportUnmatched(ctx, &unmatched)
 	}
-	defer uploader.Close()
 
 	tailer, err := filetailer.New(*inputLogFile)
 	if err != nil {
@@ -81,14 +138,70 @@ func innerMain() error {
 		for tailer.Scan() {
 			if r := parseLine(tailer.Text()); r != nil {
 				r.hostname = hostname
-				uploader.QueueRecord(r)
+				if subnets != nil && !enrichRecord(r, subnets) {
+					atomic.AddInt64(&unmatched, 1)
+				}
+				if agg != nil {
+					agg.add(r)
+				}
+				if uploader != nil {
+					uploader.QueueRecord(r)
+				}
 			}
 		}
 	}()
 	<-ctx.Done()
+	// Wait for the aggregator to flush, before the uploaders are closed.
+	<-aggDone
 	return nil
 }
 
+// newUploader returns an uploader to the table in the dataset given by the
+// flags.
+func newUploader(tableName string) (*bquploader.Uploader, error) {
+	t := bquploader.TargetTable{
+		ProjectID: *projectID,
+		Dataset:   *dataset,
+		TableName: tableName,
+	}
+	return bquploader.NewUploader(t, uploadInterval, option.WithCredentialsFile(*svcAcctJSONPath))
+}
+
+// reportUnmatched logs the number of records with unmatched client IPs
+// every upload interval, to help detect the subnet map drifting from the
+// lab network.
+func reportUnmatched(ctx context.Context, unmatched *int64) {
+	t := time.NewTicker(uploadInterval)
+	defer t.Stop()
+	for {
+		select {
+		case <-t.C:
+			if n := atomic.SwapInt64(unmatched, 0); n > 0 {
+				log.Printf("%d record(s) with client IPs not in the subnet map in the last %s", n, uploadInterval)
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// subnetLookuper looks up the subnet of an IP.
+type subnetLookuper interface {
+	Lookup(ip net.IP) *subnetmap.Subnet
+}
+
+// enrichRecord sets the lab and zone of the record from the subnet of its
+// client IP. It returns false if the client IP is not in any subnet.
+func enrichRecord(r *record, l subnetLookuper) bool {
+	s := l.Lookup(net.ParseIP(r.clientIP))
+	if s == nil {
+		return false
+	}
+	r.lab = bigquery.NullString{StringVal: s.Lab, Valid: true}
+	r.zone = bigquery.NullString{StringVal: s.Zone, Valid: true}
+	return true
+}
+
 // See https://chromium.googlesource.com/infra/infra/+/refs/heads/main/go/src/infra/cros/cmd/caching-backend/conf-creator/conf_templates.go#55
 // for the detailed log format definition.
 // An example log line:
@@ -210,6 +323,8 @@ func (i *record) Save() (row map[string]bigquery.Value, insertID string, err err
 		"expected_size":   i.expectedSize,
 		"request_time":    i.requestTime,
 		"cache":           i.cacheStatus,
+		"lab":             i.lab,
+		"zone":            i.zone,
 	}
 	// A unique insert ID can prevent duplicated uploading when the BigQuery client retrys.
 	insertID = fmt.Sprintf("%v", row)
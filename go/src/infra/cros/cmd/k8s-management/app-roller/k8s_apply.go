@@ -5,15 +5,21 @@
 package main
 
 import (
+	"bufio"
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
+	"strings"
 
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/runtime/serializer/yaml"
 	"k8s.io/apimachinery/pkg/types"
+	utilyaml "k8s.io/apimachinery/pkg/util/yaml"
 	"k8s.io/client-go/discovery"
 	"k8s.io/client-go/discovery/cached/memory"
 	"k8s.io/client-go/dynamic"
@@ -21,49 +27,152 @@ import (
 	"k8s.io/client-go/restmapper"
 )
 
-// k8sApply applies a YAML content to the K8s cluster the program running on.
+// document is a K8s object of a generated YAML file.
+type document struct {
+	obj *unstructured.Unstructured
+	gvk *schema.GroupVersionKind
+}
+
+// String returns the kind and name of the object, which identify the
+// manifest in errors.
+func (d *document) String() string {
+	return fmt.Sprintf("%s %q", d.gvk.Kind, d.obj.GetName())
+}
+
+// Objects applied by app-roller have the managedByLabel label set to
+// managedByValue, which is also the field manager of their fields.
+const (
+	managedByLabel = "app.kubernetes.io/managed-by"
+	managedByValue = "k8s_app_roller"
+)
+
+// markManaged sets the label marking the object as managed by app-roller.
+func (d *document) markManaged() {
+	labels := d.obj.GetLabels()
+	if labels == nil {
+		labels = map[string]string{}
+	}
+	labels[managedByLabel] = managedByValue
+	d.obj.SetLabels(labels)
+}
+
+// isManaged tells whether the live object is managed by app-roller.
+func isManaged(obj *unstructured.Unstructured) bool {
+	return obj.GetLabels()[managedByLabel] == managedByValue
+}
+
+// parseDocuments parses the documents of a YAML content into K8s objects.
+// Empty documents are skipped.
+func parseDocuments(content string) ([]*document, error) {
+	r := utilyaml.NewYAMLReader(bufio.NewReader(strings.NewReader(content)))
+	decUnstructured := yaml.NewDecodingSerializer(unstructured.UnstructuredJSONScheme)
+	var docs []*document
+	for i := 0; ; i++ {
+		raw, err := r.Read()
+		if err == io.EOF {
+			return docs, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("parse documents: %s", err)
+		}
+		if isEmptyDocument(raw) {
+			continue
+		}
+		obj := &unstructured.Unstructured{}
+		_, gvk, err := decUnstructured.Decode(raw, nil, obj)
+		if err != nil {
+			return nil, fmt.Errorf("parse documents: document %d: %s", i, err)
+		}
+		docs = append(docs, &document{obj: obj, gvk: gvk})
+	}
+}
+
+// isEmptyDocument returns true if the YAML document has only blank and
+// comment lines.
+func isEmptyDocument(raw []byte) bool {
+	for _, l := range strings.Split(string(raw), "\n") {
+		l = strings.TrimSpace(l)
+		if l != "" && !strings.HasPrefix(l, "#") && l != "---" {
+			return false
+		}
+	}
+	return true
+}
+
+// k8sClient applies K8s objects to the K8s cluster the program running on.
 // The implementation is mostly inspired by
 // https://ymmt2005.hatenablog.com/entry/2020/04/14/An_example_of_using_dynamic_client_of_k8s.io/client-go
-func k8sApply(ctx context.Context, content string) error {
+type k8sClient struct {
+	mapper meta.RESTMapper
+	dyn    dynamic.Interface
+}
+
+// newK8sClient creates a client of the K8s cluster the program running on.
+func newK8sClient() (*k8sClient, error) {
 	cfg, err := rest.InClusterConfig()
 	if err != nil {
-		return fmt.Errorf("apply to k8s: %s", err)
+		return nil, fmt.Errorf("new k8s client: %s", err)
 	}
 	dc, err := discovery.NewDiscoveryClientForConfig(cfg)
 	if err != nil {
-		return fmt.Errorf("apply to k8s: %s", err)
+		return nil, fmt.Errorf("new k8s client: %s", err)
 	}
-	mapper := restmapper.NewDeferredDiscoveryRESTMapper(memory.NewMemCacheClient(dc))
 	dyn, err := dynamic.NewForConfig(cfg)
 	if err != nil {
-		return fmt.Errorf("apply to k8s: %s", err)
+		return nil, fmt.Errorf("new k8s client: %s", err)
 	}
-	obj := &unstructured.Unstructured{}
-	decUnstructured := yaml.NewDecodingSerializer(unstructured.UnstructuredJSONScheme)
-	_, gvk, err := decUnstructured.Decode([]byte(content), nil, obj)
+	return &k8sClient{
+		mapper: restmapper.NewDeferredDiscoveryRESTMapper(memory.NewMemCacheClient(dc)),
+		dyn:    dyn,
+	}, nil
+}
+
+// resource returns the client of the resource of the document.
+func (c *k8sClient) resource(d *document) (dynamic.ResourceInterface, error) {
+	mapping, err := c.mapper.RESTMapping(d.gvk.GroupKind(), d.gvk.Version)
 	if err != nil {
-		return fmt.Errorf("apply to k8s: %s", err)
+		return nil, err
+	}
+	if mapping.Scope.Name() == meta.RESTScopeNameNamespace {
+		return c.dyn.Resource(mapping.Resource).Namespace(d.obj.GetNamespace()), nil
 	}
+	return c.dyn.Resource(mapping.Resource), nil
+}
 
-	mapping, err := mapper.RESTMapping(gvk.GroupKind(), gvk.Version)
+// get implements the get method of k8sApplier interface.
+func (c *k8sClient) get(ctx context.Context, d *document) (*unstructured.Unstructured, error) {
+	dr, err := c.resource(d)
+	if err != nil {
+		return nil, fmt.Errorf("get %s: %s", d, err)
+	}
+	obj, err := dr.Get(ctx, d.obj.GetName(), metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		return nil, nil
+	}
 	if err != nil {
-		return fmt.Errorf("apply to k8s: %s", err)
+		return nil, fmt.Errorf("get %s: %s", d, err)
 	}
+	return obj, nil
+}
 
-	var dr dynamic.ResourceInterface
-	if mapping.Scope.Name() == meta.RESTScopeNameNamespace {
-		dr = dyn.Resource(mapping.Resource).Namespace(obj.GetNamespace())
-	} else {
-		dr = dyn.Resource(mapping.Resource)
+// apply implements the apply method of k8sApplier interface.
+func (c *k8sClient) apply(ctx context.Context, d *document, dryRun bool) error {
+	dr, err := c.resource(d)
+	if err != nil {
+		return fmt.Errorf("apply %s: %s", d, err)
 	}
 
-	data, err := json.Marshal(obj)
+	data, err := json.Marshal(d.obj)
 	if err != nil {
-		return fmt.Errorf("apply to k8s: %s", err)
+		return fmt.Errorf("apply %s: %s", d, err)
 	}
 
-	if _, err = dr.Patch(ctx, obj.GetName(), types.ApplyPatchType, data, metav1.PatchOptions{FieldManager: "k8s_app_roller"}); err != nil {
-		return fmt.Errorf("apply to k8s: %s", err)
+	opts := metav1.PatchOptions{FieldManager: managedByValue}
+	if dryRun {
+		opts.DryRun = []string{metav1.DryRunAll}
+	}
+	if _, err = dr.Patch(ctx, d.obj.GetName(), types.ApplyPatchType, data, opts); err != nil {
+		return fmt.Errorf("apply %s: %s", d, err)
 	}
 	return nil
 }
@@ -17,9 +17,31 @@
 //   - name: my_cool_image2
 //     repo: gcr.io/project/image2
 //     official_tag_regex: ^official-\d+$
+//   - name: my_cool_image3
+//     repo: gcr.io/project/image3
+//     digest: sha256:0123...  # pin the image, mutually exclusive with tag
 //
 // The regex must begin with '^' and end with '$' in order to match the whole
 // tag string strictly.
+// An image pinned by digest is used as is, after checking the digest exists
+// in the repo, and the template gets the "repo@sha256:..." reference.
+//
+// Before an app is applied, every document of its generated YAML is
+// validated by the cluster with a server-side dry-run, and the app is only
+// applied if all of them are valid. With -dry-run-only, app-roller stops
+// after validating all apps, and fails if any app is invalid.
+//
+// Each run also reports, for every app, whether the Deployments and
+// DaemonSets of its generated YAML run the desired images in the cluster:
+// a workload is in sync, drifted (other images or containers), or missing.
+// Workloads not labeled as managed by app-roller are excluded. The report
+// is logged and written as JSON to the file given by -report-file. With
+// -report-only, app-roller only reports, without validating or applying
+// anything.
+//
+// During a freeze window in the file given by -freeze-config, apps are not
+// applied, and the generated YAML is logged instead. See package
+// infra/cros/cmd/k8s-management/internal/freeze for the file format.
 package main
 
 import (
@@ -40,10 +62,14 @@ import (
 	"time"
 
 	"github.com/google/go-containerregistry/pkg/authn"
-	"github.com/google/go-containerregistry/pkg/name"
+	v1 "github.com/google/go-containerregistry/pkg/v1"
 	"github.com/google/go-containerregistry/pkg/v1/google"
 	"github.com/jdxcode/netrc"
 	"gopkg.in/yaml.v2"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"infra/cros/cmd/k8s-management/internal/freeze"
+	"infra/cros/cmd/k8s-management/internal/registry"
 )
 
 func main() {
@@ -58,8 +84,31 @@ func innerMain() error {
 		serviceAccountJSON = flag.String("service-account-json", "", "Path to JSON file with service account credentials to use")
 		netrcPath          = flag.String("netrc", "", "Path to .netrc file used to access the gerrit server")
 		appsYaml           = flag.String("apps-yaml", "", "Path to a yaml file which includes all applications data")
+		freezeConfig       = flag.String("freeze-config", "", "Path to a yaml file of deployment freeze windows")
+		cluster            = flag.String("cluster", "", "Name of the K8s cluster the apps are applied to, used to match freeze windows")
+		ignoreFreeze       = flag.Bool("ignore-freeze", false, "Apply apps even during freeze windows, for emergency changes")
+		dryRunOnly         = flag.Bool("dry-run-only", false, "Only validate the generated YAML of apps with a server-side dry-run, without applying them")
+		reportOnly         = flag.Bool("report-only", false, "Only report the drift of the workloads of apps, without validating or applying them")
+		reportFile         = flag.String("report-file", "-", "Path to the JSON file the report is written to, '-' for stdout")
 	)
 	flag.Parse()
+	if *dryRunOnly && *reportOnly {
+		return fmt.Errorf("-dry-run-only and -report-only are mutually exclusive")
+	}
+	mode := applyMode
+	switch {
+	case *dryRunOnly:
+		mode = dryRunOnlyMode
+	case *reportOnly:
+		mode = reportOnlyMode
+	}
+
+	windows, err := freeze.Load(*freezeConfig)
+	if err != nil {
+		return err
+	}
+	fc := &freeze.Checker{Config: windows, Override: *ignoreFreeze}
+	fc.LogActive()
 
 	content, err := os.ReadFile(*serviceAccountJSON)
 	if err != nil {
@@ -81,21 +130,38 @@ func innerMain() error {
 		}
 	}
 
+	k, err := newK8sClient()
+	if err != nil {
+		return err
+	}
+
 	ch := make(chan string, len(apps))
+	rpt := &report{Cluster: *cluster, Time: time.Now().UTC(), Apps: make([]*appReport, len(apps))}
 	var wg sync.WaitGroup
-	for _, a := range apps {
+	for i, a := range apps {
 		wg.Add(1)
-		go func(a app) {
+		go func(i int, a app) {
 			defer wg.Done()
-			if err := rolloutApp(a, auth, &netrcClient{nr}); err != nil {
+			r, err := rolloutApp(a, auth, &netrcClient{nr}, k, fc, *cluster, mode)
+			if err != nil {
 				log.Printf("Apply %q: %s", a, err)
 				ch <- fmt.Sprintf("%q", a)
 			}
-		}(a)
+			// A nil report means the app failed before being reported.
+			if r == nil {
+				r = &appReport{App: a.Name, Error: err.Error()}
+			}
+			rpt.Apps[i] = r
+		}(i, a)
 	}
 	wg.Wait()
 	close(ch)
 
+	rpt.logSummary()
+	if err := rpt.write(*reportFile); err != nil {
+		return err
+	}
+
 	var names []string
 	for n := range ch {
 		names = append(names, n)
@@ -119,45 +185,121 @@ func loadApps(path string) ([]app, error) {
 	return apps, nil
 }
 
+// rolloutMode is what rolloutApp does with the generated YAML of an app.
+type rolloutMode int
+
+const (
+	// applyMode validates and applies the YAML.
+	applyMode rolloutMode = iota
+	// dryRunOnlyMode only validates the YAML.
+	dryRunOnlyMode
+	// reportOnlyMode neither validates nor applies the YAML.
+	reportOnlyMode
+)
+
 // rolloutApp generates application YAML file and apply to K8s.
-func rolloutApp(a app, auth authn.Authenticator, d downloader) error {
+// The YAML is only applied if all of its documents pass validation, and
+// only in applyMode.
+// If a freeze window affects the app, the YAML is logged instead of applied.
+// It returns the report of the workloads of the app before any change. In
+// modes other than reportOnlyMode, failing to report doesn't fail the
+// roll-out, and the error is in the report.
+func rolloutApp(a app, auth authn.Authenticator, d downloader, k k8sApplier, fc *freeze.Checker, cluster string, mode rolloutMode) (*appReport, error) {
 	yamlTemplate, err := d.download(a.Source)
 	if err != nil {
-		return fmt.Errorf("roll out app %q: %s", a, err)
+		return nil, fmt.Errorf("roll out app %q: %s", a, err)
 	}
-	imageMap, err := resolveImages(a.Images, auth)
+	imageMap, digests, err := resolveImages(a.Images, auth)
 	if err != nil {
-		return fmt.Errorf("roll out app %q: %s", a, err)
+		return nil, fmt.Errorf("roll out app %q: %s", a, err)
 	}
 	content, err := genAppYaml(yamlTemplate, imageMap)
 	if err != nil {
-		return fmt.Errorf("roll out app %q: %s", a, err)
+		return nil, fmt.Errorf("roll out app %q: %s", a, err)
 	}
-	if err := applyToK8s(content); err != nil {
-		return fmt.Errorf("roll out app %q: %s", a, err)
+	docs, err := parseDocuments(content)
+	if err != nil {
+		return nil, fmt.Errorf("roll out app %q: %s", a, err)
 	}
-	return nil
+	for _, d := range docs {
+		d.markManaged()
+	}
+
+	r, err := reportOnK8s(k, a, docs, digests)
+	if err != nil {
+		if mode == reportOnlyMode {
+			return nil, fmt.Errorf("roll out app %q: %s", a, err)
+		}
+		log.Printf("Report %q: %s", a, err)
+		r = &appReport{App: a.Name, Error: err.Error()}
+	}
+	if mode == reportOnlyMode {
+		log.Printf("Reported %q, skip applying in report-only mode", a)
+		return r, nil
+	}
+
+	if err := validateOnK8s(k, docs); err != nil {
+		return r, fmt.Errorf("roll out app %q: %s", a, err)
+	}
+	if mode == dryRunOnlyMode {
+		log.Printf("Validated %q, skip applying in dry-run-only mode", a)
+		return r, nil
+	}
+	if w := fc.Frozen(freeze.Item{Cluster: cluster, Repos: a.repos()}); w != nil {
+		log.Printf("Freeze window %s is active, skip applying %q:\n%s", w, a, content)
+		return r, nil
+	}
+	if err := applyToK8s(k, docs); err != nil {
+		return r, fmt.Errorf("roll out app %q: %s", a, err)
+	}
+	return r, nil
 }
 
-// resolveImages resolves all images to their official tags of the app.
-func resolveImages(images []image, auth authn.Authenticator) (map[string]string, error) {
+// resolveImages resolves all images of the app to their official tags, or
+// to their digests if the images are pinned.
+// It returns the map of image names to the resolved references, and the
+// map of the resolved references to the digests of the images. Digests
+// which can't be resolved are missing from the latter.
+func resolveImages(images []image, auth authn.Authenticator) (map[string]string, map[string]string, error) {
 	m := map[string]string{}
+	digests := map[string]string{}
 	for _, img := range images {
-		obj, err := parseImage(img)
+		obj, err := parseImage(img, auth)
 		if err != nil {
-			return nil, fmt.Errorf("resolve images (%q): %s", img, err)
+			return nil, nil, fmt.Errorf("resolve images (%q): %s", img, err)
 		}
 		if _, ok := m[img.Name]; ok {
-			return nil, fmt.Errorf("resolve images (%q): duplicate image name %q", img, img.Name)
+			return nil, nil, fmt.Errorf("resolve images (%q): duplicate image name %q", img, img.Name)
+		}
+		var ref string
+		if obj.digest != "" {
+			ref, err = resolveImageToDigest(obj)
+		} else {
+			ref, err = resolveImageToOfficial(obj)
 		}
-		officialTag, err := resolveImageToOfficial(obj, auth)
 		if err != nil {
-			return nil, fmt.Errorf("resolve images (%q): %s", img, err)
+			return nil, nil, fmt.Errorf("resolve images (%q): %s", img, err)
+		}
+		log.Printf("Resolved %q to %q", img, ref)
+		m[img.Name] = ref
+		if digest, err := imageDigest(obj, ref); err != nil {
+			log.Printf("Resolve digest of %q: %s", ref, err)
+		} else {
+			digests[ref] = digest
 		}
-		log.Printf("Resolved %q to %q", img, officialTag)
-		m[img.Name] = officialTag
 	}
-	return m, nil
+	return m, digests, nil
+}
+
+// imageDigest returns the digest of the image resolved to ref.
+func imageDigest(img *parsedImage, ref string) (string, error) {
+	if img.digest != "" {
+		return img.digest, nil
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), registry.DefaultTimeout)
+	defer cancel()
+	tag := strings.TrimPrefix(ref, img.repo.Name()+":")
+	return img.repo.ResolveTagToDigest(ctx, tag)
 }
 
 // genAppYaml generates real YAML file for an application.
@@ -182,6 +324,15 @@ type app struct {
 
 func (a app) String() string { return a.Name }
 
+// repos returns the container image repos of the app.
+func (a app) repos() []string {
+	var r []string
+	for _, img := range a.Images {
+		r = append(r, img.Repo)
+	}
+	return r
+}
+
 // image is an official container image of an application.
 type image struct {
 	Name string
@@ -196,21 +347,37 @@ type image struct {
 	// the official tag regex.
 	// Default tag is "latest-official".
 	Tag string
+	// Digest pins the image to the manifest having the digest, e.g.
+	// "sha256:0123...". The tags of the image are not looked up, so
+	// OfficialTagRegex is not needed.
+	// It is mutually exclusive with Tag.
+	Digest string
 }
 
 func (i *image) String() string {
+	if i.Digest != "" {
+		return fmt.Sprintf("%s(%s@%s)", i.Name, i.Repo, i.Digest)
+	}
 	return fmt.Sprintf("%s(%s:%s)", i.Name, i.Repo, i.Tag)
 }
 
 // parsedImage is a parsed image which has initialized objects.
+// Either digest or regex and tag are set.
 type parsedImage struct {
-	repo  imageRepo
-	regex *regexp.Regexp
-	tag   string
+	repo   registry.Repository
+	regex  *regexp.Regexp
+	tag    string
+	digest string
 }
 
 // parseImage parses an image and returns a parsedImage object.
-func parseImage(img image) (*parsedImage, error) {
+func parseImage(img image, auth authn.Authenticator) (*parsedImage, error) {
+	if img.Tag != "" && img.Digest != "" {
+		return nil, fmt.Errorf("parse image %q: tag %q and digest %q are mutually exclusive", img, img.Tag, img.Digest)
+	}
+	if img.Digest != "" {
+		return parsePinnedImage(img, auth)
+	}
 	if !strings.HasPrefix(img.OfficialTagRegex, "^") || !strings.HasSuffix(img.OfficialTagRegex, "$") {
 		return nil, fmt.Errorf("parse image %q: the regex %q must start with ^ and end with $", img, img.OfficialTagRegex)
 	}
@@ -225,34 +392,67 @@ func parseImage(img image) (*parsedImage, error) {
 		tag = latestOfficial
 	}
 
+	repo, err := registry.NewRepository(img.Repo, auth)
+	if err != nil {
+		return nil, fmt.Errorf("parse image %q: %s", img, err)
+	}
 	return &parsedImage{
-		repo:  &gcrRepo{img.Repo},
+		repo:  repo,
 		regex: re,
 		tag:   tag,
 	}, nil
 }
 
+// parsePinnedImage parses an image pinned by digest.
+func parsePinnedImage(img image, auth authn.Authenticator) (*parsedImage, error) {
+	if _, err := v1.NewHash(img.Digest); err != nil {
+		return nil, fmt.Errorf("parse image %q: %s", img, err)
+	}
+	repo, err := registry.NewRepository(img.Repo, auth)
+	if err != nil {
+		return nil, fmt.Errorf("parse image %q: %s", img, err)
+	}
+	return &parsedImage{
+		repo:   repo,
+		digest: img.Digest,
+	}, nil
+}
+
 // latestOfficial is the default image tag for an app.
 const latestOfficial = "latest-official"
 
-// resolveToOfficial resolves the image tag to a tag matching the official tag
-// regex.
-// There's no guarantee which one will be returned when there are multiple tags
-// matches the official tag regex.
-func resolveImageToOfficial(img *parsedImage, auth authn.Authenticator) (string, error) {
-	allTags, err := img.repo.allTagsOnImage(auth, img.tag)
+// resolveImageToOfficial resolves the image tag to a tag matching the official
+// tag regex.
+// When there are multiple tags matching the official tag regex, the first one
+// in lexicographical order is returned.
+func resolveImageToOfficial(img *parsedImage) (string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), registry.DefaultTimeout)
+	defer cancel()
+	allTags, err := registry.TagsOnImage(ctx, img.repo, img.tag)
 	if err != nil {
-		return "", fmt.Errorf("resolve to official: %s", err)
+		return "", fmt.Errorf("resolve to official: %w", err)
 	}
 	for _, t := range allTags {
 		if img.regex.Match([]byte(t)) {
-			r := fmt.Sprintf("%s:%s", img.repo, t)
+			r := fmt.Sprintf("%s:%s", img.repo.Name(), t)
 			return r, nil
 		}
 	}
 	return "", fmt.Errorf("resolve to official: no official tag on image")
 }
 
+// resolveImageToDigest resolves the pinned image to its digest reference,
+// e.g. "gcr.io/project/image@sha256:0123...", after checking the manifest
+// exists in the repo.
+func resolveImageToDigest(img *parsedImage) (string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), registry.DefaultTimeout)
+	defer cancel()
+	if err := img.repo.CheckManifest(ctx, img.digest); err != nil {
+		return "", fmt.Errorf("resolve to digest: %w", err)
+	}
+	return fmt.Sprintf("%s@%s", img.repo.Name(), img.digest), nil
+}
+
 // downloader is the interface for a client which can download the YAML template
 // from a server.
 type downloader interface {
@@ -315,49 +515,55 @@ func (n *netrcClient) setAuth(req *http.Request, hostname string) {
 	}
 }
 
-// imageRepo is the interface for a remote container image repo.
-type imageRepo interface {
-	// allTagsOnImage returns all tags of a image.
-	allTagsOnImage(auth authn.Authenticator, tag string) (tags []string, err error)
+// k8sApplier is the interface for a client which can get and apply K8s
+// objects of a cluster.
+type k8sApplier interface {
+	// get returns the live object of the document, or nil if it doesn't
+	// exist.
+	get(ctx context.Context, d *document) (*unstructured.Unstructured, error)
+	// apply applies the object. If dryRun is true, the object is only
+	// validated by the cluster with a server-side dry-run.
+	apply(ctx context.Context, d *document, dryRun bool) error
 }
 
-type gcrRepo struct {
-	name string
-}
-
-func (g gcrRepo) String() string { return g.name }
-
-// allTagsOnImage implements the allTagsOnImage method of imageRepo interface.
-func (g *gcrRepo) allTagsOnImage(auth authn.Authenticator, tag string) ([]string, error) {
-	repo, err := name.NewRepository(g.name)
-	if err != nil {
-		return nil, fmt.Errorf("all tags on image %q:%q: %s", g, tag, err)
-	}
+// k8sTimeout is the timeout of reporting, validating or applying the
+// documents of an app.
+const k8sTimeout = 10 * time.Second
 
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+// reportOnK8s reports the drift of the workloads of the documents from the
+// live workloads.
+func reportOnK8s(k k8sApplier, a app, docs []*document, digests map[string]string) (*appReport, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), k8sTimeout)
 	defer cancel()
+	return reconcileApp(ctx, k, a, docs, digests)
+}
 
-	tags, err := google.List(repo, google.WithAuth(auth), google.WithContext(ctx))
-	if err != nil {
-		return nil, fmt.Errorf("all tags on image %q:%q: %s", g, tag, err)
-	}
-	for _, m := range tags.Manifests {
-		for _, t := range m.Tags {
-			if t == tag {
-				return m.Tags, nil
-			}
+// validateOnK8s validates the documents of the generated YAML with a
+// server-side dry-run. It returns the errors of all invalid documents.
+func validateOnK8s(k k8sApplier, docs []*document) error {
+	ctx, cancel := context.WithTimeout(context.Background(), k8sTimeout)
+	defer cancel()
+	var errs []string
+	for _, d := range docs {
+		if err := k.apply(ctx, d, true); err != nil {
+			errs = append(errs, err.Error())
 		}
 	}
-	return nil, fmt.Errorf("all tags on image %q: no images had the tag %q", g, tag)
+	if len(errs) > 0 {
+		return fmt.Errorf("validate on k8s: %s", strings.Join(errs, "; "))
+	}
+	return nil
 }
 
-// applyToK8s applies the generated YAML to K8s.
-func applyToK8s(generatedYAML string) error {
+// applyToK8s applies the documents of the generated YAML to K8s.
+func applyToK8s(k k8sApplier, docs []*document) error {
 	// TODO(guocb): log to BigQuery.
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	ctx, cancel := context.WithTimeout(context.Background(), k8sTimeout)
 	defer cancel()
-	if err := k8sApply(ctx, generatedYAML); err != nil {
-		return fmt.Errorf("apply to k8s: %s", err)
+	for _, d := range docs {
+		if err := k.apply(ctx, d, false); err != nil {
+			return fmt.Errorf("apply to k8s: %s", err)
+		}
 	}
 	return nil
 }
@@ -5,32 +5,74 @@
 package main
 
 import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
 	"regexp"
+	"strings"
 	"testing"
 
-	"github.com/google/go-containerregistry/pkg/authn"
-	"github.com/jdxcode/netrc"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"infra/cros/cmd/k8s-management/internal/freeze"
+	"infra/cros/cmd/k8s-management/internal/registry"
 )
 
-type fakeRepo struct {
-	name string
-	tags []string
+type fakeSrcServer struct {
+	resp string
 }
 
-func (r *fakeRepo) allTagsOnImage(_ authn.Authenticator, tag string) ([]string, error) {
-	return r.tags, nil
+func (s *fakeSrcServer) download(string) (string, error) {
+	return s.resp, nil
 }
 
-func (r *fakeRepo) String() string { return r.name }
+// fakeK8s records the objects applied, and fails to apply the objects
+// whose names are in invalid. The live objects are keyed by the documents
+// they are got for.
+type fakeK8s struct {
+	invalid   map[string]bool
+	live      map[string]*unstructured.Unstructured
+	validated []string
+	applied   []string
+}
 
-type fakeSrcServer struct {
-	resp string
+func (k *fakeK8s) get(ctx context.Context, d *document) (*unstructured.Unstructured, error) {
+	return k.live[d.String()], nil
 }
 
-func (s *fakeSrcServer) download(string, *netrc.Netrc) (string, error) {
-	return s.resp, nil
+func (k *fakeK8s) apply(ctx context.Context, d *document, dryRun bool) error {
+	if k.invalid[d.obj.GetName()] {
+		return fmt.Errorf("apply %s: invalid", d)
+	}
+	if dryRun {
+		k.validated = append(k.validated, d.String())
+	} else {
+		k.applied = append(k.applied, d.String())
+	}
+	return nil
 }
 
+const appYAML = `# The app.
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: config
+  namespace: ns
+---
+# Nothing here.
+---
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: app
+spec:
+  template:
+    spec:
+      containers:
+      - image: {{ .image1 }}
+`
+
 func TestResolveImageToOfficial(t *testing.T) {
 	t.Parallel()
 	tests := []struct {
@@ -41,7 +83,7 @@ func TestResolveImageToOfficial(t *testing.T) {
 		{
 			name: "resolve latest-official",
 			image: &parsedImage{
-				repo:  &fakeRepo{"fake.io/image1", []string{"tag1", latestOfficial, "official-100", "tag2"}},
+				repo:  registry.NewFake("fake.io/image1", [][]string{{"tag1", latestOfficial, "official-100", "tag2"}}),
 				regex: regexp.MustCompile(`^official-\d+$`),
 				tag:   latestOfficial,
 			},
@@ -50,19 +92,41 @@ func TestResolveImageToOfficial(t *testing.T) {
 		{
 			name: "resolve canary",
 			image: &parsedImage{
-				repo:  &fakeRepo{"fake.io/image2", []string{"random-tag", "canary", "TAG-22"}},
+				repo:  registry.NewFake("fake.io/image2", [][]string{{"random-tag", "canary", "TAG-22"}}),
 				regex: regexp.MustCompile(`^TAG-\d+$`),
 				tag:   "canary",
 			},
 			want: "fake.io/image2:TAG-22",
 		},
+		{
+			name: "resolve on the image having the tag",
+			image: &parsedImage{
+				repo: registry.NewFake("fake.io/image3", [][]string{
+					{"official-3", latestOfficial},
+					{"official-2", "prod"},
+					{"official-1"},
+				}),
+				regex: regexp.MustCompile(`^official-\d+$`),
+				tag:   "prod",
+			},
+			want: "fake.io/image3:official-2",
+		},
+		{
+			name: "multiple official tags resolve to the first in order",
+			image: &parsedImage{
+				repo:  registry.NewFake("fake.io/image4", [][]string{{latestOfficial, "official-20", "official-10"}}),
+				regex: regexp.MustCompile(`^official-\d+$`),
+				tag:   latestOfficial,
+			},
+			want: "fake.io/image4:official-10",
+		},
 	}
 
 	for _, tc := range tests {
 		tc := tc
 		t.Run(tc.name, func(t *testing.T) {
 			t.Parallel()
-			got, err := resolveImageToOfficial(tc.image, nil)
+			got, err := resolveImageToOfficial(tc.image)
 			if err != nil {
 				t.Errorf("resolveImages(%v) failed: %s", tc.image, err)
 			}
@@ -76,13 +140,31 @@ func TestResolveImageToOfficial(t *testing.T) {
 func TestResolveImageToOfficialErrors(t *testing.T) {
 	t.Parallel()
 	image := &parsedImage{
-		repo:  &fakeRepo{"fake.io/image1", []string{"tag1", latestOfficial, "bad-official-100", "tag2"}},
+		repo:  registry.NewFake("fake.io/image1", [][]string{{"tag1", latestOfficial, "bad-official-100", "tag2"}}),
 		regex: regexp.MustCompile(`^official-\d+$`),
+		tag:   latestOfficial,
 	}
-	if _, err := resolveImageToOfficial(image, nil); err == nil {
+	if _, err := resolveImageToOfficial(image); err == nil {
 		t.Errorf("resolveImageToOfficial(%v) succeeded with no official tags, want error", image)
 	}
 }
+
+func TestResolveImageToOfficialTagNotFound(t *testing.T) {
+	t.Parallel()
+	image := &parsedImage{
+		repo:  registry.NewFake("fake.io/image1", [][]string{{"official-1", latestOfficial}}),
+		regex: regexp.MustCompile(`^official-\d+$`),
+		tag:   "prod",
+	}
+	_, err := resolveImageToOfficial(image)
+	if err == nil {
+		t.Fatalf("resolveImageToOfficial(%v) succeeded with no image tagged, want error", image)
+	}
+	if !errors.Is(err, registry.ErrTagNotFound) {
+		t.Errorf("resolveImageToOfficial(%v) = %v, want ErrTagNotFound", image, err)
+	}
+}
+
 func TestResolveImageErrors(t *testing.T) {
 	t.Parallel()
 	tests := []struct {
@@ -109,14 +191,157 @@ func TestResolveImageErrors(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "malformed digest",
+			images: []image{
+				{
+					Name:   "image1",
+					Repo:   "gcr.io/project/image1",
+					Digest: "sha256:1234",
+				},
+			},
+		},
 	}
 	for _, tc := range tests {
 		tc := tc
 		t.Run(tc.name, func(t *testing.T) {
-			if _, err := resolveImages(tc.images, nil); err == nil {
+			if _, _, err := resolveImages(tc.images, nil); err == nil {
 				t.Errorf("resolveImages(%v) succeeded, want error", tc.images)
 			}
 		})
 	}
 
 }
+
+func TestResolveImageMutuallyExclusive(t *testing.T) {
+	t.Parallel()
+	img := image{
+		Name:   "image1",
+		Repo:   "gcr.io/project/image1",
+		Tag:    "prod",
+		Digest: "sha256:" + strings.Repeat("0", 64),
+	}
+	_, _, err := resolveImages([]image{img}, nil)
+	if err == nil {
+		t.Fatalf("resolveImages(%v) succeeded with tag and digest, want error", img)
+	}
+	if !strings.Contains(err.Error(), "mutually exclusive") {
+		t.Errorf("resolveImages(%v) = %q, want a mutually exclusive error", img, err)
+	}
+}
+
+func TestResolveImageToDigest(t *testing.T) {
+	t.Parallel()
+	repo := registry.NewFake("fake.io/image1", [][]string{{latestOfficial, "official-2"}, {"official-1"}})
+	digest, err := repo.ResolveTagToDigest(context.Background(), "official-1")
+	if err != nil {
+		t.Fatalf("ResolveTagToDigest(%q) failed: %s", "official-1", err)
+	}
+
+	img := &parsedImage{repo: repo, digest: digest}
+	got, err := resolveImageToDigest(img)
+	if err != nil {
+		t.Fatalf("resolveImageToDigest(%v) failed: %s", img, err)
+	}
+	if want := "fake.io/image1@" + digest; got != want {
+		t.Errorf("resolveImageToDigest(%v) = %q, want %q", img, got, want)
+	}
+
+	img = &parsedImage{repo: repo, digest: "sha256:" + strings.Repeat("f", 64)}
+	if _, err := resolveImageToDigest(img); !errors.Is(err, registry.ErrManifestNotFound) {
+		t.Errorf("resolveImageToDigest(%v) = %v, want ErrManifestNotFound", img, err)
+	}
+}
+
+func TestParseDocuments(t *testing.T) {
+	t.Parallel()
+	docs, err := parseDocuments(appYAML)
+	if err != nil {
+		t.Fatalf("parseDocuments() failed: %s", err)
+	}
+	var got []string
+	for _, d := range docs {
+		got = append(got, d.String())
+	}
+	want := []string{`ConfigMap "config"`, `Deployment "app"`}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("parseDocuments() = %v, want %v", got, want)
+	}
+	if ns := docs[0].obj.GetNamespace(); ns != "ns" {
+		t.Errorf("parseDocuments() namespace = %q, want %q", ns, "ns")
+	}
+}
+
+func TestParseDocumentsErrors(t *testing.T) {
+	t.Parallel()
+	if _, err := parseDocuments("metadata:\n  name: no-kind\n"); err == nil {
+		t.Errorf("parseDocuments() succeeded with no kind, want error")
+	}
+}
+
+func TestRolloutAppValidation(t *testing.T) {
+	t.Parallel()
+	a := app{Name: "app", Source: "https://example.com/app.yaml"}
+	d := &fakeSrcServer{resp: appYAML}
+	fc := &freeze.Checker{Config: &freeze.Config{}}
+	all := []string{`ConfigMap "config"`, `Deployment "app"`}
+
+	t.Run("valid app is applied", func(t *testing.T) {
+		t.Parallel()
+		k := &fakeK8s{}
+		if _, err := rolloutApp(a, nil, d, k, fc, "cluster", applyMode); err != nil {
+			t.Fatalf("rolloutApp() failed: %s", err)
+		}
+		if !reflect.DeepEqual(k.validated, all) {
+			t.Errorf("rolloutApp() validated %v, want %v", k.validated, all)
+		}
+		if !reflect.DeepEqual(k.applied, all) {
+			t.Errorf("rolloutApp() applied %v, want %v", k.applied, all)
+		}
+	})
+	t.Run("invalid app is not applied", func(t *testing.T) {
+		t.Parallel()
+		k := &fakeK8s{invalid: map[string]bool{"config": true}}
+		_, err := rolloutApp(a, nil, d, k, fc, "cluster", applyMode)
+		if err == nil {
+			t.Fatalf("rolloutApp() succeeded with an invalid document, want error")
+		}
+		if !strings.Contains(err.Error(), `ConfigMap "config"`) {
+			t.Errorf("rolloutApp() = %q, want the invalid document identified", err)
+		}
+		// The other documents are still validated.
+		if want := []string{`Deployment "app"`}; !reflect.DeepEqual(k.validated, want) {
+			t.Errorf("rolloutApp() validated %v, want %v", k.validated, want)
+		}
+		if len(k.applied) > 0 {
+			t.Errorf("rolloutApp() applied %v, want nothing", k.applied)
+		}
+	})
+	t.Run("dry-run-only app is validated only", func(t *testing.T) {
+		t.Parallel()
+		k := &fakeK8s{}
+		if _, err := rolloutApp(a, nil, d, k, fc, "cluster", dryRunOnlyMode); err != nil {
+			t.Fatalf("rolloutApp() failed: %s", err)
+		}
+		if !reflect.DeepEqual(k.validated, all) {
+			t.Errorf("rolloutApp() validated %v, want %v", k.validated, all)
+		}
+		if len(k.applied) > 0 {
+			t.Errorf("rolloutApp() applied %v, want nothing", k.applied)
+		}
+	})
+	t.Run("report-only app is neither validated nor applied", func(t *testing.T) {
+		t.Parallel()
+		k := &fakeK8s{}
+		r, err := rolloutApp(a, nil, d, k, fc, "cluster", reportOnlyMode)
+		if err != nil {
+			t.Fatalf("rolloutApp() failed: %s", err)
+		}
+		if len(k.validated) > 0 || len(k.applied) > 0 {
+			t.Errorf("rolloutApp() validated %v and applied %v, want nothing", k.validated, k.applied)
+		}
+		if len(r.Workloads) != 1 || r.Workloads[0].Status != workloadMissing {
+			t.Errorf("rolloutApp() reported %v, want the deployment missing", r.Workloads)
+		}
+	})
+}
@@ -0,0 +1,245 @@
+// Copyright 2021 The Chromium OS Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"sort"
+	"time"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// workloadStatus is the reconciliation status of a workload.
+type workloadStatus string
+
+const (
+	// workloadInSync means the live workload runs the desired images.
+	workloadInSync workloadStatus = "in_sync"
+	// workloadDrifted means the live workload runs other images, or other
+	// containers, than the desired ones.
+	workloadDrifted workloadStatus = "drifted"
+	// workloadMissing means the workload doesn't exist in the cluster.
+	workloadMissing workloadStatus = "missing"
+)
+
+// workloadKinds are the kinds of the apps group whose images are
+// reconciled.
+var workloadKinds = map[string]bool{
+	"Deployment": true,
+	"DaemonSet":  true,
+}
+
+// report is the reconciliation report of all apps of a cluster.
+type report struct {
+	Cluster string       `json:"cluster"`
+	Time    time.Time    `json:"time"`
+	Apps    []*appReport `json:"apps"`
+}
+
+// appReport is the reconciliation report of an app, comparing the
+// workloads of its generated YAML with the live workloads.
+type appReport struct {
+	App       string            `json:"app"`
+	Workloads []*workloadReport `json:"workloads"`
+	// Error is set if the app could not be reconciled.
+	Error string `json:"error,omitempty"`
+}
+
+// workloadReport is the reconciliation report of a workload.
+type workloadReport struct {
+	Kind       string             `json:"kind"`
+	Namespace  string             `json:"namespace,omitempty"`
+	Name       string             `json:"name"`
+	Status     workloadStatus     `json:"status"`
+	Containers []*containerReport `json:"containers"`
+}
+
+// containerReport compares the desired and live images of a container.
+type containerReport struct {
+	Name string `json:"name"`
+	// DesiredImage is empty for live containers which are not desired.
+	DesiredImage string `json:"desired_image,omitempty"`
+	// DesiredDigest is the digest of the desired image, if known.
+	DesiredDigest string `json:"desired_digest,omitempty"`
+	// LiveImage is empty for desired containers which are not live.
+	LiveImage string `json:"live_image,omitempty"`
+	InSync    bool   `json:"in_sync"`
+}
+
+// reconcileApp compares the workloads of the documents with the live
+// workloads. digests maps the resolved image references of the app to
+// their digests.
+// Live workloads not managed by app-roller are excluded from the report.
+func reconcileApp(ctx context.Context, k k8sApplier, a app, docs []*document, digests map[string]string) (*appReport, error) {
+	r := &appReport{App: a.Name, Workloads: []*workloadReport{}}
+	for _, d := range docs {
+		if d.gvk.Group != "apps" || !workloadKinds[d.gvk.Kind] {
+			continue
+		}
+		live, err := k.get(ctx, d)
+		if err != nil {
+			return nil, fmt.Errorf("reconcile app %q: %s", a, err)
+		}
+		if live != nil && !isManaged(live) {
+			log.Printf("Reconcile %q: skip %s which is not managed by app-roller", a, d)
+			continue
+		}
+		r.Workloads = append(r.Workloads, reconcileWorkload(d, live, digests))
+	}
+	return r, nil
+}
+
+// reconcileWorkload compares the containers of the workload document with
+// the containers of the live workload, which is nil if it doesn't exist.
+func reconcileWorkload(d *document, live *unstructured.Unstructured, digests map[string]string) *workloadReport {
+	w := &workloadReport{
+		Kind:      d.gvk.Kind,
+		Namespace: d.obj.GetNamespace(),
+		Name:      d.obj.GetName(),
+		Status:    workloadInSync,
+	}
+	desired := podContainers(d.obj)
+	if live == nil {
+		w.Status = workloadMissing
+		for _, c := range desired {
+			w.Containers = append(w.Containers, &containerReport{
+				Name:          c.name,
+				DesiredImage:  c.image,
+				DesiredDigest: digests[c.image],
+			})
+		}
+		return w
+	}
+
+	liveImages := map[string]string{}
+	for _, c := range podContainers(live) {
+		liveImages[c.name] = c.image
+	}
+	for _, c := range desired {
+		liveImage, ok := liveImages[c.name]
+		delete(liveImages, c.name)
+		cr := &containerReport{
+			Name:          c.name,
+			DesiredImage:  c.image,
+			DesiredDigest: digests[c.image],
+			LiveImage:     liveImage,
+		}
+		cr.InSync = ok && imageMatches(cr.DesiredImage, cr.DesiredDigest, liveImage)
+		if !cr.InSync {
+			w.Status = workloadDrifted
+		}
+		w.Containers = append(w.Containers, cr)
+	}
+	// Live containers which are not desired.
+	var extra []string
+	for name := range liveImages {
+		extra = append(extra, name)
+	}
+	sort.Strings(extra)
+	for _, name := range extra {
+		w.Status = workloadDrifted
+		w.Containers = append(w.Containers, &containerReport{Name: name, LiveImage: liveImages[name]})
+	}
+	return w
+}
+
+// imageMatches tells whether the live image reference is the desired one.
+// The live image also matches if it pins the desired digest. Live images
+// referenced by another tag are not resolved, and don't match.
+func imageMatches(desired, desiredDigest, live string) bool {
+	if live == desired {
+		return true
+	}
+	return desiredDigest != "" && live == imageRepo(desired)+"@"+desiredDigest
+}
+
+// imageRepo returns the repo of an image reference "repo:tag" or
+// "repo@digest".
+func imageRepo(ref string) string {
+	for i := len(ref) - 1; i >= 0; i-- {
+		switch ref[i] {
+		case '@', ':':
+			return ref[:i]
+		case '/':
+			return ref
+		}
+	}
+	return ref
+}
+
+// container is a container of a pod template.
+type container struct {
+	name  string
+	image string
+}
+
+// podContainers returns the init containers and containers of the pod
+// template of a workload.
+func podContainers(obj *unstructured.Unstructured) []container {
+	var result []container
+	for _, field := range []string{"initContainers", "containers"} {
+		cs, _, _ := unstructured.NestedSlice(obj.Object, "spec", "template", "spec", field)
+		for _, c := range cs {
+			m, ok := c.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			name, _, _ := unstructured.NestedString(m, "name")
+			image, _, _ := unstructured.NestedString(m, "image")
+			result = append(result, container{name: name, image: image})
+		}
+	}
+	return result
+}
+
+// counts returns the number of workloads of the app in each status.
+func (r *appReport) counts() map[workloadStatus]int {
+	c := map[workloadStatus]int{}
+	for _, w := range r.Workloads {
+		c[w.Status]++
+	}
+	return c
+}
+
+// logSummary logs the number of workloads of each app in each status, and
+// the workloads which are not in sync.
+func (r *report) logSummary() {
+	for _, a := range r.Apps {
+		if a.Error != "" {
+			log.Printf("Report %q: failed: %s", a.App, a.Error)
+			continue
+		}
+		c := a.counts()
+		log.Printf("Report %q: %d in sync, %d drifted, %d missing", a.App, c[workloadInSync], c[workloadDrifted], c[workloadMissing])
+		for _, w := range a.Workloads {
+			if w.Status != workloadInSync {
+				log.Printf("Report %q: %s %s/%s is %s", a.App, w.Kind, w.Namespace, w.Name, w.Status)
+			}
+		}
+	}
+}
+
+// write writes the report as JSON to the file. "-" is the standard output.
+func (r *report) write(path string) error {
+	b, err := json.MarshalIndent(r, "", "  ")
+	if err != nil {
+		return fmt.Errorf("write report: %s", err)
+	}
+	b = append(b, '\n')
+	if path == "-" {
+		_, err = os.Stdout.Write(b)
+	} else {
+		err = os.WriteFile(path, b, 0644)
+	}
+	if err != nil {
+		return fmt.Errorf("write report: %s", err)
+	}
+	return nil
+}
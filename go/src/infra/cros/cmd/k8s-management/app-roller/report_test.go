@@ -0,0 +1,191 @@
+// Copyright 2021 The Chromium OS Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+var digest1 = "sha256:" + strings.Repeat("1", 64)
+
+// workloadYAML returns the YAML of a workload whose containers are given
+// as "name=image".
+func workloadYAML(kind, name string, labels map[string]string, containers ...string) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "apiVersion: apps/v1\nkind: %s\nmetadata:\n  name: %s\n  namespace: ns\n", kind, name)
+	if len(labels) > 0 {
+		b.WriteString("  labels:\n")
+		for k, v := range labels {
+			fmt.Fprintf(&b, "    %s: %s\n", k, v)
+		}
+	}
+	b.WriteString("spec:\n  template:\n    spec:\n      containers:\n")
+	for _, c := range containers {
+		parts := strings.SplitN(c, "=", 2)
+		fmt.Fprintf(&b, "      - name: %s\n        image: %s\n", parts[0], parts[1])
+	}
+	return b.String()
+}
+
+// mustParseObject parses the YAML of a single object.
+func mustParseObject(t *testing.T, content string) *unstructured.Unstructured {
+	t.Helper()
+	docs, err := parseDocuments(content)
+	if err != nil {
+		t.Fatalf("parseDocuments() failed: %s", err)
+	}
+	return docs[0].obj
+}
+
+func TestReconcileApp(t *testing.T) {
+	t.Parallel()
+	managed := map[string]string{managedByLabel: managedByValue}
+	desired := strings.Join([]string{
+		"apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: config\n",
+		workloadYAML("Deployment", "app", nil, "main=fake.io/image1:official-1"),
+		workloadYAML("DaemonSet", "agent", nil, "main=fake.io/image2:official-2"),
+	}, "---\n")
+	digests := map[string]string{"fake.io/image1:official-1": digest1}
+
+	tests := []struct {
+		name string
+		live []string
+		want map[string]workloadStatus
+	}{
+		{
+			name: "in sync",
+			live: []string{
+				workloadYAML("Deployment", "app", managed, "main=fake.io/image1:official-1"),
+				workloadYAML("DaemonSet", "agent", managed, "main=fake.io/image2:official-2"),
+			},
+			want: map[string]workloadStatus{"app": workloadInSync, "agent": workloadInSync},
+		},
+		{
+			name: "in sync by digest",
+			live: []string{
+				workloadYAML("Deployment", "app", managed, "main=fake.io/image1@"+digest1),
+				workloadYAML("DaemonSet", "agent", managed, "main=fake.io/image2:official-2"),
+			},
+			want: map[string]workloadStatus{"app": workloadInSync, "agent": workloadInSync},
+		},
+		{
+			name: "drifted tag",
+			live: []string{
+				workloadYAML("Deployment", "app", managed, "main=fake.io/image1:official-0"),
+				workloadYAML("DaemonSet", "agent", managed, "main=fake.io/image2:official-2"),
+			},
+			want: map[string]workloadStatus{"app": workloadDrifted, "agent": workloadInSync},
+		},
+		{
+			name: "drifted digest",
+			live: []string{
+				workloadYAML("Deployment", "app", managed, "main=fake.io/image1@sha256:"+strings.Repeat("0", 64)),
+				workloadYAML("DaemonSet", "agent", managed, "main=fake.io/image2:official-2"),
+			},
+			want: map[string]workloadStatus{"app": workloadDrifted, "agent": workloadInSync},
+		},
+		{
+			name: "drifted containers",
+			live: []string{
+				workloadYAML("Deployment", "app", managed, "main=fake.io/image1:official-1", "sidecar=fake.io/sidecar:1"),
+				workloadYAML("DaemonSet", "agent", managed, "other=fake.io/image2:official-2"),
+			},
+			want: map[string]workloadStatus{"app": workloadDrifted, "agent": workloadDrifted},
+		},
+		{
+			name: "missing",
+			live: []string{
+				workloadYAML("Deployment", "app", managed, "main=fake.io/image1:official-1"),
+			},
+			want: map[string]workloadStatus{"app": workloadInSync, "agent": workloadMissing},
+		},
+		{
+			name: "unmanaged excluded",
+			live: []string{
+				workloadYAML("Deployment", "app", nil, "main=fake.io/image1:official-0"),
+				workloadYAML("DaemonSet", "agent", map[string]string{managedByLabel: "helm"}, "main=fake.io/image2:official-0"),
+			},
+			want: map[string]workloadStatus{},
+		},
+	}
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			docs, err := parseDocuments(desired)
+			if err != nil {
+				t.Fatalf("parseDocuments() failed: %s", err)
+			}
+			k := &fakeK8s{live: map[string]*unstructured.Unstructured{}}
+			for _, l := range tc.live {
+				obj := mustParseObject(t, l)
+				for _, d := range docs {
+					if d.obj.GetName() == obj.GetName() {
+						k.live[d.String()] = obj
+					}
+				}
+			}
+
+			r, err := reconcileApp(context.Background(), k, app{Name: "app"}, docs, digests)
+			if err != nil {
+				t.Fatalf("reconcileApp() failed: %s", err)
+			}
+			got := map[string]workloadStatus{}
+			for _, w := range r.Workloads {
+				got[w.Name] = w.Status
+			}
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("reconcileApp() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestReconcileWorkloadContainers(t *testing.T) {
+	t.Parallel()
+	docs, err := parseDocuments(workloadYAML("Deployment", "app", nil, "main=fake.io/image1:official-1", "logger=fake.io/logger:1"))
+	if err != nil {
+		t.Fatalf("parseDocuments() failed: %s", err)
+	}
+	live := mustParseObject(t, workloadYAML("Deployment", "app", nil, "main=fake.io/image1@"+digest1, "extra=fake.io/extra:1"))
+
+	got := reconcileWorkload(docs[0], live, map[string]string{"fake.io/image1:official-1": digest1})
+	want := &workloadReport{
+		Kind:      "Deployment",
+		Namespace: "ns",
+		Name:      "app",
+		Status:    workloadDrifted,
+		Containers: []*containerReport{
+			{Name: "main", DesiredImage: "fake.io/image1:official-1", DesiredDigest: digest1, LiveImage: "fake.io/image1@" + digest1, InSync: true},
+			{Name: "logger", DesiredImage: "fake.io/logger:1"},
+			{Name: "extra", LiveImage: "fake.io/extra:1"},
+		},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("reconcileWorkload() = %+v, want %+v", got, want)
+	}
+}
+
+func TestImageRepo(t *testing.T) {
+	t.Parallel()
+	cases := map[string]string{
+		"gcr.io/project/image:tag":        "gcr.io/project/image",
+		"gcr.io/project/image@" + digest1: "gcr.io/project/image",
+		"localhost:5000/image":            "localhost:5000/image",
+		"localhost:5000/image:tag":        "localhost:5000/image",
+		"image":                           "image",
+	}
+	for ref, want := range cases {
+		if got := imageRepo(ref); got != want {
+			t.Errorf("imageRepo(%q) = %q, want %q", ref, got, want)
+		}
+	}
+}
@@ -0,0 +1,283 @@
+// Copyright 2021 The Chromium OS Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+// Package freeze implements deployment freeze windows shared by the
+// k8s-management tools.
+//
+// During an active freeze window, the tools skip mutating actions (e.g.
+// moving image tags, applying apps to K8s) on the affected items, and only
+// log what they would have done.
+//
+// Freeze windows are read from a YAML file, usually mounted from a
+// ConfigMap. The file looks like:
+//
+// - start: 2021-12-20T00:00:00-08:00
+//   end: 2022-01-03T09:00:00-08:00
+//   reason: Holiday production freeze
+// - start: 2021-12-01 18:00
+//   end: 2021-12-02 09:00
+//   timezone: America/Los_Angeles
+//   clusters: [prod]
+//   repos: [gcr.io/chromeos-drone-images/*]
+//   reason: Lab maintenance
+//
+// Times are either RFC 3339 timestamps, or in the format of "2006-01-02",
+// "2006-01-02 15:04" or "2006-01-02 15:04:05" in the timezone of the window
+// (UTC by default). The start is inclusive and the end is exclusive.
+//
+// A window without clusters affects all clusters, and a window without
+// repos affects all repos. Repos are matched as path.Match patterns.
+package freeze
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"path"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v2"
+)
+
+// Window is a period of time during which mutating actions on the
+// affected items are not allowed.
+type Window struct {
+	// Start is the start of the window, inclusive.
+	Start time.Time
+	// End is the end of the window, exclusive.
+	End time.Time
+	// Clusters are the names of the affected K8s clusters.
+	// Empty means all clusters.
+	Clusters []string
+	// Repos are path.Match patterns of the affected container image repos,
+	// e.g. "gcr.io/chromeos-drone-images/*". Empty means all repos.
+	Repos []string
+	// Reason explains why the window exists, e.g. "Holiday freeze".
+	Reason string
+}
+
+func (w *Window) String() string {
+	return fmt.Sprintf("%s - %s (%s)", w.Start.Format(time.RFC3339), w.End.Format(time.RFC3339), w.Reason)
+}
+
+// Item is an item a tool may mutate, e.g. an app to roll out or a repo to
+// update tags in.
+type Item struct {
+	// Cluster is the K8s cluster the item is in. Empty means the item is
+	// not specific to a cluster, and is affected by windows of any cluster.
+	Cluster string
+	// Repos are the container image repos of the item.
+	Repos []string
+}
+
+// activeAt returns whether the window is active at the time.
+func (w *Window) activeAt(t time.Time) bool {
+	return !t.Before(w.Start) && t.Before(w.End)
+}
+
+// matches returns whether the window affects the item.
+func (w *Window) matches(item Item) bool {
+	if len(w.Clusters) > 0 && item.Cluster != "" {
+		found := false
+		for _, c := range w.Clusters {
+			if c == item.Cluster {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	if len(w.Repos) == 0 {
+		return true
+	}
+	for _, r := range item.Repos {
+		for _, p := range w.Repos {
+			// Patterns were validated when parsed.
+			if ok, _ := path.Match(p, r); ok {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// Config is the list of freeze windows.
+type Config struct {
+	Windows []*Window
+}
+
+// ActiveAt returns the windows active at the time.
+func (c *Config) ActiveAt(t time.Time) []*Window {
+	var ws []*Window
+	for _, w := range c.Windows {
+		if w.activeAt(t) {
+			ws = append(ws, w)
+		}
+	}
+	return ws
+}
+
+// Find returns the first window active at the time which affects the
+// item, or nil if there is none.
+func (c *Config) Find(t time.Time, item Item) *Window {
+	for _, w := range c.Windows {
+		if w.activeAt(t) && w.matches(item) {
+			return w
+		}
+	}
+	return nil
+}
+
+// Load loads freeze windows from a YAML file.
+// If path is empty, there are no freeze windows.
+func Load(path string) (*Config, error) {
+	if path == "" {
+		return &Config{}, nil
+	}
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("load freeze windows from %q: %s", path, err)
+	}
+	c, err := Parse(content)
+	if err != nil {
+		return nil, fmt.Errorf("load freeze windows from %q: %s", path, err)
+	}
+	return c, nil
+}
+
+// rawWindow is a freeze window as written in the YAML file.
+type rawWindow struct {
+	Start    string
+	End      string
+	Timezone string
+	Clusters []string
+	Repos    []string
+	Reason   string
+}
+
+// Parse parses freeze windows from YAML content.
+func Parse(content []byte) (*Config, error) {
+	var raws []rawWindow
+	if err := yaml.UnmarshalStrict(content, &raws); err != nil {
+		return nil, fmt.Errorf("parse freeze windows: %s", err)
+	}
+	c := &Config{}
+	for i, r := range raws {
+		w, err := parseWindow(r)
+		if err != nil {
+			return nil, fmt.Errorf("parse freeze windows: window #%d: %s", i+1, err)
+		}
+		c.Windows = append(c.Windows, w)
+	}
+	return c, nil
+}
+
+// localTimeLayouts are the layouts of times without a timezone offset.
+var localTimeLayouts = []string{
+	"2006-01-02 15:04:05",
+	"2006-01-02 15:04",
+	"2006-01-02",
+}
+
+// parseWindow validates and parses a rawWindow.
+func parseWindow(r rawWindow) (*Window, error) {
+	loc := time.UTC
+	if r.Timezone != "" {
+		var err error
+		if loc, err = time.LoadLocation(r.Timezone); err != nil {
+			return nil, fmt.Errorf("timezone %q: %s", r.Timezone, err)
+		}
+	}
+	start, err := parseTime(r.Start, loc)
+	if err != nil {
+		return nil, fmt.Errorf("start: %s", err)
+	}
+	end, err := parseTime(r.End, loc)
+	if err != nil {
+		return nil, fmt.Errorf("end: %s", err)
+	}
+	if !end.After(start) {
+		return nil, fmt.Errorf("end %s is not after start %s", end.Format(time.RFC3339), start.Format(time.RFC3339))
+	}
+	if strings.TrimSpace(r.Reason) == "" {
+		return nil, fmt.Errorf("reason is required")
+	}
+	for _, p := range r.Repos {
+		if _, err := path.Match(p, ""); err != nil {
+			return nil, fmt.Errorf("repo pattern %q: %s", p, err)
+		}
+	}
+	return &Window{
+		Start:    start,
+		End:      end,
+		Clusters: r.Clusters,
+		Repos:    r.Repos,
+		Reason:   r.Reason,
+	}, nil
+}
+
+// parseTime parses a time, either an RFC 3339 timestamp or a time without
+// a timezone offset, which is in loc.
+func parseTime(s string, loc *time.Location) (time.Time, error) {
+	if s == "" {
+		return time.Time{}, fmt.Errorf("time is required")
+	}
+	if t, err := time.Parse(time.RFC3339, s); err == nil {
+		return t, nil
+	}
+	for _, l := range localTimeLayouts {
+		if t, err := time.ParseInLocation(l, s, loc); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("invalid time %q", s)
+}
+
+// Checker checks whether mutating actions on items are allowed.
+type Checker struct {
+	// Config is the freeze windows.
+	Config *Config
+	// Override allows mutating actions during freeze windows, for
+	// emergency changes.
+	Override bool
+	// Now returns the current time. Defaults to time.Now.
+	Now func() time.Time
+}
+
+func (c *Checker) now() time.Time {
+	if c.Now != nil {
+		return c.Now()
+	}
+	return time.Now()
+}
+
+// LogActive logs the freeze windows active now.
+// Tools call it at startup.
+func (c *Checker) LogActive() {
+	for _, w := range c.Config.ActiveAt(c.now()) {
+		if c.Override {
+			log.Printf("Freeze window %s is active, but overridden", w)
+		} else {
+			log.Printf("Freeze window %s is active", w)
+		}
+	}
+}
+
+// Frozen returns the active freeze window affecting the item, or nil if
+// mutating actions on the item are allowed.
+// It always returns nil if the freeze is overridden.
+func (c *Checker) Frozen(item Item) *Window {
+	w := c.Config.Find(c.now(), item)
+	if w == nil {
+		return nil
+	}
+	if c.Override {
+		log.Printf("Freeze window %s overridden for %v", w, item)
+		return nil
+	}
+	return w
+}
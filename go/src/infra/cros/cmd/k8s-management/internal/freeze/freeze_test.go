@@ -0,0 +1,199 @@
+// Copyright 2021 The Chromium OS Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package freeze
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestParse(t *testing.T) {
+	t.Parallel()
+	la, err := time.LoadLocation("America/Los_Angeles")
+	if err != nil {
+		t.Fatalf("Load location: %s", err)
+	}
+	content := `
+- start: 2021-12-20T00:00:00-08:00
+  end: 2022-01-03T09:00:00Z
+  reason: Holiday freeze
+- start: 2021-12-01 18:00
+  end: 2021-12-02
+  timezone: America/Los_Angeles
+  clusters: [prod]
+  repos: [gcr.io/chromeos-drone-images/*]
+  reason: Lab maintenance
+- start: 2021-12-05 01:02:03
+  end: 2021-12-05 04:05:06
+  reason: UTC by default
+`
+	c, err := Parse([]byte(content))
+	if err != nil {
+		t.Fatalf("Parse() failed: %s", err)
+	}
+	want := []*Window{
+		{
+			Start:  time.Date(2021, 12, 20, 8, 0, 0, 0, time.UTC),
+			End:    time.Date(2022, 1, 3, 9, 0, 0, 0, time.UTC),
+			Reason: "Holiday freeze",
+		},
+		{
+			Start:    time.Date(2021, 12, 1, 18, 0, 0, 0, la),
+			End:      time.Date(2021, 12, 2, 0, 0, 0, 0, la),
+			Clusters: []string{"prod"},
+			Repos:    []string{"gcr.io/chromeos-drone-images/*"},
+			Reason:   "Lab maintenance",
+		},
+		{
+			Start:  time.Date(2021, 12, 5, 1, 2, 3, 0, time.UTC),
+			End:    time.Date(2021, 12, 5, 4, 5, 6, 0, time.UTC),
+			Reason: "UTC by default",
+		},
+	}
+	// Compare times by instant, as parsed times have different locations.
+	opt := cmp.Comparer(func(a, b time.Time) bool { return a.Equal(b) })
+	if diff := cmp.Diff(want, c.Windows, opt); diff != "" {
+		t.Errorf("Parse() mismatch (-want, +got):\n%s", diff)
+	}
+	// 18:00 in Los Angeles is 02:00 UTC the next day in winter.
+	if got, want := c.Windows[1].Start.UTC(), time.Date(2021, 12, 2, 2, 0, 0, 0, time.UTC); !got.Equal(want) {
+		t.Errorf("Window #2 start = %s, want %s", got, want)
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		content string
+		wantErr string
+	}{
+		{
+			name:    "missing start",
+			content: "- end: 2021-12-02\n  reason: r",
+			wantErr: "window #1: start: time is required",
+		},
+		{
+			name:    "invalid time",
+			content: "- start: Dec 1\n  end: 2021-12-02\n  reason: r",
+			wantErr: `invalid time "Dec 1"`,
+		},
+		{
+			name:    "end before start",
+			content: "- start: 2021-12-02\n  end: 2021-12-01\n  reason: r",
+			wantErr: "is not after start",
+		},
+		{
+			name:    "empty window",
+			content: "- start: 2021-12-02\n  end: 2021-12-02\n  reason: r",
+			wantErr: "is not after start",
+		},
+		{
+			name:    "missing reason",
+			content: "- start: 2021-12-01\n  end: 2021-12-02",
+			wantErr: "reason is required",
+		},
+		{
+			name:    "invalid timezone",
+			content: "- start: 2021-12-01\n  end: 2021-12-02\n  timezone: Mars/Olympus\n  reason: r",
+			wantErr: `timezone "Mars/Olympus"`,
+		},
+		{
+			name:    "invalid repo pattern",
+			content: "- start: 2021-12-01\n  end: 2021-12-02\n  repos: ['gcr.io/[']\n  reason: r",
+			wantErr: `repo pattern "gcr.io/["`,
+		},
+		{
+			name:    "unknown field",
+			content: "- start: 2021-12-01\n  end: 2021-12-02\n  reason: r\n  cluster: prod",
+			wantErr: "cluster",
+		},
+	}
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			_, err := Parse([]byte(tc.content))
+			if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+				t.Errorf("Parse() = %v, want error containing %q", err, tc.wantErr)
+			}
+		})
+	}
+}
+
+func TestFind(t *testing.T) {
+	t.Parallel()
+	start := time.Date(2021, 12, 20, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2021, 12, 21, 0, 0, 0, 0, time.UTC)
+	labWide := &Window{Start: start, End: end, Reason: "lab-wide"}
+	prodDrone := &Window{
+		Start:    start,
+		End:      end,
+		Clusters: []string{"prod"},
+		Repos:    []string{"gcr.io/chromeos-drone-images/*"},
+		Reason:   "prod drone",
+	}
+	drone := Item{Cluster: "prod", Repos: []string{"gcr.io/chromeos-drone-images/drone"}}
+
+	tests := []struct {
+		name    string
+		windows []*Window
+		at      time.Time
+		item    Item
+		want    *Window
+	}{
+		{"lab-wide window", []*Window{labWide}, start, drone, labWide},
+		{"before window", []*Window{labWide}, start.Add(-time.Second), drone, nil},
+		{"end is exclusive", []*Window{labWide}, end, drone, nil},
+		{"matching cluster and repo", []*Window{prodDrone}, start, drone, prodDrone},
+		{"other cluster", []*Window{prodDrone}, start, Item{Cluster: "staging", Repos: drone.Repos}, nil},
+		{"other repo", []*Window{prodDrone}, start, Item{Cluster: "prod", Repos: []string{"gcr.io/cros-lab-servers/k8s-metrics"}}, nil},
+		{"any of the repos", []*Window{prodDrone}, start, Item{Cluster: "prod", Repos: []string{"gcr.io/cros-lab-servers/k8s-metrics", "gcr.io/chromeos-drone-images/drone"}}, prodDrone},
+		{"item of all clusters", []*Window{prodDrone}, start, Item{Repos: drone.Repos}, prodDrone},
+		{"first matching window", []*Window{prodDrone, labWide}, start, drone, prodDrone},
+	}
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			c := &Config{Windows: tc.windows}
+			if got := c.Find(tc.at, tc.item); got != tc.want {
+				t.Errorf("Find(%s, %v) = %v, want %v", tc.at, tc.item, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestCheckerOverride(t *testing.T) {
+	t.Parallel()
+	now := time.Date(2021, 12, 20, 12, 0, 0, 0, time.UTC)
+	w := &Window{Start: now.Add(-time.Hour), End: now.Add(time.Hour), Reason: "freeze"}
+	c := &Checker{
+		Config: &Config{Windows: []*Window{w}},
+		Now:    func() time.Time { return now },
+	}
+	item := Item{Repos: []string{"gcr.io/chromeos-drone-images/drone"}}
+	if got := c.Frozen(item); got != w {
+		t.Errorf("Frozen(%v) = %v, want %v", item, got, w)
+	}
+	c.Override = true
+	if got := c.Frozen(item); got != nil {
+		t.Errorf("Frozen(%v) with override = %v, want nil", item, got)
+	}
+}
+
+func TestLoadWithoutPath(t *testing.T) {
+	t.Parallel()
+	c, err := Load("")
+	if err != nil {
+		t.Fatalf("Load(\"\") failed: %s", err)
+	}
+	if len(c.Windows) != 0 {
+		t.Errorf("Load(\"\") = %v, want no windows", c.Windows)
+	}
+}
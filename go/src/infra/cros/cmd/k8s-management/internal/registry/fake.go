@@ -0,0 +1,152 @@
+// Copyright 2021 The Chromium OS Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package registry
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/go-containerregistry/pkg/v1/google"
+)
+
+// Fake is an in-memory implementation of Repository for tests.
+type Fake struct {
+	name      string
+	manifests []*fakeManifest
+}
+
+// fakeManifest is a manifest in a Fake repository.
+type fakeManifest struct {
+	digest  string
+	created time.Time
+	tags    []string
+}
+
+// NewFake creates a Fake repository with manifests having the tags of
+// tagsList. The tagsList must be in the order of newest -> oldest.
+func NewFake(name string, tagsList [][]string) *Fake {
+	baseTime := time.Date(2021, time.May, 1, 0, 0, 0, 0, time.UTC)
+	f := &Fake{name: name}
+	for i, ts := range tagsList {
+		f.manifests = append(f.manifests, &fakeManifest{
+			digest:  fmt.Sprintf("sha256:%064x", i),
+			created: baseTime.Add(time.Duration(-i) * time.Second),
+			tags:    append([]string{}, ts...),
+		})
+	}
+	return f
+}
+
+// Tags returns the tags of all manifests in the order of newest -> oldest.
+func (f *Fake) Tags() [][]string {
+	r := make([][]string, 0, len(f.manifests))
+	for _, m := range f.manifests {
+		r = append(r, append([]string{}, m.tags...))
+	}
+	return r
+}
+
+// Name implements the Name of Repository.
+func (f *Fake) Name() string { return f.name }
+
+// ListTags implements the ListTags of Repository.
+func (f *Fake) ListTags(ctx context.Context) (map[string]google.ManifestInfo, error) {
+	mm := make(map[string]google.ManifestInfo, len(f.manifests))
+	for _, m := range f.manifests {
+		mm[m.digest] = google.ManifestInfo{
+			Created:  m.created,
+			Uploaded: m.created,
+			Tags:     append([]string{}, m.tags...),
+		}
+	}
+	sortTags(mm)
+	return mm, nil
+}
+
+// ResolveTagToDigest implements the ResolveTagToDigest of Repository.
+func (f *Fake) ResolveTagToDigest(ctx context.Context, tag string) (string, error) {
+	m, ok := f.find(tag)
+	if !ok {
+		return "", fmt.Errorf("resolve %q:%q: %w", f.name, tag, ErrTagNotFound)
+	}
+	return m.digest, nil
+}
+
+// CheckManifest implements the CheckManifest of Repository.
+func (f *Fake) CheckManifest(ctx context.Context, digest string) error {
+	for _, m := range f.manifests {
+		if m.digest == digest {
+			return nil
+		}
+	}
+	return fmt.Errorf("check manifest %q@%q: %w", f.name, digest, ErrManifestNotFound)
+}
+
+// AddTag implements the AddTag of Repository.
+func (f *Fake) AddTag(ctx context.Context, newTag, existingTag string) error {
+	if _, ok := f.find(newTag); ok {
+		return fmt.Errorf("add tag %q to %q:%q: %w", newTag, f.name, existingTag, ErrTagExists)
+	}
+	if err := f.MoveTag(ctx, newTag, existingTag); err != nil {
+		return fmt.Errorf("add tag: %w", err)
+	}
+	return nil
+}
+
+// MoveTag implements the MoveTag of Repository.
+func (f *Fake) MoveTag(ctx context.Context, tag, existingTag string) error {
+	target, ok := f.find(existingTag)
+	if !ok {
+		return fmt.Errorf("move tag %q to %q:%q: %w", tag, f.name, existingTag, ErrTagNotFound)
+	}
+	if target.hasTag(tag) {
+		return nil
+	}
+	if m, ok := f.find(tag); ok {
+		m.removeTag(tag)
+	}
+	target.tags = append(target.tags, tag)
+	return nil
+}
+
+// RemoveTag implements the RemoveTag of Repository.
+func (f *Fake) RemoveTag(ctx context.Context, tag string) error {
+	m, ok := f.find(tag)
+	if !ok {
+		return fmt.Errorf("remove tag %q:%q: %w", f.name, tag, ErrTagNotFound)
+	}
+	m.removeTag(tag)
+	return nil
+}
+
+// find finds the manifest having the tag.
+func (f *Fake) find(tag string) (*fakeManifest, bool) {
+	for _, m := range f.manifests {
+		if m.hasTag(tag) {
+			return m, true
+		}
+	}
+	return nil, false
+}
+
+func (m *fakeManifest) hasTag(tag string) bool {
+	for _, t := range m.tags {
+		if t == tag {
+			return true
+		}
+	}
+	return false
+}
+
+func (m *fakeManifest) removeTag(tag string) {
+	tags := make([]string, 0, len(m.tags))
+	for _, t := range m.tags {
+		if t != tag {
+			tags = append(tags, t)
+		}
+	}
+	m.tags = tags
+}
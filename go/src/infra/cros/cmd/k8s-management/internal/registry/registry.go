@@ -0,0 +1,89 @@
+// Copyright 2021 The Chromium OS Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+// Package registry provides operations on remote container image repositories
+// shared by the k8s-management tools.
+//
+// Semantics shared by all implementations:
+//   - A tag which is on no manifest of the repository is reported as an error
+//     wrapping ErrTagNotFound. Callers use errors.Is to tell it apart from
+//     other failures.
+//   - A digest which is of no manifest of the repository is reported as an
+//     error wrapping ErrManifestNotFound.
+//   - A manifest may have multiple tags. The tags of each manifest are
+//     returned sorted, so picking the first tag matching a pattern always
+//     picks the same tag.
+package registry
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/google/go-containerregistry/pkg/v1/google"
+)
+
+// DefaultTimeout is the timeout of a single operation on a remote repository.
+const DefaultTimeout = 10 * time.Second
+
+var (
+	// ErrTagNotFound indicates no manifest in the repository has the tag.
+	ErrTagNotFound = errors.New("tag not found")
+	// ErrTagExists indicates the tag is already on a manifest in the
+	// repository.
+	ErrTagExists = errors.New("tag already exists")
+	// ErrManifestNotFound indicates no manifest in the repository has the
+	// digest.
+	ErrManifestNotFound = errors.New("manifest not found")
+)
+
+// Repository is the interface for a remote container image repository.
+type Repository interface {
+	// Name returns the name of the repository, e.g.
+	// "gcr.io/chromeos-drone-images/drone".
+	Name() string
+	// ListTags lists all manifests of the repository with their metadata.
+	// The returned map is keyed by the manifest digest.
+	ListTags(ctx context.Context) (map[string]google.ManifestInfo, error)
+	// ResolveTagToDigest returns the digest of the manifest having the tag.
+	ResolveTagToDigest(ctx context.Context, tag string) (string, error)
+	// CheckManifest checks the manifest having the digest, e.g.
+	// "sha256:0123...", exists in the repository. It fails with
+	// ErrManifestNotFound if it doesn't.
+	CheckManifest(ctx context.Context, digest string) error
+	// AddTag adds a new tag to the manifest having the existing tag.
+	// It fails with ErrTagExists if the new tag is on any manifest.
+	AddTag(ctx context.Context, newTag, existingTag string) error
+	// MoveTag moves the tag to the manifest having the existing tag.
+	// The tag is added if it isn't on any manifest.
+	MoveTag(ctx context.Context, tag, existingTag string) error
+	// RemoveTag removes the tag from the repository.
+	RemoveTag(ctx context.Context, tag string) error
+}
+
+// TagsOnImage returns all tags of the manifest having the tag.
+// The tags are sorted.
+func TagsOnImage(ctx context.Context, r Repository, tag string) ([]string, error) {
+	manifests, err := r.ListTags(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("tags on image %s:%s: %s", r.Name(), tag, err)
+	}
+	for _, m := range manifests {
+		for _, t := range m.Tags {
+			if t == tag {
+				return m.Tags, nil
+			}
+		}
+	}
+	return nil, fmt.Errorf("tags on image %s:%s: %w", r.Name(), tag, ErrTagNotFound)
+}
+
+// sortTags sorts tags of each manifest in place.
+func sortTags(manifests map[string]google.ManifestInfo) {
+	for _, m := range manifests {
+		sort.Strings(m.Tags)
+	}
+}
@@ -0,0 +1,175 @@
+// Copyright 2021 The Chromium OS Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package registry
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestTagsOnImage(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	r := NewFake("fake/repo", [][]string{{"tag1"}, {"prod", "official-2", "official-1"}, {"tag3"}})
+
+	got, err := TagsOnImage(ctx, r, "prod")
+	if err != nil {
+		t.Fatalf("TagsOnImage(%q) failed: %s", "prod", err)
+	}
+	// Tags of a manifest are sorted.
+	want := []string{"official-1", "official-2", "prod"}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("TagsOnImage(%q) mismatch (-want, +got):\n%s", "prod", diff)
+	}
+
+	if _, err := TagsOnImage(ctx, r, "canary"); !errors.Is(err, ErrTagNotFound) {
+		t.Errorf("TagsOnImage(%q) = %v, want ErrTagNotFound", "canary", err)
+	}
+}
+
+func TestFakeResolveTagToDigest(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	r := NewFake("fake/repo", [][]string{{"tag1", "tag2"}, {"tag3"}})
+
+	d1, err := r.ResolveTagToDigest(ctx, "tag1")
+	if err != nil {
+		t.Fatalf("ResolveTagToDigest(%q) failed: %s", "tag1", err)
+	}
+	d2, err := r.ResolveTagToDigest(ctx, "tag2")
+	if err != nil {
+		t.Fatalf("ResolveTagToDigest(%q) failed: %s", "tag2", err)
+	}
+	if d1 != d2 {
+		t.Errorf("tags on the same manifest resolved to different digests: %q, %q", d1, d2)
+	}
+	if _, err := r.ResolveTagToDigest(ctx, "tag4"); !errors.Is(err, ErrTagNotFound) {
+		t.Errorf("ResolveTagToDigest(%q) = %v, want ErrTagNotFound", "tag4", err)
+	}
+}
+
+func TestFakeCheckManifest(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	r := NewFake("fake/repo", [][]string{{"tag1"}, {"tag2"}})
+
+	d, err := r.ResolveTagToDigest(ctx, "tag2")
+	if err != nil {
+		t.Fatalf("ResolveTagToDigest(%q) failed: %s", "tag2", err)
+	}
+	if err := r.CheckManifest(ctx, d); err != nil {
+		t.Errorf("CheckManifest(%q) failed: %s", d, err)
+	}
+	missing := "sha256:" + strings.Repeat("f", 64)
+	if err := r.CheckManifest(ctx, missing); !errors.Is(err, ErrManifestNotFound) {
+		t.Errorf("CheckManifest(%q) = %v, want ErrManifestNotFound", missing, err)
+	}
+}
+
+func TestFakeTagOperations(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	tests := []struct {
+		name    string
+		op      func(*Fake) error
+		want    [][]string
+		wantErr error
+	}{
+		{
+			name: "add new tag",
+			op:   func(f *Fake) error { return f.AddTag(ctx, "prod", "tag2") },
+			want: [][]string{{"tag1", "canary"}, {"tag2", "prod"}},
+		},
+		{
+			name:    "add existing tag",
+			op:      func(f *Fake) error { return f.AddTag(ctx, "canary", "tag2") },
+			want:    [][]string{{"tag1", "canary"}, {"tag2"}},
+			wantErr: ErrTagExists,
+		},
+		{
+			name:    "add tag to non-existing image",
+			op:      func(f *Fake) error { return f.AddTag(ctx, "prod", "tag3") },
+			want:    [][]string{{"tag1", "canary"}, {"tag2"}},
+			wantErr: ErrTagNotFound,
+		},
+		{
+			name: "move existing tag",
+			op:   func(f *Fake) error { return f.MoveTag(ctx, "canary", "tag2") },
+			want: [][]string{{"tag1"}, {"tag2", "canary"}},
+		},
+		{
+			name: "move new tag",
+			op:   func(f *Fake) error { return f.MoveTag(ctx, "prod", "tag2") },
+			want: [][]string{{"tag1", "canary"}, {"tag2", "prod"}},
+		},
+		{
+			name: "move tag to the same image",
+			op:   func(f *Fake) error { return f.MoveTag(ctx, "canary", "tag1") },
+			want: [][]string{{"tag1", "canary"}, {"tag2"}},
+		},
+		{
+			name:    "move tag to non-existing image",
+			op:      func(f *Fake) error { return f.MoveTag(ctx, "canary", "tag3") },
+			want:    [][]string{{"tag1", "canary"}, {"tag2"}},
+			wantErr: ErrTagNotFound,
+		},
+		{
+			name: "remove tag",
+			op:   func(f *Fake) error { return f.RemoveTag(ctx, "canary") },
+			want: [][]string{{"tag1"}, {"tag2"}},
+		},
+		{
+			name:    "remove non-existing tag",
+			op:      func(f *Fake) error { return f.RemoveTag(ctx, "prod") },
+			want:    [][]string{{"tag1", "canary"}, {"tag2"}},
+			wantErr: ErrTagNotFound,
+		},
+	}
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			f := NewFake("fake/repo", [][]string{{"tag1", "canary"}, {"tag2"}})
+			err := tc.op(f)
+			if tc.wantErr == nil && err != nil {
+				t.Fatalf("%s failed: %s", tc.name, err)
+			}
+			if tc.wantErr != nil && !errors.Is(err, tc.wantErr) {
+				t.Fatalf("%s = %v, want %v", tc.name, err, tc.wantErr)
+			}
+			if diff := cmp.Diff(tc.want, f.Tags()); diff != "" {
+				t.Errorf("%s mismatch (-want, +got):\n%s", tc.name, diff)
+			}
+		})
+	}
+}
+
+func TestNewRepository(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		wantErr bool
+	}{
+		{"gcr.io/chromeos-drone-images/drone", false},
+		{"us.gcr.io/project/image", false},
+		{"us-docker.pkg.dev/project/repo/image", false},
+		{"docker.io/library/ubuntu", true},
+		{"not a repo", true},
+	}
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			_, err := NewRepository(tc.name, nil)
+			if gotErr := err != nil; gotErr != tc.wantErr {
+				t.Errorf("NewRepository(%q) error = %v, want error %v", tc.name, err, tc.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,160 @@
+// Copyright 2021 The Chromium OS Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package registry
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+
+	"github.com/google/go-containerregistry/pkg/authn"
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/v1/google"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/google/go-containerregistry/pkg/v1/remote/transport"
+)
+
+// NewRepository creates a Repository for the repository hosted on GCR (e.g.
+// "gcr.io/project/image") or Artifact Registry (e.g.
+// "us-docker.pkg.dev/project/repo/image").
+//
+// Both registries serve the GCR flavored tag listing API which includes
+// manifest metadata, so they share the implementation.
+func NewRepository(repoName string, auth authn.Authenticator) (Repository, error) {
+	repo, err := name.NewRepository(repoName)
+	if err != nil {
+		return nil, fmt.Errorf("new repository %q: %s", repoName, err)
+	}
+	host := repo.RegistryStr()
+	if !isGCR(host) && !isArtifactRegistry(host) {
+		return nil, fmt.Errorf("new repository %q: unsupported registry %q", repoName, host)
+	}
+	return &remoteRepo{name: repoName, repo: repo, auth: auth}, nil
+}
+
+// isGCR tells whether the registry host is GCR.
+func isGCR(host string) bool {
+	return host == "gcr.io" || strings.HasSuffix(host, ".gcr.io")
+}
+
+// isArtifactRegistry tells whether the registry host is Artifact Registry.
+func isArtifactRegistry(host string) bool {
+	return strings.HasSuffix(host, "-docker.pkg.dev")
+}
+
+// remoteRepo is an implementation of Repository for a repository on a remote
+// registry.
+type remoteRepo struct {
+	name string
+	repo name.Repository
+	auth authn.Authenticator
+}
+
+// Name implements the Name of Repository.
+func (r *remoteRepo) Name() string { return r.name }
+
+// ListTags implements the ListTags of Repository.
+func (r *remoteRepo) ListTags(ctx context.Context) (map[string]google.ManifestInfo, error) {
+	t, err := google.List(r.repo, google.WithContext(ctx), google.WithAuth(r.auth))
+	if err != nil {
+		return nil, fmt.Errorf("list tags %q: %s", r.name, err)
+	}
+	sortTags(t.Manifests)
+	return t.Manifests, nil
+}
+
+// ResolveTagToDigest implements the ResolveTagToDigest of Repository.
+func (r *remoteRepo) ResolveTagToDigest(ctx context.Context, tag string) (string, error) {
+	desc, err := r.get(ctx, tag)
+	if err != nil {
+		return "", fmt.Errorf("resolve %q:%q: %w", r.name, tag, err)
+	}
+	return desc.Digest.String(), nil
+}
+
+// CheckManifest implements the CheckManifest of Repository.
+func (r *remoteRepo) CheckManifest(ctx context.Context, digest string) error {
+	_, err := remote.Head(r.repo.Digest(digest), remote.WithContext(ctx), remote.WithAuth(r.auth))
+	if err != nil {
+		if isNotFound(err) {
+			err = ErrManifestNotFound
+		}
+		return fmt.Errorf("check manifest %q@%q: %w", r.name, digest, err)
+	}
+	return nil
+}
+
+// AddTag implements the AddTag of Repository.
+func (r *remoteRepo) AddTag(ctx context.Context, newTag, existingTag string) error {
+	switch _, err := r.get(ctx, newTag); {
+	case err == nil:
+		return fmt.Errorf("add tag %q to %q:%q: %w", newTag, r.name, existingTag, ErrTagExists)
+	case !errors.Is(err, ErrTagNotFound):
+		return fmt.Errorf("add tag %q to %q:%q: %s", newTag, r.name, existingTag, err)
+	}
+	if err := r.MoveTag(ctx, newTag, existingTag); err != nil {
+		return fmt.Errorf("add tag: %w", err)
+	}
+	return nil
+}
+
+// MoveTag implements the MoveTag of Repository.
+func (r *remoteRepo) MoveTag(ctx context.Context, tag, existingTag string) error {
+	desc, err := r.get(ctx, existingTag)
+	if err != nil {
+		return fmt.Errorf("move tag %q to %q:%q: %w", tag, r.name, existingTag, err)
+	}
+	dst := r.repo.Tag(tag)
+	if err := remote.Tag(dst, desc, remote.WithContext(ctx), remote.WithAuth(r.auth)); err != nil {
+		return fmt.Errorf("move tag %q to %q:%q: %s", tag, r.name, existingTag, err)
+	}
+	log.Printf("%q: Remote tagged %q->%q", r.name, existingTag, tag)
+	return nil
+}
+
+// RemoveTag implements the RemoveTag of Repository.
+func (r *remoteRepo) RemoveTag(ctx context.Context, tag string) error {
+	if err := remote.Delete(r.repo.Tag(tag), remote.WithContext(ctx), remote.WithAuth(r.auth)); err != nil {
+		if isNotFound(err) {
+			err = ErrTagNotFound
+		}
+		return fmt.Errorf("remove tag %q:%q: %w", r.name, tag, err)
+	}
+	log.Printf("%q: Remote untagged %q", r.name, tag)
+	return nil
+}
+
+// get gets the descriptor of the manifest having the tag.
+func (r *remoteRepo) get(ctx context.Context, tag string) (*remote.Descriptor, error) {
+	desc, err := remote.Get(r.repo.Tag(tag), remote.WithContext(ctx), remote.WithAuth(r.auth))
+	if err != nil {
+		if isNotFound(err) {
+			return nil, ErrTagNotFound
+		}
+		return nil, err
+	}
+	return desc, nil
+}
+
+// isNotFound tells whether the error returned by the registry means the
+// requested manifest doesn't exist.
+func isNotFound(err error) bool {
+	var terr *transport.Error
+	if !errors.As(err, &terr) {
+		return false
+	}
+	if terr.StatusCode == http.StatusNotFound {
+		return true
+	}
+	for _, d := range terr.Errors {
+		if d.Code == transport.ManifestUnknownErrorCode {
+			return true
+		}
+	}
+	return false
+}
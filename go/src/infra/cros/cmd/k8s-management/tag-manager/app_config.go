@@ -10,8 +10,9 @@ import (
 	"log"
 	"regexp"
 	"strings"
-	"time"
 
+	"infra/cros/cmd/k8s-management/internal/freeze"
+	"infra/cros/cmd/k8s-management/internal/registry"
 	"infra/cros/cmd/k8s-management/tag-manager/internal/image"
 )
 
@@ -46,15 +47,17 @@ func newAppConfig(officialTagPattern string, policies ...tagPolicy) *appConfig {
 }
 
 // apply applies tag policies to the repo.
-func (a *appConfig) apply(repo ImageRepo) error {
+// If frozen is not nil, the remote repo is not updated, and the tag changes
+// are only logged.
+func (a *appConfig) apply(repo registry.Repository, frozen *freeze.Window) error {
 	log.Printf("%q: Applying tag policies", repo.Name())
-	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	ctx, cancel := context.WithTimeout(context.Background(), registry.DefaultTimeout)
 	defer cancel()
-	t, err := repo.List(ctx)
+	manifests, err := repo.ListTags(ctx)
 	if err != nil {
 		return fmt.Errorf("apply %q: %s", repo.Name(), err)
 	}
-	img := image.NewList(repo.Name(), t.Manifests)
+	img := image.NewList(repo.Name(), manifests)
 	oImg := &image.OfficialList{
 		OfficialTagRegex: a.officialTagRegex,
 		RawImages:        img,
@@ -78,6 +81,10 @@ func (a *appConfig) apply(repo ImageRepo) error {
 
 		// Update remote if applies.
 		if newDigest := img.TagToDigest[t]; newDigest != oldDigest {
+			if frozen != nil {
+				logFrozenUpdate(repo, t, oImg, frozen)
+				continue
+			}
 			if err := updateRemoteRepo(repo, t, oImg); err != nil {
 				return fmt.Errorf("apply policy %q to %q: %s", p, repo.Name(), err)
 			}
@@ -88,17 +95,27 @@ func (a *appConfig) apply(repo ImageRepo) error {
 	return nil
 }
 
+// logFrozenUpdate logs the update of the tag skipped due to the freeze
+// window.
+func logFrozenUpdate(repo registry.Repository, tag string, oImg *image.OfficialList, w *freeze.Window) {
+	if officialTag, ok := oImg.GetOfficialTag(tag); ok {
+		log.Printf("%q: Freeze window %s is active, skip moving %q to %q", repo.Name(), w, tag, officialTag)
+	} else {
+		log.Printf("%q: Freeze window %s is active, skip removing %q", repo.Name(), w, tag)
+	}
+}
+
 // updateRemoteRepo updates the tag on the remote side.
-func updateRemoteRepo(repo ImageRepo, tag string, oImg *image.OfficialList) error {
-	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+func updateRemoteRepo(repo registry.Repository, tag string, oImg *image.OfficialList) error {
+	ctx, cancel := context.WithTimeout(context.Background(), registry.DefaultTimeout)
 	defer cancel()
 
-	if newTag, ok := oImg.GetOfficialTag(tag); ok {
-		if err := repo.Tag(ctx, tag, newTag); err != nil {
+	if officialTag, ok := oImg.GetOfficialTag(tag); ok {
+		if err := repo.MoveTag(ctx, tag, officialTag); err != nil {
 			return fmt.Errorf("update remote repo %q:%q: %s", repo.Name(), tag, err)
 		}
 	} else {
-		if err := repo.Untag(ctx, tag); err != nil {
+		if err := repo.RemoveTag(ctx, tag); err != nil {
 			return fmt.Errorf("update remote repo %q:%q: %s", repo.Name(), tag, err)
 		}
 	}
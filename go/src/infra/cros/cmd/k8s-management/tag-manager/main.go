@@ -4,6 +4,21 @@
 
 // Command tag-manager scans registered container repo and updates image tags
 // based on defined policies.
+//
+// During a freeze window in the file given by -freeze-config, tags are not
+// updated, and the changes are logged instead. See package
+// infra/cros/cmd/k8s-management/internal/freeze for the file format.
+//
+// The rollback subcommand moves a tag controlled by a max distance policy,
+// e.g. prod, back to an official image during incidents:
+//
+//	tag-manager -service-account-json <path> rollback \
+//	  -repo gcr.io/chromeos-drone-images/drone -tag prod -to-version <tag>
+//
+// The tag can't be rolled back more versions than the maxVersionOlder of
+// its policy, nor to an image the policy would move it away from on the
+// next run, unless -force is given. Rollbacks are emergency changes, so
+// they ignore freeze windows.
 package main
 
 import (
@@ -15,10 +30,15 @@ import (
 	"sync"
 
 	"github.com/google/go-containerregistry/pkg/v1/google"
+
+	"infra/cros/cmd/k8s-management/internal/freeze"
+	"infra/cros/cmd/k8s-management/internal/registry"
 )
 
 var (
 	serviceAccountJSON = flag.String("service-account-json", "", "Path to JSON file with service account credentials to use")
+	freezeConfig       = flag.String("freeze-config", "", "Path to a yaml file of deployment freeze windows")
+	ignoreFreeze       = flag.Bool("ignore-freeze", false, "Update tags even during freeze windows, for emergency changes")
 )
 
 func main() {
@@ -36,6 +56,19 @@ const (
 	prod           = "prod"
 )
 
+// registeredApps are the app configs of the registered repos.
+// Please ensure the official tag regex matches the whole tag, i.e. starting
+// with '^' and ending with '$'.
+// The order of tag policies matters! Because tag policies may depend on
+// each other, e.g. policy of "prod" may depend on policy of "canary".
+// Please add the dependent policy first.
+var registeredApps = map[string]*appConfig{
+	"gcr.io/chromeos-drone-images/drone": newAppConfig(
+		`^\d{8}T\d{6}-chromeos-test$`, latestOfficialPolicy, canaryMaxDistancePolicy, prodMaxDistancePolicy,
+	),
+	"gcr.io/cros-lab-servers/k8s-metrics": newAppConfig(`^\d{8}T\d{6}$`, latestOfficialPolicy),
+}
+
 var (
 	latestOfficialPolicy    = &latestPolicy{tag: latestOfficial}
 	canaryMaxDistancePolicy = &maxDistancePolicy{
@@ -60,38 +93,39 @@ func innerMain() error {
 	}
 	auth := google.NewJSONKeyAuthenticator(string(content))
 
-	// Please ensure the official tag regex matches the whole tag, i.e. starting
-	// with '^' and ending with '$'.
-	// The order of tag policies matters! Because tag policies may depend on
-	// each other, e.g. policy of "prod" may depend on policy of "canary".
-	// Please add the dependent policy first.
-	data := []struct {
-		repo *gcrRepo
-		app  *appConfig
-	}{
-		{
-			&gcrRepo{"gcr.io/chromeos-drone-images/drone", auth},
-			newAppConfig(
-				`^\d{8}T\d{6}-chromeos-test$`, latestOfficialPolicy, canaryMaxDistancePolicy, prodMaxDistancePolicy,
-			),
-		},
-		{
-			&gcrRepo{"gcr.io/cros-lab-servers/k8s-metrics", auth},
-			newAppConfig(`^\d{8}T\d{6}$`, latestOfficialPolicy),
-		},
+	if flag.NArg() > 0 {
+		switch cmd := flag.Arg(0); cmd {
+		case "rollback":
+			return rollbackMain(flag.Args()[1:], auth)
+		default:
+			return fmt.Errorf("unknown subcommand %q", cmd)
+		}
 	}
-	ch := make(chan string, len(data))
+
+	windows, err := freeze.Load(*freezeConfig)
+	if err != nil {
+		return err
+	}
+	fc := &freeze.Checker{Config: windows, Override: *ignoreFreeze}
+	fc.LogActive()
+
+	ch := make(chan string, len(registeredApps))
 	var wg sync.WaitGroup
-	for _, d := range data {
+	for repo, app := range registeredApps {
+		r, err := registry.NewRepository(repo, auth)
+		if err != nil {
+			return err
+		}
+		frozen := fc.Frozen(freeze.Item{Repos: []string{repo}})
 		wg.Add(1)
-		go func(a *appConfig, r *gcrRepo) {
+		go func(a *appConfig, r registry.Repository) {
 			defer wg.Done()
 
-			if err := a.apply(r); err != nil {
+			if err := a.apply(r, frozen); err != nil {
 				log.Printf("%q: Apply config failed: %s", r.Name(), err)
 				ch <- fmt.Sprintf("%q", r.Name())
 			}
-		}(d.app, d.repo)
+		}(app, r)
 	}
 	wg.Wait()
 	close(ch)
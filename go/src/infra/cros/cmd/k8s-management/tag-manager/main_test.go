@@ -5,13 +5,13 @@
 package main
 
 import (
-	"context"
 	"testing"
 	"time"
 
 	"github.com/google/go-cmp/cmp"
-	"github.com/google/go-containerregistry/pkg/v1/google"
-	"github.com/google/uuid"
+
+	"infra/cros/cmd/k8s-management/internal/freeze"
+	"infra/cros/cmd/k8s-management/internal/registry"
 )
 
 func TestAppConfigLatestTagOnly(t *testing.T) {
@@ -56,14 +56,14 @@ func TestAppConfigLatestTagOnly(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			t.Parallel()
 
-			r := &fakeRepo{tagsList: tc.tagsList}
+			r := registry.NewFake("fake/repo", tc.tagsList)
 
 			c := newAppConfig(`^official-\d{1,2}$`, latestOfficialPolicy)
-			err := c.apply(r)
+			err := c.apply(r, nil)
 			if err != nil {
 				t.Fatalf("apply() failed: %s", err)
 			}
-			if diff := cmp.Diff(tc.want, r.tagsList); diff != "" {
+			if diff := cmp.Diff(tc.want, r.Tags()); diff != "" {
 				t.Errorf("AppConfig(%q)(latest tag only) mismatch: (-want, +got):\n%s", r.Name(), diff)
 			}
 		})
@@ -154,75 +154,35 @@ func TestAppConfigCanaryAndProd(t *testing.T) {
 		tc := tc
 		t.Run(tc.name, func(t *testing.T) {
 
-			r := &fakeRepo{tagsList: tc.tagsList}
+			r := registry.NewFake("fake/repo", tc.tagsList)
 			c := newAppConfig(`^official-\d{1,2}$`, latestOfficialPolicy, canaryMaxDistancePolicy, prodMaxDistancePolicy)
-			err := c.apply(r)
+			err := c.apply(r, nil)
 			if err != nil {
 				t.Fatalf("apply() failed: %s", err)
 			}
-			if diff := cmp.Diff(tc.want, r.tagsList); diff != "" {
+			if diff := cmp.Diff(tc.want, r.Tags()); diff != "" {
 				t.Errorf("AppConfig(%q)(canary and prod) mismatch: (-want, +got):\n%s", r.Name(), diff)
 			}
 		})
 	}
 }
 
-type fakeRepo struct {
-	tagsList [][]string
-}
-
-func (f *fakeRepo) List(ctx context.Context) (*google.Tags, error) {
-	return &google.Tags{Manifests: f.genManifests()}, nil
-}
-
-func (f *fakeRepo) genManifests() map[string]google.ManifestInfo {
-	baseTime := time.Date(2021, time.May, 1, 0, 0, 0, 0, time.UTC)
-	mm := map[string]google.ManifestInfo{}
-	for i, ts := range f.tagsList {
-		mm[uuid.New().String()] = google.ManifestInfo{
-			Created: baseTime.Add(time.Duration(-i) * time.Second),
-			Tags:    ts,
-		}
+func TestAppConfigFrozen(t *testing.T) {
+	t.Parallel()
+	tagsList := [][]string{
+		{"tag1"}, {"official-3"}, {"tag2"}, {"official-2", prod, canary}, {"official-1", latestOfficial},
 	}
-	return mm
-}
-
-func (f *fakeRepo) Tag(ctx context.Context, newTag, existingTag string) error {
-	r := [][]string{}
-	for _, ts := range f.tagsList {
-		rr := []string{}
-		// Remove newTag first (if there is), then add it to the slice including 'tag'.
-		addHere := false
-		for _, t := range ts {
-			if t != newTag {
-				rr = append(rr, t)
-			}
-			if t == existingTag {
-				addHere = true
-			}
-		}
-		if addHere {
-			rr = append(rr, newTag)
-		}
-		r = append(r, rr)
+	r := registry.NewFake("fake/repo", tagsList)
+	c := newAppConfig(`^official-\d{1,2}$`, latestOfficialPolicy, canaryMaxDistancePolicy, prodMaxDistancePolicy)
+	w := &freeze.Window{
+		Start:  time.Date(2021, 12, 20, 0, 0, 0, 0, time.UTC),
+		End:    time.Date(2022, 1, 3, 0, 0, 0, 0, time.UTC),
+		Reason: "Holiday freeze",
 	}
-	f.tagsList = r
-	return nil
-}
-
-func (f *fakeRepo) Untag(ctx context.Context, tag string) error {
-	r := [][]string{}
-	for _, ts := range f.tagsList {
-		rr := []string{}
-		for _, t := range ts {
-			if t != tag {
-				rr = append(rr, t)
-			}
-		}
-		r = append(r, rr)
+	if err := c.apply(r, w); err != nil {
+		t.Fatalf("apply() failed: %s", err)
+	}
+	if diff := cmp.Diff(tagsList, r.Tags()); diff != "" {
+		t.Errorf("AppConfig(%q)(frozen) mismatch: (-want, +got):\n%s", r.Name(), diff)
 	}
-	f.tagsList = r
-	return nil
 }
-
-func (f *fakeRepo) Name() string { return "fake/repo" }
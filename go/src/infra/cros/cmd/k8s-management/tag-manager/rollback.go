@@ -0,0 +1,123 @@
+// Copyright 2021 The Chromium OS Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+
+	"github.com/google/go-containerregistry/pkg/authn"
+
+	"infra/cros/cmd/k8s-management/internal/registry"
+	"infra/cros/cmd/k8s-management/tag-manager/internal/image"
+)
+
+// rollbackMain runs the rollback subcommand with its arguments.
+func rollbackMain(args []string, auth authn.Authenticator) error {
+	fs := flag.NewFlagSet("rollback", flag.ContinueOnError)
+	var (
+		repo      = fs.String("repo", "", "Name of the registered repo, e.g. gcr.io/chromeos-drone-images/drone")
+		tag       = fs.String("tag", "", "Tag to roll back, e.g. prod")
+		toVersion = fs.String("to-version", "", "Official tag of the image to roll the tag back to")
+		force     = fs.Bool("force", false, "Roll back even further than the tag policy allows")
+	)
+	if err := fs.Parse(args); err != nil {
+		return fmt.Errorf("rollback: %s", err)
+	}
+	a, ok := registeredApps[*repo]
+	if !ok {
+		return fmt.Errorf("rollback: repo %q is not registered", *repo)
+	}
+	r, err := registry.NewRepository(*repo, auth)
+	if err != nil {
+		return fmt.Errorf("rollback: %s", err)
+	}
+	return a.rollback(r, *tag, *toVersion, *force)
+}
+
+// rollback moves the tag of the repo back to the official image tagged
+// toVersion.
+// The tag must be controlled by a max distance policy of the app. Unless
+// force is true, the tag is rolled back at most maxVersionOlder images, and
+// only if the policy would not move it again.
+func (a *appConfig) rollback(repo registry.Repository, tag, toVersion string, force bool) error {
+	p, ok := a.maxDistancePolicy(tag)
+	if !ok {
+		return fmt.Errorf("rollback %q:%q: the tag is not controlled by a max distance policy", repo.Name(), tag)
+	}
+	if !a.officialTagRegex.MatchString(toVersion) {
+		return fmt.Errorf("rollback %q:%q: %q doesn't match the official tag regex %q", repo.Name(), tag, toVersion, a.officialTagRegex)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), registry.DefaultTimeout)
+	defer cancel()
+	manifests, err := repo.ListTags(ctx)
+	if err != nil {
+		return fmt.Errorf("rollback %q:%q: %s", repo.Name(), tag, err)
+	}
+	img := image.NewList(repo.Name(), manifests)
+	oImg := &image.OfficialList{
+		OfficialTagRegex: a.officialTagRegex,
+		RawImages:        img,
+	}
+	if _, ok := img.Manifest(toVersion); !ok {
+		return fmt.Errorf("rollback %q:%q: no image tagged %q", repo.Name(), tag, toVersion)
+	}
+	previous, ok := oImg.GetOfficialTag(tag)
+	if !ok {
+		return fmt.Errorf("rollback %q:%q: the tag is not on an official image", repo.Name(), tag)
+	}
+
+	// The negative distance means the target is older than the tag.
+	d, err := oImg.Distance(toVersion, tag)
+	if err != nil {
+		return fmt.Errorf("rollback %q:%q: %s", repo.Name(), tag, err)
+	}
+	switch {
+	case d == 0:
+		log.Printf("%q: Skip rolling back %q (already on %q)", repo.Name(), tag, previous)
+		return nil
+	case d > 0:
+		return fmt.Errorf("rollback %q:%q: %q is newer than the current %q", repo.Name(), tag, toVersion, previous)
+	case -d > int(p.maxVersionOlder):
+		if !force {
+			return fmt.Errorf("rollback %q:%q: %q is %d versions older than the current %q, more than the %d allowed by %s; use -force to roll back anyway", repo.Name(), tag, toVersion, -d, previous, p.maxVersionOlder, p)
+		}
+		log.Printf("%q: Forced to roll back %q %d versions, more than the %d allowed by %s", repo.Name(), tag, -d, p.maxVersionOlder, p)
+	}
+
+	// Check the next run won't move the tag again, by applying the policy
+	// to the local list.
+	if err := oImg.PutTag(tag, toVersion); err != nil {
+		return fmt.Errorf("rollback %q:%q: %s", repo.Name(), tag, err)
+	}
+	if err := p.apply(oImg); err != nil {
+		return fmt.Errorf("rollback %q:%q: %s", repo.Name(), tag, err)
+	}
+	if next, _ := oImg.GetOfficialTag(tag); next != toVersion {
+		if !force {
+			return fmt.Errorf("rollback %q:%q: %s would move the tag from %q to %q; use -force to roll back anyway", repo.Name(), tag, p, toVersion, next)
+		}
+		log.Printf("%q: Forced to roll back %q, though %s would move it from %q to %q", repo.Name(), tag, p, toVersion, next)
+	}
+
+	if err := repo.MoveTag(ctx, tag, toVersion); err != nil {
+		return fmt.Errorf("rollback %q:%q: %s", repo.Name(), tag, err)
+	}
+	log.Printf("%q: Rolled back %q from %q to %q", repo.Name(), tag, previous, toVersion)
+	return nil
+}
+
+// maxDistancePolicy returns the max distance policy controlling the tag.
+func (a *appConfig) maxDistancePolicy(tag string) (*maxDistancePolicy, bool) {
+	for _, p := range a.policies {
+		if p, ok := p.(*maxDistancePolicy); ok && p.controlledTag() == tag {
+			return p, true
+		}
+	}
+	return nil, false
+}
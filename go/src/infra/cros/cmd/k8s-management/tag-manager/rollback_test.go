@@ -0,0 +1,135 @@
+// Copyright 2021 The Chromium OS Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package main
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"infra/cros/cmd/k8s-management/internal/registry"
+)
+
+func TestAppConfigRollback(t *testing.T) {
+	t.Parallel()
+	// In the order of newest -> oldest.
+	tagsList := [][]string{
+		{"official-4", latestOfficial, canary, prod}, {"tag1"}, {"official-3"}, {"official-2"}, {"official-1"},
+	}
+	tests := []struct {
+		name      string
+		tag       string
+		toVersion string
+		force     bool
+		want      [][]string
+	}{
+		{
+			name:      "roll back prod within the policy",
+			tag:       prod,
+			toVersion: "official-3",
+			want: [][]string{
+				{"official-4", latestOfficial, canary}, {"tag1"}, {"official-3", prod}, {"official-2"}, {"official-1"},
+			},
+		},
+		{
+			name:      "force to roll back prod further than the policy",
+			tag:       prod,
+			toVersion: "official-1",
+			force:     true,
+			want: [][]string{
+				{"official-4", latestOfficial, canary}, {"tag1"}, {"official-3"}, {"official-2"}, {"official-1", prod},
+			},
+		},
+		{
+			name:      "force to roll back canary",
+			tag:       canary,
+			toVersion: "official-3",
+			force:     true,
+			want: [][]string{
+				{"official-4", latestOfficial, prod}, {"tag1"}, {"official-3", canary}, {"official-2"}, {"official-1"},
+			},
+		},
+		{
+			name:      "roll back to the current version",
+			tag:       prod,
+			toVersion: "official-4",
+			want:      tagsList,
+		},
+	}
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			r := registry.NewFake("fake/repo", tagsList)
+			c := newAppConfig(`^official-\d{1,2}$`, latestOfficialPolicy, canaryMaxDistancePolicy, prodMaxDistancePolicy)
+			if err := c.rollback(r, tc.tag, tc.toVersion, tc.force); err != nil {
+				t.Fatalf("rollback(%q, %q) failed: %s", tc.tag, tc.toVersion, err)
+			}
+			if diff := cmp.Diff(tc.want, r.Tags()); diff != "" {
+				t.Errorf("rollback(%q, %q) mismatch: (-want, +got):\n%s", tc.tag, tc.toVersion, diff)
+			}
+		})
+	}
+}
+
+func TestAppConfigRollbackErrors(t *testing.T) {
+	t.Parallel()
+	// In the order of newest -> oldest.
+	tagsList := [][]string{
+		{"official-4", latestOfficial, prod}, {"tag1"}, {"official-3"}, {"official-2", canary}, {"official-1"},
+	}
+	tests := []struct {
+		name      string
+		tag       string
+		toVersion string
+		force     bool
+	}{
+		{
+			name:      "tag not controlled by a max distance policy",
+			tag:       latestOfficial,
+			toVersion: "official-3",
+		},
+		{
+			name:      "version not official",
+			tag:       prod,
+			toVersion: "tag1",
+		},
+		{
+			name:      "version not found",
+			tag:       prod,
+			toVersion: "official-9",
+		},
+		{
+			name:      "version newer than the tag",
+			tag:       canary,
+			toVersion: "official-3",
+			force:     true,
+		},
+		{
+			name:      "further than the policy",
+			tag:       canary,
+			toVersion: "official-1",
+		},
+		{
+			name:      "policy moves the tag again",
+			tag:       prod,
+			toVersion: "official-3",
+		},
+	}
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			r := registry.NewFake("fake/repo", tagsList)
+			c := newAppConfig(`^official-\d{1,2}$`, latestOfficialPolicy, canaryMaxDistancePolicy, prodMaxDistancePolicy)
+			if err := c.rollback(r, tc.tag, tc.toVersion, tc.force); err == nil {
+				t.Errorf("rollback(%q, %q) succeeded, want error", tc.tag, tc.toVersion)
+			}
+			if diff := cmp.Diff(tagsList, r.Tags()); diff != "" {
+				t.Errorf("rollback(%q, %q) changed tags: (-want, +got):\n%s", tc.tag, tc.toVersion, diff)
+			}
+		})
+	}
+}
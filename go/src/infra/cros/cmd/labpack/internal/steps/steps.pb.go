@@ -48,6 +48,13 @@ type LabpackInput struct {
 	NoMetrics bool `protobuf:"varint,9,opt,name=no_metrics,json=noMetrics,proto3" json:"no_metrics,omitempty"`
 	// Custom configuration.
 	Configuration string `protobuf:"bytes,8,opt,name=configuration,proto3" json:"configuration,omitempty"`
+	// Enable plans limits execution to the listed plans.
+	// If empty then all plans from configuration are run.
+	EnablePlans []string `protobuf:"bytes,10,rep,name=enable_plans,json=enablePlans,proto3" json:"enable_plans,omitempty"`
+	// Disable plans excludes listed plans from execution.
+	DisablePlans []string `protobuf:"bytes,11,rep,name=disable_plans,json=disablePlans,proto3" json:"disable_plans,omitempty"`
+	// Force allows to disable safety-critical plans, e.g. "close".
+	ForceDisablePlans bool `protobuf:"varint,12,opt,name=force_disable_plans,json=forceDisablePlans,proto3" json:"force_disable_plans,omitempty"`
 }
 
 func (x *LabpackInput) Reset() {
@@ -145,6 +152,27 @@ func (x *LabpackInput) GetConfiguration() string {
 	return ""
 }
 
+func (x *LabpackInput) GetEnablePlans() []string {
+	if x != nil {
+		return x.EnablePlans
+	}
+	return nil
+}
+
+func (x *LabpackInput) GetDisablePlans() []string {
+	if x != nil {
+		return x.DisablePlans
+	}
+	return nil
+}
+
+func (x *LabpackInput) GetForceDisablePlans() bool {
+	if x != nil {
+		return x.ForceDisablePlans
+	}
+	return false
+}
+
 // LabpackResponse represents result of execution the task on unit.
 type LabpackResponse struct {
 	state         protoimpl.MessageState
@@ -154,6 +182,8 @@ type LabpackResponse struct {
 	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
 	// Tells what was the reason of failure.
 	FailReason string `protobuf:"bytes,2,opt,name=fail_reason,json=failReason,proto3" json:"fail_reason,omitempty"`
+	// Plans selected to run for each resource of the unit.
+	EffectivePlans []*ResourcePlans `protobuf:"bytes,3,rep,name=effective_plans,json=effectivePlans,proto3" json:"effective_plans,omitempty"`
 }
 
 func (x *LabpackResponse) Reset() {
@@ -202,13 +232,76 @@ func (x *LabpackResponse) GetFailReason() string {
 	return ""
 }
 
+func (x *LabpackResponse) GetEffectivePlans() []*ResourcePlans {
+	if x != nil {
+		return x.EffectivePlans
+	}
+	return nil
+}
+
+// ResourcePlans represents list of plans selected to run against resource.
+type ResourcePlans struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Resource string   `protobuf:"bytes,1,opt,name=resource,proto3" json:"resource,omitempty"`
+	Plans    []string `protobuf:"bytes,2,rep,name=plans,proto3" json:"plans,omitempty"`
+}
+
+func (x *ResourcePlans) Reset() {
+	*x = ResourcePlans{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_infra_cros_cmd_labpack_internal_steps_steps_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResourcePlans) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResourcePlans) ProtoMessage() {}
+
+func (x *ResourcePlans) ProtoReflect() protoreflect.Message {
+	mi := &file_infra_cros_cmd_labpack_internal_steps_steps_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResourcePlans.ProtoReflect.Descriptor instead.
+func (*ResourcePlans) Descriptor() ([]byte, []int) {
+	return file_infra_cros_cmd_labpack_internal_steps_steps_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ResourcePlans) GetResource() string {
+	if x != nil {
+		return x.Resource
+	}
+	return ""
+}
+
+func (x *ResourcePlans) GetPlans() []string {
+	if x != nil {
+		return x.Plans
+	}
+	return nil
+}
+
 var File_infra_cros_cmd_labpack_internal_steps_steps_proto protoreflect.FileDescriptor
 
 var file_infra_cros_cmd_labpack_internal_steps_steps_proto_rawDesc = []byte{
 	0x0a, 0x31, 0x69, 0x6e, 0x66, 0x72, 0x61, 0x2f, 0x63, 0x72, 0x6f, 0x73, 0x2f, 0x63, 0x6d, 0x64,
 	0x2f, 0x6c, 0x61, 0x62, 0x70, 0x61, 0x63, 0x6b, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61,
 	0x6c, 0x2f, 0x73, 0x74, 0x65, 0x70, 0x73, 0x2f, 0x73, 0x74, 0x65, 0x70, 0x73, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x12, 0x05, 0x73, 0x74, 0x65, 0x70, 0x73, 0x22, 0xd2, 0x02, 0x0a, 0x0c, 0x4c,
+	0x6f, 0x74, 0x6f, 0x12, 0x05, 0x73, 0x74, 0x65, 0x70, 0x73, 0x22, 0xca, 0x03, 0x0a, 0x0c, 0x4c,
 	0x61, 0x62, 0x70, 0x61, 0x63, 0x6b, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x75,
 	0x6e, 0x69, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
 	0x75, 0x6e, 0x69, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x61, 0x73, 0x6b,
@@ -229,16 +322,32 @@ var file_infra_cros_cmd_labpack_internal_steps_steps_proto_rawDesc = []byte{
 	0x5f, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09,
 	0x6e, 0x6f, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x63, 0x6f, 0x6e,
 	0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22,
-	0x4c, 0x0a, 0x0f, 0x4c, 0x61, 0x62, 0x70, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x1f, 0x0a, 0x0b,
-	0x66, 0x61, 0x69, 0x6c, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0a, 0x66, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x42, 0x2f, 0x5a,
-	0x2d, 0x69, 0x6e, 0x66, 0x72, 0x61, 0x2f, 0x63, 0x72, 0x6f, 0x73, 0x2f, 0x63, 0x6d, 0x64, 0x2f,
-	0x6c, 0x61, 0x62, 0x70, 0x61, 0x63, 0x6b, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
-	0x2f, 0x73, 0x74, 0x65, 0x70, 0x73, 0x3b, 0x73, 0x74, 0x65, 0x70, 0x73, 0x70, 0x62, 0x62, 0x06,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x21, 0x0a, 0x0c, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x70, 0x6c, 0x61, 0x6e, 0x73, 0x18,
+	0x0a, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x6c, 0x61,
+	0x6e, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x70, 0x6c,
+	0x61, 0x6e, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x64, 0x69, 0x73, 0x61, 0x62,
+	0x6c, 0x65, 0x50, 0x6c, 0x61, 0x6e, 0x73, 0x12, 0x2e, 0x0a, 0x13, 0x66, 0x6f, 0x72, 0x63, 0x65,
+	0x5f, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x70, 0x6c, 0x61, 0x6e, 0x73, 0x18, 0x0c,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x44, 0x69, 0x73, 0x61, 0x62,
+	0x6c, 0x65, 0x50, 0x6c, 0x61, 0x6e, 0x73, 0x22, 0x8b, 0x01, 0x0a, 0x0f, 0x4c, 0x61, 0x62, 0x70,
+	0x61, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73,
+	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x61, 0x69, 0x6c, 0x5f, 0x72, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x66, 0x61, 0x69, 0x6c,
+	0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x3d, 0x0a, 0x0f, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74,
+	0x69, 0x76, 0x65, 0x5f, 0x70, 0x6c, 0x61, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x14, 0x2e, 0x73, 0x74, 0x65, 0x70, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x50, 0x6c, 0x61, 0x6e, 0x73, 0x52, 0x0e, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65,
+	0x50, 0x6c, 0x61, 0x6e, 0x73, 0x22, 0x41, 0x0a, 0x0d, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x50, 0x6c, 0x61, 0x6e, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x6c, 0x61, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x05, 0x70, 0x6c, 0x61, 0x6e, 0x73, 0x42, 0x2f, 0x5a, 0x2d, 0x69, 0x6e, 0x66, 0x72,
+	0x61, 0x2f, 0x63, 0x72, 0x6f, 0x73, 0x2f, 0x63, 0x6d, 0x64, 0x2f, 0x6c, 0x61, 0x62, 0x70, 0x61,
+	0x63, 0x6b, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x73, 0x74, 0x65, 0x70,
+	0x73, 0x3b, 0x73, 0x74, 0x65, 0x70, 0x73, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
 }
 
 var (
@@ -253,17 +362,19 @@ func file_infra_cros_cmd_labpack_internal_steps_steps_proto_rawDescGZIP() []byte
 	return file_infra_cros_cmd_labpack_internal_steps_steps_proto_rawDescData
 }
 
-var file_infra_cros_cmd_labpack_internal_steps_steps_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_infra_cros_cmd_labpack_internal_steps_steps_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
 var file_infra_cros_cmd_labpack_internal_steps_steps_proto_goTypes = []interface{}{
 	(*LabpackInput)(nil),    // 0: steps.LabpackInput
 	(*LabpackResponse)(nil), // 1: steps.LabpackResponse
+	(*ResourcePlans)(nil),   // 2: steps.ResourcePlans
 }
 var file_infra_cros_cmd_labpack_internal_steps_steps_proto_depIdxs = []int32{
-	0, // [0:0] is the sub-list for method output_type
-	0, // [0:0] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	2, // 0: steps.LabpackResponse.effective_plans:type_name -> steps.ResourcePlans
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
 }
 
 func init() { file_infra_cros_cmd_labpack_internal_steps_steps_proto_init() }
@@ -296,6 +407,18 @@ func file_infra_cros_cmd_labpack_internal_steps_steps_proto_init() {
 				return nil
 			}
 		}
+		file_infra_cros_cmd_labpack_internal_steps_steps_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResourcePlans); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -303,7 +426,7 @@ func file_infra_cros_cmd_labpack_internal_steps_steps_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_infra_cros_cmd_labpack_internal_steps_steps_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   2,
+			NumMessages:   3,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -61,7 +61,7 @@ func main() {
 				log.SetOutput(os.Stderr)
 
 				res := &steps.LabpackResponse{Success: true}
-				err := internalRun(ctx, input, state, lg)
+				err := internalRun(ctx, input, state, lg, res)
 				if err != nil {
 					res.Success = false
 					res.FailReason = err.Error()
@@ -77,7 +77,8 @@ func main() {
 }
 
 // internalRun main entry point to execution received request.
-func internalRun(ctx context.Context, in *steps.LabpackInput, state *build.State, lg logger.Logger) (err error) {
+// Effective plans selected by recovery engine are recorded to the response.
+func internalRun(ctx context.Context, in *steps.LabpackInput, state *build.State, lg logger.Logger, res *steps.LabpackResponse) (err error) {
 	// Catching the panic here as luciexe just set a step as fail and but not exit execution.
 	defer func() {
 		if r := recover(); r != nil {
@@ -126,6 +127,10 @@ func internalRun(ctx context.Context, in *steps.LabpackInput, state *build.State
 		ConfigReader:          cr,
 		SwarmingTaskID:        state.Infra().GetSwarming().GetTaskId(),
 		BuildbucketID:         state.Infra().GetBackend().GetTask().GetId().GetId(),
+		EnablePlans:           in.GetEnablePlans(),
+		DisablePlans:          in.GetDisablePlans(),
+		ForceDisablePlans:     in.GetForceDisablePlans(),
+		PlansSelected:         recordEffectivePlans(res),
 	}
 	lg.Debug("Labpack: started recovery engine.")
 	if err := recovery.Run(ctx, runArgs); err != nil {
@@ -149,6 +154,16 @@ func getConfiguration(config string, lg logger.Logger) (io.Reader, error) {
 	return bytes.NewReader(dc), nil
 }
 
+// recordEffectivePlans creates callback to record plans selected to run per resource.
+func recordEffectivePlans(res *steps.LabpackResponse) func(string, []string) {
+	return func(resource string, plans []string) {
+		res.EffectivePlans = append(res.EffectivePlans, &steps.ResourcePlans{
+			Resource: resource,
+			Plans:    plans,
+		})
+	}
+}
+
 // Mapping of all supported tasks.
 var supportedTasks = map[string]tasknames.TaskName{
 	string(tasknames.Deploy):   tasknames.Deploy,
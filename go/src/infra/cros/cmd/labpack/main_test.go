@@ -11,6 +11,7 @@ import (
 
 	"github.com/google/go-cmp/cmp"
 
+	steps "infra/cros/cmd/labpack/internal/steps"
 	"infra/cros/recovery/logger"
 )
 
@@ -68,3 +69,25 @@ func TestGetConfiguration(t *testing.T) {
 		})
 	}
 }
+
+// Testing recordEffectivePlans method.
+func TestRecordEffectivePlans(t *testing.T) {
+	t.Parallel()
+	res := &steps.LabpackResponse{}
+	record := recordEffectivePlans(res)
+	record("dut1", []string{"servo", "close"})
+	record("dut2", []string{"cros"})
+	want := []*steps.ResourcePlans{
+		{Resource: "dut1", Plans: []string{"servo", "close"}},
+		{Resource: "dut2", Plans: []string{"cros"}},
+	}
+	if len(res.GetEffectivePlans()) != len(want) {
+		t.Fatalf("got %d records, want %d", len(res.GetEffectivePlans()), len(want))
+	}
+	for i, w := range want {
+		got := res.GetEffectivePlans()[i]
+		if got.GetResource() != w.GetResource() || !cmp.Equal(got.GetPlans(), w.GetPlans()) {
+			t.Errorf("record %d: got %v, want %v", i, got, w)
+		}
+	}
+}
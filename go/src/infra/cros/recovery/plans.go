@@ -0,0 +1,79 @@
+// Copyright 2021 The Chromium OS Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package recovery
+
+import (
+	"sort"
+	"strings"
+
+	"go.chromium.org/luci/common/errors"
+
+	"infra/cros/recovery/internal/planpb"
+)
+
+// criticalPlans lists plans which cannot be disabled without force.
+//
+// The closing plan cleans up the state left on the devices by other plans.
+var criticalPlans = map[string]bool{
+	PlanClosing: true,
+}
+
+// selectPlans applies enable/disable filters from the run arguments to the
+// plans of the configuration and returns the list of plans to run.
+//
+// The order of the plans is defined by configuration.
+func selectPlans(config *planpb.Configuration, args *RunArgs) ([]string, error) {
+	if len(args.EnablePlans) == 0 && len(args.DisablePlans) == 0 {
+		return config.GetPlanNames(), nil
+	}
+	for _, name := range append(append([]string{}, args.EnablePlans...), args.DisablePlans...) {
+		if _, ok := config.GetPlans()[name]; !ok {
+			return nil, errors.Reason("select plans: unknown plan %q, valid plans: %s", name, strings.Join(validPlans(config), ", ")).Err()
+		}
+	}
+	if !args.ForceDisablePlans {
+		for _, name := range args.DisablePlans {
+			if criticalPlans[name] {
+				return nil, errors.Reason("select plans: plan %q is safety-critical and cannot be disabled without force", name).Err()
+			}
+		}
+	}
+	enabled := make(map[string]bool, len(args.EnablePlans))
+	for _, name := range args.EnablePlans {
+		enabled[name] = true
+	}
+	var planNames []string
+	for _, name := range config.GetPlanNames() {
+		if isPlanDisabled(name, args) {
+			continue
+		}
+		// Safety-critical plans always run unless disabled explicitly.
+		if len(enabled) > 0 && !enabled[name] && !criticalPlans[name] {
+			continue
+		}
+		planNames = append(planNames, name)
+	}
+	return planNames, nil
+}
+
+// isPlanDisabled tells if plan was disabled by run arguments.
+func isPlanDisabled(name string, args *RunArgs) bool {
+	for _, n := range args.DisablePlans {
+		if n == name {
+			return true
+		}
+	}
+	return false
+}
+
+// validPlans returns sorted names of plans provided by configuration.
+func validPlans(config *planpb.Configuration) []string {
+	var names []string
+	for name := range config.GetPlans() {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
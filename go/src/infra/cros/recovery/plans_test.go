@@ -0,0 +1,194 @@
+// Copyright 2021 The Chromium OS Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package recovery
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	. "github.com/smartystreets/goconvey/convey"
+
+	"infra/cros/recovery/internal/planpb"
+	"infra/cros/recovery/logger"
+	"infra/cros/recovery/tlw"
+)
+
+// stubPlansConfig creates configuration where every plan passes.
+func stubPlansConfig() *planpb.Configuration {
+	passPlan := func() *planpb.Plan {
+		return &planpb.Plan{
+			CriticalActions: []string{"sample_pass"},
+			Actions: map[string]*planpb.Action{
+				"sample_pass": {
+					ExecName: "sample_pass",
+				},
+			},
+		}
+	}
+	return &planpb.Configuration{
+		PlanNames: []string{PlanServo, PlanCrOS, PlanChameleon},
+		Plans: map[string]*planpb.Plan{
+			PlanServo:     passPlan(),
+			PlanCrOS:      passPlan(),
+			PlanChameleon: passPlan(),
+			PlanClosing:   passPlan(),
+		},
+	}
+}
+
+var selectPlansCases = []struct {
+	name    string
+	enable  []string
+	disable []string
+	force   bool
+	want    []string
+	wantErr string
+}{
+	{
+		"no filters",
+		nil,
+		nil,
+		false,
+		[]string{PlanServo, PlanCrOS, PlanChameleon},
+		"",
+	},
+	{
+		"enable single plan",
+		[]string{PlanServo},
+		nil,
+		false,
+		[]string{PlanServo},
+		"",
+	},
+	{
+		"enable keeps configuration order",
+		[]string{PlanChameleon, PlanServo},
+		nil,
+		false,
+		[]string{PlanServo, PlanChameleon},
+		"",
+	},
+	{
+		"disable single plan",
+		nil,
+		[]string{PlanCrOS},
+		false,
+		[]string{PlanServo, PlanChameleon},
+		"",
+	},
+	{
+		"disable wins over enable",
+		[]string{PlanServo, PlanCrOS},
+		[]string{PlanCrOS},
+		false,
+		[]string{PlanServo},
+		"",
+	},
+	{
+		"unknown enabled plan",
+		[]string{"servo_v2"},
+		nil,
+		false,
+		nil,
+		`unknown plan "servo_v2", valid plans: chameleon, close, cros, servo`,
+	},
+	{
+		"unknown disabled plan",
+		nil,
+		[]string{"bad"},
+		false,
+		nil,
+		`unknown plan "bad"`,
+	},
+	{
+		"disable critical plan without force",
+		nil,
+		[]string{PlanClosing},
+		false,
+		nil,
+		`plan "close" is safety-critical`,
+	},
+	{
+		"disable critical plan with force",
+		nil,
+		[]string{PlanClosing},
+		true,
+		[]string{PlanServo, PlanCrOS, PlanChameleon},
+		"",
+	},
+}
+
+// Testing selectPlans method.
+func TestSelectPlans(t *testing.T) {
+	t.Parallel()
+	for _, c := range selectPlansCases {
+		cs := c
+		t.Run(cs.name, func(t *testing.T) {
+			t.Parallel()
+			args := &RunArgs{
+				EnablePlans:       cs.enable,
+				DisablePlans:      cs.disable,
+				ForceDisablePlans: cs.force,
+			}
+			got, err := selectPlans(stubPlansConfig(), args)
+			if cs.wantErr != "" {
+				if err == nil {
+					t.Fatalf("%q -> expected to fail but passed", cs.name)
+				}
+				if !strings.Contains(err.Error(), cs.wantErr) {
+					t.Errorf("%q -> error %q does not contain %q", cs.name, err, cs.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("%q -> unexpected error: %s", cs.name, err)
+			}
+			if !cmp.Equal(got, cs.want) {
+				t.Errorf("%q -> want: %v\n got: %v", cs.name, cs.want, got)
+			}
+		})
+	}
+}
+
+func TestRunDUTPlansWithFilters(t *testing.T) {
+	t.Parallel()
+	Convey("Run DUT plans with filters", t, func() {
+		ctx := context.Background()
+		dut := &tlw.Dut{
+			Name: "test_dut",
+			ServoHost: &tlw.ServoHost{
+				Name: "servo_host",
+			},
+		}
+		var selected []string
+		args := &RunArgs{
+			Logger: logger.NewLogger(),
+			PlansSelected: func(resource string, plans []string) {
+				selected = plans
+			},
+		}
+		Convey("Closing plan still runs when only one plan enabled", func() {
+			args.EnablePlans = []string{PlanServo}
+			So(runDUTPlans(ctx, dut, stubPlansConfig(), args), ShouldBeNil)
+			So(selected, ShouldResemble, []string{PlanServo, PlanClosing})
+		})
+		Convey("Closing plan is skipped when force disabled", func() {
+			args.DisablePlans = []string{PlanClosing, PlanChameleon}
+			args.ForceDisablePlans = true
+			So(runDUTPlans(ctx, dut, stubPlansConfig(), args), ShouldBeNil)
+			So(selected, ShouldResemble, []string{PlanServo, PlanCrOS})
+		})
+		Convey("Fail on unknown plan", func() {
+			args.EnablePlans = []string{"unknown"}
+			err := runDUTPlans(ctx, dut, stubPlansConfig(), args)
+			So(err, ShouldNotBeNil)
+			So(err.Error(), ShouldContainSubstring, "run dut \"test_dut\" plans")
+			So(err.Error(), ShouldContainSubstring, "valid plans: chameleon, close, cros, servo")
+			So(selected, ShouldBeNil)
+		})
+	})
+}
@@ -292,7 +292,15 @@ func runDUTPlans(ctx context.Context, dut *tlw.Dut, config *planpb.Configuration
 		defer args.Logger.DedentLogging()
 	}
 	log.Info(ctx, "Run DUT %q: starting...", dut.Name)
-	planNames := config.GetPlanNames()
+	for _, planName := range config.GetPlanNames() {
+		if _, ok := config.GetPlans()[planName]; !ok {
+			return errors.Reason("run dut %q plans: plan %q not found in configuration", dut.Name, planName).Err()
+		}
+	}
+	planNames, err := selectPlans(config, args)
+	if err != nil {
+		return errors.Annotate(err, "run dut %q plans", dut.Name).Err()
+	}
 	log.Debug(ctx, "Run DUT %q plans: will use %s.", dut.Name, planNames)
 	hasClosingPlan := false
 	for _, planName := range planNames {
@@ -300,9 +308,15 @@ func runDUTPlans(ctx context.Context, dut *tlw.Dut, config *planpb.Configuration
 			// The Closing plan will be added by default and it i sok if it missed.
 			hasClosingPlan = true
 		}
-		if _, ok := config.GetPlans()[planName]; !ok {
-			return errors.Reason("run dut %q plans: plan %q not found in configuration", dut.Name, planName).Err()
+	}
+	// The closing plan is skipped only when it was disabled explicitly.
+	runClosingPlan := !hasClosingPlan && !isPlanDisabled(PlanClosing, args)
+	if args.PlansSelected != nil {
+		effectivePlans := append([]string{}, planNames...)
+		if _, ok := config.GetPlans()[PlanClosing]; ok && runClosingPlan {
+			effectivePlans = append(effectivePlans, PlanClosing)
 		}
+		args.PlansSelected(dut.Name, effectivePlans)
 	}
 	// Creating one run argument for each resource.
 	execArgs := &execs.RunArgs{
@@ -332,7 +346,7 @@ func runDUTPlans(ctx context.Context, dut *tlw.Dut, config *planpb.Configuration
 	}
 	defer func() {
 		// If closing plan provided by configuration then we do not need run it here.
-		if !hasClosingPlan {
+		if runClosingPlan {
 			plan, ok := config.GetPlans()[PlanClosing]
 			if !ok {
 				log.Info(ctx, "Run plans: plan %q not found in configuration.", PlanClosing)
@@ -453,6 +467,15 @@ type RunArgs struct {
 	SwarmingTaskID string
 	// BuildbucketID is the ID of the buildbucket build
 	BuildbucketID string
+	// EnablePlans limits execution to the listed plans.
+	// If empty then all plans from configuration will be run.
+	EnablePlans []string
+	// DisablePlans lists plans excluded from execution.
+	DisablePlans []string
+	// ForceDisablePlans allows to disable safety-critical plans.
+	ForceDisablePlans bool
+	// PlansSelected is called with the effective list of plans for each resource.
+	PlansSelected func(resource string, plans []string)
 }
 
 // verify verifies input arguments.
@@ -36,6 +36,10 @@ type WiringFake struct {
 	DUTAddress string
 	// DUTPort is a static int to return for OpenDutPort.
 	DUTPort int32
+	// ExposedAddress is a static string to return for ExposePortToDut.
+	ExposedAddress string
+	// ExposedPort is a static int to return for ExposePortToDut.
+	ExposedPort int32
 }
 
 // Serve serves the service using the listener.
@@ -53,6 +57,14 @@ func (s WiringFake) OpenDutPort(ctx context.Context, req *tls.OpenDutPortRequest
 	}, nil
 }
 
+// ExposePortToDut implements the respective gRPC.
+func (s WiringFake) ExposePortToDut(ctx context.Context, req *tls.ExposePortToDutRequest) (*tls.ExposePortToDutResponse, error) {
+	return &tls.ExposePortToDutResponse{
+		ExposedAddress: s.ExposedAddress,
+		ExposedPort:    s.ExposedPort,
+	}, nil
+}
+
 // SSHStub is a stub implementation of an SSH server for testing.
 // It returns canned stdout output and exit status.
 type SSHStub struct {
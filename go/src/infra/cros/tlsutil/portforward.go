@@ -0,0 +1,267 @@
+// Copyright 2021 The Chromium OS Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package tlsutil
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"strconv"
+	"sync"
+	"syscall"
+	"time"
+
+	"go.chromium.org/chromiumos/config/go/api/test/tls"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	// ErrDutRefused matches errors of port forwarding helpers caused by
+	// the TLS service or the DUT refusing the request or connection.
+	// Retrying is unlikely to help.
+	ErrDutRefused = errors.New("refused by TLS service or DUT")
+	// ErrLabNetwork matches errors of port forwarding helpers caused by
+	// the TLS service or the forwarded port being unreachable.
+	// Retrying may help.
+	ErrLabNetwork = errors.New("lab network failure")
+)
+
+// PortError is the error returned by the port forwarding helpers.
+// Use errors.Is with ErrDutRefused or ErrLabNetwork to tell the causes
+// apart.
+type PortError struct {
+	// Op is the operation which failed, e.g. "open port 22 of DUT dut1".
+	Op string
+	// Refused is whether the request or connection was refused, rather
+	// than failed because of the network.
+	Refused bool
+	// Err is the underlying error.
+	Err error
+}
+
+func (e *PortError) Error() string {
+	cause := ErrLabNetwork
+	if e.Refused {
+		cause = ErrDutRefused
+	}
+	return fmt.Sprintf("%s: %s: %s", e.Op, cause, e.Err)
+}
+
+// Unwrap returns the underlying error.
+func (e *PortError) Unwrap() error {
+	return e.Err
+}
+
+// Is reports whether the error matches ErrDutRefused or ErrLabNetwork.
+func (e *PortError) Is(target error) bool {
+	switch target {
+	case ErrDutRefused:
+		return e.Refused
+	case ErrLabNetwork:
+		return !e.Refused
+	default:
+		return false
+	}
+}
+
+// newPortError classifies err, returned by a TLS RPC or by dialing a
+// forwarded port, as a PortError.
+func newPortError(op string, err error) *PortError {
+	refused := false
+	if s, ok := status.FromError(err); ok {
+		switch s.Code() {
+		case codes.Unavailable, codes.DeadlineExceeded, codes.Canceled, codes.Unknown:
+		default:
+			refused = true
+		}
+	} else if errors.Is(err, syscall.ECONNREFUSED) {
+		refused = true
+	}
+	return &PortError{Op: op, Refused: refused, Err: err}
+}
+
+const (
+	// dialAttempts is the number of times DutPort.Dial dials the forwarded
+	// port, re-opening the port between attempts.
+	dialAttempts = 3
+	// dialRetryDelay is the delay before the first retry of
+	// DutPort.Dial. It doubles with each retry.
+	dialRetryDelay = 500 * time.Millisecond
+	// keepAlivePeriod is the TCP keepalive period of connections to
+	// forwarded ports.
+	keepAlivePeriod = 15 * time.Second
+)
+
+// DutPort is a port of a DUT forwarded by the TLS Wiring service.
+//
+// Connections made with Dial use TCP keepalive. If dialing fails, for
+// example because the forwarding was torn down, the port is opened again
+// and dialing retried. Connections are closed when the context passed to
+// OpenDutPort is canceled, or when the DutPort is closed.
+type DutPort struct {
+	c    tls.WiringClient
+	dut  string
+	port int32
+
+	mu     sync.Mutex
+	addr   string
+	conns  map[net.Conn]struct{}
+	closed bool
+}
+
+// OpenDutPort opens a port of a DUT using the TLS Wiring service.
+func OpenDutPort(ctx context.Context, c tls.WiringClient, dut string, port int32) (*DutPort, error) {
+	p := &DutPort{
+		c:     c,
+		dut:   dut,
+		port:  port,
+		conns: make(map[net.Conn]struct{}),
+	}
+	if _, err := p.reopen(ctx); err != nil {
+		return nil, err
+	}
+	go func() {
+		<-ctx.Done()
+		p.Close()
+	}()
+	return p, nil
+}
+
+// Addr returns the address, as "host:port", at which the port of the DUT
+// is currently forwarded.
+func (p *DutPort) Addr() string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.addr
+}
+
+// Dial connects to the port of the DUT.
+func (p *DutPort) Dial(ctx context.Context) (net.Conn, error) {
+	d := net.Dialer{KeepAlive: keepAlivePeriod}
+	addr := p.Addr()
+	delay := dialRetryDelay
+	var lastErr error
+	for attempt := 0; attempt < dialAttempts; attempt++ {
+		if attempt > 0 {
+			select {
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			case <-time.After(delay):
+			}
+			delay *= 2
+			// The forwarding may have been torn down. Opening the port
+			// again restores it, possibly at a new address.
+			var err error
+			if addr, err = p.reopen(ctx); err != nil {
+				return nil, err
+			}
+		}
+		conn, err := d.DialContext(ctx, "tcp", addr)
+		if err != nil {
+			lastErr = err
+			continue
+		}
+		if err := p.track(conn); err != nil {
+			conn.Close()
+			return nil, err
+		}
+		return &trackedConn{Conn: conn, p: p}, nil
+	}
+	return nil, newPortError(fmt.Sprintf("dial port %d of DUT %s at %s", p.port, p.dut, addr), lastErr)
+}
+
+// Close closes all connections to the port. Dial fails after Close.
+func (p *DutPort) Close() error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.closed = true
+	for conn := range p.conns {
+		conn.Close()
+	}
+	p.conns = nil
+	return nil
+}
+
+// reopen opens the port of the DUT with the Wiring service and returns
+// the address it is forwarded at.
+func (p *DutPort) reopen(ctx context.Context) (string, error) {
+	resp, err := p.c.OpenDutPort(ctx, &tls.OpenDutPortRequest{
+		Name: p.dut,
+		Port: p.port,
+	})
+	if err != nil {
+		return "", newPortError(fmt.Sprintf("open port %d of DUT %s", p.port, p.dut), err)
+	}
+	addr := net.JoinHostPort(resp.GetAddress(), strconv.Itoa(int(resp.GetPort())))
+	p.mu.Lock()
+	p.addr = addr
+	p.mu.Unlock()
+	return addr, nil
+}
+
+func (p *DutPort) track(conn net.Conn) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.closed {
+		return fmt.Errorf("dial port %d of DUT %s: port closed", p.port, p.dut)
+	}
+	p.conns[conn] = struct{}{}
+	return nil
+}
+
+func (p *DutPort) untrack(conn net.Conn) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	delete(p.conns, conn)
+}
+
+// trackedConn is a connection to a DutPort, which stops tracking it when
+// closed.
+type trackedConn struct {
+	net.Conn
+	p *DutPort
+}
+
+func (c *trackedConn) Close() error {
+	c.p.untrack(c.Conn)
+	return c.Conn.Close()
+}
+
+// ExposedService is a local service exposed to a DUT.
+type ExposedService struct {
+	// Address is the address, as "host:port", at which the DUT reaches the
+	// service.
+	Address string
+}
+
+// ExposeLocalService exposes the service listening on l to a DUT using the
+// TLS Wiring service. l must be a TCP listener. l is closed when ctx is
+// canceled.
+//
+// If requireRemoteProxy is set, the service is exposed through a proxy
+// reachable by the DUT, which persists across DUT reboots.
+func ExposeLocalService(ctx context.Context, c tls.WiringClient, dut string, l net.Listener, requireRemoteProxy bool) (*ExposedService, error) {
+	addr, ok := l.Addr().(*net.TCPAddr)
+	if !ok {
+		return nil, fmt.Errorf("expose local service to DUT %s: %s is not a TCP listener", dut, l.Addr())
+	}
+	resp, err := c.ExposePortToDut(ctx, &tls.ExposePortToDutRequest{
+		DutName:            dut,
+		LocalPort:          int32(addr.Port),
+		RequireRemoteProxy: requireRemoteProxy,
+	})
+	if err != nil {
+		return nil, newPortError(fmt.Sprintf("expose local port %d to DUT %s", addr.Port, dut), err)
+	}
+	go func() {
+		<-ctx.Done()
+		l.Close()
+	}()
+	return &ExposedService{
+		Address: net.JoinHostPort(resp.GetExposedAddress(), strconv.Itoa(int(resp.GetExposedPort()))),
+	}, nil
+}
@@ -0,0 +1,227 @@
+// Copyright 2021 The Chromium OS Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package tlsutil
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"go.chromium.org/chromiumos/config/go/api/test/tls"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// forwardingWiring is a fake tls.WiringServer which forwards DUT ports to
+// local echo servers. Each call to OpenDutPort after a forwarding was
+// dropped starts a new echo server, at a new address.
+type forwardingWiring struct {
+	tls.UnimplementedWiringServer
+	// err is returned by OpenDutPort if set.
+	err error
+
+	mu    sync.Mutex
+	l     net.Listener
+	calls int
+}
+
+func (s *forwardingWiring) OpenDutPort(ctx context.Context, req *tls.OpenDutPortRequest) (*tls.OpenDutPortResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.calls++
+	if s.err != nil {
+		return nil, s.err
+	}
+	if s.l == nil {
+		l, err := net.Listen("tcp", "127.0.0.1:0")
+		if err != nil {
+			return nil, err
+		}
+		go echo(l)
+		s.l = l
+	}
+	addr := s.l.Addr().(*net.TCPAddr)
+	return &tls.OpenDutPortResponse{
+		Address: addr.IP.String(),
+		Port:    int32(addr.Port),
+	}, nil
+}
+
+// drop tears down the current forwarding.
+func (s *forwardingWiring) drop() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.l.Close()
+	s.l = nil
+}
+
+func echo(l net.Listener) {
+	for {
+		c, err := l.Accept()
+		if err != nil {
+			return
+		}
+		go func() {
+			defer c.Close()
+			io.Copy(c, c)
+		}()
+	}
+}
+
+func startWiring(t *testing.T, s tls.WiringServer) tls.WiringClient {
+	t.Helper()
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	conn, l := MakeTestClient(ctx)
+	server := grpc.NewServer()
+	tls.RegisterWiringServer(server, s)
+	go server.Serve(l)
+	t.Cleanup(server.Stop)
+	t.Cleanup(func() { conn.Close() })
+	return tls.NewWiringClient(conn)
+}
+
+func checkEcho(t *testing.T, conn net.Conn) {
+	t.Helper()
+	if _, err := conn.Write([]byte("ping")); err != nil {
+		t.Fatalf("Write() failed: %s", err)
+	}
+	buf := make([]byte, 4)
+	if _, err := io.ReadFull(conn, buf); err != nil {
+		t.Fatalf("Read() failed: %s", err)
+	}
+	if got := string(buf); got != "ping" {
+		t.Errorf("Read() = %q; want %q", got, "ping")
+	}
+}
+
+func TestDutPortReconnect(t *testing.T) {
+	t.Parallel()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	w := &forwardingWiring{}
+	c := startWiring(t, w)
+
+	p, err := OpenDutPort(ctx, c, "dut1", 22)
+	if err != nil {
+		t.Fatalf("OpenDutPort() failed: %s", err)
+	}
+	conn, err := p.Dial(ctx)
+	if err != nil {
+		t.Fatalf("Dial() failed: %s", err)
+	}
+	checkEcho(t, conn)
+	conn.Close()
+
+	w.drop()
+	conn, err = p.Dial(ctx)
+	if err != nil {
+		t.Fatalf("Dial() after dropped forwarding failed: %s", err)
+	}
+	defer conn.Close()
+	checkEcho(t, conn)
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.calls != 2 {
+		t.Errorf("OpenDutPort called %d times; want 2", w.calls)
+	}
+}
+
+func TestDutPortContextCancel(t *testing.T) {
+	t.Parallel()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	c := startWiring(t, &forwardingWiring{})
+
+	p, err := OpenDutPort(ctx, c, "dut1", 22)
+	if err != nil {
+		t.Fatalf("OpenDutPort() failed: %s", err)
+	}
+	conn, err := p.Dial(ctx)
+	if err != nil {
+		t.Fatalf("Dial() failed: %s", err)
+	}
+	checkEcho(t, conn)
+
+	cancel()
+	conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+	// Read returns once the connection is closed.
+	_, err = conn.Read(make([]byte, 1))
+	if ne, ok := err.(net.Error); err == nil || ok && ne.Timeout() {
+		t.Fatalf("Read() after cancel returned %v; want the connection closed", err)
+	}
+	if _, err := p.Dial(context.Background()); err == nil {
+		t.Errorf("Dial() after cancel succeeded; want error")
+	}
+}
+
+func TestDutPortErrors(t *testing.T) {
+	t.Parallel()
+	cases := []struct {
+		name        string
+		err         error
+		wantRefused bool
+	}{
+		{"unknown DUT", status.Error(codes.NotFound, "no such DUT"), true},
+		{"TLS service down", status.Error(codes.Unavailable, "connection reset"), false},
+	}
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			c := startWiring(t, &forwardingWiring{err: tc.err})
+			_, err := OpenDutPort(context.Background(), c, "dut1", 22)
+			if err == nil {
+				t.Fatalf("OpenDutPort() succeeded; want error")
+			}
+			if got := errors.Is(err, ErrDutRefused); got != tc.wantRefused {
+				t.Errorf("errors.Is(%q, ErrDutRefused) = %t; want %t", err, got, tc.wantRefused)
+			}
+			if got := errors.Is(err, ErrLabNetwork); got == tc.wantRefused {
+				t.Errorf("errors.Is(%q, ErrLabNetwork) = %t; want %t", err, got, !tc.wantRefused)
+			}
+		})
+	}
+}
+
+func TestExposeLocalService(t *testing.T) {
+	t.Parallel()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	c := startWiring(t, &WiringFake{ExposedAddress: "192.168.0.1", ExposedPort: 2222})
+
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	s, err := ExposeLocalService(ctx, c, "dut1", l, false)
+	if err != nil {
+		t.Fatalf("ExposeLocalService() failed: %s", err)
+	}
+	if want := net.JoinHostPort("192.168.0.1", strconv.Itoa(2222)); s.Address != want {
+		t.Errorf("Address = %q; want %q", s.Address, want)
+	}
+
+	cancel()
+	accepted := make(chan error, 1)
+	go func() {
+		_, err := l.Accept()
+		accepted <- err
+	}()
+	select {
+	case err := <-accepted:
+		if err == nil {
+			t.Errorf("Accept() after cancel succeeded; want the listener closed")
+		}
+	case <-time.After(5 * time.Second):
+		t.Errorf("listener not closed after cancel")
+	}
+}
@@ -0,0 +1,311 @@
+// Copyright 2021 The Chromium Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"os/exec"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"golang.org/x/tools/go/packages"
+)
+
+// errStripped is returned when the binary has no symbol table, e.g. because
+// it was linked with -ldflags=-s.
+var errStripped = errors.New("binary has no symbol table; build it without -ldflags=-s")
+
+// nmLine matches a line of `go tool nm -size` output: address, size, type and
+// symbol name. Undefined symbols have no address, and names may contain
+// spaces (e.g. "type:struct { F int }").
+var nmLine = regexp.MustCompile(`^\s*([0-9a-f]*)\s+(\d+)\s+(\S)\s+(.+)$`)
+
+// symbol is a symbol of a Go binary.
+type symbol struct {
+	Name string
+	Size int64
+}
+
+// readSymbols returns the symbols of the Go binary at path, using
+// `go tool nm -size`.
+func readSymbols(path string) ([]symbol, error) {
+	var stdout, stderr bytes.Buffer
+	cmd := exec.Command("go", "tool", "nm", "-size", path)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		if strings.Contains(stderr.String(), "no symbols") {
+			return nil, fmt.Errorf("%s: %w", path, errStripped)
+		}
+		return nil, fmt.Errorf("go tool nm %s: %v: %s", path, err, strings.TrimSpace(stderr.String()))
+	}
+	return parseSymbols(&stdout)
+}
+
+// parseSymbols parses the output of `go tool nm -size`.
+func parseSymbols(r io.Reader) ([]symbol, error) {
+	var syms []symbol
+	s := bufio.NewScanner(r)
+	s.Buffer(nil, 1<<20)
+	for s.Scan() {
+		m := nmLine.FindStringSubmatch(s.Text())
+		if m == nil {
+			return nil, fmt.Errorf("unexpected go tool nm output %q", s.Text())
+		}
+		// Undefined symbols take no space in the binary.
+		if m[3] == "U" {
+			continue
+		}
+		size, err := strconv.ParseInt(m[2], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("parsing size of %s: %v", m[4], err)
+		}
+		syms = append(syms, symbol{Name: m[4], Size: size})
+	}
+	return syms, s.Err()
+}
+
+// symbolPackage returns the path of the package symbol name belongs to, or ""
+// if the symbol was generated by the linker for no particular package.
+//
+// Type descriptors and itabs are attributed to the package defining the type.
+// Package paths may contain dots in their last element (e.g.
+// "gopkg.in/yaml.v2"), so if known is not nil, the longest candidate package
+// path in known is preferred.
+func symbolPackage(name string, known map[string]bool) string {
+	switch {
+	case strings.HasPrefix(name, "go:itab.") || strings.HasPrefix(name, "go.itab."):
+		// go:itab.<concrete type>,<interface type>
+		name = name[len("go:itab."):]
+		if i := strings.IndexByte(name, ','); i >= 0 {
+			name = name[:i]
+		}
+	case strings.HasPrefix(name, "type:") || strings.HasPrefix(name, "type."):
+		name = name[len("type:"):]
+	case strings.HasPrefix(name, "go:") || strings.HasPrefix(name, "go."):
+		return ""
+	}
+	// Drop pointer, slice and array type constructors.
+	for len(name) > 0 && (name[0] == '*' || name[0] == '[') {
+		if name[0] == '*' {
+			name = name[1:]
+		} else if i := strings.IndexByte(name, ']'); i >= 0 {
+			name = name[i+1:]
+		} else {
+			return ""
+		}
+	}
+	// Drop the type arguments of instantiations of generic functions and
+	// types, which may contain other package paths.
+	if i := strings.IndexByte(name, '['); i >= 0 {
+		name = name[:i]
+	}
+
+	slash := strings.LastIndexByte(name, '/') + 1
+	dot := strings.IndexByte(name[slash:], '.')
+	if dot < 0 {
+		return ""
+	}
+	pkg := name[:slash+dot]
+	if known == nil || known[pkg] {
+		return pkg
+	}
+	for i := slash + dot + 1; i < len(name); i++ {
+		if name[i] == '.' && known[name[:i]] {
+			pkg = name[:i]
+		}
+	}
+	return pkg
+}
+
+// packageSizes returns the number of bytes of the symbols of each package,
+// and the number of bytes of the symbols not attributed to any package.
+func packageSizes(syms []symbol, known map[string]bool) (sizes map[string]int64, unattributed int64) {
+	sizes = map[string]int64{}
+	for _, s := range syms {
+		if pkg := symbolPackage(s.Name, known); pkg != "" {
+			sizes[pkg] += s.Size
+		} else {
+			unattributed += s.Size
+		}
+	}
+	return sizes, unattributed
+}
+
+// depSize is the size a direct dependency of the analyzed packages pulls into
+// the binary.
+type depSize struct {
+	// PkgPath is the path of the dependency.
+	PkgPath string
+	// Module is the path of the module of the dependency, or "std" for the
+	// standard library.
+	Module string
+	// Bytes is the number of bytes of the symbols of the dependency itself.
+	Bytes int64
+	// TransitiveBytes is the number of bytes of the symbols of the
+	// dependency and of all the packages it transitively imports.
+	TransitiveBytes int64
+	// TransitivePackageCount is the number of packages the dependency
+	// transitively imports, including itself, which have symbols in the
+	// binary.
+	TransitivePackageCount int
+}
+
+// isExternal returns true if pkg matches none of patterns.
+func isExternal(patterns []string, pkg string) bool {
+	for _, pat := range patterns {
+		if patMatch(pat, pkg) {
+			return false
+		}
+	}
+	return true
+}
+
+// moduleOf returns the path of the module of p, or "std" for the standard
+// library.
+func moduleOf(p *packages.Package) string {
+	if p.Module == nil {
+		return "std"
+	}
+	return p.Module.Path
+}
+
+// dependencySizes returns the sizes of the direct dependencies of pkgs
+// outside of patterns, from the largest to the smallest transitive size.
+//
+// Dependencies share transitive imports, so the transitive sizes of several
+// dependencies do not add up.
+func dependencySizes(patterns []string, pkgs []*packages.Package, sizes map[string]int64) []*depSize {
+	direct := map[string]*packages.Package{}
+	for _, p := range pkgs {
+		for path, imp := range p.Imports {
+			if isExternal(patterns, path) {
+				direct[imp.PkgPath] = imp
+			}
+		}
+	}
+
+	var deps []*depSize
+	for _, p := range direct {
+		d := &depSize{
+			PkgPath: p.PkgPath,
+			Module:  moduleOf(p),
+			Bytes:   sizes[p.PkgPath],
+		}
+		seen := map[string]bool{}
+		packages.Visit([]*packages.Package{p}, func(p *packages.Package) bool {
+			if seen[p.PkgPath] {
+				return false
+			}
+			seen[p.PkgPath] = true
+			if size, ok := sizes[p.PkgPath]; ok {
+				d.TransitiveBytes += size
+				d.TransitivePackageCount++
+			}
+			return true
+		}, nil)
+		deps = append(deps, d)
+	}
+
+	sort.Slice(deps, func(i, j int) bool {
+		if deps[i].TransitiveBytes != deps[j].TransitiveBytes {
+			return deps[i].TransitiveBytes > deps[j].TransitiveBytes
+		}
+		return deps[i].PkgPath < deps[j].PkgPath
+	})
+	return deps
+}
+
+type binaryStats struct {
+	// SymbolCount is the number of symbols in the binary.
+	SymbolCount int
+	// TotalBytes is the total size of the symbols in the binary.
+	TotalBytes int64
+	// UnattributedBytes is the total size of the symbols generated by the
+	// linker for no particular package.
+	UnattributedBytes int64
+	// PackageCount is the number of packages with symbols in the binary.
+	PackageCount int
+	// ModuleBytes is the total size of the symbols of each module,
+	// including "std" for the standard library.
+	ModuleBytes map[string]int64
+	// DirectDependencyCount is the number of direct dependencies of the
+	// analyzed packages outside of the path patterns.
+	DirectDependencyCount int
+}
+
+// analyzeBinary attributes the size of the Go binary at binPath to the
+// direct dependencies of the packages matching patterns, and writes them as
+// CSV to w, followed by a summary.
+func analyzeBinary(w io.Writer, binPath string, patterns []string) error {
+	syms, err := readSymbols(binPath)
+	if err != nil {
+		return err
+	}
+
+	cfg := &packages.Config{
+		Mode: packages.NeedName | packages.NeedImports | packages.NeedDeps | packages.NeedModule,
+	}
+	pkgs, err := packages.Load(cfg, patterns...)
+	if err != nil {
+		return fmt.Errorf("loading packages: %v", err)
+	}
+	known := map[string]bool{}
+	modules := map[string]string{}
+	packages.Visit(pkgs, nil, func(p *packages.Package) {
+		known[p.PkgPath] = true
+		modules[p.PkgPath] = moduleOf(p)
+		// Symbols of the main package are prefixed by "main".
+		if p.Name == "main" {
+			modules["main"] = moduleOf(p)
+		}
+	})
+
+	sizes, unattributed := packageSizes(syms, known)
+	sum := binaryStats{
+		SymbolCount:       len(syms),
+		UnattributedBytes: unattributed,
+		PackageCount:      len(sizes),
+		ModuleBytes:       map[string]int64{},
+	}
+	for _, s := range syms {
+		sum.TotalBytes += s.Size
+	}
+	for pkg, size := range sizes {
+		// Packages of the binary outside of the import graph of the
+		// analyzed packages have an unknown module.
+		mod, ok := modules[pkg]
+		if !ok {
+			mod = "unknown"
+		}
+		sum.ModuleBytes[mod] += size
+	}
+
+	deps := dependencySizes(patterns, pkgs, sizes)
+	sum.DirectDependencyCount = len(deps)
+	out := [][]string{}
+	for _, d := range deps {
+		out = append(out, []string{
+			d.PkgPath,
+			d.Module,
+			fmt.Sprintf("%d", d.Bytes),
+			fmt.Sprintf("%d", d.TransitiveBytes),
+			fmt.Sprintf("%d", d.TransitivePackageCount),
+		})
+	}
+	cw := csv.NewWriter(w)
+	if err := cw.WriteAll(out); err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(w, "Aggregate: %+v\n", sum)
+	return err
+}
@@ -0,0 +1,221 @@
+// Copyright 2021 The Chromium Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package main
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"golang.org/x/tools/go/packages"
+)
+
+// buildFixture builds a small Go binary, with -ldflags=ldflags, and returns
+// its path.
+func buildFixture(t *testing.T, ldflags string) string {
+	t.Helper()
+	if _, err := exec.LookPath("go"); err != nil {
+		t.Skip("go is not in PATH")
+	}
+	dir := t.TempDir()
+	files := map[string]string{
+		"go.mod": "module fixture\n\ngo 1.16\n",
+		"big/big.go": `package big
+
+// Table is large, so that the package is much larger than small.
+var Table [1 << 16]byte
+
+type Big struct{ N int }
+
+func (b *Big) String() string { return string(Table[b.N:]) }
+`,
+		"small/small.go": `package small
+
+var N = 1
+`,
+		"main.go": `package main
+
+import (
+	"fmt"
+
+	"fixture/big"
+	"fixture/small"
+)
+
+func main() {
+	big.Table[small.N] = 1
+	fmt.Println(&big.Big{N: small.N})
+}
+`,
+	}
+	for name, content := range files {
+		path := filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	bin := filepath.Join(dir, "fixture")
+	cmd := exec.Command("go", "build", "-ldflags="+ldflags, "-o", bin, ".")
+	cmd.Dir = dir
+	cmd.Env = append(os.Environ(), "GOFLAGS=-mod=mod", "GO111MODULE=on")
+	if out, err := cmd.CombinedOutput(); err != nil {
+		t.Fatalf("building the fixture: %v\n%s", err, out)
+	}
+	return bin
+}
+
+func TestPackageSizes(t *testing.T) {
+	t.Parallel()
+
+	syms, err := readSymbols(buildFixture(t, ""))
+	if err != nil {
+		t.Fatalf("readSymbols: %v", err)
+	}
+	sizes, unattributed := packageSizes(syms, nil)
+
+	if got := sizes["fixture/big"]; got < 1<<16 {
+		t.Errorf("got %d bytes for fixture/big, want at least %d", got, 1<<16)
+	}
+	if got := sizes["fixture/small"]; got <= 0 || got >= sizes["fixture/big"] {
+		t.Errorf("got %d bytes for fixture/small, want more than 0 and less than fixture/big", got)
+	}
+	for _, pkg := range []string{"main", "fmt", "runtime"} {
+		if sizes[pkg] <= 0 {
+			t.Errorf("got %d bytes for %s, want more than 0", sizes[pkg], pkg)
+		}
+	}
+	for pkg := range sizes {
+		if strings.ContainsAny(pkg, "*[]() ") {
+			t.Errorf("got bytes attributed to malformed package %q", pkg)
+		}
+	}
+	if unattributed <= 0 {
+		t.Errorf("got %d unattributed bytes, want linker generated symbols", unattributed)
+	}
+}
+
+func TestReadSymbolsStripped(t *testing.T) {
+	t.Parallel()
+
+	_, err := readSymbols(buildFixture(t, "-s"))
+	if !errors.Is(err, errStripped) {
+		t.Errorf("got %v, want errStripped", err)
+	}
+}
+
+func TestSymbolPackage(t *testing.T) {
+	t.Parallel()
+
+	known := map[string]bool{"gopkg.in/yaml.v2": true, "net/http": true}
+	for _, tc := range []struct {
+		symbol string
+		want   string
+	}{
+		{"main.main", "main"},
+		{"runtime.main.func1", "runtime"},
+		{"net/http.(*Client).Do", "net/http"},
+		{"net/http.(*Client).Do-fm", "net/http"},
+		{"fixture/big.Table", "fixture/big"},
+		{"fixture/big.Map[go.shape.int,go/ast.Node]", "fixture/big"},
+		{"fixture/big.(*List[go.shape.*uint8]).Push", "fixture/big"},
+		{"type:*fixture/big.Big", "fixture/big"},
+		{"type:[]net/http.Header", "net/http"},
+		{"type:[4]*net/http.Request", "net/http"},
+		{"type.*fixture/big.Big", "fixture/big"},
+		{"go:itab.*os.File,io.Writer", "os"},
+		{"go.itab.*os.File,io.Writer", "os"},
+		{"gopkg.in/yaml.v2.Unmarshal", "gopkg.in/yaml.v2"},
+		{"gopkg.in/yaml.v2.(*parser).parse", "gopkg.in/yaml.v2"},
+		{"type:*gopkg.in/yaml.v2.Node", "gopkg.in/yaml.v2"},
+		{"go:string.*", ""},
+		{"go:buildid", ""},
+		{"type:.eq.[2]interface {}", ""},
+		{"type:map[string]int", ""},
+		{"runtime.text", "runtime"},
+	} {
+		if got := symbolPackage(tc.symbol, known); got != tc.want {
+			t.Errorf("symbolPackage(%q) = %q, want %q", tc.symbol, got, tc.want)
+		}
+	}
+}
+
+func TestParseSymbols(t *testing.T) {
+	t.Parallel()
+
+	out := `  592e60       4096 B fixture/big.Table
+  499de0        127 T main.main
+  546a88          0 R type:*
+  4d1f40         48 R type:struct { F int }
+                  0 U _cgo_init
+`
+	syms, err := parseSymbols(strings.NewReader(out))
+	if err != nil {
+		t.Fatalf("parseSymbols: %v", err)
+	}
+	want := []symbol{
+		{"fixture/big.Table", 4096},
+		{"main.main", 127},
+		{"type:*", 0},
+		{"type:struct { F int }", 48},
+	}
+	if len(syms) != len(want) {
+		t.Fatalf("got %v, want %v", syms, want)
+	}
+	for i := range want {
+		if syms[i] != want[i] {
+			t.Errorf("got symbol %d %v, want %v", i, syms[i], want[i])
+		}
+	}
+}
+
+func TestDependencySizes(t *testing.T) {
+	t.Parallel()
+
+	pkg := func(path string, imports ...*packages.Package) *packages.Package {
+		p := &packages.Package{PkgPath: path, Imports: map[string]*packages.Package{}}
+		for _, imp := range imports {
+			p.Imports[imp.PkgPath] = imp
+		}
+		return p
+	}
+	runtime := pkg("runtime")
+	fmt := pkg("fmt", runtime)
+	shared := pkg("example.com/shared", runtime)
+	a := pkg("example.com/a", shared, fmt)
+	b := pkg("example.com/b", shared)
+	other := pkg("infra/other", runtime)
+	roots := []*packages.Package{
+		pkg("infra/tool", a, b, other),
+		pkg("infra/tool/sub", a),
+	}
+	sizes := map[string]int64{
+		"runtime":            100,
+		"fmt":                50,
+		"example.com/shared": 20,
+		"example.com/a":      5,
+		"example.com/b":      1,
+	}
+
+	deps := dependencySizes([]string{"infra/tool/...", "infra/other"}, roots, sizes)
+	want := []depSize{
+		{PkgPath: "example.com/a", Module: "std", Bytes: 5, TransitiveBytes: 175, TransitivePackageCount: 4},
+		{PkgPath: "example.com/b", Module: "std", Bytes: 1, TransitiveBytes: 121, TransitivePackageCount: 3},
+	}
+	if len(deps) != len(want) {
+		t.Fatalf("got %d dependencies, want %d", len(deps), len(want))
+	}
+	for i := range want {
+		if *deps[i] != want[i] {
+			t.Errorf("got dependency %d %+v, want %+v", i, *deps[i], want[i])
+		}
+	}
+}
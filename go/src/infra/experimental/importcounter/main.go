@@ -10,6 +10,18 @@
 // Will print CSV to stdout, listing each subpackage of go.chromium.org/luci/...
 // along with some per-subpackge counts on each row. Finally, it will produce
 // some aggregate counts for the entire set of packages.
+//
+// With --binary, it will instead attribute the size of a Go binary to the
+// direct dependencies of the packages, e.g.:
+//
+//   go run . --patterns infra/cmd/foo --binary foo
+//
+// Will print CSV to stdout, listing each direct dependency outside of the
+// patterns with its module, the size of its own symbols, the size of the
+// symbols of all the packages it transitively imports, and the number of
+// those packages, from the largest dependency to the smallest. Finally, it
+// will print a summary of the binary, including the size of each module.
+// The binary must not be stripped.
 package main
 
 import (
@@ -25,11 +37,13 @@ import (
 )
 
 var (
-	patterns stringListValue
+	patterns   stringListValue
+	binaryPath string
 )
 
 func init() {
 	flag.Var(&patterns, "patterns", "package path patterns to examine")
+	flag.StringVar(&binaryPath, "binary", "", "path to a Go binary built from the packages, to attribute its size to their dependencies")
 }
 
 type stats struct {
@@ -95,6 +109,13 @@ func countExternal(patterns []string, pkgs map[string]*packages.Package) int {
 
 func main() {
 	flag.Parse()
+	if binaryPath != "" {
+		if err := analyzeBinary(os.Stdout, binaryPath, patterns); err != nil {
+			log.Fatalf("analyzing %s: %+v", binaryPath, err)
+		}
+		return
+	}
+
 	cfg := &packages.Config{
 		// Consider using packages.NeedDeps if we want more info about imported packages.
 		Mode: packages.NeedName | packages.NeedFiles | packages.NeedImports,
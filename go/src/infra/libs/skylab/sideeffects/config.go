@@ -12,6 +12,8 @@ import (
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"regexp"
+	"strconv"
 	"strings"
 
 	"github.com/golang/protobuf/jsonpb"
@@ -21,7 +23,7 @@ import (
 	"infra/libs/skylab/cloudkms"
 )
 
-// ValidateConfig checks the presence of all required fields in
+// ValidateConfig checks the presence and format of all required fields in
 // side_effects.Config and the existence of all required files.
 func ValidateConfig(c *side_effects.Config) error {
 	ma := getMissingArgs(c)
@@ -31,6 +33,13 @@ func ValidateConfig(c *side_effects.Config) error {
 			strings.Join(ma, ", "))
 	}
 
+	ia := getInvalidArgs(c)
+
+	if len(ia) > 0 {
+		return fmt.Errorf("Error validating side_effects.Config: invalid %s",
+			strings.Join(ia, ", "))
+	}
+
 	mf := getMissingFiles(c)
 
 	if len(mf) > 0 {
@@ -63,6 +72,20 @@ func getMissingArgs(c *side_effects.Config) []string {
 	return r
 }
 
+// bucketNameRegexp matches valid Google Storage bucket names, given
+// without the gs:// scheme.
+var bucketNameRegexp = regexp.MustCompile(`^[a-z0-9][a-z0-9._-]{1,220}[a-z0-9]$`)
+
+func getInvalidArgs(c *side_effects.Config) []string {
+	var r []string
+
+	if b := c.GoogleStorage.GetBucket(); !bucketNameRegexp.MatchString(b) {
+		r = append(r, fmt.Sprintf("Google Storage bucket %q", b))
+	}
+
+	return r
+}
+
 func getMissingFiles(c *side_effects.Config) []string {
 	var r []string
 
@@ -73,25 +96,65 @@ func getMissingFiles(c *side_effects.Config) []string {
 	return r
 }
 
-const configFileName = "side_effects_config.json"
+const (
+	configFileName  = "side_effects_config.json"
+	versionFileName = "side_effects_config.version"
+)
+
+// ConfigSchemaVersion is the version of the side_effects_config.json
+// file format, written to side_effects_config.version.  It must be
+// incremented whenever consumers need to handle the file differently.
+const ConfigSchemaVersion = 1
 
 // WriteConfigToDisk writes a JSON encoded side_effects.Config proto to
-// <dir>/side_effects_config.json.
+// <dir>/side_effects_config.json, and ConfigSchemaVersion to
+// <dir>/side_effects_config.version.
+//
+// Both files are replaced atomically, so that readers never see a partial
+// file, e.g. when a task is retried with the same results directory.  The
+// version file is written first, so that it is always present along with
+// the config.
 func WriteConfigToDisk(dir string, c *side_effects.Config) error {
-	f := filepath.Join(dir, configFileName)
-	w, err := os.Create(f)
-	if err != nil {
-		return errors.Annotate(err, "write side_effects_config.json to disk").Err()
+	if err := writeFileAtomic(dir, versionFileName, []byte(strconv.Itoa(ConfigSchemaVersion)+"\n")); err != nil {
+		return errors.Annotate(err, "write side_effects_config.version to disk").Err()
 	}
-	defer w.Close()
 
 	marshaler := jsonpb.Marshaler{}
-	if err := marshaler.Marshal(w, c); err != nil {
+	content, err := marshaler.MarshalToString(c)
+	if err != nil {
+		return errors.Annotate(err, "write side_effects_config.json to disk").Err()
+	}
+	if err := writeFileAtomic(dir, configFileName, []byte(content)); err != nil {
 		return errors.Annotate(err, "write side_effects_config.json to disk").Err()
 	}
 	return nil
 }
 
+// writeFileAtomic writes the data to <dir>/<name> through a temporary file
+// renamed over it, so that the file is either unchanged or fully written.
+func writeFileAtomic(dir, name string, data []byte) (err error) {
+	f, err := ioutil.TempFile(dir, name+".tmp")
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			f.Close()
+			os.Remove(f.Name())
+		}
+	}()
+	if _, err := f.Write(data); err != nil {
+		return err
+	}
+	if err := f.Chmod(0644); err != nil {
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	return os.Rename(f.Name(), filepath.Join(dir, name))
+}
+
 // PopulateTKOPasswordFile decrypts the encrypted MySQL password, writes it
 // to a temp file and updates the corresponding config field.
 func PopulateTKOPasswordFile(ctx context.Context, ckc cloudkms.Client, c *side_effects.Config) error {
@@ -95,6 +95,31 @@ func TestMissingArgs(t *testing.T) {
 	})
 }
 
+func TestInvalidArgs(t *testing.T) {
+	Convey("Given a side_effects.Config with an invalid", t, func() {
+		cases := []struct {
+			name   string
+			bucket string
+		}{
+			{name: "bucket with a scheme", bucket: "gs://foo-bucket"},
+			{name: "bucket with a path", bucket: "foo-bucket/results"},
+			{name: "bucket with upper case letters", bucket: "Foo-Bucket"},
+			{name: "too short bucket", bucket: "fo"},
+		}
+		for _, c := range cases {
+			Convey(c.name, func() {
+				cfg := basicConfig()
+				cfg.GoogleStorage.Bucket = c.bucket
+				err := ValidateConfig(cfg)
+				Convey("then the correct error is returned.", func() {
+					So(err, ShouldNotBeNil)
+					So(err.Error(), ShouldContainSubstring, "invalid Google Storage bucket")
+				})
+			})
+		}
+	})
+}
+
 func TestMissingFiles(t *testing.T) {
 	Convey("Given a missing", t, func() {
 		cases := []struct {
@@ -154,6 +179,47 @@ func TestWriteConfigToDisk(t *testing.T) {
 	})
 }
 
+func TestWriteConfigToDiskVersionAndRetry(t *testing.T) {
+	Convey("Given a directory with a side_effects.Config from a previous attempt", t, func() {
+		dir, _ := ioutil.TempDir("", "")
+		defer os.RemoveAll(dir)
+		old := &side_effects.Config{
+			GoogleStorage: &side_effects.GoogleStorageConfig{Bucket: "old-bucket"},
+		}
+		So(WriteConfigToDisk(dir, old), ShouldBeNil)
+
+		Convey("when WriteConfigToDisk is called again", func() {
+			want := &side_effects.Config{
+				GoogleStorage: &side_effects.GoogleStorageConfig{Bucket: "new-bucket"},
+			}
+			So(WriteConfigToDisk(dir, want), ShouldBeNil)
+
+			Convey("then the config is replaced", func() {
+				f, err := os.Open(filepath.Join(dir, "side_effects_config.json"))
+				So(err, ShouldBeNil)
+				defer f.Close()
+				got := &side_effects.Config{}
+				So((&jsonpb.Unmarshaler{}).Unmarshal(f, got), ShouldBeNil)
+				So(got, ShouldResembleProto, want)
+			})
+			Convey("then the schema version is written", func() {
+				got, err := ioutil.ReadFile(filepath.Join(dir, "side_effects_config.version"))
+				So(err, ShouldBeNil)
+				So(string(got), ShouldEqual, "1\n")
+			})
+			Convey("then no temporary files are left", func() {
+				files, err := ioutil.ReadDir(dir)
+				So(err, ShouldBeNil)
+				var names []string
+				for _, f := range files {
+					names = append(names, f.Name())
+				}
+				So(names, ShouldResemble, []string{"side_effects_config.json", "side_effects_config.version"})
+			})
+		})
+	})
+}
+
 type fakeCloudKMSClient struct{}
 
 func newFakeCloudKMSClient() *fakeCloudKMSClient {
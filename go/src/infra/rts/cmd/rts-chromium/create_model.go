@@ -7,6 +7,7 @@ package main
 import (
 	"bufio"
 	"context"
+	"fmt"
 	"io/ioutil"
 	"os"
 	"path/filepath"
@@ -39,14 +40,43 @@ func cmdCreateModel(authOpt *auth.Options) *subcommands.Command {
 			`))
 
 			r.Flags.StringVar(&r.checkout, "checkout", "", "Path to a src.git checkout")
+			r.Flags.StringVar(&r.reposConfig, "repos-config", "", text.Doc(`
+				Path to a JSON file listing git checkouts to build the file graph from,
+				e.g. src.git and the repositories checked out under it, such as v8.
+				Files of each repository are named relative to its mount point.
+				See reposConfig type for the format.
+				Mutually exclusive with -checkout.
+			`))
 			r.Flags.IntVar(&r.loadOptions.MaxCommitSize, "fg-max-commit-size", 100, text.Doc(`
 				Maximum number of files touched by a commit.
 				Commits that exceed this limit are ignored.
 				The rationale is that large commits provide a weak signal of file
 				relatedness and are expensive to process, O(N^2).
 			`))
+			r.Flags.IntVar(&r.loadOptions.RenameSimilarityThreshold, "fg-rename-threshold", 0, text.Doc(`
+				Minimum similarity, in percent, of a removed and an added file for git
+				to detect a rename. Histories of renamed files are merged.
+				If 0, the git default is used. If negative, renames are not detected.
+			`))
+			r.Flags.BoolVar(&r.loadOptions.DetectCopies, "fg-detect-copies", false, text.Doc(`
+				Detect copies of files, with the same threshold as renames.
+			`))
+			r.Flags.DurationVar(&r.loadOptions.RecencyHalfLife, "fg-recency-half-life", 0, text.Doc(`
+				If positive, weight each commit by its recency, halving the weight of
+				co-changes every this long, e.g. 8760h.
+				To compare recall with and without the decay, create two models
+				with and without this flag and compare their eval reports.
+			`))
+
+			r.Flags.IntVar(&r.testFileMappingDays, "test-file-mapping-days", 7, text.Doc(`
+				Number of days of test results to build the mapping of tests to
+				files where they are defined from. The mapping is based on test
+				location metadata and is used instead of the test file names
+				reported in test results. If 0, the mapping is not built.
+			`))
 
 			r.ev.LogProgressInterval = 100
+			r.ev.TestSuiteKey = testSuite
 			r.ev.RegisterFlags(&r.Flags)
 			return r
 		},
@@ -58,9 +88,13 @@ type createModelRun struct {
 	modelDir string
 
 	checkout    string
+	reposConfig string
 	loadOptions git.LoadOptions
 	fg          *git.Graph
 
+	testFileMappingDays int
+	testFileMapping     *testFileMapping // nil if not built
+
 	ev eval.Eval
 
 	authOpt  *auth.Options
@@ -72,10 +106,14 @@ func (r *createModelRun) validateFlags() error {
 		return err
 	}
 	switch {
+	case r.testFileMappingDays < 0:
+		return errors.New("-test-file-mapping-days must not be negative")
 	case r.modelDir == "":
 		return errors.New("-model-dir is required")
-	case r.checkout == "":
-		return errors.New("-checkout is required")
+	case r.checkout == "" && r.reposConfig == "":
+		return errors.New("-checkout or -repos-config is required")
+	case r.checkout != "" && r.reposConfig != "":
+		return errors.New("-checkout and -repos-config are mutually exclusive")
 	default:
 		return nil
 	}
@@ -105,11 +143,27 @@ func (r *createModelRun) writeModel(ctx context.Context, dir string) error {
 		return errors.Annotate(err, "failed to create model dir at %q", dir).Err()
 	}
 
+	// The file graph model is evaluated using the test file mapping,
+	// so build it first.
+	manifest := &modelManifest{}
+	if r.testFileMappingDays > 0 {
+		entry, err := r.writeTestFileMapping(ctx, dir)
+		if err != nil {
+			return errors.Annotate(err, "failed to write test file mapping").Err()
+		}
+		manifest.TestFileMapping = entry
+	}
+
+	manifest.FileGraph = &fileGraphEntry{Path: "git-file-graph"}
+	if r.loadOptions.RecencyHalfLife > 0 {
+		manifest.FileGraph.RecencyHalfLife = r.loadOptions.RecencyHalfLife.String()
+	}
+
 	eg, ctx := errgroup.WithContext(ctx)
 	defer eg.Wait()
 
 	eg.Go(func() error {
-		err := r.writeFileGraphModel(ctx, filepath.Join(dir, "git-file-graph"))
+		err := r.writeFileGraphModel(ctx, filepath.Join(dir, manifest.FileGraph.Path))
 		return errors.Annotate(err, "failed to write file graph model").Err()
 	})
 
@@ -118,13 +172,55 @@ func (r *createModelRun) writeModel(ctx context.Context, dir string) error {
 		return errors.Annotate(err, "failed to write test file set").Err()
 	})
 
-	return eg.Wait()
+	if err := eg.Wait(); err != nil {
+		return err
+	}
+
+	// Write the manifest last, so that it is present only in complete models.
+	return errors.Annotate(writeModelManifest(dir, manifest), "failed to write manifest").Err()
+}
+
+// writeTestFileMapping builds the mapping of tests to files where they are
+// defined, initializes r.testFileMapping and writes the mapping to the model
+// dir.
+func (r *createModelRun) writeTestFileMapping(ctx context.Context, dir string) (*testFileMappingEntry, error) {
+	logging.Infof(ctx, "Building the test file mapping from the past %d days...", r.testFileMappingDays)
+	source, err := queryTestLocations(ctx, r.bqClient, r.testFileMappingDays)
+	if err != nil {
+		return nil, err
+	}
+	locs, err := buildTestFileMapping(ctx, source)
+	if err != nil {
+		return nil, err
+	}
+
+	f, err := os.Create(filepath.Join(dir, testFileMappingFileName))
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	bufW := bufio.NewWriter(f)
+	if err := writeTestLocations(bufW, locs); err != nil {
+		return nil, err
+	}
+	if err := bufW.Flush(); err != nil {
+		return nil, err
+	}
+	if err := f.Close(); err != nil {
+		return nil, err
+	}
+
+	r.testFileMapping = newTestFileMapping(locs)
+	return &testFileMappingEntry{
+		Path:       testFileMappingFileName,
+		WindowDays: r.testFileMappingDays,
+		Tests:      len(locs),
+	}, nil
 }
 
 // writeFileGraphModel writes the file graph model to the model dir.
 func (r *createModelRun) writeFileGraphModel(ctx context.Context, dir string) error {
-	var err error
-	if r.fg, err = git.Load(ctx, r.checkout, r.loadOptions); err != nil {
+	if err := r.loadFileGraph(ctx); err != nil {
 		return err
 	}
 
@@ -148,6 +244,23 @@ func (r *createModelRun) writeFileGraphModel(ctx context.Context, dir string) er
 	return eg.Wait()
 }
 
+// loadFileGraph loads the file graph from the checkout or from the
+// repositories listed in the repos config.
+func (r *createModelRun) loadFileGraph(ctx context.Context) error {
+	var err error
+	if r.reposConfig == "" {
+		r.fg, err = git.Load(ctx, r.checkout, r.loadOptions)
+		return err
+	}
+
+	repos, err := readReposConfig(r.reposConfig)
+	if err != nil {
+		return errors.Annotate(err, "failed to read the repos config").Err()
+	}
+	r.fg, err = git.LoadMulti(ctx, repos, r.loadOptions.UpdateOptions)
+	return err
+}
+
 // writeFileGraph writes the graph file.
 func (r *createModelRun) writeFileGraph(ctx context.Context, fileName string) error {
 	f, err := os.Create(fileName)
@@ -184,6 +297,10 @@ func (r *createModelRun) writeStrategyConfig(ctx context.Context, fileName strin
 
 	// Use both strategies with normalized distances.
 	logging.Infof(ctx, "Evaluating the combined strategy...")
+	if r.testFileMapping != nil {
+		// Report the fallback usage of the combined strategy only.
+		r.testFileMapping.resetCounts()
+	}
 	er := &git.EdgeReader{
 		// Normalize distances, but also use the scale [0, 100] for readability.
 		ChangeLogDistanceFactor:     100 / float64(changeLogRes.RejectionClosestDistanceStats.MaxNonInf),
@@ -195,6 +312,29 @@ func (r *createModelRun) writeStrategyConfig(ctx context.Context, fileName strin
 	}
 
 	eval.PrintResults(res, os.Stdout, 0.97)
+	eval.PrintBreakdown(res, os.Stdout, eval.BreakdownChangeRecall)
+	if err := r.ev.WriteBreakdown(res); err != nil {
+		return err
+	}
+	recencySummary := "none"
+	if r.loadOptions.RecencyHalfLife > 0 {
+		recencySummary = r.loadOptions.RecencyHalfLife.String()
+	}
+	mappingSummary := "none"
+	if r.testFileMapping != nil {
+		mappingSummary = r.testFileMapping.String()
+		logging.Infof(ctx, "Test file mapping: %s", mappingSummary)
+	}
+	err = r.ev.WriteHTMLReport(res,
+		eval.ReportMetadata{Name: "Model dir", Value: r.modelDir},
+		eval.ReportMetadata{Name: "Test file mapping", Value: mappingSummary},
+		eval.ReportMetadata{Name: "Recency half-life", Value: recencySummary},
+		eval.ReportMetadata{Name: "ChangeLogDistanceFactor", Value: fmt.Sprint(er.ChangeLogDistanceFactor)},
+		eval.ReportMetadata{Name: "FileStructureDistanceFactor", Value: fmt.Sprint(er.FileStructureDistanceFactor)},
+	)
+	if err != nil {
+		return err
+	}
 	cfgBytes, err := protojson.Marshal(&GitBasedStrategyConfig{
 		ChangeLogDistanceFactor:     float32(er.ChangeLogDistanceFactor),
 		FileStructureDistanceFactor: float32(er.FileStructureDistanceFactor),
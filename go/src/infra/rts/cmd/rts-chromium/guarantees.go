@@ -0,0 +1,235 @@
+// Copyright 2021 The Chromium Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"regexp"
+	"sort"
+	"strings"
+
+	"go.chromium.org/luci/common/data/stringset"
+	"go.chromium.org/luci/common/errors"
+)
+
+// guaranteesFile is the format of a file with minimum-test guarantees.
+// Each rule lists test suites that must always run when a changed file
+// matches the rule's glob, regardless of the model distance.
+//
+// In file globs, "*" matches any sequence of characters other than "/",
+// "**" matches any sequence of characters and "?" matches a single
+// character other than "/". In suite patterns, "*" and "?" match any
+// characters.
+//
+// Example:
+//
+//	{
+//	  "rules": [
+//	    {"glob": "//sandbox/**", "suites": ["sandbox_*"]},
+//	    {"glob": "//crypto/**", "suites": ["crypto_unittests", "net_unittests"]}
+//	  ]
+//	}
+type guaranteesFile struct {
+	Rules []struct {
+		Glob   string   `json:"glob"`
+		Suites []string `json:"suites"`
+	} `json:"rules"`
+}
+
+// guaranteeRule is a rule of a guaranteesFile.
+type guaranteeRule struct {
+	// Index is the 1-based index of the rule in the file.
+	Index  int
+	Glob   string
+	Suites []string
+
+	globRe   *regexp.Regexp
+	suiteRes []*regexp.Regexp
+}
+
+// String returns a description of the rule for the selection output.
+func (r *guaranteeRule) String() string {
+	return fmt.Sprintf("rule #%d %q -> %s", r.Index, r.Glob, strings.Join(r.Suites, ","))
+}
+
+// specificity returns the number of literal characters in the rule's glob.
+// When several rules force the same suite, the most specific one is
+// reported.
+func (r *guaranteeRule) specificity() int {
+	return len(r.Glob) - strings.Count(r.Glob, "*") - strings.Count(r.Glob, "?")
+}
+
+// forcesSuite returns true if the rule forces the test suite to run.
+func (r *guaranteeRule) forcesSuite(suite string) bool {
+	for _, re := range r.suiteRes {
+		if re.MatchString(suite) {
+			return true
+		}
+	}
+	return false
+}
+
+// guarantees is a set of minimum-test guarantees, applied after
+// model-based selection.
+type guarantees struct {
+	Rules []*guaranteeRule
+}
+
+// policyHit is a test suite forced to run by the guarantees.
+type policyHit struct {
+	Suite string
+	Rule  *guaranteeRule
+	// ChangedFile is the changed file that matched the rule's glob.
+	ChangedFile string
+}
+
+// String returns the line reported in the selection output.
+func (h policyHit) String() string {
+	return fmt.Sprintf("%s: forced by policy: %s matched %s", h.Suite, h.Rule, h.ChangedFile)
+}
+
+// readGuarantees reads a guaranteesFile.
+func readGuarantees(fileName string) (*guarantees, error) {
+	data, err := ioutil.ReadFile(fileName)
+	if err != nil {
+		return nil, err
+	}
+	var f guaranteesFile
+	if err := json.Unmarshal(data, &f); err != nil {
+		return nil, errors.Annotate(err, "failed to parse %q", fileName).Err()
+	}
+	g, err := newGuarantees(f)
+	return g, errors.Annotate(err, "invalid guarantees in %q", fileName).Err()
+}
+
+// newGuarantees validates and compiles the rules of a guaranteesFile.
+//
+// Rules with the same glob are rejected as contradictory, since it is
+// ambiguous which of them is the intended one.
+func newGuarantees(f guaranteesFile) (*guarantees, error) {
+	g := &guarantees{}
+	globs := map[string]int{}
+	for i, r := range f.Rules {
+		rule := &guaranteeRule{
+			Index:  i + 1,
+			Glob:   r.Glob,
+			Suites: r.Suites,
+		}
+		switch {
+		case !strings.HasPrefix(r.Glob, "//"):
+			return nil, errors.Reason("rule #%d: glob %q is not source-absolute", rule.Index, r.Glob).Err()
+		case len(r.Suites) == 0:
+			return nil, errors.Reason("rule #%d: no suites", rule.Index).Err()
+		}
+		if prev, ok := globs[r.Glob]; ok {
+			return nil, errors.Reason("rule #%d: glob %q contradicts rule #%d with the same glob", rule.Index, r.Glob, prev).Err()
+		}
+		globs[r.Glob] = rule.Index
+
+		rule.globRe = globRegexp(r.Glob, true)
+		for _, s := range r.Suites {
+			if s == "" {
+				return nil, errors.Reason("rule #%d: empty suite pattern", rule.Index).Err()
+			}
+			rule.suiteRes = append(rule.suiteRes, globRegexp(s, false))
+		}
+		g.Rules = append(g.Rules, rule)
+	}
+	return g, nil
+}
+
+// globRegexp compiles a glob to a regexp matching the whole string.
+// If paths is true, "*" and "?" do not match "/", and "**" matches
+// anything.
+func globRegexp(glob string, paths bool) *regexp.Regexp {
+	re := &strings.Builder{}
+	re.WriteString("^")
+	for i := 0; i < len(glob); i++ {
+		switch c := glob[i]; {
+		case c == '*' && paths && i+1 < len(glob) && glob[i+1] == '*':
+			re.WriteString(".*")
+			i++
+		case c == '*' && paths:
+			re.WriteString("[^/]*")
+		case c == '*':
+			re.WriteString(".*")
+		case c == '?' && paths:
+			re.WriteString("[^/]")
+		case c == '?':
+			re.WriteString(".")
+		default:
+			re.WriteString(regexp.QuoteMeta(string(c)))
+		}
+	}
+	re.WriteString("$")
+	return regexp.MustCompile(re.String())
+}
+
+// unknownSuitePatterns returns the suite patterns which do not match any
+// of the known test suites, formatted for a warning.
+func (g *guarantees) unknownSuitePatterns(knownSuites stringset.Set) []string {
+	var ret []string
+	for _, r := range g.Rules {
+		for i, s := range r.Suites {
+			found := false
+			knownSuites.Iter(func(suite string) bool {
+				found = r.suiteRes[i].MatchString(suite)
+				return !found
+			})
+			if !found {
+				ret = append(ret, fmt.Sprintf("rule #%d: %q", r.Index, s))
+			}
+		}
+	}
+	return ret
+}
+
+// apply forces the suites guaranteed by the rules matching the changed
+// files to run, by removing them from testsToSkip, which maps a suite to
+// the tests to skip. Returns the suites which were forced, ordered by
+// name.
+//
+// If several rules force a suite, the most specific one is reported,
+// and among equally specific rules, the first one in the file.
+func (g *guarantees) apply(changedFiles []string, testsToSkip map[string][]string) []policyHit {
+	if g == nil {
+		return nil
+	}
+	changedFiles = append([]string(nil), changedFiles...)
+	sort.Strings(changedFiles)
+
+	hits := map[string]policyHit{}
+	for _, r := range g.Rules {
+		changed := ""
+		for _, f := range changedFiles {
+			if r.globRe.MatchString(f) {
+				changed = f
+				break
+			}
+		}
+		if changed == "" {
+			continue
+		}
+		for suite := range testsToSkip {
+			if !r.forcesSuite(suite) {
+				continue
+			}
+			if prev, ok := hits[suite]; ok && prev.Rule.specificity() >= r.specificity() {
+				continue
+			}
+			hits[suite] = policyHit{Suite: suite, Rule: r, ChangedFile: changed}
+		}
+	}
+
+	ret := make([]policyHit, 0, len(hits))
+	for suite, h := range hits {
+		delete(testsToSkip, suite)
+		ret = append(ret, h)
+	}
+	sort.Slice(ret, func(i, j int) bool { return ret[i].Suite < ret[j].Suite })
+	return ret
+}
@@ -0,0 +1,132 @@
+// Copyright 2021 The Chromium Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"go.chromium.org/luci/common/data/stringset"
+
+	. "github.com/smartystreets/goconvey/convey"
+	. "go.chromium.org/luci/common/testing/assertions"
+)
+
+func TestGuarantees(t *testing.T) {
+	t.Parallel()
+
+	parse := func(rules string) (*guarantees, error) {
+		tmpd, err := ioutil.TempDir("", "rts_guarantees")
+		So(err, ShouldBeNil)
+		defer os.RemoveAll(tmpd)
+		fileName := filepath.Join(tmpd, "guarantees.json")
+		So(ioutil.WriteFile(fileName, []byte(`{"rules": [`+rules+`]}`), 0666), ShouldBeNil)
+		return readGuarantees(fileName)
+	}
+
+	Convey("globRegexp", t, func() {
+		Convey("Paths", func() {
+			So(globRegexp("//sandbox/**", true).MatchString("//sandbox/linux/a.cc"), ShouldBeTrue)
+			So(globRegexp("//sandbox/*", true).MatchString("//sandbox/a.cc"), ShouldBeTrue)
+			So(globRegexp("//sandbox/*", true).MatchString("//sandbox/linux/a.cc"), ShouldBeFalse)
+			So(globRegexp("//crypto/?.cc", true).MatchString("//crypto/a.cc"), ShouldBeTrue)
+			So(globRegexp("//crypto/?.cc", true).MatchString("//crypto/ab.cc"), ShouldBeFalse)
+			So(globRegexp("//a.b", true).MatchString("//axb"), ShouldBeFalse)
+		})
+		Convey("Suites", func() {
+			So(globRegexp("sandbox_*", false).MatchString("sandbox_linux_unittests"), ShouldBeTrue)
+			So(globRegexp("crypto_unittests", false).MatchString("net_unittests"), ShouldBeFalse)
+		})
+	})
+
+	Convey("readGuarantees", t, func() {
+		Convey("Works", func() {
+			g, err := parse(`
+				{"glob": "//sandbox/**", "suites": ["sandbox_*"]},
+				{"glob": "//crypto/**", "suites": ["crypto_unittests"]}
+			`)
+			So(err, ShouldBeNil)
+			So(g.Rules, ShouldHaveLength, 2)
+			So(g.Rules[1].Index, ShouldEqual, 2)
+			So(g.Rules[1].String(), ShouldEqual, `rule #2 "//crypto/**" -> crypto_unittests`)
+		})
+		Convey("Contradictory rules", func() {
+			_, err := parse(`
+				{"glob": "//crypto/**", "suites": ["crypto_unittests"]},
+				{"glob": "//crypto/**", "suites": ["net_unittests"]}
+			`)
+			So(err, ShouldErrLike, `rule #2: glob "//crypto/**" contradicts rule #1`)
+		})
+		Convey("Relative glob", func() {
+			_, err := parse(`{"glob": "crypto/**", "suites": ["crypto_unittests"]}`)
+			So(err, ShouldErrLike, "not source-absolute")
+		})
+		Convey("No suites", func() {
+			_, err := parse(`{"glob": "//crypto/**"}`)
+			So(err, ShouldErrLike, "rule #1: no suites")
+		})
+	})
+
+	Convey("unknownSuitePatterns", t, func() {
+		g, err := parse(`
+			{"glob": "//sandbox/**", "suites": ["sandbox_*", "missing_tests"]}
+		`)
+		So(err, ShouldBeNil)
+		known := stringset.NewFromSlice("sandbox_linux_unittests", "browser_tests")
+		So(g.unknownSuitePatterns(known), ShouldResemble, []string{`rule #1: "missing_tests"`})
+	})
+
+	Convey("apply", t, func() {
+		g, err := parse(`
+			{"glob": "//sandbox/**", "suites": ["sandbox_*", "browser_tests"]},
+			{"glob": "//sandbox/linux/**", "suites": ["sandbox_linux_unittests"]},
+			{"glob": "//crypto/**", "suites": ["crypto_unittests"]}
+		`)
+		So(err, ShouldBeNil)
+		testsToSkip := map[string][]string{
+			"browser_tests":           {"A.B"},
+			"sandbox_linux_unittests": {"C.D"},
+			"crypto_unittests":        {"E.F"},
+			"unit_tests":              {"G.H"},
+		}
+
+		Convey("Forces matching suites", func() {
+			hits := g.apply([]string{"//sandbox/linux/b.cc", "//sandbox/linux/a.cc"}, testsToSkip)
+			So(hits, ShouldHaveLength, 2)
+
+			So(hits[0].Suite, ShouldEqual, "browser_tests")
+			So(hits[0].Rule.Index, ShouldEqual, 1)
+			So(hits[0].ChangedFile, ShouldEqual, "//sandbox/linux/a.cc")
+
+			// Both rules match, the more specific one is reported.
+			So(hits[1].Suite, ShouldEqual, "sandbox_linux_unittests")
+			So(hits[1].Rule.Index, ShouldEqual, 2)
+
+			So(testsToSkip, ShouldResemble, map[string][]string{
+				"crypto_unittests": {"E.F"},
+				"unit_tests":       {"G.H"},
+			})
+		})
+
+		Convey("Reporting", func() {
+			hits := g.apply([]string{"//crypto/a.cc"}, testsToSkip)
+			So(hits, ShouldHaveLength, 1)
+			So(hits[0].String(), ShouldEqual, `crypto_unittests: forced by policy: rule #3 "//crypto/**" -> crypto_unittests matched //crypto/a.cc`)
+		})
+
+		Convey("No match", func() {
+			So(g.apply([]string{"//base/a.cc"}, testsToSkip), ShouldBeEmpty)
+			So(testsToSkip, ShouldHaveLength, 4)
+		})
+
+		Convey("Nil guarantees", func() {
+			var g *guarantees
+			So(g.apply([]string{"//sandbox/a.cc"}, testsToSkip), ShouldBeEmpty)
+			So(testsToSkip, ShouldHaveLength, 4)
+		})
+	})
+}
@@ -0,0 +1,92 @@
+// Copyright 2021 The Chromium Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package main
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"go.chromium.org/luci/common/errors"
+)
+
+// manifestFileName is the name of the manifest file in the model dir.
+const manifestFileName = "manifest.json"
+
+// modelManifest describes the optional files of a model.
+// Models created before the manifest was introduced do not have it.
+//
+// Example:
+//
+//	{
+//	  "test_file_mapping": {
+//	    "path": "test-file-mapping.jsonl",
+//	    "window_days": 7,
+//	    "tests": 123456
+//	  },
+//	  "file_graph": {
+//	    "path": "git-file-graph",
+//	    "recency_half_life": "8760h0m0s"
+//	  }
+//	}
+type modelManifest struct {
+	// TestFileMapping describes the mapping of tests to files where they are
+	// defined. Nil if the model does not have one.
+	TestFileMapping *testFileMappingEntry `json:"test_file_mapping,omitempty"`
+
+	// FileGraph describes the file graph model.
+	// Nil if the model was created before it was recorded.
+	FileGraph *fileGraphEntry `json:"file_graph,omitempty"`
+}
+
+// fileGraphEntry is a manifest entry of a file graph model.
+type fileGraphEntry struct {
+	// Path is the path to the file graph model dir, relative to the model dir.
+	Path string `json:"path"`
+	// RecencyHalfLife is the half-life of the recency decay the file graph was
+	// built with, in the format of time.Duration.String.
+	// Empty if the file graph was built without the decay.
+	RecencyHalfLife string `json:"recency_half_life,omitempty"`
+}
+
+// testFileMappingEntry is a manifest entry of a test file mapping.
+type testFileMappingEntry struct {
+	// Path is the path to the JSON Lines file of TestLocation messages,
+	// relative to the model dir.
+	Path string `json:"path"`
+	// WindowDays is the number of days of test results the mapping was
+	// built from.
+	WindowDays int `json:"window_days"`
+	// Tests is the number of tests in the mapping.
+	Tests int `json:"tests"`
+}
+
+// readModelManifest reads the manifest in the model dir.
+// Returns an empty manifest if the model does not have one.
+func readModelManifest(modelDir string) (*modelManifest, error) {
+	fileName := filepath.Join(modelDir, manifestFileName)
+	data, err := ioutil.ReadFile(fileName)
+	switch {
+	case os.IsNotExist(err):
+		return &modelManifest{}, nil
+	case err != nil:
+		return nil, err
+	}
+	m := &modelManifest{}
+	if err := json.Unmarshal(data, m); err != nil {
+		return nil, errors.Annotate(err, "failed to parse %q", fileName).Err()
+	}
+	return m, nil
+}
+
+// writeModelManifest writes the manifest to the model dir.
+func writeModelManifest(modelDir string, m *modelManifest) error {
+	data, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(filepath.Join(modelDir, manifestFileName), data, 0666)
+}
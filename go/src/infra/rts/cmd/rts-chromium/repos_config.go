@@ -0,0 +1,72 @@
+// Copyright 2021 The Chromium Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package main
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"path/filepath"
+
+	"go.chromium.org/luci/common/errors"
+
+	"infra/rts/filegraph/git"
+)
+
+// reposConfig lists the git repositories to build the file graph from.
+//
+// Example:
+//
+//	{
+//	  "repos": [
+//	    {"dir": "src", "superproject": true},
+//	    {"dir": "src/v8", "mount_point": "v8"},
+//	    {"dir": "src/third_party/angle", "mount_point": "third_party/angle"}
+//	  ]
+//	}
+type reposConfig struct {
+	Repos []struct {
+		// Dir is the path to the git checkout.
+		// Relative paths are relative to the config file.
+		Dir string `json:"dir"`
+		// MountPoint is where the repository files are mounted in the graph,
+		// relative to the root of src.git. Empty for src.git itself.
+		MountPoint string `json:"mount_point"`
+		// Ref is the git ref to process. Defaults to refs/heads/main.
+		Ref string `json:"ref"`
+		// Superproject indicates that the repository tracks other repositories
+		// of the config as gitlinks at their mount points.
+		Superproject bool `json:"superproject"`
+	} `json:"repos"`
+}
+
+// readReposConfig reads the repositories listed in a reposConfig file.
+func readReposConfig(fileName string) ([]git.Repo, error) {
+	data, err := ioutil.ReadFile(fileName)
+	if err != nil {
+		return nil, err
+	}
+	var cfg reposConfig
+	if err := json.Unmarshal(data, &cfg); err != nil {
+		return nil, errors.Annotate(err, "failed to parse %q", fileName).Err()
+	}
+	if len(cfg.Repos) == 0 {
+		return nil, errors.Reason("no repos in %q", fileName).Err()
+	}
+
+	repos := make([]git.Repo, len(cfg.Repos))
+	for i, r := range cfg.Repos {
+		dir := filepath.FromSlash(r.Dir)
+		if !filepath.IsAbs(dir) {
+			dir = filepath.Join(filepath.Dir(fileName), dir)
+		}
+		repos[i] = git.Repo{
+			Dir:          dir,
+			MountPoint:   r.MountPoint,
+			Ref:          r.Ref,
+			Superproject: r.Superproject,
+		}
+	}
+	return repos, nil
+}
@@ -0,0 +1,51 @@
+// Copyright 2021 The Chromium Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"infra/rts/filegraph/git"
+
+	. "github.com/smartystreets/goconvey/convey"
+	. "go.chromium.org/luci/common/testing/assertions"
+)
+
+func TestReadReposConfig(t *testing.T) {
+	t.Parallel()
+
+	Convey("readReposConfig", t, func() {
+		tmpd, err := ioutil.TempDir("", "rts_repos_config")
+		So(err, ShouldBeNil)
+		defer os.RemoveAll(tmpd)
+		fileName := filepath.Join(tmpd, "repos.json")
+
+		Convey("Works", func() {
+			err := ioutil.WriteFile(fileName, []byte(`{
+				"repos": [
+					{"dir": "src", "superproject": true},
+					{"dir": "/abs/v8", "mount_point": "v8", "ref": "refs/heads/lkgr"}
+				]
+			}`), 0666)
+			So(err, ShouldBeNil)
+
+			repos, err := readReposConfig(fileName)
+			So(err, ShouldBeNil)
+			So(repos, ShouldResemble, []git.Repo{
+				{Dir: filepath.Join(tmpd, "src"), Superproject: true},
+				{Dir: filepath.FromSlash("/abs/v8"), MountPoint: "v8", Ref: "refs/heads/lkgr"},
+			})
+		})
+
+		Convey("No repos", func() {
+			So(ioutil.WriteFile(fileName, []byte(`{"repos": []}`), 0666), ShouldBeNil)
+			_, err := readReposConfig(fileName)
+			So(err, ShouldErrLike, "no repos")
+		})
+	})
+}
@@ -4,8 +4,8 @@
 
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
-// 	protoc-gen-go v1.26.0
-// 	protoc        v3.12.1
+// 	protoc-gen-go v1.27.1
+// 	protoc        v3.17.3
 // source: infra/rts/cmd/rts-chromium/rts-chromium.proto
 
 package main
@@ -98,6 +98,89 @@ func (x *TestFile) GetTestTargets() []string {
 	return nil
 }
 
+// Location of a single test, as reported in ResultDB test metadata.
+//
+// Used in an RTS model, in file "test-file-mapping.jsonl" encoded as JSON
+// Lines of TestLocation protojson messages.
+type TestLocation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// ResultDB test ID, e.g.
+	// "ninja://chrome/test:browser_tests/PasswordAutofillAgentTest.Foo".
+	TestId string `protobuf:"bytes,1,opt,name=test_id,json=testId,proto3" json:"test_id,omitempty"`
+	// Name of the test native to the test framework, e.g.
+	// "PasswordAutofillAgentTest.Foo".
+	TestName string `protobuf:"bytes,2,opt,name=test_name,json=testName,proto3" json:"test_name,omitempty"`
+	// Test target, e.g. "browser_tests".
+	TestTarget string `protobuf:"bytes,3,opt,name=test_target,json=testTarget,proto3" json:"test_target,omitempty"`
+	// Normalized source-absolute path to the file where the test is defined,
+	// e.g. "//chrome/renderer/autofill/password_autofill_agent_browsertest.cc".
+	// Generated files are mapped back to their sources where possible.
+	FileName string `protobuf:"bytes,4,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+}
+
+func (x *TestLocation) Reset() {
+	*x = TestLocation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_infra_rts_cmd_rts_chromium_rts_chromium_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TestLocation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TestLocation) ProtoMessage() {}
+
+func (x *TestLocation) ProtoReflect() protoreflect.Message {
+	mi := &file_infra_rts_cmd_rts_chromium_rts_chromium_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TestLocation.ProtoReflect.Descriptor instead.
+func (*TestLocation) Descriptor() ([]byte, []int) {
+	return file_infra_rts_cmd_rts_chromium_rts_chromium_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *TestLocation) GetTestId() string {
+	if x != nil {
+		return x.TestId
+	}
+	return ""
+}
+
+func (x *TestLocation) GetTestName() string {
+	if x != nil {
+		return x.TestName
+	}
+	return ""
+}
+
+func (x *TestLocation) GetTestTarget() string {
+	if x != nil {
+		return x.TestTarget
+	}
+	return ""
+}
+
+func (x *TestLocation) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
 // Configuration of a git-based selection strategy.
 type GitBasedStrategyConfig struct {
 	state         protoimpl.MessageState
@@ -116,7 +199,7 @@ type GitBasedStrategyConfig struct {
 func (x *GitBasedStrategyConfig) Reset() {
 	*x = GitBasedStrategyConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_infra_rts_cmd_rts_chromium_rts_chromium_proto_msgTypes[1]
+		mi := &file_infra_rts_cmd_rts_chromium_rts_chromium_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -129,7 +212,7 @@ func (x *GitBasedStrategyConfig) String() string {
 func (*GitBasedStrategyConfig) ProtoMessage() {}
 
 func (x *GitBasedStrategyConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_infra_rts_cmd_rts_chromium_rts_chromium_proto_msgTypes[1]
+	mi := &file_infra_rts_cmd_rts_chromium_rts_chromium_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -142,7 +225,7 @@ func (x *GitBasedStrategyConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GitBasedStrategyConfig.ProtoReflect.Descriptor instead.
 func (*GitBasedStrategyConfig) Descriptor() ([]byte, []int) {
-	return file_infra_rts_cmd_rts_chromium_rts_chromium_proto_rawDescGZIP(), []int{1}
+	return file_infra_rts_cmd_rts_chromium_rts_chromium_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *GitBasedStrategyConfig) GetChangeLogDistanceFactor() float32 {
@@ -181,24 +264,32 @@ var file_infra_rts_cmd_rts_chromium_rts_chromium_proto_rawDesc = []byte{
 	0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x74,
 	0x65, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x65, 0x73, 0x74,
 	0x5f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b,
-	0x74, 0x65, 0x73, 0x74, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x73, 0x22, 0xe0, 0x01, 0x0a, 0x16,
-	0x47, 0x69, 0x74, 0x42, 0x61, 0x73, 0x65, 0x64, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79,
-	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x3b, 0x0a, 0x1a, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65,
-	0x5f, 0x6c, 0x6f, 0x67, 0x5f, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x66, 0x61,
-	0x63, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x02, 0x52, 0x17, 0x63, 0x68, 0x61, 0x6e,
-	0x67, 0x65, 0x4c, 0x6f, 0x67, 0x44, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x46, 0x61, 0x63,
-	0x74, 0x6f, 0x72, 0x12, 0x43, 0x0a, 0x1e, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x73, 0x74, 0x72, 0x75,
-	0x63, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x66,
-	0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02, 0x52, 0x1b, 0x66, 0x69, 0x6c,
-	0x65, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x75, 0x72, 0x65, 0x44, 0x69, 0x73, 0x74, 0x61, 0x6e,
-	0x63, 0x65, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x44, 0x0a, 0x0a, 0x74, 0x68, 0x72, 0x65,
-	0x73, 0x68, 0x6f, 0x6c, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x63,
-	0x68, 0x72, 0x6f, 0x6d, 0x65, 0x2e, 0x72, 0x74, 0x73, 0x2e, 0x70, 0x72, 0x65, 0x73, 0x75, 0x62,
-	0x6d, 0x69, 0x74, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f,
-	0x6c, 0x64, 0x52, 0x0a, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x73, 0x42, 0x21,
-	0x5a, 0x1f, 0x69, 0x6e, 0x66, 0x72, 0x61, 0x2f, 0x72, 0x74, 0x73, 0x2f, 0x63, 0x6d, 0x64, 0x2f,
-	0x72, 0x74, 0x73, 0x2d, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x69, 0x75, 0x6d, 0x3b, 0x6d, 0x61, 0x69,
-	0x6e, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x74, 0x65, 0x73, 0x74, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x73, 0x22, 0x82, 0x01, 0x0a, 0x0c,
+	0x54, 0x65, 0x73, 0x74, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x17, 0x0a, 0x07,
+	0x74, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74,
+	0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x65, 0x73, 0x74, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x74, 0x61, 0x72, 0x67, 0x65,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x65, 0x73, 0x74, 0x54, 0x61, 0x72,
+	0x67, 0x65, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65,
+	0x22, 0xe0, 0x01, 0x0a, 0x16, 0x47, 0x69, 0x74, 0x42, 0x61, 0x73, 0x65, 0x64, 0x53, 0x74, 0x72,
+	0x61, 0x74, 0x65, 0x67, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x3b, 0x0a, 0x1a, 0x63,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x6c, 0x6f, 0x67, 0x5f, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e,
+	0x63, 0x65, 0x5f, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x02, 0x52,
+	0x17, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x4c, 0x6f, 0x67, 0x44, 0x69, 0x73, 0x74, 0x61, 0x6e,
+	0x63, 0x65, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x43, 0x0a, 0x1e, 0x66, 0x69, 0x6c, 0x65,
+	0x5f, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x64, 0x69, 0x73, 0x74, 0x61,
+	0x6e, 0x63, 0x65, 0x5f, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02,
+	0x52, 0x1b, 0x66, 0x69, 0x6c, 0x65, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x75, 0x72, 0x65, 0x44,
+	0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x44, 0x0a,
+	0x0a, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x24, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x65, 0x2e, 0x72, 0x74, 0x73, 0x2e, 0x70,
+	0x72, 0x65, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e, 0x54, 0x68,
+	0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x52, 0x0a, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f,
+	0x6c, 0x64, 0x73, 0x42, 0x21, 0x5a, 0x1f, 0x69, 0x6e, 0x66, 0x72, 0x61, 0x2f, 0x72, 0x74, 0x73,
+	0x2f, 0x63, 0x6d, 0x64, 0x2f, 0x72, 0x74, 0x73, 0x2d, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x69, 0x75,
+	0x6d, 0x3b, 0x6d, 0x61, 0x69, 0x6e, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -213,14 +304,15 @@ func file_infra_rts_cmd_rts_chromium_rts_chromium_proto_rawDescGZIP() []byte {
 	return file_infra_rts_cmd_rts_chromium_rts_chromium_proto_rawDescData
 }
 
-var file_infra_rts_cmd_rts_chromium_rts_chromium_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_infra_rts_cmd_rts_chromium_rts_chromium_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
 var file_infra_rts_cmd_rts_chromium_rts_chromium_proto_goTypes = []interface{}{
 	(*TestFile)(nil),               // 0: chrome.rts.TestFile
-	(*GitBasedStrategyConfig)(nil), // 1: chrome.rts.GitBasedStrategyConfig
-	(*proto.Threshold)(nil),        // 2: chrome.rts.presubmit.eval.Threshold
+	(*TestLocation)(nil),           // 1: chrome.rts.TestLocation
+	(*GitBasedStrategyConfig)(nil), // 2: chrome.rts.GitBasedStrategyConfig
+	(*proto.Threshold)(nil),        // 3: chrome.rts.presubmit.eval.Threshold
 }
 var file_infra_rts_cmd_rts_chromium_rts_chromium_proto_depIdxs = []int32{
-	2, // 0: chrome.rts.GitBasedStrategyConfig.thresholds:type_name -> chrome.rts.presubmit.eval.Threshold
+	3, // 0: chrome.rts.GitBasedStrategyConfig.thresholds:type_name -> chrome.rts.presubmit.eval.Threshold
 	1, // [1:1] is the sub-list for method output_type
 	1, // [1:1] is the sub-list for method input_type
 	1, // [1:1] is the sub-list for extension type_name
@@ -247,6 +339,18 @@ func file_infra_rts_cmd_rts_chromium_rts_chromium_proto_init() {
 			}
 		}
 		file_infra_rts_cmd_rts_chromium_rts_chromium_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TestLocation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_infra_rts_cmd_rts_chromium_rts_chromium_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GitBasedStrategyConfig); i {
 			case 0:
 				return &v.state
@@ -265,7 +369,7 @@ func file_infra_rts_cmd_rts_chromium_rts_chromium_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_infra_rts_cmd_rts_chromium_rts_chromium_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   2,
+			NumMessages:   3,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
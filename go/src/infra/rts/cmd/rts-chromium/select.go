@@ -7,6 +7,7 @@ package main
 import (
 	"bufio"
 	"context"
+	"encoding/json"
 	"fmt"
 	"io/ioutil"
 	"os"
@@ -59,6 +60,20 @@ func cmdSelect() *subcommands.Command {
 				It must be a value in (0.0, 1.0) range.
 			`))
 			r.Flags.BoolVar(&r.ignoreExceptions, "ignore-exceptions", false, "For debugging. Whether we should ignore exceptions.")
+			r.Flags.StringVar(&r.guaranteesFile, "guarantees", "", text.Doc(`
+				Path to a JSON file with minimum-test guarantees: rules mapping globs
+				of changed files to test suites that must always run, regardless of
+				the model. Applied after model-based selection. Suites forced
+				to run are reported as "forced by policy" with the matching rule.
+				See guaranteesFile in guarantees.go for the format.
+			`))
+			r.Flags.StringVar(&r.debugOut, "debug-out", "", text.Doc(`
+				For debugging. Path to a file where to write selection decisions
+				in JSON Lines format, one line per test file reachable from the
+				changed files: the test file, the closest changed file, the
+				distance between them and whether the test file was selected.
+				See selectionDecision in strategy.go for the format.
+			`))
 			return r
 		},
 	}
@@ -74,12 +89,20 @@ type selectRun struct {
 	out                string
 	targetChangeRecall float64
 	ignoreExceptions   bool
+	guaranteesFile     string
+	debugOut           string
 
 	// Indirect input.
 
-	testFiles    map[string]*TestFile // indexed by source-absolute test file name
-	changedFiles stringset.Set        // files different between origin/main and the working tree
-	strategy     git.SelectionStrategy
+	testFiles       map[string]*TestFile // indexed by source-absolute test file name
+	testFileMapping *testFileMapping     // nil if the model does not have one
+	changedFiles    stringset.Set        // files different between origin/main and the working tree
+	strategy        git.SelectionStrategy
+	guarantees      *guarantees // nil if -guarantees is not specified
+
+	// Output.
+
+	debugDecisions *json.Encoder // nil if -debug-out is not specified
 }
 
 func (r *selectRun) validateFlags() error {
@@ -124,15 +147,44 @@ func (r *selectRun) Run(a subcommands.Application, args []string, env subcommand
 
 	logging.Infof(ctx, "chosen threshold: %f", r.strategy.MaxDistance)
 
+	closeDebugOut, err := r.openDebugOut()
+	if err != nil {
+		return r.done(err)
+	}
+
 	// Select the tests and write .filter files.
-	err := r.writeFilterFiles()
+	err = r.writeFilterFiles()
 	if disableRTS.In(err) {
 		logging.Warningf(ctx, "disabling RTS: %s", err)
 		err = nil
 	}
+	if closeErr := closeDebugOut(); err == nil {
+		err = closeErr
+	}
 	return r.done(err)
 }
 
+// openDebugOut initializes r.debugDecisions if -debug-out is specified.
+// The returned function flushes and closes the file.
+func (r *selectRun) openDebugOut() (closeFn func() error, err error) {
+	if r.debugOut == "" {
+		return func() error { return nil }, nil
+	}
+	f, err := os.Create(r.debugOut)
+	if err != nil {
+		return nil, errors.Annotate(err, "failed to create %q", r.debugOut).Err()
+	}
+	w := bufio.NewWriter(f)
+	r.debugDecisions = json.NewEncoder(w)
+	return func() error {
+		defer f.Close()
+		if err := w.Flush(); err != nil {
+			return errors.Annotate(err, "failed to write %q", r.debugOut).Err()
+		}
+		return f.Close()
+	}, nil
+}
+
 // writeFilterFiles writes filter files in r.filterFilesDir directory.
 func (r *selectRun) writeFilterFiles() error {
 	// Maps a test target to the list of tests to skip.
@@ -147,6 +199,11 @@ func (r *selectRun) writeFilterFiles() error {
 		return err
 	}
 
+	// No matter what the model said, run the suites guaranteed by the policy.
+	for _, hit := range r.guarantees.apply(r.changedFiles.ToSlice(), testsToSkip) {
+		fmt.Println(hit)
+	}
+
 	// Write the files.
 	for target, testNames := range testsToSkip {
 		fileName := filepath.Join(r.out, target+".filter")
@@ -215,12 +272,47 @@ func (r *selectRun) loadInput(ctx context.Context) error {
 		return errors.Annotate(err, "failed to load test files set").Err()
 	})
 
+	eg.Go(func() (err error) {
+		err = r.loadTestFileMapping()
+		return errors.Annotate(err, "failed to load test file mapping").Err()
+	})
+
 	eg.Go(func() (err error) {
 		err = r.loadChangedFiles()
 		return errors.Annotate(err, "failed to load changed files").Err()
 	})
 
-	return eg.Wait()
+	if r.guaranteesFile != "" {
+		eg.Go(func() (err error) {
+			r.guarantees, err = readGuarantees(r.guaranteesFile)
+			return errors.Annotate(err, "failed to load guarantees").Err()
+		})
+	}
+
+	if err := eg.Wait(); err != nil {
+		return err
+	}
+	if r.testFileMapping != nil {
+		r.testFiles = r.testFileMapping.mapTestFiles(r.testFiles)
+		logging.Infof(ctx, "test file mapping: %s", r.testFileMapping)
+	}
+	r.warnUnknownSuites(ctx)
+	return nil
+}
+
+// warnUnknownSuites logs a warning for each suite pattern in r.guarantees
+// which does not match any test target in r.testFiles.
+func (r *selectRun) warnUnknownSuites(ctx context.Context) {
+	if r.guarantees == nil {
+		return
+	}
+	knownSuites := stringset.New(0)
+	for _, f := range r.testFiles {
+		knownSuites.AddAll(f.TestTargets)
+	}
+	for _, p := range r.guarantees.unknownSuitePatterns(knownSuites) {
+		logging.Warningf(ctx, "guarantees: suite pattern %s does not match any known test suite", p)
+	}
 }
 
 // loadStrategy initializes r.strategy fields, except r.strategy.Graph.
@@ -276,6 +368,34 @@ func (r *selectRun) loadTestFileSet(fileName string) error {
 	})
 }
 
+// loadTestFileMapping loads r.testFileMapping if the model has one.
+func (r *selectRun) loadTestFileMapping() error {
+	manifest, err := readModelManifest(r.modelDir)
+	if err != nil {
+		return err
+	}
+	if manifest.TestFileMapping == nil {
+		return nil
+	}
+
+	f, err := os.Open(filepath.Join(r.modelDir, manifest.TestFileMapping.Path))
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	locs := make([]*TestLocation, 0, manifest.TestFileMapping.Tests)
+	err = readTestLocations(bufio.NewReader(f), func(loc *TestLocation) error {
+		locs = append(locs, loc)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	r.testFileMapping = newTestFileMapping(locs)
+	return nil
+}
+
 // loadChangedFiles initializes r.changedFiles.
 func (r *selectRun) loadChangedFiles() error {
 	changedFiles, err := gitutil.ChangedFiles(r.checkout, "origin/main")
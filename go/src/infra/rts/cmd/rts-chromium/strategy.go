@@ -64,8 +64,25 @@ var (
 	requireAllTestsRegexp = regexp.MustCompile(fmt.Sprintf("^(%s)$", strings.Join(requireAllTests, "|")))
 
 	disableRTS = errors.BoolTag{Key: errors.NewTagKey("skip RTS")}
+
+	// testTargetRe extracts the test target from a test ID, e.g. "browser_tests"
+	// from "ninja://chrome/test:browser_tests/A.B".
+	testTargetRe = regexp.MustCompile(`^ninja://[^:]*:([^/]+)/`)
+
+	variantTestSuite = eval.VariantKey("test_suite")
 )
 
+// testSuite returns the test suite of the test variant, i.e. the test target
+// in its ID, or the value of its "test_suite" variant key if the test ID does
+// not have a target.
+// It is used to break down evaluation results by test suite.
+func testSuite(tv *evalpb.TestVariant) string {
+	if m := testTargetRe.FindStringSubmatch(tv.Id); m != nil {
+		return m[1]
+	}
+	return variantTestSuite(tv)
+}
+
 // selectTests calls skipFile for test files that should be skipped.
 // May return an error annotated with disableRTS tag and the message explaining
 // why RTS was disabled.
@@ -92,17 +109,52 @@ func (r *selectRun) selectTests(skipFile func(*TestFile) error) (err error) {
 			}
 		}
 	}
-	r.strategy.Select(r.changedFiles.ToSlice(), func(fileName string) (keepGoing bool) {
-		file, ok := r.testFiles[fileName]
+
+	if r.debugDecisions == nil {
+		r.strategy.Select(r.changedFiles.ToSlice(), func(fileName string) (keepGoing bool) {
+			file, ok := r.testFiles[fileName]
+			if !ok {
+				return true
+			}
+			err = skipFile(file)
+			return err == nil
+		})
+		return
+	}
+
+	// Same as above, but also explain each decision.
+	r.strategy.SelectVerbose(r.changedFiles.ToSlice(), func(d git.Decision) (keepGoing bool) {
+		file, ok := r.testFiles[d.File]
 		if !ok {
 			return true
 		}
-		err = skipFile(file)
+		err = r.debugDecisions.Encode(&selectionDecision{
+			TestFile:           d.File,
+			ClosestChangedFile: d.ClosestChangedFile,
+			Distance:           d.Distance,
+			Selected:           d.Selected,
+		})
+		if err != nil {
+			err = errors.Annotate(err, "failed to write the decision about %q", d.File).Err()
+			return false
+		}
+		if !d.Selected {
+			err = skipFile(file)
+		}
 		return err == nil
 	})
 	return
 }
 
+// selectionDecision is a line of the -debug-out file of the select
+// subcommand.
+type selectionDecision struct {
+	TestFile           string  `json:"testFile"`
+	ClosestChangedFile string  `json:"closestChangedFile"`
+	Distance           float64 `json:"distance"`
+	Selected           bool    `json:"selected"`
+}
+
 func (r *createModelRun) evalStrategy(er *git.EdgeReader) eval.Strategy {
 	s := &git.SelectionStrategy{
 		Graph:      r.fg,
@@ -126,6 +178,11 @@ func (r *createModelRun) evalStrategy(er *git.EdgeReader) eval.Strategy {
 			}
 		}
 
+		// Prefer test files from the test file mapping.
+		if r.testFileMapping != nil {
+			in.TestVariants = r.testFileMapping.mapTestVariants(in.TestVariants)
+		}
+
 		if err := s.SelectEval(ctx, in, out); err != nil {
 			return err
 		}
@@ -0,0 +1,148 @@
+// Copyright 2021 The Chromium Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"math"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"go.chromium.org/luci/common/data/stringset"
+
+	"infra/rts/filegraph/git"
+	evalpb "infra/rts/presubmit/eval/proto"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestSelectTests(t *testing.T) {
+	t.Parallel()
+
+	Convey(`selectTests`, t, func() {
+		ctx := context.Background()
+
+		tmpd, err := ioutil.TempDir("", "rts_select")
+		So(err, ShouldBeNil)
+		defer os.RemoveAll(tmpd)
+
+		gitCmd := func(args ...string) {
+			args = append([]string{"-C", tmpd, "-c", "user.name=test", "-c", "user.email=test@example.com"}, args...)
+			out, err := exec.Command("git", args...).CombinedOutput()
+			So(err, ShouldBeNil, string(out))
+		}
+		commits := 0
+		commit := func(files ...string) {
+			commits++
+			for _, f := range files {
+				contents := fmt.Sprintf("%s, commit %d\n", f, commits)
+				So(ioutil.WriteFile(filepath.Join(tmpd, f), []byte(contents), 0666), ShouldBeNil)
+				gitCmd("add", f)
+			}
+			gitCmd("commit", "-q", "-m", "commit")
+		}
+
+		// Build the fixture graph:
+		//  - a.cc and a_test.cc are always changed together.
+		//  - b_test.cc is changed together with a.cc once.
+		//  - c_test.cc is never changed together with a.cc.
+		gitCmd("init", "-q")
+		gitCmd("symbolic-ref", "HEAD", "refs/heads/main")
+		commit("a.cc", "a_test.cc")
+		commit("a.cc", "b.cc", "b_test.cc")
+		commit("c.cc", "c_test.cc")
+
+		r := &selectRun{
+			testFiles: map[string]*TestFile{
+				"//a_test.cc":   {Path: "//a_test.cc", TestNames: []string{"A.Test"}, TestTargets: []string{"a_tests"}},
+				"//b_test.cc":   {Path: "//b_test.cc", TestNames: []string{"B.Test"}, TestTargets: []string{"b_tests"}},
+				"//c_test.cc":   {Path: "//c_test.cc", TestNames: []string{"C.Test"}, TestTargets: []string{"c_tests"}},
+				"//new_test.cc": {Path: "//new_test.cc", TestNames: []string{"New.Test"}, TestTargets: []string{"new_tests"}},
+			},
+			changedFiles: stringset.NewFromSlice("//a.cc", "//new_test.cc"),
+		}
+		r.strategy.Graph = &git.Graph{}
+		So(r.strategy.Graph.Update(ctx, tmpd, "refs/heads/main", git.UpdateOptions{}), ShouldBeNil)
+		r.strategy.MaxDistance = 0.5
+
+		var skipped []string
+		skipFile := func(f *TestFile) error {
+			skipped = append(skipped, f.Path)
+			return nil
+		}
+
+		Convey(`Without -debug-out`, func() {
+			So(r.selectTests(skipFile), ShouldBeNil)
+			So(skipped, ShouldResemble, []string{"//b_test.cc"})
+		})
+
+		Convey(`With -debug-out`, func() {
+			buf := &bytes.Buffer{}
+			r.debugDecisions = json.NewEncoder(buf)
+			So(r.selectTests(skipFile), ShouldBeNil)
+			So(skipped, ShouldResemble, []string{"//b_test.cc"})
+
+			// Read the JSONL.
+			decisions := map[string]selectionDecision{}
+			dec := json.NewDecoder(buf)
+			for dec.More() {
+				var d selectionDecision
+				So(dec.Decode(&d), ShouldBeNil)
+				decisions[d.TestFile] = d
+			}
+			So(decisions, ShouldHaveLength, 3)
+
+			So(decisions["//new_test.cc"], ShouldResemble, selectionDecision{
+				TestFile:           "//new_test.cc",
+				ClosestChangedFile: "//new_test.cc",
+				Distance:           0,
+				Selected:           true,
+			})
+
+			a := decisions["//a_test.cc"]
+			So(a.ClosestChangedFile, ShouldEqual, "//a.cc")
+			So(a.Distance, ShouldAlmostEqual, 0, 1e-6)
+			So(a.Selected, ShouldBeTrue)
+
+			b := decisions["//b_test.cc"]
+			So(b.ClosestChangedFile, ShouldEqual, "//a.cc")
+			So(b.Distance, ShouldAlmostEqual, -math.Log(0.5), 1e-6)
+			So(b.Selected, ShouldBeFalse)
+
+			// c_test.cc is not reachable from the changed files.
+			So(decisions, ShouldNotContainKey, "//c_test.cc")
+		})
+	})
+}
+
+func TestTestSuite(t *testing.T) {
+	t.Parallel()
+
+	Convey(`testSuite`, t, func() {
+		Convey(`From test ID`, func() {
+			tv := &evalpb.TestVariant{
+				Id:      "ninja://chrome/test:browser_tests/PasswordAutofillAgentTest.Foo",
+				Variant: []string{"test_suite:browser_tests_on_linux"},
+			}
+			So(testSuite(tv), ShouldEqual, "browser_tests")
+		})
+		Convey(`From variant`, func() {
+			tv := &evalpb.TestVariant{
+				Id:      "tast.lacros.Basic",
+				Variant: []string{"builder:linux-rel", "test_suite:lacros_all_tast_tests"},
+			}
+			So(testSuite(tv), ShouldEqual, "lacros_all_tast_tests")
+		})
+		Convey(`Unknown`, func() {
+			So(testSuite(&evalpb.TestVariant{Id: "ninja://:blink_web_tests"}), ShouldEqual, "")
+		})
+	})
+}
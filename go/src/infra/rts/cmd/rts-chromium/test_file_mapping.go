@@ -0,0 +1,343 @@
+// Copyright 2021 The Chromium Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package main
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"path"
+	"sort"
+	"strings"
+	"sync/atomic"
+
+	"cloud.google.com/go/bigquery"
+	"google.golang.org/api/iterator"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"go.chromium.org/luci/common/data/stringset"
+
+	evalpb "infra/rts/presubmit/eval/proto"
+)
+
+// testFileMappingFileName is the name of the test file mapping file in the
+// model dir.
+const testFileMappingFileName = "test-file-mapping.jsonl"
+
+// testLocationRow is a row of the test location query.
+type testLocationRow struct {
+	TestID     string
+	TestName   string
+	TestTarget bigquery.NullString
+	FileName   string
+	Results    int64
+}
+
+// queryTestLocations reads test locations reported in the past windowDays
+// days and calls back for each row.
+func queryTestLocations(ctx context.Context, bqClient *bigquery.Client, windowDays int) (func(dest interface{}) error, error) {
+	// Use ci_test_results table (not CQ) for the same reasons as
+	// writeTestFiles.
+	q := bqClient.Query(`
+		SELECT
+			test_id as TestID,
+			ANY_VALUE(tr.test_metadata.name) as TestName,
+			ANY_VALUE(REGEXP_EXTRACT(test_id, "ninja://[^:]*:([^/]+)/")) as TestTarget,
+			tr.test_metadata.location.file_name as FileName,
+			COUNT(*) as Results,
+		FROM chrome-luci-data.chromium.ci_test_results tr
+		WHERE partition_time > TIMESTAMP_SUB(CURRENT_TIMESTAMP(), INTERVAL @windowDays DAY)
+			AND tr.test_metadata.location.file_name != ''
+			AND tr.test_metadata.name != ''
+		GROUP BY TestID, FileName
+	`)
+	q.Parameters = []bigquery.QueryParameter{
+		{Name: "windowDays", Value: windowDays},
+	}
+	it, err := q.Read(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return it.Next, nil
+}
+
+// normalizeTestFilePath returns the source-absolute path of the source file
+// that a test file path reported in test metadata corresponds to.
+// Returns "" if the path cannot be mapped to a source file.
+//
+// Normalization rules:
+//   - Paths are made source-absolute, e.g. "chrome/a.cc" -> "//chrome/a.cc",
+//     and cleaned, e.g. "//chrome/./b/../a.cc" -> "//chrome/a.cc".
+//   - Generated files in the output dir are mapped back to the source dir
+//     they were generated from, e.g.
+//     "//out/Release/gen/chrome/a.cc" -> "//chrome/a.cc".
+//   - Paths outside of the source tree, e.g. absolute paths on the bot,
+//     cannot be mapped.
+func normalizeTestFilePath(p string) string {
+	switch {
+	case p == "":
+		return ""
+	case strings.HasPrefix(p, "//"):
+		p = p[2:]
+	case strings.HasPrefix(p, "/"):
+		// An absolute path on the machine that ran the test.
+		return ""
+	}
+
+	p = path.Clean(p)
+	if p == "." || p == ".." || strings.HasPrefix(p, "../") {
+		return ""
+	}
+
+	// Map "out/<config>/gen/<path>" and "out/<config>/obj/<path>" to "<path>".
+	if strings.HasPrefix(p, "out/") {
+		parts := strings.SplitN(p, "/", 4)
+		switch {
+		case len(parts) < 3:
+			return ""
+		case len(parts) == 4 && (parts[2] == "gen" || parts[2] == "obj"):
+			p = parts[3]
+		default:
+			p = strings.Join(parts[2:], "/")
+		}
+	}
+	return "//" + p
+}
+
+// buildTestFileMapping reads test location rows from source and returns
+// the location of each test, ordered by test ID.
+// If source returns iterator.Done, buildTestFileMapping exits.
+//
+// Test file paths are normalized with normalizeTestFilePath.
+// If a test is reported in multiple files, e.g. because it was moved, then
+// the file with most results wins.
+func buildTestFileMapping(ctx context.Context, source func(dest interface{}) error) ([]*TestLocation, error) {
+	tests := map[string]*testFileCandidates{}
+	for {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+
+		var row testLocationRow
+		switch err := source(&row); {
+		case err == iterator.Done:
+			return chooseTestFiles(tests), ctx.Err()
+		case err != nil:
+			return nil, err
+		}
+
+		fileName := normalizeTestFilePath(row.FileName)
+		if fileName == "" {
+			continue
+		}
+		t := tests[row.TestID]
+		if t == nil {
+			t = &testFileCandidates{
+				loc: &TestLocation{
+					TestId:     row.TestID,
+					TestName:   row.TestName,
+					TestTarget: row.TestTarget.StringVal,
+				},
+				results: map[string]int64{},
+			}
+			tests[row.TestID] = t
+		}
+		t.results[fileName] += row.Results
+	}
+}
+
+// testFileCandidates are the files where a test was reported.
+type testFileCandidates struct {
+	loc     *TestLocation
+	results map[string]int64 // results per normalized file name
+}
+
+// chooseTestFiles sets the file name of each test to the one with most
+// results, and returns the tests ordered by test ID.
+func chooseTestFiles(tests map[string]*testFileCandidates) []*TestLocation {
+	ret := make([]*TestLocation, 0, len(tests))
+	for _, t := range tests {
+		var best int64
+		for fileName, n := range t.results {
+			if n > best || (n == best && fileName < t.loc.FileName) {
+				t.loc.FileName = fileName
+				best = n
+			}
+		}
+		ret = append(ret, t.loc)
+	}
+	sort.Slice(ret, func(i, j int) bool {
+		return ret[i].TestId < ret[j].TestId
+	})
+	return ret
+}
+
+// writeTestLocations writes TestLocation protobuf messages to w in JSON Lines
+// format.
+func writeTestLocations(w io.Writer, locs []*TestLocation) error {
+	for _, loc := range locs {
+		jsonBytes, err := protojson.Marshal(loc)
+		if err != nil {
+			return err
+		}
+		if _, err := w.Write(jsonBytes); err != nil {
+			return err
+		}
+		if _, err := fmt.Fprintln(w); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// readTestLocations reads test locations written by writeTestLocations().
+func readTestLocations(r io.Reader, callback func(*TestLocation) error) error {
+	scan := bufio.NewScanner(r)
+	scan.Buffer(nil, 1e7) // 10 MB.
+	for scan.Scan() {
+		loc := &TestLocation{}
+		if err := protojson.Unmarshal(scan.Bytes(), loc); err != nil {
+			return err
+		}
+		if err := callback(loc); err != nil {
+			return err
+		}
+	}
+	return scan.Err()
+}
+
+// testFileMapping maps tests to the files where they are defined.
+type testFileMapping struct {
+	// Number of tests found in the mapping, and the number of tests not found
+	// in the mapping, for which the reported test file was used instead.
+	// Updated atomically, thus they go first for 64-bit alignment.
+	mapped, fallback int64
+
+	byID   map[string]*TestLocation
+	byName map[string][]*TestLocation
+}
+
+// newTestFileMapping returns a mapping of the given test locations.
+func newTestFileMapping(locs []*TestLocation) *testFileMapping {
+	m := &testFileMapping{
+		byID:   make(map[string]*TestLocation, len(locs)),
+		byName: make(map[string][]*TestLocation, len(locs)),
+	}
+	for _, loc := range locs {
+		m.byID[loc.TestId] = loc
+		m.byName[loc.TestName] = append(m.byName[loc.TestName], loc)
+	}
+	return m
+}
+
+// counts returns the number of mapped tests and the number of tests that
+// fell back to the reported test file.
+func (m *testFileMapping) counts() (mapped, fallback int64) {
+	return atomic.LoadInt64(&m.mapped), atomic.LoadInt64(&m.fallback)
+}
+
+// resetCounts resets the counters returned by counts.
+func (m *testFileMapping) resetCounts() {
+	atomic.StoreInt64(&m.mapped, 0)
+	atomic.StoreInt64(&m.fallback, 0)
+}
+
+// String returns a summary of the fallback usage.
+func (m *testFileMapping) String() string {
+	mapped, fallback := m.counts()
+	return fmt.Sprintf("%d tests mapped to files, %d tests fell back to the reported test file", mapped, fallback)
+}
+
+// mapTestVariants returns test variants with file names replaced by the ones
+// in the mapping. Test variants not in the mapping are returned as is.
+// The returned slice corresponds to tvs.
+func (m *testFileMapping) mapTestVariants(tvs []*evalpb.TestVariant) []*evalpb.TestVariant {
+	ret := make([]*evalpb.TestVariant, len(tvs))
+	var mapped, fallback int64
+	for i, tv := range tvs {
+		loc, ok := m.byID[tv.Id]
+		if !ok {
+			ret[i] = tv
+			fallback++
+			continue
+		}
+		mapped++
+		ret[i] = &evalpb.TestVariant{
+			Id:       tv.Id,
+			Variant:  tv.Variant,
+			FileName: loc.FileName,
+		}
+	}
+	atomic.AddInt64(&m.mapped, mapped)
+	atomic.AddInt64(&m.fallback, fallback)
+	return ret
+}
+
+// mapTestFiles returns test files with tests regrouped by the files in the
+// mapping, indexed by source-absolute test file name.
+// Tests not in the mapping stay in the original test file.
+// Files that must always run are excluded.
+func (m *testFileMapping) mapTestFiles(testFiles map[string]*TestFile) map[string]*TestFile {
+	type file struct {
+		names, targets stringset.Set
+	}
+	files := map[string]*file{}
+	add := func(fileName string, name string, targets ...string) {
+		f := files[fileName]
+		if f == nil {
+			f = &file{names: stringset.New(1), targets: stringset.New(1)}
+			files[fileName] = f
+		}
+		f.names.Add(name)
+		f.targets.AddAll(targets)
+	}
+
+	var mapped, fallback int64
+	for _, tf := range testFiles {
+		for _, name := range tf.TestNames {
+			locs := m.testLocations(name, tf.TestTargets)
+			if len(locs) == 0 {
+				add(tf.Path, name, tf.TestTargets...)
+				fallback++
+				continue
+			}
+			for _, loc := range locs {
+				add(loc.FileName, name, loc.TestTarget)
+			}
+			mapped++
+		}
+	}
+	atomic.AddInt64(&m.mapped, mapped)
+	atomic.AddInt64(&m.fallback, fallback)
+
+	ret := make(map[string]*TestFile, len(files))
+	for fileName, f := range files {
+		if mustAlwaysRunTest(fileName) {
+			continue
+		}
+		ret[fileName] = &TestFile{
+			Path:        fileName,
+			TestNames:   f.names.ToSortedSlice(),
+			TestTargets: f.targets.ToSortedSlice(),
+		}
+	}
+	return ret
+}
+
+// testLocations returns locations of the test with the given name in any of
+// the given targets.
+func (m *testFileMapping) testLocations(name string, targets []string) []*TestLocation {
+	var ret []*TestLocation
+	for _, loc := range m.byName[name] {
+		for _, t := range targets {
+			if loc.TestTarget == t {
+				ret = append(ret, loc)
+				break
+			}
+		}
+	}
+	return ret
+}
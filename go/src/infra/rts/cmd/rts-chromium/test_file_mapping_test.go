@@ -0,0 +1,163 @@
+// Copyright 2021 The Chromium Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"cloud.google.com/go/bigquery"
+	"google.golang.org/api/iterator"
+
+	evalpb "infra/rts/presubmit/eval/proto"
+
+	. "github.com/smartystreets/goconvey/convey"
+	. "go.chromium.org/luci/common/testing/assertions"
+)
+
+func TestNormalizeTestFilePath(t *testing.T) {
+	t.Parallel()
+
+	Convey("normalizeTestFilePath", t, func() {
+		cases := []struct {
+			in, out string
+		}{
+			{"//chrome/a_test.cc", "//chrome/a_test.cc"},
+			{"chrome/a_test.cc", "//chrome/a_test.cc"},
+			{"//chrome/./b/../a_test.cc", "//chrome/a_test.cc"},
+			{"//out/Release/gen/chrome/a_test.cc", "//chrome/a_test.cc"},
+			{"//out/Debug/obj/chrome/a_test.cc", "//chrome/a_test.cc"},
+			{"//out/Release/chrome/a_test.js", "//chrome/a_test.js"},
+			{"out/Release/gen/chrome/a_test.cc", "//chrome/a_test.cc"},
+			{"//out/Release", ""},
+			{"/b/s/w/ir/chrome/a_test.cc", ""},
+			{"//../a_test.cc", ""},
+			{"", ""},
+		}
+		for _, c := range cases {
+			So(normalizeTestFilePath(c.in), ShouldEqual, c.out)
+		}
+	})
+}
+
+func TestBuildTestFileMapping(t *testing.T) {
+	t.Parallel()
+
+	Convey("buildTestFileMapping", t, func() {
+		ctx := context.Background()
+		target := bigquery.NullString{StringVal: "browser_tests", Valid: true}
+		rows := []testLocationRow{
+			{TestID: "t1", TestName: "A.B", TestTarget: target, FileName: "//chrome/a_test.cc", Results: 2},
+			{TestID: "t1", TestName: "A.B", TestTarget: target, FileName: "//out/Release/gen/chrome/a_test.cc", Results: 2},
+			{TestID: "t1", TestName: "A.B", TestTarget: target, FileName: "//chrome/old_test.cc", Results: 3},
+			{TestID: "t2", TestName: "C.D", TestTarget: target, FileName: "/b/s/w/ir/c_test.cc", Results: 10},
+			{TestID: "t2", TestName: "C.D", TestTarget: target, FileName: "//chrome/c_test.cc", Results: 1},
+			{TestID: "t3", TestName: "E.F", FileName: "/b/s/w/ir/e_test.cc", Results: 1},
+			{TestID: "t0", TestName: "G.H", TestTarget: target, FileName: "//chrome/z_test.cc", Results: 1},
+			{TestID: "t0", TestName: "G.H", TestTarget: target, FileName: "//chrome/g_test.cc", Results: 1},
+		}
+		source := func(dest interface{}) error {
+			if len(rows) == 0 {
+				return iterator.Done
+			}
+			*dest.(*testLocationRow) = rows[0]
+			rows = rows[1:]
+			return nil
+		}
+
+		locs, err := buildTestFileMapping(ctx, source)
+		So(err, ShouldBeNil)
+		So(locs, ShouldResembleProto, []*TestLocation{
+			// Ties are broken by file name.
+			{TestId: "t0", TestName: "G.H", TestTarget: "browser_tests", FileName: "//chrome/g_test.cc"},
+			// Generated file results count towards the source file.
+			{TestId: "t1", TestName: "A.B", TestTarget: "browser_tests", FileName: "//chrome/a_test.cc"},
+			// Paths outside of the source tree are ignored.
+			{TestId: "t2", TestName: "C.D", TestTarget: "browser_tests", FileName: "//chrome/c_test.cc"},
+		})
+
+		Convey("Round trip", func() {
+			buf := bytes.NewBuffer(nil)
+			So(writeTestLocations(buf, locs), ShouldBeNil)
+
+			var actual []*TestLocation
+			err := readTestLocations(buf, func(loc *TestLocation) error {
+				actual = append(actual, loc)
+				return nil
+			})
+			So(err, ShouldBeNil)
+			So(actual, ShouldResembleProto, locs)
+		})
+	})
+}
+
+func TestTestFileMapping(t *testing.T) {
+	t.Parallel()
+
+	Convey("testFileMapping", t, func() {
+		m := newTestFileMapping([]*TestLocation{
+			{TestId: "ninja://chrome/test:browser_tests/A.B", TestName: "A.B", TestTarget: "browser_tests", FileName: "//chrome/a_test.cc"},
+			{TestId: "ninja://chrome/test:unit_tests/A.B", TestName: "A.B", TestTarget: "unit_tests", FileName: "//chrome/a_unittest.cc"},
+			{TestId: "ninja://chrome/test:browser_tests/C.D", TestName: "C.D", TestTarget: "browser_tests", FileName: "//third_party/c_test.cc"},
+		})
+
+		Convey("mapTestVariants", func() {
+			tvs := []*evalpb.TestVariant{
+				{Id: "ninja://chrome/test:browser_tests/A.B", Variant: []string{"os:linux"}, FileName: "//chrome/reported.cc"},
+				{Id: "ninja://chrome/test:browser_tests/X.Y", FileName: "//chrome/x_test.cc"},
+			}
+			So(m.mapTestVariants(tvs), ShouldResembleProto, []*evalpb.TestVariant{
+				{Id: "ninja://chrome/test:browser_tests/A.B", Variant: []string{"os:linux"}, FileName: "//chrome/a_test.cc"},
+				{Id: "ninja://chrome/test:browser_tests/X.Y", FileName: "//chrome/x_test.cc"},
+			})
+			// The input is not modified.
+			So(tvs[0].FileName, ShouldEqual, "//chrome/reported.cc")
+
+			mapped, fallback := m.counts()
+			So(mapped, ShouldEqual, int64(1))
+			So(fallback, ShouldEqual, int64(1))
+
+			m.resetCounts()
+			mapped, fallback = m.counts()
+			So(mapped, ShouldEqual, int64(0))
+			So(fallback, ShouldEqual, int64(0))
+		})
+
+		Convey("mapTestFiles", func() {
+			testFiles := map[string]*TestFile{
+				"//chrome/reported.cc": {
+					Path:        "//chrome/reported.cc",
+					TestNames:   []string{"A.B", "X.Y"},
+					TestTargets: []string{"browser_tests"},
+				},
+				"//chrome/c_reported.cc": {
+					Path:        "//chrome/c_reported.cc",
+					TestNames:   []string{"C.D"},
+					TestTargets: []string{"browser_tests"},
+				},
+			}
+			actual := m.mapTestFiles(testFiles)
+			// C.D is mapped to a file that must always run.
+			So(actual, ShouldHaveLength, 2)
+			So(actual["//chrome/a_test.cc"], ShouldResembleProto, &TestFile{
+				Path:        "//chrome/a_test.cc",
+				TestNames:   []string{"A.B"},
+				TestTargets: []string{"browser_tests"},
+			})
+			// X.Y is not in the mapping.
+			So(actual["//chrome/reported.cc"], ShouldResembleProto, &TestFile{
+				Path:        "//chrome/reported.cc",
+				TestNames:   []string{"X.Y"},
+				TestTargets: []string{"browser_tests"},
+			})
+
+			mapped, fallback := m.counts()
+			So(mapped, ShouldEqual, int64(2))
+			So(fallback, ShouldEqual, int64(1))
+			So(m.String(), ShouldEqual, "2 tests mapped to files, 1 tests fell back to the reported test file")
+		})
+	})
+}
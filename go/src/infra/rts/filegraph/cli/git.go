@@ -37,6 +37,18 @@ func (g *gitGraph) RegisterFlags(fs *flag.FlagSet) {
 		The rationale is that large commits provide a weak signal of file
 		relatedness and are expensive to process, O(N^2).
 	`))
+	fs.IntVar(&g.opt.RenameSimilarityThreshold, "rename-threshold", 0, text.Doc(`
+		Minimum similarity, in percent, of a removed and an added file for git to
+		detect a rename. Histories of renamed files are merged.
+		If 0, the git default is used. If negative, renames are not detected.
+	`))
+	fs.BoolVar(&g.opt.DetectCopies, "detect-copies", false, text.Doc(`
+		Detect copies of files, with the same threshold as renames.
+	`))
+	fs.DurationVar(&g.opt.RecencyHalfLife, "recency-half-life", 0, text.Doc(`
+		If positive, weight each commit by its recency, halving the weight of
+		co-changes every this long, e.g. 8760h.
+	`))
 	fs.Float64Var(&g.maxDistance, "max-distance", 0, text.Doc(`
 		If positive, the distance threshold. Nodes further than this are considered
 		unreachable.
@@ -58,6 +70,12 @@ func (g *gitGraph) Validate() error {
 	if g.opt.MaxCommitSize < 0 {
 		return errors.Reason("-max-commit-size must be non-negative").Err()
 	}
+	if g.opt.RenameSimilarityThreshold > 100 {
+		return errors.Reason("-rename-threshold must be at most 100").Err()
+	}
+	if g.opt.RecencyHalfLife < 0 {
+		return errors.Reason("-recency-half-life must be non-negative").Err()
+	}
 	return nil
 }
 
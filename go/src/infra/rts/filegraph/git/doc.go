@@ -28,6 +28,21 @@
 //
 // This graph defines distance only between files, and not directories.
 //
+// Recency decay
+//
+// Optionally, the contribution of each commit to the sums above is weighted by
+//
+//  weight(c) = 2^(-age(c)/halfLife) = exp(-age(c)*ln(2)/halfLife)
+//
+// where age(c) is the time between the commit and a reference date, so that
+// co-changes of files long ago weigh less than recent ones. The half-life is
+// an option of the graph, see UpdateOptions.RecencyHalfLife.
+//
+// Multiplying the weights of all commits by the same factor does not change
+// the distances, because they are ratios of sums over the same commits. Thus
+// the reference date does not matter, and a graph can be updated with new
+// commits without recomputing the weights of old ones.
+//
 // File-structure-based distance
 //
 // This distance is derived from the file structure. It is the number of edges
@@ -50,4 +65,13 @@
 //
 // This distance formula is disabled by default, and can be enabled in
 // EdgeReader.
+//
+// Multiple repositories
+//
+// A graph can be built from multiple repositories, see Graph.UpdateMulti.
+// The files of each repository are mounted at a directory of the graph,
+// e.g. //v8/BUILD.gn for a v8 checkout mounted at "v8". Files of different
+// repositories are related by superproject commits that roll the other
+// repositories: such a commit is treated as if it touched the files changed by
+// the roll, along with the superproject files it touched, e.g. //DEPS.
 package git
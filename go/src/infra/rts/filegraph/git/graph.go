@@ -9,6 +9,7 @@ import (
 	"sort"
 	"strings"
 	"sync"
+	"time"
 
 	"infra/rts/filegraph"
 )
@@ -25,8 +26,22 @@ import (
 // TODO(nodir): introduce a decay function to remove old nodes/edges.
 type Graph struct {
 	// Commit is the git commit that the graph state corresponds to.
+	// Empty for graphs built from multiple repositories, see Repos.
 	Commit string
 
+	// Repos are the states of the repositories that the graph was built from,
+	// if it was built by UpdateMulti.
+	Repos []RepoState
+
+	// RecencyHalfLife is the half-life of the weights of commits, see
+	// UpdateOptions.RecencyHalfLife. Zero if commits are not weighted.
+	// It is set by the first update of the graph.
+	RecencyHalfLife time.Duration
+
+	// recencyAnchor is the commit date at which a commit weighs recencyUnit.
+	// See recency.go.
+	recencyAnchor time.Time
+
 	root node
 	init sync.Once
 }
@@ -64,6 +79,7 @@ type node struct {
 	// in the probability that |edge.to| file is relevant to this file.
 	// This is roughly the number of commits that touched this file,
 	// but excludes some commits, see apply() logic.
+	// With recency decay, it is the sum of the weights of the commits.
 	probSumDenominator int
 
 	// edges are outgoing edges.
@@ -109,6 +125,8 @@ type edge struct {
 	// probSum is the sum of the probabilites that `to` appears
 	// in a commit, for each commit that touched the `from` node.
 	// This is explained in doc.go.
+	// With recency decay, each probability is multiplied by the weight of the
+	// commit.
 	//
 	// If 0, then this edge is an alias.
 	probSum probability
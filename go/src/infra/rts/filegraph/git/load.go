@@ -7,6 +7,8 @@ package git
 import (
 	"bufio"
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"io"
 	"os"
@@ -45,79 +47,108 @@ func Load(ctx context.Context, repoDir string, opt LoadOptions) (*Graph, error)
 		return nil, errors.Reason(`opt.Ref must start with "refs/"`).Err()
 	}
 
-	// Open the cache file and try to read it.
-	cache, err := openGraphCache(repoDir, opt)
+	cache, err := openGraphCache(repoDir, filepath.FromSlash(opt.Ref), opt.UpdateOptions)
 	if err != nil {
 		return nil, err
 	}
 	defer cache.Close()
 
-	g, err := cache.tryReading(ctx)
+	tillRev, err := resolveRef(repoDir, opt.Ref)
 	if err != nil {
 		return nil, err
 	}
 
-	// Fallback from main to master if needed.
-	tillRev := opt.Ref
-	if tillRev == "refs/heads/main" {
-		switch exists, err := gitutil.RefExists(repoDir, tillRev); {
-		case err != nil:
-			return nil, err
-		case !exists:
-			tillRev = "refs/heads/master"
+	validate := func(g *Graph) error {
+		if len(g.Repos) > 0 {
+			return errors.Reason("the graph was built from multiple repositories").Err()
 		}
+		return g.checkRecencyHalfLife(opt.RecencyHalfLife)
 	}
+	return cache.sync(ctx, opt.UpdateOptions, validate, func(g *Graph, uopt UpdateOptions) error {
+		return g.Update(ctx, repoDir, tillRev, uopt)
+	})
+}
 
-	// Sync the graph with new commits.
-	processed := 0
-	dirty := false
-	uopt := opt.UpdateOptions // make a copy
-	uopt.Callback = func() error {
-		dirty = true
-		processed++
-		if processed%1e5 == 0 {
-			if err := cache.write(g); err != nil {
-				return errors.Annotate(err, "failed to write the graph to %q", cache.Name()).Err()
-			}
-			dirty = false
-			logging.Infof(ctx, "processed %d commits; currently at %s", processed, g.Commit)
-		}
+// LoadMulti is like Load, but returns a file graph for multiple git
+// repositories, see (*Graph).UpdateMulti.
+// Caches the graph under the .git directory of the first repository.
+//
+// If the cached graph was built for another repository set, it is rebuilt.
+func LoadMulti(ctx context.Context, repos []Repo, opt UpdateOptions) (*Graph, error) {
+	if err := validateRepos(repos); err != nil {
+		return nil, err
+	}
 
-		// Call the original callback, if any.
-		if opt.Callback != nil {
-			return opt.Callback()
+	// Key the cache by the repository set.
+	h := sha256.New()
+	for _, r := range repos {
+		fmt.Fprintf(h, "%s\n%s\n", r.MountPoint, r.Ref)
+	}
+	cacheDir := filepath.Join("multi", hex.EncodeToString(h.Sum(nil))[:16])
+	cache, err := openGraphCache(repos[0].Dir, cacheDir, opt)
+	if err != nil {
+		return nil, err
+	}
+	defer cache.Close()
+
+	validate := func(g *Graph) error {
+		if g.Commit != "" {
+			return errors.Reason("the graph was built from a single repository").Err()
 		}
-		return nil
+		if err := g.checkRepos(repos); err != nil {
+			return err
+		}
+		return g.checkRecencyHalfLife(opt.RecencyHalfLife)
 	}
-	switch err := g.Update(ctx, repoDir, tillRev, uopt); {
+	return cache.sync(ctx, opt, validate, func(g *Graph, uopt UpdateOptions) error {
+		return g.UpdateMulti(ctx, repos, uopt)
+	})
+}
+
+// resolveRef returns the ref to read the log of a repository from.
+// Defaults to refs/heads/main, and falls back from main to master if needed.
+func resolveRef(repoDir, ref string) (string, error) {
+	if ref == "" {
+		ref = "refs/heads/main"
+	}
+	if ref != "refs/heads/main" {
+		return ref, nil
+	}
+	switch exists, err := gitutil.RefExists(repoDir, ref); {
 	case err != nil:
-		return nil, errors.Annotate(err, "failed to update the graph").Err()
-	case dirty:
-		if err := cache.write(g); err != nil {
-			return nil, errors.Annotate(err, "failed to write the graph to %q", cache.Name()).Err()
-		}
+		return "", err
+	case !exists:
+		return "refs/heads/master", nil
+	default:
+		return ref, nil
 	}
-	return g, nil
 }
 
 type graphCache struct {
 	*os.File
 }
 
-// openGraphCache returns a graphCache.
+// openGraphCache returns a graphCache for graphs under subDir of the
+// filegraph cache directory, e.g. the ref the graph is built for, built
+// with the given options.
 // The caller is responsible for closing it.
-func openGraphCache(repoDir string, opt LoadOptions) (*graphCache, error) {
+func openGraphCache(repoDir, subDir string, opt UpdateOptions) (*graphCache, error) {
 	gitDir, err := gitutil.Exec(repoDir)("rev-parse", "--absolute-git-dir")
 	if err != nil {
 		return nil, err
 	}
 
-	fileName := filepath.Join(
-		gitDir,
-		"filegraph",
-		filepath.FromSlash(opt.Ref),
-		fmt.Sprintf("fg.max-commit-size-%d.v0", opt.MaxCommitSize),
-	)
+	baseName := fmt.Sprintf("fg.max-commit-size-%d", opt.MaxCommitSize)
+	if opt.RenameSimilarityThreshold != 0 {
+		baseName += fmt.Sprintf(".renames-%d", opt.RenameSimilarityThreshold)
+	}
+	if opt.DetectCopies {
+		baseName += ".copies"
+	}
+	if opt.RecencyHalfLife != 0 {
+		baseName += fmt.Sprintf(".recency-half-life-%s", opt.RecencyHalfLife)
+	}
+	fileName := filepath.Join(gitDir, "filegraph", subDir, baseName+".v0")
 
 	if err := os.MkdirAll(filepath.Dir(fileName), 0777); err != nil {
 		return nil, err
@@ -158,6 +189,51 @@ func (c *graphCache) tryReading(ctx context.Context) (*Graph, error) {
 	return g, nil
 }
 
+// sync reads the graph from the cache, brings it up to date using update and
+// writes it back to the cache, periodically and at the end.
+// A cached graph which fails validation is discarded.
+func (c *graphCache) sync(ctx context.Context, opt UpdateOptions, validate func(*Graph) error, update func(*Graph, UpdateOptions) error) (*Graph, error) {
+	g, err := c.tryReading(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := validate(g); err != nil {
+		logging.Warningf(ctx, "cache is stale: %s\npopulating cache...", err)
+		g = &Graph{}
+	}
+
+	// Sync the graph with new commits.
+	processed := 0
+	dirty := false
+	uopt := opt // make a copy
+	uopt.Callback = func() error {
+		dirty = true
+		processed++
+		if processed%1e5 == 0 {
+			if err := c.write(g); err != nil {
+				return errors.Annotate(err, "failed to write the graph to %q", c.Name()).Err()
+			}
+			dirty = false
+			logging.Infof(ctx, "processed %d commits", processed)
+		}
+
+		// Call the original callback, if any.
+		if opt.Callback != nil {
+			return opt.Callback()
+		}
+		return nil
+	}
+	switch err := update(g, uopt); {
+	case err != nil:
+		return nil, errors.Annotate(err, "failed to update the graph").Err()
+	case dirty:
+		if err := c.write(g); err != nil {
+			return nil, errors.Annotate(err, "failed to write the graph to %q", c.Name()).Err()
+		}
+	}
+	return g, nil
+}
+
 // write writes the graph to the cache.
 func (c *graphCache) write(g *Graph) error {
 	// Write the graph to the beginning of the file.
@@ -10,6 +10,8 @@ import (
 	"context"
 	"io"
 	"os/exec"
+	"strconv"
+	"time"
 
 	"go.chromium.org/luci/common/errors"
 )
@@ -18,6 +20,10 @@ type commit struct {
 	Hash         string
 	ParentHashes []string
 	Files        []fileChange
+
+	// Time is the committer date. It is read only if requested, see
+	// logReader.withTime.
+	Time time.Time
 }
 
 type fileChange struct {
@@ -25,26 +31,52 @@ type fileChange struct {
 	// For more info, see --diff-filter in https://git-scm.com/docs/git-diff
 	Status byte
 	Path   string
-	Path2  string // populated if Status is 'R'
+	Path2  string // populated if Status is 'R' or 'C'
+
+	// Gitlink is populated if the file is a gitlink, i.e. a submodule.
+	Gitlink *gitlinkChange
+}
+
+// gitlinkChange is a change of the commit that a gitlink points to.
+type gitlinkChange struct {
+	// OldCommit and NewCommit are the commits before and after the change.
+	// OldCommit is zeroHash if the gitlink was added, and NewCommit is zeroHash
+	// if it was removed.
+	OldCommit string
+	NewCommit string
 }
 
+// gitlinkMode is the file mode of gitlinks in git raw diff output.
+const gitlinkMode = "160000"
+
+// zeroHash is the hash git uses for the missing side of an added or removed
+// file.
+const zeroHash = "0000000000000000000000000000000000000000"
+
 // readLog calls the callback for each commit reachable from `rev` and not
 // reachable from `exclude`. The order of commits is "reversed", i.e. ancestors
-// first.
-func readLog(ctx context.Context, repoDir, exclude, rev string, callback func(commit) error) (err error) {
+// first. diffArgs are additional git-log flags, e.g. for rename detection.
+// If withTime is true, commit.Time is populated.
+func readLog(ctx context.Context, repoDir, exclude, rev string, diffArgs []string, withTime bool, callback func(commit) error) (err error) {
 	ctx, cancel := context.WithCancel(ctx)
 	defer cancel()
 
 	// Run git-log.
+	format := "--format=format:%H %P"
+	if withTime {
+		format = "--format=format:%H %ct %P"
+	}
 	args := []string{
 		"-C", repoDir,
 		"log",
-		"--format=format:%H %P",
+		format,
 		"--raw",
+		"--no-abbrev",
 		"-z",
 		"--reverse",
-		rev,
 	}
+	args = append(args, diffArgs...)
+	args = append(args, rev)
 	if exclude != "" {
 		args = append(args, "^"+exclude)
 	}
@@ -64,7 +96,7 @@ func readLog(ctx context.Context, repoDir, exclude, rev string, callback func(co
 	}
 	defer cmd.Wait()
 
-	reader := &logReader{r: bufio.NewReader(stdout)}
+	reader := &logReader{r: bufio.NewReader(stdout), withTime: withTime}
 	if err := reader.ReadCommits(callback); err != nil {
 		return err
 	}
@@ -75,11 +107,34 @@ func readLog(ctx context.Context, repoDir, exclude, rev string, callback func(co
 	return nil
 }
 
+// readDiff returns the files changed between two commits.
+// diffArgs are additional git-diff flags, e.g. for rename detection.
+func readDiff(ctx context.Context, repoDir, from, to string, diffArgs []string) ([]fileChange, error) {
+	args := []string{"-C", repoDir, "diff", "--raw", "--no-abbrev", "-z"}
+	args = append(args, diffArgs...)
+	args = append(args, from, to)
+	cmd := exec.CommandContext(ctx, "git", args...)
+	stderr := &bytes.Buffer{}
+	cmd.Stderr = stderr
+	out, err := cmd.Output()
+	if err != nil {
+		return nil, errors.Annotate(err, "git diff failed: %s", stderr).Err()
+	}
+
+	reader := &logReader{r: bufio.NewReader(bytes.NewReader(out))}
+	return reader.readFileChanges()
+}
+
 // logReader parses a git log formatted as
 //   --format=format:"%H %P" --raw --z
+// or, if withTime is true, as
+//   --format=format:"%H %ct %P" --raw --z
 type logReader struct {
 	r *bufio.Reader
 
+	// withTime means the log has the committer date of each commit.
+	withTime bool
+
 	// hashBuf is used to read commit hash.
 	hashBuf [40]byte
 
@@ -134,6 +189,13 @@ func (r *logReader) ReadCommit() (commit, error) {
 		return c, errors.Reason("expected ' ', got %d", b).Err()
 	}
 
+	// Read the committer date, followed by a space too.
+	if r.withTime {
+		if c.Time, err = r.readTime(); err != nil {
+			return c, errors.Annotate(err, "failed to read the commit time").Err()
+		}
+	}
+
 	// Read the parent hashes, if any.
 	switch b, err := r.r.Peek(1); {
 	case err != nil:
@@ -199,12 +261,17 @@ func (r *logReader) readFileChanges() (changes []fileChange, err error) {
 func (r *logReader) readFileChange() (fc fileChange, err error) {
 	// Format doc: https://git-scm.com/docs/git-diff#_raw_output_format
 
-	// Skip 4 sub-blocks, each one ending with space.
-	for i := 0; i < 4; i++ {
-		if _, err = r.readString(' '); err != nil {
+	// Read 4 sub-blocks, each one ending with space: the old and new modes,
+	// and the old and new hashes. Only gitlinks need them.
+	var blocks [4]string
+	for i := range blocks {
+		if blocks[i], err = r.readString(' '); err != nil {
 			return
 		}
 	}
+	if blocks[0] == gitlinkMode || blocks[1] == gitlinkMode {
+		fc.Gitlink = &gitlinkChange{OldCommit: blocks[2], NewCommit: blocks[3]}
+	}
 
 	// Read status.
 	switch status, err := r.readString(r.sep); {
@@ -244,6 +311,19 @@ func (r *logReader) readHash() (string, error) {
 	return ret, nil
 }
 
+// readTime reads a unix timestamp followed by a space.
+func (r *logReader) readTime() (time.Time, error) {
+	s, err := r.readString(' ')
+	if err != nil {
+		return time.Time{}, err
+	}
+	sec, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return time.Unix(sec, 0).UTC(), nil
+}
+
 // readString reads a string until the delimiter.
 // The returned string does not include the delimiter.
 func (r *logReader) readString(delim byte) (string, error) {
@@ -8,6 +8,7 @@ import (
 	"bufio"
 	"strings"
 	"testing"
+	"time"
 
 	. "github.com/smartystreets/goconvey/convey"
 )
@@ -148,6 +149,25 @@ func TestLogReader(t *testing.T) {
 			})
 		})
 
+		Convey(`with time`, func() {
+			r := &logReader{r: bufio.NewReader(strings.NewReader(`a3dcd10d73c46ea826785d03b7aa35e294d0f12a 1600000000 91b7cf4d4e8b259f7657b6149e3393b166a7aaee
+:100644 100644 8150c0c9b 8f04adce2 M|path/to/file||`)), withTime: true}
+			r.sep = '|'
+			actual, err := r.ReadCommit()
+			So(err, ShouldBeNil)
+			So(actual, ShouldResemble, commit{
+				Hash:         "a3dcd10d73c46ea826785d03b7aa35e294d0f12a",
+				Time:         time.Unix(1600000000, 0).UTC(),
+				ParentHashes: []string{"91b7cf4d4e8b259f7657b6149e3393b166a7aaee"},
+				Files: []fileChange{
+					{
+						Status: 'M',
+						Path:   "path/to/file",
+					},
+				},
+			})
+		})
+
 		Convey(`empty commit`, func() {
 			actual := read(`f71d633d037422e101edb573038b8e281f283d16 |2f1870f85324ed520077653a2c8a881e9052d96c f71d633d037422e101edb573038b8e281f283d16
 :100644 100644 8150c0c9b 8f04adce2 M|path/to/file2||`)
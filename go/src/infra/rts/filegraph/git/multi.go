@@ -0,0 +1,221 @@
+// Copyright 2021 The Chromium Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package git
+
+import (
+	"context"
+	"path"
+	"strings"
+
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/common/logging"
+)
+
+// Repo is a git repository merged into a multi-repository graph.
+type Repo struct {
+	// Dir is the path to the git repository.
+	Dir string
+
+	// MountPoint is the path where the repository files are mounted in the
+	// graph, e.g. files of a repository mounted at "v8" are named "//v8/...".
+	// Empty means the root of the graph.
+	MountPoint string
+
+	// Ref is the git ref to process.
+	// Defaults to refs/heads/main.
+	//
+	// If it is refs/heads/main, but it does not exist, then falls back to
+	// refs/heads/master.
+	Ref string
+
+	// Superproject indicates that the repository tracks other repositories of
+	// the graph as gitlinks (submodules) at their mount points.
+	//
+	// A gitlink change in the superproject log, e.g. a DEPS roll, is expanded to
+	// the files changed in the rolled repository between the old and the new
+	// commit. This is what relates files across repositories, e.g. //DEPS and
+	// //v8/BUILD.gn.
+	Superproject bool
+}
+
+// RepoState is the state of a repository in a multi-repository graph.
+type RepoState struct {
+	// MountPoint is the Repo.MountPoint of the repository.
+	MountPoint string
+
+	// Commit is the git commit of the repository that the graph state
+	// corresponds to.
+	Commit string
+}
+
+// UpdateMulti updates a graph based on changes in multiple git repositories.
+// Each repository's files are named relative to its mount point.
+// Applies all changes reachable from each repository's ref, but not from its
+// commit in g.Repos, and updates g.Repos.
+//
+// The repository set, identified by mount points, must be the same as the one
+// the graph was built for. An empty graph is initialized with the repository
+// set.
+//
+// If returns an error which wasn't returned by the callback, then it is
+// possible that the graph is corrupted.
+func (g *Graph) UpdateMulti(ctx context.Context, repos []Repo, opt UpdateOptions) error {
+	g.ensureInitialized()
+	if err := validateRepos(repos); err != nil {
+		return err
+	}
+	if err := opt.validate(); err != nil {
+		return err
+	}
+	diffArgs := opt.diffArgs()
+	withTime := opt.RecencyHalfLife > 0
+
+	switch {
+	case g.Commit != "":
+		return errors.Reason("the graph was built from a single repository").Err()
+	case len(g.Repos) == 0:
+		g.Repos = make([]RepoState, len(repos))
+		for i, r := range repos {
+			g.Repos[i].MountPoint = r.MountPoint
+		}
+		g.RecencyHalfLife = opt.RecencyHalfLife
+	default:
+		if err := g.checkRepos(repos); err != nil {
+			return err
+		}
+		if err := g.checkRecencyHalfLife(opt.RecencyHalfLife); err != nil {
+			return err
+		}
+	}
+
+	// Map mount points to repositories, to expand gitlinks.
+	mounted := make(map[string]*Repo, len(repos))
+	for i := range repos {
+		mounted[repos[i].MountPoint] = &repos[i]
+	}
+
+	for i, r := range repos {
+		rev, err := resolveRef(r.Dir, r.Ref)
+		if err != nil {
+			return err
+		}
+
+		state := &g.Repos[i]
+		err = readLog(ctx, r.Dir, state.Commit, rev, diffArgs, withTime, func(c commit) error {
+			files := mountFileChanges(r.MountPoint, c.Files)
+			if r.Superproject {
+				files = expandGitlinks(ctx, files, mounted, diffArgs)
+			}
+			if err := g.applyWeighted(files, opt.MaxCommitSize, g.commitWeight(c.Time)); err != nil {
+				return errors.Annotate(err, "failed to apply commit %s", c.Hash).Err()
+			}
+
+			state.Commit = c.Hash
+			if opt.Callback != nil {
+				return opt.Callback()
+			}
+			return nil
+		})
+		if err != nil {
+			return errors.Annotate(err, "failed to process repository %q mounted at %q", r.Dir, r.MountPoint).Err()
+		}
+	}
+	return nil
+}
+
+// checkRepos returns an error if the graph was built for another repository
+// set.
+func (g *Graph) checkRepos(repos []Repo) error {
+	have := make([]string, len(g.Repos))
+	for i, r := range g.Repos {
+		have[i] = r.MountPoint
+	}
+	want := make([]string, len(repos))
+	for i, r := range repos {
+		want[i] = r.MountPoint
+	}
+	if strings.Join(have, "\n") != strings.Join(want, "\n") {
+		return errors.Reason("the graph was built for repositories mounted at %q, not %q", have, want).Err()
+	}
+	return nil
+}
+
+// validateRepos returns an error if the repository set is invalid.
+func validateRepos(repos []Repo) error {
+	if len(repos) == 0 {
+		return errors.Reason("no repositories").Err()
+	}
+	seen := make(map[string]struct{}, len(repos))
+	for _, r := range repos {
+		switch {
+		case r.Dir == "":
+			return errors.Reason("repository mounted at %q has no dir", r.MountPoint).Err()
+		case r.Ref != "" && !strings.HasPrefix(r.Ref, "refs/"):
+			return errors.Reason("ref %q of repository %q must start with \"refs/\"", r.Ref, r.Dir).Err()
+		case r.MountPoint != "" && path.Clean(r.MountPoint) != r.MountPoint,
+			strings.HasPrefix(r.MountPoint, "/"),
+			strings.HasPrefix(r.MountPoint, "../"),
+			r.MountPoint == "." || r.MountPoint == "..":
+			return errors.Reason("invalid mount point %q of repository %q; expected a clean relative path", r.MountPoint, r.Dir).Err()
+		}
+		if _, ok := seen[r.MountPoint]; ok {
+			return errors.Reason("mount point %q is used by more than one repository", r.MountPoint).Err()
+		}
+		seen[r.MountPoint] = struct{}{}
+	}
+	return nil
+}
+
+// mountPath returns the path of a repository file in the graph, without the
+// "//" prefix.
+func mountPath(mountPoint, p string) string {
+	if mountPoint == "" || p == "" {
+		return p
+	}
+	return mountPoint + "/" + p
+}
+
+// mountFileChanges returns the file changes with paths relative to the mount
+// point.
+func mountFileChanges(mountPoint string, fcs []fileChange) []fileChange {
+	if mountPoint == "" {
+		return fcs
+	}
+	ret := make([]fileChange, len(fcs))
+	for i, fc := range fcs {
+		fc.Path = mountPath(mountPoint, fc.Path)
+		fc.Path2 = mountPath(mountPoint, fc.Path2)
+		ret[i] = fc
+	}
+	return ret
+}
+
+// expandGitlinks replaces changes of gitlinks at the mount points of other
+// repositories with the files changed in those repositories.
+//
+// Gitlinks which were added or removed are dropped, and so are the ones that
+// cannot be expanded, e.g. because the rolled repository was not fetched
+// recently enough.
+func expandGitlinks(ctx context.Context, fcs []fileChange, mounted map[string]*Repo, diffArgs []string) []fileChange {
+	ret := make([]fileChange, 0, len(fcs))
+	for _, fc := range fcs {
+		repo := mounted[fc.Path]
+		if fc.Gitlink == nil || repo == nil {
+			ret = append(ret, fc)
+			continue
+		}
+
+		if fc.Gitlink.OldCommit == zeroHash || fc.Gitlink.NewCommit == zeroHash {
+			continue
+		}
+		rolled, err := readDiff(ctx, repo.Dir, fc.Gitlink.OldCommit, fc.Gitlink.NewCommit, diffArgs)
+		if err != nil {
+			logging.Warningf(ctx, "failed to expand the roll of %q from %s to %s: %s", fc.Path, fc.Gitlink.OldCommit, fc.Gitlink.NewCommit, err)
+			continue
+		}
+		ret = append(ret, mountFileChanges(repo.MountPoint, rolled)...)
+	}
+	return ret
+}
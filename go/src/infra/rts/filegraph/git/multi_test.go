@@ -0,0 +1,183 @@
+// Copyright 2021 The Chromium Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package git
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"infra/rts/filegraph"
+
+	. "github.com/smartystreets/goconvey/convey"
+	. "go.chromium.org/luci/common/testing/assertions"
+)
+
+// fixtureRepo is a git repository created for a test.
+type fixtureRepo struct {
+	dir string
+}
+
+func newFixtureRepo(dir string) *fixtureRepo {
+	So(os.MkdirAll(dir, 0777), ShouldBeNil)
+	r := &fixtureRepo{dir: dir}
+	r.git("init", "-q")
+	r.git("symbolic-ref", "HEAD", "refs/heads/main")
+	return r
+}
+
+func (r *fixtureRepo) git(args ...string) string {
+	args = append([]string{"-C", r.dir, "-c", "user.name=test", "-c", "user.email=test@example.com"}, args...)
+	out, err := exec.Command("git", args...).CombinedOutput()
+	So(err, ShouldBeNil)
+	return strings.TrimSpace(string(out))
+}
+
+// commit commits the files, with the given contents, and the gitlinks, which
+// map paths to commits. Returns the commit hash.
+func (r *fixtureRepo) commit(files map[string]string, gitlinks map[string]string) string {
+	for name, contents := range files {
+		So(ioutil.WriteFile(filepath.Join(r.dir, name), []byte(contents), 0666), ShouldBeNil)
+		r.git("add", name)
+	}
+	for name, commit := range gitlinks {
+		r.git("update-index", "--add", "--cacheinfo", "160000,"+commit+","+name)
+	}
+	r.git("commit", "-q", "-m", "commit")
+	return r.git("rev-parse", "HEAD")
+}
+
+func TestUpdateMulti(t *testing.T) {
+	t.Parallel()
+
+	Convey(`UpdateMulti`, t, func() {
+		ctx := context.Background()
+
+		tmpd, err := ioutil.TempDir("", "filegraph_git")
+		So(err, ShouldBeNil)
+		defer os.RemoveAll(tmpd)
+
+		v8 := newFixtureRepo(filepath.Join(tmpd, "v8"))
+		v8Commit1 := v8.commit(map[string]string{"a.cc": "a", "b.cc": "b"}, nil)
+		v8Commit2 := v8.commit(map[string]string{"a.cc": "a2", "c.cc": "c"}, nil)
+
+		src := newFixtureRepo(filepath.Join(tmpd, "src"))
+		src.commit(map[string]string{"DEPS": "v8@1", "main.cc": "main"}, map[string]string{"v8": v8Commit1})
+		srcCommit2 := src.commit(map[string]string{"DEPS": "v8@2"}, map[string]string{"v8": v8Commit2})
+
+		repos := []Repo{
+			{Dir: src.dir, Superproject: true},
+			{Dir: v8.dir, MountPoint: "v8"},
+		}
+		g := &Graph{}
+		So(g.UpdateMulti(ctx, repos, UpdateOptions{}), ShouldBeNil)
+
+		Convey(`Records the repos`, func() {
+			So(g.Commit, ShouldEqual, "")
+			So(g.Repos, ShouldResemble, []RepoState{
+				{MountPoint: "", Commit: srcCommit2},
+				{MountPoint: "v8", Commit: v8Commit2},
+			})
+		})
+
+		Convey(`Prefixes paths with mount points`, func() {
+			So(g.node("//v8/a.cc"), ShouldNotBeNil)
+			So(g.node("//a.cc"), ShouldBeNil)
+			So(g.node("//main.cc"), ShouldNotBeNil)
+
+			// The gitlink itself is not a file.
+			So(g.node("//v8").edges, ShouldBeEmpty)
+		})
+
+		Convey(`Relates files across mount points`, func() {
+			q := filegraph.Query{
+				Sources:    []filegraph.Node{g.Node("//main.cc")},
+				EdgeReader: &EdgeReader{},
+			}
+			var path []string
+			q.Run(func(sp *filegraph.ShortestPath) bool {
+				if sp.Node.Name() != "//v8/b.cc" {
+					return true
+				}
+				So(sp.Distance, ShouldBeGreaterThan, 0)
+				for ; sp != nil; sp = sp.Prev {
+					path = append([]string{sp.Node.Name()}, path...)
+				}
+				return false
+			})
+			So(path, ShouldResemble, []string{"//main.cc", "//DEPS", "//v8/a.cc", "//v8/b.cc"})
+
+			// //v8/c.cc was touched only by the roll and the v8 commit.
+			var related []string
+			(&EdgeReader{}).ReadEdges(g.Node("//DEPS"), func(to filegraph.Node, distance float64) bool {
+				related = append(related, to.Name())
+				return true
+			})
+			So(related, ShouldContain, "//v8/c.cc")
+		})
+
+		Convey(`Updates incrementally`, func() {
+			v8Commit3 := v8.commit(map[string]string{"b.cc": "b3", "d.cc": "d"}, nil)
+			So(g.UpdateMulti(ctx, repos, UpdateOptions{}), ShouldBeNil)
+			So(g.Repos[0].Commit, ShouldEqual, srcCommit2)
+			So(g.Repos[1].Commit, ShouldEqual, v8Commit3)
+			So(g.node("//v8/d.cc"), ShouldNotBeNil)
+			// The new v8 commit was not rolled into src yet.
+			So(g.node("//v8/d.cc").edges, ShouldHaveLength, 1)
+		})
+
+		Convey(`Drops rolls that cannot be expanded`, func() {
+			src.commit(map[string]string{"DEPS": "v8@?", "main.cc": "main2"}, map[string]string{"v8": strings.Repeat("1", 40)})
+			So(g.UpdateMulti(ctx, repos, UpdateOptions{}), ShouldBeNil)
+			So(g.node("//v8").edges, ShouldBeEmpty)
+		})
+
+		Convey(`Rejects another repo set`, func() {
+			err := g.UpdateMulti(ctx, repos[:1], UpdateOptions{})
+			So(err, ShouldErrLike, `the graph was built for repositories mounted at ["" "v8"], not [""]`)
+
+			err = (&Graph{Commit: "deadbeef"}).UpdateMulti(ctx, repos, UpdateOptions{})
+			So(err, ShouldErrLike, "the graph was built from a single repository")
+		})
+
+		Convey(`LoadMulti`, func() {
+			g, err := LoadMulti(ctx, repos, UpdateOptions{})
+			So(err, ShouldBeNil)
+			So(g.Repos[1].Commit, ShouldEqual, v8Commit2)
+
+			// The second load reads the cache.
+			g, err = LoadMulti(ctx, repos, UpdateOptions{})
+			So(err, ShouldBeNil)
+			So(g.Repos[1].Commit, ShouldEqual, v8Commit2)
+			So(g.node("//v8/c.cc"), ShouldNotBeNil)
+
+			// A single-repo graph is cached separately.
+			single, err := Load(ctx, src.dir, LoadOptions{})
+			So(err, ShouldBeNil)
+			So(single.Repos, ShouldBeEmpty)
+			So(single.Commit, ShouldEqual, srcCommit2)
+		})
+	})
+}
+
+func TestValidateRepos(t *testing.T) {
+	t.Parallel()
+
+	Convey(`validateRepos`, t, func() {
+		So(validateRepos([]Repo{{Dir: "src"}, {Dir: "v8", MountPoint: "v8"}, {Dir: "angle", MountPoint: "third_party/angle"}}), ShouldBeNil)
+
+		So(validateRepos(nil), ShouldErrLike, "no repositories")
+		So(validateRepos([]Repo{{MountPoint: "v8"}}), ShouldErrLike, "has no dir")
+		So(validateRepos([]Repo{{Dir: "src", Ref: "main"}}), ShouldErrLike, `must start with "refs/"`)
+		for _, mp := range []string{"/v8", "v8/", "a//b", "./v8", "../v8", ".", ".."} {
+			So(validateRepos([]Repo{{Dir: "v8", MountPoint: mp}}), ShouldErrLike, "invalid mount point")
+		}
+		So(validateRepos([]Repo{{Dir: "a", MountPoint: "v8"}, {Dir: "b", MountPoint: "v8"}}), ShouldErrLike, "used by more than one repository")
+	})
+}
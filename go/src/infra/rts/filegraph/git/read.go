@@ -10,6 +10,7 @@ import (
 	"io"
 	"strconv"
 	"strings"
+	"time"
 
 	"go.chromium.org/luci/common/errors"
 )
@@ -48,19 +49,36 @@ func (r *reader) readGraph(g *Graph) error {
 	}
 
 	// Read version.
-	switch ver, err := r.readInt(); {
+	ver, err := r.readInt()
+	switch {
 	case err != nil:
 		return err
-	case ver != 0:
-		return errors.Reason("unexpected version %d; expected 0", ver).Err()
+	case ver < 0 || ver > versionRepos|versionRecency:
+		return errors.Reason("unexpected version %d; expected 0, 1, 2 or 3", ver).Err()
 	}
 
 	// Read the commit.
-	var err error
 	if g.Commit, err = r.readString(); err != nil {
 		return err
 	}
 
+	// Read the repos.
+	g.Repos = nil
+	if ver&versionRepos != 0 {
+		if err := r.readRepos(g); err != nil {
+			return errors.Annotate(err, "failed to read repos").Err()
+		}
+	}
+
+	// Read the recency decay.
+	g.RecencyHalfLife = 0
+	g.recencyAnchor = time.Time{}
+	if ver&versionRecency != 0 {
+		if err := r.readRecency(g); err != nil {
+			return errors.Annotate(err, "failed to read recency decay").Err()
+		}
+	}
+
 	// Read the nodes.
 	r.ordered = r.ordered[:0]
 	if err := r.readNode(&g.root); err != nil {
@@ -86,6 +104,44 @@ func (r *reader) readGraph(g *Graph) error {
 	return nil
 }
 
+func (r *reader) readRepos(g *Graph) error {
+	count, err := r.readInt()
+	switch {
+	case err != nil:
+		return err
+	case count <= 0:
+		return errors.Reason("unexpected number of repos %d", count).Err()
+	}
+
+	g.Repos = make([]RepoState, count)
+	for i := range g.Repos {
+		if g.Repos[i].MountPoint, err = r.readString(); err != nil {
+			return err
+		}
+		if g.Repos[i].Commit, err = r.readString(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *reader) readRecency(g *Graph) error {
+	halfLife, err := r.readInt64()
+	switch {
+	case err != nil:
+		return err
+	case halfLife <= 0:
+		return errors.Reason("unexpected recency half-life %d", halfLife).Err()
+	}
+	anchor, err := r.readInt64()
+	if err != nil {
+		return err
+	}
+	g.RecencyHalfLife = time.Duration(halfLife)
+	g.recencyAnchor = time.Unix(anchor, 0).UTC()
+	return nil
+}
+
 func (r *reader) readNode(n *node) error {
 	r.ordered = append(r.ordered, n)
 
@@ -8,6 +8,7 @@ import (
 	"bufio"
 	"bytes"
 	"testing"
+	"time"
 
 	. "github.com/smartystreets/goconvey/convey"
 )
@@ -41,6 +42,25 @@ func TestReadWrite(t *testing.T) {
 			test(&Graph{})
 		})
 
+		Convey(`Multiple repos`, func() {
+			test(&Graph{
+				Repos: []RepoState{
+					{MountPoint: "", Commit: "deadbeef"},
+					{MountPoint: "third_party/angle", Commit: "badcoffee"},
+				},
+			})
+		})
+
+		Convey(`Recency decay`, func() {
+			test(&Graph{
+				Repos: []RepoState{
+					{MountPoint: "", Commit: "deadbeef"},
+				},
+				RecencyHalfLife: 365 * 24 * time.Hour,
+				recencyAnchor:   time.Unix(1600000000, 0).UTC(),
+			})
+		})
+
 		Convey(`Two direct children`, func() {
 			g := &Graph{
 				Commit: "deadbeef",
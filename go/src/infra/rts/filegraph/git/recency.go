@@ -0,0 +1,100 @@
+// Copyright 2021 The Chromium Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package git
+
+import (
+	"math"
+	"time"
+
+	"go.chromium.org/luci/common/errors"
+)
+
+// The weights of commits are relative to an anchor date stored in the graph,
+// rather than to the current date, see doc.go. They are in fixed point units
+// where a commit made at the anchor date weighs recencyUnit. Weights of commits
+// made long after the anchor date would overflow, so before applying such a
+// commit, the anchor is moved to the commit date and the whole graph is
+// rescaled, see (*Graph).commitWeight.
+
+const (
+	// recencyUnit is the weight of a commit made at the anchor date.
+	recencyUnit = 1 << 10
+	// maxRecencyHalfLives is the maximum number of half-lives between the
+	// anchor date and the date of a commit, before the anchor is moved.
+	// The maximum weight of a commit is recencyUnit * 2^maxRecencyHalfLives,
+	// and its contribution to probSum is at most probOne times that.
+	maxRecencyHalfLives = 10
+)
+
+// recencyWeight returns the weight of a commit made at commitTime relative to
+// a commit made at the reference date, i.e. 2^(-age/halfLife), in units of
+// 1/recencyUnit. Commits made after the reference date weigh more than
+// recencyUnit. The weight is at least 1.
+func recencyWeight(commitTime, reference time.Time, halfLife time.Duration) int {
+	age := reference.Sub(commitTime)
+	w := math.Round(recencyUnit * math.Exp2(-float64(age)/float64(halfLife)))
+	if w < 1 {
+		return 1
+	}
+	return int(w)
+}
+
+// checkRecencyHalfLife returns an error if the graph was built with another
+// recency half-life. An empty graph can be built with any.
+func (g *Graph) checkRecencyHalfLife(halfLife time.Duration) error {
+	if g.Commit == "" && len(g.Repos) == 0 {
+		return nil
+	}
+	if g.RecencyHalfLife != halfLife {
+		return errors.Reason("the graph was built with recency half-life %s, not %s", g.RecencyHalfLife, halfLife).Err()
+	}
+	return nil
+}
+
+// commitWeight returns the weight of a commit made at commitTime, which is 1
+// if the recency decay is disabled.
+//
+// The first commit sets the anchor date. If the commit was made more than
+// maxRecencyHalfLives after the anchor date, the graph is rescaled to an
+// anchor at commitTime first.
+func (g *Graph) commitWeight(commitTime time.Time) int {
+	if g.RecencyHalfLife <= 0 {
+		return 1
+	}
+	switch {
+	case g.recencyAnchor.IsZero():
+		g.recencyAnchor = commitTime
+	case commitTime.Sub(g.recencyAnchor) > maxRecencyHalfLives*g.RecencyHalfLife:
+		g.rescale(commitTime)
+	}
+	return recencyWeight(commitTime, g.recencyAnchor, g.RecencyHalfLife)
+}
+
+// rescale moves the recency anchor to a later date, and scales the
+// probability sums and their denominators accordingly.
+//
+// The sums of old commits may round to zero. They are kept at 1 instead,
+// because a zero probSum is an alias edge.
+func (g *Graph) rescale(anchor time.Time) {
+	factor := math.Exp2(-float64(anchor.Sub(g.recencyAnchor)) / float64(g.RecencyHalfLife))
+	scale := func(v int64) int64 {
+		if v == 0 {
+			return 0
+		}
+		if scaled := int64(math.Round(float64(v) * factor)); scaled > 1 {
+			return scaled
+		}
+		return 1
+	}
+
+	g.root.visit(func(n *node) bool {
+		n.probSumDenominator = int(scale(int64(n.probSumDenominator)))
+		for i := range n.edges {
+			n.edges[i].probSum = probability(scale(int64(n.edges[i].probSum)))
+		}
+		return true
+	})
+	g.recencyAnchor = anchor
+}
@@ -0,0 +1,125 @@
+// Copyright 2021 The Chromium Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package git
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+	. "go.chromium.org/luci/common/testing/assertions"
+)
+
+func TestRecency(t *testing.T) {
+	t.Parallel()
+
+	Convey(`Recency`, t, func() {
+		reference := time.Date(2021, 6, 1, 0, 0, 0, 0, time.UTC)
+		const halfLife = 30 * 24 * time.Hour
+
+		Convey(`recencyWeight`, func() {
+			weight := func(age time.Duration) int {
+				return recencyWeight(reference.Add(-age), reference, halfLife)
+			}
+			So(weight(0), ShouldEqual, recencyUnit)
+			So(weight(halfLife), ShouldEqual, recencyUnit/2)
+			So(weight(2*halfLife), ShouldEqual, recencyUnit/4)
+			So(weight(halfLife/2), ShouldEqual, 724) // 1024 / sqrt(2)
+			So(weight(-halfLife), ShouldEqual, 2*recencyUnit)
+			// Very old commits still count.
+			So(weight(100*halfLife), ShouldEqual, 1)
+		})
+
+		Convey(`commitWeight`, func() {
+			Convey(`Disabled`, func() {
+				g := &Graph{}
+				g.ensureInitialized()
+				So(g.commitWeight(reference), ShouldEqual, 1)
+				So(g.recencyAnchor.IsZero(), ShouldBeTrue)
+			})
+
+			Convey(`The first commit sets the anchor`, func() {
+				g := &Graph{RecencyHalfLife: halfLife}
+				g.ensureInitialized()
+				So(g.commitWeight(reference), ShouldEqual, recencyUnit)
+				So(g.recencyAnchor, ShouldEqual, reference)
+				So(g.commitWeight(reference.Add(halfLife)), ShouldEqual, 2*recencyUnit)
+				So(g.commitWeight(reference.Add(-halfLife)), ShouldEqual, recencyUnit/2)
+				So(g.recencyAnchor, ShouldEqual, reference)
+			})
+
+			Convey(`Rescale`, func() {
+				g := &Graph{RecencyHalfLife: halfLife}
+				g.ensureInitialized()
+				So(g.applyWeighted([]fileChange{
+					{Path: "a", Status: 'A'},
+					{Path: "b", Status: 'A'},
+				}, 100, g.commitWeight(reference)), ShouldBeNil)
+
+				// A commit made much later moves the anchor.
+				later := reference.Add((maxRecencyHalfLives + 1) * halfLife)
+				So(g.commitWeight(later), ShouldEqual, recencyUnit)
+				So(g.recencyAnchor, ShouldEqual, later)
+
+				// The old commit weighs 2^-11 of the new one, which rounds to 1.
+				a := g.node("//a")
+				So(a.probSumDenominator, ShouldEqual, 1)
+				So(a.edges, ShouldResemble, []edge{{to: g.node("//b"), probSum: probOne / 2}})
+			})
+		})
+
+		Convey(`applyWeighted`, func() {
+			g := &Graph{}
+			g.ensureInitialized()
+			So(g.applyWeighted([]fileChange{
+				{Path: "a", Status: 'A'},
+				{Path: "b", Status: 'A'},
+			}, 100, 3), ShouldBeNil)
+			So(g.applyWeighted([]fileChange{
+				{Path: "a", Status: 'M'},
+				{Path: "c", Status: 'A'},
+			}, 100, 1), ShouldBeNil)
+
+			a := g.node("//a")
+			So(a.probSumDenominator, ShouldEqual, 4)
+			So(a.edges, ShouldResemble, []edge{
+				{to: g.node("//b"), probSum: 3 * probOne},
+				{to: g.node("//c"), probSum: probOne},
+			})
+		})
+
+		Convey(`checkRecencyHalfLife`, func() {
+			So((&Graph{}).checkRecencyHalfLife(halfLife), ShouldBeNil)
+			g := &Graph{Commit: "deadbeef"}
+			So(g.checkRecencyHalfLife(0), ShouldBeNil)
+			So(g.checkRecencyHalfLife(halfLife), ShouldErrLike, "the graph was built with recency half-life 0s, not 720h0m0s")
+			g.RecencyHalfLife = halfLife
+			So(g.checkRecencyHalfLife(halfLife), ShouldBeNil)
+		})
+
+		Convey(`Update`, func() {
+			ctx := context.Background()
+			tmpd, err := ioutil.TempDir("", "filegraph_git")
+			So(err, ShouldBeNil)
+			defer os.RemoveAll(tmpd)
+
+			repo := newFixtureRepo(tmpd)
+			repo.commit(map[string]string{"a.txt": "a", "b.txt": "b"}, nil)
+
+			g := &Graph{}
+			So(g.Update(ctx, repo.dir, "refs/heads/main", UpdateOptions{RecencyHalfLife: halfLife}), ShouldBeNil)
+			So(g.RecencyHalfLife, ShouldEqual, halfLife)
+			So(g.recencyAnchor.IsZero(), ShouldBeFalse)
+			So(g.node("//a.txt").probSumDenominator, ShouldEqual, recencyUnit)
+
+			repo.commit(map[string]string{"a.txt": "a2"}, nil)
+			err = g.Update(ctx, repo.dir, "refs/heads/main", UpdateOptions{})
+			So(err, ShouldErrLike, "the graph was built with recency half-life 720h0m0s, not 0s")
+		})
+	})
+}
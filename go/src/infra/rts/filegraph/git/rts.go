@@ -38,7 +38,7 @@ type SelectionStrategy struct {
 // Select calls skipTestFile for each test file that should be skipped.
 // Does not skip files that it does not know about.
 func (s *SelectionStrategy) Select(changedFiles []string, skipFile func(name string) (keepGoing bool)) {
-	s.runQuery(changedFiles, func(name string, af rts.Affectedness) bool {
+	s.runQuery(changedFiles, func(name string, af rts.Affectedness, _ *filegraph.ShortestPath) bool {
 		if af.Distance <= s.MaxDistance {
 			// This file too close to skip it.
 			return true
@@ -47,6 +47,40 @@ func (s *SelectionStrategy) Select(changedFiles []string, skipFile func(name str
 	})
 }
 
+// Decision explains why a file was selected or skipped by SelectVerbose.
+type Decision struct {
+	// File is the name of the file.
+	File string
+	// ClosestChangedFile is the name of the changed file closest to File.
+	// It is File itself if File was changed.
+	ClosestChangedFile string
+	// Distance is the distance from ClosestChangedFile to File.
+	Distance float64
+	// Selected is true if Distance is within MaxDistance.
+	Selected bool
+}
+
+// SelectVerbose is like Select, but calls the callback for each file
+// reachable from the changed files, selected or not, along with the
+// changed file it is closest to.
+//
+// It is slower than Select, because it reconstructs the shortest path of
+// each file. Use it for debugging selection decisions.
+func (s *SelectionStrategy) SelectVerbose(changedFiles []string, callback func(Decision) (keepGoing bool)) {
+	s.runQuery(changedFiles, func(name string, af rts.Affectedness, sp *filegraph.ShortestPath) bool {
+		closest := name
+		for ; sp != nil; sp = sp.Prev {
+			closest = sp.Node.Name()
+		}
+		return callback(Decision{
+			File:               name,
+			ClosestChangedFile: closest,
+			Distance:           af.Distance,
+			Selected:           af.Distance <= s.MaxDistance,
+		})
+	})
+}
+
 // SelectEval implements eval.Strategy. It can be used to evaluate data
 // quality of the graph.
 // It is a version of Select specifically for evaluation.
@@ -82,7 +116,7 @@ func (s *SelectionStrategy) SelectEval(ctx context.Context, in eval.Input, out *
 	}
 
 	found := 0
-	s.runQuery(changedFiles, func(name string, af rts.Affectedness) (keepGoing bool) {
+	s.runQuery(changedFiles, func(name string, af rts.Affectedness, _ *filegraph.ShortestPath) (keepGoing bool) {
 		if _, ok := affectedness[name]; ok {
 			affectedness[name] = af
 			found++
@@ -98,7 +132,9 @@ func (s *SelectionStrategy) SelectEval(ctx context.Context, in eval.Input, out *
 	return nil
 }
 
-type rtsCallback func(name string, af rts.Affectedness) (keepGoing bool)
+// rtsCallback is called by runQuery for each found file.
+// sp is the shortest path to the file, or nil if the file is not in the graph.
+type rtsCallback func(name string, af rts.Affectedness, sp *filegraph.ShortestPath) (keepGoing bool)
 
 // runQuery walks the file graph from the changed files, along reversed edges
 // and calls back for each found file.
@@ -119,11 +155,11 @@ func (s *SelectionStrategy) runQuery(changedFiles []string, callback rtsCallback
 			q.Sources = append(q.Sources, n)
 		} else {
 			// Otherwise assume the file is new and treat it as very affected.
-			callback(f, rts.Affectedness{})
+			callback(f, rts.Affectedness{}, nil)
 		}
 	}
 
 	q.Run(func(sp *filegraph.ShortestPath) (keepGoing bool) {
-		return callback(sp.Node.Name(), rts.Affectedness{Distance: sp.Distance})
+		return callback(sp.Node.Name(), rts.Affectedness{Distance: sp.Distance}, sp)
 	})
 }
@@ -151,3 +151,42 @@ func TestEvalStrategy(t *testing.T) {
 		})
 	})
 }
+
+func TestSelectVerbose(t *testing.T) {
+	t.Parallel()
+
+	Convey(`SelectVerbose`, t, func() {
+		s := &SelectionStrategy{Graph: &Graph{}, MaxDistance: 0.5}
+		s.Graph.ensureInitialized()
+		err := s.Graph.apply([]fileChange{
+			{Path: "a", Status: 'A'},
+			{Path: "b", Status: 'A'},
+			{Path: "c", Status: 'A'},
+		}, 100)
+		So(err, ShouldBeNil)
+
+		var decisions []Decision
+		s.SelectVerbose([]string{"//a", "//new"}, func(d Decision) bool {
+			decisions = append(decisions, d)
+			return true
+		})
+		So(decisions, ShouldHaveLength, 4)
+
+		// The new file is reported first, as very affected.
+		So(decisions[0], ShouldResemble, Decision{
+			File:               "//new",
+			ClosestChangedFile: "//new",
+			Selected:           true,
+		})
+		So(decisions[1], ShouldResemble, Decision{
+			File:               "//a",
+			ClosestChangedFile: "//a",
+			Selected:           true,
+		})
+		for _, d := range decisions[2:] {
+			So(d.ClosestChangedFile, ShouldEqual, "//a")
+			So(d.Distance, ShouldAlmostEqual, -math.Log(0.5))
+			So(d.Selected, ShouldBeFalse)
+		}
+	})
+}
@@ -6,6 +6,8 @@ package git
 
 import (
 	"context"
+	"fmt"
+	"time"
 
 	"go.chromium.org/luci/common/errors"
 )
@@ -21,6 +23,63 @@ type UpdateOptions struct {
 	// The rationale is that large commits provide a weak signal of file
 	// relatedness and are expensive to process, O(N^2).
 	MaxCommitSize int
+
+	// RenameSimilarityThreshold is the minimum similarity, in percent, of a
+	// file removed and a file added by the same commit for git to detect a
+	// rename, see -M in https://git-scm.com/docs/git-diff.
+	// If zero, the git default is used, typically 50%.
+	// If negative, renames are not detected.
+	//
+	// The histories of a renamed file and of its new name are merged.
+	// A rename which is not detected is treated as a removal and an addition
+	// of unrelated files.
+	RenameSimilarityThreshold int
+
+	// DetectCopies makes git detect copies of files modified by the same
+	// commit, with the same similarity threshold as renames, see -C in
+	// https://git-scm.com/docs/git-diff.
+	// A copy is treated as a commit touching both the original file and the
+	// copy. Their histories are not merged.
+	DetectCopies bool
+
+	// RecencyHalfLife, if positive, enables the recency decay of the
+	// change-log-based distance: the contribution of each commit is weighted
+	// by 2^(-age/RecencyHalfLife), see doc.go.
+	// If zero, all commits weigh the same.
+	//
+	// A graph is always updated with the half-life it was built with.
+	RecencyHalfLife time.Duration
+}
+
+// validate returns an error if the options are invalid.
+func (o *UpdateOptions) validate() error {
+	switch {
+	case o.RenameSimilarityThreshold > 100:
+		return errors.Reason("rename similarity threshold must be at most 100").Err()
+	case o.DetectCopies && o.RenameSimilarityThreshold < 0:
+		return errors.Reason("copies cannot be detected if renames are not").Err()
+	case o.RecencyHalfLife < 0:
+		return errors.Reason("recency half-life must not be negative").Err()
+	default:
+		return nil
+	}
+}
+
+// diffArgs returns the git-log and git-diff flags for rename and copy
+// detection.
+func (o *UpdateOptions) diffArgs() []string {
+	switch threshold := o.RenameSimilarityThreshold; {
+	case threshold < 0:
+		return []string{"--no-renames"}
+	case threshold == 0 && o.DetectCopies:
+		return []string{"-C"}
+	case threshold == 0:
+		return nil
+	case o.DetectCopies:
+		return []string{fmt.Sprintf("-C%d%%", threshold)}
+	default:
+		return []string{fmt.Sprintf("-M%d%%", threshold)}
+	}
 }
 
 // Update updates the graph based on changes in a git repository.
@@ -35,9 +94,17 @@ func (g *Graph) Update(ctx context.Context, repoDir, rev string, opt UpdateOptio
 	if rev == "" {
 		return errors.New("rev is empty")
 	}
+	if err := opt.validate(); err != nil {
+		return err
+	}
+	if err := g.checkRecencyHalfLife(opt.RecencyHalfLife); err != nil {
+		return err
+	}
+	g.RecencyHalfLife = opt.RecencyHalfLife
 
-	return readLog(ctx, repoDir, g.Commit, rev, func(c commit) error {
-		if err := g.apply(c.Files, opt.MaxCommitSize); err != nil {
+	withTime := opt.RecencyHalfLife > 0
+	return readLog(ctx, repoDir, g.Commit, rev, opt.diffArgs(), withTime, func(c commit) error {
+		if err := g.applyWeighted(c.Files, opt.MaxCommitSize, g.commitWeight(c.Time)); err != nil {
 			return errors.Annotate(err, "failed to apply commit %s", c.Hash).Err()
 		}
 
@@ -53,8 +120,14 @@ func (g *Graph) Update(ctx context.Context, repoDir, rev string, opt UpdateOptio
 	})
 }
 
-// apply applies the file changes to the graph.
+// apply applies the file changes of a commit to the graph.
 func (g *Graph) apply(fileChanges []fileChange, maxFileCount int) error {
+	return g.applyWeighted(fileChanges, maxFileCount, 1)
+}
+
+// applyWeighted is like apply, but the commit contributes to the probability
+// sums with the given weight.
+func (g *Graph) applyWeighted(fileChanges []fileChange, maxFileCount, weight int) error {
 	files := make([]*node, 0, len(fileChanges))
 	for _, fc := range fileChanges {
 		switch {
@@ -66,6 +139,11 @@ func (g *Graph) apply(fileChanges []fileChange, maxFileCount int) error {
 			newFile.ensureAlias(oldFile)
 			files = append(files, newFile)
 
+		case fc.Status == 'C':
+			// The file was copied. Unlike renames, the copy has a history of its
+			// own, so relate it to the original file only through this commit.
+			files = append(files, g.ensureNode("//"+fc.Path), g.ensureNode("//"+fc.Path2))
+
 		case fc.Status == 'D':
 			// Ignore this file.
 			// If this file is re-added later, it is likely to be a revert, where we'd
@@ -99,15 +177,15 @@ func (g *Graph) apply(fileChanges []fileChange, maxFileCount int) error {
 	}
 
 	// For any file in |files|, compute the probability of picking
-	// any other file.
-	p := probability(probOne / int64(len(files)-1))
+	// any other file, weighted by the commit's weight.
+	p := probability(probOne/int64(len(files)-1)) * probability(weight)
 
 	fileSet := make(map[*node]struct{}, len(files))
 	for _, f := range files {
 		fileSet[f] = struct{}{}
 	}
 	for _, file := range files {
-		file.probSumDenominator++
+		file.probSumDenominator += weight
 
 		updated := make(map[*node]struct{}, len(files)-1)
 		// Increment the commit count in file's edges that point to other files.
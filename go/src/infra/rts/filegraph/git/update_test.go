@@ -5,10 +5,15 @@
 package git
 
 import (
+	"context"
 	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
 	"testing"
 
 	. "github.com/smartystreets/goconvey/convey"
+	. "go.chromium.org/luci/common/testing/assertions"
 )
 
 func TestApply(t *testing.T) {
@@ -217,6 +222,21 @@ func TestApply(t *testing.T) {
 					},
 				})
 			})
+
+			Convey(`Copy one`, func() {
+				applyChanges([]fileChange{
+					{Path: "b", Path2: "c", Status: 'C'},
+				})
+				b := g.node("//b")
+				c := g.node("//c")
+				So(b.probSumDenominator, ShouldEqual, 2)
+				So(b.edges, ShouldResemble, []edge{
+					{to: g.node("//a"), probSum: probOne},
+					{to: c, probSum: probOne},
+				})
+				So(c.probSumDenominator, ShouldEqual, 1)
+				So(c.edges, ShouldResemble, []edge{{to: b, probSum: probOne}})
+			})
 		})
 
 		Convey(`Great migration`, func() {
@@ -243,3 +263,81 @@ func TestApply(t *testing.T) {
 		})
 	})
 }
+
+func TestUpdateRenames(t *testing.T) {
+	t.Parallel()
+
+	Convey(`Update with renames`, t, func() {
+		ctx := context.Background()
+
+		tmpd, err := ioutil.TempDir("", "filegraph_git")
+		So(err, ShouldBeNil)
+		defer os.RemoveAll(tmpd)
+
+		// Rename a.txt to b.txt, keeping 4 lines out of 10, which git rates
+		// as 40% similar.
+		var original, renamed strings.Builder
+		for i := 0; i < 10; i++ {
+			fmt.Fprintf(&original, "line %d of the original file\n", i)
+			if i < 4 {
+				fmt.Fprintf(&renamed, "line %d of the original file\n", i)
+			} else {
+				fmt.Fprintf(&renamed, "replaced line %d\n", i)
+			}
+		}
+		repo := newFixtureRepo(tmpd)
+		repo.commit(map[string]string{"a.txt": original.String()}, nil)
+		repo.git("rm", "-q", "a.txt")
+		repo.commit(map[string]string{"b.txt": renamed.String(), "c.txt": "c"}, nil)
+
+		update := func(opt UpdateOptions) *Graph {
+			g := &Graph{}
+			So(g.Update(ctx, repo.dir, "refs/heads/main", opt), ShouldBeNil)
+			return g
+		}
+		// edges returns the edges from one file to others, by file name.
+		edges := func(g *Graph, from string) map[string]probability {
+			ret := map[string]probability{}
+			for _, e := range g.node(from).edges {
+				ret[e.to.name] = e.probSum
+			}
+			return ret
+		}
+
+		Convey(`Below the threshold`, func() {
+			g := update(UpdateOptions{RenameSimilarityThreshold: 30})
+			// a.txt and b.txt are aliases.
+			So(edges(g, "//a.txt"), ShouldResemble, map[string]probability{"//b.txt": 0})
+			So(edges(g, "//b.txt"), ShouldResemble, map[string]probability{"//a.txt": 0, "//c.txt": probOne})
+		})
+
+		Convey(`Above the threshold`, func() {
+			g := update(UpdateOptions{RenameSimilarityThreshold: 50})
+			// a.txt was removed, and an unrelated b.txt added.
+			So(edges(g, "//a.txt"), ShouldBeEmpty)
+			So(edges(g, "//b.txt"), ShouldResemble, map[string]probability{"//c.txt": probOne})
+		})
+
+		Convey(`Renames not detected`, func() {
+			g := update(UpdateOptions{RenameSimilarityThreshold: -1})
+			So(edges(g, "//a.txt"), ShouldBeEmpty)
+			So(edges(g, "//b.txt"), ShouldResemble, map[string]probability{"//c.txt": probOne})
+		})
+
+		Convey(`Copies`, func() {
+			// Copy b.txt to d.txt, modifying b.txt.
+			repo.commit(map[string]string{"b.txt": renamed.String() + "more\n", "d.txt": renamed.String()}, nil)
+
+			g := update(UpdateOptions{RenameSimilarityThreshold: 30, DetectCopies: true})
+			So(edges(g, "//a.txt"), ShouldResemble, map[string]probability{"//b.txt": 0})
+			So(edges(g, "//d.txt"), ShouldResemble, map[string]probability{"//b.txt": probOne})
+		})
+
+		Convey(`Invalid options`, func() {
+			err := (&Graph{}).Update(ctx, repo.dir, "refs/heads/main", UpdateOptions{RenameSimilarityThreshold: 101})
+			So(err, ShouldErrLike, "at most 100")
+			err = (&Graph{}).Update(ctx, repo.dir, "refs/heads/main", UpdateOptions{RenameSimilarityThreshold: -1, DetectCopies: true})
+			So(err, ShouldErrLike, "copies cannot be detected")
+		})
+	})
+}
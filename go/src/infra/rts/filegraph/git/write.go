@@ -16,13 +16,26 @@ import (
 // magicHeader is the first token when writing/reading a graph.
 const magicHeader = 54
 
+// Bits of the graph format version.
+const (
+	// versionRepos means the graph was built from multiple repositories.
+	versionRepos = 1 << iota
+	// versionRecency means the graph was built with recency decay.
+	versionRecency
+)
+
 // Write writes the graph to w.
 // It is the opposite of (*Graph).Read().
 //
 // Spec:
-//  graph = header version git-commit-hash root total-number-of-edges root-edges
+//  graph = header version git-commit-hash repos recency root total-number-of-edges root-edges
 //  header = 54
-//  version = 0
+//  version = 0 | 1 | 2 | 3
+//
+//  repos = number-of-repos repo*
+//  repo = mount-point git-commit-hash
+//
+//  recency = half-life-in-nanoseconds anchor-date-in-unix-seconds
 //
 //  root = node
 //  node = prob-sum-denominator number-of-children children-sorted-by-base-name
@@ -41,6 +54,10 @@ const magicHeader = 54
 //   all integer types are encoded as varint
 //   all strings are encoded as length-prefixed utf8
 //   `*` means "0 or more"
+//   repos are present only in versions 1 and 3, which are used only for graphs
+//   built from multiple repositories.
+//   recency is present only in versions 2 and 3, which are used only for
+//   graphs with recency decay.
 func (g *Graph) Write(w io.Writer) error {
 	g.ensureInitialized()
 	return (&writer{w: w}).writeGraph(g)
@@ -64,7 +81,14 @@ func (w *writer) writeGraph(g *Graph) error {
 	}
 
 	// Write version.
-	if err := w.writeInt(0); err != nil {
+	version := 0
+	if len(g.Repos) > 0 {
+		version |= versionRepos
+	}
+	if g.RecencyHalfLife > 0 {
+		version |= versionRecency
+	}
+	if err := w.writeInt(version); err != nil {
 		return err
 	}
 
@@ -73,6 +97,31 @@ func (w *writer) writeGraph(g *Graph) error {
 		return err
 	}
 
+	// Write repos.
+	if version&versionRepos != 0 {
+		if err := w.writeInt(len(g.Repos)); err != nil {
+			return err
+		}
+		for _, r := range g.Repos {
+			if err := w.writeString(r.MountPoint); err != nil {
+				return err
+			}
+			if err := w.writeString(r.Commit); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Write the recency decay.
+	if version&versionRecency != 0 {
+		if err := w.writeInt64(int64(g.RecencyHalfLife)); err != nil {
+			return err
+		}
+		if err := w.writeInt64(g.recencyAnchor.Unix()); err != nil {
+			return err
+		}
+	}
+
 	// Write nodes.
 	w.indices = map[*node]int{}
 	if err := w.writeNode(&g.root); err != nil {
@@ -8,6 +8,7 @@ import (
 	"bytes"
 	"strings"
 	"testing"
+	"time"
 
 	. "github.com/smartystreets/goconvey/convey"
 )
@@ -41,6 +42,46 @@ func TestWrite(t *testing.T) {
 			)
 		})
 
+		Convey(`Multiple repos`, func() {
+			test(&Graph{
+				Repos: []RepoState{
+					{MountPoint: "", Commit: "deadbeef"},
+					{MountPoint: "v8", Commit: "badcoffee"},
+				},
+			},
+				"54",        // header
+				"1",         // version
+				"",          // commit hash
+				"2",         // number of repos
+				"",          // mount point of the first repo
+				"deadbeef",  // commit hash of the first repo
+				"v8",        // mount point of the second repo
+				"badcoffee", // commit hash of the second repo
+				"0",         // root's probSumDenominator
+				"0",         // number of root children
+				"0",         // total number of edges
+				"0",         // number of root edges
+			)
+		})
+
+		Convey(`Recency decay`, func() {
+			test(&Graph{
+				Commit:          "deadbeef",
+				RecencyHalfLife: time.Hour,
+				recencyAnchor:   time.Unix(1600000000, 0).UTC(),
+			},
+				"54",            // header
+				"2",             // version
+				"deadbeef",      // commit hash
+				"3600000000000", // half-life in nanoseconds
+				"1600000000",    // anchor date in unix seconds
+				"0",             // root's probSumDenominator
+				"0",             // number of root children
+				"0",             // total number of edges
+				"0",             // number of root edges
+			)
+		})
+
 		Convey(`Two direct children`, func() {
 			foo := &node{probSumDenominator: 1}
 			bar := &node{probSumDenominator: 2}
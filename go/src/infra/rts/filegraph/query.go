@@ -23,8 +23,10 @@ type Query struct {
 	// Nodes further than this are considered unreachable.
 	MaxDistance float64
 
-	heap spHeap
-	dist map[Node]float64
+	heap    spHeap
+	dist    map[Node]float64
+	last    *ShortestPath
+	started bool
 }
 
 // ShortestPath represents the shortest path from one of sources to a node.
@@ -44,9 +46,77 @@ type ShortestPath struct {
 // sources, starting from the sources themselves.
 //
 // If the callback returns false, then the iteration stops.
+//
+// Run starts the query from scratch, discarding the state of Next.
 func (q *Query) Run(callback func(*ShortestPath) (keepGoing bool)) {
+	q.reset()
+	for sp := q.Next(); sp != nil; sp = q.Next() {
+		if !callback(sp) {
+			return
+		}
+	}
+}
+
+// Next returns the next closest node reachable from any of the sources,
+// or nil if there are no more nodes within q.MaxDistance.
+// The first call returns one of the sources.
+//
+// Unlike Run, Next keeps the state of the traversal between calls, so that
+// the caller can pull nodes one at a time and stop at any point without
+// exploring the rest of the graph. Edges of a node are read only when the
+// node following it is requested.
+func (q *Query) Next() *ShortestPath {
 	// This function implements Dijkstra's algorithm.
 
+	if !q.started {
+		q.reset()
+	}
+
+	// Expand the node returned by the previous call.
+	if cur := q.last; cur != nil {
+		q.last = nil
+		q.EdgeReader.ReadEdges(cur.Node, func(other Node, distFromCur float64) bool {
+			newDist := cur.Distance + distFromCur
+			if curDist, ok := q.dist[other]; !ok || newDist < curDist {
+				q.dist[other] = newDist
+				// If heap already contains the node, we cannot efficiently reduce
+				// its distance. Instead we push a new entry, and the previous (worse)
+				// one will be filtered out later.
+				heap.Push(&q.heap, &ShortestPath{
+					Prev:     cur,
+					Node:     other,
+					Distance: newDist,
+				})
+			}
+			return true
+		})
+	}
+
+	for len(q.heap) > 0 {
+		cur := heap.Pop(&q.heap).(*ShortestPath)
+
+		// Check the distance.
+		switch {
+		case q.MaxDistance > 0 && cur.Distance > q.MaxDistance:
+			// This and all subsequent nodes are too far.
+			q.heap = q.heap[:0]
+			return nil
+
+		case cur.Distance > q.dist[cur.Node]:
+			// A better one was already reported.
+			continue
+		}
+
+		q.last = cur
+		return cur
+	}
+	return nil
+}
+
+// reset prepares the query to start from q.Sources.
+func (q *Query) reset() {
+	q.started = true
+	q.last = nil
 	q.heap = q.heap[:0]
 
 	// Maps from a node to the shortest distance. Distance may shrink over time.
@@ -70,41 +140,6 @@ func (q *Query) Run(callback func(*ShortestPath) (keepGoing bool)) {
 			q.dist[n] = 0
 		}
 	}
-
-	for len(q.heap) > 0 {
-		cur := heap.Pop(&q.heap).(*ShortestPath)
-
-		// Check the distance.
-		switch {
-		case q.MaxDistance > 0 && cur.Distance > q.MaxDistance:
-			// This and all subsequent nodes are too far.
-			return
-
-		case cur.Distance > q.dist[cur.Node]:
-			// A better one was already reported.
-			continue
-		}
-
-		if !callback(cur) {
-			return
-		}
-
-		q.EdgeReader.ReadEdges(cur.Node, func(other Node, distFromCur float64) bool {
-			newDist := cur.Distance + distFromCur
-			if curDist, ok := q.dist[other]; !ok || newDist < curDist {
-				q.dist[other] = newDist
-				// If heap already contains the node, we cannot efficiently reduce
-				// its distance. Instead we push a new entry, and the previous (worse)
-				// one will be filtered out later.
-				heap.Push(&q.heap, &ShortestPath{
-					Prev:     cur,
-					Node:     other,
-					Distance: newDist,
-				})
-			}
-			return true
-		})
-	}
 }
 
 // ShortestPath returns the shortest path to a node.
@@ -5,6 +5,8 @@
 package filegraph
 
 import (
+	"fmt"
+	"math/rand"
 	"testing"
 
 	. "github.com/smartystreets/goconvey/convey"
@@ -184,6 +186,49 @@ func TestQuery(t *testing.T) {
 			})
 		})
 
+		Convey(`Next`, func() {
+			g := initGraph(
+				testEdge{from: "//a", to: "//b/1", distance: 1},
+				testEdge{from: "//a", to: "//b/2", distance: 2},
+				testEdge{from: "//b/1", to: "//c", distance: 3},
+				testEdge{from: "//b/2", to: "//c", distance: 3},
+			)
+			q := g.query("//a")
+
+			Convey(`Works`, func() {
+				var names []string
+				var dists []float64
+				for sp := q.Next(); sp != nil; sp = q.Next() {
+					names = append(names, sp.Node.Name())
+					dists = append(dists, sp.Distance)
+				}
+				So(names, ShouldResemble, []string{"//a", "//b/1", "//b/2", "//c"})
+				So(dists, ShouldResemble, []float64{0, 1, 2, 4})
+				So(q.Next(), ShouldBeNil)
+			})
+
+			Convey(`Reads edges lazily`, func() {
+				So(q.Next().Node, ShouldEqual, g.node("//a"))
+				So(q.heap, ShouldBeEmpty)
+				So(q.Next().Node, ShouldEqual, g.node("//b/1"))
+				So(q.heap, ShouldHaveLength, 1)
+			})
+
+			Convey(`MaxDistance`, func() {
+				q.MaxDistance = 1
+				So(q.Next().Node, ShouldEqual, g.node("//a"))
+				So(q.Next().Node, ShouldEqual, g.node("//b/1"))
+				So(q.Next(), ShouldBeNil)
+				So(q.Next(), ShouldBeNil)
+			})
+
+			Convey(`Run restarts`, func() {
+				q.Next()
+				q.Next()
+				So(run(q), ShouldHaveLength, 4)
+			})
+		})
+
 		Convey(`ShortestPath`, func() {
 			g := initGraph(
 				testEdge{from: "//a", to: "//b/1", distance: 1},
@@ -217,3 +262,44 @@ func TestQuery(t *testing.T) {
 		})
 	})
 }
+
+// randomGraph returns a connected graph with n nodes and random distances.
+// Each node has an edge to a random node created before it and back, plus
+// a random edge to any node.
+func randomGraph(n int) *testGraph {
+	rnd := rand.New(rand.NewSource(0))
+	g := &testGraph{nodes: make(map[string]*testNode, n)}
+	nodes := make([]*testNode, n)
+	for i := range nodes {
+		nodes[i] = g.node(fmt.Sprintf("//%d", i))
+	}
+	for i := 1; i < n; i++ {
+		parent := nodes[rnd.Intn(i)]
+		nodes[i].edges[parent] = rnd.Float64()
+		parent.edges[nodes[i]] = rnd.Float64()
+		nodes[i].edges[nodes[rnd.Intn(n)]] = rnd.Float64()
+	}
+	return g
+}
+
+func BenchmarkQuery(b *testing.B) {
+	const nodeCount = 100000
+	g := randomGraph(nodeCount)
+
+	b.Run("Full", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			q := g.query("//0")
+			for q.Next() != nil {
+			}
+		}
+	})
+
+	b.Run("EarlyStop", func(b *testing.B) {
+		// Pull only the closest 1% of the nodes.
+		for i := 0; i < b.N; i++ {
+			q := g.query("//0")
+			for j := 0; j < nodeCount/100 && q.Next() != nil; j++ {
+			}
+		}
+	})
+}
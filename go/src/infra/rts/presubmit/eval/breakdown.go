@@ -0,0 +1,266 @@
+// Copyright 2021 The Chromium Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package eval
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"sort"
+	"strconv"
+
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"go.chromium.org/luci/common/errors"
+
+	evalpb "infra/rts/presubmit/eval/proto"
+)
+
+// worstGroups is the number of builders and test suites printed by
+// PrintBreakdown.
+const worstGroups = 20
+
+// BreakdownChangeRecall is the ChangeRecall at which Main prints the worst
+// builders and test suites.
+const BreakdownChangeRecall = 0.99
+
+// GroupKeyFunc returns the group that a test variant belongs to in the
+// breakdown of results, e.g. its test suite or builder.
+// An empty string means the group is unknown.
+type GroupKeyFunc func(*evalpb.TestVariant) string
+
+// VariantKey returns a GroupKeyFunc which groups test variants by the value of
+// the variant key.
+func VariantKey(key string) GroupKeyFunc {
+	return func(tv *evalpb.TestVariant) string {
+		return variantValue(tv, key)
+	}
+}
+
+// breakdownRow is the results of a builder or a test suite.
+type breakdownRow struct {
+	Kind string
+	Name string
+
+	TotalRejections       int64
+	PreservedRejections   []int64
+	TotalTestFailures     int64
+	PreservedTestFailures []int64
+	TotalDuration         *durationpb.Duration
+	SavedDurations        []*durationpb.Duration
+}
+
+// breakdownRows returns the results of builders, followed by the results of
+// test suites.
+func breakdownRows(res *evalpb.Results) []*breakdownRow {
+	rows := make([]*breakdownRow, 0, len(res.Builders)+len(res.Suites))
+	for _, b := range res.Builders {
+		rows = append(rows, &breakdownRow{
+			Kind:                  "builder",
+			Name:                  b.Builder,
+			TotalRejections:       b.TotalRejections,
+			PreservedRejections:   b.PreservedRejections,
+			TotalTestFailures:     b.TotalTestFailures,
+			PreservedTestFailures: b.PreservedTestFailures,
+			TotalDuration:         b.TotalDuration,
+			SavedDurations:        b.SavedDurations,
+		})
+	}
+	for _, s := range res.Suites {
+		rows = append(rows, &breakdownRow{
+			Kind:                  "suite",
+			Name:                  s.Suite,
+			TotalRejections:       s.TotalRejections,
+			PreservedRejections:   s.PreservedRejections,
+			TotalTestFailures:     s.TotalTestFailures,
+			PreservedTestFailures: s.PreservedTestFailures,
+			TotalDuration:         s.TotalDuration,
+			SavedDurations:        s.SavedDurations,
+		})
+	}
+	return rows
+}
+
+// displayName returns the name of the group, or "(unknown)" if it's empty.
+func (r *breakdownRow) displayName() string {
+	if r.Name == "" {
+		return "(unknown)"
+	}
+	return r.Name
+}
+
+// changeRecall returns the ChangeRecall at threshold i, or NaN if the group
+// has no rejections.
+func (r *breakdownRow) changeRecall(i int) float32 {
+	return ratio(r.TotalRejections, r.PreservedRejections, i)
+}
+
+// testRecall returns the TestRecall at threshold i, or NaN if the group has
+// no test failures.
+func (r *breakdownRow) testRecall(i int) float32 {
+	return ratio(r.TotalTestFailures, r.PreservedTestFailures, i)
+}
+
+// savings returns the Savings at threshold i, or NaN if the group has no
+// test durations.
+func (r *breakdownRow) savings(i int) float32 {
+	total := r.TotalDuration.AsDuration()
+	if total <= 0 || i >= len(r.SavedDurations) {
+		return float32(math.NaN())
+	}
+	return float32(float64(r.SavedDurations[i].AsDuration()) / float64(total))
+}
+
+// lostRejections returns the number of rejections lost at threshold i.
+func (r *breakdownRow) lostRejections(i int) int64 {
+	if i >= len(r.PreservedRejections) {
+		return 0
+	}
+	return r.TotalRejections - r.PreservedRejections[i]
+}
+
+func ratio(total int64, preserved []int64, i int) float32 {
+	if total == 0 || i >= len(preserved) {
+		return float32(math.NaN())
+	}
+	return float32(preserved[i]) / float32(total)
+}
+
+// PrintBreakdown prints the builders and test suites with the lowest
+// ChangeRecall to w, at the threshold with the lowest ChangeRecall that is not
+// below targetChangeRecall.
+func PrintBreakdown(res *evalpb.Results, w io.Writer, targetChangeRecall float32) error {
+	if len(res.Thresholds) == 0 {
+		return nil
+	}
+	i := sort.Search(len(res.Thresholds), func(i int) bool {
+		return res.Thresholds[i].ChangeRecall >= targetChangeRecall
+	})
+	if i == len(res.Thresholds) {
+		i--
+	}
+	t := res.Thresholds[i]
+
+	// Groups without rejections do not contribute to the misses.
+	var rows []*breakdownRow
+	for _, r := range breakdownRows(res) {
+		if r.TotalRejections > 0 {
+			rows = append(rows, r)
+		}
+	}
+	sort.SliceStable(rows, func(a, b int) bool {
+		ra, rb := rows[a], rows[b]
+		if ca, cb := ra.changeRecall(i), rb.changeRecall(i); ca != cb {
+			return ca < cb
+		}
+		return ra.lostRejections(i) > rb.lostRejections(i)
+	})
+	if len(rows) > worstGroups {
+		rows = rows[:worstGroups]
+	}
+
+	p := newPrinter(w)
+	p.printf("\nWorst builders and test suites at ChangeRecall %s, distance %.3f:\n", scoreString(t.ChangeRecall), t.MaxDistance)
+	p.printf("Kind    | ChangeRecall | Savings | TestRecall | Lost rejections | Name\n")
+	p.printf("--------------------------------------------------------------------------\n")
+	for _, r := range rows {
+		p.printf(
+			"%-7s | %7s      | % 7s | %7s    | %5d of %-6d | %s\n",
+			r.Kind,
+			scoreString(r.changeRecall(i)),
+			scoreString(r.savings(i)),
+			scoreString(r.testRecall(i)),
+			r.lostRejections(i),
+			r.TotalRejections,
+			r.displayName(),
+		)
+	}
+	return p.err
+}
+
+// WriteBreakdownCSV writes per-builder and per-test-suite results to w in CSV
+// format, with a row for each group and threshold.
+// Recall and savings are empty if there is no data to compute them from.
+func WriteBreakdownCSV(w io.Writer, res *evalpb.Results) error {
+	cw := csv.NewWriter(w)
+	cw.Write([]string{
+		"kind",
+		"name",
+		"max_distance",
+		"total_rejections",
+		"preserved_rejections",
+		"change_recall",
+		"total_test_failures",
+		"preserved_test_failures",
+		"test_recall",
+		"total_duration_seconds",
+		"saved_duration_seconds",
+		"savings",
+	})
+
+	intAt := func(values []int64, i int) string {
+		if i >= len(values) {
+			return ""
+		}
+		return strconv.FormatInt(values[i], 10)
+	}
+	score := func(v float32) string {
+		if math.IsNaN(float64(v)) {
+			return ""
+		}
+		return strconv.FormatFloat(float64(v), 'f', 4, 32)
+	}
+	seconds := func(d *durationpb.Duration) string {
+		if d == nil {
+			return ""
+		}
+		return strconv.FormatFloat(d.AsDuration().Seconds(), 'f', -1, 64)
+	}
+
+	for _, r := range breakdownRows(res) {
+		for i, t := range res.Thresholds {
+			saved := ""
+			if i < len(r.SavedDurations) {
+				saved = seconds(r.SavedDurations[i])
+			}
+			cw.Write([]string{
+				r.Kind,
+				r.Name,
+				fmt.Sprintf("%.3f", t.MaxDistance),
+				strconv.FormatInt(r.TotalRejections, 10),
+				intAt(r.PreservedRejections, i),
+				score(r.changeRecall(i)),
+				strconv.FormatInt(r.TotalTestFailures, 10),
+				intAt(r.PreservedTestFailures, i),
+				score(r.testRecall(i)),
+				seconds(r.TotalDuration),
+				saved,
+				score(r.savings(i)),
+			})
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// WriteBreakdown writes per-builder and per-test-suite results to
+// e.BreakdownOut, unless it's empty. See WriteBreakdownCSV.
+func (e *Eval) WriteBreakdown(res *evalpb.Results) error {
+	if e.BreakdownOut == "" {
+		return nil
+	}
+
+	f, err := os.Create(e.BreakdownOut)
+	if err != nil {
+		return errors.Annotate(err, "failed to create the breakdown file").Err()
+	}
+	defer f.Close()
+	if err := WriteBreakdownCSV(f, res); err != nil {
+		return errors.Annotate(err, "failed to write the breakdown").Err()
+	}
+	return f.Close()
+}
@@ -0,0 +1,125 @@
+// Copyright 2021 The Chromium Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package eval
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	evalpb "infra/rts/presubmit/eval/proto"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestBreakdown(t *testing.T) {
+	t.Parallel()
+
+	Convey(`Breakdown`, t, func() {
+		res := &evalpb.Results{
+			Thresholds: []*evalpb.Threshold{
+				{MaxDistance: 1, ChangeRecall: 0.5},
+				{MaxDistance: 2, ChangeRecall: 0.99},
+			},
+			Builders: []*evalpb.BuilderResults{
+				{
+					Builder:               "linux-rel",
+					TotalRejections:       10,
+					PreservedRejections:   []int64{5, 9},
+					TotalTestFailures:     20,
+					PreservedTestFailures: []int64{10, 18},
+					TotalDuration:         durationpb.New(100 * time.Second),
+					SavedDurations:        []*durationpb.Duration{durationpb.New(50 * time.Second), durationpb.New(25 * time.Second)},
+				},
+				{
+					// No rejections.
+					Builder:        "mac-rel",
+					TotalDuration:  durationpb.New(100 * time.Second),
+					SavedDurations: []*durationpb.Duration{durationpb.New(50 * time.Second), durationpb.New(25 * time.Second)},
+				},
+			},
+			Suites: []*evalpb.SuiteResults{
+				{
+					TotalRejections:       2,
+					PreservedRejections:   []int64{1, 2},
+					TotalTestFailures:     2,
+					PreservedTestFailures: []int64{1, 2},
+				},
+				{
+					Suite:                 "browser_tests",
+					TotalRejections:       4,
+					PreservedRejections:   []int64{2, 3},
+					TotalTestFailures:     4,
+					PreservedTestFailures: []int64{2, 3},
+				},
+			},
+		}
+
+		Convey(`PrintBreakdown`, func() {
+			printBreakdown := func(targetChangeRecall float32) string {
+				buf := &bytes.Buffer{}
+				So(PrintBreakdown(res, buf, targetChangeRecall), ShouldBeNil)
+				return buf.String()
+			}
+
+			Convey(`Sorted by ChangeRecall`, func() {
+				So(printBreakdown(0.99), ShouldEqual, `
+Worst builders and test suites at ChangeRecall 99.00%, distance 2.000:
+Kind    | ChangeRecall | Savings | TestRecall | Lost rejections | Name
+--------------------------------------------------------------------------
+suite   |  75.00%      |       ? |  75.00%    |     1 of 4      | browser_tests
+builder |  90.00%      |  25.00% |  90.00%    |     1 of 10     | linux-rel
+suite   | 100.00%      |       ? | 100.00%    |     0 of 2      | (unknown)
+`)
+			})
+
+			Convey(`Ties are broken by lost rejections`, func() {
+				out := printBreakdown(0.5)
+				So(out, ShouldContainSubstring, "ChangeRecall 50.00%, distance 1.000")
+				lines := strings.Split(strings.TrimSpace(out), "\n")
+				So(lines, ShouldHaveLength, 6)
+				So(lines[3], ShouldEndWith, "linux-rel")
+				So(lines[4], ShouldEndWith, "browser_tests")
+				So(lines[5], ShouldEndWith, "(unknown)")
+			})
+
+			Convey(`Unreachable target`, func() {
+				So(printBreakdown(1), ShouldContainSubstring, "ChangeRecall 99.00%, distance 2.000")
+			})
+
+			Convey(`Limited`, func() {
+				for i := 0; i < worstGroups; i++ {
+					res.Suites = append(res.Suites, &evalpb.SuiteResults{
+						Suite:               fmt.Sprintf("suite_%d", i),
+						TotalRejections:     1,
+						PreservedRejections: []int64{1, 1},
+					})
+				}
+				lines := strings.Split(strings.TrimSpace(printBreakdown(0.99)), "\n")
+				So(lines, ShouldHaveLength, 3+worstGroups)
+			})
+		})
+
+		Convey(`WriteBreakdownCSV`, func() {
+			buf := &bytes.Buffer{}
+			So(WriteBreakdownCSV(buf, res), ShouldBeNil)
+			So(buf.String(), ShouldEqual, strings.TrimPrefix(`
+kind,name,max_distance,total_rejections,preserved_rejections,change_recall,total_test_failures,preserved_test_failures,test_recall,total_duration_seconds,saved_duration_seconds,savings
+builder,linux-rel,1.000,10,5,0.5000,20,10,0.5000,100,50,0.5000
+builder,linux-rel,2.000,10,9,0.9000,20,18,0.9000,100,25,0.2500
+builder,mac-rel,1.000,0,,,0,,,100,50,0.5000
+builder,mac-rel,2.000,0,,,0,,,100,25,0.2500
+suite,,1.000,2,1,0.5000,2,1,0.5000,,,
+suite,,2.000,2,2,1.0000,2,2,1.0000,,,
+suite,browser_tests,1.000,4,2,0.5000,4,2,0.5000,,,
+suite,browser_tests,2.000,4,3,0.7500,4,3,0.7500,,,
+`, "\n"))
+		})
+	})
+}
@@ -10,7 +10,9 @@ import (
 	"context"
 	"flag"
 	"math"
+	"runtime"
 	"sort"
+	"strings"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -26,14 +28,23 @@ import (
 	evalpb "infra/rts/presubmit/eval/proto"
 )
 
-const defaultConcurrency = 100
+// dateFormat is the format of dates in DailyResults.
+const dateFormat = "2006-01-02"
 
 // Eval estimates safety and efficiency of a given selection strategy.
 type Eval struct {
-	// The number of goroutines to spawn for each metric.
-	// If <=0, defaults to 100.
+	// Concurrency is the number of history records to evaluate in parallel.
+	// If <=0, defaults to runtime.GOMAXPROCS(0).
+	//
+	// The strategy is called concurrently, unless SerializeStrategy is true.
+	// The results do not depend on Concurrency.
 	Concurrency int
 
+	// SerializeStrategy instructs to never call the strategy concurrently.
+	// Set it for strategies which are not safe for concurrent use.
+	// Records are still read and aggregated in parallel.
+	SerializeStrategy bool
+
 	// Rejections is a path to a directory with rejection records.
 	// For format details, see comments of Rejection protobuf message.
 	Rejections string
@@ -55,11 +66,50 @@ type Eval struct {
 	// historical records. The field value is the number of records between
 	// progress reports. If zero or less, progress is not logged.
 	LogProgressInterval int
+
+	// HTMLReport is a path to the HTML report file to write.
+	// If empty, the report is not written. See WriteHTMLReport.
+	HTMLReport string
+
+	// BuilderRecall, if not BUILDER_RECALL_UNSPECIFIED, instructs to select
+	// tests separately for each builder, like CQ does, and defines when a
+	// rejection is considered preserved. Recall under both ANY_BUILDER and
+	// ALL_BUILDERS semantics is reported.
+	// The builder of a test variant is determined by BuilderKey.
+	BuilderRecall evalpb.BuilderRecall
+
+	// TestSuiteKey returns the test suite of a test variant. Results are broken
+	// down by test suite. If nil, the test suite is the value of the
+	// "test_suite" variant key.
+	TestSuiteKey GroupKeyFunc
+
+	// BuilderKey returns the builder of a test variant. Results are broken
+	// down by builder, and tests are selected separately for each builder if
+	// BuilderRecall is set. If nil, the builder is the value of the "builder"
+	// variant key.
+	BuilderKey GroupKeyFunc
+
+	// BreakdownOut is a path to a CSV file to write per-builder and
+	// per-test-suite results to. If empty, the file is not written.
+	// See WriteBreakdownCSV.
+	BreakdownOut string
+
+	// flags is the flag set passed to RegisterFlags.
+	// It is used to include the flag values in the HTML report.
+	flags *flag.FlagSet
 }
 
 // RegisterFlags registers flags for the Eval fields.
 func (e *Eval) RegisterFlags(fs *flag.FlagSet) error {
-	fs.IntVar(&e.Concurrency, "j", defaultConcurrency, "Number of job to run parallel")
+	fs.IntVar(&e.Concurrency, "j", runtime.GOMAXPROCS(0), text.Doc(`
+		Number of history records to evaluate in parallel.
+		The selection strategy is called concurrently,
+		unless -serialize-strategy is passed.
+	`))
+	fs.BoolVar(&e.SerializeStrategy, "serialize-strategy", false, text.Doc(`
+		Never call the selection strategy concurrently.
+		Use it if the strategy is not safe for concurrent use.
+	`))
 	fs.StringVar(&e.Rejections, "rejections", "", text.Doc(`
 		Path to a directory with test rejection records.
 		For format details, see comments of Rejection protobuf message.
@@ -77,6 +127,25 @@ func (e *Eval) RegisterFlags(fs *flag.FlagSet) error {
 		distance.
 		This can help diagnosing the selection strategy.
 	`))
+	fs.StringVar(&e.HTMLReport, "html-report", "", text.Doc(`
+		Path to a file to write a standalone HTML report to.
+		The report includes the recall-vs-savings curve, per-day recall
+		and per-suite breakdown.
+	`))
+	fs.Var(builderRecallFlag{&e.BuilderRecall}, "builder-recall", text.Doc(`
+		If set, select tests separately for each builder, like CQ does.
+		The builder of a test is the value of its "builder" variant key.
+		Valid values are "any" and "all". With "any", a rejection is preserved
+		if a failed test is selected on at least one of the builders where
+		tests failed. With "all", it must be selected on each of them.
+		Recall under both semantics is reported.
+	`))
+	fs.StringVar(&e.BreakdownOut, "breakdown-out", "", text.Doc(`
+		Path to a CSV file to write per-builder and per-test-suite results to,
+		for each threshold. Useful to find the builders and test suites which
+		drive the misses.
+	`))
+	e.flags = fs
 	return nil
 }
 
@@ -111,65 +180,89 @@ func (e *Eval) Run(ctx context.Context, strategy Strategy) (*evalpb.Results, err
 func (e *Eval) EvaluateSafety(ctx context.Context, strategy Strategy) (*evalpb.Results, error) {
 	// TODO(nodir): refactor this function. It is a bit long.
 
-	var changeAffectedness []rts.Affectedness
-	var testAffectedness []rts.Affectedness
-	furthest := make(furthestRejections, 0, e.LogFurthest)
-	maxNonInf := 0.0
-	var mu sync.Mutex
-
 	eg, ctx := errgroup.WithContext(ctx)
 	defer eg.Wait()
 
 	// Play back the history.
-	rejC := make(chan *evalpb.Rejection)
+	rejC := make(chan rejectionRecord)
 	eg.Go(func() error {
 		defer close(rejC)
 		err := readRejections(ctx, e.Rejections, rejC)
 		return errors.Annotate(err, "failed to read rejection records").Err()
 	})
 
-	res := &evalpb.Results{}
-	e.goMany(eg, func() error {
-		for rej := range rejC {
-			// TODO(crbug.com/1112125): skip the patchset if it has a ton of failed tests.
-			// Most selection strategies would reject such a patchset, so it represents noise.
-
-			// Invoke the strategy.
-			in := Input{TestVariants: rej.FailedTestVariants}
-			in.ensureChangedFilesInclude(rej.Patchsets...)
-			out := &Output{TestVariantAffectedness: make([]rts.Affectedness, len(in.TestVariants))}
-			if err := strategy(ctx, in, out); err != nil {
-				return errors.Annotate(err, "the selection strategy failed").Err()
-			}
-
-			// The affectedness of a change is based on the most affected failed test.
-			mostAffected, err := mostAffected(out.TestVariantAffectedness)
+	// Invoke the strategy concurrently and collect the per-rejection results.
+	strategy = e.wrapStrategy(strategy)
+	resultC := make(chan *rejectionResult)
+	workers, workerCtx := errgroup.WithContext(ctx)
+	e.goMany(workers, func(int) error {
+		for rec := range rejC {
+			r, err := e.evaluateRejection(workerCtx, strategy, rec)
 			if err != nil {
 				return err
 			}
-
-			mu.Lock()
-			changeAffectedness = append(changeAffectedness, mostAffected)
-			testAffectedness = append(testAffectedness, out.TestVariantAffectedness...)
-			furthest.Consider(affectedRejection{Rejection: rej, MostAffected: mostAffected})
-			if !math.IsInf(mostAffected.Distance, 1) && maxNonInf < mostAffected.Distance {
-				maxNonInf = mostAffected.Distance
-			}
-			if e.LogProgressInterval > 0 && len(changeAffectedness)%e.LogProgressInterval == 0 {
-				logging.Infof(ctx, "processed %d rejections", len(changeAffectedness))
+			select {
+			case resultC <- r:
+			case <-workerCtx.Done():
+				return workerCtx.Err()
 			}
-			mu.Unlock()
 		}
 		return nil
 	})
+	eg.Go(func() error {
+		defer close(resultC)
+		return workers.Wait()
+	})
 
+	var results []*rejectionResult
+	for r := range resultC {
+		results = append(results, r)
+		if e.LogProgressInterval > 0 && len(results)%e.LogProgressInterval == 0 {
+			logging.Infof(ctx, "processed %d rejections", len(results))
+		}
+	}
 	if err := eg.Wait(); err != nil {
 		return nil, err
 	}
 
+	// Merge the results in the order of the history records, so that the
+	// outcome does not depend on the order in which workers completed.
+	sort.Slice(results, func(i, j int) bool {
+		return results[i].pos.less(results[j].pos)
+	})
+	var changeAffectedness []rts.Affectedness
+	var testAffectedness []rts.Affectedness
+	dayAffectedness := map[string][]rts.Affectedness{}
+	suiteAffectedness := newGroupAffectedness()
+	builderAffectedness := newGroupAffectedness()
+	var anyBuilderAffectedness, allBuildersAffectedness []rts.Affectedness
+	furthest := make(furthestRejections, 0, e.LogFurthest)
+	maxNonInf := 0.0
+	for _, r := range results {
+		changeAffectedness = append(changeAffectedness, r.mostAffected)
+		testAffectedness = append(testAffectedness, r.testAffectedness...)
+		if r.byBuilder != nil {
+			anyBuilderAffectedness = append(anyBuilderAffectedness, r.anyBuilder)
+			allBuildersAffectedness = append(allBuildersAffectedness, r.allBuilders)
+		}
+		builderAffectedness.addRejection(mostAffectedByKey(r.rej.FailedTestVariants, r.testAffectedness, e.builderOf))
+		builderAffectedness.addFailures(r.rej.FailedTestVariants, r.testAffectedness, e.builderOf)
+		suiteAffectedness.addRejection(mostAffectedByKey(r.rej.FailedTestVariants, r.testAffectedness, e.testSuiteOf))
+		suiteAffectedness.addFailures(r.rej.FailedTestVariants, r.testAffectedness, e.testSuiteOf)
+		if r.rej.Timestamp != nil {
+			day := r.rej.Timestamp.AsTime().UTC().Format(dateFormat)
+			dayAffectedness[day] = append(dayAffectedness[day], r.mostAffected)
+		}
+		furthest.Consider(affectedRejection{Rejection: r.rej, MostAffected: r.mostAffected})
+		if !math.IsInf(r.mostAffected.Distance, 1) && maxNonInf < r.mostAffected.Distance {
+			maxNonInf = r.mostAffected.Distance
+		}
+	}
+
 	if len(changeAffectedness) == 0 {
 		return nil, errors.New("no change rejections")
 	}
+	res := &evalpb.Results{BuilderRecall: e.BuilderRecall}
 
 	if len(furthest) > 0 {
 		furthest.LogAndClear(ctx)
@@ -224,15 +317,157 @@ func (e *Eval) EvaluateSafety(ctx context.Context, strategy Strategy) (*evalpb.R
 		t.ChangeRecall = float32(t.PreservedRejections) / float32(res.TotalRejections)
 		t.TestRecall = float32(t.PreservedTestFailures) / float32(res.TotalTestFailures)
 	}
+
+	// Break down the recall by day and by test suite.
+	for day, afs := range dayAffectedness {
+		res.Daily = append(res.Daily, &evalpb.DailyResults{
+			Date:                day,
+			TotalRejections:     int64(len(afs)),
+			PreservedRejections: preserved(int64(len(afs)), losses(afs)),
+		})
+	}
+	sort.Slice(res.Daily, func(i, j int) bool {
+		return res.Daily[i].Date < res.Daily[j].Date
+	})
+	for suite, failures := range suiteAffectedness.failures {
+		rejections := suiteAffectedness.rejections[suite]
+		res.Suites = append(res.Suites, &evalpb.SuiteResults{
+			Suite:                 suite,
+			TotalRejections:       int64(len(rejections)),
+			PreservedRejections:   preserved(int64(len(rejections)), losses(rejections)),
+			TotalTestFailures:     int64(len(failures)),
+			PreservedTestFailures: preserved(int64(len(failures)), losses(failures)),
+		})
+	}
+	sortSuites(res.Suites)
+
+	// Break down the recall by builder. If tests are selected per builder,
+	// report recall under both per-builder semantics too.
+	for builder, failures := range builderAffectedness.failures {
+		rejections := builderAffectedness.rejections[builder]
+		res.Builders = append(res.Builders, &evalpb.BuilderResults{
+			Builder:               builder,
+			TotalRejections:       int64(len(rejections)),
+			PreservedRejections:   preserved(int64(len(rejections)), losses(rejections)),
+			TotalTestFailures:     int64(len(failures)),
+			PreservedTestFailures: preserved(int64(len(failures)), losses(failures)),
+		})
+	}
+	sortBuilders(res.Builders)
+	if e.BuilderRecall != evalpb.BuilderRecall_BUILDER_RECALL_UNSPECIFIED {
+		lostAny := losses(anyBuilderAffectedness)
+		lostAll := losses(allBuildersAffectedness)
+		for i, t := range res.Thresholds {
+			t.ChangeRecallAnyBuilder = float32(res.TotalRejections-lostAny[i+1]) / float32(res.TotalRejections)
+			t.ChangeRecallAllBuilders = float32(res.TotalRejections-lostAll[i+1]) / float32(res.TotalRejections)
+		}
+	}
 	return res, nil
 }
 
+// groupAffectedness accumulates the affectedness of rejections and test
+// failures by group, such as a builder or a test suite.
+type groupAffectedness struct {
+	// rejections maps a group to the affectedness of the rejections with
+	// failed tests in the group, each represented by its most affected
+	// failed test in the group.
+	rejections map[string][]rts.Affectedness
+	// failures maps a group to the affectedness of its failed tests.
+	failures map[string][]rts.Affectedness
+}
+
+func newGroupAffectedness() *groupAffectedness {
+	return &groupAffectedness{
+		rejections: map[string][]rts.Affectedness{},
+		failures:   map[string][]rts.Affectedness{},
+	}
+}
+
+// addRejection records a rejection, given the affectedness of the rejection
+// in each group, see mostAffectedByKey.
+func (g *groupAffectedness) addRejection(byGroup map[string]rts.Affectedness) {
+	for key, af := range byGroup {
+		g.rejections[key] = append(g.rejections[key], af)
+	}
+}
+
+// addFailures records failed tests. afs[i] is the affectedness of tvs[i].
+func (g *groupAffectedness) addFailures(tvs []*evalpb.TestVariant, afs []rts.Affectedness, groupKey GroupKeyFunc) {
+	for i, tv := range tvs {
+		key := groupKey(tv)
+		g.failures[key] = append(g.failures[key], afs[i])
+	}
+}
+
+// rejectionResult is the outcome of the strategy for a rejection.
+type rejectionResult struct {
+	pos recordPos
+	rej *evalpb.Rejection
+
+	// testAffectedness[i] is the affectedness of rej.FailedTestVariants[i].
+	testAffectedness []rts.Affectedness
+
+	// mostAffected is the affectedness of the change, under the semantics
+	// of Eval.BuilderRecall.
+	mostAffected rts.Affectedness
+
+	// byBuilder, anyBuilder and allBuilders are set only in per-builder
+	// mode. See evaluateRejection.
+	byBuilder   map[string]rts.Affectedness
+	anyBuilder  rts.Affectedness
+	allBuilders rts.Affectedness
+}
+
+// evaluateRejection invokes the strategy for a rejection.
+//
+// Goroutine-safe if the strategy is.
+func (e *Eval) evaluateRejection(ctx context.Context, strategy Strategy, rec rejectionRecord) (*rejectionResult, error) {
+	// TODO(crbug.com/1112125): skip the patchset if it has a ton of failed tests.
+	// Most selection strategies would reject such a patchset, so it represents noise.
+
+	// Invoke the strategy.
+	rej := rec.rejection
+	in := Input{TestVariants: rej.FailedTestVariants}
+	in.ensureChangedFilesInclude(rej.Patchsets...)
+	out := &Output{TestVariantAffectedness: make([]rts.Affectedness, len(in.TestVariants))}
+	if err := e.invokeStrategy(ctx, strategy, in, out); err != nil {
+		return nil, errors.Annotate(err, "the selection strategy failed").Err()
+	}
+
+	// The affectedness of a change is based on the most affected failed test.
+	mostAffected, err := mostAffected(out.TestVariantAffectedness)
+	if err != nil {
+		return nil, err
+	}
+	r := &rejectionResult{
+		pos:              rec.pos,
+		rej:              rej,
+		testAffectedness: out.TestVariantAffectedness,
+		mostAffected:     mostAffected,
+	}
+
+	// In per-builder mode, also compute the affectedness of the change on
+	// each builder. Under ANY_BUILDER semantics, the change is as affected
+	// as it is on the most affected builder, i.e. mostAffected.
+	// Under ALL_BUILDERS semantics, it is as affected as it is on the least
+	// affected builder.
+	if e.BuilderRecall != evalpb.BuilderRecall_BUILDER_RECALL_UNSPECIFIED {
+		r.byBuilder = mostAffectedByKey(in.TestVariants, out.TestVariantAffectedness, e.builderOf)
+		r.anyBuilder = mostAffected
+		for _, af := range r.byBuilder {
+			if r.allBuilders.Distance < af.Distance {
+				r.allBuilders = af
+			}
+		}
+		if e.BuilderRecall == evalpb.BuilderRecall_ALL_BUILDERS {
+			r.mostAffected = r.allBuilders
+		}
+	}
+	return r, nil
+}
+
 // evaluateEfficiency computes total and saved durations.
 func (e *Eval) evaluateEfficiency(ctx context.Context, strategy Strategy, res *evalpb.Results) error {
-	// Process test durations in parallel and increment appropriate counters.
-	savedDurations := make(bucketSlice, len(res.Thresholds)+1)
-	var totalDuration int64
-
 	eg, ctx := errgroup.WithContext(ctx)
 	defer eg.Wait()
 
@@ -244,8 +479,15 @@ func (e *Eval) evaluateEfficiency(ctx context.Context, strategy Strategy, res *e
 		return errors.Annotate(err, "failed to read test duration records").Err()
 	})
 
+	// Process test durations in parallel. Each worker increments its own
+	// counters, which are merged below. The counters are sums, so the merged
+	// result does not depend on which worker processed which record.
+	strategy = e.wrapStrategy(strategy)
+	counters := make([]*efficiencyCounters, e.concurrency())
 	records := int64(0)
-	e.goMany(eg, func() error {
+	e.goMany(eg, func(worker int) error {
+		c := newEfficiencyCounters(len(res.Thresholds))
+		counters[worker] = c
 		in := Input{}
 		out := &Output{}
 		for rec := range recordC {
@@ -261,18 +503,19 @@ func (e *Eval) evaluateEfficiency(ctx context.Context, strategy Strategy, res *e
 			in.ensureChangedFilesInclude(rec.Patchsets...)
 
 			out.TestVariantAffectedness = make([]rts.Affectedness, len(in.TestVariants))
-			if err := strategy(ctx, in, out); err != nil {
+			if err := e.invokeStrategy(ctx, strategy, in, out); err != nil {
 				return errors.Annotate(err, "the selection strategy failed").Err()
 			}
 
 			// Record results.
-			durSum := int64(0)
 			for i, td := range rec.TestDurations {
 				dur := int64(td.Duration.AsDuration())
-				durSum += dur
-				savedDurations.inc(res.Thresholds, out.TestVariantAffectedness[i], dur)
+				af := out.TestVariantAffectedness[i]
+				c.total += dur
+				c.saved.inc(res.Thresholds, af, dur)
+				c.suites.get(e.testSuiteOf(td.TestVariant)).add(res.Thresholds, af, dur)
+				c.builders.get(e.builderOf(td.TestVariant)).add(res.Thresholds, af, dur)
 			}
-			atomic.AddInt64(&totalDuration, durSum)
 
 			if count := atomic.AddInt64(&records, 1); e.LogProgressInterval > 0 && int(count)%e.LogProgressInterval == 0 {
 				logging.Infof(ctx, "processed %d test duration records", count)
@@ -285,6 +528,16 @@ func (e *Eval) evaluateEfficiency(ctx context.Context, strategy Strategy, res *e
 		return err
 	}
 
+	// Merge the counters of the workers.
+	merged := newEfficiencyCounters(len(res.Thresholds))
+	for _, c := range counters {
+		merged.merge(c)
+	}
+	savedDurations := merged.saved
+	totalDuration := merged.total
+	suites := merged.suites
+	builders := merged.builders
+
 	if totalDuration == 0 {
 		return errors.New("sum of test durations is 0")
 	}
@@ -297,21 +550,195 @@ func (e *Eval) evaluateEfficiency(ctx context.Context, strategy Strategy, res *e
 		t.SavedDuration = durationpb.New(time.Duration(savedDurations[i+1]))
 		t.Savings = float32(float64(savedDurations[i+1]) / float64(totalDuration))
 	}
+
+	suiteResults := make(map[string]*evalpb.SuiteResults, len(res.Suites))
+	for _, s := range res.Suites {
+		suiteResults[s.Suite] = s
+	}
+	for suite, c := range suites.m {
+		s, ok := suiteResults[suite]
+		if !ok {
+			s = &evalpb.SuiteResults{Suite: suite}
+			res.Suites = append(res.Suites, s)
+		}
+		s.TotalDuration, s.SavedDurations = c.durations()
+	}
+	sortSuites(res.Suites)
+
+	builderResults := make(map[string]*evalpb.BuilderResults, len(res.Builders))
+	for _, b := range res.Builders {
+		builderResults[b.Builder] = b
+	}
+	for builder, c := range builders.m {
+		b, ok := builderResults[builder]
+		if !ok {
+			b = &evalpb.BuilderResults{Builder: builder}
+			res.Builders = append(res.Builders, b)
+		}
+		b.TotalDuration, b.SavedDurations = c.durations()
+	}
+	sortBuilders(res.Builders)
 	return nil
 }
 
-func (e *Eval) goMany(eg *errgroup.Group, f func() error) {
-	concurrency := e.Concurrency
-	if concurrency <= 0 {
-		concurrency = defaultConcurrency
+// invokeStrategy calls the strategy for in.
+//
+// If e.BuilderRecall is not BUILDER_RECALL_UNSPECIFIED, then the test variants
+// are grouped by builder and the strategy is called for each group separately,
+// with Input.Builder set. This reflects CQ which selects tests per builder.
+func (e *Eval) invokeStrategy(ctx context.Context, strategy Strategy, in Input, out *Output) error {
+	if e.BuilderRecall == evalpb.BuilderRecall_BUILDER_RECALL_UNSPECIFIED {
+		return strategy(ctx, in, out)
 	}
-	for i := 0; i < concurrency; i++ {
+
+	// Group test variant indexes by builder.
+	byBuilder := map[string][]int{}
+	var builders []string
+	for i, tv := range in.TestVariants {
+		b := e.builderOf(tv)
+		if _, ok := byBuilder[b]; !ok {
+			builders = append(builders, b)
+		}
+		byBuilder[b] = append(byBuilder[b], i)
+	}
+
+	for _, b := range builders {
+		indexes := byBuilder[b]
+		bIn := Input{
+			ChangedFiles: in.ChangedFiles,
+			TestVariants: make([]*evalpb.TestVariant, len(indexes)),
+			Builder:      b,
+		}
+		for i, tvIndex := range indexes {
+			bIn.TestVariants[i] = in.TestVariants[tvIndex]
+		}
+		bOut := &Output{TestVariantAffectedness: make([]rts.Affectedness, len(indexes))}
+		if err := strategy(ctx, bIn, bOut); err != nil {
+			return err
+		}
+		for i, tvIndex := range indexes {
+			out.TestVariantAffectedness[tvIndex] = bOut.TestVariantAffectedness[i]
+		}
+	}
+	return nil
+}
+
+// durationCounter is a pair of saved and total test durations.
+// Updated atomically.
+type durationCounter struct {
+	saved bucketSlice
+	total int64
+}
+
+// add records a test duration.
+//
+// Goroutine-safe.
+func (c *durationCounter) add(ts []*evalpb.Threshold, af rts.Affectedness, dur int64) {
+	c.saved.inc(ts, af, dur)
+	atomic.AddInt64(&c.total, dur)
+}
+
+// durations returns the total duration and the saved durations for each
+// threshold. Not idempotent.
+func (c *durationCounter) durations() (total *durationpb.Duration, saved []*durationpb.Duration) {
+	c.saved.makeCumulative()
+	saved = make([]*durationpb.Duration, len(c.saved)-1)
+	for i := range saved {
+		saved[i] = durationpb.New(time.Duration(c.saved[i+1]))
+	}
+	return durationpb.New(time.Duration(c.total)), saved
+}
+
+// durationCounters maps a key, such as a test suite, to a durationCounter.
+type durationCounters struct {
+	thresholds int
+	mu         sync.Mutex
+	m          map[string]*durationCounter
+}
+
+func newDurationCounters(thresholds int) *durationCounters {
+	return &durationCounters{
+		thresholds: thresholds,
+		m:          map[string]*durationCounter{},
+	}
+}
+
+// merge adds the counters of other to cs.
+func (cs *durationCounters) merge(other *durationCounters) {
+	for key, oc := range other.m {
+		c := cs.get(key)
+		c.saved.add(oc.saved)
+		c.total += oc.total
+	}
+}
+
+// get returns the counter for the key, creating it if necessary.
+//
+// Goroutine-safe.
+func (cs *durationCounters) get(key string) *durationCounter {
+	cs.mu.Lock()
+	defer cs.mu.Unlock()
+	c, ok := cs.m[key]
+	if !ok {
+		c = &durationCounter{saved: make(bucketSlice, cs.thresholds+1)}
+		cs.m[key] = c
+	}
+	return c
+}
+
+// efficiencyCounters accumulates test durations: in total, and saved by each
+// threshold overall, per test suite and per builder.
+type efficiencyCounters struct {
+	saved    bucketSlice
+	total    int64
+	suites   *durationCounters
+	builders *durationCounters
+}
+
+func newEfficiencyCounters(thresholds int) *efficiencyCounters {
+	return &efficiencyCounters{
+		saved:    make(bucketSlice, thresholds+1),
+		suites:   newDurationCounters(thresholds),
+		builders: newDurationCounters(thresholds),
+	}
+}
+
+// merge adds the counters of other to c.
+func (c *efficiencyCounters) merge(other *efficiencyCounters) {
+	c.saved.add(other.saved)
+	c.total += other.total
+	c.suites.merge(other.suites)
+	c.builders.merge(other.builders)
+}
+
+// concurrency returns the number of workers to process history records.
+func (e *Eval) concurrency() int {
+	if e.Concurrency <= 0 {
+		return runtime.GOMAXPROCS(0)
+	}
+	return e.Concurrency
+}
+
+// goMany calls f in e.concurrency() goroutines, passing the index of the
+// goroutine.
+func (e *Eval) goMany(eg *errgroup.Group, f func(worker int) error) {
+	for i := 0; i < e.concurrency(); i++ {
+		i := i
 		eg.Go(func() error {
-			return f()
+			return f(i)
 		})
 	}
 }
 
+// wrapStrategy returns the strategy to invoke. If e.SerializeStrategy is
+// true, calls to the strategy are serialized.
+func (e *Eval) wrapStrategy(strategy Strategy) Strategy {
+	if e.SerializeStrategy {
+		return Serialize(strategy)
+	}
+	return strategy
+}
+
 // distanceQuantiles returns distance quantiles. Panics if afs is empty.
 func distanceQuantiles(afs []rts.Affectedness, count int) (distances []float32) {
 	if len(afs) == 0 {
@@ -363,6 +790,13 @@ func (b bucketSlice) inc(ts []*evalpb.Threshold, af rts.Affectedness, delta int6
 	atomic.AddInt64(&b[i], delta)
 }
 
+// add adds the counters of other to b.
+func (b bucketSlice) add(other bucketSlice) {
+	for i := range b {
+		b[i] += other[i]
+	}
+}
+
 // makeCumulative makes all counters cumulative.
 // Not idempotent.
 func (b bucketSlice) makeCumulative() {
@@ -371,6 +805,66 @@ func (b bucketSlice) makeCumulative() {
 	}
 }
 
+// preserved converts cumulative losses computed by bucketSlice into the
+// number of preserved data points for each threshold.
+func preserved(total int64, lost bucketSlice) []int64 {
+	ret := make([]int64, len(lost)-1)
+	for i := range ret {
+		ret[i] = total - lost[i+1]
+	}
+	return ret
+}
+
+// testSuiteOf returns the test suite of the test variant, see TestSuiteKey.
+func (e *Eval) testSuiteOf(tv *evalpb.TestVariant) string {
+	if e.TestSuiteKey != nil {
+		return e.TestSuiteKey(tv)
+	}
+	return testSuite(tv)
+}
+
+// builderOf returns the builder of the test variant, see BuilderKey.
+func (e *Eval) builderOf(tv *evalpb.TestVariant) string {
+	if e.BuilderKey != nil {
+		return e.BuilderKey(tv)
+	}
+	return builder(tv)
+}
+
+// testSuite returns the value of the "test_suite" variant key, or an empty
+// string if the test variant does not have it.
+func testSuite(tv *evalpb.TestVariant) string {
+	return variantValue(tv, "test_suite")
+}
+
+// builder returns the value of the "builder" variant key, or an empty
+// string if the test variant does not have it.
+func builder(tv *evalpb.TestVariant) string {
+	return variantValue(tv, "builder")
+}
+
+func variantValue(tv *evalpb.TestVariant, key string) string {
+	prefix := key + ":"
+	for _, kv := range tv.GetVariant() {
+		if strings.HasPrefix(kv, prefix) {
+			return strings.TrimPrefix(kv, prefix)
+		}
+	}
+	return ""
+}
+
+func sortSuites(suites []*evalpb.SuiteResults) {
+	sort.Slice(suites, func(i, j int) bool {
+		return suites[i].Suite < suites[j].Suite
+	})
+}
+
+func sortBuilders(builders []*evalpb.BuilderResults) {
+	sort.Slice(builders, func(i, j int) bool {
+		return builders[i].Builder < builders[j].Builder
+	})
+}
+
 // mostAffected returns the most significant Affectedness by comparing distance.
 func mostAffected(afs []rts.Affectedness) (rts.Affectedness, error) {
 	if len(afs) == 0 {
@@ -385,6 +879,53 @@ func mostAffected(afs []rts.Affectedness) (rts.Affectedness, error) {
 	return most, nil
 }
 
+// mostAffectedByKey returns the affectedness of the most affected test
+// variant in each group, e.g. on each builder. afs[i] is the affectedness of
+// tvs[i].
+func mostAffectedByKey(tvs []*evalpb.TestVariant, afs []rts.Affectedness, groupKey GroupKeyFunc) map[string]rts.Affectedness {
+	ret := map[string]rts.Affectedness{}
+	for i, tv := range tvs {
+		key := groupKey(tv)
+		if most, ok := ret[key]; !ok || most.Distance > afs[i].Distance {
+			ret[key] = afs[i]
+		}
+	}
+	return ret
+}
+
+// builderRecallFlag implements flag.Value for evalpb.BuilderRecall.
+type builderRecallFlag struct {
+	v *evalpb.BuilderRecall
+}
+
+func (f builderRecallFlag) String() string {
+	if f.v == nil {
+		return ""
+	}
+	switch *f.v {
+	case evalpb.BuilderRecall_ANY_BUILDER:
+		return "any"
+	case evalpb.BuilderRecall_ALL_BUILDERS:
+		return "all"
+	default:
+		return ""
+	}
+}
+
+func (f builderRecallFlag) Set(s string) error {
+	switch s {
+	case "":
+		*f.v = evalpb.BuilderRecall_BUILDER_RECALL_UNSPECIFIED
+	case "any":
+		*f.v = evalpb.BuilderRecall_ANY_BUILDER
+	case "all":
+		*f.v = evalpb.BuilderRecall_ALL_BUILDERS
+	default:
+		return errors.Reason(`expected "any" or "all", got %q`, s).Err()
+	}
+	return nil
+}
+
 type furthestRejections []affectedRejection
 type affectedRejection struct {
 	Rejection    *evalpb.Rejection
@@ -6,10 +6,23 @@ package eval
 
 import (
 	"bytes"
+	"compress/gzip"
 	"container/heap"
+	"context"
 	"fmt"
+	"hash/fnv"
+	"os"
+	"path/filepath"
+	"runtime"
 	"strings"
+	"sync/atomic"
 	"testing"
+	"time"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"infra/rts"
 	evalpb "infra/rts/presubmit/eval/proto"
@@ -164,3 +177,325 @@ func TestFurthestRejections(t *testing.T) {
 		So(heap.Pop(&furthest), ShouldResemble, affectedRejection{MostAffected: rts.Affectedness{Distance: 5}})
 	})
 }
+
+func TestEvaluateSafetyPerBuilder(t *testing.T) {
+	t.Parallel()
+	Convey(`EvaluateSafety per builder`, t, func() {
+		ctx := context.Background()
+
+		tv := func(id, builder string) *evalpb.TestVariant {
+			return &evalpb.TestVariant{Id: id, Variant: []string{"builder:" + builder}}
+		}
+		rejections := []proto.Message{
+			// Failed on both builders.
+			&evalpb.Rejection{FailedTestVariants: []*evalpb.TestVariant{tv("t1", "linux-rel"), tv("t1", "win-rel")}},
+			// Failed only on win-rel.
+			&evalpb.Rejection{FailedTestVariants: []*evalpb.TestVariant{tv("t2", "win-rel")}},
+			// Failed only on linux-rel.
+			&evalpb.Rejection{FailedTestVariants: []*evalpb.TestVariant{tv("t3", "linux-rel")}},
+		}
+
+		dir := t.TempDir()
+		So(writeHistoryFile(filepath.Join(dir, "rejections.jsonl.gz"), rejections), ShouldBeNil)
+
+		// The strategy selects all tests on linux-rel and none on win-rel.
+		// It is called from a single goroutine because Concurrency is 1.
+		var builders []string
+		strategy := func(ctx context.Context, in Input, out *Output) error {
+			builders = append(builders, in.Builder)
+			for i, tv := range in.TestVariants {
+				if in.Builder != "" && builder(tv) != in.Builder {
+					return fmt.Errorf("unexpected builder of %s", tv.Id)
+				}
+				if builder(tv) != "linux-rel" {
+					out.TestVariantAffectedness[i].Distance = 1
+				}
+			}
+			return nil
+		}
+
+		evaluate := func(builderRecall evalpb.BuilderRecall) *evalpb.Results {
+			builders = nil
+			e := &Eval{Concurrency: 1, Rejections: dir, BuilderRecall: builderRecall}
+			res, err := e.EvaluateSafety(ctx, strategy)
+			So(err, ShouldBeNil)
+			So(res.Thresholds[0].MaxDistance, ShouldEqual, 0)
+			return res
+		}
+
+		Convey(`Any builder`, func() {
+			res := evaluate(evalpb.BuilderRecall_ANY_BUILDER)
+			So(builders, ShouldHaveLength, 4)
+
+			th := res.Thresholds[0]
+			So(th.ChangeRecall, ShouldEqual, float32(2)/float32(3))
+			So(th.ChangeRecallAnyBuilder, ShouldEqual, float32(2)/float32(3))
+			So(th.ChangeRecallAllBuilders, ShouldEqual, float32(1)/float32(3))
+
+			So(res.Builders, ShouldHaveLength, 2)
+			So(res.Builders[0].Builder, ShouldEqual, "linux-rel")
+			So(res.Builders[0].TotalRejections, ShouldEqual, 2)
+			So(res.Builders[0].PreservedRejections[0], ShouldEqual, 2)
+			So(res.Builders[1].Builder, ShouldEqual, "win-rel")
+			So(res.Builders[1].TotalRejections, ShouldEqual, 2)
+			So(res.Builders[1].PreservedRejections[0], ShouldEqual, 0)
+			So(res.Builders[1].TotalTestFailures, ShouldEqual, 2)
+			So(res.Builders[1].PreservedTestFailures[0], ShouldEqual, 0)
+		})
+
+		Convey(`All builders`, func() {
+			res := evaluate(evalpb.BuilderRecall_ALL_BUILDERS)
+
+			th := res.Thresholds[0]
+			So(th.ChangeRecall, ShouldEqual, float32(1)/float32(3))
+			So(th.ChangeRecallAnyBuilder, ShouldEqual, float32(2)/float32(3))
+			So(th.ChangeRecallAllBuilders, ShouldEqual, float32(1)/float32(3))
+		})
+
+		Convey(`Unspecified`, func() {
+			res := evaluate(evalpb.BuilderRecall_BUILDER_RECALL_UNSPECIFIED)
+			So(builders, ShouldResemble, []string{"", "", ""})
+			So(res.Thresholds[0].ChangeRecall, ShouldEqual, float32(2)/float32(3))
+
+			// Results are still broken down by builder.
+			So(res.Builders, ShouldHaveLength, 2)
+			So(res.Builders[0].Builder, ShouldEqual, "linux-rel")
+			So(res.Builders[0].PreservedRejections[0], ShouldEqual, 2)
+			So(res.Builders[1].Builder, ShouldEqual, "win-rel")
+			So(res.Builders[1].PreservedRejections[0], ShouldEqual, 0)
+		})
+	})
+}
+
+func TestEvaluateSafetyBreakdown(t *testing.T) {
+	t.Parallel()
+	Convey(`EvaluateSafety breaks down results`, t, func() {
+		ctx := context.Background()
+
+		tv := func(id string) *evalpb.TestVariant {
+			return &evalpb.TestVariant{Id: id, Variant: []string{"builder:linux-rel"}}
+		}
+		rejections := []proto.Message{
+			// Both tests are selected.
+			&evalpb.Rejection{FailedTestVariants: []*evalpb.TestVariant{tv("a/selected1"), tv("b/selected1")}},
+			// The test of suite "a" is not selected.
+			&evalpb.Rejection{FailedTestVariants: []*evalpb.TestVariant{tv("a/skipped1"), tv("b/selected2")}},
+			// Only one of the tests of suite "a" is selected.
+			&evalpb.Rejection{FailedTestVariants: []*evalpb.TestVariant{tv("a/selected2"), tv("a/skipped2")}},
+		}
+		dir := t.TempDir()
+		So(writeHistoryFile(filepath.Join(dir, "rejections.jsonl.gz"), rejections), ShouldBeNil)
+
+		strategy := func(ctx context.Context, in Input, out *Output) error {
+			for i, tv := range in.TestVariants {
+				if strings.Contains(tv.Id, "skipped") {
+					out.TestVariantAffectedness[i].Distance = 1
+				}
+			}
+			return nil
+		}
+		e := &Eval{
+			Concurrency: 1,
+			Rejections:  dir,
+			TestSuiteKey: func(tv *evalpb.TestVariant) string {
+				return strings.Split(tv.Id, "/")[0]
+			},
+		}
+		res, err := e.EvaluateSafety(ctx, strategy)
+		So(err, ShouldBeNil)
+		So(res.Thresholds[0].MaxDistance, ShouldEqual, 0)
+
+		So(res.Suites, ShouldHaveLength, 2)
+		a, b := res.Suites[0], res.Suites[1]
+		So(a.Suite, ShouldEqual, "a")
+		So(a.TotalRejections, ShouldEqual, 3)
+		So(a.PreservedRejections[0], ShouldEqual, 2)
+		So(a.TotalTestFailures, ShouldEqual, 4)
+		So(a.PreservedTestFailures[0], ShouldEqual, 2)
+		So(b.Suite, ShouldEqual, "b")
+		So(b.TotalRejections, ShouldEqual, 2)
+		So(b.PreservedRejections[0], ShouldEqual, 2)
+		So(b.TotalTestFailures, ShouldEqual, 2)
+		So(b.PreservedTestFailures[0], ShouldEqual, 2)
+
+		So(res.Builders, ShouldHaveLength, 1)
+		So(res.Builders[0].Builder, ShouldEqual, "linux-rel")
+		So(res.Builders[0].TotalRejections, ShouldEqual, 3)
+		So(res.Builders[0].PreservedRejections[0], ShouldEqual, 3)
+		So(res.Builders[0].TotalTestFailures, ShouldEqual, 6)
+		So(res.Builders[0].PreservedTestFailures[0], ShouldEqual, 4)
+	})
+}
+
+func TestEvaluateConcurrently(t *testing.T) {
+	t.Parallel()
+	Convey(`Evaluate concurrently`, t, func() {
+		ctx := context.Background()
+		rejections, durations := t.TempDir(), t.TempDir()
+		So(generateHistory(rejections, durations, 200), ShouldBeNil)
+
+		evaluate := func(e *Eval, strategy Strategy) *evalpb.Results {
+			e.Rejections = rejections
+			e.Durations = durations
+			e.BuilderRecall = evalpb.BuilderRecall_ANY_BUILDER
+			res, err := e.Run(ctx, strategy)
+			So(err, ShouldBeNil)
+			return res
+		}
+
+		Convey(`Results do not depend on concurrency`, func() {
+			strategy := hashStrategy(0)
+			want := evaluate(&Eval{Concurrency: 1}, strategy)
+			So(want.TotalRejections, ShouldEqual, 200)
+			for _, concurrency := range []int{2, 8, 32} {
+				So(evaluate(&Eval{Concurrency: concurrency}, strategy), ShouldResembleProto, want)
+			}
+		})
+
+		Convey(`SerializeStrategy`, func() {
+			var running, overlaps int32
+			strategy := func(ctx context.Context, in Input, out *Output) error {
+				if atomic.AddInt32(&running, 1) > 1 {
+					atomic.AddInt32(&overlaps, 1)
+				}
+				defer atomic.AddInt32(&running, -1)
+				time.Sleep(10 * time.Microsecond)
+				return hashStrategy(0)(ctx, in, out)
+			}
+			evaluate(&Eval{Concurrency: 8, SerializeStrategy: true}, strategy)
+			So(atomic.LoadInt32(&overlaps), ShouldEqual, 0)
+		})
+	})
+}
+
+// BenchmarkEval evaluates a CPU-bound strategy against a generated history
+// with different concurrency, to show the speedup.
+func BenchmarkEval(b *testing.B) {
+	ctx := context.Background()
+	rejections, durations := b.TempDir(), b.TempDir()
+	if err := generateHistory(rejections, durations, 1000); err != nil {
+		b.Fatal(err)
+	}
+	strategy := hashStrategy(100)
+
+	concurrencies := []int{1, 2, 4}
+	if n := runtime.GOMAXPROCS(0); n > 4 {
+		concurrencies = append(concurrencies, n)
+	}
+	for _, concurrency := range concurrencies {
+		b.Run(fmt.Sprintf("j=%d", concurrency), func(b *testing.B) {
+			e := &Eval{
+				Concurrency: concurrency,
+				Rejections:  rejections,
+				Durations:   durations,
+			}
+			for i := 0; i < b.N; i++ {
+				if _, err := e.Run(ctx, strategy); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+// hashStrategy returns a strategy which derives the distance of a test
+// variant from a hash of its ID and the changed files. The hash is computed
+// work+1 times, to simulate a CPU-bound strategy.
+func hashStrategy(work int) Strategy {
+	return func(ctx context.Context, in Input, out *Output) error {
+		for i, tv := range in.TestVariants {
+			h := fnv.New64a()
+			for j := 0; j <= work; j++ {
+				h.Write([]byte(tv.Id))
+				for _, f := range in.ChangedFiles {
+					h.Write([]byte(f.Path))
+				}
+			}
+			out.TestVariantAffectedness[i].Distance = float64(h.Sum64()%1000) / 100
+		}
+		return nil
+	}
+}
+
+// generateHistory writes n rejections and n test duration records to the
+// given directories, split across several files.
+func generateHistory(rejectionsDir, durationsDir string, n int) error {
+	const files = 4
+	builders := []string{"linux-rel", "mac-rel", "win-rel"}
+	suites := []string{"base_unittests", "browser_tests", "content_unittests", "unit_tests"}
+	tv := func(i int) *evalpb.TestVariant {
+		return &evalpb.TestVariant{
+			Id: fmt.Sprintf("ninja://test_%d", i%50),
+			Variant: []string{
+				"builder:" + builders[i%len(builders)],
+				"test_suite:" + suites[i%len(suites)],
+			},
+		}
+	}
+	patchset := func(i int) *evalpb.GerritPatchset {
+		return &evalpb.GerritPatchset{
+			Change:   &evalpb.GerritChange{Host: "chromium-review.googlesource.com", Number: int64(i)},
+			Patchset: 1,
+			ChangedFiles: []*evalpb.SourceFile{
+				{Repo: "https://chromium.googlesource.com/chromium/src", Path: fmt.Sprintf("//dir_%d/file_%d.cc", i%7, i)},
+			},
+		}
+	}
+	start := time.Date(2021, time.November, 1, 0, 0, 0, 0, time.UTC)
+
+	rejections := make([][]proto.Message, files)
+	durations := make([][]proto.Message, files)
+	for i := 0; i < n; i++ {
+		rej := &evalpb.Rejection{
+			Patchsets: []*evalpb.GerritPatchset{patchset(i)},
+			Timestamp: timestamppb.New(start.Add(time.Duration(i) * time.Hour)),
+		}
+		for j := 0; j < 1+i%5; j++ {
+			rej.FailedTestVariants = append(rej.FailedTestVariants, tv(i+j))
+		}
+		rejections[i%files] = append(rejections[i%files], rej)
+
+		rec := &evalpb.TestDurationRecord{Patchsets: []*evalpb.GerritPatchset{patchset(i)}}
+		for j := 0; j < 20; j++ {
+			rec.TestDurations = append(rec.TestDurations, &evalpb.TestDuration{
+				TestVariant: tv(i + j),
+				Duration:    durationpb.New(time.Duration(1+(i+j)%10) * time.Second),
+			})
+		}
+		durations[i%files] = append(durations[i%files], rec)
+	}
+
+	for i := 0; i < files; i++ {
+		name := fmt.Sprintf("%d.jsonl.gz", i)
+		if err := writeHistoryFile(filepath.Join(rejectionsDir, name), rejections[i]); err != nil {
+			return err
+		}
+		if err := writeHistoryFile(filepath.Join(durationsDir, name), durations[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// writeHistoryFile writes the records to a .jsonl.gz file.
+func writeHistoryFile(path string, records []proto.Message) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	gz := gzip.NewWriter(f)
+	for _, m := range records {
+		b, err := protojson.Marshal(m)
+		if err != nil {
+			return err
+		}
+		if _, err := fmt.Fprintf(gz, "%s\n", b); err != nil {
+			return err
+		}
+	}
+	if err := gz.Close(); err != nil {
+		return err
+	}
+	return f.Close()
+}
@@ -19,16 +19,38 @@ import (
 	evalpb "infra/rts/presubmit/eval/proto"
 )
 
+// recordPos is the position of a record in a history directory.
+type recordPos struct {
+	// file is the index of the file, in the lexical order of file names.
+	file int
+	// line is the index of the record in the file.
+	line int
+}
+
+func (p recordPos) less(other recordPos) bool {
+	if p.file != other.file {
+		return p.file < other.file
+	}
+	return p.line < other.line
+}
+
+// rejectionRecord is a rejection read from a history directory.
+type rejectionRecord struct {
+	pos       recordPos
+	rejection *evalpb.Rejection
+}
+
 // readRejections reads rejections from a directory.
-func readRejections(ctx context.Context, dir string, dest chan<- *evalpb.Rejection) error {
-	return readHistoryRecords(dir, func(entry []byte) error {
+// The rejections are sent in no particular order; see rejectionRecord.pos.
+func readRejections(ctx context.Context, dir string, dest chan<- rejectionRecord) error {
+	return readHistoryRecords(dir, func(pos recordPos, entry []byte) error {
 		rej := &evalpb.Rejection{}
 		if err := protojson.Unmarshal(entry, rej); err != nil {
 			return err
 		}
 		select {
 		case <-ctx.Done():
-		case dest <- rej:
+		case dest <- rejectionRecord{pos: pos, rejection: rej}:
 		}
 		return ctx.Err()
 	})
@@ -36,7 +58,7 @@ func readRejections(ctx context.Context, dir string, dest chan<- *evalpb.Rejecti
 
 // readTestDurations reads test duration records from a directory.
 func readTestDurations(ctx context.Context, dir string, dest chan<- *evalpb.TestDurationRecord) error {
-	return readHistoryRecords(dir, func(entry []byte) error {
+	return readHistoryRecords(dir, func(_ recordPos, entry []byte) error {
 		td := &evalpb.TestDurationRecord{}
 		if err := protojson.Unmarshal(entry, td); err != nil {
 			return err
@@ -50,8 +72,8 @@ func readTestDurations(ctx context.Context, dir string, dest chan<- *evalpb.Test
 }
 
 // readHistoryRecords reads JSON values from .jsonl.gz files in the given
-// directory.
-func readHistoryRecords(dir string, callback func(entry []byte) error) error {
+// directory. Files are read in parallel.
+func readHistoryRecords(dir string, callback func(pos recordPos, entry []byte) error) error {
 	// Check dir existance first, because filepath.Glob quietly returns an empty
 	// slice if the directory doesn't exist.
 	switch st, err := os.Stat(dir); {
@@ -67,7 +89,8 @@ func readHistoryRecords(dir string, callback func(entry []byte) error) error {
 	}
 
 	return parallel.WorkPool(100, func(work chan<- func() error) {
-		for _, fileName := range files {
+		for i, fileName := range files {
+			i := i
 			fileName := fileName
 			work <- func() error {
 				// Open the file.
@@ -87,8 +110,8 @@ func readHistoryRecords(dir string, callback func(entry []byte) error) error {
 				// Split by line.
 				scan := bufio.NewScanner(gz)
 				scan.Buffer(nil, 1e8) // 100 MB.
-				for scan.Scan() {
-					if err := callback(scan.Bytes()); err != nil {
+				for line := 0; scan.Scan(); line++ {
+					if err := callback(recordPos{file: i, line: line}, scan.Bytes()); err != nil {
 						return err
 					}
 				}
@@ -35,6 +35,13 @@ func Main(ctx context.Context, strategy Strategy) {
 	}
 
 	PrintResults(res, os.Stdout, 0 /* minChangeRecall */)
+	PrintBreakdown(res, os.Stdout, BreakdownChangeRecall)
+	if err := ev.WriteHTMLReport(res); err != nil {
+		fatal(err)
+	}
+	if err := ev.WriteBreakdown(res); err != nil {
+		fatal(err)
+	}
 	os.Exit(0)
 }
 
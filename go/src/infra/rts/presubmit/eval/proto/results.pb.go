@@ -25,6 +25,63 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// Defines when a rejection is preserved, given that tests are selected
+// separately for each builder, like CQ does.
+// A rejection's failed tests are grouped by the builder they failed on.
+type BuilderRecall int32
+
+const (
+	// The rejection is evaluated as a whole, regardless of builders.
+	BuilderRecall_BUILDER_RECALL_UNSPECIFIED BuilderRecall = 0
+	// The rejection is preserved if at least one failed test is selected on
+	// at least one of the builders where tests failed.
+	BuilderRecall_ANY_BUILDER BuilderRecall = 1
+	// The rejection is preserved if at least one failed test is selected on
+	// each of the builders where tests failed.
+	BuilderRecall_ALL_BUILDERS BuilderRecall = 2
+)
+
+// Enum value maps for BuilderRecall.
+var (
+	BuilderRecall_name = map[int32]string{
+		0: "BUILDER_RECALL_UNSPECIFIED",
+		1: "ANY_BUILDER",
+		2: "ALL_BUILDERS",
+	}
+	BuilderRecall_value = map[string]int32{
+		"BUILDER_RECALL_UNSPECIFIED": 0,
+		"ANY_BUILDER":                1,
+		"ALL_BUILDERS":               2,
+	}
+)
+
+func (x BuilderRecall) Enum() *BuilderRecall {
+	p := new(BuilderRecall)
+	*p = x
+	return p
+}
+
+func (x BuilderRecall) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BuilderRecall) Descriptor() protoreflect.EnumDescriptor {
+	return file_infra_rts_presubmit_eval_proto_results_proto_enumTypes[0].Descriptor()
+}
+
+func (BuilderRecall) Type() protoreflect.EnumType {
+	return &file_infra_rts_presubmit_eval_proto_results_proto_enumTypes[0]
+}
+
+func (x BuilderRecall) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BuilderRecall.Descriptor instead.
+func (BuilderRecall) EnumDescriptor() ([]byte, []int) {
+	return file_infra_rts_presubmit_eval_proto_results_proto_rawDescGZIP(), []int{0}
+}
+
 // Results of evaluation.
 type Results struct {
 	state         protoimpl.MessageState
@@ -42,6 +99,19 @@ type Results struct {
 	TotalDuration *durationpb.Duration `protobuf:"bytes,4,opt,name=total_duration,json=totalDuration,proto3" json:"total_duration,omitempty"`
 	// Statistics of the distance to the closest failed test, for each rejection.
 	RejectionClosestDistanceStats *DistanceStats `protobuf:"bytes,5,opt,name=rejection_closest_distance_stats,json=rejectionClosestDistanceStats,proto3" json:"rejection_closest_distance_stats,omitempty"`
+	// Results broken down by the day of the rejection.
+	// Sorted by ascending date.
+	Daily []*DailyResults `protobuf:"bytes,6,rep,name=daily,proto3" json:"daily,omitempty"`
+	// Results broken down by test suite.
+	// Sorted by suite name.
+	Suites []*SuiteResults `protobuf:"bytes,7,rep,name=suites,proto3" json:"suites,omitempty"`
+	// How rejections were evaluated with respect to builders.
+	// If not BUILDER_RECALL_UNSPECIFIED, then thresholds are based on the
+	// distances under this semantics.
+	BuilderRecall BuilderRecall `protobuf:"varint,8,opt,name=builder_recall,json=builderRecall,proto3,enum=chrome.rts.presubmit.eval.BuilderRecall" json:"builder_recall,omitempty"`
+	// Results broken down by builder.
+	// Sorted by builder name.
+	Builders []*BuilderResults `protobuf:"bytes,9,rep,name=builders,proto3" json:"builders,omitempty"`
 }
 
 func (x *Results) Reset() {
@@ -111,6 +181,318 @@ func (x *Results) GetRejectionClosestDistanceStats() *DistanceStats {
 	return nil
 }
 
+func (x *Results) GetDaily() []*DailyResults {
+	if x != nil {
+		return x.Daily
+	}
+	return nil
+}
+
+func (x *Results) GetSuites() []*SuiteResults {
+	if x != nil {
+		return x.Suites
+	}
+	return nil
+}
+
+func (x *Results) GetBuilderRecall() BuilderRecall {
+	if x != nil {
+		return x.BuilderRecall
+	}
+	return BuilderRecall_BUILDER_RECALL_UNSPECIFIED
+}
+
+func (x *Results) GetBuilders() []*BuilderResults {
+	if x != nil {
+		return x.Builders
+	}
+	return nil
+}
+
+// Results for rejections that happened on the same day.
+type DailyResults struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The day in YYYY-MM-DD format, in UTC.
+	Date string `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	// The number of analyzed rejections on this day.
+	TotalRejections int64 `protobuf:"varint,2,opt,name=total_rejections,json=totalRejections,proto3" json:"total_rejections,omitempty"`
+	// The number of preserved rejections for each of Results.thresholds,
+	// in the same order.
+	PreservedRejections []int64 `protobuf:"varint,3,rep,packed,name=preserved_rejections,json=preservedRejections,proto3" json:"preserved_rejections,omitempty"`
+}
+
+func (x *DailyResults) Reset() {
+	*x = DailyResults{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_infra_rts_presubmit_eval_proto_results_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DailyResults) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DailyResults) ProtoMessage() {}
+
+func (x *DailyResults) ProtoReflect() protoreflect.Message {
+	mi := &file_infra_rts_presubmit_eval_proto_results_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DailyResults.ProtoReflect.Descriptor instead.
+func (*DailyResults) Descriptor() ([]byte, []int) {
+	return file_infra_rts_presubmit_eval_proto_results_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *DailyResults) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *DailyResults) GetTotalRejections() int64 {
+	if x != nil {
+		return x.TotalRejections
+	}
+	return 0
+}
+
+func (x *DailyResults) GetPreservedRejections() []int64 {
+	if x != nil {
+		return x.PreservedRejections
+	}
+	return nil
+}
+
+// Results for test variants of the same test suite.
+type SuiteResults struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The test suite, as determined by the evaluation's test suite key
+	// function. By default, the value of the "test_suite" variant key.
+	// Empty if the suite of the test variants is unknown.
+	Suite string `protobuf:"bytes,1,opt,name=suite,proto3" json:"suite,omitempty"`
+	// The number of analyzed test failures in this suite.
+	TotalTestFailures int64 `protobuf:"varint,2,opt,name=total_test_failures,json=totalTestFailures,proto3" json:"total_test_failures,omitempty"`
+	// The number of preserved test failures for each of Results.thresholds,
+	// in the same order.
+	PreservedTestFailures []int64 `protobuf:"varint,3,rep,packed,name=preserved_test_failures,json=preservedTestFailures,proto3" json:"preserved_test_failures,omitempty"`
+	// The sum of analyzed test durations in this suite.
+	TotalDuration *durationpb.Duration `protobuf:"bytes,4,opt,name=total_duration,json=totalDuration,proto3" json:"total_duration,omitempty"`
+	// The sum of test durations for skipped tests for each of
+	// Results.thresholds, in the same order.
+	SavedDurations []*durationpb.Duration `protobuf:"bytes,5,rep,name=saved_durations,json=savedDurations,proto3" json:"saved_durations,omitempty"`
+	// The number of analyzed rejections with test failures in this suite.
+	TotalRejections int64 `protobuf:"varint,6,opt,name=total_rejections,json=totalRejections,proto3" json:"total_rejections,omitempty"`
+	// The number of rejections where at least one failed test of this suite
+	// was selected, for each of Results.thresholds, in the same order.
+	PreservedRejections []int64 `protobuf:"varint,7,rep,packed,name=preserved_rejections,json=preservedRejections,proto3" json:"preserved_rejections,omitempty"`
+}
+
+func (x *SuiteResults) Reset() {
+	*x = SuiteResults{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_infra_rts_presubmit_eval_proto_results_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SuiteResults) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuiteResults) ProtoMessage() {}
+
+func (x *SuiteResults) ProtoReflect() protoreflect.Message {
+	mi := &file_infra_rts_presubmit_eval_proto_results_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuiteResults.ProtoReflect.Descriptor instead.
+func (*SuiteResults) Descriptor() ([]byte, []int) {
+	return file_infra_rts_presubmit_eval_proto_results_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SuiteResults) GetSuite() string {
+	if x != nil {
+		return x.Suite
+	}
+	return ""
+}
+
+func (x *SuiteResults) GetTotalTestFailures() int64 {
+	if x != nil {
+		return x.TotalTestFailures
+	}
+	return 0
+}
+
+func (x *SuiteResults) GetPreservedTestFailures() []int64 {
+	if x != nil {
+		return x.PreservedTestFailures
+	}
+	return nil
+}
+
+func (x *SuiteResults) GetTotalDuration() *durationpb.Duration {
+	if x != nil {
+		return x.TotalDuration
+	}
+	return nil
+}
+
+func (x *SuiteResults) GetSavedDurations() []*durationpb.Duration {
+	if x != nil {
+		return x.SavedDurations
+	}
+	return nil
+}
+
+func (x *SuiteResults) GetTotalRejections() int64 {
+	if x != nil {
+		return x.TotalRejections
+	}
+	return 0
+}
+
+func (x *SuiteResults) GetPreservedRejections() []int64 {
+	if x != nil {
+		return x.PreservedRejections
+	}
+	return nil
+}
+
+// Results for test variants of the same builder.
+type BuilderResults struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The builder, as determined by the evaluation's builder key function.
+	// By default, the value of the "builder" variant key.
+	// Empty if the builder of the test variants is unknown.
+	Builder string `protobuf:"bytes,1,opt,name=builder,proto3" json:"builder,omitempty"`
+	// The number of analyzed rejections with test failures on this builder.
+	TotalRejections int64 `protobuf:"varint,2,opt,name=total_rejections,json=totalRejections,proto3" json:"total_rejections,omitempty"`
+	// The number of rejections where at least one failed test was selected on
+	// this builder, for each of Results.thresholds, in the same order.
+	PreservedRejections []int64 `protobuf:"varint,3,rep,packed,name=preserved_rejections,json=preservedRejections,proto3" json:"preserved_rejections,omitempty"`
+	// The sum of analyzed test durations on this builder.
+	TotalDuration *durationpb.Duration `protobuf:"bytes,4,opt,name=total_duration,json=totalDuration,proto3" json:"total_duration,omitempty"`
+	// The sum of test durations for skipped tests for each of
+	// Results.thresholds, in the same order.
+	SavedDurations []*durationpb.Duration `protobuf:"bytes,5,rep,name=saved_durations,json=savedDurations,proto3" json:"saved_durations,omitempty"`
+	// The number of analyzed test failures on this builder.
+	TotalTestFailures int64 `protobuf:"varint,6,opt,name=total_test_failures,json=totalTestFailures,proto3" json:"total_test_failures,omitempty"`
+	// The number of preserved test failures on this builder for each of
+	// Results.thresholds, in the same order.
+	PreservedTestFailures []int64 `protobuf:"varint,7,rep,packed,name=preserved_test_failures,json=preservedTestFailures,proto3" json:"preserved_test_failures,omitempty"`
+}
+
+func (x *BuilderResults) Reset() {
+	*x = BuilderResults{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_infra_rts_presubmit_eval_proto_results_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BuilderResults) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BuilderResults) ProtoMessage() {}
+
+func (x *BuilderResults) ProtoReflect() protoreflect.Message {
+	mi := &file_infra_rts_presubmit_eval_proto_results_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BuilderResults.ProtoReflect.Descriptor instead.
+func (*BuilderResults) Descriptor() ([]byte, []int) {
+	return file_infra_rts_presubmit_eval_proto_results_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *BuilderResults) GetBuilder() string {
+	if x != nil {
+		return x.Builder
+	}
+	return ""
+}
+
+func (x *BuilderResults) GetTotalRejections() int64 {
+	if x != nil {
+		return x.TotalRejections
+	}
+	return 0
+}
+
+func (x *BuilderResults) GetPreservedRejections() []int64 {
+	if x != nil {
+		return x.PreservedRejections
+	}
+	return nil
+}
+
+func (x *BuilderResults) GetTotalDuration() *durationpb.Duration {
+	if x != nil {
+		return x.TotalDuration
+	}
+	return nil
+}
+
+func (x *BuilderResults) GetSavedDurations() []*durationpb.Duration {
+	if x != nil {
+		return x.SavedDurations
+	}
+	return nil
+}
+
+func (x *BuilderResults) GetTotalTestFailures() int64 {
+	if x != nil {
+		return x.TotalTestFailures
+	}
+	return 0
+}
+
+func (x *BuilderResults) GetPreservedTestFailures() []int64 {
+	if x != nil {
+		return x.PreservedTestFailures
+	}
+	return nil
+}
+
 // Collected statistics of distances.
 type DistanceStats struct {
 	state         protoimpl.MessageState
@@ -125,7 +507,7 @@ type DistanceStats struct {
 func (x *DistanceStats) Reset() {
 	*x = DistanceStats{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_infra_rts_presubmit_eval_proto_results_proto_msgTypes[1]
+		mi := &file_infra_rts_presubmit_eval_proto_results_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -138,7 +520,7 @@ func (x *DistanceStats) String() string {
 func (*DistanceStats) ProtoMessage() {}
 
 func (x *DistanceStats) ProtoReflect() protoreflect.Message {
-	mi := &file_infra_rts_presubmit_eval_proto_results_proto_msgTypes[1]
+	mi := &file_infra_rts_presubmit_eval_proto_results_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -151,7 +533,7 @@ func (x *DistanceStats) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DistanceStats.ProtoReflect.Descriptor instead.
 func (*DistanceStats) Descriptor() ([]byte, []int) {
-	return file_infra_rts_presubmit_eval_proto_results_proto_rawDescGZIP(), []int{1}
+	return file_infra_rts_presubmit_eval_proto_results_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *DistanceStats) GetPercentiles() []float32 {
@@ -189,12 +571,20 @@ type Threshold struct {
 	TestRecall float32 `protobuf:"fixed32,6,opt,name=test_recall,json=testRecall,proto3" json:"test_recall,omitempty"`
 	// The fraction of test duration that was cut.
 	Savings float32 `protobuf:"fixed32,7,opt,name=savings,proto3" json:"savings,omitempty"`
+	// The fraction of rejections that were preserved under ANY_BUILDER
+	// semantics. Populated only if Results.builder_recall is not
+	// BUILDER_RECALL_UNSPECIFIED.
+	ChangeRecallAnyBuilder float32 `protobuf:"fixed32,8,opt,name=change_recall_any_builder,json=changeRecallAnyBuilder,proto3" json:"change_recall_any_builder,omitempty"`
+	// The fraction of rejections that were preserved under ALL_BUILDERS
+	// semantics. Populated only if Results.builder_recall is not
+	// BUILDER_RECALL_UNSPECIFIED.
+	ChangeRecallAllBuilders float32 `protobuf:"fixed32,9,opt,name=change_recall_all_builders,json=changeRecallAllBuilders,proto3" json:"change_recall_all_builders,omitempty"`
 }
 
 func (x *Threshold) Reset() {
 	*x = Threshold{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_infra_rts_presubmit_eval_proto_results_proto_msgTypes[2]
+		mi := &file_infra_rts_presubmit_eval_proto_results_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -207,7 +597,7 @@ func (x *Threshold) String() string {
 func (*Threshold) ProtoMessage() {}
 
 func (x *Threshold) ProtoReflect() protoreflect.Message {
-	mi := &file_infra_rts_presubmit_eval_proto_results_proto_msgTypes[2]
+	mi := &file_infra_rts_presubmit_eval_proto_results_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -220,7 +610,7 @@ func (x *Threshold) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Threshold.ProtoReflect.Descriptor instead.
 func (*Threshold) Descriptor() ([]byte, []int) {
-	return file_infra_rts_presubmit_eval_proto_results_proto_rawDescGZIP(), []int{2}
+	return file_infra_rts_presubmit_eval_proto_results_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *Threshold) GetMaxDistance() float32 {
@@ -272,6 +662,20 @@ func (x *Threshold) GetSavings() float32 {
 	return 0
 }
 
+func (x *Threshold) GetChangeRecallAnyBuilder() float32 {
+	if x != nil {
+		return x.ChangeRecallAnyBuilder
+	}
+	return 0
+}
+
+func (x *Threshold) GetChangeRecallAllBuilders() float32 {
+	if x != nil {
+		return x.ChangeRecallAllBuilders
+	}
+	return 0
+}
+
 var File_infra_rts_presubmit_eval_proto_results_proto protoreflect.FileDescriptor
 
 var file_infra_rts_presubmit_eval_proto_results_proto_rawDesc = []byte{
@@ -281,7 +685,7 @@ var file_infra_rts_presubmit_eval_proto_results_proto_rawDesc = []byte{
 	0x63, 0x68, 0x72, 0x6f, 0x6d, 0x65, 0x2e, 0x72, 0x74, 0x73, 0x2e, 0x70, 0x72, 0x65, 0x73, 0x75,
 	0x62, 0x6d, 0x69, 0x74, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
 	0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xdf, 0x02, 0x0a, 0x07, 0x52, 0x65,
+	0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xf7, 0x04, 0x0a, 0x07, 0x52, 0x65,
 	0x73, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x44, 0x0a, 0x0a, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f,
 	0x6c, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x63, 0x68, 0x72, 0x6f,
 	0x6d, 0x65, 0x2e, 0x72, 0x74, 0x73, 0x2e, 0x70, 0x72, 0x65, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x74,
@@ -303,35 +707,120 @@ var file_infra_rts_presubmit_eval_proto_results_proto_rawDesc = []byte{
 	0x70, 0x72, 0x65, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e, 0x44,
 	0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x1d, 0x72, 0x65,
 	0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x73, 0x74, 0x44, 0x69,
-	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x22, 0x51, 0x0a, 0x0d, 0x44,
-	0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x20, 0x0a, 0x0b,
-	0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x02, 0x52, 0x0b, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x1e,
-	0x0a, 0x0b, 0x6d, 0x61, 0x78, 0x5f, 0x6e, 0x6f, 0x6e, 0x5f, 0x69, 0x6e, 0x66, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x02, 0x52, 0x09, 0x6d, 0x61, 0x78, 0x4e, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x22, 0xbb,
-	0x02, 0x0a, 0x09, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x21, 0x0a, 0x0c,
-	0x6d, 0x61, 0x78, 0x5f, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x02, 0x52, 0x0b, 0x6d, 0x61, 0x78, 0x44, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12,
-	0x31, 0x0a, 0x14, 0x70, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x5f, 0x72, 0x65, 0x6a,
-	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x13, 0x70,
-	0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x12, 0x36, 0x0a, 0x17, 0x70, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x5f,
-	0x74, 0x65, 0x73, 0x74, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x73, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x15, 0x70, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x54, 0x65,
-	0x73, 0x74, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x73, 0x12, 0x40, 0x0a, 0x0e, 0x73, 0x61,
-	0x76, 0x65, 0x64, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x73,
-	0x61, 0x76, 0x65, 0x64, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x0d,
-	0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x72, 0x65, 0x63, 0x61, 0x6c, 0x6c, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x02, 0x52, 0x0c, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x63, 0x61, 0x6c,
-	0x6c, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x72, 0x65, 0x63, 0x61, 0x6c, 0x6c,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0a, 0x74, 0x65, 0x73, 0x74, 0x52, 0x65, 0x63, 0x61,
-	0x6c, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x61, 0x76, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x07, 0x20,
-	0x01, 0x28, 0x02, 0x52, 0x07, 0x73, 0x61, 0x76, 0x69, 0x6e, 0x67, 0x73, 0x42, 0x27, 0x5a, 0x25,
-	0x69, 0x6e, 0x66, 0x72, 0x61, 0x2f, 0x72, 0x74, 0x73, 0x2f, 0x70, 0x72, 0x65, 0x73, 0x75, 0x62,
-	0x6d, 0x69, 0x74, 0x2f, 0x65, 0x76, 0x61, 0x6c, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x3b, 0x65,
-	0x76, 0x61, 0x6c, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x3d, 0x0a, 0x05, 0x64,
+	0x61, 0x69, 0x6c, 0x79, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63, 0x68, 0x72,
+	0x6f, 0x6d, 0x65, 0x2e, 0x72, 0x74, 0x73, 0x2e, 0x70, 0x72, 0x65, 0x73, 0x75, 0x62, 0x6d, 0x69,
+	0x74, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e, 0x44, 0x61, 0x69, 0x6c, 0x79, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x73, 0x52, 0x05, 0x64, 0x61, 0x69, 0x6c, 0x79, 0x12, 0x3f, 0x0a, 0x06, 0x73, 0x75,
+	0x69, 0x74, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63, 0x68, 0x72,
+	0x6f, 0x6d, 0x65, 0x2e, 0x72, 0x74, 0x73, 0x2e, 0x70, 0x72, 0x65, 0x73, 0x75, 0x62, 0x6d, 0x69,
+	0x74, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e, 0x53, 0x75, 0x69, 0x74, 0x65, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x73, 0x52, 0x06, 0x73, 0x75, 0x69, 0x74, 0x65, 0x73, 0x12, 0x4f, 0x0a, 0x0e, 0x62,
+	0x75, 0x69, 0x6c, 0x64, 0x65, 0x72, 0x5f, 0x72, 0x65, 0x63, 0x61, 0x6c, 0x6c, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x28, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x65, 0x2e, 0x72, 0x74, 0x73,
+	0x2e, 0x70, 0x72, 0x65, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e,
+	0x42, 0x75, 0x69, 0x6c, 0x64, 0x65, 0x72, 0x52, 0x65, 0x63, 0x61, 0x6c, 0x6c, 0x52, 0x0d, 0x62,
+	0x75, 0x69, 0x6c, 0x64, 0x65, 0x72, 0x52, 0x65, 0x63, 0x61, 0x6c, 0x6c, 0x12, 0x45, 0x0a, 0x08,
+	0x62, 0x75, 0x69, 0x6c, 0x64, 0x65, 0x72, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29,
+	0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x65, 0x2e, 0x72, 0x74, 0x73, 0x2e, 0x70, 0x72, 0x65, 0x73,
+	0x75, 0x62, 0x6d, 0x69, 0x74, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e, 0x42, 0x75, 0x69, 0x6c, 0x64,
+	0x65, 0x72, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x52, 0x08, 0x62, 0x75, 0x69, 0x6c, 0x64,
+	0x65, 0x72, 0x73, 0x22, 0x80, 0x01, 0x0a, 0x0c, 0x44, 0x61, 0x69, 0x6c, 0x79, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x5f, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0x31, 0x0a, 0x14, 0x70, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64,
+	0x5f, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x03, 0x52, 0x13, 0x70, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x52, 0x65, 0x6a, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xf0, 0x02, 0x0a, 0x0c, 0x53, 0x75, 0x69, 0x74, 0x65,
+	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x75, 0x69, 0x74, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x75, 0x69, 0x74, 0x65, 0x12, 0x2e, 0x0a,
+	0x13, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x66, 0x61, 0x69, 0x6c,
+	0x75, 0x72, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x54, 0x65, 0x73, 0x74, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x73, 0x12, 0x36, 0x0a,
+	0x17, 0x70, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x5f, 0x74, 0x65, 0x73, 0x74, 0x5f,
+	0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x03, 0x52, 0x15,
+	0x70, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x54, 0x65, 0x73, 0x74, 0x46, 0x61, 0x69,
+	0x6c, 0x75, 0x72, 0x65, 0x73, 0x12, 0x40, 0x0a, 0x0e, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x64,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x44,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x42, 0x0a, 0x0f, 0x73, 0x61, 0x76, 0x65, 0x64,
+	0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0e, 0x73, 0x61, 0x76,
+	0x65, 0x64, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x52, 0x65, 0x6a, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x31, 0x0a, 0x14, 0x70, 0x72, 0x65, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x64, 0x5f, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x07,
+	0x20, 0x03, 0x28, 0x03, 0x52, 0x13, 0x70, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x52,
+	0x65, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xf6, 0x02, 0x0a, 0x0e, 0x42, 0x75,
+	0x69, 0x6c, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x18, 0x0a, 0x07,
+	0x62, 0x75, 0x69, 0x6c, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x62,
+	0x75, 0x69, 0x6c, 0x64, 0x65, 0x72, 0x12, 0x29, 0x0a, 0x10, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f,
+	0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x12, 0x31, 0x0a, 0x14, 0x70, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x5f, 0x72,
+	0x65, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x03, 0x52,
+	0x13, 0x70, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x40, 0x0a, 0x0e, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x64, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x44, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x42, 0x0a, 0x0f, 0x73, 0x61, 0x76, 0x65, 0x64, 0x5f,
+	0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0e, 0x73, 0x61, 0x76, 0x65,
+	0x64, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2e, 0x0a, 0x13, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x5f, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65,
+	0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x54, 0x65,
+	0x73, 0x74, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x73, 0x12, 0x36, 0x0a, 0x17, 0x70, 0x72,
+	0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x5f, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x66, 0x61, 0x69,
+	0x6c, 0x75, 0x72, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x03, 0x52, 0x15, 0x70, 0x72, 0x65,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x54, 0x65, 0x73, 0x74, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72,
+	0x65, 0x73, 0x22, 0x51, 0x0a, 0x0d, 0x44, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x53, 0x74,
+	0x61, 0x74, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x69, 0x6c,
+	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x02, 0x52, 0x0b, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e,
+	0x74, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x1e, 0x0a, 0x0b, 0x6d, 0x61, 0x78, 0x5f, 0x6e, 0x6f, 0x6e,
+	0x5f, 0x69, 0x6e, 0x66, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02, 0x52, 0x09, 0x6d, 0x61, 0x78, 0x4e,
+	0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x22, 0xb3, 0x03, 0x0a, 0x09, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68,
+	0x6f, 0x6c, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x61, 0x78, 0x5f, 0x64, 0x69, 0x73, 0x74, 0x61,
+	0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0b, 0x6d, 0x61, 0x78, 0x44, 0x69,
+	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x31, 0x0a, 0x14, 0x70, 0x72, 0x65, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x64, 0x5f, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x13, 0x70, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x52,
+	0x65, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x36, 0x0a, 0x17, 0x70, 0x72, 0x65,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x5f, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x66, 0x61, 0x69, 0x6c,
+	0x75, 0x72, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x15, 0x70, 0x72, 0x65, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x64, 0x54, 0x65, 0x73, 0x74, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65,
+	0x73, 0x12, 0x40, 0x0a, 0x0e, 0x73, 0x61, 0x76, 0x65, 0x64, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x73, 0x61, 0x76, 0x65, 0x64, 0x44, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x72, 0x65,
+	0x63, 0x61, 0x6c, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0c, 0x63, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x52, 0x65, 0x63, 0x61, 0x6c, 0x6c, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x65, 0x73, 0x74,
+	0x5f, 0x72, 0x65, 0x63, 0x61, 0x6c, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0a, 0x74,
+	0x65, 0x73, 0x74, 0x52, 0x65, 0x63, 0x61, 0x6c, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x61, 0x76,
+	0x69, 0x6e, 0x67, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x02, 0x52, 0x07, 0x73, 0x61, 0x76, 0x69,
+	0x6e, 0x67, 0x73, 0x12, 0x39, 0x0a, 0x19, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x72, 0x65,
+	0x63, 0x61, 0x6c, 0x6c, 0x5f, 0x61, 0x6e, 0x79, 0x5f, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x65, 0x72,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x02, 0x52, 0x16, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65,
+	0x63, 0x61, 0x6c, 0x6c, 0x41, 0x6e, 0x79, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x65, 0x72, 0x12, 0x3b,
+	0x0a, 0x1a, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x72, 0x65, 0x63, 0x61, 0x6c, 0x6c, 0x5f,
+	0x61, 0x6c, 0x6c, 0x5f, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x65, 0x72, 0x73, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x02, 0x52, 0x17, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x63, 0x61, 0x6c, 0x6c,
+	0x41, 0x6c, 0x6c, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x65, 0x72, 0x73, 0x2a, 0x52, 0x0a, 0x0d, 0x42,
+	0x75, 0x69, 0x6c, 0x64, 0x65, 0x72, 0x52, 0x65, 0x63, 0x61, 0x6c, 0x6c, 0x12, 0x1e, 0x0a, 0x1a,
+	0x42, 0x55, 0x49, 0x4c, 0x44, 0x45, 0x52, 0x5f, 0x52, 0x45, 0x43, 0x41, 0x4c, 0x4c, 0x5f, 0x55,
+	0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b,
+	0x41, 0x4e, 0x59, 0x5f, 0x42, 0x55, 0x49, 0x4c, 0x44, 0x45, 0x52, 0x10, 0x01, 0x12, 0x10, 0x0a,
+	0x0c, 0x41, 0x4c, 0x4c, 0x5f, 0x42, 0x55, 0x49, 0x4c, 0x44, 0x45, 0x52, 0x53, 0x10, 0x02, 0x42,
+	0x27, 0x5a, 0x25, 0x69, 0x6e, 0x66, 0x72, 0x61, 0x2f, 0x72, 0x74, 0x73, 0x2f, 0x70, 0x72, 0x65,
+	0x73, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x2f, 0x65, 0x76, 0x61, 0x6c, 0x2f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x3b, 0x65, 0x76, 0x61, 0x6c, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -346,23 +835,36 @@ func file_infra_rts_presubmit_eval_proto_results_proto_rawDescGZIP() []byte {
 	return file_infra_rts_presubmit_eval_proto_results_proto_rawDescData
 }
 
-var file_infra_rts_presubmit_eval_proto_results_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_infra_rts_presubmit_eval_proto_results_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_infra_rts_presubmit_eval_proto_results_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
 var file_infra_rts_presubmit_eval_proto_results_proto_goTypes = []interface{}{
-	(*Results)(nil),             // 0: chrome.rts.presubmit.eval.Results
-	(*DistanceStats)(nil),       // 1: chrome.rts.presubmit.eval.DistanceStats
-	(*Threshold)(nil),           // 2: chrome.rts.presubmit.eval.Threshold
-	(*durationpb.Duration)(nil), // 3: google.protobuf.Duration
+	(BuilderRecall)(0),          // 0: chrome.rts.presubmit.eval.BuilderRecall
+	(*Results)(nil),             // 1: chrome.rts.presubmit.eval.Results
+	(*DailyResults)(nil),        // 2: chrome.rts.presubmit.eval.DailyResults
+	(*SuiteResults)(nil),        // 3: chrome.rts.presubmit.eval.SuiteResults
+	(*BuilderResults)(nil),      // 4: chrome.rts.presubmit.eval.BuilderResults
+	(*DistanceStats)(nil),       // 5: chrome.rts.presubmit.eval.DistanceStats
+	(*Threshold)(nil),           // 6: chrome.rts.presubmit.eval.Threshold
+	(*durationpb.Duration)(nil), // 7: google.protobuf.Duration
 }
 var file_infra_rts_presubmit_eval_proto_results_proto_depIdxs = []int32{
-	2, // 0: chrome.rts.presubmit.eval.Results.thresholds:type_name -> chrome.rts.presubmit.eval.Threshold
-	3, // 1: chrome.rts.presubmit.eval.Results.total_duration:type_name -> google.protobuf.Duration
-	1, // 2: chrome.rts.presubmit.eval.Results.rejection_closest_distance_stats:type_name -> chrome.rts.presubmit.eval.DistanceStats
-	3, // 3: chrome.rts.presubmit.eval.Threshold.saved_duration:type_name -> google.protobuf.Duration
-	4, // [4:4] is the sub-list for method output_type
-	4, // [4:4] is the sub-list for method input_type
-	4, // [4:4] is the sub-list for extension type_name
-	4, // [4:4] is the sub-list for extension extendee
-	0, // [0:4] is the sub-list for field type_name
+	6,  // 0: chrome.rts.presubmit.eval.Results.thresholds:type_name -> chrome.rts.presubmit.eval.Threshold
+	7,  // 1: chrome.rts.presubmit.eval.Results.total_duration:type_name -> google.protobuf.Duration
+	5,  // 2: chrome.rts.presubmit.eval.Results.rejection_closest_distance_stats:type_name -> chrome.rts.presubmit.eval.DistanceStats
+	2,  // 3: chrome.rts.presubmit.eval.Results.daily:type_name -> chrome.rts.presubmit.eval.DailyResults
+	3,  // 4: chrome.rts.presubmit.eval.Results.suites:type_name -> chrome.rts.presubmit.eval.SuiteResults
+	0,  // 5: chrome.rts.presubmit.eval.Results.builder_recall:type_name -> chrome.rts.presubmit.eval.BuilderRecall
+	4,  // 6: chrome.rts.presubmit.eval.Results.builders:type_name -> chrome.rts.presubmit.eval.BuilderResults
+	7,  // 7: chrome.rts.presubmit.eval.SuiteResults.total_duration:type_name -> google.protobuf.Duration
+	7,  // 8: chrome.rts.presubmit.eval.SuiteResults.saved_durations:type_name -> google.protobuf.Duration
+	7,  // 9: chrome.rts.presubmit.eval.BuilderResults.total_duration:type_name -> google.protobuf.Duration
+	7,  // 10: chrome.rts.presubmit.eval.BuilderResults.saved_durations:type_name -> google.protobuf.Duration
+	7,  // 11: chrome.rts.presubmit.eval.Threshold.saved_duration:type_name -> google.protobuf.Duration
+	12, // [12:12] is the sub-list for method output_type
+	12, // [12:12] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
 }
 
 func init() { file_infra_rts_presubmit_eval_proto_results_proto_init() }
@@ -384,7 +886,7 @@ func file_infra_rts_presubmit_eval_proto_results_proto_init() {
 			}
 		}
 		file_infra_rts_presubmit_eval_proto_results_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DistanceStats); i {
+			switch v := v.(*DailyResults); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -396,6 +898,42 @@ func file_infra_rts_presubmit_eval_proto_results_proto_init() {
 			}
 		}
 		file_infra_rts_presubmit_eval_proto_results_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SuiteResults); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_infra_rts_presubmit_eval_proto_results_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BuilderResults); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_infra_rts_presubmit_eval_proto_results_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DistanceStats); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_infra_rts_presubmit_eval_proto_results_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Threshold); i {
 			case 0:
 				return &v.state
@@ -413,13 +951,14 @@ func file_infra_rts_presubmit_eval_proto_results_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_infra_rts_presubmit_eval_proto_results_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   3,
+			NumEnums:      1,
+			NumMessages:   6,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_infra_rts_presubmit_eval_proto_results_proto_goTypes,
 		DependencyIndexes: file_infra_rts_presubmit_eval_proto_results_proto_depIdxs,
+		EnumInfos:         file_infra_rts_presubmit_eval_proto_results_proto_enumTypes,
 		MessageInfos:      file_infra_rts_presubmit_eval_proto_results_proto_msgTypes,
 	}.Build()
 	File_infra_rts_presubmit_eval_proto_results_proto = out.File
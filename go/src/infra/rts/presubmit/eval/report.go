@@ -0,0 +1,415 @@
+// Copyright 2021 The Chromium Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package eval
+
+import (
+	"flag"
+	"fmt"
+	"html/template"
+	"io"
+	"math"
+	"os"
+	"strings"
+
+	"go.chromium.org/luci/common/errors"
+
+	evalpb "infra/rts/presubmit/eval/proto"
+)
+
+// ReportMetadata is a name-value pair describing the evaluation run in an HTML
+// report, e.g. the path to the rejection history or the value of a flag.
+type ReportMetadata struct {
+	Name  string
+	Value string
+}
+
+// keyChangeRecalls are the ChangeRecall targets that the HTML report breaks
+// down results for.
+var keyChangeRecalls = []float32{0.9, 0.95, 0.99}
+
+// seriesColors are the colors of the lines in charts, in order.
+var seriesColors = []string{"#1a73e8", "#e8710a", "#188038", "#d93025"}
+
+// WriteHTMLReport writes a standalone HTML report of the results to w.
+//
+// The report includes the recall-vs-savings curve, per-day recall, per-suite
+// breakdown and the given metadata. It renders the same Results as
+// PrintResults.
+func WriteHTMLReport(w io.Writer, res *evalpb.Results, metadata []ReportMetadata) error {
+	return reportTmpl.Execute(w, newReportData(res, metadata))
+}
+
+// reportData is the data passed to reportTmpl.
+type reportData struct {
+	Metadata          []ReportMetadata
+	TotalRejections   int64
+	TotalTestFailures int64
+	TotalDuration     string
+
+	KeyThresholds []keyThreshold
+	RecallChart   *lineChart
+	DailyChart    *lineChart
+	Days          int
+	Suites        []suiteRow
+	Thresholds    []thresholdRow
+}
+
+// keyThreshold is a threshold that the report breaks down results for.
+type keyThreshold struct {
+	Index        int
+	Label        string
+	ChangeRecall string
+	Savings      string
+	MaxDistance  string
+}
+
+type suiteRow struct {
+	Name          string
+	TestFailures  int64
+	TestRecalls   []string
+	Savings       []string
+	TotalDuration string
+}
+
+type thresholdRow struct {
+	ChangeRecall string
+	Savings      string
+	TestRecall   string
+	MaxDistance  string
+}
+
+func newReportData(res *evalpb.Results, metadata []ReportMetadata) *reportData {
+	d := &reportData{
+		Metadata:          metadata,
+		TotalRejections:   res.TotalRejections,
+		TotalTestFailures: res.TotalTestFailures,
+		TotalDuration:     res.TotalDuration.AsDuration().String(),
+		Days:              len(res.Daily),
+	}
+
+	for _, t := range res.Thresholds {
+		d.Thresholds = append(d.Thresholds, thresholdRow{
+			ChangeRecall: scoreString(t.ChangeRecall),
+			Savings:      scoreString(t.Savings),
+			TestRecall:   scoreString(t.TestRecall),
+			MaxDistance:  fmt.Sprintf("%.3f", t.MaxDistance),
+		})
+	}
+
+	for _, i := range keyThresholdIndexes(res.Thresholds) {
+		t := res.Thresholds[i]
+		d.KeyThresholds = append(d.KeyThresholds, keyThreshold{
+			Index:        i,
+			Label:        fmt.Sprintf("distance ≤ %.3f", t.MaxDistance),
+			ChangeRecall: scoreString(t.ChangeRecall),
+			Savings:      scoreString(t.Savings),
+			MaxDistance:  fmt.Sprintf("%.3f", t.MaxDistance),
+		})
+	}
+
+	d.RecallChart = recallChart(res)
+	if len(res.Daily) > 0 {
+		d.DailyChart = dailyChart(res.Daily, d.KeyThresholds)
+	}
+
+	for _, s := range res.Suites {
+		row := suiteRow{
+			Name:          s.Suite,
+			TestFailures:  s.TotalTestFailures,
+			TotalDuration: s.TotalDuration.AsDuration().String(),
+		}
+		if row.Name == "" {
+			row.Name = "(unknown)"
+		}
+		for _, k := range d.KeyThresholds {
+			recall := "-"
+			if k.Index < len(s.PreservedTestFailures) && s.TotalTestFailures > 0 {
+				recall = scoreString(float32(s.PreservedTestFailures[k.Index]) / float32(s.TotalTestFailures))
+			}
+			savings := "-"
+			if total := s.TotalDuration.AsDuration(); k.Index < len(s.SavedDurations) && total > 0 {
+				savings = scoreString(float32(float64(s.SavedDurations[k.Index].AsDuration()) / float64(total)))
+			}
+			row.TestRecalls = append(row.TestRecalls, recall)
+			row.Savings = append(row.Savings, savings)
+		}
+		d.Suites = append(d.Suites, row)
+	}
+	return d
+}
+
+// keyThresholdIndexes returns indexes of the thresholds with the lowest
+// ChangeRecall that is not below each of keyChangeRecalls.
+// Relies on thresholds being sorted by ascending ChangeRecall.
+func keyThresholdIndexes(thresholds []*evalpb.Threshold) []int {
+	var ret []int
+	for _, target := range keyChangeRecalls {
+		for i, t := range thresholds {
+			if t.ChangeRecall >= target {
+				if len(ret) == 0 || ret[len(ret)-1] != i {
+					ret = append(ret, i)
+				}
+				break
+			}
+		}
+	}
+	return ret
+}
+
+// recallChart returns the recall-vs-savings chart.
+func recallChart(res *evalpb.Results) *lineChart {
+	c := newLineChart("Savings", "Recall")
+	c.XTicks = percentTicks(c, true)
+	c.YTicks = percentTicks(c, false)
+
+	changeRecall := chartSeries{Name: "ChangeRecall", Color: seriesColors[0]}
+	testRecall := chartSeries{Name: "TestRecall", Color: seriesColors[1]}
+	for _, t := range res.Thresholds {
+		if !isFinite(t.ChangeRecall) || !isFinite(t.Savings) {
+			continue
+		}
+		changeRecall.add(c, float64(t.Savings), float64(t.ChangeRecall))
+		if isFinite(t.TestRecall) {
+			testRecall.add(c, float64(t.Savings), float64(t.TestRecall))
+		}
+	}
+	c.Series = []chartSeries{changeRecall, testRecall}
+	return c
+}
+
+// dailyChart returns the chart of per-day ChangeRecall at key thresholds.
+func dailyChart(daily []*evalpb.DailyResults, keys []keyThreshold) *lineChart {
+	c := newLineChart("Day", "ChangeRecall")
+	c.YTicks = percentTicks(c, false)
+
+	// Spread the days evenly across the x axis.
+	x := func(i int) float64 {
+		if len(daily) == 1 {
+			return 0.5
+		}
+		return float64(i) / float64(len(daily)-1)
+	}
+	labelEvery := (len(daily) + 5) / 6
+	for i, day := range daily {
+		if i%labelEvery == 0 || i == len(daily)-1 {
+			c.XTicks = append(c.XTicks, chartTick{Pos: c.x(x(i)), Label: day.Date})
+		}
+	}
+
+	for k, key := range keys {
+		s := chartSeries{
+			Name:  fmt.Sprintf("%s (overall %s)", key.Label, key.ChangeRecall),
+			Color: seriesColors[k%len(seriesColors)],
+		}
+		for i, day := range daily {
+			if day.TotalRejections == 0 || key.Index >= len(day.PreservedRejections) {
+				continue
+			}
+			s.add(c, x(i), float64(day.PreservedRejections[key.Index])/float64(day.TotalRejections))
+		}
+		c.Series = append(c.Series, s)
+	}
+	return c
+}
+
+// lineChart is an SVG line chart where both axes have the range [0, 1].
+type lineChart struct {
+	Width, Height                      int
+	Left, Right, Top, Bottom           int
+	XLabel, YLabel                     string
+	XTicks, YTicks                     []chartTick
+	Series                             []chartSeries
+	XLabelX, XLabelY, YLabelX, YLabelY int
+}
+
+type chartTick struct {
+	Pos   string
+	Label string
+}
+
+type chartSeries struct {
+	Name   string
+	Color  string
+	Points []string
+}
+
+func newLineChart(xLabel, yLabel string) *lineChart {
+	c := &lineChart{
+		Width:  640,
+		Height: 360,
+		Left:   60,
+		Right:  620,
+		Top:    20,
+		Bottom: 310,
+		XLabel: xLabel,
+		YLabel: yLabel,
+	}
+	c.XLabelX = (c.Left + c.Right) / 2
+	c.XLabelY = c.Height - 10
+	c.YLabelX = 15
+	c.YLabelY = (c.Top + c.Bottom) / 2
+	return c
+}
+
+// x converts a value in [0, 1] to an x coordinate.
+func (c *lineChart) x(v float64) string {
+	return coord(float64(c.Left) + v*float64(c.Right-c.Left))
+}
+
+// y converts a value in [0, 1] to a y coordinate.
+func (c *lineChart) y(v float64) string {
+	return coord(float64(c.Bottom) - v*float64(c.Bottom-c.Top))
+}
+
+// PolyPoints returns the value of the points attribute of the series polyline.
+func (s chartSeries) PolyPoints() string {
+	return strings.Join(s.Points, " ")
+}
+
+func (s *chartSeries) add(c *lineChart, x, y float64) {
+	s.Points = append(s.Points, c.x(x)+","+c.y(y))
+}
+
+// percentTicks returns ticks at 0%, 20%, ..., 100% for the x or y axis.
+func percentTicks(c *lineChart, xAxis bool) []chartTick {
+	ticks := make([]chartTick, 0, 6)
+	for i := 0; i <= 5; i++ {
+		v := float64(i) / 5
+		pos := c.y(v)
+		if xAxis {
+			pos = c.x(v)
+		}
+		ticks = append(ticks, chartTick{Pos: pos, Label: fmt.Sprintf("%d%%", i*20)})
+	}
+	return ticks
+}
+
+func coord(v float64) string {
+	return fmt.Sprintf("%.1f", v)
+}
+
+func isFinite(v float32) bool {
+	return !math.IsNaN(float64(v)) && !math.IsInf(float64(v), 0)
+}
+
+var reportTmpl = template.Must(template.New("report").Parse(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>RTS evaluation report</title>
+<style>
+body { font-family: sans-serif; margin: 2em; color: #202124; }
+table { border-collapse: collapse; margin-bottom: 1em; }
+th, td { border: 1px solid #dadce0; padding: 4px 8px; text-align: right; }
+th { background: #f1f3f4; }
+td.name, th.name { text-align: left; }
+svg { font-size: 12px; }
+.axis { stroke: #5f6368; }
+.grid { stroke: #e8eaed; }
+</style>
+</head>
+<body>
+<h1>RTS evaluation report</h1>
+<p>Based on {{.TotalRejections}} rejections, {{.TotalTestFailures}} test failures, {{.TotalDuration}} testing time.</p>
+{{- if .Metadata}}
+<h2>Run</h2>
+<table>
+{{- range .Metadata}}
+<tr><th class="name">{{.Name}}</th><td class="name">{{.Value}}</td></tr>
+{{- end}}
+</table>
+{{- end}}
+{{- if .KeyThresholds}}
+<h2>Key thresholds</h2>
+<table>
+<tr><th>ChangeRecall</th><th>Savings</th><th>Distance</th></tr>
+{{- range .KeyThresholds}}
+<tr><td>{{.ChangeRecall}}</td><td>{{.Savings}}</td><td>{{.MaxDistance}}</td></tr>
+{{- end}}
+</table>
+{{- end}}
+<h2>Recall vs savings</h2>
+{{template "chart" .RecallChart}}
+{{- if .DailyChart}}
+<h2>Recall by day</h2>
+<p>ChangeRecall of rejections on each of {{.Days}} days, at key thresholds.</p>
+{{template "chart" .DailyChart}}
+{{- end}}
+{{- if .Suites}}
+<h2>Test suites</h2>
+<table>
+<tr><th class="name">Suite</th><th>Test failures</th>
+{{- range .KeyThresholds}}<th>TestRecall<br>{{.Label}}</th>{{end}}
+{{- range .KeyThresholds}}<th>Savings<br>{{.Label}}</th>{{end}}<th>Testing time</th></tr>
+{{- range .Suites}}
+<tr><td class="name">{{.Name}}</td><td>{{.TestFailures}}</td>
+{{- range .TestRecalls}}<td>{{.}}</td>{{end}}
+{{- range .Savings}}<td>{{.}}</td>{{end}}<td>{{.TotalDuration}}</td></tr>
+{{- end}}
+</table>
+{{- end}}
+<h2>All thresholds</h2>
+<details>
+<summary>{{len .Thresholds}} thresholds</summary>
+<table>
+<tr><th>ChangeRecall</th><th>Savings</th><th>TestRecall</th><th>Distance</th></tr>
+{{- range .Thresholds}}
+<tr><td>{{.ChangeRecall}}</td><td>{{.Savings}}</td><td>{{.TestRecall}}</td><td>{{.MaxDistance}}</td></tr>
+{{- end}}
+</table>
+</details>
+</body>
+</html>
+{{define "chart" -}}
+<svg xmlns="http://www.w3.org/2000/svg" width="{{.Width}}" height="{{.Height}}">
+{{- range .YTicks}}
+<line class="grid" x1="{{$.Left}}" x2="{{$.Right}}" y1="{{.Pos}}" y2="{{.Pos}}"/>
+<text x="{{$.Left}}" y="{{.Pos}}" dx="-6" dy="4" text-anchor="end">{{.Label}}</text>
+{{- end}}
+{{- range .XTicks}}
+<line class="grid" x1="{{.Pos}}" x2="{{.Pos}}" y1="{{$.Top}}" y2="{{$.Bottom}}"/>
+<text x="{{.Pos}}" y="{{$.Bottom}}" dy="16" text-anchor="middle">{{.Label}}</text>
+{{- end}}
+<line class="axis" x1="{{.Left}}" x2="{{.Right}}" y1="{{.Bottom}}" y2="{{.Bottom}}"/>
+<line class="axis" x1="{{.Left}}" x2="{{.Left}}" y1="{{.Top}}" y2="{{.Bottom}}"/>
+<text x="{{.XLabelX}}" y="{{.XLabelY}}" text-anchor="middle">{{.XLabel}}</text>
+<text x="{{.YLabelX}}" y="{{.YLabelY}}" text-anchor="middle" transform="rotate(-90 {{.YLabelX}} {{.YLabelY}})">{{.YLabel}}</text>
+{{- range $i, $s := .Series}}
+<polyline fill="none" stroke="{{$s.Color}}" stroke-width="2" points="{{$s.PolyPoints}}"/>
+<text x="{{$.Right}}" y="{{$.Top}}" dy="{{$i}}em" text-anchor="end" fill="{{$s.Color}}">{{$s.Name}}</text>
+{{- end}}
+</svg>
+{{- end}}
+`))
+
+// WriteHTMLReport writes the HTML report of the results to e.HTMLReport,
+// if set. The report metadata includes the history paths, the flags and
+// the extra metadata.
+func (e *Eval) WriteHTMLReport(res *evalpb.Results, extra ...ReportMetadata) error {
+	if e.HTMLReport == "" {
+		return nil
+	}
+
+	metadata := []ReportMetadata{
+		{Name: "Rejections", Value: e.Rejections},
+		{Name: "Durations", Value: e.Durations},
+	}
+	metadata = append(metadata, extra...)
+	if e.flags != nil {
+		e.flags.Visit(func(f *flag.Flag) {
+			metadata = append(metadata, ReportMetadata{Name: "-" + f.Name, Value: f.Value.String()})
+		})
+	}
+
+	f, err := os.Create(e.HTMLReport)
+	if err != nil {
+		return errors.Annotate(err, "failed to create the HTML report").Err()
+	}
+	defer f.Close()
+	if err := WriteHTMLReport(f, res, metadata); err != nil {
+		return errors.Annotate(err, "failed to write the HTML report").Err()
+	}
+	return f.Close()
+}
@@ -0,0 +1,87 @@
+// Copyright 2021 The Chromium Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package eval
+
+import (
+	"bytes"
+	"flag"
+	"io/ioutil"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	evalpb "infra/rts/presubmit/eval/proto"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+var updateGolden = flag.Bool("update-golden", false, "Update testdata/report.golden.html")
+
+func TestWriteHTMLReport(t *testing.T) {
+	t.Parallel()
+
+	Convey(`WriteHTMLReport`, t, func() {
+		res := &evalpb.Results{
+			TotalRejections:   100,
+			TotalTestFailures: 200,
+			TotalDuration:     durationpb.New(10 * time.Hour),
+			Thresholds: []*evalpb.Threshold{
+				{MaxDistance: 0, ChangeRecall: 0.5, TestRecall: 0.4, Savings: 0.9},
+				{MaxDistance: 10, ChangeRecall: 0.92, TestRecall: 0.9, Savings: 0.6},
+				{MaxDistance: 20, ChangeRecall: 0.96, TestRecall: 0.95, Savings: 0.4},
+				{MaxDistance: 40, ChangeRecall: 1, TestRecall: 1, Savings: 0},
+			},
+			Daily: []*evalpb.DailyResults{
+				{Date: "2021-11-01", TotalRejections: 60, PreservedRejections: []int64{30, 57, 58, 60}},
+				{Date: "2021-11-02", TotalRejections: 40, PreservedRejections: []int64{20, 35, 38, 40}},
+			},
+			Suites: []*evalpb.SuiteResults{
+				{
+					Suite:                 "browser_tests",
+					TotalTestFailures:     150,
+					PreservedTestFailures: []int64{60, 135, 143, 150},
+					TotalDuration:         durationpb.New(8 * time.Hour),
+					SavedDurations: []*durationpb.Duration{
+						durationpb.New(7 * time.Hour),
+						durationpb.New(5 * time.Hour),
+						durationpb.New(3 * time.Hour),
+						durationpb.New(0),
+					},
+				},
+				{
+					TotalTestFailures:     50,
+					PreservedTestFailures: []int64{20, 45, 47, 50},
+					TotalDuration:         durationpb.New(2 * time.Hour),
+				},
+			},
+		}
+		metadata := []ReportMetadata{
+			{Name: "Rejections", Value: "/tmp/rejections"},
+			{Name: "-j", Value: "<script>"},
+		}
+
+		buf := &bytes.Buffer{}
+		So(WriteHTMLReport(buf, res, metadata), ShouldBeNil)
+
+		const goldenFile = "testdata/report.golden.html"
+		if *updateGolden {
+			So(ioutil.WriteFile(goldenFile, buf.Bytes(), 0666), ShouldBeNil)
+		}
+		golden, err := ioutil.ReadFile(goldenFile)
+		So(err, ShouldBeNil)
+		So(buf.String(), ShouldEqual, string(golden))
+	})
+
+	Convey(`keyThresholdIndexes`, t, func() {
+		thresholds := []*evalpb.Threshold{
+			{ChangeRecall: 0.5},
+			{ChangeRecall: 0.96},
+			{ChangeRecall: 0.98},
+		}
+		So(keyThresholdIndexes(thresholds), ShouldResemble, []int{1})
+		So(keyThresholdIndexes(nil), ShouldBeNil)
+	})
+}
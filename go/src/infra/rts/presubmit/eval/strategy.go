@@ -6,6 +6,7 @@ package eval
 
 import (
 	"context"
+	"sync"
 
 	"infra/rts"
 
@@ -13,8 +14,23 @@ import (
 )
 
 // Strategy evaluates how much a given test is affected by given changed files.
+//
+// Eval calls the strategy concurrently from multiple goroutines, see
+// Eval.Concurrency, so it must be safe for concurrent use. Strategies which
+// are not can be wrapped with Serialize, or evaluated with
+// Eval.SerializeStrategy set.
 type Strategy func(context.Context, Input, *Output) error
 
+// Serialize returns a strategy which calls s, one call at a time.
+func Serialize(s Strategy) Strategy {
+	var mu sync.Mutex
+	return func(ctx context.Context, in Input, out *Output) error {
+		mu.Lock()
+		defer mu.Unlock()
+		return s(ctx, in, out)
+	}
+}
+
 // Input is input to a selection strategy.
 type Input struct {
 	// ChangedFiles is a list of changed files.
@@ -23,6 +39,12 @@ type Input struct {
 	// The strategy needs to decide how much each of these test variants is
 	// affected by the changed files.
 	TestVariants []*evalpb.TestVariant
+
+	// Builder is the builder that the tests are selected for, i.e. the
+	// builder of all TestVariants, see Eval.BuilderKey.
+	// It is set only when tests are selected per builder, see
+	// Eval.BuilderRecall.
+	Builder string
 }
 
 // ensureChangedFilesInclude ensures that in.ChangedFiles includes all changed
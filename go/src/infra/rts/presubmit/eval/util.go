@@ -43,22 +43,39 @@ func psURL(p *evalpb.GerritPatchset) string {
 // PrintResults prints the results to w.
 func PrintResults(res *evalpb.Results, w io.Writer, minChangeRecall float32) error {
 	p := newPrinter(w)
+	perBuilder := res.BuilderRecall != evalpb.BuilderRecall_BUILDER_RECALL_UNSPECIFIED
 
-	p.printf("ChangeRecall | Savings | TestRecall | Distance\n")
-	p.printf("----------------------------------------------\n")
+	if perBuilder {
+		p.printf("ChangeRecall | Savings | TestRecall | Distance | AnyBuilder | AllBuilders\n")
+		p.printf("------------------------------------------------------------------------\n")
+	} else {
+		p.printf("ChangeRecall | Savings | TestRecall | Distance\n")
+		p.printf("----------------------------------------------\n")
+	}
 	for _, t := range res.Thresholds {
 		if t.ChangeRecall < minChangeRecall {
 			continue
 		}
 		p.printf(
-			"%7s      | % 7s | %7s    | %6.3f\n",
+			"%7s      | % 7s | %7s    | %6.3f",
 			scoreString(t.ChangeRecall),
 			scoreString(t.Savings),
 			scoreString(t.TestRecall),
 			t.MaxDistance,
 		)
+		if perBuilder {
+			p.printf(
+				"   | %7s    | %7s",
+				scoreString(t.ChangeRecallAnyBuilder),
+				scoreString(t.ChangeRecallAllBuilders),
+			)
+		}
+		p.printf("\n")
 	}
 	p.printf("\nbased on %d rejections, %d test failures, %s testing time\n", res.TotalRejections, res.TotalTestFailures, res.TotalDuration.AsDuration())
+	if perBuilder {
+		p.printf("ChangeRecall is computed under %s semantics\n", res.BuilderRecall)
+	}
 	return p.err
 }
 
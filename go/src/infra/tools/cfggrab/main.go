@@ -10,14 +10,21 @@
 // If `-output-dir` is given, stores the fetched files into per-project
 // <output-dir>/<project>.cfg.
 //
+// If `-services` is given, fetches the file from service config sets instead
+// (e.g. services/luci-scheduler). Their lines are prefixed with the config set
+// name and they are stored into <output-dir>/services/<service>.cfg. `-all`
+// fetches the file from both project and service config sets in one pass.
+//
 // Usage:
 //   luci-auth login
 //   cfggrab cr-buildbucket.cfg | grep "service_account"
+//   cfggrab -services settings.cfg
 package main
 
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	"flag"
 	"fmt"
 	"io/ioutil"
@@ -39,6 +46,8 @@ import (
 
 var outputDir = flag.String("output-dir", "-", "Where to store fetched files or - to print them to stdout for grepping")
 var configService = flag.String("config-service-host", chromeinfra.ConfigServiceHost, "Hostname of LUCI Config service to query")
+var services = flag.Bool("services", false, "Fetch the file from service config sets instead of project config sets")
+var all = flag.Bool("all", false, "Fetch the file from both project and service config sets")
 
 var stdoutLock sync.Mutex
 
@@ -52,46 +61,113 @@ func main() {
 		os.Exit(2)
 	}
 
-	if err := run(ctx, flag.Arg(0), *outputDir); err != nil {
+	if *services && *all {
+		fmt.Fprintf(os.Stderr, "-services and -all can't be used together.\n")
+		os.Exit(2)
+	}
+
+	if err := run(ctx, flag.Arg(0), *outputDir, !*services || *all, *services || *all); err != nil {
 		errors.Log(ctx, err)
 		os.Exit(1)
 	}
 }
 
-func withConfigClient(ctx context.Context) (context.Context, error) {
-	auth := auth.NewAuthenticator(ctx, auth.SilentLogin, chromeinfra.DefaultAuthOptions())
-	client, err := auth.Client()
+func configClient(ctx context.Context) (*http.Client, error) {
+	return auth.NewAuthenticator(ctx, auth.SilentLogin, chromeinfra.DefaultAuthOptions()).Client()
+}
+
+func withConfigClient(ctx context.Context, client *http.Client) context.Context {
+	return cfgclient.Use(ctx, remote.New(*configService, false, func(context.Context) (*http.Client, error) {
+		return client, nil
+	}))
+}
+
+// serviceConfigSets lists all service config sets known to the config service.
+//
+// The config client interface can only enumerate projects, so this calls the
+// config service API directly.
+func serviceConfigSets(ctx context.Context, client *http.Client) ([]config.Set, error) {
+	url := fmt.Sprintf("https://%s/_ah/api/config/v1/config-sets", *configService)
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
 	if err != nil {
 		return nil, err
 	}
-	return cfgclient.Use(ctx, remote.New(*configService, false, func(context.Context) (*http.Client, error) {
-		return client, nil
-	})), nil
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, errors.Annotate(err, "failed to list config sets").Err()
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, errors.Reason("failed to list config sets: HTTP %d", resp.StatusCode).Err()
+	}
+	var body struct {
+		ConfigSets []struct {
+			ConfigSet string `json:"config_set"`
+		} `json:"config_sets"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		return nil, errors.Annotate(err, "failed to decode the config sets").Err()
+	}
+	var sets []config.Set
+	for _, cs := range body.ConfigSets {
+		if set := config.Set(cs.ConfigSet); set.Service() != "" {
+			sets = append(sets, set)
+		}
+	}
+	return sets, nil
 }
 
-func run(ctx context.Context, path, output string) error {
+// configSet is a config set to fetch the file from.
+type configSet struct {
+	set config.Set
+	// label prefixes the printed lines and names the stored file.
+	label string
+}
+
+func run(ctx context.Context, path, output string, withProjects, withServices bool) error {
 	if output != "-" {
 		if err := os.MkdirAll(output, 0777); err != nil {
 			return err
 		}
+		if withServices {
+			if err := os.MkdirAll(filepath.Join(output, "services"), 0777); err != nil {
+				return err
+			}
+		}
 	}
 
-	ctx, err := withConfigClient(ctx)
+	client, err := configClient(ctx)
 	if err != nil {
 		return err
 	}
+	ctx = withConfigClient(ctx, client)
 
-	projects, err := cfgclient.ProjectsWithConfig(ctx, path)
-	if err != nil {
-		return err
+	var sets []configSet
+	if withProjects {
+		projects, err := cfgclient.ProjectsWithConfig(ctx, path)
+		if err != nil {
+			return err
+		}
+		for _, proj := range projects {
+			sets = append(sets, configSet{set: config.ProjectSet(proj), label: proj})
+		}
+	}
+	if withServices {
+		svcs, err := serviceConfigSets(ctx, client)
+		if err != nil {
+			return err
+		}
+		for _, set := range svcs {
+			sets = append(sets, configSet{set: set, label: string(set)})
+		}
 	}
 
 	return parallel.FanOutIn(func(work chan<- func() error) {
-		for _, proj := range projects {
-			proj := proj
+		for _, cs := range sets {
+			cs := cs
 			work <- func() error {
-				if err := processProject(ctx, proj, path, output); err != nil {
-					logging.Errorf(ctx, "Failed when processing %s: %s", proj, err)
+				if err := processConfigSet(ctx, cs, path, output); err != nil {
+					logging.Errorf(ctx, "Failed when processing %s: %s", cs.set, err)
 					return err
 				}
 				return nil
@@ -100,21 +176,25 @@ func run(ctx context.Context, path, output string) error {
 	})
 }
 
-func processProject(ctx context.Context, proj, path, output string) error {
+func processConfigSet(ctx context.Context, cs configSet, path, output string) error {
 	var blob []byte
-	err := cfgclient.Get(ctx, config.Set("projects/"+proj), path, cfgclient.Bytes(&blob), nil)
-	if err != nil {
+	err := cfgclient.Get(ctx, cs.set, path, cfgclient.Bytes(&blob), nil)
+	switch {
+	case err == config.ErrNoConfig && cs.set.Service() != "":
+		// Unlike projects, services aren't filtered by having the file.
+		return nil
+	case err != nil:
 		return err
 	}
 
 	if output != "-" {
-		return ioutil.WriteFile(filepath.Join(output, proj+".cfg"), blob, 0666)
+		return ioutil.WriteFile(filepath.Join(output, filepath.FromSlash(cs.label)+".cfg"), blob, 0666)
 	}
 
 	stdoutLock.Lock()
 	defer stdoutLock.Unlock()
 	for _, line := range bytes.Split(blob, []byte{'\n'}) {
-		fmt.Printf("%s: %s\n", proj, line)
+		fmt.Printf("%s: %s\n", cs.label, line)
 	}
 	return nil
 }
@@ -6,7 +6,9 @@ package main
 
 import (
 	"context"
+	"fmt"
 	"os"
+	"time"
 
 	"github.com/maruel/subcommands"
 
@@ -30,11 +32,19 @@ project.
 If the plugin's 'FindProblems' function makes any Report calls, this will ensure
 that the project is checked out locally on disk. If 'FindProblems' does NOT make
 any Report calls, this will inform you that the checkout can be removed (pass
-'-clean' to automatically delete them).
+'-clean' to automatically delete them). Pass '-min-severity' to only consider
+reports of at least the given severity.
+
+Projects are scanned concurrently by '-workers' workers. If the plugin's
+'FindProblems' doesn't finish within '-project-timeout' for a project, the
+project is reported as timed out and is not fixed.
 
 If scan does a new checkout, plugin's 'ApplyFix' will be invoked once on the
 checked-out project.
 
+After 'ApplyFix', the generated configs of modified projects are validated by
+LUCI Config, and validation errors are recorded in the scan report.
+
 If a checkout already exists on disk and '-re-apply' is not passed, this will
 NOT attempt to update it. It's recommended to use standard git tooling to
 pull/rebase/etc. If you really want a new checkout, you can delete the
@@ -55,6 +65,12 @@ checked-out project and run 'scan' again to get a fresh top-of-tree version.
 
 			ret.Flags.BoolVar(&ret.reapply, "re-apply", false,
 				"If set, will re-run ApplyFix, even if no new checkout was made.")
+			ret.Flags.Var(&ret.minSeverity, "min-severity",
+				"If set, will only checkout projects with reports of at least this severity (INFO, WARNING or ERROR).")
+			ret.Flags.IntVar(&ret.workers, "workers", plugsupport.DefaultScanWorkers,
+				"How many projects to scan concurrently.")
+			ret.Flags.DurationVar(&ret.projectTimeout, "project-timeout", 10*time.Minute,
+				"How long FindProblems may run for a single project (0 for no limit).")
 			return &ret
 		},
 	}
@@ -63,9 +79,12 @@ checked-out project and run 'scan' again to get a fresh top-of-tree version.
 type cmdScanImpl struct {
 	cmdBase
 
-	squeaky bool
-	clean   bool
-	reapply bool
+	squeaky        bool
+	clean          bool
+	reapply        bool
+	minSeverity    migrator.Severity
+	workers        int
+	projectTimeout time.Duration
 }
 
 func (r *cmdScanImpl) positionalRange() (min, max int) { return 0, 0 }
@@ -74,6 +93,12 @@ func (r *cmdScanImpl) validateFlags(ctx context.Context, positionals []string, e
 	if r.squeaky && !r.clean {
 		return errors.New("you can't be squeaky without being clean! (pass -clean flag)")
 	}
+	if r.workers < 1 {
+		return errors.New("-workers must be positive")
+	}
+	if r.projectTimeout < 0 {
+		return errors.New("-project-timeout must not be negative")
+	}
 	return nil
 }
 
@@ -82,9 +107,12 @@ func (r *cmdScanImpl) execute(ctx context.Context) error {
 		Action:        "scan",
 		ContextConfig: r.contextConfig,
 		ScanConfig: plugsupport.ScanConfig{
-			Squeaky: r.squeaky,
-			Clean:   r.clean,
-			Reapply: r.reapply,
+			Squeaky:        r.squeaky,
+			Clean:          r.clean,
+			Reapply:        r.reapply,
+			MinSeverity:    r.minSeverity,
+			Workers:        r.workers,
+			ProjectTimeout: r.projectTimeout,
 		},
 	})
 	if err != nil {
@@ -104,19 +132,37 @@ func (r *cmdScanImpl) execute(ctx context.Context) error {
 
 	// Pretty print actionable reports for convenience.
 	dump.PrettyPrint(os.Stdout,
-		[]string{"Project", "Tag", "Problem"},
+		[]string{"Project", "Severity", "Tag", "Problem"},
 		func(r *migrator.Report) []string {
 			if !r.Actionable {
 				return nil
 			}
 			return []string{
 				r.Project,
+				r.Severity.String(),
 				r.Tag,
 				r.Problem,
 			}
 		},
 	)
 
+	// Timed out projects weren't fully scanned, list them separately.
+	var timedOut []string
+	dump.Iterate(func(_ migrator.ReportID, reports []*migrator.Report) bool {
+		for _, r := range reports {
+			if r.Tag == plugsupport.TimeoutTag {
+				timedOut = append(timedOut, r.Project)
+			}
+		}
+		return true
+	})
+	if len(timedOut) > 0 {
+		fmt.Printf("\n%d project(s) timed out and were not fixed:\n", len(timedOut))
+		for _, proj := range timedOut {
+			fmt.Printf("  %s\n", proj)
+		}
+	}
+
 	return nil
 }
 
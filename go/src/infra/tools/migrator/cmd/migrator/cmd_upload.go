@@ -21,6 +21,9 @@ func cmdUpload(opts cmdBaseOptions) *subcommands.Command {
 Takes the commit message from commit-message.txt file in the migrator project
 directory. Skips running upload hooks. By default assigns reviewers based on
 OWNERS files.
+
+Pass '-skip-invalid' to skip uploading checkouts whose generated configs failed
+LUCI Config validation during the last scan.
 `,
 
 		CommandRun: func() subcommands.CommandRun {
@@ -30,6 +33,7 @@ OWNERS files.
 				discoverProjectDir: true,
 			})
 			ret.Flags.BoolVar(&ret.force, "force", false, "Initiate upload even if nothing has changed.")
+			ret.Flags.BoolVar(&ret.skipInvalid, "skip-invalid", false, "Skip checkouts with config validation errors in the last scan.")
 			return &ret
 		},
 	}
@@ -38,7 +42,8 @@ OWNERS files.
 type cmdUploadImpl struct {
 	cmdBase
 
-	force bool
+	force       bool
+	skipInvalid bool
 }
 
 func (r *cmdUploadImpl) positionalRange() (min, max int) { return 0, 0 }
@@ -48,7 +53,7 @@ func (r *cmdUploadImpl) validateFlags(ctx context.Context, positionals []string,
 }
 
 func (r *cmdUploadImpl) execute(ctx context.Context) error {
-	dump, err := plugsupport.ExecuteUpload(ctx, r.projectDir, r.force)
+	dump, err := plugsupport.ExecuteUpload(ctx, r.projectDir, r.force, r.skipInvalid)
 	if err != nil {
 		return err
 	}
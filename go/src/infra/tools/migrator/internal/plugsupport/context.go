@@ -51,16 +51,21 @@ func (r *ContextConfig) Apply(ctx context.Context) (context.Context, error) {
 
 	authenticator := auth.NewAuthenticator(ctx, auth.SilentLogin, r.Auth)
 
+	clientFactory := func(context.Context) (*http.Client, error) {
+		return authenticator.Client()
+	}
 	client, err := cfgclient.New(cfgclient.Options{
-		ServiceHost: r.ConfigServiceHost,
-		ClientFactory: func(context.Context) (*http.Client, error) {
-			return authenticator.Client()
-		},
+		ServiceHost:   r.ConfigServiceHost,
+		ClientFactory: clientFactory,
 	})
 	if err != nil {
 		return ctx, errors.Annotate(err, "cannot configure LUCI Config client").Err()
 	}
 
 	ctx = cfgclient.Use(ctx, client)
+	ctx = withValidator(ctx, &remoteValidator{
+		host:          r.ConfigServiceHost,
+		clientFactory: clientFactory,
+	})
 	return ctx, nil
 }
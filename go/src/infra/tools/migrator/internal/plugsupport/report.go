@@ -20,6 +20,7 @@ func (s *reportSink) add(id migrator.ReportID, tag, problem string, opts ...migr
 		Tag:        tag,
 		Problem:    problem,
 		Actionable: true,
+		Severity:   migrator.SeverityWarning,
 	}
 	for _, o := range opts {
 		o(report)
@@ -50,11 +51,11 @@ func DumpReports(ctx context.Context) *migrator.ReportDump {
 }
 
 // HasActionableReports returns `true` if `ctx` contains any Reports where
-// Actionable is true.
-func HasActionableReports(ctx context.Context) (actionable bool) {
+// Actionable is true and Severity is at least `minSeverity`.
+func HasActionableReports(ctx context.Context, minSeverity migrator.Severity) (actionable bool) {
 	getReportSink(ctx).dat.Iterate(func(id migrator.ReportID, reports []*migrator.Report) bool {
 		for _, report := range reports {
-			if report.Actionable {
+			if report.Actionable && report.Severity >= minSeverity {
 				actionable = true
 				return false
 			}
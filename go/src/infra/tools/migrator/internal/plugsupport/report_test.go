@@ -0,0 +1,58 @@
+// Copyright 2021 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package plugsupport
+
+import (
+	"context"
+	"testing"
+
+	"infra/tools/migrator"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestReportSink(t *testing.T) {
+	t.Parallel()
+
+	Convey(`Report sink`, t, func() {
+		ctx := InitReportSink(context.Background())
+		sink := getReportSink(ctx)
+		id := migrator.ReportID{Checkout: "proj-project", Project: "proj"}
+
+		severities := func() []migrator.Severity {
+			var out []migrator.Severity
+			DumpReports(ctx).Iterate(func(_ migrator.ReportID, rs []*migrator.Report) bool {
+				for _, r := range rs {
+					out = append(out, r.Severity)
+				}
+				return true
+			})
+			return out
+		}
+
+		Convey(`Defaults to warning`, func() {
+			sink.add(id, "TAG", "problem")
+			So(severities(), ShouldResemble, []migrator.Severity{migrator.SeverityWarning})
+		})
+
+		Convey(`Uses severity option`, func() {
+			sink.add(id, "TAG", "problem", migrator.SeverityOption(migrator.SeverityError))
+			So(severities(), ShouldResemble, []migrator.Severity{migrator.SeverityError})
+		})
+
+		Convey(`HasActionableReports`, func() {
+			So(HasActionableReports(ctx, migrator.SeverityInfo), ShouldBeFalse)
+
+			sink.add(id, "INFO", "problem", migrator.SeverityOption(migrator.SeverityInfo))
+			sink.add(id, "NON_ACTIONABLE", "problem", migrator.NonActionable, migrator.SeverityOption(migrator.SeverityError))
+			So(HasActionableReports(ctx, migrator.SeverityInfo), ShouldBeTrue)
+			So(HasActionableReports(ctx, migrator.SeverityWarning), ShouldBeFalse)
+
+			sink.add(id, "WARNING", "problem")
+			So(HasActionableReports(ctx, migrator.SeverityWarning), ShouldBeTrue)
+			So(HasActionableReports(ctx, migrator.SeverityError), ShouldBeFalse)
+		})
+	})
+}
@@ -7,9 +7,12 @@ package plugsupport
 import (
 	"context"
 	"fmt"
+	"io"
 	"os"
 	"runtime/debug"
 	"strings"
+	"sync"
+	"time"
 
 	"go.chromium.org/luci/common/data/stringset"
 	"go.chromium.org/luci/common/errors"
@@ -28,8 +31,29 @@ type ScanConfig struct {
 	Squeaky bool
 	Clean   bool
 	Reapply bool
+
+	// MinSeverity is the minimum severity of actionable reports for which
+	// projects are checked out and fixed.
+	MinSeverity migrator.Severity
+
+	// Workers is the number of projects scanned concurrently.
+	//
+	// If zero, DefaultScanWorkers is used.
+	Workers int
+
+	// ProjectTimeout limits how long FindProblems may run for a single project.
+	//
+	// If zero, there's no limit.
+	ProjectTimeout time.Duration
 }
 
+// DefaultScanWorkers is the default number of projects scanned concurrently.
+const DefaultScanWorkers = 32
+
+// TimeoutTag is the tag of reports about projects whose FindProblems didn't
+// finish within ScanConfig.ProjectTimeout.
+const TimeoutTag = "FIND_PROBLEMS_TIMEOUT"
+
 // scanner implements the "scan" command scanning.
 type scanner struct {
 	factory    migrator.InstantiateAPI
@@ -44,6 +68,9 @@ type scannedProject struct {
 	pb     *configpb.Project    // an entry from projects.cfg
 	api    migrator.API         // a project-specific instance of the plugin impl
 	remote migrator.Project     // an instance of RemoteProject
+
+	minSeverity migrator.Severity // see ScanConfig.MinSeverity
+	timedOut    bool              // true if FindProblems didn't finish in time
 }
 
 // repoRef is a repo:ref pair.
@@ -53,16 +80,50 @@ type repoRef struct {
 }
 
 // scan calls FindProblems to scan the remote project for errors.
-func (p *scannedProject) scan() {
-	defer func() {
-		if rcov := recover(); rcov != nil {
-			// TODO(iannucci): report this better
-			logging.Errorf(p.ctx, "fatal error: %s", rcov)
-			logging.Errorf(p.ctx, string(debug.Stack()))
-			p.remote.Report("FATAL_ERROR", fmt.Sprintf("%s", rcov))
-		}
+//
+// If `timeout` is non-zero and FindProblems doesn't finish in time, its context
+// is canceled and the project is reported as timed out without waiting for
+// FindProblems to return.
+func (p *scannedProject) scan(timeout time.Duration) {
+	ctx, cancel := p.ctx, context.CancelFunc(func() {})
+	if timeout > 0 {
+		ctx, cancel = context.WithTimeout(p.ctx, timeout)
+	}
+	defer cancel()
+
+	// The remote project fetches configs using the context it was created with,
+	// so bind it to the one with the deadline.
+	p.remote = RemoteProject(ctx, p.pb.Id)
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		defer func() {
+			if rcov := recover(); rcov != nil {
+				// TODO(iannucci): report this better
+				logging.Errorf(p.ctx, "fatal error: %s", rcov)
+				logging.Errorf(p.ctx, string(debug.Stack()))
+				p.remote.Report("FATAL_ERROR", fmt.Sprintf("%s", rcov))
+			}
+		}()
+		p.api.FindProblems(ctx, p.remote)
 	}()
-	p.api.FindProblems(p.ctx, p.remote)
+
+	select {
+	case <-done:
+	case <-ctx.Done():
+		if ctx.Err() != context.DeadlineExceeded {
+			// Canceled from the outside (e.g. Ctrl+C), let FindProblems wind down.
+			<-done
+			return
+		}
+		p.timedOut = true
+		logging.Errorf(p.ctx, "FindProblems didn't finish in %s, giving up", timeout)
+		p.remote.Report(TimeoutTag,
+			fmt.Sprintf("FindProblems didn't finish in %s", timeout),
+			migrator.SeverityOption(migrator.SeverityError),
+			migrator.NonActionable)
+	}
 }
 
 // applyFix calls ApplyFix to modify the local configs checkout.
@@ -80,7 +141,7 @@ func (p *scannedProject) applyFix(repo *repo) {
 
 // hasActionableReports returns true if we need to checkout and fix the project.
 func (p *scannedProject) hasActionableReports() bool {
-	return HasActionableReports(p.ctx)
+	return HasActionableReports(p.ctx, p.minSeverity)
 }
 
 // repoRef returns repo:ref pair where project configs are hosted.
@@ -171,15 +232,17 @@ func (s *scanner) run(ctx context.Context) error {
 	if err != nil {
 		return errors.Annotate(err, "when applying projects_re filter").Err()
 	}
+	stderr := &lockedWriter{w: os.Stderr}
 	projs := make([]*scannedProject, len(projectsToVisit))
 	for i, projPB := range projectsToVisit {
-		projCtx, doneCB := s.perProjectContext(ctx, projPB.Id)
+		projCtx, doneCB := s.perProjectContext(ctx, stderr, projPB.Id)
 		projs[i] = &scannedProject{
-			ctx:    projCtx,
-			done:   doneCB,
-			pb:     projPB,
-			api:    s.factory(),
-			remote: RemoteProject(projCtx, projPB.Id),
+			ctx:  projCtx,
+			done: doneCB,
+			pb:   projPB,
+			api:  s.factory(),
+
+			minSeverity: s.cfg.MinSeverity,
 		}
 	}
 
@@ -207,11 +270,15 @@ func (s *scanner) run(ctx context.Context) error {
 	// Discover if we need to fix anything. This operates on the remote configs
 	// and safe to do in parallel. It updates the reports stored in per-project
 	// contexts.
-	parallel.WorkPool(32, func(ch chan<- func() error) {
+	workers := s.cfg.Workers
+	if workers <= 0 {
+		workers = DefaultScanWorkers
+	}
+	parallel.WorkPool(workers, func(ch chan<- func() error) {
 		for _, proj := range projs {
 			proj := proj
 			ch <- func() error {
-				proj.scan()
+				proj.scan(s.cfg.ProjectTimeout)
 				return nil
 			}
 		}
@@ -280,10 +347,13 @@ func (s *scanner) filterProjects(projs []*configpb.Project) ([]*configpb.Project
 }
 
 // perProjectContext prepares a context with project logs and reports sink.
-func (s *scanner) perProjectContext(ctx context.Context, projID string) (out context.Context, done func(removeLog bool)) {
+//
+// Logs go to the project's log file and to `stderr`, which is shared by all
+// concurrently scanned projects.
+func (s *scanner) perProjectContext(ctx context.Context, stderr io.Writer, projID string) (out context.Context, done func(removeLog bool)) {
 	ctx = InitReportSink(ctx)
 	ctx = (&gologger.LoggerConfig{
-		Out: os.Stderr,
+		Out: stderr,
 		// We pick a more helpful format here which includes the project.
 		// The gory details of the filename are recorded to the .log file.
 		Format: fmt.Sprintf("%%{color}[%%{level:.1s}|%s]%%{color:reset} %%{message}", projID),
@@ -294,17 +364,20 @@ func (s *scanner) perProjectContext(ctx context.Context, projID string) (out con
 		// should never happen, let it fly
 		panic(errors.Annotate(err, "opening logfile").Err())
 	}
+	// FindProblems of a timed out project may still be logging when the log is
+	// finalized, and checkouts log to all their projects at once.
+	logOut := &lockedWriter{w: logFile}
 
 	ctx = teelogger.Use(
 		ctx,
 		(&gologger.LoggerConfig{
-			Out:    logFile,
+			Out:    logOut,
 			Format: `[%{level:.1s} %{shortfile}] %{message}`,
 		}).NewLogger,
 	)
 
 	return ctx, func(removeLog bool) {
-		logFile.Close()
+		logOut.Close()
 		if removeLog {
 			os.Remove(logFile.Name())
 		}
@@ -352,11 +425,54 @@ func (s *scanner) doCheckoutFixups(co *multiProjectCheckout) {
 		newCheckout = true
 	}
 
+	applied := false
 	for _, proj := range co.projs {
-		if newCheckout || s.cfg.Reapply {
+		if proj.timedOut {
+			// FindProblems may still be running, don't touch the API instance.
+			logging.Warningf(proj.ctx, "FindProblems timed out, skipping ApplyFix.")
+		} else if newCheckout || s.cfg.Reapply {
 			proj.applyFix(r)
+			applied = true
 		} else if !newCheckout {
 			logging.Infof(proj.ctx, "checkout already exists, skipping ApplyFix (pass -re-apply to run anyway).")
 		}
 	}
+
+	// Pre-check the generated configs, so that broken output is discovered
+	// before the upload.
+	if v := getValidator(co.ctx); applied && v != nil {
+		validateCheckout(co.ctx, v, r, co.projs)
+	}
+}
+
+// lockedWriter serializes writes to an io.Writer shared by goroutines.
+//
+// Writes after Close are silently dropped.
+type lockedWriter struct {
+	m      sync.Mutex
+	w      io.Writer
+	closed bool
+}
+
+func (l *lockedWriter) Write(p []byte) (int, error) {
+	l.m.Lock()
+	defer l.m.Unlock()
+	if l.closed {
+		return len(p), nil
+	}
+	return l.w.Write(p)
+}
+
+// Close closes the underlying writer if it is an io.Closer.
+func (l *lockedWriter) Close() error {
+	l.m.Lock()
+	defer l.m.Unlock()
+	if l.closed {
+		return nil
+	}
+	l.closed = true
+	if c, ok := l.w.(io.Closer); ok {
+		return c.Close()
+	}
+	return nil
 }
@@ -0,0 +1,130 @@
+// Copyright 2021 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package plugsupport
+
+import (
+	"bytes"
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	configpb "go.chromium.org/luci/common/proto/config"
+
+	"infra/tools/migrator"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+// fakeAPI implements migrator.API by calling findProblems.
+type fakeAPI struct {
+	findProblems func(ctx context.Context, proj migrator.Project)
+}
+
+func (a *fakeAPI) FindProblems(ctx context.Context, proj migrator.Project) {
+	a.findProblems(ctx, proj)
+}
+
+func (a *fakeAPI) ApplyFix(ctx context.Context, proj migrator.LocalProject) {}
+
+func TestScan(t *testing.T) {
+	t.Parallel()
+
+	Convey(`scan`, t, func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		scan := func(timeout time.Duration, cb func(ctx context.Context, proj migrator.Project)) *scannedProject {
+			p := &scannedProject{
+				ctx: InitReportSink(ctx),
+				pb:  &configpb.Project{Id: "proj"},
+				api: &fakeAPI{findProblems: cb},
+			}
+			p.scan(timeout)
+			return p
+		}
+
+		tags := func(p *scannedProject) []string {
+			var out []string
+			DumpReports(p.ctx).Iterate(func(_ migrator.ReportID, rs []*migrator.Report) bool {
+				for _, r := range rs {
+					out = append(out, r.Tag)
+				}
+				return true
+			})
+			return out
+		}
+
+		Convey(`Finishes in time`, func() {
+			p := scan(time.Minute, func(ctx context.Context, proj migrator.Project) {
+				proj.Report("TAG", "problem")
+			})
+			So(p.timedOut, ShouldBeFalse)
+			So(tags(p), ShouldResemble, []string{"TAG"})
+			So(p.hasActionableReports(), ShouldBeTrue)
+		})
+
+		Convey(`Panics`, func() {
+			p := scan(time.Minute, func(ctx context.Context, proj migrator.Project) {
+				panic("boom")
+			})
+			So(p.timedOut, ShouldBeFalse)
+			So(tags(p), ShouldResemble, []string{"FATAL_ERROR"})
+		})
+
+		Convey(`Times out`, func() {
+			release := make(chan struct{})
+			defer close(release)
+			var canceled sync.WaitGroup
+			canceled.Add(1)
+			p := scan(time.Millisecond, func(ctx context.Context, proj migrator.Project) {
+				<-ctx.Done()
+				canceled.Done()
+				<-release
+			})
+			canceled.Wait()
+			So(p.timedOut, ShouldBeTrue)
+			So(tags(p), ShouldResemble, []string{TimeoutTag})
+			// Timeouts alone don't warrant a checkout.
+			So(p.hasActionableReports(), ShouldBeFalse)
+		})
+
+		Convey(`No timeout`, func() {
+			p := scan(0, func(ctx context.Context, proj migrator.Project) {
+				time.Sleep(10 * time.Millisecond)
+			})
+			So(p.timedOut, ShouldBeFalse)
+			So(tags(p), ShouldBeEmpty)
+		})
+	})
+}
+
+func TestLockedWriter(t *testing.T) {
+	t.Parallel()
+
+	Convey(`lockedWriter`, t, func() {
+		buf := &bytes.Buffer{}
+		w := &lockedWriter{w: buf}
+
+		var wg sync.WaitGroup
+		for i := 0; i < 10; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				w.Write([]byte("line\n"))
+			}()
+		}
+		wg.Wait()
+		So(buf.Len(), ShouldEqual, 50)
+
+		Convey(`Drops writes after Close`, func() {
+			So(w.Close(), ShouldBeNil)
+			n, err := w.Write([]byte("late\n"))
+			So(err, ShouldBeNil)
+			So(n, ShouldEqual, 5)
+			So(buf.Len(), ShouldEqual, 50)
+		})
+	})
+}
@@ -55,7 +55,8 @@ func (*impl) FindProblems(ctx context.Context, proj m.Project) {
 			cfgFile.Report(
 				"BUILDER_DEFAULTS",
 				fmt.Sprintf("Bucket %s defines builder defaults.", b.Name),
-				m.MetadataOption("bucketname", b.Name))
+				m.MetadataOption("bucketname", b.Name),
+				m.SeverityOption(m.SeverityError))
 		}
 		for _, sw := range b.GetSwarming().GetBuilders() {
 			if len(sw.Mixins) != 0 {
@@ -17,7 +17,10 @@ import (
 )
 
 // ExecuteUpload implements "upload" subcommand.
-func ExecuteUpload(ctx context.Context, projectDir ProjectDir, force bool) (*migrator.ReportDump, error) {
+//
+// If skipInvalid is true, checkouts with projects which had config validation
+// errors in the last scan are not uploaded.
+func ExecuteUpload(ctx context.Context, projectDir ProjectDir, force, skipInvalid bool) (*migrator.ReportDump, error) {
 	tweaks, err := LoadTweaks(projectDir)
 	if err != nil {
 		return nil, errors.Annotate(err, "failed to load tweaks").Err()
@@ -29,6 +32,13 @@ func ExecuteUpload(ctx context.Context, projectDir ProjectDir, force bool) (*mig
 	}
 	message := string(blob)
 
+	invalid := stringset.New(0)
+	if skipInvalid {
+		if invalid, err = loadProjectsWithValidationErrors(projectDir); err != nil {
+			return nil, errors.Annotate(err, "failed to load the scan report").Err()
+		}
+	}
+
 	return visitReposInParallel(ctx, projectDir, projectDir.UploadReportPath(), func(ctx context.Context, r *repo) {
 		reviewers := stringset.New(0)
 		cc := stringset.New(0)
@@ -59,6 +69,14 @@ func ExecuteUpload(ctx context.Context, projectDir ProjectDir, force bool) (*mig
 			return
 		}
 
+		// Skip checkouts with configs known to be invalid.
+		for _, proj := range r.projects {
+			if invalid.Has(proj.Id) {
+				r.report(ctx, "INVALID_CONFIG", "Not uploaded: generated configs of "+proj.Id+" failed validation during the scan")
+				return
+			}
+		}
+
 		// Skip if the change has already been uploaded.
 		if !force && uncommittedDiff == "" && remoteCL != "" {
 			r.report(ctx, "UNCHANGED", "No new changes", migrator.MetadataOption("CL", remoteCL))
@@ -0,0 +1,266 @@
+// Copyright 2021 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package plugsupport
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	config "go.chromium.org/luci/common/api/luci_config/config/v1"
+	"go.chromium.org/luci/common/data/stringset"
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/common/logging"
+
+	"infra/tools/migrator"
+)
+
+// Report tags produced by the post-fix validation.
+const (
+	// validationErrorTag is used for LUCI Config validation errors in
+	// generated configs.
+	validationErrorTag = "CONFIG_VALIDATION_ERROR"
+	// validationWarningTag is used for LUCI Config validation warnings in
+	// generated configs.
+	validationWarningTag = "CONFIG_VALIDATION_WARNING"
+	// validationFailureTag is used when the validation RPC itself failed.
+	validationFailureTag = "CONFIG_VALIDATION_FAILURE"
+	// validationSkippedTag is used for modified files which are not part of a
+	// known config set.
+	validationSkippedTag = "CONFIG_VALIDATION_SKIPPED"
+)
+
+// configValidator sends config sets for validation to LUCI Config.
+//
+// Implemented by remoteValidator, and faked in tests.
+type configValidator interface {
+	// Validate validates the files of the config set.
+	//
+	// Returns errors only on RPC errors. Actual validation errors are
+	// communicated through validation messages.
+	Validate(ctx context.Context, configSet string, files map[string][]byte) ([]*config.ComponentsConfigEndpointValidationMessage, error)
+}
+
+// remoteValidator implements configValidator through the LUCI Config API.
+type remoteValidator struct {
+	host          string
+	clientFactory func(context.Context) (*http.Client, error)
+}
+
+func (v *remoteValidator) Validate(ctx context.Context, configSet string, files map[string][]byte) ([]*config.ComponentsConfigEndpointValidationMessage, error) {
+	client, err := v.clientFactory(ctx)
+	if err != nil {
+		return nil, err
+	}
+	svc, err := config.New(client)
+	if err != nil {
+		return nil, err
+	}
+	svc.BasePath = fmt.Sprintf("https://%s/_ah/api/config/v1/", v.host)
+
+	req := &config.LuciConfigValidateConfigRequestMessage{ConfigSet: configSet}
+	for _, f := range sortedKeys(files) {
+		req.Files = append(req.Files, &config.LuciConfigValidateConfigRequestMessageFile{
+			Path:    f,
+			Content: base64.StdEncoding.EncodeToString(files[f]),
+		})
+	}
+	resp, err := svc.ValidateConfig(req).Context(ctx).Do()
+	if err != nil {
+		return nil, err
+	}
+	return resp.Messages, nil
+}
+
+var validatorKey = "holds a configValidator"
+
+// withValidator installs the validator into the context.
+func withValidator(ctx context.Context, v configValidator) context.Context {
+	return context.WithValue(ctx, &validatorKey, v)
+}
+
+// getValidator returns the validator installed in the context, or nil.
+func getValidator(ctx context.Context) configValidator {
+	v, _ := ctx.Value(&validatorKey).(configValidator)
+	return v
+}
+
+// validateCheckout validates the generated configs of all projects in the
+// checkout which have modified files, recording validation messages in the
+// project reports.
+//
+// Modified files which are not in the generated config directory of any
+// project are skipped with a note.
+func validateCheckout(ctx context.Context, v configValidator, r *repo, projs []*scannedProject) {
+	git := r.git(ctx)
+	changed := splitLines(git.read("diff", "HEAD", "--name-only"))
+	changed = append(changed, splitLines(git.read("ls-files", "--others", "--exclude-standard"))...)
+	roots := make(map[string]string, len(projs))
+	for _, proj := range projs {
+		roots[proj.pb.Id] = git.read("config", generatedConfigRootKey(proj.pb.Id))
+	}
+	if git.err != nil {
+		logging.Errorf(ctx, "Failed to find modified configs: %s", git.err)
+		for _, proj := range projs {
+			proj.remote.Report(validationFailureTag, "Failed to find modified configs", migrator.NonActionable)
+		}
+		return
+	}
+
+	byProject, unknown := groupByConfigSet(changed, roots)
+	for _, proj := range projs {
+		for _, f := range unknown {
+			proj.remote.Report(validationSkippedTag,
+				fmt.Sprintf("%s is not part of a known config set, not validated", f),
+				migrator.NonActionable, migrator.SeverityOption(migrator.SeverityInfo))
+		}
+		if len(byProject[proj.pb.Id]) == 0 {
+			continue
+		}
+		id := migrator.ReportID{Checkout: r.checkoutID, Project: proj.pb.Id}
+		validateProject(proj.ctx, v, id, filepath.Join(r.root, filepath.FromSlash(roots[proj.pb.Id])))
+	}
+}
+
+// groupByConfigSet groups modified files (slash-separated and relative to
+// the checkout root) by the project whose generated config root contains
+// them. Returns the files not in any generated config root separately.
+func groupByConfigSet(changed []string, roots map[string]string) (byProject map[string][]string, unknown []string) {
+	byProject = make(map[string][]string)
+	for _, f := range changed {
+		found := false
+		for projID, root := range roots {
+			if root == "." || strings.HasPrefix(f, path.Clean(root)+"/") {
+				byProject[projID] = append(byProject[projID], f)
+				found = true
+			}
+		}
+		if !found {
+			unknown = append(unknown, f)
+		}
+	}
+	return byProject, unknown
+}
+
+// validateProject validates all files in the generated config directory of
+// a project as its config set, and records the validation messages as
+// reports.
+func validateProject(ctx context.Context, v configValidator, id migrator.ReportID, dir string) {
+	sink := getReportSink(ctx)
+	files, err := readConfigSet(dir)
+	if err != nil {
+		logging.Errorf(ctx, "Failed to read generated configs: %s", err)
+		sink.add(id, validationFailureTag, "Failed to read generated configs", migrator.NonActionable)
+		return
+	}
+	configSet := string(id.ConfigSet())
+	logging.Infof(ctx, "Validating %d file(s) as config set %q", len(files), configSet)
+	messages, err := v.Validate(ctx, configSet, files)
+	if err != nil {
+		logging.Errorf(ctx, "Failed to validate generated configs: %s", err)
+		sink.add(id, validationFailureTag, fmt.Sprintf("Validation RPC failed: %s", err), migrator.NonActionable)
+		return
+	}
+	for _, msg := range messages {
+		fileID := id
+		fileID.ConfigFile = msg.Path
+		switch msg.Severity {
+		case "ERROR", "CRITICAL":
+			logging.Errorf(ctx, "%s: %s: %s", configSet, msg.Path, msg.Text)
+			sink.add(fileID, validationErrorTag, msg.Text, migrator.SeverityOption(migrator.SeverityError))
+		case "WARNING":
+			logging.Warningf(ctx, "%s: %s: %s", configSet, msg.Path, msg.Text)
+			sink.add(fileID, validationWarningTag, msg.Text, migrator.NonActionable)
+		}
+	}
+}
+
+// readConfigSet reads all regular files in the directory (recursively),
+// keyed by their slash-separated paths relative to it.
+func readConfigSet(dir string) (map[string][]byte, error) {
+	files := map[string][]byte{}
+	err := filepath.Walk(dir, func(p string, info os.FileInfo, err error) error {
+		switch {
+		case err != nil:
+			return err
+		case info.IsDir() && info.Name() == ".git":
+			return filepath.SkipDir
+		case !info.Mode().IsRegular():
+			return nil
+		}
+		content, err := ioutil.ReadFile(p)
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(dir, p)
+		if err != nil {
+			return err
+		}
+		files[filepath.ToSlash(rel)] = content
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Annotate(err, "reading %q", dir).Err()
+	}
+	return files, nil
+}
+
+// projectsWithValidationErrors returns IDs of projects which have
+// validation errors in the report dump.
+func projectsWithValidationErrors(dump *migrator.ReportDump) stringset.Set {
+	projs := stringset.New(0)
+	dump.Iterate(func(id migrator.ReportID, reports []*migrator.Report) bool {
+		for _, r := range reports {
+			if r.Tag == validationErrorTag {
+				projs.Add(id.Project)
+				break
+			}
+		}
+		return true
+	})
+	return projs
+}
+
+// loadProjectsWithValidationErrors returns IDs of projects which had
+// validation errors in the last scan report.
+func loadProjectsWithValidationErrors(projectDir ProjectDir) (stringset.Set, error) {
+	f, err := os.Open(projectDir.ScanReportPath())
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	dump, err := migrator.NewReportDumpFromCSV(f)
+	if err != nil {
+		return nil, err
+	}
+	return projectsWithValidationErrors(dump), nil
+}
+
+// splitLines splits the output of a git command into non-empty lines.
+func splitLines(s string) []string {
+	var lines []string
+	for _, l := range strings.Split(s, "\n") {
+		if l != "" {
+			lines = append(lines, l)
+		}
+	}
+	return lines
+}
+
+func sortedKeys(m map[string][]byte) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,153 @@
+// Copyright 2021 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package plugsupport
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	config "go.chromium.org/luci/common/api/luci_config/config/v1"
+
+	"infra/tools/migrator"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+// fakeValidator returns validation errors for specific files.
+type fakeValidator struct {
+	errors map[string]string // file path => error text
+	rpcErr error
+
+	configSet string
+	files     map[string][]byte
+}
+
+func (v *fakeValidator) Validate(ctx context.Context, configSet string, files map[string][]byte) ([]*config.ComponentsConfigEndpointValidationMessage, error) {
+	v.configSet = configSet
+	v.files = files
+	if v.rpcErr != nil {
+		return nil, v.rpcErr
+	}
+	var msgs []*config.ComponentsConfigEndpointValidationMessage
+	for _, f := range sortedKeys(files) {
+		if text, ok := v.errors[f]; ok {
+			msgs = append(msgs, &config.ComponentsConfigEndpointValidationMessage{
+				Path:     f,
+				Severity: "ERROR",
+				Text:     text,
+			})
+		}
+	}
+	msgs = append(msgs, &config.ComponentsConfigEndpointValidationMessage{
+		Path:     "project.cfg",
+		Severity: "INFO",
+		Text:     "ignored",
+	})
+	return msgs, nil
+}
+
+func TestGroupByConfigSet(t *testing.T) {
+	t.Parallel()
+
+	Convey(`groupByConfigSet`, t, func() {
+		roots := map[string]string{
+			"a": "projects/a/generated",
+			"b": "projects/b/generated/",
+		}
+		byProject, unknown := groupByConfigSet([]string{
+			"projects/a/generated/cr-buildbucket.cfg",
+			"projects/a/main.star",
+			"projects/b/generated/nested/luci-scheduler.cfg",
+			"projects/b/generated-other/x.cfg",
+		}, roots)
+		So(byProject, ShouldResemble, map[string][]string{
+			"a": {"projects/a/generated/cr-buildbucket.cfg"},
+			"b": {"projects/b/generated/nested/luci-scheduler.cfg"},
+		})
+		So(unknown, ShouldResemble, []string{
+			"projects/a/main.star",
+			"projects/b/generated-other/x.cfg",
+		})
+
+		Convey(`Root of the repo`, func() {
+			byProject, unknown := groupByConfigSet([]string{"cr-buildbucket.cfg"}, map[string]string{"a": "."})
+			So(byProject, ShouldResemble, map[string][]string{"a": {"cr-buildbucket.cfg"}})
+			So(unknown, ShouldBeEmpty)
+		})
+	})
+}
+
+func TestValidateProject(t *testing.T) {
+	t.Parallel()
+
+	Convey(`validateProject`, t, func() {
+		ctx := InitReportSink(context.Background())
+
+		dir, err := ioutil.TempDir("", "migrator-validate")
+		So(err, ShouldBeNil)
+		defer os.RemoveAll(dir)
+
+		write := func(rel, content string) {
+			p := filepath.Join(dir, filepath.FromSlash(rel))
+			So(os.MkdirAll(filepath.Dir(p), 0777), ShouldBeNil)
+			So(ioutil.WriteFile(p, []byte(content), 0666), ShouldBeNil)
+		}
+		write("project.cfg", "name: \"proj\"")
+		write("cr-buildbucket.cfg", "broken")
+		write("nested/luci-scheduler.cfg", "also broken")
+		write(".git/HEAD", "not a config")
+
+		id := migrator.ReportID{Checkout: "proj-project", Project: "proj"}
+		reports := func() map[string][]string {
+			out := map[string][]string{}
+			DumpReports(ctx).Iterate(func(id migrator.ReportID, rs []*migrator.Report) bool {
+				for _, r := range rs {
+					out[id.String()] = append(out[id.String()], r.Tag+": "+r.Problem)
+				}
+				return true
+			})
+			return out
+		}
+
+		Convey(`Records validation errors`, func() {
+			v := &fakeValidator{errors: map[string]string{
+				"cr-buildbucket.cfg":        "bad buildbucket config",
+				"nested/luci-scheduler.cfg": "bad scheduler config",
+			}}
+			validateProject(ctx, v, id, dir)
+
+			So(v.configSet, ShouldEqual, "projects/proj")
+			So(sortedKeys(v.files), ShouldResemble, []string{
+				"cr-buildbucket.cfg",
+				"nested/luci-scheduler.cfg",
+				"project.cfg",
+			})
+			So(reports(), ShouldResemble, map[string][]string{
+				"proj-project|proj|cr-buildbucket.cfg":        {"CONFIG_VALIDATION_ERROR: bad buildbucket config"},
+				"proj-project|proj|nested/luci-scheduler.cfg": {"CONFIG_VALIDATION_ERROR: bad scheduler config"},
+			})
+			So(projectsWithValidationErrors(DumpReports(ctx)).ToSortedSlice(), ShouldResemble, []string{"proj"})
+		})
+
+		Convey(`Valid configs`, func() {
+			validateProject(ctx, &fakeValidator{}, id, dir)
+			So(reports(), ShouldBeEmpty)
+			So(projectsWithValidationErrors(DumpReports(ctx)), ShouldBeEmpty)
+		})
+
+		Convey(`RPC failure`, func() {
+			validateProject(ctx, &fakeValidator{rpcErr: errors.New("boom")}, id, dir)
+			So(reports(), ShouldResemble, map[string][]string{
+				"proj-project|proj": {"CONFIG_VALIDATION_FAILURE: Validation RPC failed: boom"},
+			})
+			// RPC failures are not validation errors and do not block uploads.
+			So(projectsWithValidationErrors(DumpReports(ctx)), ShouldBeEmpty)
+		})
+	})
+}
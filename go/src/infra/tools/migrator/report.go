@@ -41,6 +41,56 @@ func (r ReportID) String() string {
 	return strings.Join(chunks, "|")
 }
 
+// Severity indicates how important a Report is.
+//
+// Severities are ordered, from the least to the most important.
+type Severity int
+
+const (
+	// SeverityInfo is for reports which are informational only.
+	SeverityInfo Severity = iota
+	// SeverityWarning is for reports which should be looked at. This is the
+	// default for reports without a SeverityOption.
+	SeverityWarning
+	// SeverityError is for reports which must be fixed, e.g. before
+	// a migration deadline.
+	SeverityError
+)
+
+var severityNames = map[Severity]string{
+	SeverityInfo:    "INFO",
+	SeverityWarning: "WARNING",
+	SeverityError:   "ERROR",
+}
+
+func (s Severity) String() string {
+	if name, ok := severityNames[s]; ok {
+		return name
+	}
+	return fmt.Sprintf("Severity(%d)", int(s))
+}
+
+// ParseSeverity parses a severity name as returned by Severity.String, e.g.
+// "WARNING". The name is case-insensitive.
+func ParseSeverity(name string) (Severity, error) {
+	for s, n := range severityNames {
+		if strings.EqualFold(name, n) {
+			return s, nil
+		}
+	}
+	return 0, errors.Reason("unknown severity %q", name).Err()
+}
+
+// Set implements flag.Value.
+func (s *Severity) Set(name string) error {
+	parsed, err := ParseSeverity(name)
+	if err != nil {
+		return err
+	}
+	*s = parsed
+	return nil
+}
+
 // Report stores a single tagged problem (and metadata).
 type Report struct {
 	ReportID
@@ -51,6 +101,8 @@ type Report struct {
 	// If true, indicates that this report can be fixed by ApplyFix.
 	Actionable bool
 
+	Severity Severity
+
 	Metadata map[string]stringset.Set
 }
 
@@ -68,11 +120,11 @@ func (r *Report) Clone() *Report {
 }
 
 // ToCSVRow returns a CSV row:
-//    Checkout, Project, ConfigFile, Tag, Problem, Actionable, Metadata*
+//    Checkout, Project, ConfigFile, Tag, Problem, Actionable, Severity, Metadata*
 //
 // Where Metadata* is one key:value entry per value in Metadata.
 func (r *Report) ToCSVRow() []string {
-	ret := []string{r.Checkout, r.Project, r.ConfigFile, r.Tag, r.Problem, fmt.Sprintf("%t", r.Actionable)}
+	ret := []string{r.Checkout, r.Project, r.ConfigFile, r.Tag, r.Problem, fmt.Sprintf("%t", r.Actionable), r.Severity.String()}
 	if len(r.Metadata) > 0 {
 		keys := make([]string, len(r.Metadata))
 		for key := range r.Metadata {
@@ -129,6 +181,15 @@ func NewReportFromCSVRow(row []string) (ret *Report, err error) {
 	}
 	ret.Actionable = actionable == "true"
 
+	severity := ""
+	if severity, ok = shift(); !ok {
+		err = errors.New("Severity field required")
+		return
+	}
+	if ret.Severity, err = ParseSeverity(severity); err != nil {
+		return
+	}
+
 	for i, mdata := range row {
 		toks := strings.SplitN(mdata, ":", 2)
 		if len(toks) != 2 {
@@ -161,6 +222,15 @@ func MetadataOption(key string, values ...string) ReportOption {
 	}
 }
 
+// SeverityOption returns a ReportOption which sets the Severity of a Report.
+//
+// Reports without this option have SeverityWarning.
+func SeverityOption(s Severity) ReportOption {
+	return func(r *Report) {
+		r.Severity = s
+	}
+}
+
 // NonActionable is-a ReportOption which indicates that this Report cannot be
 // fixed by ApplyFix. If there are no Actionable Reports for a given project in
 // FindProblems, the checkout and ApplyFix phase will be skipped.
@@ -117,7 +117,7 @@ func (r *ReportDump) Empty() bool {
 const (
 	schemaPrefix  = "{schema="
 	schemaSuffix  = "}"
-	schemaVersion = "v3"
+	schemaVersion = "v4"
 )
 
 // parseSchemaVersion returns the "v1" from e.g. "{schema=v1}" or "" if `token`
@@ -130,7 +130,7 @@ func parseSchemaVersion(token string) string {
 }
 
 var csvHeader = []string{
-	"Checkout", "Project", "ConfigFile", "Tag", "Problem", "Actionable", "Metadata",
+	"Checkout", "Project", "ConfigFile", "Tag", "Problem", "Actionable", "Severity", "Metadata",
 	schemaPrefix + schemaVersion + schemaSuffix,
 }
 
@@ -29,10 +29,10 @@ func TestReportDump(t *testing.T) {
 
 		Convey(`Add`, func() {
 			rd.Add(
-				mkReport("checkout", "proj-foo", "some.file", "TAG_THIRD", "another problem", "true"),
-				mkReport("checkout", "proj-foo", "", "TAG", "problem", "true"),
-				mkReport("checkout", "proj-foo", "", "TAG_OTHER", "problem", "true"),
-				mkReport("checkout", "other-proj", "", "TAG", "problem", "true"),
+				mkReport("checkout", "proj-foo", "some.file", "TAG_THIRD", "another problem", "true", "WARNING"),
+				mkReport("checkout", "proj-foo", "", "TAG", "problem", "true", "WARNING"),
+				mkReport("checkout", "proj-foo", "", "TAG_OTHER", "problem", "true", "WARNING"),
+				mkReport("checkout", "other-proj", "", "TAG", "problem", "true", "WARNING"),
 			)
 
 			So(rd.Empty(), ShouldBeFalse)
@@ -92,11 +92,11 @@ func TestReportCSV(t *testing.T) {
 		Convey(`Write`, func() {
 			rd := &ReportDump{}
 			rd.Add(
-				mkReport("checkout", "proj-foo", "some.file", "TAG_THIRD", "another problem", "true"),
-				mkReport("checkout", "proj-foo", "", "TAG", "problem", "true", "meta:data", "a:value"),
-				mkReport("checkout", "proj-foo", "", "TAG_OTHER", "problem", "true"),
-				mkReport("checkout", "other-proj", "", "TAG", "problem", "true"),
-				mkReport("checkout", "a-third-prog", "", "TAG", "problem", "true"),
+				mkReport("checkout", "proj-foo", "some.file", "TAG_THIRD", "another problem", "true", "WARNING"),
+				mkReport("checkout", "proj-foo", "", "TAG", "problem", "true", "WARNING", "meta:data", "a:value"),
+				mkReport("checkout", "proj-foo", "", "TAG_OTHER", "problem", "true", "WARNING"),
+				mkReport("checkout", "other-proj", "", "TAG", "problem", "true", "ERROR"),
+				mkReport("checkout", "a-third-prog", "", "TAG", "problem", "true", "WARNING"),
 			)
 
 			buf := &bytes.Buffer{}
@@ -108,11 +108,11 @@ func TestReportCSV(t *testing.T) {
 			So(err, ShouldBeNil)
 			So(lines, ShouldResemble, [][]string{
 				csvHeader,
-				{"checkout", "a-third-prog", "", "TAG", "problem", "true"},
-				{"checkout", "other-proj", "", "TAG", "problem", "true"},
-				{"checkout", "proj-foo", "", "TAG", "problem", "true", "a:value", "meta:data"},
-				{"checkout", "proj-foo", "", "TAG_OTHER", "problem", "true"},
-				{"checkout", "proj-foo", "some.file", "TAG_THIRD", "another problem", "true"},
+				{"checkout", "a-third-prog", "", "TAG", "problem", "true", "WARNING"},
+				{"checkout", "other-proj", "", "TAG", "problem", "true", "ERROR"},
+				{"checkout", "proj-foo", "", "TAG", "problem", "true", "WARNING", "a:value", "meta:data"},
+				{"checkout", "proj-foo", "", "TAG_OTHER", "problem", "true", "WARNING"},
+				{"checkout", "proj-foo", "some.file", "TAG_THIRD", "another problem", "true", "WARNING"},
 			})
 		})
 
@@ -122,11 +122,11 @@ func TestReportCSV(t *testing.T) {
 				csvWrite := csv.NewWriter(buf)
 				csvWrite.WriteAll([][]string{
 					csvHeader,
-					{"checkout", "a-third-prog", "", "TAG", "problem", "true"},
-					{"checkout", "other-proj", "", "TAG", "problem", "true"},
-					{"checkout", "proj-foo", "", "TAG", "problem", "true", "a:value", "meta:data"},
-					{"checkout", "proj-foo", "", "TAG_OTHER", "problem", "true"},
-					{"checkout", "proj-foo", "some.file", "TAG_THIRD", "another problem", "true"},
+					{"checkout", "a-third-prog", "", "TAG", "problem", "false", "INFO"},
+					{"checkout", "other-proj", "", "TAG", "problem", "true", "WARNING"},
+					{"checkout", "proj-foo", "", "TAG", "problem", "true", "WARNING", "a:value", "meta:data"},
+					{"checkout", "proj-foo", "", "TAG_OTHER", "problem", "true", "WARNING"},
+					{"checkout", "proj-foo", "some.file", "TAG_THIRD", "another problem", "true", "WARNING"},
 				})
 				csvWrite.Flush()
 
@@ -134,17 +134,17 @@ func TestReportCSV(t *testing.T) {
 				So(err, ShouldBeNil)
 				So(rd.data, ShouldResemble, map[ReportID][]*Report{
 					{"checkout", "proj-foo", ""}: {
-						mkReport("checkout", "proj-foo", "", "TAG", "problem", "true", "meta:data", "a:value"),
-						mkReport("checkout", "proj-foo", "", "TAG_OTHER", "problem", "true"),
+						mkReport("checkout", "proj-foo", "", "TAG", "problem", "true", "WARNING", "meta:data", "a:value"),
+						mkReport("checkout", "proj-foo", "", "TAG_OTHER", "problem", "true", "WARNING"),
 					},
 					{"checkout", "proj-foo", "some.file"}: {
-						mkReport("checkout", "proj-foo", "some.file", "TAG_THIRD", "another problem", "true"),
+						mkReport("checkout", "proj-foo", "some.file", "TAG_THIRD", "another problem", "true", "WARNING"),
 					},
 					{"checkout", "other-proj", ""}: {
-						mkReport("checkout", "other-proj", "", "TAG", "problem", "true"),
+						mkReport("checkout", "other-proj", "", "TAG", "problem", "true", "WARNING"),
 					},
 					{"checkout", "a-third-prog", ""}: {
-						mkReport("checkout", "a-third-prog", "", "TAG", "problem", "true"),
+						mkReport("checkout", "a-third-prog", "", "TAG", "problem", "false", "INFO"),
 					},
 				})
 			})
@@ -161,12 +161,12 @@ func TestReportCSV(t *testing.T) {
 				buf := &bytes.Buffer{}
 				csvWrite := csv.NewWriter(buf)
 				header := append([]string(nil), csvHeader[:len(csvHeader)-1]...)
-				header = append(header, "{schema=v4}")
+				header = append(header, "{schema=v5}")
 				csvWrite.Write(header)
 				csvWrite.Flush()
 
 				_, err := NewReportDumpFromCSV(buf)
-				So(err, ShouldErrLike, "unexpected version: \"v4\", expected \"v3\"")
+				So(err, ShouldErrLike, "unexpected version: \"v5\", expected \"v4\"")
 			})
 
 			Convey(`Bad Header length`, func() {
@@ -33,6 +33,43 @@ func TestReportID(t *testing.T) {
 	})
 }
 
+func TestSeverity(t *testing.T) {
+	t.Parallel()
+
+	Convey(`Severity`, t, func() {
+		Convey(`String`, func() {
+			So(SeverityInfo.String(), ShouldEqual, "INFO")
+			So(SeverityWarning.String(), ShouldEqual, "WARNING")
+			So(SeverityError.String(), ShouldEqual, "ERROR")
+			So(Severity(42).String(), ShouldEqual, "Severity(42)")
+		})
+
+		Convey(`ParseSeverity`, func() {
+			s, err := ParseSeverity("ERROR")
+			So(err, ShouldBeNil)
+			So(s, ShouldEqual, SeverityError)
+
+			s, err = ParseSeverity("info")
+			So(err, ShouldBeNil)
+			So(s, ShouldEqual, SeverityInfo)
+
+			_, err = ParseSeverity("")
+			So(err, ShouldErrLike, "unknown severity")
+		})
+
+		Convey(`Ordered`, func() {
+			So(SeverityInfo, ShouldBeLessThan, SeverityWarning)
+			So(SeverityWarning, ShouldBeLessThan, SeverityError)
+		})
+
+		Convey(`SeverityOption`, func() {
+			r := &Report{Severity: SeverityWarning}
+			SeverityOption(SeverityInfo)(r)
+			So(r.Severity, ShouldEqual, SeverityInfo)
+		})
+	})
+}
+
 func TestReport(t *testing.T) {
 	t.Parallel()
 
@@ -58,13 +95,14 @@ func TestReport(t *testing.T) {
 
 		Convey(`ToCSVRow`, func() {
 			So(r.ToCSVRow(), ShouldResemble, []string{
-				"checkout", "proj-foo", "config.file", "SOME_TAG", "This is a problem.", "false",
+				"checkout", "proj-foo", "config.file", "SOME_TAG", "This is a problem.", "false", "INFO",
 				"meta:value",
 			})
 
 			r.Actionable = true
+			r.Severity = SeverityError
 			So(r.ToCSVRow(), ShouldResemble, []string{
-				"checkout", "proj-foo", "config.file", "SOME_TAG", "This is a problem.", "true",
+				"checkout", "proj-foo", "config.file", "SOME_TAG", "This is a problem.", "true", "ERROR",
 				"meta:value",
 			})
 		})
@@ -73,13 +111,14 @@ func TestReport(t *testing.T) {
 			Convey(`Good`, func() {
 				report, err := NewReportFromCSVRow([]string{
 					"checkout", "proj-foo", "config.file", "SOME_TAG", "This is a problem.",
-					"true", "meta:value", "meta:other_value", "other_meta:1",
+					"true", "ERROR", "meta:value", "meta:other_value", "other_meta:1",
 				})
 				So(err, ShouldBeNil)
 				So(report.ReportID, ShouldResemble, ReportID{"checkout", "proj-foo", "config.file"})
 				So(report.Tag, ShouldResemble, "SOME_TAG")
 				So(report.Problem, ShouldResemble, "This is a problem.")
 				So(report.Actionable, ShouldBeTrue)
+				So(report.Severity, ShouldEqual, SeverityError)
 				So(report.Metadata, ShouldResemble, map[string]stringset.Set{
 					"meta":       stringset.NewFromSlice("value", "other_value"),
 					"other_meta": stringset.NewFromSlice("1"),
@@ -132,14 +171,22 @@ func TestReport(t *testing.T) {
 					So(err, ShouldErrLike, "Actionable field")
 
 					_, err = NewReportFromCSVRow([]string{"checkout", "proj-foo", "", "TAG", "", "true"})
+					So(err, ShouldErrLike, "Severity field")
+				})
+
+				Convey(`bad Severity`, func() {
+					_, err := NewReportFromCSVRow([]string{"checkout", "proj-foo", "", "TAG", "", "true", "FATAL"})
+					So(err, ShouldErrLike, "unknown severity")
+
+					_, err = NewReportFromCSVRow([]string{"checkout", "proj-foo", "", "TAG", "", "true", "WARNING"})
 					So(err, ShouldBeNil)
 				})
 
 				Convey(`bad metadata`, func() {
-					_, err := NewReportFromCSVRow([]string{"checkout", "proj-foo", "", "TAG", "", "true", "bad"})
+					_, err := NewReportFromCSVRow([]string{"checkout", "proj-foo", "", "TAG", "", "true", "WARNING", "bad"})
 					So(err, ShouldErrLike, "Malformed metadata")
 
-					_, err = NewReportFromCSVRow([]string{"checkout", "proj-foo", "", "TAG", "", "true", "ok:value"})
+					_, err = NewReportFromCSVRow([]string{"checkout", "proj-foo", "", "TAG", "", "true", "WARNING", "ok:value"})
 					So(err, ShouldBeNil)
 				})
 			})
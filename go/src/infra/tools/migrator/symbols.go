@@ -22,6 +22,14 @@ type API interface {
 	// more problems will cause the migrator tool to set up a checkout for this
 	// project.
 	//
+	// Reports have SeverityWarning unless reported with a SeverityOption. The
+	// `-min-severity` flag of the scan command can be used to only set up
+	// checkouts for projects with reports of at least a given severity.
+	//
+	// FindProblems is called for multiple projects concurrently. `ctx` is
+	// canceled when the `-project-timeout` flag of the scan command expires, in
+	// which case the project is reported as timed out and ApplyFix isn't called.
+	//
 	// Logging is set up for this context, and will be diverted to a per-project
 	// logfile.
 	//
@@ -2496,6 +2496,9 @@ type GetMachineRequest struct {
 
 	// The name of the machine to retrieve.
 	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// if this is true, a deleted machine which is still in the retention
+	// window will be returned with its delete_time and delete_user set.
+	ShowDeleted bool `protobuf:"varint,2,opt,name=show_deleted,json=showDeleted,proto3" json:"show_deleted,omitempty"`
 }
 
 func (x *GetMachineRequest) Reset() {
@@ -2537,6 +2540,13 @@ func (x *GetMachineRequest) GetName() string {
 	return ""
 }
 
+func (x *GetMachineRequest) GetShowDeleted() bool {
+	if x != nil {
+		return x.ShowDeleted
+	}
+	return false
+}
+
 type ListMachinesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -2562,6 +2572,10 @@ type ListMachinesRequest struct {
 	// By setting this to true, the list call will be slower as the server
 	// needs to query and populate the Nic/Drac for each machine.
 	Full bool `protobuf:"varint,5,opt,name=full,proto3" json:"full,omitempty"`
+	// if this is true, deleted machines which are still in the retention
+	// window will be returned after all the existing machines.
+	// It cannot be used together with filter.
+	ShowDeleted bool `protobuf:"varint,6,opt,name=show_deleted,json=showDeleted,proto3" json:"show_deleted,omitempty"`
 }
 
 func (x *ListMachinesRequest) Reset() {
@@ -2631,6 +2645,13 @@ func (x *ListMachinesRequest) GetFull() bool {
 	return false
 }
 
+func (x *ListMachinesRequest) GetShowDeleted() bool {
+	if x != nil {
+		return x.ShowDeleted
+	}
+	return false
+}
+
 type ListMachinesResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -2817,6 +2838,54 @@ func (*ImportMachinesRequest_MachineDbSource) isImportMachinesRequest_Source() {
 
 func (*ImportMachinesRequest_ConfigSource) isImportMachinesRequest_Source() {}
 
+type RestoreMachineRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The name of the deleted Machine to restore
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *RestoreMachineRequest) Reset() {
+	*x = RestoreMachineRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RestoreMachineRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreMachineRequest) ProtoMessage() {}
+
+func (x *RestoreMachineRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreMachineRequest.ProtoReflect.Descriptor instead.
+func (*RestoreMachineRequest) Descriptor() ([]byte, []int) {
+	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *RestoreMachineRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
 type RenameMachineRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -2831,7 +2900,7 @@ type RenameMachineRequest struct {
 func (x *RenameMachineRequest) Reset() {
 	*x = RenameMachineRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[46]
+		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[47]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2844,7 +2913,7 @@ func (x *RenameMachineRequest) String() string {
 func (*RenameMachineRequest) ProtoMessage() {}
 
 func (x *RenameMachineRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[46]
+	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[47]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2857,7 +2926,7 @@ func (x *RenameMachineRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RenameMachineRequest.ProtoReflect.Descriptor instead.
 func (*RenameMachineRequest) Descriptor() ([]byte, []int) {
-	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{46}
+	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{47}
 }
 
 func (x *RenameMachineRequest) GetName() string {
@@ -2885,7 +2954,7 @@ type MachineDBSource struct {
 func (x *MachineDBSource) Reset() {
 	*x = MachineDBSource{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[47]
+		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[48]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2898,7 +2967,7 @@ func (x *MachineDBSource) String() string {
 func (*MachineDBSource) ProtoMessage() {}
 
 func (x *MachineDBSource) ProtoReflect() protoreflect.Message {
-	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[47]
+	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[48]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2911,7 +2980,7 @@ func (x *MachineDBSource) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MachineDBSource.ProtoReflect.Descriptor instead.
 func (*MachineDBSource) Descriptor() ([]byte, []int) {
-	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{47}
+	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{48}
 }
 
 func (x *MachineDBSource) GetHost() string {
@@ -2934,7 +3003,7 @@ type ConfigSource struct {
 func (x *ConfigSource) Reset() {
 	*x = ConfigSource{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[48]
+		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[49]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2947,7 +3016,7 @@ func (x *ConfigSource) String() string {
 func (*ConfigSource) ProtoMessage() {}
 
 func (x *ConfigSource) ProtoReflect() protoreflect.Message {
-	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[48]
+	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[49]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2960,7 +3029,7 @@ func (x *ConfigSource) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ConfigSource.ProtoReflect.Descriptor instead.
 func (*ConfigSource) Descriptor() ([]byte, []int) {
-	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{48}
+	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{49}
 }
 
 func (x *ConfigSource) GetConfigServiceName() string {
@@ -2997,7 +3066,7 @@ type CreateRackRequest struct {
 func (x *CreateRackRequest) Reset() {
 	*x = CreateRackRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[49]
+		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[50]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3010,7 +3079,7 @@ func (x *CreateRackRequest) String() string {
 func (*CreateRackRequest) ProtoMessage() {}
 
 func (x *CreateRackRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[49]
+	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[50]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3023,7 +3092,7 @@ func (x *CreateRackRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateRackRequest.ProtoReflect.Descriptor instead.
 func (*CreateRackRequest) Descriptor() ([]byte, []int) {
-	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{49}
+	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{50}
 }
 
 func (x *CreateRackRequest) GetRack() *models.Rack {
@@ -3054,7 +3123,7 @@ type UpdateRackRequest struct {
 func (x *UpdateRackRequest) Reset() {
 	*x = UpdateRackRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[50]
+		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[51]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3067,7 +3136,7 @@ func (x *UpdateRackRequest) String() string {
 func (*UpdateRackRequest) ProtoMessage() {}
 
 func (x *UpdateRackRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[50]
+	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[51]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3080,7 +3149,7 @@ func (x *UpdateRackRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateRackRequest.ProtoReflect.Descriptor instead.
 func (*UpdateRackRequest) Descriptor() ([]byte, []int) {
-	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{50}
+	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{51}
 }
 
 func (x *UpdateRackRequest) GetRack() *models.Rack {
@@ -3109,7 +3178,7 @@ type GetRackRequest struct {
 func (x *GetRackRequest) Reset() {
 	*x = GetRackRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[51]
+		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[52]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3122,7 +3191,7 @@ func (x *GetRackRequest) String() string {
 func (*GetRackRequest) ProtoMessage() {}
 
 func (x *GetRackRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[51]
+	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[52]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3135,7 +3204,7 @@ func (x *GetRackRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetRackRequest.ProtoReflect.Descriptor instead.
 func (*GetRackRequest) Descriptor() ([]byte, []int) {
-	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{51}
+	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{52}
 }
 
 func (x *GetRackRequest) GetName() string {
@@ -3175,7 +3244,7 @@ type ListRacksRequest struct {
 func (x *ListRacksRequest) Reset() {
 	*x = ListRacksRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[52]
+		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[53]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3188,7 +3257,7 @@ func (x *ListRacksRequest) String() string {
 func (*ListRacksRequest) ProtoMessage() {}
 
 func (x *ListRacksRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[52]
+	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[53]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3201,7 +3270,7 @@ func (x *ListRacksRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListRacksRequest.ProtoReflect.Descriptor instead.
 func (*ListRacksRequest) Descriptor() ([]byte, []int) {
-	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{52}
+	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{53}
 }
 
 func (x *ListRacksRequest) GetPageSize() int32 {
@@ -3254,7 +3323,7 @@ type ListRacksResponse struct {
 func (x *ListRacksResponse) Reset() {
 	*x = ListRacksResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[53]
+		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[54]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3267,7 +3336,7 @@ func (x *ListRacksResponse) String() string {
 func (*ListRacksResponse) ProtoMessage() {}
 
 func (x *ListRacksResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[53]
+	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[54]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3280,7 +3349,7 @@ func (x *ListRacksResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListRacksResponse.ProtoReflect.Descriptor instead.
 func (*ListRacksResponse) Descriptor() ([]byte, []int) {
-	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{53}
+	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{54}
 }
 
 func (x *ListRacksResponse) GetRacks() []*models.Rack {
@@ -3309,7 +3378,7 @@ type DeleteRackRequest struct {
 func (x *DeleteRackRequest) Reset() {
 	*x = DeleteRackRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[54]
+		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[55]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3322,7 +3391,7 @@ func (x *DeleteRackRequest) String() string {
 func (*DeleteRackRequest) ProtoMessage() {}
 
 func (x *DeleteRackRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[54]
+	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[55]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3335,7 +3404,7 @@ func (x *DeleteRackRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteRackRequest.ProtoReflect.Descriptor instead.
 func (*DeleteRackRequest) Descriptor() ([]byte, []int) {
-	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{54}
+	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{55}
 }
 
 func (x *DeleteRackRequest) GetName() string {
@@ -3366,7 +3435,7 @@ type CreateMachineLSERequest struct {
 func (x *CreateMachineLSERequest) Reset() {
 	*x = CreateMachineLSERequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[55]
+		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[56]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3379,7 +3448,7 @@ func (x *CreateMachineLSERequest) String() string {
 func (*CreateMachineLSERequest) ProtoMessage() {}
 
 func (x *CreateMachineLSERequest) ProtoReflect() protoreflect.Message {
-	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[55]
+	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[56]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3392,7 +3461,7 @@ func (x *CreateMachineLSERequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateMachineLSERequest.ProtoReflect.Descriptor instead.
 func (*CreateMachineLSERequest) Descriptor() ([]byte, []int) {
-	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{55}
+	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{56}
 }
 
 func (x *CreateMachineLSERequest) GetMachineLSE() *models.MachineLSE {
@@ -3432,7 +3501,7 @@ type UpdateMachineLSERequest struct {
 func (x *UpdateMachineLSERequest) Reset() {
 	*x = UpdateMachineLSERequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[56]
+		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[57]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3445,7 +3514,7 @@ func (x *UpdateMachineLSERequest) String() string {
 func (*UpdateMachineLSERequest) ProtoMessage() {}
 
 func (x *UpdateMachineLSERequest) ProtoReflect() protoreflect.Message {
-	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[56]
+	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[57]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3458,7 +3527,7 @@ func (x *UpdateMachineLSERequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateMachineLSERequest.ProtoReflect.Descriptor instead.
 func (*UpdateMachineLSERequest) Descriptor() ([]byte, []int) {
-	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{56}
+	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{57}
 }
 
 func (x *UpdateMachineLSERequest) GetMachineLSE() *models.MachineLSE {
@@ -3489,12 +3558,15 @@ type GetMachineLSERequest struct {
 
 	// The name of the machineLSE to retrieve.
 	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// if this is true, a deleted machineLSE which is still in the retention
+	// window will be returned with its delete_time and delete_user set.
+	ShowDeleted bool `protobuf:"varint,2,opt,name=show_deleted,json=showDeleted,proto3" json:"show_deleted,omitempty"`
 }
 
 func (x *GetMachineLSERequest) Reset() {
 	*x = GetMachineLSERequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[57]
+		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[58]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3507,7 +3579,7 @@ func (x *GetMachineLSERequest) String() string {
 func (*GetMachineLSERequest) ProtoMessage() {}
 
 func (x *GetMachineLSERequest) ProtoReflect() protoreflect.Message {
-	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[57]
+	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[58]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3520,7 +3592,7 @@ func (x *GetMachineLSERequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetMachineLSERequest.ProtoReflect.Descriptor instead.
 func (*GetMachineLSERequest) Descriptor() ([]byte, []int) {
-	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{57}
+	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{58}
 }
 
 func (x *GetMachineLSERequest) GetName() string {
@@ -3530,6 +3602,13 @@ func (x *GetMachineLSERequest) GetName() string {
 	return ""
 }
 
+func (x *GetMachineLSERequest) GetShowDeleted() bool {
+	if x != nil {
+		return x.ShowDeleted
+	}
+	return false
+}
+
 type ListMachineLSEsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -3555,12 +3634,16 @@ type ListMachineLSEsRequest struct {
 	// By setting this to true, the list call will be slower as the server
 	// needs to query and populate the VM for each machinelse.
 	Full bool `protobuf:"varint,5,opt,name=full,proto3" json:"full,omitempty"`
+	// if this is true, deleted machineLSEs which are still in the retention
+	// window will be returned after all the existing machineLSEs.
+	// It cannot be used together with filter.
+	ShowDeleted bool `protobuf:"varint,6,opt,name=show_deleted,json=showDeleted,proto3" json:"show_deleted,omitempty"`
 }
 
 func (x *ListMachineLSEsRequest) Reset() {
 	*x = ListMachineLSEsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[58]
+		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[59]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3573,7 +3656,7 @@ func (x *ListMachineLSEsRequest) String() string {
 func (*ListMachineLSEsRequest) ProtoMessage() {}
 
 func (x *ListMachineLSEsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[58]
+	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[59]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3586,7 +3669,7 @@ func (x *ListMachineLSEsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListMachineLSEsRequest.ProtoReflect.Descriptor instead.
 func (*ListMachineLSEsRequest) Descriptor() ([]byte, []int) {
-	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{58}
+	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{59}
 }
 
 func (x *ListMachineLSEsRequest) GetPageSize() int32 {
@@ -3624,6 +3707,13 @@ func (x *ListMachineLSEsRequest) GetFull() bool {
 	return false
 }
 
+func (x *ListMachineLSEsRequest) GetShowDeleted() bool {
+	if x != nil {
+		return x.ShowDeleted
+	}
+	return false
+}
+
 type ListMachineLSEsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -3639,7 +3729,7 @@ type ListMachineLSEsResponse struct {
 func (x *ListMachineLSEsResponse) Reset() {
 	*x = ListMachineLSEsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[59]
+		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[60]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3652,7 +3742,7 @@ func (x *ListMachineLSEsResponse) String() string {
 func (*ListMachineLSEsResponse) ProtoMessage() {}
 
 func (x *ListMachineLSEsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[59]
+	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[60]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3665,7 +3755,7 @@ func (x *ListMachineLSEsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListMachineLSEsResponse.ProtoReflect.Descriptor instead.
 func (*ListMachineLSEsResponse) Descriptor() ([]byte, []int) {
-	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{59}
+	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{60}
 }
 
 func (x *ListMachineLSEsResponse) GetMachineLSEs() []*models.MachineLSE {
@@ -3694,7 +3784,7 @@ type DeleteMachineLSERequest struct {
 func (x *DeleteMachineLSERequest) Reset() {
 	*x = DeleteMachineLSERequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[60]
+		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[61]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3707,7 +3797,7 @@ func (x *DeleteMachineLSERequest) String() string {
 func (*DeleteMachineLSERequest) ProtoMessage() {}
 
 func (x *DeleteMachineLSERequest) ProtoReflect() protoreflect.Message {
-	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[60]
+	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[61]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3720,7 +3810,7 @@ func (x *DeleteMachineLSERequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteMachineLSERequest.ProtoReflect.Descriptor instead.
 func (*DeleteMachineLSERequest) Descriptor() ([]byte, []int) {
-	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{60}
+	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{61}
 }
 
 func (x *DeleteMachineLSERequest) GetName() string {
@@ -3730,6 +3820,54 @@ func (x *DeleteMachineLSERequest) GetName() string {
 	return ""
 }
 
+type RestoreMachineLSERequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The name of the deleted MachineLSE to restore
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *RestoreMachineLSERequest) Reset() {
+	*x = RestoreMachineLSERequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RestoreMachineLSERequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreMachineLSERequest) ProtoMessage() {}
+
+func (x *RestoreMachineLSERequest) ProtoReflect() protoreflect.Message {
+	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreMachineLSERequest.ProtoReflect.Descriptor instead.
+func (*RestoreMachineLSERequest) Descriptor() ([]byte, []int) {
+	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *RestoreMachineLSERequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
 type RenameMachineLSERequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -3744,7 +3882,7 @@ type RenameMachineLSERequest struct {
 func (x *RenameMachineLSERequest) Reset() {
 	*x = RenameMachineLSERequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[61]
+		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[63]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3757,7 +3895,7 @@ func (x *RenameMachineLSERequest) String() string {
 func (*RenameMachineLSERequest) ProtoMessage() {}
 
 func (x *RenameMachineLSERequest) ProtoReflect() protoreflect.Message {
-	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[61]
+	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[63]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3770,7 +3908,7 @@ func (x *RenameMachineLSERequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RenameMachineLSERequest.ProtoReflect.Descriptor instead.
 func (*RenameMachineLSERequest) Descriptor() ([]byte, []int) {
-	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{61}
+	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{63}
 }
 
 func (x *RenameMachineLSERequest) GetName() string {
@@ -3801,7 +3939,7 @@ type ImportMachineLSEsRequest struct {
 func (x *ImportMachineLSEsRequest) Reset() {
 	*x = ImportMachineLSEsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[62]
+		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[64]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3814,7 +3952,7 @@ func (x *ImportMachineLSEsRequest) String() string {
 func (*ImportMachineLSEsRequest) ProtoMessage() {}
 
 func (x *ImportMachineLSEsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[62]
+	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[64]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3827,7 +3965,7 @@ func (x *ImportMachineLSEsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ImportMachineLSEsRequest.ProtoReflect.Descriptor instead.
 func (*ImportMachineLSEsRequest) Descriptor() ([]byte, []int) {
-	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{62}
+	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{64}
 }
 
 func (m *ImportMachineLSEsRequest) GetSource() isImportMachineLSEsRequest_Source {
@@ -3881,7 +4019,7 @@ type ImportOSMachineLSEsRequest struct {
 func (x *ImportOSMachineLSEsRequest) Reset() {
 	*x = ImportOSMachineLSEsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[63]
+		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[65]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3894,7 +4032,7 @@ func (x *ImportOSMachineLSEsRequest) String() string {
 func (*ImportOSMachineLSEsRequest) ProtoMessage() {}
 
 func (x *ImportOSMachineLSEsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[63]
+	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[65]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3907,7 +4045,7 @@ func (x *ImportOSMachineLSEsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ImportOSMachineLSEsRequest.ProtoReflect.Descriptor instead.
 func (*ImportOSMachineLSEsRequest) Descriptor() ([]byte, []int) {
-	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{63}
+	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{65}
 }
 
 func (m *ImportOSMachineLSEsRequest) GetSource() isImportOSMachineLSEsRequest_Source {
@@ -3968,7 +4106,7 @@ type CreateRackLSERequest struct {
 func (x *CreateRackLSERequest) Reset() {
 	*x = CreateRackLSERequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[64]
+		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[66]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3981,7 +4119,7 @@ func (x *CreateRackLSERequest) String() string {
 func (*CreateRackLSERequest) ProtoMessage() {}
 
 func (x *CreateRackLSERequest) ProtoReflect() protoreflect.Message {
-	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[64]
+	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[66]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3994,7 +4132,7 @@ func (x *CreateRackLSERequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateRackLSERequest.ProtoReflect.Descriptor instead.
 func (*CreateRackLSERequest) Descriptor() ([]byte, []int) {
-	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{64}
+	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{66}
 }
 
 func (x *CreateRackLSERequest) GetRackLSE() *models.RackLSE {
@@ -4025,7 +4163,7 @@ type UpdateRackLSERequest struct {
 func (x *UpdateRackLSERequest) Reset() {
 	*x = UpdateRackLSERequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[65]
+		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[67]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4038,7 +4176,7 @@ func (x *UpdateRackLSERequest) String() string {
 func (*UpdateRackLSERequest) ProtoMessage() {}
 
 func (x *UpdateRackLSERequest) ProtoReflect() protoreflect.Message {
-	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[65]
+	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[67]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4051,7 +4189,7 @@ func (x *UpdateRackLSERequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateRackLSERequest.ProtoReflect.Descriptor instead.
 func (*UpdateRackLSERequest) Descriptor() ([]byte, []int) {
-	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{65}
+	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{67}
 }
 
 func (x *UpdateRackLSERequest) GetRackLSE() *models.RackLSE {
@@ -4080,7 +4218,7 @@ type GetRackLSERequest struct {
 func (x *GetRackLSERequest) Reset() {
 	*x = GetRackLSERequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[66]
+		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[68]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4093,7 +4231,7 @@ func (x *GetRackLSERequest) String() string {
 func (*GetRackLSERequest) ProtoMessage() {}
 
 func (x *GetRackLSERequest) ProtoReflect() protoreflect.Message {
-	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[66]
+	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[68]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4106,7 +4244,7 @@ func (x *GetRackLSERequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetRackLSERequest.ProtoReflect.Descriptor instead.
 func (*GetRackLSERequest) Descriptor() ([]byte, []int) {
-	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{66}
+	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{68}
 }
 
 func (x *GetRackLSERequest) GetName() string {
@@ -4142,7 +4280,7 @@ type ListRackLSEsRequest struct {
 func (x *ListRackLSEsRequest) Reset() {
 	*x = ListRackLSEsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[67]
+		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[69]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4155,7 +4293,7 @@ func (x *ListRackLSEsRequest) String() string {
 func (*ListRackLSEsRequest) ProtoMessage() {}
 
 func (x *ListRackLSEsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[67]
+	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[69]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4168,7 +4306,7 @@ func (x *ListRackLSEsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListRackLSEsRequest.ProtoReflect.Descriptor instead.
 func (*ListRackLSEsRequest) Descriptor() ([]byte, []int) {
-	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{67}
+	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{69}
 }
 
 func (x *ListRackLSEsRequest) GetPageSize() int32 {
@@ -4214,7 +4352,7 @@ type ListRackLSEsResponse struct {
 func (x *ListRackLSEsResponse) Reset() {
 	*x = ListRackLSEsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[68]
+		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[70]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4227,7 +4365,7 @@ func (x *ListRackLSEsResponse) String() string {
 func (*ListRackLSEsResponse) ProtoMessage() {}
 
 func (x *ListRackLSEsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[68]
+	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[70]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4240,7 +4378,7 @@ func (x *ListRackLSEsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListRackLSEsResponse.ProtoReflect.Descriptor instead.
 func (*ListRackLSEsResponse) Descriptor() ([]byte, []int) {
-	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{68}
+	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{70}
 }
 
 func (x *ListRackLSEsResponse) GetRackLSEs() []*models.RackLSE {
@@ -4269,7 +4407,7 @@ type DeleteRackLSERequest struct {
 func (x *DeleteRackLSERequest) Reset() {
 	*x = DeleteRackLSERequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[69]
+		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[71]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4282,7 +4420,7 @@ func (x *DeleteRackLSERequest) String() string {
 func (*DeleteRackLSERequest) ProtoMessage() {}
 
 func (x *DeleteRackLSERequest) ProtoReflect() protoreflect.Message {
-	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[69]
+	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[71]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4295,7 +4433,7 @@ func (x *DeleteRackLSERequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteRackLSERequest.ProtoReflect.Descriptor instead.
 func (*DeleteRackLSERequest) Descriptor() ([]byte, []int) {
-	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{69}
+	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{71}
 }
 
 func (x *DeleteRackLSERequest) GetName() string {
@@ -4325,7 +4463,7 @@ type CreateNicRequest struct {
 func (x *CreateNicRequest) Reset() {
 	*x = CreateNicRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[70]
+		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[72]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4338,7 +4476,7 @@ func (x *CreateNicRequest) String() string {
 func (*CreateNicRequest) ProtoMessage() {}
 
 func (x *CreateNicRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[70]
+	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[72]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4351,7 +4489,7 @@ func (x *CreateNicRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateNicRequest.ProtoReflect.Descriptor instead.
 func (*CreateNicRequest) Descriptor() ([]byte, []int) {
-	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{70}
+	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{72}
 }
 
 func (x *CreateNicRequest) GetNic() *models.Nic {
@@ -4382,7 +4520,7 @@ type UpdateNicRequest struct {
 func (x *UpdateNicRequest) Reset() {
 	*x = UpdateNicRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[71]
+		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[73]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4395,7 +4533,7 @@ func (x *UpdateNicRequest) String() string {
 func (*UpdateNicRequest) ProtoMessage() {}
 
 func (x *UpdateNicRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[71]
+	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[73]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4408,7 +4546,7 @@ func (x *UpdateNicRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateNicRequest.ProtoReflect.Descriptor instead.
 func (*UpdateNicRequest) Descriptor() ([]byte, []int) {
-	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{71}
+	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{73}
 }
 
 func (x *UpdateNicRequest) GetNic() *models.Nic {
@@ -4437,7 +4575,7 @@ type GetNicRequest struct {
 func (x *GetNicRequest) Reset() {
 	*x = GetNicRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[72]
+		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[74]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4450,7 +4588,7 @@ func (x *GetNicRequest) String() string {
 func (*GetNicRequest) ProtoMessage() {}
 
 func (x *GetNicRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[72]
+	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[74]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4463,7 +4601,7 @@ func (x *GetNicRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetNicRequest.ProtoReflect.Descriptor instead.
 func (*GetNicRequest) Descriptor() ([]byte, []int) {
-	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{72}
+	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{74}
 }
 
 func (x *GetNicRequest) GetName() string {
@@ -4499,7 +4637,7 @@ type ListNicsRequest struct {
 func (x *ListNicsRequest) Reset() {
 	*x = ListNicsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[73]
+		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[75]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4512,7 +4650,7 @@ func (x *ListNicsRequest) String() string {
 func (*ListNicsRequest) ProtoMessage() {}
 
 func (x *ListNicsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[73]
+	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[75]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4525,7 +4663,7 @@ func (x *ListNicsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListNicsRequest.ProtoReflect.Descriptor instead.
 func (*ListNicsRequest) Descriptor() ([]byte, []int) {
-	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{73}
+	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{75}
 }
 
 func (x *ListNicsRequest) GetPageSize() int32 {
@@ -4571,7 +4709,7 @@ type ListNicsResponse struct {
 func (x *ListNicsResponse) Reset() {
 	*x = ListNicsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[74]
+		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[76]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4584,7 +4722,7 @@ func (x *ListNicsResponse) String() string {
 func (*ListNicsResponse) ProtoMessage() {}
 
 func (x *ListNicsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[74]
+	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[76]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4597,7 +4735,7 @@ func (x *ListNicsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListNicsResponse.ProtoReflect.Descriptor instead.
 func (*ListNicsResponse) Descriptor() ([]byte, []int) {
-	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{74}
+	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{76}
 }
 
 func (x *ListNicsResponse) GetNics() []*models.Nic {
@@ -4626,7 +4764,7 @@ type DeleteNicRequest struct {
 func (x *DeleteNicRequest) Reset() {
 	*x = DeleteNicRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[75]
+		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[77]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4639,7 +4777,7 @@ func (x *DeleteNicRequest) String() string {
 func (*DeleteNicRequest) ProtoMessage() {}
 
 func (x *DeleteNicRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[75]
+	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[77]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4652,7 +4790,7 @@ func (x *DeleteNicRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteNicRequest.ProtoReflect.Descriptor instead.
 func (*DeleteNicRequest) Descriptor() ([]byte, []int) {
-	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{75}
+	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{77}
 }
 
 func (x *DeleteNicRequest) GetName() string {
@@ -4676,7 +4814,7 @@ type ImportNicsRequest struct {
 func (x *ImportNicsRequest) Reset() {
 	*x = ImportNicsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[76]
+		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[78]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4689,7 +4827,7 @@ func (x *ImportNicsRequest) String() string {
 func (*ImportNicsRequest) ProtoMessage() {}
 
 func (x *ImportNicsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[76]
+	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[78]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4702,7 +4840,7 @@ func (x *ImportNicsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ImportNicsRequest.ProtoReflect.Descriptor instead.
 func (*ImportNicsRequest) Descriptor() ([]byte, []int) {
-	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{76}
+	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{78}
 }
 
 func (m *ImportNicsRequest) GetSource() isImportNicsRequest_Source {
@@ -4756,7 +4894,7 @@ type RenameNicRequest struct {
 func (x *RenameNicRequest) Reset() {
 	*x = RenameNicRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[77]
+		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[79]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4769,7 +4907,7 @@ func (x *RenameNicRequest) String() string {
 func (*RenameNicRequest) ProtoMessage() {}
 
 func (x *RenameNicRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[77]
+	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[79]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4782,7 +4920,7 @@ func (x *RenameNicRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RenameNicRequest.ProtoReflect.Descriptor instead.
 func (*RenameNicRequest) Descriptor() ([]byte, []int) {
-	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{77}
+	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{79}
 }
 
 func (x *RenameNicRequest) GetName() string {
@@ -4813,7 +4951,7 @@ type RenameSwitchRequest struct {
 func (x *RenameSwitchRequest) Reset() {
 	*x = RenameSwitchRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[78]
+		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[80]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4826,7 +4964,7 @@ func (x *RenameSwitchRequest) String() string {
 func (*RenameSwitchRequest) ProtoMessage() {}
 
 func (x *RenameSwitchRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[78]
+	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[80]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4839,7 +4977,7 @@ func (x *RenameSwitchRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RenameSwitchRequest.ProtoReflect.Descriptor instead.
 func (*RenameSwitchRequest) Descriptor() ([]byte, []int) {
-	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{78}
+	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{80}
 }
 
 func (x *RenameSwitchRequest) GetName() string {
@@ -4870,7 +5008,7 @@ type ImportDatacentersRequest struct {
 func (x *ImportDatacentersRequest) Reset() {
 	*x = ImportDatacentersRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[79]
+		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[81]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4883,7 +5021,7 @@ func (x *ImportDatacentersRequest) String() string {
 func (*ImportDatacentersRequest) ProtoMessage() {}
 
 func (x *ImportDatacentersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[79]
+	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[81]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4896,7 +5034,7 @@ func (x *ImportDatacentersRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ImportDatacentersRequest.ProtoReflect.Descriptor instead.
 func (*ImportDatacentersRequest) Descriptor() ([]byte, []int) {
-	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{79}
+	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{81}
 }
 
 func (m *ImportDatacentersRequest) GetSource() isImportDatacentersRequest_Source {
@@ -4956,7 +5094,7 @@ type CreateKVMRequest struct {
 func (x *CreateKVMRequest) Reset() {
 	*x = CreateKVMRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[80]
+		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[82]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4969,7 +5107,7 @@ func (x *CreateKVMRequest) String() string {
 func (*CreateKVMRequest) ProtoMessage() {}
 
 func (x *CreateKVMRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[80]
+	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[82]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4982,7 +5120,7 @@ func (x *CreateKVMRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateKVMRequest.ProtoReflect.Descriptor instead.
 func (*CreateKVMRequest) Descriptor() ([]byte, []int) {
-	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{80}
+	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{82}
 }
 
 func (x *CreateKVMRequest) GetKVM() *models.KVM {
@@ -5014,7 +5152,7 @@ type UpdateKVMRequest struct {
 func (x *UpdateKVMRequest) Reset() {
 	*x = UpdateKVMRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[81]
+		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[83]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5027,7 +5165,7 @@ func (x *UpdateKVMRequest) String() string {
 func (*UpdateKVMRequest) ProtoMessage() {}
 
 func (x *UpdateKVMRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[81]
+	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[83]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5040,7 +5178,7 @@ func (x *UpdateKVMRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateKVMRequest.ProtoReflect.Descriptor instead.
 func (*UpdateKVMRequest) Descriptor() ([]byte, []int) {
-	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{81}
+	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{83}
 }
 
 func (x *UpdateKVMRequest) GetKVM() *models.KVM {
@@ -5076,7 +5214,7 @@ type GetKVMRequest struct {
 func (x *GetKVMRequest) Reset() {
 	*x = GetKVMRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[82]
+		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[84]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5089,7 +5227,7 @@ func (x *GetKVMRequest) String() string {
 func (*GetKVMRequest) ProtoMessage() {}
 
 func (x *GetKVMRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[82]
+	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[84]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5102,7 +5240,7 @@ func (x *GetKVMRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetKVMRequest.ProtoReflect.Descriptor instead.
 func (*GetKVMRequest) Descriptor() ([]byte, []int) {
-	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{82}
+	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{84}
 }
 
 func (x *GetKVMRequest) GetName() string {
@@ -5138,7 +5276,7 @@ type ListKVMsRequest struct {
 func (x *ListKVMsRequest) Reset() {
 	*x = ListKVMsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[83]
+		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[85]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5151,7 +5289,7 @@ func (x *ListKVMsRequest) String() string {
 func (*ListKVMsRequest) ProtoMessage() {}
 
 func (x *ListKVMsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[83]
+	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[85]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5164,7 +5302,7 @@ func (x *ListKVMsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListKVMsRequest.ProtoReflect.Descriptor instead.
 func (*ListKVMsRequest) Descriptor() ([]byte, []int) {
-	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{83}
+	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{85}
 }
 
 func (x *ListKVMsRequest) GetPageSize() int32 {
@@ -5210,7 +5348,7 @@ type ListKVMsResponse struct {
 func (x *ListKVMsResponse) Reset() {
 	*x = ListKVMsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[84]
+		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[86]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5223,7 +5361,7 @@ func (x *ListKVMsResponse) String() string {
 func (*ListKVMsResponse) ProtoMessage() {}
 
 func (x *ListKVMsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[84]
+	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[86]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5236,7 +5374,7 @@ func (x *ListKVMsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListKVMsResponse.ProtoReflect.Descriptor instead.
 func (*ListKVMsResponse) Descriptor() ([]byte, []int) {
-	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{84}
+	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{86}
 }
 
 func (x *ListKVMsResponse) GetKVMs() []*models.KVM {
@@ -5265,7 +5403,7 @@ type DeleteKVMRequest struct {
 func (x *DeleteKVMRequest) Reset() {
 	*x = DeleteKVMRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[85]
+		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[87]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5278,7 +5416,7 @@ func (x *DeleteKVMRequest) String() string {
 func (*DeleteKVMRequest) ProtoMessage() {}
 
 func (x *DeleteKVMRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[85]
+	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[87]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5291,7 +5429,7 @@ func (x *DeleteKVMRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteKVMRequest.ProtoReflect.Descriptor instead.
 func (*DeleteKVMRequest) Descriptor() ([]byte, []int) {
-	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{85}
+	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{87}
 }
 
 func (x *DeleteKVMRequest) GetName() string {
@@ -5321,7 +5459,7 @@ type CreateRPMRequest struct {
 func (x *CreateRPMRequest) Reset() {
 	*x = CreateRPMRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[86]
+		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[88]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5334,7 +5472,7 @@ func (x *CreateRPMRequest) String() string {
 func (*CreateRPMRequest) ProtoMessage() {}
 
 func (x *CreateRPMRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[86]
+	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[88]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5347,7 +5485,7 @@ func (x *CreateRPMRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateRPMRequest.ProtoReflect.Descriptor instead.
 func (*CreateRPMRequest) Descriptor() ([]byte, []int) {
-	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{86}
+	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{88}
 }
 
 func (x *CreateRPMRequest) GetRPM() *models.RPM {
@@ -5379,7 +5517,7 @@ type UpdateRPMRequest struct {
 func (x *UpdateRPMRequest) Reset() {
 	*x = UpdateRPMRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[87]
+		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[89]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5392,7 +5530,7 @@ func (x *UpdateRPMRequest) String() string {
 func (*UpdateRPMRequest) ProtoMessage() {}
 
 func (x *UpdateRPMRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[87]
+	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[89]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5405,7 +5543,7 @@ func (x *UpdateRPMRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateRPMRequest.ProtoReflect.Descriptor instead.
 func (*UpdateRPMRequest) Descriptor() ([]byte, []int) {
-	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{87}
+	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{89}
 }
 
 func (x *UpdateRPMRequest) GetRPM() *models.RPM {
@@ -5441,7 +5579,7 @@ type GetRPMRequest struct {
 func (x *GetRPMRequest) Reset() {
 	*x = GetRPMRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[88]
+		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[90]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5454,7 +5592,7 @@ func (x *GetRPMRequest) String() string {
 func (*GetRPMRequest) ProtoMessage() {}
 
 func (x *GetRPMRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[88]
+	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[90]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5467,7 +5605,7 @@ func (x *GetRPMRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetRPMRequest.ProtoReflect.Descriptor instead.
 func (*GetRPMRequest) Descriptor() ([]byte, []int) {
-	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{88}
+	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{90}
 }
 
 func (x *GetRPMRequest) GetName() string {
@@ -5503,7 +5641,7 @@ type ListRPMsRequest struct {
 func (x *ListRPMsRequest) Reset() {
 	*x = ListRPMsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[89]
+		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[91]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5516,7 +5654,7 @@ func (x *ListRPMsRequest) String() string {
 func (*ListRPMsRequest) ProtoMessage() {}
 
 func (x *ListRPMsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[89]
+	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[91]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5529,7 +5667,7 @@ func (x *ListRPMsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListRPMsRequest.ProtoReflect.Descriptor instead.
 func (*ListRPMsRequest) Descriptor() ([]byte, []int) {
-	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{89}
+	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{91}
 }
 
 func (x *ListRPMsRequest) GetPageSize() int32 {
@@ -5575,7 +5713,7 @@ type ListRPMsResponse struct {
 func (x *ListRPMsResponse) Reset() {
 	*x = ListRPMsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[90]
+		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[92]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5588,7 +5726,7 @@ func (x *ListRPMsResponse) String() string {
 func (*ListRPMsResponse) ProtoMessage() {}
 
 func (x *ListRPMsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[90]
+	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[92]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5601,7 +5739,7 @@ func (x *ListRPMsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListRPMsResponse.ProtoReflect.Descriptor instead.
 func (*ListRPMsResponse) Descriptor() ([]byte, []int) {
-	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{90}
+	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{92}
 }
 
 func (x *ListRPMsResponse) GetRPMs() []*models.RPM {
@@ -5630,7 +5768,7 @@ type DeleteRPMRequest struct {
 func (x *DeleteRPMRequest) Reset() {
 	*x = DeleteRPMRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[91]
+		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[93]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5643,7 +5781,7 @@ func (x *DeleteRPMRequest) String() string {
 func (*DeleteRPMRequest) ProtoMessage() {}
 
 func (x *DeleteRPMRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[91]
+	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[93]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5656,7 +5794,7 @@ func (x *DeleteRPMRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteRPMRequest.ProtoReflect.Descriptor instead.
 func (*DeleteRPMRequest) Descriptor() ([]byte, []int) {
-	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{91}
+	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{93}
 }
 
 func (x *DeleteRPMRequest) GetName() string {
@@ -5687,7 +5825,7 @@ type CreateDracRequest struct {
 func (x *CreateDracRequest) Reset() {
 	*x = CreateDracRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[92]
+		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[94]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5700,7 +5838,7 @@ func (x *CreateDracRequest) String() string {
 func (*CreateDracRequest) ProtoMessage() {}
 
 func (x *CreateDracRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[92]
+	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[94]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5713,7 +5851,7 @@ func (x *CreateDracRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateDracRequest.ProtoReflect.Descriptor instead.
 func (*CreateDracRequest) Descriptor() ([]byte, []int) {
-	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{92}
+	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{94}
 }
 
 func (x *CreateDracRequest) GetDrac() *models.Drac {
@@ -5752,7 +5890,7 @@ type UpdateDracRequest struct {
 func (x *UpdateDracRequest) Reset() {
 	*x = UpdateDracRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[93]
+		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[95]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5765,7 +5903,7 @@ func (x *UpdateDracRequest) String() string {
 func (*UpdateDracRequest) ProtoMessage() {}
 
 func (x *UpdateDracRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[93]
+	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[95]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5778,7 +5916,7 @@ func (x *UpdateDracRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateDracRequest.ProtoReflect.Descriptor instead.
 func (*UpdateDracRequest) Descriptor() ([]byte, []int) {
-	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{93}
+	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{95}
 }
 
 func (x *UpdateDracRequest) GetDrac() *models.Drac {
@@ -5814,7 +5952,7 @@ type GetDracRequest struct {
 func (x *GetDracRequest) Reset() {
 	*x = GetDracRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[94]
+		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[96]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5827,7 +5965,7 @@ func (x *GetDracRequest) String() string {
 func (*GetDracRequest) ProtoMessage() {}
 
 func (x *GetDracRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[94]
+	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[96]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5840,7 +5978,7 @@ func (x *GetDracRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetDracRequest.ProtoReflect.Descriptor instead.
 func (*GetDracRequest) Descriptor() ([]byte, []int) {
-	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{94}
+	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{96}
 }
 
 func (x *GetDracRequest) GetName() string {
@@ -5876,7 +6014,7 @@ type ListDracsRequest struct {
 func (x *ListDracsRequest) Reset() {
 	*x = ListDracsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[95]
+		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[97]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5889,7 +6027,7 @@ func (x *ListDracsRequest) String() string {
 func (*ListDracsRequest) ProtoMessage() {}
 
 func (x *ListDracsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[95]
+	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[97]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5902,7 +6040,7 @@ func (x *ListDracsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListDracsRequest.ProtoReflect.Descriptor instead.
 func (*ListDracsRequest) Descriptor() ([]byte, []int) {
-	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{95}
+	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{97}
 }
 
 func (x *ListDracsRequest) GetPageSize() int32 {
@@ -5948,7 +6086,7 @@ type ListDracsResponse struct {
 func (x *ListDracsResponse) Reset() {
 	*x = ListDracsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[96]
+		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[98]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5961,7 +6099,7 @@ func (x *ListDracsResponse) String() string {
 func (*ListDracsResponse) ProtoMessage() {}
 
 func (x *ListDracsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[96]
+	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[98]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5974,7 +6112,7 @@ func (x *ListDracsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListDracsResponse.ProtoReflect.Descriptor instead.
 func (*ListDracsResponse) Descriptor() ([]byte, []int) {
-	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{96}
+	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{98}
 }
 
 func (x *ListDracsResponse) GetDracs() []*models.Drac {
@@ -6003,7 +6141,7 @@ type DeleteDracRequest struct {
 func (x *DeleteDracRequest) Reset() {
 	*x = DeleteDracRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[97]
+		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[99]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -6016,7 +6154,7 @@ func (x *DeleteDracRequest) String() string {
 func (*DeleteDracRequest) ProtoMessage() {}
 
 func (x *DeleteDracRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[97]
+	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[99]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6029,7 +6167,7 @@ func (x *DeleteDracRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteDracRequest.ProtoReflect.Descriptor instead.
 func (*DeleteDracRequest) Descriptor() ([]byte, []int) {
-	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{97}
+	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{99}
 }
 
 func (x *DeleteDracRequest) GetName() string {
@@ -6059,7 +6197,7 @@ type CreateSwitchRequest struct {
 func (x *CreateSwitchRequest) Reset() {
 	*x = CreateSwitchRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[98]
+		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[100]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -6072,7 +6210,7 @@ func (x *CreateSwitchRequest) String() string {
 func (*CreateSwitchRequest) ProtoMessage() {}
 
 func (x *CreateSwitchRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[98]
+	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[100]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6085,7 +6223,7 @@ func (x *CreateSwitchRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateSwitchRequest.ProtoReflect.Descriptor instead.
 func (*CreateSwitchRequest) Descriptor() ([]byte, []int) {
-	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{98}
+	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{100}
 }
 
 func (x *CreateSwitchRequest) GetSwitch() *models.Switch {
@@ -6116,7 +6254,7 @@ type UpdateSwitchRequest struct {
 func (x *UpdateSwitchRequest) Reset() {
 	*x = UpdateSwitchRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[99]
+		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[101]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -6129,7 +6267,7 @@ func (x *UpdateSwitchRequest) String() string {
 func (*UpdateSwitchRequest) ProtoMessage() {}
 
 func (x *UpdateSwitchRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[99]
+	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[101]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6142,7 +6280,7 @@ func (x *UpdateSwitchRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateSwitchRequest.ProtoReflect.Descriptor instead.
 func (*UpdateSwitchRequest) Descriptor() ([]byte, []int) {
-	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{99}
+	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{101}
 }
 
 func (x *UpdateSwitchRequest) GetSwitch() *models.Switch {
@@ -6171,7 +6309,7 @@ type GetSwitchRequest struct {
 func (x *GetSwitchRequest) Reset() {
 	*x = GetSwitchRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[100]
+		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[102]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -6184,7 +6322,7 @@ func (x *GetSwitchRequest) String() string {
 func (*GetSwitchRequest) ProtoMessage() {}
 
 func (x *GetSwitchRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[100]
+	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[102]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6197,7 +6335,7 @@ func (x *GetSwitchRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetSwitchRequest.ProtoReflect.Descriptor instead.
 func (*GetSwitchRequest) Descriptor() ([]byte, []int) {
-	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{100}
+	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{102}
 }
 
 func (x *GetSwitchRequest) GetName() string {
@@ -6233,7 +6371,7 @@ type ListSwitchesRequest struct {
 func (x *ListSwitchesRequest) Reset() {
 	*x = ListSwitchesRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[101]
+		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[103]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -6246,7 +6384,7 @@ func (x *ListSwitchesRequest) String() string {
 func (*ListSwitchesRequest) ProtoMessage() {}
 
 func (x *ListSwitchesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[101]
+	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[103]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6259,7 +6397,7 @@ func (x *ListSwitchesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListSwitchesRequest.ProtoReflect.Descriptor instead.
 func (*ListSwitchesRequest) Descriptor() ([]byte, []int) {
-	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{101}
+	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{103}
 }
 
 func (x *ListSwitchesRequest) GetPageSize() int32 {
@@ -6305,7 +6443,7 @@ type ListSwitchesResponse struct {
 func (x *ListSwitchesResponse) Reset() {
 	*x = ListSwitchesResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[102]
+		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[104]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -6318,7 +6456,7 @@ func (x *ListSwitchesResponse) String() string {
 func (*ListSwitchesResponse) ProtoMessage() {}
 
 func (x *ListSwitchesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[102]
+	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[104]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6331,7 +6469,7 @@ func (x *ListSwitchesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListSwitchesResponse.ProtoReflect.Descriptor instead.
 func (*ListSwitchesResponse) Descriptor() ([]byte, []int) {
-	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{102}
+	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{104}
 }
 
 func (x *ListSwitchesResponse) GetSwitches() []*models.Switch {
@@ -6360,7 +6498,7 @@ type DeleteSwitchRequest struct {
 func (x *DeleteSwitchRequest) Reset() {
 	*x = DeleteSwitchRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[103]
+		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[105]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -6373,7 +6511,7 @@ func (x *DeleteSwitchRequest) String() string {
 func (*DeleteSwitchRequest) ProtoMessage() {}
 
 func (x *DeleteSwitchRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[103]
+	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[105]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6386,7 +6524,7 @@ func (x *DeleteSwitchRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteSwitchRequest.ProtoReflect.Descriptor instead.
 func (*DeleteSwitchRequest) Descriptor() ([]byte, []int) {
-	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{103}
+	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{105}
 }
 
 func (x *DeleteSwitchRequest) GetName() string {
@@ -6416,7 +6554,7 @@ type CreateVlanRequest struct {
 func (x *CreateVlanRequest) Reset() {
 	*x = CreateVlanRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[104]
+		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[106]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -6429,7 +6567,7 @@ func (x *CreateVlanRequest) String() string {
 func (*CreateVlanRequest) ProtoMessage() {}
 
 func (x *CreateVlanRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[104]
+	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[106]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6442,7 +6580,7 @@ func (x *CreateVlanRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateVlanRequest.ProtoReflect.Descriptor instead.
 func (*CreateVlanRequest) Descriptor() ([]byte, []int) {
-	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{104}
+	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{106}
 }
 
 func (x *CreateVlanRequest) GetVlan() *models.Vlan {
@@ -6473,7 +6611,7 @@ type UpdateVlanRequest struct {
 func (x *UpdateVlanRequest) Reset() {
 	*x = UpdateVlanRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[105]
+		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[107]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -6486,7 +6624,7 @@ func (x *UpdateVlanRequest) String() string {
 func (*UpdateVlanRequest) ProtoMessage() {}
 
 func (x *UpdateVlanRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[105]
+	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[107]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6499,7 +6637,7 @@ func (x *UpdateVlanRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateVlanRequest.ProtoReflect.Descriptor instead.
 func (*UpdateVlanRequest) Descriptor() ([]byte, []int) {
-	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{105}
+	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{107}
 }
 
 func (x *UpdateVlanRequest) GetVlan() *models.Vlan {
@@ -6528,7 +6666,7 @@ type GetVlanRequest struct {
 func (x *GetVlanRequest) Reset() {
 	*x = GetVlanRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[106]
+		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[108]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -6541,7 +6679,7 @@ func (x *GetVlanRequest) String() string {
 func (*GetVlanRequest) ProtoMessage() {}
 
 func (x *GetVlanRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[106]
+	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[108]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6554,7 +6692,7 @@ func (x *GetVlanRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetVlanRequest.ProtoReflect.Descriptor instead.
 func (*GetVlanRequest) Descriptor() ([]byte, []int) {
-	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{106}
+	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{108}
 }
 
 func (x *GetVlanRequest) GetName() string {
@@ -6590,7 +6728,7 @@ type ListVlansRequest struct {
 func (x *ListVlansRequest) Reset() {
 	*x = ListVlansRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[107]
+		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[109]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -6603,7 +6741,7 @@ func (x *ListVlansRequest) String() string {
 func (*ListVlansRequest) ProtoMessage() {}
 
 func (x *ListVlansRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[107]
+	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[109]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6616,7 +6754,7 @@ func (x *ListVlansRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListVlansRequest.ProtoReflect.Descriptor instead.
 func (*ListVlansRequest) Descriptor() ([]byte, []int) {
-	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{107}
+	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{109}
 }
 
 func (x *ListVlansRequest) GetPageSize() int32 {
@@ -6662,7 +6800,7 @@ type ListVlansResponse struct {
 func (x *ListVlansResponse) Reset() {
 	*x = ListVlansResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[108]
+		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[110]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -6675,7 +6813,7 @@ func (x *ListVlansResponse) String() string {
 func (*ListVlansResponse) ProtoMessage() {}
 
 func (x *ListVlansResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[108]
+	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[110]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6688,7 +6826,7 @@ func (x *ListVlansResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListVlansResponse.ProtoReflect.Descriptor instead.
 func (*ListVlansResponse) Descriptor() ([]byte, []int) {
-	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{108}
+	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{110}
 }
 
 func (x *ListVlansResponse) GetVlans() []*models.Vlan {
@@ -6717,7 +6855,7 @@ type DeleteVlanRequest struct {
 func (x *DeleteVlanRequest) Reset() {
 	*x = DeleteVlanRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[109]
+		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[111]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -6730,7 +6868,7 @@ func (x *DeleteVlanRequest) String() string {
 func (*DeleteVlanRequest) ProtoMessage() {}
 
 func (x *DeleteVlanRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[109]
+	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[111]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6743,7 +6881,7 @@ func (x *DeleteVlanRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteVlanRequest.ProtoReflect.Descriptor instead.
 func (*DeleteVlanRequest) Descriptor() ([]byte, []int) {
-	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{109}
+	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{111}
 }
 
 func (x *DeleteVlanRequest) GetName() string {
@@ -6767,7 +6905,7 @@ type ImportVlansRequest struct {
 func (x *ImportVlansRequest) Reset() {
 	*x = ImportVlansRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[110]
+		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[112]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -6780,7 +6918,7 @@ func (x *ImportVlansRequest) String() string {
 func (*ImportVlansRequest) ProtoMessage() {}
 
 func (x *ImportVlansRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[110]
+	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[112]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6793,7 +6931,7 @@ func (x *ImportVlansRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ImportVlansRequest.ProtoReflect.Descriptor instead.
 func (*ImportVlansRequest) Descriptor() ([]byte, []int) {
-	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{110}
+	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{112}
 }
 
 func (m *ImportVlansRequest) GetSource() isImportVlansRequest_Source {
@@ -6847,7 +6985,7 @@ type ImportOSVlansRequest struct {
 func (x *ImportOSVlansRequest) Reset() {
 	*x = ImportOSVlansRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[111]
+		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[113]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -6860,7 +6998,7 @@ func (x *ImportOSVlansRequest) String() string {
 func (*ImportOSVlansRequest) ProtoMessage() {}
 
 func (x *ImportOSVlansRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[111]
+	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[113]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6873,7 +7011,7 @@ func (x *ImportOSVlansRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ImportOSVlansRequest.ProtoReflect.Descriptor instead.
 func (*ImportOSVlansRequest) Descriptor() ([]byte, []int) {
-	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{111}
+	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{113}
 }
 
 func (m *ImportOSVlansRequest) GetSource() isImportOSVlansRequest_Source {
@@ -6927,7 +7065,7 @@ type ImportStatesRequest struct {
 func (x *ImportStatesRequest) Reset() {
 	*x = ImportStatesRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[112]
+		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[114]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -6940,7 +7078,7 @@ func (x *ImportStatesRequest) String() string {
 func (*ImportStatesRequest) ProtoMessage() {}
 
 func (x *ImportStatesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[112]
+	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[114]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6953,7 +7091,7 @@ func (x *ImportStatesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ImportStatesRequest.ProtoReflect.Descriptor instead.
 func (*ImportStatesRequest) Descriptor() ([]byte, []int) {
-	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{112}
+	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{114}
 }
 
 func (m *ImportStatesRequest) GetSource() isImportStatesRequest_Source {
@@ -7005,7 +7143,7 @@ type GetStateRequest struct {
 func (x *GetStateRequest) Reset() {
 	*x = GetStateRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[113]
+		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[115]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -7018,7 +7156,7 @@ func (x *GetStateRequest) String() string {
 func (*GetStateRequest) ProtoMessage() {}
 
 func (x *GetStateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[113]
+	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[115]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7031,7 +7169,7 @@ func (x *GetStateRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetStateRequest.ProtoReflect.Descriptor instead.
 func (*GetStateRequest) Descriptor() ([]byte, []int) {
-	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{113}
+	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{115}
 }
 
 func (x *GetStateRequest) GetResourceName() string {
@@ -7055,7 +7193,7 @@ type GetDutStateRequest struct {
 func (x *GetDutStateRequest) Reset() {
 	*x = GetDutStateRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[114]
+		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[116]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -7068,7 +7206,7 @@ func (x *GetDutStateRequest) String() string {
 func (*GetDutStateRequest) ProtoMessage() {}
 
 func (x *GetDutStateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[114]
+	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[116]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7081,7 +7219,7 @@ func (x *GetDutStateRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetDutStateRequest.ProtoReflect.Descriptor instead.
 func (*GetDutStateRequest) Descriptor() ([]byte, []int) {
-	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{114}
+	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{116}
 }
 
 func (x *GetDutStateRequest) GetChromeosDeviceId() string {
@@ -7124,7 +7262,7 @@ type ListDutStatesRequest struct {
 func (x *ListDutStatesRequest) Reset() {
 	*x = ListDutStatesRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[115]
+		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[117]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -7137,7 +7275,7 @@ func (x *ListDutStatesRequest) String() string {
 func (*ListDutStatesRequest) ProtoMessage() {}
 
 func (x *ListDutStatesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[115]
+	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[117]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7150,7 +7288,7 @@ func (x *ListDutStatesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListDutStatesRequest.ProtoReflect.Descriptor instead.
 func (*ListDutStatesRequest) Descriptor() ([]byte, []int) {
-	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{115}
+	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{117}
 }
 
 func (x *ListDutStatesRequest) GetPageSize() int32 {
@@ -7196,7 +7334,7 @@ type ListDutStatesResponse struct {
 func (x *ListDutStatesResponse) Reset() {
 	*x = ListDutStatesResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[116]
+		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[118]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -7209,7 +7347,7 @@ func (x *ListDutStatesResponse) String() string {
 func (*ListDutStatesResponse) ProtoMessage() {}
 
 func (x *ListDutStatesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[116]
+	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[118]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7222,7 +7360,7 @@ func (x *ListDutStatesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListDutStatesResponse.ProtoReflect.Descriptor instead.
 func (*ListDutStatesResponse) Descriptor() ([]byte, []int) {
-	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{116}
+	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{118}
 }
 
 func (x *ListDutStatesResponse) GetDutStates() []*lab.DutState {
@@ -7253,7 +7391,7 @@ type UpdateStateRequest struct {
 func (x *UpdateStateRequest) Reset() {
 	*x = UpdateStateRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[117]
+		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[119]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -7266,7 +7404,7 @@ func (x *UpdateStateRequest) String() string {
 func (*UpdateStateRequest) ProtoMessage() {}
 
 func (x *UpdateStateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[117]
+	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[119]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7279,7 +7417,7 @@ func (x *UpdateStateRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateStateRequest.ProtoReflect.Descriptor instead.
 func (*UpdateStateRequest) Descriptor() ([]byte, []int) {
-	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{117}
+	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{119}
 }
 
 func (x *UpdateStateRequest) GetState() *models.StateRecord {
@@ -7313,7 +7451,7 @@ type UpdateDutStateRequest struct {
 func (x *UpdateDutStateRequest) Reset() {
 	*x = UpdateDutStateRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[118]
+		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[120]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -7326,7 +7464,7 @@ func (x *UpdateDutStateRequest) String() string {
 func (*UpdateDutStateRequest) ProtoMessage() {}
 
 func (x *UpdateDutStateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[118]
+	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[120]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7339,7 +7477,7 @@ func (x *UpdateDutStateRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateDutStateRequest.ProtoReflect.Descriptor instead.
 func (*UpdateDutStateRequest) Descriptor() ([]byte, []int) {
-	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{118}
+	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{120}
 }
 
 func (x *UpdateDutStateRequest) GetDutState() *lab.DutState {
@@ -7382,7 +7520,7 @@ type RackRegistrationRequest struct {
 func (x *RackRegistrationRequest) Reset() {
 	*x = RackRegistrationRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[119]
+		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[121]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -7395,7 +7533,7 @@ func (x *RackRegistrationRequest) String() string {
 func (*RackRegistrationRequest) ProtoMessage() {}
 
 func (x *RackRegistrationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[119]
+	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[121]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7408,7 +7546,7 @@ func (x *RackRegistrationRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RackRegistrationRequest.ProtoReflect.Descriptor instead.
 func (*RackRegistrationRequest) Descriptor() ([]byte, []int) {
-	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{119}
+	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{121}
 }
 
 func (x *RackRegistrationRequest) GetRack() *models.Rack {
@@ -7436,7 +7574,7 @@ type NetworkOption struct {
 func (x *NetworkOption) Reset() {
 	*x = NetworkOption{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[120]
+		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[122]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -7449,7 +7587,7 @@ func (x *NetworkOption) String() string {
 func (*NetworkOption) ProtoMessage() {}
 
 func (x *NetworkOption) ProtoReflect() protoreflect.Message {
-	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[120]
+	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[122]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7462,7 +7600,7 @@ func (x *NetworkOption) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NetworkOption.ProtoReflect.Descriptor instead.
 func (*NetworkOption) Descriptor() ([]byte, []int) {
-	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{120}
+	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{122}
 }
 
 func (x *NetworkOption) GetVlan() string {
@@ -7505,7 +7643,7 @@ type CreateAssetRequest struct {
 func (x *CreateAssetRequest) Reset() {
 	*x = CreateAssetRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[121]
+		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[123]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -7518,7 +7656,7 @@ func (x *CreateAssetRequest) String() string {
 func (*CreateAssetRequest) ProtoMessage() {}
 
 func (x *CreateAssetRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[121]
+	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[123]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7531,7 +7669,7 @@ func (x *CreateAssetRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateAssetRequest.ProtoReflect.Descriptor instead.
 func (*CreateAssetRequest) Descriptor() ([]byte, []int) {
-	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{121}
+	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{123}
 }
 
 func (x *CreateAssetRequest) GetAsset() *models.Asset {
@@ -7555,7 +7693,7 @@ type UpdateAssetRequest struct {
 func (x *UpdateAssetRequest) Reset() {
 	*x = UpdateAssetRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[122]
+		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[124]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -7568,7 +7706,7 @@ func (x *UpdateAssetRequest) String() string {
 func (*UpdateAssetRequest) ProtoMessage() {}
 
 func (x *UpdateAssetRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[122]
+	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[124]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7581,7 +7719,7 @@ func (x *UpdateAssetRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateAssetRequest.ProtoReflect.Descriptor instead.
 func (*UpdateAssetRequest) Descriptor() ([]byte, []int) {
-	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{122}
+	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{124}
 }
 
 func (x *UpdateAssetRequest) GetAsset() *models.Asset {
@@ -7610,7 +7748,7 @@ type GetAssetRequest struct {
 func (x *GetAssetRequest) Reset() {
 	*x = GetAssetRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[123]
+		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[125]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -7623,7 +7761,7 @@ func (x *GetAssetRequest) String() string {
 func (*GetAssetRequest) ProtoMessage() {}
 
 func (x *GetAssetRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[123]
+	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[125]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7636,7 +7774,7 @@ func (x *GetAssetRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAssetRequest.ProtoReflect.Descriptor instead.
 func (*GetAssetRequest) Descriptor() ([]byte, []int) {
-	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{123}
+	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{125}
 }
 
 func (x *GetAssetRequest) GetName() string {
@@ -7672,7 +7810,7 @@ type ListAssetsRequest struct {
 func (x *ListAssetsRequest) Reset() {
 	*x = ListAssetsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[124]
+		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[126]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -7685,7 +7823,7 @@ func (x *ListAssetsRequest) String() string {
 func (*ListAssetsRequest) ProtoMessage() {}
 
 func (x *ListAssetsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[124]
+	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[126]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7698,7 +7836,7 @@ func (x *ListAssetsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListAssetsRequest.ProtoReflect.Descriptor instead.
 func (*ListAssetsRequest) Descriptor() ([]byte, []int) {
-	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{124}
+	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{126}
 }
 
 func (x *ListAssetsRequest) GetPageSize() int32 {
@@ -7744,7 +7882,7 @@ type ListAssetsResponse struct {
 func (x *ListAssetsResponse) Reset() {
 	*x = ListAssetsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[125]
+		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[127]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -7757,7 +7895,7 @@ func (x *ListAssetsResponse) String() string {
 func (*ListAssetsResponse) ProtoMessage() {}
 
 func (x *ListAssetsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[125]
+	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[127]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7770,7 +7908,7 @@ func (x *ListAssetsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListAssetsResponse.ProtoReflect.Descriptor instead.
 func (*ListAssetsResponse) Descriptor() ([]byte, []int) {
-	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{125}
+	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{127}
 }
 
 func (x *ListAssetsResponse) GetAssets() []*models.Asset {
@@ -7799,7 +7937,7 @@ type DeleteAssetRequest struct {
 func (x *DeleteAssetRequest) Reset() {
 	*x = DeleteAssetRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[126]
+		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[128]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -7812,7 +7950,7 @@ func (x *DeleteAssetRequest) String() string {
 func (*DeleteAssetRequest) ProtoMessage() {}
 
 func (x *DeleteAssetRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[126]
+	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[128]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7825,7 +7963,7 @@ func (x *DeleteAssetRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteAssetRequest.ProtoReflect.Descriptor instead.
 func (*DeleteAssetRequest) Descriptor() ([]byte, []int) {
-	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{126}
+	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{128}
 }
 
 func (x *DeleteAssetRequest) GetName() string {
@@ -7849,7 +7987,7 @@ type RenameAssetRequest struct {
 func (x *RenameAssetRequest) Reset() {
 	*x = RenameAssetRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[127]
+		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[129]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -7862,7 +8000,7 @@ func (x *RenameAssetRequest) String() string {
 func (*RenameAssetRequest) ProtoMessage() {}
 
 func (x *RenameAssetRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[127]
+	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[129]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7875,7 +8013,7 @@ func (x *RenameAssetRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RenameAssetRequest.ProtoReflect.Descriptor instead.
 func (*RenameAssetRequest) Descriptor() ([]byte, []int) {
-	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{127}
+	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{129}
 }
 
 func (x *RenameAssetRequest) GetName() string {
@@ -7907,7 +8045,7 @@ type BatchGetKVMsRequest struct {
 func (x *BatchGetKVMsRequest) Reset() {
 	*x = BatchGetKVMsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[128]
+		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[130]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -7920,7 +8058,7 @@ func (x *BatchGetKVMsRequest) String() string {
 func (*BatchGetKVMsRequest) ProtoMessage() {}
 
 func (x *BatchGetKVMsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[128]
+	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[130]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7933,7 +8071,7 @@ func (x *BatchGetKVMsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BatchGetKVMsRequest.ProtoReflect.Descriptor instead.
 func (*BatchGetKVMsRequest) Descriptor() ([]byte, []int) {
-	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{128}
+	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{130}
 }
 
 func (x *BatchGetKVMsRequest) GetParent() string {
@@ -7962,7 +8100,7 @@ type BatchGetKVMsResponse struct {
 func (x *BatchGetKVMsResponse) Reset() {
 	*x = BatchGetKVMsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[129]
+		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[131]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -7975,7 +8113,7 @@ func (x *BatchGetKVMsResponse) String() string {
 func (*BatchGetKVMsResponse) ProtoMessage() {}
 
 func (x *BatchGetKVMsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[129]
+	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[131]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7988,7 +8126,7 @@ func (x *BatchGetKVMsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BatchGetKVMsResponse.ProtoReflect.Descriptor instead.
 func (*BatchGetKVMsResponse) Descriptor() ([]byte, []int) {
-	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{129}
+	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{131}
 }
 
 func (x *BatchGetKVMsResponse) GetKVMs() []*models.KVM {
@@ -8012,7 +8150,7 @@ type BatchGetDHCPConfigsRequest struct {
 func (x *BatchGetDHCPConfigsRequest) Reset() {
 	*x = BatchGetDHCPConfigsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[130]
+		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[132]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -8025,7 +8163,7 @@ func (x *BatchGetDHCPConfigsRequest) String() string {
 func (*BatchGetDHCPConfigsRequest) ProtoMessage() {}
 
 func (x *BatchGetDHCPConfigsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[130]
+	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[132]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8038,7 +8176,7 @@ func (x *BatchGetDHCPConfigsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BatchGetDHCPConfigsRequest.ProtoReflect.Descriptor instead.
 func (*BatchGetDHCPConfigsRequest) Descriptor() ([]byte, []int) {
-	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{130}
+	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{132}
 }
 
 func (x *BatchGetDHCPConfigsRequest) GetParent() string {
@@ -8067,7 +8205,7 @@ type BatchGetDHCPConfigsResponse struct {
 func (x *BatchGetDHCPConfigsResponse) Reset() {
 	*x = BatchGetDHCPConfigsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[131]
+		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[133]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -8080,7 +8218,7 @@ func (x *BatchGetDHCPConfigsResponse) String() string {
 func (*BatchGetDHCPConfigsResponse) ProtoMessage() {}
 
 func (x *BatchGetDHCPConfigsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[131]
+	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[133]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8093,7 +8231,7 @@ func (x *BatchGetDHCPConfigsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BatchGetDHCPConfigsResponse.ProtoReflect.Descriptor instead.
 func (*BatchGetDHCPConfigsResponse) Descriptor() ([]byte, []int) {
-	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{131}
+	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{133}
 }
 
 func (x *BatchGetDHCPConfigsResponse) GetDhcpConfigs() []*models.DHCPConfig {
@@ -8118,7 +8256,7 @@ type BatchGetMachineLSEsRequest struct {
 func (x *BatchGetMachineLSEsRequest) Reset() {
 	*x = BatchGetMachineLSEsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[132]
+		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[134]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -8131,7 +8269,7 @@ func (x *BatchGetMachineLSEsRequest) String() string {
 func (*BatchGetMachineLSEsRequest) ProtoMessage() {}
 
 func (x *BatchGetMachineLSEsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[132]
+	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[134]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8144,7 +8282,7 @@ func (x *BatchGetMachineLSEsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BatchGetMachineLSEsRequest.ProtoReflect.Descriptor instead.
 func (*BatchGetMachineLSEsRequest) Descriptor() ([]byte, []int) {
-	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{132}
+	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{134}
 }
 
 func (x *BatchGetMachineLSEsRequest) GetParent() string {
@@ -8173,7 +8311,7 @@ type BatchGetMachineLSEsResponse struct {
 func (x *BatchGetMachineLSEsResponse) Reset() {
 	*x = BatchGetMachineLSEsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[133]
+		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[135]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -8186,7 +8324,7 @@ func (x *BatchGetMachineLSEsResponse) String() string {
 func (*BatchGetMachineLSEsResponse) ProtoMessage() {}
 
 func (x *BatchGetMachineLSEsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[133]
+	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[135]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8199,7 +8337,7 @@ func (x *BatchGetMachineLSEsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BatchGetMachineLSEsResponse.ProtoReflect.Descriptor instead.
 func (*BatchGetMachineLSEsResponse) Descriptor() ([]byte, []int) {
-	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{133}
+	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{135}
 }
 
 func (x *BatchGetMachineLSEsResponse) GetMachineLses() []*models.MachineLSE {
@@ -8224,7 +8362,7 @@ type BatchGetMachinesRequest struct {
 func (x *BatchGetMachinesRequest) Reset() {
 	*x = BatchGetMachinesRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[134]
+		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[136]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -8237,7 +8375,7 @@ func (x *BatchGetMachinesRequest) String() string {
 func (*BatchGetMachinesRequest) ProtoMessage() {}
 
 func (x *BatchGetMachinesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[134]
+	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[136]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8250,7 +8388,7 @@ func (x *BatchGetMachinesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BatchGetMachinesRequest.ProtoReflect.Descriptor instead.
 func (*BatchGetMachinesRequest) Descriptor() ([]byte, []int) {
-	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{134}
+	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{136}
 }
 
 func (x *BatchGetMachinesRequest) GetParent() string {
@@ -8279,7 +8417,7 @@ type BatchGetMachinesResponse struct {
 func (x *BatchGetMachinesResponse) Reset() {
 	*x = BatchGetMachinesResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[135]
+		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[137]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -8292,7 +8430,7 @@ func (x *BatchGetMachinesResponse) String() string {
 func (*BatchGetMachinesResponse) ProtoMessage() {}
 
 func (x *BatchGetMachinesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[135]
+	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[137]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8305,7 +8443,7 @@ func (x *BatchGetMachinesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BatchGetMachinesResponse.ProtoReflect.Descriptor instead.
 func (*BatchGetMachinesResponse) Descriptor() ([]byte, []int) {
-	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{135}
+	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{137}
 }
 
 func (x *BatchGetMachinesResponse) GetMachines() []*models.Machine {
@@ -8330,7 +8468,7 @@ type BatchGetSwitchesRequest struct {
 func (x *BatchGetSwitchesRequest) Reset() {
 	*x = BatchGetSwitchesRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[136]
+		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[138]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -8343,7 +8481,7 @@ func (x *BatchGetSwitchesRequest) String() string {
 func (*BatchGetSwitchesRequest) ProtoMessage() {}
 
 func (x *BatchGetSwitchesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[136]
+	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[138]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8356,7 +8494,7 @@ func (x *BatchGetSwitchesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BatchGetSwitchesRequest.ProtoReflect.Descriptor instead.
 func (*BatchGetSwitchesRequest) Descriptor() ([]byte, []int) {
-	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{136}
+	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{138}
 }
 
 func (x *BatchGetSwitchesRequest) GetParent() string {
@@ -8385,7 +8523,7 @@ type BatchGetSwitchesResponse struct {
 func (x *BatchGetSwitchesResponse) Reset() {
 	*x = BatchGetSwitchesResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[137]
+		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[139]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -8398,7 +8536,7 @@ func (x *BatchGetSwitchesResponse) String() string {
 func (*BatchGetSwitchesResponse) ProtoMessage() {}
 
 func (x *BatchGetSwitchesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[137]
+	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[139]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8411,7 +8549,7 @@ func (x *BatchGetSwitchesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BatchGetSwitchesResponse.ProtoReflect.Descriptor instead.
 func (*BatchGetSwitchesResponse) Descriptor() ([]byte, []int) {
-	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{137}
+	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{139}
 }
 
 func (x *BatchGetSwitchesResponse) GetSwitches() []*models.Switch {
@@ -8436,7 +8574,7 @@ type BatchGetRPMsRequest struct {
 func (x *BatchGetRPMsRequest) Reset() {
 	*x = BatchGetRPMsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[138]
+		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[140]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -8449,7 +8587,7 @@ func (x *BatchGetRPMsRequest) String() string {
 func (*BatchGetRPMsRequest) ProtoMessage() {}
 
 func (x *BatchGetRPMsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[138]
+	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[140]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8462,7 +8600,7 @@ func (x *BatchGetRPMsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BatchGetRPMsRequest.ProtoReflect.Descriptor instead.
 func (*BatchGetRPMsRequest) Descriptor() ([]byte, []int) {
-	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{138}
+	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{140}
 }
 
 func (x *BatchGetRPMsRequest) GetParent() string {
@@ -8491,7 +8629,7 @@ type BatchGetRPMsResponse struct {
 func (x *BatchGetRPMsResponse) Reset() {
 	*x = BatchGetRPMsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[139]
+		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[141]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -8504,7 +8642,7 @@ func (x *BatchGetRPMsResponse) String() string {
 func (*BatchGetRPMsResponse) ProtoMessage() {}
 
 func (x *BatchGetRPMsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[139]
+	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[141]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8517,7 +8655,7 @@ func (x *BatchGetRPMsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BatchGetRPMsResponse.ProtoReflect.Descriptor instead.
 func (*BatchGetRPMsResponse) Descriptor() ([]byte, []int) {
-	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{139}
+	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{141}
 }
 
 func (x *BatchGetRPMsResponse) GetRpms() []*models.RPM {
@@ -8542,7 +8680,7 @@ type BatchGetDracsRequest struct {
 func (x *BatchGetDracsRequest) Reset() {
 	*x = BatchGetDracsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[140]
+		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[142]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -8555,7 +8693,7 @@ func (x *BatchGetDracsRequest) String() string {
 func (*BatchGetDracsRequest) ProtoMessage() {}
 
 func (x *BatchGetDracsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[140]
+	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[142]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8568,7 +8706,7 @@ func (x *BatchGetDracsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BatchGetDracsRequest.ProtoReflect.Descriptor instead.
 func (*BatchGetDracsRequest) Descriptor() ([]byte, []int) {
-	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{140}
+	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{142}
 }
 
 func (x *BatchGetDracsRequest) GetParent() string {
@@ -8597,7 +8735,7 @@ type BatchGetDracsResponse struct {
 func (x *BatchGetDracsResponse) Reset() {
 	*x = BatchGetDracsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[141]
+		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[143]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -8610,7 +8748,7 @@ func (x *BatchGetDracsResponse) String() string {
 func (*BatchGetDracsResponse) ProtoMessage() {}
 
 func (x *BatchGetDracsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[141]
+	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[143]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8623,7 +8761,7 @@ func (x *BatchGetDracsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BatchGetDracsResponse.ProtoReflect.Descriptor instead.
 func (*BatchGetDracsResponse) Descriptor() ([]byte, []int) {
-	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{141}
+	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{143}
 }
 
 func (x *BatchGetDracsResponse) GetDracs() []*models.Drac {
@@ -8648,7 +8786,7 @@ type BatchGetNicsRequest struct {
 func (x *BatchGetNicsRequest) Reset() {
 	*x = BatchGetNicsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[142]
+		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[144]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -8661,7 +8799,7 @@ func (x *BatchGetNicsRequest) String() string {
 func (*BatchGetNicsRequest) ProtoMessage() {}
 
 func (x *BatchGetNicsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[142]
+	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[144]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8674,7 +8812,7 @@ func (x *BatchGetNicsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BatchGetNicsRequest.ProtoReflect.Descriptor instead.
 func (*BatchGetNicsRequest) Descriptor() ([]byte, []int) {
-	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{142}
+	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{144}
 }
 
 func (x *BatchGetNicsRequest) GetParent() string {
@@ -8703,7 +8841,7 @@ type BatchGetNicsResponse struct {
 func (x *BatchGetNicsResponse) Reset() {
 	*x = BatchGetNicsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[143]
+		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[145]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -8716,7 +8854,7 @@ func (x *BatchGetNicsResponse) String() string {
 func (*BatchGetNicsResponse) ProtoMessage() {}
 
 func (x *BatchGetNicsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[143]
+	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[145]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8729,7 +8867,7 @@ func (x *BatchGetNicsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BatchGetNicsResponse.ProtoReflect.Descriptor instead.
 func (*BatchGetNicsResponse) Descriptor() ([]byte, []int) {
-	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{143}
+	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{145}
 }
 
 func (x *BatchGetNicsResponse) GetNics() []*models.Nic {
@@ -8754,7 +8892,7 @@ type BatchGetVMsRequest struct {
 func (x *BatchGetVMsRequest) Reset() {
 	*x = BatchGetVMsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[144]
+		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[146]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -8767,7 +8905,7 @@ func (x *BatchGetVMsRequest) String() string {
 func (*BatchGetVMsRequest) ProtoMessage() {}
 
 func (x *BatchGetVMsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[144]
+	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[146]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8780,7 +8918,7 @@ func (x *BatchGetVMsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BatchGetVMsRequest.ProtoReflect.Descriptor instead.
 func (*BatchGetVMsRequest) Descriptor() ([]byte, []int) {
-	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{144}
+	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{146}
 }
 
 func (x *BatchGetVMsRequest) GetParent() string {
@@ -8809,7 +8947,7 @@ type BatchGetVMsResponse struct {
 func (x *BatchGetVMsResponse) Reset() {
 	*x = BatchGetVMsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[145]
+		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[147]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -8822,7 +8960,7 @@ func (x *BatchGetVMsResponse) String() string {
 func (*BatchGetVMsResponse) ProtoMessage() {}
 
 func (x *BatchGetVMsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[145]
+	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[147]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8835,7 +8973,7 @@ func (x *BatchGetVMsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BatchGetVMsResponse.ProtoReflect.Descriptor instead.
 func (*BatchGetVMsResponse) Descriptor() ([]byte, []int) {
-	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{145}
+	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{147}
 }
 
 func (x *BatchGetVMsResponse) GetVms() []*models.VM {
@@ -8860,7 +8998,7 @@ type BatchGetVlansRequest struct {
 func (x *BatchGetVlansRequest) Reset() {
 	*x = BatchGetVlansRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[146]
+		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[148]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -8873,7 +9011,7 @@ func (x *BatchGetVlansRequest) String() string {
 func (*BatchGetVlansRequest) ProtoMessage() {}
 
 func (x *BatchGetVlansRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[146]
+	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[148]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8886,7 +9024,7 @@ func (x *BatchGetVlansRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BatchGetVlansRequest.ProtoReflect.Descriptor instead.
 func (*BatchGetVlansRequest) Descriptor() ([]byte, []int) {
-	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{146}
+	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{148}
 }
 
 func (x *BatchGetVlansRequest) GetParent() string {
@@ -8915,7 +9053,7 @@ type BatchGetVlansResponse struct {
 func (x *BatchGetVlansResponse) Reset() {
 	*x = BatchGetVlansResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[147]
+		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[149]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -8928,7 +9066,7 @@ func (x *BatchGetVlansResponse) String() string {
 func (*BatchGetVlansResponse) ProtoMessage() {}
 
 func (x *BatchGetVlansResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[147]
+	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[149]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8941,7 +9079,7 @@ func (x *BatchGetVlansResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BatchGetVlansResponse.ProtoReflect.Descriptor instead.
 func (*BatchGetVlansResponse) Descriptor() ([]byte, []int) {
-	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{147}
+	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{149}
 }
 
 func (x *BatchGetVlansResponse) GetVlans() []*models.Vlan {
@@ -8966,7 +9104,7 @@ type BatchGetRacksRequest struct {
 func (x *BatchGetRacksRequest) Reset() {
 	*x = BatchGetRacksRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[148]
+		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[150]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -8979,7 +9117,7 @@ func (x *BatchGetRacksRequest) String() string {
 func (*BatchGetRacksRequest) ProtoMessage() {}
 
 func (x *BatchGetRacksRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[148]
+	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[150]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8992,7 +9130,7 @@ func (x *BatchGetRacksRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BatchGetRacksRequest.ProtoReflect.Descriptor instead.
 func (*BatchGetRacksRequest) Descriptor() ([]byte, []int) {
-	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{148}
+	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{150}
 }
 
 func (x *BatchGetRacksRequest) GetParent() string {
@@ -9021,7 +9159,7 @@ type BatchGetRacksResponse struct {
 func (x *BatchGetRacksResponse) Reset() {
 	*x = BatchGetRacksResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[149]
+		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[151]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -9034,7 +9172,7 @@ func (x *BatchGetRacksResponse) String() string {
 func (*BatchGetRacksResponse) ProtoMessage() {}
 
 func (x *BatchGetRacksResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[149]
+	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[151]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9047,7 +9185,7 @@ func (x *BatchGetRacksResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BatchGetRacksResponse.ProtoReflect.Descriptor instead.
 func (*BatchGetRacksResponse) Descriptor() ([]byte, []int) {
-	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{149}
+	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{151}
 }
 
 func (x *BatchGetRacksResponse) GetRacks() []*models.Rack {
@@ -9072,7 +9210,7 @@ type BatchGetChromePlatformsRequest struct {
 func (x *BatchGetChromePlatformsRequest) Reset() {
 	*x = BatchGetChromePlatformsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[150]
+		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[152]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -9085,7 +9223,7 @@ func (x *BatchGetChromePlatformsRequest) String() string {
 func (*BatchGetChromePlatformsRequest) ProtoMessage() {}
 
 func (x *BatchGetChromePlatformsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[150]
+	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[152]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9098,7 +9236,7 @@ func (x *BatchGetChromePlatformsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BatchGetChromePlatformsRequest.ProtoReflect.Descriptor instead.
 func (*BatchGetChromePlatformsRequest) Descriptor() ([]byte, []int) {
-	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{150}
+	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{152}
 }
 
 func (x *BatchGetChromePlatformsRequest) GetParent() string {
@@ -9127,7 +9265,7 @@ type BatchGetChromePlatformsResponse struct {
 func (x *BatchGetChromePlatformsResponse) Reset() {
 	*x = BatchGetChromePlatformsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[151]
+		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[153]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -9140,7 +9278,7 @@ func (x *BatchGetChromePlatformsResponse) String() string {
 func (*BatchGetChromePlatformsResponse) ProtoMessage() {}
 
 func (x *BatchGetChromePlatformsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[151]
+	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[153]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9153,7 +9291,7 @@ func (x *BatchGetChromePlatformsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BatchGetChromePlatformsResponse.ProtoReflect.Descriptor instead.
 func (*BatchGetChromePlatformsResponse) Descriptor() ([]byte, []int) {
-	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{151}
+	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{153}
 }
 
 func (x *BatchGetChromePlatformsResponse) GetChromePlatforms() []*models.ChromePlatform {
@@ -9178,7 +9316,7 @@ type BatchGetMachineLSEPrototypesRequest struct {
 func (x *BatchGetMachineLSEPrototypesRequest) Reset() {
 	*x = BatchGetMachineLSEPrototypesRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[152]
+		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[154]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -9191,7 +9329,7 @@ func (x *BatchGetMachineLSEPrototypesRequest) String() string {
 func (*BatchGetMachineLSEPrototypesRequest) ProtoMessage() {}
 
 func (x *BatchGetMachineLSEPrototypesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[152]
+	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[154]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9204,7 +9342,7 @@ func (x *BatchGetMachineLSEPrototypesRequest) ProtoReflect() protoreflect.Messag
 
 // Deprecated: Use BatchGetMachineLSEPrototypesRequest.ProtoReflect.Descriptor instead.
 func (*BatchGetMachineLSEPrototypesRequest) Descriptor() ([]byte, []int) {
-	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{152}
+	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{154}
 }
 
 func (x *BatchGetMachineLSEPrototypesRequest) GetParent() string {
@@ -9233,7 +9371,7 @@ type BatchGetMachineLSEPrototypesResponse struct {
 func (x *BatchGetMachineLSEPrototypesResponse) Reset() {
 	*x = BatchGetMachineLSEPrototypesResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[153]
+		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[155]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -9246,7 +9384,7 @@ func (x *BatchGetMachineLSEPrototypesResponse) String() string {
 func (*BatchGetMachineLSEPrototypesResponse) ProtoMessage() {}
 
 func (x *BatchGetMachineLSEPrototypesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[153]
+	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[155]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9259,7 +9397,7 @@ func (x *BatchGetMachineLSEPrototypesResponse) ProtoReflect() protoreflect.Messa
 
 // Deprecated: Use BatchGetMachineLSEPrototypesResponse.ProtoReflect.Descriptor instead.
 func (*BatchGetMachineLSEPrototypesResponse) Descriptor() ([]byte, []int) {
-	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{153}
+	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{155}
 }
 
 func (x *BatchGetMachineLSEPrototypesResponse) GetMachineLsePrototypes() []*models.MachineLSEPrototype {
@@ -9284,7 +9422,7 @@ type BatchGetRackLSEPrototypesRequest struct {
 func (x *BatchGetRackLSEPrototypesRequest) Reset() {
 	*x = BatchGetRackLSEPrototypesRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[154]
+		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[156]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -9297,7 +9435,7 @@ func (x *BatchGetRackLSEPrototypesRequest) String() string {
 func (*BatchGetRackLSEPrototypesRequest) ProtoMessage() {}
 
 func (x *BatchGetRackLSEPrototypesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[154]
+	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[156]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9310,7 +9448,7 @@ func (x *BatchGetRackLSEPrototypesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BatchGetRackLSEPrototypesRequest.ProtoReflect.Descriptor instead.
 func (*BatchGetRackLSEPrototypesRequest) Descriptor() ([]byte, []int) {
-	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{154}
+	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{156}
 }
 
 func (x *BatchGetRackLSEPrototypesRequest) GetParent() string {
@@ -9339,7 +9477,7 @@ type BatchGetRackLSEPrototypesResponse struct {
 func (x *BatchGetRackLSEPrototypesResponse) Reset() {
 	*x = BatchGetRackLSEPrototypesResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[155]
+		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[157]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -9352,7 +9490,7 @@ func (x *BatchGetRackLSEPrototypesResponse) String() string {
 func (*BatchGetRackLSEPrototypesResponse) ProtoMessage() {}
 
 func (x *BatchGetRackLSEPrototypesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[155]
+	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[157]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9365,7 +9503,7 @@ func (x *BatchGetRackLSEPrototypesResponse) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use BatchGetRackLSEPrototypesResponse.ProtoReflect.Descriptor instead.
 func (*BatchGetRackLSEPrototypesResponse) Descriptor() ([]byte, []int) {
-	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{155}
+	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{157}
 }
 
 func (x *BatchGetRackLSEPrototypesResponse) GetRackLsePrototypes() []*models.RackLSEPrototype {
@@ -9389,7 +9527,7 @@ type GetChromeOSDeviceDataRequest struct {
 func (x *GetChromeOSDeviceDataRequest) Reset() {
 	*x = GetChromeOSDeviceDataRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[156]
+		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[158]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -9402,7 +9540,7 @@ func (x *GetChromeOSDeviceDataRequest) String() string {
 func (*GetChromeOSDeviceDataRequest) ProtoMessage() {}
 
 func (x *GetChromeOSDeviceDataRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[156]
+	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[158]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9415,7 +9553,7 @@ func (x *GetChromeOSDeviceDataRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetChromeOSDeviceDataRequest.ProtoReflect.Descriptor instead.
 func (*GetChromeOSDeviceDataRequest) Descriptor() ([]byte, []int) {
-	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{156}
+	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{158}
 }
 
 func (x *GetChromeOSDeviceDataRequest) GetChromeosDeviceId() string {
@@ -9451,7 +9589,7 @@ type CreateCachingServiceRequest struct {
 func (x *CreateCachingServiceRequest) Reset() {
 	*x = CreateCachingServiceRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[157]
+		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[159]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -9464,7 +9602,7 @@ func (x *CreateCachingServiceRequest) String() string {
 func (*CreateCachingServiceRequest) ProtoMessage() {}
 
 func (x *CreateCachingServiceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[157]
+	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[159]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9477,7 +9615,7 @@ func (x *CreateCachingServiceRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateCachingServiceRequest.ProtoReflect.Descriptor instead.
 func (*CreateCachingServiceRequest) Descriptor() ([]byte, []int) {
-	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{157}
+	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{159}
 }
 
 func (x *CreateCachingServiceRequest) GetCachingService() *models.CachingService {
@@ -9508,7 +9646,7 @@ type UpdateCachingServiceRequest struct {
 func (x *UpdateCachingServiceRequest) Reset() {
 	*x = UpdateCachingServiceRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[158]
+		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[160]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -9521,7 +9659,7 @@ func (x *UpdateCachingServiceRequest) String() string {
 func (*UpdateCachingServiceRequest) ProtoMessage() {}
 
 func (x *UpdateCachingServiceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[158]
+	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[160]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9534,7 +9672,7 @@ func (x *UpdateCachingServiceRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateCachingServiceRequest.ProtoReflect.Descriptor instead.
 func (*UpdateCachingServiceRequest) Descriptor() ([]byte, []int) {
-	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{158}
+	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{160}
 }
 
 func (x *UpdateCachingServiceRequest) GetCachingService() *models.CachingService {
@@ -9564,7 +9702,7 @@ type GetCachingServiceRequest struct {
 func (x *GetCachingServiceRequest) Reset() {
 	*x = GetCachingServiceRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[159]
+		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[161]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -9577,7 +9715,7 @@ func (x *GetCachingServiceRequest) String() string {
 func (*GetCachingServiceRequest) ProtoMessage() {}
 
 func (x *GetCachingServiceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[159]
+	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[161]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9590,7 +9728,7 @@ func (x *GetCachingServiceRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetCachingServiceRequest.ProtoReflect.Descriptor instead.
 func (*GetCachingServiceRequest) Descriptor() ([]byte, []int) {
-	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{159}
+	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{161}
 }
 
 func (x *GetCachingServiceRequest) GetName() string {
@@ -9626,7 +9764,7 @@ type ListCachingServicesRequest struct {
 func (x *ListCachingServicesRequest) Reset() {
 	*x = ListCachingServicesRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[160]
+		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[162]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -9639,7 +9777,7 @@ func (x *ListCachingServicesRequest) String() string {
 func (*ListCachingServicesRequest) ProtoMessage() {}
 
 func (x *ListCachingServicesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[160]
+	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[162]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9652,7 +9790,7 @@ func (x *ListCachingServicesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListCachingServicesRequest.ProtoReflect.Descriptor instead.
 func (*ListCachingServicesRequest) Descriptor() ([]byte, []int) {
-	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{160}
+	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{162}
 }
 
 func (x *ListCachingServicesRequest) GetPageSize() int32 {
@@ -9698,7 +9836,7 @@ type ListCachingServicesResponse struct {
 func (x *ListCachingServicesResponse) Reset() {
 	*x = ListCachingServicesResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[161]
+		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[163]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -9711,7 +9849,7 @@ func (x *ListCachingServicesResponse) String() string {
 func (*ListCachingServicesResponse) ProtoMessage() {}
 
 func (x *ListCachingServicesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[161]
+	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[163]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9724,7 +9862,7 @@ func (x *ListCachingServicesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListCachingServicesResponse.ProtoReflect.Descriptor instead.
 func (*ListCachingServicesResponse) Descriptor() ([]byte, []int) {
-	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{161}
+	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{163}
 }
 
 func (x *ListCachingServicesResponse) GetCachingServices() []*models.CachingService {
@@ -9754,7 +9892,7 @@ type DeleteCachingServiceRequest struct {
 func (x *DeleteCachingServiceRequest) Reset() {
 	*x = DeleteCachingServiceRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[162]
+		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[164]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -9767,7 +9905,7 @@ func (x *DeleteCachingServiceRequest) String() string {
 func (*DeleteCachingServiceRequest) ProtoMessage() {}
 
 func (x *DeleteCachingServiceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[162]
+	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[164]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9780,7 +9918,7 @@ func (x *DeleteCachingServiceRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteCachingServiceRequest.ProtoReflect.Descriptor instead.
 func (*DeleteCachingServiceRequest) Descriptor() ([]byte, []int) {
-	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{162}
+	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{164}
 }
 
 func (x *DeleteCachingServiceRequest) GetName() string {
@@ -9810,7 +9948,7 @@ type CreateSchedulingUnitRequest struct {
 func (x *CreateSchedulingUnitRequest) Reset() {
 	*x = CreateSchedulingUnitRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[163]
+		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[165]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -9823,7 +9961,7 @@ func (x *CreateSchedulingUnitRequest) String() string {
 func (*CreateSchedulingUnitRequest) ProtoMessage() {}
 
 func (x *CreateSchedulingUnitRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[163]
+	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[165]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9836,7 +9974,7 @@ func (x *CreateSchedulingUnitRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateSchedulingUnitRequest.ProtoReflect.Descriptor instead.
 func (*CreateSchedulingUnitRequest) Descriptor() ([]byte, []int) {
-	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{163}
+	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{165}
 }
 
 func (x *CreateSchedulingUnitRequest) GetSchedulingUnit() *models.SchedulingUnit {
@@ -9867,7 +10005,7 @@ type UpdateSchedulingUnitRequest struct {
 func (x *UpdateSchedulingUnitRequest) Reset() {
 	*x = UpdateSchedulingUnitRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[164]
+		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[166]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -9880,7 +10018,7 @@ func (x *UpdateSchedulingUnitRequest) String() string {
 func (*UpdateSchedulingUnitRequest) ProtoMessage() {}
 
 func (x *UpdateSchedulingUnitRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[164]
+	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[166]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9893,7 +10031,7 @@ func (x *UpdateSchedulingUnitRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateSchedulingUnitRequest.ProtoReflect.Descriptor instead.
 func (*UpdateSchedulingUnitRequest) Descriptor() ([]byte, []int) {
-	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{164}
+	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{166}
 }
 
 func (x *UpdateSchedulingUnitRequest) GetSchedulingUnit() *models.SchedulingUnit {
@@ -9923,7 +10061,7 @@ type GetSchedulingUnitRequest struct {
 func (x *GetSchedulingUnitRequest) Reset() {
 	*x = GetSchedulingUnitRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[165]
+		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[167]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -9936,7 +10074,7 @@ func (x *GetSchedulingUnitRequest) String() string {
 func (*GetSchedulingUnitRequest) ProtoMessage() {}
 
 func (x *GetSchedulingUnitRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[165]
+	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[167]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9949,7 +10087,7 @@ func (x *GetSchedulingUnitRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetSchedulingUnitRequest.ProtoReflect.Descriptor instead.
 func (*GetSchedulingUnitRequest) Descriptor() ([]byte, []int) {
-	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{165}
+	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{167}
 }
 
 func (x *GetSchedulingUnitRequest) GetName() string {
@@ -9985,7 +10123,7 @@ type ListSchedulingUnitsRequest struct {
 func (x *ListSchedulingUnitsRequest) Reset() {
 	*x = ListSchedulingUnitsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[166]
+		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[168]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -9998,7 +10136,7 @@ func (x *ListSchedulingUnitsRequest) String() string {
 func (*ListSchedulingUnitsRequest) ProtoMessage() {}
 
 func (x *ListSchedulingUnitsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[166]
+	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[168]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10011,7 +10149,7 @@ func (x *ListSchedulingUnitsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListSchedulingUnitsRequest.ProtoReflect.Descriptor instead.
 func (*ListSchedulingUnitsRequest) Descriptor() ([]byte, []int) {
-	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{166}
+	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{168}
 }
 
 func (x *ListSchedulingUnitsRequest) GetPageSize() int32 {
@@ -10057,7 +10195,7 @@ type ListSchedulingUnitsResponse struct {
 func (x *ListSchedulingUnitsResponse) Reset() {
 	*x = ListSchedulingUnitsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[167]
+		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[169]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -10070,7 +10208,7 @@ func (x *ListSchedulingUnitsResponse) String() string {
 func (*ListSchedulingUnitsResponse) ProtoMessage() {}
 
 func (x *ListSchedulingUnitsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[167]
+	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[169]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10083,7 +10221,7 @@ func (x *ListSchedulingUnitsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListSchedulingUnitsResponse.ProtoReflect.Descriptor instead.
 func (*ListSchedulingUnitsResponse) Descriptor() ([]byte, []int) {
-	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{167}
+	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{169}
 }
 
 func (x *ListSchedulingUnitsResponse) GetSchedulingUnits() []*models.SchedulingUnit {
@@ -10113,7 +10251,7 @@ type DeleteSchedulingUnitRequest struct {
 func (x *DeleteSchedulingUnitRequest) Reset() {
 	*x = DeleteSchedulingUnitRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[168]
+		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[170]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -10126,7 +10264,7 @@ func (x *DeleteSchedulingUnitRequest) String() string {
 func (*DeleteSchedulingUnitRequest) ProtoMessage() {}
 
 func (x *DeleteSchedulingUnitRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[168]
+	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[170]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10139,7 +10277,7 @@ func (x *DeleteSchedulingUnitRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteSchedulingUnitRequest.ProtoReflect.Descriptor instead.
 func (*DeleteSchedulingUnitRequest) Descriptor() ([]byte, []int) {
-	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{168}
+	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{170}
 }
 
 func (x *DeleteSchedulingUnitRequest) GetName() string {
@@ -10172,7 +10310,7 @@ type UpdateConfigBundleRequest struct {
 func (x *UpdateConfigBundleRequest) Reset() {
 	*x = UpdateConfigBundleRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[169]
+		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[171]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -10185,7 +10323,7 @@ func (x *UpdateConfigBundleRequest) String() string {
 func (*UpdateConfigBundleRequest) ProtoMessage() {}
 
 func (x *UpdateConfigBundleRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[169]
+	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[171]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10198,7 +10336,7 @@ func (x *UpdateConfigBundleRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateConfigBundleRequest.ProtoReflect.Descriptor instead.
 func (*UpdateConfigBundleRequest) Descriptor() ([]byte, []int) {
-	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{169}
+	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{171}
 }
 
 func (x *UpdateConfigBundleRequest) GetConfigBundle() []byte {
@@ -10238,7 +10376,7 @@ type UpdateConfigBundleResponse struct {
 func (x *UpdateConfigBundleResponse) Reset() {
 	*x = UpdateConfigBundleResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[170]
+		mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[172]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -10251,7 +10389,7 @@ func (x *UpdateConfigBundleResponse) String() string {
 func (*UpdateConfigBundleResponse) ProtoMessage() {}
 
 func (x *UpdateConfigBundleResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[170]
+	mi := &file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[172]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10264,7 +10402,7 @@ func (x *UpdateConfigBundleResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateConfigBundleResponse.ProtoReflect.Descriptor instead.
 func (*UpdateConfigBundleResponse) Descriptor() ([]byte, []int) {
-	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{170}
+	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP(), []int{172}
 }
 
 func (x *UpdateConfigBundleResponse) GetConfigBundle() []byte {
@@ -10371,11 +10509,11 @@ var file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDesc = []byte{
 	0x65, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x73, 0x0a, 0x1e,
 	0x47, 0x65, 0x74, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x4c, 0x53, 0x45, 0x44, 0x65, 0x70,
 	0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x51,
-	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x3d, 0xe0, 0x41,
-	0x02, 0xfa, 0x41, 0x37, 0x0a, 0x35, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x2d, 0x66, 0x6c,
-	0x65, 0x65, 0x74, 0x2d, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x61, 0x70, 0x70, 0x73, 0x70,
-	0x6f, 0x74, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x4c, 0x53,
-	0x45, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x3d, 0xfa, 0x41,
+	0x37, 0x0a, 0x35, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x2d, 0x66, 0x6c, 0x65, 0x65, 0x74,
+	0x2d, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x61, 0x70, 0x70, 0x73, 0x70, 0x6f, 0x74, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x4c, 0x53, 0x45, 0x44, 0x65,
+	0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0xe0, 0x41, 0x02, 0x52, 0x04, 0x6e, 0x61, 0x6d,
 	0x65, 0x22, 0x54, 0x0a, 0x24, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x63,
 	0x68, 0x69, 0x6e, 0x65, 0x4c, 0x53, 0x45, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e,
 	0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x61, 0x72,
@@ -10439,15 +10577,15 @@ var file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDesc = []byte{
 	0x4a, 0x04, 0x08, 0x05, 0x10, 0x06, 0x52, 0x0d, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x4c,
 	0x53, 0x45, 0x5f, 0x69, 0x64, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x22, 0x4f, 0x0a, 0x0c,
 	0x47, 0x65, 0x74, 0x56, 0x4d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3f, 0x0a, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2b, 0xe0, 0x41, 0x02, 0xfa,
-	0x41, 0x25, 0x0a, 0x23, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x2d, 0x66, 0x6c, 0x65, 0x65,
-	0x74, 0x2d, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x61, 0x70, 0x70, 0x73, 0x70, 0x6f, 0x74,
-	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x56, 0x4d, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x52, 0x0a,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2b, 0xfa, 0x41, 0x25, 0x0a,
+	0x23, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x2d, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2d, 0x73,
+	0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x61, 0x70, 0x70, 0x73, 0x70, 0x6f, 0x74, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x56, 0x4d, 0xe0, 0x41, 0x02, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x52, 0x0a,
 	0x0f, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x56, 0x4d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
 	0x12, 0x3f, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2b,
-	0xe0, 0x41, 0x02, 0xfa, 0x41, 0x25, 0x0a, 0x23, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x2d,
-	0x66, 0x6c, 0x65, 0x65, 0x74, 0x2d, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x61, 0x70, 0x70,
-	0x73, 0x70, 0x6f, 0x74, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x56, 0x4d, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0xfa, 0x41, 0x25, 0x0a, 0x23, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x2d, 0x66, 0x6c, 0x65,
+	0x65, 0x74, 0x2d, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x61, 0x70, 0x70, 0x73, 0x70, 0x6f,
+	0x74, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x56, 0x4d, 0xe0, 0x41, 0x02, 0x52, 0x04, 0x6e, 0x61, 0x6d,
 	0x65, 0x22, 0x80, 0x01, 0x0a, 0x0e, 0x4c, 0x69, 0x73, 0x74, 0x56, 0x4d, 0x73, 0x52, 0x65, 0x71,
 	0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a,
 	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a,
@@ -10491,10 +10629,10 @@ var file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDesc = []byte{
 	0x74, 0x65, 0x4d, 0x61, 0x73, 0x6b, 0x22, 0x67, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x43, 0x68, 0x72,
 	0x6f, 0x6d, 0x65, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65,
 	0x73, 0x74, 0x12, 0x4b, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x42, 0x37, 0xe0, 0x41, 0x02, 0xfa, 0x41, 0x31, 0x0a, 0x2f, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65,
-	0x64, 0x2d, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2d, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x61,
-	0x70, 0x70, 0x73, 0x70, 0x6f, 0x74, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x43, 0x68, 0x72, 0x6f, 0x6d,
-	0x65, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22,
+	0x42, 0x37, 0xfa, 0x41, 0x31, 0x0a, 0x2f, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x2d, 0x66,
+	0x6c, 0x65, 0x65, 0x74, 0x2d, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x61, 0x70, 0x70, 0x73,
+	0x70, 0x6f, 0x74, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x43, 0x68, 0x72, 0x6f, 0x6d, 0x65, 0x50, 0x6c,
+	0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0xe0, 0x41, 0x02, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22,
 	0x8c, 0x01, 0x0a, 0x1a, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x68, 0x72, 0x6f, 0x6d, 0x65, 0x50, 0x6c,
 	0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b,
 	0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
@@ -10516,11 +10654,11 @@ var file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDesc = []byte{
 	0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x6a, 0x0a, 0x1b,
 	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x68, 0x72, 0x6f, 0x6d, 0x65, 0x50, 0x6c, 0x61, 0x74,
 	0x66, 0x6f, 0x72, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x4b, 0x0a, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x37, 0xe0, 0x41, 0x02, 0xfa, 0x41,
-	0x31, 0x0a, 0x2f, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x2d, 0x66, 0x6c, 0x65, 0x65, 0x74,
-	0x2d, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x61, 0x70, 0x70, 0x73, 0x70, 0x6f, 0x74, 0x2e,
-	0x63, 0x6f, 0x6d, 0x2f, 0x43, 0x68, 0x72, 0x6f, 0x6d, 0x65, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f,
-	0x72, 0x6d, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0xce, 0x01, 0x0a, 0x1c, 0x49, 0x6d, 0x70,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x37, 0xfa, 0x41, 0x31, 0x0a, 0x2f,
+	0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x2d, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2d, 0x73, 0x79,
+	0x73, 0x74, 0x65, 0x6d, 0x2e, 0x61, 0x70, 0x70, 0x73, 0x70, 0x6f, 0x74, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x43, 0x68, 0x72, 0x6f, 0x6d, 0x65, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0xe0,
+	0x41, 0x02, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0xce, 0x01, 0x0a, 0x1c, 0x49, 0x6d, 0x70,
 	0x6f, 0x72, 0x74, 0x43, 0x68, 0x72, 0x6f, 0x6d, 0x65, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72,
 	0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x56, 0x0a, 0x11, 0x6d, 0x61, 0x63,
 	0x68, 0x69, 0x6e, 0x65, 0x5f, 0x64, 0x62, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x01,
@@ -10610,10 +10748,10 @@ var file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDesc = []byte{
 	0x61, 0x73, 0x6b, 0x22, 0x71, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e,
 	0x65, 0x4c, 0x53, 0x45, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x74, 0x79, 0x70, 0x65, 0x52, 0x65, 0x71,
 	0x75, 0x65, 0x73, 0x74, 0x12, 0x50, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x3c, 0xe0, 0x41, 0x02, 0xfa, 0x41, 0x36, 0x0a, 0x34, 0x75, 0x6e, 0x69, 0x66,
-	0x69, 0x65, 0x64, 0x2d, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2d, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d,
-	0x2e, 0x61, 0x70, 0x70, 0x73, 0x70, 0x6f, 0x74, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x4d, 0x61, 0x63,
-	0x68, 0x69, 0x6e, 0x65, 0x4c, 0x53, 0x45, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x74, 0x79, 0x70, 0x65,
+	0x28, 0x09, 0x42, 0x3c, 0xfa, 0x41, 0x36, 0x0a, 0x34, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64,
+	0x2d, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2d, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x61, 0x70,
+	0x70, 0x73, 0x70, 0x6f, 0x74, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e,
+	0x65, 0x4c, 0x53, 0x45, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x74, 0x79, 0x70, 0x65, 0xe0, 0x41, 0x02,
 	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x91, 0x01, 0x0a, 0x1f, 0x4c, 0x69, 0x73, 0x74, 0x4d,
 	0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x4c, 0x53, 0x45, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x74, 0x79,
 	0x70, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61,
@@ -10638,10 +10776,10 @@ var file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDesc = []byte{
 	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x4c, 0x53, 0x45,
 	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x74, 0x79, 0x70, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
 	0x12, 0x50, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x3c,
-	0xe0, 0x41, 0x02, 0xfa, 0x41, 0x36, 0x0a, 0x34, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x2d,
-	0x66, 0x6c, 0x65, 0x65, 0x74, 0x2d, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x61, 0x70, 0x70,
-	0x73, 0x70, 0x6f, 0x74, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65,
-	0x4c, 0x53, 0x45, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x74, 0x79, 0x70, 0x65, 0x52, 0x04, 0x6e, 0x61,
+	0xfa, 0x41, 0x36, 0x0a, 0x34, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x2d, 0x66, 0x6c, 0x65,
+	0x65, 0x74, 0x2d, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x61, 0x70, 0x70, 0x73, 0x70, 0x6f,
+	0x74, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x4c, 0x53, 0x45,
+	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x74, 0x79, 0x70, 0x65, 0xe0, 0x41, 0x02, 0x52, 0x04, 0x6e, 0x61,
 	0x6d, 0x65, 0x22, 0xaf, 0x01, 0x0a, 0x1d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x61, 0x63,
 	0x6b, 0x4c, 0x53, 0x45, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x74, 0x79, 0x70, 0x65, 0x52, 0x65, 0x71,
 	0x75, 0x65, 0x73, 0x74, 0x12, 0x5d, 0x0a, 0x10, 0x72, 0x61, 0x63, 0x6b, 0x4c, 0x53, 0x45, 0x50,
@@ -10668,10 +10806,10 @@ var file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDesc = []byte{
 	0x73, 0x6b, 0x22, 0x6b, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x52, 0x61, 0x63, 0x6b, 0x4c, 0x53, 0x45,
 	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x74, 0x79, 0x70, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
 	0x12, 0x4d, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x39,
-	0xe0, 0x41, 0x02, 0xfa, 0x41, 0x33, 0x0a, 0x31, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x2d,
-	0x66, 0x6c, 0x65, 0x65, 0x74, 0x2d, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x61, 0x70, 0x70,
-	0x73, 0x70, 0x6f, 0x74, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x52, 0x61, 0x63, 0x6b, 0x4c, 0x53, 0x45,
-	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x74, 0x79, 0x70, 0x65, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22,
+	0xfa, 0x41, 0x33, 0x0a, 0x31, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x2d, 0x66, 0x6c, 0x65,
+	0x65, 0x74, 0x2d, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x61, 0x70, 0x70, 0x73, 0x70, 0x6f,
+	0x74, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x52, 0x61, 0x63, 0x6b, 0x4c, 0x53, 0x45, 0x50, 0x72, 0x6f,
+	0x74, 0x6f, 0x74, 0x79, 0x70, 0x65, 0xe0, 0x41, 0x02, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22,
 	0x8e, 0x01, 0x0a, 0x1c, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x61, 0x63, 0x6b, 0x4c, 0x53, 0x45, 0x50,
 	0x72, 0x6f, 0x74, 0x6f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
 	0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20,
@@ -10694,10 +10832,10 @@ var file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDesc = []byte{
 	0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x6e, 0x0a, 0x1d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
 	0x52, 0x61, 0x63, 0x6b, 0x4c, 0x53, 0x45, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x74, 0x79, 0x70, 0x65,
 	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x4d, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x39, 0xe0, 0x41, 0x02, 0xfa, 0x41, 0x33, 0x0a, 0x31, 0x75,
-	0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x2d, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2d, 0x73, 0x79, 0x73,
-	0x74, 0x65, 0x6d, 0x2e, 0x61, 0x70, 0x70, 0x73, 0x70, 0x6f, 0x74, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
-	0x52, 0x61, 0x63, 0x6b, 0x4c, 0x53, 0x45, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x74, 0x79, 0x70, 0x65,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x39, 0xfa, 0x41, 0x33, 0x0a, 0x31, 0x75, 0x6e, 0x69, 0x66,
+	0x69, 0x65, 0x64, 0x2d, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2d, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d,
+	0x2e, 0x61, 0x70, 0x70, 0x73, 0x70, 0x6f, 0x74, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x52, 0x61, 0x63,
+	0x6b, 0x4c, 0x53, 0x45, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x74, 0x79, 0x70, 0x65, 0xe0, 0x41, 0x02,
 	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x60, 0x0a, 0x1a, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e,
 	0x65, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
 	0x75, 0x65, 0x73, 0x74, 0x12, 0x42, 0x0a, 0x07, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x18,
@@ -10714,195 +10852,229 @@ var file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDesc = []byte{
 	0x6d, 0x61, 0x73, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
 	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65,
 	0x6c, 0x64, 0x4d, 0x61, 0x73, 0x6b, 0x52, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61,
-	0x73, 0x6b, 0x22, 0x59, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65,
+	0x73, 0x6b, 0x22, 0x7c, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65,
 	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x44, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x30, 0xe0, 0x41, 0x02, 0xfa, 0x41, 0x2a, 0x0a, 0x28, 0x75,
-	0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x2d, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2d, 0x73, 0x79, 0x73,
-	0x74, 0x65, 0x6d, 0x2e, 0x61, 0x70, 0x70, 0x73, 0x70, 0x6f, 0x74, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
-	0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x99, 0x01,
-	0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69,
-	0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69,
-	0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65,
-	0x6e, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x6b, 0x65, 0x79,
-	0x73, 0x4f, 0x6e, 0x6c, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x6b, 0x65, 0x79,
-	0x73, 0x4f, 0x6e, 0x6c, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x75, 0x6c, 0x6c, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x04, 0x66, 0x75, 0x6c, 0x6c, 0x22, 0x7f, 0x0a, 0x14, 0x4c, 0x69, 0x73,
-	0x74, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x3f, 0x0a, 0x08, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x73, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65,
-	0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73,
-	0x2e, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x52, 0x08, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e,
-	0x65, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f,
-	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78,
-	0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x5c, 0x0a, 0x14, 0x44, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x44, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x42, 0x30, 0xe0, 0x41, 0x02, 0xfa, 0x41, 0x2a, 0x0a, 0x28, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65,
-	0x64, 0x2d, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2d, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x61,
-	0x70, 0x70, 0x73, 0x70, 0x6f, 0x74, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x4d, 0x61, 0x63, 0x68, 0x69,
-	0x6e, 0x65, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0xc7, 0x01, 0x0a, 0x15, 0x49, 0x6d, 0x70,
-	0x6f, 0x72, 0x74, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x56, 0x0a, 0x11, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x5f, 0x64, 0x62,
-	0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e,
-	0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69,
-	0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x44,
-	0x42, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x48, 0x00, 0x52, 0x0f, 0x6d, 0x61, 0x63, 0x68, 0x69,
-	0x6e, 0x65, 0x44, 0x62, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x4c, 0x0a, 0x0d, 0x63, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x25, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74,
-	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x48, 0x00, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x42, 0x08, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x22, 0xa9, 0x01, 0x0a, 0x14, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x4d, 0x61, 0x63,
-	0x68, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x44, 0x0a, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x30, 0xe0, 0x41, 0x02, 0xfa, 0x41,
-	0x2a, 0x0a, 0x28, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x2d, 0x66, 0x6c, 0x65, 0x65, 0x74,
-	0x2d, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x61, 0x70, 0x70, 0x73, 0x70, 0x6f, 0x74, 0x2e,
-	0x63, 0x6f, 0x6d, 0x2f, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x52, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x12, 0x4b, 0x0a, 0x08, 0x6e, 0x65, 0x77, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x30, 0xe0, 0x41, 0x02, 0xfa, 0x41, 0x2a, 0x0a, 0x28, 0x75, 0x6e, 0x69,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x30, 0xfa, 0x41, 0x2a, 0x0a, 0x28, 0x75, 0x6e, 0x69, 0x66,
+	0x69, 0x65, 0x64, 0x2d, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2d, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d,
+	0x2e, 0x61, 0x70, 0x70, 0x73, 0x70, 0x6f, 0x74, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x4d, 0x61, 0x63,
+	0x68, 0x69, 0x6e, 0x65, 0xe0, 0x41, 0x02, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a,
+	0x0c, 0x73, 0x68, 0x6f, 0x77, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0b, 0x73, 0x68, 0x6f, 0x77, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64,
+	0x22, 0xbc, 0x01, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65,
+	0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67,
+	0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08,
+	0x6b, 0x65, 0x79, 0x73, 0x4f, 0x6e, 0x6c, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08,
+	0x6b, 0x65, 0x79, 0x73, 0x4f, 0x6e, 0x6c, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x75, 0x6c, 0x6c,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x66, 0x75, 0x6c, 0x6c, 0x12, 0x21, 0x0a, 0x0c,
+	0x73, 0x68, 0x6f, 0x77, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0b, 0x73, 0x68, 0x6f, 0x77, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x22,
+	0x7f, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f, 0x0a, 0x08, 0x6d, 0x61, 0x63, 0x68, 0x69,
+	0x6e, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x75, 0x6e, 0x69, 0x66,
+	0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x52, 0x08,
+	0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74,
+	0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x22, 0x5c, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x44, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x30, 0xfa, 0x41, 0x2a, 0x0a, 0x28, 0x75, 0x6e, 0x69,
 	0x66, 0x69, 0x65, 0x64, 0x2d, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2d, 0x73, 0x79, 0x73, 0x74, 0x65,
 	0x6d, 0x2e, 0x61, 0x70, 0x70, 0x73, 0x70, 0x6f, 0x74, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x4d, 0x61,
-	0x63, 0x68, 0x69, 0x6e, 0x65, 0x52, 0x07, 0x6e, 0x65, 0x77, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x25,
-	0x0a, 0x0f, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x44, 0x42, 0x53, 0x6f, 0x75, 0x72, 0x63,
-	0x65, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x68, 0x6f, 0x73, 0x74, 0x22, 0x5b, 0x0a, 0x0c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x53,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x2e, 0x0a, 0x13, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f,
-	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x11, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x6e, 0x61,
-	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x4e, 0x61,
-	0x6d, 0x65, 0x22, 0x67, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x61, 0x63, 0x6b,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x39, 0x0a, 0x04, 0x72, 0x61, 0x63, 0x6b, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66,
-	0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x64, 0x65,
-	0x6c, 0x73, 0x2e, 0x52, 0x61, 0x63, 0x6b, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x04, 0x72, 0x61,
-	0x63, 0x6b, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x61, 0x63, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x61, 0x63, 0x6b, 0x49, 0x64, 0x22, 0x8b, 0x01, 0x0a, 0x11,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x39, 0x0a, 0x04, 0x72, 0x61, 0x63, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x20, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61,
-	0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x52, 0x61, 0x63,
-	0x6b, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x04, 0x72, 0x61, 0x63, 0x6b, 0x12, 0x3b, 0x0a, 0x0b,
-	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61, 0x73, 0x6b, 0x52, 0x0a, 0x75,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x73, 0x6b, 0x22, 0x53, 0x0a, 0x0e, 0x47, 0x65, 0x74,
-	0x52, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x41, 0x0a, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2d, 0xe0, 0x41, 0x02, 0xfa, 0x41,
-	0x27, 0x0a, 0x25, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x2d, 0x66, 0x6c, 0x65, 0x65, 0x74,
-	0x2d, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x61, 0x70, 0x70, 0x73, 0x70, 0x6f, 0x74, 0x2e,
-	0x63, 0x6f, 0x6d, 0x2f, 0x52, 0x61, 0x63, 0x6b, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x96,
-	0x01, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x61, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65,
-	0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12,
-	0x16, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x6b, 0x65, 0x79, 0x73, 0x4f,
-	0x6e, 0x6c, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x6b, 0x65, 0x79, 0x73, 0x4f,
-	0x6e, 0x6c, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x75, 0x6c, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x04, 0x66, 0x75, 0x6c, 0x6c, 0x22, 0x73, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x52,
-	0x61, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x05,
-	0x72, 0x61, 0x63, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x75, 0x6e,
-	0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
-	0x31, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x52, 0x61, 0x63, 0x6b, 0x52, 0x05, 0x72,
-	0x61, 0x63, 0x6b, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67,
-	0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e,
-	0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x56, 0x0a, 0x11,
-	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x41, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x2d, 0xe0, 0x41, 0x02, 0xfa, 0x41, 0x27, 0x0a, 0x25, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64,
-	0x2d, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2d, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x61, 0x70,
-	0x70, 0x73, 0x70, 0x6f, 0x74, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x52, 0x61, 0x63, 0x6b, 0x52, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x22, 0xea, 0x01, 0x0a, 0x17, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d,
-	0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x4c, 0x53, 0x45, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x4b, 0x0a, 0x0a, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x4c, 0x53, 0x45, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c,
-	0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
-	0x73, 0x2e, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x4c, 0x53, 0x45, 0x42, 0x03, 0xe0, 0x41,
-	0x02, 0x52, 0x0a, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x4c, 0x53, 0x45, 0x12, 0x23, 0x0a,
-	0x0d, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x4c, 0x53, 0x45, 0x5f, 0x69, 0x64, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x4c, 0x53, 0x45,
-	0x49, 0x64, 0x12, 0x4d, 0x0a, 0x0e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x6f, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x75, 0x6e, 0x69,
-	0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31,
-	0x2e, 0x72, 0x70, 0x63, 0x2e, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x4f, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x52, 0x0d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x4f, 0x70, 0x74, 0x69, 0x6f,
-	0x6e, 0x4a, 0x04, 0x08, 0x03, 0x10, 0x04, 0x52, 0x08, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65,
-	0x73, 0x22, 0x9b, 0x03, 0x0a, 0x17, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x63, 0x68,
-	0x69, 0x6e, 0x65, 0x4c, 0x53, 0x45, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x4b, 0x0a,
-	0x0a, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x4c, 0x53, 0x45, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x26, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74,
-	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x4d,
-	0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x4c, 0x53, 0x45, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x0a,
-	0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x4c, 0x53, 0x45, 0x12, 0x3b, 0x0a, 0x0b, 0x75, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61, 0x73, 0x6b, 0x52, 0x0a, 0x75, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x4d, 0x61, 0x73, 0x6b, 0x12, 0x6d, 0x0a, 0x0f, 0x6e, 0x65, 0x74, 0x77, 0x6f,
-	0x72, 0x6b, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x44, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e,
-	0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x4c, 0x53, 0x45, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x2e, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x4f,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x69, 0x0a, 0x13, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72,
-	0x6b, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
-	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
-	0x3c, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26,
-	0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70,
-	0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
-	0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
-	0x01, 0x4a, 0x04, 0x08, 0x03, 0x10, 0x04, 0x4a, 0x04, 0x08, 0x05, 0x10, 0x06, 0x52, 0x08, 0x6d,
-	0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x65, 0x73, 0x22,
-	0x5f, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x4c, 0x53, 0x45,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x47, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x33, 0xe0, 0x41, 0x02, 0xfa, 0x41, 0x2d, 0x0a, 0x2b, 0x75,
+	0x63, 0x68, 0x69, 0x6e, 0x65, 0xe0, 0x41, 0x02, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0xc7,
+	0x01, 0x0a, 0x15, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x56, 0x0a, 0x11, 0x6d, 0x61, 0x63, 0x68,
+	0x69, 0x6e, 0x65, 0x5f, 0x64, 0x62, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65,
+	0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x4d, 0x61,
+	0x63, 0x68, 0x69, 0x6e, 0x65, 0x44, 0x42, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x48, 0x00, 0x52,
+	0x0f, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x44, 0x62, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x12, 0x4c, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65,
+	0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70,
+	0x63, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x48, 0x00,
+	0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x42, 0x08,
+	0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x22, 0x5d, 0x0a, 0x15, 0x52, 0x65, 0x73, 0x74,
+	0x6f, 0x72, 0x65, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x44, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x30, 0xfa, 0x41, 0x2a, 0x0a, 0x28, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x2d, 0x66, 0x6c,
+	0x65, 0x65, 0x74, 0x2d, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x61, 0x70, 0x70, 0x73, 0x70,
+	0x6f, 0x74, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0xe0, 0x41,
+	0x02, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0xa9, 0x01, 0x0a, 0x14, 0x52, 0x65, 0x6e, 0x61,
+	0x6d, 0x65, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x44, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x30,
+	0xfa, 0x41, 0x2a, 0x0a, 0x28, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x2d, 0x66, 0x6c, 0x65,
+	0x65, 0x74, 0x2d, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x61, 0x70, 0x70, 0x73, 0x70, 0x6f,
+	0x74, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0xe0, 0x41, 0x02,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x4b, 0x0a, 0x08, 0x6e, 0x65, 0x77, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x30, 0xfa, 0x41, 0x2a, 0x0a, 0x28, 0x75,
 	0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x2d, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2d, 0x73, 0x79, 0x73,
 	0x74, 0x65, 0x6d, 0x2e, 0x61, 0x70, 0x70, 0x73, 0x70, 0x6f, 0x74, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
-	0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x4c, 0x53, 0x45, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x22, 0x9c, 0x01, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65,
-	0x4c, 0x53, 0x45, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x70,
-	0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08,
-	0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65,
-	0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61,
-	0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65,
-	0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12,
-	0x1a, 0x0a, 0x08, 0x6b, 0x65, 0x79, 0x73, 0x4f, 0x6e, 0x6c, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x08, 0x6b, 0x65, 0x79, 0x73, 0x4f, 0x6e, 0x6c, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x66,
-	0x75, 0x6c, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x66, 0x75, 0x6c, 0x6c, 0x22,
-	0x8b, 0x01, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x4c,
-	0x53, 0x45, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x48, 0x0a, 0x0b, 0x6d,
-	0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x4c, 0x53, 0x45, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x26, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e,
-	0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x4d, 0x61,
-	0x63, 0x68, 0x69, 0x6e, 0x65, 0x4c, 0x53, 0x45, 0x52, 0x0b, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e,
-	0x65, 0x4c, 0x53, 0x45, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61,
-	0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
-	0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x62, 0x0a,
-	0x17, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x4c, 0x53,
-	0x45, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x47, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x33, 0xe0, 0x41, 0x02, 0xfa, 0x41, 0x2d, 0x0a, 0x2b,
-	0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x2d, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2d, 0x73, 0x79,
-	0x73, 0x74, 0x65, 0x6d, 0x2e, 0x61, 0x70, 0x70, 0x73, 0x70, 0x6f, 0x74, 0x2e, 0x63, 0x6f, 0x6d,
-	0x2f, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x4c, 0x53, 0x45, 0x52, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x22, 0xb2, 0x01, 0x0a, 0x17, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x4d, 0x61, 0x63, 0x68,
-	0x69, 0x6e, 0x65, 0x4c, 0x53, 0x45, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x47, 0x0a,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x33, 0xe0, 0x41, 0x02,
-	0xfa, 0x41, 0x2d, 0x0a, 0x2b, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x2d, 0x66, 0x6c, 0x65,
+	0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0xe0, 0x41, 0x02, 0x52, 0x07, 0x6e, 0x65, 0x77, 0x4e,
+	0x61, 0x6d, 0x65, 0x22, 0x25, 0x0a, 0x0f, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x44, 0x42,
+	0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x22, 0x5b, 0x0a, 0x0c, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x2e, 0x0a, 0x13, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x69,
+	0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66,
+	0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x67, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x52, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x39, 0x0a, 0x04,
+	0x72, 0x61, 0x63, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x75, 0x6e, 0x69,
+	0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31,
+	0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x52, 0x61, 0x63, 0x6b, 0x42, 0x03, 0xe0, 0x41,
+	0x02, 0x52, 0x04, 0x72, 0x61, 0x63, 0x6b, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x61, 0x63, 0x6b, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x61, 0x63, 0x6b, 0x49, 0x64,
+	0x22, 0x8b, 0x01, 0x0a, 0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x61, 0x63, 0x6b, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x39, 0x0a, 0x04, 0x72, 0x61, 0x63, 0x6b, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c,
+	0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
+	0x73, 0x2e, 0x52, 0x61, 0x63, 0x6b, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x04, 0x72, 0x61, 0x63,
+	0x6b, 0x12, 0x3b, 0x0a, 0x0b, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x73, 0x6b,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61,
+	0x73, 0x6b, 0x52, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x73, 0x6b, 0x22, 0x53,
+	0x0a, 0x0e, 0x47, 0x65, 0x74, 0x52, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x41, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2d,
+	0xfa, 0x41, 0x27, 0x0a, 0x25, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x2d, 0x66, 0x6c, 0x65,
 	0x65, 0x74, 0x2d, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x61, 0x70, 0x70, 0x73, 0x70, 0x6f,
-	0x74, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x4c, 0x53, 0x45,
-	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x4e, 0x0a, 0x08, 0x6e, 0x65, 0x77, 0x5f, 0x6e, 0x61,
-	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x33, 0xe0, 0x41, 0x02, 0xfa, 0x41, 0x2d,
+	0x74, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x52, 0x61, 0x63, 0x6b, 0xe0, 0x41, 0x02, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x22, 0x96, 0x01, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x61, 0x63, 0x6b,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65,
+	0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67,
+	0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08,
+	0x6b, 0x65, 0x79, 0x73, 0x4f, 0x6e, 0x6c, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08,
+	0x6b, 0x65, 0x79, 0x73, 0x4f, 0x6e, 0x6c, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x75, 0x6c, 0x6c,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x66, 0x75, 0x6c, 0x6c, 0x22, 0x73, 0x0a, 0x11,
+	0x4c, 0x69, 0x73, 0x74, 0x52, 0x61, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x36, 0x0a, 0x05, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x20, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x52, 0x61,
+	0x63, 0x6b, 0x52, 0x05, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78,
+	0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x22, 0x56, 0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x61, 0x63, 0x6b, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x41, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x2d, 0xfa, 0x41, 0x27, 0x0a, 0x25, 0x75, 0x6e, 0x69, 0x66, 0x69,
+	0x65, 0x64, 0x2d, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2d, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e,
+	0x61, 0x70, 0x70, 0x73, 0x70, 0x6f, 0x74, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x52, 0x61, 0x63, 0x6b,
+	0xe0, 0x41, 0x02, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0xea, 0x01, 0x0a, 0x17, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x4c, 0x53, 0x45, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x4b, 0x0a, 0x0a, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65,
+	0x4c, 0x53, 0x45, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x75, 0x6e, 0x69, 0x66,
+	0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x4c, 0x53,
+	0x45, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x0a, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x4c,
+	0x53, 0x45, 0x12, 0x23, 0x0a, 0x0d, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x4c, 0x53, 0x45,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6d, 0x61, 0x63, 0x68, 0x69,
+	0x6e, 0x65, 0x4c, 0x53, 0x45, 0x49, 0x64, 0x12, 0x4d, 0x0a, 0x0e, 0x6e, 0x65, 0x74, 0x77, 0x6f,
+	0x72, 0x6b, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x26, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72,
+	0x6b, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
+	0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x4a, 0x04, 0x08, 0x03, 0x10, 0x04, 0x52, 0x08, 0x6d, 0x61,
+	0x63, 0x68, 0x69, 0x6e, 0x65, 0x73, 0x22, 0x9b, 0x03, 0x0a, 0x17, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x4c, 0x53, 0x45, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x4b, 0x0a, 0x0a, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x4c, 0x53, 0x45,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64,
+	0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x64,
+	0x65, 0x6c, 0x73, 0x2e, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x4c, 0x53, 0x45, 0x42, 0x03,
+	0xe0, 0x41, 0x02, 0x52, 0x0a, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x4c, 0x53, 0x45, 0x12,
+	0x3b, 0x0a, 0x0b, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61, 0x73, 0x6b,
+	0x52, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x73, 0x6b, 0x12, 0x6d, 0x0a, 0x0f,
+	0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x44, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66,
+	0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x4c, 0x53, 0x45,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x4f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0e, 0x6e, 0x65, 0x74,
+	0x77, 0x6f, 0x72, 0x6b, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x69, 0x0a, 0x13, 0x4e,
+	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x3c, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65,
+	0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x4e, 0x65,
+	0x74, 0x77, 0x6f, 0x72, 0x6b, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x4a, 0x04, 0x08, 0x03, 0x10, 0x04, 0x4a, 0x04, 0x08, 0x05,
+	0x10, 0x06, 0x52, 0x08, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x73, 0x52, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x65, 0x73, 0x22, 0x82, 0x01, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x63, 0x68,
+	0x69, 0x6e, 0x65, 0x4c, 0x53, 0x45, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x47, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x33, 0xfa, 0x41, 0x2d,
 	0x0a, 0x2b, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x2d, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2d,
 	0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x61, 0x70, 0x70, 0x73, 0x70, 0x6f, 0x74, 0x2e, 0x63,
-	0x6f, 0x6d, 0x2f, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x4c, 0x53, 0x45, 0x52, 0x07, 0x6e,
-	0x65, 0x77, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0xca, 0x01, 0x0a, 0x18, 0x49, 0x6d, 0x70, 0x6f, 0x72,
-	0x74, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x4c, 0x53, 0x45, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x6f, 0x6d, 0x2f, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x4c, 0x53, 0x45, 0xe0, 0x41, 0x02,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x68, 0x6f, 0x77, 0x5f, 0x64,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x73, 0x68,
+	0x6f, 0x77, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x22, 0xbf, 0x01, 0x0a, 0x16, 0x4c, 0x69,
+	0x73, 0x74, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x4c, 0x53, 0x45, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a,
+	0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x12, 0x16, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x6b, 0x65, 0x79, 0x73,
+	0x4f, 0x6e, 0x6c, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x6b, 0x65, 0x79, 0x73,
+	0x4f, 0x6e, 0x6c, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x75, 0x6c, 0x6c, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x04, 0x66, 0x75, 0x6c, 0x6c, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x68, 0x6f, 0x77,
+	0x5f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b,
+	0x73, 0x68, 0x6f, 0x77, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x22, 0x8b, 0x01, 0x0a, 0x17,
+	0x4c, 0x69, 0x73, 0x74, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x4c, 0x53, 0x45, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x48, 0x0a, 0x0b, 0x6d, 0x61, 0x63, 0x68, 0x69,
+	0x6e, 0x65, 0x4c, 0x53, 0x45, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x75,
+	0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e,
+	0x65, 0x4c, 0x53, 0x45, 0x52, 0x0b, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x4c, 0x53, 0x45,
+	0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74,
+	0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x62, 0x0a, 0x17, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x4c, 0x53, 0x45, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x47, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x33, 0xfa, 0x41, 0x2d, 0x0a, 0x2b, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64,
+	0x2d, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2d, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x61, 0x70,
+	0x70, 0x73, 0x70, 0x6f, 0x74, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e,
+	0x65, 0x4c, 0x53, 0x45, 0xe0, 0x41, 0x02, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x63, 0x0a,
+	0x18, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x4c,
+	0x53, 0x45, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x47, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x33, 0xfa, 0x41, 0x2d, 0x0a, 0x2b, 0x75, 0x6e,
+	0x69, 0x66, 0x69, 0x65, 0x64, 0x2d, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2d, 0x73, 0x79, 0x73, 0x74,
+	0x65, 0x6d, 0x2e, 0x61, 0x70, 0x70, 0x73, 0x70, 0x6f, 0x74, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x4d,
+	0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x4c, 0x53, 0x45, 0xe0, 0x41, 0x02, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x22, 0xb2, 0x01, 0x0a, 0x17, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x4d, 0x61, 0x63,
+	0x68, 0x69, 0x6e, 0x65, 0x4c, 0x53, 0x45, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x47,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x33, 0xfa, 0x41,
+	0x2d, 0x0a, 0x2b, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x2d, 0x66, 0x6c, 0x65, 0x65, 0x74,
+	0x2d, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x61, 0x70, 0x70, 0x73, 0x70, 0x6f, 0x74, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x4c, 0x53, 0x45, 0xe0, 0x41,
+	0x02, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x4e, 0x0a, 0x08, 0x6e, 0x65, 0x77, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x33, 0xfa, 0x41, 0x2d, 0x0a, 0x2b,
+	0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x2d, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2d, 0x73, 0x79,
+	0x73, 0x74, 0x65, 0x6d, 0x2e, 0x61, 0x70, 0x70, 0x73, 0x70, 0x6f, 0x74, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x4c, 0x53, 0x45, 0xe0, 0x41, 0x02, 0x52, 0x07,
+	0x6e, 0x65, 0x77, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0xca, 0x01, 0x0a, 0x18, 0x49, 0x6d, 0x70, 0x6f,
+	0x72, 0x74, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x4c, 0x53, 0x45, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x56, 0x0a, 0x11, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x5f,
+	0x64, 0x62, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x28, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e,
+	0x65, 0x44, 0x42, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x48, 0x00, 0x52, 0x0f, 0x6d, 0x61, 0x63,
+	0x68, 0x69, 0x6e, 0x65, 0x44, 0x62, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x4c, 0x0a, 0x0d,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65,
+	0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x48, 0x00, 0x52, 0x0c, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x42, 0x08, 0x0a, 0x06, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x22, 0xcc, 0x01, 0x0a, 0x1a, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x4f,
+	0x53, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x4c, 0x53, 0x45, 0x73, 0x52, 0x65, 0x71, 0x75,
 	0x65, 0x73, 0x74, 0x12, 0x56, 0x0a, 0x11, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x5f, 0x64,
 	0x62, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28,
 	0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70,
@@ -10914,414 +11086,376 @@ var file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDesc = []byte{
 	0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x6f, 0x6e,
 	0x66, 0x69, 0x67, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x48, 0x00, 0x52, 0x0c, 0x63, 0x6f, 0x6e,
 	0x66, 0x69, 0x67, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x42, 0x08, 0x0a, 0x06, 0x73, 0x6f, 0x75,
-	0x72, 0x63, 0x65, 0x22, 0xcc, 0x01, 0x0a, 0x1a, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x4f, 0x53,
-	0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x4c, 0x53, 0x45, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x56, 0x0a, 0x11, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x5f, 0x64, 0x62,
-	0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e,
-	0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69,
-	0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x44,
-	0x42, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x48, 0x00, 0x52, 0x0f, 0x6d, 0x61, 0x63, 0x68, 0x69,
-	0x6e, 0x65, 0x44, 0x62, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x4c, 0x0a, 0x0d, 0x63, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x25, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74,
-	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x48, 0x00, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x42, 0x08, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x22, 0x79, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x61, 0x63, 0x6b,
-	0x4c, 0x53, 0x45, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x42, 0x0a, 0x07, 0x72, 0x61,
-	0x63, 0x6b, 0x4c, 0x53, 0x45, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x75, 0x6e,
-	0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
-	0x31, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x52, 0x61, 0x63, 0x6b, 0x4c, 0x53, 0x45,
-	0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x07, 0x72, 0x61, 0x63, 0x6b, 0x4c, 0x53, 0x45, 0x12, 0x1d,
-	0x0a, 0x0a, 0x72, 0x61, 0x63, 0x6b, 0x4c, 0x53, 0x45, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x09, 0x72, 0x61, 0x63, 0x6b, 0x4c, 0x53, 0x45, 0x49, 0x64, 0x22, 0x97, 0x01,
-	0x0a, 0x14, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x61, 0x63, 0x6b, 0x4c, 0x53, 0x45, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x42, 0x0a, 0x07, 0x72, 0x61, 0x63, 0x6b, 0x4c, 0x53,
-	0x45, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65,
-	0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f,
-	0x64, 0x65, 0x6c, 0x73, 0x2e, 0x52, 0x61, 0x63, 0x6b, 0x4c, 0x53, 0x45, 0x42, 0x03, 0xe0, 0x41,
-	0x02, 0x52, 0x07, 0x72, 0x61, 0x63, 0x6b, 0x4c, 0x53, 0x45, 0x12, 0x3b, 0x0a, 0x0b, 0x75, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61, 0x73, 0x6b, 0x52, 0x0a, 0x75, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x4d, 0x61, 0x73, 0x6b, 0x22, 0x59, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x52, 0x61,
-	0x63, 0x6b, 0x4c, 0x53, 0x45, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x44, 0x0a, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x30, 0xe0, 0x41, 0x02, 0xfa,
-	0x41, 0x2a, 0x0a, 0x28, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x2d, 0x66, 0x6c, 0x65, 0x65,
-	0x74, 0x2d, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x61, 0x70, 0x70, 0x73, 0x70, 0x6f, 0x74,
-	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x52, 0x61, 0x63, 0x6b, 0x4c, 0x53, 0x45, 0x52, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x22, 0x85, 0x01, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x61, 0x63, 0x6b, 0x4c,
-	0x53, 0x45, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61,
-	0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70,
-	0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f,
-	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67,
-	0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x1a,
-	0x0a, 0x08, 0x6b, 0x65, 0x79, 0x73, 0x4f, 0x6e, 0x6c, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x08, 0x6b, 0x65, 0x79, 0x73, 0x4f, 0x6e, 0x6c, 0x79, 0x22, 0x7f, 0x0a, 0x14, 0x4c, 0x69,
-	0x73, 0x74, 0x52, 0x61, 0x63, 0x6b, 0x4c, 0x53, 0x45, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x3f, 0x0a, 0x08, 0x72, 0x61, 0x63, 0x6b, 0x4c, 0x53, 0x45, 0x73, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c,
-	0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
-	0x73, 0x2e, 0x52, 0x61, 0x63, 0x6b, 0x4c, 0x53, 0x45, 0x52, 0x08, 0x72, 0x61, 0x63, 0x6b, 0x4c,
-	0x53, 0x45, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65,
-	0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65,
-	0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x5c, 0x0a, 0x14, 0x44,
-	0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x61, 0x63, 0x6b, 0x4c, 0x53, 0x45, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x44, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x30, 0xe0, 0x41, 0x02, 0xfa, 0x41, 0x2a, 0x0a, 0x28, 0x75, 0x6e, 0x69, 0x66, 0x69,
-	0x65, 0x64, 0x2d, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2d, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e,
-	0x61, 0x70, 0x70, 0x73, 0x70, 0x6f, 0x74, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x52, 0x61, 0x63, 0x6b,
-	0x4c, 0x53, 0x45, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x70, 0x0a, 0x10, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x4e, 0x69, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x36, 0x0a,
-	0x03, 0x6e, 0x69, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x75, 0x6e, 0x69,
-	0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31,
-	0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x4e, 0x69, 0x63, 0x42, 0x03, 0xe0, 0x41, 0x02,
-	0x52, 0x03, 0x6e, 0x69, 0x63, 0x12, 0x15, 0x0a, 0x06, 0x6e, 0x69, 0x63, 0x5f, 0x69, 0x64, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x69, 0x63, 0x49, 0x64, 0x4a, 0x04, 0x08, 0x03,
-	0x10, 0x04, 0x52, 0x07, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x22, 0x96, 0x01, 0x0a, 0x10,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4e, 0x69, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x36, 0x0a, 0x03, 0x6e, 0x69, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e,
-	0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69,
-	0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x4e, 0x69, 0x63, 0x42, 0x03,
-	0xe0, 0x41, 0x02, 0x52, 0x03, 0x6e, 0x69, 0x63, 0x12, 0x3b, 0x0a, 0x0b, 0x75, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x46, 0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61, 0x73, 0x6b, 0x52, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x4d, 0x61, 0x73, 0x6b, 0x4a, 0x04, 0x08, 0x03, 0x10, 0x04, 0x52, 0x07, 0x6d, 0x61, 0x63,
-	0x68, 0x69, 0x6e, 0x65, 0x22, 0x51, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x4e, 0x69, 0x63, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x40, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x2c, 0xe0, 0x41, 0x02, 0xfa, 0x41, 0x26, 0x0a, 0x24, 0x75, 0x6e, 0x69,
-	0x66, 0x69, 0x65, 0x64, 0x2d, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2d, 0x73, 0x79, 0x73, 0x74, 0x65,
-	0x6d, 0x2e, 0x61, 0x70, 0x70, 0x73, 0x70, 0x6f, 0x74, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x4e, 0x69,
-	0x63, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x81, 0x01, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74,
-	0x4e, 0x69, 0x63, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x70,
+	0x72, 0x63, 0x65, 0x22, 0x79, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x61, 0x63,
+	0x6b, 0x4c, 0x53, 0x45, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x42, 0x0a, 0x07, 0x72,
+	0x61, 0x63, 0x6b, 0x4c, 0x53, 0x45, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x75,
+	0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x52, 0x61, 0x63, 0x6b, 0x4c, 0x53,
+	0x45, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x07, 0x72, 0x61, 0x63, 0x6b, 0x4c, 0x53, 0x45, 0x12,
+	0x1d, 0x0a, 0x0a, 0x72, 0x61, 0x63, 0x6b, 0x4c, 0x53, 0x45, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x61, 0x63, 0x6b, 0x4c, 0x53, 0x45, 0x49, 0x64, 0x22, 0x97,
+	0x01, 0x0a, 0x14, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x61, 0x63, 0x6b, 0x4c, 0x53, 0x45,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x42, 0x0a, 0x07, 0x72, 0x61, 0x63, 0x6b, 0x4c,
+	0x53, 0x45, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69,
+	0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x52, 0x61, 0x63, 0x6b, 0x4c, 0x53, 0x45, 0x42, 0x03, 0xe0,
+	0x41, 0x02, 0x52, 0x07, 0x72, 0x61, 0x63, 0x6b, 0x4c, 0x53, 0x45, 0x12, 0x3b, 0x0a, 0x0b, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61, 0x73, 0x6b, 0x52, 0x0a, 0x75, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x73, 0x6b, 0x22, 0x59, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x52,
+	0x61, 0x63, 0x6b, 0x4c, 0x53, 0x45, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x44, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x30, 0xfa, 0x41, 0x2a,
+	0x0a, 0x28, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x2d, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2d,
+	0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x61, 0x70, 0x70, 0x73, 0x70, 0x6f, 0x74, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2f, 0x52, 0x61, 0x63, 0x6b, 0x4c, 0x53, 0x45, 0xe0, 0x41, 0x02, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x22, 0x85, 0x01, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x61, 0x63, 0x6b,
+	0x4c, 0x53, 0x45, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x70,
 	0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08,
 	0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65,
 	0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61,
 	0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65,
 	0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12,
 	0x1a, 0x0a, 0x08, 0x6b, 0x65, 0x79, 0x73, 0x4f, 0x6e, 0x6c, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x08, 0x6b, 0x65, 0x79, 0x73, 0x4f, 0x6e, 0x6c, 0x79, 0x22, 0x6f, 0x0a, 0x10, 0x4c,
-	0x69, 0x73, 0x74, 0x4e, 0x69, 0x63, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x33, 0x0a, 0x04, 0x6e, 0x69, 0x63, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e,
-	0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69,
-	0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x4e, 0x69, 0x63, 0x52, 0x04,
-	0x6e, 0x69, 0x63, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67,
+	0x08, 0x52, 0x08, 0x6b, 0x65, 0x79, 0x73, 0x4f, 0x6e, 0x6c, 0x79, 0x22, 0x7f, 0x0a, 0x14, 0x4c,
+	0x69, 0x73, 0x74, 0x52, 0x61, 0x63, 0x6b, 0x4c, 0x53, 0x45, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x3f, 0x0a, 0x08, 0x72, 0x61, 0x63, 0x6b, 0x4c, 0x53, 0x45, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66,
+	0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x64, 0x65,
+	0x6c, 0x73, 0x2e, 0x52, 0x61, 0x63, 0x6b, 0x4c, 0x53, 0x45, 0x52, 0x08, 0x72, 0x61, 0x63, 0x6b,
+	0x4c, 0x53, 0x45, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67,
 	0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e,
-	0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x54, 0x0a, 0x10,
-	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4e, 0x69, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x40, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2c,
-	0xe0, 0x41, 0x02, 0xfa, 0x41, 0x26, 0x0a, 0x24, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x2d,
-	0x66, 0x6c, 0x65, 0x65, 0x74, 0x2d, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x61, 0x70, 0x70,
-	0x73, 0x70, 0x6f, 0x74, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x4e, 0x69, 0x63, 0x52, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x22, 0xc3, 0x01, 0x0a, 0x11, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x4e, 0x69, 0x63,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x56, 0x0a, 0x11, 0x6d, 0x61, 0x63, 0x68,
-	0x69, 0x6e, 0x65, 0x5f, 0x64, 0x62, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65,
-	0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x4d, 0x61,
-	0x63, 0x68, 0x69, 0x6e, 0x65, 0x44, 0x42, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x48, 0x00, 0x52,
-	0x0f, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x44, 0x62, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65,
-	0x12, 0x4c, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65,
-	0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70,
-	0x63, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x48, 0x00,
-	0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x42, 0x08,
-	0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x22, 0x9d, 0x01, 0x0a, 0x10, 0x52, 0x65, 0x6e,
-	0x61, 0x6d, 0x65, 0x4e, 0x69, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x40, 0x0a,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2c, 0xe0, 0x41, 0x02,
-	0xfa, 0x41, 0x26, 0x0a, 0x24, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x2d, 0x66, 0x6c, 0x65,
-	0x65, 0x74, 0x2d, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x61, 0x70, 0x70, 0x73, 0x70, 0x6f,
-	0x74, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x4e, 0x69, 0x63, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
-	0x47, 0x0a, 0x08, 0x6e, 0x65, 0x77, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x2c, 0xe0, 0x41, 0x02, 0xfa, 0x41, 0x26, 0x0a, 0x24, 0x75, 0x6e, 0x69, 0x66, 0x69,
+	0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x5c, 0x0a, 0x14,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x61, 0x63, 0x6b, 0x4c, 0x53, 0x45, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x44, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x30, 0xfa, 0x41, 0x2a, 0x0a, 0x28, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64,
+	0x2d, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2d, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x61, 0x70,
+	0x70, 0x73, 0x70, 0x6f, 0x74, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x52, 0x61, 0x63, 0x6b, 0x4c, 0x53,
+	0x45, 0xe0, 0x41, 0x02, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x70, 0x0a, 0x10, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x4e, 0x69, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x36,
+	0x0a, 0x03, 0x6e, 0x69, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x75, 0x6e,
+	0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
+	0x31, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x4e, 0x69, 0x63, 0x42, 0x03, 0xe0, 0x41,
+	0x02, 0x52, 0x03, 0x6e, 0x69, 0x63, 0x12, 0x15, 0x0a, 0x06, 0x6e, 0x69, 0x63, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x69, 0x63, 0x49, 0x64, 0x4a, 0x04, 0x08,
+	0x03, 0x10, 0x04, 0x52, 0x07, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x22, 0x96, 0x01, 0x0a,
+	0x10, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4e, 0x69, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x36, 0x0a, 0x03, 0x6e, 0x69, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f,
+	0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x4e, 0x69, 0x63, 0x42,
+	0x03, 0xe0, 0x41, 0x02, 0x52, 0x03, 0x6e, 0x69, 0x63, 0x12, 0x3b, 0x0a, 0x0b, 0x75, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61, 0x73, 0x6b, 0x52, 0x0a, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x4d, 0x61, 0x73, 0x6b, 0x4a, 0x04, 0x08, 0x03, 0x10, 0x04, 0x52, 0x07, 0x6d, 0x61,
+	0x63, 0x68, 0x69, 0x6e, 0x65, 0x22, 0x51, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x4e, 0x69, 0x63, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x40, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x2c, 0xfa, 0x41, 0x26, 0x0a, 0x24, 0x75, 0x6e, 0x69, 0x66, 0x69,
 	0x65, 0x64, 0x2d, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2d, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e,
-	0x61, 0x70, 0x70, 0x73, 0x70, 0x6f, 0x74, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x4e, 0x69, 0x63, 0x52,
-	0x07, 0x6e, 0x65, 0x77, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0xa6, 0x01, 0x0a, 0x13, 0x52, 0x65, 0x6e,
-	0x61, 0x6d, 0x65, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x43, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2f,
-	0xe0, 0x41, 0x02, 0xfa, 0x41, 0x29, 0x0a, 0x27, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x2d,
-	0x66, 0x6c, 0x65, 0x65, 0x74, 0x2d, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x61, 0x70, 0x70,
-	0x73, 0x70, 0x6f, 0x74, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x52,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x4a, 0x0a, 0x08, 0x6e, 0x65, 0x77, 0x5f, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2f, 0xe0, 0x41, 0x02, 0xfa, 0x41, 0x29, 0x0a,
-	0x27, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x2d, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2d, 0x73,
-	0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x61, 0x70, 0x70, 0x73, 0x70, 0x6f, 0x74, 0x2e, 0x63, 0x6f,
-	0x6d, 0x2f, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x52, 0x07, 0x6e, 0x65, 0x77, 0x4e, 0x61, 0x6d,
-	0x65, 0x22, 0xca, 0x01, 0x0a, 0x18, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x44, 0x61, 0x74, 0x61,
-	0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x56,
-	0x0a, 0x11, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x5f, 0x64, 0x62, 0x5f, 0x73, 0x6f, 0x75,
-	0x72, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x75, 0x6e, 0x69, 0x66,
-	0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e,
-	0x72, 0x70, 0x63, 0x2e, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x44, 0x42, 0x53, 0x6f, 0x75,
-	0x72, 0x63, 0x65, 0x48, 0x00, 0x52, 0x0f, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x44, 0x62,
-	0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x4c, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e,
+	0x61, 0x70, 0x70, 0x73, 0x70, 0x6f, 0x74, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x4e, 0x69, 0x63, 0xe0,
+	0x41, 0x02, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x81, 0x01, 0x0a, 0x0f, 0x4c, 0x69, 0x73,
+	0x74, 0x4e, 0x69, 0x63, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09,
+	0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67,
+	0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70,
+	0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x12, 0x1a, 0x0a, 0x08, 0x6b, 0x65, 0x79, 0x73, 0x4f, 0x6e, 0x6c, 0x79, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x08, 0x6b, 0x65, 0x79, 0x73, 0x4f, 0x6e, 0x6c, 0x79, 0x22, 0x6f, 0x0a, 0x10,
+	0x4c, 0x69, 0x73, 0x74, 0x4e, 0x69, 0x63, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x33, 0x0a, 0x04, 0x6e, 0x69, 0x63, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f,
+	0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x4e, 0x69, 0x63, 0x52,
+	0x04, 0x6e, 0x69, 0x63, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61,
+	0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
+	0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x54, 0x0a,
+	0x10, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4e, 0x69, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x40, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x2c, 0xfa, 0x41, 0x26, 0x0a, 0x24, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x2d, 0x66, 0x6c,
+	0x65, 0x65, 0x74, 0x2d, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x61, 0x70, 0x70, 0x73, 0x70,
+	0x6f, 0x74, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x4e, 0x69, 0x63, 0xe0, 0x41, 0x02, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x22, 0xc3, 0x01, 0x0a, 0x11, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x4e, 0x69,
+	0x63, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x56, 0x0a, 0x11, 0x6d, 0x61, 0x63,
+	0x68, 0x69, 0x6e, 0x65, 0x5f, 0x64, 0x62, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c,
+	0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x4d,
+	0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x44, 0x42, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x48, 0x00,
+	0x52, 0x0f, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x44, 0x62, 0x53, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x12, 0x4c, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69,
+	0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72,
+	0x70, 0x63, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x48,
+	0x00, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x42,
+	0x08, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x22, 0x9d, 0x01, 0x0a, 0x10, 0x52, 0x65,
+	0x6e, 0x61, 0x6d, 0x65, 0x4e, 0x69, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x40,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2c, 0xfa, 0x41,
+	0x26, 0x0a, 0x24, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x2d, 0x66, 0x6c, 0x65, 0x65, 0x74,
+	0x2d, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x61, 0x70, 0x70, 0x73, 0x70, 0x6f, 0x74, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x4e, 0x69, 0x63, 0xe0, 0x41, 0x02, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x47, 0x0a, 0x08, 0x6e, 0x65, 0x77, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x2c, 0xfa, 0x41, 0x26, 0x0a, 0x24, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64,
+	0x2d, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2d, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x61, 0x70,
+	0x70, 0x73, 0x70, 0x6f, 0x74, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x4e, 0x69, 0x63, 0xe0, 0x41, 0x02,
+	0x52, 0x07, 0x6e, 0x65, 0x77, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0xa6, 0x01, 0x0a, 0x13, 0x52, 0x65,
+	0x6e, 0x61, 0x6d, 0x65, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x43, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x2f, 0xfa, 0x41, 0x29, 0x0a, 0x27, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x2d, 0x66, 0x6c,
+	0x65, 0x65, 0x74, 0x2d, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x61, 0x70, 0x70, 0x73, 0x70,
+	0x6f, 0x74, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0xe0, 0x41, 0x02,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x4a, 0x0a, 0x08, 0x6e, 0x65, 0x77, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2f, 0xfa, 0x41, 0x29, 0x0a, 0x27, 0x75,
+	0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x2d, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2d, 0x73, 0x79, 0x73,
+	0x74, 0x65, 0x6d, 0x2e, 0x61, 0x70, 0x70, 0x73, 0x70, 0x6f, 0x74, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0xe0, 0x41, 0x02, 0x52, 0x07, 0x6e, 0x65, 0x77, 0x4e, 0x61,
+	0x6d, 0x65, 0x22, 0xca, 0x01, 0x0a, 0x18, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x44, 0x61, 0x74,
+	0x61, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x56, 0x0a, 0x11, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x5f, 0x64, 0x62, 0x5f, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x75, 0x6e, 0x69,
+	0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31,
+	0x2e, 0x72, 0x70, 0x63, 0x2e, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x44, 0x42, 0x53, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x48, 0x00, 0x52, 0x0f, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x44,
+	0x62, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x4c, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25,
+	0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x53,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x48, 0x00, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x53,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x42, 0x08, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x22,
+	0x6d, 0x0a, 0x10, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4b, 0x56, 0x4d, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x36, 0x0a, 0x03, 0x4b, 0x56, 0x4d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1f, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x4b, 0x56,
+	0x4d, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x03, 0x4b, 0x56, 0x4d, 0x12, 0x15, 0x0a, 0x06, 0x4b,
+	0x56, 0x4d, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x4b, 0x56, 0x4d,
+	0x49, 0x64, 0x4a, 0x04, 0x08, 0x03, 0x10, 0x04, 0x52, 0x04, 0x72, 0x61, 0x63, 0x6b, 0x22, 0xe2,
+	0x01, 0x0a, 0x10, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4b, 0x56, 0x4d, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x36, 0x0a, 0x03, 0x4b, 0x56, 0x4d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1f, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x4b, 0x56,
+	0x4d, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x03, 0x4b, 0x56, 0x4d, 0x12, 0x3b, 0x0a, 0x0b, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61, 0x73, 0x6b, 0x52, 0x0a, 0x75, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x73, 0x6b, 0x12, 0x4d, 0x0a, 0x0e, 0x6e, 0x65, 0x74, 0x77,
+	0x6f, 0x72, 0x6b, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x26, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x4e, 0x65, 0x74, 0x77, 0x6f,
+	0x72, 0x6b, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72,
+	0x6b, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x4a, 0x04, 0x08, 0x03, 0x10, 0x04, 0x52, 0x04, 0x72,
+	0x61, 0x63, 0x6b, 0x22, 0x51, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x4b, 0x56, 0x4d, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x40, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x2c, 0xfa, 0x41, 0x26, 0x0a, 0x24, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64,
+	0x2d, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2d, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x61, 0x70,
+	0x70, 0x73, 0x70, 0x6f, 0x74, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x4b, 0x56, 0x4d, 0xe0, 0x41, 0x02,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x81, 0x01, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x4b,
+	0x56, 0x4d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61,
+	0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70,
+	0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67,
+	0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x1a,
+	0x0a, 0x08, 0x6b, 0x65, 0x79, 0x73, 0x4f, 0x6e, 0x6c, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x08, 0x6b, 0x65, 0x79, 0x73, 0x4f, 0x6e, 0x6c, 0x79, 0x22, 0x6f, 0x0a, 0x10, 0x4c, 0x69,
+	0x73, 0x74, 0x4b, 0x56, 0x4d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33,
+	0x0a, 0x04, 0x4b, 0x56, 0x4d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x75,
+	0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x4b, 0x56, 0x4d, 0x52, 0x04, 0x4b,
+	0x56, 0x4d, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65,
+	0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65,
+	0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x54, 0x0a, 0x10, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x4b, 0x56, 0x4d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x40, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2c, 0xfa,
+	0x41, 0x26, 0x0a, 0x24, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x2d, 0x66, 0x6c, 0x65, 0x65,
+	0x74, 0x2d, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x61, 0x70, 0x70, 0x73, 0x70, 0x6f, 0x74,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x4b, 0x56, 0x4d, 0xe0, 0x41, 0x02, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x22, 0x61, 0x0a, 0x10, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x50, 0x4d, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x36, 0x0a, 0x03, 0x52, 0x50, 0x4d, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65,
+	0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e,
+	0x52, 0x50, 0x4d, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x03, 0x52, 0x50, 0x4d, 0x12, 0x15, 0x0a,
+	0x06, 0x52, 0x50, 0x4d, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x52,
+	0x50, 0x4d, 0x49, 0x64, 0x22, 0xd6, 0x01, 0x0a, 0x10, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52,
+	0x50, 0x4d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x36, 0x0a, 0x03, 0x52, 0x50, 0x4d,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64,
+	0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x64,
+	0x65, 0x6c, 0x73, 0x2e, 0x52, 0x50, 0x4d, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x03, 0x52, 0x50,
+	0x4d, 0x12, 0x3b, 0x0a, 0x0b, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x73, 0x6b,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61,
+	0x73, 0x6b, 0x52, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x73, 0x6b, 0x12, 0x4d,
+	0x0a, 0x0e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64,
+	0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63,
+	0x2e, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d,
+	0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x51, 0x0a,
+	0x0d, 0x47, 0x65, 0x74, 0x52, 0x50, 0x4d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x40,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2c, 0xfa, 0x41,
+	0x26, 0x0a, 0x24, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x2d, 0x66, 0x6c, 0x65, 0x65, 0x74,
+	0x2d, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x61, 0x70, 0x70, 0x73, 0x70, 0x6f, 0x74, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x52, 0x50, 0x4d, 0xe0, 0x41, 0x02, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x22, 0x81, 0x01, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x50, 0x4d, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a,
+	0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x12, 0x16, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x6b, 0x65, 0x79, 0x73,
+	0x4f, 0x6e, 0x6c, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x6b, 0x65, 0x79, 0x73,
+	0x4f, 0x6e, 0x6c, 0x79, 0x22, 0x6f, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x50, 0x4d, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x04, 0x52, 0x50, 0x4d, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64,
+	0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x64,
+	0x65, 0x6c, 0x73, 0x2e, 0x52, 0x50, 0x4d, 0x52, 0x04, 0x52, 0x50, 0x4d, 0x73, 0x12, 0x26, 0x0a,
+	0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x54, 0x0a, 0x10, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52,
+	0x50, 0x4d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x40, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2c, 0xfa, 0x41, 0x26, 0x0a, 0x24, 0x75, 0x6e,
+	0x69, 0x66, 0x69, 0x65, 0x64, 0x2d, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2d, 0x73, 0x79, 0x73, 0x74,
+	0x65, 0x6d, 0x2e, 0x61, 0x70, 0x70, 0x73, 0x70, 0x6f, 0x74, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x52,
+	0x50, 0x4d, 0xe0, 0x41, 0x02, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0xc5, 0x01, 0x0a, 0x11,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x72, 0x61, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x39, 0x0a, 0x04, 0x64, 0x72, 0x61, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x20, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x44, 0x72, 0x61,
+	0x63, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x04, 0x64, 0x72, 0x61, 0x63, 0x12, 0x17, 0x0a, 0x07,
+	0x64, 0x72, 0x61, 0x63, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64,
+	0x72, 0x61, 0x63, 0x49, 0x64, 0x12, 0x4d, 0x0a, 0x0e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
+	0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e,
 	0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69,
-	0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x53, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x48, 0x00, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x53, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x42, 0x08, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x22, 0x6d,
-	0x0a, 0x10, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4b, 0x56, 0x4d, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x36, 0x0a, 0x03, 0x4b, 0x56, 0x4d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1f, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61,
-	0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x4b, 0x56, 0x4d,
-	0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x03, 0x4b, 0x56, 0x4d, 0x12, 0x15, 0x0a, 0x06, 0x4b, 0x56,
-	0x4d, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x4b, 0x56, 0x4d, 0x49,
-	0x64, 0x4a, 0x04, 0x08, 0x03, 0x10, 0x04, 0x52, 0x04, 0x72, 0x61, 0x63, 0x6b, 0x22, 0xe2, 0x01,
-	0x0a, 0x10, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4b, 0x56, 0x4d, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x36, 0x0a, 0x03, 0x4b, 0x56, 0x4d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1f, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61,
-	0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x4b, 0x56, 0x4d,
-	0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x03, 0x4b, 0x56, 0x4d, 0x12, 0x3b, 0x0a, 0x0b, 0x75, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61, 0x73, 0x6b, 0x52, 0x0a, 0x75, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x4d, 0x61, 0x73, 0x6b, 0x12, 0x4d, 0x0a, 0x0e, 0x6e, 0x65, 0x74, 0x77, 0x6f,
-	0x72, 0x6b, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x26, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61,
-	0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72,
-	0x6b, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
-	0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x4a, 0x04, 0x08, 0x03, 0x10, 0x04, 0x52, 0x04, 0x72, 0x61,
-	0x63, 0x6b, 0x22, 0x51, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x4b, 0x56, 0x4d, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x40, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x2c, 0xe0, 0x41, 0x02, 0xfa, 0x41, 0x26, 0x0a, 0x24, 0x75, 0x6e, 0x69, 0x66, 0x69,
-	0x65, 0x64, 0x2d, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2d, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e,
-	0x61, 0x70, 0x70, 0x73, 0x70, 0x6f, 0x74, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x4b, 0x56, 0x4d, 0x52,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x81, 0x01, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x4b, 0x56,
-	0x4d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67,
+	0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x4f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x4f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x4a, 0x04, 0x08, 0x03, 0x10, 0x04, 0x52, 0x07, 0x6d, 0x61, 0x63, 0x68,
+	0x69, 0x6e, 0x65, 0x22, 0xe9, 0x01, 0x0a, 0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x72,
+	0x61, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x39, 0x0a, 0x04, 0x64, 0x72, 0x61,
+	0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65,
+	0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f,
+	0x64, 0x65, 0x6c, 0x73, 0x2e, 0x44, 0x72, 0x61, 0x63, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x04,
+	0x64, 0x72, 0x61, 0x63, 0x12, 0x3b, 0x0a, 0x0b, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x6d,
+	0x61, 0x73, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c,
+	0x64, 0x4d, 0x61, 0x73, 0x6b, 0x52, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x73,
+	0x6b, 0x12, 0x4d, 0x0a, 0x0e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x6f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x75, 0x6e, 0x69, 0x66,
+	0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e,
+	0x72, 0x70, 0x63, 0x2e, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x4f, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x0d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x4a, 0x04, 0x08, 0x03, 0x10, 0x04, 0x52, 0x07, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x22,
+	0x53, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x44, 0x72, 0x61, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x41, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x2d, 0xfa, 0x41, 0x27, 0x0a, 0x25, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x2d, 0x66, 0x6c,
+	0x65, 0x65, 0x74, 0x2d, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x61, 0x70, 0x70, 0x73, 0x70,
+	0x6f, 0x74, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x44, 0x72, 0x61, 0x63, 0xe0, 0x41, 0x02, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x22, 0x82, 0x01, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x72, 0x61,
+	0x63, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67,
 	0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61,
 	0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74,
 	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65,
 	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18,
 	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x1a, 0x0a,
 	0x08, 0x6b, 0x65, 0x79, 0x73, 0x4f, 0x6e, 0x6c, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x08, 0x6b, 0x65, 0x79, 0x73, 0x4f, 0x6e, 0x6c, 0x79, 0x22, 0x6f, 0x0a, 0x10, 0x4c, 0x69, 0x73,
-	0x74, 0x4b, 0x56, 0x4d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a,
-	0x04, 0x4b, 0x56, 0x4d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x75, 0x6e,
-	0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
-	0x31, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x4b, 0x56, 0x4d, 0x52, 0x04, 0x4b, 0x56,
-	0x4d, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f,
+	0x08, 0x6b, 0x65, 0x79, 0x73, 0x4f, 0x6e, 0x6c, 0x79, 0x22, 0x73, 0x0a, 0x11, 0x4c, 0x69, 0x73,
+	0x74, 0x44, 0x72, 0x61, 0x63, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36,
+	0x0a, 0x05, 0x64, 0x72, 0x61, 0x63, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e,
+	0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x44, 0x72, 0x61, 0x63, 0x52,
+	0x05, 0x64, 0x72, 0x61, 0x63, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70,
+	0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x56,
+	0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x44, 0x72, 0x61, 0x63, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x41, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x2d, 0xfa, 0x41, 0x27, 0x0a, 0x25, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x2d,
+	0x66, 0x6c, 0x65, 0x65, 0x74, 0x2d, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x61, 0x70, 0x70,
+	0x73, 0x70, 0x6f, 0x74, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x44, 0x72, 0x61, 0x63, 0xe0, 0x41, 0x02,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x7f, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3f, 0x0a,
+	0x06, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e,
+	0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x53, 0x77, 0x69, 0x74, 0x63,
+	0x68, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x06, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x12, 0x1b,
+	0x0a, 0x09, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x49, 0x64, 0x4a, 0x04, 0x08, 0x03, 0x10,
+	0x04, 0x52, 0x04, 0x72, 0x61, 0x63, 0x6b, 0x22, 0x9f, 0x01, 0x0a, 0x13, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x3f, 0x0a, 0x06, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x22, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x53, 0x77, 0x69,
+	0x74, 0x63, 0x68, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x06, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68,
+	0x12, 0x3b, 0x0a, 0x0b, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61, 0x73,
+	0x6b, 0x52, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x73, 0x6b, 0x4a, 0x04, 0x08,
+	0x03, 0x10, 0x04, 0x52, 0x04, 0x72, 0x61, 0x63, 0x6b, 0x22, 0x57, 0x0a, 0x10, 0x47, 0x65, 0x74,
+	0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x43, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2f, 0xfa, 0x41, 0x29,
+	0x0a, 0x27, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x2d, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2d,
+	0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x61, 0x70, 0x70, 0x73, 0x70, 0x6f, 0x74, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2f, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0xe0, 0x41, 0x02, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x22, 0x85, 0x01, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x77, 0x69, 0x74, 0x63,
+	0x68, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61,
+	0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70,
+	0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67,
+	0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x1a,
+	0x0a, 0x08, 0x6b, 0x65, 0x79, 0x73, 0x4f, 0x6e, 0x6c, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x08, 0x6b, 0x65, 0x79, 0x73, 0x4f, 0x6e, 0x6c, 0x79, 0x22, 0x7e, 0x0a, 0x14, 0x4c, 0x69,
+	0x73, 0x74, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x3e, 0x0a, 0x08, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c,
+	0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
+	0x73, 0x2e, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x52, 0x08, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68,
+	0x65, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f,
 	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78,
-	0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x54, 0x0a, 0x10, 0x44, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x4b, 0x56, 0x4d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x40,
-	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2c, 0xe0, 0x41,
-	0x02, 0xfa, 0x41, 0x26, 0x0a, 0x24, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x2d, 0x66, 0x6c,
+	0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x5a, 0x0a, 0x13, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x43, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x2f, 0xfa, 0x41, 0x29, 0x0a, 0x27, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x2d, 0x66, 0x6c,
 	0x65, 0x65, 0x74, 0x2d, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x61, 0x70, 0x70, 0x73, 0x70,
-	0x6f, 0x74, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x4b, 0x56, 0x4d, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x22, 0x61, 0x0a, 0x10, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x50, 0x4d, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x36, 0x0a, 0x03, 0x52, 0x50, 0x4d, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1f, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74,
-	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x52,
-	0x50, 0x4d, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x03, 0x52, 0x50, 0x4d, 0x12, 0x15, 0x0a, 0x06,
-	0x52, 0x50, 0x4d, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x52, 0x50,
-	0x4d, 0x49, 0x64, 0x22, 0xd6, 0x01, 0x0a, 0x10, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x50,
-	0x4d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x36, 0x0a, 0x03, 0x52, 0x50, 0x4d, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66,
-	0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x64, 0x65,
-	0x6c, 0x73, 0x2e, 0x52, 0x50, 0x4d, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x03, 0x52, 0x50, 0x4d,
+	0x6f, 0x74, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0xe0, 0x41, 0x02,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x67, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x56, 0x6c, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x39, 0x0a, 0x04, 0x76,
+	0x6c, 0x61, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x75, 0x6e, 0x69, 0x66,
+	0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x56, 0x6c, 0x61, 0x6e, 0x42, 0x03, 0xe0, 0x41, 0x02,
+	0x52, 0x04, 0x76, 0x6c, 0x61, 0x6e, 0x12, 0x17, 0x0a, 0x07, 0x76, 0x6c, 0x61, 0x6e, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x76, 0x6c, 0x61, 0x6e, 0x49, 0x64, 0x22,
+	0x98, 0x01, 0x0a, 0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x56, 0x6c, 0x61, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x39, 0x0a, 0x04, 0x76, 0x6c, 0x61, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65,
+	0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73,
+	0x2e, 0x56, 0x6c, 0x61, 0x6e, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x04, 0x76, 0x6c, 0x61, 0x6e,
 	0x12, 0x3b, 0x0a, 0x0b, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x18,
 	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
 	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61, 0x73,
-	0x6b, 0x52, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x73, 0x6b, 0x12, 0x4d, 0x0a,
-	0x0e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66,
-	0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e,
-	0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x6e,
-	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x51, 0x0a, 0x0d,
-	0x47, 0x65, 0x74, 0x52, 0x50, 0x4d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x40, 0x0a,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2c, 0xe0, 0x41, 0x02,
-	0xfa, 0x41, 0x26, 0x0a, 0x24, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x2d, 0x66, 0x6c, 0x65,
-	0x65, 0x74, 0x2d, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x61, 0x70, 0x70, 0x73, 0x70, 0x6f,
-	0x74, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x52, 0x50, 0x4d, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22,
-	0x81, 0x01, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x50, 0x4d, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65,
-	0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12,
-	0x16, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x6b, 0x65, 0x79, 0x73, 0x4f,
-	0x6e, 0x6c, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x6b, 0x65, 0x79, 0x73, 0x4f,
-	0x6e, 0x6c, 0x79, 0x22, 0x6f, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x50, 0x4d, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x04, 0x52, 0x50, 0x4d, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66,
-	0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x64, 0x65,
-	0x6c, 0x73, 0x2e, 0x52, 0x50, 0x4d, 0x52, 0x04, 0x52, 0x50, 0x4d, 0x73, 0x12, 0x26, 0x0a, 0x0f,
-	0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54,
-	0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x54, 0x0a, 0x10, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x50,
-	0x4d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x40, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2c, 0xe0, 0x41, 0x02, 0xfa, 0x41, 0x26, 0x0a, 0x24,
-	0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x2d, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2d, 0x73, 0x79,
-	0x73, 0x74, 0x65, 0x6d, 0x2e, 0x61, 0x70, 0x70, 0x73, 0x70, 0x6f, 0x74, 0x2e, 0x63, 0x6f, 0x6d,
-	0x2f, 0x52, 0x50, 0x4d, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0xc5, 0x01, 0x0a, 0x11, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x72, 0x61, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x39, 0x0a, 0x04, 0x64, 0x72, 0x61, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20,
-	0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70,
-	0x69, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x44, 0x72, 0x61, 0x63,
-	0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x04, 0x64, 0x72, 0x61, 0x63, 0x12, 0x17, 0x0a, 0x07, 0x64,
-	0x72, 0x61, 0x63, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x72,
-	0x61, 0x63, 0x49, 0x64, 0x12, 0x4d, 0x0a, 0x0e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x5f,
-	0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x75,
-	0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e,
-	0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x4f, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x4f, 0x70, 0x74,
-	0x69, 0x6f, 0x6e, 0x4a, 0x04, 0x08, 0x03, 0x10, 0x04, 0x52, 0x07, 0x6d, 0x61, 0x63, 0x68, 0x69,
-	0x6e, 0x65, 0x22, 0xe9, 0x01, 0x0a, 0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x72, 0x61,
-	0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x39, 0x0a, 0x04, 0x64, 0x72, 0x61, 0x63,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64,
-	0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x64,
-	0x65, 0x6c, 0x73, 0x2e, 0x44, 0x72, 0x61, 0x63, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x04, 0x64,
-	0x72, 0x61, 0x63, 0x12, 0x3b, 0x0a, 0x0b, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61,
-	0x73, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64,
-	0x4d, 0x61, 0x73, 0x6b, 0x52, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x73, 0x6b,
-	0x12, 0x4d, 0x0a, 0x0e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x6f, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69,
-	0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72,
-	0x70, 0x63, 0x2e, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x52, 0x0d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x4a,
-	0x04, 0x08, 0x03, 0x10, 0x04, 0x52, 0x07, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x22, 0x53,
-	0x0a, 0x0e, 0x47, 0x65, 0x74, 0x44, 0x72, 0x61, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x41, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2d,
-	0xe0, 0x41, 0x02, 0xfa, 0x41, 0x27, 0x0a, 0x25, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x2d,
-	0x66, 0x6c, 0x65, 0x65, 0x74, 0x2d, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x61, 0x70, 0x70,
-	0x73, 0x70, 0x6f, 0x74, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x44, 0x72, 0x61, 0x63, 0x52, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x22, 0x82, 0x01, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x72, 0x61, 0x63,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65,
-	0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67,
-	0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f,
-	0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54,
-	0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08,
-	0x6b, 0x65, 0x79, 0x73, 0x4f, 0x6e, 0x6c, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08,
-	0x6b, 0x65, 0x79, 0x73, 0x4f, 0x6e, 0x6c, 0x79, 0x22, 0x73, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74,
-	0x44, 0x72, 0x61, 0x63, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a,
-	0x05, 0x64, 0x72, 0x61, 0x63, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x75,
-	0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e,
-	0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x44, 0x72, 0x61, 0x63, 0x52, 0x05,
-	0x64, 0x72, 0x61, 0x63, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61,
-	0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
-	0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x56, 0x0a,
-	0x11, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x44, 0x72, 0x61, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x41, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x42, 0x2d, 0xe0, 0x41, 0x02, 0xfa, 0x41, 0x27, 0x0a, 0x25, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65,
-	0x64, 0x2d, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2d, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x61,
-	0x70, 0x70, 0x73, 0x70, 0x6f, 0x74, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x44, 0x72, 0x61, 0x63, 0x52,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x7f, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53,
-	0x77, 0x69, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3f, 0x0a, 0x06,
-	0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x75,
-	0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e,
-	0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68,
-	0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x06, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x12, 0x1b, 0x0a,
-	0x09, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x08, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x49, 0x64, 0x4a, 0x04, 0x08, 0x03, 0x10, 0x04,
-	0x52, 0x04, 0x72, 0x61, 0x63, 0x6b, 0x22, 0x9f, 0x01, 0x0a, 0x13, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3f,
-	0x0a, 0x06, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22,
-	0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70,
-	0x69, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x53, 0x77, 0x69, 0x74,
-	0x63, 0x68, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x06, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x12,
-	0x3b, 0x0a, 0x0b, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61, 0x73, 0x6b,
-	0x52, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x73, 0x6b, 0x4a, 0x04, 0x08, 0x03,
-	0x10, 0x04, 0x52, 0x04, 0x72, 0x61, 0x63, 0x6b, 0x22, 0x57, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x53,
-	0x77, 0x69, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x43, 0x0a, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2f, 0xe0, 0x41, 0x02, 0xfa,
-	0x41, 0x29, 0x0a, 0x27, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x2d, 0x66, 0x6c, 0x65, 0x65,
-	0x74, 0x2d, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x61, 0x70, 0x70, 0x73, 0x70, 0x6f, 0x74,
-	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x52, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x22, 0x85, 0x01, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68,
-	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67,
-	0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61,
-	0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74,
-	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65,
-	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x1a, 0x0a,
-	0x08, 0x6b, 0x65, 0x79, 0x73, 0x4f, 0x6e, 0x6c, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x08, 0x6b, 0x65, 0x79, 0x73, 0x4f, 0x6e, 0x6c, 0x79, 0x22, 0x7e, 0x0a, 0x14, 0x4c, 0x69, 0x73,
-	0x74, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x3e, 0x0a, 0x08, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x65, 0x73, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65,
-	0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73,
-	0x2e, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x52, 0x08, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x65,
+	0x6b, 0x52, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x73, 0x6b, 0x4a, 0x04, 0x08,
+	0x03, 0x10, 0x04, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x22, 0x53, 0x0a, 0x0e, 0x47, 0x65,
+	0x74, 0x56, 0x6c, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x41, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2d, 0xfa, 0x41, 0x27, 0x0a,
+	0x25, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x2d, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2d, 0x73,
+	0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x61, 0x70, 0x70, 0x73, 0x70, 0x6f, 0x74, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x56, 0x6c, 0x61, 0x6e, 0xe0, 0x41, 0x02, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22,
+	0x82, 0x01, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x56, 0x6c, 0x61, 0x6e, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a,
+	0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x12, 0x16, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x6b, 0x65, 0x79, 0x73,
+	0x4f, 0x6e, 0x6c, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x6b, 0x65, 0x79, 0x73,
+	0x4f, 0x6e, 0x6c, 0x79, 0x22, 0x73, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x56, 0x6c, 0x61, 0x6e,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x05, 0x76, 0x6c, 0x61,
+	0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69,
+	0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x56, 0x6c, 0x61, 0x6e, 0x52, 0x05, 0x76, 0x6c, 0x61, 0x6e,
 	0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74,
 	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74,
-	0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x5a, 0x0a, 0x13, 0x44, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x43, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2f,
-	0xe0, 0x41, 0x02, 0xfa, 0x41, 0x29, 0x0a, 0x27, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x2d,
-	0x66, 0x6c, 0x65, 0x65, 0x74, 0x2d, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x61, 0x70, 0x70,
-	0x73, 0x70, 0x6f, 0x74, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x52,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x67, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56,
-	0x6c, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x39, 0x0a, 0x04, 0x76, 0x6c,
-	0x61, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69,
-	0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x6d,
-	0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x56, 0x6c, 0x61, 0x6e, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52,
-	0x04, 0x76, 0x6c, 0x61, 0x6e, 0x12, 0x17, 0x0a, 0x07, 0x76, 0x6c, 0x61, 0x6e, 0x5f, 0x69, 0x64,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x76, 0x6c, 0x61, 0x6e, 0x49, 0x64, 0x22, 0x98,
-	0x01, 0x0a, 0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x56, 0x6c, 0x61, 0x6e, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x39, 0x0a, 0x04, 0x76, 0x6c, 0x61, 0x6e, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x20, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65,
-	0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e,
-	0x56, 0x6c, 0x61, 0x6e, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x04, 0x76, 0x6c, 0x61, 0x6e, 0x12,
-	0x3b, 0x0a, 0x0b, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61, 0x73, 0x6b,
-	0x52, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x73, 0x6b, 0x4a, 0x04, 0x08, 0x03,
-	0x10, 0x04, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x22, 0x53, 0x0a, 0x0e, 0x47, 0x65, 0x74,
-	0x56, 0x6c, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x41, 0x0a, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2d, 0xe0, 0x41, 0x02, 0xfa, 0x41,
+	0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x56, 0x0a, 0x11, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x56, 0x6c, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x41,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2d, 0xfa, 0x41,
 	0x27, 0x0a, 0x25, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x2d, 0x66, 0x6c, 0x65, 0x65, 0x74,
 	0x2d, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x61, 0x70, 0x70, 0x73, 0x70, 0x6f, 0x74, 0x2e,
-	0x63, 0x6f, 0x6d, 0x2f, 0x56, 0x6c, 0x61, 0x6e, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x82,
-	0x01, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x56, 0x6c, 0x61, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65,
-	0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12,
-	0x16, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x6b, 0x65, 0x79, 0x73, 0x4f,
-	0x6e, 0x6c, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x6b, 0x65, 0x79, 0x73, 0x4f,
-	0x6e, 0x6c, 0x79, 0x22, 0x73, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x56, 0x6c, 0x61, 0x6e, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x05, 0x76, 0x6c, 0x61, 0x6e,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65,
-	0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f,
-	0x64, 0x65, 0x6c, 0x73, 0x2e, 0x56, 0x6c, 0x61, 0x6e, 0x52, 0x05, 0x76, 0x6c, 0x61, 0x6e, 0x73,
-	0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f,
-	0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50,
-	0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x56, 0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x56, 0x6c, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x41, 0x0a,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2d, 0xe0, 0x41, 0x02,
-	0xfa, 0x41, 0x27, 0x0a, 0x25, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x2d, 0x66, 0x6c, 0x65,
-	0x65, 0x74, 0x2d, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x61, 0x70, 0x70, 0x73, 0x70, 0x6f,
-	0x74, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x56, 0x6c, 0x61, 0x6e, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x22, 0xc4, 0x01, 0x0a, 0x12, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x56, 0x6c, 0x61, 0x6e, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x56, 0x0a, 0x11, 0x6d, 0x61, 0x63, 0x68, 0x69,
-	0x6e, 0x65, 0x5f, 0x64, 0x62, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x28, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65,
-	0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x4d, 0x61, 0x63,
-	0x68, 0x69, 0x6e, 0x65, 0x44, 0x42, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x48, 0x00, 0x52, 0x0f,
-	0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x44, 0x62, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12,
-	0x4c, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64,
-	0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63,
-	0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x48, 0x00, 0x52,
-	0x0c, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x42, 0x08, 0x0a,
-	0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x22, 0xc6, 0x01, 0x0a, 0x14, 0x49, 0x6d, 0x70, 0x6f,
-	0x72, 0x74, 0x4f, 0x53, 0x56, 0x6c, 0x61, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x56, 0x0a, 0x11, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x5f, 0x64, 0x62, 0x5f, 0x73,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x75, 0x6e,
-	0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
-	0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x44, 0x42, 0x53,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x48, 0x00, 0x52, 0x0f, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65,
-	0x44, 0x62, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x4c, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x25, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61,
-	0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x48, 0x00, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x42, 0x08, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
-	0x22, 0xc5, 0x01, 0x0a, 0x13, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x63, 0x6f, 0x6d, 0x2f, 0x56, 0x6c, 0x61, 0x6e, 0xe0, 0x41, 0x02, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x22, 0xc4, 0x01, 0x0a, 0x12, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x56, 0x6c, 0x61, 0x6e,
 	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x56, 0x0a, 0x11, 0x6d, 0x61, 0x63, 0x68,
 	0x69, 0x6e, 0x65, 0x5f, 0x64, 0x62, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x01, 0x20,
 	0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65,
@@ -11333,1257 +11467,1296 @@ var file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDesc = []byte{
 	0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70,
 	0x63, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x48, 0x00,
 	0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x42, 0x08,
-	0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x22, 0x3b, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x53,
-	0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x0d, 0x72,
-	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
-	0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x5e, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x44, 0x75, 0x74, 0x53,
-	0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x12, 0x63,
-	0x68, 0x72, 0x6f, 0x6d, 0x65, 0x6f, 0x73, 0x5f, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x65, 0x6f,
-	0x73, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x68, 0x6f, 0x73,
-	0x74, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x68, 0x6f, 0x73,
-	0x74, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x86, 0x01, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x75,
-	0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b,
-	0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70,
-	0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x69,
-	0x6c, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74,
-	0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x6b, 0x65, 0x79, 0x73, 0x4f, 0x6e, 0x6c, 0x79, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x6b, 0x65, 0x79, 0x73, 0x4f, 0x6e, 0x6c, 0x79, 0x22, 0x91,
-	0x01, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x75, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x50, 0x0a, 0x0a, 0x64, 0x75, 0x74, 0x5f,
-	0x73, 0x74, 0x61, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x75,
+	0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x22, 0xc6, 0x01, 0x0a, 0x14, 0x49, 0x6d, 0x70,
+	0x6f, 0x72, 0x74, 0x4f, 0x53, 0x56, 0x6c, 0x61, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x56, 0x0a, 0x11, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x5f, 0x64, 0x62, 0x5f,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x75,
 	0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e,
-	0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x65,
-	0x6f, 0x73, 0x2e, 0x6c, 0x61, 0x62, 0x2e, 0x44, 0x75, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52,
-	0x09, 0x64, 0x75, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65,
-	0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b,
-	0x65, 0x6e, 0x22, 0x95, 0x01, 0x0a, 0x12, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x74, 0x61,
-	0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x42, 0x0a, 0x05, 0x73, 0x74, 0x61,
-	0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69,
+	0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x44, 0x42,
+	0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x48, 0x00, 0x52, 0x0f, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e,
+	0x65, 0x44, 0x62, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x4c, 0x0a, 0x0d, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x25, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x48, 0x00, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x42, 0x08, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x22, 0xc5, 0x01, 0x0a, 0x13, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x56, 0x0a, 0x11, 0x6d, 0x61, 0x63,
+	0x68, 0x69, 0x6e, 0x65, 0x5f, 0x64, 0x62, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c,
+	0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x4d,
+	0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x44, 0x42, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x48, 0x00,
+	0x52, 0x0f, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x44, 0x62, 0x53, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x12, 0x4c, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69,
+	0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72,
+	0x70, 0x63, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x48,
+	0x00, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x42,
+	0x08, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x22, 0x3b, 0x0a, 0x0f, 0x47, 0x65, 0x74,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x0d,
+	0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x5e, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x44, 0x75, 0x74,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x12,
+	0x63, 0x68, 0x72, 0x6f, 0x6d, 0x65, 0x6f, 0x73, 0x5f, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x65,
+	0x6f, 0x73, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x68, 0x6f,
+	0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x68, 0x6f,
+	0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x86, 0x01, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x44,
+	0x75, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a,
+	0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x66,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x6b, 0x65, 0x79, 0x73, 0x4f, 0x6e, 0x6c, 0x79, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x6b, 0x65, 0x79, 0x73, 0x4f, 0x6e, 0x6c, 0x79, 0x22,
+	0x91, 0x01, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x75, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x50, 0x0a, 0x0a, 0x64, 0x75, 0x74,
+	0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x31, 0x2e,
+	0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d,
+	0x65, 0x6f, 0x73, 0x2e, 0x6c, 0x61, 0x62, 0x2e, 0x44, 0x75, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x52, 0x09, 0x64, 0x75, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e,
+	0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x22, 0x95, 0x01, 0x0a, 0x12, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x42, 0x0a, 0x05, 0x73, 0x74,
+	0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x75, 0x6e, 0x69, 0x66,
+	0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x63, 0x6f,
+	0x72, 0x64, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x3b,
+	0x0a, 0x0b, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61, 0x73, 0x6b, 0x52,
+	0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x73, 0x6b, 0x22, 0xa9, 0x02, 0x0a, 0x15,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x75, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x53, 0x0a, 0x09, 0x64, 0x75, 0x74, 0x5f, 0x73, 0x74, 0x61,
+	0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69,
 	0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x6d,
-	0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x72,
-	0x64, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x3b, 0x0a,
+	0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x65, 0x6f, 0x73, 0x2e, 0x6c,
+	0x61, 0x62, 0x2e, 0x44, 0x75, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x42, 0x03, 0xe0, 0x41, 0x02,
+	0x52, 0x08, 0x64, 0x75, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x3b, 0x0a, 0x0b, 0x75, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61, 0x73, 0x6b, 0x52, 0x0a, 0x75, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x4d, 0x61, 0x73, 0x6b, 0x12, 0x3e, 0x0a, 0x08, 0x64, 0x75, 0x74, 0x5f, 0x6d,
+	0x65, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x75, 0x6e, 0x69, 0x66,
+	0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x44, 0x75, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x52, 0x07,
+	0x64, 0x75, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x12, 0x3e, 0x0a, 0x08, 0x6c, 0x61, 0x62, 0x5f, 0x6d,
+	0x65, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x75, 0x6e, 0x69, 0x66,
+	0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x4c, 0x61, 0x62, 0x4d, 0x65, 0x74, 0x61, 0x52, 0x07,
+	0x6c, 0x61, 0x62, 0x4d, 0x65, 0x74, 0x61, 0x22, 0x54, 0x0a, 0x17, 0x52, 0x61, 0x63, 0x6b, 0x52,
+	0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x39, 0x0a, 0x04, 0x72, 0x61, 0x63, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x20, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x52, 0x61,
+	0x63, 0x6b, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x04, 0x72, 0x61, 0x63, 0x6b, 0x22, 0xb4, 0x01,
+	0x0a, 0x0d, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x3e, 0x0a, 0x04, 0x76, 0x6c, 0x61, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2a, 0xfa,
+	0x41, 0x27, 0x0a, 0x25, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x2d, 0x66, 0x6c, 0x65, 0x65,
+	0x74, 0x2d, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x61, 0x70, 0x70, 0x73, 0x70, 0x6f, 0x74,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x56, 0x6c, 0x61, 0x6e, 0x52, 0x04, 0x76, 0x6c, 0x61, 0x6e, 0x12,
+	0x3b, 0x0a, 0x03, 0x6e, 0x69, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x29, 0xfa, 0x41,
+	0x26, 0x0a, 0x24, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x2d, 0x66, 0x6c, 0x65, 0x65, 0x74,
+	0x2d, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x61, 0x70, 0x70, 0x73, 0x70, 0x6f, 0x74, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x4e, 0x69, 0x63, 0x52, 0x03, 0x6e, 0x69, 0x63, 0x12, 0x16, 0x0a, 0x06,
+	0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x64, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x69, 0x70, 0x22, 0x52, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x73,
+	0x73, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3c, 0x0a, 0x05, 0x61, 0x73,
+	0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x75, 0x6e, 0x69, 0x66,
+	0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x74, 0x42, 0x03, 0xe0, 0x41,
+	0x02, 0x52, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x22, 0x8f, 0x01, 0x0a, 0x12, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x3c, 0x0a, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21,
+	0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x61, 0x73, 0x73, 0x65,
+	0x74, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x12, 0x3b, 0x0a,
 	0x0b, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x18, 0x02, 0x20, 0x01,
 	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
 	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61, 0x73, 0x6b, 0x52, 0x0a,
-	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x73, 0x6b, 0x22, 0xa9, 0x02, 0x0a, 0x15, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x75, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x53, 0x0a, 0x09, 0x64, 0x75, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x74,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65,
-	0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f,
-	0x64, 0x65, 0x6c, 0x73, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x65, 0x6f, 0x73, 0x2e, 0x6c, 0x61,
-	0x62, 0x2e, 0x44, 0x75, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52,
-	0x08, 0x64, 0x75, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x3b, 0x0a, 0x0b, 0x75, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61, 0x73, 0x6b, 0x52, 0x0a, 0x75, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x4d, 0x61, 0x73, 0x6b, 0x12, 0x3e, 0x0a, 0x08, 0x64, 0x75, 0x74, 0x5f, 0x6d, 0x65,
-	0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69,
-	0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x6d,
-	0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x44, 0x75, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x52, 0x07, 0x64,
-	0x75, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x12, 0x3e, 0x0a, 0x08, 0x6c, 0x61, 0x62, 0x5f, 0x6d, 0x65,
-	0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69,
-	0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x6d,
-	0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x4c, 0x61, 0x62, 0x4d, 0x65, 0x74, 0x61, 0x52, 0x07, 0x6c,
-	0x61, 0x62, 0x4d, 0x65, 0x74, 0x61, 0x22, 0x54, 0x0a, 0x17, 0x52, 0x61, 0x63, 0x6b, 0x52, 0x65,
-	0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x39, 0x0a, 0x04, 0x72, 0x61, 0x63, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x20, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61,
-	0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x52, 0x61, 0x63,
-	0x6b, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x04, 0x72, 0x61, 0x63, 0x6b, 0x22, 0xb4, 0x01, 0x0a,
-	0x0d, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3e,
-	0x0a, 0x04, 0x76, 0x6c, 0x61, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2a, 0xfa, 0x41,
-	0x27, 0x0a, 0x25, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x2d, 0x66, 0x6c, 0x65, 0x65, 0x74,
-	0x2d, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x61, 0x70, 0x70, 0x73, 0x70, 0x6f, 0x74, 0x2e,
-	0x63, 0x6f, 0x6d, 0x2f, 0x56, 0x6c, 0x61, 0x6e, 0x52, 0x04, 0x76, 0x6c, 0x61, 0x6e, 0x12, 0x3b,
-	0x0a, 0x03, 0x6e, 0x69, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x29, 0xfa, 0x41, 0x26,
-	0x0a, 0x24, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x2d, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2d,
+	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x73, 0x6b, 0x22, 0x55, 0x0a, 0x0f, 0x47, 0x65,
+	0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x42, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2e, 0xfa, 0x41, 0x28,
+	0x0a, 0x26, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x2d, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2d,
 	0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x61, 0x70, 0x70, 0x73, 0x70, 0x6f, 0x74, 0x2e, 0x63,
-	0x6f, 0x6d, 0x2f, 0x4e, 0x69, 0x63, 0x52, 0x03, 0x6e, 0x69, 0x63, 0x12, 0x16, 0x0a, 0x06, 0x64,
-	0x65, 0x6c, 0x65, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x64, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x02, 0x69, 0x70, 0x22, 0x52, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x73, 0x73,
-	0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3c, 0x0a, 0x05, 0x61, 0x73, 0x73,
-	0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69,
-	0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x6d,
-	0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x74, 0x42, 0x03, 0xe0, 0x41, 0x02,
-	0x52, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x22, 0x8f, 0x01, 0x0a, 0x12, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3c,
-	0x0a, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e,
+	0x6f, 0x6d, 0x2f, 0x61, 0x73, 0x73, 0x65, 0x74, 0xe0, 0x41, 0x02, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x22, 0x83, 0x01, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f,
+	0x73, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65,
+	0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x6b,
+	0x65, 0x79, 0x73, 0x4f, 0x6e, 0x6c, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x6b,
+	0x65, 0x79, 0x73, 0x4f, 0x6e, 0x6c, 0x79, 0x22, 0x77, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x41,
+	0x73, 0x73, 0x65, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a,
+	0x06, 0x61, 0x73, 0x73, 0x65, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e,
 	0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69,
 	0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x74,
-	0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x12, 0x3b, 0x0a, 0x0b,
-	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61, 0x73, 0x6b, 0x52, 0x0a, 0x75,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x73, 0x6b, 0x22, 0x55, 0x0a, 0x0f, 0x47, 0x65, 0x74,
-	0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x42, 0x0a, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2e, 0xe0, 0x41, 0x02, 0xfa,
-	0x41, 0x28, 0x0a, 0x26, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x2d, 0x66, 0x6c, 0x65, 0x65,
-	0x74, 0x2d, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x61, 0x70, 0x70, 0x73, 0x70, 0x6f, 0x74,
-	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x73, 0x73, 0x65, 0x74, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x22, 0x83, 0x01, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73,
-	0x69, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53,
-	0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65,
-	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b,
-	0x65, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x6b, 0x65,
-	0x79, 0x73, 0x4f, 0x6e, 0x6c, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x6b, 0x65,
-	0x79, 0x73, 0x4f, 0x6e, 0x6c, 0x79, 0x22, 0x77, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x73,
-	0x73, 0x65, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a, 0x06,
-	0x61, 0x73, 0x73, 0x65, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x75,
-	0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e,
-	0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x74, 0x52,
-	0x06, 0x61, 0x73, 0x73, 0x65, 0x74, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f,
-	0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22,
-	0x58, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x42, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x2e, 0xe0, 0x41, 0x02, 0xfa, 0x41, 0x28, 0x0a, 0x26, 0x75, 0x6e, 0x69,
-	0x66, 0x69, 0x65, 0x64, 0x2d, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2d, 0x73, 0x79, 0x73, 0x74, 0x65,
-	0x6d, 0x2e, 0x61, 0x70, 0x70, 0x73, 0x70, 0x6f, 0x74, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x41, 0x73,
-	0x73, 0x65, 0x74, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0xa3, 0x01, 0x0a, 0x12, 0x52, 0x65,
-	0x6e, 0x61, 0x6d, 0x65, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x42, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2e,
-	0xe0, 0x41, 0x02, 0xfa, 0x41, 0x28, 0x0a, 0x26, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x2d,
-	0x66, 0x6c, 0x65, 0x65, 0x74, 0x2d, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x61, 0x70, 0x70,
-	0x73, 0x70, 0x6f, 0x74, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x49, 0x0a, 0x08, 0x6e, 0x65, 0x77, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2e, 0xe0, 0x41, 0x02, 0xfa, 0x41, 0x28, 0x0a, 0x26,
-	0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x2d, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2d, 0x73, 0x79,
-	0x73, 0x74, 0x65, 0x6d, 0x2e, 0x61, 0x70, 0x70, 0x73, 0x70, 0x6f, 0x74, 0x2e, 0x63, 0x6f, 0x6d,
-	0x2f, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x07, 0x6e, 0x65, 0x77, 0x4e, 0x61, 0x6d, 0x65, 0x22,
-	0x43, 0x0a, 0x13, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x4b, 0x56, 0x4d, 0x73, 0x52,
+	0x52, 0x06, 0x61, 0x73, 0x73, 0x65, 0x74, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74,
+	0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x22, 0x58, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x42, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x2e, 0xfa, 0x41, 0x28, 0x0a, 0x26, 0x75, 0x6e, 0x69, 0x66, 0x69,
+	0x65, 0x64, 0x2d, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2d, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e,
+	0x61, 0x70, 0x70, 0x73, 0x70, 0x6f, 0x74, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x41, 0x73, 0x73, 0x65,
+	0x74, 0xe0, 0x41, 0x02, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0xa3, 0x01, 0x0a, 0x12, 0x52,
+	0x65, 0x6e, 0x61, 0x6d, 0x65, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x42, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x2e, 0xfa, 0x41, 0x28, 0x0a, 0x26, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x2d, 0x66, 0x6c,
+	0x65, 0x65, 0x74, 0x2d, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x61, 0x70, 0x70, 0x73, 0x70,
+	0x6f, 0x74, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x41, 0x73, 0x73, 0x65, 0x74, 0xe0, 0x41, 0x02, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x49, 0x0a, 0x08, 0x6e, 0x65, 0x77, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2e, 0xfa, 0x41, 0x28, 0x0a, 0x26, 0x75, 0x6e,
+	0x69, 0x66, 0x69, 0x65, 0x64, 0x2d, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2d, 0x73, 0x79, 0x73, 0x74,
+	0x65, 0x6d, 0x2e, 0x61, 0x70, 0x70, 0x73, 0x70, 0x6f, 0x74, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x41,
+	0x73, 0x73, 0x65, 0x74, 0xe0, 0x41, 0x02, 0x52, 0x07, 0x6e, 0x65, 0x77, 0x4e, 0x61, 0x6d, 0x65,
+	0x22, 0x43, 0x0a, 0x13, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x4b, 0x56, 0x4d, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x12,
+	0x14, 0x0a, 0x05, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05,
+	0x6e, 0x61, 0x6d, 0x65, 0x73, 0x22, 0x4b, 0x0a, 0x14, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65,
+	0x74, 0x4b, 0x56, 0x4d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a,
+	0x04, 0x4b, 0x56, 0x4d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x75, 0x6e,
+	0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
+	0x31, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x4b, 0x56, 0x4d, 0x52, 0x04, 0x4b, 0x56,
+	0x4d, 0x73, 0x22, 0x4a, 0x0a, 0x1a, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x44, 0x48,
+	0x43, 0x50, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x16, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x61, 0x6d, 0x65,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x22, 0x68,
+	0x0a, 0x1b, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x44, 0x48, 0x43, 0x50, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x49, 0x0a,
+	0x0c, 0x64, 0x68, 0x63, 0x70, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65,
+	0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73,
+	0x2e, 0x44, 0x48, 0x43, 0x50, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x0b, 0x64, 0x68, 0x63,
+	0x70, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x22, 0x4a, 0x0a, 0x1a, 0x42, 0x61, 0x74, 0x63,
+	0x68, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x4c, 0x53, 0x45, 0x73, 0x52,
 	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74,
 	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x12, 0x14,
 	0x0a, 0x05, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x6e,
-	0x61, 0x6d, 0x65, 0x73, 0x22, 0x4b, 0x0a, 0x14, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74,
-	0x4b, 0x56, 0x4d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x04,
-	0x4b, 0x56, 0x4d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x75, 0x6e, 0x69,
-	0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31,
-	0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x4b, 0x56, 0x4d, 0x52, 0x04, 0x4b, 0x56, 0x4d,
-	0x73, 0x22, 0x4a, 0x0a, 0x1a, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x44, 0x48, 0x43,
-	0x50, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x61, 0x6d, 0x65, 0x73, 0x22, 0x68, 0x0a, 0x1b, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74,
+	0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x4c, 0x53, 0x45, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x49, 0x0a, 0x0c, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x5f, 0x6c,
+	0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x75, 0x6e, 0x69, 0x66,
+	0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x4c, 0x53,
+	0x45, 0x52, 0x0b, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x4c, 0x73, 0x65, 0x73, 0x22, 0x47,
+	0x0a, 0x17, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e,
+	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x61, 0x72,
+	0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e,
+	0x74, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x05, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x22, 0x5b, 0x0a, 0x18, 0x42, 0x61, 0x74, 0x63, 0x68,
+	0x47, 0x65, 0x74, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x3f, 0x0a, 0x08, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66,
+	0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x64, 0x65,
+	0x6c, 0x73, 0x2e, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x52, 0x08, 0x6d, 0x61, 0x63, 0x68,
+	0x69, 0x6e, 0x65, 0x73, 0x22, 0x47, 0x0a, 0x17, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74,
+	0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
 	0x16, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
 	0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x61, 0x6d, 0x65, 0x73,
-	0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x22, 0x68, 0x0a,
-	0x1b, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x44, 0x48, 0x43, 0x50, 0x43, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x49, 0x0a, 0x0c,
-	0x64, 0x68, 0x63, 0x70, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x26, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65,
-	0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e,
-	0x44, 0x48, 0x43, 0x50, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x0b, 0x64, 0x68, 0x63, 0x70,
-	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x22, 0x4a, 0x0a, 0x1a, 0x42, 0x61, 0x74, 0x63, 0x68,
-	0x47, 0x65, 0x74, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x4c, 0x53, 0x45, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x12, 0x14, 0x0a,
-	0x05, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x61,
-	0x6d, 0x65, 0x73, 0x22, 0x68, 0x0a, 0x1b, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x4d,
-	0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x4c, 0x53, 0x45, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x49, 0x0a, 0x0c, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x5f, 0x6c, 0x73,
-	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69,
-	0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x6d,
-	0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x4c, 0x53, 0x45,
-	0x52, 0x0b, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x4c, 0x73, 0x65, 0x73, 0x22, 0x47, 0x0a,
-	0x17, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x65,
-	0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74,
-	0x12, 0x14, 0x0a, 0x05, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52,
-	0x05, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x22, 0x5b, 0x0a, 0x18, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47,
-	0x65, 0x74, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x3f, 0x0a, 0x08, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x73, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x22, 0x5a, 0x0a,
+	0x18, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x65,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x08, 0x73, 0x77, 0x69,
+	0x74, 0x63, 0x68, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x75, 0x6e,
+	0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
+	0x31, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x52,
+	0x08, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x65, 0x73, 0x22, 0x43, 0x0a, 0x13, 0x42, 0x61, 0x74,
+	0x63, 0x68, 0x47, 0x65, 0x74, 0x52, 0x50, 0x4d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x16, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x61, 0x6d, 0x65,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x22, 0x4b,
+	0x0a, 0x14, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x52, 0x50, 0x4d, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x04, 0x72, 0x70, 0x6d, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c,
 	0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
-	0x73, 0x2e, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x52, 0x08, 0x6d, 0x61, 0x63, 0x68, 0x69,
-	0x6e, 0x65, 0x73, 0x22, 0x47, 0x0a, 0x17, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x53,
-	0x77, 0x69, 0x74, 0x63, 0x68, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16,
-	0x0a, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18,
-	0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x22, 0x5a, 0x0a, 0x18,
-	0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x65, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x08, 0x73, 0x77, 0x69, 0x74,
-	0x63, 0x68, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x75, 0x6e, 0x69,
-	0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31,
-	0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x52, 0x08,
-	0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x65, 0x73, 0x22, 0x43, 0x0a, 0x13, 0x42, 0x61, 0x74, 0x63,
-	0x68, 0x47, 0x65, 0x74, 0x52, 0x50, 0x4d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x16, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x61, 0x6d, 0x65, 0x73,
-	0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x22, 0x4b, 0x0a,
-	0x14, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x52, 0x50, 0x4d, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x04, 0x72, 0x70, 0x6d, 0x73, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65,
-	0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73,
-	0x2e, 0x52, 0x50, 0x4d, 0x52, 0x04, 0x72, 0x70, 0x6d, 0x73, 0x22, 0x44, 0x0a, 0x14, 0x42, 0x61,
-	0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x44, 0x72, 0x61, 0x63, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x61,
-	0x6d, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x61, 0x6d, 0x65, 0x73,
-	0x22, 0x4f, 0x0a, 0x15, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x44, 0x72, 0x61, 0x63,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x05, 0x64, 0x72, 0x61,
-	0x63, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69,
-	0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x6d,
-	0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x44, 0x72, 0x61, 0x63, 0x52, 0x05, 0x64, 0x72, 0x61, 0x63,
-	0x73, 0x22, 0x43, 0x0a, 0x13, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x4e, 0x69, 0x63,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x65,
-	0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74,
-	0x12, 0x14, 0x0a, 0x05, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52,
-	0x05, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x22, 0x4b, 0x0a, 0x14, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47,
-	0x65, 0x74, 0x4e, 0x69, 0x63, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33,
-	0x0a, 0x04, 0x6e, 0x69, 0x63, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x75,
+	0x73, 0x2e, 0x52, 0x50, 0x4d, 0x52, 0x04, 0x72, 0x70, 0x6d, 0x73, 0x22, 0x44, 0x0a, 0x14, 0x42,
+	0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x44, 0x72, 0x61, 0x63, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6e,
+	0x61, 0x6d, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x61, 0x6d, 0x65,
+	0x73, 0x22, 0x4f, 0x0a, 0x15, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x44, 0x72, 0x61,
+	0x63, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x05, 0x64, 0x72,
+	0x61, 0x63, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x75, 0x6e, 0x69, 0x66,
+	0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x44, 0x72, 0x61, 0x63, 0x52, 0x05, 0x64, 0x72, 0x61,
+	0x63, 0x73, 0x22, 0x43, 0x0a, 0x13, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x4e, 0x69,
+	0x63, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x61, 0x72,
+	0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e,
+	0x74, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x05, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x22, 0x4b, 0x0a, 0x14, 0x42, 0x61, 0x74, 0x63, 0x68,
+	0x47, 0x65, 0x74, 0x4e, 0x69, 0x63, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x33, 0x0a, 0x04, 0x6e, 0x69, 0x63, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e,
+	0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x4e, 0x69, 0x63, 0x52, 0x04,
+	0x6e, 0x69, 0x63, 0x73, 0x22, 0x42, 0x0a, 0x12, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74,
+	0x56, 0x4d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x61,
+	0x72, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x61, 0x72, 0x65,
+	0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x05, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x22, 0x47, 0x0a, 0x13, 0x42, 0x61, 0x74, 0x63,
+	0x68, 0x47, 0x65, 0x74, 0x56, 0x4d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x30, 0x0a, 0x03, 0x76, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x75,
 	0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e,
-	0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x4e, 0x69, 0x63, 0x52, 0x04, 0x6e,
-	0x69, 0x63, 0x73, 0x22, 0x42, 0x0a, 0x12, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x56,
-	0x4d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x61, 0x72,
+	0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x56, 0x4d, 0x52, 0x03, 0x76, 0x6d,
+	0x73, 0x22, 0x44, 0x0a, 0x14, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x56, 0x6c, 0x61,
+	0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x61, 0x72,
 	0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e,
 	0x74, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09,
-	0x52, 0x05, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x22, 0x47, 0x0a, 0x13, 0x42, 0x61, 0x74, 0x63, 0x68,
-	0x47, 0x65, 0x74, 0x56, 0x4d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30,
-	0x0a, 0x03, 0x76, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x75, 0x6e,
-	0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
-	0x31, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x56, 0x4d, 0x52, 0x03, 0x76, 0x6d, 0x73,
-	0x22, 0x44, 0x0a, 0x14, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x56, 0x6c, 0x61, 0x6e,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x65,
-	0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74,
-	0x12, 0x14, 0x0a, 0x05, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52,
-	0x05, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x22, 0x4f, 0x0a, 0x15, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47,
-	0x65, 0x74, 0x56, 0x6c, 0x61, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x36, 0x0a, 0x05, 0x76, 0x6c, 0x61, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20,
-	0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70,
-	0x69, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x56, 0x6c, 0x61, 0x6e,
-	0x52, 0x05, 0x76, 0x6c, 0x61, 0x6e, 0x73, 0x22, 0x44, 0x0a, 0x14, 0x42, 0x61, 0x74, 0x63, 0x68,
-	0x47, 0x65, 0x74, 0x52, 0x61, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x16, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x61, 0x6d, 0x65, 0x73,
-	0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x22, 0x4f, 0x0a,
-	0x15, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x52, 0x61, 0x63, 0x6b, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x05, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66,
-	0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x64, 0x65,
-	0x6c, 0x73, 0x2e, 0x52, 0x61, 0x63, 0x6b, 0x52, 0x05, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x22, 0x4e,
-	0x0a, 0x1e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x43, 0x68, 0x72, 0x6f, 0x6d, 0x65,
-	0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x52, 0x05, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x22, 0x4f, 0x0a, 0x15, 0x42, 0x61, 0x74, 0x63, 0x68,
+	0x47, 0x65, 0x74, 0x56, 0x6c, 0x61, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x36, 0x0a, 0x05, 0x76, 0x6c, 0x61, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x20, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x56, 0x6c, 0x61,
+	0x6e, 0x52, 0x05, 0x76, 0x6c, 0x61, 0x6e, 0x73, 0x22, 0x44, 0x0a, 0x14, 0x42, 0x61, 0x74, 0x63,
+	0x68, 0x47, 0x65, 0x74, 0x52, 0x61, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
 	0x12, 0x16, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
 	0x52, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x61, 0x6d, 0x65,
-	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x22, 0x78,
-	0x0a, 0x1f, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x43, 0x68, 0x72, 0x6f, 0x6d, 0x65,
-	0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x55, 0x0a, 0x10, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x65, 0x5f, 0x70, 0x6c, 0x61, 0x74,
-	0x66, 0x6f, 0x72, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x75, 0x6e,
-	0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
-	0x31, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x43, 0x68, 0x72, 0x6f, 0x6d, 0x65, 0x50,
-	0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x52, 0x0f, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x65, 0x50,
-	0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x73, 0x22, 0x53, 0x0a, 0x23, 0x42, 0x61, 0x74, 0x63,
-	0x68, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x4c, 0x53, 0x45, 0x50, 0x72,
-	0x6f, 0x74, 0x6f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x16, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x61, 0x6d, 0x65, 0x73,
-	0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x22, 0x8d, 0x01,
-	0x0a, 0x24, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e,
-	0x65, 0x4c, 0x53, 0x45, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x65, 0x0a, 0x16, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e,
-	0x65, 0x5f, 0x6c, 0x73, 0x65, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x74, 0x79, 0x70, 0x65, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x22, 0x4f,
+	0x0a, 0x15, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x52, 0x61, 0x63, 0x6b, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x05, 0x72, 0x61, 0x63, 0x6b, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64,
 	0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x64,
-	0x65, 0x6c, 0x73, 0x2e, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x4c, 0x53, 0x45, 0x50, 0x72,
-	0x6f, 0x74, 0x6f, 0x74, 0x79, 0x70, 0x65, 0x52, 0x14, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65,
-	0x4c, 0x73, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x22, 0x50, 0x0a,
-	0x20, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x52, 0x61, 0x63, 0x6b, 0x4c, 0x53, 0x45,
-	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x65, 0x6c, 0x73, 0x2e, 0x52, 0x61, 0x63, 0x6b, 0x52, 0x05, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x22,
+	0x4e, 0x0a, 0x1e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x43, 0x68, 0x72, 0x6f, 0x6d,
+	0x65, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
 	0x74, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
 	0x09, 0x52, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x61, 0x6d,
 	0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x22,
-	0x81, 0x01, 0x0a, 0x21, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x52, 0x61, 0x63, 0x6b,
-	0x4c, 0x53, 0x45, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5c, 0x0a, 0x13, 0x72, 0x61, 0x63, 0x6b, 0x5f, 0x6c, 0x73,
-	0x65, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65,
-	0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e,
-	0x52, 0x61, 0x63, 0x6b, 0x4c, 0x53, 0x45, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x74, 0x79, 0x70, 0x65,
-	0x52, 0x11, 0x72, 0x61, 0x63, 0x6b, 0x4c, 0x73, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x74, 0x79,
-	0x70, 0x65, 0x73, 0x22, 0x68, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x43, 0x68, 0x72, 0x6f, 0x6d, 0x65,
-	0x4f, 0x53, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x12, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x65, 0x6f, 0x73, 0x5f,
-	0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x10, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x65, 0x6f, 0x73, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x49,
-	0x64, 0x12, 0x1a, 0x0a, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0xa3, 0x01,
-	0x0a, 0x1b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x67, 0x53,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x57, 0x0a,
-	0x0e, 0x63, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x67, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66,
-	0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x64, 0x65,
-	0x6c, 0x73, 0x2e, 0x43, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x67, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x0e, 0x63, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x67, 0x53,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x2b, 0x0a, 0x11, 0x63, 0x61, 0x63, 0x68, 0x69, 0x6e,
-	0x67, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x10, 0x63, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x67, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x49, 0x64, 0x22, 0xb3, 0x01, 0x0a, 0x1b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x61,
-	0x63, 0x68, 0x69, 0x6e, 0x67, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x57, 0x0a, 0x0e, 0x63, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x67, 0x53, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x75, 0x6e,
-	0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
-	0x31, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x43, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x67,
-	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x0e, 0x63, 0x61,
-	0x63, 0x68, 0x69, 0x6e, 0x67, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x3b, 0x0a, 0x0b,
-	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61, 0x73, 0x6b, 0x52, 0x0a, 0x75,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x73, 0x6b, 0x22, 0x67, 0x0a, 0x18, 0x47, 0x65, 0x74,
-	0x43, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x67, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x4b, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x37, 0xe0, 0x41, 0x02, 0xfa, 0x41, 0x31, 0x0a, 0x2f, 0x75, 0x6e, 0x69,
-	0x66, 0x69, 0x65, 0x64, 0x2d, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2d, 0x73, 0x79, 0x73, 0x74, 0x65,
-	0x6d, 0x2e, 0x61, 0x70, 0x70, 0x73, 0x70, 0x6f, 0x74, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x43, 0x61,
-	0x63, 0x68, 0x69, 0x6e, 0x67, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x22, 0x8c, 0x01, 0x0a, 0x1a, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x61, 0x63, 0x68, 0x69,
-	0x6e, 0x67, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d,
-	0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x16, 0x0a,
-	0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66,
-	0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x6b, 0x65, 0x79, 0x73, 0x4f, 0x6e, 0x6c,
-	0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x6b, 0x65, 0x79, 0x73, 0x4f, 0x6e, 0x6c,
-	0x79, 0x22, 0x9b, 0x01, 0x0a, 0x1b, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x61, 0x63, 0x68, 0x69, 0x6e,
-	0x67, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x54, 0x0a, 0x0f, 0x63, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x67, 0x53, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x75, 0x6e, 0x69,
-	0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31,
-	0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x43, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x67, 0x53,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x0f, 0x63, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x67, 0x53,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f,
-	0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22,
-	0x6a, 0x0a, 0x1b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x67,
-	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x4b,
-	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x37, 0xe0, 0x41,
-	0x02, 0xfa, 0x41, 0x31, 0x0a, 0x2f, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x2d, 0x66, 0x6c,
-	0x65, 0x65, 0x74, 0x2d, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x61, 0x70, 0x70, 0x73, 0x70,
-	0x6f, 0x74, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x43, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x67, 0x53, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0xa5, 0x01, 0x0a, 0x1b,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x69, 0x6e, 0x67,
-	0x55, 0x6e, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x58, 0x0a, 0x0f, 0x73,
-	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x69, 0x6e, 0x67, 0x5f, 0x75, 0x6e, 0x69, 0x74, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c,
-	0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
-	0x73, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x69, 0x6e, 0x67, 0x55, 0x6e, 0x69, 0x74,
-	0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x0e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x69, 0x6e,
-	0x67, 0x55, 0x6e, 0x69, 0x74, 0x12, 0x2c, 0x0a, 0x12, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
-	0x69, 0x6e, 0x67, 0x5f, 0x75, 0x6e, 0x69, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x10, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x69, 0x6e, 0x67, 0x55, 0x6e, 0x69,
-	0x74, 0x49, 0x64, 0x22, 0xb4, 0x01, 0x0a, 0x1b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x63,
-	0x68, 0x65, 0x64, 0x75, 0x6c, 0x69, 0x6e, 0x67, 0x55, 0x6e, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x58, 0x0a, 0x0f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x69, 0x6e,
-	0x67, 0x5f, 0x75, 0x6e, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x75,
+	0x78, 0x0a, 0x1f, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x43, 0x68, 0x72, 0x6f, 0x6d,
+	0x65, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x55, 0x0a, 0x10, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x65, 0x5f, 0x70, 0x6c, 0x61,
+	0x74, 0x66, 0x6f, 0x72, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x75,
+	0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x43, 0x68, 0x72, 0x6f, 0x6d, 0x65,
+	0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x52, 0x0f, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x65,
+	0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x73, 0x22, 0x53, 0x0a, 0x23, 0x42, 0x61, 0x74,
+	0x63, 0x68, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x4c, 0x53, 0x45, 0x50,
+	0x72, 0x6f, 0x74, 0x6f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x16, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x61, 0x6d, 0x65,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x22, 0x8d,
+	0x01, 0x0a, 0x24, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x63, 0x68, 0x69,
+	0x6e, 0x65, 0x4c, 0x53, 0x45, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x65, 0x0a, 0x16, 0x6d, 0x61, 0x63, 0x68, 0x69,
+	0x6e, 0x65, 0x5f, 0x6c, 0x73, 0x65, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65,
+	0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f,
+	0x64, 0x65, 0x6c, 0x73, 0x2e, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x4c, 0x53, 0x45, 0x50,
+	0x72, 0x6f, 0x74, 0x6f, 0x74, 0x79, 0x70, 0x65, 0x52, 0x14, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e,
+	0x65, 0x4c, 0x73, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x22, 0x50,
+	0x0a, 0x20, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x52, 0x61, 0x63, 0x6b, 0x4c, 0x53,
+	0x45, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x61,
+	0x6d, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x61, 0x6d, 0x65, 0x73,
+	0x22, 0x81, 0x01, 0x0a, 0x21, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x52, 0x61, 0x63,
+	0x6b, 0x4c, 0x53, 0x45, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5c, 0x0a, 0x13, 0x72, 0x61, 0x63, 0x6b, 0x5f, 0x6c,
+	0x73, 0x65, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65,
+	0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73,
+	0x2e, 0x52, 0x61, 0x63, 0x6b, 0x4c, 0x53, 0x45, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x74, 0x79, 0x70,
+	0x65, 0x52, 0x11, 0x72, 0x61, 0x63, 0x6b, 0x4c, 0x73, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x22, 0x68, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x43, 0x68, 0x72, 0x6f, 0x6d,
+	0x65, 0x4f, 0x53, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x12, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x65, 0x6f, 0x73,
+	0x5f, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x10, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x65, 0x6f, 0x73, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65,
+	0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0xa3,
+	0x01, 0x0a, 0x1b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x67,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x57,
+	0x0a, 0x0e, 0x63, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x67, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64,
+	0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x64,
+	0x65, 0x6c, 0x73, 0x2e, 0x43, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x67, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x0e, 0x63, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x67,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x2b, 0x0a, 0x11, 0x63, 0x61, 0x63, 0x68, 0x69,
+	0x6e, 0x67, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x10, 0x63, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x67, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x49, 0x64, 0x22, 0xb3, 0x01, 0x0a, 0x1b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43,
+	0x61, 0x63, 0x68, 0x69, 0x6e, 0x67, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x57, 0x0a, 0x0e, 0x63, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x67, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x75,
 	0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e,
-	0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75,
-	0x6c, 0x69, 0x6e, 0x67, 0x55, 0x6e, 0x69, 0x74, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x0e, 0x73,
-	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x69, 0x6e, 0x67, 0x55, 0x6e, 0x69, 0x74, 0x12, 0x3b, 0x0a,
+	0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x43, 0x61, 0x63, 0x68, 0x69, 0x6e,
+	0x67, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x0e, 0x63,
+	0x61, 0x63, 0x68, 0x69, 0x6e, 0x67, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x3b, 0x0a,
 	0x0b, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x18, 0x02, 0x20, 0x01,
 	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
 	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61, 0x73, 0x6b, 0x52, 0x0a,
 	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x73, 0x6b, 0x22, 0x67, 0x0a, 0x18, 0x47, 0x65,
-	0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x69, 0x6e, 0x67, 0x55, 0x6e, 0x69, 0x74, 0x52,
+	0x74, 0x43, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x67, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52,
 	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x4b, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x42, 0x37, 0xe0, 0x41, 0x02, 0xfa, 0x41, 0x31, 0x0a, 0x2f, 0x75, 0x6e,
-	0x69, 0x66, 0x69, 0x65, 0x64, 0x2d, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2d, 0x73, 0x79, 0x73, 0x74,
-	0x65, 0x6d, 0x2e, 0x61, 0x70, 0x70, 0x73, 0x70, 0x6f, 0x74, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x53,
-	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x69, 0x6e, 0x67, 0x55, 0x6e, 0x69, 0x74, 0x52, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x22, 0x8d, 0x01, 0x0a, 0x1a, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x63, 0x68, 0x65,
-	0x64, 0x75, 0x6c, 0x69, 0x6e, 0x67, 0x55, 0x6e, 0x69, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x37, 0xfa, 0x41, 0x31, 0x0a, 0x2f, 0x75, 0x6e, 0x69, 0x66, 0x69,
+	0x65, 0x64, 0x2d, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2d, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e,
+	0x61, 0x70, 0x70, 0x73, 0x70, 0x6f, 0x74, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x43, 0x61, 0x63, 0x68,
+	0x69, 0x6e, 0x67, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0xe0, 0x41, 0x02, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x22, 0x8c, 0x01, 0x0a, 0x1a, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x61, 0x63, 0x68,
+	0x69, 0x6e, 0x67, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
 	0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18,
 	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12,
 	0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20,
 	0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x16,
 	0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x6b, 0x65, 0x79, 0x73, 0x5f, 0x6f,
-	0x6e, 0x6c, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x6b, 0x65, 0x79, 0x73, 0x4f,
-	0x6e, 0x6c, 0x79, 0x22, 0x9c, 0x01, 0x0a, 0x1b, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x63, 0x68, 0x65,
-	0x64, 0x75, 0x6c, 0x69, 0x6e, 0x67, 0x55, 0x6e, 0x69, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x55, 0x0a, 0x10, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x69, 0x6e,
-	0x67, 0x5f, 0x75, 0x6e, 0x69, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e,
+	0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x6b, 0x65, 0x79, 0x73, 0x4f, 0x6e,
+	0x6c, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x6b, 0x65, 0x79, 0x73, 0x4f, 0x6e,
+	0x6c, 0x79, 0x22, 0x9b, 0x01, 0x0a, 0x1b, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x61, 0x63, 0x68, 0x69,
+	0x6e, 0x67, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x54, 0x0a, 0x0f, 0x63, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x67, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x75, 0x6e,
+	0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
+	0x31, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x43, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x67,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x0f, 0x63, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x67,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74,
+	0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x22, 0x6a, 0x0a, 0x1b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x61, 0x63, 0x68, 0x69, 0x6e,
+	0x67, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x4b, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x37, 0xfa,
+	0x41, 0x31, 0x0a, 0x2f, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x2d, 0x66, 0x6c, 0x65, 0x65,
+	0x74, 0x2d, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x61, 0x70, 0x70, 0x73, 0x70, 0x6f, 0x74,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x43, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x67, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0xe0, 0x41, 0x02, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0xa5, 0x01, 0x0a,
+	0x1b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x69, 0x6e,
+	0x67, 0x55, 0x6e, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x58, 0x0a, 0x0f,
+	0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x69, 0x6e, 0x67, 0x5f, 0x75, 0x6e, 0x69, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66,
+	0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x64, 0x65,
+	0x6c, 0x73, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x69, 0x6e, 0x67, 0x55, 0x6e, 0x69,
+	0x74, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x0e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x69,
+	0x6e, 0x67, 0x55, 0x6e, 0x69, 0x74, 0x12, 0x2c, 0x0a, 0x12, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x6c, 0x69, 0x6e, 0x67, 0x5f, 0x75, 0x6e, 0x69, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x10, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x69, 0x6e, 0x67, 0x55, 0x6e,
+	0x69, 0x74, 0x49, 0x64, 0x22, 0xb4, 0x01, 0x0a, 0x1b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x69, 0x6e, 0x67, 0x55, 0x6e, 0x69, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x58, 0x0a, 0x0f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x69,
+	0x6e, 0x67, 0x5f, 0x75, 0x6e, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e,
 	0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69,
 	0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64,
-	0x75, 0x6c, 0x69, 0x6e, 0x67, 0x55, 0x6e, 0x69, 0x74, 0x52, 0x0f, 0x73, 0x63, 0x68, 0x65, 0x64,
-	0x75, 0x6c, 0x69, 0x6e, 0x67, 0x55, 0x6e, 0x69, 0x74, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65,
-	0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b,
-	0x65, 0x6e, 0x22, 0x6a, 0x0a, 0x1b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x63, 0x68, 0x65,
-	0x64, 0x75, 0x6c, 0x69, 0x6e, 0x67, 0x55, 0x6e, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x4b, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x37, 0xe0, 0x41, 0x02, 0xfa, 0x41, 0x31, 0x0a, 0x2f, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64,
-	0x2d, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2d, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x61, 0x70,
-	0x70, 0x73, 0x70, 0x6f, 0x74, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75,
-	0x6c, 0x69, 0x6e, 0x67, 0x55, 0x6e, 0x69, 0x74, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0xa7,
-	0x01, 0x0a, 0x19, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x42,
-	0x75, 0x6e, 0x64, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x0d,
-	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x62, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0c, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x12, 0x3b, 0x0a, 0x0b, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69,
-	0x65, 0x6c, 0x64, 0x4d, 0x61, 0x73, 0x6b, 0x52, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d,
-	0x61, 0x73, 0x6b, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x5f, 0x6d, 0x69, 0x73,
-	0x73, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x61, 0x6c, 0x6c, 0x6f,
-	0x77, 0x4d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x22, 0x41, 0x0a, 0x1a, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x5f, 0x62, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c, 0x63,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x32, 0xd3, 0x69, 0x0a, 0x05,
-	0x46, 0x6c, 0x65, 0x65, 0x74, 0x12, 0x78, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43,
-	0x68, 0x72, 0x6f, 0x6d, 0x65, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x12, 0x34, 0x2e,
+	0x75, 0x6c, 0x69, 0x6e, 0x67, 0x55, 0x6e, 0x69, 0x74, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x0e,
+	0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x69, 0x6e, 0x67, 0x55, 0x6e, 0x69, 0x74, 0x12, 0x3b,
+	0x0a, 0x0b, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61, 0x73, 0x6b, 0x52,
+	0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x73, 0x6b, 0x22, 0x67, 0x0a, 0x18, 0x47,
+	0x65, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x69, 0x6e, 0x67, 0x55, 0x6e, 0x69, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x4b, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x37, 0xfa, 0x41, 0x31, 0x0a, 0x2f, 0x75, 0x6e, 0x69, 0x66,
+	0x69, 0x65, 0x64, 0x2d, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2d, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d,
+	0x2e, 0x61, 0x70, 0x70, 0x73, 0x70, 0x6f, 0x74, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x53, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x69, 0x6e, 0x67, 0x55, 0x6e, 0x69, 0x74, 0xe0, 0x41, 0x02, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x22, 0x8d, 0x01, 0x0a, 0x1a, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x69, 0x6e, 0x67, 0x55, 0x6e, 0x69, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65,
+	0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12,
+	0x16, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x6b, 0x65, 0x79, 0x73, 0x5f,
+	0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x6b, 0x65, 0x79, 0x73,
+	0x4f, 0x6e, 0x6c, 0x79, 0x22, 0x9c, 0x01, 0x0a, 0x1b, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x69, 0x6e, 0x67, 0x55, 0x6e, 0x69, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x55, 0x0a, 0x10, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x69,
+	0x6e, 0x67, 0x5f, 0x75, 0x6e, 0x69, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a,
+	0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x53, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x69, 0x6e, 0x67, 0x55, 0x6e, 0x69, 0x74, 0x52, 0x0f, 0x73, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x69, 0x6e, 0x67, 0x55, 0x6e, 0x69, 0x74, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e,
+	0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x22, 0x6a, 0x0a, 0x1b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x69, 0x6e, 0x67, 0x55, 0x6e, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x4b, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x37, 0xfa, 0x41, 0x31, 0x0a, 0x2f, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x2d, 0x66,
+	0x6c, 0x65, 0x65, 0x74, 0x2d, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x61, 0x70, 0x70, 0x73,
+	0x70, 0x6f, 0x74, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x69,
+	0x6e, 0x67, 0x55, 0x6e, 0x69, 0x74, 0xe0, 0x41, 0x02, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22,
+	0xa7, 0x01, 0x0a, 0x19, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x0a,
+	0x0d, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x62, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0c, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x12, 0x3b, 0x0a, 0x0b, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46,
+	0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61, 0x73, 0x6b, 0x52, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x4d, 0x61, 0x73, 0x6b, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x5f, 0x6d, 0x69,
+	0x73, 0x73, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x61, 0x6c, 0x6c,
+	0x6f, 0x77, 0x4d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x22, 0x41, 0x0a, 0x1a, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x5f, 0x62, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x32, 0xaa, 0x6b, 0x0a,
+	0x05, 0x46, 0x6c, 0x65, 0x65, 0x74, 0x12, 0x78, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x43, 0x68, 0x72, 0x6f, 0x6d, 0x65, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x12, 0x34,
+	0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43,
+	0x68, 0x72, 0x6f, 0x6d, 0x65, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c,
+	0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
+	0x73, 0x2e, 0x43, 0x68, 0x72, 0x6f, 0x6d, 0x65, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d,
+	0x12, 0x78, 0x0a, 0x14, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x68, 0x72, 0x6f, 0x6d, 0x65,
+	0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x12, 0x34, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69,
+	0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72,
+	0x70, 0x63, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x68, 0x72, 0x6f, 0x6d, 0x65, 0x50,
+	0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a,
+	0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x43, 0x68, 0x72, 0x6f,
+	0x6d, 0x65, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x12, 0x72, 0x0a, 0x11, 0x47, 0x65,
+	0x74, 0x43, 0x68, 0x72, 0x6f, 0x6d, 0x65, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x12,
+	0x31, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x68, 0x72,
+	0x6f, 0x6d, 0x65, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65,
+	0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e,
+	0x43, 0x68, 0x72, 0x6f, 0x6d, 0x65, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x12, 0x80,
+	0x01, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x68, 0x72, 0x6f, 0x6d, 0x65, 0x50, 0x6c, 0x61,
+	0x74, 0x66, 0x6f, 0x72, 0x6d, 0x73, 0x12, 0x33, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64,
+	0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x68, 0x72, 0x6f, 0x6d, 0x65, 0x50, 0x6c, 0x61, 0x74, 0x66,
+	0x6f, 0x72, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x34, 0x2e, 0x75, 0x6e,
+	0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
+	0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x68, 0x72, 0x6f, 0x6d, 0x65,
+	0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x64, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x68, 0x72, 0x6f, 0x6d,
+	0x65, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x12, 0x34, 0x2e, 0x75, 0x6e, 0x69, 0x66,
+	0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e,
+	0x72, 0x70, 0x63, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x68, 0x72, 0x6f, 0x6d, 0x65,
+	0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x62, 0x0a, 0x15, 0x49, 0x6d, 0x70, 0x6f, 0x72,
+	0x74, 0x43, 0x68, 0x72, 0x6f, 0x6d, 0x65, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x73,
+	0x12, 0x35, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72,
+	0x74, 0x43, 0x68, 0x72, 0x6f, 0x6d, 0x65, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x71, 0x0a, 0x0e, 0x4c,
+	0x69, 0x73, 0x74, 0x4f, 0x53, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2e, 0x2e,
 	0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69,
-	0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x68,
-	0x72, 0x6f, 0x6d, 0x65, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65,
-	0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73,
-	0x2e, 0x43, 0x68, 0x72, 0x6f, 0x6d, 0x65, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x12,
-	0x78, 0x0a, 0x14, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x68, 0x72, 0x6f, 0x6d, 0x65, 0x50,
-	0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x12, 0x34, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x53, 0x56, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e,
+	0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x53, 0x56, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x58,
+	0x0a, 0x10, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x4f, 0x53, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x12, 0x30, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65,
+	0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x49, 0x6d, 0x70,
+	0x6f, 0x72, 0x74, 0x4f, 0x53, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x72, 0x70,
+	0x63, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x87, 0x01, 0x0a, 0x19, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x4c, 0x53, 0x45, 0x50, 0x72, 0x6f,
+	0x74, 0x6f, 0x74, 0x79, 0x70, 0x65, 0x12, 0x39, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64,
+	0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63,
+	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x4c, 0x53,
+	0x45, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x74, 0x79, 0x70, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x2f, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x4d,
+	0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x4c, 0x53, 0x45, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x74, 0x79,
+	0x70, 0x65, 0x12, 0x87, 0x01, 0x0a, 0x19, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x63,
+	0x68, 0x69, 0x6e, 0x65, 0x4c, 0x53, 0x45, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x74, 0x79, 0x70, 0x65,
+	0x12, 0x39, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x4c, 0x53, 0x45, 0x50, 0x72, 0x6f, 0x74, 0x6f,
+	0x74, 0x79, 0x70, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x75, 0x6e,
+	0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
+	0x31, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65,
+	0x4c, 0x53, 0x45, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x74, 0x79, 0x70, 0x65, 0x12, 0x81, 0x01, 0x0a,
+	0x16, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x4c, 0x53, 0x45, 0x50, 0x72,
+	0x6f, 0x74, 0x6f, 0x74, 0x79, 0x70, 0x65, 0x12, 0x36, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65,
 	0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70,
-	0x63, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x68, 0x72, 0x6f, 0x6d, 0x65, 0x50, 0x6c,
-	0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e,
+	0x63, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x4c, 0x53, 0x45, 0x50,
+	0x72, 0x6f, 0x74, 0x6f, 0x74, 0x79, 0x70, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x2f, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x4d, 0x61, 0x63,
+	0x68, 0x69, 0x6e, 0x65, 0x4c, 0x53, 0x45, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x74, 0x79, 0x70, 0x65,
+	0x12, 0x8f, 0x01, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65,
+	0x4c, 0x53, 0x45, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x12, 0x38, 0x2e,
 	0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69,
-	0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x43, 0x68, 0x72, 0x6f, 0x6d,
-	0x65, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x12, 0x72, 0x0a, 0x11, 0x47, 0x65, 0x74,
-	0x43, 0x68, 0x72, 0x6f, 0x6d, 0x65, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x12, 0x31,
-	0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70,
-	0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x68, 0x72, 0x6f,
-	0x6d, 0x65, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x2a, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74,
-	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x43,
-	0x68, 0x72, 0x6f, 0x6d, 0x65, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x12, 0x80, 0x01,
-	0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x68, 0x72, 0x6f, 0x6d, 0x65, 0x50, 0x6c, 0x61, 0x74,
-	0x66, 0x6f, 0x72, 0x6d, 0x73, 0x12, 0x33, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66,
-	0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e,
-	0x4c, 0x69, 0x73, 0x74, 0x43, 0x68, 0x72, 0x6f, 0x6d, 0x65, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f,
-	0x72, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x34, 0x2e, 0x75, 0x6e, 0x69,
-	0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31,
-	0x2e, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x68, 0x72, 0x6f, 0x6d, 0x65, 0x50,
-	0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x64, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x68, 0x72, 0x6f, 0x6d, 0x65,
-	0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x12, 0x34, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69,
-	0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72,
-	0x70, 0x63, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x68, 0x72, 0x6f, 0x6d, 0x65, 0x50,
-	0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x62, 0x0a, 0x15, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74,
-	0x43, 0x68, 0x72, 0x6f, 0x6d, 0x65, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x73, 0x12,
-	0x35, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61,
-	0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74,
-	0x43, 0x68, 0x72, 0x6f, 0x6d, 0x65, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x72, 0x70, 0x63, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x71, 0x0a, 0x0e, 0x4c, 0x69,
-	0x73, 0x74, 0x4f, 0x53, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2e, 0x2e, 0x75,
-	0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e,
-	0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x53, 0x56, 0x65, 0x72,
-	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x75,
-	0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e,
-	0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x53, 0x56, 0x65, 0x72,
-	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x58, 0x0a,
-	0x10, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x4f, 0x53, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
-	0x73, 0x12, 0x30, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74,
-	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x49, 0x6d, 0x70, 0x6f,
-	0x72, 0x74, 0x4f, 0x53, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x72, 0x70, 0x63,
-	0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x87, 0x01, 0x0a, 0x19, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x4c, 0x53, 0x45, 0x50, 0x72, 0x6f, 0x74,
-	0x6f, 0x74, 0x79, 0x70, 0x65, 0x12, 0x39, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66,
-	0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x4c, 0x53, 0x45,
-	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x74, 0x79, 0x70, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x2f, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e,
-	0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x4d, 0x61,
-	0x63, 0x68, 0x69, 0x6e, 0x65, 0x4c, 0x53, 0x45, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x74, 0x79, 0x70,
-	0x65, 0x12, 0x87, 0x01, 0x0a, 0x19, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x63, 0x68,
+	0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x61, 0x63, 0x68,
+	0x69, 0x6e, 0x65, 0x4c, 0x53, 0x45, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x39, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65,
+	0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70,
+	0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x4c, 0x53, 0x45,
+	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x6e, 0x0a, 0x19, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x61, 0x63, 0x68,
 	0x69, 0x6e, 0x65, 0x4c, 0x53, 0x45, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x74, 0x79, 0x70, 0x65, 0x12,
 	0x39, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61,
-	0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
 	0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x4c, 0x53, 0x45, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x74,
-	0x79, 0x70, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x75, 0x6e, 0x69,
-	0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31,
-	0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x4c,
-	0x53, 0x45, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x74, 0x79, 0x70, 0x65, 0x12, 0x81, 0x01, 0x0a, 0x16,
-	0x47, 0x65, 0x74, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x4c, 0x53, 0x45, 0x50, 0x72, 0x6f,
-	0x74, 0x6f, 0x74, 0x79, 0x70, 0x65, 0x12, 0x36, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64,
-	0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63,
-	0x2e, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x4c, 0x53, 0x45, 0x50, 0x72,
-	0x6f, 0x74, 0x6f, 0x74, 0x79, 0x70, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f,
-	0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70,
-	0x69, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x4d, 0x61, 0x63, 0x68,
-	0x69, 0x6e, 0x65, 0x4c, 0x53, 0x45, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x74, 0x79, 0x70, 0x65, 0x12,
-	0x8f, 0x01, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x4c,
-	0x53, 0x45, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x12, 0x38, 0x2e, 0x75,
+	0x79, 0x70, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x12, 0x7e, 0x0a, 0x16, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x61, 0x63, 0x6b,
+	0x4c, 0x53, 0x45, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x74, 0x79, 0x70, 0x65, 0x12, 0x36, 0x2e, 0x75,
 	0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e,
-	0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x61, 0x63, 0x68, 0x69,
-	0x6e, 0x65, 0x4c, 0x53, 0x45, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x39, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64,
-	0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63,
-	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x4c, 0x53, 0x45, 0x50,
-	0x72, 0x6f, 0x74, 0x6f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x6e, 0x0a, 0x19, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x61, 0x63, 0x68, 0x69,
-	0x6e, 0x65, 0x4c, 0x53, 0x45, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x74, 0x79, 0x70, 0x65, 0x12, 0x39,
+	0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x61, 0x63,
+	0x6b, 0x4c, 0x53, 0x45, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x74, 0x79, 0x70, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c,
+	0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
+	0x73, 0x2e, 0x52, 0x61, 0x63, 0x6b, 0x4c, 0x53, 0x45, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x74, 0x79,
+	0x70, 0x65, 0x12, 0x7e, 0x0a, 0x16, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x61, 0x63, 0x6b,
+	0x4c, 0x53, 0x45, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x74, 0x79, 0x70, 0x65, 0x12, 0x36, 0x2e, 0x75,
+	0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x61, 0x63,
+	0x6b, 0x4c, 0x53, 0x45, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x74, 0x79, 0x70, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c,
+	0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
+	0x73, 0x2e, 0x52, 0x61, 0x63, 0x6b, 0x4c, 0x53, 0x45, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x74, 0x79,
+	0x70, 0x65, 0x12, 0x78, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x52, 0x61, 0x63, 0x6b, 0x4c, 0x53, 0x45,
+	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x74, 0x79, 0x70, 0x65, 0x12, 0x33, 0x2e, 0x75, 0x6e, 0x69, 0x66,
+	0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e,
+	0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x61, 0x63, 0x6b, 0x4c, 0x53, 0x45, 0x50, 0x72,
+	0x6f, 0x74, 0x6f, 0x74, 0x79, 0x70, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c,
 	0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70,
-	0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d,
-	0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x4c, 0x53, 0x45, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x74, 0x79,
-	0x70, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74,
-	0x79, 0x12, 0x7e, 0x0a, 0x16, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x61, 0x63, 0x6b, 0x4c,
-	0x53, 0x45, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x74, 0x79, 0x70, 0x65, 0x12, 0x36, 0x2e, 0x75, 0x6e,
+	0x69, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x52, 0x61, 0x63, 0x6b,
+	0x4c, 0x53, 0x45, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x74, 0x79, 0x70, 0x65, 0x12, 0x86, 0x01, 0x0a,
+	0x15, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x61, 0x63, 0x6b, 0x4c, 0x53, 0x45, 0x50, 0x72, 0x6f, 0x74,
+	0x6f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x12, 0x35, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64,
+	0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x61, 0x63, 0x6b, 0x4c, 0x53, 0x45, 0x50, 0x72, 0x6f, 0x74,
+	0x6f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x36, 0x2e,
+	0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x61, 0x63, 0x6b,
+	0x4c, 0x53, 0x45, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x68, 0x0a, 0x16, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52,
+	0x61, 0x63, 0x6b, 0x4c, 0x53, 0x45, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x74, 0x79, 0x70, 0x65, 0x12,
+	0x36, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x52, 0x61, 0x63, 0x6b, 0x4c, 0x53, 0x45, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x74, 0x79, 0x70, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12,
+	0x6f, 0x0a, 0x13, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x33, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64,
+	0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63,
+	0x2e, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x75, 0x6e,
 	0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
-	0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x61, 0x63, 0x6b,
-	0x4c, 0x53, 0x45, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x74, 0x79, 0x70, 0x65, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65,
-	0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73,
-	0x2e, 0x52, 0x61, 0x63, 0x6b, 0x4c, 0x53, 0x45, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x74, 0x79, 0x70,
-	0x65, 0x12, 0x7e, 0x0a, 0x16, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x61, 0x63, 0x6b, 0x4c,
-	0x53, 0x45, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x74, 0x79, 0x70, 0x65, 0x12, 0x36, 0x2e, 0x75, 0x6e,
+	0x31, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65,
+	0x12, 0x63, 0x0a, 0x0d, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e,
+	0x65, 0x12, 0x2d, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x23, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x4d, 0x61,
+	0x63, 0x68, 0x69, 0x6e, 0x65, 0x12, 0x5d, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x63, 0x68,
+	0x69, 0x6e, 0x65, 0x12, 0x2a, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65,
+	0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65,
+	0x74, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x23, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x4d, 0x61, 0x63,
+	0x68, 0x69, 0x6e, 0x65, 0x12, 0x6b, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x61, 0x63, 0x68,
+	0x69, 0x6e, 0x65, 0x73, 0x12, 0x2c, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c,
+	0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65,
+	0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x56, 0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x61, 0x63, 0x68, 0x69,
+	0x6e, 0x65, 0x12, 0x2d, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65,
+	0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x54, 0x0a, 0x0e, 0x49, 0x6d, 0x70,
+	0x6f, 0x72, 0x74, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x73, 0x12, 0x2e, 0x2e, 0x75, 0x6e,
 	0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
-	0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x61, 0x63, 0x6b,
-	0x4c, 0x53, 0x45, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x74, 0x79, 0x70, 0x65, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65,
-	0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73,
-	0x2e, 0x52, 0x61, 0x63, 0x6b, 0x4c, 0x53, 0x45, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x74, 0x79, 0x70,
-	0x65, 0x12, 0x78, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x52, 0x61, 0x63, 0x6b, 0x4c, 0x53, 0x45, 0x50,
-	0x72, 0x6f, 0x74, 0x6f, 0x74, 0x79, 0x70, 0x65, 0x12, 0x33, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69,
-	0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72,
-	0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x61, 0x63, 0x6b, 0x4c, 0x53, 0x45, 0x50, 0x72, 0x6f,
-	0x74, 0x6f, 0x74, 0x79, 0x70, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e,
-	0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69,
-	0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x52, 0x61, 0x63, 0x6b, 0x4c,
-	0x53, 0x45, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x74, 0x79, 0x70, 0x65, 0x12, 0x86, 0x01, 0x0a, 0x15,
-	0x4c, 0x69, 0x73, 0x74, 0x52, 0x61, 0x63, 0x6b, 0x4c, 0x53, 0x45, 0x50, 0x72, 0x6f, 0x74, 0x6f,
-	0x74, 0x79, 0x70, 0x65, 0x73, 0x12, 0x35, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66,
-	0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e,
-	0x4c, 0x69, 0x73, 0x74, 0x52, 0x61, 0x63, 0x6b, 0x4c, 0x53, 0x45, 0x50, 0x72, 0x6f, 0x74, 0x6f,
-	0x74, 0x79, 0x70, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x36, 0x2e, 0x75,
-	0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e,
-	0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x61, 0x63, 0x6b, 0x4c,
-	0x53, 0x45, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x68, 0x0a, 0x16, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x61,
-	0x63, 0x6b, 0x4c, 0x53, 0x45, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x74, 0x79, 0x70, 0x65, 0x12, 0x36,
-	0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70,
-	0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52,
-	0x61, 0x63, 0x6b, 0x4c, 0x53, 0x45, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x74, 0x79, 0x70, 0x65, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x6f,
-	0x0a, 0x13, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x33, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66,
-	0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e,
-	0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x75, 0x6e, 0x69,
-	0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31,
-	0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x12,
-	0x63, 0x0a, 0x0d, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65,
+	0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x4d, 0x61, 0x63, 0x68,
+	0x69, 0x6e, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x63, 0x0a, 0x0d, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65,
 	0x12, 0x2d, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e,
-	0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x6e, 0x61, 0x6d,
 	0x65, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
 	0x23, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61,
 	0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x4d, 0x61, 0x63,
-	0x68, 0x69, 0x6e, 0x65, 0x12, 0x5d, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x63, 0x68, 0x69,
-	0x6e, 0x65, 0x12, 0x2a, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65,
-	0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74,
-	0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23,
-	0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70,
-	0x69, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x4d, 0x61, 0x63, 0x68,
-	0x69, 0x6e, 0x65, 0x12, 0x6b, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x61, 0x63, 0x68, 0x69,
-	0x6e, 0x65, 0x73, 0x12, 0x2c, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65,
-	0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69,
-	0x73, 0x74, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x2d, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74,
-	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74,
-	0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x56, 0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e,
-	0x65, 0x12, 0x2d, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74,
-	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x54, 0x0a, 0x0e, 0x49, 0x6d, 0x70, 0x6f,
-	0x72, 0x74, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x73, 0x12, 0x2e, 0x2e, 0x75, 0x6e, 0x69,
-	0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31,
-	0x2e, 0x72, 0x70, 0x63, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x4d, 0x61, 0x63, 0x68, 0x69,
-	0x6e, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x63,
-	0x0a, 0x0d, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x12,
-	0x2d, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61,
-	0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65,
-	0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23,
-	0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70,
-	0x69, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x4d, 0x61, 0x63, 0x68,
-	0x69, 0x6e, 0x65, 0x12, 0x66, 0x0a, 0x10, 0x52, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x67, 0x69, 0x73,
-	0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x30, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65,
-	0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70,
-	0x63, 0x2e, 0x52, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x75, 0x6e, 0x69, 0x66,
-	0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e,
-	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x52, 0x61, 0x63, 0x6b, 0x12, 0x5a, 0x0a, 0x0a, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x61, 0x63, 0x6b, 0x12, 0x2a, 0x2e, 0x75, 0x6e, 0x69, 0x66,
-	0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e,
-	0x72, 0x70, 0x63, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x61, 0x63, 0x6b, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66,
-	0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x64, 0x65,
-	0x6c, 0x73, 0x2e, 0x52, 0x61, 0x63, 0x6b, 0x12, 0x54, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x52, 0x61,
-	0x63, 0x6b, 0x12, 0x27, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65,
-	0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74,
-	0x52, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x75, 0x6e,
-	0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
-	0x31, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x52, 0x61, 0x63, 0x6b, 0x12, 0x62, 0x0a,
-	0x09, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x61, 0x63, 0x6b, 0x73, 0x12, 0x29, 0x2e, 0x75, 0x6e, 0x69,
+	0x68, 0x69, 0x6e, 0x65, 0x12, 0x65, 0x0a, 0x0e, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x4d,
+	0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x12, 0x2e, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64,
+	0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63,
+	0x2e, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64,
+	0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x64,
+	0x65, 0x6c, 0x73, 0x2e, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x12, 0x66, 0x0a, 0x10, 0x52,
+	0x61, 0x63, 0x6b, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x30, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x61, 0x63, 0x6b, 0x52, 0x65,
+	0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x20, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x52,
+	0x61, 0x63, 0x6b, 0x12, 0x5a, 0x0a, 0x0a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x61, 0x63,
+	0x6b, 0x12, 0x2a, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x52, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e,
+	0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x52, 0x61, 0x63, 0x6b, 0x12,
+	0x54, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x52, 0x61, 0x63, 0x6b, 0x12, 0x27, 0x2e, 0x75, 0x6e, 0x69,
 	0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31,
-	0x2e, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x61, 0x63, 0x6b, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66,
-	0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e,
-	0x4c, 0x69, 0x73, 0x74, 0x52, 0x61, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x50, 0x0a, 0x0a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x61, 0x63, 0x6b, 0x12,
-	0x2a, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61,
-	0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
-	0x52, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
-	0x70, 0x74, 0x79, 0x12, 0x6c, 0x0a, 0x10, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x63,
-	0x68, 0x69, 0x6e, 0x65, 0x4c, 0x53, 0x45, 0x12, 0x30, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65,
+	0x2e, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65,
+	0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73,
+	0x2e, 0x52, 0x61, 0x63, 0x6b, 0x12, 0x62, 0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x61, 0x63,
+	0x6b, 0x73, 0x12, 0x29, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65,
+	0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x52, 0x61, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e,
+	0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x61, 0x63, 0x6b,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x50, 0x0a, 0x0a, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x52, 0x61, 0x63, 0x6b, 0x12, 0x2a, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65,
 	0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70,
-	0x63, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x4c,
-	0x53, 0x45, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x75, 0x6e, 0x69, 0x66,
-	0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e,
-	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x4c, 0x53,
-	0x45, 0x12, 0x6c, 0x0a, 0x10, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x63, 0x68, 0x69,
-	0x6e, 0x65, 0x4c, 0x53, 0x45, 0x12, 0x30, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66,
-	0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x4c, 0x53, 0x45,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65,
-	0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f,
-	0x64, 0x65, 0x6c, 0x73, 0x2e, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x4c, 0x53, 0x45, 0x12,
-	0x66, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x4c, 0x53, 0x45,
-	0x12, 0x2d, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e,
-	0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x61,
-	0x63, 0x68, 0x69, 0x6e, 0x65, 0x4c, 0x53, 0x45, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x26, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61,
-	0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x4d, 0x61, 0x63,
-	0x68, 0x69, 0x6e, 0x65, 0x4c, 0x53, 0x45, 0x12, 0x74, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x4d,
-	0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x4c, 0x53, 0x45, 0x73, 0x12, 0x2f, 0x2e, 0x75, 0x6e, 0x69,
-	0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31,
-	0x2e, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65,
-	0x4c, 0x53, 0x45, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x75, 0x6e,
-	0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
-	0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e,
-	0x65, 0x4c, 0x53, 0x45, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5c, 0x0a,
-	0x10, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x4c, 0x53,
-	0x45, 0x12, 0x30, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74,
-	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x4c, 0x53, 0x45, 0x52, 0x65, 0x71, 0x75,
+	0x63, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75,
 	0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x6c, 0x0a, 0x10, 0x52,
-	0x65, 0x6e, 0x61, 0x6d, 0x65, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x4c, 0x53, 0x45, 0x12,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x6c, 0x0a, 0x10, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x4c, 0x53, 0x45, 0x12,
 	0x30, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61,
-	0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65,
+	0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
 	0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x4c, 0x53, 0x45, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
 	0x74, 0x1a, 0x26, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74,
 	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x4d,
-	0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x4c, 0x53, 0x45, 0x12, 0x5a, 0x0a, 0x11, 0x49, 0x6d, 0x70,
-	0x6f, 0x72, 0x74, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x4c, 0x53, 0x45, 0x73, 0x12, 0x31,
-	0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70,
-	0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x4d,
-	0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x4c, 0x53, 0x45, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x12, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x53,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x5e, 0x0a, 0x13, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x4f,
-	0x53, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x4c, 0x53, 0x45, 0x73, 0x12, 0x33, 0x2e, 0x75,
-	0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e,
-	0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x4f, 0x53, 0x4d,
-	0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x4c, 0x53, 0x45, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x12, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x53,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x63, 0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52,
-	0x61, 0x63, 0x6b, 0x4c, 0x53, 0x45, 0x12, 0x2d, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64,
-	0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63,
-	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x61, 0x63, 0x6b, 0x4c, 0x53, 0x45, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66,
-	0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x64, 0x65,
-	0x6c, 0x73, 0x2e, 0x52, 0x61, 0x63, 0x6b, 0x4c, 0x53, 0x45, 0x12, 0x63, 0x0a, 0x0d, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x52, 0x61, 0x63, 0x6b, 0x4c, 0x53, 0x45, 0x12, 0x2d, 0x2e, 0x75, 0x6e,
-	0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
-	0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x61, 0x63, 0x6b,
-	0x4c, 0x53, 0x45, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x75, 0x6e, 0x69,
-	0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31,
-	0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x52, 0x61, 0x63, 0x6b, 0x4c, 0x53, 0x45, 0x12,
-	0x5d, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x52, 0x61, 0x63, 0x6b, 0x4c, 0x53, 0x45, 0x12, 0x2a, 0x2e,
+	0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x4c, 0x53, 0x45, 0x12, 0x6c, 0x0a, 0x10, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x4c, 0x53, 0x45, 0x12, 0x30, 0x2e,
 	0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69,
-	0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x61, 0x63, 0x6b, 0x4c,
-	0x53, 0x45, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x75, 0x6e, 0x69, 0x66,
+	0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61,
+	0x63, 0x68, 0x69, 0x6e, 0x65, 0x4c, 0x53, 0x45, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x26, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x4d, 0x61, 0x63,
+	0x68, 0x69, 0x6e, 0x65, 0x4c, 0x53, 0x45, 0x12, 0x66, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x4d, 0x61,
+	0x63, 0x68, 0x69, 0x6e, 0x65, 0x4c, 0x53, 0x45, 0x12, 0x2d, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69,
+	0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72,
+	0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x4c, 0x53, 0x45,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65,
+	0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f,
+	0x64, 0x65, 0x6c, 0x73, 0x2e, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x4c, 0x53, 0x45, 0x12,
+	0x74, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x4c, 0x53,
+	0x45, 0x73, 0x12, 0x2f, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65,
+	0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x4c, 0x53, 0x45, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65,
+	0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x4c, 0x53, 0x45, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5c, 0x0a, 0x10, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d,
+	0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x4c, 0x53, 0x45, 0x12, 0x30, 0x2e, 0x75, 0x6e, 0x69, 0x66,
 	0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e,
-	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x52, 0x61, 0x63, 0x6b, 0x4c, 0x53, 0x45, 0x12, 0x6b,
-	0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x61, 0x63, 0x6b, 0x4c, 0x53, 0x45, 0x73, 0x12, 0x2c,
-	0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70,
-	0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x61, 0x63,
-	0x6b, 0x4c, 0x53, 0x45, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x75,
-	0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e,
-	0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x61, 0x63, 0x6b, 0x4c,
-	0x53, 0x45, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x56, 0x0a, 0x0d, 0x44,
-	0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x61, 0x63, 0x6b, 0x4c, 0x53, 0x45, 0x12, 0x2d, 0x2e, 0x75,
-	0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e,
-	0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x61, 0x63,
-	0x6b, 0x4c, 0x53, 0x45, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f,
+	0x72, 0x70, 0x63, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e,
+	0x65, 0x4c, 0x53, 0x45, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f,
 	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
-	0x70, 0x74, 0x79, 0x12, 0x57, 0x0a, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4e, 0x69, 0x63,
-	0x12, 0x29, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e,
-	0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x4e, 0x69, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x75, 0x6e,
-	0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
-	0x31, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x4e, 0x69, 0x63, 0x12, 0x57, 0x0a, 0x09,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4e, 0x69, 0x63, 0x12, 0x29, 0x2e, 0x75, 0x6e, 0x69, 0x66,
+	0x70, 0x74, 0x79, 0x12, 0x6c, 0x0a, 0x10, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x4d, 0x61, 0x63,
+	0x68, 0x69, 0x6e, 0x65, 0x4c, 0x53, 0x45, 0x12, 0x30, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65,
+	0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70,
+	0x63, 0x2e, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x4c,
+	0x53, 0x45, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x75, 0x6e, 0x69, 0x66,
 	0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e,
-	0x72, 0x70, 0x63, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4e, 0x69, 0x63, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c,
-	0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
-	0x73, 0x2e, 0x4e, 0x69, 0x63, 0x12, 0x51, 0x0a, 0x06, 0x47, 0x65, 0x74, 0x4e, 0x69, 0x63, 0x12,
-	0x26, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61,
-	0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x4e, 0x69, 0x63,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65,
-	0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f,
-	0x64, 0x65, 0x6c, 0x73, 0x2e, 0x4e, 0x69, 0x63, 0x12, 0x5f, 0x0a, 0x08, 0x4c, 0x69, 0x73, 0x74,
-	0x4e, 0x69, 0x63, 0x73, 0x12, 0x28, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c,
-	0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x4c,
-	0x69, 0x73, 0x74, 0x4e, 0x69, 0x63, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29,
-	0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70,
-	0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4e, 0x69, 0x63,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4e, 0x0a, 0x09, 0x44, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x4e, 0x69, 0x63, 0x12, 0x29, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x4c, 0x53,
+	0x45, 0x12, 0x6e, 0x0a, 0x11, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x4d, 0x61, 0x63, 0x68,
+	0x69, 0x6e, 0x65, 0x4c, 0x53, 0x45, 0x12, 0x31, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64,
 	0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63,
-	0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4e, 0x69, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x4c, 0x0a, 0x0a, 0x49, 0x6d, 0x70,
-	0x6f, 0x72, 0x74, 0x4e, 0x69, 0x63, 0x73, 0x12, 0x2a, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65,
-	0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70,
-	0x63, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x4e, 0x69, 0x63, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x72, 0x70, 0x63,
-	0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x57, 0x0a, 0x09, 0x52, 0x65, 0x6e, 0x61, 0x6d,
-	0x65, 0x4e, 0x69, 0x63, 0x12, 0x29, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c,
-	0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x52,
-	0x65, 0x6e, 0x61, 0x6d, 0x65, 0x4e, 0x69, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x1f, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61,
-	0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x4e, 0x69, 0x63,
-	0x12, 0x5a, 0x0a, 0x11, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x44, 0x61, 0x74, 0x61, 0x63, 0x65,
-	0x6e, 0x74, 0x65, 0x72, 0x73, 0x12, 0x31, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66,
-	0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e,
-	0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x44, 0x61, 0x74, 0x61, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x57, 0x0a, 0x09,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4b, 0x56, 0x4d, 0x12, 0x29, 0x2e, 0x75, 0x6e, 0x69, 0x66,
+	0x2e, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x4c,
+	0x53, 0x45, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x75, 0x6e, 0x69, 0x66,
 	0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e,
-	0x72, 0x70, 0x63, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4b, 0x56, 0x4d, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c,
-	0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
-	0x73, 0x2e, 0x4b, 0x56, 0x4d, 0x12, 0x57, 0x0a, 0x09, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4b,
-	0x56, 0x4d, 0x12, 0x29, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65,
-	0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x4b, 0x56, 0x4d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e,
-	0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69,
-	0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x4b, 0x56, 0x4d, 0x12, 0x51,
-	0x0a, 0x06, 0x47, 0x65, 0x74, 0x4b, 0x56, 0x4d, 0x12, 0x26, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69,
-	0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72,
-	0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x4b, 0x56, 0x4d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x1f, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e,
-	0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x4b, 0x56,
-	0x4d, 0x12, 0x5f, 0x0a, 0x08, 0x4c, 0x69, 0x73, 0x74, 0x4b, 0x56, 0x4d, 0x73, 0x12, 0x28, 0x2e,
-	0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69,
-	0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4b, 0x56, 0x4d, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65,
-	0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70,
-	0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4b, 0x56, 0x4d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x4e, 0x0a, 0x09, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4b, 0x56, 0x4d, 0x12,
-	0x29, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61,
-	0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
-	0x4b, 0x56, 0x4d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
-	0x74, 0x79, 0x12, 0x57, 0x0a, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x50, 0x4d, 0x12,
-	0x29, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61,
-	0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x52, 0x50, 0x4d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x75, 0x6e, 0x69,
-	0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31,
-	0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x52, 0x50, 0x4d, 0x12, 0x57, 0x0a, 0x09, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x50, 0x4d, 0x12, 0x29, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69,
-	0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72,
-	0x70, 0x63, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x50, 0x4d, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65,
-	0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73,
-	0x2e, 0x52, 0x50, 0x4d, 0x12, 0x51, 0x0a, 0x06, 0x47, 0x65, 0x74, 0x52, 0x50, 0x4d, 0x12, 0x26,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x4c, 0x53,
+	0x45, 0x12, 0x5a, 0x0a, 0x11, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x4d, 0x61, 0x63, 0x68, 0x69,
+	0x6e, 0x65, 0x4c, 0x53, 0x45, 0x73, 0x12, 0x31, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64,
+	0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63,
+	0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x4c, 0x53,
+	0x45, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x5e, 0x0a,
+	0x13, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x4f, 0x53, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65,
+	0x4c, 0x53, 0x45, 0x73, 0x12, 0x33, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c,
+	0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x49,
+	0x6d, 0x70, 0x6f, 0x72, 0x74, 0x4f, 0x53, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x4c, 0x53,
+	0x45, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x63, 0x0a,
+	0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x61, 0x63, 0x6b, 0x4c, 0x53, 0x45, 0x12, 0x2d,
 	0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70,
-	0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x50, 0x4d, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64,
-	0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x64,
-	0x65, 0x6c, 0x73, 0x2e, 0x52, 0x50, 0x4d, 0x12, 0x5f, 0x0a, 0x08, 0x4c, 0x69, 0x73, 0x74, 0x52,
-	0x50, 0x4d, 0x73, 0x12, 0x28, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65,
-	0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69,
-	0x73, 0x74, 0x52, 0x50, 0x4d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e,
+	0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52,
+	0x61, 0x63, 0x6b, 0x4c, 0x53, 0x45, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e,
 	0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69,
-	0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x50, 0x4d, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4e, 0x0a, 0x09, 0x44, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x52, 0x50, 0x4d, 0x12, 0x29, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66,
+	0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x52, 0x61, 0x63, 0x6b, 0x4c,
+	0x53, 0x45, 0x12, 0x63, 0x0a, 0x0d, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x61, 0x63, 0x6b,
+	0x4c, 0x53, 0x45, 0x12, 0x2d, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65,
+	0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x52, 0x61, 0x63, 0x6b, 0x4c, 0x53, 0x45, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x23, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65,
+	0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e,
+	0x52, 0x61, 0x63, 0x6b, 0x4c, 0x53, 0x45, 0x12, 0x5d, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x52, 0x61,
+	0x63, 0x6b, 0x4c, 0x53, 0x45, 0x12, 0x2a, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66,
 	0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e,
-	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x50, 0x4d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x5a, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x44, 0x72, 0x61, 0x63, 0x12, 0x2a, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64,
+	0x47, 0x65, 0x74, 0x52, 0x61, 0x63, 0x6b, 0x4c, 0x53, 0x45, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x23, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x52,
+	0x61, 0x63, 0x6b, 0x4c, 0x53, 0x45, 0x12, 0x6b, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x61,
+	0x63, 0x6b, 0x4c, 0x53, 0x45, 0x73, 0x12, 0x2c, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64,
 	0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63,
-	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x72, 0x61, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x20, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65,
-	0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e,
-	0x44, 0x72, 0x61, 0x63, 0x12, 0x5a, 0x0a, 0x0a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x72,
-	0x61, 0x63, 0x12, 0x2a, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65,
-	0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x44, 0x72, 0x61, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20,
-	0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70,
-	0x69, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x44, 0x72, 0x61, 0x63,
-	0x12, 0x54, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x44, 0x72, 0x61, 0x63, 0x12, 0x27, 0x2e, 0x75, 0x6e,
-	0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
-	0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x72, 0x61, 0x63, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c,
-	0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
-	0x73, 0x2e, 0x44, 0x72, 0x61, 0x63, 0x12, 0x62, 0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x72,
-	0x61, 0x63, 0x73, 0x12, 0x29, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65,
-	0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69,
-	0x73, 0x74, 0x44, 0x72, 0x61, 0x63, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a,
-	0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70,
-	0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x72, 0x61,
-	0x63, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x50, 0x0a, 0x0a, 0x44, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x44, 0x72, 0x61, 0x63, 0x12, 0x2a, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x61, 0x63, 0x6b, 0x4c, 0x53, 0x45, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c,
+	0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x52, 0x61, 0x63, 0x6b, 0x4c, 0x53, 0x45, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x56, 0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x61, 0x63,
+	0x6b, 0x4c, 0x53, 0x45, 0x12, 0x2d, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c,
+	0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x61, 0x63, 0x6b, 0x4c, 0x53, 0x45, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x57, 0x0a, 0x09, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x4e, 0x69, 0x63, 0x12, 0x29, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69,
 	0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72,
-	0x70, 0x63, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x44, 0x72, 0x61, 0x63, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x60, 0x0a, 0x0c,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x12, 0x2c, 0x2e, 0x75,
+	0x70, 0x63, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4e, 0x69, 0x63, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65,
+	0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73,
+	0x2e, 0x4e, 0x69, 0x63, 0x12, 0x57, 0x0a, 0x09, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4e, 0x69,
+	0x63, 0x12, 0x29, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x4e, 0x69, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x75,
 	0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e,
-	0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x77, 0x69,
-	0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x75, 0x6e, 0x69,
-	0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31,
-	0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x12, 0x60,
-	0x0a, 0x0c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x12, 0x2c,
+	0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x4e, 0x69, 0x63, 0x12, 0x51, 0x0a,
+	0x06, 0x47, 0x65, 0x74, 0x4e, 0x69, 0x63, 0x12, 0x26, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65,
+	0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70,
+	0x63, 0x2e, 0x47, 0x65, 0x74, 0x4e, 0x69, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1f, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x4e, 0x69, 0x63,
+	0x12, 0x5f, 0x0a, 0x08, 0x4c, 0x69, 0x73, 0x74, 0x4e, 0x69, 0x63, 0x73, 0x12, 0x28, 0x2e, 0x75,
+	0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4e, 0x69, 0x63, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64,
+	0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4e, 0x69, 0x63, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x4e, 0x0a, 0x09, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4e, 0x69, 0x63, 0x12, 0x29,
 	0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70,
-	0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53,
-	0x77, 0x69, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x75,
+	0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4e,
+	0x69, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74,
+	0x79, 0x12, 0x4c, 0x0a, 0x0a, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x4e, 0x69, 0x63, 0x73, 0x12,
+	0x2a, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74,
+	0x4e, 0x69, 0x63, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x57, 0x0a, 0x09, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x4e, 0x69, 0x63, 0x12, 0x29, 0x2e, 0x75,
 	0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e,
-	0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68,
-	0x12, 0x5a, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x12, 0x29, 0x2e,
+	0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x4e, 0x69, 0x63,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65,
+	0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f,
+	0x64, 0x65, 0x6c, 0x73, 0x2e, 0x4e, 0x69, 0x63, 0x12, 0x5a, 0x0a, 0x11, 0x49, 0x6d, 0x70, 0x6f,
+	0x72, 0x74, 0x44, 0x61, 0x74, 0x61, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x73, 0x12, 0x31, 0x2e,
 	0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69,
-	0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x77, 0x69, 0x74, 0x63,
-	0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69,
-	0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x6d,
-	0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x12, 0x6b, 0x0a, 0x0c,
-	0x4c, 0x69, 0x73, 0x74, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x65, 0x73, 0x12, 0x2c, 0x2e, 0x75,
+	0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x44, 0x61,
+	0x74, 0x61, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x12, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x57, 0x0a, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4b, 0x56,
+	0x4d, 0x12, 0x29, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x4b, 0x56, 0x4d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x75,
 	0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e,
-	0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x77, 0x69, 0x74, 0x63,
-	0x68, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x75, 0x6e, 0x69,
+	0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x4b, 0x56, 0x4d, 0x12, 0x57, 0x0a,
+	0x09, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4b, 0x56, 0x4d, 0x12, 0x29, 0x2e, 0x75, 0x6e, 0x69,
 	0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31,
-	0x2e, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x65,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x54, 0x0a, 0x0c, 0x44, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x12, 0x2c, 0x2e, 0x75, 0x6e, 0x69, 0x66,
-	0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e,
-	0x72, 0x70, 0x63, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12,
-	0x60, 0x0a, 0x0c, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x12,
-	0x2c, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61,
-	0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65,
-	0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e,
-	0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69,
-	0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x53, 0x77, 0x69, 0x74, 0x63,
-	0x68, 0x12, 0x5a, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56, 0x6c, 0x61, 0x6e, 0x12,
-	0x2a, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61,
-	0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x56, 0x6c, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x75, 0x6e,
+	0x2e, 0x72, 0x70, 0x63, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4b, 0x56, 0x4d, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66,
+	0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x64, 0x65,
+	0x6c, 0x73, 0x2e, 0x4b, 0x56, 0x4d, 0x12, 0x51, 0x0a, 0x06, 0x47, 0x65, 0x74, 0x4b, 0x56, 0x4d,
+	0x12, 0x26, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x4b, 0x56,
+	0x4d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69,
+	0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x4b, 0x56, 0x4d, 0x12, 0x5f, 0x0a, 0x08, 0x4c, 0x69, 0x73,
+	0x74, 0x4b, 0x56, 0x4d, 0x73, 0x12, 0x28, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66,
+	0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x4b, 0x56, 0x4d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x29, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4b, 0x56,
+	0x4d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4e, 0x0a, 0x09, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x4b, 0x56, 0x4d, 0x12, 0x29, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65,
+	0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70,
+	0x63, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4b, 0x56, 0x4d, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x57, 0x0a, 0x09, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x52, 0x50, 0x4d, 0x12, 0x29, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65,
+	0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70,
+	0x63, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x50, 0x4d, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65,
+	0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e,
+	0x52, 0x50, 0x4d, 0x12, 0x57, 0x0a, 0x09, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x50, 0x4d,
+	0x12, 0x29, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x52, 0x50, 0x4d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x75, 0x6e,
 	0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
-	0x31, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x56, 0x6c, 0x61, 0x6e, 0x12, 0x5a, 0x0a,
-	0x0a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x56, 0x6c, 0x61, 0x6e, 0x12, 0x2a, 0x2e, 0x75, 0x6e,
+	0x31, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x52, 0x50, 0x4d, 0x12, 0x51, 0x0a, 0x06,
+	0x47, 0x65, 0x74, 0x52, 0x50, 0x4d, 0x12, 0x26, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64,
+	0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63,
+	0x2e, 0x47, 0x65, 0x74, 0x52, 0x50, 0x4d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f,
+	0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x52, 0x50, 0x4d, 0x12,
+	0x5f, 0x0a, 0x08, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x50, 0x4d, 0x73, 0x12, 0x28, 0x2e, 0x75, 0x6e,
 	0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
-	0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x56, 0x6c, 0x61, 0x6e,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65,
-	0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f,
-	0x64, 0x65, 0x6c, 0x73, 0x2e, 0x56, 0x6c, 0x61, 0x6e, 0x12, 0x54, 0x0a, 0x07, 0x47, 0x65, 0x74,
-	0x56, 0x6c, 0x61, 0x6e, 0x12, 0x27, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c,
-	0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x47,
-	0x65, 0x74, 0x56, 0x6c, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e,
+	0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x50, 0x4d, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66,
+	0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x52, 0x50, 0x4d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x4e, 0x0a, 0x09, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x50, 0x4d, 0x12, 0x29, 0x2e,
 	0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69,
-	0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x56, 0x6c, 0x61, 0x6e, 0x12,
-	0x62, 0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74, 0x56, 0x6c, 0x61, 0x6e, 0x73, 0x12, 0x29, 0x2e, 0x75,
+	0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x50,
+	0x4d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x12, 0x5a, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x72, 0x61, 0x63, 0x12, 0x2a,
+	0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44,
+	0x72, 0x61, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x75, 0x6e, 0x69,
+	0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31,
+	0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x44, 0x72, 0x61, 0x63, 0x12, 0x5a, 0x0a, 0x0a,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x72, 0x61, 0x63, 0x12, 0x2a, 0x2e, 0x75, 0x6e, 0x69,
+	0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31,
+	0x2e, 0x72, 0x70, 0x63, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x72, 0x61, 0x63, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64,
+	0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x64,
+	0x65, 0x6c, 0x73, 0x2e, 0x44, 0x72, 0x61, 0x63, 0x12, 0x54, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x44,
+	0x72, 0x61, 0x63, 0x12, 0x27, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65,
+	0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65,
+	0x74, 0x44, 0x72, 0x61, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x75,
 	0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e,
-	0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x56, 0x6c, 0x61, 0x6e, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65,
-	0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70,
-	0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x56, 0x6c, 0x61, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x50, 0x0a, 0x0a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x56, 0x6c, 0x61,
-	0x6e, 0x12, 0x2a, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74,
+	0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x44, 0x72, 0x61, 0x63, 0x12, 0x62,
+	0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x72, 0x61, 0x63, 0x73, 0x12, 0x29, 0x2e, 0x75, 0x6e,
+	0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
+	0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x72, 0x61, 0x63, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64,
+	0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x72, 0x61, 0x63, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x50, 0x0a, 0x0a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x44, 0x72, 0x61, 0x63,
+	0x12, 0x2a, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x44, 0x72, 0x61, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x12, 0x60, 0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x77,
+	0x69, 0x74, 0x63, 0x68, 0x12, 0x2c, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c,
+	0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x22, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65,
+	0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e,
+	0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x12, 0x60, 0x0a, 0x0c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x12, 0x2c, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64,
+	0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63,
+	0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c,
+	0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
+	0x73, 0x2e, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x12, 0x5a, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x53,
+	0x77, 0x69, 0x74, 0x63, 0x68, 0x12, 0x29, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66,
+	0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e,
+	0x47, 0x65, 0x74, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x22, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x53, 0x77,
+	0x69, 0x74, 0x63, 0x68, 0x12, 0x6b, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x77, 0x69, 0x74,
+	0x63, 0x68, 0x65, 0x73, 0x12, 0x2c, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c,
+	0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65,
+	0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x54, 0x0a, 0x0c, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x77, 0x69, 0x74, 0x63,
+	0x68, 0x12, 0x2c, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74,
 	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x56, 0x6c, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x4e, 0x0a, 0x0b, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x56,
-	0x6c, 0x61, 0x6e, 0x73, 0x12, 0x2b, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c,
-	0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x49,
-	0x6d, 0x70, 0x6f, 0x72, 0x74, 0x56, 0x6c, 0x61, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x12, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x53,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x52, 0x0a, 0x0d, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x4f,
-	0x53, 0x56, 0x6c, 0x61, 0x6e, 0x73, 0x12, 0x2d, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64,
-	0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63,
-	0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x4f, 0x53, 0x56, 0x6c, 0x61, 0x6e, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x72,
-	0x70, 0x63, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x50, 0x0a, 0x0c, 0x49, 0x6d, 0x70,
-	0x6f, 0x72, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x73, 0x12, 0x2c, 0x2e, 0x75, 0x6e, 0x69, 0x66,
+	0x74, 0x65, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x60, 0x0a, 0x0c, 0x52, 0x65, 0x6e, 0x61, 0x6d,
+	0x65, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x12, 0x2c, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65,
+	0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70,
+	0x63, 0x2e, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66,
+	0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x64, 0x65,
+	0x6c, 0x73, 0x2e, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x12, 0x5a, 0x0a, 0x0a, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x56, 0x6c, 0x61, 0x6e, 0x12, 0x2a, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65,
+	0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70,
+	0x63, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56, 0x6c, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65,
+	0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73,
+	0x2e, 0x56, 0x6c, 0x61, 0x6e, 0x12, 0x5a, 0x0a, 0x0a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x56,
+	0x6c, 0x61, 0x6e, 0x12, 0x2a, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65,
+	0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x56, 0x6c, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x20, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x56, 0x6c, 0x61,
+	0x6e, 0x12, 0x54, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x56, 0x6c, 0x61, 0x6e, 0x12, 0x27, 0x2e, 0x75,
+	0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x6c, 0x61, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66,
+	0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x64, 0x65,
+	0x6c, 0x73, 0x2e, 0x56, 0x6c, 0x61, 0x6e, 0x12, 0x62, 0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74, 0x56,
+	0x6c, 0x61, 0x6e, 0x73, 0x12, 0x29, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c,
+	0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x56, 0x6c, 0x61, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x2a, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x56, 0x6c,
+	0x61, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x50, 0x0a, 0x0a, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x56, 0x6c, 0x61, 0x6e, 0x12, 0x2a, 0x2e, 0x75, 0x6e, 0x69, 0x66,
 	0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e,
-	0x72, 0x70, 0x63, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x63, 0x0a, 0x0b, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x2b, 0x2e, 0x75, 0x6e, 0x69,
-	0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31,
-	0x2e, 0x72, 0x70, 0x63, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65,
-	0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f,
-	0x64, 0x65, 0x6c, 0x73, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64,
-	0x12, 0x5d, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x28, 0x2e, 0x75,
+	0x72, 0x70, 0x63, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x56, 0x6c, 0x61, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x4e, 0x0a,
+	0x0b, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x56, 0x6c, 0x61, 0x6e, 0x73, 0x12, 0x2b, 0x2e, 0x75,
 	0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e,
-	0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64,
-	0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x64,
-	0x65, 0x6c, 0x73, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12,
-	0x6d, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x44, 0x75, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x2b,
-	0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70,
-	0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x75, 0x74, 0x53,
-	0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e, 0x75, 0x6e,
-	0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
-	0x31, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x65, 0x6f,
-	0x73, 0x2e, 0x6c, 0x61, 0x62, 0x2e, 0x44, 0x75, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x6e,
-	0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x75, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x73, 0x12,
-	0x2d, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61,
-	0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x75,
-	0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e,
+	0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x56, 0x6c, 0x61,
+	0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x52, 0x0a,
+	0x0d, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x4f, 0x53, 0x56, 0x6c, 0x61, 0x6e, 0x73, 0x12, 0x2d,
 	0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70,
-	0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x75, 0x74,
-	0x53, 0x74, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x73,
-	0x0a, 0x0e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x75, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65,
-	0x12, 0x2e, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e,
-	0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x44, 0x75, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x31, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e,
-	0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x63, 0x68,
-	0x72, 0x6f, 0x6d, 0x65, 0x6f, 0x73, 0x2e, 0x6c, 0x61, 0x62, 0x2e, 0x44, 0x75, 0x74, 0x53, 0x74,
-	0x61, 0x74, 0x65, 0x12, 0x66, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x44, 0x48, 0x43, 0x50, 0x43, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x12, 0x2d, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c,
+	0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x4f,
+	0x53, 0x56, 0x6c, 0x61, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x50, 0x0a, 0x0c, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x73, 0x12, 0x2c, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x49, 0x6d, 0x70, 0x6f,
+	0x72, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x12, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x63, 0x0a, 0x0b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x12, 0x2b, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65,
+	0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x27, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x5d, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x12, 0x28, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c,
 	0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x47,
-	0x65, 0x74, 0x44, 0x48, 0x43, 0x50, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65,
+	0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27,
+	0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x6d, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x44, 0x75,
+	0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x2b, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64,
+	0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63,
+	0x2e, 0x47, 0x65, 0x74, 0x44, 0x75, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65,
 	0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73,
-	0x2e, 0x44, 0x48, 0x43, 0x50, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x54, 0x0a, 0x08, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x56, 0x4d, 0x12, 0x28, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65,
+	0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x65, 0x6f, 0x73, 0x2e, 0x6c, 0x61, 0x62, 0x2e, 0x44, 0x75,
+	0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x6e, 0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x75,
+	0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x73, 0x12, 0x2d, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65,
 	0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70,
-	0x63, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56, 0x4d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x1e, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74,
-	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x56,
-	0x4d, 0x12, 0x54, 0x0a, 0x08, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x56, 0x4d, 0x12, 0x28, 0x2e,
+	0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x75, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64,
+	0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x75, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x73, 0x0a, 0x0e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x44, 0x75, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x2e, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69,
+	0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72,
+	0x70, 0x63, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x75, 0x74, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69,
+	0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x65, 0x6f, 0x73, 0x2e, 0x6c,
+	0x61, 0x62, 0x2e, 0x44, 0x75, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x66, 0x0a, 0x0d, 0x47,
+	0x65, 0x74, 0x44, 0x48, 0x43, 0x50, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x2d, 0x2e, 0x75,
+	0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x48, 0x43, 0x50, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x75, 0x6e,
+	0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
+	0x31, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x44, 0x48, 0x43, 0x50, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x12, 0x54, 0x0a, 0x08, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56, 0x4d, 0x12,
+	0x28, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x56, 0x4d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x75, 0x6e, 0x69, 0x66,
+	0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x56, 0x4d, 0x12, 0x54, 0x0a, 0x08, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x56, 0x4d, 0x12, 0x28, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66,
+	0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x56, 0x4d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1e, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x56, 0x4d, 0x12,
+	0x4c, 0x0a, 0x08, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x56, 0x4d, 0x12, 0x28, 0x2e, 0x75, 0x6e,
+	0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
+	0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x56, 0x4d, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x4e, 0x0a,
+	0x05, 0x47, 0x65, 0x74, 0x56, 0x4d, 0x12, 0x25, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64,
+	0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63,
+	0x2e, 0x47, 0x65, 0x74, 0x56, 0x4d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e,
 	0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69,
-	0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x56, 0x4d,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x56, 0x4d, 0x12, 0x5c, 0x0a,
+	0x07, 0x4c, 0x69, 0x73, 0x74, 0x56, 0x4d, 0x73, 0x12, 0x27, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69,
+	0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72,
+	0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x56, 0x4d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x28, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x56, 0x4d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5d, 0x0a, 0x0b, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x73, 0x73, 0x65, 0x74, 0x12, 0x2b, 0x2e, 0x75, 0x6e, 0x69,
+	0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31,
+	0x2e, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x73, 0x73, 0x65, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65,
 	0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f,
-	0x64, 0x65, 0x6c, 0x73, 0x2e, 0x56, 0x4d, 0x12, 0x4c, 0x0a, 0x08, 0x44, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x56, 0x4d, 0x12, 0x28, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65,
-	0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x56, 0x4d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x4e, 0x0a, 0x05, 0x47, 0x65, 0x74, 0x56, 0x4d, 0x12, 0x25,
-	0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70,
-	0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x4d, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66,
-	0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x64, 0x65,
-	0x6c, 0x73, 0x2e, 0x56, 0x4d, 0x12, 0x5c, 0x0a, 0x07, 0x4c, 0x69, 0x73, 0x74, 0x56, 0x4d, 0x73,
-	0x12, 0x27, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e,
-	0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x56,
-	0x4d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x75, 0x6e, 0x69, 0x66,
+	0x64, 0x65, 0x6c, 0x73, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x74, 0x12, 0x5d, 0x0a, 0x0b, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x41, 0x73, 0x73, 0x65, 0x74, 0x12, 0x2b, 0x2e, 0x75, 0x6e, 0x69, 0x66,
 	0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e,
-	0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x56, 0x4d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x5d, 0x0a, 0x0b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x73, 0x73,
-	0x65, 0x74, 0x12, 0x2b, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65,
-	0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x72, 0x70, 0x63, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64,
+	0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x64,
+	0x65, 0x6c, 0x73, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x74, 0x12, 0x57, 0x0a, 0x08, 0x47, 0x65, 0x74,
+	0x41, 0x73, 0x73, 0x65, 0x74, 0x12, 0x28, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66,
+	0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e,
+	0x47, 0x65, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
 	0x21, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61,
 	0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x61, 0x73, 0x73,
-	0x65, 0x74, 0x12, 0x5d, 0x0a, 0x0b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x73, 0x73, 0x65,
-	0x74, 0x12, 0x2b, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74,
-	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21,
-	0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70,
-	0x69, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x61, 0x73, 0x73, 0x65,
-	0x74, 0x12, 0x57, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x12, 0x28, 0x2e,
-	0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69,
-	0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65,
-	0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f,
-	0x64, 0x65, 0x6c, 0x73, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x74, 0x12, 0x65, 0x0a, 0x0a, 0x4c, 0x69,
-	0x73, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x73, 0x12, 0x2a, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69,
+	0x65, 0x74, 0x12, 0x65, 0x0a, 0x0a, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x73,
+	0x12, 0x2a, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41,
+	0x73, 0x73, 0x65, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x75,
+	0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x52, 0x0a, 0x0b, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x41, 0x73, 0x73, 0x65, 0x74, 0x12, 0x2b, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69,
 	0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72,
-	0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c,
-	0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x4c,
-	0x69, 0x73, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x52, 0x0a, 0x0b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x73, 0x73, 0x65, 0x74,
-	0x12, 0x2b, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e,
-	0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x5d, 0x0a, 0x0b, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x41,
-	0x73, 0x73, 0x65, 0x74, 0x12, 0x2b, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c,
-	0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x52,
-	0x65, 0x6e, 0x61, 0x6d, 0x65, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x21, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74,
-	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x61,
-	0x73, 0x73, 0x65, 0x74, 0x12, 0x6b, 0x0a, 0x0c, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74,
-	0x4b, 0x56, 0x4d, 0x73, 0x12, 0x2c, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c,
-	0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x42,
-	0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x4b, 0x56, 0x4d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65,
-	0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x42, 0x61, 0x74,
-	0x63, 0x68, 0x47, 0x65, 0x74, 0x4b, 0x56, 0x4d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x80, 0x01, 0x0a, 0x13, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x44, 0x48,
-	0x43, 0x50, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x12, 0x33, 0x2e, 0x75, 0x6e, 0x69, 0x66,
+	0x70, 0x63, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x5d, 0x0a,
+	0x0b, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x41, 0x73, 0x73, 0x65, 0x74, 0x12, 0x2b, 0x2e, 0x75,
+	0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x41, 0x73, 0x73,
+	0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x75, 0x6e, 0x69, 0x66,
 	0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e,
-	0x72, 0x70, 0x63, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x44, 0x48, 0x43, 0x50,
-	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x34,
-	0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70,
-	0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65,
-	0x74, 0x44, 0x48, 0x43, 0x50, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x80, 0x01, 0x0a, 0x13, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65,
-	0x74, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x4c, 0x53, 0x45, 0x73, 0x12, 0x33, 0x2e, 0x75,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x74, 0x12, 0x6b, 0x0a, 0x0c,
+	0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x4b, 0x56, 0x4d, 0x73, 0x12, 0x2c, 0x2e, 0x75,
 	0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e,
-	0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x4d,
-	0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x4c, 0x53, 0x45, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x34, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74,
+	0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x4b,
+	0x56, 0x4d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x75, 0x6e, 0x69,
+	0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31,
+	0x2e, 0x72, 0x70, 0x63, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x4b, 0x56, 0x4d,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x80, 0x01, 0x0a, 0x13, 0x42, 0x61,
+	0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x44, 0x48, 0x43, 0x50, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x73, 0x12, 0x33, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74,
 	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x42, 0x61, 0x74, 0x63,
-	0x68, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x4c, 0x53, 0x45, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x77, 0x0a, 0x10, 0x42, 0x61, 0x74, 0x63, 0x68,
-	0x47, 0x65, 0x74, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x73, 0x12, 0x30, 0x2e, 0x75, 0x6e,
-	0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
-	0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x4d, 0x61,
-	0x63, 0x68, 0x69, 0x6e, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e,
-	0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69,
-	0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74,
-	0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x77, 0x0a, 0x10, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x53, 0x77, 0x69, 0x74,
-	0x63, 0x68, 0x65, 0x73, 0x12, 0x30, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c,
-	0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x42,
-	0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x65, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64,
-	0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63,
-	0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x65,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6b, 0x0a, 0x0c, 0x42, 0x61, 0x74,
-	0x63, 0x68, 0x47, 0x65, 0x74, 0x52, 0x50, 0x4d, 0x73, 0x12, 0x2c, 0x2e, 0x75, 0x6e, 0x69, 0x66,
-	0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e,
-	0x72, 0x70, 0x63, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x52, 0x50, 0x4d, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65,
-	0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70,
-	0x63, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x52, 0x50, 0x4d, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6e, 0x0a, 0x0d, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47,
-	0x65, 0x74, 0x44, 0x72, 0x61, 0x63, 0x73, 0x12, 0x2d, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65,
-	0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70,
-	0x63, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x44, 0x72, 0x61, 0x63, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64,
-	0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63,
-	0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x44, 0x72, 0x61, 0x63, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6b, 0x0a, 0x0c, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47,
-	0x65, 0x74, 0x4e, 0x69, 0x63, 0x73, 0x12, 0x2c, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64,
+	0x68, 0x47, 0x65, 0x74, 0x44, 0x48, 0x43, 0x50, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x34, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64,
 	0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63,
-	0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x4e, 0x69, 0x63, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c,
+	0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x44, 0x48, 0x43, 0x50, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x80, 0x01, 0x0a,
+	0x13, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65,
+	0x4c, 0x53, 0x45, 0x73, 0x12, 0x33, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c,
 	0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x42,
-	0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x4e, 0x69, 0x63, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x68, 0x0a, 0x0b, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x56,
-	0x4d, 0x73, 0x12, 0x2b, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65,
-	0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x42, 0x61, 0x74,
-	0x63, 0x68, 0x47, 0x65, 0x74, 0x56, 0x4d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x2c, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61,
+	0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x4c, 0x53,
+	0x45, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x34, 0x2e, 0x75, 0x6e, 0x69, 0x66,
+	0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e,
+	0x72, 0x70, 0x63, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x63, 0x68,
+	0x69, 0x6e, 0x65, 0x4c, 0x53, 0x45, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x77, 0x0a, 0x10, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x63, 0x68, 0x69,
+	0x6e, 0x65, 0x73, 0x12, 0x30, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65,
+	0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x42, 0x61,
+	0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66,
+	0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e,
+	0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x77, 0x0a, 0x10, 0x42, 0x61, 0x74, 0x63,
+	0x68, 0x47, 0x65, 0x74, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x65, 0x73, 0x12, 0x30, 0x2e, 0x75,
+	0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x53,
+	0x77, 0x69, 0x74, 0x63, 0x68, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x31,
+	0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65,
+	0x74, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x6b, 0x0a, 0x0c, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x52, 0x50, 0x4d,
+	0x73, 0x12, 0x2c, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x42, 0x61, 0x74, 0x63,
+	0x68, 0x47, 0x65, 0x74, 0x52, 0x50, 0x4d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x2d, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47,
+	0x65, 0x74, 0x52, 0x50, 0x4d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6e,
+	0x0a, 0x0d, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x44, 0x72, 0x61, 0x63, 0x73, 0x12,
+	0x2d, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61,
 	0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47,
-	0x65, 0x74, 0x56, 0x4d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6e, 0x0a,
-	0x0d, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x56, 0x6c, 0x61, 0x6e, 0x73, 0x12, 0x2d,
+	0x65, 0x74, 0x44, 0x72, 0x61, 0x63, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e,
 	0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70,
 	0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65,
-	0x74, 0x56, 0x6c, 0x61, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e,
-	0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69,
-	0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74,
-	0x56, 0x6c, 0x61, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6e, 0x0a,
-	0x0d, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x52, 0x61, 0x63, 0x6b, 0x73, 0x12, 0x2d,
+	0x74, 0x44, 0x72, 0x61, 0x63, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6b,
+	0x0a, 0x0c, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x4e, 0x69, 0x63, 0x73, 0x12, 0x2c,
 	0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70,
 	0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65,
-	0x74, 0x52, 0x61, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e,
-	0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69,
-	0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74,
-	0x52, 0x61, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x8c, 0x01,
-	0x0a, 0x17, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x43, 0x68, 0x72, 0x6f, 0x6d, 0x65,
-	0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x73, 0x12, 0x37, 0x2e, 0x75, 0x6e, 0x69, 0x66,
-	0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e,
-	0x72, 0x70, 0x63, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x43, 0x68, 0x72, 0x6f,
-	0x6d, 0x65, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x38, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65,
-	0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x42, 0x61, 0x74,
-	0x63, 0x68, 0x47, 0x65, 0x74, 0x43, 0x68, 0x72, 0x6f, 0x6d, 0x65, 0x50, 0x6c, 0x61, 0x74, 0x66,
-	0x6f, 0x72, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x9b, 0x01, 0x0a,
-	0x1c, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65,
-	0x4c, 0x53, 0x45, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x12, 0x3c, 0x2e,
-	0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69,
-	0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74,
-	0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x4c, 0x53, 0x45, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x74,
-	0x79, 0x70, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3d, 0x2e, 0x75, 0x6e,
-	0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
-	0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x4d, 0x61,
-	0x63, 0x68, 0x69, 0x6e, 0x65, 0x4c, 0x53, 0x45, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x74, 0x79, 0x70,
-	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x92, 0x01, 0x0a, 0x19, 0x42,
-	0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x52, 0x61, 0x63, 0x6b, 0x4c, 0x53, 0x45, 0x50, 0x72,
-	0x6f, 0x74, 0x6f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x12, 0x39, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69,
-	0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72,
-	0x70, 0x63, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x52, 0x61, 0x63, 0x6b, 0x4c,
-	0x53, 0x45, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x3a, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65,
-	0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x42, 0x61,
-	0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x52, 0x61, 0x63, 0x6b, 0x4c, 0x53, 0x45, 0x50, 0x72, 0x6f,
-	0x74, 0x6f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x7e, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x43, 0x68, 0x72, 0x6f, 0x6d, 0x65, 0x4f, 0x53, 0x44, 0x65,
-	0x76, 0x69, 0x63, 0x65, 0x44, 0x61, 0x74, 0x61, 0x12, 0x35, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69,
-	0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72,
-	0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x68, 0x72, 0x6f, 0x6d, 0x65, 0x4f, 0x53, 0x44, 0x65,
-	0x76, 0x69, 0x63, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x2e, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61,
-	0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x43, 0x68, 0x72,
-	0x6f, 0x6d, 0x65, 0x4f, 0x53, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x44, 0x61, 0x74, 0x61, 0x12,
-	0x78, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x67,
-	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x34, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65,
+	0x74, 0x4e, 0x69, 0x63, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x75,
+	0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x4e,
+	0x69, 0x63, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x68, 0x0a, 0x0b, 0x42,
+	0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x56, 0x4d, 0x73, 0x12, 0x2b, 0x2e, 0x75, 0x6e, 0x69,
+	0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31,
+	0x2e, 0x72, 0x70, 0x63, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x56, 0x4d, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65,
 	0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70,
-	0x63, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x67, 0x53,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e,
-	0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69,
-	0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x43, 0x61, 0x63, 0x68, 0x69,
-	0x6e, 0x67, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x78, 0x0a, 0x14, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x43, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x67, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x12, 0x34, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74,
-	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x43, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x67, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65,
-	0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f,
-	0x64, 0x65, 0x6c, 0x73, 0x2e, 0x43, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x67, 0x53, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x12, 0x72, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x43, 0x61, 0x63, 0x68, 0x69, 0x6e,
-	0x67, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x31, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69,
-	0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72,
-	0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x67, 0x53, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x75, 0x6e,
-	0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
-	0x31, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x43, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x67,
-	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x80, 0x01, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74,
-	0x43, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x67, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x12,
-	0x33, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61,
-	0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x61,
-	0x63, 0x68, 0x69, 0x6e, 0x67, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x34, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c,
-	0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x4c,
-	0x69, 0x73, 0x74, 0x43, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x67, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x64, 0x0a, 0x14, 0x44, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x43, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x67, 0x53, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x12, 0x34, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65,
-	0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x43, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x67, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
-	0x12, 0x8a, 0x01, 0x0a, 0x1a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x63, 0x68, 0x69,
-	0x6e, 0x65, 0x4c, 0x53, 0x45, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x12,
-	0x3a, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61,
-	0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x4c, 0x53, 0x45, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79,
-	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x75, 0x6e,
-	0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
-	0x31, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65,
-	0x4c, 0x53, 0x45, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0xa4, 0x01,
-	0x0a, 0x1f, 0x42, 0x61, 0x74, 0x63, 0x68, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x63,
-	0x68, 0x69, 0x6e, 0x65, 0x4c, 0x53, 0x45, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e,
-	0x74, 0x12, 0x3f, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74,
+	0x63, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x56, 0x4d, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6e, 0x0a, 0x0d, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65,
+	0x74, 0x56, 0x6c, 0x61, 0x6e, 0x73, 0x12, 0x2d, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64,
+	0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63,
+	0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x56, 0x6c, 0x61, 0x6e, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66,
+	0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e,
+	0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x56, 0x6c, 0x61, 0x6e, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6e, 0x0a, 0x0d, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65,
+	0x74, 0x52, 0x61, 0x63, 0x6b, 0x73, 0x12, 0x2d, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64,
+	0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63,
+	0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x52, 0x61, 0x63, 0x6b, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66,
+	0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e,
+	0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x52, 0x61, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x8c, 0x01, 0x0a, 0x17, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47,
+	0x65, 0x74, 0x43, 0x68, 0x72, 0x6f, 0x6d, 0x65, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d,
+	0x73, 0x12, 0x37, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74,
 	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x42, 0x61, 0x74, 0x63,
-	0x68, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x4c, 0x53,
-	0x45, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x40, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65,
-	0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x42, 0x61, 0x74,
-	0x63, 0x68, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x4c,
-	0x53, 0x45, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x84, 0x01, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x63, 0x68,
-	0x69, 0x6e, 0x65, 0x4c, 0x53, 0x45, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74,
-	0x12, 0x37, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e,
-	0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x61,
-	0x63, 0x68, 0x69, 0x6e, 0x65, 0x4c, 0x53, 0x45, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65,
-	0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x75, 0x6e, 0x69, 0x66,
-	0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e,
-	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x4c, 0x53,
-	0x45, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x9e, 0x01, 0x0a, 0x1d,
-	0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x4c,
-	0x53, 0x45, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x3d, 0x2e,
-	0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69,
-	0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74,
-	0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x4c, 0x53, 0x45, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79,
-	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3e, 0x2e, 0x75,
-	0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e,
-	0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x4d,
-	0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x4c, 0x53, 0x45, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d,
-	0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x92, 0x01, 0x0a,
-	0x19, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x4c, 0x53, 0x45, 0x44,
-	0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x39, 0x2e, 0x75, 0x6e, 0x69,
+	0x68, 0x47, 0x65, 0x74, 0x43, 0x68, 0x72, 0x6f, 0x6d, 0x65, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f,
+	0x72, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x38, 0x2e, 0x75, 0x6e, 0x69,
 	0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31,
-	0x2e, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65,
-	0x4c, 0x53, 0x45, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3a, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66,
+	0x2e, 0x72, 0x70, 0x63, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x43, 0x68, 0x72,
+	0x6f, 0x6d, 0x65, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x9b, 0x01, 0x0a, 0x1c, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65,
+	0x74, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x4c, 0x53, 0x45, 0x50, 0x72, 0x6f, 0x74, 0x6f,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x12, 0x3c, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66,
 	0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e,
-	0x4c, 0x69, 0x73, 0x74, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x4c, 0x53, 0x45, 0x44, 0x65,
-	0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x78, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x63, 0x68, 0x65, 0x64,
-	0x75, 0x6c, 0x69, 0x6e, 0x67, 0x55, 0x6e, 0x69, 0x74, 0x12, 0x34, 0x2e, 0x75, 0x6e, 0x69, 0x66,
+	0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x4c,
+	0x53, 0x45, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x3d, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65,
+	0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x42, 0x61,
+	0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x4c, 0x53, 0x45,
+	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x92, 0x01, 0x0a, 0x19, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x52,
+	0x61, 0x63, 0x6b, 0x4c, 0x53, 0x45, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x12, 0x39, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68,
+	0x47, 0x65, 0x74, 0x52, 0x61, 0x63, 0x6b, 0x4c, 0x53, 0x45, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3a, 0x2e, 0x75, 0x6e,
+	0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
+	0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x52, 0x61,
+	0x63, 0x6b, 0x4c, 0x53, 0x45, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7e, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x43, 0x68,
+	0x72, 0x6f, 0x6d, 0x65, 0x4f, 0x53, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x44, 0x61, 0x74, 0x61,
+	0x12, 0x35, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x68,
+	0x72, 0x6f, 0x6d, 0x65, 0x4f, 0x53, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x44, 0x61, 0x74, 0x61,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65,
+	0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f,
+	0x64, 0x65, 0x6c, 0x73, 0x2e, 0x43, 0x68, 0x72, 0x6f, 0x6d, 0x65, 0x4f, 0x53, 0x44, 0x65, 0x76,
+	0x69, 0x63, 0x65, 0x44, 0x61, 0x74, 0x61, 0x12, 0x78, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x43, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x67, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12,
+	0x34, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x43, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x67, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66,
+	0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x64, 0x65,
+	0x6c, 0x73, 0x2e, 0x43, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x67, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x12, 0x78, 0x0a, 0x14, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x61, 0x63, 0x68, 0x69,
+	0x6e, 0x67, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x34, 0x2e, 0x75, 0x6e, 0x69, 0x66,
 	0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e,
-	0x72, 0x70, 0x63, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75,
-	0x6c, 0x69, 0x6e, 0x67, 0x55, 0x6e, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x72, 0x70, 0x63, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x61, 0x63, 0x68, 0x69, 0x6e,
+	0x67, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
 	0x2a, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61,
-	0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x53, 0x63, 0x68,
-	0x65, 0x64, 0x75, 0x6c, 0x69, 0x6e, 0x67, 0x55, 0x6e, 0x69, 0x74, 0x12, 0x78, 0x0a, 0x14, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x69, 0x6e, 0x67, 0x55,
-	0x6e, 0x69, 0x74, 0x12, 0x34, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65,
-	0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x69, 0x6e, 0x67, 0x55, 0x6e,
-	0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x75, 0x6e, 0x69, 0x66,
+	0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x43, 0x61, 0x63,
+	0x68, 0x69, 0x6e, 0x67, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x72, 0x0a, 0x11, 0x47,
+	0x65, 0x74, 0x43, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x67, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x12, 0x31, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x61,
+	0x63, 0x68, 0x69, 0x6e, 0x67, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65,
+	0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73,
+	0x2e, 0x43, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x67, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12,
+	0x80, 0x01, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x67, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x12, 0x33, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65,
+	0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70,
+	0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x67, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x34, 0x2e, 0x75,
+	0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x61, 0x63, 0x68, 0x69,
+	0x6e, 0x67, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x64, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x61, 0x63, 0x68,
+	0x69, 0x6e, 0x67, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x34, 0x2e, 0x75, 0x6e, 0x69,
+	0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31,
+	0x2e, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x61, 0x63, 0x68, 0x69,
+	0x6e, 0x67, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x8a, 0x01, 0x0a, 0x1a, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x4c, 0x53, 0x45, 0x44, 0x65, 0x70,
+	0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x3a, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65,
+	0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70,
+	0x63, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x4c,
+	0x53, 0x45, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65,
+	0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73,
+	0x2e, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x4c, 0x53, 0x45, 0x44, 0x65, 0x70, 0x6c, 0x6f,
+	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0xa4, 0x01, 0x0a, 0x1f, 0x42, 0x61, 0x74, 0x63, 0x68, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x4c, 0x53, 0x45, 0x44,
+	0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x3f, 0x2e, 0x75, 0x6e, 0x69, 0x66,
 	0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e,
-	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x69, 0x6e,
-	0x67, 0x55, 0x6e, 0x69, 0x74, 0x12, 0x72, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x53, 0x63, 0x68, 0x65,
-	0x64, 0x75, 0x6c, 0x69, 0x6e, 0x67, 0x55, 0x6e, 0x69, 0x74, 0x12, 0x31, 0x2e, 0x75, 0x6e, 0x69,
+	0x72, 0x70, 0x63, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d,
+	0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x4c, 0x53, 0x45, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d,
+	0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x40, 0x2e, 0x75, 0x6e, 0x69,
 	0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31,
-	0x2e, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x69,
-	0x6e, 0x67, 0x55, 0x6e, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e,
-	0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69,
-	0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64,
-	0x75, 0x6c, 0x69, 0x6e, 0x67, 0x55, 0x6e, 0x69, 0x74, 0x12, 0x80, 0x01, 0x0a, 0x13, 0x4c, 0x69,
-	0x73, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x69, 0x6e, 0x67, 0x55, 0x6e, 0x69, 0x74,
-	0x73, 0x12, 0x33, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74,
-	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74,
-	0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x69, 0x6e, 0x67, 0x55, 0x6e, 0x69, 0x74, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x34, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64,
-	0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63,
-	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x69, 0x6e, 0x67, 0x55,
-	0x6e, 0x69, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x64, 0x0a, 0x14,
-	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x69, 0x6e, 0x67,
-	0x55, 0x6e, 0x69, 0x74, 0x12, 0x34, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c,
-	0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x44,
-	0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x69, 0x6e, 0x67, 0x55,
-	0x6e, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
-	0x74, 0x79, 0x12, 0x7d, 0x0a, 0x12, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x12, 0x32, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69,
+	0x2e, 0x72, 0x70, 0x63, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x4c, 0x53, 0x45, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79,
+	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x84, 0x01, 0x0a,
+	0x17, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x4c, 0x53, 0x45, 0x44, 0x65,
+	0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x37, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69,
 	0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72,
-	0x70, 0x63, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x42,
-	0x75, 0x6e, 0x64, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x33, 0x2e, 0x75,
+	0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x4c, 0x53, 0x45,
+	0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x30, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x4d,
+	0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x4c, 0x53, 0x45, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d,
+	0x65, 0x6e, 0x74, 0x12, 0x9e, 0x01, 0x0a, 0x1d, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74,
+	0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x4c, 0x53, 0x45, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x3d, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66,
+	0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e,
+	0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x4c,
+	0x53, 0x45, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x3e, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c,
+	0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x42,
+	0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x4c, 0x53,
+	0x45, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x92, 0x01, 0x0a, 0x19, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x61, 0x63,
+	0x68, 0x69, 0x6e, 0x65, 0x4c, 0x53, 0x45, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e,
+	0x74, 0x73, 0x12, 0x39, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65,
+	0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x4c, 0x53, 0x45, 0x44, 0x65, 0x70, 0x6c, 0x6f,
+	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3a, 0x2e,
+	0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x61, 0x63, 0x68,
+	0x69, 0x6e, 0x65, 0x4c, 0x53, 0x45, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x78, 0x0a, 0x14, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x69, 0x6e, 0x67, 0x55, 0x6e, 0x69,
+	0x74, 0x12, 0x34, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x69, 0x6e, 0x67, 0x55, 0x6e, 0x69, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65,
+	0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f,
+	0x64, 0x65, 0x6c, 0x73, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x69, 0x6e, 0x67, 0x55,
+	0x6e, 0x69, 0x74, 0x12, 0x78, 0x0a, 0x14, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x69, 0x6e, 0x67, 0x55, 0x6e, 0x69, 0x74, 0x12, 0x34, 0x2e, 0x75, 0x6e,
+	0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
+	0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x69, 0x6e, 0x67, 0x55, 0x6e, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x2a, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x53,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x69, 0x6e, 0x67, 0x55, 0x6e, 0x69, 0x74, 0x12, 0x72, 0x0a,
+	0x11, 0x47, 0x65, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x69, 0x6e, 0x67, 0x55, 0x6e,
+	0x69, 0x74, 0x12, 0x31, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65,
+	0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74,
+	0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x69, 0x6e, 0x67, 0x55, 0x6e, 0x69, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66,
+	0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x6d, 0x6f, 0x64, 0x65,
+	0x6c, 0x73, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x69, 0x6e, 0x67, 0x55, 0x6e, 0x69,
+	0x74, 0x12, 0x80, 0x01, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x6c, 0x69, 0x6e, 0x67, 0x55, 0x6e, 0x69, 0x74, 0x73, 0x12, 0x33, 0x2e, 0x75, 0x6e, 0x69, 0x66,
+	0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e,
+	0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x69,
+	0x6e, 0x67, 0x55, 0x6e, 0x69, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x34,
+	0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x69, 0x6e, 0x67, 0x55, 0x6e, 0x69, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x64, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x69, 0x6e, 0x67, 0x55, 0x6e, 0x69, 0x74, 0x12, 0x34, 0x2e, 0x75,
 	0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e,
-	0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x42, 0x25, 0x5a, 0x23, 0x69, 0x6e, 0x66, 0x72, 0x61, 0x2f, 0x75, 0x6e, 0x69, 0x66, 0x69,
-	0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x72,
-	0x70, 0x63, 0x3b, 0x75, 0x66, 0x73, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x69, 0x6e, 0x67, 0x55, 0x6e, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x7d, 0x0a, 0x12, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65,
+	0x12, 0x32, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x33, 0x2e, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c,
+	0x65, 0x65, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x42, 0x75, 0x6e, 0x64, 0x6c,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x25, 0x5a, 0x23, 0x69, 0x6e, 0x66,
+	0x72, 0x61, 0x2f, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x70, 0x63, 0x3b, 0x75, 0x66, 0x73, 0x70, 0x62,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -12598,7 +12771,7 @@ func file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescGZIP() []byte {
 	return file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDescData
 }
 
-var file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes = make([]protoimpl.MessageInfo, 172)
+var file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes = make([]protoimpl.MessageInfo, 174)
 var file_infra_unifiedfleet_api_v1_rpc_fleet_proto_goTypes = []interface{}{
 	(*UpdateMachineLSEDeploymentRequest)(nil),       // 0: unifiedfleet.api.v1.rpc.UpdateMachineLSEDeploymentRequest
 	(*BatchUpdateMachineLSEDeploymentRequest)(nil),  // 1: unifiedfleet.api.v1.rpc.BatchUpdateMachineLSEDeploymentRequest
@@ -12646,292 +12819,294 @@ var file_infra_unifiedfleet_api_v1_rpc_fleet_proto_goTypes = []interface{}{
 	(*ListMachinesResponse)(nil),                    // 43: unifiedfleet.api.v1.rpc.ListMachinesResponse
 	(*DeleteMachineRequest)(nil),                    // 44: unifiedfleet.api.v1.rpc.DeleteMachineRequest
 	(*ImportMachinesRequest)(nil),                   // 45: unifiedfleet.api.v1.rpc.ImportMachinesRequest
-	(*RenameMachineRequest)(nil),                    // 46: unifiedfleet.api.v1.rpc.RenameMachineRequest
-	(*MachineDBSource)(nil),                         // 47: unifiedfleet.api.v1.rpc.MachineDBSource
-	(*ConfigSource)(nil),                            // 48: unifiedfleet.api.v1.rpc.ConfigSource
-	(*CreateRackRequest)(nil),                       // 49: unifiedfleet.api.v1.rpc.CreateRackRequest
-	(*UpdateRackRequest)(nil),                       // 50: unifiedfleet.api.v1.rpc.UpdateRackRequest
-	(*GetRackRequest)(nil),                          // 51: unifiedfleet.api.v1.rpc.GetRackRequest
-	(*ListRacksRequest)(nil),                        // 52: unifiedfleet.api.v1.rpc.ListRacksRequest
-	(*ListRacksResponse)(nil),                       // 53: unifiedfleet.api.v1.rpc.ListRacksResponse
-	(*DeleteRackRequest)(nil),                       // 54: unifiedfleet.api.v1.rpc.DeleteRackRequest
-	(*CreateMachineLSERequest)(nil),                 // 55: unifiedfleet.api.v1.rpc.CreateMachineLSERequest
-	(*UpdateMachineLSERequest)(nil),                 // 56: unifiedfleet.api.v1.rpc.UpdateMachineLSERequest
-	(*GetMachineLSERequest)(nil),                    // 57: unifiedfleet.api.v1.rpc.GetMachineLSERequest
-	(*ListMachineLSEsRequest)(nil),                  // 58: unifiedfleet.api.v1.rpc.ListMachineLSEsRequest
-	(*ListMachineLSEsResponse)(nil),                 // 59: unifiedfleet.api.v1.rpc.ListMachineLSEsResponse
-	(*DeleteMachineLSERequest)(nil),                 // 60: unifiedfleet.api.v1.rpc.DeleteMachineLSERequest
-	(*RenameMachineLSERequest)(nil),                 // 61: unifiedfleet.api.v1.rpc.RenameMachineLSERequest
-	(*ImportMachineLSEsRequest)(nil),                // 62: unifiedfleet.api.v1.rpc.ImportMachineLSEsRequest
-	(*ImportOSMachineLSEsRequest)(nil),              // 63: unifiedfleet.api.v1.rpc.ImportOSMachineLSEsRequest
-	(*CreateRackLSERequest)(nil),                    // 64: unifiedfleet.api.v1.rpc.CreateRackLSERequest
-	(*UpdateRackLSERequest)(nil),                    // 65: unifiedfleet.api.v1.rpc.UpdateRackLSERequest
-	(*GetRackLSERequest)(nil),                       // 66: unifiedfleet.api.v1.rpc.GetRackLSERequest
-	(*ListRackLSEsRequest)(nil),                     // 67: unifiedfleet.api.v1.rpc.ListRackLSEsRequest
-	(*ListRackLSEsResponse)(nil),                    // 68: unifiedfleet.api.v1.rpc.ListRackLSEsResponse
-	(*DeleteRackLSERequest)(nil),                    // 69: unifiedfleet.api.v1.rpc.DeleteRackLSERequest
-	(*CreateNicRequest)(nil),                        // 70: unifiedfleet.api.v1.rpc.CreateNicRequest
-	(*UpdateNicRequest)(nil),                        // 71: unifiedfleet.api.v1.rpc.UpdateNicRequest
-	(*GetNicRequest)(nil),                           // 72: unifiedfleet.api.v1.rpc.GetNicRequest
-	(*ListNicsRequest)(nil),                         // 73: unifiedfleet.api.v1.rpc.ListNicsRequest
-	(*ListNicsResponse)(nil),                        // 74: unifiedfleet.api.v1.rpc.ListNicsResponse
-	(*DeleteNicRequest)(nil),                        // 75: unifiedfleet.api.v1.rpc.DeleteNicRequest
-	(*ImportNicsRequest)(nil),                       // 76: unifiedfleet.api.v1.rpc.ImportNicsRequest
-	(*RenameNicRequest)(nil),                        // 77: unifiedfleet.api.v1.rpc.RenameNicRequest
-	(*RenameSwitchRequest)(nil),                     // 78: unifiedfleet.api.v1.rpc.RenameSwitchRequest
-	(*ImportDatacentersRequest)(nil),                // 79: unifiedfleet.api.v1.rpc.ImportDatacentersRequest
-	(*CreateKVMRequest)(nil),                        // 80: unifiedfleet.api.v1.rpc.CreateKVMRequest
-	(*UpdateKVMRequest)(nil),                        // 81: unifiedfleet.api.v1.rpc.UpdateKVMRequest
-	(*GetKVMRequest)(nil),                           // 82: unifiedfleet.api.v1.rpc.GetKVMRequest
-	(*ListKVMsRequest)(nil),                         // 83: unifiedfleet.api.v1.rpc.ListKVMsRequest
-	(*ListKVMsResponse)(nil),                        // 84: unifiedfleet.api.v1.rpc.ListKVMsResponse
-	(*DeleteKVMRequest)(nil),                        // 85: unifiedfleet.api.v1.rpc.DeleteKVMRequest
-	(*CreateRPMRequest)(nil),                        // 86: unifiedfleet.api.v1.rpc.CreateRPMRequest
-	(*UpdateRPMRequest)(nil),                        // 87: unifiedfleet.api.v1.rpc.UpdateRPMRequest
-	(*GetRPMRequest)(nil),                           // 88: unifiedfleet.api.v1.rpc.GetRPMRequest
-	(*ListRPMsRequest)(nil),                         // 89: unifiedfleet.api.v1.rpc.ListRPMsRequest
-	(*ListRPMsResponse)(nil),                        // 90: unifiedfleet.api.v1.rpc.ListRPMsResponse
-	(*DeleteRPMRequest)(nil),                        // 91: unifiedfleet.api.v1.rpc.DeleteRPMRequest
-	(*CreateDracRequest)(nil),                       // 92: unifiedfleet.api.v1.rpc.CreateDracRequest
-	(*UpdateDracRequest)(nil),                       // 93: unifiedfleet.api.v1.rpc.UpdateDracRequest
-	(*GetDracRequest)(nil),                          // 94: unifiedfleet.api.v1.rpc.GetDracRequest
-	(*ListDracsRequest)(nil),                        // 95: unifiedfleet.api.v1.rpc.ListDracsRequest
-	(*ListDracsResponse)(nil),                       // 96: unifiedfleet.api.v1.rpc.ListDracsResponse
-	(*DeleteDracRequest)(nil),                       // 97: unifiedfleet.api.v1.rpc.DeleteDracRequest
-	(*CreateSwitchRequest)(nil),                     // 98: unifiedfleet.api.v1.rpc.CreateSwitchRequest
-	(*UpdateSwitchRequest)(nil),                     // 99: unifiedfleet.api.v1.rpc.UpdateSwitchRequest
-	(*GetSwitchRequest)(nil),                        // 100: unifiedfleet.api.v1.rpc.GetSwitchRequest
-	(*ListSwitchesRequest)(nil),                     // 101: unifiedfleet.api.v1.rpc.ListSwitchesRequest
-	(*ListSwitchesResponse)(nil),                    // 102: unifiedfleet.api.v1.rpc.ListSwitchesResponse
-	(*DeleteSwitchRequest)(nil),                     // 103: unifiedfleet.api.v1.rpc.DeleteSwitchRequest
-	(*CreateVlanRequest)(nil),                       // 104: unifiedfleet.api.v1.rpc.CreateVlanRequest
-	(*UpdateVlanRequest)(nil),                       // 105: unifiedfleet.api.v1.rpc.UpdateVlanRequest
-	(*GetVlanRequest)(nil),                          // 106: unifiedfleet.api.v1.rpc.GetVlanRequest
-	(*ListVlansRequest)(nil),                        // 107: unifiedfleet.api.v1.rpc.ListVlansRequest
-	(*ListVlansResponse)(nil),                       // 108: unifiedfleet.api.v1.rpc.ListVlansResponse
-	(*DeleteVlanRequest)(nil),                       // 109: unifiedfleet.api.v1.rpc.DeleteVlanRequest
-	(*ImportVlansRequest)(nil),                      // 110: unifiedfleet.api.v1.rpc.ImportVlansRequest
-	(*ImportOSVlansRequest)(nil),                    // 111: unifiedfleet.api.v1.rpc.ImportOSVlansRequest
-	(*ImportStatesRequest)(nil),                     // 112: unifiedfleet.api.v1.rpc.ImportStatesRequest
-	(*GetStateRequest)(nil),                         // 113: unifiedfleet.api.v1.rpc.GetStateRequest
-	(*GetDutStateRequest)(nil),                      // 114: unifiedfleet.api.v1.rpc.GetDutStateRequest
-	(*ListDutStatesRequest)(nil),                    // 115: unifiedfleet.api.v1.rpc.ListDutStatesRequest
-	(*ListDutStatesResponse)(nil),                   // 116: unifiedfleet.api.v1.rpc.ListDutStatesResponse
-	(*UpdateStateRequest)(nil),                      // 117: unifiedfleet.api.v1.rpc.UpdateStateRequest
-	(*UpdateDutStateRequest)(nil),                   // 118: unifiedfleet.api.v1.rpc.UpdateDutStateRequest
-	(*RackRegistrationRequest)(nil),                 // 119: unifiedfleet.api.v1.rpc.RackRegistrationRequest
-	(*NetworkOption)(nil),                           // 120: unifiedfleet.api.v1.rpc.NetworkOption
-	(*CreateAssetRequest)(nil),                      // 121: unifiedfleet.api.v1.rpc.CreateAssetRequest
-	(*UpdateAssetRequest)(nil),                      // 122: unifiedfleet.api.v1.rpc.UpdateAssetRequest
-	(*GetAssetRequest)(nil),                         // 123: unifiedfleet.api.v1.rpc.GetAssetRequest
-	(*ListAssetsRequest)(nil),                       // 124: unifiedfleet.api.v1.rpc.ListAssetsRequest
-	(*ListAssetsResponse)(nil),                      // 125: unifiedfleet.api.v1.rpc.ListAssetsResponse
-	(*DeleteAssetRequest)(nil),                      // 126: unifiedfleet.api.v1.rpc.DeleteAssetRequest
-	(*RenameAssetRequest)(nil),                      // 127: unifiedfleet.api.v1.rpc.RenameAssetRequest
-	(*BatchGetKVMsRequest)(nil),                     // 128: unifiedfleet.api.v1.rpc.BatchGetKVMsRequest
-	(*BatchGetKVMsResponse)(nil),                    // 129: unifiedfleet.api.v1.rpc.BatchGetKVMsResponse
-	(*BatchGetDHCPConfigsRequest)(nil),              // 130: unifiedfleet.api.v1.rpc.BatchGetDHCPConfigsRequest
-	(*BatchGetDHCPConfigsResponse)(nil),             // 131: unifiedfleet.api.v1.rpc.BatchGetDHCPConfigsResponse
-	(*BatchGetMachineLSEsRequest)(nil),              // 132: unifiedfleet.api.v1.rpc.BatchGetMachineLSEsRequest
-	(*BatchGetMachineLSEsResponse)(nil),             // 133: unifiedfleet.api.v1.rpc.BatchGetMachineLSEsResponse
-	(*BatchGetMachinesRequest)(nil),                 // 134: unifiedfleet.api.v1.rpc.BatchGetMachinesRequest
-	(*BatchGetMachinesResponse)(nil),                // 135: unifiedfleet.api.v1.rpc.BatchGetMachinesResponse
-	(*BatchGetSwitchesRequest)(nil),                 // 136: unifiedfleet.api.v1.rpc.BatchGetSwitchesRequest
-	(*BatchGetSwitchesResponse)(nil),                // 137: unifiedfleet.api.v1.rpc.BatchGetSwitchesResponse
-	(*BatchGetRPMsRequest)(nil),                     // 138: unifiedfleet.api.v1.rpc.BatchGetRPMsRequest
-	(*BatchGetRPMsResponse)(nil),                    // 139: unifiedfleet.api.v1.rpc.BatchGetRPMsResponse
-	(*BatchGetDracsRequest)(nil),                    // 140: unifiedfleet.api.v1.rpc.BatchGetDracsRequest
-	(*BatchGetDracsResponse)(nil),                   // 141: unifiedfleet.api.v1.rpc.BatchGetDracsResponse
-	(*BatchGetNicsRequest)(nil),                     // 142: unifiedfleet.api.v1.rpc.BatchGetNicsRequest
-	(*BatchGetNicsResponse)(nil),                    // 143: unifiedfleet.api.v1.rpc.BatchGetNicsResponse
-	(*BatchGetVMsRequest)(nil),                      // 144: unifiedfleet.api.v1.rpc.BatchGetVMsRequest
-	(*BatchGetVMsResponse)(nil),                     // 145: unifiedfleet.api.v1.rpc.BatchGetVMsResponse
-	(*BatchGetVlansRequest)(nil),                    // 146: unifiedfleet.api.v1.rpc.BatchGetVlansRequest
-	(*BatchGetVlansResponse)(nil),                   // 147: unifiedfleet.api.v1.rpc.BatchGetVlansResponse
-	(*BatchGetRacksRequest)(nil),                    // 148: unifiedfleet.api.v1.rpc.BatchGetRacksRequest
-	(*BatchGetRacksResponse)(nil),                   // 149: unifiedfleet.api.v1.rpc.BatchGetRacksResponse
-	(*BatchGetChromePlatformsRequest)(nil),          // 150: unifiedfleet.api.v1.rpc.BatchGetChromePlatformsRequest
-	(*BatchGetChromePlatformsResponse)(nil),         // 151: unifiedfleet.api.v1.rpc.BatchGetChromePlatformsResponse
-	(*BatchGetMachineLSEPrototypesRequest)(nil),     // 152: unifiedfleet.api.v1.rpc.BatchGetMachineLSEPrototypesRequest
-	(*BatchGetMachineLSEPrototypesResponse)(nil),    // 153: unifiedfleet.api.v1.rpc.BatchGetMachineLSEPrototypesResponse
-	(*BatchGetRackLSEPrototypesRequest)(nil),        // 154: unifiedfleet.api.v1.rpc.BatchGetRackLSEPrototypesRequest
-	(*BatchGetRackLSEPrototypesResponse)(nil),       // 155: unifiedfleet.api.v1.rpc.BatchGetRackLSEPrototypesResponse
-	(*GetChromeOSDeviceDataRequest)(nil),            // 156: unifiedfleet.api.v1.rpc.GetChromeOSDeviceDataRequest
-	(*CreateCachingServiceRequest)(nil),             // 157: unifiedfleet.api.v1.rpc.CreateCachingServiceRequest
-	(*UpdateCachingServiceRequest)(nil),             // 158: unifiedfleet.api.v1.rpc.UpdateCachingServiceRequest
-	(*GetCachingServiceRequest)(nil),                // 159: unifiedfleet.api.v1.rpc.GetCachingServiceRequest
-	(*ListCachingServicesRequest)(nil),              // 160: unifiedfleet.api.v1.rpc.ListCachingServicesRequest
-	(*ListCachingServicesResponse)(nil),             // 161: unifiedfleet.api.v1.rpc.ListCachingServicesResponse
-	(*DeleteCachingServiceRequest)(nil),             // 162: unifiedfleet.api.v1.rpc.DeleteCachingServiceRequest
-	(*CreateSchedulingUnitRequest)(nil),             // 163: unifiedfleet.api.v1.rpc.CreateSchedulingUnitRequest
-	(*UpdateSchedulingUnitRequest)(nil),             // 164: unifiedfleet.api.v1.rpc.UpdateSchedulingUnitRequest
-	(*GetSchedulingUnitRequest)(nil),                // 165: unifiedfleet.api.v1.rpc.GetSchedulingUnitRequest
-	(*ListSchedulingUnitsRequest)(nil),              // 166: unifiedfleet.api.v1.rpc.ListSchedulingUnitsRequest
-	(*ListSchedulingUnitsResponse)(nil),             // 167: unifiedfleet.api.v1.rpc.ListSchedulingUnitsResponse
-	(*DeleteSchedulingUnitRequest)(nil),             // 168: unifiedfleet.api.v1.rpc.DeleteSchedulingUnitRequest
-	(*UpdateConfigBundleRequest)(nil),               // 169: unifiedfleet.api.v1.rpc.UpdateConfigBundleRequest
-	(*UpdateConfigBundleResponse)(nil),              // 170: unifiedfleet.api.v1.rpc.UpdateConfigBundleResponse
-	nil,                                             // 171: unifiedfleet.api.v1.rpc.UpdateMachineLSERequest.NetworkOptionsEntry
-	(*models.MachineLSEDeployment)(nil),             // 172: unifiedfleet.api.v1.models.MachineLSEDeployment
-	(*fieldmaskpb.FieldMask)(nil),                   // 173: google.protobuf.FieldMask
-	(*models.VM)(nil),                               // 174: unifiedfleet.api.v1.models.VM
-	(*models.ChromePlatform)(nil),                   // 175: unifiedfleet.api.v1.models.ChromePlatform
-	(*models.OSVersion)(nil),                        // 176: unifiedfleet.api.v1.models.OSVersion
-	(*models.MachineLSEPrototype)(nil),              // 177: unifiedfleet.api.v1.models.MachineLSEPrototype
-	(*models.RackLSEPrototype)(nil),                 // 178: unifiedfleet.api.v1.models.RackLSEPrototype
-	(*models.Machine)(nil),                          // 179: unifiedfleet.api.v1.models.Machine
-	(*models.Rack)(nil),                             // 180: unifiedfleet.api.v1.models.Rack
-	(*models.MachineLSE)(nil),                       // 181: unifiedfleet.api.v1.models.MachineLSE
-	(*models.RackLSE)(nil),                          // 182: unifiedfleet.api.v1.models.RackLSE
-	(*models.Nic)(nil),                              // 183: unifiedfleet.api.v1.models.Nic
-	(*models.KVM)(nil),                              // 184: unifiedfleet.api.v1.models.KVM
-	(*models.RPM)(nil),                              // 185: unifiedfleet.api.v1.models.RPM
-	(*models.Drac)(nil),                             // 186: unifiedfleet.api.v1.models.Drac
-	(*models.Switch)(nil),                           // 187: unifiedfleet.api.v1.models.Switch
-	(*models.Vlan)(nil),                             // 188: unifiedfleet.api.v1.models.Vlan
-	(*lab.DutState)(nil),                            // 189: unifiedfleet.api.v1.models.chromeos.lab.DutState
-	(*models.StateRecord)(nil),                      // 190: unifiedfleet.api.v1.models.StateRecord
-	(*models.DutMeta)(nil),                          // 191: unifiedfleet.api.v1.models.DutMeta
-	(*models.LabMeta)(nil),                          // 192: unifiedfleet.api.v1.models.LabMeta
-	(*models.Asset)(nil),                            // 193: unifiedfleet.api.v1.models.asset
-	(*models.DHCPConfig)(nil),                       // 194: unifiedfleet.api.v1.models.DHCPConfig
-	(*models.CachingService)(nil),                   // 195: unifiedfleet.api.v1.models.CachingService
-	(*models.SchedulingUnit)(nil),                   // 196: unifiedfleet.api.v1.models.SchedulingUnit
-	(*emptypb.Empty)(nil),                           // 197: google.protobuf.Empty
-	(*status.Status)(nil),                           // 198: google.rpc.Status
-	(*models.ChromeOSDeviceData)(nil),               // 199: unifiedfleet.api.v1.models.ChromeOSDeviceData
+	(*RestoreMachineRequest)(nil),                   // 46: unifiedfleet.api.v1.rpc.RestoreMachineRequest
+	(*RenameMachineRequest)(nil),                    // 47: unifiedfleet.api.v1.rpc.RenameMachineRequest
+	(*MachineDBSource)(nil),                         // 48: unifiedfleet.api.v1.rpc.MachineDBSource
+	(*ConfigSource)(nil),                            // 49: unifiedfleet.api.v1.rpc.ConfigSource
+	(*CreateRackRequest)(nil),                       // 50: unifiedfleet.api.v1.rpc.CreateRackRequest
+	(*UpdateRackRequest)(nil),                       // 51: unifiedfleet.api.v1.rpc.UpdateRackRequest
+	(*GetRackRequest)(nil),                          // 52: unifiedfleet.api.v1.rpc.GetRackRequest
+	(*ListRacksRequest)(nil),                        // 53: unifiedfleet.api.v1.rpc.ListRacksRequest
+	(*ListRacksResponse)(nil),                       // 54: unifiedfleet.api.v1.rpc.ListRacksResponse
+	(*DeleteRackRequest)(nil),                       // 55: unifiedfleet.api.v1.rpc.DeleteRackRequest
+	(*CreateMachineLSERequest)(nil),                 // 56: unifiedfleet.api.v1.rpc.CreateMachineLSERequest
+	(*UpdateMachineLSERequest)(nil),                 // 57: unifiedfleet.api.v1.rpc.UpdateMachineLSERequest
+	(*GetMachineLSERequest)(nil),                    // 58: unifiedfleet.api.v1.rpc.GetMachineLSERequest
+	(*ListMachineLSEsRequest)(nil),                  // 59: unifiedfleet.api.v1.rpc.ListMachineLSEsRequest
+	(*ListMachineLSEsResponse)(nil),                 // 60: unifiedfleet.api.v1.rpc.ListMachineLSEsResponse
+	(*DeleteMachineLSERequest)(nil),                 // 61: unifiedfleet.api.v1.rpc.DeleteMachineLSERequest
+	(*RestoreMachineLSERequest)(nil),                // 62: unifiedfleet.api.v1.rpc.RestoreMachineLSERequest
+	(*RenameMachineLSERequest)(nil),                 // 63: unifiedfleet.api.v1.rpc.RenameMachineLSERequest
+	(*ImportMachineLSEsRequest)(nil),                // 64: unifiedfleet.api.v1.rpc.ImportMachineLSEsRequest
+	(*ImportOSMachineLSEsRequest)(nil),              // 65: unifiedfleet.api.v1.rpc.ImportOSMachineLSEsRequest
+	(*CreateRackLSERequest)(nil),                    // 66: unifiedfleet.api.v1.rpc.CreateRackLSERequest
+	(*UpdateRackLSERequest)(nil),                    // 67: unifiedfleet.api.v1.rpc.UpdateRackLSERequest
+	(*GetRackLSERequest)(nil),                       // 68: unifiedfleet.api.v1.rpc.GetRackLSERequest
+	(*ListRackLSEsRequest)(nil),                     // 69: unifiedfleet.api.v1.rpc.ListRackLSEsRequest
+	(*ListRackLSEsResponse)(nil),                    // 70: unifiedfleet.api.v1.rpc.ListRackLSEsResponse
+	(*DeleteRackLSERequest)(nil),                    // 71: unifiedfleet.api.v1.rpc.DeleteRackLSERequest
+	(*CreateNicRequest)(nil),                        // 72: unifiedfleet.api.v1.rpc.CreateNicRequest
+	(*UpdateNicRequest)(nil),                        // 73: unifiedfleet.api.v1.rpc.UpdateNicRequest
+	(*GetNicRequest)(nil),                           // 74: unifiedfleet.api.v1.rpc.GetNicRequest
+	(*ListNicsRequest)(nil),                         // 75: unifiedfleet.api.v1.rpc.ListNicsRequest
+	(*ListNicsResponse)(nil),                        // 76: unifiedfleet.api.v1.rpc.ListNicsResponse
+	(*DeleteNicRequest)(nil),                        // 77: unifiedfleet.api.v1.rpc.DeleteNicRequest
+	(*ImportNicsRequest)(nil),                       // 78: unifiedfleet.api.v1.rpc.ImportNicsRequest
+	(*RenameNicRequest)(nil),                        // 79: unifiedfleet.api.v1.rpc.RenameNicRequest
+	(*RenameSwitchRequest)(nil),                     // 80: unifiedfleet.api.v1.rpc.RenameSwitchRequest
+	(*ImportDatacentersRequest)(nil),                // 81: unifiedfleet.api.v1.rpc.ImportDatacentersRequest
+	(*CreateKVMRequest)(nil),                        // 82: unifiedfleet.api.v1.rpc.CreateKVMRequest
+	(*UpdateKVMRequest)(nil),                        // 83: unifiedfleet.api.v1.rpc.UpdateKVMRequest
+	(*GetKVMRequest)(nil),                           // 84: unifiedfleet.api.v1.rpc.GetKVMRequest
+	(*ListKVMsRequest)(nil),                         // 85: unifiedfleet.api.v1.rpc.ListKVMsRequest
+	(*ListKVMsResponse)(nil),                        // 86: unifiedfleet.api.v1.rpc.ListKVMsResponse
+	(*DeleteKVMRequest)(nil),                        // 87: unifiedfleet.api.v1.rpc.DeleteKVMRequest
+	(*CreateRPMRequest)(nil),                        // 88: unifiedfleet.api.v1.rpc.CreateRPMRequest
+	(*UpdateRPMRequest)(nil),                        // 89: unifiedfleet.api.v1.rpc.UpdateRPMRequest
+	(*GetRPMRequest)(nil),                           // 90: unifiedfleet.api.v1.rpc.GetRPMRequest
+	(*ListRPMsRequest)(nil),                         // 91: unifiedfleet.api.v1.rpc.ListRPMsRequest
+	(*ListRPMsResponse)(nil),                        // 92: unifiedfleet.api.v1.rpc.ListRPMsResponse
+	(*DeleteRPMRequest)(nil),                        // 93: unifiedfleet.api.v1.rpc.DeleteRPMRequest
+	(*CreateDracRequest)(nil),                       // 94: unifiedfleet.api.v1.rpc.CreateDracRequest
+	(*UpdateDracRequest)(nil),                       // 95: unifiedfleet.api.v1.rpc.UpdateDracRequest
+	(*GetDracRequest)(nil),                          // 96: unifiedfleet.api.v1.rpc.GetDracRequest
+	(*ListDracsRequest)(nil),                        // 97: unifiedfleet.api.v1.rpc.ListDracsRequest
+	(*ListDracsResponse)(nil),                       // 98: unifiedfleet.api.v1.rpc.ListDracsResponse
+	(*DeleteDracRequest)(nil),                       // 99: unifiedfleet.api.v1.rpc.DeleteDracRequest
+	(*CreateSwitchRequest)(nil),                     // 100: unifiedfleet.api.v1.rpc.CreateSwitchRequest
+	(*UpdateSwitchRequest)(nil),                     // 101: unifiedfleet.api.v1.rpc.UpdateSwitchRequest
+	(*GetSwitchRequest)(nil),                        // 102: unifiedfleet.api.v1.rpc.GetSwitchRequest
+	(*ListSwitchesRequest)(nil),                     // 103: unifiedfleet.api.v1.rpc.ListSwitchesRequest
+	(*ListSwitchesResponse)(nil),                    // 104: unifiedfleet.api.v1.rpc.ListSwitchesResponse
+	(*DeleteSwitchRequest)(nil),                     // 105: unifiedfleet.api.v1.rpc.DeleteSwitchRequest
+	(*CreateVlanRequest)(nil),                       // 106: unifiedfleet.api.v1.rpc.CreateVlanRequest
+	(*UpdateVlanRequest)(nil),                       // 107: unifiedfleet.api.v1.rpc.UpdateVlanRequest
+	(*GetVlanRequest)(nil),                          // 108: unifiedfleet.api.v1.rpc.GetVlanRequest
+	(*ListVlansRequest)(nil),                        // 109: unifiedfleet.api.v1.rpc.ListVlansRequest
+	(*ListVlansResponse)(nil),                       // 110: unifiedfleet.api.v1.rpc.ListVlansResponse
+	(*DeleteVlanRequest)(nil),                       // 111: unifiedfleet.api.v1.rpc.DeleteVlanRequest
+	(*ImportVlansRequest)(nil),                      // 112: unifiedfleet.api.v1.rpc.ImportVlansRequest
+	(*ImportOSVlansRequest)(nil),                    // 113: unifiedfleet.api.v1.rpc.ImportOSVlansRequest
+	(*ImportStatesRequest)(nil),                     // 114: unifiedfleet.api.v1.rpc.ImportStatesRequest
+	(*GetStateRequest)(nil),                         // 115: unifiedfleet.api.v1.rpc.GetStateRequest
+	(*GetDutStateRequest)(nil),                      // 116: unifiedfleet.api.v1.rpc.GetDutStateRequest
+	(*ListDutStatesRequest)(nil),                    // 117: unifiedfleet.api.v1.rpc.ListDutStatesRequest
+	(*ListDutStatesResponse)(nil),                   // 118: unifiedfleet.api.v1.rpc.ListDutStatesResponse
+	(*UpdateStateRequest)(nil),                      // 119: unifiedfleet.api.v1.rpc.UpdateStateRequest
+	(*UpdateDutStateRequest)(nil),                   // 120: unifiedfleet.api.v1.rpc.UpdateDutStateRequest
+	(*RackRegistrationRequest)(nil),                 // 121: unifiedfleet.api.v1.rpc.RackRegistrationRequest
+	(*NetworkOption)(nil),                           // 122: unifiedfleet.api.v1.rpc.NetworkOption
+	(*CreateAssetRequest)(nil),                      // 123: unifiedfleet.api.v1.rpc.CreateAssetRequest
+	(*UpdateAssetRequest)(nil),                      // 124: unifiedfleet.api.v1.rpc.UpdateAssetRequest
+	(*GetAssetRequest)(nil),                         // 125: unifiedfleet.api.v1.rpc.GetAssetRequest
+	(*ListAssetsRequest)(nil),                       // 126: unifiedfleet.api.v1.rpc.ListAssetsRequest
+	(*ListAssetsResponse)(nil),                      // 127: unifiedfleet.api.v1.rpc.ListAssetsResponse
+	(*DeleteAssetRequest)(nil),                      // 128: unifiedfleet.api.v1.rpc.DeleteAssetRequest
+	(*RenameAssetRequest)(nil),                      // 129: unifiedfleet.api.v1.rpc.RenameAssetRequest
+	(*BatchGetKVMsRequest)(nil),                     // 130: unifiedfleet.api.v1.rpc.BatchGetKVMsRequest
+	(*BatchGetKVMsResponse)(nil),                    // 131: unifiedfleet.api.v1.rpc.BatchGetKVMsResponse
+	(*BatchGetDHCPConfigsRequest)(nil),              // 132: unifiedfleet.api.v1.rpc.BatchGetDHCPConfigsRequest
+	(*BatchGetDHCPConfigsResponse)(nil),             // 133: unifiedfleet.api.v1.rpc.BatchGetDHCPConfigsResponse
+	(*BatchGetMachineLSEsRequest)(nil),              // 134: unifiedfleet.api.v1.rpc.BatchGetMachineLSEsRequest
+	(*BatchGetMachineLSEsResponse)(nil),             // 135: unifiedfleet.api.v1.rpc.BatchGetMachineLSEsResponse
+	(*BatchGetMachinesRequest)(nil),                 // 136: unifiedfleet.api.v1.rpc.BatchGetMachinesRequest
+	(*BatchGetMachinesResponse)(nil),                // 137: unifiedfleet.api.v1.rpc.BatchGetMachinesResponse
+	(*BatchGetSwitchesRequest)(nil),                 // 138: unifiedfleet.api.v1.rpc.BatchGetSwitchesRequest
+	(*BatchGetSwitchesResponse)(nil),                // 139: unifiedfleet.api.v1.rpc.BatchGetSwitchesResponse
+	(*BatchGetRPMsRequest)(nil),                     // 140: unifiedfleet.api.v1.rpc.BatchGetRPMsRequest
+	(*BatchGetRPMsResponse)(nil),                    // 141: unifiedfleet.api.v1.rpc.BatchGetRPMsResponse
+	(*BatchGetDracsRequest)(nil),                    // 142: unifiedfleet.api.v1.rpc.BatchGetDracsRequest
+	(*BatchGetDracsResponse)(nil),                   // 143: unifiedfleet.api.v1.rpc.BatchGetDracsResponse
+	(*BatchGetNicsRequest)(nil),                     // 144: unifiedfleet.api.v1.rpc.BatchGetNicsRequest
+	(*BatchGetNicsResponse)(nil),                    // 145: unifiedfleet.api.v1.rpc.BatchGetNicsResponse
+	(*BatchGetVMsRequest)(nil),                      // 146: unifiedfleet.api.v1.rpc.BatchGetVMsRequest
+	(*BatchGetVMsResponse)(nil),                     // 147: unifiedfleet.api.v1.rpc.BatchGetVMsResponse
+	(*BatchGetVlansRequest)(nil),                    // 148: unifiedfleet.api.v1.rpc.BatchGetVlansRequest
+	(*BatchGetVlansResponse)(nil),                   // 149: unifiedfleet.api.v1.rpc.BatchGetVlansResponse
+	(*BatchGetRacksRequest)(nil),                    // 150: unifiedfleet.api.v1.rpc.BatchGetRacksRequest
+	(*BatchGetRacksResponse)(nil),                   // 151: unifiedfleet.api.v1.rpc.BatchGetRacksResponse
+	(*BatchGetChromePlatformsRequest)(nil),          // 152: unifiedfleet.api.v1.rpc.BatchGetChromePlatformsRequest
+	(*BatchGetChromePlatformsResponse)(nil),         // 153: unifiedfleet.api.v1.rpc.BatchGetChromePlatformsResponse
+	(*BatchGetMachineLSEPrototypesRequest)(nil),     // 154: unifiedfleet.api.v1.rpc.BatchGetMachineLSEPrototypesRequest
+	(*BatchGetMachineLSEPrototypesResponse)(nil),    // 155: unifiedfleet.api.v1.rpc.BatchGetMachineLSEPrototypesResponse
+	(*BatchGetRackLSEPrototypesRequest)(nil),        // 156: unifiedfleet.api.v1.rpc.BatchGetRackLSEPrototypesRequest
+	(*BatchGetRackLSEPrototypesResponse)(nil),       // 157: unifiedfleet.api.v1.rpc.BatchGetRackLSEPrototypesResponse
+	(*GetChromeOSDeviceDataRequest)(nil),            // 158: unifiedfleet.api.v1.rpc.GetChromeOSDeviceDataRequest
+	(*CreateCachingServiceRequest)(nil),             // 159: unifiedfleet.api.v1.rpc.CreateCachingServiceRequest
+	(*UpdateCachingServiceRequest)(nil),             // 160: unifiedfleet.api.v1.rpc.UpdateCachingServiceRequest
+	(*GetCachingServiceRequest)(nil),                // 161: unifiedfleet.api.v1.rpc.GetCachingServiceRequest
+	(*ListCachingServicesRequest)(nil),              // 162: unifiedfleet.api.v1.rpc.ListCachingServicesRequest
+	(*ListCachingServicesResponse)(nil),             // 163: unifiedfleet.api.v1.rpc.ListCachingServicesResponse
+	(*DeleteCachingServiceRequest)(nil),             // 164: unifiedfleet.api.v1.rpc.DeleteCachingServiceRequest
+	(*CreateSchedulingUnitRequest)(nil),             // 165: unifiedfleet.api.v1.rpc.CreateSchedulingUnitRequest
+	(*UpdateSchedulingUnitRequest)(nil),             // 166: unifiedfleet.api.v1.rpc.UpdateSchedulingUnitRequest
+	(*GetSchedulingUnitRequest)(nil),                // 167: unifiedfleet.api.v1.rpc.GetSchedulingUnitRequest
+	(*ListSchedulingUnitsRequest)(nil),              // 168: unifiedfleet.api.v1.rpc.ListSchedulingUnitsRequest
+	(*ListSchedulingUnitsResponse)(nil),             // 169: unifiedfleet.api.v1.rpc.ListSchedulingUnitsResponse
+	(*DeleteSchedulingUnitRequest)(nil),             // 170: unifiedfleet.api.v1.rpc.DeleteSchedulingUnitRequest
+	(*UpdateConfigBundleRequest)(nil),               // 171: unifiedfleet.api.v1.rpc.UpdateConfigBundleRequest
+	(*UpdateConfigBundleResponse)(nil),              // 172: unifiedfleet.api.v1.rpc.UpdateConfigBundleResponse
+	nil,                                             // 173: unifiedfleet.api.v1.rpc.UpdateMachineLSERequest.NetworkOptionsEntry
+	(*models.MachineLSEDeployment)(nil),             // 174: unifiedfleet.api.v1.models.MachineLSEDeployment
+	(*fieldmaskpb.FieldMask)(nil),                   // 175: google.protobuf.FieldMask
+	(*models.VM)(nil),                               // 176: unifiedfleet.api.v1.models.VM
+	(*models.ChromePlatform)(nil),                   // 177: unifiedfleet.api.v1.models.ChromePlatform
+	(*models.OSVersion)(nil),                        // 178: unifiedfleet.api.v1.models.OSVersion
+	(*models.MachineLSEPrototype)(nil),              // 179: unifiedfleet.api.v1.models.MachineLSEPrototype
+	(*models.RackLSEPrototype)(nil),                 // 180: unifiedfleet.api.v1.models.RackLSEPrototype
+	(*models.Machine)(nil),                          // 181: unifiedfleet.api.v1.models.Machine
+	(*models.Rack)(nil),                             // 182: unifiedfleet.api.v1.models.Rack
+	(*models.MachineLSE)(nil),                       // 183: unifiedfleet.api.v1.models.MachineLSE
+	(*models.RackLSE)(nil),                          // 184: unifiedfleet.api.v1.models.RackLSE
+	(*models.Nic)(nil),                              // 185: unifiedfleet.api.v1.models.Nic
+	(*models.KVM)(nil),                              // 186: unifiedfleet.api.v1.models.KVM
+	(*models.RPM)(nil),                              // 187: unifiedfleet.api.v1.models.RPM
+	(*models.Drac)(nil),                             // 188: unifiedfleet.api.v1.models.Drac
+	(*models.Switch)(nil),                           // 189: unifiedfleet.api.v1.models.Switch
+	(*models.Vlan)(nil),                             // 190: unifiedfleet.api.v1.models.Vlan
+	(*lab.DutState)(nil),                            // 191: unifiedfleet.api.v1.models.chromeos.lab.DutState
+	(*models.StateRecord)(nil),                      // 192: unifiedfleet.api.v1.models.StateRecord
+	(*models.DutMeta)(nil),                          // 193: unifiedfleet.api.v1.models.DutMeta
+	(*models.LabMeta)(nil),                          // 194: unifiedfleet.api.v1.models.LabMeta
+	(*models.Asset)(nil),                            // 195: unifiedfleet.api.v1.models.asset
+	(*models.DHCPConfig)(nil),                       // 196: unifiedfleet.api.v1.models.DHCPConfig
+	(*models.CachingService)(nil),                   // 197: unifiedfleet.api.v1.models.CachingService
+	(*models.SchedulingUnit)(nil),                   // 198: unifiedfleet.api.v1.models.SchedulingUnit
+	(*emptypb.Empty)(nil),                           // 199: google.protobuf.Empty
+	(*status.Status)(nil),                           // 200: google.rpc.Status
+	(*models.ChromeOSDeviceData)(nil),               // 201: unifiedfleet.api.v1.models.ChromeOSDeviceData
 }
 var file_infra_unifiedfleet_api_v1_rpc_fleet_proto_depIdxs = []int32{
-	172, // 0: unifiedfleet.api.v1.rpc.UpdateMachineLSEDeploymentRequest.machine_lse_deployment:type_name -> unifiedfleet.api.v1.models.MachineLSEDeployment
-	173, // 1: unifiedfleet.api.v1.rpc.UpdateMachineLSEDeploymentRequest.update_mask:type_name -> google.protobuf.FieldMask
+	174, // 0: unifiedfleet.api.v1.rpc.UpdateMachineLSEDeploymentRequest.machine_lse_deployment:type_name -> unifiedfleet.api.v1.models.MachineLSEDeployment
+	175, // 1: unifiedfleet.api.v1.rpc.UpdateMachineLSEDeploymentRequest.update_mask:type_name -> google.protobuf.FieldMask
 	0,   // 2: unifiedfleet.api.v1.rpc.BatchUpdateMachineLSEDeploymentRequest.requests:type_name -> unifiedfleet.api.v1.rpc.UpdateMachineLSEDeploymentRequest
-	172, // 3: unifiedfleet.api.v1.rpc.BatchUpdateMachineLSEDeploymentResponse.machine_lse_deployments:type_name -> unifiedfleet.api.v1.models.MachineLSEDeployment
-	172, // 4: unifiedfleet.api.v1.rpc.BatchGetMachineLSEDeploymentsResponse.machine_lse_deployments:type_name -> unifiedfleet.api.v1.models.MachineLSEDeployment
-	172, // 5: unifiedfleet.api.v1.rpc.ListMachineLSEDeploymentsResponse.machine_lse_deployments:type_name -> unifiedfleet.api.v1.models.MachineLSEDeployment
-	174, // 6: unifiedfleet.api.v1.rpc.CreateVMRequest.vm:type_name -> unifiedfleet.api.v1.models.VM
-	120, // 7: unifiedfleet.api.v1.rpc.CreateVMRequest.network_option:type_name -> unifiedfleet.api.v1.rpc.NetworkOption
-	174, // 8: unifiedfleet.api.v1.rpc.UpdateVMRequest.vm:type_name -> unifiedfleet.api.v1.models.VM
-	173, // 9: unifiedfleet.api.v1.rpc.UpdateVMRequest.update_mask:type_name -> google.protobuf.FieldMask
-	120, // 10: unifiedfleet.api.v1.rpc.UpdateVMRequest.network_option:type_name -> unifiedfleet.api.v1.rpc.NetworkOption
-	174, // 11: unifiedfleet.api.v1.rpc.ListVMsResponse.vms:type_name -> unifiedfleet.api.v1.models.VM
-	175, // 12: unifiedfleet.api.v1.rpc.CreateChromePlatformRequest.chromePlatform:type_name -> unifiedfleet.api.v1.models.ChromePlatform
-	175, // 13: unifiedfleet.api.v1.rpc.UpdateChromePlatformRequest.chromePlatform:type_name -> unifiedfleet.api.v1.models.ChromePlatform
-	173, // 14: unifiedfleet.api.v1.rpc.UpdateChromePlatformRequest.update_mask:type_name -> google.protobuf.FieldMask
-	175, // 15: unifiedfleet.api.v1.rpc.ListChromePlatformsResponse.chromePlatforms:type_name -> unifiedfleet.api.v1.models.ChromePlatform
-	47,  // 16: unifiedfleet.api.v1.rpc.ImportChromePlatformsRequest.machine_db_source:type_name -> unifiedfleet.api.v1.rpc.MachineDBSource
-	48,  // 17: unifiedfleet.api.v1.rpc.ImportChromePlatformsRequest.config_source:type_name -> unifiedfleet.api.v1.rpc.ConfigSource
+	174, // 3: unifiedfleet.api.v1.rpc.BatchUpdateMachineLSEDeploymentResponse.machine_lse_deployments:type_name -> unifiedfleet.api.v1.models.MachineLSEDeployment
+	174, // 4: unifiedfleet.api.v1.rpc.BatchGetMachineLSEDeploymentsResponse.machine_lse_deployments:type_name -> unifiedfleet.api.v1.models.MachineLSEDeployment
+	174, // 5: unifiedfleet.api.v1.rpc.ListMachineLSEDeploymentsResponse.machine_lse_deployments:type_name -> unifiedfleet.api.v1.models.MachineLSEDeployment
+	176, // 6: unifiedfleet.api.v1.rpc.CreateVMRequest.vm:type_name -> unifiedfleet.api.v1.models.VM
+	122, // 7: unifiedfleet.api.v1.rpc.CreateVMRequest.network_option:type_name -> unifiedfleet.api.v1.rpc.NetworkOption
+	176, // 8: unifiedfleet.api.v1.rpc.UpdateVMRequest.vm:type_name -> unifiedfleet.api.v1.models.VM
+	175, // 9: unifiedfleet.api.v1.rpc.UpdateVMRequest.update_mask:type_name -> google.protobuf.FieldMask
+	122, // 10: unifiedfleet.api.v1.rpc.UpdateVMRequest.network_option:type_name -> unifiedfleet.api.v1.rpc.NetworkOption
+	176, // 11: unifiedfleet.api.v1.rpc.ListVMsResponse.vms:type_name -> unifiedfleet.api.v1.models.VM
+	177, // 12: unifiedfleet.api.v1.rpc.CreateChromePlatformRequest.chromePlatform:type_name -> unifiedfleet.api.v1.models.ChromePlatform
+	177, // 13: unifiedfleet.api.v1.rpc.UpdateChromePlatformRequest.chromePlatform:type_name -> unifiedfleet.api.v1.models.ChromePlatform
+	175, // 14: unifiedfleet.api.v1.rpc.UpdateChromePlatformRequest.update_mask:type_name -> google.protobuf.FieldMask
+	177, // 15: unifiedfleet.api.v1.rpc.ListChromePlatformsResponse.chromePlatforms:type_name -> unifiedfleet.api.v1.models.ChromePlatform
+	48,  // 16: unifiedfleet.api.v1.rpc.ImportChromePlatformsRequest.machine_db_source:type_name -> unifiedfleet.api.v1.rpc.MachineDBSource
+	49,  // 17: unifiedfleet.api.v1.rpc.ImportChromePlatformsRequest.config_source:type_name -> unifiedfleet.api.v1.rpc.ConfigSource
 	23,  // 18: unifiedfleet.api.v1.rpc.ImportChromePlatformsResponse.passed:type_name -> unifiedfleet.api.v1.rpc.ChromePlatformResult
 	23,  // 19: unifiedfleet.api.v1.rpc.ImportChromePlatformsResponse.failed:type_name -> unifiedfleet.api.v1.rpc.ChromePlatformResult
-	175, // 20: unifiedfleet.api.v1.rpc.ChromePlatformResult.platform:type_name -> unifiedfleet.api.v1.models.ChromePlatform
-	47,  // 21: unifiedfleet.api.v1.rpc.ImportOSVersionsRequest.machine_db_source:type_name -> unifiedfleet.api.v1.rpc.MachineDBSource
-	48,  // 22: unifiedfleet.api.v1.rpc.ImportOSVersionsRequest.config_source:type_name -> unifiedfleet.api.v1.rpc.ConfigSource
-	176, // 23: unifiedfleet.api.v1.rpc.ListOSVersionsResponse.os_version:type_name -> unifiedfleet.api.v1.models.OSVersion
-	177, // 24: unifiedfleet.api.v1.rpc.CreateMachineLSEPrototypeRequest.machineLSEPrototype:type_name -> unifiedfleet.api.v1.models.MachineLSEPrototype
-	177, // 25: unifiedfleet.api.v1.rpc.UpdateMachineLSEPrototypeRequest.machineLSEPrototype:type_name -> unifiedfleet.api.v1.models.MachineLSEPrototype
-	173, // 26: unifiedfleet.api.v1.rpc.UpdateMachineLSEPrototypeRequest.update_mask:type_name -> google.protobuf.FieldMask
-	177, // 27: unifiedfleet.api.v1.rpc.ListMachineLSEPrototypesResponse.machineLSEPrototypes:type_name -> unifiedfleet.api.v1.models.MachineLSEPrototype
-	178, // 28: unifiedfleet.api.v1.rpc.CreateRackLSEPrototypeRequest.rackLSEPrototype:type_name -> unifiedfleet.api.v1.models.RackLSEPrototype
-	178, // 29: unifiedfleet.api.v1.rpc.UpdateRackLSEPrototypeRequest.rackLSEPrototype:type_name -> unifiedfleet.api.v1.models.RackLSEPrototype
-	173, // 30: unifiedfleet.api.v1.rpc.UpdateRackLSEPrototypeRequest.update_mask:type_name -> google.protobuf.FieldMask
-	178, // 31: unifiedfleet.api.v1.rpc.ListRackLSEPrototypesResponse.rackLSEPrototypes:type_name -> unifiedfleet.api.v1.models.RackLSEPrototype
-	179, // 32: unifiedfleet.api.v1.rpc.MachineRegistrationRequest.machine:type_name -> unifiedfleet.api.v1.models.Machine
-	179, // 33: unifiedfleet.api.v1.rpc.UpdateMachineRequest.machine:type_name -> unifiedfleet.api.v1.models.Machine
-	173, // 34: unifiedfleet.api.v1.rpc.UpdateMachineRequest.update_mask:type_name -> google.protobuf.FieldMask
-	179, // 35: unifiedfleet.api.v1.rpc.ListMachinesResponse.machines:type_name -> unifiedfleet.api.v1.models.Machine
-	47,  // 36: unifiedfleet.api.v1.rpc.ImportMachinesRequest.machine_db_source:type_name -> unifiedfleet.api.v1.rpc.MachineDBSource
-	48,  // 37: unifiedfleet.api.v1.rpc.ImportMachinesRequest.config_source:type_name -> unifiedfleet.api.v1.rpc.ConfigSource
-	180, // 38: unifiedfleet.api.v1.rpc.CreateRackRequest.rack:type_name -> unifiedfleet.api.v1.models.Rack
-	180, // 39: unifiedfleet.api.v1.rpc.UpdateRackRequest.rack:type_name -> unifiedfleet.api.v1.models.Rack
-	173, // 40: unifiedfleet.api.v1.rpc.UpdateRackRequest.update_mask:type_name -> google.protobuf.FieldMask
-	180, // 41: unifiedfleet.api.v1.rpc.ListRacksResponse.racks:type_name -> unifiedfleet.api.v1.models.Rack
-	181, // 42: unifiedfleet.api.v1.rpc.CreateMachineLSERequest.machineLSE:type_name -> unifiedfleet.api.v1.models.MachineLSE
-	120, // 43: unifiedfleet.api.v1.rpc.CreateMachineLSERequest.network_option:type_name -> unifiedfleet.api.v1.rpc.NetworkOption
-	181, // 44: unifiedfleet.api.v1.rpc.UpdateMachineLSERequest.machineLSE:type_name -> unifiedfleet.api.v1.models.MachineLSE
-	173, // 45: unifiedfleet.api.v1.rpc.UpdateMachineLSERequest.update_mask:type_name -> google.protobuf.FieldMask
-	171, // 46: unifiedfleet.api.v1.rpc.UpdateMachineLSERequest.network_options:type_name -> unifiedfleet.api.v1.rpc.UpdateMachineLSERequest.NetworkOptionsEntry
-	181, // 47: unifiedfleet.api.v1.rpc.ListMachineLSEsResponse.machineLSEs:type_name -> unifiedfleet.api.v1.models.MachineLSE
-	47,  // 48: unifiedfleet.api.v1.rpc.ImportMachineLSEsRequest.machine_db_source:type_name -> unifiedfleet.api.v1.rpc.MachineDBSource
-	48,  // 49: unifiedfleet.api.v1.rpc.ImportMachineLSEsRequest.config_source:type_name -> unifiedfleet.api.v1.rpc.ConfigSource
-	47,  // 50: unifiedfleet.api.v1.rpc.ImportOSMachineLSEsRequest.machine_db_source:type_name -> unifiedfleet.api.v1.rpc.MachineDBSource
-	48,  // 51: unifiedfleet.api.v1.rpc.ImportOSMachineLSEsRequest.config_source:type_name -> unifiedfleet.api.v1.rpc.ConfigSource
-	182, // 52: unifiedfleet.api.v1.rpc.CreateRackLSERequest.rackLSE:type_name -> unifiedfleet.api.v1.models.RackLSE
-	182, // 53: unifiedfleet.api.v1.rpc.UpdateRackLSERequest.rackLSE:type_name -> unifiedfleet.api.v1.models.RackLSE
-	173, // 54: unifiedfleet.api.v1.rpc.UpdateRackLSERequest.update_mask:type_name -> google.protobuf.FieldMask
-	182, // 55: unifiedfleet.api.v1.rpc.ListRackLSEsResponse.rackLSEs:type_name -> unifiedfleet.api.v1.models.RackLSE
-	183, // 56: unifiedfleet.api.v1.rpc.CreateNicRequest.nic:type_name -> unifiedfleet.api.v1.models.Nic
-	183, // 57: unifiedfleet.api.v1.rpc.UpdateNicRequest.nic:type_name -> unifiedfleet.api.v1.models.Nic
-	173, // 58: unifiedfleet.api.v1.rpc.UpdateNicRequest.update_mask:type_name -> google.protobuf.FieldMask
-	183, // 59: unifiedfleet.api.v1.rpc.ListNicsResponse.nics:type_name -> unifiedfleet.api.v1.models.Nic
-	47,  // 60: unifiedfleet.api.v1.rpc.ImportNicsRequest.machine_db_source:type_name -> unifiedfleet.api.v1.rpc.MachineDBSource
-	48,  // 61: unifiedfleet.api.v1.rpc.ImportNicsRequest.config_source:type_name -> unifiedfleet.api.v1.rpc.ConfigSource
-	47,  // 62: unifiedfleet.api.v1.rpc.ImportDatacentersRequest.machine_db_source:type_name -> unifiedfleet.api.v1.rpc.MachineDBSource
-	48,  // 63: unifiedfleet.api.v1.rpc.ImportDatacentersRequest.config_source:type_name -> unifiedfleet.api.v1.rpc.ConfigSource
-	184, // 64: unifiedfleet.api.v1.rpc.CreateKVMRequest.KVM:type_name -> unifiedfleet.api.v1.models.KVM
-	184, // 65: unifiedfleet.api.v1.rpc.UpdateKVMRequest.KVM:type_name -> unifiedfleet.api.v1.models.KVM
-	173, // 66: unifiedfleet.api.v1.rpc.UpdateKVMRequest.update_mask:type_name -> google.protobuf.FieldMask
-	120, // 67: unifiedfleet.api.v1.rpc.UpdateKVMRequest.network_option:type_name -> unifiedfleet.api.v1.rpc.NetworkOption
-	184, // 68: unifiedfleet.api.v1.rpc.ListKVMsResponse.KVMs:type_name -> unifiedfleet.api.v1.models.KVM
-	185, // 69: unifiedfleet.api.v1.rpc.CreateRPMRequest.RPM:type_name -> unifiedfleet.api.v1.models.RPM
-	185, // 70: unifiedfleet.api.v1.rpc.UpdateRPMRequest.RPM:type_name -> unifiedfleet.api.v1.models.RPM
-	173, // 71: unifiedfleet.api.v1.rpc.UpdateRPMRequest.update_mask:type_name -> google.protobuf.FieldMask
-	120, // 72: unifiedfleet.api.v1.rpc.UpdateRPMRequest.network_option:type_name -> unifiedfleet.api.v1.rpc.NetworkOption
-	185, // 73: unifiedfleet.api.v1.rpc.ListRPMsResponse.RPMs:type_name -> unifiedfleet.api.v1.models.RPM
-	186, // 74: unifiedfleet.api.v1.rpc.CreateDracRequest.drac:type_name -> unifiedfleet.api.v1.models.Drac
-	120, // 75: unifiedfleet.api.v1.rpc.CreateDracRequest.network_option:type_name -> unifiedfleet.api.v1.rpc.NetworkOption
-	186, // 76: unifiedfleet.api.v1.rpc.UpdateDracRequest.drac:type_name -> unifiedfleet.api.v1.models.Drac
-	173, // 77: unifiedfleet.api.v1.rpc.UpdateDracRequest.update_mask:type_name -> google.protobuf.FieldMask
-	120, // 78: unifiedfleet.api.v1.rpc.UpdateDracRequest.network_option:type_name -> unifiedfleet.api.v1.rpc.NetworkOption
-	186, // 79: unifiedfleet.api.v1.rpc.ListDracsResponse.dracs:type_name -> unifiedfleet.api.v1.models.Drac
-	187, // 80: unifiedfleet.api.v1.rpc.CreateSwitchRequest.switch:type_name -> unifiedfleet.api.v1.models.Switch
-	187, // 81: unifiedfleet.api.v1.rpc.UpdateSwitchRequest.switch:type_name -> unifiedfleet.api.v1.models.Switch
-	173, // 82: unifiedfleet.api.v1.rpc.UpdateSwitchRequest.update_mask:type_name -> google.protobuf.FieldMask
-	187, // 83: unifiedfleet.api.v1.rpc.ListSwitchesResponse.switches:type_name -> unifiedfleet.api.v1.models.Switch
-	188, // 84: unifiedfleet.api.v1.rpc.CreateVlanRequest.vlan:type_name -> unifiedfleet.api.v1.models.Vlan
-	188, // 85: unifiedfleet.api.v1.rpc.UpdateVlanRequest.vlan:type_name -> unifiedfleet.api.v1.models.Vlan
-	173, // 86: unifiedfleet.api.v1.rpc.UpdateVlanRequest.update_mask:type_name -> google.protobuf.FieldMask
-	188, // 87: unifiedfleet.api.v1.rpc.ListVlansResponse.vlans:type_name -> unifiedfleet.api.v1.models.Vlan
-	47,  // 88: unifiedfleet.api.v1.rpc.ImportVlansRequest.machine_db_source:type_name -> unifiedfleet.api.v1.rpc.MachineDBSource
-	48,  // 89: unifiedfleet.api.v1.rpc.ImportVlansRequest.config_source:type_name -> unifiedfleet.api.v1.rpc.ConfigSource
-	47,  // 90: unifiedfleet.api.v1.rpc.ImportOSVlansRequest.machine_db_source:type_name -> unifiedfleet.api.v1.rpc.MachineDBSource
-	48,  // 91: unifiedfleet.api.v1.rpc.ImportOSVlansRequest.config_source:type_name -> unifiedfleet.api.v1.rpc.ConfigSource
-	47,  // 92: unifiedfleet.api.v1.rpc.ImportStatesRequest.machine_db_source:type_name -> unifiedfleet.api.v1.rpc.MachineDBSource
-	48,  // 93: unifiedfleet.api.v1.rpc.ImportStatesRequest.config_source:type_name -> unifiedfleet.api.v1.rpc.ConfigSource
-	189, // 94: unifiedfleet.api.v1.rpc.ListDutStatesResponse.dut_states:type_name -> unifiedfleet.api.v1.models.chromeos.lab.DutState
-	190, // 95: unifiedfleet.api.v1.rpc.UpdateStateRequest.state:type_name -> unifiedfleet.api.v1.models.StateRecord
-	173, // 96: unifiedfleet.api.v1.rpc.UpdateStateRequest.update_mask:type_name -> google.protobuf.FieldMask
-	189, // 97: unifiedfleet.api.v1.rpc.UpdateDutStateRequest.dut_state:type_name -> unifiedfleet.api.v1.models.chromeos.lab.DutState
-	173, // 98: unifiedfleet.api.v1.rpc.UpdateDutStateRequest.update_mask:type_name -> google.protobuf.FieldMask
-	191, // 99: unifiedfleet.api.v1.rpc.UpdateDutStateRequest.dut_meta:type_name -> unifiedfleet.api.v1.models.DutMeta
-	192, // 100: unifiedfleet.api.v1.rpc.UpdateDutStateRequest.lab_meta:type_name -> unifiedfleet.api.v1.models.LabMeta
-	180, // 101: unifiedfleet.api.v1.rpc.RackRegistrationRequest.rack:type_name -> unifiedfleet.api.v1.models.Rack
-	193, // 102: unifiedfleet.api.v1.rpc.CreateAssetRequest.asset:type_name -> unifiedfleet.api.v1.models.asset
-	193, // 103: unifiedfleet.api.v1.rpc.UpdateAssetRequest.asset:type_name -> unifiedfleet.api.v1.models.asset
-	173, // 104: unifiedfleet.api.v1.rpc.UpdateAssetRequest.update_mask:type_name -> google.protobuf.FieldMask
-	193, // 105: unifiedfleet.api.v1.rpc.ListAssetsResponse.assets:type_name -> unifiedfleet.api.v1.models.asset
-	184, // 106: unifiedfleet.api.v1.rpc.BatchGetKVMsResponse.KVMs:type_name -> unifiedfleet.api.v1.models.KVM
-	194, // 107: unifiedfleet.api.v1.rpc.BatchGetDHCPConfigsResponse.dhcp_configs:type_name -> unifiedfleet.api.v1.models.DHCPConfig
-	181, // 108: unifiedfleet.api.v1.rpc.BatchGetMachineLSEsResponse.machine_lses:type_name -> unifiedfleet.api.v1.models.MachineLSE
-	179, // 109: unifiedfleet.api.v1.rpc.BatchGetMachinesResponse.machines:type_name -> unifiedfleet.api.v1.models.Machine
-	187, // 110: unifiedfleet.api.v1.rpc.BatchGetSwitchesResponse.switches:type_name -> unifiedfleet.api.v1.models.Switch
-	185, // 111: unifiedfleet.api.v1.rpc.BatchGetRPMsResponse.rpms:type_name -> unifiedfleet.api.v1.models.RPM
-	186, // 112: unifiedfleet.api.v1.rpc.BatchGetDracsResponse.dracs:type_name -> unifiedfleet.api.v1.models.Drac
-	183, // 113: unifiedfleet.api.v1.rpc.BatchGetNicsResponse.nics:type_name -> unifiedfleet.api.v1.models.Nic
-	174, // 114: unifiedfleet.api.v1.rpc.BatchGetVMsResponse.vms:type_name -> unifiedfleet.api.v1.models.VM
-	188, // 115: unifiedfleet.api.v1.rpc.BatchGetVlansResponse.vlans:type_name -> unifiedfleet.api.v1.models.Vlan
-	180, // 116: unifiedfleet.api.v1.rpc.BatchGetRacksResponse.racks:type_name -> unifiedfleet.api.v1.models.Rack
-	175, // 117: unifiedfleet.api.v1.rpc.BatchGetChromePlatformsResponse.chrome_platforms:type_name -> unifiedfleet.api.v1.models.ChromePlatform
-	177, // 118: unifiedfleet.api.v1.rpc.BatchGetMachineLSEPrototypesResponse.machine_lse_prototypes:type_name -> unifiedfleet.api.v1.models.MachineLSEPrototype
-	178, // 119: unifiedfleet.api.v1.rpc.BatchGetRackLSEPrototypesResponse.rack_lse_prototypes:type_name -> unifiedfleet.api.v1.models.RackLSEPrototype
-	195, // 120: unifiedfleet.api.v1.rpc.CreateCachingServiceRequest.cachingService:type_name -> unifiedfleet.api.v1.models.CachingService
-	195, // 121: unifiedfleet.api.v1.rpc.UpdateCachingServiceRequest.cachingService:type_name -> unifiedfleet.api.v1.models.CachingService
-	173, // 122: unifiedfleet.api.v1.rpc.UpdateCachingServiceRequest.update_mask:type_name -> google.protobuf.FieldMask
-	195, // 123: unifiedfleet.api.v1.rpc.ListCachingServicesResponse.cachingServices:type_name -> unifiedfleet.api.v1.models.CachingService
-	196, // 124: unifiedfleet.api.v1.rpc.CreateSchedulingUnitRequest.scheduling_unit:type_name -> unifiedfleet.api.v1.models.SchedulingUnit
-	196, // 125: unifiedfleet.api.v1.rpc.UpdateSchedulingUnitRequest.scheduling_unit:type_name -> unifiedfleet.api.v1.models.SchedulingUnit
-	173, // 126: unifiedfleet.api.v1.rpc.UpdateSchedulingUnitRequest.update_mask:type_name -> google.protobuf.FieldMask
-	196, // 127: unifiedfleet.api.v1.rpc.ListSchedulingUnitsResponse.scheduling_units:type_name -> unifiedfleet.api.v1.models.SchedulingUnit
-	173, // 128: unifiedfleet.api.v1.rpc.UpdateConfigBundleRequest.update_mask:type_name -> google.protobuf.FieldMask
-	120, // 129: unifiedfleet.api.v1.rpc.UpdateMachineLSERequest.NetworkOptionsEntry.value:type_name -> unifiedfleet.api.v1.rpc.NetworkOption
+	177, // 20: unifiedfleet.api.v1.rpc.ChromePlatformResult.platform:type_name -> unifiedfleet.api.v1.models.ChromePlatform
+	48,  // 21: unifiedfleet.api.v1.rpc.ImportOSVersionsRequest.machine_db_source:type_name -> unifiedfleet.api.v1.rpc.MachineDBSource
+	49,  // 22: unifiedfleet.api.v1.rpc.ImportOSVersionsRequest.config_source:type_name -> unifiedfleet.api.v1.rpc.ConfigSource
+	178, // 23: unifiedfleet.api.v1.rpc.ListOSVersionsResponse.os_version:type_name -> unifiedfleet.api.v1.models.OSVersion
+	179, // 24: unifiedfleet.api.v1.rpc.CreateMachineLSEPrototypeRequest.machineLSEPrototype:type_name -> unifiedfleet.api.v1.models.MachineLSEPrototype
+	179, // 25: unifiedfleet.api.v1.rpc.UpdateMachineLSEPrototypeRequest.machineLSEPrototype:type_name -> unifiedfleet.api.v1.models.MachineLSEPrototype
+	175, // 26: unifiedfleet.api.v1.rpc.UpdateMachineLSEPrototypeRequest.update_mask:type_name -> google.protobuf.FieldMask
+	179, // 27: unifiedfleet.api.v1.rpc.ListMachineLSEPrototypesResponse.machineLSEPrototypes:type_name -> unifiedfleet.api.v1.models.MachineLSEPrototype
+	180, // 28: unifiedfleet.api.v1.rpc.CreateRackLSEPrototypeRequest.rackLSEPrototype:type_name -> unifiedfleet.api.v1.models.RackLSEPrototype
+	180, // 29: unifiedfleet.api.v1.rpc.UpdateRackLSEPrototypeRequest.rackLSEPrototype:type_name -> unifiedfleet.api.v1.models.RackLSEPrototype
+	175, // 30: unifiedfleet.api.v1.rpc.UpdateRackLSEPrototypeRequest.update_mask:type_name -> google.protobuf.FieldMask
+	180, // 31: unifiedfleet.api.v1.rpc.ListRackLSEPrototypesResponse.rackLSEPrototypes:type_name -> unifiedfleet.api.v1.models.RackLSEPrototype
+	181, // 32: unifiedfleet.api.v1.rpc.MachineRegistrationRequest.machine:type_name -> unifiedfleet.api.v1.models.Machine
+	181, // 33: unifiedfleet.api.v1.rpc.UpdateMachineRequest.machine:type_name -> unifiedfleet.api.v1.models.Machine
+	175, // 34: unifiedfleet.api.v1.rpc.UpdateMachineRequest.update_mask:type_name -> google.protobuf.FieldMask
+	181, // 35: unifiedfleet.api.v1.rpc.ListMachinesResponse.machines:type_name -> unifiedfleet.api.v1.models.Machine
+	48,  // 36: unifiedfleet.api.v1.rpc.ImportMachinesRequest.machine_db_source:type_name -> unifiedfleet.api.v1.rpc.MachineDBSource
+	49,  // 37: unifiedfleet.api.v1.rpc.ImportMachinesRequest.config_source:type_name -> unifiedfleet.api.v1.rpc.ConfigSource
+	182, // 38: unifiedfleet.api.v1.rpc.CreateRackRequest.rack:type_name -> unifiedfleet.api.v1.models.Rack
+	182, // 39: unifiedfleet.api.v1.rpc.UpdateRackRequest.rack:type_name -> unifiedfleet.api.v1.models.Rack
+	175, // 40: unifiedfleet.api.v1.rpc.UpdateRackRequest.update_mask:type_name -> google.protobuf.FieldMask
+	182, // 41: unifiedfleet.api.v1.rpc.ListRacksResponse.racks:type_name -> unifiedfleet.api.v1.models.Rack
+	183, // 42: unifiedfleet.api.v1.rpc.CreateMachineLSERequest.machineLSE:type_name -> unifiedfleet.api.v1.models.MachineLSE
+	122, // 43: unifiedfleet.api.v1.rpc.CreateMachineLSERequest.network_option:type_name -> unifiedfleet.api.v1.rpc.NetworkOption
+	183, // 44: unifiedfleet.api.v1.rpc.UpdateMachineLSERequest.machineLSE:type_name -> unifiedfleet.api.v1.models.MachineLSE
+	175, // 45: unifiedfleet.api.v1.rpc.UpdateMachineLSERequest.update_mask:type_name -> google.protobuf.FieldMask
+	173, // 46: unifiedfleet.api.v1.rpc.UpdateMachineLSERequest.network_options:type_name -> unifiedfleet.api.v1.rpc.UpdateMachineLSERequest.NetworkOptionsEntry
+	183, // 47: unifiedfleet.api.v1.rpc.ListMachineLSEsResponse.machineLSEs:type_name -> unifiedfleet.api.v1.models.MachineLSE
+	48,  // 48: unifiedfleet.api.v1.rpc.ImportMachineLSEsRequest.machine_db_source:type_name -> unifiedfleet.api.v1.rpc.MachineDBSource
+	49,  // 49: unifiedfleet.api.v1.rpc.ImportMachineLSEsRequest.config_source:type_name -> unifiedfleet.api.v1.rpc.ConfigSource
+	48,  // 50: unifiedfleet.api.v1.rpc.ImportOSMachineLSEsRequest.machine_db_source:type_name -> unifiedfleet.api.v1.rpc.MachineDBSource
+	49,  // 51: unifiedfleet.api.v1.rpc.ImportOSMachineLSEsRequest.config_source:type_name -> unifiedfleet.api.v1.rpc.ConfigSource
+	184, // 52: unifiedfleet.api.v1.rpc.CreateRackLSERequest.rackLSE:type_name -> unifiedfleet.api.v1.models.RackLSE
+	184, // 53: unifiedfleet.api.v1.rpc.UpdateRackLSERequest.rackLSE:type_name -> unifiedfleet.api.v1.models.RackLSE
+	175, // 54: unifiedfleet.api.v1.rpc.UpdateRackLSERequest.update_mask:type_name -> google.protobuf.FieldMask
+	184, // 55: unifiedfleet.api.v1.rpc.ListRackLSEsResponse.rackLSEs:type_name -> unifiedfleet.api.v1.models.RackLSE
+	185, // 56: unifiedfleet.api.v1.rpc.CreateNicRequest.nic:type_name -> unifiedfleet.api.v1.models.Nic
+	185, // 57: unifiedfleet.api.v1.rpc.UpdateNicRequest.nic:type_name -> unifiedfleet.api.v1.models.Nic
+	175, // 58: unifiedfleet.api.v1.rpc.UpdateNicRequest.update_mask:type_name -> google.protobuf.FieldMask
+	185, // 59: unifiedfleet.api.v1.rpc.ListNicsResponse.nics:type_name -> unifiedfleet.api.v1.models.Nic
+	48,  // 60: unifiedfleet.api.v1.rpc.ImportNicsRequest.machine_db_source:type_name -> unifiedfleet.api.v1.rpc.MachineDBSource
+	49,  // 61: unifiedfleet.api.v1.rpc.ImportNicsRequest.config_source:type_name -> unifiedfleet.api.v1.rpc.ConfigSource
+	48,  // 62: unifiedfleet.api.v1.rpc.ImportDatacentersRequest.machine_db_source:type_name -> unifiedfleet.api.v1.rpc.MachineDBSource
+	49,  // 63: unifiedfleet.api.v1.rpc.ImportDatacentersRequest.config_source:type_name -> unifiedfleet.api.v1.rpc.ConfigSource
+	186, // 64: unifiedfleet.api.v1.rpc.CreateKVMRequest.KVM:type_name -> unifiedfleet.api.v1.models.KVM
+	186, // 65: unifiedfleet.api.v1.rpc.UpdateKVMRequest.KVM:type_name -> unifiedfleet.api.v1.models.KVM
+	175, // 66: unifiedfleet.api.v1.rpc.UpdateKVMRequest.update_mask:type_name -> google.protobuf.FieldMask
+	122, // 67: unifiedfleet.api.v1.rpc.UpdateKVMRequest.network_option:type_name -> unifiedfleet.api.v1.rpc.NetworkOption
+	186, // 68: unifiedfleet.api.v1.rpc.ListKVMsResponse.KVMs:type_name -> unifiedfleet.api.v1.models.KVM
+	187, // 69: unifiedfleet.api.v1.rpc.CreateRPMRequest.RPM:type_name -> unifiedfleet.api.v1.models.RPM
+	187, // 70: unifiedfleet.api.v1.rpc.UpdateRPMRequest.RPM:type_name -> unifiedfleet.api.v1.models.RPM
+	175, // 71: unifiedfleet.api.v1.rpc.UpdateRPMRequest.update_mask:type_name -> google.protobuf.FieldMask
+	122, // 72: unifiedfleet.api.v1.rpc.UpdateRPMRequest.network_option:type_name -> unifiedfleet.api.v1.rpc.NetworkOption
+	187, // 73: unifiedfleet.api.v1.rpc.ListRPMsResponse.RPMs:type_name -> unifiedfleet.api.v1.models.RPM
+	188, // 74: unifiedfleet.api.v1.rpc.CreateDracRequest.drac:type_name -> unifiedfleet.api.v1.models.Drac
+	122, // 75: unifiedfleet.api.v1.rpc.CreateDracRequest.network_option:type_name -> unifiedfleet.api.v1.rpc.NetworkOption
+	188, // 76: unifiedfleet.api.v1.rpc.UpdateDracRequest.drac:type_name -> unifiedfleet.api.v1.models.Drac
+	175, // 77: unifiedfleet.api.v1.rpc.UpdateDracRequest.update_mask:type_name -> google.protobuf.FieldMask
+	122, // 78: unifiedfleet.api.v1.rpc.UpdateDracRequest.network_option:type_name -> unifiedfleet.api.v1.rpc.NetworkOption
+	188, // 79: unifiedfleet.api.v1.rpc.ListDracsResponse.dracs:type_name -> unifiedfleet.api.v1.models.Drac
+	189, // 80: unifiedfleet.api.v1.rpc.CreateSwitchRequest.switch:type_name -> unifiedfleet.api.v1.models.Switch
+	189, // 81: unifiedfleet.api.v1.rpc.UpdateSwitchRequest.switch:type_name -> unifiedfleet.api.v1.models.Switch
+	175, // 82: unifiedfleet.api.v1.rpc.UpdateSwitchRequest.update_mask:type_name -> google.protobuf.FieldMask
+	189, // 83: unifiedfleet.api.v1.rpc.ListSwitchesResponse.switches:type_name -> unifiedfleet.api.v1.models.Switch
+	190, // 84: unifiedfleet.api.v1.rpc.CreateVlanRequest.vlan:type_name -> unifiedfleet.api.v1.models.Vlan
+	190, // 85: unifiedfleet.api.v1.rpc.UpdateVlanRequest.vlan:type_name -> unifiedfleet.api.v1.models.Vlan
+	175, // 86: unifiedfleet.api.v1.rpc.UpdateVlanRequest.update_mask:type_name -> google.protobuf.FieldMask
+	190, // 87: unifiedfleet.api.v1.rpc.ListVlansResponse.vlans:type_name -> unifiedfleet.api.v1.models.Vlan
+	48,  // 88: unifiedfleet.api.v1.rpc.ImportVlansRequest.machine_db_source:type_name -> unifiedfleet.api.v1.rpc.MachineDBSource
+	49,  // 89: unifiedfleet.api.v1.rpc.ImportVlansRequest.config_source:type_name -> unifiedfleet.api.v1.rpc.ConfigSource
+	48,  // 90: unifiedfleet.api.v1.rpc.ImportOSVlansRequest.machine_db_source:type_name -> unifiedfleet.api.v1.rpc.MachineDBSource
+	49,  // 91: unifiedfleet.api.v1.rpc.ImportOSVlansRequest.config_source:type_name -> unifiedfleet.api.v1.rpc.ConfigSource
+	48,  // 92: unifiedfleet.api.v1.rpc.ImportStatesRequest.machine_db_source:type_name -> unifiedfleet.api.v1.rpc.MachineDBSource
+	49,  // 93: unifiedfleet.api.v1.rpc.ImportStatesRequest.config_source:type_name -> unifiedfleet.api.v1.rpc.ConfigSource
+	191, // 94: unifiedfleet.api.v1.rpc.ListDutStatesResponse.dut_states:type_name -> unifiedfleet.api.v1.models.chromeos.lab.DutState
+	192, // 95: unifiedfleet.api.v1.rpc.UpdateStateRequest.state:type_name -> unifiedfleet.api.v1.models.StateRecord
+	175, // 96: unifiedfleet.api.v1.rpc.UpdateStateRequest.update_mask:type_name -> google.protobuf.FieldMask
+	191, // 97: unifiedfleet.api.v1.rpc.UpdateDutStateRequest.dut_state:type_name -> unifiedfleet.api.v1.models.chromeos.lab.DutState
+	175, // 98: unifiedfleet.api.v1.rpc.UpdateDutStateRequest.update_mask:type_name -> google.protobuf.FieldMask
+	193, // 99: unifiedfleet.api.v1.rpc.UpdateDutStateRequest.dut_meta:type_name -> unifiedfleet.api.v1.models.DutMeta
+	194, // 100: unifiedfleet.api.v1.rpc.UpdateDutStateRequest.lab_meta:type_name -> unifiedfleet.api.v1.models.LabMeta
+	182, // 101: unifiedfleet.api.v1.rpc.RackRegistrationRequest.rack:type_name -> unifiedfleet.api.v1.models.Rack
+	195, // 102: unifiedfleet.api.v1.rpc.CreateAssetRequest.asset:type_name -> unifiedfleet.api.v1.models.asset
+	195, // 103: unifiedfleet.api.v1.rpc.UpdateAssetRequest.asset:type_name -> unifiedfleet.api.v1.models.asset
+	175, // 104: unifiedfleet.api.v1.rpc.UpdateAssetRequest.update_mask:type_name -> google.protobuf.FieldMask
+	195, // 105: unifiedfleet.api.v1.rpc.ListAssetsResponse.assets:type_name -> unifiedfleet.api.v1.models.asset
+	186, // 106: unifiedfleet.api.v1.rpc.BatchGetKVMsResponse.KVMs:type_name -> unifiedfleet.api.v1.models.KVM
+	196, // 107: unifiedfleet.api.v1.rpc.BatchGetDHCPConfigsResponse.dhcp_configs:type_name -> unifiedfleet.api.v1.models.DHCPConfig
+	183, // 108: unifiedfleet.api.v1.rpc.BatchGetMachineLSEsResponse.machine_lses:type_name -> unifiedfleet.api.v1.models.MachineLSE
+	181, // 109: unifiedfleet.api.v1.rpc.BatchGetMachinesResponse.machines:type_name -> unifiedfleet.api.v1.models.Machine
+	189, // 110: unifiedfleet.api.v1.rpc.BatchGetSwitchesResponse.switches:type_name -> unifiedfleet.api.v1.models.Switch
+	187, // 111: unifiedfleet.api.v1.rpc.BatchGetRPMsResponse.rpms:type_name -> unifiedfleet.api.v1.models.RPM
+	188, // 112: unifiedfleet.api.v1.rpc.BatchGetDracsResponse.dracs:type_name -> unifiedfleet.api.v1.models.Drac
+	185, // 113: unifiedfleet.api.v1.rpc.BatchGetNicsResponse.nics:type_name -> unifiedfleet.api.v1.models.Nic
+	176, // 114: unifiedfleet.api.v1.rpc.BatchGetVMsResponse.vms:type_name -> unifiedfleet.api.v1.models.VM
+	190, // 115: unifiedfleet.api.v1.rpc.BatchGetVlansResponse.vlans:type_name -> unifiedfleet.api.v1.models.Vlan
+	182, // 116: unifiedfleet.api.v1.rpc.BatchGetRacksResponse.racks:type_name -> unifiedfleet.api.v1.models.Rack
+	177, // 117: unifiedfleet.api.v1.rpc.BatchGetChromePlatformsResponse.chrome_platforms:type_name -> unifiedfleet.api.v1.models.ChromePlatform
+	179, // 118: unifiedfleet.api.v1.rpc.BatchGetMachineLSEPrototypesResponse.machine_lse_prototypes:type_name -> unifiedfleet.api.v1.models.MachineLSEPrototype
+	180, // 119: unifiedfleet.api.v1.rpc.BatchGetRackLSEPrototypesResponse.rack_lse_prototypes:type_name -> unifiedfleet.api.v1.models.RackLSEPrototype
+	197, // 120: unifiedfleet.api.v1.rpc.CreateCachingServiceRequest.cachingService:type_name -> unifiedfleet.api.v1.models.CachingService
+	197, // 121: unifiedfleet.api.v1.rpc.UpdateCachingServiceRequest.cachingService:type_name -> unifiedfleet.api.v1.models.CachingService
+	175, // 122: unifiedfleet.api.v1.rpc.UpdateCachingServiceRequest.update_mask:type_name -> google.protobuf.FieldMask
+	197, // 123: unifiedfleet.api.v1.rpc.ListCachingServicesResponse.cachingServices:type_name -> unifiedfleet.api.v1.models.CachingService
+	198, // 124: unifiedfleet.api.v1.rpc.CreateSchedulingUnitRequest.scheduling_unit:type_name -> unifiedfleet.api.v1.models.SchedulingUnit
+	198, // 125: unifiedfleet.api.v1.rpc.UpdateSchedulingUnitRequest.scheduling_unit:type_name -> unifiedfleet.api.v1.models.SchedulingUnit
+	175, // 126: unifiedfleet.api.v1.rpc.UpdateSchedulingUnitRequest.update_mask:type_name -> google.protobuf.FieldMask
+	198, // 127: unifiedfleet.api.v1.rpc.ListSchedulingUnitsResponse.scheduling_units:type_name -> unifiedfleet.api.v1.models.SchedulingUnit
+	175, // 128: unifiedfleet.api.v1.rpc.UpdateConfigBundleRequest.update_mask:type_name -> google.protobuf.FieldMask
+	122, // 129: unifiedfleet.api.v1.rpc.UpdateMachineLSERequest.NetworkOptionsEntry.value:type_name -> unifiedfleet.api.v1.rpc.NetworkOption
 	15,  // 130: unifiedfleet.api.v1.rpc.Fleet.CreateChromePlatform:input_type -> unifiedfleet.api.v1.rpc.CreateChromePlatformRequest
 	16,  // 131: unifiedfleet.api.v1.rpc.Fleet.UpdateChromePlatform:input_type -> unifiedfleet.api.v1.rpc.UpdateChromePlatformRequest
 	17,  // 132: unifiedfleet.api.v1.rpc.Fleet.GetChromePlatform:input_type -> unifiedfleet.api.v1.rpc.GetChromePlatformRequest
@@ -12956,240 +13131,244 @@ var file_infra_unifiedfleet_api_v1_rpc_fleet_proto_depIdxs = []int32{
 	42,  // 151: unifiedfleet.api.v1.rpc.Fleet.ListMachines:input_type -> unifiedfleet.api.v1.rpc.ListMachinesRequest
 	44,  // 152: unifiedfleet.api.v1.rpc.Fleet.DeleteMachine:input_type -> unifiedfleet.api.v1.rpc.DeleteMachineRequest
 	45,  // 153: unifiedfleet.api.v1.rpc.Fleet.ImportMachines:input_type -> unifiedfleet.api.v1.rpc.ImportMachinesRequest
-	46,  // 154: unifiedfleet.api.v1.rpc.Fleet.RenameMachine:input_type -> unifiedfleet.api.v1.rpc.RenameMachineRequest
-	119, // 155: unifiedfleet.api.v1.rpc.Fleet.RackRegistration:input_type -> unifiedfleet.api.v1.rpc.RackRegistrationRequest
-	50,  // 156: unifiedfleet.api.v1.rpc.Fleet.UpdateRack:input_type -> unifiedfleet.api.v1.rpc.UpdateRackRequest
-	51,  // 157: unifiedfleet.api.v1.rpc.Fleet.GetRack:input_type -> unifiedfleet.api.v1.rpc.GetRackRequest
-	52,  // 158: unifiedfleet.api.v1.rpc.Fleet.ListRacks:input_type -> unifiedfleet.api.v1.rpc.ListRacksRequest
-	54,  // 159: unifiedfleet.api.v1.rpc.Fleet.DeleteRack:input_type -> unifiedfleet.api.v1.rpc.DeleteRackRequest
-	55,  // 160: unifiedfleet.api.v1.rpc.Fleet.CreateMachineLSE:input_type -> unifiedfleet.api.v1.rpc.CreateMachineLSERequest
-	56,  // 161: unifiedfleet.api.v1.rpc.Fleet.UpdateMachineLSE:input_type -> unifiedfleet.api.v1.rpc.UpdateMachineLSERequest
-	57,  // 162: unifiedfleet.api.v1.rpc.Fleet.GetMachineLSE:input_type -> unifiedfleet.api.v1.rpc.GetMachineLSERequest
-	58,  // 163: unifiedfleet.api.v1.rpc.Fleet.ListMachineLSEs:input_type -> unifiedfleet.api.v1.rpc.ListMachineLSEsRequest
-	60,  // 164: unifiedfleet.api.v1.rpc.Fleet.DeleteMachineLSE:input_type -> unifiedfleet.api.v1.rpc.DeleteMachineLSERequest
-	61,  // 165: unifiedfleet.api.v1.rpc.Fleet.RenameMachineLSE:input_type -> unifiedfleet.api.v1.rpc.RenameMachineLSERequest
-	62,  // 166: unifiedfleet.api.v1.rpc.Fleet.ImportMachineLSEs:input_type -> unifiedfleet.api.v1.rpc.ImportMachineLSEsRequest
-	63,  // 167: unifiedfleet.api.v1.rpc.Fleet.ImportOSMachineLSEs:input_type -> unifiedfleet.api.v1.rpc.ImportOSMachineLSEsRequest
-	64,  // 168: unifiedfleet.api.v1.rpc.Fleet.CreateRackLSE:input_type -> unifiedfleet.api.v1.rpc.CreateRackLSERequest
-	65,  // 169: unifiedfleet.api.v1.rpc.Fleet.UpdateRackLSE:input_type -> unifiedfleet.api.v1.rpc.UpdateRackLSERequest
-	66,  // 170: unifiedfleet.api.v1.rpc.Fleet.GetRackLSE:input_type -> unifiedfleet.api.v1.rpc.GetRackLSERequest
-	67,  // 171: unifiedfleet.api.v1.rpc.Fleet.ListRackLSEs:input_type -> unifiedfleet.api.v1.rpc.ListRackLSEsRequest
-	69,  // 172: unifiedfleet.api.v1.rpc.Fleet.DeleteRackLSE:input_type -> unifiedfleet.api.v1.rpc.DeleteRackLSERequest
-	70,  // 173: unifiedfleet.api.v1.rpc.Fleet.CreateNic:input_type -> unifiedfleet.api.v1.rpc.CreateNicRequest
-	71,  // 174: unifiedfleet.api.v1.rpc.Fleet.UpdateNic:input_type -> unifiedfleet.api.v1.rpc.UpdateNicRequest
-	72,  // 175: unifiedfleet.api.v1.rpc.Fleet.GetNic:input_type -> unifiedfleet.api.v1.rpc.GetNicRequest
-	73,  // 176: unifiedfleet.api.v1.rpc.Fleet.ListNics:input_type -> unifiedfleet.api.v1.rpc.ListNicsRequest
-	75,  // 177: unifiedfleet.api.v1.rpc.Fleet.DeleteNic:input_type -> unifiedfleet.api.v1.rpc.DeleteNicRequest
-	76,  // 178: unifiedfleet.api.v1.rpc.Fleet.ImportNics:input_type -> unifiedfleet.api.v1.rpc.ImportNicsRequest
-	77,  // 179: unifiedfleet.api.v1.rpc.Fleet.RenameNic:input_type -> unifiedfleet.api.v1.rpc.RenameNicRequest
-	79,  // 180: unifiedfleet.api.v1.rpc.Fleet.ImportDatacenters:input_type -> unifiedfleet.api.v1.rpc.ImportDatacentersRequest
-	80,  // 181: unifiedfleet.api.v1.rpc.Fleet.CreateKVM:input_type -> unifiedfleet.api.v1.rpc.CreateKVMRequest
-	81,  // 182: unifiedfleet.api.v1.rpc.Fleet.UpdateKVM:input_type -> unifiedfleet.api.v1.rpc.UpdateKVMRequest
-	82,  // 183: unifiedfleet.api.v1.rpc.Fleet.GetKVM:input_type -> unifiedfleet.api.v1.rpc.GetKVMRequest
-	83,  // 184: unifiedfleet.api.v1.rpc.Fleet.ListKVMs:input_type -> unifiedfleet.api.v1.rpc.ListKVMsRequest
-	85,  // 185: unifiedfleet.api.v1.rpc.Fleet.DeleteKVM:input_type -> unifiedfleet.api.v1.rpc.DeleteKVMRequest
-	86,  // 186: unifiedfleet.api.v1.rpc.Fleet.CreateRPM:input_type -> unifiedfleet.api.v1.rpc.CreateRPMRequest
-	87,  // 187: unifiedfleet.api.v1.rpc.Fleet.UpdateRPM:input_type -> unifiedfleet.api.v1.rpc.UpdateRPMRequest
-	88,  // 188: unifiedfleet.api.v1.rpc.Fleet.GetRPM:input_type -> unifiedfleet.api.v1.rpc.GetRPMRequest
-	89,  // 189: unifiedfleet.api.v1.rpc.Fleet.ListRPMs:input_type -> unifiedfleet.api.v1.rpc.ListRPMsRequest
-	91,  // 190: unifiedfleet.api.v1.rpc.Fleet.DeleteRPM:input_type -> unifiedfleet.api.v1.rpc.DeleteRPMRequest
-	92,  // 191: unifiedfleet.api.v1.rpc.Fleet.CreateDrac:input_type -> unifiedfleet.api.v1.rpc.CreateDracRequest
-	93,  // 192: unifiedfleet.api.v1.rpc.Fleet.UpdateDrac:input_type -> unifiedfleet.api.v1.rpc.UpdateDracRequest
-	94,  // 193: unifiedfleet.api.v1.rpc.Fleet.GetDrac:input_type -> unifiedfleet.api.v1.rpc.GetDracRequest
-	95,  // 194: unifiedfleet.api.v1.rpc.Fleet.ListDracs:input_type -> unifiedfleet.api.v1.rpc.ListDracsRequest
-	97,  // 195: unifiedfleet.api.v1.rpc.Fleet.DeleteDrac:input_type -> unifiedfleet.api.v1.rpc.DeleteDracRequest
-	98,  // 196: unifiedfleet.api.v1.rpc.Fleet.CreateSwitch:input_type -> unifiedfleet.api.v1.rpc.CreateSwitchRequest
-	99,  // 197: unifiedfleet.api.v1.rpc.Fleet.UpdateSwitch:input_type -> unifiedfleet.api.v1.rpc.UpdateSwitchRequest
-	100, // 198: unifiedfleet.api.v1.rpc.Fleet.GetSwitch:input_type -> unifiedfleet.api.v1.rpc.GetSwitchRequest
-	101, // 199: unifiedfleet.api.v1.rpc.Fleet.ListSwitches:input_type -> unifiedfleet.api.v1.rpc.ListSwitchesRequest
-	103, // 200: unifiedfleet.api.v1.rpc.Fleet.DeleteSwitch:input_type -> unifiedfleet.api.v1.rpc.DeleteSwitchRequest
-	78,  // 201: unifiedfleet.api.v1.rpc.Fleet.RenameSwitch:input_type -> unifiedfleet.api.v1.rpc.RenameSwitchRequest
-	104, // 202: unifiedfleet.api.v1.rpc.Fleet.CreateVlan:input_type -> unifiedfleet.api.v1.rpc.CreateVlanRequest
-	105, // 203: unifiedfleet.api.v1.rpc.Fleet.UpdateVlan:input_type -> unifiedfleet.api.v1.rpc.UpdateVlanRequest
-	106, // 204: unifiedfleet.api.v1.rpc.Fleet.GetVlan:input_type -> unifiedfleet.api.v1.rpc.GetVlanRequest
-	107, // 205: unifiedfleet.api.v1.rpc.Fleet.ListVlans:input_type -> unifiedfleet.api.v1.rpc.ListVlansRequest
-	109, // 206: unifiedfleet.api.v1.rpc.Fleet.DeleteVlan:input_type -> unifiedfleet.api.v1.rpc.DeleteVlanRequest
-	110, // 207: unifiedfleet.api.v1.rpc.Fleet.ImportVlans:input_type -> unifiedfleet.api.v1.rpc.ImportVlansRequest
-	111, // 208: unifiedfleet.api.v1.rpc.Fleet.ImportOSVlans:input_type -> unifiedfleet.api.v1.rpc.ImportOSVlansRequest
-	112, // 209: unifiedfleet.api.v1.rpc.Fleet.ImportStates:input_type -> unifiedfleet.api.v1.rpc.ImportStatesRequest
-	117, // 210: unifiedfleet.api.v1.rpc.Fleet.UpdateState:input_type -> unifiedfleet.api.v1.rpc.UpdateStateRequest
-	113, // 211: unifiedfleet.api.v1.rpc.Fleet.GetState:input_type -> unifiedfleet.api.v1.rpc.GetStateRequest
-	114, // 212: unifiedfleet.api.v1.rpc.Fleet.GetDutState:input_type -> unifiedfleet.api.v1.rpc.GetDutStateRequest
-	115, // 213: unifiedfleet.api.v1.rpc.Fleet.ListDutStates:input_type -> unifiedfleet.api.v1.rpc.ListDutStatesRequest
-	118, // 214: unifiedfleet.api.v1.rpc.Fleet.UpdateDutState:input_type -> unifiedfleet.api.v1.rpc.UpdateDutStateRequest
-	14,  // 215: unifiedfleet.api.v1.rpc.Fleet.GetDHCPConfig:input_type -> unifiedfleet.api.v1.rpc.GetDHCPConfigRequest
-	8,   // 216: unifiedfleet.api.v1.rpc.Fleet.CreateVM:input_type -> unifiedfleet.api.v1.rpc.CreateVMRequest
-	9,   // 217: unifiedfleet.api.v1.rpc.Fleet.UpdateVM:input_type -> unifiedfleet.api.v1.rpc.UpdateVMRequest
-	11,  // 218: unifiedfleet.api.v1.rpc.Fleet.DeleteVM:input_type -> unifiedfleet.api.v1.rpc.DeleteVMRequest
-	10,  // 219: unifiedfleet.api.v1.rpc.Fleet.GetVM:input_type -> unifiedfleet.api.v1.rpc.GetVMRequest
-	12,  // 220: unifiedfleet.api.v1.rpc.Fleet.ListVMs:input_type -> unifiedfleet.api.v1.rpc.ListVMsRequest
-	121, // 221: unifiedfleet.api.v1.rpc.Fleet.CreateAsset:input_type -> unifiedfleet.api.v1.rpc.CreateAssetRequest
-	122, // 222: unifiedfleet.api.v1.rpc.Fleet.UpdateAsset:input_type -> unifiedfleet.api.v1.rpc.UpdateAssetRequest
-	123, // 223: unifiedfleet.api.v1.rpc.Fleet.GetAsset:input_type -> unifiedfleet.api.v1.rpc.GetAssetRequest
-	124, // 224: unifiedfleet.api.v1.rpc.Fleet.ListAssets:input_type -> unifiedfleet.api.v1.rpc.ListAssetsRequest
-	126, // 225: unifiedfleet.api.v1.rpc.Fleet.DeleteAsset:input_type -> unifiedfleet.api.v1.rpc.DeleteAssetRequest
-	127, // 226: unifiedfleet.api.v1.rpc.Fleet.RenameAsset:input_type -> unifiedfleet.api.v1.rpc.RenameAssetRequest
-	128, // 227: unifiedfleet.api.v1.rpc.Fleet.BatchGetKVMs:input_type -> unifiedfleet.api.v1.rpc.BatchGetKVMsRequest
-	130, // 228: unifiedfleet.api.v1.rpc.Fleet.BatchGetDHCPConfigs:input_type -> unifiedfleet.api.v1.rpc.BatchGetDHCPConfigsRequest
-	132, // 229: unifiedfleet.api.v1.rpc.Fleet.BatchGetMachineLSEs:input_type -> unifiedfleet.api.v1.rpc.BatchGetMachineLSEsRequest
-	134, // 230: unifiedfleet.api.v1.rpc.Fleet.BatchGetMachines:input_type -> unifiedfleet.api.v1.rpc.BatchGetMachinesRequest
-	136, // 231: unifiedfleet.api.v1.rpc.Fleet.BatchGetSwitches:input_type -> unifiedfleet.api.v1.rpc.BatchGetSwitchesRequest
-	138, // 232: unifiedfleet.api.v1.rpc.Fleet.BatchGetRPMs:input_type -> unifiedfleet.api.v1.rpc.BatchGetRPMsRequest
-	140, // 233: unifiedfleet.api.v1.rpc.Fleet.BatchGetDracs:input_type -> unifiedfleet.api.v1.rpc.BatchGetDracsRequest
-	142, // 234: unifiedfleet.api.v1.rpc.Fleet.BatchGetNics:input_type -> unifiedfleet.api.v1.rpc.BatchGetNicsRequest
-	144, // 235: unifiedfleet.api.v1.rpc.Fleet.BatchGetVMs:input_type -> unifiedfleet.api.v1.rpc.BatchGetVMsRequest
-	146, // 236: unifiedfleet.api.v1.rpc.Fleet.BatchGetVlans:input_type -> unifiedfleet.api.v1.rpc.BatchGetVlansRequest
-	148, // 237: unifiedfleet.api.v1.rpc.Fleet.BatchGetRacks:input_type -> unifiedfleet.api.v1.rpc.BatchGetRacksRequest
-	150, // 238: unifiedfleet.api.v1.rpc.Fleet.BatchGetChromePlatforms:input_type -> unifiedfleet.api.v1.rpc.BatchGetChromePlatformsRequest
-	152, // 239: unifiedfleet.api.v1.rpc.Fleet.BatchGetMachineLSEPrototypes:input_type -> unifiedfleet.api.v1.rpc.BatchGetMachineLSEPrototypesRequest
-	154, // 240: unifiedfleet.api.v1.rpc.Fleet.BatchGetRackLSEPrototypes:input_type -> unifiedfleet.api.v1.rpc.BatchGetRackLSEPrototypesRequest
-	156, // 241: unifiedfleet.api.v1.rpc.Fleet.GetChromeOSDeviceData:input_type -> unifiedfleet.api.v1.rpc.GetChromeOSDeviceDataRequest
-	157, // 242: unifiedfleet.api.v1.rpc.Fleet.CreateCachingService:input_type -> unifiedfleet.api.v1.rpc.CreateCachingServiceRequest
-	158, // 243: unifiedfleet.api.v1.rpc.Fleet.UpdateCachingService:input_type -> unifiedfleet.api.v1.rpc.UpdateCachingServiceRequest
-	159, // 244: unifiedfleet.api.v1.rpc.Fleet.GetCachingService:input_type -> unifiedfleet.api.v1.rpc.GetCachingServiceRequest
-	160, // 245: unifiedfleet.api.v1.rpc.Fleet.ListCachingServices:input_type -> unifiedfleet.api.v1.rpc.ListCachingServicesRequest
-	162, // 246: unifiedfleet.api.v1.rpc.Fleet.DeleteCachingService:input_type -> unifiedfleet.api.v1.rpc.DeleteCachingServiceRequest
-	0,   // 247: unifiedfleet.api.v1.rpc.Fleet.UpdateMachineLSEDeployment:input_type -> unifiedfleet.api.v1.rpc.UpdateMachineLSEDeploymentRequest
-	1,   // 248: unifiedfleet.api.v1.rpc.Fleet.BatchUpdateMachineLSEDeployment:input_type -> unifiedfleet.api.v1.rpc.BatchUpdateMachineLSEDeploymentRequest
-	3,   // 249: unifiedfleet.api.v1.rpc.Fleet.GetMachineLSEDeployment:input_type -> unifiedfleet.api.v1.rpc.GetMachineLSEDeploymentRequest
-	4,   // 250: unifiedfleet.api.v1.rpc.Fleet.BatchGetMachineLSEDeployments:input_type -> unifiedfleet.api.v1.rpc.BatchGetMachineLSEDeploymentsRequest
-	6,   // 251: unifiedfleet.api.v1.rpc.Fleet.ListMachineLSEDeployments:input_type -> unifiedfleet.api.v1.rpc.ListMachineLSEDeploymentsRequest
-	163, // 252: unifiedfleet.api.v1.rpc.Fleet.CreateSchedulingUnit:input_type -> unifiedfleet.api.v1.rpc.CreateSchedulingUnitRequest
-	164, // 253: unifiedfleet.api.v1.rpc.Fleet.UpdateSchedulingUnit:input_type -> unifiedfleet.api.v1.rpc.UpdateSchedulingUnitRequest
-	165, // 254: unifiedfleet.api.v1.rpc.Fleet.GetSchedulingUnit:input_type -> unifiedfleet.api.v1.rpc.GetSchedulingUnitRequest
-	166, // 255: unifiedfleet.api.v1.rpc.Fleet.ListSchedulingUnits:input_type -> unifiedfleet.api.v1.rpc.ListSchedulingUnitsRequest
-	168, // 256: unifiedfleet.api.v1.rpc.Fleet.DeleteSchedulingUnit:input_type -> unifiedfleet.api.v1.rpc.DeleteSchedulingUnitRequest
-	169, // 257: unifiedfleet.api.v1.rpc.Fleet.UpdateConfigBundle:input_type -> unifiedfleet.api.v1.rpc.UpdateConfigBundleRequest
-	175, // 258: unifiedfleet.api.v1.rpc.Fleet.CreateChromePlatform:output_type -> unifiedfleet.api.v1.models.ChromePlatform
-	175, // 259: unifiedfleet.api.v1.rpc.Fleet.UpdateChromePlatform:output_type -> unifiedfleet.api.v1.models.ChromePlatform
-	175, // 260: unifiedfleet.api.v1.rpc.Fleet.GetChromePlatform:output_type -> unifiedfleet.api.v1.models.ChromePlatform
-	19,  // 261: unifiedfleet.api.v1.rpc.Fleet.ListChromePlatforms:output_type -> unifiedfleet.api.v1.rpc.ListChromePlatformsResponse
-	197, // 262: unifiedfleet.api.v1.rpc.Fleet.DeleteChromePlatform:output_type -> google.protobuf.Empty
-	198, // 263: unifiedfleet.api.v1.rpc.Fleet.ImportChromePlatforms:output_type -> google.rpc.Status
-	26,  // 264: unifiedfleet.api.v1.rpc.Fleet.ListOSVersions:output_type -> unifiedfleet.api.v1.rpc.ListOSVersionsResponse
-	198, // 265: unifiedfleet.api.v1.rpc.Fleet.ImportOSVersions:output_type -> google.rpc.Status
-	177, // 266: unifiedfleet.api.v1.rpc.Fleet.CreateMachineLSEPrototype:output_type -> unifiedfleet.api.v1.models.MachineLSEPrototype
-	177, // 267: unifiedfleet.api.v1.rpc.Fleet.UpdateMachineLSEPrototype:output_type -> unifiedfleet.api.v1.models.MachineLSEPrototype
-	177, // 268: unifiedfleet.api.v1.rpc.Fleet.GetMachineLSEPrototype:output_type -> unifiedfleet.api.v1.models.MachineLSEPrototype
-	31,  // 269: unifiedfleet.api.v1.rpc.Fleet.ListMachineLSEPrototypes:output_type -> unifiedfleet.api.v1.rpc.ListMachineLSEPrototypesResponse
-	197, // 270: unifiedfleet.api.v1.rpc.Fleet.DeleteMachineLSEPrototype:output_type -> google.protobuf.Empty
-	178, // 271: unifiedfleet.api.v1.rpc.Fleet.CreateRackLSEPrototype:output_type -> unifiedfleet.api.v1.models.RackLSEPrototype
-	178, // 272: unifiedfleet.api.v1.rpc.Fleet.UpdateRackLSEPrototype:output_type -> unifiedfleet.api.v1.models.RackLSEPrototype
-	178, // 273: unifiedfleet.api.v1.rpc.Fleet.GetRackLSEPrototype:output_type -> unifiedfleet.api.v1.models.RackLSEPrototype
-	37,  // 274: unifiedfleet.api.v1.rpc.Fleet.ListRackLSEPrototypes:output_type -> unifiedfleet.api.v1.rpc.ListRackLSEPrototypesResponse
-	197, // 275: unifiedfleet.api.v1.rpc.Fleet.DeleteRackLSEPrototype:output_type -> google.protobuf.Empty
-	179, // 276: unifiedfleet.api.v1.rpc.Fleet.MachineRegistration:output_type -> unifiedfleet.api.v1.models.Machine
-	179, // 277: unifiedfleet.api.v1.rpc.Fleet.UpdateMachine:output_type -> unifiedfleet.api.v1.models.Machine
-	179, // 278: unifiedfleet.api.v1.rpc.Fleet.GetMachine:output_type -> unifiedfleet.api.v1.models.Machine
-	43,  // 279: unifiedfleet.api.v1.rpc.Fleet.ListMachines:output_type -> unifiedfleet.api.v1.rpc.ListMachinesResponse
-	197, // 280: unifiedfleet.api.v1.rpc.Fleet.DeleteMachine:output_type -> google.protobuf.Empty
-	198, // 281: unifiedfleet.api.v1.rpc.Fleet.ImportMachines:output_type -> google.rpc.Status
-	179, // 282: unifiedfleet.api.v1.rpc.Fleet.RenameMachine:output_type -> unifiedfleet.api.v1.models.Machine
-	180, // 283: unifiedfleet.api.v1.rpc.Fleet.RackRegistration:output_type -> unifiedfleet.api.v1.models.Rack
-	180, // 284: unifiedfleet.api.v1.rpc.Fleet.UpdateRack:output_type -> unifiedfleet.api.v1.models.Rack
-	180, // 285: unifiedfleet.api.v1.rpc.Fleet.GetRack:output_type -> unifiedfleet.api.v1.models.Rack
-	53,  // 286: unifiedfleet.api.v1.rpc.Fleet.ListRacks:output_type -> unifiedfleet.api.v1.rpc.ListRacksResponse
-	197, // 287: unifiedfleet.api.v1.rpc.Fleet.DeleteRack:output_type -> google.protobuf.Empty
-	181, // 288: unifiedfleet.api.v1.rpc.Fleet.CreateMachineLSE:output_type -> unifiedfleet.api.v1.models.MachineLSE
-	181, // 289: unifiedfleet.api.v1.rpc.Fleet.UpdateMachineLSE:output_type -> unifiedfleet.api.v1.models.MachineLSE
-	181, // 290: unifiedfleet.api.v1.rpc.Fleet.GetMachineLSE:output_type -> unifiedfleet.api.v1.models.MachineLSE
-	59,  // 291: unifiedfleet.api.v1.rpc.Fleet.ListMachineLSEs:output_type -> unifiedfleet.api.v1.rpc.ListMachineLSEsResponse
-	197, // 292: unifiedfleet.api.v1.rpc.Fleet.DeleteMachineLSE:output_type -> google.protobuf.Empty
-	181, // 293: unifiedfleet.api.v1.rpc.Fleet.RenameMachineLSE:output_type -> unifiedfleet.api.v1.models.MachineLSE
-	198, // 294: unifiedfleet.api.v1.rpc.Fleet.ImportMachineLSEs:output_type -> google.rpc.Status
-	198, // 295: unifiedfleet.api.v1.rpc.Fleet.ImportOSMachineLSEs:output_type -> google.rpc.Status
-	182, // 296: unifiedfleet.api.v1.rpc.Fleet.CreateRackLSE:output_type -> unifiedfleet.api.v1.models.RackLSE
-	182, // 297: unifiedfleet.api.v1.rpc.Fleet.UpdateRackLSE:output_type -> unifiedfleet.api.v1.models.RackLSE
-	182, // 298: unifiedfleet.api.v1.rpc.Fleet.GetRackLSE:output_type -> unifiedfleet.api.v1.models.RackLSE
-	68,  // 299: unifiedfleet.api.v1.rpc.Fleet.ListRackLSEs:output_type -> unifiedfleet.api.v1.rpc.ListRackLSEsResponse
-	197, // 300: unifiedfleet.api.v1.rpc.Fleet.DeleteRackLSE:output_type -> google.protobuf.Empty
-	183, // 301: unifiedfleet.api.v1.rpc.Fleet.CreateNic:output_type -> unifiedfleet.api.v1.models.Nic
-	183, // 302: unifiedfleet.api.v1.rpc.Fleet.UpdateNic:output_type -> unifiedfleet.api.v1.models.Nic
-	183, // 303: unifiedfleet.api.v1.rpc.Fleet.GetNic:output_type -> unifiedfleet.api.v1.models.Nic
-	74,  // 304: unifiedfleet.api.v1.rpc.Fleet.ListNics:output_type -> unifiedfleet.api.v1.rpc.ListNicsResponse
-	197, // 305: unifiedfleet.api.v1.rpc.Fleet.DeleteNic:output_type -> google.protobuf.Empty
-	198, // 306: unifiedfleet.api.v1.rpc.Fleet.ImportNics:output_type -> google.rpc.Status
-	183, // 307: unifiedfleet.api.v1.rpc.Fleet.RenameNic:output_type -> unifiedfleet.api.v1.models.Nic
-	198, // 308: unifiedfleet.api.v1.rpc.Fleet.ImportDatacenters:output_type -> google.rpc.Status
-	184, // 309: unifiedfleet.api.v1.rpc.Fleet.CreateKVM:output_type -> unifiedfleet.api.v1.models.KVM
-	184, // 310: unifiedfleet.api.v1.rpc.Fleet.UpdateKVM:output_type -> unifiedfleet.api.v1.models.KVM
-	184, // 311: unifiedfleet.api.v1.rpc.Fleet.GetKVM:output_type -> unifiedfleet.api.v1.models.KVM
-	84,  // 312: unifiedfleet.api.v1.rpc.Fleet.ListKVMs:output_type -> unifiedfleet.api.v1.rpc.ListKVMsResponse
-	197, // 313: unifiedfleet.api.v1.rpc.Fleet.DeleteKVM:output_type -> google.protobuf.Empty
-	185, // 314: unifiedfleet.api.v1.rpc.Fleet.CreateRPM:output_type -> unifiedfleet.api.v1.models.RPM
-	185, // 315: unifiedfleet.api.v1.rpc.Fleet.UpdateRPM:output_type -> unifiedfleet.api.v1.models.RPM
-	185, // 316: unifiedfleet.api.v1.rpc.Fleet.GetRPM:output_type -> unifiedfleet.api.v1.models.RPM
-	90,  // 317: unifiedfleet.api.v1.rpc.Fleet.ListRPMs:output_type -> unifiedfleet.api.v1.rpc.ListRPMsResponse
-	197, // 318: unifiedfleet.api.v1.rpc.Fleet.DeleteRPM:output_type -> google.protobuf.Empty
-	186, // 319: unifiedfleet.api.v1.rpc.Fleet.CreateDrac:output_type -> unifiedfleet.api.v1.models.Drac
-	186, // 320: unifiedfleet.api.v1.rpc.Fleet.UpdateDrac:output_type -> unifiedfleet.api.v1.models.Drac
-	186, // 321: unifiedfleet.api.v1.rpc.Fleet.GetDrac:output_type -> unifiedfleet.api.v1.models.Drac
-	96,  // 322: unifiedfleet.api.v1.rpc.Fleet.ListDracs:output_type -> unifiedfleet.api.v1.rpc.ListDracsResponse
-	197, // 323: unifiedfleet.api.v1.rpc.Fleet.DeleteDrac:output_type -> google.protobuf.Empty
-	187, // 324: unifiedfleet.api.v1.rpc.Fleet.CreateSwitch:output_type -> unifiedfleet.api.v1.models.Switch
-	187, // 325: unifiedfleet.api.v1.rpc.Fleet.UpdateSwitch:output_type -> unifiedfleet.api.v1.models.Switch
-	187, // 326: unifiedfleet.api.v1.rpc.Fleet.GetSwitch:output_type -> unifiedfleet.api.v1.models.Switch
-	102, // 327: unifiedfleet.api.v1.rpc.Fleet.ListSwitches:output_type -> unifiedfleet.api.v1.rpc.ListSwitchesResponse
-	197, // 328: unifiedfleet.api.v1.rpc.Fleet.DeleteSwitch:output_type -> google.protobuf.Empty
-	187, // 329: unifiedfleet.api.v1.rpc.Fleet.RenameSwitch:output_type -> unifiedfleet.api.v1.models.Switch
-	188, // 330: unifiedfleet.api.v1.rpc.Fleet.CreateVlan:output_type -> unifiedfleet.api.v1.models.Vlan
-	188, // 331: unifiedfleet.api.v1.rpc.Fleet.UpdateVlan:output_type -> unifiedfleet.api.v1.models.Vlan
-	188, // 332: unifiedfleet.api.v1.rpc.Fleet.GetVlan:output_type -> unifiedfleet.api.v1.models.Vlan
-	108, // 333: unifiedfleet.api.v1.rpc.Fleet.ListVlans:output_type -> unifiedfleet.api.v1.rpc.ListVlansResponse
-	197, // 334: unifiedfleet.api.v1.rpc.Fleet.DeleteVlan:output_type -> google.protobuf.Empty
-	198, // 335: unifiedfleet.api.v1.rpc.Fleet.ImportVlans:output_type -> google.rpc.Status
-	198, // 336: unifiedfleet.api.v1.rpc.Fleet.ImportOSVlans:output_type -> google.rpc.Status
-	198, // 337: unifiedfleet.api.v1.rpc.Fleet.ImportStates:output_type -> google.rpc.Status
-	190, // 338: unifiedfleet.api.v1.rpc.Fleet.UpdateState:output_type -> unifiedfleet.api.v1.models.StateRecord
-	190, // 339: unifiedfleet.api.v1.rpc.Fleet.GetState:output_type -> unifiedfleet.api.v1.models.StateRecord
-	189, // 340: unifiedfleet.api.v1.rpc.Fleet.GetDutState:output_type -> unifiedfleet.api.v1.models.chromeos.lab.DutState
-	116, // 341: unifiedfleet.api.v1.rpc.Fleet.ListDutStates:output_type -> unifiedfleet.api.v1.rpc.ListDutStatesResponse
-	189, // 342: unifiedfleet.api.v1.rpc.Fleet.UpdateDutState:output_type -> unifiedfleet.api.v1.models.chromeos.lab.DutState
-	194, // 343: unifiedfleet.api.v1.rpc.Fleet.GetDHCPConfig:output_type -> unifiedfleet.api.v1.models.DHCPConfig
-	174, // 344: unifiedfleet.api.v1.rpc.Fleet.CreateVM:output_type -> unifiedfleet.api.v1.models.VM
-	174, // 345: unifiedfleet.api.v1.rpc.Fleet.UpdateVM:output_type -> unifiedfleet.api.v1.models.VM
-	197, // 346: unifiedfleet.api.v1.rpc.Fleet.DeleteVM:output_type -> google.protobuf.Empty
-	174, // 347: unifiedfleet.api.v1.rpc.Fleet.GetVM:output_type -> unifiedfleet.api.v1.models.VM
-	13,  // 348: unifiedfleet.api.v1.rpc.Fleet.ListVMs:output_type -> unifiedfleet.api.v1.rpc.ListVMsResponse
-	193, // 349: unifiedfleet.api.v1.rpc.Fleet.CreateAsset:output_type -> unifiedfleet.api.v1.models.asset
-	193, // 350: unifiedfleet.api.v1.rpc.Fleet.UpdateAsset:output_type -> unifiedfleet.api.v1.models.asset
-	193, // 351: unifiedfleet.api.v1.rpc.Fleet.GetAsset:output_type -> unifiedfleet.api.v1.models.asset
-	125, // 352: unifiedfleet.api.v1.rpc.Fleet.ListAssets:output_type -> unifiedfleet.api.v1.rpc.ListAssetsResponse
-	197, // 353: unifiedfleet.api.v1.rpc.Fleet.DeleteAsset:output_type -> google.protobuf.Empty
-	193, // 354: unifiedfleet.api.v1.rpc.Fleet.RenameAsset:output_type -> unifiedfleet.api.v1.models.asset
-	129, // 355: unifiedfleet.api.v1.rpc.Fleet.BatchGetKVMs:output_type -> unifiedfleet.api.v1.rpc.BatchGetKVMsResponse
-	131, // 356: unifiedfleet.api.v1.rpc.Fleet.BatchGetDHCPConfigs:output_type -> unifiedfleet.api.v1.rpc.BatchGetDHCPConfigsResponse
-	133, // 357: unifiedfleet.api.v1.rpc.Fleet.BatchGetMachineLSEs:output_type -> unifiedfleet.api.v1.rpc.BatchGetMachineLSEsResponse
-	135, // 358: unifiedfleet.api.v1.rpc.Fleet.BatchGetMachines:output_type -> unifiedfleet.api.v1.rpc.BatchGetMachinesResponse
-	137, // 359: unifiedfleet.api.v1.rpc.Fleet.BatchGetSwitches:output_type -> unifiedfleet.api.v1.rpc.BatchGetSwitchesResponse
-	139, // 360: unifiedfleet.api.v1.rpc.Fleet.BatchGetRPMs:output_type -> unifiedfleet.api.v1.rpc.BatchGetRPMsResponse
-	141, // 361: unifiedfleet.api.v1.rpc.Fleet.BatchGetDracs:output_type -> unifiedfleet.api.v1.rpc.BatchGetDracsResponse
-	143, // 362: unifiedfleet.api.v1.rpc.Fleet.BatchGetNics:output_type -> unifiedfleet.api.v1.rpc.BatchGetNicsResponse
-	145, // 363: unifiedfleet.api.v1.rpc.Fleet.BatchGetVMs:output_type -> unifiedfleet.api.v1.rpc.BatchGetVMsResponse
-	147, // 364: unifiedfleet.api.v1.rpc.Fleet.BatchGetVlans:output_type -> unifiedfleet.api.v1.rpc.BatchGetVlansResponse
-	149, // 365: unifiedfleet.api.v1.rpc.Fleet.BatchGetRacks:output_type -> unifiedfleet.api.v1.rpc.BatchGetRacksResponse
-	151, // 366: unifiedfleet.api.v1.rpc.Fleet.BatchGetChromePlatforms:output_type -> unifiedfleet.api.v1.rpc.BatchGetChromePlatformsResponse
-	153, // 367: unifiedfleet.api.v1.rpc.Fleet.BatchGetMachineLSEPrototypes:output_type -> unifiedfleet.api.v1.rpc.BatchGetMachineLSEPrototypesResponse
-	155, // 368: unifiedfleet.api.v1.rpc.Fleet.BatchGetRackLSEPrototypes:output_type -> unifiedfleet.api.v1.rpc.BatchGetRackLSEPrototypesResponse
-	199, // 369: unifiedfleet.api.v1.rpc.Fleet.GetChromeOSDeviceData:output_type -> unifiedfleet.api.v1.models.ChromeOSDeviceData
-	195, // 370: unifiedfleet.api.v1.rpc.Fleet.CreateCachingService:output_type -> unifiedfleet.api.v1.models.CachingService
-	195, // 371: unifiedfleet.api.v1.rpc.Fleet.UpdateCachingService:output_type -> unifiedfleet.api.v1.models.CachingService
-	195, // 372: unifiedfleet.api.v1.rpc.Fleet.GetCachingService:output_type -> unifiedfleet.api.v1.models.CachingService
-	161, // 373: unifiedfleet.api.v1.rpc.Fleet.ListCachingServices:output_type -> unifiedfleet.api.v1.rpc.ListCachingServicesResponse
-	197, // 374: unifiedfleet.api.v1.rpc.Fleet.DeleteCachingService:output_type -> google.protobuf.Empty
-	172, // 375: unifiedfleet.api.v1.rpc.Fleet.UpdateMachineLSEDeployment:output_type -> unifiedfleet.api.v1.models.MachineLSEDeployment
-	2,   // 376: unifiedfleet.api.v1.rpc.Fleet.BatchUpdateMachineLSEDeployment:output_type -> unifiedfleet.api.v1.rpc.BatchUpdateMachineLSEDeploymentResponse
-	172, // 377: unifiedfleet.api.v1.rpc.Fleet.GetMachineLSEDeployment:output_type -> unifiedfleet.api.v1.models.MachineLSEDeployment
-	5,   // 378: unifiedfleet.api.v1.rpc.Fleet.BatchGetMachineLSEDeployments:output_type -> unifiedfleet.api.v1.rpc.BatchGetMachineLSEDeploymentsResponse
-	7,   // 379: unifiedfleet.api.v1.rpc.Fleet.ListMachineLSEDeployments:output_type -> unifiedfleet.api.v1.rpc.ListMachineLSEDeploymentsResponse
-	196, // 380: unifiedfleet.api.v1.rpc.Fleet.CreateSchedulingUnit:output_type -> unifiedfleet.api.v1.models.SchedulingUnit
-	196, // 381: unifiedfleet.api.v1.rpc.Fleet.UpdateSchedulingUnit:output_type -> unifiedfleet.api.v1.models.SchedulingUnit
-	196, // 382: unifiedfleet.api.v1.rpc.Fleet.GetSchedulingUnit:output_type -> unifiedfleet.api.v1.models.SchedulingUnit
-	167, // 383: unifiedfleet.api.v1.rpc.Fleet.ListSchedulingUnits:output_type -> unifiedfleet.api.v1.rpc.ListSchedulingUnitsResponse
-	197, // 384: unifiedfleet.api.v1.rpc.Fleet.DeleteSchedulingUnit:output_type -> google.protobuf.Empty
-	170, // 385: unifiedfleet.api.v1.rpc.Fleet.UpdateConfigBundle:output_type -> unifiedfleet.api.v1.rpc.UpdateConfigBundleResponse
-	258, // [258:386] is the sub-list for method output_type
-	130, // [130:258] is the sub-list for method input_type
+	47,  // 154: unifiedfleet.api.v1.rpc.Fleet.RenameMachine:input_type -> unifiedfleet.api.v1.rpc.RenameMachineRequest
+	46,  // 155: unifiedfleet.api.v1.rpc.Fleet.RestoreMachine:input_type -> unifiedfleet.api.v1.rpc.RestoreMachineRequest
+	121, // 156: unifiedfleet.api.v1.rpc.Fleet.RackRegistration:input_type -> unifiedfleet.api.v1.rpc.RackRegistrationRequest
+	51,  // 157: unifiedfleet.api.v1.rpc.Fleet.UpdateRack:input_type -> unifiedfleet.api.v1.rpc.UpdateRackRequest
+	52,  // 158: unifiedfleet.api.v1.rpc.Fleet.GetRack:input_type -> unifiedfleet.api.v1.rpc.GetRackRequest
+	53,  // 159: unifiedfleet.api.v1.rpc.Fleet.ListRacks:input_type -> unifiedfleet.api.v1.rpc.ListRacksRequest
+	55,  // 160: unifiedfleet.api.v1.rpc.Fleet.DeleteRack:input_type -> unifiedfleet.api.v1.rpc.DeleteRackRequest
+	56,  // 161: unifiedfleet.api.v1.rpc.Fleet.CreateMachineLSE:input_type -> unifiedfleet.api.v1.rpc.CreateMachineLSERequest
+	57,  // 162: unifiedfleet.api.v1.rpc.Fleet.UpdateMachineLSE:input_type -> unifiedfleet.api.v1.rpc.UpdateMachineLSERequest
+	58,  // 163: unifiedfleet.api.v1.rpc.Fleet.GetMachineLSE:input_type -> unifiedfleet.api.v1.rpc.GetMachineLSERequest
+	59,  // 164: unifiedfleet.api.v1.rpc.Fleet.ListMachineLSEs:input_type -> unifiedfleet.api.v1.rpc.ListMachineLSEsRequest
+	61,  // 165: unifiedfleet.api.v1.rpc.Fleet.DeleteMachineLSE:input_type -> unifiedfleet.api.v1.rpc.DeleteMachineLSERequest
+	63,  // 166: unifiedfleet.api.v1.rpc.Fleet.RenameMachineLSE:input_type -> unifiedfleet.api.v1.rpc.RenameMachineLSERequest
+	62,  // 167: unifiedfleet.api.v1.rpc.Fleet.RestoreMachineLSE:input_type -> unifiedfleet.api.v1.rpc.RestoreMachineLSERequest
+	64,  // 168: unifiedfleet.api.v1.rpc.Fleet.ImportMachineLSEs:input_type -> unifiedfleet.api.v1.rpc.ImportMachineLSEsRequest
+	65,  // 169: unifiedfleet.api.v1.rpc.Fleet.ImportOSMachineLSEs:input_type -> unifiedfleet.api.v1.rpc.ImportOSMachineLSEsRequest
+	66,  // 170: unifiedfleet.api.v1.rpc.Fleet.CreateRackLSE:input_type -> unifiedfleet.api.v1.rpc.CreateRackLSERequest
+	67,  // 171: unifiedfleet.api.v1.rpc.Fleet.UpdateRackLSE:input_type -> unifiedfleet.api.v1.rpc.UpdateRackLSERequest
+	68,  // 172: unifiedfleet.api.v1.rpc.Fleet.GetRackLSE:input_type -> unifiedfleet.api.v1.rpc.GetRackLSERequest
+	69,  // 173: unifiedfleet.api.v1.rpc.Fleet.ListRackLSEs:input_type -> unifiedfleet.api.v1.rpc.ListRackLSEsRequest
+	71,  // 174: unifiedfleet.api.v1.rpc.Fleet.DeleteRackLSE:input_type -> unifiedfleet.api.v1.rpc.DeleteRackLSERequest
+	72,  // 175: unifiedfleet.api.v1.rpc.Fleet.CreateNic:input_type -> unifiedfleet.api.v1.rpc.CreateNicRequest
+	73,  // 176: unifiedfleet.api.v1.rpc.Fleet.UpdateNic:input_type -> unifiedfleet.api.v1.rpc.UpdateNicRequest
+	74,  // 177: unifiedfleet.api.v1.rpc.Fleet.GetNic:input_type -> unifiedfleet.api.v1.rpc.GetNicRequest
+	75,  // 178: unifiedfleet.api.v1.rpc.Fleet.ListNics:input_type -> unifiedfleet.api.v1.rpc.ListNicsRequest
+	77,  // 179: unifiedfleet.api.v1.rpc.Fleet.DeleteNic:input_type -> unifiedfleet.api.v1.rpc.DeleteNicRequest
+	78,  // 180: unifiedfleet.api.v1.rpc.Fleet.ImportNics:input_type -> unifiedfleet.api.v1.rpc.ImportNicsRequest
+	79,  // 181: unifiedfleet.api.v1.rpc.Fleet.RenameNic:input_type -> unifiedfleet.api.v1.rpc.RenameNicRequest
+	81,  // 182: unifiedfleet.api.v1.rpc.Fleet.ImportDatacenters:input_type -> unifiedfleet.api.v1.rpc.ImportDatacentersRequest
+	82,  // 183: unifiedfleet.api.v1.rpc.Fleet.CreateKVM:input_type -> unifiedfleet.api.v1.rpc.CreateKVMRequest
+	83,  // 184: unifiedfleet.api.v1.rpc.Fleet.UpdateKVM:input_type -> unifiedfleet.api.v1.rpc.UpdateKVMRequest
+	84,  // 185: unifiedfleet.api.v1.rpc.Fleet.GetKVM:input_type -> unifiedfleet.api.v1.rpc.GetKVMRequest
+	85,  // 186: unifiedfleet.api.v1.rpc.Fleet.ListKVMs:input_type -> unifiedfleet.api.v1.rpc.ListKVMsRequest
+	87,  // 187: unifiedfleet.api.v1.rpc.Fleet.DeleteKVM:input_type -> unifiedfleet.api.v1.rpc.DeleteKVMRequest
+	88,  // 188: unifiedfleet.api.v1.rpc.Fleet.CreateRPM:input_type -> unifiedfleet.api.v1.rpc.CreateRPMRequest
+	89,  // 189: unifiedfleet.api.v1.rpc.Fleet.UpdateRPM:input_type -> unifiedfleet.api.v1.rpc.UpdateRPMRequest
+	90,  // 190: unifiedfleet.api.v1.rpc.Fleet.GetRPM:input_type -> unifiedfleet.api.v1.rpc.GetRPMRequest
+	91,  // 191: unifiedfleet.api.v1.rpc.Fleet.ListRPMs:input_type -> unifiedfleet.api.v1.rpc.ListRPMsRequest
+	93,  // 192: unifiedfleet.api.v1.rpc.Fleet.DeleteRPM:input_type -> unifiedfleet.api.v1.rpc.DeleteRPMRequest
+	94,  // 193: unifiedfleet.api.v1.rpc.Fleet.CreateDrac:input_type -> unifiedfleet.api.v1.rpc.CreateDracRequest
+	95,  // 194: unifiedfleet.api.v1.rpc.Fleet.UpdateDrac:input_type -> unifiedfleet.api.v1.rpc.UpdateDracRequest
+	96,  // 195: unifiedfleet.api.v1.rpc.Fleet.GetDrac:input_type -> unifiedfleet.api.v1.rpc.GetDracRequest
+	97,  // 196: unifiedfleet.api.v1.rpc.Fleet.ListDracs:input_type -> unifiedfleet.api.v1.rpc.ListDracsRequest
+	99,  // 197: unifiedfleet.api.v1.rpc.Fleet.DeleteDrac:input_type -> unifiedfleet.api.v1.rpc.DeleteDracRequest
+	100, // 198: unifiedfleet.api.v1.rpc.Fleet.CreateSwitch:input_type -> unifiedfleet.api.v1.rpc.CreateSwitchRequest
+	101, // 199: unifiedfleet.api.v1.rpc.Fleet.UpdateSwitch:input_type -> unifiedfleet.api.v1.rpc.UpdateSwitchRequest
+	102, // 200: unifiedfleet.api.v1.rpc.Fleet.GetSwitch:input_type -> unifiedfleet.api.v1.rpc.GetSwitchRequest
+	103, // 201: unifiedfleet.api.v1.rpc.Fleet.ListSwitches:input_type -> unifiedfleet.api.v1.rpc.ListSwitchesRequest
+	105, // 202: unifiedfleet.api.v1.rpc.Fleet.DeleteSwitch:input_type -> unifiedfleet.api.v1.rpc.DeleteSwitchRequest
+	80,  // 203: unifiedfleet.api.v1.rpc.Fleet.RenameSwitch:input_type -> unifiedfleet.api.v1.rpc.RenameSwitchRequest
+	106, // 204: unifiedfleet.api.v1.rpc.Fleet.CreateVlan:input_type -> unifiedfleet.api.v1.rpc.CreateVlanRequest
+	107, // 205: unifiedfleet.api.v1.rpc.Fleet.UpdateVlan:input_type -> unifiedfleet.api.v1.rpc.UpdateVlanRequest
+	108, // 206: unifiedfleet.api.v1.rpc.Fleet.GetVlan:input_type -> unifiedfleet.api.v1.rpc.GetVlanRequest
+	109, // 207: unifiedfleet.api.v1.rpc.Fleet.ListVlans:input_type -> unifiedfleet.api.v1.rpc.ListVlansRequest
+	111, // 208: unifiedfleet.api.v1.rpc.Fleet.DeleteVlan:input_type -> unifiedfleet.api.v1.rpc.DeleteVlanRequest
+	112, // 209: unifiedfleet.api.v1.rpc.Fleet.ImportVlans:input_type -> unifiedfleet.api.v1.rpc.ImportVlansRequest
+	113, // 210: unifiedfleet.api.v1.rpc.Fleet.ImportOSVlans:input_type -> unifiedfleet.api.v1.rpc.ImportOSVlansRequest
+	114, // 211: unifiedfleet.api.v1.rpc.Fleet.ImportStates:input_type -> unifiedfleet.api.v1.rpc.ImportStatesRequest
+	119, // 212: unifiedfleet.api.v1.rpc.Fleet.UpdateState:input_type -> unifiedfleet.api.v1.rpc.UpdateStateRequest
+	115, // 213: unifiedfleet.api.v1.rpc.Fleet.GetState:input_type -> unifiedfleet.api.v1.rpc.GetStateRequest
+	116, // 214: unifiedfleet.api.v1.rpc.Fleet.GetDutState:input_type -> unifiedfleet.api.v1.rpc.GetDutStateRequest
+	117, // 215: unifiedfleet.api.v1.rpc.Fleet.ListDutStates:input_type -> unifiedfleet.api.v1.rpc.ListDutStatesRequest
+	120, // 216: unifiedfleet.api.v1.rpc.Fleet.UpdateDutState:input_type -> unifiedfleet.api.v1.rpc.UpdateDutStateRequest
+	14,  // 217: unifiedfleet.api.v1.rpc.Fleet.GetDHCPConfig:input_type -> unifiedfleet.api.v1.rpc.GetDHCPConfigRequest
+	8,   // 218: unifiedfleet.api.v1.rpc.Fleet.CreateVM:input_type -> unifiedfleet.api.v1.rpc.CreateVMRequest
+	9,   // 219: unifiedfleet.api.v1.rpc.Fleet.UpdateVM:input_type -> unifiedfleet.api.v1.rpc.UpdateVMRequest
+	11,  // 220: unifiedfleet.api.v1.rpc.Fleet.DeleteVM:input_type -> unifiedfleet.api.v1.rpc.DeleteVMRequest
+	10,  // 221: unifiedfleet.api.v1.rpc.Fleet.GetVM:input_type -> unifiedfleet.api.v1.rpc.GetVMRequest
+	12,  // 222: unifiedfleet.api.v1.rpc.Fleet.ListVMs:input_type -> unifiedfleet.api.v1.rpc.ListVMsRequest
+	123, // 223: unifiedfleet.api.v1.rpc.Fleet.CreateAsset:input_type -> unifiedfleet.api.v1.rpc.CreateAssetRequest
+	124, // 224: unifiedfleet.api.v1.rpc.Fleet.UpdateAsset:input_type -> unifiedfleet.api.v1.rpc.UpdateAssetRequest
+	125, // 225: unifiedfleet.api.v1.rpc.Fleet.GetAsset:input_type -> unifiedfleet.api.v1.rpc.GetAssetRequest
+	126, // 226: unifiedfleet.api.v1.rpc.Fleet.ListAssets:input_type -> unifiedfleet.api.v1.rpc.ListAssetsRequest
+	128, // 227: unifiedfleet.api.v1.rpc.Fleet.DeleteAsset:input_type -> unifiedfleet.api.v1.rpc.DeleteAssetRequest
+	129, // 228: unifiedfleet.api.v1.rpc.Fleet.RenameAsset:input_type -> unifiedfleet.api.v1.rpc.RenameAssetRequest
+	130, // 229: unifiedfleet.api.v1.rpc.Fleet.BatchGetKVMs:input_type -> unifiedfleet.api.v1.rpc.BatchGetKVMsRequest
+	132, // 230: unifiedfleet.api.v1.rpc.Fleet.BatchGetDHCPConfigs:input_type -> unifiedfleet.api.v1.rpc.BatchGetDHCPConfigsRequest
+	134, // 231: unifiedfleet.api.v1.rpc.Fleet.BatchGetMachineLSEs:input_type -> unifiedfleet.api.v1.rpc.BatchGetMachineLSEsRequest
+	136, // 232: unifiedfleet.api.v1.rpc.Fleet.BatchGetMachines:input_type -> unifiedfleet.api.v1.rpc.BatchGetMachinesRequest
+	138, // 233: unifiedfleet.api.v1.rpc.Fleet.BatchGetSwitches:input_type -> unifiedfleet.api.v1.rpc.BatchGetSwitchesRequest
+	140, // 234: unifiedfleet.api.v1.rpc.Fleet.BatchGetRPMs:input_type -> unifiedfleet.api.v1.rpc.BatchGetRPMsRequest
+	142, // 235: unifiedfleet.api.v1.rpc.Fleet.BatchGetDracs:input_type -> unifiedfleet.api.v1.rpc.BatchGetDracsRequest
+	144, // 236: unifiedfleet.api.v1.rpc.Fleet.BatchGetNics:input_type -> unifiedfleet.api.v1.rpc.BatchGetNicsRequest
+	146, // 237: unifiedfleet.api.v1.rpc.Fleet.BatchGetVMs:input_type -> unifiedfleet.api.v1.rpc.BatchGetVMsRequest
+	148, // 238: unifiedfleet.api.v1.rpc.Fleet.BatchGetVlans:input_type -> unifiedfleet.api.v1.rpc.BatchGetVlansRequest
+	150, // 239: unifiedfleet.api.v1.rpc.Fleet.BatchGetRacks:input_type -> unifiedfleet.api.v1.rpc.BatchGetRacksRequest
+	152, // 240: unifiedfleet.api.v1.rpc.Fleet.BatchGetChromePlatforms:input_type -> unifiedfleet.api.v1.rpc.BatchGetChromePlatformsRequest
+	154, // 241: unifiedfleet.api.v1.rpc.Fleet.BatchGetMachineLSEPrototypes:input_type -> unifiedfleet.api.v1.rpc.BatchGetMachineLSEPrototypesRequest
+	156, // 242: unifiedfleet.api.v1.rpc.Fleet.BatchGetRackLSEPrototypes:input_type -> unifiedfleet.api.v1.rpc.BatchGetRackLSEPrototypesRequest
+	158, // 243: unifiedfleet.api.v1.rpc.Fleet.GetChromeOSDeviceData:input_type -> unifiedfleet.api.v1.rpc.GetChromeOSDeviceDataRequest
+	159, // 244: unifiedfleet.api.v1.rpc.Fleet.CreateCachingService:input_type -> unifiedfleet.api.v1.rpc.CreateCachingServiceRequest
+	160, // 245: unifiedfleet.api.v1.rpc.Fleet.UpdateCachingService:input_type -> unifiedfleet.api.v1.rpc.UpdateCachingServiceRequest
+	161, // 246: unifiedfleet.api.v1.rpc.Fleet.GetCachingService:input_type -> unifiedfleet.api.v1.rpc.GetCachingServiceRequest
+	162, // 247: unifiedfleet.api.v1.rpc.Fleet.ListCachingServices:input_type -> unifiedfleet.api.v1.rpc.ListCachingServicesRequest
+	164, // 248: unifiedfleet.api.v1.rpc.Fleet.DeleteCachingService:input_type -> unifiedfleet.api.v1.rpc.DeleteCachingServiceRequest
+	0,   // 249: unifiedfleet.api.v1.rpc.Fleet.UpdateMachineLSEDeployment:input_type -> unifiedfleet.api.v1.rpc.UpdateMachineLSEDeploymentRequest
+	1,   // 250: unifiedfleet.api.v1.rpc.Fleet.BatchUpdateMachineLSEDeployment:input_type -> unifiedfleet.api.v1.rpc.BatchUpdateMachineLSEDeploymentRequest
+	3,   // 251: unifiedfleet.api.v1.rpc.Fleet.GetMachineLSEDeployment:input_type -> unifiedfleet.api.v1.rpc.GetMachineLSEDeploymentRequest
+	4,   // 252: unifiedfleet.api.v1.rpc.Fleet.BatchGetMachineLSEDeployments:input_type -> unifiedfleet.api.v1.rpc.BatchGetMachineLSEDeploymentsRequest
+	6,   // 253: unifiedfleet.api.v1.rpc.Fleet.ListMachineLSEDeployments:input_type -> unifiedfleet.api.v1.rpc.ListMachineLSEDeploymentsRequest
+	165, // 254: unifiedfleet.api.v1.rpc.Fleet.CreateSchedulingUnit:input_type -> unifiedfleet.api.v1.rpc.CreateSchedulingUnitRequest
+	166, // 255: unifiedfleet.api.v1.rpc.Fleet.UpdateSchedulingUnit:input_type -> unifiedfleet.api.v1.rpc.UpdateSchedulingUnitRequest
+	167, // 256: unifiedfleet.api.v1.rpc.Fleet.GetSchedulingUnit:input_type -> unifiedfleet.api.v1.rpc.GetSchedulingUnitRequest
+	168, // 257: unifiedfleet.api.v1.rpc.Fleet.ListSchedulingUnits:input_type -> unifiedfleet.api.v1.rpc.ListSchedulingUnitsRequest
+	170, // 258: unifiedfleet.api.v1.rpc.Fleet.DeleteSchedulingUnit:input_type -> unifiedfleet.api.v1.rpc.DeleteSchedulingUnitRequest
+	171, // 259: unifiedfleet.api.v1.rpc.Fleet.UpdateConfigBundle:input_type -> unifiedfleet.api.v1.rpc.UpdateConfigBundleRequest
+	177, // 260: unifiedfleet.api.v1.rpc.Fleet.CreateChromePlatform:output_type -> unifiedfleet.api.v1.models.ChromePlatform
+	177, // 261: unifiedfleet.api.v1.rpc.Fleet.UpdateChromePlatform:output_type -> unifiedfleet.api.v1.models.ChromePlatform
+	177, // 262: unifiedfleet.api.v1.rpc.Fleet.GetChromePlatform:output_type -> unifiedfleet.api.v1.models.ChromePlatform
+	19,  // 263: unifiedfleet.api.v1.rpc.Fleet.ListChromePlatforms:output_type -> unifiedfleet.api.v1.rpc.ListChromePlatformsResponse
+	199, // 264: unifiedfleet.api.v1.rpc.Fleet.DeleteChromePlatform:output_type -> google.protobuf.Empty
+	200, // 265: unifiedfleet.api.v1.rpc.Fleet.ImportChromePlatforms:output_type -> google.rpc.Status
+	26,  // 266: unifiedfleet.api.v1.rpc.Fleet.ListOSVersions:output_type -> unifiedfleet.api.v1.rpc.ListOSVersionsResponse
+	200, // 267: unifiedfleet.api.v1.rpc.Fleet.ImportOSVersions:output_type -> google.rpc.Status
+	179, // 268: unifiedfleet.api.v1.rpc.Fleet.CreateMachineLSEPrototype:output_type -> unifiedfleet.api.v1.models.MachineLSEPrototype
+	179, // 269: unifiedfleet.api.v1.rpc.Fleet.UpdateMachineLSEPrototype:output_type -> unifiedfleet.api.v1.models.MachineLSEPrototype
+	179, // 270: unifiedfleet.api.v1.rpc.Fleet.GetMachineLSEPrototype:output_type -> unifiedfleet.api.v1.models.MachineLSEPrototype
+	31,  // 271: unifiedfleet.api.v1.rpc.Fleet.ListMachineLSEPrototypes:output_type -> unifiedfleet.api.v1.rpc.ListMachineLSEPrototypesResponse
+	199, // 272: unifiedfleet.api.v1.rpc.Fleet.DeleteMachineLSEPrototype:output_type -> google.protobuf.Empty
+	180, // 273: unifiedfleet.api.v1.rpc.Fleet.CreateRackLSEPrototype:output_type -> unifiedfleet.api.v1.models.RackLSEPrototype
+	180, // 274: unifiedfleet.api.v1.rpc.Fleet.UpdateRackLSEPrototype:output_type -> unifiedfleet.api.v1.models.RackLSEPrototype
+	180, // 275: unifiedfleet.api.v1.rpc.Fleet.GetRackLSEPrototype:output_type -> unifiedfleet.api.v1.models.RackLSEPrototype
+	37,  // 276: unifiedfleet.api.v1.rpc.Fleet.ListRackLSEPrototypes:output_type -> unifiedfleet.api.v1.rpc.ListRackLSEPrototypesResponse
+	199, // 277: unifiedfleet.api.v1.rpc.Fleet.DeleteRackLSEPrototype:output_type -> google.protobuf.Empty
+	181, // 278: unifiedfleet.api.v1.rpc.Fleet.MachineRegistration:output_type -> unifiedfleet.api.v1.models.Machine
+	181, // 279: unifiedfleet.api.v1.rpc.Fleet.UpdateMachine:output_type -> unifiedfleet.api.v1.models.Machine
+	181, // 280: unifiedfleet.api.v1.rpc.Fleet.GetMachine:output_type -> unifiedfleet.api.v1.models.Machine
+	43,  // 281: unifiedfleet.api.v1.rpc.Fleet.ListMachines:output_type -> unifiedfleet.api.v1.rpc.ListMachinesResponse
+	199, // 282: unifiedfleet.api.v1.rpc.Fleet.DeleteMachine:output_type -> google.protobuf.Empty
+	200, // 283: unifiedfleet.api.v1.rpc.Fleet.ImportMachines:output_type -> google.rpc.Status
+	181, // 284: unifiedfleet.api.v1.rpc.Fleet.RenameMachine:output_type -> unifiedfleet.api.v1.models.Machine
+	181, // 285: unifiedfleet.api.v1.rpc.Fleet.RestoreMachine:output_type -> unifiedfleet.api.v1.models.Machine
+	182, // 286: unifiedfleet.api.v1.rpc.Fleet.RackRegistration:output_type -> unifiedfleet.api.v1.models.Rack
+	182, // 287: unifiedfleet.api.v1.rpc.Fleet.UpdateRack:output_type -> unifiedfleet.api.v1.models.Rack
+	182, // 288: unifiedfleet.api.v1.rpc.Fleet.GetRack:output_type -> unifiedfleet.api.v1.models.Rack
+	54,  // 289: unifiedfleet.api.v1.rpc.Fleet.ListRacks:output_type -> unifiedfleet.api.v1.rpc.ListRacksResponse
+	199, // 290: unifiedfleet.api.v1.rpc.Fleet.DeleteRack:output_type -> google.protobuf.Empty
+	183, // 291: unifiedfleet.api.v1.rpc.Fleet.CreateMachineLSE:output_type -> unifiedfleet.api.v1.models.MachineLSE
+	183, // 292: unifiedfleet.api.v1.rpc.Fleet.UpdateMachineLSE:output_type -> unifiedfleet.api.v1.models.MachineLSE
+	183, // 293: unifiedfleet.api.v1.rpc.Fleet.GetMachineLSE:output_type -> unifiedfleet.api.v1.models.MachineLSE
+	60,  // 294: unifiedfleet.api.v1.rpc.Fleet.ListMachineLSEs:output_type -> unifiedfleet.api.v1.rpc.ListMachineLSEsResponse
+	199, // 295: unifiedfleet.api.v1.rpc.Fleet.DeleteMachineLSE:output_type -> google.protobuf.Empty
+	183, // 296: unifiedfleet.api.v1.rpc.Fleet.RenameMachineLSE:output_type -> unifiedfleet.api.v1.models.MachineLSE
+	183, // 297: unifiedfleet.api.v1.rpc.Fleet.RestoreMachineLSE:output_type -> unifiedfleet.api.v1.models.MachineLSE
+	200, // 298: unifiedfleet.api.v1.rpc.Fleet.ImportMachineLSEs:output_type -> google.rpc.Status
+	200, // 299: unifiedfleet.api.v1.rpc.Fleet.ImportOSMachineLSEs:output_type -> google.rpc.Status
+	184, // 300: unifiedfleet.api.v1.rpc.Fleet.CreateRackLSE:output_type -> unifiedfleet.api.v1.models.RackLSE
+	184, // 301: unifiedfleet.api.v1.rpc.Fleet.UpdateRackLSE:output_type -> unifiedfleet.api.v1.models.RackLSE
+	184, // 302: unifiedfleet.api.v1.rpc.Fleet.GetRackLSE:output_type -> unifiedfleet.api.v1.models.RackLSE
+	70,  // 303: unifiedfleet.api.v1.rpc.Fleet.ListRackLSEs:output_type -> unifiedfleet.api.v1.rpc.ListRackLSEsResponse
+	199, // 304: unifiedfleet.api.v1.rpc.Fleet.DeleteRackLSE:output_type -> google.protobuf.Empty
+	185, // 305: unifiedfleet.api.v1.rpc.Fleet.CreateNic:output_type -> unifiedfleet.api.v1.models.Nic
+	185, // 306: unifiedfleet.api.v1.rpc.Fleet.UpdateNic:output_type -> unifiedfleet.api.v1.models.Nic
+	185, // 307: unifiedfleet.api.v1.rpc.Fleet.GetNic:output_type -> unifiedfleet.api.v1.models.Nic
+	76,  // 308: unifiedfleet.api.v1.rpc.Fleet.ListNics:output_type -> unifiedfleet.api.v1.rpc.ListNicsResponse
+	199, // 309: unifiedfleet.api.v1.rpc.Fleet.DeleteNic:output_type -> google.protobuf.Empty
+	200, // 310: unifiedfleet.api.v1.rpc.Fleet.ImportNics:output_type -> google.rpc.Status
+	185, // 311: unifiedfleet.api.v1.rpc.Fleet.RenameNic:output_type -> unifiedfleet.api.v1.models.Nic
+	200, // 312: unifiedfleet.api.v1.rpc.Fleet.ImportDatacenters:output_type -> google.rpc.Status
+	186, // 313: unifiedfleet.api.v1.rpc.Fleet.CreateKVM:output_type -> unifiedfleet.api.v1.models.KVM
+	186, // 314: unifiedfleet.api.v1.rpc.Fleet.UpdateKVM:output_type -> unifiedfleet.api.v1.models.KVM
+	186, // 315: unifiedfleet.api.v1.rpc.Fleet.GetKVM:output_type -> unifiedfleet.api.v1.models.KVM
+	86,  // 316: unifiedfleet.api.v1.rpc.Fleet.ListKVMs:output_type -> unifiedfleet.api.v1.rpc.ListKVMsResponse
+	199, // 317: unifiedfleet.api.v1.rpc.Fleet.DeleteKVM:output_type -> google.protobuf.Empty
+	187, // 318: unifiedfleet.api.v1.rpc.Fleet.CreateRPM:output_type -> unifiedfleet.api.v1.models.RPM
+	187, // 319: unifiedfleet.api.v1.rpc.Fleet.UpdateRPM:output_type -> unifiedfleet.api.v1.models.RPM
+	187, // 320: unifiedfleet.api.v1.rpc.Fleet.GetRPM:output_type -> unifiedfleet.api.v1.models.RPM
+	92,  // 321: unifiedfleet.api.v1.rpc.Fleet.ListRPMs:output_type -> unifiedfleet.api.v1.rpc.ListRPMsResponse
+	199, // 322: unifiedfleet.api.v1.rpc.Fleet.DeleteRPM:output_type -> google.protobuf.Empty
+	188, // 323: unifiedfleet.api.v1.rpc.Fleet.CreateDrac:output_type -> unifiedfleet.api.v1.models.Drac
+	188, // 324: unifiedfleet.api.v1.rpc.Fleet.UpdateDrac:output_type -> unifiedfleet.api.v1.models.Drac
+	188, // 325: unifiedfleet.api.v1.rpc.Fleet.GetDrac:output_type -> unifiedfleet.api.v1.models.Drac
+	98,  // 326: unifiedfleet.api.v1.rpc.Fleet.ListDracs:output_type -> unifiedfleet.api.v1.rpc.ListDracsResponse
+	199, // 327: unifiedfleet.api.v1.rpc.Fleet.DeleteDrac:output_type -> google.protobuf.Empty
+	189, // 328: unifiedfleet.api.v1.rpc.Fleet.CreateSwitch:output_type -> unifiedfleet.api.v1.models.Switch
+	189, // 329: unifiedfleet.api.v1.rpc.Fleet.UpdateSwitch:output_type -> unifiedfleet.api.v1.models.Switch
+	189, // 330: unifiedfleet.api.v1.rpc.Fleet.GetSwitch:output_type -> unifiedfleet.api.v1.models.Switch
+	104, // 331: unifiedfleet.api.v1.rpc.Fleet.ListSwitches:output_type -> unifiedfleet.api.v1.rpc.ListSwitchesResponse
+	199, // 332: unifiedfleet.api.v1.rpc.Fleet.DeleteSwitch:output_type -> google.protobuf.Empty
+	189, // 333: unifiedfleet.api.v1.rpc.Fleet.RenameSwitch:output_type -> unifiedfleet.api.v1.models.Switch
+	190, // 334: unifiedfleet.api.v1.rpc.Fleet.CreateVlan:output_type -> unifiedfleet.api.v1.models.Vlan
+	190, // 335: unifiedfleet.api.v1.rpc.Fleet.UpdateVlan:output_type -> unifiedfleet.api.v1.models.Vlan
+	190, // 336: unifiedfleet.api.v1.rpc.Fleet.GetVlan:output_type -> unifiedfleet.api.v1.models.Vlan
+	110, // 337: unifiedfleet.api.v1.rpc.Fleet.ListVlans:output_type -> unifiedfleet.api.v1.rpc.ListVlansResponse
+	199, // 338: unifiedfleet.api.v1.rpc.Fleet.DeleteVlan:output_type -> google.protobuf.Empty
+	200, // 339: unifiedfleet.api.v1.rpc.Fleet.ImportVlans:output_type -> google.rpc.Status
+	200, // 340: unifiedfleet.api.v1.rpc.Fleet.ImportOSVlans:output_type -> google.rpc.Status
+	200, // 341: unifiedfleet.api.v1.rpc.Fleet.ImportStates:output_type -> google.rpc.Status
+	192, // 342: unifiedfleet.api.v1.rpc.Fleet.UpdateState:output_type -> unifiedfleet.api.v1.models.StateRecord
+	192, // 343: unifiedfleet.api.v1.rpc.Fleet.GetState:output_type -> unifiedfleet.api.v1.models.StateRecord
+	191, // 344: unifiedfleet.api.v1.rpc.Fleet.GetDutState:output_type -> unifiedfleet.api.v1.models.chromeos.lab.DutState
+	118, // 345: unifiedfleet.api.v1.rpc.Fleet.ListDutStates:output_type -> unifiedfleet.api.v1.rpc.ListDutStatesResponse
+	191, // 346: unifiedfleet.api.v1.rpc.Fleet.UpdateDutState:output_type -> unifiedfleet.api.v1.models.chromeos.lab.DutState
+	196, // 347: unifiedfleet.api.v1.rpc.Fleet.GetDHCPConfig:output_type -> unifiedfleet.api.v1.models.DHCPConfig
+	176, // 348: unifiedfleet.api.v1.rpc.Fleet.CreateVM:output_type -> unifiedfleet.api.v1.models.VM
+	176, // 349: unifiedfleet.api.v1.rpc.Fleet.UpdateVM:output_type -> unifiedfleet.api.v1.models.VM
+	199, // 350: unifiedfleet.api.v1.rpc.Fleet.DeleteVM:output_type -> google.protobuf.Empty
+	176, // 351: unifiedfleet.api.v1.rpc.Fleet.GetVM:output_type -> unifiedfleet.api.v1.models.VM
+	13,  // 352: unifiedfleet.api.v1.rpc.Fleet.ListVMs:output_type -> unifiedfleet.api.v1.rpc.ListVMsResponse
+	195, // 353: unifiedfleet.api.v1.rpc.Fleet.CreateAsset:output_type -> unifiedfleet.api.v1.models.asset
+	195, // 354: unifiedfleet.api.v1.rpc.Fleet.UpdateAsset:output_type -> unifiedfleet.api.v1.models.asset
+	195, // 355: unifiedfleet.api.v1.rpc.Fleet.GetAsset:output_type -> unifiedfleet.api.v1.models.asset
+	127, // 356: unifiedfleet.api.v1.rpc.Fleet.ListAssets:output_type -> unifiedfleet.api.v1.rpc.ListAssetsResponse
+	199, // 357: unifiedfleet.api.v1.rpc.Fleet.DeleteAsset:output_type -> google.protobuf.Empty
+	195, // 358: unifiedfleet.api.v1.rpc.Fleet.RenameAsset:output_type -> unifiedfleet.api.v1.models.asset
+	131, // 359: unifiedfleet.api.v1.rpc.Fleet.BatchGetKVMs:output_type -> unifiedfleet.api.v1.rpc.BatchGetKVMsResponse
+	133, // 360: unifiedfleet.api.v1.rpc.Fleet.BatchGetDHCPConfigs:output_type -> unifiedfleet.api.v1.rpc.BatchGetDHCPConfigsResponse
+	135, // 361: unifiedfleet.api.v1.rpc.Fleet.BatchGetMachineLSEs:output_type -> unifiedfleet.api.v1.rpc.BatchGetMachineLSEsResponse
+	137, // 362: unifiedfleet.api.v1.rpc.Fleet.BatchGetMachines:output_type -> unifiedfleet.api.v1.rpc.BatchGetMachinesResponse
+	139, // 363: unifiedfleet.api.v1.rpc.Fleet.BatchGetSwitches:output_type -> unifiedfleet.api.v1.rpc.BatchGetSwitchesResponse
+	141, // 364: unifiedfleet.api.v1.rpc.Fleet.BatchGetRPMs:output_type -> unifiedfleet.api.v1.rpc.BatchGetRPMsResponse
+	143, // 365: unifiedfleet.api.v1.rpc.Fleet.BatchGetDracs:output_type -> unifiedfleet.api.v1.rpc.BatchGetDracsResponse
+	145, // 366: unifiedfleet.api.v1.rpc.Fleet.BatchGetNics:output_type -> unifiedfleet.api.v1.rpc.BatchGetNicsResponse
+	147, // 367: unifiedfleet.api.v1.rpc.Fleet.BatchGetVMs:output_type -> unifiedfleet.api.v1.rpc.BatchGetVMsResponse
+	149, // 368: unifiedfleet.api.v1.rpc.Fleet.BatchGetVlans:output_type -> unifiedfleet.api.v1.rpc.BatchGetVlansResponse
+	151, // 369: unifiedfleet.api.v1.rpc.Fleet.BatchGetRacks:output_type -> unifiedfleet.api.v1.rpc.BatchGetRacksResponse
+	153, // 370: unifiedfleet.api.v1.rpc.Fleet.BatchGetChromePlatforms:output_type -> unifiedfleet.api.v1.rpc.BatchGetChromePlatformsResponse
+	155, // 371: unifiedfleet.api.v1.rpc.Fleet.BatchGetMachineLSEPrototypes:output_type -> unifiedfleet.api.v1.rpc.BatchGetMachineLSEPrototypesResponse
+	157, // 372: unifiedfleet.api.v1.rpc.Fleet.BatchGetRackLSEPrototypes:output_type -> unifiedfleet.api.v1.rpc.BatchGetRackLSEPrototypesResponse
+	201, // 373: unifiedfleet.api.v1.rpc.Fleet.GetChromeOSDeviceData:output_type -> unifiedfleet.api.v1.models.ChromeOSDeviceData
+	197, // 374: unifiedfleet.api.v1.rpc.Fleet.CreateCachingService:output_type -> unifiedfleet.api.v1.models.CachingService
+	197, // 375: unifiedfleet.api.v1.rpc.Fleet.UpdateCachingService:output_type -> unifiedfleet.api.v1.models.CachingService
+	197, // 376: unifiedfleet.api.v1.rpc.Fleet.GetCachingService:output_type -> unifiedfleet.api.v1.models.CachingService
+	163, // 377: unifiedfleet.api.v1.rpc.Fleet.ListCachingServices:output_type -> unifiedfleet.api.v1.rpc.ListCachingServicesResponse
+	199, // 378: unifiedfleet.api.v1.rpc.Fleet.DeleteCachingService:output_type -> google.protobuf.Empty
+	174, // 379: unifiedfleet.api.v1.rpc.Fleet.UpdateMachineLSEDeployment:output_type -> unifiedfleet.api.v1.models.MachineLSEDeployment
+	2,   // 380: unifiedfleet.api.v1.rpc.Fleet.BatchUpdateMachineLSEDeployment:output_type -> unifiedfleet.api.v1.rpc.BatchUpdateMachineLSEDeploymentResponse
+	174, // 381: unifiedfleet.api.v1.rpc.Fleet.GetMachineLSEDeployment:output_type -> unifiedfleet.api.v1.models.MachineLSEDeployment
+	5,   // 382: unifiedfleet.api.v1.rpc.Fleet.BatchGetMachineLSEDeployments:output_type -> unifiedfleet.api.v1.rpc.BatchGetMachineLSEDeploymentsResponse
+	7,   // 383: unifiedfleet.api.v1.rpc.Fleet.ListMachineLSEDeployments:output_type -> unifiedfleet.api.v1.rpc.ListMachineLSEDeploymentsResponse
+	198, // 384: unifiedfleet.api.v1.rpc.Fleet.CreateSchedulingUnit:output_type -> unifiedfleet.api.v1.models.SchedulingUnit
+	198, // 385: unifiedfleet.api.v1.rpc.Fleet.UpdateSchedulingUnit:output_type -> unifiedfleet.api.v1.models.SchedulingUnit
+	198, // 386: unifiedfleet.api.v1.rpc.Fleet.GetSchedulingUnit:output_type -> unifiedfleet.api.v1.models.SchedulingUnit
+	169, // 387: unifiedfleet.api.v1.rpc.Fleet.ListSchedulingUnits:output_type -> unifiedfleet.api.v1.rpc.ListSchedulingUnitsResponse
+	199, // 388: unifiedfleet.api.v1.rpc.Fleet.DeleteSchedulingUnit:output_type -> google.protobuf.Empty
+	172, // 389: unifiedfleet.api.v1.rpc.Fleet.UpdateConfigBundle:output_type -> unifiedfleet.api.v1.rpc.UpdateConfigBundleResponse
+	260, // [260:390] is the sub-list for method output_type
+	130, // [130:260] is the sub-list for method input_type
 	130, // [130:130] is the sub-list for extension type_name
 	130, // [130:130] is the sub-list for extension extendee
 	0,   // [0:130] is the sub-list for field type_name
@@ -13754,7 +13933,7 @@ func file_infra_unifiedfleet_api_v1_rpc_fleet_proto_init() {
 			}
 		}
 		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RenameMachineRequest); i {
+			switch v := v.(*RestoreMachineRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13766,7 +13945,7 @@ func file_infra_unifiedfleet_api_v1_rpc_fleet_proto_init() {
 			}
 		}
 		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MachineDBSource); i {
+			switch v := v.(*RenameMachineRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13778,7 +13957,7 @@ func file_infra_unifiedfleet_api_v1_rpc_fleet_proto_init() {
 			}
 		}
 		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ConfigSource); i {
+			switch v := v.(*MachineDBSource); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13790,7 +13969,7 @@ func file_infra_unifiedfleet_api_v1_rpc_fleet_proto_init() {
 			}
 		}
 		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateRackRequest); i {
+			switch v := v.(*ConfigSource); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13802,7 +13981,7 @@ func file_infra_unifiedfleet_api_v1_rpc_fleet_proto_init() {
 			}
 		}
 		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateRackRequest); i {
+			switch v := v.(*CreateRackRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13814,7 +13993,7 @@ func file_infra_unifiedfleet_api_v1_rpc_fleet_proto_init() {
 			}
 		}
 		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetRackRequest); i {
+			switch v := v.(*UpdateRackRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13826,7 +14005,7 @@ func file_infra_unifiedfleet_api_v1_rpc_fleet_proto_init() {
 			}
 		}
 		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListRacksRequest); i {
+			switch v := v.(*GetRackRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13838,7 +14017,7 @@ func file_infra_unifiedfleet_api_v1_rpc_fleet_proto_init() {
 			}
 		}
 		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListRacksResponse); i {
+			switch v := v.(*ListRacksRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13850,7 +14029,7 @@ func file_infra_unifiedfleet_api_v1_rpc_fleet_proto_init() {
 			}
 		}
 		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteRackRequest); i {
+			switch v := v.(*ListRacksResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13862,7 +14041,7 @@ func file_infra_unifiedfleet_api_v1_rpc_fleet_proto_init() {
 			}
 		}
 		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateMachineLSERequest); i {
+			switch v := v.(*DeleteRackRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13874,7 +14053,7 @@ func file_infra_unifiedfleet_api_v1_rpc_fleet_proto_init() {
 			}
 		}
 		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateMachineLSERequest); i {
+			switch v := v.(*CreateMachineLSERequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13886,7 +14065,7 @@ func file_infra_unifiedfleet_api_v1_rpc_fleet_proto_init() {
 			}
 		}
 		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetMachineLSERequest); i {
+			switch v := v.(*UpdateMachineLSERequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13898,7 +14077,7 @@ func file_infra_unifiedfleet_api_v1_rpc_fleet_proto_init() {
 			}
 		}
 		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListMachineLSEsRequest); i {
+			switch v := v.(*GetMachineLSERequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13910,7 +14089,7 @@ func file_infra_unifiedfleet_api_v1_rpc_fleet_proto_init() {
 			}
 		}
 		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListMachineLSEsResponse); i {
+			switch v := v.(*ListMachineLSEsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13922,7 +14101,7 @@ func file_infra_unifiedfleet_api_v1_rpc_fleet_proto_init() {
 			}
 		}
 		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteMachineLSERequest); i {
+			switch v := v.(*ListMachineLSEsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13934,7 +14113,7 @@ func file_infra_unifiedfleet_api_v1_rpc_fleet_proto_init() {
 			}
 		}
 		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RenameMachineLSERequest); i {
+			switch v := v.(*DeleteMachineLSERequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13946,7 +14125,7 @@ func file_infra_unifiedfleet_api_v1_rpc_fleet_proto_init() {
 			}
 		}
 		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ImportMachineLSEsRequest); i {
+			switch v := v.(*RestoreMachineLSERequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13958,7 +14137,7 @@ func file_infra_unifiedfleet_api_v1_rpc_fleet_proto_init() {
 			}
 		}
 		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ImportOSMachineLSEsRequest); i {
+			switch v := v.(*RenameMachineLSERequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13970,7 +14149,7 @@ func file_infra_unifiedfleet_api_v1_rpc_fleet_proto_init() {
 			}
 		}
 		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateRackLSERequest); i {
+			switch v := v.(*ImportMachineLSEsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13982,7 +14161,7 @@ func file_infra_unifiedfleet_api_v1_rpc_fleet_proto_init() {
 			}
 		}
 		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateRackLSERequest); i {
+			switch v := v.(*ImportOSMachineLSEsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13994,7 +14173,7 @@ func file_infra_unifiedfleet_api_v1_rpc_fleet_proto_init() {
 			}
 		}
 		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetRackLSERequest); i {
+			switch v := v.(*CreateRackLSERequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -14006,7 +14185,7 @@ func file_infra_unifiedfleet_api_v1_rpc_fleet_proto_init() {
 			}
 		}
 		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListRackLSEsRequest); i {
+			switch v := v.(*UpdateRackLSERequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -14018,7 +14197,7 @@ func file_infra_unifiedfleet_api_v1_rpc_fleet_proto_init() {
 			}
 		}
 		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListRackLSEsResponse); i {
+			switch v := v.(*GetRackLSERequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -14030,7 +14209,7 @@ func file_infra_unifiedfleet_api_v1_rpc_fleet_proto_init() {
 			}
 		}
 		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteRackLSERequest); i {
+			switch v := v.(*ListRackLSEsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -14042,7 +14221,7 @@ func file_infra_unifiedfleet_api_v1_rpc_fleet_proto_init() {
 			}
 		}
 		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateNicRequest); i {
+			switch v := v.(*ListRackLSEsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -14054,7 +14233,7 @@ func file_infra_unifiedfleet_api_v1_rpc_fleet_proto_init() {
 			}
 		}
 		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateNicRequest); i {
+			switch v := v.(*DeleteRackLSERequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -14066,6 +14245,30 @@ func file_infra_unifiedfleet_api_v1_rpc_fleet_proto_init() {
 			}
 		}
 		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateNicRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateNicRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetNicRequest); i {
 			case 0:
 				return &v.state
@@ -14077,7 +14280,7 @@ func file_infra_unifiedfleet_api_v1_rpc_fleet_proto_init() {
 				return nil
 			}
 		}
-		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ListNicsRequest); i {
 			case 0:
 				return &v.state
@@ -14089,7 +14292,7 @@ func file_infra_unifiedfleet_api_v1_rpc_fleet_proto_init() {
 				return nil
 			}
 		}
-		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ListNicsResponse); i {
 			case 0:
 				return &v.state
@@ -14101,7 +14304,7 @@ func file_infra_unifiedfleet_api_v1_rpc_fleet_proto_init() {
 				return nil
 			}
 		}
-		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DeleteNicRequest); i {
 			case 0:
 				return &v.state
@@ -14113,7 +14316,7 @@ func file_infra_unifiedfleet_api_v1_rpc_fleet_proto_init() {
 				return nil
 			}
 		}
-		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ImportNicsRequest); i {
 			case 0:
 				return &v.state
@@ -14125,7 +14328,7 @@ func file_infra_unifiedfleet_api_v1_rpc_fleet_proto_init() {
 				return nil
 			}
 		}
-		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RenameNicRequest); i {
 			case 0:
 				return &v.state
@@ -14137,7 +14340,7 @@ func file_infra_unifiedfleet_api_v1_rpc_fleet_proto_init() {
 				return nil
 			}
 		}
-		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
+		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RenameSwitchRequest); i {
 			case 0:
 				return &v.state
@@ -14149,7 +14352,7 @@ func file_infra_unifiedfleet_api_v1_rpc_fleet_proto_init() {
 				return nil
 			}
 		}
-		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
+		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ImportDatacentersRequest); i {
 			case 0:
 				return &v.state
@@ -14161,7 +14364,7 @@ func file_infra_unifiedfleet_api_v1_rpc_fleet_proto_init() {
 				return nil
 			}
 		}
-		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
+		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CreateKVMRequest); i {
 			case 0:
 				return &v.state
@@ -14173,7 +14376,7 @@ func file_infra_unifiedfleet_api_v1_rpc_fleet_proto_init() {
 				return nil
 			}
 		}
-		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
+		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UpdateKVMRequest); i {
 			case 0:
 				return &v.state
@@ -14185,7 +14388,7 @@ func file_infra_unifiedfleet_api_v1_rpc_fleet_proto_init() {
 				return nil
 			}
 		}
-		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
+		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetKVMRequest); i {
 			case 0:
 				return &v.state
@@ -14197,7 +14400,7 @@ func file_infra_unifiedfleet_api_v1_rpc_fleet_proto_init() {
 				return nil
 			}
 		}
-		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
+		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ListKVMsRequest); i {
 			case 0:
 				return &v.state
@@ -14209,7 +14412,7 @@ func file_infra_unifiedfleet_api_v1_rpc_fleet_proto_init() {
 				return nil
 			}
 		}
-		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
+		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[86].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ListKVMsResponse); i {
 			case 0:
 				return &v.state
@@ -14221,7 +14424,7 @@ func file_infra_unifiedfleet_api_v1_rpc_fleet_proto_init() {
 				return nil
 			}
 		}
-		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
+		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DeleteKVMRequest); i {
 			case 0:
 				return &v.state
@@ -14233,7 +14436,7 @@ func file_infra_unifiedfleet_api_v1_rpc_fleet_proto_init() {
 				return nil
 			}
 		}
-		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[86].Exporter = func(v interface{}, i int) interface{} {
+		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[88].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CreateRPMRequest); i {
 			case 0:
 				return &v.state
@@ -14245,7 +14448,7 @@ func file_infra_unifiedfleet_api_v1_rpc_fleet_proto_init() {
 				return nil
 			}
 		}
-		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
+		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[89].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UpdateRPMRequest); i {
 			case 0:
 				return &v.state
@@ -14257,7 +14460,7 @@ func file_infra_unifiedfleet_api_v1_rpc_fleet_proto_init() {
 				return nil
 			}
 		}
-		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[88].Exporter = func(v interface{}, i int) interface{} {
+		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[90].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetRPMRequest); i {
 			case 0:
 				return &v.state
@@ -14269,7 +14472,7 @@ func file_infra_unifiedfleet_api_v1_rpc_fleet_proto_init() {
 				return nil
 			}
 		}
-		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[89].Exporter = func(v interface{}, i int) interface{} {
+		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[91].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ListRPMsRequest); i {
 			case 0:
 				return &v.state
@@ -14281,7 +14484,7 @@ func file_infra_unifiedfleet_api_v1_rpc_fleet_proto_init() {
 				return nil
 			}
 		}
-		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[90].Exporter = func(v interface{}, i int) interface{} {
+		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[92].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ListRPMsResponse); i {
 			case 0:
 				return &v.state
@@ -14293,7 +14496,7 @@ func file_infra_unifiedfleet_api_v1_rpc_fleet_proto_init() {
 				return nil
 			}
 		}
-		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[91].Exporter = func(v interface{}, i int) interface{} {
+		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[93].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DeleteRPMRequest); i {
 			case 0:
 				return &v.state
@@ -14305,7 +14508,7 @@ func file_infra_unifiedfleet_api_v1_rpc_fleet_proto_init() {
 				return nil
 			}
 		}
-		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[92].Exporter = func(v interface{}, i int) interface{} {
+		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[94].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CreateDracRequest); i {
 			case 0:
 				return &v.state
@@ -14317,7 +14520,7 @@ func file_infra_unifiedfleet_api_v1_rpc_fleet_proto_init() {
 				return nil
 			}
 		}
-		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[93].Exporter = func(v interface{}, i int) interface{} {
+		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[95].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UpdateDracRequest); i {
 			case 0:
 				return &v.state
@@ -14329,7 +14532,7 @@ func file_infra_unifiedfleet_api_v1_rpc_fleet_proto_init() {
 				return nil
 			}
 		}
-		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[94].Exporter = func(v interface{}, i int) interface{} {
+		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[96].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetDracRequest); i {
 			case 0:
 				return &v.state
@@ -14341,7 +14544,7 @@ func file_infra_unifiedfleet_api_v1_rpc_fleet_proto_init() {
 				return nil
 			}
 		}
-		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[95].Exporter = func(v interface{}, i int) interface{} {
+		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[97].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ListDracsRequest); i {
 			case 0:
 				return &v.state
@@ -14353,7 +14556,7 @@ func file_infra_unifiedfleet_api_v1_rpc_fleet_proto_init() {
 				return nil
 			}
 		}
-		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[96].Exporter = func(v interface{}, i int) interface{} {
+		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[98].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ListDracsResponse); i {
 			case 0:
 				return &v.state
@@ -14365,7 +14568,7 @@ func file_infra_unifiedfleet_api_v1_rpc_fleet_proto_init() {
 				return nil
 			}
 		}
-		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[97].Exporter = func(v interface{}, i int) interface{} {
+		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[99].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DeleteDracRequest); i {
 			case 0:
 				return &v.state
@@ -14377,7 +14580,7 @@ func file_infra_unifiedfleet_api_v1_rpc_fleet_proto_init() {
 				return nil
 			}
 		}
-		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[98].Exporter = func(v interface{}, i int) interface{} {
+		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[100].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CreateSwitchRequest); i {
 			case 0:
 				return &v.state
@@ -14389,7 +14592,7 @@ func file_infra_unifiedfleet_api_v1_rpc_fleet_proto_init() {
 				return nil
 			}
 		}
-		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[99].Exporter = func(v interface{}, i int) interface{} {
+		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[101].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UpdateSwitchRequest); i {
 			case 0:
 				return &v.state
@@ -14401,7 +14604,7 @@ func file_infra_unifiedfleet_api_v1_rpc_fleet_proto_init() {
 				return nil
 			}
 		}
-		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[100].Exporter = func(v interface{}, i int) interface{} {
+		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[102].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetSwitchRequest); i {
 			case 0:
 				return &v.state
@@ -14413,7 +14616,7 @@ func file_infra_unifiedfleet_api_v1_rpc_fleet_proto_init() {
 				return nil
 			}
 		}
-		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[101].Exporter = func(v interface{}, i int) interface{} {
+		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[103].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ListSwitchesRequest); i {
 			case 0:
 				return &v.state
@@ -14425,7 +14628,7 @@ func file_infra_unifiedfleet_api_v1_rpc_fleet_proto_init() {
 				return nil
 			}
 		}
-		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[102].Exporter = func(v interface{}, i int) interface{} {
+		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[104].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ListSwitchesResponse); i {
 			case 0:
 				return &v.state
@@ -14437,7 +14640,7 @@ func file_infra_unifiedfleet_api_v1_rpc_fleet_proto_init() {
 				return nil
 			}
 		}
-		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[103].Exporter = func(v interface{}, i int) interface{} {
+		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[105].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DeleteSwitchRequest); i {
 			case 0:
 				return &v.state
@@ -14449,7 +14652,7 @@ func file_infra_unifiedfleet_api_v1_rpc_fleet_proto_init() {
 				return nil
 			}
 		}
-		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[104].Exporter = func(v interface{}, i int) interface{} {
+		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[106].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CreateVlanRequest); i {
 			case 0:
 				return &v.state
@@ -14461,7 +14664,7 @@ func file_infra_unifiedfleet_api_v1_rpc_fleet_proto_init() {
 				return nil
 			}
 		}
-		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[105].Exporter = func(v interface{}, i int) interface{} {
+		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[107].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UpdateVlanRequest); i {
 			case 0:
 				return &v.state
@@ -14473,7 +14676,7 @@ func file_infra_unifiedfleet_api_v1_rpc_fleet_proto_init() {
 				return nil
 			}
 		}
-		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[106].Exporter = func(v interface{}, i int) interface{} {
+		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[108].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetVlanRequest); i {
 			case 0:
 				return &v.state
@@ -14485,7 +14688,7 @@ func file_infra_unifiedfleet_api_v1_rpc_fleet_proto_init() {
 				return nil
 			}
 		}
-		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[107].Exporter = func(v interface{}, i int) interface{} {
+		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[109].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ListVlansRequest); i {
 			case 0:
 				return &v.state
@@ -14497,7 +14700,7 @@ func file_infra_unifiedfleet_api_v1_rpc_fleet_proto_init() {
 				return nil
 			}
 		}
-		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[108].Exporter = func(v interface{}, i int) interface{} {
+		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[110].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ListVlansResponse); i {
 			case 0:
 				return &v.state
@@ -14509,7 +14712,7 @@ func file_infra_unifiedfleet_api_v1_rpc_fleet_proto_init() {
 				return nil
 			}
 		}
-		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[109].Exporter = func(v interface{}, i int) interface{} {
+		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[111].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DeleteVlanRequest); i {
 			case 0:
 				return &v.state
@@ -14521,7 +14724,7 @@ func file_infra_unifiedfleet_api_v1_rpc_fleet_proto_init() {
 				return nil
 			}
 		}
-		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[110].Exporter = func(v interface{}, i int) interface{} {
+		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[112].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ImportVlansRequest); i {
 			case 0:
 				return &v.state
@@ -14533,7 +14736,7 @@ func file_infra_unifiedfleet_api_v1_rpc_fleet_proto_init() {
 				return nil
 			}
 		}
-		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[111].Exporter = func(v interface{}, i int) interface{} {
+		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[113].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ImportOSVlansRequest); i {
 			case 0:
 				return &v.state
@@ -14545,7 +14748,7 @@ func file_infra_unifiedfleet_api_v1_rpc_fleet_proto_init() {
 				return nil
 			}
 		}
-		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[112].Exporter = func(v interface{}, i int) interface{} {
+		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[114].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ImportStatesRequest); i {
 			case 0:
 				return &v.state
@@ -14557,7 +14760,7 @@ func file_infra_unifiedfleet_api_v1_rpc_fleet_proto_init() {
 				return nil
 			}
 		}
-		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[113].Exporter = func(v interface{}, i int) interface{} {
+		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[115].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetStateRequest); i {
 			case 0:
 				return &v.state
@@ -14569,7 +14772,7 @@ func file_infra_unifiedfleet_api_v1_rpc_fleet_proto_init() {
 				return nil
 			}
 		}
-		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[114].Exporter = func(v interface{}, i int) interface{} {
+		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[116].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetDutStateRequest); i {
 			case 0:
 				return &v.state
@@ -14581,7 +14784,7 @@ func file_infra_unifiedfleet_api_v1_rpc_fleet_proto_init() {
 				return nil
 			}
 		}
-		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[115].Exporter = func(v interface{}, i int) interface{} {
+		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[117].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ListDutStatesRequest); i {
 			case 0:
 				return &v.state
@@ -14593,7 +14796,7 @@ func file_infra_unifiedfleet_api_v1_rpc_fleet_proto_init() {
 				return nil
 			}
 		}
-		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[116].Exporter = func(v interface{}, i int) interface{} {
+		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[118].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ListDutStatesResponse); i {
 			case 0:
 				return &v.state
@@ -14605,7 +14808,7 @@ func file_infra_unifiedfleet_api_v1_rpc_fleet_proto_init() {
 				return nil
 			}
 		}
-		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[117].Exporter = func(v interface{}, i int) interface{} {
+		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[119].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UpdateStateRequest); i {
 			case 0:
 				return &v.state
@@ -14617,7 +14820,7 @@ func file_infra_unifiedfleet_api_v1_rpc_fleet_proto_init() {
 				return nil
 			}
 		}
-		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[118].Exporter = func(v interface{}, i int) interface{} {
+		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[120].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UpdateDutStateRequest); i {
 			case 0:
 				return &v.state
@@ -14629,7 +14832,7 @@ func file_infra_unifiedfleet_api_v1_rpc_fleet_proto_init() {
 				return nil
 			}
 		}
-		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[119].Exporter = func(v interface{}, i int) interface{} {
+		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[121].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RackRegistrationRequest); i {
 			case 0:
 				return &v.state
@@ -14641,7 +14844,7 @@ func file_infra_unifiedfleet_api_v1_rpc_fleet_proto_init() {
 				return nil
 			}
 		}
-		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[120].Exporter = func(v interface{}, i int) interface{} {
+		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[122].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*NetworkOption); i {
 			case 0:
 				return &v.state
@@ -14653,7 +14856,7 @@ func file_infra_unifiedfleet_api_v1_rpc_fleet_proto_init() {
 				return nil
 			}
 		}
-		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[121].Exporter = func(v interface{}, i int) interface{} {
+		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[123].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CreateAssetRequest); i {
 			case 0:
 				return &v.state
@@ -14665,7 +14868,7 @@ func file_infra_unifiedfleet_api_v1_rpc_fleet_proto_init() {
 				return nil
 			}
 		}
-		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[122].Exporter = func(v interface{}, i int) interface{} {
+		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[124].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UpdateAssetRequest); i {
 			case 0:
 				return &v.state
@@ -14677,7 +14880,7 @@ func file_infra_unifiedfleet_api_v1_rpc_fleet_proto_init() {
 				return nil
 			}
 		}
-		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[123].Exporter = func(v interface{}, i int) interface{} {
+		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[125].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetAssetRequest); i {
 			case 0:
 				return &v.state
@@ -14689,7 +14892,7 @@ func file_infra_unifiedfleet_api_v1_rpc_fleet_proto_init() {
 				return nil
 			}
 		}
-		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[124].Exporter = func(v interface{}, i int) interface{} {
+		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[126].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ListAssetsRequest); i {
 			case 0:
 				return &v.state
@@ -14701,7 +14904,7 @@ func file_infra_unifiedfleet_api_v1_rpc_fleet_proto_init() {
 				return nil
 			}
 		}
-		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[125].Exporter = func(v interface{}, i int) interface{} {
+		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[127].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ListAssetsResponse); i {
 			case 0:
 				return &v.state
@@ -14713,7 +14916,7 @@ func file_infra_unifiedfleet_api_v1_rpc_fleet_proto_init() {
 				return nil
 			}
 		}
-		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[126].Exporter = func(v interface{}, i int) interface{} {
+		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[128].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DeleteAssetRequest); i {
 			case 0:
 				return &v.state
@@ -14725,7 +14928,7 @@ func file_infra_unifiedfleet_api_v1_rpc_fleet_proto_init() {
 				return nil
 			}
 		}
-		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[127].Exporter = func(v interface{}, i int) interface{} {
+		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[129].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RenameAssetRequest); i {
 			case 0:
 				return &v.state
@@ -14737,7 +14940,7 @@ func file_infra_unifiedfleet_api_v1_rpc_fleet_proto_init() {
 				return nil
 			}
 		}
-		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[128].Exporter = func(v interface{}, i int) interface{} {
+		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[130].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*BatchGetKVMsRequest); i {
 			case 0:
 				return &v.state
@@ -14749,7 +14952,7 @@ func file_infra_unifiedfleet_api_v1_rpc_fleet_proto_init() {
 				return nil
 			}
 		}
-		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[129].Exporter = func(v interface{}, i int) interface{} {
+		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[131].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*BatchGetKVMsResponse); i {
 			case 0:
 				return &v.state
@@ -14761,7 +14964,7 @@ func file_infra_unifiedfleet_api_v1_rpc_fleet_proto_init() {
 				return nil
 			}
 		}
-		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[130].Exporter = func(v interface{}, i int) interface{} {
+		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[132].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*BatchGetDHCPConfigsRequest); i {
 			case 0:
 				return &v.state
@@ -14773,7 +14976,7 @@ func file_infra_unifiedfleet_api_v1_rpc_fleet_proto_init() {
 				return nil
 			}
 		}
-		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[131].Exporter = func(v interface{}, i int) interface{} {
+		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[133].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*BatchGetDHCPConfigsResponse); i {
 			case 0:
 				return &v.state
@@ -14785,7 +14988,7 @@ func file_infra_unifiedfleet_api_v1_rpc_fleet_proto_init() {
 				return nil
 			}
 		}
-		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[132].Exporter = func(v interface{}, i int) interface{} {
+		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[134].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*BatchGetMachineLSEsRequest); i {
 			case 0:
 				return &v.state
@@ -14797,7 +15000,7 @@ func file_infra_unifiedfleet_api_v1_rpc_fleet_proto_init() {
 				return nil
 			}
 		}
-		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[133].Exporter = func(v interface{}, i int) interface{} {
+		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[135].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*BatchGetMachineLSEsResponse); i {
 			case 0:
 				return &v.state
@@ -14809,7 +15012,7 @@ func file_infra_unifiedfleet_api_v1_rpc_fleet_proto_init() {
 				return nil
 			}
 		}
-		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[134].Exporter = func(v interface{}, i int) interface{} {
+		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[136].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*BatchGetMachinesRequest); i {
 			case 0:
 				return &v.state
@@ -14821,7 +15024,7 @@ func file_infra_unifiedfleet_api_v1_rpc_fleet_proto_init() {
 				return nil
 			}
 		}
-		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[135].Exporter = func(v interface{}, i int) interface{} {
+		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[137].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*BatchGetMachinesResponse); i {
 			case 0:
 				return &v.state
@@ -14833,7 +15036,7 @@ func file_infra_unifiedfleet_api_v1_rpc_fleet_proto_init() {
 				return nil
 			}
 		}
-		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[136].Exporter = func(v interface{}, i int) interface{} {
+		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[138].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*BatchGetSwitchesRequest); i {
 			case 0:
 				return &v.state
@@ -14845,7 +15048,7 @@ func file_infra_unifiedfleet_api_v1_rpc_fleet_proto_init() {
 				return nil
 			}
 		}
-		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[137].Exporter = func(v interface{}, i int) interface{} {
+		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[139].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*BatchGetSwitchesResponse); i {
 			case 0:
 				return &v.state
@@ -14857,7 +15060,7 @@ func file_infra_unifiedfleet_api_v1_rpc_fleet_proto_init() {
 				return nil
 			}
 		}
-		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[138].Exporter = func(v interface{}, i int) interface{} {
+		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[140].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*BatchGetRPMsRequest); i {
 			case 0:
 				return &v.state
@@ -14869,7 +15072,7 @@ func file_infra_unifiedfleet_api_v1_rpc_fleet_proto_init() {
 				return nil
 			}
 		}
-		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[139].Exporter = func(v interface{}, i int) interface{} {
+		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[141].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*BatchGetRPMsResponse); i {
 			case 0:
 				return &v.state
@@ -14881,7 +15084,7 @@ func file_infra_unifiedfleet_api_v1_rpc_fleet_proto_init() {
 				return nil
 			}
 		}
-		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[140].Exporter = func(v interface{}, i int) interface{} {
+		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[142].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*BatchGetDracsRequest); i {
 			case 0:
 				return &v.state
@@ -14893,7 +15096,7 @@ func file_infra_unifiedfleet_api_v1_rpc_fleet_proto_init() {
 				return nil
 			}
 		}
-		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[141].Exporter = func(v interface{}, i int) interface{} {
+		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[143].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*BatchGetDracsResponse); i {
 			case 0:
 				return &v.state
@@ -14905,7 +15108,7 @@ func file_infra_unifiedfleet_api_v1_rpc_fleet_proto_init() {
 				return nil
 			}
 		}
-		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[142].Exporter = func(v interface{}, i int) interface{} {
+		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[144].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*BatchGetNicsRequest); i {
 			case 0:
 				return &v.state
@@ -14917,7 +15120,7 @@ func file_infra_unifiedfleet_api_v1_rpc_fleet_proto_init() {
 				return nil
 			}
 		}
-		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[143].Exporter = func(v interface{}, i int) interface{} {
+		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[145].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*BatchGetNicsResponse); i {
 			case 0:
 				return &v.state
@@ -14929,7 +15132,7 @@ func file_infra_unifiedfleet_api_v1_rpc_fleet_proto_init() {
 				return nil
 			}
 		}
-		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[144].Exporter = func(v interface{}, i int) interface{} {
+		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[146].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*BatchGetVMsRequest); i {
 			case 0:
 				return &v.state
@@ -14941,7 +15144,7 @@ func file_infra_unifiedfleet_api_v1_rpc_fleet_proto_init() {
 				return nil
 			}
 		}
-		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[145].Exporter = func(v interface{}, i int) interface{} {
+		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[147].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*BatchGetVMsResponse); i {
 			case 0:
 				return &v.state
@@ -14953,7 +15156,7 @@ func file_infra_unifiedfleet_api_v1_rpc_fleet_proto_init() {
 				return nil
 			}
 		}
-		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[146].Exporter = func(v interface{}, i int) interface{} {
+		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[148].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*BatchGetVlansRequest); i {
 			case 0:
 				return &v.state
@@ -14965,7 +15168,7 @@ func file_infra_unifiedfleet_api_v1_rpc_fleet_proto_init() {
 				return nil
 			}
 		}
-		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[147].Exporter = func(v interface{}, i int) interface{} {
+		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[149].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*BatchGetVlansResponse); i {
 			case 0:
 				return &v.state
@@ -14977,7 +15180,7 @@ func file_infra_unifiedfleet_api_v1_rpc_fleet_proto_init() {
 				return nil
 			}
 		}
-		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[148].Exporter = func(v interface{}, i int) interface{} {
+		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[150].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*BatchGetRacksRequest); i {
 			case 0:
 				return &v.state
@@ -14989,7 +15192,7 @@ func file_infra_unifiedfleet_api_v1_rpc_fleet_proto_init() {
 				return nil
 			}
 		}
-		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[149].Exporter = func(v interface{}, i int) interface{} {
+		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[151].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*BatchGetRacksResponse); i {
 			case 0:
 				return &v.state
@@ -15001,7 +15204,7 @@ func file_infra_unifiedfleet_api_v1_rpc_fleet_proto_init() {
 				return nil
 			}
 		}
-		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[150].Exporter = func(v interface{}, i int) interface{} {
+		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[152].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*BatchGetChromePlatformsRequest); i {
 			case 0:
 				return &v.state
@@ -15013,7 +15216,7 @@ func file_infra_unifiedfleet_api_v1_rpc_fleet_proto_init() {
 				return nil
 			}
 		}
-		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[151].Exporter = func(v interface{}, i int) interface{} {
+		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[153].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*BatchGetChromePlatformsResponse); i {
 			case 0:
 				return &v.state
@@ -15025,7 +15228,7 @@ func file_infra_unifiedfleet_api_v1_rpc_fleet_proto_init() {
 				return nil
 			}
 		}
-		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[152].Exporter = func(v interface{}, i int) interface{} {
+		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[154].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*BatchGetMachineLSEPrototypesRequest); i {
 			case 0:
 				return &v.state
@@ -15037,7 +15240,7 @@ func file_infra_unifiedfleet_api_v1_rpc_fleet_proto_init() {
 				return nil
 			}
 		}
-		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[153].Exporter = func(v interface{}, i int) interface{} {
+		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[155].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*BatchGetMachineLSEPrototypesResponse); i {
 			case 0:
 				return &v.state
@@ -15049,7 +15252,7 @@ func file_infra_unifiedfleet_api_v1_rpc_fleet_proto_init() {
 				return nil
 			}
 		}
-		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[154].Exporter = func(v interface{}, i int) interface{} {
+		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[156].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*BatchGetRackLSEPrototypesRequest); i {
 			case 0:
 				return &v.state
@@ -15061,7 +15264,7 @@ func file_infra_unifiedfleet_api_v1_rpc_fleet_proto_init() {
 				return nil
 			}
 		}
-		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[155].Exporter = func(v interface{}, i int) interface{} {
+		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[157].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*BatchGetRackLSEPrototypesResponse); i {
 			case 0:
 				return &v.state
@@ -15073,7 +15276,7 @@ func file_infra_unifiedfleet_api_v1_rpc_fleet_proto_init() {
 				return nil
 			}
 		}
-		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[156].Exporter = func(v interface{}, i int) interface{} {
+		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[158].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetChromeOSDeviceDataRequest); i {
 			case 0:
 				return &v.state
@@ -15085,7 +15288,7 @@ func file_infra_unifiedfleet_api_v1_rpc_fleet_proto_init() {
 				return nil
 			}
 		}
-		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[157].Exporter = func(v interface{}, i int) interface{} {
+		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[159].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CreateCachingServiceRequest); i {
 			case 0:
 				return &v.state
@@ -15097,7 +15300,7 @@ func file_infra_unifiedfleet_api_v1_rpc_fleet_proto_init() {
 				return nil
 			}
 		}
-		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[158].Exporter = func(v interface{}, i int) interface{} {
+		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[160].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UpdateCachingServiceRequest); i {
 			case 0:
 				return &v.state
@@ -15109,7 +15312,7 @@ func file_infra_unifiedfleet_api_v1_rpc_fleet_proto_init() {
 				return nil
 			}
 		}
-		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[159].Exporter = func(v interface{}, i int) interface{} {
+		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[161].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetCachingServiceRequest); i {
 			case 0:
 				return &v.state
@@ -15121,7 +15324,7 @@ func file_infra_unifiedfleet_api_v1_rpc_fleet_proto_init() {
 				return nil
 			}
 		}
-		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[160].Exporter = func(v interface{}, i int) interface{} {
+		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[162].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ListCachingServicesRequest); i {
 			case 0:
 				return &v.state
@@ -15133,7 +15336,7 @@ func file_infra_unifiedfleet_api_v1_rpc_fleet_proto_init() {
 				return nil
 			}
 		}
-		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[161].Exporter = func(v interface{}, i int) interface{} {
+		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[163].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ListCachingServicesResponse); i {
 			case 0:
 				return &v.state
@@ -15145,7 +15348,7 @@ func file_infra_unifiedfleet_api_v1_rpc_fleet_proto_init() {
 				return nil
 			}
 		}
-		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[162].Exporter = func(v interface{}, i int) interface{} {
+		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[164].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DeleteCachingServiceRequest); i {
 			case 0:
 				return &v.state
@@ -15157,7 +15360,7 @@ func file_infra_unifiedfleet_api_v1_rpc_fleet_proto_init() {
 				return nil
 			}
 		}
-		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[163].Exporter = func(v interface{}, i int) interface{} {
+		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[165].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CreateSchedulingUnitRequest); i {
 			case 0:
 				return &v.state
@@ -15169,7 +15372,7 @@ func file_infra_unifiedfleet_api_v1_rpc_fleet_proto_init() {
 				return nil
 			}
 		}
-		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[164].Exporter = func(v interface{}, i int) interface{} {
+		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[166].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UpdateSchedulingUnitRequest); i {
 			case 0:
 				return &v.state
@@ -15181,7 +15384,7 @@ func file_infra_unifiedfleet_api_v1_rpc_fleet_proto_init() {
 				return nil
 			}
 		}
-		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[165].Exporter = func(v interface{}, i int) interface{} {
+		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[167].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetSchedulingUnitRequest); i {
 			case 0:
 				return &v.state
@@ -15193,7 +15396,7 @@ func file_infra_unifiedfleet_api_v1_rpc_fleet_proto_init() {
 				return nil
 			}
 		}
-		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[166].Exporter = func(v interface{}, i int) interface{} {
+		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[168].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ListSchedulingUnitsRequest); i {
 			case 0:
 				return &v.state
@@ -15205,7 +15408,7 @@ func file_infra_unifiedfleet_api_v1_rpc_fleet_proto_init() {
 				return nil
 			}
 		}
-		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[167].Exporter = func(v interface{}, i int) interface{} {
+		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[169].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ListSchedulingUnitsResponse); i {
 			case 0:
 				return &v.state
@@ -15217,7 +15420,7 @@ func file_infra_unifiedfleet_api_v1_rpc_fleet_proto_init() {
 				return nil
 			}
 		}
-		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[168].Exporter = func(v interface{}, i int) interface{} {
+		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[170].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DeleteSchedulingUnitRequest); i {
 			case 0:
 				return &v.state
@@ -15229,7 +15432,7 @@ func file_infra_unifiedfleet_api_v1_rpc_fleet_proto_init() {
 				return nil
 			}
 		}
-		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[169].Exporter = func(v interface{}, i int) interface{} {
+		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[171].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UpdateConfigBundleRequest); i {
 			case 0:
 				return &v.state
@@ -15241,7 +15444,7 @@ func file_infra_unifiedfleet_api_v1_rpc_fleet_proto_init() {
 				return nil
 			}
 		}
-		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[170].Exporter = func(v interface{}, i int) interface{} {
+		file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[172].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UpdateConfigBundleResponse); i {
 			case 0:
 				return &v.state
@@ -15266,31 +15469,31 @@ func file_infra_unifiedfleet_api_v1_rpc_fleet_proto_init() {
 		(*ImportMachinesRequest_MachineDbSource)(nil),
 		(*ImportMachinesRequest_ConfigSource)(nil),
 	}
-	file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[62].OneofWrappers = []interface{}{
+	file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[64].OneofWrappers = []interface{}{
 		(*ImportMachineLSEsRequest_MachineDbSource)(nil),
 		(*ImportMachineLSEsRequest_ConfigSource)(nil),
 	}
-	file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[63].OneofWrappers = []interface{}{
+	file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[65].OneofWrappers = []interface{}{
 		(*ImportOSMachineLSEsRequest_MachineDbSource)(nil),
 		(*ImportOSMachineLSEsRequest_ConfigSource)(nil),
 	}
-	file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[76].OneofWrappers = []interface{}{
+	file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[78].OneofWrappers = []interface{}{
 		(*ImportNicsRequest_MachineDbSource)(nil),
 		(*ImportNicsRequest_ConfigSource)(nil),
 	}
-	file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[79].OneofWrappers = []interface{}{
+	file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[81].OneofWrappers = []interface{}{
 		(*ImportDatacentersRequest_MachineDbSource)(nil),
 		(*ImportDatacentersRequest_ConfigSource)(nil),
 	}
-	file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[110].OneofWrappers = []interface{}{
+	file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[112].OneofWrappers = []interface{}{
 		(*ImportVlansRequest_MachineDbSource)(nil),
 		(*ImportVlansRequest_ConfigSource)(nil),
 	}
-	file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[111].OneofWrappers = []interface{}{
+	file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[113].OneofWrappers = []interface{}{
 		(*ImportOSVlansRequest_MachineDbSource)(nil),
 		(*ImportOSVlansRequest_ConfigSource)(nil),
 	}
-	file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[112].OneofWrappers = []interface{}{
+	file_infra_unifiedfleet_api_v1_rpc_fleet_proto_msgTypes[114].OneofWrappers = []interface{}{
 		(*ImportStatesRequest_MachineDbSource)(nil),
 		(*ImportStatesRequest_ConfigSource)(nil),
 	}
@@ -15300,7 +15503,7 @@ func file_infra_unifiedfleet_api_v1_rpc_fleet_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_infra_unifiedfleet_api_v1_rpc_fleet_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   172,
+			NumMessages:   174,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -15379,6 +15582,8 @@ type FleetClient interface {
 	ImportMachines(ctx context.Context, in *ImportMachinesRequest, opts ...grpc.CallOption) (*status.Status, error)
 	// Rename rename the machine
 	RenameMachine(ctx context.Context, in *RenameMachineRequest, opts ...grpc.CallOption) (*models.Machine, error)
+	// Restore a deleted machine within the retention window
+	RestoreMachine(ctx context.Context, in *RestoreMachineRequest, opts ...grpc.CallOption) (*models.Machine, error)
 	// RackRegistration creates a new rack/kvms/rpms/switches
 	RackRegistration(ctx context.Context, in *RackRegistrationRequest, opts ...grpc.CallOption) (*models.Rack, error)
 	// Update updates the rack
@@ -15401,6 +15606,8 @@ type FleetClient interface {
 	DeleteMachineLSE(ctx context.Context, in *DeleteMachineLSERequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// Rename the machine lse
 	RenameMachineLSE(ctx context.Context, in *RenameMachineLSERequest, opts ...grpc.CallOption) (*models.MachineLSE, error)
+	// Restore a deleted machineLSE within the retention window
+	RestoreMachineLSE(ctx context.Context, in *RestoreMachineLSERequest, opts ...grpc.CallOption) (*models.MachineLSE, error)
 	// ImportMachineLSEs imports machine LSEs & all related infos.
 	ImportMachineLSEs(ctx context.Context, in *ImportMachineLSEsRequest, opts ...grpc.CallOption) (*status.Status, error)
 	// ImportOSMachineLSEs imports ChromeOS machine LSEs & all related infos if needed.
@@ -15821,6 +16028,15 @@ func (c *fleetPRPCClient) RenameMachine(ctx context.Context, in *RenameMachineRe
 	return out, nil
 }
 
+func (c *fleetPRPCClient) RestoreMachine(ctx context.Context, in *RestoreMachineRequest, opts ...grpc.CallOption) (*models.Machine, error) {
+	out := new(models.Machine)
+	err := c.client.Call(ctx, "unifiedfleet.api.v1.rpc.Fleet", "RestoreMachine", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *fleetPRPCClient) RackRegistration(ctx context.Context, in *RackRegistrationRequest, opts ...grpc.CallOption) (*models.Rack, error) {
 	out := new(models.Rack)
 	err := c.client.Call(ctx, "unifiedfleet.api.v1.rpc.Fleet", "RackRegistration", in, out, opts...)
@@ -15920,6 +16136,15 @@ func (c *fleetPRPCClient) RenameMachineLSE(ctx context.Context, in *RenameMachin
 	return out, nil
 }
 
+func (c *fleetPRPCClient) RestoreMachineLSE(ctx context.Context, in *RestoreMachineLSERequest, opts ...grpc.CallOption) (*models.MachineLSE, error) {
+	out := new(models.MachineLSE)
+	err := c.client.Call(ctx, "unifiedfleet.api.v1.rpc.Fleet", "RestoreMachineLSE", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *fleetPRPCClient) ImportMachineLSEs(ctx context.Context, in *ImportMachineLSEsRequest, opts ...grpc.CallOption) (*status.Status, error) {
 	out := new(status.Status)
 	err := c.client.Call(ctx, "unifiedfleet.api.v1.rpc.Fleet", "ImportMachineLSEs", in, out, opts...)
@@ -16981,6 +17206,15 @@ func (c *fleetClient) RenameMachine(ctx context.Context, in *RenameMachineReques
 	return out, nil
 }
 
+func (c *fleetClient) RestoreMachine(ctx context.Context, in *RestoreMachineRequest, opts ...grpc.CallOption) (*models.Machine, error) {
+	out := new(models.Machine)
+	err := c.cc.Invoke(ctx, "/unifiedfleet.api.v1.rpc.Fleet/RestoreMachine", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *fleetClient) RackRegistration(ctx context.Context, in *RackRegistrationRequest, opts ...grpc.CallOption) (*models.Rack, error) {
 	out := new(models.Rack)
 	err := c.cc.Invoke(ctx, "/unifiedfleet.api.v1.rpc.Fleet/RackRegistration", in, out, opts...)
@@ -17080,6 +17314,15 @@ func (c *fleetClient) RenameMachineLSE(ctx context.Context, in *RenameMachineLSE
 	return out, nil
 }
 
+func (c *fleetClient) RestoreMachineLSE(ctx context.Context, in *RestoreMachineLSERequest, opts ...grpc.CallOption) (*models.MachineLSE, error) {
+	out := new(models.MachineLSE)
+	err := c.cc.Invoke(ctx, "/unifiedfleet.api.v1.rpc.Fleet/RestoreMachineLSE", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *fleetClient) ImportMachineLSEs(ctx context.Context, in *ImportMachineLSEsRequest, opts ...grpc.CallOption) (*status.Status, error) {
 	out := new(status.Status)
 	err := c.cc.Invoke(ctx, "/unifiedfleet.api.v1.rpc.Fleet/ImportMachineLSEs", in, out, opts...)
@@ -17963,6 +18206,8 @@ type FleetServer interface {
 	ImportMachines(context.Context, *ImportMachinesRequest) (*status.Status, error)
 	// Rename rename the machine
 	RenameMachine(context.Context, *RenameMachineRequest) (*models.Machine, error)
+	// Restore a deleted machine within the retention window
+	RestoreMachine(context.Context, *RestoreMachineRequest) (*models.Machine, error)
 	// RackRegistration creates a new rack/kvms/rpms/switches
 	RackRegistration(context.Context, *RackRegistrationRequest) (*models.Rack, error)
 	// Update updates the rack
@@ -17985,6 +18230,8 @@ type FleetServer interface {
 	DeleteMachineLSE(context.Context, *DeleteMachineLSERequest) (*emptypb.Empty, error)
 	// Rename the machine lse
 	RenameMachineLSE(context.Context, *RenameMachineLSERequest) (*models.MachineLSE, error)
+	// Restore a deleted machineLSE within the retention window
+	RestoreMachineLSE(context.Context, *RestoreMachineLSERequest) (*models.MachineLSE, error)
 	// ImportMachineLSEs imports machine LSEs & all related infos.
 	ImportMachineLSEs(context.Context, *ImportMachineLSEsRequest) (*status.Status, error)
 	// ImportOSMachineLSEs imports ChromeOS machine LSEs & all related infos if needed.
@@ -18252,6 +18499,9 @@ func (*UnimplementedFleetServer) ImportMachines(context.Context, *ImportMachines
 func (*UnimplementedFleetServer) RenameMachine(context.Context, *RenameMachineRequest) (*models.Machine, error) {
 	return nil, status1.Errorf(codes.Unimplemented, "method RenameMachine not implemented")
 }
+func (*UnimplementedFleetServer) RestoreMachine(context.Context, *RestoreMachineRequest) (*models.Machine, error) {
+	return nil, status1.Errorf(codes.Unimplemented, "method RestoreMachine not implemented")
+}
 func (*UnimplementedFleetServer) RackRegistration(context.Context, *RackRegistrationRequest) (*models.Rack, error) {
 	return nil, status1.Errorf(codes.Unimplemented, "method RackRegistration not implemented")
 }
@@ -18285,6 +18535,9 @@ func (*UnimplementedFleetServer) DeleteMachineLSE(context.Context, *DeleteMachin
 func (*UnimplementedFleetServer) RenameMachineLSE(context.Context, *RenameMachineLSERequest) (*models.MachineLSE, error) {
 	return nil, status1.Errorf(codes.Unimplemented, "method RenameMachineLSE not implemented")
 }
+func (*UnimplementedFleetServer) RestoreMachineLSE(context.Context, *RestoreMachineLSERequest) (*models.MachineLSE, error) {
+	return nil, status1.Errorf(codes.Unimplemented, "method RestoreMachineLSE not implemented")
+}
 func (*UnimplementedFleetServer) ImportMachineLSEs(context.Context, *ImportMachineLSEsRequest) (*status.Status, error) {
 	return nil, status1.Errorf(codes.Unimplemented, "method ImportMachineLSEs not implemented")
 }
@@ -19016,6 +19269,24 @@ func _Fleet_RenameMachine_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Fleet_RestoreMachine_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreMachineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FleetServer).RestoreMachine(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/unifiedfleet.api.v1.rpc.Fleet/RestoreMachine",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FleetServer).RestoreMachine(ctx, req.(*RestoreMachineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Fleet_RackRegistration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(RackRegistrationRequest)
 	if err := dec(in); err != nil {
@@ -19214,6 +19485,24 @@ func _Fleet_RenameMachineLSE_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Fleet_RestoreMachineLSE_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreMachineLSERequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FleetServer).RestoreMachineLSE(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/unifiedfleet.api.v1.rpc.Fleet/RestoreMachineLSE",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FleetServer).RestoreMachineLSE(ctx, req.(*RestoreMachineLSERequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Fleet_ImportMachineLSEs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ImportMachineLSEsRequest)
 	if err := dec(in); err != nil {
@@ -20974,6 +21263,10 @@ var _Fleet_serviceDesc = grpc.ServiceDesc{
 			MethodName: "RenameMachine",
 			Handler:    _Fleet_RenameMachine_Handler,
 		},
+		{
+			MethodName: "RestoreMachine",
+			Handler:    _Fleet_RestoreMachine_Handler,
+		},
 		{
 			MethodName: "RackRegistration",
 			Handler:    _Fleet_RackRegistration_Handler,
@@ -21018,6 +21311,10 @@ var _Fleet_serviceDesc = grpc.ServiceDesc{
 			MethodName: "RenameMachineLSE",
 			Handler:    _Fleet_RenameMachineLSE_Handler,
 		},
+		{
+			MethodName: "RestoreMachineLSE",
+			Handler:    _Fleet_RestoreMachineLSE_Handler,
+		},
 		{
 			MethodName: "ImportMachineLSEs",
 			Handler:    _Fleet_ImportMachineLSEs_Handler,
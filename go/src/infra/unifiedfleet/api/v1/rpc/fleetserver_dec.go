@@ -453,6 +453,23 @@ func (s *DecoratedFleet) RenameMachine(ctx context.Context, req *RenameMachineRe
 	return
 }
 
+func (s *DecoratedFleet) RestoreMachine(ctx context.Context, req *RestoreMachineRequest) (rsp *models.Machine, err error) {
+	if s.Prelude != nil {
+		var newCtx context.Context
+		newCtx, err = s.Prelude(ctx, "RestoreMachine", req)
+		if err == nil {
+			ctx = newCtx
+		}
+	}
+	if err == nil {
+		rsp, err = s.Service.RestoreMachine(ctx, req)
+	}
+	if s.Postlude != nil {
+		err = s.Postlude(ctx, "RestoreMachine", rsp, err)
+	}
+	return
+}
+
 func (s *DecoratedFleet) RackRegistration(ctx context.Context, req *RackRegistrationRequest) (rsp *models.Rack, err error) {
 	if s.Prelude != nil {
 		var newCtx context.Context
@@ -640,6 +657,23 @@ func (s *DecoratedFleet) RenameMachineLSE(ctx context.Context, req *RenameMachin
 	return
 }
 
+func (s *DecoratedFleet) RestoreMachineLSE(ctx context.Context, req *RestoreMachineLSERequest) (rsp *models.MachineLSE, err error) {
+	if s.Prelude != nil {
+		var newCtx context.Context
+		newCtx, err = s.Prelude(ctx, "RestoreMachineLSE", req)
+		if err == nil {
+			ctx = newCtx
+		}
+	}
+	if err == nil {
+		rsp, err = s.Service.RestoreMachineLSE(ctx, req)
+	}
+	if s.Postlude != nil {
+		err = s.Postlude(ctx, "RestoreMachineLSE", rsp, err)
+	}
+	return
+}
+
 func (s *DecoratedFleet) ImportMachineLSEs(ctx context.Context, req *ImportMachineLSEsRequest) (rsp *status.Status, err error) {
 	if s.Prelude != nil {
 		var newCtx context.Context
@@ -56,6 +56,7 @@ var (
 	EmptyRackName                  string = "Invalid input - Rack name cannot be empty."
 	FilterFormat                   string = "Filter format Egs:\n" + "'machine=mac-1'\n" + "'machine=mac-1,mac-2'\n" + "'machine=mac-1 & nic=nic-1'\n" + "'machine=mac-1 & nic=nic-1 & kvm=kvm-1,kvm-2'"
 	InvalidFilterFormat            string = fmt.Sprintf("%s%s", "Invalid input - ", FilterFormat)
+	ShowDeletedWithFilter          string = "Invalid input - show_deleted cannot be used together with filter."
 )
 
 var (
@@ -386,6 +387,9 @@ func (r *ListMachinesRequest) Validate() error {
 	if err := ValidateFilter(r.Filter); err != nil {
 		return err
 	}
+	if r.ShowDeleted && r.Filter != "" {
+		return status.Errorf(codes.InvalidArgument, ShowDeletedWithFilter)
+	}
 	return validatePageSize(r.PageSize)
 }
 
@@ -394,6 +398,11 @@ func (r *DeleteMachineRequest) Validate() error {
 	return validateResourceName(machineRegex, MachineNameFormat, r.Name)
 }
 
+// Validate validates input requests of RestoreMachine.
+func (r *RestoreMachineRequest) Validate() error {
+	return validateResourceName(machineRegex, MachineNameFormat, r.Name)
+}
+
 // Validate validates input requests of RenameMachine.
 func (r *RenameMachineRequest) Validate() error {
 	if err := validateResourceName(machineRegex, MachineNameFormat, r.GetName()); err != nil {
@@ -492,6 +501,9 @@ func (r *ListMachineLSEsRequest) Validate() error {
 	if err := ValidateFilter(r.Filter); err != nil {
 		return err
 	}
+	if r.ShowDeleted && r.Filter != "" {
+		return status.Errorf(codes.InvalidArgument, ShowDeletedWithFilter)
+	}
 	return validatePageSize(r.PageSize)
 }
 
@@ -500,6 +512,11 @@ func (r *DeleteMachineLSERequest) Validate() error {
 	return validateResourceName(machineLSERegex, MachineLSENameFormat, r.Name)
 }
 
+// Validate validates input requests of RestoreMachineLSE.
+func (r *RestoreMachineLSERequest) Validate() error {
+	return validateResourceName(machineLSERegex, MachineLSENameFormat, r.Name)
+}
+
 // Validate validates input requests of CreateVM.
 func (r *CreateVMRequest) Validate() error {
 	if r.GetVm() == nil {
@@ -24,7 +24,62 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
-// Next Tag: 19
+type NamespaceEnforcement_Mode int32
+
+const (
+	// Requests without a valid namespace are served from the default
+	// datastore namespace.
+	NamespaceEnforcement_OFF NamespaceEnforcement_Mode = 0
+	// Like OFF, but the violations are logged and counted by user-agent,
+	// to find the clients to fix before enforcing.
+	NamespaceEnforcement_LOG NamespaceEnforcement_Mode = 1
+	// Requests without a valid namespace are rejected with
+	// InvalidArgument.
+	NamespaceEnforcement_ENFORCE NamespaceEnforcement_Mode = 2
+)
+
+// Enum value maps for NamespaceEnforcement_Mode.
+var (
+	NamespaceEnforcement_Mode_name = map[int32]string{
+		0: "OFF",
+		1: "LOG",
+		2: "ENFORCE",
+	}
+	NamespaceEnforcement_Mode_value = map[string]int32{
+		"OFF":     0,
+		"LOG":     1,
+		"ENFORCE": 2,
+	}
+)
+
+func (x NamespaceEnforcement_Mode) Enum() *NamespaceEnforcement_Mode {
+	p := new(NamespaceEnforcement_Mode)
+	*p = x
+	return p
+}
+
+func (x NamespaceEnforcement_Mode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (NamespaceEnforcement_Mode) Descriptor() protoreflect.EnumDescriptor {
+	return file_infra_unifiedfleet_app_config_config_proto_enumTypes[0].Descriptor()
+}
+
+func (NamespaceEnforcement_Mode) Type() protoreflect.EnumType {
+	return &file_infra_unifiedfleet_app_config_config_proto_enumTypes[0]
+}
+
+func (x NamespaceEnforcement_Mode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use NamespaceEnforcement_Mode.Descriptor instead.
+func (NamespaceEnforcement_Mode) EnumDescriptor() ([]byte, []int) {
+	return file_infra_unifiedfleet_app_config_config_proto_rawDescGZIP(), []int{2, 0}
+}
+
+// Next Tag: 21
 // Config is the configuration data served by luci-config for this app.
 type Config struct {
 	state         protoimpl.MessageState
@@ -63,6 +118,12 @@ type Config struct {
 	HwidServiceAccount string `protobuf:"bytes,17,opt,name=hwid_service_account,json=hwidServiceAccount,proto3" json:"hwid_service_account,omitempty"`
 	// Limit for throttling traffic to HWID server
 	HwidServiceTrafficRatio float32 `protobuf:"fixed32,18,opt,name=hwid_service_traffic_ratio,json=hwidServiceTrafficRatio,proto3" json:"hwid_service_traffic_ratio,omitempty"`
+	// Enforcement of the namespace set in the incoming context metadata.
+	NamespaceEnforcement *NamespaceEnforcement `protobuf:"bytes,19,opt,name=namespace_enforcement,json=namespaceEnforcement,proto3" json:"namespace_enforcement,omitempty"`
+	// Minimum client major versions required by some RPCs, keyed by full
+	// gRPC method name, e.g. "/unifiedfleet.api.v1.rpc.Fleet/GetMachine".
+	// RPCs not listed require the minimum version supported by the server.
+	MinClientMajorVersions map[string]int32 `protobuf:"bytes,20,rep,name=min_client_major_versions,json=minClientMajorVersions,proto3" json:"min_client_major_versions,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
 }
 
 func (x *Config) Reset() {
@@ -224,6 +285,20 @@ func (x *Config) GetHwidServiceTrafficRatio() float32 {
 	return 0
 }
 
+func (x *Config) GetNamespaceEnforcement() *NamespaceEnforcement {
+	if x != nil {
+		return x.NamespaceEnforcement
+	}
+	return nil
+}
+
+func (x *Config) GetMinClientMajorVersions() map[string]int32 {
+	if x != nil {
+		return x.MinClientMajorVersions
+	}
+	return nil
+}
+
 type OSNetworkConfig struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -295,6 +370,77 @@ func (x *OSNetworkConfig) GetCrosNetworkTopology() []*OSNetworkConfig_OSNetworkT
 	return nil
 }
 
+// NamespaceEnforcement configures how requests without a valid namespace
+// in the incoming context metadata are handled. Without enforcement, such
+// requests are served from the default datastore namespace.
+type NamespaceEnforcement struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Mode NamespaceEnforcement_Mode `protobuf:"varint,1,opt,name=mode,proto3,enum=ufs.config.NamespaceEnforcement_Mode" json:"mode,omitempty"`
+	// Clients whose user-agent contains any of these strings are
+	// temporarily exempt from enforcement. Their violations are logged and
+	// counted like in LOG mode.
+	ExemptUserAgents []string `protobuf:"bytes,2,rep,name=exempt_user_agents,json=exemptUserAgents,proto3" json:"exempt_user_agents,omitempty"`
+	// Modes overriding mode for some RPCs, e.g. to enforce read-only RPCs
+	// first. The first matching override applies.
+	MethodOverrides []*NamespaceEnforcement_MethodOverride `protobuf:"bytes,3,rep,name=method_overrides,json=methodOverrides,proto3" json:"method_overrides,omitempty"`
+}
+
+func (x *NamespaceEnforcement) Reset() {
+	*x = NamespaceEnforcement{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_infra_unifiedfleet_app_config_config_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NamespaceEnforcement) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NamespaceEnforcement) ProtoMessage() {}
+
+func (x *NamespaceEnforcement) ProtoReflect() protoreflect.Message {
+	mi := &file_infra_unifiedfleet_app_config_config_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NamespaceEnforcement.ProtoReflect.Descriptor instead.
+func (*NamespaceEnforcement) Descriptor() ([]byte, []int) {
+	return file_infra_unifiedfleet_app_config_config_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *NamespaceEnforcement) GetMode() NamespaceEnforcement_Mode {
+	if x != nil {
+		return x.Mode
+	}
+	return NamespaceEnforcement_OFF
+}
+
+func (x *NamespaceEnforcement) GetExemptUserAgents() []string {
+	if x != nil {
+		return x.ExemptUserAgents
+	}
+	return nil
+}
+
+func (x *NamespaceEnforcement) GetMethodOverrides() []*NamespaceEnforcement_MethodOverride {
+	if x != nil {
+		return x.MethodOverrides
+	}
+	return nil
+}
+
 type PubSub struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -311,7 +457,7 @@ type PubSub struct {
 func (x *PubSub) Reset() {
 	*x = PubSub{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_infra_unifiedfleet_app_config_config_proto_msgTypes[2]
+		mi := &file_infra_unifiedfleet_app_config_config_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -324,7 +470,7 @@ func (x *PubSub) String() string {
 func (*PubSub) ProtoMessage() {}
 
 func (x *PubSub) ProtoReflect() protoreflect.Message {
-	mi := &file_infra_unifiedfleet_app_config_config_proto_msgTypes[2]
+	mi := &file_infra_unifiedfleet_app_config_config_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -337,7 +483,7 @@ func (x *PubSub) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PubSub.ProtoReflect.Descriptor instead.
 func (*PubSub) Descriptor() ([]byte, []int) {
-	return file_infra_unifiedfleet_app_config_config_proto_rawDescGZIP(), []int{2}
+	return file_infra_unifiedfleet_app_config_config_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *PubSub) GetProject() string {
@@ -374,7 +520,7 @@ type OSNetworkConfig_OSNetworkTopology struct {
 func (x *OSNetworkConfig_OSNetworkTopology) Reset() {
 	*x = OSNetworkConfig_OSNetworkTopology{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_infra_unifiedfleet_app_config_config_proto_msgTypes[3]
+		mi := &file_infra_unifiedfleet_app_config_config_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -387,7 +533,7 @@ func (x *OSNetworkConfig_OSNetworkTopology) String() string {
 func (*OSNetworkConfig_OSNetworkTopology) ProtoMessage() {}
 
 func (x *OSNetworkConfig_OSNetworkTopology) ProtoReflect() protoreflect.Message {
-	mi := &file_infra_unifiedfleet_app_config_config_proto_msgTypes[3]
+	mi := &file_infra_unifiedfleet_app_config_config_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -424,13 +570,70 @@ func (x *OSNetworkConfig_OSNetworkTopology) GetSheetId() string {
 	return ""
 }
 
+type NamespaceEnforcement_MethodOverride struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The RPC name, e.g. "GetMachine", or a prefix of RPC names ending
+	// with "*", e.g. "List*".
+	Method string                    `protobuf:"bytes,1,opt,name=method,proto3" json:"method,omitempty"`
+	Mode   NamespaceEnforcement_Mode `protobuf:"varint,2,opt,name=mode,proto3,enum=ufs.config.NamespaceEnforcement_Mode" json:"mode,omitempty"`
+}
+
+func (x *NamespaceEnforcement_MethodOverride) Reset() {
+	*x = NamespaceEnforcement_MethodOverride{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_infra_unifiedfleet_app_config_config_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NamespaceEnforcement_MethodOverride) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NamespaceEnforcement_MethodOverride) ProtoMessage() {}
+
+func (x *NamespaceEnforcement_MethodOverride) ProtoReflect() protoreflect.Message {
+	mi := &file_infra_unifiedfleet_app_config_config_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NamespaceEnforcement_MethodOverride.ProtoReflect.Descriptor instead.
+func (*NamespaceEnforcement_MethodOverride) Descriptor() ([]byte, []int) {
+	return file_infra_unifiedfleet_app_config_config_proto_rawDescGZIP(), []int{2, 0}
+}
+
+func (x *NamespaceEnforcement_MethodOverride) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *NamespaceEnforcement_MethodOverride) GetMode() NamespaceEnforcement_Mode {
+	if x != nil {
+		return x.Mode
+	}
+	return NamespaceEnforcement_OFF
+}
+
 var File_infra_unifiedfleet_app_config_config_proto protoreflect.FileDescriptor
 
 var file_infra_unifiedfleet_app_config_config_proto_rawDesc = []byte{
 	0x0a, 0x2a, 0x69, 0x6e, 0x66, 0x72, 0x61, 0x2f, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66,
 	0x6c, 0x65, 0x65, 0x74, 0x2f, 0x61, 0x70, 0x70, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2f,
 	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0a, 0x75, 0x66,
-	0x73, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0xd1, 0x07, 0x0a, 0x06, 0x43, 0x6f, 0x6e,
+	0x73, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0xde, 0x09, 0x0a, 0x06, 0x43, 0x6f, 0x6e,
 	0x66, 0x69, 0x67, 0x12, 0x2e, 0x0a, 0x13, 0x6c, 0x75, 0x63, 0x69, 0x5f, 0x63, 0x6f, 0x6e, 0x66,
 	0x69, 0x67, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
 	0x52, 0x11, 0x6c, 0x75, 0x63, 0x69, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x53, 0x65, 0x72, 0x76,
@@ -491,34 +694,74 @@ var file_infra_unifiedfleet_app_config_config_proto_rawDesc = []byte{
 	0x3b, 0x0a, 0x1a, 0x68, 0x77, 0x69, 0x64, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f,
 	0x74, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x18, 0x12, 0x20,
 	0x01, 0x28, 0x02, 0x52, 0x17, 0x68, 0x77, 0x69, 0x64, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x22, 0xae, 0x02, 0x0a,
-	0x0f, 0x4f, 0x53, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x12, 0x21, 0x0a, 0x0c, 0x67, 0x69, 0x74, 0x69, 0x6c, 0x65, 0x73, 0x5f, 0x68, 0x6f, 0x73, 0x74,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x67, 0x69, 0x74, 0x69, 0x6c, 0x65, 0x73, 0x48,
-	0x6f, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x16, 0x0a,
-	0x06, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62,
-	0x72, 0x61, 0x6e, 0x63, 0x68, 0x12, 0x61, 0x0a, 0x15, 0x63, 0x72, 0x6f, 0x73, 0x5f, 0x6e, 0x65,
-	0x74, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x74, 0x6f, 0x70, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x18, 0x04,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x75, 0x66, 0x73, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x2e, 0x4f, 0x53, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x43, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x2e, 0x4f, 0x53, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x54, 0x6f, 0x70, 0x6f, 0x6c,
-	0x6f, 0x67, 0x79, 0x52, 0x13, 0x63, 0x72, 0x6f, 0x73, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
-	0x54, 0x6f, 0x70, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x1a, 0x63, 0x0a, 0x11, 0x4f, 0x53, 0x4e, 0x65,
-	0x74, 0x77, 0x6f, 0x72, 0x6b, 0x54, 0x6f, 0x70, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x12, 0x12, 0x0a,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x5f, 0x70, 0x61, 0x74, 0x68,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x50, 0x61,
-	0x74, 0x68, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x68, 0x65, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x68, 0x65, 0x65, 0x74, 0x49, 0x64, 0x22, 0x57, 0x0a,
-	0x06, 0x50, 0x75, 0x62, 0x53, 0x75, 0x62, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65,
-	0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63,
-	0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x61, 0x74, 0x63, 0x68,
-	0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x62, 0x61, 0x74,
-	0x63, 0x68, 0x53, 0x69, 0x7a, 0x65, 0x42, 0x1f, 0x5a, 0x1d, 0x69, 0x6e, 0x66, 0x72, 0x61, 0x2f,
-	0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2f, 0x61, 0x70, 0x70,
-	0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x12, 0x55, 0x0a, 0x15,
+	0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x65, 0x6e, 0x66, 0x6f, 0x72, 0x63,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x75, 0x66,
+	0x73, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x45, 0x6e, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x14, 0x6e,
+	0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x45, 0x6e, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x12, 0x69, 0x0a, 0x19, 0x6d, 0x69, 0x6e, 0x5f, 0x63, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x5f, 0x6d, 0x61, 0x6a, 0x6f, 0x72, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x18, 0x14, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x75, 0x66, 0x73, 0x2e, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x4d, 0x69, 0x6e, 0x43, 0x6c,
+	0x69, 0x65, 0x6e, 0x74, 0x4d, 0x61, 0x6a, 0x6f, 0x72, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x16, 0x6d, 0x69, 0x6e, 0x43, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x4d, 0x61, 0x6a, 0x6f, 0x72, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x49,
+	0x0a, 0x1b, 0x4d, 0x69, 0x6e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4d, 0x61, 0x6a, 0x6f, 0x72,
+	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xae, 0x02, 0x0a, 0x0f, 0x4f, 0x53,
+	0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x21, 0x0a,
+	0x0c, 0x67, 0x69, 0x74, 0x69, 0x6c, 0x65, 0x73, 0x5f, 0x68, 0x6f, 0x73, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x67, 0x69, 0x74, 0x69, 0x6c, 0x65, 0x73, 0x48, 0x6f, 0x73, 0x74,
+	0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x72,
+	0x61, 0x6e, 0x63, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x72, 0x61, 0x6e,
+	0x63, 0x68, 0x12, 0x61, 0x0a, 0x15, 0x63, 0x72, 0x6f, 0x73, 0x5f, 0x6e, 0x65, 0x74, 0x77, 0x6f,
+	0x72, 0x6b, 0x5f, 0x74, 0x6f, 0x70, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x2d, 0x2e, 0x75, 0x66, 0x73, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x4f,
+	0x53, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x4f,
+	0x53, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x54, 0x6f, 0x70, 0x6f, 0x6c, 0x6f, 0x67, 0x79,
+	0x52, 0x13, 0x63, 0x72, 0x6f, 0x73, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x54, 0x6f, 0x70,
+	0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x1a, 0x63, 0x0a, 0x11, 0x4f, 0x53, 0x4e, 0x65, 0x74, 0x77, 0x6f,
+	0x72, 0x6b, 0x54, 0x6f, 0x70, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1f,
+	0x0a, 0x0b, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x50, 0x61, 0x74, 0x68, 0x12,
+	0x19, 0x0a, 0x08, 0x73, 0x68, 0x65, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x73, 0x68, 0x65, 0x65, 0x74, 0x49, 0x64, 0x22, 0xe7, 0x02, 0x0a, 0x14, 0x4e,
+	0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x45, 0x6e, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x12, 0x39, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x25, 0x2e, 0x75, 0x66, 0x73, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x4e,
+	0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x45, 0x6e, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12, 0x2c,
+	0x0a, 0x12, 0x65, 0x78, 0x65, 0x6d, 0x70, 0x74, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x67,
+	0x65, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x10, 0x65, 0x78, 0x65, 0x6d,
+	0x70, 0x74, 0x55, 0x73, 0x65, 0x72, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x5a, 0x0a, 0x10,
+	0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x5f, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x73,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x75, 0x66, 0x73, 0x2e, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x45, 0x6e, 0x66,
+	0x6f, 0x72, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x4f,
+	0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x52, 0x0f, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x4f,
+	0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x73, 0x1a, 0x63, 0x0a, 0x0e, 0x4d, 0x65, 0x74, 0x68,
+	0x6f, 0x64, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65,
+	0x74, 0x68, 0x6f, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x68,
+	0x6f, 0x64, 0x12, 0x39, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x25, 0x2e, 0x75, 0x66, 0x73, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x4e, 0x61,
+	0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x45, 0x6e, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x22, 0x25, 0x0a,
+	0x04, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x07, 0x0a, 0x03, 0x4f, 0x46, 0x46, 0x10, 0x00, 0x12, 0x07,
+	0x0a, 0x03, 0x4c, 0x4f, 0x47, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x45, 0x4e, 0x46, 0x4f, 0x52,
+	0x43, 0x45, 0x10, 0x02, 0x22, 0x57, 0x0a, 0x06, 0x50, 0x75, 0x62, 0x53, 0x75, 0x62, 0x12, 0x18,
+	0x0a, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x70, 0x69,
+	0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x12, 0x1d,
+	0x0a, 0x0a, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x09, 0x62, 0x61, 0x74, 0x63, 0x68, 0x53, 0x69, 0x7a, 0x65, 0x42, 0x1f, 0x5a,
+	0x1d, 0x69, 0x6e, 0x66, 0x72, 0x61, 0x2f, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x66, 0x6c,
+	0x65, 0x65, 0x74, 0x2f, 0x61, 0x70, 0x70, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -533,22 +776,32 @@ func file_infra_unifiedfleet_app_config_config_proto_rawDescGZIP() []byte {
 	return file_infra_unifiedfleet_app_config_config_proto_rawDescData
 }
 
-var file_infra_unifiedfleet_app_config_config_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_infra_unifiedfleet_app_config_config_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_infra_unifiedfleet_app_config_config_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
 var file_infra_unifiedfleet_app_config_config_proto_goTypes = []interface{}{
-	(*Config)(nil),                            // 0: ufs.config.Config
-	(*OSNetworkConfig)(nil),                   // 1: ufs.config.OSNetworkConfig
-	(*PubSub)(nil),                            // 2: ufs.config.PubSub
-	(*OSNetworkConfig_OSNetworkTopology)(nil), // 3: ufs.config.OSNetworkConfig.OSNetworkTopology
+	(NamespaceEnforcement_Mode)(0), // 0: ufs.config.NamespaceEnforcement.Mode
+	(*Config)(nil),                 // 1: ufs.config.Config
+	(*OSNetworkConfig)(nil),        // 2: ufs.config.OSNetworkConfig
+	(*NamespaceEnforcement)(nil),   // 3: ufs.config.NamespaceEnforcement
+	(*PubSub)(nil),                 // 4: ufs.config.PubSub
+	nil,                            // 5: ufs.config.Config.MinClientMajorVersionsEntry
+	(*OSNetworkConfig_OSNetworkTopology)(nil),   // 6: ufs.config.OSNetworkConfig.OSNetworkTopology
+	(*NamespaceEnforcement_MethodOverride)(nil), // 7: ufs.config.NamespaceEnforcement.MethodOverride
 }
 var file_infra_unifiedfleet_app_config_config_proto_depIdxs = []int32{
-	1, // 0: ufs.config.Config.cros_network_config:type_name -> ufs.config.OSNetworkConfig
-	2, // 1: ufs.config.Config.hart:type_name -> ufs.config.PubSub
-	3, // 2: ufs.config.OSNetworkConfig.cros_network_topology:type_name -> ufs.config.OSNetworkConfig.OSNetworkTopology
-	3, // [3:3] is the sub-list for method output_type
-	3, // [3:3] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	2, // 0: ufs.config.Config.cros_network_config:type_name -> ufs.config.OSNetworkConfig
+	4, // 1: ufs.config.Config.hart:type_name -> ufs.config.PubSub
+	3, // 2: ufs.config.Config.namespace_enforcement:type_name -> ufs.config.NamespaceEnforcement
+	5, // 3: ufs.config.Config.min_client_major_versions:type_name -> ufs.config.Config.MinClientMajorVersionsEntry
+	6, // 4: ufs.config.OSNetworkConfig.cros_network_topology:type_name -> ufs.config.OSNetworkConfig.OSNetworkTopology
+	0, // 5: ufs.config.NamespaceEnforcement.mode:type_name -> ufs.config.NamespaceEnforcement.Mode
+	7, // 6: ufs.config.NamespaceEnforcement.method_overrides:type_name -> ufs.config.NamespaceEnforcement.MethodOverride
+	0, // 7: ufs.config.NamespaceEnforcement.MethodOverride.mode:type_name -> ufs.config.NamespaceEnforcement.Mode
+	8, // [8:8] is the sub-list for method output_type
+	8, // [8:8] is the sub-list for method input_type
+	8, // [8:8] is the sub-list for extension type_name
+	8, // [8:8] is the sub-list for extension extendee
+	0, // [0:8] is the sub-list for field type_name
 }
 
 func init() { file_infra_unifiedfleet_app_config_config_proto_init() }
@@ -582,7 +835,7 @@ func file_infra_unifiedfleet_app_config_config_proto_init() {
 			}
 		}
 		file_infra_unifiedfleet_app_config_config_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PubSub); i {
+			switch v := v.(*NamespaceEnforcement); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -594,6 +847,18 @@ func file_infra_unifiedfleet_app_config_config_proto_init() {
 			}
 		}
 		file_infra_unifiedfleet_app_config_config_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PubSub); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_infra_unifiedfleet_app_config_config_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*OSNetworkConfig_OSNetworkTopology); i {
 			case 0:
 				return &v.state
@@ -605,19 +870,32 @@ func file_infra_unifiedfleet_app_config_config_proto_init() {
 				return nil
 			}
 		}
+		file_infra_unifiedfleet_app_config_config_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NamespaceEnforcement_MethodOverride); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_infra_unifiedfleet_app_config_config_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   4,
+			NumEnums:      1,
+			NumMessages:   7,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_infra_unifiedfleet_app_config_config_proto_goTypes,
 		DependencyIndexes: file_infra_unifiedfleet_app_config_config_proto_depIdxs,
+		EnumInfos:         file_infra_unifiedfleet_app_config_config_proto_enumTypes,
 		MessageInfos:      file_infra_unifiedfleet_app_config_config_proto_msgTypes,
 	}.Build()
 	File_infra_unifiedfleet_app_config_config_proto = out.File
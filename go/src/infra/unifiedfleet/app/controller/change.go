@@ -7,9 +7,11 @@ package controller
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/golang/protobuf/proto"
 	"github.com/golang/protobuf/ptypes"
+	"go.chromium.org/luci/common/clock"
 	"go.chromium.org/luci/common/logging"
 	"go.chromium.org/luci/server/auth"
 
@@ -26,6 +28,10 @@ type HistoryClient struct {
 	changes []*ufspb.ChangeEvent
 	msgs    []*history.SnapshotMsgEntity
 	errs    []string
+	// deleted resources to keep for restoring
+	deleted []*history.DeletedResourceEntity
+	// names of resources (re)created, whose deleted copies are purged
+	reused []string
 }
 
 // SaveChangeEvents saves change events to database
@@ -33,6 +39,9 @@ func (hc *HistoryClient) SaveChangeEvents(ctx context.Context) error {
 	if err := hc.saveMsgs(ctx); err != nil {
 		return err
 	}
+	if err := hc.saveDeletedResources(ctx); err != nil {
+		return err
+	}
 	changes := hc.changes
 	if hc.netUdt != nil {
 		changes = append(changes, hc.netUdt.Changes...)
@@ -70,6 +79,43 @@ func (hc *HistoryClient) saveMsgs(ctx context.Context) error {
 	return nil
 }
 
+// saveDeletedResources saves the deleted resources and purges the deleted
+// copies of resources whose names are taken again.
+func (hc *HistoryClient) saveDeletedResources(ctx context.Context) error {
+	deleted := make(map[string]bool, len(hc.deleted))
+	for _, e := range hc.deleted {
+		deleted[e.ID] = true
+	}
+	var purge []string
+	for _, rn := range hc.reused {
+		if !deleted[rn] {
+			purge = append(purge, rn)
+		}
+	}
+	if len(purge) > 0 {
+		if err := history.DeleteDeletedResources(ctx, purge); err != nil {
+			return err
+		}
+	}
+	for _, e := range hc.deleted {
+		if err := history.PutDeletedResource(ctx, e); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// logDeletedResource keeps a copy of a resource being deleted, so that it can
+// be restored within DeletedResourceRetention.
+func (hc *HistoryClient) logDeletedResource(ctx context.Context, resourceName string, pm proto.Message) {
+	prefix := strings.SplitN(resourceName, "/", 2)[0]
+	if e, err := history.NewDeletedResourceEntity(resourceName, prefix, pm, clock.Now(ctx), util.CurrentUser(ctx)); err != nil {
+		hc.errs = append(hc.errs, err.Error())
+	} else {
+		hc.deleted = append(hc.deleted, e)
+	}
+}
+
 const (
 	// LifeCycleRegistration indicates the registration of a resource.
 	LifeCycleRegistration = "REGISTRATION"
@@ -183,6 +229,7 @@ func (hc *HistoryClient) LogMachineChanges(oldData *ufspb.Machine, newData *ufsp
 	if oldData == nil {
 		hc.changes = append(hc.changes, logLifeCycle(resourceName, "machine", LifeCycleRegistration)...)
 		hc.logMsgEntity(resourceName, false, newData)
+		hc.reused = append(hc.reused, resourceName)
 		return
 	}
 	if newData == nil {
@@ -199,6 +246,7 @@ func (hc *HistoryClient) LogMachineChanges(oldData *ufspb.Machine, newData *ufsp
 		hc.changes = append(hc.changes, logCommon(resourceName, "machine.name", oldData.GetName(), newData.GetName())...)
 		hc.logMsgEntity(oldResourceName, true, oldData)
 		hc.logMsgEntity(resourceName, false, newData)
+		hc.reused = append(hc.reused, resourceName)
 		return
 	}
 	hc.changes = append(hc.changes, logCommon(resourceName, "machine.serial_number", oldData.GetSerialNumber(), newData.GetSerialNumber())...)
@@ -289,6 +337,7 @@ func (hc *HistoryClient) LogMachineLSEChanges(oldData *ufspb.MachineLSE, newData
 	if oldData == nil {
 		hc.changes = append(hc.changes, logLifeCycle(resourceName, "machine_lse", LifeCycleRegistration)...)
 		hc.logMsgEntity(resourceName, false, newData)
+		hc.reused = append(hc.reused, resourceName)
 		return
 	}
 	if newData == nil {
@@ -305,6 +354,7 @@ func (hc *HistoryClient) LogMachineLSEChanges(oldData *ufspb.MachineLSE, newData
 		hc.changes = append(hc.changes, logCommon(resourceName, "machine_lse.name", oldData.GetName(), newData.GetName())...)
 		hc.logMsgEntity(oldResourceName, true, oldData)
 		hc.logMsgEntity(resourceName, false, newData)
+		hc.reused = append(hc.reused, resourceName)
 		return
 	}
 	hc.changes = append(hc.changes, logCommon(resourceName, "machine_lse.machine_lse_prototype", oldData.GetMachineLsePrototype(), newData.GetMachineLsePrototype())...)
@@ -0,0 +1,344 @@
+// Copyright 2021 The Chromium OS Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"go.chromium.org/luci/common/clock"
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/common/logging"
+	"go.chromium.org/luci/gae/service/datastore"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	ufspb "infra/unifiedfleet/api/v1/models"
+	"infra/unifiedfleet/app/model/history"
+	"infra/unifiedfleet/app/model/inventory"
+	"infra/unifiedfleet/app/model/registration"
+	"infra/unifiedfleet/app/util"
+)
+
+// DeletedResourceRetention is how long a deleted machine or host can be
+// restored before it is purged.
+const DeletedResourceRetention = 30 * 24 * time.Hour
+
+// deletedPageTokenPrefix marks a page token of a listing that has gone past
+// the existing resources and continues with the deleted ones.
+const deletedPageTokenPrefix = "deleted:"
+
+// getDeletedResource returns the deleted resource for the given resource name
+// if it is still within the retention period.
+func getDeletedResource(ctx context.Context, resourceName string) (*history.DeletedResourceEntity, error) {
+	e, err := history.GetDeletedResource(ctx, resourceName)
+	if err != nil {
+		return nil, err
+	}
+	if isExpired(ctx, e) {
+		return nil, status.Errorf(codes.NotFound, fmt.Sprintf("Deleted resource %s is past its retention of %s", resourceName, DeletedResourceRetention))
+	}
+	return e, nil
+}
+
+func isExpired(ctx context.Context, e *history.DeletedResourceEntity) bool {
+	return clock.Now(ctx).Sub(e.DeleteTime) > DeletedResourceRetention
+}
+
+func deletedMachine(e *history.DeletedResourceEntity) (*ufspb.Machine, error) {
+	machine := &ufspb.Machine{}
+	if err := e.GetProto(machine); err != nil {
+		return nil, errors.Annotate(err, "failed to unmarshal deleted machine %s", e.ID).Err()
+	}
+	machine.DeleteTime = timestamppb.New(e.DeleteTime)
+	machine.DeleteUser = e.DeleteUser
+	return machine, nil
+}
+
+func deletedMachineLSE(e *history.DeletedResourceEntity) (*ufspb.MachineLSE, error) {
+	lse := &ufspb.MachineLSE{}
+	if err := e.GetProto(lse); err != nil {
+		return nil, errors.Annotate(err, "failed to unmarshal deleted host %s", e.ID).Err()
+	}
+	lse.DeleteTime = timestamppb.New(e.DeleteTime)
+	lse.DeleteUser = e.DeleteUser
+	return lse, nil
+}
+
+// GetMachineShowDeleted returns the machine for the given id, falling back
+// to the deleted machine if it doesn't exist.
+func GetMachineShowDeleted(ctx context.Context, id string) (*ufspb.Machine, error) {
+	machine, err := GetMachine(ctx, id)
+	if !util.IsNotFoundError(err) {
+		return machine, err
+	}
+	e, derr := getDeletedResource(ctx, util.AddPrefix(util.MachineCollection, id))
+	if derr != nil {
+		if util.IsNotFoundError(derr) {
+			return nil, err
+		}
+		return nil, derr
+	}
+	return deletedMachine(e)
+}
+
+// GetMachineLSEShowDeleted returns the host for the given id, falling back to
+// the deleted host if it doesn't exist.
+func GetMachineLSEShowDeleted(ctx context.Context, id string) (*ufspb.MachineLSE, error) {
+	lse, err := GetMachineLSE(ctx, id)
+	if !util.IsNotFoundError(err) {
+		return lse, err
+	}
+	e, derr := getDeletedResource(ctx, util.AddPrefix(util.HostCollection, id))
+	if derr != nil {
+		if util.IsNotFoundError(derr) {
+			return nil, err
+		}
+		return nil, derr
+	}
+	return deletedMachineLSE(e)
+}
+
+// ListMachinesShowDeleted lists the machines followed by the deleted machines.
+func ListMachinesShowDeleted(ctx context.Context, pageSize int32, pageToken string, keysOnly, full bool) ([]*ufspb.Machine, string, error) {
+	var machines []*ufspb.Machine
+	if !strings.HasPrefix(pageToken, deletedPageTokenPrefix) {
+		var nextPageToken string
+		var err error
+		machines, nextPageToken, err = ListMachines(ctx, pageSize, pageToken, "", keysOnly, full)
+		if err != nil || nextPageToken != "" {
+			return machines, nextPageToken, err
+		}
+		if int32(len(machines)) >= pageSize {
+			return machines, deletedPageTokenPrefix, nil
+		}
+		pageToken = deletedPageTokenPrefix
+	}
+	entities, nextPageToken, err := listDeletedResources(ctx, util.MachineCollection, pageSize-int32(len(machines)), pageToken)
+	if err != nil {
+		return nil, "", err
+	}
+	for _, e := range entities {
+		if keysOnly {
+			machines = append(machines, &ufspb.Machine{Name: util.RemovePrefix(e.ID)})
+			continue
+		}
+		machine, err := deletedMachine(e)
+		if err != nil {
+			return nil, "", err
+		}
+		machines = append(machines, machine)
+	}
+	return machines, nextPageToken, nil
+}
+
+// ListMachineLSEsShowDeleted lists the hosts followed by the deleted hosts.
+func ListMachineLSEsShowDeleted(ctx context.Context, pageSize int32, pageToken string, keysOnly, full bool) ([]*ufspb.MachineLSE, string, error) {
+	var lses []*ufspb.MachineLSE
+	if !strings.HasPrefix(pageToken, deletedPageTokenPrefix) {
+		var nextPageToken string
+		var err error
+		lses, nextPageToken, err = ListMachineLSEs(ctx, pageSize, pageToken, "", keysOnly, full)
+		if err != nil || nextPageToken != "" {
+			return lses, nextPageToken, err
+		}
+		if int32(len(lses)) >= pageSize {
+			return lses, deletedPageTokenPrefix, nil
+		}
+		pageToken = deletedPageTokenPrefix
+	}
+	entities, nextPageToken, err := listDeletedResources(ctx, util.HostCollection, pageSize-int32(len(lses)), pageToken)
+	if err != nil {
+		return nil, "", err
+	}
+	for _, e := range entities {
+		if keysOnly {
+			lses = append(lses, &ufspb.MachineLSE{Name: util.RemovePrefix(e.ID)})
+			continue
+		}
+		lse, err := deletedMachineLSE(e)
+		if err != nil {
+			return nil, "", err
+		}
+		lses = append(lses, lse)
+	}
+	return lses, nextPageToken, nil
+}
+
+// listDeletedResources lists a page of deleted resources within retention.
+//
+// pageToken must have deletedPageTokenPrefix, and so does the returned token.
+func listDeletedResources(ctx context.Context, resourceType string, pageSize int32, pageToken string) ([]*history.DeletedResourceEntity, string, error) {
+	entities, nextPageToken, err := history.ListDeletedResources(ctx, resourceType, pageSize, strings.TrimPrefix(pageToken, deletedPageTokenPrefix))
+	if err != nil {
+		return nil, "", err
+	}
+	res := make([]*history.DeletedResourceEntity, 0, len(entities))
+	for _, e := range entities {
+		// Expired ones are left for PurgeDeletedResources.
+		if !isExpired(ctx, e) {
+			res = append(res, e)
+		}
+	}
+	if nextPageToken != "" {
+		nextPageToken = deletedPageTokenPrefix + nextPageToken
+	}
+	return res, nextPageToken, nil
+}
+
+// RestoreMachine restores a deleted machine.
+//
+// The nics and drac deleted with the machine are not restored.
+func RestoreMachine(ctx context.Context, id string) (*ufspb.Machine, error) {
+	var machine *ufspb.Machine
+	f := func(ctx context.Context) error {
+		e, err := getDeletedResource(ctx, util.AddPrefix(util.MachineCollection, id))
+		if err != nil {
+			return err
+		}
+		machine = &ufspb.Machine{}
+		if err := e.GetProto(machine); err != nil {
+			return errors.Annotate(err, "failed to unmarshal deleted machine %s", id).Err()
+		}
+		if err := validateRestoreMachine(ctx, machine); err != nil {
+			return errors.Annotate(err, "validation failed").Err()
+		}
+
+		machine.ResourceState = ufspb.State_STATE_REGISTERED
+		if _, err := registration.BatchUpdateMachines(ctx, []*ufspb.Machine{machine}); err != nil {
+			return errors.Annotate(err, "unable to restore machine %s", machine.Name).Err()
+		}
+		hc := GetMachineHistoryClient(machine)
+		// Logging the machine as registered also drops its deleted copy.
+		hc.LogMachineChanges(nil, machine)
+		if err := hc.stUdt.updateStateHelper(ctx, machine.GetResourceState()); err != nil {
+			return err
+		}
+		return hc.SaveChangeEvents(ctx)
+	}
+	if err := datastore.RunInTransaction(ctx, f, nil); err != nil {
+		return nil, errors.Annotate(err, "RestoreMachine").Err()
+	}
+	return machine, nil
+}
+
+// validateRestoreMachine validates if a deleted machine can be restored.
+func validateRestoreMachine(ctx context.Context, machine *ufspb.Machine) error {
+	if err := util.CheckPermission(ctx, util.RegistrationsCreate, machine.GetRealm()); err != nil {
+		return err
+	}
+	if err := resourceAlreadyExists(ctx, []*Resource{GetMachineResource(machine.GetName())}, nil); err != nil {
+		return err
+	}
+	if machine.GetChromeBrowserMachine() != nil {
+		if err := validateUniqueSerial(ctx, machine.GetSerialNumber()); err != nil {
+			return status.Errorf(codes.FailedPrecondition, err.Error())
+		}
+	}
+	return validateMachineReferences(ctx, machine)
+}
+
+// RestoreMachineLSE restores a deleted host.
+//
+// A DUT's servo is added back to its labstation, while a labstation is
+// restored without servos. The ip, dhcp and vms of the host are not restored.
+func RestoreMachineLSE(ctx context.Context, id string) (*ufspb.MachineLSE, error) {
+	var lse *ufspb.MachineLSE
+	f := func(ctx context.Context) error {
+		e, err := getDeletedResource(ctx, util.AddPrefix(util.HostCollection, id))
+		if err != nil {
+			return err
+		}
+		lse = &ufspb.MachineLSE{}
+		if err := e.GetProto(lse); err != nil {
+			return errors.Annotate(err, "failed to unmarshal deleted host %s", id).Err()
+		}
+		// Servos of a labstation are added back as its DUTs get restored.
+		if labstation := lse.GetChromeosMachineLse().GetDeviceLse().GetLabstation(); labstation != nil {
+			labstation.Servos = nil
+		}
+		if len(lse.GetMachines()) == 0 {
+			return status.Errorf(codes.FailedPrecondition, "deleted host %s has no machine", id)
+		}
+		machine, err := GetMachine(ctx, lse.GetMachines()[0])
+		if err != nil {
+			return errors.Annotate(err, "unable to get machine %s", lse.GetMachines()[0]).Err()
+		}
+		if err := validateCreateMachineLSE(ctx, lse, nil, machine); err != nil {
+			return errors.Annotate(err, "validation failed").Err()
+		}
+
+		hc := getHostHistoryClient(lse)
+		lses := []*ufspb.MachineLSE{lse}
+		servo := lse.GetChromeosMachineLse().GetDeviceLse().GetDut().GetPeripherals().GetServo()
+		if servo != nil && servo.GetServoHostname() != "" && !util.ServoV3HostnameRegex.MatchString(servo.GetServoHostname()) && servo.GetDockerContainerName() == "" {
+			labstation, err := getLabstationMachineLSE(ctx, servo.GetServoHostname())
+			if err != nil {
+				return errors.Annotate(err, "unable to get labstation").Err()
+			}
+			oldLabstation := proto.Clone(labstation).(*ufspb.MachineLSE)
+			if _, err := validateServoInfoForDUT(ctx, servo, lse.GetName()); err != nil {
+				return err
+			}
+			if err := appendServoEntryToLabstation(ctx, servo, labstation); err != nil {
+				return err
+			}
+			lses = append(lses, labstation)
+			hcLabstation := getHostHistoryClient(labstation)
+			hcLabstation.LogMachineLSEChanges(oldLabstation, labstation)
+			if err := hcLabstation.SaveChangeEvents(ctx); err != nil {
+				return err
+			}
+		}
+
+		oldMachine := proto.Clone(machine).(*ufspb.Machine)
+		machine.ResourceState = ufspb.State_STATE_SERVING
+		setOutputField(ctx, machine, lse)
+		if _, err := registration.BatchUpdateMachines(ctx, []*ufspb.Machine{machine}); err != nil {
+			return errors.Annotate(err, "unable to update machine %s", machine.GetName()).Err()
+		}
+		hc.LogMachineChanges(oldMachine, machine)
+
+		lse.Nic = ""
+		lse.Vlan = ""
+		lse.Ip = ""
+		lse.ResourceState = ufspb.State_STATE_REGISTERED
+		if _, err := inventory.BatchUpdateMachineLSEs(ctx, lses); err != nil {
+			return errors.Annotate(err, "unable to restore host %s", lse.GetName()).Err()
+		}
+		// Logging the host as registered also drops its deleted copy.
+		hc.LogMachineLSEChanges(nil, lse)
+		if err := hc.stUdt.addLseStateHelper(ctx, lse, machine); err != nil {
+			return err
+		}
+		return hc.SaveChangeEvents(ctx)
+	}
+	if err := datastore.RunInTransaction(ctx, f, nil); err != nil {
+		logging.Errorf(ctx, "RestoreMachineLSE: %s", err)
+		return nil, err
+	}
+	return lse, nil
+}
+
+// PurgeDeletedResources permanently removes the deleted resources past
+// DeletedResourceRetention.
+func PurgeDeletedResources(ctx context.Context) error {
+	names, err := history.QueryDeletedResourcesBefore(ctx, clock.Now(ctx).Add(-DeletedResourceRetention))
+	if err != nil {
+		return err
+	}
+	logging.Infof(ctx, "Purging %d deleted resources", len(names))
+	for i := 0; i < len(names); i += util.OperationPageSize {
+		end := util.Min(i+util.OperationPageSize, len(names))
+		if err := history.DeleteDeletedResources(ctx, names[i:end]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,230 @@
+// Copyright 2021 The Chromium OS Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package controller
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+	"go.chromium.org/luci/common/clock/testclock"
+
+	ufspb "infra/unifiedfleet/api/v1/models"
+	"infra/unifiedfleet/app/model/history"
+	"infra/unifiedfleet/app/model/inventory"
+	"infra/unifiedfleet/app/model/registration"
+	"infra/unifiedfleet/app/model/state"
+	"infra/unifiedfleet/app/util"
+)
+
+func TestDeleteAndRestoreMachine(t *testing.T) {
+	t.Parallel()
+	now := time.Date(2021, 11, 1, 0, 0, 0, 0, time.UTC)
+	ctx, tc := testclock.UseTime(testingContext(), now)
+	ctx = initializeMockAuthDB(ctx, "user:user@example.com", util.AtlLabAdminRealm, util.RegistrationsCreate, util.RegistrationsDelete)
+	Convey("Delete and restore machine", t, func() {
+		Convey("Delete machine keeps a deleted copy", func() {
+			_, err := registration.CreateMachine(ctx, &ufspb.Machine{
+				Name:         "machine-del-1",
+				Realm:        util.AtlLabAdminRealm,
+				SerialNumber: "serial-del-1",
+			})
+			So(err, ShouldBeNil)
+
+			So(DeleteMachine(ctx, "machine-del-1"), ShouldBeNil)
+			_, err = registration.GetMachine(ctx, "machine-del-1")
+			So(util.IsNotFoundError(err), ShouldBeTrue)
+
+			e, err := history.GetDeletedResource(ctx, "machines/machine-del-1")
+			So(err, ShouldBeNil)
+			So(e.ResourceType, ShouldEqual, util.MachineCollection)
+			So(e.DeleteTime, ShouldEqual, now)
+			So(e.DeleteUser, ShouldEqual, "user@example.com")
+		})
+
+		Convey("Get and list deleted machines", func() {
+			_, err := registration.CreateMachine(ctx, &ufspb.Machine{Name: "machine-del-2"})
+			So(err, ShouldBeNil)
+			_, err = registration.CreateMachine(ctx, &ufspb.Machine{Name: "machine-del-3"})
+			So(err, ShouldBeNil)
+			So(DeleteMachine(ctx, "machine-del-3"), ShouldBeNil)
+
+			_, err = GetMachine(ctx, "machine-del-3")
+			So(util.IsNotFoundError(err), ShouldBeTrue)
+			machine, err := GetMachineShowDeleted(ctx, "machine-del-3")
+			So(err, ShouldBeNil)
+			So(machine.GetName(), ShouldEqual, "machine-del-3")
+			So(machine.GetDeleteTime().AsTime(), ShouldEqual, now)
+			So(machine.GetDeleteUser(), ShouldEqual, "user@example.com")
+
+			var names []string
+			var deleted []string
+			token := ""
+			for {
+				machines, next, err := ListMachinesShowDeleted(ctx, 1, token, false, false)
+				So(err, ShouldBeNil)
+				for _, m := range machines {
+					if m.GetDeleteTime() != nil {
+						deleted = append(deleted, m.GetName())
+					} else {
+						names = append(names, m.GetName())
+					}
+				}
+				if next == "" {
+					break
+				}
+				token = next
+			}
+			So(names, ShouldContain, "machine-del-2")
+			So(names, ShouldNotContain, "machine-del-3")
+			So(deleted, ShouldContain, "machine-del-3")
+			So(deleted, ShouldNotContain, "machine-del-2")
+		})
+
+		Convey("Restore machine", func() {
+			_, err := registration.CreateMachine(ctx, &ufspb.Machine{
+				Name:  "machine-del-4",
+				Realm: util.AtlLabAdminRealm,
+			})
+			So(err, ShouldBeNil)
+			So(DeleteMachine(ctx, "machine-del-4"), ShouldBeNil)
+
+			machine, err := RestoreMachine(ctx, "machine-del-4")
+			So(err, ShouldBeNil)
+			So(machine.GetResourceState(), ShouldEqual, ufspb.State_STATE_REGISTERED)
+			So(machine.GetDeleteTime(), ShouldBeNil)
+
+			_, err = registration.GetMachine(ctx, "machine-del-4")
+			So(err, ShouldBeNil)
+			s, err := state.GetStateRecord(ctx, "machines/machine-del-4")
+			So(err, ShouldBeNil)
+			So(s.GetState(), ShouldEqual, ufspb.State_STATE_REGISTERED)
+			_, err = history.GetDeletedResource(ctx, "machines/machine-del-4")
+			So(util.IsNotFoundError(err), ShouldBeTrue)
+		})
+
+		Convey("Restore machine - name taken again", func() {
+			_, err := registration.CreateMachine(ctx, &ufspb.Machine{Name: "machine-del-5"})
+			So(err, ShouldBeNil)
+			So(DeleteMachine(ctx, "machine-del-5"), ShouldBeNil)
+			_, err = registration.CreateMachine(ctx, &ufspb.Machine{Name: "machine-del-5"})
+			So(err, ShouldBeNil)
+
+			_, err = RestoreMachine(ctx, "machine-del-5")
+			So(err, ShouldNotBeNil)
+			So(err.Error(), ShouldContainSubstring, "already exists")
+		})
+
+		Convey("Registering a machine purges its deleted copy", func() {
+			_, err := registration.CreateMachine(ctx, &ufspb.Machine{Name: "machine-del-6"})
+			So(err, ShouldBeNil)
+			So(DeleteMachine(ctx, "machine-del-6"), ShouldBeNil)
+
+			_, err = MachineRegistration(ctx, &ufspb.Machine{Name: "machine-del-6"})
+			So(err, ShouldBeNil)
+			_, err = history.GetDeletedResource(ctx, "machines/machine-del-6")
+			So(util.IsNotFoundError(err), ShouldBeTrue)
+		})
+
+		Convey("Restore and purge past retention", func() {
+			_, err := registration.CreateMachine(ctx, &ufspb.Machine{Name: "machine-del-7"})
+			So(err, ShouldBeNil)
+			So(DeleteMachine(ctx, "machine-del-7"), ShouldBeNil)
+
+			ctx, _ := testclock.UseTime(ctx, tc.Now().Add(DeletedResourceRetention+time.Hour))
+			_, err = RestoreMachine(ctx, "machine-del-7")
+			So(util.IsNotFoundError(err), ShouldBeTrue)
+			_, err = GetMachineShowDeleted(ctx, "machine-del-7")
+			So(util.IsNotFoundError(err), ShouldBeTrue)
+
+			So(PurgeDeletedResources(ctx), ShouldBeNil)
+			_, err = history.GetDeletedResource(ctx, "machines/machine-del-7")
+			So(util.IsNotFoundError(err), ShouldBeTrue)
+		})
+	})
+}
+
+func TestDeleteAndRestoreMachineLSE(t *testing.T) {
+	t.Parallel()
+	now := time.Date(2021, 11, 1, 0, 0, 0, 0, time.UTC)
+	ctx, _ := testclock.UseTime(testingContext(), now)
+	ctx = initializeMockAuthDB(ctx, "user:user@example.com", util.AtlLabAdminRealm, util.InventoriesCreate, util.InventoriesDelete)
+	Convey("Delete and restore host", t, func() {
+		Convey("Restore host", func() {
+			_, err := registration.CreateMachine(ctx, &ufspb.Machine{
+				Name:  "machine-lse-del-1",
+				Realm: util.AtlLabAdminRealm,
+				Device: &ufspb.Machine_ChromeBrowserMachine{
+					ChromeBrowserMachine: &ufspb.ChromeBrowserMachine{},
+				},
+			})
+			So(err, ShouldBeNil)
+			_, err = CreateMachineLSE(ctx, &ufspb.MachineLSE{
+				Name:     "lse-del-1",
+				Hostname: "lse-del-1",
+				Machines: []string{"machine-lse-del-1"},
+				Lse: &ufspb.MachineLSE_ChromeBrowserMachineLse{
+					ChromeBrowserMachineLse: &ufspb.ChromeBrowserMachineLSE{},
+				},
+			}, nil)
+			So(err, ShouldBeNil)
+
+			So(DeleteMachineLSE(ctx, "lse-del-1"), ShouldBeNil)
+			_, err = inventory.GetMachineLSE(ctx, "lse-del-1")
+			So(util.IsNotFoundError(err), ShouldBeTrue)
+			lse, err := GetMachineLSEShowDeleted(ctx, "lse-del-1")
+			So(err, ShouldBeNil)
+			So(lse.GetDeleteUser(), ShouldEqual, "user@example.com")
+
+			lse, err = RestoreMachineLSE(ctx, "lse-del-1")
+			So(err, ShouldBeNil)
+			So(lse.GetResourceState(), ShouldEqual, ufspb.State_STATE_REGISTERED)
+			_, err = inventory.GetMachineLSE(ctx, "lse-del-1")
+			So(err, ShouldBeNil)
+			machine, err := registration.GetMachine(ctx, "machine-lse-del-1")
+			So(err, ShouldBeNil)
+			So(machine.GetResourceState(), ShouldEqual, ufspb.State_STATE_SERVING)
+			s, err := state.GetStateRecord(ctx, "hosts/lse-del-1")
+			So(err, ShouldBeNil)
+			So(s.GetState(), ShouldEqual, ufspb.State_STATE_REGISTERED)
+			_, err = history.GetDeletedResource(ctx, "hosts/lse-del-1")
+			So(util.IsNotFoundError(err), ShouldBeTrue)
+		})
+
+		Convey("Restore host - machine used by another host", func() {
+			_, err := registration.CreateMachine(ctx, &ufspb.Machine{
+				Name:  "machine-lse-del-2",
+				Realm: util.AtlLabAdminRealm,
+				Device: &ufspb.Machine_ChromeBrowserMachine{
+					ChromeBrowserMachine: &ufspb.ChromeBrowserMachine{},
+				},
+			})
+			So(err, ShouldBeNil)
+			_, err = CreateMachineLSE(ctx, &ufspb.MachineLSE{
+				Name:     "lse-del-2",
+				Hostname: "lse-del-2",
+				Machines: []string{"machine-lse-del-2"},
+				Lse: &ufspb.MachineLSE_ChromeBrowserMachineLse{
+					ChromeBrowserMachineLse: &ufspb.ChromeBrowserMachineLSE{},
+				},
+			}, nil)
+			So(err, ShouldBeNil)
+			So(DeleteMachineLSE(ctx, "lse-del-2"), ShouldBeNil)
+			_, err = CreateMachineLSE(ctx, &ufspb.MachineLSE{
+				Name:     "lse-del-3",
+				Hostname: "lse-del-3",
+				Machines: []string{"machine-lse-del-2"},
+				Lse: &ufspb.MachineLSE_ChromeBrowserMachineLse{
+					ChromeBrowserMachineLse: &ufspb.ChromeBrowserMachineLSE{},
+				},
+			}, nil)
+			So(err, ShouldBeNil)
+
+			_, err = RestoreMachineLSE(ctx, "lse-del-2")
+			So(err, ShouldNotBeNil)
+			So(err.Error(), ShouldContainSubstring, "A machine cannot be associated with multiple hosts")
+		})
+	})
+}
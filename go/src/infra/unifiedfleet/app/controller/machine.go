@@ -552,6 +552,7 @@ func DeleteMachine(ctx context.Context, id string) error {
 		hc := GetMachineHistoryClient(&ufspb.Machine{Name: id})
 		hc.stUdt.deleteStateHelper(ctx)
 		hc.LogMachineChanges(&ufspb.Machine{Name: id}, nil)
+		hc.logDeletedResource(ctx, util.AddPrefix(util.MachineCollection, id), machine)
 		return hc.SaveChangeEvents(ctx)
 	}
 
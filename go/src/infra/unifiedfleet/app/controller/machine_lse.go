@@ -576,6 +576,12 @@ func DeleteMachineLSE(ctx context.Context, id string) error {
 		}
 
 		hc.LogMachineLSEChanges(existingMachinelse, nil)
+		// VMs are deleted with the host and are not kept for restoring.
+		deletedLse := proto.Clone(existingMachinelse).(*ufspb.MachineLSE)
+		if deletedLse.GetChromeBrowserMachineLse() != nil {
+			deletedLse.GetChromeBrowserMachineLse().Vms = nil
+		}
+		hc.logDeletedResource(ctx, util.AddPrefix(util.HostCollection, id), deletedLse)
 		return hc.SaveChangeEvents(ctx)
 	}
 	if err := datastore.RunInTransaction(ctx, f, nil); err != nil {
@@ -16,6 +16,7 @@ import (
 	"google.golang.org/grpc/status"
 
 	bqlib "infra/cros/lab_inventory/bq"
+	"infra/unifiedfleet/app/controller"
 	"infra/unifiedfleet/app/cron"
 	"infra/unifiedfleet/app/model/configuration"
 	"infra/unifiedfleet/app/util"
@@ -78,6 +79,13 @@ var Jobs = []*cron.CronTab{
 		TrigType: cron.EVERY,
 		Job:      reportUFSInventoryCronHandler,
 	},
+	{
+		// Purge deleted machines and hosts past their retention
+		Name:     "ufs.purge_deleted_resources",
+		Time:     30 * time.Minute,
+		TrigType: cron.DAILY,
+		Job:      controller.PurgeDeletedResources,
+	},
 }
 
 // InitServer initializes a cron server.
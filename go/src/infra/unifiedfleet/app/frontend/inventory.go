@@ -130,7 +130,12 @@ func (fs *FleetServerImpl) GetMachineLSE(ctx context.Context, req *ufsAPI.GetMac
 		return nil, err
 	}
 	name := util.FormatDHCPHostname(util.RemovePrefix(req.Name))
-	machineLSE, err := controller.GetMachineLSE(ctx, name)
+	var machineLSE *ufspb.MachineLSE
+	if req.ShowDeleted {
+		machineLSE, err = controller.GetMachineLSEShowDeleted(ctx, name)
+	} else {
+		machineLSE, err = controller.GetMachineLSE(ctx, name)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -166,7 +171,13 @@ func (fs *FleetServerImpl) ListMachineLSEs(ctx context.Context, req *ufsAPI.List
 		return nil, err
 	}
 	pageSize := util.GetPageSize(req.PageSize)
-	result, nextPageToken, err := controller.ListMachineLSEs(ctx, pageSize, req.PageToken, req.Filter, req.KeysOnly, req.Full)
+	var result []*ufspb.MachineLSE
+	var nextPageToken string
+	if req.ShowDeleted {
+		result, nextPageToken, err = controller.ListMachineLSEsShowDeleted(ctx, pageSize, req.PageToken, req.KeysOnly, req.Full)
+	} else {
+		result, nextPageToken, err = controller.ListMachineLSEs(ctx, pageSize, req.PageToken, req.Filter, req.KeysOnly, req.Full)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -206,6 +217,24 @@ func (fs *FleetServerImpl) RenameMachineLSE(ctx context.Context, req *ufsAPI.Ren
 	return
 }
 
+// RestoreMachineLSE restores a deleted machinelse in database.
+func (fs *FleetServerImpl) RestoreMachineLSE(ctx context.Context, req *ufsAPI.RestoreMachineLSERequest) (rsp *ufspb.MachineLSE, err error) {
+	defer func() {
+		err = grpcutil.GRPCifyAndLogErr(ctx, err)
+	}()
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	name := util.FormatDHCPHostname(util.RemovePrefix(req.Name))
+	lse, err := controller.RestoreMachineLSE(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	// https://aip.dev/122 - as per AIP guideline
+	lse.Name = util.AddPrefix(util.MachineLSECollection, lse.Name)
+	return lse, err
+}
+
 func updateNetworkOpt(userVlan, ip string, nwOpt *ufsAPI.NetworkOption) *ufsAPI.NetworkOption {
 	if userVlan == "" && ip == "" {
 		return nwOpt
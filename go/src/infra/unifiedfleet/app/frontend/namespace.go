@@ -0,0 +1,125 @@
+// Copyright 2021 The Chromium OS Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package frontend
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"go.chromium.org/luci/common/logging"
+	"go.chromium.org/luci/common/tsmon/field"
+	"go.chromium.org/luci/common/tsmon/metric"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"infra/unifiedfleet/app/config"
+	"infra/unifiedfleet/app/util"
+)
+
+var namespaceViolations = metric.NewCounter(
+	"chromeos/ufs/namespace_violations",
+	"Requests without a valid namespace in the context metadata",
+	nil,
+	field.String("method"),     // full name of the grpc method
+	field.String("user_agent"), // user-agent of the client
+	field.String("action"),     // "logged", "exempted" or "rejected"
+)
+
+// NamespaceInterceptor returns an interceptor setting up the datastore
+// namespace of the requests from the namespace in the context metadata.
+//
+// Requests without a valid namespace are handled according to the
+// namespace enforcement in the service config returned by cfg, which is
+// checked on each request so that enforcement can be changed without a
+// restart.
+func NamespaceInterceptor(cfg config.Provider) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		md, ok := metadata.FromIncomingContext(ctx)
+		if !ok {
+			return nil, status.Errorf(codes.InvalidArgument, "Retrieving metadata failed.")
+		}
+		var userAgent string
+		if v := md["user-agent"]; len(v) > 0 {
+			userAgent = v[0]
+		}
+		var violation string
+		if v := md[util.Namespace]; len(v) > 0 {
+			datastoreNamespace, ok := util.ClientToDatastoreNamespace[strings.ToLower(v[0])]
+			if !ok {
+				violation = "namespace " + v[0] + " in the context metadata is invalid"
+			} else {
+				var err error
+				if ctx, err = util.SetupDatastoreNamespace(ctx, datastoreNamespace); err != nil {
+					return nil, err
+				}
+			}
+		} else {
+			violation = "namespace is not set in the context metadata"
+		}
+		if violation == "" {
+			return handler(ctx, req)
+		}
+
+		enforcement := cfg().GetNamespaceEnforcement()
+		action := ""
+		switch namespaceEnforcementMode(enforcement, info.FullMethod) {
+		case config.NamespaceEnforcement_LOG:
+			action = "logged"
+		case config.NamespaceEnforcement_ENFORCE:
+			action = "rejected"
+			if isExemptUserAgent(enforcement, userAgent) {
+				action = "exempted"
+			}
+		}
+		if action != "" {
+			namespaceViolations.Add(ctx, 1, info.FullMethod, userAgent, action)
+			logging.Warningf(ctx, "Namespace violation (%s) by user-agent %q calling %s: %s", action, userAgent, info.FullMethod, violation)
+		}
+		if action == "rejected" {
+			return nil, status.Errorf(codes.InvalidArgument, "%s. Valid namespaces: [%s]", violation, strings.Join(validClientNamespaces(), ", "))
+		}
+		return handler(ctx, req)
+	}
+}
+
+// namespaceEnforcementMode returns the enforcement mode for the full
+// gRPC method name.
+func namespaceEnforcementMode(e *config.NamespaceEnforcement, fullMethod string) config.NamespaceEnforcement_Mode {
+	method := rpcName(fullMethod)
+	for _, o := range e.GetMethodOverrides() {
+		m := o.GetMethod()
+		if m == method || (strings.HasSuffix(m, "*") && strings.HasPrefix(method, strings.TrimSuffix(m, "*"))) {
+			return o.GetMode()
+		}
+	}
+	return e.GetMode()
+}
+
+// isExemptUserAgent returns true if the user-agent is exempt from
+// enforcement.
+func isExemptUserAgent(e *config.NamespaceEnforcement, userAgent string) bool {
+	for _, a := range e.GetExemptUserAgents() {
+		if a != "" && strings.Contains(userAgent, a) {
+			return true
+		}
+	}
+	return false
+}
+
+// validClientNamespaces returns the valid client namespaces, sorted so
+// that error messages are stable.
+func validClientNamespaces() []string {
+	ns := util.ValidClientNamespaceStr()
+	sort.Strings(ns)
+	return ns
+}
+
+// rpcName returns the RPC name of the full gRPC method name.
+func rpcName(fullMethod string) string {
+	return fullMethod[strings.LastIndex(fullMethod, "/")+1:]
+}
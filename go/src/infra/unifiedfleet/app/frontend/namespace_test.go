@@ -0,0 +1,133 @@
+// Copyright 2021 The Chromium OS Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package frontend
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+	. "go.chromium.org/luci/common/testing/assertions"
+	"go.chromium.org/luci/common/tsmon"
+	"go.chromium.org/luci/gae/service/info"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"infra/unifiedfleet/app/config"
+	"infra/unifiedfleet/app/util"
+)
+
+func TestNamespaceInterceptor(t *testing.T) {
+	t.Parallel()
+
+	const (
+		getMachine    = "/unifiedfleet.api.v1.rpc.Fleet/GetMachine"
+		updateMachine = "/unifiedfleet.api.v1.rpc.Fleet/UpdateMachine"
+		userAgent     = "shivas/5.0.0"
+	)
+	Convey("NamespaceInterceptor", t, func() {
+		ctx, _ := tsmon.WithDummyInMemory(testingContext())
+		enforcement := &config.NamespaceEnforcement{}
+		interceptor := NamespaceInterceptor(func() *config.Config {
+			return &config.Config{NamespaceEnforcement: enforcement}
+		})
+		// call runs the interceptor for the method with the metadata
+		// pairs, and returns the datastore namespace the handler was
+		// called with.
+		call := func(ctx context.Context, method string, kv ...string) (string, bool, error) {
+			if len(kv) > 0 {
+				ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(kv...))
+			}
+			var namespace string
+			called := false
+			handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+				namespace = info.GetNamespace(ctx)
+				called = true
+				return nil, nil
+			}
+			_, err := interceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: method}, handler)
+			return namespace, called, err
+		}
+		violations := func(method, action string) int64 {
+			return namespaceViolations.Get(ctx, method, userAgent, action)
+		}
+
+		Convey("Metadata missing", func() {
+			_, called, err := call(ctx, getMachine)
+			So(status.Code(err), ShouldEqual, codes.InvalidArgument)
+			So(called, ShouldBeFalse)
+		})
+		Convey("Valid namespace", func() {
+			enforcement.Mode = config.NamespaceEnforcement_ENFORCE
+			ns, called, err := call(ctx, getMachine, "user-agent", userAgent, util.Namespace, "OS")
+			So(err, ShouldBeNil)
+			So(called, ShouldBeTrue)
+			So(ns, ShouldEqual, util.OSNamespace)
+		})
+		Convey("Enforcement off", func() {
+			ns, called, err := call(ctx, getMachine, "user-agent", userAgent, util.Namespace, "bogus")
+			So(err, ShouldBeNil)
+			So(called, ShouldBeTrue)
+			So(ns, ShouldEqual, "")
+			So(violations(getMachine, "logged"), ShouldEqual, 0)
+		})
+		Convey("Log mode counts violations", func() {
+			enforcement.Mode = config.NamespaceEnforcement_LOG
+			_, called, err := call(ctx, getMachine, "user-agent", userAgent, util.Namespace, "bogus")
+			So(err, ShouldBeNil)
+			So(called, ShouldBeTrue)
+			_, called, err = call(ctx, getMachine, "user-agent", userAgent)
+			So(err, ShouldBeNil)
+			So(called, ShouldBeTrue)
+			So(violations(getMachine, "logged"), ShouldEqual, 2)
+		})
+		Convey("Enforce mode", func() {
+			enforcement.Mode = config.NamespaceEnforcement_ENFORCE
+			Convey("Invalid namespace", func() {
+				_, called, err := call(ctx, getMachine, "user-agent", userAgent, util.Namespace, "bogus")
+				So(status.Code(err), ShouldEqual, codes.InvalidArgument)
+				So(err, ShouldErrLike, "namespace bogus in the context metadata is invalid. Valid namespaces: [browser, os]")
+				So(called, ShouldBeFalse)
+				So(violations(getMachine, "rejected"), ShouldEqual, 1)
+			})
+			Convey("Namespace missing", func() {
+				_, called, err := call(ctx, getMachine, "user-agent", userAgent)
+				So(status.Code(err), ShouldEqual, codes.InvalidArgument)
+				So(err, ShouldErrLike, "namespace is not set in the context metadata")
+				So(called, ShouldBeFalse)
+			})
+			Convey("Exempt user-agent", func() {
+				enforcement.ExemptUserAgents = []string{"shivas/"}
+				ns, called, err := call(ctx, getMachine, "user-agent", userAgent)
+				So(err, ShouldBeNil)
+				So(called, ShouldBeTrue)
+				So(ns, ShouldEqual, "")
+				So(violations(getMachine, "exempted"), ShouldEqual, 1)
+				So(violations(getMachine, "rejected"), ShouldEqual, 0)
+			})
+		})
+		Convey("Per-method overrides", func() {
+			enforcement.Mode = config.NamespaceEnforcement_LOG
+			enforcement.MethodOverrides = []*config.NamespaceEnforcement_MethodOverride{
+				{Method: "Get*", Mode: config.NamespaceEnforcement_ENFORCE},
+				{Method: "UpdateMachine", Mode: config.NamespaceEnforcement_OFF},
+			}
+			_, _, err := call(ctx, getMachine, "user-agent", userAgent)
+			So(status.Code(err), ShouldEqual, codes.InvalidArgument)
+
+			_, called, err := call(ctx, updateMachine, "user-agent", userAgent)
+			So(err, ShouldBeNil)
+			So(called, ShouldBeTrue)
+			So(violations(updateMachine, "logged"), ShouldEqual, 0)
+
+			_, called, err = call(ctx, "/unifiedfleet.api.v1.rpc.Fleet/ListMachines", "user-agent", userAgent)
+			So(err, ShouldBeNil)
+			So(called, ShouldBeTrue)
+			So(violations("/unifiedfleet.api.v1.rpc.Fleet/ListMachines", "logged"), ShouldEqual, 1)
+		})
+	})
+}
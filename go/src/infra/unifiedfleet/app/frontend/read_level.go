@@ -0,0 +1,56 @@
+// Copyright 2021 The Chromium OS Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package frontend
+
+import (
+	"context"
+	"strings"
+
+	"go.chromium.org/luci/common/logging"
+	"go.chromium.org/luci/gae/service/info"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+
+	"infra/unifiedfleet/app/util"
+)
+
+// readMethodPrefixes are the prefixes of the read RPCs.
+var readMethodPrefixes = []string{"Get", "List", "BatchGet"}
+
+// isReadMethod returns true if the full gRPC method name is a read RPC.
+func isReadMethod(fullMethod string) bool {
+	method := rpcName(fullMethod)
+	for _, p := range readMethodPrefixes {
+		if strings.HasPrefix(method, p) {
+			return true
+		}
+	}
+	return false
+}
+
+// ReadLevelInterceptor resolves the read access level of the caller for
+// read RPCs in the datastore namespace of the request.
+//
+// It must run after the datastore namespace is set up. Callers with
+// util.BrowseRead get responses with the sensitive fields cleared.
+func ReadLevelInterceptor(ctx context.Context, req interface{}, serverInfo *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if !isReadMethod(serverInfo.FullMethod) {
+		return handler(ctx, req)
+	}
+	level, err := util.GetReadLevel(ctx, info.GetNamespace(ctx))
+	if err != nil {
+		return nil, err
+	}
+	ctx = util.WithReadLevel(ctx, level)
+	resp, err := handler(ctx, req)
+	if err != nil || level != util.BrowseRead {
+		return resp, err
+	}
+	if m, ok := resp.(proto.Message); ok {
+		logging.Debugf(ctx, "Redacting sensitive fields in the %s response", serverInfo.FullMethod)
+		util.RedactSensitiveFields(m)
+	}
+	return resp, nil
+}
@@ -0,0 +1,135 @@
+// Copyright 2021 The Chromium OS Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package frontend
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	. "github.com/smartystreets/goconvey/convey"
+	"go.chromium.org/luci/auth/identity"
+	"go.chromium.org/luci/server/auth"
+	"go.chromium.org/luci/server/auth/authtest"
+	"go.chromium.org/luci/server/auth/service/protocol"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	ufspb "infra/unifiedfleet/api/v1/models"
+	api "infra/unifiedfleet/api/v1/rpc"
+	"infra/unifiedfleet/app/util"
+)
+
+func TestReadLevelInterceptor(t *testing.T) {
+	t.Parallel()
+
+	reader := identity.Identity("user:reader@example.com")
+	browser := identity.Identity("user:browser@example.com")
+	other := identity.Identity("user:other@example.com")
+	fakeDB := authtest.NewFakeDB(
+		authtest.MockPermission(reader, util.OSReadRealm, util.ResourcesRead),
+		authtest.MockPermission(browser, util.OSReadRealm, util.ResourcesBrowse),
+		authtest.MockRealmData(util.OSReadRealm, &protocol.RealmData{}),
+	)
+	withID := func(id identity.Identity) context.Context {
+		ctx, err := util.SetupDatastoreNamespace(testingContext(), util.ClientToDatastoreNamespace[util.OSNamespace])
+		So(err, ShouldBeNil)
+		return auth.WithState(ctx, &authtest.FakeState{
+			Identity: id,
+			FakeDB:   fakeDB,
+		})
+	}
+	// call runs the interceptor for the method with a handler returning
+	// a copy of resp and recording the read level it was called with.
+	call := func(ctx context.Context, method string, resp proto.Message) (interface{}, util.ReadLevel, error) {
+		var level util.ReadLevel
+		handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+			level = util.ReadLevelFromContext(ctx)
+			return proto.Clone(resp), nil
+		}
+		info := &grpc.UnaryServerInfo{FullMethod: "/unifiedfleet.api.v1.rpc.Fleet/" + method}
+		res, err := ReadLevelInterceptor(ctx, nil, info, handler)
+		return res, level, err
+	}
+
+	machine := &ufspb.Machine{
+		Name: "machine-1",
+		Device: &ufspb.Machine_ChromeosMachine{
+			ChromeosMachine: &ufspb.ChromeOSMachine{
+				MacAddress: "12:34:56:78:90:ab",
+				Hwid:       "hwid",
+			},
+		},
+	}
+	nics := &api.ListNicsResponse{
+		Nics: []*ufspb.Nic{
+			{Name: "nic-1", MacAddress: "12:34:56:78:90:ab"},
+			{Name: "nic-2", MacAddress: "12:34:56:78:90:cd"},
+		},
+		NextPageToken: "token",
+	}
+	kvms := &api.BatchGetKVMsResponse{
+		KVMs: []*ufspb.KVM{
+			{Name: "kvm-1", MacAddress: "12:34:56:78:90:ab", ChromePlatform: "platform"},
+		},
+	}
+
+	Convey("ReadLevelInterceptor", t, func() {
+		Convey("Full read callers get complete responses", func() {
+			ctx := withID(reader)
+			res, level, err := call(ctx, "GetMachine", machine)
+			So(err, ShouldBeNil)
+			So(level, ShouldEqual, util.FullRead)
+			So(proto.Equal(res.(proto.Message), machine), ShouldBeTrue)
+
+			res, _, err = call(ctx, "ListNics", nics)
+			So(err, ShouldBeNil)
+			So(proto.Equal(res.(proto.Message), nics), ShouldBeTrue)
+
+			res, _, err = call(ctx, "BatchGetKVMs", kvms)
+			So(err, ShouldBeNil)
+			So(proto.Equal(res.(proto.Message), kvms), ShouldBeTrue)
+		})
+		Convey("Browse read callers get redacted responses", func() {
+			ctx := withID(browser)
+			res, level, err := call(ctx, "GetMachine", machine)
+			So(err, ShouldBeNil)
+			So(level, ShouldEqual, util.BrowseRead)
+			So(proto.Equal(res.(proto.Message), &ufspb.Machine{
+				Name: "machine-1",
+				Device: &ufspb.Machine_ChromeosMachine{
+					ChromeosMachine: &ufspb.ChromeOSMachine{Hwid: "hwid"},
+				},
+			}), ShouldBeTrue)
+
+			res, _, err = call(ctx, "ListNics", nics)
+			So(err, ShouldBeNil)
+			So(proto.Equal(res.(proto.Message), &api.ListNicsResponse{
+				Nics:          []*ufspb.Nic{{Name: "nic-1"}, {Name: "nic-2"}},
+				NextPageToken: "token",
+			}), ShouldBeTrue)
+
+			res, _, err = call(ctx, "BatchGetKVMs", kvms)
+			So(err, ShouldBeNil)
+			So(proto.Equal(res.(proto.Message), &api.BatchGetKVMsResponse{
+				KVMs: []*ufspb.KVM{{Name: "kvm-1", ChromePlatform: "platform"}},
+			}), ShouldBeTrue)
+		})
+		Convey("Callers without read permissions are denied", func() {
+			ctx := withID(other)
+			for _, method := range []string{"GetMachine", "ListNics", "BatchGetKVMs"} {
+				_, _, err := call(ctx, method, machine)
+				So(status.Code(err), ShouldEqual, codes.PermissionDenied)
+			}
+		})
+		Convey("Write RPCs are not checked or redacted", func() {
+			res, level, err := call(withID(other), "UpdateMachine", machine)
+			So(err, ShouldBeNil)
+			So(level, ShouldEqual, util.FullRead)
+			So(proto.Equal(res.(proto.Message), machine), ShouldBeTrue)
+		})
+	})
+}
@@ -94,7 +94,12 @@ func (fs *FleetServerImpl) GetMachine(ctx context.Context, req *ufsAPI.GetMachin
 		return nil, err
 	}
 	name := util.RemovePrefix(req.Name)
-	machine, err := controller.GetMachine(ctx, name)
+	var machine *ufspb.Machine
+	if req.ShowDeleted {
+		machine, err = controller.GetMachineShowDeleted(ctx, name)
+	} else {
+		machine, err = controller.GetMachine(ctx, name)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -130,7 +135,13 @@ func (fs *FleetServerImpl) ListMachines(ctx context.Context, req *ufsAPI.ListMac
 		return nil, err
 	}
 	pageSize := util.GetPageSize(req.PageSize)
-	result, nextPageToken, err := controller.ListMachines(ctx, pageSize, req.PageToken, req.Filter, req.KeysOnly, req.Full)
+	var result []*ufspb.Machine
+	var nextPageToken string
+	if req.ShowDeleted {
+		result, nextPageToken, err = controller.ListMachinesShowDeleted(ctx, pageSize, req.PageToken, req.KeysOnly, req.Full)
+	} else {
+		result, nextPageToken, err = controller.ListMachines(ctx, pageSize, req.PageToken, req.Filter, req.KeysOnly, req.Full)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -226,6 +237,23 @@ func (fs *FleetServerImpl) RenameMachine(ctx context.Context, req *ufsAPI.Rename
 	return machine, err
 }
 
+// RestoreMachine restores a deleted machine in database.
+func (fs *FleetServerImpl) RestoreMachine(ctx context.Context, req *ufsAPI.RestoreMachineRequest) (rsp *ufspb.Machine, err error) {
+	defer func() {
+		err = grpcutil.GRPCifyAndLogErr(ctx, err)
+	}()
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	machine, err := controller.RestoreMachine(ctx, util.RemovePrefix(req.Name))
+	if err != nil {
+		return nil, err
+	}
+	// https://aip.dev/122 - as per AIP guideline
+	machine.Name = util.AddPrefix(util.MachineCollection, machine.Name)
+	return machine, err
+}
+
 // UpdateRack updates the rack information in database.
 func (fs *FleetServerImpl) UpdateRack(ctx context.Context, req *ufsAPI.UpdateRackRequest) (rsp *ufspb.Rack, err error) {
 	defer func() {
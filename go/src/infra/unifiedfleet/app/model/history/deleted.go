@@ -0,0 +1,144 @@
+// Copyright 2021 The Chromium OS Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package history
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"go.chromium.org/luci/common/logging"
+	"go.chromium.org/luci/gae/service/datastore"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	ufsds "infra/unifiedfleet/app/model/datastore"
+)
+
+// DeletedResourceKind is the datastore entity kind for storing soft-deleted resources.
+const DeletedResourceKind string = "DeletedResource"
+
+// DeletedResourceEntity is a datastore entity that keeps a soft-deleted
+// resource until it is restored or purged.
+//
+// Deleted resources are kept in their own kind instead of being flagged in
+// the resource's kind, so that existing queries and references checks of the
+// resource's kind never see them.
+type DeletedResourceEntity struct {
+	_kind string `gae:"$kind,DeletedResource"`
+	// Resource name with the collection prefix, e.g. machines/xxx.
+	ID string `gae:"$id"`
+	// Collection of the resource, e.g. machines.
+	ResourceType string    `gae:"resource_type"`
+	DeleteTime   time.Time `gae:"delete_time"`
+	DeleteUser   string    `gae:"delete_user"`
+	// The resource proto at the time of deletion.
+	Msg []byte `gae:",noindex"`
+}
+
+// GetProto unmarshals the deleted resource into t.
+func (e *DeletedResourceEntity) GetProto(t proto.Message) error {
+	return proto.Unmarshal(e.Msg, t)
+}
+
+// NewDeletedResourceEntity creates a new DeletedResourceEntity.
+func NewDeletedResourceEntity(resourceName, resourceType string, pm proto.Message, deleteTime time.Time, deleteUser string) (*DeletedResourceEntity, error) {
+	msg, err := proto.Marshal(pm)
+	if err != nil {
+		return nil, err
+	}
+	return &DeletedResourceEntity{
+		ID:           resourceName,
+		ResourceType: resourceType,
+		DeleteTime:   deleteTime.UTC(),
+		DeleteUser:   deleteUser,
+		Msg:          msg,
+	}, nil
+}
+
+// PutDeletedResource saves a deleted resource, replacing a previous deletion
+// of a resource with the same name.
+func PutDeletedResource(ctx context.Context, e *DeletedResourceEntity) error {
+	if err := datastore.Put(ctx, e); err != nil {
+		logging.Errorf(ctx, "Failed to put deleted resource %s in datastore: %s", e.ID, err)
+		return status.Errorf(codes.Internal, ufsds.InternalError)
+	}
+	return nil
+}
+
+// GetDeletedResource returns the deleted resource for the given resource name.
+func GetDeletedResource(ctx context.Context, resourceName string) (*DeletedResourceEntity, error) {
+	e := &DeletedResourceEntity{ID: resourceName}
+	if err := datastore.Get(ctx, e); err != nil {
+		if datastore.IsErrNoSuchEntity(err) {
+			return nil, status.Errorf(codes.NotFound, fmt.Sprintf("Deleted resource not found %s", resourceName))
+		}
+		logging.Errorf(ctx, "Failed to get deleted resource %s from datastore: %s", resourceName, err)
+		return nil, status.Errorf(codes.Internal, ufsds.InternalError)
+	}
+	return e, nil
+}
+
+// ListDeletedResources lists the deleted resources of the given collection.
+//
+// Returns up to pageSize entities, plus non-nil cursor (if there are more
+// results). pageSize must be positive.
+func ListDeletedResources(ctx context.Context, resourceType string, pageSize int32, pageToken string) (res []*DeletedResourceEntity, nextPageToken string, err error) {
+	filterMap := map[string][]interface{}{
+		"resource_type": {resourceType},
+	}
+	q, err := ufsds.ListQuery(ctx, DeletedResourceKind, pageSize, pageToken, filterMap, false)
+	if err != nil {
+		return nil, "", err
+	}
+	var nextCur datastore.Cursor
+	err = datastore.Run(ctx, q, func(ent *DeletedResourceEntity, cb datastore.CursorCB) error {
+		res = append(res, ent)
+		if len(res) >= int(pageSize) {
+			if nextCur, err = cb(); err != nil {
+				return err
+			}
+			return datastore.Stop
+		}
+		return nil
+	})
+	if err != nil {
+		logging.Errorf(ctx, "Failed to list deleted resources %s", err)
+		return nil, "", status.Errorf(codes.Internal, ufsds.InternalError)
+	}
+	if nextCur != nil {
+		nextPageToken = nextCur.String()
+	}
+	return
+}
+
+// QueryDeletedResourcesBefore returns the names of resources deleted before the given time.
+func QueryDeletedResourcesBefore(ctx context.Context, t time.Time) ([]string, error) {
+	q := datastore.NewQuery(DeletedResourceKind).Lt("delete_time", t.UTC()).KeysOnly(true)
+	var entities []*DeletedResourceEntity
+	if err := datastore.GetAll(ctx, q, &entities); err != nil {
+		logging.Errorf(ctx, "Failed to query deleted resources: %s", err)
+		return nil, status.Errorf(codes.Internal, ufsds.InternalError)
+	}
+	names := make([]string, len(entities))
+	for i, e := range entities {
+		names[i] = e.ID
+	}
+	return names, nil
+}
+
+// DeleteDeletedResources permanently removes the deleted resources with the given names.
+func DeleteDeletedResources(ctx context.Context, resourceNames []string) error {
+	entities := make([]*DeletedResourceEntity, len(resourceNames))
+	for i, n := range resourceNames {
+		entities[i] = &DeletedResourceEntity{ID: n}
+	}
+	if err := datastore.Delete(ctx, entities); err != nil {
+		logging.Errorf(ctx, "Failed to delete deleted resources from datastore: %s", err)
+		return status.Errorf(codes.Internal, ufsds.InternalError)
+	}
+	return nil
+}
@@ -33,6 +33,12 @@ const AcsLabAdminRealm = "@internal:ufs/os-acs"
 //SatLabInternalUserRealm is realm for satlab internal users.
 const SatLabInternalUserRealm = "@internal:ufs/satlab-internal-users"
 
+// BrowserReadRealm is the realm granting read access to browser lab data.
+const BrowserReadRealm = "@internal:ufs/browser-read"
+
+// OSReadRealm is the realm granting read access to OS lab data.
+const OSReadRealm = "@internal:ufs/os-read"
+
 // SkipRealmsCheck flag to skip realms check
 var SkipRealmsCheck = false
 
@@ -84,6 +90,10 @@ var (
 
 	// ResourcesImport allows to import resource resources.
 	ResourcesImport = realms.RegisterPermission("ufs.resources.import")
+	// ResourcesBrowse allows to read resources without their sensitive fields.
+	ResourcesBrowse = realms.RegisterPermission("ufs.resources.browse")
+	// ResourcesRead allows to read complete resources.
+	ResourcesRead = realms.RegisterPermission("ufs.resources.read")
 )
 
 // CurrentUser returns the current user
@@ -126,6 +136,50 @@ func CheckPermission(ctx context.Context, perm realms.Permission, realm string)
 	return nil
 }
 
+// namespaceReadRealms maps datastore namespaces to the realms granting
+// read access to their data.
+var namespaceReadRealms = map[string]string{
+	ClientToDatastoreNamespace[BrowserNamespace]: BrowserReadRealm,
+	ClientToDatastoreNamespace[OSNamespace]:      OSReadRealm,
+}
+
+// ReadRealm returns the realm granting read access to the data in the
+// datastore namespace.  Returns an empty string for unknown namespaces.
+func ReadRealm(namespace string) string {
+	return namespaceReadRealms[namespace]
+}
+
+// GetReadLevel resolves the read access level of the caller to the data
+// in the datastore namespace.
+//
+// Callers with ResourcesRead in the namespace's realm get FullRead and
+// callers with only ResourcesBrowse get BrowseRead.  Returns a
+// PermissionDenied error if the caller has neither permission.
+func GetReadLevel(ctx context.Context, namespace string) (ReadLevel, error) {
+	if SkipRealmsCheck {
+		return FullRead, nil
+	}
+	realm := ReadRealm(namespace)
+	if realm == "" {
+		return FullRead, nil
+	}
+	full, err := hasPermission(ctx, ResourcesRead, realm)
+	if err != nil {
+		return 0, err
+	}
+	if full {
+		return FullRead, nil
+	}
+	browse, err := hasPermission(ctx, ResourcesBrowse, realm)
+	if err != nil {
+		return 0, err
+	}
+	if browse {
+		return BrowseRead, nil
+	}
+	return 0, status.Errorf(codes.PermissionDenied, "%s does not have permission %s or %s in the realm %s", auth.CurrentIdentity(ctx), ResourcesRead, ResourcesBrowse, realm)
+}
+
 // ToUFSRealm returns the realm name based on zone string.
 func ToUFSRealm(zone string) string {
 	ufsZone := ToUFSZone(zone)
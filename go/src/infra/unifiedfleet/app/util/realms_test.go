@@ -64,3 +64,56 @@ func TestCheckPermission(t *testing.T) {
 		check(writer, writePermission, "", true)
 	})
 }
+
+func TestGetReadLevel(t *testing.T) {
+	t.Parallel()
+
+	reader := identity.Identity("user:reader@example.com")
+	browser := identity.Identity("user:browser@example.com")
+	other := identity.Identity("user:other@example.com")
+	fakeDB := authtest.NewFakeDB(
+		authtest.MockPermission(reader, BrowserReadRealm, ResourcesRead),
+		authtest.MockPermission(reader, BrowserReadRealm, ResourcesBrowse),
+		authtest.MockPermission(browser, BrowserReadRealm, ResourcesBrowse),
+		authtest.MockPermission(browser, OSReadRealm, ResourcesRead),
+		authtest.MockRealmData(BrowserReadRealm, &protocol.RealmData{}),
+		authtest.MockRealmData(OSReadRealm, &protocol.RealmData{}),
+	)
+	withID := func(id identity.Identity) context.Context {
+		return auth.WithState(context.Background(), &authtest.FakeState{
+			Identity: id,
+			FakeDB:   fakeDB,
+		})
+	}
+	browserNS := ClientToDatastoreNamespace[BrowserNamespace]
+	osNS := ClientToDatastoreNamespace[OSNamespace]
+	Convey("GetReadLevel", t, func() {
+		Convey("Full read with the read permission", func() {
+			level, err := GetReadLevel(withID(reader), browserNS)
+			So(err, ShouldBeNil)
+			So(level, ShouldEqual, FullRead)
+		})
+		Convey("Browse read with only the browse permission", func() {
+			level, err := GetReadLevel(withID(browser), browserNS)
+			So(err, ShouldBeNil)
+			So(level, ShouldEqual, BrowseRead)
+		})
+		Convey("Levels are per namespace", func() {
+			level, err := GetReadLevel(withID(browser), osNS)
+			So(err, ShouldBeNil)
+			So(level, ShouldEqual, FullRead)
+			_, err = GetReadLevel(withID(reader), osNS)
+			So(err, ShouldNotBeNil)
+		})
+		Convey("Permission denied without permissions", func() {
+			_, err := GetReadLevel(withID(other), browserNS)
+			So(err, ShouldNotBeNil)
+			So(err.Error(), ShouldContainSubstring, "does not have permission")
+		})
+		Convey("Full read for unknown namespaces", func() {
+			level, err := GetReadLevel(withID(other), "unknown")
+			So(err, ShouldBeNil)
+			So(level, ShouldEqual, FullRead)
+		})
+	})
+}
@@ -0,0 +1,224 @@
+// Copyright 2021 The Chromium OS Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package util
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"go.chromium.org/luci/common/errors"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	ufspb "infra/unifiedfleet/api/v1/models"
+)
+
+// ReadLevel is the level of read access a caller has to UFS entities.
+type ReadLevel int
+
+const (
+	// FullRead callers get complete entities.  This is the level of
+	// contexts which were not set up with a read level, e.g. for
+	// internal calls.
+	FullRead ReadLevel = iota
+	// BrowseRead callers get entities with the fields declared in
+	// SensitiveFields cleared.
+	BrowseRead
+)
+
+// String implements fmt.Stringer.
+func (l ReadLevel) String() string {
+	switch l {
+	case FullRead:
+		return "full"
+	case BrowseRead:
+		return "browse"
+	default:
+		return fmt.Sprintf("ReadLevel(%d)", int(l))
+	}
+}
+
+// readLevelKey is the context key for the read level.
+type readLevelKey struct{}
+
+// WithReadLevel returns a context with the read level of the caller.
+func WithReadLevel(ctx context.Context, l ReadLevel) context.Context {
+	return context.WithValue(ctx, readLevelKey{}, l)
+}
+
+// ReadLevelFromContext returns the read level of the caller set with
+// WithReadLevel, or FullRead if it is not set.
+func ReadLevelFromContext(ctx context.Context) ReadLevel {
+	if l, ok := ctx.Value(readLevelKey{}).(ReadLevel); ok {
+		return l
+	}
+	return FullRead
+}
+
+// SensitiveFields declares the fields of UFS entities which are only
+// returned to callers with FullRead, keyed by message.  The masks are
+// relative to the message.  Every field of the UFS models with a name
+// matching sensitiveFieldName must be declared here, which is enforced
+// by CheckSensitiveFields.
+var SensitiveFields = map[protoreflect.FullName]*fieldmaskpb.FieldMask{
+	messageName(&ufspb.AssetInfo{}):       {Paths: []string{"asset_tag", "ethernet_mac_address"}},
+	messageName(&ufspb.ChromeOSMachine{}): {Paths: []string{"mac_address"}},
+	messageName(&ufspb.DHCPConfig{}):      {Paths: []string{"mac_address"}},
+	messageName(&ufspb.Drac{}):            {Paths: []string{"mac_address"}},
+	messageName(&ufspb.KVM{}):             {Paths: []string{"mac_address"}},
+	messageName(&ufspb.Nic{}):             {Paths: []string{"mac_address"}},
+	messageName(&ufspb.RPM{}):             {Paths: []string{"mac_address"}},
+	messageName(&ufspb.VM{}):              {Paths: []string{"mac_address"}},
+}
+
+// sensitiveFieldName matches the names of fields which must be declared
+// in SensitiveFields.
+var sensitiveFieldName = regexp.MustCompile(`(^|_)mac_address$|^asset_tag$`)
+
+// modelsPackage is the proto package of the UFS models.  Messages in
+// its subpackages are also checked by CheckSensitiveFields.
+const modelsPackage = "unifiedfleet.api.v1.models"
+
+func messageName(m proto.Message) protoreflect.FullName {
+	return m.ProtoReflect().Descriptor().FullName()
+}
+
+// RedactSensitiveFields clears the fields declared in SensitiveFields
+// in the message and in all messages nested in it.
+func RedactSensitiveFields(m proto.Message) {
+	redactMessage(m.ProtoReflect())
+}
+
+func redactMessage(m protoreflect.Message) {
+	if mask, ok := SensitiveFields[m.Descriptor().FullName()]; ok {
+		for _, p := range mask.GetPaths() {
+			clearPath(m, strings.Split(p, "."))
+		}
+	}
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		switch {
+		case fd.IsList() && fd.Message() != nil:
+			l := v.List()
+			for i := 0; i < l.Len(); i++ {
+				redactMessage(l.Get(i).Message())
+			}
+		case fd.IsMap() && fd.MapValue().Message() != nil:
+			v.Map().Range(func(_ protoreflect.MapKey, mv protoreflect.Value) bool {
+				redactMessage(mv.Message())
+				return true
+			})
+		case !fd.IsList() && !fd.IsMap() && fd.Message() != nil:
+			redactMessage(v.Message())
+		}
+		return true
+	})
+}
+
+// clearPath clears the field at the path of field names in the message.
+// Paths through unset or repeated fields are ignored.
+func clearPath(m protoreflect.Message, path []string) {
+	fd := m.Descriptor().Fields().ByName(protoreflect.Name(path[0]))
+	if fd == nil || !m.Has(fd) {
+		return
+	}
+	if len(path) == 1 {
+		m.Clear(fd)
+		return
+	}
+	if fd.Message() == nil || fd.IsList() || fd.IsMap() {
+		return
+	}
+	clearPath(m.Get(fd).Message(), path[1:])
+}
+
+// CheckSensitiveFields checks that the SensitiveFields declarations
+// refer to existing fields, and that every field of the UFS models with
+// a name matching sensitiveFieldName is declared.
+func CheckSensitiveFields() error {
+	var msgs []protoreflect.MessageDescriptor
+	protoregistry.GlobalFiles.RangeFiles(func(fd protoreflect.FileDescriptor) bool {
+		p := string(fd.Package())
+		if p == modelsPackage || strings.HasPrefix(p, modelsPackage+".") {
+			msgs = appendMessages(msgs, fd.Messages())
+		}
+		return true
+	})
+	return checkSensitiveFields(SensitiveFields, msgs)
+}
+
+// appendMessages appends the messages and their nested messages.
+func appendMessages(msgs []protoreflect.MessageDescriptor, l protoreflect.MessageDescriptors) []protoreflect.MessageDescriptor {
+	for i := 0; i < l.Len(); i++ {
+		md := l.Get(i)
+		if md.IsMapEntry() {
+			continue
+		}
+		msgs = append(msgs, md)
+		msgs = appendMessages(msgs, md.Messages())
+	}
+	return msgs
+}
+
+func checkSensitiveFields(decls map[protoreflect.FullName]*fieldmaskpb.FieldMask, msgs []protoreflect.MessageDescriptor) error {
+	byName := make(map[protoreflect.FullName]protoreflect.MessageDescriptor, len(msgs))
+	for _, md := range msgs {
+		byName[md.FullName()] = md
+	}
+	var errs errors.MultiError
+	declared := make(map[string]bool)
+	for name, mask := range decls {
+		md, ok := byName[name]
+		if !ok {
+			errs = append(errs, errors.Reason("sensitive fields declared for unknown message %s", name).Err())
+			continue
+		}
+		for _, p := range mask.GetPaths() {
+			if err := checkPath(md, strings.Split(p, ".")); err != nil {
+				errs = append(errs, errors.Annotate(err, "sensitive field %s.%s", name, p).Err())
+				continue
+			}
+			declared[string(name)+"."+p] = true
+		}
+	}
+	var missing []string
+	for _, md := range msgs {
+		fields := md.Fields()
+		for i := 0; i < fields.Len(); i++ {
+			f := fields.Get(i)
+			if sensitiveFieldName.MatchString(string(f.Name())) && !declared[string(f.FullName())] {
+				missing = append(missing, string(f.FullName()))
+			}
+		}
+	}
+	sort.Strings(missing)
+	for _, f := range missing {
+		errs = append(errs, errors.Reason("field %s looks sensitive but is not declared in SensitiveFields", f).Err())
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+// checkPath checks that the path of field names refers to a field of
+// the message.
+func checkPath(md protoreflect.MessageDescriptor, path []string) error {
+	fd := md.Fields().ByName(protoreflect.Name(path[0]))
+	if fd == nil {
+		return errors.Reason("no field %q in %s", path[0], md.FullName()).Err()
+	}
+	if len(path) == 1 {
+		return nil
+	}
+	if fd.Message() == nil || fd.IsList() || fd.IsMap() {
+		return errors.Reason("field %s is not a singular message", fd.FullName()).Err()
+	}
+	return checkPath(fd.Message(), path[1:])
+}
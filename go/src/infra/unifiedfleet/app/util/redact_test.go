@@ -0,0 +1,110 @@
+// Copyright 2021 The Chromium OS Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package util
+
+import (
+	"strings"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+	"go.chromium.org/luci/common/errors"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	ufspb "infra/unifiedfleet/api/v1/models"
+)
+
+func TestRedactSensitiveFields(t *testing.T) {
+	t.Parallel()
+
+	Convey("RedactSensitiveFields", t, func() {
+		Convey("Clears the sensitive fields of an entity", func() {
+			nic := &ufspb.Nic{
+				Name:       "nic-1",
+				MacAddress: "12:34:56:78:90:ab",
+				Machine:    "machine-1",
+			}
+			RedactSensitiveFields(nic)
+			So(proto.Equal(nic, &ufspb.Nic{Name: "nic-1", Machine: "machine-1"}), ShouldBeTrue)
+		})
+		Convey("Clears the sensitive fields of nested entities", func() {
+			machine := &ufspb.Machine{
+				Name: "machine-1",
+				Device: &ufspb.Machine_ChromeosMachine{
+					ChromeosMachine: &ufspb.ChromeOSMachine{
+						MacAddress: "12:34:56:78:90:ab",
+						Hwid:       "hwid",
+					},
+				},
+			}
+			RedactSensitiveFields(machine)
+			So(machine.GetChromeosMachine().GetMacAddress(), ShouldBeEmpty)
+			So(machine.GetChromeosMachine().GetHwid(), ShouldEqual, "hwid")
+
+			asset := &ufspb.Asset{
+				Name: "asset-1",
+				Info: &ufspb.AssetInfo{
+					AssetTag:           "asset-1",
+					EthernetMacAddress: "12:34:56:78:90:ab",
+					Model:              "model",
+				},
+			}
+			RedactSensitiveFields(asset)
+			So(asset.GetName(), ShouldEqual, "asset-1")
+			So(proto.Equal(asset.GetInfo(), &ufspb.AssetInfo{Model: "model"}), ShouldBeTrue)
+		})
+		Convey("Leaves entities without sensitive fields alone", func() {
+			rack := &ufspb.Rack{Name: "rack-1", Location: &ufspb.Location{Rack: "rack-1"}}
+			want := proto.Clone(rack)
+			RedactSensitiveFields(rack)
+			So(proto.Equal(rack, want), ShouldBeTrue)
+		})
+	})
+}
+
+func TestCheckSensitiveFields(t *testing.T) {
+	t.Parallel()
+
+	nic := (&ufspb.Nic{}).ProtoReflect().Descriptor()
+	machine := (&ufspb.Machine{}).ProtoReflect().Descriptor()
+	// errorText joins the messages of all errors of a MultiError.
+	errorText := func(err error) string {
+		var msgs []string
+		for _, e := range err.(errors.MultiError) {
+			msgs = append(msgs, e.Error())
+		}
+		return strings.Join(msgs, "\n")
+	}
+	Convey("CheckSensitiveFields", t, func() {
+		Convey("The declarations are complete", func() {
+			So(CheckSensitiveFields(), ShouldBeNil)
+		})
+		Convey("Missing declarations are reported", func() {
+			err := checkSensitiveFields(nil, []protoreflect.MessageDescriptor{nic, machine})
+			So(err, ShouldNotBeNil)
+			So(errorText(err), ShouldContainSubstring, "unifiedfleet.api.v1.models.Nic.mac_address looks sensitive")
+		})
+		Convey("Unknown fields are reported", func() {
+			decls := map[protoreflect.FullName]*fieldmaskpb.FieldMask{
+				nic.FullName():     {Paths: []string{"mac_address", "mac"}},
+				machine.FullName(): {Paths: []string{"name.first"}},
+			}
+			err := checkSensitiveFields(decls, []protoreflect.MessageDescriptor{nic, machine})
+			So(err, ShouldNotBeNil)
+			So(errorText(err), ShouldContainSubstring, `no field "mac"`)
+			So(errorText(err), ShouldContainSubstring, "is not a singular message")
+			So(errorText(err), ShouldNotContainSubstring, "looks sensitive")
+		})
+		Convey("Unknown messages are reported", func() {
+			decls := map[protoreflect.FullName]*fieldmaskpb.FieldMask{
+				"unifiedfleet.api.v1.models.Unknown": {Paths: []string{"mac_address"}},
+			}
+			err := checkSensitiveFields(decls, nil)
+			So(err, ShouldNotBeNil)
+			So(errorText(err), ShouldContainSubstring, "unknown message")
+		})
+	})
+}
@@ -7,7 +7,6 @@ package main
 import (
 	"context"
 	"flag"
-	"fmt"
 	"regexp"
 	"strconv"
 	"strings"
@@ -27,19 +26,16 @@ import (
 	"infra/unifiedfleet/app/config"
 	"infra/unifiedfleet/app/external"
 	"infra/unifiedfleet/app/frontend"
-	"infra/unifiedfleet/app/util"
 )
 
 // SupportedClientMajorVersionNumber indicates the minimum client version
 // supported by this server
 //
 // any client with major version number lower than this number will get an
-// error to update their client to this major version or above.
+// error to update their client to this major version or above. RPCs may
+// require a different minimum version in the service config.
 const SupportedClientMajorVersionNumber = 3
 
-// flag to control erroring out if namespace is not provided
-const rejectCallforNamespace = false
-
 func main() {
 	modules := []module.Module{
 		gaeemulation.NewModuleFromFlags(),
@@ -58,8 +54,9 @@ func main() {
 
 		srv.Context = config.Use(srv.Context, cfgLoader.Config())
 		srv.Context = external.WithServerInterface(srv.Context)
-		srv.RegisterUnaryServerInterceptor(versionInterceptor)
-		srv.RegisterUnaryServerInterceptor(namespaceInterceptor)
+		srv.RegisterUnaryServerInterceptor(versionInterceptor(cfgLoader.Config))
+		srv.RegisterUnaryServerInterceptor(frontend.NamespaceInterceptor(cfgLoader.Config))
+		srv.RegisterUnaryServerInterceptor(frontend.ReadLevelInterceptor)
 		frontend.InstallServices(srv.PRPC)
 
 		// Add authenticator for handling JWT tokens. This is required to
@@ -77,107 +74,79 @@ func main() {
 	})
 }
 
-// namespaceInterceptor interceptor to set namespace for the datastore
-func namespaceInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
-	md, ok := metadata.FromIncomingContext(ctx)
-	if !ok {
-		return nil, status.Errorf(codes.InvalidArgument, "Retrieving metadata failed.")
-	}
-
-	// TODO(eshwarn): this is to check http request from device ticketfiler
-	// remove this once verified. Used only for logging messages
-	var v, n string
-	version, ok := md["user-agent"]
-	if ok {
-		v = version[0]
-	}
-
-	namespace, ok := md[util.Namespace]
-	if ok {
-		// TODO(eshwarn): this is to check http request from device ticketfiler
-		// remove this once verified. Used only for logging messages
-		n = namespace[0]
-
-		ns := strings.ToLower(namespace[0])
-		datastoreNamespace, ok := util.ClientToDatastoreNamespace[ns]
-		if ok {
-			ctx, err = util.SetupDatastoreNamespace(ctx, datastoreNamespace)
+// versionInterceptor returns an interceptor checking the client version in
+// the user-agent of each RPC call against the minimum client major version
+// required by the RPC. Minimum versions are read from the service config
+// returned by cfg on each call, falling back to
+// SupportedClientMajorVersionNumber.
+func versionInterceptor(cfg config.Provider) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
+		md, ok := metadata.FromIncomingContext(ctx)
+		if !ok {
+			return nil, status.Errorf(codes.InvalidArgument, "Retrieving metadata failed.")
+		}
+		user, major, err := validateUserAgent(md, info.FullMethod, minClientMajorVersion(cfg(), info.FullMethod))
+		if err != nil {
+			return nil, err
+		}
+		defer func() {
+			code := codes.OK
 			if err != nil {
-				return nil, err
+				code = grpc.Code(err)
 			}
-		} else if rejectCallforNamespace {
-			return nil, status.Errorf(codes.InvalidArgument, "namespace %s in the context metadata is invalid. Valid namespaces: [%s]", namespace[0], strings.Join(util.ValidClientNamespaceStr(), ", "))
+			ufsGRPCServerCount.Add(ctx, 1, info.FullMethod, int(code), user, major)
+		}()
+		if blockSkylabWritesToMachineLSE(info, user) {
+			logging.Infof(ctx, "Blocking useragent: %s RPC: %s", user, info.FullMethod)
+			return nil, status.Errorf(codes.PermissionDenied, "blocking skylab writes to UFS MachineLSE")
 		}
-	} else if rejectCallforNamespace {
-		return nil, status.Errorf(codes.InvalidArgument, "namespace is not set in the context metadata. Valid namespaces: [%s]", strings.Join(util.ValidClientNamespaceStr(), ", "))
+		logging.Debugf(ctx, "Successfully pass user-agent version check for user %s, major version %d", user, major)
+		resp, err = handler(ctx, req)
+		return
 	}
-
-	// TODO(eshwarn): this is to check http request from device ticketfiler
-	// remove this once verified.
-	logging.Debugf(ctx, "user-agent = %s and namespace = %s", v, n)
-
-	resp, err = handler(ctx, req)
-	return
 }
 
-// versionInterceptor interceptor to handle client version check per RPC call
-func versionInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
-	md, ok := metadata.FromIncomingContext(ctx)
-	if !ok {
-		return nil, status.Errorf(codes.InvalidArgument, "Retrieving metadata failed.")
-	}
-	user, userAgentExists, userAgentErr := validateUserAgent(md)
-	if userAgentExists && userAgentErr != nil {
-		return nil, userAgentErr
+// minClientMajorVersion returns the minimum client major version required
+// by the RPC with the full gRPC method name.
+func minClientMajorVersion(cfg *config.Config, fullMethod string) int {
+	if v, ok := cfg.GetMinClientMajorVersions()[fullMethod]; ok {
+		return int(v)
 	}
-	if !userAgentExists {
-		return nil, status.Errorf(codes.InvalidArgument, "user-agent is not specified in the incoming request")
-	}
-	defer func() {
-		code := codes.OK
-		if err != nil {
-			code = grpc.Code(err)
-		}
-		ufsGRPCServerCount.Add(ctx, 1, info.FullMethod, int(code), user)
-	}()
-	if blockSkylabWritesToMachineLSE(info, user) {
-		logging.Infof(ctx, "Blocking useragent: %s RPC: %s", user, info.FullMethod)
-		return nil, status.Errorf(codes.PermissionDenied, "blocking skylab writes to UFS MachineLSE")
-	}
-	logging.Debugf(ctx, "Successfully pass user-agent version check for user %s, major version %d", user, SupportedClientMajorVersionNumber)
-	resp, err = handler(ctx, req)
-	return
+	return SupportedClientMajorVersionNumber
 }
 
 // Assuming the version number for major, minor and patch are less than 1000.
 var versionRegex = regexp.MustCompile(`[0-9]{1,3}`)
 
-// validateUserAgent returns a tuple
-//     (if user-agent exists, if user-agent is valid)
-func validateUserAgent(md metadata.MD) (string, bool, error) {
+// validateUserAgent checks the user-agent in the metadata against the
+// minimum client major version required by the RPC with the full gRPC
+// method name, and returns the user-agent and the client major version.
+// The major version is 0 for clients exempt from the check.
+func validateUserAgent(md metadata.MD, fullMethod string, minMajor int) (string, int, error) {
 	version, ok := md["user-agent"]
-	// Only check version for skylab commands which already set user-agent
-	if ok {
-		// TODO(xixuan): remove this check
-		// Traffic from trawler has a default userAgent "Googlebot/2.1" if no special userAgent is approved yet.
-		// So before b/179652204 is approved, temporarily allow all traffic from trawler.
-		if strings.Contains(version[0], "Googlebot") {
-			return version[0], ok, nil
-		}
-		majors := versionRegex.FindAllString(version[0], 1)
-		if len(majors) != 1 {
-			return "", ok, status.Errorf(codes.InvalidArgument, "user-agent %s doesn't contain major version", version[0])
-		}
-		major, err := strconv.ParseInt(majors[0], 10, 32)
-		if err != nil {
-			return "", ok, status.Errorf(codes.InvalidArgument, "user-agent %s has wrong major version format", version[0])
-		}
-		if major < SupportedClientMajorVersionNumber {
-			return "", ok, status.Errorf(codes.FailedPrecondition,
-				fmt.Sprintf("Unsupported client version %d, Please update your client version to v%d.X.X or above.", major, SupportedClientMajorVersionNumber))
-		}
+	if !ok || len(version) == 0 {
+		return "", 0, status.Errorf(codes.InvalidArgument, "user-agent is not specified in the incoming request")
+	}
+	// TODO(xixuan): remove this check
+	// Traffic from trawler has a default userAgent "Googlebot/2.1" if no special userAgent is approved yet.
+	// So before b/179652204 is approved, temporarily allow all traffic from trawler.
+	if strings.Contains(version[0], "Googlebot") {
+		return version[0], 0, nil
+	}
+	majors := versionRegex.FindAllString(version[0], 1)
+	if len(majors) != 1 {
+		return "", 0, status.Errorf(codes.InvalidArgument, "user-agent %s doesn't contain major version", version[0])
+	}
+	major, err := strconv.Atoi(majors[0])
+	if err != nil {
+		return "", 0, status.Errorf(codes.InvalidArgument, "user-agent %s has wrong major version format", version[0])
+	}
+	if major < minMajor {
+		rpc := fullMethod[strings.LastIndex(fullMethod, "/")+1:]
+		return "", 0, status.Errorf(codes.FailedPrecondition,
+			"Unsupported client version %d for %s, Please update your client version to v%d.X.X or above.", major, rpc, minMajor)
 	}
-	return version[0], ok, nil
+	return version[0], major, nil
 }
 
 // This is to block older version of skylab tool(deprecated) only from updating MachineLSE in UFS.
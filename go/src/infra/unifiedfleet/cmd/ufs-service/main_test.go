@@ -0,0 +1,108 @@
+// Copyright 2021 The Chromium OS Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package main
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+	. "go.chromium.org/luci/common/testing/assertions"
+	"go.chromium.org/luci/common/tsmon"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"infra/unifiedfleet/app/config"
+)
+
+func TestVersionInterceptor(t *testing.T) {
+	t.Parallel()
+
+	const (
+		getMachine    = "/unifiedfleet.api.v1.rpc.Fleet/GetMachine"
+		updateMachine = "/unifiedfleet.api.v1.rpc.Fleet/UpdateMachine"
+	)
+	Convey("versionInterceptor", t, func() {
+		ctx, _ := tsmon.WithDummyInMemory(context.Background())
+		cfg := &config.Config{}
+		interceptor := versionInterceptor(func() *config.Config { return cfg })
+		// call runs the interceptor for the method with the user-agent,
+		// and returns whether the handler was called.
+		call := func(method, userAgent string) (bool, error) {
+			ctx := metadata.NewIncomingContext(ctx, metadata.Pairs("user-agent", userAgent))
+			called := false
+			handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+				called = true
+				return nil, nil
+			}
+			_, err := interceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: method}, handler)
+			return called, err
+		}
+
+		Convey("Supported version", func() {
+			called, err := call(getMachine, "shivas/4.1.0")
+			So(err, ShouldBeNil)
+			So(called, ShouldBeTrue)
+			So(ufsGRPCServerCount.Get(ctx, getMachine, int(codes.OK), "shivas/4.1.0", 4), ShouldEqual, 1)
+		})
+		Convey("Unsupported version", func() {
+			called, err := call(getMachine, "shivas/2.0.0")
+			So(status.Code(err), ShouldEqual, codes.FailedPrecondition)
+			So(err, ShouldErrLike, "Unsupported client version 2 for GetMachine, Please update your client version to v3.X.X or above.")
+			So(called, ShouldBeFalse)
+		})
+		Convey("Method-specific minimum version", func() {
+			cfg.MinClientMajorVersions = map[string]int32{
+				updateMachine: 5,
+				getMachine:    2,
+			}
+
+			called, err := call(updateMachine, "shivas/4.1.0")
+			So(status.Code(err), ShouldEqual, codes.FailedPrecondition)
+			So(err, ShouldErrLike, "Unsupported client version 4 for UpdateMachine, Please update your client version to v5.X.X or above.")
+			So(called, ShouldBeFalse)
+
+			called, err = call(updateMachine, "shivas/5.0.0")
+			So(err, ShouldBeNil)
+			So(called, ShouldBeTrue)
+
+			called, err = call(getMachine, "shivas/2.0.0")
+			So(err, ShouldBeNil)
+			So(called, ShouldBeTrue)
+			So(ufsGRPCServerCount.Get(ctx, getMachine, int(codes.OK), "shivas/2.0.0", 2), ShouldEqual, 1)
+
+			// Other methods fall back to the global minimum.
+			called, err = call("/unifiedfleet.api.v1.rpc.Fleet/ListMachines", "shivas/2.0.0")
+			So(status.Code(err), ShouldEqual, codes.FailedPrecondition)
+			So(called, ShouldBeFalse)
+		})
+		Convey("Malformed user-agent", func() {
+			called, err := call(getMachine, "shivas")
+			So(status.Code(err), ShouldEqual, codes.InvalidArgument)
+			So(err, ShouldErrLike, "user-agent shivas doesn't contain major version")
+			So(called, ShouldBeFalse)
+		})
+		Convey("Missing user-agent", func() {
+			ctx := metadata.NewIncomingContext(ctx, metadata.MD{})
+			_, err := interceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: getMachine}, nil)
+			So(status.Code(err), ShouldEqual, codes.InvalidArgument)
+			So(err, ShouldErrLike, "user-agent is not specified in the incoming request")
+		})
+		Convey("Googlebot is exempt", func() {
+			cfg.MinClientMajorVersions = map[string]int32{getMachine: 5}
+			called, err := call(getMachine, "Googlebot/2.1")
+			So(err, ShouldBeNil)
+			So(called, ShouldBeTrue)
+			So(ufsGRPCServerCount.Get(ctx, getMachine, int(codes.OK), "Googlebot/2.1", 0), ShouldEqual, 1)
+		})
+		Convey("Skylab writes to MachineLSE are blocked", func() {
+			called, err := call("/unifiedfleet.api.v1.rpc.Fleet/UpdateMachineLSE", "skylab/3.0.0")
+			So(status.Code(err), ShouldEqual, codes.PermissionDenied)
+			So(called, ShouldBeFalse)
+		})
+	})
+}
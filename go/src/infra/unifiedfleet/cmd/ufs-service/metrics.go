@@ -14,7 +14,8 @@ var (
 		"grpc/ufs/server/count",
 		"Total number of RPCs.",
 		nil,
-		field.String("method"), // full name of the grpc method
-		field.Int("code"),      // grpc.Code of the result
-		field.String("caller")) // Caller of this method
+		field.String("method"),            // full name of the grpc method
+		field.Int("code"),                 // grpc.Code of the result
+		field.String("caller"),            // Caller of this method
+		field.Int("client_major_version")) // Major version of the caller, 0 if unknown
 )